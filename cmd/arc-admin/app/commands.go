@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+)
+
+const (
+	callTimeout = 30 * time.Second
+	maxMsgSize  = 100 * 1024 * 1024
+)
+
+var wordBoundary = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// serviceCommands generates a command for every method of the service from its descriptor, so that new admin
+// operations are available in the CLI without changes to the CLI. The fields of the request are given as flags.
+func serviceCommands(service protoreflect.ServiceDescriptor) []*cobra.Command {
+	methods := service.Methods()
+	commands := make([]*cobra.Command, 0, methods.Len())
+
+	for i := range methods.Len() {
+		commands = append(commands, methodCommand(methods.Get(i)))
+	}
+
+	return commands
+}
+
+func methodCommand(method protoreflect.MethodDescriptor) *cobra.Command {
+	fullMethod := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+
+	cmd := &cobra.Command{
+		Use:   commandName(string(method.Name())),
+		Short: fmt.Sprintf("Call %s, requires role %s", method.Name(), admin.RequiredRole(fullMethod)),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req, err := requestFromFlags(method.Input(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return invoke(cmd, fullMethod, req, dynamicpb.NewMessage(method.Output()))
+		},
+	}
+
+	fields := method.Input().Fields()
+	for i := range fields.Len() {
+		addFieldFlag(cmd.Flags(), fields.Get(i))
+	}
+
+	return cmd
+}
+
+// commandName converts the name of a method to the name of its command, e.g. UnlockRecords to unlock-records.
+func commandName(methodName string) string {
+	return strings.ToLower(wordBoundary.ReplaceAllString(methodName, "${1}-${2}"))
+}
+
+func addFieldFlag(flags *pflag.FlagSet, field protoreflect.FieldDescriptor) {
+	name := field.JSONName()
+	usage := string(field.Name())
+
+	if field.IsList() {
+		flags.StringSlice(name, nil, usage)
+		return
+	}
+
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		flags.Bool(name, false, usage)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		flags.Int64(name, 0, usage)
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		flags.Uint64(name, 0, usage)
+	default:
+		flags.String(name, "", usage)
+	}
+}
+
+func requestFromFlags(descriptor protoreflect.MessageDescriptor, flags *pflag.FlagSet) (*dynamicpb.Message, error) {
+	req := dynamicpb.NewMessage(descriptor)
+
+	fields := descriptor.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if !flags.Changed(field.JSONName()) {
+			continue
+		}
+
+		value, err := fieldValue(req, field, flags)
+		if err != nil {
+			return nil, fmt.Errorf("invalid flag %s: %v", field.JSONName(), err)
+		}
+		req.Set(field, value)
+	}
+
+	return req, nil
+}
+
+func fieldValue(req *dynamicpb.Message, field protoreflect.FieldDescriptor, flags *pflag.FlagSet) (protoreflect.Value, error) {
+	name := field.JSONName()
+
+	if field.IsList() {
+		values, err := flags.GetStringSlice(name)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+
+		list := req.NewField(field).List()
+		for _, v := range values {
+			list.Append(protoreflect.ValueOfString(v))
+		}
+		return protoreflect.ValueOfList(list), nil
+	}
+
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		v, err := flags.GetBool(name)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind:
+		v, err := flags.GetInt64(name)
+		return protoreflect.ValueOfInt32(int32(v)), err // #nosec G115
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind:
+		v, err := flags.GetInt64(name)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind:
+		v, err := flags.GetUint64(name)
+		return protoreflect.ValueOfUint32(uint32(v)), err // #nosec G115
+	case protoreflect.Uint64Kind:
+		v, err := flags.GetUint64(name)
+		return protoreflect.ValueOfUint64(v), err
+	default:
+		v, err := flags.GetString(name)
+		return protoreflect.ValueOfString(v), err
+	}
+}
+
+func invoke(cmd *cobra.Command, fullMethod string, req *dynamicpb.Message, resp *dynamicpb.Message) error {
+	address, err := cmd.Flags().GetString("address")
+	if err != nil {
+		return err
+	}
+	token, err := cmd.Flags().GetString("token")
+	if err != nil {
+		return err
+	}
+	caFile, err := cmd.Flags().GetString("caFile")
+	if err != nil {
+		return err
+	}
+
+	auth := &config.GrpcAuthConfig{
+		Token: token,
+		TLS:   &config.GrpcTLSConfig{Enabled: caFile != "", Client: &config.GrpcTLSClientConfig{CaFile: caFile}},
+	}
+
+	conn, err := grpc_utils.DialGRPC(address, "", maxMsgSize, nil, auth, nil)
+	if err != nil {
+		return fmt.Errorf("failed to connect to admin service: %v", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), callTimeout)
+	defer cancel()
+
+	err = conn.Invoke(ctx, fullMethod, req, resp)
+	if err != nil {
+		return err
+	}
+
+	b, err := protojson.MarshalOptions{Multiline: true, EmitUnpopulated: true}.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(b))
+	return err
+}
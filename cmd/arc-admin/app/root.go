@@ -0,0 +1,30 @@
+package app
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+)
+
+const tokenEnvVar = "ARC_ADMIN_TOKEN"
+
+var RootCmd = &cobra.Command{
+	Use:          "arc-admin",
+	Short:        "CLI tool to call the admin operations of ARC",
+	SilenceUsage: true,
+}
+
+func init() {
+	RootCmd.PersistentFlags().String("address", "localhost:8034", "Address of the admin service")
+	RootCmd.PersistentFlags().String("token", os.Getenv(tokenEnvVar), "Bearer token of the admin service, defaults to the env var "+tokenEnvVar)
+	RootCmd.PersistentFlags().String("caFile", "", "Optional CA certificate of the admin service, enables TLS")
+
+	service := admin_api.File_internal_admin_admin_api_admin_api_proto.Services().ByName("AdminAPI")
+	RootCmd.AddCommand(serviceCommands(service)...)
+}
+
+func Execute() error {
+	return RootCmd.Execute()
+}
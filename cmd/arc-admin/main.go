@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+
+	"github.com/bitcoin-sv/arc/cmd/arc-admin/app"
+)
+
+func main() {
+	err := app.Execute()
+	if err != nil {
+		log.Fatalf("failed to run arc-admin: %v", err)
+	}
+}
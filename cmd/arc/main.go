@@ -9,14 +9,24 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"runtime/debug"
 	"syscall"
 
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/prometheus/client_golang/prometheus"
 
 	cmd "github.com/bitcoin-sv/arc/cmd/arc/services"
 	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/api/reverification"
 	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/profiler"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
 	"github.com/bitcoin-sv/arc/internal/version"
+	"github.com/bitcoin-sv/arc/pkg/events"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
 )
 
 func main() {
@@ -29,7 +39,11 @@ func main() {
 }
 
 func run() error {
-	configDir, startAPI, startMetamorph, startBlockTx, startK8sWatcher, startCallbacker, dumpConfigFile := parseFlags()
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "init" {
+		return cmd.InitConfig(os.Args[3:], os.Stdin, os.Stdout, os.Stderr)
+	}
+
+	configDir, startAPI, startMetamorph, startBlockTx, startK8sWatcher, startCallbacker, dumpConfigFile, migrateDryRun, exportFile, exportQuery, backupDir, restoreDir, runPreflight := parseFlags()
 
 	arcConfig, err := config.Load(configDir)
 	if err != nil {
@@ -40,18 +54,53 @@ func run() error {
 		return config.DumpConfig(dumpConfigFile)
 	}
 
-	logger, err := arcLogger.NewLogger(arcConfig.LogLevel, arcConfig.LogFormat)
+	logLevels := arcLogger.NewLevels(arcLogger.LevelInfo)
+	loggerOpts := []arcLogger.Option{arcLogger.WithLevels(logLevels)}
+	if arcConfig.LogSampling != nil && arcConfig.LogSampling.Enabled {
+		loggerOpts = append(loggerOpts, arcLogger.WithSampling(arcConfig.LogSampling.Interval, arcConfig.LogSampling.First, arcConfig.LogSampling.Thereafter))
+	}
+
+	logger, err := arcLogger.NewLogger(arcConfig.LogLevel, arcConfig.LogFormat, loggerOpts...)
 	if err != nil {
 		return fmt.Errorf("failed to create logger: %v", err)
 	}
 
+	// log levels are shown read-only on the profiler server, they are changed through the admin service
+	http.Handle("/debug/loglevel", logLevels)
+
 	hostname, err := os.Hostname()
 	if err != nil {
 		return fmt.Errorf("failed to get host name: %v", err)
 	}
 
 	logger = logger.With(slog.String("host", hostname))
-	shutdownFns, err := startServices(arcConfig, logger, startAPI, startMetamorph, startBlockTx, startK8sWatcher, startCallbacker)
+
+	if migrateDryRun {
+		runner, err := cmd.MigrateDatabases(logger, arcConfig, true, true, true, true)
+		if err != nil {
+			return fmt.Errorf("failed to check migrations: %v", err)
+		}
+		runner.Shutdown()
+		return nil
+	}
+
+	if exportFile != "" {
+		return cmd.ExportTransactions(logger, arcConfig, exportQuery, exportFile)
+	}
+
+	if backupDir != "" {
+		return cmd.BackupDatabases(logger, arcConfig, backupDir)
+	}
+
+	if restoreDir != "" {
+		return cmd.RestoreDatabases(logger, arcConfig, restoreDir)
+	}
+
+	if runPreflight {
+		return cmd.RunPreflight(logger, arcConfig, os.Stdout)
+	}
+
+	shutdownFns, err := startServices(arcConfig, logger, logLevels, startAPI, startMetamorph, startBlockTx, startK8sWatcher, startCallbacker)
 	if err != nil {
 		return err
 	}
@@ -69,7 +118,7 @@ func run() error {
 	return nil
 }
 
-func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, startAPI bool, startMetamorph bool, startBlockTx bool, startK8sWatcher bool, startCallbacker bool) ([]func(), error) {
+func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, logLevels *arcLogger.Levels, startAPI bool, startMetamorph bool, startBlockTx bool, startK8sWatcher bool, startCallbacker bool) ([]func(), error) {
 	cacheStore, err := cmd.NewCacheStore(arcConfig.Cache)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cache store: %v", err)
@@ -88,16 +137,116 @@ func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, startAPI bo
 		}
 	}()
 
-	go func() {
-		if arcConfig.Prometheus.IsEnabled() {
-			logger.Info("Starting prometheus", slog.String("endpoint", arcConfig.Prometheus.Endpoint))
-			http.Handle(arcConfig.Prometheus.Endpoint, promhttp.Handler())
-			err = http.ListenAndServe(arcConfig.Prometheus.Addr, nil)
+	if arcConfig.ContinuousProfiling != nil && arcConfig.ContinuousProfiling.Enabled {
+		cfg := arcConfig.ContinuousProfiling
+		continuousProfiler := profiler.New(logger, cfg.Dir,
+			profiler.WithCheckInterval(cfg.CheckInterval),
+			profiler.WithHeapThreshold(cfg.HeapThresholdMB*1024*1024),
+			profiler.WithSchedLatencyThreshold(cfg.SchedLatencyThreshold),
+			profiler.WithCPUProfileDuration(cfg.CPUProfileDuration),
+			profiler.WithCooldown(cfg.Cooldown),
+			profiler.WithUploadCommand(cfg.UploadCommand),
+		)
+
+		err = continuousProfiler.Start()
+		if err != nil {
+			return nil, fmt.Errorf("failed to start continuous profiler: %v", err)
+		}
+		shutdownFns = append(shutdownFns, continuousProfiler.Shutdown)
+	}
+
+	if arcConfig.IsMetricsEnabled() {
+		err = prometheus.Register(supervisor.RoutinePanics())
+		if err != nil {
+			return nil, fmt.Errorf("failed to register routine panic metrics: %v", err)
+		}
+
+		for _, collector := range httpclient.Collectors() {
+			err = prometheus.Register(collector)
 			if err != nil {
-				logger.Error("failed to start prometheus server", slog.String("err", err.Error()))
+				return nil, fmt.Errorf("failed to register HTTP client metrics: %v", err)
 			}
 		}
-	}()
+
+		for _, collector := range scheduler.Collectors() {
+			err = prometheus.Register(collector)
+			if err != nil {
+				return nil, fmt.Errorf("failed to register scheduler metrics: %v", err)
+			}
+		}
+
+		for _, collector := range reverification.Collectors() {
+			err = prometheus.Register(collector)
+			if err != nil {
+				return nil, fmt.Errorf("failed to register Merkle roots re-verification metrics: %v", err)
+			}
+		}
+
+		for _, collector := range events.Collectors() {
+			err = prometheus.Register(collector)
+			if err != nil {
+				return nil, fmt.Errorf("failed to register event bus metrics: %v", err)
+			}
+		}
+	}
+
+	// the services publish their domain events on the bus, to which the optional sinks subscribe
+	eventBus := events.New(logger)
+
+	var memGuard *memlimit.Guard
+	if arcConfig.Memory != nil {
+		cfg := arcConfig.Memory
+		if cfg.GCPercent != 0 {
+			debug.SetGCPercent(cfg.GCPercent)
+		}
+		if cfg.LimitMB > 0 {
+			debug.SetMemoryLimit(cfg.LimitMB * 1024 * 1024)
+		}
+
+		if cfg.Throttling {
+			memGuard = memlimit.New(logger,
+				memlimit.WithCheckInterval(cfg.CheckInterval),
+				memlimit.WithShrinkThreshold(cfg.ShrinkThreshold),
+				memlimit.WithPauseThreshold(cfg.PauseThreshold),
+			)
+			if arcConfig.IsMetricsEnabled() {
+				err = prometheus.Register(memGuard)
+				if err != nil {
+					return nil, fmt.Errorf("failed to register memory limit metrics: %v", err)
+				}
+			}
+			memGuard.Start()
+		}
+	}
+
+	var components []string
+	for component, started := range map[string]bool{"api": startAPI, "metamorph": startMetamorph, "blocktx": startBlockTx} {
+		if started {
+			components = append(components, component)
+		}
+	}
+
+	featureFlags, err := cmd.NewFeatureFlags(logger, arcConfig.FeatureFlags, cacheStore, components...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create feature flags: %v", err)
+	}
+	if featureFlags != nil {
+		// feature flags are shown read-only on the profiler server, they are overridden through the admin service
+		http.Handle("/debug/features", featureFlags)
+		featureFlags.Start()
+	}
+
+	metricsBackend, err := cmd.NewMetricsBackend(logger, arcConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics backend: %v", err)
+	}
+	if metricsBackend != nil {
+		err = metricsBackend.Start()
+		if err != nil {
+			return nil, fmt.Errorf("failed to start metrics backend: %v", err)
+		}
+		shutdownFns = append(shutdownFns, metricsBackend.Shutdown)
+	}
 
 	if !isAnyFlagPassed("api", "blocktx", "metamorph", "k8s-watcher", "callbacker") {
 		logger.Info("No service selected, starting all")
@@ -107,9 +256,92 @@ func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, startAPI bo
 		startCallbacker = true
 	}
 
+	if arcConfig.IsAllInOneEnabled() {
+		// the services are connected in-process, therefore all of them have to run in this process
+		logger.Info("All-in-one mode, starting all services in-process")
+		startAPI = true
+		startMetamorph = true
+		startBlockTx = true
+		startCallbacker = true
+	}
+
+	var stopMigrations func()
+	if arcConfig.Migrations != nil && arcConfig.Migrations.Enabled {
+		runner, err := cmd.MigrateDatabases(logger, arcConfig, false, startMetamorph, startBlockTx, startCallbacker)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate databases: %v", err)
+		}
+
+		// schema versions of the databases and the progress of the backfills are reported on the profiler server
+		http.Handle("/debug/migrations", runner)
+		stopMigrations = runner.Shutdown
+	} else {
+		runner, err := cmd.CheckDatabases(logger, arcConfig, startMetamorph, startBlockTx, startCallbacker)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check database schemas: %v", err)
+		}
+
+		http.Handle("/debug/migrations", runner)
+		stopMigrations = runner.Shutdown
+	}
+
+	var banOpts []p2p.BanManagerOption
+	if arcConfig.PeerBan != nil {
+		cfg := arcConfig.PeerBan
+		banOpts = append(banOpts,
+			p2p.WithBanThreshold(cfg.Threshold),
+			p2p.WithInvalidMessageWeight(cfg.InvalidMessageWeight),
+			p2p.WithBanScoreDecay(cfg.Decay),
+			p2p.WithBanDuration(cfg.BanDuration),
+			p2p.WithBanFile(cfg.File),
+		)
+	}
+
+	// ban scores and bans of the peers are shared by metamorph and blocktx and are managed through the admin service
+	banManager, err := p2p.NewBanManager(logger, banOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create peer ban manager: %v", err)
+	}
+
+	messageMetrics := p2p.NewMessageMetrics()
+	if arcConfig.IsMetricsEnabled() {
+		err = prometheus.Register(messageMetrics)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register p2p message metrics: %v", err)
+		}
+	}
+
+	peerOpts := []p2p.PeerOptions{
+		p2p.WithBanManager(banManager),
+		p2p.WithMessageMetrics(messageMetrics),
+	}
+
+	if cfg := arcConfig.PeerProtocol; cfg != nil {
+		peerOpts = append(peerOpts,
+			p2p.WithProtocolVersion(cfg.Version),
+			p2p.WithMinimumProtocolVersion(cfg.MinimumVersion),
+			p2p.WithRequiredServices(wire.ServiceFlag(cfg.RequiredServices)),
+		)
+	}
+
+	var dumper *p2p.MessageDumper
+	if arcConfig.PeerMessageDump != nil && arcConfig.PeerMessageDump.Enabled {
+		cfg := arcConfig.PeerMessageDump
+		dumper, err = p2p.NewMessageDumper(cfg.File, cfg.Peers,
+			p2p.WithDumpMaxFileSize(cfg.MaxFileSizeMB*1024*1024),
+			p2p.WithDumpMaxBackups(cfg.MaxBackups),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create p2p message dumper: %v", err)
+		}
+		logger.Warn("Dumping p2p messages", slog.Any("peers", cfg.Peers), slog.String("file", cfg.File))
+
+		peerOpts = append(peerOpts, p2p.WithMessageDumper(dumper))
+	}
+
 	if startBlockTx {
 		logger.Info("Starting BlockTx")
-		shutdown, err := cmd.StartBlockTx(logger, arcConfig)
+		shutdown, err := cmd.StartBlockTx(logger, arcConfig, peerOpts, banManager, memGuard, featureFlags, eventBus, logLevels)
 		if err != nil {
 			return nil, fmt.Errorf("failed to start blocktx: %v", err)
 		}
@@ -118,7 +350,7 @@ func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, startAPI bo
 
 	if startMetamorph {
 		logger.Info("Starting Metamorph")
-		shutdown, err := cmd.StartMetamorph(logger, arcConfig, cacheStore)
+		shutdown, err := cmd.StartMetamorph(logger, arcConfig, cacheStore, peerOpts, banManager, memGuard, featureFlags, eventBus, logLevels)
 		if err != nil {
 			return nil, fmt.Errorf("failed to start metamorph: %v", err)
 		}
@@ -127,7 +359,7 @@ func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, startAPI bo
 
 	if startAPI {
 		logger.Info("Starting API")
-		shutdown, err := cmd.StartAPIServer(logger, arcConfig)
+		shutdown, err := cmd.StartAPIServer(logger, arcConfig, featureFlags, eventBus, logLevels)
 		if err != nil {
 			return nil, fmt.Errorf("failed to start api: %v", err)
 		}
@@ -145,12 +377,35 @@ func startServices(arcConfig *config.ArcConfig, logger *slog.Logger, startAPI bo
 	}
 
 	if startCallbacker {
-		shutdown, err := cmd.StartCallbacker(logger, arcConfig)
+		shutdown, err := cmd.StartCallbacker(logger, arcConfig, eventBus, logLevels)
 		if err != nil {
 			return nil, fmt.Errorf("failed to start callbacker: %v", err)
 		}
 		shutdownFns = append(shutdownFns, shutdown)
 	}
+
+	// the subscribers handle the events published until the services are shut down
+	shutdownFns = append(shutdownFns, eventBus.Shutdown)
+
+	if memGuard != nil {
+		// stop the memory guard after the processors are shut down, so that paused processors are not resumed early
+		shutdownFns = append(shutdownFns, memGuard.Shutdown)
+	}
+
+	if featureFlags != nil {
+		shutdownFns = append(shutdownFns, featureFlags.Shutdown)
+	}
+
+	if dumper != nil {
+		// close the dump file after the peers are shut down
+		shutdownFns = append(shutdownFns, func() { _ = dumper.Close() })
+	}
+
+	if stopMigrations != nil {
+		// stop the backfills after the services are shut down
+		shutdownFns = append(shutdownFns, stopMigrations)
+	}
+
 	return shutdownFns, nil
 }
 
@@ -161,7 +416,7 @@ func appCleanup(logger *slog.Logger, shutdownFns []func()) {
 	}
 }
 
-func parseFlags() (string, bool, bool, bool, bool, bool, string) {
+func parseFlags() (string, bool, bool, bool, bool, bool, string, bool, string, string, string, string, bool) {
 	startAPI := flag.Bool("api", false, "start ARC api server")
 	startMetamorph := flag.Bool("metamorph", false, "start metamorph")
 	startBlockTx := flag.Bool("blocktx", false, "start blocktx")
@@ -170,11 +425,20 @@ func parseFlags() (string, bool, bool, bool, bool, bool, string) {
 	help := flag.Bool("help", false, "Show help")
 	dumpConfigFile := flag.String("dump_config", "", "dump config to specified file and exit")
 	configDir := flag.String("config", "", "path to configuration file")
+	migrateDryRun := flag.Bool("migrate_dry_run", false, "log pending database migrations and exit")
+	exportFile := flag.String("export", "", "export the transactions matching the export query to specified file and exit")
+	exportQuery := flag.String("export_query", "", "query selecting the exported transactions")
+	backupDir := flag.String("backup", "", "back up the databases to specified directory and exit")
+	restoreDir := flag.String("restore", "", "restore the databases from the backup in specified directory and exit")
+	runPreflight := flag.Bool("preflight", false, "check the connectivity to all configured dependencies, print the report and exit")
 
 	flag.Parse()
 
 	if *help {
 		fmt.Println("usage: main [options]")
+		fmt.Println("       main config init [-profile=<dev|production|federation>] [options]")
+		fmt.Println("          generate a commented config file for a deployment profile, see `main config init -help`")
+		fmt.Println("")
 		fmt.Println("where options are:")
 		fmt.Println("")
 		fmt.Println("    -api=<true|false>")
@@ -198,10 +462,28 @@ func parseFlags() (string, bool, bool, bool, bool, bool, string) {
 		fmt.Println("    -dump_config=/file.yaml")
 		fmt.Println("          dump config to specified file and exit (default='config/dumped_config.yaml')")
 		fmt.Println("")
+		fmt.Println("    -migrate_dry_run=<true|false>")
+		fmt.Println("          log pending database migrations and exit (default=false)")
+		fmt.Println("")
+		fmt.Println("    -export=/file.csv")
+		fmt.Println("          export the transactions of the metamorph database matching -export_query to specified file and exit (default='')")
+		fmt.Println("")
+		fmt.Println("    -export_query='from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&status=MINED,REJECTED&tenant=exchange-a&format=csv'")
+		fmt.Println("          query selecting the exported transactions, the range defaults to the last 24 hours and the format to ndjson (default='')")
+		fmt.Println("")
+		fmt.Println("    -backup=/directory")
+		fmt.Println("          take consistent snapshots of the databases of blocktx, metamorph and callbacker, write them to specified directory and exit (default='')")
+		fmt.Println("")
+		fmt.Println("    -restore=/directory")
+		fmt.Println("          migrate the empty databases and restore the backup in specified directory to them and exit (default='')")
+		fmt.Println("")
+		fmt.Println("    -preflight=<true|false>")
+		fmt.Println("          check the connectivity to all configured dependencies, print the report as JSON and exit, non-zero if any check failed (default=false)")
+		fmt.Println("")
 		os.Exit(0)
 	}
 
-	return *configDir, *startAPI, *startMetamorph, *startBlockTx, *startK8sWatcher, *startCallbacker, *dumpConfigFile
+	return *configDir, *startAPI, *startMetamorph, *startBlockTx, *startK8sWatcher, *startCallbacker, *dumpConfigFile, *migrateDryRun, *exportFile, *exportQuery, *backupDir, *restoreDir, *runPreflight
 }
 
 func isAnyFlagPassed(flags ...string) bool {
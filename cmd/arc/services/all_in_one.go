@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"log/slog"
+	"sync"
+
+	"google.golang.org/grpc"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/mq"
+)
+
+var (
+	inProcessNetwork     *grpc_utils.InProcessNetwork
+	inProcessNetworkOnce sync.Once
+	inProcessBroker      *mq.InProcessBroker
+	inProcessBrokerOnce  sync.Once
+)
+
+// getInProcessNetwork returns the in-memory network connecting the gRPC clients and servers of the services in the
+// all-in-one mode.
+func getInProcessNetwork() *grpc_utils.InProcessNetwork {
+	inProcessNetworkOnce.Do(func() {
+		inProcessNetwork = grpc_utils.NewInProcessNetwork()
+	})
+
+	return inProcessNetwork
+}
+
+// getInProcessBroker returns the broker replacing the message queue in the all-in-one mode.
+func getInProcessBroker(logger *slog.Logger, cfg *config.AllInOneConfig) *mq.InProcessBroker {
+	inProcessBrokerOnce.Do(func() {
+		inProcessBroker = mq.NewInProcessBroker(logger, mq.WithInProcessBufferSize(cfg.MessageBufferSize))
+	})
+
+	return inProcessBroker
+}
+
+// dialGrpc connects to the gRPC server of the service at the address, in the all-in-one mode to the server in the
+// process regardless of the address.
+func dialGrpc(arcConfig *config.ArcConfig, service string, address string, tracingConfig *config.TracingConfig, auth *config.GrpcAuthConfig) (*grpc.ClientConn, error) {
+	if arcConfig.IsAllInOneEnabled() {
+		return getInProcessNetwork().DialGRPC(service, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, tracingConfig, auth, arcConfig.GrpcClient)
+	}
+
+	return grpc_utils.DialGRPC(address, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, tracingConfig, auth, arcConfig.GrpcClient)
+}
+
+// serveGrpc serves the gRPC server of the service at the address, in the all-in-one mode only to the clients in the
+// process.
+func serveGrpc(arcConfig *config.ArcConfig, server *grpc_utils.GrpcServer, service string, address string) error {
+	if arcConfig.IsAllInOneEnabled() {
+		server.Serve(getInProcessNetwork().Listen(service), service)
+		return nil
+	}
+
+	return server.ListenAndServe(address)
+}
@@ -7,14 +7,16 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
-	goscript "github.com/bitcoin-sv/bdk/module/gobdk/script"
 	"github.com/bsv-blockchain/go-sdk/transaction/chaintracker"
 	"github.com/google/uuid"
 	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	echomiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/ordishs/go-bitcoin"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -22,43 +24,73 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/api/admission"
+	"github.com/bitcoin-sv/arc/internal/api/compliance"
+	"github.com/bitcoin-sv/arc/internal/api/compliance/compliance_api"
+	"github.com/bitcoin-sv/arc/internal/api/concurrency"
+	"github.com/bitcoin-sv/arc/internal/api/dashboard"
+	"github.com/bitcoin-sv/arc/internal/api/digest"
+	feestats "github.com/bitcoin-sv/arc/internal/api/fee_stats"
 	apiHandler "github.com/bitcoin-sv/arc/internal/api/handler"
 	"github.com/bitcoin-sv/arc/internal/api/handler/merkle_verifier"
+	"github.com/bitcoin-sv/arc/internal/api/receipt"
+	"github.com/bitcoin-sv/arc/internal/api/reverification"
+	templatestats "github.com/bitcoin-sv/arc/internal/api/template_stats"
 	"github.com/bitcoin-sv/arc/internal/blocktx"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/canary"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/federation"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/ingester"
+	"github.com/bitcoin-sv/arc/internal/k8s_watcher/k8s_client"
 	arc_logger "github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	"github.com/bitcoin-sv/arc/internal/node_client"
+	"github.com/bitcoin-sv/arc/internal/script_verifier"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
+	"github.com/bitcoin-sv/arc/internal/tasks"
 	tx_finder "github.com/bitcoin-sv/arc/internal/tx_finder"
+	"github.com/bitcoin-sv/arc/internal/validator"
 	beefValidator "github.com/bitcoin-sv/arc/internal/validator/beef"
 	defaultValidator "github.com/bitcoin-sv/arc/internal/validator/default"
 	"github.com/bitcoin-sv/arc/pkg/api"
-	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+	apiv2 "github.com/bitcoin-sv/arc/pkg/api/v2"
+	"github.com/bitcoin-sv/arc/pkg/events"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+	"github.com/bitcoin-sv/arc/pkg/keyset"
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/nats_connection"
 	"github.com/bitcoin-sv/arc/pkg/rpc_client"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 	"github.com/bitcoin-sv/arc/pkg/woc_client"
 )
 
-func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), error) {
+func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig, featureFlags *feature.Flags, eventBus *events.Bus, logLevels *arc_logger.Levels) (func(), error) {
 	logger = logger.With(slog.String("service", "api"))
 	logger.Info("Starting")
 	var (
 		mqClient             mq.MessageQueueClient
 		echoServer           *echo.Echo
-		err                  error
 		merkleVerifierClient *merkle_verifier.Client
 	)
 
+	networks, err := networkNames(arcConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	echoServer = setAPIEcho(logger, arcConfig.API)
+	apiHandler.RouteNetworks(echoServer, arcConfig.Network, networks)
 
 	// load the ARC handler from config
 	// If you want to customize this for your own server, see examples dir
 	// check the swagger definition against our requests
-	apiHandler.CheckSwagger(echoServer)
+	apiHandler.CheckSwagger(echoServer, admin.PathPrefix, dashboard.PathPrefix)
 
 	shutdownFns := make([]func(), 0)
 	stopFn := func() {
@@ -69,7 +101,12 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 
 	connOpts := []nats_connection.Option{nats_connection.WithMaxReconnects(-1)}
 
-	mqClient, err = mq.NewMqClient(logger, arcConfig.MessageQueue, []nats_jetstream.Option{}, connOpts)
+	sup := newSupervisor(logger, arcConfig.Supervisor)
+	shutdownFns = append(shutdownFns, sup.Shutdown)
+
+	mqClient, err = supervisor.Retry(sup, "message queue", func() (mq.MessageQueueClient, error) {
+		return newMqClient(logger, arcConfig, "api", getMqTracerOpts(arcConfig), connOpts)
+	})
 	if err != nil {
 		stopFn()
 		return nil, err
@@ -77,6 +114,7 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 
 	mtmOpts := []func(*metamorph.Metamorph){
 		metamorph.WithMqClient(mqClient),
+		metamorph.WithQueueSubmissions(arcConfig.GrpcClient != nil && arcConfig.GrpcClient.QueueSubmissions),
 		metamorph.WithLogger(logger),
 	}
 
@@ -84,10 +122,203 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 		apiHandler.WithCallbackURLRestrictions(arcConfig.Metamorph.RejectCallbackContaining),
 		apiHandler.WithRebroadcastExpiration(arcConfig.ReBroadcastExpiration),
 		apiHandler.WithStandardFormatSupported(arcConfig.API.StandardFormatSupported),
+		apiHandler.WithKnownTxCache(arcConfig.API.KnownTxCacheTTL),
+		apiHandler.WithStatusMapping(toStatusMappingRules(arcConfig.API.StatusMapping)),
+		apiHandler.WithFeatureFlags(featureFlags),
+		apiHandler.WithTenants(toTenants(arcConfig.API.Tenants)),
+		apiHandler.WithReadOnly(arcConfig.API.ReadOnly),
+		apiHandler.WithStreamBatchSize(arcConfig.API.StreamBatchSize),
+	}
+
+	if arcConfig.API.ReadOnly {
+		logger.Warn("API in read-only mode, submissions are rejected")
+	}
+
+	switch arcConfig.API.NonFinalTxs {
+	case "", config.NonFinalTxsReject:
+	case config.NonFinalTxsHold:
+		apiOpts = append(apiOpts, apiHandler.WithHoldNonFinal(true))
+	default:
+		stopFn()
+		return nil, fmt.Errorf("invalid policy for non-final transactions: %s", arcConfig.API.NonFinalTxs)
+	}
+
+	// the handlers of the other networks share the options which do not depend on the network
+	networkOpts := slices.Clone(apiOpts)
+
+	if arcConfig.API.ExternalStatusLookup {
+		externalStatusNode, err := newNodeRPCClient(arcConfig.PeerRPC)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create node client for external status lookup: %v", err)
+		}
+		apiOpts = append(apiOpts, apiHandler.WithExternalStatusLookup(externalStatusNode))
+	}
+
+	if arcConfig.API.Receipts != nil && arcConfig.API.Receipts.Enabled {
+		signer, err := receipt.NewSigner(arcConfig.API.Receipts.MinerIDKey)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create receipt signer: %v", err)
+		}
+		receiptCacheStore, err := NewCacheStore(arcConfig.Cache)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create cache store for receipts: %v", err)
+		}
+		apiOpts = append(apiOpts, apiHandler.WithReceipts(signer, receipt.NewStore(receiptCacheStore, arcConfig.API.Receipts.Retention)))
+	}
+
+	if arcConfig.API.MempoolAcceptance != nil && arcConfig.API.MempoolAcceptance.Enabled {
+		pc := arcConfig.PeerRPC
+		rpcClient, err := rpc_client.NewRPCClient(pc.Host, pc.Port, pc.User, pc.Password)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create node client for mempool acceptance: %v", err)
+		}
+		mempoolAcceptance, err := node_client.NewMempoolAcceptance(rpcClient, arcConfig.API.MempoolAcceptance.Method)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+		apiOpts = append(apiOpts, apiHandler.WithMempoolAcceptance(mempoolAcceptance))
+	}
+
+	if arcConfig.API.StatusCacheTTL > 0 {
+		statusCacheStore, err := NewCacheStore(arcConfig.Cache)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create cache store for status cache: %v", err)
+		}
+		apiOpts = append(apiOpts, apiHandler.WithStatusCache(statusCacheStore, arcConfig.API.StatusCacheTTL))
+	}
+
+	if arcConfig.API.Deduplication != nil && arcConfig.API.Deduplication.Enabled {
+		claimStore, err := NewCacheStore(arcConfig.Cache)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create cache store for transaction claims: %v", err)
+		}
+		if arcConfig.Cache.Engine != config.Redis {
+			logger.Warn("Transaction claims are not shared with other API instances without the redis cache engine")
+		}
+		apiOpts = append(apiOpts, apiHandler.WithTxClaims(claimStore, arcConfig.API.Deduplication.ClaimTTL, arcConfig.API.Deduplication.Wait))
+	}
+
+	if arcConfig.API.Federation != nil && arcConfig.API.Federation.Enabled {
+		apiFederation, err := newFederation(logger, arcConfig)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create federation: %v", err)
+		}
+
+		apiFederation.Start()
+		shutdownFns = append(shutdownFns, apiFederation.Shutdown)
+		apiOpts = append(apiOpts, apiHandler.WithFederation(apiFederation))
+	}
+
+	scriptPolicies, err := toScriptPolicies(arcConfig.API.ScriptPolicies)
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+	apiOpts = append(apiOpts, apiHandler.WithScriptPolicies(scriptPolicies))
+
+	if bcNetwork := arcConfig.Metamorph.BlockchainNetwork; bcNetwork != nil {
+		var designatedPeers []string
+		for _, peer := range bcNetwork.Peers {
+			if peer.Name != "" {
+				designatedPeers = append(designatedPeers, peer.Name)
+			}
+		}
+		apiOpts = append(apiOpts, apiHandler.WithDesignatedPeers(designatedPeers...))
+	}
+
+	opcodeLimits, err := toOpcodeLimits(arcConfig.API.OpcodeLimits)
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+	apiOpts = append(apiOpts, apiHandler.WithOpcodeLimits(opcodeLimits))
+
+	if arcConfig.API.TemplateStats != nil && arcConfig.API.TemplateStats.Enabled {
+		detector, err := newTemplateStats(logger, arcConfig.API.TemplateStats)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+		apiOpts = append(apiOpts, apiHandler.WithTemplateStats(detector))
+	}
+
+	if arcConfig.API.AbuseScoring != nil && arcConfig.API.AbuseScoring.Enabled {
+		scorer, err := newAbuseScorer(logger, arcConfig.API.AbuseScoring)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+		apiOpts = append(apiOpts, apiHandler.WithAbuseScoring(scorer))
+	}
+
+	if arcConfig.API.Admission != nil && arcConfig.API.Admission.Enabled {
+		controller, err := admission.New(logger, arcConfig.API.Admission.MemoryBudget, admission.WithQueueTimeout(arcConfig.API.Admission.QueueTimeout))
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create admission controller: %v", err)
+		}
+		apiOpts = append(apiOpts, apiHandler.WithAdmissionControl(controller))
+		networkOpts = append(networkOpts, apiHandler.WithAdmissionControl(controller))
+	}
+
+	if arcConfig.API.ConcurrencyLimits != nil && arcConfig.API.ConcurrencyLimits.Enabled {
+		limiter, err := newConcurrencyLimiter(logger, arcConfig.API.ConcurrencyLimits)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create concurrency limiter: %v", err)
+		}
+		shutdownFns = append(shutdownFns, limiter.UnregisterStats)
+		echoServer.Use(limiter.Middleware())
+	}
+
+	if arcConfig.API.Compliance != nil && arcConfig.API.Compliance.Enabled {
+		checker, err := newComplianceChecker(logger, arcConfig)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+		apiOpts = append(apiOpts, apiHandler.WithCompliance(checker))
+	}
+
+	if arcConfig.API.CrashReportDir != "" {
+		apiOpts = append(apiOpts, apiHandler.WithCrashReporter(validator.NewFileCrashReporter(logger, arcConfig.API.CrashReportDir)))
+	}
+
+	if arcConfig.API.Dashboard != nil && arcConfig.API.Dashboard.Enabled {
+		operatorDashboard := dashboard.New(logger,
+			dashboard.WithMetricsSources(arcConfig.API.Dashboard.MetricsSources...),
+			dashboard.WithRecentRejections(arcConfig.API.Dashboard.RecentRejections),
+		)
+		operatorDashboard.Register(echoServer)
+		apiOpts = append(apiOpts, apiHandler.WithSubmissionRecorder(operatorDashboard))
+	}
+
+	if arcConfig.API.RejectionDigest != nil && arcConfig.API.RejectionDigest.Enabled {
+		rejectionDigest, err := newRejectionDigest(logger, arcConfig.API)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create rejection digest: %v", err)
+		}
+
+		err = rejectionDigest.Start()
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to start rejection digest: %v", err)
+		}
+		shutdownFns = append(shutdownFns, rejectionDigest.Shutdown)
+		apiOpts = append(apiOpts, apiHandler.WithRejectionDigest(rejectionDigest))
 	}
 
 	var merkleVerifierOpts []merkle_verifier.Option
-	if arcConfig.Prometheus.IsEnabled() {
+	if arcConfig.IsMetricsEnabled() {
 		handlerStats, err := apiHandler.NewStats()
 		if err != nil {
 			stopFn()
@@ -101,13 +332,23 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 		defaultValidator.WithStandardFormatSupported(arcConfig.API.StandardFormatSupported),
 	}
 	var beefValidatorOpts []beefValidator.Option
+	if arcConfig.API.Consolidation != nil && arcConfig.API.Consolidation.Enabled {
+		apiOpts = append(apiOpts, apiHandler.WithConsolidationFee(arcConfig.API.Consolidation.MinMiningTxFee))
+		defaultValidatorOpts = append(defaultValidatorOpts, defaultValidator.WithConsolidationFee(arcConfig.API.Consolidation.MinMiningTxFee))
+		beefValidatorOpts = append(beefValidatorOpts, beefValidator.WithConsolidationFee(arcConfig.API.Consolidation.MinMiningTxFee))
+	}
+	if arcConfig.API.FastPath != nil && arcConfig.API.FastPath.Enabled {
+		apiOpts = append(apiOpts, apiHandler.WithFastPath(arcConfig.API.FastPath.MaxSize))
+		defaultValidatorOpts = append(defaultValidatorOpts, defaultValidator.WithFastPath(arcConfig.API.FastPath.MaxSize))
+		beefValidatorOpts = append(beefValidatorOpts, beefValidator.WithFastPath(arcConfig.API.FastPath.MaxSize))
+	}
 	var cachedFinderOpts []func(f *tx_finder.CachedFinder)
 	var finderOpts []func(f *tx_finder.Finder)
 	var nodeClientOpts []func(client *node_client.NodeClient)
 	wocClientOpts := []func(client *woc_client.WocClient){woc_client.WithAuth(arcConfig.API.WocAPIKey)}
 
 	if arcConfig.IsTracingEnabled() {
-		cleanup, err := tracing.Enable(logger, "api", arcConfig.Tracing.DialAddr, arcConfig.Tracing.Sample)
+		cleanup, err := tracing.EnableWithOptions(logger, "api", arcConfig.Tracing.DialAddr, getTracingOpts(arcConfig.Tracing)...)
 		if err != nil {
 			logger.Error("failed to enable tracing", slog.String("err", err.Error()))
 		} else {
@@ -131,24 +372,35 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 		beefValidatorOpts = append(beefValidatorOpts, beefValidator.WithTracer(attributes...))
 	}
 
-	conn, err := grpc_utils.DialGRPC(arcConfig.Metamorph.DialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing)
+	conn, err := dialGrpc(arcConfig, "metamorph", arcConfig.Metamorph.DialAddr, arcConfig.Tracing, arcConfig.Metamorph.GrpcAuth)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("failed to connect to metamorph server: %v", err)
 	}
 
+	metamorphClient := metamorph_api.NewMetaMorphAPIClient(conn)
 	mtmClient := metamorph.NewClient(
-		metamorph_api.NewMetaMorphAPIClient(conn),
+		metamorphClient,
 		mtmOpts...,
 	)
 
-	btcConn, err := grpc_utils.DialGRPC(arcConfig.Blocktx.DialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing)
+	btcConn, err := dialGrpc(arcConfig, "blocktx", arcConfig.Blocktx.DialAddr, arcConfig.Tracing, arcConfig.Blocktx.GrpcAuth)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("failed to connect to blocktx server: %v", err)
 	}
 	blockTxClient := blocktx.NewClient(blocktx_api.NewBlockTxAPIClient(btcConn))
 
+	if arcConfig.API.FeeStats != nil && arcConfig.API.FeeStats.Enabled {
+		aggregator := feestats.New(logger, mtmClient,
+			feestats.WithWindow(arcConfig.API.FeeStats.Window),
+			feestats.WithInterval(arcConfig.API.FeeStats.Interval),
+			feestats.WithMaxPending(arcConfig.API.FeeStats.MaxPending),
+		)
+		aggregator.Start()
+		apiOpts = append(apiOpts, apiHandler.WithFeeStats(aggregator))
+	}
+
 	var policy *bitcoin.Settings
 	policy, err = getPolicyFromNode(arcConfig.PeerRPC)
 	if err != nil {
@@ -174,58 +426,142 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 	finder := tx_finder.New(mtmClient, nodeClient, wocClient, logger, finderOpts...)
 	cachedFinder := tx_finder.NewCached(finder, cachedFinderOpts...)
 
-	var network string
-	var genesisBlock int32
 	var chainTracker beefValidator.ChainTracker
-	bhsDefined := len(arcConfig.API.MerkleRootVerification.BlockHeaderServices) != 0
+	var negativeCache *merkle_verifier.NegativeCache
+	discoveryCfg := arcConfig.API.MerkleRootVerification.Discovery
+	discoveryEnabled := discoveryCfg != nil && discoveryCfg.Enabled
+	bhsDefined := len(arcConfig.API.MerkleRootVerification.BlockHeaderServices) != 0 || discoveryEnabled
 
 	if bhsDefined {
 		var chainTrackers []*merkle_verifier.ChainTracker
 		if arcConfig.API.MerkleRootVerification.Timeout > 0 {
 			merkleVerifierOpts = append(merkleVerifierOpts, merkle_verifier.WithTimeout(arcConfig.API.MerkleRootVerification.Timeout))
 		}
+		policy, err := merkle_verifier.ParseVerificationPolicy(arcConfig.API.MerkleRootVerification.Policy)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to parse verification policy of block header services: %v", err)
+		}
+		merkleVerifierOpts = append(merkleVerifierOpts, merkle_verifier.WithVerificationPolicy(policy))
+
+		negativeCacheCfg := arcConfig.API.MerkleRootVerification.NegativeCache
+		if negativeCacheCfg != nil && negativeCacheCfg.Enabled {
+			negativeCache, err = merkle_verifier.NewNegativeCache(negativeCacheCfg.Path, negativeCacheCfg.TTL)
+			if err != nil {
+				stopFn()
+				return nil, fmt.Errorf("failed to load negative cache of Merkle roots: %v", err)
+			}
+			merkleVerifierOpts = append(merkleVerifierOpts, merkle_verifier.WithNegativeCache(negativeCache))
+		}
 		for _, bhs := range arcConfig.API.MerkleRootVerification.BlockHeaderServices {
-			ct := merkle_verifier.NewChainTracker(bhs.URL, bhs.APIKey)
+			auth, err := newChainTrackerAuth(bhs)
+			if err != nil {
+				stopFn()
+				return nil, fmt.Errorf("failed to create authentication of block header service %s: %v", bhs.URL, err)
+			}
+
+			ct := merkle_verifier.NewChainTracker(bhs.URL, bhs.APIKey, merkle_verifier.WithAuth(auth))
 			chainTrackers = append(chainTrackers, ct)
 		}
 
+		if discoveryEnabled {
+			discoverer, err := newChainTrackerDiscoverer(discoveryCfg)
+			if err != nil {
+				stopFn()
+				return nil, fmt.Errorf("failed to create discovery of block header services: %v", err)
+			}
+
+			auth, err := newChainTrackerAuth(discoveryCfg.Service)
+			if err != nil {
+				stopFn()
+				return nil, fmt.Errorf("failed to create authentication of discovered block header services: %v", err)
+			}
+
+			newChainTracker := func(url string) *merkle_verifier.ChainTracker {
+				return merkle_verifier.NewChainTracker(url, discoveryCfg.Service.APIKey, merkle_verifier.WithAuth(auth))
+			}
+			merkleVerifierOpts = append(merkleVerifierOpts, merkle_verifier.WithDiscovery(discoverer, newChainTracker, discoveryCfg.Interval))
+		}
+
 		merkleVerifierClient = merkle_verifier.NewClient(logger, chainTrackers, merkleVerifierOpts...)
+		merkleVerifierClient.Subscribe(func(event merkle_verifier.AvailabilityEvent) {
+			if event.Available {
+				logger.Info("Block header service became available", slog.String("url", event.URL), slog.Time("lastSuccess", event.LastSuccess))
+				return
+			}
+			logger.Error("Block header service became unavailable", slog.String("url", event.URL), slog.String("reason", event.Reason), slog.Time("lastSuccess", event.LastSuccess))
+			eventBus.Publish(events.TrackerUnavailable{
+				URL:         event.URL,
+				Reason:      event.Reason,
+				LastSuccess: event.LastSuccess,
+				Timestamp:   event.Timestamp,
+			})
+		})
 		chainTracker = merkleVerifierClient
+		apiOpts = append(apiOpts, apiHandler.WithBlockHeaderServices(merkleVerifierClient.BlockHeaderServiceStatuses))
 	}
 
-	switch arcConfig.Network {
-	case "testnet":
-		network = "test"
-		if !bhsDefined {
-			chainTracker = chaintracker.NewWhatsOnChain(chaintracker.TestNet, arcConfig.API.WocAPIKey)
-		}
-		genesisBlock = apiHandler.GenesisForkBlockTest
-	case "mainnet":
-		network = "main"
-		if !bhsDefined {
-			chainTracker = chaintracker.NewWhatsOnChain(chaintracker.MainNet, arcConfig.API.WocAPIKey)
+	network, genesisBlock, networkChainTracker, err := chainParams(arcConfig.Network, arcConfig.API.WocAPIKey, blockTxClient)
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+	if !bhsDefined {
+		chainTracker = networkChainTracker
+	}
+
+	degradedMode, err := reverification.ParseMode(arcConfig.API.MerkleRootVerification.DegradedMode)
+	if err != nil {
+		stopFn()
+		return nil, fmt.Errorf("failed to parse degraded mode of Merkle root verification: %v", err)
+	}
+	if degradedMode != reverification.ModeReject {
+		var reverifierOpts []func(*reverification.Reverifier)
+		if cfg := arcConfig.API.MerkleRootVerification.Reverification; cfg != nil {
+			if cfg.Interval > 0 {
+				reverifierOpts = append(reverifierOpts, reverification.WithInterval(cfg.Interval))
+			}
+			if cfg.MaxAge > 0 {
+				reverifierOpts = append(reverifierOpts, reverification.WithMaxAge(cfg.MaxAge))
+			}
+			if cfg.MaxTransactions > 0 {
+				reverifierOpts = append(reverifierOpts, reverification.WithMaxTransactions(cfg.MaxTransactions))
+			}
 		}
-		genesisBlock = apiHandler.GenesisForkBlockMain
-	case "regtest":
-		network = "regtest"
-		if !bhsDefined {
-			chainTracker = merkle_verifier.New(blocktx.MerkleRootsVerifier(blockTxClient), blockTxClient)
+
+		reverifier := reverification.New(logger, degradedMode, chainTracker, mtmClient, reverifierOpts...)
+		err = reverifier.Start()
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to start re-verification of Merkle roots: %v", err)
 		}
-		genesisBlock = apiHandler.GenesisForkBlockRegtest
-	default:
-		stopFn()
-		return nil, fmt.Errorf("invalid network type: %s", arcConfig.Network)
+		shutdownFns = append(shutdownFns, reverifier.Shutdown)
+		apiOpts = append(apiOpts, apiHandler.WithMerkleRootsReverifier(reverifier))
+		logger.Warn("Degraded mode of Merkle root verification enabled", slog.String("mode", string(degradedMode)))
 	}
 
-	dv := defaultValidator.New(
-		policy,
-		cachedFinder,
-		goscript.NewScriptEngine(network),
-		genesisBlock,
-		defaultValidatorOpts...,
-	)
+	logger.Info("Verifying scripts", slog.String("engine", script_verifier.Engine))
+
+	newValidators := func(policy *bitcoin.Settings) (apiHandler.DefaultValidator, apiHandler.BeefValidator) {
+		dv := defaultValidator.New(
+			policy,
+			cachedFinder,
+			script_verifier.New(network),
+			genesisBlock,
+			defaultValidatorOpts...,
+		)
+
+		bv := beefValidator.New(policy, chainTracker, script_verifier.New(network), genesisBlock, beefValidatorOpts...)
 
-	bv := beefValidator.New(policy, chainTracker, goscript.NewScriptEngine(network), genesisBlock, beefValidatorOpts...)
+		return dv, bv
+	}
+	dv, bv := newValidators(policy)
+
+	if arcConfig.API.Admin != nil && arcConfig.API.Admin.Enabled {
+		apiOpts = append(apiOpts, apiHandler.WithPolicyReload(func(_ context.Context) (*bitcoin.Settings, error) {
+			return getPolicyFromNode(arcConfig.PeerRPC)
+		}, newValidators))
+	}
 
 	defaultAPIHandler, err := apiHandler.NewDefault(logger, mtmClient, blockTxClient, policy, dv, bv, apiOpts...)
 	if err != nil {
@@ -235,6 +571,28 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 
 	defaultAPIHandler.StartUpdateCurrentBlockHeight()
 
+	subscriber, isSubscriber := mqClient.(mq.MessageSubscriber)
+	if (arcConfig.API.StatusCacheTTL > 0 || negativeCache != nil) && !isSubscriber {
+		stopFn()
+		return nil, mq.ErrSubscribeNotSupported
+	}
+
+	if arcConfig.API.StatusCacheTTL > 0 {
+		err = subscribeStatusUpdates(subscriber, defaultAPIHandler)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+	}
+
+	if negativeCache != nil {
+		err = subscribeReorgs(logger, subscriber, negativeCache)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+	}
+
 	serverCfg := grpc_utils.ServerConfig{
 		PrometheusEndpoint: arcConfig.Prometheus.Endpoint,
 		MaxMsgSize:         arcConfig.GrpcMessageSize,
@@ -253,11 +611,69 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 		return nil, fmt.Errorf("serve GRPC server failed: %v", err)
 	}
 
-	// Register the ARC API
+	if arcConfig.API.Admin != nil && arcConfig.API.Admin.Enabled {
+		var adminOpts []func(*admin.Server)
+
+		// the callback delivery reports of the callbacker are merged into the SLA reports and the callbacks stored by the
+		// callbacker are erased together with the client data of metamorph, and its log levels are changed over gRPC
+		if arcConfig.Callbacker != nil && arcConfig.Callbacker.DialAddr != "" {
+			callbackerClient, err := initGrpcCallbackerConn(arcConfig)
+			if err != nil {
+				stopFn()
+				return nil, fmt.Errorf("failed to create callbacker client: %v", err)
+			}
+			adminOpts = append(adminOpts, admin.WithCallbacker(callbackerClient))
+		}
+
+		taskManager := tasks.New(logger, tasks.WithRetention(arcConfig.API.Admin.TaskRetention))
+		shutdownFns = append(shutdownFns, taskManager.Shutdown)
+
+		adminOpts = append(adminOpts,
+			admin.WithTasks(taskManager),
+			admin.WithBlocktx(blocktx_api.NewBlockTxAPIClient(btcConn)),
+			admin.WithLogLevels(logLevels),
+			admin.WithFeatureFlags(featureFlags),
+		)
+
+		adminServer, tokens, err := startAdminServer(logger, arcConfig, metamorph_api.NewMetaMorphAPIClient(conn), defaultAPIHandler, adminOpts...)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+		shutdownFns = append(shutdownFns, adminServer.GracefulStop)
+
+		admin.RegisterSLAReportHandlers(echoServer, logger, adminServer, tokens)
+		admin.RegisterTaskHandlers(echoServer, logger, taskManager, tokens)
+
+		if arcConfig.Metamorph != nil && arcConfig.Metamorph.BulkExport != nil && arcConfig.Metamorph.BulkExport.Enabled {
+			exporter := bulk_export.New(logger, bulk_export.NewClientStore(metamorph_api.NewMetaMorphAPIClient(conn)),
+				bulk_export.WithBatchSize(arcConfig.Metamorph.BulkExport.BatchSize))
+			admin.RegisterExportHandlers(echoServer, logger, exporter, tokens)
+		}
+	}
+
+	// Register the ARC API, version 2 shares the internals of the default handler
 	api.RegisterHandlers(echoServer, defaultAPIHandler)
+	apiv2.RegisterHandlers(echoServer, apiHandler.NewDefaultV2(defaultAPIHandler))
 
 	shutdownFns = append(shutdownFns, defaultAPIHandler.Shutdown)
 
+	if len(networks) > 0 {
+		apiHandler.RegisterNetworkHandlers(echoServer, arcConfig.Network, defaultAPIHandler)
+	}
+
+	for _, networkCfg := range arcConfig.API.Networks {
+		networkHandler, err := newNetworkAPIHandler(logger, arcConfig, networkCfg, networkOpts, defaultValidatorOpts, beefValidatorOpts)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create API handler of network %s: %v", networkCfg.Name, err)
+		}
+
+		apiHandler.RegisterNetworkHandlers(echoServer, networkCfg.Name, networkHandler)
+		shutdownFns = append(shutdownFns, networkHandler.Shutdown)
+		logger.Info("Serving network", slog.String("name", networkCfg.Name), slog.String("network", networkCfg.Network))
+	}
+
 	// Serve HTTP until the world ends.
 	go func() {
 		logger.Info("Starting API server", slog.String("address", arcConfig.API.Address))
@@ -273,9 +689,295 @@ func StartAPIServer(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), e
 		}
 	}()
 
+	if arcConfig.API.Canary != nil && arcConfig.API.Canary.Enabled {
+		apiCanary, err := newCanary(logger, arcConfig.API.Canary, wocClient, arcConfig.API.WocMainnet)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create canary: %v", err)
+		}
+
+		err = apiCanary.Start()
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to start canary: %v", err)
+		}
+		shutdownFns = append(shutdownFns, apiCanary.Shutdown)
+	}
+
+	if arcConfig.API.Ingester != nil && arcConfig.API.Ingester.Enabled {
+		apiIngester, err := newIngester(logger, arcConfig.API.Ingester)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create ingester: %v", err)
+		}
+
+		err = apiIngester.Start()
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to start ingester: %v", err)
+		}
+		shutdownFns = append(shutdownFns, apiIngester.Shutdown)
+	}
+
 	return stopFn, nil
 }
 
+func startAdminServer(logger *slog.Logger, arcConfig *config.ArcConfig, mtmClient metamorph_api.MetaMorphAPIClient, handler *apiHandler.ArcDefaultHandler, opts ...func(*admin.Server)) (*admin.Server, []admin.Token, error) {
+	adminConfig := arcConfig.API.Admin
+
+	tokens := make([]admin.Token, 0, len(adminConfig.Tokens))
+	for _, token := range adminConfig.Tokens {
+		role, err := admin.ParseRole(token.Role)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid admin token %s: %v", token.Name, err)
+		}
+		tokens = append(tokens, admin.Token{Name: token.Name, Token: token.Token, Role: role})
+	}
+
+	serverCfg := grpc_utils.ServerConfig{
+		PrometheusEndpoint: arcConfig.Prometheus.Endpoint,
+		MaxMsgSize:         arcConfig.GrpcMessageSize,
+		TracingConfig:      arcConfig.Tracing,
+		Name:               "admin",
+		Auth:               &config.GrpcAuthConfig{TLS: adminConfig.TLS},
+	}
+
+	if arcConfig.API.AbuseScoring != nil && arcConfig.API.AbuseScoring.Enabled {
+		opts = append(opts, admin.WithAbuseScorer(handler))
+	}
+
+	server, err := admin.NewServer(logger, mtmClient, handler, tokens, serverCfg, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create admin GRPCServer failed: %v", err)
+	}
+
+	err = server.ListenAndServe(adminConfig.ListenAddr)
+	if err != nil {
+		return nil, nil, fmt.Errorf("serve admin GRPC server failed: %v", err)
+	}
+
+	return server, tokens, nil
+}
+
+func newCanary(logger *slog.Logger, cfg *config.CanaryConfig, wocClient *woc_client.WocClient, mainnet bool) (*canary.Canary, error) {
+	keySet, err := keyset.NewFromExtendedKeyStr(cfg.FundingKey, cfg.DerivationPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key set from funding key: %v", err)
+	}
+
+	httpClient := httpclient.New("canary", httpclient.WithAuthorization(cfg.Authorization))
+
+	arcClient, err := api.NewClientWithResponses(cfg.URL, api.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, err
+	}
+
+	return canary.New(logger, arcClient, wocClient, keySet,
+		canary.WithInterval(cfg.Interval),
+		canary.WithMinedTimeout(cfg.MinedTimeout),
+		canary.WithFees(cfg.MiningFeeSatPerKb),
+		canary.WithMainnet(mainnet),
+	), nil
+}
+
+func newIngester(logger *slog.Logger, cfg *config.IngesterConfig) (*ingester.Ingester, error) {
+	httpClient := httpclient.New("ingester", httpclient.WithAuthorization(cfg.Authorization))
+
+	arcClient, err := api.NewClientWithResponses(cfg.URL, api.WithHTTPClient(httpClient))
+	if err != nil {
+		return nil, err
+	}
+
+	var source ingester.Source
+	switch {
+	case cfg.S3 != nil && cfg.S3.Bucket != "":
+		source, err = ingester.NewS3Source(ingester.S3Config{
+			Endpoint:        cfg.S3.Endpoint,
+			Region:          cfg.S3.Region,
+			Bucket:          cfg.S3.Bucket,
+			Prefix:          cfg.S3.Prefix,
+			AccessKeyID:     cfg.S3.AccessKeyID,
+			SecretAccessKey: cfg.S3.SecretAccessKey,
+		}, ingester.WithS3HTTPClient(httpclient.New("ingester-s3")))
+		if err != nil {
+			return nil, err
+		}
+	case cfg.Dir != "":
+		source = ingester.NewDirSource(cfg.Dir)
+	default:
+		return nil, errors.New("neither directory nor S3 bucket of the drop folder set")
+	}
+
+	opts := []func(*ingester.Ingester){
+		ingester.WithInterval(cfg.Interval),
+		ingester.WithMinAge(cfg.MinAge),
+		ingester.WithBatchSize(cfg.BatchSize),
+		ingester.WithCallback(cfg.CallbackURL, cfg.CallbackToken),
+	}
+	if cfg.DeleteProcessed {
+		opts = append(opts, ingester.WithDeleteProcessed())
+	}
+	if cfg.WaitFor != "" {
+		opts = append(opts, ingester.WithWaitFor(cfg.WaitFor))
+	}
+
+	return ingester.New(logger, arcClient, source, opts...), nil
+}
+
+func newRejectionDigest(logger *slog.Logger, apiConfig *config.APIConfig) (*digest.Digest, error) {
+	cfg := apiConfig.RejectionDigest
+
+	tenants := make(map[string]bool, len(apiConfig.Tenants))
+	for _, tenant := range apiConfig.Tenants {
+		tenants[tenant.Name] = true
+	}
+
+	opts := []func(*digest.Digest){
+		digest.WithSchedule(cfg.Schedule),
+		digest.WithMaxTransactions(cfg.MaxTransactions),
+	}
+
+	for _, recipient := range cfg.Recipients {
+		if !tenants[recipient.Tenant] {
+			logger.Warn("Rejection digest recipient of unknown tenant", slog.String("tenant", recipient.Tenant))
+		}
+
+		var senders []digest.Sender
+		if recipient.WebhookURL != "" {
+			senders = append(senders, digest.NewWebhookSender(recipient.WebhookURL,
+				digest.WithWebhookHTTPClient(httpclient.New("rejection-digest")),
+				digest.WithWebhookToken(recipient.WebhookToken),
+			))
+		}
+		if len(recipient.Emails) > 0 {
+			if cfg.SMTP == nil || cfg.SMTP.Host == "" {
+				return nil, fmt.Errorf("smtp host not set for the emails of tenant %s", recipient.Tenant)
+			}
+			senders = append(senders, digest.NewEmailSender(digest.SMTPConfig{
+				Host:     cfg.SMTP.Host,
+				Port:     cfg.SMTP.Port,
+				Username: cfg.SMTP.Username,
+				Password: cfg.SMTP.Password,
+				From:     cfg.SMTP.From,
+			}, recipient.Emails))
+		}
+		if len(senders) == 0 {
+			return nil, fmt.Errorf("neither webhook nor emails set for tenant %s", recipient.Tenant)
+		}
+
+		opts = append(opts, digest.WithRecipient(recipient.Tenant, senders...))
+	}
+
+	return digest.New(logger, opts...), nil
+}
+
+func newFederation(logger *slog.Logger, arcConfig *config.ArcConfig) (*federation.Federation, error) {
+	cfg := arcConfig.API.Federation
+
+	upstreams := make([]federation.Upstream, 0, len(cfg.Upstreams))
+	for _, upstreamCfg := range cfg.Upstreams {
+		httpClient := httpclient.New("federation-"+upstreamCfg.Name, httpclient.WithAuthorization(upstreamCfg.Authorization))
+
+		client, err := api.NewClientWithResponses(upstreamCfg.URL, api.WithHTTPClient(httpClient))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client of upstream %s: %v", upstreamCfg.Name, err)
+		}
+		upstreams = append(upstreams, federation.Upstream{Name: upstreamCfg.Name, Client: client})
+	}
+
+	store, err := NewCacheStore(arcConfig.Cache)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cache store for acknowledgements: %v", err)
+	}
+
+	return federation.New(logger, upstreams, store,
+		federation.WithTimeout(cfg.Timeout),
+		federation.WithWorkers(cfg.Workers),
+		federation.WithQueueSize(cfg.QueueSize),
+		federation.WithAcknowledgementTTL(cfg.AcknowledgementTTL),
+		federation.WithAggregation(federation.Policy(cfg.Aggregation), cfg.Quorum),
+	)
+}
+
+// networkNames returns the names of the networks served in addition to the network of the deployment.
+func networkNames(arcConfig *config.ArcConfig) ([]string, error) {
+	names := make([]string, 0, len(arcConfig.API.Networks))
+	for _, networkCfg := range arcConfig.API.Networks {
+		switch {
+		case networkCfg.Name == "" || strings.Contains(networkCfg.Name, "/"):
+			return nil, fmt.Errorf("invalid name of network: %q", networkCfg.Name)
+		case networkCfg.Name == arcConfig.Network || slices.Contains(names, networkCfg.Name):
+			return nil, fmt.Errorf("network %s configured more than once", networkCfg.Name)
+		case networkCfg.MetamorphDialAddr == "" || networkCfg.BlocktxDialAddr == "":
+			return nil, fmt.Errorf("metamorph and blocktx dial addresses of network %s required", networkCfg.Name)
+		}
+
+		_, err := config.GetNetwork(networkCfg.Network)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network of %s: %v", networkCfg.Name, err)
+		}
+
+		names = append(names, networkCfg.Name)
+	}
+
+	return names, nil
+}
+
+// chainParams returns the network of the script verifier, the height of the genesis fork and the chain tracker
+// verifying the Merkle roots of BEEF transactions without block header services of the network.
+func chainParams(network string, wocAPIKey string, blockTxClient *blocktx.BtxClient) (string, int32, beefValidator.ChainTracker, error) {
+	switch network {
+	case "testnet":
+		return "test", apiHandler.GenesisForkBlockTest, chaintracker.NewWhatsOnChain(chaintracker.TestNet, wocAPIKey), nil
+	case "mainnet":
+		return "main", apiHandler.GenesisForkBlockMain, chaintracker.NewWhatsOnChain(chaintracker.MainNet, wocAPIKey), nil
+	case "regtest":
+		return "regtest", apiHandler.GenesisForkBlockRegtest, merkle_verifier.New(blocktx.MerkleRootsVerifier(blockTxClient), blockTxClient), nil
+	default:
+		return "", 0, nil, fmt.Errorf("invalid network type: %s", network)
+	}
+}
+
+// newNetworkAPIHandler returns the handler of a network served in addition to the network of the deployment. The
+// transactions are submitted to and queried from the metamorph and blocktx of the network and validated with the
+// default policy.
+func newNetworkAPIHandler(logger *slog.Logger, arcConfig *config.ArcConfig, cfg *config.NetworkConfig, apiOpts []apiHandler.Option,
+	defaultValidatorOpts []defaultValidator.Option, beefValidatorOpts []beefValidator.Option) (*apiHandler.ArcDefaultHandler, error) {
+	logger = logger.With(slog.String("network", cfg.Name))
+
+	conn, err := grpc_utils.DialGRPC(cfg.MetamorphDialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing, arcConfig.Metamorph.GrpcAuth, arcConfig.GrpcClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to metamorph server: %v", err)
+	}
+	mtmClient := metamorph.NewClient(metamorph_api.NewMetaMorphAPIClient(conn), metamorph.WithLogger(logger))
+
+	btcConn, err := grpc_utils.DialGRPC(cfg.BlocktxDialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing, arcConfig.Blocktx.GrpcAuth, arcConfig.GrpcClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to blocktx server: %v", err)
+	}
+	blockTxClient := blocktx.NewClient(blocktx_api.NewBlockTxAPIClient(btcConn))
+
+	network, genesisBlock, chainTracker, err := chainParams(cfg.Network, arcConfig.API.WocAPIKey, blockTxClient)
+	if err != nil {
+		return nil, err
+	}
+
+	wocClient := woc_client.New(cfg.WocMainnet, woc_client.WithAuth(arcConfig.API.WocAPIKey))
+	finder := tx_finder.NewCached(tx_finder.New(mtmClient, nil, wocClient, logger))
+
+	policy := arcConfig.API.DefaultPolicy
+	dv := defaultValidator.New(policy, finder, script_verifier.New(network), genesisBlock, defaultValidatorOpts...)
+	bv := beefValidator.New(policy, chainTracker, script_verifier.New(network), genesisBlock, beefValidatorOpts...)
+
+	handler, err := apiHandler.NewDefault(logger, mtmClient, blockTxClient, policy, dv, bv, apiOpts...)
+	if err != nil {
+		return nil, err
+	}
+	handler.StartUpdateCurrentBlockHeight()
+
+	return handler, nil
+}
+
 func setAPIEcho(logger *slog.Logger, cfg *config.APIConfig) *echo.Echo {
 	// Set up a basic Echo router
 	e := echo.New()
@@ -285,11 +987,8 @@ func setAPIEcho(logger *slog.Logger, cfg *config.APIConfig) *echo.Echo {
 	// Recover returns a middleware which recovers from panics anywhere in the chain
 	e.Use(echomiddleware.Recover())
 
-	// Add CORS headers to the server - all request origins are allowed
-	e.Use(echomiddleware.CORSWithConfig(echomiddleware.CORSConfig{
-		AllowOrigins: []string{"*"},
-		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete},
-	}))
+	// Add CORS headers to the server, so that browser-based clients can access the API directly
+	e.Use(echomiddleware.CORSWithConfig(getCORSConfig(cfg.CORS)))
 
 	// Add event ID to the request context
 	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -322,6 +1021,218 @@ func setAPIEcho(logger *slog.Logger, cfg *config.APIConfig) *echo.Echo {
 	return e
 }
 
+// getCORSConfig returns the configuration of the CORS middleware. All request origins are allowed if CORS is not
+// configured.
+func getCORSConfig(cfg *config.CORSConfig) echomiddleware.CORSConfig {
+	corsConfig := echomiddleware.CORSConfig{
+		AllowOrigins: []string{"*"},
+		AllowMethods: []string{http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodPost, http.MethodDelete},
+	}
+
+	if cfg == nil {
+		return corsConfig
+	}
+
+	if len(cfg.AllowOrigins) > 0 {
+		corsConfig.AllowOrigins = cfg.AllowOrigins
+	}
+	corsConfig.AllowHeaders = cfg.AllowHeaders
+	corsConfig.ExposeHeaders = cfg.ExposeHeaders
+	corsConfig.AllowCredentials = cfg.AllowCredentials
+	corsConfig.MaxAge = int(cfg.MaxAge.Seconds())
+
+	return corsConfig
+}
+
+func toStatusMappingRules(cfg []*config.StatusMappingConfig) []apiHandler.StatusMappingRule {
+	rules := make([]apiHandler.StatusMappingRule, 0, len(cfg))
+	for _, rule := range cfg {
+		rules = append(rules, apiHandler.StatusMappingRule{
+			Status:        api.StatusCode(rule.Status),
+			ErrorContains: rule.ErrorContains,
+			MapTo:         api.StatusCode(rule.MapTo),
+			RejectReason:  rule.RejectReason,
+		})
+	}
+
+	return rules
+}
+
+func toScriptPolicies(cfg []*config.ScriptPolicyConfig) (map[string][]validator.ScriptTemplate, error) {
+	policies := make(map[string][]validator.ScriptTemplate, len(cfg))
+	for _, policy := range cfg {
+		templates, err := validator.ParseScriptTemplates(policy.AllowedTemplates)
+		if err != nil {
+			return nil, err
+		}
+		policies[policy.APIKey] = templates
+	}
+
+	return policies, nil
+}
+
+func newTemplateStats(logger *slog.Logger, cfg *config.TemplateStatsConfig) (*templatestats.Detector, error) {
+	templates, err := validator.ParseScriptTemplates(cfg.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template stats templates: %v", err)
+	}
+
+	detector, err := templatestats.New(logger,
+		templatestats.WithTemplates(templates),
+		templatestats.WithWindow(cfg.Window),
+		templatestats.WithAnomalyThreshold(cfg.AnomalyFactor, cfg.MinRate),
+		templatestats.WithWarmupWindows(cfg.WarmupWindows),
+		templatestats.WithRateClamping(cfg.Clamp),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create template stats: %v", err)
+	}
+
+	return detector, nil
+}
+
+// newConcurrencyLimiter creates the concurrency limiter of the route groups with a limit.
+func newConcurrencyLimiter(logger *slog.Logger, cfg *config.ConcurrencyLimitsConfig) (*concurrency.Limiter, error) {
+	var opts []func(*concurrency.Limiter)
+	for name, limit := range map[string]*config.ConcurrencyLimitConfig{
+		concurrency.GroupSubmission: cfg.Submission,
+		concurrency.GroupQuery:      cfg.Query,
+	} {
+		if limit == nil || limit.MaxConcurrent == 0 {
+			continue
+		}
+		opts = append(opts, concurrency.WithLimit(name, limit.MaxConcurrent, limit.QueueTimeout))
+	}
+
+	return concurrency.New(logger, opts...)
+}
+
+func newComplianceChecker(logger *slog.Logger, arcConfig *config.ArcConfig) (*compliance.Checker, error) {
+	cfg := arcConfig.API.Compliance
+
+	conn, err := grpc_utils.DialGRPC(cfg.DialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing, nil, arcConfig.GrpcClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to compliance engine: %v", err)
+	}
+
+	opts := []func(*compliance.Checker){
+		compliance.WithTimeout(cfg.Timeout),
+		compliance.WithCacheTTL(cfg.CacheTTL),
+	}
+	if cfg.FailClosed {
+		opts = append(opts, compliance.WithFailClosed())
+	}
+	if cfg.DataCarrierOnly {
+		opts = append(opts, compliance.WithDataCarrierOnly())
+	}
+
+	checker, err := compliance.New(logger, compliance.NewGRPCScanner(compliance_api.NewComplianceAPIClient(conn)), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create compliance checker: %v", err)
+	}
+
+	return checker, nil
+}
+
+func newAbuseScorer(logger *slog.Logger, cfg *config.AbuseScoringConfig) (*abuse.Scorer, error) {
+	templates, err := validator.ParseScriptTemplates(cfg.Templates)
+	if err != nil {
+		return nil, fmt.Errorf("invalid abuse scoring templates: %v", err)
+	}
+
+	templateScores := make([]abuse.TemplateScore, 0, len(cfg.TemplateScores))
+	for _, score := range cfg.TemplateScores {
+		templateScores = append(templateScores, abuse.TemplateScore{Template: score.Template, Score: score.Score})
+	}
+
+	opts := []func(*abuse.Scorer){
+		abuse.WithWindow(cfg.Window),
+		abuse.WithBurstRate(cfg.BurstRate),
+		abuse.WithTemplateScores(templates, templateScores),
+		abuse.WithFeeFactor(cfg.FeeFactor),
+		abuse.WithMinSamples(cfg.MinSamples),
+		abuse.WithThresholds(cfg.ThrottleThreshold, cfg.RejectThreshold, cfg.ThrottleRate),
+	}
+	if cfg.Weights != nil {
+		opts = append(opts, abuse.WithWeights(abuse.Weights{
+			BurstRate:     cfg.Weights.BurstRate,
+			Template:      cfg.Weights.Template,
+			FeeLevel:      cfg.Weights.FeeLevel,
+			RejectionRate: cfg.Weights.RejectionRate,
+		}))
+	}
+
+	scorer, err := abuse.New(logger, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create abuse scorer: %v", err)
+	}
+
+	return scorer, nil
+}
+
+func toOpcodeLimits(cfg []*config.OpcodeLimitConfig) ([]validator.OpcodeLimit, error) {
+	limits := make([]validator.OpcodeLimit, 0, len(cfg))
+	for _, limitCfg := range cfg {
+		limit, err := validator.NewOpcodeLimit(limitCfg.Opcode, limitCfg.MaxCount)
+		if err != nil {
+			return nil, err
+		}
+		limits = append(limits, limit)
+	}
+
+	return limits, nil
+}
+
+// newChainTrackerAuth returns the authentication of the requests to the block header service.
+func newChainTrackerAuth(bhs config.BlockHeaderService) (merkle_verifier.Authenticator, error) {
+	switch bhs.Auth {
+	case "", "bearer":
+		return merkle_verifier.NewBearerAuth(bhs.APIKey), nil
+	case "basic":
+		return merkle_verifier.NewBasicAuth(bhs.Username, bhs.Password), nil
+	case "mutual":
+		return merkle_verifier.NewMutualAuth(bhs.PrivateKey)
+	default:
+		return nil, fmt.Errorf("unknown auth: %s", bhs.Auth)
+	}
+}
+
+// newChainTrackerDiscoverer returns the discovery of the block header services of the configured mode.
+func newChainTrackerDiscoverer(cfg *config.ChainTrackerDiscoveryConfig) (merkle_verifier.Discoverer, error) {
+	switch cfg.Mode {
+	case "dns":
+		if cfg.DNS == nil || cfg.DNS.Record == "" {
+			return nil, errors.New("dns record not set")
+		}
+		return merkle_verifier.NewDNSDiscoverer(cfg.DNS.Record, cfg.DNS.Scheme), nil
+	case "kubernetes":
+		if cfg.Kubernetes == nil || cfg.Kubernetes.LabelSelector == "" {
+			return nil, errors.New("kubernetes label selector not set")
+		}
+		k8sClient, err := k8s_client.New()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %v", err)
+		}
+		return merkle_verifier.NewKubernetesDiscoverer(k8sClient, cfg.Kubernetes.Namespace, cfg.Kubernetes.LabelSelector, cfg.Kubernetes.Port, cfg.Kubernetes.Scheme), nil
+	case "configService":
+		if cfg.ConfigService == nil || cfg.ConfigService.URL == "" {
+			return nil, errors.New("config service url not set")
+		}
+		return merkle_verifier.NewConfigServiceDiscoverer(cfg.ConfigService.URL), nil
+	default:
+		return nil, fmt.Errorf("unknown mode: %s", cfg.Mode)
+	}
+}
+
+func toTenants(cfg []*config.TenantConfig) map[string]string {
+	tenants := make(map[string]string, len(cfg))
+	for _, tenant := range cfg {
+		tenants[tenant.APIKey] = tenant.Name
+	}
+
+	return tenants
+}
+
 func logRequestMiddleware(logger *slog.Logger, extendLog bool) echo.MiddlewareFunc {
 	if extendLog {
 		return echomiddleware.RequestLoggerWithConfig(extendRequestLogConfig(logger))
@@ -441,3 +1352,44 @@ func getPolicyFromNode(peerRPCConfig *config.PeerRPCConfig) (*bitcoin.Settings,
 
 	return &settings, nil
 }
+
+// subscribeReorgs removes the Merkle roots at and above the lowest height affected by a chain reorg from the negative
+// cache, as they may be valid on the new longest chain. Every API instance subscribes to all reorgs, as each of them
+// has its own negative cache.
+func subscribeReorgs(logger *slog.Logger, subscriber mq.MessageSubscriber, negativeCache *merkle_verifier.NegativeCache) error {
+	return subscriber.Subscribe(mq.ReorgTopic, func(ctx context.Context, msg []byte) error {
+		block := &blocktx_api.Block{}
+		err := mq.Unmarshal(ctx, mq.ReorgTopic, msg, block)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal reorg: %v", err)
+		}
+
+		removed := negativeCache.InvalidateFromHeight(uint32(block.GetHeight())) // #nosec G115
+		logger.Info("Invalidated negative cache of Merkle roots after reorg", slog.Uint64("height", block.GetHeight()), slog.Int("removed", removed))
+		return nil
+	})
+}
+
+// subscribeStatusUpdates invalidates the cached statuses of the transactions whose status has been updated by metamorph.
+// Every API instance subscribes to all status updates, as each of them may have cached the statuses.
+func subscribeStatusUpdates(subscriber mq.MessageSubscriber, handler *apiHandler.ArcDefaultHandler) error {
+	return subscriber.Subscribe(mq.StatusUpdateTopic, func(ctx context.Context, msg []byte) error {
+		updates := &blocktx_api.Transactions{}
+		err := mq.Unmarshal(ctx, mq.StatusUpdateTopic, msg, updates)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal status updates: %v", err)
+		}
+
+		txIDs := make([]string, 0, len(updates.GetTransactions()))
+		for _, tx := range updates.GetTransactions() {
+			hash, err := chainhash.NewHash(tx.GetHash())
+			if err != nil {
+				continue
+			}
+			txIDs = append(txIDs, hash.String())
+		}
+
+		handler.InvalidateStatuses(txIDs...)
+		return nil
+	})
+}
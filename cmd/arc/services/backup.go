@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/backup"
+)
+
+// BackupDatabases takes consistent snapshots of the postgres databases of blocktx, metamorph and callbacker and writes
+// them to the directory.
+func BackupDatabases(logger *slog.Logger, arcConfig *config.ArcConfig, dir string) error {
+	manifest, err := backup.New(logger).Create(context.Background(), dir, backupTargets(arcConfig))
+	if err != nil {
+		return fmt.Errorf("failed to back up databases: %v", err)
+	}
+
+	logger.Info("Backed up databases", slog.String("dir", dir), slog.Int("services", len(manifest.Services)))
+
+	return nil
+}
+
+// RestoreDatabases migrates the postgres databases of blocktx, metamorph and callbacker and restores the backup in the
+// directory to them. The databases must be empty and ARC must have the version which took the backup, so that the
+// databases are migrated to the schema versions of the backup.
+func RestoreDatabases(logger *slog.Logger, arcConfig *config.ArcConfig, dir string) error {
+	runner, err := MigrateDatabases(logger, arcConfig, false, true, true, true)
+	if err != nil {
+		return fmt.Errorf("failed to migrate databases: %v", err)
+	}
+	runner.Shutdown()
+
+	manifest, err := backup.New(logger).Restore(context.Background(), dir, backupTargets(arcConfig))
+	if err != nil {
+		return fmt.Errorf("failed to restore databases: %v", err)
+	}
+
+	logger.Info("Restored databases", slog.String("dir", dir), slog.Time("createdAt", manifest.CreatedAt))
+
+	if arcConfig.Metamorph == nil || arcConfig.Metamorph.Reconciliation == nil || !arcConfig.Metamorph.Reconciliation.Enabled || !arcConfig.Metamorph.Reconciliation.OnStart {
+		logger.Warn("Reconciliation on start of metamorph not enabled, transactions mined after the backup are only updated by the blocks processed by blocktx")
+	}
+
+	return nil
+}
+
+// backupTargets returns the postgres databases of the services in the order of the flow of events between them. The
+// locks held by the instances of the backed up deployment are released on restore.
+func backupTargets(arcConfig *config.ArcConfig) []backup.Target {
+	var targets []backup.Target
+
+	if arcConfig.Blocktx != nil {
+		targets = appendBackupTarget(targets, "blocktx", arcConfig.Blocktx.Db, "DELETE FROM blocktx.block_processing")
+	}
+	if arcConfig.Metamorph != nil {
+		targets = appendBackupTarget(targets, "metamorph", arcConfig.Metamorph.Db, "UPDATE metamorph.transactions SET locked_by = 'NONE'")
+	}
+	if arcConfig.Callbacker != nil {
+		targets = appendBackupTarget(targets, "callbacker", arcConfig.Callbacker.Db, "DELETE FROM callbacker.url_mapping")
+	}
+
+	return targets
+}
+
+func appendBackupTarget(targets []backup.Target, service string, dbConfig *config.DbConfig, restoreStatements ...string) []backup.Target {
+	dbInfo, ok := postgresDBInfo(dbConfig)
+	if !ok {
+		return targets
+	}
+
+	return append(targets, backup.Target{
+		Service:           service,
+		DBInfo:            dbInfo,
+		Schema:            service,
+		MigrationsTable:   service,
+		RestoreStatements: restoreStatements,
+	})
+}
@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"time"
 
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/libsv/go-p2p/wire"
 	"go.opentelemetry.io/otel/attribute"
 
@@ -15,13 +18,19 @@ import (
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet/blocktx_p2p"
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet/mcast"
+	"github.com/bitcoin-sv/arc/internal/blocktx/block_archive"
+	"github.com/bitcoin-sv/arc/internal/blocktx/block_events"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store/postgresql"
+	"github.com/bitcoin-sv/arc/internal/feature"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
 	"github.com/bitcoin-sv/arc/internal/version"
-	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/nats_connection"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
@@ -33,7 +42,7 @@ const (
 	minConnections        = 1
 )
 
-func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), error) {
+func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig, peerOpts []p2p.PeerOptions, banManager *p2p.BanManager, memGuard *memlimit.Guard, featureFlags *feature.Flags, eventBus *events.Bus, logLevels *arcLogger.Levels) (func(), error) {
 	logger = logger.With(slog.String("service", "blocktx"))
 	logger.Info("Starting")
 
@@ -56,7 +65,7 @@ func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), err
 	processorOpts := make([]func(handler *blocktx.Processor), 0)
 
 	if arcConfig.IsTracingEnabled() {
-		cleanup, err := tracing.Enable(logger, "blocktx", arcConfig.Tracing.DialAddr, arcConfig.Tracing.Sample)
+		cleanup, err := tracing.EnableWithOptions(logger, "blocktx", arcConfig.Tracing.DialAddr, getTracingOpts(arcConfig.Tracing)...)
 		if err != nil {
 			logger.Error("failed to enable tracing", slog.String("err", err.Error()))
 		} else {
@@ -79,18 +88,30 @@ func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), err
 		logger.Info("Shutdown blocktx complete")
 	}
 
+	sup := newSupervisor(logger, arcConfig.Supervisor)
+	shutdownFns = append(shutdownFns, sup.Shutdown)
+
 	blockStore, err = NewBlocktxStore(logger, btxConfig.Db, arcConfig.Tracing)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create blocktx store: %v", err)
 	}
 
+	err = sup.WaitFor("database", func() error { return pingStore(blockStore) })
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+
 	registerTxsChan := make(chan []byte, chanBufferSize)
 
-	var mqOpts []nats_jetstream.Option
+	mqOpts := getMqTracerOpts(arcConfig)
 
 	connOpts := []nats_connection.Option{nats_connection.WithMaxReconnects(-1)}
-	mqClient, err = mq.NewMqClient(logger, arcConfig.MessageQueue, mqOpts, connOpts)
+	mqClient, err = supervisor.Retry(sup, "message queue", func() (mq.MessageQueueClient, error) {
+		return newMqClient(logger, arcConfig, "blocktx", mqOpts, connOpts)
+	})
 	if err != nil {
+		stopFn()
 		return nil, err
 	}
 
@@ -100,12 +121,34 @@ func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), err
 		blocktx.WithRegisterTxsInterval(btxConfig.RegisterTxsInterval),
 		blocktx.WithMessageQueueClient(mqClient),
 		blocktx.WithMaxBlockProcessingDuration(btxConfig.MaxBlockProcessingDuration),
+		blocktx.WithBlockDownloadTimeout(btxConfig.BlockDownloadTimeout),
+		blocktx.WithPeerSelection(btxConfig.PeerSelectionDelay, btxConfig.PeerExplorationInterval),
 		blocktx.WithIncomingIsLongest(btxConfig.IncomingIsLongest),
+		blocktx.WithOptimisticMined(btxConfig.OptimisticMined),
+		blocktx.WithHashingWorkers(btxConfig.HashingWorkers),
+		blocktx.WithMemoryGuard(memGuard),
+		blocktx.WithFeatureFlags(featureFlags),
+		blocktx.WithEventBus(eventBus),
 	)
 
 	blockRequestCh := make(chan blocktx_p2p.BlockRequest, blockProcessingBuffer)
 	blockProcessCh := make(chan *bcnet.BlockMessagePeer, blockProcessingBuffer)
 
+	if webhooksCfg := btxConfig.BlockEventWebhooks; webhooksCfg != nil && len(webhooksCfg.Webhooks) > 0 {
+		webhooks := make([]block_events.Webhook, 0, len(webhooksCfg.Webhooks))
+		for _, webhook := range webhooksCfg.Webhooks {
+			webhooks = append(webhooks, block_events.Webhook{URL: webhook.URL, Token: webhook.Token, Events: webhook.Events})
+		}
+
+		blockEvents := block_events.New(logger, webhooks,
+			block_events.WithTimeout(webhooksCfg.Timeout),
+			block_events.WithRetries(webhooksCfg.MaxRetries, webhooksCfg.RetryDelay),
+		)
+		blockEvents.Start()
+		shutdownFns = append(shutdownFns, blockEvents.Shutdown)
+		processorOpts = append(processorOpts, blocktx.WithBlockEventNotifier(blockEvents))
+	}
+
 	processor, err = blocktx.NewProcessor(logger, blockStore, blockRequestCh, blockProcessCh, processorOpts...)
 	if err != nil {
 		stopFn()
@@ -118,13 +161,32 @@ func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), err
 		return nil, fmt.Errorf("failed to start prometheus: %v", err)
 	}
 
-	pm, mcastListener, err = setupBcNetworkCommunication(logger, arcConfig, blockStore, blockRequestCh, minConnections, blockProcessCh)
+	var blockArchive *block_archive.Archive
+	if archiveCfg := btxConfig.RawBlockArchive; archiveCfg != nil && archiveCfg.Enabled {
+		blockArchive, err = block_archive.New(logger, archiveCfg.Dir,
+			block_archive.WithRetention(time.Duration(archiveCfg.RetentionDays)*24*time.Hour),
+			block_archive.WithUploadCommand(archiveCfg.UploadCommand),
+			block_archive.WithUploadTimeout(archiveCfg.UploadTimeout),
+		)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create raw block archive: %v", err)
+		}
+		blockArchive.Start()
+		shutdownFns = append(shutdownFns, blockArchive.Shutdown)
+	}
+
+	pm, mcastListener, err = setupBcNetworkCommunication(logger, arcConfig, blockStore, blockRequestCh, minConnections, blockProcessCh, peerOpts, blockArchive)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("failed to establish connection with network: %v", err)
 	}
 
-	if arcConfig.Prometheus.IsEnabled() {
+	if arcConfig.IsSimulatorEnabled() {
+		shutdownFns = append(shutdownFns, getSimulatedNetwork(logger, arcConfig.Simulator).Shutdown)
+	}
+
+	if arcConfig.IsMetricsEnabled() {
 		statsCollector = blocktx.NewStatsCollector(logger, pm, blockStore)
 		err = statsCollector.Start()
 		if err != nil {
@@ -148,15 +210,16 @@ func StartBlockTx(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), err
 		MaxMsgSize:         arcConfig.GrpcMessageSize,
 		TracingConfig:      arcConfig.Tracing,
 		Name:               "blocktx",
+		Auth:               arcConfig.Blocktx.GrpcAuth,
 	}
 
-	server, err = blocktx.NewServer(logger, blockStore, pm, processor, serverCfg, arcConfig.Blocktx.MaxAllowedBlockHeightMismatch, mqClient)
+	server, err = blocktx.NewServer(logger, blockStore, pm, processor, serverCfg, arcConfig.Blocktx.MaxAllowedBlockHeightMismatch, mqClient, blocktx.WithServerBanManager(banManager), blocktx.WithServerLogLevels(logLevels))
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("create GRPCServer failed: %v", err)
 	}
 
-	err = server.ListenAndServe(btxConfig.ListenAddr)
+	err = serveGrpc(arcConfig, &server.GrpcServer, "blocktx", btxConfig.ListenAddr)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("serve GRPCServer failed: %v", err)
@@ -223,7 +286,7 @@ func NewBlocktxStore(logger *slog.Logger, dbConfig *config.DbConfig, tracingConf
 // Message Handlers:
 // - `blocktx_p2p.NewMsgHandler`: Used in classic mode, handles all blockchain communication exclusively via P2P.
 // - `blocktx_p2p.NewHybridMsgHandler`: Used in hybrid mode, seamlessly integrates P2P communication with multicast group updates.
-func setupBcNetworkCommunication(l *slog.Logger, arcConfig *config.ArcConfig, store store.BlocktxStore, blockRequestCh chan<- blocktx_p2p.BlockRequest, minConnections int, blockProcessCh chan<- *bcnet.BlockMessagePeer) (manager *p2p.PeerManager, mcastListener *mcast.Listener, err error) {
+func setupBcNetworkCommunication(l *slog.Logger, arcConfig *config.ArcConfig, store store.BlocktxStore, blockRequestCh chan<- blocktx_p2p.BlockRequest, minConnections int, blockProcessCh chan<- *bcnet.BlockMessagePeer, peerOpts []p2p.PeerOptions, blockArchive *block_archive.Archive) (manager *p2p.PeerManager, mcastListener *mcast.Listener, err error) {
 	defer func() {
 		// cleanup on error
 		if err == nil {
@@ -242,6 +305,24 @@ func setupBcNetworkCommunication(l *slog.Logger, arcConfig *config.ArcConfig, st
 	// p2p global setting
 	p2p.SetExcessiveBlockSize(maximumBlockSize)
 
+	var readerOpts []bcnet.BlockReaderOption
+	if readerCfg := arcConfig.Blocktx.BlockReader; readerCfg != nil {
+		readerOpts = append(readerOpts,
+			bcnet.WithReadBufferSize(readerCfg.ReadBufferSize),
+			bcnet.WithSpillThreshold(readerCfg.SpillThreshold),
+			bcnet.WithSpillDir(readerCfg.SpillDir),
+		)
+	}
+	if arcConfig.Blocktx.MalleabilityDetection {
+		readerOpts = append(readerOpts, bcnet.WithNormalizedHashes(true))
+	}
+	if blockArchive != nil {
+		readerOpts = append(readerOpts, bcnet.WithRawBlockArchive(blockArchive))
+	}
+	if len(readerOpts) > 0 {
+		bcnet.RegisterBlockReader(readerOpts...)
+	}
+
 	cfg := arcConfig.Blocktx.BlockchainNetwork
 	network, err := config.GetNetwork(cfg.Network)
 	if err != nil {
@@ -250,6 +331,25 @@ func setupBcNetworkCommunication(l *slog.Logger, arcConfig *config.ArcConfig, st
 
 	var msgHandler p2p.MessageHandlerI
 
+	if arcConfig.IsSimulatorEnabled() {
+		simulatedNetwork := getSimulatedNetwork(l, arcConfig.Simulator)
+
+		// continue the chain known from a previous run, a missing tip means that blocktx starts with an empty store
+		tip, tipErr := store.GetChainTip(context.Background())
+		if tipErr == nil {
+			var tipHash *chainhash.Hash
+			tipHash, tipErr = chainhash.NewHash(tip.Hash)
+			if tipErr == nil {
+				simulatedNetwork.SetChainTip(*tipHash, tip.Height)
+			}
+		}
+
+		// the configured peers and mcast group are replaced by a single peer of the simulated network
+		manager = p2p.NewPeerManager(l.With(slog.String("module", "peer-mng")), network)
+		err = manager.AddPeer(simulatedNetwork.NewPeer(blocktx_p2p.NewMsgHandler(l, blockRequestCh, blockProcessCh), network))
+		return
+	}
+
 	switch cfg.Mode {
 	case "classic":
 		msgHandler = blocktx_p2p.NewMsgHandler(l, blockRequestCh, blockProcessCh)
@@ -260,6 +360,12 @@ func setupBcNetworkCommunication(l *slog.Logger, arcConfig *config.ArcConfig, st
 		return nil, nil, fmt.Errorf("unsupported communication type: %s", cfg.Mode)
 	}
 
+	var meter *p2p.ThroughputMeter
+	if cfg.Autoscaling != nil && cfg.Autoscaling.Enabled {
+		meter = &p2p.ThroughputMeter{}
+		msgHandler = p2p.NewMeteredMessageHandler(msgHandler, meter, bcnet.BlockBytes)
+	}
+
 	// connect to peers
 	var managerOpts []p2p.PeerManagerOptions
 	if arcConfig.Blocktx.MonitorPeers {
@@ -269,12 +375,23 @@ func setupBcNetworkCommunication(l *slog.Logger, arcConfig *config.ArcConfig, st
 	manager = p2p.NewPeerManager(l.With(slog.String("module", "peer-mng")), network, managerOpts...)
 
 	connectionsReady := make(chan struct{})
-	go connectToPeers(l, manager, connectionsReady, minConnections, network, msgHandler, cfg.Peers, p2p.WithMaximumMessageSize(maximumBlockSize))
+	blocktxPeerOpts := append([]p2p.PeerOptions{p2p.WithMaximumMessageSize(maximumBlockSize)}, peerOpts...)
+	if arcConfig.Blocktx.OptimisticMined {
+		blocktxPeerOpts = append(blocktxPeerOpts, p2p.WithSendHeaders())
+	}
+	go connectToPeers(l, manager, connectionsReady, minConnections, network, msgHandler, cfg.Peers, blocktxPeerOpts...)
 
 	// wait until min peer connections are ready and then continue startup while remaining peers connect
 	<-connectionsReady
 	l.Info("current open peer connections", slog.Uint64("count", uint64(manager.CountConnectedPeers())))
 
+	if meter != nil {
+		err = startPeerAutoscaler(l, cfg.Autoscaling, manager, meter, network, msgHandler, blocktxPeerOpts...)
+		if err != nil {
+			return manager, nil, fmt.Errorf("failed to start peer autoscaler: %w", err)
+		}
+	}
+
 	// connect to mcast
 	if cfg.Mode == "hybrid" {
 		if cfg.Mcast == nil {
@@ -306,18 +423,7 @@ func connectToPeers(l *slog.Logger, manager *p2p.PeerManager, connectionsReady c
 		}
 	}()
 
-	opts := []p2p.PeerOptions{
-		p2p.WithPingInterval(30*time.Second, 2*time.Minute),
-	}
-
-	if version.Version != "" {
-		opts = append(opts,
-			p2p.WithUserAgent("ARC", version.Version),
-			p2p.WithConnectionTimeout(p2pConnectionTimeout),
-		)
-	}
-
-	opts = append(opts, additionalOpts...)
+	opts := newPeerOpts(additionalOpts...)
 	var connectedPeers int
 
 	for _, settings := range peersConfig {
@@ -326,12 +432,23 @@ func connectToPeers(l *slog.Logger, manager *p2p.PeerManager, connectionsReady c
 			l.Error("error getting peer url: ", slog.String("err", err.Error()))
 		}
 
+		peerOpts := opts
+		if settings.Proxy != "" {
+			var proxyDialer p2p.Dialer
+			proxyDialer, err = p2p.NewSOCKS5Dialer(settings.Proxy)
+			if err != nil {
+				l.Error("could not create proxy dialer", slog.String("peer", url), slog.String("err", err.Error()))
+				continue
+			}
+			peerOpts = append(slices.Clone(opts), p2p.WithProxy(proxyDialer))
+		}
+
 		p := p2p.NewPeer(
 			l.With(slog.String("module", "peer")),
 			msgHandler,
 			url,
 			network,
-			opts...)
+			peerOpts...)
 
 		err = manager.AddPeer(p)
 		if err != nil {
@@ -355,6 +472,78 @@ func connectToPeers(l *slog.Logger, manager *p2p.PeerManager, connectionsReady c
 	}
 }
 
+func newPeerOpts(additionalOpts ...p2p.PeerOptions) []p2p.PeerOptions {
+	opts := []p2p.PeerOptions{
+		p2p.WithPingInterval(30*time.Second, 2*time.Minute),
+	}
+
+	if version.Version != "" {
+		opts = append(opts,
+			p2p.WithUserAgent("ARC", version.Version),
+			p2p.WithConnectionTimeout(p2pConnectionTimeout),
+		)
+	}
+
+	return append(opts, additionalOpts...)
+}
+
+// startPeerAutoscaler connects additional peers while the throughput measured by the meter is below the target. The
+// additional peers are shut down with the peer manager.
+func startPeerAutoscaler(l *slog.Logger, cfg *config.PeerAutoscalingConfig, manager *p2p.PeerManager, meter *p2p.ThroughputMeter, network wire.BitcoinNet, msgHandler p2p.MessageHandlerI, additionalOpts ...p2p.PeerOptions) error {
+	opts := newPeerOpts(additionalOpts...)
+
+	candidates := make([]string, 0, len(cfg.Candidates))
+	proxies := make(map[string]string)
+	for _, candidate := range cfg.Candidates {
+		url, err := candidate.GetP2PUrl()
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, url)
+
+		if candidate.Proxy != "" {
+			proxies[url] = candidate.Proxy
+		}
+	}
+
+	dial := func(_ context.Context, address string) (p2p.PeerI, error) {
+		peerOpts := opts
+		if proxy := proxies[address]; proxy != "" {
+			proxyDialer, err := p2p.NewSOCKS5Dialer(proxy)
+			if err != nil {
+				return nil, err
+			}
+			peerOpts = append(slices.Clone(opts), p2p.WithProxy(proxyDialer))
+		}
+
+		p := p2p.NewPeer(l.With(slog.String("module", "peer")), msgHandler, address, network, peerOpts...)
+		if !p.Connect() {
+			return nil, errors.New("failed to connect to peer")
+		}
+
+		return p, nil
+	}
+
+	autoscalerOpts := []func(*p2p.PeerAutoscaler){
+		p2p.WithAutoscalingCandidates(candidates...),
+		p2p.WithThroughputTarget(cfg.TargetThroughput, cfg.IdleThroughput),
+		p2p.WithMaxPeers(cfg.MaxPeers),
+		p2p.WithAutoscalingInterval(cfg.Interval),
+	}
+	if len(cfg.DNSSeeds) > 0 {
+		autoscalerOpts = append(autoscalerOpts, p2p.WithAutoscalingDNSSeeds(cfg.DNSSeeds, cfg.DNSSeedPort, cfg.TrustedNetworks))
+	}
+
+	autoscaler, err := p2p.NewPeerAutoscaler(l, manager, meter, dial, autoscalerOpts...)
+	if err != nil {
+		return err
+	}
+
+	autoscaler.Start()
+
+	return nil
+}
+
 func disposeBlockTx(l *slog.Logger, server *blocktx.Server, processor *blocktx.Processor,
 	pm *p2p.PeerManager, mcastListener *mcast.Listener, mqClient mq.MessageQueueClient,
 	store store.BlocktxStore, healthServer *grpc_utils.GrpcServer, workers *blocktx.BackgroundWorkers,
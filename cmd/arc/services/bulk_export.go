@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export"
+)
+
+// ExportTransactions writes the transactions of the metamorph database matching the query to the file. The query has
+// the format of the query parameters of /v1/admin/export, e.g. `from=2026-01-01T00:00:00Z&status=MINED&format=csv`.
+func ExportTransactions(logger *slog.Logger, arcConfig *config.ArcConfig, query string, file string) error {
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return fmt.Errorf("failed to parse export query: %v", err)
+	}
+
+	metamorphStore, err := NewMetamorphStore(arcConfig.Metamorph.Db, nil, 0, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create metamorph store: %v", err)
+	}
+	defer func() {
+		_ = metamorphStore.Close(context.Background())
+	}()
+
+	var opts []func(*bulk_export.Exporter)
+	if arcConfig.Metamorph.BulkExport != nil {
+		opts = append(opts, bulk_export.WithBatchSize(arcConfig.Metamorph.BulkExport.BatchSize))
+	}
+	exporter := bulk_export.New(logger, metamorphStore, opts...)
+
+	q, err := exporter.ParseQuery(values)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return fmt.Errorf("failed to create export file: %v", err)
+	}
+	defer f.Close()
+
+	count, err := exporter.Export(context.Background(), f, q)
+	if err != nil {
+		return fmt.Errorf("failed to export transactions after %d transactions: %v", count, err)
+	}
+
+	logger.Info("Exported transactions", slog.Int64("exported", count), slog.String("file", file))
+
+	return f.Close()
+}
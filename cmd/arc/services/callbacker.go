@@ -32,13 +32,18 @@ import (
 	"github.com/bitcoin-sv/arc/config"
 	"github.com/bitcoin-sv/arc/internal/callbacker"
 	"github.com/bitcoin-sv/arc/internal/callbacker/store/postgresql"
+	"github.com/bitcoin-sv/arc/internal/encryption"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/nats_connection"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
-func StartCallbacker(logger *slog.Logger, arcConfig *config.ArcConfig) (func(), error) {
+func StartCallbacker(logger *slog.Logger, arcConfig *config.ArcConfig, eventBus *events.Bus, logLevels *arcLogger.Levels) (func(), error) {
 	logger = logger.With(slog.String("service", "callbacker"))
 	logger.Info("Starting")
 	var (
@@ -51,42 +56,92 @@ func StartCallbacker(logger *slog.Logger, arcConfig *config.ArcConfig) (func(),
 		err             error
 	)
 
+	shutdownFns := make([]func(), 0)
+	if arcConfig.IsTracingEnabled() {
+		cleanup, err := tracing.EnableWithOptions(logger, "callbacker", arcConfig.Tracing.DialAddr, getTracingOpts(arcConfig.Tracing)...)
+		if err != nil {
+			logger.Error("failed to enable tracing", slog.String("err", err.Error()))
+		} else {
+			shutdownFns = append(shutdownFns, cleanup)
+		}
+	}
+
 	stopFn := func() {
 		logger.Info("Shutting down callbacker")
 		disposeCallbacker(logger, server, sender, callbackerStore, healthServer, processor, mqClient)
+		for _, fn := range shutdownFns {
+			fn()
+		}
 		logger.Info("Shutdown callbacker complete")
 	}
 
-	callbackerStore, err = newStore(arcConfig.Callbacker.Db)
+	cipher, err := newCipher(arcConfig.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %v", err)
+	}
+
+	sup := newSupervisor(logger, arcConfig.Supervisor)
+	shutdownFns = append(shutdownFns, sup.Shutdown)
+
+	callbackerStore, err = newStore(arcConfig.Callbacker.Db, cipher)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create callbacker store: %v", err)
 	}
 
-	sender, err = callbacker.NewSender(logger, callbacker.WithTimeout(5*time.Second))
+	err = sup.WaitFor("database", func() error { return pingStore(callbackerStore) })
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+
+	payloadTemplates, err := toPayloadTemplates(arcConfig.Callbacker.PayloadTemplates)
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+
+	sender, err = callbacker.NewSender(logger,
+		callbacker.WithTimeout(5*time.Second),
+		callbacker.WithSigningSecret(arcConfig.Callbacker.SigningSecret),
+		callbacker.WithPayloadTemplates(payloadTemplates),
+		callbacker.WithEventBus(eventBus),
+	)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("failed to create callback sender: %v", err)
 	}
 
-	mqOpts := getCbkMqOpts()
+	mqOpts := append(getCbkMqOpts(arcConfig.MessageQueue.SubjectPrefix), getMqTracerOpts(arcConfig)...)
 
 	connOpts := []nats_connection.Option{nats_connection.WithMaxReconnects(-1)}
-	mqClient, err = mq.NewMqClient(logger, arcConfig.MessageQueue, mqOpts, connOpts)
+	mqClient, err = supervisor.Retry(sup, "message queue", func() (mq.MessageQueueClient, error) {
+		return newMqClient(logger, arcConfig, "callbacker", mqOpts, connOpts)
+	})
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+
+	tenantRateLimits, defaultTenantRateLimit, err := toTenantRateLimits(arcConfig.Callbacker.TenantRateLimits)
 	if err != nil {
+		stopFn()
 		return nil, err
 	}
 
-	processor, err = callbacker.NewProcessor(
-		sender,
-		callbackerStore,
-		mqClient,
-		logger,
+	processorOpts := []func(*callbacker.Processor){
 		callbacker.WithExpiration(arcConfig.Callbacker.Expiration),
 		callbacker.WithSingleSendInterval(arcConfig.Callbacker.Pause),
 		callbacker.WithBatchSendInterval(arcConfig.Callbacker.BatchSendInterval),
 		callbacker.WithClearInterval(arcConfig.Callbacker.PruneInterval),
 		callbacker.WithClearRetentionPeriod(arcConfig.Callbacker.PruneOlderThan),
-	)
+		callbacker.WithJobConfigs(toJobConfigs(arcConfig.Callbacker.Jobs)),
+		callbacker.WithTenantRateLimits(tenantRateLimits, defaultTenantRateLimit),
+	}
+	if arcConfig.Callbacker.SLAReports != nil && arcConfig.Callbacker.SLAReports.Enabled {
+		processorOpts = append(processorOpts, callbacker.WithSLAReports(arcConfig.Callbacker.SLAReports.Interval))
+	}
+
+	processor, err = callbacker.NewProcessor(sender, callbackerStore, mqClient, logger, processorOpts...)
 	if err != nil {
 		stopFn()
 		return nil, err
@@ -103,15 +158,16 @@ func StartCallbacker(logger *slog.Logger, arcConfig *config.ArcConfig) (func(),
 		MaxMsgSize:         arcConfig.GrpcMessageSize,
 		TracingConfig:      arcConfig.Tracing,
 		Name:               "blocktx",
+		Auth:               arcConfig.Callbacker.GrpcAuth,
 	}
 
-	server, err = callbacker.NewServer(logger, callbackerStore, mqClient, serverCfg)
+	server, err = callbacker.NewServer(logger, callbackerStore, mqClient, serverCfg, callbacker.WithServerLogLevels(logLevels))
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("create GRPCServer failed: %v", err)
 	}
 
-	err = server.ListenAndServe(arcConfig.Callbacker.ListenAddr)
+	err = serveGrpc(arcConfig, &server.GrpcServer, "callbacker", arcConfig.Callbacker.ListenAddr)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("serve GRPC server failed: %v", err)
@@ -121,18 +177,53 @@ func StartCallbacker(logger *slog.Logger, arcConfig *config.ArcConfig) (func(),
 	return stopFn, nil
 }
 
-func getCbkMqOpts() []nats_jetstream.Option {
-	callbackStreamName := fmt.Sprintf("%s-stream", mq.CallbackTopic)
-	callbackConsName := fmt.Sprintf("%s-cons", mq.CallbackTopic)
+func getCbkMqOpts(subjectPrefix string) []nats_jetstream.Option {
+	callbackTopic := mq.PrefixedTopic(subjectPrefix, mq.CallbackTopic)
+	callbackStreamName := mq.PrefixedName(subjectPrefix, fmt.Sprintf("%s-stream", mq.CallbackTopic))
+	callbackConsName := mq.PrefixedName(subjectPrefix, fmt.Sprintf("%s-cons", mq.CallbackTopic))
 
 	mqOpts := []nats_jetstream.Option{
-		nats_jetstream.WithStream(mq.CallbackTopic, callbackStreamName, jetstream.WorkQueuePolicy, false),
-		nats_jetstream.WithConsumer(mq.CallbackTopic, callbackStreamName, callbackConsName, true, jetstream.AckExplicitPolicy),
+		nats_jetstream.WithStream(callbackTopic, callbackStreamName, jetstream.WorkQueuePolicy, false),
+		nats_jetstream.WithConsumer(callbackTopic, callbackStreamName, callbackConsName, true, jetstream.AckExplicitPolicy),
 	}
 	return mqOpts
 }
 
-func newStore(dbConfig *config.DbConfig) (s *postgresql.PostgreSQL, err error) {
+func toPayloadTemplates(cfg []*config.PayloadTemplateConfig) ([]*callbacker.PayloadTemplate, error) {
+	templates := make([]*callbacker.PayloadTemplate, 0, len(cfg))
+	for _, c := range cfg {
+		tmpl, err := callbacker.NewPayloadTemplate(c.URLPrefix, c.Token, c.ContentType, c.Template, c.BatchTemplate)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, tmpl)
+	}
+
+	return templates, nil
+}
+
+// toTenantRateLimits returns the rate limits by tenant and the default limit of the tenant "*".
+func toTenantRateLimits(cfg []*config.TenantRateLimitConfig) (map[string]callbacker.TenantRateLimit, *callbacker.TenantRateLimit, error) {
+	limits := make(map[string]callbacker.TenantRateLimit, len(cfg))
+	var defaultLimit *callbacker.TenantRateLimit
+
+	for _, c := range cfg {
+		if c.Rate <= 0 || c.Burst < 1 {
+			return nil, nil, fmt.Errorf("invalid callback rate limit of tenant %q: rate has to be positive and burst at least 1", c.Tenant)
+		}
+
+		limit := callbacker.TenantRateLimit{Rate: c.Rate, Burst: c.Burst}
+		if c.Tenant == "*" {
+			defaultLimit = &limit
+			continue
+		}
+		limits[c.Tenant] = limit
+	}
+
+	return limits, defaultLimit, nil
+}
+
+func newStore(dbConfig *config.DbConfig, cipher *encryption.Cipher) (s *postgresql.PostgreSQL, err error) {
 	switch dbConfig.Mode {
 	case DbModePostgres:
 		cfg := dbConfig.Postgres
@@ -141,7 +232,7 @@ func newStore(dbConfig *config.DbConfig) (s *postgresql.PostgreSQL, err error) {
 			"user=%s password=%s dbname=%s host=%s port=%d sslmode=%s",
 			cfg.User, cfg.Password, cfg.Name, cfg.Host, cfg.Port, cfg.SslMode,
 		)
-		s, err = postgresql.New(dbInfo, cfg.MaxIdleConns, cfg.MaxOpenConns)
+		s, err = postgresql.New(dbInfo, cfg.MaxIdleConns, cfg.MaxOpenConns, postgresql.WithCipher(cipher))
 		if err != nil {
 			return nil, fmt.Errorf("failed to open postgres DB: %v", err)
 		}
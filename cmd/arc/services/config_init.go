@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bitcoin-sv/arc/config"
+)
+
+// InitConfig generates a config file for a deployment profile, see `arc config init -help`. The values which are not
+// given by flags are asked for on stderr and read from stdin, unless -non-interactive is set.
+func InitConfig(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	fs := flag.NewFlagSet("config init", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	profile := fs.String("profile", "", "deployment profile: dev, production or federation")
+	network := fs.String("network", "", "bitcoin network: mainnet, testnet or regtest")
+	peers := fs.String("peers", "", "comma separated addresses host:port of the p2p ports of the nodes")
+	dbHost := fs.String("db-host", "", "host of the postgres database")
+	dbPort := fs.Int("db-port", 0, "port of the postgres database")
+	dbUser := fs.String("db-user", "", "user of the postgres database")
+	dbPassword := fs.String("db-password", "", "password of the postgres database")
+	mqURL := fs.String("mq-url", "", "URL of NATS")
+	redisAddr := fs.String("redis-addr", "", "address host:port of redis")
+	upstreams := fs.String("upstreams", "", "comma separated URLs of the upstream ARC instances of the federation profile")
+	outFile := fs.String("out", "config.yaml", "file the config is written to, - writes it to stdout. Name the file config.yaml to load it with -config=<directory>")
+	nonInteractive := fs.Bool("non-interactive", false, "do not ask for the values which are not given by flags, the defaults of the profile are used")
+	force := fs.Bool("force", false, "overwrite an existing file")
+
+	err := fs.Parse(args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	opts := config.InitOptions{
+		Profile:         *profile,
+		Network:         *network,
+		Peers:           splitFlagList(*peers),
+		DbHost:          *dbHost,
+		DbPort:          *dbPort,
+		DbUser:          *dbUser,
+		DbPassword:      *dbPassword,
+		MessageQueueURL: *mqURL,
+		RedisAddr:       *redisAddr,
+		Upstreams:       splitFlagList(*upstreams),
+	}
+
+	if !*nonInteractive {
+		err = config.Prompt(stdin, stderr, &opts)
+		if err != nil {
+			return err
+		}
+	} else if opts.Profile == "" {
+		opts.Profile = config.ProfileDev
+	}
+
+	data, err := config.Generate(opts)
+	if err != nil {
+		return fmt.Errorf("failed to generate config: %w", err)
+	}
+
+	if *outFile == "-" {
+		_, err = stdout.Write(data)
+		return err
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	if *force {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	}
+
+	// the file contains the passwords of the databases
+	f, err := os.OpenFile(*outFile, flags, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("config file %s exists already, use -force to overwrite it", *outFile)
+		}
+		return fmt.Errorf("failed to create config file: %w", err)
+	}
+
+	_, err = f.Write(data)
+	closeErr := f.Close()
+	if err = errors.Join(err, closeErr); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(stderr, "Config of the %s profile written to %s\n", opts.Profile, *outFile)
+
+	return nil
+}
+
+func splitFlagList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
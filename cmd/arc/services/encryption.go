@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/encryption"
+)
+
+const keyCommandTimeout = 30 * time.Second
+
+var ErrFailedToLoadEncryptionKey = errors.New("failed to load encryption key")
+
+// newCipher loads the encryption keys from the environment or with the key commands. It returns nil if the encryption
+// is disabled.
+func newCipher(cfg *config.EncryptionConfig) (*encryption.Cipher, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+
+	keys := make([]encryption.Key, 0, len(cfg.Keys))
+	for _, keyCfg := range cfg.Keys {
+		encoded, err := loadEncryptionKey(keyCfg)
+		if err != nil {
+			return nil, errors.Join(ErrFailedToLoadEncryptionKey, fmt.Errorf("key ID %s: %w", keyCfg.ID, err))
+		}
+
+		secret, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Join(ErrFailedToLoadEncryptionKey, fmt.Errorf("key ID %s is not base64 encoded: %w", keyCfg.ID, err))
+		}
+
+		keys = append(keys, encryption.Key{ID: keyCfg.ID, Secret: secret})
+	}
+
+	return encryption.NewCipher(keys, cfg.CurrentKeyID)
+}
+
+func loadEncryptionKey(keyCfg *config.EncryptionKeyConfig) (string, error) {
+	if keyCfg.KeyEnv != "" {
+		key, found := os.LookupEnv(keyCfg.KeyEnv)
+		if !found {
+			return "", fmt.Errorf("environment variable %s not set", keyCfg.KeyEnv)
+		}
+		return strings.TrimSpace(key), nil
+	}
+
+	if len(keyCfg.KeyCommand) == 0 {
+		return "", errors.New("neither keyEnv nor keyCommand given")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), keyCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, keyCfg.KeyCommand[0], keyCfg.KeyCommand[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("key command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
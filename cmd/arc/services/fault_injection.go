@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/fault"
+)
+
+// newFaultInjector creates the injector of the configured faults. It returns nil if fault injection is disabled.
+func newFaultInjector(logger *slog.Logger, cfg *config.FaultInjectionConfig) (*fault.Injector, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+
+	if !fault.Available {
+		return nil, fault.ErrNotAvailable
+	}
+
+	rules := make([]fault.Rule, 0, len(cfg.Rules))
+	for _, r := range cfg.Rules {
+		rules = append(rules, fault.Rule{
+			Point:   fault.Point(r.Point),
+			Latency: r.Latency,
+			Error:   r.Error,
+			Drop:    r.Drop,
+			After:   r.After,
+			Every:   r.Every,
+			Count:   r.Count,
+		})
+	}
+
+	logger.Warn("Fault injection enabled", slog.Int("rules", len(rules)))
+
+	return fault.New(logger, rules)
+}
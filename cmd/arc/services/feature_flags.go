@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/feature"
+)
+
+// NewFeatureFlags creates the feature flags shared by the services of the process. It returns nil if no flags are
+// configured, which enables every feature. Components are the components running in the process, the flags of other
+// components can only be overridden if the flags are synced.
+func NewFeatureFlags(logger *slog.Logger, cfg *config.FeatureFlagsConfig, cacheStore cache.Store, components ...string) (*feature.Flags, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	configured := map[string]bool{
+		string(feature.ReorgHandling):  cfg.ReorgHandling,
+		string(feature.CumulativeFees): cfg.CumulativeFees,
+		string(feature.Rebroadcast):    cfg.Rebroadcast,
+	}
+
+	opts := []func(*feature.Flags){feature.WithComponents(components...)}
+	if cfg.Sync {
+		opts = append(opts, feature.WithStore(cacheStore, cfg.SyncInterval))
+	}
+
+	return feature.New(logger, configured, opts...)
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"log/slog"
+	"path/filepath"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/nats_connection"
+)
+
+// newMqClient returns the message queue client of the service publishing the messages in the configured schema
+// versions. With the spool enabled the published messages are spooled to the subdirectory of the service while the
+// message queue cannot be reached. In the all-in-one mode the client of the in-process broker is returned.
+func newMqClient(logger *slog.Logger, arcConfig *config.ArcConfig, service string, jsOpts []nats_jetstream.Option, connOpts []nats_connection.Option) (mq.MessageQueueClient, error) {
+	mqCfg := arcConfig.MessageQueue
+
+	schemas, err := mq.Schemas.WithPublishVersions(mqCfg.SchemaVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	if arcConfig.IsAllInOneEnabled() {
+		return mq.NewSchemaClient(getInProcessBroker(logger, arcConfig.AllInOne).Client(), schemas), nil
+	}
+
+	mqClient, err := mq.NewMqClient(logger, mqCfg, jsOpts, connOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	if mqCfg.Spool == nil || !mqCfg.Spool.Enabled {
+		return mq.NewSchemaClient(mq.NewPrefixClient(mqClient, mqCfg.SubjectPrefix), schemas), nil
+	}
+
+	spoolingClient, err := mq.NewSpoolingClient(logger, mqClient, filepath.Join(mqCfg.Spool.Dir, service),
+		mq.WithSpoolMaxBytes(mqCfg.Spool.MaxBytes),
+		mq.WithSpoolDrainInterval(mqCfg.Spool.DrainInterval),
+	)
+	if err != nil {
+		mqClient.Shutdown()
+		return nil, err
+	}
+
+	return mq.NewSchemaClient(mq.NewPrefixClient(spoolingClient, mqCfg.SubjectPrefix), schemas), nil
+}
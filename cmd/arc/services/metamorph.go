@@ -5,11 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
+	"net/url"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/nats-io/nats.go/jetstream"
+	"github.com/ordishs/go-bitcoin"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.opentelemetry.io/otel/attribute"
-	"google.golang.org/grpc"
 
 	"github.com/bitcoin-sv/arc/config"
 	"github.com/bitcoin-sv/arc/internal/blocktx"
@@ -17,18 +22,28 @@ import (
 	"github.com/bitcoin-sv/arc/internal/cache"
 	"github.com/bitcoin-sv/arc/internal/callbacker"
 	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/internal/encryption"
+	"github.com/bitcoin-sv/arc/internal/fault"
+	"github.com/bitcoin-sv/arc/internal/feature"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/analytics"
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet"
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/mcast"
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store/postgresql"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/nats_connection"
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
@@ -37,7 +52,7 @@ const (
 	chanBufferSize = 4000
 )
 
-func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore cache.Store) (func(), error) {
+func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore cache.Store, peerOpts []p2p.PeerOptions, banManager *p2p.BanManager, memGuard *memlimit.Guard, featureFlags *feature.Flags, eventBus *events.Bus, logLevels *arcLogger.Levels) (func(), error) {
 	logger = logger.With(slog.String("service", "mtm"))
 	logger.Info("Starting")
 
@@ -54,6 +69,7 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 		processor       *metamorph.Processor
 		server          *metamorph.Server
 		healthServer    *grpc_utils.GrpcServer
+		partitions      *postgresql.PartitionManager
 
 		err error
 	)
@@ -66,35 +82,106 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 		logger.Info("Shutdown metamorph complete")
 	}
 
-	metamorphStore, err = NewMetamorphStore(mtmConfig.Db, arcConfig.Tracing)
+	cipher, err := newCipher(arcConfig.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %v", err)
+	}
+
+	sup := newSupervisor(logger, arcConfig.Supervisor)
+	shutdownFns = append(shutdownFns, sup.Shutdown)
+
+	// the dependencies are started in the order database, message queue, peers and servers
+	partitioningEnabled := mtmConfig.Partitioning != nil && mtmConfig.Partitioning.Enabled
+
+	var postgresOpts []func(*postgresql.PostgreSQL)
+	if partitioningEnabled {
+		postgresOpts = append(postgresOpts, postgresql.WithPartitioning())
+	}
+
+	metamorphStore, err = NewMetamorphStore(mtmConfig.Db, arcConfig.Tracing, mtmConfig.RejectedQuarantine, cipher, postgresOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create metamorph store: %v", err)
 	}
 
-	bcMediator, messenger, pm, multicaster, statusMessageCh, err = setupMtmBcNetworkCommunication(logger, metamorphStore, arcConfig, mtmConfig.Health.MinimumHealthyConnections, bcMediatorOpts)
+	err = sup.WaitFor("database", func() error { return pingStore(metamorphStore) })
 	if err != nil {
 		stopFn()
 		return nil, err
 	}
 
+	postgresStore, isPostgres := metamorphStore.(*postgresql.PostgreSQL)
+	switch {
+	case partitioningEnabled && !isPostgres:
+		stopFn()
+		return nil, errors.New("partitioning requires the postgres store")
+	case partitioningEnabled:
+		// the table is partitioned and the partitions are maintained by a job of the processor
+		partitions = postgresql.NewPartitionManager(logger, postgresStore, mtmConfig.Partitioning.RetentionDays,
+			postgresql.WithPartitionManagerInterval(mtmConfig.Partitioning.Interval),
+			postgresql.WithPartitionsAhead(mtmConfig.Partitioning.PartitionsAhead),
+		)
+	case isPostgres:
+		// transactions cannot be upserted by their primary key once the table has been partitioned
+		partitioned, err := postgresStore.IsPartitioned(context.Background())
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to check partitioning of transactions table: %v", err)
+		}
+		if partitioned {
+			stopFn()
+			return nil, errors.New("the transactions table is partitioned, metamorph.partitioning.enabled has to be set")
+		}
+	}
+
 	// maximum amount of messages that could be coming from a single block
 	minedTxsChan := make(chan *blocktx_api.TransactionBlocks, chanBufferSize)
 	submittedTxsChan := make(chan *metamorph_api.PostTransactionRequest, chanBufferSize)
 
 	var mqOpts []nats_jetstream.Option
 	if arcConfig.MessageQueue.Initialize {
-		mqOpts = getMtmMqOpts()
+		mqOpts = getMtmMqOpts(arcConfig.MessageQueue.SubjectPrefix)
 	}
+	mqOpts = append(mqOpts, getMqTracerOpts(arcConfig)...)
 
 	connOpts := []nats_connection.Option{nats_connection.WithMaxReconnects(-1)}
-	mqClient, err = mq.NewMqClient(logger, arcConfig.MessageQueue, mqOpts, connOpts)
+	mqClient, err = supervisor.Retry(sup, "message queue", func() (mq.MessageQueueClient, error) {
+		return newMqClient(logger, arcConfig, "metamorph", mqOpts, connOpts)
+	})
+	if err != nil {
+		stopFn()
+		return nil, err
+	}
+
+	bcMediator, messenger, pm, multicaster, statusMessageCh, err = setupMtmBcNetworkCommunication(logger, metamorphStore, arcConfig, mtmConfig.Health.MinimumHealthyConnections, bcMediatorOpts, peerOpts)
 	if err != nil {
+		stopFn()
 		return nil, err
 	}
 
+	minimumHealthyConnections := mtmConfig.Health.MinimumHealthyConnections
+	if arcConfig.IsSimulatorEnabled() {
+		// metamorph is connected to a single simulated peer
+		minimumHealthyConnections = 1
+		shutdownFns = append(shutdownFns, getSimulatedNetwork(logger, arcConfig.Simulator).Shutdown)
+	}
+
+	faultInjector, err := newFaultInjector(logger, arcConfig.FaultInjection)
+	if err != nil {
+		stopFn()
+		return nil, fmt.Errorf("failed to create fault injector: %v", err)
+	}
+
+	var processorStore store.MetamorphStore = metamorphStore
+	var processorMediator metamorph.Mediator = bcMediator
+	if faultInjector != nil {
+		processorStore = fault.NewStore(metamorphStore, faultInjector)
+		processorMediator = fault.NewMediator(bcMediator, faultInjector)
+		mqClient = fault.NewMessageQueueClient(mqClient, faultInjector)
+	}
+
 	procLogger := logger.With(slog.String("module", "mtm-proc"))
 
-	callbackerConn, err := initGrpcCallbackerConn(arcConfig.Callbacker.DialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing)
+	callbackerConn, err := initGrpcCallbackerConn(arcConfig)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("failed to create callbacker client: %v", err)
@@ -102,7 +189,7 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 
 	callbackSender := callbacker.NewGrpcCallbacker(callbackerConn, procLogger, callbackerOpts...)
 
-	btcConn, err := grpc_utils.DialGRPC(arcConfig.Blocktx.DialAddr, arcConfig.Prometheus.Endpoint, arcConfig.GrpcMessageSize, arcConfig.Tracing)
+	btcConn, err := dialGrpc(arcConfig, "blocktx", arcConfig.Blocktx.DialAddr, arcConfig.Tracing, arcConfig.Blocktx.GrpcAuth)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to blocktx server: %v", err)
 	}
@@ -125,17 +212,105 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 		metamorph.WithCallbackSender(callbackSender),
 		metamorph.WithStatTimeLimits(mtmConfig.Stats.NotSeenTimeLimit, mtmConfig.Stats.NotFinalTimeLimit),
 		metamorph.WithMaxRetries(mtmConfig.MaxRetries),
-		metamorph.WithMinimumHealthyConnections(mtmConfig.Health.MinimumHealthyConnections),
+		metamorph.WithMinimumHealthyConnections(minimumHealthyConnections),
+		metamorph.WithEventBus(eventBus),
 		metamorph.WithBlocktxClient(blockTxClient),
 		metamorph.WithDoubleSpendCheckInterval(mtmConfig.DoubleSpendCheckInterval),
 		metamorph.WithDoubleSpendTxStatusOlderThanInterval(mtmConfig.DoubleSpendTxStatusOlderThanInterval),
 		metamorph.WithTrackOnly(mtmConfig.TrackOnly),
+		metamorph.WithPublishStatusUpdates(mtmConfig.PublishStatusUpdates),
+		metamorph.WithMalleabilityDetection(mtmConfig.MalleabilityDetection),
+		metamorph.WithMemoryGuard(memGuard),
+		metamorph.WithFeatureFlags(featureFlags),
+		metamorph.WithCancellationWindow(mtmConfig.CancellationWindow),
+		metamorph.WithLateStatusHistory(mtmConfig.LateStatusHistory),
+		metamorph.WithJobConfigs(toJobConfigs(mtmConfig.Jobs)),
 	)
 
+	if mtmConfig.KnownTxFilter != nil && mtmConfig.KnownTxFilter.Enabled {
+		if arcConfig.Cache.Engine != config.InMemory {
+			stopFn()
+			return nil, fmt.Errorf("known transaction filter requires cache engine %s, configured cache engine is %s", config.InMemory, arcConfig.Cache.Engine)
+		}
+		processorOpts = append(processorOpts, metamorph.WithKnownTxFilter(mtmConfig.KnownTxFilter.Capacity, mtmConfig.KnownTxFilter.FalsePositiveRate, mtmConfig.KnownTxFilter.RebuildInterval))
+	}
+
+	if mtmConfig.SLAReports != nil && mtmConfig.SLAReports.Enabled {
+		processorOpts = append(processorOpts, metamorph.WithSLAReports(mtmConfig.SLAReports.Interval))
+	}
+
+	if mtmConfig.Reconciliation != nil && mtmConfig.Reconciliation.Enabled {
+		pc := arcConfig.PeerRPC
+		reconciliationNode, err := rpc_client.NewRPCClient(pc.Host, pc.Port, pc.User, pc.Password)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create rpc client of reconciliation node: %v", err)
+		}
+		processorOpts = append(processorOpts, metamorph.WithStatusReconciliation(reconciliationNode, mtmConfig.Reconciliation.Interval, mtmConfig.Reconciliation.StaleAfter))
+		if mtmConfig.Reconciliation.OnStart {
+			processorOpts = append(processorOpts, metamorph.WithReconciliationOnStart())
+		}
+	}
+
+	if mtmConfig.ConsistencyCheck != nil && mtmConfig.ConsistencyCheck.Enabled {
+		processorOpts = append(processorOpts, metamorph.WithConsistencyCheck(mtmConfig.ConsistencyCheck.Interval, mtmConfig.ConsistencyCheck.Blocks))
+	}
+
+	if fallback := mtmConfig.NodeSubmitFallback; fallback != nil && fallback.Enabled {
+		nodeConfig := arcConfig.PeerRPC
+		if fallback.Node != nil {
+			nodeConfig = fallback.Node
+		}
+
+		fallbackNode, err := newNodeRPCClient(nodeConfig)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create rpc client of fallback node: %v", err)
+		}
+		processorOpts = append(processorOpts, metamorph.WithNodeSubmitFallback(fallbackNode, fallback.Timeout, fallback.Interval))
+	}
+
+	if mtmConfig.StatusExport != nil && mtmConfig.StatusExport.Enabled {
+		hostname, err := os.Hostname()
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to get hostname: %v", err)
+		}
+
+		statusExporter, err := status_export.New(logger, mtmConfig.StatusExport.Dir, mtmConfig.StatusExport.UploadCommand,
+			status_export.WithRotateInterval(mtmConfig.StatusExport.RotateInterval),
+			status_export.WithMaxFileSize(mtmConfig.StatusExport.MaxFileSize),
+			status_export.WithUploadTimeout(mtmConfig.StatusExport.UploadTimeout),
+			status_export.WithFilePrefix("status-events-"+hostname),
+		)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create status exporter: %v", err)
+		}
+		statusExporter.Start()
+		shutdownFns = append(shutdownFns, statusExporter.Shutdown)
+		processorOpts = append(processorOpts, metamorph.WithStatusExporter(statusExporter))
+	}
+
+	if mtmConfig.Analytics != nil && mtmConfig.Analytics.Enabled {
+		analyticsSink, err := newAnalyticsSink(logger, mtmConfig.Analytics, metamorphStore)
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to create analytics sink: %v", err)
+		}
+		err = analyticsSink.Start()
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to start analytics sink: %v", err)
+		}
+		shutdownFns = append(shutdownFns, analyticsSink.Shutdown)
+		processorOpts = append(processorOpts, metamorph.WithStatusExporter(analyticsSink))
+	}
+
 	processor, err = metamorph.NewProcessor(
-		metamorphStore,
+		processorStore,
 		cacheStore,
-		bcMediator,
+		processorMediator,
 		statusMessageCh,
 		processorOpts...,
 	)
@@ -143,7 +318,15 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 		stopFn()
 		return nil, err
 	}
-	err = processor.Start(arcConfig.Prometheus.IsEnabled())
+
+	if partitions != nil {
+		err = partitions.Schedule(processor.Scheduler())
+		if err != nil {
+			stopFn()
+			return nil, fmt.Errorf("failed to schedule partition maintenance: %v", err)
+		}
+	}
+	err = processor.Start(arcConfig.IsMetricsEnabled())
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("failed to start metamorph processor: %v", err)
@@ -154,6 +337,23 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 		MaxMsgSize:         arcConfig.GrpcMessageSize,
 		TracingConfig:      arcConfig.Tracing,
 		Name:               "metamorph",
+		Auth:               arcConfig.Metamorph.GrpcAuth,
+	}
+
+	optsServer = append(optsServer, metamorph.WithRejectedQuarantine(mtmConfig.RejectedQuarantine), metamorph.WithServerCallbackSender(callbackSender), metamorph.WithServerScheduler(processor.Scheduler()), metamorph.WithServerBanManager(banManager), metamorph.WithServerLogLevels(logLevels), metamorph.WithServerPublishStatusUpdates(mtmConfig.PublishStatusUpdates))
+	if mtmConfig.BlockTemplates != nil && mtmConfig.BlockTemplates.Enabled {
+		blockTemplates := metamorph.NewBlockTemplates(logger, metamorphStore, cacheStore, metamorph.WithBlockTemplateExpiry(mtmConfig.BlockTemplates.Expiry))
+		optsServer = append(optsServer, metamorph.WithBlockTemplates(blockTemplates))
+
+		err = startMiningCandidatePoller(logger, mtmConfig.BlockTemplates.MiningCandidates, blockTemplates, &shutdownFns)
+		if err != nil {
+			stopFn()
+			return nil, err
+		}
+	}
+	if mtmConfig.BulkExport != nil && mtmConfig.BulkExport.Enabled {
+		// the transactions are exported by the admin endpoint of the API server
+		optsServer = append(optsServer, metamorph.WithServerBulkExport(mtmConfig.BulkExport.BatchSize))
 	}
 
 	server, err = metamorph.NewServer(logger, metamorphStore, processor, mqClient, serverCfg, optsServer...)
@@ -161,16 +361,47 @@ func StartMetamorph(logger *slog.Logger, arcConfig *config.ArcConfig, cacheStore
 		stopFn()
 		return nil, fmt.Errorf("create GRPCServer failed: %v", err)
 	}
-	err = server.ListenAndServe(mtmConfig.ListenAddr)
+	err = serveGrpc(arcConfig, &server.GrpcServer, "metamorph", mtmConfig.ListenAddr)
 	if err != nil {
 		stopFn()
 		return nil, fmt.Errorf("serve GRPC server failed: %v", err)
 	}
-	err = startZMQs(logger, arcConfig.Metamorph.BlockchainNetwork.Peers, stopFn, statusMessageCh, &shutdownFns)
+	if !arcConfig.IsSimulatorEnabled() {
+		startZMQs(logger, arcConfig.Metamorph.BlockchainNetwork.Peers, statusMessageCh, sup)
+	}
+	return stopFn, nil
+}
+
+func newAnalyticsSink(logger *slog.Logger, cfg *config.AnalyticsConfig, metamorphStore store.MetamorphStore) (*analytics.Sink, error) {
+	var writer analytics.Writer
+	var err error
+
+	switch cfg.Sink {
+	case "clickhouse":
+		writer, err = analytics.NewClickHouseWriter(cfg.ClickHouse.URL, cfg.ClickHouse.Database, cfg.ClickHouse.Table,
+			analytics.WithClickHouseCredentials(cfg.ClickHouse.User, cfg.ClickHouse.Password),
+		)
+	case "bigquery":
+		writer, err = analytics.NewBigQueryWriter(cfg.BigQuery.Project, cfg.BigQuery.Dataset, cfg.BigQuery.Table, cfg.BigQuery.TokenCommand,
+			analytics.WithTokenTTL(cfg.BigQuery.TokenTTL),
+		)
+	default:
+		return nil, fmt.Errorf("unknown analytics sink: %s", cfg.Sink)
+	}
 	if err != nil {
 		return nil, err
 	}
-	return stopFn, nil
+
+	opts := []func(*analytics.Sink){
+		analytics.WithBatchSize(cfg.BatchSize),
+		analytics.WithFlushInterval(cfg.FlushInterval),
+		analytics.WithBufferSize(cfg.BufferSize),
+	}
+	if cfg.Backfill != nil && cfg.Backfill.Enabled {
+		opts = append(opts, analytics.WithBackfill(metamorphStore, cfg.Backfill.Since))
+	}
+
+	return analytics.NewSink(logger, writer, opts...), nil
 }
 
 func enableTracing(arcConfig *config.ArcConfig, logger *slog.Logger) ([]func(), []metamorph.ServerOption, []metamorph.Option, []callbacker.Option, []bcnet.Option) {
@@ -181,7 +412,7 @@ func enableTracing(arcConfig *config.ArcConfig, logger *slog.Logger) ([]func(),
 	bcMediatorOpts := make([]bcnet.Option, 0)
 
 	if arcConfig.IsTracingEnabled() {
-		cleanup, err := tracing.Enable(logger, "metamorph", arcConfig.Tracing.DialAddr, arcConfig.Tracing.Sample)
+		cleanup, err := tracing.EnableWithOptions(logger, "metamorph", arcConfig.Tracing.DialAddr, getTracingOpts(arcConfig.Tracing)...)
 		if err != nil {
 			logger.Error("failed to enable tracing", slog.String("err", err.Error()))
 		} else {
@@ -203,7 +434,9 @@ func enableTracing(arcConfig *config.ArcConfig, logger *slog.Logger) ([]func(),
 	return shutdownFns, optsServer, processorOpts, callbackerOpts, bcMediatorOpts
 }
 
-func startZMQs(logger *slog.Logger, peers []*config.PeerConfig, stopFn func(), statusMessageCh chan *metamorph_p2p.TxStatusMessage, shutdownFns *[]func()) error {
+// startZMQs starts a ZMQ listener for each peer with a ZMQ URL. The listeners are restarted by the supervisor if they
+// stop listening because of an error.
+func startZMQs(logger *slog.Logger, peers []*config.PeerConfig, statusMessageCh chan *metamorph_p2p.TxStatusMessage, sup *supervisor.Supervisor) {
 	for i, peerSetting := range peers {
 		zmqURL, err := peerSetting.GetZMQUrl()
 		if err != nil {
@@ -215,36 +448,144 @@ func startZMQs(logger *slog.Logger, peers []*config.PeerConfig, stopFn func(), s
 			continue
 		}
 
-		zmqHandler := metamorph.NewZMQHandler(context.Background(), zmqURL, logger)
-		zmq, err := metamorph.NewZMQ(zmqURL, statusMessageCh, zmqHandler, logger)
-		if err != nil {
-			stopFn()
-			return fmt.Errorf("failed to create ZMQ: %v", err)
-		}
-		logger.Info("Listening to ZMQ", slog.String("host", zmqURL.Hostname()), slog.String("port", zmqURL.Port()))
+		sup.Go("zmq-"+zmqURL.Host, func(ctx context.Context) error {
+			ctx, cancel := context.WithCancel(ctx)
+			zmqHandler := metamorph.NewZMQHandler(ctx, zmqURL, logger)
+			zmq, err := metamorph.NewZMQ(zmqURL, statusMessageCh, zmqHandler, logger)
+			if err != nil {
+				cancel()
+				return fmt.Errorf("failed to create ZMQ: %v", err)
+			}
+			logger.Info("Listening to ZMQ", slog.String("host", zmqURL.Hostname()), slog.String("port", zmqURL.Port()))
+
+			cleanup, err := zmq.Start()
+			if err != nil {
+				err = fmt.Errorf("failed to start ZMQ: %v", err)
+				cancel()
+			}
+
+			// the messages channel is closed once the handler stopped sending
+			<-zmqHandler.Done()
+			cancel()
+			cleanup()
+
+			if err != nil {
+				return err
+			}
+
+			return zmqHandler.Err()
+		})
+	}
+}
+
+func startMiningCandidatePoller(logger *slog.Logger, cfg *config.MiningCandidatesConfig, blockTemplates *metamorph.BlockTemplates, shutdownFns *[]func()) error {
+	if cfg == nil || len(cfg.Nodes) == 0 {
+		return nil
+	}
 
-		cleanup, err := zmq.Start()
-		*shutdownFns = append(*shutdownFns, cleanup)
+	nodes := make(map[string]metamorph.MiningCandidateNode, len(cfg.Nodes))
+	for _, node := range cfg.Nodes {
+		b, err := newNodeRPCClient(node)
 		if err != nil {
-			stopFn()
-			return fmt.Errorf("failed to start ZMQ: %v", err)
+			return fmt.Errorf("failed to create rpc client of mining candidate node: %v", err)
 		}
+
+		nodes[fmt.Sprintf("%s:%d", node.Host, node.Port)] = b
 	}
+
+	poller := metamorph.NewMiningCandidatePoller(logger, blockTemplates, nodes, metamorph.WithMiningCandidatePollInterval(cfg.PollInterval))
+	poller.Start()
+	*shutdownFns = append(*shutdownFns, poller.Shutdown)
+
 	return nil
 }
 
-func getMtmMqOpts() []nats_jetstream.Option {
-	submitStreamName := fmt.Sprintf("%s-stream", mq.SubmitTxTopic)
-	submitConsName := fmt.Sprintf("%s-cons", mq.SubmitTxTopic)
+func newNodeRPCClient(node *config.PeerRPCConfig) (*bitcoin.Bitcoind, error) {
+	rpcURL := &url.URL{
+		Scheme: "rpc",
+		User:   url.UserPassword(node.User, node.Password),
+		Host:   net.JoinHostPort(node.Host, strconv.Itoa(node.Port)),
+	}
+
+	return bitcoin.NewFromURL(rpcURL, false)
+}
+
+func getMtmMqOpts(subjectPrefix string) []nats_jetstream.Option {
+	submitTopic := mq.PrefixedTopic(subjectPrefix, mq.SubmitTxTopic)
+	submitStreamName := mq.PrefixedName(subjectPrefix, fmt.Sprintf("%s-stream", mq.SubmitTxTopic))
+	submitConsName := mq.PrefixedName(subjectPrefix, fmt.Sprintf("%s-cons", mq.SubmitTxTopic))
 
 	mqOpts := []nats_jetstream.Option{
-		nats_jetstream.WithStream(mq.SubmitTxTopic, submitStreamName, jetstream.WorkQueuePolicy, false),
-		nats_jetstream.WithConsumer(mq.SubmitTxTopic, submitStreamName, submitConsName, true, jetstream.AckExplicitPolicy),
+		nats_jetstream.WithStream(submitTopic, submitStreamName, jetstream.WorkQueuePolicy, false),
+		nats_jetstream.WithConsumer(submitTopic, submitStreamName, submitConsName, true, jetstream.AckExplicitPolicy),
 	}
 	return mqOpts
 }
 
-func NewMetamorphStore(dbConfig *config.DbConfig, tracingConfig *config.TracingConfig) (s store.MetamorphStore, err error) {
+// getMqTracerOpts returns the options enabling the tracing of the publishing and consumption of messages if tracing is
+// enabled. The trace context is carried in the message headers regardless.
+func getMqTracerOpts(arcConfig *config.ArcConfig) []nats_jetstream.Option {
+	if !arcConfig.IsTracingEnabled() {
+		return nil
+	}
+
+	return []nats_jetstream.Option{nats_jetstream.WithTracer(arcConfig.Tracing.KeyValueAttributes...)}
+}
+
+// getTracingOpts returns the sampling options of the tracing configuration.
+func getTracingOpts(cfg *config.TracingConfig) []tracing.Option {
+	opts := []tracing.Option{tracing.WithSample(cfg.Sample)}
+
+	if cfg.ParentBased {
+		opts = append(opts, tracing.WithParentBased())
+	}
+
+	if len(cfg.SamplingOverrides) > 0 {
+		overrides := make(map[string]int, len(cfg.SamplingOverrides))
+		for _, override := range cfg.SamplingOverrides {
+			overrides[override.SpanName] = override.Sample
+		}
+		opts = append(opts, tracing.WithSamplingOverrides(overrides))
+	}
+
+	if cfg.TailSampling != nil && cfg.TailSampling.Enabled {
+		opts = append(opts, tracing.WithTailSampling(tracing.ExportFailedOrSlowSpans(cfg.TailSampling.MinDuration)))
+	}
+
+	if cfg.Exporter != nil {
+		exporter := tracing.ExporterConfig{
+			Protocol: cfg.Exporter.Protocol,
+			Headers:  cfg.Exporter.Headers,
+			Timeout:  cfg.Exporter.Timeout,
+		}
+		if cfg.Exporter.TLS != nil && cfg.Exporter.TLS.Enabled {
+			exporter.TLS = &tracing.TLSConfig{
+				CAFile:             cfg.Exporter.TLS.CAFile,
+				CertFile:           cfg.Exporter.TLS.CertFile,
+				KeyFile:            cfg.Exporter.TLS.KeyFile,
+				InsecureSkipVerify: cfg.Exporter.TLS.InsecureSkipVerify,
+			}
+		}
+		opts = append(opts, tracing.WithExporter(exporter))
+	}
+
+	if cfg.Batch != nil {
+		opts = append(opts, tracing.WithBatch(tracing.BatchConfig{
+			MaxQueueSize:       cfg.Batch.MaxQueueSize,
+			MaxExportBatchSize: cfg.Batch.MaxExportBatchSize,
+			BatchTimeout:       cfg.Batch.BatchTimeout,
+			ExportTimeout:      cfg.Batch.ExportTimeout,
+		}))
+	}
+
+	if len(cfg.ResourceDetectors) > 0 {
+		opts = append(opts, tracing.WithResourceDetectors(cfg.ResourceDetectors...))
+	}
+
+	return opts
+}
+
+func NewMetamorphStore(dbConfig *config.DbConfig, tracingConfig *config.TracingConfig, rejectedQuarantine time.Duration, cipher *encryption.Cipher, postgresOpts ...func(*postgresql.PostgreSQL)) (s store.MetamorphStore, err error) {
 	hostname, err := os.Hostname()
 	if err != nil {
 		return nil, err
@@ -259,10 +600,14 @@ func NewMetamorphStore(dbConfig *config.DbConfig, tracingConfig *config.TracingC
 			postgres.User, postgres.Password, postgres.Name, postgres.Host, postgres.Port, postgres.SslMode,
 		)
 
-		opts := make([]func(postgreSQL *postgresql.PostgreSQL), 0)
+		opts := []func(postgreSQL *postgresql.PostgreSQL){postgresql.WithRejectedQuarantine(rejectedQuarantine)}
 		if tracingConfig != nil && tracingConfig.IsEnabled() {
 			opts = append(opts, postgresql.WithTracing(tracingConfig.KeyValueAttributes))
 		}
+		if cipher != nil {
+			opts = append(opts, postgresql.WithCipher(cipher))
+		}
+		opts = append(opts, postgresOpts...)
 
 		s, err = postgresql.New(dbInfo, hostname, postgres.MaxIdleConns, postgres.MaxOpenConns, opts...)
 		if err != nil {
@@ -304,7 +649,7 @@ func NewMetamorphStore(dbConfig *config.DbConfig, tracingConfig *config.TracingC
 // Message Handlers:
 // - `metamorph_p2p.NewMsgHandler`: Used in classic mode, handling all communication via P2P.
 // - `metamorph_p2p.NewHybridMsgHandler`: Used in hybrid mode, integrating P2P communication with multicast group updates.
-func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcConfig *config.ArcConfig, minConnections int, mediatorOpts []bcnet.Option) (
+func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcConfig *config.ArcConfig, minConnections int, mediatorOpts []bcnet.Option, peerOpts []p2p.PeerOptions) (
 	mediator *bcnet.Mediator, messenger *p2p.NetworkMessenger, manager *p2p.PeerManager, multicaster *mcast.Multicaster,
 	messageCh chan *metamorph_p2p.TxStatusMessage, err error) {
 	defer func() {
@@ -340,9 +685,27 @@ func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcC
 	messageCh = make(chan *metamorph_p2p.TxStatusMessage, 10000)
 	var msgHandler p2p.MessageHandlerI
 
+	var msgHandlerOpts []metamorph_p2p.Option
+	if sharedTxs := arcConfig.Metamorph.SharedTxs; sharedTxs != nil && sharedTxs.Enabled {
+		msgHandlerOpts = append(msgHandlerOpts, metamorph_p2p.WithSharedTxs(sharedTxs.TTL))
+	}
+
+	if arcConfig.IsSimulatorEnabled() {
+		// the configured peers and mcast groups are replaced by a single peer of the simulated network
+		manager = p2p.NewPeerManager(l.With(slog.String("module", "peer-mng")), network)
+		err = manager.AddPeer(getSimulatedNetwork(l, arcConfig.Simulator).NewPeer(metamorph_p2p.NewMsgHandler(l, s, messageCh, msgHandlerOpts...), network))
+		if err != nil {
+			return
+		}
+
+		messenger = p2p.NewNetworkMessenger(l, manager)
+		mediator = bcnet.NewMediator(l, true, messenger, nil, mediatorOpts...)
+		return
+	}
+
 	switch cfg.Mode {
 	case "classic":
-		msgHandler = metamorph_p2p.NewMsgHandler(l, s, messageCh)
+		msgHandler = metamorph_p2p.NewMsgHandler(l, s, messageCh, msgHandlerOpts...)
 	case "hybrid":
 		l.Info("!!! Metamorph will communicate with blockchain in HYBRID mode (via p2p and multicast groups) !!!")
 		msgHandler = metamorph_p2p.NewHybridMsgHandler(l, messageCh)
@@ -351,6 +714,12 @@ func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcC
 		return
 	}
 
+	var meter *p2p.ThroughputMeter
+	if cfg.Autoscaling != nil && cfg.Autoscaling.Enabled {
+		meter = &p2p.ThroughputMeter{}
+		msgHandler = p2p.NewMeteredMessageHandler(msgHandler, meter, p2p.TxAnnouncements)
+	}
+
 	// connect to peers
 	var managerOpts []p2p.PeerManagerOptions
 	if arcConfig.Metamorph.MonitorPeers {
@@ -359,9 +728,8 @@ func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcC
 
 	manager = p2p.NewPeerManager(l.With(slog.String("module", "peer-mng")), network, managerOpts...)
 	connectionsReady := make(chan struct{})
-	go connectToPeers(l, manager, connectionsReady, minConnections, network, msgHandler, cfg.Peers,
-		p2p.WithNrOfWriteHandlers(8),
-		p2p.WithWriteChannelSize(4096))
+	metamorphPeerOpts := append([]p2p.PeerOptions{p2p.WithNrOfWriteHandlers(8), p2p.WithWriteChannelSize(4096)}, peerOpts...)
+	go connectToPeers(l, manager, connectionsReady, minConnections, network, msgHandler, cfg.Peers, metamorphPeerOpts...)
 	if err != nil {
 		return
 	}
@@ -370,6 +738,14 @@ func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcC
 	<-connectionsReady
 	l.Info("current open peer connections", slog.Uint64("count", uint64(manager.CountConnectedPeers())))
 
+	if meter != nil {
+		err = startPeerAutoscaler(l, cfg.Autoscaling, manager, meter, network, msgHandler, metamorphPeerOpts...)
+		if err != nil {
+			err = fmt.Errorf("failed to start peer autoscaler: %w", err)
+			return
+		}
+	}
+
 	// connect to mcast
 	if cfg.Mode == "hybrid" {
 		if cfg.Mcast == nil {
@@ -390,17 +766,52 @@ func setupMtmBcNetworkCommunication(l *slog.Logger, s store.MetamorphStore, arcC
 		}
 	}
 
-	messenger = p2p.NewNetworkMessenger(l, manager)
+	var messengerOpts []p2p.NetworkMessengerOption
+	if invBatching := arcConfig.Metamorph.InvBatching; invBatching != nil {
+		messengerOpts = append(messengerOpts, p2p.WithInvBatching(invBatching.MaxInvs, invBatching.Interval))
+		if invBatching.Trickle {
+			messengerOpts = append(messengerOpts, p2p.WithInvTrickle())
+		}
+	}
+	if throttle := arcConfig.Metamorph.AnnouncementThrottle; throttle != nil && throttle.TxsPerSecond > 0 {
+		messengerOpts = append(messengerOpts, p2p.WithAnnouncementRateLimit(throttle.TxsPerSecond, throttle.MaxQueued))
+
+		if arcConfig.IsMetricsEnabled() {
+			announcementMetrics := p2p.NewAnnouncementMetrics()
+			err = prometheus.Register(announcementMetrics)
+			if err != nil {
+				err = fmt.Errorf("failed to register announcement metrics: %v", err)
+				return
+			}
+			messengerOpts = append(messengerOpts, p2p.WithAnnouncementMetrics(announcementMetrics))
+		}
+	}
+
+	messenger = p2p.NewNetworkMessenger(l, manager, messengerOpts...)
+
+	designatedPeers := make(map[string]string)
+	for _, peer := range cfg.Peers {
+		if peer.Name == "" {
+			continue
+		}
+
+		var url string
+		url, err = peer.GetP2PUrl()
+		if err != nil {
+			return
+		}
+		designatedPeers[peer.Name] = url
+	}
+	if len(designatedPeers) > 0 {
+		mediatorOpts = append(mediatorOpts, bcnet.WithDesignatedPeers(designatedPeers))
+	}
+
 	mediator = bcnet.NewMediator(l, cfg.Mode == "classic", messenger, multicaster, mediatorOpts...)
 	return
 }
 
-func initGrpcCallbackerConn(address, prometheusEndpoint string, grpcMsgSize int, tracingConfig *config.TracingConfig) (callbacker_api.CallbackerAPIClient, error) {
-	dialOpts, err := grpc_utils.GetGRPCClientOpts(prometheusEndpoint, grpcMsgSize, tracingConfig)
-	if err != nil {
-		return nil, err
-	}
-	callbackerConn, err := grpc.NewClient(address, dialOpts...)
+func initGrpcCallbackerConn(arcConfig *config.ArcConfig) (callbacker_api.CallbackerAPIClient, error) {
+	callbackerConn, err := dialGrpc(arcConfig, "callbacker", arcConfig.Callbacker.DialAddr, arcConfig.Tracing, arcConfig.Callbacker.GrpcAuth)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/metrics"
+)
+
+// NewMetricsBackend returns the backend the metrics of the default Prometheus registry are emitted to, or nil if the
+// metrics are disabled.
+func NewMetricsBackend(logger *slog.Logger, arcConfig *config.ArcConfig) (metrics.Backend, error) {
+	if !arcConfig.IsMetricsEnabled() {
+		return nil, nil
+	}
+
+	backend := metrics.BackendPrometheus
+	if arcConfig.Metrics != nil {
+		var err error
+		backend, err = metrics.ParseBackend(arcConfig.Metrics.Backend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if backend == metrics.BackendPrometheus {
+		return metrics.NewPrometheusBackend(logger, prometheus.DefaultGatherer, arcConfig.Prometheus.Addr, arcConfig.Prometheus.Endpoint), nil
+	}
+
+	cfg := arcConfig.Metrics.StatsD
+	opts := []func(*metrics.StatsDBackend){metrics.WithPrefix(cfg.Prefix)}
+	if cfg.Interval > 0 {
+		opts = append(opts, metrics.WithPushInterval(cfg.Interval))
+	}
+	if backend == metrics.BackendDogStatsD {
+		opts = append(opts, metrics.WithDogStatsD(cfg.Tags))
+	}
+
+	return metrics.NewStatsDBackend(logger, prometheus.DefaultGatherer, cfg.Addr, opts...), nil
+}
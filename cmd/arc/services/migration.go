@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"fmt"
+	"io/fs"
+	"log/slog"
+
+	"github.com/bitcoin-sv/arc/config"
+	blocktxPostgres "github.com/bitcoin-sv/arc/internal/blocktx/store/postgresql"
+	callbackerPostgres "github.com/bitcoin-sv/arc/internal/callbacker/store/postgresql"
+	metamorphPostgres "github.com/bitcoin-sv/arc/internal/metamorph/store/postgresql"
+	"github.com/bitcoin-sv/arc/internal/migration"
+)
+
+// MigrateDatabases applies the pending migrations of the postgres databases of the given services. The migrations
+// tables are the same as those used by the `migrate` CLI, so that databases migrated before are continued. In dry-run
+// mode the pending migrations are only logged.
+func MigrateDatabases(logger *slog.Logger, arcConfig *config.ArcConfig, dryRun bool, metamorph bool, blocktx bool, callbacker bool) (*migration.Runner, error) {
+	opts := []migration.Option{migration.WithDryRun(dryRun)}
+	if cfg := arcConfig.Migrations; cfg != nil {
+		opts = append(opts,
+			migration.WithLockTimeout(cfg.LockTimeout),
+			migration.WithBackfillBatches(cfg.BackfillBatchSize, cfg.BackfillPause),
+			migration.WithContract(cfg.Contract),
+		)
+	}
+
+	return runMigrations(migration.NewRunner(logger, opts...), arcConfig, metamorph, blocktx, callbacker)
+}
+
+// CheckDatabases checks whether the schemas of the postgres databases of the given services are compatible with this
+// version of ARC without migrating them, e.g. if they are migrated by the `migrate` CLI.
+func CheckDatabases(logger *slog.Logger, arcConfig *config.ArcConfig, metamorph bool, blocktx bool, callbacker bool) (*migration.Runner, error) {
+	return runMigrations(migration.NewRunner(logger, migration.WithCheckOnly()), arcConfig, metamorph, blocktx, callbacker)
+}
+
+func runMigrations(runner *migration.Runner, arcConfig *config.ArcConfig, metamorph bool, blocktx bool, callbacker bool) (*migration.Runner, error) {
+	var targets []migration.Target
+	if metamorph && arcConfig.Metamorph != nil {
+		targets = appendMigrationTarget(targets, "metamorph", arcConfig.Metamorph.Db, metamorphPostgres.Migrations(), metamorphPostgres.Backfills())
+	}
+	if blocktx && arcConfig.Blocktx != nil {
+		targets = appendMigrationTarget(targets, "blocktx", arcConfig.Blocktx.Db, blocktxPostgres.Migrations(), blocktxPostgres.Backfills())
+	}
+	if callbacker && arcConfig.Callbacker != nil {
+		targets = appendMigrationTarget(targets, "callbacker", arcConfig.Callbacker.Db, callbackerPostgres.Migrations(), callbackerPostgres.Backfills())
+	}
+
+	for _, target := range targets {
+		err := runner.Migrate(target)
+		if err != nil {
+			runner.Shutdown()
+			return nil, err
+		}
+	}
+
+	return runner, nil
+}
+
+func appendMigrationTarget(targets []migration.Target, service string, dbConfig *config.DbConfig, migrations fs.FS, backfills []migration.Backfill) []migration.Target {
+	dbInfo, ok := postgresDBInfo(dbConfig)
+	if !ok {
+		return targets
+	}
+
+	return append(targets, migration.Target{
+		Service:    service,
+		DBInfo:     dbInfo,
+		Table:      service,
+		Migrations: migrations,
+		Backfills:  backfills,
+	})
+}
+
+// postgresDBInfo returns the connection string of the database if it is a postgres database.
+func postgresDBInfo(dbConfig *config.DbConfig) (string, bool) {
+	if dbConfig == nil || dbConfig.Mode != DbModePostgres || dbConfig.Postgres == nil {
+		return "", false
+	}
+
+	postgres := dbConfig.Postgres
+	return fmt.Sprintf(
+		"user=%s password=%s dbname=%s host=%s port=%d sslmode=%s",
+		postgres.User, postgres.Password, postgres.Name, postgres.Host, postgres.Port, postgres.SslMode,
+	), true
+}
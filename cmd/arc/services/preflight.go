@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/nats-io/nats.go"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/preflight"
+)
+
+const (
+	preflightKindDatabase           = "database"
+	preflightKindCache              = "cache"
+	preflightKindMessageQueue       = "message-queue"
+	preflightKindNodeRPC            = "node-rpc"
+	preflightKindNodeP2P            = "node-p2p"
+	preflightKindNodeZMQ            = "node-zmq"
+	preflightKindBlockHeaderService = "block-header-service"
+	preflightKindCallbackEgress     = "callback-egress"
+)
+
+// RunPreflight checks the connectivity to every dependency configured in the config, writes the report to w and
+// returns an error if any check failed.
+func RunPreflight(logger *slog.Logger, arcConfig *config.ArcConfig, w io.Writer) error {
+	var opts []preflight.Option
+	if arcConfig.Preflight != nil && arcConfig.Preflight.Timeout > 0 {
+		opts = append(opts, preflight.WithTimeout(arcConfig.Preflight.Timeout))
+	}
+
+	checks, err := preflightChecks(arcConfig)
+	if err != nil {
+		return err
+	}
+
+	report := preflight.New(opts...).Run(context.Background(), checks)
+
+	err = report.Write(w)
+	if err != nil {
+		return fmt.Errorf("failed to write preflight report: %v", err)
+	}
+
+	logger.Info("Preflight checks finished", slog.Bool("passed", report.Passed), slog.Int("checks", len(report.Results)), slog.Int("failed", report.Failed()))
+
+	return report.Err()
+}
+
+// preflightChecks returns the checks of the dependencies configured in the config. The nodes of metamorph and blocktx
+// are checked once per address.
+func preflightChecks(arcConfig *config.ArcConfig) ([]preflight.Check, error) {
+	var checks []preflight.Check
+
+	databases := make(map[string]*config.DbConfig)
+	if arcConfig.Metamorph != nil {
+		databases["metamorph"] = arcConfig.Metamorph.Db
+	}
+	if arcConfig.Blocktx != nil {
+		databases["blocktx"] = arcConfig.Blocktx.Db
+	}
+	if arcConfig.Callbacker != nil {
+		databases["callbacker"] = arcConfig.Callbacker.Db
+	}
+	for _, service := range []string{"metamorph", "blocktx", "callbacker"} {
+		dbInfo, ok := postgresDBInfo(databases[service])
+		if !ok {
+			continue
+		}
+		checks = append(checks, preflight.Check{Kind: preflightKindDatabase, Name: service, Run: postgresCheck(dbInfo)})
+	}
+
+	if arcConfig.Cache != nil && arcConfig.Cache.Engine == config.Redis && arcConfig.Cache.Redis != nil {
+		checks = append(checks, preflight.Check{Kind: preflightKindCache, Name: arcConfig.Cache.Redis.Addr, Run: redisCheck(arcConfig.Cache.Redis)})
+	}
+
+	if arcConfig.MessageQueue != nil && arcConfig.MessageQueue.URL != "" && !arcConfig.IsAllInOneEnabled() {
+		checks = append(checks, preflight.Check{Kind: preflightKindMessageQueue, Name: arcConfig.MessageQueue.URL, Run: natsCheck(arcConfig.MessageQueue.URL)})
+	}
+
+	if arcConfig.PeerRPC != nil && arcConfig.PeerRPC.Host != "" {
+		name := net.JoinHostPort(arcConfig.PeerRPC.Host, strconv.Itoa(arcConfig.PeerRPC.Port))
+		checks = append(checks, preflight.Check{Kind: preflightKindNodeRPC, Name: name, Run: nodeRPCCheck(arcConfig.PeerRPC)})
+	}
+
+	peerChecks, err := peerPreflightChecks(arcConfig)
+	if err != nil {
+		return nil, err
+	}
+	checks = append(checks, peerChecks...)
+
+	if arcConfig.API != nil {
+		for _, bhs := range arcConfig.API.MerkleRootVerification.BlockHeaderServices {
+			auth, err := newChainTrackerAuth(bhs)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create authentication of block header service %s: %v", bhs.URL, err)
+			}
+
+			do := func(req *http.Request) (*http.Response, error) { return auth.Do(http.DefaultClient, req) }
+			checks = append(checks, preflight.Check{Kind: preflightKindBlockHeaderService, Name: bhs.URL, Run: preflight.HTTP(do, bhs.URL+"/status", http.StatusOK)})
+		}
+	}
+
+	if arcConfig.Preflight != nil {
+		for _, egressURL := range arcConfig.Preflight.CallbackEgressURLs {
+			checks = append(checks, preflight.Check{Kind: preflightKindCallbackEgress, Name: egressURL, Run: preflight.HTTP(http.DefaultClient.Do, egressURL, 0)})
+		}
+	}
+
+	return checks, nil
+}
+
+func peerPreflightChecks(arcConfig *config.ArcConfig) ([]preflight.Check, error) {
+	var peers []*config.PeerConfig
+	if arcConfig.Metamorph != nil && arcConfig.Metamorph.BlockchainNetwork != nil {
+		peers = append(peers, arcConfig.Metamorph.BlockchainNetwork.Peers...)
+	}
+	if arcConfig.Blocktx != nil && arcConfig.Blocktx.BlockchainNetwork != nil {
+		peers = append(peers, arcConfig.Blocktx.BlockchainNetwork.Peers...)
+	}
+
+	var checks []preflight.Check
+	checked := make(map[string]struct{})
+	for _, peer := range peers {
+		var dialer preflight.Dialer = &net.Dialer{}
+		if peer.Proxy != "" {
+			proxyDialer, err := p2p.NewSOCKS5Dialer(peer.Proxy)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create proxy dialer for peer %s: %v", peer.Host, err)
+			}
+			dialer = proxyDialer
+		}
+
+		p2pAddress, err := peer.GetP2PUrl()
+		if err == nil {
+			if _, found := checked[p2pAddress]; !found {
+				checked[p2pAddress] = struct{}{}
+				checks = append(checks, preflight.Check{Kind: preflightKindNodeP2P, Name: p2pAddress, Run: preflight.TCP(dialer, p2pAddress)})
+			}
+		}
+
+		zmqURL, err := peer.GetZMQUrl()
+		if err == nil && zmqURL != nil {
+			if _, found := checked[zmqURL.Host]; !found {
+				checked[zmqURL.Host] = struct{}{}
+				checks = append(checks, preflight.Check{Kind: preflightKindNodeZMQ, Name: zmqURL.Host, Run: preflight.TCP(&net.Dialer{}, zmqURL.Host)})
+			}
+		}
+	}
+
+	return checks, nil
+}
+
+func postgresCheck(dbInfo string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		db, err := sql.Open("postgres", dbInfo)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = db.Close() }()
+
+		return db.PingContext(ctx)
+	}
+}
+
+func redisCheck(redisConfig *config.RedisConfig) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		c := redis.NewClient(&redis.Options{
+			Addr:     redisConfig.Addr,
+			Password: redisConfig.Password,
+			DB:       redisConfig.DB,
+		})
+		defer func() { _ = c.Close() }()
+
+		return c.Ping(ctx).Err()
+	}
+}
+
+func natsCheck(natsURL string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		opts := []nats.Option{nats.RetryOnFailedConnect(false)}
+		if deadline, ok := ctx.Deadline(); ok {
+			opts = append(opts, nats.Timeout(time.Until(deadline)))
+		}
+
+		nc, err := nats.Connect(natsURL, opts...)
+		if err != nil {
+			return err
+		}
+		defer nc.Close()
+
+		return nc.FlushWithContext(ctx)
+	}
+}
+
+func nodeRPCCheck(peerRPC *config.PeerRPCConfig) func(ctx context.Context) error {
+	return func(_ context.Context) error {
+		node, err := newNodeRPCClient(peerRPC)
+		if err != nil {
+			return err
+		}
+
+		_, err = node.GetBlockchainInfo()
+		return err
+	}
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+)
+
+func toJobConfigs(jobs map[string]*config.JobConfig) map[string]scheduler.JobConfig {
+	configs := make(map[string]scheduler.JobConfig, len(jobs))
+	for name, job := range jobs {
+		if job == nil {
+			continue
+		}
+
+		configs[name] = scheduler.JobConfig{
+			Schedule: job.Schedule,
+			Jitter:   job.Jitter,
+			Paused:   job.Paused,
+		}
+	}
+
+	return configs
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/simulator"
+)
+
+var (
+	simulatedNetwork     *simulator.Network
+	simulatedNetworkOnce sync.Once
+)
+
+// getSimulatedNetwork returns the simulated blockchain network shared by metamorph and blocktx of the process. The
+// network is started on the first call.
+func getSimulatedNetwork(logger *slog.Logger, cfg *config.SimulatorConfig) *simulator.Network {
+	simulatedNetworkOnce.Do(func() {
+		simulatedNetwork = simulator.NewNetwork(logger,
+			simulator.WithSeenDelay(cfg.SeenDelay),
+			simulator.WithBlockInterval(cfg.BlockInterval),
+		)
+		simulatedNetwork.Start()
+	})
+
+	return simulatedNetwork
+}
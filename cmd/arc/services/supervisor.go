@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
+)
+
+const pingTimeout = 5 * time.Second
+
+type pinger interface {
+	Ping(ctx context.Context) error
+}
+
+func newSupervisor(logger *slog.Logger, cfg *config.SupervisorConfig) *supervisor.Supervisor {
+	if cfg == nil {
+		return supervisor.New(logger)
+	}
+
+	return supervisor.New(logger,
+		supervisor.WithBackoff(cfg.InitialBackoff, cfg.MaxBackoff),
+		supervisor.WithRetryDependencies(cfg.RetryDependencies, cfg.DependencyTimeout),
+	)
+}
+
+func pingStore(s pinger) error {
+	ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+	defer cancel()
+
+	return s.Ping(ctx)
+}
@@ -29,6 +29,12 @@ var (
 			for _, name := range names {
 				keySet := keySetsMap[name]
 
+				if keySet.GetMaster() == nil {
+					// the private key of an external key is kept in a KMS or HSM
+					logger.Info("address", slog.String("name", name), slog.String("address", keySet.Address(!isTestnet)), slog.String("publicKey", keySet.PublicKey.ToDERHex()))
+					continue
+				}
+
 				logger.Info("address", slog.String("name", name), slog.String("address", keySet.Address(!isTestnet)), slog.String("key", keySet.GetMaster().String()))
 			}
 
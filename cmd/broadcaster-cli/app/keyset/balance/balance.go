@@ -8,7 +8,6 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/bitcoin-sv/arc/cmd/broadcaster-cli/helper"
-	"github.com/bitcoin-sv/arc/pkg/woc_client"
 )
 
 var Cmd = &cobra.Command{
@@ -21,7 +20,10 @@ var Cmd = &cobra.Command{
 		logFormat := helper.GetString("logFormat")
 		logger := helper.NewLogger(logLevel, logFormat)
 
-		wocClient := woc_client.New(!isTestnet, woc_client.WithAuth(wocAPIKey), woc_client.WithLogger(logger))
+		utxoClient, err := helper.CreateUtxoClient(logger, isTestnet)
+		if err != nil {
+			return err
+		}
 
 		keySetsMap, err := helper.GetSelectedKeySets()
 		if err != nil {
@@ -35,7 +37,7 @@ var Cmd = &cobra.Command{
 			if wocAPIKey == "" {
 				time.Sleep(500 * time.Millisecond)
 			}
-			confirmed, unconfirmed, err := wocClient.GetBalanceWithRetries(context.Background(), keySet.Address(!isTestnet), 1*time.Second, 5)
+			confirmed, unconfirmed, err := utxoClient.GetBalanceWithRetries(context.Background(), keySet.Address(!isTestnet), 1*time.Second, 5)
 			if err != nil {
 				return err
 			}
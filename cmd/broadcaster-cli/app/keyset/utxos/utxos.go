@@ -13,7 +13,6 @@ import (
 
 	"github.com/bitcoin-sv/arc/cmd/broadcaster-cli/helper"
 	"github.com/bitcoin-sv/arc/pkg/keyset"
-	"github.com/bitcoin-sv/arc/pkg/woc_client"
 )
 
 var Cmd = &cobra.Command{
@@ -23,13 +22,15 @@ var Cmd = &cobra.Command{
 		maxRows := viper.GetInt("rows")
 
 		isTestnet := helper.GetBool("testnet")
-		wocAPIKey := helper.GetString("wocAPIKey")
 
 		logLevel := helper.GetString("logLevel")
 		logFormat := helper.GetString("logFormat")
 		logger := helper.NewLogger(logLevel, logFormat)
 
-		wocClient := woc_client.New(!isTestnet, woc_client.WithAuth(wocAPIKey), woc_client.WithLogger(logger))
+		utxoClient, err := helper.CreateUtxoClient(logger, isTestnet)
+		if err != nil {
+			return err
+		}
 
 		keySetsMap, err := helper.GetSelectedKeySets()
 		if err != nil {
@@ -54,7 +55,7 @@ var Cmd = &cobra.Command{
 			ksRow[name] = keySetsMap[name]
 			if counter >= 9 {
 				t = table.NewWriter()
-				t := getUtxosTable(ctx, logger, t, ksRow, isTestnet, wocClient, maxRows)
+				t := getUtxosTable(ctx, logger, t, ksRow, isTestnet, utxoClient, maxRows)
 				t.SetStyle(table.StyleColoredBright)
 				fmt.Println(t.Render())
 				fmt.Println()
@@ -66,7 +67,7 @@ var Cmd = &cobra.Command{
 		}
 		if len(ksRow) > 0 {
 			t = table.NewWriter()
-			t := getUtxosTable(ctx, logger, t, ksRow, isTestnet, wocClient, maxRows)
+			t := getUtxosTable(ctx, logger, t, ksRow, isTestnet, utxoClient, maxRows)
 			t.SetStyle(table.StyleColoredBright)
 			fmt.Println(t.Render())
 		}
@@ -52,6 +52,20 @@ func init() {
 		os.Exit(1)
 	}
 
+	RootCmd.PersistentFlags().String("utxoProvider", "woc", "Provider of the UTXOs of the keys. Value can be one of woc | node | arc. The node is configured with nodeRPC and the ARC store with arcStore in the config file")
+	err = viper.BindPFlag("utxoProvider", RootCmd.PersistentFlags().Lookup("utxoProvider"))
+	if err != nil {
+		logger.Printf("failed to bind flag utxoProvider: %v", err)
+		os.Exit(1)
+	}
+
+	RootCmd.PersistentFlags().Int("derivedKeys", 0, "Optional number of keys derived from each private key with the derivation paths <i>/0")
+	err = viper.BindPFlag("derivedKeys", RootCmd.PersistentFlags().Lookup("derivedKeys"))
+	if err != nil {
+		logger.Printf("failed to bind flag derivedKeys: %v", err)
+		os.Exit(1)
+	}
+
 	RootCmd.PersistentFlags().String("logLevel", "INFO", "mode of logging. Value can be one of TRACE | DEBUG | INFO | WARN | ERROR")
 	err = viper.BindPFlag("logLevel", RootCmd.PersistentFlags().Lookup("logLevel"))
 	if err != nil {
@@ -14,7 +14,6 @@ import (
 	"github.com/bitcoin-sv/arc/cmd/broadcaster-cli/helper"
 	"github.com/bitcoin-sv/arc/internal/broadcaster"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
-	"github.com/bitcoin-sv/arc/pkg/woc_client"
 )
 
 const (
@@ -69,8 +68,6 @@ var Cmd = &cobra.Command{
 			return errors.New("no api URL was given")
 		}
 
-		wocAPIKey := helper.GetString("wocAPIKey")
-
 		opReturn := helper.GetString("opReturn")
 
 		rampUpTickerEnabled := helper.GetBool("rampUpTickerEnabled")
@@ -86,7 +83,10 @@ var Cmd = &cobra.Command{
 			return fmt.Errorf("failed to create client: %v", err)
 		}
 
-		wocClient := woc_client.New(!isTestnet, woc_client.WithAuth(wocAPIKey), woc_client.WithLogger(logger))
+		utxoClient, err := helper.CreateUtxoClient(logger, isTestnet)
+		if err != nil {
+			return err
+		}
 
 		opts := []func(p *broadcaster.Broadcaster){
 			broadcaster.WithFees(miningFeeSat),
@@ -121,7 +121,7 @@ var Cmd = &cobra.Command{
 
 		rbs := make([]broadcaster.RateBroadcaster, 0, len(keySetsMap))
 		for keyName, ks := range keySetsMap {
-			rb, err := broadcaster.NewRateBroadcaster(logger.With(slog.String("address", ks.Address(!isTestnet)), slog.String("name", keyName)), client, ks, wocClient, limit, submitBatchTicker, opts...)
+			rb, err := broadcaster.NewRateBroadcaster(logger.With(slog.String("address", ks.Address(!isTestnet)), slog.String("name", keyName)), client, ks, utxoClient, limit, submitBatchTicker, opts...)
 			if err != nil {
 				return err
 			}
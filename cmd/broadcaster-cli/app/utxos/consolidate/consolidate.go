@@ -11,7 +11,6 @@ import (
 
 	"github.com/bitcoin-sv/arc/cmd/broadcaster-cli/helper"
 	"github.com/bitcoin-sv/arc/internal/broadcaster"
-	"github.com/bitcoin-sv/arc/pkg/woc_client"
 )
 
 var Cmd = &cobra.Command{
@@ -32,8 +31,6 @@ var Cmd = &cobra.Command{
 			return errors.New("no api URL was given")
 		}
 
-		wocAPIKey := helper.GetString("wocAPIKey")
-
 		logLevel := helper.GetString("logLevel")
 		logFormat := helper.GetString("logFormat")
 		logger := helper.NewLogger(logLevel, logFormat)
@@ -45,11 +42,14 @@ var Cmd = &cobra.Command{
 
 		names := helper.GetOrderedKeys(keySetsMap)
 
-		wocClient := woc_client.New(!isTestnet, woc_client.WithAuth(wocAPIKey), woc_client.WithLogger(logger))
+		utxoClient, err := helper.CreateUtxoClient(logger, isTestnet)
+		if err != nil {
+			return err
+		}
 		cs := make([]broadcaster.Consolidator, 0, len(keySetsMap))
 		for _, keyName := range names {
 			ks := keySetsMap[keyName]
-			c, err := broadcaster.NewUTXOConsolidator(logger.With(slog.String("address", ks.Address(!isTestnet)), slog.String("name", keyName)), client, ks, wocClient, broadcaster.WithIsTestnet(isTestnet), broadcaster.WithFees(miningFeeSat))
+			c, err := broadcaster.NewUTXOConsolidator(logger.With(slog.String("address", ks.Address(!isTestnet)), slog.String("name", keyName)), client, ks, utxoClient, broadcaster.WithIsTestnet(isTestnet), broadcaster.WithFees(miningFeeSat))
 			if err != nil {
 				return err
 			}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/bitcoin-sv/arc/cmd/broadcaster-cli/helper"
 	"github.com/bitcoin-sv/arc/internal/broadcaster"
-	"github.com/bitcoin-sv/arc/pkg/woc_client"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -44,8 +43,6 @@ var Cmd = &cobra.Command{
 			return errors.New("no api URL was given")
 		}
 
-		wocAPIKey := helper.GetString("wocAPIKey")
-
 		names := helper.GetOrderedKeys(keySetsMap)
 
 		logLevel := helper.GetString("logLevel")
@@ -57,13 +54,16 @@ var Cmd = &cobra.Command{
 			return fmt.Errorf("failed to create client: %v", err)
 		}
 
-		wocClient := woc_client.New(!isTestnet, woc_client.WithAuth(wocAPIKey), woc_client.WithLogger(logger))
+		utxoClient, err := helper.CreateUtxoClient(logger, isTestnet)
+		if err != nil {
+			return err
+		}
 		creators := make([]broadcaster.Creator, 0, len(keySetsMap)) // Use the Creator interface for flexibility
 		for _, keyName := range names {
 			ks := keySetsMap[keyName]
 			creator, err := broadcaster.NewUTXOCreator(
 				logger.With(slog.String("address", ks.Address(!isTestnet)), slog.String("name", keyName)),
-				client, ks, wocClient, broadcaster.WithIsTestnet(isTestnet), broadcaster.WithFees(miningFeeSat),
+				client, ks, utxoClient, broadcaster.WithIsTestnet(isTestnet), broadcaster.WithFees(miningFeeSat),
 			)
 			if err != nil {
 				return err
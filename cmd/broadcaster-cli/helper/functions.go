@@ -13,7 +13,11 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/bitcoin-sv/arc/internal/broadcaster"
+	"github.com/bitcoin-sv/arc/pkg/arc_utxo_client"
 	"github.com/bitcoin-sv/arc/pkg/keyset"
+	"github.com/bitcoin-sv/arc/pkg/node_utxo_client"
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
+	"github.com/bitcoin-sv/arc/pkg/woc_client"
 )
 
 func CreateClient(auth *broadcaster.Auth, arcServer string, logger *slog.Logger) (broadcaster.ArcClient, error) {
@@ -25,6 +29,76 @@ func CreateClient(auth *broadcaster.Auth, arcServer string, logger *slog.Logger)
 	return broadcaster.NewHTTPBroadcaster(arcClient, logger)
 }
 
+type NodeRPCConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password"`
+}
+
+// ArcStoreConfig configures the connection to the Metamorph database of ARC.
+type ArcStoreConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Name     string `mapstructure:"name"`
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password"`
+	SslMode  string `mapstructure:"sslMode"`
+}
+
+// CreateUtxoClient creates the client of the UTXO provider selected by the setting `utxoProvider`. The provider `woc`
+// (default) gets the UTXOs from WhatsOnChain, the provider `node` gets them from the wallet of the node configured in
+// `nodeRPC` and the provider `arc` gets them from the transactions stored in the Metamorph database configured in
+// `arcStore`.
+func CreateUtxoClient(logger *slog.Logger, isTestnet bool) (broadcaster.UtxoClient, error) {
+	provider := GetString("utxoProvider")
+
+	switch provider {
+	case "", "woc":
+		wocAPIKey := GetString("wocAPIKey")
+		return woc_client.New(!isTestnet, woc_client.WithAuth(wocAPIKey), woc_client.WithLogger(logger)), nil
+	case "node":
+		var nodeRPC NodeRPCConfig
+		err := viper.UnmarshalKey("nodeRPC", &nodeRPC)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node rpc config: %v", err)
+		}
+		if nodeRPC.Host == "" {
+			return nil, errors.New("no node rpc host was given")
+		}
+
+		rpcClient, err := rpc_client.NewRPCClient(nodeRPC.Host, nodeRPC.Port, nodeRPC.User, nodeRPC.Password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create node rpc client: %v", err)
+		}
+
+		return node_utxo_client.New(rpcClient, node_utxo_client.WithLogger(logger)), nil
+	case "arc":
+		var arcStore ArcStoreConfig
+		err := viper.UnmarshalKey("arcStore", &arcStore)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get arc store config: %v", err)
+		}
+		if arcStore.Host == "" {
+			return nil, errors.New("no arc store host was given")
+		}
+
+		dbInfo := fmt.Sprintf(
+			"user=%s password=%s dbname=%s host=%s port=%d sslmode=%s",
+			arcStore.User, arcStore.Password, arcStore.Name, arcStore.Host, arcStore.Port, arcStore.SslMode,
+		)
+
+		store, err := arc_utxo_client.NewPostgreSQL(dbInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create arc store: %v", err)
+		}
+
+		return arc_utxo_client.New(store, arc_utxo_client.WithLogger(logger)), nil
+	default:
+		return nil, fmt.Errorf("unknown utxo provider: %s", provider)
+	}
+}
+
 func GetKeySetsKeyFile(keyFile string) (fundingKeySet *keyset.KeySet, receivingKeySet *keyset.KeySet, err error) {
 	var extendedBytes []byte
 	extendedBytes, err = os.ReadFile(keyFile)
@@ -153,38 +227,71 @@ func NewLogger(logLevel, logFormat string) *slog.Logger {
 	}
 }
 
-func GetKeySetsFor(keys map[string]string, selectedKeys []string) (map[string]*keyset.KeySet, error) {
+func GetKeySetsFor(keys map[string]string, externalKeySets map[string]*keyset.KeySet, selectedKeys []string) (map[string]*keyset.KeySet, error) {
 	keySets := map[string]*keyset.KeySet{}
 
-	if len(keys) == 0 {
+	if len(keys) == 0 && len(externalKeySets) == 0 {
 		return nil, errors.New("no keys given in configuration")
 	}
 
 	if len(selectedKeys) > 0 {
 		for _, selectedKey := range selectedKeys {
+			externalKeySet, found := externalKeySets[selectedKey]
+			if found {
+				keySets[selectedKey] = externalKeySet
+				continue
+			}
+
 			key, found := keys[selectedKey]
 			if !found {
 				return nil, fmt.Errorf("key not found: %s", selectedKey)
 			}
-			fundingKeySet, _, err := GetKeySetsXpriv(key)
+			err := addFundingKeySets(keySets, selectedKey, key)
 			if err != nil {
 				return nil, fmt.Errorf("failed to get selected key set %s: %v", selectedKey, err)
 			}
-			keySets[selectedKey] = fundingKeySet
 		}
 		return keySets, nil
 	}
 
+	for name, externalKeySet := range externalKeySets {
+		keySets[name] = externalKeySet
+	}
+
 	for name, key := range keys {
-		fundingKeySet, _, err := GetKeySetsXpriv(key)
+		err := addFundingKeySets(keySets, name, key)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get key set with name %s and value %s: %v", name, key, err)
 		}
-		keySets[name] = fundingKeySet
 	}
 	return keySets, nil
 }
 
+// addFundingKeySets adds the funding key set of the xpriv. If the setting `derivedKeys` is greater than 0, that many
+// key sets are derived from the xpriv instead, with the derivation path `<i>/0` and the name `<name>-<i>`. The key set
+// with index 0 is the funding key set of the xpriv.
+func addFundingKeySets(keySets map[string]*keyset.KeySet, name string, xpriv string) error {
+	derivedKeys := GetInt("derivedKeys")
+	if derivedKeys <= 0 {
+		fundingKeySet, _, err := GetKeySetsXpriv(xpriv)
+		if err != nil {
+			return err
+		}
+		keySets[name] = fundingKeySet
+		return nil
+	}
+
+	for i := range derivedKeys {
+		fundingKeySet, err := keyset.NewFromExtendedKeyStr(xpriv, fmt.Sprintf("%d/0", i))
+		if err != nil {
+			return err
+		}
+		keySets[fmt.Sprintf("%s-%d", name, i)] = fundingKeySet
+	}
+
+	return nil
+}
+
 func GetPrivateKeys() (map[string]string, error) {
 	var keys map[string]string
 	err := viper.UnmarshalKey("privateKeys", &keys)
@@ -194,6 +301,53 @@ func GetPrivateKeys() (map[string]string, error) {
 	return keys, nil
 }
 
+type ExternalKeyConfig struct {
+	PublicKey   string           `mapstructure:"publicKey"`
+	SignCommand []string         `mapstructure:"signCommand"`
+	AWSKMS      *AWSKMSKeyConfig `mapstructure:"awsKms"`
+}
+
+type AWSKMSKeyConfig struct {
+	KeyID    string `mapstructure:"keyId"`
+	Region   string `mapstructure:"region"`
+	Endpoint string `mapstructure:"endpoint"`
+}
+
+// GetExternalKeySets returns the key sets of the keys configured in `externalKeys`. The private keys of these keys are
+// kept in a KMS or HSM and the transactions are signed by AWS KMS or with the sign command.
+func GetExternalKeySets() (map[string]*keyset.KeySet, error) {
+	var keys map[string]ExternalKeyConfig
+	err := viper.UnmarshalKey("externalKeys", &keys)
+	if err != nil {
+		return nil, err
+	}
+
+	keySets := make(map[string]*keyset.KeySet, len(keys))
+	for name, key := range keys {
+		var signer keyset.Signer
+		if key.AWSKMS != nil {
+			var opts []func(*keyset.AWSKMSSigner)
+			if key.AWSKMS.Endpoint != "" {
+				opts = append(opts, keyset.WithAWSKMSEndpoint(key.AWSKMS.Endpoint))
+			}
+
+			signer, err = keyset.NewAWSKMSSigner(key.AWSKMS.KeyID, key.AWSKMS.Region, opts...)
+		} else {
+			signer, err = keyset.NewCommandSigner(key.PublicKey, key.SignCommand)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create signer of external key %s: %v", name, err)
+		}
+
+		keySets[name], err = keyset.NewFromSigner(signer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get key set of external key %s: %v", name, err)
+		}
+	}
+
+	return keySets, nil
+}
+
 func GetSelectedKeys() ([]string, error) {
 	var keys []string
 	err := viper.UnmarshalKey("keys", &keys)
@@ -214,11 +368,12 @@ func GetSelectedKeySets() (map[string]*keyset.KeySet, error) {
 		return nil, fmt.Errorf("failed to get private keys: %v", err)
 	}
 
-	if len(keys) == 0 {
-		return nil, errors.New("no keys given in configuration")
+	externalKeySets, err := GetExternalKeySets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external keys: %v", err)
 	}
 
-	return GetKeySetsFor(keys, selectedKeys)
+	return GetKeySetsFor(keys, externalKeySets, selectedKeys)
 }
 
 func GetAllKeySets() (map[string]*keyset.KeySet, error) {
@@ -227,17 +382,20 @@ func GetAllKeySets() (map[string]*keyset.KeySet, error) {
 		return nil, fmt.Errorf("failed to get private keys: %v", err)
 	}
 
-	if len(keys) == 0 {
+	keySets, err := GetExternalKeySets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get external keys: %v", err)
+	}
+
+	if len(keys) == 0 && len(keySets) == 0 {
 		return nil, errors.New("no keys given in configuration")
 	}
-	keySets := map[string]*keyset.KeySet{}
 
 	for name, key := range keys {
-		fundingKeySet, _, err := GetKeySetsXpriv(key)
+		err := addFundingKeySets(keySets, name, key)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get key set with name %s and value %s: %v", name, key, err)
 		}
-		keySets[name] = fundingKeySet
 	}
 
 	return keySets, nil
@@ -10,25 +10,185 @@ import (
 const (
 	InMemory = "in-memory"
 	Redis    = "redis"
+
+	NonFinalTxsReject = "reject"
+	NonFinalTxsHold   = "hold"
 )
 
 type ArcConfig struct {
-	LogLevel              string              `mapstructure:"logLevel"`
-	LogFormat             string              `mapstructure:"logFormat"`
-	ProfilerAddr          string              `mapstructure:"profilerAddr"`
-	Prometheus            *PrometheusConfig   `mapstructure:"prometheus"`
-	GrpcMessageSize       int                 `mapstructure:"grpcMessageSize"`
-	Network               string              `mapstructure:"network"`
-	ReBroadcastExpiration time.Duration       `mapstructure:"reBroadcastExpiration"`
-	MessageQueue          *MessageQueueConfig `mapstructure:"messageQueue"`
-	Tracing               *TracingConfig      `mapstructure:"tracing"`
-	PeerRPC               *PeerRPCConfig      `mapstructure:"peerRpc"`
-	Metamorph             *MetamorphConfig    `mapstructure:"metamorph"`
-	Blocktx               *BlocktxConfig      `mapstructure:"blocktx"`
-	API                   *APIConfig          `mapstructure:"api"`
-	K8sWatcher            *K8sWatcherConfig   `mapstructure:"k8sWatcher"`
-	Callbacker            *CallbackerConfig   `mapstructure:"callbacker"`
-	Cache                 *CacheConfig        `mapstructure:"cache"`
+	LogLevel              string                     `mapstructure:"logLevel"`
+	LogFormat             string                     `mapstructure:"logFormat"`
+	LogSampling           *LogSamplingConfig         `mapstructure:"logSampling"`
+	ProfilerAddr          string                     `mapstructure:"profilerAddr"`
+	ContinuousProfiling   *ContinuousProfilingConfig `mapstructure:"continuousProfiling"`
+	Memory                *MemoryConfig              `mapstructure:"memory"`
+	Prometheus            *PrometheusConfig          `mapstructure:"prometheus"`
+	Metrics               *MetricsConfig             `mapstructure:"metrics"`
+	GrpcMessageSize       int                        `mapstructure:"grpcMessageSize"`
+	GrpcClient            *GrpcClientConfig          `mapstructure:"grpcClient"`
+	Network               string                     `mapstructure:"network"`
+	ReBroadcastExpiration time.Duration              `mapstructure:"reBroadcastExpiration"`
+	MessageQueue          *MessageQueueConfig        `mapstructure:"messageQueue"`
+	Tracing               *TracingConfig             `mapstructure:"tracing"`
+	PeerRPC               *PeerRPCConfig             `mapstructure:"peerRpc"`
+	PeerBan               *PeerBanConfig             `mapstructure:"peerBan"`
+	PeerMessageDump       *PeerMessageDumpConfig     `mapstructure:"peerMessageDump"`
+	PeerProtocol          *PeerProtocolConfig        `mapstructure:"peerProtocol"`
+	Metamorph             *MetamorphConfig           `mapstructure:"metamorph"`
+	Blocktx               *BlocktxConfig             `mapstructure:"blocktx"`
+	API                   *APIConfig                 `mapstructure:"api"`
+	K8sWatcher            *K8sWatcherConfig          `mapstructure:"k8sWatcher"`
+	Callbacker            *CallbackerConfig          `mapstructure:"callbacker"`
+	Cache                 *CacheConfig               `mapstructure:"cache"`
+	Encryption            *EncryptionConfig          `mapstructure:"encryption"`
+	Migrations            *MigrationsConfig          `mapstructure:"migrations"`
+	FaultInjection        *FaultInjectionConfig      `mapstructure:"faultInjection"`
+	Supervisor            *SupervisorConfig          `mapstructure:"supervisor"`
+	FeatureFlags          *FeatureFlagsConfig        `mapstructure:"featureFlags"`
+	Simulator             *SimulatorConfig           `mapstructure:"simulator"`
+	AllInOne              *AllInOneConfig            `mapstructure:"allInOne"`
+	Preflight             *PreflightConfig           `mapstructure:"preflight"`
+}
+
+// PreflightConfig configures the connectivity checks of `-preflight`. A check fails if the dependency does not respond
+// within Timeout. CallbackEgressURLs are requested to check that callbacks can be sent from the deployment, any HTTP
+// response passes.
+type PreflightConfig struct {
+	Timeout            time.Duration `mapstructure:"timeout"`
+	CallbackEgressURLs []string      `mapstructure:"callbackEgressUrls"`
+}
+
+// AllInOneConfig configures the all-in-one mode for laptops and small deployments. If enabled, api, metamorph, blocktx
+// and callbacker are started in one process and exchange messages over channels instead of the message queue, and the
+// gRPC calls between them are made over in-memory connections instead of TCP.
+type AllInOneConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MessageBufferSize is the number of messages buffered per topic
+	MessageBufferSize int `mapstructure:"messageBufferSize"`
+}
+
+// IsAllInOneEnabled returns whether all services are started in one process connected in-process.
+func (a *ArcConfig) IsAllInOneEnabled() bool {
+	return a.AllInOne != nil && a.AllInOne.Enabled
+}
+
+// SimulatorConfig configures the simulated blockchain network for the local development without nodes. If enabled,
+// metamorph and blocktx are connected to simulated peers instead of the configured peers, therefore they have to run
+// in the same process.
+type SimulatorConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// SeenDelay is the delay after which a transaction is announced as seen on the network
+	SeenDelay time.Duration `mapstructure:"seenDelay"`
+	// BlockInterval is the interval at which blocks are mined, 0 disables mining
+	BlockInterval time.Duration `mapstructure:"blockInterval"`
+}
+
+// IsSimulatorEnabled returns whether metamorph and blocktx are connected to the simulated blockchain network.
+func (a *ArcConfig) IsSimulatorEnabled() bool {
+	return a.Simulator != nil && a.Simulator.Enabled
+}
+
+// FeatureFlagsConfig configures the flags of risky behaviors, which can be overridden at runtime with the operations of
+// the admin service. With Sync the overrides are shared by all instances using the cache store, e.g. Redis.
+type FeatureFlagsConfig struct {
+	ReorgHandling  bool          `mapstructure:"reorgHandling"`
+	CumulativeFees bool          `mapstructure:"cumulativeFees"`
+	Rebroadcast    bool          `mapstructure:"rebroadcast"`
+	Sync           bool          `mapstructure:"sync"`
+	SyncInterval   time.Duration `mapstructure:"syncInterval"`
+}
+
+// SupervisorConfig configures the retries of the database and the message queue on the start of the services and the
+// restarts of the internal subsystems, e.g. of the ZMQ listeners, after unrecoverable errors.
+type SupervisorConfig struct {
+	RetryDependencies bool `mapstructure:"retryDependencies"`
+	// DependencyTimeout is the maximum duration for which a dependency is retried, 0 retries until shutdown
+	DependencyTimeout time.Duration `mapstructure:"dependencyTimeout"`
+	InitialBackoff    time.Duration `mapstructure:"initialBackoff"`
+	MaxBackoff        time.Duration `mapstructure:"maxBackoff"`
+}
+
+// FaultInjectionConfig configures the faults injected at named points of metamorph for testing the resilience of ARC
+// in CI and staging. Fault injection can only be enabled in binaries built with the tag fault_injection.
+type FaultInjectionConfig struct {
+	Enabled bool               `mapstructure:"enabled"`
+	Rules   []*FaultRuleConfig `mapstructure:"rules"`
+}
+
+// FaultRuleConfig injects latency, an error or a drop at one of the points `peerAnnounce`, `mqPublish` or
+// `storeWrite`. The first `after` calls passing the point are skipped, then every `every`-th call is faulted until
+// `count` faults were injected, 0 is unlimited.
+type FaultRuleConfig struct {
+	Point   string        `mapstructure:"point"`
+	Latency time.Duration `mapstructure:"latency"`
+	Error   bool          `mapstructure:"error"`
+	Drop    bool          `mapstructure:"drop"`
+	After   int           `mapstructure:"after"`
+	Every   int           `mapstructure:"every"`
+	Count   int           `mapstructure:"count"`
+}
+
+// MigrationsConfig configures the migrations of the postgres databases of metamorph, blocktx and callbacker, which are
+// applied on the start of the services if enabled.
+type MigrationsConfig struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	LockTimeout       time.Duration `mapstructure:"lockTimeout"`
+	BackfillBatchSize int           `mapstructure:"backfillBatchSize"`
+	BackfillPause     time.Duration `mapstructure:"backfillPause"`
+	// Contract applies the contract migrations, which remove schema still used by older versions of ARC
+	Contract bool `mapstructure:"contract"`
+}
+
+// EncryptionConfig configures the encryption of the callback tokens and URLs of the submissions in the databases of
+// metamorph and callbacker. Values are encrypted with the current key and decrypted with the key they were encrypted
+// with, so that a key can be rotated by adding a new key and making it current. A replaced key has to be kept until
+// the values encrypted with it have been cleared from the databases.
+type EncryptionConfig struct {
+	Enabled      bool                   `mapstructure:"enabled"`
+	CurrentKeyID string                 `mapstructure:"currentKeyId"`
+	Keys         []*EncryptionKeyConfig `mapstructure:"keys"`
+}
+
+// EncryptionKeyConfig is a base64-encoded AES-256 key read either from the environment variable KeyEnv or from the
+// output of KeyCommand, e.g. a command decrypting the key with a KMS.
+type EncryptionKeyConfig struct {
+	ID         string   `mapstructure:"id"`
+	KeyEnv     string   `mapstructure:"keyEnv"`
+	KeyCommand []string `mapstructure:"keyCommand"`
+}
+
+// LogSamplingConfig configures the sampling of high-frequency log records below level WARN.
+type LogSamplingConfig struct {
+	Enabled    bool          `mapstructure:"enabled"`
+	Interval   time.Duration `mapstructure:"interval"`
+	First      uint64        `mapstructure:"first"`
+	Thereafter uint64        `mapstructure:"thereafter"`
+}
+
+// ContinuousProfilingConfig configures the capture of profiles when the heap size or the goroutine scheduling latency
+// cross their thresholds. The profiles are kept in Dir or uploaded to object storage with UploadCommand.
+type ContinuousProfilingConfig struct {
+	Enabled               bool          `mapstructure:"enabled"`
+	Dir                   string        `mapstructure:"dir"`
+	CheckInterval         time.Duration `mapstructure:"checkInterval"`
+	HeapThresholdMB       uint64        `mapstructure:"heapThresholdMB"`
+	SchedLatencyThreshold time.Duration `mapstructure:"schedLatencyThreshold"`
+	CPUProfileDuration    time.Duration `mapstructure:"cpuProfileDuration"`
+	Cooldown              time.Duration `mapstructure:"cooldown"`
+	// UploadCommand uploads a profile, the placeholders {file} and {name} are replaced with the path and the name of the
+	// profile
+	UploadCommand []string `mapstructure:"uploadCommand"`
+}
+
+// MemoryConfig configures the garbage collector and the throttling of the processors when the process approaches its
+// memory limit.
+type MemoryConfig struct {
+	GCPercent       int           `mapstructure:"gcPercent"`
+	LimitMB         int64         `mapstructure:"limitMB"`
+	Throttling      bool          `mapstructure:"throttling"`
+	ShrinkThreshold float64       `mapstructure:"shrinkThreshold"`
+	PauseThreshold  float64       `mapstructure:"pauseThreshold"`
+	CheckInterval   time.Duration `mapstructure:"checkInterval"`
 }
 
 type PrometheusConfig struct {
@@ -41,15 +201,60 @@ func (p *PrometheusConfig) IsEnabled() bool {
 	return p.Enabled && p.Addr != "" && p.Endpoint != ""
 }
 
+// MetricsConfig selects the backend the metrics are emitted to. With the backend prometheus the metrics are served as
+// configured in Prometheus, with statsd and dogstatsd they are pushed to the agent configured in StatsD.
+type MetricsConfig struct {
+	Backend string        `mapstructure:"backend"`
+	StatsD  *StatsDConfig `mapstructure:"statsd"`
+}
+
+type StatsDConfig struct {
+	Addr     string        `mapstructure:"addr"`
+	Prefix   string        `mapstructure:"prefix"`
+	Interval time.Duration `mapstructure:"interval"`
+	// Tags are added to all metrics, they are only supported by dogstatsd
+	Tags []string `mapstructure:"tags"`
+}
+
+// IsMetricsEnabled returns whether the metrics are emitted to the configured backend.
+func (a *ArcConfig) IsMetricsEnabled() bool {
+	if a.Metrics != nil && (a.Metrics.Backend == "statsd" || a.Metrics.Backend == "dogstatsd") {
+		return a.Metrics.StatsD != nil && a.Metrics.StatsD.Addr != ""
+	}
+
+	return a.Prometheus != nil && a.Prometheus.IsEnabled()
+}
+
 type PeerConfig struct {
-	Host string          `mapstructure:"host"`
-	Port *PeerPortConfig `mapstructure:"port"`
+	Host  string          `mapstructure:"host"`
+	Port  *PeerPortConfig `mapstructure:"port"`
+	Proxy string          `mapstructure:"proxy"` // address of the SOCKS5 proxy for the p2p connection, e.g. Tor
+	// Name designates the peer, e.g. the node of a miner, to which transactions can be announced exclusively with the
+	// X-AnnounceTo header. Names must not be numbers, as a number announces the transaction to that many peers
+	Name string `mapstructure:"name"`
 }
 
 type MessageQueueConfig struct {
 	URL        string                `mapstructure:"url"`
 	Streaming  MessageQueueStreaming `mapstructure:"streaming"`
 	Initialize bool                  `mapstructure:"initialize"`
+	// Spool buffers the published messages on the local disk while the message queue cannot be reached
+	Spool *MessageQueueSpoolConfig `mapstructure:"spool"`
+	// SchemaVersions pins the schema versions in which the messages of the topics are published, e.g. to publish a new
+	// version only after all consumers have been upgraded
+	SchemaVersions map[string]int `mapstructure:"schemaVersions"`
+	// SubjectPrefix prefixes the subjects of all topics, so that the services of multiple networks can share the
+	// message queue, e.g. `testnet` publishes the submitted transactions to `testnet.submit-tx`
+	SubjectPrefix string `mapstructure:"subjectPrefix"`
+}
+
+// MessageQueueSpoolConfig configures the local disk spool of the published messages, each service spools to a
+// subdirectory of Dir. Publishing blocks while MaxBytes are spooled.
+type MessageQueueSpoolConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Dir           string        `mapstructure:"dir"`
+	MaxBytes      int64         `mapstructure:"maxBytes"`
+	DrainInterval time.Duration `mapstructure:"drainInterval"`
 }
 
 type MessageQueueStreaming struct {
@@ -58,11 +263,59 @@ type MessageQueueStreaming struct {
 }
 
 type TracingConfig struct {
-	Enabled            bool              `mapstructure:"enabled"`
-	DialAddr           string            `mapstructure:"dialAddr"`
-	Sample             int               `mapstructure:"sample"`
-	Attributes         map[string]string `mapstructure:"attributes"`
+	Enabled  bool   `mapstructure:"enabled"`
+	DialAddr string `mapstructure:"dialAddr"`
+	Sample   int    `mapstructure:"sample"`
+	// ParentBased lets spans with a parent follow the sampling decision of the parent, so that the sampling percentages
+	// only apply to root spans
+	ParentBased        bool                     `mapstructure:"parentBased"`
+	SamplingOverrides  []SamplingOverrideConfig `mapstructure:"samplingOverrides"`
+	TailSampling       *TailSamplingConfig      `mapstructure:"tailSampling"`
+	Attributes         map[string]string        `mapstructure:"attributes"`
 	KeyValueAttributes []attribute.KeyValue
+	Exporter           *TracingExporterConfig `mapstructure:"exporter"`
+	Batch              *TracingBatchConfig    `mapstructure:"batch"`
+	// ResourceDetectors adds the detected attributes of the environment to the spans, e.g. env, host, k8s or ec2
+	ResourceDetectors []string `mapstructure:"resourceDetectors"`
+}
+
+// TracingExporterConfig configures the export of the spans to the OTLP collector at DialAddr over gRPC or HTTP.
+type TracingExporterConfig struct {
+	Protocol string `mapstructure:"protocol"`
+	// Headers are sent with every export, e.g. the API key of a tracing vendor
+	Headers map[string]string `mapstructure:"headers"`
+	Timeout time.Duration     `mapstructure:"timeout"`
+	TLS     *TracingTLSConfig `mapstructure:"tls"`
+}
+
+type TracingTLSConfig struct {
+	Enabled            bool   `mapstructure:"enabled"`
+	CAFile             string `mapstructure:"caFile"`
+	CertFile           string `mapstructure:"certFile"`
+	KeyFile            string `mapstructure:"keyFile"`
+	InsecureSkipVerify bool   `mapstructure:"insecureSkipVerify"`
+}
+
+// TracingBatchConfig tunes the batching of the spans before their export, zero values keep the defaults of the SDK.
+type TracingBatchConfig struct {
+	MaxQueueSize       int           `mapstructure:"maxQueueSize"`
+	MaxExportBatchSize int           `mapstructure:"maxExportBatchSize"`
+	BatchTimeout       time.Duration `mapstructure:"batchTimeout"`
+	ExportTimeout      time.Duration `mapstructure:"exportTimeout"`
+}
+
+// SamplingOverrideConfig overrides the sampling percentage of the spans with the given name. A span name ending with
+// `*` matches all span names with the given prefix.
+type SamplingOverrideConfig struct {
+	SpanName string `mapstructure:"spanName"`
+	Sample   int    `mapstructure:"sample"`
+}
+
+// TailSamplingConfig configures the export of spans which were not sampled at their start, but failed or took at
+// least MinDuration.
+type TailSamplingConfig struct {
+	Enabled     bool          `mapstructure:"enabled"`
+	MinDuration time.Duration `mapstructure:"minDuration"`
 }
 
 func (a *ArcConfig) IsTracingEnabled() bool {
@@ -80,6 +333,32 @@ type PeerRPCConfig struct {
 	Port     int    `mapstructure:"port"`
 }
 
+// PeerBanConfig configures the ban scores of the peers which metamorph and blocktx connect to.
+type PeerBanConfig struct {
+	Threshold            int           `mapstructure:"threshold"`
+	InvalidMessageWeight int           `mapstructure:"invalidMessageWeight"`
+	Decay                time.Duration `mapstructure:"decay"`
+	BanDuration          time.Duration `mapstructure:"banDuration"`
+	File                 string        `mapstructure:"file"`
+}
+
+// PeerMessageDumpConfig configures the hex dumps of the p2p messages of selected peers.
+type PeerMessageDumpConfig struct {
+	Enabled       bool     `mapstructure:"enabled"`
+	Peers         []string `mapstructure:"peers"`
+	File          string   `mapstructure:"file"`
+	MaxFileSizeMB int64    `mapstructure:"maxFileSizeMB"`
+	MaxBackups    int      `mapstructure:"maxBackups"`
+}
+
+// PeerProtocolConfig configures the negotiation of the p2p protocol with the nodes. Nodes speaking a newer protocol are
+// connected with the protocol version announced by ARC.
+type PeerProtocolConfig struct {
+	Version          uint32 `mapstructure:"version"`
+	MinimumVersion   uint32 `mapstructure:"minimumVersion"`
+	RequiredServices uint64 `mapstructure:"requiredServices"`
+}
+
 type PeerPortConfig struct {
 	P2P int `mapstructure:"p2p"`
 	ZMQ int `mapstructure:"zmq"`
@@ -89,6 +368,7 @@ type MetamorphConfig struct {
 	ListenAddr                           string                               `mapstructure:"listenAddr"`
 	DialAddr                             string                               `mapstructure:"dialAddr"`
 	Db                                   *DbConfig                            `mapstructure:"db"`
+	GrpcAuth                             *GrpcAuthConfig                      `mapstructure:"grpcAuth"`
 	TrackOnly                            bool                                 `mapstructure:"trackOnly"`
 	ReAnnounceUnseenInterval             time.Duration                        `mapstructure:"reAnnounceUnseenInterval"`
 	ReAnnounceSeen                       *ReAnnounceSeenConfig                `mapstructure:"reAnnounceSeen"`
@@ -103,6 +383,193 @@ type MetamorphConfig struct {
 	BlockchainNetwork                    *BlockchainNetwork[*MetamorphGroups] `mapstructure:"bcnet"`
 	DoubleSpendCheckInterval             time.Duration                        `mapstructure:"doubleSpendCheckInterval"`
 	DoubleSpendTxStatusOlderThanInterval time.Duration                        `mapstructure:"doubleSpendTxStatusOlderThanInterval"`
+	RejectedQuarantine                   time.Duration                        `mapstructure:"rejectedQuarantine"`
+	KnownTxFilter                        *KnownTxFilterConfig                 `mapstructure:"knownTxFilter"`
+	SLAReports                           *SLAReportsConfig                    `mapstructure:"slaReports"`
+	BlockTemplates                       *BlockTemplatesConfig                `mapstructure:"blockTemplates"`
+	AnnouncementThrottle                 *AnnouncementThrottleConfig          `mapstructure:"announcementThrottle"`
+	SharedTxs                            *SharedTxsConfig                     `mapstructure:"sharedTxs"`
+	InvBatching                          *InvBatchingConfig                   `mapstructure:"invBatching"`
+	Reconciliation                       *ReconciliationConfig                `mapstructure:"reconciliation"`
+	ConsistencyCheck                     *ConsistencyCheckConfig              `mapstructure:"consistencyCheck"`
+	NodeSubmitFallback                   *NodeSubmitFallbackConfig            `mapstructure:"nodeSubmitFallback"`
+	Partitioning                         *PartitioningConfig                  `mapstructure:"partitioning"`
+	StatusExport                         *StatusExportConfig                  `mapstructure:"statusExport"`
+	Analytics                            *AnalyticsConfig                     `mapstructure:"analytics"`
+	BulkExport                           *BulkExportConfig                    `mapstructure:"bulkExport"`
+	// PublishStatusUpdates publishes the hashes of transactions whose status has been updated on the message queue,
+	// which invalidates the statuses cached by the API
+	PublishStatusUpdates bool `mapstructure:"publishStatusUpdates"`
+	// MalleabilityDetection stores the normalized hashes of the submitted transactions and registers them with blocktx,
+	// so that mined malleated variants of the transactions are detected
+	MalleabilityDetection bool `mapstructure:"malleabilityDetection"`
+	// CancellationWindow delays the broadcast of all submitted transactions, so that they can be cancelled before they
+	// are announced to the network
+	CancellationWindow time.Duration `mapstructure:"cancellationWindow"`
+	// LateStatusHistory records late lower-ranked statuses, e.g. SEEN_ON_NETWORK arriving after MINED, in the status
+	// history of the transaction, otherwise they are dropped. They never regress the status of the transaction.
+	LateStatusHistory bool `mapstructure:"lateStatusHistory"`
+	// Jobs override the schedules of the maintenance jobs by job name, e.g. reAnnounceUnseen
+	Jobs map[string]*JobConfig `mapstructure:"jobs"`
+}
+
+// JobConfig overrides the default schedule of a maintenance job. Schedule is a cron expression, e.g. "*/5 * * * *", a
+// descriptor like "@hourly" or an interval like "@every 30s". Each run is delayed by a random duration of up to Jitter.
+// A paused job only runs if it is triggered on the admin service.
+type JobConfig struct {
+	Schedule string        `mapstructure:"schedule"`
+	Jitter   time.Duration `mapstructure:"jitter"`
+	Paused   bool          `mapstructure:"paused"`
+}
+
+// StatusExportConfig configures the export of the status change events of transactions as newline-delimited JSON
+// files, which are uploaded to object storage with UploadCommand.
+type StatusExportConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Dir     string `mapstructure:"dir"`
+	// RotateInterval and MaxFileSize in bytes are the age and size after which the export file is rotated and uploaded
+	RotateInterval time.Duration `mapstructure:"rotateInterval"`
+	MaxFileSize    int64         `mapstructure:"maxFileSize"`
+	// UploadCommand uploads an export file, the placeholders {file} and {name} are replaced with the path and the name
+	// of the file
+	UploadCommand []string      `mapstructure:"uploadCommand"`
+	UploadTimeout time.Duration `mapstructure:"uploadTimeout"`
+}
+
+// AnalyticsConfig configures the streaming of the status change events of transactions into the analytics database
+// given by Sink, either "clickhouse" or "bigquery".
+type AnalyticsConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Sink          string        `mapstructure:"sink"`
+	BatchSize     int           `mapstructure:"batchSize"`
+	FlushInterval time.Duration `mapstructure:"flushInterval"`
+	// BufferSize is the maximum number of buffered events, events are dropped if the buffer is full
+	BufferSize int                      `mapstructure:"bufferSize"`
+	Backfill   *AnalyticsBackfillConfig `mapstructure:"backfill"`
+	ClickHouse *ClickHouseConfig        `mapstructure:"clickhouse"`
+	BigQuery   *BigQueryConfig          `mapstructure:"bigquery"`
+}
+
+// BulkExportConfig configures the export of the transactions matching a time range, statuses and a tenant as CSV or
+// newline-delimited JSON at the admin endpoint /v1/admin/export of the API server.
+type BulkExportConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// BatchSize is the number of transactions read from the database at once
+	BatchSize int64 `mapstructure:"batchSize"`
+}
+
+// AnalyticsBackfillConfig configures the backfill of the events of the transactions stored within Since before the
+// start from their status history.
+type AnalyticsBackfillConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Since   time.Duration `mapstructure:"since"`
+}
+
+type ClickHouseConfig struct {
+	URL      string `mapstructure:"url"`
+	Database string `mapstructure:"database"`
+	Table    string `mapstructure:"table"`
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password"`
+}
+
+type BigQueryConfig struct {
+	Project string `mapstructure:"project"`
+	Dataset string `mapstructure:"dataset"`
+	Table   string `mapstructure:"table"`
+	// TokenCommand prints an access token for the BigQuery API, e.g. `gcloud auth print-access-token`
+	TokenCommand []string      `mapstructure:"tokenCommand"`
+	TokenTTL     time.Duration `mapstructure:"tokenTTL"`
+}
+
+// ReconciliationConfig configures the periodic reconciliation of the transaction statuses with the mempool and the
+// chain of the node configured in `peerRpc`.
+type ReconciliationConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// StaleAfter is the duration after which transactions which are not mined are reconciled
+	StaleAfter time.Duration `mapstructure:"staleAfter"`
+	// OnStart reconciles the statuses once on start, e.g. after the databases were restored from a backup
+	OnStart bool `mapstructure:"onStart"`
+}
+
+// ConsistencyCheckConfig configures the periodic check that metamorph and blocktx agree on the transactions mined in
+// the latest blocks.
+type ConsistencyCheckConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// Blocks is the number of latest blocks whose transactions are checked
+	Blocks uint64 `mapstructure:"blocks"`
+}
+
+// NodeSubmitFallbackConfig configures the submission of transactions which no peer requested after their announcement
+// to a node with sendrawtransaction.
+type NodeSubmitFallbackConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Timeout is the duration after the announcement after which a transaction which was not requested is submitted
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Interval time.Duration `mapstructure:"interval"`
+	// Node is the node the transactions are submitted to, the node configured in `peerRpc` if not set
+	Node *PeerRPCConfig `mapstructure:"node"`
+}
+
+// PartitioningConfig configures the opt-in partitioning of the transactions table and the management of its daily
+// partitions, which replaces the deletion of expired transactions by ClearData. Partitioning cannot be disabled once the
+// table has been partitioned.
+type PartitioningConfig struct {
+	Enabled         bool          `mapstructure:"enabled"`
+	Interval        time.Duration `mapstructure:"interval"`
+	PartitionsAhead int           `mapstructure:"partitionsAhead"`
+	RetentionDays   int           `mapstructure:"retentionDays"`
+}
+
+// AnnouncementThrottleConfig configures the rate limit of transaction announcements to each peer.
+type AnnouncementThrottleConfig struct {
+	TxsPerSecond int `mapstructure:"txsPerSecond"`
+	MaxQueued    int `mapstructure:"maxQueued"`
+}
+
+// InvBatchingConfig configures the batching of the transaction announcements in INV messages. With Trickle the batches
+// are sent to each peer at randomized times around the interval.
+type InvBatchingConfig struct {
+	MaxInvs  int           `mapstructure:"maxInvs"`
+	Interval time.Duration `mapstructure:"interval"`
+	Trickle  bool          `mapstructure:"trickle"`
+}
+
+// SharedTxsConfig configures the sending of the transactions requested by the peers from a single shared copy per
+// transaction, which is kept for the TTL after it has been requested.
+type SharedTxsConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	TTL     time.Duration `mapstructure:"ttl"`
+}
+
+// BlockTemplatesConfig configures the tracking of the transactions included in the block templates of mining pools.
+type BlockTemplatesConfig struct {
+	Enabled          bool                    `mapstructure:"enabled"`
+	Expiry           time.Duration           `mapstructure:"expiry"`
+	MiningCandidates *MiningCandidatesConfig `mapstructure:"miningCandidates"`
+}
+
+// MiningCandidatesConfig configures the polling of the mining candidates of nodes via RPC.
+type MiningCandidatesConfig struct {
+	PollInterval time.Duration    `mapstructure:"pollInterval"`
+	Nodes        []*PeerRPCConfig `mapstructure:"nodes"`
+}
+
+// KnownTxFilterConfig configures the in-memory filter of known transactions.
+type KnownTxFilterConfig struct {
+	Enabled           bool          `mapstructure:"enabled"`
+	Capacity          int           `mapstructure:"capacity"`
+	FalsePositiveRate float64       `mapstructure:"falsePositiveRate"`
+	RebuildInterval   time.Duration `mapstructure:"rebuildInterval"`
+}
+
+// SLAReportsConfig configures the periodic computation of the daily and weekly SLA reports per tenant of the current
+// and the previous periods.
+type SLAReportsConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
 }
 
 type RejectPendingSeenConfig struct {
@@ -124,24 +591,92 @@ type BlocktxConfig struct {
 	ListenAddr                    string                             `mapstructure:"listenAddr"`
 	DialAddr                      string                             `mapstructure:"dialAddr"`
 	Db                            *DbConfig                          `mapstructure:"db"`
+	GrpcAuth                      *GrpcAuthConfig                    `mapstructure:"grpcAuth"`
 	RecordRetentionDays           int                                `mapstructure:"recordRetentionDays"`
 	RegisterTxsInterval           time.Duration                      `mapstructure:"registerTxsInterval"`
 	MaxBlockProcessingDuration    time.Duration                      `mapstructure:"maxBlockProcessingDuration"`
+	BlockDownloadTimeout          time.Duration                      `mapstructure:"blockDownloadTimeout"`
+	PeerSelectionDelay            time.Duration                      `mapstructure:"peerSelectionDelay"`
+	PeerExplorationInterval       int                                `mapstructure:"peerExplorationInterval"`
 	MonitorPeers                  bool                               `mapstructure:"monitorPeers"`
 	FillGaps                      *FillGapsConfig                    `mapstructure:"fillGaps"`
 	UnorphanRecentWrongOrphans    *UnorphanRecentWrongOrphansConfig  `mapstructure:"unorphanRecentWrongOrphans"`
 	MaxAllowedBlockHeightMismatch uint64                             `mapstructure:"maxAllowedBlockHeightMismatch"`
 	MessageQueue                  *MessageQueueConfig                `mapstructure:"mq"`
 	P2pReadBufferSize             int                                `mapstructure:"p2pReadBufferSize"`
+	BlockReader                   *BlockReaderConfig                 `mapstructure:"blockReader"`
+	HashingWorkers                int                                `mapstructure:"hashingWorkers"`
 	IncomingIsLongest             bool                               `mapstructure:"incomingIsLongest"`
+	OptimisticMined               bool                               `mapstructure:"optimisticMined"`
+	MalleabilityDetection         bool                               `mapstructure:"malleabilityDetection"`
+	RawBlockArchive               *RawBlockArchiveConfig             `mapstructure:"rawBlockArchive"`
+	BlockEventWebhooks            *BlockEventWebhooksConfig          `mapstructure:"blockEventWebhooks"`
 	BlockchainNetwork             *BlockchainNetwork[*BlocktxGroups] `mapstructure:"bcnet"`
 }
 
+// RawBlockArchiveConfig configures the archive of the raw bytes of the blocks received from the peers for audits and
+// reprocessing. The blocks are kept in Dir for RetentionDays or uploaded with UploadCommand.
+type RawBlockArchiveConfig struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	Dir           string `mapstructure:"dir"`
+	RetentionDays int    `mapstructure:"retentionDays"`
+	// UploadCommand uploads an archived block, the placeholders {file} and {name} are replaced with the path and the
+	// name of the file
+	UploadCommand []string      `mapstructure:"uploadCommand"`
+	UploadTimeout time.Duration `mapstructure:"uploadTimeout"`
+}
+
+// BlockEventWebhooksConfig configures the webhooks the events of the blocks are posted to, i.e. processed blocks,
+// detected reorgs and blocks whose processing failed.
+type BlockEventWebhooksConfig struct {
+	Webhooks []*BlockEventWebhookConfig `mapstructure:"webhooks"`
+	Timeout  time.Duration              `mapstructure:"timeout"`
+	// MaxRetries is the number of times a failed request is retried after RetryDelay
+	MaxRetries int           `mapstructure:"maxRetries"`
+	RetryDelay time.Duration `mapstructure:"retryDelay"`
+}
+
+// BlockEventWebhookConfig configures a webhook of the block events.
+type BlockEventWebhookConfig struct {
+	URL string `mapstructure:"url"`
+	// Token is sent as bearer token in the Authorization header if set
+	Token string `mapstructure:"token"`
+	// Events are the types of the events sent to the webhook, e.g. blockProcessed, reorgDetected and
+	// blockProcessingFailed, empty sends all events
+	Events []string `mapstructure:"events"`
+}
+
+// BlockReaderConfig configures how blocks received from the peers are read.
+type BlockReaderConfig struct {
+	ReadBufferSize int    `mapstructure:"readBufferSize"`
+	SpillThreshold uint64 `mapstructure:"spillThreshold"`
+	SpillDir       string `mapstructure:"spillDir"`
+}
+
 type BlockchainNetwork[McastT any] struct {
 	Mode    string        `mapstructure:"mode"`
 	Network string        `mapstructure:"network"`
 	Peers   []*PeerConfig `mapstructure:"peers"`
 	Mcast   McastT        `mapstructure:"mcast"`
+	// Autoscaling opens additional peer connections while the throughput received from the peers is below the target
+	Autoscaling *PeerAutoscalingConfig `mapstructure:"autoscaling"`
+}
+
+// PeerAutoscalingConfig configures the additional peer connections which are opened while the throughput of the
+// peers, i.e. the transactions announced to metamorph or the bytes of the blocks downloaded by blocktx per second, is
+// below TargetThroughput and closed again while it is at or below IdleThroughput. The additional peers are taken from
+// Candidates and from the addresses of DNSSeeds which lie within TrustedNetworks.
+type PeerAutoscalingConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	Candidates       []*PeerConfig `mapstructure:"candidates"`
+	DNSSeeds         []string      `mapstructure:"dnsSeeds"`
+	DNSSeedPort      uint16        `mapstructure:"dnsSeedPort"`
+	TrustedNetworks  []string      `mapstructure:"trustedNetworks"`
+	TargetThroughput float64       `mapstructure:"targetThroughput"`
+	IdleThroughput   float64       `mapstructure:"idleThroughput"`
+	// MaxPeers limits the number of peers including the configured peers
+	MaxPeers int           `mapstructure:"maxPeers"`
+	Interval time.Duration `mapstructure:"interval"`
 }
 
 type BlocktxGroups struct {
@@ -208,6 +743,414 @@ type APIConfig struct {
 	DefaultPolicy           *bitcoin.Settings      `mapstructure:"defaultPolicy"`
 	RequestExtendedLogs     bool                   `mapstructure:"requestExtendedLogs"`
 	MerkleRootVerification  MerkleRootVerification `mapstructure:"merkleRootVerification"`
+	Consolidation           *ConsolidationConfig   `mapstructure:"consolidation"`
+	Tenants                 []*TenantConfig        `mapstructure:"tenants"`
+	FastPath                *FastPathConfig        `mapstructure:"fastPath"`
+	Deduplication           *DeduplicationConfig   `mapstructure:"deduplication"`
+	Dashboard               *DashboardConfig       `mapstructure:"dashboard"`
+	CORS                    *CORSConfig            `mapstructure:"cors"`
+	StatusMapping           []*StatusMappingConfig `mapstructure:"statusMapping"`
+	ScriptPolicies          []*ScriptPolicyConfig  `mapstructure:"scriptPolicies"`
+	OpcodeLimits            []*OpcodeLimitConfig   `mapstructure:"opcodeLimits"`
+	TemplateStats           *TemplateStatsConfig   `mapstructure:"templateStats"`
+	AbuseScoring            *AbuseScoringConfig    `mapstructure:"abuseScoring"`
+	Admission               *AdmissionConfig       `mapstructure:"admission"`
+	FeeStats                *FeeStatsConfig        `mapstructure:"feeStats"`
+	Compliance              *ComplianceConfig      `mapstructure:"compliance"`
+	// ConcurrencyLimits limits the number of requests of each route group in flight on an instance of the API
+	ConcurrencyLimits *ConcurrencyLimitsConfig `mapstructure:"concurrencyLimits"`
+	// RejectionDigest sends a periodic digest of the rejected transactions of each tenant to its webhooks or emails
+	RejectionDigest *RejectionDigestConfig `mapstructure:"rejectionDigest"`
+	// KnownTxCacheTTL is the duration for which the statuses of already processed transactions are cached for
+	// resubmissions of the same transactions, 0 disables the cache
+	KnownTxCacheTTL time.Duration `mapstructure:"knownTxCacheTTL"`
+	// StatusCacheTTL is the duration for which the statuses requested by GET /tx/{txid} are cached in the cache store,
+	// 0 disables the cache. Cached statuses are invalidated on the status updates published by metamorph, therefore the
+	// cache requires Metamorph.PublishStatusUpdates
+	StatusCacheTTL time.Duration `mapstructure:"statusCacheTTL"`
+	Canary         *CanaryConfig `mapstructure:"canary"`
+	// Ingester submits the transactions of the files dropped into a directory or an S3 prefix
+	Ingester *IngesterConfig `mapstructure:"ingester"`
+	Admin    *AdminConfig    `mapstructure:"admin"`
+	// Federation forwards the transactions accepted by this instance to upstream ARC instances, e.g. of other miners
+	Federation *FederationConfig `mapstructure:"federation"`
+	// CrashReportDir is the directory the submissions whose decoding panicked are written to as fuzzing corpus files,
+	// empty disables the crash reports
+	CrashReportDir string `mapstructure:"crashReportDir"`
+	// ReadOnly rejects the submission, resubmission and cancellation of transactions with 503, only the queries are
+	// served, e.g. during maintenance windows or by public query-only deployments
+	ReadOnly bool `mapstructure:"readOnly"`
+	// ExternalStatusLookup looks up the transactions unknown to ARC on the node configured by peerRpc when their status
+	// is requested and returns their minimal status flagged as external instead of 404
+	ExternalStatusLookup bool `mapstructure:"externalStatusLookup"`
+	// StreamBatchSize is the number of transactions of a POST /txs request with `Accept: application/x-ndjson` which are
+	// processed together before their results are streamed
+	StreamBatchSize int `mapstructure:"streamBatchSize"`
+	// NonFinalTxs is the policy for submitted transactions which are not final because their lock time is not reached:
+	// `reject` rejects them with status 482, `hold` stores them with the status WAITING_FOR_FINALITY and broadcasts them
+	// once they are final
+	NonFinalTxs string `mapstructure:"nonFinalTxs"`
+	// Networks are the bitcoin networks served in addition to the network of the deployment, each by its own metamorph
+	// and blocktx. A request selects the network by the network path segment, e.g. `/testnet/v1/tx`, or the header
+	// X-Network
+	Networks []*NetworkConfig `mapstructure:"networks"`
+	// MempoolAcceptance tests the submitted transactions which passed the validation on the node configured by peerRpc
+	// before they are announced, so that mismatches between the policies of ARC and the node are rejected with the
+	// reason of the node
+	MempoolAcceptance *MempoolAcceptanceConfig `mapstructure:"mempoolAcceptance"`
+	// Receipts returns a receipt signed with the miner ID key with each accepted transaction and stores it in the cache
+	// store, from which it is served by GET /v1/tx/{txid}/receipt
+	Receipts *ReceiptsConfig `mapstructure:"receipts"`
+}
+
+// ReceiptsConfig configures the receipts of the accepted transactions, which are signed with the miner ID key.
+type ReceiptsConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MinerIDKey is the private key in WIF or hex format with which the receipts are signed
+	MinerIDKey string `mapstructure:"minerIdKey"`
+	// Retention is the duration for which the receipts are stored
+	Retention time.Duration `mapstructure:"retention"`
+}
+
+// MempoolAcceptanceConfig configures the mempool acceptance check of the submitted transactions on the node. Method is
+// `testmempoolaccept`, which checks the full mempool policy of the node, or `verifyscript`, which only verifies the
+// scripts, for nodes which lack testmempoolaccept.
+type MempoolAcceptanceConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Method  string `mapstructure:"method"`
+}
+
+// NetworkConfig configures a bitcoin network served by the API in addition to the network of the deployment. The name
+// is the network path segment and the value of the header X-Network of the requests for the network. Network is one of
+// `mainnet`, `testnet` or `regtest`.
+type NetworkConfig struct {
+	Name              string `mapstructure:"name"`
+	Network           string `mapstructure:"network"`
+	MetamorphDialAddr string `mapstructure:"metamorphDialAddr"`
+	BlocktxDialAddr   string `mapstructure:"blocktxDialAddr"`
+	// WocMainnet selects the mainnet API of WhatsOnChain for the lookup of the inputs of the transactions
+	WocMainnet bool `mapstructure:"wocMainnet"`
+}
+
+// StatusMappingConfig maps the failures with an ARC status, and optionally only those whose error contains a substring,
+// to another ARC status and, if given, replaces the detail of the error with an operator defined reject reason.
+type StatusMappingConfig struct {
+	Status        int    `mapstructure:"status"`
+	ErrorContains string `mapstructure:"errorContains"`
+	MapTo         int    `mapstructure:"mapTo"`
+	RejectReason  string `mapstructure:"rejectReason"`
+}
+
+// ScriptPolicyConfig restricts the API key, which is expected as bearer token in the Authorization header, to submitting
+// only transactions whose outputs match one of the allowed script templates: `p2pkh`, `p2pk`, `multisig`, `nulldata`
+// or `prefix:<hex>`.
+type ScriptPolicyConfig struct {
+	APIKey           string   `mapstructure:"apiKey"`
+	AllowedTemplates []string `mapstructure:"allowedTemplates"`
+}
+
+// OpcodeLimitConfig limits the number of occurrences of an opcode, e.g. `OP_MUL`, or of the opcodes of an opcode class,
+// i.e. `arithmetic`, `bitwise`, `splice` or `bignumber`, in the scripts of a submitted transaction. A maximum count of 0
+// forbids the opcode.
+type OpcodeLimitConfig struct {
+	Opcode   string `mapstructure:"opcode"`
+	MaxCount int    `mapstructure:"maxCount"`
+}
+
+// TemplateStatsConfig configures the statistics of the submitted transactions by the script templates of their outputs
+// and the detection of anomalies of their submission rates. The outputs are bucketed by the templates given, e.g.
+// `prefix:<hex>` of a protocol, before they are bucketed by the standard templates `p2pkh`, `p2pk`, `multisig` and
+// `nulldata` or as `nonstandard`.
+type TemplateStatsConfig struct {
+	Enabled   bool          `mapstructure:"enabled"`
+	Templates []string      `mapstructure:"templates"`
+	Window    time.Duration `mapstructure:"window"`
+	// AnomalyFactor is the factor by which the submission rate of a template has to exceed its baseline to be anomalous
+	AnomalyFactor float64 `mapstructure:"anomalyFactor"`
+	// MinRate is the submission rate in txs per second below which a template is never anomalous
+	MinRate       float64 `mapstructure:"minRate"`
+	WarmupWindows int     `mapstructure:"warmupWindows"`
+	// Clamp rejects the submissions of an anomalous template exceeding the threshold rate with status 478
+	Clamp bool `mapstructure:"clamp"`
+}
+
+// AbuseScoringConfig configures the scoring of the submissions of each client, identified by its API key or address,
+// for spam and abuse before they are validated. The score is the weighted average of the signals burst rate, template,
+// fee level and rejection rate of the client, each between 0 and 1.
+type AbuseScoringConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Window  time.Duration `mapstructure:"window"`
+	// BurstRate is the submission rate in txs per second of a client at which its burst rate signal is 1
+	BurstRate float64 `mapstructure:"burstRate"`
+	// Templates are the custom templates, e.g. `prefix:<hex>` of a protocol, by which the outputs are bucketed
+	// before the standard templates
+	Templates      []string                    `mapstructure:"templates"`
+	TemplateScores []*AbuseTemplateScoreConfig `mapstructure:"templateScores"`
+	// FeeFactor is the factor of the required fee from which a transaction has a fee level signal of 0
+	FeeFactor float64 `mapstructure:"feeFactor"`
+	// MinSamples is the number of recent outcomes of a client below which its rejection rate signal is 0
+	MinSamples int                 `mapstructure:"minSamples"`
+	Weights    *AbuseWeightsConfig `mapstructure:"weights"`
+	// ThrottleThreshold is the score from which the submissions of a client are throttled to ThrottleRate txs per second
+	ThrottleThreshold float64 `mapstructure:"throttleThreshold"`
+	ThrottleRate      float64 `mapstructure:"throttleRate"`
+	// RejectThreshold is the score from which the submissions of a client are rejected
+	RejectThreshold float64 `mapstructure:"rejectThreshold"`
+}
+
+type AbuseTemplateScoreConfig struct {
+	Template string  `mapstructure:"template"`
+	Score    float64 `mapstructure:"score"`
+}
+
+type AbuseWeightsConfig struct {
+	BurstRate     float64 `mapstructure:"burstRate"`
+	Template      float64 `mapstructure:"template"`
+	FeeLevel      float64 `mapstructure:"feeLevel"`
+	RejectionRate float64 `mapstructure:"rejectionRate"`
+}
+
+// AdmissionConfig configures the admission control of the submissions, which bounds the memory used by the submissions
+// in flight on an instance of the API. The submissions which exceed the memory budget are rejected with 429.
+type AdmissionConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MemoryBudget is the maximum sum of the sizes in bytes of the request bodies of the submissions in flight
+	MemoryBudget int64 `mapstructure:"memoryBudget"`
+	// QueueTimeout is the maximum duration a submission exceeding the budget waits for enough of the budget to be
+	// released before it is rejected, 0 rejects it immediately
+	QueueTimeout time.Duration `mapstructure:"queueTimeout"`
+}
+
+// FeeStatsConfig configures the aggregation of the fee rates and the throughput of the accepted transactions which are
+// served by GET /v1/stats.
+type FeeStatsConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	Window  time.Duration `mapstructure:"window"`
+	// Interval is the interval in which the statuses of the accepted, not yet mined transactions are checked
+	Interval time.Duration `mapstructure:"interval"`
+	// MaxPending is the maximum number of accepted transactions which are tracked until they are mined
+	MaxPending int `mapstructure:"maxPending"`
+}
+
+// ConcurrencyLimitsConfig configures the concurrency limits of the route groups, the submissions (POST requests) and
+// the queries (GET and HEAD requests), so that a flood of heavy batch submissions can't starve the status queries. The
+// requests exceeding the limit of their group are rejected with 429.
+type ConcurrencyLimitsConfig struct {
+	Enabled    bool                    `mapstructure:"enabled"`
+	Submission *ConcurrencyLimitConfig `mapstructure:"submission"`
+	Query      *ConcurrencyLimitConfig `mapstructure:"query"`
+}
+
+type ConcurrencyLimitConfig struct {
+	// MaxConcurrent is the maximum number of requests of the group in flight, 0 doesn't limit the group
+	MaxConcurrent int64 `mapstructure:"maxConcurrent"`
+	// QueueTimeout is the maximum duration a request exceeding the limit waits for a request of the group to finish
+	// before it is rejected, 0 rejects it immediately
+	QueueTimeout time.Duration `mapstructure:"queueTimeout"`
+}
+
+// ComplianceConfig configures the scanning of the output scripts and data carrier contents of the submitted
+// transactions by a compliance engine implementing the gRPC service compliance_api.ComplianceAPI, which flags or blocks
+// the transactions.
+type ComplianceConfig struct {
+	Enabled  bool   `mapstructure:"enabled"`
+	DialAddr string `mapstructure:"dialAddr"`
+	// Timeout is the time after which the scan of a transaction fails
+	Timeout time.Duration `mapstructure:"timeout"`
+	// CacheTTL is the duration for which the decision on a transaction is cached
+	CacheTTL time.Duration `mapstructure:"cacheTTL"`
+	// FailClosed blocks the transactions which could not be scanned, e.g. because the engine is unavailable, instead of
+	// accepting them
+	FailClosed bool `mapstructure:"failClosed"`
+	// DataCarrierOnly scans only the transactions with at least one data carrier output
+	DataCarrierOnly bool `mapstructure:"dataCarrierOnly"`
+}
+
+// RejectionDigestConfig configures the digest of the transactions rejected per tenant, which lists the reasons of the
+// rejections and the first rejected transactions. The digest is sent on schedule to the recipients of the tenants which
+// had rejections since the last digest.
+type RejectionDigestConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Schedule is a cron expression in the local time of the server, a descriptor like `@daily` or an interval like
+	// `@every 12h`
+	Schedule string `mapstructure:"schedule"`
+	// MaxTransactions is the maximum number of rejected transactions listed in a digest
+	MaxTransactions int                      `mapstructure:"maxTransactions"`
+	SMTP            *SMTPConfig              `mapstructure:"smtp"`
+	Recipients      []*DigestRecipientConfig `mapstructure:"recipients"`
+}
+
+// SMTPConfig configures the SMTP server emails are sent with. The username and password are only used if the username
+// is set.
+type SMTPConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	From     string `mapstructure:"from"`
+}
+
+// DigestRecipientConfig assigns the webhook and the email addresses the digest of the tenant is sent to.
+type DigestRecipientConfig struct {
+	// Tenant is the name of the tenant, see TenantConfig
+	Tenant       string   `mapstructure:"tenant"`
+	WebhookURL   string   `mapstructure:"webhookUrl"`
+	WebhookToken string   `mapstructure:"webhookToken"`
+	Emails       []string `mapstructure:"emails"`
+}
+
+// CORSConfig configures the CORS headers of the API server, so that browser-based clients, e.g. wallets, can submit
+// transactions to ARC directly.
+type CORSConfig struct {
+	// AllowOrigins are the origins allowed to access the API. The wildcards `*` and `?` are supported, e.g.
+	// `https://*.example.com`
+	AllowOrigins []string `mapstructure:"allowOrigins"`
+	// AllowHeaders are the request headers allowed in requests. If empty, the headers requested by the preflight request
+	// are allowed
+	AllowHeaders     []string `mapstructure:"allowHeaders"`
+	ExposeHeaders    []string `mapstructure:"exposeHeaders"`
+	AllowCredentials bool     `mapstructure:"allowCredentials"`
+	// MaxAge is the duration for which browsers cache the result of a preflight request
+	MaxAge time.Duration `mapstructure:"maxAge"`
+}
+
+// DashboardConfig configures the operator dashboard served by the API server at /dashboard.
+type DashboardConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MetricsSources are the URLs of the Prometheus metrics endpoints of the other services, e.g. of metamorph, blocktx
+	// and callbacker, shown in addition to the metrics of the API server
+	MetricsSources   []string `mapstructure:"metricsSources"`
+	RecentRejections int      `mapstructure:"recentRejections"`
+}
+
+// CanaryConfig configures the canary of the API server which periodically submits a tiny transaction paying the
+// canary address back to itself through the public API and tracks its progression to MINED.
+type CanaryConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the URL of the public API of ARC the canary transactions are submitted to, e.g. of the load balancer in
+	// front of the API servers, so that the whole path taken by the transactions of the clients is covered
+	URL           string `mapstructure:"url"`
+	Authorization string `mapstructure:"authorization"`
+	// FundingKey is the extended private key of the canary address, the address is derived with the derivation path
+	FundingKey        string        `mapstructure:"fundingKey"`
+	DerivationPath    string        `mapstructure:"derivationPath"`
+	Interval          time.Duration `mapstructure:"interval"`
+	MinedTimeout      time.Duration `mapstructure:"minedTimeout"`
+	MiningFeeSatPerKb uint64        `mapstructure:"miningFeeSatPerKb"`
+}
+
+// TenantConfig assigns the API key, which is expected as bearer token in the Authorization header, to a tenant. The
+// transactions submitted with the API key are stored with the name of the tenant, so that they can be reported and
+// exported by tenant.
+type TenantConfig struct {
+	Name   string `mapstructure:"name"`
+	APIKey string `mapstructure:"apiKey"`
+}
+
+// IngesterConfig configures the ingester of the API server which watches a drop folder, i.e. a directory or a prefix
+// of an S3 bucket, for files of transactions, submits them through the public API and writes a manifest with the
+// results of each file.
+type IngesterConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// URL is the URL of the public API of ARC the transactions are submitted to
+	URL           string `mapstructure:"url"`
+	Authorization string `mapstructure:"authorization"`
+	// Dir is the directory watched for files, if no S3 bucket is set
+	Dir string            `mapstructure:"dir"`
+	S3  *IngesterS3Config `mapstructure:"s3"`
+	// Interval is the interval in which the drop folder is checked for new files
+	Interval time.Duration `mapstructure:"interval"`
+	// MinAge is the duration for which a file must not have been modified before it is processed
+	MinAge          time.Duration `mapstructure:"minAge"`
+	BatchSize       int           `mapstructure:"batchSize"`
+	DeleteProcessed bool          `mapstructure:"deleteProcessed"`
+	WaitFor         string        `mapstructure:"waitFor"`
+	CallbackURL     string        `mapstructure:"callbackUrl"`
+	CallbackToken   string        `mapstructure:"callbackToken"`
+}
+
+// IngesterS3Config configures the S3 bucket watched by the ingester. The bucket is addressed path-style.
+type IngesterS3Config struct {
+	Endpoint        string `mapstructure:"endpoint"`
+	Region          string `mapstructure:"region"`
+	Bucket          string `mapstructure:"bucket"`
+	Prefix          string `mapstructure:"prefix"`
+	AccessKeyID     string `mapstructure:"accessKeyId"`
+	SecretAccessKey string `mapstructure:"secretAccessKey"`
+}
+
+// AdminConfig configures the admin gRPC service of the API server which consolidates the admin operations. Every
+// call has to be authorized with one of the tokens, whose role determines the operations it is allowed to call.
+type AdminConfig struct {
+	Enabled    bool                `mapstructure:"enabled"`
+	ListenAddr string              `mapstructure:"listenAddr"`
+	Tokens     []*AdminTokenConfig `mapstructure:"tokens"`
+	TLS        *GrpcTLSConfig      `mapstructure:"tls"`
+	// TaskRetention is the duration for which finished background tasks are listed
+	TaskRetention time.Duration `mapstructure:"taskRetention"`
+}
+
+// AdminTokenConfig is a bearer token of the admin service. The name identifies the token in the audit log, the role
+// is one of viewer, operator or admin.
+type AdminTokenConfig struct {
+	Name  string `mapstructure:"name"`
+	Token string `mapstructure:"token"`
+	Role  string `mapstructure:"role"`
+}
+
+// FederationConfig configures the forwarding of the accepted transactions to the public APIs of upstream ARC instances.
+// The acknowledgements of the upstreams are kept in the cache store and served at GET /v1/tx/{txid}/upstreams.
+type FederationConfig struct {
+	Enabled   bool                        `mapstructure:"enabled"`
+	Upstreams []*FederationUpstreamConfig `mapstructure:"upstreams"`
+	// Timeout is the timeout of the requests to the upstreams
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Workers is the number of batches of transactions forwarded concurrently
+	Workers int `mapstructure:"workers"`
+	// QueueSize is the number of batches of transactions waiting to be forwarded, batches exceeding it are dropped
+	QueueSize int `mapstructure:"queueSize"`
+	// AcknowledgementTTL is the duration for which the acknowledgements of a forwarded transaction are kept
+	AcknowledgementTTL time.Duration `mapstructure:"acknowledgementTTL"`
+	// Aggregation is the policy determining the status returned to the clients from the responses of the upstreams,
+	// none, first-success, quorum or all. With none the transactions are forwarded in the background
+	Aggregation string `mapstructure:"aggregation"`
+	// Quorum is the number of upstreams which have to acknowledge a transaction with the quorum policy
+	Quorum int `mapstructure:"quorum"`
+}
+
+type FederationUpstreamConfig struct {
+	Name          string `mapstructure:"name"`
+	URL           string `mapstructure:"url"`
+	Authorization string `mapstructure:"authorization"`
+}
+
+// ConsolidationConfig configures the fee policy of consolidation transactions. Consolidation transactions are
+// classified according to the consolidation settings of the policy, e.g. `minconsolidationfactor`.
+type ConsolidationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MinMiningTxFee is the minimum mining fee in BSV per kilobyte applied to consolidation transactions spending
+	// confirmed outputs only. A fee of 0 accepts them without fee.
+	MinMiningTxFee float64 `mapstructure:"minMiningTxFee"`
+}
+
+// FastPathConfig configures the validation of small standard P2PKH transactions, whose scripts are matched by their
+// bytes instead of being parsed for the policy checks.
+type FastPathConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// MaxSize is the maximum size in bytes of the transactions validated on the fast path
+	MaxSize int `mapstructure:"maxSize"`
+}
+
+// DeduplicationConfig configures the claims of submitted transactions in the cache store, which let only one of several
+// API instances process a transaction submitted to more than one of them at the same time. The instances have to share
+// the cache store, i.e. use redis.
+type DeduplicationConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// ClaimTTL is the duration after which the claim of an instance expires if the instance doesn't release it
+	ClaimTTL time.Duration `mapstructure:"claimTTL"`
+	// Wait is the maximum duration an instance waits for the release of a claim of another instance before it
+	// processes the transaction itself
+	Wait time.Duration `mapstructure:"wait"`
 }
 
 type K8sWatcherConfig struct {
@@ -215,22 +1158,170 @@ type K8sWatcherConfig struct {
 }
 
 type CallbackerConfig struct {
-	ListenAddr        string        `mapstructure:"listenAddr"`
-	DialAddr          string        `mapstructure:"dialAddr"`
-	Pause             time.Duration `mapstructure:"pause"`
-	BatchSendInterval time.Duration `mapstructure:"batchSendInterval"`
-	PruneOlderThan    time.Duration `mapstructure:"pruneOlderThan"`
-	PruneInterval     time.Duration `mapstructure:"pruneInterval"`
-	Expiration        time.Duration `mapstructure:"expiration"`
-	Db                *DbConfig     `mapstructure:"db"`
+	ListenAddr        string            `mapstructure:"listenAddr"`
+	DialAddr          string            `mapstructure:"dialAddr"`
+	Pause             time.Duration     `mapstructure:"pause"`
+	BatchSendInterval time.Duration     `mapstructure:"batchSendInterval"`
+	PruneOlderThan    time.Duration     `mapstructure:"pruneOlderThan"`
+	PruneInterval     time.Duration     `mapstructure:"pruneInterval"`
+	Expiration        time.Duration     `mapstructure:"expiration"`
+	SigningSecret     string            `mapstructure:"signingSecret"`
+	Db                *DbConfig         `mapstructure:"db"`
+	GrpcAuth          *GrpcAuthConfig   `mapstructure:"grpcAuth"`
+	SLAReports        *SLAReportsConfig `mapstructure:"slaReports"`
+	// PayloadTemplates transform the payloads of the callbacks, the first template matching a callback applies
+	PayloadTemplates []*PayloadTemplateConfig `mapstructure:"payloadTemplates"`
+	// Jobs override the schedules of the jobs by job name, e.g. callbackStoreCleanup
+	Jobs map[string]*JobConfig `mapstructure:"jobs"`
+	// TenantRateLimits shape the delivery of the callbacks per tenant
+	TenantRateLimits []*TenantRateLimitConfig `mapstructure:"tenantRateLimits"`
+}
+
+// TenantRateLimitConfig limits the callbacks delivered to the tenant Tenant to Rate callbacks per second with bursts of
+// up to Burst callbacks. The limit of the tenant "*" applies to all tenants without own limit and to the callbacks of
+// transactions without tenant.
+type TenantRateLimitConfig struct {
+	Tenant string  `mapstructure:"tenant"`
+	Rate   float64 `mapstructure:"rate"`
+	Burst  int     `mapstructure:"burst"`
+}
+
+// PayloadTemplateConfig transforms the payloads of the callbacks sent to the callback URLs starting with URLPrefix or
+// with the callback token Token with the Go templates Template and BatchTemplate. If BatchTemplate is empty, the
+// callbacks of batches are sent one by one.
+type PayloadTemplateConfig struct {
+	URLPrefix     string `mapstructure:"urlPrefix"`
+	Token         string `mapstructure:"token"`
+	ContentType   string `mapstructure:"contentType"`
+	Template      string `mapstructure:"template"`
+	BatchTemplate string `mapstructure:"batchTemplate"`
+}
+
+// GrpcAuthConfig configures the authentication on the gRPC endpoint of a service. The same configuration is used by
+// the gRPC server of the service and by all clients connecting to it.
+type GrpcAuthConfig struct {
+	Token string         `mapstructure:"token"`
+	TLS   *GrpcTLSConfig `mapstructure:"tls"`
+}
+
+type GrpcTLSConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// CaFile, CertFile and KeyFile are used by the gRPC server of the service only
+	CaFile   string `mapstructure:"caFile"`
+	CertFile string `mapstructure:"certFile"`
+	KeyFile  string `mapstructure:"keyFile"`
+	// Client is used by the clients connecting to the gRPC server of the service
+	Client *GrpcTLSClientConfig `mapstructure:"client"`
+}
+
+type GrpcTLSClientConfig struct {
+	// CaFile verifies the certificate of the server, the system CAs are used if empty
+	CaFile string `mapstructure:"caFile"`
+	// CertFile and KeyFile are presented to the server as client certificate (mTLS)
+	CertFile string `mapstructure:"certFile"`
+	KeyFile  string `mapstructure:"keyFile"`
+}
+
+// GrpcClientConfig configures the resilience of the clients connecting to the internal gRPC endpoints.
+type GrpcClientConfig struct {
+	// Timeout is the deadline applied to requests which do not have a deadline already. Zero disables it.
+	Timeout        time.Duration             `mapstructure:"timeout"`
+	Retry          *GrpcRetryConfig          `mapstructure:"retry"`
+	CircuitBreaker *GrpcCircuitBreakerConfig `mapstructure:"circuitBreaker"`
+	// QueueSubmissions publishes submitted transactions to the message queue if metamorph is unavailable
+	QueueSubmissions bool `mapstructure:"queueSubmissions"`
+}
+
+// GrpcRetryConfig configures the retries of idempotent requests failing with UNAVAILABLE.
+type GrpcRetryConfig struct {
+	MaxAttempts    int           `mapstructure:"maxAttempts"`
+	InitialBackoff time.Duration `mapstructure:"initialBackoff"`
+	MaxBackoff     time.Duration `mapstructure:"maxBackoff"`
+}
+
+// GrpcCircuitBreakerConfig configures the circuit breaker which fails requests fast after consecutive failures.
+type GrpcCircuitBreakerConfig struct {
+	Enabled          bool          `mapstructure:"enabled"`
+	FailureThreshold int           `mapstructure:"failureThreshold"`
+	OpenDuration     time.Duration `mapstructure:"openDuration"`
 }
 
 type MerkleRootVerification struct {
-	Timeout             time.Duration        `mapstructure:"timeout"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Policy verifies the Merkle roots against all available block header services concurrently and combines their
+	// answers: `first` answer, `majority` or `all` must confirm. The services are queried one after another if empty
+	Policy              string               `mapstructure:"policy"`
 	BlockHeaderServices []BlockHeaderService `mapstructure:"blockHeaderServices"`
+	NegativeCache       *NegativeCacheConfig `mapstructure:"negativeCache"`
+	// Discovery discovers further block header services at runtime in addition to the configured ones
+	Discovery *ChainTrackerDiscoveryConfig `mapstructure:"discovery"`
+	// DegradedMode is what happens with BEEF transactions whose Merkle roots can not be verified, because no block
+	// header service is available or the services do not reach a quorum: `reject` (default), `accept` with a warning and
+	// re-verify afterwards, or `defer` the submission until the Merkle roots are verified
+	DegradedMode   string                `mapstructure:"degradedMode"`
+	Reverification *ReverificationConfig `mapstructure:"reverification"`
+}
+
+// ReverificationConfig is the re-verification of the Merkle roots of the transactions accepted or deferred by the
+// degraded mode.
+type ReverificationConfig struct {
+	Interval time.Duration `mapstructure:"interval"`
+	// MaxAge is the duration after which the transactions whose Merkle roots could still not be verified are given up on
+	MaxAge time.Duration `mapstructure:"maxAge"`
+	// MaxTransactions is the maximum number of transactions kept for re-verification by all API instances, further ones
+	// are rejected
+	MaxTransactions int `mapstructure:"maxTransactions"`
+}
+
+// ChainTrackerDiscoveryConfig discovers the block header services in every interval, from the targets of a DNS SRV
+// record with mode `dns`, from the running pods matching a label selector with mode `kubernetes` or from the JSON list
+// of services returned by a configuration service with mode `configService`. Services which are no longer discovered
+// are removed.
+type ChainTrackerDiscoveryConfig struct {
+	Enabled       bool                          `mapstructure:"enabled"`
+	Mode          string                        `mapstructure:"mode"`
+	Interval      time.Duration                 `mapstructure:"interval"`
+	DNS           *DNSDiscoveryConfig           `mapstructure:"dns"`
+	Kubernetes    *KubernetesDiscoveryConfig    `mapstructure:"kubernetes"`
+	ConfigService *ConfigServiceDiscoveryConfig `mapstructure:"configService"`
+	// Service is the authentication of the requests to the discovered services, its url is ignored
+	Service BlockHeaderService `mapstructure:"service"`
+}
+
+type DNSDiscoveryConfig struct {
+	// Record is the name of the SRV record, e.g. `_bhs._tcp.example.com`
+	Record string `mapstructure:"record"`
+	Scheme string `mapstructure:"scheme"`
+}
+
+type KubernetesDiscoveryConfig struct {
+	Namespace     string `mapstructure:"namespace"`
+	LabelSelector string `mapstructure:"labelSelector"`
+	Port          int    `mapstructure:"port"`
+	Scheme        string `mapstructure:"scheme"`
+}
+
+type ConfigServiceDiscoveryConfig struct {
+	URL string `mapstructure:"url"`
+}
+
+// NegativeCacheConfig caches the Merkle roots which the block header services found INVALID for the TTL, so that
+// repeated submissions of the same invalid BEEF transactions are rejected without verifying them again. The entries are
+// persisted to the file at Path and invalidated from the height of a chain reorg published by blocktx.
+type NegativeCacheConfig struct {
+	Enabled bool          `mapstructure:"enabled"`
+	TTL     time.Duration `mapstructure:"ttl"`
+	Path    string        `mapstructure:"path"`
 }
 
 type BlockHeaderService struct {
 	URL    string `mapstructure:"url"`
 	APIKey string `mapstructure:"apiKey"`
+	// Auth is the authentication of the requests to the service: `bearer` with the API key, which is the default,
+	// `basic` with username and password or `mutual` with BRC-31/BRC-104 message signing with the private key
+	Auth     string `mapstructure:"auth"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password"`
+	// PrivateKey is the identity key of ARC for the mutual authentication in WIF or hex format
+	PrivateKey string `mapstructure:"privateKey"`
 }
@@ -10,20 +10,135 @@ func getDefaultArcConfig() *ArcConfig {
 	return &ArcConfig{
 		LogLevel:              "DEBUG",
 		LogFormat:             "text",
+		LogSampling:           getLogSamplingConfig(),
 		ProfilerAddr:          "", // optional
+		ContinuousProfiling:   getContinuousProfilingConfig(),
+		Memory:                getMemoryConfig(),
 		Prometheus:            getDefaultPrometheusConfig(),
+		Metrics:               getMetricsConfig(),
 		GrpcMessageSize:       100000000,
+		GrpcClient:            getGrpcClientConfig(),
 		Network:               "regtest",
 		ReBroadcastExpiration: 24 * time.Hour,
 		MessageQueue:          getDefaultMessageQueueConfig(),
 		Tracing:               getDefaultTracingConfig(),
 		PeerRPC:               getDefaultPeerRPCConfig(),
+		PeerBan:               getPeerBanConfig(),
+		PeerMessageDump:       getPeerMessageDumpConfig(),
+		PeerProtocol:          getPeerProtocolConfig(),
 		Metamorph:             getMetamorphConfig(),
 		Blocktx:               getBlocktxConfig(),
 		API:                   getAPIConfig(),
 		K8sWatcher:            nil, // optional
 		Callbacker:            getCallbackerConfig(),
 		Cache:                 getCacheConfig(),
+		Encryption:            getEncryptionConfig(),
+		Migrations:            getMigrationsConfig(),
+		FaultInjection:        getFaultInjectionConfig(),
+		Supervisor:            getSupervisorConfig(),
+		FeatureFlags:          getFeatureFlagsConfig(),
+		Simulator:             getSimulatorConfig(),
+		AllInOne:              getAllInOneConfig(),
+		Preflight:             getPreflightConfig(),
+	}
+}
+
+func getPreflightConfig() *PreflightConfig {
+	return &PreflightConfig{
+		Timeout:            5 * time.Second,
+		CallbackEgressURLs: []string{},
+	}
+}
+
+func getAllInOneConfig() *AllInOneConfig {
+	return &AllInOneConfig{
+		Enabled:           false,
+		MessageBufferSize: 10000,
+	}
+}
+
+func getSimulatorConfig() *SimulatorConfig {
+	return &SimulatorConfig{
+		Enabled:       false,
+		SeenDelay:     time.Second,
+		BlockInterval: time.Minute,
+	}
+}
+
+func getFeatureFlagsConfig() *FeatureFlagsConfig {
+	return &FeatureFlagsConfig{
+		ReorgHandling:  true,
+		CumulativeFees: true,
+		Rebroadcast:    true,
+		Sync:           false,
+		SyncInterval:   10 * time.Second,
+	}
+}
+
+func getSupervisorConfig() *SupervisorConfig {
+	return &SupervisorConfig{
+		RetryDependencies: true,
+		DependencyTimeout: 5 * time.Minute,
+		InitialBackoff:    time.Second,
+		MaxBackoff:        time.Minute,
+	}
+}
+
+func getFaultInjectionConfig() *FaultInjectionConfig {
+	return &FaultInjectionConfig{
+		Enabled: false,
+		Rules:   []*FaultRuleConfig{},
+	}
+}
+
+func getMigrationsConfig() *MigrationsConfig {
+	return &MigrationsConfig{
+		Enabled:           false,
+		LockTimeout:       time.Minute,
+		BackfillBatchSize: 1000,
+		BackfillPause:     time.Second,
+		Contract:          false,
+	}
+}
+
+func getEncryptionConfig() *EncryptionConfig {
+	return &EncryptionConfig{
+		Enabled:      false,
+		CurrentKeyID: "",
+		Keys:         []*EncryptionKeyConfig{},
+	}
+}
+
+func getLogSamplingConfig() *LogSamplingConfig {
+	return &LogSamplingConfig{
+		Enabled:    false,
+		Interval:   time.Second,
+		First:      100,
+		Thereafter: 100,
+	}
+}
+
+func getContinuousProfilingConfig() *ContinuousProfilingConfig {
+	return &ContinuousProfilingConfig{
+		Enabled:               false,
+		Dir:                   "profiles",
+		CheckInterval:         10 * time.Second,
+		HeapThresholdMB:       4096,
+		SchedLatencyThreshold: 50 * time.Millisecond,
+		CPUProfileDuration:    30 * time.Second,
+		Cooldown:              10 * time.Minute,
+		UploadCommand:         []string{},
+	}
+}
+
+func getMemoryConfig() *MemoryConfig {
+	return &MemoryConfig{
+		GCPercent:       0,
+		LimitMB:         0,
+		Throttling:      true,
+		ShrinkThreshold: 0.8,
+		PauseThreshold:  0.9,
+		CheckInterval:   time.Second,
 	}
 }
 
@@ -35,6 +150,18 @@ func getDefaultPrometheusConfig() *PrometheusConfig {
 	}
 }
 
+func getMetricsConfig() *MetricsConfig {
+	return &MetricsConfig{
+		Backend: "prometheus",
+		StatsD: &StatsDConfig{
+			Addr:     "localhost:8125",
+			Prefix:   "",
+			Interval: 10 * time.Second,
+			Tags:     []string{},
+		},
+	}
+}
+
 func getDefaultMessageQueueConfig() *MessageQueueConfig {
 	return &MessageQueueConfig{
 		URL: "nats://nats:4222",
@@ -43,6 +170,14 @@ func getDefaultMessageQueueConfig() *MessageQueueConfig {
 			FileStorage: false,
 		},
 		Initialize: true,
+		Spool: &MessageQueueSpoolConfig{
+			Enabled:       false,
+			Dir:           "mq-spool",
+			MaxBytes:      256 * 1024 * 1024,
+			DrainInterval: time.Second,
+		},
+		SchemaVersions: map[string]int{},
+		SubjectPrefix:  "",
 	}
 }
 
@@ -55,11 +190,40 @@ func getDefaultPeerRPCConfig() *PeerRPCConfig {
 	}
 }
 
+func getPeerBanConfig() *PeerBanConfig {
+	return &PeerBanConfig{
+		Threshold:            100,
+		InvalidMessageWeight: 20,
+		Decay:                time.Hour,
+		BanDuration:          24 * time.Hour,
+		File:                 "", // optional
+	}
+}
+
+func getPeerProtocolConfig() *PeerProtocolConfig {
+	return &PeerProtocolConfig{
+		Version:          70016,
+		MinimumVersion:   0, // optional
+		RequiredServices: 0, // optional
+	}
+}
+
+func getPeerMessageDumpConfig() *PeerMessageDumpConfig {
+	return &PeerMessageDumpConfig{
+		Enabled:       false,
+		Peers:         []string{},
+		File:          "./p2p-messages.dump",
+		MaxFileSizeMB: 100,
+		MaxBackups:    5,
+	}
+}
+
 func getMetamorphConfig() *MetamorphConfig {
 	return &MetamorphConfig{
 		ListenAddr:               "localhost:8001",
 		DialAddr:                 "localhost:8001",
 		Db:                       getDbConfig("metamorph"),
+		GrpcAuth:                 getGrpcAuthConfig(),
 		ReAnnounceUnseenInterval: 60 * time.Second,
 		ReAnnounceSeen: &ReAnnounceSeenConfig{
 			PendingSince:     10 * time.Minute,
@@ -75,7 +239,99 @@ func getMetamorphConfig() *MetamorphConfig {
 		StatusUpdateInterval:                 5 * time.Second,
 		DoubleSpendCheckInterval:             10 * time.Second,
 		DoubleSpendTxStatusOlderThanInterval: 10 * time.Minute,
-		MonitorPeers:                         false,
+		RejectedQuarantine:                   72 * time.Hour,
+		CancellationWindow:                   0,
+		KnownTxFilter: &KnownTxFilterConfig{
+			Enabled:           false,
+			Capacity:          1_000_000,
+			FalsePositiveRate: 0.01,
+			RebuildInterval:   1 * time.Hour,
+		},
+		SLAReports: &SLAReportsConfig{
+			Enabled:  false,
+			Interval: 15 * time.Minute,
+		},
+		BlockTemplates: &BlockTemplatesConfig{
+			Enabled: false,
+			Expiry:  2 * time.Minute,
+			MiningCandidates: &MiningCandidatesConfig{
+				PollInterval: 10 * time.Second,
+			},
+		},
+		AnnouncementThrottle: &AnnouncementThrottleConfig{
+			TxsPerSecond: 0,
+			MaxQueued:    100_000,
+		},
+		InvBatching: &InvBatchingConfig{
+			MaxInvs:  512,
+			Interval: 200 * time.Millisecond,
+			Trickle:  false,
+		},
+		SharedTxs: &SharedTxsConfig{
+			Enabled: true,
+			TTL:     30 * time.Second,
+		},
+		Reconciliation: &ReconciliationConfig{
+			Enabled:    false,
+			Interval:   10 * time.Minute,
+			StaleAfter: 10 * time.Minute,
+			OnStart:    false,
+		},
+		ConsistencyCheck: &ConsistencyCheckConfig{
+			Enabled:  false,
+			Interval: 10 * time.Minute,
+			Blocks:   6,
+		},
+		NodeSubmitFallback: &NodeSubmitFallbackConfig{
+			Enabled:  false,
+			Timeout:  2 * time.Minute,
+			Interval: 30 * time.Second,
+		},
+		Partitioning: &PartitioningConfig{
+			Enabled:         false,
+			Interval:        time.Hour,
+			PartitionsAhead: 3,
+			RetentionDays:   14,
+		},
+		StatusExport: &StatusExportConfig{
+			Enabled:        false,
+			Dir:            "status-export",
+			RotateInterval: 5 * time.Minute,
+			MaxFileSize:    64 * 1024 * 1024,
+			UploadCommand:  []string{},
+			UploadTimeout:  2 * time.Minute,
+		},
+		Analytics: &AnalyticsConfig{
+			Enabled:       false,
+			Sink:          "clickhouse",
+			BatchSize:     500,
+			FlushInterval: 5 * time.Second,
+			BufferSize:    100_000,
+			Backfill: &AnalyticsBackfillConfig{
+				Enabled: false,
+				Since:   24 * time.Hour,
+			},
+			ClickHouse: &ClickHouseConfig{
+				URL:      "http://localhost:8123",
+				Database: "arc",
+				Table:    "status_events",
+			},
+			BigQuery: &BigQueryConfig{
+				Dataset:      "arc",
+				Table:        "status_events",
+				TokenCommand: []string{"gcloud", "auth", "print-access-token"},
+				TokenTTL:     30 * time.Minute,
+			},
+		},
+		BulkExport: &BulkExportConfig{
+			Enabled:   false,
+			BatchSize: 1000,
+		},
+		PublishStatusUpdates:  false,
+		MalleabilityDetection: false,
+		LateStatusHistory:     true,
+		Jobs:                  map[string]*JobConfig{},
+		MonitorPeers:          false,
 		Health: &HealthConfig{
 			MinimumHealthyConnections: 2,
 		},
@@ -95,6 +351,7 @@ func getMetamorphConfig() *MetamorphConfig {
 					},
 				},
 			},
+			Autoscaling: getPeerAutoscalingConfig(50, time.Minute),
 		},
 	}
 }
@@ -104,6 +361,7 @@ func getBlocktxConfig() *BlocktxConfig {
 		ListenAddr:                    "localhost:8011",
 		DialAddr:                      "localhost:8011",
 		Db:                            getDbConfig("blocktx"),
+		GrpcAuth:                      getGrpcAuthConfig(),
 		RecordRetentionDays:           28,
 		RegisterTxsInterval:           10 * time.Second,
 		MonitorPeers:                  false,
@@ -111,9 +369,29 @@ func getBlocktxConfig() *BlocktxConfig {
 		FillGaps:                      getFillGapsConfig(),
 		MaxAllowedBlockHeightMismatch: 3,
 		MaxBlockProcessingDuration:    5 * time.Minute,
+		BlockDownloadTimeout:          3 * time.Minute,
+		PeerSelectionDelay:            2 * time.Second,
+		PeerExplorationInterval:       10,
 		MessageQueue:                  &MessageQueueConfig{},
 		P2pReadBufferSize:             8 * 1024 * 1024,
+		BlockReader:                   getBlockReaderConfig(),
+		HashingWorkers:                0, // GOMAXPROCS
 		IncomingIsLongest:             false,
+		OptimisticMined:               false,
+		MalleabilityDetection:         false,
+		RawBlockArchive: &RawBlockArchiveConfig{
+			Enabled:       false,
+			Dir:           "raw-blocks",
+			RetentionDays: 7,
+			UploadCommand: []string{},
+			UploadTimeout: 2 * time.Minute,
+		},
+		BlockEventWebhooks: &BlockEventWebhooksConfig{
+			Webhooks:   []*BlockEventWebhookConfig{},
+			Timeout:    10 * time.Second,
+			MaxRetries: 3,
+			RetryDelay: time.Second,
+		},
 		BlockchainNetwork: &BlockchainNetwork[*BlocktxGroups]{
 			Mode:    "classic",
 			Network: "regtest",
@@ -125,14 +403,62 @@ func getBlocktxConfig() *BlocktxConfig {
 					},
 				},
 			},
+			Autoscaling: getPeerAutoscalingConfig(100_000, 10*time.Minute),
 		},
 	}
 }
 
+func getPeerAutoscalingConfig(targetThroughput float64, interval time.Duration) *PeerAutoscalingConfig {
+	return &PeerAutoscalingConfig{
+		Enabled:          false,
+		Candidates:       []*PeerConfig{},
+		DNSSeeds:         []string{},
+		DNSSeedPort:      8333,
+		TrustedNetworks:  []string{},
+		TargetThroughput: targetThroughput,
+		IdleThroughput:   0,
+		MaxPeers:         8,
+		Interval:         interval,
+	}
+}
+
+func getBlockReaderConfig() *BlockReaderConfig {
+	return &BlockReaderConfig{
+		ReadBufferSize: 64 * 1024,
+		SpillThreshold: 1_000_000,
+		SpillDir:       "", // optional
+	}
+}
+
 func getAPIConfig() *APIConfig {
 	return &APIConfig{
 		MerkleRootVerification: MerkleRootVerification{
 			BlockHeaderServices: []BlockHeaderService{},
+			NegativeCache: &NegativeCacheConfig{
+				Enabled: false,
+				TTL:     24 * time.Hour,
+				Path:    "merkle-root-negative-cache.json",
+			},
+			Discovery: &ChainTrackerDiscoveryConfig{
+				Enabled:  false,
+				Mode:     "dns",
+				Interval: time.Minute,
+				DNS: &DNSDiscoveryConfig{
+					Scheme: "http",
+				},
+				Kubernetes: &KubernetesDiscoveryConfig{
+					Namespace: "default",
+					Port:      8080,
+					Scheme:    "http",
+				},
+				ConfigService: &ConfigServiceDiscoveryConfig{},
+			},
+			DegradedMode: "reject",
+			Reverification: &ReverificationConfig{
+				Interval:        30 * time.Second,
+				MaxAge:          time.Hour,
+				MaxTransactions: 10000,
+			},
 		},
 		StandardFormatSupported: true,
 		Address:                 "localhost:9090",
@@ -140,6 +466,156 @@ func getAPIConfig() *APIConfig {
 		WocAPIKey:               "mainnet_XXXXXXXXXXXXXXXXXXXX",
 		WocMainnet:              false,
 		RequestExtendedLogs:     false,
+		Consolidation: &ConsolidationConfig{
+			Enabled:        false,
+			MinMiningTxFee: 0,
+		},
+		Tenants: []*TenantConfig{},
+		FastPath: &FastPathConfig{
+			Enabled: false,
+			MaxSize: 1000,
+		},
+		Deduplication: &DeduplicationConfig{
+			Enabled:  false,
+			ClaimTTL: 30 * time.Second,
+			Wait:     5 * time.Second,
+		},
+		Dashboard: &DashboardConfig{
+			Enabled:          false,
+			MetricsSources:   []string{},
+			RecentRejections: 50,
+		},
+		CORS: &CORSConfig{
+			AllowOrigins:     []string{"*"},
+			AllowHeaders:     []string{},
+			ExposeHeaders:    []string{},
+			AllowCredentials: false,
+			MaxAge:           10 * time.Minute,
+		},
+		StatusMapping:  []*StatusMappingConfig{},
+		ScriptPolicies: []*ScriptPolicyConfig{},
+		OpcodeLimits:   []*OpcodeLimitConfig{},
+		TemplateStats: &TemplateStatsConfig{
+			Enabled:       false,
+			Templates:     []string{},
+			Window:        time.Minute,
+			AnomalyFactor: 10,
+			MinRate:       10,
+			WarmupWindows: 5,
+			Clamp:         false,
+		},
+		AbuseScoring: &AbuseScoringConfig{
+			Enabled:        false,
+			Window:         time.Minute,
+			BurstRate:      100,
+			Templates:      []string{},
+			TemplateScores: []*AbuseTemplateScoreConfig{},
+			FeeFactor:      2,
+			MinSamples:     20,
+			Weights: &AbuseWeightsConfig{
+				BurstRate:     1,
+				Template:      1,
+				FeeLevel:      1,
+				RejectionRate: 1,
+			},
+			ThrottleThreshold: 0.6,
+			ThrottleRate:      1,
+			RejectThreshold:   0.9,
+		},
+		Admission: &AdmissionConfig{
+			Enabled:      false,
+			MemoryBudget: 512 * 1024 * 1024,
+			QueueTimeout: 0,
+		},
+		ConcurrencyLimits: &ConcurrencyLimitsConfig{
+			Enabled: false,
+			Submission: &ConcurrencyLimitConfig{
+				MaxConcurrent: 100,
+				QueueTimeout:  5 * time.Second,
+			},
+			Query: &ConcurrencyLimitConfig{
+				MaxConcurrent: 1000,
+				QueueTimeout:  time.Second,
+			},
+		},
+		FeeStats: &FeeStatsConfig{
+			Enabled:    false,
+			Window:     24 * time.Hour,
+			Interval:   30 * time.Second,
+			MaxPending: 10000,
+		},
+		Compliance: &ComplianceConfig{
+			Enabled:         false,
+			DialAddr:        "localhost:8040",
+			Timeout:         time.Second,
+			CacheTTL:        10 * time.Minute,
+			FailClosed:      false,
+			DataCarrierOnly: false,
+		},
+		RejectionDigest: &RejectionDigestConfig{
+			Enabled:         false,
+			Schedule:        "@daily",
+			MaxTransactions: 100,
+			SMTP: &SMTPConfig{
+				Port: 587,
+			},
+			Recipients: []*DigestRecipientConfig{},
+		},
+		KnownTxCacheTTL:      5 * time.Second,
+		StatusCacheTTL:       0,
+		CrashReportDir:       "",
+		ReadOnly:             false,
+		ExternalStatusLookup: false,
+		StreamBatchSize:      1000,
+		NonFinalTxs:          NonFinalTxsReject,
+		Networks:             []*NetworkConfig{},
+		MempoolAcceptance: &MempoolAcceptanceConfig{
+			Enabled: false,
+			Method:  "testmempoolaccept",
+		},
+		Receipts: &ReceiptsConfig{
+			Enabled:    false,
+			MinerIDKey: "",
+			Retention:  90 * 24 * time.Hour,
+		},
+		Canary: &CanaryConfig{
+			Enabled:           false,
+			URL:               "http://localhost:9090",
+			DerivationPath:    "0/0",
+			Interval:          10 * time.Minute,
+			MinedTimeout:      2 * time.Hour,
+			MiningFeeSatPerKb: 1,
+		},
+		Ingester: &IngesterConfig{
+			Enabled: false,
+			URL:     "http://localhost:9090",
+			Dir:     "",
+			S3: &IngesterS3Config{
+				Endpoint: "https://s3.amazonaws.com",
+				Region:   "us-east-1",
+			},
+			Interval:        30 * time.Second,
+			MinAge:          10 * time.Second,
+			BatchSize:       100,
+			DeleteProcessed: false,
+			WaitFor:         "",
+		},
+		Admin: &AdminConfig{
+			Enabled:       false,
+			ListenAddr:    "localhost:8034",
+			Tokens:        []*AdminTokenConfig{},
+			TaskRetention: 24 * time.Hour,
+		},
+		Federation: &FederationConfig{
+			Enabled:            false,
+			Upstreams:          []*FederationUpstreamConfig{},
+			Timeout:            10 * time.Second,
+			Workers:            4,
+			QueueSize:          1000,
+			AcknowledgementTTL: 24 * time.Hour,
+			Aggregation:        "none",
+			Quorum:             1,
+		},
 		DefaultPolicy: &bitcoin.Settings{
 			ExcessiveBlockSize:              2000000000,
 			BlockMaxSize:                    512000000,
@@ -199,7 +675,50 @@ func getCallbackerConfig() *CallbackerConfig {
 		PruneOlderThan:    14 * 24 * time.Hour,
 		PruneInterval:     24 * time.Hour,
 		Expiration:        24 * time.Hour,
+		SigningSecret:     "",
 		Db:                getDbConfig("callbacker"),
+		GrpcAuth:          getGrpcAuthConfig(),
+		SLAReports: &SLAReportsConfig{
+			Enabled:  false,
+			Interval: 15 * time.Minute,
+		},
+		PayloadTemplates: []*PayloadTemplateConfig{},
+		Jobs:             map[string]*JobConfig{},
+		TenantRateLimits: []*TenantRateLimitConfig{},
+	}
+}
+
+func getGrpcClientConfig() *GrpcClientConfig {
+	return &GrpcClientConfig{
+		Timeout: 30 * time.Second,
+		Retry: &GrpcRetryConfig{
+			MaxAttempts:    3,
+			InitialBackoff: 100 * time.Millisecond,
+			MaxBackoff:     time.Second,
+		},
+		CircuitBreaker: &GrpcCircuitBreakerConfig{
+			Enabled:          false,
+			FailureThreshold: 5,
+			OpenDuration:     10 * time.Second,
+		},
+		QueueSubmissions: false,
+	}
+}
+
+func getGrpcAuthConfig() *GrpcAuthConfig {
+	return &GrpcAuthConfig{
+		Token: "", // optional
+		TLS: &GrpcTLSConfig{
+			Enabled:  false,
+			CaFile:   "",
+			CertFile: "",
+			KeyFile:  "",
+			Client: &GrpcTLSClientConfig{
+				CaFile:   "",
+				CertFile: "",
+				KeyFile:  "",
+			},
+		},
 	}
 }
 
@@ -230,8 +749,29 @@ func getCacheConfig() *CacheConfig {
 
 func getDefaultTracingConfig() *TracingConfig {
 	return &TracingConfig{
-		DialAddr: "", // optional
-		Sample:   100,
-		Enabled:  false,
+		DialAddr:          "", // optional
+		Sample:            100,
+		Enabled:           false,
+		ParentBased:       false,
+		SamplingOverrides: []SamplingOverrideConfig{},
+		TailSampling: &TailSamplingConfig{
+			Enabled:     false,
+			MinDuration: time.Second,
+		},
+		Exporter: &TracingExporterConfig{
+			Protocol: "grpc",
+			Headers:  map[string]string{},
+			Timeout:  10 * time.Second,
+			TLS: &TracingTLSConfig{
+				Enabled: false,
+			},
+		},
+		Batch: &TracingBatchConfig{
+			MaxQueueSize:       2048,
+			MaxExportBatchSize: 512,
+			BatchTimeout:       5 * time.Second,
+			ExportTimeout:      30 * time.Second,
+		},
+		ResourceDetectors: []string{},
 	}
 }
@@ -0,0 +1,619 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ProfileDev        = "dev"
+	ProfileProduction = "production"
+	ProfileFederation = "federation"
+)
+
+var (
+	ErrUnknownProfile     = errors.New("unknown deployment profile")
+	ErrInvalidConfigValue = errors.New("invalid config value")
+	ErrInvalidConfigFile  = errors.New("invalid config file")
+	ErrConfigInitAborted  = errors.New("config init aborted")
+)
+
+// Profiles are the deployment profiles of generated config files with their description.
+var Profiles = map[string]string{
+	ProfileDev:        "single-node development, all services in one process",
+	ProfileProduction: "highly available production, services scaled independently and connected by NATS",
+	ProfileFederation: "federation proxy forwarding the accepted transactions to upstream ARC instances",
+}
+
+// InitOptions are the values of a generated config file which differ between deployments. Empty values are set to the
+// defaults of the profile.
+type InitOptions struct {
+	Profile string
+	Network string
+	// Peers are the addresses host:port of the p2p ports of the nodes
+	Peers           []string
+	DbHost          string
+	DbPort          int
+	DbUser          string
+	DbPassword      string
+	MessageQueueURL string
+	RedisAddr       string
+	// Upstreams are the URLs of the APIs of the upstream ARC instances of the federation profile
+	Upstreams []string
+}
+
+// comments describe the settings of a generated config file, the keys are the paths of the settings.
+var comments = map[string]string{
+	"logLevel":                           "level of the logs: DEBUG, INFO, WARN or ERROR",
+	"logFormat":                          "format of the logs, text or json",
+	"prometheus":                         "metrics served to be scraped by Prometheus",
+	"network":                            "bitcoin network: mainnet, testnet or regtest",
+	"messageQueue":                       "NATS message queue connecting the services",
+	"messageQueue.streaming.fileStorage": "if true, the JetStream streams are stored on disk and survive restarts of NATS",
+	"metamorph":                          "metamorph processes the submitted transactions and tracks their statuses",
+	"metamorph.db":                       "postgres database of metamorph",
+	"metamorph.bcnet.peers":              "nodes to which the transactions are announced",
+	"blocktx":                            "blocktx processes the blocks and publishes the mined transactions",
+	"blocktx.db":                         "postgres database of blocktx",
+	"blocktx.bcnet.peers":                "nodes from which the blocks are received",
+	"api":                                "public HTTP API",
+	"api.federation":                     "forwarding of the accepted transactions to upstream ARC instances",
+	"callbacker":                         "callbacker sends the status callbacks to the clients",
+	"callbacker.db":                      "postgres database of callbacker",
+	"cache":                              "cache store, redis is required if the API or metamorph run with several instances",
+	"allInOne":                           "if enabled, all services run in one process and are connected in-process instead of by NATS",
+}
+
+// Generate returns a complete config file for the deployment profile of the options. All settings are written with the
+// defaults of the profile, except those given by the options, and the file is validated against the config before it
+// is returned.
+func Generate(opts InitOptions) ([]byte, error) {
+	err := opts.setDefaults()
+	if err != nil {
+		return nil, err
+	}
+
+	err = opts.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := getDefaultArcConfig()
+	applyProfile(cfg, opts)
+
+	doc := &yaml.Node{
+		Kind:        yaml.DocumentNode,
+		HeadComment: fmt.Sprintf("ARC config generated by `arc config init` for the %s profile: %s.\nAll settings are listed with their defaults, see config/config.yaml and doc/README.md for their description.", opts.Profile, Profiles[opts.Profile]),
+		Content:     []*yaml.Node{encodeNode(reflect.ValueOf(cfg), "")},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	err = encoder.Encode(doc)
+	if err != nil {
+		return nil, err
+	}
+	err = encoder.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = Parse(buf.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Parse parses a config file. Settings which are not part of the config are rejected, so that misspelled settings are
+// not ignored silently.
+func Parse(data []byte) (*ArcConfig, error) {
+	v := viper.New()
+	v.SetConfigType("yaml")
+
+	err := v.ReadConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Join(ErrInvalidConfigFile, err)
+	}
+
+	cfg := &ArcConfig{}
+	err = v.Unmarshal(cfg, func(dc *mapstructure.DecoderConfig) {
+		dc.ErrorUnused = true
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInvalidConfigFile, err)
+	}
+
+	return cfg, nil
+}
+
+// Prompt asks for the options which are not set yet on out and reads the answers from in. The default of each option
+// is taken if the answer is empty, invalid answers are asked again.
+func Prompt(in io.Reader, out io.Writer, opts *InitOptions) error {
+	p := &prompter{scanner: bufio.NewScanner(in), out: out}
+
+	if opts.Profile == "" {
+		profiles := make([]string, 0, len(Profiles))
+		for name := range Profiles {
+			profiles = append(profiles, name)
+		}
+		sort.Strings(profiles)
+		for _, name := range profiles {
+			_, _ = fmt.Fprintf(out, "  %s: %s\n", name, Profiles[name])
+		}
+
+		answer, err := p.ask("Deployment profile", ProfileDev, validateProfile)
+		if err != nil {
+			return err
+		}
+		opts.Profile = answer
+	}
+
+	defaults := opts.profileDefaults()
+
+	var err error
+	if opts.Network == "" {
+		opts.Network, err = p.ask("Bitcoin network (mainnet, testnet, regtest)", defaults.Network, validateNetwork)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(opts.Peers) == 0 {
+		answer, err := p.ask("Addresses host:port of the nodes, comma separated", defaultPeer(opts.Network), validateList(validateHostPort))
+		if err != nil {
+			return err
+		}
+		opts.Peers = splitList(answer)
+	}
+
+	if opts.DbHost == "" {
+		opts.DbHost, err = p.ask("Host of the postgres database", defaults.DbHost, validateNotEmpty)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.DbPort == 0 {
+		answer, err := p.ask("Port of the postgres database", strconv.Itoa(defaults.DbPort), validatePort)
+		if err != nil {
+			return err
+		}
+		opts.DbPort, _ = strconv.Atoi(answer)
+	}
+
+	if opts.DbUser == "" {
+		opts.DbUser, err = p.ask("User of the postgres database", defaults.DbUser, validateNotEmpty)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.DbPassword == "" {
+		opts.DbPassword, err = p.ask("Password of the postgres database", defaults.DbPassword, validateNotEmpty)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.Profile == ProfileDev {
+		return nil
+	}
+
+	if opts.MessageQueueURL == "" {
+		opts.MessageQueueURL, err = p.ask("URL of NATS", defaults.MessageQueueURL, validateURL("nats", "tls"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.RedisAddr == "" {
+		opts.RedisAddr, err = p.ask("Address host:port of redis", defaults.RedisAddr, validateHostPort)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.Profile == ProfileFederation && len(opts.Upstreams) == 0 {
+		answer, err := p.ask("URLs of the upstream ARC instances, comma separated", "", validateList(validateURL("http", "https")))
+		if err != nil {
+			return err
+		}
+		opts.Upstreams = splitList(answer)
+	}
+
+	return nil
+}
+
+type prompter struct {
+	scanner *bufio.Scanner
+	out     io.Writer
+}
+
+func (p *prompter) ask(question string, defaultValue string, validate func(string) error) (string, error) {
+	for {
+		if defaultValue != "" {
+			_, _ = fmt.Fprintf(p.out, "%s [%s]: ", question, defaultValue)
+		} else {
+			_, _ = fmt.Fprintf(p.out, "%s: ", question)
+		}
+
+		if !p.scanner.Scan() {
+			return "", errors.Join(ErrConfigInitAborted, p.scanner.Err())
+		}
+
+		answer := strings.TrimSpace(p.scanner.Text())
+		if answer == "" {
+			answer = defaultValue
+		}
+
+		err := validate(answer)
+		if err == nil {
+			return answer, nil
+		}
+
+		_, _ = fmt.Fprintf(p.out, "  %v\n", err)
+	}
+}
+
+// profileDefaults returns the options of the profile which are used for the options which are not set.
+func (o *InitOptions) profileDefaults() InitOptions {
+	defaults := InitOptions{
+		Network:         "mainnet",
+		DbHost:          "localhost",
+		DbPort:          5432,
+		DbUser:          "arc",
+		DbPassword:      "arc",
+		MessageQueueURL: "nats://localhost:4222",
+		RedisAddr:       "localhost:6379",
+	}
+
+	if o.Profile == ProfileDev {
+		defaults.Network = "regtest"
+	}
+
+	return defaults
+}
+
+func (o *InitOptions) setDefaults() error {
+	err := validateProfile(o.Profile)
+	if err != nil {
+		return err
+	}
+
+	defaults := o.profileDefaults()
+	if o.Network == "" {
+		o.Network = defaults.Network
+	}
+	if len(o.Peers) == 0 {
+		o.Peers = []string{defaultPeer(o.Network)}
+	}
+	if o.DbHost == "" {
+		o.DbHost = defaults.DbHost
+	}
+	if o.DbPort == 0 {
+		o.DbPort = defaults.DbPort
+	}
+	if o.DbUser == "" {
+		o.DbUser = defaults.DbUser
+	}
+	if o.DbPassword == "" {
+		o.DbPassword = defaults.DbPassword
+	}
+	if o.MessageQueueURL == "" {
+		o.MessageQueueURL = defaults.MessageQueueURL
+	}
+	if o.RedisAddr == "" {
+		o.RedisAddr = defaults.RedisAddr
+	}
+
+	return nil
+}
+
+func (o *InitOptions) validate() error {
+	var errs []error
+
+	if err := validateNetwork(o.Network); err != nil {
+		errs = append(errs, err)
+	}
+	for _, peer := range o.Peers {
+		if err := validateHostPort(peer); err != nil {
+			errs = append(errs, fmt.Errorf("peer: %w", err))
+		}
+	}
+	if err := validatePort(strconv.Itoa(o.DbPort)); err != nil {
+		errs = append(errs, fmt.Errorf("database: %w", err))
+	}
+	if err := validateURL("nats", "tls")(o.MessageQueueURL); err != nil {
+		errs = append(errs, fmt.Errorf("message queue: %w", err))
+	}
+	if err := validateHostPort(o.RedisAddr); err != nil {
+		errs = append(errs, fmt.Errorf("redis: %w", err))
+	}
+	for _, upstream := range o.Upstreams {
+		if err := validateURL("http", "https")(upstream); err != nil {
+			errs = append(errs, fmt.Errorf("upstream: %w", err))
+		}
+	}
+	if o.Profile == ProfileFederation && len(o.Upstreams) == 0 {
+		errs = append(errs, errors.Join(ErrInvalidConfigValue, errors.New("the federation profile requires at least one upstream")))
+	}
+
+	return errors.Join(errs...)
+}
+
+// applyProfile sets the settings of the profile and the options on the default config.
+func applyProfile(cfg *ArcConfig, opts InitOptions) {
+	cfg.Network = opts.Network
+	cfg.MessageQueue.URL = opts.MessageQueueURL
+
+	peers := make([]*PeerConfig, 0, len(opts.Peers))
+	for _, peer := range opts.Peers {
+		host, port, _ := net.SplitHostPort(peer)
+		p2pPort, _ := strconv.Atoi(port)
+		peers = append(peers, &PeerConfig{Host: host, Port: &PeerPortConfig{P2P: p2pPort}})
+	}
+
+	cfg.Metamorph.BlockchainNetwork.Network = opts.Network
+	cfg.Metamorph.BlockchainNetwork.Peers = peers
+	cfg.Blocktx.BlockchainNetwork.Network = opts.Network
+	cfg.Blocktx.BlockchainNetwork.Peers = peers
+
+	for _, db := range []*DbConfig{cfg.Metamorph.Db, cfg.Blocktx.Db, cfg.Callbacker.Db} {
+		db.Postgres.Host = opts.DbHost
+		db.Postgres.Port = opts.DbPort
+		db.Postgres.User = opts.DbUser
+		db.Postgres.Password = opts.DbPassword
+	}
+
+	switch opts.Profile {
+	case ProfileDev:
+		cfg.LogLevel = "DEBUG"
+		cfg.LogFormat = "text"
+		cfg.AllInOne.Enabled = true
+	case ProfileProduction, ProfileFederation:
+		cfg.LogLevel = "INFO"
+		cfg.LogFormat = "json"
+		cfg.Prometheus.Enabled = true
+		cfg.MessageQueue.Streaming.Enabled = true
+		cfg.MessageQueue.Streaming.FileStorage = true
+		cfg.Metamorph.MonitorPeers = true
+		cfg.Blocktx.MonitorPeers = true
+		cfg.Cache.Engine = Redis
+		cfg.Cache.Redis.Addr = opts.RedisAddr
+		for _, db := range []*DbConfig{cfg.Metamorph.Db, cfg.Blocktx.Db, cfg.Callbacker.Db} {
+			db.Postgres.SslMode = "require"
+		}
+	}
+
+	if opts.Profile == ProfileFederation {
+		cfg.API.Federation.Enabled = true
+		cfg.API.Federation.Upstreams = make([]*FederationUpstreamConfig, 0, len(opts.Upstreams))
+		for _, upstream := range opts.Upstreams {
+			u, _ := url.Parse(upstream)
+			cfg.API.Federation.Upstreams = append(cfg.API.Federation.Upstreams, &FederationUpstreamConfig{Name: u.Hostname(), URL: upstream})
+		}
+	}
+}
+
+var configPkgPath = reflect.TypeOf(ArcConfig{}).PkgPath()
+
+// encodeNode encodes the value of the setting at the path as YAML node with the names of the settings in the
+// mapstructure tags. Fields of the config without tag are set by Load and are not part of the file, fields of structs
+// of other packages are matched by their lower case name.
+func encodeNode(v reflect.Value, path string) *yaml.Node {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: formatDuration(time.Duration(v.Int()))}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return encodeNode(v.Elem(), path)
+	case reflect.Struct:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name := strings.Split(field.Tag.Get("mapstructure"), ",")[0]
+			if name == "-" || (name == "" && v.Type().PkgPath() == configPkgPath) {
+				continue
+			}
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+
+			node.Content = appendSetting(node.Content, name, encodeNode(v.Field(i), joinPath(path, name)), joinPath(path, name))
+		}
+		if len(node.Content) == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		return node
+	case reflect.Map:
+		node := &yaml.Node{Kind: yaml.MappingNode}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			node.Content = appendSetting(node.Content, key.String(), encodeNode(v.MapIndex(key), joinPath(path, key.String())), "")
+		}
+		if len(node.Content) == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		return node
+	case reflect.Slice, reflect.Array:
+		node := &yaml.Node{Kind: yaml.SequenceNode}
+		for i := range v.Len() {
+			if item := encodeNode(v.Index(i), path); item != nil {
+				node.Content = append(node.Content, item)
+			}
+		}
+		if len(node.Content) == 0 {
+			node.Style = yaml.FlowStyle
+		}
+		return node
+	case reflect.Bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v.Bool())}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatInt(v.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.FormatUint(v.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!float", Value: strconv.FormatFloat(v.Float(), 'g', -1, 64)}
+	case reflect.String:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v.String()}
+	default:
+		return nil
+	}
+}
+
+func appendSetting(content []*yaml.Node, name string, value *yaml.Node, path string) []*yaml.Node {
+	if value == nil {
+		return content
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: name, HeadComment: comments[path]}
+	return append(content, key, value)
+}
+
+// formatDuration formats the duration without trailing zero units, e.g. 24h instead of 24h0m0s.
+func formatDuration(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
+
+func joinPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func defaultPeer(network string) string {
+	switch network {
+	case "mainnet":
+		return "localhost:8333"
+	case "testnet":
+		return "localhost:18333"
+	default:
+		return "localhost:18444"
+	}
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func validateProfile(profile string) error {
+	if _, found := Profiles[profile]; !found {
+		return errors.Join(ErrUnknownProfile, fmt.Errorf("profile: %s", profile))
+	}
+
+	return nil
+}
+
+func validateNetwork(network string) error {
+	_, err := GetNetwork(network)
+	if err != nil {
+		return errors.Join(ErrInvalidConfigValue, err)
+	}
+
+	return nil
+}
+
+func validateNotEmpty(s string) error {
+	if s == "" {
+		return errors.Join(ErrInvalidConfigValue, errors.New("value must not be empty"))
+	}
+
+	return nil
+}
+
+func validatePort(s string) error {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return errors.Join(ErrInvalidConfigValue, fmt.Errorf("invalid port: %s", s))
+	}
+
+	return nil
+}
+
+func validateHostPort(s string) error {
+	host, port, err := net.SplitHostPort(s)
+	if err != nil || host == "" {
+		return errors.Join(ErrInvalidConfigValue, fmt.Errorf("invalid address host:port: %s", s))
+	}
+
+	return validatePort(port)
+}
+
+func validateURL(schemes ...string) func(string) error {
+	return func(s string) error {
+		u, err := url.Parse(s)
+		if err != nil || u.Host == "" {
+			return errors.Join(ErrInvalidConfigValue, fmt.Errorf("invalid URL: %s", s))
+		}
+
+		for _, scheme := range schemes {
+			if u.Scheme == scheme {
+				return nil
+			}
+		}
+
+		return errors.Join(ErrInvalidConfigValue, fmt.Errorf("scheme of URL %s must be one of %s", s, strings.Join(schemes, ", ")))
+	}
+}
+
+func validateList(validate func(string) error) func(string) error {
+	return func(s string) error {
+		items := splitList(s)
+		if len(items) == 0 {
+			return errors.Join(ErrInvalidConfigValue, errors.New("at least one value is required"))
+		}
+
+		for _, item := range items {
+			err := validate(item)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,158 @@
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	tt := []struct {
+		name string
+		opts InitOptions
+
+		expectedErr error
+		check       func(t *testing.T, cfg *ArcConfig)
+	}{
+		{
+			name: "dev profile",
+			opts: InitOptions{Profile: ProfileDev},
+
+			check: func(t *testing.T, cfg *ArcConfig) {
+				require.Equal(t, "regtest", cfg.Network)
+				require.True(t, cfg.AllInOne.Enabled)
+				require.Equal(t, "localhost", cfg.Blocktx.BlockchainNetwork.Peers[0].Host)
+				require.Equal(t, 18444, cfg.Blocktx.BlockchainNetwork.Peers[0].Port.P2P)
+				require.Equal(t, InMemory, cfg.Cache.Engine)
+			},
+		},
+		{
+			name: "production profile",
+			opts: InitOptions{
+				Profile:    ProfileProduction,
+				Peers:      []string{"node-1:8333", "node-2:8333"},
+				DbHost:     "postgres",
+				DbPassword: "secret",
+				RedisAddr:  "redis:6379",
+			},
+
+			check: func(t *testing.T, cfg *ArcConfig) {
+				require.Equal(t, "mainnet", cfg.Network)
+				require.Equal(t, "json", cfg.LogFormat)
+				require.False(t, cfg.AllInOne.Enabled)
+				require.Len(t, cfg.Metamorph.BlockchainNetwork.Peers, 2)
+				require.Equal(t, "node-2", cfg.Metamorph.BlockchainNetwork.Peers[1].Host)
+				require.Equal(t, "postgres", cfg.Callbacker.Db.Postgres.Host)
+				require.Equal(t, "secret", cfg.Blocktx.Db.Postgres.Password)
+				require.Equal(t, "require", cfg.Metamorph.Db.Postgres.SslMode)
+				require.Equal(t, Redis, cfg.Cache.Engine)
+				require.Equal(t, "redis:6379", cfg.Cache.Redis.Addr)
+				require.Equal(t, getDefaultArcConfig().Metamorph.ReAnnounceSeen, cfg.Metamorph.ReAnnounceSeen)
+				require.Equal(t, getDefaultArcConfig().API.DefaultPolicy, cfg.API.DefaultPolicy)
+			},
+		},
+		{
+			name: "federation profile",
+			opts: InitOptions{Profile: ProfileFederation, Upstreams: []string{"https://arc.example.com"}},
+
+			check: func(t *testing.T, cfg *ArcConfig) {
+				require.True(t, cfg.API.Federation.Enabled)
+				require.Len(t, cfg.API.Federation.Upstreams, 1)
+				require.Equal(t, "arc.example.com", cfg.API.Federation.Upstreams[0].Name)
+				require.Equal(t, "https://arc.example.com", cfg.API.Federation.Upstreams[0].URL)
+			},
+		},
+		{
+			name: "federation profile without upstreams",
+			opts: InitOptions{Profile: ProfileFederation},
+
+			expectedErr: ErrInvalidConfigValue,
+		},
+		{
+			name: "unknown profile",
+			opts: InitOptions{Profile: "staging"},
+
+			expectedErr: ErrUnknownProfile,
+		},
+		{
+			name: "invalid peer",
+			opts: InitOptions{Profile: ProfileDev, Peers: []string{"localhost"}},
+
+			expectedErr: ErrInvalidConfigValue,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := Generate(tc.opts)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, string(actual), "# bitcoin network: mainnet, testnet or regtest")
+
+			cfg, err := Parse(actual)
+			require.NoError(t, err)
+			tc.check(t, cfg)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	// when
+	_, err := Parse([]byte("network: mainnet\nmetamorph:\n  listenAdr: localhost:8001\n"))
+
+	// then
+	require.ErrorIs(t, err, ErrInvalidConfigFile)
+	require.ErrorContains(t, err, "listenadr")
+}
+
+func TestPrompt(t *testing.T) {
+	// given
+	answers := []string{
+		"production",
+		"",            // network - default mainnet
+		"node-1",      // peers - missing port
+		"node-1:8333", // peers
+		"postgres",    // database host
+		"",            // database port - default
+		"",            // database user - default
+		"secret",      // database password
+		"http://nats", // message queue - wrong scheme
+		"nats://nats:4222",
+		"", // redis - default
+	}
+	var out bytes.Buffer
+	opts := InitOptions{}
+
+	// when
+	err := Prompt(strings.NewReader(strings.Join(answers, "\n")+"\n"), &out, &opts)
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, InitOptions{
+		Profile:         ProfileProduction,
+		Network:         "mainnet",
+		Peers:           []string{"node-1:8333"},
+		DbHost:          "postgres",
+		DbPort:          5432,
+		DbUser:          "arc",
+		DbPassword:      "secret",
+		MessageQueueURL: "nats://nats:4222",
+		RedisAddr:       "localhost:6379",
+	}, opts)
+	require.Contains(t, out.String(), "invalid address host:port: node-1")
+	require.Contains(t, out.String(), "scheme of URL http://nats must be one of nats, tls")
+
+	// when the input ends before all values are given
+	err = Prompt(strings.NewReader("federation\n"), &out, &InitOptions{})
+
+	// then
+	require.ErrorIs(t, err, ErrConfigInitAborted)
+}
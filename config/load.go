@@ -14,6 +14,7 @@ import (
 var (
 	ErrConfigFailedToSetDefaults = errors.New("error occurred while setting defaults")
 	ErrConfigPath                = errors.New("config path error")
+	ErrConfigInvalid             = errors.New("invalid config")
 )
 
 func Load(configFileDirs ...string) (*ArcConfig, error) {
@@ -51,9 +52,24 @@ func Load(configFileDirs ...string) (*ArcConfig, error) {
 		}
 	}
 
+	err = arcConfig.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	return arcConfig, nil
 }
 
+// Validate returns an error if settings which depend on each other are inconsistent.
+func (c *ArcConfig) Validate() error {
+	// without the status updates published by metamorph the cached statuses are served until they expire
+	if c.API != nil && c.API.StatusCacheTTL > 0 && (c.Metamorph == nil || !c.Metamorph.PublishStatusUpdates) {
+		return errors.Join(ErrConfigInvalid, errors.New("api.statusCacheTTL requires metamorph.publishStatusUpdates"))
+	}
+
+	return nil
+}
+
 func setDefaults(defaultConfig *ArcConfig) error {
 	defaultsMap := make(map[string]interface{})
 
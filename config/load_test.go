@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -40,3 +41,43 @@ func Test_Load(t *testing.T) {
 		assert.Equal(t, "http://tracing:1234", actualConfig.Tracing.DialAddr)
 	})
 }
+
+func TestArcConfig_Validate(t *testing.T) {
+	tt := []struct {
+		name                 string
+		statusCacheTTL       time.Duration
+		publishStatusUpdates bool
+
+		expectedError error
+	}{
+		{
+			name: "status cache disabled",
+		},
+		{
+			name:                 "status cache with status updates",
+			statusCacheTTL:       time.Second,
+			publishStatusUpdates: true,
+		},
+		{
+			name:           "status cache without status updates",
+			statusCacheTTL: time.Second,
+
+			expectedError: ErrConfigInvalid,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := getDefaultArcConfig()
+			sut.API.StatusCacheTTL = tc.statusCacheTTL
+			sut.Metamorph.PublishStatusUpdates = tc.publishStatusUpdates
+
+			// when
+			err := sut.Validate()
+
+			// then
+			require.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
@@ -3,7 +3,10 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/libsv/go-p2p/wire"
 	"github.com/spf13/viper"
@@ -46,7 +49,7 @@ func (p *PeerConfig) GetZMQUrl() (*url.URL, error) {
 		return nil, nil
 	}
 
-	zmqURLString := fmt.Sprintf("zmq://%s:%d", p.Host, p.Port.ZMQ)
+	zmqURLString := fmt.Sprintf("zmq://%s", p.hostPort(p.Port.ZMQ))
 
 	return url.Parse(zmqURLString)
 }
@@ -56,5 +59,11 @@ func (p *PeerConfig) GetP2PUrl() (string, error) {
 		return "", errors.Join(ErrPortP2PNotSet, fmt.Errorf("peer host %s", p.Host))
 	}
 
-	return fmt.Sprintf("%s:%d", p.Host, p.Port.P2P), nil
+	return p.hostPort(p.Port.P2P), nil
+}
+
+// hostPort joins the host and the port. IPv6 hosts are enclosed in square brackets, they can be configured with or
+// without brackets.
+func (p *PeerConfig) hostPort(port int) string {
+	return net.JoinHostPort(strings.Trim(p.Host, "[]"), strconv.Itoa(port))
 }
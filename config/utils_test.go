@@ -93,6 +93,33 @@ func Test_GetZMQUrl_GetP2PUrl(t *testing.T) {
 			expectedP2PError: nil,
 			expectedZMQError: nil,
 		},
+		{
+			name: "IPv6 host",
+			peerConfig: &PeerConfig{
+				Host: "2001:db8::1",
+				Port: &PeerPortConfig{
+					P2P: 18332,
+					ZMQ: 28332,
+				},
+			},
+			expectedP2PUrl:   "[2001:db8::1]:18332",
+			expectedZMQUrl:   "zmq://[2001:db8::1]:28332",
+			expectedP2PError: nil,
+			expectedZMQError: nil,
+		},
+		{
+			name: "IPv6 host in brackets",
+			peerConfig: &PeerConfig{
+				Host: "[2001:db8::1]",
+				Port: &PeerPortConfig{
+					P2P: 18332,
+				},
+			},
+			expectedZmqIsNil: true,
+			expectedP2PUrl:   "[2001:db8::1]:18332",
+			expectedP2PError: nil,
+			expectedZMQError: nil,
+		},
 		{
 			name: "p2p port missing",
 			peerConfig: &PeerConfig{
@@ -14,8 +14,6 @@ import (
 	echomiddleware "github.com/labstack/echo/v4/middleware"
 	middleware "github.com/oapi-codegen/echo-middleware"
 
-	goscript "github.com/bitcoin-sv/bdk/module/gobdk/script"
-
 	"github.com/bitcoin-sv/arc/config"
 	apiHandler "github.com/bitcoin-sv/arc/internal/api/handler"
 	apimocks "github.com/bitcoin-sv/arc/internal/api/mocks"
@@ -25,6 +23,7 @@ import (
 	"github.com/bitcoin-sv/arc/internal/metamorph"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/node_client"
+	"github.com/bitcoin-sv/arc/internal/script_verifier"
 	tx_finder "github.com/bitcoin-sv/arc/internal/tx_finder"
 	beefValidator "github.com/bitcoin-sv/arc/internal/validator/beef"
 	defaultValidator "github.com/bitcoin-sv/arc/internal/validator/default"
@@ -87,7 +86,7 @@ func main() {
 	}
 
 	// add a single metamorph, with the BlockTx client we want to use
-	conn, err := grpc_utils.DialGRPC("localhost:8011", "", arcConfig.GrpcMessageSize, nil)
+	conn, err := grpc_utils.DialGRPC("localhost:8011", "", arcConfig.GrpcMessageSize, nil, nil, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -95,7 +94,7 @@ func main() {
 	metamorphClient := metamorph.NewClient(metamorph_api.NewMetaMorphAPIClient(conn))
 
 	// add blocktx as MerkleRootsVerifier
-	btcConn, err := grpc_utils.DialGRPC("localhost:8011", "", arcConfig.GrpcMessageSize, nil)
+	btcConn, err := grpc_utils.DialGRPC("localhost:8011", "", arcConfig.GrpcMessageSize, nil, nil, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -114,7 +113,7 @@ func main() {
 	default:
 	}
 
-	se := goscript.NewScriptEngine(network)
+	se := script_verifier.New(network)
 
 	pc := arcConfig.PeerRPC
 	nc, err := rpc_client.NewRPCClient(pc.Host, pc.Port, pc.User, pc.Password)
@@ -78,6 +78,14 @@ func (c *CustomHandler) GETHealth(ctx echo.Context) error {
 	return c.h.GETHealth(ctx)
 }
 
+func (c *CustomHandler) GETBlocks(ctx echo.Context, params api.GETBlocksParams) error {
+	return c.h.GETBlocks(ctx, params)
+}
+
+func (c *CustomHandler) GETStats(ctx echo.Context) error {
+	return c.h.GETStats(ctx)
+}
+
 func (c *CustomHandler) POSTTransaction(ctx echo.Context, params api.POSTTransactionParams) error {
 	return c.h.POSTTransaction(ctx, params)
 }
@@ -86,6 +94,34 @@ func (c *CustomHandler) GETTransactionStatus(ctx echo.Context, txid string) erro
 	return c.h.GETTransactionStatus(ctx, txid)
 }
 
+func (c *CustomHandler) POSTTransactionResubmit(ctx echo.Context, txid string) error {
+	return c.h.POSTTransactionResubmit(ctx, txid)
+}
+
+func (c *CustomHandler) DELETETransaction(ctx echo.Context, txid string) error {
+	return c.h.DELETETransaction(ctx, txid)
+}
+
+func (c *CustomHandler) DELETETransactionSchedule(ctx echo.Context, txid string) error {
+	return c.h.DELETETransactionSchedule(ctx, txid)
+}
+
+func (c *CustomHandler) GETTransactionGraph(ctx echo.Context, txid string, params api.GETTransactionGraphParams) error {
+	return c.h.GETTransactionGraph(ctx, txid, params)
+}
+
+func (c *CustomHandler) GETTransactionUpstreams(ctx echo.Context, txid string) error {
+	return c.h.GETTransactionUpstreams(ctx, txid)
+}
+
+func (c *CustomHandler) GETTransactionReceipt(ctx echo.Context, txid string) error {
+	return c.h.GETTransactionReceipt(ctx, txid)
+}
+
+func (c *CustomHandler) GETOutpointSpent(ctx echo.Context, txid string, vout int) error {
+	return c.h.GETOutpointSpent(ctx, txid, vout)
+}
+
 func (c *CustomHandler) POSTTransactions(ctx echo.Context, params api.POSTTransactionsParams) error {
 	return c.h.POSTTransactions(ctx, params)
 }
@@ -0,0 +1,4 @@
+package admin
+
+//go:generate moq -pkg mocks -out ./mocks/policy_handler_mock.go . PolicyHandler
+//go:generate moq -pkg mocks -out ./mocks/abuse_scorer_mock.go . AbuseScorer
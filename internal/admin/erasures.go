@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+// EraseClientData erases the client data of metamorph with the name of the calling token recorded in the audit trail,
+// and then the callbacks of the callbacker, so that no callbacks of the erased transactions are queued afterwards. An
+// erasure which failed in the callbacker can be repeated.
+func (s *Server) EraseClientData(ctx context.Context, req *admin_api.EraseClientDataRequest) (*admin_api.Erasure, error) {
+	erasure, err := s.metamorph.EraseClientData(ctx, &metamorph_api.EraseClientDataRequest{
+		Tenant:   req.GetTenant(),
+		Txids:    req.GetTxids(),
+		ErasedBy: tokenFromContext(ctx).Name,
+		Reason:   req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := toErasure(erasure)
+
+	if s.callbacker != nil {
+		erased, err := s.callbacker.EraseCallbacks(ctx, &callbacker_api.EraseCallbacksRequest{
+			Tenant: req.GetTenant(),
+			Txids:  req.GetTxids(),
+		})
+		if err != nil {
+			s.logger.ErrorContext(ctx, "Failed to erase callbacks", slog.Int64("erasure", erasure.GetId()), slog.String("err", err.Error()))
+			return nil, err
+		}
+		result.Callbacks = erased.GetCallbacks()
+	}
+
+	s.logger.InfoContext(ctx, "Erased client data", slog.Int64("erasure", result.GetId()), slog.String("tenant", result.GetTenant()),
+		slog.Int64("transactions", result.GetTransactions()), slog.Int64("callbacks", result.GetCallbacks()))
+
+	return result, nil
+}
+
+func (s *Server) ListErasures(ctx context.Context, req *admin_api.ListErasuresRequest) (*admin_api.Erasures, error) {
+	erasures, err := s.metamorph.ListErasures(ctx, &metamorph_api.ListErasuresRequest{Limit: req.GetLimit()})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &admin_api.Erasures{Erasures: make([]*admin_api.Erasure, 0, len(erasures.GetErasures()))}
+	for _, erasure := range erasures.GetErasures() {
+		result.Erasures = append(result.Erasures, toErasure(erasure))
+	}
+
+	return result, nil
+}
+
+func toErasure(erasure *metamorph_api.Erasure) *admin_api.Erasure {
+	return &admin_api.Erasure{
+		Id:           erasure.GetId(),
+		Tenant:       erasure.GetTenant(),
+		Txids:        erasure.GetTxids(),
+		ErasedBy:     erasure.GetErasedBy(),
+		Reason:       erasure.GetReason(),
+		ErasedAt:     erasure.GetErasedAt(),
+		Transactions: erasure.GetTransactions(),
+	}
+}
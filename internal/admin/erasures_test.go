@@ -0,0 +1,126 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	cbcMocks "github.com/bitcoin-sv/arc/internal/callbacker/mocks"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+)
+
+func TestServer_EraseClientData(t *testing.T) {
+	erasedAt := timestamppb.New(time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC))
+
+	tt := []struct {
+		name          string
+		callbackerErr error
+
+		expectedCallbacks int64
+		expectedErr       bool
+	}{
+		{
+			name: "erased",
+
+			expectedCallbacks: 5,
+		},
+		{
+			name:          "callbacker failed",
+			callbackerErr: errors.New("connection refused"),
+
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+				EraseClientDataFunc: func(_ context.Context, in *metamorph_api.EraseClientDataRequest, _ ...grpc.CallOption) (*metamorph_api.Erasure, error) {
+					return &metamorph_api.Erasure{Id: 7, Tenant: in.GetTenant(), Txids: in.GetTxids(), ErasedBy: in.GetErasedBy(), Reason: in.GetReason(), ErasedAt: erasedAt, Transactions: 3}, nil
+				},
+			}
+			callbackerClient := &cbcMocks.CallbackerAPIClientMock{
+				EraseCallbacksFunc: func(_ context.Context, _ *callbacker_api.EraseCallbacksRequest, _ ...grpc.CallOption) (*callbacker_api.EraseCallbacksResponse, error) {
+					return &callbacker_api.EraseCallbacksResponse{Callbacks: 5}, tc.callbackerErr
+				},
+			}
+
+			sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_erase_test"}, admin.WithCallbacker(callbackerClient))
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.EraseClientData(context.Background(), &admin_api.EraseClientDataRequest{Tenant: "exchange-a", Txids: []string{"tx-1"}, Reason: "request #42"})
+
+			// then
+			require.Len(t, metamorphClient.EraseClientDataCalls(), 1)
+			require.Len(t, callbackerClient.EraseCallbacksCalls(), 1)
+			require.Equal(t, "exchange-a", callbackerClient.EraseCallbacksCalls()[0].In.GetTenant())
+			require.Equal(t, []string{"tx-1"}, callbackerClient.EraseCallbacksCalls()[0].In.GetTxids())
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(7), actual.GetId())
+			require.Equal(t, int64(3), actual.GetTransactions())
+			require.Equal(t, tc.expectedCallbacks, actual.GetCallbacks())
+			require.Equal(t, "request #42", actual.GetReason())
+		})
+	}
+}
+
+func TestServer_EraseClientData_Authorization(t *testing.T) {
+	// given
+	const address = "localhost:8096"
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		EraseClientDataFunc: func(_ context.Context, in *metamorph_api.EraseClientDataRequest, _ ...grpc.CallOption) (*metamorph_api.Erasure, error) {
+			return &metamorph_api.Erasure{Id: 1, ErasedBy: in.GetErasedBy()}, nil
+		},
+		ListErasuresFunc: func(_ context.Context, _ *metamorph_api.ListErasuresRequest, _ ...grpc.CallOption) (*metamorph_api.Erasures, error) {
+			return &metamorph_api.Erasures{Erasures: []*metamorph_api.Erasure{{Id: 1, ErasedBy: "admin"}}}, nil
+		},
+	}
+
+	server, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{MaxMsgSize: 1000, Name: "admin_erase_auth_test"})
+	require.NoError(t, err)
+	defer server.GracefulStop()
+
+	err = server.ListenAndServe(address)
+	require.NoError(t, err)
+
+	dial := func(token string) admin_api.AdminAPIClient {
+		conn, dialErr := grpc_utils.DialGRPC(address, "", 1000, nil, &config.GrpcAuthConfig{Token: token}, nil)
+		require.NoError(t, dialErr)
+		t.Cleanup(func() { _ = conn.Close() })
+		return admin_api.NewAdminAPIClient(conn)
+	}
+
+	// when
+	_, operatorErr := dial("operator-secret").EraseClientData(context.Background(), &admin_api.EraseClientDataRequest{Tenant: "exchange-a", Reason: "request #42"})
+	erasure, adminErr := dial("admin-secret").EraseClientData(context.Background(), &admin_api.EraseClientDataRequest{Tenant: "exchange-a", Reason: "request #42"})
+	erasures, listErr := dial("viewer-secret").ListErasures(context.Background(), &admin_api.ListErasuresRequest{})
+
+	// then
+	require.Equal(t, codes.PermissionDenied, status.Code(operatorErr))
+	require.NoError(t, adminErr)
+	require.Equal(t, "admin", erasure.GetErasedBy())
+	require.Len(t, metamorphClient.EraseClientDataCalls(), 1)
+	require.NoError(t, listErr)
+	require.Len(t, erasures.GetErasures(), 1)
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"log/slog"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export"
+)
+
+// ExportPath is the path of the endpoint of the bulk export of transactions
+const ExportPath = PathPrefix + "export"
+
+// RegisterExportHandlers registers the HTTP endpoint streaming the transactions selected by the query parameters as CSV
+// or newline-delimited JSON, see bulk_export.Exporter. As the export contains the transactions of every tenant, it
+// requires the role admin and every export is written to the audit log with its query.
+func RegisterExportHandlers(e *echo.Echo, logger *slog.Logger, exporter *bulk_export.Exporter, tokens []Token) {
+	auth := newAuthorizer(logger, tokens)
+
+	e.GET(ExportPath, auth.httpRole(RoleAdmin, func(c echo.Context) error {
+		token := tokenFromContext(c.Request().Context())
+		auth.logger.InfoContext(c.Request().Context(), "Admin action", slog.String("method", c.Request().Method+" "+c.Request().URL.Path),
+			slog.String("token", token.Name), slog.String("role", token.Role.String()), slog.String("request", c.Request().URL.RawQuery))
+
+		exporter.ServeHTTP(c.Response(), c.Request())
+
+		return nil
+	}))
+}
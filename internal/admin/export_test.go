@@ -0,0 +1,108 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	metamorphMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestRegisterExportHandlers(t *testing.T) {
+	// given
+	metamorphClient := &metamorphMocks.MetaMorphAPIClientMock{
+		GetExportFunc: func(_ context.Context, req *metamorph_api.ExportRequest, _ ...grpc.CallOption) (*metamorph_api.ExportedTransactions, error) {
+			if req.GetTenant() == "unavailable" {
+				return nil, errors.New("metamorph unavailable")
+			}
+
+			return &metamorph_api.ExportedTransactions{Transactions: []*metamorph_api.ExportedTransaction{{
+				Hash:     testdata.TX1Hash[:],
+				Status:   metamorph_api.Status_MINED,
+				StoredAt: timestamppb.New(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)),
+				Tenant:   req.GetTenant(),
+			}}}, nil
+		},
+	}
+
+	e := echo.New()
+	exporter := bulk_export.New(slog.Default(), bulk_export.NewClientStore(metamorphClient))
+	admin.RegisterExportHandlers(e, slog.Default(), exporter, testTokens)
+
+	tt := []struct {
+		name  string
+		query string
+		token string
+
+		expectedCode int
+		expectedBody string
+	}{
+		{
+			name:  "without token",
+			query: "?tenant=exchange-a",
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:  "operator token",
+			query: "?tenant=exchange-a",
+			token: "operator-secret",
+
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:  "export",
+			query: "?from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&tenant=exchange-a&format=csv",
+			token: "admin-secret",
+
+			expectedCode: http.StatusOK,
+			expectedBody: testdata.TX1Hash.String() + ",MINED,2026-01-01T12:00:00Z",
+		},
+		{
+			name:  "invalid query",
+			query: "?format=xml",
+			token: "admin-secret",
+
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "metamorph unavailable",
+			query: "?tenant=unavailable",
+			token: "admin-secret",
+
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, admin.ExportPath+tc.query, nil)
+			if tc.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			e.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedBody != "" {
+				require.Contains(t, rec.Body.String(), tc.expectedBody)
+			}
+		})
+	}
+}
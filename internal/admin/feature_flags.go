@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/feature"
+)
+
+var ErrFeatureFlagsDisabled = errors.New("feature flags not enabled")
+
+// GetFeatureFlags returns the feature flags of the process running the admin service.
+func (s *Server) GetFeatureFlags(_ context.Context, _ *emptypb.Empty) (*admin_api.FeatureFlags, error) {
+	if s.featureFlags == nil {
+		return nil, ErrFeatureFlagsDisabled
+	}
+
+	return toFeatureFlags(s.featureFlags), nil
+}
+
+// SetFeatureFlag overrides the configured value of a feature flag until it is reset. If the flags share a store, the
+// override applies to all instances, otherwise only the flags of the components running in the process of the admin
+// service can be overridden.
+func (s *Server) SetFeatureFlag(ctx context.Context, req *admin_api.FeatureFlagRequest) (*admin_api.FeatureFlags, error) {
+	if s.featureFlags == nil {
+		return nil, ErrFeatureFlagsDisabled
+	}
+
+	err := s.featureFlags.Set(feature.Flag(req.GetFlag()), req.GetEnabled())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Feature flag set", slog.String("flag", req.GetFlag()), slog.Bool("enabled", req.GetEnabled()))
+
+	return toFeatureFlags(s.featureFlags), nil
+}
+
+// ResetFeatureFlag removes the override of a feature flag, so that it has its configured value again.
+func (s *Server) ResetFeatureFlag(ctx context.Context, req *admin_api.FeatureFlagRequest) (*admin_api.FeatureFlags, error) {
+	if s.featureFlags == nil {
+		return nil, ErrFeatureFlagsDisabled
+	}
+
+	err := s.featureFlags.Reset(feature.Flag(req.GetFlag()))
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Feature flag reset", slog.String("flag", req.GetFlag()))
+
+	return toFeatureFlags(s.featureFlags), nil
+}
+
+func toFeatureFlags(flags *feature.Flags) *admin_api.FeatureFlags {
+	states := flags.States()
+
+	result := &admin_api.FeatureFlags{Flags: make([]*admin_api.FeatureFlag, 0, len(states))}
+	for _, state := range states {
+		result.Flags = append(result.Flags, &admin_api.FeatureFlag{
+			Flag:       string(state.Flag),
+			Enabled:    state.Enabled,
+			Configured: state.Configured,
+			Overridden: state.Overridden,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,101 @@
+package admin_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+)
+
+func TestServer_FeatureFlags(t *testing.T) {
+	t.Run("set, reset and get feature flags", func(t *testing.T) {
+		// given
+		flags, err := feature.New(slog.Default(), map[string]bool{string(feature.CumulativeFees): false})
+		require.NoError(t, err)
+
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_feature_flags_test"}, admin.WithFeatureFlags(flags))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetFeatureFlag(context.Background(), &admin_api.FeatureFlagRequest{Flag: string(feature.Rebroadcast), Enabled: false})
+		require.NoError(t, err)
+		_, err = sut.SetFeatureFlag(context.Background(), &admin_api.FeatureFlagRequest{Flag: string(feature.CumulativeFees), Enabled: true})
+		require.NoError(t, err)
+		_, err = sut.ResetFeatureFlag(context.Background(), &admin_api.FeatureFlagRequest{Flag: string(feature.CumulativeFees)})
+		require.NoError(t, err)
+
+		actual, err := sut.GetFeatureFlags(context.Background(), &emptypb.Empty{})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, actual.GetFlags(), 3)
+		require.Equal(t, string(feature.CumulativeFees), actual.GetFlags()[0].GetFlag())
+		require.False(t, actual.GetFlags()[0].GetEnabled())
+		require.False(t, actual.GetFlags()[0].GetOverridden())
+		require.Equal(t, string(feature.Rebroadcast), actual.GetFlags()[2].GetFlag())
+		require.False(t, actual.GetFlags()[2].GetEnabled())
+		require.True(t, actual.GetFlags()[2].GetConfigured())
+		require.True(t, actual.GetFlags()[2].GetOverridden())
+		require.False(t, flags.Enabled(feature.Rebroadcast))
+	})
+
+	t.Run("unknown flag", func(t *testing.T) {
+		// given
+		flags, err := feature.New(slog.Default(), nil)
+		require.NoError(t, err)
+
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_feature_flags_test"}, admin.WithFeatureFlags(flags))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetFeatureFlag(context.Background(), &admin_api.FeatureFlagRequest{Flag: "metamorph.unknown", Enabled: true})
+
+		// then
+		require.ErrorIs(t, err, feature.ErrUnknownFlag)
+	})
+
+	t.Run("flag of a component of another process", func(t *testing.T) {
+		// given
+		flags, err := feature.New(slog.Default(), nil, feature.WithComponents("api"))
+		require.NoError(t, err)
+
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_feature_flags_test"}, admin.WithFeatureFlags(flags))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetFeatureFlag(context.Background(), &admin_api.FeatureFlagRequest{Flag: string(feature.Rebroadcast), Enabled: false})
+
+		// then
+		require.ErrorIs(t, err, feature.ErrFlagNotShared)
+		require.True(t, flags.Enabled(feature.Rebroadcast))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// given
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_feature_flags_test"})
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.GetFeatureFlags(context.Background(), &emptypb.Empty{})
+
+		// then
+		require.ErrorIs(t, err, admin.ErrFeatureFlagsDisabled)
+	})
+}
+
+func TestRequiredRole_FeatureFlags(t *testing.T) {
+	require.Equal(t, admin.RoleViewer, admin.RequiredRole(admin_api.AdminAPI_GetFeatureFlags_FullMethodName))
+	require.Equal(t, admin.RoleAdmin, admin.RequiredRole(admin_api.AdminAPI_SetFeatureFlag_FullMethodName))
+	require.Equal(t, admin.RoleAdmin, admin.RequiredRole(admin_api.AdminAPI_ResetFeatureFlag_FullMethodName))
+}
@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/tasks"
+)
+
+const (
+	// PathPrefix is the path under which the admin endpoints are served on the API server. The endpoints are not part of
+	// the swagger definition of the API.
+	PathPrefix = "/v1/admin/"
+
+	// TasksPath is the path of the endpoints of the background tasks
+	TasksPath = PathPrefix + "tasks"
+)
+
+// TaskResponse is the background task returned by the HTTP endpoints of the tasks.
+type TaskResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	Total      uint64     `json:"total"`
+	Done       uint64     `json:"done"`
+	Failed     uint64     `json:"failed"`
+	Percent    float64    `json:"percent"`
+	Error      string     `json:"error,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// RegisterTaskHandlers registers the HTTP endpoints by which operators monitor and cancel the background tasks. Like
+// the operations of the admin service, every request has to be authorized with a bearer token whose role permits it,
+// listing the tasks requires the role viewer and cancelling a task the role operator.
+func RegisterTaskHandlers(e *echo.Echo, logger *slog.Logger, manager *tasks.Manager, tokens []Token) {
+	auth := newAuthorizer(logger, tokens)
+
+	e.GET(TasksPath, auth.httpRole(RoleViewer, func(c echo.Context) error {
+		list := manager.List()
+		result := make([]TaskResponse, 0, len(list))
+		for _, task := range list {
+			result = append(result, toTaskResponse(task))
+		}
+
+		return c.JSON(http.StatusOK, result)
+	}))
+
+	e.GET(TasksPath+"/:id", auth.httpRole(RoleViewer, func(c echo.Context) error {
+		task, err := manager.Get(c.Param("id"))
+		if err != nil {
+			return taskErrorJSON(c, err)
+		}
+
+		return c.JSON(http.StatusOK, toTaskResponse(task))
+	}))
+
+	e.DELETE(TasksPath+"/:id", auth.httpRole(RoleOperator, func(c echo.Context) error {
+		task, err := manager.Cancel(c.Param("id"))
+		if err != nil {
+			return taskErrorJSON(c, err)
+		}
+
+		token := tokenFromContext(c.Request().Context())
+		auth.logger.InfoContext(c.Request().Context(), "Admin action", slog.String("method", c.Request().Method+" "+c.Request().URL.Path),
+			slog.String("token", token.Name), slog.String("role", token.Role.String()))
+
+		return c.JSON(http.StatusOK, toTaskResponse(task))
+	}))
+}
+
+// httpRole authorizes the HTTP requests by the role of the bearer token in the authorization header.
+func (a *authorizer) httpRole(role Role, next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		token, found := a.match(req.Header.Values(echo.HeaderAuthorization))
+		if !found {
+			a.logger.WarnContext(req.Context(), "Admin action denied", slog.String("method", req.Method+" "+req.URL.Path), slog.String("err", "invalid token"))
+			return c.JSON(http.StatusUnauthorized, errorResponse{Error: "invalid token"})
+		}
+
+		if token.Role < role {
+			a.logger.WarnContext(req.Context(), "Admin action denied", slog.String("method", req.Method+" "+req.URL.Path), slog.String("token", token.Name))
+			return c.JSON(http.StatusForbidden, errorResponse{Error: "role " + role.String() + " required, token has role " + token.Role.String()})
+		}
+
+		c.SetRequest(req.WithContext(contextWithToken(req.Context(), token)))
+
+		return next(c)
+	}
+}
+
+func taskErrorJSON(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, tasks.ErrTaskNotFound):
+		return c.JSON(http.StatusNotFound, errorResponse{Error: err.Error()})
+	case errors.Is(err, tasks.ErrTaskNotRunning):
+		return c.JSON(http.StatusConflict, errorResponse{Error: err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, errorResponse{Error: err.Error()})
+	}
+}
+
+func toTaskResponse(task tasks.Task) TaskResponse {
+	result := TaskResponse{
+		ID:        task.ID,
+		Name:      task.Name,
+		Status:    string(task.Status),
+		Total:     task.Total,
+		Done:      task.Done,
+		Failed:    task.Failed,
+		Percent:   task.Percent(),
+		Error:     task.Error,
+		StartedAt: task.StartedAt,
+	}
+	if !task.FinishedAt.IsZero() {
+		result.FinishedAt = &task.FinishedAt
+	}
+
+	return result
+}
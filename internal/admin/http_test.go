@@ -0,0 +1,111 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/tasks"
+)
+
+func TestRegisterTaskHandlers(t *testing.T) {
+	// given
+	manager := tasks.New(slog.Default())
+	defer manager.Shutdown()
+
+	reported := make(chan struct{})
+	running := manager.Start("prune-data", func(ctx context.Context, progress *tasks.Progress) error {
+		progress.SetTotal(4)
+		progress.Done(1)
+		close(reported)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	<-reported
+
+	e := echo.New()
+	admin.RegisterTaskHandlers(e, slog.Default(), manager, testTokens)
+
+	tt := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+
+		expectedCode int
+	}{
+		{
+			name:   "list without token",
+			method: http.MethodGet,
+			path:   admin.TasksPath,
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "list",
+			method: http.MethodGet,
+			path:   admin.TasksPath,
+			token:  "viewer-secret",
+
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "get unknown task",
+			method: http.MethodGet,
+			path:   admin.TasksPath + "/unknown",
+			token:  "viewer-secret",
+
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "cancel as viewer",
+			method: http.MethodDelete,
+			path:   admin.TasksPath + "/" + running.ID,
+			token:  "viewer-secret",
+
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:   "cancel",
+			method: http.MethodDelete,
+			path:   admin.TasksPath + "/" + running.ID,
+			token:  "operator-secret",
+
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			if tc.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			e.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedCode != http.StatusOK || tc.method != http.MethodGet {
+				return
+			}
+
+			var actual []admin.TaskResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			require.Len(t, actual, 1)
+			require.Equal(t, running.ID, actual[0].ID)
+			require.Equal(t, "RUNNING", actual[0].Status)
+			require.InDelta(t, 25, actual[0].Percent, 0.001)
+			require.Nil(t, actual[0].FinishedAt)
+		})
+	}
+}
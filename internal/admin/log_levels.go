@@ -0,0 +1,140 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sort"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+var (
+	ErrLogLevelsDisabled     = errors.New("log levels not enabled")
+	ErrComponentRequired     = errors.New("component required")
+	ErrComponentNotReachable = errors.New("component neither running in the process of the admin service nor reachable over gRPC")
+)
+
+// component names of the services whose log levels are changed over their gRPC servers
+const (
+	componentAPI        = "api"
+	componentMetamorph  = "mtm"
+	componentBlocktx    = "blocktx"
+	componentCallbacker = "callbacker"
+)
+
+// GetLogLevels returns the log level of the process running the admin service and the log levels of its components
+// differing from it.
+func (s *Server) GetLogLevels(_ context.Context, _ *emptypb.Empty) (*admin_api.LogLevels, error) {
+	if s.logLevels == nil {
+		return nil, ErrLogLevelsDisabled
+	}
+
+	return toLogLevels(s.logLevels), nil
+}
+
+// SetLogLevel sets the log level of a component, or of the process if no component is given, until the next restart.
+// The log levels of Metamorph, BlockTx and Callbacker are set over their gRPC servers, as they may run in other
+// processes. Components which are neither running in the process of the admin service nor reachable are rejected.
+func (s *Server) SetLogLevel(ctx context.Context, req *admin_api.LogLevelRequest) (*admin_api.LogLevels, error) {
+	if s.logLevels == nil {
+		return nil, ErrLogLevelsDisabled
+	}
+
+	level, err := arcLogger.GetSlogLevel(req.GetLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.GetComponent() {
+	case "", componentAPI:
+		s.logLevels.SetLevel(req.GetComponent(), level)
+	case componentMetamorph:
+		if s.metamorph == nil {
+			return nil, ErrComponentNotReachable
+		}
+		_, err = s.metamorph.SetLogLevel(ctx, &metamorph_api.LogLevelRequest{Component: req.GetComponent(), Level: req.GetLevel()})
+	case componentBlocktx:
+		if s.blocktx == nil {
+			return nil, ErrComponentNotReachable
+		}
+		_, err = s.blocktx.SetLogLevel(ctx, &blocktx_api.LogLevelRequest{Component: req.GetComponent(), Level: req.GetLevel()})
+	case componentCallbacker:
+		if s.callbacker == nil {
+			return nil, ErrComponentNotReachable
+		}
+		_, err = s.callbacker.SetLogLevel(ctx, &callbacker_api.LogLevelRequest{Component: req.GetComponent(), Level: req.GetLevel()})
+	default:
+		return nil, ErrComponentNotReachable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level changed", slog.String("component", req.GetComponent()), slog.String("level", req.GetLevel()))
+
+	return toLogLevels(s.logLevels), nil
+}
+
+// ResetLogLevel resets the log level of a component to the log level of its process. Like SetLogLevel, the log levels
+// of Metamorph, BlockTx and Callbacker are reset over their gRPC servers.
+func (s *Server) ResetLogLevel(ctx context.Context, req *admin_api.LogLevelRequest) (*admin_api.LogLevels, error) {
+	if s.logLevels == nil {
+		return nil, ErrLogLevelsDisabled
+	}
+
+	if req.GetComponent() == "" {
+		return nil, ErrComponentRequired
+	}
+
+	var err error
+	switch req.GetComponent() {
+	case componentAPI:
+		s.logLevels.ResetLevel(req.GetComponent())
+	case componentMetamorph:
+		if s.metamorph == nil {
+			return nil, ErrComponentNotReachable
+		}
+		_, err = s.metamorph.ResetLogLevel(ctx, &metamorph_api.LogLevelRequest{Component: req.GetComponent()})
+	case componentBlocktx:
+		if s.blocktx == nil {
+			return nil, ErrComponentNotReachable
+		}
+		_, err = s.blocktx.ResetLogLevel(ctx, &blocktx_api.LogLevelRequest{Component: req.GetComponent()})
+	case componentCallbacker:
+		if s.callbacker == nil {
+			return nil, ErrComponentNotReachable
+		}
+		_, err = s.callbacker.ResetLogLevel(ctx, &callbacker_api.LogLevelRequest{Component: req.GetComponent()})
+	default:
+		return nil, ErrComponentNotReachable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level reset", slog.String("component", req.GetComponent()))
+
+	return toLogLevels(s.logLevels), nil
+}
+
+func toLogLevels(levels *arcLogger.Levels) *admin_api.LogLevels {
+	level, components := levels.Names()
+
+	result := &admin_api.LogLevels{Level: level, Components: make([]*admin_api.ComponentLogLevel, 0, len(components))}
+	for component, componentLevel := range components {
+		result.Components = append(result.Components, &admin_api.ComponentLogLevel{Component: component, Level: componentLevel})
+	}
+
+	sort.Slice(result.Components, func(i, j int) bool {
+		return result.Components[i].GetComponent() < result.Components[j].GetComponent()
+	})
+
+	return result
+}
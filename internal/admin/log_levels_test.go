@@ -0,0 +1,176 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	cbcMocks "github.com/bitcoin-sv/arc/internal/callbacker/mocks"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+)
+
+func TestServer_LogLevels(t *testing.T) {
+	t.Run("set, reset and get log levels", func(t *testing.T) {
+		// given
+		levels := arcLogger.NewLevels(arcLogger.LevelInfo)
+		metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+			SetLogLevelFunc: func(_ context.Context, in *metamorph_api.LogLevelRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+				require.Equal(t, "mtm", in.GetComponent())
+				require.Equal(t, "DEBUG", in.GetLevel())
+				return &emptypb.Empty{}, nil
+			},
+		}
+		blocktxClient := &btxMocks.BlockTxAPIClientMock{
+			SetLogLevelFunc: func(_ context.Context, in *blocktx_api.LogLevelRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+				require.Equal(t, "blocktx", in.GetComponent())
+				require.Equal(t, "WARN", in.GetLevel())
+				return &emptypb.Empty{}, nil
+			},
+			ResetLogLevelFunc: func(_ context.Context, in *blocktx_api.LogLevelRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+				require.Equal(t, "blocktx", in.GetComponent())
+				return &emptypb.Empty{}, nil
+			},
+		}
+
+		sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_log_levels_test"},
+			admin.WithLogLevels(levels),
+			admin.WithBlocktx(blocktxClient),
+		)
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: "mtm", Level: "DEBUG"})
+		require.NoError(t, err)
+		_, err = sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: "blocktx", Level: "WARN"})
+		require.NoError(t, err)
+		_, err = sut.ResetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: "blocktx"})
+		require.NoError(t, err)
+		_, err = sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: "api", Level: "ERROR"})
+		require.NoError(t, err)
+		_, err = sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Level: "WARN"})
+		require.NoError(t, err)
+
+		actual, err := sut.GetLogLevels(context.Background(), &emptypb.Empty{})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, metamorphClient.SetLogLevelCalls(), 1)
+		require.Len(t, blocktxClient.SetLogLevelCalls(), 1)
+		require.Len(t, blocktxClient.ResetLogLevelCalls(), 1)
+		require.Equal(t, "WARN", actual.GetLevel())
+		require.Len(t, actual.GetComponents(), 1)
+		require.Equal(t, "api", actual.GetComponents()[0].GetComponent())
+		require.Equal(t, "ERROR", actual.GetComponents()[0].GetLevel())
+	})
+
+	t.Run("component not reachable", func(t *testing.T) {
+		tt := []struct {
+			name      string
+			component string
+		}{
+			{name: "blocktx without client", component: "blocktx"},
+			{name: "callbacker without client", component: "callbacker"},
+			{name: "metamorph without client", component: "mtm"},
+			{name: "component of another process", component: "k8s-watcher"},
+		}
+
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				// given
+				levels := arcLogger.NewLevels(arcLogger.LevelInfo)
+				sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_log_levels_test"}, admin.WithLogLevels(levels))
+				require.NoError(t, err)
+				defer sut.GracefulStop()
+
+				// when
+				_, setErr := sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: tc.component, Level: "DEBUG"})
+				_, resetErr := sut.ResetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: tc.component})
+
+				// then
+				require.ErrorIs(t, setErr, admin.ErrComponentNotReachable)
+				require.ErrorIs(t, resetErr, admin.ErrComponentNotReachable)
+				require.Equal(t, arcLogger.LevelInfo, levels.Level(tc.component))
+			})
+		}
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		// given
+		callbackerClient := &cbcMocks.CallbackerAPIClientMock{
+			SetLogLevelFunc: func(_ context.Context, _ *callbacker_api.LogLevelRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+				return nil, errors.New("unavailable")
+			},
+		}
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_log_levels_test"},
+			admin.WithLogLevels(arcLogger.NewLevels(arcLogger.LevelInfo)),
+			admin.WithCallbacker(callbackerClient),
+		)
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: "callbacker", Level: "DEBUG"})
+
+		// then
+		require.ErrorContains(t, err, "unavailable")
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		// given
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_log_levels_test"}, admin.WithLogLevels(arcLogger.NewLevels(arcLogger.LevelInfo)))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetLogLevel(context.Background(), &admin_api.LogLevelRequest{Component: "mtm", Level: "VERBOSE"})
+
+		// then
+		require.ErrorIs(t, err, arcLogger.ErrLoggerInvalidLogLevel)
+	})
+
+	t.Run("reset without component", func(t *testing.T) {
+		// given
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_log_levels_test"}, admin.WithLogLevels(arcLogger.NewLevels(arcLogger.LevelInfo)))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.ResetLogLevel(context.Background(), &admin_api.LogLevelRequest{})
+
+		// then
+		require.ErrorIs(t, err, admin.ErrComponentRequired)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// given
+		sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_log_levels_test"})
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.GetLogLevels(context.Background(), &emptypb.Empty{})
+
+		// then
+		require.ErrorIs(t, err, admin.ErrLogLevelsDisabled)
+	})
+}
+
+func TestRequiredRole_LogLevels(t *testing.T) {
+	require.Equal(t, admin.RoleViewer, admin.RequiredRole(admin_api.AdminAPI_GetLogLevels_FullMethodName))
+	require.Equal(t, admin.RoleAdmin, admin.RequiredRole(admin_api.AdminAPI_SetLogLevel_FullMethodName))
+	require.Equal(t, admin.RoleAdmin, admin.RequiredRole(admin_api.AdminAPI_ResetLogLevel_FullMethodName))
+}
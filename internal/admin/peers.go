@@ -0,0 +1,132 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+const (
+	serviceMetamorph = "metamorph"
+	serviceBlocktx   = "blocktx"
+)
+
+// peerBan is implemented by the peer bans of Metamorph and BlockTx.
+type peerBan interface {
+	GetAddress() string
+	GetConnected() bool
+	GetBanScore() int64
+	GetBanned() bool
+	GetBannedUntil() *timestamppb.Timestamp
+}
+
+// ListPeers returns the peers of Metamorph and BlockTx with their ban scores and the banned addresses.
+func (s *Server) ListPeers(ctx context.Context, _ *emptypb.Empty) (*admin_api.PeerBans, error) {
+	mtmBans, err := s.metamorph.ListPeers(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	var btxBans *blocktx_api.PeerBans
+	if s.blocktx != nil {
+		btxBans, err = s.blocktx.ListPeers(ctx, &emptypb.Empty{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toPeerBans(mtmBans, btxBans), nil
+}
+
+// BanPeer bans the address in Metamorph and BlockTx. If both share the ban manager, i.e. run in the same process, the
+// address is banned twice, which only extends the ban by the time between the calls.
+func (s *Server) BanPeer(ctx context.Context, req *admin_api.BanPeerRequest) (*admin_api.PeerBans, error) {
+	mtmBans, err := s.metamorph.BanPeer(ctx, &metamorph_api.BanPeerRequest{Address: req.GetAddress(), Duration: req.GetDuration()})
+	if err != nil {
+		return nil, err
+	}
+
+	var btxBans *blocktx_api.PeerBans
+	if s.blocktx != nil {
+		btxBans, err = s.blocktx.BanPeer(ctx, &blocktx_api.BanPeerRequest{Address: req.GetAddress(), Duration: req.GetDuration()})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toPeerBans(mtmBans, btxBans), nil
+}
+
+// UnbanPeer lifts the ban of the address in Metamorph and BlockTx. The call fails with not found only if the address
+// is banned in neither of them, as Metamorph and BlockTx share the bans if they run in the same process.
+func (s *Server) UnbanPeer(ctx context.Context, req *admin_api.BanPeerRequest) (*admin_api.PeerBans, error) {
+	mtmBans, mtmErr := s.metamorph.UnbanPeer(ctx, &metamorph_api.BanPeerRequest{Address: req.GetAddress()})
+	if mtmErr != nil && status.Code(mtmErr) != codes.NotFound {
+		return nil, mtmErr
+	}
+
+	if s.blocktx == nil {
+		if mtmErr != nil {
+			return nil, mtmErr
+		}
+		return toPeerBans(mtmBans, nil), nil
+	}
+
+	btxBans, btxErr := s.blocktx.UnbanPeer(ctx, &blocktx_api.BanPeerRequest{Address: req.GetAddress()})
+	if btxErr != nil && status.Code(btxErr) != codes.NotFound {
+		return nil, btxErr
+	}
+
+	if mtmErr != nil && btxErr != nil {
+		return nil, errors.Join(mtmErr, btxErr)
+	}
+
+	// the bans of the service in which the address was not banned are unchanged and listed again
+	if mtmErr != nil {
+		mtmBans, mtmErr = s.metamorph.ListPeers(ctx, &emptypb.Empty{})
+		if mtmErr != nil {
+			return nil, mtmErr
+		}
+	}
+	if btxErr != nil {
+		btxBans, btxErr = s.blocktx.ListPeers(ctx, &emptypb.Empty{})
+		if btxErr != nil {
+			return nil, btxErr
+		}
+	}
+
+	return toPeerBans(mtmBans, btxBans), nil
+}
+
+func toPeerBans(mtmBans *metamorph_api.PeerBans, btxBans *blocktx_api.PeerBans) *admin_api.PeerBans {
+	result := &admin_api.PeerBans{}
+	result.Peers = appendPeerBans(result.Peers, serviceMetamorph, mtmBans.GetPeers())
+	result.Bans = appendPeerBans(result.Bans, serviceMetamorph, mtmBans.GetBans())
+	result.Peers = appendPeerBans(result.Peers, serviceBlocktx, btxBans.GetPeers())
+	result.Bans = appendPeerBans(result.Bans, serviceBlocktx, btxBans.GetBans())
+
+	return result
+}
+
+func appendPeerBans[T peerBan](result []*admin_api.PeerBan, service string, peerBans []T) []*admin_api.PeerBan {
+	for _, p := range peerBans {
+		result = append(result, &admin_api.PeerBan{
+			Service:     service,
+			Address:     p.GetAddress(),
+			Connected:   p.GetConnected(),
+			BanScore:    p.GetBanScore(),
+			Banned:      p.GetBanned(),
+			BannedUntil: p.GetBannedUntil(),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,125 @@
+package admin_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+)
+
+func TestServer_ListPeers(t *testing.T) {
+	// given
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		ListPeersFunc: func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+			return &metamorph_api.PeerBans{
+				Peers: []*metamorph_api.PeerBan{{Address: "localhost:18333", Connected: true, BanScore: 20}},
+			}, nil
+		},
+	}
+	blocktxClient := &btxMocks.BlockTxAPIClientMock{
+		ListPeersFunc: func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+			return &blocktx_api.PeerBans{
+				Peers: []*blocktx_api.PeerBan{{Address: "localhost:18334", Connected: true}},
+				Bans:  []*blocktx_api.PeerBan{{Address: "localhost:18335", Banned: true}},
+			}, nil
+		},
+	}
+
+	sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_peers_test"}, admin.WithBlocktx(blocktxClient))
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	// when
+	actual, err := sut.ListPeers(context.Background(), &emptypb.Empty{})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, actual.GetPeers(), 2)
+	require.Equal(t, "metamorph", actual.GetPeers()[0].GetService())
+	require.Equal(t, int64(20), actual.GetPeers()[0].GetBanScore())
+	require.Equal(t, "blocktx", actual.GetPeers()[1].GetService())
+	require.Len(t, actual.GetBans(), 1)
+	require.Equal(t, "blocktx", actual.GetBans()[0].GetService())
+	require.Equal(t, "localhost:18335", actual.GetBans()[0].GetAddress())
+}
+
+func TestServer_UnbanPeer(t *testing.T) {
+	notBanned := status.Error(codes.NotFound, "address is not banned")
+
+	tt := []struct {
+		name          string
+		metamorphErr  error
+		blocktxErr    error
+		expectedCode  codes.Code
+		expectedLists int
+	}{
+		{
+			name: "unbanned in both",
+		},
+		{
+			name:       "unbanned in metamorph only - shared bans",
+			blocktxErr: notBanned,
+
+			expectedLists: 1,
+		},
+		{
+			name:         "banned in neither",
+			metamorphErr: notBanned,
+			blocktxErr:   notBanned,
+
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+				UnbanPeerFunc: func(_ context.Context, _ *metamorph_api.BanPeerRequest, _ ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+					if tc.metamorphErr != nil {
+						return nil, tc.metamorphErr
+					}
+					return &metamorph_api.PeerBans{}, nil
+				},
+				ListPeersFunc: func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+					return &metamorph_api.PeerBans{}, nil
+				},
+			}
+			blocktxClient := &btxMocks.BlockTxAPIClientMock{
+				UnbanPeerFunc: func(_ context.Context, _ *blocktx_api.BanPeerRequest, _ ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+					if tc.blocktxErr != nil {
+						return nil, tc.blocktxErr
+					}
+					return &blocktx_api.PeerBans{}, nil
+				},
+				ListPeersFunc: func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+					return &blocktx_api.PeerBans{}, nil
+				},
+			}
+
+			sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_peers_test"}, admin.WithBlocktx(blocktxClient))
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			_, err = sut.UnbanPeer(context.Background(), &admin_api.BanPeerRequest{Address: "localhost:18333"})
+
+			// then
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Len(t, blocktxClient.ListPeersCalls(), tc.expectedLists)
+		})
+	}
+}
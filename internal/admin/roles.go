@@ -0,0 +1,235 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+
+	// the reflection service lets clients like grpcurl discover the admin operations
+	reflectionServicePrefix = "/grpc.reflection."
+)
+
+var ErrInvalidRole = errors.New("invalid role")
+
+// Role determines the admin operations a token is allowed to call. Every role is allowed to call the operations of
+// the roles below it.
+type Role int
+
+const (
+	RoleNone Role = iota
+	RoleViewer
+	RoleOperator
+	RoleAdmin
+)
+
+var roleNames = map[Role]string{
+	RoleViewer:   "viewer",
+	RoleOperator: "operator",
+	RoleAdmin:    "admin",
+}
+
+func (r Role) String() string {
+	name, found := roleNames[r]
+	if !found {
+		return "none"
+	}
+
+	return name
+}
+
+func ParseRole(name string) (Role, error) {
+	for role, roleName := range roleNames {
+		if strings.EqualFold(roleName, name) {
+			return role, nil
+		}
+	}
+
+	return RoleNone, errors.Join(ErrInvalidRole, fmt.Errorf("role %q", name))
+}
+
+// methodRoles maps the operations of the admin service to the minimum role required to call them. Operations which
+// are not listed require the role admin.
+var methodRoles = map[string]Role{
+	admin_api.AdminAPI_GetPolicy_FullMethodName:             RoleViewer,
+	admin_api.AdminAPI_UnlockRecords_FullMethodName:         RoleOperator,
+	admin_api.AdminAPI_ReplayCallbacks_FullMethodName:       RoleOperator,
+	admin_api.AdminAPI_ReprocessTransactions_FullMethodName: RoleOperator,
+	admin_api.AdminAPI_ReloadPolicy_FullMethodName:          RoleAdmin,
+	admin_api.AdminAPI_GetSLAReports_FullMethodName:         RoleViewer,
+	admin_api.AdminAPI_ListJobs_FullMethodName:              RoleViewer,
+	admin_api.AdminAPI_TriggerJob_FullMethodName:            RoleOperator,
+	admin_api.AdminAPI_PauseJob_FullMethodName:              RoleOperator,
+	admin_api.AdminAPI_ResumeJob_FullMethodName:             RoleOperator,
+	admin_api.AdminAPI_AnnotateTransaction_FullMethodName:   RoleOperator,
+	admin_api.AdminAPI_GetAbuseScores_FullMethodName:        RoleViewer,
+	admin_api.AdminAPI_PruneData_FullMethodName:             RoleAdmin,
+	admin_api.AdminAPI_ListTasks_FullMethodName:             RoleViewer,
+	admin_api.AdminAPI_GetTask_FullMethodName:               RoleViewer,
+	admin_api.AdminAPI_CancelTask_FullMethodName:            RoleOperator,
+	admin_api.AdminAPI_EraseClientData_FullMethodName:       RoleAdmin,
+	admin_api.AdminAPI_ListErasures_FullMethodName:          RoleViewer,
+	admin_api.AdminAPI_GetLogLevels_FullMethodName:          RoleViewer,
+	admin_api.AdminAPI_SetLogLevel_FullMethodName:           RoleAdmin,
+	admin_api.AdminAPI_ResetLogLevel_FullMethodName:         RoleAdmin,
+	admin_api.AdminAPI_ListPeers_FullMethodName:             RoleViewer,
+	admin_api.AdminAPI_BanPeer_FullMethodName:               RoleOperator,
+	admin_api.AdminAPI_UnbanPeer_FullMethodName:             RoleOperator,
+	admin_api.AdminAPI_GetFeatureFlags_FullMethodName:       RoleViewer,
+	admin_api.AdminAPI_SetFeatureFlag_FullMethodName:        RoleAdmin,
+	admin_api.AdminAPI_ResetFeatureFlag_FullMethodName:      RoleAdmin,
+}
+
+// RequiredRole returns the minimum role required to call the method of the admin service.
+func RequiredRole(fullMethod string) Role {
+	role, found := methodRoles[fullMethod]
+	if found {
+		return role
+	}
+
+	if strings.HasPrefix(fullMethod, reflectionServicePrefix) {
+		return RoleViewer
+	}
+
+	return RoleAdmin
+}
+
+// Token is a bearer token of the admin service. The name identifies the token in the audit log.
+type Token struct {
+	Name  string
+	Token string
+	Role  Role
+}
+
+// authorizer authorizes the calls of the admin service by the role of the bearer token and writes the calls of the
+// admin operations to the audit log.
+type authorizer struct {
+	logger *slog.Logger
+	tokens []Token
+}
+
+func newAuthorizer(logger *slog.Logger, tokens []Token) *authorizer {
+	return &authorizer{
+		logger: logger.With(slog.String("module", "admin-audit")),
+		tokens: tokens,
+	}
+}
+
+func (a *authorizer) lookup(ctx context.Context) (Token, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return Token{}, false
+	}
+
+	return a.match(md.Get(authorizationKey))
+}
+
+// match returns the token of the first authorization value carrying a known bearer token.
+func (a *authorizer) match(values []string) (Token, bool) {
+	for _, value := range values {
+		received, found := strings.CutPrefix(value, bearerPrefix)
+		if !found {
+			continue
+		}
+
+		for _, token := range a.tokens {
+			if subtle.ConstantTimeCompare([]byte(received), []byte(token.Token)) == 1 {
+				return token, true
+			}
+		}
+	}
+
+	return Token{}, false
+}
+
+func (a *authorizer) authorize(ctx context.Context, fullMethod string) (Token, error) {
+	token, found := a.lookup(ctx)
+	if !found {
+		return Token{}, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	role := RequiredRole(fullMethod)
+	if token.Role < role {
+		return token, status.Errorf(codes.PermissionDenied, "role %s required, token has role %s", role, token.Role)
+	}
+
+	return token, nil
+}
+
+func (a *authorizer) unaryServerInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	token, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		a.logger.WarnContext(ctx, "Admin action denied", slog.String("method", info.FullMethod), slog.String("token", token.Name), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	resp, err := handler(contextWithToken(ctx, token), req)
+
+	attrs := []any{
+		slog.String("method", info.FullMethod),
+		slog.String("token", token.Name),
+		slog.String("role", token.Role.String()),
+		slog.String("request", requestString(req)),
+	}
+	if err != nil {
+		a.logger.ErrorContext(ctx, "Admin action failed", append(attrs, slog.String("err", err.Error()))...)
+		return nil, err
+	}
+
+	a.logger.InfoContext(ctx, "Admin action", attrs...)
+
+	return resp, nil
+}
+
+func (a *authorizer) streamServerInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	token, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		a.logger.WarnContext(ss.Context(), "Admin action denied", slog.String("method", info.FullMethod), slog.String("token", token.Name), slog.String("err", err.Error()))
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
+type tokenContextKey struct{}
+
+func contextWithToken(ctx context.Context, token Token) context.Context {
+	return context.WithValue(ctx, tokenContextKey{}, token)
+}
+
+// tokenFromContext returns the token with which the call of the admin operation was authorized.
+func tokenFromContext(ctx context.Context) Token {
+	token, _ := ctx.Value(tokenContextKey{}).(Token)
+
+	return token
+}
+
+func requestString(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	b, err := protojson.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
+}
@@ -0,0 +1,368 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/ordishs/go-bitcoin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/tasks"
+)
+
+var (
+	ErrNoTokens             = errors.New("no admin tokens configured")
+	ErrPolicyUnknown        = errors.New("policy unknown")
+	ErrAbuseScoringDisabled = errors.New("abuse scoring not enabled")
+	ErrTasksDisabled        = errors.New("background tasks not enabled")
+
+	errNotReplayed = "transaction not found or without callbacks"
+)
+
+// PolicyHandler is the handler of the API whose policy is used to validate transactions.
+type PolicyHandler interface {
+	Policy() *bitcoin.Settings
+	ReloadPolicy(ctx context.Context) (*bitcoin.Settings, error)
+}
+
+// AbuseScorer returns the abuse scores of the clients of the API.
+type AbuseScorer interface {
+	AbuseScores() []abuse.KeyScore
+}
+
+// Server is the gRPC server of the admin service. Every call has to be authorized with a bearer token whose role
+// permits the operation.
+type Server struct {
+	admin_api.UnimplementedAdminAPIServer
+	grpc_utils.GrpcServer
+
+	logger       *slog.Logger
+	metamorph    metamorph_api.MetaMorphAPIClient
+	callbacker   callbacker_api.CallbackerAPIClient
+	policy       PolicyHandler
+	abuse        AbuseScorer
+	tasks        *tasks.Manager
+	blocktx      blocktx_api.BlockTxAPIClient
+	logLevels    *arcLogger.Levels
+	featureFlags *feature.Flags
+}
+
+// WithAbuseScorer enables the operation returning the abuse scores of the clients of the API.
+func WithAbuseScorer(scorer AbuseScorer) func(*Server) {
+	return func(s *Server) {
+		s.abuse = scorer
+	}
+}
+
+// WithCallbacker sets the client of the callbacker whose callback delivery reports are merged into the SLA reports and
+// whose stored callbacks are erased together with the client data of metamorph.
+func WithCallbacker(client callbacker_api.CallbackerAPIClient) func(*Server) {
+	return func(s *Server) {
+		s.callbacker = client
+	}
+}
+
+// WithTasks enables the background tasks run by the manager, i.e. the async requests and the pruning of data.
+func WithTasks(manager *tasks.Manager) func(*Server) {
+	return func(s *Server) {
+		s.tasks = manager
+	}
+}
+
+// WithBlocktx prunes the data of blocktx together with the data of metamorph.
+func WithBlocktx(client blocktx_api.BlockTxAPIClient) func(*Server) {
+	return func(s *Server) {
+		s.blocktx = client
+	}
+}
+
+// WithLogLevels enables the operations returning and changing the log levels of the process running the admin service.
+func WithLogLevels(levels *arcLogger.Levels) func(*Server) {
+	return func(s *Server) {
+		s.logLevels = levels
+	}
+}
+
+// WithFeatureFlags enables the operations returning and overriding the feature flags of the process running the admin
+// service.
+func WithFeatureFlags(flags *feature.Flags) func(*Server) {
+	return func(s *Server) {
+		s.featureFlags = flags
+	}
+}
+
+func NewServer(logger *slog.Logger, metamorphClient metamorph_api.MetaMorphAPIClient, policy PolicyHandler, tokens []Token, cfg grpc_utils.ServerConfig, opts ...func(*Server)) (*Server, error) {
+	if len(tokens) == 0 {
+		return nil, ErrNoTokens
+	}
+
+	logger = logger.With(slog.String("module", "admin"))
+
+	auth := newAuthorizer(logger, tokens)
+	cfg.UnaryInterceptors = append(cfg.UnaryInterceptors, auth.unaryServerInterceptor)
+	cfg.StreamInterceptors = append(cfg.StreamInterceptors, auth.streamServerInterceptor)
+
+	grpcServer, err := grpc_utils.NewGrpcServer(logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		GrpcServer: grpcServer,
+		logger:     logger,
+		metamorph:  metamorphClient,
+		policy:     policy,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	admin_api.RegisterAdminAPIServer(s.GrpcServer.Srv, s)
+	reflection.Register(s.GrpcServer.Srv)
+
+	return s, nil
+}
+
+func (s *Server) GetPolicy(_ context.Context, _ *emptypb.Empty) (*admin_api.Policy, error) {
+	return toPolicy(s.policy.Policy())
+}
+
+func (s *Server) ReloadPolicy(ctx context.Context, _ *emptypb.Empty) (*admin_api.Policy, error) {
+	policy, err := s.policy.ReloadPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPolicy(policy)
+}
+
+func (s *Server) UnlockRecords(ctx context.Context, req *admin_api.UnlockRecordsRequest) (*admin_api.UnlockRecordsResponse, error) {
+	resp, err := s.metamorph.UnlockRecords(ctx, &metamorph_api.UnlockRecordsRequest{Instance: req.GetInstance()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_api.UnlockRecordsResponse{RecordsAffected: resp.GetRecordsAffected()}, nil
+}
+
+func (s *Server) ReplayCallbacks(ctx context.Context, req *admin_api.TransactionsRequest) (*admin_api.TransactionsResponse, error) {
+	if req.GetAsync() {
+		return s.startTask(replayCallbacksTask, func(ctx context.Context, progress *tasks.Progress) error {
+			return s.replayCallbacksInBatches(ctx, req.GetTxids(), progress)
+		})
+	}
+
+	results, err := s.replayCallbacks(ctx, req.GetTxids())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_api.TransactionsResponse{Results: results}, nil
+}
+
+func (s *Server) replayCallbacks(ctx context.Context, txIDs []string) ([]*admin_api.TransactionResult, error) {
+	resp, err := s.metamorph.ReplayCallbacks(ctx, &metamorph_api.TransactionsStatusRequest{TxIDs: txIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	replayed := make(map[string]struct{}, len(resp.GetTxIDs()))
+	for _, txID := range resp.GetTxIDs() {
+		replayed[txID] = struct{}{}
+	}
+
+	results := make([]*admin_api.TransactionResult, 0, len(txIDs))
+	for _, txID := range txIDs {
+		if _, found := replayed[txID]; found {
+			results = append(results, &admin_api.TransactionResult{Txid: txID, Success: true})
+			continue
+		}
+		results = append(results, &admin_api.TransactionResult{Txid: txID, Error: errNotReplayed})
+	}
+
+	return results, nil
+}
+
+// ReprocessTransactions resubmits the rejected transactions one by one, a failed resubmission does not stop the
+// resubmission of the other transactions.
+func (s *Server) ReprocessTransactions(ctx context.Context, req *admin_api.TransactionsRequest) (*admin_api.TransactionsResponse, error) {
+	if req.GetAsync() {
+		return s.startTask(reprocessTransactionsTask, func(ctx context.Context, progress *tasks.Progress) error {
+			progress.SetTotal(uint64(len(req.GetTxids())))
+			for _, txID := range req.GetTxids() {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				if s.reprocessTransaction(ctx, txID).GetSuccess() {
+					progress.Done(1)
+					continue
+				}
+				progress.Failed(1)
+			}
+
+			return nil
+		})
+	}
+
+	result := &admin_api.TransactionsResponse{}
+	for _, txID := range req.GetTxids() {
+		result.Results = append(result.Results, s.reprocessTransaction(ctx, txID))
+	}
+
+	return result, nil
+}
+
+func (s *Server) reprocessTransaction(ctx context.Context, txID string) *admin_api.TransactionResult {
+	_, err := s.metamorph.ResubmitTransaction(ctx, &metamorph_api.TransactionStatusRequest{Txid: txID})
+	if err != nil {
+		return &admin_api.TransactionResult{Txid: txID, Error: status.Convert(err).Message()}
+	}
+
+	return &admin_api.TransactionResult{Txid: txID, Success: true}
+}
+
+func (s *Server) ListJobs(ctx context.Context, _ *emptypb.Empty) (*admin_api.Jobs, error) {
+	resp, err := s.metamorph.ListJobs(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := &admin_api.Jobs{}
+	for _, job := range resp.GetJobs() {
+		jobs.Jobs = append(jobs.Jobs, toJob(job))
+	}
+
+	return jobs, nil
+}
+
+func (s *Server) TriggerJob(ctx context.Context, req *admin_api.JobRequest) (*admin_api.Job, error) {
+	return forwardJob(ctx, req, s.metamorph.TriggerJob)
+}
+
+func (s *Server) PauseJob(ctx context.Context, req *admin_api.JobRequest) (*admin_api.Job, error) {
+	return forwardJob(ctx, req, s.metamorph.PauseJob)
+}
+
+func (s *Server) ResumeJob(ctx context.Context, req *admin_api.JobRequest) (*admin_api.Job, error) {
+	return forwardJob(ctx, req, s.metamorph.ResumeJob)
+}
+
+// AnnotateTransaction attaches the note and labels to the transaction with the name of the calling token as author.
+func (s *Server) AnnotateTransaction(ctx context.Context, req *admin_api.AnnotateTransactionRequest) (*admin_api.Annotation, error) {
+	annotation, err := s.metamorph.AnnotateTransaction(ctx, &metamorph_api.AnnotateTransactionRequest{
+		Txid:   req.GetTxid(),
+		Note:   req.GetNote(),
+		Labels: req.GetLabels(),
+		Author: tokenFromContext(ctx).Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_api.Annotation{
+		Txid:      req.GetTxid(),
+		Note:      annotation.GetNote(),
+		Labels:    annotation.GetLabels(),
+		Author:    annotation.GetAuthor(),
+		CreatedAt: annotation.GetCreatedAt(),
+	}, nil
+}
+
+func (s *Server) GetAbuseScores(_ context.Context, req *admin_api.AbuseScoresRequest) (*admin_api.AbuseScores, error) {
+	if s.abuse == nil {
+		return nil, ErrAbuseScoringDisabled
+	}
+
+	scores := s.abuse.AbuseScores()
+	if req.GetLimit() > 0 && int(req.GetLimit()) < len(scores) {
+		scores = scores[:req.GetLimit()]
+	}
+
+	result := &admin_api.AbuseScores{Scores: make([]*admin_api.AbuseScore, 0, len(scores))}
+	for _, score := range scores {
+		result.Scores = append(result.Scores, &admin_api.AbuseScore{
+			Key:   score.Key,
+			Score: score.Score,
+			Signals: &admin_api.AbuseSignals{
+				BurstRate:     score.Signals.BurstRate,
+				Template:      score.Signals.Template,
+				FeeLevel:      score.Signals.FeeLevel,
+				RejectionRate: score.Signals.RejectionRate,
+			},
+			Throttling: score.Throttling,
+			Submitted:  score.Submitted,
+			Throttled:  score.Throttled,
+			Rejected:   score.Rejected,
+			LastSeen:   timestamppb.New(score.LastSeen),
+		})
+	}
+
+	return result, nil
+}
+
+func forwardJob(ctx context.Context, req *admin_api.JobRequest, call func(context.Context, *metamorph_api.JobRequest, ...grpc.CallOption) (*metamorph_api.Job, error)) (*admin_api.Job, error) {
+	job, err := call(ctx, &metamorph_api.JobRequest{Name: req.GetName()})
+	if err != nil {
+		return nil, err
+	}
+
+	return toJob(job), nil
+}
+
+func toJob(job *metamorph_api.Job) *admin_api.Job {
+	return &admin_api.Job{
+		Name:           job.GetName(),
+		Schedule:       job.GetSchedule(),
+		JitterMs:       job.GetJitterMs(),
+		Paused:         job.GetPaused(),
+		Running:        job.GetRunning(),
+		Runs:           job.GetRuns(),
+		LastRun:        job.GetLastRun(),
+		LastDurationMs: job.GetLastDurationMs(),
+		NextRun:        job.GetNextRun(),
+	}
+}
+
+func toPolicy(policy *bitcoin.Settings) (*admin_api.Policy, error) {
+	if policy == nil {
+		return nil, ErrPolicyUnknown
+	}
+
+	maxTxSizePolicy, err := safecast.ToUint64(policy.MaxTxSizePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	maxTxSigopsCountsPolicy, err := safecast.ToUint64(policy.MaxTxSigopsCountsPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	maxScriptSizePolicy, err := safecast.ToUint64(policy.MaxScriptSizePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admin_api.Policy{
+		MaxTxSizePolicy:         maxTxSizePolicy,
+		MaxTxSigopsCountsPolicy: maxTxSigopsCountsPolicy,
+		MaxScriptSizePolicy:     maxScriptSizePolicy,
+		MinMiningTxFee:          policy.MinMiningTxFee,
+	}, nil
+}
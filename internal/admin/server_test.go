@@ -0,0 +1,355 @@
+package admin_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ordishs/go-bitcoin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/admin/mocks"
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+)
+
+var testTokens = []admin.Token{
+	{Name: "viewer", Token: "viewer-secret", Role: admin.RoleViewer},
+	{Name: "operator", Token: "operator-secret", Role: admin.RoleOperator},
+	{Name: "admin", Token: "admin-secret", Role: admin.RoleAdmin},
+}
+
+func TestParseRole(t *testing.T) {
+	tt := []struct {
+		name string
+
+		expectedRole admin.Role
+		expectedErr  error
+	}{
+		{name: "viewer", expectedRole: admin.RoleViewer},
+		{name: "Operator", expectedRole: admin.RoleOperator},
+		{name: "admin", expectedRole: admin.RoleAdmin},
+		{name: "root", expectedErr: admin.ErrInvalidRole},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := admin.ParseRole(tc.name)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRole, actual)
+		})
+	}
+}
+
+func TestServer_Authorization(t *testing.T) {
+	tt := []struct {
+		name        string
+		clientToken string
+
+		expectedGetPolicyCode     codes.Code
+		expectedUnlockRecordsCode codes.Code
+		expectedReloadPolicyCode  codes.Code
+	}{
+		{
+			name:        "viewer",
+			clientToken: "viewer-secret",
+
+			expectedGetPolicyCode:     codes.OK,
+			expectedUnlockRecordsCode: codes.PermissionDenied,
+			expectedReloadPolicyCode:  codes.PermissionDenied,
+		},
+		{
+			name:        "operator",
+			clientToken: "operator-secret",
+
+			expectedGetPolicyCode:     codes.OK,
+			expectedUnlockRecordsCode: codes.OK,
+			expectedReloadPolicyCode:  codes.PermissionDenied,
+		},
+		{
+			name:        "admin",
+			clientToken: "admin-secret",
+
+			expectedGetPolicyCode:     codes.OK,
+			expectedUnlockRecordsCode: codes.OK,
+			expectedReloadPolicyCode:  codes.OK,
+		},
+		{
+			name:        "invalid token",
+			clientToken: "wrong",
+
+			expectedGetPolicyCode:     codes.Unauthenticated,
+			expectedUnlockRecordsCode: codes.Unauthenticated,
+			expectedReloadPolicyCode:  codes.Unauthenticated,
+		},
+	}
+
+	// given
+	const address = "localhost:8097"
+	policy := &bitcoin.Settings{MaxTxSizePolicy: 100_000_000, MaxScriptSizePolicy: 100_000_000, MaxTxSigopsCountsPolicy: 4_294_967_295, MinMiningTxFee: 0.00000001}
+	policyHandler := &mocks.PolicyHandlerMock{
+		PolicyFunc: func() *bitcoin.Settings { return policy },
+		ReloadPolicyFunc: func(_ context.Context) (*bitcoin.Settings, error) {
+			return policy, nil
+		},
+	}
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		UnlockRecordsFunc: func(_ context.Context, _ *metamorph_api.UnlockRecordsRequest, _ ...grpc.CallOption) (*metamorph_api.UnlockRecordsResponse, error) {
+			return &metamorph_api.UnlockRecordsResponse{RecordsAffected: 3}, nil
+		},
+	}
+
+	server, err := admin.NewServer(slog.Default(), metamorphClient, policyHandler, testTokens, grpc_utils.ServerConfig{
+		MaxMsgSize: 1000,
+		Name:       "admin_test",
+	})
+	require.NoError(t, err)
+	defer server.GracefulStop()
+
+	err = server.ListenAndServe(address)
+	require.NoError(t, err)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, err := grpc_utils.DialGRPC(address, "", 1000, nil, &config.GrpcAuthConfig{Token: tc.clientToken}, nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			client := admin_api.NewAdminAPIClient(conn)
+
+			// when
+			_, getPolicyErr := client.GetPolicy(context.Background(), &emptypb.Empty{})
+			_, unlockRecordsErr := client.UnlockRecords(context.Background(), &admin_api.UnlockRecordsRequest{Instance: "metamorph-1"})
+			_, reloadPolicyErr := client.ReloadPolicy(context.Background(), &emptypb.Empty{})
+
+			// then
+			require.Equal(t, tc.expectedGetPolicyCode, status.Code(getPolicyErr))
+			require.Equal(t, tc.expectedUnlockRecordsCode, status.Code(unlockRecordsErr))
+			require.Equal(t, tc.expectedReloadPolicyCode, status.Code(reloadPolicyErr))
+		})
+	}
+}
+
+func TestServer_ReplayCallbacks(t *testing.T) {
+	// given
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		ReplayCallbacksFunc: func(_ context.Context, in *metamorph_api.TransactionsStatusRequest, _ ...grpc.CallOption) (*metamorph_api.ReplayCallbacksResponse, error) {
+			require.Equal(t, []string{"tx-1", "tx-2"}, in.GetTxIDs())
+			return &metamorph_api.ReplayCallbacksResponse{TxIDs: []string{"tx-2"}}, nil
+		},
+	}
+
+	sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_replay_test"})
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	// when
+	actual, err := sut.ReplayCallbacks(context.Background(), &admin_api.TransactionsRequest{Txids: []string{"tx-1", "tx-2"}})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, actual.GetResults(), 2)
+	require.False(t, actual.GetResults()[0].GetSuccess())
+	require.NotEmpty(t, actual.GetResults()[0].GetError())
+	require.True(t, actual.GetResults()[1].GetSuccess())
+}
+
+func TestServer_ReprocessTransactions(t *testing.T) {
+	// given
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		ResubmitTransactionFunc: func(_ context.Context, in *metamorph_api.TransactionStatusRequest, _ ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+			if in.GetTxid() == "tx-1" {
+				return nil, status.Error(codes.Unknown, "transaction is not rejected")
+			}
+			return &metamorph_api.TransactionStatus{Txid: in.GetTxid(), Status: metamorph_api.Status_STORED}, nil
+		},
+	}
+
+	sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_reprocess_test"})
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	// when
+	actual, err := sut.ReprocessTransactions(context.Background(), &admin_api.TransactionsRequest{Txids: []string{"tx-1", "tx-2"}})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []*admin_api.TransactionResult{
+		{Txid: "tx-1", Error: "transaction is not rejected"},
+		{Txid: "tx-2", Success: true},
+	}, actual.GetResults())
+}
+
+func TestServer_Jobs(t *testing.T) {
+	// given
+	job := &metamorph_api.Job{Name: "ReAnnounceUnseen", Schedule: "@every 1m0s", Paused: true, Runs: 3}
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		ListJobsFunc: func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*metamorph_api.Jobs, error) {
+			return &metamorph_api.Jobs{Jobs: []*metamorph_api.Job{job}}, nil
+		},
+		PauseJobFunc: func(_ context.Context, in *metamorph_api.JobRequest, _ ...grpc.CallOption) (*metamorph_api.Job, error) {
+			require.Equal(t, "ReAnnounceUnseen", in.GetName())
+			return job, nil
+		},
+		TriggerJobFunc: func(_ context.Context, _ *metamorph_api.JobRequest, _ ...grpc.CallOption) (*metamorph_api.Job, error) {
+			return nil, status.Error(codes.Unknown, "job is already running")
+		},
+	}
+
+	sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_jobs_test"})
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	// when
+	jobs, listErr := sut.ListJobs(context.Background(), &emptypb.Empty{})
+	paused, pauseErr := sut.PauseJob(context.Background(), &admin_api.JobRequest{Name: "ReAnnounceUnseen"})
+	_, triggerErr := sut.TriggerJob(context.Background(), &admin_api.JobRequest{Name: "ReAnnounceUnseen"})
+
+	// then
+	require.NoError(t, listErr)
+	require.NoError(t, pauseErr)
+	expected := &admin_api.Job{Name: "ReAnnounceUnseen", Schedule: "@every 1m0s", Paused: true, Runs: 3}
+	require.Equal(t, []*admin_api.Job{expected}, jobs.GetJobs())
+	require.Equal(t, expected, paused)
+	require.ErrorContains(t, triggerErr, "job is already running")
+}
+
+func TestServer_AnnotateTransaction(t *testing.T) {
+	// given
+	const address = "localhost:8098"
+	createdAt := timestamppb.New(time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC))
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		AnnotateTransactionFunc: func(_ context.Context, in *metamorph_api.AnnotateTransactionRequest, _ ...grpc.CallOption) (*metamorph_api.Annotation, error) {
+			return &metamorph_api.Annotation{Note: in.GetNote(), Labels: in.GetLabels(), Author: in.GetAuthor(), CreatedAt: createdAt}, nil
+		},
+	}
+
+	server, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{MaxMsgSize: 1000, Name: "admin_annotate_test"})
+	require.NoError(t, err)
+	defer server.GracefulStop()
+
+	err = server.ListenAndServe(address)
+	require.NoError(t, err)
+
+	conn, err := grpc_utils.DialGRPC(address, "", 1000, nil, &config.GrpcAuthConfig{Token: "operator-secret"}, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := admin_api.NewAdminAPIClient(conn)
+
+	// when
+	annotation, err := client.AnnotateTransaction(context.Background(), &admin_api.AnnotateTransactionRequest{
+		Txid:   "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118",
+		Note:   "customer dispute #123",
+		Labels: []string{"dispute"},
+	})
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, metamorphClient.AnnotateTransactionCalls(), 1)
+	require.Equal(t, "operator", metamorphClient.AnnotateTransactionCalls()[0].In.GetAuthor())
+	require.Equal(t, "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118", annotation.GetTxid())
+	require.Equal(t, "customer dispute #123", annotation.GetNote())
+	require.Equal(t, []string{"dispute"}, annotation.GetLabels())
+	require.Equal(t, "operator", annotation.GetAuthor())
+	require.Equal(t, createdAt.AsTime(), annotation.GetCreatedAt().AsTime())
+}
+
+func TestServer_GetAbuseScores(t *testing.T) {
+	lastSeen := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	scores := []abuse.KeyScore{
+		{Key: "key:0123456789abcdef", Score: 0.8, Signals: abuse.Signals{BurstRate: 1, RejectionRate: 0.6}, Throttling: true, Submitted: 100, Throttled: 40, LastSeen: lastSeen},
+		{Key: "ip:192.0.2.1", Score: 0.1, Signals: abuse.Signals{BurstRate: 0.1}, Submitted: 2, LastSeen: lastSeen},
+	}
+
+	tt := []struct {
+		name   string
+		scorer admin.AbuseScorer
+		limit  uint32
+
+		expectedKeys  []string
+		expectedError error
+	}{
+		{
+			name:   "all scores",
+			scorer: &mocks.AbuseScorerMock{AbuseScoresFunc: func() []abuse.KeyScore { return scores }},
+
+			expectedKeys: []string{"key:0123456789abcdef", "ip:192.0.2.1"},
+		},
+		{
+			name:   "limited",
+			scorer: &mocks.AbuseScorerMock{AbuseScoresFunc: func() []abuse.KeyScore { return scores }},
+			limit:  1,
+
+			expectedKeys: []string{"key:0123456789abcdef"},
+		},
+		{
+			name: "abuse scoring disabled",
+
+			expectedError: admin.ErrAbuseScoringDisabled,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var opts []func(*admin.Server)
+			if tc.scorer != nil {
+				opts = append(opts, admin.WithAbuseScorer(tc.scorer))
+			}
+
+			sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_abuse_test"}, opts...)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.GetAbuseScores(context.Background(), &admin_api.AbuseScoresRequest{Limit: tc.limit})
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			keys := make([]string, 0, len(actual.GetScores()))
+			for _, score := range actual.GetScores() {
+				keys = append(keys, score.GetKey())
+			}
+			require.Equal(t, tc.expectedKeys, keys)
+
+			first := actual.GetScores()[0]
+			require.InDelta(t, 0.8, first.GetScore(), 0.001)
+			require.InDelta(t, 0.6, first.GetSignals().GetRejectionRate(), 0.001)
+			require.True(t, first.GetThrottling())
+			require.Equal(t, uint64(40), first.GetThrottled())
+			require.Equal(t, lastSeen, first.GetLastSeen().AsTime())
+		})
+	}
+}
+
+func TestNewServer_NoTokens(t *testing.T) {
+	// when
+	_, err := admin.NewServer(slog.Default(), nil, nil, nil, grpc_utils.ServerConfig{})
+
+	// then
+	require.ErrorIs(t, err, admin.ErrNoTokens)
+}
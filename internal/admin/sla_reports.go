@@ -0,0 +1,251 @@
+package admin
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/slareport"
+)
+
+// SLAReportsPath is the path of the endpoint of the SLA reports
+const SLAReportsPath = PathPrefix + "sla-reports"
+
+var ErrInvalidTime = errors.New("invalid time, expected RFC 3339 or YYYY-MM-DD")
+
+var slaReportCSVHeader = []string{
+	"period", "period_start", "tenant", "transactions", "seen_on_network", "p95_time_to_seen_ms", "mined", "p95_time_to_mined_ms",
+	"callbacks", "callbacks_delivered", "callbacks_failed", "callback_success_rate", "computed_at",
+}
+
+// SLAReporter returns the SLA reports per tenant, it is implemented by Server.
+type SLAReporter interface {
+	GetSLAReports(ctx context.Context, req *admin_api.SLAReportsRequest) (*admin_api.SLAReports, error)
+}
+
+// SLAReportResponse is the SLA report returned by the HTTP endpoint of the SLA reports.
+type SLAReportResponse struct {
+	Period              string    `json:"period"`
+	PeriodStart         time.Time `json:"periodStart"`
+	Tenant              string    `json:"tenant"`
+	Transactions        int64     `json:"transactions"`
+	SeenOnNetwork       int64     `json:"seenOnNetwork"`
+	P95TimeToSeenMs     int64     `json:"p95TimeToSeenMs"`
+	Mined               int64     `json:"mined"`
+	P95TimeToMinedMs    int64     `json:"p95TimeToMinedMs"`
+	Callbacks           int64     `json:"callbacks"`
+	CallbacksDelivered  int64     `json:"callbacksDelivered"`
+	CallbacksFailed     int64     `json:"callbacksFailed"`
+	CallbackSuccessRate float64   `json:"callbackSuccessRate"`
+	ComputedAt          time.Time `json:"computedAt"`
+}
+
+// GetSLAReports merges the SLA reports of the transactions computed by metamorph with the delivery reports of their
+// callbacks computed by the callbacker by period and tenant. The callbacker reports are omitted if the callbacker is
+// not configured.
+func (s *Server) GetSLAReports(ctx context.Context, req *admin_api.SLAReportsRequest) (*admin_api.SLAReports, error) {
+	period := req.GetPeriod()
+	if period == "" {
+		period = slareport.Daily
+	}
+
+	txReports, err := s.metamorph.GetSLAReports(ctx, &metamorph_api.SLAReportsRequest{
+		Period: period,
+		From:   req.GetFrom(),
+		To:     req.GetTo(),
+		Tenant: req.GetTenant(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		periodStart int64
+		tenant      string
+	}
+
+	reports := make(map[key]*admin_api.SLAReport)
+	for _, r := range txReports.GetReports() {
+		reports[key{r.GetPeriodStart().AsTime().Unix(), r.GetTenant()}] = &admin_api.SLAReport{
+			Period:           r.GetPeriod(),
+			PeriodStart:      r.GetPeriodStart(),
+			Tenant:           r.GetTenant(),
+			Transactions:     r.GetTransactions(),
+			SeenOnNetwork:    r.GetSeenOnNetwork(),
+			P95TimeToSeenMs:  r.GetP95TimeToSeenMs(),
+			Mined:            r.GetMined(),
+			P95TimeToMinedMs: r.GetP95TimeToMinedMs(),
+			ComputedAt:       r.GetComputedAt(),
+		}
+	}
+
+	if s.callbacker != nil {
+		callbackReports, err := s.callbacker.GetSLAReports(ctx, &callbacker_api.SLAReportsRequest{
+			Period: period,
+			From:   req.GetFrom(),
+			To:     req.GetTo(),
+			Tenant: req.GetTenant(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range callbackReports.GetReports() {
+			k := key{r.GetPeriodStart().AsTime().Unix(), r.GetTenant()}
+			report, found := reports[k]
+			if !found {
+				report = &admin_api.SLAReport{Period: r.GetPeriod(), PeriodStart: r.GetPeriodStart(), Tenant: r.GetTenant(), ComputedAt: r.GetComputedAt()}
+				reports[k] = report
+			}
+
+			report.Callbacks = r.GetCallbacks()
+			report.CallbacksDelivered = r.GetDelivered()
+			report.CallbacksFailed = r.GetFailed()
+			if completed := r.GetDelivered() + r.GetFailed(); completed > 0 {
+				report.CallbackSuccessRate = float64(r.GetDelivered()) / float64(completed)
+			}
+		}
+	}
+
+	result := &admin_api.SLAReports{Reports: make([]*admin_api.SLAReport, 0, len(reports))}
+	for _, report := range reports {
+		result.Reports = append(result.Reports, report)
+	}
+
+	sort.Slice(result.Reports, func(i, j int) bool {
+		a, b := result.Reports[i], result.Reports[j]
+		if !a.GetPeriodStart().AsTime().Equal(b.GetPeriodStart().AsTime()) {
+			return a.GetPeriodStart().AsTime().Before(b.GetPeriodStart().AsTime())
+		}
+		return a.GetTenant() < b.GetTenant()
+	})
+
+	return result, nil
+}
+
+// RegisterSLAReportHandlers registers the HTTP endpoint returning the SLA reports per tenant as JSON, or as CSV with
+// the query parameter format=csv. The reports are selected by the query parameters period, daily if not given, from,
+// to and tenant. Listing the reports requires the role viewer.
+func RegisterSLAReportHandlers(e *echo.Echo, logger *slog.Logger, reporter SLAReporter, tokens []Token) {
+	auth := newAuthorizer(logger, tokens)
+
+	e.GET(SLAReportsPath, auth.httpRole(RoleViewer, func(c echo.Context) error {
+		req := &admin_api.SLAReportsRequest{Period: c.QueryParam("period"), Tenant: c.QueryParam("tenant")}
+		if req.Period == "" {
+			req.Period = slareport.Daily
+		}
+
+		err := slareport.ValidatePeriod(req.GetPeriod())
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, errorResponse{Error: err.Error()})
+		}
+
+		req.From, err = parseQueryTime(c.QueryParam("from"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, errorResponse{Error: err.Error()})
+		}
+
+		req.To, err = parseQueryTime(c.QueryParam("to"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, errorResponse{Error: err.Error()})
+		}
+
+		reports, err := reporter.GetSLAReports(c.Request().Context(), req)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, errorResponse{Error: err.Error()})
+		}
+
+		result := make([]SLAReportResponse, 0, len(reports.GetReports()))
+		for _, report := range reports.GetReports() {
+			result = append(result, toSLAReportResponse(report))
+		}
+
+		if c.QueryParam("format") != "csv" {
+			return c.JSON(http.StatusOK, result)
+		}
+
+		c.Response().Header().Set(echo.HeaderContentType, "text/csv")
+		c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="sla-reports-`+req.GetPeriod()+`.csv"`)
+		c.Response().WriteHeader(http.StatusOK)
+
+		return writeSLAReportsCSV(c.Response(), result)
+	}))
+}
+
+// parseQueryTime parses the time of a query parameter, which is nil if the parameter is empty.
+func parseQueryTime(value string) (*timestamppb.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		t, err := time.Parse(layout, value)
+		if err == nil {
+			return timestamppb.New(t), nil
+		}
+	}
+
+	return nil, ErrInvalidTime
+}
+
+func writeSLAReportsCSV(w *echo.Response, reports []SLAReportResponse) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(slaReportCSVHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range reports {
+		err = writer.Write([]string{
+			r.Period,
+			r.PeriodStart.Format(time.RFC3339),
+			r.Tenant,
+			strconv.FormatInt(r.Transactions, 10),
+			strconv.FormatInt(r.SeenOnNetwork, 10),
+			strconv.FormatInt(r.P95TimeToSeenMs, 10),
+			strconv.FormatInt(r.Mined, 10),
+			strconv.FormatInt(r.P95TimeToMinedMs, 10),
+			strconv.FormatInt(r.Callbacks, 10),
+			strconv.FormatInt(r.CallbacksDelivered, 10),
+			strconv.FormatInt(r.CallbacksFailed, 10),
+			strconv.FormatFloat(r.CallbackSuccessRate, 'f', 4, 64),
+			r.ComputedAt.Format(time.RFC3339),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func toSLAReportResponse(report *admin_api.SLAReport) SLAReportResponse {
+	return SLAReportResponse{
+		Period:              report.GetPeriod(),
+		PeriodStart:         report.GetPeriodStart().AsTime(),
+		Tenant:              report.GetTenant(),
+		Transactions:        report.GetTransactions(),
+		SeenOnNetwork:       report.GetSeenOnNetwork(),
+		P95TimeToSeenMs:     report.GetP95TimeToSeenMs(),
+		Mined:               report.GetMined(),
+		P95TimeToMinedMs:    report.GetP95TimeToMinedMs(),
+		Callbacks:           report.GetCallbacks(),
+		CallbacksDelivered:  report.GetCallbacksDelivered(),
+		CallbacksFailed:     report.GetCallbacksFailed(),
+		CallbackSuccessRate: report.GetCallbackSuccessRate(),
+		ComputedAt:          report.GetComputedAt().AsTime(),
+	}
+}
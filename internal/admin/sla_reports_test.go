@@ -0,0 +1,238 @@
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/admin/mocks"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	cbcMocks "github.com/bitcoin-sv/arc/internal/callbacker/mocks"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+)
+
+func TestServer_GetSLAReports(t *testing.T) {
+	day := timestamppb.New(time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC))
+	nextDay := timestamppb.New(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC))
+	computedAt := timestamppb.New(time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC))
+
+	tt := []struct {
+		name           string
+		withCallbacker bool
+		callbackerErr  error
+
+		expectedReports []*admin_api.SLAReport
+		expectedErr     bool
+	}{
+		{
+			name:           "merged with callback reports",
+			withCallbacker: true,
+
+			expectedReports: []*admin_api.SLAReport{
+				{Period: "daily", PeriodStart: day, Tenant: "exchange-a", Transactions: 10, SeenOnNetwork: 9, P95TimeToSeenMs: 1500, Mined: 8, P95TimeToMinedMs: 600000,
+					Callbacks: 20, CallbacksDelivered: 15, CallbacksFailed: 5, CallbackSuccessRate: 0.75, ComputedAt: computedAt},
+				{Period: "daily", PeriodStart: day, Tenant: "exchange-b", Callbacks: 2, ComputedAt: computedAt},
+				{Period: "daily", PeriodStart: nextDay, Tenant: "exchange-a", Transactions: 1, SeenOnNetwork: 1, P95TimeToSeenMs: 900, ComputedAt: computedAt},
+			},
+		},
+		{
+			name: "without callbacker",
+
+			expectedReports: []*admin_api.SLAReport{
+				{Period: "daily", PeriodStart: day, Tenant: "exchange-a", Transactions: 10, SeenOnNetwork: 9, P95TimeToSeenMs: 1500, Mined: 8, P95TimeToMinedMs: 600000, ComputedAt: computedAt},
+				{Period: "daily", PeriodStart: nextDay, Tenant: "exchange-a", Transactions: 1, SeenOnNetwork: 1, P95TimeToSeenMs: 900, ComputedAt: computedAt},
+			},
+		},
+		{
+			name:           "callbacker failed",
+			withCallbacker: true,
+			callbackerErr:  errors.New("connection refused"),
+
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+				GetSLAReportsFunc: func(_ context.Context, in *metamorph_api.SLAReportsRequest, _ ...grpc.CallOption) (*metamorph_api.SLAReports, error) {
+					require.Equal(t, "daily", in.GetPeriod())
+
+					return &metamorph_api.SLAReports{Reports: []*metamorph_api.SLAReport{
+						{Period: "daily", PeriodStart: nextDay, Tenant: "exchange-a", Transactions: 1, SeenOnNetwork: 1, P95TimeToSeenMs: 900, ComputedAt: computedAt},
+						{Period: "daily", PeriodStart: day, Tenant: "exchange-a", Transactions: 10, SeenOnNetwork: 9, P95TimeToSeenMs: 1500, Mined: 8, P95TimeToMinedMs: 600000, ComputedAt: computedAt},
+					}}, nil
+				},
+			}
+			callbackerClient := &cbcMocks.CallbackerAPIClientMock{
+				GetSLAReportsFunc: func(_ context.Context, in *callbacker_api.SLAReportsRequest, _ ...grpc.CallOption) (*callbacker_api.SLAReports, error) {
+					require.Equal(t, "daily", in.GetPeriod())
+					if tc.callbackerErr != nil {
+						return nil, tc.callbackerErr
+					}
+
+					return &callbacker_api.SLAReports{Reports: []*callbacker_api.SLAReport{
+						{Period: "daily", PeriodStart: day, Tenant: "exchange-a", Callbacks: 20, Delivered: 15, Failed: 5, ComputedAt: computedAt},
+						{Period: "daily", PeriodStart: day, Tenant: "exchange-b", Callbacks: 2, ComputedAt: computedAt},
+					}}, nil
+				},
+			}
+
+			var opts []func(*admin.Server)
+			if tc.withCallbacker {
+				opts = append(opts, admin.WithCallbacker(callbackerClient))
+			}
+
+			sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_sla_reports_test"}, opts...)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.GetSLAReports(context.Background(), &admin_api.SLAReportsRequest{})
+
+			// then
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, actual.GetReports(), len(tc.expectedReports))
+			for i, expected := range tc.expectedReports {
+				require.Equal(t, expected.String(), actual.GetReports()[i].String())
+			}
+		})
+	}
+}
+
+func TestRegisterSLAReportHandlers(t *testing.T) {
+	// given
+	reporter := &mocks.SLAReporterMock{
+		GetSLAReportsFunc: func(_ context.Context, req *admin_api.SLAReportsRequest) (*admin_api.SLAReports, error) {
+			if req.GetTenant() == "unavailable" {
+				return nil, errors.New("metamorph unavailable")
+			}
+
+			return &admin_api.SLAReports{Reports: []*admin_api.SLAReport{{
+				Period:              req.GetPeriod(),
+				PeriodStart:         req.GetFrom(),
+				Tenant:              "exchange-a",
+				Transactions:        10,
+				SeenOnNetwork:       9,
+				P95TimeToSeenMs:     1500,
+				Mined:               8,
+				P95TimeToMinedMs:    600000,
+				Callbacks:           20,
+				CallbacksDelivered:  15,
+				CallbacksFailed:     5,
+				CallbackSuccessRate: 0.75,
+				ComputedAt:          timestamppb.New(time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)),
+			}}}, nil
+		},
+	}
+
+	e := echo.New()
+	admin.RegisterSLAReportHandlers(e, slog.Default(), reporter, testTokens)
+
+	tt := []struct {
+		name  string
+		query string
+		token string
+
+		expectedCode        int
+		expectedContentType string
+		expectedBody        string
+	}{
+		{
+			name:  "without token",
+			query: "?from=2026-10-13",
+
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:  "json",
+			query: "?from=2026-10-13",
+			token: "viewer-secret",
+
+			expectedCode:        http.StatusOK,
+			expectedContentType: echo.MIMEApplicationJSON,
+		},
+		{
+			name:  "csv",
+			query: "?period=weekly&from=2026-10-12T00:00:00Z&format=csv",
+			token: "viewer-secret",
+
+			expectedCode:        http.StatusOK,
+			expectedContentType: "text/csv",
+			expectedBody: "period,period_start,tenant,transactions,seen_on_network,p95_time_to_seen_ms,mined,p95_time_to_mined_ms,callbacks,callbacks_delivered,callbacks_failed,callback_success_rate,computed_at\n" +
+				"weekly,2026-10-12T00:00:00Z,exchange-a,10,9,1500,8,600000,20,15,5,0.7500,2026-10-14T12:00:00Z\n",
+		},
+		{
+			name:  "unknown period",
+			query: "?period=monthly",
+			token: "viewer-secret",
+
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid time",
+			query: "?from=yesterday",
+			token: "viewer-secret",
+
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "reports unavailable",
+			query: "?tenant=unavailable",
+			token: "viewer-secret",
+
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, admin.SLAReportsPath+tc.query, nil)
+			if tc.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tc.token)
+			}
+			rec := httptest.NewRecorder()
+
+			// when
+			e.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+			require.Contains(t, rec.Header().Get(echo.HeaderContentType), tc.expectedContentType)
+
+			if tc.expectedBody != "" {
+				require.Equal(t, tc.expectedBody, rec.Body.String())
+				return
+			}
+
+			var actual []admin.SLAReportResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			require.Len(t, actual, 1)
+			require.Equal(t, "daily", actual[0].Period)
+			require.Equal(t, time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC), actual[0].PeriodStart)
+			require.InDelta(t, 0.75, actual[0].CallbackSuccessRate, 0.001)
+		})
+	}
+}
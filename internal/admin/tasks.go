@@ -0,0 +1,174 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/tasks"
+)
+
+const (
+	replayCallbacksTask       = "replay-callbacks"
+	reprocessTransactionsTask = "reprocess-transactions"
+	pruneDataTask             = "prune-data"
+
+	// replayBatchSize is the number of transactions whose callbacks are replayed per request to metamorph
+	replayBatchSize = 100
+)
+
+var ErrInvalidRetentionDays = errors.New("retention days must be at least 1")
+
+func (s *Server) startTask(name string, fn tasks.Func) (*admin_api.TransactionsResponse, error) {
+	if s.tasks == nil {
+		return nil, ErrTasksDisabled
+	}
+
+	return &admin_api.TransactionsResponse{TaskId: s.tasks.Start(name, fn).ID}, nil
+}
+
+func (s *Server) replayCallbacksInBatches(ctx context.Context, txIDs []string, progress *tasks.Progress) error {
+	progress.SetTotal(uint64(len(txIDs)))
+
+	for start := 0; start < len(txIDs); start += replayBatchSize {
+		results, err := s.replayCallbacks(ctx, txIDs[start:min(start+replayBatchSize, len(txIDs))])
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			if result.GetSuccess() {
+				progress.Done(1)
+				continue
+			}
+			progress.Failed(1)
+		}
+	}
+
+	return nil
+}
+
+// PruneData deletes the data older than the retention days of metamorph and, if configured, of blocktx one after the
+// other. The progress of the task is the number of finished steps.
+func (s *Server) PruneData(_ context.Context, req *admin_api.PruneDataRequest) (*admin_api.Task, error) {
+	if s.tasks == nil {
+		return nil, ErrTasksDisabled
+	}
+
+	if req.GetRetentionDays() < 1 {
+		return nil, status.Error(codes.InvalidArgument, ErrInvalidRetentionDays.Error())
+	}
+
+	steps := []func(ctx context.Context) error{
+		func(ctx context.Context) error {
+			_, err := s.metamorph.ClearData(ctx, &metamorph_api.ClearDataRequest{RetentionDays: req.GetRetentionDays()})
+			return err
+		},
+	}
+	if s.blocktx != nil {
+		steps = append(steps,
+			func(ctx context.Context) error {
+				_, err := s.blocktx.ClearBlocks(ctx, &blocktx_api.ClearData{RetentionDays: req.GetRetentionDays()})
+				return err
+			},
+			func(ctx context.Context) error {
+				_, err := s.blocktx.ClearRegisteredTransactions(ctx, &blocktx_api.ClearData{RetentionDays: req.GetRetentionDays()})
+				return err
+			},
+		)
+	}
+
+	task := s.tasks.Start(pruneDataTask, func(ctx context.Context, progress *tasks.Progress) error {
+		progress.SetTotal(uint64(len(steps)))
+		for i, step := range steps {
+			err := step(ctx)
+			if err != nil {
+				progress.Failed(1)
+				return fmt.Errorf("step %d failed: %w", i+1, err)
+			}
+			progress.Done(1)
+		}
+
+		return nil
+	})
+
+	return toTask(task), nil
+}
+
+func (s *Server) ListTasks(_ context.Context, _ *emptypb.Empty) (*admin_api.Tasks, error) {
+	if s.tasks == nil {
+		return nil, ErrTasksDisabled
+	}
+
+	list := s.tasks.List()
+	result := &admin_api.Tasks{Tasks: make([]*admin_api.Task, 0, len(list))}
+	for _, task := range list {
+		result.Tasks = append(result.Tasks, toTask(task))
+	}
+
+	return result, nil
+}
+
+func (s *Server) GetTask(_ context.Context, req *admin_api.TaskRequest) (*admin_api.Task, error) {
+	if s.tasks == nil {
+		return nil, ErrTasksDisabled
+	}
+
+	task, err := s.tasks.Get(req.GetId())
+	if err != nil {
+		return nil, toTaskError(err)
+	}
+
+	return toTask(task), nil
+}
+
+func (s *Server) CancelTask(_ context.Context, req *admin_api.TaskRequest) (*admin_api.Task, error) {
+	if s.tasks == nil {
+		return nil, ErrTasksDisabled
+	}
+
+	task, err := s.tasks.Cancel(req.GetId())
+	if err != nil {
+		return nil, toTaskError(err)
+	}
+
+	return toTask(task), nil
+}
+
+func toTaskError(err error) error {
+	switch {
+	case errors.Is(err, tasks.ErrTaskNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, tasks.ErrTaskNotRunning):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+func toTask(task tasks.Task) *admin_api.Task {
+	result := &admin_api.Task{
+		Id:        task.ID,
+		Name:      task.Name,
+		Status:    string(task.Status),
+		Total:     task.Total,
+		Done:      task.Done,
+		Failed:    task.Failed,
+		Percent:   task.Percent(),
+		Error:     task.Error,
+		StartedAt: timestamppb.New(task.StartedAt),
+	}
+	if !task.FinishedAt.IsZero() {
+		result.FinishedAt = timestamppb.New(task.FinishedAt)
+	}
+
+	return result
+}
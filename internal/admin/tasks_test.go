@@ -0,0 +1,197 @@
+package admin_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/bitcoin-sv/arc/internal/admin"
+	"github.com/bitcoin-sv/arc/internal/admin/admin_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/tasks"
+)
+
+func waitTaskFinished(t *testing.T, sut *admin.Server, id string) *admin_api.Task {
+	t.Helper()
+
+	var task *admin_api.Task
+	require.Eventually(t, func() bool {
+		var err error
+		task, err = sut.GetTask(context.Background(), &admin_api.TaskRequest{Id: id})
+		require.NoError(t, err)
+		return task.GetStatus() != string(tasks.StatusRunning)
+	}, time.Second, 5*time.Millisecond)
+
+	return task
+}
+
+func TestServer_ReprocessTransactions_Async(t *testing.T) {
+	// given
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		ResubmitTransactionFunc: func(_ context.Context, in *metamorph_api.TransactionStatusRequest, _ ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+			if in.GetTxid() == "tx-1" {
+				return nil, status.Error(codes.Unknown, "transaction is not rejected")
+			}
+			return &metamorph_api.TransactionStatus{Txid: in.GetTxid(), Status: metamorph_api.Status_STORED}, nil
+		},
+	}
+
+	manager := tasks.New(slog.Default())
+	defer manager.Shutdown()
+
+	sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_reprocess_async_test"}, admin.WithTasks(manager))
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	// when
+	actual, err := sut.ReprocessTransactions(context.Background(), &admin_api.TransactionsRequest{Txids: []string{"tx-1", "tx-2", "tx-3"}, Async: true})
+	require.NoError(t, err)
+	task := waitTaskFinished(t, sut, actual.GetTaskId())
+
+	// then
+	require.Empty(t, actual.GetResults())
+	require.Equal(t, string(tasks.StatusSucceeded), task.GetStatus())
+	require.Equal(t, uint64(3), task.GetTotal())
+	require.Equal(t, uint64(2), task.GetDone())
+	require.Equal(t, uint64(1), task.GetFailed())
+	require.InDelta(t, 100, task.GetPercent(), 0.001)
+}
+
+func TestServer_PruneData(t *testing.T) {
+	tt := []struct {
+		name          string
+		retentionDays int32
+		blocktxErr    error
+
+		expectedStatus  tasks.Status
+		expectedDone    uint64
+		expectedErrCode codes.Code
+	}{
+		{
+			name:          "pruned",
+			retentionDays: 14,
+
+			expectedStatus: tasks.StatusSucceeded,
+			expectedDone:   3,
+		},
+		{
+			name:          "blocktx failed",
+			retentionDays: 14,
+			blocktxErr:    errors.New("connection refused"),
+
+			expectedStatus: tasks.StatusFailed,
+			expectedDone:   1,
+		},
+		{
+			name: "invalid retention days",
+
+			expectedErrCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+				ClearDataFunc: func(_ context.Context, in *metamorph_api.ClearDataRequest, _ ...grpc.CallOption) (*metamorph_api.ClearDataResponse, error) {
+					require.Equal(t, tc.retentionDays, in.GetRetentionDays())
+					return &metamorph_api.ClearDataResponse{RecordsAffected: 10}, nil
+				},
+			}
+			blocktxClient := &btxMocks.BlockTxAPIClientMock{
+				ClearBlocksFunc: func(_ context.Context, _ *blocktx_api.ClearData, _ ...grpc.CallOption) (*blocktx_api.RowsAffectedResponse, error) {
+					return &blocktx_api.RowsAffectedResponse{Rows: 5}, tc.blocktxErr
+				},
+				ClearRegisteredTransactionsFunc: func(_ context.Context, _ *blocktx_api.ClearData, _ ...grpc.CallOption) (*blocktx_api.RowsAffectedResponse, error) {
+					return &blocktx_api.RowsAffectedResponse{Rows: 5}, nil
+				},
+			}
+
+			manager := tasks.New(slog.Default())
+			defer manager.Shutdown()
+
+			sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_prune_test"},
+				admin.WithTasks(manager),
+				admin.WithBlocktx(blocktxClient),
+			)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			started, err := sut.PruneData(context.Background(), &admin_api.PruneDataRequest{RetentionDays: tc.retentionDays})
+
+			// then
+			if tc.expectedErrCode != codes.OK {
+				require.Equal(t, tc.expectedErrCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+
+			task := waitTaskFinished(t, sut, started.GetId())
+			require.Equal(t, string(tc.expectedStatus), task.GetStatus())
+			require.Equal(t, uint64(3), task.GetTotal())
+			require.Equal(t, tc.expectedDone, task.GetDone())
+			require.NotNil(t, task.GetFinishedAt())
+		})
+	}
+}
+
+func TestServer_CancelTask(t *testing.T) {
+	// given
+	metamorphClient := &mtmMocks.MetaMorphAPIClientMock{
+		ResubmitTransactionFunc: func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, _ ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+
+	manager := tasks.New(slog.Default())
+	defer manager.Shutdown()
+
+	sut, err := admin.NewServer(slog.Default(), metamorphClient, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_cancel_test"}, admin.WithTasks(manager))
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	started, err := sut.ReprocessTransactions(context.Background(), &admin_api.TransactionsRequest{Txids: []string{"tx-1", "tx-2"}, Async: true})
+	require.NoError(t, err)
+
+	// when
+	_, err = sut.CancelTask(context.Background(), &admin_api.TaskRequest{Id: started.GetTaskId()})
+	require.NoError(t, err)
+	task := waitTaskFinished(t, sut, started.GetTaskId())
+	listed, listErr := sut.ListTasks(context.Background(), &emptypb.Empty{})
+	_, unknownErr := sut.GetTask(context.Background(), &admin_api.TaskRequest{Id: "unknown"})
+
+	// then
+	require.Equal(t, string(tasks.StatusCancelled), task.GetStatus())
+	require.NoError(t, listErr)
+	require.Len(t, listed.GetTasks(), 1)
+	require.Equal(t, codes.NotFound, status.Code(unknownErr))
+}
+
+func TestServer_TasksDisabled(t *testing.T) {
+	// given
+	sut, err := admin.NewServer(slog.Default(), nil, nil, testTokens, grpc_utils.ServerConfig{Name: "admin_tasks_disabled_test"})
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	// when
+	_, replayErr := sut.ReplayCallbacks(context.Background(), &admin_api.TransactionsRequest{Txids: []string{"tx-1"}, Async: true})
+	_, listErr := sut.ListTasks(context.Background(), &emptypb.Empty{})
+
+	// then
+	require.ErrorIs(t, replayErr, admin.ErrTasksDisabled)
+	require.ErrorIs(t, listErr, admin.ErrTasksDisabled)
+}
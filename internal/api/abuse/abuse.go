@@ -0,0 +1,420 @@
+// Package abuse scores the submissions of each client, identified by its API key or address, for spam and abuse. The
+// score combines the burst rate of the client, the script templates and the fee level of the submitted transaction
+// and the rate at which transactions of the client were rejected recently. Clients whose score exceeds the thresholds
+// are throttled or rejected.
+package abuse
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+const (
+	windowDefault       = time.Minute
+	burstRateDefault    = 100
+	feeFactorDefault    = 2
+	minSamplesDefault   = 20
+	throttleDefault     = 0.6
+	rejectDefault       = 0.9
+	throttleRateDefault = 1
+	// idleWindows is the number of windows without submissions after which the score of a client is dropped
+	idleWindows = 10
+	// outcomeDecay is the factor by which the counts of the outcomes of a client decay with every window
+	outcomeDecay = 0.5
+)
+
+var ErrFailedToRegisterStats = errors.New("failed to register abuse scoring collector")
+
+// Decision is the consequence of the score of a submission.
+type Decision int
+
+const (
+	DecisionAllow Decision = iota
+	// DecisionThrottle rejects the submission because the client exceeded the throttled rate
+	DecisionThrottle
+	DecisionReject
+)
+
+var decisionNames = map[Decision]string{
+	DecisionAllow:    "allow",
+	DecisionThrottle: "throttle",
+	DecisionReject:   "reject",
+}
+
+func (d Decision) String() string {
+	return decisionNames[d]
+}
+
+// Signals are the components of the score, each between 0 and 1.
+type Signals struct {
+	// BurstRate is the submission rate of the client relative to the burst rate
+	BurstRate float64
+	// Template is the highest score of the templates of the outputs of the transaction
+	Template float64
+	// FeeLevel is 1 if the transaction pays no more than the required fee and decreases to 0 at the fee factor
+	FeeLevel float64
+	// RejectionRate is the share of the recently submitted transactions of the client which were rejected
+	RejectionRate float64
+}
+
+// Weights are the weights of the signals in the score.
+type Weights struct {
+	BurstRate     float64
+	Template      float64
+	FeeLevel      float64
+	RejectionRate float64
+}
+
+// TemplateScore is the score of transactions with outputs of the template, e.g. 1 for `nonstandard`. The templates are
+// the buckets of validator.TemplateBuckets.
+type TemplateScore struct {
+	Template string
+	Score    float64
+}
+
+// KeyScore is the score of the last submission of a client.
+type KeyScore struct {
+	Key        string
+	Score      float64
+	Signals    Signals
+	Throttling bool
+	Submitted  uint64
+	Throttled  uint64
+	Rejected   uint64
+	LastSeen   time.Time
+}
+
+// Scorer scores the submissions of the clients. The submission rate of a client is measured in fixed windows, of
+// which the previous window is weighted by the part of it overlapping with the sliding window ending now.
+type Scorer struct {
+	logger         *slog.Logger
+	now            func() time.Time
+	window         time.Duration
+	burstRate      float64
+	templates      []validator.ScriptTemplate
+	templateScores map[string]float64
+	feeFactor      float64
+	minSamples     float64
+	weights        Weights
+	throttle       float64
+	reject         float64
+	throttleRate   float64
+
+	mu        sync.Mutex
+	keys      map[string]*key
+	lastPrune time.Time
+
+	decisions *prometheus.CounterVec
+	scores    prometheus.Histogram
+}
+
+type key struct {
+	windowStart time.Time
+	count       float64
+	previous    float64
+	accepted    float64
+	rejected    float64
+	score       KeyScore
+}
+
+// WithWindow sets the duration of the windows in which the submission and rejection rates of the clients are measured.
+func WithWindow(window time.Duration) func(*Scorer) {
+	return func(s *Scorer) {
+		s.window = window
+	}
+}
+
+// WithBurstRate sets the submission rate in txs per second at which the burst rate signal of a client is at its maximum.
+func WithBurstRate(rate float64) func(*Scorer) {
+	return func(s *Scorer) {
+		s.burstRate = rate
+	}
+}
+
+// WithTemplateScores sets the scores of the templates of the outputs, the templates given are the custom templates of
+// the buckets, e.g. `prefix:<hex>` of a protocol. Outputs of templates without score have a score of 0.
+func WithTemplateScores(templates []validator.ScriptTemplate, scores []TemplateScore) func(*Scorer) {
+	return func(s *Scorer) {
+		s.templates = templates
+		for _, score := range scores {
+			s.templateScores[score.Template] = score.Score
+		}
+	}
+}
+
+// WithFeeFactor sets the factor of the required fee above which a transaction doesn't add to its fee level signal.
+func WithFeeFactor(factor float64) func(*Scorer) {
+	return func(s *Scorer) {
+		s.feeFactor = factor
+	}
+}
+
+// WithMinSamples sets the number of recent outcomes of a client below which its rejection rate signal is 0.
+func WithMinSamples(samples int) func(*Scorer) {
+	return func(s *Scorer) {
+		s.minSamples = float64(samples)
+	}
+}
+
+func WithWeights(weights Weights) func(*Scorer) {
+	return func(s *Scorer) {
+		s.weights = weights
+	}
+}
+
+// WithThresholds sets the scores from which the submissions of a client are throttled to the throttle rate in txs per
+// second or rejected.
+func WithThresholds(throttle float64, reject float64, throttleRate float64) func(*Scorer) {
+	return func(s *Scorer) {
+		s.throttle = throttle
+		s.reject = reject
+		s.throttleRate = throttleRate
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Scorer) {
+	return func(s *Scorer) {
+		s.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Scorer)) (*Scorer, error) {
+	s := &Scorer{
+		logger:         logger.With(slog.String("module", "abuse-scoring")),
+		now:            time.Now,
+		window:         windowDefault,
+		burstRate:      burstRateDefault,
+		templateScores: make(map[string]float64),
+		feeFactor:      feeFactorDefault,
+		minSamples:     minSamplesDefault,
+		weights:        Weights{BurstRate: 1, Template: 1, FeeLevel: 1, RejectionRate: 1},
+		throttle:       throttleDefault,
+		reject:         rejectDefault,
+		throttleRate:   throttleRateDefault,
+		keys:           make(map[string]*key),
+		decisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_abuse_decisions_total",
+			Help: "Nr of scored submissions by the decision taken on their abuse score",
+		}, []string{"decision"}),
+		scores: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "arc_api_abuse_score",
+			Help:    "Abuse scores of the scored submissions",
+			Buckets: prometheus.LinearBuckets(0.1, 0.1, 10),
+		}),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.lastPrune = s.now()
+
+	for _, c := range []prometheus.Collector{s.decisions, s.scores} {
+		err := prometheus.Register(c)
+		if err != nil {
+			s.UnregisterStats()
+			return nil, errors.Join(ErrFailedToRegisterStats, err)
+		}
+	}
+
+	return s, nil
+}
+
+// Score scores the submission of the transaction by the client with the given key and returns the decision on it.
+// The fee details are optional, without them the fee level signal is 0.
+func (s *Scorer) Score(keyName string, tx *sdkTx.Transaction, fee *validator.FeeDetails) (Decision, KeyScore) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.prune(now)
+
+	k, found := s.keys[keyName]
+	if !found {
+		k = &key{windowStart: now, score: KeyScore{Key: keyName}}
+		s.keys[keyName] = k
+	}
+
+	s.completeWindows(k, now)
+	k.count++
+
+	signals := Signals{
+		BurstRate:     math.Min(1, s.rate(k, now)/s.burstRate),
+		Template:      s.templateSignal(tx),
+		FeeLevel:      s.feeSignal(fee),
+		RejectionRate: s.rejectionSignal(k),
+	}
+	score := s.score(signals)
+
+	decision := DecisionAllow
+	switch {
+	case score >= s.reject:
+		decision = DecisionReject
+	case score >= s.throttle && k.count > s.throttleRate*s.window.Seconds():
+		decision = DecisionThrottle
+	}
+
+	if score >= s.throttle && !k.score.Throttling {
+		s.logger.Warn("Abuse score of client exceeded threshold", slog.String("key", keyName), slog.Float64("score", score), slog.String("decision", decision.String()))
+	}
+
+	k.score.Score = score
+	k.score.Signals = signals
+	k.score.Throttling = score >= s.throttle
+	k.score.Submitted++
+	k.score.LastSeen = now
+	switch decision {
+	case DecisionThrottle:
+		k.score.Throttled++
+	case DecisionReject:
+		k.score.Rejected++
+	default:
+	}
+
+	s.decisions.WithLabelValues(decision.String()).Inc()
+	s.scores.Observe(score)
+
+	return decision, k.score
+}
+
+// RecordOutcome records the numbers of accepted and rejected transactions of the client, from which its rejection
+// rate is calculated. Submissions rejected due to their abuse score are not expected to be recorded.
+func (s *Scorer) RecordOutcome(keyName string, accepted int, rejected int) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	k, found := s.keys[keyName]
+	if !found {
+		return
+	}
+
+	s.completeWindows(k, now)
+	k.accepted += float64(accepted)
+	k.rejected += float64(rejected)
+}
+
+// Scores returns the scores of the last submissions of the clients seen recently, the highest score first.
+func (s *Scorer) Scores() []KeyScore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scores := make([]KeyScore, 0, len(s.keys))
+	for _, k := range s.keys {
+		scores = append(scores, k.score)
+	}
+
+	slices.SortFunc(scores, func(a, b KeyScore) int {
+		if a.Score == b.Score {
+			return b.LastSeen.Compare(a.LastSeen)
+		}
+		if a.Score > b.Score {
+			return -1
+		}
+		return 1
+	})
+
+	return scores
+}
+
+// completeWindows completes the windows of the client which ended before now.
+func (s *Scorer) completeWindows(k *key, now time.Time) {
+	elapsed := int(now.Sub(k.windowStart) / s.window)
+	if elapsed < 1 {
+		return
+	}
+
+	k.previous = 0
+	if elapsed == 1 {
+		k.previous = k.count
+	}
+	k.count = 0
+	k.windowStart = k.windowStart.Add(time.Duration(elapsed) * s.window)
+
+	decay := math.Pow(outcomeDecay, float64(elapsed))
+	k.accepted *= decay
+	k.rejected *= decay
+}
+
+// rate returns the submission rate of the client in the sliding window ending now.
+func (s *Scorer) rate(k *key, now time.Time) float64 {
+	overlap := 1 - float64(now.Sub(k.windowStart))/float64(s.window)
+
+	return (k.previous*overlap + k.count) / s.window.Seconds()
+}
+
+func (s *Scorer) templateSignal(tx *sdkTx.Transaction) float64 {
+	if len(s.templateScores) == 0 {
+		return 0
+	}
+
+	var signal float64
+	for _, bucket := range validator.TemplateBuckets(tx, s.templates) {
+		signal = math.Max(signal, s.templateScores[bucket])
+	}
+
+	return math.Min(1, signal)
+}
+
+func (s *Scorer) feeSignal(fee *validator.FeeDetails) float64 {
+	if fee == nil || fee.RequiredFee == 0 || s.feeFactor <= 1 {
+		return 0
+	}
+
+	ratio := float64(fee.ActualFee) / float64(fee.RequiredFee)
+
+	return math.Max(0, math.Min(1, (s.feeFactor-ratio)/(s.feeFactor-1)))
+}
+
+func (s *Scorer) rejectionSignal(k *key) float64 {
+	total := k.accepted + k.rejected
+	if total == 0 || total < s.minSamples {
+		return 0
+	}
+
+	return k.rejected / total
+}
+
+// score returns the weighted average of the signals.
+func (s *Scorer) score(signals Signals) float64 {
+	total := s.weights.BurstRate + s.weights.Template + s.weights.FeeLevel + s.weights.RejectionRate
+	if total <= 0 {
+		return 0
+	}
+
+	return (s.weights.BurstRate*signals.BurstRate +
+		s.weights.Template*signals.Template +
+		s.weights.FeeLevel*signals.FeeLevel +
+		s.weights.RejectionRate*signals.RejectionRate) / total
+}
+
+// prune drops the clients without submissions for idleWindows windows, at most once per window.
+func (s *Scorer) prune(now time.Time) {
+	if now.Sub(s.lastPrune) < s.window {
+		return
+	}
+	s.lastPrune = now
+
+	for name, k := range s.keys {
+		if now.Sub(k.score.LastSeen) > idleWindows*s.window {
+			delete(s.keys, name)
+		}
+	}
+}
+
+func (s *Scorer) UnregisterStats() {
+	for _, c := range []prometheus.Collector{s.decisions, s.scores} {
+		_ = prometheus.Unregister(c)
+	}
+}
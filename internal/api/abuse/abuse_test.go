@@ -0,0 +1,180 @@
+package abuse_test
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+func TestScorer_Score(t *testing.T) {
+	nullDataTx := sdkTx.NewTransaction()
+	nullDataTx.AddOutput(&sdkTx.TransactionOutput{LockingScript: &script.Script{0x00, 0x6a, 0x01, 0x05}})
+
+	tt := []struct {
+		name       string
+		weights    abuse.Weights
+		txs        int
+		fee        *validator.FeeDetails
+		accepted   int
+		rejected   int
+		otherKeyTx bool
+
+		expectedDecisions map[abuse.Decision]int
+		expectedSignals   abuse.Signals
+	}{
+		{
+			name:    "below burst rate",
+			weights: abuse.Weights{BurstRate: 1},
+			txs:     5,
+
+			expectedDecisions: map[abuse.Decision]int{abuse.DecisionAllow: 5},
+			expectedSignals:   abuse.Signals{BurstRate: 0.5, Template: 0.5},
+		},
+		{
+			name:    "burst - throttled",
+			weights: abuse.Weights{BurstRate: 1},
+			txs:     8,
+
+			// throttled from a score of 0.6, i.e. the 6th submission, to the throttle rate of 3 per window
+			expectedDecisions: map[abuse.Decision]int{abuse.DecisionAllow: 5, abuse.DecisionThrottle: 3},
+			expectedSignals:   abuse.Signals{BurstRate: 0.8, Template: 0.5},
+		},
+		{
+			name:    "burst - rejected",
+			weights: abuse.Weights{BurstRate: 1},
+			txs:     10,
+
+			expectedDecisions: map[abuse.Decision]int{abuse.DecisionAllow: 5, abuse.DecisionThrottle: 3, abuse.DecisionReject: 2},
+			expectedSignals:   abuse.Signals{BurstRate: 1, Template: 0.5},
+		},
+		{
+			name:    "template and fee level",
+			weights: abuse.Weights{Template: 1, FeeLevel: 1},
+			txs:     1,
+			fee:     &validator.FeeDetails{ActualFee: 15, RequiredFee: 10},
+
+			expectedDecisions: map[abuse.Decision]int{abuse.DecisionAllow: 1},
+			expectedSignals:   abuse.Signals{BurstRate: 0.1, Template: 0.5, FeeLevel: 0.5},
+		},
+		{
+			name:     "rejection rate",
+			weights:  abuse.Weights{RejectionRate: 1},
+			txs:      1,
+			accepted: 1,
+			rejected: 9,
+
+			expectedDecisions: map[abuse.Decision]int{abuse.DecisionReject: 1},
+			expectedSignals:   abuse.Signals{BurstRate: 0.1, Template: 0.5, RejectionRate: 0.9},
+		},
+		{
+			name:       "rejection rate - other key",
+			weights:    abuse.Weights{RejectionRate: 1},
+			txs:        1,
+			accepted:   1,
+			rejected:   9,
+			otherKeyTx: true,
+
+			expectedDecisions: map[abuse.Decision]int{abuse.DecisionAllow: 1},
+			expectedSignals:   abuse.Signals{BurstRate: 0.1, Template: 0.5},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+			sut, err := abuse.New(slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				abuse.WithWindow(time.Second),
+				abuse.WithBurstRate(10),
+				abuse.WithTemplateScores(nil, []abuse.TemplateScore{{Template: "nulldata", Score: 0.5}}),
+				abuse.WithFeeFactor(2),
+				abuse.WithMinSamples(10),
+				abuse.WithWeights(tc.weights),
+				abuse.WithThresholds(0.6, 0.9, 3),
+				abuse.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.UnregisterStats()
+
+			if tc.accepted+tc.rejected > 0 {
+				sut.Score("key-a", nullDataTx, nil)
+				sut.RecordOutcome("key-a", tc.accepted, tc.rejected)
+				// the outcomes decay by half, the submission rate of the previous window is ignored after 2 windows
+				now = now.Add(2 * time.Second)
+				sut.RecordOutcome("key-a", tc.accepted, tc.rejected)
+			}
+
+			keyName := "key-a"
+			if tc.otherKeyTx {
+				keyName = "key-b"
+			}
+
+			// when
+			decisions := map[abuse.Decision]int{}
+			var score abuse.KeyScore
+			for range tc.txs {
+				var decision abuse.Decision
+				decision, score = sut.Score(keyName, nullDataTx, tc.fee)
+				decisions[decision]++
+			}
+
+			// then
+			assert.Equal(t, tc.expectedDecisions, decisions)
+			assert.InDelta(t, tc.expectedSignals.BurstRate, score.Signals.BurstRate, 0.001)
+			assert.InDelta(t, tc.expectedSignals.Template, score.Signals.Template, 0.001)
+			assert.InDelta(t, tc.expectedSignals.FeeLevel, score.Signals.FeeLevel, 0.001)
+			assert.InDelta(t, tc.expectedSignals.RejectionRate, score.Signals.RejectionRate, 0.001)
+			assert.Equal(t, keyName, score.Key)
+		})
+	}
+}
+
+func TestScorer_Scores(t *testing.T) {
+	// given
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	sut, err := abuse.New(slog.New(slog.NewTextHandler(os.Stdout, nil)),
+		abuse.WithWindow(time.Second),
+		abuse.WithBurstRate(10),
+		abuse.WithWeights(abuse.Weights{BurstRate: 1}),
+		abuse.WithNow(func() time.Time { return now }),
+	)
+	require.NoError(t, err)
+	defer sut.UnregisterStats()
+
+	tx := sdkTx.NewTransaction()
+	for range 2 {
+		sut.Score("quiet", tx, nil)
+	}
+	for range 7 {
+		sut.Score("busy", tx, nil)
+	}
+
+	// when
+	scores := sut.Scores()
+
+	// then
+	require.Len(t, scores, 2)
+	assert.Equal(t, "busy", scores[0].Key)
+	assert.InDelta(t, 0.7, scores[0].Score, 0.001)
+	assert.True(t, scores[0].Throttling)
+	assert.Equal(t, uint64(7), scores[0].Submitted)
+	assert.Equal(t, "quiet", scores[1].Key)
+	assert.False(t, scores[1].Throttling)
+
+	// clients without submissions are dropped after 10 windows
+	now = now.Add(11 * time.Second)
+	sut.Score("quiet", tx, nil)
+	scores = sut.Scores()
+	require.Len(t, scores, 1)
+	assert.Equal(t, "quiet", scores[0].Key)
+}
@@ -0,0 +1,142 @@
+// Package admission bounds the memory used by the submissions which are processed by an instance of the API at the
+// same time. Each submission reserves the size of its request body from a memory budget until it is processed, the
+// submissions which don't fit into the remaining budget are rejected or queued until enough of the budget is released,
+// so that an instance doesn't run out of memory if several clients submit large transactions simultaneously.
+package admission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/semaphore"
+)
+
+var (
+	ErrBudgetExhausted       = errors.New("memory budget for in-flight submissions exhausted")
+	ErrSubmissionTooLarge    = errors.New("submission exceeds the memory budget for in-flight submissions")
+	ErrInvalidBudget         = errors.New("memory budget must be greater than 0")
+	ErrFailedToRegisterStats = errors.New("failed to register admission control collector")
+)
+
+// Controller admits the submissions as long as the sum of the sizes of the in-flight submissions doesn't exceed the
+// memory budget.
+type Controller struct {
+	logger       *slog.Logger
+	budget       int64
+	queueTimeout time.Duration
+	sem          *semaphore.Weighted
+	inFlight     atomic.Int64
+
+	inFlightBytes prometheus.Gauge
+	rejected      prometheus.Counter
+}
+
+// WithQueueTimeout sets the maximum duration a submission which doesn't fit into the remaining budget waits for
+// enough of the budget to be released before it is rejected. The submissions are admitted in the order they
+// arrived. With a timeout of 0 the submissions are rejected immediately.
+func WithQueueTimeout(d time.Duration) func(*Controller) {
+	return func(c *Controller) {
+		c.queueTimeout = d
+	}
+}
+
+func New(logger *slog.Logger, budgetBytes int64, opts ...func(*Controller)) (*Controller, error) {
+	if budgetBytes <= 0 {
+		return nil, ErrInvalidBudget
+	}
+
+	c := &Controller{
+		logger: logger.With(slog.String("module", "admission-control")),
+		budget: budgetBytes,
+		sem:    semaphore.NewWeighted(budgetBytes),
+		inFlightBytes: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "arc_api_admission_in_flight_bytes",
+			Help: "Bytes of the submissions currently in flight",
+		}),
+		rejected: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "arc_api_admission_rejected_total",
+			Help: "Nr of submissions rejected because the memory budget for in-flight submissions was exhausted",
+		}),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	for _, collector := range []prometheus.Collector{c.inFlightBytes, c.rejected} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			c.UnregisterStats()
+			return nil, errors.Join(ErrFailedToRegisterStats, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Admit reserves the size in bytes of a submission from the budget. The returned function releases the reservation and
+// has to be called once the submission is processed. If the submission doesn't fit into the remaining budget, it
+// waits up to the queue timeout or until the context is done.
+func (c *Controller) Admit(ctx context.Context, size int64) (func(), error) {
+	if size > c.budget {
+		c.rejected.Inc()
+		return nil, errors.Join(ErrSubmissionTooLarge, fmt.Errorf("size: %d bytes, budget: %d bytes", size, c.budget))
+	}
+
+	if size <= 0 {
+		return func() {}, nil
+	}
+
+	if !c.sem.TryAcquire(size) {
+		if c.queueTimeout <= 0 {
+			return nil, c.exhausted(size)
+		}
+
+		queueCtx, cancel := context.WithTimeout(ctx, c.queueTimeout)
+		defer cancel()
+
+		if err := c.sem.Acquire(queueCtx, size); err != nil {
+			return nil, c.exhausted(size)
+		}
+	}
+
+	c.inFlightBytes.Set(float64(c.inFlight.Add(size)))
+
+	var released atomic.Bool
+	return func() {
+		if !released.CompareAndSwap(false, true) {
+			return
+		}
+		c.inFlightBytes.Set(float64(c.inFlight.Add(-size)))
+		c.sem.Release(size)
+	}, nil
+}
+
+func (c *Controller) exhausted(size int64) error {
+	c.rejected.Inc()
+	inFlight := c.inFlight.Load()
+	c.logger.Warn("Rejecting submission, memory budget exhausted", slog.Int64("size", size), slog.Int64("inFlight", inFlight), slog.Int64("budget", c.budget))
+
+	return errors.Join(ErrBudgetExhausted, fmt.Errorf("size: %d bytes, in flight: %d bytes, budget: %d bytes", size, inFlight, c.budget))
+}
+
+// Budget returns the memory budget in bytes.
+func (c *Controller) Budget() int64 {
+	return c.budget
+}
+
+// InFlight returns the sum of the sizes in bytes of the submissions currently in flight.
+func (c *Controller) InFlight() int64 {
+	return c.inFlight.Load()
+}
+
+func (c *Controller) UnregisterStats() {
+	for _, collector := range []prometheus.Collector{c.inFlightBytes, c.rejected} {
+		_ = prometheus.Unregister(collector)
+	}
+}
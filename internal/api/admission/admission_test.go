@@ -0,0 +1,108 @@
+package admission_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/admission"
+)
+
+func TestController_Admit(t *testing.T) {
+	tt := []struct {
+		name         string
+		inFlight     int64
+		size         int64
+		queueTimeout time.Duration
+		releaseAfter time.Duration
+
+		expectedErr      error
+		expectedInFlight int64
+	}{
+		{
+			name:     "within budget",
+			inFlight: 600,
+			size:     400,
+
+			expectedInFlight: 1000,
+		},
+		{
+			name:     "budget exhausted",
+			inFlight: 700,
+			size:     400,
+
+			expectedErr:      admission.ErrBudgetExhausted,
+			expectedInFlight: 700,
+		},
+		{
+			name: "too large",
+			size: 1001,
+
+			expectedErr: admission.ErrSubmissionTooLarge,
+		},
+		{
+			name:         "queued - budget released",
+			inFlight:     700,
+			size:         400,
+			queueTimeout: time.Second,
+			releaseAfter: 10 * time.Millisecond,
+
+			expectedInFlight: 400,
+		},
+		{
+			name:         "queued - timeout",
+			inFlight:     700,
+			size:         400,
+			queueTimeout: 10 * time.Millisecond,
+
+			expectedErr:      admission.ErrBudgetExhausted,
+			expectedInFlight: 700,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := admission.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), 1000, admission.WithQueueTimeout(tc.queueTimeout))
+			require.NoError(t, err)
+			defer sut.UnregisterStats()
+
+			if tc.inFlight > 0 {
+				release, err := sut.Admit(context.Background(), tc.inFlight)
+				require.NoError(t, err)
+
+				if tc.releaseAfter > 0 {
+					time.AfterFunc(tc.releaseAfter, release)
+				}
+			}
+
+			// when
+			release, actualErr := sut.Admit(context.Background(), tc.size)
+
+			// then
+			assert.Equal(t, tc.expectedInFlight, sut.InFlight())
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, actualErr, tc.expectedErr)
+				return
+			}
+			require.NoError(t, actualErr)
+
+			release()
+			release()
+			assert.Equal(t, tc.expectedInFlight-tc.size, sut.InFlight())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	// when
+	_, err := admission.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), 0)
+
+	// then
+	require.ErrorIs(t, err, admission.ErrInvalidBudget)
+}
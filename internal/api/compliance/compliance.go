@@ -0,0 +1,296 @@
+// Package compliance lets compliance engines scan the output scripts and data carrier contents of submitted
+// transactions before they are accepted. The engines are called through the Scanner interface, either in-process or
+// over gRPC with the service compliance_api.ComplianceAPI, and flag or block the transactions. The decisions are cached
+// by transaction, so that resubmissions of a transaction are not scanned again.
+package compliance
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	timeoutDefault  = time.Second
+	cacheTTLDefault = 10 * time.Minute
+)
+
+var (
+	ErrFailedToRegisterStats = errors.New("failed to register compliance collector")
+	ErrScanFailed            = errors.New("failed to scan transaction")
+)
+
+// Action is the decision of the compliance engine on a transaction.
+type Action int
+
+const (
+	ActionAllow Action = iota
+	// ActionFlag accepts the transaction, but reports it
+	ActionFlag
+	ActionBlock
+)
+
+var actionNames = map[Action]string{
+	ActionAllow: "allow",
+	ActionFlag:  "flag",
+	ActionBlock: "block",
+}
+
+func (a Action) String() string {
+	return actionNames[a]
+}
+
+// Decision is the action taken on a transaction and the reason given by the compliance engine for it.
+type Decision struct {
+	Action Action
+	Reason string
+}
+
+// Output is an output of a scanned transaction.
+type Output struct {
+	Index         uint32
+	Satoshis      uint64
+	LockingScript []byte
+	// DataCarrier is true for OP_RETURN and OP_FALSE OP_RETURN outputs
+	DataCarrier bool
+	// Data are the data pushed after the OP_RETURN of data carrier outputs
+	Data [][]byte
+}
+
+// Payload is the content of a transaction which is scanned.
+type Payload struct {
+	TxID    string
+	Outputs []Output
+}
+
+// Scanner is a compliance engine deciding on the payloads of submitted transactions.
+type Scanner interface {
+	Scan(ctx context.Context, payload *Payload) (Decision, error)
+}
+
+// NewPayload returns the outputs of the transaction with the data pushed by its data carrier outputs.
+func NewPayload(tx *sdkTx.Transaction) *Payload {
+	payload := &Payload{
+		TxID:    tx.TxID().String(),
+		Outputs: make([]Output, 0, len(tx.Outputs)),
+	}
+
+	for i, output := range tx.Outputs {
+		if output.LockingScript == nil {
+			continue
+		}
+
+		out := Output{
+			Index:         uint32(i), // #nosec G115
+			Satoshis:      output.Satoshis,
+			LockingScript: *output.LockingScript,
+			DataCarrier:   output.LockingScript.IsData(),
+		}
+		if out.DataCarrier {
+			out.Data = dataPushes(*output.LockingScript)
+		}
+
+		payload.Outputs = append(payload.Outputs, out)
+	}
+
+	return payload
+}
+
+// dataPushes returns the data pushed after the OP_RETURN of the data carrier script. The pushes are parsed as far as
+// the script is valid.
+func dataPushes(lockingScript []byte) [][]byte {
+	chunks, _ := script.DecodeScript(lockingScript, script.DecodeOptionsParseOpReturn)
+
+	var data [][]byte
+	afterReturn := false
+	for _, chunk := range chunks {
+		if chunk.Op == script.OpRETURN {
+			afterReturn = true
+			continue
+		}
+		if afterReturn && len(chunk.Data) > 0 {
+			data = append(data, chunk.Data)
+		}
+	}
+
+	return data
+}
+
+type cacheEntry struct {
+	decision Decision
+	storedAt time.Time
+}
+
+// Checker calls the scanner for the submitted transactions and caches its decisions. If the scanner fails, the
+// transactions are accepted unless the checker fails closed.
+type Checker struct {
+	logger          *slog.Logger
+	scanner         Scanner
+	now             func() time.Time
+	timeout         time.Duration
+	cacheTTL        time.Duration
+	failClosed      bool
+	dataCarrierOnly bool
+
+	mu         sync.Mutex
+	cache      map[string]cacheEntry
+	lastPurged time.Time
+
+	decisions *prometheus.CounterVec
+	failures  prometheus.Counter
+}
+
+// WithTimeout sets the time after which the scan of a transaction fails.
+func WithTimeout(timeout time.Duration) func(*Checker) {
+	return func(c *Checker) {
+		c.timeout = timeout
+	}
+}
+
+// WithCacheTTL sets the time for which the decision on a transaction is cached.
+func WithCacheTTL(ttl time.Duration) func(*Checker) {
+	return func(c *Checker) {
+		c.cacheTTL = ttl
+	}
+}
+
+// WithFailClosed blocks the transactions which could not be scanned instead of accepting them.
+func WithFailClosed() func(*Checker) {
+	return func(c *Checker) {
+		c.failClosed = true
+	}
+}
+
+// WithDataCarrierOnly scans only the transactions with at least one data carrier output.
+func WithDataCarrierOnly() func(*Checker) {
+	return func(c *Checker) {
+		c.dataCarrierOnly = true
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Checker) {
+	return func(c *Checker) {
+		c.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, scanner Scanner, opts ...func(*Checker)) (*Checker, error) {
+	c := &Checker{
+		logger:   logger.With(slog.String("module", "compliance")),
+		scanner:  scanner,
+		now:      time.Now,
+		timeout:  timeoutDefault,
+		cacheTTL: cacheTTLDefault,
+		cache:    make(map[string]cacheEntry),
+		decisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_compliance_decisions_total",
+			Help: "Nr of scanned transactions by the decision of the compliance engine",
+		}, []string{"action"}),
+		failures: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "arc_api_compliance_scan_failures_total",
+			Help: "Nr of transactions which could not be scanned by the compliance engine",
+		}),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.lastPurged = c.now()
+
+	for _, collector := range []prometheus.Collector{c.decisions, c.failures} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			c.UnregisterStats()
+			return nil, errors.Join(ErrFailedToRegisterStats, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Check returns the decision on the transaction. If the transaction could not be scanned, the error is returned with
+// the decision allowing or, if the checker fails closed, blocking the transaction. Failed scans are not cached.
+func (c *Checker) Check(ctx context.Context, tx *sdkTx.Transaction) (Decision, error) {
+	payload := NewPayload(tx)
+	if c.dataCarrierOnly && !hasDataCarrier(payload) {
+		return Decision{Action: ActionAllow}, nil
+	}
+
+	if decision, found := c.cached(payload.TxID); found {
+		return decision, nil
+	}
+
+	scanCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	decision, err := c.scanner.Scan(scanCtx, payload)
+	if err != nil {
+		c.failures.Inc()
+		if c.failClosed {
+			return Decision{Action: ActionBlock, Reason: ErrScanFailed.Error()}, errors.Join(ErrScanFailed, err)
+		}
+		return Decision{Action: ActionAllow}, errors.Join(ErrScanFailed, err)
+	}
+
+	if decision.Action != ActionAllow {
+		c.logger.Warn("Transaction flagged by compliance engine", slog.String("hash", payload.TxID), slog.String("action", decision.Action.String()), slog.String("reason", decision.Reason))
+	}
+	c.decisions.WithLabelValues(decision.Action.String()).Inc()
+	c.store(payload.TxID, decision)
+
+	return decision, nil
+}
+
+func (c *Checker) cached(txID string) (Decision, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, found := c.cache[txID]
+	if !found || c.now().Sub(entry.storedAt) > c.cacheTTL {
+		return Decision{}, false
+	}
+
+	return entry.decision, true
+}
+
+func (c *Checker) store(txID string, decision Decision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	c.cache[txID] = cacheEntry{decision: decision, storedAt: now}
+
+	// purge expired entries once per ttl
+	if now.Sub(c.lastPurged) < c.cacheTTL {
+		return
+	}
+	for id, entry := range c.cache {
+		if now.Sub(entry.storedAt) > c.cacheTTL {
+			delete(c.cache, id)
+		}
+	}
+	c.lastPurged = now
+}
+
+func hasDataCarrier(payload *Payload) bool {
+	for _, output := range payload.Outputs {
+		if output.DataCarrier {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *Checker) UnregisterStats() {
+	for _, collector := range []prometheus.Collector{c.decisions, c.failures} {
+		_ = prometheus.Unregister(collector)
+	}
+}
@@ -0,0 +1,3 @@
+package compliance
+
+//go:generate moq -pkg mocks -out ./mocks/scanner_mock.go . Scanner
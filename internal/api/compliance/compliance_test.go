@@ -0,0 +1,183 @@
+package compliance_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/compliance"
+	"github.com/bitcoin-sv/arc/internal/api/compliance/mocks"
+)
+
+const p2pkhScript = "76a914e2a623699e81b291c0327f408fea765d534baa2a88ac"
+
+func newTx(t *testing.T, scripts ...string) *sdkTx.Transaction {
+	t.Helper()
+
+	tx := sdkTx.NewTransaction()
+	for _, s := range scripts {
+		lockingScript, err := script.NewFromHex(s)
+		require.NoError(t, err)
+		tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 1, LockingScript: lockingScript})
+	}
+
+	return tx
+}
+
+func TestNewPayload(t *testing.T) {
+	// given
+	tx := newTx(t, p2pkhScript, "006a0568656c6c6f05776f726c64", "6a0461626364")
+
+	// when
+	actual := compliance.NewPayload(tx)
+
+	// then
+	assert.Equal(t, tx.TxID().String(), actual.TxID)
+	require.Len(t, actual.Outputs, 3)
+	assert.False(t, actual.Outputs[0].DataCarrier)
+	assert.Nil(t, actual.Outputs[0].Data)
+	assert.True(t, actual.Outputs[1].DataCarrier)
+	assert.Equal(t, uint32(1), actual.Outputs[1].Index)
+	assert.Equal(t, [][]byte{[]byte("hello"), []byte("world")}, actual.Outputs[1].Data)
+	assert.Equal(t, [][]byte{[]byte("abcd")}, actual.Outputs[2].Data)
+}
+
+func TestChecker_Check(t *testing.T) {
+	tt := []struct {
+		name       string
+		scripts    []string
+		decision   compliance.Decision
+		scanErr    error
+		failClosed bool
+		dataOnly   bool
+
+		expectedAction compliance.Action
+		expectedError  error
+		expectedScans  int
+	}{
+		{
+			name:     "allowed",
+			scripts:  []string{p2pkhScript},
+			decision: compliance.Decision{Action: compliance.ActionAllow},
+
+			expectedAction: compliance.ActionAllow,
+			expectedScans:  1,
+		},
+		{
+			name:     "blocked",
+			scripts:  []string{"006a0568656c6c6f"},
+			decision: compliance.Decision{Action: compliance.ActionBlock, Reason: "prohibited content"},
+
+			expectedAction: compliance.ActionBlock,
+			expectedScans:  1,
+		},
+		{
+			name:    "scan failed - fail open",
+			scripts: []string{p2pkhScript},
+			scanErr: errors.New("unavailable"),
+
+			expectedAction: compliance.ActionAllow,
+			expectedError:  compliance.ErrScanFailed,
+			expectedScans:  2,
+		},
+		{
+			name:       "scan failed - fail closed",
+			scripts:    []string{p2pkhScript},
+			scanErr:    errors.New("unavailable"),
+			failClosed: true,
+
+			expectedAction: compliance.ActionBlock,
+			expectedError:  compliance.ErrScanFailed,
+			expectedScans:  2,
+		},
+		{
+			name:     "data carrier only - no data carrier",
+			scripts:  []string{p2pkhScript},
+			decision: compliance.Decision{Action: compliance.ActionBlock},
+			dataOnly: true,
+
+			expectedAction: compliance.ActionAllow,
+			expectedScans:  0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			scanner := &mocks.ScannerMock{
+				ScanFunc: func(_ context.Context, _ *compliance.Payload) (compliance.Decision, error) {
+					return tc.decision, tc.scanErr
+				},
+			}
+
+			opts := []func(*compliance.Checker){compliance.WithTimeout(time.Second)}
+			if tc.failClosed {
+				opts = append(opts, compliance.WithFailClosed())
+			}
+			if tc.dataOnly {
+				opts = append(opts, compliance.WithDataCarrierOnly())
+			}
+
+			sut, err := compliance.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), scanner, opts...)
+			require.NoError(t, err)
+			defer sut.UnregisterStats()
+
+			tx := newTx(t, tc.scripts...)
+
+			// when
+			for range 2 {
+				decision, err := sut.Check(context.Background(), tx)
+
+				// then
+				assert.Equal(t, tc.expectedAction, decision.Action)
+				if tc.expectedError != nil {
+					require.ErrorIs(t, err, tc.expectedError)
+					continue
+				}
+				require.NoError(t, err)
+				assert.Equal(t, tc.decision.Reason, decision.Reason)
+			}
+
+			// decisions are cached
+			assert.Len(t, scanner.ScanCalls(), tc.expectedScans)
+		})
+	}
+}
+
+func TestChecker_CacheTTL(t *testing.T) {
+	// given
+	scanner := &mocks.ScannerMock{
+		ScanFunc: func(_ context.Context, _ *compliance.Payload) (compliance.Decision, error) {
+			return compliance.Decision{Action: compliance.ActionFlag}, nil
+		},
+	}
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut, err := compliance.New(slog.Default(), scanner, compliance.WithCacheTTL(time.Minute), compliance.WithNow(func() time.Time { return now }))
+	require.NoError(t, err)
+	defer sut.UnregisterStats()
+
+	tx := newTx(t, p2pkhScript)
+
+	// when
+	_, err = sut.Check(context.Background(), tx)
+	require.NoError(t, err)
+	now = now.Add(30 * time.Second)
+	_, err = sut.Check(context.Background(), tx)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	decision, err := sut.Check(context.Background(), tx)
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, compliance.ActionFlag, decision.Action)
+	assert.Len(t, scanner.ScanCalls(), 2)
+}
@@ -0,0 +1,49 @@
+package compliance
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/arc/internal/api/compliance/compliance_api"
+)
+
+// GRPCScanner calls a compliance engine implementing the service compliance_api.ComplianceAPI.
+type GRPCScanner struct {
+	client compliance_api.ComplianceAPIClient
+}
+
+func NewGRPCScanner(client compliance_api.ComplianceAPIClient) *GRPCScanner {
+	return &GRPCScanner{client: client}
+}
+
+func (s *GRPCScanner) Scan(ctx context.Context, payload *Payload) (Decision, error) {
+	req := &compliance_api.ScanTransactionRequest{
+		TxId:    payload.TxID,
+		Outputs: make([]*compliance_api.Output, 0, len(payload.Outputs)),
+	}
+	for _, output := range payload.Outputs {
+		req.Outputs = append(req.Outputs, &compliance_api.Output{
+			Index:         output.Index,
+			Satoshis:      output.Satoshis,
+			LockingScript: output.LockingScript,
+			DataCarrier:   output.DataCarrier,
+			Data:          output.Data,
+		})
+	}
+
+	resp, err := s.client.ScanTransaction(ctx, req)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	decision := Decision{Reason: resp.GetReason()}
+	switch resp.GetAction() {
+	case compliance_api.Action_FLAG:
+		decision.Action = ActionFlag
+	case compliance_api.Action_BLOCK:
+		decision.Action = ActionBlock
+	default:
+		decision.Action = ActionAllow
+	}
+
+	return decision, nil
+}
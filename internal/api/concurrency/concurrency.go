@@ -0,0 +1,189 @@
+// Package concurrency limits the number of requests of each route group which are processed by an instance of the API
+// at the same time. The requests exceeding the limit of their group are queued until a request of the group finishes
+// or rejected, so that a flood of heavy batch submissions can't starve the lightweight status queries on the same
+// instance.
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/sync/semaphore"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// The route groups of the requests.
+const (
+	// GroupSubmission are the POST requests, i.e. the submissions and resubmissions of transactions
+	GroupSubmission = "submission"
+	// GroupQuery are the GET and HEAD requests, e.g. the status, proof and policy queries
+	GroupQuery = "query"
+)
+
+// retryAfterSeconds is the delay after which clients are asked to retry requests rejected due to the limit
+const retryAfterSeconds = "1"
+
+var (
+	ErrLimitReached          = errors.New("concurrency limit of route group reached")
+	ErrInvalidLimit          = errors.New("concurrency limit must be greater than 0")
+	ErrUnknownGroup          = errors.New("unknown route group")
+	ErrFailedToRegisterStats = errors.New("failed to register concurrency limiter collector")
+)
+
+type group struct {
+	limit        int64
+	queueTimeout time.Duration
+	sem          *semaphore.Weighted
+	inFlight     atomic.Int64
+}
+
+// Limiter limits the number of requests of each route group in flight. The requests of groups without a limit are not
+// limited.
+type Limiter struct {
+	logger *slog.Logger
+	groups map[string]*group
+
+	inFlight *prometheus.GaugeVec
+	rejected *prometheus.CounterVec
+}
+
+// WithLimit limits the number of requests of the route group in flight. A request exceeding the limit waits up to the
+// queue timeout for a request of the group to finish before it is rejected, the requests are admitted in the order they
+// arrived. With a queue timeout of 0 the requests exceeding the limit are rejected immediately.
+func WithLimit(name string, limit int64, queueTimeout time.Duration) func(*Limiter) {
+	return func(l *Limiter) {
+		l.groups[name] = &group{limit: limit, queueTimeout: queueTimeout}
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Limiter)) (*Limiter, error) {
+	l := &Limiter{
+		logger: logger.With(slog.String("module", "concurrency-limiter")),
+		groups: make(map[string]*group),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "arc_api_concurrency_in_flight_requests",
+			Help: "Requests currently in flight by route group",
+		}, []string{"group"}),
+		rejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_concurrency_rejected_total",
+			Help: "Nr of requests rejected because the concurrency limit of their route group was reached",
+		}, []string{"group"}),
+	}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	for name, g := range l.groups {
+		if name != GroupSubmission && name != GroupQuery {
+			return nil, errors.Join(ErrUnknownGroup, fmt.Errorf("group: %s", name))
+		}
+		if g.limit <= 0 {
+			return nil, errors.Join(ErrInvalidLimit, fmt.Errorf("group: %s", name))
+		}
+		g.sem = semaphore.NewWeighted(g.limit)
+	}
+
+	for _, collector := range []prometheus.Collector{l.inFlight, l.rejected} {
+		err := prometheus.Register(collector)
+		if err != nil {
+			l.UnregisterStats()
+			return nil, errors.Join(ErrFailedToRegisterStats, err)
+		}
+	}
+
+	return l, nil
+}
+
+// Group returns the route group of the request, empty if the request belongs to none of the groups.
+func Group(request *http.Request) string {
+	switch request.Method {
+	case http.MethodPost:
+		return GroupSubmission
+	case http.MethodGet, http.MethodHead:
+		return GroupQuery
+	}
+
+	return ""
+}
+
+// Acquire admits a request of the route group. The returned function has to be called once the request is processed.
+// If the limit of the group is reached, it waits up to the queue timeout of the group or until the context is done.
+func (l *Limiter) Acquire(ctx context.Context, name string) (func(), error) {
+	g, found := l.groups[name]
+	if !found {
+		return func() {}, nil
+	}
+
+	if !g.sem.TryAcquire(1) {
+		if g.queueTimeout <= 0 {
+			return nil, l.limitReached(name, g)
+		}
+
+		queueCtx, cancel := context.WithTimeout(ctx, g.queueTimeout)
+		defer cancel()
+
+		if err := g.sem.Acquire(queueCtx, 1); err != nil {
+			return nil, l.limitReached(name, g)
+		}
+	}
+
+	l.inFlight.WithLabelValues(name).Set(float64(g.inFlight.Add(1)))
+
+	var released atomic.Bool
+	return func() {
+		if !released.CompareAndSwap(false, true) {
+			return
+		}
+		l.inFlight.WithLabelValues(name).Set(float64(g.inFlight.Add(-1)))
+		g.sem.Release(1)
+	}, nil
+}
+
+func (l *Limiter) limitReached(name string, g *group) error {
+	l.rejected.WithLabelValues(name).Inc()
+	l.logger.Warn("Rejecting request, concurrency limit reached", slog.String("group", name), slog.Int64("limit", g.limit))
+
+	return errors.Join(ErrLimitReached, fmt.Errorf("group: %s, limit: %d", name, g.limit))
+}
+
+// InFlight returns the number of requests of the route group currently in flight.
+func (l *Limiter) InFlight(name string) int64 {
+	g, found := l.groups[name]
+	if !found {
+		return 0
+	}
+
+	return g.inFlight.Load()
+}
+
+// Middleware limits the requests by their route group. The requests which are rejected are answered with 429.
+func (l *Limiter) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			release, err := l.Acquire(c.Request().Context(), Group(c.Request()))
+			if err != nil {
+				c.Response().Header().Set("Retry-After", retryAfterSeconds)
+				e := api.NewErrorFields(api.ErrStatusTooManyRequests, err.Error())
+				return c.JSON(int(e.Status), e)
+			}
+			defer release()
+
+			return next(c)
+		}
+	}
+}
+
+func (l *Limiter) UnregisterStats() {
+	for _, collector := range []prometheus.Collector{l.inFlight, l.rejected} {
+		_ = prometheus.Unregister(collector)
+	}
+}
@@ -0,0 +1,180 @@
+package concurrency_test
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/concurrency"
+)
+
+func TestLimiter_Acquire(t *testing.T) {
+	tt := []struct {
+		name         string
+		group        string
+		inFlight     int
+		queueTimeout time.Duration
+		releaseAfter time.Duration
+
+		expectedErr      error
+		expectedInFlight int64
+	}{
+		{
+			name:     "within limit",
+			group:    concurrency.GroupSubmission,
+			inFlight: 1,
+
+			expectedInFlight: 2,
+		},
+		{
+			name:     "limit reached",
+			group:    concurrency.GroupSubmission,
+			inFlight: 2,
+
+			expectedErr:      concurrency.ErrLimitReached,
+			expectedInFlight: 2,
+		},
+		{
+			name:         "queued - request finished",
+			group:        concurrency.GroupSubmission,
+			inFlight:     2,
+			queueTimeout: time.Second,
+			releaseAfter: 10 * time.Millisecond,
+
+			expectedInFlight: 2,
+		},
+		{
+			name:         "queued - timeout",
+			group:        concurrency.GroupSubmission,
+			inFlight:     2,
+			queueTimeout: 10 * time.Millisecond,
+
+			expectedErr:      concurrency.ErrLimitReached,
+			expectedInFlight: 2,
+		},
+		{
+			name:  "group without limit",
+			group: concurrency.GroupQuery,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := concurrency.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), concurrency.WithLimit(concurrency.GroupSubmission, 2, tc.queueTimeout))
+			require.NoError(t, err)
+			defer sut.UnregisterStats()
+
+			for i := range tc.inFlight {
+				release, err := sut.Acquire(context.Background(), tc.group)
+				require.NoError(t, err)
+
+				// the first request in flight finishes while the request is queued
+				if i == 0 && tc.releaseAfter > 0 {
+					time.AfterFunc(tc.releaseAfter, release)
+				}
+			}
+
+			// when
+			release, actualErr := sut.Acquire(context.Background(), tc.group)
+
+			// then
+			assert.Equal(t, tc.expectedInFlight, sut.InFlight(tc.group))
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, actualErr, tc.expectedErr)
+				return
+			}
+			require.NoError(t, actualErr)
+
+			release()
+			release()
+			if tc.expectedInFlight > 0 {
+				assert.Equal(t, tc.expectedInFlight-1, sut.InFlight(tc.group))
+			}
+		})
+	}
+}
+
+func TestLimiter_Middleware(t *testing.T) {
+	// given
+	sut, err := concurrency.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), concurrency.WithLimit(concurrency.GroupSubmission, 1, 0))
+	require.NoError(t, err)
+	defer sut.UnregisterStats()
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+
+	e := echo.New()
+	e.Use(sut.Middleware())
+	e.POST("/v1/txs", func(c echo.Context) error {
+		close(started)
+		<-finish
+		return c.NoContent(http.StatusOK)
+	})
+	e.GET("/v1/tx/:id", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	submitted := make(chan int)
+	go func() {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/v1/txs", nil))
+		submitted <- rec.Code
+	}()
+	<-started
+
+	// when
+	submissionRec := httptest.NewRecorder()
+	e.ServeHTTP(submissionRec, httptest.NewRequest(http.MethodPost, "/v1/txs", nil))
+	queryRec := httptest.NewRecorder()
+	e.ServeHTTP(queryRec, httptest.NewRequest(http.MethodGet, "/v1/tx/abc", nil))
+	close(finish)
+
+	// then
+	assert.Equal(t, http.StatusTooManyRequests, submissionRec.Code)
+	assert.Equal(t, "1", submissionRec.Header().Get("Retry-After"))
+	assert.Contains(t, submissionRec.Body.String(), "concurrency limit of route group reached")
+	assert.Equal(t, http.StatusOK, queryRec.Code)
+	assert.Equal(t, http.StatusOK, <-submitted)
+	assert.Equal(t, int64(0), sut.InFlight(concurrency.GroupSubmission))
+}
+
+func TestNew(t *testing.T) {
+	tt := []struct {
+		name string
+		opts []func(*concurrency.Limiter)
+
+		expectedErr error
+	}{
+		{
+			name: "invalid limit",
+			opts: []func(*concurrency.Limiter){concurrency.WithLimit(concurrency.GroupQuery, 0, 0)},
+
+			expectedErr: concurrency.ErrInvalidLimit,
+		},
+		{
+			name: "unknown group",
+			opts: []func(*concurrency.Limiter){concurrency.WithLimit("admin", 10, 0)},
+
+			expectedErr: concurrency.ErrUnknownGroup,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := concurrency.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), tc.opts...)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,347 @@
+// Package dashboard serves an embedded operator dashboard on the API server. The dashboard shows the live submission
+// rate, the status distribution of the transactions, the health of the peers, the availability of the block header
+// services, the callback backlog and the recent rejections, so that ARC can be monitored without an external Grafana.
+//
+// Apart from the submissions and rejections recorded by the API handler, the dashboard reads the Prometheus metrics of
+// the local process and of the configured metrics endpoints of the other ARC services.
+package dashboard
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+)
+
+// PathPrefix is the path under which the dashboard and its admin endpoints are served.
+const PathPrefix = "/dashboard"
+
+const (
+	recentRejectionsDefault = 50
+	tpsWindowDefault        = 10 * time.Second
+	sourceTimeoutDefault    = 2 * time.Second
+
+	// maxTPSWindow is the maximum number of seconds over which the submission rate is averaged
+	maxTPSWindow = 60
+
+	statusMetricPrefix = "arc_status_"
+	statusMetricSuffix = "_count"
+
+	metamorphConnectedPeersMetric    = "arc_metamorph_connected_peers"
+	metamorphReconnectingPeersMetric = "arc_metamorph_reconnecting_peers"
+	blocktxConnectedPeersMetric      = "arc_blocktx_connected_peers"
+	blocktxReconnectingPeersMetric   = "arc_blocktx_reconnecting_peers"
+	availableHeaderServicesMetric    = "arc_api_available_block_header_services"
+	unavailableHeaderServicesMetric  = "arc_api_unavailable_block_header_services"
+	callbackBacklogMetric            = "arc_callback_backlog_count"
+)
+
+var ErrMetricsSource = errors.New("failed to read metrics source")
+
+//go:embed static
+var staticFiles embed.FS
+
+// Rejection is a transaction rejected on submission or by the network.
+type Rejection struct {
+	Timestamp time.Time `json:"timestamp"`
+	TxID      string    `json:"txid"`
+	Status    int       `json:"status"`
+	Reason    string    `json:"reason"`
+}
+
+// PeerHealth is the number of connected and reconnecting peers of a service.
+type PeerHealth struct {
+	Connected    float64 `json:"connected"`
+	Reconnecting float64 `json:"reconnecting"`
+}
+
+// ChainTrackers is the number of available and unavailable block header services used for the verification of the
+// merkle roots of BEEF transactions.
+type ChainTrackers struct {
+	Available   float64 `json:"available"`
+	Unavailable float64 `json:"unavailable"`
+}
+
+// Summary is the data shown on the dashboard.
+type Summary struct {
+	Timestamp          time.Time             `json:"timestamp"`
+	TPS                float64               `json:"tps"`
+	StatusDistribution map[string]float64    `json:"statusDistribution"`
+	Peers              map[string]PeerHealth `json:"peers"`
+	ChainTrackers      ChainTrackers         `json:"chainTrackers"`
+	CallbackBacklog    float64               `json:"callbackBacklog"`
+	RecentRejections   []Rejection           `json:"recentRejections"`
+	// Errors are the errors of the metrics sources which could not be read
+	Errors []string `json:"errors,omitempty"`
+}
+
+type submissionBucket struct {
+	second int64
+	count  int
+}
+
+type Dashboard struct {
+	logger         *slog.Logger
+	gatherer       prometheus.Gatherer
+	metricsSources []string
+	httpClient     httpclient.Doer
+	now            func() time.Time
+	tpsWindow      time.Duration
+
+	mu            sync.Mutex
+	submissions   [maxTPSWindow]submissionBucket
+	rejections    []Rejection
+	maxRejections int
+	nextRejection int
+}
+
+// WithGatherer sets the gatherer of the metrics of the local process.
+func WithGatherer(gatherer prometheus.Gatherer) func(*Dashboard) {
+	return func(d *Dashboard) {
+		d.gatherer = gatherer
+	}
+}
+
+// WithMetricsSources sets the URLs of the Prometheus metrics endpoints of the other ARC services, e.g.
+// `http://metamorph:2112/metrics`, of which the metrics are shown in addition to the metrics of the local process.
+func WithMetricsSources(urls ...string) func(*Dashboard) {
+	return func(d *Dashboard) {
+		d.metricsSources = urls
+	}
+}
+
+// WithRecentRejections sets the number of recent rejections which are shown.
+func WithRecentRejections(size int) func(*Dashboard) {
+	return func(d *Dashboard) {
+		d.maxRejections = size
+	}
+}
+
+// WithTPSWindow sets the duration over which the submission rate is averaged. It is capped at one minute.
+func WithTPSWindow(window time.Duration) func(*Dashboard) {
+	return func(d *Dashboard) {
+		d.tpsWindow = min(window, maxTPSWindow*time.Second)
+	}
+}
+
+func WithHTTPClient(client httpclient.Doer) func(*Dashboard) {
+	return func(d *Dashboard) {
+		d.httpClient = client
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Dashboard) {
+	return func(d *Dashboard) {
+		d.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Dashboard)) *Dashboard {
+	d := &Dashboard{
+		logger:        logger.With(slog.String("module", "dashboard")),
+		gatherer:      prometheus.DefaultGatherer,
+		httpClient:    httpclient.New("dashboard", httpclient.WithTimeout(sourceTimeoutDefault)),
+		now:           time.Now,
+		tpsWindow:     tpsWindowDefault,
+		maxRejections: recentRejectionsDefault,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	if d.tpsWindow < time.Second {
+		d.tpsWindow = time.Second
+	}
+
+	d.rejections = make([]Rejection, 0, max(d.maxRejections, 0))
+
+	return d
+}
+
+// Register registers the dashboard and its admin endpoints on the echo server.
+func (d *Dashboard) Register(e *echo.Echo) {
+	static, _ := fs.Sub(staticFiles, "static")
+
+	e.GET(PathPrefix, func(c echo.Context) error {
+		return c.Redirect(http.StatusMovedPermanently, PathPrefix+"/")
+	})
+	e.GET(PathPrefix+"/", echo.WrapHandler(http.StripPrefix(PathPrefix, http.FileServer(http.FS(static)))))
+	e.GET(PathPrefix+"/api/summary", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, d.Summary(c.Request().Context()))
+	})
+}
+
+// RecordSubmissions records the number of transactions submitted to metamorph.
+func (d *Dashboard) RecordSubmissions(count int) {
+	second := d.now().Unix()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	bucket := &d.submissions[second%maxTPSWindow]
+	if bucket.second != second {
+		bucket.second = second
+		bucket.count = 0
+	}
+	bucket.count += count
+}
+
+// RecordRejection records a rejected transaction. Only the most recent rejections are kept.
+func (d *Dashboard) RecordRejection(txID string, status int, reason string) {
+	if d.maxRejections <= 0 {
+		return
+	}
+
+	rejection := Rejection{Timestamp: d.now(), TxID: txID, Status: status, Reason: reason}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.rejections) < d.maxRejections {
+		d.rejections = append(d.rejections, rejection)
+		return
+	}
+
+	d.rejections[d.nextRejection] = rejection
+	d.nextRejection = (d.nextRejection + 1) % d.maxRejections
+}
+
+// Summary returns the data shown on the dashboard. Metrics sources which cannot be read are reported in the errors of
+// the summary.
+func (d *Dashboard) Summary(ctx context.Context) Summary {
+	summary := Summary{
+		Timestamp:          d.now(),
+		TPS:                d.tps(),
+		StatusDistribution: map[string]float64{},
+		Peers:              map[string]PeerHealth{},
+		RecentRejections:   d.recentRejections(),
+	}
+
+	values := map[string]float64{}
+	families, err := d.gatherer.Gather()
+	if err != nil {
+		summary.Errors = append(summary.Errors, err.Error())
+	}
+	addValues(values, families)
+
+	for _, source := range d.metricsSources {
+		families, err := d.readSource(ctx, source)
+		if err != nil {
+			d.logger.Warn("Failed to read metrics source", slog.String("source", source), slog.String("err", err.Error()))
+			summary.Errors = append(summary.Errors, err.Error())
+			continue
+		}
+		addValues(values, families)
+	}
+
+	for name, value := range values {
+		if !strings.HasPrefix(name, statusMetricPrefix) || !strings.HasSuffix(name, statusMetricSuffix) {
+			continue
+		}
+		status := strings.TrimSuffix(strings.TrimPrefix(name, statusMetricPrefix), statusMetricSuffix)
+		// the totals are counters since the start of the service and not the current distribution
+		if strings.HasSuffix(status, "_total") {
+			continue
+		}
+		summary.StatusDistribution[status] = value
+	}
+
+	summary.Peers["metamorph"] = PeerHealth{Connected: values[metamorphConnectedPeersMetric], Reconnecting: values[metamorphReconnectingPeersMetric]}
+	summary.Peers["blocktx"] = PeerHealth{Connected: values[blocktxConnectedPeersMetric], Reconnecting: values[blocktxReconnectingPeersMetric]}
+	summary.ChainTrackers = ChainTrackers{Available: values[availableHeaderServicesMetric], Unavailable: values[unavailableHeaderServicesMetric]}
+	summary.CallbackBacklog = values[callbackBacklogMetric]
+
+	return summary
+}
+
+// tps returns the average number of submitted transactions per second over the completed seconds of the window.
+func (d *Dashboard) tps() float64 {
+	current := d.now().Unix()
+	seconds := int64(d.tpsWindow / time.Second)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var count int
+	for _, bucket := range d.submissions {
+		if bucket.second < current && bucket.second >= current-seconds {
+			count += bucket.count
+		}
+	}
+
+	return float64(count) / float64(seconds)
+}
+
+// recentRejections returns the recorded rejections with the most recent first.
+func (d *Dashboard) recentRejections() []Rejection {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	rejections := make([]Rejection, 0, len(d.rejections))
+	for i := range d.rejections {
+		idx := (d.nextRejection - 1 - i + 2*len(d.rejections)) % len(d.rejections)
+		rejections = append(rejections, d.rejections[idx])
+	}
+
+	return rejections
+}
+
+func (d *Dashboard) readSource(ctx context.Context, source string) ([]*dto.MetricFamily, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, errors.Join(ErrMetricsSource, fmt.Errorf("source: %s", source), err)
+	}
+	req.Header.Set("Accept", string(expfmt.NewFormat(expfmt.TypeTextPlain)))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Join(ErrMetricsSource, fmt.Errorf("source: %s", source), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Join(ErrMetricsSource, fmt.Errorf("source: %s, status code: %d", source, resp.StatusCode))
+	}
+
+	var parser expfmt.TextParser
+	familiesByName, err := parser.TextToMetricFamilies(resp.Body)
+	if err != nil {
+		return nil, errors.Join(ErrMetricsSource, fmt.Errorf("source: %s", source), err)
+	}
+
+	families := make([]*dto.MetricFamily, 0, len(familiesByName))
+	for _, family := range familiesByName {
+		families = append(families, family)
+	}
+
+	return families, nil
+}
+
+// addValues adds the values of the gauges and counters of the metric families to the values by metric name. The values
+// of all label combinations and of all sources are summed up.
+func addValues(values map[string]float64, families []*dto.MetricFamily) {
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			switch {
+			case metric.GetGauge() != nil:
+				values[family.GetName()] += metric.GetGauge().GetValue()
+			case metric.GetCounter() != nil:
+				values[family.GetName()] += metric.GetCounter().GetValue()
+			}
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package dashboard_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/dashboard"
+)
+
+const metamorphMetrics = `# HELP arc_status_seen_on_network_count Number of monitored transactions with status SEEN_ON_NETWORK
+# TYPE arc_status_seen_on_network_count gauge
+arc_status_seen_on_network_count 12
+# HELP arc_status_seen_on_network_total_count Total number of transactions with status SEEN_ON_NETWORK
+# TYPE arc_status_seen_on_network_total_count gauge
+arc_status_seen_on_network_total_count 1200
+# HELP arc_status_mined_count Number of monitored transactions with status MINED
+# TYPE arc_status_mined_count gauge
+arc_status_mined_count 30
+# HELP arc_metamorph_connected_peers Number of connected peers
+# TYPE arc_metamorph_connected_peers gauge
+arc_metamorph_connected_peers 2
+# HELP arc_metamorph_reconnecting_peers Number of reconnecting peers
+# TYPE arc_metamorph_reconnecting_peers gauge
+arc_metamorph_reconnecting_peers 1
+`
+
+func TestDashboard_Summary(t *testing.T) {
+	now := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name        string
+		sourceCode  int
+		submissions map[time.Duration]int
+
+		expectedTPS         float64
+		expectedStatuses    map[string]float64
+		expectedPeers       dashboard.PeerHealth
+		expectedBacklog     float64
+		expectedLenOfErrors int
+	}{
+		{
+			name:       "success",
+			sourceCode: http.StatusOK,
+			submissions: map[time.Duration]int{
+				-11 * time.Second: 1000,
+				-5 * time.Second:  30,
+				-1 * time.Second:  70,
+				0:                 500,
+			},
+
+			expectedTPS:      10,
+			expectedStatuses: map[string]float64{"seen_on_network": 12, "mined": 30},
+			expectedPeers:    dashboard.PeerHealth{Connected: 2, Reconnecting: 1},
+			expectedBacklog:  7,
+		},
+		{
+			name:       "metrics source not available",
+			sourceCode: http.StatusInternalServerError,
+
+			expectedStatuses:    map[string]float64{},
+			expectedBacklog:     7,
+			expectedLenOfErrors: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.sourceCode)
+				_, _ = w.Write([]byte(metamorphMetrics))
+			}))
+			defer source.Close()
+
+			registry := prometheus.NewRegistry()
+			backlog := prometheus.NewGauge(prometheus.GaugeOpts{Name: "arc_callback_backlog_count", Help: "Number of unsent callbacks"})
+			backlog.Set(7)
+			registry.MustRegister(backlog)
+
+			var current time.Time
+			sut := dashboard.New(slog.Default(),
+				dashboard.WithGatherer(registry),
+				dashboard.WithMetricsSources(source.URL),
+				dashboard.WithNow(func() time.Time { return current }),
+			)
+
+			for offset, count := range tc.submissions {
+				current = now.Add(offset)
+				sut.RecordSubmissions(count)
+			}
+			current = now
+
+			// when
+			actual := sut.Summary(context.Background())
+
+			// then
+			assert.Equal(t, tc.expectedTPS, actual.TPS)
+			assert.Equal(t, tc.expectedStatuses, actual.StatusDistribution)
+			assert.Equal(t, tc.expectedPeers, actual.Peers["metamorph"])
+			assert.Equal(t, tc.expectedBacklog, actual.CallbackBacklog)
+			assert.Len(t, actual.Errors, tc.expectedLenOfErrors)
+		})
+	}
+}
+
+func TestDashboard_RecordRejection(t *testing.T) {
+	// given
+	sut := dashboard.New(slog.Default(), dashboard.WithGatherer(prometheus.NewRegistry()), dashboard.WithRecentRejections(2))
+
+	// when
+	sut.RecordRejection("tx-1", 461, "malformed")
+	sut.RecordRejection("tx-2", 465, "fee too low")
+	sut.RecordRejection("tx-3", 200, "double spend")
+
+	// then
+	actual := sut.Summary(context.Background()).RecentRejections
+	require.Len(t, actual, 2)
+	assert.Equal(t, "tx-3", actual[0].TxID)
+	assert.Equal(t, "tx-2", actual[1].TxID)
+}
+
+func TestDashboard_Register(t *testing.T) {
+	// given
+	sut := dashboard.New(slog.Default(), dashboard.WithGatherer(prometheus.NewRegistry()))
+	e := echo.New()
+	sut.Register(e)
+
+	t.Run("index", func(t *testing.T) {
+		// when
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, dashboard.PathPrefix+"/", nil))
+
+		// then
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "ARC dashboard")
+	})
+
+	t.Run("summary", func(t *testing.T) {
+		// when
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, dashboard.PathPrefix+"/api/summary", nil))
+
+		// then
+		require.Equal(t, http.StatusOK, rec.Code)
+		var summary dashboard.Summary
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &summary))
+		assert.Contains(t, summary.Peers, "blocktx")
+	})
+}
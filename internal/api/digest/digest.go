@@ -0,0 +1,262 @@
+// Package digest compiles a periodic, by default daily, digest of the transactions rejected per tenant with the
+// reasons of the rejections and sends it to the webhooks and email addresses configured for the tenant, so that
+// integrators notice systematic policy violations without building their own reporting.
+//
+// The rejections are recorded by the API handler of the instance, hence with several API instances every instance
+// sends the digest of the rejections it handled.
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+)
+
+const (
+	jobName = "RejectionDigest"
+
+	scheduleDefault        = "@daily"
+	maxTransactionsDefault = 100
+	maxReasonsDefault      = 50
+
+	// otherReason collects the rejections whose reasons exceed the maximum number of distinct reasons of a digest
+	otherReason = "other reasons"
+)
+
+var ErrFailedToSend = errors.New("failed to send digest")
+
+// Sender sends the digest of a tenant, e.g. to a webhook or by email.
+type Sender interface {
+	Send(ctx context.Context, report Report) error
+}
+
+// Rejection is a transaction rejected on submission or by the network.
+type Rejection struct {
+	Timestamp time.Time `json:"timestamp"`
+	TxID      string    `json:"txid"`
+	Status    int       `json:"status"`
+	Reason    string    `json:"reason"`
+}
+
+// ReasonCount is the number of rejections with the same status and reason.
+type ReasonCount struct {
+	Status int    `json:"status"`
+	Reason string `json:"reason"`
+	Count  int    `json:"count"`
+}
+
+// Report is the digest of the transactions of a tenant rejected from From until To.
+type Report struct {
+	Tenant   string    `json:"tenant"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Rejected int       `json:"rejected"`
+	// Reasons are the reasons of the rejections, the most frequent first
+	Reasons []ReasonCount `json:"reasons"`
+	// Transactions are the first rejected transactions of the period, at most the configured maximum
+	Transactions []Rejection `json:"transactions"`
+}
+
+type reasonKey struct {
+	status int
+	reason string
+}
+
+type tenantRejections struct {
+	rejected     int
+	reasons      map[reasonKey]int
+	transactions []Rejection
+}
+
+// Digest records the rejected transactions of the tenants with recipients and sends their digests on schedule. The
+// rejections of a period are discarded once the digest is sent, also if sending it failed.
+type Digest struct {
+	logger          *slog.Logger
+	now             func() time.Time
+	schedule        string
+	maxTransactions int
+	maxReasons      int
+	recipients      map[string][]Sender
+
+	mu         sync.Mutex
+	periodFrom time.Time
+	rejections map[string]*tenantRejections
+
+	scheduler *scheduler.Scheduler
+}
+
+// WithSchedule sets the schedule on which the digests are sent, a cron expression or an interval, see
+// scheduler.ParseSchedule. The cron expressions are evaluated in the local time of the server.
+func WithSchedule(schedule string) func(*Digest) {
+	return func(d *Digest) {
+		d.schedule = schedule
+	}
+}
+
+// WithMaxTransactions sets the maximum number of rejected transactions listed in the digest of a tenant. All rejections
+// are counted regardless.
+func WithMaxTransactions(maxTransactions int) func(*Digest) {
+	return func(d *Digest) {
+		d.maxTransactions = maxTransactions
+	}
+}
+
+// WithRecipient sends the digest of the tenant with the senders. Only the rejections of tenants with recipients are
+// recorded.
+func WithRecipient(tenant string, senders ...Sender) func(*Digest) {
+	return func(d *Digest) {
+		d.recipients[tenant] = append(d.recipients[tenant], senders...)
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Digest) {
+	return func(d *Digest) {
+		d.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Digest)) *Digest {
+	d := &Digest{
+		logger:          logger.With(slog.String("module", "digest")),
+		now:             time.Now,
+		schedule:        scheduleDefault,
+		maxTransactions: maxTransactionsDefault,
+		maxReasons:      maxReasonsDefault,
+		recipients:      make(map[string][]Sender),
+		rejections:      make(map[string]*tenantRejections),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	d.periodFrom = d.now()
+
+	return d
+}
+
+// Start sends the digests on schedule until the digest is shut down.
+func (d *Digest) Start() error {
+	schedule, err := scheduler.ParseSchedule(d.schedule)
+	if err != nil {
+		return err
+	}
+
+	d.scheduler = scheduler.New(d.logger)
+
+	return d.scheduler.Add(jobName, schedule, func(ctx context.Context) {
+		err := d.Send(ctx)
+		if err != nil {
+			d.logger.Error("Failed to send rejection digests", slog.String("err", err.Error()))
+		}
+	})
+}
+
+// RecordRejection records a transaction of the tenant rejected on submission or by the network.
+func (d *Digest) RecordRejection(tenant string, txID string, status int, reason string) {
+	if _, found := d.recipients[tenant]; !found {
+		return
+	}
+
+	now := d.now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	r, found := d.rejections[tenant]
+	if !found {
+		r = &tenantRejections{reasons: make(map[reasonKey]int)}
+		d.rejections[tenant] = r
+	}
+
+	r.rejected++
+
+	key := reasonKey{status: status, reason: reason}
+	if _, known := r.reasons[key]; !known && len(r.reasons) >= d.maxReasons {
+		key = reasonKey{reason: otherReason}
+	}
+	r.reasons[key]++
+
+	if len(r.transactions) < d.maxTransactions {
+		r.transactions = append(r.transactions, Rejection{Timestamp: now, TxID: txID, Status: status, Reason: reason})
+	}
+}
+
+// Reports returns the digests of the tenants with rejections in the current period, ordered by tenant, and starts a
+// new period.
+func (d *Digest) Reports() []Report {
+	now := d.now()
+
+	d.mu.Lock()
+	rejections := d.rejections
+	from := d.periodFrom
+	d.rejections = make(map[string]*tenantRejections)
+	d.periodFrom = now
+	d.mu.Unlock()
+
+	reports := make([]Report, 0, len(rejections))
+	for tenant, r := range rejections {
+		report := Report{
+			Tenant:       tenant,
+			From:         from,
+			To:           now,
+			Rejected:     r.rejected,
+			Reasons:      make([]ReasonCount, 0, len(r.reasons)),
+			Transactions: r.transactions,
+		}
+
+		for key, count := range r.reasons {
+			report.Reasons = append(report.Reasons, ReasonCount{Status: key.status, Reason: key.reason, Count: count})
+		}
+		sort.Slice(report.Reasons, func(i, j int) bool {
+			if report.Reasons[i].Count != report.Reasons[j].Count {
+				return report.Reasons[i].Count > report.Reasons[j].Count
+			}
+			if report.Reasons[i].Status != report.Reasons[j].Status {
+				return report.Reasons[i].Status < report.Reasons[j].Status
+			}
+			return report.Reasons[i].Reason < report.Reasons[j].Reason
+		})
+
+		reports = append(reports, report)
+	}
+
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Tenant < reports[j].Tenant })
+
+	return reports
+}
+
+// Send sends the digests of the current period to the recipients of the tenants. Tenants without rejections do not
+// receive a digest.
+func (d *Digest) Send(ctx context.Context) error {
+	var errs []error
+
+	for _, report := range d.Reports() {
+		for _, sender := range d.recipients[report.Tenant] {
+			err := sender.Send(ctx, report)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("tenant %s: %w", report.Tenant, err))
+			}
+		}
+
+		d.logger.Info("Sent rejection digest", slog.String("tenant", report.Tenant), slog.Int("rejected", report.Rejected))
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(append([]error{ErrFailedToSend}, errs...)...)
+	}
+
+	return nil
+}
+
+func (d *Digest) Shutdown() {
+	if d.scheduler != nil {
+		d.scheduler.Shutdown()
+	}
+}
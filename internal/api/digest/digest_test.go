@@ -0,0 +1,241 @@
+package digest_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/digest"
+)
+
+type senderFunc func(ctx context.Context, report digest.Report) error
+
+func (f senderFunc) Send(ctx context.Context, report digest.Report) error {
+	return f(ctx, report)
+}
+
+func TestDigest_Send(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name            string
+		maxTransactions int
+		sendErr         error
+
+		expectedReports []digest.Report
+		expectedErr     error
+	}{
+		{
+			name:            "reports of tenants with recipients",
+			maxTransactions: 2,
+
+			expectedReports: []digest.Report{
+				{
+					Tenant:   "tenant-a",
+					From:     start,
+					To:       start.Add(time.Hour),
+					Rejected: 3,
+					Reasons: []digest.ReasonCount{
+						{Status: 465, Reason: "fee too low", Count: 2},
+						{Status: 200, Reason: "double spend", Count: 1},
+					},
+					Transactions: []digest.Rejection{
+						{Timestamp: start, TxID: "tx-1", Status: 465, Reason: "fee too low"},
+						{Timestamp: start, TxID: "tx-2", Status: 200, Reason: "double spend"},
+					},
+				},
+				{
+					Tenant:   "tenant-b",
+					From:     start,
+					To:       start.Add(time.Hour),
+					Rejected: 1,
+					Reasons:  []digest.ReasonCount{{Status: 461, Reason: "script failed", Count: 1}},
+					Transactions: []digest.Rejection{
+						{Timestamp: start, TxID: "tx-4", Status: 461, Reason: "script failed"},
+					},
+				},
+			},
+		},
+		{
+			name:            "failed to send",
+			maxTransactions: 0,
+			sendErr:         errors.New("connection refused"),
+
+			expectedReports: []digest.Report{
+				{
+					Tenant:       "tenant-a",
+					From:         start,
+					To:           start.Add(time.Hour),
+					Rejected:     3,
+					Reasons:      []digest.ReasonCount{{Status: 465, Reason: "fee too low", Count: 2}, {Status: 200, Reason: "double spend", Count: 1}},
+					Transactions: nil,
+				},
+				{
+					Tenant:       "tenant-b",
+					From:         start,
+					To:           start.Add(time.Hour),
+					Rejected:     1,
+					Reasons:      []digest.ReasonCount{{Status: 461, Reason: "script failed", Count: 1}},
+					Transactions: nil,
+				},
+			},
+			expectedErr: digest.ErrFailedToSend,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := start
+			var reports []digest.Report
+			sender := senderFunc(func(_ context.Context, report digest.Report) error {
+				reports = append(reports, report)
+				return tc.sendErr
+			})
+
+			sut := digest.New(slog.Default(),
+				digest.WithNow(func() time.Time { return now }),
+				digest.WithMaxTransactions(tc.maxTransactions),
+				digest.WithRecipient("tenant-a", sender),
+				digest.WithRecipient("tenant-b", sender),
+			)
+
+			sut.RecordRejection("tenant-a", "tx-1", 465, "fee too low")
+			sut.RecordRejection("tenant-a", "tx-2", 200, "double spend")
+			sut.RecordRejection("tenant-a", "tx-3", 465, "fee too low")
+			sut.RecordRejection("tenant-b", "tx-4", 461, "script failed")
+			sut.RecordRejection("tenant-c", "tx-5", 461, "script failed")
+			sut.RecordRejection("", "tx-6", 461, "script failed")
+			now = start.Add(time.Hour)
+
+			// when
+			err := sut.Send(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedReports, reports)
+
+			// when
+			reports = nil
+			err = sut.Send(context.Background())
+
+			// then
+			require.NoError(t, err)
+			assert.Empty(t, reports)
+		})
+	}
+}
+
+func TestWebhookSender_Send(t *testing.T) {
+	tt := []struct {
+		name       string
+		statusCode int
+
+		expectedErr error
+	}{
+		{
+			name:       "sent",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "server error",
+			statusCode: http.StatusInternalServerError,
+
+			expectedErr: digest.ErrWebhookFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var received digest.Report
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.NoError(t, json.Unmarshal(body, &received))
+
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			report := digest.Report{Tenant: "tenant-a", Rejected: 1, Reasons: []digest.ReasonCount{{Status: 465, Reason: "fee too low", Count: 1}}}
+			sut := digest.NewWebhookSender(server.URL, digest.WithWebhookToken("secret"))
+
+			// when
+			err := sut.Send(context.Background(), report)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, report, received)
+		})
+	}
+}
+
+func TestEmailSender_Send(t *testing.T) {
+	// given
+	var actualAddr, actualFrom string
+	var actualTo []string
+	var actualMsg []byte
+	var actualAuth smtp.Auth
+
+	sut := digest.NewEmailSender(digest.SMTPConfig{
+		Host:     "smtp.example.com",
+		Port:     587,
+		Username: "arc",
+		Password: "secret",
+		From:     "arc@example.com",
+	}, []string{"ops@tenant-a.example.com"}, digest.WithSendMail(func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error {
+		actualAddr, actualAuth, actualFrom, actualTo, actualMsg = addr, auth, from, to, msg
+		return nil
+	}))
+
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	report := digest.Report{
+		Tenant:       "tenant-a",
+		From:         from,
+		To:           from.Add(24 * time.Hour),
+		Rejected:     2,
+		Reasons:      []digest.ReasonCount{{Status: 465, Reason: "fee too low", Count: 2}},
+		Transactions: []digest.Rejection{{Timestamp: from, TxID: "tx-1", Status: 465, Reason: "fee too low\nminimum expected fee: 2"}},
+	}
+
+	// when
+	err := sut.Send(context.Background(), report)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", actualAddr)
+	assert.NotNil(t, actualAuth)
+	assert.Equal(t, "arc@example.com", actualFrom)
+	assert.Equal(t, []string{"ops@tenant-a.example.com"}, actualTo)
+
+	msg := string(actualMsg)
+	assert.Contains(t, msg, "Subject: ARC: 2 rejected transactions of tenant-a\r\n")
+	assert.Contains(t, msg, "2 transactions of tenant-a were rejected from 2025-03-01T00:00:00Z until 2025-03-02T00:00:00Z.")
+	assert.Contains(t, msg, "       2  465 fee too low\r\n")
+	assert.Contains(t, msg, "Transactions (1 of 2):\r\n2025-03-01T00:00:00Z  tx-1  465 fee too low minimum expected fee: 2\r\n")
+	assert.True(t, strings.HasPrefix(msg, "From: arc@example.com\r\nTo: ops@tenant-a.example.com\r\n"))
+}
@@ -0,0 +1,173 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	webhookTimeoutDefault = 10 * time.Second
+	maxErrorBody          = 4096
+)
+
+var ErrWebhookFailed = errors.New("webhook request failed")
+
+// HTTPClient sends the requests to the webhooks.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// WebhookSender posts the digest as JSON to a webhook.
+type WebhookSender struct {
+	client HTTPClient
+	url    string
+	token  string
+}
+
+func WithWebhookHTTPClient(client HTTPClient) func(*WebhookSender) {
+	return func(s *WebhookSender) {
+		s.client = client
+	}
+}
+
+// WithWebhookToken sends the token as bearer token in the Authorization header.
+func WithWebhookToken(token string) func(*WebhookSender) {
+	return func(s *WebhookSender) {
+		s.token = token
+	}
+}
+
+func NewWebhookSender(url string, opts ...func(*WebhookSender)) *WebhookSender {
+	s := &WebhookSender{
+		client: &http.Client{Timeout: webhookTimeoutDefault},
+		url:    url,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *WebhookSender) Send(ctx context.Context, report Report) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Join(ErrWebhookFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return errors.Join(ErrWebhookFailed, fmt.Errorf("status: %d, body: %s", resp.StatusCode, strings.TrimSpace(string(respBody))))
+	}
+
+	return nil
+}
+
+// SMTPConfig configures the SMTP server the digests are sent by email with. The credentials are only used if the
+// username is set, smtp.SendMail upgrades the connection with STARTTLS if the server supports it.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// SendMailFunc sends an email, see smtp.SendMail.
+type SendMailFunc func(addr string, auth smtp.Auth, from string, to []string, msg []byte) error
+
+// EmailSender sends the digest as plain text email.
+type EmailSender struct {
+	cfg      SMTPConfig
+	to       []string
+	sendMail SendMailFunc
+}
+
+func WithSendMail(sendMail SendMailFunc) func(*EmailSender) {
+	return func(s *EmailSender) {
+		s.sendMail = sendMail
+	}
+}
+
+func NewEmailSender(cfg SMTPConfig, to []string, opts ...func(*EmailSender)) *EmailSender {
+	s := &EmailSender{
+		cfg:      cfg,
+		to:       to,
+		sendMail: smtp.SendMail,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *EmailSender) Send(_ context.Context, report Report) error {
+	var auth smtp.Auth
+	if s.cfg.Username != "" {
+		auth = smtp.PlainAuth("", s.cfg.Username, s.cfg.Password, s.cfg.Host)
+	}
+
+	addr := net.JoinHostPort(s.cfg.Host, strconv.Itoa(s.cfg.Port))
+
+	return s.sendMail(addr, auth, s.cfg.From, s.to, s.message(report))
+}
+
+func (s *EmailSender) message(report Report) []byte {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "From: %s\r\n", s.cfg.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(s.to, ", "))
+	fmt.Fprintf(&b, "Subject: ARC: %d rejected transactions of %s\r\n", report.Rejected, report.Tenant)
+	fmt.Fprintf(&b, "Date: %s\r\n", report.To.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("\r\n")
+
+	fmt.Fprintf(&b, "%d transactions of %s were rejected from %s until %s.\r\n", report.Rejected, report.Tenant,
+		report.From.UTC().Format(time.RFC3339), report.To.UTC().Format(time.RFC3339))
+
+	b.WriteString("\r\nReasons:\r\n")
+	for _, reason := range report.Reasons {
+		fmt.Fprintf(&b, "%8d  %d %s\r\n", reason.Count, reason.Status, singleLine(reason.Reason))
+	}
+
+	fmt.Fprintf(&b, "\r\nTransactions (%d of %d):\r\n", len(report.Transactions), report.Rejected)
+	for _, tx := range report.Transactions {
+		fmt.Fprintf(&b, "%s  %s  %d %s\r\n", tx.Timestamp.UTC().Format(time.RFC3339), tx.TxID, tx.Status, singleLine(tx.Reason))
+	}
+
+	return []byte(b.String())
+}
+
+// singleLine joins the lines of a reason, as the details of the validation errors may span several lines.
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
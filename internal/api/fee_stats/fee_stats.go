@@ -0,0 +1,350 @@
+// Package feestats aggregates the fee rates and the throughput of the transactions accepted by ARC in hourly buckets
+// of a rolling window, so that integrators can base their fee decisions on the data of the miner.
+package feestats
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+const (
+	windowDefault     = 24 * time.Hour
+	intervalDefault   = 30 * time.Second
+	maxPendingDefault = 10000
+	batchSizeDefault  = 100
+
+	statusMined    = "MINED"
+	statusRejected = "REJECTED"
+)
+
+// TimeToMineBounds are the upper bounds of the buckets of the distribution of the time from the acceptance of a
+// transaction until it is mined. The last bucket is unbounded.
+var TimeToMineBounds = []time.Duration{
+	10 * time.Minute,
+	30 * time.Minute,
+	time.Hour,
+	2 * time.Hour,
+	6 * time.Hour,
+	24 * time.Hour,
+}
+
+// StatusGetter gets the statuses of transactions, e.g. metamorph.TransactionHandler.
+type StatusGetter interface {
+	GetTransactionStatuses(ctx context.Context, txIDs []string) ([]*metamorph.TransactionStatus, error)
+}
+
+// Aggregator counts the accepted transactions and their fee rates in the hour in which they are accepted. The accepted
+// transactions are tracked until they are mined, which the aggregator checks periodically, and are counted as mined in
+// the hour in which their mined status is observed. The time to mine is therefore measured with the accuracy of the
+// update interval. Transactions which are not mined within the window are no longer tracked.
+type Aggregator struct {
+	logger     *slog.Logger
+	client     StatusGetter
+	now        func() time.Time
+	window     time.Duration
+	interval   time.Duration
+	maxPending int
+	batchSize  int
+
+	mu      sync.Mutex
+	hours   map[int64]*hour
+	pending map[string]time.Time
+
+	cancelAll context.CancelFunc
+	ctx       context.Context
+	waitGroup *sync.WaitGroup
+}
+
+type hour struct {
+	start      time.Time
+	accepted   uint64
+	mined      uint64
+	feeRateSum float64
+	feeRates   uint64
+	timeToMine []uint64
+	mineSum    time.Duration
+}
+
+// Stats are the aggregates of the window, the hours ordered by their start, the latest hour last.
+type Stats struct {
+	Window time.Duration
+	// Accepted is the number of transactions accepted in the window
+	Accepted uint64
+	// Mined is the number of accepted transactions mined in the window
+	Mined uint64
+	// AverageFeeRate is the average fee rate in satoshis per kilobyte of the accepted transactions whose fee is known
+	AverageFeeRate float64
+	// AverageTimeToMine is the average time from the acceptance until the mining of the mined transactions
+	AverageTimeToMine time.Duration
+	// TimeToMine is the distribution of the time to mine of the mined transactions
+	TimeToMine []TimeToMineBucket
+	// Pending is the number of accepted transactions which are tracked until they are mined
+	Pending int
+	Hours   []Hour
+}
+
+type Hour struct {
+	Start          time.Time
+	Accepted       uint64
+	Mined          uint64
+	AverageFeeRate float64
+}
+
+// TimeToMineBucket is the number of transactions mined within the upper bound, but not within the upper bound of the
+// previous bucket. The upper bound of the last bucket is 0, i.e. unbounded.
+type TimeToMineBucket struct {
+	UpperBound time.Duration
+	Count      uint64
+}
+
+// WithWindow sets the duration of the rolling window of which the aggregates are kept.
+func WithWindow(window time.Duration) func(*Aggregator) {
+	return func(a *Aggregator) {
+		a.window = window
+	}
+}
+
+// WithInterval sets the interval in which the statuses of the accepted, not yet mined transactions are checked.
+func WithInterval(interval time.Duration) func(*Aggregator) {
+	return func(a *Aggregator) {
+		a.interval = interval
+	}
+}
+
+// WithMaxPending sets the maximum number of accepted transactions which are tracked until they are mined. Transactions
+// accepted while the maximum is reached are counted as accepted, but not as mined.
+func WithMaxPending(maxPending int) func(*Aggregator) {
+	return func(a *Aggregator) {
+		a.maxPending = maxPending
+	}
+}
+
+// WithBatchSize sets the number of transactions whose statuses are requested at once.
+func WithBatchSize(batchSize int) func(*Aggregator) {
+	return func(a *Aggregator) {
+		a.batchSize = batchSize
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Aggregator) {
+	return func(a *Aggregator) {
+		a.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, client StatusGetter, opts ...func(*Aggregator)) *Aggregator {
+	a := &Aggregator{
+		logger:     logger.With(slog.String("module", "fee-stats")),
+		client:     client,
+		now:        time.Now,
+		window:     windowDefault,
+		interval:   intervalDefault,
+		maxPending: maxPendingDefault,
+		batchSize:  batchSizeDefault,
+		hours:      make(map[int64]*hour),
+		pending:    make(map[string]time.Time),
+		waitGroup:  &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	a.ctx, a.cancelAll = context.WithCancel(context.Background())
+
+	return a
+}
+
+// Start checks the statuses of the tracked transactions in the update interval until the aggregator is shut down.
+func (a *Aggregator) Start() {
+	ticker := time.NewTicker(a.interval)
+	a.waitGroup.Add(1)
+
+	go func() {
+		defer a.waitGroup.Done()
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-a.ctx.Done():
+				return
+			case <-ticker.C:
+				a.Update(a.ctx)
+			}
+		}
+	}()
+}
+
+// Record counts an accepted transaction and its fee rate, if its fee details are known, and tracks it until it is mined.
+func (a *Aggregator) Record(txID string, fee *validator.FeeDetails) {
+	now := a.now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	h := a.hour(now)
+	h.accepted++
+	if fee != nil && fee.Size > 0 {
+		h.feeRateSum += float64(fee.ActualFee) * 1000 / float64(fee.Size)
+		h.feeRates++
+	}
+
+	if _, found := a.pending[txID]; found || len(a.pending) >= a.maxPending {
+		return
+	}
+	a.pending[txID] = now
+}
+
+// Update checks the statuses of the tracked transactions and counts the transactions which are mined. Rejected
+// transactions and transactions accepted before the window are no longer tracked.
+func (a *Aggregator) Update(ctx context.Context) {
+	now := a.now()
+
+	a.mu.Lock()
+	a.prune(now)
+	txIDs := make([]string, 0, len(a.pending))
+	for txID := range a.pending {
+		txIDs = append(txIDs, txID)
+	}
+	a.mu.Unlock()
+
+	for start := 0; start < len(txIDs); start += a.batchSize {
+		end := min(start+a.batchSize, len(txIDs))
+
+		statuses, err := a.client.GetTransactionStatuses(ctx, txIDs[start:end])
+		if err != nil {
+			a.logger.Warn("Failed to get statuses of accepted transactions", slog.Int("txs", end-start), slog.String("err", err.Error()))
+			return
+		}
+
+		a.mu.Lock()
+		for _, status := range statuses {
+			acceptedAt, found := a.pending[status.TxID]
+			if !found {
+				continue
+			}
+
+			switch status.Status {
+			case statusMined:
+				a.recordMined(now, now.Sub(acceptedAt))
+				delete(a.pending, status.TxID)
+			case statusRejected:
+				delete(a.pending, status.TxID)
+			}
+		}
+		a.mu.Unlock()
+	}
+}
+
+// Stats returns the aggregates of the window.
+func (a *Aggregator) Stats() Stats {
+	now := a.now()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.prune(now)
+
+	stats := Stats{
+		Window:     a.window,
+		Pending:    len(a.pending),
+		Hours:      make([]Hour, 0, len(a.hours)),
+		TimeToMine: make([]TimeToMineBucket, len(TimeToMineBounds)+1),
+	}
+	for i, bound := range TimeToMineBounds {
+		stats.TimeToMine[i].UpperBound = bound
+	}
+
+	var feeRateSum float64
+	var feeRates uint64
+	var mineSum time.Duration
+	for _, h := range a.hours {
+		stats.Accepted += h.accepted
+		stats.Mined += h.mined
+		feeRateSum += h.feeRateSum
+		feeRates += h.feeRates
+		mineSum += h.mineSum
+		for i, count := range h.timeToMine {
+			stats.TimeToMine[i].Count += count
+		}
+
+		stats.Hours = append(stats.Hours, Hour{
+			Start:          h.start,
+			Accepted:       h.accepted,
+			Mined:          h.mined,
+			AverageFeeRate: average(h.feeRateSum, h.feeRates),
+		})
+	}
+
+	sort.Slice(stats.Hours, func(i, j int) bool {
+		return stats.Hours[i].Start.Before(stats.Hours[j].Start)
+	})
+
+	stats.AverageFeeRate = average(feeRateSum, feeRates)
+	if stats.Mined > 0 {
+		stats.AverageTimeToMine = mineSum / time.Duration(stats.Mined) // #nosec G115
+	}
+
+	return stats
+}
+
+// Shutdown stops the checking of the statuses of the tracked transactions.
+func (a *Aggregator) Shutdown() {
+	a.cancelAll()
+	a.waitGroup.Wait()
+}
+
+func (a *Aggregator) recordMined(now time.Time, timeToMine time.Duration) {
+	h := a.hour(now)
+	h.mined++
+	h.mineSum += timeToMine
+
+	i := sort.Search(len(TimeToMineBounds), func(i int) bool {
+		return timeToMine <= TimeToMineBounds[i]
+	})
+	h.timeToMine[i]++
+}
+
+// hour returns the bucket of the hour of the time, which is created if it doesn't exist.
+func (a *Aggregator) hour(t time.Time) *hour {
+	start := t.UTC().Truncate(time.Hour)
+
+	h, found := a.hours[start.Unix()]
+	if !found {
+		h = &hour{start: start, timeToMine: make([]uint64, len(TimeToMineBounds)+1)}
+		a.hours[start.Unix()] = h
+	}
+
+	return h
+}
+
+// prune removes the hours which ended before the window and stops tracking the transactions accepted before the window.
+func (a *Aggregator) prune(now time.Time) {
+	windowStart := now.Add(-a.window)
+
+	for key, h := range a.hours {
+		if !h.start.Add(time.Hour).After(windowStart) {
+			delete(a.hours, key)
+		}
+	}
+
+	for txID, acceptedAt := range a.pending {
+		if acceptedAt.Before(windowStart) {
+			delete(a.pending, txID)
+		}
+	}
+}
+
+func average(sum float64, count uint64) float64 {
+	if count == 0 {
+		return 0
+	}
+
+	return sum / float64(count)
+}
@@ -0,0 +1,148 @@
+package feestats_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	feestats "github.com/bitcoin-sv/arc/internal/api/fee_stats"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+func TestAggregator_Stats(t *testing.T) {
+	tt := []struct {
+		name        string
+		statuses    map[string]string
+		statusesErr error
+		elapsed     time.Duration
+
+		expectedAccepted       uint64
+		expectedMined          uint64
+		expectedPending        int
+		expectedHours          int
+		expectedAverageFeeRate float64
+		expectedTimeToMine     map[time.Duration]uint64
+	}{
+		{
+			name:     "mined and pending",
+			statuses: map[string]string{"tx-1": "MINED", "tx-2": "SEEN_ON_NETWORK", "tx-3": "MINED"},
+			elapsed:  20 * time.Minute,
+
+			expectedAccepted:       3,
+			expectedMined:          2,
+			expectedPending:        1,
+			expectedHours:          1,
+			expectedAverageFeeRate: 75,
+			expectedTimeToMine:     map[time.Duration]uint64{30 * time.Minute: 2},
+		},
+		{
+			name:     "rejected - no longer pending",
+			statuses: map[string]string{"tx-1": "REJECTED", "tx-2": "REJECTED", "tx-3": "MINED"},
+			elapsed:  3 * time.Hour,
+
+			expectedAccepted:       3,
+			expectedMined:          1,
+			expectedHours:          2,
+			expectedAverageFeeRate: 75,
+			expectedTimeToMine:     map[time.Duration]uint64{6 * time.Hour: 1},
+		},
+		{
+			name:        "error getting statuses",
+			statusesErr: errors.New("metamorph unavailable"),
+			elapsed:     time.Minute,
+
+			expectedAccepted:       3,
+			expectedPending:        3,
+			expectedHours:          1,
+			expectedAverageFeeRate: 75,
+		},
+		{
+			name:     "accepted before the window",
+			statuses: map[string]string{"tx-1": "MINED", "tx-2": "MINED", "tx-3": "MINED"},
+			elapsed:  25 * time.Hour,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2026, 3, 1, 12, 10, 0, 0, time.UTC)
+			client := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, txIDs []string) ([]*metamorph.TransactionStatus, error) {
+					if tc.statusesErr != nil {
+						return nil, tc.statusesErr
+					}
+
+					statuses := make([]*metamorph.TransactionStatus, 0, len(txIDs))
+					for _, txID := range txIDs {
+						statuses = append(statuses, &metamorph.TransactionStatus{TxID: txID, Status: tc.statuses[txID]})
+					}
+					return statuses, nil
+				},
+			}
+
+			sut := feestats.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), client,
+				feestats.WithBatchSize(2),
+				feestats.WithNow(func() time.Time { return now }),
+			)
+
+			sut.Record("tx-1", &validator.FeeDetails{Size: 200, ActualFee: 10})
+			sut.Record("tx-2", &validator.FeeDetails{Size: 100, ActualFee: 10})
+			sut.Record("tx-3", nil)
+
+			now = now.Add(tc.elapsed)
+
+			// when
+			sut.Update(context.Background())
+			actual := sut.Stats()
+
+			// then
+			assert.Equal(t, tc.expectedAccepted, actual.Accepted)
+			assert.Equal(t, tc.expectedMined, actual.Mined)
+			assert.Equal(t, tc.expectedPending, actual.Pending)
+			assert.Len(t, actual.Hours, tc.expectedHours)
+			assert.InDelta(t, tc.expectedAverageFeeRate, actual.AverageFeeRate, 0.001)
+			if tc.expectedMined > 0 {
+				assert.Equal(t, tc.elapsed, actual.AverageTimeToMine)
+			}
+
+			require.Len(t, actual.TimeToMine, len(feestats.TimeToMineBounds)+1)
+			for _, bucket := range actual.TimeToMine {
+				assert.Equal(t, tc.expectedTimeToMine[bucket.UpperBound], bucket.Count, bucket.UpperBound.String())
+			}
+		})
+	}
+}
+
+func TestAggregator_Record(t *testing.T) {
+	// given
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := &mtmMocks.TransactionHandlerMock{}
+	sut := feestats.New(slog.New(slog.NewTextHandler(os.Stdout, nil)), client,
+		feestats.WithMaxPending(2),
+		feestats.WithNow(func() time.Time { return now }),
+	)
+
+	// when
+	sut.Record("tx-1", nil)
+	now = now.Add(time.Hour)
+	sut.Record("tx-2", nil)
+	sut.Record("tx-3", nil)
+
+	// then
+	actual := sut.Stats()
+	assert.Equal(t, uint64(3), actual.Accepted)
+	assert.Equal(t, 2, actual.Pending)
+	require.Len(t, actual.Hours, 2)
+	assert.Equal(t, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), actual.Hours[0].Start)
+	assert.Equal(t, uint64(1), actual.Hours[0].Accepted)
+	assert.Equal(t, uint64(2), actual.Hours[1].Accepted)
+}
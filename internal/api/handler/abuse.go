@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// keyFingerprintLength is the number of hex characters of the hash of an API key by which its client is identified
+const keyFingerprintLength = 16
+
+var (
+	ErrAbuseThrottled = errors.New("submissions throttled due to abuse score")
+	ErrAbuseRejected  = errors.New("transaction rejected due to abuse score")
+)
+
+// WithAbuseScoring scores the submitted transactions of each client for abuse before they are validated and throttles
+// or rejects the submissions of clients whose score exceeds the thresholds of the scorer.
+func WithAbuseScoring(scorer *abuse.Scorer) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.abuseScorer = scorer
+	}
+}
+
+// AbuseScores returns the scores of the clients which submitted transactions recently, the highest score first.
+func (m *ArcDefaultHandler) AbuseScores() []abuse.KeyScore {
+	if m.abuseScorer == nil {
+		return nil
+	}
+
+	return m.abuseScorer.Scores()
+}
+
+// abuseKey returns the key by which the submissions of the request are scored, which is the fingerprint of the API key
+// of the request or, without API key, the address of the client. The API key itself is not used, so that it is not
+// revealed by the scores.
+func (m *ArcDefaultHandler) abuseKey(ctx echo.Context) string {
+	if m.abuseScorer == nil {
+		return ""
+	}
+
+	key := apiKey(ctx.Request())
+	if key == "" {
+		return "ip:" + ctx.RealIP()
+	}
+
+	hash := sha256.Sum256([]byte(key))
+
+	return "key:" + hex.EncodeToString(hash[:])[:keyFingerprintLength]
+}
+
+// checkAbuseScore scores the submission of the transaction by the client of the request.
+func (m *ArcDefaultHandler) checkAbuseScore(options *metamorph.TransactionOptions, tx *sdkTx.Transaction) *validator.Error {
+	if m.abuseScorer == nil {
+		return nil
+	}
+
+	decision, score := m.abuseScorer.Score(options.AbuseKey, tx, m.feeDetails(tx))
+	switch decision {
+	case abuse.DecisionThrottle:
+		return validator.NewError(errors.Join(ErrAbuseThrottled, fmt.Errorf("score %.2f", score.Score)), api.ErrStatusAbuseThrottled)
+	case abuse.DecisionReject:
+		return validator.NewError(errors.Join(ErrAbuseRejected, fmt.Errorf("score %.2f", score.Score)), api.ErrStatusAbuseRejected)
+	default:
+		return nil
+	}
+}
+
+// checkBEEFAbuseScore scores the submissions of the transactions of the BEEF which are submitted.
+func (m *ArcDefaultHandler) checkBEEFAbuseScore(options *metamorph.TransactionOptions, beefTx *sdkTx.Beef) (*sdkTx.Transaction, error) {
+	return checkBEEFTransactions(beefTx, func(tx *sdkTx.Transaction) *validator.Error {
+		return m.checkAbuseScore(options, tx)
+	})
+}
+
+// recordAbuseOutcome records the numbers of accepted and rejected transactions of the client of the request. The
+// transactions rejected due to the abuse score are not counted, so that a throttled client doesn't rate itself worse.
+func (m *ArcDefaultHandler) recordAbuseOutcome(options *metamorph.TransactionOptions, successes []*api.TransactionResponse, fails []*api.ErrorFields) {
+	if m.abuseScorer == nil {
+		return
+	}
+
+	var accepted, rejected int
+	for _, success := range successes {
+		if success.TxStatus == api.TransactionResponseTxStatusREJECTED {
+			rejected++
+			continue
+		}
+		accepted++
+	}
+
+	for _, fail := range fails {
+		if fail.Status == int(api.ErrStatusAbuseThrottled) || fail.Status == int(api.ErrStatusAbuseRejected) {
+			continue
+		}
+		rejected++
+	}
+
+	m.abuseScorer.RecordOutcome(options.AbuseKey, accepted, rejected)
+}
@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/api/admission"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// retryAfterSeconds is the delay after which clients are asked to retry submissions rejected due to the memory budget
+const retryAfterSeconds = "1"
+
+// WithAdmissionControl bounds the memory used by the submissions in flight. The size of the body of a submission is
+// reserved from the memory budget of the controller before the body is read and the submissions exceeding the budget
+// are rejected with 429.
+func WithAdmissionControl(controller *admission.Controller) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.admission = controller
+	}
+}
+
+// admit reserves the size of the body of the request from the memory budget. The returned function releases the
+// reservation once the request is processed. As the size of a chunked body is unknown, it is read up to the budget
+// before its size is reserved.
+func (m *ArcDefaultHandler) admit(ctx echo.Context) (func(), *api.ErrorFields) {
+	if m.admission == nil {
+		return func() {}, nil
+	}
+
+	request := ctx.Request()
+	size := request.ContentLength
+	if size < 0 {
+		body, err := io.ReadAll(io.LimitReader(request.Body, m.admission.Budget()+1))
+		if err != nil {
+			return nil, newInvalidBodyError(err)
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		size = int64(len(body))
+	}
+
+	release, err := m.admission.Admit(request.Context(), size)
+	if err != nil {
+		if errors.Is(err, admission.ErrBudgetExhausted) {
+			ctx.Response().Header().Set("Retry-After", retryAfterSeconds)
+		}
+		return nil, api.NewErrorFields(api.ErrStatusTooManyRequests, err.Error())
+	}
+
+	return release, nil
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+var ErrInvalidAnnounceTo = errors.New("invalid announcement restriction")
+
+// WithDesignatedPeers sets the names of the designated peers, to which transactions can be announced exclusively with
+// the header X-AnnounceTo.
+func WithDesignatedPeers(names ...string) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.designatedPeers = make(map[string]struct{}, len(names))
+		for _, name := range names {
+			p.designatedPeers[name] = struct{}{}
+		}
+	}
+}
+
+// announceTo returns the restriction of the peers the submitted transactions are announced to from the header
+// X-AnnounceTo, either a positive number of peers or the name of a designated peer. It is empty if the transactions
+// are announced to all peers.
+func (m *ArcDefaultHandler) announceTo(params api.POSTTransactionsParams) (string, error) {
+	if params.XAnnounceTo == nil || *params.XAnnounceTo == "" {
+		return "", nil
+	}
+
+	announceTo := *params.XAnnounceTo
+
+	count, err := strconv.Atoi(announceTo)
+	if err == nil {
+		if count < 1 {
+			return "", errors.Join(ErrInvalidAnnounceTo, fmt.Errorf("number of peers: %d", count))
+		}
+		return strconv.Itoa(count), nil
+	}
+
+	if _, found := m.designatedPeers[announceTo]; !found {
+		return "", errors.Join(ErrInvalidAnnounceTo, fmt.Errorf("unknown designated peer: %s", announceTo))
+	}
+
+	return announceTo, nil
+}
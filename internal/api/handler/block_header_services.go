@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// BlockHeaderServiceStatus is the availability of a block header service. LastSuccess is the time of the last
+// successful request to the service, Reason the error of the last status check if the service is unavailable.
+// ThrottledUntil is the time until which the service is not requested because it rate limited the requests.
+type BlockHeaderServiceStatus struct {
+	URL            string
+	Available      bool
+	LastSuccess    time.Time
+	Reason         string
+	ThrottledUntil time.Time
+}
+
+// WithBlockHeaderServices includes the availability and the time of the last successful request of each block header
+// service against which merkle roots are verified, as returned by statuses, in the response of the health endpoint.
+func WithBlockHeaderServices(statuses func() []BlockHeaderServiceStatus) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.blockHeaderServices = statuses
+	}
+}
+
+func (m *ArcDefaultHandler) blockHeaderServiceStatuses() *[]api.BlockHeaderServiceStatus {
+	if m.blockHeaderServices == nil {
+		return nil
+	}
+
+	statuses := m.blockHeaderServices()
+	result := make([]api.BlockHeaderServiceStatus, 0, len(statuses))
+	for _, status := range statuses {
+		s := api.BlockHeaderServiceStatus{
+			Url:       status.URL,
+			Available: status.Available,
+		}
+		if !status.LastSuccess.IsZero() {
+			s.LastSuccess = PtrTo(status.LastSuccess.UTC())
+		}
+		if status.Reason != "" {
+			s.Reason = PtrTo(status.Reason)
+		}
+		if !status.ThrottledUntil.IsZero() {
+			s.ThrottledUntil = PtrTo(status.ThrottledUntil.UTC())
+		}
+		result = append(result, s)
+	}
+
+	return &result
+}
@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/api/compliance"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+var ErrComplianceBlocked = errors.New("transaction blocked by compliance engine")
+
+// WithCompliance scans the output scripts and data carrier contents of the submitted transactions with the compliance
+// engine of the checker before they are validated and rejects the transactions it blocks. The check is enforced even
+// if the validation is skipped.
+func WithCompliance(checker *compliance.Checker) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.complianceChecker = checker
+	}
+}
+
+// checkCompliance returns an error if the compliance engine blocks the transaction.
+func (m *ArcDefaultHandler) checkCompliance(ctx context.Context, tx *sdkTx.Transaction) *validator.Error {
+	if m.complianceChecker == nil {
+		return nil
+	}
+
+	decision, err := m.complianceChecker.Check(ctx, tx)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to scan transaction for compliance", slog.String("hash", tx.TxID().String()), slog.String("err", err.Error()))
+	}
+
+	if decision.Action != compliance.ActionBlock {
+		return nil
+	}
+
+	return validator.NewError(errors.Join(ErrComplianceBlocked, fmt.Errorf("reason: %s", decision.Reason)), api.ErrStatusComplianceBlocked)
+}
+
+// checkBEEFCompliance scans the transactions of the BEEF which are submitted.
+func (m *ArcDefaultHandler) checkBEEFCompliance(ctx context.Context, beefTx *sdkTx.Beef) (*sdkTx.Transaction, error) {
+	return checkBEEFTransactions(beefTx, func(tx *sdkTx.Transaction) *validator.Error {
+		return m.checkCompliance(ctx, tx)
+	})
+}
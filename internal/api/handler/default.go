@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -14,15 +15,32 @@ import (
 	"time"
 
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
 	"github.com/ccoveille/go-safecast"
 	"github.com/labstack/echo/v4"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/ordishs/go-bitcoin"
 	"go.opentelemetry.io/otel/attribute"
 
+	internalApi "github.com/bitcoin-sv/arc/internal/api"
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/api/admission"
+	"github.com/bitcoin-sv/arc/internal/api/compliance"
+	"github.com/bitcoin-sv/arc/internal/api/digest"
+	feestats "github.com/bitcoin-sv/arc/internal/api/fee_stats"
+	"github.com/bitcoin-sv/arc/internal/api/receipt"
+	"github.com/bitcoin-sv/arc/internal/api/reverification"
+	templatestats "github.com/bitcoin-sv/arc/internal/api/template_stats"
 	"github.com/bitcoin-sv/arc/internal/beef"
 	"github.com/bitcoin-sv/arc/internal/blocktx"
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/callbacker"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/federation"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
 	"github.com/bitcoin-sv/arc/internal/validator"
 	"github.com/bitcoin-sv/arc/internal/version"
 	"github.com/bitcoin-sv/arc/pkg/api"
@@ -36,21 +54,44 @@ const (
 	GenesisForkBlockMain         = int32(620539)
 	GenesisForkBlockTest         = int32(1344302)
 	GenesisForkBlockRegtest      = int32(10000)
+	maxCallbackRecipients        = 10
+	maxCallbackSuppressionWindow = 3600
+	// statusLookupTimeout is the time granted to look up the best-known statuses of the transactions after the deadline
+	// of the request was exceeded
+	statusLookupTimeout = 1 * time.Second
+	// broadcastDelayMax is the maximum time ahead for which the broadcast of a transaction can be scheduled
+	broadcastDelayMax   = 7 * 24 * time.Hour
+	latestBlocksDefault = 10
+	latestBlocksMax     = 100
 )
 
 var (
 	ErrInvalidCallbackURL       = errors.New("invalid callback URL")
 	ErrCallbackURLNotAcceptable = errors.New("callback URL not acceptable")
 	ErrStatusNotSupported       = errors.New("status not supported")
+	ErrCallbackVersionInvalid   = errors.New("callback version not supported")
+	ErrInvalidSuppressionWindow = fmt.Errorf("callback suppression window must be between 0 and %d seconds", maxCallbackSuppressionWindow)
+	ErrInvalidCallbackList      = errors.New("invalid callback URL list")
+	ErrTooManyCallbackURLs      = fmt.Errorf("number of callback URLs can not be higher than %d", maxCallbackRecipients)
 	ErrDecodingBeef             = errors.New("error while decoding BEEF")
 	ErrBeefByteSlice            = errors.New("error while getting BEEF byte slice")
 	ErrMaxTimeoutExceeded       = fmt.Errorf("max timeout can not be higher than %d", metamorph.MaxTimeout)
+	ErrDeadlineExceeded         = errors.New("deadline of the request exceeded before the transaction was submitted")
+	ErrInvalidBroadcastTime     = errors.New("invalid broadcast time")
+	ErrInvalidExpiry            = errors.New("invalid expiry")
+	ErrPolicyReloadDisabled     = errors.New("policy reload not enabled")
+	ErrPolicyReloadFailed       = errors.New("failed to reload policy")
+	ErrFederationDisabled       = errors.New("federation not enabled")
+	ErrReceiptsDisabled         = errors.New("receipts not enabled")
 )
 
 type ArcDefaultHandler struct {
 	TransactionHandler      metamorph.TransactionHandler
 	btxClient               blocktx.Client
 	NodePolicy              *bitcoin.Settings
+	policyMu                *sync.RWMutex
+	policySource            func(ctx context.Context) (*bitcoin.Settings, error)
+	newValidators           func(policy *bitcoin.Settings) (DefaultValidator, BeefValidator)
 	maxTxSizePolicy         uint64
 	maxTxSigopsCountsPolicy uint64
 	maxscriptsizepolicy     uint64
@@ -67,9 +108,39 @@ type ArcDefaultHandler struct {
 	tracingEnabled                bool
 	tracingAttributes             []attribute.KeyValue
 	stats                         *Stats
+	submissionRecorder            SubmissionRecorder
 	defaultValidator              DefaultValidator
 	beefValidator                 BeefValidator
 	standardFormatSupported       bool
+	consolidationFeeModel         *feemodel.SatoshisPerKilobyte
+	knownTxCacheTTL               time.Duration
+	knownTxs                      *knownTxCache
+	statuses                      *statusCache
+	statusMapping                 []StatusMappingRule
+	scriptPolicies                map[string][]validator.ScriptTemplate
+	opcodeLimits                  []validator.OpcodeLimit
+	templateStats                 *templatestats.Detector
+	featureFlags                  *feature.Flags
+	tenants                       map[string]string
+	crashReporter                 validator.CrashReporter
+	readOnly                      bool
+	holdNonFinal                  bool
+	externalStatusNode            ExternalStatusNode
+	federation                    Federation
+	abuseScorer                   *abuse.Scorer
+	admission                     *admission.Controller
+	feeStats                      *feestats.Aggregator
+	complianceChecker             *compliance.Checker
+	mempoolAcceptance             MempoolAcceptanceNode
+	receiptSigner                 *receipt.Signer
+	receiptStore                  *receipt.Store
+	fastPathMaxSize               int
+	txClaims                      *txClaims
+	rejectionDigest               *digest.Digest
+	designatedPeers               map[string]struct{}
+	blockHeaderServices           func() []BlockHeaderServiceStatus
+	streamBatchSize               int
+	reverifier                    *reverification.Reverifier
 }
 
 type PostResponse struct {
@@ -89,6 +160,28 @@ func WithStats(stats *Stats) func(*ArcDefaultHandler) {
 	}
 }
 
+// WithSubmissionRecorder sets the recorder of the submitted and rejected transactions, e.g. the operator dashboard.
+func WithSubmissionRecorder(recorder SubmissionRecorder) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.submissionRecorder = recorder
+	}
+}
+
+// WithRejectionDigest records the rejected transactions by tenant for the periodic digest sent to the tenants.
+func WithRejectionDigest(rejectionDigest *digest.Digest) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.rejectionDigest = rejectionDigest
+	}
+}
+
+// WithFederation forwards the accepted transactions to the upstream ARC instances of the federation and serves their
+// acknowledgements at GET /tx/{txid}/upstreams.
+func WithFederation(federation Federation) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.federation = federation
+	}
+}
+
 func WithStandardFormatSupported(standardFormatSupported bool) func(*ArcDefaultHandler) {
 	return func(p *ArcDefaultHandler) {
 		p.standardFormatSupported = standardFormatSupported
@@ -113,6 +206,53 @@ func WithRebroadcastExpiration(d time.Duration) func(*ArcDefaultHandler) {
 	}
 }
 
+// WithConsolidationFee applies the given minimum mining fee in BSV per kilobyte to consolidation transactions in the
+// fee details of the responses. The validators have to be configured with the same fee.
+func WithConsolidationFee(minMiningTxFee float64) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.consolidationFeeModel = internalApi.FeesToFeeModel(minMiningTxFee)
+	}
+}
+
+// WithKnownTxCache caches the statuses of transactions which were already processed for the given duration, so that
+// resubmissions of the same transactions are answered from the cache.
+func WithKnownTxCache(ttl time.Duration) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.knownTxCacheTTL = ttl
+	}
+}
+
+// WithStatusCache caches the statuses requested by GET /tx/{txid} in the given store for the given duration. The cached
+// statuses are expected to be invalidated with InvalidateStatuses once their status is updated.
+func WithStatusCache(store cache.Store, ttl time.Duration) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.statuses = newStatusCache(store, ttl)
+	}
+}
+
+// WithPolicyReload enables ReloadPolicy, which loads the policy from the source and replaces the validators with
+// validators of the loaded policy.
+func WithPolicyReload(source func(ctx context.Context) (*bitcoin.Settings, error), newValidators func(policy *bitcoin.Settings) (DefaultValidator, BeefValidator)) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.policySource = source
+		p.newValidators = newValidators
+	}
+}
+
+// WithFeatureFlags ignores the header X-CumulativeFeeValidation while the flag feature.CumulativeFees is off.
+func WithFeatureFlags(flags *feature.Flags) func(*ArcDefaultHandler) {
+	return func(s *ArcDefaultHandler) {
+		s.featureFlags = flags
+	}
+}
+
+// WithCrashReporter persists the submissions whose decoding panicked with the reporter.
+func WithCrashReporter(reporter validator.CrashReporter) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.crashReporter = reporter
+	}
+}
+
 func WithTracer(attr ...attribute.KeyValue) func(s *ArcDefaultHandler) {
 	return func(a *ArcDefaultHandler) {
 		a.tracingEnabled = true
@@ -132,6 +272,19 @@ type DefaultValidator interface {
 	ValidateTransaction(ctx context.Context, tx *sdkTx.Transaction, feeValidation validator.FeeValidation, scriptValidation validator.ScriptValidation, blockHeight int32) error
 }
 
+// SubmissionRecorder records the transactions submitted to metamorph and the transactions rejected on submission.
+type SubmissionRecorder interface {
+	RecordSubmissions(count int)
+	RecordRejection(txID string, status int, reason string)
+}
+
+// Federation forwards the transactions accepted by this instance to upstream ARC instances and returns which upstreams
+// acknowledged them.
+type Federation interface {
+	Forward(ctx context.Context, txs []*sdkTx.Transaction) (map[string]*federation.Result, error)
+	Acknowledgements(txID string) ([]federation.Acknowledgement, error)
+}
+
 type BeefValidator interface {
 	ValidateTransaction(ctx context.Context, beefTx *sdkTx.Beef, feeValidation validator.FeeValidation, scriptValidation validator.ScriptValidation, blockHeight int32) (failedTx *sdkTx.Transaction, err error)
 }
@@ -145,28 +298,15 @@ func NewDefault(
 	beefValidator BeefValidator,
 	opts ...Option,
 ) (*ArcDefaultHandler, error) {
-	var maxscriptsizepolicy, maxTxSigopsCountsPolicy, maxTxSizePolicy uint64
-	var err error
-	if policy != nil {
-		maxscriptsizepolicy, err = safecast.ToUint64(policy.MaxScriptSizePolicy)
-		if err != nil {
-			return nil, err
-		}
-
-		maxTxSigopsCountsPolicy, err = safecast.ToUint64(policy.MaxTxSigopsCountsPolicy)
-		if err != nil {
-			return nil, err
-		}
-
-		maxTxSizePolicy, err = safecast.ToUint64(policy.MaxTxSizePolicy)
-		if err != nil {
-			return nil, err
-		}
+	maxscriptsizepolicy, maxTxSigopsCountsPolicy, maxTxSizePolicy, err := policyLimits(policy)
+	if err != nil {
+		return nil, err
 	}
 
 	handler := &ArcDefaultHandler{
 		TransactionHandler:      transactionHandler,
 		NodePolicy:              policy,
+		policyMu:                &sync.RWMutex{},
 		logger:                  logger,
 		now:                     time.Now,
 		rebroadcastExpiration:   rebroadcastExpirationDefault,
@@ -178,6 +318,7 @@ func NewDefault(
 		waitGroup:               &sync.WaitGroup{},
 		defaultValidator:        defaultValidator,
 		beefValidator:           beefValidator,
+		streamBatchSize:         streamBatchSizeDefault,
 	}
 
 	// apply options
@@ -185,6 +326,15 @@ func NewDefault(
 		opt(handler)
 	}
 
+	err = validateStatusMapping(handler.statusMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	if handler.knownTxCacheTTL > 0 {
+		handler.knownTxs = newKnownTxCache(handler.knownTxCacheTTL, handler.now)
+	}
+
 	ctx, cancelAll := context.WithCancel(context.Background())
 	handler.cancelAll = cancelAll
 	handler.ctx = ctx
@@ -230,14 +380,17 @@ func (m *ArcDefaultHandler) GETPolicy(ctx echo.Context) (err error) {
 		tracing.EndTracing(span, err)
 	}()
 
+	m.policyMu.RLock()
 	satoshis, bytes := calcFeesFromBSVPerKB(m.NodePolicy.MinMiningTxFee)
+	maxscriptsizepolicy, maxTxSigopsCountsPolicy, maxTxSizePolicy := m.maxscriptsizepolicy, m.maxTxSigopsCountsPolicy, m.maxTxSizePolicy
+	m.policyMu.RUnlock()
 
 	return ctx.JSON(http.StatusOK, api.PolicyResponse{
 
 		Policy: api.Policy{
-			Maxscriptsizepolicy:     m.maxscriptsizepolicy,
-			Maxtxsigopscountspolicy: m.maxTxSigopsCountsPolicy,
-			Maxtxsizepolicy:         m.maxTxSizePolicy,
+			Maxscriptsizepolicy:     maxscriptsizepolicy,
+			Maxtxsigopscountspolicy: maxTxSigopsCountsPolicy,
+			Maxtxsizepolicy:         maxTxSizePolicy,
 			MiningFee: api.FeeAmount{
 				Bytes:    bytes,
 				Satoshis: satoshis,
@@ -256,12 +409,37 @@ func (m *ArcDefaultHandler) GETHealth(ctx echo.Context) (err error) {
 		reason = &errMsg
 	}
 	return ctx.JSON(http.StatusOK, api.Health{
-		Healthy: PtrTo(err == nil),
-		Version: &version.Version,
-		Reason:  reason,
+		Healthy:             PtrTo(err == nil),
+		Version:             &version.Version,
+		Reason:              reason,
+		BlockHeaderServices: m.blockHeaderServiceStatuses(),
 	})
 }
 
+func (m *ArcDefaultHandler) GETBlocks(ctx echo.Context, params api.GETBlocksParams) (err error) {
+	reqCtx, span := tracing.StartTracing(ctx.Request().Context(), "GETBlocks", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	limit := uint64(latestBlocksDefault)
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = uint64(min(*params.Limit, latestBlocksMax)) // #nosec G115
+	}
+
+	resp, err := m.btxClient.LatestBlocks(reqCtx, limit)
+	if err != nil {
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIBlocks(resp.GetBlocks()))
+}
+
 func calcFeesFromBSVPerKB(feePerKB float64) (uint64, uint64) {
 	bytes := uint64(1000)
 	fSatoshis := feePerKB * 1e8
@@ -279,11 +457,15 @@ func calcFeesFromBSVPerKB(feePerKB float64) (uint64, uint64) {
 
 // POSTTransaction ...
 func (m *ArcDefaultHandler) POSTTransaction(ctx echo.Context, params api.POSTTransactionParams) (err error) {
+	if e := m.readOnlyError(); e != nil {
+		return problemJSON(ctx, e)
+	}
+
 	timeout := m.defaultTimeout
 	if params.XMaxTimeout != nil {
 		if *params.XMaxTimeout > metamorph.MaxTimeout {
-			e := api.NewErrorFields(api.ErrStatusBadRequest, ErrMaxTimeoutExceeded.Error())
-			return ctx.JSON(e.Status, e)
+			e := newInvalidHeaderError(ErrMaxTimeoutExceeded)
+			return problemJSON(ctx, e)
 		}
 		timeout = time.Second * time.Duration(*params.XMaxTimeout)
 	}
@@ -292,11 +474,16 @@ func (m *ArcDefaultHandler) POSTTransaction(ctx echo.Context, params api.POSTTra
 	ctx.SetRequest(ctx.Request().WithContext(timeoutCtx))
 	defer cancel()
 
+	release, e := m.admit(ctx)
+	if e != nil {
+		return problemJSON(ctx, e)
+	}
+	defer release()
+
 	txsHex, err := parseTransactionFromRequest(ctx.Request())
 	if err != nil {
-		e := api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprintf("error parsing transactions from request: %s", err.Error()))
-		res := PostResponse{e.Status, e}
-		return ctx.JSON(res.StatusCode, res.response)
+		e := newInvalidBodyError(err)
+		return problemJSON(ctx, e)
 	}
 	txsParams := api.POSTTransactionsParams(params)
 	postResponse := m.postTransactions(ctx, txsHex, txsParams)
@@ -314,7 +501,7 @@ func (m *ArcDefaultHandler) POSTTransaction(ctx echo.Context, params api.POSTTra
 			case *api.ErrorFields:
 				res, ok := postResponse.response.([]interface{})[0].(*api.ErrorFields)
 				if ok {
-					return ctx.JSON(res.Status, res)
+					return problemJSON(ctx, res)
 				}
 			}
 		}
@@ -322,7 +509,7 @@ func (m *ArcDefaultHandler) POSTTransaction(ctx echo.Context, params api.POSTTra
 	case *api.ErrorFields:
 		res, ok := postResponse.response.(*api.ErrorFields)
 		if ok {
-			return ctx.JSON(res.Status, res)
+			return problemJSON(ctx, res)
 		}
 	}
 	return ctx.JSON(postResponse.StatusCode, postResponse.response)
@@ -338,10 +525,26 @@ func (m *ArcDefaultHandler) GETTransactionStatus(ctx echo.Context, id string) (e
 	}()
 
 	tx, err := m.getTransactionStatus(reqCtx, id)
+
+	if errors.Is(err, metamorph.ErrTransactionNotFound) || (err == nil && tx == nil) {
+		deferredTx, found := m.lookupDeferredStatus(reqCtx, id)
+		if found {
+			tx, err = deferredTx, nil
+		}
+	}
+
+	var external *bool
+	if errors.Is(err, metamorph.ErrTransactionNotFound) || (err == nil && tx == nil) {
+		externalTx, found := m.lookupExternalStatus(reqCtx, id)
+		if found {
+			tx, err, external = externalTx, nil, PtrTo(true)
+		}
+	}
+
 	if err != nil {
 		if errors.Is(err, metamorph.ErrTransactionNotFound) {
 			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
-			return ctx.JSON(e.Status, e)
+			return problemJSON(ctx, e)
 		}
 
 		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
@@ -349,7 +552,7 @@ func (m *ArcDefaultHandler) GETTransactionStatus(ctx echo.Context, id string) (e
 			attr := e.GetSpanAttributes()
 			span.SetAttributes(attr...)
 		}
-		return ctx.JSON(e.Status, e)
+		return problemJSON(ctx, e)
 	}
 
 	if tx == nil {
@@ -358,21 +561,208 @@ func (m *ArcDefaultHandler) GETTransactionStatus(ctx echo.Context, id string) (e
 			attr := e.GetSpanAttributes()
 			span.SetAttributes(attr...)
 		}
-		return ctx.JSON(e.Status, e)
+		return problemJSON(ctx, e)
+	}
+
+	if notModified(ctx, tx) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	return ctx.JSON(http.StatusOK, api.TransactionStatus{
+		BlockHash:      &tx.BlockHash,
+		BlockHeight:    &tx.BlockHeight,
+		TxStatus:       (api.TransactionStatusTxStatus)(tx.Status),
+		Timestamp:      m.now(),
+		Txid:           tx.TxID,
+		MerklePath:     &tx.MerklePath,
+		ExtraInfo:      &tx.ExtraInfo,
+		CompetingTxs:   &tx.CompetingTxs,
+		Timings:        toAPIStageTimings(tx.StageTimings),
+		BlockTemplate:  toAPIBlockTemplate(tx.BlockTemplate),
+		Peers:          toAPIPeerAcks(tx.PeerAcks),
+		Annotations:    toAPIAnnotations(tx.Annotations),
+		NodeSubmission: toAPINodeSubmission(tx.NodeSubmission),
+		External:       external,
+	})
+}
+
+// POSTTransactionResubmit resubmits a quarantined rejected transaction.
+func (m *ArcDefaultHandler) POSTTransactionResubmit(ctx echo.Context, id string) (err error) {
+	if e := m.readOnlyError(); e != nil {
+		return problemJSON(ctx, e)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	reqCtx, span := tracing.StartTracing(reqCtx, "POSTTransactionResubmit", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	tx, err := m.TransactionHandler.ResubmitTransaction(reqCtx, id)
+	if err != nil {
+		if errors.Is(err, metamorph.ErrTransactionNotFound) {
+			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
+			return problemJSON(ctx, e)
+		}
+
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
 	}
 
 	return ctx.JSON(http.StatusOK, api.TransactionStatus{
-		BlockHash:    &tx.BlockHash,
-		BlockHeight:  &tx.BlockHeight,
-		TxStatus:     (api.TransactionStatusTxStatus)(tx.Status),
-		Timestamp:    m.now(),
-		Txid:         tx.TxID,
-		MerklePath:   &tx.MerklePath,
-		ExtraInfo:    &tx.ExtraInfo,
-		CompetingTxs: &tx.CompetingTxs,
+		TxStatus:  (api.TransactionStatusTxStatus)(tx.Status),
+		Timestamp: m.now(),
+		Txid:      tx.TxID,
 	})
 }
 
+// DELETETransaction cancels a transaction which has not been announced to the network yet.
+func (m *ArcDefaultHandler) DELETETransaction(ctx echo.Context, id string) error {
+	return m.cancelBroadcast(ctx, id, "DELETETransaction")
+}
+
+// DELETETransactionSchedule cancels the scheduled broadcast of a transaction which has not been announced yet.
+func (m *ArcDefaultHandler) DELETETransactionSchedule(ctx echo.Context, id string) error {
+	return m.cancelBroadcast(ctx, id, "DELETETransactionSchedule")
+}
+
+// cancelBroadcast deletes a transaction before it is announced. The response is 204 only if the transaction is
+// guaranteed not to be broadcast.
+func (m *ArcDefaultHandler) cancelBroadcast(ctx echo.Context, id string, spanName string) (err error) {
+	if e := m.readOnlyError(); e != nil {
+		return problemJSON(ctx, e)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	reqCtx, span := tracing.StartTracing(reqCtx, spanName, m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	err = m.TransactionHandler.CancelScheduledBroadcast(reqCtx, id)
+	if err != nil {
+		if errors.Is(err, metamorph.ErrTransactionNotFound) {
+			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
+			return problemJSON(ctx, e)
+		}
+
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GETTransactionGraph returns the transaction with its unmined ancestors and descendants known to ARC.
+func (m *ArcDefaultHandler) GETTransactionGraph(ctx echo.Context, id string, params api.GETTransactionGraphParams) (err error) {
+	reqCtx := ctx.Request().Context()
+
+	reqCtx, span := tracing.StartTracing(reqCtx, "GETTransactionGraph", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	var maxNodes uint32
+	if params.MaxNodes != nil && *params.MaxNodes > 0 {
+		maxNodes = uint32(*params.MaxNodes) // #nosec G115
+	}
+
+	graph, err := m.TransactionHandler.GetTransactionGraph(reqCtx, id, maxNodes)
+	if err != nil {
+		if errors.Is(err, metamorph.ErrTransactionNotFound) {
+			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
+			return problemJSON(ctx, e)
+		}
+
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPITransactionGraph(graph))
+}
+
+// GETOutpointSpent returns whether a transaction known to ARC spends the outpoint and which one.
+func (m *ArcDefaultHandler) GETOutpointSpent(ctx echo.Context, id string, vout int) (err error) {
+	reqCtx := ctx.Request().Context()
+
+	reqCtx, span := tracing.StartTracing(reqCtx, "GETOutpointSpent", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	if _, hashErr := chainhash.NewHashFromStr(id); hashErr != nil || len(id) != chainhash.MaxHashStringSize {
+		e := api.NewErrorFields(api.ErrStatusBadRequest, "invalid transaction ID")
+		return problemJSON(ctx, e)
+	}
+
+	index, castErr := safecast.ToUint32(vout)
+	if castErr != nil {
+		e := api.NewErrorFields(api.ErrStatusBadRequest, "invalid output index")
+		return problemJSON(ctx, e)
+	}
+
+	spender, err := m.TransactionHandler.GetOutpointSpender(reqCtx, id, index)
+	if err != nil {
+		if errors.Is(err, metamorph.ErrTransactionNotFound) {
+			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
+			return problemJSON(ctx, e)
+		}
+
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIOutpointSpent(id, vout, spender))
+}
+
+// GETTransactionUpstreams returns which upstream ARC instances of the federation acknowledged the forwarded transaction.
+func (m *ArcDefaultHandler) GETTransactionUpstreams(ctx echo.Context, id string) (err error) {
+	_, span := tracing.StartTracing(ctx.Request().Context(), "GETTransactionUpstreams", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	if m.federation == nil {
+		e := api.NewErrorFields(api.ErrStatusNotFound, ErrFederationDisabled.Error())
+		return problemJSON(ctx, e)
+	}
+
+	acks, err := m.federation.Acknowledgements(id)
+	if err != nil {
+		if errors.Is(err, federation.ErrNotForwarded) {
+			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
+			return problemJSON(ctx, e)
+		}
+
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIUpstreamAcknowledgements(id, acks))
+}
+
 func (m *ArcDefaultHandler) postTransactions(ctx echo.Context, txsHex []byte, params api.POSTTransactionsParams) PostResponse {
 	var err error
 	reqCtx, span := tracing.StartTracing(ctx.Request().Context(), "POSTTransactions", m.tracingEnabled, m.tracingAttributes...)
@@ -383,13 +773,51 @@ func (m *ArcDefaultHandler) postTransactions(ctx echo.Context, txsHex []byte, pa
 	// set the globals for all transactions in this request
 	transactionOptions, err := getTransactionsOptions(params, m.rejectedCallbackURLSubstrings)
 	if err != nil {
-		e := api.NewErrorFields(api.ErrStatusBadRequest, err.Error())
+		e := newInvalidHeaderError(err)
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return PostResponse{e.Status, e}
+	}
+	transactionOptions.ReceivedAt = m.now()
+	transactionOptions.BroadcastAt, err = getBroadcastAt(params, transactionOptions.ReceivedAt)
+	if err != nil {
+		e := newInvalidHeaderError(err)
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return PostResponse{e.Status, e}
+	}
+	transactionOptions.ExpiresAt, err = getExpiresAt(params, transactionOptions.ReceivedAt, transactionOptions.BroadcastAt)
+	if err != nil {
+		e := newInvalidHeaderError(err)
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return PostResponse{e.Status, e}
+	}
+	transactionOptions.AnnounceTo, err = m.announceTo(params)
+	if err != nil {
+		e := newInvalidHeaderError(err)
 		if span != nil {
 			attr := e.GetSpanAttributes()
 			span.SetAttributes(attr...)
 		}
 		return PostResponse{e.Status, e}
 	}
+	if !transactionOptions.BroadcastAt.IsZero() && (transactionOptions.WaitForStatus == 0 || transactionOptions.WaitForStatus > metamorph_api.Status_STORED) {
+		// scheduled transactions are not announced before the broadcast time, so no status beyond STORED is awaited
+		transactionOptions.WaitForStatus = metamorph_api.Status_STORED
+	}
+	transactionOptions.AllowedScriptTemplates = m.allowedScriptTemplates(ctx.Request())
+	transactionOptions.Tenant = m.tenant(ctx.Request())
+	transactionOptions.AbuseKey = m.abuseKey(ctx)
+	if !m.featureFlags.Enabled(feature.CumulativeFees) {
+		transactionOptions.CumulativeFeeValidation = false
+	}
 
 	// check if transactions are present in db, if so skip validation (as they must have already been validated them)
 	// if LastSubmitted is not too old and callbacks are the same then stop processing transactions as there is nothing new
@@ -405,6 +833,14 @@ func (m *ArcDefaultHandler) postTransactions(ctx echo.Context, txsHex []byte, pa
 	}
 
 	if !transactionOptions.ForceValidation {
+		// answer resubmissions of already processed transactions from the cache
+		if m.knownTxs != nil {
+			txStatuses, found := m.knownTxs.get(txIDs)
+			if found && m.checkAllProcessed(txStatuses, transactionOptions) {
+				return m.postResponseForAllTxsProcessed(txStatuses)
+			}
+		}
+
 		// check if transactions already exist in db (so no need to validate)
 		txStatuses, err := m.getTransactionStatuses(reqCtx, txIDs)
 		allTransactionsProcessed := false
@@ -424,9 +860,17 @@ func (m *ArcDefaultHandler) postTransactions(ctx echo.Context, txsHex []byte, pa
 
 		// if nothing to update return
 		if allTransactionsProcessed {
+			if m.knownTxs != nil {
+				m.knownTxs.set(txStatuses)
+			}
 			return m.postResponseForAllTxsProcessed(txStatuses)
 		}
 	}
+
+	if m.knownTxs != nil {
+		m.knownTxs.remove(txIDs)
+	}
+
 	successes, fails, e := m.processTransactions(reqCtx, txsHex, transactionOptions)
 	if e != nil {
 		if span != nil {
@@ -435,6 +879,7 @@ func (m *ArcDefaultHandler) postTransactions(ctx echo.Context, txsHex []byte, pa
 		}
 		return PostResponse{e.Status, e}
 	}
+	m.recordAbuseOutcome(transactionOptions, successes, fails)
 
 	// we cannot really return any other status here
 	// each transaction in the slice will have the result of the transaction submission
@@ -448,12 +893,10 @@ func (m *ArcDefaultHandler) postTransactions(ctx echo.Context, txsHex []byte, pa
 func (m *ArcDefaultHandler) checkAllProcessed(txStatuses []*metamorph.TransactionStatus, transactionOptions *metamorph.TransactionOptions) bool {
 	allProcessed := true
 	for _, tx := range txStatuses {
-		exists := false
-		for _, cb := range tx.Callbacks {
-			if cb.CallbackUrl == transactionOptions.CallbackURL {
-				exists = true
-				break
-			}
+		// submissions without callback URL don't add anything to the stored transaction
+		exists := transactionOptions.CallbackURL == "" || callbackExists(tx.Callbacks, transactionOptions.CallbackURL)
+		for _, recipient := range transactionOptions.AdditionalCallbacks {
+			exists = exists && callbackExists(tx.Callbacks, recipient.URL)
 		}
 		if time.Since(tx.LastSubmitted.AsTime()) > m.rebroadcastExpiration || !exists {
 			allProcessed = false
@@ -463,6 +906,15 @@ func (m *ArcDefaultHandler) checkAllProcessed(txStatuses []*metamorph.Transactio
 	return allProcessed
 }
 
+func callbackExists(callbacks []*metamorph_api.Callback, callbackURL string) bool {
+	for _, cb := range callbacks {
+		if cb.CallbackUrl == callbackURL {
+			return true
+		}
+	}
+	return false
+}
+
 func mergeSuccessAndFailResults(successes []*api.TransactionResponse, fails []*api.ErrorFields) []any {
 	responses := make([]any, 0, len(successes)+len(fails))
 	for _, o := range successes {
@@ -488,6 +940,7 @@ func (m *ArcDefaultHandler) postResponseForAllTxsProcessed(txStatuses []*metamor
 			Timestamp:    m.now(),
 			Txid:         tx.TxID,
 			MerklePath:   &tx.MerklePath,
+			AlreadyKnown: PtrTo(true),
 		})
 	}
 	// merge success and fail results
@@ -500,11 +953,15 @@ func (m *ArcDefaultHandler) postResponseForAllTxsProcessed(txStatuses []*metamor
 
 // POSTTransactions ...
 func (m *ArcDefaultHandler) POSTTransactions(ctx echo.Context, params api.POSTTransactionsParams) (err error) {
+	if e := m.readOnlyError(); e != nil {
+		return problemJSON(ctx, e)
+	}
+
 	timeout := m.defaultTimeout
 	if params.XMaxTimeout != nil {
 		if *params.XMaxTimeout > metamorph.MaxTimeout {
-			e := api.NewErrorFields(api.ErrStatusBadRequest, ErrMaxTimeoutExceeded.Error())
-			return ctx.JSON(e.Status, e)
+			e := newInvalidHeaderError(ErrMaxTimeoutExceeded)
+			return problemJSON(ctx, e)
 		}
 		timeout = time.Second * time.Duration(*params.XMaxTimeout)
 	}
@@ -513,23 +970,46 @@ func (m *ArcDefaultHandler) POSTTransactions(ctx echo.Context, params api.POSTTr
 	ctx.SetRequest(ctx.Request().WithContext(timeoutCtx))
 	defer cancel()
 
+	release, e := m.admit(ctx)
+	if e != nil {
+		return problemJSON(ctx, e)
+	}
+	defer release()
+
 	txsHex, err := parseTransactionsFromRequest(ctx.Request())
 	if err != nil {
-		e := api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprintf("error parsing transactions from request: %s", err.Error()))
-		res := PostResponse{e.Status, e}
-		return ctx.JSON(res.StatusCode, res.response)
+		e := newInvalidBodyError(err)
+		return problemJSON(ctx, e)
+	}
+
+	if acceptsNDJSON(ctx.Request()) {
+		return m.streamTransactions(ctx, txsHex, params)
 	}
 
 	postResponse := m.postTransactions(ctx, txsHex, params)
+	if e, ok := postResponse.response.(*api.ErrorFields); ok {
+		return problemJSON(ctx, e)
+	}
 	return ctx.JSON(postResponse.StatusCode, postResponse.response)
 }
 
+// ValidateCallbackURL validates that the callback URL is an absolute http or https URL which does not contain any of
+// the rejected substrings.
 func ValidateCallbackURL(callbackURL string, rejectedCallbackURLSubstrings []string) error {
-	_, err := url.ParseRequestURI(callbackURL)
+	u, err := url.ParseRequestURI(callbackURL)
 	if err != nil {
 		return errors.Join(ErrInvalidCallbackURL, err)
 	}
 
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Join(ErrInvalidCallbackURL, fmt.Errorf("callback URL must be an absolute http or https URL: %s", callbackURL))
+	}
+
+	// a comma is no valid character of a host name, it is a list of URLs of which not all are absolute
+	if strings.Contains(u.Host, ",") {
+		return errors.Join(ErrInvalidCallbackURL, fmt.Errorf("host of callback URL must not contain a comma: %s", callbackURL))
+	}
+
 	for _, substring := range rejectedCallbackURLSubstrings {
 		if strings.Contains(callbackURL, substring) {
 			return ErrCallbackURLNotAcceptable
@@ -538,24 +1018,123 @@ func ValidateCallbackURL(callbackURL string, rejectedCallbackURLSubstrings []str
 	return nil
 }
 
+// parseCallbackRecipients parses the X-CallbackUrl and X-CallbackToken headers. The X-CallbackUrl header either
+// contains a single URL, a comma separated list of URLs or a JSON list of objects with "url" and "token" fields.
+// The header is only split at the commas if every part is an absolute http or https URL, so that a single URL with
+// commas in its query stays one URL. Tokens of a comma separated X-CallbackToken header are assigned to the URLs by
+// position. A single token is used for all URLs.
+func parseCallbackRecipients(callbackURLs string, callbackTokens *string) ([]metamorph.CallbackRecipient, error) {
+	var recipients []metamorph.CallbackRecipient
+
+	trimmed := strings.TrimSpace(callbackURLs)
+	if strings.HasPrefix(trimmed, "[") {
+		err := json.Unmarshal([]byte(trimmed), &recipients)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidCallbackList, err)
+		}
+		if len(recipients) == 0 {
+			return nil, ErrInvalidCallbackList
+		}
+	} else {
+		urls := splitCallbackURLs(trimmed)
+		var tokens []string
+		if callbackTokens != nil {
+			tokens = strings.Split(*callbackTokens, ",")
+		}
+
+		if len(urls) > 1 && len(tokens) > 1 && len(tokens) != len(urls) {
+			return nil, errors.Join(ErrInvalidCallbackList, fmt.Errorf("%d callback URLs but %d callback tokens", len(urls), len(tokens)))
+		}
+
+		for i, u := range urls {
+			recipient := metamorph.CallbackRecipient{URL: strings.TrimSpace(u)}
+			switch {
+			case len(urls) == 1 && callbackTokens != nil:
+				recipient.Token = *callbackTokens
+			case len(tokens) == 1:
+				recipient.Token = strings.TrimSpace(tokens[0])
+			case len(tokens) > 1:
+				recipient.Token = strings.TrimSpace(tokens[i])
+			}
+			recipients = append(recipients, recipient)
+		}
+	}
+
+	if len(recipients) > maxCallbackRecipients {
+		return nil, ErrTooManyCallbackURLs
+	}
+
+	return recipients, nil
+}
+
+// splitCallbackURLs splits the comma separated list of URLs if every part of it is an absolute http or https URL,
+// otherwise the list is a single URL.
+func splitCallbackURLs(callbackURLs string) []string {
+	parts := strings.Split(callbackURLs, ",")
+	if len(parts) == 1 {
+		return parts
+	}
+
+	for _, part := range parts {
+		u, err := url.ParseRequestURI(strings.TrimSpace(part))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return []string{callbackURLs}
+		}
+	}
+
+	return parts
+}
+
 func getTransactionsOptions(params api.POSTTransactionsParams, rejectedCallbackURLSubstrings []string) (*metamorph.TransactionOptions, error) {
 	transactionOptions := &metamorph.TransactionOptions{}
+
+	if params.XCallbackToken != nil {
+		transactionOptions.CallbackToken = *params.XCallbackToken
+	}
+
 	if params.XCallbackUrl != nil {
-		if err := ValidateCallbackURL(*params.XCallbackUrl, rejectedCallbackURLSubstrings); err != nil {
+		recipients, err := parseCallbackRecipients(*params.XCallbackUrl, params.XCallbackToken)
+		if err != nil {
 			return nil, err
 		}
 
-		transactionOptions.CallbackURL = *params.XCallbackUrl
-	}
+		for _, recipient := range recipients {
+			if err = ValidateCallbackURL(recipient.URL, rejectedCallbackURLSubstrings); err != nil {
+				return nil, err
+			}
+		}
 
-	if params.XCallbackToken != nil {
-		transactionOptions.CallbackToken = *params.XCallbackToken
+		transactionOptions.CallbackURL = recipients[0].URL
+		transactionOptions.CallbackToken = recipients[0].Token
+		if len(recipients) > 1 {
+			transactionOptions.AdditionalCallbacks = recipients[1:]
+		}
 	}
 
 	if params.XCallbackBatch != nil {
 		transactionOptions.CallbackBatch = *params.XCallbackBatch
 	}
 
+	if params.XCallbackVersion != nil {
+		version := *params.XCallbackVersion
+		if version < callbacker.CallbackVersion1 || version > callbacker.CallbackVersionLatest {
+			return nil, errors.Join(ErrCallbackVersionInvalid, fmt.Errorf("version: %d", version))
+		}
+		transactionOptions.CallbackVersion = int32(version) // #nosec G115
+	}
+
+	if params.XCallbackAllowDuplicates != nil {
+		transactionOptions.CallbackAllowDuplicates = *params.XCallbackAllowDuplicates
+	}
+
+	if params.XCallbackSuppressionWindow != nil {
+		window := *params.XCallbackSuppressionWindow
+		if window < 0 || window > maxCallbackSuppressionWindow {
+			return nil, errors.Join(ErrInvalidSuppressionWindow, fmt.Errorf("window: %d", window))
+		}
+		transactionOptions.CallbackSuppressionWindow = int32(window) // #nosec G115
+	}
+
 	if params.XWaitFor != nil {
 		value, ok := metamorph_api.Status_value[*params.XWaitFor]
 		if !ok {
@@ -589,6 +1168,68 @@ func getTransactionsOptions(params api.POSTTransactionsParams, rejectedCallbackU
 	return transactionOptions, nil
 }
 
+// getBroadcastAt returns the time at which the submitted transactions are announced to the network from the headers
+// X-BroadcastAt or X-BroadcastDelay. It is zero if the transactions are announced immediately, which is also the case
+// for broadcast times which have already passed.
+func getBroadcastAt(params api.POSTTransactionsParams, now time.Time) (time.Time, error) {
+	var broadcastAt time.Time
+
+	switch {
+	case params.XBroadcastAt != nil && params.XBroadcastDelay != nil:
+		return time.Time{}, errors.Join(ErrInvalidBroadcastTime, errors.New("X-BroadcastAt and X-BroadcastDelay can not be combined"))
+	case params.XBroadcastAt != nil:
+		broadcastAt = *params.XBroadcastAt
+	case params.XBroadcastDelay != nil:
+		if *params.XBroadcastDelay < 1 {
+			return time.Time{}, errors.Join(ErrInvalidBroadcastTime, fmt.Errorf("delay: %d", *params.XBroadcastDelay))
+		}
+		broadcastAt = now.Add(time.Duration(*params.XBroadcastDelay) * time.Second)
+	default:
+		return time.Time{}, nil
+	}
+
+	if broadcastAt.Sub(now) > broadcastDelayMax {
+		return time.Time{}, errors.Join(ErrInvalidBroadcastTime, fmt.Errorf("broadcast can not be scheduled more than %s ahead", broadcastDelayMax))
+	}
+
+	if !broadcastAt.After(now) {
+		return time.Time{}, nil
+	}
+
+	return broadcastAt.UTC(), nil
+}
+
+// getExpiresAt returns the time after which the submitted transactions expire from the headers X-ExpiresAt or X-TTL.
+// It is zero if the transactions do not expire. The expiry must lie after the broadcast time, or after now if the
+// transactions are announced immediately.
+func getExpiresAt(params api.POSTTransactionsParams, now time.Time, broadcastAt time.Time) (time.Time, error) {
+	var expiresAt time.Time
+
+	switch {
+	case params.XExpiresAt != nil && params.XTTL != nil:
+		return time.Time{}, errors.Join(ErrInvalidExpiry, errors.New("X-ExpiresAt and X-TTL can not be combined"))
+	case params.XExpiresAt != nil:
+		expiresAt = *params.XExpiresAt
+	case params.XTTL != nil:
+		if *params.XTTL < 1 {
+			return time.Time{}, errors.Join(ErrInvalidExpiry, fmt.Errorf("ttl: %d", *params.XTTL))
+		}
+		expiresAt = now.Add(time.Duration(*params.XTTL) * time.Second)
+	default:
+		return time.Time{}, nil
+	}
+
+	if !expiresAt.After(now) {
+		return time.Time{}, errors.Join(ErrInvalidExpiry, errors.New("expiry has already passed"))
+	}
+
+	if !broadcastAt.IsZero() && !expiresAt.After(broadcastAt) {
+		return time.Time{}, errors.Join(ErrInvalidExpiry, errors.New("expiry must be after the broadcast time"))
+	}
+
+	return expiresAt.UTC(), nil
+}
+
 func (m *ArcDefaultHandler) getTxIDs(txsHex []byte) ([]string, *api.ErrorFields) {
 	var txIDs []string
 	for len(txsHex) != 0 {
@@ -596,6 +1237,7 @@ func (m *ArcDefaultHandler) getTxIDs(txsHex []byte) ([]string, *api.ErrorFields)
 		if hexFormat == validator.BeefHex {
 			beefTx, _, err := beef.DecodeBEEF(txsHex)
 			if err != nil {
+				m.reportCrash(validator.CrashTargetBEEF, txsHex, err)
 				errStr := errors.Join(ErrDecodingBeef, err).Error()
 				return nil, api.NewErrorFields(api.ErrStatusMalformed, errStr)
 			}
@@ -612,19 +1254,20 @@ func (m *ArcDefaultHandler) getTxIDs(txsHex []byte) ([]string, *api.ErrorFields)
 			for _, tx := range beefTx.Transactions {
 				// in case there is just 1 transaction append it, otherwise append only unmined
 				if tx.DataFormat == sdkTx.RawTx || (tx.DataFormat == sdkTx.RawTxAndBumpIndex && len(beefTx.Transactions) == 1) {
-					txIDs = append(txIDs, tx.Transaction.TxID().String())
+					txIDs = append(txIDs, hexutils.TxID(tx.Transaction.TxID()))
 				}
 			}
 
 			continue
 		}
 
-		transaction, bytesUsed, err := sdkTx.NewTransactionFromStream(txsHex)
+		transaction, bytesUsed, err := validator.DecodeTransaction(txsHex)
 		if err != nil {
+			m.reportCrash(validator.CrashTargetTransaction, txsHex, err)
 			return nil, api.NewErrorFields(api.ErrStatusBadRequest, err.Error())
 		}
 		txsHex = txsHex[bytesUsed:]
-		txIDs = append(txIDs, transaction.TxID().String())
+		txIDs = append(txIDs, hexutils.TxID(transaction.TxID()))
 	}
 
 	return txIDs, nil
@@ -646,14 +1289,31 @@ func (m *ArcDefaultHandler) processTransactions(ctx context.Context, txsHex []by
 	}
 
 	if len(submittedTxs) == 0 {
+		m.recordRejections(options.Tenant, nil, fails)
 		return nil, fails, nil
 	}
 
+	if !options.SkipTxValidation {
+		options.ValidatedAt = m.now()
+	}
+
+	// the transactions whose Merkle roots could not be verified are submitted once they are verified
+	unverified, errFields := m.unverifiedTxIDs(ctx, submittedTxs)
+	if errFields != nil {
+		return nil, nil, errFields
+	}
+	txsToSubmit, deferredStatuses := m.deferUnverified(submittedTxs, unverified)
+
 	// submit valid transactions to metamorph
-	txStatuses, e := m.submitTransactions(ctx, submittedTxs, options)
-	if e != nil {
-		return nil, nil, e
+	var txStatuses []*metamorph.TransactionStatus
+	if len(txsToSubmit) != 0 {
+		var e *api.ErrorFields
+		txStatuses, e = m.submitTransactions(ctx, txsToSubmit, options)
+		if e != nil {
+			return nil, nil, e
+		}
 	}
+	txStatuses = append(txStatuses, deferredStatuses...)
 
 	// prepare success results
 	txStatuses = filterStatusesByTxIDs(txIDs, txStatuses)
@@ -661,10 +1321,21 @@ func (m *ArcDefaultHandler) processTransactions(ctx context.Context, txsHex []by
 	now := m.now()
 	successes = make([]*api.TransactionResponse, 0, len(submittedTxs))
 
+	txsByID := make(map[string]*sdkTx.Transaction, len(submittedTxs))
+	for _, submittedTx := range submittedTxs {
+		txsByID[hexutils.TxID(submittedTx.TxID())] = submittedTx
+	}
+
 	for idx, tx := range txStatuses {
 		txID := tx.TxID
 		if txID == "" {
-			txID = submittedTxs[idx].TxID().String()
+			txID = hexutils.TxID(submittedTxs[idx].TxID())
+		}
+
+		var fee *api.FeeDetails
+		feeDetails := m.feeDetails(txsByID[txID])
+		if feeDetails != nil {
+			fee = toAPIFeeDetails(feeDetails)
 		}
 
 		successes = append(successes, &api.TransactionResponse{
@@ -678,12 +1349,96 @@ func (m *ArcDefaultHandler) processTransactions(ctx context.Context, txsHex []by
 			Timestamp:    now,
 			Txid:         txID,
 			MerklePath:   &tx.MerklePath,
+			Fee:          fee,
+			Warnings:     toAPIWarnings(append(validator.Warnings(txsByID[txID], m.Policy(), feeDetails), m.unverifiedWarnings(txID, unverified)...)),
 		})
+
+		m.recordFeeStats(txID, api.TransactionResponseTxStatus(tx.Status), feeDetails)
 	}
 
+	m.recordRejections(options.Tenant, successes, fails)
+	m.forwardAccepted(ctx, successes, txsByID)
+	m.issueReceipts(ctx, successes)
+
 	return successes, fails, nil
 }
 
+// forwardAccepted forwards the transactions which were not rejected by metamorph to the upstreams of the federation. If
+// the federation aggregates the responses of the upstreams, the status of each forwarded transaction is replaced by
+// the aggregated status, which is UNKNOWN if the aggregation policy is not satisfied.
+func (m *ArcDefaultHandler) forwardAccepted(ctx context.Context, successes []*api.TransactionResponse, txsByID map[string]*sdkTx.Transaction) {
+	if m.federation == nil {
+		return
+	}
+
+	accepted := make([]*sdkTx.Transaction, 0, len(successes))
+	for _, success := range successes {
+		tx, found := txsByID[success.Txid]
+		if !found || success.TxStatus == api.TransactionResponseTxStatusREJECTED {
+			continue
+		}
+		accepted = append(accepted, tx)
+	}
+
+	results, err := m.federation.Forward(ctx, accepted)
+	if err != nil {
+		m.logger.WarnContext(ctx, "failed to forward transactions to upstreams", slog.Int("txs", len(accepted)), slog.String("err", err.Error()))
+		return
+	}
+
+	for _, success := range successes {
+		result, found := results[success.Txid]
+		if !found {
+			continue
+		}
+
+		success.Federation = toAPIFederationResult(result)
+		if result.Satisfied {
+			success.TxStatus = api.TransactionResponseTxStatus(result.TxStatus)
+			continue
+		}
+
+		extraInfo := fmt.Sprintf("federation policy %s not satisfied: %d of %d required upstreams acknowledged the transaction", result.Policy, result.Acknowledged, result.Required)
+		success.TxStatus = api.TransactionResponseTxStatusUNKNOWN
+		success.ExtraInfo = &extraInfo
+	}
+}
+
+// recordRejections records the transactions which failed validation or were rejected by metamorph.
+func (m *ArcDefaultHandler) recordRejections(tenant string, successes []*api.TransactionResponse, fails []*api.ErrorFields) {
+	if m.submissionRecorder == nil && m.rejectionDigest == nil {
+		return
+	}
+
+	for _, fail := range fails {
+		var txID string
+		if fail.Txid != nil {
+			txID = *fail.Txid
+		}
+		m.recordRejection(tenant, txID, fail.Status, fail.Detail)
+	}
+
+	for _, success := range successes {
+		if success.TxStatus != api.TransactionResponseTxStatusREJECTED {
+			continue
+		}
+		var extraInfo string
+		if success.ExtraInfo != nil {
+			extraInfo = *success.ExtraInfo
+		}
+		m.recordRejection(tenant, success.Txid, success.Status, extraInfo)
+	}
+}
+
+func (m *ArcDefaultHandler) recordRejection(tenant string, txID string, status int, reason string) {
+	if m.submissionRecorder != nil {
+		m.submissionRecorder.RecordRejection(txID, status, reason)
+	}
+	if m.rejectionDigest != nil {
+		m.rejectionDigest.RecordRejection(tenant, txID, status, reason)
+	}
+}
+
 func (m *ArcDefaultHandler) getTxDataFromHex(ctx context.Context, options *metamorph.TransactionOptions, txsHex []byte, fails []*api.ErrorFields) ([]string, []*sdkTx.Transaction, []*api.ErrorFields, *api.ErrorFields) {
 	var submittedTxs []*sdkTx.Transaction
 	var txIDs []string
@@ -694,6 +1449,7 @@ func (m *ArcDefaultHandler) getTxDataFromHex(ctx context.Context, options *metam
 		if hexFormat == validator.BeefHex {
 			beefTx, txID, err := beef.DecodeBEEF(txsHex)
 			if err != nil {
+				m.reportCrash(validator.CrashTargetBEEF, txsHex, err)
 				errStr := errors.Join(ErrDecodingBeef, err).Error()
 				return nil, nil, nil, api.NewErrorFields(api.ErrStatusMalformed, errStr)
 			}
@@ -708,40 +1464,55 @@ func (m *ArcDefaultHandler) getTxDataFromHex(ctx context.Context, options *metam
 			txsHex = txsHex[bytesUsed:]
 
 			arcError := m.validateBEEFTransaction(ctx, beefTx, options, txID)
-			if arcError != nil {
+			if arcError != nil && !deadlineExceeded(ctx) {
 				fails = append(fails, arcError)
 				continue
 			}
 
-			for _, tx := range beefTx.Transactions {
-				// in case there is just 1 transaction append it, otherwise append only unmined
-				if tx.DataFormat == sdkTx.RawTx || (tx.DataFormat == sdkTx.RawTxAndBumpIndex && len(beefTx.Transactions) == 1) {
-					submittedTxs = append(submittedTxs, tx.Transaction)
-					txIDs = append(txIDs, tx.Transaction.TxID().String())
-				}
+			for _, tx := range beefTransactionsToSubmit(beefTx) {
+				submittedTxs = append(submittedTxs, tx)
+				txIDs = append(txIDs, hexutils.TxID(tx.TxID()))
+				m.classifyConsolidation(options, tx)
 			}
 
 			continue
 		}
 
-		transaction, bytesUsed, err := sdkTx.NewTransactionFromStream(txsHex)
+		transaction, bytesUsed, err := validator.DecodeTransaction(txsHex)
 		if err != nil {
+			m.reportCrash(validator.CrashTargetTransaction, txsHex, err)
 			return nil, nil, nil, api.NewErrorFields(api.ErrStatusBadRequest, err.Error())
 		}
 
 		txsHex = txsHex[bytesUsed:]
 
-		if arcError := m.validateEFTransaction(ctx, transaction, options); arcError != nil {
+		if arcError := m.validateEFTransaction(ctx, transaction, options); arcError != nil && !deadlineExceeded(ctx) {
 			fails = append(fails, arcError)
 			continue
 		}
 
 		submittedTxs = append(submittedTxs, transaction)
-		txIDs = append(txIDs, transaction.TxID().String())
+		txIDs = append(txIDs, hexutils.TxID(transaction.TxID()))
+		m.classifyConsolidation(options, transaction)
 	}
 	return txIDs, submittedTxs, fails, nil
 }
 
+// reportCrash reports the submission if its decoding panicked.
+func (m *ArcDefaultHandler) reportCrash(target string, txsHex []byte, err error) {
+	if m.crashReporter == nil || (!errors.Is(err, beef.ErrBEEFPanic) && !errors.Is(err, validator.ErrTxPanic)) {
+		return
+	}
+
+	m.crashReporter.ReportCrash(target, txsHex, err)
+}
+
+// deadlineExceeded returns true if the deadline of the request was exceeded. Transactions of which the validation did
+// not finish before the deadline are not rejected, but returned with their best-known status.
+func deadlineExceeded(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.DeadlineExceeded)
+}
+
 func (m *ArcDefaultHandler) validateEFTransaction(ctx context.Context, tx *sdkTx.Transaction, options *metamorph.TransactionOptions) *api.ErrorFields {
 	var err error
 	ctx, span := tracing.StartTracing(ctx, "validateEFTransaction", m.tracingEnabled, m.tracingAttributes...)
@@ -749,16 +1520,67 @@ func (m *ArcDefaultHandler) validateEFTransaction(ctx context.Context, tx *sdkTx
 		tracing.EndTracing(span, err)
 	}()
 
+	// the script policy of the API key is enforced even if the validation is skipped
+	if vErr := validator.CheckScriptTemplates(tx, options.AllowedScriptTemplates); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction not allowed by script policy", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if vErr := m.checkOpcodes(tx); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction not allowed by opcode limits", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if vErr := m.checkTemplateRate(tx); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction rejected by clamped template rate", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if vErr := m.checkAbuseScore(options, tx); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction rejected by abuse score", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if vErr := m.checkCompliance(ctx, tx); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction blocked by compliance engine", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if vErr := m.checkFinality(ctx, tx, options); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction is not final", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
 	if options.SkipTxValidation {
 		return nil
 	}
 
 	feeOpts, scriptOpts := toValidationOpts(options)
 
-	err = m.defaultValidator.ValidateTransaction(ctx, tx, feeOpts, scriptOpts, atomic.LoadInt32(&m.currentBlockHeight))
+	defaultValidator, _ := m.validators()
+	err = defaultValidator.ValidateTransaction(ctx, tx, feeOpts, scriptOpts, atomic.LoadInt32(&m.currentBlockHeight))
 	if err != nil {
-		statusCode, arcError := m.handleError(ctx, tx.TxID().String(), err)
-		m.logger.ErrorContext(ctx, "failed to validate transaction", slog.String("id", tx.TxID().String()), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "failed to validate transaction", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if _, vErr := m.checkMempoolAcceptance(ctx, []*sdkTx.Transaction{tx}); vErr != nil {
+		err = vErr
+		statusCode, arcError := m.handleError(ctx, hexutils.TxID(tx.TxID()), err)
+		m.logger.ErrorContext(ctx, "transaction rejected by mempool acceptance check of node", slog.String("id", hexutils.TxID(tx.TxID())), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
 		return arcError
 	}
 
@@ -772,16 +1594,69 @@ func (m *ArcDefaultHandler) validateBEEFTransaction(ctx context.Context, beefTx
 		tracing.EndTracing(span, err)
 	}()
 
+	// the script policy of the API key is enforced even if the validation is skipped
+	failedTx, err := checkBEEFScriptTemplates(beefTx, options.AllowedScriptTemplates)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction not allowed by script policy", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	failedTx, err = m.checkBEEFOpcodes(beefTx)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction not allowed by opcode limits", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	failedTx, err = m.checkBEEFTemplateRate(beefTx)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction rejected by clamped template rate", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	failedTx, err = m.checkBEEFAbuseScore(options, beefTx)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction rejected by abuse score", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	failedTx, err = m.checkBEEFCompliance(ctx, beefTx)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction blocked by compliance engine", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	failedTx, err = m.checkBEEFFinality(ctx, beefTx, options)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction is not final", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
 	if options.SkipTxValidation {
 		return nil
 	}
 
 	feeOpts, scriptOpts := toValidationOpts(options)
 
-	failedTx, err := m.beefValidator.ValidateTransaction(ctx, beefTx, feeOpts, scriptOpts, atomic.LoadInt32(&m.currentBlockHeight))
-	if err != nil {
+	_, beefValidator := m.validators()
+	failedTx, err = beefValidator.ValidateTransaction(ctx, beefTx, feeOpts, scriptOpts, atomic.LoadInt32(&m.currentBlockHeight))
+	// in the degraded mode the BEEF is only rejected if it is invalid otherwise, or can not be kept for re-verification
+	verificationErr := err
+	unverified := err != nil && m.isDegraded(err)
+	if err != nil && !unverified {
 		if failedTx != nil {
-			txID = failedTx.TxID().String()
+			txID = hexutils.TxID(failedTx.TxID())
 		}
 		statusCode, arcError := m.handleError(ctx, txID, err)
 		m.logger.ErrorContext(ctx, "failed to validate transaction", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
@@ -789,6 +1664,20 @@ func (m *ArcDefaultHandler) validateBEEFTransaction(ctx context.Context, beefTx
 		return arcError
 	}
 
+	failedTx, err = m.checkBEEFMempoolAcceptance(ctx, beefTx)
+	if err != nil {
+		txID = hexutils.TxID(failedTx.TxID())
+		statusCode, arcError := m.handleError(ctx, txID, err)
+		m.logger.ErrorContext(ctx, "transaction rejected by mempool acceptance check of node", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
+		return arcError
+	}
+
+	if unverified && !m.holdUnverified(ctx, beefTx, options, txID) {
+		statusCode, arcError := m.handleError(ctx, txID, verificationErr)
+		m.logger.ErrorContext(ctx, "failed to validate transaction", slog.String("id", txID), slog.Int("status", int(statusCode)), slog.String("err", verificationErr.Error()))
+		return arcError
+	}
+
 	return nil
 }
 
@@ -804,18 +1693,33 @@ func (m *ArcDefaultHandler) submitTransactions(ctx context.Context, txs []*sdkTx
 	// to avoid false negatives first check if ctx is expired
 	select {
 	case <-ctx.Done():
-		_, arcError := m.handleError(ctx, "", context.DeadlineExceeded)
-		return nil, arcError
+		return m.bestKnownStatuses(ctx, txs), nil
 	default:
 	}
 
+	var existingStatuses []*metamorph.TransactionStatus
+	if m.txClaims != nil {
+		var release func()
+		txs, existingStatuses, release = m.claimTransactions(ctx, txs, options)
+		defer release()
+
+		if len(txs) == 0 {
+			return existingStatuses, nil
+		}
+	}
+
 	submitStatuses, err = m.TransactionHandler.SubmitTransactions(ctx, txs, options)
 	if err != nil {
-		var tx *sdkTx.Transaction
+		if deadlineExceeded(ctx) {
+			m.logger.WarnContext(ctx, "deadline exceeded while submitting transactions", slog.Int("txs", len(txs)), slog.String("err", err.Error()))
+			return m.bestKnownStatuses(ctx, txs), nil
+		}
+
+		var txID string
 		if len(txs) == 1 {
-			tx = txs[0]
+			txID = hexutils.TxID(txs[0].TxID())
 		}
-		statusCode, arcError := m.handleError(ctx, tx.TxID().String(), err)
+		statusCode, arcError := m.handleError(ctx, txID, err)
 		m.logger.ErrorContext(ctx, "failed to submit transactions", slog.Int("txs", len(txs)), slog.Int("status", int(statusCode)), slog.String("err", err.Error()))
 
 		return nil, arcError
@@ -825,7 +1729,84 @@ func (m *ArcDefaultHandler) submitTransactions(ctx context.Context, txs []*sdkTx
 		m.stats.Add(len(txs))
 	}
 
-	return submitStatuses, nil
+	if m.submissionRecorder != nil {
+		m.submissionRecorder.RecordSubmissions(len(txs))
+	}
+
+	return append(submitStatuses, existingStatuses...), nil
+}
+
+// bestKnownStatuses returns the statuses of the transactions after the deadline of the request was exceeded. The
+// transactions which are not known to metamorph, because they could not be submitted in time, are returned with
+// status UNKNOWN.
+func (m *ArcDefaultHandler) bestKnownStatuses(ctx context.Context, txs []*sdkTx.Transaction) []*metamorph.TransactionStatus {
+	lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), statusLookupTimeout)
+	defer cancel()
+
+	txIDs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txIDs = append(txIDs, hexutils.TxID(tx.TxID()))
+	}
+
+	knownStatuses := make(map[string]*metamorph.TransactionStatus, len(txs))
+	statuses, err := m.getTransactionStatuses(lookupCtx, txIDs)
+	if err != nil && !errors.Is(err, metamorph.ErrTransactionNotFound) {
+		m.logger.WarnContext(ctx, "failed to get statuses of transactions after deadline exceeded", slog.String("err", err.Error()))
+	}
+	for _, txStatus := range statuses {
+		knownStatuses[txStatus.TxID] = txStatus
+	}
+
+	bestKnown := make([]*metamorph.TransactionStatus, 0, len(txIDs))
+	for _, txID := range txIDs {
+		if txStatus, ok := knownStatuses[txID]; ok {
+			bestKnown = append(bestKnown, txStatus)
+			continue
+		}
+
+		bestKnown = append(bestKnown, &metamorph.TransactionStatus{
+			TxID:      txID,
+			Status:    metamorph_api.Status_UNKNOWN.String(),
+			ExtraInfo: ErrDeadlineExceeded.Error(),
+			Timestamp: m.now().Unix(),
+		})
+	}
+
+	return bestKnown
+}
+
+// classifyConsolidation records in the options whether the transaction is a consolidation transaction, so that the
+// classification is stored with the transaction.
+func (m *ArcDefaultHandler) classifyConsolidation(options *metamorph.TransactionOptions, tx *sdkTx.Transaction) {
+	if !validator.IsConsolidation(tx, m.Policy()) {
+		return
+	}
+
+	if options.ConsolidationTxIDs == nil {
+		options.ConsolidationTxIDs = make(map[string]bool)
+	}
+	options.ConsolidationTxIDs[hexutils.TxID(tx.TxID())] = true
+}
+
+// feeDetails returns the fee details of a submitted transaction or nil if the input amounts of the transaction are not known.
+func (m *ArcDefaultHandler) feeDetails(tx *sdkTx.Transaction) *validator.FeeDetails {
+	policy := m.Policy()
+	if tx == nil || policy == nil {
+		return nil
+	}
+
+	var feeDetails *validator.FeeDetails
+	var err error
+	if m.consolidationFeeModel != nil && validator.IsConsolidation(tx, policy) {
+		feeDetails, err = validator.NewConsolidationFeeDetails(tx, m.consolidationFeeModel)
+	} else {
+		feeDetails, err = validator.NewFeeDetails(tx, internalApi.FeesToFeeModel(policy.MinMiningTxFee))
+	}
+	if err != nil {
+		return nil
+	}
+
+	return feeDetails
 }
 
 func (m *ArcDefaultHandler) getTransactionStatus(ctx context.Context, id string) (tx *metamorph.TransactionStatus, err error) {
@@ -834,14 +1815,40 @@ func (m *ArcDefaultHandler) getTransactionStatus(ctx context.Context, id string)
 		tracing.EndTracing(span, err)
 	}()
 
+	if m.statuses != nil {
+		cached, found := m.statuses.get(id)
+		if found {
+			return cached, nil
+		}
+	}
+
 	tx, err = m.TransactionHandler.GetTransactionStatus(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if m.statuses != nil && tx != nil {
+		cacheErr := m.statuses.set(tx)
+		if cacheErr != nil {
+			m.logger.Warn("Failed to cache transaction status", slog.String("hash", id), slog.String("err", cacheErr.Error()))
+		}
+	}
+
 	return tx, nil
 }
 
+// InvalidateStatuses removes the cached statuses of the given transactions, whose status has been updated.
+func (m *ArcDefaultHandler) InvalidateStatuses(txIDs ...string) {
+	if m.statuses == nil || len(txIDs) == 0 {
+		return
+	}
+
+	err := m.statuses.remove(txIDs...)
+	if err != nil {
+		m.logger.Warn("Failed to invalidate cached transaction statuses", slog.String("err", err.Error()))
+	}
+}
+
 func (m *ArcDefaultHandler) getTransactionStatuses(ctx context.Context, txIDs []string) (tx []*metamorph.TransactionStatus, err error) {
 	ctx, span := tracing.StartTracing(ctx, "getTransactionStatus", m.tracingEnabled, m.tracingAttributes...)
 	defer func() {
@@ -873,13 +1880,24 @@ func (m *ArcDefaultHandler) handleError(_ context.Context, txID string, submitEr
 		status = validatorErr.ArcErrorStatus
 	}
 
+	var rejectReason *node_reject.Reason
+	if errors.As(submitErr, &rejectReason) {
+		status = rejectReason.Status
+	}
+
 	// enrich the response with the error details
 	arcError := api.NewErrorFields(status, submitErr.Error())
 
+	if ok && validatorErr.Fee != nil {
+		arcError.Fee = toAPIFeeDetails(validatorErr.Fee)
+	}
+
 	if txID != "" {
 		arcError.Txid = PtrTo(txID)
 	}
 
+	status = m.mapStatus(status, submitErr, arcError)
+
 	return status, arcError
 }
 
@@ -909,6 +1927,26 @@ func (m *ArcDefaultHandler) Shutdown() {
 		m.stats.UnregisterStats()
 	}
 
+	if m.templateStats != nil {
+		m.templateStats.UnregisterStats()
+	}
+
+	if m.abuseScorer != nil {
+		m.abuseScorer.UnregisterStats()
+	}
+
+	if m.admission != nil {
+		m.admission.UnregisterStats()
+	}
+
+	if m.feeStats != nil {
+		m.feeStats.Shutdown()
+	}
+
+	if m.complianceChecker != nil {
+		m.complianceChecker.UnregisterStats()
+	}
+
 	m.cancelAll()
 	m.waitGroup.Wait()
 }
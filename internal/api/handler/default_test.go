@@ -19,20 +19,37 @@ import (
 
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 	"github.com/labstack/echo/v4"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/ordishs/go-bitcoin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/bitcoin-sv/arc/internal/api/abuse"
+	"github.com/bitcoin-sv/arc/internal/api/admission"
+	"github.com/bitcoin-sv/arc/internal/api/compliance"
+	complianceMocks "github.com/bitcoin-sv/arc/internal/api/compliance/mocks"
+	"github.com/bitcoin-sv/arc/internal/api/digest"
+	feestats "github.com/bitcoin-sv/arc/internal/api/fee_stats"
 	apiHandlerMocks "github.com/bitcoin-sv/arc/internal/api/handler/mocks"
+	"github.com/bitcoin-sv/arc/internal/api/receipt"
+	"github.com/bitcoin-sv/arc/internal/api/reverification"
+	reverificationMocks "github.com/bitcoin-sv/arc/internal/api/reverification/mocks"
+	templatestats "github.com/bitcoin-sv/arc/internal/api/template_stats"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
 	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/federation"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	mtmMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
 	"github.com/bitcoin-sv/arc/internal/validator"
+	beefValidator "github.com/bitcoin-sv/arc/internal/validator/beef"
+	beefMocks "github.com/bitcoin-sv/arc/internal/validator/beef/mocks"
 	defaultvalidator "github.com/bitcoin-sv/arc/internal/validator/default"
+	validatorMocks "github.com/bitcoin-sv/arc/internal/validator/mocks"
 	"github.com/bitcoin-sv/arc/pkg/api"
 )
 
@@ -46,6 +63,7 @@ var (
 	validTxBytes, _           = hex.DecodeString(validTx)
 	validExtendedTx           = "010000000000000000ef01358eb38f1f910e76b33788ff9395a5d2af87721e950ebd3d60cf64bb43e77485010000006a47304402203be8a3ba74e7b770afa2addeff1bbc1eaeb0cedf6b4096c8eb7ec29f1278752602205dc1d1bedf2cab46096bb328463980679d4ce2126cdd6ed191d6224add9910884121021358f252895263cd7a85009fcc615b57393daf6f976662319f7d0c640e6189fcffffffffc70a0000000000001976a914f1e6837cf17b485a1dcea9e943948fafbe5e9f6888ac02bf010000000000001976a91449f066fccf8d392ff6a0a33bc766c9f3436c038a88acfc080000000000001976a914a7dcbd14f83c564e0025a57f79b0b8b591331ae288ac00000000"
 	validTxID                 = "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"
+	testReceiptKey            = "KznvCNc6Yf4iztSThoMH6oHWzH9EgjfodKxmeuUGPq5DEX5maspS"
 	validTxParentHex          = "0100000001fbbe01d83cb1f53a63ef91c0fce5750cbd8075efef5acd2ff229506a45ab832c010000006a473044022064be2f304950a87782b44e772390836aa613f40312a0df4993e9c5123d0c492d02202009b084b66a3da939fb7dc5d356043986539cac4071372d0a6481d5b5e418ca412103fc12a81e5213e30c7facc15581ac1acbf26a8612a3590ffb48045084b097d52cffffffff02bf010000000000001976a914c2ca67db517c0c972b9a6eb1181880ed3a528e3188acc70a0000000000001976a914f1e6837cf17b485a1dcea9e943948fafbe5e9f6888ac00000000"
 	validTxParentBytes, _     = hex.DecodeString(validTxParentHex)
 	validBeef1MinexTx         = "0100beef01fedcab1900080205029237d9e6a0a2a60702a1468641d249f8698e22110a956c76b2ad5230663d270504009d2aa330ab7a0a2c4035f31dfafa7f001392abd3866c34e47769eb3058f7b1c2010300b84052a6642873d4be4c4bb0fc8bb547e4dcd11bb269f5682a55ae30bcd4d9e301000043b65fce7648a97b9ac4d3f3b1ab920c66e8c163b794f1348549adfbdd7b1ddf010100d7197b83df3e1307375627e98972cd420aaf6201b005de4feab5812adbb57c7d0101002a7d7056a39c2f5a481f15ec5c5d1e9cd6bf0a310ff83f46771aa89cfd892ac201010035d8031500a4ecd8fa4dcfcd64ca591db1460e4a6e2e8b54de424064aec1bb4c010100d07c1446d7366e537426cbdacf4234c3058c2925b87ab499171d6f621e15f829010100d662aed8bf98b6971d37fc9932fdb9e965ccfb53db61bb629118177e2995080f01010000000260e0757bb7c29c082655b7bfe619f4f760ce2dd990f4438f0061d0d4b9f7040b030000006a47304402206d71dede2de1c2eb79e47e65ca36138f2c79148afbc0dc0abc1973d0b5a302f1022058ff9b054630d7d9694e17f7eb662d6839029c37bdbea10e78af52add62ad6b54121036106caa396cae56b3483498a0f19c993f9262301874c5e994fbeb4ea6f08add4ffffffff9d2aa330ab7a0a2c4035f31dfafa7f001392abd3866c34e47769eb3058f7b1c20000000015145bcbcc1c8475c237b96c782a32b8f6c71358626fffffffff1501000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac01000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac0f000000000000001976a914aad19cbf4aa09f4f65e07d74a5aaf4ec0fddd84888ac000000000100"
@@ -185,6 +203,13 @@ func TestNewDefault(t *testing.T) {
 		require.NoError(t, err)
 		assert.NotNil(t, defaultHandler)
 	})
+
+	t.Run("invalid status mapping", func(t *testing.T) {
+		_, err := NewDefault(testLogger, nil, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+			WithStatusMapping([]StatusMappingRule{{Status: api.ErrStatusFees, MapTo: api.StatusOK}}),
+		)
+		require.ErrorIs(t, err, ErrInvalidStatusMapping)
+	})
 }
 
 func TestGETPolicy(t *testing.T) {
@@ -221,6 +246,97 @@ func TestGETPolicy(t *testing.T) {
 	})
 }
 
+func TestReloadPolicy(t *testing.T) {
+	reloadedPolicy := &bitcoin.Settings{
+		MaxTxSizePolicy:         50_000_000,
+		MaxScriptSizePolicy:     20_000_000,
+		MaxTxSigopsCountsPolicy: 4_294_967_295,
+		MinMiningTxFee:          0.00000005,
+	}
+
+	tt := []struct {
+		name      string
+		reload    bool
+		sourceErr error
+
+		expectedErr     error
+		expectedPolicy  *bitcoin.Settings
+		expectedMaxSize uint64
+	}{
+		{
+			name:   "success",
+			reload: true,
+
+			expectedPolicy:  reloadedPolicy,
+			expectedMaxSize: 50_000_000,
+		},
+		{
+			name: "reload not enabled",
+
+			expectedErr:     ErrPolicyReloadDisabled,
+			expectedPolicy:  defaultPolicy,
+			expectedMaxSize: 100_000_000,
+		},
+		{
+			name:      "policy source fails",
+			reload:    true,
+			sourceErr: errors.New("node not reachable"),
+
+			expectedErr:     ErrPolicyReloadFailed,
+			expectedPolicy:  defaultPolicy,
+			expectedMaxSize: 100_000_000,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dv := &apiHandlerMocks.DefaultValidatorMock{}
+			bv := &apiHandlerMocks.BeefValidatorMock{}
+			reloadedDv := &apiHandlerMocks.DefaultValidatorMock{}
+			reloadedBv := &apiHandlerMocks.BeefValidatorMock{}
+
+			var opts []Option
+			if tc.reload {
+				opts = append(opts, WithPolicyReload(
+					func(_ context.Context) (*bitcoin.Settings, error) {
+						return reloadedPolicy, tc.sourceErr
+					},
+					func(policy *bitcoin.Settings) (DefaultValidator, BeefValidator) {
+						require.Equal(t, reloadedPolicy, policy)
+						return reloadedDv, reloadedBv
+					},
+				))
+			}
+
+			sut, err := NewDefault(testLogger, nil, &btxMocks.ClientMock{}, defaultPolicy, dv, bv, opts...)
+			require.NoError(t, err)
+
+			// when
+			_, err = sut.ReloadPolicy(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				actualDv, actualBv := sut.validators()
+				require.Same(t, reloadedDv, actualDv)
+				require.Same(t, reloadedBv, actualBv)
+			}
+			require.Equal(t, tc.expectedPolicy, sut.Policy())
+
+			rec := httptest.NewRecorder()
+			ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/v1/policy", nil), rec)
+			require.NoError(t, sut.GETPolicy(ctx))
+
+			var policyResponse api.PolicyResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &policyResponse))
+			require.Equal(t, tc.expectedMaxSize, policyResponse.Policy.Maxtxsizepolicy)
+		})
+	}
+}
+
 func TestGETHealth(t *testing.T) {
 	t.Run("health check success", func(t *testing.T) {
 		txHandler := &mtmMocks.TransactionHandlerMock{
@@ -286,6 +402,48 @@ func TestGETHealth(t *testing.T) {
 		require.NotEqual(t, health.Reason, nil)
 		require.Contains(t, *health.Reason, "some connection error")
 	})
+
+	t.Run("block header services", func(t *testing.T) {
+		// given
+		txHandler := &mtmMocks.TransactionHandlerMock{
+			HealthFunc: func(_ context.Context) error {
+				return nil
+			},
+		}
+		lastSuccess := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		throttledUntil := lastSuccess.Add(time.Minute)
+		blockHeaderServices := func() []BlockHeaderServiceStatus {
+			return []BlockHeaderServiceStatus{
+				{URL: "https://bhs-1.example.com", Available: true, LastSuccess: lastSuccess, ThrottledUntil: throttledUntil},
+				{URL: "https://bhs-2.example.com", Reason: "request timed out"},
+			}
+		}
+		btxClient := &btxMocks.ClientMock{}
+		bv := &apiHandlerMocks.BeefValidatorMock{}
+		dv := &apiHandlerMocks.DefaultValidatorMock{}
+		sut, err := NewDefault(testLogger, txHandler, btxClient, defaultPolicy, dv, bv, WithBlockHeaderServices(blockHeaderServices))
+		require.NoError(t, err)
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/v1/health", strings.NewReader(""))
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+
+		// when
+		err = sut.GETHealth(ctx)
+
+		// then
+		require.NoError(t, err)
+
+		var health api.Health
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &health))
+
+		require.True(t, *health.Healthy)
+		require.NotNil(t, health.BlockHeaderServices)
+		require.Equal(t, []api.BlockHeaderServiceStatus{
+			{Url: "https://bhs-1.example.com", Available: true, LastSuccess: &lastSuccess, ThrottledUntil: &throttledUntil},
+			{Url: "https://bhs-2.example.com", Reason: PtrTo("request timed out")},
+		}, *health.BlockHeaderServices)
+	})
 }
 
 func TestValidateCallbackURL(t *testing.T) {
@@ -304,6 +462,16 @@ func TestValidateCallbackURL(t *testing.T) {
 			name:        "valid callback URL",
 			callbackURL: "http://api.callback.com",
 		},
+		{
+			name:          "relative callback URL",
+			callbackURL:   "/callback",
+			expectedError: ErrInvalidCallbackURL,
+		},
+		{
+			name:          "unsupported scheme",
+			callbackURL:   "ftp://api.callback.com",
+			expectedError: ErrInvalidCallbackURL,
+		},
 		{
 			name:          "blocked url",
 			callbackURL:   "http://localhost",
@@ -352,6 +520,89 @@ func TestGETTransactionStatus(t *testing.T) {
 				Txid:        "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
 			},
 		},
+		{
+			name: "success - stage timings",
+			txHandlerStatusFound: &metamorph.TransactionStatus{
+				TxID:      "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Status:    "ANNOUNCED_TO_NETWORK",
+				Timestamp: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC).Unix(),
+				StageTimings: []metamorph.StageTiming{
+					{Stage: metamorph.StageReceived, Timestamp: time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC)},
+					{Stage: metamorph.StageStored, Timestamp: time.Date(2023, 5, 3, 9, 0, 1, 0, time.UTC), Latency: time.Second},
+					{Stage: metamorph.StageAnnounced, Timestamp: time.Date(2023, 5, 3, 9, 0, 1, 200_000_000, time.UTC), Latency: 200 * time.Millisecond},
+				},
+			},
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.TransactionStatus{
+				MerklePath:  PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				BlockHash:   PtrTo(""),
+				ExtraInfo:   PtrTo(""),
+				Timestamp:   time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC),
+				TxStatus:    api.ANNOUNCEDTONETWORK,
+				Txid:        "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Timings: &[]api.StageTiming{
+					{Stage: api.Received, Timestamp: time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC)},
+					{Stage: api.Stored, Timestamp: time.Date(2023, 5, 3, 9, 0, 1, 0, time.UTC), LatencyMs: 1000},
+					{Stage: api.Announced, Timestamp: time.Date(2023, 5, 3, 9, 0, 1, 200_000_000, time.UTC), LatencyMs: 200},
+				},
+			},
+		},
+		{
+			name: "success - peer acks",
+			txHandlerStatusFound: &metamorph.TransactionStatus{
+				TxID:      "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Status:    "SENT_TO_NETWORK",
+				Timestamp: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC).Unix(),
+				PeerAcks: []metamorph.PeerAck{
+					{Peer: "peer-1", RequestedAt: time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC), SentAt: time.Date(2023, 5, 3, 9, 0, 1, 0, time.UTC)},
+					{Peer: "peer-2", RequestedAt: time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC)},
+				},
+			},
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.TransactionStatus{
+				MerklePath:  PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				BlockHash:   PtrTo(""),
+				ExtraInfo:   PtrTo(""),
+				Timestamp:   time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC),
+				TxStatus:    api.SENTTONETWORK,
+				Txid:        "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Peers: &[]api.PeerAck{
+					{Peer: "peer-1", RequestedAt: PtrTo(time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC)), SentAt: PtrTo(time.Date(2023, 5, 3, 9, 0, 1, 0, time.UTC))},
+					{Peer: "peer-2", RequestedAt: PtrTo(time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC))},
+				},
+			},
+		},
+		{
+			name: "success - annotations",
+			txHandlerStatusFound: &metamorph.TransactionStatus{
+				TxID:      "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Status:    "SENT_TO_NETWORK",
+				Timestamp: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC).Unix(),
+				Annotations: []metamorph.Annotation{
+					{Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1", CreatedAt: time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC)},
+					{Note: "manually rebroadcast", Author: "ops-2", CreatedAt: time.Date(2023, 5, 3, 9, 30, 0, 0, time.UTC)},
+				},
+			},
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.TransactionStatus{
+				MerklePath:  PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				BlockHash:   PtrTo(""),
+				ExtraInfo:   PtrTo(""),
+				Timestamp:   time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC),
+				TxStatus:    api.SENTTONETWORK,
+				Txid:        "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Annotations: &[]api.Annotation{
+					{Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1", CreatedAt: time.Date(2023, 5, 3, 9, 0, 0, 0, time.UTC)},
+					{Note: "manually rebroadcast", Labels: []string{}, Author: "ops-2", CreatedAt: time.Date(2023, 5, 3, 9, 30, 0, 0, time.UTC)},
+				},
+			},
+		},
 		{
 			name: "success - double spend attempted",
 			txHandlerStatusFound: &metamorph.TransactionStatus{
@@ -442,426 +693,3660 @@ func TestGETTransactionStatus(t *testing.T) {
 	}
 }
 
-func TestPOSTTransaction(t *testing.T) { //nolint:funlen
-	errFieldMissingInputs := *api.NewErrorFields(api.ErrStatusTxFormat, "arc error 460: failed to get raw transactions for parent")
-	errFieldMissingInputs.Txid = PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118")
-
-	errFieldSubmitTx := *api.NewErrorFields(api.ErrStatusGeneric, "failed to submit tx")
-	errFieldSubmitTx.Txid = PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118")
-
-	errFieldValidation := *api.NewErrorFields(api.ErrStatusFees, "arc error 465: transaction fee is too low\nminimum expected fee: 22500000000, actual fee: 12")
-	errFieldValidation.Txid = PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118")
-
-	errBEEFDecode := *api.NewErrorFields(api.ErrStatusMalformed, "error while decoding BEEF\ninvalid BEEF - HasBUMP flag set, but no BUMP index")
-	errBEEFLowFees := *api.NewErrorFields(api.ErrStatusFees, "arc error 465: transaction fee of 0 sat is too low - minimum expected fee is 1 sat")
-	errBEEFLowFees.Txid = PtrTo("8184849de6af441c7de088428073c2a9131b08f7d878d9f49c3faf6d941eb168")
-	errBEEFInvalidScripts := *api.NewErrorFields(api.ErrStatusUnlockingScripts, "arc error 461: invalid script")
-	errBEEFInvalidScripts.Txid = PtrTo("ea2924da32c47b9942cda5ad30d3c01610ca554ca3a9ca01b2ccfe72bf0667be")
-
-	now := time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC)
+func TestGETTransactionStatusCached(t *testing.T) {
+	txID := "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"
 
 	tt := []struct {
-		name                       string
-		contentType                string
-		txHexString                string
-		getTx                      []byte
-		submitTxResponse           *metamorph.TransactionStatus
-		submitTxErr                error
-		validateTransactionErr     error
-		validateBeefTransactionErr error
-
-		expectedStatus   api.StatusCode
-		expectedResponse any
-		expectedError    error
+		name       string
+		invalidate bool
 
-		expectedFee uint64
+		expectedGetCalls int
+		expectedStatus   api.TransactionStatusTxStatus
 	}{
 		{
-			name:        "empty tx - text/plain",
-			contentType: contentTypes[0],
+			name: "second request answered from cache",
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
-			expectedError:    ErrEmptyBody,
+			expectedGetCalls: 1,
+			expectedStatus:   api.SEENONNETWORK,
 		},
 		{
-			name:        "invalid tx - empty payload, application/json",
-			contentType: contentTypes[1],
+			name:       "status update invalidates cached status",
+			invalidate: true,
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
-			expectedError:    ErrEmptyBody,
+			expectedGetCalls: 2,
+			expectedStatus:   api.MINED,
 		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			statuses := []string{"SEEN_ON_NETWORK", "MINED"}
+			txHandler := &mtmMocks.TransactionHandlerMock{}
+			txHandler.GetTransactionStatusFunc = func(_ context.Context, id string) (*metamorph.TransactionStatus, error) {
+				return &metamorph.TransactionStatus{
+					TxID:      id,
+					Status:    statuses[len(txHandler.GetTransactionStatusCalls())-1],
+					Timestamp: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC).Unix(),
+				}, nil
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+				WithStatusCache(cache.NewMemoryStore(), time.Minute),
+			)
+			require.NoError(t, err)
+
+			_, ctx := createEchoGetRequest("/v1/tx/" + txID)
+			err = sut.GETTransactionStatus(ctx, txID)
+			require.NoError(t, err)
+
+			if tc.invalidate {
+				sut.InvalidateStatuses(txID)
+			}
+
+			// when
+			rec, ctx := createEchoGetRequest("/v1/tx/" + txID)
+			err = sut.GETTransactionStatus(ctx, txID)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Len(t, txHandler.GetTransactionStatusCalls(), tc.expectedGetCalls)
+
+			var txStatus api.TransactionStatus
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &txStatus))
+			assert.Equal(t, tc.expectedStatus, txStatus.TxStatus)
+			assert.Equal(t, txID, txStatus.Txid)
+		})
+	}
+}
+
+func TestGETTransactionStatus_ETag(t *testing.T) {
+	txID := "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"
+
+	tt := []struct {
+		name          string
+		secondStatus  string
+		ifNoneMatchFn func(etag string) string
+
+		expectedCode int
+	}{
 		{
-			name:        "empty tx - application/octet-stream",
-			contentType: contentTypes[2],
+			name:          "unchanged state",
+			secondStatus:  "SEEN_ON_NETWORK",
+			ifNoneMatchFn: func(etag string) string { return etag },
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
-			expectedError:    ErrEmptyBody,
+			expectedCode: http.StatusNotModified,
 		},
 		{
-			name:        "invalid mime type",
-			contentType: echo.MIMEApplicationXML,
-			txHexString: validTx,
+			name:          "unchanged state - weak entity tag in list",
+			secondStatus:  "SEEN_ON_NETWORK",
+			ifNoneMatchFn: func(etag string) string { return `"other", W/` + etag },
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: given content-type application/xml does not match any of the allowed content-types"),
+			expectedCode: http.StatusNotModified,
 		},
 		{
-			name:        "invalid tx - text/plain",
-			contentType: contentTypes[0],
-			txHexString: "test",
+			name:          "changed status",
+			secondStatus:  "MINED",
+			ifNoneMatchFn: func(etag string) string { return etag },
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: encoding/hex: invalid byte: U+0074 't'"),
+			expectedCode: http.StatusOK,
 		},
 		{
-			name:        "invalid json - application/json",
-			contentType: contentTypes[1],
-			txHexString: "test",
+			name:          "no If-None-Match",
+			secondStatus:  "SEEN_ON_NETWORK",
+			ifNoneMatchFn: func(_ string) string { return "" },
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: invalid character 'e' in literal true (expecting 'r')"),
+			expectedCode: http.StatusOK,
 		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			statuses := []string{"SEEN_ON_NETWORK", tc.secondStatus}
+			txHandler := &mtmMocks.TransactionHandlerMock{}
+			txHandler.GetTransactionStatusFunc = func(_ context.Context, id string) (*metamorph.TransactionStatus, error) {
+				return &metamorph.TransactionStatus{
+					TxID:      id,
+					Status:    statuses[len(txHandler.GetTransactionStatusCalls())-1],
+					Timestamp: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC).Unix(),
+				}, nil
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{})
+			require.NoError(t, err)
+
+			rec, ctx := createEchoGetRequest("/v1/tx/" + txID)
+			err = sut.GETTransactionStatus(ctx, txID)
+			require.NoError(t, err)
+			etag := rec.Header().Get("ETag")
+			require.NotEmpty(t, etag)
+
+			// when
+			rec, ctx = createEchoGetRequest("/v1/tx/" + txID)
+			if ifNoneMatch := tc.ifNoneMatchFn(etag); ifNoneMatch != "" {
+				ctx.Request().Header.Set("If-None-Match", ifNoneMatch)
+			}
+			err = sut.GETTransactionStatus(ctx, txID)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCode, rec.Code)
+			require.NotEmpty(t, rec.Header().Get("ETag"))
+			if tc.expectedCode == http.StatusNotModified {
+				require.Empty(t, rec.Body.Bytes())
+				require.Equal(t, etag, rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+func TestGETTransactionStatus_ExternalLookup(t *testing.T) {
+	txID := "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"
+
+	tt := []struct {
+		name    string
+		nodeTx  *bitcoin.RawTransaction
+		nodeErr error
+
+		expectedCode        int
+		expectedStatus      api.TransactionStatusTxStatus
+		expectedBlockHeight uint64
+	}{
 		{
-			name:        "invalid tx - incorrect json field, application/json",
-			contentType: contentTypes[1],
-			txHexString: fmt.Sprintf("{\"txHex\": \"%s\"}", validTx),
+			name:   "mined",
+			nodeTx: &bitcoin.RawTransaction{TxID: txID, BlockHash: "0000000000000000025855b1f5e9a0c2e0d2a7b1e6f5e1b1a6c7e0b8e3f4a5d6", BlockHeight: 850000},
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
-			expectedError:    ErrEmptyBody,
+			expectedCode:        http.StatusOK,
+			expectedStatus:      api.MINED,
+			expectedBlockHeight: 850000,
 		},
 		{
-			name:        "invalid tx - invalid hex, application/octet-stream",
-			contentType: contentTypes[2],
-			txHexString: "test",
+			name:   "in mempool",
+			nodeTx: &bitcoin.RawTransaction{TxID: txID},
 
-			expectedStatus:   400,
-			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "could not read varint type: EOF"),
+			expectedCode:   http.StatusOK,
+			expectedStatus: api.SEENONNETWORK,
 		},
 		{
-			name:        "valid tx - fees too low",
-			contentType: contentTypes[0],
-			txHexString: validTx,
-			getTx:       validTxParentBytes,
-			expectedFee: 1000,
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID: "",
-			},
-			validateTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
+			name:    "unknown to node",
+			nodeErr: errors.New("No such mempool or blockchain transaction"),
+
+			expectedCode: int(api.ErrStatusNotFound),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusFunc: func(_ context.Context, _ string) (*metamorph.TransactionStatus, error) {
+					return nil, metamorph.ErrTransactionNotFound
+				},
+			}
+			node := &apiHandlerMocks.ExternalStatusNodeMock{
+				GetRawTransactionFunc: func(_ string) (*bitcoin.RawTransaction, error) {
+					return tc.nodeTx, tc.nodeErr
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+				WithExternalStatusLookup(node),
+			)
+			require.NoError(t, err)
+
+			rec, ctx := createEchoGetRequest("/v1/tx/" + txID)
+
+			// when
+			err = sut.GETTransactionStatus(ctx, txID)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCode, rec.Code)
+			require.Len(t, node.GetRawTransactionCalls(), 1)
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			var actual api.TransactionStatus
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			assert.Equal(t, tc.expectedStatus, actual.TxStatus)
+			assert.Equal(t, tc.expectedBlockHeight, *actual.BlockHeight)
+			assert.Equal(t, PtrTo(true), actual.External)
+		})
+	}
+}
+
+func TestPOSTTransactionResubmit(t *testing.T) {
+	tt := []struct {
+		name           string
+		txHandlerResp  *metamorph.TransactionStatus
+		txHandlerErr   error
+		expectedStatus api.StatusCode
+
+		expectedResponse any
+	}{
+		{
+			name: "success",
+			txHandlerResp: &metamorph.TransactionStatus{
+				TxID:   "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Status: "STORED",
+			},
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.TransactionStatus{
+				Timestamp: time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC),
+				TxStatus:  api.STORED,
+				Txid:      "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+			},
+		},
+		{
+			name:         "error - tx not found",
+			txHandlerErr: metamorph.ErrTransactionNotFound,
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "transaction not found"),
+		},
+		{
+			name:         "error - tx not rejected",
+			txHandlerErr: metamorph.ErrTransactionNotRejected,
+
+			expectedStatus:   api.ErrStatusGeneric,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusGeneric, "transaction is not rejected"),
+		},
+		{
+			name:         "error - quarantine expired",
+			txHandlerErr: metamorph.ErrQuarantineExpired,
+
+			expectedStatus:   api.ErrStatusGeneric,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusGeneric, "quarantine of rejected transaction has expired"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoPostRequest(nil, echo.MIMEApplicationJSON, "/v1/tx/c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46/resubmit")
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				ResubmitTransactionFunc: func(_ context.Context, _ string) (*metamorph.TransactionStatus, error) {
+					return tc.txHandlerResp, tc.txHandlerErr
+				},
+			}
+
+			btxClient := &btxMocks.ClientMock{}
+			bv := &apiHandlerMocks.BeefValidatorMock{}
+			dv := &apiHandlerMocks.DefaultValidatorMock{}
+			defaultHandler, err := NewDefault(testLogger, txHandler, btxClient, nil, dv, bv, WithNow(func() time.Time { return time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC) }))
+			require.NoError(t, err)
+
+			// when
+			err = defaultHandler.POSTTransactionResubmit(ctx, "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46")
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.TransactionStatus:
+				var txStatus api.TransactionStatus
+				err = json.Unmarshal(b, &txStatus)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txStatus)
+			case api.ErrorFields:
+				var txErr api.ErrorFields
+				err = json.Unmarshal(b, &txErr)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txErr)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestDELETETransaction(t *testing.T) {
+	tt := []struct {
+		name         string
+		txHandlerErr error
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name: "success - cancelled",
+
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:         "error - tx not found",
+			txHandlerErr: metamorph.ErrTransactionNotFound,
+
+			expectedStatus: api.ErrStatusNotFound,
+		},
+		{
+			name:         "error - tx already announced",
+			txHandlerErr: metamorph.ErrTransactionNotScheduled,
+
+			expectedStatus: api.ErrStatusGeneric,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tx/"+validTxID, nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				CancelScheduledBroadcastFunc: func(_ context.Context, _ string) error {
+					return tc.txHandlerErr
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{})
+			require.NoError(t, err)
+
+			// when
+			err = sut.DELETETransaction(ctx, validTxID)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, txHandler.CancelScheduledBroadcastCalls(), 1)
+		})
+	}
+}
+
+func TestDELETETransactionSchedule(t *testing.T) {
+	tt := []struct {
+		name         string
+		txHandlerErr error
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name: "success",
+
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:         "error - tx not found",
+			txHandlerErr: metamorph.ErrTransactionNotFound,
+
+			expectedStatus: api.ErrStatusNotFound,
+		},
+		{
+			name:         "error - tx not scheduled",
+			txHandlerErr: metamorph.ErrTransactionNotScheduled,
+
+			expectedStatus: api.ErrStatusGeneric,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/v1/tx/"+validTxID+"/schedule", nil)
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				CancelScheduledBroadcastFunc: func(_ context.Context, _ string) error {
+					return tc.txHandlerErr
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{})
+			require.NoError(t, err)
+
+			// when
+			err = sut.DELETETransactionSchedule(ctx, validTxID)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, txHandler.CancelScheduledBroadcastCalls(), 1)
+			assert.Equal(t, validTxID, txHandler.CancelScheduledBroadcastCalls()[0].TxID)
+		})
+	}
+}
+
+func TestGETTransactionGraph(t *testing.T) {
+	tt := []struct {
+		name          string
+		maxNodes      *int
+		txHandlerResp *metamorph.TransactionGraph
+		txHandlerErr  error
+
+		expectedMaxNodes uint32
+		expectedStatus   api.StatusCode
+		expectedResponse any
+	}{
+		{
+			name:     "success",
+			maxNodes: PtrTo(10),
+			txHandlerResp: &metamorph.TransactionGraph{
+				TxID: "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Nodes: []*metamorph.TransactionGraphNode{
+					{
+						TxID:   "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+						Status: "SEEN_ON_NETWORK",
+						Size:   191,
+						Children: []string{
+							"a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118",
+						},
+					},
+					{
+						TxID:         "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118",
+						Status:       "REJECTED",
+						RejectReason: "mempool min fee not met",
+						Size:         191,
+						Fee:          10,
+						FeeKnown:     true,
+						Parents: []string{
+							"c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+						},
+					},
+				},
+			},
+
+			expectedMaxNodes: 10,
+			expectedStatus:   api.StatusOK,
+			expectedResponse: api.TransactionGraph{
+				Txid: "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+				Transactions: []api.TransactionGraphNode{
+					{
+						Txid:     "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46",
+						TxStatus: "SEEN_ON_NETWORK",
+						Size:     PtrTo(uint64(191)),
+						Parents:  []string{},
+						Children: []string{"a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"},
+					},
+					{
+						Txid:      "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118",
+						TxStatus:  "REJECTED",
+						ExtraInfo: PtrTo("mempool min fee not met"),
+						Size:      PtrTo(uint64(191)),
+						Fee:       PtrTo(uint64(10)),
+						Parents:   []string{"c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"},
+						Children:  []string{},
+					},
+				},
+			},
+		},
+		{
+			name:         "error - tx not found",
+			txHandlerErr: metamorph.ErrTransactionNotFound,
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "transaction not found"),
+		},
+		{
+			name:         "error - generic",
+			txHandlerErr: errors.New("metamorph unavailable"),
+
+			expectedStatus:   api.ErrStatusGeneric,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusGeneric, "metamorph unavailable"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoGetRequest("/v1/tx/c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46/graph")
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionGraphFunc: func(_ context.Context, _ string, _ uint32) (*metamorph.TransactionGraph, error) {
+					return tc.txHandlerResp, tc.txHandlerErr
+				},
+			}
+
+			btxClient := &btxMocks.ClientMock{}
+			bv := &apiHandlerMocks.BeefValidatorMock{}
+			dv := &apiHandlerMocks.DefaultValidatorMock{}
+			defaultHandler, err := NewDefault(testLogger, txHandler, btxClient, nil, dv, bv)
+			require.NoError(t, err)
+
+			// when
+			err = defaultHandler.GETTransactionGraph(ctx, "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46", api.GETTransactionGraphParams{MaxNodes: tc.maxNodes})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, txHandler.GetTransactionGraphCalls(), 1)
+			assert.Equal(t, tc.expectedMaxNodes, txHandler.GetTransactionGraphCalls()[0].MaxNodes)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.TransactionGraph:
+				var graph api.TransactionGraph
+				err = json.Unmarshal(b, &graph)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, graph)
+			case api.ErrorFields:
+				var txErr api.ErrorFields
+				err = json.Unmarshal(b, &txErr)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txErr)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestGETOutpointSpent(t *testing.T) {
+	txID := "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"
+	spendingTxID := "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"
+
+	tt := []struct {
+		name          string
+		txID          string
+		vout          int
+		txHandlerResp *metamorph.OutpointSpender
+		txHandlerErr  error
+
+		expectedCalls    int
+		expectedStatus   api.StatusCode
+		expectedResponse any
+	}{
+		{
+			name:          "spent",
+			txID:          txID,
+			vout:          1,
+			txHandlerResp: &metamorph.OutpointSpender{Spent: true, SpendingTxID: spendingTxID, Status: "SEEN_ON_NETWORK"},
+
+			expectedCalls:  1,
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.OutpointSpent{
+				Txid:         txID,
+				Vout:         1,
+				Spent:        true,
+				SpendingTxid: PtrTo(spendingTxID),
+				TxStatus:     PtrTo("SEEN_ON_NETWORK"),
+			},
+		},
+		{
+			name:          "not spent",
+			txID:          txID,
+			txHandlerResp: &metamorph.OutpointSpender{},
+
+			expectedCalls:    1,
+			expectedStatus:   api.StatusOK,
+			expectedResponse: api.OutpointSpent{Txid: txID},
+		},
+		{
+			name: "error - invalid txid",
+			txID: "c9648bf65a734ce6",
+
+			expectedStatus:   api.ErrStatusBadRequest,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "invalid transaction ID"),
+		},
+		{
+			name: "error - invalid vout",
+			txID: txID,
+			vout: -1,
+
+			expectedStatus:   api.ErrStatusBadRequest,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "invalid output index"),
+		},
+		{
+			name:         "error - not supported",
+			txID:         txID,
+			txHandlerErr: metamorph.ErrTransactionNotFound,
+
+			expectedCalls:    1,
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "transaction not found"),
+		},
+		{
+			name:         "error - generic",
+			txID:         txID,
+			txHandlerErr: errors.New("metamorph unavailable"),
+
+			expectedCalls:    1,
+			expectedStatus:   api.ErrStatusGeneric,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusGeneric, "metamorph unavailable"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoGetRequest(fmt.Sprintf("/v1/outpoint/%s/%d/spent", tc.txID, tc.vout))
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetOutpointSpenderFunc: func(_ context.Context, _ string, _ uint32) (*metamorph.OutpointSpender, error) {
+					return tc.txHandlerResp, tc.txHandlerErr
+				},
+			}
+
+			btxClient := &btxMocks.ClientMock{}
+			bv := &apiHandlerMocks.BeefValidatorMock{}
+			dv := &apiHandlerMocks.DefaultValidatorMock{}
+			defaultHandler, err := NewDefault(testLogger, txHandler, btxClient, nil, dv, bv)
+			require.NoError(t, err)
+
+			// when
+			err = defaultHandler.GETOutpointSpent(ctx, tc.txID, tc.vout)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, txHandler.GetOutpointSpenderCalls(), tc.expectedCalls)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.OutpointSpent:
+				var spent api.OutpointSpent
+				err = json.Unmarshal(b, &spent)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, spent)
+			case api.ErrorFields:
+				var txErr api.ErrorFields
+				err = json.Unmarshal(b, &txErr)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txErr)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestGETTransactionUpstreams(t *testing.T) {
+	txID := "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"
+	timestamp := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name          string
+		federationOff bool
+		acks          []federation.Acknowledgement
+		acksErr       error
+
+		expectedStatus   api.StatusCode
+		expectedResponse any
+	}{
+		{
+			name: "success",
+			acks: []federation.Acknowledgement{
+				{Upstream: "miner-a", Acknowledged: true, Status: 200, TxStatus: "SEEN_ON_NETWORK", Timestamp: timestamp},
+				{Upstream: "miner-b", Status: 401, ExtraInfo: "unauthorized", Timestamp: timestamp},
+			},
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.UpstreamAcknowledgements{
+				Txid:         txID,
+				Acknowledged: 1,
+				Upstreams: []api.UpstreamAcknowledgement{
+					{Name: "miner-a", Acknowledged: true, Status: 200, TxStatus: PtrTo("SEEN_ON_NETWORK"), Timestamp: timestamp},
+					{Name: "miner-b", Status: 401, ExtraInfo: PtrTo("unauthorized"), Timestamp: timestamp},
+				},
+			},
+		},
+		{
+			name:          "error - federation not enabled",
+			federationOff: true,
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "federation not enabled"),
+		},
+		{
+			name:    "error - not forwarded",
+			acksErr: federation.ErrNotForwarded,
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "transaction was not forwarded to upstream ARC instances"),
+		},
+		{
+			name:    "error - generic",
+			acksErr: errors.New("cache unavailable"),
+
+			expectedStatus:   api.ErrStatusGeneric,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusGeneric, "cache unavailable"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoGetRequest(fmt.Sprintf("/v1/tx/%s/upstreams", txID))
+
+			var opts []Option
+			if !tc.federationOff {
+				opts = append(opts, WithFederation(&apiHandlerMocks.FederationMock{
+					AcknowledgementsFunc: func(_ string) ([]federation.Acknowledgement, error) {
+						return tc.acks, tc.acksErr
+					},
+				}))
+			}
+
+			defaultHandler, err := NewDefault(testLogger, &mtmMocks.TransactionHandlerMock{}, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{}, opts...)
+			require.NoError(t, err)
+
+			// when
+			err = defaultHandler.GETTransactionUpstreams(ctx, txID)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.UpstreamAcknowledgements:
+				var upstreams api.UpstreamAcknowledgements
+				err = json.Unmarshal(b, &upstreams)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, upstreams)
+			case api.ErrorFields:
+				var txErr api.ErrorFields
+				err = json.Unmarshal(b, &txErr)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txErr)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestGETTransactionReceipt(t *testing.T) {
+	txID := "c9648bf65a734ce64614dc92877012ba7269f6ea1f55be9ab5a342a2f768cf46"
+	signer, err := receipt.NewSigner(testReceiptKey)
+	require.NoError(t, err)
+	issued, err := signer.Sign(txID, "SEEN_ON_NETWORK", "policy-1", time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	tt := []struct {
+		name        string
+		receiptsOff bool
+		stored      *receipt.Receipt
+
+		expectedStatus   api.StatusCode
+		expectedResponse any
+	}{
+		{
+			name:   "success",
+			stored: issued,
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.ReceiptVerification{
+				Txid:      txID,
+				TxStatus:  "SEEN_ON_NETWORK",
+				PolicyId:  "policy-1",
+				Timestamp: issued.Timestamp,
+				PublicKey: signer.PublicKey(),
+				Signature: issued.Signature,
+				Verified:  true,
+			},
+		},
+		{
+			name:   "success - tampered receipt",
+			stored: &receipt.Receipt{TxID: txID, TxStatus: "MINED", PolicyID: "policy-1", Timestamp: issued.Timestamp, PublicKey: issued.PublicKey, Signature: issued.Signature},
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.ReceiptVerification{
+				Txid:      txID,
+				TxStatus:  "MINED",
+				PolicyId:  "policy-1",
+				Timestamp: issued.Timestamp,
+				PublicKey: signer.PublicKey(),
+				Signature: issued.Signature,
+				Verified:  false,
+			},
+		},
+		{
+			name:        "error - receipts not enabled",
+			receiptsOff: true,
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "receipts not enabled"),
+		},
+		{
+			name: "error - not found",
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, "receipt not found\ntxid: "+txID),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoGetRequest(fmt.Sprintf("/v1/tx/%s/receipt", txID))
+
+			var opts []Option
+			if !tc.receiptsOff {
+				store := receipt.NewStore(cache.NewMemoryStore(), time.Hour)
+				if tc.stored != nil {
+					_, err := store.Save(tc.stored)
+					require.NoError(t, err)
+				}
+				opts = append(opts, WithReceipts(signer, store))
+			}
+
+			defaultHandler, err := NewDefault(testLogger, &mtmMocks.TransactionHandlerMock{}, &btxMocks.ClientMock{}, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{}, opts...)
+			require.NoError(t, err)
+
+			// when
+			err = defaultHandler.GETTransactionReceipt(ctx, txID)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.ReceiptVerification:
+				var verification api.ReceiptVerification
+				err = json.Unmarshal(b, &verification)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, verification)
+			case api.ErrorFields:
+				var txErr api.ErrorFields
+				err = json.Unmarshal(b, &txErr)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txErr)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestGETBlocks(t *testing.T) {
+	blockHash := "0000000000000000064f9fd2de8a0d7a29e3774eade0d7c1f4f2f5fa79c8b9a4"
+	hash, err := chainhash.NewHashFromStr(blockHash)
+	require.NoError(t, err)
+	timestamp := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	processedAt := timestamp.Add(3 * time.Second)
+
+	tt := []struct {
+		name         string
+		limit        *int
+		blocks       []*blocktx_api.Block
+		btxClientErr error
+
+		expectedLimit    uint64
+		expectedStatus   api.StatusCode
+		expectedResponse any
+	}{
+		{
+			name:  "success",
+			limit: PtrTo(5),
+			blocks: []*blocktx_api.Block{
+				{
+					Hash:           hash[:],
+					Height:         886236,
+					CoinbaseReward: 312521345,
+					MinerTag:       "/TAAL/",
+					Timestamp:      timestamppb.New(timestamp),
+					ProcessedAt:    timestamppb.New(processedAt),
+				},
+			},
+
+			expectedLimit:  5,
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.Blocks{
+				Blocks: []api.Block{
+					{
+						BlockHash:      blockHash,
+						BlockHeight:    886236,
+						CoinbaseReward: 312521345,
+						MinerTag:       "/TAAL/",
+						Timestamp:      PtrTo(timestamp),
+						ProcessedAt:    PtrTo(processedAt),
+					},
+				},
+			},
+		},
+		{
+			name:  "success - limit capped",
+			limit: PtrTo(1000),
+
+			expectedLimit:    100,
+			expectedStatus:   api.StatusOK,
+			expectedResponse: api.Blocks{Blocks: []api.Block{}},
+		},
+		{
+			name:         "error - generic",
+			btxClientErr: errors.New("blocktx unavailable"),
+
+			expectedLimit:    10,
+			expectedStatus:   api.ErrStatusGeneric,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusGeneric, "blocktx unavailable"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoGetRequest("/v1/blocks")
+
+			btxClient := &btxMocks.ClientMock{
+				LatestBlocksFunc: func(_ context.Context, _ uint64) (*blocktx_api.LatestBlocksResponse, error) {
+					if tc.btxClientErr != nil {
+						return nil, tc.btxClientErr
+					}
+					return &blocktx_api.LatestBlocksResponse{Blocks: tc.blocks}, nil
+				},
+			}
+			txHandler := &mtmMocks.TransactionHandlerMock{}
+			bv := &apiHandlerMocks.BeefValidatorMock{}
+			dv := &apiHandlerMocks.DefaultValidatorMock{}
+			defaultHandler, err := NewDefault(testLogger, txHandler, btxClient, nil, dv, bv)
+			require.NoError(t, err)
+
+			// when
+			err = defaultHandler.GETBlocks(ctx, api.GETBlocksParams{Limit: tc.limit})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, btxClient.LatestBlocksCalls(), 1)
+			assert.Equal(t, tc.expectedLimit, btxClient.LatestBlocksCalls()[0].Blocks)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.Blocks:
+				var blocks api.Blocks
+				err = json.Unmarshal(b, &blocks)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, blocks)
+			case api.ErrorFields:
+				var btxErr api.ErrorFields
+				err = json.Unmarshal(b, &btxErr)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, btxErr)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestGETStats(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	tt := []struct {
+		name            string
+		feeStatsEnabled bool
+
+		expectedStatus   api.StatusCode
+		expectedResponse any
+	}{
+		{
+			name:            "success",
+			feeStatsEnabled: true,
+
+			expectedStatus: api.StatusOK,
+			expectedResponse: api.Stats{
+				WindowSeconds:  86400,
+				Accepted:       2,
+				AverageFeeRate: 50,
+				Pending:        2,
+				Hours: []api.StatsHour{
+					{Start: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), Accepted: 2, AverageFeeRate: 50},
+				},
+				TimeToMine: []api.TimeToMineBucket{
+					{UpperBoundSeconds: PtrTo(uint64(600))},
+					{UpperBoundSeconds: PtrTo(uint64(1800))},
+					{UpperBoundSeconds: PtrTo(uint64(3600))},
+					{UpperBoundSeconds: PtrTo(uint64(7200))},
+					{UpperBoundSeconds: PtrTo(uint64(21600))},
+					{UpperBoundSeconds: PtrTo(uint64(86400))},
+					{},
+				},
+				Timestamp: now,
+			},
+		},
+		{
+			name: "error - not enabled",
+
+			expectedStatus:   api.ErrStatusNotFound,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusNotFound, ErrFeeStatsDisabled.Error()),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rec, ctx := createEchoGetRequest("/v1/stats")
+
+			txHandler := &mtmMocks.TransactionHandlerMock{}
+			opts := []Option{WithNow(func() time.Time { return now })}
+			if tc.feeStatsEnabled {
+				aggregator := feestats.New(testLogger, txHandler, feestats.WithNow(func() time.Time { return now }))
+				aggregator.Record("tx-1", &validator.FeeDetails{Size: 200, ActualFee: 10})
+				aggregator.Record("tx-2", nil)
+				opts = append(opts, WithFeeStats(aggregator))
+			}
+
+			defaultHandler, err := NewDefault(testLogger, txHandler, nil, nil, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{}, opts...)
+			require.NoError(t, err)
+			defer defaultHandler.Shutdown()
+
+			// when
+			err = defaultHandler.GETStats(ctx)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.Stats:
+				var stats api.Stats
+				err = json.Unmarshal(b, &stats)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, stats)
+			case api.ErrorFields:
+				var errFields api.ErrorFields
+				err = json.Unmarshal(b, &errFields)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, errFields)
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction(t *testing.T) { //nolint:funlen
+	errFieldMissingInputs := *api.NewErrorFields(api.ErrStatusTxFormat, "arc error 460: failed to get raw transactions for parent")
+	errFieldMissingInputs.Txid = PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118")
+
+	errFieldSubmitTx := *api.NewErrorFields(api.ErrStatusGeneric, "failed to submit tx")
+	errFieldSubmitTx.Txid = PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118")
+
+	errFieldValidation := *api.NewErrorFields(api.ErrStatusFees, "arc error 465: transaction fee is too low\nminimum expected fee: 22500000000, actual fee: 12")
+	errFieldValidation.Txid = PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118")
+
+	errBEEFDecode := *api.NewErrorFields(api.ErrStatusMalformed, "error while decoding BEEF\ninvalid BEEF - HasBUMP flag set, but no BUMP index")
+	errBEEFLowFees := *api.NewErrorFields(api.ErrStatusFees, "arc error 465: transaction fee of 0 sat is too low - minimum expected fee is 1 sat")
+	errBEEFLowFees.Txid = PtrTo("8184849de6af441c7de088428073c2a9131b08f7d878d9f49c3faf6d941eb168")
+	errBEEFInvalidScripts := *api.NewErrorFields(api.ErrStatusUnlockingScripts, "arc error 461: invalid script")
+	errBEEFInvalidScripts.Txid = PtrTo("ea2924da32c47b9942cda5ad30d3c01610ca554ca3a9ca01b2ccfe72bf0667be")
+
+	now := time.Date(2023, 5, 3, 10, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name                       string
+		contentType                string
+		txHexString                string
+		getTx                      []byte
+		submitTxResponse           *metamorph.TransactionStatus
+		submitTxErr                error
+		validateTransactionErr     error
+		validateBeefTransactionErr error
+
+		expectedStatus   api.StatusCode
+		expectedResponse any
+		expectedError    error
+
+		expectedFee uint64
+	}{
+		{
+			name:        "empty tx - text/plain",
+			contentType: contentTypes[0],
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
+			expectedError:    ErrEmptyBody,
+		},
+		{
+			name:        "invalid tx - empty payload, application/json",
+			contentType: contentTypes[1],
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
+			expectedError:    ErrEmptyBody,
+		},
+		{
+			name:        "empty tx - application/octet-stream",
+			contentType: contentTypes[2],
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
+			expectedError:    ErrEmptyBody,
+		},
+		{
+			name:        "invalid mime type",
+			contentType: echo.MIMEApplicationXML,
+			txHexString: validTx,
+
+			expectedStatus:   415,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusUnsupportedMediaType, "error parsing transactions from request: content type not supported\ncontent type: application/xml"),
+			expectedError:    ErrUnsupportedContentType,
+		},
+		{
+			name:        "invalid tx - text/plain",
+			contentType: contentTypes[0],
+			txHexString: "test",
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: encoding/hex: invalid byte: U+0074 't'"),
+		},
+		{
+			name:        "invalid json - application/json",
+			contentType: contentTypes[1],
+			txHexString: "test",
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: invalid character 'e' in literal true (expecting 'r')"),
+		},
+		{
+			name:        "invalid tx - incorrect json field, application/json",
+			contentType: contentTypes[1],
+			txHexString: fmt.Sprintf("{\"txHex\": \"%s\"}", validTx),
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "error parsing transactions from request: no transaction found - empty request body"),
+			expectedError:    ErrEmptyBody,
+		},
+		{
+			name:        "invalid tx - invalid hex, application/octet-stream",
+			contentType: contentTypes[2],
+			txHexString: "test",
+
+			expectedStatus:   400,
+			expectedResponse: *api.NewErrorFields(api.ErrStatusBadRequest, "could not read varint type: EOF"),
+		},
+		{
+			name:        "valid tx - fees too low",
+			contentType: contentTypes[0],
+			txHexString: validTx,
+			getTx:       validTxParentBytes,
+			expectedFee: 1000,
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID: "",
+			},
+			validateTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
+
+			expectedStatus:   465,
+			expectedResponse: errFieldValidation,
+			expectedError:    defaultvalidator.ErrTxFeeTooLow,
+		},
+		{
+			name:             "valid tx - submit error",
+			contentType:      contentTypes[0],
+			txHexString:      validExtendedTx,
+			getTx:            validTxParentBytes,
+			submitTxErr:      errors.New("failed to submit tx"),
+			submitTxResponse: nil,
+
+			expectedStatus:   409,
+			expectedResponse: errFieldSubmitTx,
+		},
+		{
+			name:        "valid tx - success",
+			contentType: contentTypes[0],
+			txHexString: validExtendedTx,
+			getTx:       inputTxLowFeesBytes,
+
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID:        validTxID,
+				BlockHash:   "",
+				BlockHeight: 0,
+				Status:      "SEEN_ON_NETWORK",
+				Timestamp:   time.Now().Unix(),
+			},
+
+			expectedStatus: 200,
+			expectedResponse: api.TransactionResponse{
+				BlockHash:   PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				ExtraInfo:   PtrTo(""),
+				MerklePath:  PtrTo(""),
+				Status:      200,
+				Timestamp:   now,
+				Title:       "OK",
+				TxStatus:    api.TransactionResponseTxStatusSEENONNETWORK,
+				Fee:         &api.FeeDetails{Size: 225, ActualFee: 12, RequiredFee: 1, Rules: []api.FeeRule{{Rule: validator.FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 225, ActualFee: 12, RequiredFee: 1}}},
+				Txid:        validTxID,
+			},
+		},
+		{
+			name:        "valid tx - double spend attempted",
+			contentType: contentTypes[0],
+			txHexString: validExtendedTx,
+			getTx:       inputTxLowFeesBytes,
+
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID:         validTxID,
+				BlockHash:    "",
+				BlockHeight:  0,
+				Status:       "DOUBLE_SPEND_ATTEMPTED",
+				CompetingTxs: []string{"1234"},
+				Timestamp:    time.Now().Unix(),
+			},
+
+			expectedStatus: 200,
+			expectedResponse: api.TransactionResponse{
+				BlockHash:    PtrTo(""),
+				BlockHeight:  PtrTo(uint64(0)),
+				ExtraInfo:    PtrTo(""),
+				CompetingTxs: PtrTo([]string{"1234"}),
+				MerklePath:   PtrTo(""),
+				Status:       200,
+				Timestamp:    now,
+				Title:        "OK",
+				TxStatus:     "DOUBLE_SPEND_ATTEMPTED",
+				Fee:          &api.FeeDetails{Size: 225, ActualFee: 12, RequiredFee: 1, Rules: []api.FeeRule{{Rule: validator.FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 225, ActualFee: 12, RequiredFee: 1}}},
+				Txid:         validTxID,
+			},
+		},
+		{
+			name:        "invalid BEEF - text/plain - no BUMP index",
+			contentType: contentTypes[0],
+			txHexString: invalidBeefNoBUMPIndex,
+
+			expectedStatus:   463,
+			expectedResponse: errBEEFDecode,
+			expectedError:    ErrDecodingBeef,
+		},
+		{
+			name:                       "invalid BEEF - text/plain - low fees",
+			contentType:                contentTypes[0],
+			txHexString:                invalidBeefLowFees,
+			validateBeefTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
 
 			expectedStatus:   465,
-			expectedResponse: errFieldValidation,
-			expectedError:    defaultvalidator.ErrTxFeeTooLow,
+			expectedResponse: errBEEFLowFees,
+		},
+		{
+			name:                       "invalid BEEF - text/plain - invalid scripts",
+			contentType:                contentTypes[0],
+			txHexString:                invalidBeefInvalidScripts,
+			validateBeefTransactionErr: validator.NewError(errors.New("validation error"), api.ErrStatusUnlockingScripts),
+
+			expectedStatus:   461,
+			expectedResponse: errBEEFInvalidScripts,
+		},
+		{
+			name:        "valid BEEF - success - text/plain",
+			contentType: contentTypes[0],
+			txHexString: validBeef,
+
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID:        validBeefTxID,
+				BlockHash:   "",
+				BlockHeight: 0,
+				Status:      "SEEN_ON_NETWORK",
+				Timestamp:   time.Now().Unix(),
+			},
+
+			expectedStatus: 200,
+			expectedResponse: api.TransactionResponse{
+				BlockHash:   PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				ExtraInfo:   PtrTo(""),
+				MerklePath:  PtrTo(""),
+				Status:      200,
+				Timestamp:   now,
+				Title:       "OK",
+				TxStatus:    "SEEN_ON_NETWORK",
+				Fee:         &api.FeeDetails{Size: 191, ActualFee: 2, RequiredFee: 1, Rules: []api.FeeRule{{Rule: validator.FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 191, ActualFee: 2, RequiredFee: 1}}},
+				Txid:        validBeefTxID,
+			},
+		},
+		{
+			name:        "valid BEEF - success - application/json",
+			contentType: contentTypes[1],
+			txHexString: fmt.Sprintf("{\"rawTx\": \"%s\"}", validBeef),
+
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID:        validBeefTxID,
+				BlockHash:   "",
+				BlockHeight: 0,
+				Status:      "SEEN_ON_NETWORK",
+				Timestamp:   time.Now().Unix(),
+			},
+
+			expectedStatus: 200,
+			expectedResponse: api.TransactionResponse{
+				BlockHash:   PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				ExtraInfo:   PtrTo(""),
+				MerklePath:  PtrTo(""),
+				Status:      200,
+				Timestamp:   now,
+				Title:       "OK",
+				TxStatus:    "SEEN_ON_NETWORK",
+				Fee:         &api.FeeDetails{Size: 191, ActualFee: 2, RequiredFee: 1, Rules: []api.FeeRule{{Rule: validator.FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 191, ActualFee: 2, RequiredFee: 1}}},
+				Txid:        validBeefTxID,
+			},
+		},
+		{
+			name:        "valid BEEF 1 mined tx - success - application/json",
+			contentType: contentTypes[1],
+			txHexString: fmt.Sprintf("{\"rawTx\": \"%s\"}", validBeef1MinexTx),
+
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID:        validBeef1MinexTxID,
+				BlockHash:   "",
+				BlockHeight: 0,
+				Status:      "SEEN_ON_NETWORK",
+				Timestamp:   time.Now().Unix(),
+			},
+
+			expectedStatus: 200,
+			expectedResponse: api.TransactionResponse{
+				BlockHash:   PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				ExtraInfo:   PtrTo(""),
+				MerklePath:  PtrTo(""),
+				Status:      200,
+				Timestamp:   now,
+				Title:       "OK",
+				TxStatus:    "SEEN_ON_NETWORK",
+				Txid:        validBeef1MinexTxID,
+			},
+		},
+		{
+			name:        "valid BEEF - success - application/octet-stream",
+			contentType: contentTypes[2],
+			txHexString: string(validBeefBytes),
+
+			submitTxResponse: &metamorph.TransactionStatus{
+				TxID:        validBeefTxID,
+				BlockHash:   "",
+				BlockHeight: 0,
+				Status:      "SEEN_ON_NETWORK",
+				Timestamp:   time.Now().Unix(),
+			},
+
+			expectedStatus: 200,
+			expectedResponse: api.TransactionResponse{
+				BlockHash:   PtrTo(""),
+				BlockHeight: PtrTo(uint64(0)),
+				ExtraInfo:   PtrTo(""),
+				MerklePath:  PtrTo(""),
+				Status:      200,
+				Timestamp:   now,
+				Title:       "OK",
+				TxStatus:    "SEEN_ON_NETWORK",
+				Fee:         &api.FeeDetails{Size: 191, ActualFee: 2, RequiredFee: 1, Rules: []api.FeeRule{{Rule: validator.FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 191, ActualFee: 2, RequiredFee: 1}}},
+				Txid:        validBeefTxID,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			policy := *defaultPolicy
+			if tc.expectedFee > 0 {
+				policy.MinMiningTxFee = float64(tc.expectedFee)
+			}
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				HealthFunc: func(_ context.Context) error {
+					return nil
+				},
+
+				GetTransactionsFunc: func(_ context.Context, _ []string) ([]*metamorph.Transaction, error) {
+					if tc.getTx != nil {
+						tx, _ := sdkTx.NewTransactionFromBytes(tc.getTx)
+
+						mt := metamorph.Transaction{
+							TxID:        tx.TxID().String(),
+							Bytes:       tc.getTx,
+							BlockHeight: 100,
+						}
+						return []*metamorph.Transaction{&mt}, nil
+					}
+
+					return nil, nil
+				},
+
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, metamorph.ErrTransactionNotFound
+				},
+
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{tc.submitTxResponse}, tc.submitTxErr
+				},
+			}
+
+			blocktxClient := &btxMocks.ClientMock{}
+
+			handlerStats, err := NewStats()
+			urlRestrictions := []string{"skiptest"}
+			tracer := attribute.KeyValue{Key: "testnet", Value: attribute.StringValue("test")}
+			require.NoError(t, err)
+			dv := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return tc.validateTransactionErr
+				},
+			}
+
+			bv := &apiHandlerMocks.BeefValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Beef, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) (*sdkTx.Transaction, error) {
+					return nil, tc.validateBeefTransactionErr
+				},
+			}
+			sut, err := NewDefault(testLogger, txHandler, blocktxClient, &policy, dv, bv,
+				WithNow(func() time.Time { return now }),
+				WithStats(handlerStats),
+				WithCallbackURLRestrictions(urlRestrictions),
+				WithRebroadcastExpiration(23*time.Hour),
+				WithServerMaxTimeoutDefault(5*time.Second),
+				WithTracer(tracer),
+				WithStandardFormatSupported(true),
+			)
+			require.NoError(t, err)
+
+			defer sut.Shutdown()
+
+			inputTx := strings.NewReader(tc.txHexString)
+			rec, ctx := createEchoPostRequest(inputTx, tc.contentType, "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			b := rec.Body.Bytes()
+
+			switch v := tc.expectedResponse.(type) {
+			case api.TransactionResponse:
+				var txResponse api.TransactionResponse
+				err = json.Unmarshal(b, &txResponse)
+				require.NoError(t, err)
+
+				assert.Equal(t, tc.expectedResponse, txResponse)
+			case api.ErrorFields:
+				var actualError api.ErrorFields
+				err = json.Unmarshal(b, &actualError)
+				require.NoError(t, err)
+
+				expectedResp, ok := tc.expectedResponse.(api.ErrorFields)
+				require.True(t, ok)
+				assert.Equal(t, expectedResp.Txid, actualError.Txid)
+				assert.Equal(t, expectedResp.Status, actualError.Status)
+				assert.Equal(t, expectedResp.Detail, actualError.Detail)
+				assert.Equal(t, expectedResp.Title, actualError.Title)
+				assert.Equal(t, expectedResp.Instance, actualError.Instance)
+				assert.Equal(t, expectedResp.Type, actualError.Type)
+				if tc.expectedError != nil {
+					assert.ErrorContains(t, errors.New(*actualError.ExtraInfo), tc.expectedError.Error())
+				}
+			default:
+				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_Tenant(t *testing.T) {
+	tt := []struct {
+		name          string
+		authorization string
+
+		expectedTenant string
+	}{
+		{
+			name: "no API key",
+		},
+		{
+			name:          "API key without tenant",
+			authorization: "Bearer other-key",
+		},
+		{
+			name:          "API key of tenant",
+			authorization: "Bearer exchange-a-key",
+
+			expectedTenant: "exchange-a",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithTenants(map[string]string{"exchange-a-key": "exchange-a"}),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+			if tc.authorization != "" {
+				ctx.Request().Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(api.StatusOK), rec.Code)
+			require.Len(t, txHandler.SubmitTransactionsCalls(), 1)
+			assert.Equal(t, tc.expectedTenant, txHandler.SubmitTransactionsCalls()[0].Options.Tenant)
+		})
+	}
+}
+
+func TestPOSTTransaction_SubmissionRecorder(t *testing.T) {
+	tt := []struct {
+		name                   string
+		submitTxResponse       *metamorph.TransactionStatus
+		validateTransactionErr error
+
+		expectedSubmissions     int
+		expectedRejectionStatus []int
+	}{
+		{
+			name:             "success",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "SEEN_ON_NETWORK"},
+
+			expectedSubmissions: 1,
+		},
+		{
+			name:                   "fees too low",
+			validateTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
+
+			expectedRejectionStatus: []int{int(api.ErrStatusFees)},
+		},
+		{
+			name:             "rejected",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "REJECTED", ExtraInfo: "double spend"},
+
+			expectedSubmissions:     1,
+			expectedRejectionStatus: []int{int(api.StatusOK)},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionsFunc: func(_ context.Context, _ []string) ([]*metamorph.Transaction, error) {
+					return nil, nil
+				},
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return make([]*metamorph.TransactionStatus, 0), nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{tc.submitTxResponse}, nil
+				},
+			}
+
+			dv := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return tc.validateTransactionErr
+				},
+			}
+
+			var submissions int
+			recorder := &apiHandlerMocks.SubmissionRecorderMock{
+				RecordSubmissionsFunc: func(count int) {
+					submissions += count
+				},
+				RecordRejectionFunc: func(_ string, _ int, _ string) {},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, dv, &apiHandlerMocks.BeefValidatorMock{},
+				WithSubmissionRecorder(recorder),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, rec.Code)
+			assert.Equal(t, tc.expectedSubmissions, submissions)
+
+			rejections := recorder.RecordRejectionCalls()
+			require.Len(t, rejections, len(tc.expectedRejectionStatus))
+			for i, status := range tc.expectedRejectionStatus {
+				assert.Equal(t, validTxID, rejections[i].TxID)
+				assert.Equal(t, status, rejections[i].Status)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_RejectionDigest(t *testing.T) {
+	tt := []struct {
+		name                   string
+		authorization          string
+		submitTxResponse       *metamorph.TransactionStatus
+		validateTransactionErr error
+
+		expectedReports []digest.Report
+	}{
+		{
+			name:             "success",
+			authorization:    "Bearer exchange-a-key",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "SEEN_ON_NETWORK"},
+		},
+		{
+			name:                   "fees too low",
+			authorization:          "Bearer exchange-a-key",
+			validateTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
+
+			expectedReports: []digest.Report{{Tenant: "exchange-a", Rejected: 1}},
+		},
+		{
+			name:             "rejected",
+			authorization:    "Bearer exchange-a-key",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "REJECTED", ExtraInfo: "double spend"},
+
+			expectedReports: []digest.Report{{Tenant: "exchange-a", Rejected: 1}},
+		},
+		{
+			name:             "rejected - no tenant",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "REJECTED", ExtraInfo: "double spend"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionsFunc: func(_ context.Context, _ []string) ([]*metamorph.Transaction, error) {
+					return nil, nil
+				},
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return make([]*metamorph.TransactionStatus, 0), nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{tc.submitTxResponse}, nil
+				},
+			}
+
+			dv := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return tc.validateTransactionErr
+				},
+			}
+
+			rejectionDigest := digest.New(testLogger, digest.WithRecipient("exchange-a"))
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, dv, &apiHandlerMocks.BeefValidatorMock{},
+				WithTenants(map[string]string{"exchange-a-key": "exchange-a"}),
+				WithRejectionDigest(rejectionDigest),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+			if tc.authorization != "" {
+				ctx.Request().Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, rec.Code)
+
+			reports := rejectionDigest.Reports()
+			require.Len(t, reports, len(tc.expectedReports))
+			for i, expected := range tc.expectedReports {
+				assert.Equal(t, expected.Tenant, reports[i].Tenant)
+				assert.Equal(t, expected.Rejected, reports[i].Rejected)
+				require.Len(t, reports[i].Transactions, 1)
+				assert.Equal(t, validTxID, reports[i].Transactions[0].TxID)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_Federation(t *testing.T) {
+	tt := []struct {
+		name                   string
+		submitTxResponse       *metamorph.TransactionStatus
+		validateTransactionErr error
+		result                 *federation.Result
+
+		expectedForwarded  []string
+		expectedTxStatus   api.TransactionResponseTxStatus
+		expectedExtraInfo  string
+		expectedFederation *api.FederationResult
+	}{
+		{
+			name:             "accepted",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "SEEN_ON_NETWORK"},
+
+			expectedForwarded: []string{validTxID},
+			expectedTxStatus:  api.TransactionResponseTxStatusSEENONNETWORK,
+		},
+		{
+			name:             "aggregation policy satisfied",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "STORED"},
+			result: &federation.Result{
+				Policy:       federation.PolicyFirstSuccess,
+				Required:     1,
+				Satisfied:    true,
+				TxStatus:     "SEEN_ON_NETWORK",
+				Acknowledged: 1,
+				Upstreams:    []federation.Acknowledgement{{Upstream: "miner-a", Acknowledged: true, Status: 200, TxStatus: "SEEN_ON_NETWORK"}},
+			},
+
+			expectedForwarded: []string{validTxID},
+			expectedTxStatus:  api.TransactionResponseTxStatusSEENONNETWORK,
+			expectedFederation: &api.FederationResult{
+				Policy:       "first-success",
+				Required:     1,
+				Satisfied:    true,
+				Acknowledged: 1,
+				Upstreams:    []api.UpstreamAcknowledgement{{Name: "miner-a", Acknowledged: true, Status: 200, TxStatus: PtrTo("SEEN_ON_NETWORK")}},
+			},
+		},
+		{
+			name:             "aggregation policy not satisfied",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "STORED"},
+			result: &federation.Result{
+				Policy:    federation.PolicyAll,
+				Required:  2,
+				Upstreams: []federation.Acknowledgement{{Upstream: "miner-a", Status: 200, TxStatus: "REJECTED"}},
+			},
+
+			expectedForwarded: []string{validTxID},
+			expectedTxStatus:  api.TransactionResponseTxStatusUNKNOWN,
+			expectedExtraInfo: "federation policy all not satisfied: 0 of 2 required upstreams acknowledged the transaction",
+			expectedFederation: &api.FederationResult{
+				Policy:    "all",
+				Required:  2,
+				Upstreams: []api.UpstreamAcknowledgement{{Name: "miner-a", Status: 200, TxStatus: PtrTo("REJECTED")}},
+			},
+		},
+		{
+			name:                   "fees too low",
+			validateTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
+		},
+		{
+			name:             "rejected",
+			submitTxResponse: &metamorph.TransactionStatus{TxID: validTxID, Status: "REJECTED", ExtraInfo: "double spend"},
+
+			expectedForwarded: []string{},
+			expectedTxStatus:  api.TransactionResponseTxStatusREJECTED,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionsFunc: func(_ context.Context, _ []string) ([]*metamorph.Transaction, error) {
+					return nil, nil
+				},
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return make([]*metamorph.TransactionStatus, 0), nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{tc.submitTxResponse}, nil
+				},
+			}
+
+			dv := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return tc.validateTransactionErr
+				},
+			}
+
+			var forwarded []string
+			fed := &apiHandlerMocks.FederationMock{
+				ForwardFunc: func(_ context.Context, txs []*sdkTx.Transaction) (map[string]*federation.Result, error) {
+					forwarded = make([]string, 0, len(txs))
+					results := make(map[string]*federation.Result)
+					for _, tx := range txs {
+						forwarded = append(forwarded, tx.TxID().String())
+						if tc.result != nil {
+							results[tx.TxID().String()] = tc.result
+						}
+					}
+					return results, nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, dv, &apiHandlerMocks.BeefValidatorMock{},
+				WithFederation(fed),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.NotZero(t, rec.Code)
+			assert.Equal(t, tc.expectedForwarded, forwarded)
+
+			if tc.expectedTxStatus == "" {
+				return
+			}
+
+			var response api.TransactionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, tc.expectedTxStatus, response.TxStatus)
+			assert.Equal(t, tc.expectedFederation, response.Federation)
+			if tc.expectedExtraInfo != "" {
+				require.NotNil(t, response.ExtraInfo)
+				assert.Equal(t, tc.expectedExtraInfo, *response.ExtraInfo)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_DeadlineExceeded(t *testing.T) {
+	tt := []struct {
+		name              string
+		validationTimeout bool
+		knownStatuses     []*metamorph.TransactionStatus
+
+		expectedTxStatus  api.TransactionResponseTxStatus
+		expectedExtraInfo string
+	}{
+		{
+			name:          "tx stored before deadline",
+			knownStatuses: []*metamorph.TransactionStatus{{TxID: validTxID, Status: "STORED"}},
+
+			expectedTxStatus: api.TransactionResponseTxStatusSTORED,
+		},
+		{
+			name: "tx not submitted before deadline",
+
+			expectedTxStatus:  api.TransactionResponseTxStatusUNKNOWN,
+			expectedExtraInfo: ErrDeadlineExceeded.Error(),
+		},
+		{
+			name:              "tx not validated before deadline",
+			validationTimeout: true,
+
+			expectedTxStatus:  api.TransactionResponseTxStatusUNKNOWN,
+			expectedExtraInfo: ErrDeadlineExceeded.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var submitted bool
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					if !submitted {
+						return make([]*metamorph.TransactionStatus, 0), nil
+					}
+					return tc.knownStatuses, nil
+				},
+				SubmitTransactionsFunc: func(ctx context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					submitted = true
+					<-ctx.Done()
+					return nil, ctx.Err()
+				},
+			}
+
+			dv := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(ctx context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					if tc.validationTimeout {
+						<-ctx.Done()
+						return ctx.Err()
+					}
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, dv, &apiHandlerMocks.BeefValidatorMock{},
+				WithServerMaxTimeoutDefault(10*time.Millisecond),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var actual api.TransactionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			assert.Equal(t, validTxID, actual.Txid)
+			assert.Equal(t, tc.expectedTxStatus, actual.TxStatus)
+			require.NotNil(t, actual.ExtraInfo)
+			assert.Equal(t, tc.expectedExtraInfo, *actual.ExtraInfo)
+			assert.Equal(t, !tc.validationTimeout, submitted)
+		})
+	}
+}
+
+func TestPOSTTransaction_AlreadyKnown(t *testing.T) {
+	tt := []struct {
+		name         string
+		cacheTTL     time.Duration
+		callbackURLs []string
+
+		expectedStatusLookups int
+		expectedSubmissions   int
+		expectedAlreadyKnown  []bool
+	}{
+		{
+			name: "no cache",
+
+			expectedStatusLookups: 2,
+			expectedAlreadyKnown:  []bool{true, true},
+		},
+		{
+			name:     "resubmission answered from cache",
+			cacheTTL: time.Minute,
+
+			expectedStatusLookups: 1,
+			expectedAlreadyKnown:  []bool{true, true},
+		},
+		{
+			name:         "resubmission with new callback",
+			cacheTTL:     time.Minute,
+			callbackURLs: []string{"", "https://other.example.com"},
+
+			expectedStatusLookups: 2,
+			expectedSubmissions:   1,
+			expectedAlreadyKnown:  []bool{true, false},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK", LastSubmitted: *timestamppb.New(time.Now())}}, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+				WithKnownTxCache(tc.cacheTTL),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			for i, expectedAlreadyKnown := range tc.expectedAlreadyKnown {
+				rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+				params := api.POSTTransactionParams{}
+				if len(tc.callbackURLs) > i && tc.callbackURLs[i] != "" {
+					params.XCallbackUrl = &tc.callbackURLs[i]
+				}
+
+				// when
+				err = sut.POSTTransaction(ctx, params)
+
+				// then
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, rec.Code)
+
+				var actual api.TransactionResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+				assert.Equal(t, validTxID, actual.Txid)
+				assert.Equal(t, expectedAlreadyKnown, actual.AlreadyKnown != nil && *actual.AlreadyKnown)
+			}
+
+			assert.Len(t, txHandler.GetTransactionStatusesCalls(), tc.expectedStatusLookups)
+			assert.Len(t, txHandler.SubmitTransactionsCalls(), tc.expectedSubmissions)
+		})
+	}
+}
+
+func TestPOSTTransaction_ScriptPolicy(t *testing.T) {
+	nullDataOnly, err := validator.ParseScriptTemplates([]string{"nulldata"})
+	require.NoError(t, err)
+	p2pkhOnly, err := validator.ParseScriptTemplates([]string{"p2pkh"})
+	require.NoError(t, err)
+
+	tt := []struct {
+		name             string
+		authorization    string
+		skipTxValidation bool
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name: "no API key",
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:          "unrestricted API key",
+			authorization: "Bearer other-key",
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:          "allowed template",
+			authorization: "Bearer p2pkh-key",
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:          "template not allowed",
+			authorization: "Bearer nulldata-key",
+
+			expectedStatus: api.ErrStatusScriptTemplateNotAllowed,
+		},
+		{
+			name:             "template not allowed - tx validation skipped",
+			authorization:    "Bearer nulldata-key",
+			skipTxValidation: true,
+
+			expectedStatus: api.ErrStatusScriptTemplateNotAllowed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithScriptPolicies(map[string][]validator.ScriptTemplate{
+					"nulldata-key": nullDataOnly,
+					"p2pkh-key":    p2pkhOnly,
+				}),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+			if tc.authorization != "" {
+				ctx.Request().Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{XSkipTxValidation: PtrTo(tc.skipTxValidation)})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			if tc.expectedStatus != api.StatusOK {
+				var actual api.ErrorFields
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+				assert.Equal(t, int(api.ErrStatusScriptTemplateNotAllowed), actual.Status)
+				assert.Empty(t, txHandler.SubmitTransactionsCalls())
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_OpcodeLimits(t *testing.T) {
+	noMul, err := validator.NewOpcodeLimit("OP_MUL", 0)
+	require.NoError(t, err)
+	noCheckSig, err := validator.NewOpcodeLimit("OP_CHECKSIG", 0)
+	require.NoError(t, err)
+
+	tt := []struct {
+		name             string
+		limits           []validator.OpcodeLimit
+		skipTxValidation bool
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name: "no limits",
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:   "within limits",
+			limits: []validator.OpcodeLimit{noMul},
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:   "limit exceeded",
+			limits: []validator.OpcodeLimit{noMul, noCheckSig},
+
+			expectedStatus: api.ErrStatusOpcodeLimitExceeded,
+		},
+		{
+			name:             "limit exceeded - tx validation skipped",
+			limits:           []validator.OpcodeLimit{noCheckSig},
+			skipTxValidation: true,
+
+			expectedStatus: api.ErrStatusOpcodeLimitExceeded,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithOpcodeLimits(tc.limits),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{XSkipTxValidation: PtrTo(tc.skipTxValidation)})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+
+			if tc.expectedStatus != api.StatusOK {
+				var actual api.ErrorFields
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+				assert.Equal(t, int(api.ErrStatusOpcodeLimitExceeded), actual.Status)
+				assert.Empty(t, txHandler.SubmitTransactionsCalls())
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_TemplateRateClamping(t *testing.T) {
+	tt := []struct {
+		name     string
+		clamping bool
+
+		expectedStatuses []api.StatusCode
+	}{
+		{
+			name: "anomaly - not clamped",
+
+			expectedStatuses: []api.StatusCode{api.StatusOK, api.StatusOK},
+		},
+		{
+			name:     "anomaly - clamped",
+			clamping: true,
+
+			expectedStatuses: []api.StatusCode{api.StatusOK, api.ErrStatusTemplateRateClamped},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+			detector, err := templatestats.New(testLogger,
+				templatestats.WithWindow(time.Second),
+				templatestats.WithAnomalyThreshold(10, 1),
+				templatestats.WithWarmupWindows(0),
+				templatestats.WithRateClamping(tc.clamping),
+				templatestats.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithTemplateStats(detector),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			for _, expectedStatus := range tc.expectedStatuses {
+				rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+				// when
+				err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, int(expectedStatus), rec.Code)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_AbuseScoring(t *testing.T) {
+	tt := []struct {
+		name          string
+		throttle      float64
+		reject        float64
+		authorization []string
+
+		expectedStatuses []api.StatusCode
+		expectedKeys     int
+	}{
+		{
+			name:          "below thresholds",
+			throttle:      2,
+			reject:        2,
+			authorization: []string{"Bearer key-a", "Bearer key-a"},
+
+			expectedStatuses: []api.StatusCode{api.StatusOK, api.StatusOK},
+			expectedKeys:     1,
+		},
+		{
+			name:          "throttled",
+			throttle:      0.4,
+			reject:        2,
+			authorization: []string{"Bearer key-a", "Bearer key-a"},
+
+			expectedStatuses: []api.StatusCode{api.StatusOK, api.ErrStatusAbuseThrottled},
+			expectedKeys:     1,
+		},
+		{
+			name:          "rejected",
+			throttle:      2,
+			reject:        0.9,
+			authorization: []string{"Bearer key-a", "Bearer key-a"},
+
+			expectedStatuses: []api.StatusCode{api.StatusOK, api.ErrStatusAbuseRejected},
+			expectedKeys:     1,
+		},
+		{
+			name:          "scored by key",
+			throttle:      2,
+			reject:        0.9,
+			authorization: []string{"Bearer key-a", "Bearer key-b", ""},
+
+			expectedStatuses: []api.StatusCode{api.StatusOK, api.StatusOK, api.StatusOK},
+			expectedKeys:     3,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+			scorer, err := abuse.New(testLogger,
+				abuse.WithWindow(time.Second),
+				abuse.WithBurstRate(2),
+				abuse.WithWeights(abuse.Weights{BurstRate: 1}),
+				abuse.WithThresholds(tc.throttle, tc.reject, 1),
+				abuse.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithAbuseScoring(scorer),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			for i, expectedStatus := range tc.expectedStatuses {
+				rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+				ctx.Request().Header.Set(echo.HeaderAuthorization, tc.authorization[i])
+
+				// when
+				err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, int(expectedStatus), rec.Code)
+			}
+
+			scores := sut.AbuseScores()
+			require.Len(t, scores, tc.expectedKeys)
+			for _, score := range scores {
+				assert.NotContains(t, score.Key, "key-a")
+				assert.Regexp(t, "^(key:[0-9a-f]{16}|ip:.+)$", score.Key)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_Compliance(t *testing.T) {
+	tt := []struct {
+		name             string
+		action           compliance.Action
+		scanErr          error
+		failClosed       bool
+		skipTxValidation bool
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name:   "allowed",
+			action: compliance.ActionAllow,
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:   "flagged",
+			action: compliance.ActionFlag,
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:   "blocked",
+			action: compliance.ActionBlock,
+
+			expectedStatus: api.ErrStatusComplianceBlocked,
+		},
+		{
+			name:             "blocked - validation skipped",
+			action:           compliance.ActionBlock,
+			skipTxValidation: true,
+
+			expectedStatus: api.ErrStatusComplianceBlocked,
+		},
+		{
+			name:    "scan failed - fail open",
+			scanErr: errors.New("unavailable"),
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:       "scan failed - fail closed",
+			scanErr:    errors.New("unavailable"),
+			failClosed: true,
+
+			expectedStatus: api.ErrStatusComplianceBlocked,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+			scanner := &complianceMocks.ScannerMock{
+				ScanFunc: func(_ context.Context, payload *compliance.Payload) (compliance.Decision, error) {
+					assert.Equal(t, validTxID, payload.TxID)
+					return compliance.Decision{Action: tc.action, Reason: "prohibited content"}, tc.scanErr
+				},
+			}
+
+			var opts []func(*compliance.Checker)
+			if tc.failClosed {
+				opts = append(opts, compliance.WithFailClosed())
+			}
+			checker, err := compliance.New(testLogger, scanner, opts...)
+			require.NoError(t, err)
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithCompliance(checker),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{XSkipTxValidation: &tc.skipTxValidation})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			assert.Len(t, scanner.ScanCalls(), 1)
+		})
+	}
+}
+
+func TestPOSTTransaction_MempoolAcceptance(t *testing.T) {
+	tt := []struct {
+		name             string
+		reason           *node_reject.Reason
+		nodeErr          error
+		skipTxValidation bool
+
+		expectedStatus    api.StatusCode
+		expectedNodeCalls int
+	}{
+		{
+			name: "accepted by node",
+
+			expectedStatus:    api.StatusOK,
+			expectedNodeCalls: 1,
+		},
+		{
+			name:   "rejected by node",
+			reason: node_reject.FromRPCError(errors.New("64: dust")),
+
+			expectedStatus:    api.ErrStatusOutputs,
+			expectedNodeCalls: 1,
+		},
+		{
+			name:   "inputs unknown to node",
+			reason: node_reject.FromRPCError(errors.New("missing inputs")),
+
+			expectedStatus:    api.StatusOK,
+			expectedNodeCalls: 1,
+		},
+		{
+			name:    "node unavailable - fail open",
+			nodeErr: errors.New("connection refused"),
+
+			expectedStatus:    api.StatusOK,
+			expectedNodeCalls: 1,
+		},
+		{
+			name:             "validation skipped",
+			reason:           node_reject.FromRPCError(errors.New("64: dust")),
+			skipTxValidation: true,
+
+			expectedStatus:    api.StatusOK,
+			expectedNodeCalls: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+			node := &apiHandlerMocks.MempoolAcceptanceNodeMock{
+				TestMempoolAcceptFunc: func(_ context.Context, txs []*sdkTx.Transaction) (*sdkTx.Transaction, *node_reject.Reason, error) {
+					require.Len(t, txs, 1)
+					assert.Equal(t, validTxID, txs[0].TxID().String())
+					if tc.reason != nil {
+						return txs[0], tc.reason, nil
+					}
+					return nil, nil, tc.nodeErr
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithMempoolAcceptance(node),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{XSkipTxValidation: &tc.skipTxValidation})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			assert.Len(t, node.TestMempoolAcceptCalls(), tc.expectedNodeCalls)
+			if tc.expectedStatus != api.StatusOK {
+				assert.Contains(t, rec.Body.String(), "rejected by node")
+				assert.Empty(t, txHandler.SubmitTransactionsCalls())
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_DegradedMode(t *testing.T) {
+	tt := []struct {
+		name          string
+		mode          reverification.Mode
+		validationErr error
+		queueFull     bool
+
+		expectedStatus    api.StatusCode
+		expectedTxStatus  api.TransactionResponseTxStatus
+		expectedSubmitted int
+	}{
+		{
+			name:          "reject",
+			mode:          reverification.ModeReject,
+			validationErr: beefValidator.ErrNoChainTrackersAvailable,
+
+			expectedStatus: api.ErrStatusBeefValidationMerkleRoots,
+		},
+		{
+			name:          "accept",
+			mode:          reverification.ModeAccept,
+			validationErr: beefValidator.ErrNoChainTrackersAvailable,
+
+			expectedStatus:    api.StatusOK,
+			expectedTxStatus:  api.TransactionResponseTxStatusSEENONNETWORK,
+			expectedSubmitted: 1,
+		},
+		{
+			name:          "defer",
+			mode:          reverification.ModeDefer,
+			validationErr: beefValidator.ErrNoQuorum,
+
+			expectedStatus:   api.StatusOK,
+			expectedTxStatus: api.TransactionResponseTxStatusQUEUED,
+		},
+		{
+			name:          "defer - maximum number of transactions reached",
+			mode:          reverification.ModeDefer,
+			validationErr: beefValidator.ErrNoChainTrackersAvailable,
+			queueFull:     true,
+
+			expectedStatus: api.ErrStatusBeefValidationMerkleRoots,
+		},
+		{
+			name:          "accept - invalid Merkle roots",
+			mode:          reverification.ModeAccept,
+			validationErr: beefValidator.ErrBEEFVerificationFailed,
+
+			expectedStatus: api.ErrStatusBeefValidationMerkleRoots,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validBeefTxID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			beefValidatorMock := &apiHandlerMocks.BeefValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Beef, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) (*sdkTx.Transaction, error) {
+					return nil, validator.NewError(tc.validationErr, api.ErrStatusBeefValidationMerkleRoots)
+				},
+			}
+			chainTracker := &beefMocks.ChainTrackerMock{}
+
+			var unverified []string
+			client := &reverificationMocks.ClientMock{
+				AddReverificationFunc: func(_ context.Context, r *metamorph.Reverification, maxTransactions int) error {
+					if len(unverified)+len(r.TxIDs) > maxTransactions {
+						return metamorph.ErrReverificationsFull
+					}
+					unverified = append(unverified, r.TxIDs...)
+					return nil
+				},
+				GetUnverifiedTransactionsFunc: func(_ context.Context, _ []string) ([]string, error) {
+					return unverified, nil
+				},
+			}
+
+			var reverifierOpts []func(*reverification.Reverifier)
+			if tc.queueFull {
+				reverifierOpts = append(reverifierOpts, reverification.WithMaxTransactions(0))
+			}
+			reverifier := reverification.New(testLogger, tc.mode, chainTracker, client, reverifierOpts...)
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, &apiHandlerMocks.DefaultValidatorMock{}, beefValidatorMock,
+				WithMerkleRootsReverifier(reverifier),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validBeef), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, txHandler.SubmitTransactionsCalls(), tc.expectedSubmitted)
+			if tc.expectedStatus != api.StatusOK {
+				require.Empty(t, unverified)
+				return
+			}
+
+			var response api.TransactionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.Equal(t, validBeefTxID, response.Txid)
+			require.Equal(t, tc.expectedTxStatus, response.TxStatus)
+			require.Equal(t, []string{validBeefTxID}, unverified)
+			require.NotNil(t, response.Warnings)
+			require.Contains(t, *response.Warnings, api.Warning{Code: api.MERKLEROOTSUNVERIFIED, Message: "the Merkle roots of the BEEF could not be verified, they are verified again later"})
+		})
+	}
+}
+
+func TestGETTransactionStatus_Deferred(t *testing.T) {
+	tt := []struct {
+		name       string
+		mode       reverification.Mode
+		unverified []string
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name:       "deferred",
+			mode:       reverification.ModeDefer,
+			unverified: []string{validBeefTxID},
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name: "not deferred",
+			mode: reverification.ModeDefer,
+
+			expectedStatus: api.ErrStatusNotFound,
+		},
+		{
+			name:       "accepted",
+			mode:       reverification.ModeAccept,
+			unverified: []string{validBeefTxID},
+
+			expectedStatus: api.ErrStatusNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusFunc: func(_ context.Context, _ string) (*metamorph.TransactionStatus, error) {
+					return nil, metamorph.ErrTransactionNotFound
+				},
+			}
+			client := &reverificationMocks.ClientMock{
+				GetUnverifiedTransactionsFunc: func(_ context.Context, txIDs []string) ([]string, error) {
+					require.Equal(t, []string{validBeefTxID}, txIDs)
+					return tc.unverified, nil
+				},
+			}
+			reverifier := reverification.New(testLogger, tc.mode, &beefMocks.ChainTrackerMock{}, client)
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+				WithMerkleRootsReverifier(reverifier),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoGetRequest("/v1/tx/" + validBeefTxID)
+
+			// when
+			err = sut.GETTransactionStatus(ctx, validBeefTxID)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, int(tc.expectedStatus), rec.Code)
+			if tc.expectedStatus != api.StatusOK {
+				return
+			}
+
+			var response api.TransactionStatus
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			require.Equal(t, api.QUEUED, response.TxStatus)
+			require.Equal(t, "submission deferred until the Merkle roots of the BEEF are verified", *response.ExtraInfo)
+		})
+	}
+}
+
+func TestPOSTTransaction_Receipts(t *testing.T) {
+	tt := []struct {
+		name     string
+		txStatus string
+
+		expectedReceipt bool
+	}{
+		{
+			name:     "accepted",
+			txStatus: "SEEN_ON_NETWORK",
+
+			expectedReceipt: true,
+		},
+		{
+			name:     "rejected",
+			txStatus: "REJECTED",
+
+			expectedReceipt: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: tc.txStatus}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			signer, err := receipt.NewSigner(testReceiptKey)
+			require.NoError(t, err)
+			store := receipt.NewStore(cache.NewMemoryStore(), time.Hour)
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithReceipts(signer, store),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+
+			var response api.TransactionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+
+			stored, getErr := store.Get(validTxID)
+			if !tc.expectedReceipt {
+				assert.Nil(t, response.Receipt)
+				require.ErrorIs(t, getErr, receipt.ErrNotFound)
+				return
+			}
+
+			require.NotNil(t, response.Receipt)
+			assert.Equal(t, validTxID, response.Receipt.Txid)
+			assert.Equal(t, tc.txStatus, response.Receipt.TxStatus)
+			assert.Equal(t, signer.PublicKey(), response.Receipt.PublicKey)
+
+			expectedPolicyID, err := receipt.PolicyID(defaultPolicy)
+			require.NoError(t, err)
+			assert.Equal(t, expectedPolicyID, response.Receipt.PolicyId)
+
+			require.NoError(t, getErr)
+			assert.Equal(t, response.Receipt.Signature, stored.Signature)
+			require.NoError(t, stored.Verify())
+		})
+	}
+}
+
+func TestPOSTTransaction_NonFinal(t *testing.T) {
+	tipTime := time.Unix(1_700_000_000, 0)
+
+	tt := []struct {
+		name             string
+		lockTime         uint32
+		sequence         uint32
+		holdNonFinal     bool
+		skipTxValidation bool
+		latestBlocksErr  error
+
+		expectedStatus       api.StatusCode
+		expectedHeld         bool
+		expectedBlocksCalls  int
+		expectedSubmitsCalls int
+	}{
+		{
+			name:     "final - no lock time",
+			lockTime: 0,
+			sequence: 0,
+
+			expectedStatus:       api.StatusOK,
+			expectedSubmitsCalls: 1,
+		},
+		{
+			name:     "final - final sequence",
+			lockTime: 900_000,
+			sequence: 0xffffffff,
+
+			expectedStatus:       api.StatusOK,
+			expectedSubmitsCalls: 1,
+		},
+		{
+			name:     "final - height reached",
+			lockTime: 800_000,
+			sequence: 0,
+
+			expectedStatus:       api.StatusOK,
+			expectedBlocksCalls:  1,
+			expectedSubmitsCalls: 1,
+		},
+		{
+			name:     "non-final - height",
+			lockTime: 800_001,
+			sequence: 0,
+
+			expectedStatus:      api.ErrStatusNonFinal,
+			expectedBlocksCalls: 1,
+		},
+		{
+			name:     "non-final - median time past",
+			lockTime: 1_700_000_000,
+			sequence: 0,
+
+			expectedStatus:      api.ErrStatusNonFinal,
+			expectedBlocksCalls: 1,
 		},
 		{
-			name:             "valid tx - submit error",
-			contentType:      contentTypes[0],
-			txHexString:      validExtendedTx,
-			getTx:            validTxParentBytes,
-			submitTxErr:      errors.New("failed to submit tx"),
-			submitTxResponse: nil,
+			name:             "non-final - validation skipped",
+			lockTime:         800_001,
+			sequence:         0,
+			skipTxValidation: true,
 
-			expectedStatus:   409,
-			expectedResponse: errFieldSubmitTx,
+			expectedStatus:      api.ErrStatusNonFinal,
+			expectedBlocksCalls: 1,
 		},
 		{
-			name:        "valid tx - success",
-			contentType: contentTypes[0],
-			txHexString: validExtendedTx,
-			getTx:       inputTxLowFeesBytes,
+			name:         "non-final - held",
+			lockTime:     800_001,
+			sequence:     0,
+			holdNonFinal: true,
+
+			expectedStatus:       api.StatusOK,
+			expectedHeld:         true,
+			expectedBlocksCalls:  1,
+			expectedSubmitsCalls: 1,
+		},
+		{
+			name:            "latest blocks failed",
+			lockTime:        800_001,
+			sequence:        0,
+			latestBlocksErr: errors.New("blocktx unavailable"),
+
+			expectedStatus:       api.StatusOK,
+			expectedBlocksCalls:  1,
+			expectedSubmitsCalls: 1,
+		},
+	}
 
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID:        validTxID,
-				BlockHash:   "",
-				BlockHeight: 0,
-				Status:      "SEEN_ON_NETWORK",
-				Timestamp:   time.Now().Unix(),
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			tx, err := sdkTx.NewTransactionFromHex(validExtendedTx)
+			require.NoError(t, err)
+			tx.LockTime = tc.lockTime
+			for _, input := range tx.Inputs {
+				input.SequenceNumber = tc.sequence
+			}
+			txHex, err := tx.EFHex()
+			require.NoError(t, err)
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, txs sdkTx.Transactions, options *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					txID := txs[0].TxID().String()
+					if tc.expectedHeld {
+						assert.True(t, options.NonFinalTxIDs[txID])
+						return []*metamorph.TransactionStatus{{TxID: txID, Status: "WAITING_FOR_FINALITY"}}, nil
+					}
+
+					assert.Empty(t, options.NonFinalTxIDs)
+					return []*metamorph.TransactionStatus{{TxID: txID, Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+			btxClient := &btxMocks.ClientMock{
+				LatestBlocksFunc: func(_ context.Context, _ uint64) (*blocktx_api.LatestBlocksResponse, error) {
+					return &blocktx_api.LatestBlocksResponse{Blocks: []*blocktx_api.Block{
+						{Height: 800_000, Timestamp: timestamppb.New(tipTime)},
+					}}, tc.latestBlocksErr
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, btxClient, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithHoldNonFinal(tc.holdNonFinal),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(txHex), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{XSkipTxValidation: &tc.skipTxValidation})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			assert.Len(t, btxClient.LatestBlocksCalls(), tc.expectedBlocksCalls)
+			assert.Len(t, txHandler.SubmitTransactionsCalls(), tc.expectedSubmitsCalls)
+		})
+	}
+}
+
+func TestPOSTTransaction_Admission(t *testing.T) {
+	bodySize := int64(len(validExtendedTx))
+
+	tt := []struct {
+		name     string
+		budget   int64
+		inFlight int64
+		chunked  bool
+
+		expectedStatus       api.StatusCode
+		expectedRetryAfter   string
+		expectedSubmitsCalls int
+	}{
+		{
+			name:   "within budget",
+			budget: 2 * bodySize,
+
+			expectedStatus:       api.StatusOK,
+			expectedSubmitsCalls: 1,
+		},
+		{
+			name:    "within budget - chunked body",
+			budget:  2 * bodySize,
+			chunked: true,
+
+			expectedStatus:       api.StatusOK,
+			expectedSubmitsCalls: 1,
+		},
+		{
+			name:     "budget exhausted",
+			budget:   2 * bodySize,
+			inFlight: bodySize + 1,
+
+			expectedStatus:     api.ErrStatusTooManyRequests,
+			expectedRetryAfter: "1",
+		},
+		{
+			name:   "too large",
+			budget: bodySize - 1,
+
+			expectedStatus: api.ErrStatusTooManyRequests,
+		},
+		{
+			name:    "too large - chunked body",
+			budget:  bodySize - 1,
+			chunked: true,
+
+			expectedStatus: api.ErrStatusTooManyRequests,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			controller, err := admission.New(testLogger, tc.budget)
+			require.NoError(t, err)
+
+			if tc.inFlight > 0 {
+				release, err := controller.Admit(context.Background(), tc.inFlight)
+				require.NoError(t, err)
+				defer release()
+			}
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, txs sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					assert.Equal(t, bodySize, controller.InFlight())
+					return []*metamorph.TransactionStatus{{TxID: txs[0].TxID().String(), Status: "SEEN_ON_NETWORK"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithAdmissionControl(controller),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+			if tc.chunked {
+				ctx.Request().ContentLength = -1
+			}
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			assert.Equal(t, tc.expectedRetryAfter, rec.Header().Get("Retry-After"))
+			assert.Len(t, txHandler.SubmitTransactionsCalls(), tc.expectedSubmitsCalls)
+			assert.Equal(t, tc.inFlight, controller.InFlight())
+		})
+	}
+}
+
+func TestPOSTTransaction_TxClaims(t *testing.T) {
+	tt := []struct {
+		name             string
+		otherClaimTTL    time.Duration
+		processedByOther bool
+
+		expectedSubmissions int
+		expectedTxStatus    api.TransactionResponseTxStatus
+	}{
+		{
+			name: "not claimed",
+
+			expectedSubmissions: 1,
+			expectedTxStatus:    "SEEN_ON_NETWORK",
+		},
+		{
+			name:             "claimed by other instance - processed",
+			otherClaimTTL:    100 * time.Millisecond,
+			processedByOther: true,
+
+			expectedSubmissions: 0,
+			expectedTxStatus:    "STORED",
+		},
+		{
+			name:          "claimed by other instance - not processed",
+			otherClaimTTL: 100 * time.Millisecond,
+
+			expectedSubmissions: 1,
+			expectedTxStatus:    "SEEN_ON_NETWORK",
+		},
+		{
+			name:          "claimed by other instance - not released in time",
+			otherClaimTTL: time.Minute,
+
+			expectedSubmissions: 1,
+			expectedTxStatus:    "SEEN_ON_NETWORK",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			store := cache.NewMemoryStore()
+			if tc.otherClaimTTL > 0 {
+				claimed, err := store.SetNX(txClaimKeyPrefix+validTxID, []byte("other"), tc.otherClaimTTL)
+				require.NoError(t, err)
+				require.True(t, claimed)
+			}
+
+			txHandler := &mtmMocks.TransactionHandlerMock{}
+			txHandler.GetTransactionStatusesFunc = func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+				// the first lookup happens before the transaction is claimed
+				if !tc.processedByOther || len(txHandler.GetTransactionStatusesCalls()) == 1 {
+					return nil, nil
+				}
+				return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "STORED", LastSubmitted: *timestamppb.New(time.Now())}}, nil
+			}
+			txHandler.SubmitTransactionsFunc = func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+				// the transaction is claimed while it is submitted
+				_, err := store.Get(txClaimKeyPrefix + validTxID)
+				assert.NoError(t, err)
+
+				return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithTxClaims(store, time.Minute, 500*time.Millisecond),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var actual api.TransactionResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			assert.Equal(t, tc.expectedTxStatus, actual.TxStatus)
+			assert.Len(t, txHandler.SubmitTransactionsCalls(), tc.expectedSubmissions)
+
+			if tc.otherClaimTTL < time.Minute {
+				// the claim of this instance is released after the submission
+				_, err = store.Get(txClaimKeyPrefix + validTxID)
+				assert.ErrorIs(t, err, cache.ErrCacheNotFound)
+				return
+			}
+
+			// the claim of the other instance is kept
+			claim, err := store.Get(txClaimKeyPrefix + validTxID)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("other"), claim)
+		})
+	}
+}
+
+func TestReadOnly(t *testing.T) {
+	tt := []struct {
+		name    string
+		method  string
+		target  string
+		handler func(sut *ArcDefaultHandler, ctx echo.Context) error
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name:   "submit transaction",
+			method: http.MethodPost,
+			target: "/v1/tx",
+			handler: func(sut *ArcDefaultHandler, ctx echo.Context) error {
+				return sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+			},
+
+			expectedStatus: api.ErrStatusReadOnly,
+		},
+		{
+			name:   "submit transactions",
+			method: http.MethodPost,
+			target: "/v1/txs",
+			handler: func(sut *ArcDefaultHandler, ctx echo.Context) error {
+				return sut.POSTTransactions(ctx, api.POSTTransactionsParams{})
+			},
+
+			expectedStatus: api.ErrStatusReadOnly,
+		},
+		{
+			name:   "resubmit transaction",
+			method: http.MethodPost,
+			target: "/v1/tx/" + validTxID + "/resubmit",
+			handler: func(sut *ArcDefaultHandler, ctx echo.Context) error {
+				return sut.POSTTransactionResubmit(ctx, validTxID)
+			},
+
+			expectedStatus: api.ErrStatusReadOnly,
+		},
+		{
+			name:   "cancel transaction",
+			method: http.MethodDelete,
+			target: "/v1/tx/" + validTxID,
+			handler: func(sut *ArcDefaultHandler, ctx echo.Context) error {
+				return sut.DELETETransaction(ctx, validTxID)
+			},
+
+			expectedStatus: api.ErrStatusReadOnly,
+		},
+		{
+			name:   "transaction status",
+			method: http.MethodGet,
+			target: "/v1/tx/" + validTxID,
+			handler: func(sut *ArcDefaultHandler, ctx echo.Context) error {
+				return sut.GETTransactionStatus(ctx, validTxID)
 			},
 
-			expectedStatus: 200,
-			expectedResponse: api.TransactionResponse{
-				BlockHash:   PtrTo(""),
-				BlockHeight: PtrTo(uint64(0)),
-				ExtraInfo:   PtrTo(""),
-				MerklePath:  PtrTo(""),
-				Status:      200,
-				Timestamp:   now,
-				Title:       "OK",
-				TxStatus:    api.TransactionResponseTxStatusSEENONNETWORK,
-				Txid:        validTxID,
-			},
-		},
+			expectedStatus: api.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(validExtendedTx))
+			req.Header.Set(echo.HeaderContentType, contentTypes[0])
+			rec := httptest.NewRecorder()
+			ctx := e.NewContext(req, rec)
+
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusFunc: func(_ context.Context, _ string) (*metamorph.TransactionStatus, error) {
+					return &metamorph.TransactionStatus{TxID: validTxID, Status: "SEEN_ON_NETWORK"}, nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+				WithReadOnly(true),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			err = tc.handler(sut, ctx)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			assert.Empty(t, txHandler.SubmitTransactionsCalls())
+			assert.Empty(t, txHandler.ResubmitTransactionCalls())
+			assert.Empty(t, txHandler.CancelScheduledBroadcastCalls())
+		})
+	}
+}
+
+func TestPOSTTransaction_CrashReport(t *testing.T) {
+	tt := []struct {
+		name string
+		tx   string
+
+		expectedStatus       api.StatusCode
+		expectedCrashReports int
+	}{
+		{
+			name: "BEEF with BUMP index out of range",
+			tx:   "0100beef0001" + validTx + "0105",
+
+			expectedStatus:       api.ErrStatusMalformed,
+			expectedCrashReports: 1,
+		},
+		{
+			name: "BEEF with BUMP count out of bounds",
+			tx:   "0100beefffffffffffffffff0f",
+
+			expectedStatus:       api.ErrStatusMalformed,
+			expectedCrashReports: 0,
+		},
+		{
+			name: "transaction with script length out of bounds",
+			tx:   validTx[:82] + "ffffffffffffffff0f",
+
+			expectedStatus:       api.ErrStatusBadRequest,
+			expectedCrashReports: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			crashReporter := &validatorMocks.CrashReporterMock{
+				ReportCrashFunc: func(_ string, _ []byte, _ error) {},
+			}
+
+			sut, err := NewDefault(testLogger, &mtmMocks.TransactionHandlerMock{}, &btxMocks.ClientMock{}, defaultPolicy, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{},
+				WithCrashReporter(crashReporter),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(tc.tx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			require.Len(t, crashReporter.ReportCrashCalls(), tc.expectedCrashReports)
+			if tc.expectedCrashReports > 0 {
+				assert.Equal(t, validator.CrashTargetBEEF, crashReporter.ReportCrashCalls()[0].Target)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_BroadcastAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name   string
+		params api.POSTTransactionParams
+
+		expectedStatus      api.StatusCode
+		expectedBroadcastAt time.Time
+		expectedWaitFor     metamorph_api.Status
+	}{
 		{
-			name:        "valid tx - double spend attempted",
-			contentType: contentTypes[0],
-			txHexString: validExtendedTx,
-			getTx:       inputTxLowFeesBytes,
-
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID:         validTxID,
-				BlockHash:    "",
-				BlockHeight:  0,
-				Status:       "DOUBLE_SPEND_ATTEMPTED",
-				CompetingTxs: []string{"1234"},
-				Timestamp:    time.Now().Unix(),
-			},
+			name: "no broadcast time",
 
-			expectedStatus: 200,
-			expectedResponse: api.TransactionResponse{
-				BlockHash:    PtrTo(""),
-				BlockHeight:  PtrTo(uint64(0)),
-				ExtraInfo:    PtrTo(""),
-				CompetingTxs: PtrTo([]string{"1234"}),
-				MerklePath:   PtrTo(""),
-				Status:       200,
-				Timestamp:    now,
-				Title:        "OK",
-				TxStatus:     "DOUBLE_SPEND_ATTEMPTED",
-				Txid:         validTxID,
-			},
+			expectedStatus: api.StatusOK,
 		},
 		{
-			name:        "invalid BEEF - text/plain - no BUMP index",
-			contentType: contentTypes[0],
-			txHexString: invalidBeefNoBUMPIndex,
+			name:   "broadcast at",
+			params: api.POSTTransactionParams{XBroadcastAt: PtrTo(now.Add(time.Hour))},
 
-			expectedStatus:   463,
-			expectedResponse: errBEEFDecode,
-			expectedError:    ErrDecodingBeef,
+			expectedStatus:      api.StatusOK,
+			expectedBroadcastAt: now.Add(time.Hour),
+			expectedWaitFor:     metamorph_api.Status_STORED,
 		},
 		{
-			name:                       "invalid BEEF - text/plain - low fees",
-			contentType:                contentTypes[0],
-			txHexString:                invalidBeefLowFees,
-			validateBeefTransactionErr: validator.NewError(defaultvalidator.ErrTxFeeTooLow, api.ErrStatusFees),
+			name:   "broadcast delay",
+			params: api.POSTTransactionParams{XBroadcastDelay: PtrTo(60), XWaitFor: PtrTo("SEEN_ON_NETWORK")},
 
-			expectedStatus:   465,
-			expectedResponse: errBEEFLowFees,
+			expectedStatus:      api.StatusOK,
+			expectedBroadcastAt: now.Add(time.Minute),
+			expectedWaitFor:     metamorph_api.Status_STORED,
 		},
 		{
-			name:                       "invalid BEEF - text/plain - invalid scripts",
-			contentType:                contentTypes[0],
-			txHexString:                invalidBeefInvalidScripts,
-			validateBeefTransactionErr: validator.NewError(errors.New("validation error"), api.ErrStatusUnlockingScripts),
+			name:   "broadcast time passed",
+			params: api.POSTTransactionParams{XBroadcastAt: PtrTo(now.Add(-time.Hour))},
 
-			expectedStatus:   461,
-			expectedResponse: errBEEFInvalidScripts,
+			expectedStatus: api.StatusOK,
 		},
 		{
-			name:        "valid BEEF - success - text/plain",
-			contentType: contentTypes[0],
-			txHexString: validBeef,
+			name:   "error - broadcast time too far ahead",
+			params: api.POSTTransactionParams{XBroadcastAt: PtrTo(now.Add(8 * 24 * time.Hour))},
 
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID:        validBeefTxID,
-				BlockHash:   "",
-				BlockHeight: 0,
-				Status:      "SEEN_ON_NETWORK",
-				Timestamp:   time.Now().Unix(),
-			},
+			expectedStatus: api.ErrStatusBadRequest,
+		},
+		{
+			name:   "error - broadcast time and delay",
+			params: api.POSTTransactionParams{XBroadcastAt: PtrTo(now.Add(time.Hour)), XBroadcastDelay: PtrTo(60)},
 
-			expectedStatus: 200,
-			expectedResponse: api.TransactionResponse{
-				BlockHash:   PtrTo(""),
-				BlockHeight: PtrTo(uint64(0)),
-				ExtraInfo:   PtrTo(""),
-				MerklePath:  PtrTo(""),
-				Status:      200,
-				Timestamp:   now,
-				Title:       "OK",
-				TxStatus:    "SEEN_ON_NETWORK",
-				Txid:        validBeefTxID,
-			},
+			expectedStatus: api.ErrStatusBadRequest,
 		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "STORED"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, tc.params)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			if tc.expectedStatus != api.StatusOK {
+				require.Empty(t, txHandler.SubmitTransactionsCalls())
+				return
+			}
+
+			require.Len(t, txHandler.SubmitTransactionsCalls(), 1)
+			options := txHandler.SubmitTransactionsCalls()[0].Options
+			assert.Equal(t, tc.expectedBroadcastAt, options.BroadcastAt)
+			if tc.expectedWaitFor != 0 {
+				assert.Equal(t, tc.expectedWaitFor, options.WaitForStatus)
+			}
+		})
+	}
+}
+
+func TestPOSTTransaction_ExpiresAt(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name   string
+		params api.POSTTransactionParams
+
+		expectedStatus    api.StatusCode
+		expectedExpiresAt time.Time
+	}{
 		{
-			name:        "valid BEEF - success - application/json",
-			contentType: contentTypes[1],
-			txHexString: fmt.Sprintf("{\"rawTx\": \"%s\"}", validBeef),
+			name: "no expiry",
 
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID:        validBeefTxID,
-				BlockHash:   "",
-				BlockHeight: 0,
-				Status:      "SEEN_ON_NETWORK",
-				Timestamp:   time.Now().Unix(),
-			},
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:   "expires at",
+			params: api.POSTTransactionParams{XExpiresAt: PtrTo(now.Add(time.Hour))},
 
-			expectedStatus: 200,
-			expectedResponse: api.TransactionResponse{
-				BlockHash:   PtrTo(""),
-				BlockHeight: PtrTo(uint64(0)),
-				ExtraInfo:   PtrTo(""),
-				MerklePath:  PtrTo(""),
-				Status:      200,
-				Timestamp:   now,
-				Title:       "OK",
-				TxStatus:    "SEEN_ON_NETWORK",
-				Txid:        validBeefTxID,
-			},
+			expectedStatus:    api.StatusOK,
+			expectedExpiresAt: now.Add(time.Hour),
 		},
 		{
-			name:        "valid BEEF 1 mined tx - success - application/json",
-			contentType: contentTypes[1],
-			txHexString: fmt.Sprintf("{\"rawTx\": \"%s\"}", validBeef1MinexTx),
+			name:   "ttl",
+			params: api.POSTTransactionParams{XTTL: PtrTo(900)},
 
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID:        validBeef1MinexTxID,
-				BlockHash:   "",
-				BlockHeight: 0,
-				Status:      "SEEN_ON_NETWORK",
-				Timestamp:   time.Now().Unix(),
-			},
+			expectedStatus:    api.StatusOK,
+			expectedExpiresAt: now.Add(15 * time.Minute),
+		},
+		{
+			name:   "error - expiry passed",
+			params: api.POSTTransactionParams{XExpiresAt: PtrTo(now.Add(-time.Hour))},
 
-			expectedStatus: 200,
-			expectedResponse: api.TransactionResponse{
-				BlockHash:   PtrTo(""),
-				BlockHeight: PtrTo(uint64(0)),
-				ExtraInfo:   PtrTo(""),
-				MerklePath:  PtrTo(""),
-				Status:      200,
-				Timestamp:   now,
-				Title:       "OK",
-				TxStatus:    "SEEN_ON_NETWORK",
-				Txid:        validBeef1MinexTxID,
-			},
+			expectedStatus: api.ErrStatusBadRequest,
 		},
 		{
-			name:        "valid BEEF - success - application/octet-stream",
-			contentType: contentTypes[2],
-			txHexString: string(validBeefBytes),
+			name:   "error - expiry before broadcast time",
+			params: api.POSTTransactionParams{XBroadcastDelay: PtrTo(3600), XTTL: PtrTo(60)},
 
-			submitTxResponse: &metamorph.TransactionStatus{
-				TxID:        validBeefTxID,
-				BlockHash:   "",
-				BlockHeight: 0,
-				Status:      "SEEN_ON_NETWORK",
-				Timestamp:   time.Now().Unix(),
-			},
+			expectedStatus: api.ErrStatusBadRequest,
+		},
+		{
+			name:   "error - expiry and ttl",
+			params: api.POSTTransactionParams{XExpiresAt: PtrTo(now.Add(time.Hour)), XTTL: PtrTo(60)},
 
-			expectedStatus: 200,
-			expectedResponse: api.TransactionResponse{
-				BlockHash:   PtrTo(""),
-				BlockHeight: PtrTo(uint64(0)),
-				ExtraInfo:   PtrTo(""),
-				MerklePath:  PtrTo(""),
-				Status:      200,
-				Timestamp:   now,
-				Title:       "OK",
-				TxStatus:    "SEEN_ON_NETWORK",
-				Txid:        validBeefTxID,
-			},
+			expectedStatus: api.ErrStatusBadRequest,
 		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
 			// given
-			policy := *defaultPolicy
-			if tc.expectedFee > 0 {
-				policy.MinMiningTxFee = float64(tc.expectedFee)
-			}
-
 			txHandler := &mtmMocks.TransactionHandlerMock{
-				HealthFunc: func(_ context.Context) error {
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return nil, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "STORED"}}, nil
+				},
+			}
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
 					return nil
 				},
+			}
 
-				GetTransactionsFunc: func(_ context.Context, _ []string) ([]*metamorph.Transaction, error) {
-					if tc.getTx != nil {
-						tx, _ := sdkTx.NewTransactionFromBytes(tc.getTx)
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
 
-						mt := metamorph.Transaction{
-							TxID:        tx.TxID().String(),
-							Bytes:       tc.getTx,
-							BlockHeight: 100,
-						}
-						return []*metamorph.Transaction{&mt}, nil
-					}
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			// when
+			err = sut.POSTTransaction(ctx, tc.params)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, int(tc.expectedStatus), rec.Code)
+			if tc.expectedStatus != api.StatusOK {
+				require.Empty(t, txHandler.SubmitTransactionsCalls())
+				assert.Contains(t, rec.Body.String(), "X-ExpiresAt")
+				return
+			}
+
+			require.Len(t, txHandler.SubmitTransactionsCalls(), 1)
+			assert.Equal(t, tc.expectedExpiresAt, txHandler.SubmitTransactionsCalls()[0].Options.ExpiresAt)
+		})
+	}
+}
+
+func TestPOSTTransaction_AnnounceTo(t *testing.T) {
+	tt := []struct {
+		name       string
+		announceTo string
+
+		expectedStatus     api.StatusCode
+		expectedAnnounceTo string
+	}{
+		{
+			name: "all peers",
+
+			expectedStatus: api.StatusOK,
+		},
+		{
+			name:       "number of peers",
+			announceTo: "02",
+
+			expectedStatus:     api.StatusOK,
+			expectedAnnounceTo: "2",
+		},
+		{
+			name:       "designated peer",
+			announceTo: "miner-a",
 
-					return nil, nil
-				},
+			expectedStatus:     api.StatusOK,
+			expectedAnnounceTo: "miner-a",
+		},
+		{
+			name:       "error - no peers",
+			announceTo: "0",
+
+			expectedStatus: api.ErrStatusBadRequest,
+		},
+		{
+			name:       "error - unknown designated peer",
+			announceTo: "miner-b",
+
+			expectedStatus: api.ErrStatusBadRequest,
+		},
+	}
 
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHandler := &mtmMocks.TransactionHandlerMock{
 				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
-					return nil, metamorph.ErrTransactionNotFound
+					return nil, nil
 				},
-
 				SubmitTransactionsFunc: func(_ context.Context, _ sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
-					return []*metamorph.TransactionStatus{tc.submitTxResponse}, tc.submitTxErr
+					return []*metamorph.TransactionStatus{{TxID: validTxID, Status: "SEEN_ON_NETWORK"}}, nil
 				},
 			}
-
-			blocktxClient := &btxMocks.ClientMock{}
-
-			handlerStats, err := NewStats()
-			urlRestrictions := []string{"skiptest"}
-			tracer := attribute.KeyValue{Key: "testnet", Value: attribute.StringValue("test")}
-			require.NoError(t, err)
-			dv := &apiHandlerMocks.DefaultValidatorMock{
+			defaultValidator := &apiHandlerMocks.DefaultValidatorMock{
 				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
-					return tc.validateTransactionErr
+					return nil
 				},
 			}
 
-			bv := &apiHandlerMocks.BeefValidatorMock{
-				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Beef, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) (*sdkTx.Transaction, error) {
-					return nil, tc.validateBeefTransactionErr
-				},
-			}
-			sut, err := NewDefault(testLogger, txHandler, blocktxClient, &policy, dv, bv,
-				WithNow(func() time.Time { return now }),
-				WithStats(handlerStats),
-				WithCallbackURLRestrictions(urlRestrictions),
-				WithRebroadcastExpiration(23*time.Hour),
-				WithServerMaxTimeoutDefault(5*time.Second),
-				WithTracer(tracer),
-				WithStandardFormatSupported(true),
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, defaultValidator, &apiHandlerMocks.BeefValidatorMock{},
+				WithDesignatedPeers("miner-a"),
 			)
 			require.NoError(t, err)
-
 			defer sut.Shutdown()
 
-			inputTx := strings.NewReader(tc.txHexString)
-			rec, ctx := createEchoPostRequest(inputTx, tc.contentType, "/v1/tx")
+			rec, ctx := createEchoPostRequest(strings.NewReader(validExtendedTx), contentTypes[0], "/v1/tx")
+
+			params := api.POSTTransactionParams{}
+			if tc.announceTo != "" {
+				params.XAnnounceTo = PtrTo(tc.announceTo)
+			}
 
 			// when
-			err = sut.POSTTransaction(ctx, api.POSTTransactionParams{})
+			err = sut.POSTTransaction(ctx, params)
 
 			// then
 			require.NoError(t, err)
-
 			assert.Equal(t, int(tc.expectedStatus), rec.Code)
-
-			b := rec.Body.Bytes()
-
-			switch v := tc.expectedResponse.(type) {
-			case api.TransactionResponse:
-				var txResponse api.TransactionResponse
-				err = json.Unmarshal(b, &txResponse)
-				require.NoError(t, err)
-
-				assert.Equal(t, tc.expectedResponse, txResponse)
-			case api.ErrorFields:
-				var actualError api.ErrorFields
-				err = json.Unmarshal(b, &actualError)
-				require.NoError(t, err)
-
-				expectedResp, ok := tc.expectedResponse.(api.ErrorFields)
-				require.True(t, ok)
-				assert.Equal(t, expectedResp.Txid, actualError.Txid)
-				assert.Equal(t, expectedResp.Status, actualError.Status)
-				assert.Equal(t, expectedResp.Detail, actualError.Detail)
-				assert.Equal(t, expectedResp.Title, actualError.Title)
-				assert.Equal(t, expectedResp.Instance, actualError.Instance)
-				assert.Equal(t, expectedResp.Type, actualError.Type)
-				if tc.expectedError != nil {
-					assert.ErrorContains(t, errors.New(*actualError.ExtraInfo), tc.expectedError.Error())
-				}
-			default:
-				require.Fail(t, fmt.Sprintf("response type %T does not match any valid types", v))
+			if tc.expectedStatus != api.StatusOK {
+				require.Empty(t, txHandler.SubmitTransactionsCalls())
+				assert.Contains(t, rec.Body.String(), "X-AnnounceTo")
+				return
 			}
+
+			require.Len(t, txHandler.SubmitTransactionsCalls(), 1)
+			assert.Equal(t, tc.expectedAnnounceTo, txHandler.SubmitTransactionsCalls()[0].Options.AnnounceTo)
 		})
 	}
 }
@@ -890,7 +4375,7 @@ func TestPOSTTransactions(t *testing.T) { //nolint:funlen
 		},
 		{
 			name:           "invalid mime type",
-			expectedStatus: api.ErrStatusBadRequest,
+			expectedStatus: api.ErrStatusUnsupportedMediaType,
 			options:        api.POSTTransactionsParams{},
 			inputTxs: map[string]io.Reader{
 				echo.MIMEApplicationXML: strings.NewReader(""),
@@ -901,7 +4386,7 @@ func TestPOSTTransactions(t *testing.T) { //nolint:funlen
 			expectedStatus: api.ErrStatusBadRequest,
 			options:        api.POSTTransactionsParams{},
 			inputTxs: map[string]io.Reader{
-				echo.MIMEApplicationXML: strings.NewReader(""),
+				echo.MIMETextPlain: strings.NewReader(""),
 			},
 			expectedErrors: map[string]string{
 				echo.MIMETextPlain:       "error parsing transactions from request: encoding/hex: invalid byte: U+0074 't'",
@@ -1257,6 +4742,105 @@ func testPOSTTransactionsInputTxs(t *testing.T, sut *ArcDefaultHandler, tc PostT
 	}
 }
 
+func TestPOSTTransactions_Stream(t *testing.T) {
+	tt := []struct {
+		name      string
+		failBatch int
+
+		expectedCode    int
+		expectedLines   int
+		expectedSubmits int
+		expectedError   bool
+	}{
+		{
+			name: "results streamed in batches",
+
+			expectedCode:    http.StatusOK,
+			expectedLines:   3,
+			expectedSubmits: 2,
+		},
+		{
+			name:      "error of first batch",
+			failBatch: 1,
+
+			expectedCode:    int(api.ErrStatusGeneric),
+			expectedSubmits: 1,
+		},
+		{
+			name:      "error of later batch",
+			failBatch: 2,
+
+			expectedCode:    http.StatusOK,
+			expectedLines:   3,
+			expectedSubmits: 2,
+			expectedError:   true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			submits := 0
+			txHandler := &mtmMocks.TransactionHandlerMock{
+				GetTransactionStatusesFunc: func(_ context.Context, _ []string) ([]*metamorph.TransactionStatus, error) {
+					return make([]*metamorph.TransactionStatus, 0), nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, txs sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					submits++
+					if submits == tc.failBatch {
+						return nil, errors.New("metamorph unavailable")
+					}
+					statuses := make([]*metamorph.TransactionStatus, 0, len(txs))
+					for range txs {
+						statuses = append(statuses, &metamorph.TransactionStatus{TxID: validTxID, Status: "SEEN_ON_NETWORK"})
+					}
+					return statuses, nil
+				},
+			}
+			dv := &apiHandlerMocks.DefaultValidatorMock{
+				ValidateTransactionFunc: func(_ context.Context, _ *sdkTx.Transaction, _ validator.FeeValidation, _ validator.ScriptValidation, _ int32) error {
+					return nil
+				},
+			}
+
+			sut, err := NewDefault(testLogger, txHandler, &btxMocks.ClientMock{}, defaultPolicy, dv, &apiHandlerMocks.BeefValidatorMock{},
+				WithStreamBatchSize(2),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			rec, ctx := createEchoPostRequest(strings.NewReader(strings.Repeat(validExtendedTx+"\n", 3)), echo.MIMETextPlain, "/v1/txs")
+			ctx.Request().Header.Set(echo.HeaderAccept, MIMEApplicationNDJSON)
+
+			// when
+			err = sut.POSTTransactions(ctx, api.POSTTransactionsParams{})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedCode, rec.Code)
+			assert.Equal(t, tc.expectedSubmits, submits)
+			if tc.expectedCode != http.StatusOK {
+				assert.Equal(t, api.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+				return
+			}
+			assert.Equal(t, MIMEApplicationNDJSON, rec.Header().Get(echo.HeaderContentType))
+
+			lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+			require.Len(t, lines, tc.expectedLines)
+			for i, line := range lines {
+				var actual api.TransactionResponse
+				require.NoError(t, json.Unmarshal([]byte(line), &actual))
+				if tc.expectedError && i == len(lines)-1 {
+					assert.NotEqual(t, int(api.StatusOK), actual.Status)
+					continue
+				}
+				assert.Equal(t, validTxID, actual.Txid)
+				assert.Equal(t, api.TransactionResponseTxStatusSEENONNETWORK, actual.TxStatus)
+			}
+		})
+	}
+}
+
 func testPOSTTransactionsContentTypes(t *testing.T, sut *ArcDefaultHandler, tc PostTransactionsTest) {
 	for _, contentType := range tc.contentTypes {
 		e := echo.New()
@@ -1381,6 +4965,134 @@ func TestGetTransactionOptions(t *testing.T) {
 
 			expectedError: ErrInvalidCallbackURL,
 		},
+		{
+			name: "multiple callback urls - comma separated",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl:   PtrTo("http://api.callme.com, http://api.callme-too.com"),
+				XCallbackToken: PtrTo("1234,5678"),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL:   "http://api.callme.com",
+				CallbackToken: "1234",
+				AdditionalCallbacks: []metamorph.CallbackRecipient{
+					{URL: "http://api.callme-too.com", Token: "5678"},
+				},
+			},
+		},
+		{
+			name: "single callback url with comma in query",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl:   PtrTo("http://api.callme.com/callback?ids=1,2"),
+				XCallbackToken: PtrTo("1234,5678"),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL:   "http://api.callme.com/callback?ids=1,2",
+				CallbackToken: "1234,5678",
+			},
+		},
+		{
+			name: "multiple callback urls with comma in query - not split, requires JSON list",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl: PtrTo("http://api.callme.com/callback?ids=1,2,http://api.callme-too.com?ids=3"),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL: "http://api.callme.com/callback?ids=1,2,http://api.callme-too.com?ids=3",
+			},
+		},
+		{
+			name: "multiple callback urls - JSON list",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl: PtrTo(`[{"url":"http://api.callme.com","token":"1234"},{"url":"http://api.callme-too.com"}]`),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL:   "http://api.callme.com",
+				CallbackToken: "1234",
+				AdditionalCallbacks: []metamorph.CallbackRecipient{
+					{URL: "http://api.callme-too.com"},
+				},
+			},
+		},
+		{
+			name: "multiple callback urls - invalid url",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl: PtrTo("http://api.callme.com,api.callme-too.com"),
+			},
+
+			expectedError: ErrInvalidCallbackURL,
+		},
+		{
+			name: "multiple callback urls - number of tokens does not match",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl:   PtrTo("http://api.callme.com,http://api.callme-too.com"),
+				XCallbackToken: PtrTo("1,2,3"),
+			},
+
+			expectedError: ErrInvalidCallbackList,
+		},
+		{
+			name: "multiple callback urls - invalid JSON list",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl: PtrTo(`[{"url":"http://api.callme.com"`),
+			},
+
+			expectedError: ErrInvalidCallbackList,
+		},
+		{
+			name: "callback version 2",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl:     PtrTo("http://api.callme.com"),
+				XCallbackVersion: PtrTo(2),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL:     "http://api.callme.com",
+				CallbackVersion: 2,
+			},
+		},
+		{
+			name: "callback allow duplicates",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl:             PtrTo("http://api.callme.com"),
+				XCallbackAllowDuplicates: PtrTo(true),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL:             "http://api.callme.com",
+				CallbackAllowDuplicates: true,
+			},
+		},
+		{
+			name: "invalid callback version",
+			params: api.POSTTransactionsParams{
+				XCallbackVersion: PtrTo(3),
+			},
+
+			expectedError: ErrCallbackVersionInvalid,
+		},
+		{
+			name: "callback suppression window",
+			params: api.POSTTransactionsParams{
+				XCallbackUrl:               PtrTo("http://api.callme.com"),
+				XCallbackSuppressionWindow: PtrTo(30),
+			},
+
+			expectedOptions: &metamorph.TransactionOptions{
+				CallbackURL:               "http://api.callme.com",
+				CallbackSuppressionWindow: 30,
+			},
+		},
+		{
+			name: "invalid callback suppression window",
+			params: api.POSTTransactionsParams{
+				XCallbackSuppressionWindow: PtrTo(-1),
+			},
+
+			expectedError: ErrInvalidSuppressionWindow,
+		},
 		{
 			name: "wait for - QUEUED",
 			params: api.POSTTransactionsParams{
@@ -1449,9 +5161,15 @@ func TestGetTransactionOptions(t *testing.T) {
 }
 
 func Test_handleError(t *testing.T) {
+	statusMapping := []StatusMappingRule{
+		{Status: api.ErrStatusFees, ErrorContains: "cumulative", MapTo: api.ErrStatusCumulativeFees},
+		{Status: api.ErrStatusFees, MapTo: api.ErrStatusBadRequest, RejectReason: "see https://example.com/policy"},
+	}
+
 	tt := []struct {
-		name        string
-		submitError error
+		name          string
+		submitError   error
+		statusMapping []StatusMappingRule
 
 		expectedStatus api.StatusCode
 		expectedArcErr *api.ErrorFields
@@ -1500,6 +5218,74 @@ func Test_handleError(t *testing.T) {
 				Err:            errors.New("parent transaction not found"),
 			},
 
+			expectedStatus: api.ErrStatusTxFormat,
+			expectedArcErr: &api.ErrorFields{
+				Detail:    "Missing input scripts: Transaction could not be transformed to extended format",
+				ExtraInfo: PtrTo("arc error 460: parent transaction not found"),
+				Title:     "Not extended format",
+				Type:      "https://bitcoin-sv.github.io/arc/#/errors?id=_460",
+				Txid:      PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"),
+				Status:    460,
+			},
+		},
+		{
+			name:        "rejected by node",
+			submitError: node_reject.New(node_reject.SourceNode, 258, "txn-mempool-conflict"),
+
+			expectedStatus: api.ErrStatusConflict,
+			expectedArcErr: &api.ErrorFields{
+				Detail:    "Transaction is valid, but there is a conflicting tx in the block template",
+				ExtraInfo: PtrTo("arc error 466: rejected by node with code 258 (REJECT_CONFLICT): txn-mempool-conflict"),
+				Title:     "Conflicting tx found",
+				Type:      "https://bitcoin-sv.github.io/arc/#/errors?id=_466",
+				Txid:      PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"),
+				Status:    466,
+			},
+		},
+		{
+			name: "mapped status with reject reason",
+			submitError: &validator.Error{
+				ArcErrorStatus: api.ErrStatusFees,
+				Err:            errors.New("fee too low"),
+			},
+			statusMapping: statusMapping,
+
+			expectedStatus: api.ErrStatusBadRequest,
+			expectedArcErr: &api.ErrorFields{
+				Detail:    "see https://example.com/policy",
+				ExtraInfo: PtrTo("arc error 465: fee too low"),
+				Title:     "Fee too low",
+				Type:      "https://bitcoin-sv.github.io/arc/#/errors?id=_465",
+				Txid:      PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"),
+				Status:    400,
+			},
+		},
+		{
+			name: "mapped status by error",
+			submitError: &validator.Error{
+				ArcErrorStatus: api.ErrStatusFees,
+				Err:            errors.New("cumulative fee too low"),
+			},
+			statusMapping: statusMapping,
+
+			expectedStatus: api.ErrStatusCumulativeFees,
+			expectedArcErr: &api.ErrorFields{
+				Detail:    "Fees are insufficient",
+				ExtraInfo: PtrTo("arc error 465: cumulative fee too low"),
+				Title:     "Fee too low",
+				Type:      "https://bitcoin-sv.github.io/arc/#/errors?id=_465",
+				Txid:      PtrTo("a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"),
+				Status:    473,
+			},
+		},
+		{
+			name: "status not mapped",
+			submitError: &validator.Error{
+				ArcErrorStatus: api.ErrStatusTxFormat,
+				Err:            errors.New("parent transaction not found"),
+			},
+			statusMapping: statusMapping,
+
 			expectedStatus: api.ErrStatusTxFormat,
 			expectedArcErr: &api.ErrorFields{
 				Detail:    "Missing input scripts: Transaction could not be transformed to extended format",
@@ -1514,7 +5300,7 @@ func Test_handleError(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			handler := ArcDefaultHandler{}
+			handler := ArcDefaultHandler{statusMapping: tc.statusMapping}
 
 			tx, err := sdkTx.NewTransactionFromHex(validTx)
 			require.NoError(t, err)
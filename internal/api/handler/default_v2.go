@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+	apiv2 "github.com/bitcoin-sv/arc/pkg/api/v2"
+)
+
+// ArcDefaultHandlerV2 serves the endpoints of version 2 of the API with the internals of the default handler. Version 1
+// of the API is frozen, breaking changes of the responses are only made in version 2 by overriding the respective
+// methods of the default handler.
+type ArcDefaultHandlerV2 struct {
+	*ArcDefaultHandler
+}
+
+// NewDefaultV2 returns the handler of version 2 of the API sharing the internals of the given default handler.
+func NewDefaultV2(defaultHandler *ArcDefaultHandler) *ArcDefaultHandlerV2 {
+	return &ArcDefaultHandlerV2{ArcDefaultHandler: defaultHandler}
+}
+
+// POSTTransaction ...
+func (m *ArcDefaultHandlerV2) POSTTransaction(ctx echo.Context, params apiv2.POSTTransactionParams) error {
+	return m.ArcDefaultHandler.POSTTransaction(ctx, api.POSTTransactionParams(params))
+}
+
+// POSTTransactions ...
+func (m *ArcDefaultHandlerV2) POSTTransactions(ctx echo.Context, params apiv2.POSTTransactionsParams) error {
+	return m.ArcDefaultHandler.POSTTransactions(ctx, api.POSTTransactionsParams(params))
+}
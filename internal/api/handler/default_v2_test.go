@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apiHandlerMocks "github.com/bitcoin-sv/arc/internal/api/handler/mocks"
+	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
+	"github.com/bitcoin-sv/arc/pkg/api"
+	apiv2 "github.com/bitcoin-sv/arc/pkg/api/v2"
+)
+
+func TestArcDefaultHandlerV2(t *testing.T) {
+	tt := []struct {
+		name    string
+		method  string
+		path    string
+		headers map[string]string
+
+		expectedStatus    int
+		expectedExtraInfo string
+	}{
+		{
+			name:   "get policy",
+			method: http.MethodGet,
+			path:   "/v2/policy",
+
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "post transaction - max timeout exceeded",
+			method:  http.MethodPost,
+			path:    "/v2/tx",
+			headers: map[string]string{"X-MaxTimeout": "31"},
+
+			expectedStatus:    http.StatusBadRequest,
+			expectedExtraInfo: ErrMaxTimeoutExceeded.Error(),
+		},
+		{
+			name:    "post transactions - max timeout exceeded",
+			method:  http.MethodPost,
+			path:    "/v2/txs",
+			headers: map[string]string{"X-MaxTimeout": "31"},
+
+			expectedStatus:    http.StatusBadRequest,
+			expectedExtraInfo: ErrMaxTimeoutExceeded.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			defaultHandler, err := NewDefault(testLogger, nil, &btxMocks.ClientMock{}, defaultPolicy, &apiHandlerMocks.DefaultValidatorMock{}, &apiHandlerMocks.BeefValidatorMock{})
+			require.NoError(t, err)
+			defer defaultHandler.Shutdown()
+
+			e := echo.New()
+			apiv2.RegisterHandlers(e, NewDefaultV2(defaultHandler))
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(validTx))
+			req.Header.Set(echo.HeaderContentType, echo.MIMETextPlain)
+			for key, value := range tc.headers {
+				req.Header.Set(key, value)
+			}
+
+			// when
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedExtraInfo == "" {
+				return
+			}
+
+			var actual api.ErrorFields
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			require.NotNil(t, actual.ExtraInfo)
+			assert.Equal(t, tc.expectedExtraInfo, *actual.ExtraInfo)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/api/reverification"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	beefValidator "github.com/bitcoin-sv/arc/internal/validator/beef"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const deferredExtraInfo = "submission deferred until the Merkle roots of the BEEF are verified"
+
+// WithMerkleRootsReverifier accepts or defers, by the mode of the re-verifier, the BEEF transactions whose Merkle roots
+// could not be verified because no block header service was available or the services did not reach a quorum, instead
+// of rejecting them. Their Merkle roots are verified again by the re-verifier.
+func WithMerkleRootsReverifier(reverifier *reverification.Reverifier) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.reverifier = reverifier
+	}
+}
+
+// isDegraded returns whether the BEEF is not rejected for the error of its validation, because its Merkle roots could
+// not be verified and the degraded mode accepts or defers such transactions.
+func (m *ArcDefaultHandler) isDegraded(err error) bool {
+	return m.reverifier != nil && m.reverifier.Mode() != reverification.ModeReject && beefValidator.IsMerkleRootsUnverifiable(err)
+}
+
+// holdUnverified keeps the transactions of the BEEF whose Merkle roots could not be verified for re-verification. It
+// returns false if the maximum number of kept transactions is reached, in which case the BEEF is rejected.
+func (m *ArcDefaultHandler) holdUnverified(ctx context.Context, beefTx *sdkTx.Beef, options *metamorph.TransactionOptions, txID string) bool {
+	err := m.reverifier.Add(ctx, beefTx, beefTransactionsToSubmit(beefTx), options)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to keep transactions with unverified Merkle roots", slog.String("id", txID), slog.String("err", err.Error()))
+		return false
+	}
+
+	m.logger.WarnContext(ctx, "Merkle roots of BEEF could not be verified", slog.String("id", txID), slog.String("mode", string(m.reverifier.Mode())))
+	return true
+}
+
+// unverifiedTxIDs returns those of the transactions whose Merkle roots are still to be verified, which are kept by
+// metamorph for all API instances.
+func (m *ArcDefaultHandler) unverifiedTxIDs(ctx context.Context, txs []*sdkTx.Transaction) (map[string]struct{}, *api.ErrorFields) {
+	if m.reverifier == nil {
+		return nil, nil
+	}
+
+	txIDs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txIDs = append(txIDs, hexutils.TxID(tx.TxID()))
+	}
+
+	unverified, err := m.reverifier.Unverified(ctx, txIDs)
+	if err != nil {
+		m.logger.ErrorContext(ctx, "Failed to get transactions with unverified Merkle roots", slog.Int("txs", len(txIDs)), slog.String("err", err.Error()))
+		return nil, api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+	}
+
+	return unverified, nil
+}
+
+// deferUnverified returns the transactions to submit without the deferred ones, whose statuses are returned as QUEUED
+// instead.
+func (m *ArcDefaultHandler) deferUnverified(txs []*sdkTx.Transaction, unverified map[string]struct{}) ([]*sdkTx.Transaction, []*metamorph.TransactionStatus) {
+	if m.reverifier == nil || m.reverifier.Mode() != reverification.ModeDefer {
+		return txs, nil
+	}
+
+	toSubmit := make([]*sdkTx.Transaction, 0, len(txs))
+	var deferred []*metamorph.TransactionStatus
+	for _, tx := range txs {
+		txID := hexutils.TxID(tx.TxID())
+		if _, found := unverified[txID]; !found {
+			toSubmit = append(toSubmit, tx)
+			continue
+		}
+
+		deferred = append(deferred, m.deferredStatus(txID))
+	}
+
+	return toSubmit, deferred
+}
+
+// lookupDeferredStatus returns the QUEUED status of the transaction if its submission is deferred until its Merkle
+// roots are verified, as it is not known to metamorph yet.
+func (m *ArcDefaultHandler) lookupDeferredStatus(ctx context.Context, txID string) (*metamorph.TransactionStatus, bool) {
+	if m.reverifier == nil || m.reverifier.Mode() != reverification.ModeDefer {
+		return nil, false
+	}
+
+	unverified, err := m.reverifier.Unverified(ctx, []string{txID})
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to get transactions with unverified Merkle roots", slog.String("hash", txID), slog.String("err", err.Error()))
+		return nil, false
+	}
+
+	if _, found := unverified[txID]; !found {
+		return nil, false
+	}
+
+	return m.deferredStatus(txID), true
+}
+
+func (m *ArcDefaultHandler) deferredStatus(txID string) *metamorph.TransactionStatus {
+	return &metamorph.TransactionStatus{
+		TxID:      txID,
+		Status:    metamorph_api.Status_QUEUED.String(),
+		ExtraInfo: deferredExtraInfo,
+		Timestamp: m.now().Unix(),
+	}
+}
+
+// unverifiedWarnings returns the warning for the transaction if its Merkle roots are still to be verified.
+func (m *ArcDefaultHandler) unverifiedWarnings(txID string, unverified map[string]struct{}) []validator.Warning {
+	if _, found := unverified[txID]; !found {
+		return nil
+	}
+
+	return []validator.Warning{{
+		Code:    validator.WarningMerkleRootsUnverified,
+		Message: "the Merkle roots of the BEEF could not be verified, they are verified again later",
+	}}
+}
+
+// beefTransactionsToSubmit returns the transactions of the BEEF which are submitted, the unmined ones, or the only
+// transaction if the BEEF contains just one.
+func beefTransactionsToSubmit(beefTx *sdkTx.Beef) []*sdkTx.Transaction {
+	var txs []*sdkTx.Transaction
+	for _, tx := range beefTx.Transactions {
+		if tx.DataFormat == sdkTx.RawTx || (tx.DataFormat == sdkTx.RawTxAndBumpIndex && len(beefTx.Transactions) == 1) {
+			txs = append(txs, tx.Transaction)
+		}
+	}
+
+	return txs
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+)
+
+const (
+	headerETag        = "ETag"
+	headerIfNoneMatch = "If-None-Match"
+)
+
+// statusETag returns the entity tag of the state of a transaction, i.e. of its status and of the block it is mined in.
+// The timestamp of the response is not part of the state, so that the entity tag only changes with the state.
+func statusETag(tx *metamorph.TransactionStatus) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%s|%s|%d|%s|%s|%s", tx.Status, tx.BlockHash, tx.BlockHeight, tx.MerklePath, tx.ExtraInfo, strings.Join(tx.CompetingTxs, ","))
+
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// etagMatches returns whether the entity tag matches one of the entity tags of the If-None-Match header, which are
+// compared weakly as required for If-None-Match.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// notModified sets the entity tag of the transaction status on the response and returns whether the client already
+// has this state of the transaction, in which case 304 Not Modified is returned without body.
+func notModified(ctx echo.Context, tx *metamorph.TransactionStatus) bool {
+	etag := statusETag(tx)
+	ctx.Response().Header().Set(headerETag, etag)
+
+	return etagMatches(ctx.Request().Header.Get(headerIfNoneMatch), etag)
+}
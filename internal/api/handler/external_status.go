@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ordishs/go-bitcoin"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+// ExternalStatusNode is the node on which the statuses of transactions unknown to ARC are looked up. The index of all
+// transactions (`txindex=1`) has to be enabled on the node, so that mined transactions are found by GetRawTransaction.
+type ExternalStatusNode interface {
+	GetRawTransaction(txID string) (*bitcoin.RawTransaction, error)
+}
+
+// WithExternalStatusLookup looks up the transactions which are unknown to ARC on the node when their status is
+// requested. Transactions found on the node are returned as MINED with the block they are mined in or as
+// SEEN_ON_NETWORK if they are in the mempool of the node, flagged as external, instead of 404.
+func WithExternalStatusLookup(node ExternalStatusNode) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.externalStatusNode = node
+	}
+}
+
+// lookupExternalStatus returns the minimal status of the transaction according to the node, if the node knows it.
+func (m *ArcDefaultHandler) lookupExternalStatus(ctx context.Context, id string) (*metamorph.TransactionStatus, bool) {
+	if m.externalStatusNode == nil {
+		return nil, false
+	}
+
+	rawTx, err := m.externalStatusNode.GetRawTransaction(id)
+	if err != nil || rawTx == nil {
+		if err != nil {
+			m.logger.DebugContext(ctx, "Transaction not found on node", slog.String("hash", id), slog.String("err", err.Error()))
+		}
+		return nil, false
+	}
+
+	tx := &metamorph.TransactionStatus{
+		TxID:   id,
+		Status: metamorph_api.Status_SEEN_ON_NETWORK.String(),
+	}
+
+	if rawTx.BlockHash != "" {
+		tx.Status = metamorph_api.Status_MINED.String()
+		tx.BlockHash = rawTx.BlockHash
+		tx.BlockHeight = rawTx.BlockHeight
+	}
+
+	return tx, true
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	feestats "github.com/bitcoin-sv/arc/internal/api/fee_stats"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
+)
+
+var ErrFeeStatsDisabled = errors.New("fee stats not enabled")
+
+// WithFeeStats aggregates the fee rates and the throughput of the accepted transactions, which are served by GETStats.
+func WithFeeStats(aggregator *feestats.Aggregator) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.feeStats = aggregator
+	}
+}
+
+// GETStats returns the fee and throughput statistics of the transactions accepted in the rolling window.
+func (m *ArcDefaultHandler) GETStats(ctx echo.Context) (err error) {
+	_, span := tracing.StartTracing(ctx.Request().Context(), "GETStats", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	if m.feeStats == nil {
+		e := api.NewErrorFields(api.ErrStatusNotFound, ErrFeeStatsDisabled.Error())
+		return problemJSON(ctx, e)
+	}
+
+	return ctx.JSON(http.StatusOK, toAPIStats(m.feeStats.Stats(), m.now()))
+}
+
+// recordFeeStats records an accepted transaction in the fee stats.
+func (m *ArcDefaultHandler) recordFeeStats(txID string, status api.TransactionResponseTxStatus, feeDetails *validator.FeeDetails) {
+	if m.feeStats == nil || status == api.TransactionResponseTxStatusREJECTED {
+		return
+	}
+
+	m.feeStats.Record(txID, feeDetails)
+}
+
+func toAPIStats(stats feestats.Stats, now time.Time) api.Stats {
+	result := api.Stats{
+		WindowSeconds:            uint64(stats.Window.Seconds()),
+		Accepted:                 stats.Accepted,
+		Mined:                    stats.Mined,
+		AverageFeeRate:           stats.AverageFeeRate,
+		AverageTimeToMineSeconds: stats.AverageTimeToMine.Seconds(),
+		Pending:                  stats.Pending,
+		Hours:                    make([]api.StatsHour, 0, len(stats.Hours)),
+		TimeToMine:               make([]api.TimeToMineBucket, 0, len(stats.TimeToMine)),
+		Timestamp:                now.UTC(),
+	}
+
+	for _, h := range stats.Hours {
+		result.Hours = append(result.Hours, api.StatsHour{
+			Start:          h.Start,
+			Accepted:       h.Accepted,
+			Mined:          h.Mined,
+			AverageFeeRate: h.AverageFeeRate,
+		})
+	}
+
+	for _, bucket := range stats.TimeToMine {
+		apiBucket := api.TimeToMineBucket{Count: bucket.Count}
+		if bucket.UpperBound > 0 {
+			apiBucket.UpperBoundSeconds = PtrTo(uint64(bucket.UpperBound.Seconds()))
+		}
+		result.TimeToMine = append(result.TimeToMine, apiBucket)
+	}
+
+	return result
+}
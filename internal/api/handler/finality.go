@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// WithHoldNonFinal accepts the submitted transactions which are not final instead of rejecting them with 482. They are
+// stored by metamorph with the status WAITING_FOR_FINALITY and announced to the network once their lock time is
+// reached by the block height or the median time past.
+func WithHoldNonFinal(holdNonFinal bool) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.holdNonFinal = holdNonFinal
+	}
+}
+
+// checkFinality returns an error if the transaction is not final, i.e. it could not be mined in the next block because
+// of its lock time. Non-final transactions which are held are added to the non-final transactions of the options. The
+// check is enforced even if the validation is skipped, as the node would not accept the transaction.
+func (m *ArcDefaultHandler) checkFinality(ctx context.Context, tx *sdkTx.Transaction, options *metamorph.TransactionOptions) *validator.Error {
+	if !validator.HasLockTime(tx) {
+		return nil
+	}
+
+	nextHeight, medianTimePast, err := blocktx.LockTimeBounds(ctx, m.btxClient)
+	if err != nil {
+		// the node decides on the finality of the transaction
+		m.logger.WarnContext(ctx, "Failed to get lock time bounds", slog.String("hash", tx.TxID().String()), slog.String("err", err.Error()))
+		return nil
+	}
+
+	if validator.IsLockTimeReached(tx.LockTime, nextHeight, medianTimePast) {
+		return nil
+	}
+
+	if m.holdNonFinal {
+		if options.NonFinalTxIDs == nil {
+			options.NonFinalTxIDs = make(map[string]bool)
+		}
+		options.NonFinalTxIDs[hexutils.TxID(tx.TxID())] = true
+		return nil
+	}
+
+	return validator.NewError(errors.Join(validator.ErrTxNonFinal, fmt.Errorf("lock time: %d, next block height: %d, median time past: %d", tx.LockTime, nextHeight, medianTimePast.Unix())), api.ErrStatusNonFinal)
+}
+
+// checkBEEFFinality checks the finality of the transactions of the BEEF which are submitted.
+func (m *ArcDefaultHandler) checkBEEFFinality(ctx context.Context, beefTx *sdkTx.Beef, options *metamorph.TransactionOptions) (*sdkTx.Transaction, error) {
+	return checkBEEFTransactions(beefTx, func(tx *sdkTx.Transaction) *validator.Error {
+		return m.checkFinality(ctx, tx, options)
+	})
+}
@@ -3,3 +3,11 @@ package handler
 //go:generate moq -pkg mocks -skip-ensure -out ./mocks/default_validator_mock.go . DefaultValidator
 
 //go:generate moq -pkg mocks -skip-ensure -out ./mocks/beef_validator_mock.go . BeefValidator
+
+//go:generate moq -pkg mocks -skip-ensure -out ./mocks/submission_recorder_mock.go . SubmissionRecorder
+
+//go:generate moq -pkg mocks -skip-ensure -out ./mocks/external_status_node_mock.go . ExternalStatusNode
+
+//go:generate moq -pkg mocks -skip-ensure -out ./mocks/federation_mock.go . Federation
+
+//go:generate moq -pkg mocks -skip-ensure -out ./mocks/mempool_acceptance_node_mock.go . MempoolAcceptanceNode
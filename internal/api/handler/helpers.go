@@ -1,21 +1,68 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"net/http"
+	"strings"
 
 	"github.com/bitcoin-sv/arc/internal/api/dictionary"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	middleware "github.com/oapi-codegen/echo-middleware"
 
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/federation"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/validator"
 	"github.com/bitcoin-sv/arc/pkg/api"
+	apiv2 "github.com/bitcoin-sv/arc/pkg/api/v2"
 )
 
-// CheckSwagger validates the request against the swagger definition.
-func CheckSwagger(e *echo.Echo) *openapi3.T {
-	swagger, err := api.GetSwagger()
+// PathPrefixV2 is the path prefix of the endpoints of version 2 of the API.
+const PathPrefixV2 = "/v2/"
+
+// CheckSwagger validates the request against the swagger definition of the API version of the request. Requests to
+// paths with one of the given prefixes, e.g. the operator dashboard, are not part of the swagger definitions and are not
+// validated. The swagger definition of version 1 is returned.
+func CheckSwagger(e *echo.Echo, unvalidatedPathPrefixes ...string) *openapi3.T {
+	swagger := loadSwagger(api.GetSwagger)
+	swaggerV2 := loadSwagger(apiv2.GetSwagger)
+
+	isUnvalidated := func(path string) bool {
+		for _, prefix := range unvalidatedPathPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Use our validation middleware to check all requests against the OpenAPI schema.
+	e.Use(middleware.OapiRequestValidatorWithOptions(swagger, &middleware.Options{
+		ErrorHandler: validationErrorHandler,
+		Skipper: func(c echo.Context) bool {
+			path := c.Request().URL.Path
+			return isUnvalidated(path) || strings.HasPrefix(path, PathPrefixV2)
+		},
+	}))
+	e.Use(middleware.OapiRequestValidatorWithOptions(swaggerV2, &middleware.Options{
+		ErrorHandler: validationErrorHandler,
+		Skipper: func(c echo.Context) bool {
+			path := c.Request().URL.Path
+			return isUnvalidated(path) || !strings.HasPrefix(path, PathPrefixV2)
+		},
+	}))
+
+	return swagger
+}
+
+func loadSwagger(getSwagger func() (*openapi3.T, error)) *openapi3.T {
+	swagger, err := getSwagger()
 	if err != nil {
 		log.Fatalf(dictionary.GetInternalMessage(dictionary.ErrorLoadingSwaggerSpec), err.Error())
 	}
@@ -26,12 +73,111 @@ func CheckSwagger(e *echo.Echo) *openapi3.T {
 	// Clear out the security requirements, we check this ourselves
 	swagger.Security = nil
 
-	// Use our validation middleware to check all requests against the OpenAPI schema.
-	e.Use(middleware.OapiRequestValidator(swagger))
-
 	return swagger
 }
 
+// validationErrorHandler returns the requests which failed the validation against the swagger definition as problem
+// details with the parameter which failed validation. Other errors, e.g. unknown paths, are returned unchanged.
+func validationErrorHandler(c echo.Context, err *echo.HTTPError) error {
+	if err.Code != http.StatusBadRequest {
+		return err
+	}
+
+	var requestErr *openapi3filter.RequestError
+	if !errors.As(err.Internal, &requestErr) {
+		return problemJSON(c, api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprint(err.Message)))
+	}
+
+	reason := requestErr.Reason
+	if reason == "" && requestErr.Err != nil {
+		reason = requestErr.Err.Error()
+	}
+
+	switch {
+	case requestErr.Parameter != nil:
+		var schemaErr *openapi3.SchemaError
+		if errors.As(requestErr.Err, &schemaErr) {
+			reason = schemaErr.Reason
+		}
+		e := api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprint(err.Message))
+		return problemJSON(c, e.WithInvalidParam(requestErr.Parameter.Name, requestErr.Parameter.In, reason))
+	case requestErr.RequestBody != nil && strings.HasPrefix(requestErr.Reason, "header Content-Type"):
+		e := api.NewErrorFields(api.ErrStatusUnsupportedMediaType, fmt.Sprint(err.Message))
+		return problemJSON(c, e.WithInvalidParam(echo.HeaderContentType, api.InvalidParamInHeader, reason))
+	case requestErr.RequestBody != nil:
+		name := "body"
+		var schemaErr *openapi3.SchemaError
+		if errors.As(requestErr.Err, &schemaErr) {
+			reason = schemaErr.Reason
+			if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+				name = strings.Join(pointer, ".")
+			}
+		}
+		e := api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprint(err.Message))
+		return problemJSON(c, e.WithInvalidParam(name, api.InvalidParamInBody, reason))
+	}
+
+	return problemJSON(c, api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprint(err.Message)))
+}
+
+// problemJSON writes the error as RFC 7807 problem details.
+func problemJSON(ctx echo.Context, e *api.ErrorFields) error {
+	ctx.Response().Header().Set(echo.HeaderContentType, api.MIMEApplicationProblemJSON)
+	return ctx.JSON(e.Status, e)
+}
+
+// newInvalidHeaderError returns the error of a request with an invalid header. The header is derived from the error.
+func newInvalidHeaderError(err error) *api.ErrorFields {
+	e := api.NewErrorFields(api.ErrStatusBadRequest, err.Error())
+
+	var header string
+	switch {
+	case errors.Is(err, ErrMaxTimeoutExceeded):
+		header = "X-MaxTimeout"
+	case errors.Is(err, ErrInvalidCallbackURL), errors.Is(err, ErrCallbackURLNotAcceptable),
+		errors.Is(err, ErrInvalidCallbackList), errors.Is(err, ErrTooManyCallbackURLs):
+		header = "X-CallbackUrl"
+	case errors.Is(err, ErrCallbackVersionInvalid):
+		header = "X-CallbackVersion"
+	case errors.Is(err, ErrInvalidSuppressionWindow):
+		header = "X-CallbackSuppressionWindow"
+	case errors.Is(err, ErrStatusNotSupported):
+		header = "X-WaitFor"
+	case errors.Is(err, ErrInvalidBroadcastTime):
+		header = "X-BroadcastAt"
+	case errors.Is(err, ErrInvalidExpiry):
+		header = "X-ExpiresAt"
+	case errors.Is(err, ErrInvalidAnnounceTo):
+		header = "X-AnnounceTo"
+	default:
+		return e
+	}
+
+	return e.WithInvalidParam(header, api.InvalidParamInHeader, err.Error())
+}
+
+// newInvalidBodyError returns the error of a request of which the body could not be parsed.
+func newInvalidBodyError(err error) *api.ErrorFields {
+	extraInfo := fmt.Sprintf("error parsing transactions from request: %s", err.Error())
+
+	switch {
+	case errors.Is(err, ErrUnsupportedContentType):
+		e := api.NewErrorFields(api.ErrStatusUnsupportedMediaType, extraInfo)
+		return e.WithInvalidParam(echo.HeaderContentType, api.InvalidParamInHeader, err.Error())
+	case errors.Is(err, ErrContentTypeMismatch):
+		e := api.NewErrorFields(api.ErrStatusBadRequest, extraInfo)
+		return e.WithInvalidParam(echo.HeaderContentType, api.InvalidParamInHeader, err.Error())
+	case errors.Is(err, ErrInvalidRawTx):
+		e := api.NewErrorFields(api.ErrStatusBadRequest, extraInfo)
+		return e.WithInvalidParam("rawTx", api.InvalidParamInBody, err.Error())
+	case errors.Is(err, ErrEmptyBody):
+		e := api.NewErrorFields(api.ErrStatusBadRequest, extraInfo)
+		return e.WithInvalidParam("body", api.InvalidParamInBody, err.Error())
+	}
+
+	return api.NewErrorFields(api.ErrStatusBadRequest, extraInfo)
+}
+
 func filterStatusesByTxIDs(txIDs []string, statuses []*metamorph.TransactionStatus) []*metamorph.TransactionStatus {
 	if len(txIDs) == 1 && len(statuses) == 1 { // optimization for a common scenario
 		if statuses[0] != nil && statuses[0].TxID == txIDs[0] {
@@ -55,3 +201,254 @@ func filterStatusesByTxIDs(txIDs []string, statuses []*metamorph.TransactionStat
 
 	return filteredStatuses
 }
+
+func toAPIFeeDetails(feeDetails *validator.FeeDetails) *api.FeeDetails {
+	rules := make([]api.FeeRule, 0, len(feeDetails.Rules))
+	for _, rule := range feeDetails.Rules {
+		rules = append(rules, api.FeeRule{
+			Rule:          rule.Rule,
+			SatoshisPerKB: rule.SatoshisPerKB,
+			Size:          rule.Size,
+			ActualFee:     rule.ActualFee,
+			RequiredFee:   rule.RequiredFee,
+		})
+	}
+
+	return &api.FeeDetails{
+		Size:        feeDetails.Size,
+		ActualFee:   feeDetails.ActualFee,
+		RequiredFee: feeDetails.RequiredFee,
+		Rules:       rules,
+	}
+}
+
+func toAPIWarnings(warnings []validator.Warning) *[]api.Warning {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	result := make([]api.Warning, 0, len(warnings))
+	for _, warning := range warnings {
+		result = append(result, api.Warning{
+			Code:    api.WarningCode(warning.Code),
+			Message: warning.Message,
+		})
+	}
+
+	return &result
+}
+
+func toAPIStageTimings(timings []metamorph.StageTiming) *[]api.StageTiming {
+	if len(timings) == 0 {
+		return nil
+	}
+
+	result := make([]api.StageTiming, 0, len(timings))
+	for _, timing := range timings {
+		result = append(result, api.StageTiming{
+			Stage:     api.StageTimingStage(timing.Stage),
+			Timestamp: timing.Timestamp,
+			LatencyMs: timing.Latency.Milliseconds(),
+		})
+	}
+
+	return &result
+}
+
+func toAPIPeerAcks(acks []metamorph.PeerAck) *[]api.PeerAck {
+	if len(acks) == 0 {
+		return nil
+	}
+
+	result := make([]api.PeerAck, 0, len(acks))
+	for _, ack := range acks {
+		apiAck := api.PeerAck{Peer: ack.Peer}
+		if !ack.RequestedAt.IsZero() {
+			apiAck.RequestedAt = &ack.RequestedAt
+		}
+		if !ack.SentAt.IsZero() {
+			apiAck.SentAt = &ack.SentAt
+		}
+		result = append(result, apiAck)
+	}
+
+	return &result
+}
+
+func toAPIAnnotations(annotations []metamorph.Annotation) *[]api.Annotation {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	result := make([]api.Annotation, 0, len(annotations))
+	for _, annotation := range annotations {
+		labels := annotation.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+		result = append(result, api.Annotation{
+			Note:      annotation.Note,
+			Labels:    labels,
+			Author:    annotation.Author,
+			CreatedAt: annotation.CreatedAt,
+		})
+	}
+
+	return &result
+}
+
+func toAPIBlockTemplate(template *metamorph.BlockTemplate) *api.BlockTemplate {
+	if template == nil {
+		return nil
+	}
+
+	return &api.BlockTemplate{
+		Source:            template.Source,
+		PreviousBlockHash: template.PreviousBlockHash,
+		Timestamp:         template.Timestamp,
+	}
+}
+
+func toAPINodeSubmission(submission *metamorph.NodeSubmission) *api.NodeSubmission {
+	if submission == nil {
+		return nil
+	}
+
+	result := &api.NodeSubmission{
+		SubmittedAt: submission.SubmittedAt,
+		Accepted:    submission.Accepted,
+	}
+	if submission.RejectReason != "" {
+		result.RejectReason = &submission.RejectReason
+	}
+
+	return result
+}
+
+func toAPIOutpointSpent(txID string, vout int, spender *metamorph.OutpointSpender) api.OutpointSpent {
+	result := api.OutpointSpent{
+		Txid:  txID,
+		Vout:  vout,
+		Spent: spender.Spent,
+	}
+
+	if spender.Spent {
+		result.SpendingTxid = &spender.SpendingTxID
+		result.TxStatus = &spender.Status
+	}
+
+	return result
+}
+
+func toAPIUpstreamAcknowledgements(txID string, acks []federation.Acknowledgement) api.UpstreamAcknowledgements {
+	result := api.UpstreamAcknowledgements{
+		Txid:      txID,
+		Upstreams: make([]api.UpstreamAcknowledgement, 0, len(acks)),
+	}
+
+	for _, ack := range acks {
+		if ack.Acknowledged {
+			result.Acknowledged++
+		}
+		result.Upstreams = append(result.Upstreams, toAPIUpstreamAcknowledgement(ack))
+	}
+
+	return result
+}
+
+func toAPIFederationResult(federationResult *federation.Result) *api.FederationResult {
+	result := &api.FederationResult{
+		Policy:       string(federationResult.Policy),
+		Required:     federationResult.Required,
+		Satisfied:    federationResult.Satisfied,
+		Acknowledged: federationResult.Acknowledged,
+		Upstreams:    make([]api.UpstreamAcknowledgement, 0, len(federationResult.Upstreams)),
+	}
+
+	for _, ack := range federationResult.Upstreams {
+		result.Upstreams = append(result.Upstreams, toAPIUpstreamAcknowledgement(ack))
+	}
+
+	return result
+}
+
+func toAPIUpstreamAcknowledgement(ack federation.Acknowledgement) api.UpstreamAcknowledgement {
+	upstream := api.UpstreamAcknowledgement{
+		Name:         ack.Upstream,
+		Acknowledged: ack.Acknowledged,
+		Status:       ack.Status,
+		Timestamp:    ack.Timestamp,
+	}
+	if ack.TxStatus != "" {
+		upstream.TxStatus = &ack.TxStatus
+	}
+	if ack.ExtraInfo != "" {
+		upstream.ExtraInfo = &ack.ExtraInfo
+	}
+
+	return upstream
+}
+
+func toAPITransactionGraph(graph *metamorph.TransactionGraph) api.TransactionGraph {
+	result := api.TransactionGraph{
+		Txid:         graph.TxID,
+		Transactions: make([]api.TransactionGraphNode, 0, len(graph.Nodes)),
+		Truncated:    graph.Truncated,
+	}
+
+	for _, node := range graph.Nodes {
+		apiNode := api.TransactionGraphNode{
+			Txid:     node.TxID,
+			TxStatus: node.Status,
+			Parents:  node.Parents,
+			Children: node.Children,
+		}
+
+		if apiNode.Parents == nil {
+			apiNode.Parents = []string{}
+		}
+		if apiNode.Children == nil {
+			apiNode.Children = []string{}
+		}
+		if node.RejectReason != "" {
+			apiNode.ExtraInfo = &node.RejectReason
+		}
+		if node.Size > 0 {
+			apiNode.Size = &node.Size
+		}
+		if node.FeeKnown {
+			apiNode.Fee = &node.Fee
+		}
+
+		result.Transactions = append(result.Transactions, apiNode)
+	}
+
+	return result
+}
+
+func toAPIBlocks(blocks []*blocktx_api.Block) api.Blocks {
+	result := api.Blocks{Blocks: make([]api.Block, 0, len(blocks))}
+
+	for _, block := range blocks {
+		apiBlock := api.Block{
+			BlockHeight:    block.GetHeight(),
+			CoinbaseReward: block.GetCoinbaseReward(),
+			MinerTag:       block.GetMinerTag(),
+		}
+
+		blockHash, err := chainhash.NewHash(block.GetHash())
+		if err == nil {
+			apiBlock.BlockHash = blockHash.String()
+		}
+		if block.GetTimestamp() != nil {
+			apiBlock.Timestamp = PtrTo(block.GetTimestamp().AsTime())
+		}
+		if block.GetProcessedAt() != nil {
+			apiBlock.ProcessedAt = PtrTo(block.GetProcessedAt().AsTime())
+		}
+
+		result.Blocks = append(result.Blocks, apiBlock)
+	}
+
+	return result
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -10,8 +12,10 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/pkg/api"
 )
 
 func TestCheckSwagger(t *testing.T) {
@@ -37,6 +41,336 @@ func TestCheckSwagger(t *testing.T) {
 	}
 }
 
+func TestCheckSwagger_UnvalidatedPathPrefixes(t *testing.T) {
+	tt := []struct {
+		name string
+		path string
+
+		expectedStatus int
+	}{
+		{
+			name: "path not in swagger definition",
+			path: "/unknown",
+
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "unvalidated path prefix",
+			path: "/dashboard/api/summary",
+
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			CheckSwagger(e, "/dashboard")
+			e.GET(tc.path, func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			// when
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+
+			// then
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestCheckSwagger_ValidationErrors(t *testing.T) {
+	tt := []struct {
+		name        string
+		contentType string
+		headers     map[string]string
+
+		expectedStatus        int
+		expectedInvalidParams []api.InvalidParam
+	}{
+		{
+			name:        "valid request",
+			contentType: echo.MIMETextPlain,
+			headers:     map[string]string{"X-MaxTimeout": "30"},
+
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "max timeout too high",
+			contentType: echo.MIMETextPlain,
+			headers:     map[string]string{"X-MaxTimeout": "31"},
+
+			expectedStatus:        http.StatusBadRequest,
+			expectedInvalidParams: []api.InvalidParam{{Name: "X-MaxTimeout", In: api.InvalidParamInHeader, Reason: "number must be at most 30"}},
+		},
+		{
+			name:        "max timeout not a number",
+			contentType: echo.MIMETextPlain,
+			headers:     map[string]string{"X-MaxTimeout": "abc"},
+
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: echo.MIMEApplicationXML,
+
+			expectedStatus: http.StatusUnsupportedMediaType,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			CheckSwagger(e)
+			e.POST("/v1/tx", func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/tx", strings.NewReader(validTx))
+			req.Header.Set(echo.HeaderContentType, tc.contentType)
+			for key, value := range tc.headers {
+				req.Header.Set(key, value)
+			}
+
+			// when
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedStatus == http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, api.MIMEApplicationProblemJSON, rec.Header().Get(echo.HeaderContentType))
+
+			var actual api.ErrorFields
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &actual))
+			assert.Equal(t, tc.expectedStatus, actual.Status)
+			require.NotNil(t, actual.InvalidParams)
+			if tc.expectedInvalidParams != nil {
+				assert.Equal(t, tc.expectedInvalidParams, *actual.InvalidParams)
+			}
+		})
+	}
+}
+
+func TestCheckSwagger_Versions(t *testing.T) {
+	tt := []struct {
+		name       string
+		path       string
+		maxTimeout string
+
+		expectedStatus int
+	}{
+		{
+			name:       "v1 - valid request",
+			path:       "/v1/tx",
+			maxTimeout: "30",
+
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "v1 - invalid request",
+			path:       "/v1/tx",
+			maxTimeout: "31",
+
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "v2 - valid request",
+			path:       "/v2/tx",
+			maxTimeout: "30",
+
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:       "v2 - invalid request",
+			path:       "/v2/tx",
+			maxTimeout: "31",
+
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown version",
+			path:       "/v3/tx",
+			maxTimeout: "30",
+
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			CheckSwagger(e)
+			e.POST(tc.path, func(c echo.Context) error {
+				return c.NoContent(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(validTx))
+			req.Header.Set(echo.HeaderContentType, echo.MIMETextPlain)
+			req.Header.Set("X-MaxTimeout", tc.maxTimeout)
+
+			// when
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestRouteNetworks(t *testing.T) {
+	tt := []struct {
+		name       string
+		path       string
+		network    string
+		maxTimeout string
+
+		expectedStatus  int
+		expectedNetwork string
+	}{
+		{
+			name:       "default network",
+			path:       "/v1/tx",
+			maxTimeout: "30",
+
+			expectedStatus:  http.StatusOK,
+			expectedNetwork: "default",
+		},
+		{
+			name:       "network path segment",
+			path:       "/testnet/v1/tx",
+			maxTimeout: "30",
+
+			expectedStatus:  http.StatusOK,
+			expectedNetwork: "testnet",
+		},
+		{
+			name:       "network path segment - v2",
+			path:       "/testnet/v2/tx",
+			maxTimeout: "30",
+
+			expectedStatus:  http.StatusOK,
+			expectedNetwork: "testnet",
+		},
+		{
+			name:       "network path segment - invalid request",
+			path:       "/testnet/v1/tx",
+			maxTimeout: "31",
+
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "network header",
+			path:       "/v1/tx",
+			network:    "testnet",
+			maxTimeout: "30",
+
+			expectedStatus:  http.StatusOK,
+			expectedNetwork: "testnet",
+		},
+		{
+			name:       "network header - default network",
+			path:       "/v1/tx",
+			network:    "mainnet",
+			maxTimeout: "30",
+
+			expectedStatus:  http.StatusOK,
+			expectedNetwork: "mainnet",
+		},
+		{
+			name:       "network header - unknown network",
+			path:       "/v1/tx",
+			network:    "stn",
+			maxTimeout: "30",
+
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			e := echo.New()
+			RouteNetworks(e, "mainnet", []string{"testnet"})
+			CheckSwagger(e)
+			for path, network := range map[string]string{"/v1/tx": "default", "/mainnet/v1/tx": "mainnet", "/testnet/v1/tx": "testnet", "/testnet/v2/tx": "testnet"} {
+				e.POST(path, func(c echo.Context) error {
+					return c.String(http.StatusOK, network)
+				})
+			}
+
+			req := httptest.NewRequest(http.MethodPost, tc.path, strings.NewReader(validTx))
+			req.Header.Set(echo.HeaderContentType, echo.MIMETextPlain)
+			req.Header.Set("X-MaxTimeout", tc.maxTimeout)
+			if tc.network != "" {
+				req.Header.Set(NetworkHeader, tc.network)
+			}
+
+			// when
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedNetwork != "" {
+				assert.Equal(t, tc.expectedNetwork, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestNewInvalidHeaderError(t *testing.T) {
+	tt := []struct {
+		name string
+		err  error
+
+		expectedInvalidParams *[]api.InvalidParam
+	}{
+		{
+			name: "invalid callback URL",
+			err:  errors.Join(ErrInvalidCallbackURL, errors.New("parse error")),
+
+			expectedInvalidParams: &[]api.InvalidParam{{Name: "X-CallbackUrl", In: api.InvalidParamInHeader, Reason: "invalid callback URL\nparse error"}},
+		},
+		{
+			name: "status not supported",
+			err:  errors.Join(ErrStatusNotSupported, errors.New("status: MINING")),
+
+			expectedInvalidParams: &[]api.InvalidParam{{Name: "X-WaitFor", In: api.InvalidParamInHeader, Reason: "status not supported\nstatus: MINING"}},
+		},
+		{
+			name: "max timeout exceeded",
+			err:  ErrMaxTimeoutExceeded,
+
+			expectedInvalidParams: &[]api.InvalidParam{{Name: "X-MaxTimeout", In: api.InvalidParamInHeader, Reason: ErrMaxTimeoutExceeded.Error()}},
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("some error"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := newInvalidHeaderError(tc.err)
+
+			// then
+			assert.Equal(t, int(api.ErrStatusBadRequest), actual.Status)
+			assert.Equal(t, tc.err.Error(), *actual.ExtraInfo)
+			assert.Equal(t, tc.expectedInvalidParams, actual.InvalidParams)
+		})
+	}
+}
+
 func TestFilterStatusesByTxIDs(t *testing.T) {
 	tcs := []struct {
 		name     string
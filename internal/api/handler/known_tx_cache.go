@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+)
+
+type knownTxEntry struct {
+	status   *metamorph.TransactionStatus
+	storedAt time.Time
+}
+
+// knownTxCache keeps the statuses of transactions which were found to be processed already for a short time, so that
+// repeated submissions of the same transactions, e.g. retry storms of clients, are answered without looking up the
+// statuses in metamorph and its database again.
+type knownTxCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu         sync.Mutex
+	entries    map[string]knownTxEntry
+	lastPurged time.Time
+}
+
+func newKnownTxCache(ttl time.Duration, now func() time.Time) *knownTxCache {
+	return &knownTxCache{
+		ttl:        ttl,
+		now:        now,
+		entries:    make(map[string]knownTxEntry),
+		lastPurged: now(),
+	}
+}
+
+// get returns the cached statuses of the transactions if all of them are cached.
+func (c *knownTxCache) get(txIDs []string) ([]*metamorph.TransactionStatus, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	statuses := make([]*metamorph.TransactionStatus, 0, len(txIDs))
+	for _, txID := range txIDs {
+		entry, found := c.entries[txID]
+		if !found || now.Sub(entry.storedAt) > c.ttl {
+			return nil, false
+		}
+		statuses = append(statuses, entry.status)
+	}
+
+	return statuses, true
+}
+
+func (c *knownTxCache) set(statuses []*metamorph.TransactionStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	for _, status := range statuses {
+		c.entries[status.TxID] = knownTxEntry{status: status, storedAt: now}
+	}
+
+	// purge expired entries once per ttl
+	if now.Sub(c.lastPurged) < c.ttl {
+		return
+	}
+	for txID, entry := range c.entries {
+		if now.Sub(entry.storedAt) > c.ttl {
+			delete(c.entries, txID)
+		}
+	}
+	c.lastPurged = now
+}
+
+// remove removes the transactions which are processed again, e.g. because of new callbacks, as their cached statuses
+// are outdated.
+func (c *knownTxCache) remove(txIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, txID := range txIDs {
+		delete(c.entries, txID)
+	}
+}
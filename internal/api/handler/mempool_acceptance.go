@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/node_reject"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// MempoolAcceptanceNode tests on the node whether it would accept transactions into its mempool without broadcasting
+// them. It returns the first of the transactions the node would reject and the reason of the node.
+type MempoolAcceptanceNode interface {
+	TestMempoolAccept(ctx context.Context, txs []*sdkTx.Transaction) (*sdkTx.Transaction, *node_reject.Reason, error)
+}
+
+// WithMempoolAcceptance tests the submitted transactions which passed the validation on the node before they are
+// announced to the network, so that the transactions which ARC accepts but the node rejects because of a mismatch of
+// their policies are rejected with the precise reason of the node. The transactions are accepted if the node cannot
+// test them.
+func WithMempoolAcceptance(node MempoolAcceptanceNode) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.mempoolAcceptance = node
+	}
+}
+
+// checkMempoolAcceptance returns the first of the transactions the node would reject and the reason of the node.
+func (m *ArcDefaultHandler) checkMempoolAcceptance(ctx context.Context, txs []*sdkTx.Transaction) (*sdkTx.Transaction, error) {
+	if m.mempoolAcceptance == nil || len(txs) == 0 {
+		return nil, nil
+	}
+
+	rejectedTx, reason, err := m.mempoolAcceptance.TestMempoolAccept(ctx, txs)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to test mempool acceptance on node", slog.String("hash", txs[len(txs)-1].TxID().String()), slog.String("err", err.Error()))
+		return nil, nil
+	}
+
+	if reason == nil {
+		return nil, nil
+	}
+
+	// the parents of the transaction may not have reached the node yet, ARC validated the inputs already
+	if reason.Status == api.ErrStatusInputs {
+		m.logger.DebugContext(ctx, "Inputs of transaction unknown to node in mempool acceptance check", slog.String("hash", rejectedTx.TxID().String()), slog.String("reason", reason.Text))
+		return nil, nil
+	}
+
+	m.logger.WarnContext(ctx, "Policy mismatch, transaction valid for ARC is rejected by node", slog.String("hash", rejectedTx.TxID().String()), slog.Int("status", int(reason.Status)), slog.String("reason", reason.Text))
+	if m.stats != nil {
+		m.stats.AddPolicyMismatch(reason.Status)
+	}
+
+	return rejectedTx, reason
+}
+
+// checkBEEFMempoolAcceptance tests the transactions of the BEEF which are submitted, in the order of the BEEF.
+func (m *ArcDefaultHandler) checkBEEFMempoolAcceptance(ctx context.Context, beefTx *sdkTx.Beef) (*sdkTx.Transaction, error) {
+	var txs []*sdkTx.Transaction
+	for _, tx := range beefTx.Transactions {
+		if tx.DataFormat == sdkTx.RawTx || (tx.DataFormat == sdkTx.RawTxAndBumpIndex && len(beefTx.Transactions) == 1) {
+			txs = append(txs, tx.Transaction)
+		}
+	}
+
+	return m.checkMempoolAcceptance(ctx, txs)
+}
@@ -0,0 +1,100 @@
+package merkle_verifier
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	authhttp "github.com/bsv-blockchain/go-sdk/auth/clients/authhttp"
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/bsv-blockchain/go-sdk/wallet"
+)
+
+var ErrInvalidPrivateKey = errors.New("invalid private key")
+
+// HTTPClient sends the requests of the authentication strategies which authenticate by headers.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Authenticator sends the requests to a block header service with the authentication the service requires.
+type Authenticator interface {
+	Do(client HTTPClient, req *http.Request) (*http.Response, error)
+}
+
+// BearerAuth authenticates the requests with a bearer token in the Authorization header.
+type BearerAuth struct {
+	token string
+}
+
+func NewBearerAuth(token string) *BearerAuth {
+	return &BearerAuth{token: token}
+}
+
+func (a *BearerAuth) Do(client HTTPClient, req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", "Bearer "+a.token)
+	return client.Do(req)
+}
+
+// BasicAuth authenticates the requests with username and password in the Authorization header.
+type BasicAuth struct {
+	username string
+	password string
+}
+
+func NewBasicAuth(username string, password string) *BasicAuth {
+	return &BasicAuth{username: username, password: password}
+}
+
+func (a *BasicAuth) Do(client HTTPClient, req *http.Request) (*http.Response, error) {
+	req.SetBasicAuth(a.username, a.password)
+	return client.Do(req)
+}
+
+// MutualAuth authenticates the requests by signing them with the identity key of ARC according to BRC-31 and BRC-104.
+// The session with a service is established by a handshake on the first request. The requests are sent by the
+// authenticated client of the SDK and not by the HTTP client, hence they are not retried.
+type MutualAuth struct {
+	fetch *authhttp.AuthFetch
+}
+
+// NewMutualAuth returns the mutual authentication with the identity key, a private key in WIF or hex format.
+func NewMutualAuth(privateKey string) (*MutualAuth, error) {
+	key, err := ec.PrivateKeyFromWif(privateKey)
+	if err != nil {
+		key, err = ec.PrivateKeyFromHex(privateKey)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidPrivateKey, err)
+		}
+	}
+
+	identity, err := wallet.NewCompletedProtoWallet(key)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPrivateKey, err)
+	}
+
+	return &MutualAuth{fetch: authhttp.New(identity, nil, nil)}, nil
+}
+
+func (a *MutualAuth) Do(_ HTTPClient, req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+
+	headers := make(map[string]string, len(req.Header))
+	for key := range req.Header {
+		headers[key] = req.Header.Get(key)
+	}
+
+	return a.fetch.Fetch(req.Context(), req.URL.String(), &authhttp.SimplifiedFetchRequestOptions{
+		Method:  req.Method,
+		Headers: headers,
+		Body:    body,
+	})
+}
@@ -0,0 +1,101 @@
+package merkle_verifier
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticator_Do(t *testing.T) {
+	tt := []struct {
+		name string
+		auth Authenticator
+
+		expectedAuthorization string
+	}{
+		{
+			name: "bearer",
+			auth: NewBearerAuth("abc"),
+
+			expectedAuthorization: "Bearer abc",
+		},
+		{
+			name: "basic",
+			auth: NewBasicAuth("arc", "secret"),
+
+			expectedAuthorization: "Basic YXJjOnNlY3JldA==",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var actualAuthorization string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actualAuthorization = r.Header.Get("Authorization")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/status", nil)
+			require.NoError(t, err)
+
+			// when
+			resp, err := tc.auth.Do(http.DefaultClient, req)
+
+			// then
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, tc.expectedAuthorization, actualAuthorization)
+		})
+	}
+}
+
+func TestNewMutualAuth(t *testing.T) {
+	key, err := ec.NewPrivateKey()
+	require.NoError(t, err)
+
+	tt := []struct {
+		name       string
+		privateKey string
+
+		expectedErr error
+	}{
+		{
+			name:       "WIF",
+			privateKey: key.Wif(),
+		},
+		{
+			name:       "hex",
+			privateKey: hex.EncodeToString(key.Serialize()),
+		},
+		{
+			name:       "invalid",
+			privateKey: "not a key",
+
+			expectedErr: ErrInvalidPrivateKey,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := NewMutualAuth(tc.privateKey)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, actual)
+		})
+	}
+}
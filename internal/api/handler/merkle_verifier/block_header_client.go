@@ -10,6 +10,7 @@ import (
 	"log/slog"
 	"net"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
@@ -17,7 +18,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bitcoin-sv/arc/internal/api/handler"
+	"github.com/bitcoin-sv/arc/internal/supervisor"
 	"github.com/bitcoin-sv/arc/internal/validator/beef"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 
 	bhsDomains "github.com/bitcoin-sv/block-headers-service/domains"
@@ -55,12 +58,37 @@ func WithStats(stats *handler.Stats) func(*Client) {
 	}
 }
 
+func WithNow(nowFunc func() time.Time) Option {
+	return func(client *Client) {
+		client.now = nowFunc
+	}
+}
+
+// AvailabilityEvent is published when a block header service becomes available or unavailable. Reason is the error
+// of the status check which found the service unavailable. LastSuccess is the time of the last successful request to
+// the service, zero if no request succeeded yet.
+type AvailabilityEvent struct {
+	URL         string
+	Available   bool
+	Reason      string
+	Timestamp   time.Time
+	LastSuccess time.Time
+}
+
 type ChainTracker struct {
-	url    string
-	apiKey string
+	url  string
+	auth Authenticator
 
 	mu          sync.Mutex
 	isAvailable bool
+	reason      string
+	lastSuccess time.Time
+
+	// throttledUntil is the time until which the service is not requested because it rate limited the requests, pace
+	// the interval between the requests which is adapted to the rate limits of the service
+	throttledUntil time.Time
+	pace           time.Duration
+	nextRequest    time.Time
 }
 
 func (ct *ChainTracker) IsAvailable() bool {
@@ -75,12 +103,67 @@ func (ct *ChainTracker) SetAvailability(availability bool) {
 	ct.isAvailable = availability
 }
 
-func NewChainTracker(url string, apiKey string) *ChainTracker {
-	return &ChainTracker{
+// LastSuccess returns the time of the last successful request to the block header service.
+func (ct *ChainTracker) LastSuccess() time.Time {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return ct.lastSuccess
+}
+
+func (ct *ChainTracker) recordSuccess(t time.Time) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.lastSuccess = t
+}
+
+// updateAvailability sets the availability and the reason of unavailability and returns whether the availability
+// changed.
+func (ct *ChainTracker) updateAvailability(availability bool, reason string) bool {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	changed := ct.isAvailable != availability
+	ct.isAvailable = availability
+	ct.reason = reason
+	return changed
+}
+
+func (ct *ChainTracker) status(now time.Time) handler.BlockHeaderServiceStatus {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	status := handler.BlockHeaderServiceStatus{
+		URL:         ct.url,
+		Available:   ct.isAvailable,
+		LastSuccess: ct.lastSuccess,
+		Reason:      ct.reason,
+	}
+	if now.Before(ct.throttledUntil) {
+		status.ThrottledUntil = ct.throttledUntil
+	}
+	return status
+}
+
+type ChainTrackerOption func(*ChainTracker)
+
+// WithAuth sets the authentication of the requests to the block header service, which is a bearer token with the API
+// key by default.
+func WithAuth(auth Authenticator) ChainTrackerOption {
+	return func(ct *ChainTracker) {
+		ct.auth = auth
+	}
+}
+
+func NewChainTracker(url string, apiKey string, opts ...ChainTrackerOption) *ChainTracker {
+	ct := &ChainTracker{
 		url:         url,
-		apiKey:      apiKey,
+		auth:        NewBearerAuth(apiKey),
 		isAvailable: true,
 	}
+
+	for _, opt := range opts {
+		opt(ct)
+	}
+
+	return ct
 }
 
 type Client struct {
@@ -92,8 +175,22 @@ type Client struct {
 	cancelAll                  context.CancelFunc
 	ctx                        context.Context
 	wg                         *sync.WaitGroup
-	chainTrackers              []*ChainTracker
 	stats                      *handler.Stats
+	httpClient                 *httpclient.Client
+	now                        func() time.Time
+	verificationPolicy         VerificationPolicy
+	negativeCache              *NegativeCache
+
+	// chainTrackersMu guards the chain trackers, which are replaced by the discovery of the block header services
+	chainTrackersMu               sync.RWMutex
+	chainTrackers                 []*ChainTracker
+	discoverer                    Discoverer
+	newChainTracker               func(url string) *ChainTracker
+	discovered                    map[string]struct{}
+	discoverChainTrackersInterval time.Duration
+
+	listenersMu sync.RWMutex
+	listeners   []func(AvailabilityEvent)
 }
 
 func NewClient(logger *slog.Logger, chainTrackers []*ChainTracker, opts ...Option) *Client {
@@ -102,6 +199,10 @@ func NewClient(logger *slog.Logger, chainTrackers []*ChainTracker, opts ...Optio
 		logger:                     logger,
 		checkChainTrackersInterval: checkChainTrackersIntervalDefault,
 		wg:                         &sync.WaitGroup{},
+		now:                        time.Now,
+
+		discovered:                    make(map[string]struct{}),
+		discoverChainTrackersInterval: discoverChainTrackersIntervalDefault,
 	}
 
 	c.chainTrackers = chainTrackers
@@ -114,8 +215,24 @@ func NewClient(logger *slog.Logger, chainTrackers []*ChainTracker, opts ...Optio
 		opt(c)
 	}
 
+	c.httpClient = httpclient.New("block-header-service")
+
 	c.StartRoutine(c.checkChainTrackersInterval, checkChainTrackers, "checkChainTrackers")
 
+	if c.discoverer != nil {
+		// the services are discovered once right away, so that they need not be awaited for an interval
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			discoverChainTrackers(c.ctx, c)
+		}()
+		c.StartRoutine(c.discoverChainTrackersInterval, discoverChainTrackers, "discoverChainTrackers")
+	}
+
+	if c.negativeCache != nil {
+		c.StartRoutine(negativeCachePersistInterval, persistNegativeCache, "persistNegativeCache")
+	}
+
 	return c
 }
 
@@ -129,30 +246,80 @@ func (c *Client) StartRoutine(tickerInterval time.Duration, routine func(context
 			ticker.Stop()
 		}()
 
+		restarter := supervisor.NewRestarter(c.logger, routineName)
 		for {
 			select {
 			case <-c.ctx.Done():
 				return
 			case <-ticker.C:
-				ctx, span := tracing.StartTracing(c.ctx, routineName, c.tracingEnabled, c.tracingAttributes...)
-				attr := routine(ctx, c)
-				if span != nil && len(attr) > 0 {
-					span.SetAttributes(attr...)
-				}
-				tracing.EndTracing(span, nil)
+				restarter.Run(c.ctx, func() {
+					ctx, span := tracing.StartTracing(c.ctx, routineName, c.tracingEnabled, c.tracingAttributes...)
+					defer tracing.EndTracing(span, nil)
+
+					attr := routine(ctx, c)
+					if span != nil && len(attr) > 0 {
+						span.SetAttributes(attr...)
+					}
+				})
 			}
 		}
 	}()
 }
 
+// Subscribe registers the listener for the availability events of the block header services. The listeners are
+// called synchronously by the routine checking the services and must not block.
+func (c *Client) Subscribe(listener func(AvailabilityEvent)) {
+	c.listenersMu.Lock()
+	defer c.listenersMu.Unlock()
+	c.listeners = append(c.listeners, listener)
+}
+
+func (c *Client) publish(event AvailabilityEvent) {
+	c.listenersMu.RLock()
+	defer c.listenersMu.RUnlock()
+	for _, listener := range c.listeners {
+		listener(event)
+	}
+}
+
+// getChainTrackers returns the current chain trackers, the static ones followed by the discovered ones.
+func (c *Client) getChainTrackers() []*ChainTracker {
+	c.chainTrackersMu.RLock()
+	defer c.chainTrackersMu.RUnlock()
+	return slices.Clone(c.chainTrackers)
+}
+
+// BlockHeaderServiceStatuses returns the availability and the time of the last successful request of each block
+// header service.
+func (c *Client) BlockHeaderServiceStatuses() []handler.BlockHeaderServiceStatus {
+	chainTrackers := c.getChainTrackers()
+	statuses := make([]handler.BlockHeaderServiceStatus, 0, len(chainTrackers))
+	for _, ct := range chainTrackers {
+		statuses = append(statuses, ct.status(c.now()))
+	}
+	return statuses
+}
+
 func checkChainTrackers(ctx context.Context, c *Client) []attribute.KeyValue {
 	availableChaintrackers := 0
-	for _, ct := range c.chainTrackers {
-		isAvailable, err := c.isServiceAvailable(ctx, ct.url, ct.apiKey)
+	chainTrackers := c.getChainTrackers()
+	for _, ct := range chainTrackers {
+		if ct.isThrottled(c.now()) {
+			// the status is not checked in order not to extend the rate limit of the service
+			if ct.IsAvailable() {
+				availableChaintrackers++
+			}
+			continue
+		}
 
+		isAvailable, err := c.isServiceAvailable(ctx, ct)
+
+		var reason string
 		if err != nil {
-			c.logger.Warn("Block header service unavailable", slog.String("url", ct.url), slog.Bool("isAvailable", isAvailable), slog.String("err", err.Error()))
+			reason = err.Error()
+			c.logger.Warn("Block header service unavailable", slog.String("url", ct.url), slog.Bool("isAvailable", isAvailable), slog.String("err", reason))
 		} else {
+			ct.recordSuccess(c.now())
 			c.logger.Debug("Block header service available", slog.String("url", ct.url), slog.Bool("isAvailable", isAvailable))
 		}
 
@@ -160,31 +327,43 @@ func checkChainTrackers(ctx context.Context, c *Client) []attribute.KeyValue {
 			availableChaintrackers++
 		}
 
-		ct.SetAvailability(isAvailable)
+		if ct.updateAvailability(isAvailable, reason) {
+			c.publish(AvailabilityEvent{
+				URL:         ct.url,
+				Available:   isAvailable,
+				Reason:      reason,
+				Timestamp:   c.now(),
+				LastSuccess: ct.LastSuccess(),
+			})
+		}
 	}
 
 	if c.stats != nil {
 		c.stats.AvailableBlockHeaderServices.Set(float64(availableChaintrackers))
-		c.stats.UnavailableBlockHeaderServices.Set(float64(len(c.chainTrackers) - availableChaintrackers))
+		c.stats.UnavailableBlockHeaderServices.Set(float64(len(chainTrackers) - availableChaintrackers))
 	}
+	c.updateThrottledStats()
 	return []attribute.KeyValue{}
 }
 
-func (c *Client) isServiceAvailable(ctx context.Context, url string, apiKey string) (bool, error) {
+func (c *Client) isServiceAvailable(ctx context.Context, ct *ChainTracker) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, statusTimeout)
 	defer cancel()
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url+"/status", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", ct.url+"/status", nil)
 	if err != nil {
 		return false, fmt.Errorf("error creating request: %v", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.do(ct, req)
 	if err != nil {
+		if errors.Is(err, ErrRateLimited) {
+			// the service is reachable, the requests are shifted to the other services until the rate limit expires
+			return true, nil
+		}
+
 		var e net.Error
 		isNetError := errors.As(err, &e)
 		if isNetError && e.Timeout() {
@@ -203,45 +382,69 @@ func (c *Client) isServiceAvailable(ctx context.Context, url string, apiKey stri
 }
 
 func (c *Client) IsValidRootForHeight(ctx context.Context, root *chainhash.Hash, height uint32) (bool, error) {
-	var verificationSuccessful bool
-	var anyChainTrackerAvailable bool
+	if c.negativeCache != nil && c.negativeCache.IsInvalid(root, height) {
+		c.logger.Debug("Merkle root cached as invalid", slog.String("root", root.String()), slog.Uint64("height", uint64(height)))
+		return false, nil
+	}
+
+	var verificationSuccessful, invalid bool
 	var err error
-	for _, ct := range c.chainTrackers {
-		if !ct.IsAvailable() {
-			continue
-		}
+	if c.verificationPolicy != PolicySequential {
+		verificationSuccessful, invalid, err = c.verifyFanOut(ctx, root, height)
+	} else {
+		verificationSuccessful, invalid, err = c.verifySequential(ctx, root, height)
+	}
 
-		anyChainTrackerAvailable = true
+	if err == nil && invalid && c.negativeCache != nil {
+		c.negativeCache.Add(root, height)
+	}
+
+	return verificationSuccessful, err
+}
+
+// verifySequential verifies the Merkle root against one available chain tracker after another until one answers
+// and returns whether it confirmed the Merkle root or found it invalid.
+func (c *Client) verifySequential(ctx context.Context, root *chainhash.Hash, height uint32) (bool, bool, error) {
+	chainTrackers, err := c.usableChainTrackers()
+	if err != nil {
+		return false, false, err
+	}
 
-		verificationSuccessful, err = c.merkleRootVerify(ctx, ct.url, ct.apiKey, root, height)
+	var state bhsDomains.MerkleRootConfirmationState
+	for _, ct := range chainTrackers {
+		state, err = c.merkleRootVerify(ctx, ct, root, height)
 		if err == nil {
+			ct.recordSuccess(c.now())
 			break
 		}
 	}
 
-	if !anyChainTrackerAvailable {
-		return verificationSuccessful, errors.Join(beef.ErrNoChainTrackersAvailable, err)
-	}
-
-	return verificationSuccessful, err
+	return state == bhsDomains.Confirmed, state == bhsDomains.Invalid, err
 }
 
 type State struct {
 	Height uint32 `json:"height"`
 }
 
-func (c *Client) getChainTip(ctx context.Context, url string, apiKey string) (uint32, error) {
+func (c *Client) getChainTip(ctx context.Context, ct *ChainTracker) (uint32, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
-	client := &http.Client{}
-	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/chain/tip/longest", url), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/chain/tip/longest", ct.url), nil)
 	if err != nil {
 		return 0, err
 	}
 
-	req.Header.Set("Authorization", "Bearer "+apiKey)
-	resp, err := client.Do(req)
+	err = c.pace(ctx, ct)
 	if err != nil {
+		return 0, errors.Join(ErrRequestTimedOut, err)
+	}
+
+	resp, err := c.do(ct, req)
+	if err != nil {
+		if errors.Is(err, ErrRateLimited) {
+			return 0, errors.Join(beef.ErrRequestFailed, err)
+		}
+
 		var e net.Error
 		isNetError := errors.As(err, &e)
 		if isNetError && e.Timeout() {
@@ -265,17 +468,16 @@ func (c *Client) getChainTip(ctx context.Context, url string, apiKey string) (ui
 }
 
 func (c *Client) CurrentHeight(ctx context.Context) (uint32, error) {
-	var tip uint32
-	var anyChainTrackerAvailable bool
-	var err error
-	for _, ct := range c.chainTrackers {
-		if !ct.IsAvailable() {
-			continue
-		}
+	chainTrackers, err := c.usableChainTrackers()
+	if err != nil {
+		return 0, err
+	}
 
-		anyChainTrackerAvailable = true
-		tip, err = c.getChainTip(ctx, ct.url, ct.apiKey)
+	var tip uint32
+	for _, ct := range chainTrackers {
+		tip, err = c.getChainTip(ctx, ct)
 		if err == nil {
+			ct.recordSuccess(c.now())
 			break
 		}
 	}
@@ -284,10 +486,6 @@ func (c *Client) CurrentHeight(ctx context.Context) (uint32, error) {
 		return 0, errors.Join(ErrGetChainTip, err)
 	}
 
-	if !anyChainTrackerAvailable {
-		return 0, errors.Join(beef.ErrNoChainTrackersAvailable, err)
-	}
-
 	return tip, nil
 }
 
@@ -295,7 +493,7 @@ type IsValidRootForHeightResponse struct {
 	ConfirmationState bhsDomains.MerkleRootConfirmationState `json:"confirmationState"`
 }
 
-func (c *Client) merkleRootVerify(ctx context.Context, url string, apiKey string, root *chainhash.Hash, height uint32) (bool, error) {
+func (c *Client) merkleRootVerify(ctx context.Context, ct *ChainTracker, root *chainhash.Hash, height uint32) (bhsDomains.MerkleRootConfirmationState, error) {
 	ctx, cancel := context.WithTimeout(ctx, c.timeout)
 	defer cancel()
 
@@ -307,48 +505,55 @@ func (c *Client) merkleRootVerify(ctx context.Context, url string, apiKey string
 	payload := []requestBody{{MerkleRoot: root.String(), BlockHeight: height}}
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return false, fmt.Errorf("error marshaling JSON: %v", err)
+		return "", fmt.Errorf("error marshaling JSON: %v", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", url+"/api/v1/chain/merkleroot/verify", bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, "POST", ct.url+"/api/v1/chain/merkleroot/verify", bytes.NewBuffer(jsonPayload))
 	if err != nil {
-		return false, fmt.Errorf("error creating request: %v", err)
+		return "", fmt.Errorf("error creating request: %v", err)
 	}
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+apiKey)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	err = c.pace(ctx, ct)
+	if err != nil {
+		return "", errors.Join(beef.ErrRequestTimedOut, err)
+	}
+
+	resp, err := c.do(ct, req)
 	if err != nil {
+		if errors.Is(err, ErrRateLimited) {
+			return "", errors.Join(beef.ErrRequestFailed, err)
+		}
+
 		var e net.Error
 		isNetError := errors.As(err, &e)
 		if isNetError && e.Timeout() {
-			return false, errors.Join(beef.ErrRequestTimedOut, err)
+			return "", errors.Join(beef.ErrRequestTimedOut, err)
 		}
 
-		return false, fmt.Errorf("error sending request: %v", err)
+		return "", fmt.Errorf("error sending request: %v", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode != http.StatusOK {
-		return false, errors.Join(beef.ErrRequestFailed, fmt.Errorf("status code: %d, status: %s", resp.StatusCode, resp.Status))
+		return "", errors.Join(beef.ErrRequestFailed, fmt.Errorf("status code: %d, status: %s", resp.StatusCode, resp.Status))
 	}
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return false, errors.Join(ErrParseResponse, fmt.Errorf("error reading response body: %v", err))
+		return "", errors.Join(ErrParseResponse, fmt.Errorf("error reading response body: %v", err))
 	}
 
 	var response IsValidRootForHeightResponse
 	err = json.Unmarshal(body, &response)
 	if err != nil {
-		return false, errors.Join(ErrParseResponse, fmt.Errorf("error unmarshaling JSON: %v", err))
+		return "", errors.Join(ErrParseResponse, fmt.Errorf("error unmarshaling JSON: %v", err))
 	}
 
 	if response.ConfirmationState != bhsDomains.Confirmed {
 		c.logger.Warn("unconfirmed",
-			slog.String("url", url),
+			slog.String("url", ct.url),
 			slog.String("root", root.String()),
 			slog.Uint64("height", uint64(height)),
 			slog.String("payload", string(jsonPayload)),
@@ -356,11 +561,18 @@ func (c *Client) merkleRootVerify(ctx context.Context, url string, apiKey string
 		)
 	}
 
-	return response.ConfirmationState == bhsDomains.Confirmed, nil
+	return response.ConfirmationState, nil
 }
 
 func (c *Client) Shutdown() {
 	c.cancelAll()
 
 	c.wg.Wait()
+
+	if c.negativeCache != nil {
+		err := c.negativeCache.Persist()
+		if err != nil {
+			c.logger.Error("Failed to persist negative cache of Merkle roots", slog.String("err", err.Error()))
+		}
+	}
 }
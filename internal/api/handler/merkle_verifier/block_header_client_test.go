@@ -8,13 +8,16 @@ import (
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	bhsDomains "github.com/bitcoin-sv/block-headers-service/domains"
 	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bitcoin-sv/arc/internal/api/handler"
 	"github.com/bitcoin-sv/arc/internal/validator/beef"
 )
 
@@ -76,6 +79,61 @@ func TestClient_checkChainTrackers(t *testing.T) {
 	}
 }
 
+func TestClient_availabilityEvents(t *testing.T) {
+	// given
+	var httpStatus atomic.Int32
+	httpStatus.Store(http.StatusOK)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(int(httpStatus.Load()))
+	}))
+	defer server.Close()
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ct := NewChainTracker(server.URL, "abc")
+	sut := NewClient(slog.Default(), []*ChainTracker{ct}, WithCheckChainTrackersInterval(time.Hour), WithNow(func() time.Time { return now }))
+	defer sut.Shutdown()
+
+	var events []AvailabilityEvent
+	sut.Subscribe(func(event AvailabilityEvent) {
+		events = append(events, event)
+	})
+
+	// when
+	checkChainTrackers(context.TODO(), sut)
+	lastSuccess := now
+
+	now = now.Add(time.Minute)
+	httpStatus.Store(http.StatusServiceUnavailable)
+	checkChainTrackers(context.TODO(), sut)
+	checkChainTrackers(context.TODO(), sut)
+
+	downStatuses := sut.BlockHeaderServiceStatuses()
+
+	now = now.Add(time.Minute)
+	httpStatus.Store(http.StatusOK)
+	checkChainTrackers(context.TODO(), sut)
+
+	// then
+	require.Len(t, events, 2)
+
+	assert.Equal(t, server.URL, events[0].URL)
+	assert.False(t, events[0].Available)
+	assert.Contains(t, events[0].Reason, "status code: 503")
+	assert.Equal(t, lastSuccess.Add(time.Minute), events[0].Timestamp)
+	assert.Equal(t, lastSuccess, events[0].LastSuccess)
+
+	assert.True(t, events[1].Available)
+	assert.Empty(t, events[1].Reason)
+	assert.Equal(t, now, events[1].LastSuccess)
+
+	require.Len(t, downStatuses, 1)
+	assert.False(t, downStatuses[0].Available)
+	assert.Equal(t, lastSuccess, downStatuses[0].LastSuccess)
+	assert.NotEmpty(t, downStatuses[0].Reason)
+
+	assert.Equal(t, []handler.BlockHeaderServiceStatus{{URL: server.URL, Available: true, LastSuccess: now}}, sut.BlockHeaderServiceStatuses())
+}
+
 func TestClient_IsValidRootForHeight(t *testing.T) {
 	tt := []struct {
 		name          string
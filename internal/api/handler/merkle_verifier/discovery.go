@@ -0,0 +1,217 @@
+package merkle_verifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+)
+
+const discoverChainTrackersIntervalDefault = time.Minute
+
+var ErrDiscoveryFailed = errors.New("failed to discover block header services")
+
+// Discoverer returns the base URLs of the block header services which are currently available for the verification
+// of Merkle roots.
+type Discoverer interface {
+	Discover(ctx context.Context) ([]string, error)
+}
+
+// PodLister lists the addresses of the running pods matching the label selector in the namespace.
+type PodLister interface {
+	GetRunningPodAddresses(ctx context.Context, namespace string, labelSelector string) ([]string, error)
+}
+
+// WithDiscovery adds and removes the chain trackers of the block header services returned by the discoverer in every
+// interval, the chain trackers given to NewClient are kept. The chain tracker of a discovered URL is created by
+// newChainTracker, e.g. with the authentication of the discovered services.
+func WithDiscovery(discoverer Discoverer, newChainTracker func(url string) *ChainTracker, interval time.Duration) Option {
+	return func(client *Client) {
+		client.discoverer = discoverer
+		client.newChainTracker = newChainTracker
+		if interval > 0 {
+			client.discoverChainTrackersInterval = interval
+		}
+	}
+}
+
+// DNSDiscoverer discovers the block header services from the targets of a DNS SRV record, e.g.
+// "_bhs._tcp.example.com".
+type DNSDiscoverer struct {
+	record    string
+	scheme    string
+	lookupSRV func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+func NewDNSDiscoverer(record string, scheme string) *DNSDiscoverer {
+	return &DNSDiscoverer{
+		record:    record,
+		scheme:    schemeOrDefault(scheme),
+		lookupSRV: net.DefaultResolver.LookupSRV,
+	}
+}
+
+func (d *DNSDiscoverer) Discover(ctx context.Context) ([]string, error) {
+	_, addrs, err := d.lookupSRV(ctx, "", "", d.record)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailed, fmt.Errorf("record %s", d.record), err)
+	}
+
+	urls := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		host := strings.TrimSuffix(addr.Target, ".")
+		urls = append(urls, fmt.Sprintf("%s://%s", d.scheme, net.JoinHostPort(host, strconv.Itoa(int(addr.Port)))))
+	}
+
+	return urls, nil
+}
+
+// KubernetesDiscoverer discovers the block header services from the running pods matching the label selector of
+// their kubernetes service.
+type KubernetesDiscoverer struct {
+	pods          PodLister
+	namespace     string
+	labelSelector string
+	port          int
+	scheme        string
+}
+
+func NewKubernetesDiscoverer(pods PodLister, namespace string, labelSelector string, port int, scheme string) *KubernetesDiscoverer {
+	return &KubernetesDiscoverer{
+		pods:          pods,
+		namespace:     namespace,
+		labelSelector: labelSelector,
+		port:          port,
+		scheme:        schemeOrDefault(scheme),
+	}
+}
+
+func (d *KubernetesDiscoverer) Discover(ctx context.Context) ([]string, error) {
+	addresses, err := d.pods.GetRunningPodAddresses(ctx, d.namespace, d.labelSelector)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailed, fmt.Errorf("selector %s in namespace %s", d.labelSelector, d.namespace), err)
+	}
+
+	urls := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		urls = append(urls, fmt.Sprintf("%s://%s", d.scheme, net.JoinHostPort(address, strconv.Itoa(d.port))))
+	}
+
+	return urls, nil
+}
+
+// ConfigServiceDiscoverer discovers the block header services from a configuration service, which responds with the
+// JSON list of the services, e.g. `[{"url": "http://bhs-1:8080"}]`.
+type ConfigServiceDiscoverer struct {
+	url        string
+	httpClient *httpclient.Client
+}
+
+type discoveredService struct {
+	URL string `json:"url"`
+}
+
+func NewConfigServiceDiscoverer(url string) *ConfigServiceDiscoverer {
+	return &ConfigServiceDiscoverer{
+		url:        url,
+		httpClient: httpclient.New("block-header-service-discovery"),
+	}
+}
+
+func (d *ConfigServiceDiscoverer) Discover(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.url, nil)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailed, err)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailed, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Join(ErrDiscoveryFailed, fmt.Errorf("status code: %d, status: %s", resp.StatusCode, resp.Status))
+	}
+
+	var services []discoveredService
+	err = json.NewDecoder(resp.Body).Decode(&services)
+	if err != nil {
+		return nil, errors.Join(ErrDiscoveryFailed, ErrParseResponse, err)
+	}
+
+	urls := make([]string, 0, len(services))
+	for _, service := range services {
+		if service.URL != "" {
+			urls = append(urls, strings.TrimSuffix(service.URL, "/"))
+		}
+	}
+
+	return urls, nil
+}
+
+func schemeOrDefault(scheme string) string {
+	if scheme == "" {
+		return "http"
+	}
+
+	return scheme
+}
+
+// discoverChainTrackers replaces the discovered chain trackers by the chain trackers of the services returned by the
+// discoverer. The current chain trackers are kept if the discovery fails.
+func discoverChainTrackers(ctx context.Context, c *Client) []attribute.KeyValue {
+	urls, err := c.discoverer.Discover(ctx)
+	if err != nil {
+		c.logger.Warn("Failed to discover block header services", slog.String("err", err.Error()))
+		return []attribute.KeyValue{attribute.String("err", err.Error())}
+	}
+
+	c.chainTrackersMu.Lock()
+	defer c.chainTrackersMu.Unlock()
+
+	chainTrackers := make([]*ChainTracker, 0, len(c.chainTrackers)+len(urls))
+	known := make(map[string]struct{})
+	var removed int
+	for _, ct := range c.chainTrackers {
+		_, discovered := c.discovered[ct.url]
+		if discovered && !slices.Contains(urls, ct.url) {
+			delete(c.discovered, ct.url)
+			removed++
+			c.logger.Info("Removed block header service which is no longer discovered", slog.String("url", ct.url))
+			continue
+		}
+
+		chainTrackers = append(chainTrackers, ct)
+		known[ct.url] = struct{}{}
+	}
+
+	var added int
+	for _, url := range urls {
+		_, found := known[url]
+		if found {
+			continue
+		}
+
+		chainTrackers = append(chainTrackers, c.newChainTracker(url))
+		c.discovered[url] = struct{}{}
+		known[url] = struct{}{}
+		added++
+		c.logger.Info("Added discovered block header service", slog.String("url", url))
+	}
+
+	c.chainTrackers = chainTrackers
+
+	return []attribute.KeyValue{attribute.Int("added", added), attribute.Int("removed", removed)}
+}
@@ -0,0 +1,189 @@
+package merkle_verifier
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type discovererFunc func(ctx context.Context) ([]string, error)
+
+func (f discovererFunc) Discover(ctx context.Context) ([]string, error) {
+	return f(ctx)
+}
+
+type podListerFunc func(ctx context.Context, namespace string, labelSelector string) ([]string, error)
+
+func (f podListerFunc) GetRunningPodAddresses(ctx context.Context, namespace string, labelSelector string) ([]string, error) {
+	return f(ctx, namespace, labelSelector)
+}
+
+func TestDNSDiscoverer_Discover(t *testing.T) {
+	// given
+	sut := NewDNSDiscoverer("_bhs._tcp.example.com", "")
+	sut.lookupSRV = func(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+		require.Empty(t, service)
+		require.Empty(t, proto)
+		require.Equal(t, "_bhs._tcp.example.com", name)
+		return "", []*net.SRV{{Target: "bhs-1.example.com.", Port: 8080}, {Target: "bhs-2.example.com.", Port: 8081}}, nil
+	}
+
+	// when
+	actual, err := sut.Discover(context.Background())
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, []string{"http://bhs-1.example.com:8080", "http://bhs-2.example.com:8081"}, actual)
+}
+
+func TestKubernetesDiscoverer_Discover(t *testing.T) {
+	tt := []struct {
+		name    string
+		listErr error
+
+		expectedURLs []string
+		expectedErr  error
+	}{
+		{
+			name: "running pods",
+
+			expectedURLs: []string{"https://10.0.0.1:8080", "https://[fd00::2]:8080"},
+		},
+		{
+			name:    "listing pods failed",
+			listErr: errors.New("forbidden"),
+
+			expectedErr: ErrDiscoveryFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			pods := podListerFunc(func(_ context.Context, namespace string, labelSelector string) ([]string, error) {
+				require.Equal(t, "arc", namespace)
+				require.Equal(t, "app=block-headers-service", labelSelector)
+				return []string{"10.0.0.1", "fd00::2"}, tc.listErr
+			})
+			sut := NewKubernetesDiscoverer(pods, "arc", "app=block-headers-service", 8080, "https")
+
+			// when
+			actual, err := sut.Discover(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedURLs, actual)
+		})
+	}
+}
+
+func TestConfigServiceDiscoverer_Discover(t *testing.T) {
+	tt := []struct {
+		name       string
+		httpStatus int
+		response   string
+
+		expectedURLs []string
+		expectedErr  error
+	}{
+		{
+			name:       "services",
+			httpStatus: http.StatusOK,
+			response:   `[{"url": "http://bhs-1:8080/"}, {"url": ""}, {"url": "http://bhs-2:8080"}]`,
+
+			expectedURLs: []string{"http://bhs-1:8080", "http://bhs-2:8080"},
+		},
+		{
+			name:       "invalid response",
+			httpStatus: http.StatusOK,
+			response:   `{"url": "http://bhs-1:8080"}`,
+
+			expectedErr: ErrParseResponse,
+		},
+		{
+			name:       "service unavailable",
+			httpStatus: http.StatusServiceUnavailable,
+
+			expectedErr: ErrDiscoveryFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.httpStatus)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			sut := NewConfigServiceDiscoverer(server.URL)
+
+			// when
+			actual, err := sut.Discover(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedURLs, actual)
+		})
+	}
+}
+
+func TestClient_discoverChainTrackers(t *testing.T) {
+	// given
+	discoveries := []struct {
+		urls []string
+		err  error
+	}{
+		{urls: []string{"http://static:8080", "http://bhs-1:8080", "http://bhs-2:8080"}},
+		{err: errors.New("lookup failed")},
+		{urls: []string{"http://bhs-2:8080", "http://bhs-3:8080"}},
+		{urls: []string{}},
+	}
+	expectedURLs := [][]string{
+		{"http://static:8080", "http://bhs-1:8080", "http://bhs-2:8080"},
+		{"http://static:8080", "http://bhs-1:8080", "http://bhs-2:8080"},
+		{"http://static:8080", "http://bhs-2:8080", "http://bhs-3:8080"},
+		{"http://static:8080"},
+	}
+
+	var calls int
+	discoverer := discovererFunc(func(_ context.Context) ([]string, error) {
+		d := discoveries[calls]
+		calls++
+		return d.urls, d.err
+	})
+
+	sut := &Client{
+		logger:        slog.Default(),
+		chainTrackers: []*ChainTracker{NewChainTracker("http://static:8080", "")},
+		discovered:    make(map[string]struct{}),
+	}
+	WithDiscovery(discoverer, func(url string) *ChainTracker { return NewChainTracker(url, "") }, 0)(sut)
+
+	for i := range discoveries {
+		// when
+		discoverChainTrackers(context.Background(), sut)
+
+		// then
+		var actual []string
+		for _, ct := range sut.getChainTrackers() {
+			actual = append(actual, ct.url)
+		}
+		require.Equal(t, expectedURLs[i], actual)
+	}
+}
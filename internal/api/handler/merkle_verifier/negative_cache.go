@@ -0,0 +1,180 @@
+package merkle_verifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const negativeCachePersistInterval = 10 * time.Second
+
+var ErrNegativeCacheFile = errors.New("failed to access negative cache file")
+
+type negativeCacheEntry struct {
+	Root      string    `json:"root"`
+	Height    uint32    `json:"height"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type negativeCacheKey struct {
+	root   chainhash.Hash
+	height uint32
+}
+
+// NegativeCache holds the Merkle roots which the block header services found INVALID at a height, so that repeated
+// submissions of BEEF transactions with the same invalid Merkle roots are rejected without verifying them again. The
+// entries expire after the TTL and are persisted to a file, so that they survive restarts.
+type NegativeCache struct {
+	mu      sync.Mutex
+	path    string
+	ttl     time.Duration
+	now     func() time.Time
+	entries map[negativeCacheKey]time.Time
+	dirty   bool
+}
+
+// WithNegativeCache caches the Merkle roots which the block header services found INVALID, so that they are not
+// verified again until the entries expire or are invalidated by a chain reorg.
+func WithNegativeCache(cache *NegativeCache) Option {
+	return func(client *Client) {
+		client.negativeCache = cache
+	}
+}
+
+// NewNegativeCache returns the negative cache persisted to the file at path and loads the unexpired entries from it,
+// if the file exists.
+func NewNegativeCache(path string, ttl time.Duration) (*NegativeCache, error) {
+	nc := &NegativeCache{
+		path:    path,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: make(map[negativeCacheKey]time.Time),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nc, nil
+	}
+	if err != nil {
+		return nil, errors.Join(ErrNegativeCacheFile, err)
+	}
+
+	var entries []negativeCacheEntry
+	err = json.Unmarshal(data, &entries)
+	if err != nil {
+		return nil, errors.Join(ErrNegativeCacheFile, err)
+	}
+
+	now := nc.now()
+	for _, entry := range entries {
+		root, err := chainhash.NewHashFromHex(entry.Root)
+		if err != nil || !entry.ExpiresAt.After(now) {
+			continue
+		}
+		nc.entries[negativeCacheKey{root: *root, height: entry.Height}] = entry.ExpiresAt
+	}
+
+	return nc, nil
+}
+
+// IsInvalid returns whether the Merkle root at the height is cached as INVALID.
+func (nc *NegativeCache) IsInvalid(root *chainhash.Hash, height uint32) bool {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	expiresAt, found := nc.entries[negativeCacheKey{root: *root, height: height}]
+	return found && expiresAt.After(nc.now())
+}
+
+// Add caches the Merkle root at the height as INVALID.
+func (nc *NegativeCache) Add(root *chainhash.Hash, height uint32) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nc.entries[negativeCacheKey{root: *root, height: height}] = nc.now().Add(nc.ttl)
+	nc.dirty = true
+}
+
+// InvalidateFromHeight removes the Merkle roots at the height and above, which may have become valid with a chain
+// reorg, and returns the number of removed entries.
+func (nc *NegativeCache) InvalidateFromHeight(height uint32) int {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	removed := 0
+	for key := range nc.entries {
+		if key.height >= height {
+			delete(nc.entries, key)
+			removed++
+		}
+	}
+
+	if removed > 0 {
+		nc.dirty = true
+	}
+
+	return removed
+}
+
+// Persist writes the unexpired entries to the file if they changed since they were last persisted. The file is
+// replaced atomically.
+func (nc *NegativeCache) Persist() error {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if !nc.dirty {
+		return nil
+	}
+
+	now := nc.now()
+	entries := make([]negativeCacheEntry, 0, len(nc.entries))
+	for key, expiresAt := range nc.entries {
+		if !expiresAt.After(now) {
+			delete(nc.entries, key)
+			continue
+		}
+		entries = append(entries, negativeCacheEntry{Root: key.root.String(), Height: key.height, ExpiresAt: expiresAt})
+	}
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(nc.path), filepath.Base(nc.path)+".*.tmp")
+	if err != nil {
+		return errors.Join(ErrNegativeCacheFile, err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	_, err = tmp.Write(data)
+	closeErr := tmp.Close()
+	if err = errors.Join(err, closeErr); err != nil {
+		return errors.Join(ErrNegativeCacheFile, err)
+	}
+
+	err = os.Rename(tmp.Name(), nc.path)
+	if err != nil {
+		return errors.Join(ErrNegativeCacheFile, fmt.Errorf("failed to replace %s: %v", nc.path, err))
+	}
+
+	nc.dirty = false
+	return nil
+}
+
+func persistNegativeCache(_ context.Context, c *Client) []attribute.KeyValue {
+	err := c.negativeCache.Persist()
+	if err != nil {
+		c.logger.Error("Failed to persist negative cache of Merkle roots", slog.String("err", err.Error()))
+	}
+	return nil
+}
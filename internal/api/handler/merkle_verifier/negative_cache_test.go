@@ -0,0 +1,109 @@
+package merkle_verifier
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bhsDomains "github.com/bitcoin-sv/block-headers-service/domains"
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegativeCache(t *testing.T) {
+	root1, err := chainhash.NewHashFromHex("7382df1b717287ab87e5e3e25759697c4c45eea428f701cdd0c77ad3fc707257")
+	require.NoError(t, err)
+	root2, err := chainhash.NewHashFromHex("1c2c5b1d4a6f2e0a4b4c1e9a46e0d8b1e223cb6e7a3f5d5a8b0c4e9d7f1a2b3c")
+	require.NoError(t, err)
+
+	t.Run("expiry", func(t *testing.T) {
+		// given
+		now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+		sut, err := NewNegativeCache(filepath.Join(t.TempDir(), "negative-cache.json"), time.Hour)
+		require.NoError(t, err)
+		sut.now = func() time.Time { return now }
+
+		// when
+		sut.Add(root1, 800000)
+
+		// then
+		assert.True(t, sut.IsInvalid(root1, 800000))
+		assert.False(t, sut.IsInvalid(root1, 800001))
+
+		now = now.Add(time.Hour)
+		assert.False(t, sut.IsInvalid(root1, 800000))
+	})
+
+	t.Run("invalidate from height", func(t *testing.T) {
+		// given
+		sut, err := NewNegativeCache(filepath.Join(t.TempDir(), "negative-cache.json"), time.Hour)
+		require.NoError(t, err)
+		sut.Add(root1, 800000)
+		sut.Add(root2, 800010)
+
+		// when
+		removed := sut.InvalidateFromHeight(800005)
+
+		// then
+		assert.Equal(t, 1, removed)
+		assert.True(t, sut.IsInvalid(root1, 800000))
+		assert.False(t, sut.IsInvalid(root2, 800010))
+	})
+
+	t.Run("persist and load", func(t *testing.T) {
+		// given
+		path := filepath.Join(t.TempDir(), "negative-cache.json")
+		sut, err := NewNegativeCache(path, time.Hour)
+		require.NoError(t, err)
+		sut.Add(root1, 800000)
+
+		// when
+		err = sut.Persist()
+
+		// then
+		require.NoError(t, err)
+
+		loaded, err := NewNegativeCache(path, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, loaded.IsInvalid(root1, 800000))
+	})
+}
+
+func TestClient_IsValidRootForHeight_negativeCache(t *testing.T) {
+	// given
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		require.NoError(t, json.NewEncoder(w).Encode(IsValidRootForHeightResponse{ConfirmationState: bhsDomains.Invalid}))
+	}))
+	defer server.Close()
+
+	negativeCache, err := NewNegativeCache(filepath.Join(t.TempDir(), "negative-cache.json"), time.Hour)
+	require.NoError(t, err)
+
+	sut := NewClient(slog.Default(), []*ChainTracker{NewChainTracker(server.URL, "abc")}, WithCheckChainTrackersInterval(time.Hour), WithNegativeCache(negativeCache))
+	defer sut.Shutdown()
+
+	root, err := chainhash.NewHashFromHex("7382df1b717287ab87e5e3e25759697c4c45eea428f701cdd0c77ad3fc707257")
+	require.NoError(t, err)
+
+	// when
+	ok, err := sut.IsValidRootForHeight(context.TODO(), root, 800000)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	ok, err = sut.IsValidRootForHeight(context.TODO(), root, 800000)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// then
+	assert.Equal(t, int32(1), requests.Load())
+	assert.True(t, negativeCache.IsInvalid(root, 800000))
+}
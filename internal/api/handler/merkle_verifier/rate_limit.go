@@ -0,0 +1,263 @@
+package merkle_verifier
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/validator/beef"
+)
+
+const (
+	// rateLimitBackoffDefault is the time a service is not requested if it rate limits a request without telling when
+	// to retry
+	rateLimitBackoffDefault = 10 * time.Second
+	rateLimitBackoffMax     = 5 * time.Minute
+
+	// the interval between the requests to a service is doubled from paceInitial up to paceMax each time the service
+	// rate limits a request and halved each time a request is not rate limited
+	paceInitial = 50 * time.Millisecond
+	paceMax     = 2 * time.Second
+
+	// unixTimestampMin separates the reset times given as unix timestamps from the ones given as seconds
+	unixTimestampMin = 1_000_000_000
+)
+
+var ErrRateLimited = errors.New("block header service rate limited the request")
+
+func (ct *ChainTracker) isThrottled(now time.Time) bool {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	return now.Before(ct.throttledUntil)
+}
+
+// throttle stops requesting the service until the time and doubles the interval between its requests.
+func (ct *ChainTracker) throttle(until time.Time) {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if until.After(ct.throttledUntil) {
+		ct.throttledUntil = until
+	}
+	ct.pace = min(max(2*ct.pace, paceInitial), paceMax)
+}
+
+// relax halves the interval between the requests to the service after a request was not rate limited.
+func (ct *ChainTracker) relax() {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	ct.pace /= 2
+	if ct.pace < paceInitial {
+		ct.pace = 0
+	}
+}
+
+// delay returns how long the next request to the service has to wait for being paced.
+func (ct *ChainTracker) delay(now time.Time) time.Duration {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.pace == 0 || !now.Before(ct.nextRequest) {
+		return 0
+	}
+	return ct.nextRequest.Sub(now)
+}
+
+// reserve reserves the next request to the service and returns how long it has to wait for being paced.
+func (ct *ChainTracker) reserve(now time.Time) time.Duration {
+	ct.mu.Lock()
+	defer ct.mu.Unlock()
+	if ct.pace == 0 {
+		return 0
+	}
+
+	next := ct.nextRequest
+	if next.Before(now) {
+		next = now
+	}
+	ct.nextRequest = next.Add(ct.pace)
+
+	return next.Sub(now)
+}
+
+// usableChainTrackers returns the available chain trackers which are not throttled. The ones whose next request
+// need not wait for being paced come first, so that the requests are shifted away from the services which rate
+// limited them.
+func (c *Client) usableChainTrackers() ([]*ChainTracker, error) {
+	now := c.now()
+	var throttled bool
+	delays := make(map[*ChainTracker]time.Duration)
+	usable := make([]*ChainTracker, 0)
+	for _, ct := range c.getChainTrackers() {
+		if !ct.IsAvailable() {
+			continue
+		}
+
+		if ct.isThrottled(now) {
+			throttled = true
+			continue
+		}
+
+		delays[ct] = ct.delay(now)
+		usable = append(usable, ct)
+	}
+
+	if len(usable) == 0 {
+		if throttled {
+			return nil, errors.Join(beef.ErrNoChainTrackersAvailable, ErrRateLimited)
+		}
+		return nil, beef.ErrNoChainTrackersAvailable
+	}
+
+	slices.SortStableFunc(usable, func(a, b *ChainTracker) int {
+		return cmp.Compare(delays[a], delays[b])
+	})
+
+	return usable, nil
+}
+
+// pace waits until the next request to the service may be sent.
+func (c *Client) pace(ctx context.Context, ct *ChainTracker) error {
+	wait := ct.reserve(c.now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// do sends the request to the service and throttles the service if it rate limits the request or its quota of
+// requests is exhausted. A response with status 429 Too Many Requests is returned as ErrRateLimited.
+func (c *Client) do(ct *ChainTracker, req *http.Request) (*http.Response, error) {
+	resp, err := ct.auth.Do(c.httpClient, req)
+	if err != nil {
+		return nil, err
+	}
+
+	retryAfter, limited := rateLimit(resp, c.now())
+	if !limited {
+		ct.relax()
+		return resp, nil
+	}
+
+	c.throttle(ct, retryAfter)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		_ = resp.Body.Close()
+		return nil, errors.Join(ErrRateLimited, fmt.Errorf("status code: %d, retry after: %s", resp.StatusCode, retryAfter))
+	}
+
+	// the quota is exhausted by this request, its response is valid
+	return resp, nil
+}
+
+func (c *Client) throttle(ct *ChainTracker, retryAfter time.Duration) {
+	ct.throttle(c.now().Add(retryAfter))
+
+	c.logger.Warn("Block header service rate limited requests", slog.String("url", ct.url), slog.Duration("retryAfter", retryAfter))
+
+	if c.stats != nil {
+		c.stats.BlockHeaderServiceRateLimits.Inc()
+	}
+	c.updateThrottledStats()
+}
+
+func (c *Client) updateThrottledStats() {
+	if c.stats == nil {
+		return
+	}
+
+	now := c.now()
+	var throttled int
+	for _, ct := range c.getChainTrackers() {
+		if ct.isThrottled(now) {
+			throttled++
+		}
+	}
+	c.stats.ThrottledBlockHeaderServices.Set(float64(throttled))
+}
+
+// rateLimit returns whether the response rate limits the requests, because it has status 429 Too Many Requests or
+// the remaining quota of requests is zero, and how long the service must not be requested.
+func rateLimit(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && !quotaExhausted(resp.Header) {
+		return 0, false
+	}
+
+	retryAfter, found := parseRetryAfter(resp.Header.Get("Retry-After"), now)
+	if !found {
+		retryAfter, found = parseRateLimitReset(resp.Header, now)
+	}
+	if !found {
+		retryAfter = rateLimitBackoffDefault
+	}
+
+	return min(retryAfter, rateLimitBackoffMax), true
+}
+
+func quotaExhausted(header http.Header) bool {
+	for _, key := range []string{"RateLimit-Remaining", "X-RateLimit-Remaining"} {
+		if remaining := strings.TrimSpace(header.Get(key)); remaining != "" {
+			return remaining == "0"
+		}
+	}
+
+	return false
+}
+
+// parseRetryAfter parses the value of the Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
+
+// parseRateLimitReset parses the time at which the quota of requests is reset, which is a number of seconds or, in
+// case of the X-RateLimit-Reset header, also a unix timestamp.
+func parseRateLimitReset(header http.Header, now time.Time) (time.Duration, bool) {
+	for _, key := range []string{"RateLimit-Reset", "X-RateLimit-Reset"} {
+		value := strings.TrimSpace(header.Get(key))
+		if value == "" {
+			continue
+		}
+
+		reset, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		if reset >= unixTimestampMin {
+			return max(time.Unix(reset, 0).Sub(now), 0), true
+		}
+
+		return time.Duration(max(reset, 0)) * time.Second, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,214 @@
+package merkle_verifier
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bhsDomains "github.com/bitcoin-sv/block-headers-service/domains"
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/validator/beef"
+)
+
+func TestClient_IsValidRootForHeight_rateLimits(t *testing.T) {
+	tt := []struct {
+		name          string
+		httpStatus    int
+		header        http.Header
+		secondService bool
+
+		expectedOk             bool
+		expectedError          error
+		expectedThrottledUntil time.Duration
+	}{
+		{
+			name:          "rate limited with retry after seconds - shifted to second service",
+			httpStatus:    http.StatusTooManyRequests,
+			header:        http.Header{"Retry-After": []string{"120"}},
+			secondService: true,
+
+			expectedOk:             true,
+			expectedThrottledUntil: 120 * time.Second,
+		},
+		{
+			name:       "rate limited with retry after date",
+			httpStatus: http.StatusTooManyRequests,
+			header:     http.Header{"Retry-After": []string{"Wed, 01 Jan 2025 12:01:00 GMT"}},
+
+			expectedError:          beef.ErrRequestFailed,
+			expectedThrottledUntil: time.Minute,
+		},
+		{
+			name:       "rate limited without retry after",
+			httpStatus: http.StatusTooManyRequests,
+
+			expectedError:          ErrRateLimited,
+			expectedThrottledUntil: rateLimitBackoffDefault,
+		},
+		{
+			name:       "quota exhausted",
+			httpStatus: http.StatusOK,
+			header:     http.Header{"X-Ratelimit-Remaining": []string{"0"}, "X-Ratelimit-Reset": []string{"30"}},
+
+			expectedOk:             true,
+			expectedThrottledUntil: 30 * time.Second,
+		},
+		{
+			name:       "quota remaining",
+			httpStatus: http.StatusOK,
+			header:     http.Header{"Ratelimit-Remaining": []string{"10"}},
+
+			expectedOk: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+			var firstRequests atomic.Int32
+			first := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				firstRequests.Add(1)
+				for key, values := range tc.header {
+					w.Header()[key] = values
+				}
+				w.WriteHeader(tc.httpStatus)
+				_ = json.NewEncoder(w).Encode(IsValidRootForHeightResponse{ConfirmationState: bhsDomains.Confirmed})
+			}))
+			defer first.Close()
+
+			chainTrackers := []*ChainTracker{NewChainTracker(first.URL, "")}
+			if tc.secondService {
+				second := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					_ = json.NewEncoder(w).Encode(IsValidRootForHeightResponse{ConfirmationState: bhsDomains.Confirmed})
+				}))
+				defer second.Close()
+				chainTrackers = append(chainTrackers, NewChainTracker(second.URL, ""))
+			}
+
+			sut := NewClient(slog.Default(), chainTrackers, WithNow(func() time.Time { return now }))
+			defer sut.Shutdown()
+
+			// when
+			actual, err := sut.IsValidRootForHeight(context.Background(), &chainhash.Hash{}, 100)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedOk, actual)
+
+			status := sut.BlockHeaderServiceStatuses()[0]
+			if tc.expectedThrottledUntil == 0 {
+				require.True(t, status.ThrottledUntil.IsZero())
+				return
+			}
+			require.Equal(t, now.Add(tc.expectedThrottledUntil), status.ThrottledUntil)
+
+			// when the service is requested again while it is throttled
+			_, err = sut.IsValidRootForHeight(context.Background(), &chainhash.Hash{}, 101)
+
+			// then
+			if tc.secondService {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrRateLimited)
+				require.ErrorIs(t, err, beef.ErrNoChainTrackersAvailable)
+			}
+			require.Equal(t, int32(1), firstRequests.Load())
+
+			// when the throttling expired
+			now = now.Add(tc.expectedThrottledUntil)
+			_, _ = sut.IsValidRootForHeight(context.Background(), &chainhash.Hash{}, 102)
+
+			// then
+			require.Equal(t, int32(2), firstRequests.Load())
+		})
+	}
+}
+
+func TestChainTracker_pace(t *testing.T) {
+	// given
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	sut := NewChainTracker("http://bhs:8080", "")
+
+	// then
+	require.Zero(t, sut.reserve(now))
+
+	// when
+	sut.throttle(now)
+	sut.throttle(now)
+
+	// then
+	require.Zero(t, sut.reserve(now))
+	require.Equal(t, 2*paceInitial, sut.delay(now))
+	require.Equal(t, 2*paceInitial, sut.reserve(now))
+	require.Equal(t, 4*paceInitial, sut.delay(now))
+
+	// when
+	sut.relax()
+	sut.relax()
+
+	// then
+	require.Zero(t, sut.reserve(now.Add(time.Second)))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name  string
+		value string
+
+		expectedRetryAfter time.Duration
+		expectedFound      bool
+	}{
+		{
+			name:  "seconds",
+			value: "30",
+
+			expectedRetryAfter: 30 * time.Second,
+			expectedFound:      true,
+		},
+		{
+			name:  "date",
+			value: "Wed, 01 Jan 2025 12:00:45 GMT",
+
+			expectedRetryAfter: 45 * time.Second,
+			expectedFound:      true,
+		},
+		{
+			name:  "past date",
+			value: "Wed, 01 Jan 2025 11:00:00 GMT",
+
+			expectedFound: true,
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:  "invalid",
+			value: "soon",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, found := parseRetryAfter(tc.value, now)
+
+			// then
+			require.Equal(t, tc.expectedFound, found)
+			require.Equal(t, tc.expectedRetryAfter, actual)
+		})
+	}
+}
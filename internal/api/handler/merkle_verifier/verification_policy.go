@@ -0,0 +1,137 @@
+package merkle_verifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	bhsDomains "github.com/bitcoin-sv/block-headers-service/domains"
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/validator/beef"
+)
+
+// VerificationPolicy is the policy by which the answers of the block header services are combined if a Merkle root is
+// verified against all available services concurrently.
+type VerificationPolicy string
+
+const (
+	// PolicySequential verifies the Merkle root against one service after another until one answers, which is the
+	// default.
+	PolicySequential VerificationPolicy = ""
+	// PolicyFirst verifies the Merkle root against all services concurrently and returns the first answer.
+	PolicyFirst VerificationPolicy = "first"
+	// PolicyMajority returns the answer of the majority of the available services.
+	PolicyMajority VerificationPolicy = "majority"
+	// PolicyAll confirms the Merkle root only if all available services confirm it.
+	PolicyAll VerificationPolicy = "all"
+)
+
+var (
+	ErrUnknownVerificationPolicy = errors.New("unknown verification policy")
+	ErrNoQuorum                  = beef.ErrNoQuorum
+)
+
+// ParseVerificationPolicy returns the verification policy with the name, an empty name is the sequential policy.
+func ParseVerificationPolicy(name string) (VerificationPolicy, error) {
+	switch policy := VerificationPolicy(name); policy {
+	case PolicySequential, PolicyFirst, PolicyMajority, PolicyAll:
+		return policy, nil
+	default:
+		return PolicySequential, fmt.Errorf("%w: %s", ErrUnknownVerificationPolicy, name)
+	}
+}
+
+// WithVerificationPolicy verifies the Merkle roots against all available block header services concurrently and
+// combines their answers by the policy, which protects against a single compromised or faulty service.
+func WithVerificationPolicy(policy VerificationPolicy) Option {
+	return func(client *Client) {
+		client.verificationPolicy = policy
+	}
+}
+
+type verification struct {
+	ct    *ChainTracker
+	state bhsDomains.MerkleRootConfirmationState
+	err   error
+}
+
+// verifyFanOut verifies the Merkle root against all available chain trackers concurrently, applies the verification
+// policy to their answers and returns whether the Merkle root is confirmed or found invalid.
+func (c *Client) verifyFanOut(ctx context.Context, root *chainhash.Hash, height uint32) (bool, bool, error) {
+	available, err := c.usableChainTrackers()
+	if err != nil {
+		return false, false, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan verification, len(available))
+	for _, ct := range available {
+		go func(ct *ChainTracker) {
+			state, err := c.merkleRootVerify(ctx, ct, root, height)
+			results <- verification{ct: ct, state: state, err: err}
+		}(ct)
+	}
+
+	var confirmations, rejections, invalids int
+	var errs []error
+	for range available {
+		result := <-results
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.ct.url, result.err))
+			continue
+		}
+
+		result.ct.recordSuccess(c.now())
+
+		if c.verificationPolicy == PolicyFirst {
+			return result.state == bhsDomains.Confirmed, result.state == bhsDomains.Invalid, nil
+		}
+
+		switch result.state {
+		case bhsDomains.Confirmed:
+			confirmations++
+		case bhsDomains.Invalid:
+			invalids++
+			rejections++
+		default:
+			rejections++
+		}
+	}
+
+	if confirmations > 0 && rejections > 0 {
+		c.logger.Warn("Block header services disagree on Merkle root",
+			slog.String("root", root.String()),
+			slog.Uint64("height", uint64(height)),
+			slog.Int("confirmations", confirmations),
+			slog.Int("rejections", rejections),
+			slog.Int("errors", len(errs)),
+		)
+	}
+
+	switch c.verificationPolicy {
+	case PolicyMajority:
+		quorum := len(available)/2 + 1
+		if confirmations >= quorum {
+			return true, false, nil
+		}
+		if rejections >= quorum {
+			return false, invalids >= quorum, nil
+		}
+	case PolicyAll:
+		if rejections > 0 {
+			return false, invalids > 0, nil
+		}
+		if len(errs) == 0 {
+			return true, false, nil
+		}
+	default:
+		// all services failed with the first answer policy
+		return false, false, errors.Join(errs...)
+	}
+
+	return false, false, errors.Join(append([]error{ErrNoQuorum}, errs...)...)
+}
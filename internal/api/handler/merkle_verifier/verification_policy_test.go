@@ -0,0 +1,163 @@
+package merkle_verifier
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	bhsDomains "github.com/bitcoin-sv/block-headers-service/domains"
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/validator/beef"
+)
+
+const (
+	answerConfirmed = "confirmed"
+	answerRejected  = "rejected"
+	answerFailed    = "failed"
+	answerDown      = "down"
+)
+
+func TestClient_IsValidRootForHeight_verificationPolicy(t *testing.T) {
+	tt := []struct {
+		name    string
+		policy  VerificationPolicy
+		answers []string
+
+		expectedOk    bool
+		expectedError error
+	}{
+		{
+			name:    "first - first answer",
+			policy:  PolicyFirst,
+			answers: []string{answerFailed, answerConfirmed},
+
+			expectedOk: true,
+		},
+		{
+			name:    "first - all failed",
+			policy:  PolicyFirst,
+			answers: []string{answerFailed, answerFailed},
+
+			expectedError: beef.ErrRequestFailed,
+		},
+		{
+			name:    "majority - confirmed",
+			policy:  PolicyMajority,
+			answers: []string{answerConfirmed, answerRejected, answerConfirmed},
+
+			expectedOk: true,
+		},
+		{
+			name:    "majority - rejected",
+			policy:  PolicyMajority,
+			answers: []string{answerRejected, answerRejected, answerConfirmed},
+
+			expectedOk: false,
+		},
+		{
+			name:    "majority - no quorum",
+			policy:  PolicyMajority,
+			answers: []string{answerConfirmed, answerRejected, answerFailed},
+
+			expectedError: ErrNoQuorum,
+		},
+		{
+			name:    "majority - unavailable service not counted",
+			policy:  PolicyMajority,
+			answers: []string{answerConfirmed, answerConfirmed, answerRejected, answerDown},
+
+			expectedOk: true,
+		},
+		{
+			name:    "all - confirmed",
+			policy:  PolicyAll,
+			answers: []string{answerConfirmed, answerConfirmed, answerConfirmed},
+
+			expectedOk: true,
+		},
+		{
+			name:    "all - one rejected",
+			policy:  PolicyAll,
+			answers: []string{answerConfirmed, answerRejected, answerConfirmed},
+
+			expectedOk: false,
+		},
+		{
+			name:    "all - one failed",
+			policy:  PolicyAll,
+			answers: []string{answerConfirmed, answerFailed, answerConfirmed},
+
+			expectedError: ErrNoQuorum,
+		},
+		{
+			name:    "no service available",
+			policy:  PolicyAll,
+			answers: []string{answerDown},
+
+			expectedError: beef.ErrNoChainTrackersAvailable,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			chainTrackers := make([]*ChainTracker, 0, len(tc.answers))
+			for _, answer := range tc.answers {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					if answer == answerFailed {
+						w.WriteHeader(http.StatusInternalServerError)
+						return
+					}
+
+					state := bhsDomains.Confirmed
+					if answer == answerRejected {
+						state = bhsDomains.Invalid
+					}
+					require.NoError(t, json.NewEncoder(w).Encode(IsValidRootForHeightResponse{ConfirmationState: state}))
+				}))
+				defer server.Close()
+
+				ct := NewChainTracker(server.URL, "abc")
+				if answer == answerDown {
+					ct.SetAvailability(false)
+				}
+				chainTrackers = append(chainTrackers, ct)
+			}
+
+			sut := NewClient(slog.Default(), chainTrackers, WithCheckChainTrackersInterval(time.Hour), WithVerificationPolicy(tc.policy))
+			defer sut.Shutdown()
+
+			root, err := chainhash.NewHashFromHex("7382df1b717287ab87e5e3e25759697c4c45eea428f701cdd0c77ad3fc707257")
+			require.NoError(t, err)
+
+			// when
+			ok, err := sut.IsValidRootForHeight(context.TODO(), root, 800000)
+
+			// then
+			assert.Equal(t, tc.expectedOk, ok)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseVerificationPolicy(t *testing.T) {
+	for _, name := range []string{"", "first", "majority", "all"} {
+		actual, err := ParseVerificationPolicy(name)
+		require.NoError(t, err)
+		assert.Equal(t, VerificationPolicy(name), actual)
+	}
+
+	_, err := ParseVerificationPolicy("any")
+	require.ErrorIs(t, err, ErrUnknownVerificationPolicy)
+}
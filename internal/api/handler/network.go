@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+	apiv2 "github.com/bitcoin-sv/arc/pkg/api/v2"
+)
+
+// NetworkHeader is the header by which a request selects the network it is served for, alternatively to the network
+// path segment, e.g. `/testnet/v1/tx`.
+const NetworkHeader = "X-Network"
+
+var ErrUnknownNetwork = errors.New("unknown network")
+
+// RouteNetworks routes the requests for the networks served in addition to the default network. The endpoints of a
+// network are registered with its name as path segment in front of the API version, e.g. `/testnet/v1/tx`. A request
+// without network path segment selects the network by the header X-Network, without header it is served for the
+// default network. The network path segment is removed before the request is validated against the swagger
+// definitions, therefore RouteNetworks must be called before CheckSwagger.
+func RouteNetworks(e *echo.Echo, defaultNetwork string, networks []string) {
+	if len(networks) == 0 {
+		return
+	}
+
+	prefixes := make(map[string]string, len(networks)+1)
+	for _, network := range append([]string{defaultNetwork}, networks...) {
+		prefixes[network] = "/" + network
+	}
+
+	// the header is translated to the path segment before the request is routed
+	e.Pre(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			network := c.Request().Header.Get(NetworkHeader)
+			if network == "" || networkOfPath(c.Request().URL.Path, prefixes) != "" {
+				return next(c)
+			}
+
+			prefix, found := prefixes[network]
+			if !found {
+				errFields := api.NewErrorFields(api.ErrStatusBadRequest, fmt.Sprintf("%s: %s", ErrUnknownNetwork.Error(), network)).
+					WithInvalidParam(NetworkHeader, api.InvalidParamInHeader, ErrUnknownNetwork.Error())
+				return problemJSON(c, errFields)
+			}
+
+			req := c.Request()
+			req.URL.Path = prefix + req.URL.Path
+			if req.URL.RawPath != "" {
+				req.URL.RawPath = prefix + req.URL.RawPath
+			}
+
+			return next(c)
+		}
+	})
+
+	// the routed request is validated without the path segment of its network
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			network := networkOfPath(req.URL.Path, prefixes)
+			if network == "" {
+				return next(c)
+			}
+
+			req.URL.Path = strings.TrimPrefix(req.URL.Path, prefixes[network])
+			req.URL.RawPath = strings.TrimPrefix(req.URL.RawPath, prefixes[network])
+
+			return next(c)
+		}
+	})
+}
+
+// RegisterNetworkHandlers registers the endpoints of both API versions of the handler of a network with the name of the
+// network as path segment.
+func RegisterNetworkHandlers(e *echo.Echo, network string, handler *ArcDefaultHandler) {
+	api.RegisterHandlersWithBaseURL(e, handler, "/"+network)
+	apiv2.RegisterHandlersWithBaseURL(e, NewDefaultV2(handler), "/"+network)
+}
+
+// networkOfPath returns the network of the path segment in front of the API version of the path, if any.
+func networkOfPath(path string, prefixes map[string]string) string {
+	for network, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix+"/v1/") || strings.HasPrefix(path, prefix+"/v2/") {
+			return network
+		}
+	}
+
+	return ""
+}
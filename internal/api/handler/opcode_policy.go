@@ -0,0 +1,48 @@
+package handler
+
+import (
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+// WithOpcodeLimits limits the number of occurrences of opcodes and opcode classes in the scripts of the submitted
+// transactions.
+func WithOpcodeLimits(limits []validator.OpcodeLimit) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.opcodeLimits = limits
+	}
+}
+
+// WithFastPath takes the opcode usage of small standard P2PKH transactions of at most maxSize bytes from the number of
+// their outputs instead of parsing their scripts, see validator.IsSmallP2PKH.
+func WithFastPath(maxSize int) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.fastPathMaxSize = maxSize
+	}
+}
+
+// checkOpcodes counts the restored opcode classes used by the transaction and checks its opcodes against the limits.
+func (m *ArcDefaultHandler) checkOpcodes(tx *sdkTx.Transaction) *validator.Error {
+	if m.stats == nil && len(m.opcodeLimits) == 0 {
+		return nil
+	}
+
+	var usage validator.OpcodeUsage
+	if m.fastPathMaxSize > 0 && validator.IsSmallP2PKH(tx, m.fastPathMaxSize) {
+		usage = validator.P2PKHOpcodeUsage(tx)
+	} else {
+		usage = validator.CountOpcodes(tx)
+	}
+
+	if m.stats != nil {
+		m.stats.AddOpcodeClasses(usage.ClassesUsed())
+	}
+
+	return validator.CheckOpcodeLimits(usage, m.opcodeLimits)
+}
+
+// checkBEEFOpcodes checks the opcodes of the transactions of the BEEF which are submitted against the limits.
+func (m *ArcDefaultHandler) checkBEEFOpcodes(beefTx *sdkTx.Beef) (*sdkTx.Transaction, error) {
+	return checkBEEFTransactions(beefTx, m.checkOpcodes)
+}
@@ -12,10 +12,16 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 	"github.com/bitcoin-sv/arc/pkg/api"
 )
 
-var ErrEmptyBody = errors.New("no transaction found - empty request body")
+var (
+	ErrEmptyBody              = errors.New("no transaction found - empty request body")
+	ErrUnsupportedContentType = errors.New("content type not supported")
+	ErrContentTypeMismatch    = errors.New("body does not match content type")
+	ErrInvalidRawTx           = errors.New("rawTx is not hex encoded")
+)
 
 func parseTransactionFromRequest(request *http.Request) ([]byte, error) {
 	body, err := getBodyFromRequest(request)
@@ -33,7 +39,7 @@ func parseTransactionFromRequest(request *http.Request) ([]byte, error) {
 
 	switch {
 	case strings.Contains(contentType, echo.MIMETextPlain):
-		txHex, err = hex.DecodeString(string(body))
+		txHex, err = hexutils.DecodeInPlace(body)
 		if err != nil {
 			return nil, err
 		}
@@ -45,12 +51,15 @@ func parseTransactionFromRequest(request *http.Request) ([]byte, error) {
 
 		txHex, err = hex.DecodeString(txBody.RawTx)
 		if err != nil {
-			return nil, err
+			return nil, errors.Join(ErrInvalidRawTx, err)
 		}
 	case strings.Contains(contentType, echo.MIMEOctetStream):
+		if isHexEncoded(body) {
+			return nil, errors.Join(ErrContentTypeMismatch, fmt.Errorf("body is hex encoded, expected content type: %s", echo.MIMETextPlain))
+		}
 		return body, nil
 	default:
-		return nil, fmt.Errorf("given content-type %s does not match any of the allowed content-types", contentType)
+		return nil, errors.Join(ErrUnsupportedContentType, fmt.Errorf("content type: %s", contentType))
 	}
 
 	if len(txHex) == 0 {
@@ -80,7 +89,7 @@ func parseTransactionsFromRequest(request *http.Request) ([]byte, error) {
 			return nil, err
 		}
 	default:
-		return nil, fmt.Errorf("given content-type %s does not match any of the allowed content-types", contentType)
+		return nil, errors.Join(ErrUnsupportedContentType, fmt.Errorf("content type: %s", contentType))
 	}
 
 	if len(txHex) == 0 {
@@ -96,10 +105,10 @@ func getTxHexFromMIMEApplicationJSON(request *http.Request) ([]byte, error) {
 	if err := json.NewDecoder(request.Body).Decode(&txBody); err != nil {
 		return nil, err
 	}
-	for _, tx := range txBody {
+	for i, tx := range txBody {
 		partialHex, err := hex.DecodeString(tx.RawTx)
 		if err != nil {
-			return nil, err
+			return nil, errors.Join(ErrInvalidRawTx, fmt.Errorf("index: %d", i), err)
 		}
 		txHex = append(txHex, partialHex...)
 	}
@@ -108,24 +117,50 @@ func getTxHexFromMIMEApplicationJSON(request *http.Request) ([]byte, error) {
 
 func getTxHexFromMIMETextPlain(request *http.Request) ([]byte, error) {
 	var txHex []byte
+	var err error
 	scanner := bufio.NewScanner(request.Body)
 
 	for scanner.Scan() {
-		partialHex, err := hex.DecodeString(scanner.Text())
+		txHex, err = hex.AppendDecode(txHex, scanner.Bytes())
 		if err != nil {
 			return nil, err
 		}
-		txHex = append(txHex, partialHex...)
 	}
 
-	if err := scanner.Err(); err != nil {
+	if err = scanner.Err(); err != nil {
 		return nil, err
 	}
 	return txHex, nil
 }
 
 func getTxHexFromMIMEOctetStream(request *http.Request) ([]byte, error) {
-	return getBodyFromRequest(request)
+	body, err := getBodyFromRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if isHexEncoded(body) {
+		return nil, errors.Join(ErrContentTypeMismatch, fmt.Errorf("body is hex encoded, expected content type: %s", echo.MIMETextPlain))
+	}
+
+	return body, nil
+}
+
+// isHexEncoded returns whether the body consists of hex characters only. A binary transaction always contains bytes
+// which are not hex characters, e.g. the version.
+func isHexEncoded(body []byte) bool {
+	if len(body) == 0 {
+		return false
+	}
+
+	for _, b := range body {
+		isHexChar := (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F') || b == '\n' || b == '\r'
+		if !isHexChar {
+			return false
+		}
+	}
+
+	return true
 }
 
 func getBodyFromRequest(request *http.Request) ([]byte, error) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseTransactionFromRequest(t *testing.T) {
@@ -90,6 +91,61 @@ func TestParseTransactionFromRequest(t *testing.T) {
 	}
 }
 
+func TestParseTransactionFromRequest_ContentTypes(t *testing.T) {
+	tt := []struct {
+		name        string
+		contentType string
+		body        []byte
+
+		expectedError error
+	}{
+		{
+			name:        "binary transaction",
+			contentType: echo.MIMEOctetStream,
+			body:        validTxBytes,
+		},
+		{
+			name:        "hex encoded transaction as octet stream",
+			contentType: echo.MIMEOctetStream,
+			body:        []byte(validTx),
+
+			expectedError: ErrContentTypeMismatch,
+		},
+		{
+			name:        "invalid rawTx",
+			contentType: echo.MIMEApplicationJSON,
+			body:        []byte(`{"rawTx": "invalidTx"}`),
+
+			expectedError: ErrInvalidRawTx,
+		},
+		{
+			name:        "unsupported content type",
+			contentType: echo.MIMEApplicationXML,
+			body:        []byte(validTx),
+
+			expectedError: ErrUnsupportedContentType,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			r, _ := http.NewRequest("POST", "", bytes.NewBuffer(tc.body))
+			r.Header.Set(echo.HeaderContentType, tc.contentType)
+
+			// when
+			_, err := parseTransactionFromRequest(r)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestParseTransactionsFromRequest(t *testing.T) {
 	testCases := []struct {
 		name string
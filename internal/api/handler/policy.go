@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/ordishs/go-bitcoin"
+)
+
+// Policy returns the policy which is currently used to validate transactions.
+func (m *ArcDefaultHandler) Policy() *bitcoin.Settings {
+	m.policyMu.RLock()
+	defer m.policyMu.RUnlock()
+
+	return m.NodePolicy
+}
+
+// ReloadPolicy loads the policy from the policy source and validates the transactions submitted from then on with the
+// loaded policy. If the policy can not be loaded, the current policy is kept.
+func (m *ArcDefaultHandler) ReloadPolicy(ctx context.Context) (*bitcoin.Settings, error) {
+	if m.policySource == nil || m.newValidators == nil {
+		return nil, ErrPolicyReloadDisabled
+	}
+
+	policy, err := m.policySource(ctx)
+	if err != nil {
+		return nil, errors.Join(ErrPolicyReloadFailed, err)
+	}
+
+	maxscriptsizepolicy, maxTxSigopsCountsPolicy, maxTxSizePolicy, err := policyLimits(policy)
+	if err != nil {
+		return nil, errors.Join(ErrPolicyReloadFailed, err)
+	}
+
+	defaultValidator, beefValidator := m.newValidators(policy)
+
+	m.policyMu.Lock()
+	m.NodePolicy = policy
+	m.maxscriptsizepolicy = maxscriptsizepolicy
+	m.maxTxSigopsCountsPolicy = maxTxSigopsCountsPolicy
+	m.maxTxSizePolicy = maxTxSizePolicy
+	m.defaultValidator = defaultValidator
+	m.beefValidator = beefValidator
+	m.policyMu.Unlock()
+
+	m.logger.InfoContext(ctx, "Reloaded policy",
+		slog.Uint64("maxTxSizePolicy", maxTxSizePolicy),
+		slog.Uint64("maxScriptSizePolicy", maxscriptsizepolicy),
+		slog.Float64("minMiningTxFee", policy.MinMiningTxFee),
+	)
+
+	return policy, nil
+}
+
+func (m *ArcDefaultHandler) validators() (DefaultValidator, BeefValidator) {
+	m.policyMu.RLock()
+	defer m.policyMu.RUnlock()
+
+	return m.defaultValidator, m.beefValidator
+}
+
+func policyLimits(policy *bitcoin.Settings) (maxscriptsizepolicy uint64, maxTxSigopsCountsPolicy uint64, maxTxSizePolicy uint64, err error) {
+	if policy == nil {
+		return 0, 0, 0, nil
+	}
+
+	maxscriptsizepolicy, err = safecast.ToUint64(policy.MaxScriptSizePolicy)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	maxTxSigopsCountsPolicy, err = safecast.ToUint64(policy.MaxTxSigopsCountsPolicy)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	maxTxSizePolicy, err = safecast.ToUint64(policy.MaxTxSizePolicy)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return maxscriptsizepolicy, maxTxSigopsCountsPolicy, maxTxSizePolicy, nil
+}
@@ -0,0 +1,23 @@
+package handler
+
+import (
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// WithReadOnly puts the API in read-only mode, in which only the status, proof and policy queries are served. The
+// submission, resubmission and cancellation of transactions are rejected with 503, e.g. during a maintenance window or
+// for a public deployment answering queries only.
+func WithReadOnly(readOnly bool) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.readOnly = readOnly
+	}
+}
+
+// readOnlyError returns the error of requests changing transactions if the API is in read-only mode.
+func (m *ArcDefaultHandler) readOnlyError() *api.ErrorFields {
+	if !m.readOnly {
+		return nil
+	}
+
+	return api.NewErrorFields(api.ErrStatusReadOnly, "transactions can not be submitted, resubmitted or cancelled in read-only mode")
+}
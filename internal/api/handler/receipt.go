@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/api/receipt"
+	"github.com/bitcoin-sv/arc/pkg/api"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
+)
+
+// WithReceipts returns a receipt signed by the signer with the response of each accepted transaction and stores it in
+// the store, from which it is served by GET /v1/tx/{txid}/receipt. A resubmitted transaction is returned with the
+// receipt of its first acceptance.
+func WithReceipts(signer *receipt.Signer, store *receipt.Store) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.receiptSigner = signer
+		p.receiptStore = store
+	}
+}
+
+// issueReceipts signs and stores the receipts of the transactions which were neither rejected nor are of unknown
+// status. The transactions whose receipt could not be issued are returned without receipt.
+func (m *ArcDefaultHandler) issueReceipts(ctx context.Context, successes []*api.TransactionResponse) {
+	if m.receiptSigner == nil || m.receiptStore == nil {
+		return
+	}
+
+	policyID, err := receipt.PolicyID(m.Policy())
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to get ID of policy for receipts", slog.String("err", err.Error()))
+		return
+	}
+
+	for _, success := range successes {
+		if success.TxStatus == api.TransactionResponseTxStatusREJECTED || success.TxStatus == api.TransactionResponseTxStatusUNKNOWN {
+			continue
+		}
+
+		r, err := m.receiptSigner.Sign(success.Txid, string(success.TxStatus), policyID, success.Timestamp)
+		if err != nil {
+			m.logger.WarnContext(ctx, "Failed to sign receipt", slog.String("hash", success.Txid), slog.String("err", err.Error()))
+			continue
+		}
+
+		r, err = m.receiptStore.Save(r)
+		if err != nil {
+			m.logger.WarnContext(ctx, "Failed to store receipt", slog.String("hash", success.Txid), slog.String("err", err.Error()))
+			continue
+		}
+
+		success.Receipt = toAPIReceipt(r)
+	}
+}
+
+// GETTransactionReceipt returns the stored receipt of the acceptance of the transaction and whether its signature is
+// valid.
+func (m *ArcDefaultHandler) GETTransactionReceipt(ctx echo.Context, id string) (err error) {
+	_, span := tracing.StartTracing(ctx.Request().Context(), "GETTransactionReceipt", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	if m.receiptStore == nil {
+		e := api.NewErrorFields(api.ErrStatusNotFound, ErrReceiptsDisabled.Error())
+		return problemJSON(ctx, e)
+	}
+
+	r, err := m.receiptStore.Get(id)
+	if err != nil {
+		if errors.Is(err, receipt.ErrNotFound) {
+			e := api.NewErrorFields(api.ErrStatusNotFound, err.Error())
+			return problemJSON(ctx, e)
+		}
+
+		e := api.NewErrorFields(api.ErrStatusGeneric, err.Error())
+		if span != nil {
+			attr := e.GetSpanAttributes()
+			span.SetAttributes(attr...)
+		}
+		return problemJSON(ctx, e)
+	}
+
+	apiReceipt := toAPIReceipt(r)
+	return ctx.JSON(http.StatusOK, api.ReceiptVerification{
+		Txid:      apiReceipt.Txid,
+		TxStatus:  apiReceipt.TxStatus,
+		PolicyId:  apiReceipt.PolicyId,
+		Timestamp: apiReceipt.Timestamp,
+		PublicKey: apiReceipt.PublicKey,
+		Signature: apiReceipt.Signature,
+		Verified:  r.Verify() == nil,
+	})
+}
+
+func toAPIReceipt(r *receipt.Receipt) *api.Receipt {
+	return &api.Receipt{
+		Txid:      r.TxID,
+		TxStatus:  r.TxStatus,
+		PolicyId:  r.PolicyID,
+		Timestamp: r.Timestamp,
+		PublicKey: r.PublicKey,
+		Signature: r.Signature,
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+// WithScriptPolicies restricts the API keys, given as bearer token in the Authorization header, to submitting only
+// transactions whose outputs match the allowed script templates. Requests with other keys are not restricted.
+func WithScriptPolicies(policies map[string][]validator.ScriptTemplate) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.scriptPolicies = policies
+	}
+}
+
+// allowedScriptTemplates returns the script templates allowed for the API key of the request.
+func (m *ArcDefaultHandler) allowedScriptTemplates(req *http.Request) []validator.ScriptTemplate {
+	if len(m.scriptPolicies) == 0 {
+		return nil
+	}
+
+	return m.scriptPolicies[apiKey(req)]
+}
+
+// checkBEEFScriptTemplates checks the transactions of the BEEF which are submitted against the allowed templates.
+func checkBEEFScriptTemplates(beefTx *sdkTx.Beef, allowed []validator.ScriptTemplate) (*sdkTx.Transaction, error) {
+	return checkBEEFTransactions(beefTx, func(tx *sdkTx.Transaction) *validator.Error {
+		return validator.CheckScriptTemplates(tx, allowed)
+	})
+}
+
+// checkBEEFTransactions runs the check on the transactions of the BEEF which are submitted, i.e. all transactions which
+// are not mined yet, and returns the first transaction failing the check.
+func checkBEEFTransactions(beefTx *sdkTx.Beef, check func(tx *sdkTx.Transaction) *validator.Error) (*sdkTx.Transaction, error) {
+	for _, tx := range beefTx.Transactions {
+		if tx.DataFormat != sdkTx.RawTx && (tx.DataFormat != sdkTx.RawTxAndBumpIndex || len(beefTx.Transactions) != 1) {
+			continue
+		}
+
+		if vErr := check(tx.Transaction); vErr != nil {
+			return tx.Transaction, vErr
+		}
+	}
+
+	return nil, nil
+}
@@ -2,8 +2,11 @@ package handler
 
 import (
 	"errors"
+	"strconv"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
 )
 
 var ErrFailedToRegisterStats = errors.New("failed to register stats collector")
@@ -12,6 +15,10 @@ type Stats struct {
 	apiTxSubmissions               prometheus.Counter
 	AvailableBlockHeaderServices   prometheus.Gauge
 	UnavailableBlockHeaderServices prometheus.Gauge
+	ThrottledBlockHeaderServices   prometheus.Gauge
+	BlockHeaderServiceRateLimits   prometheus.Counter
+	submittedOpcodeClasses         *prometheus.CounterVec
+	nodePolicyMismatches           *prometheus.CounterVec
 }
 
 func NewStats() (*Stats, error) {
@@ -28,12 +35,32 @@ func NewStats() (*Stats, error) {
 			Name: "arc_api_unavailable_block_header_services",
 			Help: "Current number of unavailable block header services",
 		}),
+		ThrottledBlockHeaderServices: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "arc_api_throttled_block_header_services",
+			Help: "Current number of block header services which are not requested because they rate limited the requests",
+		}),
+		BlockHeaderServiceRateLimits: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "arc_api_block_header_service_rate_limits",
+			Help: "Nr of responses of block header services rate limiting the requests",
+		}),
+		submittedOpcodeClasses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_submitted_txs_by_opcode_class",
+			Help: "Nr of submitted txs using opcodes of the restored opcode class",
+		}, []string{"class"}),
+		nodePolicyMismatches: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_node_policy_mismatches",
+			Help: "Nr of txs valid for ARC which the mempool acceptance check of the node rejected by status",
+		}, []string{"status"}),
 	}
 
 	err := registerStats(
 		p.apiTxSubmissions,
 		p.AvailableBlockHeaderServices,
 		p.UnavailableBlockHeaderServices,
+		p.ThrottledBlockHeaderServices,
+		p.BlockHeaderServiceRateLimits,
+		p.submittedOpcodeClasses,
+		p.nodePolicyMismatches,
 	)
 	if err != nil {
 		return nil, errors.Join(ErrFailedToRegisterStats, err)
@@ -46,11 +73,27 @@ func (s *Stats) Add(inc int) {
 	s.apiTxSubmissions.Add(float64(inc))
 }
 
+// AddOpcodeClasses counts a submitted transaction for each of the opcode classes it uses.
+func (s *Stats) AddOpcodeClasses(classes []string) {
+	for _, class := range classes {
+		s.submittedOpcodeClasses.WithLabelValues(class).Inc()
+	}
+}
+
+// AddPolicyMismatch counts a transaction valid for ARC which the node rejected with the status.
+func (s *Stats) AddPolicyMismatch(status api.StatusCode) {
+	s.nodePolicyMismatches.WithLabelValues(strconv.Itoa(int(status))).Inc()
+}
+
 func (s *Stats) UnregisterStats() {
 	unregisterStats(
 		s.apiTxSubmissions,
 		s.AvailableBlockHeaderServices,
 		s.UnavailableBlockHeaderServices,
+		s.ThrottledBlockHeaderServices,
+		s.BlockHeaderServiceRateLimits,
+		s.submittedOpcodeClasses,
+		s.nodePolicyMismatches,
 	)
 }
 
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+)
+
+const statusCacheKeyPrefix = "arc-api-status:"
+
+// statusCache keeps the statuses of transactions requested by GET /tx/{txid} for a short time, so that bursts of
+// status polling of the same transactions are answered without looking up the statuses in metamorph and its database.
+// Cached statuses are invalidated as soon as metamorph publishes an update of the status.
+type statusCache struct {
+	store cache.Store
+	ttl   time.Duration
+}
+
+func newStatusCache(store cache.Store, ttl time.Duration) *statusCache {
+	return &statusCache{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+func (c *statusCache) get(txID string) (*metamorph.TransactionStatus, bool) {
+	value, err := c.store.Get(statusCacheKeyPrefix + txID)
+	if err != nil {
+		return nil, false
+	}
+
+	var status metamorph.TransactionStatus
+	err = json.Unmarshal(value, &status)
+	if err != nil {
+		return nil, false
+	}
+
+	return &status, true
+}
+
+func (c *statusCache) set(status *metamorph.TransactionStatus) error {
+	value, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	return c.store.Set(statusCacheKeyPrefix+status.TxID, value, c.ttl)
+}
+
+func (c *statusCache) remove(txIDs ...string) error {
+	keys := make([]string, 0, len(txIDs))
+	for _, txID := range txIDs {
+		keys = append(keys, statusCacheKeyPrefix+txID)
+	}
+
+	return c.store.Del(keys...)
+}
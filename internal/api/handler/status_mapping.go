@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+var ErrInvalidStatusMapping = errors.New("invalid status mapping")
+
+// StatusMappingRule maps the failures with the ARC status Status, and if ErrorContains is set only those whose error
+// contains it, to the ARC status MapTo, which is also the HTTP status of the response of a single transaction. If
+// RejectReason is set, it replaces the detail of the error, e.g. with a reason defined by the operator or the URL of
+// the policy of the operator.
+type StatusMappingRule struct {
+	Status        api.StatusCode
+	ErrorContains string
+	MapTo         api.StatusCode
+	RejectReason  string
+}
+
+// WithStatusMapping applies the first matching rule to each failure.
+func WithStatusMapping(rules []StatusMappingRule) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.statusMapping = rules
+	}
+}
+
+func validateStatusMapping(rules []StatusMappingRule) error {
+	for _, rule := range rules {
+		if rule.MapTo != 0 && (rule.MapTo < 400 || rule.MapTo > 599) {
+			return errors.Join(ErrInvalidStatusMapping, fmt.Errorf("status %d can not be mapped to %d which is not an error status", rule.Status, rule.MapTo))
+		}
+	}
+
+	return nil
+}
+
+// mapStatus applies the first rule matching the status and the error to the error fields and returns the mapped status.
+func (m *ArcDefaultHandler) mapStatus(status api.StatusCode, err error, arcError *api.ErrorFields) api.StatusCode {
+	for _, rule := range m.statusMapping {
+		if rule.Status != status || !strings.Contains(err.Error(), rule.ErrorContains) {
+			continue
+		}
+
+		if rule.MapTo != 0 {
+			status = rule.MapTo
+			arcError.Status = int(rule.MapTo)
+		}
+
+		if rule.RejectReason != "" {
+			arcError.Detail = rule.RejectReason
+		}
+
+		return status
+	}
+
+	return status
+}
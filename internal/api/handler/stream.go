@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/bitcoin-sv/arc/internal/beef"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	// MIMEApplicationNDJSON is the content type of the results of POST /txs streamed as newline delimited JSON
+	MIMEApplicationNDJSON = "application/x-ndjson"
+
+	streamBatchSizeDefault = 1000
+)
+
+// WithStreamBatchSize sets the number of transactions of a streamed submission which are processed together. The
+// results of a batch are written as soon as the batch is processed.
+func WithStreamBatchSize(size int) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.streamBatchSize = size
+	}
+}
+
+// acceptsNDJSON returns true if the client asked for the results of the submission to be streamed.
+func acceptsNDJSON(request *http.Request) bool {
+	return strings.Contains(request.Header.Get(echo.HeaderAccept), MIMEApplicationNDJSON)
+}
+
+// streamTransactions processes the transactions in batches and writes the result of each transaction as a line of
+// newline delimited JSON once its batch is processed, so the results are not buffered until all transactions are
+// processed. Errors of the first batch, e.g. invalid headers, are returned as problem details. Once the status of the
+// response is written, the error of a later batch is written as the last line.
+func (m *ArcDefaultHandler) streamTransactions(ctx echo.Context, txsHex []byte, params api.POSTTransactionsParams) error {
+	batches, e := m.splitTransactions(txsHex, m.streamBatchSize)
+	if e != nil {
+		return problemJSON(ctx, e)
+	}
+
+	response := ctx.Response()
+	encoder := json.NewEncoder(response)
+	for i, batch := range batches {
+		postResponse := m.postTransactions(ctx, batch, params)
+		if e, ok := postResponse.response.(*api.ErrorFields); ok {
+			if i == 0 {
+				return problemJSON(ctx, e)
+			}
+			return encoder.Encode(e)
+		}
+
+		if i == 0 {
+			response.Header().Set(echo.HeaderContentType, MIMEApplicationNDJSON)
+			response.WriteHeader(postResponse.StatusCode)
+		}
+
+		results, _ := postResponse.response.([]any)
+		for _, result := range results {
+			err := encoder.Encode(result)
+			if err != nil {
+				return err
+			}
+		}
+		response.Flush()
+	}
+
+	return nil
+}
+
+// splitTransactions splits the transactions into batches of at most batchSize transactions. A BEEF transaction is one
+// transaction of its batch, including its ancestors.
+func (m *ArcDefaultHandler) splitTransactions(txsHex []byte, batchSize int) ([][]byte, *api.ErrorFields) {
+	if batchSize <= 0 {
+		batchSize = streamBatchSizeDefault
+	}
+
+	var batches [][]byte
+	start, end, count := 0, 0, 0
+	for end < len(txsHex) {
+		remaining := txsHex[end:]
+		if validator.GetHexFormat(remaining) == validator.BeefHex {
+			beefTx, _, err := beef.DecodeBEEF(remaining)
+			if err != nil {
+				m.reportCrash(validator.CrashTargetBEEF, remaining, err)
+				return nil, api.NewErrorFields(api.ErrStatusMalformed, errors.Join(ErrDecodingBeef, err).Error())
+			}
+
+			beefBytes, err := beefTx.Bytes()
+			if err != nil {
+				return nil, api.NewErrorFields(api.ErrStatusMalformed, errors.Join(ErrBeefByteSlice, err).Error())
+			}
+			end += len(beefBytes)
+		} else {
+			_, bytesUsed, err := validator.DecodeTransaction(remaining)
+			if err != nil {
+				m.reportCrash(validator.CrashTargetTransaction, remaining, err)
+				return nil, api.NewErrorFields(api.ErrStatusBadRequest, err.Error())
+			}
+			end += bytesUsed
+		}
+
+		count++
+		if count == batchSize {
+			batches = append(batches, txsHex[start:end])
+			start, count = end, 0
+		}
+	}
+
+	if start < end {
+		batches = append(batches, txsHex[start:end])
+	}
+
+	return batches, nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	templatestats "github.com/bitcoin-sv/arc/internal/api/template_stats"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+var ErrTemplateRateClamped = errors.New("submission rate of script template is clamped")
+
+// WithTemplateStats counts the submitted transactions by the script templates of their outputs and, if the detector
+// clamps anomalous rates, rejects the transactions exceeding the clamped rate of their template.
+func WithTemplateStats(detector *templatestats.Detector) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.templateStats = detector
+	}
+}
+
+// checkTemplateRate observes the submission of the transaction by the template stats.
+func (m *ArcDefaultHandler) checkTemplateRate(tx *sdkTx.Transaction) *validator.Error {
+	if m.templateStats == nil {
+		return nil
+	}
+
+	bucket, clamped := m.templateStats.Observe(tx)
+	if clamped {
+		return validator.NewError(errors.Join(ErrTemplateRateClamped, fmt.Errorf("template %s", bucket)), api.ErrStatusTemplateRateClamped)
+	}
+
+	return nil
+}
+
+// checkBEEFTemplateRate observes the submission of the transactions of the BEEF which are submitted.
+func (m *ArcDefaultHandler) checkBEEFTemplateRate(beefTx *sdkTx.Beef) (*sdkTx.Transaction, error) {
+	return checkBEEFTransactions(beefTx, m.checkTemplateRate)
+}
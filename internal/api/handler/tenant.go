@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const bearerPrefix = "Bearer "
+
+// WithTenants assigns the API keys, given as bearer token in the Authorization header, to the names of tenants. The
+// transactions submitted with the API keys are stored with the name of the tenant.
+func WithTenants(tenants map[string]string) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.tenants = tenants
+	}
+}
+
+// tenant returns the name of the tenant of the API key of the request, it is empty if the API key is not assigned to
+// a tenant.
+func (m *ArcDefaultHandler) tenant(req *http.Request) string {
+	if len(m.tenants) == 0 {
+		return ""
+	}
+
+	return m.tenants[apiKey(req)]
+}
+
+// apiKey returns the API key given as bearer token in the Authorization header of the request.
+func apiKey(req *http.Request) string {
+	authorization := req.Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(authorization, bearerPrefix))
+}
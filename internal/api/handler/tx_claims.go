@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+)
+
+const (
+	txClaimKeyPrefix    = "arc-api-claim:"
+	txClaimPollInterval = 50 * time.Millisecond
+)
+
+// WithTxClaims lets only one of several API instances sharing the store submit a transaction received by more than one
+// of them at the same time. The first instance claiming the transaction in the store submits it to metamorph and
+// releases the claim afterwards, the other instances wait up to wait for the claim to be released and answer with the
+// status stored by the first instance. Claims of instances which stop while processing expire after ttl.
+func WithTxClaims(store cache.Store, ttl time.Duration, wait time.Duration) func(*ArcDefaultHandler) {
+	return func(p *ArcDefaultHandler) {
+		p.txClaims = newTxClaims(store, ttl, wait)
+	}
+}
+
+type txClaims struct {
+	store        cache.Store
+	instanceID   []byte
+	ttl          time.Duration
+	wait         time.Duration
+	pollInterval time.Duration
+}
+
+func newTxClaims(store cache.Store, ttl time.Duration, wait time.Duration) *txClaims {
+	instanceID, err := os.Hostname()
+	if err != nil {
+		instanceID = "unknown"
+	}
+
+	return &txClaims{
+		store:        store,
+		instanceID:   []byte(instanceID),
+		ttl:          ttl,
+		wait:         wait,
+		pollInterval: txClaimPollInterval,
+	}
+}
+
+// claim claims the transactions and returns the IDs of the transactions claimed by other instances. If the store
+// fails, the transaction is processed as if it was claimed.
+func (c *txClaims) claim(txIDs []string) (claimedByOthers map[string]struct{}, err error) {
+	claimedByOthers = make(map[string]struct{})
+
+	var errs []error
+	for _, txID := range txIDs {
+		claimed, setErr := c.store.SetNX(txClaimKeyPrefix+txID, c.instanceID, c.ttl)
+		if setErr != nil {
+			errs = append(errs, setErr)
+			continue
+		}
+
+		if !claimed {
+			claimedByOthers[txID] = struct{}{}
+		}
+	}
+
+	return claimedByOthers, errors.Join(errs...)
+}
+
+// release releases the claims of the transactions held by this instance. Claims which expired and were taken by
+// another instance in the meantime are kept.
+func (c *txClaims) release(txIDs []string) error {
+	var errs []error
+	for _, txID := range txIDs {
+		_, err := c.store.CompareAndDel(txClaimKeyPrefix+txID, c.instanceID)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// awaitRelease waits until the claims of the transactions are released or the wait is over and returns whether all
+// claims were released.
+func (c *txClaims) awaitRelease(ctx context.Context, txIDs []string) bool {
+	deadline := time.NewTimer(c.wait)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	pending := txIDs
+	for {
+		stillClaimed := make([]string, 0, len(pending))
+		for _, txID := range pending {
+			_, err := c.store.Get(txClaimKeyPrefix + txID)
+			if err == nil {
+				stillClaimed = append(stillClaimed, txID)
+			}
+		}
+
+		if len(stillClaimed) == 0 {
+			return true
+		}
+		pending = stillClaimed
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-deadline.C:
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// claimTransactions claims the transactions before they are submitted. It returns the transactions which have to be
+// submitted by this instance, the statuses of the transactions which were processed by other instances in the meantime
+// and a function releasing the claims of this instance after the submission. Transactions claimed by other instances
+// which are not processed when their claims are released or the wait is over are submitted by this instance as well,
+// as are transactions whose processing by other instances doesn't include the options of this submission, e.g. its
+// callback.
+func (m *ArcDefaultHandler) claimTransactions(ctx context.Context, txs []*sdkTx.Transaction, options *metamorph.TransactionOptions) ([]*sdkTx.Transaction, []*metamorph.TransactionStatus, func()) {
+	txIDs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txIDs = append(txIDs, hexutils.TxID(tx.TxID()))
+	}
+
+	claimedByOthers, err := m.txClaims.claim(txIDs)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to claim transactions", slog.String("err", err.Error()))
+	}
+
+	claimed := make([]string, 0, len(txIDs))
+	others := make([]string, 0, len(claimedByOthers))
+	for _, txID := range txIDs {
+		if _, found := claimedByOthers[txID]; found {
+			others = append(others, txID)
+			continue
+		}
+		claimed = append(claimed, txID)
+	}
+
+	release := func() {
+		releaseErr := m.txClaims.release(claimed)
+		if releaseErr != nil {
+			m.logger.WarnContext(ctx, "Failed to release claims of transactions", slog.String("err", releaseErr.Error()))
+		}
+	}
+
+	if len(others) == 0 {
+		return txs, nil, release
+	}
+
+	m.logger.DebugContext(ctx, "Transactions claimed by other instances", slog.Int("txs", len(others)))
+	if !m.txClaims.awaitRelease(ctx, others) {
+		m.logger.WarnContext(ctx, "Claims of transactions by other instances not released in time", slog.Int("txs", len(others)))
+	}
+
+	processedByOthers := make(map[string]*metamorph.TransactionStatus, len(others))
+	statuses, err := m.getTransactionStatuses(ctx, others)
+	if err != nil && !errors.Is(err, metamorph.ErrTransactionNotFound) {
+		m.logger.WarnContext(ctx, "Failed to get statuses of transactions claimed by other instances", slog.String("err", err.Error()))
+	}
+	for _, txStatus := range statuses {
+		if m.checkAllProcessed([]*metamorph.TransactionStatus{txStatus}, options) {
+			processedByOthers[txStatus.TxID] = txStatus
+		}
+	}
+
+	unprocessed := make([]string, 0, len(others))
+	for _, txID := range others {
+		if _, found := processedByOthers[txID]; !found {
+			unprocessed = append(unprocessed, txID)
+		}
+	}
+
+	// claim the transactions which this instance submits after all, so that further instances wait for it
+	stillClaimedByOthers, err := m.txClaims.claim(unprocessed)
+	if err != nil {
+		m.logger.WarnContext(ctx, "Failed to claim transactions", slog.String("err", err.Error()))
+	}
+	for _, txID := range unprocessed {
+		if _, found := stillClaimedByOthers[txID]; !found {
+			claimed = append(claimed, txID)
+		}
+	}
+
+	toSubmit := make([]*sdkTx.Transaction, 0, len(txs))
+	existingStatuses := make([]*metamorph.TransactionStatus, 0, len(processedByOthers))
+	for i, tx := range txs {
+		if txStatus, found := processedByOthers[txIDs[i]]; found {
+			existingStatuses = append(existingStatuses, txStatus)
+			continue
+		}
+		toSubmit = append(toSubmit, tx)
+	}
+
+	return toSubmit, existingStatuses, release
+}
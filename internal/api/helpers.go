@@ -1,7 +1,6 @@
 package api
 
 import (
-	"github.com/bitcoin-sv/bdk/module/gobdk/script"
 	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
 )
 
@@ -10,8 +9,9 @@ func FeesToFeeModel(minMiningFee float64) *feemodel.SatoshisPerKilobyte {
 	return &feemodel.SatoshisPerKilobyte{Satoshis: satoshisPerKB}
 }
 
+// ScriptVerifier verifies the unlocking scripts of a transaction in extended format, e.g. script_verifier.Verifier.
 type ScriptVerifier interface {
-	VerifyScript(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) script.ScriptError
+	VerifyScript(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) error
 }
 
 type ArcDefaultHandlerHealth interface {
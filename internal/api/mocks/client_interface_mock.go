@@ -21,18 +21,45 @@ var _ api.ClientInterface = &ClientInterfaceMock{}
 //
 //		// make and configure a mocked api.ClientInterface
 //		mockedClientInterface := &ClientInterfaceMock{
+//			DELETETransactionFunc: func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the DELETETransaction method")
+//			},
+//			DELETETransactionScheduleFunc: func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the DELETETransactionSchedule method")
+//			},
+//			GETBlocksFunc: func(ctx context.Context, params *api.GETBlocksParams, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the GETBlocks method")
+//			},
 //			GETHealthFunc: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 //				panic("mock out the GETHealth method")
 //			},
+//			GETOutpointSpentFunc: func(ctx context.Context, txid string, vout int, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the GETOutpointSpent method")
+//			},
 //			GETPolicyFunc: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 //				panic("mock out the GETPolicy method")
 //			},
+//			GETStatsFunc: func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the GETStats method")
+//			},
+//			GETTransactionGraphFunc: func(ctx context.Context, txid string, params *api.GETTransactionGraphParams, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the GETTransactionGraph method")
+//			},
+//			GETTransactionReceiptFunc: func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the GETTransactionReceipt method")
+//			},
 //			GETTransactionStatusFunc: func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 //				panic("mock out the GETTransactionStatus method")
 //			},
+//			GETTransactionUpstreamsFunc: func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the GETTransactionUpstreams method")
+//			},
 //			POSTTransactionFunc: func(ctx context.Context, params *api.POSTTransactionParams, body api.POSTTransactionJSONRequestBody, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 //				panic("mock out the POSTTransaction method")
 //			},
+//			POSTTransactionResubmitFunc: func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+//				panic("mock out the POSTTransactionResubmit method")
+//			},
 //			POSTTransactionWithBodyFunc: func(ctx context.Context, params *api.POSTTransactionParams, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 //				panic("mock out the POSTTransactionWithBody method")
 //			},
@@ -55,18 +82,45 @@ var _ api.ClientInterface = &ClientInterfaceMock{}
 //
 //	}
 type ClientInterfaceMock struct {
+	// DELETETransactionFunc mocks the DELETETransaction method.
+	DELETETransactionFunc func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
+	// DELETETransactionScheduleFunc mocks the DELETETransactionSchedule method.
+	DELETETransactionScheduleFunc func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
+	// GETBlocksFunc mocks the GETBlocks method.
+	GETBlocksFunc func(ctx context.Context, params *api.GETBlocksParams, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
 	// GETHealthFunc mocks the GETHealth method.
 	GETHealthFunc func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error)
 
+	// GETOutpointSpentFunc mocks the GETOutpointSpent method.
+	GETOutpointSpentFunc func(ctx context.Context, txid string, vout int, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
 	// GETPolicyFunc mocks the GETPolicy method.
 	GETPolicyFunc func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error)
 
+	// GETStatsFunc mocks the GETStats method.
+	GETStatsFunc func(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
+	// GETTransactionGraphFunc mocks the GETTransactionGraph method.
+	GETTransactionGraphFunc func(ctx context.Context, txid string, params *api.GETTransactionGraphParams, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
+	// GETTransactionReceiptFunc mocks the GETTransactionReceipt method.
+	GETTransactionReceiptFunc func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
 	// GETTransactionStatusFunc mocks the GETTransactionStatus method.
 	GETTransactionStatusFunc func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
 
+	// GETTransactionUpstreamsFunc mocks the GETTransactionUpstreams method.
+	GETTransactionUpstreamsFunc func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
 	// POSTTransactionFunc mocks the POSTTransaction method.
 	POSTTransactionFunc func(ctx context.Context, params *api.POSTTransactionParams, body api.POSTTransactionJSONRequestBody, reqEditors ...api.RequestEditorFn) (*http.Response, error)
 
+	// POSTTransactionResubmitFunc mocks the POSTTransactionResubmit method.
+	POSTTransactionResubmitFunc func(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error)
+
 	// POSTTransactionWithBodyFunc mocks the POSTTransactionWithBody method.
 	POSTTransactionWithBodyFunc func(ctx context.Context, params *api.POSTTransactionParams, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*http.Response, error)
 
@@ -84,6 +138,33 @@ type ClientInterfaceMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// DELETETransaction holds details about calls to the DELETETransaction method.
+		DELETETransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
+		// DELETETransactionSchedule holds details about calls to the DELETETransactionSchedule method.
+		DELETETransactionSchedule []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
+		// GETBlocks holds details about calls to the GETBlocks method.
+		GETBlocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Params is the params argument value.
+			Params *api.GETBlocksParams
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
 		// GETHealth holds details about calls to the GETHealth method.
 		GETHealth []struct {
 			// Ctx is the ctx argument value.
@@ -91,6 +172,17 @@ type ClientInterfaceMock struct {
 			// ReqEditors is the reqEditors argument value.
 			ReqEditors []api.RequestEditorFn
 		}
+		// GETOutpointSpent holds details about calls to the GETOutpointSpent method.
+		GETOutpointSpent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// Vout is the vout argument value.
+			Vout int
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
 		// GETPolicy holds details about calls to the GETPolicy method.
 		GETPolicy []struct {
 			// Ctx is the ctx argument value.
@@ -98,6 +190,33 @@ type ClientInterfaceMock struct {
 			// ReqEditors is the reqEditors argument value.
 			ReqEditors []api.RequestEditorFn
 		}
+		// GETStats holds details about calls to the GETStats method.
+		GETStats []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
+		// GETTransactionGraph holds details about calls to the GETTransactionGraph method.
+		GETTransactionGraph []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// Params is the params argument value.
+			Params *api.GETTransactionGraphParams
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
+		// GETTransactionReceipt holds details about calls to the GETTransactionReceipt method.
+		GETTransactionReceipt []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
 		// GETTransactionStatus holds details about calls to the GETTransactionStatus method.
 		GETTransactionStatus []struct {
 			// Ctx is the ctx argument value.
@@ -107,6 +226,15 @@ type ClientInterfaceMock struct {
 			// ReqEditors is the reqEditors argument value.
 			ReqEditors []api.RequestEditorFn
 		}
+		// GETTransactionUpstreams holds details about calls to the GETTransactionUpstreams method.
+		GETTransactionUpstreams []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
 		// POSTTransaction holds details about calls to the POSTTransaction method.
 		POSTTransaction []struct {
 			// Ctx is the ctx argument value.
@@ -118,6 +246,15 @@ type ClientInterfaceMock struct {
 			// ReqEditors is the reqEditors argument value.
 			ReqEditors []api.RequestEditorFn
 		}
+		// POSTTransactionResubmit holds details about calls to the POSTTransactionResubmit method.
+		POSTTransactionResubmit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Txid is the txid argument value.
+			Txid string
+			// ReqEditors is the reqEditors argument value.
+			ReqEditors []api.RequestEditorFn
+		}
 		// POSTTransactionWithBody holds details about calls to the POSTTransactionWithBody method.
 		POSTTransactionWithBody []struct {
 			// Ctx is the ctx argument value.
@@ -178,10 +315,19 @@ type ClientInterfaceMock struct {
 			ReqEditors []api.RequestEditorFn
 		}
 	}
+	lockDELETETransaction            sync.RWMutex
+	lockDELETETransactionSchedule    sync.RWMutex
+	lockGETBlocks                    sync.RWMutex
 	lockGETHealth                    sync.RWMutex
+	lockGETOutpointSpent             sync.RWMutex
 	lockGETPolicy                    sync.RWMutex
+	lockGETStats                     sync.RWMutex
+	lockGETTransactionGraph          sync.RWMutex
+	lockGETTransactionReceipt        sync.RWMutex
 	lockGETTransactionStatus         sync.RWMutex
+	lockGETTransactionUpstreams      sync.RWMutex
 	lockPOSTTransaction              sync.RWMutex
+	lockPOSTTransactionResubmit      sync.RWMutex
 	lockPOSTTransactionWithBody      sync.RWMutex
 	lockPOSTTransactionWithTextBody  sync.RWMutex
 	lockPOSTTransactions             sync.RWMutex
@@ -189,6 +335,126 @@ type ClientInterfaceMock struct {
 	lockPOSTTransactionsWithTextBody sync.RWMutex
 }
 
+// DELETETransaction calls DELETETransactionFunc.
+func (mock *ClientInterfaceMock) DELETETransaction(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.DELETETransactionFunc == nil {
+		panic("ClientInterfaceMock.DELETETransactionFunc: method is nil but ClientInterface.DELETETransaction was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		ReqEditors: reqEditors,
+	}
+	mock.lockDELETETransaction.Lock()
+	mock.calls.DELETETransaction = append(mock.calls.DELETETransaction, callInfo)
+	mock.lockDELETETransaction.Unlock()
+	return mock.DELETETransactionFunc(ctx, txid, reqEditors...)
+}
+
+// DELETETransactionCalls gets all the calls that were made to DELETETransaction.
+// Check the length with:
+//
+//	len(mockedClientInterface.DELETETransactionCalls())
+func (mock *ClientInterfaceMock) DELETETransactionCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockDELETETransaction.RLock()
+	calls = mock.calls.DELETETransaction
+	mock.lockDELETETransaction.RUnlock()
+	return calls
+}
+
+// DELETETransactionSchedule calls DELETETransactionScheduleFunc.
+func (mock *ClientInterfaceMock) DELETETransactionSchedule(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.DELETETransactionScheduleFunc == nil {
+		panic("ClientInterfaceMock.DELETETransactionScheduleFunc: method is nil but ClientInterface.DELETETransactionSchedule was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		ReqEditors: reqEditors,
+	}
+	mock.lockDELETETransactionSchedule.Lock()
+	mock.calls.DELETETransactionSchedule = append(mock.calls.DELETETransactionSchedule, callInfo)
+	mock.lockDELETETransactionSchedule.Unlock()
+	return mock.DELETETransactionScheduleFunc(ctx, txid, reqEditors...)
+}
+
+// DELETETransactionScheduleCalls gets all the calls that were made to DELETETransactionSchedule.
+// Check the length with:
+//
+//	len(mockedClientInterface.DELETETransactionScheduleCalls())
+func (mock *ClientInterfaceMock) DELETETransactionScheduleCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockDELETETransactionSchedule.RLock()
+	calls = mock.calls.DELETETransactionSchedule
+	mock.lockDELETETransactionSchedule.RUnlock()
+	return calls
+}
+
+// GETBlocks calls GETBlocksFunc.
+func (mock *ClientInterfaceMock) GETBlocks(ctx context.Context, params *api.GETBlocksParams, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.GETBlocksFunc == nil {
+		panic("ClientInterfaceMock.GETBlocksFunc: method is nil but ClientInterface.GETBlocks was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Params     *api.GETBlocksParams
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Params:     params,
+		ReqEditors: reqEditors,
+	}
+	mock.lockGETBlocks.Lock()
+	mock.calls.GETBlocks = append(mock.calls.GETBlocks, callInfo)
+	mock.lockGETBlocks.Unlock()
+	return mock.GETBlocksFunc(ctx, params, reqEditors...)
+}
+
+// GETBlocksCalls gets all the calls that were made to GETBlocks.
+// Check the length with:
+//
+//	len(mockedClientInterface.GETBlocksCalls())
+func (mock *ClientInterfaceMock) GETBlocksCalls() []struct {
+	Ctx        context.Context
+	Params     *api.GETBlocksParams
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Params     *api.GETBlocksParams
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockGETBlocks.RLock()
+	calls = mock.calls.GETBlocks
+	mock.lockGETBlocks.RUnlock()
+	return calls
+}
+
 // GETHealth calls GETHealthFunc.
 func (mock *ClientInterfaceMock) GETHealth(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	if mock.GETHealthFunc == nil {
@@ -225,6 +491,50 @@ func (mock *ClientInterfaceMock) GETHealthCalls() []struct {
 	return calls
 }
 
+// GETOutpointSpent calls GETOutpointSpentFunc.
+func (mock *ClientInterfaceMock) GETOutpointSpent(ctx context.Context, txid string, vout int, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.GETOutpointSpentFunc == nil {
+		panic("ClientInterfaceMock.GETOutpointSpentFunc: method is nil but ClientInterface.GETOutpointSpent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		Vout       int
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		Vout:       vout,
+		ReqEditors: reqEditors,
+	}
+	mock.lockGETOutpointSpent.Lock()
+	mock.calls.GETOutpointSpent = append(mock.calls.GETOutpointSpent, callInfo)
+	mock.lockGETOutpointSpent.Unlock()
+	return mock.GETOutpointSpentFunc(ctx, txid, vout, reqEditors...)
+}
+
+// GETOutpointSpentCalls gets all the calls that were made to GETOutpointSpent.
+// Check the length with:
+//
+//	len(mockedClientInterface.GETOutpointSpentCalls())
+func (mock *ClientInterfaceMock) GETOutpointSpentCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	Vout       int
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		Vout       int
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockGETOutpointSpent.RLock()
+	calls = mock.calls.GETOutpointSpent
+	mock.lockGETOutpointSpent.RUnlock()
+	return calls
+}
+
 // GETPolicy calls GETPolicyFunc.
 func (mock *ClientInterfaceMock) GETPolicy(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	if mock.GETPolicyFunc == nil {
@@ -261,6 +571,126 @@ func (mock *ClientInterfaceMock) GETPolicyCalls() []struct {
 	return calls
 }
 
+// GETStats calls GETStatsFunc.
+func (mock *ClientInterfaceMock) GETStats(ctx context.Context, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.GETStatsFunc == nil {
+		panic("ClientInterfaceMock.GETStatsFunc: method is nil but ClientInterface.GETStats was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		ReqEditors: reqEditors,
+	}
+	mock.lockGETStats.Lock()
+	mock.calls.GETStats = append(mock.calls.GETStats, callInfo)
+	mock.lockGETStats.Unlock()
+	return mock.GETStatsFunc(ctx, reqEditors...)
+}
+
+// GETStatsCalls gets all the calls that were made to GETStats.
+// Check the length with:
+//
+//	len(mockedClientInterface.GETStatsCalls())
+func (mock *ClientInterfaceMock) GETStatsCalls() []struct {
+	Ctx        context.Context
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockGETStats.RLock()
+	calls = mock.calls.GETStats
+	mock.lockGETStats.RUnlock()
+	return calls
+}
+
+// GETTransactionGraph calls GETTransactionGraphFunc.
+func (mock *ClientInterfaceMock) GETTransactionGraph(ctx context.Context, txid string, params *api.GETTransactionGraphParams, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.GETTransactionGraphFunc == nil {
+		panic("ClientInterfaceMock.GETTransactionGraphFunc: method is nil but ClientInterface.GETTransactionGraph was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		Params     *api.GETTransactionGraphParams
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		Params:     params,
+		ReqEditors: reqEditors,
+	}
+	mock.lockGETTransactionGraph.Lock()
+	mock.calls.GETTransactionGraph = append(mock.calls.GETTransactionGraph, callInfo)
+	mock.lockGETTransactionGraph.Unlock()
+	return mock.GETTransactionGraphFunc(ctx, txid, params, reqEditors...)
+}
+
+// GETTransactionGraphCalls gets all the calls that were made to GETTransactionGraph.
+// Check the length with:
+//
+//	len(mockedClientInterface.GETTransactionGraphCalls())
+func (mock *ClientInterfaceMock) GETTransactionGraphCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	Params     *api.GETTransactionGraphParams
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		Params     *api.GETTransactionGraphParams
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockGETTransactionGraph.RLock()
+	calls = mock.calls.GETTransactionGraph
+	mock.lockGETTransactionGraph.RUnlock()
+	return calls
+}
+
+// GETTransactionReceipt calls GETTransactionReceiptFunc.
+func (mock *ClientInterfaceMock) GETTransactionReceipt(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.GETTransactionReceiptFunc == nil {
+		panic("ClientInterfaceMock.GETTransactionReceiptFunc: method is nil but ClientInterface.GETTransactionReceipt was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		ReqEditors: reqEditors,
+	}
+	mock.lockGETTransactionReceipt.Lock()
+	mock.calls.GETTransactionReceipt = append(mock.calls.GETTransactionReceipt, callInfo)
+	mock.lockGETTransactionReceipt.Unlock()
+	return mock.GETTransactionReceiptFunc(ctx, txid, reqEditors...)
+}
+
+// GETTransactionReceiptCalls gets all the calls that were made to GETTransactionReceipt.
+// Check the length with:
+//
+//	len(mockedClientInterface.GETTransactionReceiptCalls())
+func (mock *ClientInterfaceMock) GETTransactionReceiptCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockGETTransactionReceipt.RLock()
+	calls = mock.calls.GETTransactionReceipt
+	mock.lockGETTransactionReceipt.RUnlock()
+	return calls
+}
+
 // GETTransactionStatus calls GETTransactionStatusFunc.
 func (mock *ClientInterfaceMock) GETTransactionStatus(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	if mock.GETTransactionStatusFunc == nil {
@@ -301,6 +731,46 @@ func (mock *ClientInterfaceMock) GETTransactionStatusCalls() []struct {
 	return calls
 }
 
+// GETTransactionUpstreams calls GETTransactionUpstreamsFunc.
+func (mock *ClientInterfaceMock) GETTransactionUpstreams(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.GETTransactionUpstreamsFunc == nil {
+		panic("ClientInterfaceMock.GETTransactionUpstreamsFunc: method is nil but ClientInterface.GETTransactionUpstreams was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		ReqEditors: reqEditors,
+	}
+	mock.lockGETTransactionUpstreams.Lock()
+	mock.calls.GETTransactionUpstreams = append(mock.calls.GETTransactionUpstreams, callInfo)
+	mock.lockGETTransactionUpstreams.Unlock()
+	return mock.GETTransactionUpstreamsFunc(ctx, txid, reqEditors...)
+}
+
+// GETTransactionUpstreamsCalls gets all the calls that were made to GETTransactionUpstreams.
+// Check the length with:
+//
+//	len(mockedClientInterface.GETTransactionUpstreamsCalls())
+func (mock *ClientInterfaceMock) GETTransactionUpstreamsCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockGETTransactionUpstreams.RLock()
+	calls = mock.calls.GETTransactionUpstreams
+	mock.lockGETTransactionUpstreams.RUnlock()
+	return calls
+}
+
 // POSTTransaction calls POSTTransactionFunc.
 func (mock *ClientInterfaceMock) POSTTransaction(ctx context.Context, params *api.POSTTransactionParams, body api.POSTTransactionJSONRequestBody, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	if mock.POSTTransactionFunc == nil {
@@ -345,6 +815,46 @@ func (mock *ClientInterfaceMock) POSTTransactionCalls() []struct {
 	return calls
 }
 
+// POSTTransactionResubmit calls POSTTransactionResubmitFunc.
+func (mock *ClientInterfaceMock) POSTTransactionResubmit(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
+	if mock.POSTTransactionResubmitFunc == nil {
+		panic("ClientInterfaceMock.POSTTransactionResubmitFunc: method is nil but ClientInterface.POSTTransactionResubmit was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}{
+		Ctx:        ctx,
+		Txid:       txid,
+		ReqEditors: reqEditors,
+	}
+	mock.lockPOSTTransactionResubmit.Lock()
+	mock.calls.POSTTransactionResubmit = append(mock.calls.POSTTransactionResubmit, callInfo)
+	mock.lockPOSTTransactionResubmit.Unlock()
+	return mock.POSTTransactionResubmitFunc(ctx, txid, reqEditors...)
+}
+
+// POSTTransactionResubmitCalls gets all the calls that were made to POSTTransactionResubmit.
+// Check the length with:
+//
+//	len(mockedClientInterface.POSTTransactionResubmitCalls())
+func (mock *ClientInterfaceMock) POSTTransactionResubmitCalls() []struct {
+	Ctx        context.Context
+	Txid       string
+	ReqEditors []api.RequestEditorFn
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Txid       string
+		ReqEditors []api.RequestEditorFn
+	}
+	mock.lockPOSTTransactionResubmit.RLock()
+	calls = mock.calls.POSTTransactionResubmit
+	mock.lockPOSTTransactionResubmit.RUnlock()
+	return calls
+}
+
 // POSTTransactionWithBody calls POSTTransactionWithBodyFunc.
 func (mock *ClientInterfaceMock) POSTTransactionWithBody(ctx context.Context, params *api.POSTTransactionParams, contentType string, body io.Reader, reqEditors ...api.RequestEditorFn) (*http.Response, error) {
 	if mock.POSTTransactionWithBodyFunc == nil {
@@ -5,7 +5,6 @@ package mocks
 
 import (
 	"github.com/bitcoin-sv/arc/internal/api"
-	"github.com/bitcoin-sv/bdk/module/gobdk/script"
 	"sync"
 )
 
@@ -19,7 +18,7 @@ var _ api.ScriptVerifier = &ScriptVerifierMock{}
 //
 //		// make and configure a mocked api.ScriptVerifier
 //		mockedScriptVerifier := &ScriptVerifierMock{
-//			VerifyScriptFunc: func(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) script.ScriptError {
+//			VerifyScriptFunc: func(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) error {
 //				panic("mock out the VerifyScript method")
 //			},
 //		}
@@ -30,7 +29,7 @@ var _ api.ScriptVerifier = &ScriptVerifierMock{}
 //	}
 type ScriptVerifierMock struct {
 	// VerifyScriptFunc mocks the VerifyScript method.
-	VerifyScriptFunc func(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) script.ScriptError
+	VerifyScriptFunc func(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -50,7 +49,7 @@ type ScriptVerifierMock struct {
 }
 
 // VerifyScript calls VerifyScriptFunc.
-func (mock *ScriptVerifierMock) VerifyScript(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) script.ScriptError {
+func (mock *ScriptVerifierMock) VerifyScript(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) error {
 	if mock.VerifyScriptFunc == nil {
 		panic("ScriptVerifierMock.VerifyScriptFunc: method is nil but ScriptVerifier.VerifyScript was just called")
 	}
@@ -0,0 +1,174 @@
+// Package receipt issues receipts of the acceptance of transactions signed with the miner ID key of ARC and stores them,
+// so that merchants can prove that ARC accepted a payment at a point in time. A receipt can be verified with the public
+// key it carries, without ARC.
+package receipt
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	ec "github.com/bsv-blockchain/go-sdk/primitives/ec"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+)
+
+const keyPrefix = "arc-api-receipt:"
+
+var (
+	ErrInvalidPrivateKey = errors.New("invalid private key")
+	ErrInvalidPublicKey  = errors.New("invalid public key of receipt")
+	ErrInvalidSignature  = errors.New("invalid signature of receipt")
+	ErrFailedToSign      = errors.New("failed to sign receipt")
+	ErrNotFound          = errors.New("receipt not found")
+	ErrFailedToStore     = errors.New("failed to store receipt")
+)
+
+// Receipt is the receipt of the acceptance of a transaction.
+type Receipt struct {
+	TxID      string    `json:"txid"`
+	TxStatus  string    `json:"txStatus"`
+	PolicyID  string    `json:"policyId"`
+	Timestamp time.Time `json:"timestamp"`
+	PublicKey string    `json:"publicKey"`
+	Signature string    `json:"signature"`
+}
+
+// Message returns the signed message, the fields of the receipt joined by `|`, with the timestamp in UTC.
+func (r *Receipt) Message() []byte {
+	return []byte(strings.Join([]string{r.TxID, r.TxStatus, r.PolicyID, r.Timestamp.UTC().Format(time.RFC3339Nano)}, "|"))
+}
+
+// Verify verifies the signature of the receipt with its public key.
+func (r *Receipt) Verify() error {
+	publicKey, err := ec.PublicKeyFromString(r.PublicKey)
+	if err != nil {
+		return errors.Join(ErrInvalidPublicKey, err)
+	}
+
+	signature, err := hex.DecodeString(r.Signature)
+	if err != nil {
+		return errors.Join(ErrInvalidSignature, err)
+	}
+
+	sig, err := ec.ParseDERSignature(signature)
+	if err != nil {
+		return errors.Join(ErrInvalidSignature, err)
+	}
+
+	hash := sha256.Sum256(r.Message())
+	if !sig.Verify(hash[:], publicKey) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Signer signs the receipts with the miner ID key.
+type Signer struct {
+	key       *ec.PrivateKey
+	publicKey string
+}
+
+// NewSigner returns the signer with the miner ID key, a private key in WIF or hex format.
+func NewSigner(privateKey string) (*Signer, error) {
+	key, err := ec.PrivateKeyFromWif(privateKey)
+	if err != nil {
+		key, err = ec.PrivateKeyFromHex(privateKey)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidPrivateKey, err)
+		}
+	}
+
+	return &Signer{key: key, publicKey: hex.EncodeToString(key.PubKey().Compressed())}, nil
+}
+
+// Sign returns the receipt of the transaction accepted with the status at the timestamp under the policy. The timestamp
+// is truncated to milliseconds.
+func (s *Signer) Sign(txID string, txStatus string, policyID string, timestamp time.Time) (*Receipt, error) {
+	r := &Receipt{
+		TxID:      txID,
+		TxStatus:  txStatus,
+		PolicyID:  policyID,
+		Timestamp: timestamp.UTC().Truncate(time.Millisecond),
+		PublicKey: s.publicKey,
+	}
+
+	hash := sha256.Sum256(r.Message())
+	sig, err := s.key.Sign(hash[:])
+	if err != nil {
+		return nil, errors.Join(ErrFailedToSign, err)
+	}
+	r.Signature = hex.EncodeToString(sig.Serialize())
+
+	return r, nil
+}
+
+// PublicKey returns the compressed public key of the miner ID key in hex.
+func (s *Signer) PublicKey() string {
+	return s.publicKey
+}
+
+// PolicyID returns the SHA-256 hash in hex of the JSON of the policy.
+func PolicyID(policy any) (string, error) {
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Store stores the receipts for the retention.
+type Store struct {
+	store     cache.Store
+	retention time.Duration
+}
+
+func NewStore(store cache.Store, retention time.Duration) *Store {
+	return &Store{store: store, retention: retention}
+}
+
+// Save stores the receipt unless a receipt of the transaction is stored already, e.g. of a former submission of the
+// transaction, and returns the stored receipt.
+func (s *Store) Save(r *Receipt) (*Receipt, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToStore, err)
+	}
+
+	stored, err := s.store.SetNX(keyPrefix+r.TxID, b, s.retention)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToStore, err)
+	}
+
+	if stored {
+		return r, nil
+	}
+
+	return s.Get(r.TxID)
+}
+
+// Get returns the stored receipt of the transaction.
+func (s *Store) Get(txID string) (*Receipt, error) {
+	b, err := s.store.Get(keyPrefix + txID)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheNotFound) {
+			return nil, errors.Join(ErrNotFound, fmt.Errorf("txid: %s", txID))
+		}
+		return nil, err
+	}
+
+	var r Receipt
+	err = json.Unmarshal(b, &r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,122 @@
+package receipt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/receipt"
+	"github.com/bitcoin-sv/arc/internal/cache"
+)
+
+const (
+	testKey   = "KznvCNc6Yf4iztSThoMH6oHWzH9EgjfodKxmeuUGPq5DEX5maspS"
+	testTxID  = "a147cc3c71cc13b29f18273cf50ffeb59fc9758152e2b33e21a8092f0b049118"
+	otherTxID = "6bdbcfab0526d30e8d68279f79dff61fb4026ace8b7b32789af016336e54f2f0"
+)
+
+func TestSigner_Sign(t *testing.T) {
+	tt := []struct {
+		name   string
+		tamper func(r *receipt.Receipt)
+
+		expectedErr error
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:   "status tampered",
+			tamper: func(r *receipt.Receipt) { r.TxStatus = "MINED" },
+
+			expectedErr: receipt.ErrInvalidSignature,
+		},
+		{
+			name:   "timestamp tampered",
+			tamper: func(r *receipt.Receipt) { r.Timestamp = r.Timestamp.Add(-time.Hour) },
+
+			expectedErr: receipt.ErrInvalidSignature,
+		},
+		{
+			name:   "invalid public key",
+			tamper: func(r *receipt.Receipt) { r.PublicKey = "02" },
+
+			expectedErr: receipt.ErrInvalidPublicKey,
+		},
+		{
+			name:   "invalid signature",
+			tamper: func(r *receipt.Receipt) { r.Signature = "3044" },
+
+			expectedErr: receipt.ErrInvalidSignature,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := receipt.NewSigner(testKey)
+			require.NoError(t, err)
+
+			// when
+			actual, err := sut.Sign(testTxID, "SEEN_ON_NETWORK", "policy-1", time.Date(2024, 1, 1, 12, 0, 0, 123456789, time.UTC))
+			require.NoError(t, err)
+			assert.Equal(t, sut.PublicKey(), actual.PublicKey)
+			assert.Equal(t, time.Date(2024, 1, 1, 12, 0, 0, 123000000, time.UTC), actual.Timestamp)
+			if tc.tamper != nil {
+				tc.tamper(actual)
+			}
+
+			// then
+			require.ErrorIs(t, actual.Verify(), tc.expectedErr)
+		})
+	}
+}
+
+func TestReceipt_Message(t *testing.T) {
+	// given
+	r := &receipt.Receipt{TxID: testTxID, TxStatus: "SEEN_ON_NETWORK", PolicyID: "policy-1", Timestamp: time.Date(2024, 1, 1, 12, 0, 0, 123000000, time.UTC)}
+
+	// when
+	actual := string(r.Message())
+
+	// then
+	require.Equal(t, testTxID+"|SEEN_ON_NETWORK|policy-1|2024-01-01T12:00:00.123Z", actual)
+}
+
+func TestNewSigner(t *testing.T) {
+	// when
+	_, err := receipt.NewSigner("invalid")
+
+	// then
+	require.ErrorIs(t, err, receipt.ErrInvalidPrivateKey)
+}
+
+func TestStore(t *testing.T) {
+	// given
+	signer, err := receipt.NewSigner(testKey)
+	require.NoError(t, err)
+	sut := receipt.NewStore(cache.NewMemoryStore(), time.Hour)
+
+	first, err := signer.Sign(testTxID, "SEEN_ON_NETWORK", "policy-1", time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	second, err := signer.Sign(testTxID, "MINED", "policy-1", time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	// when
+	saved, saveErr := sut.Save(first)
+	resaved, resaveErr := sut.Save(second)
+	stored, getErr := sut.Get(testTxID)
+	_, notFoundErr := sut.Get(otherTxID)
+
+	// then
+	require.NoError(t, saveErr)
+	require.NoError(t, resaveErr)
+	require.NoError(t, getErr)
+	assert.Equal(t, first, saved)
+	assert.Equal(t, first, resaved)
+	assert.Equal(t, first, stored)
+	require.NoError(t, stored.Verify())
+	require.ErrorIs(t, notFoundErr, receipt.ErrNotFound)
+}
@@ -0,0 +1,348 @@
+// Package reverification verifies the Merkle roots of submitted BEEF transactions again which could not be verified on
+// submission, because no block header service was available or the services did not reach a quorum. Depending on the
+// degraded mode the transactions are either accepted and re-verified afterwards, or their submission is deferred until
+// their Merkle roots are verified.
+//
+// The BEEFs are kept in the store of metamorph, so that they survive restarts and are shared by all API instances.
+// Every instance re-verifies the BEEFs which are not locked by another instance. Deferred transactions whose Merkle
+// roots are found invalid or could not be verified in time are rejected, which is sent to their callbacks. Accepted
+// transactions are annotated instead.
+package reverification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	beefValidator "github.com/bitcoin-sv/arc/internal/validator/beef"
+)
+
+const (
+	jobName = "MerkleRootsReverification"
+
+	intervalDefault        = 30 * time.Second
+	maxAgeDefault          = time.Hour
+	maxTransactionsDefault = 10000
+	loadLimit              = 100
+
+	// author is the author of the annotations of accepted transactions whose Merkle roots were given up on
+	author = "merkle-roots-reverification"
+)
+
+// Mode is what happens with the BEEF transactions whose Merkle roots could not be verified on submission.
+type Mode string
+
+const (
+	// ModeReject rejects the transactions, which is the default.
+	ModeReject Mode = "reject"
+	// ModeAccept accepts the transactions with a warning and verifies their Merkle roots again afterwards.
+	ModeAccept Mode = "accept"
+	// ModeDefer defers the submission of the transactions until their Merkle roots are verified.
+	ModeDefer Mode = "defer"
+)
+
+const (
+	resultVerified = "verified"
+	resultInvalid  = "invalid"
+	resultExpired  = "expired"
+	resultFailed   = "submission_failed"
+)
+
+// LabelInvalid and LabelExpired are the labels with which accepted transactions are annotated if their Merkle roots
+// are found invalid or could not be verified in time.
+const (
+	LabelInvalid = "merkle-roots-invalid"
+	LabelExpired = "merkle-roots-unverified"
+)
+
+var (
+	ErrUnknownMode = errors.New("unknown degraded mode")
+	ErrQueueFull   = errors.New("maximum number of unverified transactions reached")
+)
+
+var (
+	unverifiedTxs = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "arc_api_merkle_roots_unverified_txs",
+		Help: "Current number of transactions whose Merkle roots are re-verified by mode",
+	}, []string{"mode"})
+	reverifications = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_api_merkle_roots_reverifications_total",
+		Help: "Nr of transactions whose Merkle roots were re-verified by mode and result",
+	}, []string{"mode", "result"})
+)
+
+// Collectors returns the collectors of the metrics of the re-verification.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{unverifiedTxs, reverifications}
+}
+
+// ParseMode returns the degraded mode with the name, an empty name is the reject mode.
+func ParseMode(name string) (Mode, error) {
+	switch mode := Mode(name); mode {
+	case "":
+		return ModeReject, nil
+	case ModeReject, ModeAccept, ModeDefer:
+		return mode, nil
+	default:
+		return ModeReject, fmt.Errorf("%w: %s", ErrUnknownMode, name)
+	}
+}
+
+// Client keeps the BEEFs whose Merkle roots are re-verified in metamorph and submits, rejects or annotates their
+// transactions once the Merkle roots are verified, found invalid or could not be verified in time.
+type Client interface {
+	AddReverification(ctx context.Context, reverification *metamorph.Reverification, maxTransactions int) error
+	GetReverifications(ctx context.Context, limit int64, lockedUntil time.Time) ([]*metamorph.Reverification, int64, error)
+	DeleteReverification(ctx context.Context, id int64) error
+	GetUnverifiedTransactions(ctx context.Context, txIDs []string) ([]string, error)
+	SubmitTransactions(ctx context.Context, tx sdkTx.Transactions, options *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error)
+	RejectTransactions(ctx context.Context, txs sdkTx.Transactions, options *metamorph.TransactionOptions, reason string) ([]*metamorph.TransactionStatus, error)
+	AnnotateTransaction(ctx context.Context, txID string, note string, labels []string, author string) error
+}
+
+// Reverifier verifies the Merkle roots of the BEEF transactions which could not be verified on submission again in
+// every interval until they are verified, found invalid or expire.
+type Reverifier struct {
+	logger          *slog.Logger
+	now             func() time.Time
+	mode            Mode
+	chainTracker    beefValidator.ChainTracker
+	client          Client
+	interval        time.Duration
+	maxAge          time.Duration
+	maxTransactions int
+
+	scheduler *scheduler.Scheduler
+}
+
+// WithInterval sets the interval in which the Merkle roots are verified again.
+func WithInterval(interval time.Duration) func(*Reverifier) {
+	return func(r *Reverifier) {
+		r.interval = interval
+	}
+}
+
+// WithMaxAge sets the duration after which the transactions whose Merkle roots could still not be verified are given
+// up on. Deferred transactions are then rejected, accepted transactions annotated.
+func WithMaxAge(maxAge time.Duration) func(*Reverifier) {
+	return func(r *Reverifier) {
+		r.maxAge = maxAge
+	}
+}
+
+// WithMaxTransactions sets the maximum number of transactions re-verified by all API instances. Further transactions
+// whose Merkle roots can not be verified are rejected.
+func WithMaxTransactions(maxTransactions int) func(*Reverifier) {
+	return func(r *Reverifier) {
+		r.maxTransactions = maxTransactions
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Reverifier) {
+	return func(r *Reverifier) {
+		r.now = nowFunc
+	}
+}
+
+// New returns a re-verifier of the transactions accepted or deferred by the mode. The Merkle roots are verified
+// against the chain tracker, the BEEFs are kept in metamorph with the client.
+func New(logger *slog.Logger, mode Mode, chainTracker beefValidator.ChainTracker, client Client, opts ...func(*Reverifier)) *Reverifier {
+	r := &Reverifier{
+		logger:          logger.With(slog.String("module", "reverification")),
+		now:             time.Now,
+		mode:            mode,
+		chainTracker:    chainTracker,
+		client:          client,
+		interval:        intervalDefault,
+		maxAge:          maxAgeDefault,
+		maxTransactions: maxTransactionsDefault,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Mode returns the degraded mode by which the transactions are accepted or deferred.
+func (r *Reverifier) Mode() Mode {
+	return r.mode
+}
+
+// Start verifies the Merkle roots again in every interval until the re-verifier is shut down.
+func (r *Reverifier) Start() error {
+	r.scheduler = scheduler.New(r.logger)
+
+	return r.scheduler.Add(jobName, scheduler.Every(r.interval), r.Reverify)
+}
+
+// Add keeps the BEEF whose Merkle roots could not be verified with its transactions to be submitted for
+// re-verification. The options are those the transactions are submitted with if they are deferred.
+func (r *Reverifier) Add(ctx context.Context, beefTx *sdkTx.Beef, txs sdkTx.Transactions, options *metamorph.TransactionOptions) error {
+	beefBytes, err := beefTx.Bytes()
+	if err != nil {
+		return err
+	}
+
+	txIDs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txIDs = append(txIDs, hexutils.TxID(tx.TxID()))
+	}
+
+	err = r.client.AddReverification(ctx, &metamorph.Reverification{
+		Mode:    string(r.mode),
+		Beef:    beefBytes,
+		TxIDs:   txIDs,
+		Options: options,
+	}, r.maxTransactions)
+	if err != nil {
+		if errors.Is(err, metamorph.ErrReverificationsFull) {
+			return errors.Join(ErrQueueFull, fmt.Errorf("maximum: %d", r.maxTransactions))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Unverified returns those of the transactions whose Merkle roots are still to be verified.
+func (r *Reverifier) Unverified(ctx context.Context, txIDs []string) (map[string]struct{}, error) {
+	unverified, err := r.client.GetUnverifiedTransactions(ctx, txIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]struct{}, len(unverified))
+	for _, txID := range unverified {
+		result[txID] = struct{}{}
+	}
+
+	return result, nil
+}
+
+// Reverify verifies the Merkle roots of the kept BEEFs which are not locked by another API instance again. Deferred
+// transactions whose Merkle roots are verified are submitted. Transactions whose Merkle roots are found invalid or
+// which expired are given up on.
+func (r *Reverifier) Reverify(ctx context.Context) {
+	for ctx.Err() == nil {
+		// the BEEFs are locked for half the interval, so that those which are still unverifiable are re-verified in the
+		// next interval again
+		batch, unverified, err := r.client.GetReverifications(ctx, loadLimit, r.now().Add(r.interval/2))
+		if err != nil {
+			r.logger.Error("Failed to get transactions with unverified Merkle roots", slog.String("err", err.Error()))
+			return
+		}
+		unverifiedTxs.WithLabelValues(string(r.mode)).Set(float64(unverified))
+
+		for _, reverification := range batch {
+			if ctx.Err() != nil {
+				return
+			}
+
+			result, finished := r.reverify(ctx, reverification)
+			if result != "" {
+				reverifications.WithLabelValues(reverification.Mode, result).Add(float64(len(reverification.TxIDs)))
+			}
+			if !finished {
+				continue
+			}
+
+			err = r.client.DeleteReverification(ctx, reverification.ID)
+			if err != nil {
+				r.logger.Error("Failed to delete re-verified transactions", slog.Any("txIDs", reverification.TxIDs), slog.String("err", err.Error()))
+			}
+		}
+
+		if len(batch) < loadLimit {
+			return
+		}
+	}
+}
+
+// reverify verifies the Merkle roots of the BEEF and returns the result and whether the BEEF is finished.
+func (r *Reverifier) reverify(ctx context.Context, reverification *metamorph.Reverification) (string, bool) {
+	// the BEEF is kept in version 2, which has no subject transaction
+	beefTx, err := sdkTx.NewBeefFromBytes(reverification.Beef)
+	if err != nil {
+		r.logger.Error("Failed to decode BEEF with unverified Merkle roots", slog.Any("txIDs", reverification.TxIDs), slog.String("err", err.Error()))
+		return r.giveUp(ctx, reverification, nil, resultInvalid, "BEEF is invalid", LabelInvalid)
+	}
+
+	txs := make(sdkTx.Transactions, 0, len(reverification.TxIDs))
+	for _, txID := range reverification.TxIDs {
+		if tx := beefTx.FindTransaction(txID); tx != nil {
+			txs = append(txs, tx)
+		}
+	}
+
+	ok, err := beefTx.Verify(ctx, r.chainTracker, false)
+	if err != nil {
+		if r.now().Sub(reverification.AddedAt) < r.maxAge {
+			return "", false
+		}
+
+		r.logger.Warn("Giving up on re-verifying transactions, Merkle roots could not be verified", slog.String("mode", reverification.Mode), slog.Any("txIDs", reverification.TxIDs), slog.String("err", err.Error()))
+		return r.giveUp(ctx, reverification, txs, resultExpired, fmt.Sprintf("Merkle roots of the BEEF could not be verified within %s", r.maxAge), LabelExpired)
+	}
+
+	if !ok {
+		r.logger.Error("Transactions have invalid Merkle roots", slog.String("mode", reverification.Mode), slog.Any("txIDs", reverification.TxIDs))
+		return r.giveUp(ctx, reverification, txs, resultInvalid, "Merkle roots of the BEEF are invalid", LabelInvalid)
+	}
+
+	if Mode(reverification.Mode) == ModeDefer {
+		_, err = r.client.SubmitTransactions(ctx, txs, reverification.Options)
+		if err != nil {
+			r.logger.Warn("Failed to submit deferred transactions", slog.Any("txIDs", reverification.TxIDs), slog.String("err", err.Error()))
+			return resultFailed, false
+		}
+		r.logger.Info("Submitted deferred transactions, Merkle roots verified", slog.Any("txIDs", reverification.TxIDs))
+		return resultVerified, true
+	}
+
+	r.logger.Info("Merkle roots of accepted transactions verified", slog.Any("txIDs", reverification.TxIDs))
+	return resultVerified, true
+}
+
+// giveUp rejects deferred transactions for the reason, so that the rejection is sent to their callbacks, and annotates
+// accepted transactions with the reason and the label. It returns the result and whether the BEEF is finished, it is
+// given up on again in the next interval if the transactions could not be rejected or annotated.
+func (r *Reverifier) giveUp(ctx context.Context, reverification *metamorph.Reverification, txs sdkTx.Transactions, result string, reason string, label string) (string, bool) {
+	if Mode(reverification.Mode) == ModeDefer {
+		if len(txs) == 0 {
+			return result, true
+		}
+
+		_, err := r.client.RejectTransactions(ctx, txs, reverification.Options, reason)
+		if err != nil {
+			r.logger.Error("Failed to reject deferred transactions", slog.Any("txIDs", reverification.TxIDs), slog.String("err", err.Error()))
+			return "", false
+		}
+		return result, true
+	}
+
+	for _, txID := range reverification.TxIDs {
+		err := r.client.AnnotateTransaction(ctx, txID, reason, []string{label}, author)
+		if err != nil && !errors.Is(err, metamorph.ErrTransactionNotFound) {
+			r.logger.Error("Failed to annotate accepted transaction", slog.String("txID", txID), slog.String("err", err.Error()))
+			return "", false
+		}
+	}
+
+	return result, true
+}
+
+func (r *Reverifier) Shutdown() {
+	if r.scheduler != nil {
+		r.scheduler.Shutdown()
+	}
+}
@@ -0,0 +1,3 @@
+package reverification
+
+//go:generate moq -pkg mocks -out ./mocks/client_mock.go . Client
@@ -0,0 +1,270 @@
+package reverification_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/api/reverification"
+	"github.com/bitcoin-sv/arc/internal/api/reverification/mocks"
+	"github.com/bitcoin-sv/arc/internal/beef"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	beefValidator "github.com/bitcoin-sv/arc/internal/validator/beef"
+	beefMocks "github.com/bitcoin-sv/arc/internal/validator/beef/mocks"
+)
+
+const (
+	validBeef     = "0100beef01fe636d0c0007021400fe507c0c7aa754cef1f7889d5fd395cf1f785dd7de98eed895dbedfe4e5bc70d1502ac4e164f5bc16746bb0868404292ac8318bbac3800e4aad13a014da427adce3e010b00bc4ff395efd11719b277694cface5aa50d085a0bb81f613f70313acd28cf4557010400574b2d9142b8d28b61d88e3b2c3f44d858411356b49a28a4643b6d1a6a092a5201030051a05fc84d531b5d250c23f4f886f6812f9fe3f402d61607f977b4ecd2701c19010000fd781529d58fc2523cf396a7f25440b409857e7e221766c57214b1d38c7b481f01010062f542f45ea3660f86c013ced80534cb5fd4c19d66c56e7e8c5d4bf2d40acc5e010100b121e91836fd7cd5102b654e9f72f3cf6fdbfd0b161c53a9c54b12c841126331020100000001cd4e4cac3c7b56920d1e7655e7e260d31f29d9a388d04910f1bbd72304a79029010000006b483045022100e75279a205a547c445719420aa3138bf14743e3f42618e5f86a19bde14bb95f7022064777d34776b05d816daf1699493fcdf2ef5a5ab1ad710d9c97bfb5b8f7cef3641210263e2dee22b1ddc5e11f6fab8bcd2378bdd19580d640501ea956ec0e786f93e76ffffffff013e660000000000001976a9146bfd5c7fbe21529d45803dbcf0c87dd3c71efbc288ac0000000001000100000001ac4e164f5bc16746bb0868404292ac8318bbac3800e4aad13a014da427adce3e000000006a47304402203a61a2e931612b4bda08d541cfb980885173b8dcf64a3471238ae7abcd368d6402204cbf24f04b9aa2256d8901f0ed97866603d2be8324c2bfb7a37bf8fc90edd5b441210263e2dee22b1ddc5e11f6fab8bcd2378bdd19580d640501ea956ec0e786f93e76ffffffff013c660000000000001976a9146bfd5c7fbe21529d45803dbcf0c87dd3c71efbc288ac0000000000"
+	validBeefTxID = "157428aee67d11123203735e4c540fa1bdab3b36d5882c6f8c5ff79f07d20d1c"
+)
+
+func TestParseMode(t *testing.T) {
+	tt := []struct {
+		name string
+
+		expectedMode reverification.Mode
+		expectedErr  error
+	}{
+		{name: "", expectedMode: reverification.ModeReject},
+		{name: "reject", expectedMode: reverification.ModeReject},
+		{name: "accept", expectedMode: reverification.ModeAccept},
+		{name: "defer", expectedMode: reverification.ModeDefer},
+		{name: "queue", expectedMode: reverification.ModeReject, expectedErr: reverification.ErrUnknownMode},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := reverification.ParseMode(tc.name)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.expectedMode, actual)
+		})
+	}
+}
+
+func TestReverifier_Reverify(t *testing.T) {
+	tt := []struct {
+		name      string
+		mode      reverification.Mode
+		validRoot bool
+		rootErr   error
+		submitErr error
+		rejectErr error
+		age       time.Duration
+
+		expectedSubmitted int
+		expectedRejected  int
+		expectedLabel     string
+		expectedDeleted   int
+	}{
+		{
+			name:      "accepted - verified",
+			mode:      reverification.ModeAccept,
+			validRoot: true,
+
+			expectedDeleted: 1,
+		},
+		{
+			name: "accepted - invalid",
+			mode: reverification.ModeAccept,
+
+			expectedLabel:   reverification.LabelInvalid,
+			expectedDeleted: 1,
+		},
+		{
+			name:    "accepted - still unverifiable",
+			mode:    reverification.ModeAccept,
+			rootErr: beefValidator.ErrNoChainTrackersAvailable,
+		},
+		{
+			name:    "accepted - expired",
+			mode:    reverification.ModeAccept,
+			rootErr: beefValidator.ErrNoChainTrackersAvailable,
+			age:     time.Hour,
+
+			expectedLabel:   reverification.LabelExpired,
+			expectedDeleted: 1,
+		},
+		{
+			name:      "deferred - verified and submitted",
+			mode:      reverification.ModeDefer,
+			validRoot: true,
+
+			expectedSubmitted: 1,
+			expectedDeleted:   1,
+		},
+		{
+			name:      "deferred - submission failed",
+			mode:      reverification.ModeDefer,
+			validRoot: true,
+			submitErr: errors.New("metamorph unavailable"),
+
+			expectedSubmitted: 1,
+		},
+		{
+			name: "deferred - invalid",
+			mode: reverification.ModeDefer,
+
+			expectedRejected: 1,
+			expectedDeleted:  1,
+		},
+		{
+			name:    "deferred - expired",
+			mode:    reverification.ModeDefer,
+			rootErr: beefValidator.ErrNoQuorum,
+			age:     time.Hour,
+
+			expectedRejected: 1,
+			expectedDeleted:  1,
+		},
+		{
+			name:      "deferred - rejection failed",
+			mode:      reverification.ModeDefer,
+			rejectErr: errors.New("metamorph unavailable"),
+
+			expectedRejected: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			beefBytes, err := hex.DecodeString(validBeef)
+			require.NoError(t, err)
+
+			chainTracker := &beefMocks.ChainTrackerMock{
+				IsValidRootForHeightFunc: func(_ context.Context, _ *chainhash.Hash, _ uint32) (bool, error) {
+					return tc.validRoot, tc.rootErr
+				},
+				CurrentHeightFunc: func(_ context.Context) (uint32, error) {
+					return 1, nil
+				},
+			}
+
+			now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+			options := &metamorph.TransactionOptions{CallbackURL: "https://callback.example.com"}
+			client := &mocks.ClientMock{
+				GetReverificationsFunc: func(_ context.Context, _ int64, lockedUntil time.Time) ([]*metamorph.Reverification, int64, error) {
+					require.True(t, lockedUntil.After(now))
+					return []*metamorph.Reverification{{ID: 1, Mode: string(tc.mode), Beef: beefBytes, TxIDs: []string{validBeefTxID}, Options: options, AddedAt: now}}, 1, nil
+				},
+				SubmitTransactionsFunc: func(_ context.Context, txs sdkTx.Transactions, actualOptions *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
+					require.Len(t, txs, 1)
+					require.Equal(t, validBeefTxID, txs[0].TxID().String())
+					require.Equal(t, options, actualOptions)
+					return nil, tc.submitErr
+				},
+				RejectTransactionsFunc: func(_ context.Context, txs sdkTx.Transactions, actualOptions *metamorph.TransactionOptions, reason string) ([]*metamorph.TransactionStatus, error) {
+					require.Len(t, txs, 1)
+					require.Equal(t, options, actualOptions)
+					require.Contains(t, reason, "Merkle roots of the BEEF")
+					return nil, tc.rejectErr
+				},
+				AnnotateTransactionFunc: func(_ context.Context, txID string, _ string, labels []string, _ string) error {
+					require.Equal(t, validBeefTxID, txID)
+					require.Equal(t, []string{tc.expectedLabel}, labels)
+					return nil
+				},
+				DeleteReverificationFunc: func(_ context.Context, id int64) error {
+					require.Equal(t, int64(1), id)
+					return nil
+				},
+			}
+
+			sut := reverification.New(slog.Default(), tc.mode, chainTracker, client, reverification.WithNow(func() time.Time { return now.Add(tc.age) }), reverification.WithMaxAge(30*time.Minute))
+
+			// when
+			sut.Reverify(context.Background())
+
+			// then
+			require.Len(t, client.SubmitTransactionsCalls(), tc.expectedSubmitted)
+			require.Len(t, client.RejectTransactionsCalls(), tc.expectedRejected)
+			require.Len(t, client.DeleteReverificationCalls(), tc.expectedDeleted)
+			if tc.expectedLabel == "" {
+				require.Empty(t, client.AnnotateTransactionCalls())
+			} else {
+				require.Len(t, client.AnnotateTransactionCalls(), 1)
+			}
+		})
+	}
+}
+
+func TestReverifier_Add(t *testing.T) {
+	tt := []struct {
+		name   string
+		addErr error
+
+		expectedErr error
+	}{
+		{
+			name: "added",
+		},
+		{
+			name:   "maximum number of transactions reached",
+			addErr: metamorph.ErrReverificationsFull,
+
+			expectedErr: reverification.ErrQueueFull,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			beefBytes, err := hex.DecodeString(validBeef)
+			require.NoError(t, err)
+			beefTx, _, err := beef.DecodeBEEF(beefBytes)
+			require.NoError(t, err)
+
+			client := &mocks.ClientMock{
+				AddReverificationFunc: func(_ context.Context, r *metamorph.Reverification, maxTransactions int) error {
+					require.Equal(t, string(reverification.ModeDefer), r.Mode)
+					require.Equal(t, []string{validBeefTxID}, r.TxIDs)
+					require.Equal(t, "https://callback.example.com", r.Options.CallbackURL)
+					require.Equal(t, 5, maxTransactions)
+
+					// the kept BEEF is decoded on re-verification
+					keptBeef, decodeErr := sdkTx.NewBeefFromBytes(r.Beef)
+					require.NoError(t, decodeErr)
+					require.NotNil(t, keptBeef.FindTransaction(validBeefTxID))
+					return tc.addErr
+				},
+			}
+
+			sut := reverification.New(slog.Default(), reverification.ModeDefer, &beefMocks.ChainTrackerMock{}, client, reverification.WithMaxTransactions(5))
+
+			// when
+			err = sut.Add(context.Background(), beefTx, sdkTx.Transactions{beefTx.FindTransaction(validBeefTxID)}, &metamorph.TransactionOptions{CallbackURL: "https://callback.example.com"})
+
+			// then
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Len(t, client.AddReverificationCalls(), 1)
+		})
+	}
+}
+
+func TestReverifier_Unverified(t *testing.T) {
+	// given
+	client := &mocks.ClientMock{
+		GetUnverifiedTransactionsFunc: func(_ context.Context, txIDs []string) ([]string, error) {
+			require.Equal(t, []string{validBeefTxID, "a"}, txIDs)
+			return []string{validBeefTxID}, nil
+		},
+	}
+	sut := reverification.New(slog.Default(), reverification.ModeAccept, &beefMocks.ChainTrackerMock{}, client)
+
+	// when
+	actual, err := sut.Unverified(context.Background(), []string{validBeefTxID, "a"})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{validBeefTxID: {}}, actual)
+}
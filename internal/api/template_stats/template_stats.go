@@ -0,0 +1,254 @@
+// Package templatestats counts the submitted transactions by the script templates and protocol prefixes of their
+// outputs and detects anomalies of the submission rates, e.g. spam floods of a specific template. The submissions of a
+// template whose rate is anomalous can optionally be clamped.
+package templatestats
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+const (
+	windowDefault        = time.Minute
+	anomalyFactorDefault = 10
+	minRateDefault       = 10
+	warmupWindowsDefault = 5
+	// baselineWeight is the weight of the rate of a completed window in the exponentially weighted moving average
+	baselineWeight = 0.2
+)
+
+var ErrFailedToRegisterStats = errors.New("failed to register template stats collector")
+
+// Detector keeps the submission rate of each template bucket, see validator.TemplateBuckets, in fixed windows and
+// compares the rate of the current window with the baseline of the bucket, which is the moving average of the rates of
+// the previous windows. A bucket is anomalous from the moment its rate exceeds the baseline by the anomaly factor until
+// the rate of a window is back below that threshold. The baseline is not updated while the bucket is anomalous, so that
+// a flood doesn't become the new normal.
+type Detector struct {
+	logger        *slog.Logger
+	now           func() time.Time
+	templates     []validator.ScriptTemplate
+	window        time.Duration
+	anomalyFactor float64
+	minRate       float64
+	warmupWindows int
+	clamping      bool
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+
+	submitted *prometheus.CounterVec
+	rate      *prometheus.GaugeVec
+	anomalous *prometheus.GaugeVec
+	anomalies *prometheus.CounterVec
+	clamped   *prometheus.CounterVec
+}
+
+type bucket struct {
+	windowStart time.Time
+	count       float64
+	baseline    float64
+	windows     int
+	anomalous   bool
+}
+
+// WithTemplates sets the templates, e.g. the prefixes of protocols, which the outputs are bucketed by before they are
+// bucketed by the standard templates.
+func WithTemplates(templates []validator.ScriptTemplate) func(*Detector) {
+	return func(d *Detector) {
+		d.templates = templates
+	}
+}
+
+// WithWindow sets the duration of the windows in which the submission rates are measured.
+func WithWindow(window time.Duration) func(*Detector) {
+	return func(d *Detector) {
+		d.window = window
+	}
+}
+
+// WithAnomalyThreshold sets the factor by which the rate of a bucket has to exceed its baseline to be anomalous and the
+// minimum rate in transactions per second below which a bucket is never anomalous, e.g. after a quiet period.
+func WithAnomalyThreshold(factor float64, minRate float64) func(*Detector) {
+	return func(d *Detector) {
+		d.anomalyFactor = factor
+		d.minRate = minRate
+	}
+}
+
+// WithWarmupWindows sets the number of windows a bucket is observed before its baseline is used to detect anomalies.
+func WithWarmupWindows(windows int) func(*Detector) {
+	return func(d *Detector) {
+		d.warmupWindows = windows
+	}
+}
+
+// WithRateClamping enables the clamping of anomalous buckets to the threshold rate. The submissions exceeding it are
+// rejected until the bucket is no longer anomalous.
+func WithRateClamping(enabled bool) func(*Detector) {
+	return func(d *Detector) {
+		d.clamping = enabled
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Detector) {
+	return func(d *Detector) {
+		d.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Detector)) (*Detector, error) {
+	d := &Detector{
+		logger:        logger.With(slog.String("module", "template-stats")),
+		now:           time.Now,
+		window:        windowDefault,
+		anomalyFactor: anomalyFactorDefault,
+		minRate:       minRateDefault,
+		warmupWindows: warmupWindowsDefault,
+		buckets:       make(map[string]*bucket),
+		submitted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_submitted_txs_by_template",
+			Help: "Nr of submitted txs with outputs of the script template or protocol prefix",
+		}, []string{"template"}),
+		rate: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "arc_api_template_submission_rate",
+			Help: "Submission rate in txs per second of the script template in the last completed window",
+		}, []string{"template"}),
+		anomalous: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "arc_api_template_anomaly",
+			Help: "1 while the submission rate of the script template is anomalous, 0 otherwise",
+		}, []string{"template"}),
+		anomalies: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_template_anomalies_total",
+			Help: "Nr of detected anomalies of the submission rate of the script template",
+		}, []string{"template"}),
+		clamped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_api_template_clamped_txs_total",
+			Help: "Nr of submitted txs rejected because the submission rate of the script template is clamped",
+		}, []string{"template"}),
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	for _, c := range []prometheus.Collector{d.submitted, d.rate, d.anomalous, d.anomalies, d.clamped} {
+		err := prometheus.Register(c)
+		if err != nil {
+			d.UnregisterStats()
+			return nil, errors.Join(ErrFailedToRegisterStats, err)
+		}
+	}
+
+	return d, nil
+}
+
+// Observe counts the submission of the transaction in the buckets of its outputs. If clamping is enabled and one of
+// the buckets exceeds its clamped rate, the bucket is returned and the transaction is expected to be rejected.
+func (d *Detector) Observe(tx *sdkTx.Transaction) (clampedBucket string, clamped bool) {
+	now := d.now()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, name := range validator.TemplateBuckets(tx, d.templates) {
+		b, found := d.buckets[name]
+		if !found {
+			b = &bucket{windowStart: now}
+			d.buckets[name] = b
+		}
+
+		d.completeWindows(name, b, now)
+
+		b.count++
+		d.submitted.WithLabelValues(name).Inc()
+
+		limit := d.threshold(b) * d.window.Seconds()
+		if b.count <= limit || b.windows < d.warmupWindows {
+			continue
+		}
+
+		if !b.anomalous {
+			d.startAnomaly(name, b)
+		}
+
+		if d.clamping && !clamped {
+			d.clamped.WithLabelValues(name).Inc()
+			clampedBucket, clamped = name, true
+		}
+	}
+
+	return clampedBucket, clamped
+}
+
+// threshold returns the rate in transactions per second above which the bucket is anomalous.
+func (d *Detector) threshold(b *bucket) float64 {
+	return math.Max(d.minRate, b.baseline*d.anomalyFactor)
+}
+
+// completeWindows completes the windows of the bucket which ended before now. Windows without submissions complete
+// with a rate of 0.
+func (d *Detector) completeWindows(name string, b *bucket, now time.Time) {
+	for !now.Before(b.windowStart.Add(d.window)) {
+		rate := b.count / d.window.Seconds()
+		d.rate.WithLabelValues(name).Set(rate)
+
+		if b.anomalous && rate <= d.threshold(b) {
+			d.endAnomaly(name, b)
+		}
+
+		if !b.anomalous {
+			if b.windows == 0 {
+				b.baseline = rate
+			} else {
+				b.baseline = baselineWeight*rate + (1-baselineWeight)*b.baseline
+			}
+		}
+
+		b.windows++
+		b.count = 0
+		b.windowStart = b.windowStart.Add(d.window)
+
+		// the baseline of a bucket without submissions for a long time is reset instead of decayed window by window
+		if now.Sub(b.windowStart) > time.Duration(d.warmupWindows+1)*d.window {
+			b.windowStart = now
+			b.baseline = 0
+			b.windows = 0
+			if b.anomalous {
+				d.endAnomaly(name, b)
+			}
+		}
+	}
+}
+
+func (d *Detector) startAnomaly(name string, b *bucket) {
+	b.anomalous = true
+	d.anomalies.WithLabelValues(name).Inc()
+	d.anomalous.WithLabelValues(name).Set(1)
+	d.logger.Warn("Anomalous submission rate of script template",
+		slog.String("template", name),
+		slog.Float64("baseline", b.baseline),
+		slog.Float64("threshold", d.threshold(b)),
+		slog.Bool("clamping", d.clamping),
+	)
+}
+
+func (d *Detector) endAnomaly(name string, b *bucket) {
+	b.anomalous = false
+	d.anomalous.WithLabelValues(name).Set(0)
+	d.logger.Info("Submission rate of script template back to normal", slog.String("template", name), slog.Float64("baseline", b.baseline))
+}
+
+func (d *Detector) UnregisterStats() {
+	for _, c := range []prometheus.Collector{d.submitted, d.rate, d.anomalous, d.anomalies, d.clamped} {
+		_ = prometheus.Unregister(c)
+	}
+}
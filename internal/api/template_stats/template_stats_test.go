@@ -0,0 +1,95 @@
+package templatestats_test
+
+import (
+	"log/slog"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	templatestats "github.com/bitcoin-sv/arc/internal/api/template_stats"
+	"github.com/bitcoin-sv/arc/internal/validator"
+)
+
+func TestDetector_Observe(t *testing.T) {
+	tt := []struct {
+		name          string
+		clamping      bool
+		baselineTxs   int
+		floodTxs      int
+		afterFloodTxs int
+
+		expectedClamped int
+	}{
+		{
+			name:        "no flood",
+			clamping:    true,
+			baselineTxs: 5,
+			floodTxs:    5,
+		},
+		{
+			name:        "flood - not clamped",
+			baselineTxs: 5,
+			floodTxs:    100,
+		},
+		{
+			name:          "flood - clamped",
+			clamping:      true,
+			baselineTxs:   5,
+			floodTxs:      100,
+			afterFloodTxs: 5,
+
+			expectedClamped: 50,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+			templates, err := validator.ParseScriptTemplates([]string{"prefix:006a0372756e"})
+			require.NoError(t, err)
+
+			sut, err := templatestats.New(slog.New(slog.NewTextHandler(os.Stdout, nil)),
+				templatestats.WithTemplates(templates),
+				templatestats.WithWindow(time.Second),
+				templatestats.WithAnomalyThreshold(10, 1),
+				templatestats.WithWarmupWindows(2),
+				templatestats.WithRateClamping(tc.clamping),
+				templatestats.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.UnregisterStats()
+
+			tx := sdkTx.NewTransaction()
+			tx.AddOutput(&sdkTx.TransactionOutput{LockingScript: &script.Script{0x00, 0x6a, 0x03, 0x72, 0x75, 0x6e, 0x01, 0x05}})
+
+			observe := func(n int) (clamped int) {
+				for range n {
+					bucket, isClamped := sut.Observe(tx)
+					if isClamped {
+						assert.Equal(t, "prefix:006a0372756e", bucket)
+						clamped++
+					}
+				}
+				now = now.Add(time.Second)
+				return clamped
+			}
+
+			for range 3 {
+				require.Zero(t, observe(tc.baselineTxs))
+			}
+
+			// when
+			actualClamped := observe(tc.floodTxs)
+
+			// then
+			assert.Equal(t, tc.expectedClamped, actualClamped)
+			assert.Zero(t, observe(tc.afterFloodTxs))
+		})
+	}
+}
@@ -3,11 +3,15 @@ package transaction_handler
 import (
 	"context"
 	"encoding/hex"
+	"errors"
+	"net"
 
 	"github.com/ordishs/go-bitcoin"
 
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 )
 
@@ -101,9 +105,9 @@ func (b *BitcoinNode) GetTransactionStatuses(_ context.Context, _ []string) (sta
 
 // SubmitTransaction submits a transaction to the bitcoin network and returns the transaction in raw format.
 func (b *BitcoinNode) SubmitTransaction(_ context.Context, tx *sdkTx.Transaction, _ *metamorph.TransactionOptions) (*metamorph.TransactionStatus, error) {
-	txID, err := b.Node.SendRawTransaction(hex.EncodeToString(tx.Bytes()))
+	txID, err := b.Node.SendRawTransaction(hexutils.EncodeToString(tx.Bytes()))
 	if err != nil {
-		return nil, err
+		return nil, toRejectReason(err)
 	}
 
 	var rawTx *bitcoin.RawTransaction
@@ -124,9 +128,9 @@ func (b *BitcoinNode) SubmitTransaction(_ context.Context, tx *sdkTx.Transaction
 func (b *BitcoinNode) SubmitTransactions(_ context.Context, txs sdkTx.Transactions, _ *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
 	statuses := make([]*metamorph.TransactionStatus, 0, len(txs))
 	for _, tx := range txs {
-		txID, err := b.Node.SendRawTransaction(hex.EncodeToString(tx.Bytes()))
+		txID, err := b.Node.SendRawTransaction(hexutils.EncodeToString(tx.Bytes()))
 		if err != nil {
-			return nil, err
+			return nil, toRejectReason(err)
 		}
 
 		var rawTx *bitcoin.RawTransaction
@@ -145,3 +149,37 @@ func (b *BitcoinNode) SubmitTransactions(_ context.Context, txs sdkTx.Transactio
 
 	return statuses, nil
 }
+
+// toRejectReason classifies the error with which the node rejected the transaction. Errors of the connection to the
+// node are returned unchanged.
+func toRejectReason(err error) error {
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return err
+	}
+
+	reason := node_reject.FromRPCError(err)
+	reason.Cause = err
+
+	return reason
+}
+
+// ResubmitTransaction is not supported by a bitcoin node, as rejected transactions are not kept.
+func (b *BitcoinNode) ResubmitTransaction(_ context.Context, _ string) (*metamorph.TransactionStatus, error) {
+	return nil, metamorph.ErrTransactionNotFound
+}
+
+// GetTransactionGraph is not supported by a bitcoin node, as submitted transactions are not stored.
+func (b *BitcoinNode) GetTransactionGraph(_ context.Context, _ string, _ uint32) (*metamorph.TransactionGraph, error) {
+	return nil, metamorph.ErrTransactionNotFound
+}
+
+// CancelScheduledBroadcast is not supported by a bitcoin node, as transactions are broadcast when they are submitted.
+func (b *BitcoinNode) CancelScheduledBroadcast(_ context.Context, _ string) error {
+	return metamorph.ErrTransactionNotFound
+}
+
+// GetOutpointSpender is not supported by a bitcoin node, as submitted transactions are not stored.
+func (b *BitcoinNode) GetOutpointSpender(_ context.Context, _ string, _ uint32) (*metamorph.OutpointSpender, error) {
+	return nil, metamorph.ErrTransactionNotFound
+}
@@ -0,0 +1,628 @@
+// Package backup takes consistent logical snapshots of the postgres databases of the ARC services and restores them,
+// e.g. for disaster recovery drills.
+//
+// The snapshot of each database is taken by a read-only transaction with repeatable read isolation, so that all its
+// tables are dumped in the state of the same moment while the services keep running. The snapshots of the databases
+// are taken one after the other in the order of the flow of events between the services, i.e. blocktx before
+// metamorph before callbacker. This is the event fence of the backup: a service is never behind the services which its
+// events are derived from, so no event is lost after a restore. Events may be processed again instead, e.g. blocks
+// mined after the snapshot of blocktx are processed again and their transactions are published again to metamorph.
+// Transactions whose state cannot be derived again from the restored state, e.g. transactions mined while the
+// deployment was down, are reconciled with the chain on the start of metamorph.
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	postgresDriverName = "postgres"
+	manifestFile       = "manifest.json"
+	formatVersion      = 1
+	batchSizeDefault   = 1000
+	// maxRowSize is the maximum size of a dumped row, i.e. of the JSON representation of a row
+	maxRowSize = 64 * 1024 * 1024
+)
+
+var (
+	ErrFailedToOpenDB        = errors.New("failed to open database")
+	ErrBackupExists          = errors.New("backup already exists")
+	ErrSnapshotFailed        = errors.New("failed to take snapshot")
+	ErrInvalidManifest       = errors.New("invalid backup manifest")
+	ErrSchemaVersionMismatch = errors.New("schema version of database does not match the backup")
+	ErrDatabaseNotEmpty      = errors.New("database is not empty")
+	ErrRestoreFailed         = errors.New("failed to restore backup")
+)
+
+// Target is the database of a service.
+type Target struct {
+	Service string
+	DBInfo  string
+	// Schema is the schema of the tables of the service
+	Schema string
+	// MigrationsTable is the table in which golang-migrate records the schema version
+	MigrationsTable string
+	// RestoreStatements are executed after the tables are restored, e.g. to release the locks held by the instances
+	// of the backed up deployment
+	RestoreStatements []string
+}
+
+// Manifest describes a backup. It is written to `manifest.json` in the directory of the backup.
+type Manifest struct {
+	FormatVersion int       `json:"formatVersion"`
+	CreatedAt     time.Time `json:"createdAt"`
+	// Services are ordered by the time of their snapshots
+	Services []ServiceSnapshot `json:"services"`
+}
+
+// ServiceSnapshot is the snapshot of the database of a service.
+type ServiceSnapshot struct {
+	Service       string    `json:"service"`
+	Schema        string    `json:"schema"`
+	SchemaVersion uint      `json:"schemaVersion"`
+	SnapshotAt    time.Time `json:"snapshotAt"`
+	// Tables are ordered such that each table is restored after the tables it references
+	Tables []TableSnapshot `json:"tables"`
+}
+
+// TableSnapshot is a table of a snapshot. Its rows are written as JSON objects, one per line, to the gzip compressed
+// file relative to the directory of the backup.
+type TableSnapshot struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	Rows int64  `json:"rows"`
+}
+
+// Service returns the snapshot of the service, if the backup contains it.
+func (m *Manifest) Service(service string) (ServiceSnapshot, bool) {
+	for _, snapshot := range m.Services {
+		if snapshot.Service == service {
+			return snapshot, true
+		}
+	}
+
+	return ServiceSnapshot{}, false
+}
+
+type Backup struct {
+	logger    *slog.Logger
+	now       func() time.Time
+	batchSize int
+}
+
+// WithBatchSize sets the number of rows which are inserted at once when a backup is restored.
+func WithBatchSize(batchSize int) func(*Backup) {
+	return func(b *Backup) {
+		b.batchSize = batchSize
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Backup) {
+	return func(b *Backup) {
+		b.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Backup)) *Backup {
+	b := &Backup{
+		logger:    logger.With(slog.String("module", "backup")),
+		now:       time.Now,
+		batchSize: batchSizeDefault,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+type snapshot struct {
+	target Target
+	db     *sql.DB
+	tx     *sql.Tx
+	ServiceSnapshot
+}
+
+// Create takes the snapshots of the databases of the targets in the given order and writes them to the directory,
+// which must not contain a backup yet. All snapshots are taken before the first table is dumped.
+func (b *Backup) Create(ctx context.Context, dir string, targets []Target) (*Manifest, error) {
+	_, err := os.Stat(filepath.Join(dir, manifestFile))
+	if err == nil {
+		return nil, errors.Join(ErrBackupExists, fmt.Errorf("directory %s", dir))
+	}
+
+	err = os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*snapshot, 0, len(targets))
+	defer func() {
+		for _, s := range snapshots {
+			_ = s.tx.Rollback()
+			_ = s.db.Close()
+		}
+	}()
+
+	for _, target := range targets {
+		s, err := b.takeSnapshot(ctx, target)
+		if err != nil {
+			return nil, errors.Join(ErrSnapshotFailed, fmt.Errorf("service %s: %w", target.Service, err))
+		}
+		snapshots = append(snapshots, s)
+
+		b.logger.Info("Took snapshot", slog.String("service", target.Service), slog.Uint64("schemaVersion", uint64(s.SchemaVersion)), slog.Time("snapshotAt", s.SnapshotAt))
+	}
+
+	manifest := &Manifest{
+		FormatVersion: formatVersion,
+		CreatedAt:     b.now().UTC(),
+		Services:      make([]ServiceSnapshot, 0, len(snapshots)),
+	}
+
+	for _, s := range snapshots {
+		err = os.MkdirAll(filepath.Join(dir, s.target.Service), 0o750)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, table := range s.Tables {
+			rows, err := dumpTable(ctx, s.tx, s.Schema, table.Name, filepath.Join(dir, table.File))
+			if err != nil {
+				return nil, errors.Join(ErrSnapshotFailed, fmt.Errorf("service %s, table %s: %w", s.target.Service, table.Name, err))
+			}
+			s.Tables[i].Rows = rows
+
+			b.logger.Info("Dumped table", slog.String("service", s.target.Service), slog.String("table", table.Name), slog.Int64("rows", rows))
+		}
+
+		manifest.Services = append(manifest.Services, s.ServiceSnapshot)
+	}
+
+	err = writeManifest(dir, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// takeSnapshot begins the transaction whose snapshot is dumped and reads the schema version and the tables in it.
+func (b *Backup) takeSnapshot(ctx context.Context, target Target) (*snapshot, error) {
+	db, err := sql.Open(postgresDriverName, target.DBInfo)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToOpenDB, err)
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		_ = db.Close()
+		return nil, errors.Join(ErrFailedToOpenDB, err)
+	}
+
+	s := &snapshot{
+		target: target,
+		db:     db,
+		tx:     tx,
+		ServiceSnapshot: ServiceSnapshot{
+			Service: target.Service,
+			Schema:  target.Schema,
+		},
+	}
+
+	// the snapshot of a repeatable read transaction is taken by its first statement
+	err = tx.QueryRowContext(ctx, "SELECT clock_timestamp()").Scan(&s.SnapshotAt)
+	if err != nil {
+		_ = tx.Rollback()
+		_ = db.Close()
+		return nil, err
+	}
+	s.SnapshotAt = s.SnapshotAt.UTC()
+
+	s.SchemaVersion, err = schemaVersion(ctx, tx, target.MigrationsTable)
+	if err != nil {
+		_ = tx.Rollback()
+		_ = db.Close()
+		return nil, err
+	}
+
+	tables, err := orderedTables(ctx, tx, target.Schema)
+	if err != nil {
+		_ = tx.Rollback()
+		_ = db.Close()
+		return nil, err
+	}
+
+	for _, table := range tables {
+		s.Tables = append(s.Tables, TableSnapshot{
+			Name: table,
+			File: filepath.Join(target.Service, table+".ndjson.gz"),
+		})
+	}
+
+	return s, nil
+}
+
+// Restore restores the snapshots of the backup in the directory to the databases of the targets. The databases must be
+// migrated to the schema versions of the snapshots and their tables must be empty. The snapshot of each service is
+// restored in a single transaction. Targets whose service is not contained in the backup are skipped.
+func (b *Backup) Restore(ctx context.Context, dir string, targets []Target) (*Manifest, error) {
+	manifest, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, target := range targets {
+		s, found := manifest.Service(target.Service)
+		if !found {
+			b.logger.Warn("Backup does not contain service", slog.String("service", target.Service))
+			continue
+		}
+
+		err = b.restoreService(ctx, dir, target, s)
+		if err != nil {
+			return nil, errors.Join(ErrRestoreFailed, fmt.Errorf("service %s: %w", target.Service, err))
+		}
+
+		b.logger.Info("Restored snapshot", slog.String("service", target.Service), slog.Time("snapshotAt", s.SnapshotAt))
+	}
+
+	return manifest, nil
+}
+
+func (b *Backup) restoreService(ctx context.Context, dir string, target Target, s ServiceSnapshot) error {
+	db, err := sql.Open(postgresDriverName, target.DBInfo)
+	if err != nil {
+		return errors.Join(ErrFailedToOpenDB, err)
+	}
+	defer db.Close()
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Join(ErrFailedToOpenDB, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	version, err := schemaVersion(ctx, tx, target.MigrationsTable)
+	if err != nil {
+		return err
+	}
+	if version != s.SchemaVersion {
+		return errors.Join(ErrSchemaVersionMismatch, fmt.Errorf("database version %d, backup version %d", version, s.SchemaVersion))
+	}
+
+	for _, table := range s.Tables {
+		var notEmpty bool
+		err = tx.QueryRowContext(ctx, fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s)", qualifiedName(s.Schema, table.Name))).Scan(&notEmpty)
+		if err != nil {
+			return err
+		}
+		if notEmpty {
+			return errors.Join(ErrDatabaseNotEmpty, fmt.Errorf("table %s.%s", s.Schema, table.Name))
+		}
+	}
+
+	for _, table := range s.Tables {
+		rows, err := b.loadTable(ctx, tx, s.Schema, table.Name, filepath.Join(dir, table.File))
+		if err != nil {
+			return fmt.Errorf("table %s: %w", table.Name, err)
+		}
+		if rows != table.Rows {
+			return errors.Join(ErrInvalidManifest, fmt.Errorf("table %s: restored %d rows, expected %d", table.Name, rows, table.Rows))
+		}
+
+		err = resetSequences(ctx, tx, s.Schema, table.Name)
+		if err != nil {
+			return fmt.Errorf("table %s: %w", table.Name, err)
+		}
+
+		b.logger.Info("Restored table", slog.String("service", target.Service), slog.String("table", table.Name), slog.Int64("rows", rows))
+	}
+
+	for _, statement := range target.RestoreStatements {
+		_, err = tx.ExecContext(ctx, statement)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// loadTable inserts the rows of the dump file into the table in batches.
+func (b *Backup) loadTable(ctx context.Context, tx *sql.Tx, schema string, table string, file string) (int64, error) {
+	f, err := os.Open(file) // #nosec G304
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return 0, err
+	}
+	defer gz.Close()
+
+	name := qualifiedName(schema, table)
+	// identity columns are restored with their values instead of new values being generated
+	query := fmt.Sprintf("INSERT INTO %s OVERRIDING SYSTEM VALUE SELECT * FROM json_populate_recordset(NULL::%s, $1)", name, name)
+
+	scanner := bufio.NewScanner(gz)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxRowSize)
+
+	var count int64
+	batch := make([]string, 0, b.batchSize)
+	insert := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		_, err := tx.ExecContext(ctx, query, "["+strings.Join(batch, ",")+"]")
+		batch = batch[:0]
+		return err
+	}
+
+	for scanner.Scan() {
+		batch = append(batch, scanner.Text())
+		count++
+
+		if len(batch) >= b.batchSize {
+			err = insert()
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	err = insert()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// ReadManifest reads the manifest of the backup in the directory.
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile)) // #nosec G304
+	if err != nil {
+		return nil, errors.Join(ErrInvalidManifest, err)
+	}
+
+	var manifest Manifest
+	err = json.Unmarshal(data, &manifest)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidManifest, err)
+	}
+
+	if manifest.FormatVersion != formatVersion {
+		return nil, errors.Join(ErrInvalidManifest, fmt.Errorf("format version %d not supported", manifest.FormatVersion))
+	}
+
+	return &manifest, nil
+}
+
+func writeManifest(dir string, manifest *Manifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the manifest is written last, so that a backup without manifest is recognised as incomplete
+	return os.WriteFile(filepath.Join(dir, manifestFile), data, 0o600)
+}
+
+// dumpTable writes the rows of the table as JSON objects, one per line, to the gzip compressed file.
+func dumpTable(ctx context.Context, tx *sql.Tx, schema string, table string, file string) (int64, error) {
+	f, err := os.Create(file) // #nosec G304
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	gz := gzip.NewWriter(f)
+	w := bufio.NewWriter(gz)
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT row_to_json(t)::TEXT FROM %s t", qualifiedName(schema, table)))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var count int64
+	for rows.Next() {
+		var row string
+		err = rows.Scan(&row)
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = w.WriteString(row + "\n")
+		if err != nil {
+			return 0, err
+		}
+		count++
+	}
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	err = w.Flush()
+	if err != nil {
+		return 0, err
+	}
+
+	err = gz.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, f.Close()
+}
+
+// schemaVersion returns the version recorded by golang-migrate. A dirty database cannot be backed up or restored.
+func schemaVersion(ctx context.Context, tx *sql.Tx, migrationsTable string) (uint, error) {
+	var version int64
+	var dirty bool
+	err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT version, dirty FROM %s LIMIT 1", pq.QuoteIdentifier(migrationsTable))).Scan(&version, &dirty)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+
+	if dirty || version < 0 {
+		return 0, fmt.Errorf("schema version %d is dirty", version)
+	}
+
+	return uint(version), nil
+}
+
+// orderedTables returns the tables of the schema ordered by their foreign keys. The partitions of partitioned tables
+// are not returned, their rows are dumped and restored with the partitioned table.
+func orderedTables(ctx context.Context, tx *sql.Tx, schema string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT c.relname FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = $1 AND c.relkind IN ('r', 'p') AND NOT c.relispartition`, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		err = rows.Scan(&table)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	refRows, err := tx.QueryContext(ctx, `
+		SELECT t.relname, r.relname FROM pg_constraint c
+		JOIN pg_class t ON t.oid = c.conrelid
+		JOIN pg_class r ON r.oid = c.confrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE c.contype = 'f' AND n.nspname = $1`, schema)
+	if err != nil {
+		return nil, err
+	}
+	defer refRows.Close()
+
+	references := make(map[string][]string)
+	for refRows.Next() {
+		var table, referenced string
+		err = refRows.Scan(&table, &referenced)
+		if err != nil {
+			return nil, err
+		}
+		references[table] = append(references[table], referenced)
+	}
+	if err = refRows.Err(); err != nil {
+		return nil, err
+	}
+
+	return orderByReferences(tables, references), nil
+}
+
+// orderByReferences orders the tables such that each table follows the tables it references. Tables without mutual
+// dependency are ordered by name.
+func orderByReferences(tables []string, references map[string][]string) []string {
+	sorted := append([]string(nil), tables...)
+	sort.Strings(sorted)
+
+	known := make(map[string]bool, len(sorted))
+	for _, table := range sorted {
+		known[table] = true
+	}
+
+	ordered := make([]string, 0, len(sorted))
+	visited := make(map[string]bool, len(sorted))
+	var visit func(table string)
+	visit = func(table string) {
+		if visited[table] {
+			return
+		}
+		visited[table] = true
+
+		referenced := append([]string(nil), references[table]...)
+		sort.Strings(referenced)
+		for _, ref := range referenced {
+			// self references and references to other schemas don't affect the order
+			if ref != table && known[ref] {
+				visit(ref)
+			}
+		}
+
+		ordered = append(ordered, table)
+	}
+
+	for _, table := range sorted {
+		visit(table)
+	}
+
+	return ordered
+}
+
+// resetSequences sets the sequences of the serial columns of the table to continue after the restored values.
+func resetSequences(ctx context.Context, tx *sql.Tx, schema string, table string) error {
+	name := qualifiedName(schema, table)
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT column_name, pg_get_serial_sequence($1, column_name) FROM information_schema.columns
+		WHERE table_schema = $2 AND table_name = $3 AND pg_get_serial_sequence($1, column_name) IS NOT NULL`, name, schema, table)
+	if err != nil {
+		return err
+	}
+
+	sequences := make(map[string]string)
+	for rows.Next() {
+		var column, sequence string
+		err = rows.Scan(&column, &sequence)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+		sequences[column] = sequence
+	}
+	_ = rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for column, sequence := range sequences {
+		q := fmt.Sprintf("SELECT setval($1, COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)", pq.QuoteIdentifier(column), name)
+		_, err = tx.ExecContext(ctx, q, sequence)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func qualifiedName(schema string, table string) string {
+	return pq.QuoteIdentifier(schema) + "." + pq.QuoteIdentifier(table)
+}
@@ -0,0 +1,245 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
+)
+
+var dbInfo string
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if testing.Short() {
+		os.Exit(m.Run())
+	}
+
+	testmain(m)
+}
+
+func testmain(m *testing.M) int {
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		log.Printf("failed to create pool: %v", err)
+		return 1
+	}
+
+	resource, connStr, err := testutils.RunPostgresql(pool, "5439")
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer func() {
+		err = pool.Purge(resource)
+		if err != nil {
+			log.Fatalf("failed to purge pool: %v", err)
+		}
+	}()
+
+	dbInfo = connStr
+	return m.Run()
+}
+
+func TestOrderByReferences(t *testing.T) {
+	tt := []struct {
+		name       string
+		tables     []string
+		references map[string][]string
+
+		expected []string
+	}{
+		{
+			name:   "no references",
+			tables: []string{"transactions", "blocks"},
+
+			expected: []string{"blocks", "transactions"},
+		},
+		{
+			name:       "referenced table first",
+			tables:     []string{"block_transactions", "blocks", "registered_transactions"},
+			references: map[string][]string{"block_transactions": {"blocks"}},
+
+			expected: []string{"blocks", "block_transactions", "registered_transactions"},
+		},
+		{
+			name:       "self and unknown references",
+			tables:     []string{"b", "a"},
+			references: map[string][]string{"a": {"a", "other"}, "b": {"c", "a"}},
+
+			expected: []string{"a", "b"},
+		},
+		{
+			name:       "chain of references",
+			tables:     []string{"a", "b", "c"},
+			references: map[string][]string{"a": {"b"}, "b": {"c"}},
+
+			expected: []string{"c", "b", "a"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := orderByReferences(tc.tables, tc.references)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	tt := []struct {
+		name     string
+		manifest string
+
+		expectedError error
+	}{
+		{
+			name:     "valid manifest",
+			manifest: `{"formatVersion":1,"createdAt":"2026-01-01T00:00:00Z","services":[{"service":"blocktx","schema":"blocktx","schemaVersion":26}]}`,
+		},
+		{
+			name:     "unsupported format version",
+			manifest: `{"formatVersion":2}`,
+
+			expectedError: ErrInvalidManifest,
+		},
+		{
+			name:     "invalid json",
+			manifest: `{`,
+
+			expectedError: ErrInvalidManifest,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, manifestFile), []byte(tc.manifest), 0o600))
+
+			// when
+			actual, err := ReadManifest(dir)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			snapshot, found := actual.Service("blocktx")
+			require.True(t, found)
+			assert.Equal(t, uint(26), snapshot.SchemaVersion)
+			_, found = actual.Service("metamorph")
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestBackup_CreateRestore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	// given
+	db, err := sql.Open(postgresDriverName, dbInfo)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, testutils.Retry(db.Ping))
+
+	schema := `
+		CREATE SCHEMA test;
+		CREATE TABLE test_migrations (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL);
+		INSERT INTO test_migrations VALUES (3, false);
+		CREATE TABLE test.blocks (id BIGSERIAL PRIMARY KEY, hash BYTEA NOT NULL, processed_at TIMESTAMPTZ);
+		CREATE TABLE test.block_transactions (block_id BIGINT REFERENCES test.blocks(id), hash BYTEA NOT NULL, competing TEXT[], locked_by TEXT);`
+	_, err = db.Exec(schema)
+	require.NoError(t, err)
+
+	_, err = db.Exec(`
+		INSERT INTO test.blocks (hash, processed_at) SELECT decode(md5(i::TEXT), 'hex'), now() FROM generate_series(1, 5) AS i;
+		INSERT INTO test.block_transactions SELECT b.id, decode(md5(b.id::TEXT || '-' || i::TEXT), 'hex'), ARRAY['a', 'b'], 'instance-1' FROM test.blocks b, generate_series(1, 3) AS i;`)
+	require.NoError(t, err)
+
+	target := Target{
+		Service:           "test",
+		DBInfo:            dbInfo,
+		Schema:            "test",
+		MigrationsTable:   "test_migrations",
+		RestoreStatements: []string{"UPDATE test.block_transactions SET locked_by = 'NONE'"},
+	}
+
+	dir := t.TempDir()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut := New(slog.New(slog.NewTextHandler(os.Stdout, nil)), WithBatchSize(4), WithNow(func() time.Time { return now }))
+
+	// when
+	manifest, err := sut.Create(context.Background(), dir, []Target{target})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, now, manifest.CreatedAt)
+	require.Len(t, manifest.Services, 1)
+	assert.Equal(t, uint(3), manifest.Services[0].SchemaVersion)
+	assert.Equal(t, []TableSnapshot{
+		{Name: "blocks", File: filepath.Join("test", "blocks.ndjson.gz"), Rows: 5},
+		{Name: "block_transactions", File: filepath.Join("test", "block_transactions.ndjson.gz"), Rows: 15},
+	}, manifest.Services[0].Tables)
+
+	_, err = sut.Create(context.Background(), dir, []Target{target})
+	require.ErrorIs(t, err, ErrBackupExists)
+
+	// when
+	_, err = sut.Restore(context.Background(), dir, []Target{target})
+
+	// then
+	require.ErrorIs(t, err, ErrDatabaseNotEmpty)
+
+	// given
+	_, err = db.Exec("DELETE FROM test.block_transactions; DELETE FROM test.blocks;")
+	require.NoError(t, err)
+
+	// when
+	_, err = sut.Restore(context.Background(), dir, []Target{target})
+
+	// then
+	require.NoError(t, err)
+
+	var blocks, txs, locked int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM test.blocks").Scan(&blocks))
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM test.block_transactions WHERE competing = ARRAY['a', 'b']").Scan(&txs))
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM test.block_transactions WHERE locked_by <> 'NONE'").Scan(&locked))
+	assert.Equal(t, 5, blocks)
+	assert.Equal(t, 15, txs)
+	assert.Equal(t, 0, locked)
+
+	// the sequence continues after the restored ids
+	var id int64
+	require.NoError(t, db.QueryRow("INSERT INTO test.blocks (hash) VALUES ('\\x00') RETURNING id").Scan(&id))
+	assert.Equal(t, int64(6), id)
+
+	// given
+	_, err = db.Exec("DELETE FROM test.block_transactions; DELETE FROM test.blocks; UPDATE test_migrations SET version = 4;")
+	require.NoError(t, err)
+
+	// when
+	_, err = sut.Restore(context.Background(), dir, []Target{target})
+
+	// then
+	require.ErrorIs(t, err, ErrSchemaVersionMismatch)
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/validator/bounds"
 )
 
 const (
@@ -40,6 +42,11 @@ func DecodeBEEF(beefHex []byte) (tx *sdkTx.Beef, txID string, err error) {
 		}
 	}()
 
+	err = bounds.CheckBEEF(beefHex)
+	if err != nil {
+		return nil, "", errors.Join(ErrBEEFParse, err)
+	}
+
 	beef, _, txHash, err := sdkTx.ParseBeef(beefHex)
 	if err != nil {
 		return nil, "", errors.Join(ErrBEEFParse, err)
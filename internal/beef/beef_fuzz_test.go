@@ -0,0 +1,27 @@
+package beef
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzDecodeBEEF ensures that malformed BEEF submissions don't panic. The seed corpus is in testdata/fuzz, crashes
+// reported by the API can be added to it to reproduce them.
+func FuzzDecodeBEEF(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// when
+		beefTx, txID, err := DecodeBEEF(data)
+
+		// then
+		if err != nil {
+			require.Nil(t, beefTx)
+			require.True(t, errors.Is(err, ErrBEEFParse) || errors.Is(err, ErrBEEFPanic))
+			return
+		}
+
+		require.NotNil(t, beefTx)
+		require.NotEmpty(t, txID)
+	})
+}
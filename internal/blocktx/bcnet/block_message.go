@@ -2,6 +2,7 @@ package bcnet
 
 import (
 	"io"
+	"os"
 
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/libsv/go-p2p/wire"
@@ -13,7 +14,56 @@ type BlockMessage struct {
 	Header            *wire.BlockHeader
 	Height            uint64
 	TransactionHashes []*chainhash.Hash
-	Size              uint64
+	// NormalizedHashes are the normalized hashes of the transactions in the same order as the transaction hashes,
+	// they are only calculated if enabled with WithNormalizedHashes
+	NormalizedHashes []*chainhash.Hash
+	Size             uint64
+	// CoinbaseReward is the sum of the outputs of the coinbase transaction in satoshis, i.e. the block subsidy and fees
+	CoinbaseReward uint64
+	// MinerTag is the tag by which the miner identifies itself in the coinbase script
+	MinerTag string
+
+	// spill contains the transaction hashes of blocks with many transactions until they are loaded
+	spill   *os.File
+	txCount uint64
+}
+
+// TxCount returns the number of transactions of the block.
+func (bm *BlockMessage) TxCount() int {
+	if bm.spill == nil {
+		return len(bm.TransactionHashes)
+	}
+
+	return int(bm.txCount) // #nosec G115
+}
+
+// Spilled returns whether the transaction hashes were spilled to a temp file while reading the block. The transaction
+// hashes of spilled blocks are not held in TransactionHashes, they are read in ranges with ReadTransactionHashes, so
+// that the memory used for large blocks stays bounded.
+func (bm *BlockMessage) Spilled() bool {
+	return bm.spill != nil
+}
+
+// ReadTransactionHashes returns the transaction hashes with the indexes from `from` to `to`, excluding `to`.
+func (bm *BlockMessage) ReadTransactionHashes(from int, to int) ([]*chainhash.Hash, error) {
+	if bm.spill == nil {
+		return bm.TransactionHashes[from:to], nil
+	}
+
+	return readSpilledTxHashes(bm.spill, from, to)
+}
+
+// Close closes the temp file of spilled transaction hashes, which deletes it. It has to be called when the block is
+// processed or dropped.
+func (bm *BlockMessage) Close() error {
+	if bm.spill == nil {
+		return nil
+	}
+
+	err := bm.spill.Close()
+	bm.spill = nil
+
+	return err
 }
 
 func (bm *BlockMessage) Bsvdecode(io.Reader, uint32, wire.MessageEncoding) error {
@@ -33,3 +83,13 @@ type BlockMessagePeer struct {
 	BlockMessage
 	Peer string
 }
+
+// BlockBytes measures the size of a downloaded block, e.g. for the throughput of the peer autoscaler.
+func BlockBytes(msg wire.Message) uint64 {
+	blockMsg, ok := msg.(*BlockMessage)
+	if !ok {
+		return 0
+	}
+
+	return blockMsg.Size
+}
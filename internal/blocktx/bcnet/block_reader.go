@@ -0,0 +1,611 @@
+package bcnet
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+
+	"github.com/bitcoin-sv/arc/internal/hashing"
+	"github.com/bitcoin-sv/arc/internal/varintutils"
+)
+
+const (
+	defaultReadBufferSize = 64 * 1024
+	// defaultSpillThreshold is the number of transaction hashes of a block which are kept in memory, 1M hashes need 32MB
+	defaultSpillThreshold = 1_000_000
+
+	blockHeaderSize = 80
+	// minTxSize is the size of a transaction without inputs and outputs: version, input count, output count and lock time
+	minTxSize = 10
+	// coinbaseScriptMaxSize is the maximum size of the coinbase script which is kept, it contains the block height
+	// (BIP-34) and the tag of the miner
+	coinbaseScriptMaxSize = 100
+	// minMinerTagPartLen is the minimum number of consecutive printable characters of the coinbase script which are
+	// part of the miner tag, shorter sequences are most likely part of the extra nonce
+	minMinerTagPartLen = 3
+)
+
+var ErrSpilledTxHashesNotRead = errors.New("failed to read spilled transaction hashes")
+
+type blockReader struct {
+	readBufferSize   int
+	spillThreshold   uint64
+	spillDir         string
+	normalizedHashes bool
+	archive          RawBlockArchive
+}
+
+// RawBlockArchive stores the raw bytes of the blocks which are read, e.g. for audits and the reprocessing of blocks
+// without downloading them again.
+type RawBlockArchive interface {
+	// NewWriter returns the writer receiving the raw bytes of a block with the given payload size while it is read.
+	NewWriter(size uint64) RawBlockWriter
+}
+
+// RawBlockWriter receives the raw bytes of a block. It must not return errors, failures to archive a block are
+// handled by the archive and never fail the reading of the block.
+type RawBlockWriter interface {
+	io.Writer
+	// Commit stores the block under its hash after it was read completely.
+	Commit(hash chainhash.Hash)
+	// Abort discards the bytes of a block which could not be read.
+	Abort()
+}
+
+type BlockReaderOption func(r *blockReader)
+
+// WithReadBufferSize sets the size of the buffer through which the transactions of a block are streamed.
+func WithReadBufferSize(size int) BlockReaderOption {
+	return func(r *blockReader) {
+		r.readBufferSize = size
+	}
+}
+
+// WithSpillThreshold sets the number of transaction hashes of a block which are kept in memory. The hashes of blocks
+// with more transactions are spilled to a temp file and read from it in batches while the block is processed. Zero
+// disables spilling.
+func WithSpillThreshold(txs uint64) BlockReaderOption {
+	return func(r *blockReader) {
+		r.spillThreshold = txs
+	}
+}
+
+// WithSpillDir sets the directory of the temp files. If not set, the default directory for temp files is used.
+func WithSpillDir(dir string) BlockReaderOption {
+	return func(r *blockReader) {
+		r.spillDir = dir
+	}
+}
+
+// WithNormalizedHashes enables the calculation of the normalized hashes of the transactions of a block, which are
+// needed to detect mined malleated variants of registered transactions. The normalized hashes are kept in memory.
+func WithNormalizedHashes(enabled bool) BlockReaderOption {
+	return func(r *blockReader) {
+		r.normalizedHashes = enabled
+	}
+}
+
+// WithRawBlockArchive stores the raw bytes of the blocks in the archive while they are read.
+func WithRawBlockArchive(archive RawBlockArchive) BlockReaderOption {
+	return func(r *blockReader) {
+		r.archive = archive
+	}
+}
+
+func newBlockReader(opts ...BlockReaderOption) *blockReader {
+	r := &blockReader{
+		readBufferSize: defaultReadBufferSize,
+		spillThreshold: defaultSpillThreshold,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if r.readBufferSize <= 0 {
+		r.readBufferSize = defaultReadBufferSize
+	}
+
+	return r
+}
+
+// RegisterBlockReader overrides the default wire block handler with a reader that streams the block and keeps only
+// the transaction ids. The memory used for reading a block does not depend on the size of its transactions.
+func RegisterBlockReader(opts ...BlockReaderOption) {
+	wire.SetExternalHandler(wire.CmdBlock, newBlockReader(opts...).read)
+}
+
+// ReadBlock reads the payload of a block of the given size the same way as the blocks received from the peers, e.g.
+// to reprocess a block from the raw block archive.
+func ReadBlock(reader io.Reader, size uint64, opts ...BlockReaderOption) (*BlockMessage, error) {
+	blockMessage, err := newBlockReader(opts...).readBlock(&countingReader{r: reader}, size)
+	if err != nil {
+		return nil, err
+	}
+
+	// the size of the blocks received from the peers includes the header of the message
+	blockMessage.Size = size + wire.MessageHeaderSize
+
+	return blockMessage, nil
+}
+
+func (r *blockReader) read(reader io.Reader, length uint64, bytesRead int) (int, wire.Message, []byte, error) {
+	var archiveWriter RawBlockWriter
+	if r.archive != nil {
+		archiveWriter = r.archive.NewWriter(length)
+		reader = io.TeeReader(reader, archiveWriter)
+	}
+
+	cr := &countingReader{r: reader}
+
+	blockMessage, err := r.readBlock(cr, length)
+	bytesRead += int(cr.n)
+	if err != nil {
+		if archiveWriter != nil {
+			archiveWriter.Abort()
+		}
+		return bytesRead, nil, nil, err
+	}
+
+	if archiveWriter != nil {
+		archiveWriter.Commit(*blockMessage.Hash)
+	}
+
+	blockMessage.Size = uint64(bytesRead) // #nosec G115
+
+	return bytesRead, blockMessage, nil, nil
+}
+
+func (r *blockReader) readBlock(reader *countingReader, length uint64) (blockMessage *BlockMessage, err error) {
+	blockMessage = &BlockMessage{
+		Header: &wire.BlockHeader{},
+	}
+
+	err = blockMessage.Header.Deserialize(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	var txCount varintutils.VarInt
+	_, err = txCount.ReadFrom(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transaction count must not exceed the number of transactions which fit into the payload
+	if uint64(txCount) > length/minTxSize {
+		return nil, messageError(fmt.Sprintf("block with payload of %d bytes cannot contain %d transactions", length, txCount))
+	}
+
+	hashes := &txHashes{threshold: r.spillThreshold, dir: r.spillDir}
+	defer func() {
+		if err != nil {
+			hashes.close()
+		}
+	}()
+
+	txReader := newTxHashReader(reader, r.readBufferSize, r.normalizedHashes)
+	if r.normalizedHashes {
+		blockMessage.NormalizedHashes = make([]*chainhash.Hash, 0, uint64(txCount))
+	}
+
+	for i := uint64(0); i < uint64(txCount); i++ {
+		var txHash chainhash.Hash
+		var coinbase *coinbaseTx
+		txHash, coinbase, err = txReader.read(i == 0)
+		if err != nil {
+			return nil, err
+		}
+
+		if r.normalizedHashes {
+			normalizedHash := txReader.normalizedHash()
+			blockMessage.NormalizedHashes = append(blockMessage.NormalizedHashes, &normalizedHash)
+		}
+
+		if i == 0 {
+			blockMessage.Height = extractHeightFromCoinbaseScript(coinbase.script)
+			blockMessage.MinerTag = extractMinerTagFromCoinbaseScript(coinbase.script)
+			blockMessage.CoinbaseReward = coinbase.reward
+		}
+
+		err = hashes.add(&txHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if uint64(reader.n) != length {
+		return nil, messageError(fmt.Sprintf("block payload of %d bytes does not match payload length %d", reader.n, length))
+	}
+
+	blockMessage.TransactionHashes, blockMessage.spill, err = hashes.done()
+	if err != nil {
+		return nil, err
+	}
+	blockMessage.txCount = uint64(txCount)
+
+	blockHash := blockMessage.Header.BlockHash()
+	blockMessage.Hash = &blockHash
+
+	return blockMessage, nil
+}
+
+func messageError(description string) error {
+	return &wire.MessageError{Func: "bcnet.readBlock", Description: description}
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// txHashReader reads transactions and calculates their hashes without holding them in memory. The bytes of the
+// transaction are streamed through the hasher with a bounded buffer.
+type txHashReader struct {
+	hasher *txHasher
+	tee    io.Reader
+	buf    []byte
+}
+
+func newTxHashReader(r io.Reader, bufferSize int, normalized bool) *txHashReader {
+	hasher := &txHasher{full: hashing.NewSHA256()}
+	if normalized {
+		hasher.normalized = hashing.NewSHA256()
+	}
+
+	return &txHashReader{
+		hasher: hasher,
+		tee:    io.TeeReader(r, hasher),
+		buf:    make([]byte, bufferSize),
+	}
+}
+
+// txHasher hashes the bytes of a transaction. If the normalized hasher is set, it hashes the transaction with empty
+// unlocking scripts as well, the bytes of the unlocking scripts are excluded while skipScript is set.
+type txHasher struct {
+	full       hash.Hash
+	normalized hash.Hash
+	skipScript bool
+}
+
+func (h *txHasher) Write(p []byte) (int, error) {
+	if h.normalized != nil && !h.skipScript {
+		_, _ = h.normalized.Write(p)
+	}
+
+	return h.full.Write(p)
+}
+
+func (h *txHasher) reset() {
+	h.full.Reset()
+	if h.normalized != nil {
+		h.normalized.Reset()
+	}
+	h.skipScript = false
+}
+
+// skipUnlockingScript excludes the following bytes from the normalized hash until the unlocking script has been read
+func (h *txHasher) skipUnlockingScript() {
+	h.skipScript = true
+}
+
+// emptyUnlockingScript writes the length of an empty unlocking script to the normalized hash instead of the script
+func (h *txHasher) emptyUnlockingScript() {
+	h.skipScript = false
+	if h.normalized != nil {
+		_, _ = h.normalized.Write([]byte{0x00})
+	}
+}
+
+// coinbaseTx contains the unlocking script of the first input of the coinbase transaction, up to the maximum size,
+// and the sum of the values of its outputs.
+type coinbaseTx struct {
+	script []byte
+	reward uint64
+}
+
+// read reads the next transaction and returns its hash. For the coinbase transaction the unlocking script of the first
+// input and the reward are returned as well.
+func (t *txHashReader) read(coinbase bool) (txHash chainhash.Hash, cb *coinbaseTx, err error) {
+	t.hasher.reset()
+	if coinbase {
+		cb = &coinbaseTx{}
+	}
+
+	// version
+	err = t.skip(4)
+	if err != nil {
+		return txHash, nil, err
+	}
+
+	inputs, err := t.readVarInt()
+	if err != nil {
+		return txHash, nil, err
+	}
+
+	for i := uint64(0); i < inputs; i++ {
+		// previous outpoint
+		err = t.skip(36)
+		if err != nil {
+			return txHash, nil, err
+		}
+
+		t.hasher.skipUnlockingScript()
+
+		var scriptLen uint64
+		scriptLen, err = t.readVarInt()
+		if err != nil {
+			return txHash, nil, err
+		}
+
+		if coinbase && i == 0 {
+			cb.script = make([]byte, min(scriptLen, coinbaseScriptMaxSize))
+			_, err = io.ReadFull(t.tee, cb.script)
+			if err != nil {
+				return txHash, nil, err
+			}
+			scriptLen -= uint64(len(cb.script))
+		}
+
+		err = t.skip(scriptLen)
+		if err != nil {
+			return txHash, nil, err
+		}
+
+		t.hasher.emptyUnlockingScript()
+
+		// sequence
+		err = t.skip(4)
+		if err != nil {
+			return txHash, nil, err
+		}
+	}
+
+	outputs, err := t.readVarInt()
+	if err != nil {
+		return txHash, nil, err
+	}
+
+	for i := uint64(0); i < outputs; i++ {
+		// value
+		if coinbase {
+			var value uint64
+			value, err = t.readUint64()
+			if err != nil {
+				return txHash, nil, err
+			}
+			cb.reward += value
+		} else {
+			err = t.skip(8)
+			if err != nil {
+				return txHash, nil, err
+			}
+		}
+
+		var scriptLen uint64
+		scriptLen, err = t.readVarInt()
+		if err != nil {
+			return txHash, nil, err
+		}
+
+		err = t.skip(scriptLen)
+		if err != nil {
+			return txHash, nil, err
+		}
+	}
+
+	// lock time
+	err = t.skip(4)
+	if err != nil {
+		return txHash, nil, err
+	}
+
+	first := t.hasher.full.Sum(nil)
+	txHash = hashing.Sum256(first)
+
+	return txHash, cb, nil
+}
+
+// normalizedHash returns the normalized hash of the transaction which has been read last.
+func (t *txHashReader) normalizedHash() chainhash.Hash {
+	first := t.hasher.normalized.Sum(nil)
+	return hashing.Sum256(first)
+}
+
+func (t *txHashReader) readVarInt() (uint64, error) {
+	var v varintutils.VarInt
+	_, err := v.ReadFrom(t.tee)
+
+	return uint64(v), err
+}
+
+func (t *txHashReader) readUint64() (uint64, error) {
+	_, err := io.ReadFull(t.tee, t.buf[:8])
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint64(t.buf[:8]), nil
+}
+
+// skip streams n bytes through the hasher.
+func (t *txHashReader) skip(n uint64) error {
+	if n > uint64(len(t.buf)) {
+		copied, err := io.CopyBuffer(io.Discard, io.LimitReader(t.tee, int64(n)), t.buf) // #nosec G115
+		if err != nil {
+			return err
+		}
+		if uint64(copied) != n { // #nosec G115
+			return io.ErrUnexpectedEOF
+		}
+		return nil
+	}
+
+	_, err := io.ReadFull(t.tee, t.buf[:n])
+	return err
+}
+
+// txHashes collects the transaction hashes of a block. If the number of hashes exceeds the threshold, the hashes are
+// spilled to a temp file.
+type txHashes struct {
+	threshold uint64
+	dir       string
+
+	hashes []*chainhash.Hash
+	file   *os.File
+	writer *bufio.Writer
+}
+
+func (h *txHashes) add(txHash *chainhash.Hash) error {
+	if h.file == nil {
+		if h.threshold == 0 || uint64(len(h.hashes)) < h.threshold {
+			h.hashes = append(h.hashes, txHash)
+			return nil
+		}
+
+		err := h.spill()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := h.writer.Write(txHash[:])
+	return err
+}
+
+func (h *txHashes) spill() error {
+	file, err := os.CreateTemp(h.dir, "block-tx-hashes-*")
+	if err != nil {
+		return err
+	}
+	// the file is removed right away, it is deleted by the file system as soon as it is closed
+	_ = os.Remove(file.Name())
+
+	h.file = file
+	h.writer = bufio.NewWriter(file)
+
+	for _, txHash := range h.hashes {
+		_, err = h.writer.Write(txHash[:])
+		if err != nil {
+			return err
+		}
+	}
+	h.hashes = nil
+
+	return nil
+}
+
+func (h *txHashes) done() ([]*chainhash.Hash, *os.File, error) {
+	if h.file == nil {
+		return h.hashes, nil, nil
+	}
+
+	err := h.writer.Flush()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, h.file, nil
+}
+
+func (h *txHashes) close() {
+	if h.file != nil {
+		_ = h.file.Close()
+	}
+}
+
+// readSpilledTxHashes reads the transaction hashes with the indexes from `from` to `to` from the spill file. The file is
+// read at the offsets of the hashes, so that ranges can be read concurrently.
+func readSpilledTxHashes(file *os.File, from int, to int) ([]*chainhash.Hash, error) {
+	if to <= from {
+		return nil, nil
+	}
+
+	buf := make([]byte, (to-from)*chainhash.HashSize)
+	_, err := file.ReadAt(buf, int64(from)*chainhash.HashSize)
+	if err != nil {
+		return nil, errors.Join(ErrSpilledTxHashesNotRead, err)
+	}
+
+	hashes := make([]chainhash.Hash, to-from)
+	txHashes := make([]*chainhash.Hash, to-from)
+	for i := range hashes {
+		copy(hashes[i][:], buf[i*chainhash.HashSize:])
+		txHashes[i] = &hashes[i]
+	}
+
+	return txHashes, nil
+}
+
+func extractHeightFromCoinbaseScript(cscript []byte) uint64 {
+	// Coinbase tx has a special format, the height is encoded in the first bytes of the scriptSig (BIP-34)
+	if len(cscript) == 0 {
+		return 0
+	}
+
+	if cscript[0] >= script.Op1 && cscript[0] <= script.Op16 { // first 16 blocks are treated differently (we have to handle it due to our tests)
+		return uint64(cscript[0] - 0x50)
+	}
+
+	hLength := int(cscript[0])
+	if hLength > 8 || len(cscript) < hLength+1 {
+		return 0
+	}
+
+	b := make([]byte, 8)
+	copy(b, cscript[1:hLength+1])
+
+	return binary.LittleEndian.Uint64(b)
+}
+
+// extractMinerTagFromCoinbaseScript returns the tag by which the miner identifies itself in the coinbase script, e.g.
+// `/TAAL/`. The tag consists of the sequences of printable characters after the block height.
+func extractMinerTagFromCoinbaseScript(cscript []byte) string {
+	if len(cscript) == 0 {
+		return ""
+	}
+
+	// skip the block height
+	start := 1
+	if cscript[0] < script.Op1 || cscript[0] > script.Op16 {
+		start += int(cscript[0])
+	}
+	if start >= len(cscript) {
+		return ""
+	}
+
+	var parts []string
+	var part strings.Builder
+	addPart := func() {
+		if s := strings.TrimSpace(part.String()); len(s) >= minMinerTagPartLen {
+			parts = append(parts, s)
+		}
+		part.Reset()
+	}
+
+	for _, b := range cscript[start:] {
+		if b < 0x20 || b > 0x7e {
+			addPart()
+			continue
+		}
+		part.WriteByte(b)
+	}
+	addPart()
+
+	return strings.Join(parts, " ")
+}
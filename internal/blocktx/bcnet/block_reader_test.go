@@ -0,0 +1,358 @@
+package bcnet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"runtime"
+	"testing"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/hashing"
+)
+
+func newTestBlock(t *testing.T, txs int) *wire.MsgBlock {
+	t.Helper()
+
+	msgBlock := wire.NewMsgBlock(wire.NewBlockHeader(0, blockHash, blockHash, 0, 0))
+	for i := range txs {
+		tx := wire.NewMsgTx(1)
+		signatureScript := []byte{0x03, 0x50, 0xcc, 0x0b, byte(i)} // height 773200 in the coinbase
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{byte(i)}, Index: 0xffffffff}, signatureScript))
+		tx.AddTxOut(wire.NewTxOut(1000, bytes.Repeat([]byte{0x51}, i*100)))
+
+		err := msgBlock.AddTransaction(tx)
+		require.NoError(t, err)
+	}
+
+	return msgBlock
+}
+
+func TestBlockReader_Read(t *testing.T) {
+	tt := []struct {
+		name           string
+		txs            int
+		spillThreshold uint64
+		readBufferSize int
+
+		expectedSpilled bool
+	}{
+		{
+			name:           "transactions in memory",
+			txs:            10,
+			spillThreshold: defaultSpillThreshold,
+			readBufferSize: defaultReadBufferSize,
+		},
+		{
+			name:           "transactions larger than read buffer",
+			txs:            10,
+			spillThreshold: defaultSpillThreshold,
+			readBufferSize: 16,
+		},
+		{
+			name:           "transaction hashes spilled",
+			txs:            10,
+			spillThreshold: 3,
+			readBufferSize: defaultReadBufferSize,
+
+			expectedSpilled: true,
+		},
+		{
+			name:           "spilling disabled",
+			txs:            10,
+			spillThreshold: 0,
+			readBufferSize: defaultReadBufferSize,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			msgBlock := newTestBlock(t, tc.txs)
+			var payload bytes.Buffer
+			err := msgBlock.Serialize(&payload)
+			require.NoError(t, err)
+
+			sut := &blockReader{readBufferSize: tc.readBufferSize, spillThreshold: tc.spillThreshold, spillDir: t.TempDir()}
+
+			// when
+			n, msg, _, err := sut.read(&payload, uint64(payload.Len()), wire.MessageHeaderSize)
+
+			// then
+			require.NoError(t, err)
+			blockMsg, ok := msg.(*BlockMessage)
+			require.True(t, ok)
+
+			assert.Equal(t, msgBlock.Header.BlockHash(), *blockMsg.Hash)
+			assert.Equal(t, uint64(773200), blockMsg.Height)
+			assert.Equal(t, uint64(1000), blockMsg.CoinbaseReward)
+			assert.Equal(t, n, int(blockMsg.Size))
+			assert.Equal(t, wire.MessageHeaderSize+msgBlock.SerializeSize(), n)
+
+			assert.Equal(t, tc.expectedSpilled, blockMsg.Spilled())
+			if tc.expectedSpilled {
+				require.Nil(t, blockMsg.TransactionHashes)
+			}
+
+			require.Equal(t, tc.txs, blockMsg.TxCount())
+			txHashes, err := blockMsg.ReadTransactionHashes(0, blockMsg.TxCount())
+			require.NoError(t, err)
+			for i, tx := range msgBlock.Transactions {
+				assert.Equal(t, tx.TxHash(), *txHashes[i])
+			}
+
+			// ranges of the transaction hashes are read
+			txHashes, err = blockMsg.ReadTransactionHashes(4, 6)
+			require.NoError(t, err)
+			require.Len(t, txHashes, 2)
+			assert.Equal(t, msgBlock.Transactions[5].TxHash(), *txHashes[1])
+
+			require.NoError(t, blockMsg.Close())
+			assert.False(t, blockMsg.Spilled())
+		})
+	}
+}
+
+func TestBlockReader_ReadNormalizedHashes(t *testing.T) {
+	// given
+	msgBlock := newTestBlock(t, 5)
+	var payload bytes.Buffer
+	err := msgBlock.Serialize(&payload)
+	require.NoError(t, err)
+
+	sut := &blockReader{readBufferSize: 16, spillThreshold: defaultSpillThreshold, normalizedHashes: true}
+
+	// when
+	_, msg, _, err := sut.read(&payload, uint64(payload.Len()), wire.MessageHeaderSize)
+
+	// then
+	require.NoError(t, err)
+	blockMsg, ok := msg.(*BlockMessage)
+	require.True(t, ok)
+
+	require.Len(t, blockMsg.NormalizedHashes, len(msgBlock.Transactions))
+	for i, tx := range msgBlock.Transactions {
+		var rawTx bytes.Buffer
+		require.NoError(t, tx.Serialize(&rawTx))
+
+		expected, err := hashing.NormalizedTxHash(rawTx.Bytes())
+		require.NoError(t, err)
+
+		assert.Equal(t, tx.TxHash(), *blockMsg.TransactionHashes[i])
+		assert.Equal(t, expected, *blockMsg.NormalizedHashes[i])
+	}
+}
+
+func TestBlockReader_ReadInvalid(t *testing.T) {
+	msgBlock := newTestBlock(t, 2)
+	var payload bytes.Buffer
+	err := msgBlock.Serialize(&payload)
+	require.NoError(t, err)
+
+	tooManyTxs := bytes.Clone(payload.Bytes())
+	tooManyTxs[blockHeaderSize] = 0xfe
+	binary.LittleEndian.PutUint32(tooManyTxs[blockHeaderSize+1:], 0xffffffff)
+
+	tt := []struct {
+		name    string
+		payload []byte
+		length  uint64
+	}{
+		{
+			name:    "transaction count exceeds payload",
+			payload: tooManyTxs,
+			length:  uint64(len(tooManyTxs)),
+		},
+		{
+			name:    "payload length mismatch",
+			payload: payload.Bytes(),
+			length:  uint64(payload.Len() + 1),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := &blockReader{readBufferSize: defaultReadBufferSize, spillThreshold: defaultSpillThreshold}
+
+			// when
+			_, _, _, err := sut.read(bytes.NewReader(tc.payload), tc.length, wire.MessageHeaderSize)
+
+			// then
+			var msgErr *wire.MessageError
+			require.ErrorAs(t, err, &msgErr)
+		})
+	}
+}
+
+type testRawBlockArchive struct {
+	writer *testRawBlockWriter
+}
+
+func (a *testRawBlockArchive) NewWriter(size uint64) RawBlockWriter {
+	a.writer = &testRawBlockWriter{size: size}
+	return a.writer
+}
+
+type testRawBlockWriter struct {
+	bytes.Buffer
+	size      uint64
+	committed *chainhash.Hash
+	aborted   bool
+}
+
+func (w *testRawBlockWriter) Commit(hash chainhash.Hash) {
+	w.committed = &hash
+}
+
+func (w *testRawBlockWriter) Abort() {
+	w.aborted = true
+}
+
+func TestBlockReader_ReadRawBlockArchive(t *testing.T) {
+	tt := []struct {
+		name     string
+		truncate int
+
+		expectedCommitted bool
+	}{
+		{
+			name: "committed",
+
+			expectedCommitted: true,
+		},
+		{
+			name:     "aborted",
+			truncate: 10,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			msgBlock := newTestBlock(t, 3)
+			var payload bytes.Buffer
+			err := msgBlock.Serialize(&payload)
+			require.NoError(t, err)
+			raw := payload.Bytes()
+
+			archive := &testRawBlockArchive{}
+			sut := newBlockReader(WithRawBlockArchive(archive))
+
+			// when
+			_, _, _, err = sut.read(bytes.NewReader(raw[:len(raw)-tc.truncate]), uint64(len(raw)), wire.MessageHeaderSize)
+
+			// then
+			require.NotNil(t, archive.writer)
+			assert.Equal(t, uint64(len(raw)), archive.writer.size)
+
+			if !tc.expectedCommitted {
+				require.Error(t, err)
+				assert.True(t, archive.writer.aborted)
+				assert.Nil(t, archive.writer.committed)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, raw, archive.writer.Bytes())
+			require.NotNil(t, archive.writer.committed)
+			assert.Equal(t, msgBlock.BlockHash(), *archive.writer.committed)
+
+			blockMsg, err := ReadBlock(bytes.NewReader(archive.writer.Bytes()), archive.writer.size)
+			require.NoError(t, err)
+			assert.Equal(t, msgBlock.BlockHash(), *blockMsg.Hash)
+			assert.Equal(t, uint64(wire.MessageHeaderSize+len(raw)), blockMsg.Size)
+			require.Len(t, blockMsg.TransactionHashes, 3)
+		})
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func TestBlockReader_ReadMultiGBBlock(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping multi-GB block test in short mode")
+	}
+
+	// given
+	const scriptSize = 3 * 1024 * 1024 * 1024
+
+	// allow messages with payloads of up to 4GB
+	wire.SetLimits(4_000_000_000)
+	defer wire.SetLimits(32_000_000)
+
+	coinbase := newTestBlock(t, 1).Transactions[0]
+	var coinbaseBytes bytes.Buffer
+	err := coinbase.Serialize(&coinbaseBytes)
+	require.NoError(t, err)
+
+	// a transaction with a single output with a 3GB locking script
+	var txPrefix bytes.Buffer
+	txPrefix.Write([]byte{0x01, 0x00, 0x00, 0x00}) // version
+	txPrefix.WriteByte(0x00)                       // no inputs
+	txPrefix.WriteByte(0x01)                       // one output
+	txPrefix.Write(make([]byte, 8))                // value
+	err = wire.WriteVarInt(&txPrefix, 0, scriptSize)
+	require.NoError(t, err)
+	txSuffix := []byte{0x00, 0x00, 0x00, 0x00} // lock time
+
+	newTx := func() io.Reader {
+		return io.MultiReader(bytes.NewReader(txPrefix.Bytes()), io.LimitReader(zeroReader{}, scriptSize), bytes.NewReader(txSuffix))
+	}
+
+	hasher := sha256.New()
+	_, err = io.Copy(hasher, newTx())
+	require.NoError(t, err)
+	expectedTxHash := chainhash.Hash(sha256.Sum256(hasher.Sum(nil)))
+
+	var prefix bytes.Buffer
+	header := wire.NewBlockHeader(0, blockHash, blockHash, 0, 0)
+	err = header.Serialize(&prefix)
+	require.NoError(t, err)
+	prefix.WriteByte(0x02) // two transactions
+	prefix.Write(coinbaseBytes.Bytes())
+
+	payloadLength := prefix.Len() + txPrefix.Len() + scriptSize + len(txSuffix)
+
+	var msgHeader bytes.Buffer
+	err = binary.Write(&msgHeader, binary.LittleEndian, bitcoinNet)
+	require.NoError(t, err)
+	command := [wire.CommandSize]byte{}
+	copy(command[:], wire.CmdBlock)
+	msgHeader.Write(command[:])
+	err = binary.Write(&msgHeader, binary.LittleEndian, uint32(payloadLength))
+	require.NoError(t, err)
+	msgHeader.Write(make([]byte, 4)) // checksum is not verified for blocks
+
+	var memStatsBefore, memStatsAfter runtime.MemStats
+	runtime.ReadMemStats(&memStatsBefore)
+
+	// when
+	_, msg, _, err := wire.ReadMessageN(io.MultiReader(&msgHeader, &prefix, newTx()), wire.ProtocolVersion, bitcoinNet)
+
+	// then
+	runtime.ReadMemStats(&memStatsAfter)
+
+	require.NoError(t, err)
+	blockMsg, ok := msg.(*BlockMessage)
+	require.True(t, ok)
+
+	require.Len(t, blockMsg.TransactionHashes, 2)
+	assert.Equal(t, coinbase.TxHash(), *blockMsg.TransactionHashes[0])
+	assert.Equal(t, expectedTxHash, *blockMsg.TransactionHashes[1])
+	assert.Equal(t, uint64(773200), blockMsg.Height)
+	assert.Equal(t, uint64(wire.MessageHeaderSize+payloadLength), blockMsg.Size)
+
+	// memory allocated for reading the block is independent of its size
+	assert.Less(t, memStatsAfter.TotalAlloc-memStatsBefore.TotalAlloc, uint64(16*1024*1024))
+}
@@ -62,6 +62,24 @@ func (h *MsgHandler) OnReceive(msg wire.Message, peer p2p.PeerI) {
 			}
 		}()
 
+	case wire.CmdHeaders:
+		// peers asked to announce new blocks with their headers announce them with a headers message
+		headersMsg, ok := msg.(*wire.MsgHeaders)
+		if !ok {
+			return
+		}
+
+		// the requests are not queued if the processing lags behind, the blocks are requested again when they are
+		// announced by another peer or when the gaps are filled
+		for _, header := range headersMsg.Headers {
+			hash := header.BlockHash()
+			select {
+			case h.blockRequestingCh <- BlockRequest{Hash: &hash, Peer: peer}:
+			default:
+				h.logger.Warn("Block request queue full, ignoring announced block", slog.String("hash", hash.String()), slog.String("peer", peer.String()))
+			}
+		}
+
 	case wire.CmdBlock:
 		blockMsg, ok := msg.(*bcnet.BlockMessage)
 		if !ok {
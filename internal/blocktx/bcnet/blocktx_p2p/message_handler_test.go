@@ -0,0 +1,99 @@
+package blocktx_p2p
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
+	p2pMocks "github.com/bitcoin-sv/arc/internal/p2p/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func Test_MessageHandlerOnReceive(t *testing.T) {
+	header := wire.NewBlockHeader(1, testdata.Block1Hash, testdata.TX1Hash, 436732028, 1234660301)
+
+	tt := []struct {
+		name    string
+		wireMsg wire.Message
+
+		expectedRequested *chainhash.Hash
+	}{
+		{
+			name: wire.CmdInv,
+			wireMsg: func() wire.Message {
+				msg := wire.NewMsgInv()
+				_ = msg.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, testdata.Block1Hash))
+				return msg
+			}(),
+
+			expectedRequested: testdata.Block1Hash,
+		},
+		{
+			name: wire.CmdHeaders,
+			wireMsg: func() wire.Message {
+				msg := wire.NewMsgHeaders()
+				_ = msg.AddBlockHeader(header)
+				return msg
+			}(),
+
+			expectedRequested: func() *chainhash.Hash {
+				hash := header.BlockHash()
+				return &hash
+			}(),
+		},
+		{
+			name: wire.CmdInv + " TX should ignore",
+			wireMsg: func() wire.Message {
+				msg := wire.NewMsgInv()
+				_ = msg.AddInvVect(wire.NewInvVect(wire.InvTypeTx, testdata.TX1Hash))
+				return msg
+			}(),
+		},
+	}
+
+	t.Run(wire.CmdHeaders+" queue full should not block", func(t *testing.T) {
+		// given
+		blockRequestCh := make(chan BlockRequest, 1)
+		blockRequestCh <- BlockRequest{Hash: testdata.Block2Hash}
+		peer := &p2pMocks.PeerIMock{StringFunc: func() string { return "peer" }}
+		sut := NewMsgHandler(slog.Default(), blockRequestCh, make(chan *bcnet.BlockMessagePeer))
+
+		msg := wire.NewMsgHeaders()
+		_ = msg.AddBlockHeader(header)
+
+		// when
+		sut.OnReceive(msg, peer)
+
+		// then
+		require.Len(t, blockRequestCh, 1)
+		req := <-blockRequestCh
+		require.Equal(t, testdata.Block2Hash, req.Hash)
+	})
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			blockRequestCh := make(chan BlockRequest, 1)
+			peer := &p2pMocks.PeerIMock{StringFunc: func() string { return "peer" }}
+			sut := NewMsgHandler(slog.Default(), blockRequestCh, make(chan *bcnet.BlockMessagePeer))
+
+			// when
+			sut.OnReceive(tc.wireMsg, peer)
+
+			// then
+			select {
+			case req := <-blockRequestCh:
+				require.NotNil(t, tc.expectedRequested, "unexpected block request")
+				require.Equal(t, tc.expectedRequested, req.Hash)
+				require.Equal(t, peer, req.Peer)
+			case <-time.After(100 * time.Millisecond):
+				require.Nil(t, tc.expectedRequested, "block not requested")
+			}
+		})
+	}
+}
@@ -26,7 +26,7 @@ func TestExtractHeight(t *testing.T) {
 	require.NoError(t, err)
 
 	// when
-	height := extractHeightFromCoinbaseTx(tx)
+	height := extractHeightFromCoinbaseScript(*tx.Inputs[0].UnlockingScript)
 
 	// then
 	assert.Equalf(t, uint64(773200), height, "height should be 773200, got %d", height)
@@ -38,12 +38,65 @@ func TestExtractHeightForRegtest(t *testing.T) {
 	require.NoError(t, err)
 
 	// when
-	height := extractHeightFromCoinbaseTx(tx)
+	height := extractHeightFromCoinbaseScript(*tx.Inputs[0].UnlockingScript)
 
 	// then
 	assert.Equalf(t, uint64(2012), height, "height should be 2012, got %d", height)
 }
 
+func TestExtractMinerTag(t *testing.T) {
+	tt := []struct {
+		name    string
+		cscript []byte
+
+		expectedTag string
+	}{
+		{
+			name:    "mainnet coinbase",
+			cscript: mustDecodeScript(t, "01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff570350cc0b041547b5630cfabe6d6d0000000000000000000000000000000000000000000000000000000000000000010000000000000047ed20542096bd0000000000143362663865373833636662643732306431383436000000000140be4025000000001976a914c9b0abe09b7dd8e9d1e8c1e3502d32ab0d7119e488ac00000000"),
+
+			expectedTag: "3bf8e783cfbd720d1846",
+		},
+		{
+			name:    "tag and extra nonce",
+			cscript: append([]byte{0x03, 0x50, 0xcc, 0x0b, 0x06}, append([]byte("/TAAL/"), 0x01, 0xfe, 0x41, 0x42, 0x00)...),
+
+			expectedTag: "/TAAL/",
+		},
+		{
+			name:    "regtest coinbase without tag",
+			cscript: []byte{0x02, 0xdc, 0x07, 0x01, 0x01},
+
+			expectedTag: "",
+		},
+		{
+			name:    "empty script",
+			cscript: nil,
+
+			expectedTag: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			tag := extractMinerTagFromCoinbaseScript(tc.cscript)
+
+			// then
+			assert.Equal(t, tc.expectedTag, tag)
+		})
+	}
+}
+
+func mustDecodeScript(t *testing.T, txHex string) []byte {
+	t.Helper()
+
+	tx, err := sdkTx.NewTransactionFromHex(txHex)
+	require.NoError(t, err)
+
+	return *tx.Inputs[0].UnlockingScript
+}
+
 func TestMessageRead(t *testing.T) {
 	t.Run("Message read", func(t *testing.T) {
 		// given
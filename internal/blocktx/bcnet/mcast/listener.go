@@ -92,6 +92,9 @@ func (l *Listener) OnReceiveFromMcast(msg wire.Message) {
 		l.logger.Info("Received BLOCK msg from multicast group", slog.String("hash", hash.String()))
 		processedBy, err := l.store.SetBlockProcessing(context.Background(), hash, l.hostname, lockTime, maxBlocksInProgress)
 		if err != nil {
+			// the block is not processed by this instance
+			_ = blockMsg.Close()
+
 			if errors.Is(err, store.ErrBlockProcessingMaximumReached) {
 				l.logger.Debug("block processing maximum reached", slog.String("hash", hash.String()), slog.String("processed_by", processedBy))
 				return
@@ -0,0 +1,388 @@
+package block_archive
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
+	"github.com/bitcoin-sv/arc/internal/upload"
+)
+
+const (
+	pruneIntervalDefault = time.Hour
+	uploadTimeoutDefault = 2 * time.Minute
+	fileExtension        = ".bin.gz"
+	partialFileExtension = ".bin.gz.part"
+	writeBufferSize      = 256 * 1024
+)
+
+var (
+	ErrFailedToCreateDir   = errors.New("failed to create block archive directory")
+	ErrBlockNotArchived    = errors.New("block not archived")
+	ErrFailedToOpenBlock   = errors.New("failed to open archived block")
+	ErrFailedToPrune       = errors.New("failed to prune archived blocks")
+	ErrFailedToListUploads = errors.New("failed to list archived blocks to upload")
+	ErrUploadCommandFailed = upload.ErrCommandFailed
+	ErrFailedToRemove      = errors.New("failed to remove uploaded archived block")
+)
+
+// Archive stores the raw bytes of the blocks read by blocktx as gzip compressed files named by the hash of the block,
+// so that blocks can be reprocessed byte-exactly and analysed without downloading them again from the peers. The size
+// of the payload of a block is stored in the comment of the gzip header.
+//
+// The files are kept in a local directory and removed once they are older than the retention or, if an upload command
+// is set, uploaded to object storage, e.g. with `aws s3 cp {file} s3://bucket/blocks/{name}`, and removed locally
+// after the upload. The retention of uploaded blocks is then up to the lifecycle rules of the object storage.
+type Archive struct {
+	logger        *slog.Logger
+	dir           string
+	retention     time.Duration
+	pruneInterval time.Duration
+	uploadCommand []string
+	uploadTimeout time.Duration
+	now           func() time.Time
+
+	uploadCh  chan struct{}
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+// WithRetention sets the duration for which the blocks are kept in the local directory, 0 keeps them forever.
+func WithRetention(d time.Duration) func(*Archive) {
+	return func(a *Archive) {
+		a.retention = d
+	}
+}
+
+func WithPruneInterval(d time.Duration) func(*Archive) {
+	return func(a *Archive) {
+		a.pruneInterval = d
+	}
+}
+
+// WithUploadCommand uploads the archived blocks with the command, the placeholders {file} and {name} are replaced with
+// the path and the name of the file.
+func WithUploadCommand(command []string) func(*Archive) {
+	return func(a *Archive) {
+		a.uploadCommand = command
+	}
+}
+
+func WithUploadTimeout(d time.Duration) func(*Archive) {
+	return func(a *Archive) {
+		a.uploadTimeout = d
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Archive) {
+	return func(a *Archive) {
+		a.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, dir string, opts ...func(*Archive)) (*Archive, error) {
+	a := &Archive{
+		logger:        logger.With(slog.String("module", "block-archive")),
+		dir:           dir,
+		pruneInterval: pruneIntervalDefault,
+		uploadTimeout: uploadTimeoutDefault,
+		now:           time.Now,
+		uploadCh:      make(chan struct{}, 1),
+		waitGroup:     &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateDir, err)
+	}
+
+	// blocks which were still being written when blocktx stopped are incomplete
+	partialFiles, err := filepath.Glob(filepath.Join(dir, "*"+partialFileExtension))
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateDir, err)
+	}
+	for _, partialFile := range partialFiles {
+		_ = os.Remove(partialFile)
+	}
+
+	a.ctx, a.cancelAll = context.WithCancel(context.Background())
+
+	return a, nil
+}
+
+// NewWriter returns the writer of the raw bytes of a block with the given payload size. If the file of the block
+// cannot be created, the block is not archived.
+func (a *Archive) NewWriter(size uint64) bcnet.RawBlockWriter {
+	w := &blockWriter{archive: a}
+
+	file, err := os.CreateTemp(a.dir, "*"+partialFileExtension)
+	if err != nil {
+		w.err = err
+		return w
+	}
+
+	w.file = file
+	w.buf = bufio.NewWriterSize(file, writeBufferSize)
+	// blocks are archived while they are read from the peers, so speed is preferred over compression
+	w.gz, err = gzip.NewWriterLevel(w.buf, gzip.BestSpeed)
+	if err != nil {
+		w.err = err
+		return w
+	}
+	w.gz.Comment = strconv.FormatUint(size, 10)
+
+	return w
+}
+
+// Open returns the raw bytes of the archived block and the size of its payload.
+func (a *Archive) Open(hash chainhash.Hash) (io.ReadCloser, uint64, error) {
+	file, err := os.Open(a.path(hash))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, 0, errors.Join(ErrBlockNotArchived, fmt.Errorf("block %s", hash.String()))
+		}
+		return nil, 0, errors.Join(ErrFailedToOpenBlock, err)
+	}
+
+	gz, err := gzip.NewReader(bufio.NewReader(file))
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, errors.Join(ErrFailedToOpenBlock, err)
+	}
+
+	size, err := strconv.ParseUint(gz.Comment, 10, 64)
+	if err != nil {
+		_ = file.Close()
+		return nil, 0, errors.Join(ErrFailedToOpenBlock, fmt.Errorf("invalid size %q: %w", gz.Comment, err))
+	}
+
+	return &archivedBlock{Reader: gz, file: file}, size, nil
+}
+
+// ReadBlock reads the archived block the same way as the blocks received from the peers.
+func (a *Archive) ReadBlock(hash chainhash.Hash, opts ...bcnet.BlockReaderOption) (*bcnet.BlockMessage, error) {
+	reader, size, err := a.Open(hash)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	blockMessage, err := bcnet.ReadBlock(reader, size, opts...)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToOpenBlock, err)
+	}
+
+	return blockMessage, nil
+}
+
+// Start prunes the blocks which are older than the retention every prune interval and uploads the archived blocks
+// if an upload command is set.
+func (a *Archive) Start() {
+	ticker := time.NewTicker(a.pruneInterval)
+	a.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			a.waitGroup.Done()
+		}()
+
+		for {
+			select {
+			case <-a.ctx.Done():
+				return
+			case <-ticker.C:
+				pruned, err := a.Prune()
+				if err != nil {
+					a.logger.Error("Failed to prune archived blocks", slog.String("err", err.Error()))
+				} else if pruned > 0 {
+					a.logger.Info("Pruned archived blocks", slog.Int("blocks", pruned))
+				}
+			case <-a.uploadCh:
+			}
+
+			err := a.Upload(a.ctx)
+			if err != nil {
+				a.logger.Error("Failed to upload archived blocks", slog.String("err", err.Error()))
+			}
+		}
+	}()
+}
+
+// Prune removes the archived blocks which are older than the retention and returns their number.
+func (a *Archive) Prune() (int, error) {
+	if a.retention <= 0 {
+		return 0, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(a.dir, "*"+fileExtension))
+	if err != nil {
+		return 0, errors.Join(ErrFailedToPrune, err)
+	}
+
+	cutoff := a.now().Add(-a.retention)
+	pruned := 0
+	for _, file := range files {
+		var info os.FileInfo
+		info, err = os.Stat(file)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return pruned, errors.Join(ErrFailedToPrune, err)
+		}
+
+		if !info.ModTime().Before(cutoff) {
+			continue
+		}
+
+		err = os.Remove(file)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return pruned, errors.Join(ErrFailedToPrune, err)
+		}
+		pruned++
+	}
+
+	return pruned, nil
+}
+
+// Upload uploads all archived blocks and removes them once they are uploaded. Without upload command the blocks are
+// kept in the local directory.
+func (a *Archive) Upload(ctx context.Context) error {
+	if len(a.uploadCommand) == 0 {
+		return nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(a.dir, "*"+fileExtension))
+	if err != nil {
+		return errors.Join(ErrFailedToListUploads, err)
+	}
+
+	for _, file := range files {
+		err = a.upload(ctx, file)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("file: %s", file))
+		}
+
+		err = os.Remove(file)
+		if err != nil {
+			return errors.Join(ErrFailedToRemove, err)
+		}
+
+		a.logger.Info("Uploaded archived block", slog.String("file", filepath.Base(file)))
+	}
+
+	return nil
+}
+
+func (a *Archive) upload(ctx context.Context, file string) error {
+	return upload.Run(ctx, a.uploadCommand, file, a.uploadTimeout)
+}
+
+// Shutdown stops the pruning and makes a last attempt to upload the archived blocks.
+func (a *Archive) Shutdown() {
+	a.cancelAll()
+	a.waitGroup.Wait()
+
+	err := a.Upload(context.Background())
+	if err != nil {
+		a.logger.Error("Failed to upload archived blocks", slog.String("err", err.Error()))
+	}
+}
+
+func (a *Archive) path(hash chainhash.Hash) string {
+	return filepath.Join(a.dir, hash.String()+fileExtension)
+}
+
+// blockWriter compresses the raw bytes of a block into a partial file which is renamed to the hash of the block on
+// commit. Write errors are kept until the commit, where they are logged, so that they don't fail the reading of the
+// block.
+type blockWriter struct {
+	archive *Archive
+	file    *os.File
+	buf     *bufio.Writer
+	gz      *gzip.Writer
+	err     error
+}
+
+func (w *blockWriter) Write(p []byte) (int, error) {
+	if w.err == nil {
+		_, w.err = w.gz.Write(p)
+	}
+
+	return len(p), nil
+}
+
+func (w *blockWriter) Commit(hash chainhash.Hash) {
+	err := w.close()
+	if err != nil {
+		w.archive.logger.Error("Failed to archive block", slog.String("hash", hash.String()), slog.String("err", err.Error()))
+		w.remove()
+		return
+	}
+
+	err = os.Rename(w.file.Name(), w.archive.path(hash))
+	if err != nil {
+		w.archive.logger.Error("Failed to archive block", slog.String("hash", hash.String()), slog.String("err", err.Error()))
+		w.remove()
+		return
+	}
+
+	w.archive.logger.Debug("Archived block", slog.String("hash", hash.String()))
+
+	select {
+	case w.archive.uploadCh <- struct{}{}:
+	default:
+	}
+}
+
+func (w *blockWriter) Abort() {
+	_ = w.close()
+	w.remove()
+}
+
+func (w *blockWriter) close() error {
+	if w.file == nil {
+		return w.err
+	}
+
+	errs := []error{w.err}
+	if w.gz != nil {
+		errs = append(errs, w.gz.Close())
+	}
+	errs = append(errs, w.buf.Flush(), w.file.Close())
+
+	return errors.Join(errs...)
+}
+
+func (w *blockWriter) remove() {
+	if w.file != nil {
+		_ = os.Remove(w.file.Name())
+	}
+}
+
+type archivedBlock struct {
+	io.Reader
+	file *os.File
+}
+
+func (b *archivedBlock) Close() error {
+	return b.file.Close()
+}
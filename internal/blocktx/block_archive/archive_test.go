@@ -0,0 +1,220 @@
+package block_archive_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/block_archive"
+)
+
+func newTestBlock(t *testing.T) (*wire.MsgBlock, []byte) {
+	t.Helper()
+
+	msgBlock := wire.NewMsgBlock(wire.NewBlockHeader(0, &chainhash.Hash{1}, &chainhash.Hash{2}, 0, 0))
+	for i := range 3 {
+		tx := wire.NewMsgTx(1)
+		signatureScript := []byte{0x03, 0x50, 0xcc, 0x0b, byte(i)} // height 773200 in the coinbase
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{byte(i)}, Index: 0xffffffff}, signatureScript))
+		tx.AddTxOut(wire.NewTxOut(1000, bytes.Repeat([]byte{0x51}, i*100)))
+		require.NoError(t, msgBlock.AddTransaction(tx))
+	}
+
+	var payload bytes.Buffer
+	require.NoError(t, msgBlock.Serialize(&payload))
+
+	return msgBlock, payload.Bytes()
+}
+
+func TestArchive_ReadBlock(t *testing.T) {
+	// given
+	msgBlock, payload := newTestBlock(t)
+	blockHash := msgBlock.BlockHash()
+
+	sut, err := block_archive.New(slog.Default(), t.TempDir())
+	require.NoError(t, err)
+
+	w := sut.NewWriter(uint64(len(payload)))
+	_, err = w.Write(payload[:50])
+	require.NoError(t, err)
+	_, err = w.Write(payload[50:])
+	require.NoError(t, err)
+	w.Commit(blockHash)
+
+	// when
+	reader, size, err := sut.Open(blockHash)
+	require.NoError(t, err)
+	var raw bytes.Buffer
+	_, err = raw.ReadFrom(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+
+	blockMsg, err := sut.ReadBlock(blockHash)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, uint64(len(payload)), size)
+	assert.Equal(t, payload, raw.Bytes())
+
+	assert.Equal(t, blockHash, *blockMsg.Hash)
+	assert.Equal(t, uint64(773200), blockMsg.Height)
+	require.Len(t, blockMsg.TransactionHashes, len(msgBlock.Transactions))
+	for i, tx := range msgBlock.Transactions {
+		assert.Equal(t, tx.TxHash(), *blockMsg.TransactionHashes[i])
+	}
+}
+
+func TestArchive_Abort(t *testing.T) {
+	// given
+	msgBlock, payload := newTestBlock(t)
+	dir := t.TempDir()
+
+	sut, err := block_archive.New(slog.Default(), dir)
+	require.NoError(t, err)
+
+	w := sut.NewWriter(uint64(len(payload)))
+	_, err = w.Write(payload[:50])
+	require.NoError(t, err)
+
+	// when
+	w.Abort()
+
+	// then
+	_, _, err = sut.Open(msgBlock.BlockHash())
+	require.ErrorIs(t, err, block_archive.ErrBlockNotArchived)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestArchive_Prune(t *testing.T) {
+	tt := []struct {
+		name      string
+		retention time.Duration
+
+		expectedPruned int
+	}{
+		{
+			name:      "expired",
+			retention: time.Hour,
+
+			expectedPruned: 1,
+		},
+		{
+			name:      "not expired",
+			retention: 72 * time.Hour,
+
+			expectedPruned: 0,
+		},
+		{
+			name:      "no retention",
+			retention: 0,
+
+			expectedPruned: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			msgBlock, payload := newTestBlock(t)
+			now := time.Now().Add(48 * time.Hour)
+
+			sut, err := block_archive.New(slog.Default(), t.TempDir(),
+				block_archive.WithRetention(tc.retention),
+				block_archive.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			w := sut.NewWriter(uint64(len(payload)))
+			_, err = w.Write(payload)
+			require.NoError(t, err)
+			w.Commit(msgBlock.BlockHash())
+
+			// when
+			pruned, err := sut.Prune()
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedPruned, pruned)
+
+			_, _, err = sut.Open(msgBlock.BlockHash())
+			if tc.expectedPruned > 0 {
+				require.ErrorIs(t, err, block_archive.ErrBlockNotArchived)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestArchive_Upload(t *testing.T) {
+	tt := []struct {
+		name          string
+		uploadCommand func(uploadDir string) []string
+
+		expectedErr      error
+		expectedUploaded bool
+	}{
+		{
+			name: "uploaded",
+			uploadCommand: func(uploadDir string) []string {
+				return []string{"cp", "{file}", filepath.Join(uploadDir, "{name}")}
+			},
+
+			expectedUploaded: true,
+		},
+		{
+			name: "upload command fails",
+			uploadCommand: func(_ string) []string {
+				return []string{"false"}
+			},
+
+			expectedErr: block_archive.ErrUploadCommandFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			msgBlock, payload := newTestBlock(t)
+			uploadDir := t.TempDir()
+
+			sut, err := block_archive.New(slog.Default(), t.TempDir(), block_archive.WithUploadCommand(tc.uploadCommand(uploadDir)))
+			require.NoError(t, err)
+
+			w := sut.NewWriter(uint64(len(payload)))
+			_, err = w.Write(payload)
+			require.NoError(t, err)
+			w.Commit(msgBlock.BlockHash())
+
+			// when
+			err = sut.Upload(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				_, _, err = sut.Open(msgBlock.BlockHash())
+				require.NoError(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = os.Stat(filepath.Join(uploadDir, msgBlock.BlockHash().String()+".bin.gz"))
+			assert.Equal(t, tc.expectedUploaded, err == nil)
+
+			_, _, err = sut.Open(msgBlock.BlockHash())
+			require.ErrorIs(t, err, block_archive.ErrBlockNotArchived)
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package blocktx
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet/blocktx_p2p"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+// blockDownloads coordinates the downloads of blocks announced by multiple peers, so that each block is downloaded
+// from one peer only. The peers announcing a block which is already downloaded are kept as fallbacks. The block is
+// requested from the next fallback peer only if the download fails, i.e. if the peer disconnects or doesn't deliver the
+// block within the timeout. The peers are selected by their measured throughput, see peerThroughput.
+type blockDownloads struct {
+	mu           sync.Mutex
+	timeout      time.Duration
+	completedTTL time.Duration
+	throughput   *peerThroughput
+	inFlight     map[chainhash.Hash]*blockDownload
+	completed    map[chainhash.Hash]time.Time
+}
+
+type blockDownload struct {
+	peer p2p.PeerI
+	// requestedAt is zero until the block is requested from the peer
+	requestedAt time.Time
+	fallbacks   []p2p.PeerI
+}
+
+func newBlockDownloads(timeout time.Duration, completedTTL time.Duration, throughput *peerThroughput) *blockDownloads {
+	return &blockDownloads{
+		timeout:      timeout,
+		completedTTL: completedTTL,
+		throughput:   throughput,
+		inFlight:     make(map[chainhash.Hash]*blockDownload),
+		completed:    make(map[chainhash.Hash]time.Time),
+	}
+}
+
+// addFallback keeps the peer as fallback and returns true if the download of the block is already started.
+func (d *blockDownloads) addFallback(hash chainhash.Hash, peer p2p.PeerI) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	download, found := d.inFlight[hash]
+	if !found {
+		return false
+	}
+
+	if download.peer != peer {
+		download.fallbacks = append(download.fallbacks, peer)
+	}
+
+	return true
+}
+
+// start starts the download of the block announced by the peer. The block is requested once the peer to download it
+// from is selected by request.
+func (d *blockDownloads) start(hash chainhash.Hash, peer p2p.PeerI) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.inFlight[hash] = &blockDownload{
+		peer: peer,
+	}
+}
+
+// request selects the peer to request the block from out of the peers which announced it so far and keeps the other
+// peers as fallbacks. Returns nil and abandons the download if none of the peers is connected.
+func (d *blockDownloads) request(hash chainhash.Hash, now time.Time) p2p.PeerI {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	download, found := d.inFlight[hash]
+	if !found {
+		return nil
+	}
+
+	if len(download.fallbacks) == 0 {
+		download.requestedAt = now
+		return download.peer
+	}
+
+	candidates := append([]p2p.PeerI{download.peer}, download.fallbacks...)
+	selected := d.throughput.selectPeer(candidates)
+	if selected == nil {
+		delete(d.inFlight, hash)
+		return nil
+	}
+
+	download.peer = selected
+	download.requestedAt = now
+	download.fallbacks = slices.DeleteFunc(candidates, func(peer p2p.PeerI) bool { return peer == selected })
+
+	return selected
+}
+
+// complete ends the download of the block received from the peer and returns true if the block is a duplicate of a
+// block which was already received, e.g. delivered late by a peer the download has fallen back from. The throughput of
+// the peer is measured if the block was requested from it.
+func (d *blockDownloads) complete(hash chainhash.Hash, peer string, size uint64, now time.Time) (duplicate bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for completedHash, completedAt := range d.completed {
+		if now.Sub(completedAt) > d.completedTTL {
+			delete(d.completed, completedHash)
+		}
+	}
+
+	download, inFlight := d.inFlight[hash]
+	_, completed := d.completed[hash]
+	delete(d.inFlight, hash)
+	d.completed[hash] = now
+
+	if inFlight && !download.requestedAt.IsZero() && download.peer.String() == peer {
+		d.throughput.record(peer, size, now.Sub(download.requestedAt), now)
+	}
+
+	return !inFlight && completed
+}
+
+// reassignFailed reassigns the failed downloads to their fastest connected fallback peer and returns the requests to be
+// sent to these peers. The throughput of peers which didn't deliver the block within the timeout is reduced. Failed downloads without fallback peer are abandoned and returned, so that the block is
+// requested again once it is announced again or found missing.
+func (d *blockDownloads) reassignFailed(now time.Time) (retries []blocktx_p2p.BlockRequest, abandoned []chainhash.Hash) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for hash, download := range d.inFlight {
+		if download.requestedAt.IsZero() {
+			continue
+		}
+
+		connected := download.peer.Connected()
+		if connected && now.Sub(download.requestedAt) <= d.timeout {
+			continue
+		}
+
+		if connected {
+			d.throughput.recordFailure(download.peer.String(), now)
+		}
+
+		next := d.throughput.selectFallback(download.fallbacks)
+		download.fallbacks = slices.DeleteFunc(download.fallbacks, func(peer p2p.PeerI) bool { return peer == next })
+
+		if next == nil {
+			delete(d.inFlight, hash)
+			abandoned = append(abandoned, hash)
+			continue
+		}
+
+		download.peer = next
+		download.requestedAt = now
+		retries = append(retries, blocktx_p2p.BlockRequest{Hash: &hash, Peer: next})
+	}
+
+	return retries, abandoned
+}
@@ -0,0 +1,159 @@
+package blocktx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	p2pMocks "github.com/bitcoin-sv/arc/internal/p2p/mocks"
+)
+
+func TestBlockDownloads(t *testing.T) {
+	hash, err := chainhash.NewHashFromStr("00000000000007b1f872a8abe664223d65acd22a500b1b8eb5db3fe09a9837ff")
+	require.NoError(t, err)
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	newPeer := func(name string, connected bool) *p2pMocks.PeerIMock {
+		return &p2pMocks.PeerIMock{
+			ConnectedFunc: func() bool { return connected },
+			StringFunc:    func() string { return name },
+		}
+	}
+
+	tt := []struct {
+		name      string
+		peer      *p2pMocks.PeerIMock
+		fallbacks []*p2pMocks.PeerIMock
+		elapsed   time.Duration
+
+		expectedRetryPeer string
+		expectedAbandoned bool
+	}{
+		{
+			name:      "download in progress",
+			peer:      newPeer("peer-1", true),
+			fallbacks: []*p2pMocks.PeerIMock{newPeer("peer-2", true)},
+			elapsed:   time.Minute,
+		},
+		{
+			name:      "timeout - fallback to next peer",
+			peer:      newPeer("peer-1", true),
+			fallbacks: []*p2pMocks.PeerIMock{newPeer("peer-2", true)},
+			elapsed:   3 * time.Minute,
+
+			expectedRetryPeer: "peer-2",
+		},
+		{
+			name:      "peer disconnected - disconnected fallback skipped",
+			peer:      newPeer("peer-1", false),
+			fallbacks: []*p2pMocks.PeerIMock{newPeer("peer-2", false), newPeer("peer-3", true)},
+			elapsed:   time.Minute,
+
+			expectedRetryPeer: "peer-3",
+		},
+		{
+			name:    "timeout - no fallback",
+			peer:    newPeer("peer-1", true),
+			elapsed: 3 * time.Minute,
+
+			expectedAbandoned: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := newBlockDownloads(2*time.Minute, time.Hour, newPeerThroughput(10))
+
+			require.False(t, sut.addFallback(*hash, tc.peer))
+			sut.start(*hash, tc.peer)
+			require.Equal(t, tc.peer, sut.request(*hash, now))
+			for _, fallback := range tc.fallbacks {
+				require.True(t, sut.addFallback(*hash, fallback))
+			}
+
+			// when
+			retries, abandoned := sut.reassignFailed(now.Add(tc.elapsed))
+
+			// then
+			if tc.expectedRetryPeer == "" {
+				assert.Empty(t, retries)
+			} else {
+				require.Len(t, retries, 1)
+				assert.Equal(t, hash, retries[0].Hash)
+				assert.Equal(t, tc.expectedRetryPeer, retries[0].Peer.String())
+			}
+
+			if tc.expectedAbandoned {
+				assert.Equal(t, []chainhash.Hash{*hash}, abandoned)
+				assert.False(t, sut.addFallback(*hash, tc.peer))
+			} else {
+				assert.Empty(t, abandoned)
+			}
+		})
+	}
+}
+
+func TestBlockDownloads_Complete(t *testing.T) {
+	// given
+	hash, err := chainhash.NewHashFromStr("00000000000007b1f872a8abe664223d65acd22a500b1b8eb5db3fe09a9837ff")
+	require.NoError(t, err)
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	peer := &p2pMocks.PeerIMock{StringFunc: func() string { return "peer-1" }}
+	throughput := newPeerThroughput(10)
+
+	sut := newBlockDownloads(2*time.Minute, time.Hour, throughput)
+	sut.start(*hash, peer)
+	sut.request(*hash, now)
+
+	// when
+	firstDuplicate := sut.complete(*hash, "peer-1", 4000, now.Add(2*time.Second))
+	lateDuplicate := sut.complete(*hash, "peer-1", 4000, now.Add(time.Minute))
+	expiredDuplicate := sut.complete(*hash, "peer-1", 4000, now.Add(3*time.Hour))
+
+	// then
+	assert.False(t, firstDuplicate)
+	assert.True(t, lateDuplicate)
+	assert.False(t, expiredDuplicate)
+	assert.False(t, sut.addFallback(*hash, peer))
+	assert.Equal(t, peerMeasurement{throughput: 2000, latency: 2 * time.Second, measuredAt: now.Add(2 * time.Second)}, *throughput.peers["peer-1"])
+}
+
+func TestBlockDownloads_Request(t *testing.T) {
+	// given
+	hash, err := chainhash.NewHashFromStr("00000000000007b1f872a8abe664223d65acd22a500b1b8eb5db3fe09a9837ff")
+	require.NoError(t, err)
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	peers := make([]*p2pMocks.PeerIMock, 3)
+	for i, name := range []string{"peer-1", "peer-2", "peer-3"} {
+		peers[i] = &p2pMocks.PeerIMock{
+			ConnectedFunc: func() bool { return true },
+			StringFunc:    func() string { return name },
+		}
+	}
+
+	throughput := newPeerThroughput(10)
+	throughput.record("peer-1", 1000, time.Second, now)
+	throughput.record("peer-2", 8000, time.Second, now)
+	throughput.record("peer-3", 4000, time.Second, now)
+
+	sut := newBlockDownloads(2*time.Minute, time.Hour, throughput)
+	sut.start(*hash, peers[0])
+	require.True(t, sut.addFallback(*hash, peers[1]))
+	require.True(t, sut.addFallback(*hash, peers[2]))
+
+	// when
+	selected := sut.request(*hash, now)
+	retries, abandoned := sut.reassignFailed(now.Add(3 * time.Minute))
+
+	// then
+	assert.Equal(t, peers[1], selected)
+	require.Len(t, retries, 1)
+	assert.Equal(t, "peer-3", retries[0].Peer.String())
+	assert.Empty(t, abandoned)
+	assert.Equal(t, 4000.0, throughput.peers["peer-2"].throughput)
+}
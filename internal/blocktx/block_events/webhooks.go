@@ -0,0 +1,224 @@
+// Package block_events sends the events of the blocks processed by blocktx, e.g. a new block or a chain reorg, to the
+// webhooks configured by the operator. Unlike the callbacks of the transactions, the webhooks are global and receive
+// the events of all blocks, so that ops tooling and downstream indexers can react to chain events.
+package block_events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	EventBlockProcessed        = "blockProcessed"
+	EventReorgDetected         = "reorgDetected"
+	EventBlockProcessingFailed = "blockProcessingFailed"
+
+	timeoutDefault    = 10 * time.Second
+	maxRetriesDefault = 3
+	retryDelayDefault = time.Second
+	queueSizeDefault  = 1000
+	maxErrorBody      = 4096
+)
+
+var ErrWebhookFailed = errors.New("webhook request failed")
+
+// Event is an event of a block. The hashes are hex encoded in the byte order of block explorers.
+type Event struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Hash      string    `json:"hash"`
+	Height    uint64    `json:"height"`
+	// Status is the status of the block in the chain, e.g. LONGEST or STALE, of a processed block
+	Status  string `json:"status,omitempty"`
+	TxCount uint64 `json:"txCount,omitempty"`
+	// LowestHeight is the lowest height of the blocks of the longest chain which were replaced by a reorg
+	LowestHeight uint64 `json:"lowestHeight,omitempty"`
+	// Error is the reason why the processing of the block failed
+	Error string `json:"error,omitempty"`
+}
+
+// HTTPClient sends the requests to the webhooks.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Webhook is a webhook the events are posted to as JSON. If events is empty all events are sent to the webhook.
+type Webhook struct {
+	URL    string
+	Token  string
+	Events []string
+}
+
+// Webhooks queues the events and posts them to the webhooks in the background. Failed requests are retried, events
+// which do not fit into the queue are dropped, so that the processing of the blocks is never blocked by the webhooks.
+type Webhooks struct {
+	logger     *slog.Logger
+	client     HTTPClient
+	webhooks   []Webhook
+	maxRetries int
+	retryDelay time.Duration
+	now        func() time.Time
+
+	eventCh   chan Event
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithHTTPClient(client HTTPClient) func(*Webhooks) {
+	return func(w *Webhooks) {
+		w.client = client
+	}
+}
+
+// WithTimeout sets the timeout of a request to a webhook.
+func WithTimeout(d time.Duration) func(*Webhooks) {
+	return func(w *Webhooks) {
+		w.client = &http.Client{Timeout: d}
+	}
+}
+
+// WithRetries sets the number of times a failed request is retried and the delay before each retry.
+func WithRetries(maxRetries int, delay time.Duration) func(*Webhooks) {
+	return func(w *Webhooks) {
+		w.maxRetries = maxRetries
+		w.retryDelay = delay
+	}
+}
+
+// WithQueueSize sets the number of events which are queued for sending.
+func WithQueueSize(size int) func(*Webhooks) {
+	return func(w *Webhooks) {
+		w.eventCh = make(chan Event, size)
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Webhooks) {
+	return func(w *Webhooks) {
+		w.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, webhooks []Webhook, opts ...func(*Webhooks)) *Webhooks {
+	w := &Webhooks{
+		logger:     logger.With(slog.String("module", "block-events")),
+		client:     &http.Client{Timeout: timeoutDefault},
+		webhooks:   webhooks,
+		maxRetries: maxRetriesDefault,
+		retryDelay: retryDelayDefault,
+		now:        time.Now,
+		eventCh:    make(chan Event, queueSizeDefault),
+		waitGroup:  &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	w.cancelAll = cancelAll
+	w.ctx = ctx
+
+	return w
+}
+
+// Start sends the queued events until Shutdown is called.
+func (w *Webhooks) Start() {
+	w.waitGroup.Add(1)
+	go func() {
+		defer w.waitGroup.Done()
+
+		for {
+			select {
+			case <-w.ctx.Done():
+				return
+			case event := <-w.eventCh:
+				w.send(event)
+			}
+		}
+	}()
+}
+
+// Notify queues the event for sending. The event is dropped if the queue is full.
+func (w *Webhooks) Notify(event Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = w.now().UTC()
+	}
+
+	select {
+	case w.eventCh <- event:
+	default:
+		w.logger.Warn("Dropping block event, queue is full", slog.String("type", event.Type), slog.String("hash", event.Hash))
+	}
+}
+
+func (w *Webhooks) send(event Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		w.logger.Error("Failed to marshal block event", slog.String("type", event.Type), slog.String("err", err.Error()))
+		return
+	}
+
+	for _, webhook := range w.webhooks {
+		if len(webhook.Events) > 0 && !slices.Contains(webhook.Events, event.Type) {
+			continue
+		}
+
+		for attempt := 0; ; attempt++ {
+			err = w.post(webhook, body)
+			if err == nil {
+				break
+			}
+
+			if attempt >= w.maxRetries {
+				w.logger.Error("Failed to send block event", slog.String("type", event.Type), slog.String("hash", event.Hash), slog.String("url", webhook.URL), slog.Int("attempts", attempt+1), slog.String("err", err.Error()))
+				break
+			}
+
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-time.After(w.retryDelay):
+			}
+		}
+	}
+}
+
+func (w *Webhooks) post(webhook Webhook, body []byte) error {
+	req, err := http.NewRequestWithContext(w.ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if webhook.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+webhook.Token)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Join(ErrWebhookFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return errors.Join(ErrWebhookFailed, fmt.Errorf("status: %d, body: %s", resp.StatusCode, strings.TrimSpace(string(respBody))))
+	}
+
+	return nil
+}
+
+func (w *Webhooks) Shutdown() {
+	w.cancelAll()
+	w.waitGroup.Wait()
+}
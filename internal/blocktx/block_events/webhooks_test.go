@@ -0,0 +1,136 @@
+package block_events_test
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/block_events"
+)
+
+type receivedEvents struct {
+	mu       sync.Mutex
+	events   []block_events.Event
+	tokens   []string
+	requests int
+}
+
+func (r *receivedEvents) handler(failures int) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		r.requests++
+		if r.requests <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var event block_events.Event
+		if err := json.NewDecoder(req.Body).Decode(&event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		r.events = append(r.events, event)
+		r.tokens = append(r.tokens, req.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (r *receivedEvents) get() ([]block_events.Event, []string, int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]block_events.Event{}, r.events...), append([]string{}, r.tokens...), r.requests
+}
+
+func TestWebhooks(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name     string
+		events   []string
+		token    string
+		failures int
+
+		expectedTypes    []string
+		expectedToken    string
+		expectedRequests int
+	}{
+		{
+			name: "all events",
+
+			expectedTypes:    []string{block_events.EventBlockProcessed, block_events.EventReorgDetected, block_events.EventBlockProcessingFailed},
+			expectedRequests: 3,
+		},
+		{
+			name:   "filtered events with token",
+			events: []string{block_events.EventReorgDetected},
+			token:  "secret",
+
+			expectedTypes:    []string{block_events.EventReorgDetected},
+			expectedToken:    "Bearer secret",
+			expectedRequests: 1,
+		},
+		{
+			name:     "retried",
+			events:   []string{block_events.EventBlockProcessed},
+			failures: 2,
+
+			expectedTypes:    []string{block_events.EventBlockProcessed},
+			expectedRequests: 3,
+		},
+		{
+			name:     "retries exhausted",
+			events:   []string{block_events.EventBlockProcessed},
+			failures: 10,
+
+			expectedRequests: 4,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			received := &receivedEvents{}
+			server := httptest.NewServer(received.handler(tc.failures))
+			defer server.Close()
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+			sut := block_events.New(logger, []block_events.Webhook{{URL: server.URL, Token: tc.token, Events: tc.events}},
+				block_events.WithRetries(3, time.Millisecond),
+				block_events.WithNow(func() time.Time { return now }),
+			)
+			sut.Start()
+			defer sut.Shutdown()
+
+			// when
+			sut.Notify(block_events.Event{Type: block_events.EventBlockProcessed, Hash: "hash-1", Height: 100, Status: "LONGEST", TxCount: 5})
+			sut.Notify(block_events.Event{Type: block_events.EventReorgDetected, Hash: "hash-2", Height: 101, LowestHeight: 99})
+			sut.Notify(block_events.Event{Type: block_events.EventBlockProcessingFailed, Hash: "hash-3", Height: 102, Error: "failed to process block"})
+
+			// then
+			require.Eventually(t, func() bool {
+				_, _, requests := received.get()
+				return requests == tc.expectedRequests
+			}, time.Second, 5*time.Millisecond)
+
+			events, tokens, _ := received.get()
+			require.Len(t, events, len(tc.expectedTypes))
+			for i, event := range events {
+				assert.Equal(t, tc.expectedTypes[i], event.Type)
+				assert.Equal(t, now, event.Timestamp)
+				assert.Equal(t, tc.expectedToken, tokens[i])
+			}
+		})
+	}
+}
@@ -217,6 +217,198 @@ func (x *LatestBlocksResponse) GetBlocks() []*Block {
 	return nil
 }
 
+type BlockHashes struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hashes        [][]byte               `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"` // Little endian
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockHashes) Reset() {
+	*x = BlockHashes{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHashes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHashes) ProtoMessage() {}
+
+func (x *BlockHashes) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHashes.ProtoReflect.Descriptor instead.
+func (*BlockHashes) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockHashes) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type BlockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"` // Little endian
+	Height        uint64                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockRequest) Reset() {
+	*x = BlockRequest{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockRequest) ProtoMessage() {}
+
+func (x *BlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BlockRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BlockRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ListBlocksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromHeight    uint64                 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlocksRequest) Reset() {
+	*x = ListBlocksRequest{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlocksRequest) ProtoMessage() {}
+
+func (x *ListBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlocksRequest.ProtoReflect.Descriptor instead.
+func (*ListBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListBlocksRequest) GetFromHeight() uint64 {
+	if x != nil {
+		return x.FromHeight
+	}
+	return 0
+}
+
+func (x *ListBlocksRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type Blocks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocks        []*Block               `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Blocks) Reset() {
+	*x = Blocks{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Blocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Blocks) ProtoMessage() {}
+
+func (x *Blocks) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Blocks.ProtoReflect.Descriptor instead.
+func (*Blocks) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Blocks) GetBlocks() []*Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
 type AnyTransactionsMinedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transactions  []*IsMined             `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
@@ -226,7 +418,7 @@ type AnyTransactionsMinedResponse struct {
 
 func (x *AnyTransactionsMinedResponse) Reset() {
 	*x = AnyTransactionsMinedResponse{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[3]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -238,7 +430,7 @@ func (x *AnyTransactionsMinedResponse) String() string {
 func (*AnyTransactionsMinedResponse) ProtoMessage() {}
 
 func (x *AnyTransactionsMinedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[3]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -251,7 +443,7 @@ func (x *AnyTransactionsMinedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnyTransactionsMinedResponse.ProtoReflect.Descriptor instead.
 func (*AnyTransactionsMinedResponse) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{3}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AnyTransactionsMinedResponse) GetTransactions() []*IsMined {
@@ -274,7 +466,7 @@ type HealthResponse struct {
 
 func (x *HealthResponse) Reset() {
 	*x = HealthResponse{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[4]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -286,7 +478,7 @@ func (x *HealthResponse) String() string {
 func (*HealthResponse) ProtoMessage() {}
 
 func (x *HealthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[4]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -299,7 +491,7 @@ func (x *HealthResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthResponse.ProtoReflect.Descriptor instead.
 func (*HealthResponse) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{4}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *HealthResponse) GetOk() bool {
@@ -332,22 +524,25 @@ func (x *HealthResponse) GetNats() string {
 
 // swagger:model Block {
 type Block struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`                                     // Little endian
-	PreviousHash  []byte                 `protobuf:"bytes,2,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"` // Little endian
-	MerkleRoot    []byte                 `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`       // Little endian
-	Height        uint64                 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
-	Processed     bool                   `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
-	Status        Status                 `protobuf:"varint,6,opt,name=status,proto3,enum=blocktx_api.Status" json:"status,omitempty"`
-	Chainwork     string                 `protobuf:"bytes,7,opt,name=chainwork,proto3" json:"chainwork,omitempty"`
-	ProcessedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Hash           []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`                                     // Little endian
+	PreviousHash   []byte                 `protobuf:"bytes,2,opt,name=previous_hash,json=previousHash,proto3" json:"previous_hash,omitempty"` // Little endian
+	MerkleRoot     []byte                 `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`       // Little endian
+	Height         uint64                 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Processed      bool                   `protobuf:"varint,5,opt,name=processed,proto3" json:"processed,omitempty"`
+	Status         Status                 `protobuf:"varint,6,opt,name=status,proto3,enum=blocktx_api.Status" json:"status,omitempty"`
+	Chainwork      string                 `protobuf:"bytes,7,opt,name=chainwork,proto3" json:"chainwork,omitempty"`
+	ProcessedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	CoinbaseReward uint64                 `protobuf:"varint,9,opt,name=coinbase_reward,json=coinbaseReward,proto3" json:"coinbase_reward,omitempty"` // Sum of the outputs of the coinbase transaction in satoshis
+	MinerTag       string                 `protobuf:"bytes,10,opt,name=miner_tag,json=minerTag,proto3" json:"miner_tag,omitempty"`                   // Tag of the miner in the coinbase script
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                 // Timestamp of the block header
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Block) Reset() {
 	*x = Block{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[5]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +554,7 @@ func (x *Block) String() string {
 func (*Block) ProtoMessage() {}
 
 func (x *Block) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[5]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +567,7 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Block.ProtoReflect.Descriptor instead.
 func (*Block) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{5}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Block) GetHash() []byte {
@@ -431,6 +626,27 @@ func (x *Block) GetProcessedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Block) GetCoinbaseReward() uint64 {
+	if x != nil {
+		return x.CoinbaseReward
+	}
+	return 0
+}
+
+func (x *Block) GetMinerTag() string {
+	if x != nil {
+		return x.MinerTag
+	}
+	return ""
+}
+
+func (x *Block) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 // swagger:model Transactions
 type Transactions struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -441,7 +657,7 @@ type Transactions struct {
 
 func (x *Transactions) Reset() {
 	*x = Transactions{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[6]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -453,7 +669,7 @@ func (x *Transactions) String() string {
 func (*Transactions) ProtoMessage() {}
 
 func (x *Transactions) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[6]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -466,7 +682,7 @@ func (x *Transactions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
 func (*Transactions) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{6}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *Transactions) GetTransactions() []*Transaction {
@@ -483,13 +699,14 @@ type TransactionBlock struct {
 	TransactionHash []byte                 `protobuf:"bytes,3,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"` // Little endian
 	MerklePath      string                 `protobuf:"bytes,4,opt,name=merklePath,proto3" json:"merklePath,omitempty"`
 	BlockStatus     Status                 `protobuf:"varint,5,opt,name=block_status,json=blockStatus,proto3,enum=blocktx_api.Status" json:"block_status,omitempty"`
+	NormalizedHash  []byte                 `protobuf:"bytes,6,opt,name=normalized_hash,json=normalizedHash,proto3" json:"normalized_hash,omitempty"` // Little endian, only set for malleated variants of registered transactions
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *TransactionBlock) Reset() {
 	*x = TransactionBlock{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[7]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +718,7 @@ func (x *TransactionBlock) String() string {
 func (*TransactionBlock) ProtoMessage() {}
 
 func (x *TransactionBlock) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[7]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +731,7 @@ func (x *TransactionBlock) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionBlock.ProtoReflect.Descriptor instead.
 func (*TransactionBlock) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{7}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TransactionBlock) GetBlockHash() []byte {
@@ -552,6 +769,13 @@ func (x *TransactionBlock) GetBlockStatus() Status {
 	return Status_UNKNOWN
 }
 
+func (x *TransactionBlock) GetNormalizedHash() []byte {
+	if x != nil {
+		return x.NormalizedHash
+	}
+	return nil
+}
+
 type TransactionBlocks struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	TransactionBlocks []*TransactionBlock    `protobuf:"bytes,1,rep,name=transaction_blocks,json=transactionBlocks,proto3" json:"transaction_blocks,omitempty"`
@@ -561,7 +785,7 @@ type TransactionBlocks struct {
 
 func (x *TransactionBlocks) Reset() {
 	*x = TransactionBlocks{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[8]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +797,7 @@ func (x *TransactionBlocks) String() string {
 func (*TransactionBlocks) ProtoMessage() {}
 
 func (x *TransactionBlocks) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[8]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +810,7 @@ func (x *TransactionBlocks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionBlocks.ProtoReflect.Descriptor instead.
 func (*TransactionBlocks) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{8}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TransactionBlocks) GetTransactionBlocks() []*TransactionBlock {
@@ -606,7 +830,7 @@ type Transaction struct {
 
 func (x *Transaction) Reset() {
 	*x = Transaction{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[9]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +842,7 @@ func (x *Transaction) String() string {
 func (*Transaction) ProtoMessage() {}
 
 func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[9]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +855,7 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{9}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Transaction) GetHash() []byte {
@@ -651,7 +875,7 @@ type ClearData struct {
 
 func (x *ClearData) Reset() {
 	*x = ClearData{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[10]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +887,7 @@ func (x *ClearData) String() string {
 func (*ClearData) ProtoMessage() {}
 
 func (x *ClearData) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[10]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -676,7 +900,7 @@ func (x *ClearData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClearData.ProtoReflect.Descriptor instead.
 func (*ClearData) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{10}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ClearData) GetRetentionDays() int32 {
@@ -696,7 +920,7 @@ type RowsAffectedResponse struct {
 
 func (x *RowsAffectedResponse) Reset() {
 	*x = RowsAffectedResponse{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[11]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -708,7 +932,7 @@ func (x *RowsAffectedResponse) String() string {
 func (*RowsAffectedResponse) ProtoMessage() {}
 
 func (x *RowsAffectedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[11]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +945,7 @@ func (x *RowsAffectedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RowsAffectedResponse.ProtoReflect.Descriptor instead.
 func (*RowsAffectedResponse) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{11}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RowsAffectedResponse) GetRows() int64 {
@@ -741,7 +965,7 @@ type CurrentBlockHeightResponse struct {
 
 func (x *CurrentBlockHeightResponse) Reset() {
 	*x = CurrentBlockHeightResponse{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[12]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -753,7 +977,7 @@ func (x *CurrentBlockHeightResponse) String() string {
 func (*CurrentBlockHeightResponse) ProtoMessage() {}
 
 func (x *CurrentBlockHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[12]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -766,7 +990,7 @@ func (x *CurrentBlockHeightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CurrentBlockHeightResponse.ProtoReflect.Descriptor instead.
 func (*CurrentBlockHeightResponse) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{12}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CurrentBlockHeightResponse) GetCurrentBlockHeight() uint64 {
@@ -785,7 +1009,7 @@ type DelUnfinishedBlockProcessingRequest struct {
 
 func (x *DelUnfinishedBlockProcessingRequest) Reset() {
 	*x = DelUnfinishedBlockProcessingRequest{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[13]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -797,7 +1021,7 @@ func (x *DelUnfinishedBlockProcessingRequest) String() string {
 func (*DelUnfinishedBlockProcessingRequest) ProtoMessage() {}
 
 func (x *DelUnfinishedBlockProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[13]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,7 +1034,7 @@ func (x *DelUnfinishedBlockProcessingRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DelUnfinishedBlockProcessingRequest.ProtoReflect.Descriptor instead.
 func (*DelUnfinishedBlockProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{13}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DelUnfinishedBlockProcessingRequest) GetProcessedBy() string {
@@ -830,7 +1054,7 @@ type MerkleRootVerificationRequest struct {
 
 func (x *MerkleRootVerificationRequest) Reset() {
 	*x = MerkleRootVerificationRequest{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[14]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -842,7 +1066,7 @@ func (x *MerkleRootVerificationRequest) String() string {
 func (*MerkleRootVerificationRequest) ProtoMessage() {}
 
 func (x *MerkleRootVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[14]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1079,7 @@ func (x *MerkleRootVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerkleRootVerificationRequest.ProtoReflect.Descriptor instead.
 func (*MerkleRootVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{14}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MerkleRootVerificationRequest) GetMerkleRoot() string {
@@ -881,7 +1105,7 @@ type MerkleRootsVerificationRequest struct {
 
 func (x *MerkleRootsVerificationRequest) Reset() {
 	*x = MerkleRootsVerificationRequest{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[15]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -893,7 +1117,7 @@ func (x *MerkleRootsVerificationRequest) String() string {
 func (*MerkleRootsVerificationRequest) ProtoMessage() {}
 
 func (x *MerkleRootsVerificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[15]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +1130,7 @@ func (x *MerkleRootsVerificationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerkleRootsVerificationRequest.ProtoReflect.Descriptor instead.
 func (*MerkleRootsVerificationRequest) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{15}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *MerkleRootsVerificationRequest) GetMerkleRoots() []*MerkleRootVerificationRequest {
@@ -925,7 +1149,7 @@ type MerkleRootVerificationResponse struct {
 
 func (x *MerkleRootVerificationResponse) Reset() {
 	*x = MerkleRootVerificationResponse{}
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[16]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1161,7 @@ func (x *MerkleRootVerificationResponse) String() string {
 func (*MerkleRootVerificationResponse) ProtoMessage() {}
 
 func (x *MerkleRootVerificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[16]
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1174,7 @@ func (x *MerkleRootVerificationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MerkleRootVerificationResponse.ProtoReflect.Descriptor instead.
 func (*MerkleRootVerificationResponse) Descriptor() ([]byte, []int) {
-	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{16}
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MerkleRootVerificationResponse) GetUnverifiedBlockHeights() []uint64 {
@@ -960,6 +1184,243 @@ func (x *MerkleRootVerificationResponse) GetUnverifiedBlockHeights() []uint64 {
 	return nil
 }
 
+type BanPeerRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// duration of the ban, e.g. 2h, the configured ban duration if empty
+	Duration      string `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanPeerRequest) Reset() {
+	*x = BanPeerRequest{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPeerRequest) ProtoMessage() {}
+
+func (x *BanPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPeerRequest.ProtoReflect.Descriptor instead.
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BanPeerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BanPeerRequest) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+type PeerBan struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Address   string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Connected bool                   `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	BanScore  int64                  `protobuf:"varint,3,opt,name=ban_score,json=banScore,proto3" json:"ban_score,omitempty"`
+	Banned    bool                   `protobuf:"varint,4,opt,name=banned,proto3" json:"banned,omitempty"`
+	// only set if the peer is banned
+	BannedUntil   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerBan) Reset() {
+	*x = PeerBan{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerBan) ProtoMessage() {}
+
+func (x *PeerBan) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerBan.ProtoReflect.Descriptor instead.
+func (*PeerBan) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PeerBan) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerBan) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *PeerBan) GetBanScore() int64 {
+	if x != nil {
+		return x.BanScore
+	}
+	return 0
+}
+
+func (x *PeerBan) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *PeerBan) GetBannedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return nil
+}
+
+type PeerBans struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the registered peers with their ban scores
+	Peers []*PeerBan `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// the banned addresses, including the addresses of peers which are not registered
+	Bans          []*PeerBan `protobuf:"bytes,2,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerBans) Reset() {
+	*x = PeerBans{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerBans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerBans) ProtoMessage() {}
+
+func (x *PeerBans) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerBans.ProtoReflect.Descriptor instead.
+func (*PeerBans) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PeerBans) GetPeers() []*PeerBan {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *PeerBans) GetBans() []*PeerBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+// LogLevelRequest is the log level, e.g. DEBUG, of a component of the process, e.g. mtm
+type LogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogLevelRequest) Reset() {
+	*x = LogLevelRequest{}
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevelRequest) ProtoMessage() {}
+
+func (x *LogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevelRequest.ProtoReflect.Descriptor instead.
+func (*LogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LogLevelRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *LogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
 var File_internal_blocktx_blocktx_api_blocktx_api_proto protoreflect.FileDescriptor
 
 const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
@@ -971,6 +1432,17 @@ const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
 	"\x11NumOfLatestBlocks\x12\x16\n" +
 	"\x06blocks\x18\x01 \x01(\x04R\x06blocks\"B\n" +
 	"\x14LatestBlocksResponse\x12*\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x12.blocktx_api.BlockR\x06blocks\"%\n" +
+	"\vBlockHashes\x12\x16\n" +
+	"\x06hashes\x18\x01 \x03(\fR\x06hashes\":\n" +
+	"\fBlockRequest\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x16\n" +
+	"\x06height\x18\x02 \x01(\x04R\x06height\"J\n" +
+	"\x11ListBlocksRequest\x12\x1f\n" +
+	"\vfrom_height\x18\x01 \x01(\x04R\n" +
+	"fromHeight\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x04R\x05limit\"4\n" +
+	"\x06Blocks\x12*\n" +
 	"\x06blocks\x18\x01 \x03(\v2\x12.blocktx_api.BlockR\x06blocks\"X\n" +
 	"\x1cAnyTransactionsMinedResponse\x128\n" +
 	"\ftransactions\x18\x01 \x03(\v2\x14.blocktx_api.IsMinedR\ftransactions\"\x88\x01\n" +
@@ -978,7 +1450,7 @@ const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
 	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x18\n" +
 	"\adetails\x18\x02 \x01(\tR\adetails\x128\n" +
 	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
-	"\x04nats\x18\x04 \x01(\tR\x04nats\"\xa1\x02\n" +
+	"\x04nats\x18\x04 \x01(\tR\x04nats\"\xa1\x03\n" +
 	"\x05Block\x12\x12\n" +
 	"\x04hash\x18\x01 \x01(\fR\x04hash\x12#\n" +
 	"\rprevious_hash\x18\x02 \x01(\fR\fpreviousHash\x12\x1f\n" +
@@ -988,9 +1460,13 @@ const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
 	"\tprocessed\x18\x05 \x01(\bR\tprocessed\x12+\n" +
 	"\x06status\x18\x06 \x01(\x0e2\x13.blocktx_api.StatusR\x06status\x12\x1c\n" +
 	"\tchainwork\x18\a \x01(\tR\tchainwork\x12=\n" +
-	"\fprocessed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"L\n" +
+	"\fprocessed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x12'\n" +
+	"\x0fcoinbase_reward\x18\t \x01(\x04R\x0ecoinbaseReward\x12\x1b\n" +
+	"\tminer_tag\x18\n" +
+	" \x01(\tR\bminerTag\x128\n" +
+	"\ttimestamp\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"L\n" +
 	"\fTransactions\x12<\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x18.blocktx_api.TransactionR\ftransactions\"\xd7\x01\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x18.blocktx_api.TransactionR\ftransactions\"\x80\x02\n" +
 	"\x10TransactionBlock\x12\x1d\n" +
 	"\n" +
 	"block_hash\x18\x01 \x01(\fR\tblockHash\x12!\n" +
@@ -999,7 +1475,8 @@ const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
 	"\n" +
 	"merklePath\x18\x04 \x01(\tR\n" +
 	"merklePath\x126\n" +
-	"\fblock_status\x18\x05 \x01(\x0e2\x13.blocktx_api.StatusR\vblockStatus\"a\n" +
+	"\fblock_status\x18\x05 \x01(\x0e2\x13.blocktx_api.StatusR\vblockStatus\x12'\n" +
+	"\x0fnormalized_hash\x18\x06 \x01(\fR\x0enormalizedHash\"a\n" +
 	"\x11TransactionBlocks\x12L\n" +
 	"\x12transaction_blocks\x18\x01 \x03(\v2\x1d.blocktx_api.TransactionBlockR\x11transactionBlocks\"!\n" +
 	"\vTransaction\x12\x12\n" +
@@ -1019,13 +1496,28 @@ const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
 	"\x1eMerkleRootsVerificationRequest\x12M\n" +
 	"\fmerkle_roots\x18\x01 \x03(\v2*.blocktx_api.MerkleRootVerificationRequestR\vmerkleRoots\"Z\n" +
 	"\x1eMerkleRootVerificationResponse\x128\n" +
-	"\x18unverified_block_heights\x18\x01 \x03(\x04R\x16unverifiedBlockHeights*;\n" +
+	"\x18unverified_block_heights\x18\x01 \x03(\x04R\x16unverifiedBlockHeights\"F\n" +
+	"\x0eBanPeerRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\tR\bduration\"\xb5\x01\n" +
+	"\aPeerBan\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\bR\tconnected\x12\x1b\n" +
+	"\tban_score\x18\x03 \x01(\x03R\bbanScore\x12\x16\n" +
+	"\x06banned\x18\x04 \x01(\bR\x06banned\x12=\n" +
+	"\fbanned_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vbannedUntil\"`\n" +
+	"\bPeerBans\x12*\n" +
+	"\x05peers\x18\x01 \x03(\v2\x14.blocktx_api.PeerBanR\x05peers\x12(\n" +
+	"\x04bans\x18\x02 \x03(\v2\x14.blocktx_api.PeerBanR\x04bans\"E\n" +
+	"\x0fLogLevelRequest\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level*;\n" +
 	"\x06Status\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\v\n" +
 	"\aLONGEST\x10\n" +
 	"\x12\t\n" +
 	"\x05STALE\x10\x14\x12\f\n" +
-	"\bORPHANED\x10\x1e2\x8c\x06\n" +
+	"\bORPHANED\x10\x1e2\x8a\v\n" +
 	"\n" +
 	"BlockTxAPI\x12?\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x1b.blocktx_api.HealthResponse\"\x00\x12J\n" +
@@ -1036,7 +1528,17 @@ const file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc = "" +
 	"\x14RegisterTransactions\x12\x19.blocktx_api.Transactions\x1a\x16.google.protobuf.Empty\"\x00\x12W\n" +
 	"\x12CurrentBlockHeight\x12\x16.google.protobuf.Empty\x1a'.blocktx_api.CurrentBlockHeightResponse\"\x00\x12S\n" +
 	"\fLatestBlocks\x12\x1e.blocktx_api.NumOfLatestBlocks\x1a!.blocktx_api.LatestBlocksResponse\"\x00\x12^\n" +
-	"\x14AnyTransactionsMined\x12\x19.blocktx_api.Transactions\x1a).blocktx_api.AnyTransactionsMinedResponse\"\x00B\x0fZ\r.;blocktx_apib\x06proto3"
+	"\x14AnyTransactionsMined\x12\x19.blocktx_api.Transactions\x1a).blocktx_api.AnyTransactionsMinedResponse\"\x00\x12T\n" +
+	"\x16RegisteredTransactions\x12\x18.blocktx_api.BlockHashes\x1a\x1e.blocktx_api.TransactionBlocks\"\x00\x12;\n" +
+	"\bGetBlock\x12\x19.blocktx_api.BlockRequest\x1a\x12.blocktx_api.Block\"\x00\x12C\n" +
+	"\n" +
+	"ListBlocks\x12\x1e.blocktx_api.ListBlocksRequest\x1a\x13.blocktx_api.Blocks\"\x00\x12R\n" +
+	"\x14GetTransactionBlocks\x12\x18.blocktx_api.Transaction\x1a\x1e.blocktx_api.TransactionBlocks\"\x00\x12<\n" +
+	"\tListPeers\x12\x16.google.protobuf.Empty\x1a\x15.blocktx_api.PeerBans\"\x00\x12?\n" +
+	"\aBanPeer\x12\x1b.blocktx_api.BanPeerRequest\x1a\x15.blocktx_api.PeerBans\"\x00\x12A\n" +
+	"\tUnbanPeer\x12\x1b.blocktx_api.BanPeerRequest\x1a\x15.blocktx_api.PeerBans\"\x00\x12E\n" +
+	"\vSetLogLevel\x12\x1c.blocktx_api.LogLevelRequest\x1a\x16.google.protobuf.Empty\"\x00\x12G\n" +
+	"\rResetLogLevel\x12\x1c.blocktx_api.LogLevelRequest\x1a\x16.google.protobuf.Empty\"\x00B\x0fZ\r.;blocktx_apib\x06proto3"
 
 var (
 	file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescOnce sync.Once
@@ -1051,62 +1553,93 @@ func file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_blocktx_blocktx_api_blocktx_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_internal_blocktx_blocktx_api_blocktx_api_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_internal_blocktx_blocktx_api_blocktx_api_proto_goTypes = []any{
 	(Status)(0),                                 // 0: blocktx_api.Status
 	(*IsMined)(nil),                             // 1: blocktx_api.IsMined
 	(*NumOfLatestBlocks)(nil),                   // 2: blocktx_api.NumOfLatestBlocks
 	(*LatestBlocksResponse)(nil),                // 3: blocktx_api.LatestBlocksResponse
-	(*AnyTransactionsMinedResponse)(nil),        // 4: blocktx_api.AnyTransactionsMinedResponse
-	(*HealthResponse)(nil),                      // 5: blocktx_api.HealthResponse
-	(*Block)(nil),                               // 6: blocktx_api.Block
-	(*Transactions)(nil),                        // 7: blocktx_api.Transactions
-	(*TransactionBlock)(nil),                    // 8: blocktx_api.TransactionBlock
-	(*TransactionBlocks)(nil),                   // 9: blocktx_api.TransactionBlocks
-	(*Transaction)(nil),                         // 10: blocktx_api.Transaction
-	(*ClearData)(nil),                           // 11: blocktx_api.ClearData
-	(*RowsAffectedResponse)(nil),                // 12: blocktx_api.RowsAffectedResponse
-	(*CurrentBlockHeightResponse)(nil),          // 13: blocktx_api.CurrentBlockHeightResponse
-	(*DelUnfinishedBlockProcessingRequest)(nil), // 14: blocktx_api.DelUnfinishedBlockProcessingRequest
-	(*MerkleRootVerificationRequest)(nil),       // 15: blocktx_api.MerkleRootVerificationRequest
-	(*MerkleRootsVerificationRequest)(nil),      // 16: blocktx_api.MerkleRootsVerificationRequest
-	(*MerkleRootVerificationResponse)(nil),      // 17: blocktx_api.MerkleRootVerificationResponse
-	(*timestamppb.Timestamp)(nil),               // 18: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                       // 19: google.protobuf.Empty
+	(*BlockHashes)(nil),                         // 4: blocktx_api.BlockHashes
+	(*BlockRequest)(nil),                        // 5: blocktx_api.BlockRequest
+	(*ListBlocksRequest)(nil),                   // 6: blocktx_api.ListBlocksRequest
+	(*Blocks)(nil),                              // 7: blocktx_api.Blocks
+	(*AnyTransactionsMinedResponse)(nil),        // 8: blocktx_api.AnyTransactionsMinedResponse
+	(*HealthResponse)(nil),                      // 9: blocktx_api.HealthResponse
+	(*Block)(nil),                               // 10: blocktx_api.Block
+	(*Transactions)(nil),                        // 11: blocktx_api.Transactions
+	(*TransactionBlock)(nil),                    // 12: blocktx_api.TransactionBlock
+	(*TransactionBlocks)(nil),                   // 13: blocktx_api.TransactionBlocks
+	(*Transaction)(nil),                         // 14: blocktx_api.Transaction
+	(*ClearData)(nil),                           // 15: blocktx_api.ClearData
+	(*RowsAffectedResponse)(nil),                // 16: blocktx_api.RowsAffectedResponse
+	(*CurrentBlockHeightResponse)(nil),          // 17: blocktx_api.CurrentBlockHeightResponse
+	(*DelUnfinishedBlockProcessingRequest)(nil), // 18: blocktx_api.DelUnfinishedBlockProcessingRequest
+	(*MerkleRootVerificationRequest)(nil),       // 19: blocktx_api.MerkleRootVerificationRequest
+	(*MerkleRootsVerificationRequest)(nil),      // 20: blocktx_api.MerkleRootsVerificationRequest
+	(*MerkleRootVerificationResponse)(nil),      // 21: blocktx_api.MerkleRootVerificationResponse
+	(*BanPeerRequest)(nil),                      // 22: blocktx_api.BanPeerRequest
+	(*PeerBan)(nil),                             // 23: blocktx_api.PeerBan
+	(*PeerBans)(nil),                            // 24: blocktx_api.PeerBans
+	(*LogLevelRequest)(nil),                     // 25: blocktx_api.LogLevelRequest
+	(*timestamppb.Timestamp)(nil),               // 26: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                       // 27: google.protobuf.Empty
 }
 var file_internal_blocktx_blocktx_api_blocktx_api_proto_depIdxs = []int32{
-	6,  // 0: blocktx_api.LatestBlocksResponse.blocks:type_name -> blocktx_api.Block
-	1,  // 1: blocktx_api.AnyTransactionsMinedResponse.transactions:type_name -> blocktx_api.IsMined
-	18, // 2: blocktx_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 3: blocktx_api.Block.status:type_name -> blocktx_api.Status
-	18, // 4: blocktx_api.Block.processed_at:type_name -> google.protobuf.Timestamp
-	10, // 5: blocktx_api.Transactions.transactions:type_name -> blocktx_api.Transaction
-	0,  // 6: blocktx_api.TransactionBlock.block_status:type_name -> blocktx_api.Status
-	8,  // 7: blocktx_api.TransactionBlocks.transaction_blocks:type_name -> blocktx_api.TransactionBlock
-	15, // 8: blocktx_api.MerkleRootsVerificationRequest.merkle_roots:type_name -> blocktx_api.MerkleRootVerificationRequest
-	19, // 9: blocktx_api.BlockTxAPI.Health:input_type -> google.protobuf.Empty
-	11, // 10: blocktx_api.BlockTxAPI.ClearBlocks:input_type -> blocktx_api.ClearData
-	11, // 11: blocktx_api.BlockTxAPI.ClearRegisteredTransactions:input_type -> blocktx_api.ClearData
-	16, // 12: blocktx_api.BlockTxAPI.VerifyMerkleRoots:input_type -> blocktx_api.MerkleRootsVerificationRequest
-	10, // 13: blocktx_api.BlockTxAPI.RegisterTransaction:input_type -> blocktx_api.Transaction
-	7,  // 14: blocktx_api.BlockTxAPI.RegisterTransactions:input_type -> blocktx_api.Transactions
-	19, // 15: blocktx_api.BlockTxAPI.CurrentBlockHeight:input_type -> google.protobuf.Empty
-	2,  // 16: blocktx_api.BlockTxAPI.LatestBlocks:input_type -> blocktx_api.NumOfLatestBlocks
-	7,  // 17: blocktx_api.BlockTxAPI.AnyTransactionsMined:input_type -> blocktx_api.Transactions
-	5,  // 18: blocktx_api.BlockTxAPI.Health:output_type -> blocktx_api.HealthResponse
-	12, // 19: blocktx_api.BlockTxAPI.ClearBlocks:output_type -> blocktx_api.RowsAffectedResponse
-	12, // 20: blocktx_api.BlockTxAPI.ClearRegisteredTransactions:output_type -> blocktx_api.RowsAffectedResponse
-	17, // 21: blocktx_api.BlockTxAPI.VerifyMerkleRoots:output_type -> blocktx_api.MerkleRootVerificationResponse
-	19, // 22: blocktx_api.BlockTxAPI.RegisterTransaction:output_type -> google.protobuf.Empty
-	19, // 23: blocktx_api.BlockTxAPI.RegisterTransactions:output_type -> google.protobuf.Empty
-	13, // 24: blocktx_api.BlockTxAPI.CurrentBlockHeight:output_type -> blocktx_api.CurrentBlockHeightResponse
-	3,  // 25: blocktx_api.BlockTxAPI.LatestBlocks:output_type -> blocktx_api.LatestBlocksResponse
-	4,  // 26: blocktx_api.BlockTxAPI.AnyTransactionsMined:output_type -> blocktx_api.AnyTransactionsMinedResponse
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	10, // 0: blocktx_api.LatestBlocksResponse.blocks:type_name -> blocktx_api.Block
+	10, // 1: blocktx_api.Blocks.blocks:type_name -> blocktx_api.Block
+	1,  // 2: blocktx_api.AnyTransactionsMinedResponse.transactions:type_name -> blocktx_api.IsMined
+	26, // 3: blocktx_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 4: blocktx_api.Block.status:type_name -> blocktx_api.Status
+	26, // 5: blocktx_api.Block.processed_at:type_name -> google.protobuf.Timestamp
+	26, // 6: blocktx_api.Block.timestamp:type_name -> google.protobuf.Timestamp
+	14, // 7: blocktx_api.Transactions.transactions:type_name -> blocktx_api.Transaction
+	0,  // 8: blocktx_api.TransactionBlock.block_status:type_name -> blocktx_api.Status
+	12, // 9: blocktx_api.TransactionBlocks.transaction_blocks:type_name -> blocktx_api.TransactionBlock
+	19, // 10: blocktx_api.MerkleRootsVerificationRequest.merkle_roots:type_name -> blocktx_api.MerkleRootVerificationRequest
+	26, // 11: blocktx_api.PeerBan.banned_until:type_name -> google.protobuf.Timestamp
+	23, // 12: blocktx_api.PeerBans.peers:type_name -> blocktx_api.PeerBan
+	23, // 13: blocktx_api.PeerBans.bans:type_name -> blocktx_api.PeerBan
+	27, // 14: blocktx_api.BlockTxAPI.Health:input_type -> google.protobuf.Empty
+	15, // 15: blocktx_api.BlockTxAPI.ClearBlocks:input_type -> blocktx_api.ClearData
+	15, // 16: blocktx_api.BlockTxAPI.ClearRegisteredTransactions:input_type -> blocktx_api.ClearData
+	20, // 17: blocktx_api.BlockTxAPI.VerifyMerkleRoots:input_type -> blocktx_api.MerkleRootsVerificationRequest
+	14, // 18: blocktx_api.BlockTxAPI.RegisterTransaction:input_type -> blocktx_api.Transaction
+	11, // 19: blocktx_api.BlockTxAPI.RegisterTransactions:input_type -> blocktx_api.Transactions
+	27, // 20: blocktx_api.BlockTxAPI.CurrentBlockHeight:input_type -> google.protobuf.Empty
+	2,  // 21: blocktx_api.BlockTxAPI.LatestBlocks:input_type -> blocktx_api.NumOfLatestBlocks
+	11, // 22: blocktx_api.BlockTxAPI.AnyTransactionsMined:input_type -> blocktx_api.Transactions
+	4,  // 23: blocktx_api.BlockTxAPI.RegisteredTransactions:input_type -> blocktx_api.BlockHashes
+	5,  // 24: blocktx_api.BlockTxAPI.GetBlock:input_type -> blocktx_api.BlockRequest
+	6,  // 25: blocktx_api.BlockTxAPI.ListBlocks:input_type -> blocktx_api.ListBlocksRequest
+	14, // 26: blocktx_api.BlockTxAPI.GetTransactionBlocks:input_type -> blocktx_api.Transaction
+	27, // 27: blocktx_api.BlockTxAPI.ListPeers:input_type -> google.protobuf.Empty
+	22, // 28: blocktx_api.BlockTxAPI.BanPeer:input_type -> blocktx_api.BanPeerRequest
+	22, // 29: blocktx_api.BlockTxAPI.UnbanPeer:input_type -> blocktx_api.BanPeerRequest
+	25, // 30: blocktx_api.BlockTxAPI.SetLogLevel:input_type -> blocktx_api.LogLevelRequest
+	25, // 31: blocktx_api.BlockTxAPI.ResetLogLevel:input_type -> blocktx_api.LogLevelRequest
+	9,  // 32: blocktx_api.BlockTxAPI.Health:output_type -> blocktx_api.HealthResponse
+	16, // 33: blocktx_api.BlockTxAPI.ClearBlocks:output_type -> blocktx_api.RowsAffectedResponse
+	16, // 34: blocktx_api.BlockTxAPI.ClearRegisteredTransactions:output_type -> blocktx_api.RowsAffectedResponse
+	21, // 35: blocktx_api.BlockTxAPI.VerifyMerkleRoots:output_type -> blocktx_api.MerkleRootVerificationResponse
+	27, // 36: blocktx_api.BlockTxAPI.RegisterTransaction:output_type -> google.protobuf.Empty
+	27, // 37: blocktx_api.BlockTxAPI.RegisterTransactions:output_type -> google.protobuf.Empty
+	17, // 38: blocktx_api.BlockTxAPI.CurrentBlockHeight:output_type -> blocktx_api.CurrentBlockHeightResponse
+	3,  // 39: blocktx_api.BlockTxAPI.LatestBlocks:output_type -> blocktx_api.LatestBlocksResponse
+	8,  // 40: blocktx_api.BlockTxAPI.AnyTransactionsMined:output_type -> blocktx_api.AnyTransactionsMinedResponse
+	13, // 41: blocktx_api.BlockTxAPI.RegisteredTransactions:output_type -> blocktx_api.TransactionBlocks
+	10, // 42: blocktx_api.BlockTxAPI.GetBlock:output_type -> blocktx_api.Block
+	7,  // 43: blocktx_api.BlockTxAPI.ListBlocks:output_type -> blocktx_api.Blocks
+	13, // 44: blocktx_api.BlockTxAPI.GetTransactionBlocks:output_type -> blocktx_api.TransactionBlocks
+	24, // 45: blocktx_api.BlockTxAPI.ListPeers:output_type -> blocktx_api.PeerBans
+	24, // 46: blocktx_api.BlockTxAPI.BanPeer:output_type -> blocktx_api.PeerBans
+	24, // 47: blocktx_api.BlockTxAPI.UnbanPeer:output_type -> blocktx_api.PeerBans
+	27, // 48: blocktx_api.BlockTxAPI.SetLogLevel:output_type -> google.protobuf.Empty
+	27, // 49: blocktx_api.BlockTxAPI.ResetLogLevel:output_type -> google.protobuf.Empty
+	32, // [32:50] is the sub-list for method output_type
+	14, // [14:32] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_internal_blocktx_blocktx_api_blocktx_api_proto_init() }
@@ -1120,7 +1653,7 @@ func file_internal_blocktx_blocktx_api_blocktx_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc), len(file_internal_blocktx_blocktx_api_blocktx_api_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   17,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -29,6 +29,15 @@ const (
 	BlockTxAPI_CurrentBlockHeight_FullMethodName          = "/blocktx_api.BlockTxAPI/CurrentBlockHeight"
 	BlockTxAPI_LatestBlocks_FullMethodName                = "/blocktx_api.BlockTxAPI/LatestBlocks"
 	BlockTxAPI_AnyTransactionsMined_FullMethodName        = "/blocktx_api.BlockTxAPI/AnyTransactionsMined"
+	BlockTxAPI_RegisteredTransactions_FullMethodName      = "/blocktx_api.BlockTxAPI/RegisteredTransactions"
+	BlockTxAPI_GetBlock_FullMethodName                    = "/blocktx_api.BlockTxAPI/GetBlock"
+	BlockTxAPI_ListBlocks_FullMethodName                  = "/blocktx_api.BlockTxAPI/ListBlocks"
+	BlockTxAPI_GetTransactionBlocks_FullMethodName        = "/blocktx_api.BlockTxAPI/GetTransactionBlocks"
+	BlockTxAPI_ListPeers_FullMethodName                   = "/blocktx_api.BlockTxAPI/ListPeers"
+	BlockTxAPI_BanPeer_FullMethodName                     = "/blocktx_api.BlockTxAPI/BanPeer"
+	BlockTxAPI_UnbanPeer_FullMethodName                   = "/blocktx_api.BlockTxAPI/UnbanPeer"
+	BlockTxAPI_SetLogLevel_FullMethodName                 = "/blocktx_api.BlockTxAPI/SetLogLevel"
+	BlockTxAPI_ResetLogLevel_FullMethodName               = "/blocktx_api.BlockTxAPI/ResetLogLevel"
 )
 
 // BlockTxAPIClient is the client API for BlockTxAPI service.
@@ -53,6 +62,24 @@ type BlockTxAPIClient interface {
 	LatestBlocks(ctx context.Context, in *NumOfLatestBlocks, opts ...grpc.CallOption) (*LatestBlocksResponse, error)
 	// AnyTransactionsMined returns true if any of the transactions is mined
 	AnyTransactionsMined(ctx context.Context, in *Transactions, opts ...grpc.CallOption) (*AnyTransactionsMinedResponse, error)
+	// RegisteredTransactions returns the registered transactions mined in the blocks with their merkle paths
+	RegisteredTransactions(ctx context.Context, in *BlockHashes, opts ...grpc.CallOption) (*TransactionBlocks, error)
+	// GetBlock returns the processed block by hash or, if no hash is given, the processed block of the longest chain at the height
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error)
+	// ListBlocks returns the processed blocks of the longest chain in ascending order of height starting at the height
+	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*Blocks, error)
+	// GetTransactionBlocks returns the processed blocks in which the transaction is mined with its merkle path in each block
+	GetTransactionBlocks(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionBlocks, error)
+	// ListPeers returns the peers with their ban scores and the banned addresses
+	ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeerBans, error)
+	// BanPeer bans the address and disconnects the peers with the address
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error)
+	// UnbanPeer lifts the ban of the address
+	UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error)
+	// SetLogLevel sets the log level of a component of the process, or of the process if no component is given, until the next restart
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResetLogLevel resets the log level of a component of the process to the log level of the process
+	ResetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type blockTxAPIClient struct {
@@ -153,6 +180,96 @@ func (c *blockTxAPIClient) AnyTransactionsMined(ctx context.Context, in *Transac
 	return out, nil
 }
 
+func (c *blockTxAPIClient) RegisteredTransactions(ctx context.Context, in *BlockHashes, opts ...grpc.CallOption) (*TransactionBlocks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionBlocks)
+	err := c.cc.Invoke(ctx, BlockTxAPI_RegisteredTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Block)
+	err := c.cc.Invoke(ctx, BlockTxAPI_GetBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*Blocks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Blocks)
+	err := c.cc.Invoke(ctx, BlockTxAPI_ListBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) GetTransactionBlocks(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionBlocks, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionBlocks)
+	err := c.cc.Invoke(ctx, BlockTxAPI_GetTransactionBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeerBans, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerBans)
+	err := c.cc.Invoke(ctx, BlockTxAPI_ListPeers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerBans)
+	err := c.cc.Invoke(ctx, BlockTxAPI_BanPeer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerBans)
+	err := c.cc.Invoke(ctx, BlockTxAPI_UnbanPeer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BlockTxAPI_SetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockTxAPIClient) ResetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, BlockTxAPI_ResetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BlockTxAPIServer is the server API for BlockTxAPI service.
 // All implementations must embed UnimplementedBlockTxAPIServer
 // for forward compatibility.
@@ -175,6 +292,24 @@ type BlockTxAPIServer interface {
 	LatestBlocks(context.Context, *NumOfLatestBlocks) (*LatestBlocksResponse, error)
 	// AnyTransactionsMined returns true if any of the transactions is mined
 	AnyTransactionsMined(context.Context, *Transactions) (*AnyTransactionsMinedResponse, error)
+	// RegisteredTransactions returns the registered transactions mined in the blocks with their merkle paths
+	RegisteredTransactions(context.Context, *BlockHashes) (*TransactionBlocks, error)
+	// GetBlock returns the processed block by hash or, if no hash is given, the processed block of the longest chain at the height
+	GetBlock(context.Context, *BlockRequest) (*Block, error)
+	// ListBlocks returns the processed blocks of the longest chain in ascending order of height starting at the height
+	ListBlocks(context.Context, *ListBlocksRequest) (*Blocks, error)
+	// GetTransactionBlocks returns the processed blocks in which the transaction is mined with its merkle path in each block
+	GetTransactionBlocks(context.Context, *Transaction) (*TransactionBlocks, error)
+	// ListPeers returns the peers with their ban scores and the banned addresses
+	ListPeers(context.Context, *emptypb.Empty) (*PeerBans, error)
+	// BanPeer bans the address and disconnects the peers with the address
+	BanPeer(context.Context, *BanPeerRequest) (*PeerBans, error)
+	// UnbanPeer lifts the ban of the address
+	UnbanPeer(context.Context, *BanPeerRequest) (*PeerBans, error)
+	// SetLogLevel sets the log level of a component of the process, or of the process if no component is given, until the next restart
+	SetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error)
+	// ResetLogLevel resets the log level of a component of the process to the log level of the process
+	ResetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedBlockTxAPIServer()
 }
 
@@ -212,6 +347,33 @@ func (UnimplementedBlockTxAPIServer) LatestBlocks(context.Context, *NumOfLatestB
 func (UnimplementedBlockTxAPIServer) AnyTransactionsMined(context.Context, *Transactions) (*AnyTransactionsMinedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AnyTransactionsMined not implemented")
 }
+func (UnimplementedBlockTxAPIServer) RegisteredTransactions(context.Context, *BlockHashes) (*TransactionBlocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredTransactions not implemented")
+}
+func (UnimplementedBlockTxAPIServer) GetBlock(context.Context, *BlockRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (UnimplementedBlockTxAPIServer) ListBlocks(context.Context, *ListBlocksRequest) (*Blocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (UnimplementedBlockTxAPIServer) GetTransactionBlocks(context.Context, *Transaction) (*TransactionBlocks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionBlocks not implemented")
+}
+func (UnimplementedBlockTxAPIServer) ListPeers(context.Context, *emptypb.Empty) (*PeerBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (UnimplementedBlockTxAPIServer) BanPeer(context.Context, *BanPeerRequest) (*PeerBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (UnimplementedBlockTxAPIServer) UnbanPeer(context.Context, *BanPeerRequest) (*PeerBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (UnimplementedBlockTxAPIServer) SetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedBlockTxAPIServer) ResetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetLogLevel not implemented")
+}
 func (UnimplementedBlockTxAPIServer) mustEmbedUnimplementedBlockTxAPIServer() {}
 func (UnimplementedBlockTxAPIServer) testEmbeddedByValue()                    {}
 
@@ -395,6 +557,168 @@ func _BlockTxAPI_AnyTransactionsMined_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlockTxAPI_RegisteredTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockHashes)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).RegisteredTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_RegisteredTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).RegisteredTransactions(ctx, req.(*BlockHashes))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_GetBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_ListBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).ListBlocks(ctx, req.(*ListBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_GetTransactionBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).GetTransactionBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_GetTransactionBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).GetTransactionBlocks(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_ListPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).ListPeers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_BanPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_UnbanPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).UnbanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).SetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockTxAPI_ResetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockTxAPIServer).ResetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockTxAPI_ResetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockTxAPIServer).ResetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BlockTxAPI_ServiceDesc is the grpc.ServiceDesc for BlockTxAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -438,6 +762,42 @@ var BlockTxAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnyTransactionsMined",
 			Handler:    _BlockTxAPI_AnyTransactionsMined_Handler,
 		},
+		{
+			MethodName: "RegisteredTransactions",
+			Handler:    _BlockTxAPI_RegisteredTransactions_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _BlockTxAPI_GetBlock_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _BlockTxAPI_ListBlocks_Handler,
+		},
+		{
+			MethodName: "GetTransactionBlocks",
+			Handler:    _BlockTxAPI_GetTransactionBlocks_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _BlockTxAPI_ListPeers_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _BlockTxAPI_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _BlockTxAPI_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _BlockTxAPI_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ResetLogLevel",
+			Handler:    _BlockTxAPI_ResetLogLevel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/blocktx/blocktx_api/blocktx_api.proto",
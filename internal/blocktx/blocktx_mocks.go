@@ -13,3 +13,6 @@ package blocktx
 // from server.go
 //go:generate moq -pkg mocks -out ./mocks/blocktx_processor_mock.go . ProcessorI
 //go:generate moq -pkg mocks -out ./mocks/blocktx_peer_manager_mock.go . PeerManager
+
+// from processor.go
+//go:generate moq -pkg mocks -out ./mocks/block_event_notifier_mock.go . BlockEventNotifier
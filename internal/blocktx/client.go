@@ -3,6 +3,8 @@ package blocktx
 import (
 	"context"
 	"errors"
+	"slices"
+	"time"
 
 	"google.golang.org/protobuf/types/known/emptypb"
 
@@ -21,6 +23,7 @@ type MerkleRootsVerifier interface {
 
 var (
 	ErrRegisterTransaction = errors.New("failed to register transaction")
+	ErrNoProcessedBlocks   = errors.New("no processed blocks")
 )
 
 type Client interface {
@@ -29,6 +32,7 @@ type Client interface {
 	RegisterTransactions(ctx context.Context, hashes [][]byte) error
 	CurrentBlockHeight(ctx context.Context) (*blocktx_api.CurrentBlockHeightResponse, error)
 	LatestBlocks(ctx context.Context, blocks uint64) (*blocktx_api.LatestBlocksResponse, error)
+	RegisteredTransactions(ctx context.Context, blockHashes [][]byte) ([]*blocktx_api.TransactionBlock, error)
 }
 
 type MerkleRootVerificationRequest struct {
@@ -106,3 +110,45 @@ func (btc *BtxClient) CurrentBlockHeight(ctx context.Context) (*blocktx_api.Curr
 func (btc *BtxClient) LatestBlocks(ctx context.Context, blocks uint64) (*blocktx_api.LatestBlocksResponse, error) {
 	return btc.client.LatestBlocks(ctx, &blocktx_api.NumOfLatestBlocks{Blocks: blocks})
 }
+
+// medianTimePastBlocks is the number of latest blocks of which the median of the timestamps is the median time past.
+const medianTimePastBlocks = 11
+
+// LockTimeBounds returns the height of the next block and the median time past of the chain tip, against which the
+// lock times of transactions are evaluated.
+func LockTimeBounds(ctx context.Context, client Client) (nextHeight uint64, medianTimePast time.Time, err error) {
+	resp, err := client.LatestBlocks(ctx, medianTimePastBlocks)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	blocks := resp.GetBlocks()
+	if len(blocks) == 0 {
+		return 0, time.Time{}, ErrNoProcessedBlocks
+	}
+
+	timestamps := make([]time.Time, 0, len(blocks))
+	for _, block := range blocks {
+		nextHeight = max(nextHeight, block.GetHeight()+1)
+		if block.GetTimestamp() != nil {
+			timestamps = append(timestamps, block.GetTimestamp().AsTime())
+		}
+	}
+
+	if len(timestamps) > 0 {
+		slices.SortFunc(timestamps, time.Time.Compare)
+		medianTimePast = timestamps[len(timestamps)/2]
+	}
+
+	return nextHeight, medianTimePast, nil
+}
+
+// RegisteredTransactions returns the registered transactions mined in the blocks with their merkle paths.
+func (btc *BtxClient) RegisteredTransactions(ctx context.Context, blockHashes [][]byte) ([]*blocktx_api.TransactionBlock, error) {
+	res, err := btc.client.RegisteredTransactions(ctx, &blocktx_api.BlockHashes{Hashes: blockHashes})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.GetTransactionBlocks(), nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx"
 
@@ -137,3 +139,68 @@ func TestClient_RegisterTransactions(t *testing.T) {
 		})
 	}
 }
+
+func TestLockTimeBounds(t *testing.T) {
+	tipTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name      string
+		blocks    []*blocktx_api.Block
+		blocksErr error
+
+		expectedHeight         uint64
+		expectedMedianTimePast time.Time
+		expectedError          error
+	}{
+		{
+			name: "success",
+			blocks: []*blocktx_api.Block{
+				{Height: 104, Timestamp: timestamppb.New(tipTime)},
+				{Height: 103, Timestamp: timestamppb.New(tipTime.Add(-30 * time.Minute))},
+				{Height: 102, Timestamp: timestamppb.New(tipTime.Add(-10 * time.Minute))},
+				{Height: 101, Timestamp: timestamppb.New(tipTime.Add(-40 * time.Minute))},
+				{Height: 100},
+			},
+
+			expectedHeight:         105,
+			expectedMedianTimePast: tipTime.Add(-10 * time.Minute),
+		},
+		{
+			name: "no blocks",
+
+			expectedError: blocktx.ErrNoProcessedBlocks,
+		},
+		{
+			name:      "err",
+			blocksErr: errors.New("failed to get latest blocks"),
+
+			expectedError: errors.New("failed to get latest blocks"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			apiClient := &mocks.BlockTxAPIClientMock{
+				LatestBlocksFunc: func(_ context.Context, in *blocktx_api.NumOfLatestBlocks, _ ...grpc.CallOption) (*blocktx_api.LatestBlocksResponse, error) {
+					require.Equal(t, uint64(11), in.GetBlocks())
+					return &blocktx_api.LatestBlocksResponse{Blocks: tc.blocks}, tc.blocksErr
+				},
+			}
+			client := blocktx.NewClient(apiClient)
+
+			// when
+			height, medianTimePast, err := blocktx.LockTimeBounds(context.Background(), client)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorContains(t, err, tc.expectedError.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedHeight, height)
+			require.Equal(t, tc.expectedMedianTimePast, medianTimePast.UTC())
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet/blocktx_p2p"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store/postgresql"
+	"github.com/bitcoin-sv/arc/internal/mq"
 	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
 	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
 )
@@ -33,7 +34,11 @@ func setupSut(t *testing.T, dbInfo string) (*blocktx.Processor, *blocktx_p2p.Msg
 	require.NoError(t, err)
 
 	mqClient := &mqMocks.MessageQueueClientMock{
-		PublishMarshalCoreFunc: func(_ string, m proto.Message) error {
+		PublishMarshalCoreFunc: func(_ context.Context, topic string, m proto.Message) error {
+			if topic != mq.MinedTxsTopic {
+				return nil
+			}
+
 			serialized, ok := m.(*blocktx_api.TransactionBlocks)
 			require.True(t, ok)
 
@@ -24,6 +24,9 @@ var _ blocktx_api.BlockTxAPIClient = &BlockTxAPIClientMock{}
 //			AnyTransactionsMinedFunc: func(ctx context.Context, in *blocktx_api.Transactions, opts ...grpc.CallOption) (*blocktx_api.AnyTransactionsMinedResponse, error) {
 //				panic("mock out the AnyTransactionsMined method")
 //			},
+//			BanPeerFunc: func(ctx context.Context, in *blocktx_api.BanPeerRequest, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+//				panic("mock out the BanPeer method")
+//			},
 //			ClearBlocksFunc: func(ctx context.Context, in *blocktx_api.ClearData, opts ...grpc.CallOption) (*blocktx_api.RowsAffectedResponse, error) {
 //				panic("mock out the ClearBlocks method")
 //			},
@@ -33,18 +36,42 @@ var _ blocktx_api.BlockTxAPIClient = &BlockTxAPIClientMock{}
 //			CurrentBlockHeightFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.CurrentBlockHeightResponse, error) {
 //				panic("mock out the CurrentBlockHeight method")
 //			},
+//			GetBlockFunc: func(ctx context.Context, in *blocktx_api.BlockRequest, opts ...grpc.CallOption) (*blocktx_api.Block, error) {
+//				panic("mock out the GetBlock method")
+//			},
+//			GetTransactionBlocksFunc: func(ctx context.Context, in *blocktx_api.Transaction, opts ...grpc.CallOption) (*blocktx_api.TransactionBlocks, error) {
+//				panic("mock out the GetTransactionBlocks method")
+//			},
 //			HealthFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.HealthResponse, error) {
 //				panic("mock out the Health method")
 //			},
 //			LatestBlocksFunc: func(ctx context.Context, in *blocktx_api.NumOfLatestBlocks, opts ...grpc.CallOption) (*blocktx_api.LatestBlocksResponse, error) {
 //				panic("mock out the LatestBlocks method")
 //			},
+//			ListBlocksFunc: func(ctx context.Context, in *blocktx_api.ListBlocksRequest, opts ...grpc.CallOption) (*blocktx_api.Blocks, error) {
+//				panic("mock out the ListBlocks method")
+//			},
+//			ListPeersFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+//				panic("mock out the ListPeers method")
+//			},
 //			RegisterTransactionFunc: func(ctx context.Context, in *blocktx_api.Transaction, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 //				panic("mock out the RegisterTransaction method")
 //			},
 //			RegisterTransactionsFunc: func(ctx context.Context, in *blocktx_api.Transactions, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 //				panic("mock out the RegisterTransactions method")
 //			},
+//			RegisteredTransactionsFunc: func(ctx context.Context, in *blocktx_api.BlockHashes, opts ...grpc.CallOption) (*blocktx_api.TransactionBlocks, error) {
+//				panic("mock out the RegisteredTransactions method")
+//			},
+//			ResetLogLevelFunc: func(ctx context.Context, in *blocktx_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the ResetLogLevel method")
+//			},
+//			SetLogLevelFunc: func(ctx context.Context, in *blocktx_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the SetLogLevel method")
+//			},
+//			UnbanPeerFunc: func(ctx context.Context, in *blocktx_api.BanPeerRequest, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+//				panic("mock out the UnbanPeer method")
+//			},
 //			VerifyMerkleRootsFunc: func(ctx context.Context, in *blocktx_api.MerkleRootsVerificationRequest, opts ...grpc.CallOption) (*blocktx_api.MerkleRootVerificationResponse, error) {
 //				panic("mock out the VerifyMerkleRoots method")
 //			},
@@ -58,6 +85,9 @@ type BlockTxAPIClientMock struct {
 	// AnyTransactionsMinedFunc mocks the AnyTransactionsMined method.
 	AnyTransactionsMinedFunc func(ctx context.Context, in *blocktx_api.Transactions, opts ...grpc.CallOption) (*blocktx_api.AnyTransactionsMinedResponse, error)
 
+	// BanPeerFunc mocks the BanPeer method.
+	BanPeerFunc func(ctx context.Context, in *blocktx_api.BanPeerRequest, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error)
+
 	// ClearBlocksFunc mocks the ClearBlocks method.
 	ClearBlocksFunc func(ctx context.Context, in *blocktx_api.ClearData, opts ...grpc.CallOption) (*blocktx_api.RowsAffectedResponse, error)
 
@@ -67,18 +97,42 @@ type BlockTxAPIClientMock struct {
 	// CurrentBlockHeightFunc mocks the CurrentBlockHeight method.
 	CurrentBlockHeightFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.CurrentBlockHeightResponse, error)
 
+	// GetBlockFunc mocks the GetBlock method.
+	GetBlockFunc func(ctx context.Context, in *blocktx_api.BlockRequest, opts ...grpc.CallOption) (*blocktx_api.Block, error)
+
+	// GetTransactionBlocksFunc mocks the GetTransactionBlocks method.
+	GetTransactionBlocksFunc func(ctx context.Context, in *blocktx_api.Transaction, opts ...grpc.CallOption) (*blocktx_api.TransactionBlocks, error)
+
 	// HealthFunc mocks the Health method.
 	HealthFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.HealthResponse, error)
 
 	// LatestBlocksFunc mocks the LatestBlocks method.
 	LatestBlocksFunc func(ctx context.Context, in *blocktx_api.NumOfLatestBlocks, opts ...grpc.CallOption) (*blocktx_api.LatestBlocksResponse, error)
 
+	// ListBlocksFunc mocks the ListBlocks method.
+	ListBlocksFunc func(ctx context.Context, in *blocktx_api.ListBlocksRequest, opts ...grpc.CallOption) (*blocktx_api.Blocks, error)
+
+	// ListPeersFunc mocks the ListPeers method.
+	ListPeersFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error)
+
 	// RegisterTransactionFunc mocks the RegisterTransaction method.
 	RegisterTransactionFunc func(ctx context.Context, in *blocktx_api.Transaction, opts ...grpc.CallOption) (*emptypb.Empty, error)
 
 	// RegisterTransactionsFunc mocks the RegisterTransactions method.
 	RegisterTransactionsFunc func(ctx context.Context, in *blocktx_api.Transactions, opts ...grpc.CallOption) (*emptypb.Empty, error)
 
+	// RegisteredTransactionsFunc mocks the RegisteredTransactions method.
+	RegisteredTransactionsFunc func(ctx context.Context, in *blocktx_api.BlockHashes, opts ...grpc.CallOption) (*blocktx_api.TransactionBlocks, error)
+
+	// ResetLogLevelFunc mocks the ResetLogLevel method.
+	ResetLogLevelFunc func(ctx context.Context, in *blocktx_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
+	// SetLogLevelFunc mocks the SetLogLevel method.
+	SetLogLevelFunc func(ctx context.Context, in *blocktx_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
+	// UnbanPeerFunc mocks the UnbanPeer method.
+	UnbanPeerFunc func(ctx context.Context, in *blocktx_api.BanPeerRequest, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error)
+
 	// VerifyMerkleRootsFunc mocks the VerifyMerkleRoots method.
 	VerifyMerkleRootsFunc func(ctx context.Context, in *blocktx_api.MerkleRootsVerificationRequest, opts ...grpc.CallOption) (*blocktx_api.MerkleRootVerificationResponse, error)
 
@@ -93,6 +147,15 @@ type BlockTxAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// BanPeer holds details about calls to the BanPeer method.
+		BanPeer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.BanPeerRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// ClearBlocks holds details about calls to the ClearBlocks method.
 		ClearBlocks []struct {
 			// Ctx is the ctx argument value.
@@ -120,6 +183,24 @@ type BlockTxAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// GetBlock holds details about calls to the GetBlock method.
+		GetBlock []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.BlockRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// GetTransactionBlocks holds details about calls to the GetTransactionBlocks method.
+		GetTransactionBlocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.Transaction
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// Health holds details about calls to the Health method.
 		Health []struct {
 			// Ctx is the ctx argument value.
@@ -138,6 +219,24 @@ type BlockTxAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// ListBlocks holds details about calls to the ListBlocks method.
+		ListBlocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.ListBlocksRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ListPeers holds details about calls to the ListPeers method.
+		ListPeers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *emptypb.Empty
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// RegisterTransaction holds details about calls to the RegisterTransaction method.
 		RegisterTransaction []struct {
 			// Ctx is the ctx argument value.
@@ -156,6 +255,42 @@ type BlockTxAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// RegisteredTransactions holds details about calls to the RegisteredTransactions method.
+		RegisteredTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.BlockHashes
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ResetLogLevel holds details about calls to the ResetLogLevel method.
+		ResetLogLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.LogLevelRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// SetLogLevel holds details about calls to the SetLogLevel method.
+		SetLogLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.LogLevelRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// UnbanPeer holds details about calls to the UnbanPeer method.
+		UnbanPeer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *blocktx_api.BanPeerRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// VerifyMerkleRoots holds details about calls to the VerifyMerkleRoots method.
 		VerifyMerkleRoots []struct {
 			// Ctx is the ctx argument value.
@@ -167,13 +302,22 @@ type BlockTxAPIClientMock struct {
 		}
 	}
 	lockAnyTransactionsMined        sync.RWMutex
+	lockBanPeer                     sync.RWMutex
 	lockClearBlocks                 sync.RWMutex
 	lockClearRegisteredTransactions sync.RWMutex
 	lockCurrentBlockHeight          sync.RWMutex
+	lockGetBlock                    sync.RWMutex
+	lockGetTransactionBlocks        sync.RWMutex
 	lockHealth                      sync.RWMutex
 	lockLatestBlocks                sync.RWMutex
+	lockListBlocks                  sync.RWMutex
+	lockListPeers                   sync.RWMutex
 	lockRegisterTransaction         sync.RWMutex
 	lockRegisterTransactions        sync.RWMutex
+	lockRegisteredTransactions      sync.RWMutex
+	lockResetLogLevel               sync.RWMutex
+	lockSetLogLevel                 sync.RWMutex
+	lockUnbanPeer                   sync.RWMutex
 	lockVerifyMerkleRoots           sync.RWMutex
 }
 
@@ -217,6 +361,46 @@ func (mock *BlockTxAPIClientMock) AnyTransactionsMinedCalls() []struct {
 	return calls
 }
 
+// BanPeer calls BanPeerFunc.
+func (mock *BlockTxAPIClientMock) BanPeer(ctx context.Context, in *blocktx_api.BanPeerRequest, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+	if mock.BanPeerFunc == nil {
+		panic("BlockTxAPIClientMock.BanPeerFunc: method is nil but BlockTxAPIClient.BanPeer was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockBanPeer.Lock()
+	mock.calls.BanPeer = append(mock.calls.BanPeer, callInfo)
+	mock.lockBanPeer.Unlock()
+	return mock.BanPeerFunc(ctx, in, opts...)
+}
+
+// BanPeerCalls gets all the calls that were made to BanPeer.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.BanPeerCalls())
+func (mock *BlockTxAPIClientMock) BanPeerCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.BanPeerRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockBanPeer.RLock()
+	calls = mock.calls.BanPeer
+	mock.lockBanPeer.RUnlock()
+	return calls
+}
+
 // ClearBlocks calls ClearBlocksFunc.
 func (mock *BlockTxAPIClientMock) ClearBlocks(ctx context.Context, in *blocktx_api.ClearData, opts ...grpc.CallOption) (*blocktx_api.RowsAffectedResponse, error) {
 	if mock.ClearBlocksFunc == nil {
@@ -337,6 +521,86 @@ func (mock *BlockTxAPIClientMock) CurrentBlockHeightCalls() []struct {
 	return calls
 }
 
+// GetBlock calls GetBlockFunc.
+func (mock *BlockTxAPIClientMock) GetBlock(ctx context.Context, in *blocktx_api.BlockRequest, opts ...grpc.CallOption) (*blocktx_api.Block, error) {
+	if mock.GetBlockFunc == nil {
+		panic("BlockTxAPIClientMock.GetBlockFunc: method is nil but BlockTxAPIClient.GetBlock was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.BlockRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetBlock.Lock()
+	mock.calls.GetBlock = append(mock.calls.GetBlock, callInfo)
+	mock.lockGetBlock.Unlock()
+	return mock.GetBlockFunc(ctx, in, opts...)
+}
+
+// GetBlockCalls gets all the calls that were made to GetBlock.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.GetBlockCalls())
+func (mock *BlockTxAPIClientMock) GetBlockCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.BlockRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.BlockRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetBlock.RLock()
+	calls = mock.calls.GetBlock
+	mock.lockGetBlock.RUnlock()
+	return calls
+}
+
+// GetTransactionBlocks calls GetTransactionBlocksFunc.
+func (mock *BlockTxAPIClientMock) GetTransactionBlocks(ctx context.Context, in *blocktx_api.Transaction, opts ...grpc.CallOption) (*blocktx_api.TransactionBlocks, error) {
+	if mock.GetTransactionBlocksFunc == nil {
+		panic("BlockTxAPIClientMock.GetTransactionBlocksFunc: method is nil but BlockTxAPIClient.GetTransactionBlocks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.Transaction
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetTransactionBlocks.Lock()
+	mock.calls.GetTransactionBlocks = append(mock.calls.GetTransactionBlocks, callInfo)
+	mock.lockGetTransactionBlocks.Unlock()
+	return mock.GetTransactionBlocksFunc(ctx, in, opts...)
+}
+
+// GetTransactionBlocksCalls gets all the calls that were made to GetTransactionBlocks.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.GetTransactionBlocksCalls())
+func (mock *BlockTxAPIClientMock) GetTransactionBlocksCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.Transaction
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.Transaction
+		Opts []grpc.CallOption
+	}
+	mock.lockGetTransactionBlocks.RLock()
+	calls = mock.calls.GetTransactionBlocks
+	mock.lockGetTransactionBlocks.RUnlock()
+	return calls
+}
+
 // Health calls HealthFunc.
 func (mock *BlockTxAPIClientMock) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.HealthResponse, error) {
 	if mock.HealthFunc == nil {
@@ -417,6 +681,86 @@ func (mock *BlockTxAPIClientMock) LatestBlocksCalls() []struct {
 	return calls
 }
 
+// ListBlocks calls ListBlocksFunc.
+func (mock *BlockTxAPIClientMock) ListBlocks(ctx context.Context, in *blocktx_api.ListBlocksRequest, opts ...grpc.CallOption) (*blocktx_api.Blocks, error) {
+	if mock.ListBlocksFunc == nil {
+		panic("BlockTxAPIClientMock.ListBlocksFunc: method is nil but BlockTxAPIClient.ListBlocks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.ListBlocksRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockListBlocks.Lock()
+	mock.calls.ListBlocks = append(mock.calls.ListBlocks, callInfo)
+	mock.lockListBlocks.Unlock()
+	return mock.ListBlocksFunc(ctx, in, opts...)
+}
+
+// ListBlocksCalls gets all the calls that were made to ListBlocks.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.ListBlocksCalls())
+func (mock *BlockTxAPIClientMock) ListBlocksCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.ListBlocksRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.ListBlocksRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockListBlocks.RLock()
+	calls = mock.calls.ListBlocks
+	mock.lockListBlocks.RUnlock()
+	return calls
+}
+
+// ListPeers calls ListPeersFunc.
+func (mock *BlockTxAPIClientMock) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+	if mock.ListPeersFunc == nil {
+		panic("BlockTxAPIClientMock.ListPeersFunc: method is nil but BlockTxAPIClient.ListPeers was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockListPeers.Lock()
+	mock.calls.ListPeers = append(mock.calls.ListPeers, callInfo)
+	mock.lockListPeers.Unlock()
+	return mock.ListPeersFunc(ctx, in, opts...)
+}
+
+// ListPeersCalls gets all the calls that were made to ListPeers.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.ListPeersCalls())
+func (mock *BlockTxAPIClientMock) ListPeersCalls() []struct {
+	Ctx  context.Context
+	In   *emptypb.Empty
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}
+	mock.lockListPeers.RLock()
+	calls = mock.calls.ListPeers
+	mock.lockListPeers.RUnlock()
+	return calls
+}
+
 // RegisterTransaction calls RegisterTransactionFunc.
 func (mock *BlockTxAPIClientMock) RegisterTransaction(ctx context.Context, in *blocktx_api.Transaction, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	if mock.RegisterTransactionFunc == nil {
@@ -497,6 +841,166 @@ func (mock *BlockTxAPIClientMock) RegisterTransactionsCalls() []struct {
 	return calls
 }
 
+// RegisteredTransactions calls RegisteredTransactionsFunc.
+func (mock *BlockTxAPIClientMock) RegisteredTransactions(ctx context.Context, in *blocktx_api.BlockHashes, opts ...grpc.CallOption) (*blocktx_api.TransactionBlocks, error) {
+	if mock.RegisteredTransactionsFunc == nil {
+		panic("BlockTxAPIClientMock.RegisteredTransactionsFunc: method is nil but BlockTxAPIClient.RegisteredTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.BlockHashes
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockRegisteredTransactions.Lock()
+	mock.calls.RegisteredTransactions = append(mock.calls.RegisteredTransactions, callInfo)
+	mock.lockRegisteredTransactions.Unlock()
+	return mock.RegisteredTransactionsFunc(ctx, in, opts...)
+}
+
+// RegisteredTransactionsCalls gets all the calls that were made to RegisteredTransactions.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.RegisteredTransactionsCalls())
+func (mock *BlockTxAPIClientMock) RegisteredTransactionsCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.BlockHashes
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.BlockHashes
+		Opts []grpc.CallOption
+	}
+	mock.lockRegisteredTransactions.RLock()
+	calls = mock.calls.RegisteredTransactions
+	mock.lockRegisteredTransactions.RUnlock()
+	return calls
+}
+
+// ResetLogLevel calls ResetLogLevelFunc.
+func (mock *BlockTxAPIClientMock) ResetLogLevel(ctx context.Context, in *blocktx_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.ResetLogLevelFunc == nil {
+		panic("BlockTxAPIClientMock.ResetLogLevelFunc: method is nil but BlockTxAPIClient.ResetLogLevel was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockResetLogLevel.Lock()
+	mock.calls.ResetLogLevel = append(mock.calls.ResetLogLevel, callInfo)
+	mock.lockResetLogLevel.Unlock()
+	return mock.ResetLogLevelFunc(ctx, in, opts...)
+}
+
+// ResetLogLevelCalls gets all the calls that were made to ResetLogLevel.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.ResetLogLevelCalls())
+func (mock *BlockTxAPIClientMock) ResetLogLevelCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.LogLevelRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockResetLogLevel.RLock()
+	calls = mock.calls.ResetLogLevel
+	mock.lockResetLogLevel.RUnlock()
+	return calls
+}
+
+// SetLogLevel calls SetLogLevelFunc.
+func (mock *BlockTxAPIClientMock) SetLogLevel(ctx context.Context, in *blocktx_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.SetLogLevelFunc == nil {
+		panic("BlockTxAPIClientMock.SetLogLevelFunc: method is nil but BlockTxAPIClient.SetLogLevel was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockSetLogLevel.Lock()
+	mock.calls.SetLogLevel = append(mock.calls.SetLogLevel, callInfo)
+	mock.lockSetLogLevel.Unlock()
+	return mock.SetLogLevelFunc(ctx, in, opts...)
+}
+
+// SetLogLevelCalls gets all the calls that were made to SetLogLevel.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.SetLogLevelCalls())
+func (mock *BlockTxAPIClientMock) SetLogLevelCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.LogLevelRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockSetLogLevel.RLock()
+	calls = mock.calls.SetLogLevel
+	mock.lockSetLogLevel.RUnlock()
+	return calls
+}
+
+// UnbanPeer calls UnbanPeerFunc.
+func (mock *BlockTxAPIClientMock) UnbanPeer(ctx context.Context, in *blocktx_api.BanPeerRequest, opts ...grpc.CallOption) (*blocktx_api.PeerBans, error) {
+	if mock.UnbanPeerFunc == nil {
+		panic("BlockTxAPIClientMock.UnbanPeerFunc: method is nil but BlockTxAPIClient.UnbanPeer was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *blocktx_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockUnbanPeer.Lock()
+	mock.calls.UnbanPeer = append(mock.calls.UnbanPeer, callInfo)
+	mock.lockUnbanPeer.Unlock()
+	return mock.UnbanPeerFunc(ctx, in, opts...)
+}
+
+// UnbanPeerCalls gets all the calls that were made to UnbanPeer.
+// Check the length with:
+//
+//	len(mockedBlockTxAPIClient.UnbanPeerCalls())
+func (mock *BlockTxAPIClientMock) UnbanPeerCalls() []struct {
+	Ctx  context.Context
+	In   *blocktx_api.BanPeerRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *blocktx_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockUnbanPeer.RLock()
+	calls = mock.calls.UnbanPeer
+	mock.lockUnbanPeer.RUnlock()
+	return calls
+}
+
 // VerifyMerkleRoots calls VerifyMerkleRootsFunc.
 func (mock *BlockTxAPIClientMock) VerifyMerkleRoots(ctx context.Context, in *blocktx_api.MerkleRootsVerificationRequest, opts ...grpc.CallOption) (*blocktx_api.MerkleRootVerificationResponse, error) {
 	if mock.VerifyMerkleRootsFunc == nil {
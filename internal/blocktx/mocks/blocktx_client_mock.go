@@ -35,6 +35,9 @@ var _ blocktx.Client = &ClientMock{}
 //			RegisterTransactionsFunc: func(ctx context.Context, hashes [][]byte) error {
 //				panic("mock out the RegisterTransactions method")
 //			},
+//			RegisteredTransactionsFunc: func(ctx context.Context, blockHashes [][]byte) ([]*blocktx_api.TransactionBlock, error) {
+//				panic("mock out the RegisteredTransactions method")
+//			},
 //		}
 //
 //		// use mockedClient in code that requires blocktx.Client
@@ -57,6 +60,9 @@ type ClientMock struct {
 	// RegisterTransactionsFunc mocks the RegisterTransactions method.
 	RegisterTransactionsFunc func(ctx context.Context, hashes [][]byte) error
 
+	// RegisteredTransactionsFunc mocks the RegisteredTransactions method.
+	RegisteredTransactionsFunc func(ctx context.Context, blockHashes [][]byte) ([]*blocktx_api.TransactionBlock, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AnyTransactionsMined holds details about calls to the AnyTransactionsMined method.
@@ -92,12 +98,20 @@ type ClientMock struct {
 			// Hashes is the hashes argument value.
 			Hashes [][]byte
 		}
+		// RegisteredTransactions holds details about calls to the RegisteredTransactions method.
+		RegisteredTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BlockHashes is the blockHashes argument value.
+			BlockHashes [][]byte
+		}
 	}
-	lockAnyTransactionsMined sync.RWMutex
-	lockCurrentBlockHeight   sync.RWMutex
-	lockLatestBlocks         sync.RWMutex
-	lockRegisterTransaction  sync.RWMutex
-	lockRegisterTransactions sync.RWMutex
+	lockAnyTransactionsMined   sync.RWMutex
+	lockCurrentBlockHeight     sync.RWMutex
+	lockLatestBlocks           sync.RWMutex
+	lockRegisterTransaction    sync.RWMutex
+	lockRegisterTransactions   sync.RWMutex
+	lockRegisteredTransactions sync.RWMutex
 }
 
 // AnyTransactionsMined calls AnyTransactionsMinedFunc.
@@ -275,3 +289,39 @@ func (mock *ClientMock) RegisterTransactionsCalls() []struct {
 	mock.lockRegisterTransactions.RUnlock()
 	return calls
 }
+
+// RegisteredTransactions calls RegisteredTransactionsFunc.
+func (mock *ClientMock) RegisteredTransactions(ctx context.Context, blockHashes [][]byte) ([]*blocktx_api.TransactionBlock, error) {
+	if mock.RegisteredTransactionsFunc == nil {
+		panic("ClientMock.RegisteredTransactionsFunc: method is nil but Client.RegisteredTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		BlockHashes [][]byte
+	}{
+		Ctx:         ctx,
+		BlockHashes: blockHashes,
+	}
+	mock.lockRegisteredTransactions.Lock()
+	mock.calls.RegisteredTransactions = append(mock.calls.RegisteredTransactions, callInfo)
+	mock.lockRegisteredTransactions.Unlock()
+	return mock.RegisteredTransactionsFunc(ctx, blockHashes)
+}
+
+// RegisteredTransactionsCalls gets all the calls that were made to RegisteredTransactions.
+// Check the length with:
+//
+//	len(mockedClient.RegisteredTransactionsCalls())
+func (mock *ClientMock) RegisteredTransactionsCalls() []struct {
+	Ctx         context.Context
+	BlockHashes [][]byte
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BlockHashes [][]byte
+	}
+	mock.lockRegisteredTransactions.RLock()
+	calls = mock.calls.RegisteredTransactions
+	mock.lockRegisteredTransactions.RUnlock()
+	return calls
+}
@@ -4,7 +4,9 @@
 package mocks
 
 import (
+	"context"
 	"github.com/bitcoin-sv/arc/internal/blocktx"
+	"github.com/bitcoin-sv/arc/internal/blocktx/store"
 	"sync"
 )
 
@@ -24,6 +26,12 @@ var _ blocktx.ProcessorI = &ProcessorIMock{}
 //			RegisterTransactionFunc: func(txHash []byte)  {
 //				panic("mock out the RegisterTransaction method")
 //			},
+//			RegisteredTransactionsFunc: func(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransactionWithMerklePath, error) {
+//				panic("mock out the RegisteredTransactions method")
+//			},
+//			TransactionBlocksFunc: func(ctx context.Context, txHash []byte) ([]store.BlockTransactionWithMerklePath, error) {
+//				panic("mock out the TransactionBlocks method")
+//			},
 //		}
 //
 //		// use mockedProcessorI in code that requires blocktx.ProcessorI
@@ -37,6 +45,12 @@ type ProcessorIMock struct {
 	// RegisterTransactionFunc mocks the RegisterTransaction method.
 	RegisterTransactionFunc func(txHash []byte)
 
+	// RegisteredTransactionsFunc mocks the RegisteredTransactions method.
+	RegisteredTransactionsFunc func(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransactionWithMerklePath, error)
+
+	// TransactionBlocksFunc mocks the TransactionBlocks method.
+	TransactionBlocksFunc func(ctx context.Context, txHash []byte) ([]store.BlockTransactionWithMerklePath, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// CurrentBlockHeight holds details about calls to the CurrentBlockHeight method.
@@ -47,9 +61,25 @@ type ProcessorIMock struct {
 			// TxHash is the txHash argument value.
 			TxHash []byte
 		}
+		// RegisteredTransactions holds details about calls to the RegisteredTransactions method.
+		RegisteredTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BlockHashes is the blockHashes argument value.
+			BlockHashes [][]byte
+		}
+		// TransactionBlocks holds details about calls to the TransactionBlocks method.
+		TransactionBlocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHash is the txHash argument value.
+			TxHash []byte
+		}
 	}
-	lockCurrentBlockHeight  sync.RWMutex
-	lockRegisterTransaction sync.RWMutex
+	lockCurrentBlockHeight     sync.RWMutex
+	lockRegisterTransaction    sync.RWMutex
+	lockRegisteredTransactions sync.RWMutex
+	lockTransactionBlocks      sync.RWMutex
 }
 
 // CurrentBlockHeight calls CurrentBlockHeightFunc.
@@ -110,3 +140,75 @@ func (mock *ProcessorIMock) RegisterTransactionCalls() []struct {
 	mock.lockRegisterTransaction.RUnlock()
 	return calls
 }
+
+// RegisteredTransactions calls RegisteredTransactionsFunc.
+func (mock *ProcessorIMock) RegisteredTransactions(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransactionWithMerklePath, error) {
+	if mock.RegisteredTransactionsFunc == nil {
+		panic("ProcessorIMock.RegisteredTransactionsFunc: method is nil but ProcessorI.RegisteredTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		BlockHashes [][]byte
+	}{
+		Ctx:         ctx,
+		BlockHashes: blockHashes,
+	}
+	mock.lockRegisteredTransactions.Lock()
+	mock.calls.RegisteredTransactions = append(mock.calls.RegisteredTransactions, callInfo)
+	mock.lockRegisteredTransactions.Unlock()
+	return mock.RegisteredTransactionsFunc(ctx, blockHashes)
+}
+
+// RegisteredTransactionsCalls gets all the calls that were made to RegisteredTransactions.
+// Check the length with:
+//
+//	len(mockedProcessorI.RegisteredTransactionsCalls())
+func (mock *ProcessorIMock) RegisteredTransactionsCalls() []struct {
+	Ctx         context.Context
+	BlockHashes [][]byte
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BlockHashes [][]byte
+	}
+	mock.lockRegisteredTransactions.RLock()
+	calls = mock.calls.RegisteredTransactions
+	mock.lockRegisteredTransactions.RUnlock()
+	return calls
+}
+
+// TransactionBlocks calls TransactionBlocksFunc.
+func (mock *ProcessorIMock) TransactionBlocks(ctx context.Context, txHash []byte) ([]store.BlockTransactionWithMerklePath, error) {
+	if mock.TransactionBlocksFunc == nil {
+		panic("ProcessorIMock.TransactionBlocksFunc: method is nil but ProcessorI.TransactionBlocks was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		TxHash []byte
+	}{
+		Ctx:    ctx,
+		TxHash: txHash,
+	}
+	mock.lockTransactionBlocks.Lock()
+	mock.calls.TransactionBlocks = append(mock.calls.TransactionBlocks, callInfo)
+	mock.lockTransactionBlocks.Unlock()
+	return mock.TransactionBlocksFunc(ctx, txHash)
+}
+
+// TransactionBlocksCalls gets all the calls that were made to TransactionBlocks.
+// Check the length with:
+//
+//	len(mockedProcessorI.TransactionBlocksCalls())
+func (mock *ProcessorIMock) TransactionBlocksCalls() []struct {
+	Ctx    context.Context
+	TxHash []byte
+} {
+	var calls []struct {
+		Ctx    context.Context
+		TxHash []byte
+	}
+	mock.lockTransactionBlocks.RLock()
+	calls = mock.calls.TransactionBlocks
+	mock.lockTransactionBlocks.RUnlock()
+	return calls
+}
@@ -0,0 +1,157 @@
+package blocktx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/store"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
+)
+
+// publishOptimisticMined publishes the registered transactions of a block extending the chain tip as mined before the
+// block and its transactions are stored. The merkle tree of the block is verified against the merkle root of its header
+// first. It returns the published transactions, which are corrected by correctOptimisticMined if the processing of the
+// block disagrees.
+func (p *Processor) publishOptimisticMined(ctx context.Context, blockMsg *bcnet.BlockMessagePeer) (published []store.BlockTransactionWithMerklePath) {
+	var err error
+	ctx, span := tracing.StartTracing(ctx, "publishOptimisticMined", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	blockHash := blockMsg.Hash
+	if blockMsg.TxCount() == 0 {
+		return nil
+	}
+
+	if blockMsg.Spilled() {
+		// the merkle paths would require the merkle tree of the whole block in memory
+		p.logger.Info("Not publishing mined transactions optimistically, transaction hashes of block spilled", slog.String("hash", blockHash.String()), slog.Uint64("height", blockMsg.Height))
+		return nil
+	}
+
+	tip, err := p.store.GetChainTip(ctx)
+	if err != nil {
+		if errors.Is(err, store.ErrBlockNotFound) {
+			err = nil
+		}
+		return nil
+	}
+
+	prevBlockHash := blockMsg.Header.PrevBlock
+	if !bytes.Equal(tip.Hash, prevBlockHash[:]) || tip.Height+1 != blockMsg.Height {
+		// only blocks extending the chain tip become the longest chain without a reorg
+		return nil
+	}
+
+	merkleTree := p.hashingPool.BuildMerkleTree(blockMsg.TransactionHashes)
+	if !blockMsg.Header.MerkleRoot.IsEqual(merkleTree[len(merkleTree)-1]) {
+		p.logger.Warn("Not publishing mined transactions optimistically, merkle root mismatch", slog.String("hash", blockHash.String()), slog.Uint64("height", blockMsg.Height))
+		return nil
+	}
+
+	merkleRoot := blockMsg.Header.MerkleRoot
+	var registeredTxs []store.BlockTransactionWithMerklePath
+
+	batchSize := max(p.transactionStorageBatchSize, 1)
+	for from := 0; from < len(blockMsg.TransactionHashes); from += batchSize {
+		to := min(from+batchSize, len(blockMsg.TransactionHashes))
+
+		txIndexes := make(map[string]int, to-from)
+		txHashes := make([][]byte, 0, to-from)
+		for i := from; i < to; i++ {
+			txIndexes[string(blockMsg.TransactionHashes[i][:])] = i
+			txHashes = append(txHashes, blockMsg.TransactionHashes[i][:])
+		}
+
+		var registeredHashes [][]byte
+		registeredHashes, err = p.store.GetRegisteredTransactions(ctx, txHashes)
+		if err != nil {
+			p.logger.Error("unable to get registered transactions of block", slog.String("hash", blockHash.String()), slog.Uint64("height", blockMsg.Height), slog.String("err", err.Error()))
+			return nil
+		}
+
+		for _, txHash := range registeredHashes {
+			txIndex, found := txIndexes[string(txHash)]
+			if !found {
+				continue
+			}
+
+			registeredTxs = append(registeredTxs, store.BlockTransactionWithMerklePath{
+				BlockTransaction: store.BlockTransaction{
+					TxHash:          txHash,
+					BlockHash:       blockHash[:],
+					BlockHeight:     blockMsg.Height,
+					MerkleTreeIndex: int64(txIndex),
+					BlockStatus:     blocktx_api.Status_LONGEST,
+					MerkleRoot:      merkleRoot[:],
+				},
+			})
+		}
+	}
+
+	if len(registeredTxs) == 0 {
+		return nil
+	}
+
+	p.udpdateTxsListFromBlockTxs(registeredTxs, merkleTree, &published, hexutils.EncodeToString(blockHash[:]))
+
+	err = p.publishMinedTxs(ctx, published)
+	if err != nil {
+		return nil
+	}
+
+	p.logger.Info("Published mined transactions optimistically", slog.String("hash", blockHash.String()), slog.Uint64("height", blockMsg.Height), slog.Int("txs", len(published)))
+
+	return published
+}
+
+// correctOptimisticMined publishes the optimistically published transactions again as mined in a stale block if the
+// processing of the block failed or the block did not become part of the longest chain, e.g. because a competing block
+// was processed first. Transactions which the processing published as mined in the longest chain are not corrected.
+func (p *Processor) correctOptimisticMined(ctx context.Context, optimisticTxs []store.BlockTransactionWithMerklePath, block *blocktx_api.Block, publishedTxs []store.BlockTransactionWithMerklePath, processingErr error) {
+	if len(optimisticTxs) == 0 || errors.Is(processingErr, ErrBlockAlreadyExists) {
+		// the block was processed by another instance
+		return
+	}
+
+	if processingErr == nil && block != nil && block.Status == blocktx_api.Status_LONGEST {
+		return
+	}
+
+	confirmed := make(map[string]struct{}, len(publishedTxs))
+	if processingErr == nil {
+		for _, tx := range publishedTxs {
+			if tx.BlockStatus == blocktx_api.Status_LONGEST {
+				confirmed[string(tx.BlockHash)+string(tx.TxHash)] = struct{}{}
+			}
+		}
+	}
+
+	corrections := make([]store.BlockTransactionWithMerklePath, 0, len(optimisticTxs))
+	for _, tx := range optimisticTxs {
+		if _, found := confirmed[string(tx.BlockHash)+string(tx.TxHash)]; found {
+			continue
+		}
+
+		tx.BlockStatus = blocktx_api.Status_STALE
+		corrections = append(corrections, tx)
+	}
+
+	if len(corrections) == 0 {
+		return
+	}
+
+	tx := optimisticTxs[0]
+	p.logger.Warn("Correcting optimistically published mined transactions", slog.String("hash", getHashStringNoErr(tx.BlockHash)), slog.Uint64("height", tx.BlockHeight), slog.Int("txs", len(corrections)))
+
+	err := p.publishMinedTxs(ctx, corrections)
+	if err != nil {
+		p.logger.Error("Failed to correct optimistically published mined transactions", slog.String("hash", getHashStringNoErr(tx.BlockHash)), slog.String("err", err.Error()))
+	}
+}
@@ -0,0 +1,165 @@
+package blocktx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+const (
+	// throughputWeight is the weight of a new measurement in the exponentially weighted moving averages of a peer
+	throughputWeight = 0.3
+	// failurePenalty is the factor the throughput of a peer is reduced by if it fails to deliver a block in time
+	failurePenalty = 0.5
+)
+
+// peerThroughput keeps the measured block download throughput and latency of each peer and selects the peers blocks
+// are downloaded from. The peer with the highest throughput, or with the lowest latency among peers with the same
+// throughput, is preferred, but every explorationInterval-th selection the peer with the oldest measurement is selected
+// instead, so that the measurements of slower peers stay fresh. Peers without measurement are selected before any
+// measured peer.
+type peerThroughput struct {
+	mu                  sync.Mutex
+	explorationInterval int
+	selections          int
+	peers               map[string]*peerMeasurement
+}
+
+type peerMeasurement struct {
+	// throughput in bytes per second
+	throughput float64
+	// latency is the time between the request and the delivery of a block
+	latency    time.Duration
+	measuredAt time.Time
+}
+
+func newPeerThroughput(explorationInterval int) *peerThroughput {
+	return &peerThroughput{
+		explorationInterval: explorationInterval,
+		peers:               make(map[string]*peerMeasurement),
+	}
+}
+
+// record adds the measurement of a block of the given size delivered by the peer within the given duration.
+func (t *peerThroughput) record(peer string, size uint64, duration time.Duration, now time.Time) {
+	if duration <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	throughput := float64(size) / duration.Seconds()
+
+	m, found := t.peers[peer]
+	if !found {
+		t.peers[peer] = &peerMeasurement{throughput: throughput, latency: duration, measuredAt: now}
+		return
+	}
+
+	m.throughput = throughputWeight*throughput + (1-throughputWeight)*m.throughput
+	m.latency = time.Duration(throughputWeight*float64(duration) + (1-throughputWeight)*float64(m.latency))
+	m.measuredAt = now
+}
+
+// recordFailure reduces the throughput of a peer which failed to deliver a block in time.
+func (t *peerThroughput) recordFailure(peer string, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	m, found := t.peers[peer]
+	if !found {
+		t.peers[peer] = &peerMeasurement{measuredAt: now}
+		return
+	}
+
+	m.throughput *= failurePenalty
+	m.measuredAt = now
+}
+
+// selectPeer selects the peer to download a block from out of the connected candidates. Returns nil if none of the
+// candidates is connected.
+func (t *peerThroughput) selectPeer(candidates []p2p.PeerI) p2p.PeerI {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	connected := make([]p2p.PeerI, 0, len(candidates))
+	for _, peer := range candidates {
+		if !peer.Connected() {
+			continue
+		}
+
+		if _, found := t.peers[peer.String()]; !found {
+			return peer
+		}
+
+		connected = append(connected, peer)
+	}
+
+	if len(connected) == 0 {
+		return nil
+	}
+
+	t.selections++
+	if t.explorationInterval > 0 && t.selections%t.explorationInterval == 0 {
+		return t.oldest(connected)
+	}
+
+	return t.fastest(connected)
+}
+
+// selectFallback selects the fastest connected candidate without exploration, candidates without measurement come last
+// in the given order. Returns nil if none of the candidates is connected.
+func (t *peerThroughput) selectFallback(candidates []p2p.PeerI) p2p.PeerI {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	connected := make([]p2p.PeerI, 0, len(candidates))
+	for _, peer := range candidates {
+		if peer.Connected() {
+			connected = append(connected, peer)
+		}
+	}
+
+	if len(connected) == 0 {
+		return nil
+	}
+
+	return t.fastest(connected)
+}
+
+func (t *peerThroughput) fastest(peers []p2p.PeerI) p2p.PeerI {
+	best := peers[0]
+	bestMeasurement := t.measurementOf(best)
+
+	for _, peer := range peers[1:] {
+		m := t.measurementOf(peer)
+		if m.throughput > bestMeasurement.throughput ||
+			m.throughput == bestMeasurement.throughput && m.latency > 0 && (bestMeasurement.latency == 0 || m.latency < bestMeasurement.latency) {
+			best, bestMeasurement = peer, m
+		}
+	}
+
+	return best
+}
+
+func (t *peerThroughput) oldest(peers []p2p.PeerI) p2p.PeerI {
+	oldest := peers[0]
+	for _, peer := range peers[1:] {
+		if t.peers[peer.String()].measuredAt.Before(t.peers[oldest.String()].measuredAt) {
+			oldest = peer
+		}
+	}
+
+	return oldest
+}
+
+func (t *peerThroughput) measurementOf(peer p2p.PeerI) peerMeasurement {
+	m, found := t.peers[peer.String()]
+	if !found {
+		return peerMeasurement{}
+	}
+
+	return *m
+}
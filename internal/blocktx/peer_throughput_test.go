@@ -0,0 +1,107 @@
+package blocktx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	p2pMocks "github.com/bitcoin-sv/arc/internal/p2p/mocks"
+)
+
+func TestPeerThroughput_SelectPeer(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	newPeer := func(name string, connected bool) *p2pMocks.PeerIMock {
+		return &p2pMocks.PeerIMock{
+			ConnectedFunc: func() bool { return connected },
+			StringFunc:    func() string { return name },
+		}
+	}
+
+	tt := []struct {
+		name       string
+		candidates []p2p.PeerI
+		selections int
+
+		expectedPeer string
+	}{
+		{
+			name:       "fastest peer",
+			candidates: []p2p.PeerI{newPeer("slow", true), newPeer("fast", true), newPeer("medium", true)},
+			selections: 1,
+
+			expectedPeer: "fast",
+		},
+		{
+			name:       "fastest peer disconnected",
+			candidates: []p2p.PeerI{newPeer("slow", true), newPeer("fast", false), newPeer("medium", true)},
+			selections: 1,
+
+			expectedPeer: "medium",
+		},
+		{
+			name:       "peer without measurement explored first",
+			candidates: []p2p.PeerI{newPeer("fast", true), newPeer("new", true)},
+			selections: 1,
+
+			expectedPeer: "new",
+		},
+		{
+			name:       "peer with oldest measurement explored periodically",
+			candidates: []p2p.PeerI{newPeer("slow", true), newPeer("fast", true), newPeer("medium", true)},
+			selections: 3,
+
+			expectedPeer: "slow",
+		},
+		{
+			name:       "no peer connected",
+			candidates: []p2p.PeerI{newPeer("slow", false), newPeer("fast", false)},
+			selections: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := newPeerThroughput(3)
+			sut.record("slow", 1000, time.Second, now)
+			sut.record("medium", 4000, time.Second, now.Add(time.Minute))
+			sut.record("fast", 8000, time.Second, now.Add(2*time.Minute))
+
+			// when
+			var selected p2p.PeerI
+			for range tc.selections {
+				selected = sut.selectPeer(tc.candidates)
+			}
+
+			// then
+			if tc.expectedPeer == "" {
+				require.Nil(t, selected)
+				return
+			}
+
+			require.NotNil(t, selected)
+			assert.Equal(t, tc.expectedPeer, selected.String())
+		})
+	}
+}
+
+func TestPeerThroughput_Record(t *testing.T) {
+	// given
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	sut := newPeerThroughput(10)
+
+	// when
+	sut.record("peer", 10000, time.Second, now)
+	sut.record("peer", 1000, 2*time.Second, now.Add(time.Minute))
+	sut.recordFailure("peer", now.Add(2*time.Minute))
+
+	// then
+	m := sut.peers["peer"]
+	assert.InDelta(t, (0.3*500+0.7*10000)*failurePenalty, m.throughput, 0.001)
+	assert.Equal(t, 1300*time.Millisecond, m.latency)
+	assert.Equal(t, now.Add(2*time.Minute), m.measuredAt)
+}
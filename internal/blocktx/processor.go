@@ -19,13 +19,20 @@ import (
 	"github.com/libsv/go-p2p/wire"
 	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
-	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet/blocktx_p2p"
+	"github.com/bitcoin-sv/arc/internal/blocktx/block_events"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/hashing"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
@@ -51,6 +58,9 @@ const (
 	waitForBlockProcessing             = 5 * time.Minute
 	parallellism                       = 5
 	publishMinedMessageSizeDefault     = 256
+	blockDownloadTimeoutDefault        = 3 * time.Minute
+	blockDownloadCheckInterval         = 10 * time.Second
+	peerExplorationIntervalDefault     = 10
 	topic                              = "topic: %s"
 )
 
@@ -71,16 +81,31 @@ type Processor struct {
 	tracingAttributes           []attribute.KeyValue
 
 	incomingIsLongest       bool
+	optimisticMined         bool
 	publishMinedMessageSize int
+	hashingPool             *hashing.Pool
+	memGuard                *memlimit.Guard
+	featureFlags            *feature.Flags
+	blockEvents             BlockEventNotifier
+	eventBus                *events.Bus
 
 	now                        func() time.Time
 	maxBlockProcessingDuration time.Duration
+	blockDownloadTimeout       time.Duration
+	blockDownloads             *blockDownloads
+	peerSelectionDelay         time.Duration
+	peerExplorationInterval    int
 
 	waitGroup *sync.WaitGroup
 	cancelAll context.CancelFunc
 	ctx       context.Context
 }
 
+// BlockEventNotifier is notified of the events of the blocks, e.g. to send them to the webhooks of the operator.
+type BlockEventNotifier interface {
+	Notify(event block_events.Event)
+}
+
 func NewProcessor(
 	logger *slog.Logger,
 	storeI store.BlocktxStore,
@@ -103,8 +128,11 @@ func NewProcessor(
 		registerRequestTxsInterval:  registerRequestTxsIntervalDefault,
 		registerTxsBatchSize:        registerTxsBatchSizeDefault,
 		maxBlockProcessingDuration:  waitForBlockProcessing,
+		blockDownloadTimeout:        blockDownloadTimeoutDefault,
+		peerExplorationInterval:     peerExplorationIntervalDefault,
 		hostname:                    hostname,
 		publishMinedMessageSize:     publishMinedMessageSizeDefault,
+		hashingPool:                 hashing.NewPool(),
 		now:                         time.Now,
 		waitGroup:                   &sync.WaitGroup{},
 	}
@@ -113,6 +141,8 @@ func NewProcessor(
 		opt(p)
 	}
 
+	p.blockDownloads = newBlockDownloads(p.blockDownloadTimeout, p.maxBlockProcessingDuration, newPeerThroughput(p.peerExplorationInterval))
+
 	ctx, cancelAll := context.WithCancel(context.Background())
 	p.cancelAll = cancelAll
 	p.ctx = ctx
@@ -121,9 +151,15 @@ func NewProcessor(
 }
 
 func (p *Processor) Start() error {
-	err := p.mqClient.QueueSubscribe(mq.RegisterTxTopic, func(msg []byte) error {
+	err := p.mqClient.QueueSubscribe(mq.RegisterTxTopic, func(ctx context.Context, msg []byte) error {
+		tx := &blocktx_api.Transaction{}
+		err := mq.Unmarshal(ctx, mq.RegisterTxTopic, msg, tx)
+		if err != nil {
+			return errors.Join(ErrFailedToUnmarshalMessage, fmt.Errorf(topic, mq.RegisterTxTopic), err)
+		}
+
 		select {
-		case p.registerTxsChan <- msg:
+		case p.registerTxsChan <- tx.Hash:
 		default:
 		}
 
@@ -133,9 +169,9 @@ func (p *Processor) Start() error {
 		return errors.Join(ErrFailedToSubscribeToTopic, fmt.Errorf(topic, mq.RegisterTxTopic), err)
 	}
 
-	err = p.mqClient.QueueSubscribe(mq.RegisterTxsTopic, func(msg []byte) error {
+	err = p.mqClient.QueueSubscribe(mq.RegisterTxsTopic, func(ctx context.Context, msg []byte) error {
 		serialized := &blocktx_api.Transactions{}
-		err := proto.Unmarshal(msg, serialized)
+		err := mq.Unmarshal(ctx, mq.RegisterTxsTopic, msg, serialized)
 		if err != nil {
 			return errors.Join(ErrFailedToUnmarshalMessage, fmt.Errorf(topic, mq.RegisterTxsTopic), err)
 		}
@@ -165,14 +201,31 @@ func (p *Processor) StartBlockRequesting() {
 
 	go func() {
 		defer p.waitGroup.Done()
+
+		ticker := time.NewTicker(blockDownloadCheckInterval)
+		defer ticker.Stop()
+
 		for {
 			select {
 			case <-p.ctx.Done():
 				return
+			case <-ticker.C:
+				p.reassignFailedBlockDownloads()
 			case req := <-p.blockRequestCh:
+				// do not request further blocks while the memory usage is close to the memory limit
+				if p.memGuard.WaitWhilePaused(p.ctx) != nil {
+					return
+				}
+
 				hash := req.Hash
 				peer := req.Peer
 
+				// the block is downloaded from one peer only, the other peers announcing it are kept as fallbacks
+				if p.blockDownloads.addFallback(*hash, peer) {
+					p.logger.Debug("block download already in progress", slog.String("hash", hash.String()), slog.String("peer", peer.String()))
+					continue
+				}
+
 				// lock block for the current instance to process
 				processedBy, err := p.store.SetBlockProcessing(p.ctx, hash, p.hostname, p.maxBlockProcessingDuration, maxBlocksInProgress)
 				if err != nil {
@@ -188,17 +241,58 @@ func (p *Processor) StartBlockRequesting() {
 					continue
 				}
 
-				p.logger.Info("Sending block request", slog.String("hash", hash.String()))
-				msg := wire.NewMsgGetDataSizeHint(1)
-				_ = msg.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, hash)) // ignore error at this point
-				peer.WriteMsg(msg)
+				p.blockDownloads.start(*hash, peer)
+				if p.peerSelectionDelay == 0 {
+					p.requestFromSelectedPeer(hash)
+					continue
+				}
 
-				p.logger.Info("Block request message sent to peer", slog.String("hash", hash.String()), slog.String("peer", peer.String()))
+				// wait for further peers announcing the block to select the fastest one to download it from
+				time.AfterFunc(p.peerSelectionDelay, func() {
+					if p.ctx.Err() != nil {
+						return
+					}
+					p.requestFromSelectedPeer(hash)
+				})
 			}
 		}
 	}()
 }
 
+func (p *Processor) requestFromSelectedPeer(hash *chainhash.Hash) {
+	peer := p.blockDownloads.request(*hash, p.now())
+	if peer == nil {
+		p.logger.Error("Block download failed, none of the peers which announced the block is connected", slog.String("hash", hash.String()))
+		return
+	}
+
+	p.requestBlock(hash, peer)
+}
+
+func (p *Processor) requestBlock(hash *chainhash.Hash, peer p2p.PeerI) {
+	p.logger.Info("Sending block request", slog.String("hash", hash.String()))
+	msg := wire.NewMsgGetDataSizeHint(1)
+	_ = msg.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, hash)) // ignore error at this point
+	peer.WriteMsg(msg)
+
+	p.logger.Info("Block request message sent to peer", slog.String("hash", hash.String()), slog.String("peer", peer.String()))
+}
+
+// reassignFailedBlockDownloads requests the blocks, whose peer disconnected or didn't deliver them within the timeout,
+// from the next peer which announced them.
+func (p *Processor) reassignFailedBlockDownloads() {
+	retries, abandoned := p.blockDownloads.reassignFailed(p.now())
+
+	for _, req := range retries {
+		p.logger.Warn("Block download failed, falling back to another peer", slog.String("hash", req.Hash.String()), slog.String("peer", req.Peer.String()))
+		p.requestBlock(req.Hash, req.Peer)
+	}
+
+	for _, hash := range abandoned {
+		p.logger.Error("Block download failed and no other peer announced the block", slog.String("hash", hash.String()))
+	}
+}
+
 func (p *Processor) StartBlockProcessing() {
 	p.waitGroup.Add(1)
 
@@ -215,22 +309,51 @@ func (p *Processor) StartBlockProcessing() {
 
 				hash := blockMsg.Hash
 
+				if p.blockDownloads.complete(*hash, blockMsg.Peer, blockMsg.Size, p.now()) {
+					p.logger.Debug("dropping duplicate block", slog.String("hash", hash.String()), slog.String("peer", blockMsg.Peer))
+					_ = blockMsg.Close()
+					continue
+				}
+
 				p.logger.Info("received block", slog.String("hash", hash.String()), slog.String("peer", blockMsg.Peer))
 
-				err = p.processBlock(blockMsg)
+				block, err := p.processBlock(blockMsg)
+				nTxs := blockMsg.TxCount()
+				_ = blockMsg.Close()
 				if err != nil {
 					p.logger.Error("block processing failed", slog.String("hash", hash.String()), slog.String("err", err.Error()), slog.String("peer", blockMsg.Peer))
+					p.notifyBlockProcessingFailed(blockMsg, err)
 					continue
 				}
 
-				storeErr := p.store.MarkBlockAsDone(p.ctx, hash, blockMsg.Size, uint64(len(blockMsg.TransactionHashes)))
+				storeErr := p.store.MarkBlockAsDone(p.ctx, hash, blockMsg.Size, uint64(nTxs))
 				if storeErr != nil {
 					p.logger.Error("unable to mark block as processed", slog.String("hash", hash.String()), slog.String("err", storeErr.Error()), slog.String("peer", blockMsg.Peer))
+					p.notifyBlockProcessingFailed(blockMsg, storeErr)
 					continue
 				}
 
+				if block != nil && p.blockEvents != nil {
+					p.blockEvents.Notify(block_events.Event{
+						Type:    block_events.EventBlockProcessed,
+						Hash:    hash.String(),
+						Height:  blockMsg.Height,
+						Status:  block.Status.String(),
+						TxCount: uint64(nTxs),
+					})
+				}
+
+				if block != nil && p.eventBus != nil {
+					p.eventBus.Publish(events.BlockProcessed{
+						Hash:      hash.String(),
+						Height:    blockMsg.Height,
+						Status:    block.Status.String(),
+						TxCount:   uint64(nTxs),
+						Timestamp: p.now(),
+					})
+				}
+
 				timeElapsed := time.Since(timeStart)
-				nTxs := len(blockMsg.TransactionHashes)
 
 				// add the total block processing time to the stats
 				p.logger.Info("Processed block", slog.String("hash", hash.String()),
@@ -346,10 +469,24 @@ func (p *Processor) processTransactions(txHashes [][]byte) error {
 	return nil
 }
 
-func (p *Processor) processBlock(blockMsg *bcnet.BlockMessagePeer) (err error) {
+// notifyBlockProcessingFailed notifies the event of the block whose processing failed with the error.
+func (p *Processor) notifyBlockProcessingFailed(blockMsg *bcnet.BlockMessagePeer, err error) {
+	if p.blockEvents == nil {
+		return
+	}
+
+	p.blockEvents.Notify(block_events.Event{
+		Type:   block_events.EventBlockProcessingFailed,
+		Hash:   blockMsg.Hash.String(),
+		Height: blockMsg.Height,
+		Error:  err.Error(),
+	})
+}
+
+// processBlock processes the block and returns the stored block, which is nil if the block was processed before.
+func (p *Processor) processBlock(blockMsg *bcnet.BlockMessagePeer) (block *blocktx_api.Block, err error) {
 	ctx := p.ctx
 
-	var block *blocktx_api.Block
 	blockHash := blockMsg.Hash
 
 	ctx, span := tracing.StartTracing(ctx, "processBlock", p.tracingEnabled, p.tracingAttributes...)
@@ -371,12 +508,20 @@ func (p *Processor) processBlock(blockMsg *bcnet.BlockMessagePeer) (err error) {
 
 	if existingBlock != nil {
 		p.logger.Warn("ignoring already existing block", slog.String("hash", blockHash.String()), slog.Uint64("height", blockMsg.Height))
-		return nil
+		return nil, nil
+	}
+
+	var optimisticTxs, txsToPublish []store.BlockTransactionWithMerklePath
+	if p.optimisticMined {
+		optimisticTxs = p.publishOptimisticMined(ctx, blockMsg)
+		defer func() {
+			p.correctOptimisticMined(ctx, optimisticTxs, block, txsToPublish, err)
+		}()
 	}
 
 	block, err = p.verifyAndInsertBlock(ctx, blockMsg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var longestTxs, staleTxs []store.BlockTransaction
@@ -385,32 +530,35 @@ func (p *Processor) processBlock(blockMsg *bcnet.BlockMessagePeer) (err error) {
 	switch block.Status {
 	case blocktx_api.Status_LONGEST:
 		longestTxs, ok = p.getRegisteredTransactions(ctx, []*blocktx_api.Block{block})
+		if ok && len(blockMsg.NormalizedHashes) > 0 {
+			longestTxs, ok = p.addMalleatedTransactions(ctx, block, blockMsg, longestTxs)
+		}
 	case blocktx_api.Status_STALE:
 		longestTxs, staleTxs, ok = p.handleStaleBlock(ctx, block)
 	case blocktx_api.Status_ORPHANED:
 		longestTxs, staleTxs, ok = p.handleOrphans(ctx, block)
 	default:
-		return ErrUnexpectedBlockStatus
+		return nil, ErrUnexpectedBlockStatus
 	}
 
 	if !ok {
 		// error is already logged in each method above
-		return ErrFailedToProcessBlock
+		return nil, ErrFailedToProcessBlock
 	}
 
 	allTxs := append(longestTxs, staleTxs...)
 
-	txsToPublish, err := p.calculateMerklePaths(ctx, allTxs)
+	txsToPublish, err = p.calculateMerklePaths(ctx, allTxs)
 	if err != nil {
-		return ErrFailedToCalculateMissingMerklePaths
+		return nil, ErrFailedToCalculateMissingMerklePaths
 	}
 
 	err = p.publishMinedTxs(ctx, txsToPublish)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return block, nil
 }
 
 func (p *Processor) verifyAndInsertBlock(ctx context.Context, blockMsg *bcnet.BlockMessagePeer) (incomingBlock *blocktx_api.Block, err error) {
@@ -425,11 +573,14 @@ func (p *Processor) verifyAndInsertBlock(ctx context.Context, blockMsg *bcnet.Bl
 	merkleRoot := blockMsg.Header.MerkleRoot
 
 	incomingBlock = &blocktx_api.Block{
-		Hash:         blockHash[:],
-		PreviousHash: previousBlockHash[:],
-		MerkleRoot:   merkleRoot[:],
-		Height:       blockMsg.Height,
-		Chainwork:    calculateChainwork(blockMsg.Header.Bits).String(),
+		Hash:           blockHash[:],
+		PreviousHash:   previousBlockHash[:],
+		MerkleRoot:     merkleRoot[:],
+		Height:         blockMsg.Height,
+		Chainwork:      calculateChainwork(blockMsg.Header.Bits).String(),
+		CoinbaseReward: blockMsg.CoinbaseReward,
+		MinerTag:       blockMsg.MinerTag,
+		Timestamp:      timestamppb.New(blockMsg.Header.Timestamp),
 	}
 
 	if p.incomingIsLongest {
@@ -444,7 +595,7 @@ func (p *Processor) verifyAndInsertBlock(ctx context.Context, blockMsg *bcnet.Bl
 
 	p.logger.Info("Inserting block", slog.String("hash", blockHash.String()), slog.Uint64("height", incomingBlock.Height), slog.String("status", incomingBlock.Status.String()))
 
-	err = p.insertBlockAndStoreTransactions(ctx, incomingBlock, blockMsg.TransactionHashes, blockMsg.Header.MerkleRoot)
+	err = p.insertBlockAndStoreTransactions(ctx, incomingBlock, &blockMsg.BlockMessage)
 	if err != nil {
 		p.logger.Error("unable to insert block and store its transactions", slog.String("hash", blockHash.String()), slog.Uint64("height", incomingBlock.Height), slog.String("err", err.Error()))
 		return nil, err
@@ -548,16 +699,89 @@ func (p *Processor) getRegisteredTransactions(ctx context.Context, blocks []*blo
 	return txsToPublish, true
 }
 
-func (p *Processor) insertBlockAndStoreTransactions(ctx context.Context, incomingBlock *blocktx_api.Block, txHashes []*chainhash.Hash, merkleRoot chainhash.Hash) (err error) {
-	ctx, span := tracing.StartTracing(ctx, "insertBlockAndStoreTransactions", p.tracingEnabled, append(p.tracingAttributes, attribute.Int("txs", len(txHashes)))...)
+// addMalleatedTransactions adds the transactions of the block whose normalized hash is registered but whose transaction
+// id is not. These are malleated variants of registered transactions, they are published with the registered
+// normalized hash so that the submitted transaction can be found.
+func (p *Processor) addMalleatedTransactions(ctx context.Context, block *blocktx_api.Block, blockMsg *bcnet.BlockMessagePeer, registeredTxs []store.BlockTransaction) (txsToPublish []store.BlockTransaction, ok bool) {
+	var err error
+	ctx, span := tracing.StartTracing(ctx, "addMalleatedTransactions", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	registered := make(map[string]struct{}, len(registeredTxs))
+	for _, tx := range registeredTxs {
+		registered[string(tx.TxHash)] = struct{}{}
+	}
+
+	txsToPublish = registeredTxs
+
+	batchSize := max(p.transactionStorageBatchSize, 1)
+	for from := 0; from < len(blockMsg.NormalizedHashes); from += batchSize {
+		to := min(from+batchSize, len(blockMsg.NormalizedHashes))
+
+		txIndexes := make(map[string]int, to-from)
+		normalizedHashes := make([][]byte, 0, to-from)
+		for i := from; i < to; i++ {
+			txIndexes[string(blockMsg.NormalizedHashes[i][:])] = i
+			normalizedHashes = append(normalizedHashes, blockMsg.NormalizedHashes[i][:])
+		}
+
+		var registeredHashes [][]byte
+		registeredHashes, err = p.store.GetRegisteredTransactions(ctx, normalizedHashes)
+		if err != nil {
+			p.logger.Error("unable to get registered normalized hashes", slog.String("hash", getHashStringNoErr(block.Hash)), slog.Uint64("height", block.Height), slog.String("err", err.Error()))
+			return nil, false
+		}
+
+		for _, normalizedHash := range registeredHashes {
+			txIndex, found := txIndexes[string(normalizedHash)]
+			if !found {
+				continue
+			}
+
+			var txHashes []*chainhash.Hash
+			txHashes, err = blockMsg.ReadTransactionHashes(txIndex, txIndex+1)
+			if err != nil {
+				p.logger.Error("unable to read transaction hash of block", slog.String("hash", getHashStringNoErr(block.Hash)), slog.Uint64("height", block.Height), slog.String("err", err.Error()))
+				return nil, false
+			}
+			txHash := txHashes[0]
+			// the registered transaction itself was mined
+			if _, found = registered[string(txHash[:])]; found {
+				continue
+			}
+
+			p.logger.Warn("Malleated variant of registered transaction mined", slog.String("hash", txHash.String()), slog.String("normalizedHash", hexutils.TxID(blockMsg.NormalizedHashes[txIndex])), slog.String("blockHash", getHashStringNoErr(block.Hash)), slog.Uint64("height", block.Height))
+
+			txsToPublish = append(txsToPublish, store.BlockTransaction{
+				TxHash:          txHash[:],
+				BlockHash:       block.Hash,
+				BlockHeight:     block.Height,
+				MerkleTreeIndex: int64(txIndex),
+				BlockStatus:     block.Status,
+				MerkleRoot:      block.MerkleRoot,
+				NormalizedHash:  normalizedHash,
+			})
+		}
+	}
+
+	return txsToPublish, true
+}
+
+func (p *Processor) insertBlockAndStoreTransactions(ctx context.Context, incomingBlock *blocktx_api.Block, blockMsg *bcnet.BlockMessage) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "insertBlockAndStoreTransactions", p.tracingEnabled, append(p.tracingAttributes, attribute.Int("txs", blockMsg.TxCount()))...)
 	defer func() {
 		tracing.EndTracing(span, err)
 	}()
 
 	_, buildMerkleSpan := tracing.StartTracing(ctx, "BuildMerkleTreeStoreChainHash", p.tracingEnabled, p.tracingAttributes...)
-	calculatedMerkleTree := bc.BuildMerkleTreeStoreChainHash(txHashes)
-	tracing.EndTracing(buildMerkleSpan, nil)
-	if !merkleRoot.IsEqual(calculatedMerkleTree[len(calculatedMerkleTree)-1]) {
+	calculatedMerkleRoot, err := p.calculateMerkleRoot(blockMsg)
+	tracing.EndTracing(buildMerkleSpan, err)
+	if err != nil {
+		return err
+	}
+	if !blockMsg.Header.MerkleRoot.IsEqual(&calculatedMerkleRoot) {
 		p.logger.Error("merkle root mismatch", slog.String("hash", getHashStringNoErr(incomingBlock.Hash)))
 		return err
 	}
@@ -568,7 +792,7 @@ func (p *Processor) insertBlockAndStoreTransactions(ctx context.Context, incomin
 		return err
 	}
 
-	if err = p.storeTransactions(ctx, blockID, incomingBlock, txHashes); err != nil {
+	if err = p.storeTransactions(ctx, blockID, incomingBlock, blockMsg); err != nil {
 		p.logger.Error("unable to store transactions from block", slog.String("hash", getHashStringNoErr(incomingBlock.Hash)), slog.String("err", err.Error()))
 		return err
 	}
@@ -581,35 +805,53 @@ func (p *Processor) insertBlockAndStoreTransactions(ctx context.Context, incomin
 	return nil
 }
 
-func (p *Processor) storeTransactions(ctx context.Context, blockID uint64, block *blocktx_api.Block, txHashes []*chainhash.Hash) (err error) {
-	ctx, span := tracing.StartTracing(ctx, "storeTransactions", p.tracingEnabled, p.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
-	txs := make([]store.TxHashWithMerkleTreeIndex, 0, p.transactionStorageBatchSize)
+// calculateMerkleRoot calculates the merkle root of the transactions of the block. The transaction hashes of spilled
+// blocks are read in batches, so that neither their hashes nor their merkle tree are held in memory.
+func (p *Processor) calculateMerkleRoot(blockMsg *bcnet.BlockMessage) (chainhash.Hash, error) {
+	if !blockMsg.Spilled() {
+		merkleTree := p.hashingPool.BuildMerkleTree(blockMsg.TransactionHashes)
+		if len(merkleTree) == 0 {
+			return chainhash.Hash{}, nil
+		}
+		return *merkleTree[len(merkleTree)-1], nil
+	}
 
-	for txIndex, hash := range txHashes {
-		tx := store.TxHashWithMerkleTreeIndex{
-			Hash:            hash[:],
-			MerkleTreeIndex: int64(txIndex),
+	builder := &hashing.MerkleRootBuilder{}
+	batchSize := max(p.transactionStorageBatchSize, 1)
+	for from := 0; from < blockMsg.TxCount(); from += batchSize {
+		txHashes, err := blockMsg.ReadTransactionHashes(from, min(from+batchSize, blockMsg.TxCount()))
+		if err != nil {
+			return chainhash.Hash{}, err
 		}
 
-		txs = append(txs, tx)
+		for _, txHash := range txHashes {
+			builder.Add(txHash)
+		}
 	}
 
+	return builder.Root(), nil
+}
+
+// storeTransactions stores the transaction hashes of the block in batches. Each batch is read from the block message
+// when it is inserted, so that at most the batches being inserted in parallel are held in memory.
+func (p *Processor) storeTransactions(ctx context.Context, blockID uint64, block *blocktx_api.Block, blockMsg *bcnet.BlockMessage) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "storeTransactions", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
 	blockhash, err := chainhash.NewHash(block.Hash)
 	if err != nil {
 		return errors.Join(ErrFailedToParseBlockHash, fmt.Errorf("block height: %d", block.Height), err)
 	}
 
-	totalSize := len(txHashes)
+	totalSize := blockMsg.TxCount()
 
 	now := time.Now()
 
-	batchSize := p.transactionStorageBatchSize
+	batchSize := p.memGuard.BatchSize(p.transactionStorageBatchSize)
 
-	batches := math.Ceil(float64(len(txs)) / float64(batchSize))
+	batches := math.Ceil(float64(totalSize) / float64(batchSize))
 	g, ctx := errgroup.WithContext(ctx)
 
 	g.SetLimit(parallellism)
@@ -625,7 +867,7 @@ func (p *Processor) storeTransactions(ctx context.Context, blockID uint64, block
 	}()
 	go func() {
 		const totalSteps = 5
-		step := int64(math.Ceil(float64(len(txs)) / totalSteps))
+		step := int64(math.Ceil(float64(totalSize) / totalSteps))
 
 		showProgress := step
 		checkProgressTicker := time.NewTicker(1 * time.Second)
@@ -645,13 +887,22 @@ func (p *Processor) storeTransactions(ctx context.Context, blockID uint64, block
 	}()
 
 	for i := 0; i < int(batches); i++ {
-		batch := make([]store.TxHashWithMerkleTreeIndex, 0, batchSize)
-		if (i+1)*batchSize > len(txs) {
-			batch = txs[i*batchSize:]
-		} else {
-			batch = txs[i*batchSize : (i+1)*batchSize]
-		}
+		from := i * batchSize
+		to := min(from+batchSize, totalSize)
 		g.Go(func() error {
+			txHashes, readErr := blockMsg.ReadTransactionHashes(from, to)
+			if readErr != nil {
+				return readErr
+			}
+
+			batch := make([]store.TxHashWithMerkleTreeIndex, 0, len(txHashes))
+			for j, hash := range txHashes {
+				batch = append(batch, store.TxHashWithMerkleTreeIndex{
+					Hash:            hash[:],
+					MerkleTreeIndex: int64(from + j),
+				})
+			}
+
 			insertErr := p.store.InsertBlockTransactions(ctx, blockID, batch)
 			if insertErr != nil {
 				return errors.Join(ErrFailedToInsertBlockTransactions, insertErr)
@@ -712,6 +963,11 @@ func (p *Processor) handleStaleBlock(ctx context.Context, block *blocktx_api.Blo
 	longestChainwork := sumChainwork(longestBlocks)
 
 	if longestChainwork.Cmp(staleChainwork) < 0 {
+		if !p.featureFlags.Enabled(feature.ReorgHandling) {
+			p.logger.Warn("chain reorg detected, but reorg handling is disabled", slog.String("hash", getHashStringNoErr(block.Hash)), slog.Uint64("height", block.Height))
+			return nil, nil, true
+		}
+
 		p.logger.Info("chain reorg detected", slog.String("hash", getHashStringNoErr(block.Hash)), slog.Uint64("height", block.Height))
 
 		longestTxs, staleTxs, err = p.performReorg(ctx, staleBlocks, longestBlocks)
@@ -719,12 +975,43 @@ func (p *Processor) handleStaleBlock(ctx context.Context, block *blocktx_api.Blo
 			p.logger.Error("unable to perform reorg", slog.String("hash", getHashStringNoErr(block.Hash)), slog.Uint64("height", block.Height), slog.String("err", err.Error()))
 			return nil, nil, false
 		}
+
+		p.publishReorg(ctx, staleBlocks, lowestHeight)
+
+		if p.blockEvents != nil {
+			p.blockEvents.Notify(block_events.Event{
+				Type:         block_events.EventReorgDetected,
+				Hash:         getHashStringNoErr(block.Hash),
+				Height:       block.Height,
+				LowestHeight: lowestHeight,
+			})
+		}
+
 		return longestTxs, staleTxs, true
 	}
 
 	return nil, nil, true
 }
 
+// publishReorg publishes the first block of the new longest chain with the lowest height affected by the reorg, so
+// that the consumers can invalidate what they derived from the blocks of the previous longest chain.
+func (p *Processor) publishReorg(ctx context.Context, newLongestBlocks []*blocktx_api.Block, lowestHeight uint64) {
+	if p.mqClient == nil || len(newLongestBlocks) == 0 {
+		return
+	}
+
+	msg := &blocktx_api.Block{
+		Hash:   newLongestBlocks[0].Hash,
+		Height: lowestHeight,
+		Status: blocktx_api.Status_LONGEST,
+	}
+
+	err := p.mqClient.PublishMarshalCore(ctx, mq.ReorgTopic, msg)
+	if err != nil {
+		p.logger.Error("Failed to publish reorg", slog.String("hash", getHashStringNoErr(msg.Hash)), slog.Uint64("height", lowestHeight), slog.String("err", err.Error()))
+	}
+}
+
 func (p *Processor) handleSkippedBlock(ctx context.Context, block *blocktx_api.Block) (err error) {
 	ctx, span := tracing.StartTracing(ctx, "handleSkippedBlock", p.tracingEnabled, p.tracingAttributes...)
 	defer func() {
@@ -918,7 +1205,7 @@ func (p *Processor) acceptIntoChain(ctx context.Context, blocks []*blocktx_api.B
 
 func (p *Processor) publishMinedTxs(ctx context.Context, txs []store.BlockTransactionWithMerklePath) error {
 	var publishErr error
-	_, span := tracing.StartTracing(ctx, "publishMinedTxs", p.tracingEnabled, p.tracingAttributes...)
+	ctx, span := tracing.StartTracing(ctx, "publishMinedTxs", p.tracingEnabled, p.tracingAttributes...)
 	defer func() {
 		tracing.EndTracing(span, publishErr)
 	}()
@@ -934,12 +1221,13 @@ func (p *Processor) publishMinedTxs(ctx context.Context, txs []store.BlockTransa
 			TransactionHash: tx.TxHash,
 			MerklePath:      tx.MerklePath,
 			BlockStatus:     tx.BlockStatus,
+			NormalizedHash:  tx.NormalizedHash,
 		}
 
 		msg.TransactionBlocks = append(msg.TransactionBlocks, txBlock)
 
 		if len(msg.TransactionBlocks) >= p.publishMinedMessageSize {
-			err := p.mqClient.PublishMarshalCore(mq.MinedTxsTopic, msg)
+			err := p.mqClient.PublishMarshalCore(ctx, mq.MinedTxsTopic, msg)
 			if err != nil {
 				p.logger.Error("Failed to publish mined txs", slog.String("blockHash", getHashStringNoErr(tx.BlockHash)), slog.Uint64("height", tx.BlockHeight), slog.String("err", err.Error()))
 				publishErr = errors.Join(publishErr, err)
@@ -952,7 +1240,7 @@ func (p *Processor) publishMinedTxs(ctx context.Context, txs []store.BlockTransa
 	}
 
 	if len(msg.TransactionBlocks) > 0 {
-		err := p.mqClient.PublishMarshalCore(mq.MinedTxsTopic, msg)
+		err := p.mqClient.PublishMarshalCore(ctx, mq.MinedTxsTopic, msg)
 		if err != nil {
 			p.logger.Error("failed to publish mined txs", slog.String("err", err.Error()))
 			publishErr = errors.Join(publishErr, err)
@@ -962,6 +1250,26 @@ func (p *Processor) publishMinedTxs(ctx context.Context, txs []store.BlockTransa
 	return publishErr
 }
 
+// RegisteredTransactions returns the registered transactions mined in the blocks with their merkle paths.
+func (p *Processor) RegisteredTransactions(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransactionWithMerklePath, error) {
+	txs, err := p.store.GetRegisteredTxsByBlockHashes(ctx, blockHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.calculateMerklePaths(ctx, txs)
+}
+
+// TransactionBlocks returns the processed blocks in which the transaction is mined with its merkle path in each block.
+func (p *Processor) TransactionBlocks(ctx context.Context, txHash []byte) ([]store.BlockTransactionWithMerklePath, error) {
+	txs, err := p.store.GetMinedTransactions(ctx, [][]byte{txHash})
+	if err != nil {
+		return nil, err
+	}
+
+	return p.calculateMerklePaths(ctx, txs)
+}
+
 func (p *Processor) calculateMerklePaths(ctx context.Context, txs []store.BlockTransaction) (updatedTxs []store.BlockTransactionWithMerklePath, err error) {
 	ctx, span := tracing.StartTracing(ctx, "calculateMerklePaths", p.tracingEnabled, p.tracingAttributes...)
 	defer func() {
@@ -986,7 +1294,7 @@ func (p *Processor) calculateMerklePaths(ctx context.Context, txs []store.BlockT
 		if len(txHashes) == 0 {
 			continue
 		}
-		merkleTree := bc.BuildMerkleTreeStoreChainHash(txHashes)
+		merkleTree := p.hashingPool.BuildMerkleTree(txHashes)
 		p.udpdateTxsListFromBlockTxs(blockTxs, merkleTree, &updatedTxs, bh)
 	}
 
@@ -1004,7 +1312,7 @@ func (p *Processor) udpdateTxsListFromBlockTxs(blockTxs []store.BlockTransaction
 			p.logger.Error("Failed to create chain hash", slog.Int64(merkleTreeIndex, merkleIndex), slog.String(blockHash, bh), slog.String("err", err.Error()))
 			continue
 		}
-		txID := txHash.String()
+		txID := hexutils.TxID(txHash)
 
 		txIndex := uint64(merkleIndex)
 		if merkleIndex < 0 {
@@ -1057,10 +1365,12 @@ func getBlockTransactionsWithMerklePath(txs []store.BlockTransaction) map[string
 				MerkleTreeIndex: tx.MerkleTreeIndex,
 				BlockStatus:     tx.BlockStatus,
 				MerkleRoot:      tx.MerkleRoot,
+				NormalizedHash:  tx.NormalizedHash,
 			},
 		}
 
-		blockTxsMap[hex.EncodeToString(tx.BlockHash)] = append(blockTxsMap[hex.EncodeToString(tx.BlockHash)], blockTransactionWithMerklePath)
+		blockHash := hexutils.EncodeToString(tx.BlockHash)
+		blockTxsMap[blockHash] = append(blockTxsMap[blockHash], blockTransactionWithMerklePath)
 	}
 	return blockTxsMap
 }
@@ -6,9 +6,23 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/hashing"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/pkg/events"
 )
 
+// WithOptimisticMined publishes the registered transactions of a block extending the chain tip as mined right after its
+// merkle root is verified and before the block is stored. They are published as mined in a stale block if the
+// processing of the block fails or the block does not become part of the longest chain. The full block has to be
+// received first, only the time to store the block and to calculate the merkle paths is saved.
+func WithOptimisticMined(enabled bool) func(*Processor) {
+	return func(p *Processor) {
+		p.optimisticMined = enabled
+	}
+}
+
 func WithMessageQueueClient(mqClient mq.MessageQueueClient) func(*Processor) {
 	return func(p *Processor) {
 		p.mqClient = mqClient
@@ -58,6 +72,14 @@ func WithTracer(attr ...attribute.KeyValue) func(*Processor) {
 	}
 }
 
+// WithBlockDownloadTimeout sets the time a peer has to deliver a requested block before the block is requested from
+// another peer which announced it.
+func WithBlockDownloadTimeout(d time.Duration) func(*Processor) {
+	return func(processor *Processor) {
+		processor.blockDownloadTimeout = d
+	}
+}
+
 func WithMaxBlockProcessingDuration(d time.Duration) func(*Processor) {
 	return func(processor *Processor) {
 		processor.maxBlockProcessingDuration = d
@@ -75,3 +97,52 @@ func WithPublishMinedMessageSize(size int) func(*Processor) {
 		processor.publishMinedMessageSize = size
 	}
 }
+
+// WithHashingWorkers sets the number of workers which build the merkle trees of the blocks. If zero, the number of
+// workers is GOMAXPROCS.
+func WithHashingWorkers(n int) func(*Processor) {
+	return func(processor *Processor) {
+		processor.hashingPool = hashing.NewPool(hashing.WithWorkers(n))
+	}
+}
+
+// WithFeatureFlags disables the handling of chain reorgs while the flag feature.ReorgHandling is off.
+func WithFeatureFlags(flags *feature.Flags) func(*Processor) {
+	return func(p *Processor) {
+		p.featureFlags = flags
+	}
+}
+
+// WithMemoryGuard shrinks the batch sizes of stored transactions and pauses requesting blocks while the memory usage
+// of the process is close to its memory limit.
+func WithMemoryGuard(g *memlimit.Guard) func(*Processor) {
+	return func(p *Processor) {
+		p.memGuard = g
+	}
+}
+
+// WithBlockEventNotifier notifies the notifier of the processed blocks, the detected reorgs and the blocks whose
+// processing failed.
+func WithBlockEventNotifier(notifier BlockEventNotifier) func(*Processor) {
+	return func(p *Processor) {
+		p.blockEvents = notifier
+	}
+}
+
+// WithEventBus publishes the processed blocks as BlockProcessed events on the bus.
+func WithEventBus(bus *events.Bus) func(*Processor) {
+	return func(p *Processor) {
+		p.eventBus = bus
+	}
+}
+
+// WithPeerSelection sets the time to wait for further peers announcing a block before the peer with the highest measured
+// throughput is selected to download it from and the interval of selections at which the peer with the oldest
+// measurement is selected instead to keep its measurement fresh. If the delay is zero, blocks are requested from the
+// first peer announcing them.
+func WithPeerSelection(delay time.Duration, explorationInterval int) func(*Processor) {
+	return func(p *Processor) {
+		p.peerSelectionDelay = delay
+		p.peerExplorationInterval = explorationInterval
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -21,13 +22,16 @@ import (
 	"github.com/bitcoin-sv/arc/internal/blocktx"
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
 	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet/blocktx_p2p"
+	"github.com/bitcoin-sv/arc/internal/blocktx/block_events"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/mocks"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
 	storeMocks "github.com/bitcoin-sv/arc/internal/blocktx/store/mocks"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
 	p2p_mocks "github.com/bitcoin-sv/arc/internal/p2p/mocks"
 	"github.com/bitcoin-sv/arc/internal/testdata"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
 )
 
@@ -206,14 +210,19 @@ func TestHandleBlock(t *testing.T) {
 			}
 
 			mqClient := &mqMocks.MessageQueueClientMock{
-				PublishMarshalCoreFunc: func(_ string, _ protoreflect.ProtoMessage) error { return nil },
+				PublishMarshalCoreFunc: func(_ context.Context, _ string, _ protoreflect.ProtoMessage) error { return nil },
 			}
 
+			blockEvents := &mocks.BlockEventNotifierMock{NotifyFunc: func(_ block_events.Event) {}}
+			eventBus := events.New(slog.Default())
+			processedCh := make(chan events.BlockProcessed, 1)
+			events.Subscribe(eventBus, "processed-blocks", func(event events.BlockProcessed) { processedCh <- event })
+
 			logger := slog.Default()
 			blockProcessCh := make(chan *bcnet.BlockMessagePeer, 1)
 			p2pMsgHandler := blocktx_p2p.NewMsgHandler(logger, nil, blockProcessCh)
 
-			sut, err := blocktx.NewProcessor(logger, storeMock, nil, blockProcessCh, blocktx.WithTransactionBatchSize(batchSize), blocktx.WithMessageQueueClient(mqClient))
+			sut, err := blocktx.NewProcessor(logger, storeMock, nil, blockProcessCh, blocktx.WithTransactionBatchSize(batchSize), blocktx.WithMessageQueueClient(mqClient), blocktx.WithBlockEventNotifier(blockEvents), blocktx.WithEventBus(eventBus))
 			require.NoError(t, err)
 
 			blockMessage := &bcnet.BlockMessage{
@@ -239,6 +248,7 @@ func TestHandleBlock(t *testing.T) {
 			var actualInsertedBlockTransactions []string
 			time.Sleep(20 * time.Millisecond)
 			sut.Shutdown()
+			eventBus.Shutdown()
 
 		loop:
 			for {
@@ -253,6 +263,336 @@ func TestHandleBlock(t *testing.T) {
 			expectedInsertedTransactions := tc.txHashes
 			// then
 			require.ElementsMatch(t, expectedInsertedTransactions, actualInsertedBlockTransactions)
+
+			if tc.blockAlreadyProcessed {
+				require.Empty(t, blockEvents.NotifyCalls())
+				require.Empty(t, processedCh)
+				return
+			}
+			require.Len(t, blockEvents.NotifyCalls(), 1)
+			event := blockEvents.NotifyCalls()[0].Event
+			require.Equal(t, block_events.EventBlockProcessed, event.Type)
+			require.Equal(t, testdata.Block1Hash.String(), event.Hash)
+			require.Equal(t, tc.height, event.Height)
+			require.Equal(t, blocktx_api.Status_LONGEST.String(), event.Status)
+			require.Equal(t, uint64(len(tc.txHashes)), event.TxCount)
+
+			require.Len(t, processedCh, 1)
+			processed := <-processedCh
+			require.Equal(t, testdata.Block1Hash.String(), processed.Hash)
+			require.Equal(t, tc.height, processed.Height)
+			require.Equal(t, blocktx_api.Status_LONGEST.String(), processed.Status)
+		})
+	}
+}
+
+func TestHandleBlockMalleatedTransactions(t *testing.T) {
+	// given
+	prevBlockHash, _ := chainhash.NewHashFromStr("000000000000370a7d710d5d24968567618fa0c707950890ba138861fb7c9879")
+	merkleRoot, _ := chainhash.NewHashFromStr("de877b5f2ef9f3e294ce44141c832b84efabea0d825fd3aa7024f23c38feb696")
+
+	txIDs := []string{
+		"30f00edf09d7c4483509a52962e2e6ddfd16a0a146b9068288b1a5a2242e5c7b",
+		"63dc4a8c11ec26e141f501e5c0dfa19b463eb5660e483ca5e0c8520979bb37bb",
+		"fe220040445774788309ef0399939b70b90f7182dbf3ff24b2eaf6eeac04d395",
+		"dcd51904bc0e58199b0c6fa37b8fe3b6f8ba696e6af8ecff27fe181f173346f4",
+		"192ec6b58f1087f68728aabac2ce37ebe66e9bfc6f3af51cd39a2535e1100353",
+		"e45955e1b4b7d184ffa3f2469f18b4f9b604dce1ba2265523ec2f407ed99ee14",
+		"1d03c4f081a9c41b6ec1e45c1edb411de2765f0df3c7dfd5c91f49509af18960",
+		"7607fabbd665e1b540647d0df197ec272751257a83265fe6d312909909c25827",
+		"4c870f373eac5fb6f0a9e98dce2970047ad9c9f5b0479ae78bab86432439718a",
+		"0e28a91a0ff248ef33dba449299a6663b5401f32695b22cb5ee21e0cd2a822d9",
+		"d7f5f4ba7d1ae16cc6ff320693bc4299b4117e64afb0e2cc0634950d5a4d054f",
+		"c4cebb360bc82d1a6bd1aad631a825ec0dd57eea6964b29551616486255399e1",
+		"6346a7249eb0c40efcd5674f0f022e17b720d6f263be2cd2637326f3ee80d16f",
+		"d0d4eaaf40a4414f11f895b66ee0ecbe2f71033b45e2faeea2805c9c1da976ef",
+	}
+
+	txHashes := make([]*chainhash.Hash, len(txIDs))
+	normalizedHashes := make([]*chainhash.Hash, len(txIDs))
+	for i, txID := range txIDs {
+		txHash, err := chainhash.NewHashFromStr(txID)
+		require.NoError(t, err)
+		txHashes[i] = txHash
+		normalizedHashes[i] = &chainhash.Hash{byte(i), 0xff}
+	}
+
+	const registeredIndex = 5
+	const malleatedIndex = 3
+
+	storeMock := &storeMocks.BlocktxStoreMock{
+		GetBlockFunc: func(_ context.Context, _ *chainhash.Hash) (*blocktx_api.Block, error) {
+			return nil, store.ErrBlockNotFound
+		},
+		GetChainTipFunc: func(_ context.Context) (*blocktx_api.Block, error) {
+			return nil, store.ErrBlockNotFound
+		},
+		UpsertBlockFunc: func(_ context.Context, _ *blocktx_api.Block) (uint64, error) {
+			return 1, nil
+		},
+		InsertBlockTransactionsFunc: func(_ context.Context, _ uint64, _ []store.TxHashWithMerkleTreeIndex) error {
+			return nil
+		},
+		GetOrphansForwardFromHashFunc: func(_ context.Context, _ []byte) ([]*blocktx_api.Block, error) {
+			return []*blocktx_api.Block{}, nil
+		},
+		GetRegisteredTxsByBlockHashesFunc: func(_ context.Context, blockHashes [][]byte) ([]store.BlockTransaction, error) {
+			return []store.BlockTransaction{{
+				TxHash:          txHashes[registeredIndex][:],
+				BlockHash:       blockHashes[0],
+				BlockHeight:     1584899,
+				MerkleTreeIndex: registeredIndex,
+				BlockStatus:     blocktx_api.Status_LONGEST,
+				MerkleRoot:      merkleRoot[:],
+			}}, nil
+		},
+		GetRegisteredTransactionsFunc: func(_ context.Context, _ [][]byte) ([][]byte, error) {
+			// the submitted transaction of the registered index was mined unchanged, the other one was malleated
+			return [][]byte{normalizedHashes[registeredIndex][:], normalizedHashes[malleatedIndex][:]}, nil
+		},
+		GetBlockTransactionsHashesFunc: func(_ context.Context, _ []byte) ([]*chainhash.Hash, error) {
+			return txHashes, nil
+		},
+		MarkBlockAsDoneFunc: func(_ context.Context, _ *chainhash.Hash, _ uint64, _ uint64) error { return nil },
+	}
+
+	publishedCh := make(chan *blocktx_api.TransactionBlocks, 10)
+	mqClient := &mqMocks.MessageQueueClientMock{
+		PublishMarshalCoreFunc: func(_ context.Context, _ string, m protoreflect.ProtoMessage) error {
+			msg, ok := m.(*blocktx_api.TransactionBlocks)
+			require.True(t, ok)
+			publishedCh <- msg
+			return nil
+		},
+	}
+
+	logger := slog.Default()
+	blockProcessCh := make(chan *bcnet.BlockMessagePeer, 1)
+	p2pMsgHandler := blocktx_p2p.NewMsgHandler(logger, nil, blockProcessCh)
+
+	sut, err := blocktx.NewProcessor(logger, storeMock, nil, blockProcessCh, blocktx.WithMessageQueueClient(mqClient))
+	require.NoError(t, err)
+
+	blockMessage := &bcnet.BlockMessage{
+		Hash: testdata.Block1Hash,
+		Header: &wire.BlockHeader{
+			Version:    541065216,
+			PrevBlock:  *prevBlockHash,
+			MerkleRoot: *merkleRoot,
+			Bits:       436732028,
+			Nonce:      1234660301,
+		},
+		Height:            1584899,
+		TransactionHashes: txHashes,
+		NormalizedHashes:  normalizedHashes,
+		Size:              3150,
+	}
+
+	// when
+	sut.StartBlockProcessing()
+	p2pMsgHandler.OnReceive(blockMessage, &p2p_mocks.PeerIMock{StringFunc: func() string { return "peer" }})
+
+	// then
+	var published *blocktx_api.TransactionBlocks
+	select {
+	case published = <-publishedCh:
+	case <-time.After(time.Second):
+		t.Fatal("mined transactions not published")
+	}
+	sut.Shutdown()
+
+	require.Len(t, published.TransactionBlocks, 2)
+	for _, txBlock := range published.TransactionBlocks {
+		require.NotEmpty(t, txBlock.MerklePath)
+
+		switch {
+		case assert.ObjectsAreEqual(txHashes[registeredIndex][:], txBlock.TransactionHash):
+			assert.Nil(t, txBlock.NormalizedHash)
+		case assert.ObjectsAreEqual(txHashes[malleatedIndex][:], txBlock.TransactionHash):
+			assert.Equal(t, normalizedHashes[malleatedIndex][:], txBlock.NormalizedHash)
+		default:
+			t.Fatalf("unexpected transaction published: %x", txBlock.TransactionHash)
+		}
+	}
+}
+
+func TestHandleBlockOptimisticMined(t *testing.T) {
+	prevBlockHash, _ := chainhash.NewHashFromStr("000000000000370a7d710d5d24968567618fa0c707950890ba138861fb7c9879")
+	merkleRoot, _ := chainhash.NewHashFromStr("de877b5f2ef9f3e294ce44141c832b84efabea0d825fd3aa7024f23c38feb696")
+
+	txIDs := []string{
+		"30f00edf09d7c4483509a52962e2e6ddfd16a0a146b9068288b1a5a2242e5c7b",
+		"63dc4a8c11ec26e141f501e5c0dfa19b463eb5660e483ca5e0c8520979bb37bb",
+		"fe220040445774788309ef0399939b70b90f7182dbf3ff24b2eaf6eeac04d395",
+		"dcd51904bc0e58199b0c6fa37b8fe3b6f8ba696e6af8ecff27fe181f173346f4",
+		"192ec6b58f1087f68728aabac2ce37ebe66e9bfc6f3af51cd39a2535e1100353",
+		"e45955e1b4b7d184ffa3f2469f18b4f9b604dce1ba2265523ec2f407ed99ee14",
+		"1d03c4f081a9c41b6ec1e45c1edb411de2765f0df3c7dfd5c91f49509af18960",
+		"7607fabbd665e1b540647d0df197ec272751257a83265fe6d312909909c25827",
+		"4c870f373eac5fb6f0a9e98dce2970047ad9c9f5b0479ae78bab86432439718a",
+		"0e28a91a0ff248ef33dba449299a6663b5401f32695b22cb5ee21e0cd2a822d9",
+		"d7f5f4ba7d1ae16cc6ff320693bc4299b4117e64afb0e2cc0634950d5a4d054f",
+		"c4cebb360bc82d1a6bd1aad631a825ec0dd57eea6964b29551616486255399e1",
+		"6346a7249eb0c40efcd5674f0f022e17b720d6f263be2cd2637326f3ee80d16f",
+		"d0d4eaaf40a4414f11f895b66ee0ecbe2f71033b45e2faeea2805c9c1da976ef",
+	}
+
+	txHashes := make([]*chainhash.Hash, len(txIDs))
+	for i, txID := range txIDs {
+		txHash, err := chainhash.NewHashFromStr(txID)
+		require.NoError(t, err)
+		txHashes[i] = txHash
+	}
+
+	const registeredIndex = 5
+	const height = 1584899
+
+	tt := []struct {
+		name            string
+		optimisticMined bool
+		tipHash         *chainhash.Hash
+		upsertErr       error
+
+		expectedSteps []string
+	}{
+		{
+			name:            "block extends chain tip - mined published before the block is stored",
+			optimisticMined: true,
+			tipHash:         prevBlockHash,
+
+			expectedSteps: []string{"publish LONGEST", "upsert", "publish LONGEST"},
+		},
+		{
+			name:            "storing block failed - optimistic mined corrected",
+			optimisticMined: true,
+			tipHash:         prevBlockHash,
+			upsertErr:       errors.New("connection refused"),
+
+			expectedSteps: []string{"publish LONGEST", "upsert", "publish STALE"},
+		},
+		{
+			name:            "block does not extend chain tip - not published optimistically",
+			optimisticMined: true,
+			tipHash:         testdata.Block2Hash,
+
+			expectedSteps: []string{"upsert", "publish LONGEST"},
+		},
+		{
+			name:    "optimistic mined disabled",
+			tipHash: prevBlockHash,
+
+			expectedSteps: []string{"upsert", "publish LONGEST"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var mu sync.Mutex
+			var steps []string
+			addStep := func(step string) {
+				mu.Lock()
+				defer mu.Unlock()
+				steps = append(steps, step)
+			}
+
+			storeMock := &storeMocks.BlocktxStoreMock{
+				GetBlockFunc: func(_ context.Context, hash *chainhash.Hash) (*blocktx_api.Block, error) {
+					if hash.IsEqual(prevBlockHash) {
+						return &blocktx_api.Block{Hash: prevBlockHash[:], Height: height - 1, Status: blocktx_api.Status_LONGEST}, nil
+					}
+					return nil, store.ErrBlockNotFound
+				},
+				GetChainTipFunc: func(_ context.Context) (*blocktx_api.Block, error) {
+					return &blocktx_api.Block{Hash: tc.tipHash[:], Height: height - 1, Status: blocktx_api.Status_LONGEST}, nil
+				},
+				GetLongestBlockByHeightFunc: func(_ context.Context, _ uint64) (*blocktx_api.Block, error) {
+					return nil, store.ErrBlockNotFound
+				},
+				UpsertBlockFunc: func(_ context.Context, _ *blocktx_api.Block) (uint64, error) {
+					addStep("upsert")
+					return 1, tc.upsertErr
+				},
+				InsertBlockTransactionsFunc: func(_ context.Context, _ uint64, _ []store.TxHashWithMerkleTreeIndex) error {
+					return nil
+				},
+				GetOrphansForwardFromHashFunc: func(_ context.Context, _ []byte) ([]*blocktx_api.Block, error) {
+					return []*blocktx_api.Block{}, nil
+				},
+				GetRegisteredTxsByBlockHashesFunc: func(_ context.Context, blockHashes [][]byte) ([]store.BlockTransaction, error) {
+					return []store.BlockTransaction{{
+						TxHash:          txHashes[registeredIndex][:],
+						BlockHash:       blockHashes[0],
+						BlockHeight:     height,
+						MerkleTreeIndex: registeredIndex,
+						BlockStatus:     blocktx_api.Status_LONGEST,
+						MerkleRoot:      merkleRoot[:],
+					}}, nil
+				},
+				GetRegisteredTransactionsFunc: func(_ context.Context, _ [][]byte) ([][]byte, error) {
+					return [][]byte{txHashes[registeredIndex][:]}, nil
+				},
+				GetBlockTransactionsHashesFunc: func(_ context.Context, _ []byte) ([]*chainhash.Hash, error) {
+					return txHashes, nil
+				},
+				MarkBlockAsDoneFunc: func(_ context.Context, _ *chainhash.Hash, _ uint64, _ uint64) error { return nil },
+			}
+
+			mqClient := &mqMocks.MessageQueueClientMock{
+				PublishMarshalCoreFunc: func(_ context.Context, _ string, m protoreflect.ProtoMessage) error {
+					msg, ok := m.(*blocktx_api.TransactionBlocks)
+					require.True(t, ok)
+					require.Len(t, msg.TransactionBlocks, 1)
+					require.Equal(t, txHashes[registeredIndex][:], msg.TransactionBlocks[0].TransactionHash)
+					require.NotEmpty(t, msg.TransactionBlocks[0].MerklePath)
+					addStep("publish " + msg.TransactionBlocks[0].BlockStatus.String())
+					return nil
+				},
+			}
+
+			doneCh := make(chan struct{}, 1)
+			blockEvents := &mocks.BlockEventNotifierMock{NotifyFunc: func(_ block_events.Event) { doneCh <- struct{}{} }}
+
+			logger := slog.Default()
+			blockProcessCh := make(chan *bcnet.BlockMessagePeer, 1)
+			p2pMsgHandler := blocktx_p2p.NewMsgHandler(logger, nil, blockProcessCh)
+
+			sut, err := blocktx.NewProcessor(logger, storeMock, nil, blockProcessCh,
+				blocktx.WithMessageQueueClient(mqClient),
+				blocktx.WithBlockEventNotifier(blockEvents),
+				blocktx.WithOptimisticMined(tc.optimisticMined),
+			)
+			require.NoError(t, err)
+
+			blockMessage := &bcnet.BlockMessage{
+				Hash: testdata.Block1Hash,
+				Header: &wire.BlockHeader{
+					Version:    541065216,
+					PrevBlock:  *prevBlockHash,
+					MerkleRoot: *merkleRoot,
+					Bits:       436732028,
+					Nonce:      1234660301,
+				},
+				Height:            height,
+				TransactionHashes: txHashes,
+				Size:              3150,
+			}
+
+			// when
+			sut.StartBlockProcessing()
+			p2pMsgHandler.OnReceive(blockMessage, &p2p_mocks.PeerIMock{StringFunc: func() string { return "peer" }})
+
+			// then
+			select {
+			case <-doneCh:
+			case <-time.After(time.Second):
+				t.Fatal("block not processed")
+			}
+			sut.Shutdown()
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tc.expectedSteps, steps)
 		})
 	}
 }
@@ -267,6 +607,7 @@ func TestHandleBlockReorgAndOrphans(t *testing.T) {
 		shouldFindOrphanAncestor bool
 		ancestorStatus           blocktx_api.Status
 		expectedStatus           blocktx_api.Status
+		expectedReorg            bool
 	}{
 		{
 			name:               "block already exists - should be ignored",
@@ -292,6 +633,7 @@ func TestHandleBlockReorgAndOrphans(t *testing.T) {
 			hasCompetingBlock:   true,
 			hasGreaterChainwork: true,
 			expectedStatus:      blocktx_api.Status_LONGEST,
+			expectedReorg:       true,
 		},
 		{
 			name:                "previous block stale - no reorg",
@@ -304,6 +646,7 @@ func TestHandleBlockReorgAndOrphans(t *testing.T) {
 			prevBlockStatus:     blocktx_api.Status_STALE,
 			hasGreaterChainwork: true,
 			expectedStatus:      blocktx_api.Status_LONGEST,
+			expectedReorg:       true,
 		},
 		{
 			name:                     "previous block orphaned - no ancestor",
@@ -343,6 +686,7 @@ func TestHandleBlockReorgAndOrphans(t *testing.T) {
 			hasCompetingBlock:        true,
 			hasGreaterChainwork:      true,
 			expectedStatus:           blocktx_api.Status_LONGEST,
+			expectedReorg:            true,
 		},
 	}
 
@@ -458,7 +802,24 @@ func TestHandleBlockReorgAndOrphans(t *testing.T) {
 			blockProcessCh := make(chan *bcnet.BlockMessagePeer, 10)
 			p2pMsgHandler := blocktx_p2p.NewMsgHandler(logger, nil, blockProcessCh)
 
-			sut, err := blocktx.NewProcessor(logger, storeMock, nil, blockProcessCh)
+			var reorgPublished atomic.Bool
+			mqClient := &mqMocks.MessageQueueClientMock{
+				PublishMarshalCoreFunc: func(_ context.Context, topic string, _ protoreflect.ProtoMessage) error {
+					if topic == mq.ReorgTopic {
+						reorgPublished.Store(true)
+					}
+					return nil
+				},
+			}
+
+			var reorgNotified atomic.Bool
+			blockEvents := &mocks.BlockEventNotifierMock{NotifyFunc: func(event block_events.Event) {
+				if event.Type == block_events.EventReorgDetected {
+					reorgNotified.Store(true)
+				}
+			}}
+
+			sut, err := blocktx.NewProcessor(logger, storeMock, nil, blockProcessCh, blocktx.WithMessageQueueClient(mqClient), blocktx.WithBlockEventNotifier(blockEvents))
 			require.NoError(t, err)
 
 			txHash, err := chainhash.NewHashFromStr("be181e91217d5f802f695e52144078f8dfbe51b8a815c3d6fb48c0d853ec683b")
@@ -488,6 +849,8 @@ func TestHandleBlockReorgAndOrphans(t *testing.T) {
 			mtx.Lock()
 			require.Equal(t, tc.expectedStatus, insertedBlockStatus)
 			mtx.Unlock()
+			require.Equal(t, tc.expectedReorg, reorgPublished.Load())
+			require.Equal(t, tc.expectedReorg, reorgNotified.Load())
 		})
 	}
 }
@@ -702,7 +1065,7 @@ func TestStartProcessRegisterTxs(t *testing.T) {
 				},
 			}
 			mqClient := &mqMocks.MessageQueueClientMock{
-				PublishMarshalCoreFunc: func(_ string, _ protoreflect.ProtoMessage) error {
+				PublishMarshalCoreFunc: func(_ context.Context, _ string, _ protoreflect.ProtoMessage) error {
 					return nil
 				},
 			}
@@ -833,6 +1196,84 @@ func TestStartBlockRequesting(t *testing.T) {
 	}
 }
 
+func TestStartBlockRequesting_AnnouncedByMultiplePeers(t *testing.T) {
+	// given
+	blockHash, err := chainhash.NewHashFromStr("00000000000007b1f872a8abe664223d65acd22a500b1b8eb5db3fe09a9837ff")
+	require.NoError(t, err)
+
+	storeMock := &storeMocks.BlocktxStoreMock{
+		SetBlockProcessingFunc: func(_ context.Context, _ *chainhash.Hash, _ string, _ time.Duration, _ int) (string, error) {
+			return "abc", nil
+		},
+	}
+
+	peers := []*p2p_mocks.PeerIMock{
+		{WriteMsgFunc: func(_ wire.Message) {}, StringFunc: func() string { return "peer-1" }},
+		{WriteMsgFunc: func(_ wire.Message) {}, StringFunc: func() string { return "peer-2" }},
+	}
+
+	logger := slog.Default()
+	blockRequestCh := make(chan blocktx_p2p.BlockRequest, 10)
+	blockProcessCh := make(chan *bcnet.BlockMessagePeer, 10)
+
+	sut, err := blocktx.NewProcessor(logger, storeMock, blockRequestCh, blockProcessCh)
+	require.NoError(t, err)
+	defer sut.Shutdown()
+
+	// when
+	sut.StartBlockRequesting()
+
+	for _, peer := range peers {
+		blockRequestCh <- blocktx_p2p.BlockRequest{Hash: blockHash, Peer: peer}
+	}
+
+	time.Sleep(200 * time.Millisecond)
+
+	// then
+	require.Len(t, storeMock.SetBlockProcessingCalls(), 1)
+	require.Len(t, peers[0].WriteMsgCalls(), 1)
+	require.Empty(t, peers[1].WriteMsgCalls())
+}
+
+func TestStartBlockRequesting_PeerSelection(t *testing.T) {
+	// given
+	blockHash, err := chainhash.NewHashFromStr("00000000000007b1f872a8abe664223d65acd22a500b1b8eb5db3fe09a9837ff")
+	require.NoError(t, err)
+
+	storeMock := &storeMocks.BlocktxStoreMock{
+		SetBlockProcessingFunc: func(_ context.Context, _ *chainhash.Hash, _ string, _ time.Duration, _ int) (string, error) {
+			return "abc", nil
+		},
+	}
+
+	peers := []*p2p_mocks.PeerIMock{
+		{WriteMsgFunc: func(_ wire.Message) {}, StringFunc: func() string { return "peer-1" }, ConnectedFunc: func() bool { return false }},
+		{WriteMsgFunc: func(_ wire.Message) {}, StringFunc: func() string { return "peer-2" }, ConnectedFunc: func() bool { return true }},
+	}
+
+	logger := slog.Default()
+	blockRequestCh := make(chan blocktx_p2p.BlockRequest, 10)
+	blockProcessCh := make(chan *bcnet.BlockMessagePeer, 10)
+
+	sut, err := blocktx.NewProcessor(logger, storeMock, blockRequestCh, blockProcessCh, blocktx.WithPeerSelection(100*time.Millisecond, 10))
+	require.NoError(t, err)
+	defer sut.Shutdown()
+
+	// when
+	sut.StartBlockRequesting()
+
+	for _, peer := range peers {
+		blockRequestCh <- blocktx_p2p.BlockRequest{Hash: blockHash, Peer: peer}
+	}
+
+	time.Sleep(300 * time.Millisecond)
+
+	// then
+	require.Len(t, storeMock.SetBlockProcessingCalls(), 1)
+	require.Empty(t, peers[0].WriteMsgCalls())
+	require.Len(t, peers[1].WriteMsgCalls(), 1)
+}
+
 func TestStart(t *testing.T) {
 	tt := []struct {
 		name     string
@@ -859,12 +1300,12 @@ func TestStart(t *testing.T) {
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			var registerTxFunc func(msg []byte) error
-			var registerTxsFunc func(msg []byte) error
+			var registerTxFunc func(ctx context.Context, msg []byte) error
+			var registerTxsFunc func(ctx context.Context, msg []byte) error
 
 			// given
 			mqClient := &mqMocks.MessageQueueClientMock{
-				QueueSubscribeFunc: func(topic string, subscribeFunc func([]byte) error) error {
+				QueueSubscribeFunc: func(topic string, subscribeFunc func(context.Context, []byte) error) error {
 					err, ok := tc.topicErr[topic]
 					if ok {
 						return err
@@ -901,7 +1342,7 @@ func TestStart(t *testing.T) {
 			}
 			require.NoError(t, err)
 
-			err = registerTxFunc([]byte("some message"))
+			err = registerTxFunc(context.Background(), []byte("some message"))
 			assert.ErrorIs(t, err, tc.expectedError)
 
 			msg := &blocktx_api.Transactions{
@@ -910,10 +1351,10 @@ func TestStart(t *testing.T) {
 			data, err := proto.Marshal(msg)
 			require.NoError(t, err)
 
-			err = registerTxsFunc(data)
+			err = registerTxsFunc(context.Background(), data)
 			require.NoError(t, err)
 
-			err = registerTxsFunc([]byte("some message"))
+			err = registerTxsFunc(context.Background(), []byte("some message"))
 			assert.ErrorIs(t, err, blocktx.ErrFailedToUnmarshalMessage)
 
 			time.Sleep(100 * time.Millisecond)
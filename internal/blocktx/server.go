@@ -2,27 +2,41 @@ package blocktx
 
 import (
 	"context"
-	"encoding/hex"
+	"errors"
 	"log/slog"
+	"sort"
 	"time"
 
 	"github.com/bsv-blockchain/go-sdk/util"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/nats-io/nats.go"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	"github.com/bitcoin-sv/arc/internal/p2p"
 )
 
+var ErrPeerBansDisabled = errors.New("peer bans are not enabled")
+
+const (
+	listBlocksLimitDefault = 100
+	listBlocksLimitMax     = 1000
+)
+
 type ProcessorI interface {
 	RegisterTransaction(txHash []byte)
 	CurrentBlockHeight() (uint64, error)
+	RegisteredTransactions(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransactionWithMerklePath, error)
+	TransactionBlocks(ctx context.Context, txHash []byte) ([]store.BlockTransactionWithMerklePath, error)
 }
 
 type PeerManager interface {
@@ -41,10 +55,28 @@ type Server struct {
 	maxAllowedBlockHeightMismatch uint64
 	processor                     ProcessorI
 	mqClient                      mq.MessageQueueClient
+	banManager                    *p2p.BanManager
+	logLevels                     *arcLogger.Levels
+}
+
+type ServerOption func(s *Server)
+
+// WithServerBanManager sets the ban manager whose peers are listed, banned and unbanned.
+func WithServerBanManager(banManager *p2p.BanManager) ServerOption {
+	return func(s *Server) {
+		s.banManager = banManager
+	}
+}
+
+// WithServerLogLevels enables the operations changing the log levels of the process at runtime.
+func WithServerLogLevels(levels *arcLogger.Levels) ServerOption {
+	return func(s *Server) {
+		s.logLevels = levels
+	}
 }
 
 // NewServer will return a server instance with the logger stored within it.
-func NewServer(logger *slog.Logger, store store.BlocktxStore, pm PeerManager, processor ProcessorI, cfg grpc_utils.ServerConfig, maxAllowedBlockHeightMismatch uint64, mqClient mq.MessageQueueClient) (*Server, error) {
+func NewServer(logger *slog.Logger, store store.BlocktxStore, pm PeerManager, processor ProcessorI, cfg grpc_utils.ServerConfig, maxAllowedBlockHeightMismatch uint64, mqClient mq.MessageQueueClient, opts ...ServerOption) (*Server, error) {
 	logger = logger.With(slog.String("module", "server"))
 
 	grpcServer, err := grpc_utils.NewGrpcServer(logger, cfg)
@@ -62,6 +94,10 @@ func NewServer(logger *slog.Logger, store store.BlocktxStore, pm PeerManager, pr
 		mqClient:                      mqClient,
 	}
 
+	for _, opt := range opts {
+		opt(s)
+	}
+
 	// register health server endpoint
 	grpc_health_v1.RegisterHealthServer(grpcServer.Srv, s)
 
@@ -118,7 +154,7 @@ func (s *Server) AnyTransactionsMined(ctx context.Context, req *blocktx_api.Tran
 	minedTxs := make([][]byte, len(req.Transactions))
 	minedStatuses := make(map[string]bool, len(req.Transactions))
 	for i, v := range req.Transactions {
-		minedStatuses[string(hex.EncodeToString(v.Hash))] = false
+		minedStatuses[string(v.Hash)] = false
 		minedTxs[i] = util.ReverseBytes(v.Hash)
 	}
 
@@ -129,13 +165,12 @@ func (s *Server) AnyTransactionsMined(ctx context.Context, req *blocktx_api.Tran
 		return &res, err
 	}
 	for _, tx := range txs {
-		minedStatuses[string(hex.EncodeToString(tx.TxHash))] = true
+		minedStatuses[string(tx.TxHash)] = true
 	}
 
 	for k, v := range minedStatuses {
-		hash, _ := hex.DecodeString(k)
 		res.Transactions = append(res.Transactions, &blocktx_api.IsMined{
-			Hash:  hash,
+			Hash:  []byte(k),
 			Mined: v,
 		})
 	}
@@ -148,6 +183,90 @@ func (s *Server) CurrentBlockHeight(_ context.Context, _ *emptypb.Empty) (*block
 	return &blocktx_api.CurrentBlockHeightResponse{CurrentBlockHeight: height}, err
 }
 
+// RegisteredTransactions returns the registered transactions mined in the blocks with their merkle paths.
+func (s *Server) RegisteredTransactions(ctx context.Context, req *blocktx_api.BlockHashes) (*blocktx_api.TransactionBlocks, error) {
+	txs, err := s.processor.RegisteredTransactions(ctx, req.GetHashes())
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionBlocks(txs), nil
+}
+
+// GetBlock returns the processed block by hash or, if no hash is given, the processed block of the longest chain at the
+// height.
+func (s *Server) GetBlock(ctx context.Context, req *blocktx_api.BlockRequest) (*blocktx_api.Block, error) {
+	var block *blocktx_api.Block
+	var err error
+
+	if len(req.GetHash()) > 0 {
+		hash, hashErr := chainhash.NewHash(req.GetHash())
+		if hashErr != nil {
+			return nil, status.Error(codes.InvalidArgument, hashErr.Error())
+		}
+		block, err = s.store.GetBlock(ctx, hash)
+	} else {
+		block, err = s.store.GetLongestBlockByHeight(ctx, req.GetHeight())
+	}
+
+	if err != nil {
+		if errors.Is(err, store.ErrBlockNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return block, nil
+}
+
+// ListBlocks returns the processed blocks of the longest chain in ascending order of height starting at the height. At
+// most listBlocksLimitMax blocks are returned, listBlocksLimitDefault if no limit is given.
+func (s *Server) ListBlocks(ctx context.Context, req *blocktx_api.ListBlocksRequest) (*blocktx_api.Blocks, error) {
+	limit := req.GetLimit()
+	if limit == 0 {
+		limit = listBlocksLimitDefault
+	}
+	limit = min(limit, listBlocksLimitMax)
+
+	blocks, err := s.store.GetProcessedBlocks(ctx, req.GetFromHeight(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blocktx_api.Blocks{Blocks: blocks}, nil
+}
+
+// GetTransactionBlocks returns the processed blocks in which the transaction is mined with its merkle path in each
+// block. The response is empty if the transaction is not mined in any processed block.
+func (s *Server) GetTransactionBlocks(ctx context.Context, req *blocktx_api.Transaction) (*blocktx_api.TransactionBlocks, error) {
+	if len(req.GetHash()) != chainhash.HashSize {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction hash length %d", len(req.GetHash()))
+	}
+
+	txs, err := s.processor.TransactionBlocks(ctx, req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionBlocks(txs), nil
+}
+
+func toTransactionBlocks(txs []store.BlockTransactionWithMerklePath) *blocktx_api.TransactionBlocks {
+	res := &blocktx_api.TransactionBlocks{TransactionBlocks: make([]*blocktx_api.TransactionBlock, 0, len(txs))}
+	for _, tx := range txs {
+		res.TransactionBlocks = append(res.TransactionBlocks, &blocktx_api.TransactionBlock{
+			BlockHash:       tx.BlockHash,
+			BlockHeight:     tx.BlockHeight,
+			TransactionHash: tx.TxHash,
+			MerklePath:      tx.MerklePath,
+			BlockStatus:     tx.BlockStatus,
+			NormalizedHash:  tx.NormalizedHash,
+		})
+	}
+
+	return res
+}
+
 func (s *Server) LatestBlocks(ctx context.Context, req *blocktx_api.NumOfLatestBlocks) (*blocktx_api.LatestBlocksResponse, error) {
 	blocks, err := s.store.LatestBlocks(ctx, req.Blocks)
 	if err != nil {
@@ -156,3 +275,106 @@ func (s *Server) LatestBlocks(ctx context.Context, req *blocktx_api.NumOfLatestB
 
 	return &blocktx_api.LatestBlocksResponse{Blocks: blocks}, nil
 }
+
+// ListPeers returns the peers with their ban scores and the banned addresses.
+func (s *Server) ListPeers(_ context.Context, _ *emptypb.Empty) (*blocktx_api.PeerBans, error) {
+	if s.banManager == nil {
+		return nil, ErrPeerBansDisabled
+	}
+
+	return toPeerBans(s.banManager), nil
+}
+
+// BanPeer bans the address and disconnects the peers with the address.
+func (s *Server) BanPeer(_ context.Context, req *blocktx_api.BanPeerRequest) (*blocktx_api.PeerBans, error) {
+	if s.banManager == nil {
+		return nil, ErrPeerBansDisabled
+	}
+
+	var duration time.Duration
+	if req.GetDuration() != "" {
+		var err error
+		duration, err = time.ParseDuration(req.GetDuration())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err := s.banManager.Ban(req.GetAddress(), duration)
+	if err != nil {
+		if errors.Is(err, p2p.ErrAddressRequired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return toPeerBans(s.banManager), nil
+}
+
+// UnbanPeer lifts the ban of the address.
+func (s *Server) UnbanPeer(_ context.Context, req *blocktx_api.BanPeerRequest) (*blocktx_api.PeerBans, error) {
+	if s.banManager == nil {
+		return nil, ErrPeerBansDisabled
+	}
+
+	err := s.banManager.Unban(req.GetAddress())
+	if err != nil {
+		if errors.Is(err, p2p.ErrAddressNotBanned) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return toPeerBans(s.banManager), nil
+}
+
+func toPeerBans(banManager *p2p.BanManager) *blocktx_api.PeerBans {
+	result := &blocktx_api.PeerBans{}
+	for _, peer := range banManager.Peers() {
+		peerBan := &blocktx_api.PeerBan{
+			Address:   peer.Address,
+			Connected: peer.Connected,
+			BanScore:  int64(peer.BanScore),
+			Banned:    peer.Banned,
+		}
+		if peer.Banned {
+			peerBan.BannedUntil = timestamppb.New(peer.BannedUntil)
+		}
+		result.Peers = append(result.Peers, peerBan)
+	}
+
+	for address, bannedUntil := range banManager.Bans() {
+		result.Bans = append(result.Bans, &blocktx_api.PeerBan{Address: address, Banned: true, BannedUntil: timestamppb.New(bannedUntil)})
+	}
+
+	sort.Slice(result.Bans, func(i, j int) bool {
+		return result.Bans[i].GetAddress() < result.Bans[j].GetAddress()
+	})
+
+	return result
+}
+
+// SetLogLevel sets the log level of a component of the process running blocktx, or of the process if no component is
+// given, until the next restart.
+func (s *Server) SetLogLevel(ctx context.Context, req *blocktx_api.LogLevelRequest) (*emptypb.Empty, error) {
+	err := s.logLevels.SetLevelName(req.GetComponent(), req.GetLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level changed", slog.String("component", req.GetComponent()), slog.String("level", req.GetLevel()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResetLogLevel resets the log level of a component of the process running blocktx to the log level of the process.
+func (s *Server) ResetLogLevel(ctx context.Context, req *blocktx_api.LogLevelRequest) (*emptypb.Empty, error) {
+	err := s.logLevels.ResetComponentLevel(req.GetComponent())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level reset", slog.String("component", req.GetComponent()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -8,7 +8,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
@@ -16,6 +19,7 @@ import (
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
 	storeMocks "github.com/bitcoin-sv/arc/internal/blocktx/store/mocks"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
 	"github.com/bitcoin-sv/arc/internal/p2p"
 )
@@ -262,3 +266,222 @@ func TestClearBlocks(t *testing.T) {
 		})
 	}
 }
+
+func TestGetBlock(t *testing.T) {
+	blockHash := bytes.Repeat([]byte{0x01}, 32)
+
+	tt := []struct {
+		name    string
+		request *blocktx_api.BlockRequest
+		getErr  error
+
+		expectedCode         codes.Code
+		expectedGetBlockCall int
+		expectedByHeightCall int
+	}{
+		{
+			name:    "by hash",
+			request: &blocktx_api.BlockRequest{Hash: blockHash},
+
+			expectedCode:         codes.OK,
+			expectedGetBlockCall: 1,
+		},
+		{
+			name:    "by height",
+			request: &blocktx_api.BlockRequest{Height: 100},
+
+			expectedCode:         codes.OK,
+			expectedByHeightCall: 1,
+		},
+		{
+			name:    "invalid hash",
+			request: &blocktx_api.BlockRequest{Hash: []byte("hash")},
+
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:    "not found",
+			request: &blocktx_api.BlockRequest{Height: 100},
+			getErr:  store.ErrBlockNotFound,
+
+			expectedCode:         codes.NotFound,
+			expectedByHeightCall: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+			storeMock := &storeMocks.BlocktxStoreMock{
+				GetBlockFunc: func(_ context.Context, hash *chainhash.Hash) (*blocktx_api.Block, error) {
+					return &blocktx_api.Block{Hash: hash[:], Height: 100}, tc.getErr
+				},
+				GetLongestBlockByHeightFunc: func(_ context.Context, height uint64) (*blocktx_api.Block, error) {
+					if tc.getErr != nil {
+						return nil, tc.getErr
+					}
+					return &blocktx_api.Block{Hash: blockHash, Height: height}, nil
+				},
+			}
+			sut, err := blocktx.NewServer(logger, storeMock, nil, nil, grpc_utils.ServerConfig{}, 0, nil)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.GetBlock(context.Background(), tc.request)
+
+			// then
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Len(t, storeMock.GetBlockCalls(), tc.expectedGetBlockCall)
+			require.Len(t, storeMock.GetLongestBlockByHeightCalls(), tc.expectedByHeightCall)
+			if tc.expectedCode != codes.OK {
+				return
+			}
+			require.Equal(t, blockHash, actual.GetHash())
+			require.Equal(t, uint64(100), actual.GetHeight())
+		})
+	}
+}
+
+func TestListBlocks(t *testing.T) {
+	tt := []struct {
+		name  string
+		limit uint64
+
+		expectedLimit uint64
+	}{
+		{
+			name: "default limit",
+
+			expectedLimit: 100,
+		},
+		{
+			name:  "limit",
+			limit: 10,
+
+			expectedLimit: 10,
+		},
+		{
+			name:  "maximum limit",
+			limit: 5000,
+
+			expectedLimit: 1000,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+			storeMock := &storeMocks.BlocktxStoreMock{
+				GetProcessedBlocksFunc: func(_ context.Context, fromHeight uint64, limit uint64) ([]*blocktx_api.Block, error) {
+					require.Equal(t, uint64(822013), fromHeight)
+					require.Equal(t, tc.expectedLimit, limit)
+					return []*blocktx_api.Block{{Height: 822013}, {Height: 822014}}, nil
+				},
+			}
+			sut, err := blocktx.NewServer(logger, storeMock, nil, nil, grpc_utils.ServerConfig{}, 0, nil)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.ListBlocks(context.Background(), &blocktx_api.ListBlocksRequest{FromHeight: 822013, Limit: tc.limit})
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, actual.GetBlocks(), 2)
+		})
+	}
+}
+
+func TestGetTransactionBlocks(t *testing.T) {
+	txHash := bytes.Repeat([]byte{0x02}, 32)
+
+	tt := []struct {
+		name   string
+		txHash []byte
+
+		expectedCode   codes.Code
+		expectedBlocks int
+	}{
+		{
+			name:   "mined",
+			txHash: txHash,
+
+			expectedCode:   codes.OK,
+			expectedBlocks: 1,
+		},
+		{
+			name:   "invalid hash",
+			txHash: tx1,
+
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelInfo}))
+			proc := &mocks.ProcessorIMock{
+				TransactionBlocksFunc: func(_ context.Context, hash []byte) ([]store.BlockTransactionWithMerklePath, error) {
+					return []store.BlockTransactionWithMerklePath{
+						{BlockTransaction: store.BlockTransaction{TxHash: hash, BlockHeight: 100, BlockStatus: blocktx_api.Status_LONGEST}, MerklePath: "merkle-path"},
+					}, nil
+				},
+			}
+			sut, err := blocktx.NewServer(logger, nil, nil, proc, grpc_utils.ServerConfig{}, 0, nil)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.GetTransactionBlocks(context.Background(), &blocktx_api.Transaction{Hash: tc.txHash})
+
+			// then
+			require.Equal(t, tc.expectedCode, status.Code(err))
+			require.Len(t, actual.GetTransactionBlocks(), tc.expectedBlocks)
+			if tc.expectedBlocks > 0 {
+				require.Equal(t, "merkle-path", actual.GetTransactionBlocks()[0].GetMerklePath())
+				require.Equal(t, txHash, actual.GetTransactionBlocks()[0].GetTransactionHash())
+			}
+		})
+	}
+}
+
+func TestLogLevels(t *testing.T) {
+	t.Run("set and reset log level", func(t *testing.T) {
+		// given
+		levels := arcLogger.NewLevels(arcLogger.LevelInfo)
+		sut, err := blocktx.NewServer(slog.Default(), nil, nil, nil, grpc_utils.ServerConfig{}, 0, nil, blocktx.WithServerLogLevels(levels))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetLogLevel(context.Background(), &blocktx_api.LogLevelRequest{Component: "blocktx", Level: "DEBUG"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, arcLogger.LevelDebug, levels.Level("blocktx"))
+
+		// when
+		_, err = sut.ResetLogLevel(context.Background(), &blocktx_api.LogLevelRequest{Component: "blocktx"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, arcLogger.LevelInfo, levels.Level("blocktx"))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// given
+		sut, err := blocktx.NewServer(slog.Default(), nil, nil, nil, grpc_utils.ServerConfig{}, 0, nil)
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetLogLevel(context.Background(), &blocktx_api.LogLevelRequest{Component: "blocktx", Level: "DEBUG"})
+
+		// then
+		require.ErrorIs(t, err, arcLogger.ErrLevelsDisabled)
+	})
+}
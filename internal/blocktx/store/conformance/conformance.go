@@ -0,0 +1,185 @@
+// Package conformance contains the tests which every implementation of store.BlocktxStore has to pass. The tests only
+// use the methods of the interface, so they can be run against any store, e.g. against a postgres database of a
+// managed postgres flavor before deploying ARC with it.
+package conformance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/blocktx/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+// Run runs the conformance tests. newStore is called by every test and has to return a store without blocks and
+// transactions, it is responsible for closing the store and deleting its data when the test has finished, e.g. with
+// t.Cleanup.
+func Run(t *testing.T, newStore func(t *testing.T) store.BlocktxStore) {
+	ctx := context.Background()
+
+	block1 := &blocktx_api.Block{
+		Hash:         testdata.Block1Hash[:],
+		PreviousHash: make([]byte, 32),
+		MerkleRoot:   testdata.TX1Hash[:],
+		Height:       100,
+		Status:       blocktx_api.Status_LONGEST,
+		Processed:    true,
+	}
+	block2 := &blocktx_api.Block{
+		Hash:         testdata.Block2Hash[:],
+		PreviousHash: testdata.Block1Hash[:],
+		MerkleRoot:   testdata.TX2Hash[:],
+		Height:       101,
+		Status:       blocktx_api.Status_LONGEST,
+		Processed:    true,
+	}
+
+	t.Run("upsert and get block", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		id, err := sut.UpsertBlock(ctx, block1)
+		require.NoError(t, err)
+		err = sut.MarkBlockAsDone(ctx, testdata.Block1Hash, 1000, 1)
+		require.NoError(t, err)
+
+		// then
+		actual, err := sut.GetBlock(ctx, testdata.Block1Hash)
+		require.NoError(t, err)
+		assert.Equal(t, block1, actual)
+
+		// when
+		orphaned := &blocktx_api.Block{
+			Hash:         block1.Hash,
+			PreviousHash: block1.PreviousHash,
+			MerkleRoot:   block1.MerkleRoot,
+			Height:       block1.Height,
+			Status:       blocktx_api.Status_ORPHANED,
+			Processed:    true,
+		}
+		orphanedID, err := sut.UpsertBlock(ctx, orphaned)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, id, orphanedID)
+
+		actual, err = sut.GetBlock(ctx, testdata.Block1Hash)
+		require.NoError(t, err)
+		assert.Equal(t, blocktx_api.Status_ORPHANED, actual.Status)
+	})
+
+	t.Run("get unknown block", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		_, err := sut.GetBlock(ctx, testdata.Block1Hash)
+
+		// then
+		require.ErrorIs(t, err, store.ErrBlockNotFound)
+	})
+
+	t.Run("chain tip and block by height", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		for _, block := range []*blocktx_api.Block{block1, block2} {
+			_, err := sut.UpsertBlock(ctx, block)
+			require.NoError(t, err)
+		}
+		require.NoError(t, sut.MarkBlockAsDone(ctx, testdata.Block1Hash, 1000, 1))
+		require.NoError(t, sut.MarkBlockAsDone(ctx, testdata.Block2Hash, 1000, 1))
+
+		// when
+		tip, err := sut.GetChainTip(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, block2.Hash, tip.Hash)
+		assert.Equal(t, uint64(101), tip.Height)
+
+		// when
+		actual, err := sut.GetLongestBlockByHeight(ctx, 100)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, block1.Hash, actual.Hash)
+
+		// when
+		_, err = sut.GetLongestBlockByHeight(ctx, 99)
+
+		// then
+		require.ErrorIs(t, err, store.ErrBlockNotFound)
+	})
+
+	t.Run("register transactions", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		rowsAffected, err := sut.RegisterTransactions(ctx, [][]byte{testdata.TX1Hash[:], testdata.TX2Hash[:]})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), rowsAffected)
+
+		// when
+		rowsAffected, err = sut.RegisterTransactions(ctx, [][]byte{testdata.TX1Hash[:]})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), rowsAffected)
+
+		// when
+		registered, err := sut.GetRegisteredTransactions(ctx, [][]byte{testdata.TX1Hash[:], testdata.TX3Hash[:]})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{testdata.TX1Hash[:]}, registered)
+	})
+
+	t.Run("mined transactions", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		blockID, err := sut.UpsertBlock(ctx, block1)
+		require.NoError(t, err)
+
+		// when
+		err = sut.InsertBlockTransactions(ctx, blockID, []store.TxHashWithMerkleTreeIndex{
+			{Hash: testdata.TX1Hash[:], MerkleTreeIndex: 1},
+			{Hash: testdata.TX2Hash[:], MerkleTreeIndex: 2},
+		})
+		require.NoError(t, err)
+		err = sut.MarkBlockAsDone(ctx, testdata.Block1Hash, 1000, 2)
+		require.NoError(t, err)
+
+		// then
+		mined, err := sut.GetMinedTransactions(ctx, [][]byte{testdata.TX1Hash[:], testdata.TX3Hash[:]})
+		require.NoError(t, err)
+		require.Len(t, mined, 1)
+		assert.Equal(t, testdata.TX1Hash[:], mined[0].TxHash)
+		assert.Equal(t, block1.Hash, mined[0].BlockHash)
+		assert.Equal(t, block1.Height, mined[0].BlockHeight)
+		assert.Equal(t, int64(1), mined[0].MerkleTreeIndex)
+		assert.Equal(t, blocktx_api.Status_LONGEST, mined[0].BlockStatus)
+
+		hashes, err := sut.GetBlockTransactionsHashes(ctx, block1.Hash)
+		require.NoError(t, err)
+		assert.Len(t, hashes, 2)
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		err := sut.Ping(ctx)
+
+		// then
+		require.NoError(t, err)
+	})
+}
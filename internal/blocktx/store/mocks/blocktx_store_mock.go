@@ -55,6 +55,12 @@ var _ store.BlocktxStore = &BlocktxStoreMock{}
 //			GetOrphansForwardFromHashFunc: func(ctx context.Context, hash []byte) ([]*blocktx_api.Block, error) {
 //				panic("mock out the GetOrphansForwardFromHash method")
 //			},
+//			GetProcessedBlocksFunc: func(ctx context.Context, fromHeight uint64, limit uint64) ([]*blocktx_api.Block, error) {
+//				panic("mock out the GetProcessedBlocks method")
+//			},
+//			GetRegisteredTransactionsFunc: func(ctx context.Context, txHashes [][]byte) ([][]byte, error) {
+//				panic("mock out the GetRegisteredTransactions method")
+//			},
 //			GetRegisteredTxsByBlockHashesFunc: func(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransaction, error) {
 //				panic("mock out the GetRegisteredTxsByBlockHashes method")
 //			},
@@ -134,6 +140,12 @@ type BlocktxStoreMock struct {
 	// GetOrphansForwardFromHashFunc mocks the GetOrphansForwardFromHash method.
 	GetOrphansForwardFromHashFunc func(ctx context.Context, hash []byte) ([]*blocktx_api.Block, error)
 
+	// GetProcessedBlocksFunc mocks the GetProcessedBlocks method.
+	GetProcessedBlocksFunc func(ctx context.Context, fromHeight uint64, limit uint64) ([]*blocktx_api.Block, error)
+
+	// GetRegisteredTransactionsFunc mocks the GetRegisteredTransactions method.
+	GetRegisteredTransactionsFunc func(ctx context.Context, txHashes [][]byte) ([][]byte, error)
+
 	// GetRegisteredTxsByBlockHashesFunc mocks the GetRegisteredTxsByBlockHashes method.
 	GetRegisteredTxsByBlockHashesFunc func(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransaction, error)
 
@@ -248,6 +260,22 @@ type BlocktxStoreMock struct {
 			// Hash is the hash argument value.
 			Hash []byte
 		}
+		// GetProcessedBlocks holds details about calls to the GetProcessedBlocks method.
+		GetProcessedBlocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FromHeight is the fromHeight argument value.
+			FromHeight uint64
+			// Limit is the limit argument value.
+			Limit uint64
+		}
+		// GetRegisteredTransactions holds details about calls to the GetRegisteredTransactions method.
+		GetRegisteredTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxHashes is the txHashes argument value.
+			TxHashes [][]byte
+		}
 		// GetRegisteredTxsByBlockHashes holds details about calls to the GetRegisteredTxsByBlockHashes method.
 		GetRegisteredTxsByBlockHashes []struct {
 			// Ctx is the ctx argument value.
@@ -359,6 +387,8 @@ type BlocktxStoreMock struct {
 	lockGetMinedTransactions              sync.RWMutex
 	lockGetOrphansBackToNonOrphanAncestor sync.RWMutex
 	lockGetOrphansForwardFromHash         sync.RWMutex
+	lockGetProcessedBlocks                sync.RWMutex
+	lockGetRegisteredTransactions         sync.RWMutex
 	lockGetRegisteredTxsByBlockHashes     sync.RWMutex
 	lockGetStaleChainBackFromHash         sync.RWMutex
 	lockGetStats                          sync.RWMutex
@@ -761,6 +791,82 @@ func (mock *BlocktxStoreMock) GetOrphansForwardFromHashCalls() []struct {
 	return calls
 }
 
+// GetProcessedBlocks calls GetProcessedBlocksFunc.
+func (mock *BlocktxStoreMock) GetProcessedBlocks(ctx context.Context, fromHeight uint64, limit uint64) ([]*blocktx_api.Block, error) {
+	if mock.GetProcessedBlocksFunc == nil {
+		panic("BlocktxStoreMock.GetProcessedBlocksFunc: method is nil but BlocktxStore.GetProcessedBlocks was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		FromHeight uint64
+		Limit      uint64
+	}{
+		Ctx:        ctx,
+		FromHeight: fromHeight,
+		Limit:      limit,
+	}
+	mock.lockGetProcessedBlocks.Lock()
+	mock.calls.GetProcessedBlocks = append(mock.calls.GetProcessedBlocks, callInfo)
+	mock.lockGetProcessedBlocks.Unlock()
+	return mock.GetProcessedBlocksFunc(ctx, fromHeight, limit)
+}
+
+// GetProcessedBlocksCalls gets all the calls that were made to GetProcessedBlocks.
+// Check the length with:
+//
+//	len(mockedBlocktxStore.GetProcessedBlocksCalls())
+func (mock *BlocktxStoreMock) GetProcessedBlocksCalls() []struct {
+	Ctx        context.Context
+	FromHeight uint64
+	Limit      uint64
+} {
+	var calls []struct {
+		Ctx        context.Context
+		FromHeight uint64
+		Limit      uint64
+	}
+	mock.lockGetProcessedBlocks.RLock()
+	calls = mock.calls.GetProcessedBlocks
+	mock.lockGetProcessedBlocks.RUnlock()
+	return calls
+}
+
+// GetRegisteredTransactions calls GetRegisteredTransactionsFunc.
+func (mock *BlocktxStoreMock) GetRegisteredTransactions(ctx context.Context, txHashes [][]byte) ([][]byte, error) {
+	if mock.GetRegisteredTransactionsFunc == nil {
+		panic("BlocktxStoreMock.GetRegisteredTransactionsFunc: method is nil but BlocktxStore.GetRegisteredTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		TxHashes [][]byte
+	}{
+		Ctx:      ctx,
+		TxHashes: txHashes,
+	}
+	mock.lockGetRegisteredTransactions.Lock()
+	mock.calls.GetRegisteredTransactions = append(mock.calls.GetRegisteredTransactions, callInfo)
+	mock.lockGetRegisteredTransactions.Unlock()
+	return mock.GetRegisteredTransactionsFunc(ctx, txHashes)
+}
+
+// GetRegisteredTransactionsCalls gets all the calls that were made to GetRegisteredTransactions.
+// Check the length with:
+//
+//	len(mockedBlocktxStore.GetRegisteredTransactionsCalls())
+func (mock *BlocktxStoreMock) GetRegisteredTransactionsCalls() []struct {
+	Ctx      context.Context
+	TxHashes [][]byte
+} {
+	var calls []struct {
+		Ctx      context.Context
+		TxHashes [][]byte
+	}
+	mock.lockGetRegisteredTransactions.RLock()
+	calls = mock.calls.GetRegisteredTransactions
+	mock.lockGetRegisteredTransactions.RUnlock()
+	return calls
+}
+
 // GetRegisteredTxsByBlockHashes calls GetRegisteredTxsByBlockHashesFunc.
 func (mock *BlocktxStoreMock) GetRegisteredTxsByBlockHashes(ctx context.Context, blockHashes [][]byte) ([]store.BlockTransaction, error) {
 	if mock.GetRegisteredTxsByBlockHashesFunc == nil {
@@ -31,6 +31,9 @@ type BlockTransaction struct {
 	MerkleTreeIndex int64
 	BlockStatus     blocktx_api.Status
 	MerkleRoot      []byte
+	// NormalizedHash is the registered normalized hash if the transaction is a malleated variant of a registered
+	// transaction, otherwise it is nil
+	NormalizedHash []byte
 }
 
 type BlockStatusUpdate struct {
@@ -8,8 +8,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func (p *PostgreSQL) LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*blocktx_api.Block, error) {
-	q := `
+const selectBlocksWithCoinbase = `
 		SELECT
 			hash
 		 ,prevhash
@@ -18,7 +17,13 @@ func (p *PostgreSQL) LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*b
 		 ,processed_at
 		 ,status
 		 ,chainwork
-		FROM blocktx.blocks
+		 ,COALESCE(coinbase_reward, 0)
+		 ,COALESCE(miner_tag, '')
+		 ,timestamp
+		FROM blocktx.blocks`
+
+func (p *PostgreSQL) LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*blocktx_api.Block, error) {
+	q := selectBlocksWithCoinbase + `
 		WHERE is_longest = true AND processed_at IS NOT NULL order by height desc LIMIT $1`
 
 	rows, err := p.db.QueryContext(ctx, q, numOfBlocks)
@@ -27,11 +32,31 @@ func (p *PostgreSQL) LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*b
 	}
 	defer rows.Close()
 
+	return scanBlocksWithCoinbase(rows)
+}
+
+// GetProcessedBlocks returns at most limit processed blocks of the longest chain in ascending order of height starting
+// at fromHeight.
+func (p *PostgreSQL) GetProcessedBlocks(ctx context.Context, fromHeight uint64, limit uint64) ([]*blocktx_api.Block, error) {
+	q := selectBlocksWithCoinbase + `
+		WHERE height >= $1 AND is_longest = true AND processed_at IS NOT NULL order by height asc LIMIT $2`
+
+	rows, err := p.db.QueryContext(ctx, q, fromHeight, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanBlocksWithCoinbase(rows)
+}
+
+func scanBlocksWithCoinbase(rows *sql.Rows) ([]*blocktx_api.Block, error) {
 	blocks := make([]*blocktx_api.Block, 0)
 
 	for rows.Next() {
 		var block blocktx_api.Block
 		var processedAt sql.NullTime
+		var timestamp sql.NullTime
 		err := rows.Scan(
 			&block.Hash,
 			&block.PreviousHash,
@@ -40,6 +65,9 @@ func (p *PostgreSQL) LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*b
 			&processedAt,
 			&block.Status,
 			&block.Chainwork,
+			&block.CoinbaseReward,
+			&block.MinerTag,
+			&timestamp,
 		)
 		if err != nil {
 			return nil, err
@@ -49,8 +77,12 @@ func (p *PostgreSQL) LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*b
 			block.ProcessedAt = timestamppb.New(processedAt.Time.UTC())
 		}
 
+		if timestamp.Valid {
+			block.Timestamp = timestamppb.New(timestamp.Time.UTC())
+		}
+
 		blocks = append(blocks, &block)
 	}
 
-	return blocks, nil
+	return blocks, rows.Err()
 }
@@ -7,26 +7,39 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/stdlib"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
-// InsertBlockTransactions inserts the transaction hashes for a given block hash
+const (
+	stagingTable = "block_transactions_staging"
+
+	// the staging table is a temporary table of the session, which is emptied at the end of each transaction
+	createStagingTableQuery = `CREATE TEMPORARY TABLE IF NOT EXISTS block_transactions_staging (
+		hash BYTEA NOT NULL,
+		merkle_tree_index BIGINT
+	) ON COMMIT DELETE ROWS`
+
+	// duplicates within the staged rows would fail the upsert, therefore only one row per hash is merged
+	mergeStagedBlockTransactionsQuery = `INSERT INTO blocktx.block_transactions (block_id, hash, merkle_tree_index)
+		SELECT DISTINCT ON (s.hash) $1::BIGINT, s.hash, s.merkle_tree_index
+		FROM block_transactions_staging s
+		ORDER BY s.hash
+		ON CONFLICT (hash, block_id) DO UPDATE SET merkle_tree_index = EXCLUDED.merkle_tree_index
+		WHERE blocktx.block_transactions.merkle_tree_index IS DISTINCT FROM EXCLUDED.merkle_tree_index`
+)
+
+// InsertBlockTransactions inserts the transaction hashes for a given block hash. The transactions are streamed with
+// COPY into a staging table and merged into the block transactions with a single upsert, so that transactions which
+// are already stored for the block update their merkle tree index instead of failing the whole batch.
 func (p *PostgreSQL) InsertBlockTransactions(ctx context.Context, blockID uint64, txsWithMerklePaths []store.TxHashWithMerkleTreeIndex) (err error) {
 	ctx, span := tracing.StartTracing(ctx, "InsertBlockTransactions", p.tracingEnabled, append(p.tracingAttributes, attribute.Int("updates", len(txsWithMerklePaths)))...)
 	defer func() {
 		tracing.EndTracing(span, err)
 	}()
 
-	copyRows := make([][]any, len(txsWithMerklePaths))
-
-	for pos, tx := range txsWithMerklePaths {
-		copyRows[pos] = []any{blockID, tx.Hash, tx.MerkleTreeIndex}
-	}
-
 	conn, err := p.db.Conn(ctx)
 	if err != nil {
 		return err
@@ -34,32 +47,42 @@ func (p *PostgreSQL) InsertBlockTransactions(ctx context.Context, blockID uint64
 
 	defer conn.Close()
 
-	err = conn.Raw(func(driverConn any) error {
+	return conn.Raw(func(driverConn any) error {
 		c, ok := driverConn.(*stdlib.Conn)
 		if !ok {
 			return errors.New("driverConn.(*stdlib.Conn) conversion failed")
 		}
-		conn := c.Conn() // conn is a *pgx.Conn
-		var pqErr *pgconn.PgError
 
-		_, err = conn.CopyFrom(
+		tx, err := c.Conn().Begin(ctx) // c.Conn() is a *pgx.Conn
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = tx.Rollback(ctx)
+		}()
+
+		_, err = tx.Exec(ctx, createStagingTableQuery)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CopyFrom(
 			ctx,
-			pgx.Identifier{"blocktx", "block_transactions"},
-			[]string{"block_id", "hash", "merkle_tree_index"},
-			pgx.CopyFromRows(copyRows),
+			pgx.Identifier{stagingTable},
+			[]string{"hash", "merkle_tree_index"},
+			pgx.CopyFromSlice(len(txsWithMerklePaths), func(i int) ([]any, error) {
+				return []any{txsWithMerklePaths[i].Hash, txsWithMerklePaths[i].MerkleTreeIndex}, nil
+			}),
 		)
-
-		// Error 23505 is: "duplicate key violates unique constraint"
-		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
-			// ON CONFLICT DO NOTHING
-			err = nil
+		if err != nil {
+			return err
 		}
+
+		_, err = tx.Exec(ctx, mergeStagedBlockTransactionsQuery, blockID)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return tx.Commit(ctx)
 	})
-
-	return nil
 }
@@ -0,0 +1,3 @@
+ALTER TABLE blocktx.blocks DROP COLUMN timestamp;
+ALTER TABLE blocktx.blocks DROP COLUMN miner_tag;
+ALTER TABLE blocktx.blocks DROP COLUMN coinbase_reward;
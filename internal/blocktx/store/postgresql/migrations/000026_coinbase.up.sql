@@ -0,0 +1,3 @@
+ALTER TABLE blocktx.blocks ADD COLUMN coinbase_reward BIGINT NULL;
+ALTER TABLE blocktx.blocks ADD COLUMN miner_tag TEXT NULL;
+ALTER TABLE blocktx.blocks ADD COLUMN timestamp TIMESTAMPTZ NULL;
@@ -23,6 +23,7 @@ import (
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
+	"github.com/bitcoin-sv/arc/internal/blocktx/store/conformance"
 )
 
 type Block struct {
@@ -72,6 +73,16 @@ func TestMain(m *testing.M) {
 }
 
 func testmain(m *testing.M) int {
+	// the user-provided database has been requested explicitly, so failing to migrate it fails the tests
+	userDBInfo, err := testutils.MigrateUserPostgresql("blocktx", migrationsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if userDBInfo != "" {
+		dbInfo = userDBInfo
+		return m.Run()
+	}
+
 	pool, err := dockertest.NewPool("")
 	if err != nil {
 		log.Printf("failed to create pool: %v", err)
@@ -232,6 +243,32 @@ func TestPostgresDB(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, 3, len(blocks))
 		require.Equal(t, uint64(822015), blocks[0].Height)
+		require.Equal(t, uint64(625123456), blocks[0].CoinbaseReward)
+		require.Equal(t, "/TAAL/", blocks[0].MinerTag)
+		require.Equal(t, time.Date(2023, 12, 15, 14, 50, 0, 0, time.UTC), blocks[0].Timestamp.AsTime())
+		require.Empty(t, blocks[1].MinerTag)
+		require.Nil(t, blocks[1].Timestamp)
+	})
+
+	t.Run("get processed blocks", func(t *testing.T) {
+		// given
+		prepareDb(t, postgresDB, "fixtures/latest_blocks")
+
+		// when
+		blocks, err := postgresDB.GetProcessedBlocks(context.Background(), 822013, 2)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, blocks, 2)
+		require.Equal(t, uint64(822013), blocks[0].Height)
+		require.Equal(t, uint64(822014), blocks[1].Height)
+
+		// blocks which are not on the longest chain are not returned
+		blocks, err = postgresDB.GetProcessedBlocks(context.Background(), 822015, 10)
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		require.Equal(t, uint64(822015), blocks[0].Height)
+		require.Equal(t, "/TAAL/", blocks[0].MinerTag)
 	})
 
 	t.Run("get block gaps", func(t *testing.T) {
@@ -822,6 +859,54 @@ func TestPostgresStore_InsertBlockTransactions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "insert 2 existing and 2 new",
+			txsWithMerklePaths: []store.TxHashWithMerkleTreeIndex{
+				{
+					Hash:            testutils.RevChainhash(t, "76732b80598326a18d3bf0a86518adbdf95d0ddc6ff6693004440f4776168c3b")[:],
+					MerkleTreeIndex: int64(1),
+				},
+				{
+					Hash:            testutils.RevChainhash(t, "6b86e32c1896ff25fb2d857b96484b86c44444f3796bafb456c51a67a19a3c93")[:],
+					MerkleTreeIndex: int64(2),
+				},
+				{
+					Hash:            testutils.RevChainhash(t, "164e85a5d5bc2b2372e8feaa266e5e4b7d0808f8d2b784fb1f7349c4726392b0")[:],
+					MerkleTreeIndex: int64(3),
+				},
+				{
+					Hash:            testutils.RevChainhash(t, "f27a3609d133eef8abaf17bf19a1481da265e39b82be91b76f8f4ac964907f36")[:],
+					MerkleTreeIndex: int64(4),
+				},
+			},
+		},
+		{
+			name: "insert duplicates",
+			txsWithMerklePaths: []store.TxHashWithMerkleTreeIndex{
+				{
+					Hash:            testutils.RevChainhash(t, "8088f5e915be6dba137080c031cb6ca2fcce6d44c7c0193f52d9f058673517f8")[:],
+					MerkleTreeIndex: int64(1),
+				},
+				{
+					Hash:            testutils.RevChainhash(t, "8088f5e915be6dba137080c031cb6ca2fcce6d44c7c0193f52d9f058673517f8")[:],
+					MerkleTreeIndex: int64(1),
+				},
+			},
+		},
+		{
+			name: "insert 2 new twice",
+			txsWithMerklePaths: []store.TxHashWithMerkleTreeIndex{
+				{
+					Hash:            testutils.RevChainhash(t, "58f803957943b70ac9161b9327065d9798e80b21bae82e9f7e0bf874aa143ed5")[:],
+					MerkleTreeIndex: int64(1),
+				},
+				{
+					Hash:            testutils.RevChainhash(t, "f4a7f2ad6d0f4be651698b75fe0a816e7bc546097c6dc0acb281298dbf844f13")[:],
+					MerkleTreeIndex: int64(2),
+				},
+			},
+			upsertRepeat: true,
+		},
 	}
 
 	// common setup for test cases
@@ -851,15 +936,22 @@ func TestPostgresStore_InsertBlockTransactions(t *testing.T) {
 			require.NoError(t, err)
 
 			hashes := make([][]byte, len(tc.txsWithMerklePaths))
+			expectedIndexes := make(map[string]int64)
 			for i, tx := range tc.txsWithMerklePaths {
 				hashes[i] = tx.Hash
+				expectedIndexes[string(tx.Hash)] = tx.MerkleTreeIndex
 			}
 
 			var bt []BlockTransaction
-			err = d.Select(&bt, "SELECT block_id, hash, merkle_tree_index from blocktx.block_transactions bt WHERE bt.hash = ANY($1)", pq.Array(hashes))
+			err = d.Select(&bt, "SELECT block_id, hash, merkle_tree_index from blocktx.block_transactions bt WHERE bt.hash = ANY($1) AND bt.block_id = $2", pq.Array(hashes), testBlockID)
 			require.NoError(t, err, "error during getting block transactions map")
 
-			require.Len(t, bt, len(tc.txsWithMerklePaths))
+			// existing transactions of the block are updated with the merkle tree index
+			actualIndexes := make(map[string]int64)
+			for _, tx := range bt {
+				actualIndexes[string(tx.Hash)] = tx.MerkleTreeIndex
+			}
+			require.Equal(t, expectedIndexes, actualIndexes)
 		})
 	}
 }
@@ -944,6 +1036,8 @@ func BenchmarkInsertBlockTransactions(b *testing.B) {
 				err := sut.InsertBlockTransactions(ctx, testBlockID, txsWithMerklePaths[i*tc.batch:(i+1)*tc.batch-1])
 				require.NoError(b, err)
 			}
+
+			b.ReportMetric(float64(totalRows)/b.Elapsed().Seconds(), "rows/s")
 		})
 	}
 }
@@ -1081,6 +1175,27 @@ func TestPostgresStore_RegisterTransactions(t *testing.T) {
 	}
 }
 
+func TestPostgresStore_GetRegisteredTransactions(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	// given
+	ctx, _, sut := setupPostgresTest(t)
+	defer sut.Close()
+
+	prepareDb(t, sut, "fixtures/register_transactions")
+
+	registered := testutils.RevChainhash(t, "b4201cc6fc5768abff14adf75042ace6061da9176ee5bb943291b9ba7d7f5743")[:]
+
+	// when
+	actual, err := sut.GetRegisteredTransactions(ctx, [][]byte{registered, testdata.TX1Hash[:]})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{registered}, actual)
+}
+
 func TestUpsertBlockConditions(t *testing.T) {
 	tt := []struct {
 		name            string
@@ -1210,3 +1325,21 @@ func TestUpsertBlockConditions(t *testing.T) {
 		})
 	}
 }
+
+func TestConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	conformance.Run(t, func(t *testing.T) store.BlocktxStore {
+		postgresDB, err := New(dbInfo, 10, 10)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			prepareDb(t, postgresDB, "")
+			testutils.PruneTables(t, postgresDB.db, "blocktx.registered_transactions")
+			_ = postgresDB.Close()
+		})
+
+		return postgresDB
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/lib/pq"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/store"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
 func (p *PostgreSQL) RegisterTransactions(ctx context.Context, txHashes [][]byte) (int64, error) {
@@ -29,3 +30,32 @@ func (p *PostgreSQL) RegisterTransactions(ctx context.Context, txHashes [][]byte
 
 	return rowsAffected, nil
 }
+
+// GetRegisteredTransactions returns those of the given hashes which are registered.
+func (p *PostgreSQL) GetRegisteredTransactions(ctx context.Context, txHashes [][]byte) (registeredHashes [][]byte, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetRegisteredTransactions", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	const q = `SELECT hash FROM blocktx.registered_transactions WHERE hash = ANY($1)`
+
+	rows, err := p.db.QueryContext(ctx, q, pq.Array(txHashes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	registeredHashes = make([][]byte, 0)
+	for rows.Next() {
+		var hash []byte
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+
+		registeredHashes = append(registeredHashes, hash)
+	}
+
+	return registeredHashes, rows.Err()
+}
@@ -2,6 +2,7 @@ package postgresql
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
@@ -23,10 +24,10 @@ func (p *PostgreSQL) UpsertBlock(ctx context.Context, block *blocktx_api.Block)
 	// (probably because of another block which is being inserted by another blocktx instance at the same time)
 	// and requires the block to be received and processed again.
 	qInsert := `
-		INSERT INTO blocktx.blocks (hash, prevhash, merkleroot, height, status, chainwork, is_longest)
-		SELECT v.hash, v.prevhash, v.merkleroot, v.height, v.status, v.chainwork, v.is_longest
-		FROM (VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4::BIGINT, $5::INTEGER, $6::TEXT, $7::BOOLEAN))
-				AS v(hash, prevhash, merkleroot, height, status, chainwork, is_longest)
+		INSERT INTO blocktx.blocks (hash, prevhash, merkleroot, height, status, chainwork, is_longest, coinbase_reward, miner_tag, timestamp)
+		SELECT v.hash, v.prevhash, v.merkleroot, v.height, v.status, v.chainwork, v.is_longest, v.coinbase_reward, v.miner_tag, v.timestamp
+		FROM (VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA, $4::BIGINT, $5::INTEGER, $6::TEXT, $7::BOOLEAN, $11::BIGINT, $12::TEXT, $13::TIMESTAMPTZ))
+				AS v(hash, prevhash, merkleroot, height, status, chainwork, is_longest, coinbase_reward, miner_tag, timestamp)
 		LEFT JOIN blocktx.blocks AS prevblock ON prevblock.hash = v.prevhash
 		WHERE ((v.status = $8 OR v.status = $9) AND prevblock.id IS NULL)
 				OR prevblock.status = $5
@@ -35,6 +36,11 @@ func (p *PostgreSQL) UpsertBlock(ctx context.Context, block *blocktx_api.Block)
 		RETURNING id
 	`
 
+	var timestamp sql.NullTime
+	if block.GetTimestamp() != nil {
+		timestamp = sql.NullTime{Time: block.GetTimestamp().AsTime(), Valid: true}
+	}
+
 	row := p.db.QueryRowContext(ctx, qInsert,
 		block.GetHash(),
 		block.GetPreviousHash(),
@@ -46,6 +52,9 @@ func (p *PostgreSQL) UpsertBlock(ctx context.Context, block *blocktx_api.Block)
 		blocktx_api.Status_ORPHANED,
 		blocktx_api.Status_LONGEST,
 		blocktx_api.Status_STALE,
+		block.GetCoinbaseReward(),
+		block.GetMinerTag(),
+		timestamp,
 	)
 
 	err = row.Scan(&blockID)
@@ -34,6 +34,7 @@ type Stats struct {
 type BlocktxStore interface {
 	UnorphanRecentWrongOrphans(ctx context.Context) (healedOrphans []*blocktx_api.Block, err error)
 	RegisterTransactions(ctx context.Context, txHashes [][]byte) (rowsAffected int64, err error)
+	GetRegisteredTransactions(ctx context.Context, txHashes [][]byte) (registeredHashes [][]byte, err error)
 	GetBlock(ctx context.Context, hash *chainhash.Hash) (*blocktx_api.Block, error)
 	GetLongestBlockByHeight(ctx context.Context, height uint64) (*blocktx_api.Block, error)
 	GetChainTip(ctx context.Context) (*blocktx_api.Block, error)
@@ -52,6 +53,7 @@ type BlocktxStore interface {
 	UpdateBlocksStatuses(ctx context.Context, blockStatusUpdates []BlockStatusUpdate) error
 	GetStats(ctx context.Context) (*Stats, error)
 	LatestBlocks(ctx context.Context, numOfBlocks uint64) ([]*blocktx_api.Block, error)
+	GetProcessedBlocks(ctx context.Context, fromHeight uint64, limit uint64) ([]*blocktx_api.Block, error)
 
 	SetBlockProcessing(ctx context.Context, hash *chainhash.Hash, setProcessedBy string, lockTime time.Duration, maxParallelProcessing int) (string, error)
 	VerifyMerkleRoots(ctx context.Context, merkleRoots []*blocktx_api.MerkleRootVerificationRequest, maxAllowedBlockHeightMismatch uint64) (*blocktx_api.MerkleRootVerificationResponse, error)
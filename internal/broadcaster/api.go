@@ -9,11 +9,18 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/pkg/api"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+)
+
+const (
+	arcRetries      = 3
+	arcRetryBackoff = time.Second
 )
 
 var (
@@ -198,17 +205,12 @@ func GetArcClient(arcServer string, auth *Auth) (*api.Client, error) {
 		return nil, ErrInvalidARCUrl
 	}
 
-	opts := make([]api.ClientOption, 0)
-
+	httpOpts := []func(*httpclient.Client){httpclient.WithRetries(arcRetries, arcRetryBackoff)}
 	if auth != nil && auth.Authorization != "" {
-		// custom provider
-		opts = append(opts, api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
-			req.Header.Add("Authorization", auth.Authorization)
-			return nil
-		}))
+		httpOpts = append(httpOpts, httpclient.WithAuthorization(auth.Authorization))
 	}
 
-	arcClient, err := api.NewClient(arcServer, opts...)
+	arcClient, err := api.NewClient(arcServer, api.WithHTTPClient(httpclient.New("broadcaster", httpOpts...)))
 	if err != nil {
 		return nil, err
 	}
@@ -5,13 +5,13 @@ import (
 	"math"
 	"time"
 
-	primitives "github.com/bsv-blockchain/go-sdk/primitives/ec"
 	"github.com/bsv-blockchain/go-sdk/script"
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
-	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
+	sighash "github.com/bsv-blockchain/go-sdk/transaction/sighash"
 
 	"github.com/bitcoin-sv/arc/internal/varintutils"
+	"github.com/bitcoin-sv/arc/pkg/keyset"
 )
 
 func PayTo(tx *sdkTx.Transaction, s *script.Script, satoshis uint64) error {
@@ -26,12 +26,51 @@ func PayTo(tx *sdkTx.Transaction, s *script.Script, satoshis uint64) error {
 	return nil
 }
 
-func SignAllInputs(tx *sdkTx.Transaction, privKey *primitives.PrivateKey) error {
-	unlockingScriptTemplate, err := p2pkh.Unlock(privKey, nil)
+// p2pkhUnlocker unlocks P2PKH outputs with the signatures of a signer, equivalent to the P2PKH template of the SDK
+// which requires a private key.
+type p2pkhUnlocker struct {
+	signer keyset.Signer
+}
+
+func (u p2pkhUnlocker) Sign(tx *sdkTx.Transaction, inputIndex uint32) (*script.Script, error) {
+	if tx.Inputs[inputIndex].SourceTxOutput() == nil {
+		return nil, sdkTx.ErrEmptyPreviousTx
+	}
+
+	sh, err := tx.CalcInputSignatureHash(inputIndex, sighash.AllForkID)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	sig, err := u.signer.Sign(sh)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &script.Script{}
+	err = s.AppendPushData(append(sig.Serialize(), uint8(sighash.AllForkID)))
+	if err != nil {
+		return nil, err
+	}
+	err = s.AppendPushData(u.signer.PubKey().Compressed())
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (u p2pkhUnlocker) EstimateLength(_ *sdkTx.Transaction, _ uint32) uint32 {
+	return 106
+}
+
+func SignAllInputs(tx *sdkTx.Transaction, signer keyset.Signer) error {
+	if signer == nil {
+		return errors.New("no signer given")
 	}
 
+	unlockingScriptTemplate := p2pkhUnlocker{signer: signer}
+
 	for _, in := range tx.Inputs {
 		if in.UnlockingScriptTemplate != nil {
 			continue
@@ -40,7 +79,7 @@ func SignAllInputs(tx *sdkTx.Transaction, privKey *primitives.PrivateKey) error
 		in.UnlockingScriptTemplate = unlockingScriptTemplate
 	}
 
-	err = tx.Sign()
+	err := tx.Sign()
 	if err != nil {
 		return err
 	}
@@ -224,7 +224,7 @@ func (b *UTXORateBroadcaster) createSelfPayingTx(utxo *sdkTx.UTXO) (*sdkTx.Trans
 		return nil, errors.Join(ErrFailedToAddOutput, err)
 	}
 
-	err = SignAllInputs(tx, b.ks.PrivateKey)
+	err = SignAllInputs(tx, b.ks.Signer)
 	if err != nil {
 		return nil, errors.Join(ErrFailedToFillInputs, err)
 	}
@@ -173,7 +173,7 @@ func (b *UTXOConsolidator) consolidateToFundingKeyset(txsConsolidation *sdkTx.Tr
 		return err
 	}
 
-	err = SignAllInputs(tx, fundingKeySet.PrivateKey)
+	err = SignAllInputs(tx, fundingKeySet.Signer)
 	if err != nil {
 		return err
 	}
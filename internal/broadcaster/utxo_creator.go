@@ -204,7 +204,7 @@ func (b *UTXOCreator) splitToFundingKeyset(tx *sdkTx.Transaction, splitSatoshis,
 		return 0, errors.Join(ErrFailedToAddOutput, err)
 	}
 
-	err = SignAllInputs(tx, fundingKeySet.PrivateKey)
+	err = SignAllInputs(tx, fundingKeySet.Signer)
 	if err != nil {
 		return 0, err
 	}
@@ -82,7 +82,7 @@ func (b *UTXOSplitter) SplitUtxo(txid string, satoshis uint64, vout uint32, dryr
 		}
 	}
 
-	err = SignAllInputs(tx, b.fromKeySet.PrivateKey)
+	err = SignAllInputs(tx, b.fromKeySet.Signer)
 	if err != nil {
 		return err
 	}
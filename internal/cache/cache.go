@@ -18,7 +18,10 @@ var (
 type Store interface {
 	Get(key string) ([]byte, error)
 	Set(key string, value []byte, ttl time.Duration) error
+	SetNX(key string, value []byte, ttl time.Duration) (bool, error)
 	Del(keys ...string) error
+	// CompareAndDel removes the key only if it holds the value and returns whether it was removed.
+	CompareAndDel(key string, value []byte) (bool, error)
 
 	MapGet(hashsetKey string, field string) ([]byte, error)
 	MapGetAll(hashsetKey string) (map[string][]byte, error)
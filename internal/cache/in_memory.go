@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"bytes"
 	"errors"
 	"sync"
 	"time"
@@ -32,13 +33,14 @@ func (s *MemoryStore) Get(key string) ([]byte, error) {
 		return nil, ErrCacheNotFound
 	}
 
-	cacheItem, ok := value.(cacheItem)
+	cacheItem, ok := value.(*cacheItem)
 	if !ok {
 		return nil, ErrCacheFailedToGet
 	}
 
 	if time.Now().After(cacheItem.expiration) {
-		s.data.Delete(key)
+		// delete only the expired item, not one stored in the meantime
+		s.data.CompareAndDelete(key, value)
 		return nil, ErrCacheNotFound
 	}
 
@@ -48,13 +50,50 @@ func (s *MemoryStore) Get(key string) ([]byte, error) {
 // Set stores a key-value pair, ignoring the ttl parameter.
 func (s *MemoryStore) Set(key string, value []byte, ttl time.Duration) error {
 	expiration := time.Now().Add(ttl)
-	s.data.Store(key, cacheItem{
+	s.data.Store(key, &cacheItem{
 		expiration: expiration,
 		value:      value,
 	})
 	return nil
 }
 
+// SetNX stores a key-value pair if the key doesn't exist or is expired and returns whether the value was stored.
+func (s *MemoryStore) SetNX(key string, value []byte, ttl time.Duration) (bool, error) {
+	item := &cacheItem{
+		expiration: time.Now().Add(ttl),
+		value:      value,
+	}
+
+	existing, loaded := s.data.LoadOrStore(key, item)
+	if !loaded {
+		return true, nil
+	}
+
+	existingItem, ok := existing.(*cacheItem)
+	if ok && !time.Now().After(existingItem.expiration) {
+		return false, nil
+	}
+
+	// replace the expired item unless another caller replaced it first, the items are compared by pointer
+	return s.data.CompareAndSwap(key, existing, item), nil
+}
+
+// CompareAndDel removes the key only if it holds the value and returns whether it was removed.
+func (s *MemoryStore) CompareAndDel(key string, value []byte) (bool, error) {
+	existing, found := s.data.Load(key)
+	if !found {
+		return false, nil
+	}
+
+	existingItem, ok := existing.(*cacheItem)
+	if !ok || time.Now().After(existingItem.expiration) || !bytes.Equal(existingItem.value, value) {
+		return false, nil
+	}
+
+	// delete the item unless another caller replaced it since it was compared
+	return s.data.CompareAndDelete(key, existing), nil
+}
+
 // Del removes a key from the store.
 func (s *MemoryStore) Del(keys ...string) error {
 	for _, k := range keys {
@@ -67,16 +106,12 @@ func (s *MemoryStore) startClearCache(interval time.Duration) {
 	go func() {
 		for now := range time.Tick(interval) {
 			s.data.Range(func(key, value any) bool {
-				cacheItem, ok := value.(cacheItem)
+				cacheItem, ok := value.(*cacheItem)
 				if !ok {
 					return true // continue iteration
 				}
 				if now.After(cacheItem.expiration) {
-					item, ok := key.(string)
-					if !ok {
-						return true
-					}
-					s.data.Delete(item)
+					s.data.CompareAndDelete(key, value)
 				}
 				return true // continue iteration
 			})
@@ -78,6 +78,37 @@ func TestInMemoryCacheLifeCycle(t *testing.T) {
 		require.Nil(t, val)
 		require.ErrorIs(t, err, ErrCacheNotFound)
 
+		// when a key is set if it doesn't exist, then only the first value is stored until the ttl expires
+		stored, err := cStore.SetNX(key2, []byte("first"), ttl2)
+		require.NoError(t, err)
+		require.True(t, stored)
+
+		stored, err = cStore.SetNX(key2, []byte("second"), ttl2)
+		require.NoError(t, err)
+		require.False(t, stored)
+
+		val, err = cStore.Get(key2)
+		require.NoError(t, err)
+		require.Equal(t, []byte("first"), val)
+
+		time.Sleep(60 * time.Millisecond)
+
+		stored, err = cStore.SetNX(key2, []byte("third"), ttl2)
+		require.NoError(t, err)
+		require.True(t, stored)
+
+		// when a key is deleted if it holds the value, then only the matching value is deleted
+		deleted, err := cStore.CompareAndDel(key2, []byte("other"))
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		deleted, err = cStore.CompareAndDel(key2, []byte("third"))
+		require.NoError(t, err)
+		require.True(t, deleted)
+
+		_, err = cStore.Get(key2)
+		require.ErrorIs(t, err, ErrCacheNotFound)
+
 		//when a MapSet key/value is stored then no errors are expected
 		err = cStore.MapSet("hash", "key1", []byte("value1"))
 		require.NoError(t, err)
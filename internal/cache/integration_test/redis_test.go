@@ -1,4 +1,4 @@
-package cache
+package integrationtest
 
 import (
 	"context"
@@ -13,6 +13,7 @@ import (
 	"github.com/ory/dockertest/v3"
 	"github.com/stretchr/testify/require"
 
+	"github.com/bitcoin-sv/arc/internal/cache"
 	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
 )
 
@@ -85,7 +86,7 @@ func TestRedisClient(t *testing.T) {
 
 	setup()
 
-	redisStore := NewRedisStore(ctx, redisClient)
+	redisStore := cache.NewRedisStore(ctx, redisClient)
 
 	t.Run("get/set", func(t *testing.T) {
 		// given
@@ -100,10 +101,51 @@ func TestRedisClient(t *testing.T) {
 		//when
 		res, err = redisStore.Get("NonExistingKey")
 		//then
-		require.ErrorIs(t, err, ErrCacheNotFound)
+		require.ErrorIs(t, err, cache.ErrCacheNotFound)
 		require.Nil(t, res)
 	})
 
+	t.Run("set nx", func(t *testing.T) {
+		// when
+		stored, err := redisStore.SetNX("nxKey", []byte("first"), 4*time.Second)
+		require.NoError(t, err)
+		require.True(t, stored)
+
+		stored, err = redisStore.SetNX("nxKey", []byte("second"), 4*time.Second)
+		require.NoError(t, err)
+
+		// then
+		require.False(t, stored)
+		res, err := redisStore.Get("nxKey")
+		require.NoError(t, err)
+		require.Equal(t, "first", string(res))
+
+		err = redisStore.Del("nxKey")
+		require.NoError(t, err)
+	})
+
+	t.Run("compare and del", func(t *testing.T) {
+		// given
+		err := redisStore.Set("casKey", []byte("first"), 4*time.Second)
+		require.NoError(t, err)
+
+		// when
+		deleted, err := redisStore.CompareAndDel("casKey", []byte("second"))
+
+		// then
+		require.NoError(t, err)
+		require.False(t, deleted)
+
+		// when
+		deleted, err = redisStore.CompareAndDel("casKey", []byte("first"))
+
+		// then
+		require.NoError(t, err)
+		require.True(t, deleted)
+		_, err = redisStore.Get("casKey")
+		require.ErrorIs(t, err, cache.ErrCacheNotFound)
+	})
+
 	t.Run("del", func(t *testing.T) {
 		// given
 		err := redisStore.Set("key1", []byte("value1"), 4*time.Second)
@@ -121,7 +163,7 @@ func TestRedisClient(t *testing.T) {
 		// when
 		err = redisStore.Del([]string{"nonExistingKey"}...)
 		// then
-		require.ErrorIs(t, err, ErrCacheNotFound)
+		require.ErrorIs(t, err, cache.ErrCacheNotFound)
 	})
 
 	t.Run("map set/get", func(t *testing.T) {
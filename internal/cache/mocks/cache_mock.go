@@ -19,6 +19,9 @@ var _ cache.Store = &StoreMock{}
 //
 //		// make and configure a mocked cache.Store
 //		mockedStore := &StoreMock{
+//			CompareAndDelFunc: func(key string, value []byte) (bool, error) {
+//				panic("mock out the CompareAndDel method")
+//			},
 //			DelFunc: func(keys ...string) error {
 //				panic("mock out the Del method")
 //			},
@@ -46,6 +49,9 @@ var _ cache.Store = &StoreMock{}
 //			SetFunc: func(key string, value []byte, ttl time.Duration) error {
 //				panic("mock out the Set method")
 //			},
+//			SetNXFunc: func(key string, value []byte, ttl time.Duration) (bool, error) {
+//				panic("mock out the SetNX method")
+//			},
 //		}
 //
 //		// use mockedStore in code that requires cache.Store
@@ -53,6 +59,9 @@ var _ cache.Store = &StoreMock{}
 //
 //	}
 type StoreMock struct {
+	// CompareAndDelFunc mocks the CompareAndDel method.
+	CompareAndDelFunc func(key string, value []byte) (bool, error)
+
 	// DelFunc mocks the Del method.
 	DelFunc func(keys ...string) error
 
@@ -80,8 +89,18 @@ type StoreMock struct {
 	// SetFunc mocks the Set method.
 	SetFunc func(key string, value []byte, ttl time.Duration) error
 
+	// SetNXFunc mocks the SetNX method.
+	SetNXFunc func(key string, value []byte, ttl time.Duration) (bool, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// CompareAndDel holds details about calls to the CompareAndDel method.
+		CompareAndDel []struct {
+			// Key is the key argument value.
+			Key string
+			// Value is the value argument value.
+			Value []byte
+		}
 		// Del holds details about calls to the Del method.
 		Del []struct {
 			// Keys is the keys argument value.
@@ -139,7 +158,17 @@ type StoreMock struct {
 			// TTL is the ttl argument value.
 			TTL time.Duration
 		}
+		// SetNX holds details about calls to the SetNX method.
+		SetNX []struct {
+			// Key is the key argument value.
+			Key string
+			// Value is the value argument value.
+			Value []byte
+			// TTL is the ttl argument value.
+			TTL time.Duration
+		}
 	}
+	lockCompareAndDel sync.RWMutex
 	lockDel           sync.RWMutex
 	lockGet           sync.RWMutex
 	lockMapDel        sync.RWMutex
@@ -149,6 +178,43 @@ type StoreMock struct {
 	lockMapLen        sync.RWMutex
 	lockMapSet        sync.RWMutex
 	lockSet           sync.RWMutex
+	lockSetNX         sync.RWMutex
+}
+
+// CompareAndDel calls CompareAndDelFunc.
+func (mock *StoreMock) CompareAndDel(key string, value []byte) (bool, error) {
+	if mock.CompareAndDelFunc == nil {
+		panic("StoreMock.CompareAndDelFunc: method is nil but Store.CompareAndDel was just called")
+	}
+	callInfo := struct {
+		Key   string
+		Value []byte
+	}{
+		Key:   key,
+		Value: value,
+	}
+	mock.lockCompareAndDel.Lock()
+	mock.calls.CompareAndDel = append(mock.calls.CompareAndDel, callInfo)
+	mock.lockCompareAndDel.Unlock()
+	return mock.CompareAndDelFunc(key, value)
+}
+
+// CompareAndDelCalls gets all the calls that were made to CompareAndDel.
+// Check the length with:
+//
+//	len(mockedStore.CompareAndDelCalls())
+func (mock *StoreMock) CompareAndDelCalls() []struct {
+	Key   string
+	Value []byte
+} {
+	var calls []struct {
+		Key   string
+		Value []byte
+	}
+	mock.lockCompareAndDel.RLock()
+	calls = mock.calls.CompareAndDel
+	mock.lockCompareAndDel.RUnlock()
+	return calls
 }
 
 // Del calls DelFunc.
@@ -462,3 +528,43 @@ func (mock *StoreMock) SetCalls() []struct {
 	mock.lockSet.RUnlock()
 	return calls
 }
+
+// SetNX calls SetNXFunc.
+func (mock *StoreMock) SetNX(key string, value []byte, ttl time.Duration) (bool, error) {
+	if mock.SetNXFunc == nil {
+		panic("StoreMock.SetNXFunc: method is nil but Store.SetNX was just called")
+	}
+	callInfo := struct {
+		Key   string
+		Value []byte
+		TTL   time.Duration
+	}{
+		Key:   key,
+		Value: value,
+		TTL:   ttl,
+	}
+	mock.lockSetNX.Lock()
+	mock.calls.SetNX = append(mock.calls.SetNX, callInfo)
+	mock.lockSetNX.Unlock()
+	return mock.SetNXFunc(key, value, ttl)
+}
+
+// SetNXCalls gets all the calls that were made to SetNX.
+// Check the length with:
+//
+//	len(mockedStore.SetNXCalls())
+func (mock *StoreMock) SetNXCalls() []struct {
+	Key   string
+	Value []byte
+	TTL   time.Duration
+} {
+	var calls []struct {
+		Key   string
+		Value []byte
+		TTL   time.Duration
+	}
+	mock.lockSetNX.RLock()
+	calls = mock.calls.SetNX
+	mock.lockSetNX.RUnlock()
+	return calls
+}
@@ -45,6 +45,32 @@ func (r *RedisStore) Set(key string, value []byte, ttl time.Duration) error {
 	return nil
 }
 
+// SetNX stores a value with a TTL for key if the key doesn't exist yet and returns whether the value was stored.
+func (r *RedisStore) SetNX(key string, value []byte, ttl time.Duration) (bool, error) {
+	stored, err := r.client.SetNX(r.ctx, key, value, ttl).Result()
+	if err != nil {
+		return false, errors.Join(ErrCacheFailedToSet, err)
+	}
+
+	return stored, nil
+}
+
+// compareAndDelScript deletes the key only if it holds the value, atomically as scripts are executed atomically.
+var compareAndDelScript = redis.NewScript(`if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// CompareAndDel removes the key only if it holds the value and returns whether it was removed.
+func (r *RedisStore) CompareAndDel(key string, value []byte) (bool, error) {
+	deleted, err := compareAndDelScript.Run(r.ctx, r.client, []string{key}, value).Int64()
+	if err != nil {
+		return false, errors.Join(ErrCacheFailedToDel, err)
+	}
+
+	return deleted == 1, nil
+}
+
 // Del removes a value by key.
 func (r *RedisStore) Del(keys ...string) error {
 	result, err := r.client.Del(r.ctx, keys...).Result()
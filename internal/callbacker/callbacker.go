@@ -1,7 +1,7 @@
 package callbacker
 
 import (
-	"time"
+	"github.com/bitcoin-sv/arc/pkg/api"
 )
 
 type SenderI interface {
@@ -9,21 +9,26 @@ type SenderI interface {
 	SendBatch(url, token string, callbacks []*Callback) (success, retry bool)
 }
 
-type Callback struct {
-	Timestamp time.Time `json:"timestamp"`
-
-	CompetingTxs []string `json:"competingTxs,omitempty"`
+// Callback payload schema versions, see pkg/api.
+const (
+	CallbackVersion1      = api.CallbackVersion1
+	CallbackVersion2      = api.CallbackVersion2
+	CallbackVersionLatest = api.CallbackVersionLatest
+)
 
-	TxID       string  `json:"txid"`
-	TxStatus   string  `json:"txStatus"`
-	ExtraInfo  *string `json:"extraInfo,omitempty"`
-	MerklePath *string `json:"merklePath,omitempty"`
+// Callback and BatchCallback are the payloads sent to the callback URLs. They are defined in the public module pkg/api
+// which the receivers of callbacks use as well. Payload templates are executed with all fields regardless of the
+// version.
+type (
+	Callback      = api.Callback
+	BatchCallback = api.BatchCallback
+)
 
-	BlockHash   *string `json:"blockHash,omitempty"`
-	BlockHeight *uint64 `json:"blockHeight,omitempty"`
-}
+// payloadVersion returns the version field value of a payload for the requested schema version.
+func payloadVersion(version int32) int {
+	if version < CallbackVersion2 {
+		return 0
+	}
 
-type BatchCallback struct {
-	Count     int         `json:"count"`
-	Callbacks []*Callback `json:"callbacks,omitempty"`
+	return int(version)
 }
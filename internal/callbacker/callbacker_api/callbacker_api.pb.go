@@ -33,6 +33,7 @@ const (
 	Status_QUEUED                 Status = 10
 	Status_RECEIVED               Status = 20
 	Status_STORED                 Status = 30
+	Status_WAITING_FOR_FINALITY   Status = 35
 	Status_ANNOUNCED_TO_NETWORK   Status = 40
 	Status_REQUESTED_BY_NETWORK   Status = 50
 	Status_SENT_TO_NETWORK        Status = 60
@@ -40,6 +41,7 @@ const (
 	Status_SEEN_IN_ORPHAN_MEMPOOL Status = 80
 	Status_SEEN_ON_NETWORK        Status = 90
 	Status_DOUBLE_SPEND_ATTEMPTED Status = 100
+	Status_EXPIRED                Status = 105
 	Status_REJECTED               Status = 110
 	Status_MINED_IN_STALE_BLOCK   Status = 115
 	Status_MINED                  Status = 120
@@ -52,6 +54,7 @@ var (
 		10:  "QUEUED",
 		20:  "RECEIVED",
 		30:  "STORED",
+		35:  "WAITING_FOR_FINALITY",
 		40:  "ANNOUNCED_TO_NETWORK",
 		50:  "REQUESTED_BY_NETWORK",
 		60:  "SENT_TO_NETWORK",
@@ -59,6 +62,7 @@ var (
 		80:  "SEEN_IN_ORPHAN_MEMPOOL",
 		90:  "SEEN_ON_NETWORK",
 		100: "DOUBLE_SPEND_ATTEMPTED",
+		105: "EXPIRED",
 		110: "REJECTED",
 		115: "MINED_IN_STALE_BLOCK",
 		120: "MINED",
@@ -68,6 +72,7 @@ var (
 		"QUEUED":                 10,
 		"RECEIVED":               20,
 		"STORED":                 30,
+		"WAITING_FOR_FINALITY":   35,
 		"ANNOUNCED_TO_NETWORK":   40,
 		"REQUESTED_BY_NETWORK":   50,
 		"SENT_TO_NETWORK":        60,
@@ -75,6 +80,7 @@ var (
 		"SEEN_IN_ORPHAN_MEMPOOL": 80,
 		"SEEN_ON_NETWORK":        90,
 		"DOUBLE_SPEND_ATTEMPTED": 100,
+		"EXPIRED":                105,
 		"REJECTED":               110,
 		"MINED_IN_STALE_BLOCK":   115,
 		"MINED":                  120,
@@ -173,6 +179,8 @@ type SendRequest struct {
 	BlockHash       string                 `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	BlockHeight     uint64                 `protobuf:"varint,8,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Timestamp       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Tenant          string                 `protobuf:"bytes,10,opt,name=tenant,proto3" json:"tenant,omitempty"`                        // name of the tenant whose API key submitted the transaction
+	MinedTxid       string                 `protobuf:"bytes,11,opt,name=mined_txid,json=minedTxid,proto3" json:"mined_txid,omitempty"` // only set if a malleated variant of the transaction was mined
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -270,14 +278,31 @@ func (x *SendRequest) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SendRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SendRequest) GetMinedTxid() string {
+	if x != nil {
+		return x.MinedTxid
+	}
+	return ""
+}
+
 // swagger:model CallbackRouting
 type CallbackRouting struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	AllowBatch    bool                   `protobuf:"varint,3,opt,name=allow_batch,json=allowBatch,proto3" json:"allow_batch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Url               string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token             string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	AllowBatch        bool                   `protobuf:"varint,3,opt,name=allow_batch,json=allowBatch,proto3" json:"allow_batch,omitempty"`
+	Version           int32                  `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	AllowDuplicates   bool                   `protobuf:"varint,5,opt,name=allow_duplicates,json=allowDuplicates,proto3" json:"allow_duplicates,omitempty"`
+	SuppressionWindow int32                  `protobuf:"varint,6,opt,name=suppression_window,json=suppressionWindow,proto3" json:"suppression_window,omitempty"` // window in seconds in which the intermediate statuses are coalesced, 0 sends every status
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *CallbackRouting) Reset() {
@@ -331,6 +356,392 @@ func (x *CallbackRouting) GetAllowBatch() bool {
 	return false
 }
 
+func (x *CallbackRouting) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CallbackRouting) GetAllowDuplicates() bool {
+	if x != nil {
+		return x.AllowDuplicates
+	}
+	return false
+}
+
+func (x *CallbackRouting) GetSuppressionWindow() int32 {
+	if x != nil {
+		return x.SuppressionWindow
+	}
+	return 0
+}
+
+// swagger:model SLAReportsRequest
+type SLAReportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// daily or weekly
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// reports of the periods starting from from until to are returned
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// reports of all tenants are returned if empty
+	Tenant        string `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAReportsRequest) Reset() {
+	*x = SLAReportsRequest{}
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAReportsRequest) ProtoMessage() {}
+
+func (x *SLAReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAReportsRequest.ProtoReflect.Descriptor instead.
+func (*SLAReportsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SLAReportsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SLAReportsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SLAReportsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SLAReportsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// swagger:model SLAReport
+type SLAReport struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Period      string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Tenant      string                 `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// number of callbacks created in the period
+	Callbacks int64 `protobuf:"varint,4,opt,name=callbacks,proto3" json:"callbacks,omitempty"`
+	Delivered int64 `protobuf:"varint,5,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	// number of callbacks which were not delivered within the expiration
+	Failed        int64                  `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAReport) Reset() {
+	*x = SLAReport{}
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAReport) ProtoMessage() {}
+
+func (x *SLAReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAReport.ProtoReflect.Descriptor instead.
+func (*SLAReport) Descriptor() ([]byte, []int) {
+	return file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SLAReport) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SLAReport) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *SLAReport) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SLAReport) GetCallbacks() int64 {
+	if x != nil {
+		return x.Callbacks
+	}
+	return 0
+}
+
+func (x *SLAReport) GetDelivered() int64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *SLAReport) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *SLAReport) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+// swagger:model SLAReports
+type SLAReports struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*SLAReport           `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAReports) Reset() {
+	*x = SLAReports{}
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAReports) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAReports) ProtoMessage() {}
+
+func (x *SLAReports) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAReports.ProtoReflect.Descriptor instead.
+func (*SLAReports) Descriptor() ([]byte, []int) {
+	return file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SLAReports) GetReports() []*SLAReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// swagger:model EraseCallbacksRequest
+type EraseCallbacksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant whose callbacks are erased, all tenants if empty
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// transactions whose callbacks are erased, all transactions of the tenant if empty
+	Txids         []string `protobuf:"bytes,2,rep,name=txids,proto3" json:"txids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseCallbacksRequest) Reset() {
+	*x = EraseCallbacksRequest{}
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseCallbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseCallbacksRequest) ProtoMessage() {}
+
+func (x *EraseCallbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseCallbacksRequest.ProtoReflect.Descriptor instead.
+func (*EraseCallbacksRequest) Descriptor() ([]byte, []int) {
+	return file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EraseCallbacksRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *EraseCallbacksRequest) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+// swagger:model EraseCallbacksResponse
+type EraseCallbacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Callbacks     int64                  `protobuf:"varint,1,opt,name=callbacks,proto3" json:"callbacks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseCallbacksResponse) Reset() {
+	*x = EraseCallbacksResponse{}
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseCallbacksResponse) ProtoMessage() {}
+
+func (x *EraseCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*EraseCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EraseCallbacksResponse) GetCallbacks() int64 {
+	if x != nil {
+		return x.Callbacks
+	}
+	return 0
+}
+
+// LogLevelRequest is the log level, e.g. DEBUG, of a component of the process, e.g. mtm
+type LogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogLevelRequest) Reset() {
+	*x = LogLevelRequest{}
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevelRequest) ProtoMessage() {}
+
+func (x *LogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevelRequest.ProtoReflect.Descriptor instead.
+func (*LogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LogLevelRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *LogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
 var File_internal_callbacker_callbacker_api_callbacker_api_proto protoreflect.FileDescriptor
 
 const file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDesc = "" +
@@ -338,7 +749,7 @@ const file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDesc = ""
 	"7internal/callbacker/callbacker_api/callbacker_api.proto\x12\x0ecallbacker_api\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\"^\n" +
 	"\x0eHealthResponse\x128\n" +
 	"\ttimestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x12\n" +
-	"\x04nats\x18\x02 \x01(\tR\x04nats\"\xfe\x02\n" +
+	"\x04nats\x18\x02 \x01(\tR\x04nats\"\xb5\x03\n" +
 	"\vSendRequest\x12J\n" +
 	"\x10callback_routing\x18\x01 \x01(\v2\x1f.callbacker_api.CallbackRoutingR\x0fcallbackRouting\x12\x12\n" +
 	"\x04txid\x18\x02 \x01(\tR\x04txid\x12.\n" +
@@ -351,12 +762,44 @@ const file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDesc = ""
 	"\n" +
 	"block_hash\x18\a \x01(\tR\tblockHash\x12!\n" +
 	"\fblock_height\x18\b \x01(\x04R\vblockHeight\x128\n" +
-	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"Z\n" +
+	"\ttimestamp\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x16\n" +
+	"\x06tenant\x18\n" +
+	" \x01(\tR\x06tenant\x12\x1d\n" +
+	"\n" +
+	"mined_txid\x18\v \x01(\tR\tminedTxid\"\xce\x01\n" +
 	"\x0fCallbackRouting\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
 	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1f\n" +
 	"\vallow_batch\x18\x03 \x01(\bR\n" +
-	"allowBatch*\x9d\x02\n" +
+	"allowBatch\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\x05R\aversion\x12)\n" +
+	"\x10allow_duplicates\x18\x05 \x01(\bR\x0fallowDuplicates\x12-\n" +
+	"\x12suppression_window\x18\x06 \x01(\x05R\x11suppressionWindow\"\x9f\x01\n" +
+	"\x11SLAReportsRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\"\x8b\x02\n" +
+	"\tSLAReport\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x16\n" +
+	"\x06tenant\x18\x03 \x01(\tR\x06tenant\x12\x1c\n" +
+	"\tcallbacks\x18\x04 \x01(\x03R\tcallbacks\x12\x1c\n" +
+	"\tdelivered\x18\x05 \x01(\x03R\tdelivered\x12\x16\n" +
+	"\x06failed\x18\x06 \x01(\x03R\x06failed\x12;\n" +
+	"\vcomputed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"A\n" +
+	"\n" +
+	"SLAReports\x123\n" +
+	"\areports\x18\x01 \x03(\v2\x19.callbacker_api.SLAReportR\areports\"E\n" +
+	"\x15EraseCallbacksRequest\x12\x16\n" +
+	"\x06tenant\x18\x01 \x01(\tR\x06tenant\x12\x14\n" +
+	"\x05txids\x18\x02 \x03(\tR\x05txids\"6\n" +
+	"\x16EraseCallbacksResponse\x12\x1c\n" +
+	"\tcallbacks\x18\x01 \x01(\x03R\tcallbacks\"E\n" +
+	"\x0fLogLevelRequest\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level*\xc4\x02\n" +
 	"\x06Status\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\n" +
 	"\n" +
@@ -365,19 +808,25 @@ const file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDesc = ""
 	"\bRECEIVED\x10\x14\x12\n" +
 	"\n" +
 	"\x06STORED\x10\x1e\x12\x18\n" +
+	"\x14WAITING_FOR_FINALITY\x10#\x12\x18\n" +
 	"\x14ANNOUNCED_TO_NETWORK\x10(\x12\x18\n" +
 	"\x14REQUESTED_BY_NETWORK\x102\x12\x13\n" +
 	"\x0fSENT_TO_NETWORK\x10<\x12\x17\n" +
 	"\x13ACCEPTED_BY_NETWORK\x10F\x12\x1a\n" +
 	"\x16SEEN_IN_ORPHAN_MEMPOOL\x10P\x12\x13\n" +
 	"\x0fSEEN_ON_NETWORK\x10Z\x12\x1a\n" +
-	"\x16DOUBLE_SPEND_ATTEMPTED\x10d\x12\f\n" +
+	"\x16DOUBLE_SPEND_ATTEMPTED\x10d\x12\v\n" +
+	"\aEXPIRED\x10i\x12\f\n" +
 	"\bREJECTED\x10n\x12\x18\n" +
 	"\x14MINED_IN_STALE_BLOCK\x10s\x12\t\n" +
-	"\x05MINED\x10x2\x9a\x01\n" +
+	"\x05MINED\x10x2\xe5\x03\n" +
 	"\rCallbackerAPI\x12B\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x1e.callbacker_api.HealthResponse\"\x00\x12E\n" +
-	"\fSendCallback\x12\x1b.callbacker_api.SendRequest\x1a\x16.google.protobuf.Empty\"\x00B\x12Z\x10.;callbacker_apib\x06proto3"
+	"\fSendCallback\x12\x1b.callbacker_api.SendRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
+	"\rGetSLAReports\x12!.callbacker_api.SLAReportsRequest\x1a\x1a.callbacker_api.SLAReports\"\x00\x12a\n" +
+	"\x0eEraseCallbacks\x12%.callbacker_api.EraseCallbacksRequest\x1a&.callbacker_api.EraseCallbacksResponse\"\x00\x12H\n" +
+	"\vSetLogLevel\x12\x1f.callbacker_api.LogLevelRequest\x1a\x16.google.protobuf.Empty\"\x00\x12J\n" +
+	"\rResetLogLevel\x12\x1f.callbacker_api.LogLevelRequest\x1a\x16.google.protobuf.Empty\"\x00B\x12Z\x10.;callbacker_apib\x06proto3"
 
 var (
 	file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescOnce sync.Once
@@ -392,29 +841,48 @@ func file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDescGZIP()
 }
 
 var file_internal_callbacker_callbacker_api_callbacker_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_internal_callbacker_callbacker_api_callbacker_api_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_internal_callbacker_callbacker_api_callbacker_api_proto_goTypes = []any{
-	(Status)(0),                   // 0: callbacker_api.Status
-	(*HealthResponse)(nil),        // 1: callbacker_api.HealthResponse
-	(*SendRequest)(nil),           // 2: callbacker_api.SendRequest
-	(*CallbackRouting)(nil),       // 3: callbacker_api.CallbackRouting
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 5: google.protobuf.Empty
+	(Status)(0),                    // 0: callbacker_api.Status
+	(*HealthResponse)(nil),         // 1: callbacker_api.HealthResponse
+	(*SendRequest)(nil),            // 2: callbacker_api.SendRequest
+	(*CallbackRouting)(nil),        // 3: callbacker_api.CallbackRouting
+	(*SLAReportsRequest)(nil),      // 4: callbacker_api.SLAReportsRequest
+	(*SLAReport)(nil),              // 5: callbacker_api.SLAReport
+	(*SLAReports)(nil),             // 6: callbacker_api.SLAReports
+	(*EraseCallbacksRequest)(nil),  // 7: callbacker_api.EraseCallbacksRequest
+	(*EraseCallbacksResponse)(nil), // 8: callbacker_api.EraseCallbacksResponse
+	(*LogLevelRequest)(nil),        // 9: callbacker_api.LogLevelRequest
+	(*timestamppb.Timestamp)(nil),  // 10: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 11: google.protobuf.Empty
 }
 var file_internal_callbacker_callbacker_api_callbacker_api_proto_depIdxs = []int32{
-	4, // 0: callbacker_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
-	3, // 1: callbacker_api.SendRequest.callback_routing:type_name -> callbacker_api.CallbackRouting
-	0, // 2: callbacker_api.SendRequest.status:type_name -> callbacker_api.Status
-	4, // 3: callbacker_api.SendRequest.timestamp:type_name -> google.protobuf.Timestamp
-	5, // 4: callbacker_api.CallbackerAPI.Health:input_type -> google.protobuf.Empty
-	2, // 5: callbacker_api.CallbackerAPI.SendCallback:input_type -> callbacker_api.SendRequest
-	1, // 6: callbacker_api.CallbackerAPI.Health:output_type -> callbacker_api.HealthResponse
-	5, // 7: callbacker_api.CallbackerAPI.SendCallback:output_type -> google.protobuf.Empty
-	6, // [6:8] is the sub-list for method output_type
-	4, // [4:6] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	10, // 0: callbacker_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 1: callbacker_api.SendRequest.callback_routing:type_name -> callbacker_api.CallbackRouting
+	0,  // 2: callbacker_api.SendRequest.status:type_name -> callbacker_api.Status
+	10, // 3: callbacker_api.SendRequest.timestamp:type_name -> google.protobuf.Timestamp
+	10, // 4: callbacker_api.SLAReportsRequest.from:type_name -> google.protobuf.Timestamp
+	10, // 5: callbacker_api.SLAReportsRequest.to:type_name -> google.protobuf.Timestamp
+	10, // 6: callbacker_api.SLAReport.period_start:type_name -> google.protobuf.Timestamp
+	10, // 7: callbacker_api.SLAReport.computed_at:type_name -> google.protobuf.Timestamp
+	5,  // 8: callbacker_api.SLAReports.reports:type_name -> callbacker_api.SLAReport
+	11, // 9: callbacker_api.CallbackerAPI.Health:input_type -> google.protobuf.Empty
+	2,  // 10: callbacker_api.CallbackerAPI.SendCallback:input_type -> callbacker_api.SendRequest
+	4,  // 11: callbacker_api.CallbackerAPI.GetSLAReports:input_type -> callbacker_api.SLAReportsRequest
+	7,  // 12: callbacker_api.CallbackerAPI.EraseCallbacks:input_type -> callbacker_api.EraseCallbacksRequest
+	9,  // 13: callbacker_api.CallbackerAPI.SetLogLevel:input_type -> callbacker_api.LogLevelRequest
+	9,  // 14: callbacker_api.CallbackerAPI.ResetLogLevel:input_type -> callbacker_api.LogLevelRequest
+	1,  // 15: callbacker_api.CallbackerAPI.Health:output_type -> callbacker_api.HealthResponse
+	11, // 16: callbacker_api.CallbackerAPI.SendCallback:output_type -> google.protobuf.Empty
+	6,  // 17: callbacker_api.CallbackerAPI.GetSLAReports:output_type -> callbacker_api.SLAReports
+	8,  // 18: callbacker_api.CallbackerAPI.EraseCallbacks:output_type -> callbacker_api.EraseCallbacksResponse
+	11, // 19: callbacker_api.CallbackerAPI.SetLogLevel:output_type -> google.protobuf.Empty
+	11, // 20: callbacker_api.CallbackerAPI.ResetLogLevel:output_type -> google.protobuf.Empty
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_internal_callbacker_callbacker_api_callbacker_api_proto_init() }
@@ -428,7 +896,7 @@ func file_internal_callbacker_callbacker_api_callbacker_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDesc), len(file_internal_callbacker_callbacker_api_callbacker_api_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
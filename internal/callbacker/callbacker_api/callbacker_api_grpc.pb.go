@@ -20,8 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CallbackerAPI_Health_FullMethodName       = "/callbacker_api.CallbackerAPI/Health"
-	CallbackerAPI_SendCallback_FullMethodName = "/callbacker_api.CallbackerAPI/SendCallback"
+	CallbackerAPI_Health_FullMethodName         = "/callbacker_api.CallbackerAPI/Health"
+	CallbackerAPI_SendCallback_FullMethodName   = "/callbacker_api.CallbackerAPI/SendCallback"
+	CallbackerAPI_GetSLAReports_FullMethodName  = "/callbacker_api.CallbackerAPI/GetSLAReports"
+	CallbackerAPI_EraseCallbacks_FullMethodName = "/callbacker_api.CallbackerAPI/EraseCallbacks"
+	CallbackerAPI_SetLogLevel_FullMethodName    = "/callbacker_api.CallbackerAPI/SetLogLevel"
+	CallbackerAPI_ResetLogLevel_FullMethodName  = "/callbacker_api.CallbackerAPI/ResetLogLevel"
 )
 
 // CallbackerAPIClient is the client API for CallbackerAPI service.
@@ -30,6 +34,10 @@ const (
 type CallbackerAPIClient interface {
 	Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HealthResponse, error)
 	SendCallback(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSLAReports(ctx context.Context, in *SLAReportsRequest, opts ...grpc.CallOption) (*SLAReports, error)
+	EraseCallbacks(ctx context.Context, in *EraseCallbacksRequest, opts ...grpc.CallOption) (*EraseCallbacksResponse, error)
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type callbackerAPIClient struct {
@@ -60,12 +68,56 @@ func (c *callbackerAPIClient) SendCallback(ctx context.Context, in *SendRequest,
 	return out, nil
 }
 
+func (c *callbackerAPIClient) GetSLAReports(ctx context.Context, in *SLAReportsRequest, opts ...grpc.CallOption) (*SLAReports, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAReports)
+	err := c.cc.Invoke(ctx, CallbackerAPI_GetSLAReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackerAPIClient) EraseCallbacks(ctx context.Context, in *EraseCallbacksRequest, opts ...grpc.CallOption) (*EraseCallbacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EraseCallbacksResponse)
+	err := c.cc.Invoke(ctx, CallbackerAPI_EraseCallbacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackerAPIClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CallbackerAPI_SetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *callbackerAPIClient) ResetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CallbackerAPI_ResetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CallbackerAPIServer is the server API for CallbackerAPI service.
 // All implementations must embed UnimplementedCallbackerAPIServer
 // for forward compatibility.
 type CallbackerAPIServer interface {
 	Health(context.Context, *emptypb.Empty) (*HealthResponse, error)
 	SendCallback(context.Context, *SendRequest) (*emptypb.Empty, error)
+	GetSLAReports(context.Context, *SLAReportsRequest) (*SLAReports, error)
+	EraseCallbacks(context.Context, *EraseCallbacksRequest) (*EraseCallbacksResponse, error)
+	SetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error)
+	ResetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedCallbackerAPIServer()
 }
 
@@ -82,6 +134,18 @@ func (UnimplementedCallbackerAPIServer) Health(context.Context, *emptypb.Empty)
 func (UnimplementedCallbackerAPIServer) SendCallback(context.Context, *SendRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendCallback not implemented")
 }
+func (UnimplementedCallbackerAPIServer) GetSLAReports(context.Context, *SLAReportsRequest) (*SLAReports, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLAReports not implemented")
+}
+func (UnimplementedCallbackerAPIServer) EraseCallbacks(context.Context, *EraseCallbacksRequest) (*EraseCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseCallbacks not implemented")
+}
+func (UnimplementedCallbackerAPIServer) SetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedCallbackerAPIServer) ResetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetLogLevel not implemented")
+}
 func (UnimplementedCallbackerAPIServer) mustEmbedUnimplementedCallbackerAPIServer() {}
 func (UnimplementedCallbackerAPIServer) testEmbeddedByValue()                       {}
 
@@ -139,6 +203,78 @@ func _CallbackerAPI_SendCallback_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CallbackerAPI_GetSLAReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLAReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackerAPIServer).GetSLAReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackerAPI_GetSLAReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackerAPIServer).GetSLAReports(ctx, req.(*SLAReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallbackerAPI_EraseCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackerAPIServer).EraseCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackerAPI_EraseCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackerAPIServer).EraseCallbacks(ctx, req.(*EraseCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallbackerAPI_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackerAPIServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackerAPI_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackerAPIServer).SetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CallbackerAPI_ResetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CallbackerAPIServer).ResetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CallbackerAPI_ResetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CallbackerAPIServer).ResetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CallbackerAPI_ServiceDesc is the grpc.ServiceDesc for CallbackerAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,6 +290,22 @@ var CallbackerAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendCallback",
 			Handler:    _CallbackerAPI_SendCallback_Handler,
 		},
+		{
+			MethodName: "GetSLAReports",
+			Handler:    _CallbackerAPI_GetSLAReports_Handler,
+		},
+		{
+			MethodName: "EraseCallbacks",
+			Handler:    _CallbackerAPI_EraseCallbacks_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _CallbackerAPI_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ResetLogLevel",
+			Handler:    _CallbackerAPI_ResetLogLevel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/callbacker/callbacker_api/callbacker_api.proto",
@@ -81,9 +81,12 @@ func toGrpcInputs(data *store.Data) []*callbacker_api.SendRequest {
 		if c.CallbackURL != "" {
 			in := callbacker_api.SendRequest{
 				CallbackRouting: &callbacker_api.CallbackRouting{
-					Url:        c.CallbackURL,
-					Token:      c.CallbackToken,
-					AllowBatch: c.AllowBatch,
+					Url:               c.CallbackURL,
+					Token:             c.CallbackToken,
+					AllowBatch:        c.AllowBatch,
+					Version:           c.CallbackVersion,
+					AllowDuplicates:   c.AllowDuplicates,
+					SuppressionWindow: c.SuppressionWindow,
 				},
 				Txid:         data.Hash.String(),
 				Status:       callbacker_api.Status(data.Status),
@@ -93,6 +96,8 @@ func toGrpcInputs(data *store.Data) []*callbacker_api.SendRequest {
 
 				BlockHash:   getCallbackBlockHash(data),
 				BlockHeight: data.BlockHeight,
+				MinedTxid:   getCallbackMinedTxID(data),
+				Tenant:      data.Tenant,
 			}
 
 			requests = append(requests, &in)
@@ -118,6 +123,14 @@ func getCallbackCompetitingTxs(data *store.Data) []string {
 	return data.CompetingTxs
 }
 
+func getCallbackMinedTxID(data *store.Data) string {
+	if data.Status != metamorph_api.Status_MINED || data.MinedHash == nil {
+		return ""
+	}
+
+	return data.MinedHash.String()
+}
+
 func getCallbackBlockHash(data *store.Data) string {
 	if data.BlockHash == nil {
 		return ""
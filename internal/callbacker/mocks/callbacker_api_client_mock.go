@@ -21,12 +21,24 @@ var _ callbacker_api.CallbackerAPIClient = &CallbackerAPIClientMock{}
 //
 //		// make and configure a mocked callbacker_api.CallbackerAPIClient
 //		mockedCallbackerAPIClient := &CallbackerAPIClientMock{
+//			EraseCallbacksFunc: func(ctx context.Context, in *callbacker_api.EraseCallbacksRequest, opts ...grpc.CallOption) (*callbacker_api.EraseCallbacksResponse, error) {
+//				panic("mock out the EraseCallbacks method")
+//			},
+//			GetSLAReportsFunc: func(ctx context.Context, in *callbacker_api.SLAReportsRequest, opts ...grpc.CallOption) (*callbacker_api.SLAReports, error) {
+//				panic("mock out the GetSLAReports method")
+//			},
 //			HealthFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*callbacker_api.HealthResponse, error) {
 //				panic("mock out the Health method")
 //			},
+//			ResetLogLevelFunc: func(ctx context.Context, in *callbacker_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the ResetLogLevel method")
+//			},
 //			SendCallbackFunc: func(ctx context.Context, in *callbacker_api.SendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 //				panic("mock out the SendCallback method")
 //			},
+//			SetLogLevelFunc: func(ctx context.Context, in *callbacker_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the SetLogLevel method")
+//			},
 //		}
 //
 //		// use mockedCallbackerAPIClient in code that requires callbacker_api.CallbackerAPIClient
@@ -34,14 +46,44 @@ var _ callbacker_api.CallbackerAPIClient = &CallbackerAPIClientMock{}
 //
 //	}
 type CallbackerAPIClientMock struct {
+	// EraseCallbacksFunc mocks the EraseCallbacks method.
+	EraseCallbacksFunc func(ctx context.Context, in *callbacker_api.EraseCallbacksRequest, opts ...grpc.CallOption) (*callbacker_api.EraseCallbacksResponse, error)
+
+	// GetSLAReportsFunc mocks the GetSLAReports method.
+	GetSLAReportsFunc func(ctx context.Context, in *callbacker_api.SLAReportsRequest, opts ...grpc.CallOption) (*callbacker_api.SLAReports, error)
+
 	// HealthFunc mocks the Health method.
 	HealthFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*callbacker_api.HealthResponse, error)
 
+	// ResetLogLevelFunc mocks the ResetLogLevel method.
+	ResetLogLevelFunc func(ctx context.Context, in *callbacker_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
 	// SendCallbackFunc mocks the SendCallback method.
 	SendCallbackFunc func(ctx context.Context, in *callbacker_api.SendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 
+	// SetLogLevelFunc mocks the SetLogLevel method.
+	SetLogLevelFunc func(ctx context.Context, in *callbacker_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// EraseCallbacks holds details about calls to the EraseCallbacks method.
+		EraseCallbacks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *callbacker_api.EraseCallbacksRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// GetSLAReports holds details about calls to the GetSLAReports method.
+		GetSLAReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *callbacker_api.SLAReportsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// Health holds details about calls to the Health method.
 		Health []struct {
 			// Ctx is the ctx argument value.
@@ -51,6 +93,15 @@ type CallbackerAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// ResetLogLevel holds details about calls to the ResetLogLevel method.
+		ResetLogLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *callbacker_api.LogLevelRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// SendCallback holds details about calls to the SendCallback method.
 		SendCallback []struct {
 			// Ctx is the ctx argument value.
@@ -60,9 +111,102 @@ type CallbackerAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// SetLogLevel holds details about calls to the SetLogLevel method.
+		SetLogLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *callbacker_api.LogLevelRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+	}
+	lockEraseCallbacks sync.RWMutex
+	lockGetSLAReports  sync.RWMutex
+	lockHealth         sync.RWMutex
+	lockResetLogLevel  sync.RWMutex
+	lockSendCallback   sync.RWMutex
+	lockSetLogLevel    sync.RWMutex
+}
+
+// EraseCallbacks calls EraseCallbacksFunc.
+func (mock *CallbackerAPIClientMock) EraseCallbacks(ctx context.Context, in *callbacker_api.EraseCallbacksRequest, opts ...grpc.CallOption) (*callbacker_api.EraseCallbacksResponse, error) {
+	if mock.EraseCallbacksFunc == nil {
+		panic("CallbackerAPIClientMock.EraseCallbacksFunc: method is nil but CallbackerAPIClient.EraseCallbacks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *callbacker_api.EraseCallbacksRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockEraseCallbacks.Lock()
+	mock.calls.EraseCallbacks = append(mock.calls.EraseCallbacks, callInfo)
+	mock.lockEraseCallbacks.Unlock()
+	return mock.EraseCallbacksFunc(ctx, in, opts...)
+}
+
+// EraseCallbacksCalls gets all the calls that were made to EraseCallbacks.
+// Check the length with:
+//
+//	len(mockedCallbackerAPIClient.EraseCallbacksCalls())
+func (mock *CallbackerAPIClientMock) EraseCallbacksCalls() []struct {
+	Ctx  context.Context
+	In   *callbacker_api.EraseCallbacksRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *callbacker_api.EraseCallbacksRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockEraseCallbacks.RLock()
+	calls = mock.calls.EraseCallbacks
+	mock.lockEraseCallbacks.RUnlock()
+	return calls
+}
+
+// GetSLAReports calls GetSLAReportsFunc.
+func (mock *CallbackerAPIClientMock) GetSLAReports(ctx context.Context, in *callbacker_api.SLAReportsRequest, opts ...grpc.CallOption) (*callbacker_api.SLAReports, error) {
+	if mock.GetSLAReportsFunc == nil {
+		panic("CallbackerAPIClientMock.GetSLAReportsFunc: method is nil but CallbackerAPIClient.GetSLAReports was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *callbacker_api.SLAReportsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetSLAReports.Lock()
+	mock.calls.GetSLAReports = append(mock.calls.GetSLAReports, callInfo)
+	mock.lockGetSLAReports.Unlock()
+	return mock.GetSLAReportsFunc(ctx, in, opts...)
+}
+
+// GetSLAReportsCalls gets all the calls that were made to GetSLAReports.
+// Check the length with:
+//
+//	len(mockedCallbackerAPIClient.GetSLAReportsCalls())
+func (mock *CallbackerAPIClientMock) GetSLAReportsCalls() []struct {
+	Ctx  context.Context
+	In   *callbacker_api.SLAReportsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *callbacker_api.SLAReportsRequest
+		Opts []grpc.CallOption
 	}
-	lockHealth       sync.RWMutex
-	lockSendCallback sync.RWMutex
+	mock.lockGetSLAReports.RLock()
+	calls = mock.calls.GetSLAReports
+	mock.lockGetSLAReports.RUnlock()
+	return calls
 }
 
 // Health calls HealthFunc.
@@ -105,6 +249,46 @@ func (mock *CallbackerAPIClientMock) HealthCalls() []struct {
 	return calls
 }
 
+// ResetLogLevel calls ResetLogLevelFunc.
+func (mock *CallbackerAPIClientMock) ResetLogLevel(ctx context.Context, in *callbacker_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.ResetLogLevelFunc == nil {
+		panic("CallbackerAPIClientMock.ResetLogLevelFunc: method is nil but CallbackerAPIClient.ResetLogLevel was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *callbacker_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockResetLogLevel.Lock()
+	mock.calls.ResetLogLevel = append(mock.calls.ResetLogLevel, callInfo)
+	mock.lockResetLogLevel.Unlock()
+	return mock.ResetLogLevelFunc(ctx, in, opts...)
+}
+
+// ResetLogLevelCalls gets all the calls that were made to ResetLogLevel.
+// Check the length with:
+//
+//	len(mockedCallbackerAPIClient.ResetLogLevelCalls())
+func (mock *CallbackerAPIClientMock) ResetLogLevelCalls() []struct {
+	Ctx  context.Context
+	In   *callbacker_api.LogLevelRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *callbacker_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockResetLogLevel.RLock()
+	calls = mock.calls.ResetLogLevel
+	mock.lockResetLogLevel.RUnlock()
+	return calls
+}
+
 // SendCallback calls SendCallbackFunc.
 func (mock *CallbackerAPIClientMock) SendCallback(ctx context.Context, in *callbacker_api.SendRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	if mock.SendCallbackFunc == nil {
@@ -144,3 +328,43 @@ func (mock *CallbackerAPIClientMock) SendCallbackCalls() []struct {
 	mock.lockSendCallback.RUnlock()
 	return calls
 }
+
+// SetLogLevel calls SetLogLevelFunc.
+func (mock *CallbackerAPIClientMock) SetLogLevel(ctx context.Context, in *callbacker_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.SetLogLevelFunc == nil {
+		panic("CallbackerAPIClientMock.SetLogLevelFunc: method is nil but CallbackerAPIClient.SetLogLevel was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *callbacker_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockSetLogLevel.Lock()
+	mock.calls.SetLogLevel = append(mock.calls.SetLogLevel, callInfo)
+	mock.lockSetLogLevel.Unlock()
+	return mock.SetLogLevelFunc(ctx, in, opts...)
+}
+
+// SetLogLevelCalls gets all the calls that were made to SetLogLevel.
+// Check the length with:
+//
+//	len(mockedCallbackerAPIClient.SetLogLevelCalls())
+func (mock *CallbackerAPIClientMock) SetLogLevelCalls() []struct {
+	Ctx  context.Context
+	In   *callbacker_api.LogLevelRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *callbacker_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockSetLogLevel.RLock()
+	calls = mock.calls.SetLogLevel
+	mock.lockSetLogLevel.RUnlock()
+	return calls
+}
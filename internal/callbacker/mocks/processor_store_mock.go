@@ -23,9 +23,27 @@ var _ store.ProcessorStore = &ProcessorStoreMock{}
 //			ClearFunc: func(ctx context.Context, t time.Time) error {
 //				panic("mock out the Clear method")
 //			},
+//			ComputeSLAReportsFunc: func(ctx context.Context, period string, from time.Time, to time.Time, expiration time.Duration) (int64, error) {
+//				panic("mock out the ComputeSLAReports method")
+//			},
+//			CountUnsentFunc: func(ctx context.Context, expiration time.Duration) (int64, error) {
+//				panic("mock out the CountUnsent method")
+//			},
+//			CountUnsentByTenantFunc: func(ctx context.Context, expiration time.Duration) (map[string]int64, error) {
+//				panic("mock out the CountUnsentByTenant method")
+//			},
+//			EraseCallbacksFunc: func(ctx context.Context, tenant string, txIDs []string) (int64, error) {
+//				panic("mock out the EraseCallbacks method")
+//			},
+//			GetSLAReportsFunc: func(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error) {
+//				panic("mock out the GetSLAReports method")
+//			},
 //			GetUnsentFunc: func(ctx context.Context, limit int, expiration time.Duration, batch bool) ([]*store.CallbackData, error) {
 //				panic("mock out the GetUnsent method")
 //			},
+//			GetUnsentByTenantFunc: func(ctx context.Context, limit int, expiration time.Duration, batch bool, quotas store.TenantQuotas) ([]*store.CallbackData, error) {
+//				panic("mock out the GetUnsentByTenant method")
+//			},
 //			InsertFunc: func(ctx context.Context, data []*store.CallbackData) (int64, error) {
 //				panic("mock out the Insert method")
 //			},
@@ -45,9 +63,27 @@ type ProcessorStoreMock struct {
 	// ClearFunc mocks the Clear method.
 	ClearFunc func(ctx context.Context, t time.Time) error
 
+	// ComputeSLAReportsFunc mocks the ComputeSLAReports method.
+	ComputeSLAReportsFunc func(ctx context.Context, period string, from time.Time, to time.Time, expiration time.Duration) (int64, error)
+
+	// CountUnsentFunc mocks the CountUnsent method.
+	CountUnsentFunc func(ctx context.Context, expiration time.Duration) (int64, error)
+
+	// CountUnsentByTenantFunc mocks the CountUnsentByTenant method.
+	CountUnsentByTenantFunc func(ctx context.Context, expiration time.Duration) (map[string]int64, error)
+
+	// EraseCallbacksFunc mocks the EraseCallbacks method.
+	EraseCallbacksFunc func(ctx context.Context, tenant string, txIDs []string) (int64, error)
+
+	// GetSLAReportsFunc mocks the GetSLAReports method.
+	GetSLAReportsFunc func(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error)
+
 	// GetUnsentFunc mocks the GetUnsent method.
 	GetUnsentFunc func(ctx context.Context, limit int, expiration time.Duration, batch bool) ([]*store.CallbackData, error)
 
+	// GetUnsentByTenantFunc mocks the GetUnsentByTenant method.
+	GetUnsentByTenantFunc func(ctx context.Context, limit int, expiration time.Duration, batch bool, quotas store.TenantQuotas) ([]*store.CallbackData, error)
+
 	// InsertFunc mocks the Insert method.
 	InsertFunc func(ctx context.Context, data []*store.CallbackData) (int64, error)
 
@@ -66,6 +102,55 @@ type ProcessorStoreMock struct {
 			// T is the t argument value.
 			T time.Time
 		}
+		// ComputeSLAReports holds details about calls to the ComputeSLAReports method.
+		ComputeSLAReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Period is the period argument value.
+			Period string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+			// Expiration is the expiration argument value.
+			Expiration time.Duration
+		}
+		// CountUnsent holds details about calls to the CountUnsent method.
+		CountUnsent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Expiration is the expiration argument value.
+			Expiration time.Duration
+		}
+		// CountUnsentByTenant holds details about calls to the CountUnsentByTenant method.
+		CountUnsentByTenant []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Expiration is the expiration argument value.
+			Expiration time.Duration
+		}
+		// EraseCallbacks holds details about calls to the EraseCallbacks method.
+		EraseCallbacks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tenant is the tenant argument value.
+			Tenant string
+			// TxIDs is the txIDs argument value.
+			TxIDs []string
+		}
+		// GetSLAReports holds details about calls to the GetSLAReports method.
+		GetSLAReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Period is the period argument value.
+			Period string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+			// Tenant is the tenant argument value.
+			Tenant string
+		}
 		// GetUnsent holds details about calls to the GetUnsent method.
 		GetUnsent []struct {
 			// Ctx is the ctx argument value.
@@ -77,6 +162,19 @@ type ProcessorStoreMock struct {
 			// Batch is the batch argument value.
 			Batch bool
 		}
+		// GetUnsentByTenant holds details about calls to the GetUnsentByTenant method.
+		GetUnsentByTenant []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int
+			// Expiration is the expiration argument value.
+			Expiration time.Duration
+			// Batch is the batch argument value.
+			Batch bool
+			// Quotas is the quotas argument value.
+			Quotas store.TenantQuotas
+		}
 		// Insert holds details about calls to the Insert method.
 		Insert []struct {
 			// Ctx is the ctx argument value.
@@ -99,11 +197,17 @@ type ProcessorStoreMock struct {
 			Ids []int64
 		}
 	}
-	lockClear        sync.RWMutex
-	lockGetUnsent    sync.RWMutex
-	lockInsert       sync.RWMutex
-	lockSetSent      sync.RWMutex
-	lockUnsetPending sync.RWMutex
+	lockClear               sync.RWMutex
+	lockComputeSLAReports   sync.RWMutex
+	lockCountUnsent         sync.RWMutex
+	lockCountUnsentByTenant sync.RWMutex
+	lockEraseCallbacks      sync.RWMutex
+	lockGetSLAReports       sync.RWMutex
+	lockGetUnsent           sync.RWMutex
+	lockGetUnsentByTenant   sync.RWMutex
+	lockInsert              sync.RWMutex
+	lockSetSent             sync.RWMutex
+	lockUnsetPending        sync.RWMutex
 }
 
 // Clear calls ClearFunc.
@@ -142,6 +246,214 @@ func (mock *ProcessorStoreMock) ClearCalls() []struct {
 	return calls
 }
 
+// ComputeSLAReports calls ComputeSLAReportsFunc.
+func (mock *ProcessorStoreMock) ComputeSLAReports(ctx context.Context, period string, from time.Time, to time.Time, expiration time.Duration) (int64, error) {
+	if mock.ComputeSLAReportsFunc == nil {
+		panic("ProcessorStoreMock.ComputeSLAReportsFunc: method is nil but ProcessorStore.ComputeSLAReports was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Period     string
+		From       time.Time
+		To         time.Time
+		Expiration time.Duration
+	}{
+		Ctx:        ctx,
+		Period:     period,
+		From:       from,
+		To:         to,
+		Expiration: expiration,
+	}
+	mock.lockComputeSLAReports.Lock()
+	mock.calls.ComputeSLAReports = append(mock.calls.ComputeSLAReports, callInfo)
+	mock.lockComputeSLAReports.Unlock()
+	return mock.ComputeSLAReportsFunc(ctx, period, from, to, expiration)
+}
+
+// ComputeSLAReportsCalls gets all the calls that were made to ComputeSLAReports.
+// Check the length with:
+//
+//	len(mockedProcessorStore.ComputeSLAReportsCalls())
+func (mock *ProcessorStoreMock) ComputeSLAReportsCalls() []struct {
+	Ctx        context.Context
+	Period     string
+	From       time.Time
+	To         time.Time
+	Expiration time.Duration
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Period     string
+		From       time.Time
+		To         time.Time
+		Expiration time.Duration
+	}
+	mock.lockComputeSLAReports.RLock()
+	calls = mock.calls.ComputeSLAReports
+	mock.lockComputeSLAReports.RUnlock()
+	return calls
+}
+
+// CountUnsent calls CountUnsentFunc.
+func (mock *ProcessorStoreMock) CountUnsent(ctx context.Context, expiration time.Duration) (int64, error) {
+	if mock.CountUnsentFunc == nil {
+		panic("ProcessorStoreMock.CountUnsentFunc: method is nil but ProcessorStore.CountUnsent was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Expiration time.Duration
+	}{
+		Ctx:        ctx,
+		Expiration: expiration,
+	}
+	mock.lockCountUnsent.Lock()
+	mock.calls.CountUnsent = append(mock.calls.CountUnsent, callInfo)
+	mock.lockCountUnsent.Unlock()
+	return mock.CountUnsentFunc(ctx, expiration)
+}
+
+// CountUnsentCalls gets all the calls that were made to CountUnsent.
+// Check the length with:
+//
+//	len(mockedProcessorStore.CountUnsentCalls())
+func (mock *ProcessorStoreMock) CountUnsentCalls() []struct {
+	Ctx        context.Context
+	Expiration time.Duration
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Expiration time.Duration
+	}
+	mock.lockCountUnsent.RLock()
+	calls = mock.calls.CountUnsent
+	mock.lockCountUnsent.RUnlock()
+	return calls
+}
+
+// CountUnsentByTenant calls CountUnsentByTenantFunc.
+func (mock *ProcessorStoreMock) CountUnsentByTenant(ctx context.Context, expiration time.Duration) (map[string]int64, error) {
+	if mock.CountUnsentByTenantFunc == nil {
+		panic("ProcessorStoreMock.CountUnsentByTenantFunc: method is nil but ProcessorStore.CountUnsentByTenant was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Expiration time.Duration
+	}{
+		Ctx:        ctx,
+		Expiration: expiration,
+	}
+	mock.lockCountUnsentByTenant.Lock()
+	mock.calls.CountUnsentByTenant = append(mock.calls.CountUnsentByTenant, callInfo)
+	mock.lockCountUnsentByTenant.Unlock()
+	return mock.CountUnsentByTenantFunc(ctx, expiration)
+}
+
+// CountUnsentByTenantCalls gets all the calls that were made to CountUnsentByTenant.
+// Check the length with:
+//
+//	len(mockedProcessorStore.CountUnsentByTenantCalls())
+func (mock *ProcessorStoreMock) CountUnsentByTenantCalls() []struct {
+	Ctx        context.Context
+	Expiration time.Duration
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Expiration time.Duration
+	}
+	mock.lockCountUnsentByTenant.RLock()
+	calls = mock.calls.CountUnsentByTenant
+	mock.lockCountUnsentByTenant.RUnlock()
+	return calls
+}
+
+// EraseCallbacks calls EraseCallbacksFunc.
+func (mock *ProcessorStoreMock) EraseCallbacks(ctx context.Context, tenant string, txIDs []string) (int64, error) {
+	if mock.EraseCallbacksFunc == nil {
+		panic("ProcessorStoreMock.EraseCallbacksFunc: method is nil but ProcessorStore.EraseCallbacks was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Tenant string
+		TxIDs  []string
+	}{
+		Ctx:    ctx,
+		Tenant: tenant,
+		TxIDs:  txIDs,
+	}
+	mock.lockEraseCallbacks.Lock()
+	mock.calls.EraseCallbacks = append(mock.calls.EraseCallbacks, callInfo)
+	mock.lockEraseCallbacks.Unlock()
+	return mock.EraseCallbacksFunc(ctx, tenant, txIDs)
+}
+
+// EraseCallbacksCalls gets all the calls that were made to EraseCallbacks.
+// Check the length with:
+//
+//	len(mockedProcessorStore.EraseCallbacksCalls())
+func (mock *ProcessorStoreMock) EraseCallbacksCalls() []struct {
+	Ctx    context.Context
+	Tenant string
+	TxIDs  []string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Tenant string
+		TxIDs  []string
+	}
+	mock.lockEraseCallbacks.RLock()
+	calls = mock.calls.EraseCallbacks
+	mock.lockEraseCallbacks.RUnlock()
+	return calls
+}
+
+// GetSLAReports calls GetSLAReportsFunc.
+func (mock *ProcessorStoreMock) GetSLAReports(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error) {
+	if mock.GetSLAReportsFunc == nil {
+		panic("ProcessorStoreMock.GetSLAReportsFunc: method is nil but ProcessorStore.GetSLAReports was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Period string
+		From   time.Time
+		To     time.Time
+		Tenant string
+	}{
+		Ctx:    ctx,
+		Period: period,
+		From:   from,
+		To:     to,
+		Tenant: tenant,
+	}
+	mock.lockGetSLAReports.Lock()
+	mock.calls.GetSLAReports = append(mock.calls.GetSLAReports, callInfo)
+	mock.lockGetSLAReports.Unlock()
+	return mock.GetSLAReportsFunc(ctx, period, from, to, tenant)
+}
+
+// GetSLAReportsCalls gets all the calls that were made to GetSLAReports.
+// Check the length with:
+//
+//	len(mockedProcessorStore.GetSLAReportsCalls())
+func (mock *ProcessorStoreMock) GetSLAReportsCalls() []struct {
+	Ctx    context.Context
+	Period string
+	From   time.Time
+	To     time.Time
+	Tenant string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Period string
+		From   time.Time
+		To     time.Time
+		Tenant string
+	}
+	mock.lockGetSLAReports.RLock()
+	calls = mock.calls.GetSLAReports
+	mock.lockGetSLAReports.RUnlock()
+	return calls
+}
+
 // GetUnsent calls GetUnsentFunc.
 func (mock *ProcessorStoreMock) GetUnsent(ctx context.Context, limit int, expiration time.Duration, batch bool) ([]*store.CallbackData, error) {
 	if mock.GetUnsentFunc == nil {
@@ -186,6 +498,54 @@ func (mock *ProcessorStoreMock) GetUnsentCalls() []struct {
 	return calls
 }
 
+// GetUnsentByTenant calls GetUnsentByTenantFunc.
+func (mock *ProcessorStoreMock) GetUnsentByTenant(ctx context.Context, limit int, expiration time.Duration, batch bool, quotas store.TenantQuotas) ([]*store.CallbackData, error) {
+	if mock.GetUnsentByTenantFunc == nil {
+		panic("ProcessorStoreMock.GetUnsentByTenantFunc: method is nil but ProcessorStore.GetUnsentByTenant was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Limit      int
+		Expiration time.Duration
+		Batch      bool
+		Quotas     store.TenantQuotas
+	}{
+		Ctx:        ctx,
+		Limit:      limit,
+		Expiration: expiration,
+		Batch:      batch,
+		Quotas:     quotas,
+	}
+	mock.lockGetUnsentByTenant.Lock()
+	mock.calls.GetUnsentByTenant = append(mock.calls.GetUnsentByTenant, callInfo)
+	mock.lockGetUnsentByTenant.Unlock()
+	return mock.GetUnsentByTenantFunc(ctx, limit, expiration, batch, quotas)
+}
+
+// GetUnsentByTenantCalls gets all the calls that were made to GetUnsentByTenant.
+// Check the length with:
+//
+//	len(mockedProcessorStore.GetUnsentByTenantCalls())
+func (mock *ProcessorStoreMock) GetUnsentByTenantCalls() []struct {
+	Ctx        context.Context
+	Limit      int
+	Expiration time.Duration
+	Batch      bool
+	Quotas     store.TenantQuotas
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Limit      int
+		Expiration time.Duration
+		Batch      bool
+		Quotas     store.TenantQuotas
+	}
+	mock.lockGetUnsentByTenant.RLock()
+	calls = mock.calls.GetUnsentByTenant
+	mock.lockGetUnsentByTenant.RUnlock()
+	return calls
+}
+
 // Insert calls InsertFunc.
 func (mock *ProcessorStoreMock) Insert(ctx context.Context, data []*store.CallbackData) (int64, error) {
 	if mock.InsertFunc == nil {
@@ -0,0 +1,96 @@
+package callbacker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"text/template"
+)
+
+const contentTypeJSON = "application/json; charset=UTF-8"
+
+var (
+	ErrInvalidPayloadTemplate = errors.New("invalid callback payload template")
+	ErrPayloadTemplateFailed  = errors.New("failed to execute callback payload template")
+
+	templateFuncs = template.FuncMap{
+		// json encodes the value as JSON, so that strings are escaped and nil pointers are null
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+)
+
+// PayloadTemplate transforms the payloads of the callbacks sent to the callback URLs starting with URLPrefix or with
+// the callback token Token with Go templates, so that receivers with a fixed webhook schema can consume the callbacks.
+// The template is executed with the Callback, the batch template with the BatchCallback. If no batch template is given,
+// the callbacks of a batch are sent one by one.
+type PayloadTemplate struct {
+	URLPrefix   string
+	Token       string
+	ContentType string
+
+	template      *template.Template
+	batchTemplate *template.Template
+}
+
+func NewPayloadTemplate(urlPrefix string, token string, contentType string, tmpl string, batchTmpl string) (*PayloadTemplate, error) {
+	if urlPrefix == "" && token == "" {
+		return nil, errors.Join(ErrInvalidPayloadTemplate, errors.New("neither url prefix nor token given"))
+	}
+
+	if contentType == "" {
+		contentType = contentTypeJSON
+	}
+
+	t := &PayloadTemplate{
+		URLPrefix:   urlPrefix,
+		Token:       token,
+		ContentType: contentType,
+	}
+
+	var err error
+	t.template, err = template.New("payload").Funcs(templateFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidPayloadTemplate, err)
+	}
+
+	if batchTmpl != "" {
+		t.batchTemplate, err = template.New("batchPayload").Funcs(templateFuncs).Parse(batchTmpl)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidPayloadTemplate, err)
+		}
+	}
+
+	return t, nil
+}
+
+// matches returns true if the template applies to the callbacks sent to the URL with the token.
+func (t *PayloadTemplate) matches(url string, token string) bool {
+	if t.URLPrefix != "" && !strings.HasPrefix(url, t.URLPrefix) {
+		return false
+	}
+
+	return t.Token == "" || t.Token == token
+}
+
+func (t *PayloadTemplate) payload(callback *Callback) ([]byte, error) {
+	return execute(t.template, callback)
+}
+
+func (t *PayloadTemplate) batchPayload(batch BatchCallback) ([]byte, error) {
+	return execute(t.batchTemplate, batch)
+}
+
+func execute(tmpl *template.Template, data any) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := tmpl.Execute(&buf, data)
+	if err != nil {
+		return nil, errors.Join(ErrPayloadTemplateFailed, err)
+	}
+
+	return buf.Bytes(), nil
+}
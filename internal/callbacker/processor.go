@@ -7,11 +7,12 @@ import (
 	"sync"
 	"time"
 
-	"google.golang.org/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
 	"github.com/bitcoin-sv/arc/internal/callbacker/store"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
 )
 
 type Sender interface {
@@ -27,6 +28,7 @@ const (
 	storeCallbacksIntervalDefault = 5 * time.Second
 	storeCallbackBatchSizeDefault = 500
 	sendCallbacksInterval         = 5 * time.Second
+	backlogIntervalDefault        = 30 * time.Second
 )
 
 type Processor struct {
@@ -44,12 +46,45 @@ type Processor struct {
 	batchSendInterval      time.Duration
 	clearInterval          time.Duration
 	clearRetentionPeriod   time.Duration
-
+	slaReportsInterval     time.Duration
+	backlogInterval        time.Duration
+	backlog                prometheus.Gauge
+	tenantBacklog          *prometheus.GaugeVec
+	tenantShaper           *tenantShaper
+	jobConfigs             map[string]scheduler.JobConfig
+
+	scheduler *scheduler.Scheduler
 	wg        *sync.WaitGroup
 	cancelAll context.CancelFunc
 	ctx       context.Context
 }
 
+// WithBacklogInterval sets the interval in which the number of unsent callbacks is counted.
+func WithBacklogInterval(d time.Duration) func(*Processor) {
+	return func(m *Processor) {
+		m.backlogInterval = d
+	}
+}
+
+// WithTenantRateLimits shapes the delivery of the callbacks per tenant by the limits of the tenants. The default limit
+// applies to the tenants without own limit and to the callbacks without tenant, they are not limited if it is nil.
+func WithTenantRateLimits(limits map[string]TenantRateLimit, defaultLimit *TenantRateLimit) func(*Processor) {
+	return func(m *Processor) {
+		if len(limits) == 0 && defaultLimit == nil {
+			return
+		}
+		m.tenantShaper = newTenantShaper(limits, defaultLimit, time.Now)
+	}
+}
+
+// WithJobConfigs overrides the schedule, jitter and pause state of the jobs of the processor by job name, e.g.
+// "CallbackStoreCleanup".
+func WithJobConfigs(configs map[string]scheduler.JobConfig) func(*Processor) {
+	return func(m *Processor) {
+		m.jobConfigs = configs
+	}
+}
+
 func WithSingleSendInterval(d time.Duration) func(*Processor) {
 	return func(m *Processor) {
 		m.singleSendInterval = d
@@ -98,6 +133,14 @@ func WithStoreCallbacksInterval(d time.Duration) func(*Processor) {
 	}
 }
 
+// WithSLAReports computes the callback delivery SLA reports per tenant of the current and the previous daily and weekly
+// periods on the interval.
+func WithSLAReports(interval time.Duration) func(*Processor) {
+	return func(m *Processor) {
+		m.slaReportsInterval = interval
+	}
+}
+
 func toEntry(callbackData *store.CallbackData) CallbackEntry {
 	return CallbackEntry{
 		Token:      callbackData.Token,
@@ -108,6 +151,7 @@ func toEntry(callbackData *store.CallbackData) CallbackEntry {
 
 func toCallback(callbackData *store.CallbackData) *Callback {
 	return &Callback{
+		Version:      payloadVersion(callbackData.Version),
 		Timestamp:    callbackData.Timestamp,
 		CompetingTxs: callbackData.CompetingTxs,
 		TxID:         callbackData.TxID,
@@ -116,6 +160,7 @@ func toCallback(callbackData *store.CallbackData) *Callback {
 		MerklePath:   callbackData.MerklePath,
 		BlockHash:    callbackData.BlockHash,
 		BlockHeight:  callbackData.BlockHeight,
+		MinedTxID:    callbackData.MinedTxID,
 	}
 }
 
@@ -133,12 +178,17 @@ func NewProcessor(sender SenderI, processorStore store.ProcessorStore, mqClient
 		batchSize:              batchSizeDefault,
 		singleSendInterval:     singleSendDefault,
 		batchSendInterval:      batchSendIntervalDefault,
+		backlogInterval:        backlogIntervalDefault,
+		backlog:                newBacklogGauge(),
+		tenantBacklog:          newTenantBacklogGauge(),
 		wg:                     &sync.WaitGroup{},
 	}
 	for _, opt := range opts {
 		opt(p)
 	}
 
+	p.scheduler = scheduler.New(p.logger, scheduler.WithJobConfigs(p.jobConfigs))
+
 	ctx, cancelAll := context.WithCancel(context.Background())
 	p.cancelAll = cancelAll
 	p.ctx = ctx
@@ -147,14 +197,14 @@ func NewProcessor(sender SenderI, processorStore store.ProcessorStore, mqClient
 }
 
 func (p *Processor) Subscribe() error {
-	err := p.mqClient.Consume(mq.CallbackTopic, func(msg []byte) error {
+	err := p.mqClient.Consume(mq.CallbackTopic, func(ctx context.Context, msg []byte) error {
 		serialized := &callbacker_api.SendRequest{}
-		err := proto.Unmarshal(msg, serialized)
+		err := mq.Unmarshal(ctx, mq.CallbackTopic, msg, serialized)
 		if err != nil {
-			return fmt.Errorf("failed to unmarshal send request on %s topic", mq.CallbackTopic)
+			return fmt.Errorf("failed to unmarshal send request on %s topic: %w", mq.CallbackTopic, err)
 		}
 
-		p.logger.Debug("Enqueued callback request",
+		p.logger.DebugContext(ctx, "Enqueued callback request",
 			slog.String("url", serialized.CallbackRouting.Url),
 			slog.String("token", serialized.CallbackRouting.Token),
 			slog.String("hash", serialized.Txid),
@@ -173,31 +223,57 @@ func (p *Processor) Subscribe() error {
 }
 
 func (p *Processor) Start() error {
-	err := p.Subscribe()
+	err := registerStats(p.backlog)
+	if err != nil {
+		return err
+	}
+
+	if p.tenantShaper != nil {
+		err = registerStats(p.tenantBacklog)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = p.Subscribe()
 	if err != nil {
 		return err
 	}
-	p.StartRoutine(p.clearInterval, CallbackStoreCleanup)
-	p.StartRoutine(p.sendCallbacksInterval, LoadAndSendSingleCallbacks)
-	p.StartRoutine(p.batchSendInterval, LoadAndSendBatchCallbacks)
+	p.StartRoutine(p.clearInterval, CallbackStoreCleanup, "CallbackStoreCleanup")
+	p.StartRoutine(p.sendCallbacksInterval, LoadAndSendSingleCallbacks, "LoadAndSendSingleCallbacks")
+	p.StartRoutine(p.batchSendInterval, LoadAndSendBatchCallbacks, "LoadAndSendBatchCallbacks")
+	if p.slaReportsInterval > 0 {
+		p.StartRoutine(p.slaReportsInterval, ComputeSLAReports, "ComputeSLAReports")
+	}
+	p.StartRoutine(p.backlogInterval, UpdateCallbackBacklog, "UpdateCallbackBacklog")
 	p.StartStoreCallbackRequests()
 
 	return nil
 }
 
 func toStoreDto(request *callbacker_api.SendRequest) *store.CallbackData {
+	var minedTxID *string
+	if request.MinedTxid != "" {
+		minedTxID = ptrTo(request.MinedTxid)
+	}
+
 	return &store.CallbackData{
-		URL:          request.CallbackRouting.Url,
-		Token:        request.CallbackRouting.Token,
-		Timestamp:    request.Timestamp.AsTime(),
-		CompetingTxs: request.CompetingTxs,
-		TxID:         request.Txid,
-		TxStatus:     request.Status.String(),
-		ExtraInfo:    ptrTo(request.ExtraInfo),
-		MerklePath:   ptrTo(request.MerklePath),
-		BlockHash:    ptrTo(request.BlockHash),
-		BlockHeight:  ptrTo(request.BlockHeight),
-		AllowBatch:   request.CallbackRouting.AllowBatch,
+		URL:               request.CallbackRouting.Url,
+		Token:             request.CallbackRouting.Token,
+		Timestamp:         request.Timestamp.AsTime(),
+		CompetingTxs:      request.CompetingTxs,
+		TxID:              request.Txid,
+		TxStatus:          request.Status.String(),
+		ExtraInfo:         ptrTo(request.ExtraInfo),
+		MerklePath:        ptrTo(request.MerklePath),
+		BlockHash:         ptrTo(request.BlockHash),
+		BlockHeight:       ptrTo(request.BlockHeight),
+		MinedTxID:         minedTxID,
+		Tenant:            request.Tenant,
+		AllowBatch:        request.CallbackRouting.AllowBatch,
+		Version:           request.CallbackRouting.Version,
+		AllowDuplicates:   request.CallbackRouting.AllowDuplicates,
+		SuppressionWindow: time.Duration(request.CallbackRouting.SuppressionWindow) * time.Second,
 	}
 }
 
@@ -207,25 +283,15 @@ type CallbackEntry struct {
 	AllowBatch bool
 }
 
-func (p *Processor) StartRoutine(tickerInterval time.Duration, routine func(*Processor)) {
-	ticker := time.NewTicker(tickerInterval)
-	p.wg.Add(1)
-
-	go func() {
-		defer func() {
-			p.wg.Done()
-			ticker.Stop()
-		}()
-
-		for {
-			select {
-			case <-p.ctx.Done():
-				return
-			case <-ticker.C:
-				routine(p)
-			}
-		}
-	}()
+// StartRoutine adds the routine as job to the scheduler of the processor. The routine runs in the given interval unless
+// the schedule of the job is overridden by configuration.
+func (p *Processor) StartRoutine(tickerInterval time.Duration, routine func(*Processor), routineName string) {
+	err := p.scheduler.Add(routineName, scheduler.Every(tickerInterval), func(_ context.Context) {
+		routine(p)
+	})
+	if err != nil {
+		p.logger.Error("Failed to start routine", slog.String("routine", routineName), slog.String("err", err.Error()))
+	}
 }
 
 func (p *Processor) StartStoreCallbackRequests() {
@@ -276,5 +342,8 @@ func (p *Processor) StartStoreCallbackRequests() {
 func (p *Processor) GracefulStop() {
 	p.cancelAll()
 
+	p.scheduler.Shutdown()
 	p.wg.Wait()
+
+	unregisterStats(p.backlog, p.tenantBacklog)
 }
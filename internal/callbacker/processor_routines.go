@@ -7,6 +7,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/bitcoin-sv/arc/internal/callbacker/store"
+	"github.com/bitcoin-sv/arc/internal/slareport"
 )
 
 const maxParallelRoutines = 10
@@ -22,6 +23,43 @@ func CallbackStoreCleanup(p *Processor) {
 	}
 }
 
+// ComputeSLAReports computes the callback delivery SLA reports per tenant of the current and the previous daily and
+// weekly periods, the reports of earlier periods are final.
+func ComputeSLAReports(p *Processor) {
+	for _, window := range slareport.Recent(time.Now()) {
+		_, err := p.store.ComputeSLAReports(p.ctx, window.Period, window.Start, window.End, p.expiration)
+		if err != nil {
+			p.logger.Error("Failed to compute SLA reports", slog.String("period", window.Period), slog.Time("start", window.Start), slog.String("err", err.Error()))
+		}
+	}
+}
+
+// UpdateCallbackBacklog sets the number of callbacks which have not been sent yet and are not expired.
+func UpdateCallbackBacklog(p *Processor) {
+	count, err := p.store.CountUnsent(p.ctx, p.expiration)
+	if err != nil {
+		p.logger.Error("Failed to count unsent callbacks", slog.String("err", err.Error()))
+		return
+	}
+
+	p.backlog.Set(float64(count))
+
+	if p.tenantShaper == nil {
+		return
+	}
+
+	counts, err := p.store.CountUnsentByTenant(p.ctx, p.expiration)
+	if err != nil {
+		p.logger.Error("Failed to count unsent callbacks by tenant", slog.String("err", err.Error()))
+		return
+	}
+
+	p.tenantBacklog.Reset()
+	for tenant, tenantCount := range counts {
+		p.tenantBacklog.WithLabelValues(tenant).Set(float64(tenantCount))
+	}
+}
+
 func LoadAndSendSingleCallbacks(p *Processor) {
 	LoadAndSendCallbacks(p, false, p.sendSingleCallbacks)
 }
@@ -31,7 +69,7 @@ func LoadAndSendBatchCallbacks(p *Processor) {
 }
 
 func LoadAndSendCallbacks(p *Processor, isBatch bool, sendFunc func(url string, cbs []*store.CallbackData)) {
-	callbackRecords, err := p.store.GetUnsent(p.ctx, p.batchSize, p.expiration, isBatch)
+	callbackRecords, err := p.loadUnsent(isBatch)
 	if err != nil {
 		p.logger.Error("Failed to get many", slog.String("err", err.Error()))
 		return
@@ -65,6 +103,23 @@ func LoadAndSendCallbacks(p *Processor, isBatch bool, sendFunc func(url string,
 	}
 }
 
+// loadUnsent loads the unsent callbacks, with tenant rate limits only as many callbacks per tenant as their limits
+// allow right now.
+func (p *Processor) loadUnsent(isBatch bool) ([]*store.CallbackData, error) {
+	if p.tenantShaper == nil {
+		return p.store.GetUnsent(p.ctx, p.batchSize, p.expiration, isBatch)
+	}
+
+	callbackRecords, err := p.store.GetUnsentByTenant(p.ctx, p.batchSize, p.expiration, isBatch, p.tenantShaper.quotas())
+	if err != nil {
+		return nil, err
+	}
+
+	p.tenantShaper.consume(callbackRecords)
+
+	return callbackRecords, nil
+}
+
 func (p *Processor) sendSingleCallbacks(url string, cbs []*store.CallbackData) {
 	cbIDs := make([]int64, len(cbs))
 	for i, cb := range cbs {
@@ -90,7 +145,33 @@ func (p *Processor) sendSingleCallbacks(url string, cbs []*store.CallbackData) {
 	}
 }
 
+// sendBatchCallback sends the callbacks to the URL in one batch per payload version, so that each batch has the schema
+// of the version its callbacks were requested with. The batches are sent one after another.
 func (p *Processor) sendBatchCallback(url string, cbs []*store.CallbackData) {
+	groups := groupByPayloadVersion(cbs)
+	for i, group := range groups {
+		if p.sendBatch(url, group) {
+			continue
+		}
+
+		// the batches after the failed one are not sent now, so that the callbacks to the URL stay in order
+		var remainingIDs []int64
+		for _, remaining := range groups[i+1:] {
+			for _, cb := range remaining {
+				remainingIDs = append(remainingIDs, cb.ID)
+			}
+		}
+		if len(remainingIDs) != 0 {
+			err := p.store.UnsetPending(p.ctx, remainingIDs)
+			if err != nil {
+				p.logger.Error("Failed to set not pending", slog.String("err", err.Error()))
+			}
+		}
+		return
+	}
+}
+
+func (p *Processor) sendBatch(url string, cbs []*store.CallbackData) bool {
 	batch := make([]*Callback, len(cbs))
 	cbIDs := make([]int64, len(cbs))
 	for i, cb := range cbs {
@@ -103,11 +184,31 @@ func (p *Processor) sendBatchCallback(url string, cbs []*store.CallbackData) {
 		if err != nil {
 			p.logger.Error("Failed to set not pending", slog.String("err", err.Error()))
 		}
-		return
+		return false
 	}
 
 	err := p.store.SetSent(p.ctx, cbIDs)
 	if err != nil {
 		p.logger.Error("Failed to set sent", slog.String("err", err.Error()))
 	}
+
+	return true
+}
+
+// groupByPayloadVersion groups the callbacks by their payload version in the order in which the versions first occur.
+func groupByPayloadVersion(cbs []*store.CallbackData) [][]*store.CallbackData {
+	var groups [][]*store.CallbackData
+	index := make(map[int]int)
+	for _, cb := range cbs {
+		version := payloadVersion(cb.Version)
+		i, found := index[version]
+		if !found {
+			i = len(groups)
+			index[version] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], cb)
+	}
+
+	return groups
 }
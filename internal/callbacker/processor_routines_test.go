@@ -55,6 +55,85 @@ func TestStartCallbackStoreCleanup(t *testing.T) {
 	}
 }
 
+func TestComputeSLAReports(t *testing.T) {
+	tt := []struct {
+		name       string
+		computeErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:       "error computing reports",
+			computeErr: errors.New("some error"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cbStore := &mocks.ProcessorStoreMock{
+				ComputeSLAReportsFunc: func(_ context.Context, _ string, _ time.Time, _ time.Time, _ time.Duration) (int64, error) {
+					return 2, tc.computeErr
+				},
+			}
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			processor, err := callbacker.NewProcessor(nil, cbStore, nil, logger, callbacker.WithExpiration(time.Hour), callbacker.WithSLAReports(time.Minute))
+			require.NoError(t, err)
+			defer processor.GracefulStop()
+
+			// when
+			callbacker.ComputeSLAReports(processor)
+
+			// then
+			calls := cbStore.ComputeSLAReportsCalls()
+			require.Len(t, calls, 4)
+			require.Equal(t, []string{"daily", "daily", "weekly", "weekly"}, []string{calls[0].Period, calls[1].Period, calls[2].Period, calls[3].Period})
+			require.Equal(t, time.Hour, calls[0].Expiration)
+			require.Equal(t, calls[0].To, calls[1].From)
+		})
+	}
+}
+
+func TestUpdateCallbackBacklog(t *testing.T) {
+	tt := []struct {
+		name           string
+		countUnsentErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:           "error counting unsent callbacks",
+			countUnsentErr: errors.New("some error"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cbStore := &mocks.ProcessorStoreMock{
+				CountUnsentFunc: func(_ context.Context, _ time.Duration) (int64, error) {
+					return 5, tc.countUnsentErr
+				},
+			}
+
+			logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+			processor, err := callbacker.NewProcessor(nil, cbStore, nil, logger, callbacker.WithExpiration(time.Hour))
+			require.NoError(t, err)
+			defer processor.GracefulStop()
+
+			// when
+			callbacker.UpdateCallbackBacklog(processor)
+
+			// then
+			require.Len(t, cbStore.CountUnsentCalls(), 1)
+			require.Equal(t, time.Hour, cbStore.CountUnsentCalls()[0].Expiration)
+		})
+	}
+}
+
 func TestSendCallbacks(t *testing.T) {
 	tt := []struct {
 		name        string
@@ -240,3 +319,118 @@ func TestSendBatchCallbacks(t *testing.T) {
 		})
 	}
 }
+
+func TestSendBatchCallbacks_Versions(t *testing.T) {
+	tt := []struct {
+		name        string
+		sendSuccess bool
+
+		expectedSendBatchCalls    int
+		expectedSetSentCalls      int
+		expectedUnsetPendingCalls int
+	}{
+		{
+			name:        "success - one batch per version",
+			sendSuccess: true,
+
+			expectedSendBatchCalls: 2,
+			expectedSetSentCalls:   2,
+		},
+		{
+			name: "no success - remaining batches not sent",
+
+			expectedSendBatchCalls:    1,
+			expectedUnsetPendingCalls: 2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			cbStore := &mocks.ProcessorStoreMock{
+				GetUnsentFunc: func(_ context.Context, _ int, _ time.Duration, _ bool) ([]*store.CallbackData, error) {
+					return []*store.CallbackData{
+						{ID: 1, URL: "abc-1.com", TxID: "tx-1", TxStatus: callbacker_api.Status_MINED.String(), Version: callbacker.CallbackVersion1},
+						{ID: 2, URL: "abc-1.com", TxID: "tx-2", TxStatus: callbacker_api.Status_MINED.String(), Version: callbacker.CallbackVersion2},
+						{ID: 3, URL: "abc-1.com", TxID: "tx-3", TxStatus: callbacker_api.Status_MINED.String()},
+					}, nil
+				},
+				SetSentFunc: func(_ context.Context, _ []int64) error {
+					return nil
+				},
+				UnsetPendingFunc: func(_ context.Context, _ []int64) error {
+					return nil
+				},
+			}
+
+			sender := &mocks.SenderIMock{
+				SendBatchFunc: func(_ string, _ string, callbacks []*callbacker.Callback) (bool, bool) {
+					for _, cb := range callbacks {
+						require.Equal(t, callbacks[0].Version, cb.Version)
+					}
+					return tc.sendSuccess, false
+				},
+			}
+
+			processor, err := callbacker.NewProcessor(sender, cbStore, nil, slog.Default())
+			require.NoError(t, err)
+			defer processor.GracefulStop()
+
+			// when
+			callbacker.LoadAndSendBatchCallbacks(processor)
+
+			// then
+			require.Len(t, sender.SendBatchCalls(), tc.expectedSendBatchCalls)
+			require.Len(t, cbStore.SetSentCalls(), tc.expectedSetSentCalls)
+			require.Len(t, cbStore.UnsetPendingCalls(), tc.expectedUnsetPendingCalls)
+
+			batches := sender.SendBatchCalls()
+			require.Len(t, batches[0].Callbacks, 2)
+			require.Zero(t, batches[0].Callbacks[0].Version)
+			if tc.sendSuccess {
+				require.Len(t, batches[1].Callbacks, 1)
+				require.Equal(t, callbacker.CallbackVersion2, batches[1].Callbacks[0].Version)
+			}
+		})
+	}
+}
+
+func TestSendBatchCallbacks_TenantRateLimits(t *testing.T) {
+	// given
+	cbStore := &mocks.ProcessorStoreMock{
+		GetUnsentByTenantFunc: func(_ context.Context, _ int, _ time.Duration, _ bool, _ store.TenantQuotas) ([]*store.CallbackData, error) {
+			return []*store.CallbackData{
+				{ID: 1, URL: "abc-1.com", TxID: "tx-1", TxStatus: callbacker_api.Status_MINED.String(), Tenant: "tenant-a"},
+				{ID: 2, URL: "abc-1.com", TxID: "tx-2", TxStatus: callbacker_api.Status_MINED.String(), Tenant: "tenant-a"},
+				{ID: 3, URL: "abc-2.com", TxID: "tx-3", TxStatus: callbacker_api.Status_MINED.String(), Tenant: "tenant-b"},
+			}, nil
+		},
+		SetSentFunc: func(_ context.Context, _ []int64) error {
+			return nil
+		},
+	}
+
+	sender := &mocks.SenderIMock{
+		SendBatchFunc: func(_ string, _ string, _ []*callbacker.Callback) (bool, bool) {
+			return true, false
+		},
+	}
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	processor, err := callbacker.NewProcessor(sender, cbStore, nil, logger,
+		callbacker.WithTenantRateLimits(map[string]callbacker.TenantRateLimit{"tenant-a": {Rate: 0.001, Burst: 5}}, nil),
+	)
+	require.NoError(t, err)
+	defer processor.GracefulStop()
+
+	// when
+	callbacker.LoadAndSendBatchCallbacks(processor)
+	callbacker.LoadAndSendBatchCallbacks(processor)
+
+	// then
+	require.Empty(t, cbStore.GetUnsentCalls())
+	require.Len(t, cbStore.GetUnsentByTenantCalls(), 2)
+	require.Equal(t, store.TenantQuotas{Quotas: map[string]int{"tenant-a": 5}, Default: -1}, cbStore.GetUnsentByTenantCalls()[0].Quotas)
+	require.Equal(t, store.TenantQuotas{Quotas: map[string]int{"tenant-a": 3}, Default: -1}, cbStore.GetUnsentByTenantCalls()[1].Quotas)
+	require.Len(t, sender.SendBatchCalls(), 4)
+}
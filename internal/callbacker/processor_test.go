@@ -68,9 +68,9 @@ func TestProcessor_StartStoreCallbackRequests(t *testing.T) {
 			require.NoError(t, err)
 
 			mqClient := &mqMocks.MessageQueueClientMock{
-				ConsumeFunc: func(_ string, msgFunc func([]byte) error) error {
+				ConsumeFunc: func(_ string, msgFunc func(context.Context, []byte) error) error {
 					for range 5 {
-						err := msgFunc(data)
+						err := msgFunc(context.Background(), data)
 						require.NoError(t, err)
 					}
 					return nil
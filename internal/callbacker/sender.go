@@ -2,16 +2,22 @@ package callbacker
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"io"
 	"log/slog"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/pkg/events"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
 )
 
 type CallbackSender struct {
@@ -22,6 +28,10 @@ type CallbackSender struct {
 	retries            int
 	retrySleepDuration time.Duration
 	timeout            time.Duration
+	signingSecret      string
+	payloadTemplates   []*PayloadTemplate
+	httpClient         *httpclient.Client
+	eventBus           *events.Bus
 }
 
 type SenderOption func(s *CallbackSender)
@@ -29,6 +39,9 @@ type SenderOption func(s *CallbackSender)
 const (
 	retriesDefault                = 5
 	initRetrySleepDurationDefault = 5 * time.Second
+
+	callbackTimestampHeader = "X-Callback-Timestamp"
+	callbackSignatureHeader = "X-Callback-Signature"
 )
 
 func WithInitRetrySleepDuration(d time.Duration) func(*CallbackSender) {
@@ -49,6 +62,28 @@ func WithTimeout(d time.Duration) func(*CallbackSender) {
 	}
 }
 
+// WithSigningSecret enables signing of callback payloads. Each request carries the unix timestamp of sending in the
+// X-Callback-Timestamp header and the HMAC-SHA256 of "<timestamp>.<payload>" in the X-Callback-Signature header.
+func WithSigningSecret(secret string) func(*CallbackSender) {
+	return func(s *CallbackSender) {
+		s.signingSecret = secret
+	}
+}
+
+// WithPayloadTemplates transforms the payloads of the callbacks with the first matching template.
+func WithPayloadTemplates(templates []*PayloadTemplate) func(*CallbackSender) {
+	return func(s *CallbackSender) {
+		s.payloadTemplates = templates
+	}
+}
+
+// WithEventBus publishes the callbacks which failed after all retries as CallbackFailed events on the bus.
+func WithEventBus(bus *events.Bus) func(*CallbackSender) {
+	return func(s *CallbackSender) {
+		s.eventBus = bus
+	}
+}
+
 func NewSender(logger *slog.Logger, opts ...SenderOption) (*CallbackSender, error) {
 	cbStats := newCallbackerStats()
 
@@ -60,6 +95,7 @@ func NewSender(logger *slog.Logger, opts ...SenderOption) (*CallbackSender, erro
 		cbStats.callbackMinedCount,
 		cbStats.callbackFailedCount,
 		cbStats.callbackBatchCount,
+		cbStats.callbackLatency,
 	)
 	if err != nil {
 		return nil, err
@@ -78,6 +114,8 @@ func NewSender(logger *slog.Logger, opts ...SenderOption) (*CallbackSender, erro
 		opt(callbacker)
 	}
 
+	callbacker.httpClient = httpclient.New("callbacker", httpclient.WithTimeout(callbacker.timeout))
+
 	return callbacker, nil
 }
 
@@ -100,6 +138,7 @@ func (p *CallbackSender) GracefulStop() {
 		p.stats.callbackMinedCount,
 		p.stats.callbackFailedCount,
 		p.stats.callbackBatchCount,
+		p.stats.callbackLatency,
 	)
 
 	p.disposed = true
@@ -118,7 +157,17 @@ func (p *CallbackSender) Health() error {
 }
 
 func (p *CallbackSender) Send(url, token string, dto *Callback) (success, retry bool) {
-	payload, err := json.Marshal(dto)
+	contentType := contentTypeJSON
+	var payload []byte
+	var err error
+
+	tmpl := p.payloadTemplate(url, token)
+	if tmpl != nil {
+		contentType = tmpl.ContentType
+		payload, err = tmpl.payload(dto)
+	} else {
+		payload, err = json.Marshal(dto)
+	}
 	if err != nil {
 		p.logger.Error("Failed to marshal callback",
 			slog.String("url", url),
@@ -131,7 +180,7 @@ func (p *CallbackSender) Send(url, token string, dto *Callback) (success, retry
 	}
 	var retries int
 
-	success, retry, retries = sendCallbackWithRetries(url, token, payload, p.logger.With(slog.String("hash", dto.TxID), slog.String("status", dto.TxStatus)), p.timeout, p.retrySleepDuration, p.retries)
+	success, retry, retries = sendCallbackWithRetries(url, token, p.signingSecret, contentType, payload, p.logger.With(slog.String("hash", dto.TxID), slog.String("status", dto.TxStatus)), p.httpClient, p.retrySleepDuration, p.retries)
 
 	if success {
 		p.logger.Info("Callback sent",
@@ -143,7 +192,7 @@ func (p *CallbackSender) Send(url, token string, dto *Callback) (success, retry
 			slog.Int("retries", retries),
 		)
 
-		p.updateSuccessStats(dto.TxStatus)
+		p.updateSuccessStats(dto)
 		return success, retry
 	}
 
@@ -157,16 +206,43 @@ func (p *CallbackSender) Send(url, token string, dto *Callback) (success, retry
 	)
 
 	p.stats.callbackFailedCount.Inc()
+	p.publishFailed(url, dto, false, retries)
 	return success, retry
 }
 
+// SendBatch sends the callbacks in one batch. All callbacks of a batch must have the same payload version, which is
+// the version of the batch.
 func (p *CallbackSender) SendBatch(url, token string, dtos []*Callback) (success, retry bool) {
 	batch := BatchCallback{
 		Count:     len(dtos),
 		Callbacks: dtos,
 	}
+	if len(dtos) > 0 {
+		batch.Version = dtos[0].Version
+	}
 
-	payload, err := json.Marshal(batch)
+	contentType := contentTypeJSON
+	var payload []byte
+	var err error
+
+	tmpl := p.payloadTemplate(url, token)
+	switch {
+	case tmpl != nil && tmpl.batchTemplate == nil:
+		// receivers with a fixed schema for single callbacks get the callbacks of the batch one by one
+		success = true
+		for _, dto := range dtos {
+			success, retry = p.Send(url, token, dto)
+			if !success {
+				return success, retry
+			}
+		}
+		return success, retry
+	case tmpl != nil:
+		contentType = tmpl.ContentType
+		payload, err = tmpl.batchPayload(batch)
+	default:
+		payload, err = json.Marshal(batch)
+	}
 	if err != nil {
 		p.logger.Error("Failed to marshal callback",
 			slog.String("url", url),
@@ -178,7 +254,7 @@ func (p *CallbackSender) SendBatch(url, token string, dtos []*Callback) (success
 	}
 	var retries int
 
-	success, retry, retries = sendCallbackWithRetries(url, token, payload, p.logger.With(slog.Int("batch size", len(dtos))), p.timeout, p.retrySleepDuration, p.retries)
+	success, retry, retries = sendCallbackWithRetries(url, token, p.signingSecret, contentType, payload, p.logger.With(slog.Int("batch size", len(dtos))), p.httpClient, p.retrySleepDuration, p.retries)
 	p.stats.callbackBatchCount.Inc()
 	if success {
 		for _, dto := range dtos {
@@ -192,7 +268,7 @@ func (p *CallbackSender) SendBatch(url, token string, dtos []*Callback) (success
 				slog.Int("batch size", len(dtos)),
 			)
 
-			p.updateSuccessStats(dto.TxStatus)
+			p.updateSuccessStats(dto)
 		}
 		return success, retry
 	}
@@ -204,10 +280,28 @@ func (p *CallbackSender) SendBatch(url, token string, dtos []*Callback) (success
 		slog.Int("retries", retries))
 
 	p.stats.callbackFailedCount.Inc()
+	for _, dto := range dtos {
+		p.publishFailed(url, dto, true, retries)
+	}
 	return success, retry
 }
 
-func sendCallbackWithRetries(url, token string, jsonPayload []byte, logger *slog.Logger, timeout time.Duration, retrySleepDuration time.Duration, retries int) (success bool, retry bool, nrOfRetries int) {
+func (p *CallbackSender) publishFailed(url string, dto *Callback, batch bool, retries int) {
+	if p.eventBus == nil {
+		return
+	}
+
+	p.eventBus.Publish(events.CallbackFailed{
+		URL:       url,
+		TxID:      dto.TxID,
+		TxStatus:  dto.TxStatus,
+		Batch:     batch,
+		Retries:   retries,
+		Timestamp: time.Now(),
+	})
+}
+
+func sendCallbackWithRetries(url, token, signingSecret, contentType string, payload []byte, logger *slog.Logger, client httpclient.Doer, retrySleepDuration time.Duration, retries int) (success bool, retry bool, nrOfRetries int) {
 	retrySleep := retrySleepDuration
 	var err error
 	var statusCode int
@@ -216,7 +310,7 @@ func sendCallbackWithRetries(url, token string, jsonPayload []byte, logger *slog
 	retry = true
 	for range retries {
 		nrOfRetries++
-		statusCode, responseText, err = sendCallback(url, token, jsonPayload, timeout)
+		statusCode, responseText, err = sendCallback(client, url, token, signingSecret, contentType, payload)
 		if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
 			success = true
 			retry = false
@@ -272,15 +366,13 @@ var (
 	ErrHTTPSendFailed          = errors.New("failed to send http request")
 )
 
-func sendCallback(url, token string, payload []byte, timeout time.Duration) (statusCode int, responseText string, err error) {
-	request, err := httpRequest(url, token, payload)
+func sendCallback(client httpclient.Doer, url, token, signingSecret, contentType string, payload []byte) (statusCode int, responseText string, err error) {
+	request, err := httpRequest(url, token, signingSecret, contentType, payload)
 	if err != nil {
 		return 0, responseText, errors.Join(ErrCreateHTTPRequestFailed, err)
 	}
 
-	httpClient := &http.Client{Timeout: timeout}
-
-	response, err := httpClient.Do(request)
+	response, err := client.Do(request)
 	if err != nil {
 		if strings.Contains(err.Error(), "no such host") {
 			return 0, responseText, errors.Join(ErrHostNonExistent, err)
@@ -300,8 +392,23 @@ func sendCallback(url, token string, payload []byte, timeout time.Duration) (sta
 	return response.StatusCode, responseText, nil
 }
 
-func (p *CallbackSender) updateSuccessStats(txStatus string) {
-	status, ok := callbacker_api.Status_value[txStatus]
+// payloadTemplate returns the first payload template matching the callback URL and token, nil if none matches.
+func (p *CallbackSender) payloadTemplate(url, token string) *PayloadTemplate {
+	for _, tmpl := range p.payloadTemplates {
+		if tmpl.matches(url, token) {
+			return tmpl
+		}
+	}
+
+	return nil
+}
+
+func (p *CallbackSender) updateSuccessStats(dto *Callback) {
+	if !dto.Timestamp.IsZero() {
+		p.stats.callbackLatency.Observe(time.Since(dto.Timestamp).Seconds())
+	}
+
+	status, ok := callbacker_api.Status_value[dto.TxStatus]
 	if ok {
 		switch callbacker_api.Status(status) {
 		case callbacker_api.Status_SEEN_ON_NETWORK:
@@ -319,17 +426,33 @@ func (p *CallbackSender) updateSuccessStats(txStatus string) {
 	}
 }
 
-func httpRequest(url, token string, payload []byte) (*http.Request, error) {
+func httpRequest(url, token, signingSecret, contentType string, payload []byte) (*http.Request, error) {
 	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("Content-Type", contentType)
 
 	if token != "" {
 		req.Header.Set("Authorization", "Bearer "+token)
 	}
 
+	if signingSecret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(callbackTimestampHeader, timestamp)
+		req.Header.Set(callbackSignatureHeader, "sha256="+signPayload(signingSecret, timestamp, payload))
+	}
+
 	return req, nil
 }
+
+// signPayload returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" using the given secret.
+func signPayload(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -1,7 +1,11 @@
 package callbacker_test
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
@@ -12,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/callbacker"
+	"github.com/bitcoin-sv/arc/pkg/events"
 )
 
 func TestCallbackSender_Send(t *testing.T) {
@@ -62,7 +67,11 @@ func TestCallbackSender_Send(t *testing.T) {
 			defer server.Close()
 
 			logger := slog.Default()
-			sut, err := callbacker.NewSender(logger, callbacker.WithRetries(5), callbacker.WithInitRetrySleepDuration(20*time.Millisecond), callbacker.WithTimeout(500*time.Second))
+			eventBus := events.New(logger)
+			failedCh := make(chan events.CallbackFailed, 1)
+			events.Subscribe(eventBus, "failed-callbacks", func(event events.CallbackFailed) { failedCh <- event })
+
+			sut, err := callbacker.NewSender(logger, callbacker.WithRetries(5), callbacker.WithInitRetrySleepDuration(20*time.Millisecond), callbacker.WithTimeout(500*time.Second), callbacker.WithEventBus(eventBus))
 			require.NoError(t, err)
 
 			defer sut.GracefulStop()
@@ -74,10 +83,20 @@ func TestCallbackSender_Send(t *testing.T) {
 
 			//When
 			success, retry := sut.Send(url, "test-token", &callbacker.Callback{TxID: "1234", TxStatus: "SEEN_ON_NETWORK"})
+			eventBus.Shutdown()
 			//Then
 			require.Equal(t, tc.expectedSuccess, success, "Expected success to be %v, but got %v", tc.expectedSuccess, success)
 			require.Equal(t, tc.expectedRetry, retry, "Expected retry to be %v, but got %v", tc.expectedRetry, retry)
 			require.Equal(t, tc.expectedRetries, retries, "Expected retries to be %d, but got %d", tc.expectedRetries, retries)
+			if tc.expectedSuccess {
+				require.Empty(t, failedCh)
+				return
+			}
+			require.Len(t, failedCh, 1)
+			failed := <-failedCh
+			require.Equal(t, url, failed.URL)
+			require.Equal(t, "1234", failed.TxID)
+			require.Equal(t, "SEEN_ON_NETWORK", failed.TxStatus)
 		})
 	}
 }
@@ -182,10 +201,127 @@ func TestCallbackSender_SendBatch(t *testing.T) {
 		})
 	}
 }
+
+func TestCallbackSender_Version1(t *testing.T) {
+	// the fields added in version 2, including the version field, are not sent in payloads of version 1 even if the
+	// callbacks have version 1 set explicitly
+	v2Keys := []string{"version", "minedTxid"}
+
+	var payloads []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	sut, err := callbacker.NewSender(slog.Default(), callbacker.WithRetries(1))
+	require.NoError(t, err)
+	defer sut.GracefulStop()
+
+	minedTxID := "5678"
+	callback := &callbacker.Callback{Version: callbacker.CallbackVersion1, TxID: "1234", TxStatus: "MINED", MinedTxID: &minedTxID}
+
+	// When
+	success, _ := sut.Send(server.URL, "test-token", callback)
+	require.True(t, success)
+	success, _ = sut.SendBatch(server.URL, "test-token", []*callbacker.Callback{callback, callback})
+	require.True(t, success)
+
+	// Then
+	require.Len(t, payloads, 2)
+	single, batch := payloads[0], payloads[1]
+	for _, key := range v2Keys {
+		require.NotContains(t, single, key)
+		require.NotContains(t, batch, key)
+	}
+
+	callbacks, ok := batch["callbacks"].([]any)
+	require.True(t, ok)
+	require.Len(t, callbacks, 2)
+	for _, cb := range callbacks {
+		for _, key := range v2Keys {
+			require.NotContains(t, cb, key)
+		}
+	}
+}
+
+func TestCallbackSender_Send_Signed(t *testing.T) {
+	tests := []struct {
+		name          string
+		signingSecret string
+		version       int
+
+		expectedSigned  bool
+		expectedVersion bool
+	}{
+		{
+			name: "version 1 - unsigned",
+
+			expectedSigned:  false,
+			expectedVersion: false,
+		},
+		{
+			name:          "version 2 - signed",
+			signingSecret: "secret",
+			version:       callbacker.CallbackVersion2,
+
+			expectedSigned:  true,
+			expectedVersion: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				timestamp := r.Header.Get("X-Callback-Timestamp")
+				signature := r.Header.Get("X-Callback-Signature")
+				if tc.expectedSigned {
+					mac := hmac.New(sha256.New, []byte(tc.signingSecret))
+					mac.Write([]byte(timestamp + "." + string(body)))
+					require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), signature)
+				} else {
+					require.Empty(t, timestamp)
+					require.Empty(t, signature)
+				}
+
+				var payload map[string]any
+				require.NoError(t, json.Unmarshal(body, &payload))
+				_, found := payload["version"]
+				require.Equal(t, tc.expectedVersion, found)
+				// the fields added in version 2 are only sent in payloads of version 2
+				_, found = payload["minedTxid"]
+				require.Equal(t, tc.expectedVersion, found)
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			sut, err := callbacker.NewSender(slog.Default(), callbacker.WithRetries(1), callbacker.WithSigningSecret(tc.signingSecret))
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// When
+			minedTxID := "5678"
+			success, _ := sut.Send(server.URL, "test-token", &callbacker.Callback{Version: tc.version, TxID: "1234", TxStatus: "MINED", MinedTxID: &minedTxID})
+
+			// Then
+			require.True(t, success)
+		})
+	}
+}
+
 func TestCallbackSender_Send_WithRetries(t *testing.T) {
 	// Given
 	logger := slog.Default()
 	sut, _ := callbacker.NewSender(logger, callbacker.WithRetries(5), callbacker.WithInitRetrySleepDuration(50*time.Millisecond))
+	defer sut.GracefulStop()
 
 	retryCount := 0
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
@@ -206,3 +342,131 @@ func TestCallbackSender_Send_WithRetries(t *testing.T) {
 	assert.True(t, ok)
 	assert.False(t, retry)
 }
+
+func TestCallbackSender_PayloadTemplates(t *testing.T) {
+	tests := []struct {
+		name          string
+		token         string
+		batch         bool
+		batchTemplate string
+
+		expectedContentType string
+		expectedPayloads    []string
+	}{
+		{
+			name:  "single callback - template applied",
+			token: "event-bus-token",
+
+			expectedContentType: "application/cloudevents+json",
+			expectedPayloads:    []string{`{"type":"arc.MINED","id":"1234"}`},
+		},
+		{
+			name:  "single callback - token does not match",
+			token: "other-token",
+
+			expectedContentType: "application/json; charset=UTF-8",
+			expectedPayloads:    []string{`{"timestamp":"0001-01-01T00:00:00Z","txid":"1234","txStatus":"MINED"}`},
+		},
+		{
+			name:  "batch - callbacks sent one by one",
+			token: "event-bus-token",
+			batch: true,
+
+			expectedContentType: "application/cloudevents+json",
+			expectedPayloads:    []string{`{"type":"arc.MINED","id":"1234"}`, `{"type":"arc.REJECTED","id":"5678"}`},
+		},
+		{
+			name:          "batch - batch template applied",
+			token:         "event-bus-token",
+			batch:         true,
+			batchTemplate: `{"events":{{ .Count }},"ids":[{{ range $i, $cb := .Callbacks }}{{ if $i }},{{ end }}{{ json $cb.TxID }}{{ end }}]}`,
+
+			expectedContentType: "application/cloudevents+json",
+			expectedPayloads:    []string{`{"events":2,"ids":["1234","5678"]}`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var payloads []string
+			var contentType string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				payloads = append(payloads, string(body))
+				contentType = r.Header.Get("Content-Type")
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			tmpl, err := callbacker.NewPayloadTemplate(server.URL, "event-bus-token", "application/cloudevents+json",
+				`{"type":"arc.{{ .TxStatus }}","id":{{ json .TxID }}}`, tc.batchTemplate)
+			require.NoError(t, err)
+
+			sut, err := callbacker.NewSender(slog.Default(), callbacker.WithRetries(1), callbacker.WithPayloadTemplates([]*callbacker.PayloadTemplate{tmpl}))
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			var success bool
+			if tc.batch {
+				success, _ = sut.SendBatch(server.URL, tc.token, []*callbacker.Callback{
+					{TxID: "1234", TxStatus: "MINED"},
+					{TxID: "5678", TxStatus: "REJECTED"},
+				})
+			} else {
+				success, _ = sut.Send(server.URL, tc.token, &callbacker.Callback{TxID: "1234", TxStatus: "MINED"})
+			}
+
+			// then
+			require.True(t, success)
+			require.Equal(t, tc.expectedContentType, contentType)
+			require.Equal(t, tc.expectedPayloads, payloads)
+		})
+	}
+}
+
+func TestNewPayloadTemplate(t *testing.T) {
+	tests := []struct {
+		name      string
+		urlPrefix string
+		template  string
+
+		expectedErrorStr string
+	}{
+		{
+			name:      "success",
+			urlPrefix: "https://events.example.com/",
+			template:  `{"id":{{ json .TxID }}}`,
+		},
+		{
+			name:     "error - neither url prefix nor token",
+			template: `{"id":{{ json .TxID }}}`,
+
+			expectedErrorStr: callbacker.ErrInvalidPayloadTemplate.Error(),
+		},
+		{
+			name:      "error - invalid template",
+			urlPrefix: "https://events.example.com/",
+			template:  `{"id":{{ json .TxID }`,
+
+			expectedErrorStr: callbacker.ErrInvalidPayloadTemplate.Error(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := callbacker.NewPayloadTemplate(tc.urlPrefix, "", "", tc.template, "")
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package callbacker
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"time"
 
@@ -14,19 +15,31 @@ import (
 	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
 	"github.com/bitcoin-sv/arc/internal/callbacker/store"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/slareport"
 )
 
+var ErrErasureFilter = errors.New("erasure requires a tenant or transactions")
+
 type Server struct {
 	callbacker_api.UnimplementedCallbackerAPIServer
 	grpc_utils.GrpcServer
-	store    store.ProcessorStore
-	mqClient mq.MessageQueueClient
-	logger   *slog.Logger
+	store     store.ProcessorStore
+	mqClient  mq.MessageQueueClient
+	logger    *slog.Logger
+	logLevels *arcLogger.Levels
+}
+
+// WithServerLogLevels enables the operations changing the log levels of the process at runtime.
+func WithServerLogLevels(levels *arcLogger.Levels) func(*Server) {
+	return func(s *Server) {
+		s.logLevels = levels
+	}
 }
 
 // NewServer will return a server instance
-func NewServer(logger *slog.Logger, callbackerStore store.ProcessorStore, mqClient mq.MessageQueueClient, cfg grpc_utils.ServerConfig) (*Server, error) {
+func NewServer(logger *slog.Logger, callbackerStore store.ProcessorStore, mqClient mq.MessageQueueClient, cfg grpc_utils.ServerConfig, opts ...func(*Server)) (*Server, error) {
 	grpcServer, err := grpc_utils.NewGrpcServer(logger, cfg)
 	if err != nil {
 		return nil, err
@@ -38,6 +51,10 @@ func NewServer(logger *slog.Logger, callbackerStore store.ProcessorStore, mqClie
 		logger:     logger,
 		mqClient:   mqClient,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
 	// register health server endpoint
 	grpc_health_v1.RegisterHealthServer(grpcServer.Srv, s)
 
@@ -68,7 +85,83 @@ func (s *Server) SendCallback(ctx context.Context, request *callbacker_api.SendR
 	return nil, nil
 }
 
+// GetSLAReports returns the callback delivery SLA reports per tenant of the periods starting between from and to, until
+// now if to is not given.
+func (s *Server) GetSLAReports(ctx context.Context, request *callbacker_api.SLAReportsRequest) (*callbacker_api.SLAReports, error) {
+	err := slareport.ValidatePeriod(request.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	to := time.Now()
+	if request.GetTo() != nil {
+		to = request.GetTo().AsTime()
+	}
+
+	reports, err := s.store.GetSLAReports(ctx, request.GetPeriod(), request.GetFrom().AsTime(), to, request.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &callbacker_api.SLAReports{Reports: make([]*callbacker_api.SLAReport, 0, len(reports))}
+	for _, report := range reports {
+		result.Reports = append(result.Reports, &callbacker_api.SLAReport{
+			Period:      report.Period,
+			PeriodStart: timestamppb.New(report.PeriodStart),
+			Tenant:      report.Tenant,
+			Callbacks:   report.Callbacks,
+			Delivered:   report.Delivered,
+			Failed:      report.Failed,
+			ComputedAt:  timestamppb.New(report.ComputedAt),
+		})
+	}
+
+	return result, nil
+}
+
+// EraseCallbacks deletes the callbacks of the transactions of the tenant or with the given ids, including their callback
+// URLs and tokens.
+func (s *Server) EraseCallbacks(ctx context.Context, request *callbacker_api.EraseCallbacksRequest) (*callbacker_api.EraseCallbacksResponse, error) {
+	if request.GetTenant() == "" && len(request.GetTxids()) == 0 {
+		return nil, ErrErasureFilter
+	}
+
+	erased, err := s.store.EraseCallbacks(ctx, request.GetTenant(), request.GetTxids())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Erased callbacks", slog.String("tenant", request.GetTenant()), slog.Int("txs", len(request.GetTxids())), slog.Int64("callbacks", erased))
+
+	return &callbacker_api.EraseCallbacksResponse{Callbacks: erased}, nil
+}
+
 // ptrTo returns a pointer to the given value.
 func ptrTo[T any](v T) *T {
 	return &v
 }
+
+// SetLogLevel sets the log level of a component of the process running callbacker, or of the process if no component is
+// given, until the next restart.
+func (s *Server) SetLogLevel(ctx context.Context, req *callbacker_api.LogLevelRequest) (*emptypb.Empty, error) {
+	err := s.logLevels.SetLevelName(req.GetComponent(), req.GetLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level changed", slog.String("component", req.GetComponent()), slog.String("level", req.GetLevel()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResetLogLevel resets the log level of a component of the process running callbacker to the log level of the process.
+func (s *Server) ResetLogLevel(ctx context.Context, req *callbacker_api.LogLevelRequest) (*emptypb.Empty, error) {
+	err := s.logLevels.ResetComponentLevel(req.GetComponent())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level reset", slog.String("component", req.GetComponent()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -10,13 +10,16 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bitcoin-sv/arc/internal/callbacker"
 	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
 	"github.com/bitcoin-sv/arc/internal/callbacker/mocks"
 	"github.com/bitcoin-sv/arc/internal/callbacker/store"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
+	"github.com/bitcoin-sv/arc/internal/slareport"
 )
 
 func TestNewServer(t *testing.T) {
@@ -95,3 +98,147 @@ func TestSendCallback(t *testing.T) {
 		time.Sleep(100 * time.Millisecond)
 	})
 }
+
+func TestGetSLAReports(t *testing.T) {
+	day := time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name   string
+		period string
+
+		expectedGetCalls int
+		expectedErr      error
+	}{
+		{
+			name:   "success",
+			period: "daily",
+
+			expectedGetCalls: 1,
+		},
+		{
+			name:   "unknown period",
+			period: "monthly",
+
+			expectedErr: slareport.ErrUnknownPeriod,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			callbackerStore := &mocks.ProcessorStoreMock{
+				GetSLAReportsFunc: func(_ context.Context, period string, _ time.Time, _ time.Time, tenant string) ([]store.SLAReport, error) {
+					return []store.SLAReport{{Period: period, PeriodStart: day, Tenant: tenant, Callbacks: 20, Delivered: 15, Failed: 5, ComputedAt: day}}, nil
+				},
+			}
+
+			sut, err := callbacker.NewServer(slog.Default(), callbackerStore, nil, grpc_utils.ServerConfig{Name: "callbacker_sla_reports_test"})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.GetSLAReports(context.Background(), &callbacker_api.SLAReportsRequest{
+				Period: tc.period,
+				From:   timestamppb.New(day),
+				To:     timestamppb.New(day.AddDate(0, 0, 1)),
+				Tenant: "exchange-a",
+			})
+
+			// then
+			require.Len(t, callbackerStore.GetSLAReportsCalls(), tc.expectedGetCalls)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, day, callbackerStore.GetSLAReportsCalls()[0].From)
+			require.Equal(t, day.AddDate(0, 0, 1), callbackerStore.GetSLAReportsCalls()[0].To)
+			require.Len(t, actual.GetReports(), 1)
+			require.Equal(t, "exchange-a", actual.GetReports()[0].GetTenant())
+			require.Equal(t, int64(15), actual.GetReports()[0].GetDelivered())
+			require.Equal(t, int64(5), actual.GetReports()[0].GetFailed())
+		})
+	}
+}
+
+func TestEraseCallbacks(t *testing.T) {
+	tt := []struct {
+		name   string
+		tenant string
+		txIDs  []string
+
+		expectedEraseCalls int
+		expectedErr        error
+	}{
+		{
+			name:   "tenant",
+			tenant: "exchange-a",
+
+			expectedEraseCalls: 1,
+		},
+		{
+			name:  "transactions",
+			txIDs: []string{"1234"},
+
+			expectedEraseCalls: 1,
+		},
+		{
+			name: "neither tenant nor transactions",
+
+			expectedErr: callbacker.ErrErasureFilter,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			callbackerStore := &mocks.ProcessorStoreMock{
+				EraseCallbacksFunc: func(_ context.Context, _ string, _ []string) (int64, error) {
+					return 2, nil
+				},
+			}
+
+			sut, err := callbacker.NewServer(slog.Default(), callbackerStore, nil, grpc_utils.ServerConfig{Name: "callbacker_erase_test"})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.EraseCallbacks(context.Background(), &callbacker_api.EraseCallbacksRequest{Tenant: tc.tenant, Txids: tc.txIDs})
+
+			// then
+			require.Len(t, callbackerStore.EraseCallbacksCalls(), tc.expectedEraseCalls)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(2), actual.GetCallbacks())
+			require.Equal(t, tc.tenant, callbackerStore.EraseCallbacksCalls()[0].Tenant)
+			require.Equal(t, tc.txIDs, callbackerStore.EraseCallbacksCalls()[0].TxIDs)
+		})
+	}
+}
+
+func TestLogLevels(t *testing.T) {
+	t.Run("set and reset log level", func(t *testing.T) {
+		// given
+		levels := arcLogger.NewLevels(arcLogger.LevelInfo)
+		sut, err := callbacker.NewServer(slog.Default(), nil, nil, grpc_utils.ServerConfig{Name: "callbacker_log_levels_test"}, callbacker.WithServerLogLevels(levels))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.SetLogLevel(context.Background(), &callbacker_api.LogLevelRequest{Component: "callbacker", Level: "ERROR"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, arcLogger.LevelError, levels.Level("callbacker"))
+
+		// when
+		_, err = sut.ResetLogLevel(context.Background(), &callbacker_api.LogLevelRequest{Component: "callbacker"})
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, arcLogger.LevelInfo, levels.Level("callbacker"))
+	})
+}
@@ -14,6 +14,7 @@ type stats struct {
 	callbackMinedCount                prometheus.Gauge
 	callbackFailedCount               prometheus.Gauge
 	callbackBatchCount                prometheus.Gauge
+	callbackLatency                   prometheus.Histogram
 }
 
 func newCallbackerStats() *stats {
@@ -46,9 +47,28 @@ func newCallbackerStats() *stats {
 			Name: "arc_callback_batch_count",
 			Help: "Number of arc_callback_batch_count transactions",
 		}),
+		callbackLatency: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "arc_callback_sent_latency_seconds",
+			Help:    "Latency between the status update of a transaction and the successful sending of its callback",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 11),
+		}),
 	}
 }
 
+func newBacklogGauge() prometheus.Gauge {
+	return prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "arc_callback_backlog_count",
+		Help: "Number of callbacks which have not been sent yet and are not expired",
+	})
+}
+
+func newTenantBacklogGauge() *prometheus.GaugeVec {
+	return prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "arc_callback_tenant_backlog_count",
+		Help: "Number of callbacks per tenant which have not been sent yet and are not expired",
+	}, []string{"tenant"})
+}
+
 func registerStats(cs ...prometheus.Collector) error {
 	for _, c := range cs {
 		err := prometheus.Register(c)
@@ -0,0 +1,144 @@
+// Package conformance contains the tests which every implementation of store.ProcessorStore has to pass. The tests
+// only use the methods of the interface, so they can be run against any store, e.g. against a postgres database of a
+// managed postgres flavor before deploying ARC with it.
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/callbacker/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+// Run runs the conformance tests. newStore is called by every test and has to return a store without callbacks, it is
+// responsible for closing the store and deleting its data when the test has finished, e.g. with t.Cleanup.
+func Run(t *testing.T, newStore func(t *testing.T) store.ProcessorStore) {
+	ctx := context.Background()
+
+	newCallbacks := func() []*store.CallbackData {
+		now := time.Now()
+		return []*store.CallbackData{
+			{URL: "https://callback-1.example.com", Token: "token", TxID: testdata.TX2, TxStatus: "SEEN_ON_NETWORK", Timestamp: now, Tenant: "tenant-1"},
+			{URL: "https://callback-2.example.com", Token: "token", TxID: testdata.TX3, TxStatus: "SEEN_ON_NETWORK", Timestamp: now, Tenant: "tenant-2"},
+		}
+	}
+
+	ids := func(callbacks []*store.CallbackData) []int64 {
+		result := make([]int64, len(callbacks))
+		for i, callback := range callbacks {
+			result[i] = callback.ID
+		}
+		return result
+	}
+
+	t.Run("insert and get unsent", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		inserted, err := sut.Insert(ctx, newCallbacks())
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, int64(2), inserted)
+
+		unsent, err := sut.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Len(t, unsent, 2)
+		for _, callback := range unsent {
+			assert.Equal(t, "token", callback.Token)
+			assert.Equal(t, "SEEN_ON_NETWORK", callback.TxStatus)
+		}
+
+		// pending callbacks are not returned again
+		unsent, err = sut.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		assert.Empty(t, unsent)
+	})
+
+	t.Run("insert deduplicates callbacks", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		_, err := sut.Insert(ctx, newCallbacks())
+		require.NoError(t, err)
+
+		// when
+		inserted, err := sut.Insert(ctx, newCallbacks())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), inserted)
+	})
+
+	t.Run("set sent", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		_, err := sut.Insert(ctx, newCallbacks())
+		require.NoError(t, err)
+		unsent, err := sut.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+
+		// when
+		err = sut.SetSent(ctx, ids(unsent))
+
+		// then
+		require.NoError(t, err)
+
+		count, err := sut.CountUnsent(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("unset pending", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		_, err := sut.Insert(ctx, newCallbacks())
+		require.NoError(t, err)
+		unsent, err := sut.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+
+		// when
+		err = sut.UnsetPending(ctx, ids(unsent))
+
+		// then
+		require.NoError(t, err)
+
+		unsent, err = sut.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		assert.Len(t, unsent, 2)
+
+		count, err := sut.CountUnsent(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	t.Run("erase callbacks", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		_, err := sut.Insert(ctx, newCallbacks())
+		require.NoError(t, err)
+
+		// when
+		erased, err := sut.EraseCallbacks(ctx, "tenant-1", nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), erased)
+
+		counts, err := sut.CountUnsentByTenant(ctx, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]int64{"tenant-2": 1}, counts)
+
+		// when
+		erased, err = sut.EraseCallbacks(ctx, "", []string{testdata.TX3})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), erased)
+	})
+}
@@ -0,0 +1,26 @@
+package postgresql
+
+import (
+	"embed"
+	"io/fs"
+
+	"github.com/bitcoin-sv/arc/internal/migration"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// backfills are the backfills of the expand migrations which are not contracted yet
+var backfills []migration.Backfill
+
+// Migrations returns the schema migrations of the callbacker database.
+func Migrations() fs.FS {
+	migrations, _ := fs.Sub(migrationFiles, "migrations")
+	return migrations
+}
+
+// Backfills returns the long-running data migrations of the callbacker database. They are run in batches in the
+// background after the schema migrations, e.g. to copy the columns added in the expand phase of a schema change.
+func Backfills() []migration.Backfill {
+	return backfills
+}
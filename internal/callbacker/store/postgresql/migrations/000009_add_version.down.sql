@@ -0,0 +1 @@
+ALTER TABLE callbacker.transaction_callbacks DROP COLUMN version;
@@ -0,0 +1 @@
+ALTER TABLE callbacker.transaction_callbacks ADD COLUMN version INTEGER DEFAULT 1 NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX callbacker.ux_transaction_callbacks_deduplicate;
+
+ALTER TABLE callbacker.transaction_callbacks DROP COLUMN deduplicate;
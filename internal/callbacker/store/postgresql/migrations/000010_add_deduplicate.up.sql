@@ -0,0 +1,5 @@
+-- existing rows may contain duplicates, therefore they are excluded from deduplication
+ALTER TABLE callbacker.transaction_callbacks ADD COLUMN deduplicate BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE callbacker.transaction_callbacks ALTER COLUMN deduplicate SET DEFAULT true;
+
+CREATE UNIQUE INDEX ux_transaction_callbacks_deduplicate ON callbacker.transaction_callbacks (url, tx_id, tx_status) WHERE deduplicate AND block_hash IS NULL;
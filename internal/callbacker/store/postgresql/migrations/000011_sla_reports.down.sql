@@ -0,0 +1,3 @@
+DROP TABLE callbacker.sla_reports;
+
+ALTER TABLE callbacker.transaction_callbacks DROP COLUMN tenant;
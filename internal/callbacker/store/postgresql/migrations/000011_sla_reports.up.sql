@@ -0,0 +1,16 @@
+-- 'tenant' is the name of the tenant whose API key submitted the transaction of the callback
+ALTER TABLE callbacker.transaction_callbacks ADD COLUMN tenant TEXT;
+
+-- 'sla_reports' holds the delivery metrics of the callbacks of a tenant which were created in a daily or weekly period.
+-- Callbacks are failed if they have not been delivered within the expiration. The reports are kept after the callbacks
+-- have been pruned.
+CREATE TABLE callbacker.sla_reports (
+    period TEXT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    tenant TEXT NOT NULL,
+    callbacks BIGINT NOT NULL,
+    delivered BIGINT NOT NULL,
+    failed BIGINT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (period, period_start, tenant)
+);
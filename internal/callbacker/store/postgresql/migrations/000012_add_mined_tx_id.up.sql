@@ -0,0 +1 @@
+ALTER TABLE callbacker.transaction_callbacks ADD COLUMN mined_tx_id TEXT;
@@ -0,0 +1,4 @@
+DROP INDEX callbacker.ix_transaction_callbacks_coalesce;
+
+ALTER TABLE callbacker.transaction_callbacks DROP COLUMN deliver_after;
+ALTER TABLE callbacker.transaction_callbacks DROP COLUMN coalesce_statuses;
@@ -0,0 +1,6 @@
+-- 'coalesce_statuses' marks the callbacks of URLs which coalesce the intermediate statuses of a transaction within a
+-- suppression window, 'deliver_after' is the end of the window before which such a callback is not sent
+ALTER TABLE callbacker.transaction_callbacks ADD COLUMN coalesce_statuses BOOLEAN DEFAULT false NOT NULL;
+ALTER TABLE callbacker.transaction_callbacks ADD COLUMN deliver_after TIMESTAMPTZ NULL;
+
+CREATE INDEX ix_transaction_callbacks_coalesce ON callbacker.transaction_callbacks (url, tx_id) WHERE coalesce_statuses AND sent_at IS NULL;
@@ -12,11 +12,15 @@ import (
 	"github.com/lib/pq"
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
 	"github.com/bitcoin-sv/arc/internal/callbacker/store"
+	"github.com/bitcoin-sv/arc/internal/encryption"
 )
 
 const (
 	postgresDriverName = "postgres"
+	// lockTime is the time after which pending callbacks which have not been sent are loaded again
+	lockTime = 3 * time.Minute
 )
 
 var (
@@ -29,9 +33,17 @@ func WithNow(nowFunc func() time.Time) func(*PostgreSQL) {
 	}
 }
 
+// WithCipher encrypts the callback tokens in the database.
+func WithCipher(c *encryption.Cipher) func(*PostgreSQL) {
+	return func(m *PostgreSQL) {
+		m.cipher = c
+	}
+}
+
 type PostgreSQL struct {
-	db  *sql.DB
-	now func() time.Time
+	db     *sql.DB
+	now    func() time.Time
+	cipher *encryption.Cipher
 }
 
 func New(dbInfo string, idleConns int, maxOpenConns int, opts ...func(postgreSQL *PostgreSQL)) (*PostgreSQL, error) {
@@ -59,6 +71,10 @@ func (p *PostgreSQL) Close() error {
 	return p.db.Close()
 }
 
+func (p *PostgreSQL) Ping(ctx context.Context) error {
+	return p.db.PingContext(ctx)
+}
+
 func (p *PostgreSQL) Insert(ctx context.Context, data []*store.CallbackData) (int64, error) {
 	urls := make([]string, len(data))
 	tokens := make([]string, len(data))
@@ -72,17 +88,43 @@ func (p *PostgreSQL) Insert(ctx context.Context, data []*store.CallbackData) (in
 	competingTxs := make([]*string, len(data))
 	allowBatches := make([]bool, len(data))
 	hashes := make([][]byte, len(data))
+	versions := make([]int64, len(data))
+	deduplicates := make([]bool, len(data))
+	tenants := make([]*string, len(data))
+	minedTxIDs := make([]*string, len(data))
+	coalesceStatuses := make([]bool, len(data))
+	deliverAfters := make([]*time.Time, len(data))
 
 	for i, d := range data {
+		token, err := p.cipher.Encrypt(d.Token)
+		if err != nil {
+			return 0, err
+		}
+
 		urls[i] = d.URL
-		tokens[i] = d.Token
+		tokens[i] = token
 		timestamps[i] = d.Timestamp
 		txids[i] = d.TxID
 		txStatuses[i] = d.TxStatus
 		extraInfos[i] = d.ExtraInfo
 		merklePaths[i] = d.MerklePath
 		blockHashes[i] = d.BlockHash
+		if d.Tenant != "" {
+			tenants[i] = ptrTo(d.Tenant)
+		}
+		minedTxIDs[i] = d.MinedTxID
 		allowBatches[i] = d.AllowBatch
+		deduplicates[i] = !d.AllowDuplicates
+		if d.SuppressionWindow > 0 {
+			coalesceStatuses[i] = true
+			if !isFinalStatus(d.TxStatus) {
+				deliverAfters[i] = ptrTo(d.Timestamp.Add(d.SuppressionWindow))
+			}
+		}
+		versions[i] = int64(d.Version)
+		if versions[i] == 0 {
+			versions[i] = 1
+		}
 
 		if d.BlockHeight != nil {
 			blockHeight, err := safecast.ToInt64(*d.BlockHeight)
@@ -116,6 +158,12 @@ func (p *PostgreSQL) Insert(ctx context.Context, data []*store.CallbackData) (in
 				,competing_txs
 				,allow_batch
 				,hash
+				,version
+				,deduplicate
+				,tenant
+				,mined_tx_id
+				,coalesce_statuses
+				,deliver_after
 				)
 				SELECT
 					UNNEST($1::TEXT[])
@@ -130,10 +178,24 @@ func (p *PostgreSQL) Insert(ctx context.Context, data []*store.CallbackData) (in
 					,UNNEST($10::TEXT[])
 					,UNNEST($11::BOOLEAN[])
 					,UNNEST($12::BYTEA[])
+					,UNNEST($13::INTEGER[])
+					,UNNEST($14::BOOLEAN[])
+					,UNNEST($15::TEXT[])
+					,UNNEST($16::TEXT[])
+					,UNNEST($17::BOOLEAN[])
+					,UNNEST($18::TIMESTAMPTZ[])
 					ON CONFLICT DO NOTHING
 					`
 
-	result, err := p.db.ExecContext(ctx, query,
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	result, err := tx.ExecContext(ctx, query,
 		pq.Array(urls),
 		pq.Array(tokens),
 		pq.Array(txids),
@@ -146,6 +208,12 @@ func (p *PostgreSQL) Insert(ctx context.Context, data []*store.CallbackData) (in
 		pq.Array(competingTxs),
 		pq.Array(allowBatches),
 		pq.Array(hashes),
+		pq.Array(versions),
+		pq.Array(deduplicates),
+		pq.Array(tenants),
+		pq.Array(minedTxIDs),
+		pq.Array(coalesceStatuses),
+		pq.Array(deliverAfters),
 	)
 	if err != nil {
 		return 0, err
@@ -156,7 +224,56 @@ func (p *PostgreSQL) Insert(ctx context.Context, data []*store.CallbackData) (in
 		return 0, err
 	}
 
-	return rowsAffected, err
+	err = p.coalesceStatuses(ctx, tx, urls, txids)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return 0, err
+	}
+
+	return rowsAffected, nil
+}
+
+// coalesceStatuses coalesces the unsent callbacks of the transactions for URLs with suppression window. All unsent
+// callbacks of a transaction and URL are delivered at the end of the window of the first of them, and callbacks which
+// are superseded by a callback with a later timestamp are marked as sent without being sent. Final statuses are not
+// delayed, so they supersede the coalesced statuses immediately.
+func (p *PostgreSQL) coalesceStatuses(ctx context.Context, tx *sql.Tx, urls []string, txIDs []string) error {
+	const alignWindowsQuery = `
+		UPDATE callbacker.transaction_callbacks c SET deliver_after = w.deliver_after
+		FROM (
+			SELECT url, tx_id, MIN(deliver_after) AS deliver_after FROM callbacker.transaction_callbacks
+			WHERE coalesce_statuses AND sent_at IS NULL AND deliver_after IS NOT NULL
+			AND (url, tx_id) IN (SELECT UNNEST($1::TEXT[]), UNNEST($2::TEXT[]))
+			GROUP BY url, tx_id
+		) w
+		WHERE c.url = w.url AND c.tx_id = w.tx_id AND c.coalesce_statuses AND c.sent_at IS NULL AND c.deliver_after > w.deliver_after
+	`
+
+	_, err := tx.ExecContext(ctx, alignWindowsQuery, pq.Array(urls), pq.Array(txIDs))
+	if err != nil {
+		return err
+	}
+
+	const supersedeQuery = `
+		UPDATE callbacker.transaction_callbacks c SET sent_at = $3, pending = NULL
+		WHERE c.coalesce_statuses AND c.sent_at IS NULL AND (c.pending IS NULL OR c.pending < $4)
+		AND (c.url, c.tx_id) IN (SELECT UNNEST($1::TEXT[]), UNNEST($2::TEXT[]))
+		AND EXISTS (
+			SELECT 1 FROM callbacker.transaction_callbacks n
+			WHERE n.coalesce_statuses AND n.url = c.url AND n.tx_id = c.tx_id AND n.timestamp > c.timestamp
+		)
+	`
+
+	_, err = tx.ExecContext(ctx, supersedeQuery, pq.Array(urls), pq.Array(txIDs), p.now(), p.now().Add(-1*lockTime))
+	return err
+}
+
+func isFinalStatus(txStatus string) bool {
+	return txStatus == callbacker_api.Status_MINED.String() || txStatus == callbacker_api.Status_REJECTED.String() || txStatus == callbacker_api.Status_EXPIRED.String()
 }
 
 func (p *PostgreSQL) GetUnsent(ctx context.Context, limit int, expiration time.Duration, batch bool) ([]*store.CallbackData, error) {
@@ -165,6 +282,7 @@ func (p *PostgreSQL) GetUnsent(ctx context.Context, limit int, expiration time.D
 				WHERE c.id IN (
 				    SELECT id FROM callbacker.transaction_callbacks c
 					WHERE timestamp > $2 AND allow_batch = $3 AND sent_at IS NULL AND (c.pending IS NULL OR c.pending < $5)
+					AND (c.deliver_after IS NULL OR c.deliver_after <= $1) -- skip those whose suppression window did not end yet
 					AND NOT EXISTS (
 					SELECT 1 FROM callbacker.transaction_callbacks c1
 					WHERE c1.url=c.url AND c1.pending IS NOT NULL AND c1.pending > $5 -- skip those with URL for which there are already pending callbacks
@@ -186,10 +304,12 @@ func (p *PostgreSQL) GetUnsent(ctx context.Context, limit int, expiration time.D
 				,c.competing_txs
 				,c.timestamp
 				,c.allow_batch
+				,c.version
+				,c.mined_tx_id
+				,c.tenant
 				;
 			`
 
-	const lockTime = 3 * time.Minute
 	expirationDate := p.now().Add(-1 * expiration)
 	rows, err := p.db.QueryContext(ctx, q, p.now(), expirationDate, batch, limit, p.now().Add(-1*lockTime))
 	if err != nil {
@@ -202,6 +322,87 @@ func (p *PostgreSQL) GetUnsent(ctx context.Context, limit int, expiration time.D
 		return nil, err
 	}
 
+	for _, r := range records {
+		r.Token, err = p.cipher.Decrypt(r.Token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return records, nil
+}
+
+// GetUnsentByTenant works like GetUnsent, but loads at most the quota of callbacks of each tenant, so that the callbacks
+// of a tenant with a large backlog don't delay the callbacks of the other tenants.
+func (p *PostgreSQL) GetUnsentByTenant(ctx context.Context, limit int, expiration time.Duration, batch bool, quotas store.TenantQuotas) ([]*store.CallbackData, error) {
+	const q = `
+				UPDATE callbacker.transaction_callbacks c SET pending = $1
+				WHERE c.id IN (
+					SELECT id FROM callbacker.transaction_callbacks
+					WHERE id IN (
+						SELECT r.id FROM (
+							SELECT c.id, c.timestamp, COALESCE(c.tenant, '') AS tenant,
+								ROW_NUMBER() OVER (PARTITION BY COALESCE(c.tenant, '') ORDER BY c.timestamp ASC) AS n
+							FROM callbacker.transaction_callbacks c
+							WHERE timestamp > $2 AND allow_batch = $3 AND sent_at IS NULL AND (c.pending IS NULL OR c.pending < $5)
+							AND (c.deliver_after IS NULL OR c.deliver_after <= $1)
+							AND NOT EXISTS (
+							SELECT 1 FROM callbacker.transaction_callbacks c1
+							WHERE c1.url=c.url AND c1.pending IS NOT NULL AND c1.pending > $5 -- skip those with URL for which there are already pending callbacks
+							)
+						) r
+						LEFT JOIN UNNEST($6::TEXT[], $7::INTEGER[]) AS q(tenant, quota) ON q.tenant = r.tenant
+						WHERE (q.quota IS NULL AND $8::INTEGER < 0) OR r.n <= COALESCE(q.quota, $8::INTEGER)
+						ORDER BY r.timestamp ASC
+						LIMIT $4
+					)
+					FOR UPDATE
+				)
+				RETURNING
+				c.id
+			    ,c.url
+				,c.token
+				,c.tx_id
+				,c.tx_status
+				,c.extra_info
+				,c.merkle_path
+				,c.block_hash
+				,c.block_height
+				,c.competing_txs
+				,c.timestamp
+				,c.allow_batch
+				,c.version
+				,c.mined_tx_id
+				,c.tenant
+				;
+			`
+
+	tenants := make([]string, 0, len(quotas.Quotas))
+	tenantQuotas := make([]int64, 0, len(quotas.Quotas))
+	for tenant, quota := range quotas.Quotas {
+		tenants = append(tenants, tenant)
+		tenantQuotas = append(tenantQuotas, int64(quota))
+	}
+
+	expirationDate := p.now().Add(-1 * expiration)
+	rows, err := p.db.QueryContext(ctx, q, p.now(), expirationDate, batch, limit, p.now().Add(-1*lockTime), pq.Array(tenants), pq.Array(tenantQuotas), quotas.Default)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*store.CallbackData
+	records, err = scanCallbacks(rows, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range records {
+		r.Token, err = p.cipher.Decrypt(r.Token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return records, nil
 }
 
@@ -213,6 +414,80 @@ func (p *PostgreSQL) Clear(ctx context.Context, t time.Time) error {
 	return err
 }
 
+// ComputeSLAReports computes the SLA reports of the period starting at from for the callbacks of each tenant which were
+// created between from and to. Callbacks which have not been sent within the expiration are failed. Already computed
+// reports of the period are replaced. It returns the number of computed reports.
+func (p *PostgreSQL) ComputeSLAReports(ctx context.Context, period string, from time.Time, to time.Time, expiration time.Duration) (int64, error) {
+	const q = `INSERT INTO callbacker.sla_reports (period, period_start, tenant, callbacks, delivered, failed, computed_at)
+		SELECT $1::TEXT, $2::TIMESTAMPTZ, tenant, COUNT(1), COUNT(sent_at), COUNT(1) FILTER (WHERE sent_at IS NULL AND timestamp <= $4), $5::TIMESTAMPTZ
+		FROM callbacker.transaction_callbacks
+		WHERE tenant IS NOT NULL AND timestamp >= $2 AND timestamp < $3
+		GROUP BY tenant
+		ON CONFLICT (period, period_start, tenant) DO UPDATE SET
+			callbacks = EXCLUDED.callbacks,
+			delivered = EXCLUDED.delivered,
+			failed = EXCLUDED.failed,
+			computed_at = EXCLUDED.computed_at`
+
+	now := p.now()
+	res, err := p.db.ExecContext(ctx, q, period, from, to, now.Add(-1*expiration), now)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// GetSLAReports returns the SLA reports of the periods starting between from and to, of all tenants if tenant is
+// empty, ordered by the start of the period and the tenant.
+func (p *PostgreSQL) GetSLAReports(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error) {
+	const q = `SELECT period, period_start, tenant, callbacks, delivered, failed, computed_at
+		FROM callbacker.sla_reports
+		WHERE period = $1 AND period_start >= $2 AND period_start < $3
+		AND ($4 = '' OR tenant = $4)
+		ORDER BY period_start, tenant`
+
+	rows, err := p.db.QueryContext(ctx, q, period, from, to, tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reports := make([]store.SLAReport, 0)
+	for rows.Next() {
+		var report store.SLAReport
+		err = rows.Scan(&report.Period, &report.PeriodStart, &report.Tenant, &report.Callbacks, &report.Delivered, &report.Failed, &report.ComputedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		report.PeriodStart = report.PeriodStart.UTC()
+		report.ComputedAt = report.ComputedAt.UTC()
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
+}
+
+// EraseCallbacks deletes the sent and unsent callbacks of the transactions of the tenant or with the given ids, both
+// have to match if both are given, and returns the number of deleted callbacks.
+func (p *PostgreSQL) EraseCallbacks(ctx context.Context, tenant string, txIDs []string) (int64, error) {
+	if txIDs == nil {
+		txIDs = []string{}
+	}
+
+	const q = `DELETE FROM callbacker.transaction_callbacks
+			WHERE ($1 = '' OR tenant = $1)
+			AND (CARDINALITY($2::TEXT[]) = 0 OR tx_id = ANY($2::TEXT[]))`
+
+	res, err := p.db.ExecContext(ctx, q, tenant, pq.Array(txIDs))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
 func (p *PostgreSQL) SetSent(ctx context.Context, ids []int64) error {
 	const q = `UPDATE callbacker.transaction_callbacks SET sent_at = $1, pending = NULL WHERE id = ANY($2::INTEGER[])`
 
@@ -235,6 +510,44 @@ func (p *PostgreSQL) UnsetPending(ctx context.Context, ids []int64) error {
 	return nil
 }
 
+// CountUnsent returns the number of callbacks which have not been sent yet and are not expired.
+func (p *PostgreSQL) CountUnsent(ctx context.Context, expiration time.Duration) (int64, error) {
+	const q = `SELECT COUNT(1) FROM callbacker.transaction_callbacks WHERE timestamp > $1 AND sent_at IS NULL`
+
+	var count int64
+	err := p.db.QueryRowContext(ctx, q, p.now().Add(-1*expiration)).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CountUnsentByTenant returns the number of callbacks per tenant which have not been sent yet and are not expired. The
+// callbacks without tenant are counted for the tenant "".
+func (p *PostgreSQL) CountUnsentByTenant(ctx context.Context, expiration time.Duration) (map[string]int64, error) {
+	const q = `SELECT COALESCE(tenant, ''), COUNT(1) FROM callbacker.transaction_callbacks WHERE timestamp > $1 AND sent_at IS NULL GROUP BY 1`
+
+	rows, err := p.db.QueryContext(ctx, q, p.now().Add(-1*expiration))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int64)
+	for rows.Next() {
+		var tenant string
+		var count int64
+		err = rows.Scan(&tenant, &count)
+		if err != nil {
+			return nil, err
+		}
+		counts[tenant] = count
+	}
+
+	return counts, rows.Err()
+}
+
 func scanCallbacks(rows *sql.Rows, expectedNumber int) ([]*store.CallbackData, error) {
 	records := make([]*store.CallbackData, 0, expectedNumber)
 
@@ -249,6 +562,8 @@ func scanCallbacks(rows *sql.Rows, expectedNumber int) ([]*store.CallbackData, e
 			bHeight sql.NullInt64
 			ctxs    sql.NullString
 			id      sql.NullInt64
+			mTxID   sql.NullString
+			tenant  sql.NullString
 		)
 
 		err := rows.Scan(
@@ -264,6 +579,9 @@ func scanCallbacks(rows *sql.Rows, expectedNumber int) ([]*store.CallbackData, e
 			&ctxs,
 			&ts,
 			&r.AllowBatch,
+			&r.Version,
+			&mTxID,
+			&tenant,
 		)
 
 		if err != nil {
@@ -271,6 +589,7 @@ func scanCallbacks(rows *sql.Rows, expectedNumber int) ([]*store.CallbackData, e
 		}
 
 		r.Timestamp = ts.UTC()
+		r.Tenant = tenant.String
 
 		if id.Valid {
 			r.ID = id.Int64
@@ -284,6 +603,9 @@ func scanCallbacks(rows *sql.Rows, expectedNumber int) ([]*store.CallbackData, e
 		if bh.Valid {
 			r.BlockHash = ptrTo(bh.String)
 		}
+		if mTxID.Valid {
+			r.MinedTxID = ptrTo(mTxID.String)
+		}
 		bhuint64, err := safecast.ToUint64(bHeight.Int64)
 		if err != nil {
 			return nil, err
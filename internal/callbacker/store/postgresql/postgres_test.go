@@ -16,6 +16,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/callbacker/store"
+	"github.com/bitcoin-sv/arc/internal/callbacker/store/conformance"
+	"github.com/bitcoin-sv/arc/internal/encryption"
 	"github.com/bitcoin-sv/arc/internal/testdata"
 	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
 )
@@ -37,6 +39,16 @@ func TestMain(m *testing.M) {
 }
 
 func testmain(m *testing.M) int {
+	// the user-provided database has been requested explicitly, so failing to migrate it fails the tests
+	userDBInfo, err := testutils.MigrateUserPostgresql("callbacker", migrationsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if userDBInfo != "" {
+		dbInfo = userDBInfo
+		return m.Run()
+	}
+
 	pool, err := dockertest.NewPool("")
 	if err != nil {
 		log.Printf("failed to create pool: %v", err)
@@ -138,9 +150,18 @@ func TestPostgresDBt(t *testing.T) {
 			BlockHash:   &testdata.Block2,
 			BlockHeight: ptrTo(uint64(4524235)),
 		}
+		cbData8 := &store.CallbackData{ // at-least-once delivery
+			URL:             "https://test-callback-3/",
+			Token:           "token",
+			TxID:            testdata.TX2,
+			TxStatus:        "SEEN_ON_NETWORK",
+			Timestamp:       now,
+			AllowDuplicates: true,
+		}
 
 		data := []*store.CallbackData{
 			cbData1,
+			cbData1, // duplicate without block hash
 			cbData2,
 			cbData2, // duplicate
 			cbData3,
@@ -148,6 +169,8 @@ func TestPostgresDBt(t *testing.T) {
 			cbData4,
 			cbData5,
 			cbData7,
+			cbData8,
+			cbData8, // duplicate allowed
 		}
 
 		// when
@@ -155,7 +178,7 @@ func TestPostgresDBt(t *testing.T) {
 		// then
 		require.NoError(t, err)
 
-		require.Equal(t, rows, int64(6))
+		require.Equal(t, rows, int64(8))
 
 		dbCallbacks := readAllCallbacks(t, postgresDB.db)
 		require.NoError(t, err)
@@ -168,6 +191,8 @@ func TestPostgresDBt(t *testing.T) {
 			cbData5,
 			cbData6,
 			cbData7,
+			cbData8,
+			cbData8,
 		}
 
 		require.ElementsMatch(t, expected, dbCallbacks)
@@ -253,6 +278,209 @@ func TestPostgresDBt(t *testing.T) {
 		}
 	})
 
+	t.Run("encrypted tokens", func(t *testing.T) {
+		// given
+		defer pruneTables(t, postgresDB.db)
+		ctx := context.Background()
+
+		cipher, err := encryption.NewCipher([]encryption.Key{{ID: "test", Secret: make([]byte, 32)}}, "test")
+		require.NoError(t, err)
+		encryptedDB, err := New(dbInfo, 10, 10, WithCipher(cipher), WithNow(func() time.Time { return now }))
+		require.NoError(t, err)
+		defer encryptedDB.Close()
+
+		cbData := &store.CallbackData{
+			URL:       "https://test-callback-1/",
+			TxID:      "96cbf8ba96dc3bad6ecc19ce34d1edbf57b2bc6f76cc3d80efdca95599cf5c28",
+			TxStatus:  "SEEN_ON_NETWORK",
+			Timestamp: now,
+			Token:     "secret-token",
+		}
+
+		// when
+		_, err = encryptedDB.Insert(ctx, []*store.CallbackData{cbData})
+		require.NoError(t, err)
+
+		// then
+		var storedToken string
+		err = postgresDB.db.QueryRowContext(ctx, `SELECT token FROM callbacker.transaction_callbacks`).Scan(&storedToken)
+		require.NoError(t, err)
+		require.NotEqual(t, "secret-token", storedToken)
+
+		records, err := encryptedDB.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, "secret-token", records[0].Token)
+	})
+
+	t.Run("mined tx id", func(t *testing.T) {
+		// given
+		defer pruneTables(t, postgresDB.db)
+		ctx := context.Background()
+
+		cbData := &store.CallbackData{
+			URL:         "https://test-callback-1/",
+			Token:       "token",
+			TxID:        testdata.TX2,
+			TxStatus:    "MINED",
+			Timestamp:   now,
+			BlockHash:   ptrTo(testdata.Block1),
+			BlockHeight: ptrTo(uint64(4524235)),
+			MinedTxID:   ptrTo(testdata.TX3),
+		}
+
+		// when
+		_, err = postgresDB.Insert(ctx, []*store.CallbackData{cbData})
+		require.NoError(t, err)
+
+		// then
+		records, err := postgresDB.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, ptrTo(testdata.TX3), records[0].MinedTxID)
+	})
+
+	t.Run("sla reports", func(t *testing.T) {
+		// given
+		defer pruneTables(t, postgresDB.db)
+		ctx := context.Background()
+		day := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+
+		_, err = postgresDB.Insert(ctx, []*store.CallbackData{
+			{URL: "https://test-callback-1/", TxID: testdata.TX1Hash.String(), TxStatus: "SEEN_ON_NETWORK", Timestamp: day.Add(time.Hour), Tenant: "exchange-a"},
+			{URL: "https://test-callback-1/", TxID: testdata.TX2, TxStatus: "SEEN_ON_NETWORK", Timestamp: day.Add(2 * time.Hour), Tenant: "exchange-a"},
+			{URL: "https://test-callback-1/", TxID: testdata.TX3, TxStatus: "SEEN_ON_NETWORK", Timestamp: now.Add(-time.Minute), Tenant: "exchange-a"},
+			{URL: "https://test-callback-2/", TxID: testdata.TX4, TxStatus: "SEEN_ON_NETWORK", Timestamp: day.Add(time.Hour), Tenant: "exchange-b"},
+			// callbacks without tenant and of other periods are not reported
+			{URL: "https://test-callback-3/", TxID: testdata.TX5, TxStatus: "SEEN_ON_NETWORK", Timestamp: day.Add(time.Hour)},
+			{URL: "https://test-callback-1/", TxID: testdata.TX1Hash.String(), TxStatus: "MINED", Timestamp: day.Add(-time.Hour), Tenant: "exchange-a"},
+		})
+		require.NoError(t, err)
+		_, err = postgresDB.db.ExecContext(ctx, "UPDATE callbacker.transaction_callbacks SET sent_at = $1 WHERE tx_id = $2 AND tx_status = 'SEEN_ON_NETWORK'", now, testdata.TX1Hash.String())
+		require.NoError(t, err)
+
+		// when
+		var n int64
+		for range 2 {
+			n, err = postgresDB.ComputeSLAReports(ctx, "daily", day, day.AddDate(0, 0, 1), 6*time.Hour)
+			require.NoError(t, err)
+		}
+		reports, err := postgresDB.GetSLAReports(ctx, "daily", day, day.AddDate(0, 0, 1), "")
+		require.NoError(t, err)
+
+		// then
+		require.Equal(t, int64(2), n)
+		require.Equal(t, []store.SLAReport{
+			{Period: "daily", PeriodStart: day, Tenant: "exchange-a", Callbacks: 3, Delivered: 1, Failed: 1, ComputedAt: now},
+			{Period: "daily", PeriodStart: day, Tenant: "exchange-b", Callbacks: 1, Delivered: 0, Failed: 1, ComputedAt: now},
+		}, reports)
+
+		reports, err = postgresDB.GetSLAReports(ctx, "daily", day, day.AddDate(0, 0, 1), "exchange-b")
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+	})
+
+	t.Run("suppression window", func(t *testing.T) {
+		// given
+		defer pruneTables(t, postgresDB.db)
+		ctx := context.Background()
+
+		callback := func(status string, timestamp time.Time) *store.CallbackData {
+			return &store.CallbackData{
+				URL:               "https://test-callback-1/",
+				Token:             "token",
+				TxID:              testdata.TX2,
+				TxStatus:          status,
+				Timestamp:         timestamp,
+				SuppressionWindow: time.Minute,
+			}
+		}
+
+		// when
+		_, err = postgresDB.Insert(ctx, []*store.CallbackData{callback("STORED", now.Add(-50*time.Second))})
+		require.NoError(t, err)
+		_, err = postgresDB.Insert(ctx, []*store.CallbackData{callback("SEEN_ON_NETWORK", now.Add(-40*time.Second))})
+		require.NoError(t, err)
+
+		// then
+		records, err := postgresDB.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Empty(t, records, "window of the first status did not end yet")
+
+		count, err := postgresDB.CountUnsent(ctx, time.Hour)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count, "superseded status is marked as sent")
+
+		// when
+		later, err := New(dbInfo, 10, 10, WithNow(func() time.Time { return now.Add(10 * time.Second) }))
+		require.NoError(t, err)
+		defer later.Close()
+
+		// then
+		records, err = later.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, "SEEN_ON_NETWORK", records[0].TxStatus)
+		require.NoError(t, later.SetSent(ctx, []int64{records[0].ID}))
+
+		// when
+		_, err = postgresDB.Insert(ctx, []*store.CallbackData{callback("ACCEPTED_BY_NETWORK", now.Add(-30*time.Second)), callback("MINED", now)})
+		require.NoError(t, err)
+
+		// then
+		records, err = postgresDB.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Len(t, records, 1, "final status is sent immediately")
+		require.Equal(t, "MINED", records[0].TxStatus)
+	})
+
+	t.Run("count unsent", func(t *testing.T) {
+		// given
+		defer pruneTables(t, postgresDB.db)
+		testutils.LoadFixtures(t, postgresDB.db, "fixtures/get_unsent")
+		ctx := context.Background()
+
+		var expected int64
+		err = postgresDB.db.QueryRowContext(ctx, "SELECT COUNT(1) FROM callbacker.transaction_callbacks WHERE timestamp > $1 AND sent_at IS NULL", now.Add(-1*time.Hour)).Scan(&expected)
+		require.NoError(t, err)
+
+		// when
+		count, err := postgresDB.CountUnsent(ctx, 1*time.Hour)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, expected, count)
+		require.NotZero(t, count)
+	})
+
+	t.Run("erase callbacks", func(t *testing.T) {
+		// given
+		defer pruneTables(t, postgresDB.db)
+		ctx := context.Background()
+
+		_, err = postgresDB.Insert(ctx, []*store.CallbackData{
+			{URL: "https://test-callback-1/", Token: "token", TxID: testdata.TX2, TxStatus: "SEEN_ON_NETWORK", Timestamp: now, Tenant: "exchange-a"},
+			{URL: "https://test-callback-1/", Token: "token", TxID: testdata.TX3, TxStatus: "SEEN_ON_NETWORK", Timestamp: now, Tenant: "exchange-a"},
+			{URL: "https://test-callback-2/", Token: "token", TxID: testdata.TX4, TxStatus: "SEEN_ON_NETWORK", Timestamp: now, Tenant: "exchange-b"},
+		})
+		require.NoError(t, err)
+
+		// when
+		erasedByTx, err := postgresDB.EraseCallbacks(ctx, "exchange-a", []string{testdata.TX2, testdata.TX4})
+		require.NoError(t, err)
+		erasedByTenant, err := postgresDB.EraseCallbacks(ctx, "exchange-a", nil)
+		require.NoError(t, err)
+
+		// then
+		require.Equal(t, int64(1), erasedByTx)
+		require.Equal(t, int64(1), erasedByTenant)
+
+		records, err := postgresDB.GetUnsent(ctx, 10, time.Hour, false)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, testdata.TX4, records[0].TxID)
+	})
+
 	t.Run("clear", func(t *testing.T) {
 		// given
 		defer pruneTables(t, postgresDB.db)
@@ -280,6 +508,7 @@ func pruneTables(t *testing.T, db *sql.DB) {
 	testutils.PruneTables(t, db, "callbacker.callbacks")
 	testutils.PruneTables(t, db, "callbacker.transaction_callbacks")
 	testutils.PruneTables(t, db, "callbacker.url_mapping")
+	testutils.PruneTables(t, db, "callbacker.sla_reports")
 }
 
 func readAllCallbacks(t *testing.T, db *sql.DB) []*store.CallbackData {
@@ -296,6 +525,7 @@ func readAllCallbacks(t *testing.T, db *sql.DB) []*store.CallbackData {
 			,block_height
 			,timestamp
 			,competing_txs
+			,NOT deduplicate
 		FROM callbacker.transaction_callbacks`,
 	)
 	require.NoError(t, err)
@@ -312,7 +542,7 @@ func readAllCallbacks(t *testing.T, db *sql.DB) []*store.CallbackData {
 		var bheight sql.NullInt64
 		var competingTxs sql.NullString
 
-		_ = r.Scan(&c.URL, &c.Token, &c.TxID, &c.TxStatus, &ei, &mp, &bh, &bheight, &c.Timestamp, &competingTxs)
+		_ = r.Scan(&c.URL, &c.Token, &c.TxID, &c.TxStatus, &ei, &mp, &bh, &bheight, &c.Timestamp, &competingTxs, &c.AllowDuplicates)
 
 		if ei.Valid {
 			c.ExtraInfo = &ei.String
@@ -339,3 +569,20 @@ func readAllCallbacks(t *testing.T, db *sql.DB) []*store.CallbackData {
 
 	return callbacks
 }
+
+func TestConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	conformance.Run(t, func(t *testing.T) store.ProcessorStore {
+		postgresDB, err := New(dbInfo, 10, 10)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			pruneTables(t, postgresDB.db)
+			_ = postgresDB.Close()
+		})
+
+		return postgresDB
+	})
+}
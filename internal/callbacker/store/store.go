@@ -17,13 +17,48 @@ type CallbackData struct {
 	MerklePath   *string
 	BlockHash    *string
 	BlockHeight  *uint64
+	MinedTxID    *string
 	AllowBatch   bool
+	Version      int32
+	// AllowDuplicates disables the deduplication of callbacks with the same URL, transaction ID and status
+	AllowDuplicates bool
+	// SuppressionWindow coalesces the intermediate statuses of the transaction for the URL within the window, only the
+	// latest status at the end of the window is sent. Final statuses are sent immediately. Zero sends every status
+	SuppressionWindow time.Duration
+	// Tenant is the name of the tenant whose API key submitted the transaction, it is empty if no tenants are configured
+	Tenant string
+}
+
+// SLAReport holds the delivery metrics of the callbacks of a tenant which were created in the daily or weekly period
+// starting at PeriodStart. Callbacks are failed if they have not been delivered within the expiration, the callbacks
+// which are neither delivered nor failed are still pending.
+type SLAReport struct {
+	Period      string
+	PeriodStart time.Time
+	Tenant      string
+	Callbacks   int64
+	Delivered   int64
+	Failed      int64
+	ComputedAt  time.Time
+}
+
+// TenantQuotas limits the number of callbacks loaded per tenant. Callbacks without tenant belong to the tenant "".
+// Tenants which are not in Quotas are limited to Default callbacks, they are not limited if Default is negative.
+type TenantQuotas struct {
+	Quotas  map[string]int
+	Default int
 }
 
 type ProcessorStore interface {
 	Clear(ctx context.Context, t time.Time) error
 	Insert(ctx context.Context, data []*CallbackData) (int64, error)
 	GetUnsent(ctx context.Context, limit int, expiration time.Duration, batch bool) ([]*CallbackData, error)
+	GetUnsentByTenant(ctx context.Context, limit int, expiration time.Duration, batch bool, quotas TenantQuotas) ([]*CallbackData, error)
 	SetSent(ctx context.Context, ids []int64) error
 	UnsetPending(ctx context.Context, ids []int64) error
+	ComputeSLAReports(ctx context.Context, period string, from time.Time, to time.Time, expiration time.Duration) (int64, error)
+	GetSLAReports(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]SLAReport, error)
+	CountUnsent(ctx context.Context, expiration time.Duration) (int64, error)
+	CountUnsentByTenant(ctx context.Context, expiration time.Duration) (map[string]int64, error)
+	EraseCallbacks(ctx context.Context, tenant string, txIDs []string) (int64, error)
 }
@@ -0,0 +1,115 @@
+package callbacker
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/callbacker/store"
+)
+
+// TenantRateLimit limits the callbacks delivered to a tenant to Rate callbacks per second on average with bursts of up
+// to Burst callbacks.
+type TenantRateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// tenantShaper shapes the delivery of the callbacks per tenant with a token bucket per tenant, so that e.g. the
+// callbacks of a block which mined a lot of transactions of one tenant are delivered at the rate of the tenant instead
+// of all at once. Tenants without own limit are limited by the default limit, if any. The callbacks exceeding the limit
+// stay in the backlog until the bucket of the tenant has been refilled.
+type tenantShaper struct {
+	limits       map[string]TenantRateLimit
+	defaultLimit *TenantRateLimit
+	now          func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens     float64
+	refilledAt time.Time
+}
+
+func newTenantShaper(limits map[string]TenantRateLimit, defaultLimit *TenantRateLimit, now func() time.Time) *tenantShaper {
+	return &tenantShaper{
+		limits:       limits,
+		defaultLimit: defaultLimit,
+		now:          now,
+		buckets:      make(map[string]*tokenBucket),
+	}
+}
+
+func (s *tenantShaper) limit(tenant string) (TenantRateLimit, bool) {
+	l, found := s.limits[tenant]
+	if found {
+		return l, true
+	}
+
+	if s.defaultLimit != nil {
+		return *s.defaultLimit, true
+	}
+
+	return TenantRateLimit{}, false
+}
+
+// quotas refills the buckets and returns the number of callbacks which can be delivered to each tenant right now.
+// Tenants which have not received callbacks yet have a full bucket.
+func (s *tenantShaper) quotas() store.TenantQuotas {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	quotas := store.TenantQuotas{Quotas: make(map[string]int, len(s.limits)+len(s.buckets)), Default: -1}
+	if s.defaultLimit != nil {
+		quotas.Default = s.defaultLimit.Burst
+	}
+
+	for tenant, l := range s.limits {
+		quotas.Quotas[tenant] = l.Burst
+	}
+
+	for tenant, b := range s.buckets {
+		l, _ := s.limit(tenant)
+		s.refill(b, l, now)
+		quotas.Quotas[tenant] = int(math.Max(b.tokens, 0))
+	}
+
+	return quotas
+}
+
+// consume takes the tokens of the loaded callbacks from the buckets of their tenants. The tokens of a bucket can become
+// negative if callbacks of the tenant are loaded concurrently, the following callbacks are then delayed accordingly.
+func (s *tenantShaper) consume(callbacks []*store.CallbackData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for _, c := range callbacks {
+		l, limited := s.limit(c.Tenant)
+		if !limited {
+			continue
+		}
+
+		b, found := s.buckets[c.Tenant]
+		if !found {
+			b = &tokenBucket{tokens: float64(l.Burst), refilledAt: now}
+			s.buckets[c.Tenant] = b
+		}
+
+		s.refill(b, l, now)
+		b.tokens--
+	}
+}
+
+func (s *tenantShaper) refill(b *tokenBucket, l TenantRateLimit, now time.Time) {
+	elapsed := now.Sub(b.refilledAt).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	b.tokens = math.Min(b.tokens+elapsed*l.Rate, float64(l.Burst))
+	b.refilledAt = now
+}
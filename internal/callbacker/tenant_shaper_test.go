@@ -0,0 +1,93 @@
+package callbacker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitcoin-sv/arc/internal/callbacker/store"
+)
+
+func TestTenantShaper(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	callbacksOf := func(tenant string, n int) []*store.CallbackData {
+		callbacks := make([]*store.CallbackData, n)
+		for i := range callbacks {
+			callbacks[i] = &store.CallbackData{Tenant: tenant}
+		}
+		return callbacks
+	}
+
+	tt := []struct {
+		name         string
+		defaultLimit *TenantRateLimit
+		consumed     []*store.CallbackData
+		elapsed      time.Duration
+
+		expectedQuotas store.TenantQuotas
+	}{
+		{
+			name: "full buckets",
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 100}, Default: -1},
+		},
+		{
+			name:     "consumed bucket",
+			consumed: callbacksOf("tenant-a", 100),
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 0}, Default: -1},
+		},
+		{
+			name:     "refilled bucket",
+			consumed: callbacksOf("tenant-a", 100),
+			elapsed:  3 * time.Second,
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 30}, Default: -1},
+		},
+		{
+			name:     "refill limited to burst",
+			consumed: callbacksOf("tenant-a", 10),
+			elapsed:  time.Hour,
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 100}, Default: -1},
+		},
+		{
+			name:     "overdrawn bucket",
+			consumed: callbacksOf("tenant-a", 150),
+			elapsed:  2 * time.Second,
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 0}, Default: -1},
+		},
+		{
+			name:     "unlimited tenant",
+			consumed: callbacksOf("tenant-b", 1000),
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 100}, Default: -1},
+		},
+		{
+			name:         "default limit",
+			defaultLimit: &TenantRateLimit{Rate: 1, Burst: 20},
+			consumed:     append(callbacksOf("tenant-b", 15), callbacksOf("", 5)...),
+			elapsed:      time.Second,
+
+			expectedQuotas: store.TenantQuotas{Quotas: map[string]int{"tenant-a": 100, "tenant-b": 6, "": 16}, Default: 20},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			current := now
+			sut := newTenantShaper(map[string]TenantRateLimit{"tenant-a": {Rate: 10, Burst: 100}}, tc.defaultLimit, func() time.Time { return current })
+
+			// when
+			sut.consume(tc.consumed)
+			current = current.Add(tc.elapsed)
+			actual := sut.quotas()
+
+			// then
+			assert.Equal(t, tc.expectedQuotas, actual)
+		})
+	}
+}
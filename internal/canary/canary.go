@@ -0,0 +1,336 @@
+package canary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
+
+	"github.com/bitcoin-sv/arc/internal/broadcaster"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/pkg/api"
+	"github.com/bitcoin-sv/arc/pkg/keyset"
+)
+
+const (
+	intervalDefault     = 10 * time.Minute
+	minedTimeoutDefault = 2 * time.Hour
+	requestTimeout      = 30 * time.Second
+
+	failureSubmit   = "submit"
+	failureRejected = "rejected"
+	failureTimeout  = "timeout"
+	failureStatus   = "status"
+)
+
+var (
+	ErrNoUTXOs               = errors.New("no utxos of canary address found")
+	ErrInsufficientFunds     = errors.New("utxo of canary address does not cover the fee")
+	ErrFailedToCreateTx      = errors.New("failed to create canary transaction")
+	ErrFailedToSubmitTx      = errors.New("failed to submit canary transaction")
+	ErrCanaryTxRejected      = errors.New("canary transaction was rejected")
+	ErrFailedToGetStatus     = errors.New("failed to get status of canary transaction")
+	ErrFailedToRegisterStats = errors.New("failed to register canary metrics")
+)
+
+// ArcClient is the client of the public API of ARC used by the canary.
+type ArcClient interface {
+	POSTTransactionWithResponse(ctx context.Context, params *api.POSTTransactionParams, body api.POSTTransactionJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.POSTTransactionResponse, error)
+	GETTransactionStatusWithResponse(ctx context.Context, txid string, reqEditors ...api.RequestEditorFn) (*api.GETTransactionStatusResponse, error)
+}
+
+type UtxoClient interface {
+	GetUTXOs(ctx context.Context, address string) (sdkTx.UTXOs, error)
+}
+
+type pendingTx struct {
+	submittedAt time.Time
+	status      metamorph_api.Status
+}
+
+// Canary periodically submits a tiny transaction paying the canary address back to itself through the public API of
+// ARC and tracks its progression to MINED, so that a degradation of the end-to-end pipeline shows in the metrics
+// before clients notice. Each canary transaction spends the output of the previous one, the UTXOs of the canary
+// address are only looked up on start and after a canary transaction failed.
+type Canary struct {
+	logger       *slog.Logger
+	client       ArcClient
+	utxoClient   UtxoClient
+	keySet       *keyset.KeySet
+	mainnet      bool
+	feeModel     feemodel.SatoshisPerKilobyte
+	interval     time.Duration
+	minedTimeout time.Duration
+	now          func() time.Time
+	stats        *stats
+
+	utxo    *sdkTx.UTXO
+	pending map[string]*pendingTx
+
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithInterval(d time.Duration) func(*Canary) {
+	return func(c *Canary) {
+		c.interval = d
+	}
+}
+
+// WithMinedTimeout sets the duration after which a canary transaction which is not mined counts as failed.
+func WithMinedTimeout(d time.Duration) func(*Canary) {
+	return func(c *Canary) {
+		c.minedTimeout = d
+	}
+}
+
+func WithFees(miningFeeSatPerKb uint64) func(*Canary) {
+	return func(c *Canary) {
+		c.feeModel = feemodel.SatoshisPerKilobyte{Satoshis: miningFeeSatPerKb}
+	}
+}
+
+func WithMainnet(mainnet bool) func(*Canary) {
+	return func(c *Canary) {
+		c.mainnet = mainnet
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Canary) {
+	return func(c *Canary) {
+		c.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, client ArcClient, utxoClient UtxoClient, keySet *keyset.KeySet, opts ...func(*Canary)) *Canary {
+	c := &Canary{
+		logger:       logger.With(slog.String("module", "canary")),
+		client:       client,
+		utxoClient:   utxoClient,
+		keySet:       keySet,
+		feeModel:     feemodel.SatoshisPerKilobyte{Satoshis: 1},
+		interval:     intervalDefault,
+		minedTimeout: minedTimeoutDefault,
+		now:          time.Now,
+		stats:        newStats(),
+		pending:      make(map[string]*pendingTx),
+		waitGroup:    &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	c.cancelAll = cancelAll
+	c.ctx = ctx
+
+	return c
+}
+
+// Start registers the metrics of the canary and runs a round right away and then every interval.
+func (c *Canary) Start() error {
+	err := c.stats.register()
+	if err != nil {
+		return errors.Join(ErrFailedToRegisterStats, err)
+	}
+
+	ticker := time.NewTicker(c.interval)
+	c.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			c.waitGroup.Done()
+		}()
+
+		for {
+			c.Run(c.ctx)
+
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Run updates the statuses of the pending canary transactions and submits a new canary transaction.
+func (c *Canary) Run(ctx context.Context) {
+	c.checkPending(ctx)
+
+	err := c.submit(ctx)
+	if err != nil {
+		c.logger.Error("Canary transaction failed", slog.String("err", err.Error()))
+	}
+
+	c.stats.pending.Set(float64(len(c.pending)))
+}
+
+func (c *Canary) checkPending(ctx context.Context) {
+	for txID, tx := range c.pending {
+		status, err := c.getStatus(ctx, txID)
+		if err != nil {
+			c.stats.failed.WithLabelValues(failureStatus).Inc()
+			c.logger.Error("Failed to get status of canary transaction", slog.String("hash", txID), slog.String("err", err.Error()))
+		} else {
+			tx.status = status
+		}
+
+		switch {
+		case tx.status == metamorph_api.Status_MINED:
+			c.stats.minedDuration.Observe(c.now().Sub(tx.submittedAt).Seconds())
+			c.stats.lastMined.Set(float64(c.now().Unix()))
+			delete(c.pending, txID)
+			c.logger.Info("Canary transaction mined", slog.String("hash", txID))
+		case tx.status == metamorph_api.Status_REJECTED || tx.status == metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED:
+			c.stats.failed.WithLabelValues(failureRejected).Inc()
+			delete(c.pending, txID)
+			// the following canary transactions spend the output of the rejected transaction
+			c.utxo = nil
+			c.logger.Error("Canary transaction rejected", slog.String("hash", txID), slog.String("status", tx.status.String()))
+		case c.now().Sub(tx.submittedAt) > c.minedTimeout:
+			c.stats.failed.WithLabelValues(failureTimeout).Inc()
+			delete(c.pending, txID)
+			c.logger.Error("Canary transaction not mined in time", slog.String("hash", txID), slog.String("status", tx.status.String()), slog.Duration("timeout", c.minedTimeout))
+		}
+	}
+}
+
+func (c *Canary) getStatus(ctx context.Context, txID string) (metamorph_api.Status, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	resp, err := c.client.GETTransactionStatusWithResponse(ctx, txID)
+	if err != nil {
+		return 0, errors.Join(ErrFailedToGetStatus, err)
+	}
+
+	if resp.JSON200 == nil {
+		return 0, errors.Join(ErrFailedToGetStatus, fmt.Errorf("status: %s, response: %s", resp.Status(), strings.TrimSpace(string(resp.Body))))
+	}
+
+	return metamorph_api.Status(metamorph_api.Status_value[string(resp.JSON200.TxStatus)]), nil
+}
+
+func (c *Canary) submit(ctx context.Context) error {
+	tx, err := c.createTx(ctx)
+	if err != nil {
+		c.stats.failed.WithLabelValues(failureSubmit).Inc()
+		return err
+	}
+
+	rawTx, err := tx.EFHex()
+	if err != nil {
+		c.stats.failed.WithLabelValues(failureSubmit).Inc()
+		return errors.Join(ErrFailedToCreateTx, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	waitFor := metamorph_api.Status_SEEN_ON_NETWORK.String()
+	start := c.now()
+	resp, err := c.client.POSTTransactionWithResponse(ctx, &api.POSTTransactionParams{XWaitFor: &waitFor}, api.POSTTransactionJSONRequestBody{RawTx: rawTx})
+	if err != nil {
+		c.stats.failed.WithLabelValues(failureSubmit).Inc()
+		return errors.Join(ErrFailedToSubmitTx, err)
+	}
+	c.stats.submitDuration.Observe(c.now().Sub(start).Seconds())
+
+	if resp.JSON200 == nil {
+		c.stats.failed.WithLabelValues(failureSubmit).Inc()
+		c.utxo = nil
+		return errors.Join(ErrFailedToSubmitTx, fmt.Errorf("status: %s, response: %s", resp.Status(), strings.TrimSpace(string(resp.Body))))
+	}
+
+	status := metamorph_api.Status(metamorph_api.Status_value[string(resp.JSON200.TxStatus)])
+	if status == metamorph_api.Status_REJECTED || status == metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED {
+		c.stats.failed.WithLabelValues(failureRejected).Inc()
+		c.utxo = nil
+		extraInfo := ""
+		if resp.JSON200.ExtraInfo != nil {
+			extraInfo = *resp.JSON200.ExtraInfo
+		}
+		return errors.Join(ErrCanaryTxRejected, fmt.Errorf("hash: %s, status: %s, reason: %s", resp.JSON200.Txid, status, extraInfo))
+	}
+
+	c.stats.submitted.Inc()
+	c.pending[tx.TxID().String()] = &pendingTx{submittedAt: start, status: status}
+
+	c.utxo = &sdkTx.UTXO{
+		TxID:          tx.TxID(),
+		Vout:          0,
+		LockingScript: tx.Outputs[0].LockingScript,
+		Satoshis:      tx.Outputs[0].Satoshis,
+	}
+
+	c.logger.Info("Canary transaction submitted", slog.String("hash", tx.TxID().String()), slog.String("status", status.String()))
+
+	return nil
+}
+
+// createTx creates a transaction spending the output of the previous canary transaction or the largest UTXO of the
+// canary address back to the canary address.
+func (c *Canary) createTx(ctx context.Context) (*sdkTx.Transaction, error) {
+	utxo := c.utxo
+	if utxo == nil {
+		utxos, err := c.utxoClient.GetUTXOs(ctx, c.keySet.Address(c.mainnet))
+		if err != nil {
+			return nil, errors.Join(ErrNoUTXOs, err)
+		}
+
+		for _, u := range utxos {
+			if utxo == nil || u.Satoshis > utxo.Satoshis {
+				utxo = u
+			}
+		}
+
+		if utxo == nil {
+			return nil, errors.Join(ErrNoUTXOs, fmt.Errorf("address: %s", c.keySet.Address(c.mainnet)))
+		}
+	}
+
+	tx := sdkTx.NewTransaction()
+	err := tx.AddInputsFromUTXOs(utxo)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateTx, err)
+	}
+
+	fee, err := broadcaster.ComputeFee(tx, c.feeModel)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateTx, err)
+	}
+
+	if utxo.Satoshis <= fee {
+		return nil, errors.Join(ErrInsufficientFunds, fmt.Errorf("satoshis: %d, fee: %d", utxo.Satoshis, fee))
+	}
+
+	err = broadcaster.PayTo(tx, c.keySet.Script, utxo.Satoshis-fee)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateTx, err)
+	}
+
+	err = broadcaster.SignAllInputs(tx, c.keySet.Signer)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateTx, err)
+	}
+
+	return tx, nil
+}
+
+func (c *Canary) Shutdown() {
+	c.cancelAll()
+	c.waitGroup.Wait()
+	c.stats.unregister()
+}
@@ -0,0 +1,4 @@
+package canary
+
+//go:generate moq -pkg mocks -out ./mocks/arc_client_mock.go . ArcClient
+//go:generate moq -pkg mocks -out ./mocks/utxo_client_mock.go . UtxoClient
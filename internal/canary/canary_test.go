@@ -0,0 +1,165 @@
+package canary_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	sdkChainhash "github.com/bsv-blockchain/go-sdk/chainhash"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	chaincfg "github.com/bsv-blockchain/go-sdk/transaction/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/canary"
+	"github.com/bitcoin-sv/arc/internal/canary/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+	"github.com/bitcoin-sv/arc/pkg/api"
+	"github.com/bitcoin-sv/arc/pkg/keyset"
+)
+
+func TestCanary_Run(t *testing.T) {
+	tt := []struct {
+		name         string
+		utxoSatoshis uint64
+		getUTXOsErr  error
+		submitStatus string
+		submitCode   int
+		minedStatus  string
+		elapsed      time.Duration
+
+		expectedPosts        int
+		expectedGetUTXOs     int
+		expectedChainedInput bool
+	}{
+		{
+			name:         "success - second canary transaction spends the first",
+			utxoSatoshis: 1000,
+			submitStatus: "SEEN_ON_NETWORK",
+			submitCode:   http.StatusOK,
+			minedStatus:  "MINED",
+			elapsed:      time.Minute,
+
+			expectedPosts:        2,
+			expectedGetUTXOs:     1,
+			expectedChainedInput: true,
+		},
+		{
+			name:         "success - pending canary transaction times out",
+			utxoSatoshis: 1000,
+			submitStatus: "SEEN_ON_NETWORK",
+			submitCode:   http.StatusOK,
+			minedStatus:  "SEEN_ON_NETWORK",
+			elapsed:      3 * time.Hour,
+
+			expectedPosts:        2,
+			expectedGetUTXOs:     1,
+			expectedChainedInput: true,
+		},
+		{
+			name:         "error - canary transaction rejected",
+			utxoSatoshis: 1000,
+			submitStatus: "REJECTED",
+			submitCode:   http.StatusOK,
+			elapsed:      time.Minute,
+
+			expectedPosts:    2,
+			expectedGetUTXOs: 2,
+		},
+		{
+			name:         "error - submission failed",
+			utxoSatoshis: 1000,
+			submitCode:   http.StatusInternalServerError,
+			elapsed:      time.Minute,
+
+			expectedPosts:    2,
+			expectedGetUTXOs: 2,
+		},
+		{
+			name:         "error - utxo does not cover fee",
+			utxoSatoshis: 1,
+			elapsed:      time.Minute,
+
+			expectedPosts:    0,
+			expectedGetUTXOs: 2,
+		},
+		{
+			name:        "error - failed to get utxos",
+			getUTXOsErr: errors.New("woc unavailable"),
+			elapsed:     time.Minute,
+
+			expectedPosts:    0,
+			expectedGetUTXOs: 2,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			ks, err := keyset.New(&chaincfg.TestNet)
+			require.NoError(t, err)
+			utxoTxID, err := sdkChainhash.NewHashFromHex(testdata.TX2)
+			require.NoError(t, err)
+
+			utxoClient := &mocks.UtxoClientMock{
+				GetUTXOsFunc: func(_ context.Context, address string) (sdkTx.UTXOs, error) {
+					require.Equal(t, ks.Address(false), address)
+					return sdkTx.UTXOs{
+						{TxID: utxoTxID, Vout: 0, LockingScript: ks.Script, Satoshis: tc.utxoSatoshis},
+					}, tc.getUTXOsErr
+				},
+			}
+
+			var posted []*sdkTx.Transaction
+			arcClient := &mocks.ArcClientMock{
+				POSTTransactionWithResponseFunc: func(_ context.Context, params *api.POSTTransactionParams, body api.POSTTransactionJSONRequestBody, _ ...api.RequestEditorFn) (*api.POSTTransactionResponse, error) {
+					require.Equal(t, "SEEN_ON_NETWORK", *params.XWaitFor)
+
+					tx, err := sdkTx.NewTransactionFromHex(body.RawTx)
+					require.NoError(t, err)
+					posted = append(posted, tx)
+
+					resp := &api.POSTTransactionResponse{HTTPResponse: &http.Response{StatusCode: tc.submitCode, Status: http.StatusText(tc.submitCode)}}
+					if tc.submitCode == http.StatusOK {
+						resp.JSON200 = &api.TransactionResponse{Txid: tx.TxID().String(), TxStatus: api.TransactionResponseTxStatus(tc.submitStatus)}
+					}
+					return resp, nil
+				},
+				GETTransactionStatusWithResponseFunc: func(_ context.Context, txid string, _ ...api.RequestEditorFn) (*api.GETTransactionStatusResponse, error) {
+					return &api.GETTransactionStatusResponse{
+						JSON200: &api.TransactionStatus{Txid: txid, TxStatus: api.TransactionStatusTxStatus(tc.minedStatus)},
+					}, nil
+				},
+			}
+
+			now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			sut := canary.New(slog.Default(), arcClient, utxoClient, ks, canary.WithNow(func() time.Time { return now }))
+
+			// when
+			sut.Run(context.Background())
+			now = now.Add(tc.elapsed)
+			sut.Run(context.Background())
+
+			// then
+			require.Len(t, posted, tc.expectedPosts)
+			require.Len(t, utxoClient.GetUTXOsCalls(), tc.expectedGetUTXOs)
+
+			if tc.expectedPosts == 0 {
+				return
+			}
+
+			require.Equal(t, testdata.TX2, posted[0].Inputs[0].SourceTXID.String())
+			require.Len(t, posted[0].Outputs, 1)
+			require.Less(t, posted[0].Outputs[0].Satoshis, tc.utxoSatoshis)
+
+			if tc.expectedChainedInput {
+				require.Equal(t, posted[0].TxID().String(), posted[1].Inputs[0].SourceTXID.String())
+				require.Len(t, arcClient.GETTransactionStatusWithResponseCalls(), 1)
+			} else {
+				require.Equal(t, testdata.TX2, posted[1].Inputs[0].SourceTXID.String())
+			}
+		})
+	}
+}
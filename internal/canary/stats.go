@@ -0,0 +1,68 @@
+package canary
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type stats struct {
+	submitted      prometheus.Counter
+	failed         *prometheus.CounterVec
+	pending        prometheus.Gauge
+	lastMined      prometheus.Gauge
+	submitDuration prometheus.Histogram
+	minedDuration  prometheus.Histogram
+}
+
+func newStats() *stats {
+	return &stats{
+		submitted: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "arc_canary_submitted_total",
+			Help: "Number of canary transactions submitted successfully",
+		}),
+		failed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_canary_failed_total",
+			Help: "Number of failures of canary transactions by reason: submit, rejected, timeout or status",
+		}, []string{"reason"}),
+		pending: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "arc_canary_pending",
+			Help: "Number of canary transactions which are not mined yet",
+		}),
+		lastMined: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "arc_canary_last_mined_timestamp_seconds",
+			Help: "Unix time at which the last canary transaction was found mined",
+		}),
+		submitDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "arc_canary_submit_duration_seconds",
+			Help:    "Duration of the submission of canary transactions until SEEN_ON_NETWORK",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 10),
+		}),
+		minedDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "arc_canary_mined_duration_seconds",
+			Help:    "Duration from the submission of canary transactions until they were found mined",
+			Buckets: prometheus.ExponentialBuckets(60, 1.5, 10),
+		}),
+	}
+}
+
+func (s *stats) collectors() []prometheus.Collector {
+	return []prometheus.Collector{s.submitted, s.failed, s.pending, s.lastMined, s.submitDuration, s.minedDuration}
+}
+
+func (s *stats) register() error {
+	for _, c := range s.collectors() {
+		err := prometheus.Register(c)
+		if err != nil {
+			return fmt.Errorf("failed to register stats collector: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *stats) unregister() {
+	for _, c := range s.collectors() {
+		_ = prometheus.Unregister(c)
+	}
+}
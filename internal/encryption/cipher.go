@@ -0,0 +1,133 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// encryptedPrefix marks encrypted values, values without it are returned unchanged by Decrypt, so that values
+	// written before the encryption was enabled can still be read
+	encryptedPrefix = "enc:"
+	keySize         = 32
+)
+
+var (
+	ErrNoKeys             = errors.New("no encryption keys given")
+	ErrInvalidKey         = errors.New("invalid encryption key")
+	ErrCurrentKeyNotFound = errors.New("current encryption key not found")
+	ErrUnknownKey         = errors.New("value is encrypted with unknown key")
+	ErrInvalidCiphertext  = errors.New("invalid encrypted value")
+	ErrFailedToEncrypt    = errors.New("failed to encrypt value")
+)
+
+// Key is an AES-256 key identified by ID. The ID is stored with each encrypted value, so that the values can still be
+// decrypted after the current key has been rotated.
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+// Cipher encrypts values with AES-GCM using the current key and decrypts values encrypted with any of its keys. A nil
+// Cipher leaves all values unchanged.
+type Cipher struct {
+	aeads        map[string]cipher.AEAD
+	currentKeyID string
+}
+
+func NewCipher(keys []Key, currentKeyID string) (*Cipher, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeys
+	}
+
+	c := &Cipher{
+		aeads:        make(map[string]cipher.AEAD, len(keys)),
+		currentKeyID: currentKeyID,
+	}
+
+	for _, key := range keys {
+		if key.ID == "" || strings.Contains(key.ID, ":") {
+			return nil, errors.Join(ErrInvalidKey, fmt.Errorf("key ID %q must not be empty or contain ':'", key.ID))
+		}
+
+		if len(key.Secret) != keySize {
+			return nil, errors.Join(ErrInvalidKey, fmt.Errorf("key %s has %d bytes instead of %d", key.ID, len(key.Secret), keySize))
+		}
+
+		block, err := aes.NewCipher(key.Secret)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidKey, err)
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidKey, err)
+		}
+
+		c.aeads[key.ID] = aead
+	}
+
+	if _, found := c.aeads[currentKeyID]; !found {
+		return nil, errors.Join(ErrCurrentKeyNotFound, fmt.Errorf("key ID: %s", currentKeyID))
+	}
+
+	return c, nil
+}
+
+// Encrypt encrypts the value with the current key as `enc:<key ID>:<base64 of nonce and ciphertext>`. Empty values
+// are not encrypted.
+func (c *Cipher) Encrypt(value string) (string, error) {
+	if c == nil || value == "" {
+		return value, nil
+	}
+
+	aead := c.aeads[c.currentKeyID]
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err := rand.Read(nonce)
+	if err != nil {
+		return "", errors.Join(ErrFailedToEncrypt, err)
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(c.currentKeyID))
+
+	return encryptedPrefix + c.currentKeyID + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts the value, values which are not encrypted are returned unchanged.
+func (c *Cipher) Decrypt(value string) (string, error) {
+	if !strings.HasPrefix(value, encryptedPrefix) {
+		return value, nil
+	}
+
+	keyID, encoded, found := strings.Cut(strings.TrimPrefix(value, encryptedPrefix), ":")
+	if !found {
+		return "", ErrInvalidCiphertext
+	}
+
+	if c == nil {
+		return "", errors.Join(ErrUnknownKey, fmt.Errorf("key ID: %s", keyID))
+	}
+
+	aead, found := c.aeads[keyID]
+	if !found {
+		return "", errors.Join(ErrUnknownKey, fmt.Errorf("key ID: %s", keyID))
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrInvalidCiphertext
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(keyID))
+	if err != nil {
+		return "", errors.Join(ErrInvalidCiphertext, err)
+	}
+
+	return string(plaintext), nil
+}
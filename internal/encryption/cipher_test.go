@@ -0,0 +1,171 @@
+package encryption
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	oldKey = Key{ID: "2025-01", Secret: bytes.Repeat([]byte{1}, keySize)}
+	newKey = Key{ID: "2026-01", Secret: bytes.Repeat([]byte{2}, keySize)}
+)
+
+func TestNewCipher(t *testing.T) {
+	tt := []struct {
+		name         string
+		keys         []Key
+		currentKeyID string
+
+		expectedError error
+	}{
+		{
+			name:         "success",
+			keys:         []Key{oldKey, newKey},
+			currentKeyID: newKey.ID,
+		},
+		{
+			name: "no keys",
+
+			expectedError: ErrNoKeys,
+		},
+		{
+			name:         "key too short",
+			keys:         []Key{{ID: "short", Secret: []byte("secret")}},
+			currentKeyID: "short",
+
+			expectedError: ErrInvalidKey,
+		},
+		{
+			name:         "invalid key ID",
+			keys:         []Key{{ID: "a:b", Secret: newKey.Secret}},
+			currentKeyID: "a:b",
+
+			expectedError: ErrInvalidKey,
+		},
+		{
+			name:         "current key not found",
+			keys:         []Key{oldKey},
+			currentKeyID: newKey.ID,
+
+			expectedError: ErrCurrentKeyNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := NewCipher(tc.keys, tc.currentKeyID)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestCipher_Decrypt(t *testing.T) {
+	oldCipher, err := NewCipher([]Key{oldKey}, oldKey.ID)
+	require.NoError(t, err)
+	rotatedCipher, err := NewCipher([]Key{oldKey, newKey}, newKey.ID)
+	require.NoError(t, err)
+	newCipher, err := NewCipher([]Key{newKey}, newKey.ID)
+	require.NoError(t, err)
+
+	encryptedWithOldKey, err := oldCipher.Encrypt("token")
+	require.NoError(t, err)
+
+	tt := []struct {
+		name   string
+		cipher *Cipher
+		value  string
+
+		expected      string
+		expectedError error
+	}{
+		{
+			name:   "plaintext value",
+			cipher: rotatedCipher,
+			value:  "token",
+
+			expected: "token",
+		},
+		{
+			name:   "encrypted with rotated key",
+			cipher: rotatedCipher,
+			value:  encryptedWithOldKey,
+
+			expected: "token",
+		},
+		{
+			name:   "encrypted with removed key",
+			cipher: newCipher,
+			value:  encryptedWithOldKey,
+
+			expectedError: ErrUnknownKey,
+		},
+		{
+			name:  "no cipher",
+			value: encryptedWithOldKey,
+
+			expectedError: ErrUnknownKey,
+		},
+		{
+			name:   "tampered value",
+			cipher: oldCipher,
+			value:  encryptedWithOldKey[:len(encryptedWithOldKey)-4] + "AAAA",
+
+			expectedError: ErrInvalidCiphertext,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := tc.cipher.Decrypt(tc.value)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestCipher_Encrypt(t *testing.T) {
+	// given
+	sut, err := NewCipher([]Key{oldKey, newKey}, newKey.ID)
+	require.NoError(t, err)
+
+	// when
+	actual, err := sut.Encrypt("token")
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(actual, "enc:2026-01:"))
+	assert.NotContains(t, actual, "token")
+
+	decrypted, err := sut.Decrypt(actual)
+	require.NoError(t, err)
+	assert.Equal(t, "token", decrypted)
+
+	empty, err := sut.Encrypt("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+
+	var noCipher *Cipher
+	unchanged, err := noCipher.Encrypt("token")
+	require.NoError(t, err)
+	assert.Equal(t, "token", unchanged)
+}
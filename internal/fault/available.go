@@ -0,0 +1,7 @@
+//go:build fault_injection
+
+package fault
+
+// Available reports whether the binary was built with the tag fault_injection, without it fault injection cannot be
+// enabled by the configuration.
+const Available = true
@@ -0,0 +1,88 @@
+package fault_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/fault"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
+)
+
+func TestStore_SetBulk(t *testing.T) {
+	tt := []struct {
+		name string
+		rule fault.Rule
+
+		expectedErrorStr string
+		expectedWrites   int
+	}{
+		{
+			name: "success - no fault",
+			rule: fault.Rule{Point: fault.PointStoreWrite, Error: true, After: 1},
+
+			expectedWrites: 1,
+		},
+		{
+			name: "success - write dropped",
+			rule: fault.Rule{Point: fault.PointStoreWrite, Drop: true},
+
+			expectedWrites: 0,
+		},
+		{
+			name: "error - injected",
+			rule: fault.Rule{Point: fault.PointStoreWrite, Error: true},
+
+			expectedErrorStr: fault.ErrInjected.Error(),
+			expectedWrites:   0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				SetBulkFunc: func(_ context.Context, _ []*store.Data) error { return nil },
+			}
+			injector, err := fault.New(slog.Default(), []fault.Rule{tc.rule})
+			require.NoError(t, err)
+
+			sut := fault.NewStore(metamorphStore, injector)
+
+			// when
+			err = sut.SetBulk(context.Background(), []*store.Data{{}})
+
+			// then
+			require.Len(t, metamorphStore.SetBulkCalls(), tc.expectedWrites)
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMessageQueueClient_Publish(t *testing.T) {
+	// given
+	mqClient := &mqMocks.MessageQueueClientMock{
+		PublishFunc: func(_ context.Context, _ string, _ []byte) error { return nil },
+	}
+	injector, err := fault.New(slog.Default(), []fault.Rule{{Point: fault.PointMQPublish, Drop: true, Every: 2}})
+	require.NoError(t, err)
+
+	sut := fault.NewMessageQueueClient(mqClient, injector)
+
+	// when
+	for range 4 {
+		err = sut.Publish(context.Background(), "topic", []byte("data"))
+		require.NoError(t, err)
+	}
+
+	// then
+	require.Len(t, mqClient.PublishCalls(), 2)
+}
@@ -0,0 +1,144 @@
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Point is a named point in the code at which faults can be injected.
+type Point string
+
+const (
+	// PointPeerAnnounce is the announcement of transactions to the peers.
+	PointPeerAnnounce Point = "peerAnnounce"
+	// PointMQPublish is the publishing of messages to the message queue.
+	PointMQPublish Point = "mqPublish"
+	// PointStoreWrite is the writing of transactions to the store.
+	PointStoreWrite Point = "storeWrite"
+)
+
+var (
+	ErrInjected     = errors.New("injected fault")
+	ErrDropped      = errors.New("dropped by injected fault")
+	ErrUnknownPoint = errors.New("unknown fault injection point")
+	ErrNotAvailable = errors.New("fault injection is not available, the binary has to be built with the tag fault_injection")
+
+	points = map[Point]struct{}{
+		PointPeerAnnounce: {},
+		PointMQPublish:    {},
+		PointStoreWrite:   {},
+	}
+)
+
+// Rule injects a fault at a point. The calls passing the point are counted, the first After calls are skipped, then
+// every Every-th call is faulted until Count faults were injected, so that the same faults are injected in every run.
+type Rule struct {
+	Point Point
+	// Latency delays the call
+	Latency time.Duration
+	// Error fails the call with ErrInjected
+	Error bool
+	// Drop skips the call without an error, e.g. the message is silently lost
+	Drop  bool
+	After int
+	// Every is the interval of the faulted calls, 0 or 1 faults every call
+	Every int
+	// Count is the maximum number of faults injected by the rule, 0 is unlimited
+	Count int
+}
+
+type rule struct {
+	Rule
+	calls    int
+	injected int
+}
+
+// Injector injects the faults defined by its rules at the points passed by the decorators of the store, the message
+// queue client and the mediator.
+type Injector struct {
+	logger *slog.Logger
+	mu     sync.Mutex
+	rules  map[Point][]*rule
+}
+
+func New(logger *slog.Logger, rules []Rule) (*Injector, error) {
+	i := &Injector{
+		logger: logger.With(slog.String("module", "fault-injector")),
+		rules:  make(map[Point][]*rule),
+	}
+
+	for _, r := range rules {
+		if _, ok := points[r.Point]; !ok {
+			return nil, errors.Join(ErrUnknownPoint, fmt.Errorf("point: %s", r.Point))
+		}
+
+		i.rules[r.Point] = append(i.rules[r.Point], &rule{Rule: r})
+	}
+
+	return i, nil
+}
+
+// Inject applies the rules of the point to the current call. It waits for the injected latency and returns ErrDropped
+// if the call has to be skipped and ErrInjected if the call has to fail.
+func (i *Injector) Inject(ctx context.Context, point Point) error {
+	latency, err := i.next(point)
+
+	if latency > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(latency):
+		}
+	}
+
+	return err
+}
+
+func (i *Injector) next(point Point) (time.Duration, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	var latency time.Duration
+	var err error
+
+	for _, r := range i.rules[point] {
+		r.calls++
+
+		if !r.applies() {
+			continue
+		}
+		r.injected++
+
+		latency += r.Latency
+		// the fault of the first matching rule failing or dropping the call is returned
+		switch {
+		case err != nil:
+		case r.Error:
+			err = errors.Join(ErrInjected, fmt.Errorf("point: %s", point))
+		case r.Drop:
+			err = ErrDropped
+		}
+
+		i.logger.Debug("Injecting fault", slog.String("point", string(point)), slog.Duration("latency", r.Latency), slog.Bool("error", r.Error), slog.Bool("drop", r.Drop))
+	}
+
+	return latency, err
+}
+
+func (r *rule) applies() bool {
+	if r.calls <= r.After {
+		return false
+	}
+
+	if r.Count > 0 && r.injected >= r.Count {
+		return false
+	}
+
+	every := max(r.Every, 1)
+
+	return (r.calls-r.After-1)%every == 0
+}
@@ -0,0 +1,144 @@
+package fault_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/fault"
+)
+
+func TestNew(t *testing.T) {
+	tt := []struct {
+		name  string
+		rules []fault.Rule
+
+		expectedErrorStr string
+	}{
+		{
+			name:  "success",
+			rules: []fault.Rule{{Point: fault.PointStoreWrite, Error: true}, {Point: fault.PointMQPublish, Drop: true}},
+		},
+		{
+			name:  "error - unknown point",
+			rules: []fault.Rule{{Point: "dbRead", Error: true}},
+
+			expectedErrorStr: fault.ErrUnknownPoint.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := fault.New(slog.Default(), tc.rules)
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestInjector_Inject(t *testing.T) {
+	tt := []struct {
+		name  string
+		rules []fault.Rule
+		calls int
+
+		expectedErrors   []error
+		expectedMinDelay time.Duration
+	}{
+		{
+			name:  "no rules",
+			calls: 2,
+
+			expectedErrors: []error{nil, nil},
+		},
+		{
+			name:  "error on every call",
+			rules: []fault.Rule{{Point: fault.PointStoreWrite, Error: true}},
+			calls: 2,
+
+			expectedErrors: []error{fault.ErrInjected, fault.ErrInjected},
+		},
+		{
+			name:  "drop every second call after the first two calls",
+			rules: []fault.Rule{{Point: fault.PointStoreWrite, Drop: true, After: 2, Every: 2}},
+			calls: 6,
+
+			expectedErrors: []error{nil, nil, fault.ErrDropped, nil, fault.ErrDropped, nil},
+		},
+		{
+			name:  "error limited by count",
+			rules: []fault.Rule{{Point: fault.PointStoreWrite, Error: true, Count: 1}},
+			calls: 3,
+
+			expectedErrors: []error{fault.ErrInjected, nil, nil},
+		},
+		{
+			name:  "rules of other points are not applied",
+			rules: []fault.Rule{{Point: fault.PointMQPublish, Error: true}},
+			calls: 1,
+
+			expectedErrors: []error{nil},
+		},
+		{
+			name: "latency and error of multiple rules",
+			rules: []fault.Rule{
+				{Point: fault.PointStoreWrite, Latency: 10 * time.Millisecond},
+				{Point: fault.PointStoreWrite, Error: true, After: 1},
+			},
+			calls: 2,
+
+			expectedErrors:   []error{nil, fault.ErrInjected},
+			expectedMinDelay: 20 * time.Millisecond,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := fault.New(slog.Default(), tc.rules)
+			require.NoError(t, err)
+
+			// when
+			start := time.Now()
+			actualErrors := make([]error, 0, tc.calls)
+			for range tc.calls {
+				actualErrors = append(actualErrors, sut.Inject(context.Background(), fault.PointStoreWrite))
+			}
+
+			// then
+			require.GreaterOrEqual(t, time.Since(start), tc.expectedMinDelay)
+			require.Len(t, actualErrors, len(tc.expectedErrors))
+			for i, expectedErr := range tc.expectedErrors {
+				if expectedErr == nil {
+					require.NoError(t, actualErrors[i])
+					continue
+				}
+				require.ErrorIs(t, actualErrors[i], expectedErr)
+			}
+		})
+	}
+}
+
+func TestInjector_Inject_Canceled(t *testing.T) {
+	// given
+	sut, err := fault.New(slog.Default(), []fault.Rule{{Point: fault.PointPeerAnnounce, Latency: time.Hour}})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// when
+	err = sut.Inject(ctx, fault.PointPeerAnnounce)
+
+	// then
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,28 @@
+package fault
+
+import (
+	"context"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+// Mediator injects faults at PointPeerAnnounce into the announcement of transactions to the peers. As announcements
+// are asynchronous, failed announcements are dropped.
+type Mediator struct {
+	metamorph.Mediator
+	injector *Injector
+}
+
+func NewMediator(mediator metamorph.Mediator, injector *Injector) *Mediator {
+	return &Mediator{Mediator: mediator, injector: injector}
+}
+
+func (m *Mediator) AnnounceTxAsync(ctx context.Context, tx *store.Data) {
+	err := m.injector.Inject(ctx, PointPeerAnnounce)
+	if err != nil {
+		return
+	}
+
+	m.Mediator.AnnounceTxAsync(ctx, tx)
+}
@@ -0,0 +1,79 @@
+package fault
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/internal/mq"
+)
+
+// MessageQueueClient injects faults at PointMQPublish into the publishing of messages. Dropped messages are not
+// published and succeed.
+type MessageQueueClient struct {
+	mq.MessageQueueClient
+	injector *Injector
+}
+
+func NewMessageQueueClient(client mq.MessageQueueClient, injector *Injector) *MessageQueueClient {
+	return &MessageQueueClient{MessageQueueClient: client, injector: injector}
+}
+
+func (c *MessageQueueClient) PublishCore(ctx context.Context, topic string, data []byte) error {
+	err := c.injector.Inject(ctx, PointMQPublish)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return c.MessageQueueClient.PublishCore(ctx, topic, data)
+}
+
+func (c *MessageQueueClient) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) error {
+	err := c.injector.Inject(ctx, PointMQPublish)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return c.MessageQueueClient.PublishMarshalCore(ctx, topic, m)
+}
+
+func (c *MessageQueueClient) Publish(ctx context.Context, topic string, data []byte) error {
+	err := c.injector.Inject(ctx, PointMQPublish)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return c.MessageQueueClient.Publish(ctx, topic, data)
+}
+
+func (c *MessageQueueClient) PublishAsync(ctx context.Context, topic string, hash []byte) error {
+	err := c.injector.Inject(ctx, PointMQPublish)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return c.MessageQueueClient.PublishAsync(ctx, topic, hash)
+}
+
+func (c *MessageQueueClient) PublishMarshal(ctx context.Context, topic string, m proto.Message) error {
+	err := c.injector.Inject(ctx, PointMQPublish)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return c.MessageQueueClient.PublishMarshal(ctx, topic, m)
+}
+
+func (c *MessageQueueClient) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error {
+	err := c.injector.Inject(ctx, PointMQPublish)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return c.MessageQueueClient.PublishMarshalAsync(ctx, topic, m)
+}
+
+// Subscribe subscribes to the topic if the wrapped client is a mq.MessageSubscriber.
+func (c *MessageQueueClient) Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return mq.Subscribe(c.MessageQueueClient, topic, msgFunc)
+}
@@ -0,0 +1,94 @@
+package fault
+
+import (
+	"context"
+	"errors"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+// Store injects faults at PointStoreWrite into the writes of the transactions to the metamorph store. Dropped writes
+// are not written and succeed without returning any updated transactions.
+type Store struct {
+	store.MetamorphStore
+	injector *Injector
+}
+
+func NewStore(s store.MetamorphStore, injector *Injector) *Store {
+	return &Store{MetamorphStore: s, injector: injector}
+}
+
+func (s *Store) Set(ctx context.Context, value *store.Data) error {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.Set(ctx, value)
+}
+
+func (s *Store) SetBulk(ctx context.Context, data []*store.Data) error {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.SetBulk(ctx, data)
+}
+
+func (s *Store) UpdateStatus(ctx context.Context, updates []store.UpdateStatus) ([]*store.Data, error) {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return nil, ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.UpdateStatus(ctx, updates)
+}
+
+func (s *Store) UpdateStatusHistory(ctx context.Context, updates []store.UpdateStatus) ([]*store.Data, error) {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return nil, ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.UpdateStatusHistory(ctx, updates)
+}
+
+func (s *Store) UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.TransactionBlock) ([]*store.Data, error) {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return nil, ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.UpdateMined(ctx, txsBlocks)
+}
+
+func (s *Store) UpdateDoubleSpend(ctx context.Context, updates []store.UpdateStatus, updateCompetingTxs bool) ([]*store.Data, error) {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return nil, ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.UpdateDoubleSpend(ctx, updates, updateCompetingTxs)
+}
+
+func (s *Store) SetRequested(ctx context.Context, hashes []*chainhash.Hash) error {
+	err := s.injector.Inject(ctx, PointStoreWrite)
+	if err != nil {
+		return ignoreDropped(err)
+	}
+
+	return s.MetamorphStore.SetRequested(ctx, hashes)
+}
+
+// ignoreDropped returns nil for dropped calls, so that the caller is not aware of the lost call.
+func ignoreDropped(err error) error {
+	if errors.Is(err, ErrDropped) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,325 @@
+package feature
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+)
+
+// Flag is the name of a feature flag, prefixed by the component the feature belongs to.
+type Flag string
+
+const (
+	// ReorgHandling enables the handling of chain reorgs by blocktx.
+	ReorgHandling Flag = "blocktx.reorgHandling"
+	// CumulativeFees enables the cumulative fee validation requested with the header X-CumulativeFeeValidation.
+	CumulativeFees Flag = "api.cumulativeFees"
+	// Rebroadcast enables the re-announcement of unseen and pending transactions by metamorph.
+	Rebroadcast Flag = "metamorph.rebroadcast"
+
+	flagsKey            = "arc-feature-flags"
+	syncIntervalDefault = 10 * time.Second
+)
+
+var (
+	ErrUnknownFlag       = errors.New("unknown feature flag")
+	ErrFailedToSetFlag   = errors.New("failed to set feature flag")
+	ErrFailedToSyncFlags = errors.New("failed to sync feature flags")
+	ErrFlagNotShared     = errors.New("feature flag belongs to a component of another process and the flags are not shared")
+
+	defaults = map[Flag]bool{
+		ReorgHandling:  true,
+		CumulativeFees: true,
+		Rebroadcast:    true,
+	}
+)
+
+// Flags holds the feature flags of the process. The configured values of the flags can be overridden at runtime. If
+// a store is given, the overrides are written to the store and synced from it, so that they apply to all instances.
+type Flags struct {
+	logger       *slog.Logger
+	store        cache.Store
+	syncInterval time.Duration
+	components   map[string]struct{}
+
+	mu         sync.RWMutex
+	configured map[Flag]bool
+	overrides  map[Flag]bool
+
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+// WithStore shares the overrides of the flags with the other instances using the store, e.g. Redis.
+func WithStore(store cache.Store, syncInterval time.Duration) func(*Flags) {
+	return func(f *Flags) {
+		f.store = store
+		f.syncInterval = syncInterval
+	}
+}
+
+// WithComponents sets the components running in the process, e.g. `api` and `metamorph`. Without a store, only the flags
+// of these components can be overridden, as overrides of the flags of components running in other processes would not
+// reach them.
+func WithComponents(components ...string) func(*Flags) {
+	return func(f *Flags) {
+		f.components = make(map[string]struct{}, len(components))
+		for _, component := range components {
+			f.components[component] = struct{}{}
+		}
+	}
+}
+
+// New creates the flags with the configured values, flags which are not configured have their default value.
+func New(logger *slog.Logger, configured map[string]bool, opts ...func(*Flags)) (*Flags, error) {
+	f := &Flags{
+		logger:       logger.With(slog.String("module", "feature-flags")),
+		syncInterval: syncIntervalDefault,
+		configured:   make(map[Flag]bool, len(defaults)),
+		overrides:    make(map[Flag]bool),
+		waitGroup:    &sync.WaitGroup{},
+	}
+
+	for flag, enabled := range defaults {
+		f.configured[flag] = enabled
+	}
+
+	for name, enabled := range configured {
+		flag := Flag(name)
+		if _, ok := defaults[flag]; !ok {
+			return nil, errors.Join(ErrUnknownFlag, fmt.Errorf("flag: %s", name))
+		}
+		f.configured[flag] = enabled
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	f.cancelAll = cancelAll
+	f.ctx = ctx
+
+	return f, nil
+}
+
+// Enabled reports whether the feature is enabled. Nil flags enable every feature, so that components can be used
+// without flags.
+func (f *Flags) Enabled(flag Flag) bool {
+	if f == nil {
+		return true
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	enabled, found := f.overrides[flag]
+	if found {
+		return enabled
+	}
+
+	return f.configured[flag]
+}
+
+// Set overrides the configured value of the flag until it is reset.
+func (f *Flags) Set(flag Flag, enabled bool) error {
+	err := f.checkOverridable(flag)
+	if err != nil {
+		return err
+	}
+
+	if f.store != nil {
+		err := f.store.MapSet(flagsKey, string(flag), []byte(strconv.FormatBool(enabled)))
+		if err != nil {
+			return errors.Join(ErrFailedToSetFlag, err)
+		}
+	}
+
+	f.mu.Lock()
+	f.overrides[flag] = enabled
+	f.mu.Unlock()
+
+	f.logger.Warn("Feature flag set", slog.String("flag", string(flag)), slog.Bool("enabled", enabled))
+
+	return nil
+}
+
+// Reset removes the override of the flag, so that the flag has its configured value again.
+func (f *Flags) Reset(flag Flag) error {
+	err := f.checkOverridable(flag)
+	if err != nil {
+		return err
+	}
+
+	if f.store != nil {
+		err := f.store.MapDel(flagsKey, string(flag))
+		if err != nil && !errors.Is(err, cache.ErrCacheNotFound) {
+			return errors.Join(ErrFailedToSetFlag, err)
+		}
+	}
+
+	f.mu.Lock()
+	delete(f.overrides, flag)
+	f.mu.Unlock()
+
+	f.logger.Warn("Feature flag reset", slog.String("flag", string(flag)))
+
+	return nil
+}
+
+// checkOverridable returns an error if the flag is unknown or belongs to a component running in another process which
+// the override would not reach.
+func (f *Flags) checkOverridable(flag Flag) error {
+	if _, ok := defaults[flag]; !ok {
+		return errors.Join(ErrUnknownFlag, fmt.Errorf("flag: %s", flag))
+	}
+
+	if f.store != nil || f.components == nil {
+		return nil
+	}
+
+	component, _, _ := strings.Cut(string(flag), ".")
+	if _, found := f.components[component]; !found {
+		return errors.Join(ErrFlagNotShared, fmt.Errorf("flag: %s", flag))
+	}
+
+	return nil
+}
+
+// Sync replaces the overrides with the overrides in the store.
+func (f *Flags) Sync() error {
+	if f.store == nil {
+		return nil
+	}
+
+	values, err := f.store.MapGetAll(flagsKey)
+	if err != nil && !errors.Is(err, cache.ErrCacheNotFound) {
+		return errors.Join(ErrFailedToSyncFlags, err)
+	}
+
+	overrides := make(map[Flag]bool, len(values))
+	for name, value := range values {
+		flag := Flag(name)
+		if _, ok := defaults[flag]; !ok {
+			continue
+		}
+
+		enabled, err := strconv.ParseBool(string(value))
+		if err != nil {
+			f.logger.Warn("Invalid value of feature flag in store", slog.String("flag", name), slog.String("value", string(value)))
+			continue
+		}
+		overrides[flag] = enabled
+	}
+
+	f.mu.Lock()
+	f.overrides = overrides
+	f.mu.Unlock()
+
+	return nil
+}
+
+// Start syncs the overrides from the store every sync interval if a store is given.
+func (f *Flags) Start() {
+	if f.store == nil {
+		return
+	}
+
+	err := f.Sync()
+	if err != nil {
+		f.logger.Error("Failed to sync feature flags", slog.String("err", err.Error()))
+	}
+
+	ticker := time.NewTicker(f.syncInterval)
+	f.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			f.waitGroup.Done()
+		}()
+
+		for {
+			select {
+			case <-f.ctx.Done():
+				return
+			case <-ticker.C:
+				err := f.Sync()
+				if err != nil {
+					f.logger.Error("Failed to sync feature flags", slog.String("err", err.Error()))
+				}
+			}
+		}
+	}()
+}
+
+func (f *Flags) Shutdown() {
+	f.cancelAll()
+	f.waitGroup.Wait()
+}
+
+// State is the state of a feature flag. Configured is the configured value, Overridden is true if the configured
+// value is overridden at runtime.
+type State struct {
+	Flag       Flag
+	Enabled    bool
+	Configured bool
+	Overridden bool
+}
+
+// States returns the states of all flags sorted by name.
+func (f *Flags) States() []State {
+	f.mu.RLock()
+	states := make([]State, 0, len(f.configured))
+	for flag, configured := range f.configured {
+		override, overridden := f.overrides[flag]
+		enabled := configured
+		if overridden {
+			enabled = override
+		}
+		states = append(states, State{Flag: flag, Enabled: enabled, Configured: configured, Overridden: overridden})
+	}
+	f.mu.RUnlock()
+
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Flag < states[j].Flag
+	})
+
+	return states
+}
+
+type flagResponse struct {
+	Enabled    bool `json:"enabled"`
+	Configured bool `json:"configured"`
+	Overridden bool `json:"overridden"`
+}
+
+// ServeHTTP returns the flags on GET. The endpoint is read-only, the flags are overridden and reset with the operations
+// of the admin service, which require a token with the role admin.
+func (f *Flags) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	states := f.States()
+	response := make(map[Flag]flagResponse, len(states))
+	for _, state := range states {
+		response[state.Flag] = flagResponse{Enabled: state.Enabled, Configured: state.Configured, Overridden: state.Overridden}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,219 @@
+package feature_test
+
+import (
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/feature"
+)
+
+func TestNew(t *testing.T) {
+	tt := []struct {
+		name       string
+		configured map[string]bool
+
+		expectedErrorStr string
+		expectedEnabled  map[feature.Flag]bool
+	}{
+		{
+			name:       "success - configured and default flags",
+			configured: map[string]bool{string(feature.ReorgHandling): false},
+
+			expectedEnabled: map[feature.Flag]bool{
+				feature.ReorgHandling:  false,
+				feature.CumulativeFees: true,
+				feature.Rebroadcast:    true,
+			},
+		},
+		{
+			name:       "error - unknown flag",
+			configured: map[string]bool{"api.unknown": true},
+
+			expectedErrorStr: feature.ErrUnknownFlag.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			sut, err := feature.New(slog.Default(), tc.configured)
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+
+			for flag, enabled := range tc.expectedEnabled {
+				require.Equal(t, enabled, sut.Enabled(flag), flag)
+			}
+		})
+	}
+}
+
+func TestFlags_Enabled_Nil(t *testing.T) {
+	var sut *feature.Flags
+
+	require.True(t, sut.Enabled(feature.Rebroadcast))
+}
+
+func TestFlags_ServeHTTP(t *testing.T) {
+	tt := []struct {
+		name   string
+		method string
+		query  string
+
+		expectedStatus  int
+		expectedEnabled bool
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+
+			expectedStatus:  http.StatusOK,
+			expectedEnabled: true,
+		},
+		{
+			name:   "set - read-only",
+			method: http.MethodPut,
+			query:  "flag=metamorph.rebroadcast&enabled=false",
+
+			expectedStatus:  http.StatusMethodNotAllowed,
+			expectedEnabled: true,
+		},
+		{
+			name:   "reset - read-only",
+			method: http.MethodDelete,
+			query:  "flag=metamorph.rebroadcast",
+
+			expectedStatus:  http.StatusMethodNotAllowed,
+			expectedEnabled: true,
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodPost,
+
+			expectedStatus:  http.StatusMethodNotAllowed,
+			expectedEnabled: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := feature.New(slog.Default(), nil)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(tc.method, "/debug/features?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			// when
+			sut.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedStatus, rec.Code)
+			require.Equal(t, tc.expectedEnabled, sut.Enabled(feature.Rebroadcast))
+		})
+	}
+}
+
+func TestFlags_States(t *testing.T) {
+	// given
+	sut, err := feature.New(slog.Default(), map[string]bool{string(feature.CumulativeFees): false})
+	require.NoError(t, err)
+	require.NoError(t, sut.Set(feature.Rebroadcast, false))
+
+	// when
+	actual := sut.States()
+
+	// then
+	require.Equal(t, []feature.State{
+		{Flag: feature.CumulativeFees, Enabled: false, Configured: false},
+		{Flag: feature.ReorgHandling, Enabled: true, Configured: true},
+		{Flag: feature.Rebroadcast, Enabled: false, Configured: true, Overridden: true},
+	}, actual)
+}
+
+func TestFlags_Sync(t *testing.T) {
+	// given
+	store := cache.NewMemoryStore()
+	configured := map[string]bool{string(feature.CumulativeFees): false}
+
+	instance1, err := feature.New(slog.Default(), configured, feature.WithStore(store, 0))
+	require.NoError(t, err)
+	instance2, err := feature.New(slog.Default(), configured, feature.WithStore(store, 0))
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, instance2.Sync())
+	require.NoError(t, instance1.Set(feature.CumulativeFees, true))
+	require.NoError(t, instance2.Sync())
+
+	// then
+	require.True(t, instance2.Enabled(feature.CumulativeFees))
+
+	// when
+	require.NoError(t, instance1.Reset(feature.CumulativeFees))
+	require.NoError(t, instance2.Sync())
+
+	// then
+	require.False(t, instance2.Enabled(feature.CumulativeFees))
+}
+
+func TestFlags_Set_Components(t *testing.T) {
+	tt := []struct {
+		name      string
+		flag      feature.Flag
+		withStore bool
+
+		expectedErr error
+	}{
+		{
+			name: "flag of a component of the process",
+			flag: feature.CumulativeFees,
+		},
+		{
+			name: "flag of a component of another process",
+			flag: feature.Rebroadcast,
+
+			expectedErr: feature.ErrFlagNotShared,
+		},
+		{
+			name:      "flag of a component of another process - shared",
+			flag:      feature.Rebroadcast,
+			withStore: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			opts := []func(*feature.Flags){feature.WithComponents("api")}
+			if tc.withStore {
+				opts = append(opts, feature.WithStore(cache.NewMemoryStore(), 0))
+			}
+
+			sut, err := feature.New(slog.Default(), nil, opts...)
+			require.NoError(t, err)
+
+			// when
+			err = sut.Set(tc.flag, false)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, tc.expectedErr != nil, sut.Enabled(tc.flag))
+
+			// when
+			err = sut.Reset(tc.flag)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
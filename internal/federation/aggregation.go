@@ -0,0 +1,153 @@
+package federation
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+// Policy determines how the responses of the upstreams to a forwarded transaction are aggregated into the status
+// returned to the client which submitted the transaction.
+type Policy string
+
+const (
+	// PolicyNone forwards the transactions in the background, the clients get the status of this instance.
+	PolicyNone Policy = "none"
+	// PolicyFirstSuccess is satisfied as soon as one upstream acknowledged the transaction.
+	PolicyFirstSuccess Policy = "first-success"
+	// PolicyQuorum is satisfied as soon as the quorum of upstreams acknowledged the transaction.
+	PolicyQuorum Policy = "quorum"
+	// PolicyAll is satisfied once all upstreams acknowledged the transaction.
+	PolicyAll Policy = "all"
+)
+
+var (
+	ErrInvalidPolicy = errors.New("invalid aggregation policy")
+	ErrInvalidQuorum = errors.New("quorum must be between 1 and the number of upstreams")
+)
+
+// Result is the aggregation of the responses of the upstreams to a transaction. If the policy is satisfied, TxStatus
+// is the most advanced status which at least the required number of upstreams reached. Upstreams only contains the
+// acknowledgements of the upstreams which responded before the policy was decided.
+type Result struct {
+	Policy       Policy
+	Required     int
+	Satisfied    bool
+	TxStatus     string
+	Acknowledged int
+	Upstreams    []Acknowledgement
+}
+
+// WithAggregation makes Forward wait for the responses of the upstreams until the policy is decided. The quorum is
+// only used by PolicyQuorum.
+func WithAggregation(policy Policy, quorum int) func(*Federation) {
+	return func(f *Federation) {
+		f.policy = policy
+		f.quorum = quorum
+	}
+}
+
+// required returns the number of upstreams which have to acknowledge a transaction to satisfy the policy.
+func (f *Federation) required() int {
+	switch f.policy {
+	case PolicyFirstSuccess:
+		return 1
+	case PolicyQuorum:
+		return f.quorum
+	default:
+		return len(f.upstreams)
+	}
+}
+
+// broadcast submits the transactions to all upstreams and waits until the policy is decided for all transactions or
+// ctx is done. The acknowledgements of all upstreams are stored once the remaining upstreams responded.
+func (f *Federation) broadcast(ctx context.Context, txs []*sdkTx.Transaction) map[string]*Result {
+	txIDs, responses := f.submitAll(txs)
+
+	collected := make([]map[string]Acknowledgement, 0, len(f.upstreams))
+	results := make(map[string]*Result, len(txIDs))
+
+wait:
+	for len(collected) < len(f.upstreams) {
+		select {
+		case response := <-responses:
+			collected = append(collected, response)
+		case <-ctx.Done():
+			break wait
+		}
+
+		allDecided := true
+		for _, txID := range txIDs {
+			if _, decided := results[txID]; decided {
+				continue
+			}
+
+			result, decided := f.aggregate(txID, collected)
+			if decided {
+				results[txID] = result
+				continue
+			}
+			allDecided = false
+		}
+
+		if allDecided {
+			break
+		}
+	}
+
+	// the transactions whose policy was not decided before ctx was done are not satisfied
+	for _, txID := range txIDs {
+		if _, decided := results[txID]; !decided {
+			results[txID], _ = f.aggregate(txID, collected)
+		}
+	}
+
+	pending := len(f.upstreams) - len(collected)
+	f.waitGroup.Add(1)
+	go func() {
+		defer f.waitGroup.Done()
+
+		for range pending {
+			collected = append(collected, <-responses)
+		}
+		f.storeAcknowledgements(txIDs, collected)
+	}()
+
+	return results
+}
+
+// aggregate returns the result of the transaction by the responses of the upstreams so far and whether the policy is
+// decided, i.e. whether it is satisfied or can no longer be satisfied by the upstreams which did not respond yet.
+func (f *Federation) aggregate(txID string, responses []map[string]Acknowledgement) (*Result, bool) {
+	result := &Result{
+		Policy:    f.policy,
+		Required:  f.required(),
+		Upstreams: acknowledgementsOf(txID, responses),
+	}
+
+	statuses := make([]string, 0, len(result.Upstreams))
+	for _, ack := range result.Upstreams {
+		if ack.Acknowledged {
+			statuses = append(statuses, ack.TxStatus)
+		}
+	}
+	result.Acknowledged = len(statuses)
+
+	pending := len(f.upstreams) - len(responses)
+	if result.Acknowledged < result.Required {
+		return result, result.Acknowledged+pending < result.Required
+	}
+
+	// most advanced status first, so that the status at the index of the required number is reached by as many upstreams
+	slices.SortFunc(statuses, func(a, b string) int {
+		return int(metamorph_api.Status_value[b] - metamorph_api.Status_value[a])
+	})
+	result.Satisfied = true
+	result.TxStatus = statuses[result.Required-1]
+
+	return result, true
+}
@@ -0,0 +1,381 @@
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	acknowledgementKeyPrefix  = "arc-federation-ack:"
+	acknowledgementTTLDefault = 24 * time.Hour
+	timeoutDefault            = 10 * time.Second
+	workersDefault            = 4
+	queueSizeDefault          = 1000
+)
+
+var (
+	ErrNotForwarded     = errors.New("transaction was not forwarded to upstream ARC instances")
+	ErrQueueFull        = errors.New("federation queue is full")
+	ErrNoUpstreams      = errors.New("no upstream ARC instances configured")
+	ErrFailedToForward  = errors.New("failed to forward transactions to upstream ARC instance")
+	ErrUnexpectedStatus = errors.New("unexpected response status of upstream ARC instance")
+)
+
+// UpstreamClient is the client of the public API of an upstream ARC instance.
+type UpstreamClient interface {
+	POSTTransactionsWithResponse(ctx context.Context, params *api.POSTTransactionsParams, body api.POSTTransactionsJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.POSTTransactionsResponse, error)
+}
+
+// Upstream is an ARC instance, e.g. of another miner, to which the accepted transactions are forwarded.
+type Upstream struct {
+	Name   string
+	Client UpstreamClient
+}
+
+// Acknowledgement is the response of an upstream ARC instance to a forwarded transaction. The transaction is
+// acknowledged if the upstream accepted it, i.e. responded with a status other than REJECTED.
+type Acknowledgement struct {
+	Upstream     string    `json:"upstream"`
+	Acknowledged bool      `json:"acknowledged"`
+	Status       int       `json:"status"`
+	TxStatus     string    `json:"txStatus,omitempty"`
+	ExtraInfo    string    `json:"extraInfo,omitempty"`
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// Federation forwards the transactions accepted by this ARC instance to the upstream ARC instances and keeps which
+// upstreams acknowledged each transaction. Transactions are forwarded asynchronously by a pool of workers, so that the
+// submissions to this instance don't wait for the upstreams. The acknowledgements are kept in the cache store, so that
+// they are shared by all API instances using the same store.
+type Federation struct {
+	logger             *slog.Logger
+	upstreams          []Upstream
+	store              cache.Store
+	acknowledgementTTL time.Duration
+	timeout            time.Duration
+	workers            int
+	queueSize          int
+	now                func() time.Time
+	policy             Policy
+	quorum             int
+
+	queue     chan []*sdkTx.Transaction
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+// WithAcknowledgementTTL sets the duration for which the acknowledgements of a forwarded transaction are kept.
+func WithAcknowledgementTTL(d time.Duration) func(*Federation) {
+	return func(f *Federation) {
+		f.acknowledgementTTL = d
+	}
+}
+
+// WithTimeout sets the timeout of the requests to the upstream ARC instances.
+func WithTimeout(d time.Duration) func(*Federation) {
+	return func(f *Federation) {
+		f.timeout = d
+	}
+}
+
+// WithWorkers sets the number of batches of transactions which are forwarded concurrently.
+func WithWorkers(workers int) func(*Federation) {
+	return func(f *Federation) {
+		if workers > 0 {
+			f.workers = workers
+		}
+	}
+}
+
+// WithQueueSize sets the number of batches of transactions waiting to be forwarded. Batches exceeding the queue are
+// not forwarded.
+func WithQueueSize(size int) func(*Federation) {
+	return func(f *Federation) {
+		if size > 0 {
+			f.queueSize = size
+		}
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Federation) {
+	return func(f *Federation) {
+		f.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, upstreams []Upstream, store cache.Store, opts ...func(*Federation)) (*Federation, error) {
+	if len(upstreams) == 0 {
+		return nil, ErrNoUpstreams
+	}
+
+	f := &Federation{
+		logger:             logger.With(slog.String("module", "federation")),
+		upstreams:          upstreams,
+		store:              store,
+		acknowledgementTTL: acknowledgementTTLDefault,
+		timeout:            timeoutDefault,
+		workers:            workersDefault,
+		queueSize:          queueSizeDefault,
+		now:                time.Now,
+		waitGroup:          &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	switch f.policy {
+	case "":
+		f.policy = PolicyNone
+	case PolicyNone, PolicyFirstSuccess, PolicyAll:
+	case PolicyQuorum:
+		if f.quorum < 1 || f.quorum > len(upstreams) {
+			return nil, errors.Join(ErrInvalidQuorum, fmt.Errorf("quorum %d of %d upstreams", f.quorum, len(upstreams)))
+		}
+	default:
+		return nil, errors.Join(ErrInvalidPolicy, fmt.Errorf("policy %q", f.policy))
+	}
+
+	f.queue = make(chan []*sdkTx.Transaction, f.queueSize)
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	f.cancelAll = cancelAll
+	f.ctx = ctx
+
+	return f, nil
+}
+
+// Start starts the workers forwarding the queued transactions.
+func (f *Federation) Start() {
+	for range f.workers {
+		f.waitGroup.Add(1)
+		go func() {
+			defer f.waitGroup.Done()
+
+			for {
+				select {
+				case <-f.ctx.Done():
+					return
+				case txs := <-f.queue:
+					f.forward(txs)
+				}
+			}
+		}()
+	}
+}
+
+// Forward forwards the transactions to all upstream ARC instances. Without aggregation policy the transactions are
+// queued and forwarded in the background and no results are returned. With an aggregation policy Forward waits until
+// the policy is decided for all transactions or ctx is done and returns the aggregated results by transaction ID.
+func (f *Federation) Forward(ctx context.Context, txs []*sdkTx.Transaction) (map[string]*Result, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	if f.policy == PolicyNone {
+		select {
+		case f.queue <- txs:
+			return nil, nil
+		default:
+			return nil, errors.Join(ErrQueueFull, fmt.Errorf("%d transactions not forwarded", len(txs)))
+		}
+	}
+
+	return f.broadcast(ctx, txs), nil
+}
+
+// Acknowledgements returns the responses of the upstream ARC instances to the forwarded transaction ordered by the
+// names of the upstreams.
+func (f *Federation) Acknowledgements(txID string) ([]Acknowledgement, error) {
+	value, err := f.store.Get(acknowledgementKeyPrefix + txID)
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheNotFound) {
+			return nil, ErrNotForwarded
+		}
+		return nil, err
+	}
+
+	var acks []Acknowledgement
+	err = json.Unmarshal(value, &acks)
+	if err != nil {
+		return nil, err
+	}
+
+	return acks, nil
+}
+
+// forward submits the transactions to all upstreams and stores the acknowledgements of each transaction once all
+// upstreams responded or timed out.
+func (f *Federation) forward(txs []*sdkTx.Transaction) {
+	txIDs, responses := f.submitAll(txs)
+
+	collected := make([]map[string]Acknowledgement, 0, len(f.upstreams))
+	for range f.upstreams {
+		collected = append(collected, <-responses)
+	}
+
+	f.storeAcknowledgements(txIDs, collected)
+}
+
+// submitAll submits the transactions to all upstreams concurrently and returns the IDs of the transactions and the
+// channel on which the acknowledgements of each upstream are delivered. The requests are bound to the lifetime of the
+// federation rather than to a request, so that they complete even if the caller stops waiting for them.
+func (f *Federation) submitAll(txs []*sdkTx.Transaction) ([]string, <-chan map[string]Acknowledgement) {
+	body := make(api.POSTTransactionsJSONRequestBody, 0, len(txs))
+	txIDs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		// upstreams validate the fees and scripts of transactions in extended format without looking up the parents
+		rawTx, err := tx.EFHex()
+		if err != nil {
+			rawTx = tx.Hex()
+		}
+		body = append(body, api.TransactionRequest{RawTx: rawTx})
+		txIDs = append(txIDs, hexutils.TxID(tx.TxID()))
+	}
+
+	responses := make(chan map[string]Acknowledgement, len(f.upstreams))
+	for _, upstream := range f.upstreams {
+		go func() {
+			responses <- f.submit(f.ctx, upstream, body, txIDs)
+		}()
+	}
+
+	return txIDs, responses
+}
+
+// acknowledgementsOf returns the acknowledgements of the transaction by the upstreams which responded ordered by the
+// names of the upstreams.
+func acknowledgementsOf(txID string, responses []map[string]Acknowledgement) []Acknowledgement {
+	acks := make([]Acknowledgement, 0, len(responses))
+	for _, response := range responses {
+		acks = append(acks, response[txID])
+	}
+	slices.SortFunc(acks, func(a, b Acknowledgement) int {
+		return strings.Compare(a.Upstream, b.Upstream)
+	})
+
+	return acks
+}
+
+func (f *Federation) storeAcknowledgements(txIDs []string, responses []map[string]Acknowledgement) {
+	for _, txID := range txIDs {
+		value, err := json.Marshal(acknowledgementsOf(txID, responses))
+		if err != nil {
+			f.logger.Error("Failed to encode acknowledgements", slog.String("hash", txID), slog.String("err", err.Error()))
+			continue
+		}
+
+		err = f.store.Set(acknowledgementKeyPrefix+txID, value, f.acknowledgementTTL)
+		if err != nil {
+			f.logger.Error("Failed to store acknowledgements", slog.String("hash", txID), slog.String("err", err.Error()))
+		}
+	}
+}
+
+// submit submits the transactions to the upstream and returns its acknowledgements by transaction ID. If the request
+// fails, none of the transactions is acknowledged by the upstream.
+func (f *Federation) submit(ctx context.Context, upstream Upstream, body api.POSTTransactionsJSONRequestBody, txIDs []string) map[string]Acknowledgement {
+	ctx, cancel := context.WithTimeout(ctx, f.timeout)
+	defer cancel()
+
+	acks := make(map[string]Acknowledgement, len(txIDs))
+
+	resp, err := upstream.Client.POSTTransactionsWithResponse(ctx, &api.POSTTransactionsParams{}, body)
+	if err == nil && resp.JSON200 == nil {
+		err = errors.Join(ErrUnexpectedStatus, fmt.Errorf("status %d", resp.StatusCode()))
+	}
+	if err != nil {
+		err = errors.Join(ErrFailedToForward, fmt.Errorf("upstream %s", upstream.Name), err)
+		f.logger.Warn("Failed to forward transactions", slog.String("upstream", upstream.Name), slog.Int("txs", len(txIDs)), slog.String("err", err.Error()))
+
+		var status int
+		if resp != nil {
+			status = resp.StatusCode()
+		}
+		for _, txID := range txIDs {
+			acks[txID] = Acknowledgement{Upstream: upstream.Name, Status: status, ExtraInfo: err.Error(), Timestamp: f.now()}
+		}
+		return acks
+	}
+
+	if resp.JSON200.Transactions != nil {
+		for _, item := range *resp.JSON200.Transactions {
+			ack, txID := f.toAcknowledgement(upstream.Name, item)
+			if txID != "" {
+				acks[txID] = ack
+			}
+		}
+	}
+
+	// transactions missing in the response are not acknowledged
+	for _, txID := range txIDs {
+		if _, found := acks[txID]; !found {
+			acks[txID] = Acknowledgement{Upstream: upstream.Name, Status: http.StatusOK, ExtraInfo: "transaction missing in response", Timestamp: f.now()}
+		}
+	}
+
+	return acks
+}
+
+// toAcknowledgement returns the acknowledgement of the upstream for an item of its response and the ID of the
+// transaction of the item.
+func (f *Federation) toAcknowledgement(upstream string, item api.TransactionResponses_Transactions_Item) (Acknowledgement, string) {
+	ack := Acknowledgement{Upstream: upstream, Timestamp: f.now()}
+
+	// items of transactions failing validation are errors which always have a type
+	raw, err := item.MarshalJSON()
+	if err != nil {
+		return ack, ""
+	}
+
+	var errFields api.ErrorFields
+	err = json.Unmarshal(raw, &errFields)
+	if err == nil && errFields.Type != "" {
+		ack.Status = errFields.Status
+		ack.ExtraInfo = errFields.Detail
+		if errFields.ExtraInfo != nil && *errFields.ExtraInfo != "" {
+			ack.ExtraInfo = *errFields.ExtraInfo
+		}
+
+		var txID string
+		if errFields.Txid != nil {
+			txID = *errFields.Txid
+		}
+		return ack, txID
+	}
+
+	txResponse, err := item.AsTransactionResponse()
+	if err != nil {
+		return ack, ""
+	}
+
+	ack.Status = txResponse.Status
+	ack.TxStatus = string(txResponse.TxStatus)
+	ack.Acknowledged = txResponse.Status == http.StatusOK && txResponse.TxStatus != api.TransactionResponseTxStatusREJECTED
+	if txResponse.ExtraInfo != nil {
+		ack.ExtraInfo = *txResponse.ExtraInfo
+	}
+
+	return ack, txResponse.Txid
+}
+
+// Shutdown stops the workers, queued transactions which are not forwarded yet are dropped.
+func (f *Federation) Shutdown() {
+	f.cancelAll()
+	f.waitGroup.Wait()
+}
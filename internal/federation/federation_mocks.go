@@ -0,0 +1,3 @@
+package federation
+
+//go:generate moq -pkg mocks -out ./mocks/upstream_client_mock.go . UpstreamClient
@@ -0,0 +1,399 @@
+package federation_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/federation"
+	"github.com/bitcoin-sv/arc/internal/federation/mocks"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const efTx = "010000000000000000ef016b51c656fb06639ea6c1c3642a5ede9ecf9f749b95cb47d4e57eda7a3953b1c64c0000006a47304402201ade53acd924e90c0aeabbf9085d075acb23c4712e7f728a23979a466ab55e19022047a85963ce2eddc21573b4a6c0e7ccfec44153e74f9d03d31f955ff486449240412102f87ce69f6ba5444aed49c34470041189c1e1060acd99341959c0594002c61bf0ffffffffe8030000000000001976a914c2b6fd4319122b9b5156a2a0060d19864c24f49a88ac01e7030000000000001976a914c2b6fd4319122b9b5156a2a0060d19864c24f49a88ac00000000"
+
+func upstreamResponse(t *testing.T, items ...any) *api.POSTTransactionsResponse {
+	t.Helper()
+
+	transactions := make([]api.TransactionResponses_Transactions_Item, 0, len(items))
+	for _, item := range items {
+		b, err := json.Marshal(item)
+		require.NoError(t, err)
+
+		var i api.TransactionResponses_Transactions_Item
+		require.NoError(t, i.UnmarshalJSON(b))
+		transactions = append(transactions, i)
+	}
+
+	return &api.POSTTransactionsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &api.TransactionResponses{Transactions: &transactions},
+	}
+}
+
+func TestFederation_Forward(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tx, err := sdkTx.NewTransactionFromHex(efTx)
+	require.NoError(t, err)
+	txID := tx.TxID().String()
+
+	tt := []struct {
+		name     string
+		response *api.POSTTransactionsResponse
+		err      error
+
+		expectedAck federation.Acknowledgement
+	}{
+		{
+			name: "acknowledged",
+			response: upstreamResponse(t, api.TransactionResponse{
+				Status:   http.StatusOK,
+				Title:    "OK",
+				TxStatus: api.TransactionResponseTxStatusSEENONNETWORK,
+				Txid:     txID,
+			}),
+
+			expectedAck: federation.Acknowledgement{Upstream: "b", Acknowledged: true, Status: http.StatusOK, TxStatus: "SEEN_ON_NETWORK", Timestamp: now},
+		},
+		{
+			name: "rejected",
+			response: upstreamResponse(t, api.TransactionResponse{
+				Status:    http.StatusOK,
+				Title:     "OK",
+				TxStatus:  api.TransactionResponseTxStatusREJECTED,
+				Txid:      txID,
+				ExtraInfo: PtrTo("mempool conflict"),
+			}),
+
+			expectedAck: federation.Acknowledgement{Upstream: "b", Status: http.StatusOK, TxStatus: "REJECTED", ExtraInfo: "mempool conflict", Timestamp: now},
+		},
+		{
+			name: "validation error",
+			response: upstreamResponse(t, api.ErrorFields{
+				Status: 465,
+				Title:  "Fee too low",
+				Type:   "https://bitcoin-sv.github.io/arc/#/errors?id=_465",
+				Detail: "Fee is too low",
+				Txid:   PtrTo(txID),
+			}),
+
+			expectedAck: federation.Acknowledgement{Upstream: "b", Status: 465, ExtraInfo: "Fee is too low", Timestamp: now},
+		},
+		{
+			name: "unexpected status",
+			response: &api.POSTTransactionsResponse{
+				HTTPResponse: &http.Response{StatusCode: http.StatusUnauthorized},
+			},
+
+			expectedAck: federation.Acknowledgement{
+				Upstream:  "b",
+				Status:    http.StatusUnauthorized,
+				ExtraInfo: "failed to forward transactions to upstream ARC instance\nupstream b\nunexpected response status of upstream ARC instance\nstatus 401",
+				Timestamp: now,
+			},
+		},
+		{
+			name: "request failed",
+			err:  errors.New("connection refused"),
+
+			expectedAck: federation.Acknowledgement{
+				Upstream:  "b",
+				ExtraInfo: "failed to forward transactions to upstream ARC instance\nupstream b\nconnection refused",
+				Timestamp: now,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var forwardedTxs api.POSTTransactionsJSONRequestBody
+			upstreamA := &mocks.UpstreamClientMock{
+				POSTTransactionsWithResponseFunc: func(_ context.Context, _ *api.POSTTransactionsParams, body api.POSTTransactionsJSONRequestBody, _ ...api.RequestEditorFn) (*api.POSTTransactionsResponse, error) {
+					forwardedTxs = body
+					return upstreamResponse(t, api.TransactionResponse{Status: http.StatusOK, Title: "OK", TxStatus: api.TransactionResponseTxStatusSTORED, Txid: txID}), nil
+				},
+			}
+			upstreamB := &mocks.UpstreamClientMock{
+				POSTTransactionsWithResponseFunc: func(_ context.Context, _ *api.POSTTransactionsParams, _ api.POSTTransactionsJSONRequestBody, _ ...api.RequestEditorFn) (*api.POSTTransactionsResponse, error) {
+					return tc.response, tc.err
+				},
+			}
+
+			sut, err := federation.New(slog.Default(), []federation.Upstream{{Name: "b", Client: upstreamB}, {Name: "a", Client: upstreamA}}, cache.NewMemoryStore(),
+				federation.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			_, err = sut.Acknowledgements(txID)
+			require.ErrorIs(t, err, federation.ErrNotForwarded)
+
+			sut.Start()
+			defer sut.Shutdown()
+
+			// when
+			results, err := sut.Forward(context.Background(), []*sdkTx.Transaction{tx})
+
+			// then
+			require.NoError(t, err)
+			assert.Nil(t, results)
+
+			var acks []federation.Acknowledgement
+			require.Eventually(t, func() bool {
+				acks, err = sut.Acknowledgements(txID)
+				return err == nil
+			}, time.Second, 10*time.Millisecond)
+
+			expectedAcks := []federation.Acknowledgement{
+				{Upstream: "a", Acknowledged: true, Status: http.StatusOK, TxStatus: "STORED", Timestamp: now},
+				tc.expectedAck,
+			}
+			assert.Equal(t, expectedAcks, acks)
+			assert.Equal(t, api.POSTTransactionsJSONRequestBody{{RawTx: efTx}}, forwardedTxs)
+		})
+	}
+}
+
+func TestFederation_ForwardQueueFull(t *testing.T) {
+	// given
+	tx, err := sdkTx.NewTransactionFromHex(efTx)
+	require.NoError(t, err)
+
+	sut, err := federation.New(slog.Default(), []federation.Upstream{{Name: "a", Client: &mocks.UpstreamClientMock{}}}, cache.NewMemoryStore(),
+		federation.WithQueueSize(1),
+	)
+	require.NoError(t, err)
+
+	_, err = sut.Forward(context.Background(), []*sdkTx.Transaction{tx})
+	require.NoError(t, err)
+
+	// when
+	_, err = sut.Forward(context.Background(), []*sdkTx.Transaction{tx})
+
+	// then
+	require.ErrorIs(t, err, federation.ErrQueueFull)
+}
+
+func TestFederation_ForwardAggregation(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tx, err := sdkTx.NewTransactionFromHex(efTx)
+	require.NoError(t, err)
+	txID := tx.TxID().String()
+
+	// the upstreams respond in the order of their delays, an upstream without status does not respond in time
+	type upstream struct {
+		name     string
+		delay    time.Duration
+		txStatus api.TransactionResponseTxStatus
+	}
+
+	tt := []struct {
+		name      string
+		policy    federation.Policy
+		quorum    int
+		upstreams []upstream
+
+		expectedSatisfied    bool
+		expectedTxStatus     string
+		expectedAcknowledged int
+		expectedUpstreams    []string
+	}{
+		{
+			name:   "first success",
+			policy: federation.PolicyFirstSuccess,
+			upstreams: []upstream{
+				{name: "a", delay: 0, txStatus: api.TransactionResponseTxStatusREJECTED},
+				{name: "b", delay: 100 * time.Millisecond, txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+				{name: "c", delay: time.Second, txStatus: api.TransactionResponseTxStatusMINED},
+			},
+
+			expectedSatisfied:    true,
+			expectedTxStatus:     "SEEN_ON_NETWORK",
+			expectedAcknowledged: 1,
+			expectedUpstreams:    []string{"a", "b"},
+		},
+		{
+			name:   "first success - all rejected",
+			policy: federation.PolicyFirstSuccess,
+			upstreams: []upstream{
+				{name: "a", txStatus: api.TransactionResponseTxStatusREJECTED},
+				{name: "b", delay: 50 * time.Millisecond, txStatus: api.TransactionResponseTxStatusREJECTED},
+			},
+
+			expectedUpstreams: []string{"a", "b"},
+		},
+		{
+			name:   "quorum",
+			policy: federation.PolicyQuorum,
+			quorum: 2,
+			upstreams: []upstream{
+				{name: "a", txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+				{name: "b", delay: 50 * time.Millisecond, txStatus: api.TransactionResponseTxStatusSTORED},
+				{name: "c", delay: 100 * time.Millisecond, txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+				{name: "d", delay: time.Second, txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+			},
+
+			expectedSatisfied:    true,
+			expectedTxStatus:     "STORED",
+			expectedAcknowledged: 2,
+			expectedUpstreams:    []string{"a", "b"},
+		},
+		{
+			name:   "quorum - not reachable",
+			policy: federation.PolicyQuorum,
+			quorum: 2,
+			upstreams: []upstream{
+				{name: "a", txStatus: api.TransactionResponseTxStatusREJECTED},
+				{name: "b", delay: 50 * time.Millisecond, txStatus: api.TransactionResponseTxStatusREJECTED},
+				{name: "c", delay: time.Second, txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+			},
+
+			expectedUpstreams: []string{"a", "b"},
+		},
+		{
+			name:   "all",
+			policy: federation.PolicyAll,
+			upstreams: []upstream{
+				{name: "a", txStatus: api.TransactionResponseTxStatusMINED},
+				{name: "b", delay: 50 * time.Millisecond, txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+			},
+
+			expectedSatisfied:    true,
+			expectedTxStatus:     "SEEN_ON_NETWORK",
+			expectedAcknowledged: 2,
+			expectedUpstreams:    []string{"a", "b"},
+		},
+		{
+			name:   "all - deadline exceeded",
+			policy: federation.PolicyAll,
+			upstreams: []upstream{
+				{name: "a", txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+				{name: "b", delay: time.Second, txStatus: api.TransactionResponseTxStatusSEENONNETWORK},
+			},
+
+			expectedAcknowledged: 1,
+			expectedUpstreams:    []string{"a"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			upstreams := make([]federation.Upstream, 0, len(tc.upstreams))
+			for _, u := range tc.upstreams {
+				upstreams = append(upstreams, federation.Upstream{Name: u.name, Client: &mocks.UpstreamClientMock{
+					POSTTransactionsWithResponseFunc: func(ctx context.Context, _ *api.POSTTransactionsParams, _ api.POSTTransactionsJSONRequestBody, _ ...api.RequestEditorFn) (*api.POSTTransactionsResponse, error) {
+						select {
+						case <-time.After(u.delay):
+						case <-ctx.Done():
+							return nil, ctx.Err()
+						}
+						return upstreamResponse(t, api.TransactionResponse{Status: http.StatusOK, Title: "OK", TxStatus: u.txStatus, Txid: txID}), nil
+					},
+				}})
+			}
+
+			sut, err := federation.New(slog.Default(), upstreams, cache.NewMemoryStore(),
+				federation.WithAggregation(tc.policy, tc.quorum),
+				federation.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+			defer cancel()
+
+			// when
+			results, err := sut.Forward(ctx, []*sdkTx.Transaction{tx})
+
+			// then
+			require.NoError(t, err)
+			require.Contains(t, results, txID)
+
+			result := results[txID]
+			assert.Equal(t, tc.policy, result.Policy)
+			assert.Equal(t, tc.expectedSatisfied, result.Satisfied)
+			assert.Equal(t, tc.expectedTxStatus, result.TxStatus)
+			assert.Equal(t, tc.expectedAcknowledged, result.Acknowledged)
+
+			names := make([]string, 0, len(result.Upstreams))
+			for _, ack := range result.Upstreams {
+				names = append(names, ack.Upstream)
+			}
+			assert.Equal(t, tc.expectedUpstreams, names)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	upstreams := []federation.Upstream{{Name: "a", Client: &mocks.UpstreamClientMock{}}, {Name: "b", Client: &mocks.UpstreamClientMock{}}}
+
+	tt := []struct {
+		name      string
+		upstreams []federation.Upstream
+		policy    federation.Policy
+		quorum    int
+
+		expectedErr error
+	}{
+		{
+			name:      "success",
+			upstreams: upstreams,
+			policy:    federation.PolicyQuorum,
+			quorum:    2,
+		},
+		{
+			name:      "no aggregation policy",
+			upstreams: upstreams,
+		},
+		{
+			name: "no upstreams",
+
+			expectedErr: federation.ErrNoUpstreams,
+		},
+		{
+			name:      "invalid policy",
+			upstreams: upstreams,
+			policy:    "majority",
+
+			expectedErr: federation.ErrInvalidPolicy,
+		},
+		{
+			name:      "quorum exceeds upstreams",
+			upstreams: upstreams,
+			policy:    federation.PolicyQuorum,
+			quorum:    3,
+
+			expectedErr: federation.ErrInvalidQuorum,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := federation.New(slog.Default(), tc.upstreams, cache.NewMemoryStore(), federation.WithAggregation(tc.policy, tc.quorum))
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func PtrTo[T any](v T) *T {
+	return &v
+}
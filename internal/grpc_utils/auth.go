@@ -0,0 +1,168 @@
+package grpc_utils
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitcoin-sv/arc/config"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+
+	// health checks are exempt from token authentication so that k8s probes keep working without credentials
+	healthServicePrefix = "/grpc.health.v1.Health/"
+)
+
+var (
+	ErrGRPCFailedToLoadKeyPair = errors.New("failed to load gRPC TLS key pair")
+	ErrGRPCFailedToLoadCA      = errors.New("failed to load gRPC TLS CA certificate")
+)
+
+func tlsEnabled(auth *config.GrpcAuthConfig) bool {
+	return auth != nil && auth.TLS != nil && auth.TLS.Enabled
+}
+
+// serverTransportCredentials returns the transport credentials of the gRPC server. If a CA file is configured,
+// clients have to present a certificate signed by this CA (mTLS).
+func serverTransportCredentials(auth *config.GrpcAuthConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(auth.TLS.CertFile, auth.TLS.KeyFile)
+	if err != nil {
+		return nil, errors.Join(ErrGRPCFailedToLoadKeyPair, err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if auth.TLS.CaFile != "" {
+		pool, err := loadCertPool(auth.TLS.CaFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+// clientTransportCredentials returns the transport credentials of a gRPC client. Only the client settings are used, the
+// key pair of the server is never presented by clients. If a client key pair is configured, it is presented to the
+// server as client certificate.
+func clientTransportCredentials(auth *config.GrpcAuthConfig) (credentials.TransportCredentials, error) {
+	if !tlsEnabled(auth) {
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	client := auth.TLS.Client
+	if client == nil {
+		return credentials.NewTLS(tlsConfig), nil
+	}
+
+	if client.CertFile != "" && client.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(client.CertFile, client.KeyFile)
+		if err != nil {
+			return nil, errors.Join(ErrGRPCFailedToLoadKeyPair, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if client.CaFile != "" {
+		pool, err := loadCertPool(client.CaFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, errors.Join(ErrGRPCFailedToLoadCA, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.Join(ErrGRPCFailedToLoadCA, fmt.Errorf("no valid certificate found in %s", caFile))
+	}
+
+	return pool, nil
+}
+
+// tokenCredentials attaches a static bearer token to every request of a gRPC client.
+type tokenCredentials struct {
+	token      string
+	requireTLS bool
+}
+
+func (t tokenCredentials) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{authorizationKey: bearerPrefix + t.token}, nil
+}
+
+func (t tokenCredentials) RequireTransportSecurity() bool {
+	return t.requireTLS
+}
+
+func authorizeToken(ctx context.Context, fullMethod string, token string) error {
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	for _, value := range md.Get(authorizationKey) {
+		received, found := strings.CutPrefix(value, bearerPrefix)
+		if found && subtle.ConstantTimeCompare([]byte(received), []byte(token)) == 1 {
+			return nil
+		}
+	}
+
+	return status.Error(codes.Unauthenticated, "invalid token")
+}
+
+func tokenUnaryServerInterceptor(token string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := authorizeToken(ctx, info.FullMethod, token); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func tokenStreamServerInterceptor(token string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorizeToken(ss.Context(), info.FullMethod, token); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
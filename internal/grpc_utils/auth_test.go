@@ -0,0 +1,137 @@
+package grpc_utils_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+)
+
+func TestTokenAuth(t *testing.T) {
+	tt := []struct {
+		name        string
+		clientToken string
+
+		expectedCode codes.Code
+	}{
+		{
+			name:        "valid token",
+			clientToken: "secret",
+
+			expectedCode: codes.OK,
+		},
+		{
+			name:        "invalid token",
+			clientToken: "wrong",
+
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name: "missing token",
+
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	// given
+	const address = "localhost:8099"
+	logger := slog.Default()
+
+	server, err := grpc_utils.NewGrpcServer(logger, grpc_utils.ServerConfig{
+		MaxMsgSize: 1000,
+		Name:       "auth_test",
+		Auth:       &config.GrpcAuthConfig{Token: "secret"},
+	})
+	require.NoError(t, err)
+	defer server.GracefulStop()
+
+	grpc_health_v1.RegisterHealthServer(server.Srv, health.NewServer())
+	reflection.Register(server.Srv)
+
+	err = server.ListenAndServe(address)
+	require.NoError(t, err)
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			conn, err := grpc_utils.DialGRPC(address, "", 1000, nil, &config.GrpcAuthConfig{Token: tc.clientToken}, nil)
+			require.NoError(t, err)
+			defer conn.Close()
+
+			// when
+			_, healthErr := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+			stream, err := grpc_reflection_v1.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+			require.NoError(t, err)
+			err = stream.Send(&grpc_reflection_v1.ServerReflectionRequest{
+				MessageRequest: &grpc_reflection_v1.ServerReflectionRequest_ListServices{},
+			})
+			require.NoError(t, err)
+			_, reflectionErr := stream.Recv()
+
+			// then
+			require.NoError(t, healthErr, "health checks are not authenticated")
+			require.Equal(t, tc.expectedCode, status.Code(reflectionErr))
+		})
+	}
+}
+
+func TestClientTLS(t *testing.T) {
+	tt := []struct {
+		name   string
+		client *config.GrpcTLSClientConfig
+
+		expectedErr error
+	}{
+		{
+			name: "server key pair is not used by the client",
+		},
+		{
+			name:   "client key pair",
+			client: &config.GrpcTLSClientConfig{CertFile: "missing-client.crt", KeyFile: "missing-client.key"},
+
+			expectedErr: grpc_utils.ErrGRPCFailedToLoadKeyPair,
+		},
+		{
+			name:   "client CA",
+			client: &config.GrpcTLSClientConfig{CaFile: "missing-ca.crt"},
+
+			expectedErr: grpc_utils.ErrGRPCFailedToLoadCA,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			auth := &config.GrpcAuthConfig{
+				TLS: &config.GrpcTLSConfig{
+					Enabled:  true,
+					CaFile:   "missing-server-ca.crt",
+					CertFile: "missing-server.crt",
+					KeyFile:  "missing-server.key",
+					Client:   tc.client,
+				},
+			}
+
+			// when
+			conn, err := grpc_utils.DialGRPC("localhost:8098", "", 1000, nil, auth, nil)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, conn.Close())
+		})
+	}
+}
@@ -6,8 +6,8 @@ import (
 	"github.com/bitcoin-sv/arc/config"
 )
 
-func DialGRPC(address string, prometheusEndpoint string, grpcMessageSize int, tracingConfig *config.TracingConfig) (*grpc.ClientConn, error) {
-	dialOpts, err := GetGRPCClientOpts(prometheusEndpoint, grpcMessageSize, tracingConfig)
+func DialGRPC(address string, prometheusEndpoint string, grpcMessageSize int, tracingConfig *config.TracingConfig, auth *config.GrpcAuthConfig, clientConfig *config.GrpcClientConfig) (*grpc.ClientConn, error) {
+	dialOpts, err := GetGRPCClientOpts(prometheusEndpoint, grpcMessageSize, tracingConfig, auth, clientConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,60 @@
+package grpc_utils
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bitcoin-sv/arc/config"
+)
+
+const inProcessBufferSize = 1024 * 1024
+
+// InProcessNetwork connects the gRPC clients and servers of the services running in one process over in-memory
+// connections instead of TCP, e.g. in the all-in-one mode. Clients are connected to the server listening on the
+// address they dial, no port is opened.
+type InProcessNetwork struct {
+	mu        sync.Mutex
+	listeners map[string]*bufconn.Listener
+}
+
+func NewInProcessNetwork() *InProcessNetwork {
+	return &InProcessNetwork{listeners: make(map[string]*bufconn.Listener)}
+}
+
+// Listen returns the in-memory listener of the address.
+func (n *InProcessNetwork) Listen(address string) net.Listener {
+	return n.listener(address)
+}
+
+func (n *InProcessNetwork) listener(address string) *bufconn.Listener {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// clients may dial before the server listens, the connection is established once it is served
+	l, found := n.listeners[address]
+	if !found {
+		l = bufconn.Listen(inProcessBufferSize)
+		n.listeners[address] = l
+	}
+
+	return l
+}
+
+// DialGRPC is like DialGRPC, but connects to the server listening on the address of the in-process network.
+func (n *InProcessNetwork) DialGRPC(address string, prometheusEndpoint string, grpcMessageSize int, tracingConfig *config.TracingConfig, auth *config.GrpcAuthConfig, clientConfig *config.GrpcClientConfig) (*grpc.ClientConn, error) {
+	dialOpts, err := GetGRPCClientOpts(prometheusEndpoint, grpcMessageSize, tracingConfig, auth, clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	dialOpts = append(dialOpts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return n.listener(addr).DialContext(ctx)
+	}))
+
+	// the passthrough resolver hands the address to the dialer as it is instead of resolving it
+	return grpc.NewClient("passthrough:///"+address, dialOpts...)
+}
@@ -0,0 +1,41 @@
+package grpc_utils_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+)
+
+func TestInProcessNetwork(t *testing.T) {
+	// given
+	const address = "localhost:8098"
+	network := grpc_utils.NewInProcessNetwork()
+
+	// clients may connect before the server is served
+	conn, err := network.DialGRPC(address, "", 1000, nil, nil, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	server, err := grpc_utils.NewGrpcServer(slog.Default(), grpc_utils.ServerConfig{
+		MaxMsgSize: 1000,
+		Name:       "in_process_test",
+	})
+	require.NoError(t, err)
+	defer server.GracefulStop()
+
+	grpc_health_v1.RegisterHealthServer(server.Srv, health.NewServer())
+	server.Serve(network.Listen(address), address)
+
+	// when
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, resp.GetStatus())
+}
@@ -12,15 +12,14 @@ import (
 	"github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	prometheusclient "github.com/prometheus/client_golang/prometheus"
-	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 
 	"github.com/bitcoin-sv/arc/config"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils/common_api"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
 
 	arc_logger "github.com/bitcoin-sv/arc/internal/logger"
 )
@@ -35,6 +34,16 @@ func GetGRPCServerOpts(logger *slog.Logger, cfg ServerConfig) (*prometheus.Serve
 	// Setup logging.
 	rpcLogger := logger.With(slog.String("service", "gRPC/server"))
 
+	opts := make([]grpc.ServerOption, 0)
+
+	if tlsEnabled(cfg.Auth) {
+		creds, err := serverTransportCredentials(cfg.Auth)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
 	// Setup metrics.
 	srvMetrics := prometheus.NewServerMetrics(
 		prometheus.WithServerHandlingTimeHistogram(
@@ -60,11 +69,6 @@ func GetGRPCServerOpts(logger *slog.Logger, cfg ServerConfig) (*prometheus.Serve
 	if err != nil {
 		return nil, nil, nil, errors.Join(ErrGRPCFailedToRegisterPanics, err)
 	}
-	opts := make([]grpc.ServerOption, 0)
-
-	if cfg.TracingConfig != nil && cfg.TracingConfig.IsEnabled() {
-		opts = append(opts, grpc.StatsHandler(otelgrpc.NewServerHandler()))
-	}
 
 	grpcPanicRecoveryHandler := func(p any) (err error) {
 		panicsTotal.Inc()
@@ -74,6 +78,11 @@ func GetGRPCServerOpts(logger *slog.Logger, cfg ServerConfig) (*prometheus.Serve
 
 	var chainUnaryInterceptors []grpc.UnaryServerInterceptor
 
+	if cfg.TracingConfig != nil && cfg.TracingConfig.IsEnabled() {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, tracing.UnaryServerInterceptor(cfg.TracingConfig.KeyValueAttributes...))
+		opts = append(opts, grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(cfg.TracingConfig.KeyValueAttributes...)))
+	}
+
 	if cfg.PrometheusEndpoint != "" {
 		exemplarFromContext := func(ctx context.Context) prometheusclient.Labels {
 			if span := trace.SpanContextFromContext(ctx); span.IsSampled() {
@@ -87,6 +96,16 @@ func GetGRPCServerOpts(logger *slog.Logger, cfg ServerConfig) (*prometheus.Serve
 	chainUnaryInterceptors = append(chainUnaryInterceptors, // Order matters e.g. tracing interceptor have to create span first for the later exemplars to work.
 		recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(grpcPanicRecoveryHandler)))
 
+	if cfg.Auth != nil && cfg.Auth.Token != "" {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, tokenUnaryServerInterceptor(cfg.Auth.Token))
+		opts = append(opts, grpc.ChainStreamInterceptor(tokenStreamServerInterceptor(cfg.Auth.Token)))
+	}
+
+	chainUnaryInterceptors = append(chainUnaryInterceptors, cfg.UnaryInterceptors...)
+	if len(cfg.StreamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(cfg.StreamInterceptors...))
+	}
+
 	// decorate context with event ID
 	chainUnaryInterceptors = append(chainUnaryInterceptors, func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
 		if event, ok := req.(common_api.UnaryEvent); ok && event != nil {
@@ -111,7 +130,17 @@ func GetGRPCServerOpts(logger *slog.Logger, cfg ServerConfig) (*prometheus.Serve
 	return srvMetrics, opts, cleanup, err
 }
 
-func GetGRPCClientOpts(prometheusEndpoint string, grpcMessageSize int, tracingConfig *config.TracingConfig) ([]grpc.DialOption, error) {
+func GetGRPCClientOpts(prometheusEndpoint string, grpcMessageSize int, tracingConfig *config.TracingConfig, auth *config.GrpcAuthConfig, clientConfig *config.GrpcClientConfig) ([]grpc.DialOption, error) {
+	transportCredentials, err := clientTransportCredentials(auth)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceConfig, err := defaultServiceConfig(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	clientMetrics := prometheus.NewClientMetrics(
 		prometheus.WithClientHandlingTimeHistogram(
 			prometheus.WithHistogramBuckets([]float64{0.001, 0.01, 0.1, 0.3, 0.6, 1, 3, 6, 9, 20, 30, 60, 90, 120}),
@@ -119,12 +148,13 @@ func GetGRPCClientOpts(prometheusEndpoint string, grpcMessageSize int, tracingCo
 	)
 	opts := make([]grpc.DialOption, 0)
 
+	var chainUnaryInterceptors []grpc.UnaryClientInterceptor
+
 	if tracingConfig != nil && tracingConfig.IsEnabled() {
-		opts = append(opts, grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+		chainUnaryInterceptors = append(chainUnaryInterceptors, tracing.UnaryClientInterceptor(tracingConfig.KeyValueAttributes...))
+		opts = append(opts, grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor(tracingConfig.KeyValueAttributes...)))
 	}
 
-	var chainUnaryInterceptors []grpc.UnaryClientInterceptor
-
 	if prometheusEndpoint != "" {
 		exemplarFromContext := func(ctx context.Context) prometheusclient.Labels {
 			if span := trace.SpanContextFromContext(ctx); span.IsSampled() {
@@ -135,6 +165,14 @@ func GetGRPCClientOpts(prometheusEndpoint string, grpcMessageSize int, tracingCo
 		chainUnaryInterceptors = append(chainUnaryInterceptors, clientMetrics.UnaryClientInterceptor(prometheus.WithExemplarFromContext(exemplarFromContext)))
 	}
 
+	if clientConfig != nil && clientConfig.Timeout > 0 {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, timeoutUnaryClientInterceptor(clientConfig.Timeout))
+	}
+
+	if clientConfig != nil && clientConfig.CircuitBreaker != nil && clientConfig.CircuitBreaker.Enabled {
+		chainUnaryInterceptors = append(chainUnaryInterceptors, newCircuitBreaker(clientConfig.CircuitBreaker).unaryClientInterceptor())
+	}
+
 	// add eventID from context to grpc request if possible
 	chainUnaryInterceptors = append(chainUnaryInterceptors, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		if eventID, ok := ctx.Value(arc_logger.EventIDField).(string); ok {
@@ -144,9 +182,12 @@ func GetGRPCClientOpts(prometheusEndpoint string, grpcMessageSize int, tracingCo
 		return invoker(ctx, method, req, reply, cc, opts...)
 	})
 
-	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	opts = append(opts, grpc.WithTransportCredentials(transportCredentials))
+	if auth != nil && auth.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(tokenCredentials{token: auth.Token, requireTLS: tlsEnabled(auth)}))
+	}
 	opts = append(opts, grpc.WithChainUnaryInterceptor(chainUnaryInterceptors...))
-	opts = append(opts, grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"round_robin":{}}]}`)) // This sets the initial balancing policy.
+	opts = append(opts, grpc.WithDefaultServiceConfig(serviceConfig)) // This sets the initial balancing policy and the retry policy.
 	opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(grpcMessageSize)))
 
 	return opts, nil
@@ -0,0 +1,167 @@
+package grpc_utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitcoin-sv/arc/config"
+)
+
+var ErrGRPCCircuitOpen = errors.New("circuit breaker is open")
+
+// idempotentMethods are the methods of the internal gRPC APIs which can safely be retried.
+var idempotentMethods = map[string][]string{
+	"metamorph_api.MetaMorphAPI":   {"Health", "GetTransaction", "GetTransactions", "GetTransactionStatus", "GetTransactionStatuses"},
+	"blocktx_api.BlockTxAPI":       {"Health", "CurrentBlockHeight", "LatestBlocks", "AnyTransactionsMined", "RegisterTransaction", "RegisterTransactions", "RegisteredTransactions", "GetBlock", "ListBlocks", "GetTransactionBlocks"},
+	"callbacker_api.CallbackerAPI": {"Health"},
+	"grpc.health.v1.Health":        {"Check"},
+}
+
+type methodName struct {
+	Service string `json:"service"`
+	Method  string `json:"method"`
+}
+
+type retryPolicy struct {
+	MaxAttempts          int      `json:"maxAttempts"`
+	InitialBackoff       string   `json:"initialBackoff"`
+	MaxBackoff           string   `json:"maxBackoff"`
+	BackoffMultiplier    float64  `json:"backoffMultiplier"`
+	RetryableStatusCodes []string `json:"retryableStatusCodes"`
+}
+
+type methodConfig struct {
+	Name        []methodName `json:"name"`
+	RetryPolicy *retryPolicy `json:"retryPolicy"`
+}
+
+type serviceConfig struct {
+	LoadBalancingConfig []map[string]struct{} `json:"loadBalancingConfig"`
+	MethodConfig        []methodConfig        `json:"methodConfig,omitempty"`
+}
+
+// defaultServiceConfig returns the service config of the gRPC clients. It sets the initial balancing policy and the
+// retry policy of idempotent methods.
+func defaultServiceConfig(cfg *config.GrpcClientConfig) (string, error) {
+	sc := serviceConfig{
+		LoadBalancingConfig: []map[string]struct{}{{"round_robin": {}}},
+	}
+
+	if cfg != nil && cfg.Retry != nil && cfg.Retry.MaxAttempts > 1 {
+		names := make([]methodName, 0)
+		for service, methods := range idempotentMethods {
+			for _, method := range methods {
+				names = append(names, methodName{Service: service, Method: method})
+			}
+		}
+
+		sc.MethodConfig = []methodConfig{{
+			Name: names,
+			RetryPolicy: &retryPolicy{
+				MaxAttempts:          cfg.Retry.MaxAttempts,
+				InitialBackoff:       protoDuration(cfg.Retry.InitialBackoff),
+				MaxBackoff:           protoDuration(cfg.Retry.MaxBackoff),
+				BackoffMultiplier:    2,
+				RetryableStatusCodes: []string{"UNAVAILABLE"},
+			},
+		}}
+	}
+
+	b, err := json.Marshal(sc)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func protoDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// timeoutUnaryClientInterceptor applies a deadline to requests which do not have a deadline already.
+func timeoutUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// circuitBreaker opens after a number of consecutive requests failing with UNAVAILABLE. While open, requests fail
+// immediately. After the open duration a single request is let through and closes the circuit again if it succeeds.
+type circuitBreaker struct {
+	mu               sync.Mutex
+	failureThreshold int
+	openDuration     time.Duration
+	failures         int
+	openedAt         time.Time
+	probing          bool
+	now              func() time.Time
+}
+
+func newCircuitBreaker(cfg *config.GrpcCircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		failureThreshold: cfg.FailureThreshold,
+		openDuration:     cfg.OpenDuration,
+		now:              time.Now,
+	}
+}
+
+func (c *circuitBreaker) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failures < c.failureThreshold {
+		return true
+	}
+
+	if c.probing || c.now().Sub(c.openedAt) < c.openDuration {
+		return false
+	}
+
+	c.probing = true
+	return true
+}
+
+func (c *circuitBreaker) record(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probing = false
+
+	if status.Code(err) != codes.Unavailable {
+		c.failures = 0
+		return
+	}
+
+	c.failures++
+	if c.failures >= c.failureThreshold {
+		c.openedAt = c.now()
+	}
+}
+
+func (c *circuitBreaker) unaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !c.allow() {
+			return status.Error(codes.Unavailable, ErrGRPCCircuitOpen.Error())
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		c.record(err)
+
+		return err
+	}
+}
@@ -0,0 +1,72 @@
+package grpc_utils_test
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitcoin-sv/arc/config"
+	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	// given
+	const address = "localhost:8098"
+	clientConfig := &config.GrpcClientConfig{
+		Timeout: time.Second,
+		Retry: &config.GrpcRetryConfig{
+			MaxAttempts:    3,
+			InitialBackoff: 10 * time.Millisecond,
+			MaxBackoff:     50 * time.Millisecond,
+		},
+		CircuitBreaker: &config.GrpcCircuitBreakerConfig{
+			Enabled:          true,
+			FailureThreshold: 2,
+			OpenDuration:     200 * time.Millisecond,
+		},
+	}
+
+	conn, err := grpc_utils.DialGRPC(address, "", 1000, nil, nil, clientConfig)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := grpc_health_v1.NewHealthClient(conn)
+
+	// when
+	for range 2 {
+		_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+
+		// then
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.NotContains(t, err.Error(), grpc_utils.ErrGRPCCircuitOpen.Error())
+	}
+
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+	require.ErrorContains(t, err, grpc_utils.ErrGRPCCircuitOpen.Error())
+
+	server, err := grpc_utils.NewGrpcServer(slog.Default(), grpc_utils.ServerConfig{
+		MaxMsgSize: 1000,
+		Name:       "resilience_test",
+	})
+	require.NoError(t, err)
+	defer server.GracefulStop()
+
+	grpc_health_v1.RegisterHealthServer(server.Srv, health.NewServer())
+
+	err = server.ListenAndServe(address)
+	require.NoError(t, err)
+
+	time.Sleep(250 * time.Millisecond)
+
+	_, err = client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{}, grpc.WaitForReady(true))
+	require.NoError(t, err)
+}
@@ -25,6 +25,11 @@ type ServerConfig struct {
 	MaxMsgSize         int
 	TracingConfig      *config.TracingConfig
 	Name               string
+	Auth               *config.GrpcAuthConfig
+	// UnaryInterceptors and StreamInterceptors are chained after the interceptors of the token authentication, e.g.
+	// for the authorization of a service
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
 }
 
 func NewGrpcServer(logger *slog.Logger, cfg ServerConfig) (GrpcServer, error) {
@@ -50,15 +55,19 @@ func (s *GrpcServer) ListenAndServe(address string) error {
 		return errors.Join(ErrServerFailedToListen, fmt.Errorf("address %s: %v", address, err))
 	}
 
+	s.Serve(listener, address)
+	return nil
+}
+
+// Serve serves the gRPC server on the listener, e.g. of an InProcessNetwork, in the background.
+func (s *GrpcServer) Serve(listener net.Listener, address string) {
 	go func() {
 		s.logger.Info("GRPC server listening", slog.String("address", address))
-		err = s.Srv.Serve(listener)
+		err := s.Srv.Serve(listener)
 		if err != nil {
 			s.logger.Error("GRPC server failed to serve", slog.String("err", err.Error()))
 		}
 	}()
-
-	return nil
 }
 
 func (s *GrpcServer) GracefulStop() {
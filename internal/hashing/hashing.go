@@ -0,0 +1,182 @@
+// Package hashing provides the double SHA-256 hashing of transactions and merkle trees. The SHA-256 implementation
+// is selected at build time: the standard library by default or github.com/minio/sha256-simd with the build tag
+// `sha256simd`.
+package hashing
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+)
+
+// minParallelNodes is the minimum number of nodes of a merkle tree level for which the level is hashed in parallel
+const minParallelNodes = 1024
+
+// DoubleSHA256 returns the double SHA-256 hash of the data.
+func DoubleSHA256(data []byte) chainhash.Hash {
+	first := Sum256(data)
+	return Sum256(first[:])
+}
+
+// MerkleTreeParent returns the double SHA-256 hash of the concatenation of the left and the right node.
+func MerkleTreeParent(left *chainhash.Hash, right *chainhash.Hash) chainhash.Hash {
+	var concat [2 * chainhash.HashSize]byte
+	copy(concat[:chainhash.HashSize], left[:])
+	copy(concat[chainhash.HashSize:], right[:])
+
+	return DoubleSHA256(concat[:])
+}
+
+// Pool hashes merkle trees with a pool of workers.
+type Pool struct {
+	workers int
+}
+
+type PoolOption func(p *Pool)
+
+// WithWorkers sets the number of workers. If zero, the number of workers is GOMAXPROCS.
+func WithWorkers(n int) PoolOption {
+	return func(p *Pool) {
+		p.workers = n
+	}
+}
+
+func NewPool(opts ...PoolOption) *Pool {
+	p := &Pool{}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.workers <= 0 {
+		p.workers = runtime.GOMAXPROCS(0)
+	}
+
+	return p
+}
+
+// BuildMerkleTree builds the merkle tree of the transaction hashes in the same layout as
+// bc.BuildMerkleTreeStoreChainHash: the leaves padded with nil to the next power of two, followed by the levels up to
+// the merkle root as last element. The nodes of each level are hashed by the workers in parallel.
+func (p *Pool) BuildMerkleTree(txHashes []*chainhash.Hash) []*chainhash.Hash {
+	if len(txHashes) == 0 {
+		return nil
+	}
+
+	leaves := nextPowerOfTwo(len(txHashes))
+	merkles := make([]*chainhash.Hash, leaves*2-1)
+	copy(merkles, txHashes)
+
+	// all inner nodes are allocated at once
+	nodes := make([]chainhash.Hash, leaves-1)
+
+	levelStart := 0
+	for width := leaves; width > 1; width /= 2 {
+		parentStart := levelStart + width
+		parents := width / 2
+
+		p.run(parents, func(from int, to int) {
+			for i := from; i < to; i++ {
+				left := merkles[levelStart+2*i]
+				right := merkles[levelStart+2*i+1]
+
+				switch {
+				// when there is no left child node, the parent is nil too
+				case left == nil:
+					merkles[parentStart+i] = nil
+
+				// when there is no right child, the parent is the hash of the left child concatenated with itself
+				case right == nil:
+					nodes[parentStart-leaves+i] = MerkleTreeParent(left, left)
+					merkles[parentStart+i] = &nodes[parentStart-leaves+i]
+
+				default:
+					nodes[parentStart-leaves+i] = MerkleTreeParent(left, right)
+					merkles[parentStart+i] = &nodes[parentStart-leaves+i]
+				}
+			}
+		})
+
+		levelStart = parentStart
+	}
+
+	return merkles
+}
+
+// MerkleRootBuilder calculates the merkle root of transaction hashes which are added one by one. Only one node per
+// level of the merkle tree is held, so that the merkle root of blocks whose transaction hashes don't fit into memory
+// can be calculated.
+type MerkleRootBuilder struct {
+	count uint64
+	inner [64]chainhash.Hash
+}
+
+// Add adds the next transaction hash and hashes the completed subtrees.
+func (b *MerkleRootBuilder) Add(txHash *chainhash.Hash) {
+	h := *txHash
+	level := 0
+	for b.count&(1<<level) != 0 {
+		h = MerkleTreeParent(&b.inner[level], &h)
+		level++
+	}
+
+	b.inner[level] = h
+	b.count++
+}
+
+// Root returns the merkle root of the added transaction hashes, the same as the last element of BuildMerkleTree. A node
+// without right sibling is hashed with itself.
+func (b *MerkleRootBuilder) Root() chainhash.Hash {
+	if b.count == 0 {
+		return chainhash.Hash{}
+	}
+
+	count := b.count
+	level := 0
+	for count&(1<<level) == 0 {
+		level++
+	}
+
+	h := b.inner[level]
+	for count != 1<<level {
+		h = MerkleTreeParent(&h, &h)
+		count += 1 << level
+		level++
+
+		for count&(1<<level) == 0 {
+			h = MerkleTreeParent(&b.inner[level], &h)
+			level++
+		}
+	}
+
+	return h
+}
+
+// run splits the range [0, n) into chunks and processes them with the workers.
+func (p *Pool) run(n int, process func(from int, to int)) {
+	if n < minParallelNodes || p.workers == 1 {
+		process(0, n)
+		return
+	}
+
+	chunk := (n + p.workers - 1) / p.workers
+
+	var wg sync.WaitGroup
+	for from := 0; from < n; from += chunk {
+		wg.Add(1)
+		go func(from int, to int) {
+			defer wg.Done()
+			process(from, to)
+		}(from, min(from+chunk, n))
+	}
+	wg.Wait()
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
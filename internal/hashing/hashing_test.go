@@ -0,0 +1,204 @@
+package hashing_test
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	sdkChainhash "github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/libsv/go-bc"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/hashing"
+)
+
+func newTxHashes(n int) []*chainhash.Hash {
+	txHashes := make([]*chainhash.Hash, n)
+	for i := range txHashes {
+		txHash := chainhash.Hash(sha256.Sum256([]byte(fmt.Sprintf("tx-%d", i))))
+		txHashes[i] = &txHash
+	}
+
+	return txHashes
+}
+
+func TestDoubleSHA256(t *testing.T) {
+	// given
+	data := []byte("hello")
+	first := sha256.Sum256(data)
+	expected := chainhash.Hash(sha256.Sum256(first[:]))
+
+	// when
+	actual := hashing.DoubleSHA256(data)
+
+	// then
+	assert.Equal(t, expected, actual)
+}
+
+func TestPool_BuildMerkleTree(t *testing.T) {
+	tt := []struct {
+		name    string
+		txs     int
+		workers int
+	}{
+		{
+			name: "single transaction",
+			txs:  1,
+		},
+		{
+			name: "odd number of transactions",
+			txs:  7,
+		},
+		{
+			name: "power of two",
+			txs:  16,
+		},
+		{
+			name:    "parallel levels",
+			txs:     10_001,
+			workers: 4,
+		},
+		{
+			name:    "single worker",
+			txs:     10_001,
+			workers: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			txHashes := newTxHashes(tc.txs)
+			expected := bc.BuildMerkleTreeStoreChainHash(txHashes)
+
+			sut := hashing.NewPool(hashing.WithWorkers(tc.workers))
+
+			// when
+			actual := sut.BuildMerkleTree(txHashes)
+
+			// then
+			require.Len(t, actual, len(expected))
+			for i := range expected {
+				if expected[i] == nil {
+					require.Nil(t, actual[i], "node %d", i)
+					continue
+				}
+				require.Equal(t, *expected[i], *actual[i], "node %d", i)
+			}
+		})
+	}
+
+	t.Run("no transactions", func(t *testing.T) {
+		// when
+		actual := hashing.NewPool().BuildMerkleTree(nil)
+
+		// then
+		require.Empty(t, actual)
+	})
+}
+
+func TestMerkleRootBuilder(t *testing.T) {
+	for _, txs := range []int{1, 2, 3, 7, 16, 10_001} {
+		t.Run(fmt.Sprintf("%d transactions", txs), func(t *testing.T) {
+			// given
+			txHashes := newTxHashes(txs)
+			merkleTree := bc.BuildMerkleTreeStoreChainHash(txHashes)
+
+			sut := &hashing.MerkleRootBuilder{}
+
+			// when
+			for _, txHash := range txHashes {
+				sut.Add(txHash)
+			}
+
+			// then
+			require.Equal(t, *merkleTree[len(merkleTree)-1], sut.Root())
+		})
+	}
+}
+
+func newRawTx(t *testing.T, unlockingScript []byte) []byte {
+	t.Helper()
+
+	tx := sdkTx.NewTransaction()
+	tx.Inputs = append(tx.Inputs, &sdkTx.TransactionInput{
+		SourceTXID:      &sdkChainhash.Hash{1},
+		UnlockingScript: script.NewFromBytes(unlockingScript),
+		SequenceNumber:  sdkTx.DefaultSequenceNumber,
+	})
+	tx.AddOutput(&sdkTx.TransactionOutput{
+		Satoshis:      1000,
+		LockingScript: script.NewFromBytes([]byte{script.OpTRUE}),
+	})
+
+	return tx.Bytes()
+}
+
+func TestNormalizedTxHash(t *testing.T) {
+	t.Run("malleated unlocking script", func(t *testing.T) {
+		// given
+		original := newRawTx(t, []byte{script.OpTRUE})
+		malleated := newRawTx(t, []byte{script.OpNOP, script.OpTRUE})
+
+		// when
+		originalHash, err := hashing.NormalizedTxHash(original)
+		require.NoError(t, err)
+		malleatedHash, err := hashing.NormalizedTxHash(malleated)
+		require.NoError(t, err)
+
+		// then
+		assert.NotEqual(t, hashing.DoubleSHA256(original), hashing.DoubleSHA256(malleated))
+		assert.Equal(t, originalHash, malleatedHash)
+		assert.Equal(t, hashing.DoubleSHA256(newRawTx(t, nil)), originalHash)
+	})
+
+	t.Run("invalid transaction", func(t *testing.T) {
+		// when
+		_, err := hashing.NormalizedTxHash([]byte{0x01, 0x00})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func BenchmarkDoubleSHA256(b *testing.B) {
+	for _, size := range []int{64, 250, 1024 * 1024} {
+		data := make([]byte, size)
+		b.Run(fmt.Sprintf("%s/%dB", hashing.Implementation, size), func(b *testing.B) {
+			b.SetBytes(int64(size))
+			for range b.N {
+				hashing.DoubleSHA256(data)
+			}
+		})
+	}
+}
+
+func BenchmarkBuildMerkleTree(b *testing.B) {
+	for _, txs := range []int{1_000, 100_000, 1_000_000} {
+		txHashes := newTxHashes(txs)
+
+		b.Run(fmt.Sprintf("go-bc/%d", txs), func(b *testing.B) {
+			for range b.N {
+				bc.BuildMerkleTreeStoreChainHash(txHashes)
+			}
+		})
+
+		b.Run(fmt.Sprintf("%s/1-worker/%d", hashing.Implementation, txs), func(b *testing.B) {
+			pool := hashing.NewPool(hashing.WithWorkers(1))
+			for range b.N {
+				pool.BuildMerkleTree(txHashes)
+			}
+		})
+
+		b.Run(fmt.Sprintf("%s/pool/%d", hashing.Implementation, txs), func(b *testing.B) {
+			pool := hashing.NewPool()
+			for range b.N {
+				pool.BuildMerkleTree(txHashes)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package hashing
+
+import (
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+)
+
+// NormalizedTxHash returns the normalized hash of a transaction: the double SHA-256 hash of the transaction serialized
+// with empty unlocking scripts. Variants of a transaction whose unlocking scripts were malleated have different
+// transaction ids but the same normalized hash.
+func NormalizedTxHash(rawTx []byte) (chainhash.Hash, error) {
+	tx, err := sdkTx.NewTransactionFromBytes(rawTx)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	for _, input := range tx.Inputs {
+		input.UnlockingScript = nil
+	}
+
+	return DoubleSHA256(tx.Bytes()), nil
+}
@@ -0,0 +1,21 @@
+//go:build !sha256simd
+
+package hashing
+
+import (
+	"crypto/sha256"
+	"hash"
+)
+
+// Implementation is the name of the SHA-256 implementation the binary is built with.
+const Implementation = "crypto/sha256"
+
+// NewSHA256 returns a SHA-256 hash. The standard library uses SHA-NI or the ARMv8 SHA-2 instructions if available.
+func NewSHA256() hash.Hash {
+	return sha256.New()
+}
+
+// Sum256 returns the SHA-256 checksum of the data.
+func Sum256(data []byte) [32]byte {
+	return sha256.Sum256(data)
+}
@@ -0,0 +1,23 @@
+//go:build sha256simd
+
+package hashing
+
+import (
+	"hash"
+
+	"github.com/minio/sha256-simd"
+)
+
+// Implementation is the name of the SHA-256 implementation the binary is built with.
+const Implementation = "sha256-simd"
+
+// NewSHA256 returns a SHA-256 hash which uses SHA-NI, AVX-512 or the ARMv8 SHA-2 instructions, whichever the CPU
+// supports.
+func NewSHA256() hash.Hash {
+	return sha256.New()
+}
+
+// Sum256 returns the SHA-256 checksum of the data.
+func Sum256(data []byte) [32]byte {
+	return sha256.Sum256(data)
+}
@@ -0,0 +1,140 @@
+// Package hexutils contains allocation-free helpers for the hex encoding and decoding of transaction IDs and raw
+// bytes, which are used on the hot paths of the submission of transactions and the processing of blocks.
+//
+// Transaction IDs are encoded in display byte order, i.e. the byte-reversed order of the hash, as done by
+// chainhash.Hash.String and chainhash.NewHashFromStr.
+package hexutils
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+)
+
+const (
+	hexTable = "0123456789abcdef"
+
+	// maxPooledBufferSize is the capacity above which buffers are not returned to the pool, so that single large
+	// transactions don't keep large buffers alive.
+	maxPooledBufferSize = 1 << 20
+
+	invalidHexValue = 0xff
+)
+
+// hexValues maps hex characters to their values and all other characters to invalidHexValue.
+var hexValues = func() [256]byte {
+	var values [256]byte
+	for i := range values {
+		values[i] = invalidHexValue
+	}
+	for i := range 16 {
+		values[hexTable[i]] = byte(i)
+		values["0123456789ABCDEF"[i]] = byte(i)
+	}
+	return values
+}()
+
+var bufferPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 1024)
+		return &b
+	},
+}
+
+// GetBuffer returns an empty buffer from the pool. The buffer has to be returned with PutBuffer once it is not used
+// anymore.
+func GetBuffer() *[]byte {
+	b, _ := bufferPool.Get().(*[]byte)
+	*b = (*b)[:0]
+	return b
+}
+
+// PutBuffer returns the buffer to the pool.
+func PutBuffer(b *[]byte) {
+	if cap(*b) > maxPooledBufferSize {
+		return
+	}
+	bufferPool.Put(b)
+}
+
+// Hash is implemented by the hash types of the go-p2p and go-sdk chainhash packages.
+type Hash interface {
+	~[chainhash.HashSize]byte
+}
+
+// AppendTxID appends the transaction ID of the hash to dst and returns the extended buffer.
+func AppendTxID[H Hash](dst []byte, hash *H) []byte {
+	h := [chainhash.HashSize]byte(*hash)
+	for i := chainhash.HashSize - 1; i >= 0; i-- {
+		dst = append(dst, hexTable[h[i]>>4], hexTable[h[i]&0x0f])
+	}
+	return dst
+}
+
+// TxID returns the transaction ID of the hash like hash.String, with a single allocation for the string.
+func TxID[H Hash](hash *H) string {
+	var buf [chainhash.MaxHashStringSize]byte
+	return string(AppendTxID(buf[:0], hash))
+}
+
+// DecodeTxID decodes the transaction ID into dst without allocating like chainhash.Decode. Transaction IDs shorter
+// than the maximum length are padded with leading zeros.
+func DecodeTxID[H Hash](dst *H, txID string) error {
+	if len(txID) > chainhash.MaxHashStringSize {
+		return chainhash.ErrHashStrSize
+	}
+
+	var hash chainhash.Hash
+	// decode the nibble pairs from the end of the transaction ID, a leading odd nibble is decoded on its own
+	for i, j := len(txID), 0; i > 0; i, j = i-2, j+1 {
+		lo := hexValues[txID[i-1]]
+		if lo == invalidHexValue {
+			return hex.InvalidByteError(txID[i-1])
+		}
+
+		var hi byte
+		if i > 1 {
+			hi = hexValues[txID[i-2]]
+			if hi == invalidHexValue {
+				return hex.InvalidByteError(txID[i-2])
+			}
+		}
+
+		hash[j] = hi<<4 | lo
+	}
+
+	*dst = H(hash)
+	return nil
+}
+
+// NewHashFromTxID returns the hash of the transaction ID like chainhash.NewHashFromStr.
+func NewHashFromTxID(txID string) (*chainhash.Hash, error) {
+	hash := new(chainhash.Hash)
+	err := DecodeTxID(hash, txID)
+	if err != nil {
+		return nil, err
+	}
+	return hash, nil
+}
+
+// EncodeToString returns the hex encoding of src like hex.EncodeToString, with a single allocation for the string.
+func EncodeToString(src []byte) string {
+	buf := GetBuffer()
+	*buf = hex.AppendEncode(*buf, src)
+	s := string(*buf)
+	PutBuffer(buf)
+
+	return s
+}
+
+// DecodeInPlace decodes the hex encoded src into src itself and returns the decoded bytes, which share the
+// underlying array of src. It avoids the conversion of the bytes to a string and the allocation of a destination
+// required by hex.DecodeString.
+func DecodeInPlace(src []byte) ([]byte, error) {
+	n, err := hex.Decode(src, src)
+	if err != nil {
+		return nil, err
+	}
+	return src[:n], nil
+}
@@ -0,0 +1,189 @@
+package hexutils_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+)
+
+const txID = "ea2a81b5e07a0d492439b14c0cf4f01fd9a689b94e2810e9a5f694c73fe7c7ee"
+
+func TestTxID(t *testing.T) {
+	// given
+	hash, err := chainhash.NewHashFromStr(txID)
+	require.NoError(t, err)
+
+	// when
+	actual := hexutils.TxID(hash)
+
+	// then
+	assert.Equal(t, hash.String(), actual)
+	assert.Equal(t, txID, string(hexutils.AppendTxID([]byte("txid:"), hash))[5:])
+
+	allocs := testing.AllocsPerRun(100, func() { _ = hexutils.TxID(hash) })
+	assert.Equal(t, 1.0, allocs)
+}
+
+func TestDecodeTxID(t *testing.T) {
+	tt := []struct {
+		name string
+		txID string
+
+		expectedErr error
+	}{
+		{
+			name: "tx id",
+			txID: txID,
+		},
+		{
+			name: "upper case",
+			txID: "EA2A81B5E07A0D492439B14C0CF4F01FD9A689B94E2810E9A5F694C73FE7C7EE",
+		},
+		{
+			name: "odd length",
+			txID: "1a2b3",
+		},
+		{
+			name: "empty",
+			txID: "",
+		},
+		{
+			name: "too long",
+			txID: txID + "00",
+
+			expectedErr: chainhash.ErrHashStrSize,
+		},
+		{
+			name: "invalid character",
+			txID: "ea2a81b5e07a0d492439b14c0cf4f01fd9a689b94e2810e9a5f694c73fe7c7eg",
+
+			expectedErr: hex.InvalidByteError('g'),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := hexutils.NewHashFromTxID(tc.txID)
+
+			// then
+			expected, expectedErr := chainhash.NewHashFromStr(tc.txID)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Error(t, expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, expectedErr)
+			assert.Equal(t, expected, actual)
+
+			var hash chainhash.Hash
+			allocs := testing.AllocsPerRun(100, func() { _ = hexutils.DecodeTxID(&hash, tc.txID) })
+			assert.Equal(t, 0.0, allocs)
+		})
+	}
+}
+
+func TestEncodeToString(t *testing.T) {
+	// given
+	src := make([]byte, 300)
+	for i := range src {
+		src[i] = byte(i)
+	}
+
+	// when
+	actual := hexutils.EncodeToString(src)
+
+	// then
+	assert.Equal(t, hex.EncodeToString(src), actual)
+
+	allocs := testing.AllocsPerRun(100, func() { _ = hexutils.EncodeToString(src) })
+	assert.Equal(t, 1.0, allocs)
+}
+
+func TestDecodeInPlace(t *testing.T) {
+	tt := []struct {
+		name string
+		src  string
+
+		expected    []byte
+		expectedErr error
+	}{
+		{
+			name: "valid",
+			src:  "0102abff",
+
+			expected: []byte{0x01, 0x02, 0xab, 0xff},
+		},
+		{
+			name: "odd length",
+			src:  "0102a",
+
+			expectedErr: hex.ErrLength,
+		},
+		{
+			name: "invalid character",
+			src:  "01zz",
+
+			expectedErr: hex.InvalidByteError('z'),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := hexutils.DecodeInPlace([]byte(tc.src))
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func BenchmarkTxID(b *testing.B) {
+	hash, err := chainhash.NewHashFromStr(txID)
+	require.NoError(b, err)
+
+	b.Run("chainhash", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = hash.String()
+		}
+	})
+
+	b.Run("hexutils", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = hexutils.TxID(hash)
+		}
+	})
+}
+
+func BenchmarkDecodeTxID(b *testing.B) {
+	b.Run("chainhash", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_, _ = chainhash.NewHashFromStr(txID)
+		}
+	})
+
+	b.Run("hexutils", func(b *testing.B) {
+		b.ReportAllocs()
+		var hash chainhash.Hash
+		for range b.N {
+			_ = hexutils.DecodeTxID(&hash, txID)
+		}
+	})
+}
@@ -0,0 +1,485 @@
+package ingester
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/validator"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	ManifestSuffix = ".manifest.json"
+
+	intervalDefault  = 30 * time.Second
+	minAgeDefault    = 10 * time.Second
+	batchSizeDefault = 100
+	requestTimeout   = 2 * time.Minute
+
+	resultProcessed = "processed"
+	resultFailed    = "failed"
+	resultAccepted  = "accepted"
+	resultRejected  = "rejected"
+
+	txStatusRejected = "REJECTED"
+)
+
+var (
+	ErrFailedToSubmit        = errors.New("failed to submit transactions")
+	ErrTxNotDecodable        = errors.New("transaction could not be decoded")
+	ErrNoResult              = errors.New("no result returned for transaction")
+	ErrFailedToRegisterStats = errors.New("failed to register ingester metrics")
+)
+
+// ArcClient is the client of the public API of ARC used by the ingester.
+type ArcClient interface {
+	POSTTransactionsWithResponse(ctx context.Context, params *api.POSTTransactionsParams, body api.POSTTransactionsJSONRequestBody, reqEditors ...api.RequestEditorFn) (*api.POSTTransactionsResponse, error)
+}
+
+// Manifest is the result of the submission of the transactions of a file. It is written next to the file as
+// <file>.manifest.json once all transactions of the file are submitted.
+type Manifest struct {
+	File         string    `json:"file"`
+	ProcessedAt  time.Time `json:"processedAt"`
+	Transactions int       `json:"transactions"`
+	Accepted     int       `json:"accepted"`
+	Rejected     int       `json:"rejected"`
+	Results      []Result  `json:"results"`
+}
+
+// Result is the result of a transaction of a file, the status is 200 if the transaction was accepted by ARC,
+// otherwise the error status. The index is the position of the transaction in the file.
+type Result struct {
+	Index     int    `json:"index"`
+	TxID      string `json:"txid,omitempty"`
+	Status    int    `json:"status"`
+	TxStatus  string `json:"txStatus,omitempty"`
+	Title     string `json:"title,omitempty"`
+	ExtraInfo string `json:"extraInfo,omitempty"`
+}
+
+// Accepted returns whether the transaction was accepted by ARC.
+func (r Result) Accepted() bool {
+	return r.Status == int(api.StatusOK) && r.TxStatus != txStatusRejected
+}
+
+// submission is an item of the response of POST /v1/txs, which is either a transaction response or an error.
+type submission struct {
+	TxID      *string `json:"txid"`
+	Status    int     `json:"status"`
+	TxStatus  string  `json:"txStatus"`
+	Title     string  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+}
+
+type fileTx struct {
+	index int
+	txID  string
+	rawTx string
+}
+
+// Ingester watches a drop folder for files of transactions in raw or extended format, submits the transactions of the
+// files through the public API of ARC and writes a manifest with the results of each file. A file either contains one
+// hex encoded transaction per line or the binary transactions concatenated. Files are processed once they weren't
+// modified for minAge, so that files which are still written are not read. A file which failed to be submitted is
+// retried in the next round, the transactions which were submitted already are answered by ARC with their stored
+// status.
+type Ingester struct {
+	logger          *slog.Logger
+	client          ArcClient
+	source          Source
+	interval        time.Duration
+	minAge          time.Duration
+	batchSize       int
+	deleteProcessed bool
+	params          api.POSTTransactionsParams
+	now             func() time.Time
+	stats           *stats
+
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithInterval(d time.Duration) func(*Ingester) {
+	return func(i *Ingester) {
+		i.interval = d
+	}
+}
+
+// WithMinAge sets the duration for which a file must not have been modified before it is processed.
+func WithMinAge(d time.Duration) func(*Ingester) {
+	return func(i *Ingester) {
+		i.minAge = d
+	}
+}
+
+// WithBatchSize sets the maximum number of transactions submitted in one request.
+func WithBatchSize(size int) func(*Ingester) {
+	return func(i *Ingester) {
+		i.batchSize = size
+	}
+}
+
+// WithDeleteProcessed deletes the files once their manifest is written.
+func WithDeleteProcessed() func(*Ingester) {
+	return func(i *Ingester) {
+		i.deleteProcessed = true
+	}
+}
+
+// WithWaitFor sets the status until which the submissions wait, see header X-WaitFor.
+func WithWaitFor(status string) func(*Ingester) {
+	return func(i *Ingester) {
+		i.params.XWaitFor = &status
+	}
+}
+
+// WithCallback sets the callback URL and token of the submitted transactions.
+func WithCallback(url string, token string) func(*Ingester) {
+	return func(i *Ingester) {
+		if url != "" {
+			i.params.XCallbackUrl = &url
+		}
+		if token != "" {
+			i.params.XCallbackToken = &token
+		}
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Ingester) {
+	return func(i *Ingester) {
+		i.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, client ArcClient, source Source, opts ...func(*Ingester)) *Ingester {
+	i := &Ingester{
+		logger:    logger.With(slog.String("module", "ingester")),
+		client:    client,
+		source:    source,
+		interval:  intervalDefault,
+		minAge:    minAgeDefault,
+		batchSize: batchSizeDefault,
+		now:       time.Now,
+		stats:     newStats(),
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	if i.batchSize <= 0 {
+		i.batchSize = batchSizeDefault
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	i.cancelAll = cancelAll
+	i.ctx = ctx
+
+	return i
+}
+
+// Start registers the metrics of the ingester and processes the drop folder right away and then every interval.
+func (i *Ingester) Start() error {
+	err := i.stats.register()
+	if err != nil {
+		return errors.Join(ErrFailedToRegisterStats, err)
+	}
+
+	ticker := time.NewTicker(i.interval)
+	i.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			i.waitGroup.Done()
+		}()
+
+		for {
+			i.Run(i.ctx)
+
+			select {
+			case <-i.ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Run processes the files of the drop folder which have no manifest yet in the order of their names.
+func (i *Ingester) Run(ctx context.Context) {
+	files, err := i.source.List(ctx)
+	if err != nil {
+		i.logger.Error("Failed to list drop folder", slog.String("err", err.Error()))
+		return
+	}
+
+	for _, name := range i.pending(files) {
+		if ctx.Err() != nil {
+			return
+		}
+
+		manifest, err := i.processFile(ctx, name)
+		if err != nil {
+			i.stats.files.WithLabelValues(resultFailed).Inc()
+			i.logger.Error("Failed to process file", slog.String("file", name), slog.String("err", err.Error()))
+			continue
+		}
+
+		i.stats.files.WithLabelValues(resultProcessed).Inc()
+		i.stats.txs.WithLabelValues(resultAccepted).Add(float64(manifest.Accepted))
+		i.stats.txs.WithLabelValues(resultRejected).Add(float64(manifest.Rejected))
+		i.logger.Info("File processed", slog.String("file", name), slog.Int("txs", manifest.Transactions), slog.Int("accepted", manifest.Accepted), slog.Int("rejected", manifest.Rejected))
+
+		if !i.deleteProcessed {
+			continue
+		}
+
+		err = i.source.Remove(ctx, name)
+		if err != nil {
+			i.logger.Error("Failed to delete processed file", slog.String("file", name), slog.String("err", err.Error()))
+		}
+	}
+}
+
+// pending returns the names of the files which are neither manifests nor have a manifest and weren't modified for
+// minAge.
+func (i *Ingester) pending(files []File) []string {
+	manifests := make(map[string]struct{})
+	for _, file := range files {
+		if strings.HasSuffix(file.Name, ManifestSuffix) {
+			manifests[strings.TrimSuffix(file.Name, ManifestSuffix)] = struct{}{}
+		}
+	}
+
+	now := i.now()
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		if strings.HasSuffix(file.Name, ManifestSuffix) || strings.HasPrefix(file.Name, ".") {
+			continue
+		}
+		if _, found := manifests[file.Name]; found {
+			continue
+		}
+		if now.Sub(file.ModTime) < i.minAge {
+			continue
+		}
+		names = append(names, file.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (i *Ingester) processFile(ctx context.Context, name string) (*Manifest, error) {
+	data, err := i.source.Read(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, results := parseFile(data)
+
+	for start := 0; start < len(txs); start += i.batchSize {
+		end := min(start+i.batchSize, len(txs))
+
+		batchResults, err := i.submit(ctx, txs[start:end])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, batchResults...)
+	}
+
+	sort.Slice(results, func(a, b int) bool {
+		return results[a].Index < results[b].Index
+	})
+
+	manifest := &Manifest{
+		File:         name,
+		ProcessedAt:  i.now().UTC(),
+		Transactions: len(results),
+		Results:      results,
+	}
+	for _, result := range results {
+		if result.Accepted() {
+			manifest.Accepted++
+		} else {
+			manifest.Rejected++
+		}
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.source.Write(ctx, name+ManifestSuffix, manifestData)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (i *Ingester) submit(ctx context.Context, txs []fileTx) ([]Result, error) {
+	body := make(api.POSTTransactionsJSONRequestBody, 0, len(txs))
+	for _, tx := range txs {
+		body = append(body, api.TransactionRequest{RawTx: tx.rawTx})
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	params := i.params
+	resp, err := i.client.POSTTransactionsWithResponse(ctx, &params, body)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToSubmit, err)
+	}
+
+	if resp.JSON200 == nil || resp.JSON200.Transactions == nil {
+		return nil, errors.Join(ErrFailedToSubmit, fmt.Errorf("status: %s, response: %s", resp.Status(), strings.TrimSpace(string(resp.Body))))
+	}
+
+	submissions := make(map[string]submission, len(*resp.JSON200.Transactions))
+	for _, item := range *resp.JSON200.Transactions {
+		itemData, err := item.MarshalJSON()
+		if err != nil {
+			return nil, errors.Join(ErrFailedToSubmit, err)
+		}
+
+		var s submission
+		err = json.Unmarshal(itemData, &s)
+		if err != nil {
+			return nil, errors.Join(ErrFailedToSubmit, err)
+		}
+		if s.TxID != nil {
+			submissions[*s.TxID] = s
+		}
+	}
+
+	results := make([]Result, 0, len(txs))
+	for _, tx := range txs {
+		s, found := submissions[tx.txID]
+		if !found {
+			results = append(results, errorResult(tx.index, tx.txID, api.ErrStatusGeneric, ErrNoResult))
+			continue
+		}
+
+		result := Result{
+			Index:    tx.index,
+			TxID:     tx.txID,
+			Status:   s.Status,
+			TxStatus: s.TxStatus,
+			Title:    s.Title,
+		}
+		if s.ExtraInfo != nil {
+			result.ExtraInfo = *s.ExtraInfo
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// parseFile parses the transactions of a file and returns the transactions to be submitted and the results of the
+// transactions which could not be decoded. A file consisting of hex characters and whitespace only contains one
+// transaction per line, otherwise the file contains binary transactions concatenated, which can't be decoded any
+// further after a transaction which fails to decode.
+func parseFile(data []byte) ([]fileTx, []Result) {
+	if isHexText(data) {
+		return parseLines(data)
+	}
+
+	var txs []fileTx
+	for index := 0; len(data) > 0; index++ {
+		tx, bytesUsed, err := validator.DecodeTransaction(data)
+		if err != nil {
+			return txs, []Result{errorResult(index, "", api.ErrStatusBadRequest, errors.Join(ErrTxNotDecodable, err))}
+		}
+
+		txs = append(txs, fileTx{index: index, txID: hexutils.TxID(tx.TxID()), rawTx: hex.EncodeToString(data[:bytesUsed])})
+		data = data[bytesUsed:]
+	}
+
+	return txs, nil
+}
+
+func parseLines(data []byte) ([]fileTx, []Result) {
+	var txs []fileTx
+	var results []Result
+
+	index := 0
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+
+		b, err := hex.DecodeString(string(line))
+		if err != nil {
+			results = append(results, errorResult(index, "", api.ErrStatusBadRequest, errors.Join(ErrTxNotDecodable, err)))
+			index++
+			continue
+		}
+
+		tx, bytesUsed, err := validator.DecodeTransaction(b)
+		if err == nil && bytesUsed != len(b) {
+			err = fmt.Errorf("%d trailing bytes", len(b)-bytesUsed)
+		}
+		if err != nil {
+			results = append(results, errorResult(index, "", api.ErrStatusBadRequest, errors.Join(ErrTxNotDecodable, err)))
+			index++
+			continue
+		}
+
+		txs = append(txs, fileTx{index: index, txID: hexutils.TxID(tx.TxID()), rawTx: string(line)})
+		index++
+	}
+
+	return txs, results
+}
+
+func isHexText(data []byte) bool {
+	for _, c := range data {
+		switch {
+		case c >= '0' && c <= '9', c >= 'a' && c <= 'f', c >= 'A' && c <= 'F':
+		case c == '\n' || c == '\r' || c == ' ' || c == '\t':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func errorResult(index int, txID string, status api.StatusCode, err error) Result {
+	fields := api.NewErrorFields(status, err.Error())
+
+	return Result{
+		Index:     index,
+		TxID:      txID,
+		Status:    fields.Status,
+		Title:     fields.Title,
+		ExtraInfo: *fields.ExtraInfo,
+	}
+}
+
+func (i *Ingester) Shutdown() {
+	i.cancelAll()
+	i.waitGroup.Wait()
+	i.stats.unregister()
+}
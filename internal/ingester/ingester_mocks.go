@@ -0,0 +1,4 @@
+package ingester
+
+//go:generate moq -pkg mocks -out ./mocks/arc_client_mock.go . ArcClient
+//go:generate moq -pkg mocks -out ./mocks/source_mock.go . Source
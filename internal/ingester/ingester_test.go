@@ -0,0 +1,194 @@
+package ingester_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/ingester"
+	"github.com/bitcoin-sv/arc/internal/ingester/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+func TestIngester_Run(t *testing.T) {
+	tx1Bytes, err := hex.DecodeString(testdata.TX1RawString)
+	require.NoError(t, err)
+	tx6Bytes, err := hex.DecodeString(testdata.TX6RawString)
+	require.NoError(t, err)
+
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name            string
+		content         []byte
+		modTime         time.Time
+		existingFiles   []string
+		submitErr       error
+		submitCode      int
+		deleteProcessed bool
+
+		expectedSubmissions int
+		expectedResults     []ingester.Result
+		expectedFiles       []string
+	}{
+		{
+			name:       "text file - one transaction per line",
+			content:    []byte(testdata.TX1RawString + "\n\n" + testdata.TX6RawString + "\r\nabcd\n"),
+			modTime:    now.Add(-time.Minute),
+			submitCode: http.StatusOK,
+
+			expectedSubmissions: 1,
+			expectedResults: []ingester.Result{
+				{Index: 0, TxID: testdata.TX1Raw.TxID().String(), Status: 200, TxStatus: "SEEN_ON_NETWORK", Title: "OK"},
+				{Index: 1, TxID: testdata.TX6Raw.TxID().String(), Status: 200, TxStatus: "REJECTED", Title: "OK", ExtraInfo: "double spend"},
+				{Index: 2, Status: 400, Title: "Bad request", ExtraInfo: "transaction could not be decoded\nunexpected EOF"},
+			},
+			expectedFiles: []string{"txs.hex", "txs.hex.manifest.json"},
+		},
+		{
+			name:            "binary file - transactions concatenated",
+			content:         append(append([]byte{}, tx1Bytes...), tx6Bytes...),
+			modTime:         now.Add(-time.Minute),
+			submitCode:      http.StatusOK,
+			deleteProcessed: true,
+
+			expectedSubmissions: 1,
+			expectedResults: []ingester.Result{
+				{Index: 0, TxID: testdata.TX1Raw.TxID().String(), Status: 200, TxStatus: "SEEN_ON_NETWORK", Title: "OK"},
+				{Index: 1, TxID: testdata.TX6Raw.TxID().String(), Status: 200, TxStatus: "REJECTED", Title: "OK", ExtraInfo: "double spend"},
+			},
+			expectedFiles: []string{"txs.hex.manifest.json"},
+		},
+		{
+			name:    "file modified recently",
+			content: []byte(testdata.TX1RawString),
+			modTime: now.Add(-time.Second),
+
+			expectedFiles: []string{"txs.hex"},
+		},
+		{
+			name:          "manifest exists",
+			content:       []byte(testdata.TX1RawString),
+			modTime:       now.Add(-time.Minute),
+			existingFiles: []string{"txs.hex.manifest.json"},
+
+			expectedFiles: []string{"txs.hex", "txs.hex.manifest.json"},
+		},
+		{
+			name:      "submission failed",
+			content:   []byte(testdata.TX1RawString),
+			modTime:   now.Add(-time.Minute),
+			submitErr: errors.New("connection refused"),
+
+			expectedSubmissions: 1,
+			expectedFiles:       []string{"txs.hex"},
+		},
+		{
+			name:       "submission failed - server error",
+			content:    []byte(testdata.TX1RawString),
+			modTime:    now.Add(-time.Minute),
+			submitCode: http.StatusInternalServerError,
+
+			expectedSubmissions: 1,
+			expectedFiles:       []string{"txs.hex"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			path := filepath.Join(dir, "txs.hex")
+			require.NoError(t, os.WriteFile(path, tc.content, 0o600))
+			require.NoError(t, os.Chtimes(path, tc.modTime, tc.modTime))
+			for _, name := range tc.existingFiles {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte("{}"), 0o600))
+			}
+
+			client := &mocks.ArcClientMock{
+				POSTTransactionsWithResponseFunc: func(_ context.Context, _ *api.POSTTransactionsParams, body api.POSTTransactionsJSONRequestBody, _ ...api.RequestEditorFn) (*api.POSTTransactionsResponse, error) {
+					if tc.submitErr != nil {
+						return nil, tc.submitErr
+					}
+					if tc.submitCode != http.StatusOK {
+						return &api.POSTTransactionsResponse{HTTPResponse: &http.Response{StatusCode: tc.submitCode, Status: http.StatusText(tc.submitCode)}}, nil
+					}
+
+					assert.Len(t, body, 2)
+					return transactionsResponse(t,
+						fmt.Sprintf(`{"txid":%q,"status":200,"title":"OK","txStatus":"SEEN_ON_NETWORK"}`, testdata.TX1Raw.TxID().String()),
+						fmt.Sprintf(`{"txid":%q,"status":200,"title":"OK","txStatus":"REJECTED","extraInfo":"double spend"}`, testdata.TX6Raw.TxID().String()),
+					), nil
+				},
+			}
+
+			opts := []func(*ingester.Ingester){
+				ingester.WithNow(func() time.Time { return now }),
+				ingester.WithMinAge(10 * time.Second),
+			}
+			if tc.deleteProcessed {
+				opts = append(opts, ingester.WithDeleteProcessed())
+			}
+
+			sut := ingester.New(slog.Default(), client, ingester.NewDirSource(dir), opts...)
+
+			// when
+			sut.Run(context.Background())
+
+			// then
+			assert.Len(t, client.POSTTransactionsWithResponseCalls(), tc.expectedSubmissions)
+
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			actualFiles := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				actualFiles = append(actualFiles, entry.Name())
+			}
+			assert.Equal(t, tc.expectedFiles, actualFiles)
+
+			if tc.expectedResults == nil {
+				return
+			}
+
+			manifestData, err := os.ReadFile(path + ingester.ManifestSuffix)
+			require.NoError(t, err)
+
+			var manifest ingester.Manifest
+			require.NoError(t, json.Unmarshal(manifestData, &manifest))
+			assert.Equal(t, "txs.hex", manifest.File)
+			assert.Equal(t, now, manifest.ProcessedAt)
+			assert.Equal(t, len(tc.expectedResults), manifest.Transactions)
+			assert.Equal(t, 1, manifest.Accepted)
+			assert.Equal(t, len(tc.expectedResults)-1, manifest.Rejected)
+			assert.Equal(t, tc.expectedResults, manifest.Results)
+		})
+	}
+}
+
+func transactionsResponse(t *testing.T, items ...string) *api.POSTTransactionsResponse {
+	t.Helper()
+
+	transactions := make([]api.TransactionResponses_Transactions_Item, 0, len(items))
+	for _, item := range items {
+		var transaction api.TransactionResponses_Transactions_Item
+		require.NoError(t, transaction.UnmarshalJSON([]byte(item)))
+		transactions = append(transactions, transaction)
+	}
+
+	return &api.POSTTransactionsResponse{
+		HTTPResponse: &http.Response{StatusCode: http.StatusOK},
+		JSON200:      &api.TransactionResponses{Transactions: &transactions},
+	}
+}
@@ -0,0 +1,337 @@
+package ingester
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	s3DateFormat    = "20060102"
+	s3AmzDateFormat = "20060102T150405Z"
+	s3MaxErrorBody  = 4096
+)
+
+var ErrS3RequestFailed = errors.New("s3 request failed")
+
+// HTTPClient sends the requests to S3.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// S3Config configures the S3 bucket used as drop folder. The bucket is addressed path-style, i.e.
+// <endpoint>/<bucket>/<key>, which is supported by AWS S3 as well as by S3 compatible stores like MinIO.
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	Prefix          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3Source is a prefix of an S3 bucket used as drop folder. The requests are signed with AWS Signature Version 4.
+// Objects below further prefixes, i.e. subfolders, are ignored.
+type S3Source struct {
+	client   HTTPClient
+	endpoint *url.URL
+	cfg      S3Config
+	prefix   string
+	now      func() time.Time
+}
+
+func WithS3HTTPClient(client HTTPClient) func(*S3Source) {
+	return func(s *S3Source) {
+		s.client = client
+	}
+}
+
+func WithS3Now(nowFunc func() time.Time) func(*S3Source) {
+	return func(s *S3Source) {
+		s.now = nowFunc
+	}
+}
+
+func NewS3Source(cfg S3Config, opts ...func(*S3Source)) (*S3Source, error) {
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %s", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 bucket not set")
+	}
+
+	prefix := strings.Trim(cfg.Prefix, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+
+	s := &S3Source{
+		client:   http.DefaultClient,
+		endpoint: endpoint,
+		cfg:      cfg,
+		prefix:   prefix,
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
+}
+
+type listBucketResult struct {
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (s *S3Source) List(ctx context.Context) ([]File, error) {
+	var files []File
+
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("delimiter", "/")
+		if s.prefix != "" {
+			query.Set("prefix", s.prefix)
+		}
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		body, err := s.do(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, errors.Join(ErrFailedToList, err)
+		}
+
+		var result listBucketResult
+		err = xml.Unmarshal(body, &result)
+		if err != nil {
+			return nil, errors.Join(ErrFailedToList, err)
+		}
+
+		for _, object := range result.Contents {
+			name := strings.TrimPrefix(object.Key, s.prefix)
+			if name == "" {
+				continue
+			}
+			files = append(files, File{Name: name, ModTime: object.LastModified})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return files, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (s *S3Source) Read(ctx context.Context, name string) ([]byte, error) {
+	body, err := s.do(ctx, http.MethodGet, s.prefix+name, nil, nil)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToRead, err)
+	}
+
+	return body, nil
+}
+
+func (s *S3Source) Write(ctx context.Context, name string, data []byte) error {
+	_, err := s.do(ctx, http.MethodPut, s.prefix+name, nil, data)
+	if err != nil {
+		return errors.Join(ErrFailedToWrite, err)
+	}
+
+	return nil
+}
+
+func (s *S3Source) Remove(ctx context.Context, name string) error {
+	_, err := s.do(ctx, http.MethodDelete, s.prefix+name, nil, nil)
+	if err != nil {
+		return errors.Join(ErrFailedToRemove, err)
+	}
+
+	return nil
+}
+
+func (s *S3Source) do(ctx context.Context, method string, key string, query url.Values, payload []byte) ([]byte, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.cfg.Bucket
+	if key != "" {
+		u.Path += "/" + key
+	}
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	if payload == nil {
+		req.Body = http.NoBody
+	}
+	req.ContentLength = int64(len(payload))
+
+	s.sign(req, payload)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(ErrS3RequestFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, s3MaxErrorBody))
+
+		var s3Err s3Error
+		if xml.Unmarshal(body, &s3Err) == nil && s3Err.Code != "" {
+			return nil, errors.Join(ErrS3RequestFailed, fmt.Errorf("status: %d, code: %s, message: %s", resp.StatusCode, s3Err.Code, s3Err.Message))
+		}
+
+		return nil, errors.Join(ErrS3RequestFailed, fmt.Errorf("status: %d", resp.StatusCode))
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Join(ErrS3RequestFailed, err)
+	}
+
+	return body, nil
+}
+
+// sign signs the request with AWS Signature Version 4. The host, the headers already set on the request and the
+// headers x-amz-date and x-amz-content-sha256 added by sign are signed.
+func (s *S3Source) sign(req *http.Request, payload []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format(s3AmzDateFormat)
+	date := now.Format(s3DateFormat)
+
+	payloadHash := sha256.Sum256(payload)
+	payloadHashHex := hex.EncodeToString(payloadHash[:])
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHashHex)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI(req.URL.EscapedPath()),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHashHex,
+	}, "\n")
+
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	scope := strings.Join([]string{date, s.cfg.Region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(canonicalRequestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", s3Algorithm, s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalURI returns the path URI-encoded as required by Signature Version 4, which differs from url.PathEscape in
+// the characters which are kept.
+func canonicalURI(escapedPath string) string {
+	if escapedPath == "" {
+		return "/"
+	}
+
+	segments := strings.Split(escapedPath, "/")
+	for i, segment := range segments {
+		unescaped, err := url.PathUnescape(segment)
+		if err != nil {
+			unescaped = segment
+		}
+		segments[i] = uriEncode(unescaped)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// canonicalQuery returns the query sorted by key with keys and values URI-encoded as required by Signature Version 4.
+func canonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		for _, value := range query[key] {
+			parts = append(parts, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+
+	return strings.Join(parts, "&")
+}
+
+func uriEncode(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
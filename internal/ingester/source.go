@@ -0,0 +1,101 @@
+package ingester
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var (
+	ErrFailedToList   = errors.New("failed to list files")
+	ErrFailedToRead   = errors.New("failed to read file")
+	ErrFailedToWrite  = errors.New("failed to write file")
+	ErrFailedToRemove = errors.New("failed to remove file")
+)
+
+// File is a file in the drop folder. Its name is relative to the folder.
+type File struct {
+	Name    string
+	ModTime time.Time
+}
+
+// Source is a drop folder the files of transactions are read from and the manifests are written to.
+type Source interface {
+	List(ctx context.Context) ([]File, error)
+	Read(ctx context.Context, name string) ([]byte, error)
+	Write(ctx context.Context, name string, data []byte) error
+	Remove(ctx context.Context, name string) error
+}
+
+// DirSource is a directory of the filesystem used as drop folder. Subdirectories are ignored.
+type DirSource struct {
+	dir string
+}
+
+func NewDirSource(dir string) *DirSource {
+	return &DirSource{dir: dir}
+}
+
+func (s *DirSource) List(_ context.Context) ([]File, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToList, err)
+	}
+
+	files := make([]File, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			// the file was removed in the meantime
+			continue
+		}
+
+		files = append(files, File{Name: entry.Name(), ModTime: info.ModTime()})
+	}
+
+	return files, nil
+}
+
+func (s *DirSource) Read(_ context.Context, name string) ([]byte, error) {
+	data, err := os.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, errors.Join(ErrFailedToRead, err)
+	}
+
+	return data, nil
+}
+
+// Write writes the file to a temporary file first and renames it, so that readers of the folder never see a partially
+// written file.
+func (s *DirSource) Write(_ context.Context, name string, data []byte) error {
+	path := filepath.Join(s.dir, name)
+	tmpPath := filepath.Join(s.dir, "."+name+".tmp")
+
+	err := os.WriteFile(tmpPath, data, 0o644)
+	if err != nil {
+		return errors.Join(ErrFailedToWrite, err)
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.Join(ErrFailedToWrite, err)
+	}
+
+	return nil
+}
+
+func (s *DirSource) Remove(_ context.Context, name string) error {
+	err := os.Remove(filepath.Join(s.dir, name))
+	if err != nil {
+		return errors.Join(ErrFailedToRemove, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package ingester
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type stats struct {
+	files *prometheus.CounterVec
+	txs   *prometheus.CounterVec
+}
+
+func newStats() *stats {
+	return &stats{
+		files: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_ingester_files_total",
+			Help: "Number of files of the drop folder by result: processed or failed",
+		}, []string{"result"}),
+		txs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_ingester_txs_total",
+			Help: "Number of transactions of the processed files by result: accepted or rejected",
+		}, []string{"result"}),
+	}
+}
+
+func (s *stats) collectors() []prometheus.Collector {
+	return []prometheus.Collector{s.files, s.txs}
+}
+
+func (s *stats) register() error {
+	for _, c := range s.collectors() {
+		err := prometheus.Register(c)
+		if err != nil {
+			return fmt.Errorf("failed to register stats collector: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *stats) unregister() {
+	for _, c := range s.collectors() {
+		_ = prometheus.Unregister(c)
+	}
+}
@@ -46,3 +46,34 @@ func (k *K8sClient) GetRunningPodNamesSlice(ctx context.Context, namespace strin
 
 	return podNames, nil
 }
+
+// GetRunningPodAddresses returns the IP addresses of the running and ready pods matching the label selector, e.g. the
+// selector of a service.
+func (k *K8sClient) GetRunningPodAddresses(ctx context.Context, namespace string, labelSelector string) ([]string, error) {
+	pods, err := k.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	for _, item := range pods.Items {
+		if item.Status.Phase != v1.PodRunning || item.Status.PodIP == "" || !isPodReady(item) {
+			continue
+		}
+		addresses = append(addresses, item.Status.PodIP)
+	}
+
+	return addresses, nil
+}
+
+func isPodReady(pod v1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
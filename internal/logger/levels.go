@@ -0,0 +1,170 @@
+package logger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+)
+
+var ErrLevelsDisabled = errors.New("log levels not enabled")
+
+// ComponentKey is the attribute identifying the component of a logger, e.g. `logger.With(slog.String("service", "api"))`.
+const ComponentKey = "service"
+
+// Levels holds the log level of the process and the log levels of components differing from it. Levels can be
+// changed at runtime.
+type Levels struct {
+	mu         sync.RWMutex
+	level      slog.Level
+	components map[string]slog.Level
+}
+
+func NewLevels(level slog.Level) *Levels {
+	return &Levels{
+		level:      level,
+		components: map[string]slog.Level{},
+	}
+}
+
+// Level returns the log level of the component. An empty component returns the log level of the process.
+func (l *Levels) Level(component string) slog.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	level, found := l.components[component]
+	if !found {
+		return l.level
+	}
+
+	return level
+}
+
+// SetLevel sets the log level of the component. An empty component sets the log level of the process.
+func (l *Levels) SetLevel(component string, level slog.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if component == "" {
+		l.level = level
+		return
+	}
+
+	l.components[component] = level
+}
+
+// ResetLevel resets the log level of the component to the log level of the process.
+func (l *Levels) ResetLevel(component string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.components, component)
+}
+
+// SetLevelName sets the log level with the name, e.g. DEBUG, of the component. An empty component sets the log level
+// of the process. It returns ErrLevelsDisabled for nil levels, so that services can be run without levels.
+func (l *Levels) SetLevelName(component string, name string) error {
+	if l == nil {
+		return ErrLevelsDisabled
+	}
+
+	level, err := GetSlogLevel(name)
+	if err != nil {
+		return err
+	}
+
+	l.SetLevel(component, level)
+
+	return nil
+}
+
+// ResetComponentLevel resets the log level of the component to the log level of the process. It returns
+// ErrLevelsDisabled for nil levels.
+func (l *Levels) ResetComponentLevel(component string) error {
+	if l == nil {
+		return ErrLevelsDisabled
+	}
+
+	l.ResetLevel(component)
+
+	return nil
+}
+
+type levelsResponse struct {
+	Level      string            `json:"level"`
+	Components map[string]string `json:"components"`
+}
+
+// Names returns the name of the log level of the process and the names of the log levels of the components differing
+// from it.
+func (l *Levels) Names() (string, map[string]string) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	components := make(map[string]string, len(l.components))
+	for name, level := range l.components {
+		components[name] = levelName(level)
+	}
+
+	return levelName(l.level), components
+}
+
+// ServeHTTP returns the log levels on GET. The endpoint is read-only, the log levels are changed with the operations
+// of the admin service, which require a token with the role admin.
+func (l *Levels) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	level, components := l.Names()
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(levelsResponse{Level: level, Components: components})
+}
+
+func levelName(level slog.Level) string {
+	if level == LevelTrace {
+		return "TRACE"
+	}
+
+	return level.String()
+}
+
+// LevelHandler filters records by the log level of the component of the logger and samples high-frequency records.
+type LevelHandler struct {
+	slog.Handler
+	levels    *Levels
+	sampler   *sampler
+	component string
+}
+
+func (h LevelHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.levels.Level(h.component)
+}
+
+func (h LevelHandler) Handle(ctx context.Context, r slog.Record) error {
+	if h.sampler != nil && !h.sampler.allow(h.component, r) {
+		return nil
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h LevelHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	component := h.component
+	for _, attr := range attrs {
+		if attr.Key == ComponentKey {
+			component = attr.Value.String()
+		}
+	}
+
+	return LevelHandler{Handler: h.Handler.WithAttrs(attrs), levels: h.levels, sampler: h.sampler, component: component}
+}
+
+func (h LevelHandler) WithGroup(name string) slog.Handler {
+	return LevelHandler{Handler: h.Handler.WithGroup(name), levels: h.levels, sampler: h.sampler, component: h.component}
+}
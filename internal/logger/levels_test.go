@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLevelHandler(t *testing.T) {
+	t.Run("component levels", func(t *testing.T) {
+		// given
+		buf := &bytes.Buffer{}
+		levels := NewLevels(LevelInfo)
+		sut := slog.New(LevelHandler{Handler: slog.NewTextHandler(buf, &slog.HandlerOptions{Level: LevelTrace}), levels: levels})
+
+		mtmLogger := sut.With(slog.String(ComponentKey, "mtm"))
+		apiLogger := sut.With(slog.String(ComponentKey, "api"))
+
+		// when
+		mtmLogger.Debug("mtm debug 1")
+		levels.SetLevel("mtm", LevelDebug)
+		mtmLogger.Debug("mtm debug 2")
+		apiLogger.Debug("api debug")
+		levels.ResetLevel("mtm")
+		mtmLogger.Debug("mtm debug 3")
+		mtmLogger.Info("mtm info")
+
+		// then
+		logs := buf.String()
+		assert.NotContains(t, logs, "mtm debug 1")
+		assert.Contains(t, logs, "mtm debug 2")
+		assert.NotContains(t, logs, "api debug")
+		assert.NotContains(t, logs, "mtm debug 3")
+		assert.Contains(t, logs, "mtm info")
+	})
+
+	t.Run("sampling", func(t *testing.T) {
+		// given
+		buf := &bytes.Buffer{}
+		s := newSampler(time.Hour, 2, 3)
+		sut := slog.New(LevelHandler{Handler: slog.NewTextHandler(buf, &slog.HandlerOptions{Level: LevelTrace}), levels: NewLevels(LevelInfo), sampler: s})
+
+		// when
+		for range 10 {
+			sut.Info("status updated")
+			sut.Warn("status update failed")
+		}
+
+		// then
+		logs := buf.String()
+		assert.Equal(t, 4, strings.Count(logs, "status updated"))
+		assert.Equal(t, 10, strings.Count(logs, "status update failed"))
+	})
+}
+
+func TestLevels_ServeHTTP(t *testing.T) {
+	tt := []struct {
+		name   string
+		method string
+		query  string
+
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "get",
+			method: http.MethodGet,
+
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"level":"INFO","components":{"blocktx":"WARN"}}`,
+		},
+		{
+			name:   "set level - read-only",
+			method: http.MethodPut,
+			query:  "component=mtm&level=DEBUG",
+
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "reset level - read-only",
+			method: http.MethodDelete,
+			query:  "component=blocktx",
+
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodPost,
+
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := NewLevels(LevelInfo)
+			sut.SetLevel("blocktx", LevelWarning)
+
+			req := httptest.NewRequest(tc.method, "/debug/loglevel?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			// when
+			sut.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedStatus, rec.Code)
+			if tc.expectedBody != "" {
+				require.JSONEq(t, tc.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
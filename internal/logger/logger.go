@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/lmittmann/tint"
 )
@@ -43,33 +44,77 @@ func GetSlogLevel(logLevel string) (slog.Level, error) {
 	return 0, errors.Join(ErrLoggerInvalidLogLevel, fmt.Errorf("log level: %s", logLevel))
 }
 
-func NewLogger(logLevel, logFormat string) (*slog.Logger, error) {
+type loggerOptions struct {
+	levels  *Levels
+	sampler *sampler
+}
+
+type Option func(*loggerOptions)
+
+// WithLevels makes the log levels of the logger changeable at runtime. The log level of the process is initialised
+// with the log level of the logger.
+func WithLevels(levels *Levels) Option {
+	return func(o *loggerOptions) {
+		o.levels = levels
+	}
+}
+
+// WithSampling logs the first `first` records with the same component, level and message per interval and every
+// `thereafter`th record after that.
+func WithSampling(interval time.Duration, first uint64, thereafter uint64) Option {
+	return func(o *loggerOptions) {
+		o.sampler = newSampler(interval, first, thereafter)
+	}
+}
+
+func NewLogger(logLevel, logFormat string, opts ...Option) (*slog.Logger, error) {
 	slogLevel, err := GetSlogLevel(logLevel)
 	if err != nil {
 		return nil, err
 	}
 
+	options := &loggerOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	if options.sampler != nil && options.levels == nil {
+		options.levels = NewLevels(slogLevel)
+	}
+
+	handlerLevel := slogLevel
+	if options.levels != nil {
+		options.levels.SetLevel("", slogLevel)
+		// records are filtered by the level handler
+		handlerLevel = LevelTrace
+	}
+
+	var handler slog.Handler
 	switch logFormat {
 	case "json":
-		return slog.New(&ArcContextHandler{slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
-			Level:       slogLevel,
+		handler = slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+			Level:       handlerLevel,
 			ReplaceAttr: replaceAttr,
-		},
-		)}), nil
+		})
 	case "text":
-		return slog.New(&ArcContextHandler{slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-			Level:       slogLevel,
+		handler = slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+			Level:       handlerLevel,
 			ReplaceAttr: replaceAttr,
-		})}), nil
+		})
 	case "tint":
-		return slog.New(&ArcContextHandler{tint.NewHandler(os.Stdout, &tint.Options{
-			Level:       slogLevel,
+		handler = tint.NewHandler(os.Stdout, &tint.Options{
+			Level:       handlerLevel,
 			ReplaceAttr: replaceAttr,
-		})}), nil
+		})
 	default:
+		return nil, errors.Join(ErrLoggerInvalidLogFormat, fmt.Errorf("log format: %s", logFormat))
+	}
+
+	if options.levels != nil {
+		handler = LevelHandler{Handler: handler, levels: options.levels, sampler: options.sampler}
 	}
 
-	return nil, errors.Join(ErrLoggerInvalidLogFormat, fmt.Errorf("log format: %s", logFormat))
+	return slog.New(&ArcContextHandler{handler}), nil
 }
 
 // replaceAttr inspired by https://go.dev/src/log/slog/example_custom_levels_test.go
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type samplerKey struct {
+	component string
+	level     slog.Level
+	message   string
+}
+
+// sampler limits records with the same component, level and message to the first `first` records per interval and
+// every `thereafter`th record after that. Warnings and errors are never sampled.
+type sampler struct {
+	mu          sync.Mutex
+	interval    time.Duration
+	first       uint64
+	thereafter  uint64
+	windowStart time.Time
+	counts      map[samplerKey]uint64
+	now         func() time.Time
+}
+
+func newSampler(interval time.Duration, first uint64, thereafter uint64) *sampler {
+	return &sampler{
+		interval:   interval,
+		first:      first,
+		thereafter: thereafter,
+		counts:     map[samplerKey]uint64{},
+		now:        time.Now,
+	}
+}
+
+func (s *sampler) allow(component string, r slog.Record) bool {
+	if r.Level >= LevelWarning {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.windowStart) >= s.interval {
+		s.windowStart = now
+		clear(s.counts)
+	}
+
+	key := samplerKey{component: component, level: r.Level, message: r.Message}
+	s.counts[key]++
+	count := s.counts[key]
+
+	if count <= s.first {
+		return true
+	}
+
+	return s.thereafter > 0 && (count-s.first)%s.thereafter == 0
+}
@@ -0,0 +1,305 @@
+// Package memlimit throttles the processors of ARC when the process approaches its memory limit, so that they shrink
+// their batch sizes and pause prefetching of new work instead of getting OOM-killed, e.g. in the middle of a block.
+package memlimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	checkIntervalDefault   = time.Second
+	shrinkThresholdDefault = 0.8
+	pauseThresholdDefault  = 0.9
+
+	// shrinkDivisor is the divisor of the batch sizes while the memory usage is above the shrink threshold
+	shrinkDivisor = 4
+
+	totalMetric    = "/memory/classes/total:bytes"
+	releasedMetric = "/memory/classes/heap/released:bytes"
+)
+
+// Level is the throttling level of the guard.
+type Level int32
+
+const (
+	LevelNormal Level = iota
+	LevelShrink
+	LevelPause
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelShrink:
+		return "shrink"
+	case LevelPause:
+		return "pause"
+	default:
+		return "normal"
+	}
+}
+
+// Guard periodically compares the memory used by the Go runtime with the memory limit of the process, which is set by
+// GOMEMLIMIT or debug.SetMemoryLimit. Above the shrink threshold processors shrink their batch sizes, above the pause
+// threshold they additionally pause prefetching of new work until the memory usage drops below the pause threshold.
+// If no memory limit is set, the guard never throttles. All methods can be called on a nil guard, which never
+// throttles.
+type Guard struct {
+	logger          *slog.Logger
+	checkInterval   time.Duration
+	shrinkThreshold float64
+	pauseThreshold  float64
+	limit           func() int64
+	usage           func() uint64
+	now             func() time.Time
+
+	level     atomic.Int32
+	ratio     atomic.Uint64
+	lastCheck time.Time
+	since     time.Time
+
+	mu       sync.Mutex
+	resumeCh chan struct{}
+
+	throttledNanos [LevelPause + 1]atomic.Int64
+
+	usageRatioDesc       *prometheus.Desc
+	throttledSecondsDesc *prometheus.Desc
+
+	cancelAll context.CancelFunc
+	ctx       context.Context
+	waitGroup *sync.WaitGroup
+}
+
+func WithCheckInterval(d time.Duration) func(*Guard) {
+	return func(g *Guard) {
+		g.checkInterval = d
+	}
+}
+
+// WithShrinkThreshold sets the share of the memory limit above which batch sizes are shrunk.
+func WithShrinkThreshold(threshold float64) func(*Guard) {
+	return func(g *Guard) {
+		g.shrinkThreshold = threshold
+	}
+}
+
+// WithPauseThreshold sets the share of the memory limit above which prefetching is paused.
+func WithPauseThreshold(threshold float64) func(*Guard) {
+	return func(g *Guard) {
+		g.pauseThreshold = threshold
+	}
+}
+
+// WithMemoryUsage replaces the functions returning the memory limit and the memory usage of the process.
+func WithMemoryUsage(limit func() int64, usage func() uint64) func(*Guard) {
+	return func(g *Guard) {
+		g.limit = limit
+		g.usage = usage
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Guard) {
+	return func(g *Guard) {
+		g.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Guard)) *Guard {
+	g := &Guard{
+		logger:          logger.With(slog.String("module", "memlimit")),
+		checkInterval:   checkIntervalDefault,
+		shrinkThreshold: shrinkThresholdDefault,
+		pauseThreshold:  pauseThresholdDefault,
+		limit:           func() int64 { return debug.SetMemoryLimit(-1) },
+		usage:           runtimeMemoryUsage,
+		now:             time.Now,
+		resumeCh:        make(chan struct{}),
+
+		usageRatioDesc: prometheus.NewDesc("arc_memory_limit_usage_ratio",
+			"Memory used by the Go runtime relative to the memory limit of the process",
+			nil, nil,
+		),
+		throttledSecondsDesc: prometheus.NewDesc("arc_memory_limit_throttled_seconds_total",
+			"Time the processors have been throttled due to the memory usage by throttling level",
+			[]string{"level"}, nil,
+		),
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	g.ctx, g.cancelAll = context.WithCancel(context.Background())
+
+	return g
+}
+
+// runtimeMemoryUsage returns the memory used by the Go runtime as accounted against the memory limit.
+func runtimeMemoryUsage() uint64 {
+	samples := []metrics.Sample{{Name: totalMetric}, {Name: releasedMetric}}
+	metrics.Read(samples)
+
+	if samples[0].Value.Kind() != metrics.KindUint64 || samples[1].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+
+	return samples[0].Value.Uint64() - samples[1].Value.Uint64()
+}
+
+func (g *Guard) Start() {
+	limit := g.limit()
+	if limit == math.MaxInt64 {
+		g.logger.Warn("No memory limit set - processors are not throttled on high memory usage")
+	} else {
+		g.logger.Info("Starting memory limit guard", slog.Int64("limit", limit), slog.Float64("shrinkThreshold", g.shrinkThreshold), slog.Float64("pauseThreshold", g.pauseThreshold))
+	}
+
+	g.lastCheck = g.now()
+
+	g.waitGroup.Add(1)
+	go func() {
+		defer g.waitGroup.Done()
+
+		ticker := time.NewTicker(g.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-g.ctx.Done():
+				return
+			case <-ticker.C:
+				g.check()
+			}
+		}
+	}()
+}
+
+func (g *Guard) check() {
+	now := g.now()
+	current := g.Level()
+	if current != LevelNormal {
+		g.throttledNanos[current].Add(int64(now.Sub(g.lastCheck)))
+	}
+	g.lastCheck = now
+
+	var ratio float64
+	if limit := g.limit(); limit > 0 && limit != math.MaxInt64 {
+		ratio = float64(g.usage()) / float64(limit)
+	}
+	g.ratio.Store(math.Float64bits(ratio))
+
+	next := LevelNormal
+	switch {
+	case ratio >= g.pauseThreshold:
+		next = LevelPause
+	case ratio >= g.shrinkThreshold:
+		next = LevelShrink
+	}
+
+	if next == current {
+		return
+	}
+
+	g.setLevel(next)
+
+	switch next {
+	case LevelPause:
+		g.logger.Warn("Memory usage approaching memory limit - pausing prefetching", slog.Float64("usageRatio", ratio))
+	case LevelShrink:
+		g.logger.Warn("Memory usage approaching memory limit - shrinking batch sizes", slog.Float64("usageRatio", ratio))
+	default:
+		g.logger.Info("Memory usage below memory limit thresholds - throttling ended", slog.Float64("usageRatio", ratio), slog.String("duration", now.Sub(g.since).String()))
+	}
+
+	if current == LevelNormal {
+		g.since = now
+	}
+}
+
+func (g *Guard) setLevel(level Level) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	previous := Level(g.level.Swap(int32(level)))
+	if previous == LevelPause && level != LevelPause {
+		// resume the processors waiting while paused
+		close(g.resumeCh)
+		g.resumeCh = make(chan struct{})
+	}
+}
+
+// Level returns the current throttling level.
+func (g *Guard) Level() Level {
+	if g == nil {
+		return LevelNormal
+	}
+
+	return Level(g.level.Load())
+}
+
+// BatchSize returns the batch size to be used instead of the configured batch size, which is shrunk while the memory
+// usage is above the shrink threshold.
+func (g *Guard) BatchSize(size int) int {
+	if g.Level() == LevelNormal {
+		return size
+	}
+
+	return max(1, size/shrinkDivisor)
+}
+
+// WaitWhilePaused blocks while the memory usage is above the pause threshold. It returns the error of the context if
+// the context is done before.
+func (g *Guard) WaitWhilePaused(ctx context.Context) error {
+	if g == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	if Level(g.level.Load()) != LevelPause {
+		g.mu.Unlock()
+		return nil
+	}
+	resumeCh := g.resumeCh
+	g.mu.Unlock()
+
+	select {
+	case <-resumeCh:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-g.ctx.Done():
+		return nil
+	}
+}
+
+// Describe writes all descriptors to the prometheus desc channel.
+func (g *Guard) Describe(ch chan<- *prometheus.Desc) {
+	ch <- g.usageRatioDesc
+	ch <- g.throttledSecondsDesc
+}
+
+// Collect implements required collect function for all prometheus collectors
+func (g *Guard) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(g.usageRatioDesc, prometheus.GaugeValue, math.Float64frombits(g.ratio.Load()))
+
+	for _, level := range []Level{LevelShrink, LevelPause} {
+		seconds := time.Duration(g.throttledNanos[level].Load()).Seconds()
+		ch <- prometheus.MustNewConstMetric(g.throttledSecondsDesc, prometheus.CounterValue, seconds, level.String())
+	}
+}
+
+// Shutdown stops the guard and resumes all processors waiting while paused.
+func (g *Guard) Shutdown() {
+	g.cancelAll()
+	g.waitGroup.Wait()
+}
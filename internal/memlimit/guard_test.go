@@ -0,0 +1,96 @@
+package memlimit_test
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/memlimit"
+)
+
+func TestGuard(t *testing.T) {
+	t.Run("shrink, pause and resume", func(t *testing.T) {
+		// given
+		var usage atomic.Uint64
+		usage.Store(500)
+
+		sut := memlimit.New(slog.Default(),
+			memlimit.WithCheckInterval(5*time.Millisecond),
+			memlimit.WithMemoryUsage(func() int64 { return 1000 }, usage.Load),
+		)
+		sut.Start()
+		defer sut.Shutdown()
+
+		// when
+		time.Sleep(20 * time.Millisecond)
+
+		// then
+		require.Equal(t, memlimit.LevelNormal, sut.Level())
+		require.Equal(t, 100, sut.BatchSize(100))
+		require.NoError(t, sut.WaitWhilePaused(context.Background()))
+
+		// shrink batch sizes
+		usage.Store(850)
+		require.Eventually(t, func() bool { return sut.Level() == memlimit.LevelShrink }, time.Second, time.Millisecond)
+		require.Equal(t, 25, sut.BatchSize(100))
+		require.Equal(t, 1, sut.BatchSize(2))
+		require.NoError(t, sut.WaitWhilePaused(context.Background()))
+
+		// pause
+		usage.Store(950)
+		require.Eventually(t, func() bool { return sut.Level() == memlimit.LevelPause }, time.Second, time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, sut.WaitWhilePaused(ctx), context.DeadlineExceeded)
+
+		resumed := make(chan error)
+		go func() {
+			resumed <- sut.WaitWhilePaused(context.Background())
+		}()
+
+		// resume
+		usage.Store(100)
+		select {
+		case err := <-resumed:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("not resumed")
+		}
+		require.Equal(t, memlimit.LevelNormal, sut.Level())
+
+		assert.Equal(t, 3, testutil.CollectAndCount(sut))
+	})
+
+	t.Run("no memory limit", func(t *testing.T) {
+		// given
+		sut := memlimit.New(slog.Default(),
+			memlimit.WithCheckInterval(5*time.Millisecond),
+			memlimit.WithMemoryUsage(func() int64 { return int64(^uint64(0) >> 1) }, func() uint64 { return 1 << 40 }),
+		)
+		sut.Start()
+		defer sut.Shutdown()
+
+		// when
+		time.Sleep(20 * time.Millisecond)
+
+		// then
+		require.Equal(t, memlimit.LevelNormal, sut.Level())
+	})
+
+	t.Run("nil guard", func(t *testing.T) {
+		// given
+		var sut *memlimit.Guard
+
+		// then
+		require.Equal(t, memlimit.LevelNormal, sut.Level())
+		require.Equal(t, 100, sut.BatchSize(100))
+		require.NoError(t, sut.WaitWhilePaused(context.Background()))
+	})
+}
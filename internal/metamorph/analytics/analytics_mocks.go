@@ -0,0 +1,4 @@
+package analytics
+
+//go:generate moq -pkg mocks -out ./mocks/writer_mock.go . Writer
+//go:generate moq -pkg mocks -out ./mocks/backfill_store_mock.go . BackfillStore
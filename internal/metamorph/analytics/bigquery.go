@@ -0,0 +1,286 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+)
+
+const (
+	bigQueryEndpointDefault = "https://bigquery.googleapis.com/bigquery/v2"
+	tokenTTLDefault         = 30 * time.Minute
+	tokenCommandTimeout     = 30 * time.Second
+)
+
+var (
+	ErrNoTokenCommand     = errors.New("no token command given")
+	ErrTokenCommandFailed = errors.New("token command failed")
+	ErrInsertErrors       = errors.New("events were rejected by BigQuery")
+
+	bigQuerySchema = []bigQueryField{
+		{Name: "txid", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "txStatus", Type: "STRING", Mode: "REQUIRED"},
+		{Name: "timestamp", Type: "TIMESTAMP", Mode: "REQUIRED"},
+		{Name: "blockHash", Type: "STRING", Mode: "NULLABLE"},
+		{Name: "blockHeight", Type: "INTEGER", Mode: "NULLABLE"},
+		{Name: "minedTxid", Type: "STRING", Mode: "NULLABLE"},
+		{Name: "rejectReason", Type: "STRING", Mode: "NULLABLE"},
+		{Name: "competingTxs", Type: "STRING", Mode: "REPEATED"},
+	}
+)
+
+type bigQueryField struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Mode string `json:"mode"`
+}
+
+type bigQueryTable struct {
+	TableReference struct {
+		ProjectID string `json:"projectId"`
+		DatasetID string `json:"datasetId"`
+		TableID   string `json:"tableId"`
+	} `json:"tableReference"`
+	Schema struct {
+		Fields []bigQueryField `json:"fields"`
+	} `json:"schema"`
+	TimePartitioning struct {
+		Type  string `json:"type"`
+		Field string `json:"field"`
+	} `json:"timePartitioning"`
+	Clustering struct {
+		Fields []string `json:"fields"`
+	} `json:"clustering"`
+}
+
+type bigQueryInsertRow struct {
+	InsertID string              `json:"insertId"`
+	JSON     status_export.Event `json:"json"`
+}
+
+type bigQueryInsertResponse struct {
+	InsertErrors []struct {
+		Index  int `json:"index"`
+		Errors []struct {
+			Reason  string `json:"reason"`
+			Message string `json:"message"`
+		} `json:"errors"`
+	} `json:"insertErrors"`
+}
+
+// BigQueryWriter inserts the events into a BigQuery table with the streaming API of BigQuery. The access token is
+// printed by a command, e.g. `gcloud auth print-access-token`, and reused for the token TTL. Each event has an insert
+// id, so that events inserted more than once are deduplicated by BigQuery on a best effort basis.
+type BigQueryWriter struct {
+	client       *httpclient.Client
+	timeout      time.Duration
+	endpoint     string
+	project      string
+	dataset      string
+	table        string
+	tokenCommand []string
+	tokenTTL     time.Duration
+	now          func() time.Time
+
+	mu           sync.Mutex
+	token        string
+	tokenExpires time.Time
+}
+
+// WithBigQueryEndpoint sets the URL of the BigQuery API.
+func WithBigQueryEndpoint(endpoint string) func(*BigQueryWriter) {
+	return func(w *BigQueryWriter) {
+		w.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+func WithBigQueryTimeout(d time.Duration) func(*BigQueryWriter) {
+	return func(w *BigQueryWriter) {
+		w.timeout = d
+	}
+}
+
+func WithTokenTTL(d time.Duration) func(*BigQueryWriter) {
+	return func(w *BigQueryWriter) {
+		w.tokenTTL = d
+	}
+}
+
+func NewBigQueryWriter(project string, dataset string, table string, tokenCommand []string, opts ...func(*BigQueryWriter)) (*BigQueryWriter, error) {
+	if len(tokenCommand) == 0 {
+		return nil, ErrNoTokenCommand
+	}
+
+	if !identifierRegex.MatchString(dataset) || !identifierRegex.MatchString(table) {
+		return nil, errors.Join(ErrInvalidIdentifier, fmt.Errorf("dataset: %s, table: %s", dataset, table))
+	}
+
+	w := &BigQueryWriter{
+		timeout:      requestTimeoutDefault,
+		endpoint:     bigQueryEndpointDefault,
+		project:      project,
+		dataset:      dataset,
+		table:        table,
+		tokenCommand: tokenCommand,
+		tokenTTL:     tokenTTLDefault,
+		now:          time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	w.client = httpclient.New("bigquery", httpclient.WithTimeout(w.timeout))
+
+	return w, nil
+}
+
+// EnsureTable creates the table partitioned by day of the event timestamp if it does not exist yet.
+func (w *BigQueryWriter) EnsureTable(ctx context.Context) error {
+	tablesURL := fmt.Sprintf("%s/projects/%s/datasets/%s/tables", w.endpoint, w.project, w.dataset)
+
+	resp, err := w.do(ctx, http.MethodGet, tablesURL+"/"+w.table, nil)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		return checkResponse(resp)
+	}
+	_ = resp.Body.Close()
+
+	var table bigQueryTable
+	table.TableReference.ProjectID = w.project
+	table.TableReference.DatasetID = w.dataset
+	table.TableReference.TableID = w.table
+	table.Schema.Fields = bigQuerySchema
+	table.TimePartitioning.Type = "DAY"
+	table.TimePartitioning.Field = "timestamp"
+	table.Clustering.Fields = []string{"txStatus", "txid"}
+
+	resp, err = w.do(ctx, http.MethodPost, tablesURL, table)
+	if err != nil {
+		return err
+	}
+
+	// the table may have been created by another instance in the meantime
+	if resp.StatusCode == http.StatusConflict {
+		_ = resp.Body.Close()
+		return nil
+	}
+
+	return checkResponse(resp)
+}
+
+func (w *BigQueryWriter) Insert(ctx context.Context, events []status_export.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]bigQueryInsertRow, 0, len(events))
+	for _, event := range events {
+		rows = append(rows, bigQueryInsertRow{
+			InsertID: fmt.Sprintf("%s-%s-%d", event.TxID, event.Status, event.Timestamp.UnixMilli()),
+			JSON:     event,
+		})
+	}
+
+	insertURL := fmt.Sprintf("%s/projects/%s/datasets/%s/tables/%s/insertAll", w.endpoint, w.project, w.dataset, w.table)
+	resp, err := w.do(ctx, http.MethodPost, insertURL, map[string]any{"rows": rows})
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return checkResponse(resp)
+	}
+	defer resp.Body.Close()
+
+	var insertResponse bigQueryInsertResponse
+	err = json.NewDecoder(resp.Body).Decode(&insertResponse)
+	if err != nil {
+		return errors.Join(ErrRequestFailed, err)
+	}
+
+	if len(insertResponse.InsertErrors) > 0 {
+		first := insertResponse.InsertErrors[0]
+		reason := ""
+		if len(first.Errors) > 0 {
+			reason = first.Errors[0].Reason + ": " + first.Errors[0].Message
+		}
+		return errors.Join(ErrInsertErrors, fmt.Errorf("rejected: %d, first error: %s", len(insertResponse.InsertErrors), reason))
+	}
+
+	return nil
+}
+
+func (w *BigQueryWriter) do(ctx context.Context, method string, url string, payload any) (*http.Response, error) {
+	token, err := w.accessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var body bytes.Buffer
+	if payload != nil {
+		err = json.NewEncoder(&body).Encode(payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(ErrRequestFailed, err)
+	}
+
+	return resp, nil
+}
+
+// accessToken returns the access token printed by the token command, the token is reused until the token TTL passed.
+func (w *BigQueryWriter) accessToken(ctx context.Context) (string, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.token != "" && w.now().Before(w.tokenExpires) {
+		return w.token, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, w.tokenCommand[0], w.tokenCommand[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return "", errors.Join(ErrTokenCommandFailed, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String())))
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", errors.Join(ErrTokenCommandFailed, errors.New("token command printed no token"))
+	}
+
+	w.token = token
+	w.tokenExpires = w.now().Add(w.tokenTTL)
+
+	return token, nil
+}
@@ -0,0 +1,115 @@
+package analytics_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/analytics"
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+)
+
+func TestBigQueryWriter(t *testing.T) {
+	tt := []struct {
+		name         string
+		tableExists  bool
+		insertErrors bool
+		tokenCommand []string
+
+		expectedErrorStr string
+		expectedCreated  bool
+	}{
+		{
+			name:         "success - table exists",
+			tableExists:  true,
+			tokenCommand: []string{"echo", "token"},
+		},
+		{
+			name:         "success - table created",
+			tokenCommand: []string{"echo", "token"},
+
+			expectedCreated: true,
+		},
+		{
+			name:         "error - insert errors",
+			tableExists:  true,
+			insertErrors: true,
+			tokenCommand: []string{"echo", "token"},
+
+			expectedErrorStr: analytics.ErrInsertErrors.Error(),
+		},
+		{
+			name:         "error - token command failed",
+			tableExists:  true,
+			tokenCommand: []string{"false"},
+
+			expectedErrorStr: analytics.ErrTokenCommandFailed.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			created := false
+			var insertIDs []string
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /projects/project/datasets/arc/tables/status_events", func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+				if !tc.tableExists {
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+			mux.HandleFunc("POST /projects/project/datasets/arc/tables", func(w http.ResponseWriter, r *http.Request) {
+				var table map[string]any
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&table))
+				require.Contains(t, table, "schema")
+				created = true
+			})
+			mux.HandleFunc("POST /projects/project/datasets/arc/tables/status_events/insertAll", func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					Rows []struct {
+						InsertID string `json:"insertId"`
+					} `json:"rows"`
+				}
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+				for _, row := range req.Rows {
+					insertIDs = append(insertIDs, row.InsertID)
+				}
+
+				if tc.insertErrors {
+					_, _ = w.Write([]byte(`{"insertErrors":[{"index":0,"errors":[{"reason":"invalid","message":"no such field"}]}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{}`))
+			})
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			sut, err := analytics.NewBigQueryWriter("project", "arc", "status_events", tc.tokenCommand, analytics.WithBigQueryEndpoint(server.URL))
+			require.NoError(t, err)
+
+			// when
+			err = sut.EnsureTable(context.Background())
+			if err == nil {
+				err = sut.Insert(context.Background(), []status_export.Event{
+					{TxID: "tx1", Status: "MINED", Timestamp: time.UnixMilli(1767268800000).UTC()},
+				})
+			}
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expectedCreated, created)
+			require.Equal(t, []string{"tx1-MINED-1767268800000"}, insertIDs)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+)
+
+const (
+	requestTimeoutDefault = 30 * time.Second
+	maxErrorBodySize      = 1024
+
+	clickHouseCreateTableQuery = `CREATE TABLE IF NOT EXISTS %s.%s (
+	txid String,
+	txStatus LowCardinality(String),
+	timestamp DateTime64(3, 'UTC'),
+	blockHash String,
+	blockHeight UInt64,
+	minedTxid String,
+	rejectReason String,
+	competingTxs Array(String)
+) ENGINE = ReplacingMergeTree
+PARTITION BY toYYYYMM(timestamp)
+ORDER BY (txid, txStatus, timestamp)`
+)
+
+var (
+	ErrInvalidIdentifier = errors.New("invalid name of database, dataset or table")
+	ErrRequestFailed     = errors.New("request to analytics database failed")
+
+	identifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// ClickHouseWriter inserts the events into a ClickHouse table with the HTTP interface of ClickHouse. Events are sent
+// in the JSONEachRow format, the table is a ReplacingMergeTree which deduplicates events inserted more than once.
+type ClickHouseWriter struct {
+	client   *httpclient.Client
+	timeout  time.Duration
+	url      string
+	database string
+	table    string
+	user     string
+	password string
+}
+
+func WithClickHouseCredentials(user string, password string) func(*ClickHouseWriter) {
+	return func(w *ClickHouseWriter) {
+		w.user = user
+		w.password = password
+	}
+}
+
+func WithClickHouseTimeout(d time.Duration) func(*ClickHouseWriter) {
+	return func(w *ClickHouseWriter) {
+		w.timeout = d
+	}
+}
+
+// NewClickHouseWriter creates a writer for the table in the database of the ClickHouse server at the URL of its HTTP
+// interface, e.g. `http://localhost:8123`.
+func NewClickHouseWriter(serverURL string, database string, table string, opts ...func(*ClickHouseWriter)) (*ClickHouseWriter, error) {
+	if !identifierRegex.MatchString(database) || !identifierRegex.MatchString(table) {
+		return nil, errors.Join(ErrInvalidIdentifier, fmt.Errorf("database: %s, table: %s", database, table))
+	}
+
+	w := &ClickHouseWriter{
+		timeout:  requestTimeoutDefault,
+		url:      strings.TrimSuffix(serverURL, "/"),
+		database: database,
+		table:    table,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	w.client = httpclient.New("clickhouse", httpclient.WithTimeout(w.timeout))
+
+	return w, nil
+}
+
+func (w *ClickHouseWriter) EnsureTable(ctx context.Context) error {
+	return w.query(ctx, fmt.Sprintf(clickHouseCreateTableQuery, w.database, w.table), nil)
+}
+
+func (w *ClickHouseWriter) Insert(ctx context.Context, events []status_export.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, event := range events {
+		err := encoder.Encode(event)
+		if err != nil {
+			return err
+		}
+	}
+
+	return w.query(ctx, fmt.Sprintf("INSERT INTO %s.%s FORMAT JSONEachRow", w.database, w.table), &body)
+}
+
+func (w *ClickHouseWriter) query(ctx context.Context, query string, body io.Reader) error {
+	params := url.Values{}
+	params.Set("query", query)
+	// the timestamps of the events are encoded in RFC 3339
+	params.Set("date_time_input_format", "best_effort")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url+"/?"+params.Encode(), body)
+	if err != nil {
+		return err
+	}
+
+	if w.user != "" {
+		req.Header.Set("X-ClickHouse-User", w.user)
+		req.Header.Set("X-ClickHouse-Key", w.password)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return errors.Join(ErrRequestFailed, err)
+	}
+
+	return checkResponse(resp)
+}
+
+// checkResponse closes the body of the response and returns an error with the beginning of the body if the request
+// was not successful.
+func checkResponse(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	return errors.Join(ErrRequestFailed, fmt.Errorf("status: %d, response: %s", resp.StatusCode, strings.TrimSpace(string(msg))))
+}
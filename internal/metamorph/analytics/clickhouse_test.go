@@ -0,0 +1,99 @@
+package analytics_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/analytics"
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+)
+
+func TestClickHouseWriter(t *testing.T) {
+	tt := []struct {
+		name       string
+		table      string
+		statusCode int
+
+		expectedNewErrorStr string
+		expectedErrorStr    string
+	}{
+		{
+			name:       "success",
+			table:      "status_events",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "error - request failed",
+			table:      "status_events",
+			statusCode: http.StatusInternalServerError,
+
+			expectedErrorStr: analytics.ErrRequestFailed.Error(),
+		},
+		{
+			name:  "error - invalid table name",
+			table: "status_events; DROP TABLE transactions",
+
+			expectedNewErrorStr: analytics.ErrInvalidIdentifier.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var queries []string
+			var rows []status_export.Event
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				require.Equal(t, "arc", r.Header.Get("X-ClickHouse-User"))
+				require.Equal(t, "secret", r.Header.Get("X-ClickHouse-Key"))
+
+				queries = append(queries, r.URL.Query().Get("query"))
+				scanner := bufio.NewScanner(r.Body)
+				for scanner.Scan() {
+					var event status_export.Event
+					require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+					rows = append(rows, event)
+				}
+
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			sut, err := analytics.NewClickHouseWriter(server.URL, "arc", tc.table, analytics.WithClickHouseCredentials("arc", "secret"))
+			if tc.expectedNewErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedNewErrorStr)
+				return
+			}
+			require.NoError(t, err)
+
+			// when
+			ensureErr := sut.EnsureTable(context.Background())
+			insertErr := sut.Insert(context.Background(), []status_export.Event{
+				{TxID: "tx1", Status: "MINED", Timestamp: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC), BlockHeight: 100},
+				{TxID: "tx2", Status: "REJECTED", Timestamp: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)},
+			})
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, ensureErr, tc.expectedErrorStr)
+				require.ErrorContains(t, insertErr, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, ensureErr)
+			require.NoError(t, insertErr)
+
+			require.Len(t, queries, 2)
+			require.True(t, strings.HasPrefix(queries[0], "CREATE TABLE IF NOT EXISTS arc.status_events"))
+			require.Equal(t, "INSERT INTO arc.status_events FORMAT JSONEachRow", queries[1])
+			require.Len(t, rows, 2)
+			require.Equal(t, uint64(100), rows[0].BlockHeight)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const (
+	batchSizeDefault     = 500
+	flushIntervalDefault = 5 * time.Second
+	bufferSizeDefault    = 100_000
+	backfillBatchSize    = 1000
+)
+
+var (
+	ErrBufferFull          = errors.New("analytics buffer is full, events are dropped")
+	ErrFailedToEnsureTable = errors.New("failed to ensure analytics table")
+	ErrFailedToInsert      = errors.New("failed to insert events into analytics table")
+	ErrFailedToBackfill    = errors.New("failed to backfill analytics table")
+)
+
+// Writer writes the events to an analytics database.
+type Writer interface {
+	// EnsureTable creates the table of the events if it does not exist yet.
+	EnsureTable(ctx context.Context) error
+	Insert(ctx context.Context, events []status_export.Event) error
+}
+
+// BackfillStore returns the stored transactions for the backfill.
+type BackfillStore interface {
+	GetStoredBetween(ctx context.Context, from time.Time, to time.Time, limit int64, offset int64) ([]*store.Data, error)
+}
+
+// Sink streams the status change events of transactions into an analytics database such as BigQuery or ClickHouse.
+// Events are buffered and inserted in batches, so that a slow or unavailable database does not slow down the
+// processing of the transactions. If the buffer is full, events are dropped.
+type Sink struct {
+	logger        *slog.Logger
+	writer        Writer
+	batchSize     int
+	flushInterval time.Duration
+	now           func() time.Time
+
+	backfillStore BackfillStore
+	backfillSince time.Duration
+
+	eventsCh  chan status_export.Event
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithBatchSize(size int) func(*Sink) {
+	return func(s *Sink) {
+		s.batchSize = size
+	}
+}
+
+func WithFlushInterval(d time.Duration) func(*Sink) {
+	return func(s *Sink) {
+		s.flushInterval = d
+	}
+}
+
+func WithBufferSize(size int) func(*Sink) {
+	return func(s *Sink) {
+		s.eventsCh = make(chan status_export.Event, size)
+	}
+}
+
+// WithBackfill configures the sink to insert the events of the transactions stored within the given duration before
+// the start from their status history.
+func WithBackfill(backfillStore BackfillStore, since time.Duration) func(*Sink) {
+	return func(s *Sink) {
+		s.backfillStore = backfillStore
+		s.backfillSince = since
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Sink) {
+	return func(s *Sink) {
+		s.now = nowFunc
+	}
+}
+
+func NewSink(logger *slog.Logger, writer Writer, opts ...func(*Sink)) *Sink {
+	s := &Sink{
+		logger:        logger.With(slog.String("module", "analytics")),
+		writer:        writer,
+		batchSize:     batchSizeDefault,
+		flushInterval: flushIntervalDefault,
+		now:           time.Now,
+		eventsCh:      make(chan status_export.Event, bufferSizeDefault),
+		waitGroup:     &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	s.cancelAll = cancelAll
+	s.ctx = ctx
+
+	return s
+}
+
+// Export buffers a status change event for each of the transactions.
+func (s *Sink) Export(data []*store.Data) error {
+	timestamp := s.now().UTC()
+
+	dropped := 0
+	for _, d := range data {
+		select {
+		case s.eventsCh <- status_export.NewEvent(d, timestamp):
+		default:
+			dropped++
+		}
+	}
+
+	if dropped > 0 {
+		return errors.Join(ErrBufferFull, fmt.Errorf("dropped events: %d", dropped))
+	}
+
+	return nil
+}
+
+// Start ensures the table of the events and inserts the buffered events every flush interval or once a batch is
+// complete. The backfill, if configured, runs alongside.
+func (s *Sink) Start() error {
+	err := s.writer.EnsureTable(s.ctx)
+	if err != nil {
+		return errors.Join(ErrFailedToEnsureTable, err)
+	}
+
+	ticker := time.NewTicker(s.flushInterval)
+	s.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			s.waitGroup.Done()
+		}()
+
+		batch := make([]status_export.Event, 0, s.batchSize)
+		for {
+			select {
+			case <-s.ctx.Done():
+				s.drain(batch)
+				return
+			case event := <-s.eventsCh:
+				batch = append(batch, event)
+				if len(batch) < s.batchSize {
+					continue
+				}
+			case <-ticker.C:
+				if len(batch) == 0 {
+					continue
+				}
+			}
+
+			s.insert(s.ctx, batch)
+			batch = batch[:0]
+		}
+	}()
+
+	if s.backfillStore != nil {
+		s.waitGroup.Add(1)
+		go func() {
+			defer s.waitGroup.Done()
+
+			to := s.now()
+			inserted, err := s.Backfill(s.ctx, to.Add(-1*s.backfillSince), to)
+			if err != nil {
+				s.logger.Error("Failed to backfill analytics table", slog.Int("inserted", inserted), slog.String("err", err.Error()))
+				return
+			}
+
+			s.logger.Info("Backfilled analytics table", slog.Int("inserted", inserted))
+		}()
+	}
+
+	return nil
+}
+
+// drain inserts the events which are still buffered on shutdown.
+func (s *Sink) drain(batch []status_export.Event) {
+	for {
+		select {
+		case event := <-s.eventsCh:
+			batch = append(batch, event)
+		default:
+			for len(batch) > 0 {
+				n := min(len(batch), s.batchSize)
+				s.insert(context.Background(), batch[:n])
+				batch = batch[n:]
+			}
+			return
+		}
+	}
+}
+
+func (s *Sink) insert(ctx context.Context, batch []status_export.Event) {
+	err := s.writer.Insert(ctx, batch)
+	if err != nil {
+		s.logger.Error("Failed to insert events", slog.Int("count", len(batch)), slog.String("err", errors.Join(ErrFailedToInsert, err).Error()))
+	}
+}
+
+// Backfill inserts the events of the status history of the transactions stored in the time range. Events which were
+// already inserted are deduplicated by the analytics database.
+func (s *Sink) Backfill(ctx context.Context, from time.Time, to time.Time) (int, error) {
+	inserted := 0
+
+	for offset := int64(0); ; offset += backfillBatchSize {
+		data, err := s.backfillStore.GetStoredBetween(ctx, from, to, backfillBatchSize, offset)
+		if err != nil {
+			return inserted, errors.Join(ErrFailedToBackfill, err)
+		}
+
+		events := make([]status_export.Event, 0, len(data))
+		for _, d := range data {
+			events = append(events, historyEvents(d)...)
+		}
+
+		if len(events) > 0 {
+			err = s.writer.Insert(ctx, events)
+			if err != nil {
+				return inserted, errors.Join(ErrFailedToBackfill, err)
+			}
+			inserted += len(events)
+		}
+
+		if len(data) < backfillBatchSize {
+			return inserted, nil
+		}
+	}
+}
+
+// historyEvents returns the events of the status history of the transaction followed by the event of its current
+// status.
+func historyEvents(data *store.Data) []status_export.Event {
+	events := make([]status_export.Event, 0, len(data.StatusHistory)+1)
+
+	for _, sh := range data.StatusHistory {
+		if sh == nil || sh.Status == data.Status {
+			continue
+		}
+
+		event := status_export.NewEvent(&store.Data{Hash: data.Hash, Status: sh.Status}, sh.Timestamp.UTC())
+		events = append(events, event)
+	}
+
+	timestamp := data.LastModified
+	if timestamp.IsZero() {
+		timestamp = data.StoredAt
+	}
+
+	return append(events, status_export.NewEvent(data, timestamp.UTC()))
+}
+
+func (s *Sink) Shutdown() {
+	s.cancelAll()
+	s.waitGroup.Wait()
+}
@@ -0,0 +1,170 @@
+package analytics_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/analytics"
+	"github.com/bitcoin-sv/arc/internal/metamorph/analytics/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestSink_Start(t *testing.T) {
+	tt := []struct {
+		name           string
+		batchSize      int
+		bufferSize     int
+		ensureTableErr error
+
+		expectedErrorStr       string
+		expectedExportErrorStr string
+		expectedInserted       int
+	}{
+		{
+			name:       "success - batch size reached",
+			batchSize:  2,
+			bufferSize: 10,
+
+			expectedInserted: 3,
+		},
+		{
+			name:       "success - events inserted on shutdown",
+			batchSize:  10,
+			bufferSize: 10,
+
+			expectedInserted: 3,
+		},
+		{
+			name:       "error - buffer full",
+			batchSize:  10,
+			bufferSize: 2,
+
+			expectedExportErrorStr: analytics.ErrBufferFull.Error(),
+			expectedInserted:       2,
+		},
+		{
+			name:           "error - failed to ensure table",
+			batchSize:      10,
+			bufferSize:     10,
+			ensureTableErr: errors.New("connection refused"),
+
+			expectedErrorStr: analytics.ErrFailedToEnsureTable.Error(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var mu sync.Mutex
+			var inserted []status_export.Event
+			writer := &mocks.WriterMock{
+				EnsureTableFunc: func(_ context.Context) error {
+					return tc.ensureTableErr
+				},
+				InsertFunc: func(_ context.Context, events []status_export.Event) error {
+					mu.Lock()
+					defer mu.Unlock()
+					inserted = append(inserted, events...)
+					return nil
+				},
+			}
+
+			sut := analytics.NewSink(slog.Default(), writer,
+				analytics.WithBatchSize(tc.batchSize),
+				analytics.WithBufferSize(tc.bufferSize),
+				analytics.WithFlushInterval(time.Hour),
+			)
+
+			// when
+			err := sut.Start()
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+
+			err = sut.Export([]*store.Data{
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+				{Hash: testdata.TX2Hash, Status: metamorph_api.Status_MINED},
+				{Hash: testdata.TX3Hash, Status: metamorph_api.Status_REJECTED},
+			})
+			if tc.expectedExportErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedExportErrorStr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			time.Sleep(20 * time.Millisecond)
+			sut.Shutdown()
+
+			require.Len(t, inserted, tc.expectedInserted)
+		})
+	}
+}
+
+func TestSink_Backfill(t *testing.T) {
+	// given
+	storedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	backfillStore := &mocks.BackfillStoreMock{
+		GetStoredBetweenFunc: func(_ context.Context, _ time.Time, _ time.Time, _ int64, offset int64) ([]*store.Data, error) {
+			if offset > 0 {
+				return nil, nil
+			}
+
+			return []*store.Data{
+				{
+					Hash:         testdata.TX1Hash,
+					Status:       metamorph_api.Status_MINED,
+					StoredAt:     storedAt,
+					LastModified: storedAt.Add(10 * time.Minute),
+					StatusHistory: []*store.StatusWithTimestamp{
+						{Status: metamorph_api.Status_STORED, Timestamp: storedAt},
+						{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: storedAt.Add(time.Second)},
+					},
+				},
+				{
+					Hash:     testdata.TX2Hash,
+					Status:   metamorph_api.Status_STORED,
+					StoredAt: storedAt,
+				},
+			}, nil
+		},
+	}
+
+	var inserted []status_export.Event
+	writer := &mocks.WriterMock{
+		InsertFunc: func(_ context.Context, events []status_export.Event) error {
+			inserted = append(inserted, events...)
+			return nil
+		},
+	}
+
+	sut := analytics.NewSink(slog.Default(), writer, analytics.WithBackfill(backfillStore, 24*time.Hour))
+
+	// when
+	count, err := sut.Backfill(context.Background(), storedAt.Add(-time.Hour), storedAt.Add(time.Hour))
+
+	// then
+	require.NoError(t, err)
+	require.Equal(t, 4, count)
+	require.Len(t, backfillStore.GetStoredBetweenCalls(), 1)
+
+	require.Equal(t, metamorph_api.Status_STORED.String(), inserted[0].Status)
+	require.Equal(t, metamorph_api.Status_SEEN_ON_NETWORK.String(), inserted[1].Status)
+	require.Equal(t, storedAt.Add(time.Second), inserted[1].Timestamp)
+	require.Equal(t, metamorph_api.Status_MINED.String(), inserted[2].Status)
+	require.Equal(t, storedAt.Add(10*time.Minute), inserted[2].Timestamp)
+	require.Equal(t, testdata.TX2Hash.String(), inserted[3].TxID)
+	require.Equal(t, storedAt, inserted[3].Timestamp)
+}
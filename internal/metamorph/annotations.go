@@ -0,0 +1,79 @@
+package metamorph
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const (
+	maxAnnotationNoteLength = 1000
+	maxAnnotationLabels     = 20
+)
+
+// Annotation is a note and labels attached to a transaction by an operator, e.g. the reference of a customer dispute.
+type Annotation struct {
+	Note      string
+	Labels    []string
+	Author    string
+	CreatedAt time.Time
+}
+
+// normalizeLabels trims the labels and removes empty and duplicate labels.
+func normalizeLabels(labels []string) []string {
+	result := make([]string, 0, len(labels))
+	for _, label := range labels {
+		label = strings.TrimSpace(label)
+		if label == "" || slices.Contains(result, label) {
+			continue
+		}
+		result = append(result, label)
+	}
+
+	return result
+}
+
+func toAnnotationProto(annotation store.Annotation) *metamorph_api.Annotation {
+	return &metamorph_api.Annotation{
+		Note:      annotation.Note,
+		Labels:    annotation.Labels,
+		Author:    annotation.Author,
+		CreatedAt: timestamppb.New(annotation.CreatedAt),
+	}
+}
+
+func toAnnotationsProto(annotations []store.Annotation) []*metamorph_api.Annotation {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	result := make([]*metamorph_api.Annotation, 0, len(annotations))
+	for _, annotation := range annotations {
+		result = append(result, toAnnotationProto(annotation))
+	}
+
+	return result
+}
+
+func annotationsFromProto(annotations []*metamorph_api.Annotation) []Annotation {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	result := make([]Annotation, 0, len(annotations))
+	for _, annotation := range annotations {
+		result = append(result, Annotation{
+			Note:      annotation.GetNote(),
+			Labels:    annotation.GetLabels(),
+			Author:    annotation.GetAuthor(),
+			CreatedAt: timeOrZero(annotation.GetCreatedAt()),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,103 @@
+package bcnet
+
+import (
+	"crypto/sha256"
+	"log/slog"
+	"sort"
+	"strconv"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+// WithDesignatedPeers sets the designated peers by name, e.g. the node of a miner, to which transactions can be
+// announced exclusively. The addresses are the p2p addresses of the peers, i.e. host:port.
+func WithDesignatedPeers(peers map[string]string) Option {
+	return func(m *Mediator) {
+		m.designatedPeers = peers
+	}
+}
+
+// announcementPeers returns the connected peers a transaction restricted by AnnounceTo is announced to. A number
+// selects that many peers by rendezvous hashing of the transaction hash and the peer addresses, so that the selection
+// is random across transactions, but stable across the re-announcements of a transaction as long as the same peers
+// are connected. A name selects the designated peer of that name, if it is connected.
+func (m *Mediator) announcementPeers(tx *store.Data, peers []p2p.PeerI) []p2p.PeerI {
+	connected := make([]p2p.PeerI, 0, len(peers))
+	for _, peer := range peers {
+		if peer.Connected() {
+			connected = append(connected, peer)
+		}
+	}
+
+	count, err := strconv.Atoi(tx.AnnounceTo)
+	if err == nil {
+		return selectPeers(tx.Hash, connected, count)
+	}
+
+	address, found := m.designatedPeers[tx.AnnounceTo]
+	if !found {
+		m.logger.Warn("Transaction not announced, designated peer unknown", slog.String("hash", tx.Hash.String()), slog.String("peer", tx.AnnounceTo))
+		return nil
+	}
+
+	for _, peer := range connected {
+		if peer.String() == address {
+			return []p2p.PeerI{peer}
+		}
+	}
+
+	m.logger.Warn("Transaction not announced, designated peer not connected", slog.String("hash", tx.Hash.String()), slog.String("peer", tx.AnnounceTo))
+	return nil
+}
+
+// selectPeers returns the count peers with the highest weights, the weight of a peer being the hash of the transaction
+// hash and the address of the peer.
+func selectPeers(hash *chainhash.Hash, peers []p2p.PeerI, count int) []p2p.PeerI {
+	if count <= 0 {
+		return nil
+	}
+	if count >= len(peers) {
+		return peers
+	}
+
+	type weightedPeer struct {
+		peer   p2p.PeerI
+		weight [sha256.Size]byte
+	}
+
+	weighted := make([]weightedPeer, 0, len(peers))
+	for _, peer := range peers {
+		weighted = append(weighted, weightedPeer{peer: peer, weight: sha256.Sum256(append(hash.CloneBytes(), peer.String()...))})
+	}
+
+	sort.Slice(weighted, func(i, j int) bool {
+		return string(weighted[i].weight[:]) > string(weighted[j].weight[:])
+	})
+
+	selected := make([]p2p.PeerI, 0, count)
+	for _, w := range weighted[:count] {
+		selected = append(selected, w.peer)
+	}
+
+	return selected
+}
+
+// filterPeers returns the peers which are also contained in allowed.
+func filterPeers(peers []p2p.PeerI, allowed []p2p.PeerI) []p2p.PeerI {
+	addresses := make(map[string]struct{}, len(allowed))
+	for _, peer := range allowed {
+		addresses[peer.String()] = struct{}{}
+	}
+
+	filtered := make([]p2p.PeerI, 0, len(peers))
+	for _, peer := range peers {
+		if _, found := addresses[peer.String()]; found {
+			filtered = append(filtered, peer)
+		}
+	}
+
+	return filtered
+}
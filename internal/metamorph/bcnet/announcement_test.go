@@ -0,0 +1,131 @@
+package bcnet
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/p2p/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestMediator_announcementPeers(t *testing.T) {
+	peer := func(address string, connected bool) p2p.PeerI {
+		return &mocks.PeerIMock{
+			StringFunc:    func() string { return address },
+			ConnectedFunc: func() bool { return connected },
+		}
+	}
+
+	peers := []p2p.PeerI{
+		peer("10.0.0.1:8333", true),
+		peer("10.0.0.2:8333", true),
+		peer("10.0.0.3:8333", true),
+		peer("10.0.0.4:8333", false),
+		peer("10.0.0.5:8333", true),
+	}
+
+	tt := []struct {
+		name       string
+		announceTo string
+
+		expectedCount     int
+		expectedAddresses []string
+	}{
+		{
+			name:       "number of peers",
+			announceTo: "2",
+
+			expectedCount: 2,
+		},
+		{
+			name:       "more peers than connected",
+			announceTo: "10",
+
+			expectedAddresses: []string{"10.0.0.1:8333", "10.0.0.2:8333", "10.0.0.3:8333", "10.0.0.5:8333"},
+		},
+		{
+			name:       "zero peers",
+			announceTo: "0",
+		},
+		{
+			name:       "designated peer",
+			announceTo: "miner-a",
+
+			expectedAddresses: []string{"10.0.0.2:8333"},
+		},
+		{
+			name:       "designated peer not connected",
+			announceTo: "miner-b",
+		},
+		{
+			name:       "designated peer unknown",
+			announceTo: "miner-c",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := NewMediator(slog.Default(), true, nil, nil, WithDesignatedPeers(map[string]string{
+				"miner-a": "10.0.0.2:8333",
+				"miner-b": "10.0.0.4:8333",
+			}))
+			tx := &store.Data{Hash: testdata.TX1Hash, AnnounceTo: tc.announceTo}
+
+			// when
+			actual := sut.announcementPeers(tx, peers)
+
+			// then
+			addresses := make([]string, 0, len(actual))
+			for _, p := range actual {
+				assert.True(t, p.Connected())
+				addresses = append(addresses, p.String())
+			}
+
+			if tc.expectedAddresses != nil {
+				assert.ElementsMatch(t, tc.expectedAddresses, addresses)
+				return
+			}
+			assert.Len(t, addresses, tc.expectedCount)
+
+			// the selection is stable for the re-announcements of the transaction
+			again := sut.announcementPeers(tx, peers)
+			require.Len(t, again, len(actual))
+			for i := range again {
+				assert.Equal(t, actual[i].String(), again[i].String())
+			}
+		})
+	}
+}
+
+func TestSelectPeers(t *testing.T) {
+	// given
+	peers := make([]p2p.PeerI, 0, 10)
+	for _, address := range []string{"a:8333", "b:8333", "c:8333", "d:8333", "e:8333", "f:8333", "g:8333", "h:8333", "i:8333", "j:8333"} {
+		peers = append(peers, &mocks.PeerIMock{StringFunc: func() string { return address }})
+	}
+
+	// when
+	selectedTx1 := selectPeers(testdata.TX1Hash, peers, 3)
+	selectedTx2 := selectPeers(testdata.TX2Hash, peers, 3)
+
+	// then
+	require.Len(t, selectedTx1, 3)
+	require.Len(t, selectedTx2, 3)
+
+	// the selection differs between transactions
+	addresses := func(peers []p2p.PeerI) []string {
+		result := make([]string, 0, len(peers))
+		for _, p := range peers {
+			result = append(result, p.String())
+		}
+		return result
+	}
+	assert.NotEqual(t, addresses(selectedTx1), addresses(selectedTx2))
+	assert.Equal(t, addresses(selectedTx1), addresses(selectPeers(testdata.TX1Hash, peers, 3)))
+}
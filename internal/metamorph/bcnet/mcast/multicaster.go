@@ -10,6 +10,7 @@ import (
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/multicast"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
 )
 
 var ErrTxRejectedByPeer = errors.New("transaction rejected by peer")
@@ -97,11 +98,14 @@ func (m *Multicaster) OnReceiveFromMcast(msg wire.Message) {
 			return
 		}
 
+		reason := node_reject.FromMessage(rejectMsg, "multicast group")
+		reason.Cause = ErrTxRejectedByPeer
+
 		m.messageCh <- &metamorph_p2p.TxStatusMessage{
 			Hash:   &rejectMsg.Hash,
 			Status: metamorph_api.Status_REJECTED,
 			Peer:   "Mcast REJECT",
-			Err:    errors.Join(ErrTxRejectedByPeer, errors.New(rejectMsg.Reason)),
+			Err:    reason,
 		}
 	}
 
@@ -29,6 +29,7 @@ import (
 // - `AskForTxAsync`: Asynchronously requests a transaction by its hash from the network via P2P.
 // - `AnnounceTxAsync`: Asynchronously announces a transaction to the network.
 // In classic mode, it uses `p2pMessenger` to announce the transaction. In hybrid mode, it uses `mcaster` to send the transaction via multicast.
+// Transactions restricted by `AnnounceTo` are announced in classic mode only to the selected or designated peers.
 //
 // Usage:
 // - The `Mediator` abstracts the differences between classic (peer-to-peer) and hybrid (peer-to-peer and multicast) modes.
@@ -41,6 +42,8 @@ type Mediator struct {
 	p2pMessenger *p2p.NetworkMessenger
 	mcaster      *mcast.Multicaster
 
+	designatedPeers map[string]string
+
 	tracingEnabled    bool
 	tracingAttributes []attribute.KeyValue
 }
@@ -90,8 +93,34 @@ func (m *Mediator) AskForTxAsync(ctx context.Context, tx *store.Data) {
 func (m *Mediator) AnnounceTxAsync(ctx context.Context, tx *store.Data) {
 	_, span := tracing.StartTracing(ctx, "AskForTxAsync", m.tracingEnabled, m.tracingAttributes...)
 
-	if m.classic {
+	switch {
+	case !m.classic:
+		_ = m.mcaster.SendTx(tx.RawTx)
+	case tx.AnnounceTo != "":
+		peers := m.announcementPeers(tx, m.p2pMessenger.GetPeers())
+		if len(peers) > 0 {
+			m.p2pMessenger.AnnounceToPeersWithAutoBatch(tx.Hash, peers)
+		}
+	default:
 		m.p2pMessenger.AnnounceWithAutoBatch(tx.Hash, wire.InvTypeTx)
+	}
+
+	tracing.EndTracing(span, nil)
+}
+
+// AnnounceTxToPeersAsync announces a transaction to the given peers only. In hybrid mode the transaction is sent via
+// multicast, as the peers are not known to the multicast group. A transaction restricted by AnnounceTo is only announced
+// to those of the given peers which it may be announced to.
+func (m *Mediator) AnnounceTxToPeersAsync(ctx context.Context, tx *store.Data, peers []p2p.PeerI) {
+	_, span := tracing.StartTracing(ctx, "AnnounceTxToPeersAsync", m.tracingEnabled, m.tracingAttributes...)
+
+	if m.classic {
+		if tx.AnnounceTo != "" {
+			peers = filterPeers(peers, m.announcementPeers(tx, m.p2pMessenger.GetPeers()))
+		}
+		if len(peers) > 0 {
+			m.p2pMessenger.AnnounceToPeersWithAutoBatch(tx.Hash, peers)
+		}
 	} else {
 		_ = m.mcaster.SendTx(tx.RawTx)
 	}
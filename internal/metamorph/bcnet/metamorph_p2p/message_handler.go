@@ -4,8 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
-	"fmt"
 	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/libsv/go-p2p/bsvutil"
@@ -14,6 +14,7 @@ import (
 
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
 	"github.com/bitcoin-sv/arc/internal/p2p"
 )
 
@@ -36,6 +37,12 @@ type MsgHandler struct {
 	store     store.MetamorphStore
 	messageCh chan<- *TxStatusMessage
 	now       func() time.Time
+	sharedTxs *sharedTxs
+	sharedTTL time.Duration
+
+	// minFees are the fee rates in satoshis per kB announced by the peers with feefilter messages
+	minFeesMu sync.Mutex
+	minFees   map[string]int64
 }
 
 type Option func(f *MsgHandler)
@@ -46,6 +53,14 @@ func WithNow(now func() time.Time) func(*MsgHandler) {
 	}
 }
 
+// WithSharedTxs sends the transactions requested by the peers as shared messages which are kept for the ttl, so that
+// a transaction requested by many peers is held in memory once. The transactions are not parsed before sending them.
+func WithSharedTxs(ttl time.Duration) func(*MsgHandler) {
+	return func(h *MsgHandler) {
+		h.sharedTTL = ttl
+	}
+}
+
 func NewMsgHandler(l *slog.Logger, s store.MetamorphStore, messageCh chan<- *TxStatusMessage, opts ...Option) *MsgHandler {
 	ph := &MsgHandler{
 		logger: l.With(
@@ -55,6 +70,7 @@ func NewMsgHandler(l *slog.Logger, s store.MetamorphStore, messageCh chan<- *TxS
 		store:     s,
 		messageCh: messageCh,
 		now:       time.Now,
+		minFees:   make(map[string]int64),
 	}
 
 	// apply options to MsgHandler
@@ -62,6 +78,10 @@ func NewMsgHandler(l *slog.Logger, s store.MetamorphStore, messageCh chan<- *TxS
 		opt(ph)
 	}
 
+	if ph.sharedTTL > 0 {
+		ph.sharedTxs = newSharedTxs(ph.sharedTTL, ph.now)
+	}
+
 	return ph
 }
 
@@ -81,6 +101,9 @@ func (h *MsgHandler) OnReceive(msg wire.Message, peer p2p.PeerI) {
 	case wire.CmdGetData:
 		h.handleReceivedGetData(msg, peer)
 
+	case wire.CmdFeeFilter:
+		h.handleReceivedFeeFilter(msg, peer)
+
 	default:
 		// ignore other messages
 	}
@@ -91,12 +114,16 @@ func (h *MsgHandler) OnSend(msg wire.Message, peer p2p.PeerI) {
 	cmd := msg.Command()
 	switch cmd {
 	case wire.CmdTx:
-		txMsg, ok := msg.(*wire.MsgTx)
-		if !ok {
+		var hash chainhash.Hash
+		switch txMsg := msg.(type) {
+		case *wire.MsgTx:
+			hash = txMsg.TxHash()
+		case *p2p.SharedMessage:
+			hash = txMsg.Hash()
+		default:
 			return
 		}
 
-		hash := txMsg.TxHash()
 		h.messageCh <- &TxStatusMessage{
 			Hash:   &hash,
 			Status: metamorph_api.Status_SENT_TO_NETWORK,
@@ -154,15 +181,37 @@ func (h *MsgHandler) handleReceivedReject(wireMsg wire.Message, peer p2p.PeerI)
 		return
 	}
 
+	reason := node_reject.FromMessage(msg, peer.String())
+	reason.MinFee = h.minFee(peer.String())
+	reason.Cause = ErrTxRejectedByPeer
+
 	h.messageCh <- &TxStatusMessage{
 		Hash:   &msg.Hash,
 		Status: metamorph_api.Status_REJECTED,
 		Peer:   peer.String(),
-		Err:    errors.Join(ErrTxRejectedByPeer, fmt.Errorf("peer: %s reason: %s", peer.String(), msg.Reason)),
+		Err:    reason,
 		Start:  h.now(),
 	}
 }
 
+func (h *MsgHandler) handleReceivedFeeFilter(wireMsg wire.Message, peer p2p.PeerI) {
+	msg, ok := wireMsg.(*wire.MsgFeeFilter)
+	if !ok {
+		return
+	}
+
+	h.minFeesMu.Lock()
+	h.minFees[peer.String()] = msg.MinFee
+	h.minFeesMu.Unlock()
+}
+
+func (h *MsgHandler) minFee(peer string) int64 {
+	h.minFeesMu.Lock()
+	defer h.minFeesMu.Unlock()
+
+	return h.minFees[peer]
+}
+
 func (h *MsgHandler) handleReceivedGetData(wireMsg wire.Message, peer p2p.PeerI) {
 	msg, ok := wireMsg.(*wire.MsgGetData)
 	if !ok {
@@ -176,11 +225,22 @@ func (h *MsgHandler) handleReceivedGetData(wireMsg wire.Message, peer p2p.PeerI)
 
 		for _, iv := range msg.InvList {
 			if iv.Type == wire.InvTypeTx {
+				if h.sharedTxs != nil {
+					if shared := h.sharedTxs.get(iv.Hash); shared != nil {
+						h.sendSharedTx(shared, peer)
+						continue
+					}
+				}
+
 				txRequests = append(txRequests, iv.Hash[:])
 			}
 			// ignore other INV types
 		}
 
+		if len(txRequests) == 0 {
+			return
+		}
+
 		rtx, err := h.store.GetRawTxs(context.Background(), txRequests)
 		if err != nil {
 			h.logger.Error("Unable to fetch txs from store", slog.Int("count", len(txRequests)), slog.String("err", err.Error()))
@@ -188,6 +248,17 @@ func (h *MsgHandler) handleReceivedGetData(wireMsg wire.Message, peer p2p.PeerI)
 		}
 
 		for _, txBytes := range rtx {
+			if h.sharedTxs != nil {
+				shared, err := h.sharedTxs.add(chainhash.DoubleHashH(txBytes), txBytes, peer.Network())
+				if err != nil {
+					h.logger.Error("failed to share tx", slog.String("err", err.Error()))
+					continue
+				}
+
+				h.sendSharedTx(shared, peer)
+				continue
+			}
+
 			tx, err := bsvutil.NewTxFromBytes(txBytes)
 			if err != nil {
 				h.logger.Error("failed to parse tx", slog.String("rawHex", hex.EncodeToString(txBytes)), slog.String("err", err.Error()))
@@ -206,3 +277,16 @@ func (h *MsgHandler) handleReceivedGetData(wireMsg wire.Message, peer p2p.PeerI)
 		}
 	}(msg, peer)
 }
+
+// sendSharedTx writes the shared transaction to the peer, which releases the reference once it is sent.
+func (h *MsgHandler) sendSharedTx(shared *p2p.SharedMessage, peer p2p.PeerI) {
+	hash := shared.Hash()
+	h.messageCh <- &TxStatusMessage{
+		Hash:   &hash,
+		Status: metamorph_api.Status_REQUESTED_BY_NETWORK,
+		Peer:   peer.String(),
+		Start:  h.now(),
+	}
+
+	peer.WriteMsg(shared)
+}
@@ -2,7 +2,6 @@ package metamorph_p2p
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log/slog"
 	"testing"
@@ -10,11 +9,15 @@ import (
 
 	"github.com/libsv/go-p2p/wire"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
+	"github.com/bitcoin-sv/arc/internal/p2p"
 	p2pMocks "github.com/bitcoin-sv/arc/internal/p2p/mocks"
 	"github.com/bitcoin-sv/arc/internal/testdata"
+	"github.com/bitcoin-sv/arc/pkg/api"
 )
 
 const (
@@ -74,7 +77,13 @@ func Test_MessageHandlerOnReceive(t *testing.T) {
 				Hash:   ptrTo(wire.NewMsgReject("command", wire.RejectMalformed, "malformed").Hash),
 				Status: metamorph_api.Status_REJECTED,
 				Peer:   peerAddr,
-				Err:    errors.Join(ErrTxRejectedByPeer, fmt.Errorf("peer: %s reason: %s", peerAddr, "malformed")),
+				Err: &node_reject.Reason{
+					Status: api.ErrStatusMalformed,
+					Source: peerAddr,
+					Code:   int(wire.RejectMalformed),
+					Text:   "malformed",
+					Cause:  ErrTxRejectedByPeer,
+				},
 			},
 		},
 		{
@@ -137,6 +146,25 @@ func Test_MessageHandlerOnReceive(t *testing.T) {
 	}
 }
 
+func Test_MessageHandlerFeeFilter(t *testing.T) {
+	// given
+	messageCh := make(chan *TxStatusMessage, 10)
+	peer := &p2pMocks.PeerIMock{
+		StringFunc: func() string { return peerAddr },
+	}
+
+	sut := NewMsgHandler(slog.Default(), &storeMocks.MetamorphStoreMock{}, messageCh)
+
+	// when
+	sut.OnReceive(wire.NewMsgFeeFilter(500), peer)
+	sut.OnReceive(wire.NewMsgReject("tx", wire.RejectInsufficientFee, "mempool min fee not met"), peer)
+
+	// then
+	msg := <-messageCh
+	require.ErrorIs(t, msg.Err, ErrTxRejectedByPeer)
+	require.Equal(t, "arc error 465: rejected by peer with code 66 (REJECT_INSUFFICIENTFEE): mempool min fee not met (min fee of peer: 500 sat/kB)", msg.Err.Error())
+}
+
 func Test_MessageHandlerOnSend(t *testing.T) {
 	tt := []struct {
 		name                 string
@@ -200,3 +228,71 @@ func Test_MessageHandlerOnSend(t *testing.T) {
 func ptrTo[T any](v T) *T {
 	return &v
 }
+
+func Test_MessageHandlerSharedTxs(t *testing.T) {
+	// given
+	messageCh := make(chan *TxStatusMessage, 10)
+	getRawTxsCalls := make(chan struct{}, 10)
+	store := &storeMocks.MetamorphStoreMock{
+		GetRawTxsFunc: func(_ context.Context, _ [][]byte) ([][]byte, error) {
+			getRawTxsCalls <- struct{}{}
+			return [][]byte{testdata.TX1Raw.Bytes()}, nil
+		},
+	}
+
+	written := make(chan wire.Message, 10)
+	newPeer := func() *p2pMocks.PeerIMock {
+		return &p2pMocks.PeerIMock{
+			StringFunc:   func() string { return peerAddr },
+			NetworkFunc:  func() wire.BitcoinNet { return bitconnet },
+			WriteMsgFunc: func(msg wire.Message) { written <- msg },
+		}
+	}
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	sut := NewMsgHandler(slog.Default(), store, messageCh,
+		WithNow(func() time.Time { return now }),
+		WithSharedTxs(time.Minute),
+	)
+
+	getData := wire.NewMsgGetData()
+	_ = getData.AddInvVect(wire.NewInvVect(wire.InvTypeTx, txHash))
+
+	receiveShared := func() *p2p.SharedMessage {
+		t.Helper()
+
+		sut.OnReceive(getData, newPeer())
+
+		select {
+		case msg := <-written:
+			shared, ok := msg.(*p2p.SharedMessage)
+			require.True(t, ok)
+			status := <-messageCh
+			assert.Equal(t, metamorph_api.Status_REQUESTED_BY_NETWORK, status.Status)
+			return shared
+		case <-time.After(time.Second):
+			t.Fatal("no tx written to peer")
+			return nil
+		}
+	}
+
+	// when
+	first := receiveShared()
+	second := receiveShared()
+
+	sut.OnSend(first, newPeer())
+	sentStatus := <-messageCh
+
+	// then
+	assert.Same(t, first, second)
+	assert.Equal(t, *txHash, first.Hash())
+	assert.Equal(t, testdata.TX1Raw.Bytes(), first.Payload())
+	assert.Len(t, getRawTxsCalls, 1)
+	assert.Equal(t, &TxStatusMessage{Hash: txHash, Status: metamorph_api.Status_SENT_TO_NETWORK, Peer: peerAddr, Start: now}, sentStatus)
+
+	// the shared tx is fetched again after the ttl
+	now = now.Add(2 * time.Minute)
+	third := receiveShared()
+	assert.NotSame(t, first, third)
+	assert.Len(t, getRawTxsCalls, 2)
+}
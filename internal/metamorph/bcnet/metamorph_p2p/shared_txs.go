@@ -0,0 +1,88 @@
+package metamorph_p2p
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+type sharedTxExpiry struct {
+	hash    chainhash.Hash
+	expires time.Time
+}
+
+// sharedTxs keeps the transactions sent to the peers as shared messages for the ttl, so that a transaction requested
+// by many peers after its announcement is held in memory once instead of once per peer.
+type sharedTxs struct {
+	pool *p2p.BufferPool
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu     sync.Mutex
+	msgs   map[chainhash.Hash]*p2p.SharedMessage
+	expiry []sharedTxExpiry
+}
+
+func newSharedTxs(ttl time.Duration, now func() time.Time) *sharedTxs {
+	return &sharedTxs{
+		pool: p2p.NewBufferPool(),
+		ttl:  ttl,
+		now:  now,
+		msgs: make(map[chainhash.Hash]*p2p.SharedMessage),
+	}
+}
+
+// get returns a reference to the shared message of the transaction or nil if it isn't kept.
+func (s *sharedTxs) get(hash chainhash.Hash) *p2p.SharedMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictLocked()
+
+	msg, found := s.msgs[hash]
+	if !found {
+		return nil
+	}
+
+	return msg.Retain()
+}
+
+// add keeps the raw transaction as shared message and returns a reference to it.
+func (s *sharedTxs) add(hash chainhash.Hash, rawTx []byte, network wire.BitcoinNet) (*p2p.SharedMessage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictLocked()
+
+	// the transaction was requested by another peer in the meantime
+	if msg, found := s.msgs[hash]; found {
+		return msg.Retain(), nil
+	}
+
+	msg, err := p2p.NewSharedMessage(s.pool, wire.CmdTx, hash, rawTx, network)
+	if err != nil {
+		return nil, err
+	}
+
+	s.msgs[hash] = msg
+	s.expiry = append(s.expiry, sharedTxExpiry{hash: hash, expires: s.now().Add(s.ttl)})
+
+	return msg.Retain(), nil
+}
+
+func (s *sharedTxs) evictLocked() {
+	now := s.now()
+
+	i := 0
+	for ; i < len(s.expiry) && !s.expiry[i].expires.After(now); i++ {
+		hash := s.expiry[i].hash
+		s.msgs[hash].Release()
+		delete(s.msgs, hash)
+	}
+
+	s.expiry = s.expiry[i:]
+}
@@ -0,0 +1,134 @@
+package metamorph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const (
+	blockTemplateExpiryDefault = 2 * time.Minute
+	blockTemplateKeyPrefix     = "block-template-"
+)
+
+var (
+	ErrBlockTemplatesDisabled = errors.New("block templates are not enabled")
+	ErrBlockTemplateInvalid   = errors.New("invalid block template")
+	ErrBlockTemplateFailed    = errors.New("failed to process block template")
+)
+
+// BlockTemplate identifies the block template in which a transaction is included.
+type BlockTemplate struct {
+	// Source is the identifier of the mining pool or node which created the block template
+	Source string `json:"source"`
+	// PreviousBlockHash is the hash of the block on top of which the block template is built
+	PreviousBlockHash string `json:"previousBlockHash"`
+	// Timestamp is the time at which the transaction was marked as included in the block template
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// BlockTemplates keeps track of the transactions known to ARC which are included in the candidate block templates of
+// mining pools or nodes, i.e. which are expected to be mined in the next block. The block templates can be fed
+// completely or in merkle subtrees. The inclusion of a transaction is stored in the cache and expires, unless the
+// transaction is included in a subsequent block template again.
+type BlockTemplates struct {
+	logger     *slog.Logger
+	store      store.MetamorphStore
+	cacheStore cache.Store
+	expiry     time.Duration
+	now        func() time.Time
+}
+
+func WithBlockTemplateExpiry(d time.Duration) func(*BlockTemplates) {
+	return func(b *BlockTemplates) {
+		b.expiry = d
+	}
+}
+
+func WithBlockTemplateNow(nowFunc func() time.Time) func(*BlockTemplates) {
+	return func(b *BlockTemplates) {
+		b.now = nowFunc
+	}
+}
+
+func NewBlockTemplates(logger *slog.Logger, s store.MetamorphStore, cacheStore cache.Store, opts ...func(*BlockTemplates)) *BlockTemplates {
+	b := &BlockTemplates{
+		logger:     logger.With(slog.String("module", "block-templates")),
+		store:      s,
+		cacheStore: cacheStore,
+		expiry:     blockTemplateExpiryDefault,
+		now:        time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+// Add marks the transactions of a block template or of a merkle subtree of it as included in the block template. Only
+// the stored transactions which are neither mined nor rejected or expired are marked, they are looked up in the store
+// in one query. It returns the number of marked transactions.
+func (b *BlockTemplates) Add(ctx context.Context, source string, previousBlockHash string, hashes []*chainhash.Hash) (uint64, error) {
+	value, err := json.Marshal(BlockTemplate{Source: source, PreviousBlockHash: previousBlockHash, Timestamp: b.now()})
+	if err != nil {
+		return 0, errors.Join(ErrBlockTemplateFailed, err)
+	}
+
+	keys := make([][]byte, len(hashes))
+	for i, hash := range hashes {
+		keys[i] = hash[:]
+	}
+
+	stored, err := b.store.GetMany(ctx, keys)
+	if err != nil {
+		return 0, errors.Join(ErrBlockTemplateFailed, err)
+	}
+
+	var included uint64
+	for _, data := range stored {
+		switch data.Status {
+		case metamorph_api.Status_MINED, metamorph_api.Status_REJECTED, metamorph_api.Status_EXPIRED:
+			continue
+		}
+
+		err = b.cacheStore.Set(blockTemplateKeyPrefix+hexutils.TxID(data.Hash), value, b.expiry)
+		if err != nil {
+			return included, errors.Join(ErrBlockTemplateFailed, err)
+		}
+		included++
+	}
+
+	b.logger.Debug("Block template processed", slog.String("source", source), slog.String("previousBlockHash", previousBlockHash), slog.Int("txs", len(hashes)), slog.Uint64("included", included))
+
+	return included, nil
+}
+
+// Get returns the latest block template in which the transaction is included or nil if it is not included in any.
+func (b *BlockTemplates) Get(hash *chainhash.Hash) (*BlockTemplate, error) {
+	value, err := b.cacheStore.Get(blockTemplateKeyPrefix + hexutils.TxID(hash))
+	if errors.Is(err, cache.ErrCacheNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var template BlockTemplate
+	err = json.Unmarshal(value, &template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &template, nil
+}
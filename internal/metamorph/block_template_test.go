@@ -0,0 +1,121 @@
+package metamorph_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+// blockTemplateStore returns a store mock returning the stored transactions with the requested hashes.
+func blockTemplateStore(stored ...*store.Data) *storeMocks.MetamorphStoreMock {
+	return &storeMocks.MetamorphStoreMock{
+		GetManyFunc: func(_ context.Context, keys [][]byte) ([]*store.Data, error) {
+			res := make([]*store.Data, 0)
+			for _, data := range stored {
+				for _, key := range keys {
+					if bytes.Equal(data.Hash[:], key) {
+						res = append(res, data)
+					}
+				}
+			}
+			return res, nil
+		},
+	}
+}
+
+func TestBlockTemplates(t *testing.T) {
+	now := time.Date(2025, 2, 3, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name     string
+		stored   []*store.Data
+		storeErr error
+
+		expectedIncluded uint64
+		expectedTemplate map[*chainhash.Hash]*metamorph.BlockTemplate
+		expectedErr      error
+	}{
+		{
+			name: "stored txs are included",
+			stored: []*store.Data{
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+				{Hash: testdata.TX2Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK},
+			},
+
+			expectedIncluded: 2,
+			expectedTemplate: map[*chainhash.Hash]*metamorph.BlockTemplate{
+				testdata.TX1Hash: {Source: "pool-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: now},
+				testdata.TX2Hash: {Source: "pool-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: now},
+				testdata.TX3Hash: nil,
+			},
+		},
+		{
+			name: "unknown, mined and rejected txs are skipped",
+			stored: []*store.Data{
+				{Hash: testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+				{Hash: testdata.TX3Hash, Status: metamorph_api.Status_MINED},
+				{Hash: testdata.TX4Hash, Status: metamorph_api.Status_REJECTED},
+			},
+
+			expectedIncluded: 1,
+			expectedTemplate: map[*chainhash.Hash]*metamorph.BlockTemplate{
+				testdata.TX1Hash: nil,
+				testdata.TX2Hash: {Source: "pool-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: now},
+				testdata.TX3Hash: nil,
+				testdata.TX4Hash: nil,
+			},
+		},
+		{
+			name:     "store error",
+			storeErr: errors.New("connection refused"),
+
+			expectedErr: metamorph.ErrBlockTemplateFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := blockTemplateStore(tc.stored...)
+			if tc.storeErr != nil {
+				metamorphStore.GetManyFunc = func(_ context.Context, _ [][]byte) ([]*store.Data, error) {
+					return nil, tc.storeErr
+				}
+			}
+
+			sut := metamorph.NewBlockTemplates(slog.Default(), metamorphStore, cache.NewMemoryStore(), metamorph.WithBlockTemplateNow(func() time.Time { return now }))
+
+			// when
+			actual, err := sut.Add(context.Background(), "pool-1", testdata.Block1Hash.String(), []*chainhash.Hash{testdata.TX1Hash, testdata.TX2Hash, testdata.TX3Hash, testdata.TX4Hash})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedIncluded, actual)
+			require.Len(t, metamorphStore.GetManyCalls(), 1)
+
+			for hash, expectedTemplate := range tc.expectedTemplate {
+				template, err := sut.Get(hash)
+				require.NoError(t, err)
+				assert.Equal(t, expectedTemplate, template)
+			}
+		})
+	}
+}
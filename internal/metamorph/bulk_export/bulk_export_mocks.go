@@ -0,0 +1,3 @@
+package bulk_export
+
+//go:generate moq -pkg mocks -out ./mocks/store_mock.go . Store
@@ -0,0 +1,98 @@
+package bulk_export
+
+import (
+	"context"
+	"errors"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+var ErrInvalidExportedTx = errors.New("invalid exported transaction")
+
+// ExportClient is the client of the metamorph gRPC API which returns the batches of the export.
+type ExportClient interface {
+	GetExport(ctx context.Context, in *metamorph_api.ExportRequest, opts ...grpc.CallOption) (*metamorph_api.ExportedTransactions, error)
+}
+
+// ClientStore reads the exported transactions from metamorph with its gRPC API, so that the export can be served by a
+// process without access to the metamorph database, e.g. by the admin endpoints of the API server.
+type ClientStore struct {
+	client ExportClient
+}
+
+func NewClientStore(client ExportClient) *ClientStore {
+	return &ClientStore{client: client}
+}
+
+func (c *ClientStore) GetExport(ctx context.Context, filter store.ExportFilter, limit int64) ([]*store.Data, error) {
+	req := &metamorph_api.ExportRequest{
+		From:     timestamppb.New(filter.From),
+		To:       timestamppb.New(filter.To),
+		Statuses: filter.Statuses,
+		Tenant:   filter.Tenant,
+		Limit:    limit,
+	}
+
+	if filter.AfterHash != nil {
+		req.AfterHash = filter.AfterHash[:]
+		req.AfterStoredAt = timestamppb.New(filter.AfterStoredAt)
+	}
+
+	resp, err := c.client.GetExport(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := make([]*store.Data, 0, len(resp.GetTransactions()))
+	for _, tx := range resp.GetTransactions() {
+		data, err := fromExportedTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, data)
+	}
+
+	return batch, nil
+}
+
+func fromExportedTransaction(tx *metamorph_api.ExportedTransaction) (*store.Data, error) {
+	hash, err := chainhash.NewHash(tx.GetHash())
+	if err != nil {
+		return nil, errors.Join(ErrInvalidExportedTx, err)
+	}
+
+	data := &store.Data{
+		Hash:         hash,
+		Status:       tx.GetStatus(),
+		StoredAt:     tx.GetStoredAt().AsTime(),
+		BlockHeight:  tx.GetBlockHeight(),
+		RejectReason: tx.GetRejectReason(),
+		CompetingTxs: tx.GetCompetingTxs(),
+		Tenant:       tx.GetTenant(),
+	}
+
+	if tx.GetLastModified() != nil {
+		data.LastModified = tx.GetLastModified().AsTime()
+	}
+
+	if len(tx.GetBlockHash()) > 0 {
+		data.BlockHash, err = chainhash.NewHash(tx.GetBlockHash())
+		if err != nil {
+			return nil, errors.Join(ErrInvalidExportedTx, err)
+		}
+	}
+
+	if len(tx.GetMinedHash()) > 0 {
+		data.MinedHash, err = chainhash.NewHash(tx.GetMinedHash())
+		if err != nil {
+			return nil, errors.Join(ErrInvalidExportedTx, err)
+		}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,91 @@
+package bulk_export_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	metamorphMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestClientStore_GetExport(t *testing.T) {
+	storedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name string
+		hash []byte
+
+		expectedErr error
+	}{
+		{
+			name: "success",
+			hash: testdata.TX2Hash[:],
+		},
+		{
+			name: "invalid hash",
+			hash: []byte{1, 2, 3},
+
+			expectedErr: bulk_export.ErrInvalidExportedTx,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			client := &metamorphMocks.MetaMorphAPIClientMock{
+				GetExportFunc: func(_ context.Context, _ *metamorph_api.ExportRequest, _ ...grpc.CallOption) (*metamorph_api.ExportedTransactions, error) {
+					return &metamorph_api.ExportedTransactions{Transactions: []*metamorph_api.ExportedTransaction{{
+						Hash:         tc.hash,
+						Status:       metamorph_api.Status_MINED,
+						StoredAt:     timestamppb.New(storedAt),
+						BlockHash:    testdata.Block1Hash[:],
+						BlockHeight:  100,
+						CompetingTxs: []string{testdata.TX3Hash.String()},
+						Tenant:       "exchange-a",
+					}}}, nil
+				},
+			}
+
+			sut := bulk_export.NewClientStore(client)
+
+			// when
+			actual, err := sut.GetExport(context.Background(), store.ExportFilter{
+				From:          storedAt.Add(-time.Hour),
+				To:            storedAt.Add(time.Hour),
+				Tenant:        "exchange-a",
+				AfterStoredAt: storedAt,
+				AfterHash:     testdata.TX1Hash,
+			}, 10)
+
+			// then
+			require.Len(t, client.GetExportCalls(), 1)
+			req := client.GetExportCalls()[0].In
+			require.Equal(t, testdata.TX1Hash[:], req.GetAfterHash())
+			require.Equal(t, storedAt, req.GetAfterStoredAt().AsTime())
+			require.Equal(t, int64(10), req.GetLimit())
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []*store.Data{{
+				Hash:         testdata.TX2Hash,
+				Status:       metamorph_api.Status_MINED,
+				StoredAt:     storedAt,
+				BlockHash:    testdata.Block1Hash,
+				BlockHeight:  100,
+				CompetingTxs: []string{testdata.TX3Hash.String()},
+				Tenant:       "exchange-a",
+			}}, actual)
+		})
+	}
+}
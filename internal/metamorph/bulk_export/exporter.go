@@ -0,0 +1,366 @@
+package bulk_export
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+
+	batchSizeDefault = 1000
+	// rangeDefault is the time range exported if the query does not give the start of the range
+	rangeDefault = 24 * time.Hour
+)
+
+var (
+	ErrInvalidQuery    = errors.New("invalid export query")
+	ErrInvalidCursor   = errors.New("invalid export cursor")
+	ErrFailedToGetTxs  = errors.New("failed to get transactions to export")
+	ErrFailedToWriteTx = errors.New("failed to write exported transaction")
+
+	csvHeader = []string{"txid", "txStatus", "storedAt", "lastModified", "blockHash", "blockHeight", "minedTxid", "rejectReason", "competingTxs", "tenant", "cursor"}
+)
+
+type Store interface {
+	GetExport(ctx context.Context, filter store.ExportFilter, limit int64) ([]*store.Data, error)
+}
+
+// Row is an exported transaction. Cursor is the position of the transaction in the export, an export which was
+// interrupted is continued after the transaction by passing its cursor with the query.
+type Row struct {
+	TxID         string    `json:"txid"`
+	Status       string    `json:"txStatus"`
+	StoredAt     time.Time `json:"storedAt"`
+	LastModified time.Time `json:"lastModified"`
+	BlockHash    string    `json:"blockHash,omitempty"`
+	BlockHeight  uint64    `json:"blockHeight,omitempty"`
+	MinedTxID    string    `json:"minedTxid,omitempty"`
+	RejectReason string    `json:"rejectReason,omitempty"`
+	CompetingTxs []string  `json:"competingTxs,omitempty"`
+	Tenant       string    `json:"tenant,omitempty"`
+	Cursor       string    `json:"cursor"`
+}
+
+// Query selects the exported transactions and the format of the export. Limit is the maximum number of exported
+// transactions, all matching transactions are exported if it is 0.
+type Query struct {
+	Filter store.ExportFilter
+	Format string
+	Limit  int64
+}
+
+// Exporter streams the transactions matching a query as CSV or newline-delimited JSON, e.g. for the reconciliation
+// against back-office systems. The transactions are read in batches ordered by the time at which they were stored and
+// their hash, so that exports of large time ranges neither hold all transactions in memory nor slow down with the
+// number of exported transactions.
+type Exporter struct {
+	logger    *slog.Logger
+	store     Store
+	batchSize int64
+	now       func() time.Time
+}
+
+func WithBatchSize(size int64) func(*Exporter) {
+	return func(e *Exporter) {
+		e.batchSize = size
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Exporter) {
+	return func(e *Exporter) {
+		e.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, s Store, opts ...func(*Exporter)) *Exporter {
+	e := &Exporter{
+		logger:    logger.With(slog.String("module", "bulk-export")),
+		store:     s,
+		batchSize: batchSizeDefault,
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// ParseQuery parses the query parameters `from` and `to` as RFC 3339 timestamps, `status` as comma-separated list of
+// statuses, `tenant`, `format` which is `csv` or `ndjson`, `cursor` and `limit`. The range defaults to the last 24
+// hours and the format to `ndjson`.
+func (e *Exporter) ParseQuery(values url.Values) (Query, error) {
+	q := Query{Format: FormatNDJSON}
+	q.Filter.To = e.now()
+
+	var err error
+	if to := values.Get("to"); to != "" {
+		q.Filter.To, err = time.Parse(time.RFC3339, to)
+		if err != nil {
+			return Query{}, errors.Join(ErrInvalidQuery, fmt.Errorf("to: %w", err))
+		}
+	}
+
+	q.Filter.From = q.Filter.To.Add(-rangeDefault)
+	if from := values.Get("from"); from != "" {
+		q.Filter.From, err = time.Parse(time.RFC3339, from)
+		if err != nil {
+			return Query{}, errors.Join(ErrInvalidQuery, fmt.Errorf("from: %w", err))
+		}
+	}
+
+	if !q.Filter.From.Before(q.Filter.To) {
+		return Query{}, errors.Join(ErrInvalidQuery, fmt.Errorf("from %s is not before to %s", q.Filter.From, q.Filter.To))
+	}
+
+	if statuses := values.Get("status"); statuses != "" {
+		for _, name := range strings.Split(statuses, ",") {
+			status, ok := metamorph_api.Status_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok {
+				return Query{}, errors.Join(ErrInvalidQuery, fmt.Errorf("unknown status: %s", name))
+			}
+			q.Filter.Statuses = append(q.Filter.Statuses, metamorph_api.Status(status))
+		}
+	}
+
+	q.Filter.Tenant = values.Get("tenant")
+
+	if format := values.Get("format"); format != "" {
+		if format != FormatCSV && format != FormatNDJSON {
+			return Query{}, errors.Join(ErrInvalidQuery, fmt.Errorf("unknown format: %s", format))
+		}
+		q.Format = format
+	}
+
+	if cursor := values.Get("cursor"); cursor != "" {
+		q.Filter.AfterStoredAt, q.Filter.AfterHash, err = decodeCursor(cursor)
+		if err != nil {
+			return Query{}, err
+		}
+	}
+
+	if limit := values.Get("limit"); limit != "" {
+		q.Limit, err = strconv.ParseInt(limit, 10, 64)
+		if err != nil || q.Limit < 0 {
+			return Query{}, errors.Join(ErrInvalidQuery, fmt.Errorf("invalid limit: %s", limit))
+		}
+	}
+
+	return q, nil
+}
+
+// Export writes the transactions matching the query to the writer and returns the number of exported transactions.
+// The store is only queried for the first batch before anything is written, so that an invalid query or an unavailable
+// store does not result in a partial export.
+func (e *Exporter) Export(ctx context.Context, w io.Writer, q Query) (int64, error) {
+	filter := q.Filter
+	var csvWriter *csv.Writer
+	var jsonEncoder *json.Encoder
+	var count int64
+
+	for {
+		limit := e.batchSize
+		if q.Limit > 0 && q.Limit-count < limit {
+			limit = q.Limit - count
+		}
+
+		batch, err := e.store.GetExport(ctx, filter, limit)
+		if err != nil {
+			return count, errors.Join(ErrFailedToGetTxs, err)
+		}
+
+		if csvWriter == nil && jsonEncoder == nil {
+			if q.Format == FormatCSV {
+				csvWriter = csv.NewWriter(w)
+				err = csvWriter.Write(csvHeader)
+			} else {
+				jsonEncoder = json.NewEncoder(w)
+			}
+			if err != nil {
+				return count, errors.Join(ErrFailedToWriteTx, err)
+			}
+		}
+
+		for _, data := range batch {
+			row := toRow(data)
+			if csvWriter != nil {
+				err = csvWriter.Write(row.csvRecord())
+			} else {
+				err = jsonEncoder.Encode(row)
+			}
+			if err != nil {
+				return count, errors.Join(ErrFailedToWriteTx, err)
+			}
+			count++
+		}
+
+		if csvWriter != nil {
+			csvWriter.Flush()
+			err = csvWriter.Error()
+			if err != nil {
+				return count, errors.Join(ErrFailedToWriteTx, err)
+			}
+		}
+		if flusher, ok := w.(http.Flusher); ok {
+			flusher.Flush()
+		}
+
+		if int64(len(batch)) < limit || (q.Limit > 0 && count >= q.Limit) {
+			return count, nil
+		}
+
+		last := batch[len(batch)-1]
+		filter.AfterStoredAt = last.StoredAt
+		filter.AfterHash = last.Hash
+	}
+}
+
+// ServeHTTP streams the export of the transactions matching the query parameters. The content type is `text/csv` or
+// `application/x-ndjson` depending on the format.
+func (e *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q, err := e.ParseQuery(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	tw := &trackingWriter{ResponseWriter: w}
+	if q.Format == FormatCSV {
+		w.Header().Set("Content-Type", "text/csv")
+	} else {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+	}
+
+	count, err := e.Export(r.Context(), tw, q)
+	if err != nil {
+		e.logger.Error("Failed to export transactions", slog.Int64("exported", count), slog.String("err", err.Error()))
+		if !tw.written {
+			w.Header().Del("Content-Type")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+
+	e.logger.Info("Exported transactions", slog.Int64("exported", count), slog.String("format", q.Format))
+}
+
+// trackingWriter records whether the response was written to, so that errors are only returned as status code
+// before the export started.
+type trackingWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (t *trackingWriter) Write(b []byte) (int, error) {
+	t.written = true
+	return t.ResponseWriter.Write(b)
+}
+
+func (t *trackingWriter) Flush() {
+	if flusher, ok := t.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+func toRow(data *store.Data) Row {
+	row := Row{
+		TxID:         data.Hash.String(),
+		Status:       data.Status.String(),
+		StoredAt:     data.StoredAt,
+		LastModified: data.LastModified,
+		BlockHeight:  data.BlockHeight,
+		RejectReason: data.RejectReason,
+		CompetingTxs: data.CompetingTxs,
+		Tenant:       data.Tenant,
+		Cursor:       encodeCursor(data.StoredAt, data.Hash),
+	}
+
+	if data.BlockHash != nil {
+		row.BlockHash = data.BlockHash.String()
+	}
+
+	if data.MinedHash != nil {
+		row.MinedTxID = data.MinedHash.String()
+	}
+
+	return row
+}
+
+func (r Row) csvRecord() []string {
+	blockHeight := ""
+	if r.BlockHeight > 0 {
+		blockHeight = strconv.FormatUint(r.BlockHeight, 10)
+	}
+
+	lastModified := ""
+	if !r.LastModified.IsZero() {
+		lastModified = r.LastModified.Format(time.RFC3339Nano)
+	}
+
+	return []string{
+		r.TxID,
+		r.Status,
+		r.StoredAt.Format(time.RFC3339Nano),
+		lastModified,
+		r.BlockHash,
+		blockHeight,
+		r.MinedTxID,
+		r.RejectReason,
+		strings.Join(r.CompetingTxs, " "),
+		r.Tenant,
+		r.Cursor,
+	}
+}
+
+// encodeCursor encodes the position of a transaction in the export as `<stored at in unix nanoseconds>:<txid>`.
+func encodeCursor(storedAt time.Time, hash *chainhash.Hash) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%s", storedAt.UnixNano(), hash.String())))
+}
+
+func decodeCursor(cursor string) (time.Time, *chainhash.Hash, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, nil, errors.Join(ErrInvalidCursor, err)
+	}
+
+	storedAtStr, txID, found := strings.Cut(string(b), ":")
+	if !found {
+		return time.Time{}, nil, ErrInvalidCursor
+	}
+
+	storedAt, err := strconv.ParseInt(storedAtStr, 10, 64)
+	if err != nil {
+		return time.Time{}, nil, errors.Join(ErrInvalidCursor, err)
+	}
+
+	hash, err := chainhash.NewHashFromStr(txID)
+	if err != nil {
+		return time.Time{}, nil, errors.Join(ErrInvalidCursor, err)
+	}
+
+	return time.Unix(0, storedAt).UTC(), hash, nil
+}
@@ -0,0 +1,190 @@
+package bulk_export_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export"
+	"github.com/bitcoin-sv/arc/internal/metamorph/bulk_export/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestExporter_ServeHTTP(t *testing.T) {
+	storedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	txs := []*store.Data{
+		{Hash: testdata.TX1Hash, Status: metamorph_api.Status_MINED, StoredAt: storedAt, BlockHash: testdata.Block1Hash, BlockHeight: 100, Tenant: "exchange-a"},
+		{Hash: testdata.TX2Hash, Status: metamorph_api.Status_REJECTED, StoredAt: storedAt, RejectReason: "double spend", Tenant: "exchange-a"},
+		{Hash: testdata.TX3Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, StoredAt: storedAt.Add(time.Second)},
+	}
+
+	tt := []struct {
+		name         string
+		query        string
+		getExportErr error
+
+		expectedCode     int
+		expectedTxIDs    []string
+		expectedGetCalls int
+		expectedStatuses []metamorph_api.Status
+		expectedTenant   string
+	}{
+		{
+			name:  "success - ndjson",
+			query: "from=2026-01-01T00:00:00Z&to=2026-01-02T00:00:00Z&status=MINED,rejected&tenant=exchange-a",
+
+			expectedCode:     http.StatusOK,
+			expectedTxIDs:    []string{testdata.TX1Hash.String(), testdata.TX2Hash.String(), testdata.TX3Hash.String()},
+			expectedGetCalls: 2,
+			expectedStatuses: []metamorph_api.Status{metamorph_api.Status_MINED, metamorph_api.Status_REJECTED},
+			expectedTenant:   "exchange-a",
+		},
+		{
+			name:  "success - csv with limit",
+			query: "format=csv&limit=2",
+
+			expectedCode:     http.StatusOK,
+			expectedTxIDs:    []string{testdata.TX1Hash.String(), testdata.TX2Hash.String()},
+			expectedGetCalls: 1,
+		},
+		{
+			name:  "error - unknown status",
+			query: "status=UNKNOWN_STATUS",
+
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "error - invalid cursor",
+			query: "cursor=invalid",
+
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "error - failed to get transactions",
+			query:        "",
+			getExportErr: errors.New("connection refused"),
+
+			expectedCode:     http.StatusInternalServerError,
+			expectedGetCalls: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			exportStore := &mocks.StoreMock{
+				GetExportFunc: func(_ context.Context, filter store.ExportFilter, limit int64) ([]*store.Data, error) {
+					if tc.getExportErr != nil {
+						return nil, tc.getExportErr
+					}
+
+					// the export store returns the transactions after the cursor
+					start := 0
+					if filter.AfterHash != nil {
+						for i, tx := range txs {
+							if tx.Hash.IsEqual(filter.AfterHash) {
+								start = i + 1
+							}
+						}
+					}
+
+					end := min(start+int(limit), len(txs))
+					return txs[start:end], nil
+				},
+			}
+
+			now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+			sut := bulk_export.New(slog.Default(), exportStore,
+				bulk_export.WithBatchSize(2),
+				bulk_export.WithNow(func() time.Time { return now }),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/admin/export?"+tc.query, nil)
+			rec := httptest.NewRecorder()
+
+			// when
+			sut.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedCode, rec.Code)
+			require.Len(t, exportStore.GetExportCalls(), tc.expectedGetCalls)
+
+			if tc.expectedCode != http.StatusOK {
+				return
+			}
+
+			filter := exportStore.GetExportCalls()[0].Filter
+			require.Equal(t, tc.expectedStatuses, filter.Statuses)
+			require.Equal(t, tc.expectedTenant, filter.Tenant)
+			require.Equal(t, now, filter.To)
+			require.Equal(t, now.Add(-24*time.Hour), filter.From)
+
+			var txIDs []string
+			if strings.Contains(tc.query, "format=csv") {
+				require.Equal(t, "text/csv", rec.Header().Get("Content-Type"))
+				records, err := csv.NewReader(rec.Body).ReadAll()
+				require.NoError(t, err)
+				require.Equal(t, "txid", records[0][0])
+				for _, record := range records[1:] {
+					txIDs = append(txIDs, record[0])
+				}
+			} else {
+				require.Equal(t, "application/x-ndjson", rec.Header().Get("Content-Type"))
+				scanner := bufio.NewScanner(rec.Body)
+				for scanner.Scan() {
+					var row bulk_export.Row
+					require.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+					txIDs = append(txIDs, row.TxID)
+				}
+			}
+			require.Equal(t, tc.expectedTxIDs, txIDs)
+		})
+	}
+}
+
+func TestExporter_Export(t *testing.T) {
+	// given
+	storedAt := time.Date(2026, 1, 1, 12, 0, 0, 123456000, time.UTC)
+	var filters []store.ExportFilter
+	exportStore := &mocks.StoreMock{
+		GetExportFunc: func(_ context.Context, filter store.ExportFilter, _ int64) ([]*store.Data, error) {
+			filters = append(filters, filter)
+			if len(filters) > 1 {
+				return nil, nil
+			}
+			return []*store.Data{{Hash: testdata.TX1Hash, Status: metamorph_api.Status_MINED, StoredAt: storedAt}}, nil
+		},
+	}
+
+	sut := bulk_export.New(slog.Default(), exportStore, bulk_export.WithBatchSize(1))
+
+	var first strings.Builder
+	count, err := sut.Export(context.Background(), &first, bulk_export.Query{Format: bulk_export.FormatNDJSON})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	var row bulk_export.Row
+	require.NoError(t, json.Unmarshal([]byte(first.String()), &row))
+
+	// when
+	q, err := sut.ParseQuery(map[string][]string{"cursor": {row.Cursor}})
+	require.NoError(t, err)
+
+	// then
+	require.Equal(t, storedAt, q.Filter.AfterStoredAt)
+	require.Equal(t, testdata.TX1Hash, q.Filter.AfterHash)
+	require.Equal(t, storedAt, filters[1].AfterStoredAt)
+	require.Equal(t, testdata.TX1Hash, filters[1].AfterHash)
+}
@@ -2,6 +2,7 @@ package metamorph
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
 	"os"
@@ -11,16 +12,24 @@ import (
 
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/validator"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
 var (
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound     = errors.New("transaction not found")
+	ErrTransactionNotRejected  = errors.New("transaction is not rejected")
+	ErrQuarantineExpired       = errors.New("quarantine of rejected transaction has expired")
+	ErrTransactionNotScheduled = errors.New("transaction is not scheduled for broadcast or has already been broadcast")
+	ErrInvalidReverification   = errors.New("invalid options of reverification")
 )
 
 type TransactionHandler interface {
@@ -29,6 +38,10 @@ type TransactionHandler interface {
 	GetTransactionStatus(ctx context.Context, txID string) (*TransactionStatus, error)
 	GetTransactionStatuses(ctx context.Context, txIDs []string) ([]*TransactionStatus, error)
 	SubmitTransactions(ctx context.Context, tx sdkTx.Transactions, options *TransactionOptions) ([]*TransactionStatus, error)
+	ResubmitTransaction(ctx context.Context, txID string) (*TransactionStatus, error)
+	GetTransactionGraph(ctx context.Context, txID string, maxNodes uint32) (*TransactionGraph, error)
+	CancelScheduledBroadcast(ctx context.Context, txID string) error
+	GetOutpointSpender(ctx context.Context, txID string, vout uint32) (*OutpointSpender, error)
 }
 
 // TransactionStatus defines model for TransactionStatus.
@@ -43,12 +56,23 @@ type TransactionStatus struct {
 	CompetingTxs  []string
 	LastSubmitted timestamppb.Timestamp
 	Timestamp     int64
+	// StageTimings is the timing breakdown of the stages the transaction has reached
+	StageTimings []StageTiming
+	// BlockTemplate is the block template in which the unmined transaction is included, if any
+	BlockTemplate *BlockTemplate
+	// PeerAcks are the peers which requested the transaction after its announcement or to which it was sent
+	PeerAcks []PeerAck
+	// Annotations are the notes and labels attached to the transaction by operators
+	Annotations []Annotation
+	// NodeSubmission is the submission of the transaction to the node after no peer requested it, if any
+	NodeSubmission *NodeSubmission
 }
 
 // Metamorph is the connector to a metamorph server.
 type Metamorph struct {
 	client            metamorph_api.MetaMorphAPIClient
 	mqClient          mq.MessageQueueClient
+	queueSubmissions  bool
 	logger            *slog.Logger
 	now               func() time.Time
 	tracingEnabled    bool
@@ -61,6 +85,14 @@ func WithMqClient(mqClient mq.MessageQueueClient) func(*Metamorph) {
 	}
 }
 
+// WithQueueSubmissions publishes submitted transactions to the message queue if metamorph is unavailable instead of
+// failing the submission. Requires a message queue client.
+func WithQueueSubmissions(enabled bool) func(*Metamorph) {
+	return func(m *Metamorph) {
+		m.queueSubmissions = enabled
+	}
+}
+
 func WithClientNow(nowFunc func() time.Time) func(*Metamorph) {
 	return func(p *Metamorph) {
 		p.now = nowFunc
@@ -184,12 +216,283 @@ func (m *Metamorph) GetTransactionStatus(ctx context.Context, txID string) (txSt
 		Timestamp:    m.now().Unix(),
 	}
 
+	if tx.GetLastSubmitted() != nil {
+		txStatus.LastSubmitted = *tx.GetLastSubmitted()
+	}
+
+	for _, timing := range tx.GetStageTimings() {
+		txStatus.StageTimings = append(txStatus.StageTimings, StageTiming{
+			Stage:     timing.GetStage(),
+			Timestamp: timeOrZero(timing.GetTimestamp()),
+			Latency:   time.Duration(timing.GetLatencyMs()) * time.Millisecond,
+		})
+	}
+
+	if template := tx.GetBlockTemplate(); template != nil {
+		txStatus.BlockTemplate = &BlockTemplate{
+			Source:            template.GetSource(),
+			PreviousBlockHash: template.GetPreviousBlockHash(),
+			Timestamp:         timeOrZero(template.GetTimestamp()),
+		}
+	}
+
+	txStatus.PeerAcks = peerAcksFromProto(tx.GetPeerAcks())
+	txStatus.Annotations = annotationsFromProto(tx.GetAnnotations())
+	txStatus.NodeSubmission = nodeSubmissionFromProto(tx.GetNodeSubmission())
+	return txStatus, nil
+}
+
+// ResubmitTransaction resubmits a quarantined rejected transaction.
+func (m *Metamorph) ResubmitTransaction(ctx context.Context, txID string) (txStatus *TransactionStatus, err error) {
+	ctx, span := tracing.StartTracing(ctx, "ResubmitTransaction", m.tracingEnabled, append(m.tracingAttributes, attribute.String("txID", txID))...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	var tx *metamorph_api.TransactionStatus
+	tx, err = m.client.ResubmitTransaction(ctx, &metamorph_api.TransactionStatusRequest{
+		Txid: txID,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), ErrNotFound.Error()):
+			return nil, ErrTransactionNotFound
+		case strings.Contains(err.Error(), ErrNotRejected.Error()):
+			return nil, ErrTransactionNotRejected
+		case strings.Contains(err.Error(), ErrRejectedTxExpired.Error()):
+			return nil, ErrQuarantineExpired
+		}
+		return nil, err
+	}
+
+	txStatus = &TransactionStatus{
+		TxID:      txID,
+		Status:    tx.GetStatus().String(),
+		Callbacks: tx.GetCallbacks(),
+		Timestamp: m.now().Unix(),
+	}
+
 	if tx.GetLastSubmitted() != nil {
 		txStatus.LastSubmitted = *tx.GetLastSubmitted()
 	}
 	return txStatus, nil
 }
 
+// GetTransactionGraph gets the transaction with its unmined ancestors and descendants known to metamorph.
+func (m *Metamorph) GetTransactionGraph(ctx context.Context, txID string, maxNodes uint32) (graph *TransactionGraph, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetTransactionGraph", m.tracingEnabled, append(m.tracingAttributes, attribute.String("txID", txID))...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	var resp *metamorph_api.TransactionGraph
+	resp, err = m.client.GetTransactionGraph(ctx, &metamorph_api.TransactionGraphRequest{
+		Txid:     txID,
+		MaxNodes: maxNodes,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), ErrNotFound.Error()) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return transactionGraphFromProto(resp), nil
+}
+
+// CancelScheduledBroadcast cancels the broadcast of a scheduled transaction which has not been announced yet.
+func (m *Metamorph) CancelScheduledBroadcast(ctx context.Context, txID string) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "CancelScheduledBroadcast", m.tracingEnabled, append(m.tracingAttributes, attribute.String("txID", txID))...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	_, err = m.client.CancelScheduledBroadcast(ctx, &metamorph_api.TransactionStatusRequest{
+		Txid: txID,
+	})
+	if err != nil {
+		switch {
+		case strings.Contains(err.Error(), ErrNotFound.Error()):
+			return ErrTransactionNotFound
+		case strings.Contains(err.Error(), ErrNotScheduled.Error()):
+			return ErrTransactionNotScheduled
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RejectTransactions rejects transactions which were never broadcast for the reason, e.g. deferred transactions whose
+// Merkle roots could not be verified. The rejected transactions are stored with their callbacks, to which the rejection
+// is sent.
+func (m *Metamorph) RejectTransactions(ctx context.Context, txs sdkTx.Transactions, options *TransactionOptions, reason string) (txStatuses []*TransactionStatus, err error) {
+	ctx, span := tracing.StartTracing(ctx, "RejectTransactions", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	responses, err := m.client.RejectTransactions(ctx, &metamorph_api.RejectTransactionsRequest{
+		Transactions: transactionRequests(txs, options),
+		RejectReason: reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, response := range responses.GetStatuses() {
+		txStatuses = append(txStatuses, &TransactionStatus{
+			TxID:      response.GetTxid(),
+			Status:    response.GetStatus().String(),
+			ExtraInfo: response.GetRejectReason(),
+			Timestamp: m.now().Unix(),
+		})
+	}
+
+	return txStatuses, nil
+}
+
+// AnnotateTransaction attaches a note and labels to the stored transaction on behalf of the author.
+func (m *Metamorph) AnnotateTransaction(ctx context.Context, txID string, note string, labels []string, author string) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "AnnotateTransaction", m.tracingEnabled, append(m.tracingAttributes, attribute.String("txID", txID))...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	_, err = m.client.AnnotateTransaction(ctx, &metamorph_api.AnnotateTransactionRequest{
+		Txid:   txID,
+		Note:   note,
+		Labels: labels,
+		Author: author,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), ErrNotFound.Error()) {
+			return ErrTransactionNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// AddReverification keeps the BEEF whose Merkle roots could not be verified on submission in metamorph until they are
+// verified again, the ID of the reverification is set. It returns ErrReverificationsFull if the number of the
+// transactions of all reverifications would exceed maxTransactions.
+func (m *Metamorph) AddReverification(ctx context.Context, reverification *Reverification, maxTransactions int) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "AddReverification", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	var options []byte
+	var tenant string
+	if reverification.Options != nil {
+		options, err = json.Marshal(reverification.Options)
+		if err != nil {
+			return err
+		}
+		tenant = reverification.Options.Tenant
+	}
+
+	resp, err := m.client.AddReverification(ctx, &metamorph_api.AddReverificationRequest{
+		Reverification: &metamorph_api.Reverification{
+			Mode:    reverification.Mode,
+			Beef:    reverification.Beef,
+			Txids:   reverification.TxIDs,
+			Options: options,
+			Tenant:  tenant,
+		},
+		MaxTransactions: int64(maxTransactions),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), ErrReverificationsFull.Error()) {
+			return ErrReverificationsFull
+		}
+		return err
+	}
+
+	reverification.ID = resp.GetId()
+	reverification.AddedAt = timeOrZero(resp.GetAddedAt())
+
+	return nil
+}
+
+// GetReverifications returns at most limit reverifications which are not re-verified by another API instance and
+// locks them until lockedUntil, and the number of the transactions of all reverifications.
+func (m *Metamorph) GetReverifications(ctx context.Context, limit int64, lockedUntil time.Time) (reverifications []*Reverification, unverified int64, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetReverifications", m.tracingEnabled, m.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	resp, err := m.client.GetReverifications(ctx, &metamorph_api.GetReverificationsRequest{
+		Limit:       limit,
+		LockedUntil: timestamppb.New(lockedUntil),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reverifications = make([]*Reverification, 0, len(resp.GetReverifications()))
+	for _, r := range resp.GetReverifications() {
+		// the options are empty if the client data of the tenant was erased
+		options := &TransactionOptions{}
+		if len(r.GetOptions()) > 0 {
+			err = json.Unmarshal(r.GetOptions(), options)
+			if err != nil {
+				return nil, 0, errors.Join(ErrInvalidReverification, err)
+			}
+		}
+		options.Tenant = r.GetTenant()
+
+		reverifications = append(reverifications, &Reverification{
+			ID:      r.GetId(),
+			Mode:    r.GetMode(),
+			Beef:    r.GetBeef(),
+			TxIDs:   r.GetTxids(),
+			Options: options,
+			AddedAt: timeOrZero(r.GetAddedAt()),
+		})
+	}
+
+	return reverifications, resp.GetUnverifiedTxs(), nil
+}
+
+// DeleteReverification deletes the reverification once its transactions are verified or given up on.
+func (m *Metamorph) DeleteReverification(ctx context.Context, id int64) error {
+	_, err := m.client.DeleteReverification(ctx, &metamorph_api.ReverificationRequest{Id: id})
+	return err
+}
+
+// GetUnverifiedTransactions returns those of the transactions whose Merkle roots are re-verified.
+func (m *Metamorph) GetUnverifiedTransactions(ctx context.Context, txIDs []string) ([]string, error) {
+	resp, err := m.client.GetUnverifiedTransactions(ctx, &metamorph_api.TransactionsStatusRequest{TxIDs: txIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetTxIDs(), nil
+}
+
+// GetOutpointSpender gets the transaction known to metamorph which spends the outpoint.
+func (m *Metamorph) GetOutpointSpender(ctx context.Context, txID string, vout uint32) (spender *OutpointSpender, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetOutpointSpender", m.tracingEnabled, append(m.tracingAttributes, attribute.String("txID", txID))...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	var resp *metamorph_api.OutpointSpender
+	resp, err = m.client.GetOutpointSpender(ctx, &metamorph_api.OutpointSpenderRequest{
+		Txid: txID,
+		Vout: vout,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outpointSpenderFromProto(resp), nil
+}
+
 // GetTransactionStatuses gets the status of all transactions.
 func (m *Metamorph) GetTransactionStatuses(ctx context.Context, txIDs []string) (txStatus []*TransactionStatus, err error) {
 	tracingAttr := m.tracingAttributes
@@ -256,31 +559,10 @@ func (m *Metamorph) SubmitTransactions(ctx context.Context, txs sdkTx.Transactio
 	}()
 
 	// prepare transaction inputs
-	in := new(metamorph_api.PostTransactionsRequest)
-	in.Transactions = make([]*metamorph_api.PostTransactionRequest, 0)
-	for _, tx := range txs {
-		in.Transactions = append(in.Transactions, transactionRequest(tx.Bytes(), options))
-	}
+	in := &metamorph_api.PostTransactionsRequest{Transactions: transactionRequests(txs, options)}
 
 	if options.WaitForStatus == metamorph_api.Status_QUEUED && m.mqClient != nil {
-		for _, tx := range in.Transactions {
-			err = m.mqClient.PublishMarshal(ctx, mq.SubmitTxTopic, tx)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		// parse response and return to user
-		ret := make([]*TransactionStatus, 0)
-		for _, tx := range txs {
-			ret = append(ret, &TransactionStatus{
-				TxID:      tx.TxID().String(),
-				Status:    metamorph_api.Status_QUEUED.String(),
-				Timestamp: m.now().Unix(),
-			})
-		}
-
-		return ret, nil
+		return m.publishSubmitTxs(ctx, txs, in)
 	}
 	var responses *metamorph_api.TransactionStatuses
 
@@ -296,6 +578,11 @@ func (m *Metamorph) SubmitTransactions(ctx context.Context, txs sdkTx.Transactio
 
 	responses, err = m.client.PostTransactions(ctx, in)
 	if err != nil {
+		if m.queueSubmissions && m.mqClient != nil && status.Code(err) == codes.Unavailable {
+			m.logger.WarnContext(ctx, "Metamorph unavailable - queueing submitted transactions", slog.Int("count", len(txs)), slog.String("err", err.Error()))
+			return m.publishSubmitTxs(ctx, txs, in)
+		}
+
 		return nil, err
 	}
 	for _, response := range responses.GetStatuses() {
@@ -314,29 +601,146 @@ func (m *Metamorph) SubmitTransactions(ctx context.Context, txs sdkTx.Transactio
 	return txStatuses, nil
 }
 
+// publishSubmitTxs publishes transactions to the message queue from which they are consumed by metamorph.
+func (m *Metamorph) publishSubmitTxs(ctx context.Context, txs sdkTx.Transactions, in *metamorph_api.PostTransactionsRequest) ([]*TransactionStatus, error) {
+	for _, tx := range in.Transactions {
+		err := m.mqClient.PublishMarshal(ctx, mq.SubmitTxTopic, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// parse response and return to user
+	ret := make([]*TransactionStatus, 0)
+	for _, tx := range txs {
+		ret = append(ret, &TransactionStatus{
+			TxID:      hexutils.TxID(tx.TxID()),
+			Status:    metamorph_api.Status_QUEUED.String(),
+			Timestamp: m.now().Unix(),
+		})
+	}
+
+	return ret, nil
+}
+
+func transactionRequests(txs sdkTx.Transactions, options *TransactionOptions) []*metamorph_api.PostTransactionRequest {
+	requests := make([]*metamorph_api.PostTransactionRequest, 0, len(txs))
+	for _, tx := range txs {
+		request := transactionRequest(tx.Bytes(), options)
+		var txIDBuf [64]byte
+		txID := string(hexutils.AppendTxID(txIDBuf[:0], tx.TxID()))
+		request.Consolidation = options.ConsolidationTxIDs[txID]
+		if options.NonFinalTxIDs[txID] {
+			request.LockTime = tx.LockTime
+		}
+		requests = append(requests, request)
+	}
+
+	return requests
+}
+
 func transactionRequest(rawTx []byte, options *TransactionOptions) *metamorph_api.PostTransactionRequest {
-	return &metamorph_api.PostTransactionRequest{
-		RawTx:             rawTx,
-		CallbackUrl:       options.CallbackURL,
-		CallbackToken:     options.CallbackToken,
-		CallbackBatch:     options.CallbackBatch,
-		WaitForStatus:     options.WaitForStatus,
-		FullStatusUpdates: options.FullStatusUpdates,
+	request := &metamorph_api.PostTransactionRequest{
+		RawTx:                     rawTx,
+		CallbackUrl:               options.CallbackURL,
+		CallbackToken:             options.CallbackToken,
+		CallbackBatch:             options.CallbackBatch,
+		CallbackVersion:           options.CallbackVersion,
+		CallbackAllowDuplicates:   options.CallbackAllowDuplicates,
+		CallbackSuppressionWindow: options.CallbackSuppressionWindow,
+		WaitForStatus:             options.WaitForStatus,
+		FullStatusUpdates:         options.FullStatusUpdates,
+		Tenant:                    options.Tenant,
+		AnnounceTo:                options.AnnounceTo,
+	}
+
+	if !options.ReceivedAt.IsZero() {
+		request.ReceivedAt = timestamppb.New(options.ReceivedAt)
+	}
+
+	if !options.ValidatedAt.IsZero() {
+		request.ValidatedAt = timestamppb.New(options.ValidatedAt)
+	}
+
+	if !options.BroadcastAt.IsZero() {
+		request.BroadcastAt = timestamppb.New(options.BroadcastAt)
+	}
+
+	if !options.ExpiresAt.IsZero() {
+		request.ExpiresAt = timestamppb.New(options.ExpiresAt)
+	}
+
+	for _, recipient := range options.AdditionalCallbacks {
+		request.AdditionalCallbacks = append(request.AdditionalCallbacks, &metamorph_api.Callback{
+			CallbackUrl:       recipient.URL,
+			CallbackToken:     recipient.Token,
+			AllowBatch:        options.CallbackBatch,
+			CallbackVersion:   options.CallbackVersion,
+			AllowDuplicates:   options.CallbackAllowDuplicates,
+			SuppressionWindow: options.CallbackSuppressionWindow,
+		})
 	}
+
+	return request
 }
 
 // TransactionOptions options passed from header when creating transactions.
 type TransactionOptions struct {
-	CallbackURL             string               `json:"callback_url,omitempty"`
-	CallbackToken           string               `json:"callback_token,omitempty"`
-	CallbackBatch           bool                 `json:"callback_batch,omitempty"`
-	SkipFeeValidation       bool                 `json:"X-SkipFeeValidation,omitempty"`
-	SkipScriptValidation    bool                 `json:"X-SkipScriptValidation,omitempty"`
-	SkipTxValidation        bool                 `json:"X-SkipTxValidation,omitempty"`
-	ForceValidation         bool                 `json:"X-ForceValidation,omitempty"`
-	CumulativeFeeValidation bool                 `json:"X-CumulativeFeeValidation,omitempty"`
-	WaitForStatus           metamorph_api.Status `json:"wait_for_status,omitempty"`
-	FullStatusUpdates       bool                 `json:"full_status_updates,omitempty"`
+	CallbackURL               string               `json:"callback_url,omitempty"`
+	CallbackToken             string               `json:"callback_token,omitempty"`
+	CallbackBatch             bool                 `json:"callback_batch,omitempty"`
+	CallbackVersion           int32                `json:"callback_version,omitempty"`
+	CallbackAllowDuplicates   bool                 `json:"callback_allow_duplicates,omitempty"`
+	CallbackSuppressionWindow int32                `json:"callback_suppression_window,omitempty"`
+	SkipFeeValidation         bool                 `json:"X-SkipFeeValidation,omitempty"`
+	SkipScriptValidation      bool                 `json:"X-SkipScriptValidation,omitempty"`
+	SkipTxValidation          bool                 `json:"X-SkipTxValidation,omitempty"`
+	ForceValidation           bool                 `json:"X-ForceValidation,omitempty"`
+	CumulativeFeeValidation   bool                 `json:"X-CumulativeFeeValidation,omitempty"`
+	WaitForStatus             metamorph_api.Status `json:"wait_for_status,omitempty"`
+	FullStatusUpdates         bool                 `json:"full_status_updates,omitempty"`
+	AdditionalCallbacks       []CallbackRecipient  `json:"additional_callbacks,omitempty"`
+	// ConsolidationTxIDs are the IDs of the submitted transactions classified as consolidation transactions
+	ConsolidationTxIDs map[string]bool `json:"consolidation_tx_ids,omitempty"`
+	// NonFinalTxIDs are the IDs of the submitted transactions which are not final and held until they are final
+	NonFinalTxIDs map[string]bool `json:"non_final_tx_ids,omitempty"`
+	// ReceivedAt and ValidatedAt are the times at which the API received and validated the submitted transactions
+	ReceivedAt  time.Time `json:"received_at,omitzero"`
+	ValidatedAt time.Time `json:"validated_at,omitzero"`
+	// AllowedScriptTemplates restrict the output scripts of the submitted transactions to the templates allowed for the
+	// API key of the request
+	AllowedScriptTemplates []validator.ScriptTemplate `json:"-"`
+	// AbuseKey identifies the client of the request by which the submissions are scored for abuse
+	AbuseKey string `json:"-"`
+	// Tenant is the name of the tenant the API key of the request belongs to
+	Tenant string `json:"tenant,omitempty"`
+	// BroadcastAt is the time at which the submitted transactions are announced to the network, they are announced as
+	// soon as they are stored if it is zero
+	BroadcastAt time.Time `json:"broadcast_at,omitzero"`
+	// ExpiresAt is the time after which the submitted transactions expire if they are not mined, they do not expire if
+	// it is zero
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+	// AnnounceTo restricts the peers the submitted transactions are announced to, either the number of peers or the name
+	// of a designated peer, they are announced to all peers if it is empty
+	AnnounceTo string `json:"announce_to,omitempty"`
+}
+
+// CallbackRecipient is a further recipient of callbacks besides the primary callback URL of TransactionOptions.
+type CallbackRecipient struct {
+	URL   string `json:"url"`
+	Token string `json:"token,omitempty"`
+}
+
+// Reverification is a BEEF whose Merkle roots could not be verified on submission and are verified again by the API.
+// Mode is the degraded mode by which its transactions TxIDs were accepted or deferred, Options are the options with
+// which deferred transactions are submitted.
+type Reverification struct {
+	ID      int64
+	Mode    string
+	Beef    []byte
+	TxIDs   []string
+	Options *TransactionOptions
+	AddedAt time.Time
 }
 
 type Transaction struct {
@@ -2,6 +2,7 @@ package metamorph_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -13,8 +14,11 @@ import (
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	apiMocks "github.com/bitcoin-sv/arc/internal/metamorph/mocks"
 	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
@@ -215,6 +219,7 @@ func TestClient_SubmitTransactions(t *testing.T) {
 		getTxErr           error
 		getTxStatus        *metamorph_api.TransactionStatus
 		withMqClient       bool
+		queueSubmissions   bool
 		publishSubmitTxErr error
 
 		expectedErrorStr string
@@ -399,6 +404,44 @@ func TestClient_SubmitTransactions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "wait for received, metamorph unavailable, queue submissions",
+			options: &metamorph.TransactionOptions{
+				WaitForStatus: metamorph_api.Status_RECEIVED,
+			},
+			putTxErr:         status.Error(codes.Unavailable, "connection refused"),
+			withMqClient:     true,
+			queueSubmissions: true,
+
+			expectedStatuses: []*metamorph.TransactionStatus{
+				{
+					TxID:      tx1.TxID().String(),
+					Status:    metamorph_api.Status_QUEUED.String(),
+					Timestamp: now.Unix(),
+				},
+				{
+					TxID:      tx2.TxID().String(),
+					Status:    metamorph_api.Status_QUEUED.String(),
+					Timestamp: now.Unix(),
+				},
+				{
+					TxID:      tx3.TxID().String(),
+					Status:    metamorph_api.Status_QUEUED.String(),
+					Timestamp: now.Unix(),
+				},
+			},
+		},
+		{
+			name: "wait for received, metamorph unavailable, queue submissions disabled",
+			options: &metamorph.TransactionOptions{
+				WaitForStatus: metamorph_api.Status_RECEIVED,
+			},
+			putTxErr:     status.Error(codes.Unavailable, "connection refused"),
+			withMqClient: true,
+
+			expectedStatuses: nil,
+			expectedErrorStr: "connection refused",
+		},
 		{
 			name: "wait for queued, with mq client, publish submit tx err",
 			options: &metamorph.TransactionOptions{
@@ -426,6 +469,7 @@ func TestClient_SubmitTransactions(t *testing.T) {
 
 			opts := []func(client *metamorph.Metamorph){
 				metamorph.WithClientNow(func() time.Time { return now }),
+				metamorph.WithQueueSubmissions(tc.queueSubmissions),
 			}
 			if tc.withMqClient {
 				mqClient := &mqMocks.MessageQueueClientMock{
@@ -636,6 +680,10 @@ func TestClient_GetTransactionStatus(t *testing.T) {
 				BlockHeight:  200,
 				RejectReason: "none",
 				CompetingTxs: []string{"competingTx1"},
+				StageTimings: []*metamorph_api.StageTiming{
+					{Stage: metamorph.StageStored, Timestamp: timestamppb.New(time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC))},
+					{Stage: metamorph.StageMined, Timestamp: timestamppb.New(time.Date(2024, 9, 1, 12, 10, 0, 0, time.UTC)), LatencyMs: 600_000},
+				},
 			},
 			expectedStatus: &metamorph.TransactionStatus{
 				TxID:         "testTxID2",
@@ -646,6 +694,10 @@ func TestClient_GetTransactionStatus(t *testing.T) {
 				ExtraInfo:    "none",
 				CompetingTxs: []string{"competingTx1"},
 				Timestamp:    time.Now().Unix(),
+				StageTimings: []metamorph.StageTiming{
+					{Stage: metamorph.StageStored, Timestamp: time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)},
+					{Stage: metamorph.StageMined, Timestamp: time.Date(2024, 9, 1, 12, 10, 0, 0, time.UTC), Latency: 10 * time.Minute},
+				},
 			},
 		},
 	}
@@ -683,6 +735,278 @@ func TestClient_GetTransactionStatus(t *testing.T) {
 	}
 }
 
+func TestClient_ResubmitTransaction(t *testing.T) {
+	tt := []struct {
+		name        string
+		mockResp    *metamorph_api.TransactionStatus
+		mockErr     error
+		expectedErr error
+	}{
+		{
+			name: "success",
+			mockResp: &metamorph_api.TransactionStatus{
+				Txid:   "testTxID",
+				Status: metamorph_api.Status_STORED,
+			},
+		},
+		{
+			name:        "error - transaction not found",
+			mockErr:     status.Error(codes.Unknown, metamorph.ErrNotFound.Error()),
+			expectedErr: metamorph.ErrTransactionNotFound,
+		},
+		{
+			name:        "error - transaction not rejected",
+			mockErr:     status.Error(codes.Unknown, metamorph.ErrNotRejected.Error()),
+			expectedErr: metamorph.ErrTransactionNotRejected,
+		},
+		{
+			name:        "error - quarantine expired",
+			mockErr:     status.Error(codes.Unknown, metamorph.ErrRejectedTxExpired.Error()),
+			expectedErr: metamorph.ErrQuarantineExpired,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			mockClient := &apiMocks.MetaMorphAPIClientMock{
+				ResubmitTransactionFunc: func(_ context.Context, _ *metamorph_api.TransactionStatusRequest, _ ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+					return tc.mockResp, tc.mockErr
+				},
+			}
+
+			now := time.Now().Unix()
+			client := metamorph.NewClient(mockClient, metamorph.WithClientNow(func() time.Time { return time.Unix(now, 0) }))
+
+			// when
+			actual, err := client.ResubmitTransaction(context.Background(), "testTxID")
+
+			// then
+			require.Len(t, mockClient.ResubmitTransactionCalls(), 1)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &metamorph.TransactionStatus{
+				TxID:      "testTxID",
+				Status:    metamorph_api.Status_STORED.String(),
+				Timestamp: now,
+			}, actual)
+		})
+	}
+}
+
+func TestClient_GetTransactionGraph(t *testing.T) {
+	tt := []struct {
+		name        string
+		mockResp    *metamorph_api.TransactionGraph
+		mockErr     error
+		expectedErr error
+	}{
+		{
+			name: "success",
+			mockResp: &metamorph_api.TransactionGraph{
+				Txid: "testTxID",
+				Nodes: []*metamorph_api.TransactionGraphNode{
+					{Txid: "testTxID", Status: metamorph_api.Status_REJECTED, Size: 191, Fee: 10, FeeKnown: true, Parents: []string{"parentTxID"}},
+					{Txid: "parentTxID", Status: metamorph_api.Status_SEEN_ON_NETWORK, Children: []string{"testTxID"}},
+				},
+			},
+		},
+		{
+			name:        "error - transaction not found",
+			mockErr:     status.Error(codes.Unknown, metamorph.ErrNotFound.Error()),
+			expectedErr: metamorph.ErrTransactionNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			mockClient := &apiMocks.MetaMorphAPIClientMock{
+				GetTransactionGraphFunc: func(_ context.Context, _ *metamorph_api.TransactionGraphRequest, _ ...grpc.CallOption) (*metamorph_api.TransactionGraph, error) {
+					return tc.mockResp, tc.mockErr
+				},
+			}
+
+			client := metamorph.NewClient(mockClient)
+
+			// when
+			actual, err := client.GetTransactionGraph(context.Background(), "testTxID", 10)
+
+			// then
+			require.Len(t, mockClient.GetTransactionGraphCalls(), 1)
+			require.Equal(t, uint32(10), mockClient.GetTransactionGraphCalls()[0].In.GetMaxNodes())
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &metamorph.TransactionGraph{
+				TxID: "testTxID",
+				Nodes: []*metamorph.TransactionGraphNode{
+					{TxID: "testTxID", Status: metamorph_api.Status_REJECTED.String(), Size: 191, Fee: 10, FeeKnown: true, Parents: []string{"parentTxID"}},
+					{TxID: "parentTxID", Status: metamorph_api.Status_SEEN_ON_NETWORK.String(), Children: []string{"testTxID"}},
+				},
+			}, actual)
+		})
+	}
+}
+
+func TestClient_GetOutpointSpender(t *testing.T) {
+	tt := []struct {
+		name     string
+		mockResp *metamorph_api.OutpointSpender
+		mockErr  error
+
+		expected    *metamorph.OutpointSpender
+		expectedErr error
+	}{
+		{
+			name:     "spent",
+			mockResp: &metamorph_api.OutpointSpender{Spent: true, SpendingTxid: "spendingTxID", Status: metamorph_api.Status_SEEN_ON_NETWORK},
+
+			expected: &metamorph.OutpointSpender{Spent: true, SpendingTxID: "spendingTxID", Status: metamorph_api.Status_SEEN_ON_NETWORK.String()},
+		},
+		{
+			name:     "not spent",
+			mockResp: &metamorph_api.OutpointSpender{},
+
+			expected: &metamorph.OutpointSpender{},
+		},
+		{
+			name:    "error",
+			mockErr: errors.New("metamorph unavailable"),
+
+			expectedErr: errors.New("metamorph unavailable"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			mockClient := &apiMocks.MetaMorphAPIClientMock{
+				GetOutpointSpenderFunc: func(_ context.Context, _ *metamorph_api.OutpointSpenderRequest, _ ...grpc.CallOption) (*metamorph_api.OutpointSpender, error) {
+					return tc.mockResp, tc.mockErr
+				},
+			}
+
+			client := metamorph.NewClient(mockClient)
+
+			// when
+			actual, err := client.GetOutpointSpender(context.Background(), "testTxID", 3)
+
+			// then
+			require.Len(t, mockClient.GetOutpointSpenderCalls(), 1)
+			require.Equal(t, uint32(3), mockClient.GetOutpointSpenderCalls()[0].In.GetVout())
+
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestClient_AddReverification(t *testing.T) {
+	tt := []struct {
+		name    string
+		mockErr error
+
+		expectedErr error
+	}{
+		{
+			name: "added",
+		},
+		{
+			name:    "maximum number of transactions reached",
+			mockErr: status.Error(codes.Unknown, metamorph.ErrReverificationsFull.Error()),
+
+			expectedErr: metamorph.ErrReverificationsFull,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			mockClient := &apiMocks.MetaMorphAPIClientMock{
+				AddReverificationFunc: func(_ context.Context, in *metamorph_api.AddReverificationRequest, _ ...grpc.CallOption) (*metamorph_api.Reverification, error) {
+					if tc.mockErr != nil {
+						return nil, tc.mockErr
+					}
+					result := in.GetReverification()
+					result.Id = 3
+					result.AddedAt = timestamppb.New(testdata.Time)
+					return result, nil
+				},
+				GetReverificationsFunc: func(_ context.Context, _ *metamorph_api.GetReverificationsRequest, _ ...grpc.CallOption) (*metamorph_api.Reverifications, error) {
+					return &metamorph_api.Reverifications{
+						Reverifications: []*metamorph_api.Reverification{mockClientReverification(t)},
+						UnverifiedTxs:   1,
+					}, nil
+				},
+			}
+
+			client := metamorph.NewClient(mockClient)
+			reverification := &metamorph.Reverification{
+				Mode:    "defer",
+				Beef:    []byte{0x01},
+				TxIDs:   []string{testdata.TX1Hash.String()},
+				Options: &metamorph.TransactionOptions{CallbackURL: "https://callback.example.com", WaitForStatus: metamorph_api.Status_SEEN_ON_NETWORK, Tenant: "exchange-a"},
+			}
+
+			// when
+			err := client.AddReverification(context.Background(), reverification, 10)
+
+			// then
+			require.Len(t, mockClient.AddReverificationCalls(), 1)
+			require.Equal(t, int64(10), mockClient.AddReverificationCalls()[0].In.GetMaxTransactions())
+			require.Equal(t, "exchange-a", mockClient.AddReverificationCalls()[0].In.GetReverification().GetTenant())
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(3), reverification.ID)
+			require.Equal(t, testdata.Time, reverification.AddedAt)
+
+			// when
+			actual, unverified, err := client.GetReverifications(context.Background(), 100, testdata.Time)
+
+			// then
+			require.NoError(t, err)
+			require.Equal(t, int64(1), unverified)
+			require.Equal(t, []*metamorph.Reverification{reverification}, actual)
+		})
+	}
+}
+
+// mockClientReverification returns the reverification as it is added by TestClient_AddReverification.
+func mockClientReverification(t *testing.T) *metamorph_api.Reverification {
+	t.Helper()
+
+	options, err := json.Marshal(&metamorph.TransactionOptions{CallbackURL: "https://callback.example.com", WaitForStatus: metamorph_api.Status_SEEN_ON_NETWORK, Tenant: "exchange-a"})
+	require.NoError(t, err)
+
+	return &metamorph_api.Reverification{
+		Id:      3,
+		Mode:    "defer",
+		Beef:    []byte{0x01},
+		Txids:   []string{testdata.TX1Hash.String()},
+		Options: options,
+		AddedAt: timestamppb.New(testdata.Time),
+		Tenant:  "exchange-a",
+	}
+}
+
 func TestClient_Health(t *testing.T) {
 	tt := []struct {
 		name           string
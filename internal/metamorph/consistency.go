@@ -0,0 +1,126 @@
+package metamorph
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+const (
+	consistencyCheckIntervalDefault = 10 * time.Minute
+	consistencyCheckBlocksDefault   = 6
+	consistencyCheckBatchSize       = 1000
+
+	DriftNotMined     = "not_mined"
+	DriftNotInBlocktx = "not_in_blocktx"
+)
+
+// CheckConsistency verifies that metamorph and blocktx agree on the transactions mined in the recently mined blocks
+// of the longest chain:
+//   - transactions which blocktx registered in one of the blocks, but which are not mined in that block according to
+//     metamorph are repaired by updating them to MINED with the merkle path of the block, the callbacks are sent as for
+//     any other mined transaction
+//   - transactions which are mined in one of the blocks according to metamorph, but which blocktx did not register in
+//     the block are flagged, they are logged and counted
+//
+// The drift of the last check is exported as metric.
+func CheckConsistency(ctx context.Context, p *Processor) []attribute.KeyValue {
+	latestBlocks, err := p.blocktxClient.LatestBlocks(ctx, p.consistencyCheckBlocks)
+	if err != nil {
+		p.logger.Error("Failed to get latest blocks for consistency check", slog.String("err", err.Error()))
+		return nil
+	}
+
+	blockHashes := make([][]byte, 0, len(latestBlocks.GetBlocks()))
+	for _, block := range latestBlocks.GetBlocks() {
+		if block.GetProcessed() {
+			blockHashes = append(blockHashes, block.GetHash())
+		}
+	}
+
+	if len(blockHashes) == 0 {
+		return nil
+	}
+
+	registeredTxs, err := p.blocktxClient.RegisteredTransactions(ctx, blockHashes)
+	if err != nil {
+		p.logger.Error("Failed to get registered transactions of blocks for consistency check", slog.String("err", err.Error()))
+		return nil
+	}
+
+	drift := map[string]int{DriftNotMined: 0, DriftNotInBlocktx: 0}
+	registered := make(map[chainhash.Hash]struct{}, len(registeredTxs))
+	var repairs []*blocktx_api.TransactionBlock
+
+	for start := 0; start < len(registeredTxs); start += consistencyCheckBatchSize {
+		batch := registeredTxs[start:min(start+consistencyCheckBatchSize, len(registeredTxs))]
+
+		keys := make([][]byte, 0, len(batch))
+		txBlocks := make(map[chainhash.Hash]*blocktx_api.TransactionBlock, len(batch))
+		for _, txBlock := range batch {
+			hash, err := chainhash.NewHash(txBlock.GetTransactionHash())
+			if err != nil {
+				continue
+			}
+			registered[*hash] = struct{}{}
+			txBlocks[*hash] = txBlock
+			keys = append(keys, hash[:])
+		}
+
+		knownTxs, err := p.store.GetMany(ctx, keys)
+		if err != nil {
+			p.logger.Error("Failed to get transactions for consistency check", slog.String("err", err.Error()))
+			return nil
+		}
+
+		for _, data := range knownTxs {
+			txBlock, found := txBlocks[*data.Hash]
+			if !found {
+				continue
+			}
+
+			if data.Status == metamorph_api.Status_MINED && data.BlockHash != nil && bytes.Equal(data.BlockHash[:], txBlock.GetBlockHash()) {
+				continue
+			}
+
+			p.logger.Warn("Tx mined according to blocktx but not in metamorph", slog.String("hash", data.Hash.String()), slog.String("status", data.Status.String()), slog.Uint64("blockHeight", txBlock.GetBlockHeight()))
+			repairs = append(repairs, txBlock)
+			drift[DriftNotMined]++
+		}
+	}
+
+	minedTxs, err := p.store.GetMinedInBlocks(ctx, blockHashes)
+	if err != nil {
+		p.logger.Error("Failed to get mined transactions of blocks for consistency check", slog.String("err", err.Error()))
+	}
+
+	for _, hash := range minedTxs {
+		if _, found := registered[*hash]; found {
+			continue
+		}
+
+		p.logger.Warn("Tx mined according to metamorph but not registered in block by blocktx", slog.String("hash", hash.String()))
+		drift[DriftNotInBlocktx]++
+	}
+
+	if len(repairs) > 0 {
+		p.updateMined(ctx, repairs)
+	}
+
+	for kind, count := range drift {
+		p.stats.consistencyDrift.WithLabelValues(kind).Set(float64(count))
+	}
+
+	if drift[DriftNotMined] > 0 || drift[DriftNotInBlocktx] > 0 {
+		p.logger.Warn("Found inconsistencies between metamorph and blocktx", slog.Int("blocks", len(blockHashes)), slog.Int(DriftNotMined, drift[DriftNotMined]), slog.Int(DriftNotInBlocktx, drift[DriftNotInBlocktx]))
+	}
+
+	return []attribute.KeyValue{attribute.Int("blocks", len(blockHashes)), attribute.Int(DriftNotMined, drift[DriftNotMined]), attribute.Int(DriftNotInBlocktx, drift[DriftNotInBlocktx])}
+}
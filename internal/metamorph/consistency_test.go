@@ -0,0 +1,153 @@
+package metamorph_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestCheckConsistency(t *testing.T) {
+	tt := []struct {
+		name                string
+		blockProcessed      bool
+		latestBlocksErr     error
+		registeredTxsErr    error
+		getMinedInBlocksErr error
+
+		expectedAttributes            []attribute.KeyValue
+		expectedRegisteredTxsCalls    int
+		expectedUpdateMinedCalls      int
+		expectedGetMinedInBlocksCalls int
+	}{
+		{
+			name:           "success",
+			blockProcessed: true,
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int("blocks", 1),
+				attribute.Int(metamorph.DriftNotMined, 1),
+				attribute.Int(metamorph.DriftNotInBlocktx, 1),
+			},
+			expectedRegisteredTxsCalls:    1,
+			expectedUpdateMinedCalls:      1,
+			expectedGetMinedInBlocksCalls: 1,
+		},
+		{
+			name:                "success - failed to get mined transactions",
+			blockProcessed:      true,
+			getMinedInBlocksErr: errors.New("failed to get mined txs"),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int("blocks", 1),
+				attribute.Int(metamorph.DriftNotMined, 1),
+				attribute.Int(metamorph.DriftNotInBlocktx, 0),
+			},
+			expectedRegisteredTxsCalls:    1,
+			expectedUpdateMinedCalls:      1,
+			expectedGetMinedInBlocksCalls: 1,
+		},
+		{
+			name: "no processed blocks",
+		},
+		{
+			name:            "error - latest blocks",
+			blockProcessed:  true,
+			latestBlocksErr: errors.New("failed to get latest blocks"),
+		},
+		{
+			name:             "error - registered transactions",
+			blockProcessed:   true,
+			registeredTxsErr: errors.New("failed to get registered txs"),
+
+			expectedRegisteredTxsCalls: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			blocktxClient := &btxMocks.ClientMock{
+				LatestBlocksFunc: func(_ context.Context, blocks uint64) (*blocktx_api.LatestBlocksResponse, error) {
+					require.Equal(t, uint64(3), blocks)
+					if tc.latestBlocksErr != nil {
+						return nil, tc.latestBlocksErr
+					}
+
+					return &blocktx_api.LatestBlocksResponse{Blocks: []*blocktx_api.Block{
+						{Hash: testdata.Block1Hash[:], Height: 100, Processed: tc.blockProcessed},
+						{Hash: testdata.Block2Hash[:], Height: 101, Processed: false},
+					}}, nil
+				},
+				RegisteredTransactionsFunc: func(_ context.Context, blockHashes [][]byte) ([]*blocktx_api.TransactionBlock, error) {
+					require.Equal(t, [][]byte{testdata.Block1Hash[:]}, blockHashes)
+					if tc.registeredTxsErr != nil {
+						return nil, tc.registeredTxsErr
+					}
+
+					return []*blocktx_api.TransactionBlock{
+						{BlockHash: testdata.Block1Hash[:], BlockHeight: 100, TransactionHash: testdata.TX1Hash[:]},
+						{BlockHash: testdata.Block1Hash[:], BlockHeight: 100, TransactionHash: testdata.TX2Hash[:]},
+						{BlockHash: testdata.Block1Hash[:], BlockHeight: 100, TransactionHash: testdata.TX3Hash[:]},
+					}, nil
+				},
+			}
+
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetManyFunc: func(_ context.Context, keys [][]byte) ([]*store.Data, error) {
+					require.Len(t, keys, 3)
+
+					return []*store.Data{
+						{Hash: testdata.TX1Hash, Status: metamorph_api.Status_MINED, BlockHash: testdata.Block1Hash},
+						{Hash: testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+					}, nil
+				},
+				GetMinedInBlocksFunc: func(_ context.Context, _ [][]byte) ([]*chainhash.Hash, error) {
+					if tc.getMinedInBlocksErr != nil {
+						return nil, tc.getMinedInBlocksErr
+					}
+
+					return []*chainhash.Hash{testdata.TX1Hash, testdata.TX4Hash}, nil
+				},
+				UpdateMinedFunc: func(_ context.Context, txsBlocks []*blocktx_api.TransactionBlock) ([]*store.Data, error) {
+					require.Len(t, txsBlocks, 1)
+					require.Equal(t, testdata.TX2Hash[:], txsBlocks[0].GetTransactionHash())
+
+					return []*store.Data{{Hash: testdata.TX2Hash, Status: metamorph_api.Status_MINED}}, nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			sut, err := metamorph.NewProcessor(metamorphStore, cache.NewMemoryStore(), &mocks.MediatorMock{}, nil,
+				metamorph.WithBlocktxClient(blocktxClient),
+				metamorph.WithConsistencyCheck(time.Minute, 3),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			actual := metamorph.CheckConsistency(context.TODO(), sut)
+
+			// then
+			assert.Equal(t, tc.expectedAttributes, actual)
+			assert.Len(t, blocktxClient.RegisteredTransactionsCalls(), tc.expectedRegisteredTxsCalls)
+			assert.Len(t, metamorphStore.UpdateMinedCalls(), tc.expectedUpdateMinedCalls)
+			assert.Len(t, metamorphStore.GetMinedInBlocksCalls(), tc.expectedGetMinedInBlocksCalls)
+		})
+	}
+}
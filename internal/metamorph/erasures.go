@@ -0,0 +1,32 @@
+package metamorph
+
+import (
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const erasuresLimitDefault = 100
+
+func toErasureProto(erasure store.Erasure) *metamorph_api.Erasure {
+	txIDs := make([]string, 0, len(erasure.Hashes))
+	for _, hash := range erasure.Hashes {
+		h, err := chainhash.NewHash(hash)
+		if err != nil {
+			continue
+		}
+		txIDs = append(txIDs, h.String())
+	}
+
+	return &metamorph_api.Erasure{
+		Id:           erasure.ID,
+		Tenant:       erasure.Tenant,
+		Txids:        txIDs,
+		ErasedBy:     erasure.ErasedBy,
+		Reason:       erasure.Reason,
+		ErasedAt:     timestamppb.New(erasure.ErasedAt),
+		Transactions: erasure.Transactions,
+	}
+}
@@ -0,0 +1,84 @@
+package metamorph
+
+import (
+	"context"
+	"errors"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+var (
+	ErrBulkExportDisabled = errors.New("bulk export is not enabled")
+	ErrInvalidExportHash  = errors.New("invalid hash of last exported transaction")
+)
+
+// GetExport returns the next batch of the transactions matching the filter of the bulk export, at most the configured
+// batch size of transactions are returned at once.
+func (s *Server) GetExport(ctx context.Context, req *metamorph_api.ExportRequest) (*metamorph_api.ExportedTransactions, error) {
+	if s.exportBatchSize <= 0 {
+		return nil, ErrBulkExportDisabled
+	}
+
+	filter := store.ExportFilter{
+		From:     req.GetFrom().AsTime(),
+		To:       req.GetTo().AsTime(),
+		Statuses: req.GetStatuses(),
+		Tenant:   req.GetTenant(),
+	}
+
+	if len(req.GetAfterHash()) > 0 {
+		hash, err := chainhash.NewHash(req.GetAfterHash())
+		if err != nil {
+			return nil, errors.Join(ErrInvalidExportHash, err)
+		}
+		filter.AfterHash = hash
+		filter.AfterStoredAt = req.GetAfterStoredAt().AsTime()
+	}
+
+	limit := req.GetLimit()
+	if limit <= 0 || limit > s.exportBatchSize {
+		limit = s.exportBatchSize
+	}
+
+	batch, err := s.store.GetExport(ctx, filter, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &metamorph_api.ExportedTransactions{Transactions: make([]*metamorph_api.ExportedTransaction, 0, len(batch))}
+	for _, data := range batch {
+		result.Transactions = append(result.Transactions, toExportedTransactionProto(data))
+	}
+
+	return result, nil
+}
+
+func toExportedTransactionProto(data *store.Data) *metamorph_api.ExportedTransaction {
+	tx := &metamorph_api.ExportedTransaction{
+		Hash:         data.Hash[:],
+		Status:       data.Status,
+		StoredAt:     timestamppb.New(data.StoredAt),
+		BlockHeight:  data.BlockHeight,
+		RejectReason: data.RejectReason,
+		CompetingTxs: data.CompetingTxs,
+		Tenant:       data.Tenant,
+	}
+
+	if !data.LastModified.IsZero() {
+		tx.LastModified = timestamppb.New(data.LastModified)
+	}
+
+	if data.BlockHash != nil {
+		tx.BlockHash = data.BlockHash[:]
+	}
+
+	if data.MinedHash != nil {
+		tx.MinedHash = data.MinedHash[:]
+	}
+
+	return tx
+}
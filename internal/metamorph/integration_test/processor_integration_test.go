@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
 	"github.com/bitcoin-sv/arc/internal/cache"
@@ -37,7 +38,7 @@ func TestProcessor(t *testing.T) {
 		}
 
 		mqClient := &mqMocks.MessageQueueClientMock{
-			PublishAsyncFunc: func(_ string, _ []byte) error {
+			PublishMarshalAsyncFunc: func(_ context.Context, _ string, _ proto.Message) error {
 				return nil
 			},
 		}
@@ -0,0 +1,300 @@
+package metamorph
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/maphash"
+	"log/slog"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	knownTxFilterCapacityDefault          = 1_000_000
+	knownTxFilterFalsePositiveRateDefault = 0.01
+	knownTxFilterRebuildIntervalDefault   = 1 * time.Hour
+	knownTxFilterRebuildBatchSize         = 100_000
+)
+
+// bloomFilter is a fixed size bloom filter of transaction hashes.
+type bloomFilter struct {
+	bits   []atomic.Uint64
+	m      uint64
+	k      uint64
+	seed   maphash.Seed
+	filled atomic.Int64
+}
+
+func newBloomFilter(capacity int, falsePositiveRate float64) *bloomFilter {
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	m = max(m, 64)
+	k := uint64(math.Round(float64(m) / float64(capacity) * math.Ln2))
+	k = max(k, 1)
+
+	return &bloomFilter{
+		bits: make([]atomic.Uint64, (m+63)/64),
+		m:    m,
+		k:    k,
+		seed: maphash.MakeSeed(),
+	}
+}
+
+// locations derives the bit locations of the hash by double hashing. The hash is seeded, so that transaction hashes
+// chosen by a peer cannot be crafted to collide.
+func (b *bloomFilter) locations(hash *chainhash.Hash) (uint64, uint64) {
+	h := maphash.Bytes(b.seed, hash[:])
+	h2 := binary.LittleEndian.Uint64(hash[:8]) ^ (h >> 17)
+
+	return h, h2 | 1
+}
+
+func (b *bloomFilter) add(hash *chainhash.Hash) {
+	h1, h2 := b.locations(hash)
+	for i := range b.k {
+		bit := (h1 + i*h2) % b.m
+		mask := uint64(1) << (bit % 64)
+
+		old := b.bits[bit/64].Or(mask)
+		if old&mask == 0 {
+			b.filled.Add(1)
+		}
+	}
+}
+
+func (b *bloomFilter) mayContain(hash *chainhash.Hash) bool {
+	h1, h2 := b.locations(hash)
+	for i := range b.k {
+		bit := (h1 + i*h2) % b.m
+		if b.bits[bit/64].Load()&(uint64(1)<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// estimatedFalsePositiveRate estimates the false positive rate from the share of set bits.
+func (b *bloomFilter) estimatedFalsePositiveRate() float64 {
+	return math.Pow(float64(b.filled.Load())/float64(b.m), float64(b.k))
+}
+
+// knownTxFilter is an in-memory filter of the transactions known to metamorph. Transactions which are certainly
+// unknown short-circuit without a cache or database round trip. Transactions are added when they are stored and the
+// filter is rebuilt from the hashes of all stored transactions every rebuild interval, so that transactions deleted
+// from the store are dropped from the filter. Until the first rebuild has completed after start, the filter reports
+// every transaction as possibly known.
+type knownTxFilter struct {
+	mu      sync.RWMutex
+	current *bloomFilter
+	// next is the filter which is being rebuilt, it is nil if no rebuild is running
+	next  *bloomFilter
+	ready atomic.Bool
+
+	capacity          int
+	falsePositiveRate float64
+	rebuildInterval   time.Duration
+
+	negatives      atomic.Uint64
+	positives      atomic.Uint64
+	falsePositives atomic.Uint64
+
+	generationPositives      atomic.Uint64
+	generationFalsePositives atomic.Uint64
+
+	lookupsDesc                    *prometheus.Desc
+	falsePositivesDesc             *prometheus.Desc
+	falsePositiveRateDesc          *prometheus.Desc
+	estimatedFalsePositiveRateDesc *prometheus.Desc
+}
+
+func newKnownTxFilter(capacity int, falsePositiveRate float64, rebuildInterval time.Duration) *knownTxFilter {
+	if capacity <= 0 {
+		capacity = knownTxFilterCapacityDefault
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = knownTxFilterFalsePositiveRateDefault
+	}
+	if rebuildInterval <= 0 {
+		rebuildInterval = knownTxFilterRebuildIntervalDefault
+	}
+
+	return &knownTxFilter{
+		current: newBloomFilter(capacity, falsePositiveRate),
+
+		capacity:          capacity,
+		falsePositiveRate: falsePositiveRate,
+		rebuildInterval:   rebuildInterval,
+
+		lookupsDesc: prometheus.NewDesc("arc_metamorph_known_tx_filter_lookups_total",
+			"Number of lookups in the known transaction filter by result, negative lookups skip the cache and database lookups",
+			[]string{"result"}, nil,
+		),
+		falsePositivesDesc: prometheus.NewDesc("arc_metamorph_known_tx_filter_false_positives_total",
+			"Number of positive lookups in the known transaction filter of transactions which are not stored",
+			nil, nil,
+		),
+		falsePositiveRateDesc: prometheus.NewDesc("arc_metamorph_known_tx_filter_false_positive_rate",
+			"Share of false positives of the positive lookups in the known transaction filter since the last rebuild",
+			nil, nil,
+		),
+		estimatedFalsePositiveRateDesc: prometheus.NewDesc("arc_metamorph_known_tx_filter_estimated_false_positive_rate",
+			"False positive rate of the known transaction filter estimated from its fill ratio",
+			nil, nil,
+		),
+	}
+}
+
+// Add adds the transaction to the filter.
+func (f *knownTxFilter) Add(hash *chainhash.Hash) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	f.current.add(hash)
+	if f.next != nil {
+		f.next.add(hash)
+	}
+}
+
+// MayContain returns false if the transaction is certainly not known and true if it may be known.
+func (f *knownTxFilter) MayContain(hash *chainhash.Hash) bool {
+	if !f.ready.Load() {
+		return true
+	}
+
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.current.mayContain(hash) {
+		f.positives.Add(1)
+		f.generationPositives.Add(1)
+		return true
+	}
+
+	f.negatives.Add(1)
+	return false
+}
+
+// FalsePositive records that a transaction reported as possibly known by the filter is not stored.
+func (f *knownTxFilter) FalsePositive() {
+	if !f.ready.Load() {
+		return
+	}
+
+	f.falsePositives.Add(1)
+	f.generationFalsePositives.Add(1)
+}
+
+// rebuild builds a new filter from the hashes of all stored transactions, which are read in pages by getHashes, and
+// replaces the current filter by it. Transactions added during the rebuild are added to both filters. The current
+// filter is kept if the rebuild fails.
+func (f *knownTxFilter) rebuild(ctx context.Context, getHashes func(ctx context.Context, after []byte, limit int64) ([][]byte, error)) error {
+	next := newBloomFilter(f.capacity, f.falsePositiveRate)
+
+	f.mu.Lock()
+	f.next = next
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		f.next = nil
+		f.mu.Unlock()
+	}()
+
+	var after []byte
+	for {
+		hashes, err := getHashes(ctx, after, knownTxFilterRebuildBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, hash := range hashes {
+			h, err := chainhash.NewHash(hash)
+			if err != nil {
+				return err
+			}
+			next.add(h)
+		}
+
+		if len(hashes) < knownTxFilterRebuildBatchSize {
+			break
+		}
+		after = hashes[len(hashes)-1]
+	}
+
+	f.mu.Lock()
+	f.current = next
+	f.mu.Unlock()
+
+	f.generationPositives.Store(0)
+	f.generationFalsePositives.Store(0)
+	f.ready.Store(true)
+
+	return nil
+}
+
+// Describe writes all descriptors to the prometheus desc channel.
+func (f *knownTxFilter) Describe(ch chan<- *prometheus.Desc) {
+	ch <- f.lookupsDesc
+	ch <- f.falsePositivesDesc
+	ch <- f.falsePositiveRateDesc
+	ch <- f.estimatedFalsePositiveRateDesc
+}
+
+// Collect implements required collect function for all prometheus collectors
+func (f *knownTxFilter) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(f.lookupsDesc, prometheus.CounterValue, float64(f.negatives.Load()), "negative")
+	ch <- prometheus.MustNewConstMetric(f.lookupsDesc, prometheus.CounterValue, float64(f.positives.Load()), "positive")
+	ch <- prometheus.MustNewConstMetric(f.falsePositivesDesc, prometheus.CounterValue, float64(f.falsePositives.Load()))
+
+	var rate float64
+	if positives := f.generationPositives.Load(); positives > 0 {
+		rate = float64(f.generationFalsePositives.Load()) / float64(positives)
+	}
+	ch <- prometheus.MustNewConstMetric(f.falsePositiveRateDesc, prometheus.GaugeValue, rate)
+
+	f.mu.RLock()
+	estimated := f.current.estimatedFalsePositiveRate()
+	f.mu.RUnlock()
+	ch <- prometheus.MustNewConstMetric(f.estimatedFalsePositiveRateDesc, prometheus.GaugeValue, estimated)
+}
+
+// StartRebuildKnownTxFilter builds the known transaction filter from the store on start and rebuilds it every rebuild
+// interval.
+func (p *Processor) StartRebuildKnownTxFilter() {
+	ticker := time.NewTicker(p.knownTxFilter.rebuildInterval)
+	p.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			p.waitGroup.Done()
+		}()
+
+		p.rebuildKnownTxFilter()
+
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.rebuildKnownTxFilter()
+			}
+		}
+	}()
+}
+
+func (p *Processor) rebuildKnownTxFilter() {
+	start := time.Now()
+	err := p.knownTxFilter.rebuild(p.ctx, p.store.GetHashes)
+	if err != nil {
+		p.logger.Error("Failed to rebuild known transaction filter", slog.String("err", err.Error()))
+		return
+	}
+
+	p.logger.Info("Known transaction filter rebuilt", slog.String("duration", time.Since(start).String()))
+}
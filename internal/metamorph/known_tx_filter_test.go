@@ -0,0 +1,106 @@
+package metamorph
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTxHash(i int) *chainhash.Hash {
+	h := chainhash.Hash(sha256.Sum256([]byte{byte(i), byte(i >> 8), byte(i >> 16)}))
+	return &h
+}
+
+func TestKnownTxFilter(t *testing.T) {
+	t.Run("filter is rebuilt from the stored txs", func(t *testing.T) {
+		// given
+		stored := [][]byte{testTxHash(1)[:], testTxHash(2)[:], testTxHash(3)[:]}
+		slices.SortFunc(stored, bytes.Compare)
+
+		var pages int
+		getHashes := func(_ context.Context, after []byte, limit int64) ([][]byte, error) {
+			pages++
+			res := make([][]byte, 0)
+			for _, hash := range stored {
+				if bytes.Compare(hash, after) > 0 && int64(len(res)) < limit {
+					res = append(res, hash)
+				}
+			}
+			return res, nil
+		}
+
+		sut := newKnownTxFilter(1000, 0.01, time.Minute)
+
+		// every tx may be known until the filter is built
+		require.True(t, sut.MayContain(testTxHash(4)))
+
+		// when
+		err := sut.rebuild(context.Background(), getHashes)
+
+		// then
+		require.NoError(t, err)
+		require.Equal(t, 1, pages)
+		for i := 1; i <= 3; i++ {
+			assert.True(t, sut.MayContain(testTxHash(i)))
+		}
+		assert.False(t, sut.MayContain(testTxHash(4)))
+
+		// added txs are kept until the next rebuild
+		sut.Add(testTxHash(4))
+		assert.True(t, sut.MayContain(testTxHash(4)))
+
+		// deleted txs are dropped on rebuild
+		stored = stored[:0]
+		require.NoError(t, sut.rebuild(context.Background(), getHashes))
+		assert.False(t, sut.MayContain(testTxHash(1)))
+		assert.False(t, sut.MayContain(testTxHash(4)))
+
+		// the current filter is kept if the rebuild fails
+		sut.Add(testTxHash(5))
+		err = sut.rebuild(context.Background(), func(_ context.Context, _ []byte, _ int64) ([][]byte, error) {
+			return nil, errors.New("failed to get hashes")
+		})
+		require.Error(t, err)
+		assert.True(t, sut.MayContain(testTxHash(5)))
+	})
+
+	t.Run("false positive rate", func(t *testing.T) {
+		// given
+		const capacity = 10_000
+		sut := newKnownTxFilter(capacity, 0.01, time.Minute)
+		require.NoError(t, sut.rebuild(context.Background(), func(_ context.Context, _ []byte, _ int64) ([][]byte, error) {
+			return nil, nil
+		}))
+
+		for i := range capacity {
+			sut.Add(testTxHash(i))
+		}
+
+		// when
+		falsePositives := 0
+		for i := capacity; i < 2*capacity; i++ {
+			if sut.MayContain(testTxHash(i)) {
+				sut.FalsePositive()
+				falsePositives++
+			}
+		}
+
+		// then
+		for i := range capacity {
+			require.True(t, sut.MayContain(testTxHash(i)))
+		}
+
+		assert.Less(t, float64(falsePositives)/capacity, 0.02)
+		assert.Equal(t, uint64(falsePositives), sut.falsePositives.Load())
+		assert.Equal(t, uint64(capacity-falsePositives), sut.negatives.Load())
+		assert.InDelta(t, 0.01, sut.current.estimatedFalsePositiveRate(), 0.005)
+	})
+}
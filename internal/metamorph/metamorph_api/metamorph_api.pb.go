@@ -33,6 +33,7 @@ const (
 	Status_QUEUED                 Status = 10
 	Status_RECEIVED               Status = 20
 	Status_STORED                 Status = 30
+	Status_WAITING_FOR_FINALITY   Status = 35
 	Status_ANNOUNCED_TO_NETWORK   Status = 40
 	Status_REQUESTED_BY_NETWORK   Status = 50
 	Status_SENT_TO_NETWORK        Status = 60
@@ -40,6 +41,7 @@ const (
 	Status_SEEN_IN_ORPHAN_MEMPOOL Status = 80
 	Status_SEEN_ON_NETWORK        Status = 90
 	Status_DOUBLE_SPEND_ATTEMPTED Status = 100
+	Status_EXPIRED                Status = 105
 	Status_REJECTED               Status = 110
 	Status_MINED_IN_STALE_BLOCK   Status = 115
 	Status_MINED                  Status = 120
@@ -52,6 +54,7 @@ var (
 		10:  "QUEUED",
 		20:  "RECEIVED",
 		30:  "STORED",
+		35:  "WAITING_FOR_FINALITY",
 		40:  "ANNOUNCED_TO_NETWORK",
 		50:  "REQUESTED_BY_NETWORK",
 		60:  "SENT_TO_NETWORK",
@@ -59,6 +62,7 @@ var (
 		80:  "SEEN_IN_ORPHAN_MEMPOOL",
 		90:  "SEEN_ON_NETWORK",
 		100: "DOUBLE_SPEND_ATTEMPTED",
+		105: "EXPIRED",
 		110: "REJECTED",
 		115: "MINED_IN_STALE_BLOCK",
 		120: "MINED",
@@ -68,6 +72,7 @@ var (
 		"QUEUED":                 10,
 		"RECEIVED":               20,
 		"STORED":                 30,
+		"WAITING_FOR_FINALITY":   35,
 		"ANNOUNCED_TO_NETWORK":   40,
 		"REQUESTED_BY_NETWORK":   50,
 		"SENT_TO_NETWORK":        60,
@@ -75,6 +80,7 @@ var (
 		"SEEN_IN_ORPHAN_MEMPOOL": 80,
 		"SEEN_ON_NETWORK":        90,
 		"DOUBLE_SPEND_ATTEMPTED": 100,
+		"EXPIRED":                105,
 		"REJECTED":               110,
 		"MINED_IN_STALE_BLOCK":   115,
 		"MINED":                  120,
@@ -375,16 +381,28 @@ func (x *TransactionRequests) GetEventId() string {
 
 // swagger:model PostTransactionRequest
 type PostTransactionRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CallbackUrl       string                 `protobuf:"bytes,1,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
-	CallbackToken     string                 `protobuf:"bytes,2,opt,name=callback_token,json=callbackToken,proto3" json:"callback_token,omitempty"`
-	CallbackBatch     bool                   `protobuf:"varint,3,opt,name=callback_batch,json=callbackBatch,proto3" json:"callback_batch,omitempty"`
-	RawTx             []byte                 `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
-	WaitForStatus     Status                 `protobuf:"varint,5,opt,name=wait_for_status,json=waitForStatus,proto3,enum=metamorph_api.Status" json:"wait_for_status,omitempty"`
-	FullStatusUpdates bool                   `protobuf:"varint,6,opt,name=full_status_updates,json=fullStatusUpdates,proto3" json:"full_status_updates,omitempty"`
-	EventId           string                 `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	CallbackUrl               string                 `protobuf:"bytes,1,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	CallbackToken             string                 `protobuf:"bytes,2,opt,name=callback_token,json=callbackToken,proto3" json:"callback_token,omitempty"`
+	CallbackBatch             bool                   `protobuf:"varint,3,opt,name=callback_batch,json=callbackBatch,proto3" json:"callback_batch,omitempty"`
+	RawTx                     []byte                 `protobuf:"bytes,4,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	WaitForStatus             Status                 `protobuf:"varint,5,opt,name=wait_for_status,json=waitForStatus,proto3,enum=metamorph_api.Status" json:"wait_for_status,omitempty"`
+	FullStatusUpdates         bool                   `protobuf:"varint,6,opt,name=full_status_updates,json=fullStatusUpdates,proto3" json:"full_status_updates,omitempty"`
+	EventId                   string                 `protobuf:"bytes,7,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	CallbackVersion           int32                  `protobuf:"varint,8,opt,name=callback_version,json=callbackVersion,proto3" json:"callback_version,omitempty"`
+	AdditionalCallbacks       []*Callback            `protobuf:"bytes,9,rep,name=additional_callbacks,json=additionalCallbacks,proto3" json:"additional_callbacks,omitempty"`
+	CallbackAllowDuplicates   bool                   `protobuf:"varint,10,opt,name=callback_allow_duplicates,json=callbackAllowDuplicates,proto3" json:"callback_allow_duplicates,omitempty"`
+	Consolidation             bool                   `protobuf:"varint,11,opt,name=consolidation,proto3" json:"consolidation,omitempty"`
+	ReceivedAt                *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	ValidatedAt               *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=validated_at,json=validatedAt,proto3" json:"validated_at,omitempty"`
+	Tenant                    string                 `protobuf:"bytes,14,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	BroadcastAt               *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=broadcast_at,json=broadcastAt,proto3" json:"broadcast_at,omitempty"`
+	AnnounceTo                string                 `protobuf:"bytes,16,opt,name=announce_to,json=announceTo,proto3" json:"announce_to,omitempty"`
+	CallbackSuppressionWindow int32                  `protobuf:"varint,17,opt,name=callback_suppression_window,json=callbackSuppressionWindow,proto3" json:"callback_suppression_window,omitempty"` // window in seconds in which the intermediate statuses are coalesced for the callbacks
+	ExpiresAt                 *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LockTime                  uint32                 `protobuf:"varint,19,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"` // lock time of a non-final transaction which is held until it is final, 0 if it is not held
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *PostTransactionRequest) Reset() {
@@ -466,6 +484,90 @@ func (x *PostTransactionRequest) GetEventId() string {
 	return ""
 }
 
+func (x *PostTransactionRequest) GetCallbackVersion() int32 {
+	if x != nil {
+		return x.CallbackVersion
+	}
+	return 0
+}
+
+func (x *PostTransactionRequest) GetAdditionalCallbacks() []*Callback {
+	if x != nil {
+		return x.AdditionalCallbacks
+	}
+	return nil
+}
+
+func (x *PostTransactionRequest) GetCallbackAllowDuplicates() bool {
+	if x != nil {
+		return x.CallbackAllowDuplicates
+	}
+	return false
+}
+
+func (x *PostTransactionRequest) GetConsolidation() bool {
+	if x != nil {
+		return x.Consolidation
+	}
+	return false
+}
+
+func (x *PostTransactionRequest) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *PostTransactionRequest) GetValidatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidatedAt
+	}
+	return nil
+}
+
+func (x *PostTransactionRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *PostTransactionRequest) GetBroadcastAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BroadcastAt
+	}
+	return nil
+}
+
+func (x *PostTransactionRequest) GetAnnounceTo() string {
+	if x != nil {
+		return x.AnnounceTo
+	}
+	return ""
+}
+
+func (x *PostTransactionRequest) GetCallbackSuppressionWindow() int32 {
+	if x != nil {
+		return x.CallbackSuppressionWindow
+	}
+	return 0
+}
+
+func (x *PostTransactionRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PostTransactionRequest) GetLockTime() uint32 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
 // swagger:model PostTransactionsRequest
 type PostTransactionsRequest struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
@@ -645,12 +747,15 @@ func (x *Transaction) GetRawTx() []byte {
 }
 
 type Callback struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CallbackUrl   string                 `protobuf:"bytes,1,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
-	CallbackToken string                 `protobuf:"bytes,2,opt,name=callback_token,json=callbackToken,proto3" json:"callback_token,omitempty"`
-	AllowBatch    bool                   `protobuf:"varint,3,opt,name=allow_batch,json=allowBatch,proto3" json:"allow_batch,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CallbackUrl       string                 `protobuf:"bytes,1,opt,name=callback_url,json=callbackUrl,proto3" json:"callback_url,omitempty"`
+	CallbackToken     string                 `protobuf:"bytes,2,opt,name=callback_token,json=callbackToken,proto3" json:"callback_token,omitempty"`
+	AllowBatch        bool                   `protobuf:"varint,3,opt,name=allow_batch,json=allowBatch,proto3" json:"allow_batch,omitempty"`
+	CallbackVersion   int32                  `protobuf:"varint,4,opt,name=callback_version,json=callbackVersion,proto3" json:"callback_version,omitempty"`
+	AllowDuplicates   bool                   `protobuf:"varint,5,opt,name=allow_duplicates,json=allowDuplicates,proto3" json:"allow_duplicates,omitempty"`
+	SuppressionWindow int32                  `protobuf:"varint,6,opt,name=suppression_window,json=suppressionWindow,proto3" json:"suppression_window,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Callback) Reset() {
@@ -704,22 +809,48 @@ func (x *Callback) GetAllowBatch() bool {
 	return false
 }
 
+func (x *Callback) GetCallbackVersion() int32 {
+	if x != nil {
+		return x.CallbackVersion
+	}
+	return 0
+}
+
+func (x *Callback) GetAllowDuplicates() bool {
+	if x != nil {
+		return x.AllowDuplicates
+	}
+	return false
+}
+
+func (x *Callback) GetSuppressionWindow() int32 {
+	if x != nil {
+		return x.SuppressionWindow
+	}
+	return 0
+}
+
 // swagger:model TransactionStatus
 type TransactionStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TimedOut      bool                   `protobuf:"varint,1,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
-	StoredAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=stored_at,json=storedAt,proto3" json:"stored_at,omitempty"`
-	Txid          string                 `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
-	Status        Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=metamorph_api.Status" json:"status,omitempty"`
-	RejectReason  string                 `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
-	CompetingTxs  []string               `protobuf:"bytes,6,rep,name=competingTxs,proto3" json:"competingTxs,omitempty"`
-	BlockHeight   uint64                 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	BlockHash     string                 `protobuf:"bytes,8,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
-	MerklePath    string                 `protobuf:"bytes,9,opt,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
-	LastSubmitted *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_submitted,json=lastSubmitted,proto3" json:"last_submitted,omitempty"`
-	Callbacks     []*Callback            `protobuf:"bytes,11,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TimedOut       bool                   `protobuf:"varint,1,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	StoredAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=stored_at,json=storedAt,proto3" json:"stored_at,omitempty"`
+	Txid           string                 `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	Status         Status                 `protobuf:"varint,4,opt,name=status,proto3,enum=metamorph_api.Status" json:"status,omitempty"`
+	RejectReason   string                 `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	CompetingTxs   []string               `protobuf:"bytes,6,rep,name=competingTxs,proto3" json:"competingTxs,omitempty"`
+	BlockHeight    uint64                 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash      string                 `protobuf:"bytes,8,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	MerklePath     string                 `protobuf:"bytes,9,opt,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+	LastSubmitted  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_submitted,json=lastSubmitted,proto3" json:"last_submitted,omitempty"`
+	Callbacks      []*Callback            `protobuf:"bytes,11,rep,name=callbacks,proto3" json:"callbacks,omitempty"`
+	StageTimings   []*StageTiming         `protobuf:"bytes,12,rep,name=stage_timings,json=stageTimings,proto3" json:"stage_timings,omitempty"`
+	BlockTemplate  *BlockTemplate         `protobuf:"bytes,13,opt,name=block_template,json=blockTemplate,proto3" json:"block_template,omitempty"`
+	PeerAcks       []*PeerAck             `protobuf:"bytes,14,rep,name=peer_acks,json=peerAcks,proto3" json:"peer_acks,omitempty"`
+	Annotations    []*Annotation          `protobuf:"bytes,15,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	NodeSubmission *NodeSubmission        `protobuf:"bytes,16,opt,name=node_submission,json=nodeSubmission,proto3" json:"node_submission,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TransactionStatus) Reset() {
@@ -829,29 +960,67 @@ func (x *TransactionStatus) GetCallbacks() []*Callback {
 	return nil
 }
 
-// swagger:model TransactionStatuses
-type TransactionStatuses struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statuses      []*TransactionStatus   `protobuf:"bytes,1,rep,name=Statuses,proto3" json:"Statuses,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+func (x *TransactionStatus) GetStageTimings() []*StageTiming {
+	if x != nil {
+		return x.StageTimings
+	}
+	return nil
+}
+
+func (x *TransactionStatus) GetBlockTemplate() *BlockTemplate {
+	if x != nil {
+		return x.BlockTemplate
+	}
+	return nil
+}
+
+func (x *TransactionStatus) GetPeerAcks() []*PeerAck {
+	if x != nil {
+		return x.PeerAcks
+	}
+	return nil
+}
+
+func (x *TransactionStatus) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *TransactionStatus) GetNodeSubmission() *NodeSubmission {
+	if x != nil {
+		return x.NodeSubmission
+	}
+	return nil
+}
+
+// swagger:model NodeSubmission
+type NodeSubmission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// time at which the transaction was submitted to the node after no peer requested it
+	SubmittedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Accepted    bool                   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// reason given by the node if it rejected the transaction
+	RejectReason  string `protobuf:"bytes,3,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionStatuses) Reset() {
-	*x = TransactionStatuses{}
+func (x *NodeSubmission) Reset() {
+	*x = NodeSubmission{}
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionStatuses) String() string {
+func (x *NodeSubmission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionStatuses) ProtoMessage() {}
+func (*NodeSubmission) ProtoMessage() {}
 
-func (x *TransactionStatuses) ProtoReflect() protoreflect.Message {
+func (x *NodeSubmission) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -863,48 +1032,58 @@ func (x *TransactionStatuses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionStatuses.ProtoReflect.Descriptor instead.
-func (*TransactionStatuses) Descriptor() ([]byte, []int) {
+// Deprecated: Use NodeSubmission.ProtoReflect.Descriptor instead.
+func (*NodeSubmission) Descriptor() ([]byte, []int) {
 	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *TransactionStatuses) GetStatuses() []*TransactionStatus {
+func (x *NodeSubmission) GetSubmittedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Statuses
+		return x.SubmittedAt
 	}
 	return nil
 }
 
-func (x *TransactionStatuses) GetEventId() string {
+func (x *NodeSubmission) GetAccepted() bool {
 	if x != nil {
-		return x.EventId
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *NodeSubmission) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
 	}
 	return ""
 }
 
-// swagger:model TransactionRequest
-type TransactionStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Txid          string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+// swagger:model PeerAck
+type PeerAck struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Peer  string                 `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	// time at which the peer requested the transaction after its announcement
+	RequestedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	// time at which the transaction was sent to the peer
+	SentAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=sent_at,json=sentAt,proto3" json:"sent_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionStatusRequest) Reset() {
-	*x = TransactionStatusRequest{}
+func (x *PeerAck) Reset() {
+	*x = PeerAck{}
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionStatusRequest) String() string {
+func (x *PeerAck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionStatusRequest) ProtoMessage() {}
+func (*PeerAck) ProtoMessage() {}
 
-func (x *TransactionStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *PeerAck) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -916,47 +1095,56 @@ func (x *TransactionStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionStatusRequest.ProtoReflect.Descriptor instead.
-func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeerAck.ProtoReflect.Descriptor instead.
+func (*PeerAck) Descriptor() ([]byte, []int) {
 	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TransactionStatusRequest) GetTxid() string {
+func (x *PeerAck) GetPeer() string {
 	if x != nil {
-		return x.Txid
+		return x.Peer
 	}
 	return ""
 }
 
-func (x *TransactionStatusRequest) GetEventId() string {
+func (x *PeerAck) GetRequestedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EventId
+		return x.RequestedAt
 	}
-	return ""
+	return nil
 }
 
-// swagger:model UpdateInstancesRequest
-type UpdateInstancesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Instances     []string               `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PeerAck) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
 }
 
-func (x *UpdateInstancesRequest) Reset() {
-	*x = UpdateInstancesRequest{}
+// swagger:model BlockTemplate
+type BlockTemplate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Source            string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	PreviousBlockHash string                 `protobuf:"bytes,2,opt,name=previous_block_hash,json=previousBlockHash,proto3" json:"previous_block_hash,omitempty"`
+	Timestamp         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *BlockTemplate) Reset() {
+	*x = BlockTemplate{}
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInstancesRequest) String() string {
+func (x *BlockTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInstancesRequest) ProtoMessage() {}
+func (*BlockTemplate) ProtoMessage() {}
 
-func (x *UpdateInstancesRequest) ProtoReflect() protoreflect.Message {
+func (x *BlockTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,40 +1156,59 @@ func (x *UpdateInstancesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInstancesRequest.ProtoReflect.Descriptor instead.
-func (*UpdateInstancesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlockTemplate.ProtoReflect.Descriptor instead.
+func (*BlockTemplate) Descriptor() ([]byte, []int) {
 	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateInstancesRequest) GetInstances() []string {
+func (x *BlockTemplate) GetSource() string {
 	if x != nil {
-		return x.Instances
+		return x.Source
+	}
+	return ""
+}
+
+func (x *BlockTemplate) GetPreviousBlockHash() string {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return ""
+}
+
+func (x *BlockTemplate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
 	}
 	return nil
 }
 
-// swagger:model ClearDataRequest
-type ClearDataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RetentionDays int32                  `protobuf:"varint,1,opt,name=retentionDays,proto3" json:"retentionDays,omitempty"`
+// swagger:model PostBlockTemplateRequest
+type PostBlockTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// identifier of the mining pool or node which created the block template
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// hash of the block on top of which the block template is built
+	PreviousBlockHash string `protobuf:"bytes,2,opt,name=previous_block_hash,json=previousBlockHash,proto3" json:"previous_block_hash,omitempty"`
+	// IDs of the transactions of the block template or of a merkle subtree of it
+	Txids         []string `protobuf:"bytes,3,rep,name=txids,proto3" json:"txids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClearDataRequest) Reset() {
-	*x = ClearDataRequest{}
+func (x *PostBlockTemplateRequest) Reset() {
+	*x = PostBlockTemplateRequest{}
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearDataRequest) String() string {
+func (x *PostBlockTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearDataRequest) ProtoMessage() {}
+func (*PostBlockTemplateRequest) ProtoMessage() {}
 
-func (x *ClearDataRequest) ProtoReflect() protoreflect.Message {
+func (x *PostBlockTemplateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1013,40 +1220,55 @@ func (x *ClearDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearDataRequest.ProtoReflect.Descriptor instead.
-func (*ClearDataRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostBlockTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PostBlockTemplateRequest) Descriptor() ([]byte, []int) {
 	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ClearDataRequest) GetRetentionDays() int32 {
+func (x *PostBlockTemplateRequest) GetSource() string {
 	if x != nil {
-		return x.RetentionDays
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-// swagger:model ClearDataResponse
-type ClearDataResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	RecordsAffected int64                  `protobuf:"varint,1,opt,name=records_affected,json=recordsAffected,proto3" json:"records_affected,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *PostBlockTemplateRequest) GetPreviousBlockHash() string {
+	if x != nil {
+		return x.PreviousBlockHash
+	}
+	return ""
 }
 
-func (x *ClearDataResponse) Reset() {
-	*x = ClearDataResponse{}
+func (x *PostBlockTemplateRequest) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+// swagger:model PostBlockTemplateResponse
+type PostBlockTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// number of the transactions known to ARC which were marked as included in the block template
+	IncludedTransactions uint64 `protobuf:"varint,1,opt,name=included_transactions,json=includedTransactions,proto3" json:"included_transactions,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *PostBlockTemplateResponse) Reset() {
+	*x = PostBlockTemplateResponse{}
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClearDataResponse) String() string {
+func (x *PostBlockTemplateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearDataResponse) ProtoMessage() {}
+func (*PostBlockTemplateResponse) ProtoMessage() {}
 
-func (x *ClearDataResponse) ProtoReflect() protoreflect.Message {
+func (x *PostBlockTemplateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1058,40 +1280,42 @@ func (x *ClearDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearDataResponse.ProtoReflect.Descriptor instead.
-func (*ClearDataResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PostBlockTemplateResponse.ProtoReflect.Descriptor instead.
+func (*PostBlockTemplateResponse) Descriptor() ([]byte, []int) {
 	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ClearDataResponse) GetRecordsAffected() int64 {
+func (x *PostBlockTemplateResponse) GetIncludedTransactions() uint64 {
 	if x != nil {
-		return x.RecordsAffected
+		return x.IncludedTransactions
 	}
 	return 0
 }
 
-// swagger:model TransactionsStatusRequest
-type TransactionsStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TxIDs         []string               `protobuf:"bytes,1,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
+// swagger:model TransactionGraphRequest
+type TransactionGraphRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Txid  string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// maximum number of transactions in the graph, the graph is truncated if it has more transactions
+	MaxNodes      uint32 `protobuf:"varint,2,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactionsStatusRequest) Reset() {
-	*x = TransactionsStatusRequest{}
+func (x *TransactionGraphRequest) Reset() {
+	*x = TransactionGraphRequest{}
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactionsStatusRequest) String() string {
+func (x *TransactionGraphRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactionsStatusRequest) ProtoMessage() {}
+func (*TransactionGraphRequest) ProtoMessage() {}
 
-func (x *TransactionsStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *TransactionGraphRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1103,41 +1327,2229 @@ func (x *TransactionsStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactionsStatusRequest.ProtoReflect.Descriptor instead.
-func (*TransactionsStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionGraphRequest.ProtoReflect.Descriptor instead.
+func (*TransactionGraphRequest) Descriptor() ([]byte, []int) {
 	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TransactionsStatusRequest) GetTxIDs() []string {
+func (x *TransactionGraphRequest) GetTxid() string {
 	if x != nil {
-		return x.TxIDs
+		return x.Txid
 	}
-	return nil
-}
-
-// swagger:model Transactions
-type Transactions struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *Transactions) Reset() {
-	*x = Transactions{}
+func (x *TransactionGraphRequest) GetMaxNodes() uint32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+// swagger:model TransactionGraphNode
+type TransactionGraphNode struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Txid         string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Status       Status                 `protobuf:"varint,2,opt,name=status,proto3,enum=metamorph_api.Status" json:"status,omitempty"`
+	RejectReason string                 `protobuf:"bytes,3,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	Size         uint64                 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// fee paid by the transaction, only set if fee_known is true
+	Fee uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// true if the values of all outputs spent by the transaction are known to ARC
+	FeeKnown bool `protobuf:"varint,6,opt,name=fee_known,json=feeKnown,proto3" json:"fee_known,omitempty"`
+	// IDs of the transactions of the graph whose outputs are spent by the transaction
+	Parents []string `protobuf:"bytes,7,rep,name=parents,proto3" json:"parents,omitempty"`
+	// IDs of the transactions of the graph which spend outputs of the transaction
+	Children      []string `protobuf:"bytes,8,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionGraphNode) Reset() {
+	*x = TransactionGraphNode{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionGraphNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionGraphNode) ProtoMessage() {}
+
+func (x *TransactionGraphNode) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionGraphNode.ProtoReflect.Descriptor instead.
+func (*TransactionGraphNode) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TransactionGraphNode) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *TransactionGraphNode) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *TransactionGraphNode) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *TransactionGraphNode) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TransactionGraphNode) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TransactionGraphNode) GetFeeKnown() bool {
+	if x != nil {
+		return x.FeeKnown
+	}
+	return false
+}
+
+func (x *TransactionGraphNode) GetParents() []string {
+	if x != nil {
+		return x.Parents
+	}
+	return nil
+}
+
+func (x *TransactionGraphNode) GetChildren() []string {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+// swagger:model TransactionGraph
+type TransactionGraph struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Txid  string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	// the requested transaction and its unmined ancestors and descendants known to ARC
+	Nodes         []*TransactionGraphNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Truncated     bool                    `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionGraph) Reset() {
+	*x = TransactionGraph{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionGraph) ProtoMessage() {}
+
+func (x *TransactionGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionGraph.ProtoReflect.Descriptor instead.
+func (*TransactionGraph) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransactionGraph) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *TransactionGraph) GetNodes() []*TransactionGraphNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *TransactionGraph) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// swagger:model StageTiming
+type StageTiming struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stage         string                 `protobuf:"bytes,1,opt,name=stage,proto3" json:"stage,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	LatencyMs     int64                  `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageTiming) Reset() {
+	*x = StageTiming{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageTiming) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageTiming) ProtoMessage() {}
+
+func (x *StageTiming) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageTiming.ProtoReflect.Descriptor instead.
+func (*StageTiming) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StageTiming) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *StageTiming) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *StageTiming) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+// swagger:model TransactionStatuses
+type TransactionStatuses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*TransactionStatus   `protobuf:"bytes,1,rep,name=Statuses,proto3" json:"Statuses,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionStatuses) Reset() {
+	*x = TransactionStatuses{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStatuses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatuses) ProtoMessage() {}
+
+func (x *TransactionStatuses) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatuses.ProtoReflect.Descriptor instead.
+func (*TransactionStatuses) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TransactionStatuses) GetStatuses() []*TransactionStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TransactionStatuses) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// swagger:model TransactionRequest
+type TransactionStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Txid          string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionStatusRequest) Reset() {
+	*x = TransactionStatusRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatusRequest) ProtoMessage() {}
+
+func (x *TransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TransactionStatusRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *TransactionStatusRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// swagger:model UpdateInstancesRequest
+type UpdateInstancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instances     []string               `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInstancesRequest) Reset() {
+	*x = UpdateInstancesRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInstancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInstancesRequest) ProtoMessage() {}
+
+func (x *UpdateInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInstancesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateInstancesRequest) GetInstances() []string {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// swagger:model ClearDataRequest
+type ClearDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RetentionDays int32                  `protobuf:"varint,1,opt,name=retentionDays,proto3" json:"retentionDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearDataRequest) Reset() {
+	*x = ClearDataRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDataRequest) ProtoMessage() {}
+
+func (x *ClearDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDataRequest.ProtoReflect.Descriptor instead.
+func (*ClearDataRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ClearDataRequest) GetRetentionDays() int32 {
+	if x != nil {
+		return x.RetentionDays
+	}
+	return 0
+}
+
+// swagger:model ClearDataResponse
+type ClearDataResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecordsAffected int64                  `protobuf:"varint,1,opt,name=records_affected,json=recordsAffected,proto3" json:"records_affected,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClearDataResponse) Reset() {
+	*x = ClearDataResponse{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearDataResponse) ProtoMessage() {}
+
+func (x *ClearDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearDataResponse.ProtoReflect.Descriptor instead.
+func (*ClearDataResponse) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ClearDataResponse) GetRecordsAffected() int64 {
+	if x != nil {
+		return x.RecordsAffected
+	}
+	return 0
+}
+
+// swagger:model TransactionsStatusRequest
+type TransactionsStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxIDs         []string               `protobuf:"bytes,1,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactionsStatusRequest) Reset() {
+	*x = TransactionsStatusRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionsStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionsStatusRequest) ProtoMessage() {}
+
+func (x *TransactionsStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionsStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransactionsStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TransactionsStatusRequest) GetTxIDs() []string {
+	if x != nil {
+		return x.TxIDs
+	}
+	return nil
+}
+
+// swagger:model UnlockRecordsRequest
+type UnlockRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Instance      string                 `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockRecordsRequest) Reset() {
+	*x = UnlockRecordsRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRecordsRequest) ProtoMessage() {}
+
+func (x *UnlockRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRecordsRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UnlockRecordsRequest) GetInstance() string {
+	if x != nil {
+		return x.Instance
+	}
+	return ""
+}
+
+// swagger:model UnlockRecordsResponse
+type UnlockRecordsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RecordsAffected int64                  `protobuf:"varint,1,opt,name=records_affected,json=recordsAffected,proto3" json:"records_affected,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnlockRecordsResponse) Reset() {
+	*x = UnlockRecordsResponse{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockRecordsResponse) ProtoMessage() {}
+
+func (x *UnlockRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockRecordsResponse.ProtoReflect.Descriptor instead.
+func (*UnlockRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UnlockRecordsResponse) GetRecordsAffected() int64 {
+	if x != nil {
+		return x.RecordsAffected
+	}
+	return 0
+}
+
+// swagger:model ReplayCallbacksResponse
+type ReplayCallbacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxIDs         []string               `protobuf:"bytes,1,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayCallbacksResponse) Reset() {
+	*x = ReplayCallbacksResponse{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayCallbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayCallbacksResponse) ProtoMessage() {}
+
+func (x *ReplayCallbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayCallbacksResponse.ProtoReflect.Descriptor instead.
+func (*ReplayCallbacksResponse) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReplayCallbacksResponse) GetTxIDs() []string {
+	if x != nil {
+		return x.TxIDs
+	}
+	return nil
+}
+
+// swagger:model OutpointSpenderRequest
+type OutpointSpenderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Txid          string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Vout          uint32                 `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutpointSpenderRequest) Reset() {
+	*x = OutpointSpenderRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutpointSpenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutpointSpenderRequest) ProtoMessage() {}
+
+func (x *OutpointSpenderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutpointSpenderRequest.ProtoReflect.Descriptor instead.
+func (*OutpointSpenderRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OutpointSpenderRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *OutpointSpenderRequest) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+// swagger:model OutpointSpender
+type OutpointSpender struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// true if a transaction spending the outpoint is known to metamorph
+	Spent bool `protobuf:"varint,1,opt,name=spent,proto3" json:"spent,omitempty"`
+	// ID and status of the transaction spending the outpoint, only set if spent is true
+	SpendingTxid  string `protobuf:"bytes,2,opt,name=spending_txid,json=spendingTxid,proto3" json:"spending_txid,omitempty"`
+	Status        Status `protobuf:"varint,3,opt,name=status,proto3,enum=metamorph_api.Status" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutpointSpender) Reset() {
+	*x = OutpointSpender{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutpointSpender) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutpointSpender) ProtoMessage() {}
+
+func (x *OutpointSpender) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutpointSpender.ProtoReflect.Descriptor instead.
+func (*OutpointSpender) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OutpointSpender) GetSpent() bool {
+	if x != nil {
+		return x.Spent
+	}
+	return false
+}
+
+func (x *OutpointSpender) GetSpendingTxid() string {
+	if x != nil {
+		return x.SpendingTxid
+	}
+	return ""
+}
+
+func (x *OutpointSpender) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+// swagger:model JobRequest
+type JobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobRequest) Reset() {
+	*x = JobRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobRequest) ProtoMessage() {}
+
+func (x *JobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobRequest.ProtoReflect.Descriptor instead.
+func (*JobRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *JobRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// swagger:model Job
+type Job struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// cron expression or interval of the job
+	Schedule string `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	JitterMs int64  `protobuf:"varint,3,opt,name=jitter_ms,json=jitterMs,proto3" json:"jitter_ms,omitempty"`
+	Paused   bool   `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	Running  bool   `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	Runs     uint64 `protobuf:"varint,6,opt,name=runs,proto3" json:"runs,omitempty"`
+	// start and duration of the last run, only set if the job has run
+	LastRun        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	LastDurationMs int64                  `protobuf:"varint,8,opt,name=last_duration_ms,json=lastDurationMs,proto3" json:"last_duration_ms,omitempty"`
+	NextRun        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Job) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Job) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *Job) GetJitterMs() int64 {
+	if x != nil {
+		return x.JitterMs
+	}
+	return 0
+}
+
+func (x *Job) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Job) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *Job) GetRuns() uint64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *Job) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *Job) GetLastDurationMs() int64 {
+	if x != nil {
+		return x.LastDurationMs
+	}
+	return 0
+}
+
+func (x *Job) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+// swagger:model Jobs
+type Jobs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Jobs          []*Job                 `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Jobs) Reset() {
+	*x = Jobs{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jobs) ProtoMessage() {}
+
+func (x *Jobs) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jobs.ProtoReflect.Descriptor instead.
+func (*Jobs) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Jobs) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+// swagger:model Transactions
+type Transactions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transactions) Reset() {
+	*x = Transactions{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transactions) ProtoMessage() {}
+
+func (x *Transactions) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
+func (*Transactions) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Transactions) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// swagger:model SLAReportsRequest
+type SLAReportsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// daily or weekly
+	Period string `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	// reports of the periods starting from from until to are returned
+	From *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// reports of all tenants are returned if empty
+	Tenant        string `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAReportsRequest) Reset() {
+	*x = SLAReportsRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAReportsRequest) ProtoMessage() {}
+
+func (x *SLAReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAReportsRequest.ProtoReflect.Descriptor instead.
+func (*SLAReportsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SLAReportsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SLAReportsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *SLAReportsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *SLAReportsRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// swagger:model SLAReport
+type SLAReport struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Period      string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	PeriodStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	Tenant      string                 `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// number of transactions stored in the period
+	Transactions int64 `protobuf:"varint,4,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	// number of transactions which were seen on the network and p95 time from storing them to SEEN_ON_NETWORK
+	SeenOnNetwork   int64 `protobuf:"varint,5,opt,name=seen_on_network,json=seenOnNetwork,proto3" json:"seen_on_network,omitempty"`
+	P95TimeToSeenMs int64 `protobuf:"varint,6,opt,name=p95_time_to_seen_ms,json=p95TimeToSeenMs,proto3" json:"p95_time_to_seen_ms,omitempty"`
+	// number of transactions which were mined and p95 time from storing them to MINED
+	Mined            int64                  `protobuf:"varint,7,opt,name=mined,proto3" json:"mined,omitempty"`
+	P95TimeToMinedMs int64                  `protobuf:"varint,8,opt,name=p95_time_to_mined_ms,json=p95TimeToMinedMs,proto3" json:"p95_time_to_mined_ms,omitempty"`
+	ComputedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SLAReport) Reset() {
+	*x = SLAReport{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAReport) ProtoMessage() {}
+
+func (x *SLAReport) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAReport.ProtoReflect.Descriptor instead.
+func (*SLAReport) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SLAReport) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *SLAReport) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *SLAReport) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *SLAReport) GetTransactions() int64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *SLAReport) GetSeenOnNetwork() int64 {
+	if x != nil {
+		return x.SeenOnNetwork
+	}
+	return 0
+}
+
+func (x *SLAReport) GetP95TimeToSeenMs() int64 {
+	if x != nil {
+		return x.P95TimeToSeenMs
+	}
+	return 0
+}
+
+func (x *SLAReport) GetMined() int64 {
+	if x != nil {
+		return x.Mined
+	}
+	return 0
+}
+
+func (x *SLAReport) GetP95TimeToMinedMs() int64 {
+	if x != nil {
+		return x.P95TimeToMinedMs
+	}
+	return 0
+}
+
+func (x *SLAReport) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+// swagger:model SLAReports
+type SLAReports struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*SLAReport           `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SLAReports) Reset() {
+	*x = SLAReports{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SLAReports) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SLAReports) ProtoMessage() {}
+
+func (x *SLAReports) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SLAReports.ProtoReflect.Descriptor instead.
+func (*SLAReports) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SLAReports) GetReports() []*SLAReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// swagger:model AnnotateTransactionRequest
+type AnnotateTransactionRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Txid   string                 `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Note   string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Labels []string               `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty"`
+	// name of the operator or token which added the annotation
+	Author        string `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnotateTransactionRequest) Reset() {
+	*x = AnnotateTransactionRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnotateTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnotateTransactionRequest) ProtoMessage() {}
+
+func (x *AnnotateTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnotateTransactionRequest.ProtoReflect.Descriptor instead.
+func (*AnnotateTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AnnotateTransactionRequest) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+func (x *AnnotateTransactionRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *AnnotateTransactionRequest) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *AnnotateTransactionRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+// swagger:model Annotation
+type Annotation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	Labels        []string               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	Author        string                 `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Annotation) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Annotation) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Annotation) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *Annotation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// swagger:model EraseClientDataRequest
+type EraseClientDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// tenant whose transactions are erased, all tenants if empty
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// transactions which are erased, all transactions of the tenant if empty
+	Txids []string `protobuf:"bytes,2,rep,name=txids,proto3" json:"txids,omitempty"`
+	// name of the operator or token which erased the client data
+	ErasedBy      string `protobuf:"bytes,3,opt,name=erased_by,json=erasedBy,proto3" json:"erased_by,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EraseClientDataRequest) Reset() {
+	*x = EraseClientDataRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EraseClientDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EraseClientDataRequest) ProtoMessage() {}
+
+func (x *EraseClientDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EraseClientDataRequest.ProtoReflect.Descriptor instead.
+func (*EraseClientDataRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EraseClientDataRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *EraseClientDataRequest) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+func (x *EraseClientDataRequest) GetErasedBy() string {
+	if x != nil {
+		return x.ErasedBy
+	}
+	return ""
+}
+
+func (x *EraseClientDataRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// swagger:model Erasure
+type Erasure struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tenant   string                 `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Txids    []string               `protobuf:"bytes,3,rep,name=txids,proto3" json:"txids,omitempty"`
+	ErasedBy string                 `protobuf:"bytes,4,opt,name=erased_by,json=erasedBy,proto3" json:"erased_by,omitempty"`
+	Reason   string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErasedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=erased_at,json=erasedAt,proto3" json:"erased_at,omitempty"`
+	// number of transactions whose client data was erased
+	Transactions  int64 `protobuf:"varint,7,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Erasure) Reset() {
+	*x = Erasure{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Erasure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Erasure) ProtoMessage() {}
+
+func (x *Erasure) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Erasure.ProtoReflect.Descriptor instead.
+func (*Erasure) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Erasure) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Erasure) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *Erasure) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+func (x *Erasure) GetErasedBy() string {
+	if x != nil {
+		return x.ErasedBy
+	}
+	return ""
+}
+
+func (x *Erasure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Erasure) GetErasedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ErasedAt
+	}
+	return nil
+}
+
+func (x *Erasure) GetTransactions() int64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+// swagger:model ListErasuresRequest
+type ListErasuresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListErasuresRequest) Reset() {
+	*x = ListErasuresRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListErasuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListErasuresRequest) ProtoMessage() {}
+
+func (x *ListErasuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListErasuresRequest.ProtoReflect.Descriptor instead.
+func (*ListErasuresRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListErasuresRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// swagger:model Erasures
+type Erasures struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Erasures      []*Erasure             `protobuf:"bytes,1,rep,name=erasures,proto3" json:"erasures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Erasures) Reset() {
+	*x = Erasures{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Erasures) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Erasures) ProtoMessage() {}
+
+func (x *Erasures) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Erasures.ProtoReflect.Descriptor instead.
+func (*Erasures) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Erasures) GetErasures() []*Erasure {
+	if x != nil {
+		return x.Erasures
+	}
+	return nil
+}
+
+// swagger:model BanPeerRequest
+type BanPeerRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// duration of the ban, e.g. 2h, the configured ban duration if empty
+	Duration      string `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanPeerRequest) Reset() {
+	*x = BanPeerRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPeerRequest) ProtoMessage() {}
+
+func (x *BanPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPeerRequest.ProtoReflect.Descriptor instead.
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BanPeerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BanPeerRequest) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+// swagger:model PeerBan
+type PeerBan struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Address   string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Connected bool                   `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+	BanScore  int64                  `protobuf:"varint,3,opt,name=ban_score,json=banScore,proto3" json:"ban_score,omitempty"`
+	Banned    bool                   `protobuf:"varint,4,opt,name=banned,proto3" json:"banned,omitempty"`
+	// only set if the peer is banned
+	BannedUntil   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerBan) Reset() {
+	*x = PeerBan{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerBan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerBan) ProtoMessage() {}
+
+func (x *PeerBan) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerBan.ProtoReflect.Descriptor instead.
+func (*PeerBan) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PeerBan) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerBan) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+func (x *PeerBan) GetBanScore() int64 {
+	if x != nil {
+		return x.BanScore
+	}
+	return 0
+}
+
+func (x *PeerBan) GetBanned() bool {
+	if x != nil {
+		return x.Banned
+	}
+	return false
+}
+
+func (x *PeerBan) GetBannedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return nil
+}
+
+// swagger:model PeerBans
+type PeerBans struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the registered peers with their ban scores
+	Peers []*PeerBan `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	// the banned addresses, including the addresses of peers which are not registered
+	Bans          []*PeerBan `protobuf:"bytes,2,rep,name=bans,proto3" json:"bans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeerBans) Reset() {
+	*x = PeerBans{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeerBans) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerBans) ProtoMessage() {}
+
+func (x *PeerBans) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerBans.ProtoReflect.Descriptor instead.
+func (*PeerBans) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PeerBans) GetPeers() []*PeerBan {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *PeerBans) GetBans() []*PeerBan {
+	if x != nil {
+		return x.Bans
+	}
+	return nil
+}
+
+// swagger:model ExportRequest
+type ExportRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// statuses and tenant are not applied if they are empty
+	Statuses []Status `protobuf:"varint,3,rep,packed,name=statuses,proto3,enum=metamorph_api.Status" json:"statuses,omitempty"`
+	Tenant   string   `protobuf:"bytes,4,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// position of the last exported transaction, the export starts with the first transaction stored since from if
+	// after_hash is empty
+	AfterStoredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=after_stored_at,json=afterStoredAt,proto3" json:"after_stored_at,omitempty"`
+	AfterHash     []byte                 `protobuf:"bytes,6,opt,name=after_hash,json=afterHash,proto3" json:"after_hash,omitempty"`
+	// maximum number of returned transactions, limited by the batch size of the bulk export
+	Limit         int64 `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRequest) Reset() {
+	*x = ExportRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRequest) ProtoMessage() {}
+
+func (x *ExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRequest.ProtoReflect.Descriptor instead.
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetStatuses() []Status {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *ExportRequest) GetAfterStoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AfterStoredAt
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetAfterHash() []byte {
+	if x != nil {
+		return x.AfterHash
+	}
+	return nil
+}
+
+func (x *ExportRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// swagger:model ExportedTransaction
+type ExportedTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Status        Status                 `protobuf:"varint,2,opt,name=status,proto3,enum=metamorph_api.Status" json:"status,omitempty"`
+	StoredAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=stored_at,json=storedAt,proto3" json:"stored_at,omitempty"`
+	LastModified  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	BlockHash     []byte                 `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight   uint64                 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	MinedHash     []byte                 `protobuf:"bytes,7,opt,name=mined_hash,json=minedHash,proto3" json:"mined_hash,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,8,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	CompetingTxs  []string               `protobuf:"bytes,9,rep,name=competing_txs,json=competingTxs,proto3" json:"competing_txs,omitempty"`
+	Tenant        string                 `protobuf:"bytes,10,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedTransaction) Reset() {
+	*x = ExportedTransaction{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedTransaction) ProtoMessage() {}
+
+func (x *ExportedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedTransaction.ProtoReflect.Descriptor instead.
+func (*ExportedTransaction) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ExportedTransaction) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ExportedTransaction) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ExportedTransaction) GetStoredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StoredAt
+	}
+	return nil
+}
+
+func (x *ExportedTransaction) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+func (x *ExportedTransaction) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ExportedTransaction) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *ExportedTransaction) GetMinedHash() []byte {
+	if x != nil {
+		return x.MinedHash
+	}
+	return nil
+}
+
+func (x *ExportedTransaction) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *ExportedTransaction) GetCompetingTxs() []string {
+	if x != nil {
+		return x.CompetingTxs
+	}
+	return nil
+}
+
+func (x *ExportedTransaction) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// swagger:model ExportedTransactions
+type ExportedTransactions struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the transactions ordered by the time at which they were stored and their hash
+	Transactions  []*ExportedTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportedTransactions) Reset() {
+	*x = ExportedTransactions{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportedTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedTransactions) ProtoMessage() {}
+
+func (x *ExportedTransactions) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedTransactions.ProtoReflect.Descriptor instead.
+func (*ExportedTransactions) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ExportedTransactions) GetTransactions() []*ExportedTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+// swagger:model Reverification
+type Reverification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// degraded mode by which the transactions were accepted or deferred
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Beef []byte `protobuf:"bytes,3,opt,name=beef,proto3" json:"beef,omitempty"`
+	// the transactions of the BEEF which are submitted
+	Txids []string `protobuf:"bytes,4,rep,name=txids,proto3" json:"txids,omitempty"`
+	// the options with which deferred transactions are submitted, as encoded by the API
+	Options []byte                 `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	AddedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	// the tenant the API key of the request belongs to, the options of its reverifications are erased with its client data
+	Tenant        string `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reverification) Reset() {
+	*x = Reverification{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reverification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reverification) ProtoMessage() {}
+
+func (x *Reverification) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reverification.ProtoReflect.Descriptor instead.
+func (*Reverification) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Reverification) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reverification) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Reverification) GetBeef() []byte {
+	if x != nil {
+		return x.Beef
+	}
+	return nil
+}
+
+func (x *Reverification) GetTxids() []string {
+	if x != nil {
+		return x.Txids
+	}
+	return nil
+}
+
+func (x *Reverification) GetOptions() []byte {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Reverification) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *Reverification) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+// swagger:model AddReverificationRequest
+type AddReverificationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reverification *Reverification        `protobuf:"bytes,1,opt,name=reverification,proto3" json:"reverification,omitempty"`
+	// the reverification is not added if the number of the transactions of all reverifications would exceed the maximum
+	MaxTransactions int64 `protobuf:"varint,2,opt,name=max_transactions,json=maxTransactions,proto3" json:"max_transactions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddReverificationRequest) Reset() {
+	*x = AddReverificationRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transactions) String() string {
+func (x *AddReverificationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transactions) ProtoMessage() {}
+func (*AddReverificationRequest) ProtoMessage() {}
 
-func (x *Transactions) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[14]
+func (x *AddReverificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,18 +3560,330 @@ func (x *Transactions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
-func (*Transactions) Descriptor() ([]byte, []int) {
-	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AddReverificationRequest.ProtoReflect.Descriptor instead.
+func (*AddReverificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *Transactions) GetTransactions() []*Transaction {
+func (x *AddReverificationRequest) GetReverification() *Reverification {
+	if x != nil {
+		return x.Reverification
+	}
+	return nil
+}
+
+func (x *AddReverificationRequest) GetMaxTransactions() int64 {
+	if x != nil {
+		return x.MaxTransactions
+	}
+	return 0
+}
+
+// swagger:model GetReverificationsRequest
+type GetReverificationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Limit int64                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the returned reverifications are not returned again before, so that each of them is re-verified by one API
+	// instance at a time
+	LockedUntil   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReverificationsRequest) Reset() {
+	*x = GetReverificationsRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReverificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReverificationsRequest) ProtoMessage() {}
+
+func (x *GetReverificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReverificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetReverificationsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetReverificationsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetReverificationsRequest) GetLockedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedUntil
+	}
+	return nil
+}
+
+// swagger:model Reverifications
+type Reverifications struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Reverifications []*Reverification      `protobuf:"bytes,1,rep,name=reverifications,proto3" json:"reverifications,omitempty"`
+	// number of the transactions of all reverifications
+	UnverifiedTxs int64 `protobuf:"varint,2,opt,name=unverified_txs,json=unverifiedTxs,proto3" json:"unverified_txs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reverifications) Reset() {
+	*x = Reverifications{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reverifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reverifications) ProtoMessage() {}
+
+func (x *Reverifications) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reverifications.ProtoReflect.Descriptor instead.
+func (*Reverifications) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Reverifications) GetReverifications() []*Reverification {
+	if x != nil {
+		return x.Reverifications
+	}
+	return nil
+}
+
+func (x *Reverifications) GetUnverifiedTxs() int64 {
+	if x != nil {
+		return x.UnverifiedTxs
+	}
+	return 0
+}
+
+// swagger:model ReverificationRequest
+type ReverificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverificationRequest) Reset() {
+	*x = ReverificationRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverificationRequest) ProtoMessage() {}
+
+func (x *ReverificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverificationRequest.ProtoReflect.Descriptor instead.
+func (*ReverificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ReverificationRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// swagger:model UnverifiedTransactions
+type UnverifiedTransactions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TxIDs         []string               `protobuf:"bytes,1,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnverifiedTransactions) Reset() {
+	*x = UnverifiedTransactions{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnverifiedTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnverifiedTransactions) ProtoMessage() {}
+
+func (x *UnverifiedTransactions) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnverifiedTransactions.ProtoReflect.Descriptor instead.
+func (*UnverifiedTransactions) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UnverifiedTransactions) GetTxIDs() []string {
+	if x != nil {
+		return x.TxIDs
+	}
+	return nil
+}
+
+// swagger:model RejectTransactionsRequest
+type RejectTransactionsRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Transactions  []*PostTransactionRequest `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	RejectReason  string                    `protobuf:"bytes,2,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectTransactionsRequest) Reset() {
+	*x = RejectTransactionsRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTransactionsRequest) ProtoMessage() {}
+
+func (x *RejectTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*RejectTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RejectTransactionsRequest) GetTransactions() []*PostTransactionRequest {
 	if x != nil {
 		return x.Transactions
 	}
 	return nil
 }
 
+func (x *RejectTransactionsRequest) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+// LogLevelRequest is the log level, e.g. DEBUG, of a component of the process, e.g. mtm
+type LogLevelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Component     string                 `protobuf:"bytes,1,opt,name=component,proto3" json:"component,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogLevelRequest) Reset() {
+	*x = LogLevelRequest{}
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevelRequest) ProtoMessage() {}
+
+func (x *LogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevelRequest.ProtoReflect.Descriptor instead.
+func (*LogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LogLevelRequest) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *LogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
 var File_internal_metamorph_metamorph_api_metamorph_api_proto protoreflect.FileDescriptor
 
 const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
@@ -1188,7 +3912,7 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"\bevent_id\x18\r \x01(\tR\aeventId\"w\n" +
 	"\x13TransactionRequests\x12E\n" +
 	"\fTransactions\x18\x01 \x03(\v2!.metamorph_api.TransactionRequestR\fTransactions\x12\x19\n" +
-	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\xaa\x02\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\x8f\a\n" +
 	"\x16PostTransactionRequest\x12!\n" +
 	"\fcallback_url\x18\x01 \x01(\tR\vcallbackUrl\x12%\n" +
 	"\x0ecallback_token\x18\x02 \x01(\tR\rcallbackToken\x12%\n" +
@@ -1196,7 +3920,23 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"\x06raw_tx\x18\x04 \x01(\fR\x05rawTx\x12=\n" +
 	"\x0fwait_for_status\x18\x05 \x01(\x0e2\x15.metamorph_api.StatusR\rwaitForStatus\x12.\n" +
 	"\x13full_status_updates\x18\x06 \x01(\bR\x11fullStatusUpdates\x12\x19\n" +
-	"\bevent_id\x18\a \x01(\tR\aeventId\"\x7f\n" +
+	"\bevent_id\x18\a \x01(\tR\aeventId\x12)\n" +
+	"\x10callback_version\x18\b \x01(\x05R\x0fcallbackVersion\x12J\n" +
+	"\x14additional_callbacks\x18\t \x03(\v2\x17.metamorph_api.callbackR\x13additionalCallbacks\x12:\n" +
+	"\x19callback_allow_duplicates\x18\n" +
+	" \x01(\bR\x17callbackAllowDuplicates\x12$\n" +
+	"\rconsolidation\x18\v \x01(\bR\rconsolidation\x12;\n" +
+	"\vreceived_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12=\n" +
+	"\fvalidated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vvalidatedAt\x12\x16\n" +
+	"\x06tenant\x18\x0e \x01(\tR\x06tenant\x12=\n" +
+	"\fbroadcast_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vbroadcastAt\x12\x1f\n" +
+	"\vannounce_to\x18\x10 \x01(\tR\n" +
+	"announceTo\x12>\n" +
+	"\x1bcallback_suppression_window\x18\x11 \x01(\x05R\x19callbackSuppressionWindow\x129\n" +
+	"\n" +
+	"expires_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1b\n" +
+	"\tlock_time\x18\x13 \x01(\rR\blockTime\"\x7f\n" +
 	"\x17PostTransactionsRequest\x12I\n" +
 	"\fTransactions\x18\x01 \x03(\v2%.metamorph_api.PostTransactionRequestR\fTransactions\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\"\xbe\x03\n" +
@@ -1213,12 +3953,15 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"\n" +
 	"block_hash\x18\n" +
 	" \x01(\tR\tblockHash\x12\x15\n" +
-	"\x06raw_tx\x18\v \x01(\fR\x05rawTx\"u\n" +
+	"\x06raw_tx\x18\v \x01(\fR\x05rawTx\"\xfa\x01\n" +
 	"\bcallback\x12!\n" +
 	"\fcallback_url\x18\x01 \x01(\tR\vcallbackUrl\x12%\n" +
 	"\x0ecallback_token\x18\x02 \x01(\tR\rcallbackToken\x12\x1f\n" +
 	"\vallow_batch\x18\x03 \x01(\bR\n" +
-	"allowBatch\"\xd2\x03\n" +
+	"allowBatch\x12)\n" +
+	"\x10callback_version\x18\x04 \x01(\x05R\x0fcallbackVersion\x12)\n" +
+	"\x10allow_duplicates\x18\x05 \x01(\bR\x0fallowDuplicates\x12-\n" +
+	"\x12suppression_window\x18\x06 \x01(\x05R\x11suppressionWindow\"\x92\x06\n" +
 	"\x11TransactionStatus\x12\x1b\n" +
 	"\ttimed_out\x18\x01 \x01(\bR\btimedOut\x127\n" +
 	"\tstored_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstoredAt\x12\x12\n" +
@@ -1233,7 +3976,51 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"merklePath\x12A\n" +
 	"\x0elast_submitted\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\rlastSubmitted\x125\n" +
-	"\tcallbacks\x18\v \x03(\v2\x17.metamorph_api.callbackR\tcallbacks\"n\n" +
+	"\tcallbacks\x18\v \x03(\v2\x17.metamorph_api.callbackR\tcallbacks\x12?\n" +
+	"\rstage_timings\x18\f \x03(\v2\x1a.metamorph_api.StageTimingR\fstageTimings\x12C\n" +
+	"\x0eblock_template\x18\r \x01(\v2\x1c.metamorph_api.BlockTemplateR\rblockTemplate\x123\n" +
+	"\tpeer_acks\x18\x0e \x03(\v2\x16.metamorph_api.PeerAckR\bpeerAcks\x12;\n" +
+	"\vannotations\x18\x0f \x03(\v2\x19.metamorph_api.AnnotationR\vannotations\x12F\n" +
+	"\x0fnode_submission\x18\x10 \x01(\v2\x1d.metamorph_api.NodeSubmissionR\x0enodeSubmission\"\x90\x01\n" +
+	"\x0eNodeSubmission\x12=\n" +
+	"\fsubmitted_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\bR\baccepted\x12#\n" +
+	"\rreject_reason\x18\x03 \x01(\tR\frejectReason\"\x91\x01\n" +
+	"\aPeerAck\x12\x12\n" +
+	"\x04peer\x18\x01 \x01(\tR\x04peer\x12=\n" +
+	"\frequested_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\x123\n" +
+	"\asent_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06sentAt\"\x91\x01\n" +
+	"\rBlockTemplate\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12.\n" +
+	"\x13previous_block_hash\x18\x02 \x01(\tR\x11previousBlockHash\x128\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"x\n" +
+	"\x18PostBlockTemplateRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12.\n" +
+	"\x13previous_block_hash\x18\x02 \x01(\tR\x11previousBlockHash\x12\x14\n" +
+	"\x05txids\x18\x03 \x03(\tR\x05txids\"P\n" +
+	"\x19PostBlockTemplateResponse\x123\n" +
+	"\x15included_transactions\x18\x01 \x01(\x04R\x14includedTransactions\"J\n" +
+	"\x17TransactionGraphRequest\x12\x12\n" +
+	"\x04txid\x18\x01 \x01(\tR\x04txid\x12\x1b\n" +
+	"\tmax_nodes\x18\x02 \x01(\rR\bmaxNodes\"\xf7\x01\n" +
+	"\x14TransactionGraphNode\x12\x12\n" +
+	"\x04txid\x18\x01 \x01(\tR\x04txid\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.metamorph_api.StatusR\x06status\x12#\n" +
+	"\rreject_reason\x18\x03 \x01(\tR\frejectReason\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x04R\x04size\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\x04R\x03fee\x12\x1b\n" +
+	"\tfee_known\x18\x06 \x01(\bR\bfeeKnown\x12\x18\n" +
+	"\aparents\x18\a \x03(\tR\aparents\x12\x1a\n" +
+	"\bchildren\x18\b \x03(\tR\bchildren\"\x7f\n" +
+	"\x10TransactionGraph\x12\x12\n" +
+	"\x04txid\x18\x01 \x01(\tR\x04txid\x129\n" +
+	"\x05nodes\x18\x02 \x03(\v2#.metamorph_api.TransactionGraphNodeR\x05nodes\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"|\n" +
+	"\vStageTiming\x12\x14\n" +
+	"\x05stage\x18\x01 \x01(\tR\x05stage\x128\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\"n\n" +
 	"\x13TransactionStatuses\x12<\n" +
 	"\bStatuses\x18\x01 \x03(\v2 .metamorph_api.TransactionStatusR\bStatuses\x12\x19\n" +
 	"\bevent_id\x18\x02 \x01(\tR\aeventId\"I\n" +
@@ -1247,9 +4034,149 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"\x11ClearDataResponse\x12)\n" +
 	"\x10records_affected\x18\x01 \x01(\x03R\x0frecordsAffected\"1\n" +
 	"\x19TransactionsStatusRequest\x12\x14\n" +
-	"\x05txIDs\x18\x01 \x03(\tR\x05txIDs\"N\n" +
+	"\x05txIDs\x18\x01 \x03(\tR\x05txIDs\"2\n" +
+	"\x14UnlockRecordsRequest\x12\x1a\n" +
+	"\binstance\x18\x01 \x01(\tR\binstance\"B\n" +
+	"\x15UnlockRecordsResponse\x12)\n" +
+	"\x10records_affected\x18\x01 \x01(\x03R\x0frecordsAffected\"/\n" +
+	"\x17ReplayCallbacksResponse\x12\x14\n" +
+	"\x05txIDs\x18\x01 \x03(\tR\x05txIDs\"@\n" +
+	"\x16OutpointSpenderRequest\x12\x12\n" +
+	"\x04txid\x18\x01 \x01(\tR\x04txid\x12\x12\n" +
+	"\x04vout\x18\x02 \x01(\rR\x04vout\"{\n" +
+	"\x0fOutpointSpender\x12\x14\n" +
+	"\x05spent\x18\x01 \x01(\bR\x05spent\x12#\n" +
+	"\rspending_txid\x18\x02 \x01(\tR\fspendingTxid\x12-\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x15.metamorph_api.StatusR\x06status\" \n" +
+	"\n" +
+	"JobRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\xb0\x02\n" +
+	"\x03Job\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bschedule\x18\x02 \x01(\tR\bschedule\x12\x1b\n" +
+	"\tjitter_ms\x18\x03 \x01(\x03R\bjitterMs\x12\x16\n" +
+	"\x06paused\x18\x04 \x01(\bR\x06paused\x12\x18\n" +
+	"\arunning\x18\x05 \x01(\bR\arunning\x12\x12\n" +
+	"\x04runs\x18\x06 \x01(\x04R\x04runs\x125\n" +
+	"\blast_run\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\alastRun\x12(\n" +
+	"\x10last_duration_ms\x18\b \x01(\x03R\x0elastDurationMs\x125\n" +
+	"\bnext_run\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\anextRun\".\n" +
+	"\x04Jobs\x12&\n" +
+	"\x04jobs\x18\x01 \x03(\v2\x12.metamorph_api.JobR\x04jobs\"N\n" +
 	"\fTransactions\x12>\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x1a.metamorph_api.TransactionR\ftransactions*\x9d\x02\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1a.metamorph_api.TransactionR\ftransactions\"\x9f\x01\n" +
+	"\x11SLAReportsRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12.\n" +
+	"\x04from\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\"\xf7\x02\n" +
+	"\tSLAReport\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12=\n" +
+	"\fperiod_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x12\x16\n" +
+	"\x06tenant\x18\x03 \x01(\tR\x06tenant\x12\"\n" +
+	"\ftransactions\x18\x04 \x01(\x03R\ftransactions\x12&\n" +
+	"\x0fseen_on_network\x18\x05 \x01(\x03R\rseenOnNetwork\x12,\n" +
+	"\x13p95_time_to_seen_ms\x18\x06 \x01(\x03R\x0fp95TimeToSeenMs\x12\x14\n" +
+	"\x05mined\x18\a \x01(\x03R\x05mined\x12.\n" +
+	"\x14p95_time_to_mined_ms\x18\b \x01(\x03R\x10p95TimeToMinedMs\x12;\n" +
+	"\vcomputed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"@\n" +
+	"\n" +
+	"SLAReports\x122\n" +
+	"\areports\x18\x01 \x03(\v2\x18.metamorph_api.SLAReportR\areports\"t\n" +
+	"\x1aAnnotateTransactionRequest\x12\x12\n" +
+	"\x04txid\x18\x01 \x01(\tR\x04txid\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x12\x16\n" +
+	"\x06labels\x18\x03 \x03(\tR\x06labels\x12\x16\n" +
+	"\x06author\x18\x04 \x01(\tR\x06author\"\x8b\x01\n" +
+	"\n" +
+	"Annotation\x12\x12\n" +
+	"\x04note\x18\x01 \x01(\tR\x04note\x12\x16\n" +
+	"\x06labels\x18\x02 \x03(\tR\x06labels\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"{\n" +
+	"\x16EraseClientDataRequest\x12\x16\n" +
+	"\x06tenant\x18\x01 \x01(\tR\x06tenant\x12\x14\n" +
+	"\x05txids\x18\x02 \x03(\tR\x05txids\x12\x1b\n" +
+	"\terased_by\x18\x03 \x01(\tR\berasedBy\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\xd9\x01\n" +
+	"\aErasure\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06tenant\x18\x02 \x01(\tR\x06tenant\x12\x14\n" +
+	"\x05txids\x18\x03 \x03(\tR\x05txids\x12\x1b\n" +
+	"\terased_by\x18\x04 \x01(\tR\berasedBy\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x127\n" +
+	"\terased_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\berasedAt\x12\"\n" +
+	"\ftransactions\x18\a \x01(\x03R\ftransactions\"+\n" +
+	"\x13ListErasuresRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\">\n" +
+	"\bErasures\x122\n" +
+	"\berasures\x18\x01 \x03(\v2\x16.metamorph_api.ErasureR\berasures\"F\n" +
+	"\x0eBanPeerRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\tR\bduration\"\xb5\x01\n" +
+	"\aPeerBan\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\bR\tconnected\x12\x1b\n" +
+	"\tban_score\x18\x03 \x01(\x03R\bbanScore\x12\x16\n" +
+	"\x06banned\x18\x04 \x01(\bR\x06banned\x12=\n" +
+	"\fbanned_until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vbannedUntil\"d\n" +
+	"\bPeerBans\x12,\n" +
+	"\x05peers\x18\x01 \x03(\v2\x16.metamorph_api.PeerBanR\x05peers\x12*\n" +
+	"\x04bans\x18\x02 \x03(\v2\x16.metamorph_api.PeerBanR\x04bans\"\xaf\x02\n" +
+	"\rExportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x121\n" +
+	"\bstatuses\x18\x03 \x03(\x0e2\x15.metamorph_api.StatusR\bstatuses\x12\x16\n" +
+	"\x06tenant\x18\x04 \x01(\tR\x06tenant\x12B\n" +
+	"\x0fafter_stored_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rafterStoredAt\x12\x1d\n" +
+	"\n" +
+	"after_hash\x18\x06 \x01(\fR\tafterHash\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x03R\x05limit\"\x95\x03\n" +
+	"\x13ExportedTransaction\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12-\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x15.metamorph_api.StatusR\x06status\x127\n" +
+	"\tstored_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstoredAt\x12?\n" +
+	"\rlast_modified\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\flastModified\x12\x1d\n" +
+	"\n" +
+	"block_hash\x18\x05 \x01(\fR\tblockHash\x12!\n" +
+	"\fblock_height\x18\x06 \x01(\x04R\vblockHeight\x12\x1d\n" +
+	"\n" +
+	"mined_hash\x18\a \x01(\fR\tminedHash\x12#\n" +
+	"\rreject_reason\x18\b \x01(\tR\frejectReason\x12#\n" +
+	"\rcompeting_txs\x18\t \x03(\tR\fcompetingTxs\x12\x16\n" +
+	"\x06tenant\x18\n" +
+	" \x01(\tR\x06tenant\"^\n" +
+	"\x14ExportedTransactions\x12F\n" +
+	"\ftransactions\x18\x01 \x03(\v2\".metamorph_api.ExportedTransactionR\ftransactions\"\xc7\x01\n" +
+	"\x0eReverification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x12\n" +
+	"\x04beef\x18\x03 \x01(\fR\x04beef\x12\x14\n" +
+	"\x05txids\x18\x04 \x03(\tR\x05txids\x12\x18\n" +
+	"\aoptions\x18\x05 \x01(\fR\aoptions\x125\n" +
+	"\badded_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aaddedAt\x12\x16\n" +
+	"\x06tenant\x18\a \x01(\tR\x06tenant\"\x8c\x01\n" +
+	"\x18AddReverificationRequest\x12E\n" +
+	"\x0ereverification\x18\x01 \x01(\v2\x1d.metamorph_api.ReverificationR\x0ereverification\x12)\n" +
+	"\x10max_transactions\x18\x02 \x01(\x03R\x0fmaxTransactions\"p\n" +
+	"\x19GetReverificationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x03R\x05limit\x12=\n" +
+	"\flocked_until\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vlockedUntil\"\x81\x01\n" +
+	"\x0fReverifications\x12G\n" +
+	"\x0freverifications\x18\x01 \x03(\v2\x1d.metamorph_api.ReverificationR\x0freverifications\x12%\n" +
+	"\x0eunverified_txs\x18\x02 \x01(\x03R\runverifiedTxs\"'\n" +
+	"\x15ReverificationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
+	"\x16UnverifiedTransactions\x12\x14\n" +
+	"\x05txIDs\x18\x01 \x03(\tR\x05txIDs\"\x8b\x01\n" +
+	"\x19RejectTransactionsRequest\x12I\n" +
+	"\ftransactions\x18\x01 \x03(\v2%.metamorph_api.PostTransactionRequestR\ftransactions\x12#\n" +
+	"\rreject_reason\x18\x02 \x01(\tR\frejectReason\"E\n" +
+	"\x0fLogLevelRequest\x12\x1c\n" +
+	"\tcomponent\x18\x01 \x01(\tR\tcomponent\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level*\xc4\x02\n" +
 	"\x06Status\x12\v\n" +
 	"\aUNKNOWN\x10\x00\x12\n" +
 	"\n" +
@@ -1258,16 +4185,18 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"\bRECEIVED\x10\x14\x12\n" +
 	"\n" +
 	"\x06STORED\x10\x1e\x12\x18\n" +
+	"\x14WAITING_FOR_FINALITY\x10#\x12\x18\n" +
 	"\x14ANNOUNCED_TO_NETWORK\x10(\x12\x18\n" +
 	"\x14REQUESTED_BY_NETWORK\x102\x12\x13\n" +
 	"\x0fSENT_TO_NETWORK\x10<\x12\x17\n" +
 	"\x13ACCEPTED_BY_NETWORK\x10F\x12\x1a\n" +
 	"\x16SEEN_IN_ORPHAN_MEMPOOL\x10P\x12\x13\n" +
 	"\x0fSEEN_ON_NETWORK\x10Z\x12\x1a\n" +
-	"\x16DOUBLE_SPEND_ATTEMPTED\x10d\x12\f\n" +
+	"\x16DOUBLE_SPEND_ATTEMPTED\x10d\x12\v\n" +
+	"\aEXPIRED\x10i\x12\f\n" +
 	"\bREJECTED\x10n\x12\x18\n" +
 	"\x14MINED_IN_STALE_BLOCK\x10s\x12\t\n" +
-	"\x05MINED\x10x2\xdd\x05\n" +
+	"\x05MINED\x10x2\xf8\x16\n" +
 	"\fMetaMorphAPI\x12A\n" +
 	"\x06Health\x12\x16.google.protobuf.Empty\x1a\x1d.metamorph_api.HealthResponse\"\x00\x12`\n" +
 	"\x10PostTransactions\x12&.metamorph_api.PostTransactionsRequest\x1a\".metamorph_api.TransactionStatuses\"\x00\x12W\n" +
@@ -1276,7 +4205,34 @@ const file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc = "" +
 	"\x14GetTransactionStatus\x12'.metamorph_api.TransactionStatusRequest\x1a .metamorph_api.TransactionStatus\"\x00\x12h\n" +
 	"\x16GetTransactionStatuses\x12(.metamorph_api.TransactionsStatusRequest\x1a\".metamorph_api.TransactionStatuses\"\x00\x12R\n" +
 	"\x0fUpdateInstances\x12%.metamorph_api.UpdateInstancesRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
-	"\tClearData\x12\x1f.metamorph_api.ClearDataRequest\x1a .metamorph_api.ClearDataResponse\"\x00B\x11Z\x0f.;metamorph_apib\x06proto3"
+	"\tClearData\x12\x1f.metamorph_api.ClearDataRequest\x1a .metamorph_api.ClearDataResponse\"\x00\x12b\n" +
+	"\x13ResubmitTransaction\x12'.metamorph_api.TransactionStatusRequest\x1a .metamorph_api.TransactionStatus\"\x00\x12N\n" +
+	"\rGetSLAReports\x12 .metamorph_api.SLAReportsRequest\x1a\x19.metamorph_api.SLAReports\"\x00\x12h\n" +
+	"\x11PostBlockTemplate\x12'.metamorph_api.PostBlockTemplateRequest\x1a(.metamorph_api.PostBlockTemplateResponse\"\x00\x12`\n" +
+	"\x13GetTransactionGraph\x12&.metamorph_api.TransactionGraphRequest\x1a\x1f.metamorph_api.TransactionGraph\"\x00\x12]\n" +
+	"\x18CancelScheduledBroadcast\x12'.metamorph_api.TransactionStatusRequest\x1a\x16.google.protobuf.Empty\"\x00\x12\\\n" +
+	"\rUnlockRecords\x12#.metamorph_api.UnlockRecordsRequest\x1a$.metamorph_api.UnlockRecordsResponse\"\x00\x12e\n" +
+	"\x0fReplayCallbacks\x12(.metamorph_api.TransactionsStatusRequest\x1a&.metamorph_api.ReplayCallbacksResponse\"\x00\x12]\n" +
+	"\x12GetOutpointSpender\x12%.metamorph_api.OutpointSpenderRequest\x1a\x1e.metamorph_api.OutpointSpender\"\x00\x129\n" +
+	"\bListJobs\x12\x16.google.protobuf.Empty\x1a\x13.metamorph_api.Jobs\"\x00\x12=\n" +
+	"\n" +
+	"TriggerJob\x12\x19.metamorph_api.JobRequest\x1a\x12.metamorph_api.Job\"\x00\x12;\n" +
+	"\bPauseJob\x12\x19.metamorph_api.JobRequest\x1a\x12.metamorph_api.Job\"\x00\x12<\n" +
+	"\tResumeJob\x12\x19.metamorph_api.JobRequest\x1a\x12.metamorph_api.Job\"\x00\x12]\n" +
+	"\x13AnnotateTransaction\x12).metamorph_api.AnnotateTransactionRequest\x1a\x19.metamorph_api.Annotation\"\x00\x12R\n" +
+	"\x0fEraseClientData\x12%.metamorph_api.EraseClientDataRequest\x1a\x16.metamorph_api.Erasure\"\x00\x12M\n" +
+	"\fListErasures\x12\".metamorph_api.ListErasuresRequest\x1a\x17.metamorph_api.Erasures\"\x00\x12>\n" +
+	"\tListPeers\x12\x16.google.protobuf.Empty\x1a\x17.metamorph_api.PeerBans\"\x00\x12C\n" +
+	"\aBanPeer\x12\x1d.metamorph_api.BanPeerRequest\x1a\x17.metamorph_api.PeerBans\"\x00\x12E\n" +
+	"\tUnbanPeer\x12\x1d.metamorph_api.BanPeerRequest\x1a\x17.metamorph_api.PeerBans\"\x00\x12P\n" +
+	"\tGetExport\x12\x1c.metamorph_api.ExportRequest\x1a#.metamorph_api.ExportedTransactions\"\x00\x12]\n" +
+	"\x11AddReverification\x12'.metamorph_api.AddReverificationRequest\x1a\x1d.metamorph_api.Reverification\"\x00\x12`\n" +
+	"\x12GetReverifications\x12(.metamorph_api.GetReverificationsRequest\x1a\x1e.metamorph_api.Reverifications\"\x00\x12V\n" +
+	"\x14DeleteReverification\x12$.metamorph_api.ReverificationRequest\x1a\x16.google.protobuf.Empty\"\x00\x12n\n" +
+	"\x19GetUnverifiedTransactions\x12(.metamorph_api.TransactionsStatusRequest\x1a%.metamorph_api.UnverifiedTransactions\"\x00\x12d\n" +
+	"\x12RejectTransactions\x12(.metamorph_api.RejectTransactionsRequest\x1a\".metamorph_api.TransactionStatuses\"\x00\x12G\n" +
+	"\vSetLogLevel\x12\x1e.metamorph_api.LogLevelRequest\x1a\x16.google.protobuf.Empty\"\x00\x12I\n" +
+	"\rResetLogLevel\x12\x1e.metamorph_api.LogLevelRequest\x1a\x16.google.protobuf.Empty\"\x00B\x11Z\x0f.;metamorph_apib\x06proto3"
 
 var (
 	file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescOnce sync.Once
@@ -1291,64 +4247,201 @@ func file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDescGZIP() []b
 }
 
 var file_internal_metamorph_metamorph_api_metamorph_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_internal_metamorph_metamorph_api_metamorph_api_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_internal_metamorph_metamorph_api_metamorph_api_proto_goTypes = []any{
-	(Status)(0),                       // 0: metamorph_api.Status
-	(*HealthResponse)(nil),            // 1: metamorph_api.HealthResponse
-	(*TransactionRequest)(nil),        // 2: metamorph_api.TransactionRequest
-	(*TransactionRequests)(nil),       // 3: metamorph_api.TransactionRequests
-	(*PostTransactionRequest)(nil),    // 4: metamorph_api.PostTransactionRequest
-	(*PostTransactionsRequest)(nil),   // 5: metamorph_api.PostTransactionsRequest
-	(*Transaction)(nil),               // 6: metamorph_api.Transaction
-	(*Callback)(nil),                  // 7: metamorph_api.callback
-	(*TransactionStatus)(nil),         // 8: metamorph_api.TransactionStatus
-	(*TransactionStatuses)(nil),       // 9: metamorph_api.TransactionStatuses
-	(*TransactionStatusRequest)(nil),  // 10: metamorph_api.TransactionStatusRequest
-	(*UpdateInstancesRequest)(nil),    // 11: metamorph_api.UpdateInstancesRequest
-	(*ClearDataRequest)(nil),          // 12: metamorph_api.ClearDataRequest
-	(*ClearDataResponse)(nil),         // 13: metamorph_api.ClearDataResponse
-	(*TransactionsStatusRequest)(nil), // 14: metamorph_api.TransactionsStatusRequest
-	(*Transactions)(nil),              // 15: metamorph_api.Transactions
-	(*timestamppb.Timestamp)(nil),     // 16: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),             // 17: google.protobuf.Empty
+	(Status)(0),                        // 0: metamorph_api.Status
+	(*HealthResponse)(nil),             // 1: metamorph_api.HealthResponse
+	(*TransactionRequest)(nil),         // 2: metamorph_api.TransactionRequest
+	(*TransactionRequests)(nil),        // 3: metamorph_api.TransactionRequests
+	(*PostTransactionRequest)(nil),     // 4: metamorph_api.PostTransactionRequest
+	(*PostTransactionsRequest)(nil),    // 5: metamorph_api.PostTransactionsRequest
+	(*Transaction)(nil),                // 6: metamorph_api.Transaction
+	(*Callback)(nil),                   // 7: metamorph_api.callback
+	(*TransactionStatus)(nil),          // 8: metamorph_api.TransactionStatus
+	(*NodeSubmission)(nil),             // 9: metamorph_api.NodeSubmission
+	(*PeerAck)(nil),                    // 10: metamorph_api.PeerAck
+	(*BlockTemplate)(nil),              // 11: metamorph_api.BlockTemplate
+	(*PostBlockTemplateRequest)(nil),   // 12: metamorph_api.PostBlockTemplateRequest
+	(*PostBlockTemplateResponse)(nil),  // 13: metamorph_api.PostBlockTemplateResponse
+	(*TransactionGraphRequest)(nil),    // 14: metamorph_api.TransactionGraphRequest
+	(*TransactionGraphNode)(nil),       // 15: metamorph_api.TransactionGraphNode
+	(*TransactionGraph)(nil),           // 16: metamorph_api.TransactionGraph
+	(*StageTiming)(nil),                // 17: metamorph_api.StageTiming
+	(*TransactionStatuses)(nil),        // 18: metamorph_api.TransactionStatuses
+	(*TransactionStatusRequest)(nil),   // 19: metamorph_api.TransactionStatusRequest
+	(*UpdateInstancesRequest)(nil),     // 20: metamorph_api.UpdateInstancesRequest
+	(*ClearDataRequest)(nil),           // 21: metamorph_api.ClearDataRequest
+	(*ClearDataResponse)(nil),          // 22: metamorph_api.ClearDataResponse
+	(*TransactionsStatusRequest)(nil),  // 23: metamorph_api.TransactionsStatusRequest
+	(*UnlockRecordsRequest)(nil),       // 24: metamorph_api.UnlockRecordsRequest
+	(*UnlockRecordsResponse)(nil),      // 25: metamorph_api.UnlockRecordsResponse
+	(*ReplayCallbacksResponse)(nil),    // 26: metamorph_api.ReplayCallbacksResponse
+	(*OutpointSpenderRequest)(nil),     // 27: metamorph_api.OutpointSpenderRequest
+	(*OutpointSpender)(nil),            // 28: metamorph_api.OutpointSpender
+	(*JobRequest)(nil),                 // 29: metamorph_api.JobRequest
+	(*Job)(nil),                        // 30: metamorph_api.Job
+	(*Jobs)(nil),                       // 31: metamorph_api.Jobs
+	(*Transactions)(nil),               // 32: metamorph_api.Transactions
+	(*SLAReportsRequest)(nil),          // 33: metamorph_api.SLAReportsRequest
+	(*SLAReport)(nil),                  // 34: metamorph_api.SLAReport
+	(*SLAReports)(nil),                 // 35: metamorph_api.SLAReports
+	(*AnnotateTransactionRequest)(nil), // 36: metamorph_api.AnnotateTransactionRequest
+	(*Annotation)(nil),                 // 37: metamorph_api.Annotation
+	(*EraseClientDataRequest)(nil),     // 38: metamorph_api.EraseClientDataRequest
+	(*Erasure)(nil),                    // 39: metamorph_api.Erasure
+	(*ListErasuresRequest)(nil),        // 40: metamorph_api.ListErasuresRequest
+	(*Erasures)(nil),                   // 41: metamorph_api.Erasures
+	(*BanPeerRequest)(nil),             // 42: metamorph_api.BanPeerRequest
+	(*PeerBan)(nil),                    // 43: metamorph_api.PeerBan
+	(*PeerBans)(nil),                   // 44: metamorph_api.PeerBans
+	(*ExportRequest)(nil),              // 45: metamorph_api.ExportRequest
+	(*ExportedTransaction)(nil),        // 46: metamorph_api.ExportedTransaction
+	(*ExportedTransactions)(nil),       // 47: metamorph_api.ExportedTransactions
+	(*Reverification)(nil),             // 48: metamorph_api.Reverification
+	(*AddReverificationRequest)(nil),   // 49: metamorph_api.AddReverificationRequest
+	(*GetReverificationsRequest)(nil),  // 50: metamorph_api.GetReverificationsRequest
+	(*Reverifications)(nil),            // 51: metamorph_api.Reverifications
+	(*ReverificationRequest)(nil),      // 52: metamorph_api.ReverificationRequest
+	(*UnverifiedTransactions)(nil),     // 53: metamorph_api.UnverifiedTransactions
+	(*RejectTransactionsRequest)(nil),  // 54: metamorph_api.RejectTransactionsRequest
+	(*LogLevelRequest)(nil),            // 55: metamorph_api.LogLevelRequest
+	(*timestamppb.Timestamp)(nil),      // 56: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 57: google.protobuf.Empty
 }
 var file_internal_metamorph_metamorph_api_metamorph_api_proto_depIdxs = []int32{
-	16, // 0: metamorph_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
+	56, // 0: metamorph_api.HealthResponse.timestamp:type_name -> google.protobuf.Timestamp
 	0,  // 1: metamorph_api.TransactionRequest.wait_for_status:type_name -> metamorph_api.Status
 	2,  // 2: metamorph_api.TransactionRequests.Transactions:type_name -> metamorph_api.TransactionRequest
 	0,  // 3: metamorph_api.PostTransactionRequest.wait_for_status:type_name -> metamorph_api.Status
-	4,  // 4: metamorph_api.PostTransactionsRequest.Transactions:type_name -> metamorph_api.PostTransactionRequest
-	16, // 5: metamorph_api.Transaction.stored_at:type_name -> google.protobuf.Timestamp
-	16, // 6: metamorph_api.Transaction.announced_at:type_name -> google.protobuf.Timestamp
-	16, // 7: metamorph_api.Transaction.mined_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: metamorph_api.Transaction.status:type_name -> metamorph_api.Status
-	16, // 9: metamorph_api.TransactionStatus.stored_at:type_name -> google.protobuf.Timestamp
-	0,  // 10: metamorph_api.TransactionStatus.status:type_name -> metamorph_api.Status
-	16, // 11: metamorph_api.TransactionStatus.last_submitted:type_name -> google.protobuf.Timestamp
-	7,  // 12: metamorph_api.TransactionStatus.callbacks:type_name -> metamorph_api.callback
-	8,  // 13: metamorph_api.TransactionStatuses.Statuses:type_name -> metamorph_api.TransactionStatus
-	6,  // 14: metamorph_api.Transactions.transactions:type_name -> metamorph_api.Transaction
-	17, // 15: metamorph_api.MetaMorphAPI.Health:input_type -> google.protobuf.Empty
-	5,  // 16: metamorph_api.MetaMorphAPI.PostTransactions:input_type -> metamorph_api.PostTransactionsRequest
-	10, // 17: metamorph_api.MetaMorphAPI.GetTransaction:input_type -> metamorph_api.TransactionStatusRequest
-	14, // 18: metamorph_api.MetaMorphAPI.GetTransactions:input_type -> metamorph_api.TransactionsStatusRequest
-	10, // 19: metamorph_api.MetaMorphAPI.GetTransactionStatus:input_type -> metamorph_api.TransactionStatusRequest
-	14, // 20: metamorph_api.MetaMorphAPI.GetTransactionStatuses:input_type -> metamorph_api.TransactionsStatusRequest
-	11, // 21: metamorph_api.MetaMorphAPI.UpdateInstances:input_type -> metamorph_api.UpdateInstancesRequest
-	12, // 22: metamorph_api.MetaMorphAPI.ClearData:input_type -> metamorph_api.ClearDataRequest
-	1,  // 23: metamorph_api.MetaMorphAPI.Health:output_type -> metamorph_api.HealthResponse
-	9,  // 24: metamorph_api.MetaMorphAPI.PostTransactions:output_type -> metamorph_api.TransactionStatuses
-	6,  // 25: metamorph_api.MetaMorphAPI.GetTransaction:output_type -> metamorph_api.Transaction
-	15, // 26: metamorph_api.MetaMorphAPI.GetTransactions:output_type -> metamorph_api.Transactions
-	8,  // 27: metamorph_api.MetaMorphAPI.GetTransactionStatus:output_type -> metamorph_api.TransactionStatus
-	9,  // 28: metamorph_api.MetaMorphAPI.GetTransactionStatuses:output_type -> metamorph_api.TransactionStatuses
-	17, // 29: metamorph_api.MetaMorphAPI.UpdateInstances:output_type -> google.protobuf.Empty
-	13, // 30: metamorph_api.MetaMorphAPI.ClearData:output_type -> metamorph_api.ClearDataResponse
-	23, // [23:31] is the sub-list for method output_type
-	15, // [15:23] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	7,  // 4: metamorph_api.PostTransactionRequest.additional_callbacks:type_name -> metamorph_api.callback
+	56, // 5: metamorph_api.PostTransactionRequest.received_at:type_name -> google.protobuf.Timestamp
+	56, // 6: metamorph_api.PostTransactionRequest.validated_at:type_name -> google.protobuf.Timestamp
+	56, // 7: metamorph_api.PostTransactionRequest.broadcast_at:type_name -> google.protobuf.Timestamp
+	56, // 8: metamorph_api.PostTransactionRequest.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 9: metamorph_api.PostTransactionsRequest.Transactions:type_name -> metamorph_api.PostTransactionRequest
+	56, // 10: metamorph_api.Transaction.stored_at:type_name -> google.protobuf.Timestamp
+	56, // 11: metamorph_api.Transaction.announced_at:type_name -> google.protobuf.Timestamp
+	56, // 12: metamorph_api.Transaction.mined_at:type_name -> google.protobuf.Timestamp
+	0,  // 13: metamorph_api.Transaction.status:type_name -> metamorph_api.Status
+	56, // 14: metamorph_api.TransactionStatus.stored_at:type_name -> google.protobuf.Timestamp
+	0,  // 15: metamorph_api.TransactionStatus.status:type_name -> metamorph_api.Status
+	56, // 16: metamorph_api.TransactionStatus.last_submitted:type_name -> google.protobuf.Timestamp
+	7,  // 17: metamorph_api.TransactionStatus.callbacks:type_name -> metamorph_api.callback
+	17, // 18: metamorph_api.TransactionStatus.stage_timings:type_name -> metamorph_api.StageTiming
+	11, // 19: metamorph_api.TransactionStatus.block_template:type_name -> metamorph_api.BlockTemplate
+	10, // 20: metamorph_api.TransactionStatus.peer_acks:type_name -> metamorph_api.PeerAck
+	37, // 21: metamorph_api.TransactionStatus.annotations:type_name -> metamorph_api.Annotation
+	9,  // 22: metamorph_api.TransactionStatus.node_submission:type_name -> metamorph_api.NodeSubmission
+	56, // 23: metamorph_api.NodeSubmission.submitted_at:type_name -> google.protobuf.Timestamp
+	56, // 24: metamorph_api.PeerAck.requested_at:type_name -> google.protobuf.Timestamp
+	56, // 25: metamorph_api.PeerAck.sent_at:type_name -> google.protobuf.Timestamp
+	56, // 26: metamorph_api.BlockTemplate.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 27: metamorph_api.TransactionGraphNode.status:type_name -> metamorph_api.Status
+	15, // 28: metamorph_api.TransactionGraph.nodes:type_name -> metamorph_api.TransactionGraphNode
+	56, // 29: metamorph_api.StageTiming.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 30: metamorph_api.TransactionStatuses.Statuses:type_name -> metamorph_api.TransactionStatus
+	0,  // 31: metamorph_api.OutpointSpender.status:type_name -> metamorph_api.Status
+	56, // 32: metamorph_api.Job.last_run:type_name -> google.protobuf.Timestamp
+	56, // 33: metamorph_api.Job.next_run:type_name -> google.protobuf.Timestamp
+	30, // 34: metamorph_api.Jobs.jobs:type_name -> metamorph_api.Job
+	6,  // 35: metamorph_api.Transactions.transactions:type_name -> metamorph_api.Transaction
+	56, // 36: metamorph_api.SLAReportsRequest.from:type_name -> google.protobuf.Timestamp
+	56, // 37: metamorph_api.SLAReportsRequest.to:type_name -> google.protobuf.Timestamp
+	56, // 38: metamorph_api.SLAReport.period_start:type_name -> google.protobuf.Timestamp
+	56, // 39: metamorph_api.SLAReport.computed_at:type_name -> google.protobuf.Timestamp
+	34, // 40: metamorph_api.SLAReports.reports:type_name -> metamorph_api.SLAReport
+	56, // 41: metamorph_api.Annotation.created_at:type_name -> google.protobuf.Timestamp
+	56, // 42: metamorph_api.Erasure.erased_at:type_name -> google.protobuf.Timestamp
+	39, // 43: metamorph_api.Erasures.erasures:type_name -> metamorph_api.Erasure
+	56, // 44: metamorph_api.PeerBan.banned_until:type_name -> google.protobuf.Timestamp
+	43, // 45: metamorph_api.PeerBans.peers:type_name -> metamorph_api.PeerBan
+	43, // 46: metamorph_api.PeerBans.bans:type_name -> metamorph_api.PeerBan
+	56, // 47: metamorph_api.ExportRequest.from:type_name -> google.protobuf.Timestamp
+	56, // 48: metamorph_api.ExportRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 49: metamorph_api.ExportRequest.statuses:type_name -> metamorph_api.Status
+	56, // 50: metamorph_api.ExportRequest.after_stored_at:type_name -> google.protobuf.Timestamp
+	0,  // 51: metamorph_api.ExportedTransaction.status:type_name -> metamorph_api.Status
+	56, // 52: metamorph_api.ExportedTransaction.stored_at:type_name -> google.protobuf.Timestamp
+	56, // 53: metamorph_api.ExportedTransaction.last_modified:type_name -> google.protobuf.Timestamp
+	46, // 54: metamorph_api.ExportedTransactions.transactions:type_name -> metamorph_api.ExportedTransaction
+	56, // 55: metamorph_api.Reverification.added_at:type_name -> google.protobuf.Timestamp
+	48, // 56: metamorph_api.AddReverificationRequest.reverification:type_name -> metamorph_api.Reverification
+	56, // 57: metamorph_api.GetReverificationsRequest.locked_until:type_name -> google.protobuf.Timestamp
+	48, // 58: metamorph_api.Reverifications.reverifications:type_name -> metamorph_api.Reverification
+	4,  // 59: metamorph_api.RejectTransactionsRequest.transactions:type_name -> metamorph_api.PostTransactionRequest
+	57, // 60: metamorph_api.MetaMorphAPI.Health:input_type -> google.protobuf.Empty
+	5,  // 61: metamorph_api.MetaMorphAPI.PostTransactions:input_type -> metamorph_api.PostTransactionsRequest
+	19, // 62: metamorph_api.MetaMorphAPI.GetTransaction:input_type -> metamorph_api.TransactionStatusRequest
+	23, // 63: metamorph_api.MetaMorphAPI.GetTransactions:input_type -> metamorph_api.TransactionsStatusRequest
+	19, // 64: metamorph_api.MetaMorphAPI.GetTransactionStatus:input_type -> metamorph_api.TransactionStatusRequest
+	23, // 65: metamorph_api.MetaMorphAPI.GetTransactionStatuses:input_type -> metamorph_api.TransactionsStatusRequest
+	20, // 66: metamorph_api.MetaMorphAPI.UpdateInstances:input_type -> metamorph_api.UpdateInstancesRequest
+	21, // 67: metamorph_api.MetaMorphAPI.ClearData:input_type -> metamorph_api.ClearDataRequest
+	19, // 68: metamorph_api.MetaMorphAPI.ResubmitTransaction:input_type -> metamorph_api.TransactionStatusRequest
+	33, // 69: metamorph_api.MetaMorphAPI.GetSLAReports:input_type -> metamorph_api.SLAReportsRequest
+	12, // 70: metamorph_api.MetaMorphAPI.PostBlockTemplate:input_type -> metamorph_api.PostBlockTemplateRequest
+	14, // 71: metamorph_api.MetaMorphAPI.GetTransactionGraph:input_type -> metamorph_api.TransactionGraphRequest
+	19, // 72: metamorph_api.MetaMorphAPI.CancelScheduledBroadcast:input_type -> metamorph_api.TransactionStatusRequest
+	24, // 73: metamorph_api.MetaMorphAPI.UnlockRecords:input_type -> metamorph_api.UnlockRecordsRequest
+	23, // 74: metamorph_api.MetaMorphAPI.ReplayCallbacks:input_type -> metamorph_api.TransactionsStatusRequest
+	27, // 75: metamorph_api.MetaMorphAPI.GetOutpointSpender:input_type -> metamorph_api.OutpointSpenderRequest
+	57, // 76: metamorph_api.MetaMorphAPI.ListJobs:input_type -> google.protobuf.Empty
+	29, // 77: metamorph_api.MetaMorphAPI.TriggerJob:input_type -> metamorph_api.JobRequest
+	29, // 78: metamorph_api.MetaMorphAPI.PauseJob:input_type -> metamorph_api.JobRequest
+	29, // 79: metamorph_api.MetaMorphAPI.ResumeJob:input_type -> metamorph_api.JobRequest
+	36, // 80: metamorph_api.MetaMorphAPI.AnnotateTransaction:input_type -> metamorph_api.AnnotateTransactionRequest
+	38, // 81: metamorph_api.MetaMorphAPI.EraseClientData:input_type -> metamorph_api.EraseClientDataRequest
+	40, // 82: metamorph_api.MetaMorphAPI.ListErasures:input_type -> metamorph_api.ListErasuresRequest
+	57, // 83: metamorph_api.MetaMorphAPI.ListPeers:input_type -> google.protobuf.Empty
+	42, // 84: metamorph_api.MetaMorphAPI.BanPeer:input_type -> metamorph_api.BanPeerRequest
+	42, // 85: metamorph_api.MetaMorphAPI.UnbanPeer:input_type -> metamorph_api.BanPeerRequest
+	45, // 86: metamorph_api.MetaMorphAPI.GetExport:input_type -> metamorph_api.ExportRequest
+	49, // 87: metamorph_api.MetaMorphAPI.AddReverification:input_type -> metamorph_api.AddReverificationRequest
+	50, // 88: metamorph_api.MetaMorphAPI.GetReverifications:input_type -> metamorph_api.GetReverificationsRequest
+	52, // 89: metamorph_api.MetaMorphAPI.DeleteReverification:input_type -> metamorph_api.ReverificationRequest
+	23, // 90: metamorph_api.MetaMorphAPI.GetUnverifiedTransactions:input_type -> metamorph_api.TransactionsStatusRequest
+	54, // 91: metamorph_api.MetaMorphAPI.RejectTransactions:input_type -> metamorph_api.RejectTransactionsRequest
+	55, // 92: metamorph_api.MetaMorphAPI.SetLogLevel:input_type -> metamorph_api.LogLevelRequest
+	55, // 93: metamorph_api.MetaMorphAPI.ResetLogLevel:input_type -> metamorph_api.LogLevelRequest
+	1,  // 94: metamorph_api.MetaMorphAPI.Health:output_type -> metamorph_api.HealthResponse
+	18, // 95: metamorph_api.MetaMorphAPI.PostTransactions:output_type -> metamorph_api.TransactionStatuses
+	6,  // 96: metamorph_api.MetaMorphAPI.GetTransaction:output_type -> metamorph_api.Transaction
+	32, // 97: metamorph_api.MetaMorphAPI.GetTransactions:output_type -> metamorph_api.Transactions
+	8,  // 98: metamorph_api.MetaMorphAPI.GetTransactionStatus:output_type -> metamorph_api.TransactionStatus
+	18, // 99: metamorph_api.MetaMorphAPI.GetTransactionStatuses:output_type -> metamorph_api.TransactionStatuses
+	57, // 100: metamorph_api.MetaMorphAPI.UpdateInstances:output_type -> google.protobuf.Empty
+	22, // 101: metamorph_api.MetaMorphAPI.ClearData:output_type -> metamorph_api.ClearDataResponse
+	8,  // 102: metamorph_api.MetaMorphAPI.ResubmitTransaction:output_type -> metamorph_api.TransactionStatus
+	35, // 103: metamorph_api.MetaMorphAPI.GetSLAReports:output_type -> metamorph_api.SLAReports
+	13, // 104: metamorph_api.MetaMorphAPI.PostBlockTemplate:output_type -> metamorph_api.PostBlockTemplateResponse
+	16, // 105: metamorph_api.MetaMorphAPI.GetTransactionGraph:output_type -> metamorph_api.TransactionGraph
+	57, // 106: metamorph_api.MetaMorphAPI.CancelScheduledBroadcast:output_type -> google.protobuf.Empty
+	25, // 107: metamorph_api.MetaMorphAPI.UnlockRecords:output_type -> metamorph_api.UnlockRecordsResponse
+	26, // 108: metamorph_api.MetaMorphAPI.ReplayCallbacks:output_type -> metamorph_api.ReplayCallbacksResponse
+	28, // 109: metamorph_api.MetaMorphAPI.GetOutpointSpender:output_type -> metamorph_api.OutpointSpender
+	31, // 110: metamorph_api.MetaMorphAPI.ListJobs:output_type -> metamorph_api.Jobs
+	30, // 111: metamorph_api.MetaMorphAPI.TriggerJob:output_type -> metamorph_api.Job
+	30, // 112: metamorph_api.MetaMorphAPI.PauseJob:output_type -> metamorph_api.Job
+	30, // 113: metamorph_api.MetaMorphAPI.ResumeJob:output_type -> metamorph_api.Job
+	37, // 114: metamorph_api.MetaMorphAPI.AnnotateTransaction:output_type -> metamorph_api.Annotation
+	39, // 115: metamorph_api.MetaMorphAPI.EraseClientData:output_type -> metamorph_api.Erasure
+	41, // 116: metamorph_api.MetaMorphAPI.ListErasures:output_type -> metamorph_api.Erasures
+	44, // 117: metamorph_api.MetaMorphAPI.ListPeers:output_type -> metamorph_api.PeerBans
+	44, // 118: metamorph_api.MetaMorphAPI.BanPeer:output_type -> metamorph_api.PeerBans
+	44, // 119: metamorph_api.MetaMorphAPI.UnbanPeer:output_type -> metamorph_api.PeerBans
+	47, // 120: metamorph_api.MetaMorphAPI.GetExport:output_type -> metamorph_api.ExportedTransactions
+	48, // 121: metamorph_api.MetaMorphAPI.AddReverification:output_type -> metamorph_api.Reverification
+	51, // 122: metamorph_api.MetaMorphAPI.GetReverifications:output_type -> metamorph_api.Reverifications
+	57, // 123: metamorph_api.MetaMorphAPI.DeleteReverification:output_type -> google.protobuf.Empty
+	53, // 124: metamorph_api.MetaMorphAPI.GetUnverifiedTransactions:output_type -> metamorph_api.UnverifiedTransactions
+	18, // 125: metamorph_api.MetaMorphAPI.RejectTransactions:output_type -> metamorph_api.TransactionStatuses
+	57, // 126: metamorph_api.MetaMorphAPI.SetLogLevel:output_type -> google.protobuf.Empty
+	57, // 127: metamorph_api.MetaMorphAPI.ResetLogLevel:output_type -> google.protobuf.Empty
+	94, // [94:128] is the sub-list for method output_type
+	60, // [60:94] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
 }
 
 func init() { file_internal_metamorph_metamorph_api_metamorph_api_proto_init() }
@@ -1362,7 +4455,7 @@ func file_internal_metamorph_metamorph_api_metamorph_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc), len(file_internal_metamorph_metamorph_api_metamorph_api_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,14 +20,40 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetaMorphAPI_Health_FullMethodName                 = "/metamorph_api.MetaMorphAPI/Health"
-	MetaMorphAPI_PostTransactions_FullMethodName       = "/metamorph_api.MetaMorphAPI/PostTransactions"
-	MetaMorphAPI_GetTransaction_FullMethodName         = "/metamorph_api.MetaMorphAPI/GetTransaction"
-	MetaMorphAPI_GetTransactions_FullMethodName        = "/metamorph_api.MetaMorphAPI/GetTransactions"
-	MetaMorphAPI_GetTransactionStatus_FullMethodName   = "/metamorph_api.MetaMorphAPI/GetTransactionStatus"
-	MetaMorphAPI_GetTransactionStatuses_FullMethodName = "/metamorph_api.MetaMorphAPI/GetTransactionStatuses"
-	MetaMorphAPI_UpdateInstances_FullMethodName        = "/metamorph_api.MetaMorphAPI/UpdateInstances"
-	MetaMorphAPI_ClearData_FullMethodName              = "/metamorph_api.MetaMorphAPI/ClearData"
+	MetaMorphAPI_Health_FullMethodName                    = "/metamorph_api.MetaMorphAPI/Health"
+	MetaMorphAPI_PostTransactions_FullMethodName          = "/metamorph_api.MetaMorphAPI/PostTransactions"
+	MetaMorphAPI_GetTransaction_FullMethodName            = "/metamorph_api.MetaMorphAPI/GetTransaction"
+	MetaMorphAPI_GetTransactions_FullMethodName           = "/metamorph_api.MetaMorphAPI/GetTransactions"
+	MetaMorphAPI_GetTransactionStatus_FullMethodName      = "/metamorph_api.MetaMorphAPI/GetTransactionStatus"
+	MetaMorphAPI_GetTransactionStatuses_FullMethodName    = "/metamorph_api.MetaMorphAPI/GetTransactionStatuses"
+	MetaMorphAPI_UpdateInstances_FullMethodName           = "/metamorph_api.MetaMorphAPI/UpdateInstances"
+	MetaMorphAPI_ClearData_FullMethodName                 = "/metamorph_api.MetaMorphAPI/ClearData"
+	MetaMorphAPI_ResubmitTransaction_FullMethodName       = "/metamorph_api.MetaMorphAPI/ResubmitTransaction"
+	MetaMorphAPI_GetSLAReports_FullMethodName             = "/metamorph_api.MetaMorphAPI/GetSLAReports"
+	MetaMorphAPI_PostBlockTemplate_FullMethodName         = "/metamorph_api.MetaMorphAPI/PostBlockTemplate"
+	MetaMorphAPI_GetTransactionGraph_FullMethodName       = "/metamorph_api.MetaMorphAPI/GetTransactionGraph"
+	MetaMorphAPI_CancelScheduledBroadcast_FullMethodName  = "/metamorph_api.MetaMorphAPI/CancelScheduledBroadcast"
+	MetaMorphAPI_UnlockRecords_FullMethodName             = "/metamorph_api.MetaMorphAPI/UnlockRecords"
+	MetaMorphAPI_ReplayCallbacks_FullMethodName           = "/metamorph_api.MetaMorphAPI/ReplayCallbacks"
+	MetaMorphAPI_GetOutpointSpender_FullMethodName        = "/metamorph_api.MetaMorphAPI/GetOutpointSpender"
+	MetaMorphAPI_ListJobs_FullMethodName                  = "/metamorph_api.MetaMorphAPI/ListJobs"
+	MetaMorphAPI_TriggerJob_FullMethodName                = "/metamorph_api.MetaMorphAPI/TriggerJob"
+	MetaMorphAPI_PauseJob_FullMethodName                  = "/metamorph_api.MetaMorphAPI/PauseJob"
+	MetaMorphAPI_ResumeJob_FullMethodName                 = "/metamorph_api.MetaMorphAPI/ResumeJob"
+	MetaMorphAPI_AnnotateTransaction_FullMethodName       = "/metamorph_api.MetaMorphAPI/AnnotateTransaction"
+	MetaMorphAPI_EraseClientData_FullMethodName           = "/metamorph_api.MetaMorphAPI/EraseClientData"
+	MetaMorphAPI_ListErasures_FullMethodName              = "/metamorph_api.MetaMorphAPI/ListErasures"
+	MetaMorphAPI_ListPeers_FullMethodName                 = "/metamorph_api.MetaMorphAPI/ListPeers"
+	MetaMorphAPI_BanPeer_FullMethodName                   = "/metamorph_api.MetaMorphAPI/BanPeer"
+	MetaMorphAPI_UnbanPeer_FullMethodName                 = "/metamorph_api.MetaMorphAPI/UnbanPeer"
+	MetaMorphAPI_GetExport_FullMethodName                 = "/metamorph_api.MetaMorphAPI/GetExport"
+	MetaMorphAPI_AddReverification_FullMethodName         = "/metamorph_api.MetaMorphAPI/AddReverification"
+	MetaMorphAPI_GetReverifications_FullMethodName        = "/metamorph_api.MetaMorphAPI/GetReverifications"
+	MetaMorphAPI_DeleteReverification_FullMethodName      = "/metamorph_api.MetaMorphAPI/DeleteReverification"
+	MetaMorphAPI_GetUnverifiedTransactions_FullMethodName = "/metamorph_api.MetaMorphAPI/GetUnverifiedTransactions"
+	MetaMorphAPI_RejectTransactions_FullMethodName        = "/metamorph_api.MetaMorphAPI/RejectTransactions"
+	MetaMorphAPI_SetLogLevel_FullMethodName               = "/metamorph_api.MetaMorphAPI/SetLogLevel"
+	MetaMorphAPI_ResetLogLevel_FullMethodName             = "/metamorph_api.MetaMorphAPI/ResetLogLevel"
 )
 
 // MetaMorphAPIClient is the client API for MetaMorphAPI service.
@@ -42,6 +68,32 @@ type MetaMorphAPIClient interface {
 	GetTransactionStatuses(ctx context.Context, in *TransactionsStatusRequest, opts ...grpc.CallOption) (*TransactionStatuses, error)
 	UpdateInstances(ctx context.Context, in *UpdateInstancesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ClearData(ctx context.Context, in *ClearDataRequest, opts ...grpc.CallOption) (*ClearDataResponse, error)
+	ResubmitTransaction(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatus, error)
+	GetSLAReports(ctx context.Context, in *SLAReportsRequest, opts ...grpc.CallOption) (*SLAReports, error)
+	PostBlockTemplate(ctx context.Context, in *PostBlockTemplateRequest, opts ...grpc.CallOption) (*PostBlockTemplateResponse, error)
+	GetTransactionGraph(ctx context.Context, in *TransactionGraphRequest, opts ...grpc.CallOption) (*TransactionGraph, error)
+	CancelScheduledBroadcast(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnlockRecords(ctx context.Context, in *UnlockRecordsRequest, opts ...grpc.CallOption) (*UnlockRecordsResponse, error)
+	ReplayCallbacks(ctx context.Context, in *TransactionsStatusRequest, opts ...grpc.CallOption) (*ReplayCallbacksResponse, error)
+	GetOutpointSpender(ctx context.Context, in *OutpointSpenderRequest, opts ...grpc.CallOption) (*OutpointSpender, error)
+	ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Jobs, error)
+	TriggerJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	PauseJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	ResumeJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error)
+	AnnotateTransaction(ctx context.Context, in *AnnotateTransactionRequest, opts ...grpc.CallOption) (*Annotation, error)
+	EraseClientData(ctx context.Context, in *EraseClientDataRequest, opts ...grpc.CallOption) (*Erasure, error)
+	ListErasures(ctx context.Context, in *ListErasuresRequest, opts ...grpc.CallOption) (*Erasures, error)
+	ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeerBans, error)
+	BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error)
+	UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error)
+	GetExport(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportedTransactions, error)
+	AddReverification(ctx context.Context, in *AddReverificationRequest, opts ...grpc.CallOption) (*Reverification, error)
+	GetReverifications(ctx context.Context, in *GetReverificationsRequest, opts ...grpc.CallOption) (*Reverifications, error)
+	DeleteReverification(ctx context.Context, in *ReverificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetUnverifiedTransactions(ctx context.Context, in *TransactionsStatusRequest, opts ...grpc.CallOption) (*UnverifiedTransactions, error)
+	RejectTransactions(ctx context.Context, in *RejectTransactionsRequest, opts ...grpc.CallOption) (*TransactionStatuses, error)
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type metaMorphAPIClient struct {
@@ -132,6 +184,266 @@ func (c *metaMorphAPIClient) ClearData(ctx context.Context, in *ClearDataRequest
 	return out, nil
 }
 
+func (c *metaMorphAPIClient) ResubmitTransaction(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionStatus)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ResubmitTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) GetSLAReports(ctx context.Context, in *SLAReportsRequest, opts ...grpc.CallOption) (*SLAReports, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SLAReports)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_GetSLAReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) PostBlockTemplate(ctx context.Context, in *PostBlockTemplateRequest, opts ...grpc.CallOption) (*PostBlockTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PostBlockTemplateResponse)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_PostBlockTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) GetTransactionGraph(ctx context.Context, in *TransactionGraphRequest, opts ...grpc.CallOption) (*TransactionGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionGraph)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_GetTransactionGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) CancelScheduledBroadcast(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_CancelScheduledBroadcast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) UnlockRecords(ctx context.Context, in *UnlockRecordsRequest, opts ...grpc.CallOption) (*UnlockRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockRecordsResponse)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_UnlockRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) ReplayCallbacks(ctx context.Context, in *TransactionsStatusRequest, opts ...grpc.CallOption) (*ReplayCallbacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayCallbacksResponse)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ReplayCallbacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) GetOutpointSpender(ctx context.Context, in *OutpointSpenderRequest, opts ...grpc.CallOption) (*OutpointSpender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OutpointSpender)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_GetOutpointSpender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Jobs, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Jobs)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) TriggerJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_TriggerJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) PauseJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_PauseJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) ResumeJob(ctx context.Context, in *JobRequest, opts ...grpc.CallOption) (*Job, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Job)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ResumeJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) AnnotateTransaction(ctx context.Context, in *AnnotateTransactionRequest, opts ...grpc.CallOption) (*Annotation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Annotation)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_AnnotateTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) EraseClientData(ctx context.Context, in *EraseClientDataRequest, opts ...grpc.CallOption) (*Erasure, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Erasure)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_EraseClientData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) ListErasures(ctx context.Context, in *ListErasuresRequest, opts ...grpc.CallOption) (*Erasures, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Erasures)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ListErasures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*PeerBans, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerBans)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ListPeers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) BanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerBans)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_BanPeer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) UnbanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*PeerBans, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PeerBans)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_UnbanPeer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) GetExport(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportedTransactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportedTransactions)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_GetExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) AddReverification(ctx context.Context, in *AddReverificationRequest, opts ...grpc.CallOption) (*Reverification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reverification)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_AddReverification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) GetReverifications(ctx context.Context, in *GetReverificationsRequest, opts ...grpc.CallOption) (*Reverifications, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reverifications)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_GetReverifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) DeleteReverification(ctx context.Context, in *ReverificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_DeleteReverification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) GetUnverifiedTransactions(ctx context.Context, in *TransactionsStatusRequest, opts ...grpc.CallOption) (*UnverifiedTransactions, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnverifiedTransactions)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_GetUnverifiedTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) RejectTransactions(ctx context.Context, in *RejectTransactionsRequest, opts ...grpc.CallOption) (*TransactionStatuses, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionStatuses)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_RejectTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_SetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metaMorphAPIClient) ResetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MetaMorphAPI_ResetLogLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetaMorphAPIServer is the server API for MetaMorphAPI service.
 // All implementations must embed UnimplementedMetaMorphAPIServer
 // for forward compatibility.
@@ -144,6 +456,32 @@ type MetaMorphAPIServer interface {
 	GetTransactionStatuses(context.Context, *TransactionsStatusRequest) (*TransactionStatuses, error)
 	UpdateInstances(context.Context, *UpdateInstancesRequest) (*emptypb.Empty, error)
 	ClearData(context.Context, *ClearDataRequest) (*ClearDataResponse, error)
+	ResubmitTransaction(context.Context, *TransactionStatusRequest) (*TransactionStatus, error)
+	GetSLAReports(context.Context, *SLAReportsRequest) (*SLAReports, error)
+	PostBlockTemplate(context.Context, *PostBlockTemplateRequest) (*PostBlockTemplateResponse, error)
+	GetTransactionGraph(context.Context, *TransactionGraphRequest) (*TransactionGraph, error)
+	CancelScheduledBroadcast(context.Context, *TransactionStatusRequest) (*emptypb.Empty, error)
+	UnlockRecords(context.Context, *UnlockRecordsRequest) (*UnlockRecordsResponse, error)
+	ReplayCallbacks(context.Context, *TransactionsStatusRequest) (*ReplayCallbacksResponse, error)
+	GetOutpointSpender(context.Context, *OutpointSpenderRequest) (*OutpointSpender, error)
+	ListJobs(context.Context, *emptypb.Empty) (*Jobs, error)
+	TriggerJob(context.Context, *JobRequest) (*Job, error)
+	PauseJob(context.Context, *JobRequest) (*Job, error)
+	ResumeJob(context.Context, *JobRequest) (*Job, error)
+	AnnotateTransaction(context.Context, *AnnotateTransactionRequest) (*Annotation, error)
+	EraseClientData(context.Context, *EraseClientDataRequest) (*Erasure, error)
+	ListErasures(context.Context, *ListErasuresRequest) (*Erasures, error)
+	ListPeers(context.Context, *emptypb.Empty) (*PeerBans, error)
+	BanPeer(context.Context, *BanPeerRequest) (*PeerBans, error)
+	UnbanPeer(context.Context, *BanPeerRequest) (*PeerBans, error)
+	GetExport(context.Context, *ExportRequest) (*ExportedTransactions, error)
+	AddReverification(context.Context, *AddReverificationRequest) (*Reverification, error)
+	GetReverifications(context.Context, *GetReverificationsRequest) (*Reverifications, error)
+	DeleteReverification(context.Context, *ReverificationRequest) (*emptypb.Empty, error)
+	GetUnverifiedTransactions(context.Context, *TransactionsStatusRequest) (*UnverifiedTransactions, error)
+	RejectTransactions(context.Context, *RejectTransactionsRequest) (*TransactionStatuses, error)
+	SetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error)
+	ResetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMetaMorphAPIServer()
 }
 
@@ -178,6 +516,84 @@ func (UnimplementedMetaMorphAPIServer) UpdateInstances(context.Context, *UpdateI
 func (UnimplementedMetaMorphAPIServer) ClearData(context.Context, *ClearDataRequest) (*ClearDataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearData not implemented")
 }
+func (UnimplementedMetaMorphAPIServer) ResubmitTransaction(context.Context, *TransactionStatusRequest) (*TransactionStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitTransaction not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) GetSLAReports(context.Context, *SLAReportsRequest) (*SLAReports, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSLAReports not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) PostBlockTemplate(context.Context, *PostBlockTemplateRequest) (*PostBlockTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostBlockTemplate not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) GetTransactionGraph(context.Context, *TransactionGraphRequest) (*TransactionGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionGraph not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) CancelScheduledBroadcast(context.Context, *TransactionStatusRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledBroadcast not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) UnlockRecords(context.Context, *UnlockRecordsRequest) (*UnlockRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockRecords not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) ReplayCallbacks(context.Context, *TransactionsStatusRequest) (*ReplayCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayCallbacks not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) GetOutpointSpender(context.Context, *OutpointSpenderRequest) (*OutpointSpender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutpointSpender not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) ListJobs(context.Context, *emptypb.Empty) (*Jobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) TriggerJob(context.Context, *JobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerJob not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) PauseJob(context.Context, *JobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) ResumeJob(context.Context, *JobRequest) (*Job, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) AnnotateTransaction(context.Context, *AnnotateTransactionRequest) (*Annotation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateTransaction not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) EraseClientData(context.Context, *EraseClientDataRequest) (*Erasure, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EraseClientData not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) ListErasures(context.Context, *ListErasuresRequest) (*Erasures, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListErasures not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) ListPeers(context.Context, *emptypb.Empty) (*PeerBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPeers not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) BanPeer(context.Context, *BanPeerRequest) (*PeerBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BanPeer not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) UnbanPeer(context.Context, *BanPeerRequest) (*PeerBans, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbanPeer not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) GetExport(context.Context, *ExportRequest) (*ExportedTransactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExport not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) AddReverification(context.Context, *AddReverificationRequest) (*Reverification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReverification not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) GetReverifications(context.Context, *GetReverificationsRequest) (*Reverifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReverifications not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) DeleteReverification(context.Context, *ReverificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReverification not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) GetUnverifiedTransactions(context.Context, *TransactionsStatusRequest) (*UnverifiedTransactions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnverifiedTransactions not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) RejectTransactions(context.Context, *RejectTransactionsRequest) (*TransactionStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTransactions not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) SetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedMetaMorphAPIServer) ResetLogLevel(context.Context, *LogLevelRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetLogLevel not implemented")
+}
 func (UnimplementedMetaMorphAPIServer) mustEmbedUnimplementedMetaMorphAPIServer() {}
 func (UnimplementedMetaMorphAPIServer) testEmbeddedByValue()                      {}
 
@@ -343,44 +759,616 @@ func _MetaMorphAPI_ClearData_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-// MetaMorphAPI_ServiceDesc is the grpc.ServiceDesc for MetaMorphAPI service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var MetaMorphAPI_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "metamorph_api.MetaMorphAPI",
-	HandlerType: (*MetaMorphAPIServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Health",
-			Handler:    _MetaMorphAPI_Health_Handler,
-		},
-		{
-			MethodName: "PostTransactions",
-			Handler:    _MetaMorphAPI_PostTransactions_Handler,
-		},
-		{
-			MethodName: "GetTransaction",
-			Handler:    _MetaMorphAPI_GetTransaction_Handler,
-		},
-		{
-			MethodName: "GetTransactions",
-			Handler:    _MetaMorphAPI_GetTransactions_Handler,
-		},
-		{
-			MethodName: "GetTransactionStatus",
-			Handler:    _MetaMorphAPI_GetTransactionStatus_Handler,
-		},
-		{
-			MethodName: "GetTransactionStatuses",
-			Handler:    _MetaMorphAPI_GetTransactionStatuses_Handler,
-		},
-		{
-			MethodName: "UpdateInstances",
-			Handler:    _MetaMorphAPI_UpdateInstances_Handler,
-		},
-		{
-			MethodName: "ClearData",
-			Handler:    _MetaMorphAPI_ClearData_Handler,
+func _MetaMorphAPI_ResubmitTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ResubmitTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ResubmitTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ResubmitTransaction(ctx, req.(*TransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_GetSLAReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SLAReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).GetSLAReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_GetSLAReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).GetSLAReports(ctx, req.(*SLAReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_PostBlockTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostBlockTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).PostBlockTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_PostBlockTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).PostBlockTemplate(ctx, req.(*PostBlockTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_GetTransactionGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).GetTransactionGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_GetTransactionGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).GetTransactionGraph(ctx, req.(*TransactionGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_CancelScheduledBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).CancelScheduledBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_CancelScheduledBroadcast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).CancelScheduledBroadcast(ctx, req.(*TransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_UnlockRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).UnlockRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_UnlockRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).UnlockRecords(ctx, req.(*UnlockRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_ReplayCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ReplayCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ReplayCallbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ReplayCallbacks(ctx, req.(*TransactionsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_GetOutpointSpender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OutpointSpenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).GetOutpointSpender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_GetOutpointSpender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).GetOutpointSpender(ctx, req.(*OutpointSpenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ListJobs(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_TriggerJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).TriggerJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_TriggerJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).TriggerJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_PauseJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).PauseJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ResumeJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ResumeJob(ctx, req.(*JobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_AnnotateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).AnnotateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_AnnotateTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).AnnotateTransaction(ctx, req.(*AnnotateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_EraseClientData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EraseClientDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).EraseClientData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_EraseClientData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).EraseClientData(ctx, req.(*EraseClientDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_ListErasures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListErasuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ListErasures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ListErasures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ListErasures(ctx, req.(*ListErasuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ListPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ListPeers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ListPeers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_BanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).BanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_BanPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).BanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_UnbanPeer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).UnbanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_GetExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).GetExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_GetExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).GetExport(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_AddReverification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReverificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).AddReverification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_AddReverification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).AddReverification(ctx, req.(*AddReverificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_GetReverifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReverificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).GetReverifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_GetReverifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).GetReverifications(ctx, req.(*GetReverificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_DeleteReverification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).DeleteReverification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_DeleteReverification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).DeleteReverification(ctx, req.(*ReverificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_GetUnverifiedTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).GetUnverifiedTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_GetUnverifiedTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).GetUnverifiedTransactions(ctx, req.(*TransactionsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_RejectTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).RejectTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_RejectTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).RejectTransactions(ctx, req.(*RejectTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).SetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetaMorphAPI_ResetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetaMorphAPIServer).ResetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetaMorphAPI_ResetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetaMorphAPIServer).ResetLogLevel(ctx, req.(*LogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MetaMorphAPI_ServiceDesc is the grpc.ServiceDesc for MetaMorphAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetaMorphAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "metamorph_api.MetaMorphAPI",
+	HandlerType: (*MetaMorphAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Health",
+			Handler:    _MetaMorphAPI_Health_Handler,
+		},
+		{
+			MethodName: "PostTransactions",
+			Handler:    _MetaMorphAPI_PostTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _MetaMorphAPI_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _MetaMorphAPI_GetTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _MetaMorphAPI_GetTransactionStatus_Handler,
+		},
+		{
+			MethodName: "GetTransactionStatuses",
+			Handler:    _MetaMorphAPI_GetTransactionStatuses_Handler,
+		},
+		{
+			MethodName: "UpdateInstances",
+			Handler:    _MetaMorphAPI_UpdateInstances_Handler,
+		},
+		{
+			MethodName: "ClearData",
+			Handler:    _MetaMorphAPI_ClearData_Handler,
+		},
+		{
+			MethodName: "ResubmitTransaction",
+			Handler:    _MetaMorphAPI_ResubmitTransaction_Handler,
+		},
+		{
+			MethodName: "GetSLAReports",
+			Handler:    _MetaMorphAPI_GetSLAReports_Handler,
+		},
+		{
+			MethodName: "PostBlockTemplate",
+			Handler:    _MetaMorphAPI_PostBlockTemplate_Handler,
+		},
+		{
+			MethodName: "GetTransactionGraph",
+			Handler:    _MetaMorphAPI_GetTransactionGraph_Handler,
+		},
+		{
+			MethodName: "CancelScheduledBroadcast",
+			Handler:    _MetaMorphAPI_CancelScheduledBroadcast_Handler,
+		},
+		{
+			MethodName: "UnlockRecords",
+			Handler:    _MetaMorphAPI_UnlockRecords_Handler,
+		},
+		{
+			MethodName: "ReplayCallbacks",
+			Handler:    _MetaMorphAPI_ReplayCallbacks_Handler,
+		},
+		{
+			MethodName: "GetOutpointSpender",
+			Handler:    _MetaMorphAPI_GetOutpointSpender_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _MetaMorphAPI_ListJobs_Handler,
+		},
+		{
+			MethodName: "TriggerJob",
+			Handler:    _MetaMorphAPI_TriggerJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _MetaMorphAPI_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _MetaMorphAPI_ResumeJob_Handler,
+		},
+		{
+			MethodName: "AnnotateTransaction",
+			Handler:    _MetaMorphAPI_AnnotateTransaction_Handler,
+		},
+		{
+			MethodName: "EraseClientData",
+			Handler:    _MetaMorphAPI_EraseClientData_Handler,
+		},
+		{
+			MethodName: "ListErasures",
+			Handler:    _MetaMorphAPI_ListErasures_Handler,
+		},
+		{
+			MethodName: "ListPeers",
+			Handler:    _MetaMorphAPI_ListPeers_Handler,
+		},
+		{
+			MethodName: "BanPeer",
+			Handler:    _MetaMorphAPI_BanPeer_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _MetaMorphAPI_UnbanPeer_Handler,
+		},
+		{
+			MethodName: "GetExport",
+			Handler:    _MetaMorphAPI_GetExport_Handler,
+		},
+		{
+			MethodName: "AddReverification",
+			Handler:    _MetaMorphAPI_AddReverification_Handler,
+		},
+		{
+			MethodName: "GetReverifications",
+			Handler:    _MetaMorphAPI_GetReverifications_Handler,
+		},
+		{
+			MethodName: "DeleteReverification",
+			Handler:    _MetaMorphAPI_DeleteReverification_Handler,
+		},
+		{
+			MethodName: "GetUnverifiedTransactions",
+			Handler:    _MetaMorphAPI_GetUnverifiedTransactions_Handler,
+		},
+		{
+			MethodName: "RejectTransactions",
+			Handler:    _MetaMorphAPI_RejectTransactions_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _MetaMorphAPI_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ResetLogLevel",
+			Handler:    _MetaMorphAPI_ResetLogLevel_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
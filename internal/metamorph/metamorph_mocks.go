@@ -9,6 +9,7 @@ package metamorph
 // from processor.go
 //go:generate moq -pkg mocks -out ./mocks/callback_sender_mock.go . CallbackSender
 //go:generate moq -pkg mocks -out ./mocks/mediator_mock.go . Mediator
+//go:generate moq -pkg mocks -out ./mocks/status_exporter_mock.go . StatusExporter
 
 // from zmq.go
 //go:generate moq -pkg mocks -out ./mocks/zmq_mock.go . ZMQI
@@ -17,5 +18,14 @@ package metamorph
 //go:generate moq -pkg mocks -out ./mocks/processor_mock.go . ProcessorI
 //go:generate moq -pkg mocks -out ./mocks/bitcoin_mock.go . BitcoinNode
 
+// from mining_candidate.go
+//go:generate moq -pkg mocks -out ./mocks/mining_candidate_node_mock.go . MiningCandidateNode
+
+// from reconciliation.go
+//go:generate moq -pkg mocks -out ./mocks/reconciliation_node_mock.go . ReconciliationNode
+
+// from node_submission.go
+//go:generate moq -pkg mocks -out ./mocks/fallback_node_mock.go . FallbackNode
+
 // from client.go
 //go:generate moq -pkg mocks -out ./mocks/transaction_handler_mock.go . TransactionHandler
@@ -0,0 +1,145 @@
+package metamorph
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/ordishs/go-bitcoin"
+)
+
+const miningCandidatePollIntervalDefault = 10 * time.Second
+
+type MiningCandidateNode interface {
+	GetMiningCandidate() (*bitcoin.MiningCandidate, error)
+	GetBlockTemplate(includeSegwit bool) (*bitcoin.BlockTemplate, error)
+}
+
+type miningCandidate struct {
+	id       string
+	markedAt time.Time
+}
+
+// MiningCandidatePoller polls the mining candidates of nodes and marks the transactions known to ARC which are
+// included in them in the block templates. As the mining candidate only carries the merkle branch of the coinbase
+// transaction, the transactions of a new mining candidate are read from the block template of the node.
+type MiningCandidatePoller struct {
+	logger         *slog.Logger
+	blockTemplates *BlockTemplates
+	nodes          map[string]MiningCandidateNode
+	pollInterval   time.Duration
+	now            func() time.Time
+
+	candidates map[string]miningCandidate
+
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithMiningCandidatePollInterval(d time.Duration) func(*MiningCandidatePoller) {
+	return func(p *MiningCandidatePoller) {
+		p.pollInterval = d
+	}
+}
+
+func WithMiningCandidateNow(nowFunc func() time.Time) func(*MiningCandidatePoller) {
+	return func(p *MiningCandidatePoller) {
+		p.now = nowFunc
+	}
+}
+
+// NewMiningCandidatePoller creates a poller for the given nodes by name, e.g. the host of the node.
+func NewMiningCandidatePoller(logger *slog.Logger, blockTemplates *BlockTemplates, nodes map[string]MiningCandidateNode, opts ...func(*MiningCandidatePoller)) *MiningCandidatePoller {
+	p := &MiningCandidatePoller{
+		logger:         logger.With(slog.String("module", "mining-candidate-poller")),
+		blockTemplates: blockTemplates,
+		nodes:          nodes,
+		pollInterval:   miningCandidatePollIntervalDefault,
+		now:            time.Now,
+		candidates:     make(map[string]miningCandidate),
+		waitGroup:      &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	p.cancelAll = cancelAll
+	p.ctx = ctx
+
+	return p
+}
+
+// Start polls the mining candidates of all nodes every poll interval.
+func (p *MiningCandidatePoller) Start() {
+	ticker := time.NewTicker(p.pollInterval)
+	p.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			p.waitGroup.Done()
+		}()
+
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.Poll()
+			}
+		}
+	}()
+}
+
+// Poll reads the mining candidate of each node. The transactions of a mining candidate are marked in the block
+// templates if the mining candidate is new, or if it was marked half the block template expiry ago, so that the
+// transactions stay marked as long as the mining candidate does not change.
+func (p *MiningCandidatePoller) Poll() {
+	for name, node := range p.nodes {
+		candidate, err := node.GetMiningCandidate()
+		if err != nil {
+			p.logger.Warn("Failed to get mining candidate", slog.String("node", name), slog.String("err", err.Error()))
+			continue
+		}
+
+		now := p.now()
+		last, found := p.candidates[name]
+		if found && last.id == candidate.ID && now.Sub(last.markedAt) < p.blockTemplates.expiry/2 {
+			continue
+		}
+
+		template, err := node.GetBlockTemplate(false)
+		if err != nil {
+			p.logger.Warn("Failed to get block template", slog.String("node", name), slog.String("err", err.Error()))
+			continue
+		}
+
+		hashes := make([]*chainhash.Hash, 0, len(template.Transactions))
+		for _, tx := range template.Transactions {
+			hash, err := chainhash.NewHashFromStr(tx.TXID)
+			if err != nil {
+				p.logger.Warn("Invalid txid in block template", slog.String("node", name), slog.String("txid", tx.TXID))
+				continue
+			}
+			hashes = append(hashes, hash)
+		}
+
+		_, err = p.blockTemplates.Add(p.ctx, name, candidate.PreviousHash, hashes)
+		if err != nil {
+			p.logger.Error("Failed to add block template", slog.String("node", name), slog.String("err", err.Error()))
+			continue
+		}
+
+		p.candidates[name] = miningCandidate{id: candidate.ID, markedAt: now}
+	}
+}
+
+func (p *MiningCandidatePoller) Shutdown() {
+	p.cancelAll()
+	p.waitGroup.Wait()
+}
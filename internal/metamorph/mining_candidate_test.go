@@ -0,0 +1,111 @@
+package metamorph_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/ordishs/go-bitcoin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestMiningCandidatePoller_Poll(t *testing.T) {
+	now := time.Date(2025, 2, 3, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name              string
+		candidateErr      error
+		secondCandidateID string
+		secondPollAfter   time.Duration
+
+		expectedTemplateCalls int
+		expectedTemplate      *metamorph.BlockTemplate
+	}{
+		{
+			name:              "same candidate",
+			secondCandidateID: "candidate-1",
+			secondPollAfter:   10 * time.Second,
+
+			expectedTemplateCalls: 1,
+			expectedTemplate:      &metamorph.BlockTemplate{Source: "node-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: now},
+		},
+		{
+			name:              "same candidate - refreshed",
+			secondCandidateID: "candidate-1",
+			secondPollAfter:   time.Minute,
+
+			expectedTemplateCalls: 2,
+			expectedTemplate:      &metamorph.BlockTemplate{Source: "node-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: now.Add(time.Minute)},
+		},
+		{
+			name:              "new candidate",
+			secondCandidateID: "candidate-2",
+			secondPollAfter:   10 * time.Second,
+
+			expectedTemplateCalls: 2,
+			expectedTemplate:      &metamorph.BlockTemplate{Source: "node-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: now.Add(10 * time.Second)},
+		},
+		{
+			name:         "failed to get mining candidate",
+			candidateErr: errors.New("connection refused"),
+
+			expectedTemplateCalls: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := blockTemplateStore(&store.Data{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK})
+
+			current := now
+			nowFunc := func() time.Time { return current }
+
+			candidateID := "candidate-1"
+			node := &mocks.MiningCandidateNodeMock{
+				GetMiningCandidateFunc: func() (*bitcoin.MiningCandidate, error) {
+					if tc.candidateErr != nil {
+						return nil, tc.candidateErr
+					}
+					return &bitcoin.MiningCandidate{ID: candidateID, PreviousHash: testdata.Block1Hash.String()}, nil
+				},
+				GetBlockTemplateFunc: func(_ bool) (*bitcoin.BlockTemplate, error) {
+					return &bitcoin.BlockTemplate{
+						PreviousBlockHash: testdata.Block1Hash.String(),
+						Transactions:      []bitcoin.Transaction{{TXID: testdata.TX1Hash.String()}, {TXID: testdata.TX2Hash.String()}},
+					}, nil
+				},
+			}
+
+			blockTemplates := metamorph.NewBlockTemplates(slog.Default(), metamorphStore, cache.NewMemoryStore(), metamorph.WithBlockTemplateNow(nowFunc))
+			sut := metamorph.NewMiningCandidatePoller(slog.Default(), blockTemplates, map[string]metamorph.MiningCandidateNode{"node-1": node}, metamorph.WithMiningCandidateNow(nowFunc))
+
+			// when
+			sut.Poll()
+			current = now.Add(tc.secondPollAfter)
+			candidateID = tc.secondCandidateID
+			sut.Poll()
+
+			// then
+			assert.Len(t, node.GetMiningCandidateCalls(), 2)
+			assert.Len(t, node.GetBlockTemplateCalls(), tc.expectedTemplateCalls)
+
+			actual, err := blockTemplates.Get(testdata.TX1Hash)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedTemplate, actual)
+
+			unknown, err := blockTemplates.Get(testdata.TX2Hash)
+			require.NoError(t, err)
+			assert.Nil(t, unknown)
+		})
+	}
+}
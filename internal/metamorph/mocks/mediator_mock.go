@@ -24,6 +24,9 @@ var _ metamorph.Mediator = &MediatorMock{}
 //			AnnounceTxAsyncFunc: func(ctx context.Context, tx *store.Data)  {
 //				panic("mock out the AnnounceTxAsync method")
 //			},
+//			AnnounceTxToPeersAsyncFunc: func(ctx context.Context, tx *store.Data, peers []p2p.PeerI)  {
+//				panic("mock out the AnnounceTxToPeersAsync method")
+//			},
 //			AskForTxAsyncFunc: func(ctx context.Context, tx *store.Data)  {
 //				panic("mock out the AskForTxAsync method")
 //			},
@@ -43,6 +46,9 @@ type MediatorMock struct {
 	// AnnounceTxAsyncFunc mocks the AnnounceTxAsync method.
 	AnnounceTxAsyncFunc func(ctx context.Context, tx *store.Data)
 
+	// AnnounceTxToPeersAsyncFunc mocks the AnnounceTxToPeersAsync method.
+	AnnounceTxToPeersAsyncFunc func(ctx context.Context, tx *store.Data, peers []p2p.PeerI)
+
 	// AskForTxAsyncFunc mocks the AskForTxAsync method.
 	AskForTxAsyncFunc func(ctx context.Context, tx *store.Data)
 
@@ -61,6 +67,15 @@ type MediatorMock struct {
 			// Tx is the tx argument value.
 			Tx *store.Data
 		}
+		// AnnounceTxToPeersAsync holds details about calls to the AnnounceTxToPeersAsync method.
+		AnnounceTxToPeersAsync []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx *store.Data
+			// Peers is the peers argument value.
+			Peers []p2p.PeerI
+		}
 		// AskForTxAsync holds details about calls to the AskForTxAsync method.
 		AskForTxAsync []struct {
 			// Ctx is the ctx argument value.
@@ -75,10 +90,11 @@ type MediatorMock struct {
 		GetPeers []struct {
 		}
 	}
-	lockAnnounceTxAsync     sync.RWMutex
-	lockAskForTxAsync       sync.RWMutex
-	lockCountConnectedPeers sync.RWMutex
-	lockGetPeers            sync.RWMutex
+	lockAnnounceTxAsync        sync.RWMutex
+	lockAnnounceTxToPeersAsync sync.RWMutex
+	lockAskForTxAsync          sync.RWMutex
+	lockCountConnectedPeers    sync.RWMutex
+	lockGetPeers               sync.RWMutex
 }
 
 // AnnounceTxAsync calls AnnounceTxAsyncFunc.
@@ -117,6 +133,46 @@ func (mock *MediatorMock) AnnounceTxAsyncCalls() []struct {
 	return calls
 }
 
+// AnnounceTxToPeersAsync calls AnnounceTxToPeersAsyncFunc.
+func (mock *MediatorMock) AnnounceTxToPeersAsync(ctx context.Context, tx *store.Data, peers []p2p.PeerI) {
+	if mock.AnnounceTxToPeersAsyncFunc == nil {
+		panic("MediatorMock.AnnounceTxToPeersAsyncFunc: method is nil but Mediator.AnnounceTxToPeersAsync was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    *store.Data
+		Peers []p2p.PeerI
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Peers: peers,
+	}
+	mock.lockAnnounceTxToPeersAsync.Lock()
+	mock.calls.AnnounceTxToPeersAsync = append(mock.calls.AnnounceTxToPeersAsync, callInfo)
+	mock.lockAnnounceTxToPeersAsync.Unlock()
+	mock.AnnounceTxToPeersAsyncFunc(ctx, tx, peers)
+}
+
+// AnnounceTxToPeersAsyncCalls gets all the calls that were made to AnnounceTxToPeersAsync.
+// Check the length with:
+//
+//	len(mockedMediator.AnnounceTxToPeersAsyncCalls())
+func (mock *MediatorMock) AnnounceTxToPeersAsyncCalls() []struct {
+	Ctx   context.Context
+	Tx    *store.Data
+	Peers []p2p.PeerI
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    *store.Data
+		Peers []p2p.PeerI
+	}
+	mock.lockAnnounceTxToPeersAsync.RLock()
+	calls = mock.calls.AnnounceTxToPeersAsync
+	mock.lockAnnounceTxToPeersAsync.RUnlock()
+	return calls
+}
+
 // AskForTxAsync calls AskForTxAsyncFunc.
 func (mock *MediatorMock) AskForTxAsync(ctx context.Context, tx *store.Data) {
 	if mock.AskForTxAsyncFunc == nil {
@@ -21,12 +21,45 @@ var _ metamorph_api.MetaMorphAPIClient = &MetaMorphAPIClientMock{}
 //
 //		// make and configure a mocked metamorph_api.MetaMorphAPIClient
 //		mockedMetaMorphAPIClient := &MetaMorphAPIClientMock{
+//			AddReverificationFunc: func(ctx context.Context, in *metamorph_api.AddReverificationRequest, opts ...grpc.CallOption) (*metamorph_api.Reverification, error) {
+//				panic("mock out the AddReverification method")
+//			},
+//			AnnotateTransactionFunc: func(ctx context.Context, in *metamorph_api.AnnotateTransactionRequest, opts ...grpc.CallOption) (*metamorph_api.Annotation, error) {
+//				panic("mock out the AnnotateTransaction method")
+//			},
+//			BanPeerFunc: func(ctx context.Context, in *metamorph_api.BanPeerRequest, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+//				panic("mock out the BanPeer method")
+//			},
+//			CancelScheduledBroadcastFunc: func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the CancelScheduledBroadcast method")
+//			},
 //			ClearDataFunc: func(ctx context.Context, in *metamorph_api.ClearDataRequest, opts ...grpc.CallOption) (*metamorph_api.ClearDataResponse, error) {
 //				panic("mock out the ClearData method")
 //			},
+//			DeleteReverificationFunc: func(ctx context.Context, in *metamorph_api.ReverificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the DeleteReverification method")
+//			},
+//			EraseClientDataFunc: func(ctx context.Context, in *metamorph_api.EraseClientDataRequest, opts ...grpc.CallOption) (*metamorph_api.Erasure, error) {
+//				panic("mock out the EraseClientData method")
+//			},
+//			GetExportFunc: func(ctx context.Context, in *metamorph_api.ExportRequest, opts ...grpc.CallOption) (*metamorph_api.ExportedTransactions, error) {
+//				panic("mock out the GetExport method")
+//			},
+//			GetOutpointSpenderFunc: func(ctx context.Context, in *metamorph_api.OutpointSpenderRequest, opts ...grpc.CallOption) (*metamorph_api.OutpointSpender, error) {
+//				panic("mock out the GetOutpointSpender method")
+//			},
+//			GetReverificationsFunc: func(ctx context.Context, in *metamorph_api.GetReverificationsRequest, opts ...grpc.CallOption) (*metamorph_api.Reverifications, error) {
+//				panic("mock out the GetReverifications method")
+//			},
+//			GetSLAReportsFunc: func(ctx context.Context, in *metamorph_api.SLAReportsRequest, opts ...grpc.CallOption) (*metamorph_api.SLAReports, error) {
+//				panic("mock out the GetSLAReports method")
+//			},
 //			GetTransactionFunc: func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transaction, error) {
 //				panic("mock out the GetTransaction method")
 //			},
+//			GetTransactionGraphFunc: func(ctx context.Context, in *metamorph_api.TransactionGraphRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionGraph, error) {
+//				panic("mock out the GetTransactionGraph method")
+//			},
 //			GetTransactionStatusFunc: func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
 //				panic("mock out the GetTransactionStatus method")
 //			},
@@ -36,12 +69,57 @@ var _ metamorph_api.MetaMorphAPIClient = &MetaMorphAPIClientMock{}
 //			GetTransactionsFunc: func(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transactions, error) {
 //				panic("mock out the GetTransactions method")
 //			},
+//			GetUnverifiedTransactionsFunc: func(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.UnverifiedTransactions, error) {
+//				panic("mock out the GetUnverifiedTransactions method")
+//			},
 //			HealthFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.HealthResponse, error) {
 //				panic("mock out the Health method")
 //			},
+//			ListErasuresFunc: func(ctx context.Context, in *metamorph_api.ListErasuresRequest, opts ...grpc.CallOption) (*metamorph_api.Erasures, error) {
+//				panic("mock out the ListErasures method")
+//			},
+//			ListJobsFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.Jobs, error) {
+//				panic("mock out the ListJobs method")
+//			},
+//			ListPeersFunc: func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+//				panic("mock out the ListPeers method")
+//			},
+//			PauseJobFunc: func(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error) {
+//				panic("mock out the PauseJob method")
+//			},
+//			PostBlockTemplateFunc: func(ctx context.Context, in *metamorph_api.PostBlockTemplateRequest, opts ...grpc.CallOption) (*metamorph_api.PostBlockTemplateResponse, error) {
+//				panic("mock out the PostBlockTemplate method")
+//			},
 //			PostTransactionsFunc: func(ctx context.Context, in *metamorph_api.PostTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
 //				panic("mock out the PostTransactions method")
 //			},
+//			RejectTransactionsFunc: func(ctx context.Context, in *metamorph_api.RejectTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
+//				panic("mock out the RejectTransactions method")
+//			},
+//			ReplayCallbacksFunc: func(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.ReplayCallbacksResponse, error) {
+//				panic("mock out the ReplayCallbacks method")
+//			},
+//			ResetLogLevelFunc: func(ctx context.Context, in *metamorph_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the ResetLogLevel method")
+//			},
+//			ResubmitTransactionFunc: func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+//				panic("mock out the ResubmitTransaction method")
+//			},
+//			ResumeJobFunc: func(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error) {
+//				panic("mock out the ResumeJob method")
+//			},
+//			SetLogLevelFunc: func(ctx context.Context, in *metamorph_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+//				panic("mock out the SetLogLevel method")
+//			},
+//			TriggerJobFunc: func(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error) {
+//				panic("mock out the TriggerJob method")
+//			},
+//			UnbanPeerFunc: func(ctx context.Context, in *metamorph_api.BanPeerRequest, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+//				panic("mock out the UnbanPeer method")
+//			},
+//			UnlockRecordsFunc: func(ctx context.Context, in *metamorph_api.UnlockRecordsRequest, opts ...grpc.CallOption) (*metamorph_api.UnlockRecordsResponse, error) {
+//				panic("mock out the UnlockRecords method")
+//			},
 //			UpdateInstancesFunc: func(ctx context.Context, in *metamorph_api.UpdateInstancesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 //				panic("mock out the UpdateInstances method")
 //			},
@@ -52,12 +130,45 @@ var _ metamorph_api.MetaMorphAPIClient = &MetaMorphAPIClientMock{}
 //
 //	}
 type MetaMorphAPIClientMock struct {
+	// AddReverificationFunc mocks the AddReverification method.
+	AddReverificationFunc func(ctx context.Context, in *metamorph_api.AddReverificationRequest, opts ...grpc.CallOption) (*metamorph_api.Reverification, error)
+
+	// AnnotateTransactionFunc mocks the AnnotateTransaction method.
+	AnnotateTransactionFunc func(ctx context.Context, in *metamorph_api.AnnotateTransactionRequest, opts ...grpc.CallOption) (*metamorph_api.Annotation, error)
+
+	// BanPeerFunc mocks the BanPeer method.
+	BanPeerFunc func(ctx context.Context, in *metamorph_api.BanPeerRequest, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error)
+
+	// CancelScheduledBroadcastFunc mocks the CancelScheduledBroadcast method.
+	CancelScheduledBroadcastFunc func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
 	// ClearDataFunc mocks the ClearData method.
 	ClearDataFunc func(ctx context.Context, in *metamorph_api.ClearDataRequest, opts ...grpc.CallOption) (*metamorph_api.ClearDataResponse, error)
 
+	// DeleteReverificationFunc mocks the DeleteReverification method.
+	DeleteReverificationFunc func(ctx context.Context, in *metamorph_api.ReverificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
+	// EraseClientDataFunc mocks the EraseClientData method.
+	EraseClientDataFunc func(ctx context.Context, in *metamorph_api.EraseClientDataRequest, opts ...grpc.CallOption) (*metamorph_api.Erasure, error)
+
+	// GetExportFunc mocks the GetExport method.
+	GetExportFunc func(ctx context.Context, in *metamorph_api.ExportRequest, opts ...grpc.CallOption) (*metamorph_api.ExportedTransactions, error)
+
+	// GetOutpointSpenderFunc mocks the GetOutpointSpender method.
+	GetOutpointSpenderFunc func(ctx context.Context, in *metamorph_api.OutpointSpenderRequest, opts ...grpc.CallOption) (*metamorph_api.OutpointSpender, error)
+
+	// GetReverificationsFunc mocks the GetReverifications method.
+	GetReverificationsFunc func(ctx context.Context, in *metamorph_api.GetReverificationsRequest, opts ...grpc.CallOption) (*metamorph_api.Reverifications, error)
+
+	// GetSLAReportsFunc mocks the GetSLAReports method.
+	GetSLAReportsFunc func(ctx context.Context, in *metamorph_api.SLAReportsRequest, opts ...grpc.CallOption) (*metamorph_api.SLAReports, error)
+
 	// GetTransactionFunc mocks the GetTransaction method.
 	GetTransactionFunc func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transaction, error)
 
+	// GetTransactionGraphFunc mocks the GetTransactionGraph method.
+	GetTransactionGraphFunc func(ctx context.Context, in *metamorph_api.TransactionGraphRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionGraph, error)
+
 	// GetTransactionStatusFunc mocks the GetTransactionStatus method.
 	GetTransactionStatusFunc func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error)
 
@@ -67,17 +178,98 @@ type MetaMorphAPIClientMock struct {
 	// GetTransactionsFunc mocks the GetTransactions method.
 	GetTransactionsFunc func(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transactions, error)
 
+	// GetUnverifiedTransactionsFunc mocks the GetUnverifiedTransactions method.
+	GetUnverifiedTransactionsFunc func(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.UnverifiedTransactions, error)
+
 	// HealthFunc mocks the Health method.
 	HealthFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.HealthResponse, error)
 
+	// ListErasuresFunc mocks the ListErasures method.
+	ListErasuresFunc func(ctx context.Context, in *metamorph_api.ListErasuresRequest, opts ...grpc.CallOption) (*metamorph_api.Erasures, error)
+
+	// ListJobsFunc mocks the ListJobs method.
+	ListJobsFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.Jobs, error)
+
+	// ListPeersFunc mocks the ListPeers method.
+	ListPeersFunc func(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error)
+
+	// PauseJobFunc mocks the PauseJob method.
+	PauseJobFunc func(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error)
+
+	// PostBlockTemplateFunc mocks the PostBlockTemplate method.
+	PostBlockTemplateFunc func(ctx context.Context, in *metamorph_api.PostBlockTemplateRequest, opts ...grpc.CallOption) (*metamorph_api.PostBlockTemplateResponse, error)
+
 	// PostTransactionsFunc mocks the PostTransactions method.
 	PostTransactionsFunc func(ctx context.Context, in *metamorph_api.PostTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error)
 
+	// RejectTransactionsFunc mocks the RejectTransactions method.
+	RejectTransactionsFunc func(ctx context.Context, in *metamorph_api.RejectTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error)
+
+	// ReplayCallbacksFunc mocks the ReplayCallbacks method.
+	ReplayCallbacksFunc func(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.ReplayCallbacksResponse, error)
+
+	// ResetLogLevelFunc mocks the ResetLogLevel method.
+	ResetLogLevelFunc func(ctx context.Context, in *metamorph_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
+	// ResubmitTransactionFunc mocks the ResubmitTransaction method.
+	ResubmitTransactionFunc func(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error)
+
+	// ResumeJobFunc mocks the ResumeJob method.
+	ResumeJobFunc func(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error)
+
+	// SetLogLevelFunc mocks the SetLogLevel method.
+	SetLogLevelFunc func(ctx context.Context, in *metamorph_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+
+	// TriggerJobFunc mocks the TriggerJob method.
+	TriggerJobFunc func(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error)
+
+	// UnbanPeerFunc mocks the UnbanPeer method.
+	UnbanPeerFunc func(ctx context.Context, in *metamorph_api.BanPeerRequest, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error)
+
+	// UnlockRecordsFunc mocks the UnlockRecords method.
+	UnlockRecordsFunc func(ctx context.Context, in *metamorph_api.UnlockRecordsRequest, opts ...grpc.CallOption) (*metamorph_api.UnlockRecordsResponse, error)
+
 	// UpdateInstancesFunc mocks the UpdateInstances method.
 	UpdateInstancesFunc func(ctx context.Context, in *metamorph_api.UpdateInstancesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddReverification holds details about calls to the AddReverification method.
+		AddReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.AddReverificationRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// AnnotateTransaction holds details about calls to the AnnotateTransaction method.
+		AnnotateTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.AnnotateTransactionRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// BanPeer holds details about calls to the BanPeer method.
+		BanPeer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.BanPeerRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// CancelScheduledBroadcast holds details about calls to the CancelScheduledBroadcast method.
+		CancelScheduledBroadcast []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.TransactionStatusRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// ClearData holds details about calls to the ClearData method.
 		ClearData []struct {
 			// Ctx is the ctx argument value.
@@ -87,6 +279,60 @@ type MetaMorphAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// DeleteReverification holds details about calls to the DeleteReverification method.
+		DeleteReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.ReverificationRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// EraseClientData holds details about calls to the EraseClientData method.
+		EraseClientData []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.EraseClientDataRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// GetExport holds details about calls to the GetExport method.
+		GetExport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.ExportRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// GetOutpointSpender holds details about calls to the GetOutpointSpender method.
+		GetOutpointSpender []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.OutpointSpenderRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// GetReverifications holds details about calls to the GetReverifications method.
+		GetReverifications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.GetReverificationsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// GetSLAReports holds details about calls to the GetSLAReports method.
+		GetSLAReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.SLAReportsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// GetTransaction holds details about calls to the GetTransaction method.
 		GetTransaction []struct {
 			// Ctx is the ctx argument value.
@@ -96,6 +342,15 @@ type MetaMorphAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// GetTransactionGraph holds details about calls to the GetTransactionGraph method.
+		GetTransactionGraph []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.TransactionGraphRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// GetTransactionStatus holds details about calls to the GetTransactionStatus method.
 		GetTransactionStatus []struct {
 			// Ctx is the ctx argument value.
@@ -123,6 +378,15 @@ type MetaMorphAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// GetUnverifiedTransactions holds details about calls to the GetUnverifiedTransactions method.
+		GetUnverifiedTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.TransactionsStatusRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// Health holds details about calls to the Health method.
 		Health []struct {
 			// Ctx is the ctx argument value.
@@ -132,6 +396,51 @@ type MetaMorphAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// ListErasures holds details about calls to the ListErasures method.
+		ListErasures []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.ListErasuresRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ListJobs holds details about calls to the ListJobs method.
+		ListJobs []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *emptypb.Empty
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ListPeers holds details about calls to the ListPeers method.
+		ListPeers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *emptypb.Empty
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// PauseJob holds details about calls to the PauseJob method.
+		PauseJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.JobRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// PostBlockTemplate holds details about calls to the PostBlockTemplate method.
+		PostBlockTemplate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.PostBlockTemplateRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// PostTransactions holds details about calls to the PostTransactions method.
 		PostTransactions []struct {
 			// Ctx is the ctx argument value.
@@ -141,6 +450,87 @@ type MetaMorphAPIClientMock struct {
 			// Opts is the opts argument value.
 			Opts []grpc.CallOption
 		}
+		// RejectTransactions holds details about calls to the RejectTransactions method.
+		RejectTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.RejectTransactionsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ReplayCallbacks holds details about calls to the ReplayCallbacks method.
+		ReplayCallbacks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.TransactionsStatusRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ResetLogLevel holds details about calls to the ResetLogLevel method.
+		ResetLogLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.LogLevelRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ResubmitTransaction holds details about calls to the ResubmitTransaction method.
+		ResubmitTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.TransactionStatusRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// ResumeJob holds details about calls to the ResumeJob method.
+		ResumeJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.JobRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// SetLogLevel holds details about calls to the SetLogLevel method.
+		SetLogLevel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.LogLevelRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// TriggerJob holds details about calls to the TriggerJob method.
+		TriggerJob []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.JobRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// UnbanPeer holds details about calls to the UnbanPeer method.
+		UnbanPeer []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.BanPeerRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
+		// UnlockRecords holds details about calls to the UnlockRecords method.
+		UnlockRecords []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// In is the in argument value.
+			In *metamorph_api.UnlockRecordsRequest
+			// Opts is the opts argument value.
+			Opts []grpc.CallOption
+		}
 		// UpdateInstances holds details about calls to the UpdateInstances method.
 		UpdateInstances []struct {
 			// Ctx is the ctx argument value.
@@ -151,180 +541,1046 @@ type MetaMorphAPIClientMock struct {
 			Opts []grpc.CallOption
 		}
 	}
-	lockClearData              sync.RWMutex
-	lockGetTransaction         sync.RWMutex
-	lockGetTransactionStatus   sync.RWMutex
-	lockGetTransactionStatuses sync.RWMutex
-	lockGetTransactions        sync.RWMutex
-	lockHealth                 sync.RWMutex
-	lockPostTransactions       sync.RWMutex
-	lockUpdateInstances        sync.RWMutex
+	lockAddReverification         sync.RWMutex
+	lockAnnotateTransaction       sync.RWMutex
+	lockBanPeer                   sync.RWMutex
+	lockCancelScheduledBroadcast  sync.RWMutex
+	lockClearData                 sync.RWMutex
+	lockDeleteReverification      sync.RWMutex
+	lockEraseClientData           sync.RWMutex
+	lockGetExport                 sync.RWMutex
+	lockGetOutpointSpender        sync.RWMutex
+	lockGetReverifications        sync.RWMutex
+	lockGetSLAReports             sync.RWMutex
+	lockGetTransaction            sync.RWMutex
+	lockGetTransactionGraph       sync.RWMutex
+	lockGetTransactionStatus      sync.RWMutex
+	lockGetTransactionStatuses    sync.RWMutex
+	lockGetTransactions           sync.RWMutex
+	lockGetUnverifiedTransactions sync.RWMutex
+	lockHealth                    sync.RWMutex
+	lockListErasures              sync.RWMutex
+	lockListJobs                  sync.RWMutex
+	lockListPeers                 sync.RWMutex
+	lockPauseJob                  sync.RWMutex
+	lockPostBlockTemplate         sync.RWMutex
+	lockPostTransactions          sync.RWMutex
+	lockRejectTransactions        sync.RWMutex
+	lockReplayCallbacks           sync.RWMutex
+	lockResetLogLevel             sync.RWMutex
+	lockResubmitTransaction       sync.RWMutex
+	lockResumeJob                 sync.RWMutex
+	lockSetLogLevel               sync.RWMutex
+	lockTriggerJob                sync.RWMutex
+	lockUnbanPeer                 sync.RWMutex
+	lockUnlockRecords             sync.RWMutex
+	lockUpdateInstances           sync.RWMutex
 }
 
-// ClearData calls ClearDataFunc.
-func (mock *MetaMorphAPIClientMock) ClearData(ctx context.Context, in *metamorph_api.ClearDataRequest, opts ...grpc.CallOption) (*metamorph_api.ClearDataResponse, error) {
-	if mock.ClearDataFunc == nil {
-		panic("MetaMorphAPIClientMock.ClearDataFunc: method is nil but MetaMorphAPIClient.ClearData was just called")
+// AddReverification calls AddReverificationFunc.
+func (mock *MetaMorphAPIClientMock) AddReverification(ctx context.Context, in *metamorph_api.AddReverificationRequest, opts ...grpc.CallOption) (*metamorph_api.Reverification, error) {
+	if mock.AddReverificationFunc == nil {
+		panic("MetaMorphAPIClientMock.AddReverificationFunc: method is nil but MetaMorphAPIClient.AddReverification was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
-		In   *metamorph_api.ClearDataRequest
+		In   *metamorph_api.AddReverificationRequest
 		Opts []grpc.CallOption
 	}{
 		Ctx:  ctx,
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockClearData.Lock()
-	mock.calls.ClearData = append(mock.calls.ClearData, callInfo)
-	mock.lockClearData.Unlock()
-	return mock.ClearDataFunc(ctx, in, opts...)
+	mock.lockAddReverification.Lock()
+	mock.calls.AddReverification = append(mock.calls.AddReverification, callInfo)
+	mock.lockAddReverification.Unlock()
+	return mock.AddReverificationFunc(ctx, in, opts...)
 }
 
-// ClearDataCalls gets all the calls that were made to ClearData.
+// AddReverificationCalls gets all the calls that were made to AddReverification.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.ClearDataCalls())
-func (mock *MetaMorphAPIClientMock) ClearDataCalls() []struct {
+//	len(mockedMetaMorphAPIClient.AddReverificationCalls())
+func (mock *MetaMorphAPIClientMock) AddReverificationCalls() []struct {
 	Ctx  context.Context
-	In   *metamorph_api.ClearDataRequest
+	In   *metamorph_api.AddReverificationRequest
 	Opts []grpc.CallOption
 } {
 	var calls []struct {
 		Ctx  context.Context
-		In   *metamorph_api.ClearDataRequest
+		In   *metamorph_api.AddReverificationRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockClearData.RLock()
-	calls = mock.calls.ClearData
-	mock.lockClearData.RUnlock()
+	mock.lockAddReverification.RLock()
+	calls = mock.calls.AddReverification
+	mock.lockAddReverification.RUnlock()
 	return calls
 }
 
-// GetTransaction calls GetTransactionFunc.
-func (mock *MetaMorphAPIClientMock) GetTransaction(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transaction, error) {
-	if mock.GetTransactionFunc == nil {
-		panic("MetaMorphAPIClientMock.GetTransactionFunc: method is nil but MetaMorphAPIClient.GetTransaction was just called")
+// AnnotateTransaction calls AnnotateTransactionFunc.
+func (mock *MetaMorphAPIClientMock) AnnotateTransaction(ctx context.Context, in *metamorph_api.AnnotateTransactionRequest, opts ...grpc.CallOption) (*metamorph_api.Annotation, error) {
+	if mock.AnnotateTransactionFunc == nil {
+		panic("MetaMorphAPIClientMock.AnnotateTransactionFunc: method is nil but MetaMorphAPIClient.AnnotateTransaction was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
-		In   *metamorph_api.TransactionStatusRequest
+		In   *metamorph_api.AnnotateTransactionRequest
 		Opts []grpc.CallOption
 	}{
 		Ctx:  ctx,
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockGetTransaction.Lock()
-	mock.calls.GetTransaction = append(mock.calls.GetTransaction, callInfo)
-	mock.lockGetTransaction.Unlock()
-	return mock.GetTransactionFunc(ctx, in, opts...)
+	mock.lockAnnotateTransaction.Lock()
+	mock.calls.AnnotateTransaction = append(mock.calls.AnnotateTransaction, callInfo)
+	mock.lockAnnotateTransaction.Unlock()
+	return mock.AnnotateTransactionFunc(ctx, in, opts...)
 }
 
-// GetTransactionCalls gets all the calls that were made to GetTransaction.
+// AnnotateTransactionCalls gets all the calls that were made to AnnotateTransaction.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.GetTransactionCalls())
-func (mock *MetaMorphAPIClientMock) GetTransactionCalls() []struct {
+//	len(mockedMetaMorphAPIClient.AnnotateTransactionCalls())
+func (mock *MetaMorphAPIClientMock) AnnotateTransactionCalls() []struct {
 	Ctx  context.Context
-	In   *metamorph_api.TransactionStatusRequest
+	In   *metamorph_api.AnnotateTransactionRequest
 	Opts []grpc.CallOption
 } {
 	var calls []struct {
 		Ctx  context.Context
-		In   *metamorph_api.TransactionStatusRequest
+		In   *metamorph_api.AnnotateTransactionRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockGetTransaction.RLock()
-	calls = mock.calls.GetTransaction
-	mock.lockGetTransaction.RUnlock()
+	mock.lockAnnotateTransaction.RLock()
+	calls = mock.calls.AnnotateTransaction
+	mock.lockAnnotateTransaction.RUnlock()
 	return calls
 }
 
-// GetTransactionStatus calls GetTransactionStatusFunc.
-func (mock *MetaMorphAPIClientMock) GetTransactionStatus(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
-	if mock.GetTransactionStatusFunc == nil {
-		panic("MetaMorphAPIClientMock.GetTransactionStatusFunc: method is nil but MetaMorphAPIClient.GetTransactionStatus was just called")
+// BanPeer calls BanPeerFunc.
+func (mock *MetaMorphAPIClientMock) BanPeer(ctx context.Context, in *metamorph_api.BanPeerRequest, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+	if mock.BanPeerFunc == nil {
+		panic("MetaMorphAPIClientMock.BanPeerFunc: method is nil but MetaMorphAPIClient.BanPeer was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
-		In   *metamorph_api.TransactionStatusRequest
+		In   *metamorph_api.BanPeerRequest
 		Opts []grpc.CallOption
 	}{
 		Ctx:  ctx,
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockGetTransactionStatus.Lock()
-	mock.calls.GetTransactionStatus = append(mock.calls.GetTransactionStatus, callInfo)
-	mock.lockGetTransactionStatus.Unlock()
+	mock.lockBanPeer.Lock()
+	mock.calls.BanPeer = append(mock.calls.BanPeer, callInfo)
+	mock.lockBanPeer.Unlock()
+	return mock.BanPeerFunc(ctx, in, opts...)
+}
+
+// BanPeerCalls gets all the calls that were made to BanPeer.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.BanPeerCalls())
+func (mock *MetaMorphAPIClientMock) BanPeerCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.BanPeerRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockBanPeer.RLock()
+	calls = mock.calls.BanPeer
+	mock.lockBanPeer.RUnlock()
+	return calls
+}
+
+// CancelScheduledBroadcast calls CancelScheduledBroadcastFunc.
+func (mock *MetaMorphAPIClientMock) CancelScheduledBroadcast(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.CancelScheduledBroadcastFunc == nil {
+		panic("MetaMorphAPIClientMock.CancelScheduledBroadcastFunc: method is nil but MetaMorphAPIClient.CancelScheduledBroadcast was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockCancelScheduledBroadcast.Lock()
+	mock.calls.CancelScheduledBroadcast = append(mock.calls.CancelScheduledBroadcast, callInfo)
+	mock.lockCancelScheduledBroadcast.Unlock()
+	return mock.CancelScheduledBroadcastFunc(ctx, in, opts...)
+}
+
+// CancelScheduledBroadcastCalls gets all the calls that were made to CancelScheduledBroadcast.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.CancelScheduledBroadcastCalls())
+func (mock *MetaMorphAPIClientMock) CancelScheduledBroadcastCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockCancelScheduledBroadcast.RLock()
+	calls = mock.calls.CancelScheduledBroadcast
+	mock.lockCancelScheduledBroadcast.RUnlock()
+	return calls
+}
+
+// ClearData calls ClearDataFunc.
+func (mock *MetaMorphAPIClientMock) ClearData(ctx context.Context, in *metamorph_api.ClearDataRequest, opts ...grpc.CallOption) (*metamorph_api.ClearDataResponse, error) {
+	if mock.ClearDataFunc == nil {
+		panic("MetaMorphAPIClientMock.ClearDataFunc: method is nil but MetaMorphAPIClient.ClearData was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.ClearDataRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockClearData.Lock()
+	mock.calls.ClearData = append(mock.calls.ClearData, callInfo)
+	mock.lockClearData.Unlock()
+	return mock.ClearDataFunc(ctx, in, opts...)
+}
+
+// ClearDataCalls gets all the calls that were made to ClearData.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.ClearDataCalls())
+func (mock *MetaMorphAPIClientMock) ClearDataCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.ClearDataRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.ClearDataRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockClearData.RLock()
+	calls = mock.calls.ClearData
+	mock.lockClearData.RUnlock()
+	return calls
+}
+
+// DeleteReverification calls DeleteReverificationFunc.
+func (mock *MetaMorphAPIClientMock) DeleteReverification(ctx context.Context, in *metamorph_api.ReverificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.DeleteReverificationFunc == nil {
+		panic("MetaMorphAPIClientMock.DeleteReverificationFunc: method is nil but MetaMorphAPIClient.DeleteReverification was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.ReverificationRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockDeleteReverification.Lock()
+	mock.calls.DeleteReverification = append(mock.calls.DeleteReverification, callInfo)
+	mock.lockDeleteReverification.Unlock()
+	return mock.DeleteReverificationFunc(ctx, in, opts...)
+}
+
+// DeleteReverificationCalls gets all the calls that were made to DeleteReverification.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.DeleteReverificationCalls())
+func (mock *MetaMorphAPIClientMock) DeleteReverificationCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.ReverificationRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.ReverificationRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockDeleteReverification.RLock()
+	calls = mock.calls.DeleteReverification
+	mock.lockDeleteReverification.RUnlock()
+	return calls
+}
+
+// EraseClientData calls EraseClientDataFunc.
+func (mock *MetaMorphAPIClientMock) EraseClientData(ctx context.Context, in *metamorph_api.EraseClientDataRequest, opts ...grpc.CallOption) (*metamorph_api.Erasure, error) {
+	if mock.EraseClientDataFunc == nil {
+		panic("MetaMorphAPIClientMock.EraseClientDataFunc: method is nil but MetaMorphAPIClient.EraseClientData was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.EraseClientDataRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockEraseClientData.Lock()
+	mock.calls.EraseClientData = append(mock.calls.EraseClientData, callInfo)
+	mock.lockEraseClientData.Unlock()
+	return mock.EraseClientDataFunc(ctx, in, opts...)
+}
+
+// EraseClientDataCalls gets all the calls that were made to EraseClientData.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.EraseClientDataCalls())
+func (mock *MetaMorphAPIClientMock) EraseClientDataCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.EraseClientDataRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.EraseClientDataRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockEraseClientData.RLock()
+	calls = mock.calls.EraseClientData
+	mock.lockEraseClientData.RUnlock()
+	return calls
+}
+
+// GetExport calls GetExportFunc.
+func (mock *MetaMorphAPIClientMock) GetExport(ctx context.Context, in *metamorph_api.ExportRequest, opts ...grpc.CallOption) (*metamorph_api.ExportedTransactions, error) {
+	if mock.GetExportFunc == nil {
+		panic("MetaMorphAPIClientMock.GetExportFunc: method is nil but MetaMorphAPIClient.GetExport was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.ExportRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetExport.Lock()
+	mock.calls.GetExport = append(mock.calls.GetExport, callInfo)
+	mock.lockGetExport.Unlock()
+	return mock.GetExportFunc(ctx, in, opts...)
+}
+
+// GetExportCalls gets all the calls that were made to GetExport.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetExportCalls())
+func (mock *MetaMorphAPIClientMock) GetExportCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.ExportRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.ExportRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetExport.RLock()
+	calls = mock.calls.GetExport
+	mock.lockGetExport.RUnlock()
+	return calls
+}
+
+// GetOutpointSpender calls GetOutpointSpenderFunc.
+func (mock *MetaMorphAPIClientMock) GetOutpointSpender(ctx context.Context, in *metamorph_api.OutpointSpenderRequest, opts ...grpc.CallOption) (*metamorph_api.OutpointSpender, error) {
+	if mock.GetOutpointSpenderFunc == nil {
+		panic("MetaMorphAPIClientMock.GetOutpointSpenderFunc: method is nil but MetaMorphAPIClient.GetOutpointSpender was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.OutpointSpenderRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetOutpointSpender.Lock()
+	mock.calls.GetOutpointSpender = append(mock.calls.GetOutpointSpender, callInfo)
+	mock.lockGetOutpointSpender.Unlock()
+	return mock.GetOutpointSpenderFunc(ctx, in, opts...)
+}
+
+// GetOutpointSpenderCalls gets all the calls that were made to GetOutpointSpender.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetOutpointSpenderCalls())
+func (mock *MetaMorphAPIClientMock) GetOutpointSpenderCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.OutpointSpenderRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.OutpointSpenderRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetOutpointSpender.RLock()
+	calls = mock.calls.GetOutpointSpender
+	mock.lockGetOutpointSpender.RUnlock()
+	return calls
+}
+
+// GetReverifications calls GetReverificationsFunc.
+func (mock *MetaMorphAPIClientMock) GetReverifications(ctx context.Context, in *metamorph_api.GetReverificationsRequest, opts ...grpc.CallOption) (*metamorph_api.Reverifications, error) {
+	if mock.GetReverificationsFunc == nil {
+		panic("MetaMorphAPIClientMock.GetReverificationsFunc: method is nil but MetaMorphAPIClient.GetReverifications was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.GetReverificationsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetReverifications.Lock()
+	mock.calls.GetReverifications = append(mock.calls.GetReverifications, callInfo)
+	mock.lockGetReverifications.Unlock()
+	return mock.GetReverificationsFunc(ctx, in, opts...)
+}
+
+// GetReverificationsCalls gets all the calls that were made to GetReverifications.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetReverificationsCalls())
+func (mock *MetaMorphAPIClientMock) GetReverificationsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.GetReverificationsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.GetReverificationsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetReverifications.RLock()
+	calls = mock.calls.GetReverifications
+	mock.lockGetReverifications.RUnlock()
+	return calls
+}
+
+// GetSLAReports calls GetSLAReportsFunc.
+func (mock *MetaMorphAPIClientMock) GetSLAReports(ctx context.Context, in *metamorph_api.SLAReportsRequest, opts ...grpc.CallOption) (*metamorph_api.SLAReports, error) {
+	if mock.GetSLAReportsFunc == nil {
+		panic("MetaMorphAPIClientMock.GetSLAReportsFunc: method is nil but MetaMorphAPIClient.GetSLAReports was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.SLAReportsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetSLAReports.Lock()
+	mock.calls.GetSLAReports = append(mock.calls.GetSLAReports, callInfo)
+	mock.lockGetSLAReports.Unlock()
+	return mock.GetSLAReportsFunc(ctx, in, opts...)
+}
+
+// GetSLAReportsCalls gets all the calls that were made to GetSLAReports.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetSLAReportsCalls())
+func (mock *MetaMorphAPIClientMock) GetSLAReportsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.SLAReportsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.SLAReportsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetSLAReports.RLock()
+	calls = mock.calls.GetSLAReports
+	mock.lockGetSLAReports.RUnlock()
+	return calls
+}
+
+// GetTransaction calls GetTransactionFunc.
+func (mock *MetaMorphAPIClientMock) GetTransaction(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transaction, error) {
+	if mock.GetTransactionFunc == nil {
+		panic("MetaMorphAPIClientMock.GetTransactionFunc: method is nil but MetaMorphAPIClient.GetTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetTransaction.Lock()
+	mock.calls.GetTransaction = append(mock.calls.GetTransaction, callInfo)
+	mock.lockGetTransaction.Unlock()
+	return mock.GetTransactionFunc(ctx, in, opts...)
+}
+
+// GetTransactionCalls gets all the calls that were made to GetTransaction.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetTransactionCalls())
+func (mock *MetaMorphAPIClientMock) GetTransactionCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetTransaction.RLock()
+	calls = mock.calls.GetTransaction
+	mock.lockGetTransaction.RUnlock()
+	return calls
+}
+
+// GetTransactionGraph calls GetTransactionGraphFunc.
+func (mock *MetaMorphAPIClientMock) GetTransactionGraph(ctx context.Context, in *metamorph_api.TransactionGraphRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionGraph, error) {
+	if mock.GetTransactionGraphFunc == nil {
+		panic("MetaMorphAPIClientMock.GetTransactionGraphFunc: method is nil but MetaMorphAPIClient.GetTransactionGraph was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionGraphRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetTransactionGraph.Lock()
+	mock.calls.GetTransactionGraph = append(mock.calls.GetTransactionGraph, callInfo)
+	mock.lockGetTransactionGraph.Unlock()
+	return mock.GetTransactionGraphFunc(ctx, in, opts...)
+}
+
+// GetTransactionGraphCalls gets all the calls that were made to GetTransactionGraph.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetTransactionGraphCalls())
+func (mock *MetaMorphAPIClientMock) GetTransactionGraphCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionGraphRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionGraphRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetTransactionGraph.RLock()
+	calls = mock.calls.GetTransactionGraph
+	mock.lockGetTransactionGraph.RUnlock()
+	return calls
+}
+
+// GetTransactionStatus calls GetTransactionStatusFunc.
+func (mock *MetaMorphAPIClientMock) GetTransactionStatus(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+	if mock.GetTransactionStatusFunc == nil {
+		panic("MetaMorphAPIClientMock.GetTransactionStatusFunc: method is nil but MetaMorphAPIClient.GetTransactionStatus was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetTransactionStatus.Lock()
+	mock.calls.GetTransactionStatus = append(mock.calls.GetTransactionStatus, callInfo)
+	mock.lockGetTransactionStatus.Unlock()
 	return mock.GetTransactionStatusFunc(ctx, in, opts...)
 }
 
-// GetTransactionStatusCalls gets all the calls that were made to GetTransactionStatus.
+// GetTransactionStatusCalls gets all the calls that were made to GetTransactionStatus.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetTransactionStatusCalls())
+func (mock *MetaMorphAPIClientMock) GetTransactionStatusCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetTransactionStatus.RLock()
+	calls = mock.calls.GetTransactionStatus
+	mock.lockGetTransactionStatus.RUnlock()
+	return calls
+}
+
+// GetTransactionStatuses calls GetTransactionStatusesFunc.
+func (mock *MetaMorphAPIClientMock) GetTransactionStatuses(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
+	if mock.GetTransactionStatusesFunc == nil {
+		panic("MetaMorphAPIClientMock.GetTransactionStatusesFunc: method is nil but MetaMorphAPIClient.GetTransactionStatuses was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionsStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetTransactionStatuses.Lock()
+	mock.calls.GetTransactionStatuses = append(mock.calls.GetTransactionStatuses, callInfo)
+	mock.lockGetTransactionStatuses.Unlock()
+	return mock.GetTransactionStatusesFunc(ctx, in, opts...)
+}
+
+// GetTransactionStatusesCalls gets all the calls that were made to GetTransactionStatuses.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetTransactionStatusesCalls())
+func (mock *MetaMorphAPIClientMock) GetTransactionStatusesCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionsStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionsStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetTransactionStatuses.RLock()
+	calls = mock.calls.GetTransactionStatuses
+	mock.lockGetTransactionStatuses.RUnlock()
+	return calls
+}
+
+// GetTransactions calls GetTransactionsFunc.
+func (mock *MetaMorphAPIClientMock) GetTransactions(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transactions, error) {
+	if mock.GetTransactionsFunc == nil {
+		panic("MetaMorphAPIClientMock.GetTransactionsFunc: method is nil but MetaMorphAPIClient.GetTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionsStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetTransactions.Lock()
+	mock.calls.GetTransactions = append(mock.calls.GetTransactions, callInfo)
+	mock.lockGetTransactions.Unlock()
+	return mock.GetTransactionsFunc(ctx, in, opts...)
+}
+
+// GetTransactionsCalls gets all the calls that were made to GetTransactions.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetTransactionsCalls())
+func (mock *MetaMorphAPIClientMock) GetTransactionsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionsStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionsStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetTransactions.RLock()
+	calls = mock.calls.GetTransactions
+	mock.lockGetTransactions.RUnlock()
+	return calls
+}
+
+// GetUnverifiedTransactions calls GetUnverifiedTransactionsFunc.
+func (mock *MetaMorphAPIClientMock) GetUnverifiedTransactions(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.UnverifiedTransactions, error) {
+	if mock.GetUnverifiedTransactionsFunc == nil {
+		panic("MetaMorphAPIClientMock.GetUnverifiedTransactionsFunc: method is nil but MetaMorphAPIClient.GetUnverifiedTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionsStatusRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockGetUnverifiedTransactions.Lock()
+	mock.calls.GetUnverifiedTransactions = append(mock.calls.GetUnverifiedTransactions, callInfo)
+	mock.lockGetUnverifiedTransactions.Unlock()
+	return mock.GetUnverifiedTransactionsFunc(ctx, in, opts...)
+}
+
+// GetUnverifiedTransactionsCalls gets all the calls that were made to GetUnverifiedTransactions.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.GetUnverifiedTransactionsCalls())
+func (mock *MetaMorphAPIClientMock) GetUnverifiedTransactionsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.TransactionsStatusRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.TransactionsStatusRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockGetUnverifiedTransactions.RLock()
+	calls = mock.calls.GetUnverifiedTransactions
+	mock.lockGetUnverifiedTransactions.RUnlock()
+	return calls
+}
+
+// Health calls HealthFunc.
+func (mock *MetaMorphAPIClientMock) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.HealthResponse, error) {
+	if mock.HealthFunc == nil {
+		panic("MetaMorphAPIClientMock.HealthFunc: method is nil but MetaMorphAPIClient.Health was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockHealth.Lock()
+	mock.calls.Health = append(mock.calls.Health, callInfo)
+	mock.lockHealth.Unlock()
+	return mock.HealthFunc(ctx, in, opts...)
+}
+
+// HealthCalls gets all the calls that were made to Health.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.HealthCalls())
+func (mock *MetaMorphAPIClientMock) HealthCalls() []struct {
+	Ctx  context.Context
+	In   *emptypb.Empty
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}
+	mock.lockHealth.RLock()
+	calls = mock.calls.Health
+	mock.lockHealth.RUnlock()
+	return calls
+}
+
+// ListErasures calls ListErasuresFunc.
+func (mock *MetaMorphAPIClientMock) ListErasures(ctx context.Context, in *metamorph_api.ListErasuresRequest, opts ...grpc.CallOption) (*metamorph_api.Erasures, error) {
+	if mock.ListErasuresFunc == nil {
+		panic("MetaMorphAPIClientMock.ListErasuresFunc: method is nil but MetaMorphAPIClient.ListErasures was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.ListErasuresRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockListErasures.Lock()
+	mock.calls.ListErasures = append(mock.calls.ListErasures, callInfo)
+	mock.lockListErasures.Unlock()
+	return mock.ListErasuresFunc(ctx, in, opts...)
+}
+
+// ListErasuresCalls gets all the calls that were made to ListErasures.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.ListErasuresCalls())
+func (mock *MetaMorphAPIClientMock) ListErasuresCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.ListErasuresRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.ListErasuresRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockListErasures.RLock()
+	calls = mock.calls.ListErasures
+	mock.lockListErasures.RUnlock()
+	return calls
+}
+
+// ListJobs calls ListJobsFunc.
+func (mock *MetaMorphAPIClientMock) ListJobs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.Jobs, error) {
+	if mock.ListJobsFunc == nil {
+		panic("MetaMorphAPIClientMock.ListJobsFunc: method is nil but MetaMorphAPIClient.ListJobs was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockListJobs.Lock()
+	mock.calls.ListJobs = append(mock.calls.ListJobs, callInfo)
+	mock.lockListJobs.Unlock()
+	return mock.ListJobsFunc(ctx, in, opts...)
+}
+
+// ListJobsCalls gets all the calls that were made to ListJobs.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.ListJobsCalls())
+func (mock *MetaMorphAPIClientMock) ListJobsCalls() []struct {
+	Ctx  context.Context
+	In   *emptypb.Empty
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}
+	mock.lockListJobs.RLock()
+	calls = mock.calls.ListJobs
+	mock.lockListJobs.RUnlock()
+	return calls
+}
+
+// ListPeers calls ListPeersFunc.
+func (mock *MetaMorphAPIClientMock) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+	if mock.ListPeersFunc == nil {
+		panic("MetaMorphAPIClientMock.ListPeersFunc: method is nil but MetaMorphAPIClient.ListPeers was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockListPeers.Lock()
+	mock.calls.ListPeers = append(mock.calls.ListPeers, callInfo)
+	mock.lockListPeers.Unlock()
+	return mock.ListPeersFunc(ctx, in, opts...)
+}
+
+// ListPeersCalls gets all the calls that were made to ListPeers.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.ListPeersCalls())
+func (mock *MetaMorphAPIClientMock) ListPeersCalls() []struct {
+	Ctx  context.Context
+	In   *emptypb.Empty
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *emptypb.Empty
+		Opts []grpc.CallOption
+	}
+	mock.lockListPeers.RLock()
+	calls = mock.calls.ListPeers
+	mock.lockListPeers.RUnlock()
+	return calls
+}
+
+// PauseJob calls PauseJobFunc.
+func (mock *MetaMorphAPIClientMock) PauseJob(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error) {
+	if mock.PauseJobFunc == nil {
+		panic("MetaMorphAPIClientMock.PauseJobFunc: method is nil but MetaMorphAPIClient.PauseJob was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.JobRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockPauseJob.Lock()
+	mock.calls.PauseJob = append(mock.calls.PauseJob, callInfo)
+	mock.lockPauseJob.Unlock()
+	return mock.PauseJobFunc(ctx, in, opts...)
+}
+
+// PauseJobCalls gets all the calls that were made to PauseJob.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.GetTransactionStatusCalls())
-func (mock *MetaMorphAPIClientMock) GetTransactionStatusCalls() []struct {
+//	len(mockedMetaMorphAPIClient.PauseJobCalls())
+func (mock *MetaMorphAPIClientMock) PauseJobCalls() []struct {
 	Ctx  context.Context
-	In   *metamorph_api.TransactionStatusRequest
+	In   *metamorph_api.JobRequest
 	Opts []grpc.CallOption
 } {
 	var calls []struct {
 		Ctx  context.Context
-		In   *metamorph_api.TransactionStatusRequest
+		In   *metamorph_api.JobRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockGetTransactionStatus.RLock()
-	calls = mock.calls.GetTransactionStatus
-	mock.lockGetTransactionStatus.RUnlock()
+	mock.lockPauseJob.RLock()
+	calls = mock.calls.PauseJob
+	mock.lockPauseJob.RUnlock()
 	return calls
 }
 
-// GetTransactionStatuses calls GetTransactionStatusesFunc.
-func (mock *MetaMorphAPIClientMock) GetTransactionStatuses(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
-	if mock.GetTransactionStatusesFunc == nil {
-		panic("MetaMorphAPIClientMock.GetTransactionStatusesFunc: method is nil but MetaMorphAPIClient.GetTransactionStatuses was just called")
+// PostBlockTemplate calls PostBlockTemplateFunc.
+func (mock *MetaMorphAPIClientMock) PostBlockTemplate(ctx context.Context, in *metamorph_api.PostBlockTemplateRequest, opts ...grpc.CallOption) (*metamorph_api.PostBlockTemplateResponse, error) {
+	if mock.PostBlockTemplateFunc == nil {
+		panic("MetaMorphAPIClientMock.PostBlockTemplateFunc: method is nil but MetaMorphAPIClient.PostBlockTemplate was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
-		In   *metamorph_api.TransactionsStatusRequest
+		In   *metamorph_api.PostBlockTemplateRequest
 		Opts []grpc.CallOption
 	}{
 		Ctx:  ctx,
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockGetTransactionStatuses.Lock()
-	mock.calls.GetTransactionStatuses = append(mock.calls.GetTransactionStatuses, callInfo)
-	mock.lockGetTransactionStatuses.Unlock()
-	return mock.GetTransactionStatusesFunc(ctx, in, opts...)
+	mock.lockPostBlockTemplate.Lock()
+	mock.calls.PostBlockTemplate = append(mock.calls.PostBlockTemplate, callInfo)
+	mock.lockPostBlockTemplate.Unlock()
+	return mock.PostBlockTemplateFunc(ctx, in, opts...)
 }
 
-// GetTransactionStatusesCalls gets all the calls that were made to GetTransactionStatuses.
+// PostBlockTemplateCalls gets all the calls that were made to PostBlockTemplate.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.GetTransactionStatusesCalls())
-func (mock *MetaMorphAPIClientMock) GetTransactionStatusesCalls() []struct {
+//	len(mockedMetaMorphAPIClient.PostBlockTemplateCalls())
+func (mock *MetaMorphAPIClientMock) PostBlockTemplateCalls() []struct {
 	Ctx  context.Context
-	In   *metamorph_api.TransactionsStatusRequest
+	In   *metamorph_api.PostBlockTemplateRequest
 	Opts []grpc.CallOption
 } {
 	var calls []struct {
 		Ctx  context.Context
-		In   *metamorph_api.TransactionsStatusRequest
+		In   *metamorph_api.PostBlockTemplateRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockGetTransactionStatuses.RLock()
-	calls = mock.calls.GetTransactionStatuses
-	mock.lockGetTransactionStatuses.RUnlock()
+	mock.lockPostBlockTemplate.RLock()
+	calls = mock.calls.PostBlockTemplate
+	mock.lockPostBlockTemplate.RUnlock()
 	return calls
 }
 
-// GetTransactions calls GetTransactionsFunc.
-func (mock *MetaMorphAPIClientMock) GetTransactions(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.Transactions, error) {
-	if mock.GetTransactionsFunc == nil {
-		panic("MetaMorphAPIClientMock.GetTransactionsFunc: method is nil but MetaMorphAPIClient.GetTransactions was just called")
+// PostTransactions calls PostTransactionsFunc.
+func (mock *MetaMorphAPIClientMock) PostTransactions(ctx context.Context, in *metamorph_api.PostTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
+	if mock.PostTransactionsFunc == nil {
+		panic("MetaMorphAPIClientMock.PostTransactionsFunc: method is nil but MetaMorphAPIClient.PostTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.PostTransactionsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockPostTransactions.Lock()
+	mock.calls.PostTransactions = append(mock.calls.PostTransactions, callInfo)
+	mock.lockPostTransactions.Unlock()
+	return mock.PostTransactionsFunc(ctx, in, opts...)
+}
+
+// PostTransactionsCalls gets all the calls that were made to PostTransactions.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.PostTransactionsCalls())
+func (mock *MetaMorphAPIClientMock) PostTransactionsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.PostTransactionsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.PostTransactionsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockPostTransactions.RLock()
+	calls = mock.calls.PostTransactions
+	mock.lockPostTransactions.RUnlock()
+	return calls
+}
+
+// RejectTransactions calls RejectTransactionsFunc.
+func (mock *MetaMorphAPIClientMock) RejectTransactions(ctx context.Context, in *metamorph_api.RejectTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
+	if mock.RejectTransactionsFunc == nil {
+		panic("MetaMorphAPIClientMock.RejectTransactionsFunc: method is nil but MetaMorphAPIClient.RejectTransactions was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.RejectTransactionsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockRejectTransactions.Lock()
+	mock.calls.RejectTransactions = append(mock.calls.RejectTransactions, callInfo)
+	mock.lockRejectTransactions.Unlock()
+	return mock.RejectTransactionsFunc(ctx, in, opts...)
+}
+
+// RejectTransactionsCalls gets all the calls that were made to RejectTransactions.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.RejectTransactionsCalls())
+func (mock *MetaMorphAPIClientMock) RejectTransactionsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.RejectTransactionsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.RejectTransactionsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockRejectTransactions.RLock()
+	calls = mock.calls.RejectTransactions
+	mock.lockRejectTransactions.RUnlock()
+	return calls
+}
+
+// ReplayCallbacks calls ReplayCallbacksFunc.
+func (mock *MetaMorphAPIClientMock) ReplayCallbacks(ctx context.Context, in *metamorph_api.TransactionsStatusRequest, opts ...grpc.CallOption) (*metamorph_api.ReplayCallbacksResponse, error) {
+	if mock.ReplayCallbacksFunc == nil {
+		panic("MetaMorphAPIClientMock.ReplayCallbacksFunc: method is nil but MetaMorphAPIClient.ReplayCallbacks was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
@@ -335,17 +1591,17 @@ func (mock *MetaMorphAPIClientMock) GetTransactions(ctx context.Context, in *met
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockGetTransactions.Lock()
-	mock.calls.GetTransactions = append(mock.calls.GetTransactions, callInfo)
-	mock.lockGetTransactions.Unlock()
-	return mock.GetTransactionsFunc(ctx, in, opts...)
+	mock.lockReplayCallbacks.Lock()
+	mock.calls.ReplayCallbacks = append(mock.calls.ReplayCallbacks, callInfo)
+	mock.lockReplayCallbacks.Unlock()
+	return mock.ReplayCallbacksFunc(ctx, in, opts...)
 }
 
-// GetTransactionsCalls gets all the calls that were made to GetTransactions.
+// ReplayCallbacksCalls gets all the calls that were made to ReplayCallbacks.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.GetTransactionsCalls())
-func (mock *MetaMorphAPIClientMock) GetTransactionsCalls() []struct {
+//	len(mockedMetaMorphAPIClient.ReplayCallbacksCalls())
+func (mock *MetaMorphAPIClientMock) ReplayCallbacksCalls() []struct {
 	Ctx  context.Context
 	In   *metamorph_api.TransactionsStatusRequest
 	Opts []grpc.CallOption
@@ -355,89 +1611,289 @@ func (mock *MetaMorphAPIClientMock) GetTransactionsCalls() []struct {
 		In   *metamorph_api.TransactionsStatusRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockGetTransactions.RLock()
-	calls = mock.calls.GetTransactions
-	mock.lockGetTransactions.RUnlock()
+	mock.lockReplayCallbacks.RLock()
+	calls = mock.calls.ReplayCallbacks
+	mock.lockReplayCallbacks.RUnlock()
 	return calls
 }
 
-// Health calls HealthFunc.
-func (mock *MetaMorphAPIClientMock) Health(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*metamorph_api.HealthResponse, error) {
-	if mock.HealthFunc == nil {
-		panic("MetaMorphAPIClientMock.HealthFunc: method is nil but MetaMorphAPIClient.Health was just called")
+// ResetLogLevel calls ResetLogLevelFunc.
+func (mock *MetaMorphAPIClientMock) ResetLogLevel(ctx context.Context, in *metamorph_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.ResetLogLevelFunc == nil {
+		panic("MetaMorphAPIClientMock.ResetLogLevelFunc: method is nil but MetaMorphAPIClient.ResetLogLevel was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
-		In   *emptypb.Empty
+		In   *metamorph_api.LogLevelRequest
 		Opts []grpc.CallOption
 	}{
 		Ctx:  ctx,
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockHealth.Lock()
-	mock.calls.Health = append(mock.calls.Health, callInfo)
-	mock.lockHealth.Unlock()
-	return mock.HealthFunc(ctx, in, opts...)
+	mock.lockResetLogLevel.Lock()
+	mock.calls.ResetLogLevel = append(mock.calls.ResetLogLevel, callInfo)
+	mock.lockResetLogLevel.Unlock()
+	return mock.ResetLogLevelFunc(ctx, in, opts...)
 }
 
-// HealthCalls gets all the calls that were made to Health.
+// ResetLogLevelCalls gets all the calls that were made to ResetLogLevel.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.HealthCalls())
-func (mock *MetaMorphAPIClientMock) HealthCalls() []struct {
+//	len(mockedMetaMorphAPIClient.ResetLogLevelCalls())
+func (mock *MetaMorphAPIClientMock) ResetLogLevelCalls() []struct {
 	Ctx  context.Context
-	In   *emptypb.Empty
+	In   *metamorph_api.LogLevelRequest
 	Opts []grpc.CallOption
 } {
 	var calls []struct {
 		Ctx  context.Context
-		In   *emptypb.Empty
+		In   *metamorph_api.LogLevelRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockHealth.RLock()
-	calls = mock.calls.Health
-	mock.lockHealth.RUnlock()
+	mock.lockResetLogLevel.RLock()
+	calls = mock.calls.ResetLogLevel
+	mock.lockResetLogLevel.RUnlock()
 	return calls
 }
 
-// PostTransactions calls PostTransactionsFunc.
-func (mock *MetaMorphAPIClientMock) PostTransactions(ctx context.Context, in *metamorph_api.PostTransactionsRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatuses, error) {
-	if mock.PostTransactionsFunc == nil {
-		panic("MetaMorphAPIClientMock.PostTransactionsFunc: method is nil but MetaMorphAPIClient.PostTransactions was just called")
+// ResubmitTransaction calls ResubmitTransactionFunc.
+func (mock *MetaMorphAPIClientMock) ResubmitTransaction(ctx context.Context, in *metamorph_api.TransactionStatusRequest, opts ...grpc.CallOption) (*metamorph_api.TransactionStatus, error) {
+	if mock.ResubmitTransactionFunc == nil {
+		panic("MetaMorphAPIClientMock.ResubmitTransactionFunc: method is nil but MetaMorphAPIClient.ResubmitTransaction was just called")
 	}
 	callInfo := struct {
 		Ctx  context.Context
-		In   *metamorph_api.PostTransactionsRequest
+		In   *metamorph_api.TransactionStatusRequest
 		Opts []grpc.CallOption
 	}{
 		Ctx:  ctx,
 		In:   in,
 		Opts: opts,
 	}
-	mock.lockPostTransactions.Lock()
-	mock.calls.PostTransactions = append(mock.calls.PostTransactions, callInfo)
-	mock.lockPostTransactions.Unlock()
-	return mock.PostTransactionsFunc(ctx, in, opts...)
+	mock.lockResubmitTransaction.Lock()
+	mock.calls.ResubmitTransaction = append(mock.calls.ResubmitTransaction, callInfo)
+	mock.lockResubmitTransaction.Unlock()
+	return mock.ResubmitTransactionFunc(ctx, in, opts...)
 }
 
-// PostTransactionsCalls gets all the calls that were made to PostTransactions.
+// ResubmitTransactionCalls gets all the calls that were made to ResubmitTransaction.
 // Check the length with:
 //
-//	len(mockedMetaMorphAPIClient.PostTransactionsCalls())
-func (mock *MetaMorphAPIClientMock) PostTransactionsCalls() []struct {
+//	len(mockedMetaMorphAPIClient.ResubmitTransactionCalls())
+func (mock *MetaMorphAPIClientMock) ResubmitTransactionCalls() []struct {
 	Ctx  context.Context
-	In   *metamorph_api.PostTransactionsRequest
+	In   *metamorph_api.TransactionStatusRequest
 	Opts []grpc.CallOption
 } {
 	var calls []struct {
 		Ctx  context.Context
-		In   *metamorph_api.PostTransactionsRequest
+		In   *metamorph_api.TransactionStatusRequest
 		Opts []grpc.CallOption
 	}
-	mock.lockPostTransactions.RLock()
-	calls = mock.calls.PostTransactions
-	mock.lockPostTransactions.RUnlock()
+	mock.lockResubmitTransaction.RLock()
+	calls = mock.calls.ResubmitTransaction
+	mock.lockResubmitTransaction.RUnlock()
+	return calls
+}
+
+// ResumeJob calls ResumeJobFunc.
+func (mock *MetaMorphAPIClientMock) ResumeJob(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error) {
+	if mock.ResumeJobFunc == nil {
+		panic("MetaMorphAPIClientMock.ResumeJobFunc: method is nil but MetaMorphAPIClient.ResumeJob was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.JobRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockResumeJob.Lock()
+	mock.calls.ResumeJob = append(mock.calls.ResumeJob, callInfo)
+	mock.lockResumeJob.Unlock()
+	return mock.ResumeJobFunc(ctx, in, opts...)
+}
+
+// ResumeJobCalls gets all the calls that were made to ResumeJob.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.ResumeJobCalls())
+func (mock *MetaMorphAPIClientMock) ResumeJobCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.JobRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.JobRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockResumeJob.RLock()
+	calls = mock.calls.ResumeJob
+	mock.lockResumeJob.RUnlock()
+	return calls
+}
+
+// SetLogLevel calls SetLogLevelFunc.
+func (mock *MetaMorphAPIClientMock) SetLogLevel(ctx context.Context, in *metamorph_api.LogLevelRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	if mock.SetLogLevelFunc == nil {
+		panic("MetaMorphAPIClientMock.SetLogLevelFunc: method is nil but MetaMorphAPIClient.SetLogLevel was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockSetLogLevel.Lock()
+	mock.calls.SetLogLevel = append(mock.calls.SetLogLevel, callInfo)
+	mock.lockSetLogLevel.Unlock()
+	return mock.SetLogLevelFunc(ctx, in, opts...)
+}
+
+// SetLogLevelCalls gets all the calls that were made to SetLogLevel.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.SetLogLevelCalls())
+func (mock *MetaMorphAPIClientMock) SetLogLevelCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.LogLevelRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.LogLevelRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockSetLogLevel.RLock()
+	calls = mock.calls.SetLogLevel
+	mock.lockSetLogLevel.RUnlock()
+	return calls
+}
+
+// TriggerJob calls TriggerJobFunc.
+func (mock *MetaMorphAPIClientMock) TriggerJob(ctx context.Context, in *metamorph_api.JobRequest, opts ...grpc.CallOption) (*metamorph_api.Job, error) {
+	if mock.TriggerJobFunc == nil {
+		panic("MetaMorphAPIClientMock.TriggerJobFunc: method is nil but MetaMorphAPIClient.TriggerJob was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.JobRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockTriggerJob.Lock()
+	mock.calls.TriggerJob = append(mock.calls.TriggerJob, callInfo)
+	mock.lockTriggerJob.Unlock()
+	return mock.TriggerJobFunc(ctx, in, opts...)
+}
+
+// TriggerJobCalls gets all the calls that were made to TriggerJob.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.TriggerJobCalls())
+func (mock *MetaMorphAPIClientMock) TriggerJobCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.JobRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.JobRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockTriggerJob.RLock()
+	calls = mock.calls.TriggerJob
+	mock.lockTriggerJob.RUnlock()
+	return calls
+}
+
+// UnbanPeer calls UnbanPeerFunc.
+func (mock *MetaMorphAPIClientMock) UnbanPeer(ctx context.Context, in *metamorph_api.BanPeerRequest, opts ...grpc.CallOption) (*metamorph_api.PeerBans, error) {
+	if mock.UnbanPeerFunc == nil {
+		panic("MetaMorphAPIClientMock.UnbanPeerFunc: method is nil but MetaMorphAPIClient.UnbanPeer was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockUnbanPeer.Lock()
+	mock.calls.UnbanPeer = append(mock.calls.UnbanPeer, callInfo)
+	mock.lockUnbanPeer.Unlock()
+	return mock.UnbanPeerFunc(ctx, in, opts...)
+}
+
+// UnbanPeerCalls gets all the calls that were made to UnbanPeer.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.UnbanPeerCalls())
+func (mock *MetaMorphAPIClientMock) UnbanPeerCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.BanPeerRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.BanPeerRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockUnbanPeer.RLock()
+	calls = mock.calls.UnbanPeer
+	mock.lockUnbanPeer.RUnlock()
+	return calls
+}
+
+// UnlockRecords calls UnlockRecordsFunc.
+func (mock *MetaMorphAPIClientMock) UnlockRecords(ctx context.Context, in *metamorph_api.UnlockRecordsRequest, opts ...grpc.CallOption) (*metamorph_api.UnlockRecordsResponse, error) {
+	if mock.UnlockRecordsFunc == nil {
+		panic("MetaMorphAPIClientMock.UnlockRecordsFunc: method is nil but MetaMorphAPIClient.UnlockRecords was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		In   *metamorph_api.UnlockRecordsRequest
+		Opts []grpc.CallOption
+	}{
+		Ctx:  ctx,
+		In:   in,
+		Opts: opts,
+	}
+	mock.lockUnlockRecords.Lock()
+	mock.calls.UnlockRecords = append(mock.calls.UnlockRecords, callInfo)
+	mock.lockUnlockRecords.Unlock()
+	return mock.UnlockRecordsFunc(ctx, in, opts...)
+}
+
+// UnlockRecordsCalls gets all the calls that were made to UnlockRecords.
+// Check the length with:
+//
+//	len(mockedMetaMorphAPIClient.UnlockRecordsCalls())
+func (mock *MetaMorphAPIClientMock) UnlockRecordsCalls() []struct {
+	Ctx  context.Context
+	In   *metamorph_api.UnlockRecordsRequest
+	Opts []grpc.CallOption
+} {
+	var calls []struct {
+		Ctx  context.Context
+		In   *metamorph_api.UnlockRecordsRequest
+		Opts []grpc.CallOption
+	}
+	mock.lockUnlockRecords.RLock()
+	calls = mock.calls.UnlockRecords
+	mock.lockUnlockRecords.RUnlock()
 	return calls
 }
 
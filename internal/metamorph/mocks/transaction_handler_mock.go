@@ -20,6 +20,15 @@ var _ metamorph.TransactionHandler = &TransactionHandlerMock{}
 //
 //		// make and configure a mocked metamorph.TransactionHandler
 //		mockedTransactionHandler := &TransactionHandlerMock{
+//			CancelScheduledBroadcastFunc: func(ctx context.Context, txID string) error {
+//				panic("mock out the CancelScheduledBroadcast method")
+//			},
+//			GetOutpointSpenderFunc: func(ctx context.Context, txID string, vout uint32) (*metamorph.OutpointSpender, error) {
+//				panic("mock out the GetOutpointSpender method")
+//			},
+//			GetTransactionGraphFunc: func(ctx context.Context, txID string, maxNodes uint32) (*metamorph.TransactionGraph, error) {
+//				panic("mock out the GetTransactionGraph method")
+//			},
 //			GetTransactionStatusFunc: func(ctx context.Context, txID string) (*metamorph.TransactionStatus, error) {
 //				panic("mock out the GetTransactionStatus method")
 //			},
@@ -32,6 +41,9 @@ var _ metamorph.TransactionHandler = &TransactionHandlerMock{}
 //			HealthFunc: func(ctx context.Context) error {
 //				panic("mock out the Health method")
 //			},
+//			ResubmitTransactionFunc: func(ctx context.Context, txID string) (*metamorph.TransactionStatus, error) {
+//				panic("mock out the ResubmitTransaction method")
+//			},
 //			SubmitTransactionsFunc: func(ctx context.Context, tx sdkTx.Transactions, options *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
 //				panic("mock out the SubmitTransactions method")
 //			},
@@ -42,6 +54,15 @@ var _ metamorph.TransactionHandler = &TransactionHandlerMock{}
 //
 //	}
 type TransactionHandlerMock struct {
+	// CancelScheduledBroadcastFunc mocks the CancelScheduledBroadcast method.
+	CancelScheduledBroadcastFunc func(ctx context.Context, txID string) error
+
+	// GetOutpointSpenderFunc mocks the GetOutpointSpender method.
+	GetOutpointSpenderFunc func(ctx context.Context, txID string, vout uint32) (*metamorph.OutpointSpender, error)
+
+	// GetTransactionGraphFunc mocks the GetTransactionGraph method.
+	GetTransactionGraphFunc func(ctx context.Context, txID string, maxNodes uint32) (*metamorph.TransactionGraph, error)
+
 	// GetTransactionStatusFunc mocks the GetTransactionStatus method.
 	GetTransactionStatusFunc func(ctx context.Context, txID string) (*metamorph.TransactionStatus, error)
 
@@ -54,11 +75,39 @@ type TransactionHandlerMock struct {
 	// HealthFunc mocks the Health method.
 	HealthFunc func(ctx context.Context) error
 
+	// ResubmitTransactionFunc mocks the ResubmitTransaction method.
+	ResubmitTransactionFunc func(ctx context.Context, txID string) (*metamorph.TransactionStatus, error)
+
 	// SubmitTransactionsFunc mocks the SubmitTransactions method.
 	SubmitTransactionsFunc func(ctx context.Context, tx sdkTx.Transactions, options *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error)
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// CancelScheduledBroadcast holds details about calls to the CancelScheduledBroadcast method.
+		CancelScheduledBroadcast []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxID is the txID argument value.
+			TxID string
+		}
+		// GetOutpointSpender holds details about calls to the GetOutpointSpender method.
+		GetOutpointSpender []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxID is the txID argument value.
+			TxID string
+			// Vout is the vout argument value.
+			Vout uint32
+		}
+		// GetTransactionGraph holds details about calls to the GetTransactionGraph method.
+		GetTransactionGraph []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxID is the txID argument value.
+			TxID string
+			// MaxNodes is the maxNodes argument value.
+			MaxNodes uint32
+		}
 		// GetTransactionStatus holds details about calls to the GetTransactionStatus method.
 		GetTransactionStatus []struct {
 			// Ctx is the ctx argument value.
@@ -85,6 +134,13 @@ type TransactionHandlerMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// ResubmitTransaction holds details about calls to the ResubmitTransaction method.
+		ResubmitTransaction []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// TxID is the txID argument value.
+			TxID string
+		}
 		// SubmitTransactions holds details about calls to the SubmitTransactions method.
 		SubmitTransactions []struct {
 			// Ctx is the ctx argument value.
@@ -95,11 +151,131 @@ type TransactionHandlerMock struct {
 			Options *metamorph.TransactionOptions
 		}
 	}
-	lockGetTransactionStatus   sync.RWMutex
-	lockGetTransactionStatuses sync.RWMutex
-	lockGetTransactions        sync.RWMutex
-	lockHealth                 sync.RWMutex
-	lockSubmitTransactions     sync.RWMutex
+	lockCancelScheduledBroadcast sync.RWMutex
+	lockGetOutpointSpender       sync.RWMutex
+	lockGetTransactionGraph      sync.RWMutex
+	lockGetTransactionStatus     sync.RWMutex
+	lockGetTransactionStatuses   sync.RWMutex
+	lockGetTransactions          sync.RWMutex
+	lockHealth                   sync.RWMutex
+	lockResubmitTransaction      sync.RWMutex
+	lockSubmitTransactions       sync.RWMutex
+}
+
+// CancelScheduledBroadcast calls CancelScheduledBroadcastFunc.
+func (mock *TransactionHandlerMock) CancelScheduledBroadcast(ctx context.Context, txID string) error {
+	if mock.CancelScheduledBroadcastFunc == nil {
+		panic("TransactionHandlerMock.CancelScheduledBroadcastFunc: method is nil but TransactionHandler.CancelScheduledBroadcast was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		TxID string
+	}{
+		Ctx:  ctx,
+		TxID: txID,
+	}
+	mock.lockCancelScheduledBroadcast.Lock()
+	mock.calls.CancelScheduledBroadcast = append(mock.calls.CancelScheduledBroadcast, callInfo)
+	mock.lockCancelScheduledBroadcast.Unlock()
+	return mock.CancelScheduledBroadcastFunc(ctx, txID)
+}
+
+// CancelScheduledBroadcastCalls gets all the calls that were made to CancelScheduledBroadcast.
+// Check the length with:
+//
+//	len(mockedTransactionHandler.CancelScheduledBroadcastCalls())
+func (mock *TransactionHandlerMock) CancelScheduledBroadcastCalls() []struct {
+	Ctx  context.Context
+	TxID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		TxID string
+	}
+	mock.lockCancelScheduledBroadcast.RLock()
+	calls = mock.calls.CancelScheduledBroadcast
+	mock.lockCancelScheduledBroadcast.RUnlock()
+	return calls
+}
+
+// GetOutpointSpender calls GetOutpointSpenderFunc.
+func (mock *TransactionHandlerMock) GetOutpointSpender(ctx context.Context, txID string, vout uint32) (*metamorph.OutpointSpender, error) {
+	if mock.GetOutpointSpenderFunc == nil {
+		panic("TransactionHandlerMock.GetOutpointSpenderFunc: method is nil but TransactionHandler.GetOutpointSpender was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		TxID string
+		Vout uint32
+	}{
+		Ctx:  ctx,
+		TxID: txID,
+		Vout: vout,
+	}
+	mock.lockGetOutpointSpender.Lock()
+	mock.calls.GetOutpointSpender = append(mock.calls.GetOutpointSpender, callInfo)
+	mock.lockGetOutpointSpender.Unlock()
+	return mock.GetOutpointSpenderFunc(ctx, txID, vout)
+}
+
+// GetOutpointSpenderCalls gets all the calls that were made to GetOutpointSpender.
+// Check the length with:
+//
+//	len(mockedTransactionHandler.GetOutpointSpenderCalls())
+func (mock *TransactionHandlerMock) GetOutpointSpenderCalls() []struct {
+	Ctx  context.Context
+	TxID string
+	Vout uint32
+} {
+	var calls []struct {
+		Ctx  context.Context
+		TxID string
+		Vout uint32
+	}
+	mock.lockGetOutpointSpender.RLock()
+	calls = mock.calls.GetOutpointSpender
+	mock.lockGetOutpointSpender.RUnlock()
+	return calls
+}
+
+// GetTransactionGraph calls GetTransactionGraphFunc.
+func (mock *TransactionHandlerMock) GetTransactionGraph(ctx context.Context, txID string, maxNodes uint32) (*metamorph.TransactionGraph, error) {
+	if mock.GetTransactionGraphFunc == nil {
+		panic("TransactionHandlerMock.GetTransactionGraphFunc: method is nil but TransactionHandler.GetTransactionGraph was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		TxID     string
+		MaxNodes uint32
+	}{
+		Ctx:      ctx,
+		TxID:     txID,
+		MaxNodes: maxNodes,
+	}
+	mock.lockGetTransactionGraph.Lock()
+	mock.calls.GetTransactionGraph = append(mock.calls.GetTransactionGraph, callInfo)
+	mock.lockGetTransactionGraph.Unlock()
+	return mock.GetTransactionGraphFunc(ctx, txID, maxNodes)
+}
+
+// GetTransactionGraphCalls gets all the calls that were made to GetTransactionGraph.
+// Check the length with:
+//
+//	len(mockedTransactionHandler.GetTransactionGraphCalls())
+func (mock *TransactionHandlerMock) GetTransactionGraphCalls() []struct {
+	Ctx      context.Context
+	TxID     string
+	MaxNodes uint32
+} {
+	var calls []struct {
+		Ctx      context.Context
+		TxID     string
+		MaxNodes uint32
+	}
+	mock.lockGetTransactionGraph.RLock()
+	calls = mock.calls.GetTransactionGraph
+	mock.lockGetTransactionGraph.RUnlock()
+	return calls
 }
 
 // GetTransactionStatus calls GetTransactionStatusFunc.
@@ -242,6 +418,42 @@ func (mock *TransactionHandlerMock) HealthCalls() []struct {
 	return calls
 }
 
+// ResubmitTransaction calls ResubmitTransactionFunc.
+func (mock *TransactionHandlerMock) ResubmitTransaction(ctx context.Context, txID string) (*metamorph.TransactionStatus, error) {
+	if mock.ResubmitTransactionFunc == nil {
+		panic("TransactionHandlerMock.ResubmitTransactionFunc: method is nil but TransactionHandler.ResubmitTransaction was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		TxID string
+	}{
+		Ctx:  ctx,
+		TxID: txID,
+	}
+	mock.lockResubmitTransaction.Lock()
+	mock.calls.ResubmitTransaction = append(mock.calls.ResubmitTransaction, callInfo)
+	mock.lockResubmitTransaction.Unlock()
+	return mock.ResubmitTransactionFunc(ctx, txID)
+}
+
+// ResubmitTransactionCalls gets all the calls that were made to ResubmitTransaction.
+// Check the length with:
+//
+//	len(mockedTransactionHandler.ResubmitTransactionCalls())
+func (mock *TransactionHandlerMock) ResubmitTransactionCalls() []struct {
+	Ctx  context.Context
+	TxID string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		TxID string
+	}
+	mock.lockResubmitTransaction.RLock()
+	calls = mock.calls.ResubmitTransaction
+	mock.lockResubmitTransaction.RUnlock()
+	return calls
+}
+
 // SubmitTransactions calls SubmitTransactionsFunc.
 func (mock *TransactionHandlerMock) SubmitTransactions(ctx context.Context, tx sdkTx.Transactions, options *metamorph.TransactionOptions) ([]*metamorph.TransactionStatus, error) {
 	if mock.SubmitTransactionsFunc == nil {
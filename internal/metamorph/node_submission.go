@@ -0,0 +1,158 @@
+package metamorph
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
+)
+
+const (
+	nodeSubmitIntervalDefault = 30 * time.Second
+	nodeSubmitTimeoutDefault  = 2 * time.Minute
+
+	NodeSubmissionsAccepted = "accepted"
+	NodeSubmissionsRejected = "rejected"
+)
+
+// alreadyKnownReasons are the reasons with which the node rejects transactions it already has in its mempool or chain,
+// the submission of such a transaction is recorded as accepted.
+var alreadyKnownReasons = []string{
+	"txn-already-known",
+	"txn-already-in-mempool",
+	"Transaction already in the mempool",
+	"transaction already in block chain",
+}
+
+// NodeSubmission is the submission of a transaction to the node after no peer requested it. RejectReason is the
+// reason given by the node if it rejected the transaction.
+type NodeSubmission struct {
+	SubmittedAt  time.Time
+	Accepted     bool
+	RejectReason string
+}
+
+// FallbackNode is the node to which transactions are submitted with sendrawtransaction if no peer requested them
+// after their announcement.
+type FallbackNode interface {
+	SendRawTransaction(hexString string) (string, error)
+}
+
+// SubmitUnrequestedToNode submits the transactions which were announced to the network, but which no peer requested
+// within the timeout, to the node with sendrawtransaction and records the response of the node on the transactions.
+// Transactions accepted by the node are updated to ACCEPTED_BY_NETWORK. Each transaction is submitted to the node
+// once, unless the node cannot be reached, rejected transactions are still re-announced to the peers as any other
+// unseen transaction.
+func SubmitUnrequestedToNode(ctx context.Context, p *Processor) []attribute.KeyValue {
+	now := p.now()
+
+	txs, err := p.store.GetUnrequested(ctx, now.Add(-1*p.rebroadcastExpiration), now.Add(-1*p.nodeSubmitTimeout), loadLimit)
+	if err != nil {
+		p.logger.Error("Failed to get unrequested transactions", slog.String("err", err.Error()))
+		return nil
+	}
+
+	var accepted, rejected int
+	var submission store.NodeSubmission
+	for _, tx := range txs {
+		submission, err = p.submitToNode(tx)
+		if err != nil {
+			// the transactions are submitted again on the next run
+			p.logger.Error("Failed to submit unrequested transactions to node", slog.String("err", err.Error()))
+			break
+		}
+
+		err = p.store.SetNodeSubmission(ctx, tx.Hash, submission)
+		if err != nil {
+			p.logger.Error("Failed to record node submission", slog.String("hash", tx.Hash.String()), slog.String("err", err.Error()))
+		}
+
+		if !submission.Accepted {
+			rejected++
+			continue
+		}
+
+		accepted++
+		p.storageStatusUpdateCh <- store.UpdateStatus{
+			Hash:      *tx.Hash,
+			Status:    metamorph_api.Status_ACCEPTED_BY_NETWORK,
+			Timestamp: submission.SubmittedAt,
+		}
+	}
+
+	p.stats.nodeSubmissions.WithLabelValues(NodeSubmissionsAccepted).Add(float64(accepted))
+	p.stats.nodeSubmissions.WithLabelValues(NodeSubmissionsRejected).Add(float64(rejected))
+
+	if len(txs) > 0 {
+		p.logger.Info("Submitted unrequested transactions to node", slog.Int(NodeSubmissionsAccepted, accepted), slog.Int(NodeSubmissionsRejected, rejected))
+	}
+
+	return []attribute.KeyValue{attribute.Int(NodeSubmissionsAccepted, accepted), attribute.Int(NodeSubmissionsRejected, rejected)}
+}
+
+// submitToNode submits the transaction to the node and returns the response of the node. An error is returned if
+// the node cannot be reached.
+func (p *Processor) submitToNode(tx *store.Data) (store.NodeSubmission, error) {
+	submission := store.NodeSubmission{SubmittedAt: p.now(), Accepted: true}
+
+	_, err := p.fallbackNode.SendRawTransaction(hex.EncodeToString(tx.RawTx))
+	if err == nil {
+		return submission, nil
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return store.NodeSubmission{}, err
+	}
+
+	reason := node_reject.FromRPCError(err)
+	if reason.IsAlreadyKnown() {
+		return submission, nil
+	}
+	for _, known := range alreadyKnownReasons {
+		if strings.Contains(reason.Text, known) {
+			return submission, nil
+		}
+	}
+
+	p.logger.Warn("Node rejected unrequested transaction", slog.String("hash", tx.Hash.String()), slog.String("reason", reason.Text))
+
+	submission.Accepted = false
+	submission.RejectReason = reason.Error()
+
+	return submission, nil
+}
+
+func toNodeSubmissionProto(submission *store.NodeSubmission) *metamorph_api.NodeSubmission {
+	if submission == nil {
+		return nil
+	}
+
+	return &metamorph_api.NodeSubmission{
+		SubmittedAt:  timestamppb.New(submission.SubmittedAt),
+		Accepted:     submission.Accepted,
+		RejectReason: submission.RejectReason,
+	}
+}
+
+func nodeSubmissionFromProto(submission *metamorph_api.NodeSubmission) *NodeSubmission {
+	if submission == nil {
+		return nil
+	}
+
+	return &NodeSubmission{
+		SubmittedAt:  timeOrZero(submission.GetSubmittedAt()),
+		Accepted:     submission.GetAccepted(),
+		RejectReason: submission.GetRejectReason(),
+	}
+}
@@ -0,0 +1,130 @@
+package metamorph_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestSubmitUnrequestedToNode(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name    string
+		sendErr error
+
+		expectedAttributes  []attribute.KeyValue
+		expectedSubmissions []store.NodeSubmission
+	}{
+		{
+			name: "accepted",
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.NodeSubmissionsAccepted, 2),
+				attribute.Int(metamorph.NodeSubmissionsRejected, 0),
+			},
+			expectedSubmissions: []store.NodeSubmission{
+				{SubmittedAt: now, Accepted: true},
+				{SubmittedAt: now, Accepted: true},
+			},
+		},
+		{
+			name:    "already known",
+			sendErr: errors.New("-27: 257: txn-already-known"),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.NodeSubmissionsAccepted, 2),
+				attribute.Int(metamorph.NodeSubmissionsRejected, 0),
+			},
+			expectedSubmissions: []store.NodeSubmission{
+				{SubmittedAt: now, Accepted: true},
+				{SubmittedAt: now, Accepted: true},
+			},
+		},
+		{
+			name:    "rejected",
+			sendErr: errors.New("-26: 258: txn-mempool-conflict"),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.NodeSubmissionsAccepted, 0),
+				attribute.Int(metamorph.NodeSubmissionsRejected, 2),
+			},
+			expectedSubmissions: []store.NodeSubmission{
+				{SubmittedAt: now, RejectReason: "arc error 466: rejected by node with code 258 (REJECT_CONFLICT): txn-mempool-conflict"},
+				{SubmittedAt: now, RejectReason: "arc error 466: rejected by node with code 258 (REJECT_CONFLICT): txn-mempool-conflict"},
+			},
+		},
+		{
+			name:    "node unreachable",
+			sendErr: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.NodeSubmissionsAccepted, 0),
+				attribute.Int(metamorph.NodeSubmissionsRejected, 0),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			node := &mocks.FallbackNodeMock{
+				SendRawTransactionFunc: func(_ string) (string, error) {
+					return "", tc.sendErr
+				},
+			}
+
+			var submissions []store.NodeSubmission
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetUnrequestedFunc: func(_ context.Context, _ time.Time, announcedBefore time.Time, _ int64) ([]*store.Data, error) {
+					assert.Equal(t, now.Add(-time.Minute), announcedBefore)
+					return []*store.Data{
+						{Hash: testdata.TX1Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, RawTx: testdata.TX1Raw.Bytes()},
+						{Hash: testdata.TX2Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, RawTx: testdata.TX1Raw.Bytes()},
+					}, nil
+				},
+				SetNodeSubmissionFunc: func(_ context.Context, _ *chainhash.Hash, submission store.NodeSubmission) error {
+					submissions = append(submissions, submission)
+					return nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			sut, err := metamorph.NewProcessor(
+				metamorphStore,
+				nil,
+				&mocks.MediatorMock{},
+				nil,
+				metamorph.WithNow(func() time.Time { return now }),
+				metamorph.WithNodeSubmitFallback(node, time.Minute, 30*time.Second),
+			)
+			require.NoError(t, err)
+
+			// when
+			actual := metamorph.SubmitUnrequestedToNode(context.TODO(), sut)
+
+			// then
+			assert.Equal(t, tc.expectedAttributes, actual)
+			assert.Equal(t, tc.expectedSubmissions, submissions)
+			if tc.sendErr != nil && len(tc.expectedSubmissions) == 0 {
+				assert.Len(t, node.SendRawTransactionCalls(), 1)
+				return
+			}
+			assert.Len(t, node.SendRawTransactionCalls(), 2)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package metamorph
+
+import (
+	"context"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+// OutpointSpender is the transaction known to metamorph which spends an outpoint.
+type OutpointSpender struct {
+	// Spent is false if none of the transactions known to metamorph spends the outpoint
+	Spent        bool
+	SpendingTxID string
+	Status       string
+}
+
+func outpointSpenderFromProto(spender *metamorph_api.OutpointSpender) *OutpointSpender {
+	result := &OutpointSpender{
+		Spent: spender.GetSpent(),
+	}
+
+	if result.Spent {
+		result.SpendingTxID = spender.GetSpendingTxid()
+		result.Status = spender.GetStatus().String()
+	}
+
+	return result
+}
+
+// findOutpointSpender looks up the stored transactions which spend outputs of the transaction and returns the one
+// spending the outpoint. Rejected transactions don't spend the outpoint. If multiple transactions spend it, e.g. a
+// double spend, the one with the most advanced status is returned, i.e. a mined transaction before an unmined one.
+func findOutpointSpender(ctx context.Context, s store.MetamorphStore, hash *chainhash.Hash, vout uint32) (*metamorph_api.OutpointSpender, error) {
+	childHashes, err := s.GetChildHashes(ctx, [][]byte{hash.CloneBytes()})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(childHashes) == 0 {
+		return &metamorph_api.OutpointSpender{}, nil
+	}
+
+	children, err := s.GetMany(ctx, childHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	var spender *store.Data
+	for _, data := range children {
+		if data.Status == metamorph_api.Status_REJECTED || !spendsOutpoint(data.RawTx, hash, vout) {
+			continue
+		}
+
+		if spender == nil || data.Status > spender.Status {
+			spender = data
+		}
+	}
+
+	if spender == nil {
+		return &metamorph_api.OutpointSpender{}, nil
+	}
+
+	return &metamorph_api.OutpointSpender{
+		Spent:        true,
+		SpendingTxid: spender.Hash.String(),
+		Status:       spender.Status,
+	}, nil
+}
+
+func spendsOutpoint(rawTx []byte, hash *chainhash.Hash, vout uint32) bool {
+	if len(rawTx) == 0 {
+		return false
+	}
+
+	tx, err := sdkTx.NewTransactionFromBytes(rawTx)
+	if err != nil {
+		return false
+	}
+
+	for _, input := range tx.Inputs {
+		if input.SourceTXID != nil && chainhash.Hash(*input.SourceTXID) == *hash && input.SourceTxOutIndex == vout {
+			return true
+		}
+	}
+
+	return false
+}
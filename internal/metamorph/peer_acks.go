@@ -0,0 +1,153 @@
+package metamorph
+
+import (
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+// PeerAck is the acknowledgment of the announcement of a transaction by a peer. RequestedAt is the time at which the
+// peer requested the transaction, SentAt the time at which the transaction was sent to the peer, both are zero if
+// the peer did not request or receive the transaction.
+type PeerAck struct {
+	Peer        string
+	RequestedAt time.Time
+	SentAt      time.Time
+}
+
+type peerAckKey struct {
+	hash chainhash.Hash
+	peer string
+}
+
+// peerAckBuffer collects the acknowledgments by peers until they are stored, acknowledgments of the same transaction
+// by the same peer are merged.
+type peerAckBuffer struct {
+	mu   sync.Mutex
+	acks map[peerAckKey]store.PeerAck
+}
+
+func newPeerAckBuffer() *peerAckBuffer {
+	return &peerAckBuffer{acks: make(map[peerAckKey]store.PeerAck)}
+}
+
+// add records the request of a transaction by a peer or the sending of the transaction to the peer. Other status
+// messages are ignored.
+func (b *peerAckBuffer) add(msg *metamorph_p2p.TxStatusMessage) {
+	if msg.Peer == "" || (msg.Status != metamorph_api.Status_REQUESTED_BY_NETWORK && msg.Status != metamorph_api.Status_SENT_TO_NETWORK) {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := peerAckKey{hash: *msg.Hash, peer: msg.Peer}
+	ack, found := b.acks[key]
+	if !found {
+		ack = store.PeerAck{Hash: *msg.Hash, Peer: msg.Peer}
+	}
+
+	if msg.Status == metamorph_api.Status_REQUESTED_BY_NETWORK && ack.RequestedAt.IsZero() {
+		ack.RequestedAt = msg.Start
+	}
+	if msg.Status == metamorph_api.Status_SENT_TO_NETWORK && ack.SentAt.IsZero() {
+		ack.SentAt = msg.Start
+	}
+
+	b.acks[key] = ack
+}
+
+// extract returns the collected acknowledgments and empties the buffer.
+func (b *peerAckBuffer) extract() []store.PeerAck {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.acks) == 0 {
+		return nil
+	}
+
+	acks := make([]store.PeerAck, 0, len(b.acks))
+	for _, ack := range b.acks {
+		acks = append(acks, ack)
+	}
+	b.acks = make(map[peerAckKey]store.PeerAck)
+
+	return acks
+}
+
+// requestedBy returns per transaction the peers which have requested the transaction.
+func requestedBy(acks []store.PeerAck) map[chainhash.Hash]map[string]struct{} {
+	result := make(map[chainhash.Hash]map[string]struct{})
+	for _, ack := range acks {
+		if ack.RequestedAt.IsZero() {
+			continue
+		}
+
+		if result[ack.Hash] == nil {
+			result[ack.Hash] = make(map[string]struct{})
+		}
+		result[ack.Hash][ack.Peer] = struct{}{}
+	}
+
+	return result
+}
+
+// peersExcept returns the connected peers which are not in the given set of peers.
+func peersExcept(peers []p2p.PeerI, except map[string]struct{}) []p2p.PeerI {
+	result := make([]p2p.PeerI, 0, len(peers))
+	for _, peer := range peers {
+		if !peer.Connected() {
+			continue
+		}
+		if _, found := except[peer.String()]; found {
+			continue
+		}
+		result = append(result, peer)
+	}
+
+	return result
+}
+
+func toPeerAcksProto(acks []store.PeerAck) []*metamorph_api.PeerAck {
+	if len(acks) == 0 {
+		return nil
+	}
+
+	result := make([]*metamorph_api.PeerAck, 0, len(acks))
+	for _, ack := range acks {
+		protoAck := &metamorph_api.PeerAck{Peer: ack.Peer}
+		if !ack.RequestedAt.IsZero() {
+			protoAck.RequestedAt = timestamppb.New(ack.RequestedAt)
+		}
+		if !ack.SentAt.IsZero() {
+			protoAck.SentAt = timestamppb.New(ack.SentAt)
+		}
+		result = append(result, protoAck)
+	}
+
+	return result
+}
+
+func peerAcksFromProto(acks []*metamorph_api.PeerAck) []PeerAck {
+	if len(acks) == 0 {
+		return nil
+	}
+
+	result := make([]PeerAck, 0, len(acks))
+	for _, ack := range acks {
+		result = append(result, PeerAck{
+			Peer:        ack.GetPeer(),
+			RequestedAt: timeOrZero(ack.GetRequestedAt()),
+			SentAt:      timeOrZero(ack.GetSentAt()),
+		})
+	}
+
+	return result
+}
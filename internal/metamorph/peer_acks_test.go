@@ -0,0 +1,63 @@
+package metamorph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestPeerAckBuffer(t *testing.T) {
+	requestedAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	sentAt := requestedAt.Add(time.Second)
+
+	tt := []struct {
+		name     string
+		messages []*metamorph_p2p.TxStatusMessage
+
+		expected []store.PeerAck
+	}{
+		{
+			name: "request and sending are merged",
+			messages: []*metamorph_p2p.TxStatusMessage{
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_REQUESTED_BY_NETWORK, Peer: "peer-1", Start: requestedAt},
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_REQUESTED_BY_NETWORK, Peer: "peer-1", Start: sentAt},
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SENT_TO_NETWORK, Peer: "peer-1", Start: sentAt},
+			},
+
+			expected: []store.PeerAck{
+				{Hash: *testdata.TX1Hash, Peer: "peer-1", RequestedAt: requestedAt, SentAt: sentAt},
+			},
+		},
+		{
+			name: "other statuses and messages without peer are ignored",
+			messages: []*metamorph_p2p.TxStatusMessage{
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, Peer: "peer-1", Start: requestedAt},
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_REQUESTED_BY_NETWORK, Start: requestedAt},
+			},
+
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := newPeerAckBuffer()
+
+			// when
+			for _, msg := range tc.messages {
+				sut.add(msg)
+			}
+
+			// then
+			require.Equal(t, tc.expected, sut.extract())
+			require.Nil(t, sut.extract())
+		})
+	}
+}
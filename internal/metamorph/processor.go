@@ -11,16 +11,21 @@ import (
 
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"go.opentelemetry.io/otel/attribute"
-	"google.golang.org/protobuf/proto"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
 	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/hashing"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
@@ -54,6 +59,12 @@ const (
 	registerBatchSizeDefault            = 50
 	processMinedBatchSizeDefault        = 200
 	processMinedIntervalDefault         = 1 * time.Second
+	broadcastScheduledIntervalDefault   = 1 * time.Second
+	storePeerAcksIntervalDefault        = 1 * time.Second
+	expireIntervalDefault               = 5 * time.Second
+	broadcastFinalIntervalDefault       = 30 * time.Second
+
+	txCacheTTL = 10 * time.Minute
 )
 
 var (
@@ -63,6 +74,7 @@ var (
 	ErrFailedToSubscribe            = errors.New("failed to subscribe to topic")
 	ErrFailedToStartCollectingStats = errors.New("failed to start collecting stats")
 	ErrUnhealthy                    = errors.New("processor has less than minimum healthy peer connections")
+	ErrKnownTxFilterSharedCache     = errors.New("known transaction filter requires a cache store local to the processor")
 )
 
 type Processor struct {
@@ -78,11 +90,17 @@ type Processor struct {
 	minimumHealthyConnections int
 	callbackSender            CallbackSender
 	trackOnly                 bool
+	publishStatusUpdates      bool
+	malleabilityDetection     bool
+	statusExporters           []StatusExporter
+	eventBus                  *events.Bus
 
 	responseProcessor *ResponseProcessor
 	statusMessageCh   chan *metamorph_p2p.TxStatusMessage
 
-	waitGroup *sync.WaitGroup
+	waitGroup  *sync.WaitGroup
+	scheduler  *scheduler.Scheduler
+	jobConfigs map[string]scheduler.JobConfig
 
 	statCollectionInterval time.Duration
 
@@ -116,6 +134,19 @@ type Processor struct {
 
 	reAnnounceUnseenInterval time.Duration
 
+	broadcastScheduledInterval time.Duration
+	cancellationWindow         time.Duration
+
+	expireInterval time.Duration
+
+	broadcastFinalInterval time.Duration
+
+	lateStatusHistory bool
+	statusMachine     *StatusMachine
+
+	peerAcks              *peerAckBuffer
+	storePeerAcksInterval time.Duration
+
 	rebroadcastExpiration time.Duration
 
 	processTransactionsInterval  time.Duration
@@ -129,6 +160,26 @@ type Processor struct {
 	tracingAttributes []attribute.KeyValue
 
 	blocktxClient blocktx.Client
+
+	knownTxFilter *knownTxFilter
+	memGuard      *memlimit.Guard
+	featureFlags  *feature.Flags
+
+	slaReports         bool
+	slaReportsInterval time.Duration
+
+	reconciliationNode  ReconciliationNode
+	reconcileInterval   time.Duration
+	reconcileStaleAfter time.Duration
+	reconcileOnStart    bool
+
+	consistencyCheck         bool
+	consistencyCheckInterval time.Duration
+	consistencyCheckBlocks   uint64
+
+	fallbackNode       FallbackNode
+	nodeSubmitTimeout  time.Duration
+	nodeSubmitInterval time.Duration
 }
 
 type Option func(f *Processor)
@@ -137,9 +188,15 @@ type CallbackSender interface {
 	SendCallback(ctx context.Context, data *store.Data)
 }
 
+// StatusExporter exports the status change events of transactions, e.g. to object storage.
+type StatusExporter interface {
+	Export(data []*store.Data) error
+}
+
 type Mediator interface {
 	AskForTxAsync(ctx context.Context, tx *store.Data)
 	AnnounceTxAsync(ctx context.Context, tx *store.Data)
+	AnnounceTxToPeersAsync(ctx context.Context, tx *store.Data, peers []p2p.PeerI)
 	GetPeers() []p2p.PeerI
 	CountConnectedPeers() uint
 }
@@ -191,9 +248,23 @@ func NewProcessor(s store.MetamorphStore, c cache.Store, bcMediator Mediator, st
 		statCollectionInterval:            statCollectionIntervalDefault,
 		processTransactionsInterval:       processTransactionsIntervalDefault,
 		processTransactionsBatchSize:      processTransactionsBatchSizeDefault,
+		reconcileInterval:                 reconcileIntervalDefault,
+		reconcileStaleAfter:               reconcileStaleAfterDefault,
+		consistencyCheckInterval:          consistencyCheckIntervalDefault,
+		consistencyCheckBlocks:            consistencyCheckBlocksDefault,
+		nodeSubmitTimeout:                 nodeSubmitTimeoutDefault,
+		nodeSubmitInterval:                nodeSubmitIntervalDefault,
+		broadcastScheduledInterval:        broadcastScheduledIntervalDefault,
+		expireInterval:                    expireIntervalDefault,
+		broadcastFinalInterval:            broadcastFinalIntervalDefault,
+		peerAcks:                          newPeerAckBuffer(),
+		storePeerAcksInterval:             storePeerAcksIntervalDefault,
+		lateStatusHistory:                 true,
+		statusMachine:                     NewStatusMachine(),
 
 		processMinedInterval:  processMinedIntervalDefault,
 		processMinedBatchSize: processMinedBatchSizeDefault,
+		slaReportsInterval:    slaReportsIntervalDefault,
 	}
 
 	p.logger = slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: logLevelDefault})).With(slog.String("service", "mtm"))
@@ -203,6 +274,16 @@ func NewProcessor(s store.MetamorphStore, c cache.Store, bcMediator Mediator, st
 		opt(p)
 	}
 
+	// between rebuilds the filter only learns the transactions stored by this processor, with a shared cache and store
+	// it would drop the transactions stored by other instances
+	if p.knownTxFilter != nil {
+		if _, local := c.(*cache.MemoryStore); !local {
+			return nil, ErrKnownTxFilterSharedCache
+		}
+	}
+
+	p.scheduler = scheduler.New(p.logger, scheduler.WithJobConfigs(p.jobConfigs))
+
 	p.logger.Info("Starting processor")
 
 	ctx, cancelAll := context.WithCancel(context.Background())
@@ -218,9 +299,9 @@ func NewProcessor(s store.MetamorphStore, c cache.Store, bcMediator Mediator, st
 }
 
 func (p *Processor) Start(statsEnabled bool) error {
-	err := p.mqClient.QueueSubscribe(mq.MinedTxsTopic, func(msg []byte) error {
+	err := p.mqClient.QueueSubscribe(mq.MinedTxsTopic, func(ctx context.Context, msg []byte) error {
 		serialized := &blocktx_api.TransactionBlocks{}
-		err := proto.Unmarshal(msg, serialized)
+		err := mq.Unmarshal(ctx, mq.MinedTxsTopic, msg, serialized)
 		if err != nil {
 			return errors.Join(ErrFailedToUnmarshalMessage, fmt.Errorf("subscribed on %s topic", mq.MinedTxsTopic), err)
 		}
@@ -232,9 +313,9 @@ func (p *Processor) Start(statsEnabled bool) error {
 		return errors.Join(ErrFailedToSubscribe, fmt.Errorf("to %s topic", mq.MinedTxsTopic), err)
 	}
 
-	err = p.mqClient.Consume(mq.SubmitTxTopic, func(msg []byte) error {
+	err = p.mqClient.Consume(mq.SubmitTxTopic, func(ctx context.Context, msg []byte) error {
 		serialized := &metamorph_api.PostTransactionRequest{}
-		err = proto.Unmarshal(msg, serialized)
+		err = mq.Unmarshal(ctx, mq.SubmitTxTopic, msg, serialized)
 		if err != nil {
 			return errors.Join(ErrFailedToUnmarshalMessage, fmt.Errorf("subscribed on %s topic", mq.SubmitTxTopic), err)
 		}
@@ -254,6 +335,37 @@ func (p *Processor) Start(statsEnabled bool) error {
 	p.StartRoutine(p.reRegisterSeenInterval, RegisterSeenTxs, "RegisterSeenTxs")
 	p.StartRoutine(p.checkUnconfirmedSeenInterval, RejectUnconfirmedRequested, "RejectUnconfirmedRequested")
 	p.StartRoutine(p.doubleSpendTxStatusCheck, ProcessDoubleSpendTxs, "ProcessDoubleSpendTxs")
+	p.StartRoutine(p.broadcastScheduledInterval, BroadcastScheduled, "BroadcastScheduled")
+	p.StartRoutine(p.expireInterval, ExpireTransactions, "ExpireTransactions")
+	if p.blocktxClient != nil {
+		p.StartRoutine(p.broadcastFinalInterval, BroadcastFinal, "BroadcastFinal")
+	}
+	p.StartRoutine(p.storePeerAcksInterval, StorePeerAcks, "StorePeerAcks")
+	if p.reconciliationNode != nil {
+		p.StartRoutine(p.reconcileInterval, ReconcileStatuses, "ReconcileStatuses")
+		if p.reconcileOnStart {
+			err := p.scheduler.Trigger("ReconcileStatuses")
+			if err != nil {
+				p.logger.Error("Failed to reconcile statuses on start", slog.String("err", err.Error()))
+			}
+		}
+	}
+
+	if p.slaReports {
+		p.StartRoutine(p.slaReportsInterval, ComputeSLAReports, "ComputeSLAReports")
+	}
+
+	if p.consistencyCheck && p.blocktxClient != nil {
+		p.StartRoutine(p.consistencyCheckInterval, CheckConsistency, "CheckConsistency")
+	}
+
+	if p.fallbackNode != nil {
+		p.StartRoutine(p.nodeSubmitInterval, SubmitUnrequestedToNode, "SubmitUnrequestedToNode")
+	}
+
+	if p.knownTxFilter != nil {
+		p.StartRebuildKnownTxFilter()
+	}
 
 	p.StartProcessStatusUpdatesInStorage()
 	p.StartProcessMinedCallbacks()
@@ -282,9 +394,15 @@ func (p *Processor) Shutdown() {
 		p.cancelAll()
 	}
 
+	p.scheduler.Shutdown()
 	p.waitGroup.Wait()
 }
 
+// Scheduler returns the scheduler of the maintenance jobs of the processor.
+func (p *Processor) Scheduler() *scheduler.Scheduler {
+	return p.scheduler
+}
+
 func (p *Processor) unlockRecords() error {
 	unlockedItems, err := p.store.SetUnlockedByName(context.Background(), p.hostname)
 	if err != nil {
@@ -310,14 +428,15 @@ func (p *Processor) StartProcessMinedCallbacks() {
 					continue
 				}
 
-				if len(msg.TransactionBlocks) >= p.processMinedBatchSize {
+				batchSize := p.memGuard.BatchSize(p.processMinedBatchSize)
+				if len(msg.TransactionBlocks) >= batchSize {
 					p.updateMined(p.ctx, msg.TransactionBlocks)
 					continue
 				}
 
 				txsBlocksBuffer = append(txsBlocksBuffer, msg.TransactionBlocks...)
 
-				if len(txsBlocksBuffer) < p.processMinedBatchSize {
+				if len(txsBlocksBuffer) < batchSize {
 					continue
 				}
 
@@ -366,6 +485,8 @@ func (p *Processor) updateMined(ctx context.Context, txsBlocks []*blocktx_api.Tr
 			Status: metamorph_api.Status_MINED,
 		})
 
+		p.observeStageLatency(data)
+
 		if len(data.Callbacks) > 0 {
 			requests := toSendRequest(data, p.now())
 			for _, request := range requests {
@@ -378,6 +499,9 @@ func (p *Processor) updateMined(ctx context.Context, txsBlocks []*blocktx_api.Tr
 
 		p.delTxFromCache(data.Hash)
 	}
+
+	p.publishUpdatedStatuses(ctx, updatedData)
+	p.exportStatusEvents(updatedData)
 }
 
 // StartProcessSubmitted starts processing txs submitted to message queue
@@ -389,6 +513,18 @@ func (p *Processor) StartProcessSubmitted() {
 
 		reqs := make([]*store.Data, 0, p.processTransactionsBatchSize)
 		for {
+			// stop consuming submitted txs while the memory usage is close to the memory limit
+			if p.memGuard.Level() == memlimit.LevelPause {
+				if len(reqs) > 0 {
+					p.ProcessTransactions(p.ctx, reqs)
+					reqs = make([]*store.Data, 0, p.processTransactionsBatchSize)
+				}
+
+				if p.memGuard.WaitWhilePaused(p.ctx) != nil {
+					return
+				}
+			}
+
 			select {
 			case <-p.ctx.Done():
 				return
@@ -410,22 +546,34 @@ func (p *Processor) StartProcessSubmitted() {
 					Status:            metamorph_api.Status_STORED,
 					FullStatusUpdates: submittedTx.GetFullStatusUpdates(),
 					RawTx:             submittedTx.GetRawTx(),
+					Consolidation:     submittedTx.GetConsolidation(),
 					Callbacks:         []store.Callback{},
 					StoredAt:          now,
 					LastSubmittedAt:   now,
+					ReceivedAt:        timeOrZero(submittedTx.GetReceivedAt()),
+					ValidatedAt:       timeOrZero(submittedTx.GetValidatedAt()),
+					Tenant:            submittedTx.GetTenant(),
+					BroadcastAt:       timeOrZero(submittedTx.GetBroadcastAt()),
+					AnnounceTo:        submittedTx.GetAnnounceTo(),
+					ExpiresAt:         timeOrZero(submittedTx.GetExpiresAt()),
+					LockTime:          submittedTx.GetLockTime(),
 				}
 
 				if submittedTx.GetCallbackUrl() != "" || submittedTx.GetCallbackToken() != "" {
 					sReq.Callbacks = []store.Callback{
 						{
-							CallbackURL:   submittedTx.GetCallbackUrl(),
-							CallbackToken: submittedTx.GetCallbackToken(),
+							CallbackURL:       submittedTx.GetCallbackUrl(),
+							CallbackToken:     submittedTx.GetCallbackToken(),
+							CallbackVersion:   submittedTx.GetCallbackVersion(),
+							AllowDuplicates:   submittedTx.GetCallbackAllowDuplicates(),
+							SuppressionWindow: submittedTx.GetCallbackSuppressionWindow(),
 						},
 					}
 				}
+				sReq.Callbacks = append(sReq.Callbacks, additionalCallbacks(submittedTx)...)
 
 				reqs = append(reqs, sReq)
-				if len(reqs) >= p.processTransactionsBatchSize {
+				if len(reqs) >= p.memGuard.BatchSize(p.processTransactionsBatchSize) {
 					p.ProcessTransactions(p.ctx, reqs)
 					reqs = make([]*store.Data, 0, p.processTransactionsBatchSize)
 
@@ -447,7 +595,7 @@ func (p *Processor) StartSendStatusUpdate() {
 				return
 
 			case msg := <-p.statusMessageCh:
-				if msg.ReceivedRawTx {
+				if msg.ReceivedRawTx && p.mayBeKnown(msg.Hash) {
 					err := p.store.MarkConfirmedRequested(p.ctx, msg.Hash)
 					if err != nil {
 						p.logger.Error("Failed to mark confirmed requested", slog.String("err", err.Error()))
@@ -458,6 +606,8 @@ func (p *Processor) StartSendStatusUpdate() {
 					continue
 				}
 
+				p.peerAcks.add(msg)
+
 				p.logger.Debug("Status update received", slog.String("hash", msg.Hash.String()), slog.String("status", msg.Status.String()))
 
 				// update status of transaction in storage
@@ -573,15 +723,20 @@ func (p *Processor) statusUpdateWithCallback(ctx context.Context, statusUpdates,
 		updatedData = append(updatedData, updatedDoubleSpendData...)
 	}
 
-	statusHistoryUpdates := filterUpdates(statusUpdates, updatedData)
-	_, err = p.store.UpdateStatusHistory(ctx, statusHistoryUpdates)
-	if err != nil {
-		p.logger.Error("failed to update status history", slog.String("err", err.Error()))
+	// the updates which did not change the status are late compared to the status stored in the meantime
+	if p.lateStatusHistory {
+		statusHistoryUpdates := filterUpdates(statusUpdates, updatedData)
+		_, err = p.store.UpdateStatusHistory(ctx, statusHistoryUpdates)
+		if err != nil {
+			p.logger.Error("failed to update status history", slog.String("err", err.Error()))
+		}
 	}
 
 	for _, data := range updatedData {
 		p.logger.Debug("Status updated for tx", slog.String("status", data.Status.String()), slog.String("hash", data.Hash.String()))
-		sendCallback := data.Status >= metamorph_api.Status_REJECTED
+		p.observeStageLatency(data)
+
+		sendCallback := data.Status >= metamorph_api.Status_REJECTED || data.Status == metamorph_api.Status_EXPIRED
 
 		if data.FullStatusUpdates {
 			sendCallback = data.Status >= metamorph_api.Status_SEEN_IN_ORPHAN_MEMPOOL
@@ -597,9 +752,59 @@ func (p *Processor) statusUpdateWithCallback(ctx context.Context, statusUpdates,
 			}
 		}
 	}
+
+	p.publishUpdatedStatuses(ctx, updatedData)
+	p.exportStatusEvents(updatedData)
 	return nil
 }
 
+// publishUpdatedStatuses publishes the hashes of the transactions whose status has been updated, if enabled.
+func (p *Processor) publishUpdatedStatuses(ctx context.Context, updatedData []*store.Data) {
+	if !p.publishStatusUpdates {
+		return
+	}
+
+	publishStatusUpdates(ctx, p.mqClient, p.logger, updatedData)
+}
+
+// publishStatusUpdates publishes the hashes of the transactions on the status update topic.
+func publishStatusUpdates(ctx context.Context, mqClient mq.MessageQueueClient, logger *slog.Logger, updatedData []*store.Data) {
+	if mqClient == nil || len(updatedData) == 0 {
+		return
+	}
+
+	txs := make([]*blocktx_api.Transaction, 0, len(updatedData))
+	for _, data := range updatedData {
+		txs = append(txs, &blocktx_api.Transaction{Hash: data.Hash[:]})
+	}
+
+	err := mqClient.PublishMarshalCore(ctx, mq.StatusUpdateTopic, &blocktx_api.Transactions{Transactions: txs})
+	if err != nil {
+		logger.Error("Failed to publish status updates", slog.String("err", err.Error()))
+	}
+}
+
+// exportStatusEvents exports the status change events of the transactions whose status has been updated with each of
+// the configured exporters and publishes them on the event bus.
+func (p *Processor) exportStatusEvents(updatedData []*store.Data) {
+	if len(updatedData) == 0 {
+		return
+	}
+
+	if p.eventBus != nil {
+		for _, data := range updatedData {
+			p.eventBus.Publish(toTxStatusChanged(data, p.now()))
+		}
+	}
+
+	for _, exporter := range p.statusExporters {
+		err := exporter.Export(updatedData)
+		if err != nil {
+			p.logger.Error("Failed to export status events", slog.String("err", err.Error()))
+		}
+	}
+}
+
 func (p *Processor) StartLockTransactions() {
 	ticker := time.NewTicker(p.lockTransactionsInterval)
 	p.waitGroup.Add(1)
@@ -634,7 +839,7 @@ func (p *Processor) registerTransaction(ctx context.Context, hash *chainhash.Has
 
 	p.logger.Warn("Register transaction call failed", slog.String("err", err.Error()))
 
-	err = p.mqClient.PublishAsync(mq.RegisterTxTopic, hash[:])
+	err = p.mqClient.PublishMarshalAsync(ctx, mq.RegisterTxTopic, &blocktx_api.Transaction{Hash: hash[:]})
 	if err != nil {
 		return fmt.Errorf("failed to publish hash on topic %s: %w", mq.RegisterTxTopic, err)
 	}
@@ -642,6 +847,36 @@ func (p *Processor) registerTransaction(ctx context.Context, hash *chainhash.Has
 	return nil
 }
 
+// registerTransactionHashes registers the hash of the transaction in blocktx and its normalized hash if it is set.
+func (p *Processor) registerTransactionHashes(ctx context.Context, data *store.Data) error {
+	err := p.registerTransaction(ctx, data.Hash)
+	if err != nil {
+		return err
+	}
+
+	if data.NormalizedHash == nil {
+		return nil
+	}
+
+	return p.registerTransaction(ctx, data.NormalizedHash)
+}
+
+// setNormalizedHash calculates the normalized hash of the transaction if the detection of malleated transactions is
+// enabled. Transactions which cannot be parsed are stored without normalized hash.
+func (p *Processor) setNormalizedHash(data *store.Data) {
+	if !p.malleabilityDetection || data.NormalizedHash != nil || len(data.RawTx) == 0 {
+		return
+	}
+
+	normalizedHash, err := hashing.NormalizedTxHash(data.RawTx)
+	if err != nil {
+		p.logger.Warn("Failed to calculate normalized hash", slog.String("hash", data.Hash.String()), slog.String("err", err.Error()))
+		return
+	}
+
+	data.NormalizedHash = &normalizedHash
+}
+
 func (p *Processor) registerTransactions(ctx context.Context, data []*store.Data) error {
 	txHashesBatch := make([][]byte, 0, len(data))
 
@@ -700,12 +935,12 @@ func (p *Processor) ProcessTransaction(ctx context.Context, req *ProcessorReques
 	statusResponse := NewStatusResponse(ctx, req.Data.Hash, req.ResponseChannel)
 
 	// check if tx already stored, return it
-	data, err := p.store.Get(p.ctx, req.Data.Hash[:])
+	data, err := p.getStored(ctx, req.Data.Hash)
 	if err == nil {
 		//	When transaction is re-submitted we update last_submitted_at with now()
 		//	to make sure it will be loaded and re-broadcast if needed.
 		addNewCallback(data, req.Data)
-		err = p.storeData(p.ctx, data)
+		err = p.storeData(ctx, data)
 		if err != nil {
 			p.logger.Error("Failed to update data", slog.String("hash", req.Data.Hash.String()), slog.String("err", err.Error()))
 		}
@@ -737,6 +972,8 @@ func (p *Processor) ProcessTransaction(ctx context.Context, req *ProcessorReques
 	sh := &store.StatusWithTimestamp{Status: req.Data.Status, Timestamp: p.now()}
 	req.Data.StatusHistory = append(req.Data.StatusHistory, sh)
 	req.Data.Status = metamorph_api.Status_STORED
+	p.setNormalizedHash(req.Data)
+	p.delayBroadcast(req.Data)
 
 	if err = p.storeData(ctx, req.Data); err != nil {
 		// issue with the store itself
@@ -753,9 +990,12 @@ func (p *Processor) ProcessTransaction(ctx context.Context, req *ProcessorReques
 	statusResponse.UpdateStatus(StatusAndError{
 		Status: metamorph_api.Status_STORED,
 	})
+	p.exportStatusEvents([]*store.Data{req.Data})
+
+	p.observeStageLatency(req.Data)
 
 	// register transaction in blocktx using message queue
-	err = p.registerTransaction(ctx, req.Data.Hash)
+	err = p.registerTransactionHashes(ctx, req.Data)
 	if err != nil {
 		p.logger.Error("Failed to register tx in blocktx", slog.String("hash", req.Data.Hash.String()), slog.String("err", err.Error()))
 	}
@@ -772,8 +1012,16 @@ func (p *Processor) ProcessTransaction(ctx context.Context, req *ProcessorReques
 		// don't return here, because the transaction will try to be added to cache again when re-broadcasting unmined txs
 	}
 
+	if p.isHeld(req.Data) {
+		statusResponse.UpdateStatus(StatusAndError{
+			Status: metamorph_api.Status_WAITING_FOR_FINALITY,
+		})
+		p.holdUntilFinal(req.Data)
+		return
+	}
+
 	// ask network about the tx to see if they have it
-	if !p.trackOnly { // Only broadcast if not in TrackOnly mode
+	if !p.trackOnly && !p.isScheduled(req.Data) { // Only broadcast if not in TrackOnly mode and not scheduled for later
 		p.bcMediator.AskForTxAsync(ctx, req.Data)
 		p.bcMediator.AnnounceTxAsync(ctx, req.Data)
 
@@ -799,6 +1047,11 @@ func (p *Processor) ProcessTransactions(ctx context.Context, sReq []*store.Data)
 		tracing.EndTracing(span, err)
 	}()
 
+	for _, data := range sReq {
+		p.setNormalizedHash(data)
+		p.delayBroadcast(data)
+	}
+
 	// store in database
 	err = p.store.SetBulk(ctx, sReq)
 	if err != nil {
@@ -806,19 +1059,28 @@ func (p *Processor) ProcessTransactions(ctx context.Context, sReq []*store.Data)
 		return
 	}
 
+	p.exportStatusEvents(sReq)
+
 	for _, data := range sReq {
+		p.observeStageLatency(data)
+
 		err = p.saveTxToCache(data.Hash)
 		if err != nil {
 			p.logger.Error("Failed to save tx in cache", slog.String("hash", data.Hash.String()), slog.String("err", err.Error()))
 		}
 
 		// register transaction in blocktx using message queue
-		err = p.registerTransaction(ctx, data.Hash)
+		err = p.registerTransactionHashes(ctx, data)
 		if err != nil {
 			p.logger.Error("Failed to register tx in blocktx", slog.String("hash", data.Hash.String()), slog.String("err", err.Error()))
 		}
 
-		if !p.trackOnly { // no broadcast or status advance when track only
+		if p.isHeld(data) {
+			p.holdUntilFinal(data)
+			continue
+		}
+
+		if !p.trackOnly && !p.isScheduled(data) { // no broadcast or status advance when track only or scheduled for later
 			p.bcMediator.AnnounceTxAsync(ctx, data)
 			// update status in storage
 			p.storageStatusUpdateCh <- store.UpdateStatus{
@@ -830,6 +1092,37 @@ func (p *Processor) ProcessTransactions(ctx context.Context, sReq []*store.Data)
 	}
 }
 
+// isScheduled returns true if the transaction is announced to the network by BroadcastScheduled at a later time.
+func (p *Processor) isScheduled(data *store.Data) bool {
+	return data.BroadcastAt.After(p.now())
+}
+
+// isHeld returns true if the transaction is not final and announced to the network by BroadcastFinal once it is final.
+func (p *Processor) isHeld(data *store.Data) bool {
+	return data.LockTime != 0
+}
+
+// holdUntilFinal changes the status of a transaction which is not final to WAITING_FOR_FINALITY.
+func (p *Processor) holdUntilFinal(data *store.Data) {
+	p.storageStatusUpdateCh <- store.UpdateStatus{
+		Hash:      *data.Hash,
+		Status:    metamorph_api.Status_WAITING_FOR_FINALITY,
+		Timestamp: p.now(),
+	}
+}
+
+// delayBroadcast schedules the broadcast of a new transaction not earlier than the end of the cancellation window.
+func (p *Processor) delayBroadcast(data *store.Data) {
+	if p.cancellationWindow <= 0 {
+		return
+	}
+
+	windowEnd := p.now().Add(p.cancellationWindow)
+	if data.BroadcastAt.Before(windowEnd) {
+		data.BroadcastAt = windowEnd
+	}
+}
+
 func (p *Processor) Health() error {
 	healthyConnections := int(p.bcMediator.CountConnectedPeers()) // #nosec G115
 
@@ -866,12 +1159,24 @@ func callbackExists(callback store.Callback, data *store.Data) bool {
 }
 
 func (p *Processor) saveTxToCache(hash *chainhash.Hash) error {
-	const txCacheTTL = 10 * time.Minute
-	return p.cacheStore.Set(hash.String(), []byte("1"), txCacheTTL)
+	if p.knownTxFilter != nil {
+		p.knownTxFilter.Add(hash)
+	}
+
+	return p.cacheStore.Set(hexutils.TxID(hash), []byte("1"), txCacheTTL)
+}
+
+// mayBeKnown returns false if the known transaction filter is enabled and the transaction is certainly not known.
+func (p *Processor) mayBeKnown(hash *chainhash.Hash) bool {
+	return p.knownTxFilter == nil || p.knownTxFilter.MayContain(hash)
 }
 
 func (p *Processor) txFoundInCache(hash *chainhash.Hash) (found bool) {
-	value, err := p.cacheStore.Get(hash.String())
+	if !p.mayBeKnown(hash) {
+		return false
+	}
+
+	value, err := p.cacheStore.Get(hexutils.TxID(hash))
 	if err != nil && !errors.Is(err, cache.ErrCacheNotFound) {
 		p.logger.Error("count not get the transaction from hash", slog.String("hash", hash.String()), slog.String("err", err.Error()))
 		// respond as if the tx is found just in case this transaction is registered
@@ -881,8 +1186,22 @@ func (p *Processor) txFoundInCache(hash *chainhash.Hash) (found bool) {
 	return value != nil
 }
 
+// getStored returns the stored transaction, the store lookup is skipped if the transaction is certainly not known.
+func (p *Processor) getStored(ctx context.Context, hash *chainhash.Hash) (*store.Data, error) {
+	if !p.mayBeKnown(hash) {
+		return nil, store.ErrNotFound
+	}
+
+	data, err := p.store.Get(ctx, hash[:])
+	if errors.Is(err, store.ErrNotFound) && p.knownTxFilter != nil {
+		p.knownTxFilter.FalsePositive()
+	}
+
+	return data, err
+}
+
 func (p *Processor) delTxFromCache(hash *chainhash.Hash) {
-	err := p.cacheStore.Del(hash.String())
+	err := p.cacheStore.Del(hexutils.TxID(hash))
 	if err != nil && !errors.Is(err, cache.ErrCacheNotFound) {
 		p.logger.Error("unable to delete transaction from cache", slog.String("hash", hash.String()), slog.String("err", err.Error()))
 	}
@@ -902,7 +1221,7 @@ func (p *Processor) updateTxStatus(ctx context.Context, statusUpdate store.Updat
 		return
 	}
 
-	if statusUpdateCount >= p.statusUpdatesBatchSize {
+	if statusUpdateCount >= p.memGuard.BatchSize(p.statusUpdatesBatchSize) {
 		err := p.checkAndUpdate(ctx)
 		if err != nil {
 			p.logger.Error("failed to check and update statuses", slog.String("err", err.Error()))
@@ -14,8 +14,11 @@ import (
 
 	"github.com/bitcoin-sv/arc/internal/cache"
 	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	"github.com/bitcoin-sv/arc/pkg/events"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
@@ -46,13 +49,15 @@ func (p *Processor) updateStatusMap(statusUpdate store.UpdateStatus) error {
 		currentStatusUpdate.CompetingTxs = mergeUnique(statusUpdate.CompetingTxs, currentStatusUpdate.CompetingTxs)
 	}
 
-	if shouldUpdateStatus(statusUpdate, *currentStatusUpdate) {
-		currentStatusUpdate.StatusHistory = append(currentStatusUpdate.StatusHistory, store.StatusWithTimestamp{
-			Status:    currentStatusUpdate.Status,
-			Timestamp: currentStatusUpdate.Timestamp,
-		})
-		currentStatusUpdate.Status = statusUpdate.Status
-		currentStatusUpdate.Timestamp = statusUpdate.Timestamp
+	previousStatus := currentStatusUpdate.Status
+	result := sequenceStatus(currentStatusUpdate, statusUpdate, p.lateStatusHistory)
+	p.observeSequenceResult(result, *currentStatusUpdate, statusUpdate)
+
+	if result == sequenceAdvanced || result == sequenceAdvancedCorrected {
+		err = p.statusMachine.Transition(statusUpdate.Hash, previousStatus, currentStatusUpdate.Status)
+		if err != nil {
+			return err
+		}
 	}
 
 	return p.setTransactionStatus(*currentStatusUpdate)
@@ -64,7 +69,7 @@ func (p *Processor) setTransactionStatus(status store.UpdateStatus) error {
 		return errors.Join(ErrFailedToSerialize, err)
 	}
 
-	err = p.cacheStore.MapSet(CacheStatusUpdateHash, status.Hash.String(), bytes)
+	err = p.cacheStore.MapSet(CacheStatusUpdateHash, hexutils.TxID(&status.Hash), bytes)
 	if err != nil {
 		return err
 	}
@@ -72,7 +77,7 @@ func (p *Processor) setTransactionStatus(status store.UpdateStatus) error {
 }
 
 func (p *Processor) getTransactionStatus(hash chainhash.Hash) (*store.UpdateStatus, error) {
-	bytes, err := p.cacheStore.MapGet(CacheStatusUpdateHash, hash.String())
+	bytes, err := p.cacheStore.MapGet(CacheStatusUpdateHash, hexutils.TxID(&hash))
 	if err != nil {
 		return nil, err
 	}
@@ -94,7 +99,8 @@ func (p *Processor) getAndDeleteAllTransactionStatuses() (StatusUpdateMap, error
 	}
 
 	for key, value := range keys {
-		hash, err := chainhash.NewHashFromStr(key)
+		var hash chainhash.Hash
+		err = hexutils.DecodeTxID(&hash, key)
 		if err != nil {
 			p.logger.Error("failed to convert hash from key", slog.String("error", err.Error()), slog.String("key", key))
 			continue
@@ -107,7 +113,7 @@ func (p *Processor) getAndDeleteAllTransactionStatuses() (StatusUpdateMap, error
 			continue
 		}
 
-		statuses[*hash] = status
+		statuses[hash] = status
 	}
 
 	return statuses, nil
@@ -123,7 +129,7 @@ func (p *Processor) getStatusUpdateCount() (int, error) {
 }
 
 func shouldUpdateCompetingTxs(newStatus, found store.UpdateStatus) bool {
-	if newStatus.Status >= found.Status && !unorderedEqual(newStatus.CompetingTxs, found.CompetingTxs) {
+	if (newStatus.Status == found.Status || canTransition(found.Status, newStatus.Status)) && !unorderedEqual(newStatus.CompetingTxs, found.CompetingTxs) {
 		return true
 	}
 
@@ -131,7 +137,7 @@ func shouldUpdateCompetingTxs(newStatus, found store.UpdateStatus) bool {
 }
 
 func shouldUpdateStatus(newStatus, found store.UpdateStatus) bool {
-	return newStatus.Status > found.Status
+	return canTransition(found.Status, newStatus.Status)
 }
 
 // unorderedEqual checks if two string slices contain
@@ -200,9 +206,12 @@ func toSendRequest(d *store.Data, timestamp time.Time) []*callbacker_api.SendReq
 	for _, c := range d.Callbacks {
 		if c.CallbackURL != "" {
 			routing := &callbacker_api.CallbackRouting{
-				Url:        c.CallbackURL,
-				Token:      c.CallbackToken,
-				AllowBatch: c.AllowBatch,
+				Url:               c.CallbackURL,
+				Token:             c.CallbackToken,
+				AllowBatch:        c.AllowBatch,
+				Version:           c.CallbackVersion,
+				AllowDuplicates:   c.AllowDuplicates,
+				SuppressionWindow: c.SuppressionWindow,
 			}
 
 			request := &callbacker_api.SendRequest{
@@ -216,6 +225,8 @@ func toSendRequest(d *store.Data, timestamp time.Time) []*callbacker_api.SendReq
 
 				BlockHash:   getCallbackBlockHash(d),
 				BlockHeight: d.BlockHeight,
+				MinedTxid:   getCallbackMinedTxID(d),
+				Tenant:      d.Tenant,
 
 				Timestamp: timestamppb.New(timestamp),
 			}
@@ -226,6 +237,27 @@ func toSendRequest(d *store.Data, timestamp time.Time) []*callbacker_api.SendReq
 	return requests
 }
 
+func toTxStatusChanged(d *store.Data, timestamp time.Time) events.TxStatusChanged {
+	return events.TxStatusChanged{
+		Hash:         d.Hash.String(),
+		Status:       d.Status.String(),
+		Tenant:       d.Tenant,
+		BlockHash:    getCallbackBlockHash(d),
+		BlockHeight:  d.BlockHeight,
+		RejectReason: d.RejectReason,
+		CompetingTxs: d.CompetingTxs,
+		Timestamp:    timestamp,
+	}
+}
+
+func getCallbackMinedTxID(d *store.Data) string {
+	if d.Status != metamorph_api.Status_MINED || d.MinedHash == nil {
+		return ""
+	}
+
+	return d.MinedHash.String()
+}
+
 func getCallbackExtraInfo(d *store.Data) string {
 	if d.Status == metamorph_api.Status_MINED && len(d.CompetingTxs) > 0 {
 		return minedDoubleSpendMsg
@@ -250,30 +282,21 @@ func getCallbackBlockHash(d *store.Data) string {
 	return d.BlockHash.String()
 }
 
+// StartRoutine adds the routine as job to the scheduler of the processor. The routine runs in the given interval unless
+// the schedule of the job is overridden by configuration.
 func (p *Processor) StartRoutine(tickerInterval time.Duration, routine func(context.Context, *Processor) []attribute.KeyValue, routineName string) {
-	ticker := time.NewTicker(tickerInterval)
-	p.waitGroup.Add(1)
-
-	go func() {
-		defer func() {
-			p.waitGroup.Done()
-			ticker.Stop()
-		}()
-
-		for {
-			select {
-			case <-p.ctx.Done():
-				return
-			case <-ticker.C:
-				ctx, span := tracing.StartTracing(p.ctx, routineName, p.tracingEnabled, p.tracingAttributes...)
-				attr := routine(ctx, p)
-				if span != nil && len(attr) > 0 {
-					span.SetAttributes(attr...)
-				}
-				tracing.EndTracing(span, nil)
-			}
+	err := p.scheduler.Add(routineName, scheduler.Every(tickerInterval), func(jobCtx context.Context) {
+		ctx, span := tracing.StartTracing(jobCtx, routineName, p.tracingEnabled, p.tracingAttributes...)
+		defer tracing.EndTracing(span, nil)
+
+		attr := routine(ctx, p)
+		if span != nil && len(attr) > 0 {
+			span.SetAttributes(attr...)
 		}
-	}()
+	})
+	if err != nil {
+		p.logger.Error("Failed to start routine", slog.String("routine", routineName), slog.String("err", err.Error()))
+	}
 }
 
 func txBytesFromHex(txs []string) ([][]byte, error) {
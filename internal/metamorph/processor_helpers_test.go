@@ -73,6 +73,17 @@ func TestShouldUpdateStatus(t *testing.T) {
 			expectedResultStatus:      false,
 			expectedResultCompetingTx: false,
 		},
+		{
+			name: "mined in stale block after reorg",
+			existingStatus: store.UpdateStatus{
+				Status: metamorph_api.Status_MINED,
+			},
+			newStatus: store.UpdateStatus{
+				Status: metamorph_api.Status_MINED_IN_STALE_BLOCK,
+			},
+			expectedResultStatus:      true,
+			expectedResultCompetingTx: false,
+		},
 		{
 			name: "statuses equal",
 			existingStatus: store.UpdateStatus{
@@ -9,8 +9,12 @@ import (
 
 	"github.com/bitcoin-sv/arc/internal/blocktx"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/feature"
+	"github.com/bitcoin-sv/arc/internal/memlimit"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	"github.com/bitcoin-sv/arc/pkg/events"
 )
 
 func WithStatTimeLimits(notSeenLimit time.Duration, notFinalLimit time.Duration) func(*Processor) {
@@ -208,3 +212,163 @@ func WithTrackOnly(trackOnly bool) func(*Processor) {
 		p.trackOnly = trackOnly
 	}
 }
+
+// WithPublishStatusUpdates configures the processor to publish the hashes of the transactions whose status has been
+// updated on the status update topic, e.g. to invalidate statuses cached by the API.
+func WithPublishStatusUpdates(enabled bool) func(*Processor) {
+	return func(p *Processor) {
+		p.publishStatusUpdates = enabled
+	}
+}
+
+// WithMalleabilityDetection configures the processor to store the normalized hashes of the submitted transactions and
+// to register them with blocktx, so that mined malleated variants of the transactions are detected.
+func WithMalleabilityDetection(enabled bool) func(*Processor) {
+	return func(p *Processor) {
+		p.malleabilityDetection = enabled
+	}
+}
+
+// WithStatusExporter configures the processor to export the status change events of the transactions with the
+// exporter in addition to the exporters configured before.
+func WithStatusExporter(exporter StatusExporter) func(*Processor) {
+	return func(p *Processor) {
+		p.statusExporters = append(p.statusExporters, exporter)
+	}
+}
+
+// WithEventBus publishes the status changes of the transactions as TxStatusChanged events on the bus.
+func WithEventBus(bus *events.Bus) func(*Processor) {
+	return func(p *Processor) {
+		p.eventBus = bus
+	}
+}
+
+// WithKnownTxFilter enables the in-memory filter of known transactions, which short-circuits the store and cache lookups
+// of transactions which are certainly unknown. The filter is built from the stored transactions every rebuild interval
+// and sized for the capacity of stored transactions at the false positive rate. Between rebuilds the filter only learns
+// the transactions stored by this processor and therefore requires the in-memory cache store, NewProcessor fails with
+// a shared cache store.
+func WithKnownTxFilter(capacity int, falsePositiveRate float64, rebuildInterval time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.knownTxFilter = newKnownTxFilter(capacity, falsePositiveRate, rebuildInterval)
+	}
+}
+
+// WithSLAReports computes the SLA reports per tenant of the current and the previous daily and weekly periods on the
+// interval.
+func WithSLAReports(interval time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.slaReports = true
+		p.slaReportsInterval = interval
+	}
+}
+
+// WithStatusReconciliation periodically reconciles the statuses of the transactions which have not been mined for the
+// stale period with the mempool and the chain of the node.
+func WithStatusReconciliation(node ReconciliationNode, interval time.Duration, staleAfter time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.reconciliationNode = node
+		p.reconcileInterval = interval
+		p.reconcileStaleAfter = staleAfter
+	}
+}
+
+// WithReconciliationOnStart reconciles the statuses once on start in addition to the periodic reconciliation, e.g.
+// after the databases were restored from a backup.
+func WithReconciliationOnStart() func(*Processor) {
+	return func(p *Processor) {
+		p.reconcileOnStart = true
+	}
+}
+
+// WithConsistencyCheck periodically checks that metamorph and blocktx agree on the transactions mined in the given
+// number of latest blocks, repairs the transactions which are not mined according to metamorph and flags the others.
+func WithConsistencyCheck(interval time.Duration, blocks uint64) func(*Processor) {
+	return func(p *Processor) {
+		p.consistencyCheck = true
+		p.consistencyCheckInterval = interval
+		p.consistencyCheckBlocks = blocks
+	}
+}
+
+// WithNodeSubmitFallback submits the transactions which no peer requested within the timeout after their announcement
+// to the node with sendrawtransaction. The unrequested transactions are checked on the interval.
+func WithNodeSubmitFallback(node FallbackNode, timeout time.Duration, interval time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.fallbackNode = node
+		p.nodeSubmitTimeout = timeout
+		p.nodeSubmitInterval = interval
+	}
+}
+
+// WithFeatureFlags disables the re-announcement of unseen and pending transactions while the flag feature.Rebroadcast
+// is off.
+func WithFeatureFlags(flags *feature.Flags) func(*Processor) {
+	return func(p *Processor) {
+		p.featureFlags = flags
+	}
+}
+
+// WithMemoryGuard shrinks the batch sizes and pauses consuming submitted transactions while the memory usage of the
+// process is close to its memory limit.
+func WithMemoryGuard(g *memlimit.Guard) func(*Processor) {
+	return func(p *Processor) {
+		p.memGuard = g
+	}
+}
+
+// WithBroadcastScheduledInterval sets the interval in which scheduled transactions whose broadcast time is reached are
+// announced to the network.
+func WithBroadcastScheduledInterval(d time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.broadcastScheduledInterval = d
+	}
+}
+
+// WithBroadcastFinalInterval sets the interval in which the transactions waiting for finality whose lock time is reached
+// are announced to the network.
+func WithBroadcastFinalInterval(d time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.broadcastFinalInterval = d
+	}
+}
+
+// WithExpireInterval sets the interval in which the transactions whose expiry is reached are changed to EXPIRED.
+func WithExpireInterval(d time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.expireInterval = d
+	}
+}
+
+// WithCancellationWindow delays the broadcast of all new transactions by the window, during which they can be
+// cancelled before they are announced to the network.
+func WithCancellationWindow(d time.Duration) func(*Processor) {
+	return func(p *Processor) {
+		p.cancellationWindow = d
+	}
+}
+
+// WithLateStatusHistory sets whether late lower-ranked statuses, e.g. SEEN_ON_NETWORK arriving after MINED, are
+// recorded in the status history of the transaction. They never regress the status, otherwise they are dropped.
+func WithLateStatusHistory(enabled bool) func(*Processor) {
+	return func(p *Processor) {
+		p.lateStatusHistory = enabled
+	}
+}
+
+// WithStatusTransitionHook registers the hook for the status transition of the transactions, AnyStatus as from or to
+// registers it for all transitions from or to a status.
+func WithStatusTransitionHook(from, to metamorph_api.Status, hook StatusTransitionHook) func(*Processor) {
+	return func(p *Processor) {
+		p.statusMachine.OnTransition(from, to, hook)
+	}
+}
+
+// WithJobConfigs overrides the schedule, jitter and pause state of the maintenance jobs of the processor by job name,
+// e.g. "ReAnnounceUnseen" or "CollectStats".
+func WithJobConfigs(configs map[string]scheduler.JobConfig) func(*Processor) {
+	return func(p *Processor) {
+		p.jobConfigs = configs
+	}
+}
@@ -12,18 +12,21 @@ import (
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/bitcoin-sv/arc/internal/blocktx"
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/feature"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
 )
 
 var (
 	ErrRejectUnconfirmed = errors.New("transaction rejected as not existing in node mempool")
+	ErrTxExpired         = errors.New("transaction expired before it was mined")
 )
 
 // ReAnnounceUnseen re-broadcasts transactions with status lower than SEEN_ON_NETWORK
 func ReAnnounceUnseen(ctx context.Context, p *Processor) []attribute.KeyValue {
-	if p.trackOnly { // tracking only: skip rebroadcast
+	if p.trackOnly || !p.featureFlags.Enabled(feature.Rebroadcast) { // tracking only or rebroadcast disabled: skip rebroadcast
 		return []attribute.KeyValue{attribute.Int("announced", 0), attribute.Int("requested", 0)}
 	}
 	// define from what point in time we are interested in unmined transactions
@@ -58,8 +61,9 @@ func ReAnnounceUnseen(ctx context.Context, p *Processor) []attribute.KeyValue {
 }
 
 func (p *Processor) reAnnounceUnseenTxs(ctx context.Context, unminedTxs []*store.Data) (int, int) {
-	requested := 0
+	requestedCount := 0
 	announced := 0
+	requested := p.requestedByPeers(ctx, unminedTxs)
 	for _, tx := range unminedTxs {
 		if tx.Retries > p.maxRetries {
 			continue
@@ -82,15 +86,170 @@ func (p *Processor) reAnnounceUnseenTxs(ctx context.Context, unminedTxs []*store
 			// Send GETDATA to peers to see if they have it
 			p.logger.Debug("Re-requesting unseen tx", slog.String("hash", tx.Hash.String()))
 			p.bcMediator.AskForTxAsync(ctx, tx)
-			requested++
+			requestedCount++
+			continue
+		}
+
+		requestedByPeers := requested[*tx.Hash]
+		if len(requestedByPeers) == 0 {
+			p.logger.Debug("Re-announcing unseen tx", slog.String("hash", tx.Hash.String()))
+			p.bcMediator.AnnounceTxAsync(ctx, tx)
+			announced++
+			continue
+		}
+
+		// peers which have already requested the transaction do not request it again after another announcement
+		peers := peersExcept(p.bcMediator.GetPeers(), requestedByPeers)
+		if len(peers) == 0 {
+			p.logger.Debug("Skipping re-announcement of unseen tx requested by all peers", slog.String("hash", tx.Hash.String()))
 			continue
 		}
 
-		p.logger.Debug("Re-announcing unseen tx", slog.String("hash", tx.Hash.String()))
-		p.bcMediator.AnnounceTxAsync(ctx, tx)
+		p.logger.Debug("Re-announcing unseen tx to peers which have not requested it", slog.String("hash", tx.Hash.String()), slog.Int("peers", len(peers)))
+		p.bcMediator.AnnounceTxToPeersAsync(ctx, tx, peers)
 		announced++
 	}
-	return announced, requested
+	return announced, requestedCount
+}
+
+// requestedByPeers returns per transaction the peers which have requested the transaction. If the acknowledgments
+// by peers cannot be loaded, the transactions are re-announced to all peers.
+func (p *Processor) requestedByPeers(ctx context.Context, txs []*store.Data) map[chainhash.Hash]map[string]struct{} {
+	hashes := make([][]byte, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash[:])
+	}
+
+	acks, err := p.store.GetPeerAcks(ctx, hashes)
+	if err != nil {
+		p.logger.Error("Failed to get peer acks", slog.String("err", err.Error()))
+		return nil
+	}
+
+	return requestedBy(acks)
+}
+
+// StorePeerAcks stores the requests of transactions by peers and the sending of transactions to peers
+func StorePeerAcks(ctx context.Context, p *Processor) []attribute.KeyValue {
+	acks := p.peerAcks.extract()
+	if len(acks) == 0 {
+		return []attribute.KeyValue{attribute.Int("acks", 0)}
+	}
+
+	err := p.store.SetPeerAcks(ctx, acks)
+	if err != nil {
+		p.logger.Error("Failed to store peer acks", slog.Int("count", len(acks)), slog.String("err", err.Error()))
+	}
+
+	return []attribute.KeyValue{attribute.Int("acks", len(acks))}
+}
+
+// BroadcastScheduled announces the scheduled transactions whose broadcast time is reached
+func BroadcastScheduled(ctx context.Context, p *Processor) []attribute.KeyValue {
+	if p.trackOnly { // tracking only: transactions are never broadcast
+		return []attribute.KeyValue{attribute.Int("announced", 0)}
+	}
+
+	announced := 0
+	for {
+		dueTxs, err := p.store.GetDueScheduled(ctx, p.now(), loadLimit)
+		if err != nil {
+			p.logger.Error("Failed to get due scheduled transactions", slog.String("err", err.Error()))
+			break
+		}
+
+		for _, tx := range dueTxs {
+			p.bcMediator.AskForTxAsync(ctx, tx)
+			p.bcMediator.AnnounceTxAsync(ctx, tx)
+
+			p.storageStatusUpdateCh <- store.UpdateStatus{
+				Hash:      *tx.Hash,
+				Status:    metamorph_api.Status_ANNOUNCED_TO_NETWORK,
+				Timestamp: p.now(),
+			}
+		}
+
+		announced += len(dueTxs)
+		if int64(len(dueTxs)) < loadLimit {
+			break
+		}
+	}
+
+	if announced > 0 {
+		p.logger.Info("Broadcast scheduled transactions", slog.Int("announced", announced))
+	}
+
+	return []attribute.KeyValue{attribute.Int("announced", announced)}
+}
+
+// BroadcastFinal announces the transactions waiting for finality whose lock time is reached by the height of the next
+// block or the median time past of the chain tip.
+func BroadcastFinal(ctx context.Context, p *Processor) []attribute.KeyValue {
+	if p.trackOnly { // tracking only: transactions are never broadcast
+		return []attribute.KeyValue{attribute.Int("announced", 0)}
+	}
+
+	nextHeight, medianTimePast, err := blocktx.LockTimeBounds(ctx, p.blocktxClient)
+	if err != nil {
+		p.logger.Error("Failed to get lock time bounds", slog.String("err", err.Error()))
+		return []attribute.KeyValue{attribute.Int("announced", 0)}
+	}
+
+	announced := 0
+	for {
+		finalTxs, err := p.store.GetFinal(ctx, nextHeight, medianTimePast, p.now(), loadLimit)
+		if err != nil {
+			p.logger.Error("Failed to get final transactions", slog.String("err", err.Error()))
+			break
+		}
+
+		for _, tx := range finalTxs {
+			p.bcMediator.AskForTxAsync(ctx, tx)
+			p.bcMediator.AnnounceTxAsync(ctx, tx)
+
+			p.storageStatusUpdateCh <- store.UpdateStatus{
+				Hash:      *tx.Hash,
+				Status:    metamorph_api.Status_ANNOUNCED_TO_NETWORK,
+				Timestamp: p.now(),
+			}
+		}
+
+		announced += len(finalTxs)
+		if int64(len(finalTxs)) < loadLimit {
+			break
+		}
+	}
+
+	if announced > 0 {
+		p.logger.Info("Broadcast final transactions", slog.Int("announced", announced), slog.Uint64("next-height", nextHeight), slog.Time("median-time-past", medianTimePast))
+	}
+
+	return []attribute.KeyValue{attribute.Int("announced", announced), attribute.Int64("next-height", int64(nextHeight))} // #nosec G115
+}
+
+// ExpireTransactions changes the status of the transactions whose expiry is reached to EXPIRED, which stops their
+// re-broadcasting. The callbacks of the expired transactions are sent with the expiry as reason.
+func ExpireTransactions(ctx context.Context, p *Processor) []attribute.KeyValue {
+	expiredTxs, err := p.store.GetExpired(ctx, p.now(), loadLimit)
+	if err != nil {
+		p.logger.Error("Failed to get expired transactions", slog.String("err", err.Error()))
+		return []attribute.KeyValue{attribute.Int("expired", 0)}
+	}
+
+	for _, hash := range expiredTxs {
+		p.storageStatusUpdateCh <- store.UpdateStatus{
+			Hash:      *hash,
+			Status:    metamorph_api.Status_EXPIRED,
+			Error:     ErrTxExpired,
+			Timestamp: p.now(),
+		}
+	}
+
+	if len(expiredTxs) > 0 {
+		p.logger.Info("Expired transactions", slog.Int("count", len(expiredTxs)))
+	}
+
+	return []attribute.KeyValue{attribute.Int("expired", len(expiredTxs))}
 }
 
 // RejectUnconfirmedRequested finds transactions which have been requested, but not confirmed by any node and rejects them
@@ -154,7 +313,7 @@ func RejectUnconfirmedRequested(ctx context.Context, p *Processor) []attribute.K
 
 // ReAnnounceSeen re-broadcasts and re-requests SEEN_ON_NETWORK transactions that have been pending
 func ReAnnounceSeen(ctx context.Context, p *Processor) []attribute.KeyValue {
-	if p.trackOnly { // do not re-announce in track-only
+	if p.trackOnly || !p.featureFlags.Enabled(feature.Rebroadcast) { // do not re-announce in track-only or with rebroadcast disabled
 		return []attribute.KeyValue{attribute.Int("announced", 0)}
 	}
 	var offset int64
@@ -271,7 +430,7 @@ func ProcessDoubleSpendTxs(ctx context.Context, p *Processor) []attribute.KeyVal
 		// if ANY of those competing txs gets mined we reject this one
 		for _, competingTx := range competingTxStatuses {
 			if competingTx.Mined {
-				_, err := p.store.UpdateStatus(ctx, []store.UpdateStatus{
+				updatedData, err := p.store.UpdateStatus(ctx, []store.UpdateStatus{
 					{
 						Hash:         *doubleSpendTx.Hash,
 						Status:       metamorph_api.Status_REJECTED,
@@ -285,6 +444,7 @@ func ProcessDoubleSpendTxs(ctx context.Context, p *Processor) []attribute.KeyVal
 					continue
 				}
 				totalRejected++
+				p.publishUpdatedStatuses(ctx, updatedData)
 				p.logger.Info("Double spend tx rejected", slog.String("hash", doubleSpendTx.Hash.String()), slog.String("competing mined tx hash", hex.EncodeToString(competingTx.Hash)))
 				break
 			}
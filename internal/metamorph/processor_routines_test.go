@@ -16,11 +16,16 @@ import (
 
 func TestStartRoutine(t *testing.T) {
 	tt := []struct {
-		name string
+		name   string
+		panics bool
 	}{
 		{
 			name: "start routine",
 		},
+		{
+			name:   "panicking routine is recovered",
+			panics: true,
+		},
 	}
 
 	for _, tc := range tt {
@@ -43,6 +48,9 @@ func TestStartRoutine(t *testing.T) {
 			require.NoError(t, err)
 
 			testFunc := func(_ context.Context, _ *metamorph.Processor) []attribute.KeyValue {
+				if tc.panics {
+					panic("routine failed")
+				}
 				time.Sleep(200 * time.Millisecond)
 
 				return []attribute.KeyValue{attribute.Int("atr", 5)}
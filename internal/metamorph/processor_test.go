@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -25,6 +26,7 @@ import (
 	btxMocks "github.com/bitcoin-sv/arc/internal/blocktx/mocks"
 	"github.com/bitcoin-sv/arc/internal/cache"
 	cacheMocks "github.com/bitcoin-sv/arc/internal/cache/mocks"
+	"github.com/bitcoin-sv/arc/internal/feature"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
@@ -33,7 +35,10 @@ import (
 	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	p2pMocks "github.com/bitcoin-sv/arc/internal/p2p/mocks"
 	"github.com/bitcoin-sv/arc/internal/testdata"
+	"github.com/bitcoin-sv/arc/pkg/events"
 )
 
 func TestNewProcessor(t *testing.T) {
@@ -151,10 +156,13 @@ func TestStartLockTransactions(t *testing.T) {
 
 func TestProcessTransaction(t *testing.T) {
 	tt := []struct {
-		name            string
-		storeData       *store.Data
-		storeDataGetErr error
-		registerTxErr   error
+		name               string
+		storeData          *store.Data
+		storeDataGetErr    error
+		registerTxErr      error
+		broadcastAt        time.Time
+		lockTime           uint32
+		cancellationWindow time.Duration
 
 		expectedResponses     []metamorph_api.Status
 		expectedSetCalls      int
@@ -190,6 +198,46 @@ func TestProcessTransaction(t *testing.T) {
 			expectedRequestCalls:  1,
 			expectedPublishCalls:  1,
 		},
+		{
+			name:            "record not found - scheduled broadcast",
+			storeData:       nil,
+			storeDataGetErr: store.ErrNotFound,
+			broadcastAt:     time.Now().Add(time.Hour),
+
+			expectedResponses: []metamorph_api.Status{
+				metamorph_api.Status_STORED,
+			},
+			expectedSetCalls:      1,
+			expectedAnnounceCalls: 0,
+			expectedRequestCalls:  0,
+		},
+		{
+			name:            "record not found - waiting for finality",
+			storeData:       nil,
+			storeDataGetErr: store.ErrNotFound,
+			lockTime:        800_000,
+
+			expectedResponses: []metamorph_api.Status{
+				metamorph_api.Status_STORED,
+				metamorph_api.Status_WAITING_FOR_FINALITY,
+			},
+			expectedSetCalls:      1,
+			expectedAnnounceCalls: 0,
+			expectedRequestCalls:  0,
+		},
+		{
+			name:               "record not found - cancellation window",
+			storeData:          nil,
+			storeDataGetErr:    store.ErrNotFound,
+			cancellationWindow: 5 * time.Second,
+
+			expectedResponses: []metamorph_api.Status{
+				metamorph_api.Status_STORED,
+			},
+			expectedSetCalls:      1,
+			expectedAnnounceCalls: 0,
+			expectedRequestCalls:  0,
+		},
 		{
 			name: "record found",
 			storeData: &store.Data{
@@ -263,14 +311,18 @@ func TestProcessTransaction(t *testing.T) {
 			}
 
 			publisher := &mqMocks.MessageQueueClientMock{
-				PublishAsyncFunc: func(_ string, _ []byte) error {
+				PublishMarshalAsyncFunc: func(_ context.Context, _ string, _ proto.Message) error {
 					return nil
 				},
 			}
 
 			blocktxClient := &btxMocks.ClientMock{RegisterTransactionFunc: func(_ context.Context, _ []byte) error { return tc.registerTxErr }}
 
-			sut, err := metamorph.NewProcessor(s, cStore, messenger, nil, metamorph.WithMessageQueueClient(publisher), metamorph.WithBlocktxClient(blocktxClient))
+			sut, err := metamorph.NewProcessor(s, cStore, messenger, nil,
+				metamorph.WithMessageQueueClient(publisher),
+				metamorph.WithBlocktxClient(blocktxClient),
+				metamorph.WithCancellationWindow(tc.cancellationWindow),
+			)
 			require.NoError(t, err)
 			require.Equal(t, 0, sut.GetProcessorMapSize())
 
@@ -293,7 +345,9 @@ func TestProcessTransaction(t *testing.T) {
 			sut.ProcessTransaction(context.Background(),
 				&metamorph.ProcessorRequest{
 					Data: &store.Data{
-						Hash: testdata.TX1Hash,
+						Hash:        testdata.TX1Hash,
+						BroadcastAt: tc.broadcastAt,
+						LockTime:    tc.lockTime,
 					},
 					ResponseChannel: responseChannel,
 				})
@@ -303,7 +357,7 @@ func TestProcessTransaction(t *testing.T) {
 			require.Equal(t, tc.expectedSetCalls, len(s.SetCalls()))
 			require.Equal(t, tc.expectedAnnounceCalls, len(messenger.AnnounceTxAsyncCalls()))
 			require.Equal(t, tc.expectedRequestCalls, len(messenger.AskForTxAsyncCalls()))
-			require.Equal(t, tc.expectedPublishCalls, len(publisher.PublishAsyncCalls()))
+			require.Equal(t, tc.expectedPublishCalls, len(publisher.PublishMarshalAsyncCalls()))
 		})
 	}
 }
@@ -597,14 +651,155 @@ func TestStartSendStatusForTransaction(t *testing.T) {
 	}
 }
 
+// sharedCacheStore stands in for a cache store shared by several processors, e.g. redis.
+type sharedCacheStore struct {
+	cache.Store
+}
+
+func TestKnownTxFilterSharedCacheStore(t *testing.T) {
+	// given
+	sharedStore := sharedCacheStore{Store: cache.NewMemoryStore()}
+
+	metamorphStore := &storeMocks.MetamorphStoreMock{
+		GetFunc: func(_ context.Context, _ []byte) (*store.Data, error) {
+			return nil, store.ErrNotFound
+		},
+		SetFunc: func(_ context.Context, _ *store.Data) error {
+			return nil
+		},
+		UpdateStatusFunc: func(_ context.Context, _ []store.UpdateStatus) ([]*store.Data, error) {
+			return nil, nil
+		},
+		UpdateStatusHistoryFunc: func(_ context.Context, _ []store.UpdateStatus) ([]*store.Data, error) {
+			return nil, nil
+		},
+		SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+	}
+	messenger := &mocks.MediatorMock{
+		AskForTxAsyncFunc:   func(_ context.Context, _ *store.Data) {},
+		AnnounceTxAsyncFunc: func(_ context.Context, _ *store.Data) {},
+	}
+	mqClient := &mqMocks.MessageQueueClientMock{
+		PublishMarshalFunc: func(_ context.Context, _ string, _ protoreflect.ProtoMessage) error {
+			return nil
+		},
+		PublishMarshalAsyncFunc: func(_ context.Context, _ string, _ proto.Message) error {
+			return nil
+		},
+	}
+	blocktxClient := &btxMocks.ClientMock{RegisterTransactionFunc: func(_ context.Context, _ []byte) error { return nil }}
+
+	// when
+	_, err := metamorph.NewProcessor(metamorphStore, sharedStore, messenger, nil,
+		metamorph.WithKnownTxFilter(1000, 0.01, time.Minute),
+	)
+
+	// then
+	require.ErrorIs(t, err, metamorph.ErrKnownTxFilterSharedCache)
+
+	// given
+	localProcessor, err := metamorph.NewProcessor(metamorphStore, cache.NewMemoryStore(), messenger, nil,
+		metamorph.WithKnownTxFilter(1000, 0.01, time.Minute),
+	)
+	require.NoError(t, err)
+	localProcessor.Shutdown()
+
+	submittingProcessor, err := metamorph.NewProcessor(metamorphStore, sharedStore, messenger, nil,
+		metamorph.WithMessageQueueClient(mqClient),
+		metamorph.WithBlocktxClient(blocktxClient),
+	)
+	require.NoError(t, err)
+	defer submittingProcessor.Shutdown()
+
+	statusMessageChannel := make(chan *metamorph_p2p.TxStatusMessage, 10)
+	receivingProcessor, err := metamorph.NewProcessor(metamorphStore, sharedStore, messenger, statusMessageChannel,
+		metamorph.WithStatusUpdatesInterval(200*time.Millisecond),
+		metamorph.WithMessageQueueClient(mqClient),
+	)
+	require.NoError(t, err)
+	defer receivingProcessor.Shutdown()
+
+	// when
+	submittingProcessor.ProcessTransaction(context.Background(), &metamorph.ProcessorRequest{
+		Data:            &store.Data{Hash: testdata.TX1Hash},
+		ResponseChannel: make(chan metamorph.StatusAndError, 10),
+	})
+
+	receivingProcessor.StartProcessStatusUpdatesInStorage()
+	receivingProcessor.StartSendStatusUpdate()
+	statusMessageChannel <- &metamorph_p2p.TxStatusMessage{
+		Hash:   testdata.TX1Hash,
+		Status: metamorph_api.Status_SEEN_ON_NETWORK,
+	}
+
+	time.Sleep(300 * time.Millisecond)
+
+	// then
+	updateStatusCalls := metamorphStore.UpdateStatusCalls()
+	require.Len(t, updateStatusCalls, 1)
+	require.Equal(t, *testdata.TX1Hash, updateStatusCalls[0].Updates[0].Hash)
+}
+
+func TestProcessTransactionKnownTxFilter(t *testing.T) {
+	// given
+	metamorphStore := &storeMocks.MetamorphStoreMock{
+		GetHashesFunc: func(_ context.Context, _ []byte, _ int64) ([][]byte, error) {
+			return [][]byte{testdata.TX2Hash[:]}, nil
+		},
+		GetFunc: func(_ context.Context, _ []byte) (*store.Data, error) {
+			return &store.Data{Hash: testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK}, nil
+		},
+		SetFunc: func(_ context.Context, _ *store.Data) error {
+			return nil
+		},
+		SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+	}
+	messenger := &mocks.MediatorMock{
+		AskForTxAsyncFunc:   func(_ context.Context, _ *store.Data) {},
+		AnnounceTxAsyncFunc: func(_ context.Context, _ *store.Data) {},
+	}
+	blocktxClient := &btxMocks.ClientMock{RegisterTransactionFunc: func(_ context.Context, _ []byte) error { return nil }}
+
+	sut, err := metamorph.NewProcessor(metamorphStore, cache.NewMemoryStore(), messenger, nil,
+		metamorph.WithBlocktxClient(blocktxClient),
+		metamorph.WithKnownTxFilter(1000, 0.01, time.Hour),
+	)
+	require.NoError(t, err)
+	defer sut.Shutdown()
+
+	sut.StartRebuildKnownTxFilter()
+	require.Eventually(t, func() bool {
+		return len(metamorphStore.GetHashesCalls()) == 1
+	}, time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+
+	// when
+	sut.ProcessTransaction(context.Background(), &metamorph.ProcessorRequest{
+		Data:            &store.Data{Hash: testdata.TX1Hash},
+		ResponseChannel: make(chan metamorph.StatusAndError, 10),
+	})
+	sut.ProcessTransaction(context.Background(), &metamorph.ProcessorRequest{
+		Data:            &store.Data{Hash: testdata.TX2Hash},
+		ResponseChannel: make(chan metamorph.StatusAndError, 10),
+	})
+
+	// then
+	// the unknown tx skips the store lookup, the stored tx is looked up
+	require.Len(t, metamorphStore.GetCalls(), 1)
+	require.Equal(t, testdata.TX2Hash[:], metamorphStore.GetCalls()[0].Key)
+	require.Len(t, metamorphStore.SetCalls(), 2)
+}
+
 func TestStartProcessSubmitted(t *testing.T) {
 	tt := []struct {
-		name   string
-		txReqs []*metamorph_api.PostTransactionRequest
+		name                  string
+		txReqs                []*metamorph_api.PostTransactionRequest
+		malleabilityDetection bool
 
 		expectedSetBulkCalls  int32
 		expectedAnnounceCalls int32
 		expcetedUpdates       int
+		expectedRegisterCalls int
 	}{
 		{
 			name: "2 submitted txs",
@@ -626,6 +821,30 @@ func TestStartProcessSubmitted(t *testing.T) {
 			expectedSetBulkCalls:  1,
 			expectedAnnounceCalls: 2,
 			expcetedUpdates:       2,
+			expectedRegisterCalls: 2,
+		},
+		{
+			name: "2 submitted txs - malleability detection",
+			txReqs: []*metamorph_api.PostTransactionRequest{
+				{
+					CallbackUrl:   "callback-1.example.com",
+					CallbackToken: "token-1",
+					RawTx:         testdata.TX1Raw.Bytes(),
+					WaitForStatus: metamorph_api.Status_RECEIVED,
+				},
+				{
+					CallbackUrl:   "callback-2.example.com",
+					CallbackToken: "token-2",
+					RawTx:         testdata.TX6Raw.Bytes(),
+					WaitForStatus: metamorph_api.Status_RECEIVED,
+				},
+			},
+			malleabilityDetection: true,
+
+			expectedSetBulkCalls:  1,
+			expectedAnnounceCalls: 2,
+			expcetedUpdates:       2,
+			expectedRegisterCalls: 4,
 		},
 		{
 			name: "5 submitted txs",
@@ -665,6 +884,7 @@ func TestStartProcessSubmitted(t *testing.T) {
 			expectedSetBulkCalls:  2,
 			expectedAnnounceCalls: 5,
 			expcetedUpdates:       5,
+			expectedRegisterCalls: 5,
 		},
 	}
 
@@ -702,6 +922,7 @@ func TestStartProcessSubmitted(t *testing.T) {
 				metamorph.WithProcessTransactionsInterval(20*time.Millisecond),
 				metamorph.WithProcessTransactionsBatchSize(4),
 				metamorph.WithBlocktxClient(blocktxClient),
+				metamorph.WithMalleabilityDetection(tc.malleabilityDetection),
 			)
 			require.NoError(t, err)
 			require.Equal(t, 0, sut.GetProcessorMapSize())
@@ -726,18 +947,27 @@ func TestStartProcessSubmitted(t *testing.T) {
 
 			assert.Equal(t, tc.expectedSetBulkCalls, l)
 			assert.Equal(t, tc.expectedAnnounceCalls, announceMsgCounter.Load())
+			assert.Len(t, blocktxClient.RegisterTransactionCalls(), tc.expectedRegisterCalls)
+
+			for _, data := range s.SetBulkCalls()[0].Data {
+				assert.Equal(t, tc.malleabilityDetection, data.NormalizedHash != nil)
+			}
 		})
 	}
 }
 
 func TestReAnnounceUnseen(t *testing.T) {
 	tt := []struct {
-		name          string
-		retries       int
-		getUnminedErr error
-
-		expectedRequests      int
-		expectedAnnouncements int
+		name                string
+		retries             int
+		getUnminedErr       error
+		rebroadcastDisabled bool
+		requestedBy         []string
+		getPeerAcksErr      error
+
+		expectedRequests          int
+		expectedAnnouncements     int
+		expectedPeerAnnouncements int
 	}{
 		{
 			name:    "expired txs",
@@ -746,6 +976,41 @@ func TestReAnnounceUnseen(t *testing.T) {
 			expectedAnnouncements: 1,
 			expectedRequests:      1,
 		},
+		{
+			name:        "expired txs - requested by some peers",
+			retries:     4,
+			requestedBy: []string{"peer-1"},
+
+			expectedAnnouncements:     0,
+			expectedPeerAnnouncements: 1,
+			expectedRequests:          1,
+		},
+		{
+			name:        "expired txs - requested by all peers",
+			retries:     4,
+			requestedBy: []string{"peer-1", "peer-2"},
+
+			expectedAnnouncements:     0,
+			expectedPeerAnnouncements: 0,
+			expectedRequests:          1,
+		},
+		{
+			name:           "expired txs - failed to get peer acks",
+			retries:        4,
+			requestedBy:    []string{"peer-1"},
+			getPeerAcksErr: errors.New("failed to get peer acks"),
+
+			expectedAnnouncements: 1,
+			expectedRequests:      1,
+		},
+		{
+			name:                "expired txs - rebroadcast disabled",
+			retries:             4,
+			rebroadcastDisabled: true,
+
+			expectedAnnouncements: 0,
+			expectedRequests:      0,
+		},
 		{
 			name:    "expired txs - max retries exceeded",
 			retries: 16,
@@ -798,6 +1063,13 @@ func TestReAnnounceUnseen(t *testing.T) {
 					retries++
 					return nil
 				},
+				GetPeerAcksFunc: func(_ context.Context, _ [][]byte) ([]store.PeerAck, error) {
+					acks := make([]store.PeerAck, 0, len(tc.requestedBy))
+					for _, peer := range tc.requestedBy {
+						acks = append(acks, store.PeerAck{Hash: *testdata.TX5Hash, Peer: peer, RequestedAt: time.Now()})
+					}
+					return acks, tc.getPeerAcksErr
+				},
 			}
 
 			cStore := &cacheMocks.StoreMock{
@@ -806,20 +1078,30 @@ func TestReAnnounceUnseen(t *testing.T) {
 				},
 			}
 
+			peers := []p2p.PeerI{
+				&p2pMocks.PeerIMock{StringFunc: func() string { return "peer-1" }, ConnectedFunc: func() bool { return true }},
+				&p2pMocks.PeerIMock{StringFunc: func() string { return "peer-2" }, ConnectedFunc: func() bool { return true }},
+			}
 			messenger := &mocks.MediatorMock{
-				AskForTxAsyncFunc:   func(_ context.Context, _ *store.Data) {},
-				AnnounceTxAsyncFunc: func(_ context.Context, _ *store.Data) {},
+				AskForTxAsyncFunc:          func(_ context.Context, _ *store.Data) {},
+				AnnounceTxAsyncFunc:        func(_ context.Context, _ *store.Data) {},
+				AnnounceTxToPeersAsyncFunc: func(_ context.Context, _ *store.Data, _ []p2p.PeerI) {},
+				GetPeersFunc:               func() []p2p.PeerI { return peers },
 			}
 
 			publisher := &mqMocks.MessageQueueClientMock{
-				PublishAsyncFunc: func(_ string, _ []byte) error {
+				PublishMarshalAsyncFunc: func(_ context.Context, _ string, _ proto.Message) error {
 					return nil
 				},
 			}
 
+			flags, err := feature.New(slog.Default(), map[string]bool{string(feature.Rebroadcast): !tc.rebroadcastDisabled})
+			require.NoError(t, err)
+
 			sut, err := metamorph.NewProcessor(metamorphStore, cStore, messenger, nil,
 				metamorph.WithMessageQueueClient(publisher),
 				metamorph.WithMaxRetries(10),
+				metamorph.WithFeatureFlags(flags),
 				metamorph.WithNow(func() time.Time {
 					return time.Date(2033, 1, 1, 1, 0, 0, 0, time.UTC)
 				}))
@@ -835,7 +1117,230 @@ func TestReAnnounceUnseen(t *testing.T) {
 
 			// then
 			require.Equal(t, tc.expectedAnnouncements, len(messenger.AnnounceTxAsyncCalls()))
+			require.Equal(t, tc.expectedPeerAnnouncements, len(messenger.AnnounceTxToPeersAsyncCalls()))
 			require.Equal(t, tc.expectedRequests, len(messenger.AskForTxAsyncCalls()))
+			for _, call := range messenger.AnnounceTxToPeersAsyncCalls() {
+				require.Len(t, call.Peers, 1)
+				require.Equal(t, "peer-2", call.Peers[0].String())
+			}
+		})
+	}
+}
+
+func TestBroadcastScheduled(t *testing.T) {
+	tt := []struct {
+		name               string
+		trackOnly          bool
+		getDueScheduledErr error
+
+		expectedAnnouncements int
+		expectedGetCalls      int
+	}{
+		{
+			name: "success",
+
+			expectedAnnouncements: 2,
+			expectedGetCalls:      1,
+		},
+		{
+			name:      "track only",
+			trackOnly: true,
+
+			expectedAnnouncements: 0,
+			expectedGetCalls:      0,
+		},
+		{
+			name:               "error - get due scheduled",
+			getDueScheduledErr: errors.New("failed to get due scheduled"),
+
+			expectedAnnouncements: 0,
+			expectedGetCalls:      1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2033, 1, 1, 1, 0, 0, 0, time.UTC)
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetDueScheduledFunc: func(_ context.Context, dueAt time.Time, _ int64) ([]*store.Data, error) {
+					require.Equal(t, now, dueAt)
+					if tc.getDueScheduledErr != nil {
+						return nil, tc.getDueScheduledErr
+					}
+
+					return []*store.Data{
+						{Hash: testdata.TX4Hash, Status: metamorph_api.Status_STORED},
+						{Hash: testdata.TX5Hash, Status: metamorph_api.Status_STORED},
+					}, nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			messenger := &mocks.MediatorMock{
+				AskForTxAsyncFunc:   func(_ context.Context, _ *store.Data) {},
+				AnnounceTxAsyncFunc: func(_ context.Context, _ *store.Data) {},
+			}
+
+			sut, err := metamorph.NewProcessor(metamorphStore, nil, messenger, nil,
+				metamorph.WithTrackOnly(tc.trackOnly),
+				metamorph.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			metamorph.BroadcastScheduled(context.TODO(), sut)
+
+			// then
+			require.Len(t, metamorphStore.GetDueScheduledCalls(), tc.expectedGetCalls)
+			require.Len(t, messenger.AnnounceTxAsyncCalls(), tc.expectedAnnouncements)
+			require.Len(t, messenger.AskForTxAsyncCalls(), tc.expectedAnnouncements)
+		})
+	}
+}
+
+func TestBroadcastFinal(t *testing.T) {
+	tt := []struct {
+		name            string
+		trackOnly       bool
+		latestBlocksErr error
+		getFinalErr     error
+
+		expectedAnnouncements int
+		expectedGetCalls      int
+	}{
+		{
+			name: "success",
+
+			expectedAnnouncements: 2,
+			expectedGetCalls:      1,
+		},
+		{
+			name:      "track only",
+			trackOnly: true,
+
+			expectedAnnouncements: 0,
+			expectedGetCalls:      0,
+		},
+		{
+			name:            "error - latest blocks",
+			latestBlocksErr: errors.New("failed to get latest blocks"),
+
+			expectedAnnouncements: 0,
+			expectedGetCalls:      0,
+		},
+		{
+			name:        "error - get final",
+			getFinalErr: errors.New("failed to get final"),
+
+			expectedAnnouncements: 0,
+			expectedGetCalls:      1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2033, 1, 1, 1, 0, 0, 0, time.UTC)
+			tipTime := time.Date(2033, 1, 1, 0, 30, 0, 0, time.UTC)
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetFinalFunc: func(_ context.Context, nextHeight uint64, medianTimePast time.Time, dueAt time.Time, _ int64) ([]*store.Data, error) {
+					require.Equal(t, uint64(101), nextHeight)
+					require.Equal(t, tipTime, medianTimePast.UTC())
+					require.Equal(t, now, dueAt)
+					if tc.getFinalErr != nil {
+						return nil, tc.getFinalErr
+					}
+
+					return []*store.Data{
+						{Hash: testdata.TX4Hash, Status: metamorph_api.Status_WAITING_FOR_FINALITY},
+						{Hash: testdata.TX5Hash, Status: metamorph_api.Status_WAITING_FOR_FINALITY},
+					}, nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			blocktxClient := &btxMocks.ClientMock{
+				LatestBlocksFunc: func(_ context.Context, _ uint64) (*blocktx_api.LatestBlocksResponse, error) {
+					return &blocktx_api.LatestBlocksResponse{Blocks: []*blocktx_api.Block{
+						{Height: 100, Timestamp: timestamppb.New(tipTime)},
+					}}, tc.latestBlocksErr
+				},
+			}
+
+			messenger := &mocks.MediatorMock{
+				AskForTxAsyncFunc:   func(_ context.Context, _ *store.Data) {},
+				AnnounceTxAsyncFunc: func(_ context.Context, _ *store.Data) {},
+			}
+
+			sut, err := metamorph.NewProcessor(metamorphStore, nil, messenger, nil,
+				metamorph.WithTrackOnly(tc.trackOnly),
+				metamorph.WithNow(func() time.Time { return now }),
+				metamorph.WithBlocktxClient(blocktxClient),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			metamorph.BroadcastFinal(context.TODO(), sut)
+
+			// then
+			require.Len(t, metamorphStore.GetFinalCalls(), tc.expectedGetCalls)
+			require.Len(t, messenger.AnnounceTxAsyncCalls(), tc.expectedAnnouncements)
+			require.Len(t, messenger.AskForTxAsyncCalls(), tc.expectedAnnouncements)
+		})
+	}
+}
+
+func TestExpireTransactions(t *testing.T) {
+	tt := []struct {
+		name          string
+		getExpiredErr error
+
+		expectedExpired attribute.KeyValue
+	}{
+		{
+			name: "success",
+
+			expectedExpired: attribute.Int("expired", 2),
+		},
+		{
+			name:          "error - get expired",
+			getExpiredErr: errors.New("failed to get expired"),
+
+			expectedExpired: attribute.Int("expired", 0),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2033, 1, 1, 1, 0, 0, 0, time.UTC)
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetExpiredFunc: func(_ context.Context, expiredAt time.Time, _ int64) ([]*chainhash.Hash, error) {
+					require.Equal(t, now, expiredAt)
+					if tc.getExpiredErr != nil {
+						return nil, tc.getExpiredErr
+					}
+
+					return []*chainhash.Hash{testdata.TX4Hash, testdata.TX5Hash}, nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			sut, err := metamorph.NewProcessor(metamorphStore, nil, &mocks.MediatorMock{}, nil,
+				metamorph.WithNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			actual := metamorph.ExpireTransactions(context.TODO(), sut)
+
+			// then
+			require.Len(t, metamorphStore.GetExpiredCalls(), 1)
+			assert.Equal(t, []attribute.KeyValue{tc.expectedExpired}, actual)
 		})
 	}
 }
@@ -847,9 +1352,14 @@ func TestStartProcessMinedCallbacks(t *testing.T) {
 		updateMinedErr        error
 		processMinedBatchSize int
 		processMinedInterval  time.Duration
-
-		expectedTxsBlocks         int
-		expectedSendCallbackCalls int
+		publishStatusUpdates  bool
+		exportStatusEvents    bool
+
+		expectedTxsBlocks          int
+		expectedSendCallbackCalls  int
+		expectedStatusUpdateEvents int
+		expectedExportCalls        int
+		expectedStatusChanges      int32
 	}{
 		{
 			name:                  "success - batch size reached",
@@ -867,6 +1377,27 @@ func TestStartProcessMinedCallbacks(t *testing.T) {
 			expectedTxsBlocks:         4,
 			expectedSendCallbackCalls: 2,
 		},
+		{
+			name:                  "success - status updates published",
+			processMinedBatchSize: 3,
+			processMinedInterval:  20 * time.Second,
+			publishStatusUpdates:  true,
+
+			expectedTxsBlocks:          3,
+			expectedSendCallbackCalls:  2,
+			expectedStatusUpdateEvents: 1,
+		},
+		{
+			name:                  "success - status events exported",
+			processMinedBatchSize: 3,
+			processMinedInterval:  20 * time.Second,
+			exportStatusEvents:    true,
+
+			expectedTxsBlocks:         3,
+			expectedSendCallbackCalls: 2,
+			expectedExportCalls:       1,
+			expectedStatusChanges:     3,
+		},
 		{
 			name:                  "error - updated mined",
 			updateMinedErr:        errors.New("update failed"),
@@ -902,6 +1433,13 @@ func TestStartProcessMinedCallbacks(t *testing.T) {
 				PublishMarshalFunc: func(_ context.Context, _ string, _ protoreflect.ProtoMessage) error {
 					return nil
 				},
+				PublishMarshalCoreFunc: func(_ context.Context, topic string, m protoreflect.ProtoMessage) error {
+					require.Equal(t, mq.StatusUpdateTopic, topic)
+					txs, ok := m.(*blocktx_api.Transactions)
+					require.True(t, ok)
+					require.Len(t, txs.GetTransactions(), 3)
+					return nil
+				},
 			}
 
 			cStore := &cacheMocks.StoreMock{
@@ -909,16 +1447,34 @@ func TestStartProcessMinedCallbacks(t *testing.T) {
 					return nil
 				},
 			}
-			sut, err := metamorph.NewProcessor(
-				metamorphStore,
-				cStore,
-				pm,
-				nil,
+			statusExporter := &mocks.StatusExporterMock{
+				ExportFunc: func(data []*store.Data) error {
+					require.Len(t, data, 3)
+					return nil
+				},
+			}
+
+			opts := []metamorph.Option{
 				metamorph.WithMinedTxsChan(minedTxsChan),
 				metamorph.WithCallbackSender(callbackSender),
 				metamorph.WithProcessMinedBatchSize(tc.processMinedBatchSize),
 				metamorph.WithProcessMinedInterval(tc.processMinedInterval),
 				metamorph.WithMessageQueueClient(mqClient),
+				metamorph.WithPublishStatusUpdates(tc.publishStatusUpdates),
+			}
+			eventBus := events.New(slog.Default())
+			var statusChanges atomic.Int32
+			events.Subscribe(eventBus, "status-changes", func(_ events.TxStatusChanged) { statusChanges.Add(1) })
+			if tc.exportStatusEvents {
+				opts = append(opts, metamorph.WithStatusExporter(statusExporter), metamorph.WithEventBus(eventBus))
+			}
+
+			sut, err := metamorph.NewProcessor(
+				metamorphStore,
+				cStore,
+				pm,
+				nil,
+				opts...,
 			)
 			require.NoError(t, err)
 
@@ -933,9 +1489,13 @@ func TestStartProcessMinedCallbacks(t *testing.T) {
 
 			time.Sleep(50 * time.Millisecond)
 			sut.Shutdown()
+			eventBus.Shutdown()
 
 			// then
 			require.Equal(t, tc.expectedSendCallbackCalls, len(mqClient.PublishMarshalCalls()))
+			require.Equal(t, tc.expectedStatusUpdateEvents, len(mqClient.PublishMarshalCoreCalls()))
+			require.Equal(t, tc.expectedExportCalls, len(statusExporter.ExportCalls()))
+			require.Equal(t, tc.expectedStatusChanges, statusChanges.Load())
 		})
 	}
 }
@@ -1407,8 +1967,12 @@ func TestProcessDoubleSpendTxs(t *testing.T) {
 				GetDoubleSpendTxsFunc: func(_ context.Context, _ time.Time) ([]*store.Data, error) {
 					return tc.doubleSpendTxs, nil
 				},
-				UpdateStatusFunc: func(_ context.Context, _ []store.UpdateStatus) ([]*store.Data, error) {
-					return nil, nil
+				UpdateStatusFunc: func(_ context.Context, updates []store.UpdateStatus) ([]*store.Data, error) {
+					updatedData := make([]*store.Data, 0, len(updates))
+					for _, update := range updates {
+						updatedData = append(updatedData, &store.Data{Hash: &update.Hash, Status: update.Status})
+					}
+					return updatedData, nil
 				},
 			}
 			pm := &mocks.MediatorMock{}
@@ -1417,6 +1981,16 @@ func TestProcessDoubleSpendTxs(t *testing.T) {
 					return tc.minedTxs, nil
 				},
 			}
+			mqClient := &mqMocks.MessageQueueClientMock{
+				PublishMarshalCoreFunc: func(_ context.Context, topic string, m protoreflect.ProtoMessage) error {
+					require.Equal(t, mq.StatusUpdateTopic, topic)
+					txs, ok := m.(*blocktx_api.Transactions)
+					require.True(t, ok)
+					require.Len(t, txs.GetTransactions(), 1)
+					require.Equal(t, testdata.TX1Hash[:], txs.GetTransactions()[0].GetHash())
+					return nil
+				},
+			}
 
 			statusMessageChannel := make(chan *metamorph_p2p.TxStatusMessage, 10)
 
@@ -1428,6 +2002,8 @@ func TestProcessDoubleSpendTxs(t *testing.T) {
 				statusMessageChannel,
 				metamorph.WithRejectPendingSeenEnabled(true),
 				metamorph.WithBlocktxClient(blockTxClient),
+				metamorph.WithMessageQueueClient(mqClient),
+				metamorph.WithPublishStatusUpdates(true),
 			)
 			require.NoError(t, err)
 
@@ -1436,6 +2012,7 @@ func TestProcessDoubleSpendTxs(t *testing.T) {
 
 			// then
 			assert.Equal(t, tc.rejected, len(metamorphStore.UpdateStatusCalls()))
+			assert.Equal(t, tc.rejected, len(mqClient.PublishMarshalCoreCalls()))
 		})
 	}
 }
@@ -1545,10 +2122,10 @@ func TestStart(t *testing.T) {
 
 			cStore := &cacheMocks.StoreMock{}
 
-			var subscribeMinedTxsFunction func([]byte) error
-			var subscribeSubmitTxsFunction func([]byte) error
+			var subscribeMinedTxsFunction func(context.Context, []byte) error
+			var subscribeSubmitTxsFunction func(context.Context, []byte) error
 			mqClient := &mqMocks.MessageQueueClientMock{
-				ConsumeFunc: func(topic string, msgFunc func([]byte) error) error {
+				ConsumeFunc: func(topic string, msgFunc func(context.Context, []byte) error) error {
 					subscribeSubmitTxsFunction = msgFunc
 
 					err, ok := tc.topicErr[topic]
@@ -1557,7 +2134,7 @@ func TestStart(t *testing.T) {
 					}
 					return nil
 				},
-				QueueSubscribeFunc: func(topic string, msgFunc func([]byte) error) error {
+				QueueSubscribeFunc: func(topic string, msgFunc func(context.Context, []byte) error) error {
 					subscribeMinedTxsFunction = msgFunc
 
 					err, ok := tc.topicErr[topic]
@@ -1593,14 +2170,14 @@ func TestStart(t *testing.T) {
 			data, err := proto.Marshal(txBlock)
 			require.NoError(t, err)
 
-			_ = subscribeMinedTxsFunction([]byte("invalid data"))
-			_ = subscribeMinedTxsFunction(data)
+			_ = subscribeMinedTxsFunction(context.Background(), []byte("invalid data"))
+			_ = subscribeMinedTxsFunction(context.Background(), data)
 
 			txRequest := &metamorph_api.PostTransactionRequest{}
 			data, err = proto.Marshal(txRequest)
 			require.NoError(t, err)
-			_ = subscribeSubmitTxsFunction([]byte("invalid data"))
-			_ = subscribeSubmitTxsFunction(data)
+			_ = subscribeSubmitTxsFunction(context.Background(), []byte("invalid data"))
+			_ = subscribeSubmitTxsFunction(context.Background(), data)
 
 			sut.Shutdown()
 		})
@@ -0,0 +1,327 @@
+package metamorph
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	sdkChainhash "github.com/bsv-blockchain/go-sdk/chainhash"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/ccoveille/go-safecast"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
+)
+
+const (
+	reconcileIntervalDefault   = 10 * time.Minute
+	reconcileStaleAfterDefault = 10 * time.Minute
+
+	DriftSeen    = "seen"
+	DriftMined   = "mined"
+	DriftMissing = "missing"
+)
+
+// ReconciliationNode is the node against which the statuses of the transactions are reconciled. The index of all
+// transactions (`txindex=1`) has to be enabled on the node, so that mined transactions are found by
+// GetRawTransactionsVerbose.
+type ReconciliationNode interface {
+	GetRawMempool(ctx context.Context) ([]string, error)
+	GetRawTransactionsVerbose(ctx context.Context, txIDs []string) ([]*rpc_client.VerboseRawTransaction, error)
+	GetBlockHeaders(ctx context.Context, blockHashes []string) ([]*rpc_client.BlockHeader, error)
+	GetMerkleProofs(ctx context.Context, requests []rpc_client.MerkleProofRequest) ([]*rpc_client.MerkleProof, error)
+}
+
+// ReconcileStatuses cross-checks the statuses of the transactions, which have not been mined for the stale period,
+// against the mempool and the chain of the node and corrects divergences:
+//   - transactions with a status lower than SEEN_ON_NETWORK which are in the mempool of the node are updated to
+//     SEEN_ON_NETWORK
+//   - transactions which are mined according to the node are updated to MINED with the block hash, the block height
+//     and the merkle path built from the merkle proof of the node
+//   - SEEN_ON_NETWORK transactions which are unknown to the node are announced again
+//
+// Callbacks are sent for the corrected statuses as for any other status update.
+func ReconcileStatuses(ctx context.Context, p *Processor) []attribute.KeyValue {
+	txIDs, err := p.reconciliationNode.GetRawMempool(ctx)
+	if err != nil {
+		p.logger.Error("Failed to get mempool of node for reconciliation", slog.String("err", err.Error()))
+		return nil
+	}
+
+	mempool := make(map[string]struct{}, len(txIDs))
+	for _, txID := range txIDs {
+		mempool[txID] = struct{}{}
+	}
+
+	drift := map[string]int{}
+	staleSince := p.now().Add(-1 * p.reconcileStaleAfter)
+	var offset int64
+
+	for {
+		unseenTxs, err := p.store.GetUnseen(ctx, p.now().Add(-1*p.rebroadcastExpiration), loadLimit, offset)
+		if err != nil {
+			p.logger.Error("Failed to get unseen transactions for reconciliation", slog.String("err", err.Error()))
+			break
+		}
+
+		offset += loadLimit
+		if len(unseenTxs) == 0 {
+			break
+		}
+
+		var stale []*store.Data
+		for _, tx := range unseenTxs {
+			if tx.StoredAt.Before(staleSince) {
+				stale = append(stale, tx)
+			}
+		}
+
+		p.reconcileTxs(ctx, stale, mempool, drift)
+	}
+
+	offset = 0
+	for {
+		seenTxs, err := p.store.GetSeen(ctx, p.rebroadcastExpiration, p.reconcileStaleAfter, loadLimit, offset)
+		if err != nil {
+			p.logger.Error("Failed to get seen transactions for reconciliation", slog.String("err", err.Error()))
+			break
+		}
+
+		offset += loadLimit
+		if len(seenTxs) == 0 {
+			break
+		}
+
+		p.reconcileTxs(ctx, seenTxs, mempool, drift)
+	}
+
+	for kind, count := range drift {
+		p.stats.reconciliationDrift.WithLabelValues(kind).Add(float64(count))
+	}
+
+	if len(drift) > 0 {
+		p.logger.Warn("Reconciled transaction statuses with node", slog.Int(DriftSeen, drift[DriftSeen]), slog.Int(DriftMined, drift[DriftMined]), slog.Int(DriftMissing, drift[DriftMissing]))
+	}
+
+	return []attribute.KeyValue{attribute.Int(DriftSeen, drift[DriftSeen]), attribute.Int(DriftMined, drift[DriftMined]), attribute.Int(DriftMissing, drift[DriftMissing])}
+}
+
+func (p *Processor) reconcileTxs(ctx context.Context, txs []*store.Data, mempool map[string]struct{}, drift map[string]int) {
+	var notInMempool []*store.Data
+	var inMempool []*store.Data
+
+	for _, tx := range txs {
+		if _, found := mempool[tx.Hash.String()]; found {
+			inMempool = append(inMempool, tx)
+			continue
+		}
+		notInMempool = append(notInMempool, tx)
+	}
+
+	var minedTxs []*store.Data
+	var minedBlockHashes []string
+
+	if len(notInMempool) > 0 {
+		txIDs := make([]string, len(notInMempool))
+		for i, tx := range notInMempool {
+			txIDs[i] = tx.Hash.String()
+		}
+
+		rawTxs, err := p.reconciliationNode.GetRawTransactionsVerbose(ctx, txIDs)
+		if err != nil {
+			p.logger.Error("Failed to get transactions from node for reconciliation", slog.String("err", err.Error()))
+			notInMempool = nil
+		}
+
+		for i, tx := range notInMempool {
+			rawTx := rawTxs[i]
+			switch {
+			case rawTx == nil:
+				// the transaction is neither in the mempool nor in the chain of the node
+				if tx.Status == metamorph_api.Status_SEEN_ON_NETWORK {
+					p.reAnnounceMissingTx(ctx, tx)
+					drift[DriftMissing]++
+				}
+			case rawTx.BlockHash != "":
+				p.logger.Debug("Tx mined according to node", slog.String("hash", txIDs[i]), slog.String("blockHash", rawTx.BlockHash))
+				minedTxs = append(minedTxs, tx)
+				minedBlockHashes = append(minedBlockHashes, rawTx.BlockHash)
+				drift[DriftMined]++
+			default:
+				// the transaction entered the mempool of the node after the mempool was read
+				inMempool = append(inMempool, tx)
+			}
+		}
+	}
+
+	for _, tx := range inMempool {
+		if tx.Status >= metamorph_api.Status_SEEN_ON_NETWORK {
+			continue
+		}
+
+		p.logger.Debug("Tx in mempool of node", slog.String("hash", tx.Hash.String()), slog.String("status", tx.Status.String()))
+		p.storageStatusUpdateCh <- store.UpdateStatus{
+			Hash:      *tx.Hash,
+			Status:    metamorph_api.Status_SEEN_ON_NETWORK,
+			Timestamp: p.now(),
+		}
+		drift[DriftSeen]++
+	}
+
+	if len(minedTxs) == 0 {
+		return
+	}
+
+	txsBlocks, err := p.getTxsBlocks(ctx, minedTxs, minedBlockHashes)
+	if err != nil {
+		p.logger.Error("Failed to get blocks of mined txs from node", slog.String("err", err.Error()))
+		return
+	}
+
+	if len(txsBlocks) > 0 {
+		p.updateMined(ctx, txsBlocks)
+	}
+}
+
+// reAnnounceMissingTx announces a SEEN_ON_NETWORK transaction which is unknown to the node again, so that the node
+// requests it. Transactions are not announced if metamorph only tracks transactions.
+func (p *Processor) reAnnounceMissingTx(ctx context.Context, tx *store.Data) {
+	if p.trackOnly {
+		p.logger.Debug("Seen tx unknown to node", slog.String("hash", tx.Hash.String()))
+		return
+	}
+
+	err := p.saveTxToCache(tx.Hash)
+	if err != nil {
+		p.logger.Error("Failed to store tx in cache", slog.String("hash", tx.Hash.String()), slog.String("err", err.Error()))
+	}
+
+	p.logger.Debug("Re-announcing seen tx unknown to node", slog.String("hash", tx.Hash.String()))
+	p.bcMediator.AnnounceTxAsync(ctx, tx)
+}
+
+// getTxsBlocks gets the heights of the blocks and the merkle proofs of the transactions in the blocks from the node and
+// returns the blocks of the transactions whose merkle paths match the merkle roots of the blocks.
+func (p *Processor) getTxsBlocks(ctx context.Context, txs []*store.Data, blockHashes []string) ([]*blocktx_api.TransactionBlock, error) {
+	uniqueBlockHashes := make([]string, 0, len(blockHashes))
+	for _, blockHash := range blockHashes {
+		if !slices.Contains(uniqueBlockHashes, blockHash) {
+			uniqueBlockHashes = append(uniqueBlockHashes, blockHash)
+		}
+	}
+
+	headers, err := p.reconciliationNode.GetBlockHeaders(ctx, uniqueBlockHashes)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeaders := make(map[string]*rpc_client.BlockHeader, len(headers))
+	for i, header := range headers {
+		if header != nil {
+			blockHeaders[uniqueBlockHashes[i]] = header
+		}
+	}
+
+	requests := make([]rpc_client.MerkleProofRequest, len(txs))
+	for i, tx := range txs {
+		requests[i] = rpc_client.MerkleProofRequest{BlockHash: blockHashes[i], TxID: tx.Hash.String()}
+	}
+
+	proofs, err := p.reconciliationNode.GetMerkleProofs(ctx, requests)
+	if err != nil {
+		return nil, err
+	}
+
+	txsBlocks := make([]*blocktx_api.TransactionBlock, 0, len(txs))
+	for i, tx := range txs {
+		txID := tx.Hash.String()
+
+		header, found := blockHeaders[blockHashes[i]]
+		if !found || proofs[i] == nil {
+			p.logger.Error("Failed to get block header or merkle proof of mined tx from node", slog.String("hash", txID), slog.String("blockHash", blockHashes[i]))
+			continue
+		}
+
+		merklePath, err := merklePathFromProof(proofs[i], header.Height)
+		if err != nil {
+			p.logger.Error("Failed to create merkle path from merkle proof", slog.String("hash", txID), slog.String("err", err.Error()))
+			continue
+		}
+
+		root, err := merklePath.ComputeRootHex(&txID)
+		if err != nil || root != header.MerkleRoot {
+			p.logger.Error("Merkle path of mined tx does not match merkle root of block", slog.String("hash", txID), slog.String("blockHash", blockHashes[i]))
+			continue
+		}
+
+		blockHash, err := chainhash.NewHashFromStr(blockHashes[i])
+		if err != nil {
+			p.logger.Error("Invalid block hash of mined tx", slog.String("hash", txID), slog.String("blockHash", blockHashes[i]))
+			continue
+		}
+
+		txsBlocks = append(txsBlocks, &blocktx_api.TransactionBlock{
+			BlockHash:       blockHash[:],
+			BlockHeight:     header.Height,
+			TransactionHash: tx.Hash[:],
+			MerklePath:      merklePath.Hex(),
+			BlockStatus:     blocktx_api.Status_LONGEST,
+		})
+	}
+
+	return txsBlocks, nil
+}
+
+// merklePathFromProof converts the merkle proof in the TSC format to a merkle path in the BUMP format.
+func merklePathFromProof(proof *rpc_client.MerkleProof, blockHeight uint64) (*sdkTx.MerklePath, error) {
+	if len(proof.Nodes) == 0 {
+		return nil, errors.New("merkle proof without nodes")
+	}
+
+	height, err := safecast.ToUint32(blockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := sdkChainhash.NewHashFromHex(proof.TxOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	isTxID := true
+	isDuplicate := true
+	offset := proof.Index
+	path := make([][]*sdkTx.PathElement, len(proof.Nodes))
+
+	for level, node := range proof.Nodes {
+		sibling := &sdkTx.PathElement{Offset: offset ^ 1}
+		if node == "*" {
+			sibling.Duplicate = &isDuplicate
+		} else {
+			sibling.Hash, err = sdkChainhash.NewHashFromHex(node)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		path[level] = []*sdkTx.PathElement{sibling}
+		if level == 0 {
+			leaf := &sdkTx.PathElement{Offset: offset, Hash: txID, Txid: &isTxID}
+			if offset%2 == 0 {
+				path[level] = []*sdkTx.PathElement{leaf, sibling}
+			} else {
+				path[level] = []*sdkTx.PathElement{sibling, leaf}
+			}
+		}
+
+		offset >>= 1
+	}
+
+	return sdkTx.NewMerklePath(height, path), nil
+}
@@ -0,0 +1,212 @@
+package metamorph_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdkChainhash "github.com/bsv-blockchain/go-sdk/chainhash"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
+)
+
+func TestReconcileStatuses(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// block of 3 transactions in which TX3 is at index 2, the hash of TX3 is paired with itself
+	tx0, err := sdkChainhash.NewHashFromHex(testdata.TX6Hash.String())
+	require.NoError(t, err)
+	tx1, err := sdkChainhash.NewHashFromHex(testdata.Block2Hash.String())
+	require.NoError(t, err)
+	tx3, err := sdkChainhash.NewHashFromHex(testdata.TX3Hash.String())
+	require.NoError(t, err)
+	left := sdkTx.MerkleTreeParent(tx0, tx1)
+	merkleRoot := sdkTx.MerkleTreeParent(left, sdkTx.MerkleTreeParent(tx3, tx3))
+
+	tt := []struct {
+		name       string
+		mempoolErr error
+		rawTxsErr  error
+		trackOnly  bool
+		merkleRoot string
+
+		expectedAttributes []attribute.KeyValue
+		expectedRawTxCalls int
+		expectedMined      int
+		expectedAnnounced  int
+	}{
+		{
+			name:       "success",
+			merkleRoot: merkleRoot.String(),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.DriftSeen, 1),
+				attribute.Int(metamorph.DriftMined, 1),
+				attribute.Int(metamorph.DriftMissing, 1),
+			},
+			expectedRawTxCalls: 1,
+			expectedMined:      1,
+			expectedAnnounced:  1,
+		},
+		{
+			name:       "track only - missing tx is not announced",
+			trackOnly:  true,
+			merkleRoot: merkleRoot.String(),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.DriftSeen, 1),
+				attribute.Int(metamorph.DriftMined, 1),
+				attribute.Int(metamorph.DriftMissing, 1),
+			},
+			expectedRawTxCalls: 1,
+			expectedMined:      1,
+		},
+		{
+			name:       "merkle path does not match merkle root",
+			merkleRoot: testdata.Block1Hash.String(),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.DriftSeen, 1),
+				attribute.Int(metamorph.DriftMined, 1),
+				attribute.Int(metamorph.DriftMissing, 1),
+			},
+			expectedRawTxCalls: 1,
+			expectedAnnounced:  1,
+		},
+		{
+			name:      "failed to get transactions",
+			rawTxsErr: errors.New("connection refused"),
+
+			expectedAttributes: []attribute.KeyValue{
+				attribute.Int(metamorph.DriftSeen, 1),
+				attribute.Int(metamorph.DriftMined, 0),
+				attribute.Int(metamorph.DriftMissing, 0),
+			},
+			expectedRawTxCalls: 1,
+		},
+		{
+			name:       "failed to get mempool",
+			mempoolErr: errors.New("connection refused"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			node := &mocks.ReconciliationNodeMock{
+				GetRawMempoolFunc: func(_ context.Context) ([]string, error) {
+					return []string{testdata.TX1Hash.String(), testdata.TX5Hash.String()}, tc.mempoolErr
+				},
+				GetRawTransactionsVerboseFunc: func(_ context.Context, txIDs []string) ([]*rpc_client.VerboseRawTransaction, error) {
+					if tc.rawTxsErr != nil {
+						return nil, tc.rawTxsErr
+					}
+
+					rawTxs := make([]*rpc_client.VerboseRawTransaction, len(txIDs))
+					for i, txID := range txIDs {
+						if txID == testdata.TX3Hash.String() {
+							rawTxs[i] = &rpc_client.VerboseRawTransaction{TxID: txID, BlockHash: testdata.Block1Hash.String()}
+						}
+					}
+					return rawTxs, nil
+				},
+				GetBlockHeadersFunc: func(_ context.Context, blockHashes []string) ([]*rpc_client.BlockHeader, error) {
+					require.Equal(t, []string{testdata.Block1Hash.String()}, blockHashes)
+					return []*rpc_client.BlockHeader{{Hash: blockHashes[0], Height: 100, MerkleRoot: tc.merkleRoot}}, nil
+				},
+				GetMerkleProofsFunc: func(_ context.Context, requests []rpc_client.MerkleProofRequest) ([]*rpc_client.MerkleProof, error) {
+					require.Equal(t, []rpc_client.MerkleProofRequest{{BlockHash: testdata.Block1Hash.String(), TxID: testdata.TX3Hash.String()}}, requests)
+					return []*rpc_client.MerkleProof{{Index: 2, TxOrID: tx3.String(), Target: testdata.Block1Hash.String(), Nodes: []string{"*", left.String()}}}, nil
+				},
+			}
+
+			var minedBlocks []*blocktx_api.TransactionBlock
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetUnseenFunc: func(_ context.Context, _ time.Time, _ int64, offset int64) ([]*store.Data, error) {
+					if offset > 0 {
+						return nil, nil
+					}
+					return []*store.Data{
+						// stale and in the mempool of the node
+						{Hash: testdata.TX1Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, StoredAt: now.Add(-time.Hour)},
+						// recently stored
+						{Hash: testdata.TX2Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, StoredAt: now.Add(-time.Minute)},
+					}, nil
+				},
+				GetSeenFunc: func(_ context.Context, _ time.Duration, _ time.Duration, _ int64, offset int64) ([]*store.Data, error) {
+					if offset > 0 {
+						return nil, nil
+					}
+					return []*store.Data{
+						// mined according to the node
+						{Hash: testdata.TX3Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+						// unknown to the node
+						{Hash: testdata.TX4Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+						// in the mempool of the node
+						{Hash: testdata.TX5Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+					}, nil
+				},
+				UpdateMinedFunc: func(_ context.Context, txsBlocks []*blocktx_api.TransactionBlock) ([]*store.Data, error) {
+					minedBlocks = append(minedBlocks, txsBlocks...)
+					return nil, nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			mediator := &mocks.MediatorMock{
+				AnnounceTxAsyncFunc: func(_ context.Context, _ *store.Data) {},
+			}
+
+			sut, err := metamorph.NewProcessor(
+				metamorphStore,
+				cache.NewMemoryStore(),
+				mediator,
+				nil,
+				metamorph.WithNow(func() time.Time { return now }),
+				metamorph.WithStatusReconciliation(node, time.Minute, 10*time.Minute),
+				metamorph.WithTrackOnly(tc.trackOnly),
+			)
+			require.NoError(t, err)
+
+			// when
+			actual := metamorph.ReconcileStatuses(context.TODO(), sut)
+
+			// then
+			assert.Equal(t, tc.expectedAttributes, actual)
+			assert.Len(t, node.GetRawTransactionsVerboseCalls(), tc.expectedRawTxCalls)
+			assert.Len(t, mediator.AnnounceTxAsyncCalls(), tc.expectedAnnounced)
+			if tc.expectedAnnounced > 0 {
+				assert.Equal(t, testdata.TX4Hash, mediator.AnnounceTxAsyncCalls()[0].Tx.Hash)
+			}
+
+			require.Len(t, minedBlocks, tc.expectedMined)
+			if tc.expectedMined == 0 {
+				return
+			}
+
+			assert.Equal(t, testdata.Block1Hash[:], minedBlocks[0].GetBlockHash())
+			assert.Equal(t, uint64(100), minedBlocks[0].GetBlockHeight())
+			assert.Equal(t, testdata.TX3Hash[:], minedBlocks[0].GetTransactionHash())
+
+			merklePath, err := sdkTx.NewMerklePathFromHex(minedBlocks[0].GetMerklePath())
+			require.NoError(t, err)
+			assert.Equal(t, uint32(100), merklePath.BlockHeight)
+			root, err := merklePath.ComputeRoot(tx3)
+			require.NoError(t, err)
+			assert.Equal(t, merkleRoot, root)
+		})
+	}
+}
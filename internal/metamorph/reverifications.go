@@ -0,0 +1,227 @@
+package metamorph
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/pkg/tracing"
+)
+
+var (
+	ErrReverificationsFull = errors.New("maximum number of unverified transactions reached")
+	ErrRejectReasonEmpty   = errors.New("rejection requires a reason")
+)
+
+// AddReverification keeps the BEEF whose Merkle roots could not be verified on submission until the API has verified
+// them again. If one of its transactions is already re-verified, the existing reverification is returned instead.
+func (s *Server) AddReverification(ctx context.Context, req *metamorph_api.AddReverificationRequest) (result *metamorph_api.Reverification, err error) {
+	ctx, span := tracing.StartTracing(ctx, "AddReverification", s.tracingEnabled, s.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	hashes, err := toHashes(req.GetReverification().GetTxids())
+	if err != nil {
+		return nil, err
+	}
+
+	reverification, err := s.store.AddReverification(ctx, store.Reverification{
+		Mode:    req.GetReverification().GetMode(),
+		Beef:    req.GetReverification().GetBeef(),
+		Hashes:  hashes,
+		Options: req.GetReverification().GetOptions(),
+		Tenant:  req.GetReverification().GetTenant(),
+		AddedAt: s.now(),
+	}, req.GetMaxTransactions())
+	if err != nil {
+		if errors.Is(err, store.ErrReverificationsFull) {
+			return nil, ErrReverificationsFull
+		}
+		s.logger.ErrorContext(ctx, "failed to add reverification", slog.Int("txs", len(hashes)), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	return toReverificationProto(reverification), nil
+}
+
+// GetReverifications returns the reverifications which are not re-verified by another API instance and locks them
+// until the requested time.
+func (s *Server) GetReverifications(ctx context.Context, req *metamorph_api.GetReverificationsRequest) (result *metamorph_api.Reverifications, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetReverifications", s.tracingEnabled, s.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	reverifications, err := s.store.GetReverifications(ctx, s.now(), req.GetLockedUntil().AsTime(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	unverified, err := s.store.CountUnverified(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result = &metamorph_api.Reverifications{
+		Reverifications: make([]*metamorph_api.Reverification, 0, len(reverifications)),
+		UnverifiedTxs:   unverified,
+	}
+	for _, reverification := range reverifications {
+		result.Reverifications = append(result.Reverifications, toReverificationProto(reverification))
+	}
+
+	return result, nil
+}
+
+// DeleteReverification deletes the reverification once its transactions are verified or given up on.
+func (s *Server) DeleteReverification(ctx context.Context, req *metamorph_api.ReverificationRequest) (*emptypb.Empty, error) {
+	err := s.store.DelReverification(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetUnverifiedTransactions returns those of the transactions whose Merkle roots are re-verified.
+func (s *Server) GetUnverifiedTransactions(ctx context.Context, req *metamorph_api.TransactionsStatusRequest) (*metamorph_api.UnverifiedTransactions, error) {
+	hashes, err := toHashes(req.GetTxIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	unverified, err := s.store.GetUnverified(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &metamorph_api.UnverifiedTransactions{TxIDs: make([]string, 0, len(unverified))}
+	for _, hash := range unverified {
+		h, err := chainhash.NewHash(hash)
+		if err != nil {
+			continue
+		}
+		result.TxIDs = append(result.TxIDs, h.String())
+	}
+
+	return result, nil
+}
+
+// RejectTransactions stores the transactions, which were never broadcast, as rejected for the reason and sends their
+// callbacks, e.g. deferred transactions whose Merkle roots could not be verified. Transactions which are already
+// stored keep their status.
+func (s *Server) RejectTransactions(ctx context.Context, req *metamorph_api.RejectTransactionsRequest) (result *metamorph_api.TransactionStatuses, err error) {
+	ctx, span := tracing.StartTracing(ctx, "RejectTransactions", s.tracingEnabled, s.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	reason := strings.TrimSpace(req.GetRejectReason())
+	if reason == "" {
+		return nil, ErrRejectReasonEmpty
+	}
+
+	now := s.now()
+	keys := make([][]byte, 0, len(req.GetTransactions()))
+	rejected := make([]*store.Data, 0, len(req.GetTransactions()))
+	for _, txReq := range req.GetTransactions() {
+		hash := PtrTo(chainhash.DoubleHashH(txReq.GetRawTx()))
+		data := requestToStoreData(hash, metamorph_api.Status_REJECTED, txReq)
+		data.RejectReason = reason
+		data.StoredAt = now
+		data.LastSubmittedAt = now
+		data.LockedBy = "NONE"
+		data.StatusHistory = []*store.StatusWithTimestamp{{Status: metamorph_api.Status_REJECTED, Timestamp: now}}
+
+		keys = append(keys, hash[:])
+		rejected = append(rejected, data)
+	}
+
+	stored, err := s.store.GetMany(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[chainhash.Hash]*store.Data, len(stored))
+	for _, data := range stored {
+		known[*data.Hash] = data
+	}
+
+	toStore := make([]*store.Data, 0, len(rejected))
+	for _, data := range rejected {
+		if _, found := known[*data.Hash]; !found {
+			toStore = append(toStore, data)
+		}
+	}
+
+	if len(toStore) > 0 {
+		err = s.store.SetBulk(ctx, toStore)
+		if err != nil {
+			s.logger.ErrorContext(ctx, "failed to store rejected transactions", slog.Int("txs", len(toStore)), slog.String("err", err.Error()))
+			return nil, err
+		}
+		s.publishUpdatedStatuses(ctx, toStore)
+	}
+
+	result = &metamorph_api.TransactionStatuses{Statuses: make([]*metamorph_api.TransactionStatus, 0, len(rejected))}
+	for _, data := range rejected {
+		if storedData, found := known[*data.Hash]; found {
+			data = storedData
+		} else if s.callbackSender != nil && len(data.Callbacks) > 0 {
+			s.callbackSender.SendCallback(ctx, data)
+		}
+
+		result.Statuses = append(result.Statuses, &metamorph_api.TransactionStatus{
+			Txid:         data.Hash.String(),
+			Status:       data.Status,
+			RejectReason: data.RejectReason,
+			StoredAt:     timestamppb.New(data.StoredAt),
+		})
+	}
+
+	s.logger.InfoContext(ctx, "Rejected transactions", slog.Int("requested", len(rejected)), slog.Int("rejected", len(toStore)), slog.String("reason", reason))
+
+	return result, nil
+}
+
+func toHashes(txIDs []string) ([][]byte, error) {
+	hashes := make([][]byte, 0, len(txIDs))
+	for _, txID := range txIDs {
+		hash, err := chainhash.NewHashFromStr(txID)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash[:])
+	}
+
+	return hashes, nil
+}
+
+func toReverificationProto(reverification store.Reverification) *metamorph_api.Reverification {
+	txIDs := make([]string, 0, len(reverification.Hashes))
+	for _, hash := range reverification.Hashes {
+		h, err := chainhash.NewHash(hash)
+		if err != nil {
+			continue
+		}
+		txIDs = append(txIDs, h.String())
+	}
+
+	return &metamorph_api.Reverification{
+		Id:      reverification.ID,
+		Mode:    reverification.Mode,
+		Beef:    reverification.Beef,
+		Txids:   txIDs,
+		Options: reverification.Options,
+		Tenant:  reverification.Tenant,
+		AddedAt: timestamppb.New(reverification.AddedAt),
+	}
+}
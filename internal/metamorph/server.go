@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"log/slog"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -17,16 +19,21 @@ import (
 	"github.com/ordishs/go-bitcoin"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
 	"github.com/bitcoin-sv/arc/internal/mq"
 	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	"github.com/bitcoin-sv/arc/internal/slareport"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
@@ -36,10 +43,24 @@ const (
 	MaxTimeout                 = 30
 	deadlineExtension          = 1 * time.Second * MaxTimeout
 	minusDeadlineExtension     = -1 * deadlineExtension
+	// statusLookupTimeout is the time granted to look up the best-known status of a transaction after the deadline of
+	// the request was exceeded
+	statusLookupTimeout = 1 * time.Second
 )
 
 var (
-	ErrNotFound = errors.New("key could not be found")
+	ErrNotFound          = errors.New("key could not be found")
+	ErrNotRejected       = errors.New("transaction is not rejected")
+	ErrRejectedTxExpired = errors.New("quarantine of rejected transaction has expired")
+	ErrNotScheduled      = errors.New("transaction is not scheduled for broadcast")
+	ErrInstanceMissing   = errors.New("instance missing")
+	ErrCallbacksDisabled = errors.New("callbacks are not enabled")
+	ErrJobsDisabled      = errors.New("jobs are not enabled")
+	ErrAnnotationEmpty   = errors.New("annotation has neither note nor labels")
+	ErrAnnotationTooLong = errors.New("annotation exceeds the maximum length")
+	ErrErasureFilter     = errors.New("erasure requires a tenant or transactions")
+	ErrErasureReason     = errors.New("erasure requires a reason")
+	ErrPeerBansDisabled  = errors.New("peer bans are not enabled")
 )
 
 type BitcoinNode interface {
@@ -58,13 +79,22 @@ type Server struct {
 	metamorph_api.UnimplementedMetaMorphAPIServer
 	grpc_utils.GrpcServer
 
-	logger              *slog.Logger
-	mq                  mq.MessageQueueClient
-	processor           ProcessorI
-	store               store.MetamorphStore
-	checkStatusInterval time.Duration
-	tracingEnabled      bool
-	tracingAttributes   []attribute.KeyValue
+	logger               *slog.Logger
+	mq                   mq.MessageQueueClient
+	processor            ProcessorI
+	store                store.MetamorphStore
+	checkStatusInterval  time.Duration
+	rejectedQuarantine   time.Duration
+	blockTemplates       *BlockTemplates
+	callbackSender       CallbackSender
+	scheduler            *scheduler.Scheduler
+	banManager           *p2p.BanManager
+	logLevels            *arcLogger.Levels
+	publishStatusUpdates bool
+	exportBatchSize      int64
+	now                  func() time.Time
+	tracingEnabled       bool
+	tracingAttributes    []attribute.KeyValue
 }
 
 func WithCheckStatusInterval(d time.Duration) func(*Server) {
@@ -73,6 +103,72 @@ func WithCheckStatusInterval(d time.Duration) func(*Server) {
 	}
 }
 
+// WithRejectedQuarantine sets the period after rejection during which a rejected transaction can be resubmitted. If
+// the period is zero, rejected transactions can be resubmitted for as long as they are stored.
+func WithRejectedQuarantine(d time.Duration) func(*Server) {
+	return func(s *Server) {
+		s.rejectedQuarantine = d
+	}
+}
+
+// WithBlockTemplates enables the block templates which can be fed by mining pools or nodes. The status of a
+// transaction then shows whether the transaction is included in a block template.
+func WithBlockTemplates(b *BlockTemplates) func(*Server) {
+	return func(s *Server) {
+		s.blockTemplates = b
+	}
+}
+
+// WithServerPublishStatusUpdates configures the server to publish the hashes of the transactions whose status has been
+// changed by an operation of the server on the status update topic, like the processor does.
+func WithServerPublishStatusUpdates(enabled bool) func(*Server) {
+	return func(s *Server) {
+		s.publishStatusUpdates = enabled
+	}
+}
+
+// WithServerLogLevels enables the operations changing the log levels of the process at runtime.
+func WithServerLogLevels(levels *arcLogger.Levels) func(*Server) {
+	return func(s *Server) {
+		s.logLevels = levels
+	}
+}
+
+// WithServerCallbackSender sets the sender with which the callbacks of transactions are replayed.
+func WithServerCallbackSender(sender CallbackSender) func(*Server) {
+	return func(s *Server) {
+		s.callbackSender = sender
+	}
+}
+
+// WithServerScheduler sets the scheduler whose jobs are listed, triggered, paused and resumed.
+func WithServerScheduler(jobScheduler *scheduler.Scheduler) func(*Server) {
+	return func(s *Server) {
+		s.scheduler = jobScheduler
+	}
+}
+
+// WithServerBanManager sets the ban manager whose peers are listed, banned and unbanned.
+func WithServerBanManager(banManager *p2p.BanManager) func(*Server) {
+	return func(s *Server) {
+		s.banManager = banManager
+	}
+}
+
+// WithServerBulkExport enables the bulk export of transactions, batchSize is the maximum number of transactions returned
+// at once.
+func WithServerBulkExport(batchSize int64) func(*Server) {
+	return func(s *Server) {
+		s.exportBatchSize = batchSize
+	}
+}
+
+func WithServerNow(nowFunc func() time.Time) func(*Server) {
+	return func(s *Server) {
+		s.now = nowFunc
+	}
+}
+
 // WithServerTracer sets the tracer to be used for tracing
 func WithServerTracer(attr ...attribute.KeyValue) func(s *Server) {
 	return func(s *Server) {
@@ -99,6 +195,7 @@ func NewServer(logger *slog.Logger, store store.MetamorphStore, processor Proces
 		store:               store,
 		checkStatusInterval: checkStatusIntervalDefault,
 		mq:                  mq,
+		now:                 time.Now,
 	}
 
 	for _, opt := range opts {
@@ -121,12 +218,7 @@ func NewServer(logger *slog.Logger, store store.MetamorphStore, processor Proces
 	return s, nil
 }
 
-func (s *Server) Health(ctx context.Context, _ *emptypb.Empty) (healthResp *metamorph_api.HealthResponse, err error) {
-	_, span := tracing.StartTracing(ctx, "Health", s.tracingEnabled, s.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
+func (s *Server) Health(_ context.Context, _ *emptypb.Empty) (healthResp *metamorph_api.HealthResponse, err error) {
 	processorMapSize := s.processor.GetProcessorMapSize()
 
 	peers := s.processor.GetPeers()
@@ -162,11 +254,6 @@ func (s *Server) Health(ctx context.Context, _ *emptypb.Empty) (healthResp *meta
 }
 
 func (s *Server) PostTransactions(ctx context.Context, req *metamorph_api.PostTransactionsRequest) (txsStatuses *metamorph_api.TransactionStatuses, err error) {
-	ctx, span := tracing.StartTracing(ctx, "PostTransactions", s.tracingEnabled, s.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
 	deadline, ok := ctx.Deadline()
 	if ok {
 		// Create a new deadline
@@ -229,12 +316,16 @@ func requestToStoreData(hash *chainhash.Hash, statusReceived metamorph_api.Statu
 	if req.GetCallbackUrl() != "" || req.GetCallbackToken() != "" {
 		callbacks = []store.Callback{
 			{
-				CallbackURL:   req.GetCallbackUrl(),
-				CallbackToken: req.GetCallbackToken(),
-				AllowBatch:    req.GetCallbackBatch(),
+				CallbackURL:       req.GetCallbackUrl(),
+				CallbackToken:     req.GetCallbackToken(),
+				AllowBatch:        req.GetCallbackBatch(),
+				CallbackVersion:   req.GetCallbackVersion(),
+				AllowDuplicates:   req.GetCallbackAllowDuplicates(),
+				SuppressionWindow: req.GetCallbackSuppressionWindow(),
 			},
 		}
 	}
+	callbacks = append(callbacks, additionalCallbacks(req)...)
 
 	return &store.Data{
 		Hash:              hash,
@@ -242,8 +333,38 @@ func requestToStoreData(hash *chainhash.Hash, statusReceived metamorph_api.Statu
 		Callbacks:         callbacks,
 		FullStatusUpdates: req.GetFullStatusUpdates(),
 		RawTx:             req.GetRawTx(),
+		Consolidation:     req.GetConsolidation(),
+		ReceivedAt:        timeOrZero(req.GetReceivedAt()),
+		ValidatedAt:       timeOrZero(req.GetValidatedAt()),
+		Tenant:            req.GetTenant(),
+		BroadcastAt:       timeOrZero(req.GetBroadcastAt()),
+		AnnounceTo:        req.GetAnnounceTo(),
+		ExpiresAt:         timeOrZero(req.GetExpiresAt()),
+		LockTime:          req.GetLockTime(),
+	}
+}
+
+// additionalCallbacks returns the callbacks of further recipients of the request besides the primary callback URL.
+func additionalCallbacks(req *metamorph_api.PostTransactionRequest) []store.Callback {
+	callbacks := make([]store.Callback, 0, len(req.GetAdditionalCallbacks()))
+	for _, cb := range req.GetAdditionalCallbacks() {
+		if cb.GetCallbackUrl() == "" {
+			continue
+		}
+
+		callbacks = append(callbacks, store.Callback{
+			CallbackURL:       cb.GetCallbackUrl(),
+			CallbackToken:     cb.GetCallbackToken(),
+			AllowBatch:        cb.GetAllowBatch(),
+			CallbackVersion:   cb.GetCallbackVersion(),
+			AllowDuplicates:   cb.GetAllowDuplicates(),
+			SuppressionWindow: cb.GetSuppressionWindow(),
+		})
 	}
+
+	return callbacks
 }
+
 func (s *Server) processTransaction(ctx context.Context, waitForStatus metamorph_api.Status, data *store.Data, txID string) *metamorph_api.TransactionStatus {
 	var err error
 	ctx, span := tracing.StartTracing(ctx, "processTransaction", s.tracingEnabled, s.tracingAttributes...)
@@ -267,7 +388,7 @@ func (s *Server) processTransaction(ctx context.Context, waitForStatus metamorph
 	// to avoid false negatives first check if ctx is expired
 	select {
 	case <-ctx.Done():
-		return nil
+		return s.bestKnownStatus(ctx, returnedStatus)
 	default:
 	}
 
@@ -287,6 +408,22 @@ func (s *Server) processTransaction(ctx context.Context, waitForStatus metamorph
 	return status
 }
 
+// bestKnownStatus returns the stored status of a transaction of which the processing was not started before the
+// deadline of the request was exceeded. If the transaction is not stored, the given status is returned. The status is
+// marked as timed out in both cases.
+func (s *Server) bestKnownStatus(ctx context.Context, returnedStatus *metamorph_api.TransactionStatus) *metamorph_api.TransactionStatus {
+	lookupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), statusLookupTimeout)
+	defer cancel()
+
+	storedStatus, err := s.transactionStatus(lookupCtx, &metamorph_api.TransactionStatusRequest{Txid: returnedStatus.GetTxid()})
+	if err == nil {
+		returnedStatus = storedStatus
+	}
+
+	returnedStatus.TimedOut = true
+	return returnedStatus
+}
+
 func updateReturnedCallbacks(data *store.Data, returnedStatus *metamorph_api.TransactionStatus) {
 	for _, cb := range data.Callbacks {
 		if cb.CallbackURL != "" {
@@ -299,11 +436,6 @@ func updateReturnedCallbacks(data *store.Data, returnedStatus *metamorph_api.Tra
 }
 
 func (s *Server) GetTransaction(ctx context.Context, req *metamorph_api.TransactionStatusRequest) (txn *metamorph_api.Transaction, err error) {
-	ctx, span := tracing.StartTracing(ctx, "GetTransaction", s.tracingEnabled, s.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
 	data, storedAt, err := s.getTransactionData(ctx, req)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to get transaction", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
@@ -326,11 +458,6 @@ func (s *Server) GetTransaction(ctx context.Context, req *metamorph_api.Transact
 }
 
 func (s *Server) GetTransactions(ctx context.Context, req *metamorph_api.TransactionsStatusRequest) (txs *metamorph_api.Transactions, err error) {
-	ctx, span := tracing.StartTracing(ctx, "GetTransactions", s.tracingEnabled, s.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
 	data, err := s.getTransactions(ctx, req)
 	if err != nil {
 		s.logger.ErrorContext(ctx, "failed to get transactions", slog.String("err", err.Error()))
@@ -359,7 +486,37 @@ func (s *Server) GetTransactions(ctx context.Context, req *metamorph_api.Transac
 	return &metamorph_api.Transactions{Transactions: res}, nil
 }
 
-func (s *Server) GetTransactionStatus(ctx context.Context, req *metamorph_api.TransactionStatusRequest) (returnStatus *metamorph_api.TransactionStatus, err error) {
+// GetTransactionStatus returns the status of the transaction together with the acknowledgments of its announcement by
+// peers and the annotations of operators.
+func (s *Server) GetTransactionStatus(ctx context.Context, req *metamorph_api.TransactionStatusRequest) (*metamorph_api.TransactionStatus, error) {
+	returnStatus, err := s.transactionStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := chainhash.NewHashFromStr(returnStatus.GetTxid())
+	if err != nil {
+		return nil, err
+	}
+
+	peerAcks, err := s.store.GetPeerAcks(ctx, [][]byte{hash[:]})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get peer acks", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+	}
+	returnStatus.PeerAcks = toPeerAcksProto(peerAcks)
+
+	annotations, err := s.store.GetAnnotations(ctx, [][]byte{hash[:]})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get annotations", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+	}
+	returnStatus.Annotations = toAnnotationsProto(annotations)
+
+	return returnStatus, nil
+}
+
+// transactionStatus returns the status of the transaction without the acknowledgments by peers and the annotations,
+// which are not needed while waiting for the status of a submitted transaction.
+func (s *Server) transactionStatus(ctx context.Context, req *metamorph_api.TransactionStatusRequest) (returnStatus *metamorph_api.TransactionStatus, err error) {
 	ctx, span := tracing.StartTracing(ctx, "GetTransactionStatus", s.tracingEnabled, s.tracingAttributes...)
 
 	var status metamorph_api.Status
@@ -388,23 +545,28 @@ func (s *Server) GetTransactionStatus(ctx context.Context, req *metamorph_api.Tr
 	status = data.Status
 
 	returnStatus = &metamorph_api.TransactionStatus{
-		Txid:          data.Hash.String(),
-		StoredAt:      storedAt,
-		Status:        data.Status,
-		BlockHeight:   data.BlockHeight,
-		BlockHash:     blockHash,
-		RejectReason:  data.RejectReason,
-		CompetingTxs:  data.CompetingTxs,
-		MerklePath:    data.MerklePath,
-		LastSubmitted: timestamppb.New(data.LastSubmittedAt),
+		Txid:           data.Hash.String(),
+		StoredAt:       storedAt,
+		Status:         data.Status,
+		BlockHeight:    data.BlockHeight,
+		BlockHash:      blockHash,
+		RejectReason:   data.RejectReason,
+		CompetingTxs:   data.CompetingTxs,
+		MerklePath:     data.MerklePath,
+		LastSubmitted:  timestamppb.New(data.LastSubmittedAt),
+		StageTimings:   toStageTimingsProto(stageTimings(data)),
+		NodeSubmission: toNodeSubmissionProto(data.NodeSubmission),
 	}
 
 	for _, cb := range data.Callbacks {
 		if cb.CallbackURL != "" {
 			returnStatus.Callbacks = append(returnStatus.Callbacks, &metamorph_api.Callback{
-				CallbackUrl:   cb.CallbackURL,
-				CallbackToken: cb.CallbackToken,
-				AllowBatch:    cb.AllowBatch,
+				CallbackUrl:       cb.CallbackURL,
+				CallbackToken:     cb.CallbackToken,
+				AllowBatch:        cb.AllowBatch,
+				CallbackVersion:   cb.CallbackVersion,
+				AllowDuplicates:   cb.AllowDuplicates,
+				SuppressionWindow: cb.SuppressionWindow,
 			})
 		}
 	}
@@ -414,9 +576,61 @@ func (s *Server) GetTransactionStatus(ctx context.Context, req *metamorph_api.Tr
 		returnStatus.RejectReason = minedDoubleSpendMsg
 	}
 
+	returnStatus.BlockTemplate = s.getBlockTemplate(ctx, data)
+
 	return returnStatus, nil
 }
 
+// getBlockTemplate returns the block template in which an unmined transaction is included or nil if block templates
+// are not enabled or the transaction is not included in any.
+func (s *Server) getBlockTemplate(ctx context.Context, data *store.Data) *metamorph_api.BlockTemplate {
+	if s.blockTemplates == nil || data.Status == metamorph_api.Status_MINED || data.Status == metamorph_api.Status_REJECTED {
+		return nil
+	}
+
+	template, err := s.blockTemplates.Get(data.Hash)
+	if err != nil {
+		s.logger.WarnContext(ctx, "failed to get block template of transaction", slog.String("hash", data.Hash.String()), slog.String("err", err.Error()))
+		return nil
+	}
+
+	if template == nil {
+		return nil
+	}
+
+	return &metamorph_api.BlockTemplate{
+		Source:            template.Source,
+		PreviousBlockHash: template.PreviousBlockHash,
+		Timestamp:         timestamppb.New(template.Timestamp),
+	}
+}
+
+// PostBlockTemplate marks the transactions of a block template or of a merkle subtree of it, which are fed by a mining
+// pool or node, as included in the block template.
+func (s *Server) PostBlockTemplate(ctx context.Context, req *metamorph_api.PostBlockTemplateRequest) (resp *metamorph_api.PostBlockTemplateResponse, err error) {
+	if s.blockTemplates == nil {
+		return nil, ErrBlockTemplatesDisabled
+	}
+
+	hashes := make([]*chainhash.Hash, 0, len(req.GetTxids()))
+	for _, txID := range req.GetTxids() {
+		var hash *chainhash.Hash
+		hash, err = chainhash.NewHashFromStr(txID)
+		if err != nil {
+			return nil, errors.Join(ErrBlockTemplateInvalid, err)
+		}
+		hashes = append(hashes, hash)
+	}
+
+	included, err := s.blockTemplates.Add(ctx, req.GetSource(), req.GetPreviousBlockHash(), hashes)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to process block template", slog.String("source", req.GetSource()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	return &metamorph_api.PostBlockTemplateResponse{IncludedTransactions: included}, nil
+}
+
 func (s *Server) GetTransactionStatuses(ctx context.Context, req *metamorph_api.TransactionsStatusRequest) (returnStatus *metamorph_api.TransactionStatuses, err error) {
 	ctx, span := tracing.StartTracing(ctx, "GetTransactionStatuses", s.tracingEnabled, s.tracingAttributes...)
 
@@ -463,9 +677,12 @@ func (s *Server) GetTransactionStatuses(ctx context.Context, req *metamorph_api.
 					txStatus.Callbacks = make([]*metamorph_api.Callback, 0)
 				}
 				txStatus.Callbacks = append(txStatus.Callbacks, &metamorph_api.Callback{
-					CallbackUrl:   cb.CallbackURL,
-					CallbackToken: cb.CallbackToken,
-					AllowBatch:    cb.AllowBatch,
+					CallbackUrl:       cb.CallbackURL,
+					CallbackToken:     cb.CallbackToken,
+					AllowBatch:        cb.AllowBatch,
+					CallbackVersion:   cb.CallbackVersion,
+					AllowDuplicates:   cb.AllowDuplicates,
+					SuppressionWindow: cb.SuppressionWindow,
 				})
 			}
 		}
@@ -524,11 +741,6 @@ func (s *Server) UpdateInstances(ctx context.Context, request *metamorph_api.Upd
 }
 
 func (s *Server) ClearData(ctx context.Context, req *metamorph_api.ClearDataRequest) (result *metamorph_api.ClearDataResponse, err error) {
-	ctx, span := tracing.StartTracing(ctx, "ClearData", s.tracingEnabled, s.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
 	recordsAffected, err := s.store.ClearData(ctx, req.RetentionDays)
 	if err != nil {
 		s.logger.Error("failed to clear data", slog.String("err", err.Error()))
@@ -542,6 +754,360 @@ func (s *Server) ClearData(ctx context.Context, req *metamorph_api.ClearDataRequ
 	return result, nil
 }
 
+// ResubmitTransaction resubmits a rejected transaction which is still in quarantine. The transaction is reset to
+// status STORED with its original submission metadata and re-announced to the network.
+func (s *Server) ResubmitTransaction(ctx context.Context, req *metamorph_api.TransactionStatusRequest) (returnStatus *metamorph_api.TransactionStatus, err error) {
+	data, storedAt, err := s.getTransactionData(ctx, req)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		s.logger.ErrorContext(ctx, "failed to get transaction", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	if data.Status != metamorph_api.Status_REJECTED {
+		return nil, ErrNotRejected
+	}
+
+	if s.rejectedQuarantine > 0 && s.now().Sub(data.LastModified) > s.rejectedQuarantine {
+		return nil, ErrRejectedTxExpired
+	}
+
+	err = s.store.ResubmitRejected(ctx, data.Hash)
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			// status of transaction changed in the meantime
+			return nil, ErrNotRejected
+		}
+		s.logger.ErrorContext(ctx, "failed to resubmit rejected transaction", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	s.publishUpdatedStatuses(ctx, []*store.Data{data})
+	s.logger.InfoContext(ctx, "Resubmitted rejected transaction", slog.String("hash", req.GetTxid()), slog.String("reject reason", data.RejectReason))
+
+	returnStatus = &metamorph_api.TransactionStatus{
+		Txid:          data.Hash.String(),
+		StoredAt:      storedAt,
+		Status:        metamorph_api.Status_STORED,
+		LastSubmitted: timestamppb.New(s.now()),
+	}
+
+	for _, cb := range data.Callbacks {
+		if cb.CallbackURL != "" {
+			returnStatus.Callbacks = append(returnStatus.Callbacks, &metamorph_api.Callback{
+				CallbackUrl:       cb.CallbackURL,
+				CallbackToken:     cb.CallbackToken,
+				AllowBatch:        cb.AllowBatch,
+				CallbackVersion:   cb.CallbackVersion,
+				AllowDuplicates:   cb.AllowDuplicates,
+				SuppressionWindow: cb.SuppressionWindow,
+			})
+		}
+	}
+
+	return returnStatus, nil
+}
+
+// GetTransactionGraph returns the transaction with its unmined ancestors and descendants which are known to metamorph,
+// so that a chain of transactions stuck on one of its transactions can be debugged.
+func (s *Server) GetTransactionGraph(ctx context.Context, req *metamorph_api.TransactionGraphRequest) (graph *metamorph_api.TransactionGraph, err error) {
+	data, _, err := s.getTransactionData(ctx, &metamorph_api.TransactionStatusRequest{Txid: req.GetTxid()})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		s.logger.ErrorContext(ctx, "failed to get transaction", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	maxNodes := graphMaxNodesDefault
+	if req.GetMaxNodes() > 0 {
+		maxNodes = min(int(req.GetMaxNodes()), graphMaxNodesLimit)
+	}
+
+	graph, err = buildTransactionGraph(ctx, s.store, data, maxNodes)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get transaction graph", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// CancelScheduledBroadcast deletes a scheduled transaction before it is announced to the network.
+func (s *Server) CancelScheduledBroadcast(ctx context.Context, req *metamorph_api.TransactionStatusRequest) (_ *emptypb.Empty, err error) {
+	hash, err := chainhash.NewHashFromStr(req.GetTxid())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.CancelScheduled(ctx, hash, s.now())
+	if err != nil {
+		switch {
+		case errors.Is(err, store.ErrNotFound):
+			return nil, ErrNotFound
+		case errors.Is(err, store.ErrNotScheduled):
+			return nil, ErrNotScheduled
+		}
+		s.logger.ErrorContext(ctx, "failed to cancel scheduled broadcast", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	s.publishUpdatedStatuses(ctx, []*store.Data{{Hash: hash}})
+	s.logger.InfoContext(ctx, "Cancelled scheduled broadcast", slog.String("hash", req.GetTxid()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// publishUpdatedStatuses publishes the hashes of the transactions whose status has been changed by the server, if
+// enabled.
+func (s *Server) publishUpdatedStatuses(ctx context.Context, updatedData []*store.Data) {
+	if !s.publishStatusUpdates {
+		return
+	}
+
+	publishStatusUpdates(ctx, s.mq, s.logger, updatedData)
+}
+
+// UnlockRecords unlocks the records locked by the given metamorph instance, so that they can be processed by the
+// other instances, e.g. after the instance has been removed without unlocking its records.
+func (s *Server) UnlockRecords(ctx context.Context, req *metamorph_api.UnlockRecordsRequest) (result *metamorph_api.UnlockRecordsResponse, err error) {
+	if req.GetInstance() == "" {
+		return nil, ErrInstanceMissing
+	}
+
+	rowsAffected, err := s.store.SetUnlockedByName(ctx, req.GetInstance())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to unlock records", slog.String("instance", req.GetInstance()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Unlocked records", slog.String("instance", req.GetInstance()), slog.Int64("items", rowsAffected))
+
+	return &metamorph_api.UnlockRecordsResponse{RecordsAffected: rowsAffected}, nil
+}
+
+// ReplayCallbacks sends the callbacks with the current status of the transactions again, e.g. after a callback
+// receiver was unavailable. Transactions which are not found or have no callbacks are skipped, the response contains
+// the transactions whose callbacks have been sent.
+func (s *Server) ReplayCallbacks(ctx context.Context, req *metamorph_api.TransactionsStatusRequest) (result *metamorph_api.ReplayCallbacksResponse, err error) {
+	if s.callbackSender == nil {
+		return nil, ErrCallbacksDisabled
+	}
+
+	data, err := s.getTransactions(ctx, req)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get transactions", slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	result = &metamorph_api.ReplayCallbacksResponse{}
+	for _, d := range data {
+		if len(d.Callbacks) == 0 {
+			continue
+		}
+
+		s.callbackSender.SendCallback(ctx, d)
+		result.TxIDs = append(result.TxIDs, d.Hash.String())
+	}
+
+	s.logger.InfoContext(ctx, "Replayed callbacks", slog.Int("requested", len(req.GetTxIDs())), slog.Int("replayed", len(result.TxIDs)))
+
+	return result, nil
+}
+
+// GetOutpointSpender returns whether one of the transactions known to metamorph, mined or unmined, spends the outpoint
+// and which one, so that it can be checked whether an output is already spent without a node.
+func (s *Server) GetOutpointSpender(ctx context.Context, req *metamorph_api.OutpointSpenderRequest) (spender *metamorph_api.OutpointSpender, err error) {
+	hash, err := chainhash.NewHashFromStr(req.GetTxid())
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err = findOutpointSpender(ctx, s.store, hash, req.GetVout())
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to get outpoint spender", slog.String("hash", req.GetTxid()), slog.Uint64("vout", uint64(req.GetVout())), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	return spender, nil
+}
+
+// ListJobs returns the state of the maintenance jobs of the processor.
+func (s *Server) ListJobs(_ context.Context, _ *emptypb.Empty) (*metamorph_api.Jobs, error) {
+	if s.scheduler == nil {
+		return nil, ErrJobsDisabled
+	}
+
+	jobs := &metamorph_api.Jobs{}
+	for _, state := range s.scheduler.Jobs() {
+		jobs.Jobs = append(jobs.Jobs, toJob(state))
+	}
+
+	return jobs, nil
+}
+
+// TriggerJob runs the job right away, independent of its schedule and even if it is paused.
+func (s *Server) TriggerJob(ctx context.Context, req *metamorph_api.JobRequest) (*metamorph_api.Job, error) {
+	return s.updateJob(ctx, req.GetName(), "Triggered job", func(name string) error { return s.scheduler.Trigger(name) })
+}
+
+// PauseJob skips the scheduled runs of the job until it is resumed.
+func (s *Server) PauseJob(ctx context.Context, req *metamorph_api.JobRequest) (*metamorph_api.Job, error) {
+	return s.updateJob(ctx, req.GetName(), "Paused job", func(name string) error { return s.scheduler.Pause(name) })
+}
+
+// ResumeJob resumes the scheduled runs of the paused job.
+func (s *Server) ResumeJob(ctx context.Context, req *metamorph_api.JobRequest) (*metamorph_api.Job, error) {
+	return s.updateJob(ctx, req.GetName(), "Resumed job", func(name string) error { return s.scheduler.Resume(name) })
+}
+
+func (s *Server) updateJob(ctx context.Context, name string, msg string, update func(name string) error) (*metamorph_api.Job, error) {
+	if s.scheduler == nil {
+		return nil, ErrJobsDisabled
+	}
+
+	err := update(name)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := s.scheduler.Job(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, msg, slog.String("job", state.Name))
+
+	return toJob(state), nil
+}
+
+// ListPeers returns the peers with their ban scores and the banned addresses.
+func (s *Server) ListPeers(_ context.Context, _ *emptypb.Empty) (*metamorph_api.PeerBans, error) {
+	if s.banManager == nil {
+		return nil, ErrPeerBansDisabled
+	}
+
+	return toPeerBans(s.banManager), nil
+}
+
+// BanPeer bans the address and disconnects the peers with the address.
+func (s *Server) BanPeer(_ context.Context, req *metamorph_api.BanPeerRequest) (*metamorph_api.PeerBans, error) {
+	if s.banManager == nil {
+		return nil, ErrPeerBansDisabled
+	}
+
+	var duration time.Duration
+	if req.GetDuration() != "" {
+		var err error
+		duration, err = time.ParseDuration(req.GetDuration())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	err := s.banManager.Ban(req.GetAddress(), duration)
+	if err != nil {
+		if errors.Is(err, p2p.ErrAddressRequired) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return toPeerBans(s.banManager), nil
+}
+
+// UnbanPeer lifts the ban of the address.
+func (s *Server) UnbanPeer(_ context.Context, req *metamorph_api.BanPeerRequest) (*metamorph_api.PeerBans, error) {
+	if s.banManager == nil {
+		return nil, ErrPeerBansDisabled
+	}
+
+	err := s.banManager.Unban(req.GetAddress())
+	if err != nil {
+		if errors.Is(err, p2p.ErrAddressNotBanned) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return toPeerBans(s.banManager), nil
+}
+
+// SetLogLevel sets the log level of a component of the process running metamorph, or of the process if no component is
+// given, until the next restart.
+func (s *Server) SetLogLevel(ctx context.Context, req *metamorph_api.LogLevelRequest) (*emptypb.Empty, error) {
+	err := s.logLevels.SetLevelName(req.GetComponent(), req.GetLevel())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level changed", slog.String("component", req.GetComponent()), slog.String("level", req.GetLevel()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResetLogLevel resets the log level of a component of the process running metamorph to the log level of the process.
+func (s *Server) ResetLogLevel(ctx context.Context, req *metamorph_api.LogLevelRequest) (*emptypb.Empty, error) {
+	err := s.logLevels.ResetComponentLevel(req.GetComponent())
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Log level reset", slog.String("component", req.GetComponent()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func toPeerBans(banManager *p2p.BanManager) *metamorph_api.PeerBans {
+	result := &metamorph_api.PeerBans{}
+	for _, peer := range banManager.Peers() {
+		peerBan := &metamorph_api.PeerBan{
+			Address:   peer.Address,
+			Connected: peer.Connected,
+			BanScore:  int64(peer.BanScore),
+			Banned:    peer.Banned,
+		}
+		if peer.Banned {
+			peerBan.BannedUntil = timestamppb.New(peer.BannedUntil)
+		}
+		result.Peers = append(result.Peers, peerBan)
+	}
+
+	for address, bannedUntil := range banManager.Bans() {
+		result.Bans = append(result.Bans, &metamorph_api.PeerBan{Address: address, Banned: true, BannedUntil: timestamppb.New(bannedUntil)})
+	}
+
+	sort.Slice(result.Bans, func(i, j int) bool {
+		return result.Bans[i].GetAddress() < result.Bans[j].GetAddress()
+	})
+
+	return result
+}
+
+func toJob(state scheduler.JobState) *metamorph_api.Job {
+	job := &metamorph_api.Job{
+		Name:           state.Name,
+		Schedule:       state.Schedule,
+		JitterMs:       state.Jitter.Milliseconds(),
+		Paused:         state.Paused,
+		Running:        state.Running,
+		Runs:           state.Runs,
+		LastDurationMs: state.LastDuration.Milliseconds(),
+	}
+
+	if !state.LastRun.IsZero() {
+		job.LastRun = timestamppb.New(state.LastRun)
+	}
+	if !state.NextRun.IsZero() {
+		job.NextRun = timestamppb.New(state.NextRun)
+	}
+
+	return job
+}
+
 func (s *Server) waitForTxStatus(ctx context.Context, returnedStatus *metamorph_api.TransactionStatus, responseChannel chan StatusAndError, txID string, waitForStatus metamorph_api.Status) *metamorph_api.TransactionStatus {
 	ctx, span := tracing.StartTracing(ctx, "waitForTxStatus", s.tracingEnabled, s.tracingAttributes...)
 	var err error
@@ -560,7 +1126,7 @@ func (s *Server) waitForTxStatus(ctx context.Context, returnedStatus *metamorph_
 			// It's possible the transaction status was received and updated in db by another metamorph instance
 			// If yes, return new tx status
 			var tx *metamorph_api.TransactionStatus
-			tx, err = s.GetTransactionStatus(ctx, &metamorph_api.TransactionStatusRequest{
+			tx, err = s.transactionStatus(ctx, &metamorph_api.TransactionStatusRequest{
 				Txid: txID,
 			})
 			if err == nil && tx.Status >= waitForStatus {
@@ -577,6 +1143,12 @@ func (s *Server) waitForTxStatus(ctx context.Context, returnedStatus *metamorph_
 				returnedStatus.CompetingTxs = res.CompetingTxs
 			}
 
+			if errors.Is(res.Err, context.DeadlineExceeded) {
+				// the deadline of the request was exceeded while processing the transaction, e.g. while storing it
+				returnedStatus.TimedOut = true
+				return returnedStatus
+			}
+
 			if res.Err != nil {
 				returnedStatus.RejectReason = res.Err.Error()
 				// Note: return here so that user doesn't have to wait for timeout in case of an error
@@ -585,7 +1157,7 @@ func (s *Server) waitForTxStatus(ctx context.Context, returnedStatus *metamorph_
 			returnedStatus.RejectReason = ""
 			if res.Status == metamorph_api.Status_MINED {
 				var tx *metamorph_api.TransactionStatus
-				tx, err = s.GetTransactionStatus(ctx, &metamorph_api.TransactionStatusRequest{
+				tx, err = s.transactionStatus(ctx, &metamorph_api.TransactionStatusRequest{
 					Txid: txID,
 				})
 				if err != nil {
@@ -605,6 +1177,132 @@ func (s *Server) waitForTxStatus(ctx context.Context, returnedStatus *metamorph_
 	}
 }
 
+// GetSLAReports returns the SLA reports per tenant of the periods starting between from and to, until now if to is not
+// given.
+func (s *Server) GetSLAReports(ctx context.Context, req *metamorph_api.SLAReportsRequest) (*metamorph_api.SLAReports, error) {
+	err := slareport.ValidatePeriod(req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	to := s.now()
+	if req.GetTo() != nil {
+		to = req.GetTo().AsTime()
+	}
+
+	reports, err := s.store.GetSLAReports(ctx, req.GetPeriod(), req.GetFrom().AsTime(), to, req.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &metamorph_api.SLAReports{Reports: make([]*metamorph_api.SLAReport, 0, len(reports))}
+	for _, report := range reports {
+		result.Reports = append(result.Reports, toSLAReportProto(report))
+	}
+
+	return result, nil
+}
+
+// AnnotateTransaction attaches a note and labels to the stored transaction, e.g. for the tracking of incidents. The
+// annotations are returned with the status of the transaction.
+func (s *Server) AnnotateTransaction(ctx context.Context, req *metamorph_api.AnnotateTransactionRequest) (annotation *metamorph_api.Annotation, err error) {
+	ctx, span := tracing.StartTracing(ctx, "AnnotateTransaction", s.tracingEnabled, s.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	hash, err := chainhash.NewHashFromStr(req.GetTxid())
+	if err != nil {
+		return nil, err
+	}
+
+	note := strings.TrimSpace(req.GetNote())
+	labels := normalizeLabels(req.GetLabels())
+	if note == "" && len(labels) == 0 {
+		return nil, ErrAnnotationEmpty
+	}
+
+	if len(note) > maxAnnotationNoteLength || len(labels) > maxAnnotationLabels {
+		return nil, errors.Join(ErrAnnotationTooLong, fmt.Errorf("note of at most %d characters and %d labels allowed", maxAnnotationNoteLength, maxAnnotationLabels))
+	}
+
+	stored, err := s.store.AddAnnotation(ctx, store.Annotation{Hash: *hash, Note: note, Labels: labels, Author: req.GetAuthor()})
+	if err != nil {
+		if errors.Is(err, store.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		s.logger.ErrorContext(ctx, "failed to add annotation", slog.String("hash", req.GetTxid()), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Annotated transaction", slog.String("hash", req.GetTxid()), slog.String("author", req.GetAuthor()))
+
+	return toAnnotationProto(stored), nil
+}
+
+// EraseClientData irreversibly removes the callback URLs and tokens of the transactions of the tenant or with the
+// given ids, while the transactions and their status are kept. The erasure is recorded as audit trail.
+func (s *Server) EraseClientData(ctx context.Context, req *metamorph_api.EraseClientDataRequest) (result *metamorph_api.Erasure, err error) {
+	ctx, span := tracing.StartTracing(ctx, "EraseClientData", s.tracingEnabled, s.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	if req.GetTenant() == "" && len(req.GetTxids()) == 0 {
+		return nil, ErrErasureFilter
+	}
+
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return nil, ErrErasureReason
+	}
+
+	hashes := make([][]byte, 0, len(req.GetTxids()))
+	for _, txID := range req.GetTxids() {
+		hash, err := chainhash.NewHashFromStr(txID)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash[:])
+	}
+
+	erasure, err := s.store.EraseClientData(ctx, store.Erasure{
+		Tenant:   req.GetTenant(),
+		Hashes:   hashes,
+		ErasedBy: req.GetErasedBy(),
+		Reason:   reason,
+	})
+	if err != nil {
+		s.logger.ErrorContext(ctx, "failed to erase client data", slog.String("tenant", req.GetTenant()), slog.Int("txs", len(hashes)), slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	s.logger.InfoContext(ctx, "Erased client data", slog.Int64("id", erasure.ID), slog.String("tenant", req.GetTenant()), slog.Int("txs", len(hashes)),
+		slog.Int64("erased", erasure.Transactions), slog.String("erased by", req.GetErasedBy()))
+
+	return toErasureProto(erasure), nil
+}
+
+// ListErasures returns the audit trail of the erasures of client data, the latest erasures first.
+func (s *Server) ListErasures(ctx context.Context, req *metamorph_api.ListErasuresRequest) (*metamorph_api.Erasures, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = erasuresLimitDefault
+	}
+
+	erasures, err := s.store.GetErasures(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &metamorph_api.Erasures{Erasures: make([]*metamorph_api.Erasure, 0, len(erasures))}
+	for _, erasure := range erasures {
+		result.Erasures = append(result.Erasures, toErasureProto(erasure))
+	}
+
+	return result, nil
+}
+
 // PtrTo returns a pointer to the given value.
 func PtrTo[T any](v T) *T {
 	return &v
@@ -6,24 +6,37 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
+	sdkChainhash "github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
 	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
 
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/cache"
 	"github.com/bitcoin-sv/arc/internal/grpc_utils"
+	arcLogger "github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/metamorph"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/mocks"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
 	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+	"github.com/bitcoin-sv/arc/internal/mq"
+	mqMocks "github.com/bitcoin-sv/arc/internal/mq/mocks"
 	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+	"github.com/bitcoin-sv/arc/internal/slareport"
 	"github.com/bitcoin-sv/arc/internal/testdata"
 )
 
@@ -186,6 +199,94 @@ func TestPostTransaction(t *testing.T) {
 	}
 }
 
+func TestPostTransaction_DeadlineExceeded(t *testing.T) {
+	testCases := []struct {
+		name              string
+		expired           bool
+		storedData        *store.Data
+		processorResponse metamorph.StatusAndError
+
+		expectedStatus            metamorph_api.Status
+		expectedProcessTxsInvoked int
+	}{
+		{
+			name:       "deadline exceeded before processing - tx stored",
+			expired:    true,
+			storedData: &store.Data{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+
+			expectedStatus: metamorph_api.Status_SEEN_ON_NETWORK,
+		},
+		{
+			name:    "deadline exceeded before processing - tx not stored",
+			expired: true,
+
+			expectedStatus: metamorph_api.Status_RECEIVED,
+		},
+		{
+			name: "deadline exceeded while storing tx",
+			processorResponse: metamorph.StatusAndError{
+				Hash:   testdata.TX1Hash,
+				Status: metamorph_api.Status_RECEIVED,
+				Err:    context.DeadlineExceeded,
+			},
+
+			expectedStatus:            metamorph_api.Status_RECEIVED,
+			expectedProcessTxsInvoked: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetFunc: func(_ context.Context, _ []byte) (*store.Data, error) {
+					if tc.storedData == nil {
+						return nil, store.ErrNotFound
+					}
+					return tc.storedData, nil
+				},
+			}
+
+			processor := &mocks.ProcessorIMock{
+				ProcessTransactionFunc: func(_ context.Context, req *metamorph.ProcessorRequest) {
+					req.ResponseChannel <- tc.processorResponse
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, processor, nil, grpc_utils.ServerConfig{})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			txRequest := &metamorph_api.PostTransactionsRequest{
+				Transactions: []*metamorph_api.PostTransactionRequest{
+					{
+						RawTx:         testdata.TX1Raw.Bytes(),
+						WaitForStatus: metamorph_api.Status_SEEN_ON_NETWORK,
+					},
+				},
+			}
+
+			timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			if tc.expired {
+				timeoutCtx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			}
+			defer cancel()
+
+			// when
+			actualStatus, err := sut.PostTransactions(timeoutCtx, txRequest)
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, actualStatus.Statuses, 1)
+			assert.Equal(t, testdata.TX1Hash.String(), actualStatus.Statuses[0].GetTxid())
+			assert.Equal(t, tc.expectedStatus, actualStatus.Statuses[0].GetStatus())
+			assert.Empty(t, actualStatus.Statuses[0].GetRejectReason())
+			assert.True(t, actualStatus.Statuses[0].GetTimedOut())
+			assert.Len(t, processor.ProcessTransactionCalls(), tc.expectedProcessTxsInvoked)
+		})
+	}
+}
+
 func TestServer_GetTransactionStatus(t *testing.T) {
 	errFailedToGetTxData := errors.New("failed to get transaction data")
 
@@ -196,6 +297,9 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 		getErr             error
 		status             metamorph_api.Status
 		competingTxs       []string
+		inBlockTemplate    bool
+		peerAcks           []store.PeerAck
+		annotations        []store.Annotation
 
 		expected      *metamorph_api.TransactionStatus
 		expectedError assert.ErrorAssertionFunc
@@ -238,6 +342,96 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 				MerklePath:    "00000",
 				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
 				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
+			},
+			expectedError: assert.NoError,
+		},
+		{
+			name: "GetTransactionStatus - with peer acks",
+			req: &metamorph_api.TransactionStatusRequest{
+				Txid: testdata.TX1Hash.String(),
+			},
+			status: metamorph_api.Status_SENT_TO_NETWORK,
+			peerAcks: []store.PeerAck{
+				{Hash: *testdata.TX1Hash, Peer: "peer-1", RequestedAt: testdata.Time, SentAt: testdata.Time},
+				{Hash: *testdata.TX1Hash, Peer: "peer-2", RequestedAt: testdata.Time},
+			},
+
+			expected: &metamorph_api.TransactionStatus{
+				StoredAt:      timestamppb.New(testdata.Time),
+				Txid:          testdata.TX1Hash.String(),
+				Status:        metamorph_api.Status_SENT_TO_NETWORK,
+				MerklePath:    "00000",
+				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
+				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
+				PeerAcks: []*metamorph_api.PeerAck{
+					{Peer: "peer-1", RequestedAt: timestamppb.New(testdata.Time), SentAt: timestamppb.New(testdata.Time)},
+					{Peer: "peer-2", RequestedAt: timestamppb.New(testdata.Time)},
+				},
+			},
+			expectedError: assert.NoError,
+		},
+		{
+			name: "GetTransactionStatus - with annotations",
+			req: &metamorph_api.TransactionStatusRequest{
+				Txid: testdata.TX1Hash.String(),
+			},
+			status: metamorph_api.Status_SENT_TO_NETWORK,
+			annotations: []store.Annotation{
+				{Hash: *testdata.TX1Hash, Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1", CreatedAt: testdata.Time},
+			},
+
+			expected: &metamorph_api.TransactionStatus{
+				StoredAt:      timestamppb.New(testdata.Time),
+				Txid:          testdata.TX1Hash.String(),
+				Status:        metamorph_api.Status_SENT_TO_NETWORK,
+				MerklePath:    "00000",
+				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
+				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
+				Annotations: []*metamorph_api.Annotation{
+					{Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1", CreatedAt: timestamppb.New(testdata.Time)},
+				},
+			},
+			expectedError: assert.NoError,
+		},
+		{
+			name: "GetTransactionStatus - in block template",
+			req: &metamorph_api.TransactionStatusRequest{
+				Txid: testdata.TX1Hash.String(),
+			},
+			status:          metamorph_api.Status_SEEN_ON_NETWORK,
+			inBlockTemplate: true,
+
+			expected: &metamorph_api.TransactionStatus{
+				StoredAt:      timestamppb.New(testdata.Time),
+				Txid:          testdata.TX1Hash.String(),
+				Status:        metamorph_api.Status_SEEN_ON_NETWORK,
+				MerklePath:    "00000",
+				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
+				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
+				BlockTemplate: &metamorph_api.BlockTemplate{Source: "pool-1", PreviousBlockHash: testdata.Block1Hash.String(), Timestamp: timestamppb.New(testdata.Time)},
+			},
+			expectedError: assert.NoError,
+		},
+		{
+			name: "GetTransactionStatus - mined - block template not shown",
+			req: &metamorph_api.TransactionStatusRequest{
+				Txid: testdata.TX1Hash.String(),
+			},
+			status:          metamorph_api.Status_MINED,
+			inBlockTemplate: true,
+
+			expected: &metamorph_api.TransactionStatus{
+				StoredAt:      timestamppb.New(testdata.Time),
+				Txid:          testdata.TX1Hash.String(),
+				Status:        metamorph_api.Status_MINED,
+				MerklePath:    "00000",
+				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
+				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
 			},
 			expectedError: assert.NoError,
 		},
@@ -257,6 +451,7 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 				MerklePath:    "00000",
 				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
 				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
 			},
 			expectedError: assert.NoError,
 		},
@@ -277,6 +472,7 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 				MerklePath:    "00000",
 				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
 				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
 			},
 			expectedError: assert.NoError,
 		},
@@ -295,6 +491,7 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 				MerklePath:    "00000",
 				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
 				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
 			},
 			expectedError: assert.NoError,
 		},
@@ -313,6 +510,7 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 				MerklePath:    "00000",
 				Callbacks:     []*metamorph_api.Callback{{CallbackUrl: "https://test.com", CallbackToken: "token"}},
 				LastSubmitted: timestamppb.New(testdata.Time),
+				StageTimings:  []*metamorph_api.StageTiming{{Stage: metamorph.StageStored, Timestamp: timestamppb.New(testdata.Time)}},
 			},
 			expectedError: assert.NoError,
 		},
@@ -333,9 +531,24 @@ func TestServer_GetTransactionStatus(t *testing.T) {
 					}
 					return data, tt.getErr
 				},
+				GetPeerAcksFunc: func(_ context.Context, _ [][]byte) ([]store.PeerAck, error) {
+					return tt.peerAcks, nil
+				},
+				GetAnnotationsFunc: func(_ context.Context, _ [][]byte) ([]store.Annotation, error) {
+					return tt.annotations, nil
+				},
 			}
 
-			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{})
+			var opts []metamorph.ServerOption
+			if tt.inBlockTemplate {
+				templateStore := blockTemplateStore(&store.Data{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK})
+				blockTemplates := metamorph.NewBlockTemplates(slog.Default(), templateStore, cache.NewMemoryStore(), metamorph.WithBlockTemplateNow(func() time.Time { return testdata.Time }))
+				_, err := blockTemplates.Add(context.Background(), "pool-1", testdata.Block1Hash.String(), []*chainhash.Hash{testdata.TX1Hash})
+				require.NoError(t, err)
+				opts = append(opts, metamorph.WithBlockTemplates(blockTemplates))
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{}, opts...)
 			require.NoError(t, err)
 			defer sut.GracefulStop()
 
@@ -1161,3 +1374,1302 @@ func TestClearData(t *testing.T) {
 		require.NotNil(t, res)
 	})
 }
+
+func TestResubmitTransaction(t *testing.T) {
+	now := time.Date(2023, 10, 1, 14, 25, 0, 0, time.UTC)
+
+	tt := []struct {
+		name               string
+		getErr             error
+		status             metamorph_api.Status
+		lastModified       time.Time
+		resubmitErr        error
+		rejectedQuarantine time.Duration
+
+		expectedErr           error
+		expectedResubmitCalls int
+	}{
+		{
+			name:               "resubmit rejected tx",
+			status:             metamorph_api.Status_REJECTED,
+			lastModified:       now.Add(-1 * time.Hour),
+			rejectedQuarantine: 72 * time.Hour,
+
+			expectedResubmitCalls: 1,
+		},
+		{
+			name:         "resubmit rejected tx - no quarantine limit",
+			status:       metamorph_api.Status_REJECTED,
+			lastModified: now.Add(-100 * time.Hour),
+
+			expectedResubmitCalls: 1,
+		},
+		{
+			name:   "tx not found",
+			getErr: store.ErrNotFound,
+
+			expectedErr: metamorph.ErrNotFound,
+		},
+		{
+			name:         "tx not rejected",
+			status:       metamorph_api.Status_SEEN_ON_NETWORK,
+			lastModified: now.Add(-1 * time.Hour),
+
+			expectedErr: metamorph.ErrNotRejected,
+		},
+		{
+			name:               "quarantine expired",
+			status:             metamorph_api.Status_REJECTED,
+			lastModified:       now.Add(-73 * time.Hour),
+			rejectedQuarantine: 72 * time.Hour,
+
+			expectedErr: metamorph.ErrRejectedTxExpired,
+		},
+		{
+			name:               "status changed in the meantime",
+			status:             metamorph_api.Status_REJECTED,
+			lastModified:       now.Add(-1 * time.Hour),
+			resubmitErr:        store.ErrNotFound,
+			rejectedQuarantine: 72 * time.Hour,
+
+			expectedErr:           metamorph.ErrNotRejected,
+			expectedResubmitCalls: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetFunc: func(_ context.Context, key []byte) (*store.Data, error) {
+					if tc.getErr != nil {
+						return nil, tc.getErr
+					}
+
+					return &store.Data{
+						Hash:         testdata.TX1Hash,
+						StoredAt:     now.Add(-100 * time.Hour),
+						Status:       tc.status,
+						RejectReason: "missing inputs",
+						LastModified: tc.lastModified,
+						Callbacks:    []store.Callback{{CallbackURL: "https://callback.example.com"}},
+					}, nil
+				},
+				ResubmitRejectedFunc: func(_ context.Context, _ *chainhash.Hash) error {
+					return tc.resubmitErr
+				},
+			}
+
+			mqClient := newStatusUpdateClientMock(t)
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, mqClient, grpc_utils.ServerConfig{},
+				metamorph.WithRejectedQuarantine(tc.rejectedQuarantine),
+				metamorph.WithServerNow(func() time.Time { return now }),
+				metamorph.WithServerPublishStatusUpdates(true),
+			)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			res, err := sut.ResubmitTransaction(context.Background(), &metamorph_api.TransactionStatusRequest{
+				Txid: testdata.TX1Hash.String(),
+			})
+
+			// then
+			require.Len(t, metamorphStore.ResubmitRejectedCalls(), tc.expectedResubmitCalls)
+
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Empty(t, mqClient.PublishMarshalCoreCalls())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, mqClient.PublishMarshalCoreCalls(), 1)
+			require.Equal(t, metamorph_api.Status_STORED, res.GetStatus())
+			require.Equal(t, testdata.TX1Hash.String(), res.GetTxid())
+			require.Equal(t, now, res.GetLastSubmitted().AsTime())
+			require.Len(t, res.GetCallbacks(), 1)
+		})
+	}
+}
+
+func TestServer_GetSLAReports(t *testing.T) {
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name   string
+		period string
+		to     *timestamppb.Timestamp
+
+		expectedTo       time.Time
+		expectedGetCalls int
+		expectedErr      error
+	}{
+		{
+			name:   "until now",
+			period: "daily",
+
+			expectedTo:       now,
+			expectedGetCalls: 1,
+		},
+		{
+			name:   "until to",
+			period: "weekly",
+			to:     timestamppb.New(day),
+
+			expectedTo:       day,
+			expectedGetCalls: 1,
+		},
+		{
+			name:   "unknown period",
+			period: "monthly",
+
+			expectedErr: slareport.ErrUnknownPeriod,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetSLAReportsFunc: func(_ context.Context, period string, _ time.Time, _ time.Time, tenant string) ([]store.SLAReport, error) {
+					return []store.SLAReport{{Period: period, PeriodStart: day, Tenant: tenant, Transactions: 10, SeenOnNetwork: 9, P95TimeToSeen: 1500 * time.Millisecond,
+						Mined: 8, P95TimeToMined: 10 * time.Minute, ComputedAt: now}}, nil
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{}, metamorph.WithServerNow(func() time.Time { return now }))
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.GetSLAReports(context.Background(), &metamorph_api.SLAReportsRequest{
+				Period: tc.period,
+				From:   timestamppb.New(day),
+				To:     tc.to,
+				Tenant: "exchange-a",
+			})
+
+			// then
+			require.Len(t, metamorphStore.GetSLAReportsCalls(), tc.expectedGetCalls)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, day, metamorphStore.GetSLAReportsCalls()[0].From)
+			require.Equal(t, tc.expectedTo, metamorphStore.GetSLAReportsCalls()[0].To)
+			require.Len(t, actual.GetReports(), 1)
+			require.Equal(t, tc.period, actual.GetReports()[0].GetPeriod())
+			require.Equal(t, "exchange-a", actual.GetReports()[0].GetTenant())
+			require.Equal(t, int64(1500), actual.GetReports()[0].GetP95TimeToSeenMs())
+			require.Equal(t, int64(600000), actual.GetReports()[0].GetP95TimeToMinedMs())
+		})
+	}
+}
+
+func TestServer_GetExport(t *testing.T) {
+	storedAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("batch after the last exported transaction", func(t *testing.T) {
+		// given
+		metamorphStore := &storeMocks.MetamorphStoreMock{
+			GetExportFunc: func(_ context.Context, _ store.ExportFilter, _ int64) ([]*store.Data, error) {
+				return []*store.Data{{Hash: testdata.TX2Hash, Status: metamorph_api.Status_MINED, StoredAt: storedAt, BlockHash: testdata.Block1Hash, BlockHeight: 100, Tenant: "exchange-a"}}, nil
+			},
+		}
+
+		sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{}, metamorph.WithServerBulkExport(100))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		actual, err := sut.GetExport(context.Background(), &metamorph_api.ExportRequest{
+			From:          timestamppb.New(storedAt.Add(-time.Hour)),
+			To:            timestamppb.New(storedAt.Add(time.Hour)),
+			Statuses:      []metamorph_api.Status{metamorph_api.Status_MINED},
+			Tenant:        "exchange-a",
+			AfterStoredAt: timestamppb.New(storedAt),
+			AfterHash:     testdata.TX1Hash[:],
+			Limit:         1000,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, metamorphStore.GetExportCalls(), 1)
+		call := metamorphStore.GetExportCalls()[0]
+		require.Equal(t, int64(100), call.Limit)
+		require.Equal(t, testdata.TX1Hash, call.Filter.AfterHash)
+		require.Equal(t, storedAt, call.Filter.AfterStoredAt)
+		require.Equal(t, []metamorph_api.Status{metamorph_api.Status_MINED}, call.Filter.Statuses)
+		require.Equal(t, "exchange-a", call.Filter.Tenant)
+
+		require.Len(t, actual.GetTransactions(), 1)
+		require.Equal(t, testdata.TX2Hash[:], actual.GetTransactions()[0].GetHash())
+		require.Equal(t, testdata.Block1Hash[:], actual.GetTransactions()[0].GetBlockHash())
+		require.Equal(t, uint64(100), actual.GetTransactions()[0].GetBlockHeight())
+		require.Nil(t, actual.GetTransactions()[0].GetLastModified())
+	})
+
+	t.Run("bulk export disabled", func(t *testing.T) {
+		// given
+		sut, err := metamorph.NewServer(slog.Default(), &storeMocks.MetamorphStoreMock{}, nil, nil, grpc_utils.ServerConfig{})
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.GetExport(context.Background(), &metamorph_api.ExportRequest{})
+
+		// then
+		require.ErrorIs(t, err, metamorph.ErrBulkExportDisabled)
+	})
+}
+
+func TestServer_PostBlockTemplate(t *testing.T) {
+	tt := []struct {
+		name     string
+		disabled bool
+		txids    []string
+
+		expectedIncluded uint64
+		expectedErr      error
+	}{
+		{
+			name:  "known txs are included",
+			txids: []string{testdata.TX1Hash.String(), testdata.TX2Hash.String()},
+
+			expectedIncluded: 1,
+		},
+		{
+			name:  "invalid txid",
+			txids: []string{"invalid"},
+
+			expectedErr: metamorph.ErrBlockTemplateInvalid,
+		},
+		{
+			name:     "block templates disabled",
+			disabled: true,
+			txids:    []string{testdata.TX1Hash.String()},
+
+			expectedErr: metamorph.ErrBlockTemplatesDisabled,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var opts []metamorph.ServerOption
+			if !tc.disabled {
+				metamorphStore := blockTemplateStore(&store.Data{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK})
+				opts = append(opts, metamorph.WithBlockTemplates(metamorph.NewBlockTemplates(slog.Default(), metamorphStore, cache.NewMemoryStore())))
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), &storeMocks.MetamorphStoreMock{}, nil, nil, grpc_utils.ServerConfig{}, opts...)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			res, err := sut.PostBlockTemplate(context.Background(), &metamorph_api.PostBlockTemplateRequest{
+				Source:            "pool-1",
+				PreviousBlockHash: testdata.Block1Hash.String(),
+				Txids:             tc.txids,
+			})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedIncluded, res.GetIncludedTransactions())
+		})
+	}
+}
+
+func TestServer_GetTransactionGraph(t *testing.T) {
+	// chain of transactions: mined grandparent -> parent -> tx -> child
+	chainedTx := func(source *chainhash.Hash, satoshis uint64) *sdkTx.Transaction {
+		sourceTxID := sdkChainhash.Hash(*source)
+		tx := sdkTx.NewTransaction()
+		tx.AddInput(&sdkTx.TransactionInput{SourceTXID: &sourceTxID, UnlockingScript: &script.Script{}, SequenceNumber: 0xffffffff})
+		tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: satoshis, LockingScript: &script.Script{}})
+		return tx
+	}
+	hashOf := func(tx *sdkTx.Transaction) *chainhash.Hash {
+		hash := chainhash.Hash(*tx.TxID())
+		return &hash
+	}
+
+	grandparentTx := chainedTx(testdata.TX2Hash, 10000)
+	parentTx := chainedTx(hashOf(grandparentTx), 9000)
+	tx := chainedTx(hashOf(parentTx), 8990)
+	childTx := chainedTx(hashOf(tx), 8980)
+
+	stored := map[chainhash.Hash]*store.Data{
+		*hashOf(grandparentTx): {Hash: hashOf(grandparentTx), Status: metamorph_api.Status_MINED, RawTx: grandparentTx.Bytes()},
+		*hashOf(parentTx):      {Hash: hashOf(parentTx), Status: metamorph_api.Status_SEEN_ON_NETWORK, RawTx: parentTx.Bytes()},
+		*hashOf(tx):            {Hash: hashOf(tx), Status: metamorph_api.Status_REJECTED, RejectReason: "mempool min fee not met", RawTx: tx.Bytes()},
+		*hashOf(childTx):       {Hash: hashOf(childTx), Status: metamorph_api.Status_SEEN_IN_ORPHAN_MEMPOOL, RawTx: childTx.Bytes()},
+	}
+	children := map[chainhash.Hash]*chainhash.Hash{
+		*hashOf(grandparentTx): hashOf(parentTx),
+		*hashOf(parentTx):      hashOf(tx),
+		*hashOf(tx):            hashOf(childTx),
+	}
+
+	tt := []struct {
+		name     string
+		txID     string
+		maxNodes uint32
+
+		expectedErr       error
+		expectedTxIDs     []string
+		expectedTruncated bool
+	}{
+		{
+			name: "success - unmined ancestors and descendants",
+			txID: hashOf(tx).String(),
+
+			expectedTxIDs: []string{hashOf(tx).String(), hashOf(parentTx).String(), hashOf(childTx).String()},
+		},
+		{
+			name:     "success - truncated",
+			txID:     hashOf(tx).String(),
+			maxNodes: 2,
+
+			expectedTxIDs:     []string{hashOf(tx).String(), hashOf(parentTx).String()},
+			expectedTruncated: true,
+		},
+		{
+			name: "error - tx not found",
+			txID: testdata.TX1Hash.String(),
+
+			expectedErr: metamorph.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetFunc: func(_ context.Context, key []byte) (*store.Data, error) {
+					data, ok := stored[chainhash.Hash(key)]
+					if !ok {
+						return nil, store.ErrNotFound
+					}
+					return data, nil
+				},
+				GetManyFunc: func(_ context.Context, keys [][]byte) ([]*store.Data, error) {
+					var res []*store.Data
+					for _, key := range keys {
+						if data, ok := stored[chainhash.Hash(key)]; ok {
+							res = append(res, data)
+						}
+					}
+					return res, nil
+				},
+				GetChildHashesFunc: func(_ context.Context, hashes [][]byte) ([][]byte, error) {
+					var res [][]byte
+					for _, hash := range hashes {
+						if child, ok := children[chainhash.Hash(hash)]; ok {
+							res = append(res, child[:])
+						}
+					}
+					return res, nil
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			res, err := sut.GetTransactionGraph(context.Background(), &metamorph_api.TransactionGraphRequest{
+				Txid:     tc.txID,
+				MaxNodes: tc.maxNodes,
+			})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			txIDs := make([]string, 0, len(res.GetNodes()))
+			for _, node := range res.GetNodes() {
+				txIDs = append(txIDs, node.GetTxid())
+			}
+			require.Equal(t, tc.expectedTxIDs, txIDs)
+			require.Equal(t, tc.expectedTruncated, res.GetTruncated())
+
+			root := res.GetNodes()[0]
+			require.Equal(t, metamorph_api.Status_REJECTED, root.GetStatus())
+			require.Equal(t, []string{hashOf(parentTx).String()}, root.GetParents())
+			require.True(t, root.GetFeeKnown())
+			require.Equal(t, uint64(10), root.GetFee())
+
+			// the value of the output spent by the parent is known from the mined grandparent
+			parent := res.GetNodes()[1]
+			require.True(t, parent.GetFeeKnown())
+			require.Equal(t, uint64(1000), parent.GetFee())
+			require.Equal(t, []string{hashOf(tx).String()}, parent.GetChildren())
+		})
+	}
+}
+
+func TestServer_GetOutpointSpender(t *testing.T) {
+	spendingTx := func(vout uint32, satoshis uint64) *sdkTx.Transaction {
+		sourceTxID := sdkChainhash.Hash(*testdata.TX1Hash)
+		tx := sdkTx.NewTransaction()
+		tx.AddInput(&sdkTx.TransactionInput{SourceTXID: &sourceTxID, SourceTxOutIndex: vout, UnlockingScript: &script.Script{}, SequenceNumber: 0xffffffff})
+		tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: satoshis, LockingScript: &script.Script{}})
+		return tx
+	}
+	dataOf := func(tx *sdkTx.Transaction, status metamorph_api.Status) *store.Data {
+		hash := chainhash.Hash(*tx.TxID())
+		return &store.Data{Hash: &hash, Status: status, RawTx: tx.Bytes()}
+	}
+
+	spendingVout0 := dataOf(spendingTx(0, 1000), metamorph_api.Status_SEEN_ON_NETWORK)
+	doubleSpendingVout0 := dataOf(spendingTx(0, 900), metamorph_api.Status_MINED)
+	rejectedSpendingVout1 := dataOf(spendingTx(1, 1000), metamorph_api.Status_REJECTED)
+
+	tt := []struct {
+		name        string
+		vout        uint32
+		children    []*store.Data
+		getManyErr  error
+		txID        string
+		expectedErr error
+
+		expected *metamorph_api.OutpointSpender
+	}{
+		{
+			name:     "spent - mined double spend preferred",
+			children: []*store.Data{spendingVout0, doubleSpendingVout0, rejectedSpendingVout1},
+
+			expected: &metamorph_api.OutpointSpender{Spent: true, SpendingTxid: doubleSpendingVout0.Hash.String(), Status: metamorph_api.Status_MINED},
+		},
+		{
+			name:     "not spent - only spent by rejected tx",
+			vout:     1,
+			children: []*store.Data{spendingVout0, rejectedSpendingVout1},
+
+			expected: &metamorph_api.OutpointSpender{},
+		},
+		{
+			name:     "not spent - other output spent",
+			vout:     2,
+			children: []*store.Data{spendingVout0},
+
+			expected: &metamorph_api.OutpointSpender{},
+		},
+		{
+			name: "not spent - no children",
+
+			expected: &metamorph_api.OutpointSpender{},
+		},
+		{
+			name:       "error - failed to get children",
+			children:   []*store.Data{spendingVout0},
+			getManyErr: errors.New("db connection lost"),
+
+			expectedErr: errors.New("db connection lost"),
+		},
+		{
+			name: "error - invalid txid",
+			txID: testdata.TX1Hash.String() + "00",
+
+			expectedErr: chainhash.ErrHashStrSize,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetChildHashesFunc: func(_ context.Context, _ [][]byte) ([][]byte, error) {
+					res := make([][]byte, 0, len(tc.children))
+					for _, child := range tc.children {
+						res = append(res, child.Hash[:])
+					}
+					return res, nil
+				},
+				GetManyFunc: func(_ context.Context, _ [][]byte) ([]*store.Data, error) {
+					return tc.children, tc.getManyErr
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			txID := testdata.TX1Hash.String()
+			if tc.txID != "" {
+				txID = tc.txID
+			}
+
+			// when
+			actual, err := sut.GetOutpointSpender(context.Background(), &metamorph_api.OutpointSpenderRequest{
+				Txid: txID,
+				Vout: tc.vout,
+			})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected.GetSpent(), actual.GetSpent())
+			require.Equal(t, tc.expected.GetSpendingTxid(), actual.GetSpendingTxid())
+			require.Equal(t, tc.expected.GetStatus(), actual.GetStatus())
+		})
+	}
+}
+
+func TestServer_CancelScheduledBroadcast(t *testing.T) {
+	tt := []struct {
+		name      string
+		cancelErr error
+
+		expectedErr error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:      "not found",
+			cancelErr: store.ErrNotFound,
+
+			expectedErr: metamorph.ErrNotFound,
+		},
+		{
+			name:      "already broadcast",
+			cancelErr: store.ErrNotScheduled,
+
+			expectedErr: metamorph.ErrNotScheduled,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				CancelScheduledFunc: func(_ context.Context, hash *chainhash.Hash, cancelledAt time.Time) error {
+					require.Equal(t, testdata.TX1Hash, hash)
+					require.Equal(t, now, cancelledAt)
+					return tc.cancelErr
+				},
+			}
+
+			mqClient := newStatusUpdateClientMock(t)
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, mqClient, grpc_utils.ServerConfig{},
+				metamorph.WithServerNow(func() time.Time { return now }),
+				metamorph.WithServerPublishStatusUpdates(true),
+			)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			_, err = sut.CancelScheduledBroadcast(context.Background(), &metamorph_api.TransactionStatusRequest{
+				Txid: testdata.TX1Hash.String(),
+			})
+
+			// then
+			require.Len(t, metamorphStore.CancelScheduledCalls(), 1)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.Empty(t, mqClient.PublishMarshalCoreCalls())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, mqClient.PublishMarshalCoreCalls(), 1)
+		})
+	}
+}
+
+func TestServer_UnlockRecords(t *testing.T) {
+	tt := []struct {
+		name           string
+		instance       string
+		errSetUnlocked error
+
+		expectedRecordsAffected int64
+		expectedErr             error
+	}{
+		{
+			name:     "success",
+			instance: "metamorph-1",
+
+			expectedRecordsAffected: 5,
+		},
+		{
+			name: "instance missing",
+
+			expectedErr: metamorph.ErrInstanceMissing,
+		},
+		{
+			name:           "error",
+			instance:       "metamorph-1",
+			errSetUnlocked: errors.New("failed to set unlocked"),
+
+			expectedErr: errors.New("failed to set unlocked"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				SetUnlockedByNameFunc: func(_ context.Context, lockedBy string) (int64, error) {
+					require.Equal(t, tc.instance, lockedBy)
+					return 5, tc.errSetUnlocked
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.UnlockRecords(context.Background(), &metamorph_api.UnlockRecordsRequest{Instance: tc.instance})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedRecordsAffected, actual.GetRecordsAffected())
+		})
+	}
+}
+
+func TestServer_ReplayCallbacks(t *testing.T) {
+	tt := []struct {
+		name       string
+		withSender bool
+		data       []*store.Data
+		getManyErr error
+
+		expectedTxIDs     []string
+		expectedSentCount int
+		expectedErr       error
+	}{
+		{
+			name:       "success",
+			withSender: true,
+			data: []*store.Data{
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_MINED, Callbacks: []store.Callback{{CallbackURL: "https://callback.example.com"}}},
+				{Hash: testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+			},
+
+			expectedTxIDs:     []string{testdata.TX1Hash.String()},
+			expectedSentCount: 1,
+		},
+		{
+			name: "callbacks not enabled",
+
+			expectedErr: metamorph.ErrCallbacksDisabled,
+		},
+		{
+			name:       "failed to get transactions",
+			withSender: true,
+			getManyErr: errors.New("db connection lost"),
+
+			expectedErr: errors.New("db connection lost"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetManyFunc: func(_ context.Context, _ [][]byte) ([]*store.Data, error) {
+					return tc.data, tc.getManyErr
+				},
+			}
+			sender := &mocks.CallbackSenderMock{
+				SendCallbackFunc: func(_ context.Context, _ *store.Data) {},
+			}
+
+			var opts []metamorph.ServerOption
+			if tc.withSender {
+				opts = append(opts, metamorph.WithServerCallbackSender(sender))
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{}, opts...)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.ReplayCallbacks(context.Background(), &metamorph_api.TransactionsStatusRequest{
+				TxIDs: []string{testdata.TX1Hash.String(), testdata.TX2Hash.String()},
+			})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTxIDs, actual.GetTxIDs())
+			require.Len(t, sender.SendCallbackCalls(), tc.expectedSentCount)
+		})
+	}
+}
+
+func TestServer_Jobs(t *testing.T) {
+	tt := []struct {
+		name          string
+		withScheduler bool
+		job           string
+
+		expectedErr error
+	}{
+		{
+			name:          "success",
+			withScheduler: true,
+			job:           "reannounceunseen",
+		},
+		{
+			name:          "job not found",
+			withScheduler: true,
+			job:           "UnknownJob",
+
+			expectedErr: scheduler.ErrJobNotFound,
+		},
+		{
+			name: "jobs disabled",
+			job:  "ReAnnounceUnseen",
+
+			expectedErr: metamorph.ErrJobsDisabled,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			opts := []metamorph.ServerOption{}
+			if tc.withScheduler {
+				jobScheduler := scheduler.New(slog.Default())
+				defer jobScheduler.Shutdown()
+				require.NoError(t, jobScheduler.Add("ReAnnounceUnseen", scheduler.Every(time.Hour), func(_ context.Context) {}))
+				opts = append(opts, metamorph.WithServerScheduler(jobScheduler))
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), &storeMocks.MetamorphStoreMock{}, nil, nil, grpc_utils.ServerConfig{}, opts...)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			paused, pauseErr := sut.PauseJob(context.Background(), &metamorph_api.JobRequest{Name: tc.job})
+			jobs, listErr := sut.ListJobs(context.Background(), &emptypb.Empty{})
+			resumed, resumeErr := sut.ResumeJob(context.Background(), &metamorph_api.JobRequest{Name: tc.job})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, pauseErr, tc.expectedErr)
+				require.ErrorIs(t, resumeErr, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, pauseErr)
+			require.NoError(t, listErr)
+			require.NoError(t, resumeErr)
+			require.True(t, paused.GetPaused())
+			require.False(t, resumed.GetPaused())
+			require.Len(t, jobs.GetJobs(), 1)
+			require.Equal(t, "ReAnnounceUnseen", jobs.GetJobs()[0].GetName())
+			require.Equal(t, "@every 1h0m0s", jobs.GetJobs()[0].GetSchedule())
+			require.NotNil(t, jobs.GetJobs()[0].GetNextRun())
+		})
+	}
+}
+
+func TestServer_Peers(t *testing.T) {
+	t.Run("ban, list and unban", func(t *testing.T) {
+		// given
+		banManager, err := p2p.NewBanManager(slog.Default())
+		require.NoError(t, err)
+
+		sut, err := metamorph.NewServer(slog.Default(), &storeMocks.MetamorphStoreMock{}, nil, nil, grpc_utils.ServerConfig{}, metamorph.WithServerBanManager(banManager))
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		banned, banErr := sut.BanPeer(context.Background(), &metamorph_api.BanPeerRequest{Address: "localhost:18333", Duration: "2h"})
+		listed, listErr := sut.ListPeers(context.Background(), &emptypb.Empty{})
+		unbanned, unbanErr := sut.UnbanPeer(context.Background(), &metamorph_api.BanPeerRequest{Address: "localhost:18333"})
+		_, notBannedErr := sut.UnbanPeer(context.Background(), &metamorph_api.BanPeerRequest{Address: "localhost:18333"})
+		_, invalidErr := sut.BanPeer(context.Background(), &metamorph_api.BanPeerRequest{Address: "localhost:18333", Duration: "2 hours"})
+
+		// then
+		require.NoError(t, banErr)
+		require.NoError(t, listErr)
+		require.NoError(t, unbanErr)
+		require.Len(t, banned.GetBans(), 1)
+		require.Equal(t, "localhost:18333", banned.GetBans()[0].GetAddress())
+		require.Len(t, listed.GetBans(), 1)
+		require.Empty(t, unbanned.GetBans())
+		require.Equal(t, codes.NotFound, status.Code(notBannedErr))
+		require.Equal(t, codes.InvalidArgument, status.Code(invalidErr))
+	})
+
+	t.Run("peer bans disabled", func(t *testing.T) {
+		// given
+		sut, err := metamorph.NewServer(slog.Default(), &storeMocks.MetamorphStoreMock{}, nil, nil, grpc_utils.ServerConfig{})
+		require.NoError(t, err)
+		defer sut.GracefulStop()
+
+		// when
+		_, err = sut.ListPeers(context.Background(), &emptypb.Empty{})
+
+		// then
+		require.ErrorIs(t, err, metamorph.ErrPeerBansDisabled)
+	})
+}
+
+func TestServer_AnnotateTransaction(t *testing.T) {
+	tt := []struct {
+		name   string
+		note   string
+		labels []string
+		addErr error
+
+		expectedAnnotation *store.Annotation
+		expectedAddCalls   int
+		expectedErr        error
+	}{
+		{
+			name:   "success",
+			note:   " customer dispute #123 ",
+			labels: []string{"dispute", " dispute", ""},
+
+			expectedAnnotation: &store.Annotation{Hash: *testdata.TX1Hash, Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1"},
+			expectedAddCalls:   1,
+		},
+		{
+			name:   "labels only",
+			labels: []string{"manually-rebroadcast"},
+
+			expectedAnnotation: &store.Annotation{Hash: *testdata.TX1Hash, Note: "", Labels: []string{"manually-rebroadcast"}, Author: "ops-1"},
+			expectedAddCalls:   1,
+		},
+		{
+			name:   "empty",
+			note:   " ",
+			labels: []string{""},
+
+			expectedErr: metamorph.ErrAnnotationEmpty,
+		},
+		{
+			name: "too long",
+			note: strings.Repeat("a", 1001),
+
+			expectedErr: metamorph.ErrAnnotationTooLong,
+		},
+		{
+			name:   "not found",
+			note:   "customer dispute #123",
+			addErr: store.ErrNotFound,
+
+			expectedAddCalls: 1,
+			expectedErr:      metamorph.ErrNotFound,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				AddAnnotationFunc: func(_ context.Context, annotation store.Annotation) (store.Annotation, error) {
+					annotation.CreatedAt = testdata.Time
+					return annotation, tc.addErr
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			annotation, err := sut.AnnotateTransaction(context.Background(), &metamorph_api.AnnotateTransactionRequest{
+				Txid:   testdata.TX1Hash.String(),
+				Note:   tc.note,
+				Labels: tc.labels,
+				Author: "ops-1",
+			})
+
+			// then
+			require.Len(t, metamorphStore.AddAnnotationCalls(), tc.expectedAddCalls)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, *tc.expectedAnnotation, metamorphStore.AddAnnotationCalls()[0].Annotation)
+			require.Equal(t, tc.expectedAnnotation.Note, annotation.GetNote())
+			require.Equal(t, tc.expectedAnnotation.Labels, annotation.GetLabels())
+			require.Equal(t, "ops-1", annotation.GetAuthor())
+			require.Equal(t, timestamppb.New(testdata.Time), annotation.GetCreatedAt())
+		})
+	}
+}
+
+func TestServer_EraseClientData(t *testing.T) {
+	tt := []struct {
+		name   string
+		tenant string
+		txIDs  []string
+		reason string
+
+		expectedErasure    *store.Erasure
+		expectedEraseCalls int
+		expectedErr        error
+	}{
+		{
+			name:   "tenant",
+			tenant: "exchange-a",
+			reason: " request #42 ",
+
+			expectedErasure:    &store.Erasure{Tenant: "exchange-a", Hashes: [][]byte{}, ErasedBy: "ops-1", Reason: "request #42"},
+			expectedEraseCalls: 1,
+		},
+		{
+			name:   "transactions",
+			txIDs:  []string{testdata.TX1Hash.String()},
+			reason: "request #42",
+
+			expectedErasure:    &store.Erasure{Hashes: [][]byte{testdata.TX1Hash[:]}, ErasedBy: "ops-1", Reason: "request #42"},
+			expectedEraseCalls: 1,
+		},
+		{
+			name:   "neither tenant nor transactions",
+			reason: "request #42",
+
+			expectedErr: metamorph.ErrErasureFilter,
+		},
+		{
+			name:   "without reason",
+			tenant: "exchange-a",
+
+			expectedErr: metamorph.ErrErasureReason,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				EraseClientDataFunc: func(_ context.Context, erasure store.Erasure) (store.Erasure, error) {
+					erasure.ID = 7
+					erasure.ErasedAt = testdata.Time
+					erasure.Transactions = 3
+					return erasure, nil
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{})
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.EraseClientData(context.Background(), &metamorph_api.EraseClientDataRequest{
+				Tenant:   tc.tenant,
+				Txids:    tc.txIDs,
+				ErasedBy: "ops-1",
+				Reason:   tc.reason,
+			})
+
+			// then
+			require.Len(t, metamorphStore.EraseClientDataCalls(), tc.expectedEraseCalls)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, *tc.expectedErasure, metamorphStore.EraseClientDataCalls()[0].Erasure)
+			require.Equal(t, int64(7), actual.GetId())
+			require.Equal(t, int64(3), actual.GetTransactions())
+			require.ElementsMatch(t, tc.txIDs, actual.GetTxids())
+			require.Equal(t, timestamppb.New(testdata.Time), actual.GetErasedAt())
+		})
+	}
+}
+
+func TestServer_RejectTransactions(t *testing.T) {
+	tt := []struct {
+		name       string
+		reason     string
+		stored     []*store.Data
+		setBulkErr error
+
+		expectedStatus    metamorph_api.Status
+		expectedReason    string
+		expectedSetBulk   int
+		expectedCallbacks int
+		expectedErr       error
+	}{
+		{
+			name:   "rejected",
+			reason: " Merkle roots of the BEEF are invalid ",
+
+			expectedStatus:    metamorph_api.Status_REJECTED,
+			expectedReason:    "Merkle roots of the BEEF are invalid",
+			expectedSetBulk:   1,
+			expectedCallbacks: 1,
+		},
+		{
+			name:   "already stored",
+			reason: "Merkle roots of the BEEF are invalid",
+			stored: []*store.Data{{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, StoredAt: testdata.Time}},
+
+			expectedStatus: metamorph_api.Status_SEEN_ON_NETWORK,
+		},
+		{
+			name:       "failed to store",
+			reason:     "Merkle roots of the BEEF are invalid",
+			setBulkErr: errors.New("connection refused"),
+
+			expectedSetBulk: 1,
+			expectedErr:     errors.New("connection refused"),
+		},
+		{
+			name:   "without reason",
+			reason: " ",
+
+			expectedErr: metamorph.ErrRejectReasonEmpty,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				GetManyFunc: func(_ context.Context, keys [][]byte) ([]*store.Data, error) {
+					require.Equal(t, [][]byte{testdata.TX1Hash[:]}, keys)
+					return tc.stored, nil
+				},
+				SetBulkFunc: func(_ context.Context, data []*store.Data) error {
+					require.Len(t, data, 1)
+					require.Equal(t, metamorph_api.Status_REJECTED, data[0].Status)
+					require.Equal(t, testdata.TX1Raw.Bytes(), data[0].RawTx)
+					require.Equal(t, "https://callback.example.com", data[0].Callbacks[0].CallbackURL)
+					return tc.setBulkErr
+				},
+			}
+			sender := &mocks.CallbackSenderMock{
+				SendCallbackFunc: func(_ context.Context, data *store.Data) {
+					require.Equal(t, metamorph_api.Status_REJECTED, data.Status)
+				},
+			}
+
+			mqClient := newStatusUpdateClientMock(t)
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, mqClient, grpc_utils.ServerConfig{},
+				metamorph.WithServerCallbackSender(sender),
+				metamorph.WithServerNow(func() time.Time { return testdata.Time }),
+				metamorph.WithServerPublishStatusUpdates(true),
+			)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.RejectTransactions(context.Background(), &metamorph_api.RejectTransactionsRequest{
+				Transactions: []*metamorph_api.PostTransactionRequest{{RawTx: testdata.TX1Raw.Bytes(), CallbackUrl: "https://callback.example.com"}},
+				RejectReason: tc.reason,
+			})
+
+			// then
+			require.Len(t, metamorphStore.SetBulkCalls(), tc.expectedSetBulk)
+			require.Len(t, sender.SendCallbackCalls(), tc.expectedCallbacks)
+			require.Len(t, mqClient.PublishMarshalCoreCalls(), tc.expectedCallbacks)
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, actual.GetStatuses(), 1)
+			require.Equal(t, testdata.TX1Hash.String(), actual.GetStatuses()[0].GetTxid())
+			require.Equal(t, tc.expectedStatus, actual.GetStatuses()[0].GetStatus())
+			require.Equal(t, tc.expectedReason, actual.GetStatuses()[0].GetRejectReason())
+			require.Equal(t, timestamppb.New(testdata.Time), actual.GetStatuses()[0].GetStoredAt())
+		})
+	}
+}
+
+func TestServer_AddReverification(t *testing.T) {
+	tt := []struct {
+		name   string
+		txIDs  []string
+		addErr error
+
+		expectedAddCalls int
+		expectedErr      error
+	}{
+		{
+			name:  "added",
+			txIDs: []string{testdata.TX1Hash.String()},
+
+			expectedAddCalls: 1,
+		},
+		{
+			name:   "maximum number of transactions reached",
+			txIDs:  []string{testdata.TX1Hash.String()},
+			addErr: store.ErrReverificationsFull,
+
+			expectedAddCalls: 1,
+			expectedErr:      metamorph.ErrReverificationsFull,
+		},
+		{
+			name:  "invalid tx id",
+			txIDs: []string{"invalid"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				AddReverificationFunc: func(_ context.Context, reverification store.Reverification, maxTransactions int64) (store.Reverification, error) {
+					require.Equal(t, int64(10), maxTransactions)
+					reverification.ID = 3
+					return reverification, tc.addErr
+				},
+			}
+
+			sut, err := metamorph.NewServer(slog.Default(), metamorphStore, nil, nil, grpc_utils.ServerConfig{},
+				metamorph.WithServerNow(func() time.Time { return testdata.Time }),
+			)
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			actual, err := sut.AddReverification(context.Background(), &metamorph_api.AddReverificationRequest{
+				Reverification: &metamorph_api.Reverification{
+					Mode:    "defer",
+					Beef:    []byte{0x01},
+					Txids:   tc.txIDs,
+					Options: []byte(`{}`),
+				},
+				MaxTransactions: 10,
+			})
+
+			// then
+			require.Len(t, metamorphStore.AddReverificationCalls(), tc.expectedAddCalls)
+			if tc.expectedAddCalls == 0 {
+				require.Error(t, err)
+				return
+			}
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(3), actual.GetId())
+			require.Equal(t, "defer", actual.GetMode())
+			require.Equal(t, tc.txIDs, actual.GetTxids())
+			require.Equal(t, timestamppb.New(testdata.Time), actual.GetAddedAt())
+		})
+	}
+}
+
+func TestServer_LogLevels(t *testing.T) {
+	tt := []struct {
+		name      string
+		levels    *arcLogger.Levels
+		component string
+		level     string
+
+		expectedLevel slog.Level
+		expectedErr   error
+	}{
+		{
+			name:      "component",
+			levels:    arcLogger.NewLevels(arcLogger.LevelInfo),
+			component: "mtm",
+			level:     "DEBUG",
+
+			expectedLevel: arcLogger.LevelDebug,
+		},
+		{
+			name:      "process",
+			levels:    arcLogger.NewLevels(arcLogger.LevelInfo),
+			component: "",
+			level:     "WARN",
+
+			expectedLevel: arcLogger.LevelWarning,
+		},
+		{
+			name:      "invalid level",
+			levels:    arcLogger.NewLevels(arcLogger.LevelInfo),
+			component: "mtm",
+			level:     "VERBOSE",
+
+			expectedErr: arcLogger.ErrLoggerInvalidLogLevel,
+		},
+		{
+			name:      "disabled",
+			component: "mtm",
+			level:     "DEBUG",
+
+			expectedErr: arcLogger.ErrLevelsDisabled,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := metamorph.NewServer(slog.Default(), nil, nil, nil, grpc_utils.ServerConfig{}, metamorph.WithServerLogLevels(tc.levels))
+			require.NoError(t, err)
+			defer sut.GracefulStop()
+
+			// when
+			_, err = sut.SetLogLevel(context.Background(), &metamorph_api.LogLevelRequest{Component: tc.component, Level: tc.level})
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedLevel, tc.levels.Level(tc.component))
+
+			// when
+			_, err = sut.ResetLogLevel(context.Background(), &metamorph_api.LogLevelRequest{Component: tc.component})
+
+			// then
+			require.NoError(t, err)
+			if tc.component != "" {
+				require.Equal(t, arcLogger.LevelInfo, tc.levels.Level(tc.component))
+			}
+		})
+	}
+}
+
+// newStatusUpdateClientMock returns a message queue client expecting the status update of testdata.TX1Hash.
+func newStatusUpdateClientMock(t *testing.T) *mqMocks.MessageQueueClientMock {
+	t.Helper()
+
+	return &mqMocks.MessageQueueClientMock{
+		PublishMarshalCoreFunc: func(_ context.Context, topic string, m protoreflect.ProtoMessage) error {
+			require.Equal(t, mq.StatusUpdateTopic, topic)
+			txs, ok := m.(*blocktx_api.Transactions)
+			require.True(t, ok)
+			require.Len(t, txs.GetTransactions(), 1)
+			require.Equal(t, testdata.TX1Hash[:], txs.GetTransactions()[0].GetHash())
+			return nil
+		},
+	}
+}
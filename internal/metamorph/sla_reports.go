@@ -0,0 +1,46 @@
+package metamorph
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/slareport"
+)
+
+const slaReportsIntervalDefault = 15 * time.Minute
+
+// ComputeSLAReports computes the SLA reports per tenant of the current and the previous daily and weekly periods, the
+// reports of earlier periods are final.
+func ComputeSLAReports(ctx context.Context, p *Processor) []attribute.KeyValue {
+	var reports int64
+	for _, window := range slareport.Recent(p.now()) {
+		n, err := p.store.ComputeSLAReports(ctx, window.Period, window.Start, window.End)
+		if err != nil {
+			p.logger.Error("Failed to compute SLA reports", slog.String("period", window.Period), slog.Time("start", window.Start), slog.String("err", err.Error()))
+			continue
+		}
+		reports += n
+	}
+
+	return []attribute.KeyValue{attribute.Int64("reports", reports)}
+}
+
+func toSLAReportProto(report store.SLAReport) *metamorph_api.SLAReport {
+	return &metamorph_api.SLAReport{
+		Period:           report.Period,
+		PeriodStart:      timestamppb.New(report.PeriodStart),
+		Tenant:           report.Tenant,
+		Transactions:     report.Transactions,
+		SeenOnNetwork:    report.SeenOnNetwork,
+		P95TimeToSeenMs:  report.P95TimeToSeen.Milliseconds(),
+		Mined:            report.Mined,
+		P95TimeToMinedMs: report.P95TimeToMined.Milliseconds(),
+		ComputedAt:       timestamppb.New(report.ComputedAt),
+	}
+}
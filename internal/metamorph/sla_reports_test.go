@@ -0,0 +1,73 @@
+package metamorph_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/internal/cache"
+	"github.com/bitcoin-sv/arc/internal/metamorph"
+	"github.com/bitcoin-sv/arc/internal/metamorph/mocks"
+	storeMocks "github.com/bitcoin-sv/arc/internal/metamorph/store/mocks"
+)
+
+func TestComputeSLAReports(t *testing.T) {
+	tt := []struct {
+		name       string
+		computeErr error
+
+		expectedAttributes []attribute.KeyValue
+	}{
+		{
+			name: "success",
+
+			expectedAttributes: []attribute.KeyValue{attribute.Int64("reports", 8)},
+		},
+		{
+			name:       "error - compute",
+			computeErr: errors.New("db connection lost"),
+
+			expectedAttributes: []attribute.KeyValue{attribute.Int64("reports", 0)},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			metamorphStore := &storeMocks.MetamorphStoreMock{
+				ComputeSLAReportsFunc: func(_ context.Context, _ string, _ time.Time, _ time.Time) (int64, error) {
+					if tc.computeErr != nil {
+						return 0, tc.computeErr
+					}
+					return 2, nil
+				},
+				SetUnlockedByNameFunc: func(_ context.Context, _ string) (int64, error) { return 0, nil },
+			}
+
+			sut, err := metamorph.NewProcessor(metamorphStore, cache.NewMemoryStore(), &mocks.MediatorMock{}, nil,
+				metamorph.WithSLAReports(time.Minute),
+				metamorph.WithNow(func() time.Time { return time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC) }),
+			)
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			actual := metamorph.ComputeSLAReports(context.TODO(), sut)
+
+			// then
+			require.Equal(t, tc.expectedAttributes, actual)
+
+			calls := metamorphStore.ComputeSLAReportsCalls()
+			require.Len(t, calls, 4)
+			require.Equal(t, "daily", calls[0].Period)
+			require.Equal(t, time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC), calls[0].From)
+			require.Equal(t, time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), calls[1].To)
+			require.Equal(t, "weekly", calls[3].Period)
+			require.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), calls[3].From)
+		})
+	}
+}
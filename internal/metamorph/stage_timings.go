@@ -0,0 +1,136 @@
+package metamorph
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+// Stages of the processing of a transaction for which timestamps are recorded. The stage of the sending of callbacks
+// is recorded by callbacker.
+const (
+	StageReceived  = "received"
+	StageValidated = "validated"
+	StageStored    = "stored"
+	StageAnnounced = "announced"
+	StageSeen      = "seen"
+	StageMined     = "mined"
+)
+
+// stageStatuses maps the statuses of a transaction to the stages they represent.
+var stageStatuses = map[metamorph_api.Status]string{
+	metamorph_api.Status_ANNOUNCED_TO_NETWORK: StageAnnounced,
+	metamorph_api.Status_SEEN_ON_NETWORK:      StageSeen,
+	metamorph_api.Status_MINED:                StageMined,
+}
+
+// StageTiming is the time at which a transaction reached a stage. Latency is the time since the previous recorded
+// stage of the transaction.
+type StageTiming struct {
+	Stage     string
+	Timestamp time.Time
+	Latency   time.Duration
+}
+
+// stageTimings returns the timing breakdown of the recorded stages of the transaction in the order of the stages.
+// Stages which the transaction has not reached or for which no timestamp is known, e.g. validation if it was skipped,
+// are omitted.
+func stageTimings(data *store.Data) []StageTiming {
+	timestamps := map[string]time.Time{
+		StageReceived:  data.ReceivedAt,
+		StageValidated: data.ValidatedAt,
+		StageStored:    data.StoredAt,
+	}
+
+	setEarliest := func(stage string, timestamp time.Time) {
+		if timestamp.IsZero() {
+			return
+		}
+		current, found := timestamps[stage]
+		if !found || current.IsZero() || timestamp.Before(current) {
+			timestamps[stage] = timestamp
+		}
+	}
+
+	for _, sh := range data.StatusHistory {
+		if sh == nil {
+			continue
+		}
+
+		// fallback to the time of storage of transactions which were not submitted through the API
+		if sh.Status == metamorph_api.Status_RECEIVED && data.ReceivedAt.IsZero() {
+			setEarliest(StageReceived, sh.Timestamp)
+		}
+
+		if stage, found := stageStatuses[sh.Status]; found {
+			setEarliest(stage, sh.Timestamp)
+		}
+	}
+
+	if stage, found := stageStatuses[data.Status]; found {
+		setEarliest(stage, data.LastModified)
+	}
+
+	timings := make([]StageTiming, 0, len(timestamps))
+	var previous time.Time
+	for _, stage := range []string{StageReceived, StageValidated, StageStored, StageAnnounced, StageSeen, StageMined} {
+		timestamp := timestamps[stage]
+		if timestamp.IsZero() {
+			continue
+		}
+
+		timing := StageTiming{Stage: stage, Timestamp: timestamp}
+		if !previous.IsZero() && timestamp.After(previous) {
+			timing.Latency = timestamp.Sub(previous)
+		}
+		timings = append(timings, timing)
+		previous = timestamp
+	}
+
+	return timings
+}
+
+// observeStageLatency records the latency of the stage reached by the transaction with its current status, or of the
+// stages up to storage for a newly stored transaction.
+func (p *Processor) observeStageLatency(data *store.Data) {
+	var observed []string
+	switch {
+	case data.Status == metamorph_api.Status_STORED:
+		observed = []string{StageValidated, StageStored}
+	case stageStatuses[data.Status] != "":
+		observed = []string{stageStatuses[data.Status]}
+	default:
+		return
+	}
+
+	for _, timing := range stageTimings(data) {
+		for _, stage := range observed {
+			if timing.Stage == stage && timing.Latency > 0 {
+				p.stats.stageLatency.WithLabelValues(stage).Observe(timing.Latency.Seconds())
+			}
+		}
+	}
+}
+
+func toStageTimingsProto(timings []StageTiming) []*metamorph_api.StageTiming {
+	result := make([]*metamorph_api.StageTiming, 0, len(timings))
+	for _, timing := range timings {
+		result = append(result, &metamorph_api.StageTiming{
+			Stage:     timing.Stage,
+			Timestamp: timestamppb.New(timing.Timestamp),
+			LatencyMs: timing.Latency.Milliseconds(),
+		})
+	}
+	return result
+}
+
+// timeOrZero returns the time of the timestamp or the zero time if the timestamp is not set.
+func timeOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
@@ -0,0 +1,76 @@
+package metamorph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+func TestStageTimings(t *testing.T) {
+	receivedAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name string
+		data *store.Data
+
+		expected []StageTiming
+	}{
+		{
+			name: "submitted through API",
+			data: &store.Data{
+				Status:      metamorph_api.Status_SEEN_ON_NETWORK,
+				ReceivedAt:  receivedAt,
+				ValidatedAt: receivedAt.Add(20 * time.Millisecond),
+				StoredAt:    receivedAt.Add(50 * time.Millisecond),
+				StatusHistory: []*store.StatusWithTimestamp{
+					{Status: metamorph_api.Status_RECEIVED, Timestamp: receivedAt.Add(40 * time.Millisecond)},
+					{Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, Timestamp: receivedAt.Add(time.Second)},
+					{Status: metamorph_api.Status_ACCEPTED_BY_NETWORK, Timestamp: receivedAt.Add(2 * time.Second)},
+				},
+				LastModified: receivedAt.Add(3 * time.Second),
+			},
+
+			expected: []StageTiming{
+				{Stage: StageReceived, Timestamp: receivedAt},
+				{Stage: StageValidated, Timestamp: receivedAt.Add(20 * time.Millisecond), Latency: 20 * time.Millisecond},
+				{Stage: StageStored, Timestamp: receivedAt.Add(50 * time.Millisecond), Latency: 30 * time.Millisecond},
+				{Stage: StageAnnounced, Timestamp: receivedAt.Add(time.Second), Latency: 950 * time.Millisecond},
+				{Stage: StageSeen, Timestamp: receivedAt.Add(3 * time.Second), Latency: 2 * time.Second},
+			},
+		},
+		{
+			name: "submitted through message queue without validation",
+			data: &store.Data{
+				Status:   metamorph_api.Status_MINED,
+				StoredAt: receivedAt,
+				StatusHistory: []*store.StatusWithTimestamp{
+					{Status: metamorph_api.Status_RECEIVED, Timestamp: receivedAt.Add(-10 * time.Millisecond)},
+					{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: receivedAt.Add(time.Second)},
+					{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: receivedAt.Add(2 * time.Second)},
+				},
+				LastModified: receivedAt.Add(10 * time.Minute),
+			},
+
+			expected: []StageTiming{
+				{Stage: StageReceived, Timestamp: receivedAt.Add(-10 * time.Millisecond)},
+				{Stage: StageStored, Timestamp: receivedAt, Latency: 10 * time.Millisecond},
+				{Stage: StageSeen, Timestamp: receivedAt.Add(time.Second), Latency: time.Second},
+				{Stage: StageMined, Timestamp: receivedAt.Add(10 * time.Minute), Latency: 10*time.Minute - time.Second},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := stageTimings(tc.data)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -1,13 +1,15 @@
 package metamorph
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
-	"runtime/debug"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/internal/scheduler"
 )
 
 const (
@@ -37,6 +39,13 @@ type processorStats struct {
 	statusSeenOnNetworkTotal   prometheus.Gauge
 	connectedPeers             prometheus.Gauge
 	reconnectingPeers          prometheus.Gauge
+	stageLatency               *prometheus.HistogramVec
+	reconciliationDrift        *prometheus.CounterVec
+	statusRegressions          *prometheus.CounterVec
+	statusTimestampCorrections prometheus.Counter
+	statusTransitionsRejected  *prometheus.CounterVec
+	nodeSubmissions            *prometheus.CounterVec
+	consistencyDrift           *prometheus.GaugeVec
 }
 
 func WithLimits(notSeenLimit time.Duration, notFinalLimit time.Duration) func(*processorStats) {
@@ -104,6 +113,35 @@ func newProcessorStats(opts ...func(stats *processorStats)) *processorStats {
 			Name: "arc_metamorph_reconnecting_peers",
 			Help: "Current number of peers that are reconnecting",
 		}),
+		stageLatency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "arc_tx_stage_latency_seconds",
+			Help:    "Latency of transactions between the previous recorded stage and the stage, e.g. between stored and announced",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 11),
+		}, []string{"stage"}),
+		reconciliationDrift: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_reconciliation_drift_total",
+			Help: "Number of transactions whose status diverged from the node, by drift, e.g. mined according to the node but not in ARC",
+		}, []string{"drift"}),
+		statusRegressions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_metamorph_status_regressions_total",
+			Help: "Number of late lower-ranked statuses which did not regress the status of transactions, by handling, i.e. recorded in the status history or dropped",
+		}, []string{"handling"}),
+		statusTimestampCorrections: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "arc_metamorph_status_timestamp_corrections_total",
+			Help: "Number of status updates whose timestamp preceded the timestamp of the current status and was corrected",
+		}),
+		statusTransitionsRejected: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_metamorph_status_transitions_rejected_total",
+			Help: "Number of status updates rejected because the status flow does not lead from the current status to the updated status, by current and updated status",
+		}, []string{"from", "to"}),
+		nodeSubmissions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_metamorph_node_submissions_total",
+			Help: "Number of transactions not requested by any peer which were submitted to the node with sendrawtransaction, by result, i.e. accepted or rejected",
+		}, []string{"result"}),
+		consistencyDrift: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "arc_metamorph_consistency_drift",
+			Help: "Number of transactions in the recently mined blocks whose mined status diverged between metamorph and blocktx in the last consistency check, by drift",
+		}, []string{"drift"}),
 		notSeenLimit:  notSeenLimitDefault,
 		notFinalLimit: notFinalLimitDefault,
 	}
@@ -125,7 +163,12 @@ func newProcessorStats(opts ...func(stats *processorStats)) *processorStats {
 }
 
 func (p *Processor) StartCollectStats() error {
-	ticker := time.NewTicker(p.statCollectionInterval)
+	if p.knownTxFilter != nil {
+		err := registerStats(p.knownTxFilter)
+		if err != nil {
+			return err
+		}
+	}
 
 	err := registerStats(
 		p.stats.statusStored,
@@ -144,80 +187,91 @@ func (p *Processor) StartCollectStats() error {
 		p.stats.statusMinedTotal,
 		p.stats.connectedPeers,
 		p.stats.reconnectingPeers,
+		p.stats.stageLatency,
+		p.stats.reconciliationDrift,
+		p.stats.statusRegressions,
+		p.stats.statusTimestampCorrections,
+		p.stats.statusTransitionsRejected,
+		p.stats.nodeSubmissions,
+		p.stats.consistencyDrift,
 	)
 	if err != nil {
 		return err
 	}
 
 	p.waitGroup.Add(1)
-
 	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				p.logger.Error("Recovered from panic", slog.Any("panic", r), slog.String("stacktrace", string(debug.Stack())))
-			}
-		}()
-		defer func() {
-			unregisterStats(
-				p.stats.statusStored,
-				p.stats.statusAnnouncedToNetwork,
-				p.stats.statusRequestedByNetwork,
-				p.stats.statusSentToNetwork,
-				p.stats.statusAcceptedByNetwork,
-				p.stats.statusSeenInOrphanMempool,
-				p.stats.statusSeenOnNetwork,
-				p.stats.statusDoubleSpendAttempted,
-				p.stats.statusRejected,
-				p.stats.statusMined,
-				p.stats.statusNotFinal,
-				p.stats.statusNotSeen,
-				p.stats.statusSeenOnNetworkTotal,
-				p.stats.statusMinedTotal,
-				p.stats.connectedPeers,
-				p.stats.reconnectingPeers,
-			)
-			p.waitGroup.Done()
-		}()
-
-		for {
-			select {
-			case <-p.ctx.Done():
-				return
-			case <-ticker.C:
-
-				getStatsSince := p.now().Add(-1 * p.rebroadcastExpiration)
-
-				collectedStats, err := p.store.GetStats(p.ctx, getStatsSince, p.stats.notSeenLimit, p.stats.notFinalLimit)
-				if err != nil {
-					p.logger.Error("failed to get stats", slog.String("err", err.Error()))
-					continue
-				}
-
-				connectedPeers := int(p.bcMediator.CountConnectedPeers()) // #nosec G115
-
-				p.stats.statusStored.Set(float64(collectedStats.StatusStored))
-				p.stats.statusAnnouncedToNetwork.Set(float64(collectedStats.StatusAnnouncedToNetwork))
-				p.stats.statusRequestedByNetwork.Set(float64(collectedStats.StatusRequestedByNetwork))
-				p.stats.statusSentToNetwork.Set(float64(collectedStats.StatusSentToNetwork))
-				p.stats.statusAcceptedByNetwork.Set(float64(collectedStats.StatusAcceptedByNetwork))
-				p.stats.statusSeenInOrphanMempool.Set(float64(collectedStats.StatusSeenInOrphanMempool))
-				p.stats.statusSeenOnNetwork.Set(float64(collectedStats.StatusSeenOnNetwork))
-				p.stats.statusDoubleSpendAttempted.Set(float64(collectedStats.StatusDoubleSpendAttempted))
-				p.stats.statusRejected.Set(float64(collectedStats.StatusRejected))
-				p.stats.statusMined.Set(float64(collectedStats.StatusMined))
-				p.stats.statusNotFinal.Set(float64(collectedStats.StatusNotFinal))
-				p.stats.statusNotSeen.Set(float64(collectedStats.StatusNotSeen))
-				p.stats.statusSeenOnNetworkTotal.Set(float64(collectedStats.StatusSeenOnNetworkTotal))
-				p.stats.statusMinedTotal.Set(float64(collectedStats.StatusMinedTotal))
-				p.stats.connectedPeers.Set(float64(connectedPeers))
-				p.stats.reconnectingPeers.Set(float64(len(p.bcMediator.GetPeers()) - connectedPeers))
-			}
+		defer p.waitGroup.Done()
+
+		<-p.ctx.Done()
+
+		unregisterStats(
+			p.stats.statusStored,
+			p.stats.statusAnnouncedToNetwork,
+			p.stats.statusRequestedByNetwork,
+			p.stats.statusSentToNetwork,
+			p.stats.statusAcceptedByNetwork,
+			p.stats.statusSeenInOrphanMempool,
+			p.stats.statusSeenOnNetwork,
+			p.stats.statusDoubleSpendAttempted,
+			p.stats.statusRejected,
+			p.stats.statusMined,
+			p.stats.statusNotFinal,
+			p.stats.statusNotSeen,
+			p.stats.statusSeenOnNetworkTotal,
+			p.stats.statusMinedTotal,
+			p.stats.connectedPeers,
+			p.stats.reconnectingPeers,
+			p.stats.stageLatency,
+			p.stats.reconciliationDrift,
+			p.stats.statusRegressions,
+			p.stats.statusTimestampCorrections,
+			p.stats.statusTransitionsRejected,
+			p.stats.nodeSubmissions,
+			p.stats.consistencyDrift,
+		)
+		if p.knownTxFilter != nil {
+			unregisterStats(p.knownTxFilter)
 		}
 	}()
 
+	err = p.scheduler.Add("CollectStats", scheduler.Every(p.statCollectionInterval), p.collectStats)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func (p *Processor) collectStats(ctx context.Context) {
+	getStatsSince := p.now().Add(-1 * p.rebroadcastExpiration)
+
+	collectedStats, err := p.store.GetStats(ctx, getStatsSince, p.stats.notSeenLimit, p.stats.notFinalLimit)
+	if err != nil {
+		p.logger.Error("failed to get stats", slog.String("err", err.Error()))
+		return
+	}
+
+	connectedPeers := int(p.bcMediator.CountConnectedPeers()) // #nosec G115
+
+	p.stats.statusStored.Set(float64(collectedStats.StatusStored))
+	p.stats.statusAnnouncedToNetwork.Set(float64(collectedStats.StatusAnnouncedToNetwork))
+	p.stats.statusRequestedByNetwork.Set(float64(collectedStats.StatusRequestedByNetwork))
+	p.stats.statusSentToNetwork.Set(float64(collectedStats.StatusSentToNetwork))
+	p.stats.statusAcceptedByNetwork.Set(float64(collectedStats.StatusAcceptedByNetwork))
+	p.stats.statusSeenInOrphanMempool.Set(float64(collectedStats.StatusSeenInOrphanMempool))
+	p.stats.statusSeenOnNetwork.Set(float64(collectedStats.StatusSeenOnNetwork))
+	p.stats.statusDoubleSpendAttempted.Set(float64(collectedStats.StatusDoubleSpendAttempted))
+	p.stats.statusRejected.Set(float64(collectedStats.StatusRejected))
+	p.stats.statusMined.Set(float64(collectedStats.StatusMined))
+	p.stats.statusNotFinal.Set(float64(collectedStats.StatusNotFinal))
+	p.stats.statusNotSeen.Set(float64(collectedStats.StatusNotSeen))
+	p.stats.statusSeenOnNetworkTotal.Set(float64(collectedStats.StatusSeenOnNetworkTotal))
+	p.stats.statusMinedTotal.Set(float64(collectedStats.StatusMinedTotal))
+	p.stats.connectedPeers.Set(float64(connectedPeers))
+	p.stats.reconnectingPeers.Set(float64(len(p.bcMediator.GetPeers()) - connectedPeers))
+}
+
 func registerStats(cs ...prometheus.Collector) error {
 	for _, c := range cs {
 		err := prometheus.Register(c)
@@ -0,0 +1,360 @@
+package status_export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/upload"
+)
+
+const (
+	rotateIntervalDefault = 5 * time.Minute
+	maxFileSizeDefault    = 64 * 1024 * 1024
+	uploadTimeoutDefault  = 2 * time.Minute
+	filePrefixDefault     = "status-events"
+	fileTimeLayout        = "20060102T150405.000000000Z"
+	fileExtension         = ".ndjson"
+	partialFileExtension  = ".ndjson.part"
+)
+
+var (
+	ErrNoUploadCommand       = errors.New("no upload command given")
+	ErrFailedToCreateDir     = errors.New("failed to create export directory")
+	ErrFailedToWriteEvents   = errors.New("failed to write status events")
+	ErrFailedToRotateFile    = errors.New("failed to rotate export file")
+	ErrUploadCommandFailed   = upload.ErrCommandFailed
+	ErrFailedToListUploads   = errors.New("failed to list export files to upload")
+	ErrFailedToRemoveUploads = errors.New("failed to remove uploaded export file")
+)
+
+// Event is a status change of a transaction as exported to the files.
+type Event struct {
+	TxID         string    `json:"txid"`
+	Status       string    `json:"txStatus"`
+	Timestamp    time.Time `json:"timestamp"`
+	BlockHash    string    `json:"blockHash,omitempty"`
+	BlockHeight  uint64    `json:"blockHeight,omitempty"`
+	MinedTxID    string    `json:"minedTxid,omitempty"`
+	RejectReason string    `json:"rejectReason,omitempty"`
+	CompetingTxs []string  `json:"competingTxs,omitempty"`
+}
+
+// Exporter appends the status change events of transactions as newline-delimited JSON to files in a local directory.
+// The files are rotated once they reach the maximum size or the rotation interval has passed and are then uploaded
+// to object storage with an external command, e.g. `aws s3 cp {file} s3://bucket/arc/{name}` or
+// `gsutil cp {file} gs://bucket/arc/{name}`. The path of the file and its name are passed to the command with the
+// placeholders `{file}` and `{name}`. Uploaded files are removed, files which failed to upload are retried with the
+// next rotation.
+type Exporter struct {
+	logger         *slog.Logger
+	dir            string
+	prefix         string
+	uploadCommand  []string
+	uploadTimeout  time.Duration
+	rotateInterval time.Duration
+	maxFileSize    int64
+	now            func() time.Time
+
+	mu       sync.Mutex
+	file     *os.File
+	writer   *bufio.Writer
+	fileSize int64
+	openedAt time.Time
+
+	uploadCh  chan struct{}
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithRotateInterval(d time.Duration) func(*Exporter) {
+	return func(e *Exporter) {
+		e.rotateInterval = d
+	}
+}
+
+// WithMaxFileSize sets the size in bytes after which the export file is rotated.
+func WithMaxFileSize(size int64) func(*Exporter) {
+	return func(e *Exporter) {
+		e.maxFileSize = size
+	}
+}
+
+func WithUploadTimeout(d time.Duration) func(*Exporter) {
+	return func(e *Exporter) {
+		e.uploadTimeout = d
+	}
+}
+
+// WithFilePrefix sets the prefix of the file names, which should be unique per instance if several instances upload
+// to the same location.
+func WithFilePrefix(prefix string) func(*Exporter) {
+	return func(e *Exporter) {
+		e.prefix = prefix
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Exporter) {
+	return func(e *Exporter) {
+		e.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, dir string, uploadCommand []string, opts ...func(*Exporter)) (*Exporter, error) {
+	if len(uploadCommand) == 0 {
+		return nil, ErrNoUploadCommand
+	}
+
+	e := &Exporter{
+		logger:         logger.With(slog.String("module", "status-export")),
+		dir:            dir,
+		prefix:         filePrefixDefault,
+		uploadCommand:  uploadCommand,
+		uploadTimeout:  uploadTimeoutDefault,
+		rotateInterval: rotateIntervalDefault,
+		maxFileSize:    maxFileSizeDefault,
+		now:            time.Now,
+		uploadCh:       make(chan struct{}, 1),
+		waitGroup:      &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateDir, err)
+	}
+
+	// files which were still being written when the exporter stopped are completed, so that they are uploaded
+	partialFiles, err := filepath.Glob(filepath.Join(dir, e.prefix+"-*"+partialFileExtension))
+	if err != nil {
+		return nil, errors.Join(ErrFailedToRotateFile, err)
+	}
+	for _, partialFile := range partialFiles {
+		err = os.Rename(partialFile, strings.TrimSuffix(partialFile, partialFileExtension)+fileExtension)
+		if err != nil {
+			return nil, errors.Join(ErrFailedToRotateFile, err)
+		}
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	e.cancelAll = cancelAll
+	e.ctx = ctx
+
+	return e, nil
+}
+
+// Export appends a status change event for each of the transactions to the current export file.
+func (e *Exporter) Export(data []*store.Data) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	timestamp := e.now().UTC()
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, d := range data {
+		line, err := json.Marshal(NewEvent(d, timestamp))
+		if err != nil {
+			return errors.Join(ErrFailedToWriteEvents, err)
+		}
+
+		if e.file == nil {
+			err = e.openFile()
+			if err != nil {
+				return errors.Join(ErrFailedToWriteEvents, err)
+			}
+		}
+
+		line = append(line, '\n')
+		n, err := e.writer.Write(line)
+		e.fileSize += int64(n)
+		if err != nil {
+			return errors.Join(ErrFailedToWriteEvents, err)
+		}
+
+		if e.fileSize >= e.maxFileSize {
+			err = e.rotate()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if e.writer == nil {
+		return nil
+	}
+
+	return e.writer.Flush()
+}
+
+// NewEvent returns the event of the status of the transaction at the given time.
+func NewEvent(data *store.Data, timestamp time.Time) Event {
+	event := Event{
+		Status:       data.Status.String(),
+		Timestamp:    timestamp,
+		BlockHeight:  data.BlockHeight,
+		RejectReason: data.RejectReason,
+		CompetingTxs: data.CompetingTxs,
+	}
+
+	if data.Hash != nil {
+		event.TxID = data.Hash.String()
+	}
+
+	if data.BlockHash != nil {
+		event.BlockHash = data.BlockHash.String()
+	}
+
+	if data.MinedHash != nil {
+		event.MinedTxID = data.MinedHash.String()
+	}
+
+	return event
+}
+
+func (e *Exporter) openFile() error {
+	openedAt := e.now().UTC()
+	name := fmt.Sprintf("%s-%s%s", e.prefix, openedAt.Format(fileTimeLayout), partialFileExtension)
+
+	file, err := os.OpenFile(filepath.Join(e.dir, name), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o640)
+	if err != nil {
+		return err
+	}
+
+	e.file = file
+	e.writer = bufio.NewWriter(file)
+	e.fileSize = 0
+	e.openedAt = openedAt
+
+	return nil
+}
+
+// rotate closes the current export file and marks it as complete for the upload.
+func (e *Exporter) rotate() error {
+	if e.file == nil {
+		return nil
+	}
+
+	partialFile := e.file.Name()
+
+	err := e.writer.Flush()
+	if err != nil {
+		return errors.Join(ErrFailedToRotateFile, err)
+	}
+
+	err = e.file.Close()
+	e.file = nil
+	e.writer = nil
+	if err != nil {
+		return errors.Join(ErrFailedToRotateFile, err)
+	}
+
+	err = os.Rename(partialFile, strings.TrimSuffix(partialFile, partialFileExtension)+fileExtension)
+	if err != nil {
+		return errors.Join(ErrFailedToRotateFile, err)
+	}
+
+	select {
+	case e.uploadCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Start rotates the export file every rotation interval and uploads the completed files after each rotation.
+func (e *Exporter) Start() {
+	ticker := time.NewTicker(e.rotateInterval)
+	e.waitGroup.Add(1)
+
+	go func() {
+		defer func() {
+			ticker.Stop()
+			e.waitGroup.Done()
+		}()
+
+		for {
+			select {
+			case <-e.ctx.Done():
+				return
+			case <-ticker.C:
+				e.mu.Lock()
+				var err error
+				if e.file != nil && !e.now().Before(e.openedAt.Add(e.rotateInterval)) {
+					err = e.rotate()
+				}
+				e.mu.Unlock()
+				if err != nil {
+					e.logger.Error("Failed to rotate export file", slog.String("err", err.Error()))
+				}
+			case <-e.uploadCh:
+			}
+
+			err := e.Upload(e.ctx)
+			if err != nil {
+				e.logger.Error("Failed to upload export files", slog.String("err", err.Error()))
+			}
+		}
+	}()
+}
+
+// Upload uploads all completed export files and removes them once they are uploaded.
+func (e *Exporter) Upload(ctx context.Context) error {
+	files, err := filepath.Glob(filepath.Join(e.dir, e.prefix+"-*"+fileExtension))
+	if err != nil {
+		return errors.Join(ErrFailedToListUploads, err)
+	}
+
+	for _, file := range files {
+		err = e.upload(ctx, file)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("file: %s", file))
+		}
+
+		err = os.Remove(file)
+		if err != nil {
+			return errors.Join(ErrFailedToRemoveUploads, err)
+		}
+
+		e.logger.Info("Uploaded export file", slog.String("file", filepath.Base(file)))
+	}
+
+	return nil
+}
+
+func (e *Exporter) upload(ctx context.Context, file string) error {
+	return upload.Run(ctx, e.uploadCommand, file, e.uploadTimeout)
+}
+
+// Shutdown stops the rotation, rotates the current export file and makes a last attempt to upload the completed files.
+func (e *Exporter) Shutdown() {
+	e.cancelAll()
+	e.waitGroup.Wait()
+
+	e.mu.Lock()
+	err := e.rotate()
+	e.mu.Unlock()
+	if err != nil {
+		e.logger.Error("Failed to rotate export file", slog.String("err", err.Error()))
+	}
+
+	err = e.Upload(context.Background())
+	if err != nil {
+		e.logger.Error("Failed to upload export files", slog.String("err", err.Error()))
+	}
+}
@@ -0,0 +1,173 @@
+package status_export_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/status_export"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+func TestExporter_Export(t *testing.T) {
+	tt := []struct {
+		name        string
+		maxFileSize int64
+
+		expectedFiles        int
+		expectedPartialFiles int
+	}{
+		{
+			name:        "no rotation",
+			maxFileSize: 1024 * 1024,
+
+			expectedFiles:        0,
+			expectedPartialFiles: 1,
+		},
+		{
+			name:        "rotation after each event",
+			maxFileSize: 1,
+
+			expectedFiles:        3,
+			expectedPartialFiles: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+			sut, err := status_export.New(slog.Default(), dir, []string{"true"},
+				status_export.WithMaxFileSize(tc.maxFileSize),
+				status_export.WithNow(func() time.Time {
+					now = now.Add(time.Millisecond)
+					return now
+				}),
+			)
+			require.NoError(t, err)
+
+			data := []*store.Data{
+				{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK},
+				{Hash: testdata.TX2Hash, Status: metamorph_api.Status_MINED, BlockHash: testdata.Block1Hash, BlockHeight: 100},
+				{Hash: testdata.TX3Hash, Status: metamorph_api.Status_REJECTED, RejectReason: "txn-mempool-conflict"},
+			}
+
+			// when
+			err = sut.Export(data)
+
+			// then
+			require.NoError(t, err)
+
+			files, err := filepath.Glob(filepath.Join(dir, "status-events-*.ndjson"))
+			require.NoError(t, err)
+			require.Len(t, files, tc.expectedFiles)
+
+			partialFiles, err := filepath.Glob(filepath.Join(dir, "status-events-*.ndjson.part"))
+			require.NoError(t, err)
+			require.Len(t, partialFiles, tc.expectedPartialFiles)
+
+			events := readEvents(t, append(files, partialFiles...))
+			require.Len(t, events, 3)
+			require.Equal(t, testdata.TX1Hash.String(), events[0].TxID)
+			require.Equal(t, metamorph_api.Status_SEEN_ON_NETWORK.String(), events[0].Status)
+			require.Equal(t, testdata.Block1Hash.String(), events[1].BlockHash)
+			require.Equal(t, uint64(100), events[1].BlockHeight)
+			require.Equal(t, "txn-mempool-conflict", events[2].RejectReason)
+		})
+	}
+}
+
+func TestExporter_Upload(t *testing.T) {
+	tt := []struct {
+		name          string
+		uploadCommand func(uploadDir string) []string
+
+		expectedErrorStr  string
+		expectedUploaded  int
+		expectedRemaining int
+	}{
+		{
+			name: "success",
+			uploadCommand: func(uploadDir string) []string {
+				return []string{"cp", "{file}", filepath.Join(uploadDir, "{name}")}
+			},
+
+			expectedUploaded:  1,
+			expectedRemaining: 0,
+		},
+		{
+			name: "error - upload command failed",
+			uploadCommand: func(_ string) []string {
+				return []string{"false"}
+			},
+
+			expectedErrorStr:  status_export.ErrUploadCommandFailed.Error(),
+			expectedUploaded:  0,
+			expectedRemaining: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			uploadDir := t.TempDir()
+
+			// a file left behind by a previous run is completed on start
+			err := os.WriteFile(filepath.Join(dir, "status-events-20260101T120000.000000000Z.ndjson.part"), []byte("{}\n"), 0o600)
+			require.NoError(t, err)
+
+			sut, err := status_export.New(slog.Default(), dir, tc.uploadCommand(uploadDir))
+			require.NoError(t, err)
+
+			// when
+			err = sut.Upload(context.Background())
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			uploaded, err := filepath.Glob(filepath.Join(uploadDir, "status-events-*.ndjson"))
+			require.NoError(t, err)
+			require.Len(t, uploaded, tc.expectedUploaded)
+
+			remaining, err := filepath.Glob(filepath.Join(dir, "status-events-*"))
+			require.NoError(t, err)
+			require.Len(t, remaining, tc.expectedRemaining)
+		})
+	}
+}
+
+func readEvents(t *testing.T, files []string) []status_export.Event {
+	t.Helper()
+
+	var events []status_export.Event
+	for _, file := range files {
+		f, err := os.Open(file)
+		require.NoError(t, err)
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			var event status_export.Event
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			events = append(events, event)
+		}
+		require.NoError(t, scanner.Err())
+		require.NoError(t, f.Close())
+	}
+
+	return events
+}
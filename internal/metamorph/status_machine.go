@@ -0,0 +1,156 @@
+package metamorph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+// AnyStatus matches every status in the registration of a transition hook.
+const AnyStatus metamorph_api.Status = -1
+
+var ErrInvalidStatusTransition = errors.New("invalid status transition")
+
+// StatusStep is a declared step of the status flow of a transaction.
+type StatusStep struct {
+	From        metamorph_api.Status
+	To          metamorph_api.Status
+	Description string
+}
+
+// statusSteps declares the status flow of a transaction. The statuses between two statuses of the flow are often not
+// observed, e.g. a transaction announced to the peers can be seen on the network without having been requested from
+// ARC, therefore a transition is valid if the status flow leads from one status to the other.
+var statusSteps = []StatusStep{
+	{metamorph_api.Status_UNKNOWN, metamorph_api.Status_QUEUED, "Transaction could not be transmitted\\n to metamorph within timeout duration"},
+	{metamorph_api.Status_UNKNOWN, metamorph_api.Status_RECEIVED, "Transaction validation passed"},
+	{metamorph_api.Status_QUEUED, metamorph_api.Status_RECEIVED, "Transaction received by metamorph"},
+	{metamorph_api.Status_RECEIVED, metamorph_api.Status_STORED, "Transaction has been stored in ARC"},
+	{metamorph_api.Status_STORED, metamorph_api.Status_ANNOUNCED_TO_NETWORK, "Transaction ID has been announced to\\n P2P network via an INV message"},
+	{metamorph_api.Status_STORED, metamorph_api.Status_WAITING_FOR_FINALITY, "The lock time of the transaction\\n is not reached yet"},
+	{metamorph_api.Status_WAITING_FOR_FINALITY, metamorph_api.Status_ANNOUNCED_TO_NETWORK, "Transaction became final by height\\n or median time past and was announced"},
+	{metamorph_api.Status_ANNOUNCED_TO_NETWORK, metamorph_api.Status_REQUESTED_BY_NETWORK, "Peer has requested the transaction\\n with a GETDATA message"},
+	{metamorph_api.Status_REQUESTED_BY_NETWORK, metamorph_api.Status_SENT_TO_NETWORK, "Transaction has been sent to peer"},
+	{metamorph_api.Status_SENT_TO_NETWORK, metamorph_api.Status_ACCEPTED_BY_NETWORK, "The transaction has been accepted\\n by peer on the ZMQ interface"},
+	{metamorph_api.Status_SENT_TO_NETWORK, metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, "This transaction has competing transactions"},
+	{metamorph_api.Status_SENT_TO_NETWORK, metamorph_api.Status_REJECTED, "Peer has sent a REJECT message"},
+	{metamorph_api.Status_ACCEPTED_BY_NETWORK, metamorph_api.Status_SEEN_ON_NETWORK, "ARC has received Transaction ID\\n announcement from another peer"},
+	{metamorph_api.Status_ACCEPTED_BY_NETWORK, metamorph_api.Status_SEEN_IN_ORPHAN_MEMPOOL, "Peer has sent a 'missing inputs' message"},
+	{metamorph_api.Status_SEEN_IN_ORPHAN_MEMPOOL, metamorph_api.Status_SEEN_ON_NETWORK, "All parent transactions\\n have been received by peer"},
+	{metamorph_api.Status_SEEN_ON_NETWORK, metamorph_api.Status_MINED, "Transaction ID was included in a BLOCK message"},
+	{metamorph_api.Status_SEEN_ON_NETWORK, metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, "A competing transactions entered the mempool"},
+	{metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, metamorph_api.Status_MINED, "This transaction was accepted and mined"},
+	{metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, metamorph_api.Status_REJECTED, "This transaction was rejected in favor\\n of one of the competing transactions"},
+	{metamorph_api.Status_REJECTED, metamorph_api.Status_MINED, "Transaction rejected by a peer\\n was mined nevertheless"},
+	{metamorph_api.Status_SEEN_ON_NETWORK, metamorph_api.Status_EXPIRED, "The validity window of the transaction\\n elapsed before it was mined"},
+	{metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, metamorph_api.Status_EXPIRED, "The validity window of the transaction\\n elapsed before it was mined"},
+	{metamorph_api.Status_EXPIRED, metamorph_api.Status_REJECTED, "Expired transaction was rejected by a peer"},
+	{metamorph_api.Status_EXPIRED, metamorph_api.Status_MINED, "Expired transaction was mined nevertheless"},
+	{metamorph_api.Status_MINED, metamorph_api.Status_MINED_IN_STALE_BLOCK, "This transaction was mined in a block that became stale after reorg"},
+	{metamorph_api.Status_MINED_IN_STALE_BLOCK, metamorph_api.Status_MINED, "Transaction was mined in a block\\n of the longest chain"},
+}
+
+// statusTransitions contains the valid transitions, i.e. all statuses which can be reached from a status following the
+// status flow.
+var statusTransitions = reachableStatuses(statusSteps)
+
+func reachableStatuses(steps []StatusStep) map[metamorph_api.Status]map[metamorph_api.Status]struct{} {
+	next := make(map[metamorph_api.Status][]metamorph_api.Status)
+	for _, step := range steps {
+		next[step.From] = append(next[step.From], step.To)
+	}
+
+	reachable := make(map[metamorph_api.Status]map[metamorph_api.Status]struct{})
+	for from := range next {
+		reached := make(map[metamorph_api.Status]struct{})
+		queue := append([]metamorph_api.Status{}, next[from]...)
+		for len(queue) > 0 {
+			status := queue[0]
+			queue = queue[1:]
+			if _, found := reached[status]; found {
+				continue
+			}
+			reached[status] = struct{}{}
+			queue = append(queue, next[status]...)
+		}
+		// a status is not a transition to itself, even if the flow leads back to the status
+		delete(reached, from)
+		reachable[from] = reached
+	}
+
+	return reachable
+}
+
+// canTransition returns whether the status of a transaction can change from one status to the other.
+func canTransition(from, to metamorph_api.Status) bool {
+	_, found := statusTransitions[from][to]
+	return found
+}
+
+// StatusTransitionHook is called with the hash of the transaction whose status changed.
+type StatusTransitionHook func(hash chainhash.Hash, from, to metamorph_api.Status)
+
+type statusTransitionKey struct {
+	from metamorph_api.Status
+	to   metamorph_api.Status
+}
+
+// StatusMachine validates the changes of the statuses of the transactions against the declared status flow and calls
+// the hooks registered for the transitions.
+type StatusMachine struct {
+	mu    sync.RWMutex
+	hooks map[statusTransitionKey][]StatusTransitionHook
+}
+
+func NewStatusMachine() *StatusMachine {
+	return &StatusMachine{
+		hooks: make(map[statusTransitionKey][]StatusTransitionHook),
+	}
+}
+
+// OnTransition registers the hook for the transition, AnyStatus as from or to registers it for all transitions from
+// or to a status. The hooks are called synchronously with the status updates and must not block.
+func (m *StatusMachine) OnTransition(from, to metamorph_api.Status, hook StatusTransitionHook) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := statusTransitionKey{from: from, to: to}
+	m.hooks[key] = append(m.hooks[key], hook)
+}
+
+// Transition validates the transition and calls the hooks registered for it.
+func (m *StatusMachine) Transition(hash chainhash.Hash, from, to metamorph_api.Status) error {
+	if !canTransition(from, to) {
+		return errors.Join(ErrInvalidStatusTransition, fmt.Errorf("from %s to %s", from.String(), to.String()))
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, key := range []statusTransitionKey{{from, to}, {from, AnyStatus}, {AnyStatus, to}, {AnyStatus, AnyStatus}} {
+		for _, hook := range m.hooks[key] {
+			hook(hash, from, to)
+		}
+	}
+
+	return nil
+}
+
+// StatusFlowDiagram returns the mermaid state diagram of the declared status flow.
+func StatusFlowDiagram() string {
+	var b strings.Builder
+
+	b.WriteString("stateDiagram-v2\n")
+	b.WriteString("    [*] --> UNKNOWN\n")
+	for _, step := range statusSteps {
+		fmt.Fprintf(&b, "    %s --> %s: %s\n", step.From.String(), step.To.String(), step.Description)
+	}
+	b.WriteString("    MINED --> [*]\n")
+
+	return b.String()
+}
@@ -0,0 +1,105 @@
+package metamorph
+
+import (
+	"os"
+	"testing"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+func TestCanTransition(t *testing.T) {
+	for fromValue := range metamorph_api.Status_name {
+		for toValue := range metamorph_api.Status_name {
+			from := metamorph_api.Status(fromValue)
+			to := metamorph_api.Status(toValue)
+
+			t.Run(from.String()+" to "+to.String(), func(t *testing.T) {
+				// statuses only move forward, except for transactions whose block became stale in a reorg
+				expected := to > from || (from == metamorph_api.Status_MINED && to == metamorph_api.Status_MINED_IN_STALE_BLOCK)
+
+				// when
+				actual := canTransition(from, to)
+
+				// then
+				assert.Equal(t, expected, actual)
+			})
+		}
+	}
+}
+
+func TestStatusMachine_Transition(t *testing.T) {
+	tt := []struct {
+		name string
+		from metamorph_api.Status
+		to   metamorph_api.Status
+
+		expectedHooks []string
+		expectedErr   error
+	}{
+		{
+			name: "declared step",
+			from: metamorph_api.Status_SEEN_ON_NETWORK,
+			to:   metamorph_api.Status_MINED,
+
+			expectedHooks: []string{"seen to mined", "to mined", "any"},
+		},
+		{
+			name: "skipped statuses",
+			from: metamorph_api.Status_STORED,
+			to:   metamorph_api.Status_SEEN_ON_NETWORK,
+
+			expectedHooks: []string{"any"},
+		},
+		{
+			name: "invalid transition",
+			from: metamorph_api.Status_MINED,
+			to:   metamorph_api.Status_SEEN_ON_NETWORK,
+
+			expectedErr: ErrInvalidStatusTransition,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var calledHooks []string
+			hook := func(name string) StatusTransitionHook {
+				return func(_ chainhash.Hash, _, _ metamorph_api.Status) {
+					calledHooks = append(calledHooks, name)
+				}
+			}
+
+			sut := NewStatusMachine()
+			sut.OnTransition(metamorph_api.Status_SEEN_ON_NETWORK, metamorph_api.Status_MINED, hook("seen to mined"))
+			sut.OnTransition(AnyStatus, metamorph_api.Status_MINED, hook("to mined"))
+			sut.OnTransition(AnyStatus, AnyStatus, hook("any"))
+			sut.OnTransition(metamorph_api.Status_REJECTED, AnyStatus, hook("from rejected"))
+
+			// when
+			err := sut.Transition(chainhash.Hash{1}, tc.from, tc.to)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				assert.Empty(t, calledHooks)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedHooks, calledHooks)
+		})
+	}
+}
+
+func TestStatusFlowDiagram(t *testing.T) {
+	// when
+	actual := StatusFlowDiagram()
+
+	// then
+	readme, err := os.ReadFile("../../doc/README.md")
+	require.NoError(t, err)
+	assert.Contains(t, string(readme), "```mermaid\n"+actual+"```\n", "the status flow diagram in doc/README.md has to match StatusFlowDiagram")
+}
@@ -0,0 +1,115 @@
+package metamorph
+
+import (
+	"log/slog"
+	"slices"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const (
+	// LateStatusRecorded and LateStatusDropped are the handlings of late lower-ranked statuses
+	LateStatusRecorded = "recorded"
+	LateStatusDropped  = "dropped"
+)
+
+type sequenceResult int
+
+const (
+	sequenceUnchanged sequenceResult = iota
+	sequenceAdvanced
+	sequenceAdvancedCorrected
+	sequenceLateRecorded
+	sequenceLateDropped
+)
+
+// sequenceStatus merges the status update into the current status update of a transaction, so that the status of the
+// transaction and the timestamps of its status history only move forward.
+//
+// A status following the current status in the status flow whose timestamp lies before the timestamp of the current
+// status, e.g. due to clock skew between the sources of the updates, is moved to the timestamp of the current status.
+// An invalid transition, e.g. a late SEEN_ON_NETWORK arriving after MINED, is rejected and never regresses the status.
+// The late status is recorded in the status history only if recordLate is set, at the latest at the timestamp of the
+// current status, otherwise it is dropped.
+func sequenceStatus(current *store.UpdateStatus, update store.UpdateStatus, recordLate bool) sequenceResult {
+	if shouldUpdateStatus(update, *current) {
+		result := sequenceAdvanced
+		if update.Timestamp.Before(current.Timestamp) {
+			update.Timestamp = current.Timestamp
+			result = sequenceAdvancedCorrected
+		}
+
+		current.StatusHistory = append(current.StatusHistory, store.StatusWithTimestamp{
+			Status:    current.Status,
+			Timestamp: current.Timestamp,
+		})
+		current.Status = update.Status
+		current.Timestamp = update.Timestamp
+
+		return result
+	}
+
+	if update.Status == current.Status {
+		return sequenceUnchanged
+	}
+
+	if !recordLate {
+		return sequenceLateDropped
+	}
+
+	found := slices.ContainsFunc(current.StatusHistory, func(s store.StatusWithTimestamp) bool {
+		return s.Status == update.Status
+	})
+	if found {
+		return sequenceUnchanged
+	}
+
+	timestamp := update.Timestamp
+	if timestamp.After(current.Timestamp) {
+		timestamp = current.Timestamp
+	}
+
+	// keep the status history ordered by the rank of the statuses
+	i := slices.IndexFunc(current.StatusHistory, func(s store.StatusWithTimestamp) bool {
+		return s.Status > update.Status
+	})
+	if i < 0 {
+		i = len(current.StatusHistory)
+	}
+	current.StatusHistory = slices.Insert(current.StatusHistory, i, store.StatusWithTimestamp{
+		Status:    update.Status,
+		Timestamp: timestamp,
+	})
+
+	return sequenceLateRecorded
+}
+
+// observeSequenceResult logs and counts the corrected timestamps, the rejected transitions and the late lower-ranked
+// statuses.
+func (p *Processor) observeSequenceResult(result sequenceResult, current, update store.UpdateStatus) {
+	switch result {
+	case sequenceAdvancedCorrected:
+		p.stats.statusTimestampCorrections.Inc()
+		p.logger.Debug("Corrected timestamp of status preceding the current status",
+			slog.String("hash", update.Hash.String()),
+			slog.String("status", update.Status.String()),
+			slog.Time("timestamp", update.Timestamp),
+			slog.Time("corrected", current.Timestamp),
+		)
+	case sequenceLateRecorded, sequenceLateDropped:
+		p.stats.statusTransitionsRejected.WithLabelValues(current.Status.String(), update.Status.String()).Inc()
+
+		handling := LateStatusRecorded
+		if result == sequenceLateDropped {
+			handling = LateStatusDropped
+		}
+
+		p.stats.statusRegressions.WithLabelValues(handling).Inc()
+		p.logger.Debug("Late lower-ranked status",
+			slog.String("hash", update.Hash.String()),
+			slog.String("status", update.Status.String()),
+			slog.String("current", current.Status.String()),
+			slog.String("handling", handling),
+		)
+	}
+}
@@ -0,0 +1,118 @@
+package metamorph
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+func TestSequenceStatus(t *testing.T) {
+	seenAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	minedAt := seenAt.Add(10 * time.Minute)
+
+	tt := []struct {
+		name       string
+		current    store.UpdateStatus
+		update     store.UpdateStatus
+		recordLate bool
+
+		expectedResult  sequenceResult
+		expectedCurrent store.UpdateStatus
+	}{
+		{
+			name:    "higher-ranked status",
+			current: store.UpdateStatus{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenAt},
+			update:  store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: minedAt},
+
+			expectedResult: sequenceAdvanced,
+			expectedCurrent: store.UpdateStatus{
+				Status:        metamorph_api.Status_MINED,
+				Timestamp:     minedAt,
+				StatusHistory: []store.StatusWithTimestamp{{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenAt}},
+			},
+		},
+		{
+			name:    "higher-ranked status with skewed timestamp",
+			current: store.UpdateStatus{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: minedAt},
+			update:  store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: seenAt},
+
+			expectedResult: sequenceAdvancedCorrected,
+			expectedCurrent: store.UpdateStatus{
+				Status:        metamorph_api.Status_MINED,
+				Timestamp:     minedAt,
+				StatusHistory: []store.StatusWithTimestamp{{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: minedAt}},
+			},
+		},
+		{
+			name:    "same status",
+			current: store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: minedAt},
+			update:  store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: seenAt},
+
+			expectedResult:  sequenceUnchanged,
+			expectedCurrent: store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: minedAt},
+		},
+		{
+			name: "late lower-ranked status - recorded",
+			current: store.UpdateStatus{
+				Status:        metamorph_api.Status_MINED,
+				Timestamp:     minedAt,
+				StatusHistory: []store.StatusWithTimestamp{{Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, Timestamp: seenAt}},
+			},
+			update:     store.UpdateStatus{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: minedAt.Add(time.Minute)},
+			recordLate: true,
+
+			expectedResult: sequenceLateRecorded,
+			expectedCurrent: store.UpdateStatus{
+				Status:    metamorph_api.Status_MINED,
+				Timestamp: minedAt,
+				StatusHistory: []store.StatusWithTimestamp{
+					{Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, Timestamp: seenAt},
+					{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: minedAt},
+				},
+			},
+		},
+		{
+			name: "late lower-ranked status - already in history",
+			current: store.UpdateStatus{
+				Status:        metamorph_api.Status_MINED,
+				Timestamp:     minedAt,
+				StatusHistory: []store.StatusWithTimestamp{{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenAt}},
+			},
+			update:     store.UpdateStatus{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenAt},
+			recordLate: true,
+
+			expectedResult: sequenceUnchanged,
+			expectedCurrent: store.UpdateStatus{
+				Status:        metamorph_api.Status_MINED,
+				Timestamp:     minedAt,
+				StatusHistory: []store.StatusWithTimestamp{{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenAt}},
+			},
+		},
+		{
+			name:    "late lower-ranked status - dropped",
+			current: store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: minedAt},
+			update:  store.UpdateStatus{Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenAt},
+
+			expectedResult:  sequenceLateDropped,
+			expectedCurrent: store.UpdateStatus{Status: metamorph_api.Status_MINED, Timestamp: minedAt},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			current := tc.current
+
+			// when
+			actual := sequenceStatus(&current, tc.update, tc.recordLate)
+
+			// then
+			assert.Equal(t, tc.expectedResult, actual)
+			assert.Equal(t, tc.expectedCurrent, current)
+		})
+	}
+}
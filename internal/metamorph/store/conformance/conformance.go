@@ -0,0 +1,244 @@
+// Package conformance contains the tests which every implementation of store.MetamorphStore has to pass. The tests
+// only use the methods of the interface, so they can be run against any store, e.g. against a postgres database of a
+// managed postgres flavor before deploying ARC with it.
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/testdata"
+)
+
+// Run runs the conformance tests. newStore is called by every test and has to return a store without transactions, it
+// is responsible for closing the store and deleting its data when the test has finished, e.g. with t.Cleanup.
+func Run(t *testing.T, newStore func(t *testing.T) store.MetamorphStore) {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	newData := func(hash *chainhash.Hash, status metamorph_api.Status) *store.Data {
+		return &store.Data{
+			RawTx:           testdata.TX1Raw.Bytes(),
+			StoredAt:        now,
+			Hash:            hash,
+			Status:          status,
+			LastSubmittedAt: now,
+			LockedBy:        "NONE",
+			Callbacks:       []store.Callback{{CallbackURL: "https://callback.example.com", CallbackToken: "token"}},
+		}
+	}
+
+	t.Run("set, get and delete", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		data := newData(testdata.TX1Hash, metamorph_api.Status_STORED)
+
+		// when
+		err := sut.Set(ctx, data)
+		require.NoError(t, err)
+
+		// then
+		actual, err := sut.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		assert.Equal(t, testdata.TX1Hash, actual.Hash)
+		assert.Equal(t, metamorph_api.Status_STORED, actual.Status)
+		assert.Equal(t, data.RawTx, actual.RawTx)
+		assert.Equal(t, data.Callbacks, actual.Callbacks)
+		assert.True(t, now.Equal(actual.StoredAt))
+
+		// when
+		err = sut.Del(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+
+		// then
+		_, err = sut.Get(ctx, testdata.TX1Hash[:])
+		require.ErrorIs(t, err, store.ErrNotFound)
+	})
+
+	t.Run("get unknown transaction", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		_, err := sut.Get(ctx, testdata.TX2Hash[:])
+
+		// then
+		require.True(t, errors.Is(err, store.ErrNotFound))
+	})
+
+	t.Run("get many", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_STORED)))
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX2Hash, metamorph_api.Status_STORED)))
+
+		// when
+		actual, err := sut.GetMany(ctx, [][]byte{testdata.TX1Hash[:], testdata.TX2Hash[:], testdata.TX3Hash[:]})
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, actual, 2)
+	})
+
+	t.Run("set bulk keeps stored transactions", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_SENT_TO_NETWORK)))
+
+		// when
+		err := sut.SetBulk(ctx, []*store.Data{
+			newData(testdata.TX1Hash, metamorph_api.Status_STORED),
+			newData(testdata.TX2Hash, metamorph_api.Status_STORED),
+		})
+
+		// then
+		require.NoError(t, err)
+
+		stored, err := sut.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		assert.Equal(t, metamorph_api.Status_SENT_TO_NETWORK, stored.Status)
+
+		inserted, err := sut.Get(ctx, testdata.TX2Hash[:])
+		require.NoError(t, err)
+		assert.Equal(t, metamorph_api.Status_STORED, inserted.Status)
+	})
+
+	t.Run("update status only advances the status", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_STORED)))
+
+		// when
+		updated, err := sut.UpdateStatus(ctx, []store.UpdateStatus{
+			{Hash: *testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: now},
+			{Hash: *testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: now},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+		assert.Equal(t, testdata.TX1Hash, updated[0].Hash)
+		assert.Equal(t, metamorph_api.Status_SEEN_ON_NETWORK, updated[0].Status)
+
+		// when
+		updated, err = sut.UpdateStatus(ctx, []store.UpdateStatus{
+			{Hash: *testdata.TX1Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, Timestamp: now},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, updated)
+
+		actual, err := sut.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		assert.Equal(t, metamorph_api.Status_SEEN_ON_NETWORK, actual.Status)
+	})
+
+	t.Run("update mined", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_SEEN_ON_NETWORK)))
+
+		// when
+		updated, err := sut.UpdateMined(ctx, []*blocktx_api.TransactionBlock{
+			{
+				BlockHash:       testdata.Block1Hash[:],
+				BlockHeight:     100,
+				TransactionHash: testdata.TX1Hash[:],
+				MerklePath:      "merkle-path",
+				BlockStatus:     blocktx_api.Status_LONGEST,
+			},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+		assert.Equal(t, metamorph_api.Status_MINED, updated[0].Status)
+
+		actual, err := sut.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		assert.Equal(t, metamorph_api.Status_MINED, actual.Status)
+		assert.Equal(t, testdata.Block1Hash, actual.BlockHash)
+		assert.Equal(t, uint64(100), actual.BlockHeight)
+		assert.Equal(t, "merkle-path", actual.MerklePath)
+	})
+
+	t.Run("lock and get unseen", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_ANNOUNCED_TO_NETWORK)))
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX2Hash, metamorph_api.Status_SEEN_ON_NETWORK)))
+
+		// when
+		err := sut.SetLocked(ctx, now.Add(-time.Hour), 10)
+		require.NoError(t, err)
+		unseen, err := sut.GetUnseen(ctx, now.Add(-time.Hour), 10, 0)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, unseen, 1)
+		assert.Equal(t, testdata.TX1Hash, unseen[0].Hash)
+
+		// when
+		err = sut.IncrementRetries(ctx, testdata.TX1Hash)
+		require.NoError(t, err)
+
+		// then
+		actual, err := sut.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		assert.Equal(t, 1, actual.Retries)
+	})
+
+	t.Run("get raw txs", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_STORED)))
+
+		// when
+		rawTxs, err := sut.GetRawTxs(ctx, [][]byte{testdata.TX1Hash[:], testdata.TX2Hash[:]})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{testdata.TX1Raw.Bytes()}, rawTxs)
+	})
+
+	t.Run("peer acks", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+		require.NoError(t, sut.Set(ctx, newData(testdata.TX1Hash, metamorph_api.Status_ANNOUNCED_TO_NETWORK)))
+		ack := store.PeerAck{Hash: *testdata.TX1Hash, Peer: "localhost:18333", RequestedAt: now, SentAt: now.Add(time.Second)}
+
+		// when
+		err := sut.SetPeerAcks(ctx, []store.PeerAck{ack})
+		require.NoError(t, err)
+		acks, err := sut.GetPeerAcks(ctx, [][]byte{testdata.TX1Hash[:]})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, acks, 1)
+		assert.Equal(t, ack.Hash, acks[0].Hash)
+		assert.Equal(t, ack.Peer, acks[0].Peer)
+		assert.True(t, ack.RequestedAt.Equal(acks[0].RequestedAt))
+		assert.True(t, ack.SentAt.Equal(acks[0].SentAt))
+	})
+
+	t.Run("ping", func(t *testing.T) {
+		// given
+		sut := newStore(t)
+
+		// when
+		err := sut.Ping(ctx)
+
+		// then
+		require.NoError(t, err)
+	})
+}
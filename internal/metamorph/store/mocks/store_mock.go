@@ -22,27 +22,84 @@ var _ store.MetamorphStore = &MetamorphStoreMock{}
 //
 //		// make and configure a mocked store.MetamorphStore
 //		mockedMetamorphStore := &MetamorphStoreMock{
+//			AddAnnotationFunc: func(ctx context.Context, annotation store.Annotation) (store.Annotation, error) {
+//				panic("mock out the AddAnnotation method")
+//			},
+//			AddReverificationFunc: func(ctx context.Context, reverification store.Reverification, maxTransactions int64) (store.Reverification, error) {
+//				panic("mock out the AddReverification method")
+//			},
+//			CancelScheduledFunc: func(ctx context.Context, hash *chainhash.Hash, now time.Time) error {
+//				panic("mock out the CancelScheduled method")
+//			},
 //			ClearDataFunc: func(ctx context.Context, retentionDays int32) (int64, error) {
 //				panic("mock out the ClearData method")
 //			},
 //			CloseFunc: func(ctx context.Context) error {
 //				panic("mock out the Close method")
 //			},
+//			ComputeSLAReportsFunc: func(ctx context.Context, period string, from time.Time, to time.Time) (int64, error) {
+//				panic("mock out the ComputeSLAReports method")
+//			},
+//			CountUnverifiedFunc: func(ctx context.Context) (int64, error) {
+//				panic("mock out the CountUnverified method")
+//			},
 //			DelFunc: func(ctx context.Context, key []byte) error {
 //				panic("mock out the Del method")
 //			},
+//			DelReverificationFunc: func(ctx context.Context, id int64) error {
+//				panic("mock out the DelReverification method")
+//			},
+//			EraseClientDataFunc: func(ctx context.Context, erasure store.Erasure) (store.Erasure, error) {
+//				panic("mock out the EraseClientData method")
+//			},
 //			GetFunc: func(ctx context.Context, key []byte) (*store.Data, error) {
 //				panic("mock out the Get method")
 //			},
+//			GetAnnotationsFunc: func(ctx context.Context, hashes [][]byte) ([]store.Annotation, error) {
+//				panic("mock out the GetAnnotations method")
+//			},
+//			GetChildHashesFunc: func(ctx context.Context, hashes [][]byte) ([][]byte, error) {
+//				panic("mock out the GetChildHashes method")
+//			},
 //			GetDoubleSpendTxsFunc: func(ctx context.Context, older time.Time) ([]*store.Data, error) {
 //				panic("mock out the GetDoubleSpendTxs method")
 //			},
+//			GetDueScheduledFunc: func(ctx context.Context, now time.Time, limit int64) ([]*store.Data, error) {
+//				panic("mock out the GetDueScheduled method")
+//			},
+//			GetErasuresFunc: func(ctx context.Context, limit int64) ([]store.Erasure, error) {
+//				panic("mock out the GetErasures method")
+//			},
+//			GetExpiredFunc: func(ctx context.Context, now time.Time, limit int64) ([]*chainhash.Hash, error) {
+//				panic("mock out the GetExpired method")
+//			},
+//			GetExportFunc: func(ctx context.Context, filter store.ExportFilter, limit int64) ([]*store.Data, error) {
+//				panic("mock out the GetExport method")
+//			},
+//			GetFinalFunc: func(ctx context.Context, nextHeight uint64, medianTimePast time.Time, now time.Time, limit int64) ([]*store.Data, error) {
+//				panic("mock out the GetFinal method")
+//			},
+//			GetHashesFunc: func(ctx context.Context, after []byte, limit int64) ([][]byte, error) {
+//				panic("mock out the GetHashes method")
+//			},
 //			GetManyFunc: func(ctx context.Context, keys [][]byte) ([]*store.Data, error) {
 //				panic("mock out the GetMany method")
 //			},
+//			GetMinedInBlocksFunc: func(ctx context.Context, blockHashes [][]byte) ([]*chainhash.Hash, error) {
+//				panic("mock out the GetMinedInBlocks method")
+//			},
+//			GetPeerAcksFunc: func(ctx context.Context, hashes [][]byte) ([]store.PeerAck, error) {
+//				panic("mock out the GetPeerAcks method")
+//			},
 //			GetRawTxsFunc: func(ctx context.Context, hashes [][]byte) ([][]byte, error) {
 //				panic("mock out the GetRawTxs method")
 //			},
+//			GetReverificationsFunc: func(ctx context.Context, now time.Time, lockedUntil time.Time, limit int64) ([]store.Reverification, error) {
+//				panic("mock out the GetReverifications method")
+//			},
+//			GetSLAReportsFunc: func(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error) {
+//				panic("mock out the GetSLAReports method")
+//			},
 //			GetSeenFunc: func(ctx context.Context, fromDuration time.Duration, toDuration time.Duration, limit int64, offset int64) ([]*store.Data, error) {
 //				panic("mock out the GetSeen method")
 //			},
@@ -52,12 +109,21 @@ var _ store.MetamorphStore = &MetamorphStoreMock{}
 //			GetStatsFunc: func(ctx context.Context, since time.Time, notSeenLimit time.Duration, notMinedLimit time.Duration) (*store.Stats, error) {
 //				panic("mock out the GetStats method")
 //			},
+//			GetStoredBetweenFunc: func(ctx context.Context, from time.Time, to time.Time, limit int64, offset int64) ([]*store.Data, error) {
+//				panic("mock out the GetStoredBetween method")
+//			},
 //			GetUnconfirmedRequestedFunc: func(ctx context.Context, requestedAgo time.Duration, limit int64, offset int64) ([]*chainhash.Hash, error) {
 //				panic("mock out the GetUnconfirmedRequested method")
 //			},
+//			GetUnrequestedFunc: func(ctx context.Context, since time.Time, announcedBefore time.Time, limit int64) ([]*store.Data, error) {
+//				panic("mock out the GetUnrequested method")
+//			},
 //			GetUnseenFunc: func(ctx context.Context, since time.Time, limit int64, offset int64) ([]*store.Data, error) {
 //				panic("mock out the GetUnseen method")
 //			},
+//			GetUnverifiedFunc: func(ctx context.Context, hashes [][]byte) ([][]byte, error) {
+//				panic("mock out the GetUnverified method")
+//			},
 //			IncrementRetriesFunc: func(ctx context.Context, hash *chainhash.Hash) error {
 //				panic("mock out the IncrementRetries method")
 //			},
@@ -67,6 +133,9 @@ var _ store.MetamorphStore = &MetamorphStoreMock{}
 //			PingFunc: func(ctx context.Context) error {
 //				panic("mock out the Ping method")
 //			},
+//			ResubmitRejectedFunc: func(ctx context.Context, hash *chainhash.Hash) error {
+//				panic("mock out the ResubmitRejected method")
+//			},
 //			SetFunc: func(ctx context.Context, value *store.Data) error {
 //				panic("mock out the Set method")
 //			},
@@ -76,6 +145,12 @@ var _ store.MetamorphStore = &MetamorphStoreMock{}
 //			SetLockedFunc: func(ctx context.Context, since time.Time, limit int64) error {
 //				panic("mock out the SetLocked method")
 //			},
+//			SetNodeSubmissionFunc: func(ctx context.Context, hash *chainhash.Hash, submission store.NodeSubmission) error {
+//				panic("mock out the SetNodeSubmission method")
+//			},
+//			SetPeerAcksFunc: func(ctx context.Context, acks []store.PeerAck) error {
+//				panic("mock out the SetPeerAcks method")
+//			},
 //			SetRequestedFunc: func(ctx context.Context, hashes []*chainhash.Hash) error {
 //				panic("mock out the SetRequested method")
 //			},
@@ -104,27 +179,84 @@ var _ store.MetamorphStore = &MetamorphStoreMock{}
 //
 //	}
 type MetamorphStoreMock struct {
+	// AddAnnotationFunc mocks the AddAnnotation method.
+	AddAnnotationFunc func(ctx context.Context, annotation store.Annotation) (store.Annotation, error)
+
+	// AddReverificationFunc mocks the AddReverification method.
+	AddReverificationFunc func(ctx context.Context, reverification store.Reverification, maxTransactions int64) (store.Reverification, error)
+
+	// CancelScheduledFunc mocks the CancelScheduled method.
+	CancelScheduledFunc func(ctx context.Context, hash *chainhash.Hash, now time.Time) error
+
 	// ClearDataFunc mocks the ClearData method.
 	ClearDataFunc func(ctx context.Context, retentionDays int32) (int64, error)
 
 	// CloseFunc mocks the Close method.
 	CloseFunc func(ctx context.Context) error
 
+	// ComputeSLAReportsFunc mocks the ComputeSLAReports method.
+	ComputeSLAReportsFunc func(ctx context.Context, period string, from time.Time, to time.Time) (int64, error)
+
+	// CountUnverifiedFunc mocks the CountUnverified method.
+	CountUnverifiedFunc func(ctx context.Context) (int64, error)
+
 	// DelFunc mocks the Del method.
 	DelFunc func(ctx context.Context, key []byte) error
 
+	// DelReverificationFunc mocks the DelReverification method.
+	DelReverificationFunc func(ctx context.Context, id int64) error
+
+	// EraseClientDataFunc mocks the EraseClientData method.
+	EraseClientDataFunc func(ctx context.Context, erasure store.Erasure) (store.Erasure, error)
+
 	// GetFunc mocks the Get method.
 	GetFunc func(ctx context.Context, key []byte) (*store.Data, error)
 
+	// GetAnnotationsFunc mocks the GetAnnotations method.
+	GetAnnotationsFunc func(ctx context.Context, hashes [][]byte) ([]store.Annotation, error)
+
+	// GetChildHashesFunc mocks the GetChildHashes method.
+	GetChildHashesFunc func(ctx context.Context, hashes [][]byte) ([][]byte, error)
+
 	// GetDoubleSpendTxsFunc mocks the GetDoubleSpendTxs method.
 	GetDoubleSpendTxsFunc func(ctx context.Context, older time.Time) ([]*store.Data, error)
 
+	// GetDueScheduledFunc mocks the GetDueScheduled method.
+	GetDueScheduledFunc func(ctx context.Context, now time.Time, limit int64) ([]*store.Data, error)
+
+	// GetErasuresFunc mocks the GetErasures method.
+	GetErasuresFunc func(ctx context.Context, limit int64) ([]store.Erasure, error)
+
+	// GetExpiredFunc mocks the GetExpired method.
+	GetExpiredFunc func(ctx context.Context, now time.Time, limit int64) ([]*chainhash.Hash, error)
+
+	// GetExportFunc mocks the GetExport method.
+	GetExportFunc func(ctx context.Context, filter store.ExportFilter, limit int64) ([]*store.Data, error)
+
+	// GetFinalFunc mocks the GetFinal method.
+	GetFinalFunc func(ctx context.Context, nextHeight uint64, medianTimePast time.Time, now time.Time, limit int64) ([]*store.Data, error)
+
+	// GetHashesFunc mocks the GetHashes method.
+	GetHashesFunc func(ctx context.Context, after []byte, limit int64) ([][]byte, error)
+
 	// GetManyFunc mocks the GetMany method.
 	GetManyFunc func(ctx context.Context, keys [][]byte) ([]*store.Data, error)
 
+	// GetMinedInBlocksFunc mocks the GetMinedInBlocks method.
+	GetMinedInBlocksFunc func(ctx context.Context, blockHashes [][]byte) ([]*chainhash.Hash, error)
+
+	// GetPeerAcksFunc mocks the GetPeerAcks method.
+	GetPeerAcksFunc func(ctx context.Context, hashes [][]byte) ([]store.PeerAck, error)
+
 	// GetRawTxsFunc mocks the GetRawTxs method.
 	GetRawTxsFunc func(ctx context.Context, hashes [][]byte) ([][]byte, error)
 
+	// GetReverificationsFunc mocks the GetReverifications method.
+	GetReverificationsFunc func(ctx context.Context, now time.Time, lockedUntil time.Time, limit int64) ([]store.Reverification, error)
+
+	// GetSLAReportsFunc mocks the GetSLAReports method.
+	GetSLAReportsFunc func(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error)
+
 	// GetSeenFunc mocks the GetSeen method.
 	GetSeenFunc func(ctx context.Context, fromDuration time.Duration, toDuration time.Duration, limit int64, offset int64) ([]*store.Data, error)
 
@@ -134,12 +266,21 @@ type MetamorphStoreMock struct {
 	// GetStatsFunc mocks the GetStats method.
 	GetStatsFunc func(ctx context.Context, since time.Time, notSeenLimit time.Duration, notMinedLimit time.Duration) (*store.Stats, error)
 
+	// GetStoredBetweenFunc mocks the GetStoredBetween method.
+	GetStoredBetweenFunc func(ctx context.Context, from time.Time, to time.Time, limit int64, offset int64) ([]*store.Data, error)
+
 	// GetUnconfirmedRequestedFunc mocks the GetUnconfirmedRequested method.
 	GetUnconfirmedRequestedFunc func(ctx context.Context, requestedAgo time.Duration, limit int64, offset int64) ([]*chainhash.Hash, error)
 
+	// GetUnrequestedFunc mocks the GetUnrequested method.
+	GetUnrequestedFunc func(ctx context.Context, since time.Time, announcedBefore time.Time, limit int64) ([]*store.Data, error)
+
 	// GetUnseenFunc mocks the GetUnseen method.
 	GetUnseenFunc func(ctx context.Context, since time.Time, limit int64, offset int64) ([]*store.Data, error)
 
+	// GetUnverifiedFunc mocks the GetUnverified method.
+	GetUnverifiedFunc func(ctx context.Context, hashes [][]byte) ([][]byte, error)
+
 	// IncrementRetriesFunc mocks the IncrementRetries method.
 	IncrementRetriesFunc func(ctx context.Context, hash *chainhash.Hash) error
 
@@ -149,6 +290,9 @@ type MetamorphStoreMock struct {
 	// PingFunc mocks the Ping method.
 	PingFunc func(ctx context.Context) error
 
+	// ResubmitRejectedFunc mocks the ResubmitRejected method.
+	ResubmitRejectedFunc func(ctx context.Context, hash *chainhash.Hash) error
+
 	// SetFunc mocks the Set method.
 	SetFunc func(ctx context.Context, value *store.Data) error
 
@@ -158,6 +302,12 @@ type MetamorphStoreMock struct {
 	// SetLockedFunc mocks the SetLocked method.
 	SetLockedFunc func(ctx context.Context, since time.Time, limit int64) error
 
+	// SetNodeSubmissionFunc mocks the SetNodeSubmission method.
+	SetNodeSubmissionFunc func(ctx context.Context, hash *chainhash.Hash, submission store.NodeSubmission) error
+
+	// SetPeerAcksFunc mocks the SetPeerAcks method.
+	SetPeerAcksFunc func(ctx context.Context, acks []store.PeerAck) error
+
 	// SetRequestedFunc mocks the SetRequested method.
 	SetRequestedFunc func(ctx context.Context, hashes []*chainhash.Hash) error
 
@@ -181,6 +331,31 @@ type MetamorphStoreMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// AddAnnotation holds details about calls to the AddAnnotation method.
+		AddAnnotation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Annotation is the annotation argument value.
+			Annotation store.Annotation
+		}
+		// AddReverification holds details about calls to the AddReverification method.
+		AddReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Reverification is the reverification argument value.
+			Reverification store.Reverification
+			// MaxTransactions is the maxTransactions argument value.
+			MaxTransactions int64
+		}
+		// CancelScheduled holds details about calls to the CancelScheduled method.
+		CancelScheduled []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hash is the hash argument value.
+			Hash *chainhash.Hash
+			// Now is the now argument value.
+			Now time.Time
+		}
 		// ClearData holds details about calls to the ClearData method.
 		ClearData []struct {
 			// Ctx is the ctx argument value.
@@ -193,6 +368,22 @@ type MetamorphStoreMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// ComputeSLAReports holds details about calls to the ComputeSLAReports method.
+		ComputeSLAReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Period is the period argument value.
+			Period string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+		}
+		// CountUnverified holds details about calls to the CountUnverified method.
+		CountUnverified []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
 		// Del holds details about calls to the Del method.
 		Del []struct {
 			// Ctx is the ctx argument value.
@@ -200,6 +391,20 @@ type MetamorphStoreMock struct {
 			// Key is the key argument value.
 			Key []byte
 		}
+		// DelReverification holds details about calls to the DelReverification method.
+		DelReverification []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ID is the id argument value.
+			ID int64
+		}
+		// EraseClientData holds details about calls to the EraseClientData method.
+		EraseClientData []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Erasure is the erasure argument value.
+			Erasure store.Erasure
+		}
 		// Get holds details about calls to the Get method.
 		Get []struct {
 			// Ctx is the ctx argument value.
@@ -207,6 +412,20 @@ type MetamorphStoreMock struct {
 			// Key is the key argument value.
 			Key []byte
 		}
+		// GetAnnotations holds details about calls to the GetAnnotations method.
+		GetAnnotations []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hashes is the hashes argument value.
+			Hashes [][]byte
+		}
+		// GetChildHashes holds details about calls to the GetChildHashes method.
+		GetChildHashes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hashes is the hashes argument value.
+			Hashes [][]byte
+		}
 		// GetDoubleSpendTxs holds details about calls to the GetDoubleSpendTxs method.
 		GetDoubleSpendTxs []struct {
 			// Ctx is the ctx argument value.
@@ -214,6 +433,62 @@ type MetamorphStoreMock struct {
 			// Older is the older argument value.
 			Older time.Time
 		}
+		// GetDueScheduled holds details about calls to the GetDueScheduled method.
+		GetDueScheduled []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int64
+		}
+		// GetErasures holds details about calls to the GetErasures method.
+		GetErasures []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Limit is the limit argument value.
+			Limit int64
+		}
+		// GetExpired holds details about calls to the GetExpired method.
+		GetExpired []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int64
+		}
+		// GetExport holds details about calls to the GetExport method.
+		GetExport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Filter is the filter argument value.
+			Filter store.ExportFilter
+			// Limit is the limit argument value.
+			Limit int64
+		}
+		// GetFinal holds details about calls to the GetFinal method.
+		GetFinal []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// NextHeight is the nextHeight argument value.
+			NextHeight uint64
+			// MedianTimePast is the medianTimePast argument value.
+			MedianTimePast time.Time
+			// Now is the now argument value.
+			Now time.Time
+			// Limit is the limit argument value.
+			Limit int64
+		}
+		// GetHashes holds details about calls to the GetHashes method.
+		GetHashes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// After is the after argument value.
+			After []byte
+			// Limit is the limit argument value.
+			Limit int64
+		}
 		// GetMany holds details about calls to the GetMany method.
 		GetMany []struct {
 			// Ctx is the ctx argument value.
@@ -221,6 +496,20 @@ type MetamorphStoreMock struct {
 			// Keys is the keys argument value.
 			Keys [][]byte
 		}
+		// GetMinedInBlocks holds details about calls to the GetMinedInBlocks method.
+		GetMinedInBlocks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// BlockHashes is the blockHashes argument value.
+			BlockHashes [][]byte
+		}
+		// GetPeerAcks holds details about calls to the GetPeerAcks method.
+		GetPeerAcks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hashes is the hashes argument value.
+			Hashes [][]byte
+		}
 		// GetRawTxs holds details about calls to the GetRawTxs method.
 		GetRawTxs []struct {
 			// Ctx is the ctx argument value.
@@ -228,6 +517,30 @@ type MetamorphStoreMock struct {
 			// Hashes is the hashes argument value.
 			Hashes [][]byte
 		}
+		// GetReverifications holds details about calls to the GetReverifications method.
+		GetReverifications []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Now is the now argument value.
+			Now time.Time
+			// LockedUntil is the lockedUntil argument value.
+			LockedUntil time.Time
+			// Limit is the limit argument value.
+			Limit int64
+		}
+		// GetSLAReports holds details about calls to the GetSLAReports method.
+		GetSLAReports []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Period is the period argument value.
+			Period string
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+			// Tenant is the tenant argument value.
+			Tenant string
+		}
 		// GetSeen holds details about calls to the GetSeen method.
 		GetSeen []struct {
 			// Ctx is the ctx argument value.
@@ -267,6 +580,19 @@ type MetamorphStoreMock struct {
 			// NotMinedLimit is the notMinedLimit argument value.
 			NotMinedLimit time.Duration
 		}
+		// GetStoredBetween holds details about calls to the GetStoredBetween method.
+		GetStoredBetween []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// From is the from argument value.
+			From time.Time
+			// To is the to argument value.
+			To time.Time
+			// Limit is the limit argument value.
+			Limit int64
+			// Offset is the offset argument value.
+			Offset int64
+		}
 		// GetUnconfirmedRequested holds details about calls to the GetUnconfirmedRequested method.
 		GetUnconfirmedRequested []struct {
 			// Ctx is the ctx argument value.
@@ -278,6 +604,17 @@ type MetamorphStoreMock struct {
 			// Offset is the offset argument value.
 			Offset int64
 		}
+		// GetUnrequested holds details about calls to the GetUnrequested method.
+		GetUnrequested []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Since is the since argument value.
+			Since time.Time
+			// AnnouncedBefore is the announcedBefore argument value.
+			AnnouncedBefore time.Time
+			// Limit is the limit argument value.
+			Limit int64
+		}
 		// GetUnseen holds details about calls to the GetUnseen method.
 		GetUnseen []struct {
 			// Ctx is the ctx argument value.
@@ -289,6 +626,13 @@ type MetamorphStoreMock struct {
 			// Offset is the offset argument value.
 			Offset int64
 		}
+		// GetUnverified holds details about calls to the GetUnverified method.
+		GetUnverified []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hashes is the hashes argument value.
+			Hashes [][]byte
+		}
 		// IncrementRetries holds details about calls to the IncrementRetries method.
 		IncrementRetries []struct {
 			// Ctx is the ctx argument value.
@@ -308,6 +652,13 @@ type MetamorphStoreMock struct {
 			// Ctx is the ctx argument value.
 			Ctx context.Context
 		}
+		// ResubmitRejected holds details about calls to the ResubmitRejected method.
+		ResubmitRejected []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hash is the hash argument value.
+			Hash *chainhash.Hash
+		}
 		// Set holds details about calls to the Set method.
 		Set []struct {
 			// Ctx is the ctx argument value.
@@ -331,6 +682,22 @@ type MetamorphStoreMock struct {
 			// Limit is the limit argument value.
 			Limit int64
 		}
+		// SetNodeSubmission holds details about calls to the SetNodeSubmission method.
+		SetNodeSubmission []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Hash is the hash argument value.
+			Hash *chainhash.Hash
+			// Submission is the submission argument value.
+			Submission store.NodeSubmission
+		}
+		// SetPeerAcks holds details about calls to the SetPeerAcks method.
+		SetPeerAcks []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Acks is the acks argument value.
+			Acks []store.PeerAck
+		}
 		// SetRequested holds details about calls to the SetRequested method.
 		SetRequested []struct {
 			// Ctx is the ctx argument value.
@@ -383,24 +750,49 @@ type MetamorphStoreMock struct {
 			Updates []store.UpdateStatus
 		}
 	}
+	lockAddAnnotation           sync.RWMutex
+	lockAddReverification       sync.RWMutex
+	lockCancelScheduled         sync.RWMutex
 	lockClearData               sync.RWMutex
 	lockClose                   sync.RWMutex
+	lockComputeSLAReports       sync.RWMutex
+	lockCountUnverified         sync.RWMutex
 	lockDel                     sync.RWMutex
+	lockDelReverification       sync.RWMutex
+	lockEraseClientData         sync.RWMutex
 	lockGet                     sync.RWMutex
+	lockGetAnnotations          sync.RWMutex
+	lockGetChildHashes          sync.RWMutex
 	lockGetDoubleSpendTxs       sync.RWMutex
+	lockGetDueScheduled         sync.RWMutex
+	lockGetErasures             sync.RWMutex
+	lockGetExpired              sync.RWMutex
+	lockGetExport               sync.RWMutex
+	lockGetFinal                sync.RWMutex
+	lockGetHashes               sync.RWMutex
 	lockGetMany                 sync.RWMutex
+	lockGetMinedInBlocks        sync.RWMutex
+	lockGetPeerAcks             sync.RWMutex
 	lockGetRawTxs               sync.RWMutex
+	lockGetReverifications      sync.RWMutex
+	lockGetSLAReports           sync.RWMutex
 	lockGetSeen                 sync.RWMutex
 	lockGetSeenPending          sync.RWMutex
 	lockGetStats                sync.RWMutex
+	lockGetStoredBetween        sync.RWMutex
 	lockGetUnconfirmedRequested sync.RWMutex
+	lockGetUnrequested          sync.RWMutex
 	lockGetUnseen               sync.RWMutex
+	lockGetUnverified           sync.RWMutex
 	lockIncrementRetries        sync.RWMutex
 	lockMarkConfirmedRequested  sync.RWMutex
 	lockPing                    sync.RWMutex
+	lockResubmitRejected        sync.RWMutex
 	lockSet                     sync.RWMutex
 	lockSetBulk                 sync.RWMutex
 	lockSetLocked               sync.RWMutex
+	lockSetNodeSubmission       sync.RWMutex
+	lockSetPeerAcks             sync.RWMutex
 	lockSetRequested            sync.RWMutex
 	lockSetUnlockedByName       sync.RWMutex
 	lockSetUnlockedByNameExcept sync.RWMutex
@@ -410,6 +802,122 @@ type MetamorphStoreMock struct {
 	lockUpdateStatusHistory     sync.RWMutex
 }
 
+// AddAnnotation calls AddAnnotationFunc.
+func (mock *MetamorphStoreMock) AddAnnotation(ctx context.Context, annotation store.Annotation) (store.Annotation, error) {
+	if mock.AddAnnotationFunc == nil {
+		panic("MetamorphStoreMock.AddAnnotationFunc: method is nil but MetamorphStore.AddAnnotation was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Annotation store.Annotation
+	}{
+		Ctx:        ctx,
+		Annotation: annotation,
+	}
+	mock.lockAddAnnotation.Lock()
+	mock.calls.AddAnnotation = append(mock.calls.AddAnnotation, callInfo)
+	mock.lockAddAnnotation.Unlock()
+	return mock.AddAnnotationFunc(ctx, annotation)
+}
+
+// AddAnnotationCalls gets all the calls that were made to AddAnnotation.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.AddAnnotationCalls())
+func (mock *MetamorphStoreMock) AddAnnotationCalls() []struct {
+	Ctx        context.Context
+	Annotation store.Annotation
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Annotation store.Annotation
+	}
+	mock.lockAddAnnotation.RLock()
+	calls = mock.calls.AddAnnotation
+	mock.lockAddAnnotation.RUnlock()
+	return calls
+}
+
+// AddReverification calls AddReverificationFunc.
+func (mock *MetamorphStoreMock) AddReverification(ctx context.Context, reverification store.Reverification, maxTransactions int64) (store.Reverification, error) {
+	if mock.AddReverificationFunc == nil {
+		panic("MetamorphStoreMock.AddReverificationFunc: method is nil but MetamorphStore.AddReverification was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		Reverification  store.Reverification
+		MaxTransactions int64
+	}{
+		Ctx:             ctx,
+		Reverification:  reverification,
+		MaxTransactions: maxTransactions,
+	}
+	mock.lockAddReverification.Lock()
+	mock.calls.AddReverification = append(mock.calls.AddReverification, callInfo)
+	mock.lockAddReverification.Unlock()
+	return mock.AddReverificationFunc(ctx, reverification, maxTransactions)
+}
+
+// AddReverificationCalls gets all the calls that were made to AddReverification.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.AddReverificationCalls())
+func (mock *MetamorphStoreMock) AddReverificationCalls() []struct {
+	Ctx             context.Context
+	Reverification  store.Reverification
+	MaxTransactions int64
+} {
+	var calls []struct {
+		Ctx             context.Context
+		Reverification  store.Reverification
+		MaxTransactions int64
+	}
+	mock.lockAddReverification.RLock()
+	calls = mock.calls.AddReverification
+	mock.lockAddReverification.RUnlock()
+	return calls
+}
+
+// CancelScheduled calls CancelScheduledFunc.
+func (mock *MetamorphStoreMock) CancelScheduled(ctx context.Context, hash *chainhash.Hash, now time.Time) error {
+	if mock.CancelScheduledFunc == nil {
+		panic("MetamorphStoreMock.CancelScheduledFunc: method is nil but MetamorphStore.CancelScheduled was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash *chainhash.Hash
+		Now  time.Time
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+		Now:  now,
+	}
+	mock.lockCancelScheduled.Lock()
+	mock.calls.CancelScheduled = append(mock.calls.CancelScheduled, callInfo)
+	mock.lockCancelScheduled.Unlock()
+	return mock.CancelScheduledFunc(ctx, hash, now)
+}
+
+// CancelScheduledCalls gets all the calls that were made to CancelScheduled.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.CancelScheduledCalls())
+func (mock *MetamorphStoreMock) CancelScheduledCalls() []struct {
+	Ctx  context.Context
+	Hash *chainhash.Hash
+	Now  time.Time
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash *chainhash.Hash
+		Now  time.Time
+	}
+	mock.lockCancelScheduled.RLock()
+	calls = mock.calls.CancelScheduled
+	mock.lockCancelScheduled.RUnlock()
+	return calls
+}
+
 // ClearData calls ClearDataFunc.
 func (mock *MetamorphStoreMock) ClearData(ctx context.Context, retentionDays int32) (int64, error) {
 	if mock.ClearDataFunc == nil {
@@ -472,117 +980,581 @@ func (mock *MetamorphStoreMock) CloseCalls() []struct {
 	var calls []struct {
 		Ctx context.Context
 	}
-	mock.lockClose.RLock()
-	calls = mock.calls.Close
-	mock.lockClose.RUnlock()
+	mock.lockClose.RLock()
+	calls = mock.calls.Close
+	mock.lockClose.RUnlock()
+	return calls
+}
+
+// ComputeSLAReports calls ComputeSLAReportsFunc.
+func (mock *MetamorphStoreMock) ComputeSLAReports(ctx context.Context, period string, from time.Time, to time.Time) (int64, error) {
+	if mock.ComputeSLAReportsFunc == nil {
+		panic("MetamorphStoreMock.ComputeSLAReportsFunc: method is nil but MetamorphStore.ComputeSLAReports was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Period string
+		From   time.Time
+		To     time.Time
+	}{
+		Ctx:    ctx,
+		Period: period,
+		From:   from,
+		To:     to,
+	}
+	mock.lockComputeSLAReports.Lock()
+	mock.calls.ComputeSLAReports = append(mock.calls.ComputeSLAReports, callInfo)
+	mock.lockComputeSLAReports.Unlock()
+	return mock.ComputeSLAReportsFunc(ctx, period, from, to)
+}
+
+// ComputeSLAReportsCalls gets all the calls that were made to ComputeSLAReports.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.ComputeSLAReportsCalls())
+func (mock *MetamorphStoreMock) ComputeSLAReportsCalls() []struct {
+	Ctx    context.Context
+	Period string
+	From   time.Time
+	To     time.Time
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Period string
+		From   time.Time
+		To     time.Time
+	}
+	mock.lockComputeSLAReports.RLock()
+	calls = mock.calls.ComputeSLAReports
+	mock.lockComputeSLAReports.RUnlock()
+	return calls
+}
+
+// CountUnverified calls CountUnverifiedFunc.
+func (mock *MetamorphStoreMock) CountUnverified(ctx context.Context) (int64, error) {
+	if mock.CountUnverifiedFunc == nil {
+		panic("MetamorphStoreMock.CountUnverifiedFunc: method is nil but MetamorphStore.CountUnverified was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCountUnverified.Lock()
+	mock.calls.CountUnverified = append(mock.calls.CountUnverified, callInfo)
+	mock.lockCountUnverified.Unlock()
+	return mock.CountUnverifiedFunc(ctx)
+}
+
+// CountUnverifiedCalls gets all the calls that were made to CountUnverified.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.CountUnverifiedCalls())
+func (mock *MetamorphStoreMock) CountUnverifiedCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockCountUnverified.RLock()
+	calls = mock.calls.CountUnverified
+	mock.lockCountUnverified.RUnlock()
+	return calls
+}
+
+// Del calls DelFunc.
+func (mock *MetamorphStoreMock) Del(ctx context.Context, key []byte) error {
+	if mock.DelFunc == nil {
+		panic("MetamorphStoreMock.DelFunc: method is nil but MetamorphStore.Del was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key []byte
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockDel.Lock()
+	mock.calls.Del = append(mock.calls.Del, callInfo)
+	mock.lockDel.Unlock()
+	return mock.DelFunc(ctx, key)
+}
+
+// DelCalls gets all the calls that were made to Del.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.DelCalls())
+func (mock *MetamorphStoreMock) DelCalls() []struct {
+	Ctx context.Context
+	Key []byte
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key []byte
+	}
+	mock.lockDel.RLock()
+	calls = mock.calls.Del
+	mock.lockDel.RUnlock()
+	return calls
+}
+
+// DelReverification calls DelReverificationFunc.
+func (mock *MetamorphStoreMock) DelReverification(ctx context.Context, id int64) error {
+	if mock.DelReverificationFunc == nil {
+		panic("MetamorphStoreMock.DelReverificationFunc: method is nil but MetamorphStore.DelReverification was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		ID  int64
+	}{
+		Ctx: ctx,
+		ID:  id,
+	}
+	mock.lockDelReverification.Lock()
+	mock.calls.DelReverification = append(mock.calls.DelReverification, callInfo)
+	mock.lockDelReverification.Unlock()
+	return mock.DelReverificationFunc(ctx, id)
+}
+
+// DelReverificationCalls gets all the calls that were made to DelReverification.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.DelReverificationCalls())
+func (mock *MetamorphStoreMock) DelReverificationCalls() []struct {
+	Ctx context.Context
+	ID  int64
+} {
+	var calls []struct {
+		Ctx context.Context
+		ID  int64
+	}
+	mock.lockDelReverification.RLock()
+	calls = mock.calls.DelReverification
+	mock.lockDelReverification.RUnlock()
+	return calls
+}
+
+// EraseClientData calls EraseClientDataFunc.
+func (mock *MetamorphStoreMock) EraseClientData(ctx context.Context, erasure store.Erasure) (store.Erasure, error) {
+	if mock.EraseClientDataFunc == nil {
+		panic("MetamorphStoreMock.EraseClientDataFunc: method is nil but MetamorphStore.EraseClientData was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Erasure store.Erasure
+	}{
+		Ctx:     ctx,
+		Erasure: erasure,
+	}
+	mock.lockEraseClientData.Lock()
+	mock.calls.EraseClientData = append(mock.calls.EraseClientData, callInfo)
+	mock.lockEraseClientData.Unlock()
+	return mock.EraseClientDataFunc(ctx, erasure)
+}
+
+// EraseClientDataCalls gets all the calls that were made to EraseClientData.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.EraseClientDataCalls())
+func (mock *MetamorphStoreMock) EraseClientDataCalls() []struct {
+	Ctx     context.Context
+	Erasure store.Erasure
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Erasure store.Erasure
+	}
+	mock.lockEraseClientData.RLock()
+	calls = mock.calls.EraseClientData
+	mock.lockEraseClientData.RUnlock()
+	return calls
+}
+
+// Get calls GetFunc.
+func (mock *MetamorphStoreMock) Get(ctx context.Context, key []byte) (*store.Data, error) {
+	if mock.GetFunc == nil {
+		panic("MetamorphStoreMock.GetFunc: method is nil but MetamorphStore.Get was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Key []byte
+	}{
+		Ctx: ctx,
+		Key: key,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, key)
+}
+
+// GetCalls gets all the calls that were made to Get.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetCalls())
+func (mock *MetamorphStoreMock) GetCalls() []struct {
+	Ctx context.Context
+	Key []byte
+} {
+	var calls []struct {
+		Ctx context.Context
+		Key []byte
+	}
+	mock.lockGet.RLock()
+	calls = mock.calls.Get
+	mock.lockGet.RUnlock()
+	return calls
+}
+
+// GetAnnotations calls GetAnnotationsFunc.
+func (mock *MetamorphStoreMock) GetAnnotations(ctx context.Context, hashes [][]byte) ([]store.Annotation, error) {
+	if mock.GetAnnotationsFunc == nil {
+		panic("MetamorphStoreMock.GetAnnotationsFunc: method is nil but MetamorphStore.GetAnnotations was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}{
+		Ctx:    ctx,
+		Hashes: hashes,
+	}
+	mock.lockGetAnnotations.Lock()
+	mock.calls.GetAnnotations = append(mock.calls.GetAnnotations, callInfo)
+	mock.lockGetAnnotations.Unlock()
+	return mock.GetAnnotationsFunc(ctx, hashes)
+}
+
+// GetAnnotationsCalls gets all the calls that were made to GetAnnotations.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetAnnotationsCalls())
+func (mock *MetamorphStoreMock) GetAnnotationsCalls() []struct {
+	Ctx    context.Context
+	Hashes [][]byte
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}
+	mock.lockGetAnnotations.RLock()
+	calls = mock.calls.GetAnnotations
+	mock.lockGetAnnotations.RUnlock()
+	return calls
+}
+
+// GetChildHashes calls GetChildHashesFunc.
+func (mock *MetamorphStoreMock) GetChildHashes(ctx context.Context, hashes [][]byte) ([][]byte, error) {
+	if mock.GetChildHashesFunc == nil {
+		panic("MetamorphStoreMock.GetChildHashesFunc: method is nil but MetamorphStore.GetChildHashes was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}{
+		Ctx:    ctx,
+		Hashes: hashes,
+	}
+	mock.lockGetChildHashes.Lock()
+	mock.calls.GetChildHashes = append(mock.calls.GetChildHashes, callInfo)
+	mock.lockGetChildHashes.Unlock()
+	return mock.GetChildHashesFunc(ctx, hashes)
+}
+
+// GetChildHashesCalls gets all the calls that were made to GetChildHashes.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetChildHashesCalls())
+func (mock *MetamorphStoreMock) GetChildHashesCalls() []struct {
+	Ctx    context.Context
+	Hashes [][]byte
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}
+	mock.lockGetChildHashes.RLock()
+	calls = mock.calls.GetChildHashes
+	mock.lockGetChildHashes.RUnlock()
+	return calls
+}
+
+// GetDoubleSpendTxs calls GetDoubleSpendTxsFunc.
+func (mock *MetamorphStoreMock) GetDoubleSpendTxs(ctx context.Context, older time.Time) ([]*store.Data, error) {
+	if mock.GetDoubleSpendTxsFunc == nil {
+		panic("MetamorphStoreMock.GetDoubleSpendTxsFunc: method is nil but MetamorphStore.GetDoubleSpendTxs was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Older time.Time
+	}{
+		Ctx:   ctx,
+		Older: older,
+	}
+	mock.lockGetDoubleSpendTxs.Lock()
+	mock.calls.GetDoubleSpendTxs = append(mock.calls.GetDoubleSpendTxs, callInfo)
+	mock.lockGetDoubleSpendTxs.Unlock()
+	return mock.GetDoubleSpendTxsFunc(ctx, older)
+}
+
+// GetDoubleSpendTxsCalls gets all the calls that were made to GetDoubleSpendTxs.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetDoubleSpendTxsCalls())
+func (mock *MetamorphStoreMock) GetDoubleSpendTxsCalls() []struct {
+	Ctx   context.Context
+	Older time.Time
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Older time.Time
+	}
+	mock.lockGetDoubleSpendTxs.RLock()
+	calls = mock.calls.GetDoubleSpendTxs
+	mock.lockGetDoubleSpendTxs.RUnlock()
+	return calls
+}
+
+// GetDueScheduled calls GetDueScheduledFunc.
+func (mock *MetamorphStoreMock) GetDueScheduled(ctx context.Context, now time.Time, limit int64) ([]*store.Data, error) {
+	if mock.GetDueScheduledFunc == nil {
+		panic("MetamorphStoreMock.GetDueScheduledFunc: method is nil but MetamorphStore.GetDueScheduled was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int64
+	}{
+		Ctx:   ctx,
+		Now:   now,
+		Limit: limit,
+	}
+	mock.lockGetDueScheduled.Lock()
+	mock.calls.GetDueScheduled = append(mock.calls.GetDueScheduled, callInfo)
+	mock.lockGetDueScheduled.Unlock()
+	return mock.GetDueScheduledFunc(ctx, now, limit)
+}
+
+// GetDueScheduledCalls gets all the calls that were made to GetDueScheduled.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetDueScheduledCalls())
+func (mock *MetamorphStoreMock) GetDueScheduledCalls() []struct {
+	Ctx   context.Context
+	Now   time.Time
+	Limit int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int64
+	}
+	mock.lockGetDueScheduled.RLock()
+	calls = mock.calls.GetDueScheduled
+	mock.lockGetDueScheduled.RUnlock()
+	return calls
+}
+
+// GetErasures calls GetErasuresFunc.
+func (mock *MetamorphStoreMock) GetErasures(ctx context.Context, limit int64) ([]store.Erasure, error) {
+	if mock.GetErasuresFunc == nil {
+		panic("MetamorphStoreMock.GetErasuresFunc: method is nil but MetamorphStore.GetErasures was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Limit int64
+	}{
+		Ctx:   ctx,
+		Limit: limit,
+	}
+	mock.lockGetErasures.Lock()
+	mock.calls.GetErasures = append(mock.calls.GetErasures, callInfo)
+	mock.lockGetErasures.Unlock()
+	return mock.GetErasuresFunc(ctx, limit)
+}
+
+// GetErasuresCalls gets all the calls that were made to GetErasures.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetErasuresCalls())
+func (mock *MetamorphStoreMock) GetErasuresCalls() []struct {
+	Ctx   context.Context
+	Limit int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Limit int64
+	}
+	mock.lockGetErasures.RLock()
+	calls = mock.calls.GetErasures
+	mock.lockGetErasures.RUnlock()
+	return calls
+}
+
+// GetExpired calls GetExpiredFunc.
+func (mock *MetamorphStoreMock) GetExpired(ctx context.Context, now time.Time, limit int64) ([]*chainhash.Hash, error) {
+	if mock.GetExpiredFunc == nil {
+		panic("MetamorphStoreMock.GetExpiredFunc: method is nil but MetamorphStore.GetExpired was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int64
+	}{
+		Ctx:   ctx,
+		Now:   now,
+		Limit: limit,
+	}
+	mock.lockGetExpired.Lock()
+	mock.calls.GetExpired = append(mock.calls.GetExpired, callInfo)
+	mock.lockGetExpired.Unlock()
+	return mock.GetExpiredFunc(ctx, now, limit)
+}
+
+// GetExpiredCalls gets all the calls that were made to GetExpired.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetExpiredCalls())
+func (mock *MetamorphStoreMock) GetExpiredCalls() []struct {
+	Ctx   context.Context
+	Now   time.Time
+	Limit int64
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Now   time.Time
+		Limit int64
+	}
+	mock.lockGetExpired.RLock()
+	calls = mock.calls.GetExpired
+	mock.lockGetExpired.RUnlock()
 	return calls
 }
 
-// Del calls DelFunc.
-func (mock *MetamorphStoreMock) Del(ctx context.Context, key []byte) error {
-	if mock.DelFunc == nil {
-		panic("MetamorphStoreMock.DelFunc: method is nil but MetamorphStore.Del was just called")
+// GetExport calls GetExportFunc.
+func (mock *MetamorphStoreMock) GetExport(ctx context.Context, filter store.ExportFilter, limit int64) ([]*store.Data, error) {
+	if mock.GetExportFunc == nil {
+		panic("MetamorphStoreMock.GetExportFunc: method is nil but MetamorphStore.GetExport was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		Key []byte
+		Ctx    context.Context
+		Filter store.ExportFilter
+		Limit  int64
 	}{
-		Ctx: ctx,
-		Key: key,
+		Ctx:    ctx,
+		Filter: filter,
+		Limit:  limit,
 	}
-	mock.lockDel.Lock()
-	mock.calls.Del = append(mock.calls.Del, callInfo)
-	mock.lockDel.Unlock()
-	return mock.DelFunc(ctx, key)
+	mock.lockGetExport.Lock()
+	mock.calls.GetExport = append(mock.calls.GetExport, callInfo)
+	mock.lockGetExport.Unlock()
+	return mock.GetExportFunc(ctx, filter, limit)
 }
 
-// DelCalls gets all the calls that were made to Del.
+// GetExportCalls gets all the calls that were made to GetExport.
 // Check the length with:
 //
-//	len(mockedMetamorphStore.DelCalls())
-func (mock *MetamorphStoreMock) DelCalls() []struct {
-	Ctx context.Context
-	Key []byte
+//	len(mockedMetamorphStore.GetExportCalls())
+func (mock *MetamorphStoreMock) GetExportCalls() []struct {
+	Ctx    context.Context
+	Filter store.ExportFilter
+	Limit  int64
 } {
 	var calls []struct {
-		Ctx context.Context
-		Key []byte
+		Ctx    context.Context
+		Filter store.ExportFilter
+		Limit  int64
 	}
-	mock.lockDel.RLock()
-	calls = mock.calls.Del
-	mock.lockDel.RUnlock()
+	mock.lockGetExport.RLock()
+	calls = mock.calls.GetExport
+	mock.lockGetExport.RUnlock()
 	return calls
 }
 
-// Get calls GetFunc.
-func (mock *MetamorphStoreMock) Get(ctx context.Context, key []byte) (*store.Data, error) {
-	if mock.GetFunc == nil {
-		panic("MetamorphStoreMock.GetFunc: method is nil but MetamorphStore.Get was just called")
+// GetFinal calls GetFinalFunc.
+func (mock *MetamorphStoreMock) GetFinal(ctx context.Context, nextHeight uint64, medianTimePast time.Time, now time.Time, limit int64) ([]*store.Data, error) {
+	if mock.GetFinalFunc == nil {
+		panic("MetamorphStoreMock.GetFinalFunc: method is nil but MetamorphStore.GetFinal was just called")
 	}
 	callInfo := struct {
-		Ctx context.Context
-		Key []byte
+		Ctx            context.Context
+		NextHeight     uint64
+		MedianTimePast time.Time
+		Now            time.Time
+		Limit          int64
 	}{
-		Ctx: ctx,
-		Key: key,
-	}
-	mock.lockGet.Lock()
-	mock.calls.Get = append(mock.calls.Get, callInfo)
-	mock.lockGet.Unlock()
-	return mock.GetFunc(ctx, key)
+		Ctx:            ctx,
+		NextHeight:     nextHeight,
+		MedianTimePast: medianTimePast,
+		Now:            now,
+		Limit:          limit,
+	}
+	mock.lockGetFinal.Lock()
+	mock.calls.GetFinal = append(mock.calls.GetFinal, callInfo)
+	mock.lockGetFinal.Unlock()
+	return mock.GetFinalFunc(ctx, nextHeight, medianTimePast, now, limit)
 }
 
-// GetCalls gets all the calls that were made to Get.
+// GetFinalCalls gets all the calls that were made to GetFinal.
 // Check the length with:
 //
-//	len(mockedMetamorphStore.GetCalls())
-func (mock *MetamorphStoreMock) GetCalls() []struct {
-	Ctx context.Context
-	Key []byte
+//	len(mockedMetamorphStore.GetFinalCalls())
+func (mock *MetamorphStoreMock) GetFinalCalls() []struct {
+	Ctx            context.Context
+	NextHeight     uint64
+	MedianTimePast time.Time
+	Now            time.Time
+	Limit          int64
 } {
 	var calls []struct {
-		Ctx context.Context
-		Key []byte
-	}
-	mock.lockGet.RLock()
-	calls = mock.calls.Get
-	mock.lockGet.RUnlock()
+		Ctx            context.Context
+		NextHeight     uint64
+		MedianTimePast time.Time
+		Now            time.Time
+		Limit          int64
+	}
+	mock.lockGetFinal.RLock()
+	calls = mock.calls.GetFinal
+	mock.lockGetFinal.RUnlock()
 	return calls
 }
 
-// GetDoubleSpendTxs calls GetDoubleSpendTxsFunc.
-func (mock *MetamorphStoreMock) GetDoubleSpendTxs(ctx context.Context, older time.Time) ([]*store.Data, error) {
-	if mock.GetDoubleSpendTxsFunc == nil {
-		panic("MetamorphStoreMock.GetDoubleSpendTxsFunc: method is nil but MetamorphStore.GetDoubleSpendTxs was just called")
+// GetHashes calls GetHashesFunc.
+func (mock *MetamorphStoreMock) GetHashes(ctx context.Context, after []byte, limit int64) ([][]byte, error) {
+	if mock.GetHashesFunc == nil {
+		panic("MetamorphStoreMock.GetHashesFunc: method is nil but MetamorphStore.GetHashes was just called")
 	}
 	callInfo := struct {
 		Ctx   context.Context
-		Older time.Time
+		After []byte
+		Limit int64
 	}{
 		Ctx:   ctx,
-		Older: older,
+		After: after,
+		Limit: limit,
 	}
-	mock.lockGetDoubleSpendTxs.Lock()
-	mock.calls.GetDoubleSpendTxs = append(mock.calls.GetDoubleSpendTxs, callInfo)
-	mock.lockGetDoubleSpendTxs.Unlock()
-	return mock.GetDoubleSpendTxsFunc(ctx, older)
+	mock.lockGetHashes.Lock()
+	mock.calls.GetHashes = append(mock.calls.GetHashes, callInfo)
+	mock.lockGetHashes.Unlock()
+	return mock.GetHashesFunc(ctx, after, limit)
 }
 
-// GetDoubleSpendTxsCalls gets all the calls that were made to GetDoubleSpendTxs.
+// GetHashesCalls gets all the calls that were made to GetHashes.
 // Check the length with:
 //
-//	len(mockedMetamorphStore.GetDoubleSpendTxsCalls())
-func (mock *MetamorphStoreMock) GetDoubleSpendTxsCalls() []struct {
+//	len(mockedMetamorphStore.GetHashesCalls())
+func (mock *MetamorphStoreMock) GetHashesCalls() []struct {
 	Ctx   context.Context
-	Older time.Time
+	After []byte
+	Limit int64
 } {
 	var calls []struct {
 		Ctx   context.Context
-		Older time.Time
+		After []byte
+		Limit int64
 	}
-	mock.lockGetDoubleSpendTxs.RLock()
-	calls = mock.calls.GetDoubleSpendTxs
-	mock.lockGetDoubleSpendTxs.RUnlock()
+	mock.lockGetHashes.RLock()
+	calls = mock.calls.GetHashes
+	mock.lockGetHashes.RUnlock()
 	return calls
 }
 
@@ -622,6 +1594,78 @@ func (mock *MetamorphStoreMock) GetManyCalls() []struct {
 	return calls
 }
 
+// GetMinedInBlocks calls GetMinedInBlocksFunc.
+func (mock *MetamorphStoreMock) GetMinedInBlocks(ctx context.Context, blockHashes [][]byte) ([]*chainhash.Hash, error) {
+	if mock.GetMinedInBlocksFunc == nil {
+		panic("MetamorphStoreMock.GetMinedInBlocksFunc: method is nil but MetamorphStore.GetMinedInBlocks was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		BlockHashes [][]byte
+	}{
+		Ctx:         ctx,
+		BlockHashes: blockHashes,
+	}
+	mock.lockGetMinedInBlocks.Lock()
+	mock.calls.GetMinedInBlocks = append(mock.calls.GetMinedInBlocks, callInfo)
+	mock.lockGetMinedInBlocks.Unlock()
+	return mock.GetMinedInBlocksFunc(ctx, blockHashes)
+}
+
+// GetMinedInBlocksCalls gets all the calls that were made to GetMinedInBlocks.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetMinedInBlocksCalls())
+func (mock *MetamorphStoreMock) GetMinedInBlocksCalls() []struct {
+	Ctx         context.Context
+	BlockHashes [][]byte
+} {
+	var calls []struct {
+		Ctx         context.Context
+		BlockHashes [][]byte
+	}
+	mock.lockGetMinedInBlocks.RLock()
+	calls = mock.calls.GetMinedInBlocks
+	mock.lockGetMinedInBlocks.RUnlock()
+	return calls
+}
+
+// GetPeerAcks calls GetPeerAcksFunc.
+func (mock *MetamorphStoreMock) GetPeerAcks(ctx context.Context, hashes [][]byte) ([]store.PeerAck, error) {
+	if mock.GetPeerAcksFunc == nil {
+		panic("MetamorphStoreMock.GetPeerAcksFunc: method is nil but MetamorphStore.GetPeerAcks was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}{
+		Ctx:    ctx,
+		Hashes: hashes,
+	}
+	mock.lockGetPeerAcks.Lock()
+	mock.calls.GetPeerAcks = append(mock.calls.GetPeerAcks, callInfo)
+	mock.lockGetPeerAcks.Unlock()
+	return mock.GetPeerAcksFunc(ctx, hashes)
+}
+
+// GetPeerAcksCalls gets all the calls that were made to GetPeerAcks.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetPeerAcksCalls())
+func (mock *MetamorphStoreMock) GetPeerAcksCalls() []struct {
+	Ctx    context.Context
+	Hashes [][]byte
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}
+	mock.lockGetPeerAcks.RLock()
+	calls = mock.calls.GetPeerAcks
+	mock.lockGetPeerAcks.RUnlock()
+	return calls
+}
+
 // GetRawTxs calls GetRawTxsFunc.
 func (mock *MetamorphStoreMock) GetRawTxs(ctx context.Context, hashes [][]byte) ([][]byte, error) {
 	if mock.GetRawTxsFunc == nil {
@@ -658,6 +1702,98 @@ func (mock *MetamorphStoreMock) GetRawTxsCalls() []struct {
 	return calls
 }
 
+// GetReverifications calls GetReverificationsFunc.
+func (mock *MetamorphStoreMock) GetReverifications(ctx context.Context, now time.Time, lockedUntil time.Time, limit int64) ([]store.Reverification, error) {
+	if mock.GetReverificationsFunc == nil {
+		panic("MetamorphStoreMock.GetReverificationsFunc: method is nil but MetamorphStore.GetReverifications was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Now         time.Time
+		LockedUntil time.Time
+		Limit       int64
+	}{
+		Ctx:         ctx,
+		Now:         now,
+		LockedUntil: lockedUntil,
+		Limit:       limit,
+	}
+	mock.lockGetReverifications.Lock()
+	mock.calls.GetReverifications = append(mock.calls.GetReverifications, callInfo)
+	mock.lockGetReverifications.Unlock()
+	return mock.GetReverificationsFunc(ctx, now, lockedUntil, limit)
+}
+
+// GetReverificationsCalls gets all the calls that were made to GetReverifications.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetReverificationsCalls())
+func (mock *MetamorphStoreMock) GetReverificationsCalls() []struct {
+	Ctx         context.Context
+	Now         time.Time
+	LockedUntil time.Time
+	Limit       int64
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Now         time.Time
+		LockedUntil time.Time
+		Limit       int64
+	}
+	mock.lockGetReverifications.RLock()
+	calls = mock.calls.GetReverifications
+	mock.lockGetReverifications.RUnlock()
+	return calls
+}
+
+// GetSLAReports calls GetSLAReportsFunc.
+func (mock *MetamorphStoreMock) GetSLAReports(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error) {
+	if mock.GetSLAReportsFunc == nil {
+		panic("MetamorphStoreMock.GetSLAReportsFunc: method is nil but MetamorphStore.GetSLAReports was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Period string
+		From   time.Time
+		To     time.Time
+		Tenant string
+	}{
+		Ctx:    ctx,
+		Period: period,
+		From:   from,
+		To:     to,
+		Tenant: tenant,
+	}
+	mock.lockGetSLAReports.Lock()
+	mock.calls.GetSLAReports = append(mock.calls.GetSLAReports, callInfo)
+	mock.lockGetSLAReports.Unlock()
+	return mock.GetSLAReportsFunc(ctx, period, from, to, tenant)
+}
+
+// GetSLAReportsCalls gets all the calls that were made to GetSLAReports.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetSLAReportsCalls())
+func (mock *MetamorphStoreMock) GetSLAReportsCalls() []struct {
+	Ctx    context.Context
+	Period string
+	From   time.Time
+	To     time.Time
+	Tenant string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Period string
+		From   time.Time
+		To     time.Time
+		Tenant string
+	}
+	mock.lockGetSLAReports.RLock()
+	calls = mock.calls.GetSLAReports
+	mock.lockGetSLAReports.RUnlock()
+	return calls
+}
+
 // GetSeen calls GetSeenFunc.
 func (mock *MetamorphStoreMock) GetSeen(ctx context.Context, fromDuration time.Duration, toDuration time.Duration, limit int64, offset int64) ([]*store.Data, error) {
 	if mock.GetSeenFunc == nil {
@@ -802,6 +1938,54 @@ func (mock *MetamorphStoreMock) GetStatsCalls() []struct {
 	return calls
 }
 
+// GetStoredBetween calls GetStoredBetweenFunc.
+func (mock *MetamorphStoreMock) GetStoredBetween(ctx context.Context, from time.Time, to time.Time, limit int64, offset int64) ([]*store.Data, error) {
+	if mock.GetStoredBetweenFunc == nil {
+		panic("MetamorphStoreMock.GetStoredBetweenFunc: method is nil but MetamorphStore.GetStoredBetween was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		From   time.Time
+		To     time.Time
+		Limit  int64
+		Offset int64
+	}{
+		Ctx:    ctx,
+		From:   from,
+		To:     to,
+		Limit:  limit,
+		Offset: offset,
+	}
+	mock.lockGetStoredBetween.Lock()
+	mock.calls.GetStoredBetween = append(mock.calls.GetStoredBetween, callInfo)
+	mock.lockGetStoredBetween.Unlock()
+	return mock.GetStoredBetweenFunc(ctx, from, to, limit, offset)
+}
+
+// GetStoredBetweenCalls gets all the calls that were made to GetStoredBetween.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetStoredBetweenCalls())
+func (mock *MetamorphStoreMock) GetStoredBetweenCalls() []struct {
+	Ctx    context.Context
+	From   time.Time
+	To     time.Time
+	Limit  int64
+	Offset int64
+} {
+	var calls []struct {
+		Ctx    context.Context
+		From   time.Time
+		To     time.Time
+		Limit  int64
+		Offset int64
+	}
+	mock.lockGetStoredBetween.RLock()
+	calls = mock.calls.GetStoredBetween
+	mock.lockGetStoredBetween.RUnlock()
+	return calls
+}
+
 // GetUnconfirmedRequested calls GetUnconfirmedRequestedFunc.
 func (mock *MetamorphStoreMock) GetUnconfirmedRequested(ctx context.Context, requestedAgo time.Duration, limit int64, offset int64) ([]*chainhash.Hash, error) {
 	if mock.GetUnconfirmedRequestedFunc == nil {
@@ -846,6 +2030,50 @@ func (mock *MetamorphStoreMock) GetUnconfirmedRequestedCalls() []struct {
 	return calls
 }
 
+// GetUnrequested calls GetUnrequestedFunc.
+func (mock *MetamorphStoreMock) GetUnrequested(ctx context.Context, since time.Time, announcedBefore time.Time, limit int64) ([]*store.Data, error) {
+	if mock.GetUnrequestedFunc == nil {
+		panic("MetamorphStoreMock.GetUnrequestedFunc: method is nil but MetamorphStore.GetUnrequested was just called")
+	}
+	callInfo := struct {
+		Ctx             context.Context
+		Since           time.Time
+		AnnouncedBefore time.Time
+		Limit           int64
+	}{
+		Ctx:             ctx,
+		Since:           since,
+		AnnouncedBefore: announcedBefore,
+		Limit:           limit,
+	}
+	mock.lockGetUnrequested.Lock()
+	mock.calls.GetUnrequested = append(mock.calls.GetUnrequested, callInfo)
+	mock.lockGetUnrequested.Unlock()
+	return mock.GetUnrequestedFunc(ctx, since, announcedBefore, limit)
+}
+
+// GetUnrequestedCalls gets all the calls that were made to GetUnrequested.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetUnrequestedCalls())
+func (mock *MetamorphStoreMock) GetUnrequestedCalls() []struct {
+	Ctx             context.Context
+	Since           time.Time
+	AnnouncedBefore time.Time
+	Limit           int64
+} {
+	var calls []struct {
+		Ctx             context.Context
+		Since           time.Time
+		AnnouncedBefore time.Time
+		Limit           int64
+	}
+	mock.lockGetUnrequested.RLock()
+	calls = mock.calls.GetUnrequested
+	mock.lockGetUnrequested.RUnlock()
+	return calls
+}
+
 // GetUnseen calls GetUnseenFunc.
 func (mock *MetamorphStoreMock) GetUnseen(ctx context.Context, since time.Time, limit int64, offset int64) ([]*store.Data, error) {
 	if mock.GetUnseenFunc == nil {
@@ -890,6 +2118,42 @@ func (mock *MetamorphStoreMock) GetUnseenCalls() []struct {
 	return calls
 }
 
+// GetUnverified calls GetUnverifiedFunc.
+func (mock *MetamorphStoreMock) GetUnverified(ctx context.Context, hashes [][]byte) ([][]byte, error) {
+	if mock.GetUnverifiedFunc == nil {
+		panic("MetamorphStoreMock.GetUnverifiedFunc: method is nil but MetamorphStore.GetUnverified was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}{
+		Ctx:    ctx,
+		Hashes: hashes,
+	}
+	mock.lockGetUnverified.Lock()
+	mock.calls.GetUnverified = append(mock.calls.GetUnverified, callInfo)
+	mock.lockGetUnverified.Unlock()
+	return mock.GetUnverifiedFunc(ctx, hashes)
+}
+
+// GetUnverifiedCalls gets all the calls that were made to GetUnverified.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.GetUnverifiedCalls())
+func (mock *MetamorphStoreMock) GetUnverifiedCalls() []struct {
+	Ctx    context.Context
+	Hashes [][]byte
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Hashes [][]byte
+	}
+	mock.lockGetUnverified.RLock()
+	calls = mock.calls.GetUnverified
+	mock.lockGetUnverified.RUnlock()
+	return calls
+}
+
 // IncrementRetries calls IncrementRetriesFunc.
 func (mock *MetamorphStoreMock) IncrementRetries(ctx context.Context, hash *chainhash.Hash) error {
 	if mock.IncrementRetriesFunc == nil {
@@ -994,6 +2258,42 @@ func (mock *MetamorphStoreMock) PingCalls() []struct {
 	return calls
 }
 
+// ResubmitRejected calls ResubmitRejectedFunc.
+func (mock *MetamorphStoreMock) ResubmitRejected(ctx context.Context, hash *chainhash.Hash) error {
+	if mock.ResubmitRejectedFunc == nil {
+		panic("MetamorphStoreMock.ResubmitRejectedFunc: method is nil but MetamorphStore.ResubmitRejected was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Hash *chainhash.Hash
+	}{
+		Ctx:  ctx,
+		Hash: hash,
+	}
+	mock.lockResubmitRejected.Lock()
+	mock.calls.ResubmitRejected = append(mock.calls.ResubmitRejected, callInfo)
+	mock.lockResubmitRejected.Unlock()
+	return mock.ResubmitRejectedFunc(ctx, hash)
+}
+
+// ResubmitRejectedCalls gets all the calls that were made to ResubmitRejected.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.ResubmitRejectedCalls())
+func (mock *MetamorphStoreMock) ResubmitRejectedCalls() []struct {
+	Ctx  context.Context
+	Hash *chainhash.Hash
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Hash *chainhash.Hash
+	}
+	mock.lockResubmitRejected.RLock()
+	calls = mock.calls.ResubmitRejected
+	mock.lockResubmitRejected.RUnlock()
+	return calls
+}
+
 // Set calls SetFunc.
 func (mock *MetamorphStoreMock) Set(ctx context.Context, value *store.Data) error {
 	if mock.SetFunc == nil {
@@ -1106,6 +2406,82 @@ func (mock *MetamorphStoreMock) SetLockedCalls() []struct {
 	return calls
 }
 
+// SetNodeSubmission calls SetNodeSubmissionFunc.
+func (mock *MetamorphStoreMock) SetNodeSubmission(ctx context.Context, hash *chainhash.Hash, submission store.NodeSubmission) error {
+	if mock.SetNodeSubmissionFunc == nil {
+		panic("MetamorphStoreMock.SetNodeSubmissionFunc: method is nil but MetamorphStore.SetNodeSubmission was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Hash       *chainhash.Hash
+		Submission store.NodeSubmission
+	}{
+		Ctx:        ctx,
+		Hash:       hash,
+		Submission: submission,
+	}
+	mock.lockSetNodeSubmission.Lock()
+	mock.calls.SetNodeSubmission = append(mock.calls.SetNodeSubmission, callInfo)
+	mock.lockSetNodeSubmission.Unlock()
+	return mock.SetNodeSubmissionFunc(ctx, hash, submission)
+}
+
+// SetNodeSubmissionCalls gets all the calls that were made to SetNodeSubmission.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.SetNodeSubmissionCalls())
+func (mock *MetamorphStoreMock) SetNodeSubmissionCalls() []struct {
+	Ctx        context.Context
+	Hash       *chainhash.Hash
+	Submission store.NodeSubmission
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Hash       *chainhash.Hash
+		Submission store.NodeSubmission
+	}
+	mock.lockSetNodeSubmission.RLock()
+	calls = mock.calls.SetNodeSubmission
+	mock.lockSetNodeSubmission.RUnlock()
+	return calls
+}
+
+// SetPeerAcks calls SetPeerAcksFunc.
+func (mock *MetamorphStoreMock) SetPeerAcks(ctx context.Context, acks []store.PeerAck) error {
+	if mock.SetPeerAcksFunc == nil {
+		panic("MetamorphStoreMock.SetPeerAcksFunc: method is nil but MetamorphStore.SetPeerAcks was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Acks []store.PeerAck
+	}{
+		Ctx:  ctx,
+		Acks: acks,
+	}
+	mock.lockSetPeerAcks.Lock()
+	mock.calls.SetPeerAcks = append(mock.calls.SetPeerAcks, callInfo)
+	mock.lockSetPeerAcks.Unlock()
+	return mock.SetPeerAcksFunc(ctx, acks)
+}
+
+// SetPeerAcksCalls gets all the calls that were made to SetPeerAcks.
+// Check the length with:
+//
+//	len(mockedMetamorphStore.SetPeerAcksCalls())
+func (mock *MetamorphStoreMock) SetPeerAcksCalls() []struct {
+	Ctx  context.Context
+	Acks []store.PeerAck
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Acks []store.PeerAck
+	}
+	mock.lockSetPeerAcks.RLock()
+	calls = mock.calls.SetPeerAcks
+	mock.lockSetPeerAcks.RUnlock()
+	return calls
+}
+
 // SetRequested calls SetRequestedFunc.
 func (mock *MetamorphStoreMock) SetRequested(ctx context.Context, hashes []*chainhash.Hash) error {
 	if mock.SetRequestedFunc == nil {
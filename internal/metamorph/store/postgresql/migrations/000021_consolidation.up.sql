@@ -0,0 +1 @@
+ALTER TABLE metamorph.transactions ADD COLUMN consolidation BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,2 @@
+ALTER TABLE metamorph.transactions DROP COLUMN received_at;
+ALTER TABLE metamorph.transactions DROP COLUMN validated_at;
@@ -0,0 +1,2 @@
+ALTER TABLE metamorph.transactions ADD COLUMN received_at TIMESTAMPTZ;
+ALTER TABLE metamorph.transactions ADD COLUMN validated_at TIMESTAMPTZ;
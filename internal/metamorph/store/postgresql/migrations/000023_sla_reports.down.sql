@@ -0,0 +1,5 @@
+DROP TABLE metamorph.sla_reports;
+
+DROP INDEX metamorph.ix_metamorph_transactions_tenant;
+
+ALTER TABLE metamorph.transactions DROP COLUMN tenant;
@@ -0,0 +1,20 @@
+-- 'tenant' is the name of the tenant whose API key submitted the transaction
+ALTER TABLE metamorph.transactions ADD COLUMN tenant TEXT;
+
+CREATE INDEX ix_metamorph_transactions_tenant ON metamorph.transactions (tenant, stored_at) WHERE tenant IS NOT NULL;
+
+-- 'sla_reports' holds the SLA metrics of the transactions of a tenant which were stored in a daily or weekly period,
+-- the p95 times are measured from storing the transaction to its first seen and its mined status in milliseconds. The
+-- reports are kept after the transactions have been cleared.
+CREATE TABLE metamorph.sla_reports (
+    period TEXT NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    tenant TEXT NOT NULL,
+    transactions BIGINT NOT NULL,
+    seen_on_network BIGINT NOT NULL,
+    p95_time_to_seen_ms BIGINT NOT NULL,
+    mined BIGINT NOT NULL,
+    p95_time_to_mined_ms BIGINT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (period, period_start, tenant)
+);
@@ -0,0 +1,23 @@
+-- Down Migration: Convert 'metamorph.transactions' back into a regular table by copying all records if it has been
+-- partitioned by the partition manager of metamorph
+
+DO $$
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'metamorph.transactions'::REGCLASS) <> 'p' THEN
+        RETURN;
+    END IF;
+
+    CREATE TABLE metamorph.transactions_unpartitioned (LIKE metamorph.transactions INCLUDING DEFAULTS);
+
+    INSERT INTO metamorph.transactions_unpartitioned SELECT * FROM metamorph.transactions;
+
+    DROP TABLE metamorph.transactions;
+
+    ALTER TABLE metamorph.transactions_unpartitioned RENAME TO transactions;
+
+    ALTER TABLE metamorph.transactions ADD PRIMARY KEY (hash);
+    CREATE INDEX ix_metamorph_transactions_locked_by ON metamorph.transactions (locked_by);
+    CREATE INDEX idx_metamorph_transactions_locked_by_status ON metamorph.transactions (locked_by, status);
+    CREATE INDEX ix_metamorph_transactions_last_submitted_at ON metamorph.transactions (last_submitted_at);
+    CREATE INDEX ix_metamorph_transactions_tenant ON metamorph.transactions (tenant, stored_at) WHERE tenant IS NOT NULL;
+END $$;
@@ -0,0 +1,4 @@
+-- Up Migration: The partitioning of 'metamorph.transactions' by range of 'stored_at' is opt-in. The table is converted
+-- by the partition manager of metamorph if 'metamorph.partitioning.enabled' is set, see PartitionManager.partitionTable.
+-- Migrations which create indexes on the table have to create them on each partition if the table is partitioned.
+SELECT 1;
@@ -0,0 +1,2 @@
+ALTER TABLE metamorph.transactions DROP COLUMN normalized_hash;
+ALTER TABLE metamorph.transactions DROP COLUMN mined_hash;
@@ -0,0 +1,24 @@
+-- 'normalized_hash' is the hash of the transaction with empty unlocking scripts, which is the same for all malleated
+-- variants of the transaction. 'mined_hash' is the hash of the variant which was mined if it differs from 'hash'.
+ALTER TABLE metamorph.transactions ADD COLUMN normalized_hash BYTEA;
+ALTER TABLE metamorph.transactions ADD COLUMN mined_hash BYTEA;
+
+-- if the table has been partitioned by the partition manager of metamorph, the partitioned table has no indexes, the
+-- index is created on each partition, new partitions get it from the default partition
+DO $$
+DECLARE
+    partition_name TEXT;
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'metamorph.transactions'::REGCLASS) <> 'p' THEN
+        CREATE INDEX ix_metamorph_transactions_normalized_hash ON metamorph.transactions (normalized_hash) WHERE normalized_hash IS NOT NULL;
+        RETURN;
+    END IF;
+
+    FOR partition_name IN
+        SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'metamorph.transactions'::REGCLASS
+    LOOP
+        EXECUTE FORMAT('CREATE INDEX %I ON metamorph.%I (normalized_hash) WHERE normalized_hash IS NOT NULL',
+            'ix_' || partition_name || '_normalized_hash', partition_name);
+    END LOOP;
+END $$;
@@ -0,0 +1,11 @@
+-- 'transaction_parents' links each transaction to the transactions whose outputs it spends, so that the unmined
+-- descendants of a transaction can be looked up by 'parent_hash'
+CREATE TABLE metamorph.transaction_parents (
+    hash BYTEA NOT NULL,
+    parent_hash BYTEA NOT NULL,
+    stored_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (hash, parent_hash)
+);
+
+CREATE INDEX ix_metamorph_transaction_parents_parent_hash ON metamorph.transaction_parents (parent_hash);
+CREATE INDEX ix_metamorph_transaction_parents_stored_at ON metamorph.transaction_parents (stored_at);
@@ -0,0 +1,23 @@
+-- 'broadcast_at' is the time at which a scheduled transaction is announced to the network, it is reset when the
+-- transaction is announced
+ALTER TABLE metamorph.transactions ADD COLUMN broadcast_at TIMESTAMPTZ;
+
+-- if the table has been partitioned by the partition manager of metamorph, the partitioned table has no indexes, the
+-- index is created on each partition, new partitions get it from the default partition
+DO $$
+DECLARE
+    partition_name TEXT;
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'metamorph.transactions'::REGCLASS) <> 'p' THEN
+        CREATE INDEX ix_metamorph_transactions_broadcast_at ON metamorph.transactions (broadcast_at) WHERE broadcast_at IS NOT NULL;
+        RETURN;
+    END IF;
+
+    FOR partition_name IN
+        SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'metamorph.transactions'::REGCLASS
+    LOOP
+        EXECUTE FORMAT('CREATE INDEX %I ON metamorph.%I (broadcast_at) WHERE broadcast_at IS NOT NULL',
+            'ix_' || partition_name || '_broadcast_at', partition_name);
+    END LOOP;
+END $$;
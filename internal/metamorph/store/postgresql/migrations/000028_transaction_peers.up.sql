@@ -0,0 +1,12 @@
+-- 'transaction_peers' records per transaction which peers requested the transaction after its announcement and which
+-- peers it was sent to, so that re-announcements skip the peers which have already requested it
+CREATE TABLE metamorph.transaction_peers (
+    hash BYTEA NOT NULL,
+    peer TEXT NOT NULL,
+    requested_at TIMESTAMPTZ,
+    sent_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (hash, peer)
+);
+
+CREATE INDEX ix_metamorph_transaction_peers_created_at ON metamorph.transaction_peers (created_at);
@@ -0,0 +1 @@
+DROP TABLE metamorph.transaction_annotations;
@@ -0,0 +1,12 @@
+-- 'transaction_annotations' holds the notes and labels attached to transactions by operators, e.g. the reference of
+-- a customer dispute, for the tracking of incidents
+CREATE TABLE metamorph.transaction_annotations (
+    id BIGSERIAL PRIMARY KEY,
+    hash BYTEA NOT NULL,
+    note TEXT NOT NULL,
+    labels TEXT[] NOT NULL,
+    author TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX ix_metamorph_transaction_annotations_hash ON metamorph.transaction_annotations (hash);
@@ -0,0 +1,3 @@
+-- 'announce_to' restricts the peers a transaction is announced to, either the number of peers or the name of a
+-- designated peer, it is NULL if the transaction is announced to all peers
+ALTER TABLE metamorph.transactions ADD COLUMN announce_to TEXT;
@@ -0,0 +1,3 @@
+ALTER TABLE metamorph.transactions DROP COLUMN node_reject_reason;
+ALTER TABLE metamorph.transactions DROP COLUMN node_accepted;
+ALTER TABLE metamorph.transactions DROP COLUMN node_submitted_at;
@@ -0,0 +1,5 @@
+-- 'node_submitted_at' is the time at which a transaction which was not requested by any peer after its announcement was
+-- submitted to the node with sendrawtransaction, 'node_accepted' and 'node_reject_reason' record the response of the node
+ALTER TABLE metamorph.transactions ADD COLUMN node_submitted_at TIMESTAMPTZ;
+ALTER TABLE metamorph.transactions ADD COLUMN node_accepted BOOLEAN;
+ALTER TABLE metamorph.transactions ADD COLUMN node_reject_reason TEXT;
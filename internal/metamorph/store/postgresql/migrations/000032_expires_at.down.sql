@@ -0,0 +1 @@
+ALTER TABLE metamorph.transactions DROP COLUMN expires_at;
@@ -0,0 +1,23 @@
+-- 'expires_at' is the time after which a transaction which is not mined expires, it is NULL if the transaction does not
+-- expire
+ALTER TABLE metamorph.transactions ADD COLUMN expires_at TIMESTAMPTZ;
+
+-- if the table has been partitioned by the partition manager of metamorph, the partitioned table has no indexes, the
+-- index is created on each partition, new partitions get it from the default partition
+DO $$
+DECLARE
+    partition_name TEXT;
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'metamorph.transactions'::REGCLASS) <> 'p' THEN
+        CREATE INDEX ix_metamorph_transactions_expires_at ON metamorph.transactions (expires_at) WHERE expires_at IS NOT NULL;
+        RETURN;
+    END IF;
+
+    FOR partition_name IN
+        SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'metamorph.transactions'::REGCLASS
+    LOOP
+        EXECUTE FORMAT('CREATE INDEX %I ON metamorph.%I (expires_at) WHERE expires_at IS NOT NULL',
+            'ix_' || partition_name || '_expires_at', partition_name);
+    END LOOP;
+END $$;
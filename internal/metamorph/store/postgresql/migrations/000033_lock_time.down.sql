@@ -0,0 +1 @@
+ALTER TABLE metamorph.transactions DROP COLUMN lock_time;
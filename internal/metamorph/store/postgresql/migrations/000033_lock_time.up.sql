@@ -0,0 +1,23 @@
+-- 'lock_time' is the lock time of a non-final transaction which is held in the status WAITING_FOR_FINALITY until it is
+-- final, it is NULL if the transaction is not held
+ALTER TABLE metamorph.transactions ADD COLUMN lock_time BIGINT;
+
+-- if the table has been partitioned by the partition manager of metamorph, the partitioned table has no indexes, the
+-- index is created on each partition, new partitions get it from the default partition
+DO $$
+DECLARE
+    partition_name TEXT;
+BEGIN
+    IF (SELECT relkind FROM pg_class WHERE oid = 'metamorph.transactions'::REGCLASS) <> 'p' THEN
+        CREATE INDEX ix_metamorph_transactions_lock_time ON metamorph.transactions (lock_time) WHERE lock_time IS NOT NULL;
+        RETURN;
+    END IF;
+
+    FOR partition_name IN
+        SELECT c.relname FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+        WHERE i.inhparent = 'metamorph.transactions'::REGCLASS
+    LOOP
+        EXECUTE FORMAT('CREATE INDEX %I ON metamorph.%I (lock_time) WHERE lock_time IS NOT NULL',
+            'ix_' || partition_name || '_lock_time', partition_name);
+    END LOOP;
+END $$;
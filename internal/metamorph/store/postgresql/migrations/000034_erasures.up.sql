@@ -0,0 +1,11 @@
+-- 'erasures' is the audit trail of the erasures of the client data, i.e. the callback URLs and tokens, of the
+-- transactions of a tenant or with the given hashes
+CREATE TABLE metamorph.erasures (
+    id BIGSERIAL PRIMARY KEY,
+    tenant TEXT,
+    hashes BYTEA[] NOT NULL,
+    erased_by TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    erased_at TIMESTAMPTZ NOT NULL,
+    transactions BIGINT NOT NULL
+);
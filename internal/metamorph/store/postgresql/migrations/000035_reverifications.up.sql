@@ -0,0 +1,15 @@
+-- 'reverifications' are the BEEFs whose Merkle roots could not be verified on submission and are verified again by the
+-- API, they are kept until their Merkle roots are verified, found invalid or could not be verified in time
+CREATE TABLE metamorph.reverifications (
+    id BIGSERIAL PRIMARY KEY,
+    mode TEXT NOT NULL,
+    beef BYTEA NOT NULL,
+    hashes BYTEA[] NOT NULL,
+    options BYTEA,
+    tenant TEXT,
+    added_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ
+);
+
+CREATE INDEX ix_metamorph_reverifications_hashes ON metamorph.reverifications USING GIN (hashes);
+CREATE INDEX ix_metamorph_reverifications_added_at ON metamorph.reverifications (added_at);
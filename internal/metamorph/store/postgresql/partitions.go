@@ -0,0 +1,399 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+)
+
+const (
+	partitionManagerIntervalDefault = time.Hour
+	partitionsAheadDefault          = 3
+	partitionNameLayout             = "transactions_p20060102"
+	partitionManagerLock            = "arc_metamorph_partitions"
+	maintainPartitionsJob           = "MaintainPartitions"
+	legacyConstraint                = "transactions_legacy_stored_at"
+	partitionLockTimeout            = "10s"
+)
+
+var (
+	ErrFailedToPartitionTable  = errors.New("failed to partition transactions table")
+	ErrFailedToCreatePartition = errors.New("failed to create partition")
+	ErrFailedToDropPartition   = errors.New("failed to drop partition")
+)
+
+// PartitionManager maintains the daily partitions of the transactions table, which is partitioned by stored_at. It
+// converts the table into a partitioned table on its first run, creates the partitions of the next days ahead of time
+// and drops the partitions whose records are all older than the retention period, which replaces the deletion of the
+// records by ClearData. Records which have been submitted again within the retention period or which are in the
+// quarantine of rejected transactions are moved to the current partition before their partition is dropped. The store
+// has to be created WithPartitioning.
+type PartitionManager struct {
+	logger        *slog.Logger
+	store         *PostgreSQL
+	retentionDays int
+	interval      time.Duration
+	ahead         int
+}
+
+func WithPartitionManagerInterval(d time.Duration) func(*PartitionManager) {
+	return func(m *PartitionManager) {
+		m.interval = d
+	}
+}
+
+// WithPartitionsAhead sets the number of days ahead for which the partitions are created.
+func WithPartitionsAhead(days int) func(*PartitionManager) {
+	return func(m *PartitionManager) {
+		m.ahead = days
+	}
+}
+
+func NewPartitionManager(logger *slog.Logger, p *PostgreSQL, retentionDays int, opts ...func(*PartitionManager)) *PartitionManager {
+	m := &PartitionManager{
+		logger:        logger.With(slog.String("module", "partition-manager")),
+		store:         p,
+		retentionDays: retentionDays,
+		interval:      partitionManagerIntervalDefault,
+		ahead:         partitionsAheadDefault,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// Schedule adds the maintenance of the partitions as job to the scheduler. The partitions are maintained right away,
+// unless the job is paused, and then every interval.
+func (m *PartitionManager) Schedule(s *scheduler.Scheduler) error {
+	err := s.Add(maintainPartitionsJob, scheduler.Every(m.interval), func(ctx context.Context) {
+		maintainErr := m.Maintain(ctx)
+		if maintainErr != nil {
+			m.logger.Error("Failed to maintain partitions", slog.String("err", maintainErr.Error()))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	job, err := s.Job(maintainPartitionsJob)
+	if err != nil {
+		return err
+	}
+	if job.Paused {
+		return nil
+	}
+
+	return s.Trigger(maintainPartitionsJob)
+}
+
+// Maintain partitions the transactions table if it is not partitioned yet, creates the missing partitions of the next
+// days and drops the expired partitions. The partitions are maintained by one instance at a time, other instances skip
+// the maintenance.
+func (m *PartitionManager) Maintain(ctx context.Context) error {
+	conn, err := m.store.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(HASHTEXT($1))`, partitionManagerLock).Scan(&locked)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		m.logger.Debug("Partitions are maintained by another instance")
+		return nil
+	}
+	defer func() {
+		_, _ = conn.ExecContext(context.Background(), `SELECT pg_advisory_unlock(HASHTEXT($1))`, partitionManagerLock)
+	}()
+
+	err = m.partitionTable(ctx)
+	if err != nil {
+		return errors.Join(ErrFailedToPartitionTable, err)
+	}
+
+	err = m.createPartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.dropExpiredPartitions(ctx)
+}
+
+// IsPartitioned returns true if the transactions table has been partitioned by the PartitionManager.
+func (p *PostgreSQL) IsPartitioned(ctx context.Context) (bool, error) {
+	var partitioned bool
+	err := p.db.QueryRowContext(ctx, `SELECT relkind = 'p' FROM pg_class WHERE oid = 'metamorph.transactions'::REGCLASS`).Scan(&partitioned)
+
+	return partitioned, err
+}
+
+// partitionTable converts the transactions table into a table partitioned by range of stored_at, unless it is
+// partitioned already. The existing table is attached as partition 'transactions_legacy' holding all records stored
+// until the end of the next day (UTC), so that no records are copied. The range of the legacy partition is proven by a
+// check constraint, which is added as NOT VALID and validated separately, so that the table is scanned without blocking
+// reads and writes. Only renaming the table and attaching the partitions lock the table, briefly. The range includes the
+// next day, so that the records inserted while the constraint is validated satisfy it.
+func (m *PartitionManager) partitionTable(ctx context.Context) (err error) {
+	partitioned, err := m.store.IsPartitioned(ctx)
+	if err != nil {
+		return err
+	}
+	if partitioned {
+		return nil
+	}
+
+	legacyUntil := m.store.now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 2)
+
+	// the constraint of a failed conversion is replaced, its range may have passed already
+	_, err = m.store.db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE metamorph.transactions DROP CONSTRAINT IF EXISTS %s`, legacyConstraint))
+	if err != nil {
+		return err
+	}
+
+	_, err = m.store.db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE metamorph.transactions ADD CONSTRAINT %s CHECK (stored_at < '%s') NOT VALID`,
+		legacyConstraint, legacyUntil.Format(time.RFC3339)))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// a constraint left behind would reject the records stored after the end of its range
+		if err != nil {
+			_, _ = m.store.db.ExecContext(context.Background(), fmt.Sprintf(`ALTER TABLE IF EXISTS metamorph.transactions DROP CONSTRAINT IF EXISTS %s`, legacyConstraint))
+		}
+	}()
+
+	m.logger.Info("Validating range of legacy partition", slog.Time("until", legacyUntil))
+
+	_, err = m.store.db.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE metamorph.transactions VALIDATE CONSTRAINT %s`, legacyConstraint))
+	if err != nil {
+		return err
+	}
+
+	tx, err := m.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// the conversion is retried by the next maintenance rather than blocking the queries on the table while it waits
+	// for its locks
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`SET LOCAL lock_timeout = '%s'`, partitionLockTimeout))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `ALTER TABLE metamorph.transactions RENAME TO transactions_legacy`)
+	if err != nil {
+		return err
+	}
+
+	// the partitioned table has no primary key, as it would have to include stored_at, each partition has its own
+	// primary key on the hash
+	_, err = tx.ExecContext(ctx, `CREATE TABLE metamorph.transactions (LIKE metamorph.transactions_legacy INCLUDING DEFAULTS)
+		PARTITION BY RANGE (stored_at)`)
+	if err != nil {
+		return err
+	}
+
+	// new partitions are created like the default partition, which has the same indexes as the legacy table
+	_, err = tx.ExecContext(ctx, `CREATE TABLE metamorph.transactions_default (LIKE metamorph.transactions_legacy INCLUDING ALL EXCLUDING CONSTRAINTS)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `ALTER TABLE metamorph.transactions ATTACH PARTITION metamorph.transactions_default DEFAULT`)
+	if err != nil {
+		return err
+	}
+
+	// the validated constraint proves the range of the legacy partition, so that the attachment does not scan it
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE metamorph.transactions ATTACH PARTITION metamorph.transactions_legacy FOR VALUES FROM (MINVALUE) TO ('%s')`,
+		legacyUntil.Format(time.RFC3339)))
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("Partitioned transactions table", slog.Time("legacy until", legacyUntil))
+
+	return nil
+}
+
+// createPartitions creates the partitions of the days after the last partition until the number of days ahead. The
+// partition of the current day has been created the day before, or the current day is covered by the legacy
+// partition. Records of days without partition are stored in the default partition.
+func (m *PartitionManager) createPartitions(ctx context.Context) error {
+	today := m.store.now().UTC().Truncate(24 * time.Hour)
+
+	upperBounds, err := m.upperBounds(ctx)
+	if err != nil {
+		return errors.Join(ErrFailedToCreatePartition, err)
+	}
+
+	from := today.AddDate(0, 0, 1)
+	for _, upperBound := range upperBounds {
+		if upperBound.After(from) {
+			from = upperBound.UTC()
+		}
+	}
+
+	for until := today.AddDate(0, 0, m.ahead+1); from.Before(until); from = from.AddDate(0, 0, 1) {
+		name := from.Format(partitionNameLayout)
+
+		err = m.createPartition(ctx, name, from, from.AddDate(0, 0, 1))
+		if err != nil {
+			return errors.Join(ErrFailedToCreatePartition, fmt.Errorf("partition %s: %w", name, err))
+		}
+
+		m.logger.Info("Created partition", slog.String("partition", name))
+	}
+
+	return nil
+}
+
+// upperBounds returns the upper bounds of the range partitions by name. The upper bound is parsed from the partition
+// bound expression, the default partition has none.
+func (m *PartitionManager) upperBounds(ctx context.Context) (map[string]time.Time, error) {
+	rows, err := m.store.db.QueryContext(ctx, `
+		SELECT c.relname, (REGEXP_MATCH(PG_GET_EXPR(c.relpartbound, c.oid), $$TO \('([^']+)'\)$$))[1]::TIMESTAMPTZ
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'metamorph.transactions'::REGCLASS`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	upperBounds := make(map[string]time.Time)
+	for rows.Next() {
+		var name string
+		var upperBound sql.NullTime
+
+		err = rows.Scan(&name, &upperBound)
+		if err != nil {
+			return nil, err
+		}
+
+		if upperBound.Valid {
+			upperBounds[name] = upperBound.Time
+		}
+	}
+
+	return upperBounds, rows.Err()
+}
+
+func (m *PartitionManager) createPartition(ctx context.Context, name string, from time.Time, to time.Time) error {
+	tx, err := m.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// the partitioned table has no indexes, the partition gets the indexes of the default partition
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE metamorph.%s (LIKE metamorph.transactions_default INCLUDING ALL)`, name))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE metamorph.transactions ATTACH PARTITION metamorph.%s FOR VALUES FROM ('%s') TO ('%s')`,
+		name, from.Format(time.RFC3339), to.Format(time.RFC3339)))
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// dropExpiredPartitions drops the partitions whose upper bound is older than the retention period.
+func (m *PartitionManager) dropExpiredPartitions(ctx context.Context) error {
+	now := m.store.now()
+	deleteBeforeDate := now.Add(-24 * time.Hour * time.Duration(m.retentionDays))
+
+	upperBounds, err := m.upperBounds(ctx)
+	if err != nil {
+		return errors.Join(ErrFailedToDropPartition, err)
+	}
+
+	expired := make(map[string]time.Time)
+	for name, upperBound := range upperBounds {
+		if !upperBound.After(deleteBeforeDate) {
+			expired[name] = upperBound
+		}
+	}
+
+	for name, upperBound := range expired {
+		moved, err := m.dropPartition(ctx, name, upperBound, now, deleteBeforeDate)
+		if err != nil {
+			return errors.Join(ErrFailedToDropPartition, fmt.Errorf("partition %s: %w", name, err))
+		}
+
+		m.logger.Info("Dropped partition", slog.String("partition", name), slog.Int64("moved", moved))
+	}
+
+	if len(expired) == 0 {
+		return nil
+	}
+
+	err = m.store.deleteOrphans(ctx, deleteBeforeDate)
+	if err != nil {
+		return errors.Join(ErrFailedToDropPartition, err)
+	}
+
+	return nil
+}
+
+func (m *PartitionManager) dropPartition(ctx context.Context, name string, upperBound time.Time, now time.Time, deleteBeforeDate time.Time) (int64, error) {
+	tx, err := m.store.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// updating stored_at on the partitioned table moves the records which are kept to the current partition, the
+	// condition on stored_at restricts the scan to the expired partitions
+	res, err := tx.ExecContext(ctx, `
+		UPDATE metamorph.transactions SET stored_at = $1
+		WHERE stored_at < $2 AND tableoid = $3::REGCLASS
+		AND (last_submitted_at > $4 OR (status = $5 AND last_modified > $6))`,
+		now, upperBound, "metamorph."+pq.QuoteIdentifier(name), deleteBeforeDate, metamorph_api.Status_REJECTED, now.Add(-1*m.store.rejectedQuarantine),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	moved, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, "DROP TABLE metamorph."+pq.QuoteIdentifier(name))
+	if err != nil {
+		return 0, err
+	}
+
+	return moved, tx.Commit()
+}
@@ -17,22 +17,43 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/encryption"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/validator"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
 const (
 	postgresDriverName = "postgres"
 	failedRollback     = "failed to rollback: %v"
+
+	// lockHashesQuery locks the hashes of records to be inserted until the end of the transaction. If the transactions
+	// table is partitioned by stored_at, the hash is unique only within each partition. Records are therefore updated
+	// or inserted under the lock, so that concurrent inserts of the same hash do not store it in multiple partitions.
+	// The locks are taken in order, so that bulk inserts do not deadlock.
+	lockHashesQuery = `SELECT pg_advisory_xact_lock(k.key) FROM (
+		SELECT DISTINCT ('x' || ENCODE(SUBSTRING(h FROM 1 FOR 8), 'hex'))::BIT(64)::BIGINT AS key
+		FROM UNNEST($1::BYTEA[]) AS h
+		ORDER BY key
+	) AS k;`
+
+	// insertParentsQuery links the transactions to the transactions whose outputs they spend, the links of transactions
+	// which are submitted again already exist
+	insertParentsQuery = `INSERT INTO metamorph.transaction_parents (hash, parent_hash, stored_at)
+		SELECT UNNEST($1::BYTEA[]), UNNEST($2::BYTEA[]), UNNEST($3::TIMESTAMPTZ[])
+		ON CONFLICT DO NOTHING;`
 )
 
 type PostgreSQL struct {
-	db                *sql.DB
-	hostname          string
-	now               func() time.Time
-	tracingEnabled    bool
-	tracingAttributes []attribute.KeyValue
+	db                 *sql.DB
+	hostname           string
+	now                func() time.Time
+	rejectedQuarantine time.Duration
+	tracingEnabled     bool
+	tracingAttributes  []attribute.KeyValue
+	cipher             *encryption.Cipher
+	partitioning       bool
 }
 
 func WithNow(nowFunc func() time.Time) func(*PostgreSQL) {
@@ -41,6 +62,30 @@ func WithNow(nowFunc func() time.Time) func(*PostgreSQL) {
 	}
 }
 
+// WithRejectedQuarantine sets the period for which rejected transactions are kept by ClearData after they have been
+// rejected, so that they can be resubmitted.
+func WithRejectedQuarantine(d time.Duration) func(*PostgreSQL) {
+	return func(p *PostgreSQL) {
+		p.rejectedQuarantine = d
+	}
+}
+
+// WithCipher encrypts the callback URLs and tokens of the transactions in the database.
+func WithCipher(c *encryption.Cipher) func(*PostgreSQL) {
+	return func(p *PostgreSQL) {
+		p.cipher = c
+	}
+}
+
+// WithPartitioning stores the transactions in a table which is partitioned by the PartitionManager. As the hash is
+// unique only within each partition, records are inserted under an advisory lock on their hash instead of relying on the
+// primary key. ClearData does not delete transactions, as the expired partitions are dropped by the PartitionManager.
+func WithPartitioning() func(*PostgreSQL) {
+	return func(p *PostgreSQL) {
+		p.partitioning = true
+	}
+}
+
 func WithTracing(attr []attribute.KeyValue) func(*PostgreSQL) {
 	return func(p *PostgreSQL) {
 		p.tracingEnabled = true
@@ -136,6 +181,19 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 		,retries
 		,status_history
 		,last_modified
+		,consolidation
+		,received_at
+		,validated_at
+		,normalized_hash
+		,mined_hash
+		,tenant
+		,broadcast_at
+		,announce_to
+		,node_submitted_at
+		,node_accepted
+		,node_reject_reason
+		,expires_at
+		,lock_time
 	 	FROM metamorph.transactions WHERE hash = $1 LIMIT 1;`
 
 	var storedAt time.Time
@@ -153,6 +211,19 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 	var retries sql.NullInt32
 	var statusHistory []byte
 	var lastModified sql.NullTime
+	var consolidation bool
+	var receivedAt sql.NullTime
+	var validatedAt sql.NullTime
+	var normalizedHash []byte
+	var minedHash []byte
+	var tenant sql.NullString
+	var broadcastAt sql.NullTime
+	var announceTo sql.NullString
+	var nodeSubmittedAt sql.NullTime
+	var nodeAccepted sql.NullBool
+	var nodeRejectReason sql.NullString
+	var expiresAt sql.NullTime
+	var lockTime sql.NullInt64
 
 	err = p.db.QueryRowContext(ctx, q, hash).Scan(
 		&storedAt,
@@ -170,6 +241,19 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 		&retries,
 		&statusHistory,
 		&lastModified,
+		&consolidation,
+		&receivedAt,
+		&validatedAt,
+		&normalizedHash,
+		&minedHash,
+		&tenant,
+		&broadcastAt,
+		&announceTo,
+		&nodeSubmittedAt,
+		&nodeAccepted,
+		&nodeRejectReason,
+		&expiresAt,
+		&lockTime,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -191,8 +275,23 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 		}
 	}
 
+	if len(normalizedHash) > 0 {
+		data.NormalizedHash, err = chainhash.NewHash(normalizedHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(minedHash) > 0 {
+		data.MinedHash, err = chainhash.NewHash(minedHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	data.RawTx = rawTx
 
+	data.AnnounceTo = announceTo.String
 	data.StoredAt = storedAt.UTC()
 
 	data.LastSubmittedAt = lastSubmittedAt.UTC()
@@ -201,6 +300,34 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 		data.LastModified = lastModified.Time.UTC()
 	}
 
+	if receivedAt.Valid {
+		data.ReceivedAt = receivedAt.Time.UTC()
+	}
+
+	if validatedAt.Valid {
+		data.ValidatedAt = validatedAt.Time.UTC()
+	}
+
+	if broadcastAt.Valid {
+		data.BroadcastAt = broadcastAt.Time.UTC()
+	}
+
+	if expiresAt.Valid {
+		data.ExpiresAt = expiresAt.Time.UTC()
+	}
+
+	if lockTime.Valid {
+		data.LockTime = uint32(lockTime.Int64) // #nosec G115
+	}
+
+	if nodeSubmittedAt.Valid {
+		data.NodeSubmission = &store.NodeSubmission{
+			SubmittedAt:  nodeSubmittedAt.Time.UTC(),
+			Accepted:     nodeAccepted.Bool,
+			RejectReason: nodeRejectReason.String,
+		}
+	}
+
 	if status.Valid {
 		data.Status = metamorph_api.Status(status.Int32)
 	}
@@ -214,7 +341,7 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 	}
 
 	if len(callbacksData) > 0 {
-		callbacks, err := readCallbacksFromDB(callbacksData)
+		callbacks, err := p.readCallbacksFromDB(callbacksData)
 		if err != nil {
 			return nil, err
 		}
@@ -241,6 +368,8 @@ func (p *PostgreSQL) Get(ctx context.Context, hash []byte) (data *store.Data, er
 	data.RejectReason = rejectReason.String
 	data.LockedBy = lockedBy
 	data.MerklePath = merklePath.String
+	data.Consolidation = consolidation
+	data.Tenant = tenant.String
 
 	return data, nil
 }
@@ -278,205 +407,830 @@ func (p *PostgreSQL) GetRawTxs(ctx context.Context, hashes [][]byte) ([][]byte,
 	return retRawTxs, nil
 }
 
-func (p *PostgreSQL) GetMany(ctx context.Context, keys [][]byte) (data []*store.Data, err error) {
-	ctx, span := tracing.StartTracing(ctx, "GetMany", p.tracingEnabled, p.tracingAttributes...)
+// ComputeSLAReports computes the SLA reports of the period starting at from for the transactions of each tenant which
+// were stored between from and to. Already computed reports of the period are replaced. It returns the number of
+// computed reports.
+func (p *PostgreSQL) ComputeSLAReports(ctx context.Context, period string, from time.Time, to time.Time) (n int64, err error) {
+	ctx, span := tracing.StartTracing(ctx, "ComputeSLAReports", p.tracingEnabled, p.tracingAttributes...)
 	defer func() {
 		tracing.EndTracing(span, err)
 	}()
 
-	const q = `
-	 SELECT
-	 	stored_at
-		,hash
-		,status
-		,block_height
-		,block_hash
-		,callbacks
-		,full_status_updates
-		,reject_reason
-		,competing_txs
-		,raw_tx
-		,locked_by
-		,merkle_path
-		,retries
-		,status_history
-		,last_modified
-	 FROM metamorph.transactions WHERE hash in (SELECT UNNEST($1::BYTEA[]));`
+	seenStatuses := []metamorph_api.Status{
+		metamorph_api.Status_SEEN_ON_NETWORK,
+		metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED,
+		metamorph_api.Status_MINED_IN_STALE_BLOCK,
+		metamorph_api.Status_MINED,
+	}
+	seen := make([]int32, len(seenStatuses))
+	for i, status := range seenStatuses {
+		seen[i] = int32(status)
+	}
+
+	res, err := p.db.ExecContext(ctx, `INSERT INTO metamorph.sla_reports (period, period_start, tenant, transactions, seen_on_network,
+			p95_time_to_seen_ms, mined, p95_time_to_mined_ms, computed_at)
+		SELECT $1::TEXT, $2::TIMESTAMPTZ, t.tenant, COUNT(1),
+			COUNT(h.seen_at),
+			COALESCE(ROUND(PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY (EXTRACT(EPOCH FROM h.seen_at - t.stored_at) * 1000)::DOUBLE PRECISION)), 0),
+			COUNT(h.mined_at),
+			COALESCE(ROUND(PERCENTILE_CONT(0.95) WITHIN GROUP (ORDER BY (EXTRACT(EPOCH FROM h.mined_at - t.stored_at) * 1000)::DOUBLE PRECISION)), 0),
+			$6::TIMESTAMPTZ
+		FROM metamorph.transactions t
+		CROSS JOIN LATERAL (
+			SELECT MIN((elem->>'timestamp')::TIMESTAMPTZ) FILTER (WHERE (elem->>'status')::INT = ANY($4::INT[])) AS seen_at,
+				MIN((elem->>'timestamp')::TIMESTAMPTZ) FILTER (WHERE (elem->>'status')::INT = $5) AS mined_at
+			FROM jsonb_array_elements(COALESCE(t.status_history, '[]'::JSONB)) AS elem
+		) h
+		WHERE t.tenant IS NOT NULL AND t.stored_at >= $2 AND t.stored_at < $3
+		GROUP BY t.tenant
+		ON CONFLICT (period, period_start, tenant) DO UPDATE SET
+			transactions = EXCLUDED.transactions,
+			seen_on_network = EXCLUDED.seen_on_network,
+			p95_time_to_seen_ms = EXCLUDED.p95_time_to_seen_ms,
+			mined = EXCLUDED.mined,
+			p95_time_to_mined_ms = EXCLUDED.p95_time_to_mined_ms,
+			computed_at = EXCLUDED.computed_at;`,
+		period, from, to, pq.Array(seen), int32(metamorph_api.Status_MINED), p.now())
+	if err != nil {
+		return 0, err
+	}
 
-	rows, err := p.db.QueryContext(ctx, q, pq.Array(keys))
+	return res.RowsAffected()
+}
+
+// GetSLAReports returns the SLA reports of the periods starting between from and to, of all tenants if tenant is
+// empty, ordered by the start of the period and the tenant.
+func (p *PostgreSQL) GetSLAReports(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]store.SLAReport, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT period, period_start, tenant, transactions, seen_on_network, p95_time_to_seen_ms,
+			mined, p95_time_to_mined_ms, computed_at
+		FROM metamorph.sla_reports
+		WHERE period = $1 AND period_start >= $2 AND period_start < $3
+		AND ($4 = '' OR tenant = $4)
+		ORDER BY period_start, tenant;`, period, from, to, tenant)
 	if err != nil {
 		return nil, err
 	}
-
 	defer rows.Close()
-	return getStoreDataFromRows(rows)
+
+	reports := make([]store.SLAReport, 0)
+	for rows.Next() {
+		var report store.SLAReport
+		var timeToSeenMs, timeToMinedMs int64
+
+		err = rows.Scan(&report.Period, &report.PeriodStart, &report.Tenant, &report.Transactions, &report.SeenOnNetwork, &timeToSeenMs,
+			&report.Mined, &timeToMinedMs, &report.ComputedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		report.PeriodStart = report.PeriodStart.UTC()
+		report.ComputedAt = report.ComputedAt.UTC()
+		report.P95TimeToSeen = time.Duration(timeToSeenMs) * time.Millisecond
+		report.P95TimeToMined = time.Duration(timeToMinedMs) * time.Millisecond
+		reports = append(reports, report)
+	}
+
+	return reports, rows.Err()
 }
 
-func (p *PostgreSQL) GetDoubleSpendTxs(ctx context.Context, older time.Time) (data []*store.Data, err error) {
-	ctx, span := tracing.StartTracing(ctx, "GetDoubleSpendTxs", p.tracingEnabled, p.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
+// GetHashes returns the hashes of the stored transactions in ascending order, starting after the given hash. Pages of
+// all stored transactions are read by passing the last hash of the previous page.
+func (p *PostgreSQL) GetHashes(ctx context.Context, after []byte, limit int64) ([][]byte, error) {
+	if after == nil {
+		after = []byte{}
+	}
 
-	const q = `
-	 SELECT
-	 	stored_at
-		,hash
-		,status
-		,block_height
-		,block_hash
-		,callbacks
-		,full_status_updates
-		,reject_reason
-		,competing_txs
-		,raw_tx
-		,locked_by
-		,merkle_path
-		,retries
-		,status_history
-		,last_modified
-	 FROM metamorph.transactions WHERE status=$1 AND last_modified<$2;`
+	rows, err := p.db.QueryContext(ctx, `SELECT hash FROM metamorph.transactions WHERE hash > $1 ORDER BY hash LIMIT $2`, after, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	rows, err := p.db.QueryContext(ctx, q, metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, older)
+	hashes := make([][]byte, 0, limit)
+	for rows.Next() {
+		var hash []byte
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes, rows.Err()
+}
+
+// GetChildHashes returns the hashes of the stored transactions which spend outputs of the transactions with the given
+// hashes.
+func (p *PostgreSQL) GetChildHashes(ctx context.Context, hashes [][]byte) ([][]byte, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT DISTINCT hash FROM metamorph.transaction_parents WHERE parent_hash = ANY($1::BYTEA[])`, pq.Array(hashes))
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
+
+	childHashes := make([][]byte, 0)
+	for rows.Next() {
+		var hash []byte
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+		childHashes = append(childHashes, hash)
+	}
+
+	return childHashes, rows.Err()
+}
+
+// SetPeerAcks records the acknowledgments of the announcements of transactions by peers, each peer may only be given
+// once per transaction. The time at which a peer first requested or received a transaction is kept if it is recorded
+// again.
+func (p *PostgreSQL) SetPeerAcks(ctx context.Context, acks []store.PeerAck) error {
+	if len(acks) == 0 {
+		return nil
+	}
+
+	hashes := make([][]byte, len(acks))
+	peers := make([]string, len(acks))
+	requestedAt := make([]sql.NullTime, len(acks))
+	sentAt := make([]sql.NullTime, len(acks))
+	for i, ack := range acks {
+		hashes[i] = ack.Hash[:]
+		peers[i] = ack.Peer
+		requestedAt[i] = nullTime(ack.RequestedAt)
+		sentAt[i] = nullTime(ack.SentAt)
+	}
+
+	q := `INSERT INTO metamorph.transaction_peers (hash, peer, requested_at, sent_at, created_at)
+		SELECT UNNEST($1::BYTEA[]), UNNEST($2::TEXT[]), UNNEST($3::TIMESTAMPTZ[]), UNNEST($4::TIMESTAMPTZ[]), $5
+		ON CONFLICT (hash, peer) DO UPDATE SET
+			requested_at = COALESCE(metamorph.transaction_peers.requested_at, EXCLUDED.requested_at)
+			,sent_at = COALESCE(metamorph.transaction_peers.sent_at, EXCLUDED.sent_at);`
+
+	_, err := p.db.ExecContext(ctx, q, pq.Array(hashes), pq.Array(peers), pq.Array(requestedAt), pq.Array(sentAt), p.now())
 
+	return err
+}
+
+// GetPeerAcks returns the recorded acknowledgments by peers of the transactions with the given hashes ordered by the
+// time at which they were first recorded.
+func (p *PostgreSQL) GetPeerAcks(ctx context.Context, hashes [][]byte) ([]store.PeerAck, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT hash, peer, requested_at, sent_at FROM metamorph.transaction_peers
+		WHERE hash = ANY($1::BYTEA[])
+		ORDER BY created_at, peer`, pq.Array(hashes))
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
-	return getStoreDataFromRows(rows)
+
+	acks := make([]store.PeerAck, 0)
+	for rows.Next() {
+		var hash []byte
+		var ack store.PeerAck
+		var requestedAt sql.NullTime
+		var sentAt sql.NullTime
+
+		err = rows.Scan(&hash, &ack.Peer, &requestedAt, &sentAt)
+		if err != nil {
+			return nil, err
+		}
+
+		copy(ack.Hash[:], hash)
+		if requestedAt.Valid {
+			ack.RequestedAt = requestedAt.Time.UTC()
+		}
+		if sentAt.Valid {
+			ack.SentAt = sentAt.Time.UTC()
+		}
+		acks = append(acks, ack)
+	}
+
+	return acks, rows.Err()
 }
 
-func (p *PostgreSQL) IncrementRetries(ctx context.Context, hash *chainhash.Hash) error {
-	q := `UPDATE metamorph.transactions SET retries = retries+1 WHERE hash = $1;`
+// AddAnnotation attaches the annotation to the stored transaction and returns it with the time at which it was added.
+// Returns store.ErrNotFound if the transaction is not stored.
+func (p *PostgreSQL) AddAnnotation(ctx context.Context, annotation store.Annotation) (store.Annotation, error) {
+	labels := annotation.Labels
+	if labels == nil {
+		labels = []string{}
+	}
 
-	_, err := p.db.ExecContext(ctx, q, hash[:])
+	q := `INSERT INTO metamorph.transaction_annotations (hash, note, labels, author, created_at)
+		SELECT $1, $2, $3, $4, $5
+		WHERE EXISTS (SELECT 1 FROM metamorph.transactions t WHERE t.hash = $1)
+		RETURNING created_at;`
+
+	err := p.db.QueryRowContext(ctx, q, annotation.Hash[:], annotation.Note, pq.Array(labels), annotation.Author, p.now()).Scan(&annotation.CreatedAt)
 	if err != nil {
-		return err
+		if errors.Is(err, sql.ErrNoRows) {
+			return store.Annotation{}, store.ErrNotFound
+		}
+		return store.Annotation{}, err
 	}
 
-	return nil
+	annotation.Labels = labels
+	annotation.CreatedAt = annotation.CreatedAt.UTC()
+
+	return annotation, nil
 }
 
-// Set stores a single record in the transactions table.
-func (p *PostgreSQL) Set(ctx context.Context, value *store.Data) (err error) {
-	ctx, span := tracing.StartTracing(ctx, "Set", p.tracingEnabled, p.tracingAttributes...)
+// GetAnnotations returns the annotations of the transactions with the given hashes in the order in which they were
+// added.
+func (p *PostgreSQL) GetAnnotations(ctx context.Context, hashes [][]byte) ([]store.Annotation, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT hash, note, labels, author, created_at FROM metamorph.transaction_annotations
+		WHERE hash = ANY($1::BYTEA[])
+		ORDER BY created_at, id`, pq.Array(hashes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	annotations := make([]store.Annotation, 0)
+	for rows.Next() {
+		var hash []byte
+		var annotation store.Annotation
+
+		err = rows.Scan(&hash, &annotation.Note, pq.Array(&annotation.Labels), &annotation.Author, &annotation.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		copy(annotation.Hash[:], hash)
+		annotation.CreatedAt = annotation.CreatedAt.UTC()
+		annotations = append(annotations, annotation)
+	}
+
+	return annotations, rows.Err()
+}
+
+// EraseClientData removes the callbacks of the transactions of the tenant or with the hashes of the erasure, and the
+// options of their reverifications, and records the erasure in the same database transaction.
+func (p *PostgreSQL) EraseClientData(ctx context.Context, erasure store.Erasure) (result store.Erasure, err error) {
+	ctx, span := tracing.StartTracing(ctx, "EraseClientData", p.tracingEnabled, p.tracingAttributes...)
 	defer func() {
 		tracing.EndTracing(span, err)
 	}()
 
-	q := `INSERT INTO metamorph.transactions (
-		 stored_at
-		,hash
-		,status
-		,block_height
-		,block_hash
-		,callbacks
-		,full_status_updates
-		,reject_reason
-		,competing_txs
-		,raw_tx
-		,locked_by
-		,last_submitted_at
-		,status_history
-		,last_modified
-	) VALUES (
-		 $1
-		,$2
-		,$3
-		,$4
-		,$5
-		,$6
-		,$7
-		,$8
-		,$9
-		,$10
-		,$11
-		,$12
-		,$13
-		,$14
-	) ON CONFLICT (hash) DO UPDATE SET last_submitted_at=$12, callbacks=$6;`
-
-	var txHash []byte
-	var blockHash []byte
-
-	if value.Hash != nil {
-		txHash = value.Hash.CloneBytes()
+	hashes := erasure.Hashes
+	if hashes == nil {
+		hashes = [][]byte{}
 	}
 
-	if value.BlockHash != nil {
-		blockHash = value.BlockHash.CloneBytes()
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return store.Erasure{}, err
 	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
 
-	// If the storedAt time is zero, set it to now on insert
-	if value.StoredAt.IsZero() {
-		value.StoredAt = p.now()
+	res, err := tx.ExecContext(ctx, `UPDATE metamorph.transactions SET callbacks = NULL
+		WHERE callbacks IS NOT NULL
+		AND ($1 = '' OR tenant = $1)
+		AND (CARDINALITY($2::BYTEA[]) = 0 OR hash = ANY($2::BYTEA[]));`, erasure.Tenant, pq.Array(hashes))
+	if err != nil {
+		return store.Erasure{}, err
 	}
 
-	callbacksData, err := json.Marshal(value.Callbacks)
+	erasure.Transactions, err = res.RowsAffected()
 	if err != nil {
-		return err
+		return store.Erasure{}, err
 	}
 
-	if value.StatusHistory == nil {
-		value.StatusHistory = make([]*store.StatusWithTimestamp, 0)
+	// the options of the transactions kept for re-verification contain their callbacks as well
+	_, err = tx.ExecContext(ctx, `UPDATE metamorph.reverifications SET options = NULL
+		WHERE options IS NOT NULL
+		AND ($1 = '' OR tenant = $1)
+		AND (CARDINALITY($2::BYTEA[]) = 0 OR hashes && $2::BYTEA[]);`, erasure.Tenant, pq.Array(hashes))
+	if err != nil {
+		return store.Erasure{}, err
 	}
-	statusHistoryData, err := json.Marshal(value.StatusHistory)
+
+	erasure.Hashes = hashes
+	erasure.ErasedAt = p.now()
+	err = tx.QueryRowContext(ctx, `INSERT INTO metamorph.erasures (tenant, hashes, erased_by, reason, erased_at, transactions)
+		VALUES (NULLIF($1, ''), $2, $3, $4, $5, $6)
+		RETURNING id;`, erasure.Tenant, pq.Array(hashes), erasure.ErasedBy, erasure.Reason, erasure.ErasedAt, erasure.Transactions).Scan(&erasure.ID)
 	if err != nil {
-		return err
+		return store.Erasure{}, err
 	}
 
-	_, err = p.db.ExecContext(ctx, q,
-		value.StoredAt,
-		txHash,
-		value.Status,
-		value.BlockHeight,
-		blockHash,
-		callbacksData,
-		value.FullStatusUpdates,
-		value.RejectReason,
-		strings.Join(value.CompetingTxs, ","),
-		value.RawTx,
-		p.hostname,
-		value.LastSubmittedAt,
-		statusHistoryData,
-		p.now(),
-	)
+	err = tx.Commit()
 	if err != nil {
-		return err
+		return store.Erasure{}, err
 	}
 
-	return nil
+	return erasure, nil
 }
 
-// SetBulk bulk inserts records into the transactions table. If a record with the same hash already exists the field last_submitted_at will be overwritten with NOW()
-func (p *PostgreSQL) SetBulk(ctx context.Context, data []*store.Data) error {
-	storedAt := make([]time.Time, len(data))
-	hashes := make([][]byte, len(data))
-	statuses := make([]int, len(data))
-	callbacks := make([]string, len(data))
-	statusHistory := make([]string, len(data))
-	fullStatusUpdate := make([]bool, len(data))
-	rawTxs := make([][]byte, len(data))
-	lockedBy := make([]string, len(data))
-	lastSubmittedAt := make([]time.Time, len(data))
+// GetErasures returns the latest erasures of client data first.
+func (p *PostgreSQL) GetErasures(ctx context.Context, limit int64) ([]store.Erasure, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT id, COALESCE(tenant, ''), hashes, erased_by, reason, erased_at, transactions
+		FROM metamorph.erasures
+		ORDER BY erased_at DESC, id DESC
+		LIMIT $1;`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-	for i, txData := range data {
-		storedAt[i] = txData.StoredAt
-		hashes[i] = txData.Hash[:]
-		statuses[i] = int(txData.Status)
-		fullStatusUpdate[i] = txData.FullStatusUpdates
-		rawTxs[i] = txData.RawTx
-		lockedBy[i] = p.hostname
-		lastSubmittedAt[i] = txData.LastSubmittedAt
+	erasures := make([]store.Erasure, 0)
+	for rows.Next() {
+		var erasure store.Erasure
 
-		callbacksData, err := json.Marshal(txData.Callbacks)
+		err = rows.Scan(&erasure.ID, &erasure.Tenant, pq.Array(&erasure.Hashes), &erasure.ErasedBy, &erasure.Reason, &erasure.ErasedAt, &erasure.Transactions)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		callbacks[i] = string(callbacksData)
 
-		if txData.StatusHistory == nil {
-			txData.StatusHistory = make([]*store.StatusWithTimestamp, 0)
+		erasure.ErasedAt = erasure.ErasedAt.UTC()
+		erasures = append(erasures, erasure)
+	}
+
+	return erasures, rows.Err()
+}
+
+// AddReverification adds the reverification unless one of its transactions is already re-verified, in which case the
+// existing reverification is returned. It returns ErrReverificationsFull if the number of the transactions of all
+// reverifications would exceed maxTransactions.
+func (p *PostgreSQL) AddReverification(ctx context.Context, reverification store.Reverification, maxTransactions int64) (result store.Reverification, err error) {
+	ctx, span := tracing.StartTracing(ctx, "AddReverification", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return store.Reverification{}, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	// API instances add reverifications concurrently, the lock keeps the check of maxTransactions consistent
+	_, err = tx.ExecContext(ctx, `LOCK TABLE metamorph.reverifications IN SHARE ROW EXCLUSIVE MODE;`)
+	if err != nil {
+		return store.Reverification{}, err
+	}
+
+	row := tx.QueryRowContext(ctx, `SELECT id, mode, beef, hashes, COALESCE(options, ''), COALESCE(tenant, ''), added_at FROM metamorph.reverifications
+		WHERE hashes && $1::BYTEA[]
+		ORDER BY id
+		LIMIT 1;`, pq.Array(reverification.Hashes))
+	err = row.Scan(&result.ID, &result.Mode, &result.Beef, pq.Array(&result.Hashes), &result.Options, &result.Tenant, &result.AddedAt)
+	if err == nil {
+		return p.decryptReverification(result)
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return store.Reverification{}, err
+	}
+
+	var unverified int64
+	err = tx.QueryRowContext(ctx, `SELECT COALESCE(SUM(CARDINALITY(hashes)), 0) FROM metamorph.reverifications;`).Scan(&unverified)
+	if err != nil {
+		return store.Reverification{}, err
+	}
+
+	if unverified+int64(len(reverification.Hashes)) > maxTransactions {
+		return store.Reverification{}, store.ErrReverificationsFull
+	}
+
+	// the options contain the callback URLs and tokens
+	options, err := p.cipher.Encrypt(string(reverification.Options))
+	if err != nil {
+		return store.Reverification{}, err
+	}
+
+	err = tx.QueryRowContext(ctx, `INSERT INTO metamorph.reverifications (mode, beef, hashes, options, tenant, added_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''::BYTEA), NULLIF($5, ''), $6)
+		RETURNING id;`, reverification.Mode, reverification.Beef, pq.Array(reverification.Hashes), []byte(options), reverification.Tenant, reverification.AddedAt).Scan(&reverification.ID)
+	if err != nil {
+		return store.Reverification{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return store.Reverification{}, err
+	}
+
+	return reverification, nil
+}
+
+// GetReverifications returns the oldest reverifications which are not locked at now and locks them until lockedUntil,
+// so that they are not returned again before.
+func (p *PostgreSQL) GetReverifications(ctx context.Context, now time.Time, lockedUntil time.Time, limit int64) (reverifications []store.Reverification, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetReverifications", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	rows, err := p.db.QueryContext(ctx, `UPDATE metamorph.reverifications r
+		SET locked_until = $2
+		WHERE r.id IN (
+		   SELECT r2.id
+		   FROM metamorph.reverifications r2
+		   WHERE r2.locked_until IS NULL OR r2.locked_until <= $1
+		   ORDER BY r2.added_at, r2.id
+		   LIMIT $3
+		   FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, mode, beef, hashes, COALESCE(options, ''), COALESCE(tenant, ''), added_at;`, now, lockedUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reverifications = make([]store.Reverification, 0)
+	for rows.Next() {
+		var reverification store.Reverification
+
+		err = rows.Scan(&reverification.ID, &reverification.Mode, &reverification.Beef, pq.Array(&reverification.Hashes), &reverification.Options, &reverification.Tenant, &reverification.AddedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		reverification, err = p.decryptReverification(reverification)
+		if err != nil {
+			return nil, err
+		}
+		reverifications = append(reverifications, reverification)
+	}
+
+	return reverifications, rows.Err()
+}
+
+func (p *PostgreSQL) decryptReverification(reverification store.Reverification) (store.Reverification, error) {
+	options, err := p.cipher.Decrypt(string(reverification.Options))
+	if err != nil {
+		return store.Reverification{}, err
+	}
+
+	reverification.Options = []byte(options)
+	reverification.AddedAt = reverification.AddedAt.UTC()
+
+	return reverification, nil
+}
+
+// DelReverification deletes the reverification once its transactions are verified or given up on.
+func (p *PostgreSQL) DelReverification(ctx context.Context, id int64) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM metamorph.reverifications WHERE id = $1;`, id)
+	return err
+}
+
+// GetUnverified returns those of the hashes whose transactions are re-verified.
+func (p *PostgreSQL) GetUnverified(ctx context.Context, hashes [][]byte) ([][]byte, error) {
+	rows, err := p.db.QueryContext(ctx, `SELECT DISTINCT h.hash
+		FROM metamorph.reverifications r, UNNEST(r.hashes) AS h(hash)
+		WHERE r.hashes && $1::BYTEA[] AND h.hash = ANY($1::BYTEA[]);`, pq.Array(hashes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	unverified := make([][]byte, 0)
+	for rows.Next() {
+		var hash []byte
+
+		err = rows.Scan(&hash)
+		if err != nil {
+			return nil, err
+		}
+
+		unverified = append(unverified, hash)
+	}
+
+	return unverified, rows.Err()
+}
+
+// CountUnverified returns the number of the transactions of all reverifications.
+func (p *PostgreSQL) CountUnverified(ctx context.Context) (count int64, err error) {
+	err = p.db.QueryRowContext(ctx, `SELECT COALESCE(SUM(CARDINALITY(hashes)), 0) FROM metamorph.reverifications;`).Scan(&count)
+	return count, err
+}
+
+func (p *PostgreSQL) GetMany(ctx context.Context, keys [][]byte) (data []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetMany", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	const q = `
+	 SELECT
+	 	stored_at
+		,hash
+		,status
+		,block_height
+		,block_hash
+		,callbacks
+		,full_status_updates
+		,reject_reason
+		,competing_txs
+		,raw_tx
+		,locked_by
+		,merkle_path
+		,retries
+		,status_history
+		,last_modified
+		,tenant
+		,announce_to
+	 FROM metamorph.transactions WHERE hash in (SELECT UNNEST($1::BYTEA[]));`
+
+	rows, err := p.db.QueryContext(ctx, q, pq.Array(keys))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	return p.getStoreDataFromRows(rows)
+}
+
+func (p *PostgreSQL) GetDoubleSpendTxs(ctx context.Context, older time.Time) (data []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetDoubleSpendTxs", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	const q = `
+	 SELECT
+	 	stored_at
+		,hash
+		,status
+		,block_height
+		,block_hash
+		,callbacks
+		,full_status_updates
+		,reject_reason
+		,competing_txs
+		,raw_tx
+		,locked_by
+		,merkle_path
+		,retries
+		,status_history
+		,last_modified
+		,tenant
+		,announce_to
+	 FROM metamorph.transactions WHERE status=$1 AND last_modified<$2;`
+
+	rows, err := p.db.QueryContext(ctx, q, metamorph_api.Status_DOUBLE_SPEND_ATTEMPTED, older)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	return p.getStoreDataFromRows(rows)
+}
+
+func (p *PostgreSQL) IncrementRetries(ctx context.Context, hash *chainhash.Hash) error {
+	q := `UPDATE metamorph.transactions SET retries = retries+1 WHERE hash = $1;`
+
+	_, err := p.db.ExecContext(ctx, q, hash[:])
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Set stores a single record in the transactions table.
+func (p *PostgreSQL) Set(ctx context.Context, value *store.Data) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "Set", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `WITH updated AS (
+		UPDATE metamorph.transactions SET last_submitted_at = $12::TIMESTAMPTZ, callbacks = $6::JSONB
+		WHERE hash = $2::BYTEA
+		RETURNING hash
+	)
+	INSERT INTO metamorph.transactions (
+		 stored_at
+		,hash
+		,status
+		,block_height
+		,block_hash
+		,callbacks
+		,full_status_updates
+		,reject_reason
+		,competing_txs
+		,raw_tx
+		,locked_by
+		,last_submitted_at
+		,status_history
+		,last_modified
+		,consolidation
+		,received_at
+		,validated_at
+		,normalized_hash
+		,tenant
+		,broadcast_at
+		,announce_to
+		,expires_at
+		,lock_time
+	) SELECT
+		 $1::TIMESTAMPTZ
+		,$2::BYTEA
+		,$3::INTEGER
+		,$4::BIGINT
+		,$5::BYTEA
+		,$6::JSONB
+		,$7::BOOLEAN
+		,$8::TEXT
+		,$9::TEXT
+		,$10::BYTEA
+		,$11::TEXT
+		,$12::TIMESTAMPTZ
+		,$13::JSONB
+		,$14::TIMESTAMPTZ
+		,$15::BOOLEAN
+		,$16::TIMESTAMPTZ
+		,$17::TIMESTAMPTZ
+		,$18::BYTEA
+		,NULLIF($19::TEXT, '')
+		,$20::TIMESTAMPTZ
+		,NULLIF($21::TEXT, '')
+		,$22::TIMESTAMPTZ
+		,$23::BIGINT
+	WHERE NOT EXISTS (SELECT 1 FROM updated)`
+
+	if !p.partitioning {
+		// the primary key on the hash resolves concurrent inserts of the same hash
+		q += ` ON CONFLICT (hash) DO UPDATE SET last_submitted_at = $12::TIMESTAMPTZ, callbacks = $6::JSONB`
+	}
+
+	var txHash []byte
+	var blockHash []byte
+	var normalizedHash []byte
+
+	if value.Hash != nil {
+		txHash = value.Hash.CloneBytes()
+	}
+
+	if value.BlockHash != nil {
+		blockHash = value.BlockHash.CloneBytes()
+	}
+
+	if value.NormalizedHash != nil {
+		normalizedHash = value.NormalizedHash.CloneBytes()
+	}
+
+	// If the storedAt time is zero, set it to now on insert
+	if value.StoredAt.IsZero() {
+		value.StoredAt = p.now()
+	}
+
+	callbacksData, err := p.marshalCallbacks(value.Callbacks)
+	if err != nil {
+		return err
+	}
+
+	if value.StatusHistory == nil {
+		value.StatusHistory = make([]*store.StatusWithTimestamp, 0)
+	}
+	statusHistoryData, err := json.Marshal(value.StatusHistory)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = p.lockHashes(ctx, tx, [][]byte{txHash})
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, q,
+		value.StoredAt,
+		txHash,
+		value.Status,
+		value.BlockHeight,
+		blockHash,
+		callbacksData,
+		value.FullStatusUpdates,
+		value.RejectReason,
+		strings.Join(value.CompetingTxs, ","),
+		value.RawTx,
+		p.hostname,
+		value.LastSubmittedAt,
+		statusHistoryData,
+		p.now(),
+		value.Consolidation,
+		nullTime(value.ReceivedAt),
+		nullTime(value.ValidatedAt),
+		normalizedHash,
+		value.Tenant,
+		nullTime(value.BroadcastAt),
+		value.AnnounceTo,
+		nullTime(value.ExpiresAt),
+		nullLockTime(value.LockTime),
+	)
+	if err != nil {
+		return err
+	}
+
+	parents := parentHashes(value.RawTx)
+	if len(parents) > 0 {
+		childHashes := make([][]byte, len(parents))
+		parentsStoredAt := make([]time.Time, len(parents))
+		for i := range parents {
+			childHashes[i] = txHash
+			parentsStoredAt[i] = value.StoredAt
+		}
+
+		_, err = tx.ExecContext(ctx, insertParentsQuery, pq.Array(childHashes), pq.Array(parents), pq.Array(parentsStoredAt))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// lockHashes locks the hashes of the records to be inserted in the transaction if the transactions table is
+// partitioned.
+func (p *PostgreSQL) lockHashes(ctx context.Context, tx *sql.Tx, hashes [][]byte) error {
+	if !p.partitioning {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, lockHashesQuery, pq.Array(hashes))
+	return err
+}
+
+// SetBulk bulk inserts records into the transactions table. If a record with the same hash already exists the field last_submitted_at will be overwritten with NOW()
+func (p *PostgreSQL) SetBulk(ctx context.Context, data []*store.Data) error {
+	now := p.now()
+
+	storedAt := make([]time.Time, len(data))
+	hashes := make([][]byte, len(data))
+	statuses := make([]int, len(data))
+	callbacks := make([]string, len(data))
+	statusHistory := make([]string, len(data))
+	fullStatusUpdate := make([]bool, len(data))
+	rawTxs := make([][]byte, len(data))
+	lockedBy := make([]string, len(data))
+	lastSubmittedAt := make([]time.Time, len(data))
+	consolidation := make([]bool, len(data))
+	receivedAt := make([]sql.NullTime, len(data))
+	validatedAt := make([]sql.NullTime, len(data))
+	normalizedHashes := make([][]byte, len(data))
+	tenants := make([]*string, len(data))
+	broadcastAt := make([]sql.NullTime, len(data))
+	announceTo := make([]string, len(data))
+	expiresAt := make([]sql.NullTime, len(data))
+	lockTimes := make([]sql.NullInt64, len(data))
+
+	// the parents of the transactions are inserted as flat arrays of edges
+	var childHashes, parents [][]byte
+	var parentsStoredAt []time.Time
+
+	for i, txData := range data {
+		// If the storedAt time is zero, set it to now on insert, so that the record is stored in the current partition
+		if txData.StoredAt.IsZero() {
+			txData.StoredAt = now
+		}
+
+		storedAt[i] = txData.StoredAt
+		hashes[i] = txData.Hash[:]
+		statuses[i] = int(txData.Status)
+		fullStatusUpdate[i] = txData.FullStatusUpdates
+		rawTxs[i] = txData.RawTx
+		lockedBy[i] = p.hostname
+		lastSubmittedAt[i] = txData.LastSubmittedAt
+		consolidation[i] = txData.Consolidation
+		receivedAt[i] = nullTime(txData.ReceivedAt)
+		validatedAt[i] = nullTime(txData.ValidatedAt)
+		broadcastAt[i] = nullTime(txData.BroadcastAt)
+		announceTo[i] = txData.AnnounceTo
+		expiresAt[i] = nullTime(txData.ExpiresAt)
+		lockTimes[i] = nullLockTime(txData.LockTime)
+		if txData.NormalizedHash != nil {
+			normalizedHashes[i] = txData.NormalizedHash[:]
+		}
+		if txData.Tenant != "" {
+			tenants[i] = &txData.Tenant
+		}
+
+		for _, parent := range parentHashes(txData.RawTx) {
+			childHashes = append(childHashes, txData.Hash[:])
+			parents = append(parents, parent)
+			parentsStoredAt = append(parentsStoredAt, txData.StoredAt)
+		}
+
+		callbacksData, err := p.marshalCallbacks(txData.Callbacks)
+		if err != nil {
+			return err
+		}
+		callbacks[i] = string(callbacksData)
+
+		if txData.StatusHistory == nil {
+			txData.StatusHistory = make([]*store.StatusWithTimestamp, 0)
 		}
 		statusHistoryData, err := json.Marshal(txData.StatusHistory)
 		if err != nil {
@@ -485,7 +1239,33 @@ func (p *PostgreSQL) SetBulk(ctx context.Context, data []*store.Data) error {
 		statusHistory[i] = string(statusHistoryData)
 	}
 
-	q := `INSERT INTO metamorph.transactions (
+	q := `WITH data AS (
+			SELECT
+				UNNEST($1::TIMESTAMPTZ[]) AS stored_at,
+				UNNEST($2::BYTEA[]) AS hash,
+				UNNEST($3::INT[]) AS status,
+				UNNEST($4::TEXT[])::JSONB AS callbacks,
+				UNNEST($5::BOOL[]) AS full_status_updates,
+				UNNEST($6::BYTEA[]) AS raw_tx,
+				UNNEST($7::TEXT[]) AS locked_by,
+				UNNEST($8::TIMESTAMPTZ[]) AS last_submitted_at,
+				UNNEST($9::TEXT[])::JSONB AS status_history,
+				UNNEST($11::BOOL[]) AS consolidation,
+				UNNEST($12::TIMESTAMPTZ[]) AS received_at,
+				UNNEST($13::TIMESTAMPTZ[]) AS validated_at,
+				UNNEST($14::BYTEA[]) AS normalized_hash,
+				UNNEST($15::TEXT[]) AS tenant,
+				UNNEST($16::TIMESTAMPTZ[]) AS broadcast_at,
+				UNNEST($17::TEXT[]) AS announce_to,
+				UNNEST($18::TIMESTAMPTZ[]) AS expires_at,
+				UNNEST($19::BIGINT[]) AS lock_time
+		), updated AS (
+			UPDATE metamorph.transactions t SET last_submitted_at = $10::TIMESTAMPTZ, callbacks = d.callbacks
+			FROM data d
+			WHERE t.hash = d.hash
+			RETURNING t.hash
+		)
+		INSERT INTO metamorph.transactions (
 		 stored_at
 		,hash
 		,status
@@ -496,22 +1276,59 @@ func (p *PostgreSQL) SetBulk(ctx context.Context, data []*store.Data) error {
 		,last_submitted_at
 		,status_history
 		,last_modified
+		,consolidation
+		,received_at
+		,validated_at
+		,normalized_hash
+		,tenant
+		,broadcast_at
+		,announce_to
+		,expires_at
+		,lock_time
 		)
 		SELECT
-			UNNEST($1::TIMESTAMPTZ[]),
-			UNNEST($2::BYTEA[]),
-			UNNEST($3::INT[]),
-			UNNEST($4::TEXT[])::JSONB,
-			UNNEST($5::BOOL[]),
-			UNNEST($6::BYTEA[]),
-			UNNEST($7::TEXT[]),
-			UNNEST($8::TIMESTAMPTZ[]),
-			UNNEST($9::TEXT[])::JSONB,
-			$10
-		ON CONFLICT (hash) DO UPDATE SET last_submitted_at = $10, callbacks=EXCLUDED.callbacks;
-		`
+			d.stored_at,
+			d.hash,
+			d.status,
+			d.callbacks,
+			d.full_status_updates,
+			d.raw_tx,
+			d.locked_by,
+			d.last_submitted_at,
+			d.status_history,
+			$10::TIMESTAMPTZ,
+			d.consolidation,
+			d.received_at,
+			d.validated_at,
+			-- hashes which are not set are passed as empty byte arrays
+			NULLIF(d.normalized_hash, ''::BYTEA),
+			d.tenant,
+			d.broadcast_at,
+			NULLIF(d.announce_to, ''),
+			d.expires_at,
+			d.lock_time
+		FROM data d
+		WHERE NOT EXISTS (SELECT 1 FROM updated u WHERE u.hash = d.hash)`
+
+	if !p.partitioning {
+		// the primary key on the hash resolves concurrent inserts of the same hash
+		q += ` ON CONFLICT (hash) DO UPDATE SET last_submitted_at = $10::TIMESTAMPTZ, callbacks = EXCLUDED.callbacks`
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	err = p.lockHashes(ctx, tx, hashes)
+	if err != nil {
+		return err
+	}
 
-	_, err := p.db.ExecContext(ctx, q,
+	_, err = tx.ExecContext(ctx, q,
 		pq.Array(storedAt),
 		pq.Array(hashes),
 		pq.Array(statuses),
@@ -521,13 +1338,29 @@ func (p *PostgreSQL) SetBulk(ctx context.Context, data []*store.Data) error {
 		pq.Array(lockedBy),
 		pq.Array(lastSubmittedAt),
 		pq.Array(statusHistory),
-		p.now(),
+		now,
+		pq.Array(consolidation),
+		pq.Array(receivedAt),
+		pq.Array(validatedAt),
+		pq.Array(normalizedHashes),
+		pq.Array(tenants),
+		pq.Array(broadcastAt),
+		pq.Array(announceTo),
+		pq.Array(expiresAt),
+		pq.Array(lockTimes),
 	)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if len(parents) > 0 {
+		_, err = tx.ExecContext(ctx, insertParentsQuery, pq.Array(childHashes), pq.Array(parents), pq.Array(parentsStoredAt))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
 }
 
 func (p *PostgreSQL) SetLocked(ctx context.Context, since time.Time, limit int64) error {
@@ -537,7 +1370,7 @@ func (p *PostgreSQL) SetLocked(ctx context.Context, since time.Time, limit int64
 		WHERE t.hash IN (
 		   SELECT t2.hash
 		   FROM metamorph.transactions t2
-		   WHERE t2.locked_by = 'NONE' AND t2.status <= $3 AND last_submitted_at > $4
+		   WHERE t2.locked_by = 'NONE' AND t2.status <= $3 AND (last_submitted_at > $4 OR broadcast_at IS NOT NULL OR lock_time IS NOT NULL)
 		   ORDER BY hash
 		   LIMIT $2
 		   FOR UPDATE SKIP LOCKED
@@ -574,10 +1407,14 @@ func (p *PostgreSQL) GetUnseen(ctx context.Context, since time.Time, limit int64
 		,retries
 		,status_history
 		,last_modified
+		,tenant
+		,announce_to
 		FROM metamorph.transactions
 		WHERE locked_by = $5
 		AND status < $1
 		AND last_submitted_at > $2
+		AND broadcast_at IS NULL
+		AND lock_time IS NULL
 		ORDER BY last_submitted_at DESC
 		LIMIT $3 OFFSET $4;`
 
@@ -587,7 +1424,7 @@ func (p *PostgreSQL) GetUnseen(ctx context.Context, since time.Time, limit int64
 	}
 	defer rows.Close()
 
-	return getStoreDataFromRows(rows)
+	return p.getStoreDataFromRows(rows)
 }
 
 // GetSeenPending returns all transactions that are pending in SEEN_ON_NETWORK status for longer than `pendingSince`
@@ -616,6 +1453,8 @@ func (p *PostgreSQL) GetSeenPending(ctx context.Context, lastSubmittedSince time
 		t.retries,
 		t.status_history,
 		t.last_modified,
+		t.tenant,
+		t.announce_to,
 		t.last_submitted_at,
 	 	t.requested_at,
 	 	t.confirmed_at
@@ -641,7 +1480,9 @@ func (p *PostgreSQL) GetSeenPending(ctx context.Context, lastSubmittedSince time
 		seen_txs.merkle_path,
 		seen_txs.retries,
 		seen_txs.status_history,
-		seen_txs.last_modified
+		seen_txs.last_modified,
+		seen_txs.tenant,
+		seen_txs.announce_to
 	FROM seen_txs
 	WHERE seen_txs.last_submitted_at > $2
 -- 	AND $3 - seen_txs.seen_at > $4 * INTERVAL '1 SEC'
@@ -668,7 +1509,7 @@ func (p *PostgreSQL) GetSeenPending(ctx context.Context, lastSubmittedSince time
 	}
 	defer rows.Close()
 
-	return getStoreDataFromRows(rows)
+	return p.getStoreDataFromRows(rows)
 }
 
 func (p *PostgreSQL) GetSeen(ctx context.Context, fromDuration time.Duration, toDuration time.Duration, limit int64, offset int64) (res []*store.Data, err error) {
@@ -693,6 +1534,8 @@ func (p *PostgreSQL) GetSeen(ctx context.Context, fromDuration time.Duration, to
 			,retries
 			,status_history
 			,last_modified
+			,tenant
+			,announce_to
 	FROM metamorph.transactions
 	WHERE locked_by = $6
 	AND status = $1
@@ -711,7 +1554,7 @@ func (p *PostgreSQL) GetSeen(ctx context.Context, fromDuration time.Duration, to
 
 	defer rows.Close()
 
-	res, err = getStoreDataFromRows(rows)
+	res, err = p.getStoreDataFromRows(rows)
 	if err != nil {
 		return nil, err
 	}
@@ -719,6 +1562,148 @@ func (p *PostgreSQL) GetSeen(ctx context.Context, fromDuration time.Duration, to
 	return res, nil
 }
 
+// GetStoredBetween returns the transactions stored in the time range from (inclusive) to (exclusive) ordered by the time
+// they were stored, without their raw transactions.
+func (p *PostgreSQL) GetStoredBetween(ctx context.Context, from time.Time, to time.Time, limit int64, offset int64) (res []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetStoredBetween", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `SELECT
+			stored_at
+			,hash
+			,status
+			,block_height
+			,block_hash
+			,callbacks
+			,full_status_updates
+			,reject_reason
+			,competing_txs
+			,NULL::BYTEA AS raw_tx
+			,locked_by
+			,merkle_path
+			,retries
+			,status_history
+			,last_modified
+			,tenant
+			,announce_to
+	FROM metamorph.transactions
+	WHERE stored_at >= $1
+	AND stored_at < $2
+	ORDER BY stored_at, hash
+	LIMIT $3 OFFSET $4
+	`
+
+	rows, err := p.db.QueryContext(ctx, q, from, to, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	return p.getStoreDataFromRows(rows)
+}
+
+// GetExport returns the transactions matching the filter ordered by the time at which they were stored and their hash.
+// Only the fields which are exported are set, the raw transactions and callbacks are not read.
+func (p *PostgreSQL) GetExport(ctx context.Context, filter store.ExportFilter, limit int64) (res []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetExport", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `SELECT
+			stored_at
+			,hash
+			,status
+			,block_height
+			,block_hash
+			,reject_reason
+			,competing_txs
+			,last_modified
+			,mined_hash
+			,tenant
+	FROM metamorph.transactions
+	WHERE stored_at >= $1
+	AND stored_at < $2
+	AND (CARDINALITY($3::INT[]) = 0 OR status = ANY($3::INT[]))
+	AND ($4::TEXT = '' OR tenant = $4::TEXT)
+	AND ($6::BYTEA IS NULL OR (stored_at, hash) > ($5::TIMESTAMPTZ, $6::BYTEA))
+	ORDER BY stored_at, hash
+	LIMIT $7
+	`
+
+	statuses := make([]int, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, int(status))
+	}
+
+	var afterHash []byte
+	if filter.AfterHash != nil {
+		afterHash = filter.AfterHash.CloneBytes()
+	}
+
+	rows, err := p.db.QueryContext(ctx, q, filter.From, filter.To, pq.Array(statuses), filter.Tenant, filter.AfterStoredAt, afterHash, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	res = make([]*store.Data, 0)
+	for rows.Next() {
+		var storedAt time.Time
+		var txHash []byte
+		var status sql.NullInt32
+		var blockHeight sql.NullInt64
+		var blockHash []byte
+		var rejectReason sql.NullString
+		var competingTxs sql.NullString
+		var lastModified sql.NullTime
+		var minedHash []byte
+		var tenant sql.NullString
+
+		err = rows.Scan(&storedAt, &txHash, &status, &blockHeight, &blockHash, &rejectReason, &competingTxs, &lastModified, &minedHash, &tenant)
+		if err != nil {
+			return nil, err
+		}
+
+		data := &store.Data{
+			StoredAt:     storedAt.UTC(),
+			RejectReason: rejectReason.String,
+			Tenant:       tenant.String,
+		}
+
+		err = data.UpdateTxHash(txHash)
+		if err != nil {
+			return nil, err
+		}
+		err = data.UpdateBlockHash(blockHash)
+		if err != nil {
+			return nil, err
+		}
+		err = data.UpdateBlockHeightFromSQL(blockHeight)
+		if err != nil {
+			return nil, err
+		}
+		data.UpdateStatusFromSQL(status)
+		data.UpdateCompetingTxs(competingTxs)
+		data.UpdateLastModifiedFromSQL(lastModified)
+
+		if len(minedHash) > 0 {
+			data.MinedHash, err = chainhash.NewHash(minedHash)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		res = append(res, data)
+	}
+
+	return res, rows.Err()
+}
+
 func (p *PostgreSQL) UpdateStatus(ctx context.Context, updates []store.UpdateStatus) (res []*store.Data, err error) {
 	ctx, span := tracing.StartTracing(ctx, "UpdateStatusBulk", p.tracingEnabled, append(p.tracingAttributes, attribute.Int("updates", len(updates)))...)
 	defer func() {
@@ -735,15 +1720,22 @@ func (p *PostgreSQL) UpdateStatus(ctx context.Context, updates []store.UpdateSta
 				status = bulk_query.status,
 				reject_reason = bulk_query.reject_reason,
 				last_modified = $1,
+				-- the timestamp of the new status must not precede the timestamps in the status history, e.g. due to clock skew
 				status_history = status_history
 					|| COALESCE(
 						bulk_query.history_update || json_build_object(
 							'status', bulk_query.status,
-							'timestamp', bulk_query.timestamp
+							'timestamp', GREATEST(bulk_query.timestamp, (
+								SELECT MAX((existing_status->>'timestamp')::TIMESTAMP WITH TIME ZONE)
+								FROM jsonb_array_elements(COALESCE(metamorph.transactions.status_history, '[]'::JSONB)) AS existing_status
+							))
 						)::JSONB,
 						json_build_object(
 							'status', bulk_query.status,
-							'timestamp', bulk_query.timestamp
+							'timestamp', GREATEST(bulk_query.timestamp, (
+								SELECT MAX((existing_status->>'timestamp')::TIMESTAMP WITH TIME ZONE)
+								FROM jsonb_array_elements(COALESCE(metamorph.transactions.status_history, '[]'::JSONB)) AS existing_status
+							))
 						)::JSONB
 					)
 			FROM
@@ -768,6 +1760,8 @@ func (p *PostgreSQL) UpdateStatus(ctx context.Context, updates []store.UpdateSta
 		,metamorph.transactions.retries
 		,metamorph.transactions.status_history
 		,metamorph.transactions.last_modified
+		,metamorph.transactions.tenant
+		,metamorph.transactions.announce_to
 		;
     `
 
@@ -790,7 +1784,7 @@ func (p *PostgreSQL) UpdateStatus(ctx context.Context, updates []store.UpdateSta
 	}
 	defer rows.Close()
 
-	res, err = getStoreDataFromRows(rows)
+	res, err = p.getStoreDataFromRows(rows)
 	if err != nil {
 		return nil, err
 	}
@@ -917,6 +1911,8 @@ func (p *PostgreSQL) UpdateStatusHistory(ctx context.Context, updates []store.Up
     ,metamorph.transactions.retries
     ,metamorph.transactions.status_history
     ,metamorph.transactions.last_modified
+    ,metamorph.transactions.tenant
+    ,metamorph.transactions.announce_to
     ;
 `
 
@@ -939,7 +1935,7 @@ func (p *PostgreSQL) UpdateStatusHistory(ctx context.Context, updates []store.Up
 	}
 	defer rows.Close()
 
-	res, err = getStoreDataFromRows(rows)
+	res, err = p.getStoreDataFromRows(rows)
 	if err != nil {
 		return nil, err
 	}
@@ -1001,6 +1997,8 @@ func (p *PostgreSQL) UpdateDoubleSpend(ctx context.Context, updates []store.Upda
 		,metamorph.transactions.retries
 		,metamorph.transactions.status_history
 		,metamorph.transactions.last_modified
+		,metamorph.transactions.tenant
+		,metamorph.transactions.announce_to
 		;
     `
 
@@ -1036,7 +2034,7 @@ func (p *PostgreSQL) UpdateDoubleSpend(ctx context.Context, updates []store.Upda
 	}
 	defer rows.Close()
 
-	res, err = getStoreDataFromRows(rows)
+	res, err = p.getStoreDataFromRows(rows)
 	rollbackErr = p.rollbackIfFailed(err, tx)
 	if rollbackErr != nil {
 		return nil, rollbackErr
@@ -1117,14 +2115,30 @@ func (p *PostgreSQL) UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.T
 		return nil, nil
 	}
 
+	originalHashes, err := p.getHashesByNormalizedHashes(ctx, txsBlocks)
+	if err != nil {
+		return nil, err
+	}
+
 	txHashes := make([][]byte, len(txsBlocks))
+	minedHashes := make([][]byte, len(txsBlocks))
 	blockHashes := make([][]byte, len(txsBlocks))
 	blockHeights := make([]uint64, len(txsBlocks))
 	merklePaths := make([]string, len(txsBlocks))
 	statuses := make([]metamorph_api.Status, len(txsBlocks))
+	minedHashesByHash := make(map[string][]byte)
 
 	for i, tx := range txsBlocks {
 		txHashes[i] = tx.TransactionHash
+
+		// a malleated variant of the transaction was mined, the transaction is updated with the hash of the variant
+		originalHash, found := originalHashes[string(tx.NormalizedHash)]
+		if len(tx.NormalizedHash) > 0 && found && !bytes.Equal(originalHash, tx.TransactionHash) {
+			txHashes[i] = originalHash
+			minedHashes[i] = tx.TransactionHash
+			minedHashesByHash[string(originalHash)] = tx.TransactionHash
+		}
+
 		blockHashes[i] = tx.BlockHash
 		blockHeights[i] = tx.BlockHeight
 		merklePaths[i] = tx.MerklePath
@@ -1141,6 +2155,7 @@ func (p *PostgreSQL) UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.T
 			    block_hash=bulk_query.block_hash,
 			    block_height=bulk_query.block_height,
 			  	merkle_path=bulk_query.merkle_path,
+			  	mined_hash=NULLIF(bulk_query.mined_hash, ''::BYTEA),
 			  	last_modified=$1,
 				status_history=status_history || json_build_object(
 					'status', bulk_query.mined_status,
@@ -1150,8 +2165,8 @@ func (p *PostgreSQL) UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.T
 			  (
 				SELECT *
 				FROM
-					UNNEST($2::INT[], $3::BYTEA[], $4::BYTEA[], $5::BIGINT[], $6::TEXT[])
-					AS t(mined_status, hash, block_hash, block_height, merkle_path)
+					UNNEST($2::INT[], $3::BYTEA[], $4::BYTEA[], $5::BIGINT[], $6::TEXT[], $7::BYTEA[])
+					AS t(mined_status, hash, block_hash, block_height, merkle_path, mined_hash)
 			  ) AS bulk_query
 			WHERE
 			  t.hash=bulk_query.hash
@@ -1170,6 +2185,8 @@ func (p *PostgreSQL) UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.T
 		,t.retries
 		,t.status_history
 		,t.last_modified
+		,t.tenant
+		,t.announce_to
 		;
 	`
 
@@ -1187,30 +2204,79 @@ func (p *PostgreSQL) UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.T
 
 	compTxsData := getCompetingTxsFromRows(rows)
 
-	rows, err = tx.QueryContext(ctx, qBulkUpdate, p.now(), pq.Array(statuses), pq.Array(txHashes), pq.Array(blockHashes), pq.Array(blockHeights), pq.Array(merklePaths))
+	rows, err = tx.QueryContext(ctx, qBulkUpdate, p.now(), pq.Array(statuses), pq.Array(txHashes), pq.Array(blockHashes), pq.Array(blockHeights), pq.Array(merklePaths), pq.Array(minedHashes))
 	rollbackErr = p.rollbackIfFailed(err, tx)
 	if rollbackErr != nil {
 		return nil, rollbackErr
 	}
 
 	defer rows.Close()
-	res, err := getStoreDataFromRows(rows)
+	res, err := p.getStoreDataFromRows(rows)
 	rollbackErr = p.rollbackIfFailed(err, tx)
 	if rollbackErr != nil {
 		return nil, rollbackErr
 	}
 
+	for _, d := range res {
+		minedHash, found := minedHashesByHash[string(d.Hash[:])]
+		if !found {
+			continue
+		}
+
+		d.MinedHash, err = chainhash.NewHash(minedHash)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
 	}
 
-	rejectedResponses, err := p.updateDoubleSpendRejected(ctx, compTxsData)
-	if err != nil {
-		return nil, errors.Join(store.ErrUpdateCompeting, err)
+	rejectedResponses, err := p.updateDoubleSpendRejected(ctx, compTxsData)
+	if err != nil {
+		return nil, errors.Join(store.ErrUpdateCompeting, err)
+	}
+
+	return append(res, rejectedResponses...), nil
+}
+
+// getHashesByNormalizedHashes returns the hashes of the stored transactions by the normalized hashes of the mined
+// malleated variants.
+func (p *PostgreSQL) getHashesByNormalizedHashes(ctx context.Context, txsBlocks []*blocktx_api.TransactionBlock) (map[string][]byte, error) {
+	normalizedHashes := make([][]byte, 0)
+	for _, tx := range txsBlocks {
+		if len(tx.NormalizedHash) > 0 {
+			normalizedHashes = append(normalizedHashes, tx.NormalizedHash)
+		}
+	}
+
+	hashes := make(map[string][]byte, len(normalizedHashes))
+	if len(normalizedHashes) == 0 {
+		return hashes, nil
+	}
+
+	rows, err := p.db.QueryContext(ctx, `SELECT normalized_hash, hash FROM metamorph.transactions WHERE normalized_hash = ANY($1::BYTEA[])`, pq.Array(normalizedHashes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var normalizedHash []byte
+		var hash []byte
+
+		err = rows.Scan(&normalizedHash, &hash)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes[string(normalizedHash)] = hash
 	}
 
-	return append(res, rejectedResponses...), nil
+	return hashes, rows.Err()
 }
 
 func (p *PostgreSQL) updateDoubleSpendRejected(ctx context.Context, competingTxsData []competingTxsData) ([]*store.Data, error) {
@@ -1236,6 +2302,8 @@ func (p *PostgreSQL) updateDoubleSpendRejected(ctx context.Context, competingTxs
 		,t.retries
 		,t.status_history
 		,t.last_modified
+		,t.tenant
+		,t.announce_to
 		;
 	`
 	rejectReason := "double spend attempted"
@@ -1263,7 +2331,7 @@ func (p *PostgreSQL) updateDoubleSpendRejected(ctx context.Context, competingTxs
 
 	defer rows.Close()
 
-	res, err := getStoreDataFromRows(rows)
+	res, err := p.getStoreDataFromRows(rows)
 	if err != nil {
 		return nil, err
 	}
@@ -1298,12 +2366,24 @@ func (p *PostgreSQL) Ping(ctx context.Context) error {
 	return rows.Close()
 }
 
+// ClearData deletes the transactions which have not been submitted within the retention period, except for rejected
+// transactions in quarantine. If the transactions table is partitioned, no transactions are deleted, as the expired
+// partitions are dropped by the PartitionManager.
 func (p *PostgreSQL) ClearData(ctx context.Context, retentionDays int32) (int64, error) {
+	if p.partitioning {
+		return 0, nil
+	}
+
 	start := p.now()
 
 	deleteBeforeDate := start.Add(-24 * time.Hour * time.Duration(retentionDays))
+	quarantinedSince := start.Add(-1 * p.rejectedQuarantine)
 
-	res, err := p.db.ExecContext(ctx, "DELETE FROM metamorph.transactions WHERE last_submitted_at <= $1", deleteBeforeDate)
+	q := `DELETE FROM metamorph.transactions
+		WHERE last_submitted_at <= $1
+		AND NOT (status = $2 AND last_modified > $3)`
+
+	res, err := p.db.ExecContext(ctx, q, deleteBeforeDate, metamorph_api.Status_REJECTED, quarantinedSince)
 	if err != nil {
 		return 0, err
 	}
@@ -1313,9 +2393,391 @@ func (p *PostgreSQL) ClearData(ctx context.Context, retentionDays int32) (int64,
 		return 0, err
 	}
 
+	err = p.deleteOrphans(ctx, deleteBeforeDate)
+	if err != nil {
+		return 0, err
+	}
+
 	return rows, nil
 }
 
+// deleteOrphans deletes the parents, acknowledgments by peers and annotations recorded before the given time of
+// transactions which have been deleted.
+func (p *PostgreSQL) deleteOrphans(ctx context.Context, before time.Time) error {
+	err := p.deleteOrphanedParents(ctx, before)
+	if err != nil {
+		return err
+	}
+
+	err = p.deleteOrphanedPeerAcks(ctx, before)
+	if err != nil {
+		return err
+	}
+
+	return p.deleteOrphanedAnnotations(ctx, before)
+}
+
+// deleteOrphanedParents deletes the links to the parents of transactions stored before the given time which have been
+// deleted.
+func (p *PostgreSQL) deleteOrphanedParents(ctx context.Context, before time.Time) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM metamorph.transaction_parents tp
+		WHERE tp.stored_at <= $1
+		AND NOT EXISTS (SELECT 1 FROM metamorph.transactions t WHERE t.hash = tp.hash)`, before)
+
+	return err
+}
+
+// deleteOrphanedPeerAcks deletes the acknowledgments by peers recorded before the given time of transactions which
+// have been deleted.
+func (p *PostgreSQL) deleteOrphanedPeerAcks(ctx context.Context, before time.Time) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM metamorph.transaction_peers tp
+		WHERE tp.created_at <= $1
+		AND NOT EXISTS (SELECT 1 FROM metamorph.transactions t WHERE t.hash = tp.hash)`, before)
+
+	return err
+}
+
+// deleteOrphanedAnnotations deletes the annotations added before the given time of transactions which have been
+// deleted.
+func (p *PostgreSQL) deleteOrphanedAnnotations(ctx context.Context, before time.Time) error {
+	_, err := p.db.ExecContext(ctx, `DELETE FROM metamorph.transaction_annotations ta
+		WHERE ta.created_at <= $1
+		AND NOT EXISTS (SELECT 1 FROM metamorph.transactions t WHERE t.hash = ta.hash)`, before)
+
+	return err
+}
+
+// ResubmitRejected resets a rejected transaction to status STORED and locks it by this instance, so that it is
+// re-announced to the network. Callbacks and all other submission metadata of the transaction are preserved.
+func (p *PostgreSQL) ResubmitRejected(ctx context.Context, hash *chainhash.Hash) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "ResubmitRejected", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `
+		UPDATE metamorph.transactions
+		SET status = $2
+			,reject_reason = ''
+			,retries = 0
+			,locked_by = $3
+			,last_submitted_at = $4
+			,last_modified = $4
+			,status_history = COALESCE(status_history, '[]'::JSONB) || json_build_object(
+				'status', $2::INT,
+				'timestamp', $4::TIMESTAMPTZ
+			)::JSONB
+		WHERE hash = $1 AND status = $5;`
+
+	now := p.now()
+	res, err := p.db.ExecContext(ctx, q, hash[:], metamorph_api.Status_STORED, p.hostname, now, metamorph_api.Status_REJECTED)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return store.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetDueScheduled returns the scheduled transactions locked by this instance whose broadcast time is reached. The
+// broadcast time of the returned transactions is reset and their last submission is set to now, so that each of them
+// is returned once and re-broadcast like any other transaction afterwards.
+func (p *PostgreSQL) GetDueScheduled(ctx context.Context, now time.Time, limit int64) (data []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetDueScheduled", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `
+		UPDATE metamorph.transactions t
+		SET broadcast_at = NULL, last_submitted_at = $2
+		WHERE t.hash IN (
+		   SELECT t2.hash
+		   FROM metamorph.transactions t2
+		   WHERE t2.locked_by = $1 AND t2.status = $3 AND t2.broadcast_at <= $2
+		   ORDER BY t2.broadcast_at
+		   LIMIT $4
+		   FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+		stored_at
+		,hash
+		,status
+		,block_height
+		,block_hash
+		,callbacks
+		,full_status_updates
+		,reject_reason
+		,competing_txs
+		,raw_tx
+		,locked_by
+		,merkle_path
+		,retries
+		,status_history
+		,last_modified
+		,tenant
+		,announce_to;`
+
+	rows, err := p.db.QueryContext(ctx, q, p.hostname, now, metamorph_api.Status_STORED, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return p.getStoreDataFromRows(rows)
+}
+
+// GetUnrequested returns the transactions locked by this instance which were submitted after since and announced to
+// the network before announcedBefore, but not requested by any peer, and which were not submitted to the node yet.
+func (p *PostgreSQL) GetUnrequested(ctx context.Context, since time.Time, announcedBefore time.Time, limit int64) (data []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetUnrequested", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `
+		SELECT
+		stored_at
+		,hash
+		,status
+		,block_height
+		,block_hash
+		,callbacks
+		,full_status_updates
+		,reject_reason
+		,competing_txs
+		,raw_tx
+		,locked_by
+		,merkle_path
+		,retries
+		,status_history
+		,last_modified
+		,tenant
+		,announce_to
+		FROM metamorph.transactions
+		WHERE locked_by = $1
+		AND status = $4
+		AND node_submitted_at IS NULL
+		AND last_submitted_at > $2
+		AND last_submitted_at <= $3
+		ORDER BY last_submitted_at
+		LIMIT $5;`
+
+	rows, err := p.db.QueryContext(ctx, q, p.hostname, since, announcedBefore, metamorph_api.Status_ANNOUNCED_TO_NETWORK, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return p.getStoreDataFromRows(rows)
+}
+
+// GetExpired returns the hashes of the transactions locked by this instance whose expiry is reached and which are
+// neither mined, rejected nor expired yet.
+func (p *PostgreSQL) GetExpired(ctx context.Context, now time.Time, limit int64) (hashes []*chainhash.Hash, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetExpired", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `
+		SELECT hash
+		FROM metamorph.transactions
+		WHERE locked_by = $1
+		AND expires_at <= $2
+		AND status < $3
+		ORDER BY expires_at
+		LIMIT $4;`
+
+	rows, err := p.db.QueryContext(ctx, q, p.hostname, now, metamorph_api.Status_EXPIRED, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hashes = make([]*chainhash.Hash, 0)
+	for rows.Next() {
+		var hashBytes []byte
+		err = rows.Scan(&hashBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := chainhash.NewHash(hashBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// GetFinal returns the transactions locked by this instance which wait for finality and whose lock time is reached by
+// the next block of height nextHeight, i.e. a lock time below 500000000 is lower than nextHeight or a later lock time
+// is before the median time past. Scheduled transactions are only returned once their broadcast time is reached. The
+// lock time and broadcast time of the returned transactions are cleared, so that they are re-broadcast as usual.
+func (p *PostgreSQL) GetFinal(ctx context.Context, nextHeight uint64, medianTimePast time.Time, now time.Time, limit int64) (data []*store.Data, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetFinal", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `
+		UPDATE metamorph.transactions t
+		SET lock_time = NULL, broadcast_at = NULL, last_submitted_at = $2
+		WHERE t.hash IN (
+		   SELECT t2.hash
+		   FROM metamorph.transactions t2
+		   WHERE t2.locked_by = $1 AND t2.status = $3 AND t2.lock_time IS NOT NULL
+		   AND ((t2.lock_time < $4 AND t2.lock_time < $5) OR (t2.lock_time >= $4 AND t2.lock_time < $6))
+		   AND (t2.broadcast_at IS NULL OR t2.broadcast_at <= $2)
+		   ORDER BY t2.lock_time
+		   LIMIT $7
+		   FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+		stored_at
+		,hash
+		,status
+		,block_height
+		,block_hash
+		,callbacks
+		,full_status_updates
+		,reject_reason
+		,competing_txs
+		,raw_tx
+		,locked_by
+		,merkle_path
+		,retries
+		,status_history
+		,last_modified
+		,tenant
+		,announce_to;`
+
+	rows, err := p.db.QueryContext(ctx, q, p.hostname, now, metamorph_api.Status_WAITING_FOR_FINALITY, validator.LockTimeThreshold, nextHeight, medianTimePast.Unix(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return p.getStoreDataFromRows(rows)
+}
+
+// GetMinedInBlocks returns the hashes of the transactions which are mined in the blocks according to metamorph.
+func (p *PostgreSQL) GetMinedInBlocks(ctx context.Context, blockHashes [][]byte) (hashes []*chainhash.Hash, err error) {
+	ctx, span := tracing.StartTracing(ctx, "GetMinedInBlocks", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	q := `SELECT hash FROM metamorph.transactions WHERE status = $1 AND block_hash = ANY($2);`
+
+	rows, err := p.db.QueryContext(ctx, q, metamorph_api.Status_MINED, pq.Array(blockHashes))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	hashes = make([]*chainhash.Hash, 0)
+	for rows.Next() {
+		var hashBytes []byte
+		err = rows.Scan(&hashBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := chainhash.NewHash(hashBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		hashes = append(hashes, hash)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// SetNodeSubmission records the response of the node to the submission of a transaction.
+func (p *PostgreSQL) SetNodeSubmission(ctx context.Context, hash *chainhash.Hash, submission store.NodeSubmission) error {
+	q := `
+		UPDATE metamorph.transactions
+		SET node_submitted_at = $2, node_accepted = $3, node_reject_reason = NULLIF($4, '')
+		WHERE hash = $1;`
+
+	_, err := p.db.ExecContext(ctx, q, hash[:], submission.SubmittedAt, submission.Accepted, submission.RejectReason)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CancelScheduled deletes a stored transaction whose broadcast time is not reached yet, i.e. which has not been
+// announced to the network. It returns ErrNotScheduled if the transaction exists but is not scheduled or already
+// announced.
+func (p *PostgreSQL) CancelScheduled(ctx context.Context, hash *chainhash.Hash, now time.Time) (err error) {
+	ctx, span := tracing.StartTracing(ctx, "CancelScheduled", p.tracingEnabled, p.tracingAttributes...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	res, err := tx.ExecContext(ctx, `DELETE FROM metamorph.transactions WHERE hash = $1 AND broadcast_at > $2 AND status <= $3;`, hash[:], now, metamorph_api.Status_STORED)
+	if err != nil {
+		return err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		var exists bool
+		err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM metamorph.transactions WHERE hash = $1);`, hash[:]).Scan(&exists)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return store.ErrNotScheduled
+		}
+		return store.ErrNotFound
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM metamorph.transaction_parents WHERE hash = $1;`, hash[:])
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (p *PostgreSQL) GetStats(ctx context.Context, since time.Time, notSeenLimit time.Duration, notFinalLimit time.Duration) (*store.Stats, error) {
 	q := `
 	SELECT
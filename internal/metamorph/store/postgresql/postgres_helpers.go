@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
 )
 
@@ -34,11 +36,11 @@ func mergeUnique(arr1, arr2 []string) []string {
 	return uniqueSlice
 }
 
-func getStoreDataFromRows(rows *sql.Rows) ([]*store.Data, error) {
+func (p *PostgreSQL) getStoreDataFromRows(rows *sql.Rows) ([]*store.Data, error) {
 	var storeData []*store.Data
 
 	for rows.Next() {
-		data, err := getStoreDataFromRow(rows, &store.Data{})
+		data, err := p.getStoreDataFromRow(rows, &store.Data{})
 		if err != nil {
 			return nil, err
 		}
@@ -48,7 +50,7 @@ func getStoreDataFromRows(rows *sql.Rows) ([]*store.Data, error) {
 	return storeData, nil
 }
 
-func getStoreDataFromRow(rows *sql.Rows, data *store.Data) (*store.Data, error) {
+func (p *PostgreSQL) getStoreDataFromRow(rows *sql.Rows, data *store.Data) (*store.Data, error) {
 	var storedAt time.Time
 	var status sql.NullInt32
 
@@ -63,6 +65,8 @@ func getStoreDataFromRow(rows *sql.Rows, data *store.Data) (*store.Data, error)
 	var merklePath sql.NullString
 	var retries sql.NullInt32
 	var lastModified sql.NullTime
+	var tenant sql.NullString
+	var announceTo sql.NullString
 
 	err := rows.Scan(
 		&storedAt,
@@ -80,6 +84,8 @@ func getStoreDataFromRow(rows *sql.Rows, data *store.Data) (*store.Data, error)
 		&retries,
 		&statusHistory,
 		&lastModified,
+		&tenant,
+		&announceTo,
 	)
 	if err != nil {
 		return nil, err
@@ -101,7 +107,7 @@ func getStoreDataFromRow(rows *sql.Rows, data *store.Data) (*store.Data, error)
 	}
 	data.UpdateStatusFromSQL(status)
 	if len(callbacksData) > 0 {
-		callbacks, err := readCallbacksFromDB(callbacksData)
+		callbacks, err := p.readCallbacksFromDB(callbacksData)
 		if err != nil {
 			return nil, err
 		}
@@ -119,6 +125,8 @@ func getStoreDataFromRow(rows *sql.Rows, data *store.Data) (*store.Data, error)
 	data.UpdateLastModifiedFromSQL(lastModified)
 	data.RejectReason = rejectReason.String
 	data.MerklePath = merklePath.String
+	data.Tenant = tenant.String
+	data.AnnounceTo = announceTo.String
 	return data, nil
 }
 
@@ -151,15 +159,52 @@ func getCompetingTxsFromRows(rows *sql.Rows) []competingTxsData {
 	return dbData
 }
 
-func readCallbacksFromDB(callbacks []byte) ([]store.Callback, error) {
+func (p *PostgreSQL) readCallbacksFromDB(callbacks []byte) ([]store.Callback, error) {
 	var callbacksData []store.Callback
 	err := json.Unmarshal(callbacks, &callbacksData)
 	if err != nil {
 		return nil, err
 	}
+
+	for i := range callbacksData {
+		callbacksData[i].CallbackURL, err = p.cipher.Decrypt(callbacksData[i].CallbackURL)
+		if err != nil {
+			return nil, err
+		}
+		callbacksData[i].CallbackToken, err = p.cipher.Decrypt(callbacksData[i].CallbackToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return callbacksData, nil
 }
 
+// marshalCallbacks encrypts the callback URLs and tokens if a cipher is set, without changing the given callbacks.
+func (p *PostgreSQL) marshalCallbacks(callbacks []store.Callback) ([]byte, error) {
+	if p.cipher == nil || len(callbacks) == 0 {
+		return json.Marshal(callbacks)
+	}
+
+	encrypted := make([]store.Callback, len(callbacks))
+	for i, callback := range callbacks {
+		url, err := p.cipher.Encrypt(callback.CallbackURL)
+		if err != nil {
+			return nil, err
+		}
+		token, err := p.cipher.Encrypt(callback.CallbackToken)
+		if err != nil {
+			return nil, err
+		}
+
+		encrypted[i] = callback
+		encrypted[i].CallbackURL = url
+		encrypted[i].CallbackToken = token
+	}
+
+	return json.Marshal(encrypted)
+}
+
 func readStatusHistoryFromDB(statusHistory []byte) ([]*store.StatusWithTimestamp, error) {
 	var statusHistoryData []*store.StatusWithTimestamp
 	err := json.Unmarshal(statusHistory, &statusHistoryData)
@@ -175,3 +220,42 @@ func readStatusHistoryFromDB(statusHistory []byte) ([]*store.StatusWithTimestamp
 
 	return statusHistoryData, nil
 }
+
+// nullTime returns a NULL time for the zero time.
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
+
+func nullLockTime(lockTime uint32) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(lockTime), Valid: lockTime != 0}
+}
+
+// parentHashes returns the unique hashes of the transactions whose outputs are spent by the raw transaction. Raw
+// transactions which cannot be parsed have no parents.
+func parentHashes(rawTx []byte) [][]byte {
+	if len(rawTx) == 0 {
+		return nil
+	}
+
+	tx, err := sdkTx.NewTransactionFromBytes(rawTx)
+	if err != nil {
+		return nil
+	}
+
+	seen := make(map[[32]byte]struct{}, len(tx.Inputs))
+	parents := make([][]byte, 0, len(tx.Inputs))
+	for _, input := range tx.Inputs {
+		if input.SourceTXID == nil {
+			continue
+		}
+
+		if _, ok := seen[*input.SourceTXID]; ok {
+			continue
+		}
+		seen[*input.SourceTXID] = struct{}{}
+
+		parents = append(parents, input.SourceTXID.CloneBytes())
+	}
+
+	return parents
+}
@@ -1,12 +1,15 @@
 package postgresql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"encoding/hex"
 	"errors"
 	"flag"
 	"log"
+	"log/slog"
+	"slices"
 	"testing"
 	"time"
 
@@ -19,8 +22,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/encryption"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
 	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store/conformance"
 	"github.com/bitcoin-sv/arc/internal/testdata"
 	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
 )
@@ -42,6 +47,16 @@ func TestMain(m *testing.M) {
 }
 
 func testmain(m *testing.M) int {
+	// the user-provided database has been requested explicitly, so failing to migrate it fails the tests
+	userDBInfo, err := testutils.MigrateUserPostgresql("metamorph", migrationsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if userDBInfo != "" {
+		dbInfo = userDBInfo
+		return m.Run()
+	}
+
 	pool, err := dockertest.NewPool("")
 	if err != nil {
 		log.Printf("failed to create pool: %v", err)
@@ -66,7 +81,7 @@ func testmain(m *testing.M) int {
 }
 
 func pruneTables(t *testing.T, db *sql.DB) {
-	testutils.PruneTables(t, db, "metamorph.transactions")
+	testutils.PruneTables(t, db, "metamorph.transactions", "metamorph.sla_reports", "metamorph.transaction_parents", "metamorph.transaction_peers", "metamorph.transaction_annotations", "metamorph.erasures", "metamorph.reverifications")
 }
 
 func TestPostgresDB(t *testing.T) {
@@ -145,6 +160,34 @@ func TestPostgresDB(t *testing.T) {
 		require.True(t, errors.Is(err, store.ErrNotFound))
 	})
 
+	t.Run("encrypted callbacks", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		cipher, err := encryption.NewCipher([]encryption.Key{{ID: "test", Secret: make([]byte, 32)}}, "test")
+		require.NoError(t, err)
+		encryptedDB, err := New(dbInfo, "metamorph-1", 10, 10, WithCipher(cipher), WithNow(func() time.Time {
+			return now
+		}))
+		require.NoError(t, err)
+		defer func() {
+			encryptedDB.Close(ctx)
+		}()
+
+		mined := *minedData
+		err = encryptedDB.Set(ctx, &mined)
+		require.NoError(t, err)
+
+		var callbacksData string
+		err = postgresDB.db.QueryRowContext(ctx, "SELECT callbacks FROM metamorph.transactions WHERE hash = $1", minedHash[:]).Scan(&callbacksData)
+		require.NoError(t, err)
+		require.NotContains(t, callbacksData, "callback.example.com")
+		require.NotContains(t, callbacksData, "12345")
+
+		dataReturned, err := encryptedDB.Get(ctx, minedHash[:])
+		require.NoError(t, err)
+		require.Equal(t, minedData.Callbacks, dataReturned.Callbacks)
+	})
+
 	t.Run("get raw txs", func(t *testing.T) {
 		defer pruneTables(t, postgresDB.db)
 		testutils.LoadFixtures(t, postgresDB.db, "fixtures/get_rawtxs")
@@ -275,6 +318,73 @@ func TestPostgresDB(t *testing.T) {
 		require.Equal(t, expectedHash1, records[0].Hash)
 	})
 
+	t.Run("get stored between", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+		testutils.LoadFixtures(t, postgresDB.db, "fixtures/transactions")
+
+		from := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2023, 9, 2, 0, 0, 0, 0, time.UTC)
+
+		records, err := postgresDB.GetStoredBetween(ctx, from, to, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, records, 4)
+		for _, record := range records {
+			require.Equal(t, time.Date(2023, 9, 1, 14, 0, 0, 0, time.UTC), record.StoredAt)
+			require.Empty(t, record.RawTx)
+		}
+
+		records, err = postgresDB.GetStoredBetween(ctx, from, to, 10, 3)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+	})
+
+	t.Run("get export", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+		testutils.LoadFixtures(t, postgresDB.db, "fixtures/transactions")
+
+		filter := store.ExportFilter{
+			From:     time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC),
+			To:       time.Date(2023, 9, 2, 0, 0, 0, 0, time.UTC),
+			Statuses: []metamorph_api.Status{metamorph_api.Status_MINED},
+		}
+
+		records, err := postgresDB.GetExport(ctx, filter, 2)
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		for _, record := range records {
+			require.Equal(t, metamorph_api.Status_MINED, record.Status)
+			require.Empty(t, record.RawTx)
+		}
+
+		// the export is continued after the last exported transaction
+		filter.AfterStoredAt = records[1].StoredAt
+		filter.AfterHash = records[1].Hash
+		next, err := postgresDB.GetExport(ctx, filter, 2)
+		require.NoError(t, err)
+		require.Len(t, next, 1)
+		require.NotEqual(t, records[0].Hash, next[0].Hash)
+		require.NotEqual(t, records[1].Hash, next[0].Hash)
+
+		tenantTx := &store.Data{
+			Hash:     testdata.TX1Hash,
+			Status:   metamorph_api.Status_STORED,
+			StoredAt: time.Date(2023, 9, 1, 15, 0, 0, 0, time.UTC),
+			Tenant:   "exchange-a",
+		}
+		err = postgresDB.Set(ctx, tenantTx)
+		require.NoError(t, err)
+
+		stored, err := postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Equal(t, "exchange-a", stored.Tenant)
+
+		records, err = postgresDB.GetExport(ctx, store.ExportFilter{From: filter.From, To: filter.To, Tenant: "exchange-a"}, 10)
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, testdata.TX1Hash, records[0].Hash)
+		require.Equal(t, "exchange-a", records[0].Tenant)
+	})
+
 	t.Run("set locked by", func(t *testing.T) {
 		defer pruneTables(t, postgresDB.db)
 		testutils.LoadFixtures(t, postgresDB.db, "fixtures/set_locked")
@@ -435,6 +545,33 @@ func TestPostgresDB(t *testing.T) {
 		require.Len(t, statusUpdates, 0)
 	})
 
+	t.Run("update status with timestamp preceding the status history", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+		testutils.LoadFixtures(t, postgresDB.db, "fixtures/update_status")
+
+		hash := *testutils.RevChainhash(t, "cd3d2f97dfc0cdb6a07ec4b72df5e1794c9553ff2f62d90ed4add047e8088853")
+		seenTimestamp := time.Date(2025, 4, 3, 14, 5, 20, 0, time.UTC)
+
+		statusUpdates, err := postgresDB.UpdateStatus(ctx, []store.UpdateStatus{
+			{Hash: hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, Timestamp: seenTimestamp},
+		})
+		require.NoError(t, err)
+		require.Len(t, statusUpdates, 1)
+
+		// when
+		statusUpdates, err = postgresDB.UpdateStatus(ctx, []store.UpdateStatus{
+			{Hash: hash, Status: metamorph_api.Status_REJECTED, Timestamp: seenTimestamp.Add(-time.Minute)},
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, statusUpdates, 1)
+
+		history := statusUpdates[0].StatusHistory
+		require.Equal(t, metamorph_api.Status_REJECTED, history[len(history)-1].Status)
+		require.True(t, seenTimestamp.Equal(history[len(history)-1].Timestamp))
+	})
+
 	t.Run("update double spend status", func(t *testing.T) {
 		defer pruneTables(t, postgresDB.db)
 		testutils.LoadFixtures(t, postgresDB.db, "fixtures/update_double_spend")
@@ -669,6 +806,41 @@ func TestPostgresDB(t *testing.T) {
 		require.Equal(t, &unmined, dataReturned)
 	})
 
+	t.Run("update mined - malleated variant", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		normalizedHash := testutils.RevChainhash(t, "b16cea53fc823e146fbb9ae4ad3124f7c273f30562585ad6e4831495d609f430")
+		minedHash := testutils.RevChainhash(t, "3e0b5b218c344110f09bf485bc58de4ea5378e55744185edf9c1dafa40068ecd")
+
+		unmined := *unminedData
+		unmined.NormalizedHash = normalizedHash
+		err = postgresDB.Set(ctx, &unmined)
+		require.NoError(t, err)
+
+		txBlocks := []*blocktx_api.TransactionBlock{{
+			BlockHash:       testdata.Block1Hash[:],
+			BlockHeight:     100,
+			TransactionHash: minedHash[:],
+			MerklePath:      "merkle-path-1",
+			BlockStatus:     blocktx_api.Status_LONGEST,
+			NormalizedHash:  normalizedHash[:],
+		}}
+
+		updated, err := postgresDB.UpdateMined(ctx, txBlocks)
+		require.NoError(t, err)
+		require.Len(t, updated, 1)
+
+		require.True(t, unminedHash.IsEqual(updated[0].Hash))
+		require.True(t, minedHash.IsEqual(updated[0].MinedHash))
+		require.Equal(t, metamorph_api.Status_MINED, updated[0].Status)
+
+		dataReturned, err := postgresDB.Get(ctx, unminedHash[:])
+		require.NoError(t, err)
+		require.True(t, normalizedHash.IsEqual(dataReturned.NormalizedHash))
+		require.True(t, minedHash.IsEqual(dataReturned.MinedHash))
+		require.Equal(t, "merkle-path-1", dataReturned.MerklePath)
+	})
+
 	t.Run("update mined - all possible updates", func(t *testing.T) {
 		defer pruneTables(t, postgresDB.db)
 
@@ -997,6 +1169,289 @@ func TestPostgresDB(t *testing.T) {
 		require.Equal(t, &unmined, statusUpdates[0])
 	})
 
+	t.Run("sla reports", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		day := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+		history := func(storedAt time.Time, statuses map[metamorph_api.Status]time.Duration) []*store.StatusWithTimestamp {
+			h := []*store.StatusWithTimestamp{{Status: metamorph_api.Status_STORED, Timestamp: storedAt}}
+			for _, status := range []metamorph_api.Status{metamorph_api.Status_SEEN_ON_NETWORK, metamorph_api.Status_MINED} {
+				if after, ok := statuses[status]; ok {
+					h = append(h, &store.StatusWithTimestamp{Status: status, Timestamp: storedAt.Add(after)})
+				}
+			}
+			return h
+		}
+
+		for _, data := range []*store.Data{
+			{Hash: testdata.TX1Hash, Status: metamorph_api.Status_MINED, Tenant: "exchange-a", StoredAt: day.Add(10 * time.Hour),
+				StatusHistory: history(day.Add(10*time.Hour), map[metamorph_api.Status]time.Duration{metamorph_api.Status_SEEN_ON_NETWORK: 2 * time.Second, metamorph_api.Status_MINED: 10 * time.Minute})},
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, Tenant: "exchange-a", StoredAt: day.Add(11 * time.Hour),
+				StatusHistory: history(day.Add(11*time.Hour), map[metamorph_api.Status]time.Duration{metamorph_api.Status_SEEN_ON_NETWORK: 4 * time.Second})},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_STORED, Tenant: "exchange-b", StoredAt: day.Add(12 * time.Hour),
+				StatusHistory: history(day.Add(12*time.Hour), nil)},
+			// transactions without tenant and stored in other periods are not reported
+			{Hash: testdata.TX4Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, StoredAt: day.Add(12 * time.Hour),
+				StatusHistory: history(day.Add(12*time.Hour), map[metamorph_api.Status]time.Duration{metamorph_api.Status_SEEN_ON_NETWORK: time.Second})},
+			{Hash: testdata.TX5Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, Tenant: "exchange-a", StoredAt: day.Add(-time.Hour),
+				StatusHistory: history(day.Add(-time.Hour), map[metamorph_api.Status]time.Duration{metamorph_api.Status_SEEN_ON_NETWORK: time.Minute})},
+		} {
+			err := postgresDB.Set(ctx, data)
+			require.NoError(t, err)
+		}
+
+		// reports are replaced if they are computed again
+		for range 2 {
+			n, err := postgresDB.ComputeSLAReports(ctx, "daily", day, day.AddDate(0, 0, 1))
+			require.NoError(t, err)
+			require.Equal(t, int64(2), n)
+		}
+
+		reports, err := postgresDB.GetSLAReports(ctx, "daily", day, day.AddDate(0, 0, 1), "")
+		require.NoError(t, err)
+		require.Equal(t, []store.SLAReport{
+			{Period: "daily", PeriodStart: day, Tenant: "exchange-a", Transactions: 2, SeenOnNetwork: 2, P95TimeToSeen: 3900 * time.Millisecond, Mined: 1, P95TimeToMined: 10 * time.Minute, ComputedAt: now},
+			{Period: "daily", PeriodStart: day, Tenant: "exchange-b", Transactions: 1, ComputedAt: now},
+		}, reports)
+
+		reports, err = postgresDB.GetSLAReports(ctx, "daily", day, day.AddDate(0, 0, 1), "exchange-b")
+		require.NoError(t, err)
+		require.Len(t, reports, 1)
+
+		reports, err = postgresDB.GetSLAReports(ctx, "weekly", day, day.AddDate(0, 0, 7), "")
+		require.NoError(t, err)
+		require.Empty(t, reports)
+	})
+
+	t.Run("get child hashes", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		err := postgresDB.SetBulk(ctx, []*store.Data{
+			{RawTx: testdata.TX1Raw.Bytes(), Hash: testdata.TX1Hash, Status: metamorph_api.Status_STORED},
+		})
+		require.NoError(t, err)
+
+		parentHash := testdata.TX1Raw.Inputs[0].SourceTXID
+
+		childHashes, err := postgresDB.GetChildHashes(ctx, [][]byte{parentHash[:]})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{testdata.TX1Hash[:]}, childHashes)
+
+		childHashes, err = postgresDB.GetChildHashes(ctx, [][]byte{testdata.TX1Hash[:]})
+		require.NoError(t, err)
+		require.Empty(t, childHashes)
+	})
+
+	t.Run("get hashes", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		err := postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX1Hash, Status: metamorph_api.Status_STORED},
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_STORED},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_STORED},
+		})
+		require.NoError(t, err)
+
+		var hashes [][]byte
+		var after []byte
+		for {
+			page, err := postgresDB.GetHashes(ctx, after, 2)
+			require.NoError(t, err)
+			hashes = append(hashes, page...)
+			if len(page) < 2 {
+				break
+			}
+			after = page[len(page)-1]
+		}
+
+		expected := [][]byte{testdata.TX1Hash[:], testdata.TX2Hash[:], testdata.TX3Hash[:]}
+		slices.SortFunc(expected, bytes.Compare)
+		require.Equal(t, expected, hashes)
+	})
+
+	t.Run("peer acks", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		requestedAt := time.Date(2023, 10, 1, 14, 20, 0, 0, time.UTC)
+		sentAt := requestedAt.Add(time.Second)
+
+		err := postgresDB.SetPeerAcks(ctx, []store.PeerAck{
+			{Hash: *testdata.TX1Hash, Peer: "peer-1", RequestedAt: requestedAt},
+			{Hash: *testdata.TX2Hash, Peer: "peer-2", RequestedAt: requestedAt},
+		})
+		require.NoError(t, err)
+
+		// a repeated request keeps the time of the first request
+		err = postgresDB.SetPeerAcks(ctx, []store.PeerAck{
+			{Hash: *testdata.TX1Hash, Peer: "peer-1", RequestedAt: sentAt, SentAt: sentAt},
+		})
+		require.NoError(t, err)
+
+		acks, err := postgresDB.GetPeerAcks(ctx, [][]byte{testdata.TX1Hash[:]})
+		require.NoError(t, err)
+		require.Equal(t, []store.PeerAck{
+			{Hash: *testdata.TX1Hash, Peer: "peer-1", RequestedAt: requestedAt, SentAt: sentAt},
+		}, acks)
+	})
+
+	t.Run("annotations", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		err := postgresDB.Set(ctx, unminedData)
+		require.NoError(t, err)
+
+		annotation, err := postgresDB.AddAnnotation(ctx, store.Annotation{Hash: *unminedHash, Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1"})
+		require.NoError(t, err)
+		require.Equal(t, now, annotation.CreatedAt)
+
+		_, err = postgresDB.AddAnnotation(ctx, store.Annotation{Hash: *unminedHash, Note: "manually rebroadcast", Author: "ops-2"})
+		require.NoError(t, err)
+
+		// transactions which are not stored can't be annotated
+		_, err = postgresDB.AddAnnotation(ctx, store.Annotation{Hash: *testdata.TX2Hash, Note: "unknown", Author: "ops-1"})
+		require.ErrorIs(t, err, store.ErrNotFound)
+
+		annotations, err := postgresDB.GetAnnotations(ctx, [][]byte{unminedHash[:], testdata.TX2Hash[:]})
+		require.NoError(t, err)
+		require.Equal(t, []store.Annotation{
+			{Hash: *unminedHash, Note: "customer dispute #123", Labels: []string{"dispute"}, Author: "ops-1", CreatedAt: now},
+			{Hash: *unminedHash, Note: "manually rebroadcast", Labels: []string{}, Author: "ops-2", CreatedAt: now},
+		}, annotations)
+	})
+
+	t.Run("erase client data", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		callbacks := []store.Callback{{CallbackURL: "http://callback.example.com", CallbackToken: "12345"}}
+		err := postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX1Hash, Status: metamorph_api.Status_STORED, Callbacks: callbacks, Tenant: "exchange-a"},
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_STORED, Callbacks: callbacks, Tenant: "exchange-a"},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_STORED, Callbacks: callbacks, Tenant: "exchange-b"},
+		})
+		require.NoError(t, err)
+
+		erasure, err := postgresDB.EraseClientData(ctx, store.Erasure{Tenant: "exchange-a", Hashes: [][]byte{testdata.TX1Hash[:], testdata.TX3Hash[:]}, ErasedBy: "ops-1", Reason: "request #42"})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), erasure.Transactions)
+
+		erasure, err = postgresDB.EraseClientData(ctx, store.Erasure{Tenant: "exchange-a", ErasedBy: "ops-1", Reason: "request #43"})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), erasure.Transactions)
+
+		// the transactions are kept without their callbacks
+		for hash, expectedCallbacks := range map[*chainhash.Hash][]store.Callback{testdata.TX1Hash: nil, testdata.TX2Hash: nil, testdata.TX3Hash: callbacks} {
+			stored, getErr := postgresDB.Get(ctx, hash[:])
+			require.NoError(t, getErr)
+			require.Equal(t, expectedCallbacks, stored.Callbacks)
+			require.Equal(t, metamorph_api.Status_STORED, stored.Status)
+		}
+
+		erasures, err := postgresDB.GetErasures(ctx, 10)
+		require.NoError(t, err)
+		require.Equal(t, []store.Erasure{
+			{ID: erasure.ID, Tenant: "exchange-a", Hashes: [][]byte{}, ErasedBy: "ops-1", Reason: "request #43", ErasedAt: now, Transactions: 1},
+			{ID: erasure.ID - 1, Tenant: "exchange-a", Hashes: [][]byte{testdata.TX1Hash[:], testdata.TX3Hash[:]}, ErasedBy: "ops-1", Reason: "request #42", ErasedAt: now, Transactions: 1},
+		}, erasures)
+	})
+
+	t.Run("reverifications", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		reverification, err := postgresDB.AddReverification(ctx, store.Reverification{Mode: "defer", Beef: []byte{0x01}, Hashes: [][]byte{testdata.TX1Hash[:], testdata.TX2Hash[:]}, Options: []byte(`{}`), AddedAt: now}, 3)
+		require.NoError(t, err)
+		require.NotZero(t, reverification.ID)
+
+		// a BEEF whose transaction is already re-verified is not added again
+		existing, err := postgresDB.AddReverification(ctx, store.Reverification{Mode: "defer", Beef: []byte{0x02}, Hashes: [][]byte{testdata.TX2Hash[:]}, AddedAt: now}, 3)
+		require.NoError(t, err)
+		require.Equal(t, reverification.ID, existing.ID)
+
+		_, err = postgresDB.AddReverification(ctx, store.Reverification{Mode: "defer", Beef: []byte{0x03}, Hashes: [][]byte{testdata.TX3Hash[:], testdata.Block1Hash[:]}, AddedAt: now}, 3)
+		require.ErrorIs(t, err, store.ErrReverificationsFull)
+
+		unverified, err := postgresDB.GetUnverified(ctx, [][]byte{testdata.TX1Hash[:], testdata.TX3Hash[:]})
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{testdata.TX1Hash[:]}, unverified)
+
+		count, err := postgresDB.CountUnverified(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+
+		reverifications, err := postgresDB.GetReverifications(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Equal(t, []store.Reverification{reverification}, reverifications)
+
+		// locked reverifications are not returned to another API instance
+		reverifications, err = postgresDB.GetReverifications(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Empty(t, reverifications)
+
+		err = postgresDB.DelReverification(ctx, reverification.ID)
+		require.NoError(t, err)
+
+		count, err = postgresDB.CountUnverified(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), count)
+	})
+
+	t.Run("reverifications - encrypted options", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		cipher, err := encryption.NewCipher([]encryption.Key{{ID: "test", Secret: make([]byte, 32)}}, "test")
+		require.NoError(t, err)
+		encryptedDB, err := New(dbInfo, "metamorph-1", 10, 10, WithCipher(cipher), WithNow(func() time.Time {
+			return now
+		}))
+		require.NoError(t, err)
+		defer func() {
+			encryptedDB.Close(ctx)
+		}()
+
+		options := []byte(`{"callback_url":"https://callback.example.com","callback_token":"12345"}`)
+		_, err = encryptedDB.AddReverification(ctx, store.Reverification{Mode: "defer", Beef: []byte{0x01}, Hashes: [][]byte{testdata.TX1Hash[:]}, Options: options, Tenant: "exchange-a", AddedAt: now}, 10)
+		require.NoError(t, err)
+
+		var storedOptions string
+		err = postgresDB.db.QueryRowContext(ctx, "SELECT options FROM metamorph.reverifications").Scan(&storedOptions)
+		require.NoError(t, err)
+		require.NotContains(t, storedOptions, "callback.example.com")
+		require.NotContains(t, storedOptions, "12345")
+
+		reverifications, err := encryptedDB.GetReverifications(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, reverifications, 1)
+		require.Equal(t, options, reverifications[0].Options)
+		require.Equal(t, "exchange-a", reverifications[0].Tenant)
+	})
+
+	t.Run("reverifications - erase client data", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		options := []byte(`{"callback_url":"https://callback.example.com"}`)
+		for _, reverification := range []store.Reverification{
+			{Mode: "defer", Beef: []byte{0x01}, Hashes: [][]byte{testdata.TX1Hash[:]}, Options: options, Tenant: "exchange-a", AddedAt: now},
+			{Mode: "defer", Beef: []byte{0x02}, Hashes: [][]byte{testdata.TX2Hash[:]}, Options: options, Tenant: "exchange-b", AddedAt: now},
+			{Mode: "defer", Beef: []byte{0x03}, Hashes: [][]byte{testdata.TX3Hash[:]}, Options: options, Tenant: "exchange-b", AddedAt: now},
+		} {
+			_, err := postgresDB.AddReverification(ctx, reverification, 10)
+			require.NoError(t, err)
+		}
+
+		_, err := postgresDB.EraseClientData(ctx, store.Erasure{Tenant: "exchange-a", ErasedBy: "ops-1", Reason: "request #42"})
+		require.NoError(t, err)
+		_, err = postgresDB.EraseClientData(ctx, store.Erasure{Hashes: [][]byte{testdata.TX2Hash[:]}, ErasedBy: "ops-1", Reason: "request #43"})
+		require.NoError(t, err)
+
+		// the reverifications are kept without the options containing the callbacks
+		reverifications, err := postgresDB.GetReverifications(ctx, now, now.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, reverifications, 3)
+		expectedOptions := map[string][]byte{string(testdata.TX1Hash[:]): {}, string(testdata.TX2Hash[:]): {}, string(testdata.TX3Hash[:]): options}
+		for _, reverification := range reverifications {
+			require.Equal(t, expectedOptions[string(reverification.Hashes[0])], reverification.Options)
+		}
+	})
+
 	t.Run("clear data", func(t *testing.T) {
 		defer pruneTables(t, postgresDB.db)
 		testutils.LoadFixtures(t, postgresDB.db, "fixtures/transactions")
@@ -1011,6 +1466,273 @@ func TestPostgresDB(t *testing.T) {
 		require.Equal(t, 13, numberOfRemainingTxs)
 	})
 
+	t.Run("clear data - keep quarantined rejected txs", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+		testutils.LoadFixtures(t, postgresDB.db, "fixtures/resubmit_rejected")
+
+		postgresDB.rejectedQuarantine = 72 * time.Hour
+		defer func() {
+			postgresDB.rejectedQuarantine = 0
+		}()
+
+		res, err := postgresDB.ClearData(ctx, 14)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), res)
+
+		quarantinedHash := testutils.RevChainhash(t, "cd3d2f97dfc0cdb6a07ec4b72df5e1794c9553ff2f62d90ed4add047e8088853")
+		_, err = postgresDB.Get(ctx, quarantinedHash[:])
+		require.NoError(t, err)
+	})
+
+	t.Run("resubmit rejected", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+		testutils.LoadFixtures(t, postgresDB.db, "fixtures/resubmit_rejected")
+
+		rejectedHash := testutils.RevChainhash(t, "cd3d2f97dfc0cdb6a07ec4b72df5e1794c9553ff2f62d90ed4add047e8088853")
+		minedHash := testutils.RevChainhash(t, "21132d32cb5411c058bb4391f24f6a36ed9b810df851d0e36cac514fd03d6b4e")
+
+		err := postgresDB.ResubmitRejected(ctx, rejectedHash)
+		require.NoError(t, err)
+
+		data, err := postgresDB.Get(ctx, rejectedHash[:])
+		require.NoError(t, err)
+		require.Equal(t, metamorph_api.Status_STORED, data.Status)
+		require.Empty(t, data.RejectReason)
+		require.Equal(t, 0, data.Retries)
+		require.Equal(t, "metamorph-1", data.LockedBy)
+		require.True(t, data.FullStatusUpdates)
+		require.Equal(t, time.Date(2023, 9, 1, 14, 0, 0, 0, time.UTC), data.StoredAt)
+		require.Equal(t, now, data.LastSubmittedAt)
+		require.Len(t, data.StatusHistory, 3)
+		require.Equal(t, metamorph_api.Status_STORED, data.StatusHistory[2].Status)
+
+		err = postgresDB.ResubmitRejected(ctx, minedHash)
+		require.ErrorIs(t, err, store.ErrNotFound)
+	})
+
+	t.Run("scheduled broadcast", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		broadcastAt := time.Date(2023, 10, 1, 15, 0, 0, 0, time.UTC)
+		for _, hash := range []*chainhash.Hash{testdata.TX1Hash, testdata.TX2Hash} {
+			err := postgresDB.Set(ctx, &store.Data{
+				Hash:            hash,
+				Status:          metamorph_api.Status_STORED,
+				LastSubmittedAt: now,
+				BroadcastAt:     broadcastAt,
+			})
+			require.NoError(t, err)
+		}
+
+		stored, err := postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Equal(t, broadcastAt, stored.BroadcastAt)
+
+		// scheduled transactions are not re-broadcast before they are due
+		unseen, err := postgresDB.GetUnseen(ctx, now.Add(-time.Hour), 10, 0)
+		require.NoError(t, err)
+		require.Empty(t, unseen)
+
+		due, err := postgresDB.GetDueScheduled(ctx, broadcastAt.Add(-time.Second), 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		err = postgresDB.CancelScheduled(ctx, testdata.TX2Hash, broadcastAt.Add(-time.Second))
+		require.NoError(t, err)
+		_, err = postgresDB.Get(ctx, testdata.TX2Hash[:])
+		require.ErrorIs(t, err, store.ErrNotFound)
+
+		due, err = postgresDB.GetDueScheduled(ctx, broadcastAt, 10)
+		require.NoError(t, err)
+		require.Len(t, due, 1)
+		require.Equal(t, testdata.TX1Hash, due[0].Hash)
+
+		// the transaction is only returned once and can no longer be cancelled
+		due, err = postgresDB.GetDueScheduled(ctx, broadcastAt, 10)
+		require.NoError(t, err)
+		require.Empty(t, due)
+
+		err = postgresDB.CancelScheduled(ctx, testdata.TX1Hash, broadcastAt.Add(-time.Second))
+		require.ErrorIs(t, err, store.ErrNotScheduled)
+		err = postgresDB.CancelScheduled(ctx, testdata.TX3Hash, broadcastAt.Add(-time.Second))
+		require.ErrorIs(t, err, store.ErrNotFound)
+	})
+
+	t.Run("announce to", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		err := postgresDB.Set(ctx, &store.Data{
+			Hash:            testdata.TX1Hash,
+			Status:          metamorph_api.Status_STORED,
+			LastSubmittedAt: now,
+			AnnounceTo:      "miner-a",
+		})
+		require.NoError(t, err)
+
+		err = postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now, AnnounceTo: "2"},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now},
+		})
+		require.NoError(t, err)
+
+		stored, err := postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Equal(t, "miner-a", stored.AnnounceTo)
+
+		// the restriction is kept for the re-announcements
+		unseen, err := postgresDB.GetUnseen(ctx, now.Add(-time.Hour), 10, 0)
+		require.NoError(t, err)
+		announceTo := make(map[string]string)
+		for _, data := range unseen {
+			announceTo[data.Hash.String()] = data.AnnounceTo
+		}
+		require.Equal(t, map[string]string{
+			testdata.TX1Hash.String(): "miner-a",
+			testdata.TX2Hash.String(): "2",
+			testdata.TX3Hash.String(): "",
+		}, announceTo)
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		expiresAt := now.Add(time.Hour)
+		err := postgresDB.Set(ctx, &store.Data{
+			Hash:            testdata.TX1Hash,
+			Status:          metamorph_api.Status_SEEN_ON_NETWORK,
+			LastSubmittedAt: now,
+			ExpiresAt:       expiresAt,
+		})
+		require.NoError(t, err)
+
+		err = postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_MINED, LastSubmittedAt: now, ExpiresAt: expiresAt},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now},
+		})
+		require.NoError(t, err)
+
+		stored, err := postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Equal(t, expiresAt, stored.ExpiresAt)
+
+		expired, err := postgresDB.GetExpired(ctx, expiresAt.Add(-time.Second), 10)
+		require.NoError(t, err)
+		require.Empty(t, expired)
+
+		// mined transactions and transactions without expiry do not expire
+		expired, err = postgresDB.GetExpired(ctx, expiresAt, 10)
+		require.NoError(t, err)
+		require.Equal(t, []*chainhash.Hash{testdata.TX1Hash}, expired)
+	})
+
+	t.Run("lock time", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		medianTimePast := time.Unix(1_700_000_000, 0)
+		err := postgresDB.Set(ctx, &store.Data{
+			Hash:            testdata.TX1Hash,
+			Status:          metamorph_api.Status_WAITING_FOR_FINALITY,
+			LastSubmittedAt: now,
+			LockTime:        800_000,
+		})
+		require.NoError(t, err)
+
+		err = postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_WAITING_FOR_FINALITY, LastSubmittedAt: now, LockTime: 1_700_000_000},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_WAITING_FOR_FINALITY, LastSubmittedAt: now, LockTime: 1_699_999_999, BroadcastAt: now.Add(time.Hour)},
+			{Hash: testdata.TX4Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now},
+		})
+		require.NoError(t, err)
+
+		stored, err := postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Equal(t, uint32(800_000), stored.LockTime)
+
+		// held transactions are not re-broadcast
+		unseen, err := postgresDB.GetUnseen(ctx, now.Add(-time.Hour), 10, 0)
+		require.NoError(t, err)
+		require.Len(t, unseen, 1)
+		require.Equal(t, testdata.TX4Hash, unseen[0].Hash)
+
+		final, err := postgresDB.GetFinal(ctx, 800_000, medianTimePast, now, 10)
+		require.NoError(t, err)
+		require.Empty(t, final)
+
+		// the scheduled transaction is final, but its broadcast time is not reached
+		final, err = postgresDB.GetFinal(ctx, 800_001, medianTimePast.Add(time.Second), now, 10)
+		require.NoError(t, err)
+		hashes := make([]string, 0, len(final))
+		for _, data := range final {
+			hashes = append(hashes, data.Hash.String())
+		}
+		require.ElementsMatch(t, []string{testdata.TX1Hash.String(), testdata.TX2Hash.String()}, hashes)
+
+		final, err = postgresDB.GetFinal(ctx, 800_001, medianTimePast.Add(time.Second), now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, final, 1)
+		require.Equal(t, testdata.TX3Hash, final[0].Hash)
+
+		stored, err = postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Zero(t, stored.LockTime)
+	})
+
+	t.Run("get mined in blocks", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		err := postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX1Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, LastSubmittedAt: now},
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_SEEN_ON_NETWORK, LastSubmittedAt: now},
+		})
+		require.NoError(t, err)
+
+		_, err = postgresDB.UpdateMined(ctx, []*blocktx_api.TransactionBlock{
+			{BlockHash: testdata.Block1Hash[:], BlockHeight: 100, TransactionHash: testdata.TX1Hash[:], MerklePath: "merkle-path-1"},
+			{BlockHash: testdata.Block2Hash[:], BlockHeight: 101, TransactionHash: testdata.TX2Hash[:], MerklePath: "merkle-path-2"},
+		})
+		require.NoError(t, err)
+
+		mined, err := postgresDB.GetMinedInBlocks(ctx, [][]byte{testdata.Block1Hash[:]})
+		require.NoError(t, err)
+		require.Equal(t, []*chainhash.Hash{testdata.TX1Hash}, mined)
+	})
+
+	t.Run("node submission", func(t *testing.T) {
+		defer pruneTables(t, postgresDB.db)
+
+		err := postgresDB.SetBulk(ctx, []*store.Data{
+			{Hash: testdata.TX1Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now.Add(-10 * time.Minute)},
+			{Hash: testdata.TX2Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now.Add(-10 * time.Minute)},
+			{Hash: testdata.TX3Hash, Status: metamorph_api.Status_STORED, LastSubmittedAt: now},
+		})
+		require.NoError(t, err)
+
+		_, err = postgresDB.UpdateStatus(ctx, []store.UpdateStatus{
+			{Hash: *testdata.TX1Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, Timestamp: now},
+			{Hash: *testdata.TX2Hash, Status: metamorph_api.Status_REQUESTED_BY_NETWORK, Timestamp: now},
+			{Hash: *testdata.TX3Hash, Status: metamorph_api.Status_ANNOUNCED_TO_NETWORK, Timestamp: now},
+		})
+		require.NoError(t, err)
+
+		// only the transaction announced before the timeout and not requested is returned
+		unrequested, err := postgresDB.GetUnrequested(ctx, now.Add(-time.Hour), now.Add(-time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, unrequested, 1)
+		require.Equal(t, testdata.TX1Hash, unrequested[0].Hash)
+
+		err = postgresDB.SetNodeSubmission(ctx, testdata.TX1Hash, store.NodeSubmission{SubmittedAt: now, RejectReason: "258: txn-mempool-conflict"})
+		require.NoError(t, err)
+
+		stored, err := postgresDB.Get(ctx, testdata.TX1Hash[:])
+		require.NoError(t, err)
+		require.Equal(t, &store.NodeSubmission{SubmittedAt: now, RejectReason: "258: txn-mempool-conflict"}, stored.NodeSubmission)
+
+		// a transaction is submitted to the node once
+		unrequested, err = postgresDB.GetUnrequested(ctx, now.Add(-time.Hour), now.Add(-time.Minute), 10)
+		require.NoError(t, err)
+		require.Empty(t, unrequested)
+	})
+
 	t.Run("get seen pending", func(t *testing.T) {
 		defer pruneTables(t, postgresDB.db)
 		testutils.LoadFixtures(t, postgresDB.db, "fixtures/get_seen_pending")
@@ -1136,3 +1858,100 @@ func TestPostgresDB(t *testing.T) {
 		postgresDB.now = func() time.Time { return now }
 	})
 }
+
+func TestConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	conformance.Run(t, func(t *testing.T) store.MetamorphStore {
+		postgresDB, err := New(dbInfo, "metamorph-1", 10, 10)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			pruneTables(t, postgresDB.db)
+			_ = postgresDB.Close(context.Background())
+		})
+
+		return postgresDB
+	})
+}
+
+func TestPartitionManager(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	// given
+	ctx := context.Background()
+
+	// the table is partitioned by the first maintenance, the partitions are created after the legacy partition, which
+	// covers the records until the end of the day after the conversion
+	firstDay := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 10)
+	now := firstDay.AddDate(0, 0, -1).Add(-time.Hour)
+
+	postgresDB, err := New(dbInfo, "metamorph-1", 10, 10, WithNow(func() time.Time { return now }), WithRejectedQuarantine(time.Hour), WithPartitioning())
+	require.NoError(t, err)
+	defer func() {
+		postgresDB.Close(ctx)
+	}()
+	defer pruneTables(t, postgresDB.db)
+
+	sut := NewPartitionManager(slog.Default(), postgresDB, 5, WithPartitionsAhead(3))
+
+	partitionExists := func(day time.Time) bool {
+		var exists bool
+		require.NoError(t, postgresDB.db.QueryRow(`SELECT TO_REGCLASS($1) IS NOT NULL`, "metamorph."+day.Format(partitionNameLayout)).Scan(&exists))
+		return exists
+	}
+
+	// when
+	err = sut.Maintain(ctx)
+
+	// then
+	require.NoError(t, err)
+	partitioned, err := postgresDB.IsPartitioned(ctx)
+	require.NoError(t, err)
+	require.True(t, partitioned)
+	require.True(t, partitionExists(firstDay))
+	require.True(t, partitionExists(firstDay.AddDate(0, 0, 1)))
+
+	expired := &store.Data{
+		Hash:            testdata.TX1Hash,
+		Status:          metamorph_api.Status_SEEN_ON_NETWORK,
+		StoredAt:        firstDay.Add(time.Hour),
+		LastSubmittedAt: firstDay.Add(time.Hour),
+	}
+	resubmitted := &store.Data{
+		Hash:            testdata.TX2Hash,
+		Status:          metamorph_api.Status_SEEN_ON_NETWORK,
+		StoredAt:        firstDay.Add(time.Hour),
+		LastSubmittedAt: firstDay.AddDate(0, 0, 4),
+	}
+	require.NoError(t, postgresDB.Set(ctx, expired))
+	require.NoError(t, postgresDB.Set(ctx, resubmitted))
+
+	// resubmission of a stored transaction updates the record instead of inserting another one
+	resubmitted.StoredAt = firstDay.AddDate(0, 0, 1).Add(time.Hour)
+	require.NoError(t, postgresDB.Set(ctx, resubmitted))
+
+	var count int
+	require.NoError(t, postgresDB.db.QueryRow(`SELECT COUNT(*) FROM metamorph.transactions WHERE hash = $1`, testdata.TX2Hash[:]).Scan(&count))
+	require.Equal(t, 1, count)
+
+	// when
+	now = firstDay.AddDate(0, 0, 8)
+	err = sut.Maintain(ctx)
+
+	// then
+	require.NoError(t, err)
+	require.False(t, partitionExists(firstDay))
+	require.False(t, partitionExists(firstDay.AddDate(0, 0, 1)))
+	require.True(t, partitionExists(firstDay.AddDate(0, 0, 9)))
+
+	_, err = postgresDB.Get(ctx, testdata.TX1Hash[:])
+	require.ErrorIs(t, err, store.ErrNotFound)
+
+	data, err := postgresDB.Get(ctx, testdata.TX2Hash[:])
+	require.NoError(t, err)
+	require.True(t, now.Equal(data.StoredAt))
+}
@@ -18,8 +18,10 @@ import (
 )
 
 var (
-	ErrNotFound        = errors.New("key could not be found")
-	ErrUpdateCompeting = fmt.Errorf("failed to updated competing transactions with status %s", metamorph_api.Status_REJECTED.String())
+	ErrNotFound            = errors.New("key could not be found")
+	ErrNotScheduled        = errors.New("transaction is not scheduled for broadcast")
+	ErrReverificationsFull = errors.New("maximum number of unverified transactions reached")
+	ErrUpdateCompeting     = fmt.Errorf("failed to updated competing transactions with status %s", metamorph_api.Status_REJECTED.String())
 )
 
 type Data struct {
@@ -40,12 +42,116 @@ type Data struct {
 	MerklePath        string
 	LastSubmittedAt   time.Time
 	Retries           int
+	// Consolidation is true if the transaction was classified as consolidation transaction on submission
+	Consolidation bool
+	// ReceivedAt and ValidatedAt are the times at which the API received and validated the transaction. They are
+	// zero if the transaction was not submitted through the API or its validation was skipped.
+	ReceivedAt  time.Time
+	ValidatedAt time.Time
+	// NormalizedHash is the hash of the transaction with empty unlocking scripts, it is only set if the detection of
+	// malleated transactions is enabled
+	NormalizedHash *chainhash.Hash
+	// MinedHash is the hash of the malleated variant of the transaction which was mined instead of the transaction
+	MinedHash *chainhash.Hash
+	// Tenant is the name of the tenant whose API key submitted the transaction, it is empty if no tenants are configured
+	Tenant string
+	// BroadcastAt is the time at which the transaction is announced to the network, it is zero if the transaction is
+	// announced as soon as it is stored
+	BroadcastAt time.Time
+	// ExpiresAt is the time after which the transaction expires if it is not mined, it is zero if the transaction does
+	// not expire
+	ExpiresAt time.Time
+	// LockTime is the lock time of a non-final transaction which is held in WAITING_FOR_FINALITY until it is final, it
+	// is zero if the transaction is not held
+	LockTime uint32
+	// AnnounceTo restricts the peers the transaction is announced to, either the number of peers or the name of a
+	// designated peer, it is empty if the transaction is announced to all peers
+	AnnounceTo string
+	// NodeSubmission is the submission of the transaction to the node after no peer requested it, it is nil if the
+	// transaction was not submitted to the node
+	NodeSubmission *NodeSubmission
+}
+
+// NodeSubmission records the submission of a transaction to the node with sendrawtransaction. Accepted is false and
+// RejectReason is the reason given by the node if the node rejected the transaction.
+type NodeSubmission struct {
+	SubmittedAt  time.Time
+	Accepted     bool
+	RejectReason string
 }
 
 type Callback struct {
 	CallbackURL   string `json:"callback_url"`
 	CallbackToken string `json:"callback_token"`
 	AllowBatch    bool   `json:"allow_batch"`
+	// CallbackVersion is the callback payload schema version requested by the client
+	CallbackVersion int32 `json:"callback_version,omitempty"`
+	// AllowDuplicates disables the deduplication of callbacks with the same status for this callback URL
+	AllowDuplicates bool `json:"allow_duplicates,omitempty"`
+	// SuppressionWindow is the window in seconds in which the intermediate statuses are coalesced for this callback URL
+	SuppressionWindow int32 `json:"suppression_window,omitempty"`
+}
+
+// SLAReport holds the SLA metrics of the transactions of a tenant which were stored in the daily or weekly period
+// starting at PeriodStart. The p95 times are measured from storing the transaction to its first seen and its mined
+// status, SeenOnNetwork and Mined are the numbers of transactions which reached these statuses.
+type SLAReport struct {
+	Period         string
+	PeriodStart    time.Time
+	Tenant         string
+	Transactions   int64
+	SeenOnNetwork  int64
+	P95TimeToSeen  time.Duration
+	Mined          int64
+	P95TimeToMined time.Duration
+	ComputedAt     time.Time
+}
+
+// PeerAck records the acknowledgment of the announcement of a transaction by a peer. RequestedAt is the time at which
+// the peer requested the transaction with GETDATA, SentAt the time at which the transaction was sent to the peer. They
+// are zero if the peer did not request or receive the transaction.
+type PeerAck struct {
+	Hash        chainhash.Hash
+	Peer        string
+	RequestedAt time.Time
+	SentAt      time.Time
+}
+
+// Annotation is a note and labels attached to a transaction by an operator. Author is the name of the admin token
+// with which the annotation was added.
+type Annotation struct {
+	Hash      chainhash.Hash
+	Note      string
+	Labels    []string
+	Author    string
+	CreatedAt time.Time
+}
+
+// Erasure is the erasure of the client data, i.e. the callback URLs and tokens, of the transactions of a tenant or with
+// the given hashes, both have to match if both are given. The transactions themselves are kept. Every erasure is
+// recorded as audit trail, Transactions is the number of transactions whose client data was erased.
+type Erasure struct {
+	ID           int64
+	Tenant       string
+	Hashes       [][]byte
+	ErasedBy     string
+	Reason       string
+	ErasedAt     time.Time
+	Transactions int64
+}
+
+// Reverification is a BEEF whose Merkle roots could not be verified on submission and are verified again by the API.
+// Mode is the degraded mode by which its transactions were accepted or deferred, Hashes are the hashes of its submitted
+// transactions and Options the options, as encoded by the API, with which deferred transactions are submitted. The
+// options contain the callbacks and are erased with the client data of the tenant.
+type Reverification struct {
+	ID      int64
+	Mode    string
+	Beef    []byte
+	Hashes  [][]byte
+	Options []byte
+	Tenant  string
+	AddedAt time.Time
 }
 
 type StatusWithTimestamp struct {
@@ -84,6 +190,8 @@ type MetamorphStore interface {
 	GetUnseen(ctx context.Context, since time.Time, limit int64, offset int64) ([]*Data, error)
 	GetSeenPending(ctx context.Context, lastSubmittedSince time.Duration, confirmedAgo time.Duration, seenAgo time.Duration, limit int64, offset int64) ([]*Data, error)
 	GetSeen(ctx context.Context, fromDuration time.Duration, toDuration time.Duration, limit int64, offset int64) (res []*Data, err error)
+	GetStoredBetween(ctx context.Context, from time.Time, to time.Time, limit int64, offset int64) (res []*Data, err error)
+	GetExport(ctx context.Context, filter ExportFilter, limit int64) (res []*Data, err error)
 	UpdateStatus(ctx context.Context, updates []UpdateStatus) ([]*Data, error)
 	UpdateStatusHistory(ctx context.Context, updates []UpdateStatus) (res []*Data, err error)
 	UpdateMined(ctx context.Context, txsBlocks []*blocktx_api.TransactionBlock) ([]*Data, error)
@@ -91,16 +199,53 @@ type MetamorphStore interface {
 	UpdateDoubleSpend(ctx context.Context, updates []UpdateStatus, updateCompetingTxs bool) ([]*Data, error)
 	Close(ctx context.Context) error
 	ClearData(ctx context.Context, retentionDays int32) (int64, error)
+	ResubmitRejected(ctx context.Context, hash *chainhash.Hash) error
+	GetDueScheduled(ctx context.Context, now time.Time, limit int64) ([]*Data, error)
+	CancelScheduled(ctx context.Context, hash *chainhash.Hash, now time.Time) error
+	GetUnrequested(ctx context.Context, since time.Time, announcedBefore time.Time, limit int64) ([]*Data, error)
+	GetExpired(ctx context.Context, now time.Time, limit int64) ([]*chainhash.Hash, error)
+	GetFinal(ctx context.Context, nextHeight uint64, medianTimePast time.Time, now time.Time, limit int64) ([]*Data, error)
+	GetMinedInBlocks(ctx context.Context, blockHashes [][]byte) ([]*chainhash.Hash, error)
+	SetNodeSubmission(ctx context.Context, hash *chainhash.Hash, submission NodeSubmission) error
 	Ping(ctx context.Context) error
 
 	GetStats(ctx context.Context, since time.Time, notSeenLimit time.Duration, notMinedLimit time.Duration) (*Stats, error)
 	GetRawTxs(ctx context.Context, hashes [][]byte) ([][]byte, error)
+	ComputeSLAReports(ctx context.Context, period string, from time.Time, to time.Time) (int64, error)
+	GetSLAReports(ctx context.Context, period string, from time.Time, to time.Time, tenant string) ([]SLAReport, error)
+	GetChildHashes(ctx context.Context, hashes [][]byte) ([][]byte, error)
+	GetHashes(ctx context.Context, after []byte, limit int64) ([][]byte, error)
+	SetPeerAcks(ctx context.Context, acks []PeerAck) error
+	GetPeerAcks(ctx context.Context, hashes [][]byte) ([]PeerAck, error)
+	AddAnnotation(ctx context.Context, annotation Annotation) (Annotation, error)
+	GetAnnotations(ctx context.Context, hashes [][]byte) ([]Annotation, error)
+	EraseClientData(ctx context.Context, erasure Erasure) (Erasure, error)
+	GetErasures(ctx context.Context, limit int64) ([]Erasure, error)
+	AddReverification(ctx context.Context, reverification Reverification, maxTransactions int64) (Reverification, error)
+	GetReverifications(ctx context.Context, now time.Time, lockedUntil time.Time, limit int64) ([]Reverification, error)
+	DelReverification(ctx context.Context, id int64) error
+	GetUnverified(ctx context.Context, hashes [][]byte) ([][]byte, error)
+	CountUnverified(ctx context.Context) (int64, error)
 
 	SetRequested(ctx context.Context, hashes []*chainhash.Hash) error
 	GetUnconfirmedRequested(ctx context.Context, requestedAgo time.Duration, limit int64, offset int64) ([]*chainhash.Hash, error)
 	MarkConfirmedRequested(ctx context.Context, hash *chainhash.Hash) error
 }
 
+// ExportFilter selects the transactions which are exported in bulk. The transactions are ordered by the time at which
+// they were stored and their hash, so that an export can be continued after the last exported transaction.
+type ExportFilter struct {
+	From time.Time
+	To   time.Time
+	// Statuses and Tenant are not applied if they are empty
+	Statuses []metamorph_api.Status
+	Tenant   string
+	// AfterStoredAt and AfterHash are the position of the last exported transaction, the export starts with the first
+	// transaction stored since From if AfterHash is nil
+	AfterStoredAt time.Time
+	AfterHash     *chainhash.Hash
+}
+
 type UpdateStatus struct {
 	Hash          chainhash.Hash        `json:"-"`
 	Status        metamorph_api.Status  `json:"status"`
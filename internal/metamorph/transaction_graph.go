@@ -0,0 +1,326 @@
+package metamorph
+
+import (
+	"context"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/store"
+)
+
+const (
+	graphMaxNodesDefault = 100
+	graphMaxNodesLimit   = 1000
+)
+
+// TransactionGraph is a transaction with its unmined ancestors and descendants known to metamorph.
+type TransactionGraph struct {
+	TxID string
+	// Nodes holds the transaction itself first, followed by its ancestors and its descendants
+	Nodes []*TransactionGraphNode
+	// Truncated is true if the graph has more transactions than the maximum number of transactions requested
+	Truncated bool
+}
+
+type TransactionGraphNode struct {
+	TxID         string
+	Status       string
+	RejectReason string
+	Size         uint64
+	// Fee is only set if FeeKnown is true, i.e. the values of all outputs spent by the transaction are known
+	Fee      uint64
+	FeeKnown bool
+	// Parents and Children are the IDs of the transactions of the graph which are spent by and spend the transaction
+	Parents  []string
+	Children []string
+}
+
+func transactionGraphFromProto(graph *metamorph_api.TransactionGraph) *TransactionGraph {
+	result := &TransactionGraph{
+		TxID:      graph.GetTxid(),
+		Nodes:     make([]*TransactionGraphNode, 0, len(graph.GetNodes())),
+		Truncated: graph.GetTruncated(),
+	}
+
+	for _, node := range graph.GetNodes() {
+		result.Nodes = append(result.Nodes, &TransactionGraphNode{
+			TxID:         node.GetTxid(),
+			Status:       node.GetStatus().String(),
+			RejectReason: node.GetRejectReason(),
+			Size:         node.GetSize(),
+			Fee:          node.GetFee(),
+			FeeKnown:     node.GetFeeKnown(),
+			Parents:      node.GetParents(),
+			Children:     node.GetChildren(),
+		})
+	}
+
+	return result
+}
+
+type graphNode struct {
+	data *store.Data
+	// tx is nil if the raw transaction is not stored or cannot be parsed
+	tx *sdkTx.Transaction
+}
+
+// transactionGraph collects the unmined ancestors and descendants of a transaction which are stored by metamorph.
+type transactionGraph struct {
+	store    store.MetamorphStore
+	maxNodes int
+
+	nodes     map[chainhash.Hash]*graphNode
+	order     []chainhash.Hash
+	truncated bool
+	// spent holds the parsed transactions whose outputs are spent by nodes of the graph, including the mined ones
+	spent map[chainhash.Hash]*sdkTx.Transaction
+}
+
+func newGraphNode(data *store.Data) *graphNode {
+	node := &graphNode{data: data}
+
+	if len(data.RawTx) > 0 {
+		tx, err := sdkTx.NewTransactionFromBytes(data.RawTx)
+		if err == nil {
+			node.tx = tx
+		}
+	}
+
+	return node
+}
+
+// buildTransactionGraph walks from the root transaction to the unmined transactions whose outputs it spends and to the
+// unmined transactions which spend its outputs, level by level until no further transactions are found or the graph
+// has the maximum number of transactions.
+func buildTransactionGraph(ctx context.Context, s store.MetamorphStore, root *store.Data, maxNodes int) (*metamorph_api.TransactionGraph, error) {
+	g := &transactionGraph{
+		store:    s,
+		maxNodes: maxNodes,
+		nodes:    make(map[chainhash.Hash]*graphNode),
+		spent:    make(map[chainhash.Hash]*sdkTx.Transaction),
+	}
+
+	rootNode := newGraphNode(root)
+	g.add(rootNode)
+
+	err := g.walkAncestors(ctx, rootNode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.walkDescendants(ctx, rootNode)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.toProto(root.Hash), nil
+}
+
+func (g *transactionGraph) add(node *graphNode) bool {
+	if len(g.order) >= g.maxNodes {
+		g.truncated = true
+		return false
+	}
+
+	g.nodes[*node.data.Hash] = node
+	g.order = append(g.order, *node.data.Hash)
+
+	return true
+}
+
+func (g *transactionGraph) walkAncestors(ctx context.Context, root *graphNode) error {
+	level := []*graphNode{root}
+
+	for len(level) > 0 && !g.truncated {
+		keys := make([][]byte, 0)
+		requested := make(map[chainhash.Hash]struct{})
+		for _, node := range level {
+			for _, parent := range parentHashes(node.tx) {
+				_, isSpent := g.spent[parent]
+				_, isRequested := requested[parent]
+				if isSpent || isRequested {
+					continue
+				}
+				requested[parent] = struct{}{}
+				keys = append(keys, parent.CloneBytes())
+			}
+		}
+
+		if len(keys) == 0 {
+			return nil
+		}
+
+		parents, err := g.store.GetMany(ctx, keys)
+		if err != nil {
+			return err
+		}
+
+		level = level[:0]
+		for _, data := range parents {
+			node := newGraphNode(data)
+			g.spent[*data.Hash] = node.tx
+
+			_, exists := g.nodes[*data.Hash]
+			if exists || data.Status == metamorph_api.Status_MINED {
+				continue
+			}
+
+			if !g.add(node) {
+				break
+			}
+			level = append(level, node)
+		}
+	}
+
+	return nil
+}
+
+func (g *transactionGraph) walkDescendants(ctx context.Context, root *graphNode) error {
+	level := []*graphNode{root}
+
+	for len(level) > 0 && !g.truncated {
+		keys := make([][]byte, 0, len(level))
+		for _, node := range level {
+			keys = append(keys, node.data.Hash.CloneBytes())
+		}
+
+		childHashes, err := g.store.GetChildHashes(ctx, keys)
+		if err != nil {
+			return err
+		}
+
+		childKeys := make([][]byte, 0, len(childHashes))
+		for _, hash := range childHashes {
+			h, err := chainhash.NewHash(hash)
+			if err != nil {
+				return err
+			}
+			if _, exists := g.nodes[*h]; !exists {
+				childKeys = append(childKeys, hash)
+			}
+		}
+
+		if len(childKeys) == 0 {
+			return nil
+		}
+
+		children, err := g.store.GetMany(ctx, childKeys)
+		if err != nil {
+			return err
+		}
+
+		level = level[:0]
+		for _, data := range children {
+			if data.Status == metamorph_api.Status_MINED {
+				continue
+			}
+
+			node := newGraphNode(data)
+			if !g.add(node) {
+				break
+			}
+			level = append(level, node)
+		}
+	}
+
+	return nil
+}
+
+func (g *transactionGraph) toProto(rootHash *chainhash.Hash) *metamorph_api.TransactionGraph {
+	children := make(map[chainhash.Hash][]string)
+	parents := make(map[chainhash.Hash][]string)
+
+	for _, hash := range g.order {
+		for _, parent := range parentHashes(g.nodes[hash].tx) {
+			if _, ok := g.nodes[parent]; ok {
+				parents[hash] = append(parents[hash], parent.String())
+				children[parent] = append(children[parent], hash.String())
+			}
+		}
+	}
+
+	graph := &metamorph_api.TransactionGraph{
+		Txid:      rootHash.String(),
+		Nodes:     make([]*metamorph_api.TransactionGraphNode, 0, len(g.order)),
+		Truncated: g.truncated,
+	}
+
+	for _, hash := range g.order {
+		node := g.nodes[hash]
+		protoNode := &metamorph_api.TransactionGraphNode{
+			Txid:         hash.String(),
+			Status:       node.data.Status,
+			RejectReason: node.data.RejectReason,
+			Parents:      parents[hash],
+			Children:     children[hash],
+		}
+
+		if node.tx != nil {
+			protoNode.Size = uint64(node.tx.Size()) // #nosec G115
+			protoNode.Fee, protoNode.FeeKnown = g.fee(node.tx)
+		}
+
+		graph.Nodes = append(graph.Nodes, protoNode)
+	}
+
+	return graph
+}
+
+// fee returns the fee paid by the transaction if the values of all outputs spent by the transaction are known, either
+// from the extended format of the transaction or from the stored parent transactions.
+func (g *transactionGraph) fee(tx *sdkTx.Transaction) (uint64, bool) {
+	var inputs uint64
+	for _, input := range tx.Inputs {
+		satoshis := input.SourceTxSatoshis()
+		if satoshis == nil && input.SourceTXID != nil {
+			parent := g.spent[chainhash.Hash(*input.SourceTXID)]
+			if parent == nil {
+				if node, ok := g.nodes[chainhash.Hash(*input.SourceTXID)]; ok {
+					parent = node.tx
+				}
+			}
+
+			if parent != nil && int(input.SourceTxOutIndex) < len(parent.Outputs) {
+				satoshis = &parent.Outputs[input.SourceTxOutIndex].Satoshis
+			}
+		}
+
+		if satoshis == nil {
+			return 0, false
+		}
+		inputs += *satoshis
+	}
+
+	outputs := tx.TotalOutputSatoshis()
+	if inputs < outputs {
+		return 0, false
+	}
+
+	return inputs - outputs, true
+}
+
+// parentHashes returns the unique hashes of the transactions whose outputs are spent by the transaction.
+func parentHashes(tx *sdkTx.Transaction) []chainhash.Hash {
+	if tx == nil {
+		return nil
+	}
+
+	hashes := make([]chainhash.Hash, 0, len(tx.Inputs))
+	seen := make(map[chainhash.Hash]struct{}, len(tx.Inputs))
+	for _, input := range tx.Inputs {
+		if input.SourceTXID == nil {
+			continue
+		}
+
+		hash := chainhash.Hash(*input.SourceTXID)
+		if _, ok := seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		hashes = append(hashes, hash)
+	}
+
+	return hashes
+}
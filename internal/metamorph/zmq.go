@@ -13,9 +13,11 @@ import (
 
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 	"github.com/bitcoin-sv/arc/internal/logger"
 	"github.com/bitcoin-sv/arc/internal/metamorph/bcnet/metamorph_p2p"
 	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/node_reject"
 )
 
 var allowedTopics = []string{
@@ -192,11 +194,11 @@ func (z *ZMQ) handleInvalidTx(msg []string) (hash *chainhash.Hash, status metamo
 
 	errReason := "invalid transaction"
 	if txInfo.RejectionReason != "" {
-		errReason += ": " + txInfo.RejectionReason
+		errReason = txInfo.RejectionReason
 	}
 	z.logger.Debug("invalidtx", slog.String("hash", txInfo.TxID), slog.String("reason", errReason))
 
-	txErr = errors.New(errReason)
+	txErr = node_reject.New(node_reject.SourceNode, txInfo.RejectionCode, errReason)
 	return
 }
 
@@ -305,7 +307,7 @@ func (z *ZMQ) parseDiscardedInfo(c []string) (*ZMQDiscardFromMempool, error) {
 
 func (z *ZMQ) processHashTxTopic(ctx context.Context, hashtxTopic string, c []string) {
 	z.logger.Log(ctx, logger.LevelTrace, hashtxTopic, slog.String("hash", c[1]))
-	hash, err := chainhash.NewHashFromStr(c[1])
+	hash, err := hexutils.NewHashFromTxID(c[1])
 	if err != nil {
 		z.logger.Error("failed to get hash from string", slog.String("topic", hashtxTopic), slog.String("err", err.Error()))
 		return
@@ -3,7 +3,6 @@ package metamorph
 import (
 	"context"
 	"encoding/binary"
-	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -12,6 +11,8 @@ import (
 	"time"
 
 	"github.com/go-zeromq/zmq4"
+
+	"github.com/bitcoin-sv/arc/internal/hexutils"
 )
 
 type ZMQHandler struct {
@@ -24,6 +25,7 @@ type ZMQHandler struct {
 	removeSubscription chan subscriptionRequest
 	logger             *slog.Logger
 	refreshRate        time.Duration
+	done               chan struct{}
 }
 
 func NewZMQHandler(ctx context.Context, zmqURL *url.URL, logger *slog.Logger) *ZMQHandler {
@@ -37,6 +39,7 @@ func NewZMQHandlerWithRefreshRate(ctx context.Context, zmqURL *url.URL, logger *
 		removeSubscription: make(chan subscriptionRequest, 10),
 		logger:             logger.With(slog.String("module", "zmq-handler")),
 		refreshRate:        refreshRate,
+		done:               make(chan struct{}),
 	}
 
 	go zmq.start(ctx)
@@ -44,7 +47,20 @@ func NewZMQHandlerWithRefreshRate(ctx context.Context, zmqURL *url.URL, logger *
 	return zmq
 }
 
+// Done is closed once the handler stopped listening, because its context was canceled or because of an error
+// returned by Err.
+func (zmqHandler *ZMQHandler) Done() <-chan struct{} {
+	return zmqHandler.done
+}
+
+// Err returns the error which stopped the handler once Done is closed.
+func (zmqHandler *ZMQHandler) Err() error {
+	return zmqHandler.err
+}
+
 func (zmqHandler *ZMQHandler) start(ctx context.Context) {
+	defer close(zmqHandler.done)
+
 	for {
 		zmqHandler.socket = zmq4.NewSub(ctx, zmq4.WithID(zmq4.SocketIdentity("sub")))
 		defer func() {
@@ -60,7 +76,9 @@ func (zmqHandler *ZMQHandler) start(ctx context.Context) {
 			zmqHandler.err = err
 			zmqHandler.logger.Error("Could not dial ZMQ", slog.String("address", zmqHandler.address), slog.String("error", err.Error()))
 			zmqHandler.logger.Info("Attempting to re-establish ZMQ connection in ...", slog.Duration("refreshRate", zmqHandler.refreshRate))
-			time.Sleep(zmqHandler.refreshRate)
+			if !zmqHandler.waitRefresh(ctx) {
+				return
+			}
 			continue
 		}
 
@@ -76,10 +94,25 @@ func (zmqHandler *ZMQHandler) start(ctx context.Context) {
 
 		err := zmqHandler.checkZMQHandlerCases(ctx)
 		if err != nil {
+			zmqHandler.err = err
 			return
 		}
 
 		zmqHandler.checkConnection()
+		if !zmqHandler.waitRefresh(ctx) {
+			return
+		}
+	}
+}
+
+// waitRefresh waits for the refresh rate before reconnecting and returns false if the context was canceled meanwhile.
+func (zmqHandler *ZMQHandler) waitRefresh(ctx context.Context) bool {
+	select {
+	case <-ctx.Done():
+		zmqHandler.err = ctx.Err()
+		return false
+	case <-time.After(zmqHandler.refreshRate):
+		return true
 	}
 }
 
@@ -181,7 +214,7 @@ func (zmqHandler *ZMQHandler) receiveMessage() error {
 	}
 
 	for _, subscriber := range subscribers {
-		subscriber <- []string{string(msg.Frames[0]), hex.EncodeToString(msg.Frames[1]), sequence}
+		subscriber <- []string{string(msg.Frames[0]), hexutils.EncodeToString(msg.Frames[1]), sequence}
 	}
 	return nil
 }
@@ -194,5 +227,4 @@ func (zmqHandler *ZMQHandler) checkConnection() {
 		zmqHandler.connected = false
 	}
 	zmqHandler.logger.Info("Attempting to re-establish ZMQ connection in ...", slog.Duration("refreshRate", zmqHandler.refreshRate))
-	time.Sleep(zmqHandler.refreshRate)
 }
@@ -0,0 +1,83 @@
+// Package metrics emits the metrics of ARC to a metrics backend. The services collect their metrics with the
+// Prometheus client in the default registry, the backend either serves them to be scraped by Prometheus or pushes them
+// to a StatsD or DogStatsD agent, e.g. the Datadog agent.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	BackendPrometheus = "prometheus"
+	BackendStatsD     = "statsd"
+	BackendDogStatsD  = "dogstatsd"
+
+	shutdownTimeout = 5 * time.Second
+)
+
+var ErrUnknownBackend = errors.New("unknown metrics backend")
+
+// Backend emits the metrics gathered from the Prometheus registry until it is shut down.
+type Backend interface {
+	Start() error
+	Shutdown()
+}
+
+// PrometheusBackend serves the metrics at the endpoint to be scraped by Prometheus.
+type PrometheusBackend struct {
+	logger *slog.Logger
+	server *http.Server
+}
+
+func NewPrometheusBackend(logger *slog.Logger, gatherer prometheus.Gatherer, addr string, endpoint string) *PrometheusBackend {
+	mux := http.NewServeMux()
+	mux.Handle(endpoint, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+
+	return &PrometheusBackend{
+		logger: logger.With(slog.String("module", "metrics"), slog.String("backend", BackendPrometheus)),
+		server: &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: 10 * time.Second},
+	}
+}
+
+func (b *PrometheusBackend) Start() error {
+	b.logger.Info("Starting prometheus", slog.String("addr", b.server.Addr))
+
+	go func() {
+		err := b.server.ListenAndServe()
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			b.logger.Error("failed to start prometheus server", slog.String("err", err.Error()))
+		}
+	}()
+
+	return nil
+}
+
+func (b *PrometheusBackend) Shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	err := b.server.Shutdown(ctx)
+	if err != nil {
+		b.logger.Error("failed to shutdown prometheus server", slog.String("err", err.Error()))
+	}
+}
+
+// ParseBackend validates the name of a backend, an empty name selects Prometheus.
+func ParseBackend(name string) (string, error) {
+	switch name {
+	case "", BackendPrometheus:
+		return BackendPrometheus, nil
+	case BackendStatsD, BackendDogStatsD:
+		return name, nil
+	default:
+		return "", errors.Join(ErrUnknownBackend, fmt.Errorf("backend %q", name))
+	}
+}
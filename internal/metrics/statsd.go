@@ -0,0 +1,282 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	pushIntervalDefault = 10 * time.Second
+
+	// maxPacketSize keeps the datagrams below the MTU of common networks, the lines of a push are split into as many
+	// datagrams as needed
+	maxPacketSize = 1432
+)
+
+// StatsDBackend periodically gathers the metrics from the Prometheus registry and pushes them to a StatsD agent over
+// UDP. Gauges are sent as gauges and counters as the increase since the last push. Histograms and summaries are sent
+// as the increase of their count and sum, the quantiles of summaries as gauges.
+//
+// Plain StatsD has no tags, so the values of the labels of a metric are appended to its name, e.g.
+// arc_scheduler_job_runs_total.ReAnnounceSeen.manual. With DogStatsD the labels are sent as tags instead, together with
+// the configured tags.
+type StatsDBackend struct {
+	logger    *slog.Logger
+	gatherer  prometheus.Gatherer
+	addr      string
+	prefix    string
+	interval  time.Duration
+	dogStatsD bool
+	tags      []string
+
+	conn net.Conn
+	// last holds the last pushed values of the counters by series
+	last map[string]float64
+
+	cancelAll context.CancelFunc
+	ctx       context.Context
+	waitGroup *sync.WaitGroup
+}
+
+// WithPrefix prepends the prefix to the names of the metrics, e.g. "arc.".
+func WithPrefix(prefix string) func(*StatsDBackend) {
+	return func(b *StatsDBackend) {
+		b.prefix = prefix
+	}
+}
+
+func WithPushInterval(d time.Duration) func(*StatsDBackend) {
+	return func(b *StatsDBackend) {
+		b.interval = d
+	}
+}
+
+// WithDogStatsD sends the metrics in the DogStatsD format with the labels of the metrics and the given tags, e.g.
+// "env:prod", as tags.
+func WithDogStatsD(tags []string) func(*StatsDBackend) {
+	return func(b *StatsDBackend) {
+		b.dogStatsD = true
+		b.tags = tags
+	}
+}
+
+func NewStatsDBackend(logger *slog.Logger, gatherer prometheus.Gatherer, addr string, opts ...func(*StatsDBackend)) *StatsDBackend {
+	b := &StatsDBackend{
+		logger:    logger.With(slog.String("module", "metrics")),
+		gatherer:  gatherer,
+		addr:      addr,
+		interval:  pushIntervalDefault,
+		last:      make(map[string]float64),
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	b.ctx, b.cancelAll = context.WithCancel(context.Background())
+
+	return b
+}
+
+func (b *StatsDBackend) Start() error {
+	conn, err := net.Dial("udp", b.addr)
+	if err != nil {
+		return err
+	}
+	b.conn = conn
+
+	b.logger.Info("Pushing metrics to StatsD", slog.String("addr", b.addr), slog.Bool("dogstatsd", b.dogStatsD), slog.String("interval", b.interval.String()))
+
+	b.waitGroup.Add(1)
+	go func() {
+		defer b.waitGroup.Done()
+
+		ticker := time.NewTicker(b.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-b.ctx.Done():
+				return
+			case <-ticker.C:
+				b.push()
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *StatsDBackend) push() {
+	// the registry returns the metrics it could gather together with the errors of the failed collectors
+	families, err := b.gatherer.Gather()
+	if err != nil {
+		b.logger.Warn("Failed to gather some metrics", slog.String("err", err.Error()))
+	}
+
+	for _, packet := range packets(b.lines(families), maxPacketSize) {
+		_, err = b.conn.Write(packet)
+		if err != nil {
+			b.logger.Warn("Failed to push metrics", slog.String("err", err.Error()))
+			return
+		}
+	}
+}
+
+// lines returns the StatsD lines of the metrics and remembers the values of the counters for the next push.
+func (b *StatsDBackend) lines(families []*dto.MetricFamily) []string {
+	lines := make([]string, 0, len(families))
+
+	for _, family := range families {
+		name := family.GetName()
+
+		for _, m := range family.GetMetric() {
+			labels := m.GetLabel()
+
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				lines = b.appendCounter(lines, name, labels, m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				lines = b.appendLine(lines, name, labels, m.GetGauge().GetValue(), "g")
+			case dto.MetricType_UNTYPED:
+				lines = b.appendLine(lines, name, labels, m.GetUntyped().GetValue(), "g")
+			case dto.MetricType_HISTOGRAM, dto.MetricType_GAUGE_HISTOGRAM:
+				lines = b.appendCounter(lines, name+".count", labels, float64(m.GetHistogram().GetSampleCount()))
+				lines = b.appendCounter(lines, name+".sum", labels, m.GetHistogram().GetSampleSum())
+			case dto.MetricType_SUMMARY:
+				lines = b.appendCounter(lines, name+".count", labels, float64(m.GetSummary().GetSampleCount()))
+				lines = b.appendCounter(lines, name+".sum", labels, m.GetSummary().GetSampleSum())
+				for _, q := range m.GetSummary().GetQuantile() {
+					quantile := strconv.FormatFloat(q.GetQuantile(), 'f', -1, 64)
+					quantileLabels := append(slices.Clone(labels), &dto.LabelPair{Name: ptr("quantile"), Value: &quantile})
+					lines = b.appendLine(lines, name+".quantile", quantileLabels, q.GetValue(), "g")
+				}
+			}
+		}
+	}
+
+	return lines
+}
+
+// appendCounter appends the increase of the counter since the last push. A counter which decreased has been reset,
+// e.g. because its collector was registered again, its whole value is the increase.
+func (b *StatsDBackend) appendCounter(lines []string, name string, labels []*dto.LabelPair, value float64) []string {
+	key := seriesKey(name, labels)
+	last := b.last[key]
+	b.last[key] = value
+
+	delta := value - last
+	if delta < 0 {
+		delta = value
+	}
+
+	if delta == 0 {
+		return lines
+	}
+
+	return b.appendLine(lines, name, labels, delta, "c")
+}
+
+func (b *StatsDBackend) appendLine(lines []string, name string, labels []*dto.LabelPair, value float64, metricType string) []string {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return lines
+	}
+
+	var sb strings.Builder
+	sb.WriteString(sanitize(b.prefix + name))
+	if !b.dogStatsD {
+		for _, label := range labels {
+			sb.WriteString(".")
+			sb.WriteString(sanitize(label.GetValue()))
+		}
+	}
+
+	sb.WriteString(":")
+	sb.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	sb.WriteString("|")
+	sb.WriteString(metricType)
+
+	if b.dogStatsD && (len(b.tags) > 0 || len(labels) > 0) {
+		tags := make([]string, 0, len(b.tags)+len(labels))
+		tags = append(tags, b.tags...)
+		for _, label := range labels {
+			tags = append(tags, sanitize(label.GetName())+":"+sanitize(label.GetValue()))
+		}
+		sb.WriteString("|#")
+		sb.WriteString(strings.Join(tags, ","))
+	}
+
+	return append(lines, sb.String())
+}
+
+func (b *StatsDBackend) Shutdown() {
+	b.cancelAll()
+	b.waitGroup.Wait()
+
+	if b.conn != nil {
+		_ = b.conn.Close()
+	}
+}
+
+// packets joins the lines to datagrams of at most maxSize bytes, a line longer than maxSize is sent on its own.
+func packets(lines []string, maxSize int) [][]byte {
+	var result [][]byte
+	var packet []byte
+
+	for _, line := range lines {
+		if len(packet) > 0 && len(packet)+1+len(line) > maxSize {
+			result = append(result, packet)
+			packet = nil
+		}
+
+		if len(packet) > 0 {
+			packet = append(packet, '\n')
+		}
+		packet = append(packet, line...)
+	}
+
+	if len(packet) > 0 {
+		result = append(result, packet)
+	}
+
+	return result
+}
+
+func seriesKey(name string, labels []*dto.LabelPair) string {
+	var sb strings.Builder
+	sb.WriteString(name)
+	for _, label := range labels {
+		sb.WriteString("|")
+		sb.WriteString(label.GetName())
+		sb.WriteString("=")
+		sb.WriteString(label.GetValue())
+	}
+
+	return sb.String()
+}
+
+// sanitize replaces the characters which separate the fields of a StatsD line.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '|', '@', '#', ',', '\n', ' ':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,124 @@
+package metrics
+
+import (
+	"log/slog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsDBackend_Lines(t *testing.T) {
+	tt := []struct {
+		name string
+		opts []func(*StatsDBackend)
+
+		expectedFirst  []string
+		expectedSecond []string
+	}{
+		{
+			name: "statsd",
+			opts: []func(*StatsDBackend){WithPrefix("arc.")},
+
+			expectedFirst: []string{
+				"arc.test_duration_seconds.count.job-1:2|c",
+				"arc.test_duration_seconds.sum.job-1:1.5|c",
+				"arc.test_queue_length:7|g",
+				"arc.test_runs_total.job-1.manual:3|c",
+			},
+			expectedSecond: []string{
+				"arc.test_queue_length:7|g",
+				"arc.test_runs_total.job-1.manual:2|c",
+			},
+		},
+		{
+			name: "dogstatsd",
+			opts: []func(*StatsDBackend){WithDogStatsD([]string{"env:test"})},
+
+			expectedFirst: []string{
+				"test_duration_seconds.count:2|c|#env:test,job:job-1",
+				"test_duration_seconds.sum:1.5|c|#env:test,job:job-1",
+				"test_queue_length:7|g|#env:test",
+				"test_runs_total:3|c|#env:test,job:job-1,trigger:manual",
+			},
+			expectedSecond: []string{
+				"test_queue_length:7|g|#env:test",
+				"test_runs_total:2|c|#env:test,job:job-1,trigger:manual",
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			registry := prometheus.NewRegistry()
+			runs := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "test_runs_total"}, []string{"job", "trigger"})
+			queueLength := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_queue_length"})
+			duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "test_duration_seconds"}, []string{"job"})
+			registry.MustRegister(runs, queueLength, duration)
+
+			runs.WithLabelValues("job-1", "manual").Add(3)
+			queueLength.Set(7)
+			duration.WithLabelValues("job-1").Observe(0.5)
+			duration.WithLabelValues("job-1").Observe(1)
+
+			sut := NewStatsDBackend(slog.Default(), registry, "localhost:8125", tc.opts...)
+
+			// when
+			families, err := registry.Gather()
+			require.NoError(t, err)
+			first := sut.lines(families)
+
+			runs.WithLabelValues("job-1", "manual").Add(2)
+			families, err = registry.Gather()
+			require.NoError(t, err)
+			second := sut.lines(families)
+
+			// then
+			assert.Equal(t, tc.expectedFirst, first)
+			assert.Equal(t, tc.expectedSecond, second)
+		})
+	}
+}
+
+func TestStatsDBackend_Push(t *testing.T) {
+	// given
+	listener, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	registry := prometheus.NewRegistry()
+	queueLength := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_queue_length"})
+	registry.MustRegister(queueLength)
+	queueLength.Set(7)
+
+	sut := NewStatsDBackend(slog.Default(), registry, listener.LocalAddr().String(), WithPushInterval(10*time.Millisecond))
+
+	// when
+	err = sut.Start()
+	require.NoError(t, err)
+	defer sut.Shutdown()
+
+	// then
+	require.NoError(t, listener.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, maxPacketSize)
+	n, _, err := listener.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Equal(t, "test_queue_length:7|g", string(buf[:n]))
+}
+
+func TestPackets(t *testing.T) {
+	// given
+	lines := []string{strings.Repeat("a", 6), strings.Repeat("b", 3), strings.Repeat("c", 12), "d"}
+
+	// when
+	actual := packets(lines, 10)
+
+	// then
+	expected := [][]byte{[]byte("aaaaaa\nbbb"), []byte("cccccccccccc"), []byte("d")}
+	assert.Equal(t, expected, actual)
+}
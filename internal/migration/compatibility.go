@@ -0,0 +1,173 @@
+package migration
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/lib/pq"
+)
+
+// contractMarker marks a contract migration on the first line of its up migration, e.g.
+// `-- arc:contract compatible-from=31`. After a contract migration only instances knowing at least the migration given
+// by compatible-from, i.e. the version from which on the removed schema is no longer used, can run against the
+// database. Without compatible-from only instances knowing the contract migration itself are compatible.
+const (
+	contractMarker       = "-- arc:contract"
+	compatibleFromOption = "compatible-from="
+)
+
+var (
+	ErrIncompatibleSchema     = errors.New("database schema is incompatible with this version of ARC")
+	ErrInvalidContractMarker  = errors.New("invalid contract migration marker")
+	ErrFailedToRecordContract = errors.New("failed to record schema compatibility")
+)
+
+// contractMigrations returns the versions from which on instances are compatible with the schema by the versions of
+// the contract migrations.
+func contractMigrations(migrations fs.FS) (map[uint]uint, error) {
+	entries, err := fs.ReadDir(migrations, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make(map[uint]uint)
+	for _, entry := range entries {
+		m, err := source.Parse(entry.Name())
+		if err != nil || m.Direction != source.Up {
+			continue
+		}
+
+		file, err := migrations.Open(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		scanner := bufio.NewScanner(file)
+		var firstLine string
+		if scanner.Scan() {
+			firstLine = strings.TrimSpace(scanner.Text())
+		}
+		_ = file.Close()
+
+		if !strings.HasPrefix(firstLine, contractMarker) {
+			continue
+		}
+
+		compatibleFrom := m.Version
+		for _, option := range strings.Fields(strings.TrimPrefix(firstLine, contractMarker)) {
+			value, found := strings.CutPrefix(option, compatibleFromOption)
+			if !found {
+				return nil, errors.Join(ErrInvalidContractMarker, fmt.Errorf("%s: option %q", entry.Name(), option))
+			}
+
+			v, err := strconv.ParseUint(value, 10, 0)
+			if err != nil || uint(v) > m.Version {
+				return nil, errors.Join(ErrInvalidContractMarker, fmt.Errorf("%s: compatible-from %q", entry.Name(), value))
+			}
+			compatibleFrom = uint(v)
+		}
+
+		contracts[m.Version] = compatibleFrom
+	}
+
+	return contracts, nil
+}
+
+// minCompatibleVersion returns the oldest version of the migrations an instance must know to run against the schema
+// at the given version.
+func minCompatibleVersion(contracts map[uint]uint, version uint) uint {
+	var minVersion uint
+	for contractVersion, compatibleFrom := range contracts {
+		if contractVersion <= version && compatibleFrom > minVersion {
+			minVersion = compatibleFrom
+		}
+	}
+
+	return minVersion
+}
+
+// expandVersion returns the newest of the pending versions which can be applied without applying a contract
+// migration, 0 if the next pending migration is a contract migration.
+func expandVersion(contracts map[uint]uint, pending []uint) uint {
+	var version uint
+	for _, v := range pending {
+		if _, contract := contracts[v]; contract {
+			break
+		}
+		version = v
+	}
+
+	return version
+}
+
+func compatibilityTable(table string) string {
+	return pq.QuoteIdentifier(table + "_compatibility")
+}
+
+// readMinCompatibleVersion reads the oldest version of the migrations an instance must know to run against the
+// database, as recorded by the instance which migrated it.
+func readMinCompatibleVersion(ctx context.Context, db *sql.DB, table string) (uint, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", compatibilityTable(table)).Scan(&exists)
+	if err != nil || !exists {
+		return 0, err
+	}
+
+	var version int64
+	err = db.QueryRowContext(ctx, fmt.Sprintf("SELECT min_version FROM %s LIMIT 1", compatibilityTable(table))).Scan(&version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return uint(max(version, 0)), nil // #nosec G115 -- the version is not negative
+}
+
+// recordMinCompatibleVersion records the oldest version of the migrations an instance must know to run against the
+// database, so that instances of older versions of ARC, which don't know the contract migrations, can check it.
+func recordMinCompatibleVersion(ctx context.Context, db *sql.DB, table string, version uint) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (min_version BIGINT NOT NULL)", compatibilityTable(table)))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("DELETE FROM %s", compatibilityTable(table))) // #nosec G201 -- the table name is quoted
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (min_version) VALUES ($1)", compatibilityTable(table)), int64(version)) // #nosec G201,G115
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// CopyColumnBackfill returns the backfill copying the values of a column to the column replacing it, for the expand
+// phase in which both columns are written and the values are read from the new column falling back to the old one.
+func CopyColumnBackfill(name string, table string, keyColumn string, fromColumn string, toColumn string) Backfill {
+	t, key, from, to := pq.QuoteIdentifier(table), pq.QuoteIdentifier(keyColumn), pq.QuoteIdentifier(fromColumn), pq.QuoteIdentifier(toColumn)
+
+	return Backfill{
+		Name: name,
+		Query: fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s IN (SELECT %s FROM %s WHERE %s IS NULL AND %s IS NOT NULL LIMIT $1)",
+			t, to, from, key, key, t, to, from),
+	}
+}
@@ -0,0 +1,557 @@
+// Package migration applies the schema migrations of the databases of the ARC services on start and runs long-running
+// backfill migrations in batches in the background.
+//
+// The schema migrations are applied under the advisory lock of golang-migrate, so that only one instance of a service
+// migrates the database at a time while the other instances wait for it. Each backfill is run by the instance which
+// holds its advisory lock only.
+//
+// Schema changes are rolled out with expand and contract migrations, so that instances of the previous and of the new
+// version of ARC can run against the same database during an upgrade. Expand migrations only add to the schema and
+// are applied on start. Contract migrations remove what is no longer used, they are marked with `-- arc:contract` and
+// only applied once enabled. Each instance checks on start whether the schema of the database is compatible with it.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/golang-migrate/migrate/v4"
+	migratepostgres "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/lib/pq"
+)
+
+const (
+	postgresDriverName = "postgres"
+
+	lockTimeoutDefault       = time.Minute
+	backfillBatchSizeDefault = 1000
+	backfillPauseDefault     = time.Second
+)
+
+var (
+	ErrFailedToOpenDB         = errors.New("failed to open database")
+	ErrFailedToReadMigrations = errors.New("failed to read migrations")
+	ErrFailedToMigrate        = errors.New("failed to migrate database")
+	ErrDirtyDatabase          = errors.New("database is dirty, a previous migration failed and has to be fixed manually")
+)
+
+// Backfill is a long-running data migration. The query processes at most $1 rows per batch and is repeated until a
+// batch affects no rows, therefore it has to skip the rows which have already been processed.
+type Backfill struct {
+	Name  string
+	Query string
+}
+
+// Target is the database of a service.
+type Target struct {
+	Service string
+	DBInfo  string
+	// Table is the table in which golang-migrate records the schema version
+	Table string
+	// Migrations contains the migrations `<version>_<name>.up.sql` and `<version>_<name>.down.sql`
+	Migrations fs.FS
+	Backfills  []Backfill
+}
+
+// BackfillStatus is the progress of a backfill run by this instance.
+type BackfillStatus struct {
+	Name          string `json:"name"`
+	RowsProcessed int64  `json:"rowsProcessed"`
+	Completed     bool   `json:"completed"`
+	Error         string `json:"error,omitempty"`
+}
+
+// Status is the schema version of the database of a service.
+type Status struct {
+	Service           string `json:"service"`
+	Version           uint   `json:"version"`
+	Dirty             bool   `json:"dirty"`
+	PendingMigrations []uint `json:"pendingMigrations"`
+	// PendingContractMigrations are the pending migrations which are only applied with contract migrations enabled
+	PendingContractMigrations []uint `json:"pendingContractMigrations,omitempty"`
+	// MinCompatibleVersion is the oldest version of the migrations an instance must know to run against the database
+	MinCompatibleVersion uint             `json:"minCompatibleVersion"`
+	Backfills            []BackfillStatus `json:"backfills,omitempty"`
+	Error                string           `json:"error,omitempty"`
+}
+
+type target struct {
+	Target
+	db        *sql.DB
+	versions  []uint
+	contracts map[uint]uint
+	backfills []*BackfillStatus
+}
+
+// latestVersion returns the version of the newest migration known to this instance.
+func (t *target) latestVersion() uint {
+	if len(t.versions) == 0 {
+		return 0
+	}
+
+	return t.versions[len(t.versions)-1]
+}
+
+// Runner migrates the databases of the services. It implements http.Handler and serves the status of the databases
+// as JSON.
+type Runner struct {
+	logger            *slog.Logger
+	dryRun            bool
+	checkOnly         bool
+	contract          bool
+	lockTimeout       time.Duration
+	backfillBatchSize int
+	backfillPause     time.Duration
+
+	mu      sync.Mutex
+	targets []*target
+
+	wg        *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+type Option func(r *Runner)
+
+// WithDryRun only logs the pending migrations instead of applying them. Backfills are not run either.
+func WithDryRun(dryRun bool) Option {
+	return func(r *Runner) {
+		r.dryRun = dryRun
+	}
+}
+
+// WithCheckOnly only checks whether the schemas of the databases are compatible with this instance, e.g. if the
+// databases are migrated by the `migrate` CLI. Databases which cannot be reached are skipped.
+func WithCheckOnly() Option {
+	return func(r *Runner) {
+		r.checkOnly = true
+	}
+}
+
+// WithContract applies the contract migrations. It must only be enabled once no instance of a version of ARC which
+// is incompatible with the contract migrations is running anymore.
+func WithContract(contract bool) Option {
+	return func(r *Runner) {
+		r.contract = contract
+	}
+}
+
+// WithLockTimeout sets the maximum duration to wait for the migration lock held by another instance.
+func WithLockTimeout(d time.Duration) Option {
+	return func(r *Runner) {
+		r.lockTimeout = d
+	}
+}
+
+// WithBackfillBatches sets the number of rows processed per batch by the backfills and the pause between the batches.
+func WithBackfillBatches(batchSize int, pause time.Duration) Option {
+	return func(r *Runner) {
+		r.backfillBatchSize = batchSize
+		r.backfillPause = pause
+	}
+}
+
+func NewRunner(logger *slog.Logger, opts ...Option) *Runner {
+	r := &Runner{
+		logger:            logger.With(slog.String("module", "migration")),
+		lockTimeout:       lockTimeoutDefault,
+		backfillBatchSize: backfillBatchSizeDefault,
+		backfillPause:     backfillPauseDefault,
+		wg:                &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.ctx = ctx
+	r.cancelAll = cancel
+
+	return r
+}
+
+// Migrate checks whether the schema of the target is compatible with this instance, applies the pending migrations
+// and starts its backfills in the background. Contract migrations and the migrations after them are only applied with
+// contract migrations enabled. In dry-run mode the pending migrations are only logged.
+func (r *Runner) Migrate(t Target) error {
+	versions, err := sourceVersions(t.Migrations)
+	if err != nil {
+		return errors.Join(ErrFailedToReadMigrations, fmt.Errorf("service %s: %w", t.Service, err))
+	}
+
+	contracts, err := contractMigrations(t.Migrations)
+	if err != nil {
+		return errors.Join(ErrFailedToReadMigrations, fmt.Errorf("service %s: %w", t.Service, err))
+	}
+
+	db, err := sql.Open(postgresDriverName, t.DBInfo)
+	if err != nil {
+		return errors.Join(ErrFailedToOpenDB, fmt.Errorf("service %s: %w", t.Service, err))
+	}
+
+	tr := &target{Target: t, db: db, versions: versions, contracts: contracts}
+	r.mu.Lock()
+	r.targets = append(r.targets, tr)
+	r.mu.Unlock()
+
+	status := r.status(r.ctx, tr)
+	if status.Error != "" {
+		if r.checkOnly {
+			r.logger.Warn("Failed to check schema compatibility", slog.String("service", t.Service), slog.String("err", status.Error))
+			return nil
+		}
+		return errors.Join(ErrFailedToMigrate, fmt.Errorf("service %s: %s", t.Service, status.Error))
+	}
+
+	if status.MinCompatibleVersion > tr.latestVersion() {
+		return errors.Join(ErrIncompatibleSchema, fmt.Errorf("service %s: schema version %d requires migrations of at least version %d, latest known version is %d",
+			t.Service, status.Version, status.MinCompatibleVersion, tr.latestVersion()))
+	}
+
+	if status.Version > tr.latestVersion() {
+		r.logger.Info("Schema of the database is newer than this version and compatible with it", slog.String("service", t.Service), slog.Uint64("version", uint64(status.Version)), slog.Uint64("latest", uint64(tr.latestVersion())))
+		return nil
+	}
+
+	if r.checkOnly {
+		if len(status.PendingMigrations) > 0 {
+			r.logger.Warn("Database has pending migrations", slog.String("service", t.Service), slog.Uint64("version", uint64(status.Version)), slog.Any("pending", status.PendingMigrations))
+		}
+		return nil
+	}
+
+	if r.dryRun {
+		if status.Dirty {
+			return errors.Join(ErrDirtyDatabase, fmt.Errorf("service %s: version %d", t.Service, status.Version))
+		}
+
+		r.logger.Info("Dry run - pending migrations not applied", slog.String("service", t.Service), slog.Uint64("version", uint64(status.Version)), slog.Any("pending", status.PendingMigrations), slog.Any("contract", status.PendingContractMigrations))
+		return nil
+	}
+
+	if len(status.PendingMigrations) > 0 {
+		version := status.PendingMigrations[len(status.PendingMigrations)-1]
+		if !r.contract && len(status.PendingContractMigrations) > 0 {
+			version = expandVersion(tr.contracts, status.PendingMigrations)
+			r.logger.Warn("Contract migrations not applied", slog.String("service", t.Service), slog.Any("contract", status.PendingContractMigrations))
+		}
+
+		if version > 0 {
+			r.logger.Info("Applying migrations", slog.String("service", t.Service), slog.Uint64("version", uint64(status.Version)), slog.Any("pending", status.PendingMigrations), slog.Uint64("target", uint64(version)))
+
+			err = r.up(tr, version)
+			if err != nil {
+				return errors.Join(ErrFailedToMigrate, fmt.Errorf("service %s: %w", t.Service, err))
+			}
+		}
+	}
+
+	err = r.recordCompatibility(tr)
+	if err != nil {
+		return errors.Join(ErrFailedToRecordContract, fmt.Errorf("service %s: %w", t.Service, err))
+	}
+
+	for _, backfill := range t.Backfills {
+		backfillStatus := &BackfillStatus{Name: backfill.Name}
+		r.mu.Lock()
+		tr.backfills = append(tr.backfills, backfillStatus)
+		r.mu.Unlock()
+
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			r.runBackfill(tr, backfill, backfillStatus)
+		}()
+	}
+
+	return nil
+}
+
+// recordCompatibility records the oldest version of the migrations an instance must know to run against the migrated
+// database.
+func (r *Runner) recordCompatibility(t *target) error {
+	version, _, err := readVersion(r.ctx, t.db, t.Table)
+	if err != nil {
+		return err
+	}
+
+	return recordMinCompatibleVersion(r.ctx, t.db, t.Table, minCompatibleVersion(t.contracts, version))
+}
+
+// up migrates the target up to the given version.
+func (r *Runner) up(t *target, version uint) error {
+	conn, err := t.db.Conn(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	// the driver only closes the connection and not the connection pool, which is still used for the backfills
+	driver, err := migratepostgres.WithConnection(r.ctx, conn, &migratepostgres.Config{MigrationsTable: t.Table})
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+
+	src, err := iofs.New(t.Migrations, ".")
+	if err != nil {
+		_ = driver.Close()
+		return err
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, postgresDriverName, driver)
+	if err != nil {
+		_ = src.Close()
+		_ = driver.Close()
+		return err
+	}
+	defer func() {
+		_, _ = m.Close()
+	}()
+
+	m.LockTimeout = r.lockTimeout
+
+	// the database is also dirty while another instance applies a migration, therefore it is only checked under the lock
+	err = m.Migrate(version)
+	var dirtyErr migrate.ErrDirty
+	if errors.As(err, &dirtyErr) {
+		return errors.Join(ErrDirtyDatabase, err)
+	}
+	if err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	return nil
+}
+
+// runBackfill runs the batches of the backfill as long as this instance holds the advisory lock of the backfill.
+func (r *Runner) runBackfill(t *target, backfill Backfill, status *BackfillStatus) {
+	logger := r.logger.With(slog.String("service", t.Service), slog.String("backfill", backfill.Name))
+
+	setError := func(err error) {
+		logger.Error("Backfill failed", slog.String("err", err.Error()))
+		r.mu.Lock()
+		status.Error = err.Error()
+		r.mu.Unlock()
+	}
+
+	conn, err := t.db.Conn(r.ctx)
+	if err != nil {
+		setError(err)
+		return
+	}
+	defer conn.Close()
+
+	lockKey := fmt.Sprintf("arc_backfill_%s_%s", t.Service, backfill.Name)
+
+	var locked bool
+	err = conn.QueryRowContext(r.ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockKey).Scan(&locked)
+	if err != nil {
+		setError(err)
+		return
+	}
+
+	if !locked {
+		logger.Info("Backfill is run by another instance")
+		return
+	}
+
+	defer func() {
+		_, err = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", lockKey)
+		if err != nil {
+			logger.Error("Failed to release backfill lock", slog.String("err", err.Error()))
+		}
+	}()
+
+	logger.Info("Starting backfill", slog.Int("batchSize", r.backfillBatchSize))
+
+	for {
+		res, err := conn.ExecContext(r.ctx, backfill.Query, r.backfillBatchSize)
+		if err != nil {
+			if r.ctx.Err() == nil {
+				setError(err)
+			}
+			return
+		}
+
+		rows, err := res.RowsAffected()
+		if err != nil {
+			setError(err)
+			return
+		}
+
+		r.mu.Lock()
+		status.RowsProcessed += rows
+		status.Completed = rows == 0
+		r.mu.Unlock()
+
+		if rows == 0 {
+			logger.Info("Backfill completed", slog.Int64("rows", status.RowsProcessed))
+			return
+		}
+
+		select {
+		case <-r.ctx.Done():
+			return
+		case <-time.After(r.backfillPause):
+		}
+	}
+}
+
+// Status returns the current schema version and the pending migrations of the databases of all services.
+func (r *Runner) Status(ctx context.Context) []Status {
+	r.mu.Lock()
+	targets := make([]*target, len(r.targets))
+	copy(targets, r.targets)
+	r.mu.Unlock()
+
+	statuses := make([]Status, 0, len(targets))
+	for _, t := range targets {
+		statuses = append(statuses, r.status(ctx, t))
+	}
+
+	return statuses
+}
+
+func (r *Runner) status(ctx context.Context, t *target) Status {
+	status := Status{
+		Service:           t.Service,
+		PendingMigrations: []uint{},
+	}
+
+	r.mu.Lock()
+	for _, backfill := range t.backfills {
+		status.Backfills = append(status.Backfills, *backfill)
+	}
+	r.mu.Unlock()
+
+	version, dirty, err := readVersion(ctx, t.db, t.Table)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	status.Version = version
+	status.Dirty = dirty
+	status.PendingMigrations = pendingVersions(t.versions, version)
+	for _, pending := range status.PendingMigrations {
+		if _, contract := t.contracts[pending]; contract {
+			status.PendingContractMigrations = append(status.PendingContractMigrations, pending)
+		}
+	}
+
+	status.MinCompatibleVersion, err = readMinCompatibleVersion(ctx, t.db, t.Table)
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+func (r *Runner) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(r.Status(req.Context()))
+}
+
+// Shutdown stops the backfills and closes the connections to the databases.
+func (r *Runner) Shutdown() {
+	r.cancelAll()
+	r.wg.Wait()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range r.targets {
+		err := t.db.Close()
+		if err != nil {
+			r.logger.Error("Failed to close database", slog.String("service", t.Service), slog.String("err", err.Error()))
+		}
+	}
+}
+
+// readVersion reads the version recorded by golang-migrate, the version is 0 if no migration has been applied yet.
+func readVersion(ctx context.Context, db *sql.DB, table string) (uint, bool, error) {
+	var exists bool
+	err := db.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", pq.QuoteIdentifier(table)).Scan(&exists)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if !exists {
+		return 0, false, nil
+	}
+
+	var version int64
+	var dirty bool
+	err = db.QueryRowContext(ctx, fmt.Sprintf("SELECT version, dirty FROM %s LIMIT 1", pq.QuoteIdentifier(table))).Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	// golang-migrate records -1 if the last migration was a down migration to no version
+	if version < 0 {
+		return 0, dirty, nil
+	}
+
+	v, err := safecast.ToUint(version)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return v, dirty, nil
+}
+
+// sourceVersions returns the sorted versions of the up migrations.
+func sourceVersions(migrations fs.FS) ([]uint, error) {
+	entries, err := fs.ReadDir(migrations, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []uint
+	for _, entry := range entries {
+		m, err := source.Parse(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		if m.Direction == source.Up {
+			versions = append(versions, m.Version)
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	return versions, nil
+}
+
+func pendingVersions(versions []uint, current uint) []uint {
+	pending := []uint{}
+	for _, version := range versions {
+		if version > current {
+			pending = append(pending, version)
+		}
+	}
+
+	return pending
+}
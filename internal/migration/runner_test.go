@@ -0,0 +1,263 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"log"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/ory/dockertest/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	testutils "github.com/bitcoin-sv/arc/pkg/test_utils"
+)
+
+var dbInfo string
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if testing.Short() {
+		return
+	}
+
+	testmain(m)
+}
+
+func testmain(m *testing.M) int {
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		log.Printf("failed to create pool: %v", err)
+		return 1
+	}
+
+	resource, connStr, err := testutils.RunPostgresql(pool, "5438")
+	if err != nil {
+		log.Print(err)
+		return 1
+	}
+	defer func() {
+		err = pool.Purge(resource)
+		if err != nil {
+			log.Fatalf("failed to purge pool: %v", err)
+		}
+	}()
+
+	dbInfo = connStr
+	return m.Run()
+}
+
+var testMigrations = fstest.MapFS{
+	"000001_create_items.up.sql":   {Data: []byte("CREATE TABLE items (id SERIAL PRIMARY KEY, name TEXT NOT NULL, upper_name TEXT);")},
+	"000001_create_items.down.sql": {Data: []byte("DROP TABLE items;")},
+	"000002_fill_items.up.sql":     {Data: []byte("INSERT INTO items (name) SELECT 'item-' || i FROM generate_series(1, 25) AS i;")},
+	"000002_fill_items.down.sql":   {Data: []byte("DELETE FROM items;")},
+	"README.md":                    {Data: []byte("not a migration")},
+}
+
+func TestSourceVersions(t *testing.T) {
+	// when
+	actual, err := sourceVersions(testMigrations)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []uint{1, 2}, actual)
+	assert.Equal(t, []uint{2}, pendingVersions(actual, 1))
+	assert.Equal(t, []uint{}, pendingVersions(actual, 2))
+}
+
+func TestRunner_Migrate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	// given
+	db, err := sql.Open(postgresDriverName, dbInfo)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, testutils.Retry(db.Ping))
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	target := Target{
+		Service:    "test",
+		DBInfo:     dbInfo,
+		Table:      "test_migrations",
+		Migrations: testMigrations,
+		Backfills: []Backfill{{
+			Name:  "upper_name",
+			Query: "UPDATE items SET upper_name = upper(name) WHERE id IN (SELECT id FROM items WHERE upper_name IS NULL LIMIT $1)",
+		}},
+	}
+
+	// when
+	dryRun := NewRunner(logger, WithDryRun(true))
+	err = dryRun.Migrate(target)
+
+	// then
+	require.NoError(t, err)
+	statuses := dryRun.Status(context.Background())
+	require.Len(t, statuses, 1)
+	assert.Equal(t, uint(0), statuses[0].Version)
+	assert.Equal(t, []uint{1, 2}, statuses[0].PendingMigrations)
+	dryRun.Shutdown()
+
+	// when
+	sut := NewRunner(logger, WithBackfillBatches(10, 10*time.Millisecond))
+	defer sut.Shutdown()
+	err = sut.Migrate(target)
+
+	// then
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		statuses = sut.Status(context.Background())
+		return len(statuses[0].Backfills) == 1 && statuses[0].Backfills[0].Completed
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, uint(2), statuses[0].Version)
+	assert.Empty(t, statuses[0].PendingMigrations)
+	assert.Equal(t, int64(25), statuses[0].Backfills[0].RowsProcessed)
+
+	var missing int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM items WHERE upper_name IS NULL").Scan(&missing))
+	assert.Zero(t, missing)
+
+	rec := httptest.NewRecorder()
+	sut.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/migrations", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var served []Status
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+	require.Len(t, served, 1)
+	assert.Equal(t, uint(2), served[0].Version)
+}
+
+var contractTestMigrations = fstest.MapFS{
+	"000001_create_items.up.sql":       {Data: []byte("CREATE TABLE items (id SERIAL PRIMARY KEY, name TEXT NOT NULL);")},
+	"000001_create_items.down.sql":     {Data: []byte("DROP TABLE items;")},
+	"000002_add_title.up.sql":          {Data: []byte("ALTER TABLE items ADD COLUMN title TEXT;")},
+	"000002_add_title.down.sql":        {Data: []byte("ALTER TABLE items DROP COLUMN title;")},
+	"000003_drop_name.up.sql":          {Data: []byte("-- arc:contract compatible-from=2\nALTER TABLE items DROP COLUMN name;")},
+	"000003_drop_name.down.sql":        {Data: []byte("ALTER TABLE items ADD COLUMN name TEXT;")},
+	"000004_add_description.up.sql":    {Data: []byte("ALTER TABLE items ADD COLUMN description TEXT;")},
+	"000004_add_description.down.sql":  {Data: []byte("ALTER TABLE items DROP COLUMN description;")},
+	"000005_drop_description.up.sql":   {Data: []byte("-- arc:contract\nALTER TABLE items DROP COLUMN description;")},
+	"000005_drop_description.down.sql": {Data: []byte("ALTER TABLE items ADD COLUMN description TEXT;")},
+	"000006_not_a_contract.up.sql":     {Data: []byte("SELECT 1;\n-- arc:contract")},
+	"000006_not_a_contract.down.sql":   {Data: []byte("SELECT 1;")},
+}
+
+func TestContractMigrations(t *testing.T) {
+	// when
+	actual, err := contractMigrations(contractTestMigrations)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, map[uint]uint{3: 2, 5: 5}, actual)
+
+	assert.Equal(t, uint(0), minCompatibleVersion(actual, 2))
+	assert.Equal(t, uint(2), minCompatibleVersion(actual, 4))
+	assert.Equal(t, uint(5), minCompatibleVersion(actual, 6))
+
+	assert.Equal(t, uint(2), expandVersion(actual, []uint{1, 2, 3, 4}))
+	assert.Equal(t, uint(0), expandVersion(actual, []uint{3, 4}))
+	assert.Equal(t, uint(4), expandVersion(actual, []uint{4, 5, 6}))
+}
+
+func TestContractMigrations_InvalidMarker(t *testing.T) {
+	tt := []struct {
+		name   string
+		marker string
+	}{
+		{name: "invalid version", marker: "-- arc:contract compatible-from=x"},
+		{name: "newer version", marker: "-- arc:contract compatible-from=3"},
+		{name: "unknown option", marker: "-- arc:contract since=1"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			migrations := fstest.MapFS{"000002_drop.up.sql": {Data: []byte(tc.marker + "\nSELECT 1;")}}
+
+			// when
+			_, err := contractMigrations(migrations)
+
+			// then
+			require.ErrorIs(t, err, ErrInvalidContractMarker)
+		})
+	}
+}
+
+func TestCopyColumnBackfill(t *testing.T) {
+	// when
+	actual := CopyColumnBackfill("title", "items", "id", "name", "title")
+
+	// then
+	assert.Equal(t, "title", actual.Name)
+	assert.Equal(t, `UPDATE "items" SET "title" = "name" WHERE "id" IN (SELECT "id" FROM "items" WHERE "title" IS NULL AND "name" IS NOT NULL LIMIT $1)`, actual.Query)
+}
+
+func TestRunner_Contract(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	// given
+	db, err := sql.Open(postgresDriverName, dbInfo)
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, testutils.Retry(db.Ping))
+
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	target := Target{
+		Service:    "contract",
+		DBInfo:     dbInfo,
+		Table:      "contract_migrations",
+		Migrations: contractTestMigrations,
+	}
+
+	// when
+	sut := NewRunner(logger)
+	err = sut.Migrate(target)
+
+	// then the expand migrations before the first contract migration are applied
+	require.NoError(t, err)
+	statuses := sut.Status(context.Background())
+	assert.Equal(t, uint(2), statuses[0].Version)
+	assert.Equal(t, []uint{3, 5}, statuses[0].PendingContractMigrations)
+	sut.Shutdown()
+
+	// when
+	sut = NewRunner(logger, WithContract(true))
+	err = sut.Migrate(target)
+
+	// then
+	require.NoError(t, err)
+	statuses = sut.Status(context.Background())
+	assert.Equal(t, uint(6), statuses[0].Version)
+	assert.Equal(t, uint(5), statuses[0].MinCompatibleVersion)
+	sut.Shutdown()
+
+	// when an instance which only knows the migrations before the contract starts
+	old := NewRunner(logger, WithCheckOnly())
+	defer old.Shutdown()
+	oldTarget := target
+	oldTarget.Migrations = fstest.MapFS{
+		"000001_create_items.up.sql":    contractTestMigrations["000001_create_items.up.sql"],
+		"000002_add_title.up.sql":       contractTestMigrations["000002_add_title.up.sql"],
+		"000003_drop_name.up.sql":       contractTestMigrations["000003_drop_name.up.sql"],
+		"000004_add_description.up.sql": contractTestMigrations["000004_add_description.up.sql"],
+	}
+	err = old.Migrate(oldTarget)
+
+	// then
+	require.ErrorIs(t, err, ErrIncompatibleSchema)
+}
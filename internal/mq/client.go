@@ -21,35 +21,63 @@ const (
 	RegisterTxTopic  = "register-tx"
 	RegisterTxsTopic = "register-txs"
 	CallbackTopic    = "callback"
+	// StatusUpdateTopic carries the hashes of transactions whose status has been updated
+	StatusUpdateTopic = "status-update"
+	// ReorgTopic carries the first block of the new longest chain after a chain reorg, its height is the lowest height
+	// affected by the reorg
+	ReorgTopic = "reorg"
 )
 
+// MessageQueueClient publishes and consumes messages. The trace context of the context given on publishing is carried
+// in the headers of the message and continued in the context given to the function consuming the message.
 type MessageQueueClient interface {
 	// PublishCore publishes a message as byte array to the specified topic
-	PublishCore(topic string, data []byte) (err error)
+	PublishCore(ctx context.Context, topic string, data []byte) (err error)
 	// PublishMarshalCore publishes a message as proto message to the specified topic
-	PublishMarshalCore(topic string, m proto.Message) (err error)
+	PublishMarshalCore(ctx context.Context, topic string, m proto.Message) (err error)
 
 	// Publish publishes a message as byte array to a topic persisted in a stream
 	Publish(ctx context.Context, topic string, data []byte) error
 	// PublishAsync publishes a message as byte array to the specified topic persisted in a stream not blocking while waiting for an acknowledgement
-	PublishAsync(topic string, hash []byte) (err error)
+	PublishAsync(ctx context.Context, topic string, hash []byte) (err error)
 	// PublishMarshal publishes a message as proto message to a topic persisted in a stream
 	PublishMarshal(ctx context.Context, topic string, m proto.Message) error
 	// PublishMarshalAsync publishes a message as proto message to the specified topic persisted in a stream not blocking while waiting for an acknowledgement
-	PublishMarshalAsync(topic string, m proto.Message) error
+	PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error
 
 	// Consume subscribes to a topic to consume a stream and calls the specified function for each message as byte array
-	Consume(topic string, msgFunc func([]byte) error) error
+	Consume(topic string, msgFunc func(ctx context.Context, msg []byte) error) error
 	// ConsumeMsg subscribes to a topic to consume a stream and calls the specified function for each message as jetstream.Msg
 	ConsumeMsg(topic string, msgFunc func(msg jetstream.Msg) error) error
 	// QueueSubscribe subscribes to a topic and calls the specified function for each message as byte array
-	QueueSubscribe(topic string, msgFunc func([]byte) error) error
+	QueueSubscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error
 
 	Status() string
 	IsConnected() bool
 	Shutdown()
 }
 
+var ErrSubscribeNotSupported = errors.New("message queue client does not support subscriptions")
+
+// MessageSubscriber receives all messages of a topic. It is implemented by the clients of NewMqClient and of the
+// InProcessBroker, so that clients which only publish and consume do not have to implement it.
+type MessageSubscriber interface {
+	// Subscribe subscribes to a topic and calls the specified function for each message as byte array. Other than with
+	// QueueSubscribe, every subscriber receives all messages of the topic
+	Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error
+}
+
+// Subscribe subscribes to the topic with the client if it is a MessageSubscriber. Clients which wrap another client
+// forward their subscriptions with it.
+func Subscribe(client MessageQueueClient, topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	subscriber, ok := client.(MessageSubscriber)
+	if !ok {
+		return ErrSubscribeNotSupported
+	}
+
+	return subscriber.Subscribe(topic, msgFunc)
+}
+
 func NewMqClient(logger *slog.Logger, mqCfg *config.MessageQueueConfig, jsOpts []nats_jetstream.Option, connOpts []nats_connection.Option) (MessageQueueClient, error) {
 	if mqCfg == nil {
 		return nil, errors.New("mqCfg is required")
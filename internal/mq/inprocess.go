@@ -0,0 +1,285 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+const inProcessBufferSizeDefault = 10000
+
+var (
+	ErrInProcessClientClosed = errors.New("in-process message queue client is closed")
+	ErrInProcessQueueFull    = errors.New("in-process message queue is full")
+	ErrNotSupported          = errors.New("not supported by the in-process message queue")
+)
+
+// InProcessBroker exchanges the messages between the services running in one process over channels in place of NATS,
+// e.g. in the all-in-one mode. Messages published to a stream are buffered until they are consumed, messages published
+// to core topics are only delivered to the current subscribers. Other than with NATS the messages are not persisted,
+// i.e. buffered messages are lost on shutdown.
+type InProcessBroker struct {
+	logger     *slog.Logger
+	bufferSize int
+
+	mu     sync.Mutex
+	topics map[string]*inProcessTopic
+}
+
+type InProcessBrokerOption func(*InProcessBroker)
+
+// WithInProcessBufferSize sets the number of messages buffered per topic and subscriber. Publishing to a stream blocks
+// while the buffer of the topic is full.
+func WithInProcessBufferSize(size int) InProcessBrokerOption {
+	return func(b *InProcessBroker) {
+		if size > 0 {
+			b.bufferSize = size
+		}
+	}
+}
+
+// inProcessMsg is a published message with the context of the publisher detached from its cancellation, so that the
+// schema version and the trace context are continued by the consumer.
+type inProcessMsg struct {
+	ctx  context.Context
+	data []byte
+}
+
+type inProcessTopic struct {
+	// queue is read by the competing consumers of the topic
+	queue chan inProcessMsg
+
+	mu             sync.RWMutex
+	queueConsumers int
+	subscribers    map[chan inProcessMsg]struct{}
+}
+
+func NewInProcessBroker(logger *slog.Logger, opts ...InProcessBrokerOption) *InProcessBroker {
+	b := &InProcessBroker{
+		logger:     logger.With(slog.String("module", "in-process-message-queue")),
+		bufferSize: inProcessBufferSizeDefault,
+		topics:     make(map[string]*inProcessTopic),
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	return b
+}
+
+func (b *InProcessBroker) topic(name string) *inProcessTopic {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, found := b.topics[name]
+	if !found {
+		t = &inProcessTopic{
+			queue:       make(chan inProcessMsg, b.bufferSize),
+			subscribers: make(map[chan inProcessMsg]struct{}),
+		}
+		b.topics[name] = t
+	}
+
+	return t
+}
+
+// Client returns a message queue client of the broker. Shutting down the client stops its subscriptions.
+func (b *InProcessBroker) Client() MessageQueueClient {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &inProcessClient{
+		broker:    b,
+		logger:    b.logger,
+		ctx:       ctx,
+		cancelAll: cancel,
+	}
+}
+
+type inProcessClient struct {
+	broker    *InProcessBroker
+	logger    *slog.Logger
+	ctx       context.Context
+	cancelAll context.CancelFunc
+	waitGroup sync.WaitGroup
+}
+
+func (c *inProcessClient) PublishCore(ctx context.Context, topic string, data []byte) error {
+	return c.publish(ctx, topic, data, false)
+}
+
+func (c *inProcessClient) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.publish(ctx, topic, data, false)
+}
+
+func (c *inProcessClient) Publish(ctx context.Context, topic string, data []byte) error {
+	return c.publish(ctx, topic, data, true)
+}
+
+func (c *inProcessClient) PublishAsync(ctx context.Context, topic string, data []byte) error {
+	return c.publish(ctx, topic, data, true)
+}
+
+func (c *inProcessClient) PublishMarshal(ctx context.Context, topic string, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.publish(ctx, topic, data, true)
+}
+
+func (c *inProcessClient) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error {
+	return c.PublishMarshal(ctx, topic, m)
+}
+
+// publish delivers the message to all subscribers of the topic and to one of its consumers. Messages to streams are
+// buffered until they are consumed, messages to core topics without consumers are dropped.
+func (c *inProcessClient) publish(ctx context.Context, topic string, data []byte, stream bool) error {
+	if c.ctx.Err() != nil {
+		return ErrInProcessClientClosed
+	}
+
+	t := c.broker.topic(topic)
+	msg := inProcessMsg{ctx: context.WithoutCancel(ctx), data: slices.Clone(data)}
+
+	t.mu.RLock()
+	for ch := range t.subscribers {
+		select {
+		case ch <- msg:
+		default:
+			// like NATS, messages of slow subscribers are dropped instead of blocking the publisher
+			c.logger.Warn("Dropped message of slow subscriber", slog.String("topic", topic))
+		}
+	}
+	queue := stream || t.queueConsumers > 0
+	t.mu.RUnlock()
+
+	if !queue {
+		return nil
+	}
+
+	select {
+	case t.queue <- msg:
+		return nil
+	case <-ctx.Done():
+		return errors.Join(ErrInProcessQueueFull, fmt.Errorf("topic %s", topic), ctx.Err())
+	case <-c.ctx.Done():
+		return ErrInProcessClientClosed
+	}
+}
+
+func (c *inProcessClient) Consume(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return c.consume(topic, msgFunc)
+}
+
+func (c *inProcessClient) ConsumeMsg(_ string, _ func(msg jetstream.Msg) error) error {
+	return errors.Join(ErrNotSupported, errors.New("consuming jetstream messages"))
+}
+
+func (c *inProcessClient) QueueSubscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return c.consume(topic, msgFunc)
+}
+
+// consume calls msgFunc for the messages of the topic competing with the other consumers of the topic.
+func (c *inProcessClient) consume(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	if c.ctx.Err() != nil {
+		return ErrInProcessClientClosed
+	}
+
+	t := c.broker.topic(topic)
+
+	t.mu.Lock()
+	t.queueConsumers++
+	t.mu.Unlock()
+
+	c.waitGroup.Add(1)
+	go func() {
+		defer func() {
+			t.mu.Lock()
+			t.queueConsumers--
+			t.mu.Unlock()
+			c.waitGroup.Done()
+		}()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case msg := <-t.queue:
+				c.handle(topic, msg, msgFunc)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (c *inProcessClient) Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	if c.ctx.Err() != nil {
+		return ErrInProcessClientClosed
+	}
+
+	t := c.broker.topic(topic)
+	ch := make(chan inProcessMsg, c.broker.bufferSize)
+
+	t.mu.Lock()
+	t.subscribers[ch] = struct{}{}
+	t.mu.Unlock()
+
+	c.waitGroup.Add(1)
+	go func() {
+		defer func() {
+			t.mu.Lock()
+			delete(t.subscribers, ch)
+			t.mu.Unlock()
+			c.waitGroup.Done()
+		}()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case msg := <-ch:
+				c.handle(topic, msg, msgFunc)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (c *inProcessClient) handle(topic string, msg inProcessMsg, msgFunc func(ctx context.Context, msg []byte) error) {
+	err := msgFunc(msg.ctx, msg.data)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("failed to consume message on %s topic", topic), slog.String("err", err.Error()))
+	}
+}
+
+func (c *inProcessClient) Status() string {
+	if c.ctx.Err() != nil {
+		return "CLOSED"
+	}
+
+	return "CONNECTED"
+}
+
+func (c *inProcessClient) IsConnected() bool {
+	return c.ctx.Err() == nil
+}
+
+func (c *inProcessClient) Shutdown() {
+	c.cancelAll()
+	c.waitGroup.Wait()
+}
@@ -0,0 +1,135 @@
+package mq_test
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+)
+
+type received struct {
+	mu            sync.Mutex
+	msgs          []string
+	schemaVersion string
+}
+
+func (r *received) add(ctx context.Context, msg []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.msgs = append(r.msgs, string(msg))
+	r.schemaVersion = nats_jetstream.SchemaVersionFromContext(ctx)
+	return nil
+}
+
+func (r *received) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.msgs...)
+}
+
+func TestInProcessBroker(t *testing.T) {
+	t.Run("stream messages are buffered until consumed", func(t *testing.T) {
+		// given
+		broker := mq.NewInProcessBroker(slog.Default())
+		publisher := broker.Client()
+		consumer := broker.Client()
+		defer publisher.Shutdown()
+		defer consumer.Shutdown()
+
+		ctx := nats_jetstream.ContextWithSchemaVersion(context.Background(), "2")
+
+		// when
+		require.NoError(t, publisher.Publish(ctx, "topic", []byte("1")))
+		require.NoError(t, publisher.PublishAsync(ctx, "topic", []byte("2")))
+
+		var r received
+		require.NoError(t, consumer.Consume("topic", r.add))
+
+		// then
+		assert.Eventually(t, func() bool { return len(r.get()) == 2 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"1", "2"}, r.get())
+		assert.Equal(t, "2", r.schemaVersion)
+	})
+
+	t.Run("core messages without consumers are dropped", func(t *testing.T) {
+		// given
+		broker := mq.NewInProcessBroker(slog.Default())
+		client := broker.Client()
+		defer client.Shutdown()
+
+		// when
+		require.NoError(t, client.PublishCore(context.Background(), "topic", []byte("dropped")))
+
+		var r received
+		require.NoError(t, client.QueueSubscribe("topic", r.add))
+		require.NoError(t, client.PublishCore(context.Background(), "topic", []byte("delivered")))
+
+		// then
+		assert.Eventually(t, func() bool { return len(r.get()) == 1 }, time.Second, 10*time.Millisecond)
+		assert.Equal(t, []string{"delivered"}, r.get())
+	})
+
+	t.Run("competing consumers and subscribers", func(t *testing.T) {
+		// given
+		broker := mq.NewInProcessBroker(slog.Default())
+		client := broker.Client()
+		defer client.Shutdown()
+
+		var consumer1, consumer2, subscriber1, subscriber2 received
+		require.NoError(t, client.QueueSubscribe("topic", consumer1.add))
+		require.NoError(t, client.QueueSubscribe("topic", consumer2.add))
+		require.NoError(t, mq.Subscribe(client, "topic", subscriber1.add))
+		require.NoError(t, mq.Subscribe(client, "topic", subscriber2.add))
+
+		// when
+		for _, msg := range []string{"1", "2", "3", "4"} {
+			require.NoError(t, client.PublishCore(context.Background(), "topic", []byte(msg)))
+		}
+
+		// then
+		assert.Eventually(t, func() bool {
+			return len(consumer1.get())+len(consumer2.get()) == 4 && len(subscriber1.get()) == 4 && len(subscriber2.get()) == 4
+		}, time.Second, 10*time.Millisecond)
+		assert.ElementsMatch(t, []string{"1", "2", "3", "4"}, append(consumer1.get(), consumer2.get()...))
+	})
+
+	t.Run("full queue", func(t *testing.T) {
+		// given
+		broker := mq.NewInProcessBroker(slog.Default(), mq.WithInProcessBufferSize(1))
+		client := broker.Client()
+		defer client.Shutdown()
+
+		require.NoError(t, client.Publish(context.Background(), "topic", []byte("1")))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// when
+		err := client.Publish(ctx, "topic", []byte("2"))
+
+		// then
+		require.ErrorIs(t, err, mq.ErrInProcessQueueFull)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		// given
+		broker := mq.NewInProcessBroker(slog.Default())
+		client := broker.Client()
+
+		// when
+		client.Shutdown()
+
+		// then
+		assert.False(t, client.IsConnected())
+		require.ErrorIs(t, client.Publish(context.Background(), "topic", []byte("1")), mq.ErrInProcessClientClosed)
+	})
+}
@@ -21,7 +21,7 @@ var _ mq.MessageQueueClient = &MessageQueueClientMock{}
 //
 //		// make and configure a mocked mq.MessageQueueClient
 //		mockedMessageQueueClient := &MessageQueueClientMock{
-//			ConsumeFunc: func(topic string, msgFunc func([]byte) error) error {
+//			ConsumeFunc: func(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
 //				panic("mock out the Consume method")
 //			},
 //			ConsumeMsgFunc: func(topic string, msgFunc func(msg jetstream.Msg) error) error {
@@ -33,22 +33,22 @@ var _ mq.MessageQueueClient = &MessageQueueClientMock{}
 //			PublishFunc: func(ctx context.Context, topic string, data []byte) error {
 //				panic("mock out the Publish method")
 //			},
-//			PublishAsyncFunc: func(topic string, hash []byte) error {
+//			PublishAsyncFunc: func(ctx context.Context, topic string, hash []byte) error {
 //				panic("mock out the PublishAsync method")
 //			},
-//			PublishCoreFunc: func(topic string, data []byte) error {
+//			PublishCoreFunc: func(ctx context.Context, topic string, data []byte) error {
 //				panic("mock out the PublishCore method")
 //			},
 //			PublishMarshalFunc: func(ctx context.Context, topic string, m proto.Message) error {
 //				panic("mock out the PublishMarshal method")
 //			},
-//			PublishMarshalAsyncFunc: func(topic string, m proto.Message) error {
+//			PublishMarshalAsyncFunc: func(ctx context.Context, topic string, m proto.Message) error {
 //				panic("mock out the PublishMarshalAsync method")
 //			},
-//			PublishMarshalCoreFunc: func(topic string, m proto.Message) error {
+//			PublishMarshalCoreFunc: func(ctx context.Context, topic string, m proto.Message) error {
 //				panic("mock out the PublishMarshalCore method")
 //			},
-//			QueueSubscribeFunc: func(topic string, msgFunc func([]byte) error) error {
+//			QueueSubscribeFunc: func(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
 //				panic("mock out the QueueSubscribe method")
 //			},
 //			ShutdownFunc: func()  {
@@ -65,7 +65,7 @@ var _ mq.MessageQueueClient = &MessageQueueClientMock{}
 //	}
 type MessageQueueClientMock struct {
 	// ConsumeFunc mocks the Consume method.
-	ConsumeFunc func(topic string, msgFunc func([]byte) error) error
+	ConsumeFunc func(topic string, msgFunc func(ctx context.Context, msg []byte) error) error
 
 	// ConsumeMsgFunc mocks the ConsumeMsg method.
 	ConsumeMsgFunc func(topic string, msgFunc func(msg jetstream.Msg) error) error
@@ -77,22 +77,22 @@ type MessageQueueClientMock struct {
 	PublishFunc func(ctx context.Context, topic string, data []byte) error
 
 	// PublishAsyncFunc mocks the PublishAsync method.
-	PublishAsyncFunc func(topic string, hash []byte) error
+	PublishAsyncFunc func(ctx context.Context, topic string, hash []byte) error
 
 	// PublishCoreFunc mocks the PublishCore method.
-	PublishCoreFunc func(topic string, data []byte) error
+	PublishCoreFunc func(ctx context.Context, topic string, data []byte) error
 
 	// PublishMarshalFunc mocks the PublishMarshal method.
 	PublishMarshalFunc func(ctx context.Context, topic string, m proto.Message) error
 
 	// PublishMarshalAsyncFunc mocks the PublishMarshalAsync method.
-	PublishMarshalAsyncFunc func(topic string, m proto.Message) error
+	PublishMarshalAsyncFunc func(ctx context.Context, topic string, m proto.Message) error
 
 	// PublishMarshalCoreFunc mocks the PublishMarshalCore method.
-	PublishMarshalCoreFunc func(topic string, m proto.Message) error
+	PublishMarshalCoreFunc func(ctx context.Context, topic string, m proto.Message) error
 
 	// QueueSubscribeFunc mocks the QueueSubscribe method.
-	QueueSubscribeFunc func(topic string, msgFunc func([]byte) error) error
+	QueueSubscribeFunc func(topic string, msgFunc func(ctx context.Context, msg []byte) error) error
 
 	// ShutdownFunc mocks the Shutdown method.
 	ShutdownFunc func()
@@ -107,7 +107,7 @@ type MessageQueueClientMock struct {
 			// Topic is the topic argument value.
 			Topic string
 			// MsgFunc is the msgFunc argument value.
-			MsgFunc func([]byte) error
+			MsgFunc func(ctx context.Context, msg []byte) error
 		}
 		// ConsumeMsg holds details about calls to the ConsumeMsg method.
 		ConsumeMsg []struct {
@@ -130,6 +130,8 @@ type MessageQueueClientMock struct {
 		}
 		// PublishAsync holds details about calls to the PublishAsync method.
 		PublishAsync []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Topic is the topic argument value.
 			Topic string
 			// Hash is the hash argument value.
@@ -137,6 +139,8 @@ type MessageQueueClientMock struct {
 		}
 		// PublishCore holds details about calls to the PublishCore method.
 		PublishCore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Topic is the topic argument value.
 			Topic string
 			// Data is the data argument value.
@@ -153,6 +157,8 @@ type MessageQueueClientMock struct {
 		}
 		// PublishMarshalAsync holds details about calls to the PublishMarshalAsync method.
 		PublishMarshalAsync []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Topic is the topic argument value.
 			Topic string
 			// M is the m argument value.
@@ -160,6 +166,8 @@ type MessageQueueClientMock struct {
 		}
 		// PublishMarshalCore holds details about calls to the PublishMarshalCore method.
 		PublishMarshalCore []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
 			// Topic is the topic argument value.
 			Topic string
 			// M is the m argument value.
@@ -170,7 +178,7 @@ type MessageQueueClientMock struct {
 			// Topic is the topic argument value.
 			Topic string
 			// MsgFunc is the msgFunc argument value.
-			MsgFunc func([]byte) error
+			MsgFunc func(ctx context.Context, msg []byte) error
 		}
 		// Shutdown holds details about calls to the Shutdown method.
 		Shutdown []struct {
@@ -194,13 +202,13 @@ type MessageQueueClientMock struct {
 }
 
 // Consume calls ConsumeFunc.
-func (mock *MessageQueueClientMock) Consume(topic string, msgFunc func([]byte) error) error {
+func (mock *MessageQueueClientMock) Consume(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
 	if mock.ConsumeFunc == nil {
 		panic("MessageQueueClientMock.ConsumeFunc: method is nil but MessageQueueClient.Consume was just called")
 	}
 	callInfo := struct {
 		Topic   string
-		MsgFunc func([]byte) error
+		MsgFunc func(ctx context.Context, msg []byte) error
 	}{
 		Topic:   topic,
 		MsgFunc: msgFunc,
@@ -217,11 +225,11 @@ func (mock *MessageQueueClientMock) Consume(topic string, msgFunc func([]byte) e
 //	len(mockedMessageQueueClient.ConsumeCalls())
 func (mock *MessageQueueClientMock) ConsumeCalls() []struct {
 	Topic   string
-	MsgFunc func([]byte) error
+	MsgFunc func(ctx context.Context, msg []byte) error
 } {
 	var calls []struct {
 		Topic   string
-		MsgFunc func([]byte) error
+		MsgFunc func(ctx context.Context, msg []byte) error
 	}
 	mock.lockConsume.RLock()
 	calls = mock.calls.Consume
@@ -333,21 +341,23 @@ func (mock *MessageQueueClientMock) PublishCalls() []struct {
 }
 
 // PublishAsync calls PublishAsyncFunc.
-func (mock *MessageQueueClientMock) PublishAsync(topic string, hash []byte) error {
+func (mock *MessageQueueClientMock) PublishAsync(ctx context.Context, topic string, hash []byte) error {
 	if mock.PublishAsyncFunc == nil {
 		panic("MessageQueueClientMock.PublishAsyncFunc: method is nil but MessageQueueClient.PublishAsync was just called")
 	}
 	callInfo := struct {
+		Ctx   context.Context
 		Topic string
 		Hash  []byte
 	}{
+		Ctx:   ctx,
 		Topic: topic,
 		Hash:  hash,
 	}
 	mock.lockPublishAsync.Lock()
 	mock.calls.PublishAsync = append(mock.calls.PublishAsync, callInfo)
 	mock.lockPublishAsync.Unlock()
-	return mock.PublishAsyncFunc(topic, hash)
+	return mock.PublishAsyncFunc(ctx, topic, hash)
 }
 
 // PublishAsyncCalls gets all the calls that were made to PublishAsync.
@@ -355,10 +365,12 @@ func (mock *MessageQueueClientMock) PublishAsync(topic string, hash []byte) erro
 //
 //	len(mockedMessageQueueClient.PublishAsyncCalls())
 func (mock *MessageQueueClientMock) PublishAsyncCalls() []struct {
+	Ctx   context.Context
 	Topic string
 	Hash  []byte
 } {
 	var calls []struct {
+		Ctx   context.Context
 		Topic string
 		Hash  []byte
 	}
@@ -369,21 +381,23 @@ func (mock *MessageQueueClientMock) PublishAsyncCalls() []struct {
 }
 
 // PublishCore calls PublishCoreFunc.
-func (mock *MessageQueueClientMock) PublishCore(topic string, data []byte) error {
+func (mock *MessageQueueClientMock) PublishCore(ctx context.Context, topic string, data []byte) error {
 	if mock.PublishCoreFunc == nil {
 		panic("MessageQueueClientMock.PublishCoreFunc: method is nil but MessageQueueClient.PublishCore was just called")
 	}
 	callInfo := struct {
+		Ctx   context.Context
 		Topic string
 		Data  []byte
 	}{
+		Ctx:   ctx,
 		Topic: topic,
 		Data:  data,
 	}
 	mock.lockPublishCore.Lock()
 	mock.calls.PublishCore = append(mock.calls.PublishCore, callInfo)
 	mock.lockPublishCore.Unlock()
-	return mock.PublishCoreFunc(topic, data)
+	return mock.PublishCoreFunc(ctx, topic, data)
 }
 
 // PublishCoreCalls gets all the calls that were made to PublishCore.
@@ -391,10 +405,12 @@ func (mock *MessageQueueClientMock) PublishCore(topic string, data []byte) error
 //
 //	len(mockedMessageQueueClient.PublishCoreCalls())
 func (mock *MessageQueueClientMock) PublishCoreCalls() []struct {
+	Ctx   context.Context
 	Topic string
 	Data  []byte
 } {
 	var calls []struct {
+		Ctx   context.Context
 		Topic string
 		Data  []byte
 	}
@@ -445,21 +461,23 @@ func (mock *MessageQueueClientMock) PublishMarshalCalls() []struct {
 }
 
 // PublishMarshalAsync calls PublishMarshalAsyncFunc.
-func (mock *MessageQueueClientMock) PublishMarshalAsync(topic string, m proto.Message) error {
+func (mock *MessageQueueClientMock) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error {
 	if mock.PublishMarshalAsyncFunc == nil {
 		panic("MessageQueueClientMock.PublishMarshalAsyncFunc: method is nil but MessageQueueClient.PublishMarshalAsync was just called")
 	}
 	callInfo := struct {
+		Ctx   context.Context
 		Topic string
 		M     proto.Message
 	}{
+		Ctx:   ctx,
 		Topic: topic,
 		M:     m,
 	}
 	mock.lockPublishMarshalAsync.Lock()
 	mock.calls.PublishMarshalAsync = append(mock.calls.PublishMarshalAsync, callInfo)
 	mock.lockPublishMarshalAsync.Unlock()
-	return mock.PublishMarshalAsyncFunc(topic, m)
+	return mock.PublishMarshalAsyncFunc(ctx, topic, m)
 }
 
 // PublishMarshalAsyncCalls gets all the calls that were made to PublishMarshalAsync.
@@ -467,10 +485,12 @@ func (mock *MessageQueueClientMock) PublishMarshalAsync(topic string, m proto.Me
 //
 //	len(mockedMessageQueueClient.PublishMarshalAsyncCalls())
 func (mock *MessageQueueClientMock) PublishMarshalAsyncCalls() []struct {
+	Ctx   context.Context
 	Topic string
 	M     proto.Message
 } {
 	var calls []struct {
+		Ctx   context.Context
 		Topic string
 		M     proto.Message
 	}
@@ -481,21 +501,23 @@ func (mock *MessageQueueClientMock) PublishMarshalAsyncCalls() []struct {
 }
 
 // PublishMarshalCore calls PublishMarshalCoreFunc.
-func (mock *MessageQueueClientMock) PublishMarshalCore(topic string, m proto.Message) error {
+func (mock *MessageQueueClientMock) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) error {
 	if mock.PublishMarshalCoreFunc == nil {
 		panic("MessageQueueClientMock.PublishMarshalCoreFunc: method is nil but MessageQueueClient.PublishMarshalCore was just called")
 	}
 	callInfo := struct {
+		Ctx   context.Context
 		Topic string
 		M     proto.Message
 	}{
+		Ctx:   ctx,
 		Topic: topic,
 		M:     m,
 	}
 	mock.lockPublishMarshalCore.Lock()
 	mock.calls.PublishMarshalCore = append(mock.calls.PublishMarshalCore, callInfo)
 	mock.lockPublishMarshalCore.Unlock()
-	return mock.PublishMarshalCoreFunc(topic, m)
+	return mock.PublishMarshalCoreFunc(ctx, topic, m)
 }
 
 // PublishMarshalCoreCalls gets all the calls that were made to PublishMarshalCore.
@@ -503,10 +525,12 @@ func (mock *MessageQueueClientMock) PublishMarshalCore(topic string, m proto.Mes
 //
 //	len(mockedMessageQueueClient.PublishMarshalCoreCalls())
 func (mock *MessageQueueClientMock) PublishMarshalCoreCalls() []struct {
+	Ctx   context.Context
 	Topic string
 	M     proto.Message
 } {
 	var calls []struct {
+		Ctx   context.Context
 		Topic string
 		M     proto.Message
 	}
@@ -517,13 +541,13 @@ func (mock *MessageQueueClientMock) PublishMarshalCoreCalls() []struct {
 }
 
 // QueueSubscribe calls QueueSubscribeFunc.
-func (mock *MessageQueueClientMock) QueueSubscribe(topic string, msgFunc func([]byte) error) error {
+func (mock *MessageQueueClientMock) QueueSubscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
 	if mock.QueueSubscribeFunc == nil {
 		panic("MessageQueueClientMock.QueueSubscribeFunc: method is nil but MessageQueueClient.QueueSubscribe was just called")
 	}
 	callInfo := struct {
 		Topic   string
-		MsgFunc func([]byte) error
+		MsgFunc func(ctx context.Context, msg []byte) error
 	}{
 		Topic:   topic,
 		MsgFunc: msgFunc,
@@ -540,11 +564,11 @@ func (mock *MessageQueueClientMock) QueueSubscribe(topic string, msgFunc func([]
 //	len(mockedMessageQueueClient.QueueSubscribeCalls())
 func (mock *MessageQueueClientMock) QueueSubscribeCalls() []struct {
 	Topic   string
-	MsgFunc func([]byte) error
+	MsgFunc func(ctx context.Context, msg []byte) error
 } {
 	var calls []struct {
 		Topic   string
-		MsgFunc func([]byte) error
+		MsgFunc func(ctx context.Context, msg []byte) error
 	}
 	mock.lockQueueSubscribe.RLock()
 	calls = mock.calls.QueueSubscribe
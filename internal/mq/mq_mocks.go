@@ -1,3 +1,4 @@
 package mq
 
 //go:generate moq -pkg mocks -out ./mocks/message_queue_mock.go . MessageQueueClient
+//go:generate moq -pkg mocks -out ./mocks/message_subscriber_mock.go . MessageSubscriber
@@ -0,0 +1,88 @@
+package mq
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+)
+
+// PrefixedTopic returns the topic prefixed with the subject prefix, so that the services of multiple networks can share
+// a message queue. Without prefix the topic is returned unchanged.
+func PrefixedTopic(prefix string, topic string) string {
+	if prefix == "" {
+		return topic
+	}
+
+	return prefix + "." + topic
+}
+
+// PrefixedName returns the name of a stream or consumer prefixed with the subject prefix. Other than subjects, the
+// names of streams and consumers must not contain dots.
+func PrefixedName(prefix string, name string) string {
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "-" + name
+}
+
+// prefixClient publishes to and consumes from the topics prefixed with the subject prefix.
+type prefixClient struct {
+	MessageQueueClient
+	prefix string
+}
+
+// NewPrefixClient wraps the client prefixing all topics with the subject prefix.
+func NewPrefixClient(client MessageQueueClient, prefix string) MessageQueueClient {
+	if prefix == "" {
+		return client
+	}
+
+	return &prefixClient{MessageQueueClient: client, prefix: prefix}
+}
+
+func (c *prefixClient) topic(topic string) string {
+	return PrefixedTopic(c.prefix, topic)
+}
+
+func (c *prefixClient) PublishCore(ctx context.Context, topic string, data []byte) error {
+	return c.MessageQueueClient.PublishCore(ctx, c.topic(topic), data)
+}
+
+func (c *prefixClient) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) error {
+	return c.MessageQueueClient.PublishMarshalCore(ctx, c.topic(topic), m)
+}
+
+func (c *prefixClient) Publish(ctx context.Context, topic string, data []byte) error {
+	return c.MessageQueueClient.Publish(ctx, c.topic(topic), data)
+}
+
+func (c *prefixClient) PublishAsync(ctx context.Context, topic string, data []byte) error {
+	return c.MessageQueueClient.PublishAsync(ctx, c.topic(topic), data)
+}
+
+func (c *prefixClient) PublishMarshal(ctx context.Context, topic string, m proto.Message) error {
+	return c.MessageQueueClient.PublishMarshal(ctx, c.topic(topic), m)
+}
+
+func (c *prefixClient) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error {
+	return c.MessageQueueClient.PublishMarshalAsync(ctx, c.topic(topic), m)
+}
+
+func (c *prefixClient) Consume(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return c.MessageQueueClient.Consume(c.topic(topic), msgFunc)
+}
+
+func (c *prefixClient) ConsumeMsg(topic string, msgFunc func(msg jetstream.Msg) error) error {
+	return c.MessageQueueClient.ConsumeMsg(c.topic(topic), msgFunc)
+}
+
+func (c *prefixClient) QueueSubscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return c.MessageQueueClient.QueueSubscribe(c.topic(topic), msgFunc)
+}
+
+// Subscribe subscribes to the prefixed topic if the wrapped client is a MessageSubscriber.
+func (c *prefixClient) Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return Subscribe(c.MessageQueueClient, c.topic(topic), msgFunc)
+}
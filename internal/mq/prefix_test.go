@@ -0,0 +1,84 @@
+package mq_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/mq/mocks"
+)
+
+type subscribingClient struct {
+	*mocks.MessageQueueClientMock
+	*mocks.MessageSubscriberMock
+}
+
+func TestPrefixClient(t *testing.T) {
+	tt := []struct {
+		name   string
+		prefix string
+
+		expectedTopic string
+	}{
+		{
+			name: "no prefix",
+
+			expectedTopic: mq.SubmitTxTopic,
+		},
+		{
+			name:   "prefix",
+			prefix: "testnet",
+
+			expectedTopic: "testnet.submit-tx",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var topics []string
+			client := &subscribingClient{
+				MessageQueueClientMock: &mocks.MessageQueueClientMock{
+					PublishFunc: func(_ context.Context, topic string, _ []byte) error {
+						topics = append(topics, topic)
+						return nil
+					},
+				},
+				MessageSubscriberMock: &mocks.MessageSubscriberMock{
+					SubscribeFunc: func(topic string, _ func(ctx context.Context, msg []byte) error) error {
+						topics = append(topics, topic)
+						return nil
+					},
+				},
+			}
+
+			sut := mq.NewPrefixClient(client, tc.prefix)
+
+			// when
+			err := sut.Publish(context.Background(), mq.SubmitTxTopic, []byte{1})
+			require.NoError(t, err)
+			err = mq.Subscribe(sut, mq.SubmitTxTopic, func(_ context.Context, _ []byte) error { return nil })
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, []string{tc.expectedTopic, tc.expectedTopic}, topics)
+			assert.Equal(t, tc.expectedTopic, mq.PrefixedTopic(tc.prefix, mq.SubmitTxTopic))
+		})
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Run("subscriptions not supported", func(t *testing.T) {
+		// given
+		sut := mq.NewPrefixClient(&mocks.MessageQueueClientMock{}, "testnet")
+
+		// when
+		err := mq.Subscribe(sut, mq.SubmitTxTopic, func(_ context.Context, _ []byte) error { return nil })
+
+		// then
+		require.ErrorIs(t, err, mq.ErrSubscribeNotSupported)
+	})
+}
@@ -0,0 +1,199 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+)
+
+// LegacyVersion is the version of the messages published without schema version, i.e. by versions of ARC before the
+// schemas were versioned.
+const LegacyVersion = 1
+
+var (
+	ErrUnknownTopic      = errors.New("no schema registered for topic")
+	ErrUnknownVersion    = errors.New("unknown schema version")
+	ErrInvalidVersion    = errors.New("invalid schema version")
+	ErrUnexpectedMessage = errors.New("unexpected message type")
+	ErrFailedToMarshal   = errors.New("failed to marshal message")
+	ErrFailedToUnmarshal = errors.New("failed to unmarshal message")
+)
+
+// Version is an encoding of the messages of a topic. All versions of a topic decode into and encode from the same
+// protobuf message, the current message of the topic. Compatible changes of the protobuf message, e.g. new fields,
+// keep the version, a new version is only registered for an incompatible encoding.
+type Version struct {
+	Version   int
+	Marshal   func(m proto.Message) ([]byte, error)
+	Unmarshal func(data []byte, m proto.Message) error
+}
+
+// Proto returns the version which encodes the message as protobuf.
+func Proto(version int) Version {
+	return Version{
+		Version:   version,
+		Marshal:   proto.Marshal,
+		Unmarshal: proto.Unmarshal,
+	}
+}
+
+type topicSchema struct {
+	versions       map[int]Version
+	publishVersion int
+}
+
+// Registry contains the schema versions of the messages of the topics. Consumers decode all registered versions of a
+// topic, publishers encode the messages in the publish version of the topic. For rolling upgrades a new version is
+// first registered while the previous version is still published, and published only after all consumers have been
+// upgraded, so that services of mixed versions keep decoding each other's messages.
+type Registry struct {
+	topics map[string]*topicSchema
+}
+
+func NewRegistry() *Registry {
+	return &Registry{topics: make(map[string]*topicSchema)}
+}
+
+// Register registers the versions of the messages of the topic, the messages are published in publishVersion.
+func (r *Registry) Register(topic string, publishVersion int, versions ...Version) error {
+	ts := &topicSchema{versions: make(map[int]Version, len(versions)), publishVersion: publishVersion}
+	for _, v := range versions {
+		if v.Version < LegacyVersion {
+			return errors.Join(ErrInvalidVersion, fmt.Errorf("topic %s: version %d", topic, v.Version))
+		}
+		if _, found := ts.versions[v.Version]; found {
+			return errors.Join(ErrInvalidVersion, fmt.Errorf("topic %s: duplicate version %d", topic, v.Version))
+		}
+		ts.versions[v.Version] = v
+	}
+
+	if _, found := ts.versions[publishVersion]; !found {
+		return errors.Join(ErrUnknownVersion, fmt.Errorf("topic %s: publish version %d", topic, publishVersion))
+	}
+
+	r.topics[topic] = ts
+	return nil
+}
+
+// WithPublishVersions returns a copy of the registry publishing the messages of the topics in the given versions.
+func (r *Registry) WithPublishVersions(publishVersions map[string]int) (*Registry, error) {
+	c := &Registry{topics: make(map[string]*topicSchema, len(r.topics))}
+	for topic, ts := range r.topics {
+		c.topics[topic] = &topicSchema{versions: ts.versions, publishVersion: ts.publishVersion}
+	}
+
+	for topic, version := range publishVersions {
+		ts, found := c.topics[topic]
+		if !found {
+			return nil, errors.Join(ErrUnknownTopic, fmt.Errorf("topic %s", topic))
+		}
+		if _, found = ts.versions[version]; !found {
+			return nil, errors.Join(ErrUnknownVersion, fmt.Errorf("topic %s: publish version %d", topic, version))
+		}
+		ts.publishVersion = version
+	}
+
+	return c, nil
+}
+
+// Versions returns the registered versions of the topic in ascending order.
+func (r *Registry) Versions(topic string) []int {
+	ts, found := r.topics[topic]
+	if !found {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(ts.versions))
+}
+
+// PublishVersion returns the version in which the messages of the topic are published.
+func (r *Registry) PublishVersion(topic string) (int, error) {
+	ts, found := r.topics[topic]
+	if !found {
+		return 0, errors.Join(ErrUnknownTopic, fmt.Errorf("topic %s", topic))
+	}
+
+	return ts.publishVersion, nil
+}
+
+// Marshal encodes the message in the publish version of the topic. The returned context carries the version, so that
+// the message is published with the schema version header.
+func (r *Registry) Marshal(ctx context.Context, topic string, m proto.Message) (context.Context, []byte, error) {
+	ts, found := r.topics[topic]
+	if !found {
+		return ctx, nil, errors.Join(ErrUnknownTopic, fmt.Errorf("topic %s", topic))
+	}
+
+	data, err := r.MarshalVersion(topic, ts.publishVersion, m)
+	if err != nil {
+		return ctx, nil, err
+	}
+
+	return nats_jetstream.ContextWithSchemaVersion(ctx, strconv.Itoa(ts.publishVersion)), data, nil
+}
+
+// MarshalVersion encodes the message in the given version of the topic.
+func (r *Registry) MarshalVersion(topic string, version int, m proto.Message) ([]byte, error) {
+	v, err := r.version(topic, version)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := v.Marshal(m)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToMarshal, fmt.Errorf("topic %s: version %d", topic, version), err)
+	}
+
+	return data, nil
+}
+
+// Unmarshal decodes the data of a message of the topic into m in the version carried by the context of the consumer.
+// Messages without version are decoded in the legacy version.
+func (r *Registry) Unmarshal(ctx context.Context, topic string, data []byte, m proto.Message) error {
+	version := LegacyVersion
+	if header := nats_jetstream.SchemaVersionFromContext(ctx); header != "" {
+		var err error
+		version, err = strconv.Atoi(header)
+		if err != nil {
+			return errors.Join(ErrInvalidVersion, fmt.Errorf("topic %s: version %q", topic, header))
+		}
+	}
+
+	return r.UnmarshalVersion(topic, version, data, m)
+}
+
+// UnmarshalVersion decodes the data of a message of the topic in the given version into m.
+func (r *Registry) UnmarshalVersion(topic string, version int, data []byte, m proto.Message) error {
+	v, err := r.version(topic, version)
+	if err != nil {
+		return err
+	}
+
+	err = v.Unmarshal(data, m)
+	if err != nil {
+		return errors.Join(ErrFailedToUnmarshal, fmt.Errorf("topic %s: version %d", topic, version), err)
+	}
+
+	return nil
+}
+
+func (r *Registry) version(topic string, version int) (Version, error) {
+	ts, found := r.topics[topic]
+	if !found {
+		return Version{}, errors.Join(ErrUnknownTopic, fmt.Errorf("topic %s", topic))
+	}
+
+	v, found := ts.versions[version]
+	if !found {
+		return Version{}, errors.Join(ErrUnknownVersion, fmt.Errorf("topic %s: version %d", topic, version))
+	}
+
+	return v, nil
+}
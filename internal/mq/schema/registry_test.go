@@ -0,0 +1,207 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/bitcoin-sv/arc/internal/mq/schema"
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+)
+
+// prefixVersion encodes the message as protobuf with a prefix.
+func prefixVersion(version int) schema.Version {
+	return schema.Version{
+		Version: version,
+		Marshal: func(m proto.Message) ([]byte, error) {
+			data, err := proto.Marshal(m)
+			return append([]byte("v2:"), data...), err
+		},
+		Unmarshal: func(data []byte, m proto.Message) error {
+			return proto.Unmarshal(data[3:], m)
+		},
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	tt := []struct {
+		name           string
+		publishVersion int
+		versions       []schema.Version
+
+		expectedErr error
+	}{
+		{
+			name:           "success",
+			publishVersion: 1,
+			versions:       []schema.Version{schema.Proto(1), prefixVersion(2)},
+		},
+		{
+			name:           "unknown publish version",
+			publishVersion: 3,
+			versions:       []schema.Version{schema.Proto(1), prefixVersion(2)},
+
+			expectedErr: schema.ErrUnknownVersion,
+		},
+		{
+			name:           "duplicate version",
+			publishVersion: 1,
+			versions:       []schema.Version{schema.Proto(1), prefixVersion(1)},
+
+			expectedErr: schema.ErrInvalidVersion,
+		},
+		{
+			name:           "invalid version",
+			publishVersion: 1,
+			versions:       []schema.Version{schema.Proto(0), schema.Proto(1)},
+
+			expectedErr: schema.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := schema.NewRegistry()
+
+			// when
+			err := sut.Register("topic", tc.publishVersion, tc.versions...)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, []int{1, 2}, sut.Versions("topic"))
+		})
+	}
+}
+
+func TestRegistry_MarshalUnmarshal(t *testing.T) {
+	tt := []struct {
+		name            string
+		publishVersions map[string]int
+
+		expectedVersion string
+		expectedErr     error
+	}{
+		{
+			name: "publish version",
+
+			expectedVersion: "1",
+		},
+		{
+			name:            "pinned publish version",
+			publishVersions: map[string]int{"topic": 2},
+
+			expectedVersion: "2",
+		},
+		{
+			name:            "unknown pinned version",
+			publishVersions: map[string]int{"topic": 3},
+
+			expectedErr: schema.ErrUnknownVersion,
+		},
+		{
+			name:            "unknown topic",
+			publishVersions: map[string]int{"other": 1},
+
+			expectedErr: schema.ErrUnknownTopic,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			registry := schema.NewRegistry()
+			require.NoError(t, registry.Register("topic", 1, schema.Proto(1), prefixVersion(2)))
+
+			sut, err := registry.WithPublishVersions(tc.publishVersions)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// when
+			ctx, data, err := sut.Marshal(context.Background(), "topic", wrapperspb.String("msg"))
+			require.NoError(t, err)
+
+			// then
+			version := nats_jetstream.SchemaVersionFromContext(ctx)
+			assert.Equal(t, tc.expectedVersion, version)
+
+			// the consumer decodes the message in the version of its header
+			actual := &wrapperspb.StringValue{}
+			require.NoError(t, registry.Unmarshal(nats_jetstream.ContextWithSchemaVersion(context.Background(), version), "topic", data, actual))
+			assert.Equal(t, "msg", actual.GetValue())
+		})
+	}
+}
+
+func TestRegistry_Unmarshal(t *testing.T) {
+	legacy, err := proto.Marshal(wrapperspb.String("msg"))
+	require.NoError(t, err)
+
+	tt := []struct {
+		name    string
+		version string
+		data    []byte
+
+		expectedErr error
+	}{
+		{
+			name: "without version",
+			data: legacy,
+		},
+		{
+			name:    "version 2",
+			version: "2",
+			data:    append([]byte("v2:"), legacy...),
+		},
+		{
+			name:    "unknown version",
+			version: "3",
+			data:    legacy,
+
+			expectedErr: schema.ErrUnknownVersion,
+		},
+		{
+			name:    "invalid version",
+			version: "two",
+			data:    legacy,
+
+			expectedErr: schema.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := schema.NewRegistry()
+			require.NoError(t, sut.Register("topic", 1, schema.Proto(1), prefixVersion(2)))
+
+			ctx := context.Background()
+			if tc.version != "" {
+				ctx = nats_jetstream.ContextWithSchemaVersion(ctx, tc.version)
+			}
+
+			// when
+			actual := &wrapperspb.StringValue{}
+			err := sut.Unmarshal(ctx, "topic", tc.data, actual)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "msg", actual.GetValue())
+		})
+	}
+}
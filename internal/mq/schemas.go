@@ -0,0 +1,107 @@
+package mq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/mq/schema"
+)
+
+// Schemas contains the schema versions of the messages exchanged between the services. Version 1 of all topics is the
+// encoding used before the schemas were versioned.
+var Schemas = mustRegisterSchemas()
+
+func mustRegisterSchemas() *schema.Registry {
+	r := schema.NewRegistry()
+
+	for _, err := range []error{
+		r.Register(SubmitTxTopic, 1, schema.Proto(1)),     // metamorph_api.PostTransactionRequest
+		r.Register(MinedTxsTopic, 1, schema.Proto(1)),     // blocktx_api.TransactionBlocks
+		r.Register(RegisterTxsTopic, 1, schema.Proto(1)),  // blocktx_api.Transactions
+		r.Register(CallbackTopic, 1, schema.Proto(1)),     // callbacker_api.SendRequest
+		r.Register(StatusUpdateTopic, 1, schema.Proto(1)), // blocktx_api.Transactions
+		r.Register(ReorgTopic, 1, schema.Proto(1)),        // blocktx_api.Block
+		// version 1 is the raw hash of the transaction, version 2 is published once all blocktx instances decode it
+		r.Register(RegisterTxTopic, 1, rawHashVersion(1), schema.Proto(2)), // blocktx_api.Transaction
+	} {
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return r
+}
+
+// rawHashVersion encodes a blocktx_api.Transaction as the raw bytes of its hash.
+func rawHashVersion(version int) schema.Version {
+	return schema.Version{
+		Version: version,
+		Marshal: func(m proto.Message) ([]byte, error) {
+			tx, ok := m.(*blocktx_api.Transaction)
+			if !ok {
+				return nil, errors.Join(schema.ErrUnexpectedMessage, fmt.Errorf("%T", m))
+			}
+			return tx.GetHash(), nil
+		},
+		Unmarshal: func(data []byte, m proto.Message) error {
+			tx, ok := m.(*blocktx_api.Transaction)
+			if !ok {
+				return errors.Join(schema.ErrUnexpectedMessage, fmt.Errorf("%T", m))
+			}
+			tx.Hash = append([]byte(nil), data...)
+			return nil
+		},
+	}
+}
+
+// Unmarshal decodes the data of a message consumed with ctx from the topic into m.
+func Unmarshal(ctx context.Context, topic string, data []byte, m proto.Message) error {
+	return Schemas.Unmarshal(ctx, topic, data, m)
+}
+
+// schemaClient publishes the proto messages in the publish version of their topics.
+type schemaClient struct {
+	MessageQueueClient
+	schemas *schema.Registry
+}
+
+// NewSchemaClient wraps the client publishing the proto messages in the publish versions of the schemas.
+func NewSchemaClient(client MessageQueueClient, schemas *schema.Registry) MessageQueueClient {
+	return &schemaClient{MessageQueueClient: client, schemas: schemas}
+}
+
+func (c *schemaClient) PublishMarshal(ctx context.Context, topic string, m proto.Message) error {
+	ctx, data, err := c.schemas.Marshal(ctx, topic, m)
+	if err != nil {
+		return err
+	}
+
+	return c.Publish(ctx, topic, data)
+}
+
+func (c *schemaClient) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error {
+	ctx, data, err := c.schemas.Marshal(ctx, topic, m)
+	if err != nil {
+		return err
+	}
+
+	return c.PublishAsync(ctx, topic, data)
+}
+
+func (c *schemaClient) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) error {
+	ctx, data, err := c.schemas.Marshal(ctx, topic, m)
+	if err != nil {
+		return err
+	}
+
+	return c.PublishCore(ctx, topic, data)
+}
+
+// Subscribe subscribes to the topic if the wrapped client is a MessageSubscriber.
+func (c *schemaClient) Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return Subscribe(c.MessageQueueClient, topic, msgFunc)
+}
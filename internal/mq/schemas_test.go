@@ -0,0 +1,199 @@
+package mq_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/blocktx_api"
+	"github.com/bitcoin-sv/arc/internal/callbacker/callbacker_api"
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/mq/mocks"
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+)
+
+// schemaFixture is a message encoded in a version of the schema of a topic. The fixtures must never be changed, they
+// ensure that messages published by other versions of ARC keep being decoded.
+type schemaFixture struct {
+	topic    string
+	version  int
+	encoded  string
+	expected proto.Message
+	new      func() proto.Message
+}
+
+var schemaFixtures = []schemaFixture{
+	{
+		topic:   mq.SubmitTxTopic,
+		version: 1,
+		encoded: "0a1c68747470733a2f2f63616c6c6261636b2e6578616d706c652e636f6d1205746f6b656e2203010203285a720674656e616e74",
+		expected: &metamorph_api.PostTransactionRequest{
+			CallbackUrl:   "https://callback.example.com",
+			CallbackToken: "token",
+			RawTx:         []byte{1, 2, 3},
+			WaitForStatus: metamorph_api.Status_SEEN_ON_NETWORK,
+			Tenant:        "tenant",
+		},
+		new: func() proto.Message { return &metamorph_api.PostTransactionRequest{} },
+	},
+	{
+		topic:   mq.MinedTxsTopic,
+		version: 1,
+		encoded: "0a0e0a01aa10641a01bb22026665280a",
+		expected: &blocktx_api.TransactionBlocks{TransactionBlocks: []*blocktx_api.TransactionBlock{{
+			BlockHash:       []byte{0xaa},
+			BlockHeight:     100,
+			TransactionHash: []byte{0xbb},
+			MerklePath:      "fe",
+			BlockStatus:     blocktx_api.Status_LONGEST,
+		}}},
+		new: func() proto.Message { return &blocktx_api.TransactionBlocks{} },
+	},
+	{
+		topic:    mq.RegisterTxsTopic,
+		version:  1,
+		encoded:  "0a030a01cc0a030a01dd",
+		expected: &blocktx_api.Transactions{Transactions: []*blocktx_api.Transaction{{Hash: []byte{0xcc}}, {Hash: []byte{0xdd}}}},
+		new:      func() proto.Message { return &blocktx_api.Transactions{} },
+	},
+	{
+		topic:   mq.CallbackTopic,
+		version: 1,
+		encoded: "0a250a1c68747470733a2f2f63616c6c6261636b2e6578616d706c652e636f6d1205746f6b656e12047478696418784064",
+		expected: &callbacker_api.SendRequest{
+			CallbackRouting: &callbacker_api.CallbackRouting{Url: "https://callback.example.com", Token: "token"},
+			Txid:            "txid",
+			Status:          callbacker_api.Status_MINED,
+			BlockHeight:     100,
+		},
+		new: func() proto.Message { return &callbacker_api.SendRequest{} },
+	},
+	{
+		topic:    mq.StatusUpdateTopic,
+		version:  1,
+		encoded:  "0a030a01cc0a030a01dd",
+		expected: &blocktx_api.Transactions{Transactions: []*blocktx_api.Transaction{{Hash: []byte{0xcc}}, {Hash: []byte{0xdd}}}},
+		new:      func() proto.Message { return &blocktx_api.Transactions{} },
+	},
+	{
+		topic:    mq.ReorgTopic,
+		version:  1,
+		encoded:  "0a01aa2064300a",
+		expected: &blocktx_api.Block{Hash: []byte{0xaa}, Height: 100, Status: blocktx_api.Status_LONGEST},
+		new:      func() proto.Message { return &blocktx_api.Block{} },
+	},
+	{
+		topic:    mq.RegisterTxTopic,
+		version:  1,
+		encoded:  "ee",
+		expected: &blocktx_api.Transaction{Hash: []byte{0xee}},
+		new:      func() proto.Message { return &blocktx_api.Transaction{} },
+	},
+	{
+		topic:    mq.RegisterTxTopic,
+		version:  2,
+		encoded:  "0a01ee",
+		expected: &blocktx_api.Transaction{Hash: []byte{0xee}},
+		new:      func() proto.Message { return &blocktx_api.Transaction{} },
+	},
+}
+
+func TestSchemas_Compatibility(t *testing.T) {
+	t.Run("all versions have fixtures", func(t *testing.T) {
+		for _, topic := range []string{mq.SubmitTxTopic, mq.MinedTxsTopic, mq.RegisterTxTopic, mq.RegisterTxsTopic, mq.CallbackTopic, mq.StatusUpdateTopic, mq.ReorgTopic} {
+			var fixtureVersions []int
+			for _, f := range schemaFixtures {
+				if f.topic == topic {
+					fixtureVersions = append(fixtureVersions, f.version)
+				}
+			}
+
+			assert.Equal(t, mq.Schemas.Versions(topic), fixtureVersions, "topic %s", topic)
+		}
+	})
+
+	for _, f := range schemaFixtures {
+		t.Run(f.topic+" v"+strconv.Itoa(f.version), func(t *testing.T) {
+			// given
+			data, err := hex.DecodeString(f.encoded)
+			require.NoError(t, err)
+
+			// when
+			actual := f.new()
+			err = mq.Schemas.UnmarshalVersion(f.topic, f.version, data, actual)
+
+			// then
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(f.expected, actual), "expected %v, got %v", f.expected, actual)
+
+			// messages are encoded the same way by all versions of ARC
+			encoded, err := mq.Schemas.MarshalVersion(f.topic, f.version, f.expected)
+			require.NoError(t, err)
+			assert.Equal(t, f.encoded, hex.EncodeToString(encoded))
+
+			// messages without schema version are decoded in the legacy version
+			if f.version == 1 {
+				actual = f.new()
+				require.NoError(t, mq.Unmarshal(context.Background(), f.topic, data, actual))
+				assert.True(t, proto.Equal(f.expected, actual), "expected %v, got %v", f.expected, actual)
+			}
+		})
+	}
+}
+
+func TestSchemaClient_PublishMarshal(t *testing.T) {
+	tt := []struct {
+		name            string
+		publishVersions map[string]int
+
+		expectedData    string
+		expectedVersion string
+	}{
+		{
+			name: "legacy version",
+
+			expectedData:    "ee",
+			expectedVersion: "1",
+		},
+		{
+			name:            "pinned version",
+			publishVersions: map[string]int{mq.RegisterTxTopic: 2},
+
+			expectedData:    "0a01ee",
+			expectedVersion: "2",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var publishedData []byte
+			var publishedVersion string
+			client := &mocks.MessageQueueClientMock{
+				PublishAsyncFunc: func(ctx context.Context, _ string, data []byte) error {
+					publishedData = data
+					publishedVersion = nats_jetstream.SchemaVersionFromContext(ctx)
+					return nil
+				},
+			}
+
+			schemas, err := mq.Schemas.WithPublishVersions(tc.publishVersions)
+			require.NoError(t, err)
+			sut := mq.NewSchemaClient(client, schemas)
+
+			// when
+			err = sut.PublishMarshalAsync(context.Background(), mq.RegisterTxTopic, &blocktx_api.Transaction{Hash: []byte{0xee}})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedData, hex.EncodeToString(publishedData))
+			assert.Equal(t, tc.expectedVersion, publishedVersion)
+		})
+	}
+}
@@ -0,0 +1,629 @@
+package mq
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+)
+
+const (
+	spoolMaxBytesDefault      = 256 * 1024 * 1024
+	spoolDrainIntervalDefault = time.Second
+	spoolFileExtension        = ".spool"
+	// spoolRecordHeaderSize is the size of the header of a spooled message: the kind of publishing, the length of the
+	// schema version and the length of the data
+	spoolRecordHeaderSize = 6
+)
+
+var (
+	ErrSpoolFull              = errors.New("message queue spool is full")
+	ErrSpoolMessageTooLarge   = errors.New("message is larger than the message queue spool")
+	ErrSpoolClosed            = errors.New("message queue spool is closed")
+	ErrFailedToCreateSpool    = errors.New("failed to create message queue spool")
+	ErrFailedToWriteSpool     = errors.New("failed to write to message queue spool")
+	ErrFailedToReadSpool      = errors.New("failed to read from message queue spool")
+	ErrFailedToCompactSpool   = errors.New("failed to compact message queue spool")
+	errSpoolRecordIncomplete  = errors.New("incomplete spool record")
+	transientPublishingErrors = []error{
+		nats.ErrConnectionClosed,
+		nats.ErrConnectionDraining,
+		nats.ErrConnectionReconnecting,
+		nats.ErrReconnectBufExceeded,
+		nats.ErrTimeout,
+		nats.ErrNoResponders,
+		jetstream.ErrNoStreamResponse,
+		context.DeadlineExceeded,
+	}
+)
+
+type spoolKind byte
+
+// spoolRecord is a spooled message, the schema version of the message is published with it once it is drained.
+type spoolRecord struct {
+	kind          spoolKind
+	schemaVersion string
+	data          []byte
+}
+
+func (r spoolRecord) size() int64 {
+	return int64(spoolRecordHeaderSize + len(r.schemaVersion) + len(r.data))
+}
+
+const (
+	spoolKindCore spoolKind = iota + 1
+	spoolKindStream
+)
+
+// subjectSpool is the append only file of the messages spooled for a subject. The messages before offset have been
+// published already.
+type subjectSpool struct {
+	mu     sync.Mutex
+	topic  string
+	path   string
+	file   *os.File
+	offset int64
+	size   int64
+}
+
+func (s *subjectSpool) pending() bool {
+	return s.size > s.offset
+}
+
+// SpoolingClient publishes the messages of the wrapped client and spools them to a local directory while the message
+// queue cannot be reached. The spooled messages of a subject are published in order once the connection is back, and
+// messages for a subject with spooled messages are spooled as well until the spool of the subject is drained, so that
+// the ordering per subject is preserved. The spool is bounded, publishing blocks while it is full until messages were
+// drained or the context of the caller is done.
+//
+// The spool survives restarts. Messages may be published twice if the service stops while the spool is drained, and
+// the trace context of spooled messages is not kept, unlike their schema version.
+type SpoolingClient struct {
+	MessageQueueClient
+
+	logger        *slog.Logger
+	dir           string
+	maxBytes      int64
+	drainInterval time.Duration
+
+	mu       sync.Mutex
+	subjects map[string]*subjectSpool
+	bytes    int64
+	// spaceCh is closed and replaced when spooled messages have been drained
+	spaceCh chan struct{}
+	drainCh chan struct{}
+
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+// WithSpoolMaxBytes sets the maximum size of all spooled messages.
+func WithSpoolMaxBytes(maxBytes int64) func(*SpoolingClient) {
+	return func(c *SpoolingClient) {
+		c.maxBytes = maxBytes
+	}
+}
+
+// WithSpoolDrainInterval sets the interval in which the spooled messages are published while the connection is back.
+func WithSpoolDrainInterval(d time.Duration) func(*SpoolingClient) {
+	return func(c *SpoolingClient) {
+		c.drainInterval = d
+	}
+}
+
+// NewSpoolingClient wraps the client with a spool in dir. The messages spooled before a restart are loaded and
+// published once the message queue can be reached.
+func NewSpoolingClient(logger *slog.Logger, client MessageQueueClient, dir string, opts ...func(*SpoolingClient)) (*SpoolingClient, error) {
+	c := &SpoolingClient{
+		MessageQueueClient: client,
+		logger:             logger.With(slog.String("module", "mq-spool")),
+		dir:                dir,
+		maxBytes:           spoolMaxBytesDefault,
+		drainInterval:      spoolDrainIntervalDefault,
+		subjects:           make(map[string]*subjectSpool),
+		spaceCh:            make(chan struct{}),
+		drainCh:            make(chan struct{}, 1),
+		waitGroup:          &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateSpool, err)
+	}
+
+	err = c.load()
+	if err != nil {
+		return nil, errors.Join(ErrFailedToCreateSpool, err)
+	}
+
+	c.ctx, c.cancelAll = context.WithCancel(context.Background())
+	c.start()
+
+	return c, nil
+}
+
+// load opens the spools left in the directory. A message which was still being written when the service stopped is
+// discarded.
+func (c *SpoolingClient) load() error {
+	paths, err := filepath.Glob(filepath.Join(c.dir, "*"+spoolFileExtension))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		topic, err := url.PathUnescape(strings.TrimSuffix(filepath.Base(path), spoolFileExtension))
+		if err != nil {
+			c.logger.Warn("Ignoring spool file", slog.String("file", path), slog.String("err", err.Error()))
+			continue
+		}
+
+		s, err := c.openSubject(topic)
+		if err != nil {
+			return err
+		}
+
+		complete, err := completeRecordsSize(s.file)
+		if err != nil {
+			return err
+		}
+		if complete < s.size {
+			c.logger.Warn("Discarding incomplete spooled message", slog.String("topic", topic))
+			err = s.file.Truncate(complete)
+			if err != nil {
+				return err
+			}
+			s.size = complete
+		}
+
+		c.bytes += s.size
+		if s.pending() {
+			c.logger.Info("Loaded spooled messages", slog.String("topic", topic), slog.Int64("bytes", s.size))
+		}
+	}
+
+	return nil
+}
+
+// completeRecordsSize returns the size of the complete records of a spool file.
+func completeRecordsSize(file *os.File) (int64, error) {
+	var offset int64
+	for {
+		_, n, err := readRecord(file, offset)
+		if errors.Is(err, io.EOF) || errors.Is(err, errSpoolRecordIncomplete) {
+			return offset, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		offset += n
+	}
+}
+
+func (c *SpoolingClient) openSubject(topic string) (*subjectSpool, error) {
+	path := filepath.Join(c.dir, url.PathEscape(topic)+spoolFileExtension)
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600) // #nosec G304 -- the path is escaped
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	s := &subjectSpool{topic: topic, path: path, file: file, size: info.Size()}
+	c.subjects[topic] = s
+
+	return s, nil
+}
+
+func (c *SpoolingClient) subject(topic string) (*subjectSpool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, found := c.subjects[topic]
+	if found {
+		return s, nil
+	}
+
+	return c.openSubject(topic)
+}
+
+func (c *SpoolingClient) start() {
+	c.waitGroup.Add(1)
+	go func() {
+		defer c.waitGroup.Done()
+		ticker := time.NewTicker(c.drainInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case <-ticker.C:
+			case <-c.drainCh:
+			}
+
+			c.drain()
+		}
+	}()
+}
+
+// drain publishes the spooled messages subject by subject while connected.
+func (c *SpoolingClient) drain() {
+	c.mu.Lock()
+	subjects := make([]*subjectSpool, 0, len(c.subjects))
+	for _, s := range c.subjects {
+		subjects = append(subjects, s)
+	}
+	c.mu.Unlock()
+
+	for _, s := range subjects {
+		if c.ctx.Err() != nil || !c.IsConnected() {
+			return
+		}
+
+		err := c.drainSubject(s)
+		if err != nil {
+			c.logger.Warn("Failed to publish spooled messages", slog.String("topic", s.topic), slog.String("err", err.Error()))
+		}
+	}
+}
+
+func (c *SpoolingClient) drainSubject(s *subjectSpool) error {
+	var published int64
+	defer func() {
+		if published > 0 {
+			c.release(published)
+		}
+	}()
+
+	// the messages are read and published one by one so that appending to the spool is not blocked while publishing
+	for {
+		s.mu.Lock()
+		if !s.pending() {
+			err := s.reset()
+			s.mu.Unlock()
+			return err
+		}
+		record, n, err := readRecord(s.file, s.offset)
+		s.mu.Unlock()
+		if err != nil {
+			return errors.Join(ErrFailedToReadSpool, err)
+		}
+
+		err = c.publish(c.ctx, s.topic, record)
+		if err != nil && !isTransientPublishingError(err) {
+			// the message would block the subject forever
+			c.logger.Error("Dropping spooled message", slog.String("topic", s.topic), slog.String("err", err.Error()))
+			err = nil
+		}
+		if err != nil {
+			s.mu.Lock()
+			compactErr := s.compact()
+			s.mu.Unlock()
+			return errors.Join(err, compactErr)
+		}
+
+		s.mu.Lock()
+		s.offset += n
+		s.mu.Unlock()
+		published += n
+	}
+}
+
+// reset truncates the drained spool of the subject.
+func (s *subjectSpool) reset() error {
+	if s.offset == 0 {
+		return nil
+	}
+
+	err := s.file.Truncate(0)
+	if err != nil {
+		return errors.Join(ErrFailedToCompactSpool, err)
+	}
+	s.offset = 0
+	s.size = 0
+
+	return nil
+}
+
+// compact removes the published messages from the spool of the subject, so that they are not published again after a
+// restart.
+func (s *subjectSpool) compact() error {
+	if s.offset == 0 {
+		return nil
+	}
+
+	tmpPath := s.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o600) // #nosec G304 -- the path is escaped
+	if err != nil {
+		return errors.Join(ErrFailedToCompactSpool, err)
+	}
+
+	_, err = io.Copy(tmp, io.NewSectionReader(s.file, s.offset, s.size-s.offset))
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return errors.Join(ErrFailedToCompactSpool, err)
+	}
+
+	// open files can't be replaced on windows, therefore the spool is closed before and reopened after the rename
+	_ = s.file.Close()
+	err = os.Rename(tmpPath, s.path)
+	if err != nil {
+		_ = os.Remove(tmpPath)
+	} else {
+		s.size -= s.offset
+		s.offset = 0
+	}
+
+	file, openErr := os.OpenFile(s.path, os.O_CREATE|os.O_RDWR, 0o600) // #nosec G304 -- the path is escaped
+	if openErr != nil {
+		return errors.Join(ErrFailedToCompactSpool, err, openErr)
+	}
+	s.file = file
+
+	if err != nil {
+		return errors.Join(ErrFailedToCompactSpool, err)
+	}
+
+	return nil
+}
+
+// readRecord returns the spooled message at the offset and the size of its record.
+func readRecord(r io.ReaderAt, offset int64) (spoolRecord, int64, error) {
+	header := make([]byte, spoolRecordHeaderSize)
+	n, err := r.ReadAt(header, offset)
+	if err != nil {
+		if errors.Is(err, io.EOF) && n > 0 {
+			return spoolRecord{}, 0, errSpoolRecordIncomplete
+		}
+		return spoolRecord{}, 0, err
+	}
+
+	body := make([]byte, int(header[1])+int(binary.BigEndian.Uint32(header[2:])))
+	_, err = r.ReadAt(body, offset+spoolRecordHeaderSize)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return spoolRecord{}, 0, errSpoolRecordIncomplete
+		}
+		return spoolRecord{}, 0, err
+	}
+
+	record := spoolRecord{
+		kind:          spoolKind(header[0]),
+		schemaVersion: string(body[:header[1]]),
+		data:          body[header[1]:],
+	}
+
+	return record, record.size(), nil
+}
+
+func (r spoolRecord) encode() []byte {
+	b := make([]byte, r.size())
+	b[0] = byte(r.kind)
+	b[1] = byte(len(r.schemaVersion))
+	binary.BigEndian.PutUint32(b[2:], uint32(len(r.data))) // #nosec G115
+	copy(b[spoolRecordHeaderSize:], r.schemaVersion)
+	copy(b[spoolRecordHeaderSize+len(r.schemaVersion):], r.data)
+
+	return b
+}
+
+func (c *SpoolingClient) publish(ctx context.Context, topic string, record spoolRecord) error {
+	if record.schemaVersion != "" {
+		ctx = nats_jetstream.ContextWithSchemaVersion(ctx, record.schemaVersion)
+	}
+
+	if record.kind == spoolKindCore {
+		return c.MessageQueueClient.PublishCore(ctx, topic, record.data)
+	}
+
+	return c.MessageQueueClient.Publish(ctx, topic, record.data)
+}
+
+// reserve blocks until the spool has space for the message or the context is done.
+func (c *SpoolingClient) reserve(ctx context.Context, size int64) error {
+	if size > c.maxBytes {
+		return errors.Join(ErrSpoolMessageTooLarge, fmt.Errorf("size: %d bytes", size))
+	}
+
+	for {
+		c.mu.Lock()
+		if c.bytes+size <= c.maxBytes {
+			c.bytes += size
+			c.mu.Unlock()
+			return nil
+		}
+		spaceCh := c.spaceCh
+		c.mu.Unlock()
+
+		select {
+		case <-spaceCh:
+		case <-ctx.Done():
+			return errors.Join(ErrSpoolFull, ctx.Err())
+		case <-c.ctx.Done():
+			return ErrSpoolClosed
+		}
+	}
+}
+
+func (c *SpoolingClient) release(size int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bytes -= size
+	close(c.spaceCh)
+	c.spaceCh = make(chan struct{})
+}
+
+func (c *SpoolingClient) spool(ctx context.Context, kind spoolKind, topic string, data []byte) error {
+	record := spoolRecord{kind: kind, schemaVersion: nats_jetstream.SchemaVersionFromContext(ctx), data: data}
+	if len(record.schemaVersion) > math.MaxUint8 {
+		return errors.Join(ErrFailedToWriteSpool, fmt.Errorf("schema version %q too long", record.schemaVersion))
+	}
+
+	size := record.size()
+	err := c.reserve(ctx, size)
+	if err != nil {
+		return err
+	}
+
+	s, err := c.subject(topic)
+	if err != nil {
+		c.release(size)
+		return errors.Join(ErrFailedToWriteSpool, err)
+	}
+
+	s.mu.Lock()
+	_, err = s.file.WriteAt(record.encode(), s.size)
+	if err == nil {
+		s.size += size
+	}
+	s.mu.Unlock()
+	if err != nil {
+		c.release(size)
+		return errors.Join(ErrFailedToWriteSpool, err)
+	}
+
+	select {
+	case c.drainCh <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// publishOrSpool publishes the message if connected and no messages of the subject are spooled, otherwise or if
+// publishing fails transiently the message is spooled.
+func (c *SpoolingClient) publishOrSpool(ctx context.Context, kind spoolKind, topic string, data []byte, publish func() error) error {
+	s, err := c.subject(topic)
+	if err != nil {
+		return errors.Join(ErrFailedToWriteSpool, err)
+	}
+
+	s.mu.Lock()
+	direct := !s.pending() && c.IsConnected()
+	s.mu.Unlock()
+
+	if direct {
+		err = publish()
+		if err == nil || !isTransientPublishingError(err) || ctx.Err() != nil {
+			return err
+		}
+	}
+
+	return c.spool(ctx, kind, topic, data)
+}
+
+func isTransientPublishingError(err error) bool {
+	for _, transientErr := range transientPublishingErrors {
+		if errors.Is(err, transientErr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *SpoolingClient) PublishCore(ctx context.Context, topic string, data []byte) error {
+	return c.publishOrSpool(ctx, spoolKindCore, topic, data, func() error {
+		return c.MessageQueueClient.PublishCore(ctx, topic, data)
+	})
+}
+
+func (c *SpoolingClient) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.PublishCore(ctx, topic, data)
+}
+
+func (c *SpoolingClient) Publish(ctx context.Context, topic string, data []byte) error {
+	return c.publishOrSpool(ctx, spoolKindStream, topic, data, func() error {
+		return c.MessageQueueClient.Publish(ctx, topic, data)
+	})
+}
+
+// PublishAsync publishes the message without waiting for the acknowledgement, spooled messages are published
+// synchronously when the spool is drained.
+func (c *SpoolingClient) PublishAsync(ctx context.Context, topic string, data []byte) error {
+	return c.publishOrSpool(ctx, spoolKindStream, topic, data, func() error {
+		return c.MessageQueueClient.PublishAsync(ctx, topic, data)
+	})
+}
+
+func (c *SpoolingClient) PublishMarshal(ctx context.Context, topic string, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.Publish(ctx, topic, data)
+}
+
+func (c *SpoolingClient) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) error {
+	data, err := proto.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return c.PublishAsync(ctx, topic, data)
+}
+
+// Subscribe subscribes to the topic if the wrapped client is a MessageSubscriber.
+func (c *SpoolingClient) Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	return Subscribe(c.MessageQueueClient, topic, msgFunc)
+}
+
+// Shutdown stops the draining and shuts down the wrapped client, the messages left in the spool are published after
+// the next start.
+func (c *SpoolingClient) Shutdown() {
+	c.cancelAll()
+	c.waitGroup.Wait()
+
+	c.mu.Lock()
+	for _, s := range c.subjects {
+		s.mu.Lock()
+		err := s.compact()
+		if err != nil {
+			c.logger.Error("Failed to compact spool", slog.String("topic", s.topic), slog.String("err", err.Error()))
+		}
+		_ = s.file.Close()
+		s.mu.Unlock()
+	}
+	c.mu.Unlock()
+
+	c.MessageQueueClient.Shutdown()
+}
@@ -0,0 +1,235 @@
+package mq_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/mq"
+	"github.com/bitcoin-sv/arc/internal/mq/mocks"
+	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
+)
+
+type publishedMsg struct {
+	kind          string
+	topic         string
+	data          string
+	schemaVersion string
+}
+
+// fakeMq records the published messages while connected and not failing.
+type fakeMq struct {
+	mu         sync.Mutex
+	connected  bool
+	publishErr error
+	published  []publishedMsg
+}
+
+func (f *fakeMq) set(connected bool, publishErr error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.connected = connected
+	f.publishErr = publishErr
+}
+
+func (f *fakeMq) isConnected() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.connected
+}
+
+func (f *fakeMq) publish(kind string) func(ctx context.Context, topic string, data []byte) error {
+	return func(ctx context.Context, topic string, data []byte) error {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.publishErr != nil {
+			return f.publishErr
+		}
+		f.published = append(f.published, publishedMsg{kind: kind, topic: topic, data: string(data), schemaVersion: nats_jetstream.SchemaVersionFromContext(ctx)})
+		return nil
+	}
+}
+
+func (f *fakeMq) publishedMsgs() []publishedMsg {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]publishedMsg{}, f.published...)
+}
+
+func (f *fakeMq) client() *mocks.MessageQueueClientMock {
+	return &mocks.MessageQueueClientMock{
+		IsConnectedFunc:  f.isConnected,
+		PublishCoreFunc:  f.publish("core"),
+		PublishFunc:      f.publish("stream"),
+		PublishAsyncFunc: f.publish("stream"),
+		ShutdownFunc:     func() {},
+	}
+}
+
+var expectedPublished = []publishedMsg{
+	{kind: "stream", topic: "a", data: "1"},
+	{kind: "core", topic: "b", data: "2"},
+	{kind: "stream", topic: "a", data: "3", schemaVersion: "2"},
+}
+
+func publishMsgs(t *testing.T, sut *mq.SpoolingClient) error {
+	t.Helper()
+
+	err := sut.Publish(context.Background(), "a", []byte("1"))
+	if err != nil {
+		return err
+	}
+	require.NoError(t, sut.PublishCore(context.Background(), "b", []byte("2")))
+	require.NoError(t, sut.PublishAsync(nats_jetstream.ContextWithSchemaVersion(context.Background(), "2"), "a", []byte("3")))
+
+	return nil
+}
+
+// messagesOfTopic returns the messages of the topic in the order they were published.
+func messagesOfTopic(msgs []publishedMsg, topic string) []publishedMsg {
+	var topicMsgs []publishedMsg
+	for _, msg := range msgs {
+		if msg.topic == topic {
+			topicMsgs = append(topicMsgs, msg)
+		}
+	}
+	return topicMsgs
+}
+
+func TestSpoolingClient(t *testing.T) {
+	tt := []struct {
+		name       string
+		connected  bool
+		publishErr error
+
+		expectedErr   error
+		expectedSpool bool
+	}{
+		{
+			name:      "connected",
+			connected: true,
+		},
+		{
+			name:      "disconnected",
+			connected: false,
+
+			expectedSpool: true,
+		},
+		{
+			name:       "transient publishing error",
+			connected:  true,
+			publishErr: errors.Join(errors.New("failed to publish"), nats.ErrTimeout),
+
+			expectedSpool: true,
+		},
+		{
+			name:       "publishing error",
+			connected:  true,
+			publishErr: errors.New("failed to publish"),
+
+			expectedErr: errors.New("failed to publish"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			fake := &fakeMq{}
+			fake.set(tc.connected, tc.publishErr)
+
+			sut, err := mq.NewSpoolingClient(slog.Default(), fake.client(), t.TempDir(), mq.WithSpoolDrainInterval(10*time.Millisecond))
+			require.NoError(t, err)
+			defer sut.Shutdown()
+
+			// when
+			err = publishMsgs(t, sut)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorContains(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.expectedSpool {
+				time.Sleep(50 * time.Millisecond)
+				require.Empty(t, fake.publishedMsgs())
+
+				// the spooled messages are published once the connection is back
+				fake.set(true, nil)
+				require.Eventually(t, func() bool { return len(fake.publishedMsgs()) == len(expectedPublished) }, time.Second, 10*time.Millisecond)
+			}
+
+			actual := fake.publishedMsgs()
+			assert.ElementsMatch(t, expectedPublished, actual)
+			assert.Equal(t, messagesOfTopic(expectedPublished, "a"), messagesOfTopic(actual, "a"))
+		})
+	}
+}
+
+func TestSpoolingClient_Restart(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	fake := &fakeMq{}
+
+	sut, err := mq.NewSpoolingClient(slog.Default(), fake.client(), dir)
+	require.NoError(t, err)
+	require.NoError(t, publishMsgs(t, sut))
+	sut.Shutdown()
+
+	// a message which was still being written when the service stopped
+	file, err := os.OpenFile(filepath.Join(dir, "a.spool"), os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{2, 0, 0, 0, 0, 10, 'x'})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	// when
+	fake.set(true, nil)
+	sut, err = mq.NewSpoolingClient(slog.Default(), fake.client(), dir, mq.WithSpoolDrainInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer sut.Shutdown()
+
+	// then
+	require.Eventually(t, func() bool { return len(fake.publishedMsgs()) == len(expectedPublished) }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, messagesOfTopic(expectedPublished, "a"), messagesOfTopic(fake.publishedMsgs(), "a"))
+}
+
+func TestSpoolingClient_Backpressure(t *testing.T) {
+	// given
+	fake := &fakeMq{}
+	sut, err := mq.NewSpoolingClient(slog.Default(), fake.client(), t.TempDir(), mq.WithSpoolMaxBytes(20), mq.WithSpoolDrainInterval(10*time.Millisecond))
+	require.NoError(t, err)
+	defer sut.Shutdown()
+
+	require.NoError(t, sut.Publish(context.Background(), "a", []byte("0123456789")))
+
+	// when
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = sut.Publish(ctx, "a", []byte("0123456789"))
+
+	// then
+	require.ErrorIs(t, err, mq.ErrSpoolFull)
+	require.ErrorIs(t, sut.Publish(context.Background(), "a", make([]byte, 20)), mq.ErrSpoolMessageTooLarge)
+
+	// publishing is unblocked once the spool is drained
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- sut.Publish(context.Background(), "a", []byte("abcdefghij"))
+	}()
+	fake.set(true, nil)
+
+	require.NoError(t, <-errCh)
+	require.Eventually(t, func() bool { return len(fake.publishedMsgs()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []publishedMsg{{kind: "stream", topic: "a", data: "0123456789"}, {kind: "stream", topic: "a", data: "abcdefghij"}}, fake.publishedMsgs())
+}
@@ -0,0 +1,122 @@
+package node_client
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/node_reject"
+	"github.com/bitcoin-sv/arc/pkg/api"
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
+)
+
+const (
+	// MethodTestMempoolAccept checks the transactions against the full mempool policy of the node.
+	MethodTestMempoolAccept = "testmempoolaccept"
+	// MethodVerifyScript only verifies the scripts of the inputs, for nodes which lack testmempoolaccept.
+	MethodVerifyScript = "verifyscript"
+
+	verifyScriptOK      = "ok"
+	verifyScriptTimeout = "timeout"
+)
+
+var (
+	ErrUnknownMempoolAcceptanceMethod = errors.New("unknown mempool acceptance method")
+	ErrFailedToTestMempoolAccept      = errors.New("failed to test mempool acceptance")
+)
+
+// MempoolAcceptance tests on the node whether it would accept transactions into its mempool without broadcasting them.
+type MempoolAcceptance struct {
+	client *rpc_client.RPCClient
+	method string
+}
+
+func NewMempoolAcceptance(client *rpc_client.RPCClient, method string) (*MempoolAcceptance, error) {
+	switch method {
+	case "":
+		method = MethodTestMempoolAccept
+	case MethodTestMempoolAccept, MethodVerifyScript:
+	default:
+		return nil, errors.Join(ErrUnknownMempoolAcceptanceMethod, fmt.Errorf("method: %s", method))
+	}
+
+	return &MempoolAcceptance{client: client, method: method}, nil
+}
+
+// TestMempoolAccept returns the first of the transactions the node would reject and the reason of the node. The
+// transactions may spend the outputs of the transactions preceding them. An error is returned if the node could not
+// test the transactions, e.g. because it does not support the method.
+func (m *MempoolAcceptance) TestMempoolAccept(ctx context.Context, txs []*sdkTx.Transaction) (*sdkTx.Transaction, *node_reject.Reason, error) {
+	if m.method == MethodVerifyScript {
+		return m.verifyScripts(ctx, txs)
+	}
+
+	txsHex := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txsHex = append(txsHex, tx.Hex())
+	}
+
+	results, err := m.client.TestMempoolAccept(ctx, txsHex)
+	if err != nil {
+		return nil, nil, errors.Join(ErrFailedToTestMempoolAccept, err)
+	}
+
+	if len(results) != len(txs) {
+		return nil, nil, errors.Join(ErrFailedToTestMempoolAccept, fmt.Errorf("%d results for %d transactions", len(results), len(txs)))
+	}
+
+	for i, result := range results {
+		if !result.Allowed {
+			// the reject reason carries the reject code like the errors of sendrawtransaction, e.g. "66: min relay fee not met"
+			return txs[i], node_reject.FromRPCError(errors.New(result.RejectReason)), nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+func (m *MempoolAcceptance) verifyScripts(ctx context.Context, txs []*sdkTx.Transaction) (*sdkTx.Transaction, *node_reject.Reason, error) {
+	for _, tx := range txs {
+		txHex := tx.Hex()
+
+		inputs := make([]rpc_client.VerifyScriptInput, 0, len(tx.Inputs))
+		for n, input := range tx.Inputs {
+			verifyInput := rpc_client.VerifyScriptInput{Tx: txHex, N: n}
+
+			// the outputs of the extended format and the BEEF are given, as they may not be known to the node yet
+			if txo := input.SourceTxOutput(); txo != nil && txo.LockingScript != nil {
+				verifyInput.Txo = &rpc_client.VerifyScriptTxo{Lock: hex.EncodeToString(txo.LockingScript.Bytes()), Value: txo.Satoshis}
+			}
+			inputs = append(inputs, verifyInput)
+		}
+
+		results, err := m.client.VerifyScript(ctx, inputs)
+		if err != nil {
+			return nil, nil, errors.Join(ErrFailedToTestMempoolAccept, err)
+		}
+
+		for n, result := range results {
+			if result.Result == verifyScriptOK {
+				continue
+			}
+
+			if result.Result == verifyScriptTimeout {
+				return nil, nil, errors.Join(ErrFailedToTestMempoolAccept, fmt.Errorf("verification of input %d timed out", n))
+			}
+
+			description := result.Description
+			if description == "" {
+				description = result.Result
+			}
+
+			reason := node_reject.New(node_reject.SourceNode, 0, fmt.Sprintf("script of input %d: %s", n, description))
+			reason.Status = api.ErrStatusUnlockingScripts
+			return tx, reason, nil
+		}
+	}
+
+	return nil, nil, nil
+}
@@ -0,0 +1,175 @@
+package node_reject
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/libsv/go-p2p/wire"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+// SourceNode is the source of the reasons given by the node over RPC or ZMQ.
+const SourceNode = "node"
+
+// Reject codes of the node which are not part of the P2P protocol, as the reject message supports only one byte.
+const (
+	codeHighFee      = 256
+	codeAlreadyKnown = 257
+	codeConflict     = 258
+)
+
+// classification maps a fragment of the reason given by the node to the status of the ARC error catalog. The
+// fragments are matched in order against the lower case reason.
+type classification struct {
+	fragment string
+	status   api.StatusCode
+}
+
+var classifications = []classification{
+	{fragment: "txn-mempool-conflict", status: api.ErrStatusConflict},
+	{fragment: "txn-double-spend", status: api.ErrStatusConflict},
+	{fragment: "double spend", status: api.ErrStatusConflict},
+	{fragment: "missing inputs", status: api.ErrStatusInputs},
+	{fragment: "missingorspent", status: api.ErrStatusInputs},
+	{fragment: "bad-txns-inputs", status: api.ErrStatusInputs},
+	{fragment: "non-final", status: api.ErrStatusNonFinal},
+	{fragment: "non-bip68-final", status: api.ErrStatusNonFinal},
+	{fragment: "min fee not met", status: api.ErrStatusFees},
+	{fragment: "min relay fee not met", status: api.ErrStatusFees},
+	{fragment: "insufficient priority", status: api.ErrStatusFees},
+	{fragment: "insufficient fee", status: api.ErrStatusFees},
+	{fragment: "script-verify-flag", status: api.ErrStatusUnlockingScripts},
+	{fragment: "scriptsig", status: api.ErrStatusUnlockingScripts},
+	{fragment: "dust", status: api.ErrStatusOutputs},
+	{fragment: "bad-txns-vout", status: api.ErrStatusOutputs},
+	{fragment: "bad-txns-txouttotal", status: api.ErrStatusOutputs},
+	{fragment: "scriptpubkey", status: api.ErrStatusOutputs},
+	{fragment: "tx-size", status: api.ErrStatusTxSize},
+	{fragment: "oversize", status: api.ErrStatusTxSize},
+}
+
+// codeStatuses maps the reject codes to the status of the ARC error catalog if the reason is not classified.
+var codeStatuses = map[int]api.StatusCode{
+	int(wire.RejectMalformed):       api.ErrStatusMalformed,
+	int(wire.RejectDust):            api.ErrStatusOutputs,
+	int(wire.RejectInsufficientFee): api.ErrStatusFees,
+	codeConflict:                    api.ErrStatusConflict,
+}
+
+// Reason is the reason with which a node rejected a transaction, classified by the status of the ARC error catalog.
+// Code is the reject code of the node, if given, and Text is the verbatim reason of the node.
+type Reason struct {
+	Status api.StatusCode
+	Source string
+	Code   int
+	Text   string
+	// MinFee is the fee rate in satoshis per kB which the peer announced with its feefilter message, if any.
+	MinFee int64
+	// Cause is the error wrapped by the reason, it is not part of the formatted reason.
+	Cause error
+}
+
+// New classifies the reason with the reject code given by the source.
+func New(source string, code int, text string) *Reason {
+	return &Reason{
+		Status: classify(code, text),
+		Source: source,
+		Code:   code,
+		Text:   text,
+	}
+}
+
+// FromMessage classifies the reason of the reject message of the peer.
+func FromMessage(msg *wire.MsgReject, peer string) *Reason {
+	return New(peer, int(msg.Code), msg.Reason)
+}
+
+// FromRPCError classifies the error returned by the RPC interface of the node, e.g. "-26: 258: txn-mempool-conflict",
+// in which the reject code precedes the reason after the code of the RPC error.
+func FromRPCError(err error) *Reason {
+	text := err.Error()
+	code := 0
+
+	for {
+		prefix, rest, found := strings.Cut(text, ": ")
+		if !found {
+			break
+		}
+
+		n, parseErr := strconv.Atoi(prefix)
+		if parseErr != nil {
+			break
+		}
+
+		// negative numbers are the codes of the RPC errors
+		if n >= 0 {
+			code = n
+		}
+		text = rest
+	}
+
+	return New(SourceNode, code, text)
+}
+
+func (r *Reason) Unwrap() error {
+	return r.Cause
+}
+
+// IsAlreadyKnown returns true if the node rejected the transaction because it already has it.
+func (r *Reason) IsAlreadyKnown() bool {
+	return r.Code == codeAlreadyKnown
+}
+
+func classify(code int, text string) api.StatusCode {
+	lower := strings.ToLower(text)
+	for _, c := range classifications {
+		if strings.Contains(lower, c.fragment) {
+			return c.status
+		}
+	}
+
+	status, found := codeStatuses[code]
+	if found {
+		return status
+	}
+
+	return api.ErrStatusGeneric
+}
+
+// Error formats the reason in the same way as the errors of the validator, followed by the source, the reject code
+// and the verbatim reason of the node.
+func (r *Reason) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "arc error %d: rejected by %s", r.Status, r.Source)
+
+	if r.Code != 0 {
+		fmt.Fprintf(&b, " with code %d (%s)", r.Code, codeName(r.Code))
+	}
+
+	fmt.Fprintf(&b, ": %s", r.Text)
+
+	if r.MinFee > 0 && r.Status == api.ErrStatusFees {
+		fmt.Fprintf(&b, " (min fee of peer: %d sat/kB)", r.MinFee)
+	}
+
+	return b.String()
+}
+
+func codeName(code int) string {
+	switch code {
+	case codeHighFee:
+		return "REJECT_HIGHFEE"
+	case codeAlreadyKnown:
+		return "REJECT_ALREADY_KNOWN"
+	case codeConflict:
+		return "REJECT_CONFLICT"
+	}
+
+	if code > 0 && code <= 0xff {
+		return wire.RejectCode(code).String()
+	}
+
+	return "unknown"
+}
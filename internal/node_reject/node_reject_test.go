@@ -0,0 +1,202 @@
+package node_reject_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/node_reject"
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+func TestFromRPCError(t *testing.T) {
+	tt := []struct {
+		name string
+		err  error
+
+		expectedStatus       api.StatusCode
+		expectedCode         int
+		expectedText         string
+		expectedAlreadyKnown bool
+	}{
+		{
+			name: "conflict",
+			err:  errors.New("-26: 258: txn-mempool-conflict"),
+
+			expectedStatus: api.ErrStatusConflict,
+			expectedCode:   258,
+			expectedText:   "txn-mempool-conflict",
+		},
+		{
+			name: "fee too low",
+			err:  errors.New("-26: 66: mempool min fee not met"),
+
+			expectedStatus: api.ErrStatusFees,
+			expectedCode:   66,
+			expectedText:   "mempool min fee not met",
+		},
+		{
+			name: "script verification failed",
+			err:  errors.New("-26: 16: mandatory-script-verify-flag-failed (Script evaluated without error but finished with a false/empty top stack element)"),
+
+			expectedStatus: api.ErrStatusUnlockingScripts,
+			expectedCode:   16,
+			expectedText:   "mandatory-script-verify-flag-failed (Script evaluated without error but finished with a false/empty top stack element)",
+		},
+		{
+			name: "missing inputs without reject code",
+			err:  errors.New("-25: Missing inputs"),
+
+			expectedStatus: api.ErrStatusInputs,
+			expectedText:   "Missing inputs",
+		},
+		{
+			name: "already known",
+			err:  errors.New("-27: 257: txn-already-known"),
+
+			expectedStatus:       api.ErrStatusGeneric,
+			expectedCode:         257,
+			expectedText:         "txn-already-known",
+			expectedAlreadyKnown: true,
+		},
+		{
+			name: "unclassified",
+			err:  errors.New("unexpected response"),
+
+			expectedStatus: api.ErrStatusGeneric,
+			expectedText:   "unexpected response",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := node_reject.FromRPCError(tc.err)
+
+			// then
+			require.Equal(t, tc.expectedStatus, actual.Status)
+			require.Equal(t, node_reject.SourceNode, actual.Source)
+			require.Equal(t, tc.expectedCode, actual.Code)
+			require.Equal(t, tc.expectedText, actual.Text)
+			require.Equal(t, tc.expectedAlreadyKnown, actual.IsAlreadyKnown())
+		})
+	}
+}
+
+func TestFromMessage(t *testing.T) {
+	tt := []struct {
+		name   string
+		code   wire.RejectCode
+		reason string
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name:   "classified by reason",
+			code:   wire.RejectNonstandard,
+			reason: "dust",
+
+			expectedStatus: api.ErrStatusOutputs,
+		},
+		{
+			name:   "classified by code",
+			code:   wire.RejectInsufficientFee,
+			reason: "priority",
+
+			expectedStatus: api.ErrStatusFees,
+		},
+		{
+			name:   "malformed",
+			code:   wire.RejectMalformed,
+			reason: "error parsing message",
+
+			expectedStatus: api.ErrStatusMalformed,
+		},
+		{
+			name:   "non-final",
+			code:   wire.RejectNonstandard,
+			reason: "non-final",
+
+			expectedStatus: api.ErrStatusNonFinal,
+		},
+		{
+			name:   "unclassified",
+			code:   wire.RejectInvalid,
+			reason: "bad-txns-too-many-sigops",
+
+			expectedStatus: api.ErrStatusGeneric,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := node_reject.FromMessage(wire.NewMsgReject("tx", tc.code, tc.reason), "10.0.0.1:8333")
+
+			// then
+			require.Equal(t, tc.expectedStatus, actual.Status)
+			require.Equal(t, "10.0.0.1:8333", actual.Source)
+			require.Equal(t, tc.reason, actual.Text)
+		})
+	}
+}
+
+func TestReason_Error(t *testing.T) {
+	tt := []struct {
+		name   string
+		reason *node_reject.Reason
+
+		expected string
+	}{
+		{
+			name:   "with reject code",
+			reason: node_reject.New("10.0.0.1:8333", int(wire.RejectDust), "dust"),
+
+			expected: "arc error 464: rejected by 10.0.0.1:8333 with code 65 (REJECT_DUST): dust",
+		},
+		{
+			name:   "without reject code",
+			reason: node_reject.New(node_reject.SourceNode, 0, "Missing inputs"),
+
+			expected: "arc error 462: rejected by node: Missing inputs",
+		},
+		{
+			name: "with min fee of peer",
+			reason: &node_reject.Reason{
+				Status: api.ErrStatusFees,
+				Source: "10.0.0.1:8333",
+				Code:   int(wire.RejectInsufficientFee),
+				Text:   "mempool min fee not met",
+				MinFee: 1000,
+			},
+
+			expected: "arc error 465: rejected by 10.0.0.1:8333 with code 66 (REJECT_INSUFFICIENTFEE): mempool min fee not met (min fee of peer: 1000 sat/kB)",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := tc.reason.Error()
+
+			// then
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestReason_Unwrap(t *testing.T) {
+	// given
+	cause := errors.New("transaction rejected by peer")
+	sut := node_reject.New("10.0.0.1:8333", int(wire.RejectDust), "dust")
+	sut.Cause = cause
+
+	// when
+	var err error = sut
+
+	// then
+	require.ErrorIs(t, err, cause)
+	require.NotContains(t, err.Error(), cause.Error())
+}
@@ -0,0 +1,222 @@
+package p2p
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/wire"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	throttleInterval     = 100 * time.Millisecond
+	maxQueuedDefault     = 100000
+	maxInvPerThrottleMsg = wire.MaxInvPerMsg
+)
+
+// AnnouncementMetrics exposes the announcements which are queued or dropped by the announcement rate limit per peer.
+// It implements prometheus.Collector.
+type AnnouncementMetrics struct {
+	queued  *prometheus.GaugeVec
+	dropped *prometheus.CounterVec
+}
+
+func NewAnnouncementMetrics() *AnnouncementMetrics {
+	return &AnnouncementMetrics{
+		queued: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "arc_p2p_announcements_queued",
+			Help: "Number of transaction announcements per peer which are queued because of the announcement rate limit",
+		}, []string{"peer"}),
+		dropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_p2p_announcements_dropped_total",
+			Help: "Number of transaction announcements per peer which were dropped because the announcement queue was full",
+		}, []string{"peer"}),
+	}
+}
+
+func (m *AnnouncementMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.queued.Describe(ch)
+	m.dropped.Describe(ch)
+}
+
+func (m *AnnouncementMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.queued.Collect(ch)
+	m.dropped.Collect(ch)
+}
+
+type announcementQueue struct {
+	tokens float64
+	invs   []*wire.InvVect
+}
+
+// announcementThrottler limits the number of inventory vectors announced to each peer per second with a token bucket
+// holding the announcements of one second at most. Announcements exceeding the limit are queued and sent as soon as
+// the limit allows. If the queue of a peer is full, the oldest announcements are dropped, they are announced again by
+// the re-announcement of unseen transactions.
+type announcementThrottler struct {
+	logger    *slog.Logger
+	limit     float64
+	maxQueued int
+	metrics   *AnnouncementMetrics
+
+	mu         sync.Mutex
+	queues     map[PeerI]*announcementQueue
+	lastRefill time.Time
+
+	wg        *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func newAnnouncementThrottler(logger *slog.Logger, limit int, maxQueued int, metrics *AnnouncementMetrics) *announcementThrottler {
+	if maxQueued <= 0 {
+		maxQueued = maxQueuedDefault
+	}
+
+	t := &announcementThrottler{
+		logger:     logger,
+		limit:      float64(limit),
+		maxQueued:  maxQueued,
+		metrics:    metrics,
+		queues:     make(map[PeerI]*announcementQueue),
+		lastRefill: time.Now(),
+		wg:         &sync.WaitGroup{},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.ctx = ctx
+	t.cancelAll = cancel
+
+	t.start()
+
+	return t
+}
+
+func (t *announcementThrottler) start() {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(throttleInterval)
+
+		for {
+			select {
+			case <-t.ctx.Done():
+				ticker.Stop()
+				return
+			case now := <-ticker.C:
+				t.release(now)
+			}
+		}
+	}()
+}
+
+// announce sends the inventory vectors to the peer as far as the limit allows and queues the rest.
+func (t *announcementThrottler) announce(peer PeerI, invs []*wire.InvVect) {
+	t.mu.Lock()
+	if !peer.Connected() {
+		t.removePeer(peer)
+		t.mu.Unlock()
+		return
+	}
+
+	q, found := t.queues[peer]
+	if !found {
+		q = &announcementQueue{tokens: t.limit}
+		t.queues[peer] = q
+	}
+
+	q.invs = append(q.invs, invs...)
+	if excess := len(q.invs) - t.maxQueued; excess > 0 {
+		q.invs = q.invs[excess:]
+		t.logger.Warn("Announcement queue full - dropping announcements", slog.String("peer", peer.String()), slog.Int("dropped", excess))
+		if t.metrics != nil {
+			t.metrics.dropped.WithLabelValues(peer.String()).Add(float64(excess))
+		}
+	}
+
+	msgs := t.take(peer, q)
+	t.mu.Unlock()
+
+	for _, msg := range msgs {
+		peer.WriteMsg(msg)
+	}
+}
+
+// release refills the token buckets and sends the queued announcements which the limit allows. The queues of peers
+// which are disconnected are dropped together with their metrics, their announcements are announced again by the
+// re-announcement of unseen transactions.
+func (t *announcementThrottler) release(now time.Time) {
+	type peerMsgs struct {
+		peer PeerI
+		msgs []*wire.MsgInv
+	}
+
+	t.mu.Lock()
+	refill := now.Sub(t.lastRefill).Seconds() * t.limit
+	t.lastRefill = now
+
+	var toSend []peerMsgs
+	for peer, q := range t.queues {
+		if !peer.Connected() {
+			t.removePeer(peer)
+			continue
+		}
+
+		q.tokens = min(q.tokens+refill, t.limit)
+
+		msgs := t.take(peer, q)
+		if len(msgs) > 0 {
+			toSend = append(toSend, peerMsgs{peer: peer, msgs: msgs})
+		}
+	}
+	t.mu.Unlock()
+
+	for _, s := range toSend {
+		for _, msg := range s.msgs {
+			s.peer.WriteMsg(msg)
+		}
+	}
+}
+
+// take removes as many inventory vectors from the queue as there are tokens and returns them as INV messages. The
+// lock has to be held by the caller.
+func (t *announcementThrottler) take(peer PeerI, q *announcementQueue) []*wire.MsgInv {
+	n := min(int(q.tokens), len(q.invs))
+	var msgs []*wire.MsgInv
+
+	for start := 0; start < n; start += maxInvPerThrottleMsg {
+		end := min(start+maxInvPerThrottleMsg, n)
+
+		invMsg := wire.NewMsgInvSizeHint(uint(end - start))
+		for _, iv := range q.invs[start:end] {
+			_ = invMsg.AddInvVect(iv)
+		}
+		msgs = append(msgs, invMsg)
+	}
+
+	q.tokens -= float64(n)
+	q.invs = q.invs[n:]
+
+	if t.metrics != nil {
+		t.metrics.queued.WithLabelValues(peer.String()).Set(float64(len(q.invs)))
+	}
+
+	return msgs
+}
+
+// removePeer drops the queue and the metrics of the peer. The lock has to be held by the caller.
+func (t *announcementThrottler) removePeer(peer PeerI) {
+	delete(t.queues, peer)
+
+	if t.metrics != nil {
+		t.metrics.queued.DeleteLabelValues(peer.String())
+		t.metrics.dropped.DeleteLabelValues(peer.String())
+	}
+}
+
+func (t *announcementThrottler) shutdown() {
+	t.cancelAll()
+	t.wg.Wait()
+}
@@ -0,0 +1,383 @@
+package p2p
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultBanThreshold         = 100
+	defaultInvalidMessageWeight = 20
+	defaultBanScoreDecay        = time.Hour
+	defaultBanDuration          = 24 * time.Hour
+)
+
+var (
+	ErrAddressRequired  = errors.New("address is required")
+	ErrAddressNotBanned = errors.New("address is not banned")
+)
+
+type banScore struct {
+	score     float64
+	updatedAt time.Time
+}
+
+// BanManager keeps the ban scores of the peers and the banned peer addresses. The ban score of a peer is increased on
+// misbehaviour, e.g. invalid messages, and halves every decay period. A peer whose ban score reaches the threshold is
+// banned for the ban duration. Banned peers are disconnected and not reconnected until the ban expires or is lifted.
+type BanManager struct {
+	mu     sync.RWMutex
+	logger *slog.Logger
+	now    func() time.Time
+
+	peers  []PeerI
+	scores map[string]*banScore
+	bans   map[string]time.Time
+
+	threshold            int
+	invalidMessageWeight int
+	decay                time.Duration
+	banDuration          time.Duration
+	file                 string
+}
+
+type BanManagerOption func(b *BanManager)
+
+// WithBanThreshold sets the ban score at which a peer is banned.
+func WithBanThreshold(threshold int) BanManagerOption {
+	return func(b *BanManager) {
+		b.threshold = threshold
+	}
+}
+
+// WithInvalidMessageWeight sets the ban score added for each invalid message received from a peer.
+func WithInvalidMessageWeight(weight int) BanManagerOption {
+	return func(b *BanManager) {
+		b.invalidMessageWeight = weight
+	}
+}
+
+// WithBanScoreDecay sets the period after which the ban score of a peer is halved. Zero disables the decay.
+func WithBanScoreDecay(d time.Duration) BanManagerOption {
+	return func(b *BanManager) {
+		b.decay = d
+	}
+}
+
+// WithBanDuration sets the duration of bans caused by the ban score and of manual bans without duration.
+func WithBanDuration(d time.Duration) BanManagerOption {
+	return func(b *BanManager) {
+		b.banDuration = d
+	}
+}
+
+// WithBanFile persists the bans to the file, so that they are kept across restarts.
+func WithBanFile(file string) BanManagerOption {
+	return func(b *BanManager) {
+		b.file = file
+	}
+}
+
+func WithBanManagerNow(nowFunc func() time.Time) BanManagerOption {
+	return func(b *BanManager) {
+		b.now = nowFunc
+	}
+}
+
+// NewBanManager creates a ban manager. If a ban file is configured, the bans persisted in it are loaded.
+func NewBanManager(logger *slog.Logger, opts ...BanManagerOption) (*BanManager, error) {
+	b := &BanManager{
+		logger: logger.With(slog.String("module", "ban-manager")),
+		now:    time.Now,
+
+		scores: map[string]*banScore{},
+		bans:   map[string]time.Time{},
+
+		threshold:            defaultBanThreshold,
+		invalidMessageWeight: defaultInvalidMessageWeight,
+		decay:                defaultBanScoreDecay,
+		banDuration:          defaultBanDuration,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	err := b.load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bans from %s: %w", b.file, err)
+	}
+
+	return b, nil
+}
+
+// AddPeer registers a peer, so that it is listed with its ban score and disconnected if it gets banned. A peer which
+// is already registered is not registered again. Ban scores which have decayed to zero are pruned.
+func (b *BanManager) AddPeer(peer PeerI) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.pruneScores(b.now())
+
+	for _, p := range b.peers {
+		if p == peer {
+			return
+		}
+	}
+
+	b.peers = append(b.peers, peer)
+}
+
+// RemovePeer unregisters a peer which has been shut down, so that the peers which are replaced or closed over time
+// don't pile up.
+func (b *BanManager) RemovePeer(peer PeerI) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, p := range b.peers {
+		if p == peer {
+			b.peers = append(b.peers[:i], b.peers[i+1:]...)
+			return
+		}
+	}
+}
+
+// InvalidMessage increases the ban score of the peer by the weight of invalid messages. It returns whether the peer
+// is banned.
+func (b *BanManager) InvalidMessage(address string, reason string) bool {
+	return b.Misbehaving(address, b.invalidMessageWeight, reason)
+}
+
+// Misbehaving increases the ban score of the peer by the weight and bans the peer if the ban score reaches the
+// threshold. It returns whether the peer is banned. Peers banned due to their ban score are not disconnected by the
+// ban manager, the caller is responsible for disconnecting the peer.
+func (b *BanManager) Misbehaving(address string, weight int, reason string) bool {
+	b.mu.Lock()
+
+	now := b.now()
+	score := b.decayedScore(address, now) + float64(weight)
+	b.scores[address] = &banScore{score: score, updatedAt: now}
+
+	b.logger.Warn("Peer misbehaving", slog.String("peer", address), slog.String("reason", reason), slog.Int("score", int(score)))
+
+	if score < float64(b.threshold) {
+		banned := b.isBanned(address, now)
+		b.mu.Unlock()
+		return banned
+	}
+
+	b.mu.Unlock()
+
+	err := b.ban(address, 0, false)
+	if err != nil {
+		b.logger.Error("Failed to ban peer", slog.String("peer", address), slog.String("err", err.Error()))
+	}
+
+	return true
+}
+
+// Ban bans the address for the duration. If the duration is zero, the configured ban duration is used. Connected
+// peers with the address are disconnected.
+func (b *BanManager) Ban(address string, duration time.Duration) error {
+	return b.ban(address, duration, true)
+}
+
+func (b *BanManager) ban(address string, duration time.Duration, disconnectPeers bool) error {
+	if address == "" {
+		return ErrAddressRequired
+	}
+
+	if duration <= 0 {
+		duration = b.banDuration
+	}
+
+	b.mu.Lock()
+	b.bans[address] = b.now().Add(duration)
+	err := b.persist()
+
+	var disconnect []PeerI
+	for _, peer := range b.peers {
+		if disconnectPeers && peer.String() == address {
+			disconnect = append(disconnect, peer)
+		}
+	}
+	b.mu.Unlock()
+
+	b.logger.Warn("Banned peer", slog.String("peer", address), slog.Duration("duration", duration))
+
+	for _, peer := range disconnect {
+		peer.Shutdown()
+	}
+
+	return err
+}
+
+// Unban lifts the ban of the address and resets its ban score.
+func (b *BanManager) Unban(address string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	_, found := b.bans[address]
+	if !found {
+		return ErrAddressNotBanned
+	}
+
+	delete(b.bans, address)
+	delete(b.scores, address)
+
+	b.logger.Info("Unbanned peer", slog.String("peer", address))
+
+	return b.persist()
+}
+
+// IsBanned returns whether the address is banned.
+func (b *BanManager) IsBanned(address string) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return b.isBanned(address, b.now())
+}
+
+// Score returns the current ban score of the address.
+func (b *BanManager) Score(address string) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return int(b.decayedScore(address, b.now()))
+}
+
+func (b *BanManager) isBanned(address string, now time.Time) bool {
+	bannedUntil, found := b.bans[address]
+
+	return found && now.Before(bannedUntil)
+}
+
+// pruneScores removes the ban scores which have decayed to zero of addresses which are not banned. The caller has to
+// hold the lock.
+func (b *BanManager) pruneScores(now time.Time) {
+	for address := range b.scores {
+		if b.decayedScore(address, now) < 1 && !b.isBanned(address, now) {
+			delete(b.scores, address)
+		}
+	}
+}
+
+func (b *BanManager) decayedScore(address string, now time.Time) float64 {
+	s, found := b.scores[address]
+	if !found {
+		return 0
+	}
+
+	if b.decay <= 0 {
+		return s.score
+	}
+
+	return s.score * math.Pow(0.5, float64(now.Sub(s.updatedAt))/float64(b.decay))
+}
+
+func (b *BanManager) load() error {
+	if b.file == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(b.file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	return json.Unmarshal(data, &b.bans)
+}
+
+// persist writes the bans to the ban file. Expired bans are removed. The caller has to hold the lock.
+func (b *BanManager) persist() error {
+	now := b.now()
+	for address, bannedUntil := range b.bans {
+		if !now.Before(bannedUntil) {
+			delete(b.bans, address)
+		}
+	}
+
+	if b.file == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(b.bans)
+	if err != nil {
+		return err
+	}
+
+	tmpFile := b.file + ".tmp"
+	err = os.MkdirAll(filepath.Dir(b.file), 0o755)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(tmpFile, data, 0o600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile, b.file)
+}
+
+// PeerBanInfo is the ban score and ban of a registered peer.
+type PeerBanInfo struct {
+	Address     string
+	Connected   bool
+	BanScore    int
+	Banned      bool
+	BannedUntil time.Time
+}
+
+// Peers returns the registered peers with their ban scores sorted by address.
+func (b *BanManager) Peers() []PeerBanInfo {
+	b.mu.RLock()
+	now := b.now()
+	peers := make([]PeerBanInfo, 0, len(b.peers))
+	for _, peer := range b.peers {
+		info := PeerBanInfo{
+			Address:   peer.String(),
+			Connected: peer.Connected(),
+			BanScore:  int(b.decayedScore(peer.String(), now)),
+			Banned:    b.isBanned(peer.String(), now),
+		}
+		if info.Banned {
+			info.BannedUntil = b.bans[peer.String()]
+		}
+		peers = append(peers, info)
+	}
+	b.mu.RUnlock()
+
+	sort.Slice(peers, func(i, j int) bool {
+		return peers[i].Address < peers[j].Address
+	})
+
+	return peers
+}
+
+// Bans returns the addresses which are currently banned with the end of their bans.
+func (b *BanManager) Bans() map[string]time.Time {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	now := b.now()
+	bans := make(map[string]time.Time, len(b.bans))
+	for address, bannedUntil := range b.bans {
+		if b.isBanned(address, now) {
+			bans[address] = bannedUntil
+		}
+	}
+
+	return bans
+}
@@ -0,0 +1,187 @@
+package p2p_test
+
+import (
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/p2p/mocks"
+)
+
+func TestBanManager_Misbehaving(t *testing.T) {
+	tt := []struct {
+		name        string
+		misbehaving []time.Duration
+		decay       time.Duration
+
+		expectedBanned bool
+		expectedScore  int
+	}{
+		{
+			name:        "below threshold",
+			misbehaving: []time.Duration{0, 0, 0, 0},
+
+			expectedBanned: false,
+			expectedScore:  80,
+		},
+		{
+			name:        "threshold reached",
+			misbehaving: []time.Duration{0, 0, 0, 0, 0},
+
+			expectedBanned: true,
+			expectedScore:  100,
+		},
+		{
+			name:        "score decayed",
+			misbehaving: []time.Duration{0, 0, 0, 0, time.Hour},
+			decay:       time.Hour,
+
+			expectedBanned: false,
+			expectedScore:  60,
+		},
+		{
+			name:        "decay disabled",
+			misbehaving: []time.Duration{0, 0, 0, 0, time.Hour},
+
+			expectedBanned: true,
+			expectedScore:  100,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+			sut, err := p2p.NewBanManager(slog.Default(),
+				p2p.WithBanScoreDecay(tc.decay),
+				p2p.WithBanManagerNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+
+			// when
+			var banned bool
+			for _, after := range tc.misbehaving {
+				now = now.Add(after)
+				banned = sut.InvalidMessage("localhost:18333", "invalid checksum")
+			}
+
+			// then
+			assert.Equal(t, tc.expectedBanned, banned)
+			assert.Equal(t, tc.expectedBanned, sut.IsBanned("localhost:18333"))
+			assert.Equal(t, tc.expectedScore, sut.Score("localhost:18333"))
+		})
+	}
+}
+
+func TestBanManager_BanUnban(t *testing.T) {
+	t.Run("ban, expire, unban and persist", func(t *testing.T) {
+		// given
+		now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+		banFile := filepath.Join(t.TempDir(), "bans.json")
+		opts := []p2p.BanManagerOption{
+			p2p.WithBanFile(banFile),
+			p2p.WithBanManagerNow(func() time.Time { return now }),
+		}
+
+		sut, err := p2p.NewBanManager(slog.Default(), opts...)
+		require.NoError(t, err)
+
+		peer := &mocks.PeerIMock{
+			StringFunc:   func() string { return "localhost:18333" },
+			ShutdownFunc: func() {},
+		}
+		otherPeer := &mocks.PeerIMock{
+			StringFunc: func() string { return "localhost:18334" },
+		}
+		sut.AddPeer(peer)
+		sut.AddPeer(otherPeer)
+
+		// when
+		err = sut.Ban("localhost:18333", time.Hour)
+		require.NoError(t, err)
+		err = sut.Ban("localhost:18335", 0)
+		require.NoError(t, err)
+
+		// then
+		require.Len(t, peer.ShutdownCalls(), 1)
+		require.Empty(t, otherPeer.ShutdownCalls())
+		require.True(t, sut.IsBanned("localhost:18333"))
+		require.True(t, sut.IsBanned("localhost:18335"))
+		require.False(t, sut.IsBanned("localhost:18334"))
+
+		// bans are loaded after restart
+		restarted, err := p2p.NewBanManager(slog.Default(), opts...)
+		require.NoError(t, err)
+		require.True(t, restarted.IsBanned("localhost:18333"))
+		require.True(t, restarted.IsBanned("localhost:18335"))
+
+		// ban expires
+		now = now.Add(2 * time.Hour)
+		require.False(t, restarted.IsBanned("localhost:18333"))
+		require.True(t, restarted.IsBanned("localhost:18335"))
+
+		// unban
+		err = restarted.Unban("localhost:18335")
+		require.NoError(t, err)
+		require.False(t, restarted.IsBanned("localhost:18335"))
+
+		err = restarted.Unban("localhost:18335")
+		require.ErrorIs(t, err, p2p.ErrAddressNotBanned)
+
+		restarted, err = p2p.NewBanManager(slog.Default(), opts...)
+		require.NoError(t, err)
+		require.False(t, restarted.IsBanned("localhost:18335"))
+	})
+}
+
+func TestBanManager_Peers(t *testing.T) {
+	t.Run("list, remove and prune peers", func(t *testing.T) {
+		// given
+		now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+		sut, err := p2p.NewBanManager(slog.Default(), p2p.WithBanManagerNow(func() time.Time { return now }))
+		require.NoError(t, err)
+
+		peer := &mocks.PeerIMock{
+			StringFunc:    func() string { return "localhost:18334" },
+			ConnectedFunc: func() bool { return true },
+		}
+		bannedPeer := &mocks.PeerIMock{
+			StringFunc:    func() string { return "localhost:18333" },
+			ConnectedFunc: func() bool { return false },
+			ShutdownFunc:  func() {},
+		}
+		closedPeer := &mocks.PeerIMock{
+			StringFunc:    func() string { return "localhost:18335" },
+			ConnectedFunc: func() bool { return false },
+		}
+
+		// when
+		sut.AddPeer(peer)
+		sut.AddPeer(peer)
+		sut.AddPeer(bannedPeer)
+		sut.AddPeer(closedPeer)
+		sut.RemovePeer(closedPeer)
+
+		sut.InvalidMessage("localhost:18334", "invalid checksum")
+		err = sut.Ban("localhost:18333", time.Hour)
+		require.NoError(t, err)
+
+		// then
+		peers := sut.Peers()
+		require.Len(t, peers, 2)
+		assert.Equal(t, p2p.PeerBanInfo{Address: "localhost:18333", Banned: true, BannedUntil: now.Add(time.Hour)}, peers[0])
+		assert.Equal(t, p2p.PeerBanInfo{Address: "localhost:18334", Connected: true, BanScore: 20}, peers[1])
+		assert.Equal(t, map[string]time.Time{"localhost:18333": now.Add(time.Hour)}, sut.Bans())
+
+		// ban scores decay and bans expire
+		now = now.Add(24 * time.Hour)
+		sut.AddPeer(closedPeer)
+		assert.Equal(t, 0, sut.Score("localhost:18334"))
+		assert.Empty(t, sut.Bans())
+	})
+}
@@ -0,0 +1,160 @@
+package p2p
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/libsv/go-p2p/wire"
+)
+
+const (
+	trickleTickDefault = 10 * time.Millisecond
+	// maxTrickleFactor caps the randomized delay of a flush to a multiple of the interval
+	maxTrickleFactor = 4
+)
+
+type peerInvQueue struct {
+	invs      []*wire.InvVect
+	nextFlush time.Time
+}
+
+// invTrickler queues the announcements per peer and flushes each queue in INV messages of at most maxInvs inventory
+// vectors. With trickle the flush times of the peers are randomized with an exponential distribution around the
+// interval, like the trickling of announcements by the nodes, otherwise the queues are flushed after the interval.
+// Queues which exceed maxQueued drop the oldest announcements, they are announced again by the re-announcement of
+// unseen transactions.
+type invTrickler struct {
+	logger    *slog.Logger
+	maxInvs   int
+	interval  time.Duration
+	trickle   bool
+	maxQueued int
+	send      func(peer PeerI, invs []*wire.InvVect)
+	now       func() time.Time
+	random    func() float64
+
+	mu     sync.Mutex
+	queues map[PeerI]*peerInvQueue
+
+	wg        *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func newInvTrickler(logger *slog.Logger, maxInvs int, interval time.Duration, trickle bool, send func(peer PeerI, invs []*wire.InvVect)) *invTrickler {
+	t := &invTrickler{
+		logger:    logger,
+		maxInvs:   min(maxInvs, wire.MaxInvPerMsg),
+		interval:  interval,
+		trickle:   trickle,
+		maxQueued: maxQueuedDefault,
+		send:      send,
+		now:       time.Now,
+		random:    rand.Float64, // #nosec G404 -- the flush times don't need to be unpredictable
+		queues:    make(map[PeerI]*peerInvQueue),
+		wg:        &sync.WaitGroup{},
+	}
+
+	t.ctx, t.cancelAll = context.WithCancel(context.Background())
+	t.start()
+
+	return t
+}
+
+func (t *invTrickler) start() {
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(min(trickleTickDefault, t.interval))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-t.ctx.Done():
+				return
+			case <-ticker.C:
+				t.flush()
+			}
+		}
+	}()
+}
+
+// announce queues the inventory vectors for the peer.
+func (t *invTrickler) announce(peer PeerI, invs []*wire.InvVect) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	q, found := t.queues[peer]
+	if !found {
+		q = &peerInvQueue{}
+		t.queues[peer] = q
+	}
+
+	if len(q.invs) == 0 {
+		q.nextFlush = t.now().Add(t.delay())
+	}
+
+	q.invs = append(q.invs, invs...)
+	if excess := len(q.invs) - t.maxQueued; excess > 0 {
+		q.invs = q.invs[excess:]
+		t.logger.Warn("INV queue full - dropping announcements", slog.String("peer", peer.String()), slog.Int("dropped", excess))
+	}
+}
+
+// delay returns the duration until the next flush of a queue. The randomized delay of the trickle is exponentially
+// distributed with the interval as mean, capped at a multiple of the interval.
+func (t *invTrickler) delay() time.Duration {
+	if !t.trickle {
+		return t.interval
+	}
+
+	factor := min(-math.Log(1-t.random()), maxTrickleFactor)
+	return time.Duration(factor * float64(t.interval))
+}
+
+// flush sends the queues whose flush time is reached. The queues of disconnected peers are dropped.
+func (t *invTrickler) flush() {
+	type peerInvs struct {
+		peer PeerI
+		invs []*wire.InvVect
+	}
+
+	now := t.now()
+	var toSend []peerInvs
+
+	t.mu.Lock()
+	for peer, q := range t.queues {
+		if len(q.invs) == 0 {
+			delete(t.queues, peer)
+			continue
+		}
+
+		if now.Before(q.nextFlush) {
+			continue
+		}
+
+		toSend = append(toSend, peerInvs{peer: peer, invs: q.invs})
+		delete(t.queues, peer)
+	}
+	t.mu.Unlock()
+
+	for _, s := range toSend {
+		if !s.peer.Connected() {
+			continue
+		}
+
+		for start := 0; start < len(s.invs); start += t.maxInvs {
+			t.send(s.peer, s.invs[start:min(start+t.maxInvs, len(s.invs))])
+		}
+	}
+}
+
+// shutdown stops the trickling, the queued announcements are dropped.
+func (t *invTrickler) shutdown() {
+	t.cancelAll()
+	t.wg.Wait()
+}
@@ -0,0 +1,191 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDumpMaxFileSize = 100 * 1024 * 1024
+	defaultDumpMaxBackups  = 5
+)
+
+// MessageDumper writes hex dumps of the raw p2p messages of selected peers to a file, so that wire-level
+// incompatibilities can be diagnosed. The file is rotated when it exceeds the maximum file size, the rotated files are
+// kept as `<file>.1` to `<file>.<max backups>`.
+type MessageDumper struct {
+	mu  sync.Mutex
+	now func() time.Time
+
+	peers      map[string]struct{}
+	file       string
+	maxSize    int64
+	maxBackups int
+
+	out  *os.File
+	size int64
+}
+
+type MessageDumperOption func(d *MessageDumper)
+
+// WithDumpMaxFileSize sets the size in bytes at which the dump file is rotated.
+func WithDumpMaxFileSize(size int64) MessageDumperOption {
+	return func(d *MessageDumper) {
+		d.maxSize = size
+	}
+}
+
+// WithDumpMaxBackups sets the number of rotated dump files which are kept.
+func WithDumpMaxBackups(n int) MessageDumperOption {
+	return func(d *MessageDumper) {
+		d.maxBackups = n
+	}
+}
+
+func WithDumperNow(nowFunc func() time.Time) MessageDumperOption {
+	return func(d *MessageDumper) {
+		d.now = nowFunc
+	}
+}
+
+// NewMessageDumper creates a message dumper for the peers with the given addresses which writes to the file.
+func NewMessageDumper(file string, peers []string, opts ...MessageDumperOption) (*MessageDumper, error) {
+	d := &MessageDumper{
+		now:        time.Now,
+		peers:      make(map[string]struct{}, len(peers)),
+		file:       file,
+		maxSize:    defaultDumpMaxFileSize,
+		maxBackups: defaultDumpMaxBackups,
+	}
+
+	for _, peer := range peers {
+		d.peers[peer] = struct{}{}
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	err := os.MkdirAll(filepath.Dir(file), 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Enabled returns whether the messages of the peer are dumped.
+func (d *MessageDumper) Enabled(peer string) bool {
+	if d == nil {
+		return false
+	}
+
+	_, found := d.peers[peer]
+	return found
+}
+
+// Dump writes the hex dump of the raw message of the given size if the messages of the peer are dumped. The raw bytes
+// can be truncated for large messages.
+func (d *MessageDumper) Dump(peer string, direction string, command string, size int, raw []byte) error {
+	if !d.Enabled(peer) {
+		return nil
+	}
+
+	var truncated string
+	if len(raw) < size {
+		truncated = fmt.Sprintf(" truncated=%d", len(raw))
+	}
+
+	entry := fmt.Sprintf("%s peer=%s direction=%s command=%s bytes=%d%s\n%s\n",
+		d.now().UTC().Format(time.RFC3339Nano), peer, direction, command, size, truncated, hex.Dump(raw))
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.out == nil {
+		return os.ErrClosed
+	}
+
+	if d.size > 0 && d.size+int64(len(entry)) > d.maxSize {
+		err := d.rotate()
+		if err != nil {
+			return fmt.Errorf("failed to rotate %s: %w", d.file, err)
+		}
+	}
+
+	n, err := d.out.WriteString(entry)
+	d.size += int64(n)
+
+	return err
+}
+
+// Close closes the dump file.
+func (d *MessageDumper) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.out == nil {
+		return nil
+	}
+
+	err := d.out.Close()
+	d.out = nil
+
+	return err
+}
+
+func (d *MessageDumper) open() error {
+	out, err := os.OpenFile(d.file, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	info, err := out.Stat()
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+
+	d.out = out
+	d.size = info.Size()
+
+	return nil
+}
+
+// rotate shifts the rotated files, moves the current file to `<file>.1` and opens a new file. The caller has to hold
+// the lock.
+func (d *MessageDumper) rotate() error {
+	err := d.out.Close()
+	if err != nil {
+		return err
+	}
+	d.out = nil
+
+	if d.maxBackups > 0 {
+		for i := d.maxBackups - 1; i > 0; i-- {
+			err = os.Rename(fmt.Sprintf("%s.%d", d.file, i), fmt.Sprintf("%s.%d", d.file, i+1))
+			if err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+
+		err = os.Rename(d.file, d.file+".1")
+	} else {
+		err = os.Remove(d.file)
+	}
+	if err != nil {
+		return err
+	}
+
+	return d.open()
+}
@@ -0,0 +1,83 @@
+package p2p_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+func TestMessageDumper_Dump(t *testing.T) {
+	tt := []struct {
+		name       string
+		dumps      int
+		maxBackups int
+
+		expectedFiles []string
+	}{
+		{
+			name:  "no rotation",
+			dumps: 1,
+
+			expectedFiles: []string{"dump.log"},
+		},
+		{
+			name:       "rotation",
+			dumps:      4,
+			maxBackups: 2,
+
+			expectedFiles: []string{"dump.log", "dump.log.1", "dump.log.2"},
+		},
+		{
+			name:       "rotation without backups",
+			dumps:      4,
+			maxBackups: 0,
+
+			expectedFiles: []string{"dump.log"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+			sut, err := p2p.NewMessageDumper(filepath.Join(dir, "dump.log"), []string{"localhost:18333"},
+				p2p.WithDumpMaxFileSize(150),
+				p2p.WithDumpMaxBackups(tc.maxBackups),
+				p2p.WithDumperNow(func() time.Time { return now }),
+			)
+			require.NoError(t, err)
+			defer sut.Close()
+
+			// when
+			for range tc.dumps {
+				err = sut.Dump("localhost:18333", "received", "ping", 8, []byte{0xe3, 0xe1, 0xf3, 0xe8, 0x70, 0x69, 0x6e, 0x67})
+				require.NoError(t, err)
+			}
+			err = sut.Dump("localhost:18334", "received", "ping", 1, []byte{0x00})
+			require.NoError(t, err)
+
+			// then
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			actualFiles := make([]string, 0, len(entries))
+			for _, entry := range entries {
+				actualFiles = append(actualFiles, entry.Name())
+			}
+			assert.Equal(t, tc.expectedFiles, actualFiles)
+
+			dump, err := os.ReadFile(filepath.Join(dir, "dump.log"))
+			require.NoError(t, err)
+			expected := "2024-09-01T12:00:00Z peer=localhost:18333 direction=received command=ping bytes=8\n" +
+				"00000000  e3 e1 f3 e8 70 69 6e 67                           |....ping|\n\n"
+			assert.Equal(t, expected, string(dump))
+		})
+	}
+}
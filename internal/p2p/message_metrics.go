@@ -0,0 +1,56 @@
+package p2p
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	directionReceived = "received"
+	directionSent     = "sent"
+
+	// commandInvalid is the command label of messages which could not be read
+	commandInvalid = "invalid"
+	// commandUnknown is the command label of messages with commands which are not supported
+	commandUnknown = "unknown"
+)
+
+// MessageMetrics counts the p2p messages and their bytes per peer, direction and message type. It implements
+// prometheus.Collector and can be shared by multiple peers.
+type MessageMetrics struct {
+	messages *prometheus.CounterVec
+	bytes    *prometheus.CounterVec
+}
+
+func NewMessageMetrics() *MessageMetrics {
+	labels := []string{"peer", "direction", "command"}
+
+	return &MessageMetrics{
+		messages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_p2p_messages_total",
+			Help: "Number of p2p messages per peer, direction and message type",
+		}, labels),
+		bytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "arc_p2p_message_bytes_total",
+			Help: "Number of bytes of p2p messages per peer, direction and message type",
+		}, labels),
+	}
+}
+
+func (m *MessageMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.messages.Describe(ch)
+	m.bytes.Describe(ch)
+}
+
+func (m *MessageMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.messages.Collect(ch)
+	m.bytes.Collect(ch)
+}
+
+func (m *MessageMetrics) observe(peer string, direction string, command string, n int) {
+	if m == nil {
+		return
+	}
+
+	m.messages.WithLabelValues(peer, direction, command).Inc()
+	m.bytes.WithLabelValues(peer, direction, command).Add(float64(n))
+}
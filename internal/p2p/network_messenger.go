@@ -20,16 +20,75 @@ type NetworkMessenger struct {
 	manager         *PeerManager
 	requestBatcher  *batchProcessor
 	announceBatcher *batchProcessor
+
+	invBatchSize     int
+	invBatchInterval time.Duration
+	invTrickle       bool
+	trickler         *invTrickler
+
+	announcementLimit     int
+	announcementMaxQueued int
+	announcementMetrics   *AnnouncementMetrics
+	throttler             *announcementThrottler
+}
+
+type NetworkMessengerOption func(m *NetworkMessenger)
+
+// WithAnnouncementRateLimit limits the number of transactions announced with auto batch to each peer per second.
+// Announcements exceeding the limit are queued up to maxQueued announcements per peer.
+func WithAnnouncementRateLimit(txsPerSecond int, maxQueued int) NetworkMessengerOption {
+	return func(m *NetworkMessenger) {
+		m.announcementLimit = txsPerSecond
+		m.announcementMaxQueued = maxQueued
+	}
+}
+
+// WithInvBatching sets the maximum number of transactions announced in one INV message and the interval after which
+// the batched announcements are sent.
+func WithInvBatching(maxInvs int, interval time.Duration) NetworkMessengerOption {
+	return func(m *NetworkMessenger) {
+		if maxInvs > 0 {
+			m.invBatchSize = maxInvs
+		}
+		if interval > 0 {
+			m.invBatchInterval = interval
+		}
+	}
+}
+
+// WithInvTrickle randomizes the times at which the batched announcements are sent per peer, exponentially distributed
+// around the interval of the INV batching, so that the announcements don't reach all peers at once.
+func WithInvTrickle() NetworkMessengerOption {
+	return func(m *NetworkMessenger) {
+		m.invTrickle = true
+	}
 }
 
-func NewNetworkMessenger(l *slog.Logger, pm *PeerManager) *NetworkMessenger {
+func WithAnnouncementMetrics(metrics *AnnouncementMetrics) NetworkMessengerOption {
+	return func(m *NetworkMessenger) {
+		m.announcementMetrics = metrics
+	}
+}
+
+func NewNetworkMessenger(l *slog.Logger, pm *PeerManager, opts ...NetworkMessengerOption) *NetworkMessenger {
 	m := &NetworkMessenger{
-		logger:  l.With(slog.String("module", "network-messenger")),
-		manager: pm,
+		logger:           l.With(slog.String("module", "network-messenger")),
+		manager:          pm,
+		invBatchSize:     batchSize,
+		invBatchInterval: batchInterval,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.announcementLimit > 0 {
+		m.throttler = newAnnouncementThrottler(m.logger, m.announcementLimit, m.announcementMaxQueued, m.announcementMetrics)
 	}
 
 	m.requestBatcher = newBatchProcessor(batchSize, batchInterval, m.sendGetDataMsg, batchBufferSize)
-	m.announceBatcher = newBatchProcessor(batchSize, batchInterval, m.sendInvMsg, batchBufferSize)
+	m.announceBatcher = newBatchProcessor(m.invBatchSize, m.invBatchInterval, m.sendInvMsg, batchBufferSize)
+	m.trickler = newInvTrickler(m.logger, m.invBatchSize, m.invBatchInterval, m.invTrickle, m.writeInvMsg)
 
 	return m
 }
@@ -47,6 +106,10 @@ func (m *NetworkMessenger) Shutdown() {
 	m.announceBatcher = nil
 	m.requestBatcher.Shutdown()
 	m.requestBatcher = nil
+	m.trickler.shutdown()
+	if m.throttler != nil {
+		m.throttler.shutdown()
+	}
 }
 
 // AnnounceTransactions will send an INV messages to the provided peers or to selected peers if peers is nil.
@@ -196,6 +259,14 @@ func (m *NetworkMessenger) AnnounceWithAutoBatch(hash *chainhash.Hash, invType w
 	m.announceBatcher.Put(wire.NewInvVect(invType, hash))
 }
 
+// AnnounceToPeersWithAutoBatch announces the transaction to the given peers in batches per peer.
+func (m *NetworkMessenger) AnnounceToPeersWithAutoBatch(hash *chainhash.Hash, peers []PeerI) {
+	iv := wire.NewInvVect(wire.InvTypeTx, hash)
+	for _, peer := range peers {
+		m.trickler.announce(peer, []*wire.InvVect{iv})
+	}
+}
+
 func (m *NetworkMessenger) RequestWithAutoBatch(hash *chainhash.Hash, invType wire.InvType) {
 	m.requestBatcher.Put(wire.NewInvVect(invType, hash))
 }
@@ -205,11 +276,6 @@ func (m *NetworkMessenger) sendInvMsg(inv []*wire.InvVect) {
 		return
 	}
 
-	invMsg := wire.NewMsgInvSizeHint(uint(len(inv)))
-	for _, v := range inv {
-		_ = invMsg.AddInvVect(v)
-	}
-
 	// choose peers to announce transactions
 	peers := m.manager.GetPeersForAnnouncement()
 	if len(peers) == 0 {
@@ -217,12 +283,47 @@ func (m *NetworkMessenger) sendInvMsg(inv []*wire.InvVect) {
 		return
 	}
 
+	// the batch is trickled to each peer separately
+	if m.invTrickle {
+		for _, peer := range peers {
+			m.trickler.announce(peer, inv)
+		}
+		return
+	}
+
+	if m.throttler != nil {
+		for _, peer := range peers {
+			m.throttler.announce(peer, inv)
+		}
+		return
+	}
+
+	invMsg := wire.NewMsgInvSizeHint(uint(len(inv)))
+	for _, v := range inv {
+		_ = invMsg.AddInvVect(v)
+	}
+
 	// send message
 	for _, peer := range peers {
 		peer.WriteMsg(invMsg)
 	}
 }
 
+// writeInvMsg writes the INV message to the peer, subject to the announcement rate limit if configured.
+func (m *NetworkMessenger) writeInvMsg(peer PeerI, inv []*wire.InvVect) {
+	if m.throttler != nil {
+		m.throttler.announce(peer, inv)
+		return
+	}
+
+	invMsg := wire.NewMsgInvSizeHint(uint(len(inv)))
+	for _, v := range inv {
+		_ = invMsg.AddInvVect(v)
+	}
+
+	peer.WriteMsg(invMsg)
+}
+
 func (m *NetworkMessenger) sendGetDataMsg(inv []*wire.InvVect) {
 	if len(inv) == 0 {
 		return
@@ -1,12 +1,18 @@
 package p2p_test
 
 import (
+	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/ccoveille/go-safecast"
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/libsv/go-p2p/wire"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -336,3 +342,221 @@ func Test_RequestBlock(t *testing.T) {
 		require.Len(t, notConnectedPeer.WriteMsgCalls(), 0)
 	})
 }
+
+func Test_AnnounceWithAutoBatch(t *testing.T) {
+	tt := []struct {
+		name         string
+		txsPerSecond int
+		maxQueued    int
+
+		expectedInvs    int
+		expectedDropped int
+	}{
+		{
+			name: "no rate limit",
+
+			expectedInvs: 15,
+		},
+		{
+			name:         "rate limit - queued announcements are sent",
+			txsPerSecond: 10,
+			maxQueued:    20,
+
+			expectedInvs: 15,
+		},
+		{
+			name:         "rate limit - queue full",
+			txsPerSecond: 10,
+			maxQueued:    12,
+
+			expectedInvs:    12,
+			expectedDropped: 3,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var mu sync.Mutex
+			var invs int
+			peer := &mocks.PeerIMock{
+				WriteMsgFunc: func(msg wire.Message) {
+					invMsg, ok := msg.(*wire.MsgInv)
+					require.True(t, ok)
+
+					mu.Lock()
+					invs += len(invMsg.InvList)
+					mu.Unlock()
+				},
+				StringFunc:    func() string { return "peer" },
+				NetworkFunc:   func() wire.BitcoinNet { return peerManagerNetwork },
+				ConnectedFunc: func() bool { return true },
+			}
+
+			pm := p2p.NewPeerManager(slog.Default(), peerManagerNetwork)
+			err := pm.AddPeer(peer)
+			require.NoError(t, err)
+
+			metrics := p2p.NewAnnouncementMetrics()
+			sut := p2p.NewNetworkMessenger(slog.Default(), pm,
+				p2p.WithAnnouncementRateLimit(tc.txsPerSecond, tc.maxQueued),
+				p2p.WithAnnouncementMetrics(metrics),
+			)
+			defer sut.Shutdown()
+
+			// when
+			for i := range 15 {
+				sut.AnnounceWithAutoBatch(&chainhash.Hash{byte(i)}, wire.InvTypeTx)
+			}
+
+			// then
+			require.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return invs == tc.expectedInvs
+			}, 2*time.Second, 20*time.Millisecond)
+
+			if tc.txsPerSecond == 0 {
+				assert.Equal(t, 0, testutil.CollectAndCount(metrics))
+				return
+			}
+
+			expected := `
+# HELP arc_p2p_announcements_queued Number of transaction announcements per peer which are queued because of the announcement rate limit
+# TYPE arc_p2p_announcements_queued gauge
+arc_p2p_announcements_queued{peer="peer"} 0
+`
+			if tc.expectedDropped > 0 {
+				expected += fmt.Sprintf(`# HELP arc_p2p_announcements_dropped_total Number of transaction announcements per peer which were dropped because the announcement queue was full
+# TYPE arc_p2p_announcements_dropped_total counter
+arc_p2p_announcements_dropped_total{peer="peer"} %d
+`, tc.expectedDropped)
+			}
+			require.NoError(t, testutil.CollectAndCompare(metrics, strings.NewReader(expected)))
+		})
+	}
+}
+
+func Test_AnnounceWithAutoBatchDisconnectedPeer(t *testing.T) {
+	// given
+	var mu sync.Mutex
+	var invs int
+	var connected atomic.Bool
+	connected.Store(true)
+	peer := &mocks.PeerIMock{
+		WriteMsgFunc: func(msg wire.Message) {
+			invMsg, ok := msg.(*wire.MsgInv)
+			require.True(t, ok)
+
+			mu.Lock()
+			invs += len(invMsg.InvList)
+			mu.Unlock()
+		},
+		StringFunc:    func() string { return "peer" },
+		NetworkFunc:   func() wire.BitcoinNet { return peerManagerNetwork },
+		ConnectedFunc: connected.Load,
+	}
+
+	pm := p2p.NewPeerManager(slog.Default(), peerManagerNetwork)
+	err := pm.AddPeer(peer)
+	require.NoError(t, err)
+
+	metrics := p2p.NewAnnouncementMetrics()
+	sut := p2p.NewNetworkMessenger(slog.Default(), pm,
+		p2p.WithAnnouncementRateLimit(1, 10),
+		p2p.WithAnnouncementMetrics(metrics),
+	)
+	defer sut.Shutdown()
+
+	for i := range 5 {
+		sut.AnnounceWithAutoBatch(&chainhash.Hash{byte(i)}, wire.InvTypeTx)
+	}
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return invs == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// when
+	connected.Store(false)
+
+	// then
+	require.Eventually(t, func() bool {
+		return testutil.CollectAndCount(metrics) == 0
+	}, 2*time.Second, 20*time.Millisecond)
+
+	time.Sleep(1200 * time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, invs)
+}
+
+func Test_AnnounceToPeersWithAutoBatch(t *testing.T) {
+	tt := []struct {
+		name    string
+		trickle bool
+	}{
+		{
+			name: "batched",
+		},
+		{
+			name:    "trickled",
+			trickle: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var mu sync.Mutex
+			msgs := make(map[string][]int)
+			newPeer := func(name string, connected bool) *mocks.PeerIMock {
+				return &mocks.PeerIMock{
+					WriteMsgFunc: func(msg wire.Message) {
+						invMsg, ok := msg.(*wire.MsgInv)
+						require.True(t, ok)
+
+						mu.Lock()
+						msgs[name] = append(msgs[name], len(invMsg.InvList))
+						mu.Unlock()
+					},
+					StringFunc:    func() string { return name },
+					NetworkFunc:   func() wire.BitcoinNet { return peerManagerNetwork },
+					ConnectedFunc: func() bool { return connected },
+				}
+			}
+			peer1 := newPeer("peer1", true)
+			peer2 := newPeer("peer2", true)
+			disconnectedPeer := newPeer("peer3", false)
+
+			pm := p2p.NewPeerManager(slog.Default(), peerManagerNetwork)
+			opts := []p2p.NetworkMessengerOption{p2p.WithInvBatching(2, 50*time.Millisecond)}
+			if tc.trickle {
+				opts = append(opts, p2p.WithInvTrickle())
+			}
+			sut := p2p.NewNetworkMessenger(slog.Default(), pm, opts...)
+			defer sut.Shutdown()
+
+			// when
+			for i := range 5 {
+				sut.AnnounceToPeersWithAutoBatch(&chainhash.Hash{byte(i)}, []p2p.PeerI{peer1})
+			}
+			for i := range 3 {
+				sut.AnnounceToPeersWithAutoBatch(&chainhash.Hash{byte(10 + i)}, []p2p.PeerI{peer1, peer2, disconnectedPeer})
+			}
+
+			// then
+			require.Eventually(t, func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(msgs["peer1"]) == 4 && len(msgs["peer2"]) == 2
+			}, 2*time.Second, 10*time.Millisecond)
+
+			mu.Lock()
+			defer mu.Unlock()
+			assert.Equal(t, []int{2, 2, 2, 2}, msgs["peer1"])
+			assert.Equal(t, []int{2, 1}, msgs["peer2"])
+			assert.Empty(t, msgs["peer3"])
+		})
+	}
+}
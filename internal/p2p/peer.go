@@ -1,15 +1,20 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"log/slog"
 	"net"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/ccoveille/go-safecast"
 	"github.com/libsv/go-p2p/wire"
+
+	"github.com/bitcoin-sv/arc/internal/supervisor"
 )
 
 const (
@@ -37,14 +42,23 @@ type Peer struct {
 	address          string
 	network          wire.BitcoinNet
 	servicesFlag     wire.ServiceFlag
+	protocolVersion  uint32
+	minProtocolVer   uint32
+	requiredServices wire.ServiceFlag
+	features         atomic.Pointer[PeerFeatures]
 	userAgentName    *string
 	userAgentVersion *string
 	dialer           Dialer
+	proxied          bool
+	sendHeaders      bool
 
 	connectionTimeout time.Duration
 	lConn             net.Conn
 	logger            *slog.Logger
 	mh                MessageHandlerI
+	banManager        *BanManager
+	metrics           *MessageMetrics
+	dumper            *MessageDumper
 
 	writeCh      chan wire.Message
 	nWriters     uint8
@@ -74,9 +88,10 @@ func NewPeer(logger *slog.Logger, msgHandler MessageHandlerI, address string, ne
 
 		connectionTimeout: connectionTimeoutDefault,
 
-		address:      address,
-		network:      network,
-		servicesFlag: wire.SFNodeNetwork,
+		address:         address,
+		network:         network,
+		servicesFlag:    wire.SFNodeNetwork,
+		protocolVersion: wire.ProtocolVersion,
 
 		pingInterval:    defaultPingInterval,
 		healthThreshold: defaultHealthTreshold,
@@ -96,6 +111,15 @@ func NewPeer(logger *slog.Logger, msgHandler MessageHandlerI, address string, ne
 		p.writeCh = make(chan wire.Message, 128)
 	}
 
+	// newer protocol versions than the one implemented by the wire package can't be spoken
+	if p.protocolVersion == 0 || p.protocolVersion > wire.ProtocolVersion {
+		p.protocolVersion = wire.ProtocolVersion
+	}
+
+	if p.banManager != nil {
+		p.banManager.AddPeer(p)
+	}
+
 	return p
 }
 
@@ -131,6 +155,10 @@ func (p *Peer) Shutdown() {
 	p.startMu.Lock()
 	defer p.startMu.Unlock()
 
+	if p.banManager != nil {
+		p.banManager.RemovePeer(p)
+	}
+
 	if !p.connected.Load() {
 		return
 	}
@@ -155,7 +183,38 @@ func (p *Peer) String() string {
 	return p.address
 }
 
+// Features returns the features negotiated with the peer in the last handshake, false if no handshake has been
+// completed yet.
+func (p *Peer) Features() (PeerFeatures, bool) {
+	features := p.features.Load()
+	if features == nil {
+		return PeerFeatures{}, false
+	}
+
+	return *features, true
+}
+
+// pver returns the protocol version used for the messages exchanged with the peer. Until the version of the peer is
+// known, the messages are exchanged with the protocol version announced by ARC.
+func (p *Peer) pver() uint32 {
+	features := p.features.Load()
+	if features == nil {
+		return p.protocolVersion
+	}
+
+	return features.ProtocolVersion
+}
+
 func (p *Peer) connect() bool {
+	if p.banManager != nil && p.banManager.IsBanned(p.address) {
+		p.logger.Warn("Peer is banned - not connecting")
+		return false
+	}
+
+	if p.banManager != nil {
+		p.banManager.AddPeer(p)
+	}
+
 	p.logger.Info("Connecting")
 
 	ctx := context.Background()
@@ -172,6 +231,7 @@ func (p *Peer) connect() bool {
 	execCtx, cancelFn := context.WithCancel(ctx)
 	p.execCtx = execCtx
 	p.cancelExecCtx = cancelFn
+	p.features.Store(nil)
 
 	if ok := p.handshake(lc); !ok {
 		_ = lc.Close()
@@ -185,6 +245,11 @@ func (p *Peer) connect() bool {
 		p.sendMessages(i)
 	}
 
+	// ask the node to announce new blocks with their headers instead of an inv
+	if p.sendHeaders && p.pver() >= wire.SendHeadersVersion {
+		p.writeCh <- wire.NewMsgSendHeaders()
+	}
+
 	p.keepAlive()
 	p.healthMonitor()
 
@@ -204,8 +269,7 @@ func (p *Peer) handshake(c net.Conn) (ok bool) {
 	// send VerMsg
 	me := wire.NewNetAddress(&net.TCPAddr{IP: nil, Port: 0}, p.servicesFlag) // shouldn't be mode configurable?
 
-	nAddr, _ := net.ResolveTCPAddr("tcp", p.address) // address was validate already, we can omit error
-	you := wire.NewNetAddress(nAddr, wire.SFNodeNetwork)
+	you := p.remoteNetAddress()
 
 	nonce, err := wire.RandomUint64()
 	if err != nil {
@@ -214,6 +278,11 @@ func (p *Peer) handshake(c net.Conn) (ok bool) {
 
 	const lastBlock = int32(0)
 	verMsg := wire.NewMsgVersion(me, you, nonce, lastBlock)
+	verMsg.ProtocolVersion, err = safecast.ToInt32(p.protocolVersion)
+	if err != nil {
+		p.logger.Error("Handshake failed", slog.String("reason", "invalid protocol version"), slog.String(errKey, err.Error()))
+		return false
+	}
 
 	if p.userAgentName != nil && p.userAgentVersion != nil {
 		err = verMsg.AddUserAgent(*p.userAgentName, *p.userAgentVersion)
@@ -222,7 +291,7 @@ func (p *Peer) handshake(c net.Conn) (ok bool) {
 		}
 	}
 
-	err = wire.WriteMessage(c, verMsg, wire.ProtocolVersion, p.network)
+	err = p.writeMessage(c, verMsg)
 	const handshakeFailed = "Handshake failed"
 	if err != nil {
 		p.logger.Error(handshakeFailed,
@@ -242,6 +311,11 @@ func (p *Peer) handshake(c net.Conn) (ok bool) {
 	read := make(chan readResult, 1)
 	readController := make(chan struct{}, 1)
 
+	var raw *rawCapture
+	if p.dumper.Enabled(p.address) {
+		raw = &rawCapture{}
+	}
+
 	go func(ctx context.Context) {
 		for {
 			select {
@@ -249,7 +323,8 @@ func (p *Peer) handshake(c net.Conn) (ok bool) {
 				return
 
 			case <-readController:
-				msg, _, err := wire.ReadMessage(c, wire.ProtocolVersion, p.network)
+				n, msg, err := readMessageN(c, p.pver(), p.network, raw)
+				p.messageReceived(n, msg, rawBytes(raw), err)
 				read <- readResult{msg, err}
 			}
 		}
@@ -296,9 +371,19 @@ func (p *Peer) performHandShake(c net.Conn, read chan readResult, readController
 					continue
 				}
 
+				verMsg, ok := nmsg.(*wire.MsgVersion)
+				if !ok {
+					p.logger.Error(handshakeFailed, slog.String("reason", "received invalid VER message"))
+					return false
+				}
+
+				if !p.negotiate(verMsg, handshakeFailed) {
+					return false
+				}
+
 				// send VERACK to node
 				ackMsg := wire.NewMsgVerAck()
-				err = wire.WriteMessage(c, ackMsg, wire.ProtocolVersion, p.network)
+				err = p.writeMessage(c, ackMsg)
 				if err != nil {
 					p.logger.Error(handshakeFailed,
 						slog.String("reason", "failed to write VERACK message"),
@@ -321,6 +406,35 @@ func (p *Peer) performHandShake(c net.Conn, read chan readResult, readController
 	return true
 }
 
+// negotiate negotiates the features of the connection with the VER message of the peer. Peers speaking a newer protocol
+// than ARC are still connected with the protocol version of ARC, but a warning is logged.
+func (p *Peer) negotiate(verMsg *wire.MsgVersion, handshakeFailed string) bool {
+	features, err := negotiateFeatures(verMsg, p.protocolVersion, p.minProtocolVer, p.requiredServices)
+	if err != nil {
+		p.logger.Error(handshakeFailed, slog.String("reason", "protocol negotiation failed"), slog.String(errKey, err.Error()))
+		return false
+	}
+
+	logger := p.logger.With(
+		slog.Uint64("protocolVersion", uint64(features.ProtocolVersion)),
+		slog.Uint64("peerProtocolVersion", uint64(features.PeerProtocolVersion)),
+		slog.String("userAgent", features.UserAgent),
+	)
+
+	if features.NewerProtocol() {
+		logger.Warn("Peer speaks a newer protocol than supported - falling back to supported protocol version")
+	}
+	if features.UnknownServices != 0 {
+		logger.Warn("Peer announces unknown services", slog.String("services", features.UnknownServices.String()))
+	}
+	if !features.ExtendedMessages {
+		logger.Info("Extended messages are not supported with peer")
+	}
+
+	p.features.Store(&features)
+	return true
+}
+
 func (p *Peer) keepAlive() {
 	p.execWg.Add(1)
 
@@ -421,52 +535,81 @@ func (p *Peer) listenForMessages() {
 		defer p.execWg.Done()
 
 		reader := NewWireReaderSize(p.lConn, p.maxMsgSize, p.readBuffSize)
-		for {
-			msg, err := reader.ReadNextMsg(p.execCtx, wire.ProtocolVersion, p.network)
-			if errors.Is(err, context.Canceled) {
-				return
-			}
-			if err != nil {
-				l.Error("Failed to read message", slog.String("err", err.Error()))
-				// stop peer
-				p.unhealthyDisconnect()
-				return
-			}
-
-			cmd := msg.Command()
-			l.Log(context.Background(), slogLvlTrace, "Received", slogUpperString(commandKey, cmd))
+		reader.observe = p.messageReceived
+		if p.dumper.Enabled(p.address) {
+			reader.raw = &rawCapture{}
+		}
 
-			switch cmd {
-			// micro optimization - INV is the most frequently received message
-			case wire.CmdInv:
-				p.mh.OnReceive(msg, p)
+		// a panic while reading leaves the connection in an unknown state, so the peer is disconnected and reconnected
+		panicked := supervisor.Recover(l, "peer read handler", func() {
+			p.readMessages(reader)
+		})
+		if panicked {
+			p.unhealthyDisconnect()
+		}
+	}()
+}
 
-			// ignore handshake type messages
-			case wire.CmdVersion:
-				fallthrough
-			case wire.CmdVerAck:
-				l.Warn("Received handshake message after handshake completed", slogUpperString(commandKey, cmd))
+func (p *Peer) readMessages(reader *WireReader) {
+	l := p.logger
+	for {
+		msg, err := reader.ReadNextMsg(p.execCtx, p.pver(), p.network)
+		if errors.Is(err, context.Canceled) {
+			return
+		}
+		if err != nil {
+			l.Error("Failed to read message", slog.String("err", err.Error()))
 
-			// handle keep-alive ping-pong
-			case wire.CmdPing:
-				ping, ok := msg.(*wire.MsgPing)
-				if !ok {
-					p.logger.Warn("Received invalid PING")
-					continue
-				}
+			var msgErr *wire.MessageError
+			if p.banManager != nil && errors.As(err, &msgErr) {
+				p.banManager.InvalidMessage(p.address, msgErr.Error())
+			}
 
-				p.aliveCh <- struct{}{}
-				p.writeCh <- wire.NewMsgPong(ping.Nonce) // are we sure it should go with write channel not beside?
+			// stop peer
+			p.unhealthyDisconnect()
+			return
+		}
 
-			case wire.CmdPong:
-				p.aliveCh <- struct{}{}
+		// a panic while handling a message drops the message, the connection is kept
+		supervisor.Recover(l, "peer message handler", func() {
+			p.handleMessage(msg)
+		})
+	}
+}
 
-			// pass message to client
-			default:
-				p.mh.OnReceive(msg, p)
-			}
+func (p *Peer) handleMessage(msg wire.Message) {
+	cmd := msg.Command()
+	p.logger.Log(context.Background(), slogLvlTrace, "Received", slogUpperString(commandKey, cmd))
+
+	switch cmd {
+	// micro optimization - INV is the most frequently received message
+	case wire.CmdInv:
+		p.mh.OnReceive(msg, p)
+
+	// ignore handshake type messages
+	case wire.CmdVersion:
+		fallthrough
+	case wire.CmdVerAck:
+		p.logger.Warn("Received handshake message after handshake completed", slogUpperString(commandKey, cmd))
+
+	// handle keep-alive ping-pong
+	case wire.CmdPing:
+		ping, ok := msg.(*wire.MsgPing)
+		if !ok {
+			p.logger.Warn("Received invalid PING")
+			return
 		}
-	}()
+
+		p.aliveCh <- struct{}{}
+		p.writeCh <- wire.NewMsgPong(ping.Nonce) // are we sure it should go with write channel not beside?
+
+	case wire.CmdPong:
+		p.aliveCh <- struct{}{}
+
+	// pass message to client
+	default:
+		p.mh.OnReceive(msg, p)
+	}
 }
 
 func (p *Peer) sendMessages(n uint8) {
@@ -486,12 +629,15 @@ func (p *Peer) sendMessages(n uint8) {
 
 			case msg := <-p.writeCh:
 				// do not retry || TODO: rethink retry
-				err := wire.WriteMessage(p.lConn, msg, wire.ProtocolVersion, p.network)
+				err := p.writeMessage(p.lConn, msg)
 				if err != nil {
 					l.Error("Failed to send message",
 						slogUpperString(commandKey, msg.Command()),
 						slog.String("err", err.Error()),
 					)
+					if shared, ok := msg.(*SharedMessage); ok {
+						shared.Release()
+					}
 					// stop peer
 					p.unhealthyDisconnect()
 					return
@@ -499,8 +645,85 @@ func (p *Peer) sendMessages(n uint8) {
 
 				l.Log(context.Background(), slogLvlTrace, "Sent", slogUpperString(commandKey, msg.Command()))
 				// let client react on sending msg
-				p.mh.OnSend(msg, p)
+				supervisor.Recover(l, "peer write handler", func() {
+					p.mh.OnSend(msg, p)
+				})
+
+				if shared, ok := msg.(*SharedMessage); ok {
+					shared.Release()
+				}
 			}
 		}
 	}()
 }
+
+// writeMessage writes the message and records it in the message metrics and the message dump.
+func (p *Peer) writeMessage(w io.Writer, msg wire.Message) error {
+	// shared messages are already encoded for the network of the peer
+	if shared, ok := msg.(*SharedMessage); ok && shared.network == p.network {
+		n, err := shared.writeTo(w)
+		if err != nil {
+			return err
+		}
+
+		p.metrics.observe(p.address, directionSent, msg.Command(), n)
+		if p.dumper.Enabled(p.address) {
+			p.dump(directionSent, msg.Command(), n, shared.raw)
+		}
+		return nil
+	}
+
+	if !p.dumper.Enabled(p.address) {
+		n, err := wire.WriteMessageN(w, msg, p.pver(), p.network)
+		if err != nil {
+			return err
+		}
+
+		p.metrics.observe(p.address, directionSent, msg.Command(), n)
+		return nil
+	}
+
+	// encode the message first to capture the raw bytes
+	var buf bytes.Buffer
+	_, err := wire.WriteMessageN(&buf, msg, p.pver(), p.network)
+	if err != nil {
+		return err
+	}
+
+	n, err := w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	p.metrics.observe(p.address, directionSent, msg.Command(), n)
+	p.dump(directionSent, msg.Command(), n, buf.Bytes())
+
+	return nil
+}
+
+// messageReceived records the message read in the message metrics and the message dump.
+func (p *Peer) messageReceived(n int, msg wire.Message, raw []byte, err error) {
+	command := commandInvalid
+	switch {
+	case err == nil:
+		command = msg.Command()
+	case isUnknownCommandErr(err):
+		command = commandUnknown
+	case n == 0:
+		// nothing was read, e.g. the connection was closed
+		return
+	}
+
+	p.metrics.observe(p.address, directionReceived, command, n)
+
+	if raw != nil {
+		p.dump(directionReceived, command, n, raw)
+	}
+}
+
+func (p *Peer) dump(direction string, command string, size int, raw []byte) {
+	err := p.dumper.Dump(p.address, direction, command, size, raw)
+	if err != nil {
+		p.logger.Warn("Failed to dump message", slog.String(errKey, err.Error()))
+	}
+}
@@ -0,0 +1,392 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/libsv/go-p2p/wire"
+)
+
+const (
+	autoscalingIntervalDefault = time.Minute
+	// failedCandidateCooldown is the duration for which a candidate is skipped after a failed connection attempt
+	failedCandidateCooldown = 10 * time.Minute
+	dnsSeedTimeout          = 10 * time.Second
+)
+
+var (
+	ErrNoCandidates          = errors.New("neither candidates nor dns seeds given")
+	ErrNoTrustedNetworks     = errors.New("dns seeds require trusted networks")
+	ErrInvalidTrustedNetwork = errors.New("invalid trusted network")
+	ErrInvalidTarget         = errors.New("throughput target must be greater than the idle throughput")
+)
+
+// ThroughputMeter counts the items received from the peers, e.g. the announced transactions or the bytes of the
+// downloaded blocks.
+type ThroughputMeter struct {
+	count atomic.Uint64
+}
+
+func (t *ThroughputMeter) Add(n uint64) {
+	t.count.Add(n)
+}
+
+// take returns the items counted since the last call.
+func (t *ThroughputMeter) take() uint64 {
+	return t.count.Swap(0)
+}
+
+// meteredMessageHandler counts the received items measured by the measure function before it passes the messages on.
+type meteredMessageHandler struct {
+	MessageHandlerI
+	meter   *ThroughputMeter
+	measure func(msg wire.Message) uint64
+}
+
+// NewMeteredMessageHandler wraps the message handler so that the items of the received messages which are measured
+// by the measure function are counted by the meter.
+func NewMeteredMessageHandler(handler MessageHandlerI, meter *ThroughputMeter, measure func(msg wire.Message) uint64) MessageHandlerI {
+	return &meteredMessageHandler{MessageHandlerI: handler, meter: meter, measure: measure}
+}
+
+func (h *meteredMessageHandler) OnReceive(msg wire.Message, peer PeerI) {
+	h.meter.Add(h.measure(msg))
+	h.MessageHandlerI.OnReceive(msg, peer)
+}
+
+// TxAnnouncements measures the number of transactions announced by an INV message.
+func TxAnnouncements(msg wire.Message) uint64 {
+	inv, ok := msg.(*wire.MsgInv)
+	if !ok {
+		return 0
+	}
+
+	var n uint64
+	for _, iv := range inv.InvList {
+		if iv.Type == wire.InvTypeTx {
+			n++
+		}
+	}
+
+	return n
+}
+
+// PeerAutoscaler opens additional peer connections while the throughput measured by the meter is below the target and
+// closes them again while the throughput is idle. The additional peers are taken from the configured candidates and
+// from the addresses of the DNS seeds which lie within the trusted networks. The configured peers are never closed.
+type PeerAutoscaler struct {
+	logger  *slog.Logger
+	manager *PeerManager
+	meter   *ThroughputMeter
+	dial    func(ctx context.Context, address string) (PeerI, error)
+
+	candidates      []string
+	dnsSeeds        []string
+	dnsSeedPort     uint16
+	trustedNetworks []string
+	trusted         []*net.IPNet
+	lookupHost      func(ctx context.Context, host string) ([]string, error)
+
+	target   float64
+	idle     float64
+	maxPeers int
+	interval time.Duration
+	now      func() time.Time
+
+	mu     sync.Mutex
+	scaled []PeerI
+	failed map[string]time.Time
+}
+
+// WithAutoscalingCandidates sets the addresses of the peers which may be connected additionally, in order of preference.
+func WithAutoscalingCandidates(addresses ...string) func(*PeerAutoscaler) {
+	return func(a *PeerAutoscaler) {
+		a.candidates = addresses
+	}
+}
+
+// WithAutoscalingDNSSeeds resolves the addresses of additional peers from the DNS seeds if the candidates are
+// exhausted. Only the addresses within the trusted networks, given as CIDR or IP, are connected.
+func WithAutoscalingDNSSeeds(seeds []string, port uint16, trustedNetworks []string) func(*PeerAutoscaler) {
+	return func(a *PeerAutoscaler) {
+		a.dnsSeeds = seeds
+		a.dnsSeedPort = port
+		a.trustedNetworks = trustedNetworks
+	}
+}
+
+// WithThroughputTarget sets the throughput per second below which peers are added and the throughput at or below
+// which the throughput is idle and the additional peers are closed.
+func WithThroughputTarget(target float64, idle float64) func(*PeerAutoscaler) {
+	return func(a *PeerAutoscaler) {
+		a.target = target
+		a.idle = idle
+	}
+}
+
+// WithMaxPeers limits the number of peers of the peer manager including the additional peers.
+func WithMaxPeers(n int) func(*PeerAutoscaler) {
+	return func(a *PeerAutoscaler) {
+		a.maxPeers = n
+	}
+}
+
+// WithAutoscalingInterval sets the interval over which the throughput is measured. At most one peer is opened or
+// closed per interval.
+func WithAutoscalingInterval(d time.Duration) func(*PeerAutoscaler) {
+	return func(a *PeerAutoscaler) {
+		a.interval = d
+	}
+}
+
+func WithHostLookup(lookupHost func(ctx context.Context, host string) ([]string, error)) func(*PeerAutoscaler) {
+	return func(a *PeerAutoscaler) {
+		a.lookupHost = lookupHost
+	}
+}
+
+// NewPeerAutoscaler creates a peer autoscaler adding the peers connected by the dial function to the peer manager.
+func NewPeerAutoscaler(logger *slog.Logger, manager *PeerManager, meter *ThroughputMeter, dial func(ctx context.Context, address string) (PeerI, error), opts ...func(*PeerAutoscaler)) (*PeerAutoscaler, error) {
+	a := &PeerAutoscaler{
+		logger:     logger.With(slog.String("module", "peer-autoscaler")),
+		manager:    manager,
+		meter:      meter,
+		dial:       dial,
+		lookupHost: net.DefaultResolver.LookupHost,
+		interval:   autoscalingIntervalDefault,
+		now:        time.Now,
+		failed:     make(map[string]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if len(a.candidates) == 0 && len(a.dnsSeeds) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	if len(a.dnsSeeds) > 0 && len(a.trustedNetworks) == 0 {
+		return nil, ErrNoTrustedNetworks
+	}
+
+	for _, network := range a.trustedNetworks {
+		trusted, err := parseTrustedNetwork(network)
+		if err != nil {
+			return nil, err
+		}
+		a.trusted = append(a.trusted, trusted)
+	}
+
+	if a.target <= a.idle {
+		return nil, ErrInvalidTarget
+	}
+
+	if a.interval <= 0 {
+		a.interval = autoscalingIntervalDefault
+	}
+
+	return a, nil
+}
+
+func parseTrustedNetwork(network string) (*net.IPNet, error) {
+	_, ipNet, err := net.ParseCIDR(network)
+	if err == nil {
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(network)
+	if ip == nil {
+		return nil, errors.Join(ErrInvalidTrustedNetwork, fmt.Errorf("network %q", network))
+	}
+
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// Start measures the throughput and scales the peers in every interval until the peer manager is shut down, which
+// also shuts down the additional peers.
+func (a *PeerAutoscaler) Start() {
+	ctx := a.manager.execCtx
+
+	a.manager.execWg.Add(1)
+	go func() {
+		defer a.manager.execWg.Done()
+
+		ticker := time.NewTicker(a.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.scale(ctx)
+			}
+		}
+	}()
+}
+
+// scale opens a peer if the throughput of the last interval is below the target and closes an additional peer if it
+// is idle.
+func (a *PeerAutoscaler) scale(ctx context.Context) {
+	throughput := float64(a.meter.take()) / a.interval.Seconds()
+
+	switch {
+	case throughput <= a.idle:
+		a.scaleDown(throughput)
+	case throughput < a.target:
+		a.scaleUp(ctx, throughput)
+	}
+}
+
+func (a *PeerAutoscaler) scaleUp(ctx context.Context, throughput float64) {
+	if a.maxPeers > 0 && len(a.manager.GetPeers()) >= a.maxPeers {
+		return
+	}
+
+	for _, address := range a.nextCandidates(ctx) {
+		peer, err := a.dial(ctx, address)
+		if err != nil {
+			a.logger.Warn("Failed to connect to additional peer", slog.String("peer", address), slog.String("err", err.Error()))
+			a.mu.Lock()
+			a.failed[address] = a.now()
+			a.mu.Unlock()
+			continue
+		}
+
+		err = a.manager.AddPeer(peer)
+		if err != nil {
+			a.logger.Error("Failed to add additional peer", slog.String("peer", address), slog.String("err", err.Error()))
+			peer.Shutdown()
+			return
+		}
+
+		a.mu.Lock()
+		a.scaled = append(a.scaled, peer)
+		a.mu.Unlock()
+
+		a.logger.Info("Connected additional peer", slog.String("peer", address), slog.Float64("throughput", throughput), slog.Float64("target", a.target))
+		return
+	}
+
+	a.logger.Warn("Throughput below target, but no candidate peer available", slog.Float64("throughput", throughput), slog.Float64("target", a.target))
+}
+
+func (a *PeerAutoscaler) scaleDown(throughput float64) {
+	a.mu.Lock()
+	if len(a.scaled) == 0 {
+		a.mu.Unlock()
+		return
+	}
+
+	// the most recently added peer is closed first
+	peer := a.scaled[len(a.scaled)-1]
+	a.scaled = a.scaled[:len(a.scaled)-1]
+	a.mu.Unlock()
+
+	a.manager.RemovePeer(peer)
+	peer.Shutdown()
+
+	a.logger.Info("Closed additional peer", slog.String("peer", peer.String()), slog.Float64("throughput", throughput))
+}
+
+// nextCandidates returns the candidates which are neither connected nor failed recently, the configured candidates
+// before the trusted addresses of the DNS seeds.
+func (a *PeerAutoscaler) nextCandidates(ctx context.Context) []string {
+	connected := make(map[string]struct{})
+	for _, peer := range a.manager.GetPeers() {
+		connected[peer.String()] = struct{}{}
+	}
+
+	a.mu.Lock()
+	now := a.now()
+	for address, failedAt := range a.failed {
+		if now.Sub(failedAt) > failedCandidateCooldown {
+			delete(a.failed, address)
+			continue
+		}
+		connected[address] = struct{}{}
+	}
+	a.mu.Unlock()
+
+	available := func(address string) bool {
+		_, found := connected[address]
+		return !found
+	}
+
+	var candidates []string
+	for _, address := range a.candidates {
+		if available(address) {
+			candidates = append(candidates, address)
+		}
+	}
+	if len(candidates) > 0 {
+		return candidates
+	}
+
+	for _, address := range a.resolveDNSSeeds(ctx) {
+		if available(address) {
+			candidates = append(candidates, address)
+		}
+	}
+
+	return candidates
+}
+
+// resolveDNSSeeds returns the addresses of the DNS seeds which lie within the trusted networks.
+func (a *PeerAutoscaler) resolveDNSSeeds(ctx context.Context) []string {
+	var addresses []string
+	for _, seed := range a.dnsSeeds {
+		lookupCtx, cancel := context.WithTimeout(ctx, dnsSeedTimeout)
+		hosts, err := a.lookupHost(lookupCtx, seed)
+		cancel()
+		if err != nil {
+			a.logger.Warn("Failed to resolve DNS seed", slog.String("seed", seed), slog.String("err", err.Error()))
+			continue
+		}
+
+		for _, host := range hosts {
+			if !a.isTrusted(net.ParseIP(host)) {
+				continue
+			}
+			addresses = append(addresses, net.JoinHostPort(host, strconv.Itoa(int(a.dnsSeedPort))))
+		}
+	}
+
+	return addresses
+}
+
+func (a *PeerAutoscaler) isTrusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+
+	for _, trusted := range a.trusted {
+		if trusted.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AdditionalPeers returns the number of peers opened by the autoscaler.
+func (a *PeerAutoscaler) AdditionalPeers() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return len(a.scaled)
+}
@@ -0,0 +1,225 @@
+package p2p_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/p2p/mocks"
+)
+
+func newAutoscalerPeer(address string) *mocks.PeerIMock {
+	return &mocks.PeerIMock{
+		NetworkFunc:   func() wire.BitcoinNet { return peerManagerNetwork },
+		StringFunc:    func() string { return address },
+		ConnectedFunc: func() bool { return true },
+		ShutdownFunc:  func() {},
+	}
+}
+
+func TestPeerAutoscaler(t *testing.T) {
+	tt := []struct {
+		name       string
+		throughput uint64
+		opts       []func(*p2p.PeerAutoscaler)
+		dialErrs   map[string]error
+
+		expectedDialed     []string
+		expectedAdditional int
+	}{
+		{
+			name:       "throughput below target",
+			throughput: 5,
+			opts:       []func(*p2p.PeerAutoscaler){p2p.WithAutoscalingCandidates("10.0.0.1:8333", "10.0.0.2:8333")},
+
+			expectedDialed:     []string{"10.0.0.2:8333"},
+			expectedAdditional: 1,
+		},
+		{
+			name:       "failed candidate is skipped",
+			throughput: 5,
+			opts:       []func(*p2p.PeerAutoscaler){p2p.WithAutoscalingCandidates("10.0.0.2:8333", "10.0.0.3:8333")},
+			dialErrs:   map[string]error{"10.0.0.2:8333": errors.New("connection refused")},
+
+			expectedDialed:     []string{"10.0.0.2:8333", "10.0.0.3:8333"},
+			expectedAdditional: 1,
+		},
+		{
+			name:       "throughput at target",
+			throughput: 100,
+			opts:       []func(*p2p.PeerAutoscaler){p2p.WithAutoscalingCandidates("10.0.0.2:8333")},
+
+			expectedAdditional: 0,
+		},
+		{
+			name:       "max peers reached",
+			throughput: 5,
+			opts: []func(*p2p.PeerAutoscaler){
+				p2p.WithAutoscalingCandidates("10.0.0.2:8333"),
+				p2p.WithMaxPeers(1),
+			},
+
+			expectedAdditional: 0,
+		},
+		{
+			name:       "trusted addresses of dns seeds",
+			throughput: 5,
+			opts: []func(*p2p.PeerAutoscaler){
+				p2p.WithAutoscalingDNSSeeds([]string{"seed.example.com"}, 18333, []string{"192.0.2.0/24"}),
+				p2p.WithHostLookup(func(_ context.Context, host string) ([]string, error) {
+					require.Equal(t, "seed.example.com", host)
+					return []string{"198.51.100.7", "192.0.2.5"}, nil
+				}),
+			},
+
+			expectedDialed:     []string{"192.0.2.5:18333"},
+			expectedAdditional: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			manager := p2p.NewPeerManager(slog.Default(), peerManagerNetwork)
+			require.NoError(t, manager.AddPeer(newAutoscalerPeer("10.0.0.1:8333")))
+
+			var mu sync.Mutex
+			var dialed []string
+			dial := func(_ context.Context, address string) (p2p.PeerI, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				dialed = append(dialed, address)
+				if err := tc.dialErrs[address]; err != nil {
+					return nil, err
+				}
+				return newAutoscalerPeer(address), nil
+			}
+
+			meter := &p2p.ThroughputMeter{}
+			opts := append([]func(*p2p.PeerAutoscaler){
+				p2p.WithThroughputTarget(10, 0),
+				p2p.WithAutoscalingInterval(time.Second),
+			}, tc.opts...)
+
+			sut, err := p2p.NewPeerAutoscaler(slog.Default(), manager, meter, dial, opts...)
+			require.NoError(t, err)
+
+			// when
+			meter.Add(tc.throughput)
+			sut.Start()
+			time.Sleep(1500 * time.Millisecond)
+			manager.Shutdown()
+
+			// then
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tc.expectedDialed, dialed)
+			require.Equal(t, tc.expectedAdditional, sut.AdditionalPeers())
+			require.Len(t, manager.GetPeers(), 1+tc.expectedAdditional)
+		})
+	}
+}
+
+func TestPeerAutoscaler_ScaleDown(t *testing.T) {
+	// given
+	manager := p2p.NewPeerManager(slog.Default(), peerManagerNetwork)
+	require.NoError(t, manager.AddPeer(newAutoscalerPeer("10.0.0.1:8333")))
+
+	additional := newAutoscalerPeer("10.0.0.2:8333")
+	meter := &p2p.ThroughputMeter{}
+	sut, err := p2p.NewPeerAutoscaler(slog.Default(), manager, meter,
+		func(_ context.Context, _ string) (p2p.PeerI, error) { return additional, nil },
+		p2p.WithAutoscalingCandidates("10.0.0.2:8333"),
+		p2p.WithThroughputTarget(10, 0),
+		p2p.WithAutoscalingInterval(500*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	// when
+	meter.Add(1)
+	sut.Start()
+	require.Eventually(t, func() bool { return sut.AdditionalPeers() == 1 }, 2*time.Second, 10*time.Millisecond)
+	require.Eventually(t, func() bool { return sut.AdditionalPeers() == 0 }, 2*time.Second, 10*time.Millisecond)
+
+	// then
+	require.Len(t, additional.ShutdownCalls(), 1)
+	require.Len(t, manager.GetPeers(), 1)
+	require.Equal(t, "10.0.0.1:8333", manager.GetPeers()[0].String())
+	manager.Shutdown()
+}
+
+func TestNewPeerAutoscaler(t *testing.T) {
+	tt := []struct {
+		name string
+		opts []func(*p2p.PeerAutoscaler)
+
+		expectedErr error
+	}{
+		{
+			name: "no candidates",
+			opts: []func(*p2p.PeerAutoscaler){p2p.WithThroughputTarget(10, 0)},
+
+			expectedErr: p2p.ErrNoCandidates,
+		},
+		{
+			name: "dns seeds without trusted networks",
+			opts: []func(*p2p.PeerAutoscaler){
+				p2p.WithAutoscalingDNSSeeds([]string{"seed.example.com"}, 8333, nil),
+				p2p.WithThroughputTarget(10, 0),
+			},
+
+			expectedErr: p2p.ErrNoTrustedNetworks,
+		},
+		{
+			name: "invalid trusted network",
+			opts: []func(*p2p.PeerAutoscaler){
+				p2p.WithAutoscalingDNSSeeds([]string{"seed.example.com"}, 8333, []string{"192.0.2.0/33"}),
+				p2p.WithThroughputTarget(10, 0),
+			},
+
+			expectedErr: p2p.ErrInvalidTrustedNetwork,
+		},
+		{
+			name: "target not above idle throughput",
+			opts: []func(*p2p.PeerAutoscaler){
+				p2p.WithAutoscalingCandidates("10.0.0.2:8333"),
+				p2p.WithThroughputTarget(10, 10),
+			},
+
+			expectedErr: p2p.ErrInvalidTarget,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := p2p.NewPeerAutoscaler(slog.Default(), p2p.NewPeerManager(slog.Default(), peerManagerNetwork), &p2p.ThroughputMeter{}, nil, tc.opts...)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestTxAnnouncements(t *testing.T) {
+	// given
+	inv := wire.NewMsgInv()
+	require.NoError(t, inv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &chainhash.Hash{1})))
+	require.NoError(t, inv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &chainhash.Hash{2})))
+	require.NoError(t, inv.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, &chainhash.Hash{3})))
+
+	// when
+	actual := p2p.TxAnnouncements(inv)
+
+	// then
+	require.Equal(t, uint64(2), actual)
+	require.Equal(t, uint64(0), p2p.TxAnnouncements(wire.NewMsgPing(1)))
+}
@@ -52,12 +52,73 @@ func WithServiceFlag(flag wire.ServiceFlag) PeerOptions {
 	}
 }
 
+// WithProtocolVersion sets the protocol version announced to the node, which is negotiated down to the version of the
+// node if the node speaks an older protocol. Versions lower than ExtendedMessagesVersion disable extended messages.
+func WithProtocolVersion(version uint32) PeerOptions {
+	return func(p *Peer) {
+		p.protocolVersion = version
+	}
+}
+
+// WithMinimumProtocolVersion fails the handshake with nodes announcing a lower protocol version.
+func WithMinimumProtocolVersion(version uint32) PeerOptions {
+	return func(p *Peer) {
+		p.minProtocolVer = version
+	}
+}
+
+// WithRequiredServices fails the handshake with nodes which do not provide all the given services.
+func WithRequiredServices(services wire.ServiceFlag) PeerOptions {
+	return func(p *Peer) {
+		p.requiredServices = services
+	}
+}
+
 func WithDialer(dial Dialer) PeerOptions {
 	return func(p *Peer) {
 		p.dialer = dial
 	}
 }
 
+// WithProxy connects to the node through the proxy dialer, e.g. created by NewSOCKS5Dialer. The address of the node is
+// not resolved locally.
+func WithProxy(dial Dialer) PeerOptions {
+	return func(p *Peer) {
+		p.dialer = dial
+		p.proxied = true
+	}
+}
+
+// WithBanManager registers the peer at the ban manager. Invalid messages received from the peer increase its ban
+// score and the peer does not connect while it is banned.
+func WithBanManager(b *BanManager) PeerOptions {
+	return func(p *Peer) {
+		p.banManager = b
+	}
+}
+
+// WithMessageMetrics counts the messages and bytes sent and received per message type.
+func WithMessageMetrics(m *MessageMetrics) PeerOptions {
+	return func(p *Peer) {
+		p.metrics = m
+	}
+}
+
+// WithMessageDumper writes hex dumps of the messages sent and received if the dumper is enabled for the peer.
+func WithMessageDumper(d *MessageDumper) PeerOptions {
+	return func(p *Peer) {
+		p.dumper = d
+	}
+}
+
+// WithSendHeaders sends a sendheaders message after the handshake, so that the node announces new blocks with a headers
+// message instead of an inv message.
+func WithSendHeaders() PeerOptions {
+	return func(p *Peer) {
+		p.sendHeaders = true
+	}
+}
+
 func WithConnectionTimeout(d time.Duration) PeerOptions {
 	return func(p *Peer) {
 		p.connectionTimeout = d
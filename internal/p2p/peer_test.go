@@ -1,12 +1,15 @@
 package p2p_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"net"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -15,6 +18,7 @@ import (
 	"github.com/cbeuw/connutil"
 	"github.com/libsv/go-p2p/chaincfg/chainhash"
 	"github.com/libsv/go-p2p/wire"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/p2p"
@@ -95,6 +99,168 @@ func Test_Connect(t *testing.T) {
 		require.True(t, result, "Peer connection failed")
 		require.True(t, connected, "Peer.Connected() returned `false` after successful connection to peer")
 	})
+
+	t.Run("Connect banned peer", func(t *testing.T) {
+		// given
+		banManager, err := p2p.NewBanManager(slog.Default())
+		require.NoError(t, err)
+		err = banManager.Ban(peerAddr, time.Hour)
+		require.NoError(t, err)
+
+		dialer := &mocks.DialerMock{}
+		sut := p2p.NewPeer(slog.Default(), &mocks.MessageHandlerIMock{}, peerAddr, bitcoinNet,
+			p2p.WithDialer(dialer),
+			p2p.WithBanManager(banManager),
+		)
+
+		// when
+		result := sut.Connect()
+
+		// then
+		require.False(t, result, "Banned peer shouldn't connect")
+		require.Empty(t, dialer.DialContextCalls())
+	})
+
+	t.Run("Connect - messages are counted and dumped", func(t *testing.T) {
+		// given
+		metrics := p2p.NewMessageMetrics()
+		dumpFile := filepath.Join(t.TempDir(), "dump.log")
+		dumper, err := p2p.NewMessageDumper(dumpFile, []string{peerAddr})
+		require.NoError(t, err)
+		defer dumper.Close()
+
+		sut, _, fromPeerConn := peerWithConn(t, &mocks.MessageHandlerIMock{}, p2p.WithMessageMetrics(metrics), p2p.WithMessageDumper(dumper))
+
+		// when
+		connectPeer(t, sut, fromPeerConn)
+
+		// then
+		require.Equal(t, 4, testutil.CollectAndCount(metrics, "arc_p2p_messages_total"))
+
+		dump, err := os.ReadFile(dumpFile)
+		require.NoError(t, err)
+		require.Contains(t, string(dump), "peer=localhost:1234 direction=sent command=version")
+		require.Contains(t, string(dump), "peer=localhost:1234 direction=sent command=verack")
+		require.Contains(t, string(dump), "peer=localhost:1234 direction=received command=version")
+		require.Contains(t, string(dump), "peer=localhost:1234 direction=received command=verack")
+	})
+
+	t.Run("Connect - headers announcements are requested", func(t *testing.T) {
+		// given
+		mhMq := &mocks.MessageHandlerIMock{OnSendFunc: func(_ wire.Message, _ p2p.PeerI) {}}
+
+		// when
+		_, _, fromPeerConn := connectedPeer(t, mhMq, p2p.WithSendHeaders())
+
+		// then
+		msg, _, err := wire.ReadMessage(fromPeerConn, wire.ProtocolVersion, bitcoinNet)
+		require.NoError(t, err)
+		require.Equal(t, wire.CmdSendHeaders, msg.Command())
+	})
+}
+
+func Test_ProtocolNegotiation(t *testing.T) {
+	tt := []struct {
+		name         string
+		opts         []p2p.PeerOptions
+		nodeVersion  int32
+		nodeServices wire.ServiceFlag
+
+		expectedConnected        bool
+		expectedAnnouncedVersion int32
+		expectedVersion          uint32
+		expectedExtendedMessages bool
+		expectedNewerProtocol    bool
+	}{
+		{
+			name:         "same protocol version",
+			nodeVersion:  int32(wire.ProtocolVersion),
+			nodeServices: wire.SFNodeNetwork,
+
+			expectedConnected:        true,
+			expectedAnnouncedVersion: int32(wire.ProtocolVersion),
+			expectedVersion:          wire.ProtocolVersion,
+			expectedExtendedMessages: true,
+		},
+		{
+			name:         "newer protocol version of node - falls back to supported version",
+			nodeVersion:  int32(wire.ProtocolVersion) + 1,
+			nodeServices: wire.SFNodeNetwork | 1<<24,
+
+			expectedConnected:        true,
+			expectedAnnouncedVersion: int32(wire.ProtocolVersion),
+			expectedVersion:          wire.ProtocolVersion,
+			expectedExtendedMessages: true,
+			expectedNewerProtocol:    true,
+		},
+		{
+			name:         "older protocol version of node - no extended messages",
+			nodeVersion:  70015,
+			nodeServices: wire.SFNodeNetwork,
+
+			expectedConnected:        true,
+			expectedAnnouncedVersion: int32(wire.ProtocolVersion),
+			expectedVersion:          70015,
+		},
+		{
+			name:         "extended messages disabled",
+			opts:         []p2p.PeerOptions{p2p.WithProtocolVersion(70015)},
+			nodeVersion:  int32(wire.ProtocolVersion),
+			nodeServices: wire.SFNodeNetwork,
+
+			expectedConnected:        true,
+			expectedAnnouncedVersion: 70015,
+			expectedVersion:          70015,
+		},
+		{
+			name:         "protocol version of node below minimum",
+			opts:         []p2p.PeerOptions{p2p.WithMinimumProtocolVersion(wire.ProtocolVersion)},
+			nodeVersion:  70015,
+			nodeServices: wire.SFNodeNetwork,
+
+			expectedAnnouncedVersion: int32(wire.ProtocolVersion),
+		},
+		{
+			name:         "required services missing",
+			opts:         []p2p.PeerOptions{p2p.WithRequiredServices(wire.SFNodeNetwork | wire.SFNodeBloom)},
+			nodeVersion:  int32(wire.ProtocolVersion),
+			nodeServices: wire.SFNodeNetwork,
+
+			expectedAnnouncedVersion: int32(wire.ProtocolVersion),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			mhMq := &mocks.MessageHandlerIMock{OnSendFunc: func(_ wire.Message, _ p2p.PeerI) {}}
+			sut, _, fromPeerConn := peerWithConn(t, mhMq, tc.opts...)
+			defer sut.Shutdown()
+
+			announcedVersion := make(chan int32, 1)
+			go func() {
+				announcedVersion <- nodeHandshake(fromPeerConn, tc.nodeVersion, tc.nodeServices)
+			}()
+
+			// when
+			connected := sut.Connect()
+
+			// then
+			require.Equal(t, tc.expectedConnected, connected)
+			require.Equal(t, tc.expectedAnnouncedVersion, <-announcedVersion)
+
+			features, negotiated := sut.Features()
+			if !tc.expectedConnected {
+				return
+			}
+
+			require.True(t, negotiated)
+			require.Equal(t, tc.expectedVersion, features.ProtocolVersion)
+			require.Equal(t, tc.expectedExtendedMessages, features.ExtendedMessages)
+			require.Equal(t, tc.expectedNewerProtocol, features.NewerProtocol())
+			require.Equal(t, tc.nodeServices, features.Services)
+		})
+	}
 }
 
 func Test_Shutdown(t *testing.T) {
@@ -282,6 +448,45 @@ func Test_WriteMsg(t *testing.T) {
 	}
 }
 
+func Test_WriteSharedMsg(t *testing.T) {
+	// given
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, []byte{0x51}))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+
+	var payload bytes.Buffer
+	require.NoError(t, tx.Serialize(&payload))
+
+	sent := make(chan wire.Message, 1)
+	mhMq := &mocks.MessageHandlerIMock{
+		OnSendFunc: func(msg wire.Message, _ p2p.PeerI) { sent <- msg },
+	}
+
+	sut, _, fromPeerConn := connectedPeer(t, mhMq)
+
+	shared, err := p2p.NewSharedMessage(p2p.NewBufferPool(), wire.CmdTx, tx.TxHash(), payload.Bytes(), bitcoinNet)
+	require.NoError(t, err)
+
+	// when
+	sut.WriteMsg(shared)
+
+	// read msg as node
+	readMsg, _, readErr := wire.ReadMessage(fromPeerConn, wire.ProtocolVersion, bitcoinNet)
+
+	// then
+	require.NoError(t, readErr)
+	readTx, ok := readMsg.(*wire.MsgTx)
+	require.True(t, ok)
+	require.Equal(t, tx.TxHash(), readTx.TxHash())
+
+	select {
+	case msg := <-sent:
+		require.Same(t, shared, msg)
+	case <-time.After(time.Second):
+		t.Fatal("OnSend not called")
+	}
+}
+
 func Test_listenMessages(t *testing.T) {
 	tt := []struct {
 		cmdType string
@@ -353,6 +558,28 @@ func Test_ErrorOnRead(t *testing.T) {
 		require.False(t, sut.Connected(), "Peer didn't disconnect on error on reading message")
 		require.True(t, sutUnhealthy.Load(), "Peer didn't signal it's unhealthy on error on reading message")
 	})
+
+	t.Run("Invalid message from node - should increase ban score", func(t *testing.T) {
+		// given
+		banManager, err := p2p.NewBanManager(slog.Default())
+		require.NoError(t, err)
+
+		mhMq := &mocks.MessageHandlerIMock{OnSendFunc: func(_ wire.Message, _ p2p.PeerI) {}}
+		sut, _, fromPeerConn := connectedPeer(t, mhMq, p2p.WithBanManager(banManager))
+
+		invalidPayload := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25}
+
+		// when
+		_, writeErr := fromPeerConn.Write(invalidPayload)
+
+		// then
+		// give peer time to consume msg from node
+		time.Sleep(100 * time.Millisecond)
+
+		require.NoError(t, writeErr)
+		require.False(t, sut.Connected(), "Peer didn't disconnect on invalid message")
+		require.Positive(t, banManager.Score(peerAddr))
+	})
 }
 
 func Test_ErrorOnWrite(t *testing.T) {
@@ -486,6 +713,39 @@ func testHandshake(t *testing.T, conn net.Conn) (ok bool) {
 	return true
 }
 
+// nodeHandshake performs the handshake of a node announcing the given protocol version and services and returns the
+// protocol version announced by the peer.
+func nodeHandshake(conn net.Conn, version int32, services wire.ServiceFlag) int32 {
+	msg, _, err := wire.ReadMessage(conn, wire.ProtocolVersion, bitcoinNet)
+	if err != nil {
+		return 0
+	}
+	verMsg, ok := msg.(*wire.MsgVersion)
+	if !ok {
+		return 0
+	}
+	announcedVersion := verMsg.ProtocolVersion
+
+	err = wire.WriteMessage(conn, wire.NewMsgVerAck(), wire.ProtocolVersion, bitcoinNet)
+	if err != nil {
+		return announcedVersion
+	}
+
+	nAddr, _ := net.ResolveTCPAddr("tcp", "localhost:8876")
+	nodeVerMsg := wire.NewMsgVersion(wire.NewNetAddress(&net.TCPAddr{IP: nil, Port: 0}, services), wire.NewNetAddress(nAddr, wire.SFNodeNetwork), 1, 0)
+	nodeVerMsg.ProtocolVersion = version
+	nodeVerMsg.Services = services
+	err = wire.WriteMessage(conn, nodeVerMsg, wire.ProtocolVersion, bitcoinNet)
+	if err != nil {
+		return announcedVersion
+	}
+
+	// read VERACK, if the peer accepts the version
+	_, _, _ = wire.ReadMessage(conn, wire.ProtocolVersion, bitcoinNet)
+
+	return announcedVersion
+}
+
 func pong(t *testing.T, conn net.Conn) {
 	t.Helper()
 
@@ -0,0 +1,79 @@
+package p2p
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ccoveille/go-safecast"
+	"github.com/libsv/go-p2p/wire"
+)
+
+const (
+	// ExtendedMessagesVersion is the protocol version which added extended messages with payloads larger than 4GB
+	ExtendedMessagesVersion uint32 = 70016
+
+	// knownServices are the service bits ARC understands, other bits are announced by newer nodes
+	knownServices = wire.SFNodeNetwork | wire.SFNodeGetUTXO | wire.SFNodeBloom | wire.SFNodeWitness | wire.SFNodeXthin |
+		wire.SFNodeBitcoinCash | wire.SFNodeGraphene | wire.SFNodeWeakBlocks | wire.SFNodeCF | wire.SFNodeXThinner |
+		wire.SFNodeNetworkLimited
+)
+
+var (
+	ErrProtocolVersionTooLow   = errors.New("protocol version of peer is lower than the minimum protocol version")
+	ErrRequiredServicesMissing = errors.New("peer does not provide the required services")
+)
+
+// PeerFeatures are the features negotiated with a peer in the handshake.
+type PeerFeatures struct {
+	// ProtocolVersion is the protocol version used for the messages exchanged with the peer, the lower of the version
+	// announced by the peer and the version announced by ARC
+	ProtocolVersion uint32
+	// PeerProtocolVersion is the protocol version announced by the peer
+	PeerProtocolVersion uint32
+	Services            wire.ServiceFlag
+	UserAgent           string
+	// ExtendedMessages is true if messages with payloads larger than 4GB can be exchanged with the peer
+	ExtendedMessages bool
+	// UnknownServices are the service bits announced by the peer which ARC does not understand
+	UnknownServices wire.ServiceFlag
+}
+
+// HasService returns true if the peer provides the given services.
+func (f PeerFeatures) HasService(service wire.ServiceFlag) bool {
+	return f.Services&service == service
+}
+
+// NewerProtocol returns true if the peer speaks a newer protocol than ARC understands.
+func (f PeerFeatures) NewerProtocol() bool {
+	return f.PeerProtocolVersion > wire.ProtocolVersion
+}
+
+// negotiateFeatures determines the features of the connection from the VER message of the peer. Newer protocol
+// versions of the peer are negotiated down to the protocol version of ARC, lower versions than the given minimum
+// version and missing required services fail the negotiation.
+func negotiateFeatures(msg *wire.MsgVersion, protocolVersion uint32, minimumVersion uint32, requiredServices wire.ServiceFlag) (PeerFeatures, error) {
+	// a negative protocol version is treated as the lowest version
+	peerVersion, err := safecast.ToUint32(msg.ProtocolVersion)
+	if err != nil {
+		peerVersion = 0
+	}
+
+	features := PeerFeatures{
+		ProtocolVersion:     min(peerVersion, protocolVersion),
+		PeerProtocolVersion: peerVersion,
+		Services:            msg.Services,
+		UserAgent:           msg.UserAgent,
+		UnknownServices:     msg.Services &^ knownServices,
+	}
+	features.ExtendedMessages = features.ProtocolVersion >= ExtendedMessagesVersion
+
+	if peerVersion < minimumVersion {
+		return features, errors.Join(ErrProtocolVersionTooLow, fmt.Errorf("version: %d, minimum version: %d", peerVersion, minimumVersion))
+	}
+
+	if !features.HasService(requiredServices) {
+		return features, errors.Join(ErrRequiredServicesMissing, fmt.Errorf("services: %s, required services: %s", msg.Services, requiredServices))
+	}
+
+	return features, nil
+}
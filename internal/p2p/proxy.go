@@ -0,0 +1,54 @@
+package p2p
+
+import (
+	"errors"
+	"net"
+	"strconv"
+
+	"github.com/libsv/go-p2p/wire"
+	"golang.org/x/net/proxy"
+)
+
+var ErrProxyDialerNotSupported = errors.New("proxy dialer does not support dialing with context")
+
+// NewSOCKS5Dialer creates a dialer which connects through the SOCKS5 proxy, e.g. Tor on `127.0.0.1:9050`. The proxy
+// resolves the addresses of the nodes, so that nodes can be reached by their onion addresses.
+func NewSOCKS5Dialer(proxyAddress string) (Dialer, error) {
+	d, err := proxy.SOCKS5("tcp", proxyAddress, nil, proxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxDialer, ok := d.(proxy.ContextDialer)
+	if !ok {
+		return nil, ErrProxyDialerNotSupported
+	}
+
+	return ctxDialer, nil
+}
+
+// remoteNetAddress returns the address of the node for the VER message. IPv4 and IPv6 addresses are used as they are.
+// Host names are resolved unless the peer connects through a proxy, in which case the host name must not be resolved
+// locally, e.g. onion addresses, and the unspecified IP is sent instead.
+func (p *Peer) remoteNetAddress() *wire.NetAddress {
+	host, portStr, err := net.SplitHostPort(p.address)
+	if err != nil {
+		return wire.NewNetAddressIPPort(net.IPv4zero, 0, wire.SFNodeNetwork)
+	}
+
+	port, _ := strconv.ParseUint(portStr, 10, 16)
+
+	ip := net.ParseIP(host)
+	if ip == nil && !p.proxied {
+		tcpAddr, err := net.ResolveTCPAddr("tcp", p.address)
+		if err == nil {
+			ip = tcpAddr.IP
+		}
+	}
+
+	if ip == nil {
+		ip = net.IPv4zero
+	}
+
+	return wire.NewNetAddressIPPort(ip, uint16(port), wire.SFNodeNetwork)
+}
@@ -0,0 +1,127 @@
+package p2p_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cbeuw/connutil"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+	"github.com/bitcoin-sv/arc/internal/p2p/mocks"
+)
+
+func TestNewSOCKS5Dialer(t *testing.T) {
+	t.Run("dial through proxy", func(t *testing.T) {
+		// given
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		defer listener.Close()
+
+		greetingCh := make(chan []byte, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+
+			// SOCKS5 greeting: version, number of auth methods, no authentication
+			greeting := make([]byte, 3)
+			_, _ = io.ReadFull(conn, greeting)
+			greetingCh <- greeting
+		}()
+
+		sut, err := p2p.NewSOCKS5Dialer(listener.Addr().String())
+		require.NoError(t, err)
+
+		// when
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		_, err = sut.DialContext(ctx, "tcp", "abcdefghijklmnop.onion:8333")
+
+		// then
+		require.Error(t, err)
+		select {
+		case greeting := <-greetingCh:
+			assert.Equal(t, []byte{0x05, 0x01, 0x00}, greeting)
+		case <-time.After(time.Second):
+			t.Fatal("proxy was not dialed")
+		}
+	})
+}
+
+func Test_Connect_RemoteAddress(t *testing.T) {
+	tt := []struct {
+		name    string
+		address string
+		proxied bool
+
+		expectedIP   net.IP
+		expectedPort uint16
+	}{
+		{
+			name:    "IPv4",
+			address: "127.0.0.1:18333",
+
+			expectedIP:   net.ParseIP("127.0.0.1"),
+			expectedPort: 18333,
+		},
+		{
+			name:    "IPv6",
+			address: "[2001:db8::1]:18333",
+
+			expectedIP:   net.ParseIP("2001:db8::1"),
+			expectedPort: 18333,
+		},
+		{
+			name:    "onion address through proxy",
+			address: "abcdefghijklmnop.onion:8333",
+			proxied: true,
+
+			expectedIP:   net.IPv4zero,
+			expectedPort: 8333,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			toPeerConn, fromPeerConn := connutil.AsyncPipe()
+			mhMq := &mocks.MessageHandlerIMock{OnSendFunc: func(_ wire.Message, _ p2p.PeerI) {}}
+
+			dialer := &mocks.DialerMock{
+				DialContextFunc: func(_ context.Context, _ string, _ string) (net.Conn, error) {
+					return toPeerConn, nil
+				},
+			}
+
+			dialerOpt := p2p.WithDialer(dialer)
+			if tc.proxied {
+				dialerOpt = p2p.WithProxy(dialer)
+			}
+
+			sut := p2p.NewPeer(slog.Default(), mhMq, tc.address, bitcoinNet, dialerOpt)
+			// closing the connection makes the handshake fail and Connect return
+			defer fromPeerConn.Close()
+
+			// when
+			go sut.Connect()
+
+			msg, _, err := wire.ReadMessage(fromPeerConn, wire.ProtocolVersion, bitcoinNet)
+
+			// then
+			require.NoError(t, err)
+			verMsg, ok := msg.(*wire.MsgVersion)
+			require.True(t, ok)
+			assert.True(t, tc.expectedIP.Equal(verMsg.AddrYou.IP))
+			assert.Equal(t, tc.expectedPort, verMsg.AddrYou.Port)
+		})
+	}
+}
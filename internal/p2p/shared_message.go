@@ -0,0 +1,165 @@
+package p2p
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/bits"
+	"sync"
+	"sync/atomic"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+)
+
+const (
+	// minPooledSizeBits and maxPooledSizeBits are the sizes of the smallest and the largest pooled buffers, 1 KiB and
+	// 64 MiB, larger buffers are allocated for each message
+	minPooledSizeBits = 10
+	maxPooledSizeBits = 26
+)
+
+var (
+	ErrSharedMessageDecode = errors.New("shared messages cannot be decoded")
+	ErrSharedMessageCmd    = errors.New("command of shared message too long")
+)
+
+// BufferPool pools the buffers of the shared messages in size classes of powers of two.
+type BufferPool struct {
+	classes [maxPooledSizeBits - minPooledSizeBits + 1]sync.Pool
+}
+
+func NewBufferPool() *BufferPool {
+	return &BufferPool{}
+}
+
+func (bp *BufferPool) get(size int) []byte {
+	class, pooled := sizeClass(size)
+	if !pooled {
+		return make([]byte, size)
+	}
+
+	buf, ok := bp.classes[class].Get().(*[]byte)
+	if !ok {
+		return make([]byte, size, 1<<(class+minPooledSizeBits))
+	}
+
+	return (*buf)[:size]
+}
+
+func (bp *BufferPool) put(buf []byte) {
+	class, pooled := sizeClass(cap(buf))
+	// only buffers allocated by the pool have the capacity of their size class
+	if !pooled || cap(buf) != 1<<(class+minPooledSizeBits) {
+		return
+	}
+
+	bp.classes[class].Put(&buf)
+}
+
+func sizeClass(size int) (int, bool) {
+	sizeBits := minPooledSizeBits
+	if size > 1<<minPooledSizeBits {
+		sizeBits = bits.Len(uint(size - 1)) // #nosec G115
+	}
+
+	if sizeBits > maxPooledSizeBits {
+		return 0, false
+	}
+
+	return sizeBits - minPooledSizeBits, true
+}
+
+// SharedMessage is an immutable message encoded once for a network, so that a single copy of it, e.g. of a large
+// transaction, is written by the write handlers of all peers it is sent to. The message is reference counted, every
+// owner of a reference, e.g. a peer it is written to, has to release it. Its buffer is returned to the pool when the
+// last reference is released.
+type SharedMessage struct {
+	command string
+	hash    chainhash.Hash
+	network wire.BitcoinNet
+	// raw contains the header and the payload of the message
+	raw  []byte
+	refs atomic.Int32
+	pool *BufferPool
+}
+
+// NewSharedMessage copies the payload with the given command into a buffer of the pool. The returned message has one
+// reference owned by the caller.
+func NewSharedMessage(pool *BufferPool, command string, hash chainhash.Hash, payload []byte, network wire.BitcoinNet) (*SharedMessage, error) {
+	if len(command) > wire.CommandSize {
+		return nil, ErrSharedMessageCmd
+	}
+
+	raw := pool.get(wire.MessageHeaderSize + len(payload))
+
+	// header: magic, command, length and checksum of the payload
+	binary.LittleEndian.PutUint32(raw[0:4], uint32(network))
+	clear(raw[4 : 4+wire.CommandSize])
+	copy(raw[4:], command)
+	binary.LittleEndian.PutUint32(raw[16:20], uint32(len(payload))) // #nosec G115
+	checksum := chainhash.DoubleHashB(payload)
+	copy(raw[20:24], checksum[:4])
+	copy(raw[wire.MessageHeaderSize:], payload)
+
+	m := &SharedMessage{
+		command: command,
+		hash:    hash,
+		network: network,
+		raw:     raw,
+		pool:    pool,
+	}
+	m.refs.Store(1)
+
+	return m, nil
+}
+
+// Retain adds a reference to the message.
+func (m *SharedMessage) Retain() *SharedMessage {
+	m.refs.Add(1)
+	return m
+}
+
+// Release releases a reference to the message. The message must not be used by the owner of the reference anymore.
+func (m *SharedMessage) Release() {
+	if m.refs.Add(-1) == 0 {
+		raw := m.raw
+		m.raw = nil
+		m.pool.put(raw)
+	}
+}
+
+// Hash returns the hash of the inventory carried by the message, e.g. of the transaction.
+func (m *SharedMessage) Hash() chainhash.Hash {
+	return m.hash
+}
+
+// Payload returns the payload of the message, it must not be modified.
+func (m *SharedMessage) Payload() []byte {
+	return m.raw[wire.MessageHeaderSize:]
+}
+
+func (m *SharedMessage) Command() string {
+	return m.command
+}
+
+func (m *SharedMessage) MaxPayloadLength(_ uint32) uint64 {
+	return uint64(len(m.raw) - wire.MessageHeaderSize) // #nosec G115
+}
+
+// BsvEncode writes the payload, it is used by writers which do not write the shared message as is, e.g. to peers of
+// another network.
+func (m *SharedMessage) BsvEncode(w io.Writer, _ uint32, _ wire.MessageEncoding) error {
+	_, err := w.Write(m.Payload())
+	return err
+}
+
+func (m *SharedMessage) Bsvdecode(_ io.Reader, _ uint32, _ wire.MessageEncoding) error {
+	return ErrSharedMessageDecode
+}
+
+// writeTo writes the encoded message in a single write so that it doesn't interleave with the messages of other
+// write handlers of the connection.
+func (m *SharedMessage) writeTo(w io.Writer) (int, error) {
+	return w.Write(m.raw)
+}
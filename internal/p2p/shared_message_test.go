@@ -0,0 +1,74 @@
+package p2p_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+func TestNewSharedMessage(t *testing.T) {
+	tt := []struct {
+		name       string
+		scriptSize int
+		command    string
+
+		expectedErr error
+	}{
+		{
+			name:       "small tx",
+			scriptSize: 10,
+			command:    wire.CmdTx,
+		},
+		{
+			name:       "large tx",
+			scriptSize: 1024 * 1024,
+			command:    wire.CmdTx,
+		},
+		{
+			name:    "command too long",
+			command: "transactions1",
+
+			expectedErr: p2p.ErrSharedMessageCmd,
+		},
+	}
+
+	pool := p2p.NewBufferPool()
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			tx := wire.NewMsgTx(1)
+			tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, []byte{0x51}))
+			tx.AddTxOut(wire.NewTxOut(1000, bytes.Repeat([]byte{0x51}, tc.scriptSize)))
+
+			var payload bytes.Buffer
+			require.NoError(t, tx.Serialize(&payload))
+
+			// when
+			sut, err := p2p.NewSharedMessage(pool, tc.command, tx.TxHash(), payload.Bytes(), wire.TestNet)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			defer sut.Release()
+
+			assert.Equal(t, tx.TxHash(), sut.Hash())
+			assert.Equal(t, payload.Bytes(), sut.Payload())
+
+			var expected, actual bytes.Buffer
+			require.NoError(t, wire.WriteMessage(&expected, tx, wire.ProtocolVersion, wire.TestNet))
+			require.NoError(t, wire.WriteMessage(&actual, sut.Retain(), wire.ProtocolVersion, wire.TestNet))
+			sut.Release()
+			assert.Equal(t, expected.Bytes(), actual.Bytes())
+		})
+	}
+}
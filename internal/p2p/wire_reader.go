@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"io"
 	"strings"
@@ -13,6 +14,11 @@ type WireReader struct {
 	bufio.Reader
 	limitedReader *io.LimitedReader
 	maxMsgSize    int64
+
+	// observe is called for each message read, including the ignored and invalid messages
+	observe func(n int, msg wire.Message, raw []byte, err error)
+	// raw captures the raw bytes of each message read if set
+	raw *rawCapture
 }
 
 func NewWireReader(r io.Reader, maxMsgSize int64) *WireReader {
@@ -53,6 +59,49 @@ func (r *WireReader) resetLimit() {
 	r.limitedReader.N = r.maxMsgSize
 }
 
+// maxRawCaptureSize limits the raw bytes captured per message, so that large messages, e.g. blocks, are not held in
+// memory.
+const maxRawCaptureSize = 1024 * 1024
+
+// rawCapture captures the first maxRawCaptureSize bytes written to it.
+type rawCapture struct {
+	bytes.Buffer
+}
+
+func (c *rawCapture) Write(p []byte) (int, error) {
+	room := maxRawCaptureSize - c.Len()
+	if room > 0 {
+		_, _ = c.Buffer.Write(p[:min(len(p), room)])
+	}
+
+	return len(p), nil
+}
+
+// readMessageN reads the next message and returns the number of bytes read. If raw is set, it captures the raw bytes
+// of the message.
+func readMessageN(r io.Reader, pver uint32, bsvnet wire.BitcoinNet, raw *rawCapture) (int, wire.Message, error) {
+	if raw != nil {
+		raw.Reset()
+		r = io.TeeReader(r, raw)
+	}
+
+	n, msg, _, err := wire.ReadMessageN(r, pver, bsvnet)
+
+	return n, msg, err
+}
+
+func isUnknownCommandErr(err error) bool {
+	return strings.Contains(err.Error(), "unhandled command [")
+}
+
+func rawBytes(raw *rawCapture) []byte {
+	if raw == nil {
+		return nil
+	}
+
+	return raw.Bytes()
+}
+
 type readResult struct {
 	msg wire.Message
 	err error
@@ -60,11 +109,15 @@ type readResult struct {
 
 func handleRead(r *WireReader, pver uint32, bsvnet wire.BitcoinNet, result chan<- readResult) {
 	for {
-		msg, _, err := wire.ReadMessage(r, pver, bsvnet)
+		n, msg, err := readMessageN(r, pver, bsvnet, r.raw)
 		r.resetLimit()
 
+		if r.observe != nil {
+			r.observe(n, msg, rawBytes(r.raw), err)
+		}
+
 		if err != nil {
-			if strings.Contains(err.Error(), "unhandled command [") {
+			if isUnknownCommandErr(err) {
 				// ignore unknown msg
 				continue
 			}
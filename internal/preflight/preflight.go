@@ -0,0 +1,205 @@
+// Package preflight checks the connectivity to the dependencies of an ARC deployment, e.g. the databases, the message
+// queue, the nodes and the block header services, and reports the results with their latencies. The checks are run by
+// deployment pipelines before a cutover, so that missing firewall rules or wrong credentials are found before traffic
+// is sent to the deployment.
+package preflight
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+const checkTimeoutDefault = 5 * time.Second
+
+var (
+	ErrCheckTimedOut  = errors.New("check timed out")
+	ErrChecksFailed   = errors.New("preflight checks failed")
+	ErrUnexpectedCode = errors.New("unexpected status code")
+)
+
+// Check is the check of the connectivity to a dependency. Run returns an error if the dependency cannot be reached.
+type Check struct {
+	// Kind is the kind of the dependency, e.g. database or node-p2p
+	Kind string
+	// Name designates the dependency of its kind, e.g. the service using the database or the address of the node
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Result is the result of a check.
+type Result struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Passed    bool   `json:"passed"`
+	LatencyMs int64  `json:"latencyMs"`
+	Error     string `json:"error,omitempty"`
+}
+
+// Report is the report of all checks.
+type Report struct {
+	Passed    bool      `json:"passed"`
+	CheckedAt time.Time `json:"checkedAt"`
+	Results   []Result  `json:"results"`
+}
+
+type Runner struct {
+	timeout time.Duration
+	now     func() time.Time
+}
+
+type Option func(*Runner)
+
+// WithTimeout sets the time after which a check fails if the dependency did not respond.
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *Runner) {
+		r.timeout = timeout
+	}
+}
+
+func WithNow(nowFunc func() time.Time) Option {
+	return func(r *Runner) {
+		r.now = nowFunc
+	}
+}
+
+func New(opts ...Option) *Runner {
+	r := &Runner{
+		timeout: checkTimeoutDefault,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Run runs the checks concurrently and returns the report with the results in the order of the checks. A check which
+// does not return within the timeout fails, even if it does not respect the cancellation of its context.
+func (r *Runner) Run(ctx context.Context, checks []Check) Report {
+	report := Report{Passed: true, CheckedAt: r.now().UTC(), Results: make([]Result, len(checks))}
+
+	type indexedResult struct {
+		index  int
+		result Result
+	}
+
+	results := make(chan indexedResult, len(checks))
+	for i, check := range checks {
+		go func() {
+			results <- indexedResult{index: i, result: r.run(ctx, check)}
+		}()
+	}
+
+	for range checks {
+		res := <-results
+		report.Results[res.index] = res.result
+		if !res.result.Passed {
+			report.Passed = false
+		}
+	}
+
+	return report
+}
+
+func (r *Runner) run(ctx context.Context, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := r.now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- check.Run(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = errors.Join(ErrCheckTimedOut, ctx.Err())
+	}
+
+	result := Result{
+		Kind:      check.Kind,
+		Name:      check.Name,
+		Passed:    err == nil,
+		LatencyMs: r.now().Sub(start).Milliseconds(),
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// Failed returns the number of failed checks.
+func (r Report) Failed() int {
+	failed := 0
+	for _, result := range r.Results {
+		if !result.Passed {
+			failed++
+		}
+	}
+	return failed
+}
+
+// Write writes the report as indented JSON.
+func (r Report) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// Err returns ErrChecksFailed with the number of failed checks if any check failed.
+func (r Report) Err() error {
+	if r.Passed {
+		return nil
+	}
+	return fmt.Errorf("%w: %d of %d checks failed", ErrChecksFailed, r.Failed(), len(r.Results))
+}
+
+// Dialer dials the TCP connections of the checks, e.g. directly or through a SOCKS5 proxy.
+type Dialer interface {
+	DialContext(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// TCP returns the check whether a TCP connection to the address can be established with the dialer.
+func TCP(dialer Dialer, address string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+}
+
+// HTTP returns the check whether the URL responds to a GET request sent by do. Any response passes unless
+// expectedStatus is set, in which case the status code of the response must be expectedStatus.
+func HTTP(do func(req *http.Request) (*http.Response, error), url string, expectedStatus int) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := do(req)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = resp.Body.Close() }()
+
+		if expectedStatus != 0 && resp.StatusCode != expectedStatus {
+			return fmt.Errorf("%w: %d", ErrUnexpectedCode, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,159 @@
+package preflight_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/preflight"
+)
+
+func TestRunner_Run(t *testing.T) {
+	// given
+	sut := preflight.New(preflight.WithTimeout(50 * time.Millisecond))
+
+	checks := []preflight.Check{
+		{Kind: "database", Name: "metamorph", Run: func(_ context.Context) error { return nil }},
+		{Kind: "cache", Name: "redis:6379", Run: func(_ context.Context) error { return errors.New("connection refused") }},
+		{Kind: "node-rpc", Name: "node:8332", Run: func(_ context.Context) error {
+			// ignores the cancellation of the context
+			time.Sleep(time.Second)
+			return nil
+		}},
+	}
+
+	// when
+	actual := sut.Run(context.Background(), checks)
+
+	// then
+	assert.False(t, actual.Passed)
+	assert.Equal(t, 2, actual.Failed())
+	require.ErrorIs(t, actual.Err(), preflight.ErrChecksFailed)
+
+	require.Len(t, actual.Results, 3)
+	assert.Equal(t, preflight.Result{Kind: "database", Name: "metamorph", Passed: true, LatencyMs: actual.Results[0].LatencyMs}, actual.Results[0])
+	assert.False(t, actual.Results[1].Passed)
+	assert.Equal(t, "connection refused", actual.Results[1].Error)
+	assert.False(t, actual.Results[2].Passed)
+	assert.Contains(t, actual.Results[2].Error, preflight.ErrCheckTimedOut.Error())
+	assert.Less(t, actual.Results[2].LatencyMs, int64(time.Second/time.Millisecond))
+
+	var buf bytes.Buffer
+	require.NoError(t, actual.Write(&buf))
+	var written preflight.Report
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &written))
+	assert.Equal(t, actual.Results, written.Results)
+}
+
+func TestRunner_Run_passed(t *testing.T) {
+	// given
+	sut := preflight.New()
+
+	// when
+	actual := sut.Run(context.Background(), []preflight.Check{{Kind: "database", Name: "blocktx", Run: func(_ context.Context) error { return nil }}})
+
+	// then
+	assert.True(t, actual.Passed)
+	assert.NoError(t, actual.Err())
+}
+
+func TestTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	tt := []struct {
+		name   string
+		listen bool
+
+		expectedErr bool
+	}{
+		{
+			name:   "reachable",
+			listen: true,
+		},
+		{
+			name: "unreachable",
+
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			if tc.listen {
+				l, err := net.Listen("tcp", address)
+				require.NoError(t, err)
+				defer l.Close()
+			}
+
+			// when
+			err := preflight.TCP(&net.Dialer{}, address)(context.Background())
+
+			// then
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	tt := []struct {
+		name           string
+		status         int
+		expectedStatus int
+
+		expectedErr error
+	}{
+		{
+			name:   "any response",
+			status: http.StatusNotFound,
+		},
+		{
+			name:           "expected status",
+			status:         http.StatusOK,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unexpected status",
+			status:         http.StatusUnauthorized,
+			expectedStatus: http.StatusOK,
+
+			expectedErr: preflight.ErrUnexpectedCode,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			// when
+			err := preflight.HTTP(http.DefaultClient.Do, server.URL, tc.expectedStatus)(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package profiler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime/metrics"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/bitcoin-sv/arc/internal/upload"
+)
+
+const (
+	checkIntervalDefault      = 10 * time.Second
+	cpuProfileDurationDefault = 30 * time.Second
+	cooldownDefault           = 10 * time.Minute
+	uploadTimeoutDefault      = 2 * time.Minute
+
+	fileExtension = ".pprof"
+
+	heapMetric         = "/memory/classes/heap/objects:bytes"
+	schedLatencyMetric = "/sched/latencies:seconds"
+
+	// schedLatencyQuantile is the quantile of the goroutine scheduling latency compared against the threshold
+	schedLatencyQuantile = 0.99
+)
+
+var (
+	ErrFailedToCreateDir     = errors.New("failed to create profile directory")
+	ErrFailedToWriteProfile  = errors.New("failed to write profile")
+	ErrFailedToStartProfiler = errors.New("failed to start CPU profiler")
+	ErrFailedToListUploads   = errors.New("failed to list profiles to upload")
+	ErrUploadCommandFailed   = upload.ErrCommandFailed
+	ErrFailedToRemove        = errors.New("failed to remove uploaded profile")
+)
+
+// Profiler periodically checks the heap size and the goroutine scheduling latency of the process. If one of them
+// crosses its threshold, a heap respectively a CPU profile is written to the profile directory. If an upload command
+// is set, the profiles are uploaded to object storage, e.g. with `aws s3 cp {file} s3://bucket/profiles/{name}`, and
+// removed locally after the upload.
+type Profiler struct {
+	logger                *slog.Logger
+	dir                   string
+	checkInterval         time.Duration
+	heapThreshold         uint64
+	schedLatencyThreshold time.Duration
+	cpuProfileDuration    time.Duration
+	cooldown              time.Duration
+	uploadCommand         []string
+	uploadTimeout         time.Duration
+	now                   func() time.Time
+
+	lastCapture    map[string]time.Time
+	latencyCounts  []uint64
+	metricsSamples []metrics.Sample
+
+	cancelAll context.CancelFunc
+	ctx       context.Context
+	waitGroup *sync.WaitGroup
+}
+
+func WithCheckInterval(d time.Duration) func(*Profiler) {
+	return func(p *Profiler) {
+		p.checkInterval = d
+	}
+}
+
+// WithHeapThreshold sets the heap size in bytes above which a heap profile is captured. Zero disables it.
+func WithHeapThreshold(bytes uint64) func(*Profiler) {
+	return func(p *Profiler) {
+		p.heapThreshold = bytes
+	}
+}
+
+// WithSchedLatencyThreshold sets the p99 goroutine scheduling latency above which a CPU profile is captured. Zero disables it.
+func WithSchedLatencyThreshold(d time.Duration) func(*Profiler) {
+	return func(p *Profiler) {
+		p.schedLatencyThreshold = d
+	}
+}
+
+func WithCPUProfileDuration(d time.Duration) func(*Profiler) {
+	return func(p *Profiler) {
+		p.cpuProfileDuration = d
+	}
+}
+
+// WithCooldown sets the minimum duration between two captures of the same profile.
+func WithCooldown(d time.Duration) func(*Profiler) {
+	return func(p *Profiler) {
+		p.cooldown = d
+	}
+}
+
+// WithUploadCommand uploads the profiles with the command, the placeholders {file} and {name} are replaced with the
+// path and the name of the profile.
+func WithUploadCommand(command []string) func(*Profiler) {
+	return func(p *Profiler) {
+		p.uploadCommand = command
+	}
+}
+
+func WithUploadTimeout(d time.Duration) func(*Profiler) {
+	return func(p *Profiler) {
+		p.uploadTimeout = d
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Profiler) {
+	return func(p *Profiler) {
+		p.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, dir string, opts ...func(*Profiler)) *Profiler {
+	p := &Profiler{
+		logger:             logger.With(slog.String("module", "profiler")),
+		dir:                dir,
+		checkInterval:      checkIntervalDefault,
+		cpuProfileDuration: cpuProfileDurationDefault,
+		cooldown:           cooldownDefault,
+		uploadTimeout:      uploadTimeoutDefault,
+		now:                time.Now,
+		lastCapture:        map[string]time.Time{},
+		metricsSamples: []metrics.Sample{
+			{Name: heapMetric},
+			{Name: schedLatencyMetric},
+		},
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	p.ctx, p.cancelAll = context.WithCancel(context.Background())
+
+	return p
+}
+
+func (p *Profiler) Start() error {
+	err := os.MkdirAll(p.dir, 0o755)
+	if err != nil {
+		return errors.Join(ErrFailedToCreateDir, err)
+	}
+
+	p.logger.Info("Starting continuous profiler", slog.String("dir", p.dir), slog.Uint64("heapThreshold", p.heapThreshold), slog.String("schedLatencyThreshold", p.schedLatencyThreshold.String()), slog.Bool("upload", len(p.uploadCommand) != 0))
+
+	p.waitGroup.Add(1)
+	go func() {
+		defer p.waitGroup.Done()
+
+		ticker := time.NewTicker(p.checkInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.ctx.Done():
+				return
+			case <-ticker.C:
+				p.check()
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (p *Profiler) check() {
+	metrics.Read(p.metricsSamples)
+
+	heap := p.metricsSamples[0].Value
+	if p.heapThreshold > 0 && heap.Kind() == metrics.KindUint64 && heap.Uint64() > p.heapThreshold && p.cooledDown("heap") {
+		p.logger.Warn("Heap threshold exceeded - capturing heap profile", slog.Uint64("heap", heap.Uint64()))
+
+		err := p.writeProfile("heap", func(f *os.File) error { return pprof.Lookup("heap").WriteTo(f, 0) })
+		if err != nil {
+			p.logger.Error("Failed to capture heap profile", slog.String("err", err.Error()))
+		}
+		p.uploadProfiles()
+	}
+
+	latency := p.metricsSamples[1].Value
+	if latency.Kind() != metrics.KindFloat64Histogram {
+		return
+	}
+
+	quantile := p.latencyQuantile(latency.Float64Histogram())
+	if p.schedLatencyThreshold > 0 && quantile > p.schedLatencyThreshold && p.cooledDown("cpu") {
+		p.logger.Warn("Scheduling latency threshold exceeded - capturing CPU profile", slog.String("latency", quantile.String()))
+
+		err := p.writeProfile("cpu", p.captureCPU)
+		if err != nil {
+			p.logger.Error("Failed to capture CPU profile", slog.String("err", err.Error()))
+		}
+		p.uploadProfiles()
+	}
+}
+
+// latencyQuantile returns the scheduling latency quantile of the samples recorded since the last check.
+func (p *Profiler) latencyQuantile(h *metrics.Float64Histogram) time.Duration {
+	defer func() {
+		p.latencyCounts = append(p.latencyCounts[:0], h.Counts...)
+	}()
+
+	if len(p.latencyCounts) != len(h.Counts) {
+		return 0
+	}
+
+	var total uint64
+	deltas := make([]uint64, len(h.Counts))
+	for i := range h.Counts {
+		deltas[i] = h.Counts[i] - p.latencyCounts[i]
+		total += deltas[i]
+	}
+
+	if total == 0 {
+		return 0
+	}
+
+	rank := uint64(math.Ceil(float64(total) * schedLatencyQuantile))
+
+	var cumulative uint64
+	for i, count := range deltas {
+		cumulative += count
+		if cumulative < rank {
+			continue
+		}
+
+		// bucket i is [Buckets[i], Buckets[i+1]), use the upper bound unless it is infinite
+		bound := h.Buckets[i+1]
+		if math.IsInf(bound, 1) {
+			bound = h.Buckets[i]
+		}
+
+		return time.Duration(bound * float64(time.Second))
+	}
+
+	return 0
+}
+
+func (p *Profiler) cooledDown(kind string) bool {
+	last, found := p.lastCapture[kind]
+	if found && p.now().Sub(last) < p.cooldown {
+		return false
+	}
+
+	p.lastCapture[kind] = p.now()
+	return true
+}
+
+func (p *Profiler) captureCPU(f *os.File) error {
+	err := pprof.StartCPUProfile(f)
+	if err != nil {
+		return errors.Join(ErrFailedToStartProfiler, err)
+	}
+	defer pprof.StopCPUProfile()
+
+	select {
+	case <-p.ctx.Done():
+	case <-time.After(p.cpuProfileDuration):
+	}
+
+	return nil
+}
+
+func (p *Profiler) writeProfile(kind string, write func(f *os.File) error) error {
+	name := fmt.Sprintf("%s-%s%s", kind, p.now().UTC().Format("20060102T150405Z"), fileExtension)
+
+	f, err := os.Create(filepath.Join(p.dir, name))
+	if err != nil {
+		return errors.Join(ErrFailedToWriteProfile, err)
+	}
+	defer f.Close()
+
+	err = write(f)
+	if err != nil {
+		return errors.Join(ErrFailedToWriteProfile, err)
+	}
+
+	p.logger.Info("Profile written", slog.String("file", f.Name()))
+
+	return nil
+}
+
+func (p *Profiler) uploadProfiles() {
+	err := p.Upload(p.ctx)
+	if err != nil {
+		p.logger.Error("Failed to upload profiles", slog.String("err", err.Error()))
+	}
+}
+
+// Upload uploads all profiles of the profile directory and removes them once they are uploaded, profiles which failed
+// to upload are retried with the next capture. Without upload command the profiles are kept in the directory.
+func (p *Profiler) Upload(ctx context.Context) error {
+	if len(p.uploadCommand) == 0 {
+		return nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(p.dir, "*"+fileExtension))
+	if err != nil {
+		return errors.Join(ErrFailedToListUploads, err)
+	}
+
+	for _, file := range files {
+		err = p.upload(ctx, file)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("file: %s", file))
+		}
+
+		err = os.Remove(file)
+		if err != nil {
+			return errors.Join(ErrFailedToRemove, err)
+		}
+
+		p.logger.Info("Uploaded profile", slog.String("file", filepath.Base(file)))
+	}
+
+	return nil
+}
+
+func (p *Profiler) upload(ctx context.Context, file string) error {
+	return upload.Run(ctx, p.uploadCommand, file, p.uploadTimeout)
+}
+
+// Shutdown stops the checks and makes a last attempt to upload the profiles.
+func (p *Profiler) Shutdown() {
+	p.cancelAll()
+	p.waitGroup.Wait()
+
+	err := p.Upload(context.Background())
+	if err != nil {
+		p.logger.Error("Failed to upload profiles", slog.String("err", err.Error()))
+	}
+}
@@ -0,0 +1,130 @@
+package profiler_test
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/profiler"
+)
+
+func TestProfiler(t *testing.T) {
+	tt := []struct {
+		name          string
+		heapThreshold uint64
+
+		expectedFiles []string
+	}{
+		{
+			name:          "heap threshold exceeded",
+			heapThreshold: 1,
+
+			expectedFiles: []string{"heap-20240901T122500Z.pprof"},
+		},
+		{
+			name:          "heap threshold not exceeded",
+			heapThreshold: 1 << 50,
+
+			expectedFiles: []string{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := filepath.Join(t.TempDir(), "profiles")
+			now := time.Date(2024, 9, 1, 12, 25, 0, 0, time.UTC)
+
+			sut := profiler.New(slog.Default(), dir,
+				profiler.WithCheckInterval(10*time.Millisecond),
+				profiler.WithHeapThreshold(tc.heapThreshold),
+				profiler.WithCooldown(time.Hour),
+				profiler.WithNow(func() time.Time { return now }),
+			)
+
+			// when
+			err := sut.Start()
+			require.NoError(t, err)
+
+			time.Sleep(100 * time.Millisecond)
+			sut.Shutdown()
+
+			// then
+			entries, err := os.ReadDir(dir)
+			require.NoError(t, err)
+
+			files := make([]string, 0)
+			for _, entry := range entries {
+				files = append(files, entry.Name())
+
+				info, err := entry.Info()
+				require.NoError(t, err)
+				require.Positive(t, info.Size())
+			}
+
+			require.Equal(t, tc.expectedFiles, files)
+		})
+	}
+}
+
+func TestProfiler_Upload(t *testing.T) {
+	tt := []struct {
+		name          string
+		uploadCommand func(uploadDir string) []string
+
+		expectedErr      error
+		expectedUploaded bool
+	}{
+		{
+			name: "uploaded",
+			uploadCommand: func(uploadDir string) []string {
+				return []string{"cp", "{file}", filepath.Join(uploadDir, "{name}")}
+			},
+
+			expectedUploaded: true,
+		},
+		{
+			name: "upload command fails",
+			uploadCommand: func(_ string) []string {
+				return []string{"false"}
+			},
+
+			expectedErr: profiler.ErrUploadCommandFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			uploadDir := t.TempDir()
+			profile := filepath.Join(dir, "heap-20240901T122500Z.pprof")
+			require.NoError(t, os.WriteFile(profile, []byte("profile"), 0o600))
+
+			sut := profiler.New(slog.Default(), dir, profiler.WithUploadCommand(tc.uploadCommand(uploadDir)))
+
+			// when
+			err := sut.Upload(context.Background())
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				_, err = os.Stat(profile)
+				require.NoError(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			_, err = os.Stat(filepath.Join(uploadDir, "heap-20240901T122500Z.pprof"))
+			require.Equal(t, tc.expectedUploaded, err == nil)
+
+			_, err = os.Stat(profile)
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxScheduleSearch limits the search for the next time of a cron schedule, e.g. for 30th of February
+const maxScheduleSearch = 5 * 366 * 24 * time.Hour
+
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Schedule returns the next time a job is due after the given time. A zero time means the job is never due again.
+type Schedule interface {
+	Next(after time.Time) time.Time
+	String() string
+}
+
+type interval time.Duration
+
+// Every returns a schedule which is due every interval.
+func Every(d time.Duration) Schedule {
+	return interval(d)
+}
+
+func (i interval) Next(after time.Time) time.Time {
+	return after.Add(time.Duration(i))
+}
+
+func (i interval) String() string {
+	return "@every " + time.Duration(i).String()
+}
+
+// cronSchedule holds the allowed values of each field of a cron expression as bit sets.
+type cronSchedule struct {
+	expr   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDom bool
+	anyDow bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseSchedule parses a cron expression of the fields minute, hour, day of month, month and day of week, e.g.
+// "*/15 2-4 * * 1-5", a descriptor like "@daily" or an interval like "@every 30s". The fields support lists, ranges
+// and steps. If both the day of month and the day of week are restricted, a day matching either of them is due.
+func ParseSchedule(expr string) (Schedule, error) {
+	expr = strings.TrimSpace(expr)
+
+	if rest, found := strings.CutPrefix(expr, "@every "); found {
+		d, err := time.ParseDuration(strings.TrimSpace(rest))
+		if err != nil || d <= 0 {
+			return nil, errors.Join(ErrInvalidSchedule, fmt.Errorf("interval %q", rest))
+		}
+		return Every(d), nil
+	}
+
+	withoutDescriptor := expr
+	if descriptor, found := cronDescriptors[strings.ToLower(expr)]; found {
+		withoutDescriptor = descriptor
+	}
+
+	fields := strings.Fields(withoutDescriptor)
+	if len(fields) != len(cronFields) {
+		return nil, errors.Join(ErrInvalidSchedule, fmt.Errorf("expected %d fields in %q", len(cronFields), expr))
+	}
+
+	s := &cronSchedule{expr: expr}
+	sets := []*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	for i, field := range fields {
+		set, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, errors.Join(ErrInvalidSchedule, err)
+		}
+		*sets[i] = set
+	}
+
+	// 7 is an alias of Sunday
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.anyDom = fields[2] == "*"
+	s.anyDow = fields[4] == "*"
+
+	return s, nil
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var set uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q of %s", stepPart, f.name)
+			}
+		}
+
+		low, high := f.min, f.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			low, err = strconv.Atoi(lowPart)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q of %s", lowPart, f.name)
+			}
+
+			high = low
+			if isRange {
+				high, err = strconv.Atoi(highPart)
+				if err != nil {
+					return 0, fmt.Errorf("invalid value %q of %s", highPart, f.name)
+				}
+			} else if hasStep {
+				high = f.max
+			}
+		}
+
+		if low < f.min || high > f.max || low > high {
+			return 0, fmt.Errorf("%s %q out of range %d-%d", f.name, rangePart, f.min, f.max)
+		}
+
+		for v := low; v <= high; v += step {
+			set |= 1 << uint(v) // #nosec G115
+		}
+	}
+
+	return set, nil
+}
+
+// Next returns the first minute after the given time which matches the expression.
+func (s *cronSchedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxScheduleSearch)
+
+	for t.Before(limit) {
+		switch {
+		case !has(s.month, int(t.Month())):
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+		case !has(s.hour, t.Hour()):
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+		case !has(s.minute, t.Minute()):
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	domMatches := has(s.dom, t.Day())
+	dowMatches := has(s.dow, int(t.Weekday()))
+
+	if s.anyDom || s.anyDow {
+		return domMatches && dowMatches
+	}
+
+	return domMatches || dowMatches
+}
+
+func (s *cronSchedule) String() string {
+	return s.expr
+}
+
+func has(set uint64, v int) bool {
+	return set&(1<<uint(v)) != 0 // #nosec G115
+}
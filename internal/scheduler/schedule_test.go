@@ -0,0 +1,118 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+)
+
+func TestParseSchedule(t *testing.T) {
+	// Sunday
+	after := time.Date(2024, 9, 1, 12, 7, 30, 0, time.UTC)
+
+	tt := []struct {
+		name string
+		expr string
+
+		expectedNext time.Time
+		expectedErr  error
+	}{
+		{
+			name: "interval",
+			expr: "@every 30s",
+
+			expectedNext: after.Add(30 * time.Second),
+		},
+		{
+			name: "every minute",
+			expr: "* * * * *",
+
+			expectedNext: time.Date(2024, 9, 1, 12, 8, 0, 0, time.UTC),
+		},
+		{
+			name: "step",
+			expr: "*/15 * * * *",
+
+			expectedNext: time.Date(2024, 9, 1, 12, 15, 0, 0, time.UTC),
+		},
+		{
+			name: "list and range",
+			expr: "0,30 2-4 * * *",
+
+			expectedNext: time.Date(2024, 9, 2, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of week",
+			expr: "0 3 * * 1-5",
+
+			expectedNext: time.Date(2024, 9, 2, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 15 * 0",
+
+			expectedNext: time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Sunday as 7",
+			expr: "0 13 * * 7",
+
+			expectedNext: time.Date(2024, 9, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "descriptor",
+			expr: "@monthly",
+
+			expectedNext: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "never due",
+			expr: "0 0 30 2 *",
+		},
+		{
+			name: "error - number of fields",
+			expr: "* * * *",
+
+			expectedErr: scheduler.ErrInvalidSchedule,
+		},
+		{
+			name: "error - out of range",
+			expr: "60 * * * *",
+
+			expectedErr: scheduler.ErrInvalidSchedule,
+		},
+		{
+			name: "error - invalid step",
+			expr: "*/0 * * * *",
+
+			expectedErr: scheduler.ErrInvalidSchedule,
+		},
+		{
+			name: "error - invalid interval",
+			expr: "@every soon",
+
+			expectedErr: scheduler.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := scheduler.ParseSchedule(tc.expr)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedNext, actual.Next(after))
+			assert.Equal(t, tc.expr, actual.String())
+		})
+	}
+}
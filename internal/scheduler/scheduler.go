@@ -0,0 +1,367 @@
+// Package scheduler runs the periodic maintenance jobs of a service, e.g. the re-announcement of transactions or the
+// pruning of expired records, on interval or cron schedules. The schedules of the jobs can be overridden by
+// configuration, a job can be delayed by a random jitter, paused, resumed and triggered manually, and the runs of a job
+// never overlap.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bitcoin-sv/arc/internal/supervisor"
+)
+
+const (
+	TriggerScheduled = "scheduled"
+	TriggerManual    = "manual"
+
+	skipReasonPaused  = "paused"
+	skipReasonOverlap = "overlap"
+)
+
+var (
+	ErrJobExists   = errors.New("job already exists")
+	ErrJobNotFound = errors.New("job not found")
+	ErrJobRunning  = errors.New("job is already running")
+)
+
+var (
+	jobRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_scheduler_job_runs_total",
+		Help: "Nr of runs of scheduled jobs by trigger",
+	}, []string{"job", "trigger"})
+	jobSkips = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_scheduler_job_skips_total",
+		Help: "Nr of due runs of scheduled jobs which were skipped because the job was paused or still running",
+	}, []string{"job", "reason"})
+	jobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "arc_scheduler_job_duration_seconds",
+		Help:    "Duration of the runs of scheduled jobs",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	}, []string{"job"})
+	jobPaused = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "arc_scheduler_job_paused",
+		Help: "1 while the scheduled job is paused, 0 otherwise",
+	}, []string{"job"})
+)
+
+// Collectors returns the collectors of the metrics of the scheduled jobs.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{jobRuns, jobSkips, jobDuration, jobPaused}
+}
+
+// JobConfig overrides the defaults of a job.
+type JobConfig struct {
+	// Schedule is a cron expression or an interval, see ParseSchedule. If empty, the default schedule of the job is used
+	Schedule string
+	// Jitter is the maximum random delay of each run
+	Jitter time.Duration
+	// Paused prevents the scheduled runs of the job until it is resumed
+	Paused bool
+}
+
+// JobState is the state of a job.
+type JobState struct {
+	Name         string
+	Schedule     string
+	Jitter       time.Duration
+	Paused       bool
+	Running      bool
+	Runs         uint64
+	LastRun      time.Time
+	LastDuration time.Duration
+	NextRun      time.Time
+}
+
+type job struct {
+	name     string
+	schedule Schedule
+	jitter   time.Duration
+	fn       func(ctx context.Context)
+
+	paused  atomic.Bool
+	running atomic.Bool
+
+	mu           sync.Mutex
+	runs         uint64
+	lastRun      time.Time
+	lastDuration time.Duration
+	nextRun      time.Time
+}
+
+type Scheduler struct {
+	logger  *slog.Logger
+	configs map[string]JobConfig
+
+	mu   sync.Mutex
+	jobs map[string]*job
+
+	ctx       context.Context
+	cancelAll context.CancelFunc
+	waitGroup *sync.WaitGroup
+}
+
+// WithJobConfigs overrides the defaults of the jobs by name. The names are matched case-insensitively, as the keys of
+// the configuration are lowercased.
+func WithJobConfigs(configs map[string]JobConfig) func(*Scheduler) {
+	return func(s *Scheduler) {
+		for name, cfg := range configs {
+			s.configs[strings.ToLower(name)] = cfg
+		}
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Scheduler)) *Scheduler {
+	s := &Scheduler{
+		logger:    logger.With(slog.String("module", "scheduler")),
+		configs:   make(map[string]JobConfig),
+		jobs:      make(map[string]*job),
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.ctx, s.cancelAll = context.WithCancel(context.Background())
+
+	return s
+}
+
+// Add adds the job with its default schedule and starts scheduling it. The default schedule, jitter and pause state
+// are overridden by the configuration of the job, if any.
+func (s *Scheduler) Add(name string, schedule Schedule, fn func(ctx context.Context)) error {
+	j := &job{name: name, schedule: schedule, fn: fn}
+
+	cfg, found := s.configs[strings.ToLower(name)]
+	if found {
+		if cfg.Schedule != "" {
+			configured, err := ParseSchedule(cfg.Schedule)
+			if err != nil {
+				return errors.Join(err, fmt.Errorf("job %s", name))
+			}
+			j.schedule = configured
+		}
+		j.jitter = cfg.Jitter
+		j.paused.Store(cfg.Paused)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[strings.ToLower(name)]; exists {
+		return errors.Join(ErrJobExists, fmt.Errorf("job %s", name))
+	}
+	s.jobs[strings.ToLower(name)] = j
+
+	jobPaused.WithLabelValues(name).Set(boolToFloat(j.paused.Load()))
+	j.planNextRun()
+
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		s.schedule(j)
+	}()
+
+	return nil
+}
+
+func (s *Scheduler) schedule(j *job) {
+	for ; ; j.planNextRun() {
+		j.mu.Lock()
+		next := j.nextRun
+		j.mu.Unlock()
+
+		if next.IsZero() {
+			s.logger.Warn("Job is never due again", slog.String("job", j.name), slog.String("schedule", j.schedule.String()))
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-s.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if j.paused.Load() {
+			jobSkips.WithLabelValues(j.name, skipReasonPaused).Inc()
+			continue
+		}
+
+		if !s.run(j, TriggerScheduled) {
+			jobSkips.WithLabelValues(j.name, skipReasonOverlap).Inc()
+			s.logger.Warn("Skipping job, previous run still running", slog.String("job", j.name))
+		}
+	}
+}
+
+// run runs the job unless it is already running and returns whether it was run.
+func (s *Scheduler) run(j *job, trigger string) bool {
+	if !j.running.CompareAndSwap(false, true) {
+		return false
+	}
+	defer j.running.Store(false)
+
+	start := time.Now()
+	jobRuns.WithLabelValues(j.name, trigger).Inc()
+
+	supervisor.Recover(s.logger, j.name, func() {
+		j.fn(s.ctx)
+	})
+
+	duration := time.Since(start)
+	jobDuration.WithLabelValues(j.name).Observe(duration.Seconds())
+
+	j.mu.Lock()
+	j.runs++
+	j.lastRun = start
+	j.lastDuration = duration
+	j.mu.Unlock()
+
+	return true
+}
+
+// Trigger runs the job right away in the background, independent of its schedule and even if it is paused.
+func (s *Scheduler) Trigger(name string) error {
+	j, err := s.job(name)
+	if err != nil {
+		return err
+	}
+
+	if j.running.Load() {
+		return errors.Join(ErrJobRunning, fmt.Errorf("job %s", j.name))
+	}
+
+	if s.ctx.Err() != nil {
+		return s.ctx.Err()
+	}
+
+	s.logger.Info("Triggering job", slog.String("job", j.name))
+
+	s.waitGroup.Add(1)
+	go func() {
+		defer s.waitGroup.Done()
+		if !s.run(j, TriggerManual) {
+			jobSkips.WithLabelValues(j.name, skipReasonOverlap).Inc()
+		}
+	}()
+
+	return nil
+}
+
+// Pause skips the scheduled runs of the job until it is resumed. A running job is not interrupted.
+func (s *Scheduler) Pause(name string) error {
+	return s.setPaused(name, true)
+}
+
+func (s *Scheduler) Resume(name string) error {
+	return s.setPaused(name, false)
+}
+
+func (s *Scheduler) setPaused(name string, paused bool) error {
+	j, err := s.job(name)
+	if err != nil {
+		return err
+	}
+
+	j.paused.Store(paused)
+	jobPaused.WithLabelValues(j.name).Set(boolToFloat(paused))
+	s.logger.Info("Setting job pause state", slog.String("job", j.name), slog.Bool("paused", paused))
+
+	return nil
+}
+
+// Job returns the state of the job.
+func (s *Scheduler) Job(name string) (JobState, error) {
+	j, err := s.job(name)
+	if err != nil {
+		return JobState{}, err
+	}
+
+	return j.state(), nil
+}
+
+// Jobs returns the states of all jobs ordered by name.
+func (s *Scheduler) Jobs() []JobState {
+	s.mu.Lock()
+	jobs := make([]*job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, j)
+	}
+	s.mu.Unlock()
+
+	states := make([]JobState, 0, len(jobs))
+	for _, j := range jobs {
+		states = append(states, j.state())
+	}
+
+	slices.SortFunc(states, func(a, b JobState) int { return strings.Compare(a.Name, b.Name) })
+
+	return states
+}
+
+func (s *Scheduler) job(name string) (*job, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	j, found := s.jobs[strings.ToLower(name)]
+	if !found {
+		return nil, errors.Join(ErrJobNotFound, fmt.Errorf("job %s", name))
+	}
+
+	return j, nil
+}
+
+// planNextRun sets the next run of the job according to its schedule, delayed by a random jitter.
+func (j *job) planNextRun() {
+	next := j.schedule.Next(time.Now())
+	if !next.IsZero() && j.jitter > 0 {
+		next = next.Add(rand.N(j.jitter)) // #nosec G404
+	}
+
+	j.mu.Lock()
+	j.nextRun = next
+	j.mu.Unlock()
+}
+
+func (j *job) state() JobState {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	return JobState{
+		Name:         j.name,
+		Schedule:     j.schedule.String(),
+		Jitter:       j.jitter,
+		Paused:       j.paused.Load(),
+		Running:      j.running.Load(),
+		Runs:         j.runs,
+		LastRun:      j.lastRun,
+		LastDuration: j.lastDuration,
+		NextRun:      j.nextRun,
+	}
+}
+
+// Shutdown stops scheduling the jobs and waits for the running jobs to return.
+func (s *Scheduler) Shutdown() {
+	s.cancelAll()
+	s.waitGroup.Wait()
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
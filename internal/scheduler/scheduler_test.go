@@ -0,0 +1,151 @@
+package scheduler_test
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/scheduler"
+)
+
+func TestScheduler(t *testing.T) {
+	tt := []struct {
+		name    string
+		configs map[string]scheduler.JobConfig
+		jobTime time.Duration
+		pause   bool
+		trigger bool
+
+		expectedMinRuns int32
+		expectedMaxRuns int32
+		expectedErr     error
+	}{
+		{
+			name: "scheduled runs",
+
+			expectedMinRuns: 3,
+			expectedMaxRuns: 6,
+		},
+		{
+			name:    "runs don't overlap",
+			jobTime: 200 * time.Millisecond,
+
+			expectedMinRuns: 1,
+			expectedMaxRuns: 1,
+		},
+		{
+			name:    "paused by configuration",
+			configs: map[string]scheduler.JobConfig{"testjob": {Paused: true}},
+
+			expectedMinRuns: 0,
+			expectedMaxRuns: 0,
+		},
+		{
+			name:  "paused",
+			pause: true,
+
+			expectedMinRuns: 0,
+			expectedMaxRuns: 0,
+		},
+		{
+			name:    "triggered while paused",
+			pause:   true,
+			trigger: true,
+
+			expectedMinRuns: 1,
+			expectedMaxRuns: 1,
+		},
+		{
+			name:    "schedule overridden by configuration",
+			configs: map[string]scheduler.JobConfig{"TestJob": {Schedule: "@every 1h"}},
+
+			expectedMinRuns: 0,
+			expectedMaxRuns: 0,
+		},
+		{
+			name:    "error - invalid schedule configured",
+			configs: map[string]scheduler.JobConfig{"testJob": {Schedule: "every hour"}},
+
+			expectedErr: scheduler.ErrInvalidSchedule,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := scheduler.New(slog.Default(), scheduler.WithJobConfigs(tc.configs))
+			defer sut.Shutdown()
+
+			var runs atomic.Int32
+			job := func(_ context.Context) {
+				runs.Add(1)
+				time.Sleep(tc.jobTime)
+			}
+
+			// when
+			err := sut.Add("TestJob", scheduler.Every(40*time.Millisecond), job)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tc.pause {
+				require.NoError(t, sut.Pause("testJob"))
+			}
+			if tc.trigger {
+				require.NoError(t, sut.Trigger("testJob"))
+			}
+
+			time.Sleep(190 * time.Millisecond)
+
+			// then
+			assert.GreaterOrEqual(t, runs.Load(), tc.expectedMinRuns)
+			assert.LessOrEqual(t, runs.Load(), tc.expectedMaxRuns)
+
+			state, err := sut.Job("testjob")
+			require.NoError(t, err)
+			assert.Equal(t, "TestJob", state.Name)
+			assert.Equal(t, tc.pause || tc.configs["testjob"].Paused, state.Paused)
+		})
+	}
+}
+
+func TestScheduler_Trigger(t *testing.T) {
+	// given
+	sut := scheduler.New(slog.Default())
+	defer sut.Shutdown()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	err := sut.Add("SlowJob", scheduler.Every(time.Hour), func(_ context.Context) {
+		close(started)
+		<-release
+	})
+	require.NoError(t, err)
+
+	// when
+	require.NoError(t, sut.Trigger("SlowJob"))
+	<-started
+	overlapErr := sut.Trigger("SlowJob")
+	close(release)
+
+	// then
+	require.ErrorIs(t, overlapErr, scheduler.ErrJobRunning)
+	require.ErrorIs(t, sut.Trigger("UnknownJob"), scheduler.ErrJobNotFound)
+	require.ErrorIs(t, sut.Add("slowjob", scheduler.Every(time.Hour), func(_ context.Context) {}), scheduler.ErrJobExists)
+
+	require.Eventually(t, func() bool {
+		state, stateErr := sut.Job("SlowJob")
+		return stateErr == nil && state.Runs == 1 && !state.Running
+	}, time.Second, 10*time.Millisecond)
+
+	jobs := sut.Jobs()
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "@every 1h0m0s", jobs[0].Schedule)
+}
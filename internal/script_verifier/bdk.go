@@ -0,0 +1,29 @@
+//go:build cgo && (linux || darwin) && (amd64 || arm64)
+
+package script_verifier
+
+import (
+	goscript "github.com/bitcoin-sv/bdk/module/gobdk/script"
+)
+
+// Engine is the name of the script engine verifying the scripts on this platform.
+const Engine = "bdk"
+
+// Verifier verifies the scripts with the BDK.
+type Verifier struct {
+	se *goscript.ScriptEngine
+}
+
+func New(network string) *Verifier {
+	return &Verifier{se: goscript.NewScriptEngine(network)}
+}
+
+// VerifyScript verifies the unlocking scripts of the transaction, the returned error is a goscript.ScriptError.
+func (v *Verifier) VerifyScript(extendedTX []byte, utxoHeights []int32, blockHeight int32, consensus bool) error {
+	err := v.se.VerifyScript(extendedTX, utxoHeights, blockHeight, consensus)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
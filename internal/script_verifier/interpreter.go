@@ -0,0 +1,66 @@
+//go:build !(cgo && (linux || darwin) && (amd64 || arm64))
+
+package script_verifier
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bsv-blockchain/go-sdk/script/interpreter"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+)
+
+// Engine is the name of the script engine verifying the scripts on this platform.
+const Engine = "go-sdk"
+
+// genesisActivationHeights are the heights of the genesis upgrade by network, outputs created before are spent with
+// the rules before genesis.
+var genesisActivationHeights = map[string]int32{
+	"main":    620538,
+	"test":    1344302,
+	"regtest": 10000,
+}
+
+// Verifier verifies the scripts with the script interpreter of the go-sdk.
+type Verifier struct {
+	genesisActivationHeight int32
+}
+
+func New(network string) *Verifier {
+	return &Verifier{genesisActivationHeight: genesisActivationHeights[network]}
+}
+
+// VerifyScript verifies the unlocking scripts of the transaction against the locking scripts of its previous outputs.
+// Other than the BDK the interpreter has no policy limits, i.e. the scripts are verified by the consensus rules
+// regardless of consensus.
+func (v *Verifier) VerifyScript(extendedTX []byte, utxoHeights []int32, _ int32, _ bool) error {
+	tx, err := sdkTx.NewTransactionFromBytes(extendedTX)
+	if err != nil {
+		return errors.Join(ErrMalformedTransaction, err)
+	}
+
+	if len(utxoHeights) != len(tx.Inputs) {
+		return errors.Join(ErrMalformedTransaction, fmt.Errorf("%d utxo heights for %d inputs", len(utxoHeights), len(tx.Inputs)))
+	}
+
+	for i, input := range tx.Inputs {
+		prevOutput := input.SourceTxOutput()
+		if prevOutput == nil {
+			return errors.Join(ErrMissingPreviousOutput, fmt.Errorf("input %d", i))
+		}
+
+		opts := []interpreter.ExecutionOptionFunc{interpreter.WithTx(tx, i, prevOutput), interpreter.WithForkID()}
+		if utxoHeights[i] >= v.genesisActivationHeight {
+			opts = append(opts, interpreter.WithAfterGenesis())
+		} else {
+			opts = append(opts, interpreter.WithP2SH())
+		}
+
+		err = interpreter.NewEngine().Execute(opts...)
+		if err != nil {
+			return errors.Join(ErrScriptVerificationFailed, fmt.Errorf("input %d", i), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+// Package script_verifier verifies the unlocking scripts of transactions in extended format. On the platforms
+// supported by the BDK, the script engine of the node, i.e. linux and darwin on amd64 and arm64 built with cgo, the
+// scripts are verified by the BDK. On all other platforms, e.g. windows or builds without cgo, the scripts are verified
+// by the script interpreter of the go-sdk, which only applies the consensus rules.
+package script_verifier
+
+import "errors"
+
+var (
+	ErrMalformedTransaction     = errors.New("malformed extended transaction")
+	ErrMissingPreviousOutput    = errors.New("previous output of input missing")
+	ErrScriptVerificationFailed = errors.New("script verification failed")
+)
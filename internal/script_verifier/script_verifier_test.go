@@ -0,0 +1,58 @@
+package script_verifier_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/script_verifier"
+)
+
+// extendedTx is the mainnet transaction 7be4fa421844154ec4105894def768a8bcd80da25792947d585274ce38c07105 in extended
+// format
+const extendedTx = "020000000000000000ef023f6c667203b47ce2fed8c8bcc78d764c39da9c0094f1a49074e05f66910e9c44000000006b4c69522102401d5481712745cf7ada12b7251c85ca5f1b8b6c859c7e81b8002a85b0f36d3c21039d8b1e461715ddd4d10806125be8592e6f48fb69e4c31699ce6750da1c9eaeb32103af3b35d4ad547fd1ce102bbd5cce36de2277723796f1b4001ec0ea6a1db6474053aeffffffffa73018250000000017a91413402e079464ec2a85e5a613732c78b0613fcc65873f6c667203b47ce2fed8c8bcc78d764c39da9c0094f1a49074e05f66910e9c44010000006b4c69522102401d5481712745cf7ada12b7251c85ca5f1b8b6c859c7e81b8002a85b0f36d3c21039d8b1e461715ddd4d10806125be8592e6f48fb69e4c31699ce6750da1c9eaeb32103af3b35d4ad547fd1ce102bbd5cce36de2277723796f1b4001ec0ea6a1db6474053aeffffffff34b82f000000000017a91413402e079464ec2a85e5a613732c78b0613fcc65870187e74725000000001976a9141be3d23725148a90807ee6df191bcdfcf083a3b288ac00000000"
+
+func TestVerifier_VerifyScript(t *testing.T) {
+	valid, err := hex.DecodeString(extendedTx)
+	require.NoError(t, err)
+
+	// flips a byte of the redeem script pushed by the first unlocking script
+	invalid := append([]byte(nil), valid...)
+	invalid[150] ^= 0xff
+
+	tt := []struct {
+		name string
+		tx   []byte
+
+		expectedErr bool
+	}{
+		{
+			name: "valid scripts",
+			tx:   valid,
+		},
+		{
+			name: "invalid unlocking script",
+			tx:   invalid,
+
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := script_verifier.New("main")
+
+			// when
+			err := sut.VerifyScript(tc.tx, []int32{631924, 631924}, 632099, true)
+
+			// then
+			if tc.expectedErr {
+				require.Error(t, err, "engine %s", script_verifier.Engine)
+				return
+			}
+			require.NoError(t, err, "engine %s", script_verifier.Engine)
+		})
+	}
+}
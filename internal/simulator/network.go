@@ -0,0 +1,342 @@
+// Package simulator simulates a blockchain network for the local development against ARC without nodes. The simulated
+// peers accept the transactions announced by metamorph, announce them back as seen after a delay and mine them in
+// blocks on an interval, which are announced to and downloaded by blocktx. This way transactions go through the same
+// statuses as on a real network, up to MINED with merkle paths and callbacks.
+//
+// The transactions and blocks are only kept in memory, so metamorph and blocktx have to run in the same process.
+package simulator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/libsv/go-bc"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+const (
+	seenDelayDefault     = time.Second
+	blockIntervalDefault = time.Minute
+	// keptBlocks is the number of the latest blocks which can be downloaded from the simulated peers
+	keptBlocks = 100
+
+	minerTag       = "ARC simulator"
+	coinbaseReward = 50 * 100_000_000
+	// regtestBits is the difficulty of the blocks, the proof of work of the simulated blocks is not valid
+	regtestBits     = 0x207fffff
+	blockHeaderSize = 80
+)
+
+type mempoolTx struct {
+	hash chainhash.Hash
+	size int
+}
+
+// Network is the simulated blockchain network which the simulated peers are connected to.
+type Network struct {
+	logger        *slog.Logger
+	seenDelay     time.Duration
+	blockInterval time.Duration
+	now           func() time.Time
+
+	mu      sync.Mutex
+	peers   []*Peer
+	known   map[chainhash.Hash]struct{}
+	mempool []mempoolTx
+	tip     chainhash.Hash
+	height  uint64
+	blocks  map[chainhash.Hash]*bcnet.BlockMessage
+	recent  []chainhash.Hash
+
+	shutdownOnce sync.Once
+	cancelAll    context.CancelFunc
+	ctx          context.Context
+	waitGroup    *sync.WaitGroup
+}
+
+// WithSeenDelay sets the delay after which a transaction sent to the network is announced back as seen.
+func WithSeenDelay(d time.Duration) func(*Network) {
+	return func(n *Network) {
+		n.seenDelay = d
+	}
+}
+
+// WithBlockInterval sets the interval at which blocks are mined, 0 disables the mining on an interval.
+func WithBlockInterval(d time.Duration) func(*Network) {
+	return func(n *Network) {
+		n.blockInterval = d
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Network) {
+	return func(n *Network) {
+		n.now = nowFunc
+	}
+}
+
+func NewNetwork(logger *slog.Logger, opts ...func(*Network)) *Network {
+	n := &Network{
+		logger:        logger.With(slog.String("module", "simulator")),
+		seenDelay:     seenDelayDefault,
+		blockInterval: blockIntervalDefault,
+		now:           time.Now,
+		known:         make(map[chainhash.Hash]struct{}),
+		blocks:        make(map[chainhash.Hash]*bcnet.BlockMessage),
+		waitGroup:     &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	n.ctx, n.cancelAll = context.WithCancel(context.Background())
+
+	return n
+}
+
+// SetChainTip continues the simulated chain on top of the given block, e.g. the tip of the chain known to blocktx from
+// a previous run, so that the new blocks are not orphaned.
+func (n *Network) SetChainTip(hash chainhash.Hash, height uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if height < n.height {
+		return
+	}
+
+	n.tip = hash
+	n.height = height
+}
+
+// NewPeer returns a simulated peer of the network passing the messages of the network to the message handler.
+func (n *Network) NewPeer(handler p2p.MessageHandlerI, network wire.BitcoinNet) *Peer {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	p := &Peer{
+		network:       n,
+		handler:       handler,
+		bitcoinNet:    network,
+		name:          fmt.Sprintf("simulator-%d", len(n.peers)),
+		isUnhealthyCh: make(chan struct{}),
+	}
+	n.peers = append(n.peers, p)
+
+	return p
+}
+
+// Start mines a block every block interval.
+func (n *Network) Start() {
+	n.logger.Warn("!!! Simulating the blockchain network, transactions are not sent to any node !!!",
+		slog.String("seenDelay", n.seenDelay.String()), slog.String("blockInterval", n.blockInterval.String()))
+
+	if n.blockInterval <= 0 {
+		return
+	}
+
+	n.waitGroup.Add(1)
+	go func() {
+		defer n.waitGroup.Done()
+
+		ticker := time.NewTicker(n.blockInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-n.ctx.Done():
+				return
+			case <-ticker.C:
+				n.MineBlock()
+			}
+		}
+	}()
+}
+
+// MineBlock mines a block with all transactions which have been seen by the network since the last block and
+// announces it to the peers.
+func (n *Network) MineBlock() *bcnet.BlockMessage {
+	n.mu.Lock()
+
+	n.height++
+	coinbase := newCoinbase(n.height)
+	coinbaseHash := coinbase.TxHash()
+
+	txHashes := make([]*chainhash.Hash, 0, len(n.mempool)+1)
+	txHashes = append(txHashes, &coinbaseHash)
+	size := wire.MessageHeaderSize + blockHeaderSize + wire.VarIntSerializeSize(uint64(len(n.mempool)+1)) + coinbase.SerializeSize()
+	for i := range n.mempool {
+		txHashes = append(txHashes, &n.mempool[i].hash)
+		size += n.mempool[i].size
+	}
+	n.mempool = nil
+
+	merkleTree := bc.BuildMerkleTreeStoreChainHash(txHashes)
+	header := wire.NewBlockHeader(1, &n.tip, merkleTree[len(merkleTree)-1], regtestBits, randomNonce())
+	header.Timestamp = n.now().Truncate(time.Second)
+
+	hash := header.BlockHash()
+	block := &bcnet.BlockMessage{
+		Hash:              &hash,
+		Header:            header,
+		Height:            n.height,
+		TransactionHashes: txHashes,
+		Size:              uint64(size), // #nosec G115
+		CoinbaseReward:    coinbaseReward,
+		MinerTag:          minerTag,
+	}
+
+	n.tip = hash
+	n.blocks[hash] = block
+	n.recent = append(n.recent, hash)
+	if len(n.recent) > keptBlocks {
+		delete(n.blocks, n.recent[0])
+		n.recent = n.recent[1:]
+	}
+
+	peers := n.peersLocked()
+	n.mu.Unlock()
+
+	n.logger.Info("Mined block", slog.String("hash", hash.String()), slog.Uint64("height", block.Height), slog.Int("txs", len(txHashes)))
+
+	inv := wire.NewMsgInv()
+	_ = inv.AddInvVect(wire.NewInvVect(wire.InvTypeBlock, &hash))
+	for _, p := range peers {
+		p.deliver(inv)
+	}
+
+	return block
+}
+
+// Shutdown stops the mining and the pending announcements.
+func (n *Network) Shutdown() {
+	n.shutdownOnce.Do(func() {
+		n.cancelAll()
+		n.waitGroup.Wait()
+	})
+}
+
+// receive handles the message sent by the peer to the network.
+func (n *Network) receive(msg wire.Message, from *Peer) {
+	switch m := msg.(type) {
+	case *wire.MsgInv:
+		// the network requests all transactions it doesn't know yet
+		getData := wire.NewMsgGetData()
+		n.mu.Lock()
+		for _, iv := range m.InvList {
+			if iv.Type != wire.InvTypeTx {
+				continue
+			}
+			if _, found := n.known[iv.Hash]; found {
+				continue
+			}
+			_ = getData.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &iv.Hash))
+		}
+		n.mu.Unlock()
+
+		if len(getData.InvList) > 0 {
+			from.deliver(getData)
+		}
+	case *wire.MsgTx:
+		n.receiveTx(mempoolTx{hash: m.TxHash(), size: m.SerializeSize()})
+	case *p2p.SharedMessage:
+		if m.Command() == wire.CmdTx {
+			n.receiveTx(mempoolTx{hash: m.Hash(), size: len(m.Payload())})
+		}
+	case *wire.MsgGetData:
+		for _, iv := range m.InvList {
+			if iv.Type != wire.InvTypeBlock {
+				continue
+			}
+
+			n.mu.Lock()
+			block, found := n.blocks[iv.Hash]
+			n.mu.Unlock()
+
+			if found {
+				// the peers must not modify the blocks, they are delivered to every peer requesting them
+				blockCopy := *block
+				from.deliver(&blockCopy)
+			}
+		}
+	default:
+		// ignore other messages, e.g. pings
+	}
+}
+
+func (n *Network) receiveTx(tx mempoolTx) {
+	n.mu.Lock()
+	_, found := n.known[tx.hash]
+	n.known[tx.hash] = struct{}{}
+	n.mu.Unlock()
+
+	if !found {
+		n.announceSeen(tx)
+	}
+}
+
+// announceSeen adds the transaction to the mempool after the seen delay and announces it to all peers as seen.
+func (n *Network) announceSeen(tx mempoolTx) {
+	n.waitGroup.Add(1)
+	go func() {
+		defer n.waitGroup.Done()
+
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-time.After(n.seenDelay):
+		}
+
+		n.mu.Lock()
+		n.mempool = append(n.mempool, tx)
+		peers := n.peersLocked()
+		n.mu.Unlock()
+
+		inv := wire.NewMsgInv()
+		_ = inv.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &tx.hash))
+		for _, p := range peers {
+			p.deliver(inv)
+		}
+	}()
+}
+
+func (n *Network) peersLocked() []*Peer {
+	peers := make([]*Peer, len(n.peers))
+	copy(peers, n.peers)
+	return peers
+}
+
+// newCoinbase returns the coinbase transaction of the block at the height with the height (BIP-34) and the tag of the
+// simulator in the coinbase script.
+func newCoinbase(height uint64) *wire.MsgTx {
+	heightBytes := binary.LittleEndian.AppendUint64(nil, height)
+	for len(heightBytes) > 1 && heightBytes[len(heightBytes)-1] == 0 {
+		heightBytes = heightBytes[:len(heightBytes)-1]
+	}
+	if heightBytes[len(heightBytes)-1]&0x80 != 0 {
+		heightBytes = append(heightBytes, 0)
+	}
+
+	script := append([]byte{byte(len(heightBytes))}, heightBytes...)
+	script = append(script, []byte("/"+minerTag+"/")...)
+
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Index: 0xffffffff}, script))
+	tx.AddTxOut(wire.NewTxOut(coinbaseReward, []byte{0x51})) // OP_TRUE
+
+	return tx
+}
+
+func randomNonce() uint32 {
+	var b [4]byte
+	_, _ = rand.Read(b[:])
+	return binary.LittleEndian.Uint32(b[:])
+}
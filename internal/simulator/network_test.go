@@ -0,0 +1,173 @@
+package simulator
+
+import (
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/libsv/go-bc"
+	"github.com/libsv/go-p2p/chaincfg/chainhash"
+	"github.com/libsv/go-p2p/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/blocktx/bcnet"
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+type recordingHandler struct {
+	mu       sync.Mutex
+	received []wire.Message
+	sent     []wire.Message
+	// onReceive answers the messages of the network like the message handlers of metamorph and blocktx
+	onReceive func(msg wire.Message, peer p2p.PeerI)
+}
+
+func (h *recordingHandler) OnReceive(msg wire.Message, peer p2p.PeerI) {
+	h.mu.Lock()
+	h.received = append(h.received, msg)
+	h.mu.Unlock()
+
+	if h.onReceive != nil {
+		h.onReceive(msg, peer)
+	}
+}
+
+func (h *recordingHandler) OnSend(msg wire.Message, _ p2p.PeerI) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sent = append(h.sent, msg)
+}
+
+func (h *recordingHandler) receivedCommands(cmd string) []wire.Message {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var msgs []wire.Message
+	for _, msg := range h.received {
+		if msg.Command() == cmd {
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs
+}
+
+func TestNetwork(t *testing.T) {
+	// given
+	tx := wire.NewMsgTx(1)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: chainhash.Hash{1}}, []byte{0x51}))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	txHash := tx.TxHash()
+
+	tipHash := chainhash.Hash{9}
+	sut := NewNetwork(slog.Default(), WithSeenDelay(10*time.Millisecond), WithBlockInterval(0))
+	defer sut.Shutdown()
+	sut.SetChainTip(tipHash, 100)
+
+	// metamorph sends the transactions requested by the network
+	metamorphHandler := &recordingHandler{}
+	metamorphHandler.onReceive = func(msg wire.Message, peer p2p.PeerI) {
+		if msg.Command() == wire.CmdGetData {
+			peer.WriteMsg(tx)
+		}
+	}
+	metamorphPeer := sut.NewPeer(metamorphHandler, wire.TestNet)
+
+	// blocktx requests the announced blocks
+	blocktxHandler := &recordingHandler{}
+	blocktxHandler.onReceive = func(msg wire.Message, peer p2p.PeerI) {
+		inv, ok := msg.(*wire.MsgInv)
+		if !ok || inv.InvList[0].Type != wire.InvTypeBlock {
+			return
+		}
+		getData := wire.NewMsgGetData()
+		_ = getData.AddInvVect(inv.InvList[0])
+		peer.WriteMsg(getData)
+	}
+	sut.NewPeer(blocktxHandler, wire.TestNet)
+
+	sut.Start()
+
+	// when
+	announcement := wire.NewMsgInv()
+	_ = announcement.AddInvVect(wire.NewInvVect(wire.InvTypeTx, &txHash))
+	metamorphPeer.WriteMsg(announcement)
+
+	// then the transaction is requested and announced back as seen
+	require.Eventually(t, func() bool {
+		for _, msg := range metamorphHandler.receivedCommands(wire.CmdInv) {
+			if msg.(*wire.MsgInv).InvList[0].Hash == txHash {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 5*time.Millisecond)
+	assert.Len(t, metamorphHandler.receivedCommands(wire.CmdGetData), 1)
+
+	// when
+	mined := sut.MineBlock()
+
+	// then the block contains the coinbase and the transaction and is downloaded by blocktx
+	require.Eventually(t, func() bool {
+		return len(blocktxHandler.receivedCommands(wire.CmdBlock)) == 1
+	}, time.Second, 5*time.Millisecond)
+
+	block, ok := blocktxHandler.receivedCommands(wire.CmdBlock)[0].(*bcnet.BlockMessage)
+	require.True(t, ok)
+	assert.Equal(t, *mined.Hash, *block.Hash)
+	assert.Equal(t, uint64(101), block.Height)
+	assert.Equal(t, tipHash, block.Header.PrevBlock)
+	assert.Equal(t, minerTag, block.MinerTag)
+	require.Len(t, block.TransactionHashes, 2)
+	assert.Equal(t, txHash, *block.TransactionHashes[1])
+
+	merkleTree := bc.BuildMerkleTreeStoreChainHash(block.TransactionHashes)
+	assert.Equal(t, block.Header.MerkleRoot, *merkleTree[len(merkleTree)-1])
+
+	// the next block is empty and continues the chain
+	next := sut.MineBlock()
+	assert.Equal(t, *mined.Hash, next.Header.PrevBlock)
+	assert.Len(t, next.TransactionHashes, 1)
+}
+
+func TestNewCoinbase(t *testing.T) {
+	tt := []struct {
+		name   string
+		height uint64
+
+		expectedPrefix []byte
+	}{
+		{
+			name:   "1 byte",
+			height: 100,
+
+			expectedPrefix: []byte{0x01, 0x64},
+		},
+		{
+			name:   "sign bit",
+			height: 128,
+
+			expectedPrefix: []byte{0x02, 0x80, 0x00},
+		},
+		{
+			name:   "3 bytes",
+			height: 773200,
+
+			expectedPrefix: []byte{0x03, 0x50, 0xcc, 0x0b},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := newCoinbase(tc.height)
+
+			// then
+			script := actual.TxIn[0].SignatureScript
+			assert.Equal(t, tc.expectedPrefix, script[:len(tc.expectedPrefix)])
+			assert.Equal(t, "/"+minerTag+"/", string(script[len(tc.expectedPrefix):]))
+		})
+	}
+}
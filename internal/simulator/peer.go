@@ -0,0 +1,73 @@
+package simulator
+
+import (
+	"github.com/libsv/go-p2p/wire"
+
+	"github.com/bitcoin-sv/arc/internal/p2p"
+)
+
+var _ p2p.PeerI = (*Peer)(nil)
+
+// Peer is a peer of the simulated network. The messages written to the peer are passed to the network, the messages of
+// the network to the message handler of the peer, like the messages exchanged with a node.
+type Peer struct {
+	network       *Network
+	handler       p2p.MessageHandlerI
+	bitcoinNet    wire.BitcoinNet
+	name          string
+	isUnhealthyCh chan struct{}
+}
+
+func (p *Peer) WriteMsg(msg wire.Message) {
+	if p.network.ctx.Err() != nil {
+		return
+	}
+
+	p.network.waitGroup.Add(1)
+	go func() {
+		defer p.network.waitGroup.Done()
+
+		p.handler.OnSend(msg, p)
+		p.network.receive(msg, p)
+
+		if shared, ok := msg.(*p2p.SharedMessage); ok {
+			shared.Release()
+		}
+	}()
+}
+
+// deliver passes the message of the network to the message handler of the peer.
+func (p *Peer) deliver(msg wire.Message) {
+	if p.network.ctx.Err() != nil {
+		return
+	}
+
+	p.network.waitGroup.Add(1)
+	go func() {
+		defer p.network.waitGroup.Done()
+
+		p.handler.OnReceive(msg, p)
+	}()
+}
+
+func (p *Peer) Restart() bool {
+	return true
+}
+
+func (p *Peer) Shutdown() {}
+
+func (p *Peer) Connected() bool {
+	return true
+}
+
+func (p *Peer) IsUnhealthyCh() <-chan struct{} {
+	return p.isUnhealthyCh
+}
+
+func (p *Peer) Network() wire.BitcoinNet {
+	return p.bitcoinNet
+}
+
+func (p *Peer) String() string {
+	return p.name
+}
@@ -0,0 +1,64 @@
+// Package slareport contains the periods of the SLA reports which are computed per tenant by metamorph and callbacker.
+//
+// Reports are computed for daily and weekly periods. Days start at midnight UTC and weeks on Monday at midnight UTC.
+// A transaction or callback belongs to the period in which it was stored.
+package slareport
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	Daily  = "daily"
+	Weekly = "weekly"
+)
+
+var ErrUnknownPeriod = errors.New("unknown SLA report period")
+
+// ValidatePeriod returns ErrUnknownPeriod if the period type is neither daily nor weekly.
+func ValidatePeriod(period string) error {
+	if period != Daily && period != Weekly {
+		return errors.Join(ErrUnknownPeriod, fmt.Errorf("period: %q", period))
+	}
+
+	return nil
+}
+
+// Window is the period of the type Period from Start until End, excluding End.
+type Window struct {
+	Period string
+	Start  time.Time
+	End    time.Time
+}
+
+// WindowOf returns the window of the period type which contains t.
+func WindowOf(period string, t time.Time) (Window, error) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch period {
+	case Daily:
+		return Window{Period: Daily, Start: day, End: day.AddDate(0, 0, 1)}, nil
+	case Weekly:
+		// time.Sunday is 0, weeks start on Monday
+		start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		return Window{Period: Weekly, Start: start, End: start.AddDate(0, 0, 7)}, nil
+	default:
+		return Window{}, ValidatePeriod(period)
+	}
+}
+
+// Recent returns the current and the previous daily and weekly windows at now. The previous windows are recomputed,
+// because the transactions and callbacks stored at their end are still processed at the start of the current windows.
+func Recent(now time.Time) []Window {
+	windows := make([]Window, 0, 4)
+	for _, period := range []string{Daily, Weekly} {
+		current, _ := WindowOf(period, now)
+		previous, _ := WindowOf(period, current.Start.Add(-time.Nanosecond))
+		windows = append(windows, previous, current)
+	}
+
+	return windows
+}
@@ -0,0 +1,92 @@
+package slareport_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/slareport"
+)
+
+func TestWindowOf(t *testing.T) {
+	tt := []struct {
+		name   string
+		period string
+		t      time.Time
+
+		expectedWindow slareport.Window
+		expectedError  error
+	}{
+		{
+			name:   "daily",
+			period: slareport.Daily,
+			t:      time.Date(2026, 10, 14, 17, 30, 0, 0, time.UTC),
+
+			expectedWindow: slareport.Window{Period: slareport.Daily, Start: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "daily - other time zone",
+			period: slareport.Daily,
+			t:      time.Date(2026, 10, 15, 1, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+
+			expectedWindow: slareport.Window{Period: slareport.Daily, Start: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "weekly - wednesday",
+			period: slareport.Weekly,
+			t:      time.Date(2026, 10, 14, 17, 30, 0, 0, time.UTC),
+
+			expectedWindow: slareport.Window{Period: slareport.Weekly, Start: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "weekly - sunday",
+			period: slareport.Weekly,
+			t:      time.Date(2026, 10, 18, 23, 59, 0, 0, time.UTC),
+
+			expectedWindow: slareport.Window{Period: slareport.Weekly, Start: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "weekly - monday",
+			period: slareport.Weekly,
+			t:      time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+
+			expectedWindow: slareport.Window{Period: slareport.Weekly, Start: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:   "unknown period",
+			period: "monthly",
+			t:      time.Date(2026, 10, 14, 17, 30, 0, 0, time.UTC),
+
+			expectedError: slareport.ErrUnknownPeriod,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := slareport.WindowOf(tc.period, tc.t)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedWindow, actual)
+		})
+	}
+}
+
+func TestRecent(t *testing.T) {
+	// when
+	actual := slareport.Recent(time.Date(2026, 10, 12, 8, 0, 0, 0, time.UTC))
+
+	// then
+	require.Equal(t, []slareport.Window{
+		{Period: slareport.Daily, Start: time.Date(2026, 10, 11, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{Period: slareport.Daily, Start: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC)},
+		{Period: slareport.Weekly, Start: time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)},
+		{Period: slareport.Weekly, Start: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), End: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)},
+	}, actual)
+}
@@ -0,0 +1,82 @@
+package supervisor
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	restartInitialBackoff = time.Second
+	restartMaxBackoff     = time.Minute
+)
+
+var routinePanics = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "arc_routine_panics_total",
+	Help: "Nr of recovered panics of background routines",
+}, []string{"routine"})
+
+// RoutinePanics returns the collector of the panics recovered by Recover and Restarter by routine.
+func RoutinePanics() prometheus.Collector {
+	return routinePanics
+}
+
+// Recover runs fn and recovers a panic, which is logged with its stack trace and counted by routine. It returns whether
+// fn panicked.
+func Recover(logger *slog.Logger, routine string, fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		panicked = true
+		routinePanics.WithLabelValues(routine).Inc()
+		logger.Error("Routine panicked", slog.String("routine", routine), slog.Any("panic", r), slog.String("stack", string(debug.Stack())))
+	}()
+
+	fn()
+
+	return false
+}
+
+// Restarter runs the iterations of a background routine, e.g. the ticks of a ticker, recovering their panics. After a
+// panic the next iteration is delayed with an exponential backoff, which is reset once an iteration succeeds, so that a
+// routine which keeps panicking neither dies silently nor floods the logs.
+type Restarter struct {
+	logger  *slog.Logger
+	routine string
+	policy  *backoff.ExponentialBackOff
+}
+
+func NewRestarter(logger *slog.Logger, routine string) *Restarter {
+	return &Restarter{
+		logger:  logger,
+		routine: routine,
+		policy: backoff.NewExponentialBackOff(
+			backoff.WithInitialInterval(restartInitialBackoff),
+			backoff.WithMaxInterval(restartMaxBackoff),
+			backoff.WithMaxElapsedTime(0),
+		),
+	}
+}
+
+// Run runs an iteration of the routine. If it panics, Run waits for the backoff or until the context is canceled.
+func (r *Restarter) Run(ctx context.Context, fn func()) {
+	if !Recover(r.logger, r.routine, fn) {
+		r.policy.Reset()
+		return
+	}
+
+	next := r.policy.NextBackOff()
+	r.logger.Warn("Restarting routine", slog.String("routine", r.routine), slog.Duration("next", next))
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(next):
+	}
+}
@@ -0,0 +1,168 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const (
+	initialBackoffDefault    = time.Second
+	maxBackoffDefault        = time.Minute
+	dependencyTimeoutDefault = 5 * time.Minute
+)
+
+var (
+	ErrDependencyUnavailable = errors.New("dependency unavailable")
+	ErrSubsystemPanicked     = errors.New("subsystem panicked")
+)
+
+// Supervisor retries the dependencies of a service on startup with an exponential backoff instead of failing the
+// start, and restarts its internal subsystems on unrecoverable errors while the rest of the service keeps running.
+type Supervisor struct {
+	logger            *slog.Logger
+	initialBackoff    time.Duration
+	maxBackoff        time.Duration
+	dependencyTimeout time.Duration
+	retryDependencies bool
+
+	waitGroup *sync.WaitGroup
+	cancelAll context.CancelFunc
+	ctx       context.Context
+}
+
+func WithBackoff(initial time.Duration, maxBackoff time.Duration) func(*Supervisor) {
+	return func(s *Supervisor) {
+		s.initialBackoff = initial
+		s.maxBackoff = maxBackoff
+	}
+}
+
+// WithRetryDependencies sets whether dependencies are retried and the maximum duration for which they are retried, 0
+// retries until the supervisor is shut down.
+func WithRetryDependencies(retry bool, timeout time.Duration) func(*Supervisor) {
+	return func(s *Supervisor) {
+		s.retryDependencies = retry
+		s.dependencyTimeout = timeout
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Supervisor)) *Supervisor {
+	s := &Supervisor{
+		logger:            logger.With(slog.String("module", "supervisor")),
+		initialBackoff:    initialBackoffDefault,
+		maxBackoff:        maxBackoffDefault,
+		dependencyTimeout: dependencyTimeoutDefault,
+		retryDependencies: true,
+		waitGroup:         &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	ctx, cancelAll := context.WithCancel(context.Background())
+	s.cancelAll = cancelAll
+	s.ctx = ctx
+
+	return s
+}
+
+// Retry connects to the dependency of the given name, e.g. the database or the message queue, with connect until it
+// succeeds. If retrying dependencies is disabled, connect is called once.
+func Retry[T any](s *Supervisor, name string, connect func() (T, error)) (T, error) {
+	if !s.retryDependencies {
+		return connect()
+	}
+
+	policy := backoff.WithContext(s.newBackOff(s.dependencyTimeout), s.ctx)
+
+	notify := func(err error, next time.Duration) {
+		s.logger.Warn("Dependency unavailable, retrying", slog.String("dependency", name), slog.Duration("next", next), slog.String("err", err.Error()))
+	}
+
+	dependency, err := backoff.RetryNotifyWithData(connect, policy, notify)
+	if err != nil {
+		return dependency, errors.Join(ErrDependencyUnavailable, fmt.Errorf("dependency: %s", name), err)
+	}
+
+	return dependency, nil
+}
+
+// WaitFor calls check until the dependency of the given name is available, e.g. until the database answers a ping.
+func (s *Supervisor) WaitFor(name string, check func() error) error {
+	_, err := Retry(s, name, func() (struct{}, error) {
+		return struct{}{}, check()
+	})
+
+	return err
+}
+
+// Go runs the subsystem of the given name until the supervisor is shut down. The context given to run is canceled on
+// shutdown. If run returns an error or panics, the subsystem is restarted with an exponential backoff which is reset
+// once the subsystem ran longer than the maximum backoff. If run returns nil, the subsystem is considered finished.
+func (s *Supervisor) Go(name string, run func(ctx context.Context) error) {
+	s.waitGroup.Add(1)
+
+	go func() {
+		defer s.waitGroup.Done()
+
+		policy := s.newBackOff(0)
+		for {
+			started := time.Now()
+			err := s.runSafe(run)
+
+			if s.ctx.Err() != nil {
+				return
+			}
+
+			if err == nil {
+				s.logger.Info("Subsystem finished", slog.String("subsystem", name))
+				return
+			}
+
+			if time.Since(started) > s.maxBackoff {
+				policy.Reset()
+			}
+
+			next := policy.NextBackOff()
+			s.logger.Error("Subsystem failed, restarting", slog.String("subsystem", name), slog.Duration("next", next), slog.String("err", err.Error()))
+
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(next):
+			}
+		}
+	}()
+}
+
+func (s *Supervisor) runSafe(run func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Join(ErrSubsystemPanicked, fmt.Errorf("%v: %s", r, debug.Stack()))
+		}
+	}()
+
+	return run(s.ctx)
+}
+
+func (s *Supervisor) newBackOff(maxElapsed time.Duration) *backoff.ExponentialBackOff {
+	return backoff.NewExponentialBackOff(
+		backoff.WithInitialInterval(s.initialBackoff),
+		backoff.WithMaxInterval(s.maxBackoff),
+		backoff.WithMaxElapsedTime(maxElapsed),
+	)
+}
+
+// Shutdown cancels the context of the subsystems and waits until they returned.
+func (s *Supervisor) Shutdown() {
+	s.cancelAll()
+	s.waitGroup.Wait()
+}
@@ -0,0 +1,232 @@
+package supervisor_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/supervisor"
+)
+
+func TestRetry(t *testing.T) {
+	tt := []struct {
+		name              string
+		failures          int
+		retryDependencies bool
+		timeout           time.Duration
+
+		expectedErrorStr string
+		expectedCalls    int
+	}{
+		{
+			name:              "success - available after failures",
+			failures:          2,
+			retryDependencies: true,
+
+			expectedCalls: 3,
+		},
+		{
+			name:              "error - timeout",
+			failures:          1000,
+			retryDependencies: true,
+			timeout:           50 * time.Millisecond,
+
+			expectedErrorStr: supervisor.ErrDependencyUnavailable.Error(),
+		},
+		{
+			name:              "error - retry disabled",
+			failures:          1,
+			retryDependencies: false,
+
+			expectedErrorStr: "connection refused",
+			expectedCalls:    1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := supervisor.New(slog.Default(),
+				supervisor.WithBackoff(time.Millisecond, 5*time.Millisecond),
+				supervisor.WithRetryDependencies(tc.retryDependencies, tc.timeout),
+			)
+			defer sut.Shutdown()
+
+			calls := 0
+
+			// when
+			actual, err := supervisor.Retry(sut, "database", func() (string, error) {
+				calls++
+				if calls <= tc.failures {
+					return "", errors.New("connection refused")
+				}
+				return "connected", nil
+			})
+
+			// then
+			if tc.expectedErrorStr != "" {
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, "connected", actual)
+			}
+
+			if tc.expectedCalls > 0 {
+				require.Equal(t, tc.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+func TestSupervisor_Go(t *testing.T) {
+	tt := []struct {
+		name string
+		fail func(run int32) error
+
+		expectedRuns int32
+	}{
+		{
+			name: "restarted after errors",
+			fail: func(run int32) error {
+				if run < 3 {
+					return errors.New("socket closed")
+				}
+				return nil
+			},
+
+			expectedRuns: 3,
+		},
+		{
+			name: "restarted after panic",
+			fail: func(run int32) error {
+				if run == 1 {
+					panic("nil pointer")
+				}
+				return nil
+			},
+
+			expectedRuns: 2,
+		},
+		{
+			name: "finished",
+			fail: func(_ int32) error {
+				return nil
+			},
+
+			expectedRuns: 1,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := supervisor.New(slog.Default(), supervisor.WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+			var runs atomic.Int32
+
+			// when
+			sut.Go("zmq", func(_ context.Context) error {
+				return tc.fail(runs.Add(1))
+			})
+			time.Sleep(100 * time.Millisecond)
+			sut.Shutdown()
+
+			// then
+			require.Equal(t, tc.expectedRuns, runs.Load())
+		})
+	}
+}
+
+func TestSupervisor_Shutdown(t *testing.T) {
+	// given
+	sut := supervisor.New(slog.Default())
+
+	stopped := make(chan struct{})
+	sut.Go("listener", func(ctx context.Context) error {
+		<-ctx.Done()
+		close(stopped)
+		return ctx.Err()
+	})
+
+	// when
+	sut.Shutdown()
+
+	// then
+	select {
+	case <-stopped:
+	default:
+		t.Fatal("subsystem not stopped")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	tt := []struct {
+		name string
+		fn   func()
+
+		expectedPanicked bool
+	}{
+		{
+			name: "no panic",
+			fn:   func() {},
+		},
+		{
+			name: "panic",
+			fn:   func() { panic("routine failed") },
+
+			expectedPanicked: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := supervisor.Recover(slog.Default(), "test", tc.fn)
+
+			// then
+			require.Equal(t, tc.expectedPanicked, actual)
+		})
+	}
+}
+
+func TestRestarter_Run(t *testing.T) {
+	t.Run("panicking iterations are delayed until the context is canceled", func(t *testing.T) {
+		// given
+		sut := supervisor.NewRestarter(slog.Default(), "test")
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		var calls atomic.Int32
+
+		// when
+		start := time.Now()
+		sut.Run(ctx, func() {
+			calls.Add(1)
+			panic("routine failed")
+		})
+
+		// then
+		require.Equal(t, int32(1), calls.Load())
+		require.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("successful iterations are not delayed", func(t *testing.T) {
+		// given
+		sut := supervisor.NewRestarter(slog.Default(), "test")
+
+		// when
+		start := time.Now()
+		for range 3 {
+			sut.Run(context.Background(), func() {})
+		}
+
+		// then
+		require.Less(t, time.Since(start), 50*time.Millisecond)
+	})
+}
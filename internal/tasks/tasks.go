@@ -0,0 +1,266 @@
+// Package tasks runs long maintenance operations, e.g. the reprocessing of many transactions or the pruning of old
+// data, in the background. Each task has an ID by which operators can monitor its progress and cancel it.
+package tasks
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const retentionDefault = 24 * time.Hour
+
+var (
+	ErrTaskNotFound   = errors.New("task not found")
+	ErrTaskNotRunning = errors.New("task not running")
+)
+
+type Status string
+
+const (
+	StatusRunning   Status = "RUNNING"
+	StatusSucceeded Status = "SUCCEEDED"
+	StatusFailed    Status = "FAILED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Task is the state of a task. Total is the number of items processed by the task, of which Done were processed
+// successfully and Failed failed. The total of a task which doesn't know its number of items is 0.
+type Task struct {
+	ID         string
+	Name       string
+	Status     Status
+	Total      uint64
+	Done       uint64
+	Failed     uint64
+	Error      string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Percent returns the progress of the task in percent. A task without total is at 0% until it is finished.
+func (t Task) Percent() float64 {
+	if t.Status == StatusSucceeded {
+		return 100
+	}
+
+	if t.Total == 0 {
+		return 0
+	}
+
+	return min(100, float64(t.Done+t.Failed)*100/float64(t.Total))
+}
+
+// Func is the operation of a task. It reports its progress and has to stop once the context is cancelled.
+type Func func(ctx context.Context, progress *Progress) error
+
+// Progress reports the progress of a task.
+type Progress struct {
+	mu   *sync.Mutex
+	task *Task
+}
+
+// SetTotal sets the number of items processed by the task.
+func (p *Progress) SetTotal(total uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.task.Total = total
+}
+
+// Done adds to the number of items processed successfully.
+func (p *Progress) Done(n uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.task.Done += n
+}
+
+// Failed adds to the number of items which failed.
+func (p *Progress) Failed(n uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.task.Failed += n
+}
+
+type runningTask struct {
+	task   *Task
+	cancel context.CancelFunc
+}
+
+// Manager runs the tasks and keeps the state of the finished tasks for the retention duration.
+type Manager struct {
+	logger    *slog.Logger
+	now       func() time.Time
+	retention time.Duration
+
+	mu    sync.Mutex
+	tasks map[string]*runningTask
+	order []string
+
+	waitGroup *sync.WaitGroup
+	ctx       context.Context
+	cancelAll context.CancelFunc
+}
+
+// WithRetention sets the duration for which the state of a finished task is kept.
+func WithRetention(d time.Duration) func(*Manager) {
+	return func(m *Manager) {
+		m.retention = d
+	}
+}
+
+func WithNow(nowFunc func() time.Time) func(*Manager) {
+	return func(m *Manager) {
+		m.now = nowFunc
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Manager)) *Manager {
+	m := &Manager{
+		logger:    logger.With(slog.String("module", "tasks")),
+		now:       time.Now,
+		retention: retentionDefault,
+		tasks:     make(map[string]*runningTask),
+		waitGroup: &sync.WaitGroup{},
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	m.ctx, m.cancelAll = context.WithCancel(context.Background())
+
+	return m
+}
+
+// Start runs the operation as task in the background and returns the state of the started task.
+func (m *Manager) Start(name string, fn Func) Task {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune()
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	t := &runningTask{
+		task: &Task{
+			ID:        uuid.NewString(),
+			Name:      name,
+			Status:    StatusRunning,
+			StartedAt: m.now(),
+		},
+		cancel: cancel,
+	}
+	m.tasks[t.task.ID] = t
+	m.order = append(m.order, t.task.ID)
+
+	m.logger.Info("Starting task", slog.String("id", t.task.ID), slog.String("name", name))
+
+	m.waitGroup.Add(1)
+	go func() {
+		defer m.waitGroup.Done()
+		defer cancel()
+
+		err := fn(ctx, &Progress{mu: &m.mu, task: t.task})
+		m.finish(t, ctx, err)
+	}()
+
+	return *t.task
+}
+
+func (m *Manager) finish(t *runningTask, ctx context.Context, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t.task.FinishedAt = m.now()
+	switch {
+	case ctx.Err() != nil:
+		t.task.Status = StatusCancelled
+	case err != nil:
+		t.task.Status = StatusFailed
+		t.task.Error = err.Error()
+	default:
+		t.task.Status = StatusSucceeded
+	}
+
+	attrs := []any{slog.String("id", t.task.ID), slog.String("name", t.task.Name), slog.String("status", string(t.task.Status)),
+		slog.Uint64("done", t.task.Done), slog.Uint64("failed", t.task.Failed)}
+	if err != nil {
+		attrs = append(attrs, slog.String("err", err.Error()))
+	}
+	m.logger.Info("Task finished", attrs...)
+}
+
+// List returns the states of the running and retained tasks, the latest task first.
+func (m *Manager) List() []Task {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.prune()
+
+	result := make([]Task, 0, len(m.order))
+	for _, id := range slices.Backward(m.order) {
+		result = append(result, *m.tasks[id].task)
+	}
+
+	return result
+}
+
+// Get returns the state of the task.
+func (m *Manager) Get(id string) (Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, found := m.tasks[id]
+	if !found {
+		return Task{}, ErrTaskNotFound
+	}
+
+	return *t.task, nil
+}
+
+// Cancel cancels the running task. The task is cancelled once its operation returns.
+func (m *Manager) Cancel(id string) (Task, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	t, found := m.tasks[id]
+	if !found {
+		return Task{}, ErrTaskNotFound
+	}
+
+	if t.task.Status != StatusRunning {
+		return *t.task, ErrTaskNotRunning
+	}
+
+	m.logger.Info("Cancelling task", slog.String("id", id), slog.String("name", t.task.Name))
+	t.cancel()
+
+	return *t.task, nil
+}
+
+// prune drops the finished tasks older than the retention duration.
+func (m *Manager) prune() {
+	cutoff := m.now().Add(-m.retention)
+	m.order = slices.DeleteFunc(m.order, func(id string) bool {
+		task := m.tasks[id].task
+		if task.Status == StatusRunning || task.FinishedAt.After(cutoff) {
+			return false
+		}
+
+		delete(m.tasks, id)
+		return true
+	})
+}
+
+// Shutdown cancels the running tasks and waits until they returned.
+func (m *Manager) Shutdown() {
+	m.cancelAll()
+	m.waitGroup.Wait()
+}
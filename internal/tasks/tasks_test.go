@@ -0,0 +1,162 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/tasks"
+)
+
+func waitFinished(t *testing.T, sut *tasks.Manager, id string) tasks.Task {
+	t.Helper()
+
+	var task tasks.Task
+	require.Eventually(t, func() bool {
+		var err error
+		task, err = sut.Get(id)
+		require.NoError(t, err)
+		return task.Status != tasks.StatusRunning
+	}, time.Second, 5*time.Millisecond)
+
+	return task
+}
+
+func TestManager_Start(t *testing.T) {
+	tt := []struct {
+		name string
+		fn   tasks.Func
+
+		expectedStatus  tasks.Status
+		expectedError   string
+		expectedPercent float64
+	}{
+		{
+			name: "succeeded",
+			fn: func(_ context.Context, progress *tasks.Progress) error {
+				progress.SetTotal(4)
+				progress.Done(3)
+				progress.Failed(1)
+				return nil
+			},
+
+			expectedStatus:  tasks.StatusSucceeded,
+			expectedPercent: 100,
+		},
+		{
+			name: "failed",
+			fn: func(_ context.Context, progress *tasks.Progress) error {
+				progress.SetTotal(4)
+				progress.Done(1)
+				return errors.New("connection refused")
+			},
+
+			expectedStatus:  tasks.StatusFailed,
+			expectedError:   "connection refused",
+			expectedPercent: 25,
+		},
+		{
+			name: "without total",
+			fn: func(_ context.Context, _ *tasks.Progress) error {
+				return errors.New("connection refused")
+			},
+
+			expectedStatus:  tasks.StatusFailed,
+			expectedError:   "connection refused",
+			expectedPercent: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := tasks.New(slog.Default())
+			defer sut.Shutdown()
+
+			// when
+			started := sut.Start("reprocess", tc.fn)
+			actual := waitFinished(t, sut, started.ID)
+
+			// then
+			require.NotEmpty(t, started.ID)
+			require.Equal(t, "reprocess", actual.Name)
+			require.Equal(t, tc.expectedStatus, actual.Status)
+			require.Equal(t, tc.expectedError, actual.Error)
+			require.InDelta(t, tc.expectedPercent, actual.Percent(), 0.001)
+			require.False(t, actual.FinishedAt.IsZero())
+		})
+	}
+}
+
+func TestManager_Cancel(t *testing.T) {
+	// given
+	sut := tasks.New(slog.Default())
+	defer sut.Shutdown()
+
+	started := sut.Start("prune", func(ctx context.Context, _ *tasks.Progress) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// when
+	_, err := sut.Cancel(started.ID)
+	require.NoError(t, err)
+	actual := waitFinished(t, sut, started.ID)
+	_, cancelAgainErr := sut.Cancel(started.ID)
+	_, cancelUnknownErr := sut.Cancel("unknown")
+
+	// then
+	require.Equal(t, tasks.StatusCancelled, actual.Status)
+	require.ErrorIs(t, cancelAgainErr, tasks.ErrTaskNotRunning)
+	require.ErrorIs(t, cancelUnknownErr, tasks.ErrTaskNotFound)
+}
+
+func TestManager_List(t *testing.T) {
+	// given
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	sut := tasks.New(slog.Default(),
+		tasks.WithRetention(time.Hour),
+		tasks.WithNow(func() time.Time { return now }),
+	)
+	defer sut.Shutdown()
+
+	first := sut.Start("first", func(_ context.Context, _ *tasks.Progress) error { return nil })
+	waitFinished(t, sut, first.ID)
+	second := sut.Start("second", func(ctx context.Context, _ *tasks.Progress) error {
+		<-ctx.Done()
+		return nil
+	})
+
+	// when
+	listed := sut.List()
+	now = now.Add(2 * time.Hour)
+	listedAfterRetention := sut.List()
+
+	// then
+	require.Len(t, listed, 2)
+	require.Equal(t, second.ID, listed[0].ID)
+	require.Equal(t, first.ID, listed[1].ID)
+	require.Len(t, listedAfterRetention, 1)
+	require.Equal(t, second.ID, listedAfterRetention[0].ID)
+}
+
+func TestManager_Shutdown(t *testing.T) {
+	// given
+	sut := tasks.New(slog.Default())
+	started := sut.Start("replay", func(ctx context.Context, _ *tasks.Progress) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	// when
+	sut.Shutdown()
+
+	// then
+	actual, err := sut.Get(started.ID)
+	require.NoError(t, err)
+	require.Equal(t, tasks.StatusCancelled, actual.Status)
+}
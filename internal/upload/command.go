@@ -0,0 +1,52 @@
+// Package upload runs the external commands uploading local files to object storage, e.g. `aws s3 cp {file}
+// s3://bucket/arc/{name}` or `gsutil cp {file} gs://bucket/arc/{name}`.
+package upload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// FilePlaceholder is replaced with the path of the uploaded file in the arguments of the command.
+	FilePlaceholder = "{file}"
+	// NamePlaceholder is replaced with the name of the uploaded file in the arguments of the command.
+	NamePlaceholder = "{name}"
+)
+
+var ErrCommandFailed = errors.New("upload command failed")
+
+// Run uploads the file with the command, whose first element is the executable. The placeholders {file} and {name} in
+// the arguments are replaced with the path and the name of the file. The command is killed after the timeout. The
+// output of the command on stderr is returned with ErrCommandFailed if the command fails.
+func Run(ctx context.Context, command []string, file string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	replacer := strings.NewReplacer(
+		FilePlaceholder, file,
+		NamePlaceholder, filepath.Base(file),
+	)
+
+	args := make([]string, len(command)-1)
+	for i, arg := range command[1:] {
+		args[i] = replacer.Replace(arg)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, command[0], args...)
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return errors.Join(ErrCommandFailed, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String())))
+	}
+
+	return nil
+}
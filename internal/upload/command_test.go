@@ -0,0 +1,78 @@
+package upload_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/internal/upload"
+)
+
+func TestRun(t *testing.T) {
+	tt := []struct {
+		name    string
+		command func(uploadDir string) []string
+		timeout time.Duration
+
+		expectedErr      error
+		expectedErrorStr string
+		expectedUploaded bool
+	}{
+		{
+			name: "placeholders replaced",
+			command: func(uploadDir string) []string {
+				return []string{"cp", "{file}", filepath.Join(uploadDir, "copy-{name}")}
+			},
+			timeout: time.Minute,
+
+			expectedUploaded: true,
+		},
+		{
+			name: "command fails",
+			command: func(_ string) []string {
+				return []string{"sh", "-c", "echo access denied >&2; exit 1"}
+			},
+			timeout: time.Minute,
+
+			expectedErr:      upload.ErrCommandFailed,
+			expectedErrorStr: "access denied",
+		},
+		{
+			name: "timeout",
+			command: func(_ string) []string {
+				return []string{"sleep", "10"}
+			},
+			timeout: 10 * time.Millisecond,
+
+			expectedErr: upload.ErrCommandFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			uploadDir := t.TempDir()
+			file := filepath.Join(t.TempDir(), "file.bin")
+			require.NoError(t, os.WriteFile(file, []byte("content"), 0o600))
+
+			// when
+			err := upload.Run(context.Background(), tc.command(uploadDir), file, tc.timeout)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				require.ErrorContains(t, err, tc.expectedErrorStr)
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(filepath.Join(uploadDir, "copy-file.bin"))
+			require.Equal(t, tc.expectedUploaded, err == nil)
+			require.Equal(t, []byte("content"), content)
+		})
+	}
+}
@@ -25,8 +25,24 @@ var (
 	ErrRequestFailed            = errors.New("request failed")
 	ErrRequestTimedOut          = errors.New("request timed out")
 	ErrNoChainTrackersAvailable = errors.New("no chain trackers available")
+	ErrNoQuorum                 = errors.New("block header services did not reach quorum")
 )
 
+// IsMerkleRootsUnverifiable returns whether the error of a validation is that the Merkle roots of the BEEF could not
+// be verified, because no chain tracker was available, the requests to them failed or they did not reach a quorum, as
+// opposed to the chain trackers finding a Merkle root invalid.
+func IsMerkleRootsUnverifiable(err error) bool {
+	var vErr *validator.Error
+	if errors.As(err, &vErr) {
+		err = vErr.Err
+	}
+
+	return errors.Is(err, ErrNoChainTrackersAvailable) ||
+		errors.Is(err, ErrNoQuorum) ||
+		errors.Is(err, ErrRequestFailed) ||
+		errors.Is(err, ErrRequestTimedOut)
+}
+
 type ChainTracker interface {
 	IsValidRootForHeight(ctx context.Context, root *chainhash.Hash, height uint32) (bool, error)
 	CurrentHeight(ctx context.Context) (uint32, error)
@@ -39,6 +55,9 @@ type Validator struct {
 	genesisForkBLock  int32
 	tracingEnabled    bool
 	tracingAttributes []attribute.KeyValue
+
+	consolidationFeeModel *feemodel.SatoshisPerKilobyte
+	fastPathMaxSize       int
 }
 
 type Option func(d *Validator)
@@ -56,6 +75,22 @@ func WithTracer(attr ...attribute.KeyValue) func(s *Validator) {
 	}
 }
 
+// WithConsolidationFee applies the given minimum mining fee in BSV per kilobyte instead of the minimum mining fee of
+// the policy to consolidation transactions which spend outputs of mined transactions only.
+func WithConsolidationFee(minMiningTxFee float64) func(*Validator) {
+	return func(v *Validator) {
+		v.consolidationFeeModel = internalApi.FeesToFeeModel(minMiningTxFee)
+	}
+}
+
+// WithFastPath validates the small standard P2PKH transactions of at most maxSize bytes of the BEEF without parsing
+// their scripts for the policy checks, see validator.IsSmallP2PKH.
+func WithFastPath(maxSize int) func(*Validator) {
+	return func(v *Validator) {
+		v.fastPathMaxSize = maxSize
+	}
+}
+
 func New(policy *bitcoin.Settings, chainTracker ChainTracker, sv internalApi.ScriptVerifier, genesisForkBLock int32, opts ...Option) *Validator {
 	v := &Validator{
 		policy:           policy,
@@ -91,13 +126,17 @@ func (v *Validator) ValidateTransaction(ctx context.Context, beefTx *sdkTx.Beef,
 
 		tx := btx.Transaction
 
-		vErr = validator.CommonValidateTransaction(v.policy, tx)
+		if v.fastPathMaxSize > 0 && validator.IsSmallP2PKH(tx, v.fastPathMaxSize) {
+			vErr = validator.CommonValidateSmallP2PKH(v.policy, tx)
+		} else {
+			vErr = validator.CommonValidateTransaction(v.policy, tx)
+		}
 		if vErr != nil {
 			return tx, vErr
 		}
 
 		if feeValidation == validator.StandardFeeValidation || feeValidation == validator.CumulativeFeeValidation {
-			vErr = standardCheckFees(tx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee))
+			vErr = v.checkFees(tx)
 			if vErr != nil {
 				return tx, vErr
 			}
@@ -112,7 +151,7 @@ func (v *Validator) ValidateTransaction(ctx context.Context, beefTx *sdkTx.Beef,
 	}
 
 	if feeValidation == validator.CumulativeFeeValidation {
-		vErr = cumulativeCheckFees(beefTx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee))
+		vErr = cumulativeCheckFees(beefTx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee), v.isDiscountedConsolidation)
 		if vErr != nil {
 			return nil, vErr
 		}
@@ -140,35 +179,60 @@ func (v *Validator) ValidateTransaction(ctx context.Context, beefTx *sdkTx.Beef,
 	return nil, nil
 }
 
-func standardCheckFees(tx *sdkTx.Transaction, feeModel sdkTx.FeeModel) *validator.Error {
-	expectedFees, err := feeModel.ComputeFee(tx)
-	if err != nil {
-		return validator.NewError(err, api.ErrStatusFees)
-	}
-	outputSatoshis := tx.TotalOutputSatoshis()
-	inputSatoshis, err := tx.TotalInputSatoshis()
-	if err != nil {
-		return validator.NewError(err, api.ErrStatusFees)
+func (v *Validator) checkFees(tx *sdkTx.Transaction) *validator.Error {
+	if v.isDiscountedConsolidation(tx) {
+		feeDetails, err := validator.NewConsolidationFeeDetails(tx, v.consolidationFeeModel)
+		return checkFeeDetails(feeDetails, err)
 	}
 
-	actualFeePaid := inputSatoshis - outputSatoshis
+	return standardCheckFees(tx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee))
+}
 
-	if inputSatoshis < outputSatoshis {
-		// force an error without wrong negative values
-		actualFeePaid = 0
+// isDiscountedConsolidation returns whether the consolidation fee is applied to the transaction. This is the case if
+// the consolidation fee is configured and the transaction is a consolidation transaction spending outputs of mined
+// transactions only.
+func (v *Validator) isDiscountedConsolidation(tx *sdkTx.Transaction) bool {
+	if v.consolidationFeeModel == nil || !validator.IsConsolidation(tx, v.policy) {
+		return false
 	}
 
-	if actualFeePaid < expectedFees {
-		err := fmt.Errorf("transaction fee of %d sat is too low - minimum expected fee is %d sat", actualFeePaid, expectedFees)
+	for _, input := range tx.Inputs {
+		if input.SourceTransaction == nil || input.SourceTransaction.MerklePath == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func standardCheckFees(tx *sdkTx.Transaction, feeModel *feemodel.SatoshisPerKilobyte) *validator.Error {
+	feeDetails, err := validator.NewFeeDetails(tx, feeModel)
+	return checkFeeDetails(feeDetails, err)
+}
+
+func checkFeeDetails(feeDetails *validator.FeeDetails, err error) *validator.Error {
+	if err != nil {
 		return validator.NewError(err, api.ErrStatusFees)
 	}
 
+	if feeDetails.ActualFee < feeDetails.RequiredFee {
+		err := fmt.Errorf("transaction fee of %d sat is too low - minimum expected fee is %d sat", feeDetails.ActualFee, feeDetails.RequiredFee)
+		vErr := validator.NewError(err, api.ErrStatusFees)
+		vErr.Fee = feeDetails
+		return vErr
+	}
+
 	return nil
 }
 
-func cumulativeCheckFees(beefTx *sdkTx.Beef, feeModel *feemodel.SatoshisPerKilobyte) *validator.Error {
+// cumulativeCheckFees checks the cumulative fee of all unmined transactions. Consolidation transactions to which the
+// consolidation fee is applied are checked on their own and are excluded.
+func cumulativeCheckFees(beefTx *sdkTx.Beef, feeModel *feemodel.SatoshisPerKilobyte, isDiscountedConsolidation func(tx *sdkTx.Transaction) bool) *validator.Error {
 	cumulativePaidFee := uint64(0)
 	expectedFees := uint64(0)
+	cumulativeSize := 0
+
+	var lastTx *sdkTx.Transaction
 
 	for _, bTx := range beefTx.Transactions {
 		if bTx.DataFormat != sdkTx.RawTx {
@@ -176,6 +240,9 @@ func cumulativeCheckFees(beefTx *sdkTx.Beef, feeModel *feemodel.SatoshisPerKilob
 		}
 
 		tx := bTx.Transaction
+		if isDiscountedConsolidation != nil && isDiscountedConsolidation(tx) {
+			continue
+		}
 
 		totalOutputSatoshis := tx.TotalOutputSatoshis()
 		totalInputSatoshis, err := tx.TotalInputSatoshis()
@@ -190,11 +257,22 @@ func cumulativeCheckFees(beefTx *sdkTx.Beef, feeModel *feemodel.SatoshisPerKilob
 			return validator.NewError(err, api.ErrStatusCumulativeFees)
 		}
 		expectedFees += expectedFee
+		cumulativeSize += tx.Size()
+		lastTx = tx
 	}
 
 	if expectedFees > cumulativePaidFee {
 		err := fmt.Errorf("cumulative transaction fee of %d sat is too low - minimum expected fee is %d sat", cumulativePaidFee, expectedFees)
-		return validator.NewError(err, api.ErrStatusCumulativeFees)
+		vErr := validator.NewError(err, api.ErrStatusCumulativeFees)
+
+		// the fee details refer to the last unmined transaction which is the one paying for its ancestors
+		feeDetails, feeErr := validator.NewFeeDetails(lastTx, feeModel)
+		if feeErr == nil {
+			feeDetails.AddCumulativeRule(feeModel.Satoshis, uint64(cumulativeSize), cumulativePaidFee, expectedFees) // #nosec G115
+			vErr.Fee = feeDetails
+		}
+
+		return vErr
 	}
 
 	return nil
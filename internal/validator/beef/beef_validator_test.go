@@ -1,3 +1,7 @@
+//go:build cgo && (linux || darwin) && (amd64 || arm64)
+
+// the expected script errors are those of the BDK
+
 package beef
 
 import (
@@ -14,6 +18,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bitcoin-sv/arc/internal/beef"
+	"github.com/bitcoin-sv/arc/internal/script_verifier"
 	"github.com/bitcoin-sv/arc/internal/testdata"
 	validation "github.com/bitcoin-sv/arc/internal/validator"
 	"github.com/bitcoin-sv/arc/internal/validator/beef/mocks"
@@ -120,7 +125,7 @@ func TestBeefValidator(t *testing.T) {
 				},
 			}
 
-			se := goscript.NewScriptEngine("regtest")
+			se := script_verifier.New("regtest")
 			sut := New(getPolicy(1), ctMock, se, int32(10000))
 
 			// when
@@ -176,7 +181,7 @@ func TestValidateScripts(t *testing.T) {
 			beefTx, txID, err := beef.DecodeBEEF(beefHex)
 			require.NoError(t, err)
 
-			se := goscript.NewScriptEngine("regtest")
+			se := script_verifier.New("regtest")
 			// when
 			for _, btx := range beefTx.Transactions {
 				if btx.Transaction.TxID().String() != txID {
@@ -253,7 +258,7 @@ func TestCumulativeCheckFees(t *testing.T) {
 			require.NoError(t, err)
 
 			// when
-			actualError := cumulativeCheckFees(beefTx, tc.feeModel)
+			actualError := cumulativeCheckFees(beefTx, tc.feeModel, nil)
 
 			// then
 			if tc.expectedError == nil {
@@ -266,3 +271,47 @@ func TestCumulativeCheckFees(t *testing.T) {
 		})
 	}
 }
+
+func TestIsMerkleRootsUnverifiable(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+
+		expected bool
+	}{
+		{
+			name: "no chain trackers available",
+			err:  validation.NewError(ErrNoChainTrackersAvailable, api.ErrStatusBeefValidationMerkleRoots),
+
+			expected: true,
+		},
+		{
+			name: "no quorum",
+			err:  errors.Join(ErrNoQuorum, ErrRequestFailed),
+
+			expected: true,
+		},
+		{
+			name: "request timed out",
+			err:  errors.Join(ErrBEEFVerificationTimedOut, ErrRequestTimedOut),
+
+			expected: true,
+		},
+		{
+			name: "merkle roots invalid",
+			err:  validation.NewError(ErrBEEFVerificationFailed, api.ErrStatusBeefValidationFailedBeefInvalid),
+
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := IsMerkleRootsUnverifiable(tc.err)
+
+			// then
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,383 @@
+// Package bounds checks the counts and lengths encoded in submitted transactions and BEEFs against the size of the
+// submission before they are parsed. The parsers allocate the encoded lengths up front, so a malformed submission of a
+// few bytes could otherwise make them allocate more memory than is available, which can't be recovered from.
+package bounds
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	beefV1     uint32 = 4022206465
+	beefV2     uint32 = 4022206466
+	atomicBEEF uint32 = 0x01010101
+
+	extendedFormatMarker uint32 = 0xEF
+
+	outpointSize = 36
+	hashSize     = 32
+	// a leaf of a BUMP has at least an offset and flags
+	minLeafSize = 2
+
+	txIDOnly          = 2
+	rawTxAndBumpIndex = 1
+)
+
+var ErrOutOfBounds = errors.New("encoded length exceeds the size of the submission")
+
+// errTruncated stops the check at the end of a truncated submission, the parser rejects it with its own error.
+var errTruncated = errors.New("submission truncated")
+
+type reader struct {
+	b   []byte
+	pos int
+}
+
+func (r *reader) remaining() uint64 {
+	return uint64(len(r.b) - r.pos)
+}
+
+func (r *reader) skip(n uint64) error {
+	if n > r.remaining() {
+		return errTruncated
+	}
+
+	r.pos += int(n)
+
+	return nil
+}
+
+func (r *reader) readByte() (byte, error) {
+	if r.remaining() < 1 {
+		return 0, errTruncated
+	}
+
+	b := r.b[r.pos]
+	r.pos++
+
+	return b, nil
+}
+
+func (r *reader) readUint32() (uint32, error) {
+	if r.remaining() < 4 {
+		return 0, errTruncated
+	}
+
+	v := binary.LittleEndian.Uint32(r.b[r.pos:])
+	r.pos += 4
+
+	return v, nil
+}
+
+func (r *reader) readVarInt() (uint64, error) {
+	prefix, err := r.readByte()
+	if err != nil {
+		return 0, err
+	}
+
+	var size uint64
+	switch prefix {
+	case 0xff:
+		size = 8
+	case 0xfe:
+		size = 4
+	case 0xfd:
+		size = 2
+	default:
+		return uint64(prefix), nil
+	}
+
+	if r.remaining() < size {
+		return 0, errTruncated
+	}
+
+	var v uint64
+	for i := uint64(0); i < size; i++ {
+		v |= uint64(r.b[r.pos+int(i)]) << (8 * i)
+	}
+	r.pos += int(size)
+
+	return v, nil
+}
+
+// readLength reads a count of elements of at least the given size, which the remaining bytes have to be able to hold.
+func (r *reader) readLength(elementSize uint64) (uint64, error) {
+	n, err := r.readVarInt()
+	if err != nil {
+		return 0, err
+	}
+
+	if n > r.remaining()/elementSize {
+		return 0, errors.Join(ErrOutOfBounds, fmt.Errorf("length %d at byte %d, %d bytes remaining", n, r.pos, r.remaining()))
+	}
+
+	return n, nil
+}
+
+func (r *reader) script() error {
+	length, err := r.readLength(1)
+	if err != nil {
+		return err
+	}
+
+	return r.skip(length)
+}
+
+// transaction follows the parser of the transactions in raw and extended format.
+func (r *reader) transaction() error {
+	err := r.skip(4)
+	if err != nil {
+		return err
+	}
+
+	inputCount, err := r.readVarInt()
+	if err != nil {
+		return err
+	}
+
+	extended := false
+	var outputCount uint64
+	if inputCount == 0 {
+		outputCount, err = r.readVarInt()
+		if err != nil {
+			return err
+		}
+
+		if outputCount == 0 {
+			if r.remaining() < 4 {
+				return errTruncated
+			}
+			marker := binary.BigEndian.Uint32(r.b[r.pos:])
+			r.pos += 4
+
+			if marker != extendedFormatMarker {
+				return nil
+			}
+
+			extended = true
+			inputCount, err = r.readVarInt()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for i := uint64(0); i < inputCount; i++ {
+		err = r.input(extended)
+		if err != nil {
+			return err
+		}
+	}
+
+	if inputCount > 0 || extended {
+		outputCount, err = r.readVarInt()
+		if err != nil {
+			return err
+		}
+	}
+
+	for i := uint64(0); i < outputCount; i++ {
+		err = r.skip(8)
+		if err != nil {
+			return err
+		}
+		err = r.script()
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.skip(4)
+}
+
+func (r *reader) input(extended bool) error {
+	err := r.skip(outpointSize)
+	if err != nil {
+		return err
+	}
+
+	err = r.script()
+	if err != nil {
+		return err
+	}
+
+	err = r.skip(4)
+	if err != nil {
+		return err
+	}
+
+	if !extended {
+		return nil
+	}
+
+	err = r.skip(8)
+	if err != nil {
+		return err
+	}
+
+	return r.script()
+}
+
+func (r *reader) bumps() error {
+	count, err := r.readLength(1)
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		_, err = r.readVarInt()
+		if err != nil {
+			return err
+		}
+
+		treeHeight, err := r.readByte()
+		if err != nil {
+			return err
+		}
+
+		for level := 0; level < int(treeHeight); level++ {
+			err = r.leaves()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) leaves() error {
+	count, err := r.readLength(minLeafSize)
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		_, err = r.readVarInt()
+		if err != nil {
+			return err
+		}
+
+		flags, err := r.readByte()
+		if err != nil {
+			return err
+		}
+
+		if flags&1 == 0 {
+			err = r.skip(hashSize)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) beefTransactions(version uint32) error {
+	count, err := r.readVarInt()
+	if err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < count; i++ {
+		if version == beefV1 {
+			err = r.transaction()
+			if err != nil {
+				return err
+			}
+
+			hasBump, err := r.readByte()
+			if err != nil {
+				return err
+			}
+			if hasBump != 0 {
+				_, err = r.readVarInt()
+				if err != nil {
+					return err
+				}
+			}
+
+			continue
+		}
+
+		format, err := r.readByte()
+		if err != nil {
+			return err
+		}
+
+		switch format {
+		case txIDOnly:
+			err = r.skip(hashSize)
+		case rawTxAndBumpIndex:
+			_, err = r.readVarInt()
+			if err == nil {
+				err = r.transaction()
+			}
+		case 0:
+			err = r.transaction()
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *reader) beef() error {
+	version, err := r.readUint32()
+	if err != nil {
+		return err
+	}
+
+	// the atomic BEEF prefixes the BEEF with the ID of the subject transaction
+	for range 2 {
+		if version != atomicBEEF {
+			break
+		}
+
+		err = r.skip(hashSize)
+		if err != nil {
+			return err
+		}
+
+		version, err = r.readUint32()
+		if err != nil {
+			return err
+		}
+	}
+
+	if version != beefV1 && version != beefV2 {
+		return nil
+	}
+
+	err = r.bumps()
+	if err != nil {
+		return err
+	}
+
+	return r.beefTransactions(version)
+}
+
+// CheckTransaction checks the lengths of the transaction in raw or extended format at the start of the bytes.
+func CheckTransaction(b []byte) error {
+	return check((&reader{b: b}).transaction())
+}
+
+// CheckBEEF checks the lengths of the BUMPs and transactions of the BEEF.
+func CheckBEEF(b []byte) error {
+	return check((&reader{b: b}).beef())
+}
+
+func check(err error) error {
+	if errors.Is(err, errTruncated) {
+		return nil
+	}
+
+	return err
+}
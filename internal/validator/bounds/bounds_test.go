@@ -0,0 +1,124 @@
+package bounds
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rawTx      = "0100000001358eb38f1f910e76b33788ff9395a5d2af87721e950ebd3d60cf64bb43e77485010000006a47304402203be8a3ba74e7b770afa2addeff1bbc1eaeb0cedf6b4096c8eb7ec29f1278752602205dc1d1bedf2cab46096bb328463980679d4ce2126cdd6ed191d6224add9910884121021358f252895263cd7a85009fcc615b57393daf6f976662319f7d0c640e6189fcffffffff0200000000000000001976a914948194e936d3a20b8d78dbac7476167c38b76bf888ac22020000000000001976a914a6a3a4691b0f1e5b7ec0437732b16c58b5083cfc88ac00000000"
+	beefPrefix = "0100beef"
+)
+
+func TestCheckTransaction(t *testing.T) {
+	validTx, err := hex.DecodeString(rawTx)
+	require.NoError(t, err)
+
+	tt := []struct {
+		name string
+		data []byte
+
+		expectedError error
+	}{
+		{
+			name: "valid transaction",
+			data: validTx,
+		},
+		{
+			name: "truncated transaction",
+			data: validTx[:len(validTx)-2],
+		},
+		{
+			name: "extended format marker without inputs",
+			data: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xef, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		},
+		{
+			name: "unlocking script length out of bounds",
+			data: append(validTx[:41:41], 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0f),
+
+			expectedError: ErrOutOfBounds,
+		},
+		{
+			name: "locking script length out of bounds",
+			data: []byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0xff, 0xff, 0xff, 0x7f},
+
+			expectedError: ErrOutOfBounds,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actualErr := CheckTransaction(tc.data)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, actualErr, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, actualErr)
+		})
+	}
+}
+
+func TestCheckBEEF(t *testing.T) {
+	validTx, err := hex.DecodeString(rawTx)
+	require.NoError(t, err)
+	prefix, err := hex.DecodeString(beefPrefix)
+	require.NoError(t, err)
+
+	withoutBumps := append(append(append([]byte{}, prefix...), 0x00, 0x01), validTx...)
+	withoutBumps = append(withoutBumps, 0x00)
+
+	tt := []struct {
+		name string
+		data []byte
+
+		expectedError error
+	}{
+		{
+			name: "BEEF without BUMPs",
+			data: withoutBumps,
+		},
+		{
+			name: "BUMP count out of bounds",
+			data: append(append([]byte{}, prefix...), 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x0f),
+
+			expectedError: ErrOutOfBounds,
+		},
+		{
+			name: "leaf count out of bounds",
+			data: append(append([]byte{}, prefix...), 0x01, 0x64, 0x01, 0xfe, 0xff, 0xff, 0xff, 0x0f),
+
+			expectedError: ErrOutOfBounds,
+		},
+		{
+			name: "script length of transaction out of bounds",
+			data: append(append(append([]byte{}, prefix...), 0x00, 0x01), append(validTx[:41:41], 0xfe, 0xff, 0xff, 0xff, 0x0f)...),
+
+			expectedError: ErrOutOfBounds,
+		},
+		{
+			name: "unknown version",
+			data: []byte{0x01, 0x00, 0xbe, 0xee, 0xff},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actualErr := CheckBEEF(tc.data)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, actualErr, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, actualErr)
+		})
+	}
+}
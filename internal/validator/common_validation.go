@@ -40,6 +40,27 @@ func CommonValidateTransaction(policy *bitcoin.Settings, tx *sdkTx.Transaction)
 	//
 	txSize := tx.Size()
 
+	if vErr := commonValidateFields(policy, tx, txSize); vErr != nil {
+		return vErr
+	}
+
+	// 8) The number of signature operations (SIGOPS) contained in the transaction is less than the signature operation limit
+	if err := sigOpsCheck(tx, policy); err != nil {
+		return NewError(err, api.ErrStatusMalformed)
+	}
+
+	// 9) The unlocking script (scriptSig) can only push numbers on the stack
+	if err := pushDataCheck(tx); err != nil {
+		return NewError(err, api.ErrStatusMalformed)
+	}
+
+	// everything checks out
+	return nil
+}
+
+// commonValidateFields validates the size, the inputs and the outputs of the transaction, i.e. the checks which don't
+// depend on the scripts of the transaction.
+func commonValidateFields(policy *bitcoin.Settings, tx *sdkTx.Transaction, txSize int) *Error {
 	// 1) Neither lists of inputs or outputs are empty
 	if len(tx.Inputs) == 0 || len(tx.Outputs) == 0 {
 		return NewError(ErrNoInputsOrOutputs, api.ErrStatusInputs)
@@ -70,17 +91,6 @@ func CommonValidateTransaction(policy *bitcoin.Settings, tx *sdkTx.Transaction)
 		return NewError(ErrTxSizeLessThanMinSize, api.ErrStatusMalformed)
 	}
 
-	// 8) The number of signature operations (SIGOPS) contained in the transaction is less than the signature operation limit
-	if err := sigOpsCheck(tx, policy); err != nil {
-		return NewError(err, api.ErrStatusMalformed)
-	}
-
-	// 9) The unlocking script (scriptSig) can only push numbers on the stack
-	if err := pushDataCheck(tx); err != nil {
-		return NewError(err, api.ErrStatusMalformed)
-	}
-
-	// everything checks out
 	return nil
 }
 
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/ordishs/go-bitcoin"
+)
+
+// IsConsolidation returns whether the transaction is a consolidation transaction according to the consolidation
+// settings of the policy. A consolidation transaction has at least `minconsolidationfactor` times as many inputs as
+// outputs, the locking scripts it spends are at least `minconsolidationfactor` times as large as the locking scripts
+// it creates and none of its unlocking scripts exceeds `maxconsolidationinputscriptsize`. Unless
+// `acceptnonstdconsolidationinput` is set, all spent outputs have to be P2PKH. The source outputs of the inputs have
+// to be known, otherwise the transaction is not classified as consolidation.
+//
+// Whether the spent outputs are confirmed is not checked here but by the validators.
+func IsConsolidation(tx *sdkTx.Transaction, policy *bitcoin.Settings) bool {
+	if policy == nil || policy.MinConsolidationFactor <= 0 || len(tx.Inputs) == 0 || len(tx.Outputs) == 0 {
+		return false
+	}
+
+	factor := policy.MinConsolidationFactor
+	if len(tx.Inputs) < factor*len(tx.Outputs) {
+		return false
+	}
+
+	var inputScriptsSize int
+	for _, input := range tx.Inputs {
+		if input.UnlockingScript == nil || len(*input.UnlockingScript) > policy.MaxConsolidationInputScriptSize {
+			return false
+		}
+
+		sourceScript := input.SourceTxScript()
+		if sourceScript == nil {
+			return false
+		}
+
+		if !policy.AcceptNonStdConsolidationInput && !sourceScript.IsP2PKH() {
+			return false
+		}
+
+		inputScriptsSize += len(*sourceScript)
+	}
+
+	var outputScriptsSize int
+	for _, output := range tx.Outputs {
+		if output.LockingScript != nil {
+			outputScriptsSize += len(*output.LockingScript)
+		}
+	}
+
+	return inputScriptsSize >= factor*outputScriptsSize
+}
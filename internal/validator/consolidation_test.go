@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/ordishs/go-bitcoin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newConsolidationTx(inputs int, outputs int, unlockingScriptSize int, sourceScript *script.Script) *sdkTx.Transaction {
+	tx := sdkTx.NewTransaction()
+	for i := range inputs {
+		unlockingScript := script.Script(make([]byte, unlockingScriptSize))
+		input := &sdkTx.TransactionInput{
+			SourceTXID:       &chainhash.Hash{byte(i)},
+			SourceTxOutIndex: 0,
+			UnlockingScript:  &unlockingScript,
+		}
+		if sourceScript != nil {
+			input.SetSourceTxOutput(&sdkTx.TransactionOutput{Satoshis: 1000, LockingScript: sourceScript})
+		}
+		tx.AddInput(input)
+	}
+
+	for range outputs {
+		tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 1000, LockingScript: validLockingScript})
+	}
+
+	return tx
+}
+
+func TestIsConsolidation(t *testing.T) {
+	policy := &bitcoin.Settings{
+		MinConsolidationFactor:          20,
+		MaxConsolidationInputScriptSize: 150,
+	}
+
+	tt := []struct {
+		name   string
+		tx     *sdkTx.Transaction
+		policy *bitcoin.Settings
+
+		expected bool
+	}{
+		{
+			name:   "consolidation",
+			tx:     newConsolidationTx(20, 1, 107, validLockingScript),
+			policy: policy,
+
+			expected: true,
+		},
+		{
+			name:   "too few inputs",
+			tx:     newConsolidationTx(39, 2, 107, validLockingScript),
+			policy: policy,
+
+			expected: false,
+		},
+		{
+			name:   "unlocking script too large",
+			tx:     newConsolidationTx(20, 1, 151, validLockingScript),
+			policy: policy,
+
+			expected: false,
+		},
+		{
+			name:   "non-standard input",
+			tx:     newConsolidationTx(20, 1, 107, opReturnLockingScript),
+			policy: policy,
+
+			expected: false,
+		},
+		{
+			name: "non-standard input accepted",
+			tx:   newConsolidationTx(20, 1, 107, &script.Script{0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51, 0x51}),
+			policy: &bitcoin.Settings{
+				MinConsolidationFactor:          20,
+				MaxConsolidationInputScriptSize: 150,
+				AcceptNonStdConsolidationInput:  true,
+			},
+
+			expected: true,
+		},
+		{
+			name:   "source outputs not known",
+			tx:     newConsolidationTx(20, 1, 107, nil),
+			policy: policy,
+
+			expected: false,
+		},
+		{
+			name:   "consolidation disabled",
+			tx:     newConsolidationTx(20, 1, 107, validLockingScript),
+			policy: &bitcoin.Settings{MaxConsolidationInputScriptSize: 150},
+
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := IsConsolidation(tc.tx, tc.policy)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package validator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// CrashTargetBEEF is the fuzz target reproducing crashes of the BEEF parser
+	CrashTargetBEEF = "FuzzDecodeBEEF"
+	// CrashTargetTransaction is the fuzz target reproducing crashes of the raw and extended format parser
+	CrashTargetTransaction = "FuzzDecodeTransaction"
+
+	corpusFileHeader = "go test fuzz v1\n"
+)
+
+// CrashReporter persists the submissions whose decoding panicked.
+type CrashReporter interface {
+	ReportCrash(target string, input []byte, err error)
+}
+
+// FileCrashReporter writes the submissions whose decoding panicked to a directory per fuzz target in the format of the
+// Go fuzzing corpus, so that a crash can be reproduced by copying its file to testdata/fuzz of the package of the target.
+type FileCrashReporter struct {
+	logger *slog.Logger
+	dir    string
+}
+
+func NewFileCrashReporter(logger *slog.Logger, dir string) *FileCrashReporter {
+	return &FileCrashReporter{
+		logger: logger.With(slog.String("module", "crash-reporter")),
+		dir:    dir,
+	}
+}
+
+// ReportCrash writes the input to a file named by its hash, so that repeated submissions of the same input are
+// persisted only once.
+func (r *FileCrashReporter) ReportCrash(target string, input []byte, err error) {
+	hash := sha256.Sum256(input)
+	path := filepath.Join(r.dir, target, hex.EncodeToString(hash[:8]))
+
+	writeErr := writeCorpusFile(path, input)
+	if writeErr != nil {
+		r.logger.Error("Failed to persist crash report", slog.String("target", target), slog.String("err", writeErr.Error()))
+		return
+	}
+
+	r.logger.Warn("Decoding of submission panicked", slog.String("target", target), slog.String("file", path), slog.String("err", err.Error()))
+}
+
+func writeCorpusFile(path string, input []byte) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	content := fmt.Sprintf("%s[]byte(%s)\n", corpusFileHeader, strconv.Quote(string(input)))
+
+	return os.WriteFile(path, []byte(content), 0o600)
+}
@@ -0,0 +1,31 @@
+package validator
+
+import (
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCrashReporter_ReportCrash(t *testing.T) {
+	// given
+	dir := t.TempDir()
+	sut := NewFileCrashReporter(slog.Default(), dir)
+	input := []byte{0x01, 0x00, 0xbe, 0xef, '"'}
+
+	// when
+	sut.ReportCrash(CrashTargetBEEF, input, errors.New("index out of range"))
+	sut.ReportCrash(CrashTargetBEEF, input, errors.New("index out of range"))
+
+	// then
+	files, err := os.ReadDir(filepath.Join(dir, CrashTargetBEEF))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	content, err := os.ReadFile(filepath.Join(dir, CrashTargetBEEF, files[0].Name()))
+	require.NoError(t, err)
+	require.Equal(t, "go test fuzz v1\n[]byte(\"\\x01\\x00\\xbe\\xef\\\"\")\n", string(content))
+}
@@ -0,0 +1,31 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/internal/validator/bounds"
+)
+
+var ErrTxPanic = errors.New("panic while parsing transaction")
+
+// DecodeTransaction decodes the transaction in raw or extended format at the start of the bytes and returns the
+// number of bytes used. Lengths exceeding the size of the bytes are rejected before parsing, a panic of the parser on
+// malformed bytes is returned as ErrTxPanic.
+func DecodeTransaction(b []byte) (tx *sdkTx.Transaction, bytesUsed int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			tx, bytesUsed = nil, 0
+			err = errors.Join(ErrTxPanic, fmt.Errorf("%v", r))
+		}
+	}()
+
+	err = bounds.CheckTransaction(b)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return sdkTx.NewTransactionFromStream(b)
+}
@@ -0,0 +1,25 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// FuzzDecodeTransaction ensures that malformed submissions in raw and extended format don't panic. The seed corpus is
+// in testdata/fuzz, crashes reported by the API can be added to it to reproduce them.
+func FuzzDecodeTransaction(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// when
+		tx, bytesUsed, err := DecodeTransaction(data)
+
+		// then
+		if err != nil {
+			return
+		}
+
+		require.NotNil(t, tx)
+		require.Positive(t, bytesUsed)
+		require.LessOrEqual(t, bytesUsed, len(data))
+	})
+}
@@ -30,6 +30,8 @@ type DefaultValidator struct {
 	tracingEnabled          bool
 	tracingAttributes       []attribute.KeyValue
 	standardFormatSupported bool
+	consolidationFeeModel   *feemodel.SatoshisPerKilobyte
+	fastPathMaxSize         int
 }
 
 func New(policy *bitcoin.Settings, finder validator.TxFinderI, sv internalApi.ScriptVerifier, genesisForkBLock int32, opts ...Option) *DefaultValidator {
@@ -55,6 +57,24 @@ func WithStandardFormatSupported(standardFormatSupported bool) func(*DefaultVali
 	}
 }
 
+// WithConsolidationFee applies the given minimum mining fee in BSV per kilobyte instead of the minimum mining fee of
+// the policy to consolidation transactions which spend confirmed outputs only. A fee of 0 accepts such consolidation
+// transactions without fee.
+func WithConsolidationFee(minMiningTxFee float64) func(*DefaultValidator) {
+	return func(d *DefaultValidator) {
+		d.consolidationFeeModel = internalApi.FeesToFeeModel(minMiningTxFee)
+	}
+}
+
+// WithFastPath validates small standard P2PKH transactions of at most maxSize bytes without parsing their scripts for
+// the policy checks, see validator.IsSmallP2PKH. The scripts are still verified and the fees checked, with cumulative
+// fee validation also against the unmined ancestors.
+func WithFastPath(maxSize int) func(*DefaultValidator) {
+	return func(d *DefaultValidator) {
+		d.fastPathMaxSize = maxSize
+	}
+}
+
 func WithTracer(attr ...attribute.KeyValue) func(s *DefaultValidator) {
 	return func(a *DefaultValidator) {
 		a.tracingEnabled = true
@@ -95,7 +115,11 @@ func (v *DefaultValidator) ValidateTransaction(ctx context.Context, tx *sdkTx.Tr
 	}
 
 	// The rest of the validation steps
-	vErr = validator.CommonValidateTransaction(v.policy, tx)
+	if v.fastPathMaxSize > 0 && validator.IsSmallP2PKH(tx, v.fastPathMaxSize) {
+		vErr = validator.CommonValidateSmallP2PKH(v.policy, tx)
+	} else {
+		vErr = validator.CommonValidateTransaction(v.policy, tx)
+	}
 	if vErr != nil {
 		return vErr
 	}
@@ -104,11 +128,11 @@ func (v *DefaultValidator) ValidateTransaction(ctx context.Context, tx *sdkTx.Tr
 	// 11) Reject if transaction fee would be too low (minRelayTxFee) to get into an empty block.
 	switch feeValidation {
 	case validator.StandardFeeValidation:
-		if vErr = checkStandardFees(tx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee)); vErr != nil {
+		if vErr = v.checkFees(ctx, tx); vErr != nil {
 			return vErr
 		}
 	case validator.CumulativeFeeValidation:
-		if vErr = checkStandardFees(tx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee)); vErr != nil {
+		if vErr = v.checkFees(ctx, tx); vErr != nil {
 			return vErr
 		}
 		txSet, err := getUnminedAncestors(ctx, v.txFinder, tx, v.tracingEnabled, v.tracingAttributes...)
@@ -176,15 +200,39 @@ func isExtended(tx *sdkTx.Transaction) bool {
 	return true
 }
 
-func checkStandardFees(tx *sdkTx.Transaction, feeModel sdkTx.FeeModel) *validator.Error {
-	feesOK, expFeesPaid, actualFeePaid, err := isFeePaidEnough(feeModel, tx)
+// checkFees checks the fee of a consolidation transaction spending confirmed outputs only against the consolidation fee
+// if configured and the fee of any other transaction against the minimum mining fee of the policy.
+func (v *DefaultValidator) checkFees(ctx context.Context, tx *sdkTx.Transaction) *validator.Error {
+	if v.consolidationFeeModel != nil && validator.IsConsolidation(tx, v.policy) {
+		unminedParents, err := hasUnminedParents(ctx, v.txFinder, tx)
+		if err == nil && !unminedParents {
+			return checkConsolidationFees(tx, v.consolidationFeeModel)
+		}
+	}
+
+	return checkStandardFees(tx, internalApi.FeesToFeeModel(v.policy.MinMiningTxFee))
+}
+
+func checkStandardFees(tx *sdkTx.Transaction, feeModel *feemodel.SatoshisPerKilobyte) *validator.Error {
+	feeDetails, err := validator.NewFeeDetails(tx, feeModel)
+	return checkFeeDetails(feeDetails, err)
+}
+
+func checkConsolidationFees(tx *sdkTx.Transaction, feeModel *feemodel.SatoshisPerKilobyte) *validator.Error {
+	feeDetails, err := validator.NewConsolidationFeeDetails(tx, feeModel)
+	return checkFeeDetails(feeDetails, err)
+}
+
+func checkFeeDetails(feeDetails *validator.FeeDetails, err error) *validator.Error {
 	if err != nil {
 		return validator.NewError(err, api.ErrStatusFees)
 	}
 
-	if !feesOK {
-		err = errors.Join(ErrTxFeeTooLow, fmt.Errorf("minimum expected fee: %d, actual fee: %d", expFeesPaid, actualFeePaid))
-		return validator.NewError(err, api.ErrStatusFees)
+	if feeDetails.ActualFee < feeDetails.RequiredFee {
+		err = errors.Join(ErrTxFeeTooLow, fmt.Errorf("minimum expected fee: %d, actual fee: %d", feeDetails.RequiredFee, feeDetails.ActualFee))
+		vErr := validator.NewError(err, api.ErrStatusFees)
+		vErr.Fee = feeDetails
+		return vErr
 	}
 
 	return nil
@@ -202,6 +250,7 @@ func checkCumulativeFees(ctx context.Context, txSet map[string]*sdkTx.Transactio
 
 	expectedCumulativeFee := uint64(0)
 	cumulativePaidFeeAncestors := uint64(0)
+	cumulativeSize := 0
 
 	totalInputTx, err := tx.TotalInputSatoshis()
 	if err != nil {
@@ -222,6 +271,7 @@ func checkCumulativeFees(ctx context.Context, txSet map[string]*sdkTx.Transactio
 			return validator.NewError(e, api.ErrStatusCumulativeFees)
 		}
 		expectedCumulativeFee += expectedFeeTx
+		cumulativeSize += txFromSet.Size()
 		total, err := txFromSet.TotalInputSatoshis()
 		if err != nil {
 			e := fmt.Errorf("failed to get total input satoshis: %w", err)
@@ -241,31 +291,18 @@ func checkCumulativeFees(ctx context.Context, txSet map[string]*sdkTx.Transactio
 
 	if expectedCumulativeFee > paidFeeTx+cumulativePaidFeeAncestors {
 		err = errors.Join(ErrTxFeeTooLow, fmt.Errorf("minimum expected cumulative fee: %d, actual cumulative fee: %d", expectedCumulativeFee, actualCumulativeFee))
-		return validator.NewError(err, api.ErrStatusCumulativeFees)
-	}
-
-	return nil
-}
+		vErr = validator.NewError(err, api.ErrStatusCumulativeFees)
 
-func isFeePaidEnough(feeModel sdkTx.FeeModel, tx *sdkTx.Transaction) (bool, uint64, uint64, error) {
-	expFeesPaid, err := feeModel.ComputeFee(tx)
-	if err != nil {
-		return false, 0, 0, err
-	}
-
-	total, err := tx.TotalInputSatoshis()
-	if err != nil {
-		return false, 0, 0, err
-	}
-	totalInputSatoshis := total
-	totalOutputSatoshis := tx.TotalOutputSatoshis()
+		feeDetails, feeErr := validator.NewFeeDetails(tx, feeModel)
+		if feeErr == nil {
+			feeDetails.AddCumulativeRule(feeModel.Satoshis, uint64(cumulativeSize), actualCumulativeFee, expectedCumulativeFee) // #nosec G115
+			vErr.Fee = feeDetails
+		}
 
-	if totalInputSatoshis < totalOutputSatoshis {
-		return false, expFeesPaid, 0, nil
+		return vErr
 	}
 
-	actualFeePaid := totalInputSatoshis - totalOutputSatoshis
-	return actualFeePaid >= expFeesPaid, expFeesPaid, actualFeePaid, nil
+	return nil
 }
 
 func checkScripts(tx *sdkTx.Transaction) error {
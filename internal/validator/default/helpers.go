@@ -81,6 +81,21 @@ func extendInputs(tx *sdkTx.Transaction, childInputs []*sdkTx.TransactionInput)
 	return nil
 }
 
+// hasUnminedParents returns whether any of the transactions spent by the transaction is not mined yet.
+func hasUnminedParents(ctx context.Context, txFinder validator.TxFinderI, tx *sdkTx.Transaction) (bool, error) {
+	parentIDs := make([]string, 0, len(tx.Inputs))
+	for _, in := range tx.Inputs {
+		parentIDs = append(parentIDs, in.SourceTXID.String())
+	}
+
+	mempoolAncestorTxIDs, err := txFinder.GetMempoolAncestors(ctx, parentIDs)
+	if err != nil {
+		return false, errors.Join(ErrFailedToGetMempoolAncestors, err)
+	}
+
+	return len(mempoolAncestorTxIDs) > 0, nil
+}
+
 // getUnminedAncestors returns unmined ancestors with data necessary to perform cumulative fee validation
 func getUnminedAncestors(ctx context.Context, txFinder validator.TxFinderI, tx *sdkTx.Transaction, tracingEnabled bool, tracingAttributes ...attribute.KeyValue) (unmindedAncestorsSet map[string]*sdkTx.Transaction, err error) {
 	ctx, span := tracing.StartTracing(ctx, "getUnminedAncestors", tracingEnabled, tracingAttributes...)
@@ -9,6 +9,8 @@ import (
 type Error struct {
 	Err            error
 	ArcErrorStatus api.StatusCode
+	// Fee is set if the transaction failed the fee validation
+	Fee *FeeDetails
 }
 
 func NewError(err error, status api.StatusCode) *Error {
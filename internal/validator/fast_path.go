@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/bsv-blockchain/go-sdk/util"
+	"github.com/ordishs/go-bitcoin"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	p2pkhLockingScriptLen = 25
+	minSignatureLen       = 9
+	// maxSignatureLen is the length of the longest DER signature with the sighash flag
+	maxSignatureLen       = 73
+	compressedPubKeyLen   = 33
+	uncompressedPubKeyLen = 65
+	p2pkhOpcodesPerOutput = 4
+	p2pkhSigOpsPerOutput  = 1
+)
+
+var (
+	p2pkhLockingScriptPrefix = []byte{script.OpDUP, script.OpHASH160, script.OpDATA20}
+	p2pkhLockingScriptSuffix = []byte{script.OpEQUALVERIFY, script.OpCHECKSIG}
+)
+
+// IsSmallP2PKH returns whether the transaction is a small standard P2PKH transaction of at most maxSize bytes, i.e. all
+// its outputs are P2PKH outputs and all its inputs are unlocked by a signature and a public key and, if the transaction
+// is extended, spend P2PKH outputs. The scripts are matched by their bytes only, the shape of the scripts determines the
+// results of the policy checks which otherwise parse the scripts, see CommonValidateSmallP2PKH and P2PKHOpcodeUsage.
+func IsSmallP2PKH(tx *sdkTx.Transaction, maxSize int) bool {
+	if len(tx.Inputs) == 0 || len(tx.Outputs) == 0 {
+		return false
+	}
+
+	for _, output := range tx.Outputs {
+		if !isP2PKHLockingScript(output.LockingScript) {
+			return false
+		}
+	}
+
+	for _, input := range tx.Inputs {
+		if !isP2PKHUnlockingScript(input.UnlockingScript) {
+			return false
+		}
+
+		if sourceScript := input.SourceTxScript(); sourceScript != nil && !isP2PKHLockingScript(sourceScript) {
+			return false
+		}
+	}
+
+	return p2pkhTxSize(tx) <= maxSize
+}
+
+// p2pkhTxSize returns the size of the transaction matched by IsSmallP2PKH without serializing it.
+func p2pkhTxSize(tx *sdkTx.Transaction) int {
+	// version, numbers of inputs and outputs and lock time
+	size := 4 + util.VarInt(len(tx.Inputs)).Length() + util.VarInt(len(tx.Outputs)).Length() + 4
+
+	for _, input := range tx.Inputs {
+		// previous tx ID, output index, unlocking script and sequence
+		size += chainhash.HashSize + 4 + util.VarInt(len(*input.UnlockingScript)).Length() + len(*input.UnlockingScript) + 4
+	}
+
+	// satoshis and locking script
+	size += len(tx.Outputs) * (8 + 1 + p2pkhLockingScriptLen)
+
+	return size
+}
+
+// isP2PKHLockingScript matches OP_DUP OP_HASH160 <20 bytes> OP_EQUALVERIFY OP_CHECKSIG.
+func isP2PKHLockingScript(s *script.Script) bool {
+	if s == nil || len(*s) != p2pkhLockingScriptLen {
+		return false
+	}
+
+	b := []byte(*s)
+
+	return bytes.Equal(b[:3], p2pkhLockingScriptPrefix) && bytes.Equal(b[23:], p2pkhLockingScriptSuffix)
+}
+
+// isP2PKHUnlockingScript matches <signature> <public key>, both pushed directly by their length.
+func isP2PKHUnlockingScript(s *script.Script) bool {
+	if s == nil || len(*s) == 0 {
+		return false
+	}
+
+	b := []byte(*s)
+
+	sigLen := int(b[0])
+	if sigLen < minSignatureLen || sigLen > maxSignatureLen || len(b) < 1+sigLen+1 {
+		return false
+	}
+
+	pubKey := b[1+sigLen:]
+	pubKeyLen := int(pubKey[0])
+	if len(pubKey) != 1+pubKeyLen {
+		return false
+	}
+
+	switch pubKeyLen {
+	case compressedPubKeyLen:
+		return pubKey[1] == 0x02 || pubKey[1] == 0x03
+	case uncompressedPubKeyLen:
+		return pubKey[1] == 0x04
+	default:
+		return false
+	}
+}
+
+// CommonValidateSmallP2PKH is like CommonValidateTransaction for transactions matched by IsSmallP2PKH, but takes the
+// number of signature operations from the outputs and the push only unlocking scripts from their shape, instead of
+// parsing the scripts.
+func CommonValidateSmallP2PKH(policy *bitcoin.Settings, tx *sdkTx.Transaction) *Error {
+	if vErr := commonValidateFields(policy, tx, p2pkhTxSize(tx)); vErr != nil {
+		return vErr
+	}
+
+	// 8) each P2PKH output has one signature operation, the unlocking scripts have none
+	maxSigOps := policy.MaxTxSigopsCountsPolicy
+	if maxSigOps == 0 {
+		maxSigOps = int64(maxTxSigopsCountPolicyAfterGenesis)
+	}
+
+	numSigOps := int64(len(tx.Outputs) * p2pkhSigOpsPerOutput)
+	if numSigOps > maxSigOps {
+		return NewError(errors.Join(ErrUnlockingScriptHasTooManySigOps, fmt.Errorf("sigops: %d", numSigOps)), api.ErrStatusMalformed)
+	}
+
+	// 9) the unlocking scripts push the signature and the public key only
+
+	return nil
+}
+
+// P2PKHOpcodeUsage returns the opcode usage of a transaction matched by IsSmallP2PKH, which is the same as counted by
+// CountOpcodes. Each output uses OP_DUP, OP_HASH160, OP_EQUALVERIFY and OP_CHECKSIG once, the unlocking scripts only
+// push data.
+func P2PKHOpcodeUsage(tx *sdkTx.Transaction) OpcodeUsage {
+	usage := OpcodeUsage{
+		Opcodes: make(map[byte]int, p2pkhOpcodesPerOutput),
+		Classes: make(map[string]int),
+	}
+
+	for _, op := range []byte{script.OpDUP, script.OpHASH160, script.OpEQUALVERIFY, script.OpCHECKSIG} {
+		usage.Opcodes[op] = len(tx.Outputs)
+	}
+
+	return usage
+}
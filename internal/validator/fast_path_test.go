@@ -0,0 +1,199 @@
+package validator
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/ordishs/go-bitcoin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+func p2pkhUnlockingScript(sigLen int, pubKey []byte) *script.Script {
+	s := script.Script{byte(sigLen)}
+	s = append(s, bytes.Repeat([]byte{0x30}, sigLen)...)
+	s = append(s, byte(len(pubKey)))
+	s = append(s, pubKey...)
+
+	return &s
+}
+
+func TestIsSmallP2PKH(t *testing.T) {
+	p2pkh, err := script.NewFromHex("76a914e2a623699e81b291c0327f408fea765d534baa2a88ac")
+	require.NoError(t, err)
+	p2pk, err := script.NewFromHex("2103b12bda06e5a3e439690bf3996f1d4b81289f4747068a5cbb12786df83ae14c18ac")
+	require.NoError(t, err)
+	compressed := append([]byte{0x02}, bytes.Repeat([]byte{0x01}, 32)...)
+	uncompressed := append([]byte{0x04}, bytes.Repeat([]byte{0x01}, 64)...)
+
+	tt := []struct {
+		name            string
+		unlockingScript *script.Script
+		sourceScript    *script.Script
+		lockingScripts  []*script.Script
+		maxSize         int
+
+		expected bool
+	}{
+		{
+			name:            "compressed public key",
+			unlockingScript: p2pkhUnlockingScript(72, compressed),
+			lockingScripts:  []*script.Script{p2pkh, p2pkh},
+			maxSize:         1000,
+
+			expected: true,
+		},
+		{
+			name:            "uncompressed public key, extended",
+			unlockingScript: p2pkhUnlockingScript(71, uncompressed),
+			sourceScript:    p2pkh,
+			lockingScripts:  []*script.Script{p2pkh},
+			maxSize:         1000,
+
+			expected: true,
+		},
+		{
+			name:            "too large",
+			unlockingScript: p2pkhUnlockingScript(72, compressed),
+			lockingScripts:  []*script.Script{p2pkh, p2pkh},
+			maxSize:         100,
+
+			expected: false,
+		},
+		{
+			name:            "p2pk output",
+			unlockingScript: p2pkhUnlockingScript(72, compressed),
+			lockingScripts:  []*script.Script{p2pkh, p2pk},
+			maxSize:         1000,
+
+			expected: false,
+		},
+		{
+			name:            "p2pk source output",
+			unlockingScript: p2pkhUnlockingScript(72, compressed),
+			sourceScript:    p2pk,
+			lockingScripts:  []*script.Script{p2pkh},
+			maxSize:         1000,
+
+			expected: false,
+		},
+		{
+			name:            "signature only",
+			unlockingScript: &script.Script{0x01, 0x30},
+			lockingScripts:  []*script.Script{p2pkh},
+			maxSize:         1000,
+
+			expected: false,
+		},
+		{
+			name:            "invalid public key prefix",
+			unlockingScript: p2pkhUnlockingScript(72, append([]byte{0x04}, bytes.Repeat([]byte{0x01}, 32)...)),
+			lockingScripts:  []*script.Script{p2pkh},
+			maxSize:         1000,
+
+			expected: false,
+		},
+		{
+			name:            "trailing opcode",
+			unlockingScript: func() *script.Script { s := append(*p2pkhUnlockingScript(72, compressed), script.OpDROP); return &s }(),
+			lockingScripts:  []*script.Script{p2pkh},
+			maxSize:         1000,
+
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			tx := newOpcodesTx(tc.unlockingScript, tc.lockingScripts...)
+			if tc.sourceScript != nil {
+				tx.Inputs[0].SetSourceTxOutput(&sdkTx.TransactionOutput{Satoshis: 10000, LockingScript: tc.sourceScript})
+			}
+
+			// when
+			actual := IsSmallP2PKH(tx, tc.maxSize)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+
+			if actual {
+				// the fast path gives the same results as parsing the scripts
+				assert.Equal(t, tx.Size(), p2pkhTxSize(tx))
+				assert.Equal(t, CountOpcodes(tx), P2PKHOpcodeUsage(tx))
+				assert.Equal(t, CommonValidateTransaction(&bitcoin.Settings{}, tx), CommonValidateSmallP2PKH(&bitcoin.Settings{}, tx))
+			}
+		})
+	}
+}
+
+func TestCommonValidateSmallP2PKH(t *testing.T) {
+	p2pkh, err := script.NewFromHex("76a914e2a623699e81b291c0327f408fea765d534baa2a88ac")
+	require.NoError(t, err)
+	compressed := append([]byte{0x03}, bytes.Repeat([]byte{0x01}, 32)...)
+
+	tt := []struct {
+		name      string
+		satoshis  uint64
+		maxSigOps int64
+		coinbase  bool
+
+		expectedStatus api.StatusCode
+	}{
+		{
+			name:     "valid",
+			satoshis: 1000,
+		},
+		{
+			name:     "dust output",
+			satoshis: 0,
+
+			expectedStatus: api.ErrStatusOutputs,
+		},
+		{
+			name:      "too many sigops",
+			satoshis:  1000,
+			maxSigOps: 1,
+
+			expectedStatus: api.ErrStatusMalformed,
+		},
+		{
+			name:     "coinbase input",
+			satoshis: 1000,
+			coinbase: true,
+
+			expectedStatus: api.ErrStatusInputs,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			tx := newOpcodesTx(p2pkhUnlockingScript(72, compressed), p2pkh, p2pkh)
+			for _, output := range tx.Outputs {
+				output.Satoshis = tc.satoshis
+			}
+			if tc.coinbase {
+				tx.Inputs[0].SourceTXID = &chainhash.Hash{}
+			}
+			policy := &bitcoin.Settings{MaxTxSigopsCountsPolicy: tc.maxSigOps}
+
+			// when
+			actual := CommonValidateSmallP2PKH(policy, tx)
+
+			// then
+			assert.Equal(t, CommonValidateTransaction(policy, tx), actual)
+			if tc.expectedStatus == 0 {
+				assert.Nil(t, actual)
+				return
+			}
+			require.NotNil(t, actual)
+			assert.Equal(t, tc.expectedStatus, actual.ArcErrorStatus)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
+	"github.com/ccoveille/go-safecast"
+)
+
+const (
+	FeeRuleMinMiningTxFee              = "minMiningTxFee"
+	FeeRuleCumulativeMinMiningTxFee    = "cumulativeMinMiningTxFee"
+	FeeRuleConsolidationMinMiningTxFee = "consolidationMinMiningTxFee"
+)
+
+// FeeRule is the fee required by a policy rule for the size it is applied to.
+type FeeRule struct {
+	Rule          string
+	SatoshisPerKB uint64
+	Size          uint64
+	ActualFee     uint64
+	RequiredFee   uint64
+}
+
+// FeeDetails is the fee paid by a transaction compared to the minimum fee required by the policy.
+type FeeDetails struct {
+	Size        uint64
+	ActualFee   uint64
+	RequiredFee uint64
+	Rules       []FeeRule
+}
+
+// NewFeeDetails calculates the fee details of a transaction. The input amounts of the transaction have to be known.
+func NewFeeDetails(tx *sdkTx.Transaction, feeModel *feemodel.SatoshisPerKilobyte) (*FeeDetails, error) {
+	return newFeeDetails(tx, feeModel, FeeRuleMinMiningTxFee)
+}
+
+// NewConsolidationFeeDetails calculates the fee details of a consolidation transaction to which the fee model of
+// consolidation transactions is applied instead of the minimum mining fee of the policy.
+func NewConsolidationFeeDetails(tx *sdkTx.Transaction, feeModel *feemodel.SatoshisPerKilobyte) (*FeeDetails, error) {
+	return newFeeDetails(tx, feeModel, FeeRuleConsolidationMinMiningTxFee)
+}
+
+func newFeeDetails(tx *sdkTx.Transaction, feeModel *feemodel.SatoshisPerKilobyte, rule string) (*FeeDetails, error) {
+	requiredFee, err := feeModel.ComputeFee(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalInput, err := tx.TotalInputSatoshis()
+	if err != nil {
+		return nil, err
+	}
+
+	var actualFee uint64
+	totalOutput := tx.TotalOutputSatoshis()
+	if totalInput > totalOutput {
+		actualFee = totalInput - totalOutput
+	}
+
+	size, err := safecast.ToUint64(tx.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	return &FeeDetails{
+		Size:        size,
+		ActualFee:   actualFee,
+		RequiredFee: requiredFee,
+		Rules: []FeeRule{{
+			Rule:          rule,
+			SatoshisPerKB: feeModel.Satoshis,
+			Size:          size,
+			ActualFee:     actualFee,
+			RequiredFee:   requiredFee,
+		}},
+	}, nil
+}
+
+// AddCumulativeRule adds the fee required by the cumulative fee rule for the transaction and its unmined ancestors.
+func (f *FeeDetails) AddCumulativeRule(satoshisPerKB uint64, size uint64, actualFee uint64, requiredFee uint64) {
+	f.Rules = append(f.Rules, FeeRule{
+		Rule:          FeeRuleCumulativeMinMiningTxFee,
+		SatoshisPerKB: satoshisPerKB,
+		Size:          size,
+		ActualFee:     actualFee,
+		RequiredFee:   requiredFee,
+	})
+}
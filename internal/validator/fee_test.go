@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	feemodel "github.com/bsv-blockchain/go-sdk/transaction/fee_model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeeDetails(t *testing.T) {
+	txWithoutSource := sdkTx.NewTransaction()
+	txWithoutSource.AddInput(&sdkTx.TransactionInput{SourceTXID: &chainhash.Hash{}})
+
+	tt := []struct {
+		name          string
+		tx            string
+		satoshisPerKB uint64
+
+		expectedFeeDetails *FeeDetails
+		expectedErr        bool
+	}{
+		{
+			name:          "fee paid enough",
+			tx:            opReturnTx,
+			satoshisPerKB: 1,
+
+			expectedFeeDetails: &FeeDetails{
+				Size:        279,
+				ActualFee:   16,
+				RequiredFee: 1,
+				Rules:       []FeeRule{{Rule: FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 279, ActualFee: 16, RequiredFee: 1}},
+			},
+		},
+		{
+			name:          "fee too low",
+			tx:            opReturnTx,
+			satoshisPerKB: 100,
+
+			expectedFeeDetails: &FeeDetails{
+				Size:        279,
+				ActualFee:   16,
+				RequiredFee: 100,
+				Rules:       []FeeRule{{Rule: FeeRuleMinMiningTxFee, SatoshisPerKB: 100, Size: 279, ActualFee: 16, RequiredFee: 100}},
+			},
+		},
+		{
+			name:          "input amounts not known",
+			satoshisPerKB: 1,
+
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			tx := txWithoutSource
+			if tc.tx != "" {
+				var err error
+				tx, err = sdkTx.NewTransactionFromHex(tc.tx)
+				require.NoError(t, err)
+			}
+
+			// when
+			actual, err := NewFeeDetails(tx, &feemodel.SatoshisPerKilobyte{Satoshis: tc.satoshisPerKB})
+
+			// then
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedFeeDetails, actual)
+		})
+	}
+}
+
+func TestFeeDetails_AddCumulativeRule(t *testing.T) {
+	// given
+	sut := &FeeDetails{
+		Size:        279,
+		ActualFee:   16,
+		RequiredFee: 1,
+		Rules:       []FeeRule{{Rule: FeeRuleMinMiningTxFee, SatoshisPerKB: 1, Size: 279, ActualFee: 16, RequiredFee: 1}},
+	}
+
+	// when
+	sut.AddCumulativeRule(5, 1200, 20, 6)
+
+	// then
+	require.Len(t, sut.Rules, 2)
+	assert.Equal(t, FeeRule{Rule: FeeRuleCumulativeMinMiningTxFee, SatoshisPerKB: 5, Size: 1200, ActualFee: 20, RequiredFee: 6}, sut.Rules[1])
+}
@@ -0,0 +1,52 @@
+package validator
+
+import (
+	"errors"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+)
+
+const (
+	// LockTimeThreshold is the lock time from which on the lock time is a unix timestamp instead of a block height
+	LockTimeThreshold = 500_000_000
+	finalSequence     = 0xffffffff
+)
+
+var ErrTxNonFinal = errors.New("transaction is not final")
+
+// HasLockTime returns whether the lock time of the transaction is enforced, i.e. the lock time is set and at least one
+// of the inputs does not have the final sequence number.
+func HasLockTime(tx *sdkTx.Transaction) bool {
+	if tx.LockTime == 0 {
+		return false
+	}
+
+	for _, input := range tx.Inputs {
+		if input.SequenceNumber != finalSequence {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFinal returns whether the transaction can be included in the next block of height nextHeight following the rules
+// of the node: a lock time below LockTimeThreshold is a block height and has to be lower than nextHeight, otherwise it
+// is a unix timestamp and has to be before the median time past of the chain tip.
+func IsFinal(tx *sdkTx.Transaction, nextHeight uint64, medianTimePast time.Time) bool {
+	if !HasLockTime(tx) {
+		return true
+	}
+
+	return IsLockTimeReached(tx.LockTime, nextHeight, medianTimePast)
+}
+
+// IsLockTimeReached returns whether the lock time is reached by the next block of height nextHeight.
+func IsLockTimeReached(lockTime uint32, nextHeight uint64, medianTimePast time.Time) bool {
+	if lockTime < LockTimeThreshold {
+		return uint64(lockTime) < nextHeight
+	}
+
+	return int64(lockTime) < medianTimePast.Unix()
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func newLockTimeTx(lockTime uint32, sequences ...uint32) *sdkTx.Transaction {
+	tx := sdkTx.NewTransaction()
+	tx.LockTime = lockTime
+	for i, sequence := range sequences {
+		tx.AddInput(&sdkTx.TransactionInput{
+			SourceTXID:     &chainhash.Hash{byte(i)},
+			SequenceNumber: sequence,
+		})
+	}
+
+	return tx
+}
+
+func TestIsFinal(t *testing.T) {
+	medianTimePast := time.Unix(1_700_000_000, 0)
+
+	tt := []struct {
+		name string
+		tx   *sdkTx.Transaction
+
+		expected bool
+	}{
+		{
+			name: "no lock time",
+			tx:   newLockTimeTx(0, 0),
+
+			expected: true,
+		},
+		{
+			name: "final sequences",
+			tx:   newLockTimeTx(900_000, finalSequence, finalSequence),
+
+			expected: true,
+		},
+		{
+			name: "height reached",
+			tx:   newLockTimeTx(799_999, 0, finalSequence),
+
+			expected: true,
+		},
+		{
+			name: "height not reached",
+			tx:   newLockTimeTx(800_000, 0, finalSequence),
+
+			expected: false,
+		},
+		{
+			name: "time reached",
+			tx:   newLockTimeTx(1_699_999_999, 0),
+
+			expected: true,
+		},
+		{
+			name: "time not reached",
+			tx:   newLockTimeTx(1_700_000_000, 0),
+
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := IsFinal(tc.tx, 800_000, medianTimePast)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	// OpcodeClassArithmetic are the restored arithmetic opcodes
+	OpcodeClassArithmetic = "arithmetic"
+	// OpcodeClassBitwise are the restored opcodes operating on the bits of byte arrays
+	OpcodeClassBitwise = "bitwise"
+	// OpcodeClassSplice are the restored opcodes concatenating, splitting and converting byte arrays
+	OpcodeClassSplice = "splice"
+	// OpcodeClassBigNumber are the numeric opcodes applied to numbers longer than the 4 bytes allowed before Genesis
+	OpcodeClassBigNumber = "bignumber"
+
+	// maxLegacyScriptNumLen is the maximum length of numbers in numeric opcodes before Genesis
+	maxLegacyScriptNumLen = 4
+)
+
+var (
+	ErrInvalidOpcodeLimit  = errors.New("invalid opcode limit")
+	ErrOpcodeLimitExceeded = errors.New("transaction exceeds the opcode limit of the policy")
+
+	// OpcodeClasses are the classes of the opcodes which were restored in BSV
+	OpcodeClasses = []string{OpcodeClassArithmetic, OpcodeClassBitwise, OpcodeClassSplice, OpcodeClassBigNumber}
+
+	restoredOpcodes = map[byte]string{
+		script.OpMUL:     OpcodeClassArithmetic,
+		script.OpDIV:     OpcodeClassArithmetic,
+		script.OpMOD:     OpcodeClassArithmetic,
+		script.Op2MUL:    OpcodeClassArithmetic,
+		script.Op2DIV:    OpcodeClassArithmetic,
+		script.OpINVERT:  OpcodeClassBitwise,
+		script.OpAND:     OpcodeClassBitwise,
+		script.OpOR:      OpcodeClassBitwise,
+		script.OpXOR:     OpcodeClassBitwise,
+		script.OpLSHIFT:  OpcodeClassBitwise,
+		script.OpRSHIFT:  OpcodeClassBitwise,
+		script.OpCAT:     OpcodeClassSplice,
+		script.OpSPLIT:   OpcodeClassSplice,
+		script.OpNUM2BIN: OpcodeClassSplice,
+		script.OpBIN2NUM: OpcodeClassSplice,
+	}
+)
+
+// OpcodeUsage is the number of occurrences of the opcodes and of the opcode classes in the scripts of a transaction.
+type OpcodeUsage struct {
+	Opcodes map[byte]int
+	Classes map[string]int
+}
+
+// CountOpcodes counts the opcodes in the unlocking scripts of the inputs and in the locking scripts of the outputs of
+// the transaction. Scripts which can not be parsed are skipped, they fail the validation of the transaction.
+func CountOpcodes(tx *sdkTx.Transaction) OpcodeUsage {
+	usage := OpcodeUsage{
+		Opcodes: make(map[byte]int),
+		Classes: make(map[string]int),
+	}
+
+	for _, input := range tx.Inputs {
+		usage.add(input.UnlockingScript)
+	}
+	for _, output := range tx.Outputs {
+		usage.add(output.LockingScript)
+	}
+
+	return usage
+}
+
+func (u OpcodeUsage) add(s *script.Script) {
+	if s == nil {
+		return
+	}
+
+	chunks, err := s.Chunks()
+	if err != nil {
+		return
+	}
+
+	for i, chunk := range chunks {
+		if isPush(chunk) {
+			continue
+		}
+
+		u.Opcodes[chunk.Op]++
+
+		class, found := restoredOpcodes[chunk.Op]
+		if found {
+			u.Classes[class]++
+		}
+
+		if isNumericOpcode(chunk.Op) && hasBigNumberOperand(chunks[:i]) {
+			u.Classes[OpcodeClassBigNumber]++
+		}
+	}
+}
+
+// ClassesUsed returns the opcode classes which occur in the transaction in the order of OpcodeClasses.
+func (u OpcodeUsage) ClassesUsed() []string {
+	var classes []string
+	for _, class := range OpcodeClasses {
+		if u.Classes[class] > 0 {
+			classes = append(classes, class)
+		}
+	}
+
+	return classes
+}
+
+func isPush(chunk *script.ScriptChunk) bool {
+	return chunk.Op <= script.OpPUSHDATA4
+}
+
+// isOperand returns whether the chunk pushes data or a small number onto the stack.
+func isOperand(chunk *script.ScriptChunk) bool {
+	return isPush(chunk) || chunk.Op == script.Op1NEGATE || (chunk.Op >= script.Op1 && chunk.Op <= script.Op16)
+}
+
+func isNumericOpcode(op byte) bool {
+	return op >= script.Op1ADD && op <= script.OpWITHIN && op != script.OpLSHIFT && op != script.OpRSHIFT
+}
+
+// hasBigNumberOperand returns whether one of the two pushes preceding a numeric opcode, i.e. its operands if they are
+// pushed directly before, is longer than the numbers allowed before Genesis.
+func hasBigNumberOperand(preceding []*script.ScriptChunk) bool {
+	for i := len(preceding) - 1; i >= 0 && i >= len(preceding)-2; i-- {
+		if !isOperand(preceding[i]) {
+			return false
+		}
+		if len(preceding[i].Data) > maxLegacyScriptNumLen {
+			return true
+		}
+	}
+
+	return false
+}
+
+// OpcodeLimit limits the number of occurrences of an opcode or of the opcodes of an opcode class in a transaction.
+type OpcodeLimit struct {
+	name     string
+	opcode   *byte
+	maxCount int
+}
+
+// NewOpcodeLimit returns the limit of the opcode, e.g. OP_MUL, or of the opcode class, e.g. arithmetic. A maximum
+// count of 0 forbids the opcode.
+func NewOpcodeLimit(name string, maxCount int) (OpcodeLimit, error) {
+	if maxCount < 0 {
+		return OpcodeLimit{}, errors.Join(ErrInvalidOpcodeLimit, fmt.Errorf("negative maximum count for %s", name))
+	}
+
+	for _, class := range OpcodeClasses {
+		if strings.EqualFold(class, name) {
+			return OpcodeLimit{name: class, maxCount: maxCount}, nil
+		}
+	}
+
+	opcode, found := script.OpCodeStrings[strings.ToUpper(name)]
+	if !found {
+		return OpcodeLimit{}, errors.Join(ErrInvalidOpcodeLimit, fmt.Errorf("unknown opcode or opcode class %s", name))
+	}
+
+	return OpcodeLimit{name: script.OpCodeValues[opcode], opcode: &opcode, maxCount: maxCount}, nil
+}
+
+func (l OpcodeLimit) String() string {
+	return l.name
+}
+
+func (l OpcodeLimit) count(usage OpcodeUsage) int {
+	if l.opcode != nil {
+		return usage.Opcodes[*l.opcode]
+	}
+
+	return usage.Classes[l.name]
+}
+
+// CheckOpcodeLimits checks the opcode usage of a transaction against the limits of the policy.
+func CheckOpcodeLimits(usage OpcodeUsage, limits []OpcodeLimit) *Error {
+	var exceeded []string
+	for _, limit := range limits {
+		count := limit.count(usage)
+		if count > limit.maxCount {
+			exceeded = append(exceeded, fmt.Sprintf("%s: %d > %d", limit, count, limit.maxCount))
+		}
+	}
+
+	if len(exceeded) == 0 {
+		return nil
+	}
+
+	sort.Strings(exceeded)
+
+	return NewError(errors.Join(ErrOpcodeLimitExceeded, errors.New(strings.Join(exceeded, ", "))), api.ErrStatusOpcodeLimitExceeded)
+}
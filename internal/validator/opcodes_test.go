@@ -0,0 +1,188 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+func newOpcodesTx(unlockingScript *script.Script, lockingScripts ...*script.Script) *sdkTx.Transaction {
+	tx := sdkTx.NewTransaction()
+	tx.AddInput(&sdkTx.TransactionInput{SourceTXID: &chainhash.Hash{0x01}, SourceTxOutIndex: 0, UnlockingScript: unlockingScript})
+
+	for _, lockingScript := range lockingScripts {
+		tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 1000, LockingScript: lockingScript})
+	}
+
+	return tx
+}
+
+func TestCountOpcodes(t *testing.T) {
+	// 5 byte number pushed as operand of OP_ADD
+	bigNumber := &script.Script{0x05, 0x01, 0x02, 0x03, 0x04, 0x05, script.Op1, script.OpADD, script.Op1, script.OpEQUAL}
+	// 5 byte data pushed before OP_CAT and OP_1ADD is not a number operand of OP_1ADD
+	spliceOnly := &script.Script{0x05, 0x01, 0x02, 0x03, 0x04, 0x05, script.OpDUP, script.OpCAT, script.Op1ADD, script.OpDROP}
+	arithmetic := &script.Script{script.Op2, script.Op3, script.OpMUL, script.Op6, script.OpEQUALVERIFY, script.Op2MUL, script.OpDROP}
+	bitwise := &script.Script{script.Op1, script.Op1, script.OpAND, script.OpINVERT, script.OpDROP}
+
+	tt := []struct {
+		name string
+		tx   *sdkTx.Transaction
+
+		expectedClasses     []string
+		expectedMulCount    int
+		expectedEqualsCount int
+	}{
+		{
+			name: "standard scripts",
+			tx:   newOpcodesTx(&script.Script{script.Op1}, validLockingScript, opReturnLockingScript),
+
+			expectedClasses: nil,
+		},
+		{
+			name: "arithmetic",
+			tx:   newOpcodesTx(&script.Script{script.Op1}, arithmetic),
+
+			expectedClasses:     []string{OpcodeClassArithmetic},
+			expectedMulCount:    1,
+			expectedEqualsCount: 0,
+		},
+		{
+			name: "big number in unlocking script",
+			tx:   newOpcodesTx(bigNumber, validLockingScript),
+
+			expectedClasses:     []string{OpcodeClassBigNumber},
+			expectedEqualsCount: 1,
+		},
+		{
+			name: "splice and bitwise in outputs",
+			tx:   newOpcodesTx(&script.Script{script.Op1}, spliceOnly, bitwise),
+
+			expectedClasses: []string{OpcodeClassBitwise, OpcodeClassSplice},
+		},
+		{
+			name: "unparsable script",
+			tx:   newOpcodesTx(&script.Script{script.Op1}, &script.Script{script.OpPUSHDATA1, 0x05, 0x01}),
+
+			expectedClasses: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := CountOpcodes(tc.tx)
+
+			// then
+			assert.Equal(t, tc.expectedClasses, actual.ClassesUsed())
+			assert.Equal(t, tc.expectedMulCount, actual.Opcodes[script.OpMUL])
+			assert.Equal(t, tc.expectedEqualsCount, actual.Opcodes[script.OpEQUAL])
+		})
+	}
+}
+
+func TestNewOpcodeLimit(t *testing.T) {
+	tt := []struct {
+		name     string
+		maxCount int
+
+		expectedName  string
+		expectedError error
+	}{
+		{
+			name: "op_mul",
+
+			expectedName: "OP_MUL",
+		},
+		{
+			name: "BigNumber",
+
+			expectedName: OpcodeClassBigNumber,
+		},
+		{
+			name: "OP_UNKNOWN_OPCODE",
+
+			expectedError: ErrInvalidOpcodeLimit,
+		},
+		{
+			name:     "OP_CAT",
+			maxCount: -1,
+
+			expectedError: ErrInvalidOpcodeLimit,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := NewOpcodeLimit(tc.name, tc.maxCount)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedName, actual.String())
+		})
+	}
+}
+
+func TestCheckOpcodeLimits(t *testing.T) {
+	mulLimit, err := NewOpcodeLimit("OP_MUL", 1)
+	require.NoError(t, err)
+	noSplice, err := NewOpcodeLimit(OpcodeClassSplice, 0)
+	require.NoError(t, err)
+	limits := []OpcodeLimit{mulLimit, noSplice}
+
+	tt := []struct {
+		name          string
+		lockingScript *script.Script
+
+		expectedError error
+	}{
+		{
+			name:          "within limits",
+			lockingScript: &script.Script{script.Op2, script.Op3, script.OpMUL, script.Op6, script.OpEQUAL},
+		},
+		{
+			name:          "opcode limit exceeded",
+			lockingScript: &script.Script{script.Op2, script.Op3, script.OpMUL, script.Op2, script.OpMUL, script.Op12, script.OpEQUAL},
+
+			expectedError: ErrOpcodeLimitExceeded,
+		},
+		{
+			name:          "forbidden opcode class",
+			lockingScript: &script.Script{script.Op1, script.Op2, script.OpCAT, script.OpDROP},
+
+			expectedError: ErrOpcodeLimitExceeded,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			tx := newOpcodesTx(&script.Script{script.Op1}, tc.lockingScript)
+
+			// when
+			actual := CheckOpcodeLimits(CountOpcodes(tx), limits)
+
+			// then
+			if tc.expectedError == nil {
+				require.Nil(t, actual)
+				return
+			}
+
+			require.NotNil(t, actual)
+			require.ErrorIs(t, actual.Err, tc.expectedError)
+			require.Equal(t, api.ErrStatusOpcodeLimitExceeded, actual.ArcErrorStatus)
+		})
+	}
+}
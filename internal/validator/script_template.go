@@ -0,0 +1,143 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	ScriptTemplateP2PKH    = "p2pkh"
+	ScriptTemplateP2PK     = "p2pk"
+	ScriptTemplateMultiSig = "multisig"
+	ScriptTemplateNullData = "nulldata"
+	// ScriptTemplateNonStandard is the bucket of locking scripts matching neither a standard template nor a prefix
+	ScriptTemplateNonStandard = "nonstandard"
+
+	scriptTemplatePrefix = "prefix:"
+)
+
+var (
+	ErrInvalidScriptTemplate    = errors.New("invalid script template")
+	ErrScriptTemplateNotAllowed = errors.New("transaction output script does not match any allowed script template")
+)
+
+// ScriptTemplate is a template which output locking scripts can be matched against. It is either one of the standard
+// templates `p2pkh`, `p2pk`, `multisig` and `nulldata` (OP_RETURN and OP_FALSE OP_RETURN outputs) or `prefix:<hex>`,
+// which matches all locking scripts starting with the given bytes, e.g. the prefix of a protocol.
+type ScriptTemplate struct {
+	name   string
+	prefix []byte
+}
+
+// ParseScriptTemplates parses the templates given by their names.
+func ParseScriptTemplates(names []string) ([]ScriptTemplate, error) {
+	templates := make([]ScriptTemplate, 0, len(names))
+
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		switch {
+		case name == ScriptTemplateP2PKH, name == ScriptTemplateP2PK, name == ScriptTemplateMultiSig, name == ScriptTemplateNullData:
+			templates = append(templates, ScriptTemplate{name: name})
+		case strings.HasPrefix(name, scriptTemplatePrefix):
+			prefix, err := hex.DecodeString(strings.TrimPrefix(name, scriptTemplatePrefix))
+			if err != nil || len(prefix) == 0 {
+				return nil, errors.Join(ErrInvalidScriptTemplate, fmt.Errorf("template %s has no valid hex prefix", name))
+			}
+			templates = append(templates, ScriptTemplate{name: name, prefix: prefix})
+		default:
+			return nil, errors.Join(ErrInvalidScriptTemplate, fmt.Errorf("unknown template %s", name))
+		}
+	}
+
+	return templates, nil
+}
+
+func (t ScriptTemplate) String() string {
+	return t.name
+}
+
+func (t ScriptTemplate) matches(lockingScript *script.Script) bool {
+	if lockingScript == nil {
+		return false
+	}
+
+	switch t.name {
+	case ScriptTemplateP2PKH:
+		return lockingScript.IsP2PKH()
+	case ScriptTemplateP2PK:
+		return lockingScript.IsP2PK()
+	case ScriptTemplateMultiSig:
+		return lockingScript.IsMultiSigOut()
+	case ScriptTemplateNullData:
+		return lockingScript.IsData()
+	default:
+		return bytes.HasPrefix(*lockingScript, t.prefix)
+	}
+}
+
+// standardScriptTemplates are the standard templates in the order in which locking scripts are matched against them
+var standardScriptTemplates = []ScriptTemplate{
+	{name: ScriptTemplateP2PKH},
+	{name: ScriptTemplateP2PK},
+	{name: ScriptTemplateMultiSig},
+	{name: ScriptTemplateNullData},
+}
+
+// TemplateBuckets classifies the outputs of the transaction and returns the distinct buckets they fall into. An output
+// falls into the first of the given templates it matches, e.g. the prefix of a protocol, otherwise into the standard
+// template it matches or into `nonstandard`. As the buckets are limited to the given and the standard templates, they
+// can be used as metric labels.
+func TemplateBuckets(tx *sdkTx.Transaction, templates []ScriptTemplate) []string {
+	buckets := make([]string, 0, 1)
+	templates = slices.Concat(templates, standardScriptTemplates)
+
+	for _, output := range tx.Outputs {
+		bucket := ScriptTemplateNonStandard
+		for _, template := range templates {
+			if template.matches(output.LockingScript) {
+				bucket = template.name
+				break
+			}
+		}
+
+		if !slices.Contains(buckets, bucket) {
+			buckets = append(buckets, bucket)
+		}
+	}
+
+	return buckets
+}
+
+// CheckScriptTemplates checks that each output of the transaction matches at least one of the allowed templates. If
+// no templates are given, all outputs are allowed.
+func CheckScriptTemplates(tx *sdkTx.Transaction, allowed []ScriptTemplate) *Error {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	for index, output := range tx.Outputs {
+		matched := false
+		for _, template := range allowed {
+			if template.matches(output.LockingScript) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return NewError(errors.Join(ErrScriptTemplateNotAllowed, fmt.Errorf("output %d", index)), api.ErrStatusScriptTemplateNotAllowed)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+func TestParseScriptTemplates(t *testing.T) {
+	tt := []struct {
+		name  string
+		names []string
+
+		expected      []string
+		expectedError error
+	}{
+		{
+			name:  "standard templates and prefix",
+			names: []string{"p2pkh", " NullData ", "prefix:006a0372756e"},
+
+			expected: []string{"p2pkh", "nulldata", "prefix:006a0372756e"},
+		},
+		{
+			name:  "unknown template",
+			names: []string{"p2sh"},
+
+			expectedError: ErrInvalidScriptTemplate,
+		},
+		{
+			name:  "invalid prefix",
+			names: []string{"prefix:xyz"},
+
+			expectedError: ErrInvalidScriptTemplate,
+		},
+		{
+			name:  "empty prefix",
+			names: []string{"prefix:"},
+
+			expectedError: ErrInvalidScriptTemplate,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := ParseScriptTemplates(tc.names)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			actualNames := make([]string, len(actual))
+			for i, template := range actual {
+				actualNames[i] = template.String()
+			}
+			assert.Equal(t, tc.expected, actualNames)
+		})
+	}
+}
+
+func TestCheckScriptTemplates(t *testing.T) {
+	runLockingScript := &script.Script{0x00, 0x6a, 0x03, 0x72, 0x75, 0x6e, 0x01, 0x05}
+
+	tt := []struct {
+		name           string
+		templates      []string
+		lockingScripts []*script.Script
+
+		expectedError error
+	}{
+		{
+			name:           "no templates",
+			lockingScripts: []*script.Script{opReturnLockingScript},
+		},
+		{
+			name:           "p2pkh only - p2pkh output",
+			templates:      []string{"p2pkh"},
+			lockingScripts: []*script.Script{validLockingScript},
+		},
+		{
+			name:           "p2pkh only - data output",
+			templates:      []string{"p2pkh"},
+			lockingScripts: []*script.Script{validLockingScript, opReturnLockingScript},
+
+			expectedError: ErrScriptTemplateNotAllowed,
+		},
+		{
+			name:           "p2pkh and nulldata",
+			templates:      []string{"p2pkh", "nulldata"},
+			lockingScripts: []*script.Script{validLockingScript, opReturnLockingScript},
+		},
+		{
+			name:           "protocol prefix",
+			templates:      []string{"p2pkh", "prefix:006a0372756e"},
+			lockingScripts: []*script.Script{runLockingScript, validLockingScript},
+		},
+		{
+			name:           "other protocol",
+			templates:      []string{"prefix:006a0372756e"},
+			lockingScripts: []*script.Script{opReturnLockingScript},
+
+			expectedError: ErrScriptTemplateNotAllowed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			templates, err := ParseScriptTemplates(tc.templates)
+			require.NoError(t, err)
+
+			tx := sdkTx.NewTransaction()
+			for _, lockingScript := range tc.lockingScripts {
+				tx.AddOutput(&sdkTx.TransactionOutput{LockingScript: lockingScript})
+			}
+
+			// when
+			actual := CheckScriptTemplates(tx, templates)
+
+			// then
+			if tc.expectedError != nil {
+				require.NotNil(t, actual)
+				require.ErrorIs(t, actual.Err, tc.expectedError)
+				assert.Equal(t, api.ErrStatusScriptTemplateNotAllowed, actual.ArcErrorStatus)
+				return
+			}
+
+			require.Nil(t, actual)
+		})
+	}
+}
+
+func TestTemplateBuckets(t *testing.T) {
+	runLockingScript := &script.Script{0x00, 0x6a, 0x03, 0x72, 0x75, 0x6e, 0x01, 0x05}
+	nonStandardLockingScript := &script.Script{0x51}
+
+	tt := []struct {
+		name           string
+		templates      []string
+		lockingScripts []*script.Script
+
+		expected []string
+	}{
+		{
+			name:           "standard templates",
+			lockingScripts: []*script.Script{validLockingScript, opReturnLockingScript, validLockingScript},
+
+			expected: []string{"p2pkh", "nulldata"},
+		},
+		{
+			name:           "protocol prefix before standard template",
+			templates:      []string{"prefix:006a0372756e"},
+			lockingScripts: []*script.Script{runLockingScript, validLockingScript},
+
+			expected: []string{"prefix:006a0372756e", "p2pkh"},
+		},
+		{
+			name:           "non-standard script",
+			lockingScripts: []*script.Script{nonStandardLockingScript},
+
+			expected: []string{"nonstandard"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			templates, err := ParseScriptTemplates(tc.templates)
+			require.NoError(t, err)
+
+			tx := sdkTx.NewTransaction()
+			for _, lockingScript := range tc.lockingScripts {
+				tx.AddOutput(&sdkTx.TransactionOutput{LockingScript: lockingScript})
+			}
+
+			// when
+			actual := TemplateBuckets(tx, templates)
+
+			// then
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -1,3 +1,4 @@
 package validator
 
 //go:generate moq -pkg mocks -out ./mocks/tx_finder_mock.go . TxFinderI
+//go:generate moq -pkg mocks -out ./mocks/crash_reporter_mock.go . CrashReporter
@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"fmt"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/ordishs/go-bitcoin"
+)
+
+const (
+	WarningFeeNearMinimum        = "FEE_NEAR_MINIMUM"
+	WarningLargeDataOutput       = "LARGE_DATA_OUTPUT"
+	WarningNearMaxTxSize         = "NEAR_MAX_TX_SIZE"
+	WarningNearMaxScriptSize     = "NEAR_MAX_SCRIPT_SIZE"
+	WarningNonStandardScript     = "NON_STANDARD_SCRIPT"
+	WarningMerkleRootsUnverified = "MERKLE_ROOTS_UNVERIFIED"
+
+	// softLimitPercent is the percentage of a policy limit from which on a transaction is close to the limit
+	softLimitPercent = 90
+	// feeMarginPercent is the percentage by which the fee of a transaction has to exceed the minimum fee
+	feeMarginPercent = 10
+	// largeDataOutputSize is the size in bytes from which on a data output is large, even if it is not close to the
+	// data carrier size of the policy
+	largeDataOutputSize = 100 * 1024
+)
+
+// Warning is a non-fatal finding about an accepted transaction, e.g. that it is close to a policy limit.
+type Warning struct {
+	Code    string
+	Message string
+}
+
+// Warnings returns the warnings for a transaction which has been accepted with the policy. The fee details are only
+// given if the input amounts of the transaction are known.
+func Warnings(tx *sdkTx.Transaction, policy *bitcoin.Settings, feeDetails *FeeDetails) []Warning {
+	if tx == nil || policy == nil {
+		return nil
+	}
+
+	var warnings []Warning
+
+	if feeDetails != nil && feeDetails.RequiredFee > 0 && feeDetails.ActualFee*100 < feeDetails.RequiredFee*(100+feeMarginPercent) {
+		warnings = append(warnings, Warning{
+			Code:    WarningFeeNearMinimum,
+			Message: fmt.Sprintf("fee of %d satoshis is less than %d%% above the minimum fee of %d satoshis", feeDetails.ActualFee, feeMarginPercent, feeDetails.RequiredFee),
+		})
+	}
+
+	if nearLimit(tx.Size(), policy.MaxTxSizePolicy) {
+		warnings = append(warnings, Warning{
+			Code:    WarningNearMaxTxSize,
+			Message: fmt.Sprintf("transaction size of %d bytes is close to the maximum of %d bytes", tx.Size(), policy.MaxTxSizePolicy),
+		})
+	}
+
+	for index, input := range tx.Inputs {
+		if input.UnlockingScript != nil && nearLimit(len(*input.UnlockingScript), policy.MaxScriptSizePolicy) {
+			warnings = append(warnings, Warning{
+				Code:    WarningNearMaxScriptSize,
+				Message: fmt.Sprintf("unlocking script of input %d with %d bytes is close to the maximum of %d bytes", index, len(*input.UnlockingScript), policy.MaxScriptSizePolicy),
+			})
+		}
+	}
+
+	for index, output := range tx.Outputs {
+		lockingScript := output.LockingScript
+		if lockingScript == nil {
+			continue
+		}
+
+		switch {
+		case lockingScript.IsData():
+			if len(*lockingScript) >= largeDataOutputSize || nearLimit(len(*lockingScript), int(policy.DataCarrierSize)) { // #nosec G115
+				warnings = append(warnings, Warning{
+					Code:    WarningLargeDataOutput,
+					Message: fmt.Sprintf("data output %d with %d bytes is large", index, len(*lockingScript)),
+				})
+			}
+			continue
+		case nearLimit(len(*lockingScript), policy.MaxScriptSizePolicy):
+			warnings = append(warnings, Warning{
+				Code:    WarningNearMaxScriptSize,
+				Message: fmt.Sprintf("locking script of output %d with %d bytes is close to the maximum of %d bytes", index, len(*lockingScript), policy.MaxScriptSizePolicy),
+			})
+		}
+
+		if !lockingScript.IsP2PKH() && !lockingScript.IsP2PK() && !lockingScript.IsMultiSigOut() {
+			warnings = append(warnings, Warning{
+				Code:    WarningNonStandardScript,
+				Message: fmt.Sprintf("locking script of output %d is non-standard", index),
+			})
+		}
+	}
+
+	return warnings
+}
+
+// nearLimit returns whether the size reaches the soft limit of the limit. Limits which are not set are never reached.
+func nearLimit(size int, limit int) bool {
+	return limit > 0 && size*100 >= limit*softLimitPercent
+}
@@ -0,0 +1,120 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/ordishs/go-bitcoin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWarningsTx(lockingScripts ...*script.Script) *sdkTx.Transaction {
+	tx := sdkTx.NewTransaction()
+	unlockingScript := script.Script(make([]byte, 107))
+	tx.AddInput(&sdkTx.TransactionInput{SourceTXID: &chainhash.Hash{0x01}, SourceTxOutIndex: 0, UnlockingScript: &unlockingScript})
+
+	for _, lockingScript := range lockingScripts {
+		tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 1000, LockingScript: lockingScript})
+	}
+
+	return tx
+}
+
+func warningCodes(warnings []Warning) []string {
+	var codes []string
+	for _, warning := range warnings {
+		codes = append(codes, warning.Code)
+	}
+
+	return codes
+}
+
+func TestWarnings(t *testing.T) {
+	policy := &bitcoin.Settings{
+		MaxTxSizePolicy:     10_000_000,
+		MaxScriptSizePolicy: 500_000,
+	}
+	largeData := append(script.Script{0x00, 0x6a}, make([]byte, largeDataOutputSize)...)
+	largeScript := append(script.Script{0x00, 0x6a}, make([]byte, 450_000)...)
+	nonStandardScript := &script.Script{0x51, 0x51, 0x87}
+
+	tt := []struct {
+		name       string
+		tx         *sdkTx.Transaction
+		policy     *bitcoin.Settings
+		feeDetails *FeeDetails
+
+		expectedCodes []string
+	}{
+		{
+			name:       "no warnings",
+			tx:         newWarningsTx(validLockingScript, opReturnLockingScript),
+			policy:     policy,
+			feeDetails: &FeeDetails{ActualFee: 110, RequiredFee: 100},
+
+			expectedCodes: nil,
+		},
+		{
+			name:       "fee near minimum",
+			tx:         newWarningsTx(validLockingScript),
+			policy:     policy,
+			feeDetails: &FeeDetails{ActualFee: 109, RequiredFee: 100},
+
+			expectedCodes: []string{WarningFeeNearMinimum},
+		},
+		{
+			name:   "large data output",
+			tx:     newWarningsTx(validLockingScript, &largeData),
+			policy: policy,
+
+			expectedCodes: []string{WarningLargeDataOutput},
+		},
+		{
+			name:   "data output near data carrier size",
+			tx:     newWarningsTx(opReturnLockingScript),
+			policy: &bitcoin.Settings{DataCarrierSize: 16},
+
+			expectedCodes: []string{WarningLargeDataOutput},
+		},
+		{
+			name:   "near policy size limits",
+			tx:     newWarningsTx(&largeScript),
+			policy: &bitcoin.Settings{MaxTxSizePolicy: 460_000, MaxScriptSizePolicy: 500_000},
+
+			expectedCodes: []string{WarningNearMaxTxSize, WarningLargeDataOutput},
+		},
+		{
+			name:   "unlocking script near max script size",
+			tx:     newWarningsTx(validLockingScript),
+			policy: &bitcoin.Settings{MaxScriptSizePolicy: 110},
+
+			expectedCodes: []string{WarningNearMaxScriptSize},
+		},
+		{
+			name:   "non-standard script",
+			tx:     newWarningsTx(nonStandardScript),
+			policy: &bitcoin.Settings{MaxScriptSizePolicy: 120},
+
+			expectedCodes: []string{WarningNonStandardScript},
+		},
+		{
+			name:   "no policy",
+			tx:     newWarningsTx(nonStandardScript),
+			policy: nil,
+
+			expectedCodes: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			warnings := Warnings(tc.tx, tc.policy, tc.feeDetails)
+
+			// then
+			assert.Equal(t, tc.expectedCodes, warningCodes(warnings))
+		})
+	}
+}
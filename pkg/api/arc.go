@@ -28,11 +28,22 @@ const (
 	BearerAuthScopes    = "BearerAuth.Scopes"
 )
 
+// Defines values for StageTimingStage.
+const (
+	Announced StageTimingStage = "announced"
+	Mined     StageTimingStage = "mined"
+	Received  StageTimingStage = "received"
+	Seen      StageTimingStage = "seen"
+	Stored    StageTimingStage = "stored"
+	Validated StageTimingStage = "validated"
+)
+
 // Defines values for TransactionDetailsTxStatus.
 const (
 	TransactionDetailsTxStatusACCEPTEDBYNETWORK    TransactionDetailsTxStatus = "ACCEPTED_BY_NETWORK"
 	TransactionDetailsTxStatusANNOUNCEDTONETWORK   TransactionDetailsTxStatus = "ANNOUNCED_TO_NETWORK"
 	TransactionDetailsTxStatusDOUBLESPENDATTEMPTED TransactionDetailsTxStatus = "DOUBLE_SPEND_ATTEMPTED"
+	TransactionDetailsTxStatusEXPIRED              TransactionDetailsTxStatus = "EXPIRED"
 	TransactionDetailsTxStatusMINED                TransactionDetailsTxStatus = "MINED"
 	TransactionDetailsTxStatusMINEDINSTALEBLOCK    TransactionDetailsTxStatus = "MINED_IN_STALE_BLOCK"
 	TransactionDetailsTxStatusQUEUED               TransactionDetailsTxStatus = "QUEUED"
@@ -44,6 +55,7 @@ const (
 	TransactionDetailsTxStatusSENTTONETWORK        TransactionDetailsTxStatus = "SENT_TO_NETWORK"
 	TransactionDetailsTxStatusSTORED               TransactionDetailsTxStatus = "STORED"
 	TransactionDetailsTxStatusUNKNOWN              TransactionDetailsTxStatus = "UNKNOWN"
+	TransactionDetailsTxStatusWAITINGFORFINALITY   TransactionDetailsTxStatus = "WAITING_FOR_FINALITY"
 )
 
 // Defines values for TransactionResponseTxStatus.
@@ -51,6 +63,7 @@ const (
 	TransactionResponseTxStatusACCEPTEDBYNETWORK    TransactionResponseTxStatus = "ACCEPTED_BY_NETWORK"
 	TransactionResponseTxStatusANNOUNCEDTONETWORK   TransactionResponseTxStatus = "ANNOUNCED_TO_NETWORK"
 	TransactionResponseTxStatusDOUBLESPENDATTEMPTED TransactionResponseTxStatus = "DOUBLE_SPEND_ATTEMPTED"
+	TransactionResponseTxStatusEXPIRED              TransactionResponseTxStatus = "EXPIRED"
 	TransactionResponseTxStatusMINED                TransactionResponseTxStatus = "MINED"
 	TransactionResponseTxStatusMINEDINSTALEBLOCK    TransactionResponseTxStatus = "MINED_IN_STALE_BLOCK"
 	TransactionResponseTxStatusQUEUED               TransactionResponseTxStatus = "QUEUED"
@@ -62,6 +75,7 @@ const (
 	TransactionResponseTxStatusSENTTONETWORK        TransactionResponseTxStatus = "SENT_TO_NETWORK"
 	TransactionResponseTxStatusSTORED               TransactionResponseTxStatus = "STORED"
 	TransactionResponseTxStatusUNKNOWN              TransactionResponseTxStatus = "UNKNOWN"
+	TransactionResponseTxStatusWAITINGFORFINALITY   TransactionResponseTxStatus = "WAITING_FOR_FINALITY"
 )
 
 // Defines values for TransactionStatusTxStatus.
@@ -69,6 +83,7 @@ const (
 	ACCEPTEDBYNETWORK    TransactionStatusTxStatus = "ACCEPTED_BY_NETWORK"
 	ANNOUNCEDTONETWORK   TransactionStatusTxStatus = "ANNOUNCED_TO_NETWORK"
 	DOUBLESPENDATTEMPTED TransactionStatusTxStatus = "DOUBLE_SPEND_ATTEMPTED"
+	EXPIRED              TransactionStatusTxStatus = "EXPIRED"
 	MINED                TransactionStatusTxStatus = "MINED"
 	MINEDINSTALEBLOCK    TransactionStatusTxStatus = "MINED_IN_STALE_BLOCK"
 	QUEUED               TransactionStatusTxStatus = "QUEUED"
@@ -80,8 +95,91 @@ const (
 	SENTTONETWORK        TransactionStatusTxStatus = "SENT_TO_NETWORK"
 	STORED               TransactionStatusTxStatus = "STORED"
 	UNKNOWN              TransactionStatusTxStatus = "UNKNOWN"
+	WAITINGFORFINALITY   TransactionStatusTxStatus = "WAITING_FOR_FINALITY"
+)
+
+// Defines values for WarningCode.
+const (
+	FEENEARMINIMUM        WarningCode = "FEE_NEAR_MINIMUM"
+	LARGEDATAOUTPUT       WarningCode = "LARGE_DATA_OUTPUT"
+	MERKLEROOTSUNVERIFIED WarningCode = "MERKLE_ROOTS_UNVERIFIED"
+	NEARMAXSCRIPTSIZE     WarningCode = "NEAR_MAX_SCRIPT_SIZE"
+	NEARMAXTXSIZE         WarningCode = "NEAR_MAX_TX_SIZE"
+	NONSTANDARDSCRIPT     WarningCode = "NON_STANDARD_SCRIPT"
 )
 
+// Annotation Note and labels attached to a transaction by an operator
+type Annotation struct {
+	// Author Name of the admin token with which the annotation was added
+	Author string `json:"author"`
+
+	// CreatedAt Time at which the annotation was added
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Labels Labels of the transaction
+	Labels []string `json:"labels"`
+
+	// Note Note of the operator, empty if the annotation only has labels
+	Note string `json:"note"`
+}
+
+// Block Block of the longest chain and the miner which mined it
+type Block struct {
+	// BlockHash Block hash
+	BlockHash string `json:"blockHash"`
+
+	// BlockHeight Block height
+	BlockHeight uint64 `json:"blockHeight"`
+
+	// CoinbaseReward Sum of the outputs of the coinbase transaction in satoshis, i.e. the block subsidy and the fees
+	CoinbaseReward uint64 `json:"coinbaseReward"`
+
+	// MinerTag Tag by which the miner identifies itself in the coinbase transaction, empty if there is none
+	MinerTag string `json:"minerTag"`
+
+	// ProcessedAt Time at which the block was processed by ARC
+	ProcessedAt *time.Time `json:"processedAt"`
+
+	// Timestamp Timestamp of the block header, null if the block was processed before it was recorded
+	Timestamp *time.Time `json:"timestamp"`
+}
+
+// BlockHeaderServiceStatus Availability of a block header service
+type BlockHeaderServiceStatus struct {
+	// Available whether the block header service is available
+	Available bool `json:"available"`
+
+	// LastSuccess time of the last successful request to the block header service
+	LastSuccess *time.Time `json:"lastSuccess"`
+
+	// Reason reason why the block header service is unavailable
+	Reason *string `json:"reason"`
+
+	// ThrottledUntil time until which the block header service is not requested because it rate limited the requests
+	ThrottledUntil *time.Time `json:"throttledUntil"`
+
+	// Url URL of the block header service
+	Url string `json:"url"`
+}
+
+// BlockTemplate Block template of a mining pool or node in which the unmined transaction is included, i.e. the transaction is expected to be mined in the next block
+type BlockTemplate struct {
+	// PreviousBlockHash Hash of the block on top of which the block template is built
+	PreviousBlockHash string `json:"previousBlockHash"`
+
+	// Source Identifier of the mining pool or node which created the block template
+	Source string `json:"source"`
+
+	// Timestamp Time at which the transaction was included in the block template
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Blocks defines model for Blocks.
+type Blocks struct {
+	// Blocks The latest blocks ordered by height, the highest block first
+	Blocks []Block `json:"blocks"`
+}
+
 // ChainInfo Chain info
 type ChainInfo struct {
 	// BlockHash Block hash
@@ -101,15 +199,61 @@ type Error struct {
 	union json.RawMessage
 }
 
+// ErrorAbuseRejected defines model for ErrorAbuseRejected.
+type ErrorAbuseRejected struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorAbuseThrottled defines model for ErrorAbuseThrottled.
+type ErrorAbuseThrottled struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
 // ErrorBadRequest defines model for ErrorBadRequest.
 type ErrorBadRequest struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee           *FeeDetails  `json:"fee,omitempty"`
+	Instance      *interface{} `json:"instance,omitempty"`
+	InvalidParams *interface{} `json:"invalidParams,omitempty"`
+	Status        interface{}  `json:"status"`
+	Title         interface{}  `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -121,10 +265,37 @@ type ErrorCalculatingMerkleRoots struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorComplianceBlocked defines model for ErrorComplianceBlocked.
+type ErrorComplianceBlocked struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -136,10 +307,16 @@ type ErrorCumulativeFees struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -151,10 +328,16 @@ type ErrorFee struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -169,9 +352,15 @@ type ErrorFields struct {
 	// ExtraInfo Optional extra information about the error from the miner
 	ExtraInfo *string `json:"extraInfo"`
 
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee *FeeDetails `json:"fee,omitempty"`
+
 	// Instance (Optional) Link to actual error on server
 	Instance *string `json:"instance"`
 
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+
 	// Status Error code
 	Status int `json:"status"`
 
@@ -190,10 +379,16 @@ type ErrorFrozenConsensus struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -205,10 +400,16 @@ type ErrorFrozenPolicy struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -220,10 +421,16 @@ type ErrorGeneric struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -235,10 +442,16 @@ type ErrorInputs struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -250,10 +463,16 @@ type ErrorMalformed struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -265,10 +484,37 @@ type ErrorMinedAncestorsNotFound struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorNonFinal defines model for ErrorNonFinal.
+type ErrorNonFinal struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -280,10 +526,37 @@ type ErrorNotFound struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorOpcodeLimitExceeded defines model for ErrorOpcodeLimitExceeded.
+type ErrorOpcodeLimitExceeded struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -295,10 +568,100 @@ type ErrorOutputs struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorReadOnly defines model for ErrorReadOnly.
+type ErrorReadOnly struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorScriptTemplateNotAllowed defines model for ErrorScriptTemplateNotAllowed.
+type ErrorScriptTemplateNotAllowed struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorTemplateRateClamped defines model for ErrorTemplateRateClamped.
+type ErrorTemplateRateClamped struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorTooManyRequests defines model for ErrorTooManyRequests.
+type ErrorTooManyRequests struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -310,10 +673,16 @@ type ErrorTxFormat struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -325,10 +694,37 @@ type ErrorUnlockingScripts struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
+
+	// Txid Transaction ID this error is referring to
+	Txid *string     `json:"txid"`
+	Type interface{} `json:"type"`
+}
+
+// ErrorUnsupportedMediaType defines model for ErrorUnsupportedMediaType.
+type ErrorUnsupportedMediaType struct {
+	Detail interface{} `json:"detail"`
+
+	// ExtraInfo Optional extra information about the error from the miner
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee *FeeDetails `json:"fee,omitempty"`
+
+	// Instance (Optional) Link to actual error on server
+	Instance      *string      `json:"instance"`
+	InvalidParams *interface{} `json:"invalidParams,omitempty"`
+	Status        interface{}  `json:"status"`
+	Title         interface{}  `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
@@ -340,16 +736,40 @@ type ErrorValidatingMerkleRoots struct {
 	Detail interface{} `json:"detail"`
 
 	// ExtraInfo Optional extra information about the error from the miner
-	ExtraInfo *string      `json:"extraInfo"`
-	Instance  *interface{} `json:"instance,omitempty"`
-	Status    interface{}  `json:"status"`
-	Title     interface{}  `json:"title"`
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee      *FeeDetails  `json:"fee,omitempty"`
+	Instance *interface{} `json:"instance,omitempty"`
+
+	// InvalidParams Parameters of the request which failed validation
+	InvalidParams *[]InvalidParam `json:"invalidParams,omitempty"`
+	Status        interface{}     `json:"status"`
+	Title         interface{}     `json:"title"`
 
 	// Txid Transaction ID this error is referring to
 	Txid *string     `json:"txid"`
 	Type interface{} `json:"type"`
 }
 
+// FederationResult Aggregation of the responses of the upstream ARC instances the transaction was forwarded to, only set if an aggregation policy is configured. If the policy is satisfied, txStatus is the most advanced status reached by at least the required number of upstreams, otherwise txStatus is UNKNOWN.
+type FederationResult struct {
+	// Acknowledged Number of upstreams which acknowledged the transaction
+	Acknowledged int `json:"acknowledged"`
+
+	// Policy Aggregation policy, `first-success`, `quorum` or `all`
+	Policy string `json:"policy"`
+
+	// Required Number of upstreams which have to acknowledge the transaction to satisfy the policy
+	Required int `json:"required"`
+
+	// Satisfied True if at least the required number of upstreams acknowledged the transaction
+	Satisfied bool `json:"satisfied"`
+
+	// Upstreams Responses of the upstreams which responded before the policy was decided, ordered by their names
+	Upstreams []UpstreamAcknowledgement `json:"upstreams"`
+}
+
 // FeeAmount defines model for FeeAmount.
 type FeeAmount struct {
 	// Bytes Number of bytes
@@ -359,8 +779,44 @@ type FeeAmount struct {
 	Satoshis uint64 `json:"satoshis"`
 }
 
+// FeeDetails Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+type FeeDetails struct {
+	// ActualFee Fee paid by the transaction in satoshis
+	ActualFee uint64 `json:"actualFee"`
+
+	// RequiredFee Minimum fee in satoshis required by the policy for the transaction
+	RequiredFee uint64 `json:"requiredFee"`
+
+	// Rules Breakdown of the required fee per policy rule
+	Rules []FeeRule `json:"rules"`
+
+	// Size Size of the transaction in bytes
+	Size uint64 `json:"size"`
+}
+
+// FeeRule Fee required by a policy rule
+type FeeRule struct {
+	// ActualFee Fee in satoshis paid for the size the rule is applied to
+	ActualFee uint64 `json:"actualFee"`
+
+	// RequiredFee Minimum fee in satoshis required by the rule
+	RequiredFee uint64 `json:"requiredFee"`
+
+	// Rule Policy rule. `minMiningTxFee` is applied to the transaction, `cumulativeMinMiningTxFee` to the transaction and its unmined ancestors and `consolidationMinMiningTxFee` to consolidation transactions.
+	Rule string `json:"rule"`
+
+	// SatoshisPerKB Fee rate of the rule in satoshis per started kilobyte
+	SatoshisPerKB uint64 `json:"satoshisPerKB"`
+
+	// Size Size in bytes the rule is applied to
+	Size uint64 `json:"size"`
+}
+
 // Health healthy or not
 type Health struct {
+	// BlockHeaderServices availability of the block header services against which merkle roots are verified
+	BlockHeaderServices *[]BlockHeaderServiceStatus `json:"blockHeaderServices"`
+
 	// Healthy whether healthy or not
 	Healthy *bool `json:"healthy,omitempty"`
 
@@ -371,6 +827,60 @@ type Health struct {
 	Version *string `json:"version,omitempty"`
 }
 
+// InvalidParam defines model for InvalidParam.
+type InvalidParam struct {
+	// In Location of the parameter, one of header, path or body
+	In string `json:"in"`
+
+	// Name Name of the header, path parameter or field of the request body which failed validation
+	Name string `json:"name"`
+
+	// Reason Reason why the validation failed
+	Reason string `json:"reason"`
+}
+
+// NodeSubmission Submission of the transaction to the node with sendrawtransaction, because no peer requested the transaction within the timeout after its announcement
+type NodeSubmission struct {
+	// Accepted True if the node accepted the transaction into its mempool
+	Accepted bool `json:"accepted"`
+
+	// RejectReason Reason given by the node if it rejected the transaction
+	RejectReason *string `json:"rejectReason,omitempty"`
+
+	// SubmittedAt Time at which the transaction was submitted to the node
+	SubmittedAt time.Time `json:"submittedAt"`
+}
+
+// OutpointSpent defines model for OutpointSpent.
+type OutpointSpent struct {
+	// SpendingTxid Transaction ID of the transaction spending the outpoint, only set if spent is true
+	SpendingTxid *string `json:"spendingTxid"`
+
+	// Spent True if a transaction known to ARC spends the outpoint
+	Spent bool `json:"spent"`
+
+	// TxStatus Status of the transaction spending the outpoint, only set if spent is true
+	TxStatus *string `json:"txStatus"`
+
+	// Txid Transaction ID of the transaction whose output is checked
+	Txid string `json:"txid"`
+
+	// Vout Index of the output
+	Vout int `json:"vout"`
+}
+
+// PeerAck Acknowledgment of the announcement of a transaction by a peer
+type PeerAck struct {
+	// Peer Address of the peer
+	Peer string `json:"peer"`
+
+	// RequestedAt Time at which the peer requested the transaction, null if the peer did not request it
+	RequestedAt *time.Time `json:"requestedAt"`
+
+	// SentAt Time at which the transaction was sent to the peer, null if it was not sent to the peer
+	SentAt *time.Time `json:"sentAt"`
+}
+
 // Policy defines model for Policy.
 type Policy struct {
 	// Maxscriptsizepolicy Maximum script size [bytes]
@@ -393,34 +903,205 @@ type PolicyResponse struct {
 	Timestamp time.Time `json:"timestamp"`
 }
 
-// TransactionDetails Transaction details
-type TransactionDetails struct {
-	CompetingTxs *[]string `json:"competingTxs"`
+// Receipt Receipt of the acceptance of a transaction, only issued if enabled. The signature is the DER encoded ECDSA signature with the miner ID key of the SHA-256 hash of the fields txid, txStatus, policyId and timestamp joined by `|`, e.g. `<txid>|SEEN_ON_NETWORK|<policyId>|2024-01-01T12:00:00.123Z`.
+type Receipt struct {
+	// PolicyId SHA-256 hash of the policy with which the transaction was validated
+	PolicyId string `json:"policyId"`
 
-	// ExtraInfo Extra information about the transaction
-	ExtraInfo *string `json:"extraInfo"`
+	// PublicKey Compressed public key of the miner ID key in hex with which the receipt was signed
+	PublicKey string `json:"publicKey"`
 
-	// MerklePath Transaction Merkle path as a hex string in BUMP format [BRC-74](https://brc.dev/74)
-	MerklePath *string `json:"merklePath"`
+	// Signature DER encoded signature in hex
+	Signature string `json:"signature"`
 
-	// TxStatus Transaction status
-	TxStatus TransactionDetailsTxStatus `json:"txStatus"`
+	// Timestamp Time at which the transaction was accepted
+	Timestamp time.Time `json:"timestamp"`
 
-	// Txid Transaction ID in hex
+	// TxStatus Status of the transaction when it was accepted
+	TxStatus string `json:"txStatus"`
+
+	// Txid Transaction ID of the accepted transaction
 	Txid string `json:"txid"`
 }
 
-// TransactionDetailsTxStatus Transaction status
-type TransactionDetailsTxStatus string
+// ReceiptVerification defines model for ReceiptVerification.
+type ReceiptVerification struct {
+	// PolicyId SHA-256 hash of the policy with which the transaction was validated
+	PolicyId string `json:"policyId"`
 
-// TransactionRequest defines model for TransactionRequest.
-type TransactionRequest struct {
-	// RawTx Raw hex string
-	RawTx string `json:"rawTx"`
+	// PublicKey Compressed public key of the miner ID key in hex with which the receipt was signed
+	PublicKey string `json:"publicKey"`
+
+	// Signature DER encoded signature in hex
+	Signature string `json:"signature"`
+
+	// Timestamp Time at which the transaction was accepted
+	Timestamp time.Time `json:"timestamp"`
+
+	// TxStatus Status of the transaction when it was accepted
+	TxStatus string `json:"txStatus"`
+
+	// Txid Transaction ID of the accepted transaction
+	Txid string `json:"txid"`
+
+	// Verified True if the signature of the receipt is valid for its fields and public key
+	Verified bool `json:"verified"`
+}
+
+// StageTiming Time at which a transaction reached a processing stage
+type StageTiming struct {
+	// LatencyMs Time in milliseconds since the previous recorded stage
+	LatencyMs int64 `json:"latencyMs"`
+
+	// Stage Processing stage
+	Stage StageTimingStage `json:"stage"`
+
+	// Timestamp Time at which the transaction reached the stage
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// StageTimingStage Processing stage
+type StageTimingStage string
+
+// Stats Aggregates of the transactions accepted in the rolling window
+type Stats struct {
+	// Accepted Number of transactions accepted in the window
+	Accepted uint64 `json:"accepted"`
+
+	// AverageFeeRate Average fee rate in satoshis per kilobyte of the accepted transactions whose fee is known
+	AverageFeeRate float64 `json:"averageFeeRate"`
+
+	// AverageTimeToMineSeconds Average time in seconds from the acceptance until the mining of the mined transactions
+	AverageTimeToMineSeconds float64 `json:"averageTimeToMineSeconds"`
+
+	// Hours Aggregates of the hours of the window with accepted or mined transactions, the latest hour last
+	Hours []StatsHour `json:"hours"`
+
+	// Mined Number of the accepted transactions mined in the window
+	Mined uint64 `json:"mined"`
+
+	// Pending Number of accepted transactions which are not mined yet
+	Pending int `json:"pending"`
+
+	// TimeToMine Distribution of the time from the acceptance until the mining of the mined transactions. A bucket counts the transactions mined within its upper bound, but not within the upper bound of the previous bucket.
+	TimeToMine []TimeToMineBucket `json:"timeToMine"`
+
+	// Timestamp Time at which the statistics were computed
+	Timestamp time.Time `json:"timestamp"`
+
+	// WindowSeconds Duration of the rolling window in seconds
+	WindowSeconds uint64 `json:"windowSeconds"`
+}
+
+// StatsHour Aggregates of the transactions accepted or mined in an hour
+type StatsHour struct {
+	// Accepted Number of transactions accepted in the hour
+	Accepted uint64 `json:"accepted"`
+
+	// AverageFeeRate Average fee rate in satoshis per kilobyte of the transactions accepted in the hour whose fee is known
+	AverageFeeRate float64 `json:"averageFeeRate"`
+
+	// Mined Number of the accepted transactions mined in the hour
+	Mined uint64 `json:"mined"`
+
+	// Start Start of the hour
+	Start time.Time `json:"start"`
+}
+
+// TimeToMineBucket Number of mined transactions whose time to mine falls into the bucket
+type TimeToMineBucket struct {
+	// Count Number of mined transactions
+	Count uint64 `json:"count"`
+
+	// UpperBoundSeconds Upper bound of the time to mine in seconds, missing for the last, unbounded bucket
+	UpperBoundSeconds *uint64 `json:"upperBoundSeconds,omitempty"`
+}
+
+// TransactionDetails Transaction details
+type TransactionDetails struct {
+	CompetingTxs *[]string `json:"competingTxs"`
+
+	// ExtraInfo Extra information about the transaction
+	ExtraInfo *string `json:"extraInfo"`
+
+	// MerklePath Transaction Merkle path as a hex string in BUMP format [BRC-74](https://brc.dev/74)
+	MerklePath *string `json:"merklePath"`
+
+	// TxStatus Transaction status
+	TxStatus TransactionDetailsTxStatus `json:"txStatus"`
+
+	// Txid Transaction ID in hex
+	Txid string `json:"txid"`
+}
+
+// TransactionDetailsTxStatus Transaction status
+type TransactionDetailsTxStatus string
+
+// TransactionFederation Aggregated responses of the upstream ARC instances to the forwarded transaction
+type TransactionFederation struct {
+	// Federation Aggregation of the responses of the upstream ARC instances the transaction was forwarded to, only set if an aggregation policy is configured. If the policy is satisfied, txStatus is the most advanced status reached by at least the required number of upstreams, otherwise txStatus is UNKNOWN.
+	Federation *FederationResult `json:"federation,omitempty"`
+}
+
+// TransactionFee Fee of the submitted transaction
+type TransactionFee struct {
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee *FeeDetails `json:"fee,omitempty"`
+}
+
+// TransactionGraph defines model for TransactionGraph.
+type TransactionGraph struct {
+	// Transactions The requested transaction followed by its unmined ancestors and descendants known to ARC
+	Transactions []TransactionGraphNode `json:"transactions"`
+
+	// Truncated True if the graph has more transactions than the maximum number of transactions requested
+	Truncated bool `json:"truncated"`
+
+	// Txid Transaction ID of the requested transaction
+	Txid string `json:"txid"`
+}
+
+// TransactionGraphNode defines model for TransactionGraphNode.
+type TransactionGraphNode struct {
+	// Children IDs of the transactions of the graph which spend outputs of the transaction
+	Children []string `json:"children"`
+
+	// ExtraInfo Reason of the rejection of the transaction
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Fee Fee paid by the transaction in satoshis, null if the values of the outputs spent by the transaction are not known
+	Fee *uint64 `json:"fee"`
+
+	// Parents IDs of the transactions of the graph whose outputs are spent by the transaction
+	Parents []string `json:"parents"`
+
+	// Size Size of the transaction in bytes
+	Size *uint64 `json:"size"`
+
+	// TxStatus Transaction status
+	TxStatus string `json:"txStatus"`
+
+	// Txid Transaction ID
+	Txid string `json:"txid"`
+}
+
+// TransactionReceipt Receipt of the acceptance of the transaction signed by ARC
+type TransactionReceipt struct {
+	// Receipt Receipt of the acceptance of a transaction, only issued if enabled. The signature is the DER encoded ECDSA signature with the miner ID key of the SHA-256 hash of the fields txid, txStatus, policyId and timestamp joined by `|`, e.g. `<txid>|SEEN_ON_NETWORK|<policyId>|2024-01-01T12:00:00.123Z`.
+	Receipt *Receipt `json:"receipt,omitempty"`
+}
+
+// TransactionRequest defines model for TransactionRequest.
+type TransactionRequest struct {
+	// RawTx Raw hex string
+	RawTx string `json:"rawTx"`
 }
 
 // TransactionResponse defines model for TransactionResponse.
 type TransactionResponse struct {
+	// AlreadyKnown The transaction was already processed by ARC, so validation and processing were skipped and its stored status is returned
+	AlreadyKnown *bool `json:"alreadyKnown,omitempty"`
+
 	// BlockHash Block hash
 	BlockHash *string `json:"blockHash,omitempty"`
 
@@ -431,9 +1112,18 @@ type TransactionResponse struct {
 	// ExtraInfo Extra information about the transaction
 	ExtraInfo *string `json:"extraInfo"`
 
+	// Federation Aggregation of the responses of the upstream ARC instances the transaction was forwarded to, only set if an aggregation policy is configured. If the policy is satisfied, txStatus is the most advanced status reached by at least the required number of upstreams, otherwise txStatus is UNKNOWN.
+	Federation *FederationResult `json:"federation,omitempty"`
+
+	// Fee Fee paid by a transaction compared to the minimum fee required by the policy. Only available if the input amounts of the transaction are known.
+	Fee *FeeDetails `json:"fee,omitempty"`
+
 	// MerklePath Transaction Merkle path as a hex string in BUMP format [BRC-74](https://brc.dev/74)
 	MerklePath *string `json:"merklePath"`
 
+	// Receipt Receipt of the acceptance of a transaction, only issued if enabled. The signature is the DER encoded ECDSA signature with the miner ID key of the SHA-256 hash of the fields txid, txStatus, policyId and timestamp joined by `|`, e.g. `<txid>|SEEN_ON_NETWORK|<policyId>|2024-01-01T12:00:00.123Z`.
+	Receipt *Receipt `json:"receipt,omitempty"`
+
 	// Status Status
 	Status    int       `json:"status"`
 	Timestamp time.Time `json:"timestamp"`
@@ -446,6 +1136,9 @@ type TransactionResponse struct {
 
 	// Txid Transaction ID in hex
 	Txid string `json:"txid"`
+
+	// Warnings Non-fatal findings about the transaction, e.g. that it is close to a policy limit. The transaction has been accepted, but might be rejected if the policy is tightened.
+	Warnings *[]Warning `json:"warnings,omitempty"`
 }
 
 // TransactionResponseTxStatus Transaction status
@@ -463,19 +1156,37 @@ type TransactionResponses_Transactions_Item struct {
 
 // TransactionStatus defines model for TransactionStatus.
 type TransactionStatus struct {
+	// Annotations Notes and labels attached to the transaction by operators with the admin service, e.g. for the tracking of incidents, in the order in which they were added
+	Annotations *[]Annotation `json:"annotations"`
+
 	// BlockHash Block hash
 	BlockHash *string `json:"blockHash,omitempty"`
 
 	// BlockHeight Block height
-	BlockHeight  *uint64   `json:"blockHeight,omitempty"`
-	CompetingTxs *[]string `json:"competingTxs"`
+	BlockHeight *uint64 `json:"blockHeight,omitempty"`
+
+	// BlockTemplate Block template of a mining pool or node in which the unmined transaction is included, i.e. the transaction is expected to be mined in the next block
+	BlockTemplate *BlockTemplate `json:"blockTemplate"`
+	CompetingTxs  *[]string      `json:"competingTxs"`
+
+	// External True if the transaction is unknown to ARC and its status was looked up on a connected node, i.e. MINED with the block of the transaction or SEEN_ON_NETWORK if it is in the mempool of the node. External statuses have no Merkle path and are not tracked by ARC.
+	External *bool `json:"external"`
 
 	// ExtraInfo Extra information about the transaction
 	ExtraInfo *string `json:"extraInfo"`
 
 	// MerklePath Transaction Merkle path as a hex string in BUMP format [BRC-74](https://brc.dev/74)
-	MerklePath *string   `json:"merklePath"`
-	Timestamp  time.Time `json:"timestamp"`
+	MerklePath *string `json:"merklePath"`
+
+	// NodeSubmission Submission of the transaction to the node with sendrawtransaction, because no peer requested the transaction within the timeout after its announcement
+	NodeSubmission *NodeSubmission `json:"nodeSubmission"`
+
+	// Peers Peers which requested the transaction after its announcement or to which the transaction was sent. Re-announcements of the transaction skip the peers which have already requested it.
+	Peers     *[]PeerAck `json:"peers"`
+	Timestamp time.Time  `json:"timestamp"`
+
+	// Timings Timing breakdown of the processing stages the transaction has reached. Stages without a recorded timestamp, e.g. the validation if it was skipped, are omitted.
+	Timings *[]StageTiming `json:"timings"`
 
 	// TxStatus Transaction status
 	TxStatus TransactionStatusTxStatus `json:"txStatus"`
@@ -489,6 +1200,9 @@ type TransactionStatusTxStatus string
 
 // TransactionSubmitStatus Transaction submit status
 type TransactionSubmitStatus struct {
+	// AlreadyKnown The transaction was already processed by ARC, so validation and processing were skipped and its stored status is returned
+	AlreadyKnown *bool `json:"alreadyKnown,omitempty"`
+
 	// Status Status
 	Status int `json:"status"`
 
@@ -496,18 +1210,90 @@ type TransactionSubmitStatus struct {
 	Title string `json:"title"`
 }
 
+// TransactionWarnings Warnings about the submitted transaction
+type TransactionWarnings struct {
+	// Warnings Non-fatal findings about the transaction, e.g. that it is close to a policy limit. The transaction has been accepted, but might be rejected if the policy is tightened.
+	Warnings *[]Warning `json:"warnings,omitempty"`
+}
+
+// UpstreamAcknowledgement defines model for UpstreamAcknowledgement.
+type UpstreamAcknowledgement struct {
+	// Acknowledged True if the upstream accepted the transaction
+	Acknowledged bool `json:"acknowledged"`
+
+	// ExtraInfo Extra information about the response of the upstream, e.g. the reason why the transaction was not acknowledged
+	ExtraInfo *string `json:"extraInfo"`
+
+	// Name Name of the upstream ARC instance
+	Name string `json:"name"`
+
+	// Status Status code of the response of the upstream to the transaction, 0 if the upstream did not respond
+	Status int `json:"status"`
+
+	// Timestamp Time at which the upstream responded
+	Timestamp time.Time `json:"timestamp"`
+
+	// TxStatus Status of the transaction at the upstream, only set if the upstream returned a status
+	TxStatus *string `json:"txStatus"`
+}
+
+// UpstreamAcknowledgements defines model for UpstreamAcknowledgements.
+type UpstreamAcknowledgements struct {
+	// Acknowledged Number of upstream ARC instances which acknowledged the transaction
+	Acknowledged int `json:"acknowledged"`
+
+	// Txid Transaction ID of the forwarded transaction
+	Txid string `json:"txid"`
+
+	// Upstreams Responses of the upstream ARC instances ordered by their names
+	Upstreams []UpstreamAcknowledgement `json:"upstreams"`
+}
+
+// Warning Non-fatal finding about a submitted transaction
+type Warning struct {
+	// Code Warning code. `FEE_NEAR_MINIMUM` if the fee is less than 10% above the minimum fee, `LARGE_DATA_OUTPUT` if a data output has at least 100 KB or reaches 90% of the data carrier size, `NEAR_MAX_TX_SIZE` and `NEAR_MAX_SCRIPT_SIZE` if the transaction or one of its scripts reaches 90% of the maximum size of the policy `NON_STANDARD_SCRIPT` if a locking script is neither P2PKH, P2PK, multisig nor a data output and `MERKLE_ROOTS_UNVERIFIED` if the Merkle roots of a BEEF could not be verified and are verified again later.
+	Code WarningCode `json:"code"`
+
+	// Message Description of the warning
+	Message string `json:"message"`
+}
+
+// WarningCode Warning code. `FEE_NEAR_MINIMUM` if the fee is less than 10% above the minimum fee, `LARGE_DATA_OUTPUT` if a data output has at least 100 KB or reaches 90% of the data carrier size, `NEAR_MAX_TX_SIZE` and `NEAR_MAX_SCRIPT_SIZE` if the transaction or one of its scripts reaches 90% of the maximum size of the policy `NON_STANDARD_SCRIPT` if a locking script is neither P2PKH, P2PK, multisig nor a data output and `MERKLE_ROOTS_UNVERIFIED` if the Merkle roots of a BEEF could not be verified and are verified again later.
+type WarningCode string
+
+// AnnounceTo defines model for announceTo.
+type AnnounceTo = string
+
+// BroadcastAt defines model for broadcastAt.
+type BroadcastAt = time.Time
+
+// BroadcastDelay defines model for broadcastDelay.
+type BroadcastDelay = int
+
+// CallbackAllowDuplicates defines model for callbackAllowDuplicates.
+type CallbackAllowDuplicates = bool
+
 // CallbackBatch defines model for callbackBatch.
 type CallbackBatch = bool
 
+// CallbackSuppressionWindow defines model for callbackSuppressionWindow.
+type CallbackSuppressionWindow = int
+
 // CallbackToken defines model for callbackToken.
 type CallbackToken = string
 
 // CallbackUrl defines model for callbackUrl.
 type CallbackUrl = string
 
+// CallbackVersion defines model for callbackVersion.
+type CallbackVersion = int
+
 // CumulativeFeeValidation defines model for cumulativeFeeValidation.
 type CumulativeFeeValidation = bool
 
+// ExpiresAt defines model for expiresAt.
+type ExpiresAt = time.Time
+
 // ForceValidation defines model for forceValidation.
 type ForceValidation = bool
 
@@ -526,15 +1312,30 @@ type SkipScriptValidation = bool
 // SkipTxValidation defines model for skipTxValidation.
 type SkipTxValidation = bool
 
+// Ttl defines model for ttl.
+type Ttl = int
+
 // WaitFor defines model for waitFor.
 type WaitFor = string
 
+// ReadOnly defines model for ReadOnly.
+type ReadOnly = ErrorReadOnly
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorTooManyRequests
+
+// GETBlocksParams defines parameters for GETBlocks.
+type GETBlocksParams struct {
+	// Limit Maximum number of blocks, at most 100. Defaults to 10.
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // POSTTransactionTextBody defines parameters for POSTTransaction.
 type POSTTransactionTextBody = string
 
 // POSTTransactionParams defines parameters for POSTTransaction.
 type POSTTransactionParams struct {
-	// XCallbackUrl Default double spend and merkle proof notification callback endpoint.
+	// XCallbackUrl Default double spend and merkle proof notification callback endpoint. Multiple endpoints can be given as a comma separated list or as a JSON list of objects with url and token fields, e.g. [{"url":"https://a.com","token":"abc"},{"url":"https://b.com"}].
 	XCallbackUrl *CallbackUrl `json:"X-CallbackUrl,omitempty"`
 
 	// XFullStatusUpdates Whether we should have full status updates in callback or not (including SEEN_IN_ORPHAN_MEMPOOL and SEEN_ON_NETWORK statuses).
@@ -558,14 +1359,44 @@ type POSTTransactionParams struct {
 	// XCumulativeFeeValidation Whether we should perform cumulative fee validation for fee consolidation txs or not.
 	XCumulativeFeeValidation *CumulativeFeeValidation `json:"X-CumulativeFeeValidation,omitempty"`
 
-	// XCallbackToken Access token for notification callback endpoint. It will be used as a Authorization header for the http callback
+	// XCallbackToken Access token for notification callback endpoint. It will be used as a Authorization header for the http callback. In case of multiple callback endpoints a comma separated list of tokens is assigned to the endpoints by position
 	XCallbackToken *CallbackToken `json:"X-CallbackToken,omitempty"`
 
 	// XCallbackBatch Callback will be send in a batch
 	XCallbackBatch *CallbackBatch `json:"X-CallbackBatch,omitempty"`
 
+	// XCallbackVersion Version of the callback payload schema (1 or 2, default 1). Version 2 payloads contain an explicit version field and the `minedTxid` of mined malleated variants
+	XCallbackVersion *CallbackVersion `json:"X-CallbackVersion,omitempty"`
+
+	// XCallbackAllowDuplicates Whether the same transaction status should be sent again if it is emitted more than once (at-least-once delivery). By default, each status is sent only once per callback URL
+	XCallbackAllowDuplicates *CallbackAllowDuplicates `json:"X-CallbackAllowDuplicates,omitempty"`
+
+	// XCallbackSuppressionWindow Window in seconds within which the intermediate statuses of the transaction are coalesced for the callback URLs. Only the latest status at the end of the window is sent, a final status (MINED, REJECTED or EXPIRED) is sent immediately and discards the coalesced statuses. By default every status is sent
+	XCallbackSuppressionWindow *CallbackSuppressionWindow `json:"X-CallbackSuppressionWindow,omitempty"`
+
 	// XWaitFor Which status to wait for from the server before returning ('QUEUED', 'RECEIVED', 'STORED', 'ANNOUNCED_TO_NETWORK', 'REQUESTED_BY_NETWORK', 'SENT_TO_NETWORK', 'ACCEPTED_BY_NETWORK', 'SEEN_ON_NETWORK')
 	XWaitFor *WaitFor `json:"X-WaitFor,omitempty"`
+
+	// XBroadcastAt RFC 3339 timestamp at which the transaction is announced to the network. The transaction is validated and stored immediately, but not broadcast before the given time (at most 7 days ahead). Requests with a broadcast time do not wait for a status beyond STORED.
+	XBroadcastAt *BroadcastAt `json:"X-BroadcastAt,omitempty"`
+
+	// XBroadcastDelay Delay in seconds after which the transaction is announced to the network, as alternative to X-BroadcastAt.
+	XBroadcastDelay *BroadcastDelay `json:"X-BroadcastDelay,omitempty"`
+
+	// XExpiresAt RFC 3339 timestamp after which the transaction expires if it is not mined until then. ARC stops re-broadcasting an expired transaction and changes its status to EXPIRED, the final callback gives the expiry as reason. The expiry must lie in the future and after the broadcast time of the transaction.
+	XExpiresAt *ExpiresAt `json:"X-ExpiresAt,omitempty"`
+
+	// XTTL Validity window in seconds after which the transaction expires if it is not mined until then, as alternative to X-ExpiresAt.
+	XTTL *Ttl `json:"X-TTL,omitempty"`
+
+	// XAnnounceTo Restricts the peers the transaction is announced to. A number announces the transaction to that many peers, selected pseudo-randomly per transaction, a name announces it exclusively to the designated peer of that name configured by the operator, e.g. for mining-direct submission. By default the transaction is announced to all peers. The restriction also applies to the re-announcements of the transaction.
+	XAnnounceTo *AnnounceTo `json:"X-AnnounceTo,omitempty"`
+}
+
+// GETTransactionGraphParams defines parameters for GETTransactionGraph.
+type GETTransactionGraphParams struct {
+	// MaxNodes Maximum number of transactions in the graph, at most 1000. Defaults to 100.
+	MaxNodes *int `form:"maxNodes,omitempty" json:"maxNodes,omitempty"`
 }
 
 // POSTTransactionsJSONBody defines parameters for POSTTransactions.
@@ -576,7 +1407,7 @@ type POSTTransactionsTextBody = string
 
 // POSTTransactionsParams defines parameters for POSTTransactions.
 type POSTTransactionsParams struct {
-	// XCallbackUrl Default double spend and merkle proof notification callback endpoint.
+	// XCallbackUrl Default double spend and merkle proof notification callback endpoint. Multiple endpoints can be given as a comma separated list or as a JSON list of objects with url and token fields, e.g. [{"url":"https://a.com","token":"abc"},{"url":"https://b.com"}].
 	XCallbackUrl *CallbackUrl `json:"X-CallbackUrl,omitempty"`
 
 	// XFullStatusUpdates Whether we should have full status updates in callback or not (including SEEN_IN_ORPHAN_MEMPOOL and SEEN_ON_NETWORK statuses).
@@ -600,14 +1431,38 @@ type POSTTransactionsParams struct {
 	// XCumulativeFeeValidation Whether we should perform cumulative fee validation for fee consolidation txs or not.
 	XCumulativeFeeValidation *CumulativeFeeValidation `json:"X-CumulativeFeeValidation,omitempty"`
 
-	// XCallbackToken Access token for notification callback endpoint. It will be used as a Authorization header for the http callback
+	// XCallbackToken Access token for notification callback endpoint. It will be used as a Authorization header for the http callback. In case of multiple callback endpoints a comma separated list of tokens is assigned to the endpoints by position
 	XCallbackToken *CallbackToken `json:"X-CallbackToken,omitempty"`
 
 	// XCallbackBatch Callback will be send in a batch
 	XCallbackBatch *CallbackBatch `json:"X-CallbackBatch,omitempty"`
 
+	// XCallbackVersion Version of the callback payload schema (1 or 2, default 1). Version 2 payloads contain an explicit version field and the `minedTxid` of mined malleated variants
+	XCallbackVersion *CallbackVersion `json:"X-CallbackVersion,omitempty"`
+
+	// XCallbackAllowDuplicates Whether the same transaction status should be sent again if it is emitted more than once (at-least-once delivery). By default, each status is sent only once per callback URL
+	XCallbackAllowDuplicates *CallbackAllowDuplicates `json:"X-CallbackAllowDuplicates,omitempty"`
+
+	// XCallbackSuppressionWindow Window in seconds within which the intermediate statuses of the transaction are coalesced for the callback URLs. Only the latest status at the end of the window is sent, a final status (MINED, REJECTED or EXPIRED) is sent immediately and discards the coalesced statuses. By default every status is sent
+	XCallbackSuppressionWindow *CallbackSuppressionWindow `json:"X-CallbackSuppressionWindow,omitempty"`
+
 	// XWaitFor Which status to wait for from the server before returning ('QUEUED', 'RECEIVED', 'STORED', 'ANNOUNCED_TO_NETWORK', 'REQUESTED_BY_NETWORK', 'SENT_TO_NETWORK', 'ACCEPTED_BY_NETWORK', 'SEEN_ON_NETWORK')
 	XWaitFor *WaitFor `json:"X-WaitFor,omitempty"`
+
+	// XBroadcastAt RFC 3339 timestamp at which the transaction is announced to the network. The transaction is validated and stored immediately, but not broadcast before the given time (at most 7 days ahead). Requests with a broadcast time do not wait for a status beyond STORED.
+	XBroadcastAt *BroadcastAt `json:"X-BroadcastAt,omitempty"`
+
+	// XBroadcastDelay Delay in seconds after which the transaction is announced to the network, as alternative to X-BroadcastAt.
+	XBroadcastDelay *BroadcastDelay `json:"X-BroadcastDelay,omitempty"`
+
+	// XExpiresAt RFC 3339 timestamp after which the transaction expires if it is not mined until then. ARC stops re-broadcasting an expired transaction and changes its status to EXPIRED, the final callback gives the expiry as reason. The expiry must lie in the future and after the broadcast time of the transaction.
+	XExpiresAt *ExpiresAt `json:"X-ExpiresAt,omitempty"`
+
+	// XTTL Validity window in seconds after which the transaction expires if it is not mined until then, as alternative to X-ExpiresAt.
+	XTTL *Ttl `json:"X-TTL,omitempty"`
+
+	// XAnnounceTo Restricts the peers the transaction is announced to. A number announces the transaction to that many peers, selected pseudo-randomly per transaction, a name announces it exclusively to the designated peer of that name configured by the operator, e.g. for mining-direct submission. By default the transaction is announced to all peers. The restriction also applies to the re-announcements of the transaction.
+	XAnnounceTo *AnnounceTo `json:"X-AnnounceTo,omitempty"`
 }
 
 // POSTTransactionJSONRequestBody defines body for POSTTransaction for application/json ContentType.
@@ -949,12 +1804,21 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GETBlocks request
+	GETBlocks(ctx context.Context, params *GETBlocksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GETHealth request
 	GETHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GETOutpointSpent request
+	GETOutpointSpent(ctx context.Context, txid string, vout int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GETPolicy request
 	GETPolicy(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GETStats request
+	GETStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// POSTTransactionWithBody request with any body
 	POSTTransactionWithBody(ctx context.Context, params *POSTTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -962,9 +1826,27 @@ type ClientInterface interface {
 
 	POSTTransactionWithTextBody(ctx context.Context, params *POSTTransactionParams, body POSTTransactionTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DELETETransaction request
+	DELETETransaction(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GETTransactionStatus request
 	GETTransactionStatus(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GETTransactionGraph request
+	GETTransactionGraph(ctx context.Context, txid string, params *GETTransactionGraphParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GETTransactionReceipt request
+	GETTransactionReceipt(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// POSTTransactionResubmit request
+	POSTTransactionResubmit(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DELETETransactionSchedule request
+	DELETETransactionSchedule(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GETTransactionUpstreams request
+	GETTransactionUpstreams(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// POSTTransactionsWithBody request with any body
 	POSTTransactionsWithBody(ctx context.Context, params *POSTTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -973,6 +1855,18 @@ type ClientInterface interface {
 	POSTTransactionsWithTextBody(ctx context.Context, params *POSTTransactionsParams, body POSTTransactionsTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) GETBlocks(ctx context.Context, params *GETBlocksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGETBlocksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GETHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGETHealthRequest(c.Server)
 	if err != nil {
@@ -985,6 +1879,18 @@ func (c *Client) GETHealth(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
+func (c *Client) GETOutpointSpent(ctx context.Context, txid string, vout int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGETOutpointSpentRequest(c.Server, txid, vout)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GETPolicy(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGETPolicyRequest(c.Server)
 	if err != nil {
@@ -997,6 +1903,18 @@ func (c *Client) GETPolicy(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
+func (c *Client) GETStats(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGETStatsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) POSTTransactionWithBody(ctx context.Context, params *POSTTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewPOSTTransactionRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
@@ -1033,6 +1951,18 @@ func (c *Client) POSTTransactionWithTextBody(ctx context.Context, params *POSTTr
 	return c.Client.Do(req)
 }
 
+func (c *Client) DELETETransaction(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDELETETransactionRequest(c.Server, txid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GETTransactionStatus(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGETTransactionStatusRequest(c.Server, txid)
 	if err != nil {
@@ -1045,8 +1975,8 @@ func (c *Client) GETTransactionStatus(ctx context.Context, txid string, reqEdito
 	return c.Client.Do(req)
 }
 
-func (c *Client) POSTTransactionsWithBody(ctx context.Context, params *POSTTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPOSTTransactionsRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) GETTransactionGraph(ctx context.Context, txid string, params *GETTransactionGraphParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGETTransactionGraphRequest(c.Server, txid, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1057,8 +1987,8 @@ func (c *Client) POSTTransactionsWithBody(ctx context.Context, params *POSTTrans
 	return c.Client.Do(req)
 }
 
-func (c *Client) POSTTransactions(ctx context.Context, params *POSTTransactionsParams, body POSTTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPOSTTransactionsRequest(c.Server, params, body)
+func (c *Client) GETTransactionReceipt(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGETTransactionReceiptRequest(c.Server, txid)
 	if err != nil {
 		return nil, err
 	}
@@ -1069,8 +1999,8 @@ func (c *Client) POSTTransactions(ctx context.Context, params *POSTTransactionsP
 	return c.Client.Do(req)
 }
 
-func (c *Client) POSTTransactionsWithTextBody(ctx context.Context, params *POSTTransactionsParams, body POSTTransactionsTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPOSTTransactionsRequestWithTextBody(c.Server, params, body)
+func (c *Client) POSTTransactionResubmit(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPOSTTransactionResubmitRequest(c.Server, txid)
 	if err != nil {
 		return nil, err
 	}
@@ -1081,35 +2011,68 @@ func (c *Client) POSTTransactionsWithTextBody(ctx context.Context, params *POSTT
 	return c.Client.Do(req)
 }
 
-// NewGETHealthRequest generates requests for GETHealth
-func NewGETHealthRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DELETETransactionSchedule(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDELETETransactionScheduleRequest(c.Server, txid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/v1/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) GETTransactionUpstreams(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGETTransactionUpstreamsRequest(c.Server, txid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) POSTTransactionsWithBody(ctx context.Context, params *POSTTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPOSTTransactionsRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) POSTTransactions(ctx context.Context, params *POSTTransactionsParams, body POSTTransactionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPOSTTransactionsRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) POSTTransactionsWithTextBody(ctx context.Context, params *POSTTransactionsParams, body POSTTransactionsTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPOSTTransactionsRequestWithTextBody(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGETPolicyRequest generates requests for GETPolicy
-func NewGETPolicyRequest(server string) (*http.Request, error) {
+// NewGETBlocksRequest generates requests for GETBlocks
+func NewGETBlocksRequest(server string, params *GETBlocksParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1117,7 +2080,151 @@ func NewGETPolicyRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/policy")
+	operationPath := fmt.Sprintf("/v1/blocks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGETHealthRequest generates requests for GETHealth
+func NewGETHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGETOutpointSpentRequest generates requests for GETOutpointSpent
+func NewGETOutpointSpentRequest(server string, txid string, vout int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "vout", runtime.ParamLocationPath, vout)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/outpoint/%s/%s/spent", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGETPolicyRequest generates requests for GETPolicy
+func NewGETPolicyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGETStatsRequest generates requests for GETStats
+func NewGETStatsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/stats")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1291,15 +2398,103 @@ func NewPOSTTransactionRequestWithBody(server string, params *POSTTransactionPar
 			req.Header.Set("X-CallbackBatch", headerParam9)
 		}
 
-		if params.XWaitFor != nil {
+		if params.XCallbackVersion != nil {
 			var headerParam10 string
 
-			headerParam10, err = runtime.StyleParamWithLocation("simple", false, "X-WaitFor", runtime.ParamLocationHeader, *params.XWaitFor)
+			headerParam10, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackVersion", runtime.ParamLocationHeader, *params.XCallbackVersion)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CallbackVersion", headerParam10)
+		}
+
+		if params.XCallbackAllowDuplicates != nil {
+			var headerParam11 string
+
+			headerParam11, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackAllowDuplicates", runtime.ParamLocationHeader, *params.XCallbackAllowDuplicates)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CallbackAllowDuplicates", headerParam11)
+		}
+
+		if params.XCallbackSuppressionWindow != nil {
+			var headerParam12 string
+
+			headerParam12, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackSuppressionWindow", runtime.ParamLocationHeader, *params.XCallbackSuppressionWindow)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CallbackSuppressionWindow", headerParam12)
+		}
+
+		if params.XWaitFor != nil {
+			var headerParam13 string
+
+			headerParam13, err = runtime.StyleParamWithLocation("simple", false, "X-WaitFor", runtime.ParamLocationHeader, *params.XWaitFor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-WaitFor", headerParam13)
+		}
+
+		if params.XBroadcastAt != nil {
+			var headerParam14 string
+
+			headerParam14, err = runtime.StyleParamWithLocation("simple", false, "X-BroadcastAt", runtime.ParamLocationHeader, *params.XBroadcastAt)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-BroadcastAt", headerParam14)
+		}
+
+		if params.XBroadcastDelay != nil {
+			var headerParam15 string
+
+			headerParam15, err = runtime.StyleParamWithLocation("simple", false, "X-BroadcastDelay", runtime.ParamLocationHeader, *params.XBroadcastDelay)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-BroadcastDelay", headerParam15)
+		}
+
+		if params.XExpiresAt != nil {
+			var headerParam16 string
+
+			headerParam16, err = runtime.StyleParamWithLocation("simple", false, "X-ExpiresAt", runtime.ParamLocationHeader, *params.XExpiresAt)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-ExpiresAt", headerParam16)
+		}
+
+		if params.XTTL != nil {
+			var headerParam17 string
+
+			headerParam17, err = runtime.StyleParamWithLocation("simple", false, "X-TTL", runtime.ParamLocationHeader, *params.XTTL)
 			if err != nil {
 				return nil, err
 			}
 
-			req.Header.Set("X-WaitFor", headerParam10)
+			req.Header.Set("X-TTL", headerParam17)
+		}
+
+		if params.XAnnounceTo != nil {
+			var headerParam18 string
+
+			headerParam18, err = runtime.StyleParamWithLocation("simple", false, "X-AnnounceTo", runtime.ParamLocationHeader, *params.XAnnounceTo)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-AnnounceTo", headerParam18)
 		}
 
 	}
@@ -1307,8 +2502,8 @@ func NewPOSTTransactionRequestWithBody(server string, params *POSTTransactionPar
 	return req, nil
 }
 
-// NewGETTransactionStatusRequest generates requests for GETTransactionStatus
-func NewGETTransactionStatusRequest(server string, txid string) (*http.Request, error) {
+// NewDELETETransactionRequest generates requests for DELETETransaction
+func NewDELETETransactionRequest(server string, txid string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -1333,7 +2528,7 @@ func NewGETTransactionStatusRequest(server string, txid string) (*http.Request,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1341,34 +2536,23 @@ func NewGETTransactionStatusRequest(server string, txid string) (*http.Request,
 	return req, nil
 }
 
-// NewPOSTTransactionsRequest calls the generic POSTTransactions builder with application/json body
-func NewPOSTTransactionsRequest(server string, params *POSTTransactionsParams, body POSTTransactionsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGETTransactionStatusRequest generates requests for GETTransactionStatus
+func NewGETTransactionStatusRequest(server string, txid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPOSTTransactionsRequestWithBody(server, params, "application/json", bodyReader)
-}
-
-// NewPOSTTransactionsRequestWithTextBody calls the generic POSTTransactions builder with text/plain body
-func NewPOSTTransactionsRequestWithTextBody(server string, params *POSTTransactionsParams, body POSTTransactionsTextRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	bodyReader = strings.NewReader(string(body))
-	return NewPOSTTransactionsRequestWithBody(server, params, "text/plain", bodyReader)
-}
-
-// NewPOSTTransactionsRequestWithBody generates requests for POSTTransactions with any type of body
-func NewPOSTTransactionsRequestWithBody(server string, params *POSTTransactionsParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/txs")
+	operationPath := fmt.Sprintf("/v1/tx/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1378,39 +2562,276 @@ func NewPOSTTransactionsRequestWithBody(server string, params *POSTTransactionsP
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
 
-	if params != nil {
+// NewGETTransactionGraphRequest generates requests for GETTransactionGraph
+func NewGETTransactionGraphRequest(server string, txid string, params *GETTransactionGraphParams) (*http.Request, error) {
+	var err error
 
-		if params.XCallbackUrl != nil {
-			var headerParam0 string
+	var pathParam0 string
 
-			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackUrl", runtime.ParamLocationHeader, *params.XCallbackUrl)
-			if err != nil {
-				return nil, err
-			}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
 
-			req.Header.Set("X-CallbackUrl", headerParam0)
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.XFullStatusUpdates != nil {
-			var headerParam1 string
+	operationPath := fmt.Sprintf("/v1/tx/%s/graph", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-FullStatusUpdates", runtime.ParamLocationHeader, *params.XFullStatusUpdates)
-			if err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.MaxNodes != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxNodes", runtime.ParamLocationQuery, *params.MaxNodes); err != nil {
 				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
 			}
 
-			req.Header.Set("X-FullStatusUpdates", headerParam1)
 		}
 
-		if params.XMaxTimeout != nil {
-			var headerParam2 string
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGETTransactionReceiptRequest generates requests for GETTransactionReceipt
+func NewGETTransactionReceiptRequest(server string, txid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tx/%s/receipt", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPOSTTransactionResubmitRequest generates requests for POSTTransactionResubmit
+func NewPOSTTransactionResubmitRequest(server string, txid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tx/%s/resubmit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDELETETransactionScheduleRequest generates requests for DELETETransactionSchedule
+func NewDELETETransactionScheduleRequest(server string, txid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tx/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGETTransactionUpstreamsRequest generates requests for GETTransactionUpstreams
+func NewGETTransactionUpstreamsRequest(server string, txid string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "txid", runtime.ParamLocationPath, txid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/tx/%s/upstreams", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPOSTTransactionsRequest calls the generic POSTTransactions builder with application/json body
+func NewPOSTTransactionsRequest(server string, params *POSTTransactionsParams, body POSTTransactionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPOSTTransactionsRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPOSTTransactionsRequestWithTextBody calls the generic POSTTransactions builder with text/plain body
+func NewPOSTTransactionsRequestWithTextBody(server string, params *POSTTransactionsParams, body POSTTransactionsTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewPOSTTransactionsRequestWithBody(server, params, "text/plain", bodyReader)
+}
+
+// NewPOSTTransactionsRequestWithBody generates requests for POSTTransactions with any type of body
+func NewPOSTTransactionsRequestWithBody(server string, params *POSTTransactionsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/txs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.XCallbackUrl != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackUrl", runtime.ParamLocationHeader, *params.XCallbackUrl)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-CallbackUrl", headerParam0)
+		}
+
+		if params.XFullStatusUpdates != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-FullStatusUpdates", runtime.ParamLocationHeader, *params.XFullStatusUpdates)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-FullStatusUpdates", headerParam1)
+		}
+
+		if params.XMaxTimeout != nil {
+			var headerParam2 string
 
 			headerParam2, err = runtime.StyleParamWithLocation("simple", false, "X-MaxTimeout", runtime.ParamLocationHeader, *params.XMaxTimeout)
 			if err != nil {
@@ -1497,81 +2918,196 @@ func NewPOSTTransactionsRequestWithBody(server string, params *POSTTransactionsP
 			req.Header.Set("X-CallbackBatch", headerParam9)
 		}
 
-		if params.XWaitFor != nil {
+		if params.XCallbackVersion != nil {
 			var headerParam10 string
 
-			headerParam10, err = runtime.StyleParamWithLocation("simple", false, "X-WaitFor", runtime.ParamLocationHeader, *params.XWaitFor)
+			headerParam10, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackVersion", runtime.ParamLocationHeader, *params.XCallbackVersion)
 			if err != nil {
 				return nil, err
 			}
 
-			req.Header.Set("X-WaitFor", headerParam10)
+			req.Header.Set("X-CallbackVersion", headerParam10)
 		}
 
-	}
+		if params.XCallbackAllowDuplicates != nil {
+			var headerParam11 string
 
-	return req, nil
-}
+			headerParam11, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackAllowDuplicates", runtime.ParamLocationHeader, *params.XCallbackAllowDuplicates)
+			if err != nil {
+				return nil, err
+			}
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+			req.Header.Set("X-CallbackAllowDuplicates", headerParam11)
 		}
-	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+		if params.XCallbackSuppressionWindow != nil {
+			var headerParam12 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
-}
+			headerParam12, err = runtime.StyleParamWithLocation("simple", false, "X-CallbackSuppressionWindow", runtime.ParamLocationHeader, *params.XCallbackSuppressionWindow)
+			if err != nil {
+				return nil, err
+			}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+			req.Header.Set("X-CallbackSuppressionWindow", headerParam12)
 		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GETHealthWithResponse request
-	GETHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETHealthResponse, error)
 
-	// GETPolicyWithResponse request
-	GETPolicyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETPolicyResponse, error)
+		if params.XWaitFor != nil {
+			var headerParam13 string
 
-	// POSTTransactionWithBodyWithResponse request with any body
+			headerParam13, err = runtime.StyleParamWithLocation("simple", false, "X-WaitFor", runtime.ParamLocationHeader, *params.XWaitFor)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-WaitFor", headerParam13)
+		}
+
+		if params.XBroadcastAt != nil {
+			var headerParam14 string
+
+			headerParam14, err = runtime.StyleParamWithLocation("simple", false, "X-BroadcastAt", runtime.ParamLocationHeader, *params.XBroadcastAt)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-BroadcastAt", headerParam14)
+		}
+
+		if params.XBroadcastDelay != nil {
+			var headerParam15 string
+
+			headerParam15, err = runtime.StyleParamWithLocation("simple", false, "X-BroadcastDelay", runtime.ParamLocationHeader, *params.XBroadcastDelay)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-BroadcastDelay", headerParam15)
+		}
+
+		if params.XExpiresAt != nil {
+			var headerParam16 string
+
+			headerParam16, err = runtime.StyleParamWithLocation("simple", false, "X-ExpiresAt", runtime.ParamLocationHeader, *params.XExpiresAt)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-ExpiresAt", headerParam16)
+		}
+
+		if params.XTTL != nil {
+			var headerParam17 string
+
+			headerParam17, err = runtime.StyleParamWithLocation("simple", false, "X-TTL", runtime.ParamLocationHeader, *params.XTTL)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-TTL", headerParam17)
+		}
+
+		if params.XAnnounceTo != nil {
+			var headerParam18 string
+
+			headerParam18, err = runtime.StyleParamWithLocation("simple", false, "X-AnnounceTo", runtime.ParamLocationHeader, *params.XAnnounceTo)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("X-AnnounceTo", headerParam18)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GETBlocksWithResponse request
+	GETBlocksWithResponse(ctx context.Context, params *GETBlocksParams, reqEditors ...RequestEditorFn) (*GETBlocksResponse, error)
+
+	// GETHealthWithResponse request
+	GETHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETHealthResponse, error)
+
+	// GETOutpointSpentWithResponse request
+	GETOutpointSpentWithResponse(ctx context.Context, txid string, vout int, reqEditors ...RequestEditorFn) (*GETOutpointSpentResponse, error)
+
+	// GETPolicyWithResponse request
+	GETPolicyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETPolicyResponse, error)
+
+	// GETStatsWithResponse request
+	GETStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETStatsResponse, error)
+
+	// POSTTransactionWithBodyWithResponse request with any body
 	POSTTransactionWithBodyWithResponse(ctx context.Context, params *POSTTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error)
 
 	POSTTransactionWithResponse(ctx context.Context, params *POSTTransactionParams, body POSTTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error)
 
 	POSTTransactionWithTextBodyWithResponse(ctx context.Context, params *POSTTransactionParams, body POSTTransactionTextRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error)
 
+	// DELETETransactionWithResponse request
+	DELETETransactionWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*DELETETransactionResponse, error)
+
 	// GETTransactionStatusWithResponse request
 	GETTransactionStatusWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GETTransactionStatusResponse, error)
 
+	// GETTransactionGraphWithResponse request
+	GETTransactionGraphWithResponse(ctx context.Context, txid string, params *GETTransactionGraphParams, reqEditors ...RequestEditorFn) (*GETTransactionGraphResponse, error)
+
+	// GETTransactionReceiptWithResponse request
+	GETTransactionReceiptWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GETTransactionReceiptResponse, error)
+
+	// POSTTransactionResubmitWithResponse request
+	POSTTransactionResubmitWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*POSTTransactionResubmitResponse, error)
+
+	// DELETETransactionScheduleWithResponse request
+	DELETETransactionScheduleWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*DELETETransactionScheduleResponse, error)
+
+	// GETTransactionUpstreamsWithResponse request
+	GETTransactionUpstreamsWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GETTransactionUpstreamsResponse, error)
+
 	// POSTTransactionsWithBodyWithResponse request with any body
 	POSTTransactionsWithBodyWithResponse(ctx context.Context, params *POSTTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*POSTTransactionsResponse, error)
 
@@ -1580,6 +3116,29 @@ type ClientWithResponsesInterface interface {
 	POSTTransactionsWithTextBodyWithResponse(ctx context.Context, params *POSTTransactionsParams, body POSTTransactionsTextRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionsResponse, error)
 }
 
+type GETBlocksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Blocks
+	JSON409      *ErrorGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r GETBlocksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GETBlocksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GETHealthResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1602,6 +3161,31 @@ func (r GETHealthResponse) StatusCode() int {
 	return 0
 }
 
+type GETOutpointSpentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OutpointSpent
+	JSON400      *ErrorBadRequest
+	JSON404      *ErrorNotFound
+	JSON409      *ErrorGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r GETOutpointSpentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GETOutpointSpentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GETPolicyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1624,13 +3208,38 @@ func (r GETPolicyResponse) StatusCode() int {
 	return 0
 }
 
+type GETStatsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Stats
+	JSON404      *ErrorNotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r GETStatsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GETStatsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type POSTTransactionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *TransactionResponse
 	JSON400      *ErrorBadRequest
 	JSON409      *ErrorGeneric
+	JSON415      *ErrorUnsupportedMediaType
 	JSON422      *Error
+	JSON429      *TooManyRequests
 	JSON460      *ErrorTxFormat
 	JSON461      *ErrorUnlockingScripts
 	JSON462      *ErrorInputs
@@ -1641,6 +3250,14 @@ type POSTTransactionResponse struct {
 	JSON468      *ErrorCalculatingMerkleRoots
 	JSON469      *ErrorValidatingMerkleRoots
 	JSON473      *ErrorCumulativeFees
+	JSON476      *ErrorScriptTemplateNotAllowed
+	JSON477      *ErrorOpcodeLimitExceeded
+	JSON478      *ErrorTemplateRateClamped
+	JSON479      *ErrorAbuseThrottled
+	JSON480      *ErrorAbuseRejected
+	JSON481      *ErrorComplianceBlocked
+	JSON482      *ErrorNonFinal
+	JSON503      *ReadOnly
 }
 
 // Status returns HTTPResponse.Status
@@ -1659,6 +3276,30 @@ func (r POSTTransactionResponse) StatusCode() int {
 	return 0
 }
 
+type DELETETransactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorNotFound
+	JSON409      *ErrorGeneric
+	JSON503      *ReadOnly
+}
+
+// Status returns HTTPResponse.Status
+func (r DELETETransactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DELETETransactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GETTransactionStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1683,26 +3324,16 @@ func (r GETTransactionStatusResponse) StatusCode() int {
 	return 0
 }
 
-type POSTTransactionsResponse struct {
+type GETTransactionGraphResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *TransactionResponses
-	JSON400      *ErrorBadRequest
+	JSON200      *TransactionGraph
+	JSON404      *ErrorNotFound
 	JSON409      *ErrorGeneric
-	JSON460      *ErrorTxFormat
-	JSON461      *ErrorUnlockingScripts
-	JSON462      *ErrorInputs
-	JSON463      *ErrorMalformed
-	JSON464      *ErrorOutputs
-	JSON465      *ErrorFee
-	JSON467      *ErrorMinedAncestorsNotFound
-	JSON468      *ErrorCalculatingMerkleRoots
-	JSON469      *ErrorValidatingMerkleRoots
-	JSON473      *ErrorCumulativeFees
 }
 
 // Status returns HTTPResponse.Status
-func (r POSTTransactionsResponse) Status() string {
+func (r GETTransactionGraphResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1710,54 +3341,231 @@ func (r POSTTransactionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r POSTTransactionsResponse) StatusCode() int {
+func (r GETTransactionGraphResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-// GETHealthWithResponse request returning *GETHealthResponse
-func (c *ClientWithResponses) GETHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETHealthResponse, error) {
-	rsp, err := c.GETHealth(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGETHealthResponse(rsp)
+type GETTransactionReceiptResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReceiptVerification
+	JSON404      *ErrorNotFound
+	JSON409      *ErrorGeneric
 }
 
-// GETPolicyWithResponse request returning *GETPolicyResponse
-func (c *ClientWithResponses) GETPolicyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETPolicyResponse, error) {
-	rsp, err := c.GETPolicy(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+// Status returns HTTPResponse.Status
+func (r GETTransactionReceiptResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParseGETPolicyResponse(rsp)
+	return http.StatusText(0)
 }
 
-// POSTTransactionWithBodyWithResponse request with arbitrary body returning *POSTTransactionResponse
-func (c *ClientWithResponses) POSTTransactionWithBodyWithResponse(ctx context.Context, params *POSTTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error) {
-	rsp, err := c.POSTTransactionWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r GETTransactionReceiptResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePOSTTransactionResponse(rsp)
+	return 0
 }
 
-func (c *ClientWithResponses) POSTTransactionWithResponse(ctx context.Context, params *POSTTransactionParams, body POSTTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error) {
-	rsp, err := c.POSTTransaction(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+type POSTTransactionResubmitResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionStatus
+	JSON404      *ErrorNotFound
+	JSON409      *ErrorGeneric
+	JSON503      *ReadOnly
+}
+
+// Status returns HTTPResponse.Status
+func (r POSTTransactionResubmitResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
-	return ParsePOSTTransactionResponse(rsp)
+	return http.StatusText(0)
 }
 
-func (c *ClientWithResponses) POSTTransactionWithTextBodyWithResponse(ctx context.Context, params *POSTTransactionParams, body POSTTransactionTextRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error) {
-	rsp, err := c.POSTTransactionWithTextBody(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r POSTTransactionResubmitResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
-	return ParsePOSTTransactionResponse(rsp)
+	return 0
+}
+
+type DELETETransactionScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorNotFound
+	JSON409      *ErrorGeneric
+	JSON503      *ReadOnly
+}
+
+// Status returns HTTPResponse.Status
+func (r DELETETransactionScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DELETETransactionScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GETTransactionUpstreamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpstreamAcknowledgements
+	JSON404      *ErrorNotFound
+	JSON409      *ErrorGeneric
+}
+
+// Status returns HTTPResponse.Status
+func (r GETTransactionUpstreamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GETTransactionUpstreamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type POSTTransactionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransactionResponses
+	JSON400      *ErrorBadRequest
+	JSON409      *ErrorGeneric
+	JSON415      *ErrorUnsupportedMediaType
+	JSON429      *TooManyRequests
+	JSON460      *ErrorTxFormat
+	JSON461      *ErrorUnlockingScripts
+	JSON462      *ErrorInputs
+	JSON463      *ErrorMalformed
+	JSON464      *ErrorOutputs
+	JSON465      *ErrorFee
+	JSON467      *ErrorMinedAncestorsNotFound
+	JSON468      *ErrorCalculatingMerkleRoots
+	JSON469      *ErrorValidatingMerkleRoots
+	JSON473      *ErrorCumulativeFees
+	JSON476      *ErrorScriptTemplateNotAllowed
+	JSON477      *ErrorOpcodeLimitExceeded
+	JSON478      *ErrorTemplateRateClamped
+	JSON479      *ErrorAbuseThrottled
+	JSON480      *ErrorAbuseRejected
+	JSON481      *ErrorComplianceBlocked
+	JSON482      *ErrorNonFinal
+	JSON503      *ReadOnly
+}
+
+// Status returns HTTPResponse.Status
+func (r POSTTransactionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r POSTTransactionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GETBlocksWithResponse request returning *GETBlocksResponse
+func (c *ClientWithResponses) GETBlocksWithResponse(ctx context.Context, params *GETBlocksParams, reqEditors ...RequestEditorFn) (*GETBlocksResponse, error) {
+	rsp, err := c.GETBlocks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETBlocksResponse(rsp)
+}
+
+// GETHealthWithResponse request returning *GETHealthResponse
+func (c *ClientWithResponses) GETHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETHealthResponse, error) {
+	rsp, err := c.GETHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETHealthResponse(rsp)
+}
+
+// GETOutpointSpentWithResponse request returning *GETOutpointSpentResponse
+func (c *ClientWithResponses) GETOutpointSpentWithResponse(ctx context.Context, txid string, vout int, reqEditors ...RequestEditorFn) (*GETOutpointSpentResponse, error) {
+	rsp, err := c.GETOutpointSpent(ctx, txid, vout, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETOutpointSpentResponse(rsp)
+}
+
+// GETPolicyWithResponse request returning *GETPolicyResponse
+func (c *ClientWithResponses) GETPolicyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETPolicyResponse, error) {
+	rsp, err := c.GETPolicy(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETPolicyResponse(rsp)
+}
+
+// GETStatsWithResponse request returning *GETStatsResponse
+func (c *ClientWithResponses) GETStatsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GETStatsResponse, error) {
+	rsp, err := c.GETStats(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETStatsResponse(rsp)
+}
+
+// POSTTransactionWithBodyWithResponse request with arbitrary body returning *POSTTransactionResponse
+func (c *ClientWithResponses) POSTTransactionWithBodyWithResponse(ctx context.Context, params *POSTTransactionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error) {
+	rsp, err := c.POSTTransactionWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePOSTTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) POSTTransactionWithResponse(ctx context.Context, params *POSTTransactionParams, body POSTTransactionJSONRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error) {
+	rsp, err := c.POSTTransaction(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePOSTTransactionResponse(rsp)
+}
+
+func (c *ClientWithResponses) POSTTransactionWithTextBodyWithResponse(ctx context.Context, params *POSTTransactionParams, body POSTTransactionTextRequestBody, reqEditors ...RequestEditorFn) (*POSTTransactionResponse, error) {
+	rsp, err := c.POSTTransactionWithTextBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePOSTTransactionResponse(rsp)
+}
+
+// DELETETransactionWithResponse request returning *DELETETransactionResponse
+func (c *ClientWithResponses) DELETETransactionWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*DELETETransactionResponse, error) {
+	rsp, err := c.DELETETransaction(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDELETETransactionResponse(rsp)
 }
 
 // GETTransactionStatusWithResponse request returning *GETTransactionStatusResponse
@@ -1769,6 +3577,51 @@ func (c *ClientWithResponses) GETTransactionStatusWithResponse(ctx context.Conte
 	return ParseGETTransactionStatusResponse(rsp)
 }
 
+// GETTransactionGraphWithResponse request returning *GETTransactionGraphResponse
+func (c *ClientWithResponses) GETTransactionGraphWithResponse(ctx context.Context, txid string, params *GETTransactionGraphParams, reqEditors ...RequestEditorFn) (*GETTransactionGraphResponse, error) {
+	rsp, err := c.GETTransactionGraph(ctx, txid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETTransactionGraphResponse(rsp)
+}
+
+// GETTransactionReceiptWithResponse request returning *GETTransactionReceiptResponse
+func (c *ClientWithResponses) GETTransactionReceiptWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GETTransactionReceiptResponse, error) {
+	rsp, err := c.GETTransactionReceipt(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETTransactionReceiptResponse(rsp)
+}
+
+// POSTTransactionResubmitWithResponse request returning *POSTTransactionResubmitResponse
+func (c *ClientWithResponses) POSTTransactionResubmitWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*POSTTransactionResubmitResponse, error) {
+	rsp, err := c.POSTTransactionResubmit(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePOSTTransactionResubmitResponse(rsp)
+}
+
+// DELETETransactionScheduleWithResponse request returning *DELETETransactionScheduleResponse
+func (c *ClientWithResponses) DELETETransactionScheduleWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*DELETETransactionScheduleResponse, error) {
+	rsp, err := c.DELETETransactionSchedule(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDELETETransactionScheduleResponse(rsp)
+}
+
+// GETTransactionUpstreamsWithResponse request returning *GETTransactionUpstreamsResponse
+func (c *ClientWithResponses) GETTransactionUpstreamsWithResponse(ctx context.Context, txid string, reqEditors ...RequestEditorFn) (*GETTransactionUpstreamsResponse, error) {
+	rsp, err := c.GETTransactionUpstreams(ctx, txid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGETTransactionUpstreamsResponse(rsp)
+}
+
 // POSTTransactionsWithBodyWithResponse request with arbitrary body returning *POSTTransactionsResponse
 func (c *ClientWithResponses) POSTTransactionsWithBodyWithResponse(ctx context.Context, params *POSTTransactionsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*POSTTransactionsResponse, error) {
 	rsp, err := c.POSTTransactionsWithBody(ctx, params, contentType, body, reqEditors...)
@@ -1794,6 +3647,39 @@ func (c *ClientWithResponses) POSTTransactionsWithTextBodyWithResponse(ctx conte
 	return ParsePOSTTransactionsResponse(rsp)
 }
 
+// ParseGETBlocksResponse parses an HTTP response from a GETBlocksWithResponse call
+func ParseGETBlocksResponse(rsp *http.Response) (*GETBlocksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GETBlocksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Blocks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGETHealthResponse parses an HTTP response from a GETHealthWithResponse call
 func ParseGETHealthResponse(rsp *http.Response) (*GETHealthResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1802,77 +3688,424 @@ func ParseGETHealthResponse(rsp *http.Response) (*GETHealthResponse, error) {
 		return nil, err
 	}
 
-	response := &GETHealthResponse{
+	response := &GETHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Health
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGETOutpointSpentResponse parses an HTTP response from a GETOutpointSpentWithResponse call
+func ParseGETOutpointSpentResponse(rsp *http.Response) (*GETOutpointSpentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GETOutpointSpentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OutpointSpent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGETPolicyResponse parses an HTTP response from a GETPolicyWithResponse call
+func ParseGETPolicyResponse(rsp *http.Response) (*GETPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GETPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGETStatsResponse parses an HTTP response from a GETStatsWithResponse call
+func ParseGETStatsResponse(rsp *http.Response) (*GETStatsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GETStatsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Stats
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePOSTTransactionResponse parses an HTTP response from a POSTTransactionWithResponse call
+func ParsePOSTTransactionResponse(rsp *http.Response) (*POSTTransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &POSTTransactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorBadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorUnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON422 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 460:
+		var dest ErrorTxFormat
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON460 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 461:
+		var dest ErrorUnlockingScripts
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON461 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 462:
+		var dest ErrorInputs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON462 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 463:
+		var dest ErrorMalformed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON463 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 464:
+		var dest ErrorOutputs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON464 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 465:
+		var dest ErrorFee
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON465 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 467:
+		var dest ErrorMinedAncestorsNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON467 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 468:
+		var dest ErrorCalculatingMerkleRoots
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON468 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 469:
+		var dest ErrorValidatingMerkleRoots
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON469 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 473:
+		var dest ErrorCumulativeFees
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON473 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 476:
+		var dest ErrorScriptTemplateNotAllowed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON476 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 477:
+		var dest ErrorOpcodeLimitExceeded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON477 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 478:
+		var dest ErrorTemplateRateClamped
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON478 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 479:
+		var dest ErrorAbuseThrottled
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON479 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 480:
+		var dest ErrorAbuseRejected
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON480 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 481:
+		var dest ErrorComplianceBlocked
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON481 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 482:
+		var dest ErrorNonFinal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON482 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ReadOnly
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDELETETransactionResponse parses an HTTP response from a DELETETransactionWithResponse call
+func ParseDELETETransactionResponse(rsp *http.Response) (*DELETETransactionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DELETETransactionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Health
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ReadOnly
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGETPolicyResponse parses an HTTP response from a GETPolicyWithResponse call
-func ParseGETPolicyResponse(rsp *http.Response) (*GETPolicyResponse, error) {
+// ParseGETTransactionStatusResponse parses an HTTP response from a GETTransactionStatusWithResponse call
+func ParseGETTransactionStatusResponse(rsp *http.Response) (*GETTransactionStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GETPolicyResponse{
+	response := &GETTransactionStatusResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PolicyResponse
+		var dest TransactionStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePOSTTransactionResponse parses an HTTP response from a POSTTransactionWithResponse call
-func ParsePOSTTransactionResponse(rsp *http.Response) (*POSTTransactionResponse, error) {
+// ParseGETTransactionGraphResponse parses an HTTP response from a GETTransactionGraphWithResponse call
+func ParseGETTransactionGraphResponse(rsp *http.Response) (*GETTransactionGraphResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &POSTTransactionResponse{
+	response := &GETTransactionGraphResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionResponse
+		var dest TransactionGraph
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ErrorBadRequest
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
 		var dest ErrorGeneric
@@ -1881,104 +4114,154 @@ func ParsePOSTTransactionResponse(rsp *http.Response) (*POSTTransactionResponse,
 		}
 		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 422:
-		var dest Error
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON422 = &dest
+	}
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 460:
-		var dest ErrorTxFormat
+	return response, nil
+}
+
+// ParseGETTransactionReceiptResponse parses an HTTP response from a GETTransactionReceiptWithResponse call
+func ParseGETTransactionReceiptResponse(rsp *http.Response) (*GETTransactionReceiptResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GETTransactionReceiptResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReceiptVerification
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON460 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 461:
-		var dest ErrorUnlockingScripts
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON461 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 462:
-		var dest ErrorInputs
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON462 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 463:
-		var dest ErrorMalformed
+	}
+
+	return response, nil
+}
+
+// ParsePOSTTransactionResubmitResponse parses an HTTP response from a POSTTransactionResubmitWithResponse call
+func ParsePOSTTransactionResubmitResponse(rsp *http.Response) (*POSTTransactionResubmitResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &POSTTransactionResubmitResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransactionStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON463 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 464:
-		var dest ErrorOutputs
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON464 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 465:
-		var dest ErrorFee
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON465 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 467:
-		var dest ErrorMinedAncestorsNotFound
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ReadOnly
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON467 = &dest
+		response.JSON503 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 468:
-		var dest ErrorCalculatingMerkleRoots
+	}
+
+	return response, nil
+}
+
+// ParseDELETETransactionScheduleResponse parses an HTTP response from a DELETETransactionScheduleWithResponse call
+func ParseDELETETransactionScheduleResponse(rsp *http.Response) (*DELETETransactionScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DELETETransactionScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorNotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON468 = &dest
+		response.JSON404 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 469:
-		var dest ErrorValidatingMerkleRoots
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorGeneric
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON469 = &dest
+		response.JSON409 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 473:
-		var dest ErrorCumulativeFees
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ReadOnly
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON473 = &dest
+		response.JSON503 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGETTransactionStatusResponse parses an HTTP response from a GETTransactionStatusWithResponse call
-func ParseGETTransactionStatusResponse(rsp *http.Response) (*GETTransactionStatusResponse, error) {
+// ParseGETTransactionUpstreamsResponse parses an HTTP response from a GETTransactionUpstreamsWithResponse call
+func ParseGETTransactionUpstreamsResponse(rsp *http.Response) (*GETTransactionUpstreamsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GETTransactionStatusResponse{
+	response := &GETTransactionUpstreamsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TransactionStatus
+		var dest UpstreamAcknowledgements
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -2038,6 +4321,20 @@ func ParsePOSTTransactionsResponse(rsp *http.Response) (*POSTTransactionsRespons
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorUnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 460:
 		var dest ErrorTxFormat
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2108,6 +4405,65 @@ func ParsePOSTTransactionsResponse(rsp *http.Response) (*POSTTransactionsRespons
 		}
 		response.JSON473 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 476:
+		var dest ErrorScriptTemplateNotAllowed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON476 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 477:
+		var dest ErrorOpcodeLimitExceeded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON477 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 478:
+		var dest ErrorTemplateRateClamped
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON478 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 479:
+		var dest ErrorAbuseThrottled
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON479 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 480:
+		var dest ErrorAbuseRejected
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON480 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 481:
+		var dest ErrorComplianceBlocked
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON481 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 482:
+		var dest ErrorNonFinal
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON482 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ReadOnly
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (application/x-ndjson) unsupported
+
 	}
 
 	return response, nil
@@ -2115,18 +4471,45 @@ func ParsePOSTTransactionsResponse(rsp *http.Response) (*POSTTransactionsRespons
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the latest blocks.
+	// (GET /v1/blocks)
+	GETBlocks(ctx echo.Context, params GETBlocksParams) error
 	// Get metamorph health
 	// (GET /v1/health)
 	GETHealth(ctx echo.Context) error
+	// Get whether an outpoint is spent by a transaction known to ARC.
+	// (GET /v1/outpoint/{txid}/{vout}/spent)
+	GETOutpointSpent(ctx echo.Context, txid string, vout int) error
 	// Get the policy settings
 	// (GET /v1/policy)
 	GETPolicy(ctx echo.Context) error
+	// Get the historic fee and throughput statistics.
+	// (GET /v1/stats)
+	GETStats(ctx echo.Context) error
 	// Submit a transaction.
 	// (POST /v1/tx)
 	POSTTransaction(ctx echo.Context, params POSTTransactionParams) error
+	// Cancel a transaction.
+	// (DELETE /v1/tx/{txid})
+	DELETETransaction(ctx echo.Context, txid string) error
 	// Get transaction status.
 	// (GET /v1/tx/{txid})
 	GETTransactionStatus(ctx echo.Context, txid string) error
+	// Get the unmined ancestors and descendants of a transaction.
+	// (GET /v1/tx/{txid}/graph)
+	GETTransactionGraph(ctx echo.Context, txid string, params GETTransactionGraphParams) error
+	// Get and verify the signed receipt of the acceptance of a transaction.
+	// (GET /v1/tx/{txid}/receipt)
+	GETTransactionReceipt(ctx echo.Context, txid string) error
+	// Resubmit a rejected transaction.
+	// (POST /v1/tx/{txid}/resubmit)
+	POSTTransactionResubmit(ctx echo.Context, txid string) error
+	// Cancel a scheduled broadcast.
+	// (DELETE /v1/tx/{txid}/schedule)
+	DELETETransactionSchedule(ctx echo.Context, txid string) error
+	// Get the acknowledgements of the upstream ARC instances for a forwarded transaction.
+	// (GET /v1/tx/{txid}/upstreams)
+	GETTransactionUpstreams(ctx echo.Context, txid string) error
 	// Submit multiple transactions.
 	// (POST /v1/txs)
 	POSTTransactions(ctx echo.Context, params POSTTransactionsParams) error
@@ -2137,8 +4520,77 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// GETHealth converts echo context to params.
-func (w *ServerInterfaceWrapper) GETHealth(ctx echo.Context) error {
+// GETBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) GETBlocks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GETBlocksParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GETBlocks(ctx, params)
+	return err
+}
+
+// GETHealth converts echo context to params.
+func (w *ServerInterfaceWrapper) GETHealth(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GETHealth(ctx)
+	return err
+}
+
+// GETOutpointSpent converts echo context to params.
+func (w *ServerInterfaceWrapper) GETOutpointSpent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Path parameter "vout" -------------
+	var vout int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vout", ctx.Param("vout"), &vout, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter vout: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GETOutpointSpent(ctx, txid, vout)
+	return err
+}
+
+// GETPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GETPolicy(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2148,12 +4600,12 @@ func (w *ServerInterfaceWrapper) GETHealth(ctx echo.Context) error {
 	ctx.Set(AuthorizationScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GETHealth(ctx)
+	err = w.Handler.GETPolicy(ctx)
 	return err
 }
 
-// GETPolicy converts echo context to params.
-func (w *ServerInterfaceWrapper) GETPolicy(ctx echo.Context) error {
+// GETStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GETStats(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
@@ -2163,7 +4615,7 @@ func (w *ServerInterfaceWrapper) GETPolicy(ctx echo.Context) error {
 	ctx.Set(AuthorizationScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GETPolicy(ctx)
+	err = w.Handler.GETStats(ctx)
 	return err
 }
 
@@ -2331,6 +4783,51 @@ func (w *ServerInterfaceWrapper) POSTTransaction(ctx echo.Context) error {
 
 		params.XCallbackBatch = &XCallbackBatch
 	}
+	// ------------- Optional header parameter "X-CallbackVersion" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CallbackVersion")]; found {
+		var XCallbackVersion CallbackVersion
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-CallbackVersion, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CallbackVersion", valueList[0], &XCallbackVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-CallbackVersion: %s", err))
+		}
+
+		params.XCallbackVersion = &XCallbackVersion
+	}
+	// ------------- Optional header parameter "X-CallbackAllowDuplicates" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CallbackAllowDuplicates")]; found {
+		var XCallbackAllowDuplicates CallbackAllowDuplicates
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-CallbackAllowDuplicates, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CallbackAllowDuplicates", valueList[0], &XCallbackAllowDuplicates, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-CallbackAllowDuplicates: %s", err))
+		}
+
+		params.XCallbackAllowDuplicates = &XCallbackAllowDuplicates
+	}
+	// ------------- Optional header parameter "X-CallbackSuppressionWindow" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CallbackSuppressionWindow")]; found {
+		var XCallbackSuppressionWindow CallbackSuppressionWindow
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-CallbackSuppressionWindow, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CallbackSuppressionWindow", valueList[0], &XCallbackSuppressionWindow, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-CallbackSuppressionWindow: %s", err))
+		}
+
+		params.XCallbackSuppressionWindow = &XCallbackSuppressionWindow
+	}
 	// ------------- Optional header parameter "X-WaitFor" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-WaitFor")]; found {
 		var XWaitFor WaitFor
@@ -2346,12 +4843,109 @@ func (w *ServerInterfaceWrapper) POSTTransaction(ctx echo.Context) error {
 
 		params.XWaitFor = &XWaitFor
 	}
+	// ------------- Optional header parameter "X-BroadcastAt" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-BroadcastAt")]; found {
+		var XBroadcastAt BroadcastAt
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-BroadcastAt, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-BroadcastAt", valueList[0], &XBroadcastAt, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-BroadcastAt: %s", err))
+		}
+
+		params.XBroadcastAt = &XBroadcastAt
+	}
+	// ------------- Optional header parameter "X-BroadcastDelay" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-BroadcastDelay")]; found {
+		var XBroadcastDelay BroadcastDelay
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-BroadcastDelay, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-BroadcastDelay", valueList[0], &XBroadcastDelay, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-BroadcastDelay: %s", err))
+		}
+
+		params.XBroadcastDelay = &XBroadcastDelay
+	}
+	// ------------- Optional header parameter "X-ExpiresAt" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-ExpiresAt")]; found {
+		var XExpiresAt ExpiresAt
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-ExpiresAt, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-ExpiresAt", valueList[0], &XExpiresAt, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-ExpiresAt: %s", err))
+		}
+
+		params.XExpiresAt = &XExpiresAt
+	}
+	// ------------- Optional header parameter "X-TTL" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-TTL")]; found {
+		var XTTL Ttl
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-TTL, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-TTL", valueList[0], &XTTL, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-TTL: %s", err))
+		}
+
+		params.XTTL = &XTTL
+	}
+	// ------------- Optional header parameter "X-AnnounceTo" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-AnnounceTo")]; found {
+		var XAnnounceTo AnnounceTo
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-AnnounceTo, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-AnnounceTo", valueList[0], &XAnnounceTo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-AnnounceTo: %s", err))
+		}
+
+		params.XAnnounceTo = &XAnnounceTo
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.POSTTransaction(ctx, params)
 	return err
 }
 
+// DELETETransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) DELETETransaction(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DELETETransaction(ctx, txid)
+	return err
+}
+
 // GETTransactionStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GETTransactionStatus(ctx echo.Context) error {
 	var err error
@@ -2374,6 +4968,125 @@ func (w *ServerInterfaceWrapper) GETTransactionStatus(ctx echo.Context) error {
 	return err
 }
 
+// GETTransactionGraph converts echo context to params.
+func (w *ServerInterfaceWrapper) GETTransactionGraph(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GETTransactionGraphParams
+	// ------------- Optional query parameter "maxNodes" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxNodes", ctx.QueryParams(), &params.MaxNodes)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter maxNodes: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GETTransactionGraph(ctx, txid, params)
+	return err
+}
+
+// GETTransactionReceipt converts echo context to params.
+func (w *ServerInterfaceWrapper) GETTransactionReceipt(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GETTransactionReceipt(ctx, txid)
+	return err
+}
+
+// POSTTransactionResubmit converts echo context to params.
+func (w *ServerInterfaceWrapper) POSTTransactionResubmit(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.POSTTransactionResubmit(ctx, txid)
+	return err
+}
+
+// DELETETransactionSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) DELETETransactionSchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DELETETransactionSchedule(ctx, txid)
+	return err
+}
+
+// GETTransactionUpstreams converts echo context to params.
+func (w *ServerInterfaceWrapper) GETTransactionUpstreams(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "txid", ctx.Param("txid"), &txid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(Api_KeyScopes, []string{})
+
+	ctx.Set(AuthorizationScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GETTransactionUpstreams(ctx, txid)
+	return err
+}
+
 // POSTTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) POSTTransactions(ctx echo.Context) error {
 	var err error
@@ -2538,6 +5251,51 @@ func (w *ServerInterfaceWrapper) POSTTransactions(ctx echo.Context) error {
 
 		params.XCallbackBatch = &XCallbackBatch
 	}
+	// ------------- Optional header parameter "X-CallbackVersion" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CallbackVersion")]; found {
+		var XCallbackVersion CallbackVersion
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-CallbackVersion, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CallbackVersion", valueList[0], &XCallbackVersion, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-CallbackVersion: %s", err))
+		}
+
+		params.XCallbackVersion = &XCallbackVersion
+	}
+	// ------------- Optional header parameter "X-CallbackAllowDuplicates" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CallbackAllowDuplicates")]; found {
+		var XCallbackAllowDuplicates CallbackAllowDuplicates
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-CallbackAllowDuplicates, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CallbackAllowDuplicates", valueList[0], &XCallbackAllowDuplicates, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-CallbackAllowDuplicates: %s", err))
+		}
+
+		params.XCallbackAllowDuplicates = &XCallbackAllowDuplicates
+	}
+	// ------------- Optional header parameter "X-CallbackSuppressionWindow" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-CallbackSuppressionWindow")]; found {
+		var XCallbackSuppressionWindow CallbackSuppressionWindow
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-CallbackSuppressionWindow, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-CallbackSuppressionWindow", valueList[0], &XCallbackSuppressionWindow, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-CallbackSuppressionWindow: %s", err))
+		}
+
+		params.XCallbackSuppressionWindow = &XCallbackSuppressionWindow
+	}
 	// ------------- Optional header parameter "X-WaitFor" -------------
 	if valueList, found := headers[http.CanonicalHeaderKey("X-WaitFor")]; found {
 		var XWaitFor WaitFor
@@ -2553,6 +5311,81 @@ func (w *ServerInterfaceWrapper) POSTTransactions(ctx echo.Context) error {
 
 		params.XWaitFor = &XWaitFor
 	}
+	// ------------- Optional header parameter "X-BroadcastAt" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-BroadcastAt")]; found {
+		var XBroadcastAt BroadcastAt
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-BroadcastAt, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-BroadcastAt", valueList[0], &XBroadcastAt, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-BroadcastAt: %s", err))
+		}
+
+		params.XBroadcastAt = &XBroadcastAt
+	}
+	// ------------- Optional header parameter "X-BroadcastDelay" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-BroadcastDelay")]; found {
+		var XBroadcastDelay BroadcastDelay
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-BroadcastDelay, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-BroadcastDelay", valueList[0], &XBroadcastDelay, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-BroadcastDelay: %s", err))
+		}
+
+		params.XBroadcastDelay = &XBroadcastDelay
+	}
+	// ------------- Optional header parameter "X-ExpiresAt" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-ExpiresAt")]; found {
+		var XExpiresAt ExpiresAt
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-ExpiresAt, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-ExpiresAt", valueList[0], &XExpiresAt, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-ExpiresAt: %s", err))
+		}
+
+		params.XExpiresAt = &XExpiresAt
+	}
+	// ------------- Optional header parameter "X-TTL" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-TTL")]; found {
+		var XTTL Ttl
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-TTL, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-TTL", valueList[0], &XTTL, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-TTL: %s", err))
+		}
+
+		params.XTTL = &XTTL
+	}
+	// ------------- Optional header parameter "X-AnnounceTo" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-AnnounceTo")]; found {
+		var XAnnounceTo AnnounceTo
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-AnnounceTo, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-AnnounceTo", valueList[0], &XAnnounceTo, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-AnnounceTo: %s", err))
+		}
+
+		params.XAnnounceTo = &XAnnounceTo
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.POSTTransactions(ctx, params)
@@ -2587,10 +5420,19 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v1/blocks", wrapper.GETBlocks)
 	router.GET(baseURL+"/v1/health", wrapper.GETHealth)
+	router.GET(baseURL+"/v1/outpoint/:txid/:vout/spent", wrapper.GETOutpointSpent)
 	router.GET(baseURL+"/v1/policy", wrapper.GETPolicy)
+	router.GET(baseURL+"/v1/stats", wrapper.GETStats)
 	router.POST(baseURL+"/v1/tx", wrapper.POSTTransaction)
+	router.DELETE(baseURL+"/v1/tx/:txid", wrapper.DELETETransaction)
 	router.GET(baseURL+"/v1/tx/:txid", wrapper.GETTransactionStatus)
+	router.GET(baseURL+"/v1/tx/:txid/graph", wrapper.GETTransactionGraph)
+	router.GET(baseURL+"/v1/tx/:txid/receipt", wrapper.GETTransactionReceipt)
+	router.POST(baseURL+"/v1/tx/:txid/resubmit", wrapper.POSTTransactionResubmit)
+	router.DELETE(baseURL+"/v1/tx/:txid/schedule", wrapper.DELETETransactionSchedule)
+	router.GET(baseURL+"/v1/tx/:txid/upstreams", wrapper.GETTransactionUpstreams)
 	router.POST(baseURL+"/v1/txs", wrapper.POSTTransactions)
 
 }
@@ -2598,82 +5440,220 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xce2/buJb/KoTuAtMCTkK9pQCLRZI6d7JtHjdxZna3ExQkdRTzVg+vSKXODPLdF6Qk",
-	"W7LlR1KnM9ib/lHE5uvHcw7Pi4f+w2B5OskzyKQwDv8wJqQgKUgo9CdGkoQS9vWYSDZWX0QgWMEnkueZ",
-	"cWic1M3oG08SRAEJyCLEM0QQ1SMGBlf9xkAiKIyBkZEUjEPjv/ZOOhMPDMHGkBK1gnycqC40zxMgmfH0",
-	"NJihGOVfIVtGccQYCIGkakVxXqAslzzmjKh21AxGkEWTnGdyH53JGeBSQISIQAQdlXKcF/z3alSFWM8m",
-	"x4DGUk5mM23eVQW0Z1dCFjy772zqtkiWt/QBYlImEkV5SRNAYqLoSrIIpVB8TQBNijyPN+1zM0619gaU",
-	"ZVomRPIHOAX4hSQ8IhXERcS/jkGOoUDfAIlxXiYRmkAR50WK5lOgGAA9zCbR1FVfsTwT+exbORWoYuK6",
-	"HazAtUGS4rxgz9yGHoJESVMuJURITttbULKePSJGBKxBe7qw7CaUZZLcSCJLcTuJiASxDc4xUQQukwQJ",
-	"PRSV1VgFcSYbFV3RO56xpIx4do9uhsOLL2cXXy6vr34+uvhyPjy/urz8pGVNN11efLkYjn69vP5Yzwvi",
-	"/bqdLkHfsNeUTEc8hbyUy5usG9QOBLA8i9QhR98Il9Uxh29IFiQThGle1PumEOcFoAL+twQhEUwnvACB",
-	"3qVkimzczDRAUX3G3Pert3M+R9ezD55JuIdC70N85ZNnHxE1aPFQbBT9m6WVNpBYrXKjcbwAXdXl2QCX",
-	"1tsC42j6Anz5AxQkSRaO5VYYO+ttwKeE7jQv+mBxNm5Ery2dcZGn2nYIKB6gmIulLItMnbx3P/3jdng7",
-	"/PDTAP10PTwZnv1S/X0zuryu/jq6uLi8vTgZfvgyumxOYdX7H7fDm9Hww5fj/25/fzO8GC10PTo5GV71",
-	"9ewc7Z/WHIFf652vsxNPA6MAMckzUemqi1w25hSiZZrdACsLLh/1GeUFpMr5QDHhCUSVNOiV9FQnY8Kz",
-	"syzOe7wP1YS4ahsYkyKfQCF5BYAmOfv6MxE9PsuxakJj1TYwYErSSaL2ghf/Ba7jOyG1mWm5kWsxL3Rs",
-	"z/ddx2EB8UgQuJbjh7ZLSRCZfmQMFqkyqFEAvx/LlTiq1hYSP7BsM9CmKiXSODRKnknPmc/f1jr1Vzn9",
-	"JzCpljzJ0zTPrmtm9NBMt6OGW6geuUg/yVMQkqQT9WGGRCn0PdW0vFktAZqZkXH4uTX+rgfksCj6jtJR",
-	"hn4eja7QVZHTBFL0ASThiagxDpSbFkHMM9Au5tlwdIquT0+QH2AfvVMemjg8OJB5noh9DjLez4v7g7FM",
-	"k4MiZqqTtlt5Bpexcfj5D+PfCoiNQ+NvB3MH+KAWvAON8DZTLOLZfaXMhPE02GLUWTYpt+17ThJFXCX1",
-	"W3VXez/KGAiZF+Iil6d5mW059oQkTHtM2f259iGv83xbmKdF/jtkV3nC2eNzRpwoEctEKYynu4btxyS6",
-	"riyzEgCSJNty45RDElWAu7IaaTFRf81P82g8dwAEQKpVMwWUNgTXDg4jmfKGqPanVQyhGWHwTEiSMehO",
-	"2QgYKdi+JCTZZ3l6AAqZODAt23FdTw2uLEFnqIOxOqpcJgtTHpOoQWnMDnPfmpRLlvNsTzzs33M5Luk+",
-	"zxWQg7/VCP6DR//+xcG4TynMSL9CBF6RDXrEzC5n9+h4ODw9REybb0V6VkMCVCFCGlJlO+/5A2To+Pb8",
-	"SnwHV+xVTPGCfqacZRrvfOHvZosXrGdLO5QRr30qFqIxrk5GjpL823fQ2FpFY9/up/FJF0QLwXcT27fX",
-	"EvsU4LUpHAMIRAp4TcJ6bj9hT3dMTc9dT82KNoerSdO18J/y7B4K1PoS5THSC3acsZqMyswrD7od5M0F",
-	"tlbpVSgLTdpJmez9PmcMprIg/Y7kpf6DJEj30R6l8njUcoSqAFSB0CjnXn3Ks8pPLpOEUIVaFiX0rNtm",
-	"fXfZd82679Ennn1V+yFMlgqHXkuFtDp22GaZuZR0F6lUMMsjaFPYwVbLw+SZ7HEvWwK2mJyqPz0AkjCt",
-	"wp2GiUvA5JT3hACjFkvPPiA55qLeNReogBgKNR7JfJu9N2K+sMTjBGbiNUDfuByjpKZzqkKxFp83O7Sq",
-	"taHIjNqDRtJXermLjtAr6h7teKJqQfSOJoR9TbiQKCUZUaeONSDQrA2i96+i9q1VpnWOcDfK3lqvntp+",
-	"659I+YlG8PpkN38U2c21ZP87ZFBw9qp2tqU+5t7k6zvyYT+F6x3XSnAnrny4lsR1kPmDKMwF4rVXTIGR",
-	"UoA2gVyD0K5Olmd7MFWinUmUF/rCQr6K42Ot99p5E33vwPdZr1zmsfuP48Jrhq+rSb7CiZ8RoO2f7Yby",
-	"6334FWmQHx/HpjoXRRokWgfFCot2XNusm0nlzqNYfwVzVkHbDYP8tQz6ESxpZXggQgWIvCwYdI3BbMO7",
-	"NwROP9kvdkpm7Kwl82Up/wJWIK9Q9JqBuv+raCVnvSGoYe1G3NfzYTQ9rSOp12PEORdCKR6tSepLOXGI",
-	"VvpBWvs06jlXgS1kKkiuY77XOBMeXn0metb/fq6sz3YuZe//v5tp84eb6fVhwC8zc/lXyTbXTd1k86tY",
-	"5RVxQifJ3S6Eqa8+d8GUlYHDKcBRmpdZpaeiiFfJp6sWXWOSCFi6S33srUC5KFMKBcpjVHVoZZdMjPE2",
-	"F5gDQxCZizHvmb6Cqhypm6ZPe4Ut70fbKRwxn6dC3Je0+RlIIntujcf6+8e6sGDpvrRuXh73ra5aWBo/",
-	"20pN88WaA4WdiL5CCJhOEsIzoa3vpL4r1dmtFCRJ82LSvdnOchRRxPIsA1bnujZm1B6gEL1VGHWDYrta",
-	"/uj6BE0I+0ruO+lF48Hcx/u4N6u2RPJWfmZ7oUzJtDaC/HeYzGbogj0nU56WaVPDorqiz5r3d220rr7v",
-	"305gUzKVU8Hv84lgSkDFprWz2TER/D4jsiwAqY3og9+RaccKndDzrdB9FpTN2+/USK2ggVlXPWy5NM94",
-	"dl/fpaxT4nOdU2nKLCJFVHlLN+Vkkheyrz6kKfWpq9V0EU09tnYglJUVswla++jIc7uAp60J+oRnNWuX",
-	"Kd0mwN1KiW4XYWxn8haKN5TVe8aRmIvBujWaW/wFktSD73otR8u9qWsy1if0o7rTIkIFB5RlHk31Zy4h",
-	"7VrOzwbDkRcz8E0HHMtyPdOJMcbMIy6JIkKIaTsmYZSGLPBN0zVNJ2Jx4MS2T0PHJV5rC3N1tkLfkaIg",
-	"jxvuhoZrroTaDtVgtTs4cz220bxVge8V6TNC7XlrV2JC5LiqXx7DFFXTKKN5fHt+1ZyVz8fXJ3u+czer",
-	"kaEF24/g4cB33i/VQG113zK9WXHbNFoqx1QLZGWqWHt78fHi8tcLY2BUhW/GwGjq3oyBUZW9GQOjr+pN",
-	"d10uelPDujVvavxyyZvu11fn2jTMS+GMgfHh8vb40/DLzdXw4sOXo9FoeK6m0xD+c3hS/Xl+djH8oOa7",
-	"GR19Gn45/nR58rH5WongnKj9cF52TcYzxeYOzzwaURYTil3Li2wMQeQFlh/GfhjFsWfG1MGWRxgE1Ke2",
-	"5QchibHp2bYHrhNbMd588zXVgjvjeZ/Ca8FsFfd0D39Bvo2myzu8Jt9aotvZ2m8lxjZrW695R922uQ6t",
-	"WnQj5B0o6rXdZzWMm3r2aNpnDLnRReo1nxY193W7QHOh2m/eq6uXtyuW6yPlVlViFcauMn5az6u54vkL",
-	"cmqB4h12rFeVuudcY3bZs+pq/2amYJsjY2Hz5df6o/puu6W6oqhKH6WQTvI82XjcZhuollg+d8oLrIt/",
-	"bxQhqw0eAymgOCr7bF7Vhkgpx5DJ5rFLtxT0+vQEeb6Lmxpl7fvpcXPEyvZVlcq8tvMJZ1Cf+rrY+XIC",
-	"GTq++QV9Uk1MEaMskuUYnAiRM66R7GcgD/IJZHtUPOzVUx60qGyoEGmveV0kq6KnRh7RSSNYRivmMqrg",
-	"6WlgqInJhBuHhl3HU8rea5odPJgH41mweg+yrz4a2FeBeDwPDJU70oSiJItQUWZZpXZnMclZZBwafx+O",
-	"6kh4obLbwrjy5DIJdSZhMklqthz8sw5Y55Xi645QvYJmyoJkl/pJlyKBg81V88yAHXTrzbWUlWlKikd9",
-	"Nytb+x83u5LkXiiRPSqYcadGKILOXehego50lUr9vKqu5K/D8OqGX4BU7q3Y7yPoVRM8vBpBF+KOH0DY",
-	"nr2voq2cVkGK2EhYLqpneTKv3hMSVJDuSx+ZI1JVYOnaI10CJurnWApSBlNZlYIhOSZyXrCFWAFEQg+D",
-	"ri5vRqOOP99+CbnCysy7HLSf862yHa3uy0+8thjUeiu1Re/lF0nb4Fp4qbblOkvPfbYc13mCs8WYVc8R",
-	"txnaeZv5jAHVE9UtBjRvhJ7uKqsIQh7n0ePOjnePl60OV3vCnEmQe0IWQNLuxDOvgPJMnd/e1BxM5YFO",
-	"LnbHfqdLvqSFRr3jjbYvoSLPpxepyhpslVdoHprMtdbi/eDAeCBJCe07hV3denbyCgYp6vog5Hj2IVr4",
-	"2CZpNTfCqMlYt0AY7csKFajPLyAcz557HcvbrAJMg+DIC4kVxSTyTez7GCIrsBgD2/SY64dW7JnYJF6A",
-	"HY9Ynk1Mn5gEsOX5HjZd6HpUzyrq+M2oXnzWzmSHLR2SZy2Hc8ad1pMuw1h4W4W7pDa6aRRjXrB5aGE8",
-	"aL9uMixs2XvY3sPhyLQOsX3oBPt2YIUmdk3nf4w5RS8/tqPgw568QU3h785gPVUZ1ioz2k+iqnkFdTrP",
-	"2AhhQRhTiEzPhsjD2DMpsW3KMKFhBAH4cRRQ2yFR6DDLMR0WLVLXtz3LCtaTOAbXsVwzwBhb2FH/B1Ho",
-	"xyFQiKIojENCAsAQuja1ie/FtulZYUAodiEMbIeQwDR904MwskPf9RxwsYktN/YcPdC0wPKIy9wA2yyM",
-	"QycymcUCIF4ADGLTMV1smmAy1Y+GLPQ86pEIW9gyYzcmduhhnxGbOkHk2izEFo1cSh1KY4/4hIUhi8M4",
-	"Io7LmGVS3wQPrNgPgtDDNrYcYlFqmh4Enm25LKSBa1qxiallMcsKCLUt34rBjm3fpiaNHBISj9q2Q7EX",
-	"UOphS7HCM/3QppYf2NhWZ8y0Q8yAgEt8044AA6FRyCLi2T62YggcFlpB6GPCYp85LmATY+J6PtgR9jyw",
-	"A88O1HSh77qhjS0glAUuUC+kFraYBYEXObYdUEJ9G+Mgdjz8KkehynrNDgD1Aoo9h9q2R0PiEBpR07dj",
-	"G2wrtnxqB8SyLEYtE1uxa9KAhZbr2RCYHjUt6pDKZLzAJm7p9+7O4V580daz8sIbr5d43WpUuFvMTYlu",
-	"D+ClWlbHsna7eN+qt1ldHEFoAkhF+vIR7VXXmt2XntUt/zw5pIR6p/Bm5Tw9MFfUsjieuVsMy09Pl7Gs",
-	"LOxwvB0zrHnSuoxhuezW8ezdLt56I/ssGji7hdEU260hQqvkzPHc3S5/Cr16beG9l+P5Oyb+ihfHPZxY",
-	"Vwt7PByeVviC3eJb9ap5NZP0s84uph3r1v66pzWQFquRHH/HR2jhhWkPlNaDzNOF3+hp/SrDzBWtMtuI",
-	"tE/d/urEz8EfyjN42jKv1kr/3NcpJlYWhQqu6p/ZyGNE0KSAB56XInls/zhPF89SDm75JmEpz7MIrfv8",
-	"8OwDemdbuuRJ/4bE+24Aq39DY0J0hrFOKtf3Z93Qdt2Pady9YoZwef+7TxKqUTtWvusUT6fk+k90lJZz",
-	"o0t34WuOiHhpcjQtE8knCSzmSMUPSJKKtyzpW5b0z8iSzi6on5su7blt/mulT3/LXpZi/f5cKYmidRmn",
-	"vqTc53+prNzd0+BZCeXPbxnl184oV0x5Xq708ysnSz0z8N6SpW/J0h+WLL37rmyp2HRBV1PgLXP6gszp",
-	"W2ryLTX5lpp8S02+pSZ3lpqciVRfQnKWDGknQnqyLq0yVO0QtgtQP9+pIPZowvc+wuPsY/vH4fWXd2qK",
-	"4qHJe3TrRNsvRpWB/r8AAAD//0wgcFplXwAA",
+	"H4sIAAAAAAAC/+x9+3PbOJLwv4LSfVc7UyXLfEiUlKqvvvJD3vFNbOdsZWZvJ6kYJEELG4rUEaBt7Vz+",
+	"96/wIkESpChHzuztZn7YjUU8Gv1Co9Hd+H0QpOtNmqCEksGb3wcbmME1oijjf8EkSfMkQMuU/RUiEmR4",
+	"Q3GaDN4MbhGhGQ4oAXSFwAahTPyLZjAhMGCtACZADRECmo7ACUjytY+y4udmH5oCuoIUrGGyFcMOAUEx",
+	"CigKwYagPEyPMpiE6Tpm3zO98xBAkMA10obHFKDnIM4JfkTxVoyOQIgIfkggHxKhDKSRmJR3DtIkwg95",
+	"hkLgb3nzdIMySNNsCNDoYQSiNANrnODk4SjEGQooILm/xoTgNBmB0y0IUQTzmO7CB4BxLJY4AssVAplE",
+	"KWsKY5ICuNnEGBEFdYaOVPc1I5gAuzLFaDAcYEaeFYIhygbDAVvS4M3gL0cnJTGHAxKs0BoyqtLthn1n",
+	"MycPgy9fhgM/S2EYQEJPqIHsF2fAdd05oHiNCIXrDYAUPK1wsNq5XPY9QfQpzT6LBdcaP8IYh5woMAkB",
+	"oSkjAV6vUYghRfF2CPycgiSloAAR+ChKM8SHfsCPKOFwgR8YA6WEgikI4ZYAyNDx4wjcov/OEaEEPGG6",
+	"AlAbh3cLUz76E8SUExkCQiHNCfDRNk1CcLe8uV2cd+D4VEOdjuQozdaQDt4M2PKO2FyDYRfmz1EMt03k",
+	"858BTgBBQZqEBMCIomx/7A8BJADGFGUJpPgRsc8V4PssUcCor5LJxDpfD97YxeJwQtEDyvjqAhjHPgw+",
+	"n8Rx+nSeb2IcQIpIc5m/rhBdMcleIUDgurosSRGySvM4BD4CBCUUwAeIE4AjJu+YALTGlPHRWvAGTECa",
+	"BJwtjmIECT3if4Yoxo8o2/6oC+0QIBis1DSYiPHTJN6KMZjKUSsB72/ftmPqrGW9Bunz0zRGMKmg6RTS",
+	"YNVEjhoVPOE4lusPGU9A4PMeO+E5lc16QXGXbzYZ4qrtV5yE6ZOBXPx3nS2ZdOFE40vGBpmUY4laZFJf",
+	"AGZM/8IYEca1TAZZCx3fZARuGDHY7zHDJ1W0gkLhMnTIkZ8kZIKIbHeIcAJj1eGHq8vrxfkQ3C7+Y3G2",
+	"XJyDNAOLv7y7vF2c/1hQXtM/XC2FmAQwC8XGVYKqFlXR/4hxV42VdtOniXKjkFmdQrZMP6OkSauTIECE",
+	"bSifUcLRm6QUR4wxGfYLPKMk3KQ4oSNwSQtGywnTzARAcJLTVZrhv4teYiUFsVaUboqRRuCSDUsQI8k6",
+	"jynexKg5Dxs0SNdrCAjawIzvATEmlBOSwcrRBwnbtUttVvb2t2CTEswXuRO/AjXde6CC8H0Wm9SwIG+Y",
+	"5n6MANkwlmO8sUbZ5xiBTZam0U7MXilslMsIYAJ8tZHBdqRk4uN/3N1cF2hK/b+hQO1seRZzgCSdMYpD",
+	"Io2X337/MMiz+MPgzYcBIxV5c3wMR0G6/jAYfhjwDvwb9IMPgy9DQ2tftP7ycbQb1wx//TD9C8oIR28d",
+	"2/KDkukCkxu4jVMYAjE4+MFmeHGGhfDZP46A6uuo1oRZd5TtFTAB6JnpZEzBo2zGESUQt0Lgfo0TFC6f",
+	"cXjPuZf9BdYwjhEnxSPMMEwo2Y0EtTQDIiqim6/zmO/HFwj9ImwhI0bU/viE1Da4QRkzMUA5BIgQUgYV",
+	"X1qa8Z+CNCFp8St9JkAogS5atsC1YwdBzxucIdLXiOywZORI5fbOjDRBjjyhOGYdkhE4uT1jRuOGMEO5",
+	"MKVw8iBpjTMUVsZlhA5WMHlgY1OiFDVN1S4w5KCIPaPgOyadQvvzMbdMFjMESZoIo1b+us4JBTFme58Y",
+	"Jad5hvicYq3sx5r9uZc9vyjw+wJLM0qzYE8e413ESYfbVvRZ5y8uUVuu6jtgvqhNu4OFojyO7zhR3m/C",
+	"bluxhHMFGffncbHN56IvA7EgomB68ANOgjgPGY/cLRbXny6vP93cvvvp5PrT1eLq3c3NW04v/unm+tP1",
+	"Yvnrze3PxV7/Y9dKG6DvWOsaPi/xGqW5QV7kB93Eoml5TEnQk8lElkejTJx5Cin6YQ2fgWupkUqFOfmx",
+	"fTlXJXQVYwQ+C2PEtYa7zH/yGW/2VmysU12V7VRYd42ZduCezXLH4XgBdKLJ3gA25usB4/L5BfCljyiD",
+	"cVyT114wLp/7w0epwVLi3THdFpZ4v6NrL4VvPsQWWrFjacvl233OrUzOLtLMhHBcnhR1gYyydC1Oryh7",
+	"RFkpiTTPmNsI/PCn/3y/eL84/9MQ/Ol2cba4/EX8WzgY2L9Orq9v3l+fLc4/LW+U4hGt//P94m65OP90",
+	"+l/673eL62Wt6cnZ2eKdqWVFm/2pQ+p/lSvvsuC+DAcZIps0IUI9X6dUHQ9Q2MTZHQryjHEEU0s4k46s",
+	"COIYhYMvw8EtgiE737GezFRDCdeH3BMmbOnjvxHB/SVM/ydD0eDN4N+OS2fmsfhKjhdZlmbFqBzeuiMT",
+	"hkf8fL9OQ8RgWKbpFUy2yll0WFDqgxsgukLrNNsCPw8fkGAonBxFMX5Y6Z5GAtDzCuaEonAwlMTjsN4i",
+	"mm2PTph8NfF/LfyvadQqiOUM6jySIZphPkuH/crXIVfKGjB3I23RVNcpFZZQDH0UEwAphcFK+kQrmsBn",
+	"h+7C/zoYDjYZ+4NiwWyQc5phAlhaUzBc40SehPjhqFwqLGAET0yZhCFfJXqG603MlpduCHMVwThuWlHD",
+	"QZDxk8BJy45ddYy2zNXHXhsOBJ6a07zlvxvsRn0Vvw1CTDY5RYOPwwGmaE0MclxMCrMMbtnfSUpRC+nk",
+	"fJpbfL2hW4DFz9pKuVitIJGEruA2yAlN1ygDEjrwb7bjGo1VqSpCthQOVYGQoeIAnRgfizHEmZgt5jRO",
+	"g8/N1fCf1XLilJ0EKDsS4KQ4BbJtR4mH2IIwbfChz8b5CZJV2xQr9k1fvVX/zxtH8yh0QjSDVjiFzhy5",
+	"0+mYSbUVTgM7GkdONIngdB7M/Dkcm7hEQIGYnmiFQ3zVIJnNPMf1NEbMcUI9bfxCxIeDIMWJDwm6RU8w",
+	"Myn3fF3wRk43eXlFoXpWPdQJIJCmZIXJEOARGvGmfBVMCxEcbgsyRAhV2Me1nYlju+NJP8g5FZfwwSCp",
+	"8IFpmVJQBcFxiBKKIyyOhiiO1DnOtJKqAGRImCwJqlD8eHly8vbYRLdNljKvXF9NIhDElEjRka3g5Pas",
+	"TZ8keRxDn0FBsxwZICjO4eb5+SdFSl8yEttvhoANDbD+pQaYMH0w5b9nKEizDsW3A9CaLiilrsr7DUbV",
+	"6N+qHH7iC7pD2SMOkDi5GdynjxDH0Mcxs2DSCMAKNri5hwPU3KZEt9igT5+0uw7TWNzvWXTX+KmCoMIQ",
+	"Z5qR0Lucu3mbs+leBtYQENEyyuPinEjTVmAq/OxYzuTIso8se2k7byzrjWX99cUMKBwoTYDF7+Bpte3E",
+	"UJ4YcTQo1oTXKATi9LpbGFZZSmmMwvfsrNGCRHEOqQtlEzJ2cpFQcGkIYE64OGSQIhDjNWa/i9tdaRF2",
+	"Itn9CiTnJmf2+9u3Jsk2krzwAK/ISP7KfME7N+2ce4FLErUK4RKtNzE02R38M6Dyu5A9cQcPNmkai/Ns",
+	"yL1tJVHyROzYtWtR4fRBobbp1Fqg540IOKApM4DFKHIDSNAzFagy4Lwq95sMPeI0J6ft5gH7tYp/HgPB",
+	"tW2du4rVYwL8HMe026JwJrPJxLejCZpDK3CQFTpw6tvIiybI9m3oBVNk+TPkRmM4CT3TzkTSPAsM1LhU",
+	"u2OmYDfRQsAvDTPDOirgs55H9mD/zak9/uAJlrRW1GtA0NNhqrOzxMrQQF8d2lYul7zRMCANCntZ3nKK",
+	"JoDtnzI0RRhzwkm9wg+rohWIcEboQLP2u46lHKbmCcC02RLjos6YwXyZRIYoIf4JYPbtFWzm2WQ8Hc99",
+	"N7CdSThxAm8+dr3pdDIeBzPowdls4oync3fiw1loT8OD2czTmePasz6W5xcTutL1Ok1updPEgDP+HSiv",
+	"irzZa+CvIhYv4OJuRuW+CoMBlICflst34F2W+jFag3NEIWYnUN6Ru+RCFCl1eblYXgB22TOdWVPwg9o+",
+	"aJrGZIQRjUZp9nC8ouv4OIsC1oi71NME3USDN7/18Ka8TxiJcPIg3KmE3Vju7nWZbPK+ba9gzJCLwp7N",
+	"2dpPkgARmmbkOqUXaZ707HsG44DftCUPV/wm+TZN+4J5kaV/R8m7NMbBdp8eZ4zFEpKTwZePiuwnfs5M",
+	"57/xLZDbr3HclyAX/K6ZQ1Bl15BzCvtXKdBLTVlncj4Q5kjZoJABAkiQZoXVGsQYJZxDcUIoTAJUHbK4",
+	"0s6CEYUwZvbJMWKQkWPbcccTh/UlhX1f9BzPLL7X0Lg24okGBE1TrmlLbWma28eUHUCOyOPoAdNV7o9w",
+	"ygA6/jcJyf/D4f/9NJ5ZJv1QpcJS2aKvSYY7zadYQTMPxynMYUUZTIlOmdegxXRupoUOaQHXQYgxnXcS",
+	"4xSG0mP7qvJQHgMAQWhNlP2pdJC4suYONvZ7ccz+ChpMPNGZ3w29gxlcV2nx2++7bwfU6U3Skh96SL7Z",
+	"pBlF4Rt5Q/IGXF1eX17/WWDVRHWrRQJPYajQchBaW92C16KGX5HuvEdxO5c8gNPF4uINCPglHkNmIEFC",
+	"QEAEOEjinklEC52+v3pHvoIN3DZR9GZmolwKjikn/mqyeLNusqTrTYzZ0rhZ9u12Jl9Mp4LBgwIOgJIH",
+	"nLyKApzZLaJQwlLCcZjdyO7Gvh4ERF5bCdbimDDhO2+cPr3KZuOacX1WBUKD4Ot3G7cT2RcIvTaGmT9d",
+	"bO+vh1hvYkbsxYGx6U26sSlw86YdNbVLNXYPlAHtR2YV8QkHwyYalWeh5kKSC5Q7uO6GkE7jkek4ip5p",
+	"Bs1H6Rv+DxgD3oafqdmZj00H/TSXwc98JyniD7jbu49rMBIc18VnFwjJ016dV6pw/qAA/RG8xclnhgAY",
+	"0BzGErg0kWERfeBq2CXVud4V6UrKcFXmk/AIibgCLe6lr0/kUpvXdDlKWi4IxE4epGHFuTW2HM1ZgHkQ",
+	"ePOOqpCUerSx/IuFuKBnERCguLGBMPqMDRdz+nZ2eQ7oChNJDUxAhiKUsf6Apn1oouS1NsV2gwo5GYqb",
+	"9ljSn6dhaAy72zfBviqMFNgeKpFtdVjUz7SvqES5DwGICcEPfgyDzzwUew0TyNRHoIAAxTcU/vgq+5fT",
+	"ZqGVEB5m13K69azugvgDMb/hELw+2u1vhfZuy+zPKEEZDr6VNVweSg56ADWeB1u8AHLFUgke5ETYffqX",
+	"/sJvhGF+QyUOV+q+UORQMSC4zZakyRF6Zqyd8GwQsnkln5jndB/+sHKkHsCI61YupRv221HhNd0u7Shv",
+	"OY0UCKiEeR0E892HkRaP9rd3h4hbWKgg4TooYrBwC1wnXcGVB3eGTFuI0wbaYQg07STQdZpc4ATG31Ay",
+	"+PrYnEPuDRZ3qnitRTyIeM4teh1XfYtauk6TIw7WwWVk5uwgwetLheYcRiHIkLiFru7HBc8dfi8et6H8",
+	"kJxujTvRfLNhJ6u3eI3p4jlAKPx224E46rDIEDavDH9l0IgYnuL8uVE3cIe3N1t0z40GhgTvUHci3Xrn",
+	"RoR4/sFmkQo0NdlFsv2rbNPjbstIgnUY/d8tFXrKxGsqn5N3l4IIIKukTIi0+BQJ1Q+DAG0qeQqvoY8m",
+	"VouZ1Mzm+Fr0T6xu+0hEHqjINZb+wuo9fDvNJDhNpaEVdFhDGqx4Tqb8UkSPQQFfkbDP6PoZvY7O8lru",
+	"cWsgccaRaDuI5vI6SaaIdQspOovhevOt7tdF1KfcKup0MZTDwAQEAj51+Q4TAJN0DePXodds17175wok",
+	"rIchYfdtoCFH6zV137pnShbWsrKGPHFqy+P3slcxypwWB8kyTUX9rCKm+BAUcbqdJMvnC+nhfj1SXDE8",
+	"Jw/ihKdssjeg1T/FZUkdm1N2c4KSUKg+Bulr0MSz2g1lw/xfbxx0BzM0AuT+2d0n9jd3n9g7CFBEwlyh",
+	"EMOlnO9Vr89Ftiqg202hq9XFGK6F57ws+udMzHC0FBc1RQBQZeZaGJCeOPu8jtvjgOyWe2MNlYAXZeLT",
+	"HISIdvcV8i+FL+ofJSJIfqoGBL2Ky6tlj9HnrRSaKVK5v16yWjecCxSijM93i0geG0K3Tx4eMvQAqVY5",
+	"qEhTVz/kG0IzBNe8cozCGzGmEURpxjLJ+DYyFGmtBFGAI26QaXPJyydMtDKSI3CpOwfYRwIpJhFmRgJ9",
+	"visKhLFGvG4hDB9hUtYVKxxqLB+aAl7DrpBqXtcmKfK61arIEKR0hbInTFBlkvfXP1/f/Ho9aqapBZ+T",
+	"9ClG4QMKuzLHixnkLbverysR2THdem+K+8J2Aoo2Q3DP8xqOZNLa/RDc/3eeZvn6HqQZuIdxfK9PNxAf",
+	"B8Z0M3XX3H+VvK4MTfXVmoqXCspuNXrvREHBDKaL+xxxLutL9N7EaMsfLEYylnw1C5BCkZCwsEz71Jj+",
+	"iacGBJjnXWlJLHSFcMYLr5K+wRnv5bQn5VLX8gKsM4WlIEfxo477YZX/dUyY4g0uEDpZp7msCRGGWAS9",
+	"vNMkKoIxaeSE+VtjHaOS70QDjVK2ZVn9spxVNrVBmjioACfgTrXRZ+iZyqIjk5TjCIhbkKRihhogsSiw",
+	"DcRCp1WkiJEcZmWdP1mfhQdbFbzv6yIma0IWGYYqI1mcEyBffGu9SUb0xKQNaQ5jGYrXDjpdtSa1V4nY",
+	"j4RqfcZ5rzREaPO0IKVw8LRoALsnRHlsYtjTDMHPYfqU6AYmByJCokqqhIL17yvZFwjdsuamiCv8dwNG",
+	"7vDfzY6TpClHjuO9hM/ZvEONG6o0Uvhp4X6+GiP/6DSDNVztxYg6I3CmVGRnkPN/sFF5HjkvJx2KMK8/",
+	"hjPlAl/Cg4bovxJpI16t8YonoS6fLxC6ry64ziBDcF/GGF/Vejab8xMspqTIKC6vetmX+0pVRcNwle/6",
+	"wGSkY2NQXYMxI1di9h3Kfj5t4SzNQydIr3MIygChkJ+hPuM4ZVLyAoJ0SKMSvR6s9zKJlDxUxcRwl6Ca",
+	"BPQnBGNqyD9d8d+3skhaS+aqXi/CoB9hrVREW4I9EbWri5BVWUM24+cqmCHwiDIsDZT+6bymahZfWmM7",
+	"CyUrF95epqKBmYKW0thpGpRtpR1Y9VW2cLFjyXxSHja6RhSu02xTzf5NUhD6TJISpLaynaGqj21FZR+r",
+	"RWXZAXADg8/woSIMg0d7ZI0sY7hqg5kqIcONYHOcmALNg8oBtXh8gZ0wuQirKisbSFcM534aVo4TpV+m",
+	"sXThqOkqjFUZvJibTSPq4NYcR2zujrDqEiY9M6x3oY/baqGPpkNBn6FXmtnOSlKQJ0njpPRfmXTEdRqi",
+	"8vrDVPNIfTPZIaruPi+HwHiboCTM4FNlK1LX2UkqXqMoozwarghRWJ3/LGuCivJtmJbV/teiynh3aQpx",
+	"Tdt15CzgVk0NRpbMBl2j9SZN457qgGH2tpsNRE6bvy2BEKUgiyThDrN24ExmbwB9To4kWEfMExPjgBr3",
+	"U1XPtl/tpbpfqOiuU/qFVSU0SIYlfUw8ySIvUpzQu42sSVilLS9Izo2IHrkABqZV/Yv4CjZX1evFmnBf",
+	"MuOtCvZ9b+Zb3th3XY/VKYN+6NtTN3KR60TO1Hdn0HGcwHdsy4kmtj8L5s7Ec9HM9nzb8cewj1onat0t",
+	"zpLKavjxjpGH14dmSyOVhfVxiij3mUH8+e8HxyJ/maAPKugLafy0SokKnmEQBCvEcyp1IDw/9IMI+tbE",
+	"8ULXQrPQmznTeTSdh1Hk2ZE/thwPBmjmT33Xmc7mMLJsz3U9NGHV6iyTvD0aCxxfJiF6rpaO0yGxdtqE",
+	"HA1ydMUfJsl5h1B2YqoEWPqSmPpUoOgqFaSRoTgl19gNC5H/2JwjDDNEylAx0bPEd5wGMF6lhL6xZ67r",
+	"tjkuEemtrrq3k2r1Nt42xKFeskrUOnxZtSmCEvpCpcqwTdMCqhJOWUaOb/y1RocpKcdHMjJOmdbS39e3",
+	"hs9i5exs0ubovhI1tFUwBWsKfuMnqI86d0x4xZue1Q7hM30m+CHdkIC7vnbNXTqSxbtYeaaqe/IArmEl",
+	"5mA896bOfLIXKLuXX1GdLTiwZd2f3jUfcfJw0SuzUbpyxR1YEsIsFMEMd8U1aWu5bVlKnntcZF95v88v",
+	"eooBdm4zNVY0MU87aZuY1hHQztF6GaJ+l5m18kVfhnuJRMkGXXOoOjZmB/5H453gLQoQ3phemxAfCp3O",
+	"LSuev1/X6HJ3xoTkKGT6BiVMdYTiYYdSNORN3fniFqAkSEMUgsXZ+d2J1oQb+2U50ctzFmKnQLj76eTI",
+	"mXi8wpX6TTzSAthGVt4JDqVj8FK+SlLU4vxbyt1Q/hbc/8+9fNnl/kNuWW7ARuD/Qv9TK/X9P6KBGlI2",
+	"cixnXKvjOLId96/3TZe46mkwgwwrUhc/1ZrLdWVfPPpW2QrH4SS0WV26wI0sNPMdNA7saO7boQungYcs",
+	"fx7N4DT0gok/hu7cmdlTK/LQJBwHru9AY5nV3I9x8DPaGktu8SeXUAhEK51aFQriBKzQc31RmWQxvnvx",
+	"N4qqhcscyOyiAEZBYNnIj3wvnM6QO4+82RTakTWfTwLPiYL5JJrYCIa2BS0HIctHk4ntT8LAeHRRzGbI",
+	"GdY4U2NbDnsFMtcajy3HsaY2Kw0YTEMvilzoQd8OAje0fCeY+KHlW2gWOGPkhhZyJpEVWdCFbmBFwcT3",
+	"IgtZARtjEjpwMgmiwA2sYOI7yPbdMHRDz59FFvLgJLB8O7AiGzmhG4z9CfTm09lsOvfgxB8H7sFr/xVn",
+	"qL5ltl9i5j+tUKLsEm1CLXayKoSDrzTiy9N4y/H38Fa72dYukDUs9YJOMF3gdGY17UVSRf/CXZ1BUTW+",
+	"34YkOxsiawrXaaeTQzN5ooo0qycpRbwnJUpHM1Vcqom9N/YCKvM+dkfhA1riNUP9DqavHkdUjAhUEXbs",
+	"8Emo8GlWERNDipJge0VaZsAJWOM4xuqNAILZdikctaLkZVHKuZihtNIcq1rtoO0OgXds3uo0gUdJvmaY",
+	"44R55PKlbxvipdDBsHivlv+I+CNv/Lpm8FEDr9LqcApHYV/agQ8v9QLJrrocldQyyQ6TQtIeP2N8Z7FU",
+	"Vap6SZbGMUP5k3pxsK+7sAxc6By/GLdkk4nT05CHjyiDD6zw0K2xNvCJ+A4idflVv/BSF11dOpRIl4is",
+	"N8S9RtWzhzPSzzzi8b8SXnGE0sBl7LJMWWbonZCidsCplDklbkX9Fs1WLR6+UaV2NeukuhAdas92RuNe",
+	"UK/SPOvFRrxh7YVN8ZyuwmqaGaAa6i92sjF4AfK+l1qcyX9K88wUGsAn6+TMVppXKjsbeHQ868mj0tnX",
+	"BUUb13FNniHtkaMtotXyMaYZacFfBvsPM/Xi5/rtEmv/lZzFHvD28+AzokAcPpt6RXSS9xT8xnzDJNBn",
+	"KaLl+83aPYb2XU1c7DFirlFfJikl7pR3NPHKXvqdUEgxoTgg4AllovRbvo9BKTiqVf7P86waoVpRwppG",
+	"qDzY4Y37eUBqO0sVFu2GQQlQQ9F2qLKS4ZXmqDDkrjLYpTi/eN8qlIx4y5MBcfhdS45aqtPJH7Rj7YRy",
+	"5+5l9doFDqVKG4jrG8FIYUaNx6+M6hvQi42rjPZifRPTNtRLB5qaulMSiGthmvIGIIJxTMQ1KluYUHcN",
+	"Lg5UlGn/yWrmeE/kc1V8yjRxq8Z639TWlRWVKmsI1jJZS8Wjsf1+CPKE90ZhuVyNS16i2AR+jBQrUdIa",
+	"hqq1AaFs1KTAeoMov1Hlfxe7kfbGVmCFXhSgqT1GY8eZePY4siwr8OAEhiGE0HbHNgx8fx7MprY9se1x",
+	"GESzceRO/fl4Ar3Bxwb37o7X6SgXuOioEtjmOjBU+RAJ9D2ue0Tc0jtoiqfSx5V5IzzohL9izdxpYhjG",
+	"PaycrHKf/3Z6e3Y0HX8sCsf7WTAK0ePxdPxj42GAfpelbf6dZeP5VO3YKRMmBsOBeLVxMByoRxsHw4F4",
+	"s3EwHPx6crm8vP7zp4ub208Xl9cnby+X/zUYDkwvOfIRmg85stGq7ziy/s1nHHk703O1g6HB3XR+8/70",
+	"7eLT3bvF9fmnk+VyccWGGwwH8pVjDox4AX8wFDfPbOS75cnbxafTtzdnP6ufq+doM2Avc24ZXJPf3I+1",
+	"Q4OUOUcdRkvYP8tI6HwtsagilFUFFFXm7r7LqmVGffmya1ktccUSfC3CpRPAveqH7oDpzxncrJpxLZUd",
+	"zvhUinbVXbYFUSorHvjbjiBeNhpKQphQaT/JmJHep48a/NeV+hPaCSTLkwDuDL16YGPwtxN59Ux97YCu",
+	"oDCz1o3720q7Ah29IrP28UIbEf3HiG/V7Cmx+7EHj3EaNfgsWOE4zJBBzi/PzaeSVKeZOD/y8J/6M4hV",
+	"ZPV/i7Njn5cRcwVd/iaiY3e8CTqQsXHMauOHBu58QHSPWsEvSomphpw8wjgvFaXClYiKMoyjnCSG043J",
+	"bGxZiO60gRkT4RfTuQyhEqHabZDvReuvT3Sx5/bL8LGvfXToO64//B5L8sOwVAE7tMjLgg7qRBRXxuUj",
+	"nlV1lJVz9LoG+7IL5OIdk9o88Gn5bFgJfNJs9AqJZMCBtpKyIf+2+0guJt2J5QMEqXQ2L14w29XScKTc",
+	"owsPFqdFPkbvfsxI26P5r5A/M7/nFIWJuUevguk+milGdltxhWbs9+yXYZJ+710JGBsJwl18V2rDf0yu",
+	"qyK2fHebmN/tJm1vrtf1kb8t3vYmZSSTeEhdpi3JmCMtxZRXmWHaDScBfz2ZDJU3kKd7V57m3AqHunoF",
+	"vZeBrb0o38M14tffE92ZM1U0FgYXymRN1XYbvVaqK08qseYqZ1Bmq7AAkThN2est+QYwc0YlM6GQpw3I",
+	"50j5SbvEuq8/Ua5PmGagtu3KCFlRo4+KqlXiQc4ym2MEFnJpEi5ERGWDJK26ZpKwsLc4cYv9adQMeGih",
+	"hXa2SBpZNF3kqOXc8Os1ZLqdZOHcZfGBtqwZc4oMQyBNd4Qhj8AtOtJ7mUP9P+NNEYhcqRcB4wzBcKsB",
+	"h/vfZ6lg9R7cTnm8iDmcg4mlX8/WroeINMuerGBRdmQE7kQbxpU86agM/ygueqRGqGVvlUHbDEkbFA45",
+	"W6XCpzDa4/63CIrZiQ5zzZi2bbjb1uUtS5O3pnEFdX/m5xGjQ6LOU7JH4+X2ISCpjjYeYlSSiCtLiUBN",
+	"r6RZWSGGJ1vTPEt6nvZJZ6RbJUvXsl/+esmS/1xxG4ah2HXKrLGdtzYCIjHFDluxsH2aAdvyi+YI7+fc",
+	"emodkhechhTGIMJJWBu8ElgsZQNSqaGDmN8GpWXuPy/gKyKN62LoI5QUt27iFn3NSw/6qEyJw/UaQ5Q1",
+	"QckeUiYxNOhlJ7VVYWkYfN1lhfT9tHCVtqUc9snVeuGdSPncbhUWTa3VHqOvy7aoflspIVNyfR/Hyu6U",
+	"XaMvuV5BAGVHxvjnbonnjxbVS2U15jVVUrAa5CuziNgwYVWZtAaz9A7LKOYp6g29aoQvpDWG0FP4avAI",
+	"BQxgPyfJfrlJMmG5xmKaduyKuGiRVrKvuDbrY9XuNfYrCWab3VD9HdFt9yevm8X4kiJZNTz9MSWwpM9r",
+	"r0pXalfYvftJdQp77quB9L4bt2muj0bg/mKx+HS9OLn9xNL6r95f3Supk6EuMSLyQsS2/p0B8IjqRaOG",
+	"4P7tye2fF5/OT5Ynn27eL9+9X96LROEQUqiyYNlOW9RZsy0L/HwK0kzawATMrX9XJOW9AphlGGU8WW0I",
+	"7gWMJ3/5tPzLp7vLvy7uRWWY4ue7s9vLd0v5CRuPc7LoA7ft5HMDhsnVnQ/RPMJy07+/vuH3ttfnJ7fn",
+	"cka5UFkFVg7Mb/cR5vlr75x3P/805P83BOs8ppjgB5CkWQ09fDlXi9uf3y4+3d7cLO8+vb/+ZXF7eXG5",
+	"OC9WdKVXL+HpVaxwZbUor4p/L86Y5Q8PECeiXvJIu3+vs8BgOGjQczAc1Cmg/6Rhn/3cRBO74javrXrp",
+	"bYDFEApBCHzoesqvvJ+RtmVFY0Xi9tW2y5iwfoxe67XTspbvFCp4m8LP03iDPMN0e8c0j5DbUwQzlJ3k",
+	"plAP8Q3AnK5QQmUuB+C1/yIVIHZ7cQa86YRpVq7PuAHH+5UQryjdDL584QVNhSkX4wBJH7AwlAY3G/bw",
+	"8d0v4C37xA2hPIubdUYhIWmAOSSjBNHjdIOSI588Hskhj7Xzy4Bp6SPAFpdmmIrnX5VOB2dKEw+02jID",
+	"USTmy3DABoYbzPKq+E/DAfOlcJwdP9rH3JXD/3owRY8tGaFREvJCAdyfRMRB6QFRPXhaDKMYKGavlRIK",
+	"AuZMbJwrAU0fRJpq4VDKENswufCV2W0UqrBfnAGGMx8SVIvfLhx+MkyYHd6IrG4V4WwtjjdiCAkj94Hw",
+	"00vq80c/CyeSOOfUsll4L25LN9YRwITpjqI0IF8O05SF2cM0RpGzzHIUB39eLE8Fyhkh1Euh3Iu7Kw1a",
+	"wD9kwPF6rLZljcA5imAeU75m2xqJ4jGszCjKtmVpZH6OU6wNZRo4G15GYUp5Ndo+Xz4yAdVc545liZ2S",
+	"l1Vm/9SrKP9N1lApp9rp5CRCqOo1bHhBVcbCY8tuG6cA7Jg9ciEE5O+i1C97QO9QYFYeNTQAW3v9j9dw",
+	"Wa9htuXfDJLCCEUhO77/NjjJgsFH1ofJ46oo/2WUxzNWEoOwna0oSMWkUpXAYgKU5YnU3g3Ok7XFXpGe",
+	"cobD07OB0nL9K7UqI0JVlZPj35md+eX4d1aP48txUa5lP7XHS5IAVXmMKYEV98qipJb41qi0klNDarc4",
+	"xteHhWADt2tZhkV55zjAmrMzYjELwnuuXnoLiyiiSoBA9QKApwwkBHNLf6hVL9K7SC/7CJwkRZkYqRFV",
+	"rXYVVgCTbXtpmzXcAkJxHDM9WXapJasKzzSRthhKqhWMpHu/wczVgkM7tCnT7Jfn4AfX4aEJbLrVj3qY",
+	"517laLiKZdtoqWHlIaa0ZMTpuZSahtVjAhEba84YppNFZdqnKzS69Y01epUsuxSBdVgVfQpDFUpgmPkU",
+	"hurS42u2lfFhYS7e6TNAXHnJ7h9pQytUlaYfMGkTcV0ntO58ZRWOHipZeLWIftAkiLJweGJUFu/KMtuv",
+	"xPe10iXfYAc0rL0Nt0Tl477MyIc985+kXWxM35U3yeyDMGlJe6YT28rERrZBGc+tGUobHtaTlNSRIdyV",
+	"XYgzOX41v3DL9zo+mXaa4KpSxhrQFIiDBx+DTRyiAIunldKk9L3olTnEUUJDG9Qy9RR+fBh8fsiYdPNV",
+	"cA9ukD6irBvD/KF83W8nM+HFlWAmbzAbEiBSsl9RAMQEr2PJ/zEq1yhxK0xoylQj4wTBfVmaP6z4409F",
+	"bmarlqPPomgN2UcOCWI8AjL4VC/kCeVZWTBtEOdEBmByOUPP8rxRHINl8yBDjC2bjPLu5m65rLpHq2aV",
+	"Cbdlk+MAxjHj6/dZ3BpApDVnRqy45Hi/CRlEfTqt4fNSFBrt05rdUF8g9EtZF7YPXGkW7NmFzSPes9q/",
+	"3/J5vz5lmfC9V6YItEw/o706nEIarPbp8Iv0Re3Rhb9SeZ4L2UZkn66sKFqGeGzOr3y/6dP5SdYE7tHU",
+	"z1IYBpDQE7pX83MUw22fHuh5gzNE+g1PaS/xUkFCy3QgbH5u+56m4fZg+tQQxMtUnT5gGlBEj8RtTnXg",
+	"4n7Uxwnk/ipDTWv0TI95Ve5q36+M+G3o/aWxf+OM9eVFO6gElvcQJvWb37W9pf5ALy8lkyP9Ia5DPTtc",
+	"iUUYwEya+GDsuW9A7U/afNHVqrj/y0HLF77Y/XF5rz/23NKN3VymuFUdQCv05tAJIxhObWs6tVDozJwg",
+	"QK7tBZPp3Ik827KhN7PGHnQ8F9pTaENkOd7Us+wJqrro93nNy/0w4Fym4n4qZFlWcxrK2KCCOqLqPiQr",
+	"EU0h/kQsxoUfvHVUD6rpqIPymlzGH5TRBgPHctwjyz2y5rz8nftmPBu5M2duWxN7/NdBidGbn/VkAWPO",
+	"g8DwV2cCf9ET8c0oEp9bsGPp/0EYzOaRj0Lbc1HoWZZn+9B1/cCC/jxEMzSNwpnvjmE4HwfO2B4HYR27",
+	"U9dznFk3iiM0GTsTe2ZZlmON2f/Owvk0miMfhWE4j+YQzpCF5hPXd+HUi1zbc+YzdiuP5jN3DOHMtqe2",
+	"h+ahO59OvDGaWLblTCJvzDvaDnJYBbnJzHKDeTQfh3bgBDMEvRkKUGSP7Yll28gOWDt/Hsw9z/dgaDmW",
+	"Y0eTCLpzz5oG0PXHs3DiBnPL8cOJ7499P/LgFAbzeRDNoxCOJ0Hg2P7URh5youlsNvcs13LG0PF92/bQ",
+	"zHOdSTD3ZxPbiWzLd5zAcWaQBQ44EXIjd+r6th+O4Rx6vuuOfcub+b5nOYwUnj2du74znbmWy2TMdudW",
+	"gCCawKnthshC0A/nQQg9d2o5EZqNg7kzm08tGETTYDxBlm1ZcOJNWR0+z0PuzHNnbLj5dDKZu5aDoB/M",
+	"Jsj35r5jOYGDZl44dt2ZD/2pa1mziD0W+hqioKpVSwH46hLgfMt4wZ7Y0x/wv9ER9oe5o4YD9jjmQSc3",
+	"PolqgKT9vc+x4xwWJPP08haSvyiGEorpFhyJm8fLxfKC32NPZ9YU8CFAGTHDnkreSeT6C9asn3dg1ize",
+	"ZG45fBseJGbv2R6Y2rUXiA2wtL7OO/YOTOjLZJObYVAWE5Yt2OTuYScvlrknDg7skrmRNmEHEpTZyKc/",
+	"sPjzZLbm1CyDmKYpiPl5cjD2pgdGPjOZTlTKf5db6qpWHSBRbipeoGSxuBDwzQ4L3xmMgzxuPDLcQSRW",
+	"K4VUYTrwNmF+97gDpPprxOPpgUXoTHfHGEEpWwDGUsanicdT77BgCd2mMsjY/i1KTpgAFOKnIvGo7MO5",
+	"DKpeDMQD8//NJkhD9BavMV08BwiFLdDxZiIbAaCiIQPowAyvsMUKbp3FcL0xA6Q9sKS/bFdHX6BGYJAe",
+	"WAxO/Jyg5SpLKY13AUkALRsOB+OZ9Qqw3MpIAhMovAEgAa8akqZghR9WApIDb+wsGC7GTE3yyCIzNPIT",
+	"u0wJivYCHOfQFw3JBU5gbLZ1kqOIfazvrRPL3W2p3SIYsvdla7cUdyI3rHL92nEXIYNihKMpRhTtcSkR",
+	"MKzFABalMVkCginG2hThUdQ7ZiOxEBL+0ssWUXlNCBvFOkQqhChsJUMzCicrA4vhPcxjUYUxZF7X8oVP",
+	"AWosNK4sZJlGWsxf1bcmUCFu5Z5wHEuwy/lYzVHHGpcpKTLakDRmGwIIxtbc2BLS1pQdOYTpKu988Xax",
+	"XHTe0XSn/HWFwRwotqUZXzLuzp0o1vs9LqP7ILysZeGp6LCmUIkLQPqUZp+ZRDzUT9Bfo2XOlOh3apnh",
+	"C6MNgjzLUKISXEXU3C4dI+RYlzLK3/GECVgs4YN635TXAMDRVgXmEart3pXIPK6FtEx5FWcgrlNFvqSq",
+	"LjoC6sAsFdNldHSdJujoit2ZqbkLmPgFPocKZgjAhDyhInrYtcaAMddVGor0AxXwx1+65Nn6LKWFaNAz",
+	"DCbBCiYPLTf/zeIR/xs0hvUaV1Ry/R3OuKF8vpRDwYhkyNgUXpcyPL2VjQZdS2YwuCbFyOi/lvQfdo4P",
+	"1ozBZJ485yjZxsSA33hp37X4V4SLNypb7bbhjh9UgcL9tW4PG66as7F/xcKhCE9RIdE4K0t9sG4RQqQM",
+	"h2ape81ktKTIyRB5JWlUwFAJlmLGIM2Dz2JPkClIsG7k8XCrIjm2UfwNxnG/4m/12MYu9StqSP7jad/h",
+	"7uSTKoaTsuRcJR+lkZDSmpGyhs+sogppTUr5I7NSGiT7Z4pq+8fLjNmtSeoC3EMdahXq9lOIGTqKEK0/",
+	"osY1D3+tV9TFK9Rg5SU29fBWW60IqeIgAUG23dCUSxAOwCZL+RYLKZ+j6F5mgkCupQWsCY9srT8AWGzY",
+	"vAKL/l6USmX1UZRmSBqvSvUpy5k3F7Ej5gcHi9TYz2hDiyJb/Dz7kIspGTtJ6nSpQVWg7V/LDDW9J/Zd",
+	"r7yWXmHMyhl/W7ynpknF7mc3e2kYYSq9IKBXda1aXqZcLKUxeLmwoi6POO6BNNPqiIlCofU8roy9TcYO",
+	"qG1pXlyacQL+O4cZTCh/AoKNW0g2v2neoAynoZytKOhr9J6ptVFdS6YZfuC+TlJ6zteIQh45T/KA191X",
+	"sZy745JvFeq/n2S/K5Cv96TJnEYlf0NdGJiXTcTmhgf3o92WasAk+z10kPJ6f40Hn64013bzrCRMH14t",
+	"ryrXrN9fjk7LwGiGH+0HHvqsvF8V26PNUbnTGy8z7ktAeHWOHk7yO4Wn/6XO8rvidqOk1Hen+ctFvbgt",
+	"Gjbd6JosvJbfnDTJ2UPYKxWe9vfyCEHurgBVqZHVpge0+lZcfNfmtDRexV8VcGYeTcTuzWrSXZmQp5OX",
+	"oLVW4MPqmrAotiY0EizuDLirihemUc/IyJS8Wqkx4YJ/hJjXPAMpgxvGcTEn0aboeh2FsQo7lPEopV3n",
+	"n/cFHf+1zJfWem/frZjXdK80eL5bBQjr38jlHTqKvDStkVf32sSont1IDpDeCC4rL8MALAool+aLNE7u",
+	"T7hmeQN0Qj0fJSEj1v3QkI+bIa0cEE6ALy9i1DVhxh86YphGMFjpnTkQHO8oFI+NxVjUWEvQE/9niHig",
+	"EwrBf9zdXLM2JBX1qjAlYiY2SDH/ztMS+Z7G+T2N83sa5796Gue+L4bdlhkijfqd/1j5nR+Sl+WAfvk2",
+	"vpry4ZE66tQeU13w7x9kPtQHkRD1QSQ5fRi8+TC4+fnDYPihSHTiv9Wy/mQDHPKPX5v592EwHI1GXz4k",
+	"OlQsrVOHqhaiX4Xga7M7CwjKgpTi/X/+UMdeGZu//UulbLIQrH2yjX/7nm782unGgij7JdL+9sqZtJ49",
+	"875n0n7PpP1mmbQfq6m03+CNrW6fIFFvn33Pw/2nyMP9nuj6PdH1e6Lr90TX74muf3Siq85S39Nbv6e3",
+	"fk9v/Z7eeoD01uLOSBvalCKhPRXCzxf6IyG/fWSnh5MNPvoZbYs/pWUNxSJ++8j8rfyRCHlvUn3LA2bB",
+	"iEIYj4J0zY4Z/38A4MdHFLoKAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
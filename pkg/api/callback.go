@@ -0,0 +1,65 @@
+package api
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Callback payload schema versions. Version 1 payloads do not contain the version field so that
+// consumers which were implemented before versioning was introduced keep working unchanged. Version 2 payloads
+// contain the version field and the fields added after version 1, which is the id of the mined malleated variant.
+const (
+	CallbackVersion1      = 1
+	CallbackVersion2      = 2
+	CallbackVersionLatest = CallbackVersion2
+)
+
+// Callback is a status update of a transaction sent by ARC to the callback URL of the transaction.
+type Callback struct {
+	Version   int       `json:"version,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+
+	CompetingTxs []string `json:"competingTxs,omitempty"`
+
+	TxID       string  `json:"txid"`
+	TxStatus   string  `json:"txStatus"`
+	ExtraInfo  *string `json:"extraInfo,omitempty"`
+	MerklePath *string `json:"merklePath,omitempty"`
+
+	BlockHash   *string `json:"blockHash,omitempty"`
+	BlockHeight *uint64 `json:"blockHeight,omitempty"`
+
+	// MinedTxID is the id of the malleated variant of the transaction which was mined instead of the transaction, it is
+	// only sent in payloads of version 2
+	MinedTxID *string `json:"minedTxid,omitempty"`
+}
+
+// MarshalJSON omits the fields added in version 2, including the version field, from payloads of version 1.
+func (c Callback) MarshalJSON() ([]byte, error) {
+	type callback Callback
+	payload := callback(c)
+	if payload.Version < CallbackVersion2 {
+		payload.Version = 0
+		payload.MinedTxID = nil
+	}
+
+	return json.Marshal(payload)
+}
+
+// BatchCallback is a batch of status updates sent by ARC to callback URLs which requested batched callbacks.
+type BatchCallback struct {
+	Version   int         `json:"version,omitempty"`
+	Count     int         `json:"count"`
+	Callbacks []*Callback `json:"callbacks,omitempty"`
+}
+
+// MarshalJSON omits the version field from batches of version 1.
+func (b BatchCallback) MarshalJSON() ([]byte, error) {
+	type batchCallback BatchCallback
+	payload := batchCallback(b)
+	if payload.Version < CallbackVersion2 {
+		payload.Version = 0
+	}
+
+	return json.Marshal(payload)
+}
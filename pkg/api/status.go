@@ -11,10 +11,20 @@ type StatusCode int
 const (
 	arcDocServerErrorsURL = "https://bitcoin-sv.github.io/arc/#/errors?id=_"
 
+	// MIMEApplicationProblemJSON is the content type of errors returned as RFC 7807 problem details
+	MIMEApplicationProblemJSON = "application/problem+json"
+
+	InvalidParamInHeader = "header"
+	InvalidParamInPath   = "path"
+	InvalidParamInBody   = "body"
+
 	StatusOK                                 StatusCode = 200
 	ErrStatusBadRequest                      StatusCode = 400
 	ErrStatusNotFound                        StatusCode = 404
 	ErrStatusGeneric                         StatusCode = 409
+	ErrStatusUnsupportedMediaType            StatusCode = 415
+	ErrStatusTooManyRequests                 StatusCode = 429
+	ErrStatusReadOnly                        StatusCode = 503
 	ErrStatusTxFormat                        StatusCode = 460
 	ErrStatusUnlockingScripts                StatusCode = 461
 	ErrStatusInputs                          StatusCode = 462
@@ -29,6 +39,13 @@ const (
 	ErrStatusCumulativeFees                  StatusCode = 473
 	ErrStatusTxSize                          StatusCode = 474
 	ErrStatusMinedAncestorsNotFoundInBUMP    StatusCode = 475
+	ErrStatusScriptTemplateNotAllowed        StatusCode = 476
+	ErrStatusOpcodeLimitExceeded             StatusCode = 477
+	ErrStatusTemplateRateClamped             StatusCode = 478
+	ErrStatusAbuseThrottled                  StatusCode = 479
+	ErrStatusAbuseRejected                   StatusCode = 480
+	ErrStatusComplianceBlocked               StatusCode = 481
+	ErrStatusNonFinal                        StatusCode = 482
 )
 
 func (e *ErrorFields) GetSpanAttributes() []attribute.KeyValue {
@@ -39,6 +56,18 @@ func (e *ErrorFields) GetSpanAttributes() []attribute.KeyValue {
 	return attr
 }
 
+// WithInvalidParam adds a parameter of the request which failed validation to the error.
+func (e *ErrorFields) WithInvalidParam(name string, in string, reason string) *ErrorFields {
+	invalidParams := []InvalidParam{}
+	if e.InvalidParams != nil {
+		invalidParams = *e.InvalidParams
+	}
+	invalidParams = append(invalidParams, InvalidParam{Name: name, In: in, Reason: reason})
+	e.InvalidParams = &invalidParams
+
+	return e
+}
+
 func NewErrorFields(status StatusCode, extraInfo string) *ErrorFields {
 	emptyString := ""
 	errFields := ErrorFields{
@@ -63,6 +92,18 @@ func NewErrorFields(status StatusCode, extraInfo string) *ErrorFields {
 		errFields.Detail = "Transaction could not be processed"
 		errFields.Title = "Generic error"
 		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusGeneric))
+	case ErrStatusUnsupportedMediaType: // 415
+		errFields.Detail = "The content type of the request is not supported"
+		errFields.Title = "Unsupported media type"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusUnsupportedMediaType))
+	case ErrStatusTooManyRequests: // 429
+		errFields.Detail = "The memory budget for in-flight submissions is exhausted, retry later"
+		errFields.Title = "Too many requests"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusTooManyRequests))
+	case ErrStatusReadOnly: // 503
+		errFields.Detail = "The API is in read-only mode and does not accept submissions"
+		errFields.Title = "Read-only mode"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusReadOnly))
 	case ErrStatusTxFormat: // 460
 		errFields.Detail = "Missing input scripts: Transaction could not be transformed to extended format"
 		errFields.Title = "Not extended format"
@@ -119,6 +160,34 @@ func NewErrorFields(status StatusCode, extraInfo string) *ErrorFields {
 		errFields.Detail = "BEEF validation failed: couldn't find mined ancestor of the transaction in provided BUMPs"
 		errFields.Title = "Mined ancestors not found in BUMPs"
 		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusMinedAncestorsNotFoundInBUMP))
+	case ErrStatusScriptTemplateNotAllowed: // 476
+		errFields.Detail = "Transaction output script does not match any script template allowed for the API key"
+		errFields.Title = "Script template not allowed"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusScriptTemplateNotAllowed))
+	case ErrStatusOpcodeLimitExceeded: // 477
+		errFields.Detail = "Transaction scripts exceed the opcode limits of the policy"
+		errFields.Title = "Opcode limit exceeded"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusOpcodeLimitExceeded))
+	case ErrStatusTemplateRateClamped: // 478
+		errFields.Detail = "Submission rate of the script template of the transaction is clamped due to an anomaly"
+		errFields.Title = "Submission rate of the script template clamped"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusTemplateRateClamped))
+	case ErrStatusAbuseThrottled: // 479
+		errFields.Detail = "Submissions of the client are throttled due to its abuse score"
+		errFields.Title = "Submissions throttled"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusAbuseThrottled))
+	case ErrStatusAbuseRejected: // 480
+		errFields.Detail = "Transaction rejected due to the abuse score of the client"
+		errFields.Title = "Abuse score too high"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusAbuseRejected))
+	case ErrStatusComplianceBlocked: // 481
+		errFields.Detail = "Transaction blocked by the compliance engine"
+		errFields.Title = "Blocked by compliance"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusComplianceBlocked))
+	case ErrStatusNonFinal: // 482
+		errFields.Detail = "Transaction is not final, its lock time is not reached yet"
+		errFields.Title = "Non-final transaction"
+		errFields.Type = arcDocServerErrorsURL + strconv.Itoa(int(ErrStatusNonFinal))
 	default:
 		errFields.Status = int(ErrStatusGeneric)
 		errFields.Detail = "Transaction could not be processed"
@@ -0,0 +1,241 @@
+package arc_utxo_client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
+	"github.com/cenkalti/backoff/v4"
+)
+
+var (
+	ErrArcInvalidAddress       = errors.New("invalid address")
+	ErrArcFailedToGetUTXOs     = errors.New("failed to get utxos from ARC store")
+	ErrArcFailedToGetSpendings = errors.New("failed to get spending transactions from ARC store")
+	ErrArcFailedToDecodeTx     = errors.New("failed to decode transaction from ARC store")
+	ErrArcTopUpNotSupported    = errors.New("top up is not supported by the ARC store")
+)
+
+// StoredTx is a transaction stored by ARC. Mined is true if the transaction is mined.
+type StoredTx struct {
+	RawTx []byte
+	Mined bool
+}
+
+type Store interface {
+	// GetTransactions returns the stored transactions which are neither rejected nor expired and which contain the
+	// locking script.
+	GetTransactions(ctx context.Context, lockingScript []byte) ([]StoredTx, error)
+	// GetSpendingTransactions returns the stored transactions which are neither rejected nor expired and which spend
+	// outputs of the transactions with the given hashes.
+	GetSpendingTransactions(ctx context.Context, hashes [][]byte) ([]StoredTx, error)
+}
+
+// ArcUtxoClient gets the UTXOs of addresses from the transactions stored by ARC, i.e. from the database of Metamorph.
+// Only the outputs of transactions which were submitted to ARC are known, therefore an address has to be funded by a
+// transaction submitted to ARC. Outputs which are spent by a stored transaction which is neither rejected nor expired
+// are not unspent. Top ups are not supported.
+type ArcUtxoClient struct {
+	store  Store
+	logger *slog.Logger
+}
+
+func WithLogger(logger *slog.Logger) func(*ArcUtxoClient) {
+	return func(a *ArcUtxoClient) {
+		a.logger = logger
+	}
+}
+
+func New(s Store, opts ...func(client *ArcUtxoClient)) *ArcUtxoClient {
+	a := &ArcUtxoClient{
+		store:  s,
+		logger: slog.Default(),
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	return a
+}
+
+type utxo struct {
+	*sdkTx.UTXO
+	mined bool
+}
+
+func (a *ArcUtxoClient) listUnspent(ctx context.Context, address string) ([]utxo, error) {
+	addr, err := script.NewAddressFromString(address)
+	if err != nil {
+		return nil, errors.Join(ErrArcInvalidAddress, err)
+	}
+
+	lockingScript, err := p2pkh.Lock(addr)
+	if err != nil {
+		return nil, errors.Join(ErrArcInvalidAddress, err)
+	}
+
+	stored, err := a.store.GetTransactions(ctx, *lockingScript)
+	if err != nil {
+		return nil, errors.Join(ErrArcFailedToGetUTXOs, err)
+	}
+
+	outputs := make([]utxo, 0)
+	hashes := make([][]byte, 0, len(stored))
+	for _, storedTx := range stored {
+		tx, err := sdkTx.NewTransactionFromBytes(storedTx.RawTx)
+		if err != nil {
+			return nil, errors.Join(ErrArcFailedToDecodeTx, err)
+		}
+
+		hash := tx.TxID()
+		found := false
+		for vout, output := range tx.Outputs {
+			if !bytes.Equal(*output.LockingScript, *lockingScript) {
+				continue
+			}
+
+			found = true
+			outputs = append(outputs, utxo{
+				UTXO: &sdkTx.UTXO{
+					TxID:          hash,
+					Vout:          uint32(vout), // #nosec G115
+					LockingScript: lockingScript,
+					Satoshis:      output.Satoshis,
+				},
+				mined: storedTx.Mined,
+			})
+		}
+
+		if found {
+			hashes = append(hashes, hash[:])
+		}
+	}
+
+	if len(outputs) == 0 {
+		return outputs, nil
+	}
+
+	spending, err := a.store.GetSpendingTransactions(ctx, hashes)
+	if err != nil {
+		return nil, errors.Join(ErrArcFailedToGetSpendings, err)
+	}
+
+	type outpoint struct {
+		hash chainhash.Hash
+		vout uint32
+	}
+
+	spent := make(map[outpoint]struct{})
+	for _, storedTx := range spending {
+		tx, err := sdkTx.NewTransactionFromBytes(storedTx.RawTx)
+		if err != nil {
+			return nil, errors.Join(ErrArcFailedToDecodeTx, err)
+		}
+
+		for _, input := range tx.Inputs {
+			spent[outpoint{hash: *input.SourceTXID, vout: input.SourceTxOutIndex}] = struct{}{}
+		}
+	}
+
+	unspent := make([]utxo, 0, len(outputs))
+	for _, output := range outputs {
+		if _, found := spent[outpoint{hash: *output.TxID, vout: output.Vout}]; found {
+			continue
+		}
+		unspent = append(unspent, output)
+	}
+
+	return unspent, nil
+}
+
+// GetUTXOs Get UTXOs from the transactions stored by ARC
+func (a *ArcUtxoClient) GetUTXOs(ctx context.Context, address string) (sdkTx.UTXOs, error) {
+	unspent, err := a.listUnspent(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make(sdkTx.UTXOs, len(unspent))
+	for i, output := range unspent {
+		utxos[i] = output.UTXO
+	}
+
+	return utxos, nil
+}
+
+func (a *ArcUtxoClient) GetUTXOsWithRetries(ctx context.Context, address string, constantBackoff time.Duration, retries uint64) (sdkTx.UTXOs, error) {
+	policy := backoff.WithMaxRetries(backoff.NewConstantBackOff(constantBackoff), retries)
+
+	policyContext := backoff.WithContext(policy, ctx)
+	operation := func() (sdkTx.UTXOs, error) {
+		return a.GetUTXOs(ctx, address)
+	}
+
+	notify := func(err error, nextTry time.Duration) {
+		a.logger.ErrorContext(ctx, "failed to get utxos from ARC store", slog.String("address", address), slog.String("next try", nextTry.String()), slog.String("err", err.Error()))
+	}
+
+	return backoff.RetryNotifyWithData(operation, policyContext, notify)
+}
+
+// GetBalance returns the confirmed and the unconfirmed balance of the address in satoshis. Outputs of mined
+// transactions are confirmed.
+func (a *ArcUtxoClient) GetBalance(ctx context.Context, address string) (uint64, uint64, error) {
+	unspent, err := a.listUnspent(ctx, address)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var confirmed, unconfirmed uint64
+	for _, output := range unspent {
+		if output.mined {
+			confirmed += output.Satoshis
+			continue
+		}
+		unconfirmed += output.Satoshis
+	}
+
+	return confirmed, unconfirmed, nil
+}
+
+func (a *ArcUtxoClient) GetBalanceWithRetries(ctx context.Context, address string, constantBackoff time.Duration, retries uint64) (uint64, uint64, error) {
+	policy := backoff.WithMaxRetries(backoff.NewConstantBackOff(constantBackoff), retries)
+
+	policyContext := backoff.WithContext(policy, ctx)
+
+	type balanceResult struct {
+		confirmed   uint64
+		unconfirmed uint64
+	}
+
+	operation := func() (balanceResult, error) {
+		confirmed, unconfirmed, err := a.GetBalance(ctx, address)
+		if err != nil {
+			return balanceResult{}, err
+		}
+		return balanceResult{confirmed: confirmed, unconfirmed: unconfirmed}, nil
+	}
+
+	notify := func(err error, nextTry time.Duration) {
+		a.logger.ErrorContext(ctx, "failed to get balance from ARC store", slog.String("address", address), slog.String("next try", nextTry.String()), slog.String("err", err.Error()))
+	}
+
+	balanceRes, err := backoff.RetryNotifyWithData(operation, policyContext, notify)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return balanceRes.confirmed, balanceRes.unconfirmed, nil
+}
+
+// TopUp is not supported, as ARC has no funds of its own.
+func (a *ArcUtxoClient) TopUp(_ context.Context, _ string) error {
+	return ErrArcTopUpNotSupported
+}
@@ -0,0 +1,3 @@
+package arc_utxo_client
+
+//go:generate moq -pkg mocks -out ./mocks/store_mock.go . Store
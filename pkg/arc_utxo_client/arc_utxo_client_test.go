@@ -0,0 +1,158 @@
+package arc_utxo_client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/arc_utxo_client"
+	"github.com/bitcoin-sv/arc/pkg/arc_utxo_client/mocks"
+)
+
+const address = "mhCkkGrvgXGw6Y4QdPXDyWJPNy2qrHTcf8"
+
+func TestArcUtxoClient_GetUTXOs(t *testing.T) {
+	addr, err := script.NewAddressFromString(address)
+	require.NoError(t, err)
+	lockingScript, err := p2pkh.Lock(addr)
+	require.NoError(t, err)
+	otherScript, err := script.NewFromHex("006a")
+	require.NoError(t, err)
+
+	// funding tx with two outputs to the address and one other output
+	fundingTx := sdkTx.NewTransaction()
+	fundingTx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 1000, LockingScript: lockingScript})
+	fundingTx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 0, LockingScript: otherScript})
+	fundingTx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 2000, LockingScript: lockingScript})
+
+	// spending tx spending the first output of the funding tx
+	spendingTx := sdkTx.NewTransaction()
+	spendingTx.AddInput(&sdkTx.TransactionInput{SourceTXID: fundingTx.TxID(), SourceTxOutIndex: 0, UnlockingScript: &script.Script{}})
+	spendingTx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 999, LockingScript: otherScript})
+
+	tt := []struct {
+		name        string
+		address     string
+		stored      []arc_utxo_client.StoredTx
+		getErr      error
+		spending    []arc_utxo_client.StoredTx
+		spendingErr error
+
+		expectedVouts       []uint32
+		expectedConfirmed   uint64
+		expectedUnconfirmed uint64
+		expectedError       error
+	}{
+		{
+			name:     "unspent outputs",
+			address:  address,
+			stored:   []arc_utxo_client.StoredTx{{RawTx: fundingTx.Bytes(), Mined: true}},
+			spending: []arc_utxo_client.StoredTx{{RawTx: spendingTx.Bytes()}},
+
+			expectedVouts:     []uint32{2},
+			expectedConfirmed: 2000,
+		},
+		{
+			name:    "unmined outputs are unconfirmed",
+			address: address,
+			stored:  []arc_utxo_client.StoredTx{{RawTx: fundingTx.Bytes()}},
+
+			expectedVouts:       []uint32{0, 2},
+			expectedUnconfirmed: 3000,
+		},
+		{
+			name:    "no stored txs",
+			address: address,
+
+			expectedVouts: []uint32{},
+		},
+		{
+			name:    "invalid address",
+			address: "invalid",
+
+			expectedError: arc_utxo_client.ErrArcInvalidAddress,
+		},
+		{
+			name:    "failed to get txs",
+			address: address,
+			getErr:  errors.New("connection refused"),
+
+			expectedError: arc_utxo_client.ErrArcFailedToGetUTXOs,
+		},
+		{
+			name:        "failed to get spending txs",
+			address:     address,
+			stored:      []arc_utxo_client.StoredTx{{RawTx: fundingTx.Bytes()}},
+			spendingErr: errors.New("connection refused"),
+
+			expectedError: arc_utxo_client.ErrArcFailedToGetSpendings,
+		},
+		{
+			name:    "invalid tx",
+			address: address,
+			stored:  []arc_utxo_client.StoredTx{{RawTx: []byte{0x01}}},
+
+			expectedError: arc_utxo_client.ErrArcFailedToDecodeTx,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			store := &mocks.StoreMock{
+				GetTransactionsFunc: func(_ context.Context, actualScript []byte) ([]arc_utxo_client.StoredTx, error) {
+					require.Equal(t, []byte(*lockingScript), actualScript)
+					return tc.stored, tc.getErr
+				},
+				GetSpendingTransactionsFunc: func(_ context.Context, hashes [][]byte) ([]arc_utxo_client.StoredTx, error) {
+					require.Equal(t, [][]byte{fundingTx.TxID()[:]}, hashes)
+					return tc.spending, tc.spendingErr
+				},
+			}
+
+			sut := arc_utxo_client.New(store)
+
+			// when
+			utxos, err := sut.GetUTXOs(context.Background(), tc.address)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			vouts := make([]uint32, 0, len(utxos))
+			for _, utxo := range utxos {
+				assert.Equal(t, fundingTx.TxID(), utxo.TxID)
+				vouts = append(vouts, utxo.Vout)
+			}
+			assert.Equal(t, tc.expectedVouts, vouts)
+
+			// when
+			confirmed, unconfirmed, err := sut.GetBalance(context.Background(), tc.address)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedConfirmed, confirmed)
+			assert.Equal(t, tc.expectedUnconfirmed, unconfirmed)
+		})
+	}
+}
+
+func TestArcUtxoClient_TopUp(t *testing.T) {
+	// given
+	sut := arc_utxo_client.New(&mocks.StoreMock{})
+
+	// when
+	err := sut.TopUp(context.Background(), address)
+
+	// then
+	require.ErrorIs(t, err, arc_utxo_client.ErrArcTopUpNotSupported)
+}
@@ -0,0 +1,81 @@
+package arc_utxo_client
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/bitcoin-sv/arc/internal/metamorph/metamorph_api"
+)
+
+const postgresDriverName = "postgres"
+
+// PostgreSQL reads the transactions stored by ARC from the database of Metamorph. The transactions are found by a
+// search of the locking script in the raw transactions, which scans the transactions table, therefore it is meant for
+// test environments.
+type PostgreSQL struct {
+	db *sql.DB
+}
+
+func NewPostgreSQL(dbInfo string) (*PostgreSQL, error) {
+	db, err := sql.Open(postgresDriverName, dbInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open postgres DB: %+v", err)
+	}
+
+	return &PostgreSQL{db: db}, nil
+}
+
+func (p *PostgreSQL) GetTransactions(ctx context.Context, lockingScript []byte) ([]StoredTx, error) {
+	q := `SELECT raw_tx, status FROM metamorph.transactions
+		WHERE raw_tx IS NOT NULL AND status <> ALL($2::INT[]) AND position($1::BYTEA IN raw_tx) > 0;`
+
+	rows, err := p.db.QueryContext(ctx, q, lockingScript, pq.Array(excludedStatuses()))
+	if err != nil {
+		return nil, err
+	}
+
+	return scanStoredTxs(rows)
+}
+
+func (p *PostgreSQL) GetSpendingTransactions(ctx context.Context, hashes [][]byte) ([]StoredTx, error) {
+	q := `SELECT t.raw_tx, t.status FROM metamorph.transactions t
+		JOIN metamorph.transaction_parents tp ON tp.hash = t.hash
+		WHERE tp.parent_hash = ANY($1::BYTEA[]) AND t.raw_tx IS NOT NULL AND t.status <> ALL($2::INT[]);`
+
+	rows, err := p.db.QueryContext(ctx, q, pq.Array(hashes), pq.Array(excludedStatuses()))
+	if err != nil {
+		return nil, err
+	}
+
+	return scanStoredTxs(rows)
+}
+
+func (p *PostgreSQL) Close() error {
+	return p.db.Close()
+}
+
+// excludedStatuses are the statuses of the transactions which are not going to be mined.
+func excludedStatuses() []int32 {
+	return []int32{int32(metamorph_api.Status_REJECTED), int32(metamorph_api.Status_EXPIRED)}
+}
+
+func scanStoredTxs(rows *sql.Rows) ([]StoredTx, error) {
+	defer rows.Close()
+
+	txs := make([]StoredTx, 0)
+	for rows.Next() {
+		var rawTx []byte
+		var status int32
+		err := rows.Scan(&rawTx, &status)
+		if err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, StoredTx{RawTx: rawTx, Mined: metamorph_api.Status(status) == metamorph_api.Status_MINED})
+	}
+
+	return txs, rows.Err()
+}
@@ -0,0 +1,219 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	callbackTimestampHeader = "X-Callback-Timestamp"
+	callbackSignatureHeader = "X-Callback-Signature"
+	signaturePrefix         = "sha256="
+
+	toleranceDefault   = 5 * time.Minute
+	maxPayloadSize     = 10 << 20
+	callbackBearerType = "Bearer "
+)
+
+var (
+	ErrSignatureMissing     = errors.New("callback signature missing")
+	ErrSignatureInvalid     = errors.New("callback signature invalid")
+	ErrTimestampInvalid     = errors.New("callback timestamp invalid")
+	ErrTimestampExpired     = errors.New("callback timestamp outside of tolerance")
+	ErrUnauthorized         = errors.New("callback token invalid")
+	ErrInvalidCallback      = errors.New("invalid callback payload")
+	ErrHandleCallbackFailed = errors.New("failed to handle callback")
+)
+
+// Callback is a status update of a transaction sent by ARC to the callback endpoint of the transaction, its status is
+// Status(callback.TxStatus).
+type Callback = api.Callback
+
+// BatchCallback is a batch of status updates sent by ARC to callback endpoints which requested batched callbacks.
+type BatchCallback = api.BatchCallback
+
+// CallbackHandler is an http.Handler receiving the callbacks of ARC. It verifies the token and signature of the
+// requests if configured and calls the handle function with every callback of single and batched payloads.
+type CallbackHandler struct {
+	handle        func(ctx context.Context, callback *Callback) error
+	token         string
+	signingSecret string
+	tolerance     time.Duration
+	now           func() time.Time
+}
+
+// WithCallbackToken makes the handler reject requests whose Authorization header is not "Bearer <token>", i.e. the
+// callback token with which the transactions were submitted.
+func WithCallbackToken(token string) func(*CallbackHandler) {
+	return func(h *CallbackHandler) {
+		h.token = token
+	}
+}
+
+// WithSigningSecret makes the handler reject requests which are not signed with the callback signing secret of ARC.
+func WithSigningSecret(secret string) func(*CallbackHandler) {
+	return func(h *CallbackHandler) {
+		h.signingSecret = secret
+	}
+}
+
+// WithTolerance sets the maximum age of the timestamp of signed requests, 5 minutes by default, to reject replays.
+func WithTolerance(d time.Duration) func(*CallbackHandler) {
+	return func(h *CallbackHandler) {
+		h.tolerance = d
+	}
+}
+
+// WithNow sets the function returning the current time against which the timestamps of signed requests are checked.
+func WithNow(now func() time.Time) func(*CallbackHandler) {
+	return func(h *CallbackHandler) {
+		h.now = now
+	}
+}
+
+// NewCallbackHandler returns a handler calling handle with every received callback. If handle returns an error the
+// request is answered with status 500, so that ARC retries to send the callback.
+func NewCallbackHandler(handle func(ctx context.Context, callback *Callback) error, opts ...func(*CallbackHandler)) *CallbackHandler {
+	h := &CallbackHandler{
+		handle:    handle,
+		tolerance: toleranceDefault,
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.token != "" && !hmac.Equal([]byte(r.Header.Get("Authorization")), []byte(callbackBearerType+h.token)) {
+		http.Error(w, ErrUnauthorized.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, errors.Join(ErrInvalidCallback, err).Error(), http.StatusBadRequest)
+		return
+	}
+
+	if h.signingSecret != "" {
+		err = VerifySignature(h.signingSecret, r.Header.Get(callbackTimestampHeader), r.Header.Get(callbackSignatureHeader), payload, h.tolerance, h.now())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	callbacks, err := ParseCallbacks(payload)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, callback := range callbacks {
+		err = h.handle(r.Context(), callback)
+		if err != nil {
+			http.Error(w, errors.Join(ErrHandleCallbackFailed, err).Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// VerifySignature verifies that the signature header of a callback is the HMAC-SHA256 of "<timestamp>.<payload>" with
+// the signing secret and that its timestamp header is at most tolerance apart from now. A tolerance of 0 disables the
+// check of the timestamp.
+func VerifySignature(secret, timestamp, signature string, payload []byte, tolerance time.Duration, now time.Time) error {
+	if timestamp == "" || signature == "" {
+		return ErrSignatureMissing
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Join(ErrTimestampInvalid, err)
+	}
+
+	if tolerance > 0 {
+		age := now.Sub(time.Unix(unix, 0))
+		if age > tolerance || age < -tolerance {
+			return ErrTimestampExpired
+		}
+	}
+
+	signatureHex, found := strings.CutPrefix(signature, signaturePrefix)
+	if !found {
+		return ErrSignatureInvalid
+	}
+
+	expected, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return errors.Join(ErrSignatureInvalid, err)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return ErrSignatureInvalid
+	}
+
+	return nil
+}
+
+// ParseCallbacks returns the callbacks of a single or batched callback payload.
+func ParseCallbacks(payload []byte) ([]*Callback, error) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal(payload, &fields)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidCallback, err)
+	}
+
+	_, isBatch := fields["callbacks"]
+	if !isBatch {
+		_, isBatch = fields["count"]
+	}
+
+	if isBatch {
+		var batch BatchCallback
+		err = json.Unmarshal(payload, &batch)
+		if err != nil {
+			return nil, errors.Join(ErrInvalidCallback, err)
+		}
+
+		return batch.Callbacks, nil
+	}
+
+	var callback Callback
+	err = json.Unmarshal(payload, &callback)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidCallback, err)
+	}
+
+	if callback.TxID == "" {
+		return nil, errors.Join(ErrInvalidCallback, errors.New("txid missing"))
+	}
+
+	return []*Callback{&callback}, nil
+}
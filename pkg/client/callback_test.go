@@ -0,0 +1,201 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/client"
+)
+
+const (
+	signingSecret = "secret"
+	callbackToken = "token"
+
+	singlePayload = `{"timestamp":"2026-01-01T00:00:00Z","txid":"abc","txStatus":"MINED","blockHeight":100}`
+	batchPayload  = `{"count":2,"callbacks":[{"timestamp":"2026-01-01T00:00:00Z","txid":"abc","txStatus":"MINED"},{"timestamp":"2026-01-01T00:00:00Z","txid":"def","txStatus":"SEEN_ON_NETWORK"}]}`
+)
+
+func sign(secret, timestamp, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + payload))
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifySignature(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	tt := []struct {
+		name      string
+		timestamp string
+		signature string
+		now       time.Time
+
+		expectedError error
+	}{
+		{
+			name:      "valid signature",
+			timestamp: timestamp,
+			signature: sign(signingSecret, timestamp, singlePayload),
+			now:       now.Add(time.Minute),
+		},
+		{
+			name:      "signature missing",
+			timestamp: timestamp,
+			now:       now,
+
+			expectedError: client.ErrSignatureMissing,
+		},
+		{
+			name:      "wrong secret",
+			timestamp: timestamp,
+			signature: sign("other", timestamp, singlePayload),
+			now:       now,
+
+			expectedError: client.ErrSignatureInvalid,
+		},
+		{
+			name:      "missing prefix",
+			timestamp: timestamp,
+			signature: sign(signingSecret, timestamp, singlePayload)[len("sha256="):],
+			now:       now,
+
+			expectedError: client.ErrSignatureInvalid,
+		},
+		{
+			name:      "invalid timestamp",
+			timestamp: "yesterday",
+			signature: sign(signingSecret, "yesterday", singlePayload),
+			now:       now,
+
+			expectedError: client.ErrTimestampInvalid,
+		},
+		{
+			name:      "expired timestamp",
+			timestamp: timestamp,
+			signature: sign(signingSecret, timestamp, singlePayload),
+			now:       now.Add(10 * time.Minute),
+
+			expectedError: client.ErrTimestampExpired,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := client.VerifySignature(signingSecret, tc.timestamp, tc.signature, []byte(singlePayload), 5*time.Minute, tc.now)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
+
+func TestCallbackHandler(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+
+	tt := []struct {
+		name          string
+		payload       string
+		authorization string
+		signature     string
+		handleErr     error
+
+		expectedStatus int
+		expectedTxIDs  []string
+	}{
+		{
+			name:          "single callback",
+			payload:       singlePayload,
+			authorization: "Bearer " + callbackToken,
+			signature:     sign(signingSecret, timestamp, singlePayload),
+
+			expectedStatus: http.StatusOK,
+			expectedTxIDs:  []string{"abc"},
+		},
+		{
+			name:          "batched callbacks",
+			payload:       batchPayload,
+			authorization: "Bearer " + callbackToken,
+			signature:     sign(signingSecret, timestamp, batchPayload),
+
+			expectedStatus: http.StatusOK,
+			expectedTxIDs:  []string{"abc", "def"},
+		},
+		{
+			name:          "wrong token",
+			payload:       singlePayload,
+			authorization: "Bearer other",
+			signature:     sign(signingSecret, timestamp, singlePayload),
+
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "tampered payload",
+			payload:       `{"timestamp":"2026-01-01T00:00:00Z","txid":"abc","txStatus":"REJECTED"}`,
+			authorization: "Bearer " + callbackToken,
+			signature:     sign(signingSecret, timestamp, singlePayload),
+
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "invalid payload",
+			payload:       `{"txStatus":"MINED"}`,
+			authorization: "Bearer " + callbackToken,
+			signature:     sign(signingSecret, timestamp, `{"txStatus":"MINED"}`),
+
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:          "handle error",
+			payload:       singlePayload,
+			authorization: "Bearer " + callbackToken,
+			signature:     sign(signingSecret, timestamp, singlePayload),
+			handleErr:     errors.New("db down"),
+
+			expectedStatus: http.StatusInternalServerError,
+			expectedTxIDs:  []string{"abc"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var txIDs []string
+			sut := client.NewCallbackHandler(func(_ context.Context, callback *client.Callback) error {
+				txIDs = append(txIDs, callback.TxID)
+				return tc.handleErr
+			},
+				client.WithCallbackToken(callbackToken),
+				client.WithSigningSecret(signingSecret),
+				client.WithNow(func() time.Time { return now }),
+			)
+
+			req := httptest.NewRequest(http.MethodPost, "/callback", bytes.NewBufferString(tc.payload))
+			req.Header.Set("Authorization", tc.authorization)
+			req.Header.Set("X-Callback-Timestamp", timestamp)
+			req.Header.Set("X-Callback-Signature", tc.signature)
+			rec := httptest.NewRecorder()
+
+			// when
+			sut.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.expectedStatus, rec.Code)
+			require.Equal(t, tc.expectedTxIDs, txIDs)
+		})
+	}
+}
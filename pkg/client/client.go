@@ -0,0 +1,358 @@
+// Package client provides a high level client of ARC for integrators. It submits transactions in extended format,
+// waits until they reach a status and verifies and parses the callbacks sent by ARC, on top of the generated API
+// client in pkg/api.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+
+	"github.com/bitcoin-sv/arc/pkg/api"
+)
+
+const (
+	pollIntervalDefault = 2 * time.Second
+	maxTimeoutDefault   = 5
+)
+
+var (
+	ErrRejected          = errors.New("transaction rejected")
+	ErrSubmitFailed      = errors.New("failed to submit transaction")
+	ErrGetStatusFailed   = errors.New("failed to get transaction status")
+	ErrTxNotFound        = errors.New("transaction not found")
+	ErrSourceFetchFailed = errors.New("failed to fetch source transaction")
+)
+
+// SourceFetcher returns the transaction with the given ID. It is used to look up the outputs spent by the inputs of
+// transactions which are submitted without source transactions, so that they can be sent in extended format.
+type SourceFetcher interface {
+	Transaction(ctx context.Context, txID string) (*sdkTx.Transaction, error)
+}
+
+// Client submits transactions to ARC and waits for their statuses.
+type Client struct {
+	client        api.ClientWithResponsesInterface
+	apiOpts       []api.ClientOption
+	pollInterval  time.Duration
+	maxTimeout    int
+	callbackURL   string
+	callbackToken string
+	sourceFetcher SourceFetcher
+}
+
+// Response is the response of ARC to a submitted transaction or the status of a transaction.
+type Response struct {
+	TxID         string
+	TxStatus     Status
+	ExtraInfo    string
+	BlockHash    string
+	BlockHeight  uint64
+	MerklePath   string
+	CompetingTxs []string
+	Timestamp    time.Time
+}
+
+// Error is an error response of ARC, e.g. the rejection of a transaction which failed validation.
+type Error struct {
+	Status    int
+	Title     string
+	Detail    string
+	ExtraInfo string
+}
+
+func (e *Error) Error() string {
+	if e.ExtraInfo != "" {
+		return fmt.Sprintf("%d %s: %s: %s", e.Status, e.Title, e.Detail, e.ExtraInfo)
+	}
+
+	return fmt.Sprintf("%d %s: %s", e.Status, e.Title, e.Detail)
+}
+
+// WithHTTPClient sets the client sending the requests to ARC, e.g. a httpclient.Client with retries.
+func WithHTTPClient(doer api.HttpRequestDoer) func(*Client) {
+	return func(c *Client) {
+		c.apiOpts = append(c.apiOpts, api.WithHTTPClient(doer))
+	}
+}
+
+// WithAuthorization sets the value of the Authorization header of all requests, e.g. "Bearer <api key>".
+func WithAuthorization(authorization string) func(*Client) {
+	return func(c *Client) {
+		c.apiOpts = append(c.apiOpts, api.WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", authorization)
+			return nil
+		}))
+	}
+}
+
+// WithPollInterval sets the interval in which the status of a transaction is requested while waiting for it.
+func WithPollInterval(d time.Duration) func(*Client) {
+	return func(c *Client) {
+		c.pollInterval = d
+	}
+}
+
+// WithMaxTimeout sets the number of seconds ARC waits for the requested status before it responds to a submission.
+func WithMaxTimeout(seconds int) func(*Client) {
+	return func(c *Client) {
+		c.maxTimeout = seconds
+	}
+}
+
+// WithCallback sets the endpoint and token to which ARC sends the status updates of the submitted transactions.
+func WithCallback(url, token string) func(*Client) {
+	return func(c *Client) {
+		c.callbackURL = url
+		c.callbackToken = token
+	}
+}
+
+// WithSourceFetcher sets the fetcher of the source transactions of inputs which have none.
+func WithSourceFetcher(fetcher SourceFetcher) func(*Client) {
+	return func(c *Client) {
+		c.sourceFetcher = fetcher
+	}
+}
+
+// New returns a client of the ARC instance at the given URL, e.g. "https://arc.taal.com".
+func New(url string, opts ...func(*Client)) (*Client, error) {
+	c := &Client{
+		pollInterval: pollIntervalDefault,
+		maxTimeout:   maxTimeoutDefault,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	client, err := api.NewClientWithResponses(url, c.apiOpts...)
+	if err != nil {
+		return nil, err
+	}
+	c.client = client
+
+	return c, nil
+}
+
+// Submit submits the transaction in extended format and returns the response of ARC once the transaction reached the
+// wait for status or the max timeout elapsed.
+func (c *Client) Submit(ctx context.Context, tx *sdkTx.Transaction, waitFor Status) (*Response, error) {
+	rawTx, err := c.rawTx(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &api.POSTTransactionParams{
+		XMaxTimeout: &c.maxTimeout,
+	}
+	if waitFor != "" {
+		params.XWaitFor = ptr(string(waitFor))
+	}
+	if c.callbackURL != "" {
+		params.XCallbackUrl = &c.callbackURL
+	}
+	if c.callbackToken != "" {
+		params.XCallbackToken = &c.callbackToken
+	}
+
+	resp, err := c.client.POSTTransactionWithResponse(ctx, params, api.POSTTransactionJSONRequestBody{RawTx: rawTx})
+	if err != nil {
+		return nil, errors.Join(ErrSubmitFailed, err)
+	}
+
+	if resp.JSON200 == nil {
+		return nil, errors.Join(ErrSubmitFailed, toError(resp.StatusCode(), resp.Body))
+	}
+
+	result := resp.JSON200
+	response := &Response{
+		TxID:         result.Txid,
+		TxStatus:     Status(result.TxStatus),
+		ExtraInfo:    deref(result.ExtraInfo),
+		BlockHash:    deref(result.BlockHash),
+		BlockHeight:  deref(result.BlockHeight),
+		MerklePath:   deref(result.MerklePath),
+		CompetingTxs: deref(result.CompetingTxs),
+		Timestamp:    result.Timestamp,
+	}
+
+	return response, outcome(response)
+}
+
+// Status returns the current status of the transaction. It returns ErrTxNotFound if the transaction is unknown to ARC.
+func (c *Client) Status(ctx context.Context, txID string) (*Response, error) {
+	resp, err := c.client.GETTransactionStatusWithResponse(ctx, txID)
+	if err != nil {
+		return nil, errors.Join(ErrGetStatusFailed, err)
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, ErrTxNotFound
+	}
+
+	if resp.JSON200 == nil {
+		return nil, errors.Join(ErrGetStatusFailed, toError(resp.StatusCode(), resp.Body))
+	}
+
+	result := resp.JSON200
+
+	return &Response{
+		TxID:         result.Txid,
+		TxStatus:     Status(result.TxStatus),
+		ExtraInfo:    deref(result.ExtraInfo),
+		BlockHash:    deref(result.BlockHash),
+		BlockHeight:  deref(result.BlockHeight),
+		MerklePath:   deref(result.MerklePath),
+		CompetingTxs: deref(result.CompetingTxs),
+		Timestamp:    result.Timestamp,
+	}, nil
+}
+
+// WaitForStatus polls the status of the transaction until it reached the target status or ctx is done. It returns
+// ErrRejected together with the last response if the transaction was rejected. Transactions with a double spend
+// attempt are still waited for, since one of the competing transactions is going to be mined. If ctx is done, the last
+// received status is returned together with the error of ctx.
+func (c *Client) WaitForStatus(ctx context.Context, txID string, target Status) (*Response, error) {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	var last *Response
+	for {
+		response, err := c.Status(ctx, txID)
+		// the status of a transaction which was just submitted might not be available yet
+		if err != nil && !errors.Is(err, ErrTxNotFound) {
+			return last, err
+		}
+
+		if response != nil {
+			last = response
+			if response.TxStatus.Reached(target) {
+				return response, nil
+			}
+
+			err = outcome(response)
+			if err != nil {
+				return response, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// SubmitAndWait submits the transaction and waits until it reached the target status, e.g. MINED, or ctx is done. ARC
+// is asked to wait for the target status itself if it supports waiting for it, otherwise the status is polled after
+// the submission.
+func (c *Client) SubmitAndWait(ctx context.Context, tx *sdkTx.Transaction, target Status) (*Response, error) {
+	waitFor := target
+	if !waitable(target) {
+		waitFor = StatusSeenOnNetwork
+	}
+
+	response, err := c.Submit(ctx, tx, waitFor)
+	if err != nil {
+		return response, err
+	}
+
+	if response.TxStatus.Reached(target) {
+		return response, nil
+	}
+
+	waited, err := c.WaitForStatus(ctx, response.TxID, target)
+	if waited == nil {
+		return response, err
+	}
+
+	return waited, err
+}
+
+// rawTx returns the transaction in extended format. The source outputs of inputs without source transaction are
+// fetched with the source fetcher if configured, otherwise the transaction is returned in raw format.
+func (c *Client) rawTx(ctx context.Context, tx *sdkTx.Transaction) (string, error) {
+	sources := make(map[string]*sdkTx.Transaction)
+
+	for _, input := range tx.Inputs {
+		if input.SourceTxOutput() != nil {
+			continue
+		}
+
+		if c.sourceFetcher == nil {
+			return tx.Hex(), nil
+		}
+
+		sourceTxID := input.SourceTXID.String()
+		source, found := sources[sourceTxID]
+		if !found {
+			var err error
+			source, err = c.sourceFetcher.Transaction(ctx, sourceTxID)
+			if err != nil {
+				return "", errors.Join(ErrSourceFetchFailed, fmt.Errorf("source transaction %s: %w", sourceTxID, err))
+			}
+			sources[sourceTxID] = source
+		}
+
+		if int(input.SourceTxOutIndex) >= len(source.Outputs) {
+			return "", errors.Join(ErrSourceFetchFailed, fmt.Errorf("source transaction %s has no output %d", sourceTxID, input.SourceTxOutIndex))
+		}
+		input.SourceTransaction = source
+	}
+
+	return tx.EFHex()
+}
+
+// waitable returns whether ARC supports waiting for the status in the X-WaitFor header.
+func waitable(status Status) bool {
+	rank, found := progression[status]
+	return found && rank <= progression[StatusSeenOnNetwork] && status != StatusSeenInOrphanMempool
+}
+
+// outcome returns ErrRejected if the transaction was rejected and can't progress anymore.
+func outcome(response *Response) error {
+	if response.TxStatus != StatusRejected {
+		return nil
+	}
+
+	if response.ExtraInfo == "" {
+		return ErrRejected
+	}
+
+	return errors.Join(ErrRejected, errors.New(response.ExtraInfo))
+}
+
+func toError(statusCode int, body []byte) error {
+	var fields api.ErrorFields
+	err := json.Unmarshal(body, &fields)
+	if err != nil || fields.Status == 0 {
+		return &Error{Status: statusCode, Title: http.StatusText(statusCode), Detail: string(body)}
+	}
+
+	return &Error{
+		Status:    fields.Status,
+		Title:     fields.Title,
+		Detail:    fields.Detail,
+		ExtraInfo: deref(fields.ExtraInfo),
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+
+	return *v
+}
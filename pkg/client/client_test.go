@@ -0,0 +1,356 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/client"
+)
+
+const lockingScript = "76a914e7d8cbeb9ad90543071cf7ed909bb2a2b1e4ab3588ac"
+
+type sourceFetcher map[string]*sdkTx.Transaction
+
+func (s sourceFetcher) Transaction(_ context.Context, txID string) (*sdkTx.Transaction, error) {
+	tx, found := s[txID]
+	if !found {
+		return nil, errors.New("not found")
+	}
+
+	return tx, nil
+}
+
+// testTxs returns a transaction spending the output of its source transaction, which is not attached to the input.
+func testTxs(t *testing.T) (*sdkTx.Transaction, *sdkTx.Transaction) {
+	t.Helper()
+
+	s, err := script.NewFromHex(lockingScript)
+	require.NoError(t, err)
+
+	source := sdkTx.NewTransaction()
+	source.AddOutput(&sdkTx.TransactionOutput{Satoshis: 1000, LockingScript: s})
+
+	tx := sdkTx.NewTransaction()
+	tx.AddInput(&sdkTx.TransactionInput{
+		SourceTXID:       source.TxID(),
+		SourceTxOutIndex: 0,
+		UnlockingScript:  &script.Script{},
+		SequenceNumber:   0xffffffff,
+	})
+	tx.AddOutput(&sdkTx.TransactionOutput{Satoshis: 900, LockingScript: s})
+
+	return source, tx
+}
+
+func writeJSON(t *testing.T, w http.ResponseWriter, status int, body map[string]any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	require.NoError(t, json.NewEncoder(w).Encode(body))
+}
+
+func TestStatus_Reached(t *testing.T) {
+	tt := []struct {
+		name   string
+		status client.Status
+		target client.Status
+
+		expected bool
+	}{
+		{name: "same status", status: client.StatusSeenOnNetwork, target: client.StatusSeenOnNetwork, expected: true},
+		{name: "more advanced status", status: client.StatusMined, target: client.StatusSeenOnNetwork, expected: true},
+		{name: "less advanced status", status: client.StatusStored, target: client.StatusSeenOnNetwork, expected: false},
+		{name: "rejected", status: client.StatusRejected, target: client.StatusStored, expected: false},
+		{name: "double spend attempted", status: client.StatusDoubleSpendAttempted, target: client.StatusStored, expected: false},
+		{name: "expired", status: client.StatusExpired, target: client.StatusStored, expected: false},
+		{name: "waiting for finality", status: client.StatusWaitingForFinality, target: client.StatusStored, expected: true},
+		{name: "target rejected", status: client.StatusMined, target: client.StatusRejected, expected: false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual := tc.status.Reached(tc.target)
+
+			// then
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestClient_Submit(t *testing.T) {
+	source, tx := testTxs(t)
+
+	tt := []struct {
+		name          string
+		sourceFetcher client.SourceFetcher
+		status        int
+		response      map[string]any
+
+		expectedEF        bool
+		expectedTxStatus  client.Status
+		expectedError     error
+		expectedErrorText string
+	}{
+		{
+			name:          "source fetched - extended format",
+			sourceFetcher: sourceFetcher{source.TxID().String(): source},
+			status:        http.StatusOK,
+			response:      map[string]any{"txid": tx.TxID().String(), "txStatus": "SEEN_ON_NETWORK", "status": 200},
+
+			expectedEF:       true,
+			expectedTxStatus: client.StatusSeenOnNetwork,
+		},
+		{
+			name:     "no source fetcher - raw format",
+			status:   http.StatusOK,
+			response: map[string]any{"txid": tx.TxID().String(), "txStatus": "STORED", "status": 200},
+
+			expectedTxStatus: client.StatusStored,
+		},
+		{
+			name:          "source not found",
+			sourceFetcher: sourceFetcher{},
+
+			expectedError: client.ErrSourceFetchFailed,
+		},
+		{
+			name:     "rejected",
+			status:   http.StatusOK,
+			response: map[string]any{"txid": tx.TxID().String(), "txStatus": "REJECTED", "extraInfo": "mempool conflict", "status": 200},
+
+			expectedTxStatus:  client.StatusRejected,
+			expectedError:     client.ErrRejected,
+			expectedErrorText: "mempool conflict",
+		},
+		{
+			name:     "error response",
+			status:   465,
+			response: map[string]any{"type": "https://bitcoin-sv.github.io/arc/#/errors?id=_465", "title": "Fee too low", "detail": "Fee is too low", "status": 465},
+
+			expectedError:     client.ErrSubmitFailed,
+			expectedErrorText: "465 Fee too low: Fee is too low",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var rawTx string
+			var waitFor string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var body map[string]string
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+				rawTx = body["rawTx"]
+				waitFor = r.Header.Get("X-WaitFor")
+
+				writeJSON(t, w, tc.status, tc.response)
+			}))
+			defer server.Close()
+
+			opts := []func(*client.Client){client.WithAuthorization("Bearer token")}
+			if tc.sourceFetcher != nil {
+				opts = append(opts, client.WithSourceFetcher(tc.sourceFetcher))
+			}
+			sut, err := client.New(server.URL, opts...)
+			require.NoError(t, err)
+
+			// when
+			actual, err := sut.Submit(context.Background(), tx.Clone(), client.StatusSeenOnNetwork)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.ErrorContains(t, err, tc.expectedErrorText)
+			} else {
+				require.NoError(t, err)
+			}
+
+			if tc.expectedTxStatus == "" {
+				return
+			}
+
+			require.Equal(t, tc.expectedTxStatus, actual.TxStatus)
+			require.Equal(t, tx.TxID().String(), actual.TxID)
+			require.Equal(t, "SEEN_ON_NETWORK", waitFor)
+
+			if tc.expectedEF {
+				efTx := tx.Clone()
+				efTx.Inputs[0].SourceTransaction = source
+				expected, err := efTx.EFHex()
+				require.NoError(t, err)
+				require.Equal(t, expected, rawTx)
+				return
+			}
+			require.Equal(t, tx.Hex(), rawTx)
+		})
+	}
+}
+
+func TestClient_SubmitAndWait(t *testing.T) {
+	_, tx := testTxs(t)
+	txID := tx.TxID().String()
+
+	tt := []struct {
+		name     string
+		target   client.Status
+		statuses []string
+
+		expectedWaitFor  string
+		expectedTxStatus client.Status
+		expectedPolls    int32
+		expectedError    error
+	}{
+		{
+			name:     "reached on submission",
+			target:   client.StatusSeenOnNetwork,
+			statuses: []string{"SEEN_ON_NETWORK"},
+
+			expectedWaitFor:  "SEEN_ON_NETWORK",
+			expectedTxStatus: client.StatusSeenOnNetwork,
+		},
+		{
+			name:     "mined after polling",
+			target:   client.StatusMined,
+			statuses: []string{"SEEN_ON_NETWORK", "SEEN_ON_NETWORK", "DOUBLE_SPEND_ATTEMPTED", "MINED"},
+
+			expectedWaitFor:  "SEEN_ON_NETWORK",
+			expectedTxStatus: client.StatusMined,
+			expectedPolls:    3,
+		},
+		{
+			name:     "rejected while polling",
+			target:   client.StatusMined,
+			statuses: []string{"SENT_TO_NETWORK", "REJECTED"},
+
+			expectedWaitFor:  "SEEN_ON_NETWORK",
+			expectedTxStatus: client.StatusRejected,
+			expectedPolls:    1,
+			expectedError:    client.ErrRejected,
+		},
+		{
+			name:     "context done",
+			target:   client.StatusMined,
+			statuses: []string{"SEEN_ON_NETWORK"},
+
+			expectedWaitFor:  "SEEN_ON_NETWORK",
+			expectedTxStatus: client.StatusSeenOnNetwork,
+			expectedError:    context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var polls atomic.Int32
+			var waitFor string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					waitFor = r.Header.Get("X-WaitFor")
+					writeJSON(t, w, http.StatusOK, map[string]any{"txid": txID, "txStatus": tc.statuses[0], "status": 200})
+					return
+				}
+
+				require.Equal(t, "/v1/tx/"+txID, r.URL.Path)
+				poll := int(polls.Add(1))
+				if poll >= len(tc.statuses) {
+					poll = len(tc.statuses) - 1
+				}
+				writeJSON(t, w, http.StatusOK, map[string]any{"txid": txID, "txStatus": tc.statuses[poll]})
+			}))
+			defer server.Close()
+
+			sut, err := client.New(server.URL, client.WithPollInterval(10*time.Millisecond))
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			// when
+			actual, err := sut.SubmitAndWait(ctx, tx, tc.target)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expectedWaitFor, waitFor)
+			require.Equal(t, tc.expectedTxStatus, actual.TxStatus)
+			if tc.expectedPolls > 0 {
+				require.Equal(t, tc.expectedPolls, polls.Load())
+			}
+		})
+	}
+}
+
+func TestClient_Status(t *testing.T) {
+	tt := []struct {
+		name     string
+		status   int
+		response map[string]any
+
+		expectedTxStatus client.Status
+		expectedError    error
+	}{
+		{
+			name:     "found",
+			status:   http.StatusOK,
+			response: map[string]any{"txid": "abc", "txStatus": "MINED", "blockHeight": 100},
+
+			expectedTxStatus: client.StatusMined,
+		},
+		{
+			name:     "not found",
+			status:   http.StatusNotFound,
+			response: map[string]any{"title": "Not found", "status": 404},
+
+			expectedError: client.ErrTxNotFound,
+		},
+		{
+			name:     "error",
+			status:   http.StatusInternalServerError,
+			response: map[string]any{"title": "Generic error", "status": 409},
+
+			expectedError: client.ErrGetStatusFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				writeJSON(t, w, tc.status, tc.response)
+			}))
+			defer server.Close()
+
+			sut, err := client.New(server.URL)
+			require.NoError(t, err)
+
+			// when
+			actual, err := sut.Status(context.Background(), "abc")
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTxStatus, actual.TxStatus)
+			require.Equal(t, uint64(100), actual.BlockHeight)
+		})
+	}
+}
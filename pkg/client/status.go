@@ -0,0 +1,58 @@
+package client
+
+// Status is the status of a transaction in ARC.
+type Status string
+
+const (
+	StatusUnknown              Status = "UNKNOWN"
+	StatusQueued               Status = "QUEUED"
+	StatusReceived             Status = "RECEIVED"
+	StatusStored               Status = "STORED"
+	StatusWaitingForFinality   Status = "WAITING_FOR_FINALITY"
+	StatusAnnouncedToNetwork   Status = "ANNOUNCED_TO_NETWORK"
+	StatusRequestedByNetwork   Status = "REQUESTED_BY_NETWORK"
+	StatusSentToNetwork        Status = "SENT_TO_NETWORK"
+	StatusAcceptedByNetwork    Status = "ACCEPTED_BY_NETWORK"
+	StatusSeenInOrphanMempool  Status = "SEEN_IN_ORPHAN_MEMPOOL"
+	StatusSeenOnNetwork        Status = "SEEN_ON_NETWORK"
+	StatusDoubleSpendAttempted Status = "DOUBLE_SPEND_ATTEMPTED"
+	StatusExpired              Status = "EXPIRED"
+	StatusRejected             Status = "REJECTED"
+	StatusMinedInStaleBlock    Status = "MINED_IN_STALE_BLOCK"
+	StatusMined                Status = "MINED"
+)
+
+// progression is the order in which a transaction progresses through the statuses on its way to be mined. Double
+// spend attempts, expiries and rejections are outcomes rather than steps of the progression.
+var progression = map[Status]int{
+	StatusQueued:              1,
+	StatusReceived:            2,
+	StatusStored:              3,
+	StatusWaitingForFinality:  4,
+	StatusAnnouncedToNetwork:  5,
+	StatusRequestedByNetwork:  6,
+	StatusSentToNetwork:       7,
+	StatusAcceptedByNetwork:   8,
+	StatusSeenInOrphanMempool: 9,
+	StatusSeenOnNetwork:       10,
+	StatusMinedInStaleBlock:   11,
+	StatusMined:               12,
+}
+
+// Reached returns whether a transaction in the status has reached the target status, e.g. a mined transaction has
+// reached SEEN_ON_NETWORK. The outcomes DOUBLE_SPEND_ATTEMPTED, EXPIRED and REJECTED are only reached by themselves.
+func (s Status) Reached(target Status) bool {
+	if s == target {
+		return true
+	}
+
+	rank, found := progression[s]
+	targetRank, targetFound := progression[target]
+
+	return found && targetFound && rank >= targetRank
+}
+
+// IsFinal returns whether the status can't change anymore, i.e. the transaction is mined, rejected or expired.
+func (s Status) IsFinal() bool {
+	return s == StatusMined || s == StatusRejected || s == StatusExpired
+}
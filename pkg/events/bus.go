@@ -0,0 +1,182 @@
+package events
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const queueSizeDefault = 1000
+
+var (
+	published = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_events_published_total",
+		Help: "Nr of events published on the event bus by type",
+	}, []string{"type"})
+	dropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_events_dropped_total",
+		Help: "Nr of events dropped by subscriber because its queue was full",
+	}, []string{"subscriber"})
+)
+
+// Collectors returns the collectors of the metrics of the event bus.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{published, dropped}
+}
+
+type subscriber struct {
+	name      string
+	eventType string
+	handle    func(Event)
+	queue     chan Event
+}
+
+// Bus delivers the published events to the subscribers of their type. Each subscriber has its own queue and routine,
+// so that a slow subscriber neither blocks the publishing modules nor the other subscribers. Events which do not fit
+// into the queue of a subscriber are dropped for that subscriber.
+type Bus struct {
+	logger    *slog.Logger
+	queueSize int
+
+	mu          sync.RWMutex
+	subscribers []*subscriber
+	closed      bool
+	waitGroup   sync.WaitGroup
+}
+
+// WithQueueSize sets the number of events which are queued for each subscriber.
+func WithQueueSize(size int) func(*Bus) {
+	return func(b *Bus) {
+		b.queueSize = size
+	}
+}
+
+func New(logger *slog.Logger, opts ...func(*Bus)) *Bus {
+	b := &Bus{
+		logger:    logger.With(slog.String("module", "event-bus")),
+		queueSize: queueSizeDefault,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	if b.queueSize <= 0 {
+		b.queueSize = queueSizeDefault
+	}
+
+	return b
+}
+
+// Subscribe calls the handler with the events of type E in the order in which they were published. The name of the
+// subscriber identifies it in the logs and metrics. The returned function unsubscribes the handler, the events queued
+// until then are still handled.
+func Subscribe[E Event](b *Bus, name string, handler func(E)) (unsubscribe func()) {
+	var zero E
+	return b.subscribe(name, zero.Type(), func(event Event) {
+		typed, ok := event.(E)
+		if ok {
+			handler(typed)
+		}
+	})
+}
+
+// SubscribeAll calls the handler with the events of all types, e.g. to export them.
+func SubscribeAll(b *Bus, name string, handler func(Event)) (unsubscribe func()) {
+	return b.subscribe(name, "", handler)
+}
+
+func (b *Bus) subscribe(name string, eventType string, handle func(Event)) func() {
+	s := &subscriber{
+		name:      name,
+		eventType: eventType,
+		handle:    handle,
+		queue:     make(chan Event, b.queueSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		close(s.queue)
+		return func() {}
+	}
+
+	b.subscribers = append(b.subscribers, s)
+
+	b.waitGroup.Add(1)
+	go func() {
+		defer b.waitGroup.Done()
+		for event := range s.queue {
+			b.handle(s, event)
+		}
+	}()
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		i := slices.Index(b.subscribers, s)
+		if i < 0 {
+			return
+		}
+		b.subscribers = slices.Delete(b.subscribers, i, i+1)
+		close(s.queue)
+	}
+}
+
+func (b *Bus) handle(s *subscriber, event Event) {
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.Error("Subscriber panicked", slog.String("subscriber", s.name), slog.String("type", event.Type()), slog.String("panic", fmt.Sprintf("%v", r)))
+		}
+	}()
+
+	s.handle(event)
+}
+
+// Publish queues the event for the subscribers of its type. It never blocks the caller.
+func (b *Bus) Publish(event Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	if b.closed {
+		return
+	}
+
+	published.WithLabelValues(event.Type()).Inc()
+
+	for _, s := range b.subscribers {
+		if s.eventType != "" && s.eventType != event.Type() {
+			continue
+		}
+
+		select {
+		case s.queue <- event:
+		default:
+			dropped.WithLabelValues(s.name).Inc()
+			b.logger.Warn("Dropping event, queue of subscriber is full", slog.String("subscriber", s.name), slog.String("type", event.Type()))
+		}
+	}
+}
+
+// Shutdown stops accepting events and waits until the subscribers handled the queued events.
+func (b *Bus) Shutdown() {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return
+	}
+
+	b.closed = true
+	for _, s := range b.subscribers {
+		close(s.queue)
+	}
+	b.subscribers = nil
+	b.mu.Unlock()
+
+	b.waitGroup.Wait()
+}
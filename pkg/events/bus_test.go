@@ -0,0 +1,106 @@
+package events_test
+
+import (
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/events"
+)
+
+type received struct {
+	mu     sync.Mutex
+	events []events.Event
+}
+
+func (r *received) add(event events.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *received) get() []events.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]events.Event{}, r.events...)
+}
+
+func TestBus(t *testing.T) {
+	// given
+	sut := events.New(slog.Default())
+
+	var statuses received
+	var all received
+	events.Subscribe(sut, "statuses", func(event events.TxStatusChanged) { statuses.add(event) })
+	events.SubscribeAll(sut, "all", all.add)
+	events.SubscribeAll(sut, "panicking", func(_ events.Event) { panic("failed to handle event") })
+
+	// when
+	sut.Publish(events.TxStatusChanged{Hash: "tx-1", Status: "SEEN_ON_NETWORK"})
+	sut.Publish(events.BlockProcessed{Hash: "block-1", Height: 100})
+	sut.Publish(events.TxStatusChanged{Hash: "tx-1", Status: "MINED", BlockHash: "block-1", BlockHeight: 100})
+	sut.Shutdown()
+	sut.Publish(events.TxStatusChanged{Hash: "tx-2", Status: "STORED"})
+
+	// then
+	require.Equal(t, []events.Event{
+		events.TxStatusChanged{Hash: "tx-1", Status: "SEEN_ON_NETWORK"},
+		events.TxStatusChanged{Hash: "tx-1", Status: "MINED", BlockHash: "block-1", BlockHeight: 100},
+	}, statuses.get())
+	require.Len(t, all.get(), 3)
+	require.Equal(t, events.TypeBlockProcessed, all.get()[1].Type())
+}
+
+func TestBus_Unsubscribe(t *testing.T) {
+	// given
+	sut := events.New(slog.Default())
+	defer sut.Shutdown()
+
+	var failed received
+	unsubscribe := events.Subscribe(sut, "failed-callbacks", func(event events.CallbackFailed) { failed.add(event) })
+
+	// when
+	sut.Publish(events.CallbackFailed{URL: "https://example.com/callback", TxID: "tx-1"})
+	require.Eventually(t, func() bool { return len(failed.get()) == 1 }, time.Second, 5*time.Millisecond)
+	unsubscribe()
+	unsubscribe()
+	sut.Publish(events.CallbackFailed{URL: "https://example.com/callback", TxID: "tx-2"})
+
+	// then
+	time.Sleep(20 * time.Millisecond)
+	require.Len(t, failed.get(), 1)
+}
+
+func TestBus_SlowSubscriber(t *testing.T) {
+	// given
+	sut := events.New(slog.Default(), events.WithQueueSize(1))
+
+	started := make(chan struct{}, 1)
+	block := make(chan struct{})
+	var slow, fast received
+	events.Subscribe(sut, "slow", func(event events.TrackerUnavailable) {
+		started <- struct{}{}
+		<-block
+		slow.add(event)
+	})
+	events.Subscribe(sut, "fast", func(event events.TrackerUnavailable) { fast.add(event) })
+
+	// when
+	for i, url := range []string{"bhs-1", "bhs-2", "bhs-3", "bhs-4"} {
+		sut.Publish(events.TrackerUnavailable{URL: url})
+		if i == 0 {
+			<-started
+		}
+		require.Eventually(t, func() bool { return len(fast.get()) == i+1 }, time.Second, 5*time.Millisecond)
+	}
+	close(block)
+	sut.Shutdown()
+
+	// then
+	require.Len(t, fast.get(), 4)
+	// the slow subscriber handles the first event and the one queued, the others are dropped
+	require.Len(t, slow.get(), 2)
+}
@@ -0,0 +1,70 @@
+// Package events provides the typed domain events of ARC and an in-process bus on which the modules publish them.
+// Optional sinks, e.g. metrics, alerting or exporters, subscribe to the events they are interested in, so that new
+// integrations are added without changes to the publishing modules.
+package events
+
+import "time"
+
+const (
+	TypeTxStatusChanged    = "txStatusChanged"
+	TypeBlockProcessed     = "blockProcessed"
+	TypeCallbackFailed     = "callbackFailed"
+	TypeTrackerUnavailable = "trackerUnavailable"
+)
+
+// Event is an event published on the bus. Type identifies the kind of the event and has to be constant for each
+// event type.
+type Event interface {
+	Type() string
+}
+
+// TxStatusChanged is published by metamorph when the status of a transaction changed. The hashes are hex encoded in
+// the byte order of block explorers.
+type TxStatusChanged struct {
+	Hash         string
+	Status       string
+	Tenant       string
+	BlockHash    string
+	BlockHeight  uint64
+	RejectReason string
+	CompetingTxs []string
+	Timestamp    time.Time
+}
+
+func (TxStatusChanged) Type() string { return TypeTxStatusChanged }
+
+// BlockProcessed is published by blocktx when a block has been processed. Status is the status of the block in the
+// chain, e.g. LONGEST or STALE.
+type BlockProcessed struct {
+	Hash      string
+	Height    uint64
+	Status    string
+	TxCount   uint64
+	Timestamp time.Time
+}
+
+func (BlockProcessed) Type() string { return TypeBlockProcessed }
+
+// CallbackFailed is published by the callbacker when a callback could not be delivered after all retries. The token
+// of the callback is not part of the event.
+type CallbackFailed struct {
+	URL       string
+	TxID      string
+	TxStatus  string
+	Batch     bool
+	Retries   int
+	Timestamp time.Time
+}
+
+func (CallbackFailed) Type() string { return TypeCallbackFailed }
+
+// TrackerUnavailable is published by the API when a block header service, which tracks the chain for the
+// verification of Merkle roots, became unavailable. LastSuccess is zero if no request to the service succeeded yet.
+type TrackerUnavailable struct {
+	URL         string
+	Reason      string
+	LastSuccess time.Time
+	Timestamp   time.Time
+}
+
+func (TrackerUnavailable) Type() string { return TypeTrackerUnavailable }
@@ -0,0 +1,210 @@
+// Package httpclient provides the HTTP client used for all outbound HTTP calls, e.g. to block header services, callback
+// endpoints and ARC itself. It adds timeouts, retries with an exponential backoff, tracing, metrics and the injection
+// of authorization headers to the standard library client.
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/bitcoin-sv/arc/pkg/tracing"
+)
+
+const (
+	retryBackoffDefault    = 100 * time.Millisecond
+	maxRetryBackoffDefault = 5 * time.Second
+
+	authorizationHeader = "Authorization"
+	codeError           = "error"
+)
+
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_http_client_requests_total",
+		Help: "Nr of outbound HTTP requests by client, method and status code, code error if no response was received",
+	}, []string{"client", "method", "code"})
+	requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "arc_http_client_request_duration_seconds",
+		Help:    "Duration of outbound HTTP requests including their retries by client",
+		Buckets: prometheus.ExponentialBuckets(0.005, 4, 9),
+	}, []string{"client"})
+	retries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "arc_http_client_retries_total",
+		Help: "Nr of retried outbound HTTP requests by client",
+	}, []string{"client"})
+)
+
+// Collectors returns the collectors of the metrics of all clients.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{requests, requestDuration, retries}
+}
+
+// Doer sends HTTP requests. It is implemented by Client and by http.Client.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client is an HTTP client which retries requests failing with a network error or with a status code indicating a
+// temporary failure of the server, i.e. 429, 502, 503 or 504. The name of the client identifies it in the metrics.
+type Client struct {
+	client            *http.Client
+	name              string
+	maxRetries        int
+	retryBackoff      time.Duration
+	maxRetryBackoff   time.Duration
+	authorization     string
+	tracingEnabled    bool
+	tracingAttributes []attribute.KeyValue
+}
+
+// WithTimeout sets the timeout of each attempt of a request. Without timeout the attempts are only limited by the
+// context of the request.
+func WithTimeout(d time.Duration) func(*Client) {
+	return func(c *Client) {
+		c.client.Timeout = d
+	}
+}
+
+// WithRetries sets the number of retries of failed requests and the backoff before the first retry, which doubles
+// with every further retry up to 5 seconds.
+func WithRetries(maxRetries int, backoff time.Duration) func(*Client) {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.retryBackoff = backoff
+	}
+}
+
+// WithAuthorization sets the value of the Authorization header of all requests which do not set it themselves.
+func WithAuthorization(authorization string) func(*Client) {
+	return func(c *Client) {
+		c.authorization = authorization
+	}
+}
+
+// WithBearerToken sets the bearer token of all requests which do not set the Authorization header themselves.
+func WithBearerToken(token string) func(*Client) {
+	return func(c *Client) {
+		if token != "" {
+			c.authorization = "Bearer " + token
+		}
+	}
+}
+
+// WithTransport sets the transport of the client, e.g. to use a custom TLS configuration.
+func WithTransport(transport http.RoundTripper) func(*Client) {
+	return func(c *Client) {
+		c.client.Transport = transport
+	}
+}
+
+func WithTracer(attr ...attribute.KeyValue) func(*Client) {
+	return func(c *Client) {
+		c.tracingEnabled = true
+		if len(attr) > 0 {
+			c.tracingAttributes = append(c.tracingAttributes, attr...)
+		}
+		_, file, _, ok := runtime.Caller(1)
+		if ok {
+			c.tracingAttributes = append(c.tracingAttributes, attribute.String("file", file))
+		}
+	}
+}
+
+func New(name string, opts ...func(*Client)) *Client {
+	c := &Client{
+		client:          &http.Client{},
+		name:            name,
+		retryBackoff:    retryBackoffDefault,
+		maxRetryBackoff: maxRetryBackoffDefault,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Do sends the request and retries it if it failed temporarily. A request with a body is only retried if the body can
+// be replayed, which is the case for requests created with a body of type bytes.Buffer, bytes.Reader or strings.Reader.
+// Retries stop once the context of the request is done.
+func (c *Client) Do(req *http.Request) (resp *http.Response, err error) {
+	ctx, span := tracing.StartTracing(req.Context(), "HTTP "+req.Method, c.tracingEnabled, append(c.tracingAttributes, attribute.String("url", req.URL.Redacted()))...)
+	defer func() {
+		tracing.EndTracing(span, err)
+	}()
+
+	req = req.WithContext(ctx)
+	if c.authorization != "" && req.Header.Get(authorizationHeader) == "" {
+		req.Header.Set(authorizationHeader, c.authorization)
+	}
+	if c.tracingEnabled {
+		tracing.InjectTraceContext(ctx, req.Header)
+	}
+
+	start := time.Now()
+	defer func() {
+		requestDuration.WithLabelValues(c.name).Observe(time.Since(start).Seconds())
+	}()
+
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err = c.client.Do(req)
+		code := codeError
+		if err == nil {
+			code = strconv.Itoa(resp.StatusCode)
+		}
+		requests.WithLabelValues(c.name, req.Method, code).Inc()
+
+		if attempt >= c.maxRetries || ctx.Err() != nil || !isRetryable(resp, err) || !isReplayable(req) {
+			return resp, err
+		}
+
+		// the response of the failed attempt is discarded
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errors.Join(ctx.Err(), fmt.Errorf("retry %d of %s %s", attempt+1, req.Method, req.URL.Redacted()))
+		case <-time.After(backoff):
+		}
+
+		retries.WithLabelValues(c.name).Inc()
+		backoff = min(2*backoff, c.maxRetryBackoff)
+	}
+}
+
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
@@ -0,0 +1,129 @@
+package httpclient_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
+)
+
+func TestClient_Do(t *testing.T) {
+	tt := []struct {
+		name          string
+		statusCodes   []int
+		maxRetries    int
+		authorization string
+
+		expectedStatusCode    int
+		expectedAttempts      int32
+		expectedAuthorization string
+	}{
+		{
+			name:        "success",
+			statusCodes: []int{http.StatusOK},
+			maxRetries:  2,
+
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   1,
+		},
+		{
+			name:        "retried until success",
+			statusCodes: []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK},
+			maxRetries:  2,
+
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   3,
+		},
+		{
+			name:        "retries exhausted",
+			statusCodes: []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			maxRetries:  1,
+
+			expectedStatusCode: http.StatusBadGateway,
+			expectedAttempts:   2,
+		},
+		{
+			name:        "client error not retried",
+			statusCodes: []int{http.StatusBadRequest, http.StatusOK},
+			maxRetries:  2,
+
+			expectedStatusCode: http.StatusBadRequest,
+			expectedAttempts:   1,
+		},
+		{
+			name:          "authorization injected",
+			statusCodes:   []int{http.StatusOK},
+			authorization: "token",
+
+			expectedStatusCode:    http.StatusOK,
+			expectedAttempts:      1,
+			expectedAuthorization: "Bearer token",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				require.Equal(t, "payload", string(body))
+				require.Equal(t, tc.expectedAuthorization, r.Header.Get("Authorization"))
+
+				w.WriteHeader(tc.statusCodes[attempt-1])
+			}))
+			defer server.Close()
+
+			sut := httpclient.New("test",
+				httpclient.WithRetries(tc.maxRetries, time.Millisecond),
+				httpclient.WithTimeout(time.Second),
+				httpclient.WithBearerToken(tc.authorization),
+			)
+
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, bytes.NewBufferString("payload"))
+			require.NoError(t, err)
+
+			// when
+			resp, err := sut.Do(req)
+
+			// then
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			require.Equal(t, tc.expectedStatusCode, resp.StatusCode)
+			require.Equal(t, tc.expectedAttempts, attempts.Load())
+		})
+	}
+}
+
+func TestClient_Do_ContextDone(t *testing.T) {
+	// given
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sut := httpclient.New("test", httpclient.WithRetries(10, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	// when
+	_, err = sut.Do(req)
+
+	// then
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,376 @@
+package keyset
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	primitives "github.com/bsv-blockchain/go-sdk/primitives/ec"
+)
+
+const (
+	awsKMSTimeoutDefault    = 10 * time.Second
+	awsKMSService           = "kms"
+	awsKMSContentType       = "application/x-amz-json-1.1"
+	awsKMSSigningAlgorithm  = "ECDSA_SHA_256"
+	awsKMSKeySpecSecp256k1  = "ECC_SECG_P256K1"
+	awsSigV4Algorithm       = "AWS4-HMAC-SHA256"
+	awsSigV4DateFormat      = "20060102"
+	awsSigV4TimestampFormat = "20060102T150405Z"
+	awsKMSMaxResponseSize   = 1 << 20
+)
+
+var (
+	ErrAWSKMSSignerNoKeyID            = errors.New("no key id of aws kms signer given")
+	ErrAWSKMSSignerNoRegion           = errors.New("no region of aws kms signer given")
+	ErrAWSKMSSignerNoCredentials      = errors.New("no aws credentials given")
+	ErrAWSKMSSignerRequestFailed      = errors.New("aws kms request failed")
+	ErrAWSKMSSignerInvalidPublicKey   = errors.New("invalid public key returned by aws kms")
+	ErrAWSKMSSignerInvalidSignature   = errors.New("invalid signature returned by aws kms")
+	ErrAWSKMSSignerUnsupportedKeySpec = errors.New("unsupported key spec of aws kms key, expected " + awsKMSKeySpecSecp256k1)
+)
+
+// AWSKMSCredentials are the credentials with which the requests to AWS KMS are signed.
+type AWSKMSCredentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+// AWSKMSSigner signs with a key of spec ECC_SECG_P256K1 in AWS KMS by calling the Sign action of the KMS API, so that
+// the private key never leaves the KMS. The requests are signed with signature version 4.
+type AWSKMSSigner struct {
+	keyID       string
+	region      string
+	endpoint    string
+	credentials AWSKMSCredentials
+	client      *http.Client
+	timeout     time.Duration
+	now         func() time.Time
+	publicKey   *primitives.PublicKey
+}
+
+// WithAWSKMSEndpoint sets the URL of the KMS API, by default https://kms.<region>.amazonaws.com.
+func WithAWSKMSEndpoint(endpoint string) func(*AWSKMSSigner) {
+	return func(s *AWSKMSSigner) {
+		s.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithAWSKMSCredentials sets the credentials, by default they are read from the environment variables
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN.
+func WithAWSKMSCredentials(credentials AWSKMSCredentials) func(*AWSKMSSigner) {
+	return func(s *AWSKMSSigner) {
+		s.credentials = credentials
+	}
+}
+
+func WithAWSKMSTimeout(timeout time.Duration) func(*AWSKMSSigner) {
+	return func(s *AWSKMSSigner) {
+		s.timeout = timeout
+	}
+}
+
+func WithAWSKMSHTTPClient(client *http.Client) func(*AWSKMSSigner) {
+	return func(s *AWSKMSSigner) {
+		s.client = client
+	}
+}
+
+func WithAWSKMSNow(now func() time.Time) func(*AWSKMSSigner) {
+	return func(s *AWSKMSSigner) {
+		s.now = now
+	}
+}
+
+// NewAWSKMSSigner creates a signer for the key with the id, ARN or alias, e.g. alias/broadcaster. If region is empty
+// the region is read from the environment variable AWS_REGION. The public key is fetched from the KMS.
+func NewAWSKMSSigner(keyID string, region string, opts ...func(*AWSKMSSigner)) (*AWSKMSSigner, error) {
+	if keyID == "" {
+		return nil, ErrAWSKMSSignerNoKeyID
+	}
+
+	if region == "" {
+		region = os.Getenv("AWS_REGION")
+	}
+
+	if region == "" {
+		return nil, ErrAWSKMSSignerNoRegion
+	}
+
+	s := &AWSKMSSigner{
+		keyID:    keyID,
+		region:   region,
+		endpoint: fmt.Sprintf("https://kms.%s.amazonaws.com", region),
+		credentials: AWSKMSCredentials{
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+			SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+		},
+		client:  http.DefaultClient,
+		timeout: awsKMSTimeoutDefault,
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if s.credentials.AccessKeyID == "" || s.credentials.SecretAccessKey == "" {
+		return nil, ErrAWSKMSSignerNoCredentials
+	}
+
+	publicKey, err := s.getPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	s.publicKey = publicKey
+
+	return s, nil
+}
+
+func (s *AWSKMSSigner) PubKey() *primitives.PublicKey {
+	return s.publicKey
+}
+
+// Sign signs the hash as digest with the KMS key and verifies the returned signature against the public key.
+func (s *AWSKMSSigner) Sign(hash []byte) (*primitives.Signature, error) {
+	request := struct {
+		KeyID            string `json:"KeyId"`
+		Message          []byte `json:"Message"`
+		MessageType      string `json:"MessageType"`
+		SigningAlgorithm string `json:"SigningAlgorithm"`
+	}{
+		KeyID:            s.keyID,
+		Message:          hash,
+		MessageType:      "DIGEST",
+		SigningAlgorithm: awsKMSSigningAlgorithm,
+	}
+
+	var response struct {
+		Signature []byte `json:"Signature"`
+	}
+
+	err := s.call("Sign", request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := primitives.ParseDERSignature(response.Signature)
+	if err != nil {
+		return nil, errors.Join(ErrAWSKMSSignerInvalidSignature, err)
+	}
+
+	if !sig.Verify(hash, s.publicKey) {
+		return nil, errors.Join(ErrAWSKMSSignerInvalidSignature, errors.New("signature does not match public key"))
+	}
+
+	return sig, nil
+}
+
+// subjectPublicKeyInfo is the DER encoded public key returned by GetPublicKey.
+type subjectPublicKeyInfo struct {
+	Algorithm struct {
+		Algorithm  asn1.ObjectIdentifier
+		Parameters asn1.RawValue `asn1:"optional"`
+	}
+	PublicKey asn1.BitString
+}
+
+func (s *AWSKMSSigner) getPublicKey() (*primitives.PublicKey, error) {
+	request := struct {
+		KeyID string `json:"KeyId"`
+	}{
+		KeyID: s.keyID,
+	}
+
+	var response struct {
+		KeySpec   string `json:"KeySpec"`
+		PublicKey []byte `json:"PublicKey"`
+	}
+
+	err := s.call("GetPublicKey", request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.KeySpec != awsKMSKeySpecSecp256k1 {
+		return nil, errors.Join(ErrAWSKMSSignerUnsupportedKeySpec, fmt.Errorf("key spec %s", response.KeySpec))
+	}
+
+	var info subjectPublicKeyInfo
+	_, err = asn1.Unmarshal(response.PublicKey, &info)
+	if err != nil {
+		return nil, errors.Join(ErrAWSKMSSignerInvalidPublicKey, err)
+	}
+
+	publicKey, err := primitives.ParsePubKey(info.PublicKey.RightAlign())
+	if err != nil {
+		return nil, errors.Join(ErrAWSKMSSignerInvalidPublicKey, err)
+	}
+
+	return publicKey, nil
+}
+
+// call calls the action of the KMS API with the request and decodes the response into the response value.
+func (s *AWSKMSSigner) call(action string, request any, response any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return errors.Join(ErrAWSKMSSignerRequestFailed, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint+"/", bytes.NewReader(payload))
+	if err != nil {
+		return errors.Join(ErrAWSKMSSignerRequestFailed, err)
+	}
+
+	req.Header.Set("Content-Type", awsKMSContentType)
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+
+	signAWSRequest(req, payload, s.credentials, s.region, awsKMSService, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Join(ErrAWSKMSSignerRequestFailed, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, awsKMSMaxResponseSize))
+	if err != nil {
+		return errors.Join(ErrAWSKMSSignerRequestFailed, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var kmsErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(body, &kmsErr)
+
+		return errors.Join(ErrAWSKMSSignerRequestFailed, fmt.Errorf("%s: status %d %s: %s", action, resp.StatusCode, kmsErr.Type, kmsErr.Message))
+	}
+
+	err = json.Unmarshal(body, response)
+	if err != nil {
+		return errors.Join(ErrAWSKMSSignerRequestFailed, err)
+	}
+
+	return nil
+}
+
+// signAWSRequest adds the headers X-Amz-Date, X-Amz-Security-Token and Authorization of signature version 4 to the
+// request. All headers set on the request and the host are signed.
+func signAWSRequest(req *http.Request, payload []byte, credentials AWSKMSCredentials, region string, service string, now time.Time) {
+	now = now.UTC()
+	timestamp := now.Format(awsSigV4TimestampFormat)
+	scope := strings.Join([]string{now.Format(awsSigV4DateFormat), region, service, "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", timestamp)
+	if credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", credentials.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.Join(values, ",")
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{awsSigV4Algorithm, timestamp, scope, hex.EncodeToString(canonicalRequestHash[:])}, "\n")
+
+	signature := hex.EncodeToString(hmacSHA256(awsSigningKey(credentials.SecretAccessKey, now, region, service), stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", awsSigV4Algorithm, credentials.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(req *http.Request) string {
+	query := req.URL.Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, awsURIEncode(key)+"="+awsURIEncode(value))
+		}
+	}
+
+	return strings.Join(params, "&")
+}
+
+// awsURIEncode encodes all characters except the unreserved characters A-Z, a-z, 0-9, '-', '.', '_' and '~'.
+func awsURIEncode(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
+
+func awsSigningKey(secretAccessKey string, now time.Time, region string, service string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), now.Format(awsSigV4DateFormat))
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+
+	return hmacSHA256(key, "aws4_request")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
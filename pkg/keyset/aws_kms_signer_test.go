@@ -0,0 +1,206 @@
+package keyset
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	primitives "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAWSRequest(t *testing.T) {
+	// example of the signature version 4 documentation of AWS
+	credentials := AWSKMSCredentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+
+	// given
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	// when
+	signAWSRequest(req, nil, credentials, "us-east-1", "iam", now)
+
+	// then
+	assert.Equal(t, "c4afb1cc5771d871763a393e44b703571b55cc28424d1a5e86da6ed3c154a4b9", hex.EncodeToString(awsSigningKey(credentials.SecretAccessKey, now, "us-east-1", "iam")))
+	assert.Equal(t, "20150830T123600Z", req.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7", req.Header.Get("Authorization"))
+}
+
+func TestAWSKMSSigner_Sign(t *testing.T) {
+	privateKey, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+
+	secp256k1, err := asn1.Marshal(asn1.ObjectIdentifier{1, 3, 132, 0, 10})
+	require.NoError(t, err)
+
+	var info subjectPublicKeyInfo
+	info.Algorithm.Algorithm = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	info.Algorithm.Parameters = asn1.RawValue{FullBytes: secp256k1}
+	info.PublicKey = asn1.BitString{Bytes: privateKey.PubKey().Uncompressed(), BitLength: 8 * len(privateKey.PubKey().Uncompressed())}
+	publicKeyDER, err := asn1.Marshal(info)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("transaction"))
+	now := time.Date(2026, 10, 14, 12, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name       string
+		keySpec    string
+		signingKey *primitives.PrivateKey
+		signStatus int
+
+		expectedNewError  error
+		expectedSignError error
+	}{
+		{
+			name:       "success",
+			keySpec:    awsKMSKeySpecSecp256k1,
+			signingKey: privateKey,
+			signStatus: http.StatusOK,
+		},
+		{
+			name:       "signature of other key",
+			keySpec:    awsKMSKeySpecSecp256k1,
+			signingKey: otherKey,
+			signStatus: http.StatusOK,
+
+			expectedSignError: ErrAWSKMSSignerInvalidSignature,
+		},
+		{
+			name:       "sign request rejected",
+			keySpec:    awsKMSKeySpecSecp256k1,
+			signingKey: privateKey,
+			signStatus: http.StatusBadRequest,
+
+			expectedSignError: ErrAWSKMSSignerRequestFailed,
+		},
+		{
+			name:    "unsupported key spec",
+			keySpec: "ECC_NIST_P256",
+
+			expectedNewError: ErrAWSKMSSignerUnsupportedKeySpec,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, awsKMSContentType, r.Header.Get("Content-Type"))
+				assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/20261014/eu-central-1/kms/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature="))
+				assert.Equal(t, "token", r.Header.Get("X-Amz-Security-Token"))
+
+				var request struct {
+					KeyID            string `json:"KeyId"`
+					Message          []byte `json:"Message"`
+					MessageType      string `json:"MessageType"`
+					SigningAlgorithm string `json:"SigningAlgorithm"`
+				}
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+				assert.Equal(t, "alias/broadcaster", request.KeyID)
+
+				switch r.Header.Get("X-Amz-Target") {
+				case "TrentService.GetPublicKey":
+					_ = json.NewEncoder(w).Encode(map[string]any{"KeySpec": tc.keySpec, "PublicKey": publicKeyDER})
+				case "TrentService.Sign":
+					assert.Equal(t, "DIGEST", request.MessageType)
+					assert.Equal(t, awsKMSSigningAlgorithm, request.SigningAlgorithm)
+
+					if tc.signStatus != http.StatusOK {
+						w.WriteHeader(tc.signStatus)
+						_ = json.NewEncoder(w).Encode(map[string]any{"__type": "KMSInvalidStateException", "message": "key is disabled"})
+						return
+					}
+
+					sig, err := tc.signingKey.Sign(request.Message)
+					assert.NoError(t, err)
+					_ = json.NewEncoder(w).Encode(map[string]any{"KeyId": request.KeyID, "Signature": sig.Serialize(), "SigningAlgorithm": request.SigningAlgorithm})
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+				}
+			}))
+			defer server.Close()
+
+			// when
+			sut, err := NewAWSKMSSigner("alias/broadcaster", "eu-central-1",
+				WithAWSKMSEndpoint(server.URL),
+				WithAWSKMSCredentials(AWSKMSCredentials{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token"}),
+				WithAWSKMSNow(func() time.Time { return now }),
+			)
+
+			// then
+			if tc.expectedNewError != nil {
+				require.ErrorIs(t, err, tc.expectedNewError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, privateKey.PubKey().Compressed(), sut.PubKey().Compressed())
+
+			// when
+			actual, err := sut.Sign(hash[:])
+
+			// then
+			if tc.expectedSignError != nil {
+				require.ErrorIs(t, err, tc.expectedSignError)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, actual.Verify(hash[:], privateKey.PubKey()))
+		})
+	}
+}
+
+func TestNewAWSKMSSigner(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+
+	tt := []struct {
+		name   string
+		keyID  string
+		region string
+
+		expectedError error
+	}{
+		{
+			name:   "no key id",
+			region: "eu-central-1",
+
+			expectedError: ErrAWSKMSSignerNoKeyID,
+		},
+		{
+			name:  "no region",
+			keyID: "alias/broadcaster",
+
+			expectedError: ErrAWSKMSSignerNoRegion,
+		},
+		{
+			name:   "no credentials",
+			keyID:  "alias/broadcaster",
+			region: "eu-central-1",
+
+			expectedError: ErrAWSKMSSignerNoCredentials,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			_, err := NewAWSKMSSigner(tc.keyID, tc.region)
+
+			// then
+			require.ErrorIs(t, err, tc.expectedError)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package keyset
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os/exec"
+	"strings"
+	"time"
+
+	primitives "github.com/bsv-blockchain/go-sdk/primitives/ec"
+)
+
+const (
+	commandSignerTimeoutDefault = 10 * time.Second
+	digestHexPlaceholder        = "{digestHex}"
+	digestBase64Placeholder     = "{digestBase64}"
+	rawSignatureLength          = 64
+)
+
+var (
+	ErrCommandSignerInvalidPublicKey = errors.New("invalid public key of command signer")
+	ErrCommandSignerNoCommand        = errors.New("no sign command given")
+	ErrCommandSignerFailed           = errors.New("sign command failed")
+	ErrCommandSignerInvalidSignature = errors.New("invalid signature returned by sign command")
+)
+
+// CommandSigner signs with an external command, so that the private key never leaves the KMS or HSM, e.g. with
+// `aws kms sign --message-type DIGEST`, `gcloud kms asymmetric-sign` or `pkcs11-tool --sign`. The signature hash is
+// passed to the command in the arguments with the placeholders `{digestHex}` and `{digestBase64}` and as raw bytes on
+// stdin. The command has to print the signature in DER format or as 64 bytes `r || s`, encoded in hex or base64.
+type CommandSigner struct {
+	publicKey *primitives.PublicKey
+	command   []string
+	timeout   time.Duration
+}
+
+func WithCommandSignerTimeout(timeout time.Duration) func(*CommandSigner) {
+	return func(s *CommandSigner) {
+		s.timeout = timeout
+	}
+}
+
+// NewCommandSigner creates a signer for the key with the given compressed or uncompressed public key in hex.
+func NewCommandSigner(publicKeyHex string, command []string, opts ...func(*CommandSigner)) (*CommandSigner, error) {
+	if len(command) == 0 {
+		return nil, ErrCommandSignerNoCommand
+	}
+
+	publicKey, err := primitives.PublicKeyFromString(publicKeyHex)
+	if err != nil {
+		return nil, errors.Join(ErrCommandSignerInvalidPublicKey, err)
+	}
+
+	s := &CommandSigner{
+		publicKey: publicKey,
+		command:   command,
+		timeout:   commandSignerTimeoutDefault,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s, nil
+}
+
+func (s *CommandSigner) PubKey() *primitives.PublicKey {
+	return s.publicKey
+}
+
+// Sign runs the sign command for the hash and verifies the returned signature against the public key.
+func (s *CommandSigner) Sign(hash []byte) (*primitives.Signature, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	replacer := strings.NewReplacer(
+		digestHexPlaceholder, hex.EncodeToString(hash),
+		digestBase64Placeholder, base64.StdEncoding.EncodeToString(hash),
+	)
+
+	args := make([]string, len(s.command)-1)
+	for i, arg := range s.command[1:] {
+		args[i] = replacer.Replace(arg)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s.command[0], args...)
+	cmd.Stdin = bytes.NewReader(hash)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		return nil, errors.Join(ErrCommandSignerFailed, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String())))
+	}
+
+	sig, err := parseCommandSignature(strings.TrimSpace(stdout.String()))
+	if err != nil {
+		return nil, errors.Join(ErrCommandSignerInvalidSignature, err)
+	}
+
+	if !sig.Verify(hash, s.publicKey) {
+		return nil, errors.Join(ErrCommandSignerInvalidSignature, errors.New("signature does not match public key"))
+	}
+
+	return sig, nil
+}
+
+func parseCommandSignature(output string) (*primitives.Signature, error) {
+	sigBytes, err := hex.DecodeString(output)
+	if err != nil {
+		sigBytes, err = base64.StdEncoding.DecodeString(output)
+		if err != nil {
+			return nil, errors.New("signature is neither hex nor base64 encoded")
+		}
+	}
+
+	if len(sigBytes) == rawSignatureLength {
+		return &primitives.Signature{
+			R: new(big.Int).SetBytes(sigBytes[:32]),
+			S: new(big.Int).SetBytes(sigBytes[32:]),
+		}, nil
+	}
+
+	return primitives.ParseSignature(sigBytes)
+}
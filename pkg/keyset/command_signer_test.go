@@ -0,0 +1,106 @@
+package keyset
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+
+	primitives "github.com/bsv-blockchain/go-sdk/primitives/ec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandSigner_Sign(t *testing.T) {
+	privateKey, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := primitives.NewPrivateKey()
+	require.NoError(t, err)
+
+	hash := sha256.Sum256([]byte("transaction"))
+
+	sig, err := privateKey.Sign(hash[:])
+	require.NoError(t, err)
+	otherSig, err := otherKey.Sign(hash[:])
+	require.NoError(t, err)
+
+	rawSig := append(sig.R.FillBytes(make([]byte, 32)), sig.S.FillBytes(make([]byte, 32))...)
+
+	tt := []struct {
+		name    string
+		command []string
+
+		expectedError error
+	}{
+		{
+			name:    "DER signature in hex",
+			command: []string{"echo", hex.EncodeToString(sig.Serialize())},
+		},
+		{
+			name:    "raw signature in base64",
+			command: []string{"echo", base64.StdEncoding.EncodeToString(rawSig)},
+		},
+		{
+			name: "digest as argument",
+			command: []string{"sh", "-c", `test "$0" = "` + hex.EncodeToString(hash[:]) + `" && echo "$1"`,
+				digestHexPlaceholder, hex.EncodeToString(sig.Serialize())},
+		},
+		{
+			name:    "signature of other key",
+			command: []string{"echo", hex.EncodeToString(otherSig.Serialize())},
+
+			expectedError: ErrCommandSignerInvalidSignature,
+		},
+		{
+			name:    "invalid output",
+			command: []string{"echo", "not a signature"},
+
+			expectedError: ErrCommandSignerInvalidSignature,
+		},
+		{
+			name:    "command fails",
+			command: []string{"false"},
+
+			expectedError: ErrCommandSignerFailed,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut, err := NewCommandSigner(hex.EncodeToString(privateKey.PubKey().Compressed()), tc.command)
+			require.NoError(t, err)
+
+			// when
+			actual, err := sut.Sign(hash[:])
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.True(t, actual.Verify(hash[:], privateKey.PubKey()))
+			assert.Equal(t, privateKey.PubKey(), sut.PubKey())
+		})
+	}
+}
+
+func TestNewFromSigner(t *testing.T) {
+	// given
+	key, err := NewFromExtendedKeyStr("xprv9s21ZrQH143K3uWZ5zfEG9v1JimHetdddkbnFAVKx2ELSws3T51wHoQuhfxsXTF4XGREBt7fVVbJiVpXJzrzb3dUVGsMsve5HaMGma4r6SG", "0/0")
+	require.NoError(t, err)
+
+	signer, err := NewCommandSigner(hex.EncodeToString(key.PublicKey.Compressed()), []string{"false"})
+	require.NoError(t, err)
+
+	// when
+	actual, err := NewFromSigner(signer)
+
+	// then
+	require.NoError(t, err)
+	assert.Nil(t, actual.PrivateKey)
+	assert.Equal(t, key.Script, actual.Script)
+	assert.Equal(t, key.Address(false), actual.Address(false))
+}
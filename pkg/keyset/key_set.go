@@ -13,6 +13,13 @@ import (
 	"github.com/bsv-blockchain/go-sdk/transaction/template/p2pkh"
 )
 
+// Signer signs the signature hashes of transaction inputs. A private key is a signer, other signers keep the private
+// key outside the process, e.g. in a KMS or HSM.
+type Signer interface {
+	PubKey() *primitives.PublicKey
+	Sign(hash []byte) (*primitives.Signature, error)
+}
+
 type KeySet struct {
 	master        *bip32.ExtendedKey
 	Path          string
@@ -20,10 +27,11 @@ type KeySet struct {
 	PublicKey     *primitives.PublicKey
 	PublicKeyHash []byte
 	Script        *script.Script
+	Signer        Signer
 }
 
 func (k *KeySet) Address(mainnet bool) string {
-	addr, err := script.NewAddressFromPublicKey(k.PrivateKey.PubKey(), mainnet)
+	addr, err := script.NewAddressFromPublicKey(k.PublicKey, mainnet)
 	if err != nil {
 		panic(err)
 	}
@@ -72,7 +80,22 @@ func NewFromExtendedKey(extendedKey *bip32.ExtendedKey, derivationPath string) (
 		return nil, err
 	}
 
-	publicKey := privateKey.PubKey()
+	ks, err := NewFromSigner(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ks.master = master
+	ks.Path = derivationPath
+	ks.PrivateKey = privateKey
+
+	return ks, nil
+}
+
+// NewFromSigner creates a key set for the public key of the signer. The key set has no private key, the inputs are
+// signed by the signer.
+func NewFromSigner(signer Signer) (*KeySet, error) {
+	publicKey := signer.PubKey()
 
 	address, err := script.NewAddressFromPublicKey(publicKey, true)
 	if err != nil {
@@ -84,12 +107,10 @@ func NewFromExtendedKey(extendedKey *bip32.ExtendedKey, derivationPath string) (
 	}
 
 	return &KeySet{
-		master:        master,
-		Path:          derivationPath,
-		PrivateKey:    privateKey,
 		PublicKey:     publicKey,
 		PublicKeyHash: publicKey.Compressed(),
 		Script:        p2pkhScript,
+		Signer:        signer,
 	}, nil
 }
 
@@ -29,14 +29,11 @@ type NatsConnection interface {
 
 func WithTracer(attr ...attribute.KeyValue) func(p *Client) {
 	return func(p *Client) {
-		p.tracingEnabled = true
-		if len(attr) > 0 {
-			p.tracingAttributes = append(p.tracingAttributes, attr...)
-		}
 		_, file, _, ok := runtime.Caller(1)
 		if ok {
-			p.tracingAttributes = append(p.tracingAttributes, attribute.String("file", file))
+			attr = append(attr, attribute.String("file", file))
 		}
+		p.tracer = tracing.NewMessageTracer("nats", true, attr...)
 	}
 }
 
@@ -44,8 +41,7 @@ type Client struct {
 	nc     NatsConnection
 	logger *slog.Logger
 
-	tracingEnabled    bool
-	tracingAttributes []attribute.KeyValue
+	tracer tracing.MessageTracer
 }
 
 func WithLogger(logger *slog.Logger) func(p *Client) {
@@ -81,26 +77,18 @@ func (c Client) Shutdown() {
 	}
 }
 
-func (c Client) Publish(ctx context.Context, topic string, data []byte) (err error) {
-	_, span := tracing.StartTracing(ctx, "Publish", c.tracingEnabled, c.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
-	err = c.nc.Publish(topic, data)
-	if err != nil {
-		return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
-	}
+func (c Client) Publish(ctx context.Context, topic string, data []byte) error {
+	return c.tracer.Publish(ctx, "Publish", topic, nil, func(_ context.Context) error {
+		err := c.nc.Publish(topic, data)
+		if err != nil {
+			return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
+		}
 
-	return nil
+		return nil
+	})
 }
 
-func (c Client) PublishMarshal(ctx context.Context, topic string, m proto.Message) (err error) {
-	ctx, span := tracing.StartTracing(ctx, "PublishMarshal", c.tracingEnabled, c.tracingAttributes...)
-	defer func() {
-		tracing.EndTracing(span, err)
-	}()
-
+func (c Client) PublishMarshal(ctx context.Context, topic string, m proto.Message) error {
 	data, err := proto.Marshal(m)
 	if err != nil {
 		return err
@@ -13,6 +13,9 @@ import (
 	"github.com/ory/dockertest/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/bitcoin-sv/arc/pkg/message_queue/nats/client/nats_jetstream"
@@ -78,7 +81,7 @@ func TestPublish(t *testing.T) {
 	}
 
 	consume := func(cl *nats_jetstream.Client, topic string, messageChan chan *test_api.TestMessage) {
-		err = cl.Consume(topic, func(bytes []byte) error {
+		err = cl.Consume(topic, func(_ context.Context, bytes []byte) error {
 			serialized := &test_api.TestMessage{}
 			err := proto.Unmarshal(bytes, serialized)
 			if assert.NoError(t, err) {
@@ -118,7 +121,7 @@ func TestPublish(t *testing.T) {
 				nats_jetstream.WithConsumer("pub-topic-2", "pub-topic-2-stream", "pub-topic-2-cons", false, jetstream.AckExplicitPolicy),
 			},
 			testFunc: func(cl *nats_jetstream.Client, topic string, msg *test_api.TestMessage) {
-				err = cl.PublishMarshalAsync(topic, msg)
+				err = cl.PublishMarshalAsync(context.TODO(), topic, msg)
 				require.NoError(t, err)
 			},
 			subscribeFunc: consume,
@@ -141,11 +144,11 @@ func TestPublish(t *testing.T) {
 			topic: "pub-topic-4",
 			opts:  []nats_jetstream.Option{},
 			testFunc: func(cl *nats_jetstream.Client, topic string, msg *test_api.TestMessage) {
-				err = cl.PublishMarshalCore(topic, msg)
+				err = cl.PublishMarshalCore(context.TODO(), topic, msg)
 				require.NoError(t, err)
 			},
 			subscribeFunc: func(cl *nats_jetstream.Client, topic string, messageChan chan *test_api.TestMessage) {
-				err = cl.QueueSubscribe(topic, func(bytes []byte) error {
+				err = cl.QueueSubscribe(topic, func(_ context.Context, bytes []byte) error {
 					serialized := &test_api.TestMessage{}
 					err := proto.Unmarshal(bytes, serialized)
 					if assert.NoError(t, err) {
@@ -218,7 +221,7 @@ func TestSubscribe(t *testing.T) {
 				nats_jetstream.WithConsumer("sub-topic-1", "sub-topic-1-stream", "sub-topic-1-cons", false, jetstream.AckExplicitPolicy),
 			},
 			testFunc: func(cl *nats_jetstream.Client, topic string, messageChan chan *test_api.TestMessage) {
-				err = cl.Consume(topic, func(bytes []byte) error {
+				err = cl.Consume(topic, func(_ context.Context, bytes []byte) error {
 					serialized := &test_api.TestMessage{}
 					unmarshalErr := proto.Unmarshal(bytes, serialized)
 					if unmarshalErr != nil {
@@ -301,3 +304,84 @@ func TestSubscribe(t *testing.T) {
 		})
 	}
 }
+
+func TestTraceContextPropagation(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	tt := []struct {
+		name    string
+		topic   string
+		opts    []nats_jetstream.Option
+		publish func(ctx context.Context, cl *nats_jetstream.Client, topic string) error
+		consume func(cl *nats_jetstream.Client, topic string, msgFunc func(ctx context.Context, msg []byte) error) error
+	}{
+		{
+			name:  "publish - consume",
+			topic: "trace-topic-1",
+			opts: []nats_jetstream.Option{
+				nats_jetstream.WithStream("trace-topic-1", "trace-topic-1-stream", jetstream.WorkQueuePolicy, false),
+				nats_jetstream.WithConsumer("trace-topic-1", "trace-topic-1-stream", "trace-topic-1-cons", false, jetstream.AckExplicitPolicy),
+			},
+			publish: func(ctx context.Context, cl *nats_jetstream.Client, topic string) error {
+				return cl.Publish(ctx, topic, []byte("data"))
+			},
+			consume: func(cl *nats_jetstream.Client, topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+				return cl.Consume(topic, msgFunc)
+			},
+		},
+		{
+			name:  "publish core - queue subscribe",
+			topic: "trace-topic-2",
+			publish: func(ctx context.Context, cl *nats_jetstream.Client, topic string) error {
+				return cl.PublishCore(ctx, topic, []byte("data"))
+			},
+			consume: func(cl *nats_jetstream.Client, topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+				return cl.QueueSubscribe(topic, msgFunc)
+			},
+		},
+	}
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	defer otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			natsConnClient, err := nats_connection.New(natsURL, logger)
+			require.NoError(t, err)
+			mqClient, err := nats_jetstream.New(natsConnClient, logger, tc.opts...)
+			require.NoError(t, err)
+			defer mqClient.Shutdown()
+
+			spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x01, 0x02, 0x03},
+				SpanID:     trace.SpanID{0x04, 0x05},
+				TraceFlags: trace.FlagsSampled,
+			})
+			ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+			consumedCh := make(chan trace.SpanContext, 1)
+			err = tc.consume(mqClient, tc.topic, func(ctx context.Context, _ []byte) error {
+				consumedCh <- trace.SpanContextFromContext(ctx)
+				return nil
+			})
+			require.NoError(t, err)
+
+			// when
+			err = tc.publish(ctx, mqClient, tc.topic)
+			require.NoError(t, err)
+
+			// then
+			select {
+			case <-time.NewTimer(time.Second).C:
+				t.Fatal("timeout waiting for message")
+			case consumed := <-consumedCh:
+				require.Equal(t, spanContext.TraceID(), consumed.TraceID())
+				require.Equal(t, spanContext.SpanID(), consumed.SpanID())
+				require.True(t, consumed.IsRemote())
+			}
+		})
+	}
+}
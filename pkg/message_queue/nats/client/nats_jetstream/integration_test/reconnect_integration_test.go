@@ -36,7 +36,7 @@ func TestReconnect(t *testing.T) {
 
 	var receivedCounter *atomic.Int32
 
-	msgReceived := func(bytes []byte) error {
+	msgReceived := func(_ context.Context, bytes []byte) error {
 		logger.Info("message received", "msg", string(bytes))
 		receivedCounter.Add(1)
 		return nil
@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"runtime"
 	"time"
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/protobuf/proto"
+
+	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
 type Client struct {
@@ -20,8 +24,12 @@ type Client struct {
 	storageType jetstream.StorageType
 	ctx         context.Context
 	cancelAll   context.CancelFunc
+
+	tracer tracing.MessageTracer
 }
 
+const messagingSystem = "nats"
+
 var (
 	ErrConsumerNotInitialized = errors.New("consumer for topic not initialized")
 	ErrFailedToGetStream      = errors.New("failed to get stream")
@@ -101,6 +109,18 @@ func WithConsumer(topic string, streamName string, consumerName string, durable
 	}
 }
 
+// WithTracer enables the tracing of the publishing and consumption of messages.
+func WithTracer(attr ...attribute.KeyValue) func(*Client) error {
+	return func(cl *Client) error {
+		_, file, _, ok := runtime.Caller(1)
+		if ok {
+			attr = append(attr, attribute.String("file", file))
+		}
+		cl.tracer = tracing.NewMessageTracer(messagingSystem, true, attr...)
+		return nil
+	}
+}
+
 func WithFileStorage() func(*Client) error {
 	return func(c *Client) error {
 		c.storageType = jetstream.FileStorage
@@ -110,6 +130,33 @@ func WithFileStorage() func(*Client) error {
 
 type Option func(p *Client) error
 
+// SchemaVersionHeader is the header carrying the version of the schema in which the data of a message is encoded.
+const SchemaVersionHeader = "Arc-Schema-Version"
+
+type schemaVersionKey struct{}
+
+// ContextWithSchemaVersion returns a context whose messages are published with the schema version header.
+func ContextWithSchemaVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, schemaVersionKey{}, version)
+}
+
+// SchemaVersionFromContext returns the schema version of the message published or consumed with the context, it is
+// empty for messages published without the schema version header.
+func SchemaVersionFromContext(ctx context.Context) string {
+	version, _ := ctx.Value(schemaVersionKey{}).(string)
+	return version
+}
+
+// consumeContext returns the context for consuming a message carrying the schema version of the message.
+func (cl *Client) consumeContext(header nats.Header) context.Context {
+	ctx := cl.ctx
+	if version := header.Get(SchemaVersionHeader); version != "" {
+		ctx = ContextWithSchemaVersion(ctx, version)
+	}
+
+	return ctx
+}
+
 func New(nc *nats.Conn, logger *slog.Logger, opts ...Option) (*Client, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -146,31 +193,54 @@ func (cl *Client) IsConnected() bool {
 	return cl.nc.IsConnected()
 }
 
-func (cl *Client) Publish(ctx context.Context, topic string, hash []byte) (err error) {
-	_, err = cl.js.Publish(ctx, topic, hash)
-	if err != nil {
-		return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
+// newMsg returns a message for the topic carrying the schema version of ctx in its headers. The trace context is
+// written into the headers when the message is published.
+func newMsg(ctx context.Context, topic string, data []byte) *nats.Msg {
+	msg := &nats.Msg{
+		Subject: topic,
+		Data:    data,
+		Header:  nats.Header{},
+	}
+	if version := SchemaVersionFromContext(ctx); version != "" {
+		msg.Header.Set(SchemaVersionHeader, version)
 	}
 
-	return nil
+	return msg
 }
 
-func (cl *Client) PublishCore(topic string, data []byte) (err error) {
-	err = cl.nc.Publish(topic, data)
-	if err != nil {
-		return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
-	}
+func (cl *Client) Publish(ctx context.Context, topic string, hash []byte) error {
+	msg := newMsg(ctx, topic, hash)
 
-	return nil
+	return cl.tracer.Publish(ctx, "Publish", topic, msg.Header, func(ctx context.Context) error {
+		_, err := cl.js.PublishMsg(ctx, msg)
+		if err != nil {
+			return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
+		}
+
+		return nil
+	})
 }
 
-func (cl *Client) PublishMarshalCore(topic string, m proto.Message) (err error) {
+func (cl *Client) PublishCore(ctx context.Context, topic string, data []byte) error {
+	msg := newMsg(ctx, topic, data)
+
+	return cl.tracer.Publish(ctx, "PublishCore", topic, msg.Header, func(_ context.Context) error {
+		err := cl.nc.PublishMsg(msg)
+		if err != nil {
+			return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
+		}
+
+		return nil
+	})
+}
+
+func (cl *Client) PublishMarshalCore(ctx context.Context, topic string, m proto.Message) (err error) {
 	data, err := proto.Marshal(m)
 	if err != nil {
 		return err
 	}
 
-	err = cl.PublishCore(topic, data)
+	err = cl.PublishCore(ctx, topic, data)
 	if err != nil {
 		return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
 	}
@@ -178,13 +248,17 @@ func (cl *Client) PublishMarshalCore(topic string, m proto.Message) (err error)
 	return nil
 }
 
-func (cl *Client) PublishAsync(topic string, hash []byte) (err error) {
-	_, err = cl.js.PublishAsync(topic, hash)
-	if err != nil {
-		return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
-	}
+func (cl *Client) PublishAsync(ctx context.Context, topic string, hash []byte) error {
+	msg := newMsg(ctx, topic, hash)
 
-	return nil
+	return cl.tracer.Publish(ctx, "PublishAsync", topic, msg.Header, func(_ context.Context) error {
+		_, err := cl.js.PublishMsgAsync(msg)
+		if err != nil {
+			return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
+		}
+
+		return nil
+	})
 }
 
 func (cl *Client) PublishMarshal(ctx context.Context, topic string, m proto.Message) (err error) {
@@ -201,13 +275,13 @@ func (cl *Client) PublishMarshal(ctx context.Context, topic string, m proto.Mess
 	return nil
 }
 
-func (cl *Client) PublishMarshalAsync(topic string, m proto.Message) (err error) {
+func (cl *Client) PublishMarshalAsync(ctx context.Context, topic string, m proto.Message) (err error) {
 	data, err := proto.Marshal(m)
 	if err != nil {
 		return err
 	}
 
-	err = cl.PublishAsync(topic, data)
+	err = cl.PublishAsync(ctx, topic, data)
 	if err != nil {
 		return errors.Join(ErrFailedToPublish, fmt.Errorf(topic, topic), err)
 	}
@@ -236,7 +310,9 @@ func (cl *Client) ConsumeMsg(topic string, msgFunc func(msg jetstream.Msg) error
 	return nil
 }
 
-func (cl *Client) Consume(topic string, msgFunc func([]byte) error) error {
+// Consume calls msgFunc for each message of the topic with a context continuing the trace of the publisher of the
+// message.
+func (cl *Client) Consume(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
 	consumer, found := cl.consumers[topic]
 
 	if !found {
@@ -244,16 +320,20 @@ func (cl *Client) Consume(topic string, msgFunc func([]byte) error) error {
 	}
 
 	_, err := consumer.Consume(func(msg jetstream.Msg) {
-		msgErr := msgFunc(msg.Data())
-		if msgErr != nil {
-			cl.logger.Error(fmt.Sprintf("failed to consume message on %s topic: %s", topic, string(msg.Data())), slog.String("err", msgErr.Error()))
-			return
-		}
+		_ = cl.tracer.Consume(cl.consumeContext(msg.Headers()), "Consume", topic, msg.Headers(), func(ctx context.Context) error {
+			msgErr := msgFunc(ctx, msg.Data())
+			if msgErr != nil {
+				cl.logger.Error(fmt.Sprintf("failed to consume message on %s topic: %s", topic, string(msg.Data())), slog.String("err", msgErr.Error()))
+				return msgErr
+			}
 
-		ackErr := msg.Ack()
-		if ackErr != nil {
-			cl.logger.Error(fmt.Sprintf("failed to acknowledge message on %s topic: %s", topic, string(msg.Data())))
-		}
+			ackErr := msg.Ack()
+			if ackErr != nil {
+				cl.logger.Error(fmt.Sprintf("failed to acknowledge message on %s topic: %s", topic, string(msg.Data())))
+			}
+
+			return nil
+		})
 	})
 	if err != nil {
 		return errors.Join(ErrFailedToSubscribe, fmt.Errorf(topic, topic), err)
@@ -262,12 +342,18 @@ func (cl *Client) Consume(topic string, msgFunc func([]byte) error) error {
 	return nil
 }
 
-func (cl *Client) QueueSubscribe(topic string, msgFunc func([]byte) error) error {
+// QueueSubscribe calls msgFunc for each message of the topic with a context continuing the trace of the publisher of
+// the message.
+func (cl *Client) QueueSubscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
 	_, err := cl.nc.QueueSubscribe(topic, topic+"-group", func(msg *nats.Msg) {
-		err := msgFunc(msg.Data)
-		if err != nil {
-			cl.logger.Error(fmt.Sprintf("failed to run message function on %s topic", topic), slog.String("err", err.Error()))
-		}
+		_ = cl.tracer.Consume(cl.consumeContext(msg.Header), "QueueSubscribe", topic, msg.Header, func(ctx context.Context) error {
+			msgErr := msgFunc(ctx, msg.Data)
+			if msgErr != nil {
+				cl.logger.Error(fmt.Sprintf("failed to run message function on %s topic", topic), slog.String("err", msgErr.Error()))
+			}
+
+			return msgErr
+		})
 	})
 	if err != nil {
 		return errors.Join(ErrFailedToSubscribe, fmt.Errorf(topic, topic), err)
@@ -276,6 +362,26 @@ func (cl *Client) QueueSubscribe(topic string, msgFunc func([]byte) error) error
 	return nil
 }
 
+// Subscribe calls msgFunc for each message of the topic with a context continuing the trace of the publisher of the
+// message. Other than with QueueSubscribe, each subscribing client receives all messages.
+func (cl *Client) Subscribe(topic string, msgFunc func(ctx context.Context, msg []byte) error) error {
+	_, err := cl.nc.Subscribe(topic, func(msg *nats.Msg) {
+		_ = cl.tracer.Consume(cl.consumeContext(msg.Header), "Subscribe", topic, msg.Header, func(ctx context.Context) error {
+			msgErr := msgFunc(ctx, msg.Data)
+			if msgErr != nil {
+				cl.logger.Error(fmt.Sprintf("failed to run message function on %s topic", topic), slog.String("err", msgErr.Error()))
+			}
+
+			return msgErr
+		})
+	})
+	if err != nil {
+		return errors.Join(ErrFailedToSubscribe, fmt.Errorf("topic: %s", topic), err)
+	}
+
+	return nil
+}
+
 func (cl *Client) Shutdown() {
 	if cl == nil {
 		return
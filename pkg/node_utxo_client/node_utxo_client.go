@@ -0,0 +1,214 @@
+package node_utxo_client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
+)
+
+const (
+	satoshisPerBSV     = 1e8
+	topUpAmountDefault = 0.01
+)
+
+var (
+	ErrNodeFailedToImportAddress = errors.New("failed to import address into wallet of node")
+	ErrNodeFailedToGetUTXOs      = errors.New("failed to get utxos from node")
+	ErrNodeFailedToDecodeUTXO    = errors.New("failed to decode utxo")
+	ErrNodeFailedToTopUp         = errors.New("failed to top up address from wallet of node")
+)
+
+type NodeRPC interface {
+	ImportAddress(ctx context.Context, address string) error
+	ListUnspent(ctx context.Context, addresses []string) ([]rpc_client.UnspentOutput, error)
+	SendToAddress(ctx context.Context, address string, amount float64) (string, error)
+}
+
+// NodeUtxoClient gets the UTXOs of addresses from the wallet of a node, e.g. of a node in a local regtest network. The
+// addresses are imported into the wallet of the node with importaddress without rescan on first use, therefore only
+// outputs which the node receives from then on are known to it. Outputs received before the import are missing, e.g.
+// the funding of an address in a previous run against another node. Top ups are sent from the wallet of the node.
+type NodeUtxoClient struct {
+	rpc         NodeRPC
+	logger      *slog.Logger
+	topUpAmount float64
+	imported    sync.Map
+}
+
+func WithLogger(logger *slog.Logger) func(*NodeUtxoClient) {
+	return func(n *NodeUtxoClient) {
+		n.logger = logger
+	}
+}
+
+// WithTopUpAmount sets the amount in BSV which is sent to an address by TopUp.
+func WithTopUpAmount(amount float64) func(*NodeUtxoClient) {
+	return func(n *NodeUtxoClient) {
+		n.topUpAmount = amount
+	}
+}
+
+func New(rpc NodeRPC, opts ...func(client *NodeUtxoClient)) *NodeUtxoClient {
+	n := &NodeUtxoClient{
+		rpc:         rpc,
+		logger:      slog.Default(),
+		topUpAmount: topUpAmountDefault,
+	}
+
+	for _, opt := range opts {
+		opt(n)
+	}
+
+	return n
+}
+
+func (n *NodeUtxoClient) importAddress(ctx context.Context, address string) error {
+	if _, found := n.imported.Load(address); found {
+		return nil
+	}
+
+	err := n.rpc.ImportAddress(ctx, address)
+	if err != nil {
+		return errors.Join(ErrNodeFailedToImportAddress, err)
+	}
+
+	n.imported.Store(address, struct{}{})
+	return nil
+}
+
+func (n *NodeUtxoClient) listUnspent(ctx context.Context, address string) ([]rpc_client.UnspentOutput, error) {
+	err := n.importAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	unspent, err := n.rpc.ListUnspent(ctx, []string{address})
+	if err != nil {
+		return nil, errors.Join(ErrNodeFailedToGetUTXOs, err)
+	}
+
+	return unspent, nil
+}
+
+// GetUTXOs Get UTXOs from the wallet of the node
+func (n *NodeUtxoClient) GetUTXOs(ctx context.Context, address string) (sdkTx.UTXOs, error) {
+	unspent, err := n.listUnspent(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make(sdkTx.UTXOs, len(unspent))
+	for i, output := range unspent {
+		h, err := chainhash.NewHashFromHex(output.TxID)
+		if err != nil {
+			return nil, errors.Join(ErrNodeFailedToDecodeUTXO, err)
+		}
+
+		lockingScript, err := script.NewFromHex(output.ScriptPubKey)
+		if err != nil {
+			return nil, errors.Join(ErrNodeFailedToDecodeUTXO, err)
+		}
+
+		utxos[i] = &sdkTx.UTXO{
+			TxID:          h,
+			Vout:          output.Vout,
+			LockingScript: lockingScript,
+			Satoshis:      toSatoshis(output.Amount),
+		}
+	}
+
+	return utxos, nil
+}
+
+func (n *NodeUtxoClient) GetUTXOsWithRetries(ctx context.Context, address string, constantBackoff time.Duration, retries uint64) (sdkTx.UTXOs, error) {
+	policy := backoff.WithMaxRetries(backoff.NewConstantBackOff(constantBackoff), retries)
+
+	policyContext := backoff.WithContext(policy, ctx)
+	operation := func() (sdkTx.UTXOs, error) {
+		return n.GetUTXOs(ctx, address)
+	}
+
+	notify := func(err error, nextTry time.Duration) {
+		n.logger.ErrorContext(ctx, "failed to get utxos from node", slog.String("address", address), slog.String("next try", nextTry.String()), slog.String("err", err.Error()))
+	}
+
+	return backoff.RetryNotifyWithData(operation, policyContext, notify)
+}
+
+// GetBalance returns the confirmed and the unconfirmed balance of the address in satoshis.
+func (n *NodeUtxoClient) GetBalance(ctx context.Context, address string) (uint64, uint64, error) {
+	unspent, err := n.listUnspent(ctx, address)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var confirmed, unconfirmed uint64
+	for _, output := range unspent {
+		if output.Confirmations > 0 {
+			confirmed += toSatoshis(output.Amount)
+			continue
+		}
+		unconfirmed += toSatoshis(output.Amount)
+	}
+
+	return confirmed, unconfirmed, nil
+}
+
+func (n *NodeUtxoClient) GetBalanceWithRetries(ctx context.Context, address string, constantBackoff time.Duration, retries uint64) (uint64, uint64, error) {
+	policy := backoff.WithMaxRetries(backoff.NewConstantBackOff(constantBackoff), retries)
+
+	policyContext := backoff.WithContext(policy, ctx)
+
+	type balanceResult struct {
+		confirmed   uint64
+		unconfirmed uint64
+	}
+
+	operation := func() (balanceResult, error) {
+		confirmed, unconfirmed, err := n.GetBalance(ctx, address)
+		if err != nil {
+			return balanceResult{}, err
+		}
+		return balanceResult{confirmed: confirmed, unconfirmed: unconfirmed}, nil
+	}
+
+	notify := func(err error, nextTry time.Duration) {
+		n.logger.ErrorContext(ctx, "failed to get balance from node", slog.String("address", address), slog.String("next try", nextTry.String()), slog.String("err", err.Error()))
+	}
+
+	balanceRes, err := backoff.RetryNotifyWithData(operation, policyContext, notify)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return balanceRes.confirmed, balanceRes.unconfirmed, nil
+}
+
+// TopUp sends the top up amount from the wallet of the node to the address.
+func (n *NodeUtxoClient) TopUp(ctx context.Context, address string) error {
+	err := n.importAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	_, err = n.rpc.SendToAddress(ctx, address, n.topUpAmount)
+	if err != nil {
+		return errors.Join(ErrNodeFailedToTopUp, err)
+	}
+
+	return nil
+}
+
+func toSatoshis(amount float64) uint64 {
+	return uint64(math.Round(amount * satoshisPerBSV))
+}
@@ -0,0 +1,3 @@
+package node_utxo_client
+
+//go:generate moq -pkg mocks -out ./mocks/node_rpc_mock.go . NodeRPC
@@ -0,0 +1,176 @@
+package node_utxo_client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/bsv-blockchain/go-sdk/chainhash"
+	"github.com/bsv-blockchain/go-sdk/script"
+	sdkTx "github.com/bsv-blockchain/go-sdk/transaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bitcoin-sv/arc/pkg/node_utxo_client"
+	"github.com/bitcoin-sv/arc/pkg/node_utxo_client/mocks"
+	"github.com/bitcoin-sv/arc/pkg/rpc_client"
+)
+
+const (
+	address       = "mhCkkGrvgXGw6Y4QdPXDyWJPNy2qrHTcf8"
+	txID          = "4a2992fa3af9eb7ff6b94dc9e27e44f29a54ab351ee6377455409b0ebbe1f00c"
+	lockingScript = "76a91412ab8dc588ca9d5787dde7eb29569da63c3a238c88ac"
+)
+
+func TestNodeUtxoClient_GetUTXOs(t *testing.T) {
+	hash, err := chainhash.NewHashFromHex(txID)
+	require.NoError(t, err)
+	s, err := script.NewFromHex(lockingScript)
+	require.NoError(t, err)
+
+	tt := []struct {
+		name           string
+		importErr      error
+		listUnspentErr error
+		unspent        []rpc_client.UnspentOutput
+
+		expected      sdkTx.UTXOs
+		expectedError error
+	}{
+		{
+			name: "success",
+			unspent: []rpc_client.UnspentOutput{
+				{TxID: txID, Vout: 1, ScriptPubKey: lockingScript, Amount: 0.00001, Confirmations: 1},
+				{TxID: txID, Vout: 2, ScriptPubKey: lockingScript, Amount: 0.1},
+			},
+
+			expected: sdkTx.UTXOs{
+				{TxID: hash, Vout: 1, LockingScript: s, Satoshis: 1000},
+				{TxID: hash, Vout: 2, LockingScript: s, Satoshis: 10000000},
+			},
+		},
+		{
+			name:      "failed to import address",
+			importErr: errors.New("wallet disabled"),
+
+			expectedError: node_utxo_client.ErrNodeFailedToImportAddress,
+		},
+		{
+			name:           "failed to list unspent",
+			listUnspentErr: errors.New("connection refused"),
+
+			expectedError: node_utxo_client.ErrNodeFailedToGetUTXOs,
+		},
+		{
+			name:    "invalid txid",
+			unspent: []rpc_client.UnspentOutput{{TxID: "invalid", ScriptPubKey: lockingScript}},
+
+			expectedError: node_utxo_client.ErrNodeFailedToDecodeUTXO,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rpc := &mocks.NodeRPCMock{
+				ImportAddressFunc: func(_ context.Context, _ string) error {
+					return tc.importErr
+				},
+				ListUnspentFunc: func(_ context.Context, _ []string) ([]rpc_client.UnspentOutput, error) {
+					return tc.unspent, tc.listUnspentErr
+				},
+			}
+			sut := node_utxo_client.New(rpc)
+
+			// when
+			actual, err := sut.GetUTXOs(context.Background(), address)
+
+			// then
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+
+			// the address is imported only once
+			_, err = sut.GetUTXOs(context.Background(), address)
+			require.NoError(t, err)
+			assert.Len(t, rpc.ImportAddressCalls(), 1)
+		})
+	}
+}
+
+func TestNodeUtxoClient_GetBalance(t *testing.T) {
+	// given
+	rpc := &mocks.NodeRPCMock{
+		ImportAddressFunc: func(_ context.Context, _ string) error {
+			return nil
+		},
+		ListUnspentFunc: func(_ context.Context, _ []string) ([]rpc_client.UnspentOutput, error) {
+			return []rpc_client.UnspentOutput{
+				{TxID: txID, Vout: 1, Amount: 0.5, Confirmations: 3},
+				{TxID: txID, Vout: 2, Amount: 0.25, Confirmations: 1},
+				{TxID: txID, Vout: 3, Amount: 0.00000001},
+			}, nil
+		},
+	}
+	sut := node_utxo_client.New(rpc)
+
+	// when
+	confirmed, unconfirmed, err := sut.GetBalance(context.Background(), address)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, uint64(75000000), confirmed)
+	assert.Equal(t, uint64(1), unconfirmed)
+}
+
+func TestNodeUtxoClient_TopUp(t *testing.T) {
+	tt := []struct {
+		name    string
+		sendErr error
+
+		expectedError error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:    "insufficient funds",
+			sendErr: errors.New("Insufficient funds"),
+
+			expectedError: node_utxo_client.ErrNodeFailedToTopUp,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			rpc := &mocks.NodeRPCMock{
+				ImportAddressFunc: func(_ context.Context, _ string) error {
+					return nil
+				},
+				SendToAddressFunc: func(_ context.Context, _ string, _ float64) (string, error) {
+					return txID, tc.sendErr
+				},
+			}
+			sut := node_utxo_client.New(rpc, node_utxo_client.WithTopUpAmount(2))
+
+			// when
+			err := sut.TopUp(context.Background(), address)
+
+			// then
+			require.Len(t, rpc.SendToAddressCalls(), 1)
+			assert.Equal(t, 2.0, rpc.SendToAddressCalls()[0].Amount)
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
 )
 
 type RPCRequest struct {
@@ -24,9 +26,7 @@ type RPCResponse struct {
 	Err    interface{}     `json:"error"`
 }
 
-func sendJSONRPCCall[T any](ctx context.Context, method string, params []interface{}, nodeHost string, nodePort int, nodeUser, nodePassword string) (*T, error) {
-	c := http.Client{}
-
+func sendJSONRPCCall[T any](ctx context.Context, c httpclient.Doer, method string, params []interface{}, nodeHost string, nodePort int, nodeUser, nodePassword string) (*T, error) {
 	rpcRequest := RPCRequest{method, params, time.Now().UnixNano(), "1.0"}
 	payloadBuffer := &bytes.Buffer{}
 	jsonEncoder := json.NewEncoder(payloadBuffer)
@@ -99,7 +99,73 @@ func sendJSONRPCCall[T any](ctx context.Context, method string, params []interfa
 	return &responseResult, nil
 }
 
+// sendJSONRPCBatch sends the calls of the method with the given params in one batch request. The results are returned
+// in the order of the params, the result of a call which failed is nil.
+func sendJSONRPCBatch[T any](ctx context.Context, c httpclient.Doer, method string, params [][]interface{}, nodeHost string, nodePort int, nodeUser, nodePassword string) ([]*T, error) {
+	rpcRequests := make([]RPCRequest, len(params))
+	for i, p := range params {
+		rpcRequests[i] = RPCRequest{method, p, int64(i), "1.0"}
+	}
+
+	payload, err := json.Marshal(rpcRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx,
+		"POST",
+		fmt.Sprintf("%s://%s:%d", "http", nodeHost, nodePort),
+		bytes.NewReader(payload),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(nodeUser, nodePassword)
+	req.Header.Add("Content-Type", "application/json;charset=utf-8")
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("HTTP error: " + resp.Status)
+	}
+
+	var rpcResponses []RPCResponse
+	err = json.Unmarshal(data, &rpcResponses)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*T, len(params))
+	for _, rpcResponse := range rpcResponses {
+		if rpcResponse.Err != nil || rpcResponse.ID < 0 || rpcResponse.ID >= int64(len(params)) {
+			continue
+		}
+
+		var responseResult T
+		err = json.Unmarshal(rpcResponse.Result, &responseResult)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarhsal response: %v", err)
+		}
+
+		results[rpcResponse.ID] = &responseResult
+	}
+
+	return results, nil
+}
+
 type RPCClient struct {
+	client   *httpclient.Client
 	host     string
 	port     int
 	user     string
@@ -108,6 +174,7 @@ type RPCClient struct {
 
 func NewRPCClient(host string, port int, user, password string) (*RPCClient, error) {
 	c := &RPCClient{
+		client:   httpclient.New("node-rpc"),
 		host:     host,
 		port:     port,
 		user:     user,
@@ -118,7 +185,7 @@ func NewRPCClient(host string, port int, user, password string) (*RPCClient, err
 }
 
 func (c *RPCClient) GetRawTransactionHex(ctx context.Context, txID string) (string, error) {
-	res, err := sendJSONRPCCall[string](ctx, "getrawtransaction", []interface{}{txID, 0}, c.host, c.port, c.user, c.password)
+	res, err := sendJSONRPCCall[string](ctx, c.client, "getrawtransaction", []interface{}{txID, 0}, c.host, c.port, c.user, c.password)
 	if err != nil {
 		return "", err
 	}
@@ -127,7 +194,7 @@ func (c *RPCClient) GetRawTransactionHex(ctx context.Context, txID string) (stri
 }
 
 func (c *RPCClient) GetMempoolAncestors(ctx context.Context, txID string) ([]string, error) {
-	res, err := sendJSONRPCCall[[]string](ctx, "getmempoolancestors", []interface{}{txID}, c.host, c.port, c.user, c.password)
+	res, err := sendJSONRPCCall[[]string](ctx, c.client, "getmempoolancestors", []interface{}{txID}, c.host, c.port, c.user, c.password)
 	if err != nil {
 		return nil, err
 	}
@@ -136,13 +203,13 @@ func (c *RPCClient) GetMempoolAncestors(ctx context.Context, txID string) ([]str
 }
 
 func (c *RPCClient) InvalidateBlock(ctx context.Context, blockHash string) error {
-	_, err := sendJSONRPCCall[[]byte](ctx, "invalidateblock", []interface{}{blockHash}, c.host, c.port, c.user, c.password)
+	_, err := sendJSONRPCCall[[]byte](ctx, c.client, "invalidateblock", []interface{}{blockHash}, c.host, c.port, c.user, c.password)
 
 	return err
 }
 
 func (c *RPCClient) SendRawTransaction(ctx context.Context, txHex string) (string, error) {
-	res, err := sendJSONRPCCall[string](ctx, "sendrawtransaction", []interface{}{txHex}, c.host, c.port, c.user, c.password)
+	res, err := sendJSONRPCCall[string](ctx, c.client, "sendrawtransaction", []interface{}{txHex}, c.host, c.port, c.user, c.password)
 	if err != nil {
 		return "", err
 	}
@@ -157,9 +224,171 @@ type VerboseRawTransaction struct {
 }
 
 func (c *RPCClient) GetRawTransactionVerbose(ctx context.Context, txID string) (*VerboseRawTransaction, error) {
-	res, err := sendJSONRPCCall[VerboseRawTransaction](ctx, "getrawtransaction", []interface{}{txID, true}, c.host, c.port, c.user, c.password)
+	res, err := sendJSONRPCCall[VerboseRawTransaction](ctx, c.client, "getrawtransaction", []interface{}{txID, true}, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetRawTransactionsVerbose gets the transactions with one batch request. The transactions which are neither in the
+// mempool nor, if the index of all transactions is enabled on the node, in the chain of the node are nil.
+func (c *RPCClient) GetRawTransactionsVerbose(ctx context.Context, txIDs []string) ([]*VerboseRawTransaction, error) {
+	params := make([][]interface{}, len(txIDs))
+	for i, txID := range txIDs {
+		params[i] = []interface{}{txID, true}
+	}
+
+	res, err := sendJSONRPCBatch[VerboseRawTransaction](ctx, c.client, "getrawtransaction", params, c.host, c.port, c.user, c.password)
 	if err != nil {
 		return nil, err
 	}
+
 	return res, nil
 }
+
+func (c *RPCClient) GetRawMempool(ctx context.Context) ([]string, error) {
+	res, err := sendJSONRPCCall[[]string](ctx, c.client, "getrawmempool", []interface{}{false}, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+
+	return *res, nil
+}
+
+type BlockHeader struct {
+	Hash       string `json:"hash"`
+	Height     uint64 `json:"height"`
+	MerkleRoot string `json:"merkleroot"`
+}
+
+// GetBlockHeaders gets the headers of the blocks with one batch request. The headers of unknown blocks are nil.
+func (c *RPCClient) GetBlockHeaders(ctx context.Context, blockHashes []string) ([]*BlockHeader, error) {
+	params := make([][]interface{}, len(blockHashes))
+	for i, blockHash := range blockHashes {
+		params[i] = []interface{}{blockHash, true}
+	}
+
+	res, err := sendJSONRPCBatch[BlockHeader](ctx, c.client, "getblockheader", params, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// MerkleProof is the merkle proof of a transaction in the TSC format. The nodes are the hashes of the merkle tree from
+// the bottom to the top, a node which is a duplicate of the hash it is paired with is "*".
+type MerkleProof struct {
+	Index  uint64   `json:"index"`
+	TxOrID string   `json:"txOrId"`
+	Target string   `json:"target"`
+	Nodes  []string `json:"nodes"`
+}
+
+type MerkleProofRequest struct {
+	BlockHash string
+	TxID      string
+}
+
+// GetMerkleProofs gets the merkle proofs of the transactions in the blocks with one batch request. The proofs which the
+// node failed to create are nil.
+func (c *RPCClient) GetMerkleProofs(ctx context.Context, requests []MerkleProofRequest) ([]*MerkleProof, error) {
+	params := make([][]interface{}, len(requests))
+	for i, request := range requests {
+		params[i] = []interface{}{request.BlockHash, request.TxID}
+	}
+
+	res, err := sendJSONRPCBatch[MerkleProof](ctx, c.client, "getmerkleproof2", params, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type UnspentOutput struct {
+	TxID          string  `json:"txid"`
+	Vout          uint32  `json:"vout"`
+	Address       string  `json:"address"`
+	ScriptPubKey  string  `json:"scriptPubKey"`
+	Amount        float64 `json:"amount"`
+	Confirmations int     `json:"confirmations"`
+}
+
+// ImportAddress adds an address to the wallet of the node without rescanning the blockchain, so that the unspent
+// outputs of the address received from then on are listed by ListUnspent.
+func (c *RPCClient) ImportAddress(ctx context.Context, address string) error {
+	_, err := sendJSONRPCCall[[]byte](ctx, c.client, "importaddress", []interface{}{address, "", false}, c.host, c.port, c.user, c.password)
+
+	return err
+}
+
+func (c *RPCClient) ListUnspent(ctx context.Context, addresses []string) ([]UnspentOutput, error) {
+	res, err := sendJSONRPCCall[[]UnspentOutput](ctx, c.client, "listunspent", []interface{}{0, 9999999, addresses}, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+
+	return *res, nil
+}
+
+func (c *RPCClient) SendToAddress(ctx context.Context, address string, amount float64) (string, error) {
+	res, err := sendJSONRPCCall[string](ctx, c.client, "sendtoaddress", []interface{}{address, amount}, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return "", err
+	}
+
+	return *res, nil
+}
+
+// MempoolAcceptResult is the result of testmempoolaccept for one of the transactions. RejectReason is the reason of the
+// node if the transaction is not allowed into its mempool.
+type MempoolAcceptResult struct {
+	TxID         string `json:"txid"`
+	Allowed      bool   `json:"allowed"`
+	RejectReason string `json:"reject-reason,omitempty"`
+}
+
+// TestMempoolAccept returns whether the node would accept the transactions into its mempool without broadcasting them.
+// The transactions may spend the outputs of the transactions preceding them.
+func (c *RPCClient) TestMempoolAccept(ctx context.Context, txsHex []string) ([]MempoolAcceptResult, error) {
+	res, err := sendJSONRPCCall[[]MempoolAcceptResult](ctx, c.client, "testmempoolaccept", []interface{}{txsHex}, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+
+	return *res, nil
+}
+
+// VerifyScriptTxo is the output spent by the verified input. It is only needed if the output is neither in the UTXO set
+// nor in the mempool of the node.
+type VerifyScriptTxo struct {
+	Lock  string `json:"lock"`
+	Value uint64 `json:"value"`
+}
+
+// VerifyScriptInput designates the input N of the transaction whose unlocking script is verified.
+type VerifyScriptInput struct {
+	Tx  string           `json:"tx"`
+	N   int              `json:"n"`
+	Txo *VerifyScriptTxo `json:"txo,omitempty"`
+}
+
+// VerifyScriptResult is the result of the verification of an input. Result is ok if the script is valid, otherwise
+// e.g. error, timeout or skipped with the reason in Description.
+type VerifyScriptResult struct {
+	Result      string `json:"result"`
+	Description string `json:"description,omitempty"`
+}
+
+// VerifyScript verifies the unlocking scripts of the inputs with the script engine of the node. The verification stops
+// at the first invalid script, the results of the inputs after it are skipped.
+func (c *RPCClient) VerifyScript(ctx context.Context, inputs []VerifyScriptInput) ([]VerifyScriptResult, error) {
+	res, err := sendJSONRPCCall[[]VerifyScriptResult](ctx, c.client, "verifyscript", []interface{}{inputs, true}, c.host, c.port, c.user, c.password)
+	if err != nil {
+		return nil, err
+	}
+
+	return *res, nil
+}
@@ -21,6 +21,27 @@ const (
 	dbPassword = "arcpass"
 )
 
+// PostgresDSNEnv is the environment variable with the DSN of a user-provided postgres database. If it is set, the store
+// integration tests run against this database instead of a postgres container, e.g. in order to verify a managed
+// postgres flavor. The tests delete the data of the stores, so the database must not be used otherwise.
+const PostgresDSNEnv = "ARC_TEST_POSTGRES_DSN"
+
+// MigrateUserPostgresql migrates the user-provided postgres database given by PostgresDSNEnv and returns its DSN. It
+// returns an empty DSN if PostgresDSNEnv is not set.
+func MigrateUserPostgresql(migrationTable, migrationsPath string) (string, error) {
+	dbInfo := os.Getenv(PostgresDSNEnv)
+	if dbInfo == "" {
+		return "", nil
+	}
+
+	err := MigrateUp(migrationTable, migrationsPath, dbInfo)
+	if err != nil {
+		return "", fmt.Errorf("failed to run migration on %s: %v", PostgresDSNEnv, err)
+	}
+
+	return dbInfo, nil
+}
+
 func RunAndMigratePostgresql(pool *dockertest.Pool, port, migrationTable, migrationsPath string) (*dockertest.Resource, string, error) {
 	resource, dbInfo, err := RunPostgresql(pool, port)
 	if err != nil {
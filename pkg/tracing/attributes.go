@@ -0,0 +1,45 @@
+package tracing
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Keys of the span attributes set by the instrumentation of this package. The names follow the semantic conventions
+// of open telemetry, so that the spans of the gRPC, HTTP and message queue calls of all components can be queried
+// alike.
+const (
+	AttributeRPCSystem         = attribute.Key("rpc.system")
+	AttributeRPCService        = attribute.Key("rpc.service")
+	AttributeRPCMethod         = attribute.Key("rpc.method")
+	AttributeRPCGRPCStatusCode = attribute.Key("rpc.grpc.status_code")
+
+	AttributeHTTPRequestMethod      = attribute.Key("http.request.method")
+	AttributeHTTPRoute              = attribute.Key("http.route")
+	AttributeHTTPResponseStatusCode = attribute.Key("http.response.status_code")
+
+	AttributeMessagingSystem          = attribute.Key("messaging.system")
+	AttributeMessagingDestinationName = attribute.Key("messaging.destination.name")
+	AttributeMessagingOperationType   = attribute.Key("messaging.operation.type")
+)
+
+// Values of the messaging operation type.
+const (
+	MessagingOperationPublish = "publish"
+	MessagingOperationProcess = "process"
+)
+
+// rpcAttributes returns the attributes of the gRPC method given in the format "/package.Service/Method".
+func rpcAttributes(fullMethod string) []attribute.KeyValue {
+	service, method, found := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !found {
+		return []attribute.KeyValue{AttributeRPCSystem.String("grpc"), AttributeRPCMethod.String(service)}
+	}
+
+	return []attribute.KeyValue{
+		AttributeRPCSystem.String("grpc"),
+		AttributeRPCService.String(service),
+		AttributeRPCMethod.String(method),
+	}
+}
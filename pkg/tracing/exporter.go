@@ -0,0 +1,191 @@
+package tracing
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+)
+
+var (
+	ErrUnknownProtocol = errors.New("unknown OTLP protocol")
+	ErrTLSConfig       = errors.New("invalid TLS configuration of the OTLP exporter")
+)
+
+// Option configures the trace provider.
+type Option func(*options)
+
+type options struct {
+	samplingConfig
+	exporter  ExporterConfig
+	batch     BatchConfig
+	detectors []string
+}
+
+// ExporterConfig configures the OTLP exporter of the spans.
+type ExporterConfig struct {
+	// Protocol is either ProtocolGRPC or ProtocolHTTP, an empty protocol selects gRPC
+	Protocol string
+	// Headers are sent with every export, e.g. the API key of a tracing vendor
+	Headers map[string]string
+	TLS     *TLSConfig
+	// Timeout is the maximum duration of an export, 0 keeps the default of the exporter
+	Timeout time.Duration
+}
+
+// TLSConfig enables TLS for the connection to the collector. Without CAFile the certificate of the collector is verified
+// against the system roots, CertFile and KeyFile enable mutual TLS.
+type TLSConfig struct {
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
+}
+
+// BatchConfig tunes the batching of the spans before their export. Zero values keep the defaults of the SDK.
+type BatchConfig struct {
+	MaxQueueSize       int
+	MaxExportBatchSize int
+	BatchTimeout       time.Duration
+	ExportTimeout      time.Duration
+}
+
+// WithExporter configures the OTLP exporter. Without this option the spans are exported over gRPC without TLS.
+func WithExporter(cfg ExporterConfig) Option {
+	return func(o *options) {
+		o.exporter = cfg
+	}
+}
+
+// WithBatch tunes the batching of the spans before their export.
+func WithBatch(cfg BatchConfig) Option {
+	return func(o *options) {
+		o.batch = cfg
+	}
+}
+
+// WithResourceDetectors adds the attributes detected by the named resource detectors to the resource of the spans, see
+// ResourceDetectors for the available detectors.
+func WithResourceDetectors(names ...string) Option {
+	return func(o *options) {
+		o.detectors = names
+	}
+}
+
+func newOptions(opts ...Option) *options {
+	o := &options{samplingConfig: samplingConfig{sample: 100}}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// NewExporter returns an OTLP exporter sending the spans to the collector at the endpoint URL.
+func NewExporter(ctx context.Context, endpointURL string, cfg ExporterConfig) (*otlptrace.Exporter, error) {
+	var tlsConfig *tls.Config
+	if cfg.TLS != nil {
+		var err error
+		tlsConfig, err = cfg.TLS.load()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch cfg.Protocol {
+	case "", ProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpointURL(endpointURL)}
+		if tlsConfig != nil {
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		} else {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+		}
+		if cfg.Timeout > 0 {
+			opts = append(opts, otlptracegrpc.WithTimeout(cfg.Timeout))
+		}
+
+		return otlptracegrpc.New(ctx, opts...)
+	case ProtocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(endpointURL)}
+		if tlsConfig != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		} else {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		if cfg.Timeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(cfg.Timeout))
+		}
+
+		return otlptracehttp.New(ctx, opts...)
+	default:
+		return nil, errors.Join(ErrUnknownProtocol, fmt.Errorf("protocol %q", cfg.Protocol))
+	}
+}
+
+func (c *TLSConfig) load() (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: c.InsecureSkipVerify, // #nosec G402
+	}
+
+	if c.CAFile != "" {
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, errors.Join(ErrTLSConfig, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, errors.Join(ErrTLSConfig, fmt.Errorf("no certificates found in %s", c.CAFile))
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if c.CertFile != "" || c.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, errors.Join(ErrTLSConfig, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+func (c BatchConfig) options() []trace.BatchSpanProcessorOption {
+	var opts []trace.BatchSpanProcessorOption
+	if c.MaxQueueSize > 0 {
+		opts = append(opts, trace.WithMaxQueueSize(c.MaxQueueSize))
+	}
+	if c.MaxExportBatchSize > 0 {
+		opts = append(opts, trace.WithMaxExportBatchSize(c.MaxExportBatchSize))
+	}
+	if c.BatchTimeout > 0 {
+		opts = append(opts, trace.WithBatchTimeout(c.BatchTimeout))
+	}
+	if c.ExportTimeout > 0 {
+		opts = append(opts, trace.WithExportTimeout(c.ExportTimeout))
+	}
+
+	return opts
+}
@@ -0,0 +1,104 @@
+package tracing
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExporter(t *testing.T) {
+	tt := []struct {
+		name string
+		cfg  ExporterConfig
+
+		expectedErr error
+	}{
+		{
+			name: "grpc",
+			cfg:  ExporterConfig{Headers: map[string]string{"api-key": "secret"}},
+		},
+		{
+			name: "http",
+			cfg:  ExporterConfig{Protocol: ProtocolHTTP, Timeout: time.Second},
+		},
+		{
+			name: "tls",
+			cfg:  ExporterConfig{TLS: &TLSConfig{}},
+		},
+		{
+			name: "unknown protocol",
+			cfg:  ExporterConfig{Protocol: "udp"},
+
+			expectedErr: ErrUnknownProtocol,
+		},
+		{
+			name: "missing CA file",
+			cfg:  ExporterConfig{TLS: &TLSConfig{CAFile: filepath.Join(t.TempDir(), "ca.pem")}},
+
+			expectedErr: ErrTLSConfig,
+		},
+		{
+			name: "missing key file",
+			cfg:  ExporterConfig{TLS: &TLSConfig{CertFile: filepath.Join(t.TempDir(), "cert.pem")}},
+
+			expectedErr: ErrTLSConfig,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			actual, err := NewExporter(context.Background(), "http://localhost:4317", tc.cfg)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, actual.Shutdown(context.Background()))
+		})
+	}
+}
+
+func TestNewTraceProviderWithOptions_HTTP(t *testing.T) {
+	// given
+	requests := make(chan *http.Request, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tp, exporter, err := NewTraceProviderWithOptions(context.Background(), slog.Default(), "api", server.URL+"/v1/traces",
+		WithExporter(ExporterConfig{Protocol: ProtocolHTTP, Headers: map[string]string{"api-key": "secret"}}),
+		WithBatch(BatchConfig{MaxExportBatchSize: 1, BatchTimeout: 10 * time.Millisecond}),
+	)
+	require.NoError(t, err)
+	defer func() {
+		_ = exporter.Shutdown(context.Background())
+	}()
+
+	// when
+	_, span := tp.Tracer("").Start(context.Background(), "POST /v1/tx")
+	span.End()
+	require.NoError(t, tp.ForceFlush(context.Background()))
+
+	// then
+	select {
+	case r := <-requests:
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("api-key"))
+	case <-time.After(5 * time.Second):
+		t.Fatal("no spans exported")
+	}
+
+	require.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -0,0 +1,160 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataCarrier reads and writes the trace context from and to the metadata of a gRPC call.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key string, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// UnaryServerInterceptor traces the unary calls of a gRPC server. The span of a call continues the trace of the
+// client, is named after the full method, e.g. "metamorph_api.MetaMorphAPI/PutTransaction", and has the attributes of
+// the method and of its status code followed by the given attributes.
+func UnaryServerInterceptor(attributes ...attribute.KeyValue) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod, attributes)
+		defer func() {
+			endRPCSpan(span, err)
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor traces the streams of a gRPC server in the same way as UnaryServerInterceptor, the span
+// lasts until the handler of the stream returns.
+func StreamServerInterceptor(attributes ...attribute.KeyValue) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod, attributes)
+		defer func() {
+			endRPCSpan(span, err)
+		}()
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientInterceptor traces the unary calls of a gRPC client and passes the trace context on to the server in the
+// metadata of the call.
+func UnaryClientInterceptor(attributes ...attribute.KeyValue) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) (err error) {
+		ctx, span := startClientSpan(ctx, method, attributes)
+		defer func() {
+			endRPCSpan(span, err)
+		}()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor traces the streams of a gRPC client in the same way as UnaryClientInterceptor. The span ends
+// when the stream could not be opened or when receiving from the stream returns an error, io.EOF at the end of the
+// stream included.
+func StreamClientInterceptor(attributes ...attribute.KeyValue) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := startClientSpan(ctx, method, attributes)
+
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			endRPCSpan(span, err)
+			return nil, err
+		}
+
+		return &clientStream{ClientStream: cs, span: span}, nil
+	}
+}
+
+func startServerSpan(ctx context.Context, fullMethod string, attributes []attribute.KeyValue) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	return startSpan(ctx, rpcSpanName(fullMethod), trace.SpanKindServer, append(rpcAttributes(fullMethod), attributes...)...)
+}
+
+func startClientSpan(ctx context.Context, fullMethod string, attributes []attribute.KeyValue) (context.Context, trace.Span) {
+	ctx, span := startSpan(ctx, rpcSpanName(fullMethod), trace.SpanKindClient, append(rpcAttributes(fullMethod), attributes...)...)
+
+	md, found := metadata.FromOutgoingContext(ctx)
+	if found {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+
+	return metadata.NewOutgoingContext(ctx, md), span
+}
+
+func rpcSpanName(fullMethod string) string {
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+func endRPCSpan(span trace.Span, err error) {
+	span.SetAttributes(AttributeRPCGRPCStatusCode.Int(int(status.Code(err))))
+	EndTracing(span, err)
+}
+
+// serverStream passes the context of the span on to the handler of the stream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// clientStream ends the span of the stream when receiving from the stream returns an error.
+type clientStream struct {
+	grpc.ClientStream
+	span trace.Span
+	once sync.Once
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.once.Do(func() {
+			if errors.Is(err, io.EOF) {
+				endRPCSpan(s.span, nil)
+				return
+			}
+			endRPCSpan(s.span, err)
+		})
+	}
+
+	return err
+}
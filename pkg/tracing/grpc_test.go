@@ -0,0 +1,196 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testFullMethod  = "/metamorph_api.MetaMorphAPI/PutTransaction"
+	testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+)
+
+// newSpanRecorder records the spans started with the global tracer until the end of the test.
+func newSpanRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := otel.GetTracerProvider()
+	propagator := otel.GetTextMapPropagator()
+
+	otel.SetTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(provider)
+		otel.SetTextMapPropagator(propagator)
+	})
+
+	return recorder
+}
+
+func spanAttributes(span trace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attributes := make(map[attribute.Key]attribute.Value)
+	for _, a := range span.Attributes() {
+		attributes[a.Key] = a.Value
+	}
+
+	return attributes
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tt := []struct {
+		name       string
+		handlerErr error
+
+		expectedStatus     codes.Code
+		expectedStatusCode int64
+	}{
+		{
+			name: "success",
+
+			expectedStatus:     codes.Unset,
+			expectedStatusCode: int64(grpccodes.OK),
+		},
+		{
+			name:       "error",
+			handlerErr: status.Error(grpccodes.NotFound, "transaction not found"),
+
+			expectedStatus:     codes.Error,
+			expectedStatusCode: int64(grpccodes.NotFound),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			recorder := newSpanRecorder(t)
+			sut := UnaryServerInterceptor(attribute.String("service", "metamorph"))
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", testTraceParent))
+
+			var handlerSpan oteltrace.SpanContext
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handlerSpan = oteltrace.SpanContextFromContext(ctx)
+				return nil, tc.handlerErr
+			}
+
+			// when
+			_, err := sut(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testFullMethod}, handler)
+
+			// then
+			require.ErrorIs(t, err, tc.handlerErr)
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			require.Equal(t, "metamorph_api.MetaMorphAPI/PutTransaction", span.Name())
+			require.Equal(t, oteltrace.SpanKindServer, span.SpanKind())
+			require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.Parent().TraceID().String())
+			require.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+			require.Equal(t, tc.expectedStatus, span.Status().Code)
+
+			attributes := spanAttributes(span)
+			require.Equal(t, "grpc", attributes[AttributeRPCSystem].AsString())
+			require.Equal(t, "metamorph_api.MetaMorphAPI", attributes[AttributeRPCService].AsString())
+			require.Equal(t, "PutTransaction", attributes[AttributeRPCMethod].AsString())
+			require.Equal(t, tc.expectedStatusCode, attributes[AttributeRPCGRPCStatusCode].AsInt64())
+			require.Equal(t, "metamorph", attributes["service"].AsString())
+		})
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	// given
+	recorder := newSpanRecorder(t)
+	sut := UnaryClientInterceptor()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer secret")
+
+	var md metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	// when
+	err := sut(ctx, testFullMethod, nil, nil, nil, invoker)
+
+	// then
+	require.NoError(t, err)
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, oteltrace.SpanKindClient, spans[0].SpanKind())
+	require.Equal(t, []string{"Bearer secret"}, md.Get("authorization"))
+	require.Len(t, md.Get("traceparent"), 1)
+	require.Contains(t, md.Get("traceparent")[0], spans[0].SpanContext().SpanID().String())
+}
+
+type testClientStream struct {
+	grpc.ClientStream
+	recvErrs []error
+}
+
+func (s *testClientStream) RecvMsg(_ any) error {
+	err := s.recvErrs[0]
+	s.recvErrs = s.recvErrs[1:]
+	return err
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	tt := []struct {
+		name     string
+		recvErrs []error
+
+		expectedStatus codes.Code
+	}{
+		{
+			name:     "end of stream",
+			recvErrs: []error{nil, io.EOF, io.EOF},
+
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:     "error",
+			recvErrs: []error{nil, errors.New("connection reset"), io.EOF},
+
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			recorder := newSpanRecorder(t)
+			sut := StreamClientInterceptor()
+			streamer := func(_ context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+				return &testClientStream{recvErrs: tc.recvErrs}, nil
+			}
+
+			// when
+			stream, err := sut(context.Background(), &grpc.StreamDesc{}, nil, testFullMethod, streamer)
+			require.NoError(t, err)
+			require.NoError(t, stream.RecvMsg(nil))
+			require.Empty(t, recorder.Ended())
+			for range tc.recvErrs[1:] {
+				_ = stream.RecvMsg(nil)
+			}
+
+			// then
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			require.Equal(t, tc.expectedStatus, spans[0].Status().Code)
+		})
+	}
+}
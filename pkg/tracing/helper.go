@@ -2,10 +2,12 @@ package tracing
 
 import (
 	"context"
+	"net/http"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -29,6 +31,11 @@ func StartTracing(ctx context.Context, spanName string, tracingEnabled bool, att
 	return ctx, span
 }
 
+// startSpan starts a span of the given kind for the instrumentation of the calls between the components.
+func startSpan(ctx context.Context, spanName string, kind trace.SpanKind, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer("").Start(ctx, spanName, trace.WithSpanKind(kind), trace.WithAttributes(attributes...))
+}
+
 func EndTracing(span trace.Span, err error) {
 	if span != nil {
 		if err != nil {
@@ -38,3 +45,18 @@ func EndTracing(span trace.Span, err error) {
 		span.End()
 	}
 }
+
+// InjectTraceContext writes the trace context of ctx into the headers of a message, e.g. of a NATS message, so that the
+// consumer of the message continues the trace. The headers are left unchanged if tracing is not enabled.
+func InjectTraceContext(ctx context.Context, header map[string][]string) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(http.Header(header)))
+}
+
+// ExtractTraceContext returns a copy of ctx with the trace context read from the headers of a message.
+func ExtractTraceContext(ctx context.Context, header map[string][]string) context.Context {
+	if len(header) == 0 {
+		return ctx
+	}
+
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(http.Header(header)))
+}
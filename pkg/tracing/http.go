@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HTTPMiddleware traces the requests of an HTTP server. The span of a request continues the trace of the client, is
+// named after the method and the route of the request, e.g. "GET /v1/tx/{txid}", and has the attributes of the
+// request and of its response status code followed by the given attributes. The route function returns the route of a
+// request, if it is nil the path of the request is used, which should be avoided for paths containing IDs. Responses
+// with a status code of 500 or above mark the span as failed.
+func HTTPMiddleware(route func(r *http.Request) string, attributes ...attribute.KeyValue) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			path := r.URL.Path
+			if route != nil {
+				path = route(r)
+			}
+
+			ctx := ExtractTraceContext(r.Context(), r.Header)
+			ctx, span := startSpan(ctx, r.Method+" "+path, trace.SpanKindServer, append([]attribute.KeyValue{
+				AttributeHTTPRequestMethod.String(r.Method),
+				AttributeHTTPRoute.String(path),
+			}, attributes...)...)
+			defer span.End()
+
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(recorder, r.WithContext(ctx))
+
+			span.SetAttributes(AttributeHTTPResponseStatusCode.Int(recorder.status))
+			if recorder.status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(recorder.status))
+			}
+		})
+	}
+}
+
+// statusRecorder records the status code written to the response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
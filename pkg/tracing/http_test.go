@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	tt := []struct {
+		name   string
+		route  func(r *http.Request) string
+		status int
+
+		expectedName   string
+		expectedStatus codes.Code
+	}{
+		{
+			name:   "path of the request",
+			status: http.StatusOK,
+
+			expectedName:   "GET /v1/tx/a1b2",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:   "route",
+			route:  func(_ *http.Request) string { return "/v1/tx/{txid}" },
+			status: http.StatusNotFound,
+
+			expectedName:   "GET /v1/tx/{txid}",
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:   "server error",
+			status: http.StatusServiceUnavailable,
+
+			expectedName:   "GET /v1/tx/a1b2",
+			expectedStatus: codes.Error,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			recorder := newSpanRecorder(t)
+
+			var handlerSpan oteltrace.SpanContext
+			sut := HTTPMiddleware(tc.route)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerSpan = oteltrace.SpanContextFromContext(r.Context())
+				w.WriteHeader(tc.status)
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/tx/a1b2", nil)
+			req.Header.Set("traceparent", testTraceParent)
+			rec := httptest.NewRecorder()
+
+			// when
+			sut.ServeHTTP(rec, req)
+
+			// then
+			require.Equal(t, tc.status, rec.Code)
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			span := spans[0]
+			require.Equal(t, tc.expectedName, span.Name())
+			require.Equal(t, oteltrace.SpanKindServer, span.SpanKind())
+			require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.Parent().TraceID().String())
+			require.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+			require.Equal(t, tc.expectedStatus, span.Status().Code)
+
+			attributes := spanAttributes(span)
+			require.Equal(t, http.MethodGet, attributes[AttributeHTTPRequestMethod].AsString())
+			require.Equal(t, int64(tc.status), attributes[AttributeHTTPResponseStatusCode].AsInt64())
+		})
+	}
+}
@@ -15,6 +15,9 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
+// NewTraceProvider returns a trace provider exporting the sampled spans over gRPC with the given exporter options. The
+// spans are sampled with the percentage sample, of which 0 samples all spans. Use NewTraceProviderWithOptions for the
+// other sampling, exporter and resource options.
 func NewTraceProvider(ctx context.Context, serviceName string, sample int, opts ...otlptracegrpc.Option) (*trace.TracerProvider, *otlptrace.Exporter, error) {
 	exporter, err := otlptracegrpc.New(
 		ctx,
@@ -24,18 +27,49 @@ func NewTraceProvider(ctx context.Context, serviceName string, sample int, opts
 		return nil, nil, err
 	}
 
-	traceOpt := trace.WithSampler(trace.AlwaysSample())
-	if sample != 0 && sample != 100 {
-		traceOpt = trace.WithSampler(trace.TraceIDRatioBased(float64(sample) / 100))
-	}
-
 	tp := trace.NewTracerProvider(
 		trace.WithResource(resource.NewWithAttributes(
 			semconv.SchemaURL,
 			semconv.ServiceName(serviceName),
 		)),
 		trace.WithBatcher(exporter),
-		traceOpt,
+		trace.WithSampler(percentageSampler(legacySample(sample))),
+	)
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	otel.SetTracerProvider(tp)
+
+	return tp, exporter, nil
+}
+
+// NewTraceProviderWithOptions returns a trace provider exporting the spans sampled according to the sampling options to
+// the collector at the endpoint URL. The resource of the spans has the attributes of the configured resource
+// detectors, a detector which failed is skipped with a warning.
+func NewTraceProviderWithOptions(ctx context.Context, logger *slog.Logger, serviceName string, endpointURL string, opts ...Option) (*trace.TracerProvider, *otlptrace.Exporter, error) {
+	o := newOptions(opts...)
+
+	res, err := newResource(ctx, serviceName, o.detectors)
+	if err != nil {
+		if !errors.Is(err, resource.ErrPartialResource) {
+			return nil, nil, err
+		}
+		logger.Warn("Failed to detect some tracing resource attributes", slog.String("err", err.Error()))
+	}
+
+	exporter, err := NewExporter(ctx, endpointURL, o.exporter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var processor trace.SpanProcessor = trace.NewBatchSpanProcessor(exporter, o.batch.options()...)
+	if o.tailSampling != nil {
+		processor = tailSamplingProcessor{SpanProcessor: processor, export: o.tailSampling}
+	}
+
+	tp := trace.NewTracerProvider(
+		trace.WithResource(res),
+		trace.WithSpanProcessor(processor),
+		trace.WithSampler(o.newSampler()),
 	)
 
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
@@ -44,14 +78,22 @@ func NewTraceProvider(ctx context.Context, serviceName string, sample int, opts
 	return tp, exporter, nil
 }
 
+// Enable enables tracing with the spans exported over gRPC without TLS to dialAddr. The spans are sampled with the
+// percentage sample, of which 0 samples all spans. Use EnableWithOptions for the other sampling, exporter and resource
+// options.
 func Enable(logger *slog.Logger, serviceName string, dialAddr string, sample int) (func(), error) {
+	return EnableWithOptions(logger, serviceName, dialAddr, WithSample(legacySample(sample)))
+}
+
+// EnableWithOptions enables tracing with the spans exported to the collector at dialAddr according to the options.
+func EnableWithOptions(logger *slog.Logger, serviceName string, dialAddr string, opts ...Option) (func(), error) {
 	if dialAddr == "" {
 		return nil, errors.New("tracing enabled, but tracing address empty")
 	}
 
 	ctx := context.Background()
 
-	tp, exporter, err := NewTraceProvider(ctx, serviceName, sample, otlptracegrpc.WithEndpointURL(dialAddr), otlptracegrpc.WithInsecure())
+	tp, exporter, err := NewTraceProviderWithOptions(ctx, logger, serviceName, dialAddr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create trace provider: %v", err)
 	}
@@ -70,3 +112,13 @@ func Enable(logger *slog.Logger, serviceName string, dialAddr string, sample int
 
 	return cleanup, nil
 }
+
+// legacySample maps the sampling percentage of Enable and NewTraceProvider, of which 0 samples all spans, to the
+// sampling percentage of the options.
+func legacySample(sample int) int {
+	if sample == 0 {
+		return 100
+	}
+
+	return sample
+}
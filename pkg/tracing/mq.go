@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MessageTracer traces the publishing and the consumption of the messages of a message queue. The spans are named
+// after the operation and the destination, e.g. "Publish submit-tx", and have the attributes of the messaging system
+// and of the destination followed by the attributes of the tracer. The zero value does not trace.
+type MessageTracer struct {
+	system     string
+	enabled    bool
+	attributes []attribute.KeyValue
+}
+
+// NewMessageTracer returns a tracer for the messages of the messaging system, e.g. "nats", which traces only if
+// tracing is enabled.
+func NewMessageTracer(system string, tracingEnabled bool, attributes ...attribute.KeyValue) MessageTracer {
+	return MessageTracer{
+		system:     system,
+		enabled:    tracingEnabled,
+		attributes: attributes,
+	}
+}
+
+// Publish calls publish with a context carrying the span of the publishing. The trace context is written into the
+// header of the message, if given, so that the consumer of the message continues the trace.
+func (t MessageTracer) Publish(ctx context.Context, operation string, destination string, header map[string][]string, publish func(ctx context.Context) error) (err error) {
+	ctx, span := t.start(ctx, operation, destination, trace.SpanKindProducer, MessagingOperationPublish)
+	defer func() {
+		EndTracing(span, err)
+	}()
+
+	if header != nil {
+		InjectTraceContext(ctx, header)
+	}
+
+	return publish(ctx)
+}
+
+// Consume calls consume with a context continuing the trace read from the header of the message and carrying the span
+// of the consumption.
+func (t MessageTracer) Consume(ctx context.Context, operation string, destination string, header map[string][]string, consume func(ctx context.Context) error) (err error) {
+	ctx = ExtractTraceContext(ctx, header)
+
+	ctx, span := t.start(ctx, operation, destination, trace.SpanKindConsumer, MessagingOperationProcess)
+	defer func() {
+		EndTracing(span, err)
+	}()
+
+	return consume(ctx)
+}
+
+func (t MessageTracer) start(ctx context.Context, operation string, destination string, kind trace.SpanKind, operationType string) (context.Context, trace.Span) {
+	if !t.enabled {
+		return ctx, nil
+	}
+
+	return startSpan(ctx, operation+" "+destination, kind, append([]attribute.KeyValue{
+		AttributeMessagingSystem.String(t.system),
+		AttributeMessagingDestinationName.String(destination),
+		AttributeMessagingOperationType.String(operationType),
+	}, t.attributes...)...)
+}
@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestMessageTracer(t *testing.T) {
+	tt := []struct {
+		name       string
+		enabled    bool
+		consumeErr error
+
+		expectedSpans  int
+		expectedStatus codes.Code
+	}{
+		{
+			name:    "published and consumed",
+			enabled: true,
+
+			expectedSpans:  2,
+			expectedStatus: codes.Unset,
+		},
+		{
+			name:       "consumption failed",
+			enabled:    true,
+			consumeErr: errors.New("invalid message"),
+
+			expectedSpans:  2,
+			expectedStatus: codes.Error,
+		},
+		{
+			name: "tracing disabled",
+
+			expectedSpans: 0,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			recorder := newSpanRecorder(t)
+			sut := NewMessageTracer("nats", tc.enabled)
+			header := map[string][]string{}
+
+			// when
+			err := sut.Publish(context.Background(), "Publish", "submit-tx", header, func(_ context.Context) error {
+				return nil
+			})
+			require.NoError(t, err)
+
+			var consumed oteltrace.SpanContext
+			err = sut.Consume(context.Background(), "Consume", "submit-tx", header, func(ctx context.Context) error {
+				consumed = oteltrace.SpanContextFromContext(ctx)
+				return tc.consumeErr
+			})
+
+			// then
+			require.ErrorIs(t, err, tc.consumeErr)
+			spans := recorder.Ended()
+			require.Len(t, spans, tc.expectedSpans)
+			if tc.expectedSpans == 0 {
+				require.Empty(t, header)
+				return
+			}
+
+			published, consumer := spans[0], spans[1]
+			require.Equal(t, "Publish submit-tx", published.Name())
+			require.Equal(t, oteltrace.SpanKindProducer, published.SpanKind())
+			require.Equal(t, "Consume submit-tx", consumer.Name())
+			require.Equal(t, oteltrace.SpanKindConsumer, consumer.SpanKind())
+			require.Equal(t, published.SpanContext().SpanID(), consumer.Parent().SpanID())
+			require.Equal(t, consumer.SpanContext().SpanID(), consumed.SpanID())
+			require.Equal(t, tc.expectedStatus, consumer.Status().Code)
+
+			attributes := spanAttributes(consumer)
+			require.Equal(t, "nats", attributes[AttributeMessagingSystem].AsString())
+			require.Equal(t, "submit-tx", attributes[AttributeMessagingDestinationName].AsString())
+			require.Equal(t, MessagingOperationProcess, attributes[AttributeMessagingOperationType].AsString())
+		})
+	}
+}
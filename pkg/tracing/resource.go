@@ -0,0 +1,197 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	DetectorEnv       = "env"
+	DetectorHost      = "host"
+	DetectorOS        = "os"
+	DetectorProcess   = "process"
+	DetectorContainer = "container"
+	DetectorK8s       = "k8s"
+	DetectorEC2       = "ec2"
+
+	ec2MetadataEndpoint = "http://169.254.169.254"
+	ec2MetadataTimeout  = time.Second
+
+	k8sNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+var ErrUnknownResourceDetector = errors.New("unknown resource detector")
+
+// ResourceDetectors are the names of the available resource detectors:
+//   - env: the attributes of the env var OTEL_RESOURCE_ATTRIBUTES
+//   - host, os, process, container: the attributes of the host, the operating system, the process and the container
+//   - k8s: the pod, namespace and node, see k8sDetector
+//   - ec2: the instance, region and availability zone from the instance metadata service, see ec2Detector
+var ResourceDetectors = []string{DetectorEnv, DetectorHost, DetectorOS, DetectorProcess, DetectorContainer, DetectorK8s, DetectorEC2}
+
+// newResource returns the resource of the service with the attributes of the resource detectors. The resource of a
+// detector which failed is skipped, the returned error then wraps resource.ErrPartialResource.
+func newResource(ctx context.Context, serviceName string, detectors []string) (*resource.Resource, error) {
+	opts := []resource.Option{
+		resource.WithSchemaURL(semconv.SchemaURL),
+		resource.WithAttributes(semconv.ServiceName(serviceName)),
+	}
+
+	for _, name := range detectors {
+		switch name {
+		case DetectorEnv:
+			opts = append(opts, resource.WithFromEnv())
+		case DetectorHost:
+			opts = append(opts, resource.WithHost(), resource.WithHostID())
+		case DetectorOS:
+			opts = append(opts, resource.WithOS())
+		case DetectorProcess:
+			opts = append(opts, resource.WithProcess())
+		case DetectorContainer:
+			opts = append(opts, resource.WithContainer())
+		case DetectorK8s:
+			opts = append(opts, resource.WithDetectors(k8sDetector{namespaceFile: k8sNamespaceFile}))
+		case DetectorEC2:
+			opts = append(opts, resource.WithDetectors(ec2Detector{endpoint: ec2MetadataEndpoint, client: &http.Client{Timeout: ec2MetadataTimeout}}))
+		default:
+			return nil, errors.Join(ErrUnknownResourceDetector, fmt.Errorf("detector %q", name))
+		}
+	}
+
+	return resource.New(ctx, opts...)
+}
+
+// k8sDetector detects the pod, namespace and node of a service running in Kubernetes. The names are read from the env
+// vars K8S_POD_NAME, K8S_NAMESPACE_NAME and K8S_NODE_NAME, which can be set with the downward API. Without these env
+// vars the pod name is the hostname and the namespace is read from the service account. Outside of Kubernetes the
+// resource is empty.
+type k8sDetector struct {
+	namespaceFile string
+}
+
+func (d k8sDetector) Detect(_ context.Context) (*resource.Resource, error) {
+	if os.Getenv("KUBERNETES_SERVICE_HOST") == "" {
+		return resource.Empty(), nil
+	}
+
+	var attrs []attribute.KeyValue
+
+	podName := os.Getenv("K8S_POD_NAME")
+	if podName == "" {
+		podName, _ = os.Hostname()
+	}
+	if podName != "" {
+		attrs = append(attrs, semconv.K8SPodName(podName))
+	}
+
+	namespace := os.Getenv("K8S_NAMESPACE_NAME")
+	if namespace == "" {
+		b, err := os.ReadFile(d.namespaceFile)
+		if err == nil {
+			namespace = strings.TrimSpace(string(b))
+		}
+	}
+	if namespace != "" {
+		attrs = append(attrs, semconv.K8SNamespaceName(namespace))
+	}
+
+	nodeName := os.Getenv("K8S_NODE_NAME")
+	if nodeName != "" {
+		attrs = append(attrs, semconv.K8SNodeName(nodeName))
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
+// ec2Detector detects the instance of a service running on AWS EC2 from the instance identity document of the instance
+// metadata service, using IMDSv2. If the metadata service is not reachable, e.g. outside of EC2, the resource is empty.
+type ec2Detector struct {
+	endpoint string
+	client   *http.Client
+}
+
+type ec2IdentityDocument struct {
+	AccountID        string `json:"accountId"`
+	AvailabilityZone string `json:"availabilityZone"`
+	ImageID          string `json:"imageId"`
+	InstanceID       string `json:"instanceId"`
+	InstanceType     string `json:"instanceType"`
+	Region           string `json:"region"`
+}
+
+func (d ec2Detector) Detect(ctx context.Context) (*resource.Resource, error) {
+	token, err := d.token(ctx)
+	if err != nil {
+		// not running on EC2
+		return resource.Empty(), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, d.endpoint+"/latest/dynamic/instance-identity/document", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token", token)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get EC2 instance identity document: status %d", resp.StatusCode)
+	}
+
+	var doc ec2IdentityDocument
+	err = json.NewDecoder(resp.Body).Decode(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode EC2 instance identity document: %w", err)
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.CloudProviderAWS,
+		semconv.CloudPlatformAWSEC2,
+		semconv.CloudAccountID(doc.AccountID),
+		semconv.CloudRegion(doc.Region),
+		semconv.CloudAvailabilityZone(doc.AvailabilityZone),
+		semconv.HostID(doc.InstanceID),
+		semconv.HostType(doc.InstanceType),
+		semconv.HostImageID(doc.ImageID),
+	), nil
+}
+
+func (d ec2Detector) token(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, d.endpoint+"/latest/api/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-aws-ec2-metadata-token-ttl-seconds", "60")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get EC2 metadata token: status %d", resp.StatusCode)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
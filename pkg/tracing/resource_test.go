@@ -0,0 +1,198 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+func TestNewResource(t *testing.T) {
+	tt := []struct {
+		name      string
+		detectors []string
+		env       map[string]string
+
+		expectedAttributes map[attribute.Key]string
+		expectedErr        error
+	}{
+		{
+			name: "no detectors",
+
+			expectedAttributes: map[attribute.Key]string{semconv.ServiceNameKey: "metamorph"},
+		},
+		{
+			name:      "env",
+			detectors: []string{DetectorEnv},
+			env:       map[string]string{"OTEL_RESOURCE_ATTRIBUTES": "deployment.environment=staging"},
+
+			expectedAttributes: map[attribute.Key]string{
+				semconv.ServiceNameKey:   "metamorph",
+				"deployment.environment": "staging",
+			},
+		},
+		{
+			name:      "k8s",
+			detectors: []string{DetectorK8s},
+			env: map[string]string{
+				"KUBERNETES_SERVICE_HOST": "10.0.0.1",
+				"K8S_POD_NAME":            "metamorph-0",
+				"K8S_NAMESPACE_NAME":      "arc",
+				"K8S_NODE_NAME":           "node-1",
+			},
+
+			expectedAttributes: map[attribute.Key]string{
+				semconv.ServiceNameKey:      "metamorph",
+				semconv.K8SPodNameKey:       "metamorph-0",
+				semconv.K8SNamespaceNameKey: "arc",
+				semconv.K8SNodeNameKey:      "node-1",
+			},
+		},
+		{
+			name:      "k8s - outside of kubernetes",
+			detectors: []string{DetectorK8s},
+			env:       map[string]string{"KUBERNETES_SERVICE_HOST": ""},
+
+			expectedAttributes: map[attribute.Key]string{semconv.ServiceNameKey: "metamorph"},
+		},
+		{
+			name:      "unknown detector",
+			detectors: []string{"gcp"},
+
+			expectedErr: ErrUnknownResourceDetector,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			// when
+			actual, err := newResource(context.Background(), "metamorph", tc.detectors)
+
+			// then
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			attributes := make(map[attribute.Key]string)
+			for _, kv := range actual.Attributes() {
+				attributes[kv.Key] = kv.Value.Emit()
+			}
+			assert.Equal(t, tc.expectedAttributes, attributes)
+		})
+	}
+}
+
+func TestK8sDetector_NamespaceFile(t *testing.T) {
+	// given
+	namespaceFile := filepath.Join(t.TempDir(), "namespace")
+	require.NoError(t, os.WriteFile(namespaceFile, []byte("arc\n"), 0o600))
+	t.Setenv("KUBERNETES_SERVICE_HOST", "10.0.0.1")
+	t.Setenv("K8S_POD_NAME", "")
+	t.Setenv("K8S_NAMESPACE_NAME", "")
+
+	sut := k8sDetector{namespaceFile: namespaceFile}
+
+	// when
+	actual, err := sut.Detect(context.Background())
+
+	// then
+	require.NoError(t, err)
+	namespace, found := actual.Set().Value(semconv.K8SNamespaceNameKey)
+	require.True(t, found)
+	assert.Equal(t, "arc", namespace.AsString())
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+	podName, found := actual.Set().Value(semconv.K8SPodNameKey)
+	require.True(t, found)
+	assert.Equal(t, hostname, podName.AsString())
+}
+
+func TestEC2Detector(t *testing.T) {
+	tt := []struct {
+		name        string
+		tokenCode   int
+		unreachable bool
+
+		expectedAttributes map[attribute.Key]string
+	}{
+		{
+			name:      "instance identity",
+			tokenCode: http.StatusOK,
+
+			expectedAttributes: map[attribute.Key]string{
+				semconv.CloudProviderKey:         "aws",
+				semconv.CloudPlatformKey:         "aws_ec2",
+				semconv.CloudAccountIDKey:        "123456789012",
+				semconv.CloudRegionKey:           "eu-central-1",
+				semconv.CloudAvailabilityZoneKey: "eu-central-1a",
+				semconv.HostIDKey:                "i-0123456789abcdef0",
+				semconv.HostTypeKey:              "m5.large",
+				semconv.HostImageIDKey:           "ami-0123456789abcdef0",
+			},
+		},
+		{
+			name:        "not on EC2",
+			unreachable: true,
+
+			expectedAttributes: map[attribute.Key]string{},
+		},
+		{
+			name:      "token rejected",
+			tokenCode: http.StatusForbidden,
+
+			expectedAttributes: map[attribute.Key]string{},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPut && r.URL.Path == "/latest/api/token":
+					w.WriteHeader(tc.tokenCode)
+					_, _ = w.Write([]byte("token"))
+				case r.URL.Path == "/latest/dynamic/instance-identity/document" && r.Header.Get("X-aws-ec2-metadata-token") == "token":
+					_, _ = w.Write([]byte(`{"accountId":"123456789012","availabilityZone":"eu-central-1a","imageId":"ami-0123456789abcdef0","instanceId":"i-0123456789abcdef0","instanceType":"m5.large","region":"eu-central-1"}`))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			endpoint := server.URL
+			if tc.unreachable {
+				server.Close()
+			}
+
+			sut := ec2Detector{endpoint: endpoint, client: server.Client()}
+
+			// when
+			actual, err := sut.Detect(context.Background())
+
+			// then
+			require.NoError(t, err)
+
+			attributes := make(map[attribute.Key]string)
+			for _, kv := range actual.Attributes() {
+				attributes[kv.Key] = kv.Value.Emit()
+			}
+			assert.Equal(t, tc.expectedAttributes, attributes)
+		})
+	}
+}
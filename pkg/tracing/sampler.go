@@ -0,0 +1,205 @@
+package tracing
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// samplingConfig configures the sampling of the spans. Spans are sampled in the following order:
+//  1. If parent-based sampling is enabled, spans with a parent follow the sampling decision of the parent.
+//  2. Spans with a name matching a sampling override are sampled with the percentage of the override.
+//  3. All other spans are sampled with the default percentage.
+//
+// Spans which are not sampled are dropped, unless tail sampling is enabled, in which case they are recorded and
+// exported if the tail sampling hook decides so after they ended.
+type samplingConfig struct {
+	sample       int
+	parentBased  bool
+	overrides    map[string]int
+	tailSampling func(span trace.ReadOnlySpan) bool
+}
+
+// WithSample sets the percentage of the spans which are sampled. A percentage of 0 samples no spans, 100 samples all
+// spans. Without this option all spans are sampled.
+func WithSample(sample int) Option {
+	return func(c *options) {
+		c.sample = sample
+	}
+}
+
+// WithParentBased lets spans with a parent follow the sampling decision of the parent, so that traces are either
+// sampled completely or not at all. The sampling percentages only apply to root spans then.
+func WithParentBased() Option {
+	return func(c *options) {
+		c.parentBased = true
+	}
+}
+
+// WithSamplingOverrides sets the percentage of the spans which are sampled by span name, e.g. 100 for the processing of
+// blocks and 1 for the status requests. The percentages have the same meaning as in WithSample. A name ending with `*` matches all span names with the given prefix. The
+// longest matching name takes precedence.
+func WithSamplingOverrides(overrides map[string]int) Option {
+	return func(c *options) {
+		c.overrides = overrides
+	}
+}
+
+// WithTailSampling sets a hook deciding after the end of a span which was not sampled whether it is exported anyway,
+// e.g. because it failed or took long. As all spans have to be recorded for the hook, tail sampling increases the
+// overhead of tracing. Spans exported by the hook can lack their parent or children in the trace.
+func WithTailSampling(export func(span trace.ReadOnlySpan) bool) Option {
+	return func(c *options) {
+		c.tailSampling = export
+	}
+}
+
+// ExportFailedOrSlowSpans returns a tail sampling hook exporting spans with an error status and spans which took at
+// least minDuration. A minDuration of 0 exports failed spans only.
+func ExportFailedOrSlowSpans(minDuration time.Duration) func(span trace.ReadOnlySpan) bool {
+	return func(span trace.ReadOnlySpan) bool {
+		if span.Status().Code == codes.Error {
+			return true
+		}
+
+		return minDuration > 0 && span.EndTime().Sub(span.StartTime()) >= minDuration
+	}
+}
+
+func newSamplingConfig(opts ...Option) *samplingConfig {
+	return &newOptions(opts...).samplingConfig
+}
+
+// newSampler returns the head sampler. The decision is made at the start of the span.
+func (c *samplingConfig) newSampler() trace.Sampler {
+	var sampler trace.Sampler = newSpanNameSampler(percentageSampler(c.sample), c.overrides)
+
+	if c.parentBased {
+		sampler = trace.ParentBased(sampler)
+	}
+
+	if c.tailSampling != nil {
+		sampler = recordingSampler{sampler}
+	}
+
+	return sampler
+}
+
+// percentageSampler samples the given percentage of the spans, 0 or less samples no spans, 100 or more all spans.
+func percentageSampler(sample int) trace.Sampler {
+	switch {
+	case sample <= 0:
+		return trace.NeverSample()
+	case sample >= 100:
+		return trace.AlwaysSample()
+	default:
+		return trace.TraceIDRatioBased(float64(sample) / 100)
+	}
+}
+
+type nameSampler struct {
+	name    string
+	prefix  bool
+	sampler trace.Sampler
+}
+
+// spanNameSampler samples spans by their name and falls back to a default sampler for all other spans.
+type spanNameSampler struct {
+	samplers []nameSampler
+	fallback trace.Sampler
+}
+
+func newSpanNameSampler(fallback trace.Sampler, overrides map[string]int) trace.Sampler {
+	if len(overrides) == 0 {
+		return fallback
+	}
+
+	s := spanNameSampler{fallback: fallback}
+	for name, sample := range overrides {
+		sampler := percentageSampler(sample)
+		prefix := strings.HasSuffix(name, "*")
+		s.samplers = append(s.samplers, nameSampler{name: strings.TrimSuffix(name, "*"), prefix: prefix, sampler: sampler})
+	}
+
+	// the longest names are matched first
+	sort.Slice(s.samplers, func(i, j int) bool {
+		return len(s.samplers[i].name) > len(s.samplers[j].name)
+	})
+
+	return s
+}
+
+func (s spanNameSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	for _, ns := range s.samplers {
+		if p.Name == ns.name || (ns.prefix && strings.HasPrefix(p.Name, ns.name)) {
+			return ns.sampler.ShouldSample(p)
+		}
+	}
+
+	return s.fallback.ShouldSample(p)
+}
+
+func (s spanNameSampler) Description() string {
+	descriptions := make([]string, 0, len(s.samplers))
+	for _, ns := range s.samplers {
+		name := ns.name
+		if ns.prefix {
+			name += "*"
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s:%s", name, ns.sampler.Description()))
+	}
+
+	return fmt.Sprintf("SpanNameBased{overrides:[%s],fallback:%s}", strings.Join(descriptions, ","), s.fallback.Description())
+}
+
+// recordingSampler records the spans dropped by the wrapped sampler, so that the tail sampling hook can decide whether
+// to export them.
+type recordingSampler struct {
+	sampler trace.Sampler
+}
+
+func (s recordingSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	result := s.sampler.ShouldSample(p)
+	if result.Decision == trace.Drop {
+		result.Decision = trace.RecordOnly
+	}
+
+	return result
+}
+
+func (s recordingSampler) Description() string {
+	return fmt.Sprintf("Recording{%s}", s.sampler.Description())
+}
+
+// tailSamplingProcessor passes the sampled spans and the recorded spans selected by the tail sampling hook to the
+// wrapped span processor.
+type tailSamplingProcessor struct {
+	trace.SpanProcessor
+	export func(span trace.ReadOnlySpan) bool
+}
+
+func (p tailSamplingProcessor) OnEnd(s trace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.SpanProcessor.OnEnd(s)
+		return
+	}
+
+	if p.export(s) {
+		p.SpanProcessor.OnEnd(sampledSpan{s})
+	}
+}
+
+// sampledSpan marks a recorded span as sampled, so that it is exported.
+type sampledSpan struct {
+	trace.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() oteltrace.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
@@ -0,0 +1,207 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestSampler(t *testing.T) {
+	tt := []struct {
+		name     string
+		opts     []Option
+		spanName string
+
+		expectedDecision trace.SamplingDecision
+	}{
+		{
+			name:     "default - sample all",
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.RecordAndSample,
+		},
+		{
+			name:     "sample 0 - never sample",
+			opts:     []Option{WithSample(0)},
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.Drop,
+		},
+		{
+			name:     "override - never sample",
+			opts:     []Option{WithSamplingOverrides(map[string]int{"GetTransactionStatus": 0})},
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.Drop,
+		},
+		{
+			name:     "override - other span name",
+			opts:     []Option{WithSample(100), WithSamplingOverrides(map[string]int{"GetTransactionStatus": 0})},
+			spanName: "processBlock",
+
+			expectedDecision: trace.RecordAndSample,
+		},
+		{
+			name:     "override - prefix",
+			opts:     []Option{WithSamplingOverrides(map[string]int{"Get*": 0})},
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.Drop,
+		},
+		{
+			name:     "override - longest match takes precedence",
+			opts:     []Option{WithSamplingOverrides(map[string]int{"Get*": 0, "GetTransaction*": 100})},
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.RecordAndSample,
+		},
+		{
+			name:     "override - prefix without wildcard does not match",
+			opts:     []Option{WithSamplingOverrides(map[string]int{"Get": 0})},
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.RecordAndSample,
+		},
+		{
+			name:     "tail sampling - record dropped span",
+			opts:     []Option{WithSamplingOverrides(map[string]int{"GetTransactionStatus": 0}), WithTailSampling(ExportFailedOrSlowSpans(0))},
+			spanName: "GetTransactionStatus",
+
+			expectedDecision: trace.RecordOnly,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			sut := newSamplingConfig(tc.opts...).newSampler()
+
+			// when
+			actual := sut.ShouldSample(trace.SamplingParameters{ParentContext: context.Background(), Name: tc.spanName})
+
+			// then
+			assert.Equal(t, tc.expectedDecision, actual.Decision)
+		})
+	}
+}
+
+func TestSampler_ParentBased(t *testing.T) {
+	tt := []struct {
+		name        string
+		parentBased bool
+
+		expectedChildSampled bool
+	}{
+		{
+			name:        "parent based",
+			parentBased: true,
+
+			expectedChildSampled: true,
+		},
+		{
+			name:        "not parent based",
+			parentBased: false,
+
+			expectedChildSampled: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			opts := []Option{WithSamplingOverrides(map[string]int{"processBlock": 100, "insertBlock": 0})}
+			if tc.parentBased {
+				opts = append(opts, WithParentBased())
+			}
+			tp := trace.NewTracerProvider(trace.WithSampler(newSamplingConfig(opts...).newSampler()))
+			tracer := tp.Tracer("test")
+
+			// when
+			ctx, parent := tracer.Start(context.Background(), "processBlock")
+			_, child := tracer.Start(ctx, "insertBlock")
+
+			// then
+			assert.True(t, parent.SpanContext().IsSampled())
+			assert.Equal(t, tc.expectedChildSampled, child.SpanContext().IsSampled())
+		})
+	}
+}
+
+func TestTailSamplingProcessor(t *testing.T) {
+	tt := []struct {
+		name     string
+		spanName string
+		failed   bool
+		duration time.Duration
+
+		expectedExported bool
+	}{
+		{
+			name:     "sampled span",
+			spanName: "processBlock",
+
+			expectedExported: true,
+		},
+		{
+			name:     "unsampled span",
+			spanName: "GetTransactionStatus",
+
+			expectedExported: false,
+		},
+		{
+			name:     "unsampled failed span",
+			spanName: "GetTransactionStatus",
+			failed:   true,
+
+			expectedExported: true,
+		},
+		{
+			name:     "unsampled slow span",
+			spanName: "GetTransactionStatus",
+			duration: 2 * time.Second,
+
+			expectedExported: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			exporter := tracetest.NewInMemoryExporter()
+			sampling := newSamplingConfig(
+				WithSamplingOverrides(map[string]int{"GetTransactionStatus": 0}),
+				WithTailSampling(ExportFailedOrSlowSpans(time.Second)),
+			)
+			tp := trace.NewTracerProvider(
+				trace.WithSampler(sampling.newSampler()),
+				trace.WithSpanProcessor(tailSamplingProcessor{SpanProcessor: trace.NewSimpleSpanProcessor(exporter), export: sampling.tailSampling}),
+			)
+
+			start := time.Now()
+
+			// when
+			_, span := tp.Tracer("test").Start(context.Background(), tc.spanName, oteltrace.WithTimestamp(start))
+			if tc.failed {
+				span.SetStatus(codes.Error, "failed")
+			}
+			span.End(oteltrace.WithTimestamp(start.Add(tc.duration)))
+
+			// then
+			spans := exporter.GetSpans()
+			if !tc.expectedExported {
+				require.Empty(t, spans)
+				return
+			}
+			require.Len(t, spans, 1)
+			assert.Equal(t, tc.spanName, spans[0].Name)
+			assert.True(t, spans[0].SpanContext.IsSampled())
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cenkalti/backoff/v4"
 	"go.opentelemetry.io/otel/attribute"
 
+	"github.com/bitcoin-sv/arc/pkg/httpclient"
 	"github.com/bitcoin-sv/arc/pkg/tracing"
 )
 
@@ -38,7 +39,7 @@ var (
 )
 
 type WocClient struct {
-	client            http.Client
+	client            *httpclient.Client
 	authorization     string
 	net               string
 	logger            *slog.Logger
@@ -92,7 +93,6 @@ func New(mainnet bool, opts ...func(client *WocClient)) *WocClient {
 	}
 
 	w := &WocClient{
-		client:    http.Client{Timeout: 10 * time.Second},
 		net:       net,
 		url:       apiURL,
 		maxNumIDs: maxIDsNum,
@@ -102,6 +102,12 @@ func New(mainnet bool, opts ...func(client *WocClient)) *WocClient {
 		opt(w)
 	}
 
+	httpOpts := []func(*httpclient.Client){httpclient.WithTimeout(10 * time.Second)}
+	if w.tracingEnabled {
+		httpOpts = append(httpOpts, httpclient.WithTracer(w.tracingAttributes...))
+	}
+	w.client = httpclient.New("woc", httpOpts...)
+
 	return w
 }
 
@@ -10,5 +10,8 @@ fi
 
 swagger-cli bundle -o pkg/api/arc.json pkg/api/arc.yaml
 cp pkg/api/arc.json doc/
+swagger-cli bundle -o pkg/api/v2/arc.json pkg/api/v2/arc.yaml
+mkdir -p doc/v2
+cp pkg/api/v2/arc.json doc/v2/
 cp web/logo.png doc/dist/
 widdershins --search false --language_tabs 'http:HTTP' 'javascript:JavaScript' 'java:Java' 'go:Go' 'ruby:Ruby' 'python:Python' 'shell:curl' --summary pkg/api/arc.json -o doc/api.md